@@ -1231,12 +1231,19 @@ func defStreamEncoder() core.StreamEncoder {
 }
 
 // genFieldSignatureAppearance generates the appearance dictionary for a
-// signature appearance widget.
-func genFieldSignatureAppearance(fields []*SignatureLine, opts *SignatureFieldOpts) (*core.PdfObjectDictionary, error) {
+// signature appearance widget. It also returns the rectangle of the
+// appearance, which is computed from the content if not specified by the
+// options.
+func genFieldSignatureAppearance(fields []*SignatureLine, opts *SignatureFieldOpts) (*core.PdfObjectDictionary, []float64, error) {
 	if opts == nil {
 		opts = NewSignatureFieldOpts()
 	}
 
+	// Work on a copy of the options, so that the defaults filled in below
+	// do not leak into the caller's options.
+	o := *opts
+	opts = &o
+
 	// Get font.
 	var err error
 	var fontName *core.PdfObjectName
@@ -1254,7 +1261,7 @@ func genFieldSignatureAppearance(fields []*SignatureLine, opts *SignatureFieldOp
 		}
 	} else {
 		if font, err = model.NewStandard14Font("Helvetica"); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		fontName = core.MakeName("Helv")
 	}
@@ -1273,7 +1280,7 @@ func genFieldSignatureAppearance(fields []*SignatureLine, opts *SignatureFieldOp
 	// Get space character width.
 	spaceMetrics, found := font.GetRuneMetrics(' ')
 	if !found {
-		return nil, errors.New("the font does not have a space glyph")
+		return nil, nil, errors.New("the font does not have a space glyph")
 	}
 	spaceWidth := spaceMetrics.Wx
 
@@ -1314,24 +1321,61 @@ func genFieldSignatureAppearance(fields []*SignatureLine, opts *SignatureFieldOp
 	rect := opts.Rect
 	if rect == nil {
 		rect = []float64{0, 0, maxLineWidth, height}
-		opts.Rect = rect
+		if opts.Image != nil {
+			// Reserve a square area for the image.
+			switch opts.ImagePosition {
+			case SignatureImageTop:
+				rect[3] += height
+			default:
+				rect[2] += height
+			}
+		}
 	}
 	rectWidth := rect[2] - rect[0]
 	rectHeight := rect[3] - rect[1]
 
+	// Split the annotation rectangle into image and text areas.
+	textRect := rect
+	var imageRect []float64
+	if opts.Image != nil {
+		switch {
+		case len(lines) == 0:
+			imageRect, textRect = rect, nil
+		case opts.ImagePosition == SignatureImageTop:
+			midY := rect[1] + rectHeight/2
+			imageRect = []float64{rect[0], midY, rect[2], rect[3]}
+			textRect = []float64{rect[0], rect[1], rect[2], midY}
+		default:
+			midX := rect[0] + rectWidth/2
+			if opts.Rect == nil {
+				// Keep the full width of the text next to the square reserved
+				// for the image.
+				midX = rect[0] + height
+			}
+			imageRect = []float64{rect[0], rect[1], midX, rect[3]}
+			textRect = []float64{midX, rect[1], rect[2], rect[3]}
+		}
+	}
+
 	// Fit contents.
 	var offsetY float64
-	if opts.AutoSize {
-		if maxLineWidth > rectWidth || height > rectHeight {
-			scale := math.Min(rectWidth/maxLineWidth, rectHeight/height)
-			fontSize *= scale
-		}
+	if textRect != nil && len(lines) > 0 {
+		textWidth := textRect[2] - textRect[0]
+		textHeight := textRect[3] - textRect[1]
+		if (opts.AutoSize || opts.FitText) && maxLineWidth > 0 && height > 0 {
+			if opts.FitText || maxLineWidth > textWidth || height > textHeight {
+				scale := math.Min(textWidth/maxLineWidth, textHeight/height)
+				fontSize *= scale
+			}
 
-		lineHeight = opts.LineHeight * fontSize
-		offsetY += (rectHeight - float64(len(lines))*lineHeight) / 2
+			lineHeight = opts.LineHeight * fontSize
+			offsetY += (textHeight - float64(len(lines))*lineHeight) / 2
+		}
 	}
 
-	// Draw annotation rectangle.
+	resources := model.NewPdfPageResources()
+
+	// Draw annotation background.
 	cc := contentstream.NewContentCreator()
 
 	if opts.BorderSize <= 0 {
@@ -1339,11 +1383,14 @@ func genFieldSignatureAppearance(fields []*SignatureLine, opts *SignatureFieldOp
 		opts.BorderColor = model.NewPdfColorDeviceGray(1)
 	}
 	if opts.BorderColor == nil {
-		opts.FillColor = model.NewPdfColorDeviceGray(1)
+		opts.BorderColor = model.NewPdfColorDeviceGray(1)
 	}
 	if opts.FillColor == nil {
 		opts.FillColor = model.NewPdfColorDeviceGray(1)
 	}
+	if opts.TextColor == nil {
+		opts.TextColor = model.NewPdfColorDeviceGray(0)
+	}
 
 	cc.Add_q().
 		Add_re(rect[0], rect[1], rectWidth, rectHeight).
@@ -1353,55 +1400,170 @@ func genFieldSignatureAppearance(fields []*SignatureLine, opts *SignatureFieldOp
 		Add_B().
 		Add_Q()
 
-	// Draw signature.
-	cc.Add_q()
-	cc.Translate(rect[0], rect[3]-lineHeight-offsetY)
-	cc.Add_BT()
+	// Draw watermark.
+	if opts.WatermarkImage != nil {
+		ximg, err := model.NewXObjectImageFromImage(opts.WatermarkImage, nil, defStreamEncoder())
+		if err != nil {
+			return nil, nil, err
+		}
+		imgName := core.PdfObjectName("Wm1")
+		resources.SetXObjectImageByName(imgName, ximg)
+
+		cc.Add_q()
+		if opacity := opts.WatermarkOpacity; opacity > 0 && opacity < 1 {
+			gsName := core.PdfObjectName("GS1")
+			gsDict := core.MakeDict()
+			gsDict.Set("ca", core.MakeFloat(opacity))
+			gsDict.Set("CA", core.MakeFloat(opacity))
+			resources.AddExtGState(gsName, gsDict)
+			cc.Add_gs(gsName)
+		}
+		drawSignatureImage(cc, imgName, opts.WatermarkImage, rect)
+		cc.Add_Q()
+	}
 
-	encoder := font.Encoder()
-	for _, line := range lines {
-		var encStr []byte
-		for _, r := range line {
-			if unicode.IsSpace(r) {
-				if len(encStr) > 0 {
-					cc.SetNonStrokingColor(opts.TextColor).
-						Add_Tf(*fontName, fontSize).
+	// Draw signature image.
+	sc := contentstream.NewContentCreator()
+	if imageRect != nil {
+		ximg, err := model.NewXObjectImageFromImage(opts.Image, nil, defStreamEncoder())
+		if err != nil {
+			return nil, nil, err
+		}
+		imgName := core.PdfObjectName("Img1")
+		resources.SetXObjectImageByName(imgName, ximg)
+
+		sc.Add_q()
+		drawSignatureImage(sc, imgName, opts.Image, imageRect)
+		sc.Add_Q()
+	}
+
+	// Draw signature text.
+	if textRect != nil && len(lines) > 0 {
+		sc.Add_q()
+		sc.Translate(textRect[0], textRect[3]-lineHeight-offsetY)
+		sc.Add_BT()
+
+		encoder := font.Encoder()
+		for _, line := range lines {
+			var encStr []byte
+			for _, r := range line {
+				if unicode.IsSpace(r) {
+					if len(encStr) > 0 {
+						sc.SetNonStrokingColor(opts.TextColor).
+							Add_Tf(*fontName, fontSize).
+							Add_TL(lineHeight).
+							Add_TJ([]core.PdfObject{core.MakeStringFromBytes(encStr)}...)
+						encStr = nil
+					}
+
+					sc.Add_Tf(*fontName, fontSize).
 						Add_TL(lineHeight).
-						Add_TJ([]core.PdfObject{core.MakeStringFromBytes(encStr)}...)
-					encStr = nil
+						Add_TJ([]core.PdfObject{core.MakeFloat(-spaceWidth)}...)
+				} else {
+					encStr = append(encStr, encoder.Encode(string(r))...)
 				}
+			}
 
-				cc.Add_Tf(*fontName, fontSize).
+			if len(encStr) > 0 {
+				sc.SetNonStrokingColor(opts.TextColor).
+					Add_Tf(*fontName, fontSize).
 					Add_TL(lineHeight).
-					Add_TJ([]core.PdfObject{core.MakeFloat(-spaceWidth)}...)
-			} else {
-				encStr = append(encStr, encoder.Encode(string(r))...)
+					Add_TJ([]core.PdfObject{core.MakeStringFromBytes(encStr)}...)
 			}
-		}
 
-		if len(encStr) > 0 {
-			cc.SetNonStrokingColor(opts.TextColor).
-				Add_Tf(*fontName, fontSize).
-				Add_TL(lineHeight).
-				Add_TJ([]core.PdfObject{core.MakeStringFromBytes(encStr)}...)
+			sc.Add_Td(0, -lineHeight)
 		}
 
-		cc.Add_Td(0, -lineHeight)
-	}
+		sc.Add_ET()
+		sc.Add_Q()
 
-	cc.Add_ET()
-	cc.Add_Q()
+		resources.SetFontByName(*fontName, font.ToPdfObject())
+	}
 
 	// Create appearance dictionary.
-	resources := model.NewPdfPageResources()
-	resources.SetFontByName(*fontName, font.ToPdfObject())
-
-	xform := model.NewXObjectForm()
-	xform.Resources = resources
-	xform.BBox = core.MakeArrayFromFloats(rect)
-	xform.SetContentStream(cc.Bytes(), defStreamEncoder())
+	var xform *model.XObjectForm
+	if opts.LayeredAppearance {
+		xform, err = genSignatureLayers(cc.Bytes(), sc.Bytes(), resources, rect)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		xform = model.NewXObjectForm()
+		xform.Resources = resources
+		xform.BBox = core.MakeArrayFromFloats(rect)
+		xform.SetContentStream(append(cc.Bytes(), sc.Bytes()...), defStreamEncoder())
+	}
 
 	apDict := core.MakeDict()
 	apDict.Set("N", xform.ToPdfObject())
-	return apDict, nil
+	return apDict, rect, nil
+}
+
+// genSignatureLayers generates a signature appearance using the standard
+// layers recommended for signature appearances. The normal appearance
+// contains a form (FRM) which draws the background layer (n0) and the
+// signature layer (n2).
+func genSignatureLayers(background, signature []byte, resources *model.PdfPageResources, rect []float64) (*model.XObjectForm, error) {
+	bbox := core.MakeArrayFromFloats(rect)
+
+	newForm := func(content []byte, res *model.PdfPageResources) (*model.XObjectForm, error) {
+		xform := model.NewXObjectForm()
+		xform.Resources = res
+		xform.BBox = bbox
+		if err := xform.SetContentStream(content, defStreamEncoder()); err != nil {
+			return nil, err
+		}
+		return xform, nil
+	}
+
+	// Background and signature layers.
+	n0, err := newForm(background, resources)
+	if err != nil {
+		return nil, err
+	}
+	n2, err := newForm(signature, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	// Layer container.
+	frmResources := model.NewPdfPageResources()
+	frmResources.SetXObjectFormByName("n0", n0)
+	frmResources.SetXObjectFormByName("n2", n2)
+
+	cc := contentstream.NewContentCreator()
+	cc.Add_q().Add_Do("n0").Add_Q()
+	cc.Add_q().Add_Do("n2").Add_Q()
+
+	frm, err := newForm(cc.Bytes(), frmResources)
+	if err != nil {
+		return nil, err
+	}
+
+	// Normal appearance.
+	apResources := model.NewPdfPageResources()
+	apResources.SetXObjectFormByName("FRM", frm)
+
+	cc = contentstream.NewContentCreator()
+	cc.Add_q().Add_Do("FRM").Add_Q()
+
+	return newForm(cc.Bytes(), apResources)
+}
+
+// drawSignatureImage draws the image XObject named `name` in the specified
+// rectangle, preserving the aspect ratio of the image and centering it.
+func drawSignatureImage(cc *contentstream.ContentCreator, name core.PdfObjectName, img *model.Image, rect []float64) {
+	rectWidth := rect[2] - rect[0]
+	rectHeight := rect[3] - rect[1]
+	if img.Width <= 0 || img.Height <= 0 || rectWidth <= 0 || rectHeight <= 0 {
+		return
+	}
+
+	scale := math.Min(rectWidth/float64(img.Width), rectHeight/float64(img.Height))
+	width := float64(img.Width) * scale
+	height := float64(img.Height) * scale
+	x := rect[0] + (rectWidth-width)/2
+	y := rect[1] + (rectHeight-height)/2
+
+	cc.Add_cm(width, 0, 0, height, x, y).Add_Do(name)
 }
@@ -8,6 +8,7 @@ package annotator
 import (
 	"bytes"
 	"errors"
+	"math"
 
 	"github.com/showntop/unipdf/contentstream"
 	"github.com/showntop/unipdf/core"
@@ -221,6 +222,21 @@ func NewSignatureLine(desc, text string) *SignatureLine {
 	}
 }
 
+// SignatureImagePosition specifies the position of the image in a
+// signature appearance, relative to the text content.
+type SignatureImagePosition int
+
+// Signature image positions.
+const (
+	// SignatureImageLeft positions the image on the left side of the
+	// appearance, with the text content on the right.
+	SignatureImageLeft SignatureImagePosition = iota
+
+	// SignatureImageTop positions the image on the top side of the
+	// appearance, with the text content below.
+	SignatureImageTop
+)
+
 // SignatureFieldOpts represents a set of options used to configure
 // an appearance widget dictionary.
 type SignatureFieldOpts struct {
@@ -231,6 +247,34 @@ type SignatureFieldOpts struct {
 	// scaled to fit in the annotation rectangle.
 	AutoSize bool
 
+	// FitText specifies if the text content should also be scaled up in
+	// order to fill the available text area. If not set, the text is only
+	// scaled down, when AutoSize is enabled and the text does not fit.
+	FitText bool
+
+	// Image represents the image displayed next to the text content
+	// (e.g. a handwritten signature or a company seal). If there are
+	// no signature lines, the image fills the entire appearance area.
+	Image *model.Image
+
+	// ImagePosition specifies the position of the image relative to the
+	// text content. The image and the text content share the appearance
+	// area equally.
+	ImagePosition SignatureImagePosition
+
+	// WatermarkImage represents an image displayed in the background of
+	// the appearance, scaled to fit in the annotation rectangle.
+	WatermarkImage *model.Image
+
+	// WatermarkOpacity specifies the opacity of the watermark image, in
+	// the range (0, 1). If not in range, the watermark is fully opaque.
+	WatermarkOpacity float64
+
+	// LayeredAppearance specifies if the appearance should be generated
+	// using the standard signature appearance layers: the background
+	// layer (n0) and the signature layer (n2).
+	LayeredAppearance bool
+
 	// Font specifies the font of the text content.
 	Font *model.PdfFont
 
@@ -264,6 +308,8 @@ func NewSignatureFieldOpts() *SignatureFieldOpts {
 		TextColor:   model.NewPdfColorDeviceGray(0),
 		BorderColor: model.NewPdfColorDeviceGray(0),
 		FillColor:   model.NewPdfColorDeviceGray(1),
+
+		WatermarkOpacity: 0.3,
 	}
 }
 
@@ -275,13 +321,60 @@ func NewSignatureField(signature *model.PdfSignature, lines []*SignatureLine, op
 		return nil, errors.New("signature cannot be nil")
 	}
 
-	apDict, err := genFieldSignatureAppearance(lines, opts)
+	apDict, rect, err := genFieldSignatureAppearance(lines, opts)
 	if err != nil {
 		return nil, err
 	}
 
 	field := model.NewPdfFieldSignature(signature)
-	field.Rect = core.MakeArrayFromFloats(opts.Rect)
+	field.Rect = core.MakeArrayFromFloats(rect)
 	field.AP = apDict
 	return field, nil
 }
+
+// SetSignatureAppearance generates a visible appearance containing the
+// specified signature lines, styled according to the specified options, and
+// sets it as the appearance of the existing signature field `field`.
+// The field is typically retrieved using model.PdfAppender.GetSignatureField
+// and signed using model.PdfAppender.SignField afterwards. If the options do
+// not specify a rectangle, the rectangle of the field widget is used.
+func SetSignatureAppearance(field *model.PdfFieldSignature, lines []*SignatureLine, opts *SignatureFieldOpts) error {
+	if field == nil {
+		return errors.New("signature field cannot be nil")
+	}
+
+	widget := field.PdfAnnotationWidget
+	if widget == nil {
+		return errors.New("signature field widget not found")
+	}
+	if opts == nil {
+		opts = NewSignatureFieldOpts()
+	}
+
+	// Work on a copy of the options, so that the field rectangle does not
+	// leak into the caller's options.
+	o := *opts
+	opts = &o
+
+	if opts.Rect == nil {
+		rectArr, ok := core.GetArray(widget.Rect)
+		if !ok || rectArr.Len() != 4 {
+			return errors.New("invalid signature field rectangle")
+		}
+		rect, err := model.NewPdfRectangle(*rectArr)
+		if err != nil {
+			return err
+		}
+		opts.Rect = []float64{
+			math.Min(rect.Llx, rect.Urx), math.Min(rect.Lly, rect.Ury),
+			math.Max(rect.Llx, rect.Urx), math.Max(rect.Lly, rect.Ury),
+		}
+	}
+
+	apDict, _, err := genFieldSignatureAppearance(lines, opts)
+	if err != nil {
+		return err
+	}
+	widget.AP = apDict
+	return nil
+}
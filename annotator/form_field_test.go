@@ -0,0 +1,98 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package annotator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/contentstream"
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+)
+
+// TestSignatureFieldOptsReuse tests that signature options can be reused to
+// generate the appearance of multiple fields, without the defaults computed
+// for a field leaking into the next ones.
+func TestSignatureFieldOptsReuse(t *testing.T) {
+	opts := NewSignatureFieldOpts()
+
+	short, err := NewSignatureField(model.NewPdfSignature(nil),
+		[]*SignatureLine{NewSignatureLine("Name", "A")}, opts)
+	require.NoError(t, err)
+	require.Nil(t, opts.Rect)
+
+	long, err := NewSignatureField(model.NewPdfSignature(nil),
+		[]*SignatureLine{NewSignatureLine("Name", "A much longer signer name")}, opts)
+	require.NoError(t, err)
+	require.Nil(t, opts.Rect)
+
+	shortRect, err := model.NewPdfRectangle(*short.Rect.(*core.PdfObjectArray))
+	require.NoError(t, err)
+	longRect, err := model.NewPdfRectangle(*long.Rect.(*core.PdfObjectArray))
+	require.NoError(t, err)
+	require.Greater(t, longRect.Width(), shortRect.Width())
+
+	// Existing fields keep their own widget rectangles.
+	for _, rect := range []*model.PdfRectangle{shortRect, longRect} {
+		field := model.NewPdfFieldSignature(nil)
+		field.Rect = rect.ToPdfObject()
+		require.NoError(t, SetSignatureAppearance(field, nil, opts))
+		require.Nil(t, opts.Rect)
+	}
+}
+
+// TestSignatureImageLeftDefaultRect tests that the text of a signature
+// appearance with an image on the left side and no rectangle set keeps the
+// width of its longest line next to the square reserved for the image.
+func TestSignatureImageLeftDefaultRect(t *testing.T) {
+	opts := NewSignatureFieldOpts()
+	opts.Image = &model.Image{
+		Width:            10,
+		Height:           10,
+		BitsPerComponent: 8,
+		ColorComponents:  1,
+		Data:             make([]byte, 100),
+	}
+
+	line := NewSignatureLine("Name", "John Smith")
+	apDict, rect, err := genFieldSignatureAppearance([]*SignatureLine{line}, opts)
+	require.NoError(t, err)
+
+	// Measure the line.
+	font, err := model.NewStandard14Font("Helvetica")
+	require.NoError(t, err)
+	var lineWidth float64
+	for _, r := range line.Desc + ": " + line.Text {
+		metrics, found := font.GetRuneMetrics(r)
+		require.True(t, found)
+		lineWidth += metrics.Wx
+	}
+	lineWidth = lineWidth * opts.FontSize / 1000.0
+
+	// The text is drawn from the last translation of the appearance stream.
+	stream, ok := core.GetStream(apDict.Get("N"))
+	require.True(t, ok)
+	xform, err := model.NewXObjectFormFromStream(stream)
+	require.NoError(t, err)
+	content, err := xform.GetContentStream()
+	require.NoError(t, err)
+	ops, err := contentstream.NewContentStreamParser(string(content)).Parse()
+	require.NoError(t, err)
+
+	var textX float64
+	for _, op := range *ops {
+		if op.Operand != "cm" {
+			continue
+		}
+		params, err := core.GetNumbersAsFloat(op.Params)
+		require.NoError(t, err)
+		textX = params[4]
+	}
+	require.Greater(t, textX, rect[0])
+	require.GreaterOrEqual(t, rect[2]-textX, lineWidth)
+}
@@ -2,6 +2,8 @@ package contentstream
 
 import (
 	"testing"
+
+	"github.com/showntop/unipdf/internal/transform"
 )
 
 func TestOperandTJSpacing(t *testing.T) {
@@ -23,3 +25,17 @@ func TestOperandTJSpacing(t *testing.T) {
 	}
 
 }
+
+// TestGraphicsStateTransform tests that points are mapped to device space by
+// [x y 1] × CTM, for a CTM which rotates and shears.
+func TestGraphicsStateTransform(t *testing.T) {
+	gs := GraphicsState{CTM: transform.NewMatrix(0, 1, -1, 0, 100, 200)}
+	if x, y := gs.Transform(10, 0); x != 100 || y != 210 {
+		t.Fatalf("Bad rotated transform: (%g,%g)", x, y)
+	}
+
+	gs.CTM = transform.NewMatrix(1, 0, 0.5, 1, 0, 0)
+	if x, y := gs.Transform(0, 10); x != 5 || y != 10 {
+		t.Fatalf("Bad sheared transform: (%g,%g)", x, y)
+	}
+}
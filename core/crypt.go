@@ -6,6 +6,7 @@
 package core
 
 import (
+	"bytes"
 	"crypto/md5"
 	"crypto/rand"
 	"errors"
@@ -102,6 +103,8 @@ type PdfCrypt struct {
 	decryptedObjects map[PdfObject]bool
 	encryptedObjects map[PdfObject]bool
 	authenticated    bool
+	// Access permissions granted by the password used for authentication.
+	authPerm security.Permissions
 	// Crypt filters (V4).
 	cryptFilters cryptFilters
 	streamFilter string
@@ -500,6 +503,16 @@ func (crypt *PdfCrypt) GetAccessPermissions() security.Permissions {
 	return crypt.encryptStd.P
 }
 
+// GetCryptFilter returns the crypt filter used by default for encrypting the
+// streams of the document.
+func (crypt *PdfCrypt) GetCryptFilter() crypto.Filter {
+	name := stdCryptFilter
+	if crypt.encrypt.V >= 4 {
+		name = crypt.streamFilter
+	}
+	return crypt.cryptFilters[name]
+}
+
 func (crypt *PdfCrypt) securityHandler() security.StdHandler {
 	if crypt.encryptStd.R >= 5 {
 		return security.NewHandlerR6()
@@ -511,6 +524,7 @@ func (crypt *PdfCrypt) securityHandler() security.StdHandler {
 // Also build the encryption/decryption key.
 func (crypt *PdfCrypt) authenticate(password []byte) (bool, error) {
 	crypt.authenticated = false
+	crypt.authPerm = 0
 	h := crypt.securityHandler()
 	fkey, perm, err := h.Authenticate(&crypt.encryptStd, password)
 	if err != nil {
@@ -519,10 +533,28 @@ func (crypt *PdfCrypt) authenticate(password []byte) (bool, error) {
 		return false, nil
 	}
 	crypt.authenticated = true
+	crypt.authPerm = perm
 	crypt.encryptionKey = fkey
 	return true, nil
 }
 
+// authenticateAs authenticates `crypt` with the encryption key and the access
+// permissions of `other`, which must be an authenticated handler of the same
+// document.
+func (crypt *PdfCrypt) authenticateAs(other *PdfCrypt) bool {
+	crypt.authenticated = false
+	crypt.authPerm = 0
+	if !other.authenticated || crypt.id0 != other.id0 ||
+		!bytes.Equal(crypt.encryptStd.O, other.encryptStd.O) ||
+		!bytes.Equal(crypt.encryptStd.U, other.encryptStd.U) {
+		return false
+	}
+	crypt.authenticated = true
+	crypt.authPerm = other.authPerm
+	crypt.encryptionKey = append([]byte(nil), other.encryptionKey...)
+	return true
+}
+
 // Check access rights and permissions for a specified password.  If either user/owner password is specified,
 // full rights are granted, otherwise the access rights are specified by the Permissions flag.
 //
@@ -84,6 +84,15 @@ func (parser *PdfParser) IsAuthenticated() bool {
 	return parser.crypter.authenticated
 }
 
+// IsOwnerAuthenticated returns true if the PDF has been authenticated with the
+// owner password, which grants full access to the document.
+func (parser *PdfParser) IsOwnerAuthenticated() bool {
+	if parser.crypter == nil {
+		return true
+	}
+	return parser.crypter.authenticated && parser.crypter.authPerm == security.PermOwner
+}
+
 // GetTrailer returns the PDFs trailer dictionary. The trailer dictionary is typically the starting point for a PDF,
 // referencing other key objects that are important in the document structure.
 func (parser *PdfParser) GetTrailer() *PdfObjectDictionary {
@@ -1751,6 +1760,16 @@ func (parser *PdfParser) Decrypt(password []byte) (bool, error) {
 	return authenticated, err
 }
 
+// DecryptAs decrypts the PDF with the access rights of `other`, which must be an
+// authenticated parser of the same document. It allows reopening an encrypted
+// document without keeping its password. Returns true if successful.
+func (parser *PdfParser) DecryptAs(other *PdfParser) (bool, error) {
+	if parser.crypter == nil || other.crypter == nil {
+		return false, errors.New("check encryption first")
+	}
+	return parser.crypter.authenticateAs(other.crypter), nil
+}
+
 // CheckAccessRights checks access rights and permissions for a specified password. If either user/owner password is
 // specified, full rights are granted, otherwise the access rights are specified by the Permissions flag.
 //
@@ -0,0 +1,260 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/showntop/unipdf/common"
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/internal/transform"
+	"github.com/showntop/unipdf/model"
+)
+
+// DocumentExtractOptions contains options for controlling the extraction of text from all the
+// pages of a PDF document.
+type DocumentExtractOptions struct {
+	// Workers is the maximum number of pages that are extracted concurrently. It defaults to the
+	// number of CPUs.
+	Workers int
+
+	// TextOptions controls the text extracted from each page. It can be nil.
+	TextOptions *TextExtractOptions
+}
+
+// DocumentExtractor extracts text from the pages of a PDF document. Fonts and the text of form
+// XObjects with their own resources are cached by object reference across pages, so the fonts and
+// forms that are shared by many pages are only parsed a few times, and pages are extracted
+// concurrently.
+type DocumentExtractor struct {
+	reader  *model.PdfReader
+	options DocumentExtractOptions
+
+	// readerLock protects `reader`, which can't load objects concurrently. Pages are loaded and
+	// their objects are resolved with the lock held for writing. The text of the loaded pages is
+	// extracted with the lock held for reading.
+	readerLock sync.RWMutex
+	traversed  map[core.PdfObject]struct{} // Objects whose references have been resolved.
+	structure  docStructure                // The structure of the pages, loaded with the first page.
+
+	forms *formCache
+}
+
+// PageTextResult is the text extracted from a page of a document.
+type PageTextResult struct {
+	// PageNumber is the (1-offset) number of the page.
+	PageNumber int
+	// PageText is the text of the page. It is nil if Err is not nil.
+	PageText *PageText
+	// NumChars and NumMisses are the number of characters in the text and the number of
+	// characters that were not decoded.
+	NumChars, NumMisses int
+	// Err is the error that occurred extracting the text of the page, if any.
+	Err error
+}
+
+// NewDocumentExtractor returns a DocumentExtractor for extracting the text of the pages of
+// `reader`. `options` can be nil for the default options.
+func NewDocumentExtractor(reader *model.PdfReader, options *DocumentExtractOptions) *DocumentExtractor {
+	d := &DocumentExtractor{
+		reader:    reader,
+		traversed: map[core.PdfObject]struct{}{},
+		forms:     &formCache{results: map[formKey]textResult{}},
+	}
+	if options != nil {
+		d.options = *options
+	}
+	if d.options.Workers <= 0 {
+		d.options.Workers = runtime.NumCPU()
+	}
+	return d
+}
+
+// ExtractPageTexts returns the text of the pages of the document with (1-offset) page numbers
+// `pageNums`, in the order of `pageNums`. The text of all the pages is returned if `pageNums` is
+// empty. An error that occurs extracting the text of a page is returned in the page's result and
+// does not stop the extraction of the other pages.
+func (d *DocumentExtractor) ExtractPageTexts(pageNums []int) ([]PageTextResult, error) {
+	if len(pageNums) == 0 {
+		numPages, err := d.reader.GetNumPages()
+		if err != nil {
+			return nil, err
+		}
+		for pageNum := 1; pageNum <= numPages; pageNum++ {
+			pageNums = append(pageNums, pageNum)
+		}
+	}
+
+	results := make([]PageTextResult, len(pageNums))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < d.options.Workers && w < len(pageNums); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Fonts are not safe for concurrent use so each worker has its own font cache of
+			// indirect fonts. Direct fonts are cached by the page's Extractor.
+			fonts := map[int64]*model.PdfFont{}
+			for i := range jobs {
+				results[i] = d.extractPage(pageNums[i], fonts)
+			}
+		}()
+	}
+	for i := range pageNums {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return results, nil
+}
+
+// extractPage returns the text of the page with (1-offset) page number `pageNum`. `fonts` is the
+// font cache of the calling worker.
+func (d *DocumentExtractor) extractPage(pageNum int, fonts map[int64]*model.PdfFont) PageTextResult {
+	result := PageTextResult{PageNumber: pageNum}
+
+	d.readerLock.Lock()
+	e, err := d.loadPage(pageNum)
+	d.readerLock.Unlock()
+	if err != nil {
+		common.Log.Debug("ERROR: loading page %d failed. err=%v", pageNum, err)
+		result.Err = err
+		return result
+	}
+	e.docFonts = fonts
+	e.forms = d.forms
+
+	d.readerLock.RLock()
+	pageText, numChars, numMisses, err := e.ExtractPageText()
+	d.readerLock.RUnlock()
+	result.PageText, result.NumChars, result.NumMisses, result.Err = pageText, numChars, numMisses, err
+	return result
+}
+
+// loadPage returns an Extractor for the page with (1-offset) page number `pageNum`. All the
+// references in the page's resources are resolved so that the text of the page can be extracted
+// without loading objects from the reader.
+func (d *DocumentExtractor) loadPage(pageNum int) (*Extractor, error) {
+	page, err := d.reader.GetPage(pageNum)
+	if err != nil {
+		return nil, err
+	}
+	e, err := New(page)
+	if err != nil {
+		return nil, err
+	}
+	e.textOptions = d.options.TextOptions
+	if e.textOptions != nil && e.textOptions.StructureOrder {
+		// The structure tree is traversed once for all the pages.
+		if d.structure == nil {
+			root, err := page.GetStructTreeRoot()
+			if err != nil {
+				return nil, err
+			}
+			d.structure = newDocStructure(root)
+		}
+		e.structure = d.structure.page(page.GetPageAsIndirectObject())
+	}
+	if e.resources == nil {
+		return e, nil
+	}
+	r := e.resources
+	for _, obj := range []*core.PdfObject{&r.ExtGState, &r.ColorSpace, &r.Pattern, &r.Shading,
+		&r.XObject, &r.Font, &r.ProcSet, &r.Properties} {
+		if *obj == nil {
+			continue
+		}
+		*obj = core.ResolveReference(*obj)
+		if err := core.ResolveReferencesDeep(*obj, d.traversed); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// maxFormCache is the maximum number of form XObject results in a formCache.
+const maxFormCache = 1000
+
+// maxDocFonts is the maximum number of fonts in the document font cache of a worker.
+const maxDocFonts = 100
+
+// formKey identifies the text of a form XObject drawn on a page. The text depends on the CTM the
+// form is drawn with and the media box of the page it is drawn on. Only forms with their own
+// resources are cached by formKey, as the text of the forms that use the resources of the content
+// stream drawing them also depends on those resources.
+type formKey struct {
+	objNum   int64
+	ctm      transform.Matrix
+	mediaBox model.PdfRectangle
+}
+
+// formCache is a cache of the text of form XObjects that is shared by the pages of a document.
+type formCache struct {
+	sync.Mutex
+	results map[formKey]textResult
+}
+
+// get returns the cached text of the form with key `key`. The marks are copied as the marks
+// returned are modified by text extraction.
+func (c *formCache) get(key formKey) (textResult, bool) {
+	c.Lock()
+	result, ok := c.results[key]
+	c.Unlock()
+	if !ok {
+		return result, false
+	}
+	result.pageText.marks = copyMarks(result.pageText.marks)
+	return result, true
+}
+
+// set caches `result`, the text of the form with key `key`, if the cache is not full.
+func (c *formCache) set(key formKey, result textResult) {
+	result.pageText.marks = copyMarks(result.pageText.marks)
+	c.Lock()
+	defer c.Unlock()
+	if len(c.results) < maxFormCache {
+		c.results[key] = result
+	}
+}
+
+// copyMarks returns a copy of `marks` that doesn't share textMarks with `marks`.
+func copyMarks(marks []*textMark) []*textMark {
+	copied := make([]*textMark, len(marks))
+	for i, tm := range marks {
+		mark := *tm
+		copied[i] = &mark
+	}
+	return copied
+}
+
+// sharesForm returns true if the text of form XObject `xobj` is cached in the form cache shared
+// by the pages of a document.
+func (e *Extractor) sharesForm(xobj *core.PdfObjectStream) bool {
+	return e.forms != nil && xobj != nil && xobj.ObjectNumber != 0 && xobj.Get("Resources") != nil
+}
+
+// getFormResult returns the text of form XObject `xobj` with name `name` drawn with CTM `ctm` if
+// it has been cached.
+func (e *Extractor) getFormResult(name string, xobj *core.PdfObjectStream,
+	ctm transform.Matrix) (textResult, bool) {
+	if e.sharesForm(xobj) {
+		return e.forms.get(formKey{xobj.ObjectNumber, ctm, e.mediaBox})
+	}
+	result, ok := e.formResults[name]
+	return result, ok
+}
+
+// setFormResult caches `result`, the text of form XObject `xobj` with name `name` drawn with CTM
+// `ctm`.
+func (e *Extractor) setFormResult(name string, xobj *core.PdfObjectStream, ctm transform.Matrix,
+	result textResult) {
+	if e.sharesForm(xobj) {
+		e.forms.set(formKey{xobj.ObjectNumber, ctm, e.mediaBox}, result)
+		return
+	}
+	e.formResults[name] = result
+}
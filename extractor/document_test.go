@@ -0,0 +1,150 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+)
+
+// makeFormDocument returns a PDF document with `numPages` pages that all draw the same form
+// XObject. The last page draws it with a different CTM.
+func makeFormDocument(t *testing.T, numPages int) []byte {
+	courier := model.NewStandard14FontMustCompile(model.CourierName)
+	formResources := model.NewPdfPageResources()
+	formResources.SetFontByName("UniDocCourier", courier.ToPdfObject())
+	xform := model.NewXObjectForm()
+	xform.Resources = formResources
+	require.NoError(t, xform.SetContentStream(
+		[]byte("BT /UniDocCourier 10 Tf 100 100 Td (Shared footer) Tj ET"), nil))
+
+	writer := model.NewPdfWriter()
+	for pageNum := 1; pageNum <= numPages; pageNum++ {
+		resources := model.NewPdfPageResources()
+		resources.SetFontByName("UniDocCourier", courier.ToPdfObject())
+		require.NoError(t, resources.SetXObjectFormByName("Fm1", xform))
+
+		page := model.NewPdfPage()
+		page.MediaBox = &model.PdfRectangle{Urx: 600, Ury: 800}
+		page.Resources = resources
+		contents := fmt.Sprintf("BT /UniDocCourier 10 Tf 100 700 Td (Page %d) Tj ET /Fm1 Do", pageNum)
+		if pageNum == numPages {
+			contents = fmt.Sprintf("BT /UniDocCourier 10 Tf 100 700 Td (Page %d) Tj ET "+
+				"q 1 0 0 1 0 300 cm /Fm1 Do Q", pageNum)
+		}
+		require.NoError(t, page.SetContentStreams([]string{contents}, nil))
+		require.NoError(t, writer.AddPage(page))
+	}
+	var buf bytes.Buffer
+	require.NoError(t, writer.Write(&buf))
+	return buf.Bytes()
+}
+
+func TestDocumentExtractor(t *testing.T) {
+	const numPages = 7
+	data := makeFormDocument(t, numPages)
+	reader, err := model.NewPdfReader(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	d := NewDocumentExtractor(reader, &DocumentExtractOptions{Workers: 3})
+	results, err := d.ExtractPageTexts(nil)
+	require.NoError(t, err)
+	require.Len(t, results, numPages)
+	for i, result := range results {
+		require.NoError(t, result.Err)
+		require.Equal(t, i+1, result.PageNumber)
+
+		// The text is the same as the text extracted from the page alone.
+		page, err := reader.GetPage(i + 1)
+		require.NoError(t, err)
+		e, err := New(page)
+		require.NoError(t, err)
+		pageText, _, _, err := e.ExtractPageText()
+		require.NoError(t, err)
+		require.Equal(t, pageText.Text(), result.PageText.Text())
+		require.Contains(t, result.PageText.Text(), fmt.Sprintf("Page %d", i+1))
+		require.Contains(t, result.PageText.Text(), "Shared footer")
+	}
+
+	// The form is cached once for each CTM it is drawn with.
+	require.Len(t, d.forms.results, 2)
+	footer, err := results[numPages-1].PageText.Search("Shared footer", nil)
+	require.NoError(t, err)
+	require.Len(t, footer, 1)
+	require.InDelta(t, 400, footer[0].Quads[0][5], 0.01)
+
+	// Selected pages are returned in the order requested.
+	results, err = d.ExtractPageTexts([]int{3, 1, numPages + 1})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.Equal(t, 3, results[0].PageNumber)
+	require.Contains(t, results[0].PageText.Text(), "Page 3")
+	require.Equal(t, 1, results[1].PageNumber)
+	require.Error(t, results[2].Err)
+}
+
+// TestDocumentExtractorInheritedResources checks that the text of a form XObject that uses the
+// resources of the pages drawing it is extracted with the fonts of each page.
+func TestDocumentExtractorInheritedResources(t *testing.T) {
+	xform := model.NewXObjectForm()
+	require.NoError(t, xform.SetContentStream([]byte("BT /F1 10 Tf 100 100 Td (AAA) Tj ET"), nil))
+
+	// The second page's /F1 maps code 65 to "B".
+	differences := core.MakeDict()
+	differences.Set("Type", core.MakeName("Encoding"))
+	differences.Set("BaseEncoding", core.MakeName("WinAnsiEncoding"))
+	differences.Set("Differences", core.MakeArray(core.MakeInteger(65), core.MakeName("B")))
+	remapped := core.MakeDict()
+	remapped.Set("Type", core.MakeName("Font"))
+	remapped.Set("Subtype", core.MakeName("Type1"))
+	remapped.Set("BaseFont", core.MakeName(string(model.CourierName)))
+	remapped.Set("Encoding", differences)
+	fonts := []core.PdfObject{
+		model.NewStandard14FontMustCompile(model.CourierName).ToPdfObject(),
+		core.MakeIndirectObject(remapped),
+	}
+
+	writer := model.NewPdfWriter()
+	for _, font := range fonts {
+		resources := model.NewPdfPageResources()
+		resources.SetFontByName("F1", font)
+		require.NoError(t, resources.SetXObjectFormByName("Fm1", xform))
+
+		page := model.NewPdfPage()
+		page.MediaBox = &model.PdfRectangle{Urx: 600, Ury: 800}
+		page.Resources = resources
+		require.NoError(t, page.SetContentStreams([]string{"/Fm1 Do"}, nil))
+		require.NoError(t, writer.AddPage(page))
+	}
+	var buf bytes.Buffer
+	require.NoError(t, writer.Write(&buf))
+	reader, err := model.NewPdfReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	d := NewDocumentExtractor(reader, nil)
+	results, err := d.ExtractPageTexts(nil)
+	require.NoError(t, err)
+	require.Len(t, results, len(fonts))
+	for i, result := range results {
+		require.NoError(t, result.Err)
+		page, err := reader.GetPage(i + 1)
+		require.NoError(t, err)
+		e, err := New(page)
+		require.NoError(t, err)
+		pageText, _, _, err := e.ExtractPageText()
+		require.NoError(t, err)
+		require.Equal(t, pageText.Text(), result.PageText.Text())
+	}
+	require.Contains(t, results[0].PageText.Text(), "AAA")
+	require.Contains(t, results[1].PageText.Text(), "BBB")
+	require.Empty(t, d.forms.results)
+}
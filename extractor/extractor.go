@@ -22,8 +22,9 @@ type Extractor struct {
 	// from PDF objects. NOTE: This is not a conventional glyph cache. It only caches PdfFonts.
 	fontCache map[string]fontEntry
 
-	// text results from running extractXYText on forms within the page.
-	// TODO(peterwilliams97): Cache this map accross all pages in a PDF to speed up processing.
+	// text results from running extractXYText on forms within the page. DocumentExtractor caches
+	// the results of the forms with their own resources across all pages of a PDF in `forms`
+	// instead.
 	formResults map[string]textResult
 
 	// accessCount is used to set fontEntry.access to an incrementing number.
@@ -31,6 +32,19 @@ type Extractor struct {
 
 	// textCount is an incrementing number used to identify XYTest objects.
 	textCount int
+
+	// textOptions controls the selection of the text that is extracted.
+	textOptions *TextExtractOptions
+
+	// docFonts and forms are caches of fonts and form XObject text keyed by object reference.
+	// They are shared by the pages of a document when extracting with a DocumentExtractor and are
+	// nil otherwise.
+	docFonts map[int64]*model.PdfFont
+	forms    *formCache
+
+	// structure is the logical structure of the page from the structure tree of the document. It
+	// is nil unless text extraction follows the logical structure order.
+	structure *pageStructure
 }
 
 // New returns an Extractor instance for extracting content from the input PDF page.
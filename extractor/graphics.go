@@ -0,0 +1,639 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"errors"
+	"image/color"
+	"math"
+
+	"github.com/showntop/unipdf/common"
+	"github.com/showntop/unipdf/contentstream"
+	"github.com/showntop/unipdf/contentstream/draw"
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/internal/transform"
+	"github.com/showntop/unipdf/model"
+)
+
+// GraphicsExtractOptions contains options for controlling the extraction of
+// vector graphics from PDF pages.
+type GraphicsExtractOptions struct {
+	// IncludeClipPaths includes the paths that are only used as clipping
+	// paths (W n) and are not painted.
+	IncludeClipPaths bool
+}
+
+// FillRule is the rule used to determine the inside of a path.
+type FillRule int
+
+// Fill rules.
+const (
+	FillRuleWinding FillRule = iota // Nonzero winding number rule.
+	FillRuleEvenOdd                 // Even-odd rule.
+)
+
+// ExtractPageGraphics returns the paths painted on the page of the extractor,
+// including the paths in form XObjects, in the order they are painted.
+// A set of options to control the extraction can be passed in. The options
+// parameter can be nil for the default options. By default, paths that are
+// only used for clipping are not extracted. Malformed operators and forms
+// are skipped.
+func (e *Extractor) ExtractPageGraphics(options *GraphicsExtractOptions) (*PageGraphics, error) {
+	if options == nil {
+		options = &GraphicsExtractOptions{}
+	}
+	ctx := &graphicsExtractContext{options: options}
+	state := pathGraphicsState{
+		lineWidth:        1,
+		clipBox:          e.mediaBox,
+		strokeColor:      model.NewPdfColorDeviceGray(0),
+		strokeColorspace: model.NewPdfColorspaceDeviceGray(),
+		fillColor:        model.NewPdfColorDeviceGray(0),
+		fillColorspace:   model.NewPdfColorspaceDeviceGray(),
+	}
+	err := ctx.extractContentStreamGraphics(e.contents, e.resources, transform.IdentityMatrix(), state, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &PageGraphics{Paths: ctx.paths}, nil
+}
+
+// PageGraphics represents the vector graphics on a PDF page.
+type PageGraphics struct {
+	Paths []PathMark
+}
+
+// PathMark represents a path painted on a page and the graphics state it was
+// painted with. All coordinates and dimensions are in device coordinates,
+// i.e. they are transformed by the current transformation matrix.
+type PathMark struct {
+	// Subpaths contains the subpaths of the path.
+	Subpaths []Subpath
+
+	// BBox is the bounding box of the control points of the path.
+	BBox model.PdfRectangle
+
+	// Stroked and Filled indicate whether the path is stroked and filled.
+	Stroked bool
+	Filled  bool
+
+	// FillRule is the fill rule of filled paths.
+	FillRule FillRule
+
+	// StrokeColor and FillColor are the stroke and fill colors of the path,
+	// in the colorspaces StrokeColorspace and FillColorspace.
+	StrokeColor      model.PdfColor
+	StrokeColorspace model.PdfColorspace
+	FillColor        model.PdfColor
+	FillColorspace   model.PdfColorspace
+
+	// LineWidth is the width of the stroke.
+	LineWidth float64
+
+	// LineCap and LineJoin are the line cap and line join styles, as the
+	// values of the PDF J and j operators.
+	LineCap  int
+	LineJoin int
+
+	// DashArray and DashPhase are the dash pattern of the stroke. DashArray
+	// is empty for solid lines.
+	DashArray []float64
+	DashPhase float64
+
+	// Clip indicates whether the path is used as a clipping path, with clip
+	// rule ClipRule, in addition to being painted.
+	Clip     bool
+	ClipRule FillRule
+
+	// Clipped indicates whether the path is painted inside a clipping path
+	// and ClipBox is the bounding box of the clipping region. ClipBox is the
+	// media box for paths that are not clipped.
+	Clipped bool
+	ClipBox model.PdfRectangle
+}
+
+// Subpath is a subpath of a PathMark. It is a connected sequence of straight
+// lines and cubic Bézier curves.
+type Subpath struct {
+	// Segments contains the segments of the subpath in order.
+	Segments []PathSegment
+
+	// Closed indicates whether the subpath was closed.
+	Closed bool
+}
+
+// PathSegment is a straight line or a cubic Bézier curve in a Subpath.
+// Straight lines go from P0 to P3 and have control points P1 = P0 and P2 = P3.
+type PathSegment struct {
+	draw.CubicBezierCurve
+
+	// Curve indicates whether the segment is a curve. Otherwise it is a
+	// straight line.
+	Curve bool
+}
+
+// Points returns the end points of the segments of `s`. For subpaths of
+// straight lines this is the polygon of the subpath.
+func (s Subpath) Points() draw.Path {
+	path := draw.NewPath()
+	for i, seg := range s.Segments {
+		if i == 0 {
+			path = path.AppendPoint(seg.P0)
+		}
+		path = path.AppendPoint(seg.P3)
+	}
+	return path
+}
+
+// BezierPath returns `s` as a path of cubic Bézier curves.
+func (s Subpath) BezierPath() draw.CubicBezierPath {
+	path := draw.NewCubicBezierPath()
+	for _, seg := range s.Segments {
+		path = path.AppendCurve(seg.CubicBezierCurve)
+	}
+	return path
+}
+
+// StrokeGoColor returns the stroke color of `p` as a Go color.
+func (p PathMark) StrokeGoColor() color.Color {
+	return pdfColorToGoColor(p.StrokeColorspace, p.StrokeColor)
+}
+
+// FillGoColor returns the fill color of `p` as a Go color.
+func (p PathMark) FillGoColor() color.Color {
+	return pdfColorToGoColor(p.FillColorspace, p.FillColor)
+}
+
+// graphicsExtractContext provides context for vector graphics extraction content stream
+// processing.
+type graphicsExtractContext struct {
+	paths   []PathMark
+	options *GraphicsExtractOptions
+}
+
+// pathGraphicsState is the part of the graphics state that is tracked for path extraction in
+// addition to contentstream.GraphicsState. The colors are tracked here rather than taken from
+// contentstream.GraphicsState because forms inherit the colors of the content stream that paints
+// them, while a contentstream.ContentStreamProcessor starts from the default colors.
+type pathGraphicsState struct {
+	lineWidth        float64
+	lineCap          int
+	lineJoin         int
+	dashArray        []float64
+	dashPhase        float64
+	clipped          bool
+	clipBox          model.PdfRectangle
+	strokeColor      model.PdfColor
+	strokeColorspace model.PdfColorspace
+	fillColor        model.PdfColor
+	fillColorspace   model.PdfColorspace
+}
+
+// extractContentStreamGraphics appends the paths painted by content stream `contents` with
+// resources `resources` to ctx.paths. `parentCTM` is the transformation matrix of the containing
+// content stream and `state` is the initial graphics state.
+func (ctx *graphicsExtractContext) extractContentStreamGraphics(contents string,
+	resources *model.PdfPageResources, parentCTM transform.Matrix, state pathGraphicsState,
+	level int) error {
+	if level > maxFormStack {
+		err := errors.New("form stack overflow")
+		common.Log.Debug("ERROR: extractContentStreamGraphics. recursion level=%d err=%v", level, err)
+		return err
+	}
+
+	cstreamParser := contentstream.NewContentStreamParser(contents)
+	operations, err := cstreamParser.Parse()
+	if err != nil {
+		return err
+	}
+
+	var stateStack []pathGraphicsState
+	var pb pathBuilder
+	var clip bool
+	var clipRule FillRule
+
+	// paint appends the current path to ctx.paths and starts a new path.
+	paint := func(gs contentstream.GraphicsState, stroked, filled bool, rule FillRule) {
+		path := PathMark{
+			Stroked:          stroked,
+			Filled:           filled,
+			FillRule:         rule,
+			StrokeColor:      state.strokeColor,
+			StrokeColorspace: state.strokeColorspace,
+			FillColor:        state.fillColor,
+			FillColorspace:   state.fillColorspace,
+			LineCap:          state.lineCap,
+			LineJoin:         state.lineJoin,
+			DashPhase:        state.dashPhase,
+			Clip:             clip,
+			ClipRule:         clipRule,
+			Clipped:          state.clipped,
+			ClipBox:          state.clipBox,
+		}
+		path.Subpaths = pb.path()
+
+		// Scale the stroke dimensions like the coordinates.
+		ctm := parentCTM.Mult(gs.CTM)
+		scale := math.Sqrt(math.Abs(ctm[0]*ctm[4] - ctm[1]*ctm[3]))
+		path.LineWidth = state.lineWidth * scale
+		path.DashPhase *= scale
+		for _, d := range state.dashArray {
+			path.DashArray = append(path.DashArray, d*scale)
+		}
+
+		if len(path.Subpaths) > 0 {
+			path.BBox = pathBBox(path.Subpaths)
+			if clip {
+				// The clipping path takes effect after the path is painted.
+				state.clipBox, _ = rectIntersection(state.clipBox, path.BBox)
+				state.clipped = true
+			}
+			if stroked || filled || (clip && ctx.options.IncludeClipPaths) {
+				ctx.paths = append(ctx.paths, path)
+			}
+		}
+		pb.reset()
+		clip = false
+	}
+
+	processor := contentstream.NewContentStreamProcessor(*operations)
+	processor.AddHandler(contentstream.HandlerConditionEnumAllOperands, "", skipOperatorErrors(
+		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState,
+			resources *model.PdfPageResources) error {
+			ctm := parentCTM.Mult(gs.CTM)
+			if ok, err := pb.handleOp(op, ctm); ok {
+				return err
+			}
+
+			switch op.Operand {
+			case "q":
+				stateStack = append(stateStack, state)
+			case "Q":
+				if len(stateStack) > 0 {
+					state = stateStack[len(stateStack)-1]
+					stateStack = stateStack[:len(stateStack)-1]
+				}
+			case "RG", "G", "K", "CS", "SC", "SCN":
+				state.strokeColor, state.strokeColorspace = gs.ColorStroking, gs.ColorspaceStroking
+			case "rg", "g", "k", "cs", "sc", "scn":
+				state.fillColor, state.fillColorspace = gs.ColorNonStroking, gs.ColorspaceNonStroking
+			case "w":
+				v, err := floatParam(op)
+				if err != nil {
+					return err
+				}
+				state.lineWidth = v
+			case "J":
+				v, err := floatParam(op)
+				if err != nil {
+					return err
+				}
+				state.lineCap = int(v)
+			case "j":
+				v, err := floatParam(op)
+				if err != nil {
+					return err
+				}
+				state.lineJoin = int(v)
+			case "d":
+				if len(op.Params) != 2 {
+					return core.ErrRangeError
+				}
+				return state.setDash(op.Params[0], op.Params[1])
+			case "gs":
+				if len(op.Params) != 1 {
+					return core.ErrRangeError
+				}
+				name, ok := core.GetName(op.Params[0])
+				if !ok {
+					return core.ErrTypeError
+				}
+				obj, ok := resources.GetExtGState(*name)
+				if !ok {
+					common.Log.Debug("ERROR: ExtGState %s not found", *name)
+					return nil
+				}
+				return state.setExtGState(obj)
+			case "W":
+				clip, clipRule = true, FillRuleWinding
+			case "W*":
+				clip, clipRule = true, FillRuleEvenOdd
+			case "S":
+				paint(gs, true, false, FillRuleWinding)
+			case "s":
+				pb.closePath()
+				paint(gs, true, false, FillRuleWinding)
+			case "f", "F":
+				paint(gs, false, true, FillRuleWinding)
+			case "f*":
+				paint(gs, false, true, FillRuleEvenOdd)
+			case "B":
+				paint(gs, true, true, FillRuleWinding)
+			case "B*":
+				paint(gs, true, true, FillRuleEvenOdd)
+			case "b":
+				pb.closePath()
+				paint(gs, true, true, FillRuleWinding)
+			case "b*":
+				pb.closePath()
+				paint(gs, true, true, FillRuleEvenOdd)
+			case "n":
+				paint(gs, false, false, FillRuleWinding)
+			case "Do":
+				if len(op.Params) != 1 {
+					return core.ErrRangeError
+				}
+				name, ok := core.GetName(op.Params[0])
+				if !ok {
+					return errTypeCheck
+				}
+				if _, xtype := resources.GetXObjectByName(*name); xtype != model.XObjectTypeForm {
+					return nil
+				}
+				return ctx.extractFormGraphics(name, resources, ctm, state, level)
+			}
+			return nil
+		}))
+
+	return processor.Process(resources)
+}
+
+// skipOperatorErrors returns a handler calling `handler` that logs the errors of `handler` instead
+// of returning them, so that malformed operators and forms are skipped rather than aborting the
+// extraction, as in text extraction.
+func skipOperatorErrors(handler contentstream.HandlerFunc) contentstream.HandlerFunc {
+	return func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState,
+		resources *model.PdfPageResources) error {
+		if err := handler(op, gs, resources); err != nil {
+			common.Log.Debug("ERROR: skipping op=%s err=%v", op, err)
+		}
+		return nil
+	}
+}
+
+// extractFormGraphics appends the paths painted by form XObject `name` in `resources` to
+// ctx.paths. `ctm` is the current transformation matrix and `state` the graphics state at the
+// point the form is painted.
+func (ctx *graphicsExtractContext) extractFormGraphics(name *core.PdfObjectName,
+	resources *model.PdfPageResources, ctm transform.Matrix, state pathGraphicsState,
+	level int) error {
+	xform, err := resources.GetXObjectFormByName(*name)
+	if err != nil {
+		return err
+	}
+	if xform == nil {
+		return nil
+	}
+	formContent, err := xform.GetContentStream()
+	if err != nil {
+		return err
+	}
+	formResources := xform.Resources
+	if formResources == nil {
+		formResources = resources
+	}
+
+	if array, ok := core.GetArray(xform.Matrix); ok {
+		m, err := core.GetNumbersAsFloat(array.Elements())
+		if err != nil {
+			return err
+		}
+		if len(m) != 6 {
+			return core.ErrRangeError
+		}
+		ctm = ctm.Mult(transform.NewMatrix(m[0], m[1], m[2], m[3], m[4], m[5]))
+	}
+
+	// Forms are clipped by their bounding boxes.
+	if array, ok := core.GetArray(xform.BBox); ok {
+		b, err := core.GetNumbersAsFloat(array.Elements())
+		if err != nil {
+			return err
+		}
+		if len(b) != 4 {
+			return core.ErrRangeError
+		}
+		var corners []draw.Point
+		for _, c := range [][2]float64{{b[0], b[1]}, {b[2], b[1]}, {b[2], b[3]}, {b[0], b[3]}} {
+			x, y := ctm.Transform(c[0], c[1])
+			corners = append(corners, draw.NewPoint(x, y))
+		}
+		state.clipBox, _ = rectIntersection(state.clipBox, pointsBBox(corners))
+		state.clipped = true
+	}
+
+	return ctx.extractContentStreamGraphics(string(formContent), formResources, ctm, state, level+1)
+}
+
+// setDash sets the dash pattern of `state` to dash array `array` and dash phase `phase`.
+func (state *pathGraphicsState) setDash(array, phase core.PdfObject) error {
+	arr, ok := core.GetArray(array)
+	if !ok {
+		return core.ErrTypeError
+	}
+	dashArray, err := core.GetNumbersAsFloat(arr.Elements())
+	if err != nil {
+		return err
+	}
+	dashPhase, err := core.GetNumberAsFloat(phase)
+	if err != nil {
+		return err
+	}
+	state.dashArray, state.dashPhase = dashArray, dashPhase
+	return nil
+}
+
+// setExtGState sets the line style parameters of `state` from ExtGState dictionary `obj`.
+func (state *pathGraphicsState) setExtGState(obj core.PdfObject) error {
+	dict, ok := core.GetDict(obj)
+	if !ok {
+		return core.ErrTypeError
+	}
+	if v, err := core.GetNumberAsFloat(dict.Get("LW")); err == nil {
+		state.lineWidth = v
+	}
+	if v, ok := core.GetIntVal(dict.Get("LC")); ok {
+		state.lineCap = v
+	}
+	if v, ok := core.GetIntVal(dict.Get("LJ")); ok {
+		state.lineJoin = v
+	}
+	if arr, ok := core.GetArray(dict.Get("D")); ok && arr.Len() == 2 {
+		return state.setDash(arr.Get(0), arr.Get(1))
+	}
+	return nil
+}
+
+// pathBuilder constructs the subpaths of a path from the path construction operators of a
+// content stream. It is used for both vector graphics extraction and the rulings of table
+// detection.
+type pathBuilder struct {
+	subpaths []Subpath
+	current  draw.Point
+}
+
+// handleOp updates `pb` for path construction operator `op`, which is drawn with current
+// transformation matrix `ctm`, and returns true. It returns false if `op` is not a path
+// construction operator.
+func (pb *pathBuilder) handleOp(op *contentstream.ContentStreamOperation,
+	ctm transform.Matrix) (bool, error) {
+	// point returns the point at `op`.Params[i:i+2] in device coordinates.
+	point := func(i int) (draw.Point, error) {
+		if len(op.Params) < i+2 {
+			return draw.Point{}, core.ErrRangeError
+		}
+		xy, err := core.GetNumbersAsFloat(op.Params[i : i+2])
+		if err != nil {
+			return draw.Point{}, err
+		}
+		x, y := ctm.Transform(xy[0], xy[1])
+		return draw.NewPoint(x, y), nil
+	}
+
+	switch op.Operand {
+	case "m":
+		p, err := point(0)
+		if err != nil {
+			return true, err
+		}
+		pb.moveTo(p)
+	case "l":
+		p, err := point(0)
+		if err != nil {
+			return true, err
+		}
+		pb.lineTo(p)
+	case "c", "v", "y":
+		var points [3]draw.Point
+		n := 3
+		if op.Operand != "c" {
+			n = 2
+		}
+		for i := 0; i < n; i++ {
+			p, err := point(2 * i)
+			if err != nil {
+				return true, err
+			}
+			points[i] = p
+		}
+		curve := draw.CubicBezierCurve{P0: pb.current}
+		switch op.Operand {
+		case "c":
+			curve.P1, curve.P2, curve.P3 = points[0], points[1], points[2]
+		case "v":
+			curve.P1, curve.P2, curve.P3 = pb.current, points[0], points[1]
+		case "y":
+			curve.P1, curve.P2, curve.P3 = points[0], points[1], points[1]
+		}
+		pb.addSegment(PathSegment{CubicBezierCurve: curve, Curve: true})
+	case "h":
+		pb.closePath()
+	case "re":
+		if len(op.Params) != 4 {
+			return true, core.ErrRangeError
+		}
+		r, err := core.GetNumbersAsFloat(op.Params)
+		if err != nil {
+			return true, err
+		}
+		x, y, w, h := r[0], r[1], r[2], r[3]
+		for i, c := range [][2]float64{{x, y}, {x + w, y}, {x + w, y + h}, {x, y + h}} {
+			px, py := ctm.Transform(c[0], c[1])
+			if i == 0 {
+				pb.moveTo(draw.NewPoint(px, py))
+			} else {
+				pb.lineTo(draw.NewPoint(px, py))
+			}
+		}
+		pb.closePath()
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// moveTo starts a new subpath at `p`.
+func (pb *pathBuilder) moveTo(p draw.Point) {
+	pb.subpaths = append(pb.subpaths, Subpath{})
+	pb.current = p
+}
+
+// lineTo appends a straight line segment from the current point to `p`.
+func (pb *pathBuilder) lineTo(p draw.Point) {
+	pb.addSegment(PathSegment{CubicBezierCurve: draw.CubicBezierCurve{
+		P0: pb.current, P1: pb.current, P2: p, P3: p}})
+}
+
+// addSegment appends `seg`, which starts at the current point, to the last subpath, starting a
+// new subpath if the last one is closed. If there is no current point, a new subpath is started
+// at the end of `seg` instead.
+func (pb *pathBuilder) addSegment(seg PathSegment) {
+	if len(pb.subpaths) == 0 {
+		pb.moveTo(seg.P3)
+		return
+	}
+	if pb.subpaths[len(pb.subpaths)-1].Closed {
+		pb.subpaths = append(pb.subpaths, Subpath{})
+	}
+	sp := &pb.subpaths[len(pb.subpaths)-1]
+	sp.Segments = append(sp.Segments, seg)
+	pb.current = seg.P3
+}
+
+// closePath closes the current subpath with a straight line segment to its start.
+func (pb *pathBuilder) closePath() {
+	if len(pb.subpaths) == 0 {
+		return
+	}
+	sp := &pb.subpaths[len(pb.subpaths)-1]
+	if len(sp.Segments) == 0 {
+		return
+	}
+	start := sp.Segments[0].P0
+	if pb.current != start {
+		pb.lineTo(start)
+	}
+	sp.Closed = true
+}
+
+// path returns the non-empty subpaths of the path.
+func (pb *pathBuilder) path() []Subpath {
+	var subpaths []Subpath
+	for _, sp := range pb.subpaths {
+		if len(sp.Segments) > 0 {
+			subpaths = append(subpaths, sp)
+		}
+	}
+	return subpaths
+}
+
+// reset starts a new path.
+func (pb *pathBuilder) reset() {
+	pb.subpaths = nil
+}
+
+// pathBBox returns the bounding box of the control points of `subpaths`.
+func pathBBox(subpaths []Subpath) model.PdfRectangle {
+	var points []draw.Point
+	for _, sp := range subpaths {
+		for _, seg := range sp.Segments {
+			points = append(points, seg.P0, seg.P1, seg.P2, seg.P3)
+		}
+	}
+	return pointsBBox(points)
+}
+
+// pointsBBox returns the bounding box of `points`, which must not be empty.
+func pointsBBox(points []draw.Point) model.PdfRectangle {
+	bbox := model.PdfRectangle{Llx: points[0].X, Lly: points[0].Y, Urx: points[0].X, Ury: points[0].Y}
+	for _, p := range points[1:] {
+		bbox.Llx = math.Min(bbox.Llx, p.X)
+		bbox.Lly = math.Min(bbox.Lly, p.Y)
+		bbox.Urx = math.Max(bbox.Urx, p.X)
+		bbox.Ury = math.Max(bbox.Ury, p.Y)
+	}
+	return bbox
+}
@@ -0,0 +1,153 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/contentstream/draw"
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+)
+
+func TestExtractPageGraphics(t *testing.T) {
+	resources := model.NewPdfPageResources()
+
+	// A form with a circular arc, drawn with a scaled form matrix and clipped by its bounding box.
+	xform := model.NewXObjectForm()
+	xform.BBox = core.MakeArrayFromFloats([]float64{0, 0, 10, 10})
+	xform.Matrix = core.MakeArrayFromFloats([]float64{2, 0, 0, 2, 0, 0})
+	require.NoError(t, xform.SetContentStream([]byte("0 0 m 5 0 10 5 10 10 c S"), nil))
+	require.NoError(t, resources.SetXObjectFormByName("Fm1", xform))
+
+	e := Extractor{resources: resources, mediaBox: r(0, 0, 600, 800), contents: `
+		q 2 w [3 1] 0 d 1 0 0 RG
+		100 100 m 200 100 l S
+		Q
+		0 0 1 rg 10 10 50 20 re f*
+		q 350 350 100 100 re W n
+		1 0 0 1 400 400 cm /Fm1 Do
+		Q
+		300 300 m 310 300 l 310 310 l h 320 320 l b
+	`}
+	graphics, err := e.ExtractPageGraphics(nil)
+	require.NoError(t, err)
+	paths := graphics.Paths
+	require.Len(t, paths, 4)
+
+	// Dashed red line.
+	line := paths[0]
+	require.True(t, line.Stroked)
+	require.False(t, line.Filled)
+	require.Equal(t, 2.0, line.LineWidth)
+	require.Equal(t, []float64{3, 1}, line.DashArray)
+	require.Equal(t, color.NRGBA{255, 0, 0, 255}, line.StrokeGoColor())
+	require.Len(t, line.Subpaths, 1)
+	require.Len(t, line.Subpaths[0].Segments, 1)
+	require.False(t, line.Subpaths[0].Segments[0].Curve)
+	require.Equal(t, []draw.Point{{X: 100, Y: 100}, {X: 200, Y: 100}}, line.Subpaths[0].Points().Points)
+	require.False(t, line.Clipped)
+	require.Equal(t, r(0, 0, 600, 800), line.ClipBox)
+
+	// Filled blue rectangle. The line width and dash pattern were restored by Q.
+	rect := paths[1]
+	require.False(t, rect.Stroked)
+	require.True(t, rect.Filled)
+	require.Equal(t, FillRuleEvenOdd, rect.FillRule)
+	require.Equal(t, color.NRGBA{0, 0, 255, 255}, rect.FillGoColor())
+	require.Equal(t, 1.0, rect.LineWidth)
+	require.Empty(t, rect.DashArray)
+	require.True(t, rect.Subpaths[0].Closed)
+	require.Len(t, rect.Subpaths[0].Points().Points, 5)
+	require.Equal(t, r(10, 10, 60, 30), rect.BBox)
+
+	// The curve in the form is transformed by the CTM and the form matrix and clipped by the
+	// clipping path and the form bounding box.
+	curve := paths[2]
+	require.Len(t, curve.Subpaths[0].Segments, 1)
+	segment := curve.Subpaths[0].Segments[0]
+	require.True(t, segment.Curve)
+	require.Equal(t, draw.NewPoint(400, 400), segment.P0)
+	require.Equal(t, draw.NewPoint(410, 400), segment.P1)
+	require.Equal(t, draw.NewPoint(420, 410), segment.P2)
+	require.Equal(t, draw.NewPoint(420, 420), segment.P3)
+	require.Equal(t, 2.0, curve.LineWidth)
+	require.True(t, curve.Clipped)
+	require.Equal(t, r(400, 400, 420, 420), curve.ClipBox)
+
+	// A closed triangle followed by an open subpath that is closed by b.
+	triangle := paths[3]
+	require.True(t, triangle.Stroked)
+	require.True(t, triangle.Filled)
+	require.False(t, triangle.Clipped)
+	require.Len(t, triangle.Subpaths, 2)
+	require.True(t, triangle.Subpaths[0].Closed)
+	require.Len(t, triangle.Subpaths[0].Segments, 3)
+	require.Equal(t, []draw.Point{{X: 300, Y: 300}, {X: 320, Y: 320}, {X: 300, Y: 300}},
+		triangle.Subpaths[1].Points().Points)
+
+	// Clipping paths.
+	graphics, err = e.ExtractPageGraphics(&GraphicsExtractOptions{IncludeClipPaths: true})
+	require.NoError(t, err)
+	require.Len(t, graphics.Paths, 5)
+	clip := graphics.Paths[2]
+	require.True(t, clip.Clip)
+	require.False(t, clip.Stroked || clip.Filled)
+	require.False(t, clip.Clipped)
+	require.Equal(t, r(350, 350, 450, 450), clip.BBox)
+}
+
+func TestExtractPageGraphicsMalformed(t *testing.T) {
+	// A form with an invalid matrix.
+	resources := model.NewPdfPageResources()
+	xform := model.NewXObjectForm()
+	xform.Matrix = core.MakeArrayFromFloats([]float64{1, 0, 0, 1})
+	require.NoError(t, xform.SetContentStream([]byte("0 0 m 10 10 l S"), nil))
+	require.NoError(t, resources.SetXObjectFormByName("Fm1", xform))
+
+	// The malformed operators and forms are skipped.
+	e := Extractor{resources: resources, mediaBox: r(0, 0, 600, 800), contents: `
+		3 w (wide) w /round J (x) j [1 (2)] 0 d (GS1) gs /Fm1 Do
+		10 10 m (x) 20 m 20 10 l 30 l 1 2 3 c 1 2 re S
+	`}
+	graphics, err := e.ExtractPageGraphics(nil)
+	require.NoError(t, err)
+	require.Len(t, graphics.Paths, 1)
+
+	path := graphics.Paths[0]
+	require.Equal(t, 3.0, path.LineWidth)
+	require.Empty(t, path.DashArray)
+	require.Len(t, path.Subpaths, 1)
+	require.Equal(t, []draw.Point{{X: 10, Y: 10}, {X: 20, Y: 10}}, path.Subpaths[0].Points().Points)
+}
+
+func TestExtractPageGraphicsFormColors(t *testing.T) {
+	// A form that strokes a line without setting a color and then fills a rectangle in its own
+	// color.
+	resources := model.NewPdfPageResources()
+	xform := model.NewXObjectForm()
+	require.NoError(t, xform.SetContentStream([]byte("0 0 m 100 0 l S 0 1 0 rg 0 10 50 5 re f"), nil))
+	require.NoError(t, resources.SetXObjectFormByName("Fm1", xform))
+
+	// The form inherits the colors of the content stream that paints it. Colors set in the form
+	// do not change the colors of the page.
+	e := Extractor{resources: resources, mediaBox: r(0, 0, 600, 800), contents: `
+		q 1 0 0 RG 0 0 1 rg /Fm1 Do 10 10 20 20 re B Q
+		0 0 m 10 0 l S
+	`}
+	graphics, err := e.ExtractPageGraphics(nil)
+	require.NoError(t, err)
+	paths := graphics.Paths
+	require.Len(t, paths, 4)
+	require.Equal(t, color.NRGBA{255, 0, 0, 255}, paths[0].StrokeGoColor())
+	require.Equal(t, color.NRGBA{0, 255, 0, 255}, paths[1].FillGoColor())
+	require.Equal(t, color.NRGBA{255, 0, 0, 255}, paths[2].StrokeGoColor())
+	require.Equal(t, color.NRGBA{0, 0, 255, 255}, paths[2].FillGoColor())
+	require.Equal(t, color.NRGBA{0, 0, 0, 255}, paths[3].StrokeGoColor())
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/showntop/unipdf/common"
 	"github.com/showntop/unipdf/contentstream"
 	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/internal/cmap"
 	"github.com/showntop/unipdf/internal/textencoding"
 	"github.com/showntop/unipdf/internal/transform"
 	"github.com/showntop/unipdf/model"
@@ -37,7 +38,8 @@ func (e *Extractor) ExtractText() (string, error) {
 }
 
 // ExtractTextWithStats works like ExtractText but returns the number of characters in the output
-// (`numChars`) and the number of characters that were not decoded (`numMisses`).
+// (`numChars`) and the number of characters that were not decoded (`numMisses`). The characters
+// removed by the region and mark filters of the TextExtractOptions are not counted.
 func (e *Extractor) ExtractTextWithStats() (extracted string, numChars int, numMisses int, err error) {
 	pageText, numChars, numMisses, err := e.ExtractPageText()
 	if err != nil {
@@ -55,6 +57,12 @@ func (e *Extractor) ExtractPageText() (*PageText, int, int, error) {
 	if err != nil {
 		return nil, numChars, numMisses, err
 	}
+	pt.marks = e.structure.apply(pt.marks)
+	marks, removedChars, removedMisses := e.textOptions.filterMarks(pt.marks)
+	pt.marks = marks
+	numChars -= removedChars
+	numMisses -= removedMisses
+	pt.structure = e.structure
 	pt.computeViews()
 
 	return pt, numChars, numMisses, err
@@ -70,8 +78,10 @@ func (e *Extractor) extractPageText(contents string, resources *model.PdfPageRes
 	pageText := &PageText{pageSize: e.mediaBox}
 	state := newTextState(e.mediaBox)
 	var savedStates stateStack
-	to := newTextObject(e, resources, contentstream.GraphicsState{}, &state, &savedStates)
+	var marked markedContentStack
+	to := newTextObject(e, resources, contentstream.GraphicsState{}, &state, &savedStates, &marked)
 	var inTextObj bool
+	var paths pathRulings
 
 	if level > maxFormStack {
 		err := errors.New("form stack overflow")
@@ -126,7 +136,7 @@ func (e *Extractor) extractPageText(contents string, resources *model.PdfPageRes
 
 				graphicsState := gs
 				graphicsState.CTM = parentCTM.Mult(graphicsState.CTM)
-				to = newTextObject(e, resources, graphicsState, &state, &savedStates)
+				to = newTextObject(e, resources, graphicsState, &state, &savedStates, &marked)
 			case "ET": // End Text
 				// End text object, discarding text matrix. If the current
 				// text object contains text marks, they are added to the
@@ -319,12 +329,13 @@ func (e *Extractor) extractPageText(contents string, resources *model.PdfPageRes
 					return core.ErrTypeError
 				}
 
-				_, xtype := resources.GetXObjectByName(*name)
+				xobj, xtype := resources.GetXObjectByName(*name)
 				if xtype != model.XObjectTypeForm {
 					break
 				}
 				// Only process each form once.
-				formResult, ok := e.formResults[name.String()]
+				formCTM := parentCTM.Mult(gs.CTM)
+				formResult, ok := e.getFormResult(name.String(), xobj, formCTM)
 				if !ok {
 					xform, err := resources.GetXObjectFormByName(*name)
 					if err != nil {
@@ -342,16 +353,19 @@ func (e *Extractor) extractPageText(contents string, resources *model.PdfPageRes
 					}
 
 					tList, numChars, numMisses, err := e.extractPageText(string(formContent),
-						formResources, parentCTM.Mult(gs.CTM), level+1)
+						formResources, formCTM, level+1)
 					if err != nil {
 						common.Log.Debug("ERROR: %v", err)
 						return err
 					}
 					formResult = textResult{*tList, numChars, numMisses}
-					e.formResults[name.String()] = formResult
+					e.setFormResult(name.String(), xobj, formCTM, formResult)
 				}
 
-				pageText.marks = append(pageText.marks, formResult.pageText.marks...)
+				formObj, _ := objectNumber(xobj)
+				pageText.marks = append(pageText.marks,
+					marked.labelForm(formResult.pageText.marks, formObj)...)
+				pageText.rulings = append(pageText.rulings, formResult.pageText.rulings...)
 				state.numChars += formResult.numChars
 				state.numMisses += formResult.numMisses
 			case "rg", "g", "k", "cs", "sc", "scn":
@@ -362,6 +376,12 @@ func (e *Extractor) extractPageText(contents string, resources *model.PdfPageRes
 				// Set stroking color/colorspace.
 				to.gs.ColorspaceStroking = gs.ColorspaceStroking
 				to.gs.ColorStroking = gs.ColorStroking
+			case "m", "l", "c", "v", "y", "h", "re", "S", "s", "f", "F", "f*", "B", "B*", "b", "b*", "n":
+				// Construct and paint paths. Horizontal and vertical lines are used to find tables.
+				paths.handleOp(op, parentCTM.Mult(gs.CTM))
+			case "BMC", "BDC", "EMC":
+				// Marked content carries the MCIDs, languages and replacement text of the text.
+				marked.handleOp(op, resources)
 			}
 			return nil
 		})
@@ -370,6 +390,8 @@ func (e *Extractor) extractPageText(contents string, resources *model.PdfPageRes
 	if err != nil {
 		common.Log.Debug("ERROR: Processing: err=%v", err)
 	}
+	pageText.rulings = append(pageText.rulings, paths.rulings...)
+	pageText.marks = substituteActualText(pageText.marks)
 	return pageText, state.numChars, state.numMisses, err
 }
 
@@ -680,10 +702,11 @@ type textObject struct {
 	gs          contentstream.GraphicsState
 	state       *textState
 	savedStates *stateStack
-	tm          transform.Matrix // Text matrix. For the character pointer.
-	tlm         transform.Matrix // Text line matrix. For the start of line pointer.
-	marks       []*textMark      // Text marks get written here.
-	invalidFont bool             // Flag that gets set true when we can't handle the current font.
+	tm          transform.Matrix    // Text matrix. For the character pointer.
+	tlm         transform.Matrix    // Text line matrix. For the start of line pointer.
+	marks       []*textMark         // Text marks get written here.
+	marked      *markedContentStack // The marked-content sequences enclosing the text.
+	invalidFont bool                // Flag that gets set true when we can't handle the current font.
 }
 
 // newTextState returns a default textState.
@@ -697,13 +720,14 @@ func newTextState(mediaBox model.PdfRectangle) textState {
 
 // newTextObject returns a default textObject.
 func newTextObject(e *Extractor, resources *model.PdfPageResources, gs contentstream.GraphicsState,
-	state *textState, savedStates *stateStack) *textObject {
+	state *textState, savedStates *stateStack, marked *markedContentStack) *textObject {
 	return &textObject{
 		e:           e,
 		resources:   resources,
 		gs:          gs,
 		savedStates: savedStates,
 		state:       state,
+		marked:      marked,
 		tm:          transform.IdentityMatrix(),
 		tlm:         transform.IdentityMatrix(),
 	}
@@ -838,6 +862,10 @@ func (to *textObject) renderText(data []byte) error {
 			common.Log.Debug("Text mark outside page. Skipping")
 			continue
 		}
+		mark.numChars = 1
+		if text == cmap.MissingCodeString {
+			mark.numMisses = 1
+		}
 		if font == nil {
 			common.Log.Debug("ERROR: No font.")
 		} else if font.Encoder() == nil {
@@ -849,6 +877,7 @@ func (to *textObject) renderText(data []byte) error {
 				mark.original = string(original)
 			}
 		}
+		to.marked.label(&mark)
 		common.Log.Trace("i=%d code=%d mark=%s trm=%s", i, code, mark, trm)
 		to.marks = append(to.marks, &mark)
 
@@ -888,7 +917,10 @@ type PageText struct {
 	viewText   string             // Extracted page text.
 	viewMarks  []TextMark         // Public view of text marks.
 	viewTables []TextTable        // Public view of text tables.
+	paras      paraList           // Paragraphs in reading order. Used to build the layout.
+	rulings    []ruling           // Ruling lines on the page. Used to find tables.
 	pageSize   model.PdfRectangle // Page size. Used to calculate depth.
+	structure  *pageStructure     // Logical structure. Used to order the text if not nil.
 }
 
 // String returns a string describing `pt`.
@@ -929,30 +961,50 @@ func (pt PageText) Tables() []TextTable {
 // The comments above the TextMark definition describe how to use the []TextMark to
 // maps substrings of the page text to locations on the PDF page.
 func (pt *PageText) computeViews() {
+	var paras paraList
+	if pt.structure != nil {
+		// Lay out each block of the logical structure separately, in structure order.
+		for _, marks := range pt.structure.blocks(pt.marks) {
+			paras = append(paras, pt.makeParas(marks)...)
+		}
+	} else {
+		paras = pt.makeParas(pt.marks)
+	}
+	// Build the public viewable fields from the paraLis
+	b := new(bytes.Buffer)
+	paras.writeText(b)
+	pt.viewText = b.String()
+	pt.viewMarks = paras.toTextMarks()
+	pt.viewTables = paras.tables()
+	pt.paras = paras
+}
+
+// makeParas returns the paragraphs of the text of `marks` on page `pt`.
+func (pt *PageText) makeParas(marks []*textMark) paraList {
 	// Extract text paragraphs one orientation at a time.
 	// If there are texts with several orientations on a page then the all the text of the same
 	// orientation gets extracted togther.
 	var paras paraList
-	n := len(pt.marks)
+	n := len(marks)
 	for orient := 0; orient < 360 && n > 0; orient += 90 {
-		marks := make([]*textMark, 0, len(pt.marks)-n)
-		for _, tm := range pt.marks {
+		orientMarks := make([]*textMark, 0, len(marks)-n)
+		for _, tm := range marks {
 			if tm.orient == orient {
-				marks = append(marks, tm)
+				orientMarks = append(orientMarks, tm)
 			}
 		}
-		if len(marks) > 0 {
-			parasOrient := makeTextPage(marks, pt.pageSize)
+		if len(orientMarks) > 0 {
+			// Ruling lines are only used to find tables in unrotated text.
+			var rulings []ruling
+			if orient == 0 {
+				rulings = pt.rulings
+			}
+			parasOrient := makeTextPage(orientMarks, pt.pageSize, rulings)
 			paras = append(paras, parasOrient...)
-			n -= len(marks)
+			n -= len(orientMarks)
 		}
 	}
-	// Build the public viewable fields from the paraLis
-	b := new(bytes.Buffer)
-	paras.writeText(b)
-	pt.viewText = b.String()
-	pt.viewMarks = paras.toTextMarks()
-	pt.viewTables = paras.tables()
+	return paras
 }
 
 // TextMarkArray is a collection of TextMarks.
@@ -1097,6 +1149,19 @@ type TextMark struct {
 	// StrokeColor is the stroke color of the text.
 	// The color is nil for spaces and line breaks (i.e. the Meta field is true).
 	StrokeColor color.Color
+	// Orientation is the orientation of the text in degrees. It is a multiple of 90.
+	Orientation int
+	// MCID is the marked-content identifier of the marked-content sequence containing the text.
+	// It links the text to an element of the structure tree of the document. It is -1 if the
+	// text is not in a marked-content sequence with an identifier. The text of form XObjects has
+	// the identifier of the page sequence containing the form, if any.
+	MCID int
+	// Lang is the language of the text, from the Lang entry of the marked-content sequence or
+	// structure element containing it.
+	Lang string
+	// Alt is the alternate description of the text, from the Alt entry of the marked-content
+	// sequence or structure element containing it.
+	Alt string
 }
 
 // String returns a string describing `tm`.
@@ -1124,6 +1189,7 @@ var spaceMark = TextMark{
 	Meta:        true,
 	FillColor:   color.White,
 	StrokeColor: color.White,
+	MCID:        -1,
 }
 
 // TextTable represents a table.
@@ -1136,11 +1202,18 @@ type TextTable struct {
 }
 
 // TableCell is a cell in a TextTable.
+// Cells of tables found from ruling lines may span several rows or columns. The cells covered by
+// the span of another cell are empty and have ColSpan and RowSpan 0.
 type TableCell struct {
 	// Text is the extracted text.
 	Text string
 	// Marks returns the TextMarks corresponding to the text in Text.
 	Marks TextMarkArray
+	// BBox is the bounding box of the cell. It is the rectangle delimited by the ruling lines for
+	// tables found from ruling lines and the bounding box of the cell text otherwise.
+	BBox model.PdfRectangle
+	// ColSpan and RowSpan are the numbers of columns and rows spanned by the cell.
+	ColSpan, RowSpan int
 }
 
 // getCurrentFont returns the font on top of the font stack, or DefaultFont if the font stack is
@@ -1160,6 +1233,11 @@ func (to *textObject) getCurrentFont() *model.PdfFont {
 // getFont returns the font named `name` if it exists in the page's resources or an error if it
 // doesn't. It caches the returned fonts.
 func (to *textObject) getFont(name string) (*model.PdfFont, error) {
+	if to.e.docFonts != nil {
+		if font, ok, err := to.getDocFont(name); ok || err != nil {
+			return font, err
+		}
+	}
 	if to.e.fontCache != nil {
 		to.e.accessCount++
 		entry, ok := to.e.fontCache[name]
@@ -1195,6 +1273,33 @@ func (to *textObject) getFont(name string) (*model.PdfFont, error) {
 	return font, nil
 }
 
+// getDocFont returns the font named `name` from the document font cache, which is keyed by the
+// object numbers of the fonts. It loads the font if it is not in the cache and adds it to the
+// cache if the cache is not full. The bool return is false if the font isn't an indirect object
+// and so can't be cached.
+func (to *textObject) getDocFont(name string) (*model.PdfFont, bool, error) {
+	fontObj, err := to.getFontDict(name)
+	if err != nil {
+		return nil, false, err
+	}
+	objNum, ok := objectNumber(fontObj)
+	if !ok {
+		return nil, false, nil
+	}
+	if font, ok := to.e.docFonts[objNum]; ok {
+		return font, true, nil
+	}
+	font, err := model.NewPdfFontFromPdfObject(fontObj)
+	if err != nil {
+		common.Log.Debug("getDocFont: NewPdfFontFromPdfObject failed. name=%#q err=%v", name, err)
+		return nil, false, err
+	}
+	if len(to.e.docFonts) < maxDocFonts {
+		to.e.docFonts[objNum] = font
+	}
+	return font, true, nil
+}
+
 // fontEntry is a entry in the font cache.
 type fontEntry struct {
 	font   *model.PdfFont // The font being cached.
@@ -86,3 +86,17 @@ const (
 	// Minimum number of cells in a textTable
 	minTableParas = 6
 )
+
+// The following constants control the detection of tables from ruling lines. They are distances in
+// page (default user space) coordinates, not fractions of the font size.
+const (
+	// Maximum deviation of rulings from the horizontal or vertical. Also the maximum distance between
+	// ruling ends that are joined.
+	rulingTol = 2.0
+
+	// Minimum length of rulings.
+	minRulingLength = 4.0
+
+	// Maximum thickness of filled rectangles that are rulings.
+	maxRulingThickness = 3.0
+)
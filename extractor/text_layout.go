@@ -0,0 +1,358 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/showntop/unipdf/model"
+)
+
+// Layout block types.
+const (
+	LayoutBlockParagraph = "paragraph"
+	LayoutBlockTable     = "table"
+)
+
+// PageLayout represents the layout of the text on a page, as a hierarchy of
+// blocks, lines, words and marks in reading order. It is the public view of
+// the paragraphs, lines and words used for extracting the page text, and can
+// be serialized to JSON, hOCR and ALTO.
+//
+// All the bounding boxes are in the unrotated page coordinates used by the
+// bounding boxes of TextMarks, regardless of the orientation of the text.
+// The style of each level (font, size and color) is the style of most of
+// its text.
+type PageLayout struct {
+	// PageBox is the media box of the page.
+	PageBox model.PdfRectangle `json:"page_box"`
+
+	// Blocks contains the paragraphs and tables of the page, in reading order.
+	Blocks []LayoutBlock `json:"blocks"`
+}
+
+// LayoutStyle represents the style of a text element of a page layout.
+type LayoutStyle struct {
+	// Font is the base font name of the font the text was drawn with.
+	Font string `json:"font,omitempty"`
+
+	// FontSize is the font size the text was drawn with.
+	FontSize float64 `json:"font_size"`
+
+	// Color is the fill color of the text, as a hex RGB string (e.g. #ff0000).
+	Color string `json:"color,omitempty"`
+}
+
+// LayoutBlock represents a paragraph or a table of a page layout.
+type LayoutBlock struct {
+	LayoutStyle
+
+	// Type is the type of the block: LayoutBlockParagraph or LayoutBlockTable.
+	Type string `json:"type"`
+
+	// BBox is the bounding box of the block.
+	BBox model.PdfRectangle `json:"bbox"`
+
+	// Orientation is the orientation of the text of the block, in degrees.
+	// It is a multiple of 90.
+	Orientation int `json:"orientation"`
+
+	// Text is the extracted text of the block.
+	Text string `json:"text"`
+
+	// Lines contains the lines of paragraph blocks, in reading order.
+	Lines []LayoutLine `json:"lines,omitempty"`
+
+	// Table contains the cells of table blocks.
+	Table *LayoutTable `json:"table,omitempty"`
+}
+
+// LayoutTable represents the cells of a table block. Cells[y][x] is the
+// (0-offset) x'th column of the y'th row.
+type LayoutTable struct {
+	W int `json:"w"`
+	H int `json:"h"`
+
+	Cells [][]LayoutCell `json:"cells"`
+}
+
+// LayoutCell represents a cell of a table block.
+type LayoutCell struct {
+	LayoutStyle
+
+	// BBox is the bounding box of the cell. For tables found from ruling
+	// lines it is the rectangle delimited by the lines.
+	BBox model.PdfRectangle `json:"bbox"`
+
+	// ColSpan and RowSpan are the numbers of columns and rows spanned by the
+	// cell. They are 0 for cells covered by the spans of other cells.
+	ColSpan int `json:"col_span"`
+	RowSpan int `json:"row_span"`
+
+	// Text is the extracted text of the cell.
+	Text string `json:"text"`
+
+	// Lines contains the lines of the cell, in reading order.
+	Lines []LayoutLine `json:"lines"`
+}
+
+// LayoutLine represents a line of text of a page layout.
+type LayoutLine struct {
+	LayoutStyle
+
+	// BBox is the bounding box of the line.
+	BBox model.PdfRectangle `json:"bbox"`
+
+	// Text is the text of the line. The words of the line are separated by
+	// spaces.
+	Text string `json:"text"`
+
+	// Words contains the words of the line, in reading order.
+	Words []LayoutWord `json:"words"`
+}
+
+// LayoutWord represents a word of a page layout.
+type LayoutWord struct {
+	LayoutStyle
+
+	// BBox is the bounding box of the word.
+	BBox model.PdfRectangle `json:"bbox"`
+
+	// Text is the text of the word.
+	Text string `json:"text"`
+
+	// Marks contains the marks of the word, in reading order.
+	Marks []LayoutMark `json:"marks"`
+}
+
+// LayoutMark represents a text mark of a page layout, which is usually a
+// single character.
+type LayoutMark struct {
+	LayoutStyle
+
+	// BBox is the bounding box of the mark.
+	BBox model.PdfRectangle `json:"bbox"`
+
+	// Text is the extracted text of the mark.
+	Text string `json:"text"`
+
+	// Original is the text in the PDF. It has not been decoded like Text.
+	Original string `json:"original,omitempty"`
+}
+
+// Layout returns the layout of the text on the page.
+func (pt PageText) Layout() *PageLayout {
+	layout := &PageLayout{PageBox: pt.pageSize}
+	for _, para := range pt.paras {
+		layout.Blocks = append(layout.Blocks, para.toLayoutBlock())
+	}
+	return layout
+}
+
+// toLayoutBlock returns the layout block of `p`.
+func (p *textPara) toLayoutBlock() LayoutBlock {
+	block := LayoutBlock{
+		Type:        LayoutBlockParagraph,
+		Orientation: p.orient(),
+		Text:        p.text(),
+	}
+
+	var styles styleCounter
+	if p.table == nil {
+		block.Lines, block.BBox = p.toLayoutLines(&styles)
+		block.LayoutStyle = styles.dominant()
+		return block
+	}
+
+	block.Type = LayoutBlockTable
+	block.Table = &LayoutTable{W: p.table.w, H: p.table.h, Cells: make([][]LayoutCell, p.table.h)}
+	first := true
+	for y := 0; y < p.table.h; y++ {
+		block.Table.Cells[y] = make([]LayoutCell, p.table.w)
+		for x := 0; x < p.table.w; x++ {
+			cell := LayoutCell{ColSpan: 1, RowSpan: 1}
+			var cellStyles styleCounter
+			if para := p.table.get(x, y); para != nil {
+				cell.Text = para.text()
+				cell.Lines, cell.BBox = para.toLayoutLines(&cellStyles)
+				cell.LayoutStyle = cellStyles.dominant()
+			}
+			if p.table.geometry != nil {
+				g := p.table.geometry[cellIndex(x, y)]
+				cell.BBox, cell.ColSpan, cell.RowSpan = g.PdfRectangle, g.colSpan, g.rowSpan
+			}
+			block.Table.Cells[y][x] = cell
+
+			styles.absorb(cellStyles)
+			if len(cell.Lines) == 0 {
+				continue
+			}
+			if first {
+				block.BBox = cell.BBox
+				first = false
+			} else {
+				block.BBox = rectUnion(block.BBox, cell.BBox)
+			}
+		}
+	}
+	if p.table.geometry != nil {
+		block.BBox = p.table.PdfRectangle
+	}
+	block.LayoutStyle = styles.dominant()
+	return block
+}
+
+// orient returns the orientation of the text of `p`.
+func (p *textPara) orient() int {
+	if p.table != nil {
+		for _, cell := range p.table.cells {
+			return cell.orient()
+		}
+		return 0
+	}
+	for _, line := range p.lines {
+		for _, word := range line.words {
+			if len(word.marks) > 0 {
+				return word.marks[0].orient
+			}
+		}
+	}
+	return 0
+}
+
+// toLayoutLines returns the layout lines of `p`, which must not be a table,
+// and their bounding box. The styles of the lines are added to `styles`.
+func (p *textPara) toLayoutLines(styles *styleCounter) ([]LayoutLine, model.PdfRectangle) {
+	var lines []LayoutLine
+	var bbox model.PdfRectangle
+	for _, l := range p.lines {
+		var lineStyles styleCounter
+		line := LayoutLine{Text: l.text()}
+		for _, w := range l.words {
+			// Word fragments which do not start new words are parts of the
+			// previous words, such as fragments separated by kerning.
+			if !w.newWord && len(line.Words) > 0 {
+				line.Words[len(line.Words)-1].appendMarks(w.marks, &lineStyles)
+				continue
+			}
+			var word LayoutWord
+			word.appendMarks(w.marks, &lineStyles)
+			line.Words = append(line.Words, word)
+		}
+		if len(line.Words) == 0 {
+			continue
+		}
+
+		line.BBox = line.Words[0].BBox
+		for _, word := range line.Words[1:] {
+			line.BBox = rectUnion(line.BBox, word.BBox)
+		}
+		line.LayoutStyle = lineStyles.dominant()
+		styles.absorb(lineStyles)
+
+		if len(lines) == 0 {
+			bbox = line.BBox
+		} else {
+			bbox = rectUnion(bbox, line.BBox)
+		}
+		lines = append(lines, line)
+	}
+	return lines, bbox
+}
+
+// appendMarks appends the layout marks of `marks` to `w`, and updates the
+// text, bounding box and style of `w`. The styles of the marks are also
+// added to `styles`.
+func (w *LayoutWord) appendMarks(marks []*textMark, styles *styleCounter) {
+	var wordStyles styleCounter
+	for _, m := range w.Marks {
+		wordStyles.add(m.LayoutStyle, m.Text)
+	}
+
+	for _, tm := range marks {
+		mark := tm.toLayoutMark()
+		if len(w.Marks) == 0 {
+			w.BBox = mark.BBox
+		} else {
+			w.BBox = rectUnion(w.BBox, mark.BBox)
+		}
+		w.Text += mark.Text
+		w.Marks = append(w.Marks, mark)
+		wordStyles.add(mark.LayoutStyle, mark.Text)
+		styles.add(mark.LayoutStyle, mark.Text)
+	}
+	w.LayoutStyle = wordStyles.dominant()
+}
+
+// toLayoutMark returns the layout mark of `tm`.
+func (tm *textMark) toLayoutMark() LayoutMark {
+	mark := LayoutMark{
+		BBox:     tm.originaBBox,
+		Text:     tm.text,
+		Original: tm.original,
+	}
+	mark.FontSize = tm.fontsize
+	if tm.font != nil {
+		mark.Font = tm.font.BaseFont()
+	}
+	if tm.fillColor != nil {
+		mark.Color = colorToHex(tm.fillColor)
+	}
+	return mark
+}
+
+// colorToHex returns the hex RGB string of `c`.
+func colorToHex(c color.Color) string {
+	rgba := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return fmt.Sprintf("#%02x%02x%02x", rgba.R, rgba.G, rgba.B)
+}
+
+// styleCounter counts the number of characters having each style, for
+// finding the dominant style of text elements.
+type styleCounter struct {
+	styles []LayoutStyle
+	counts []int
+}
+
+// add counts the characters of `text`, having style `style`.
+func (c *styleCounter) add(style LayoutStyle, text string) {
+	n := len([]rune(text))
+	for i, s := range c.styles {
+		if s == style {
+			c.counts[i] += n
+			return
+		}
+	}
+	c.styles = append(c.styles, style)
+	c.counts = append(c.counts, n)
+}
+
+// absorb adds the counts of `other` to `c`.
+func (c *styleCounter) absorb(other styleCounter) {
+	for i, style := range other.styles {
+		c.add(style, "")
+		for j, s := range c.styles {
+			if s == style {
+				c.counts[j] += other.counts[i]
+				break
+			}
+		}
+	}
+}
+
+// dominant returns the style having the most characters. Ties are broken in
+// favor of the style counted first.
+func (c *styleCounter) dominant() LayoutStyle {
+	var style LayoutStyle
+	max := -1
+	for i, s := range c.styles {
+		if c.counts[i] > max {
+			style, max = s, c.counts[i]
+		}
+	}
+	return style
+}
@@ -0,0 +1,342 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/showntop/unipdf/model"
+)
+
+// WriteLayoutJSON writes the layouts of the specified pages to `w` as an
+// indented JSON object, having the layouts in its "pages" array.
+func WriteLayoutJSON(w io.Writer, pages []*PageLayout) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Pages []*PageLayout `json:"pages"`
+	}{pages})
+}
+
+// WriteLayoutHOCR writes the layouts of the specified pages to `w` as an
+// hOCR document. The pages are numbered from 1, and the bounding boxes are
+// in points, relative to the top left corner of the page boxes. The blocks
+// are written as ocr_carea elements containing an ocr_par element, or as
+// ocr_table elements containing an ocr_par element for each cell. The
+// language of the document is unknown to the layouts, so that the html
+// element has no lang attribute.
+func WriteLayoutHOCR(w io.Writer, pages []*PageLayout) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Transitional//EN" "http://www.w3.org/TR/xhtml1/DTD/xhtml1-transitional.dtd">
+<html xmlns="http://www.w3.org/1999/xhtml">
+<head>
+<title></title>
+<meta http-equiv="Content-Type" content="text/html;charset=utf-8"/>
+<meta name="ocr-system" content="unipdf"/>
+<meta name="ocr-capabilities" content="ocr_page ocr_carea ocr_table ocr_par ocr_line ocrx_word"/>
+</head>
+<body>
+`)
+
+	for i, page := range pages {
+		h := hocrWriter{w: bw, page: page.PageBox, pageNum: i + 1}
+		h.writePage(page)
+	}
+
+	bw.WriteString("</body>\n</html>\n")
+	return bw.Flush()
+}
+
+// hocrWriter writes the elements of a page layout to an hOCR document.
+type hocrWriter struct {
+	w       *bufio.Writer
+	page    model.PdfRectangle
+	pageNum int
+	ids     map[string]int
+}
+
+// writePage writes the ocr_page element of `page`.
+func (h *hocrWriter) writePage(page *PageLayout) {
+	h.ids = map[string]int{}
+	fmt.Fprintf(h.w, "<div class=\"ocr_page\" id=\"page_%d\" title=\"%s; ppageno %d\">\n",
+		h.pageNum, h.bbox(page.PageBox), h.pageNum-1)
+	for _, block := range page.Blocks {
+		if block.Table == nil {
+			fmt.Fprintf(h.w, " <div class=\"ocr_carea\" id=\"%s\" title=\"%s\">\n", h.id("block"), h.bbox(block.BBox))
+			h.writePar(block.BBox, block.Lines)
+			h.w.WriteString(" </div>\n")
+			continue
+		}
+
+		fmt.Fprintf(h.w, " <div class=\"ocr_table\" id=\"%s\" title=\"%s\">\n", h.id("table"), h.bbox(block.BBox))
+		for _, row := range block.Table.Cells {
+			for _, cell := range row {
+				h.writePar(cell.BBox, cell.Lines)
+			}
+		}
+		h.w.WriteString(" </div>\n")
+	}
+	h.w.WriteString("</div>\n")
+}
+
+// writePar writes an ocr_par element containing `lines`.
+func (h *hocrWriter) writePar(bbox model.PdfRectangle, lines []LayoutLine) {
+	fmt.Fprintf(h.w, "  <p class=\"ocr_par\" id=\"%s\" title=\"%s\">\n", h.id("par"), h.bbox(bbox))
+	for _, line := range lines {
+		fmt.Fprintf(h.w, "   <span class=\"ocr_line\" id=\"%s\" title=\"%s; x_size %s\">",
+			h.id("line"), h.bbox(line.BBox), formatNumber(line.FontSize))
+		for i, word := range line.Words {
+			if i > 0 {
+				h.w.WriteString(" ")
+			}
+			title := h.bbox(word.BBox)
+			if word.Font != "" {
+				title += "; x_font " + hocrString(word.Font)
+			}
+			title += "; x_fsize " + formatNumber(word.FontSize)
+			fmt.Fprintf(h.w, "<span class=\"ocrx_word\" id=\"%s\" title=\"%s\">%s</span>",
+				h.id("word"), html.EscapeString(title), html.EscapeString(word.Text))
+		}
+		h.w.WriteString("</span>\n")
+	}
+	h.w.WriteString("  </p>\n")
+}
+
+// hocrString returns `s` as a quoted hOCR property value, so that the
+// semicolons and spaces it contains do not end the property.
+func hocrString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// id returns a new element id having the specified prefix.
+func (h *hocrWriter) id(prefix string) string {
+	h.ids[prefix]++
+	return fmt.Sprintf("%s_%d_%d", prefix, h.pageNum, h.ids[prefix])
+}
+
+// bbox returns the hOCR bbox property of `r`.
+func (h *hocrWriter) bbox(r model.PdfRectangle) string {
+	return fmt.Sprintf("bbox %d %d %d %d",
+		int(math.Round(r.Llx-h.page.Llx)), int(math.Round(h.page.Ury-r.Ury)),
+		int(math.Round(r.Urx-h.page.Llx)), int(math.Round(h.page.Ury-r.Lly)))
+}
+
+// WriteLayoutALTO writes the layouts of the specified pages to `w` as an
+// ALTO (version 4) document. The measurement unit is the pixel, at a
+// resolution of 72 DPI, so that the positions and dimensions are in points,
+// relative to the top left corner of the page boxes. The paragraph blocks
+// are written as TextBlock elements, and the table blocks as ComposedBlock
+// elements containing a TextBlock element for each cell.
+func WriteLayoutALTO(w io.Writer, pages []*PageLayout) error {
+	doc := altoDocument{
+		Xmlns:           "http://www.loc.gov/standards/alto/ns-v4#",
+		MeasurementUnit: "pixel",
+	}
+	a := altoBuilder{doc: &doc, styles: map[LayoutStyle]string{}}
+	for i, page := range pages {
+		a.addPage(page, i+1)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", " ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// altoDocument represents an ALTO document.
+type altoDocument struct {
+	XMLName         xml.Name        `xml:"alto"`
+	Xmlns           string          `xml:"xmlns,attr"`
+	MeasurementUnit string          `xml:"Description>MeasurementUnit"`
+	Styles          []altoTextStyle `xml:"Styles>TextStyle"`
+	Pages           []altoPage      `xml:"Layout>Page"`
+}
+
+// altoTextStyle represents a TextStyle element of an ALTO document.
+type altoTextStyle struct {
+	ID         string  `xml:"ID,attr"`
+	FontFamily string  `xml:"FONTFAMILY,attr,omitempty"`
+	FontSize   float64 `xml:"FONTSIZE,attr"`
+	FontColor  string  `xml:"FONTCOLOR,attr,omitempty"`
+}
+
+// altoBox contains the position and the dimensions of an element of an ALTO
+// document.
+type altoBox struct {
+	HPos   float64 `xml:"HPOS,attr"`
+	VPos   float64 `xml:"VPOS,attr"`
+	Width  float64 `xml:"WIDTH,attr"`
+	Height float64 `xml:"HEIGHT,attr"`
+}
+
+// altoPage represents a Page element of an ALTO document.
+type altoPage struct {
+	ID            string         `xml:"ID,attr"`
+	PhysicalImgNr int            `xml:"PHYSICAL_IMG_NR,attr"`
+	Width         float64        `xml:"WIDTH,attr"`
+	Height        float64        `xml:"HEIGHT,attr"`
+	PrintSpace    altoPrintSpace `xml:"PrintSpace"`
+}
+
+// altoPrintSpace represents the PrintSpace element of an ALTO page. Its
+// blocks are TextBlock and ComposedBlock elements.
+type altoPrintSpace struct {
+	altoBox
+	Blocks []interface{}
+}
+
+// altoComposedBlock represents a ComposedBlock element of an ALTO document.
+type altoComposedBlock struct {
+	XMLName xml.Name `xml:"ComposedBlock"`
+	ID      string   `xml:"ID,attr"`
+	Type    string   `xml:"TYPE,attr"`
+	altoBox
+	Blocks []altoTextBlock
+}
+
+// altoTextBlock represents a TextBlock element of an ALTO document.
+type altoTextBlock struct {
+	XMLName xml.Name `xml:"TextBlock"`
+	ID      string   `xml:"ID,attr"`
+	altoBox
+	Lines []altoTextLine `xml:"TextLine"`
+}
+
+// altoTextLine represents a TextLine element of an ALTO document. Its
+// contents are String and SP elements.
+type altoTextLine struct {
+	ID string `xml:"ID,attr"`
+	altoBox
+	Contents []interface{}
+}
+
+// altoString represents a String element of an ALTO document.
+type altoString struct {
+	XMLName xml.Name `xml:"String"`
+	ID      string   `xml:"ID,attr"`
+	Content string   `xml:"CONTENT,attr"`
+	altoBox
+	StyleRefs string `xml:"STYLEREFS,attr,omitempty"`
+}
+
+// altoSpace represents an SP element of an ALTO document.
+type altoSpace struct {
+	XMLName xml.Name `xml:"SP"`
+}
+
+// altoBuilder builds an ALTO document from page layouts.
+type altoBuilder struct {
+	doc    *altoDocument
+	styles map[LayoutStyle]string
+	page   model.PdfRectangle
+	prefix string
+}
+
+// addPage adds the Page element of `page`, having page number `pageNum`.
+func (a *altoBuilder) addPage(page *PageLayout, pageNum int) {
+	a.page = page.PageBox
+	a.prefix = fmt.Sprintf("p%d", pageNum)
+	p := altoPage{
+		ID:            a.prefix,
+		PhysicalImgNr: pageNum,
+		Width:         roundCoord(page.PageBox.Width()),
+		Height:        roundCoord(page.PageBox.Height()),
+	}
+	p.PrintSpace.altoBox = a.box(page.PageBox)
+
+	for i, block := range page.Blocks {
+		id := fmt.Sprintf("%s_b%d", a.prefix, i+1)
+		if block.Table == nil {
+			p.PrintSpace.Blocks = append(p.PrintSpace.Blocks, a.textBlock(id, block.BBox, block.Lines))
+			continue
+		}
+
+		composed := altoComposedBlock{ID: id, Type: "table", altoBox: a.box(block.BBox)}
+		for y, row := range block.Table.Cells {
+			for x, cell := range row {
+				cellID := fmt.Sprintf("%s_r%dc%d", id, y+1, x+1)
+				composed.Blocks = append(composed.Blocks, a.textBlock(cellID, cell.BBox, cell.Lines))
+			}
+		}
+		p.PrintSpace.Blocks = append(p.PrintSpace.Blocks, composed)
+	}
+
+	a.doc.Pages = append(a.doc.Pages, p)
+}
+
+// textBlock returns a TextBlock element containing `lines`.
+func (a *altoBuilder) textBlock(id string, bbox model.PdfRectangle, lines []LayoutLine) altoTextBlock {
+	block := altoTextBlock{ID: id, altoBox: a.box(bbox)}
+	for i, line := range lines {
+		lineID := fmt.Sprintf("%s_l%d", id, i+1)
+		l := altoTextLine{ID: lineID, altoBox: a.box(line.BBox)}
+		for j, word := range line.Words {
+			if j > 0 {
+				l.Contents = append(l.Contents, altoSpace{})
+			}
+			l.Contents = append(l.Contents, altoString{
+				ID:        fmt.Sprintf("%s_w%d", lineID, j+1),
+				Content:   word.Text,
+				altoBox:   a.box(word.BBox),
+				StyleRefs: a.styleID(word.LayoutStyle),
+			})
+		}
+		block.Lines = append(block.Lines, l)
+	}
+	return block
+}
+
+// styleID returns the ID of the TextStyle element of `style`, which is added
+// to the document the first time it is used.
+func (a *altoBuilder) styleID(style LayoutStyle) string {
+	if id, ok := a.styles[style]; ok {
+		return id
+	}
+	id := fmt.Sprintf("s%d", len(a.styles))
+	a.styles[style] = id
+	a.doc.Styles = append(a.doc.Styles, altoTextStyle{
+		ID:         id,
+		FontFamily: style.Font,
+		FontSize:   roundCoord(style.FontSize),
+		FontColor:  strings.TrimPrefix(style.Color, "#"),
+	})
+	return id
+}
+
+// box returns the ALTO position and dimensions of `r`.
+func (a *altoBuilder) box(r model.PdfRectangle) altoBox {
+	return altoBox{
+		HPos:   roundCoord(r.Llx - a.page.Llx),
+		VPos:   roundCoord(a.page.Ury - r.Ury),
+		Width:  roundCoord(r.Width()),
+		Height: roundCoord(r.Height()),
+	}
+}
+
+// roundCoord rounds `v` to 2 decimal places.
+func roundCoord(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// formatNumber formats `v` rounded to 2 decimal places, without trailing
+// zeros.
+func formatNumber(v float64) string {
+	return fmt.Sprintf("%g", roundCoord(v))
+}
@@ -0,0 +1,157 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/model"
+)
+
+// extractTestLayout returns the layout of the page having content stream `contents`.
+func extractTestLayout(t *testing.T, contents string) *PageLayout {
+	e := newTestExtractor(contents)
+	helvetica := model.NewStandard14FontMustCompile(model.HelveticaName)
+	e.resources.SetFontByName("UniDocHelvetica", helvetica.ToPdfObject())
+
+	pageText, _, _, err := e.ExtractPageText()
+	require.NoError(t, err)
+	return pageText.Layout()
+}
+
+func TestPageLayout(t *testing.T) {
+	layout := extractTestLayout(t, `
+		BT
+		/UniDocCourier 24 Tf
+		100 700 Td
+		(Hello World!)Tj
+		0 -25 Td
+		1 0 0 rg
+		(Doink)Tj
+		ET
+	`)
+	require.Equal(t, r(0, 0, 600, 800), layout.PageBox)
+	require.Len(t, layout.Blocks, 1)
+
+	block := layout.Blocks[0]
+	require.Equal(t, LayoutBlockParagraph, block.Type)
+	require.Equal(t, "Hello World!\nDoink", block.Text)
+	require.Equal(t, 0, block.Orientation)
+	require.Nil(t, block.Table)
+	require.Equal(t, LayoutStyle{Font: "Courier", FontSize: 24, Color: "#000000"}, block.LayoutStyle)
+	require.Len(t, block.Lines, 2)
+
+	line := block.Lines[0]
+	require.Equal(t, "Hello World!", line.Text)
+	require.Len(t, line.Words, 2)
+	require.Equal(t, "Hello", line.Words[0].Text)
+	require.Equal(t, "World!", line.Words[1].Text)
+	require.Len(t, line.Words[0].Marks, 5)
+	require.Equal(t, "H", line.Words[0].Marks[0].Text)
+	require.Equal(t, LayoutStyle{Font: "Courier", FontSize: 24, Color: "#000000"}, line.Words[0].Marks[0].LayoutStyle)
+	require.InDelta(t, 100, line.BBox.Llx, 0.01)
+	require.InDelta(t, 700, line.BBox.Lly, 0.01)
+	require.InDelta(t, 100+12*0.6*24, line.BBox.Urx, 0.01)
+	require.True(t, line.Words[0].BBox.Urx < line.Words[1].BBox.Llx)
+
+	line = block.Lines[1]
+	require.Equal(t, "Doink", line.Text)
+	require.Equal(t, LayoutStyle{Font: "Courier", FontSize: 24, Color: "#ff0000"}, line.LayoutStyle)
+	require.InDelta(t, 675, line.BBox.Lly, 0.01)
+
+	// The block bounding box contains the lines.
+	require.InDelta(t, 675, block.BBox.Lly, 0.01)
+	require.InDelta(t, 724, block.BBox.Ury, 0.01)
+}
+
+func TestPageLayoutRotated(t *testing.T) {
+	layout := extractTestLayout(t, `
+		BT
+		/UniDocCourier 24 Tf
+		0 1 -1 0 300 100 Tm
+		(Hello World!)Tj
+		ET
+	`)
+	require.Len(t, layout.Blocks, 1)
+	require.Equal(t, 270, layout.Blocks[0].Orientation)
+
+	// The bounding boxes are in unrotated page coordinates, like the
+	// bounding boxes of the text marks.
+	line := layout.Blocks[0].Lines[0]
+	require.Equal(t, "Hello World!", line.Text)
+	require.InDelta(t, 24, line.BBox.Width(), 0.01)
+	require.InDelta(t, 12*0.6*24, line.BBox.Height(), 0.01)
+	require.InDelta(t, 100, line.BBox.Lly, 0.01)
+	require.True(t, line.Words[0].BBox.Ury < line.Words[1].BBox.Lly)
+}
+
+func TestPageLayoutExport(t *testing.T) {
+	layout := extractTestLayout(t, `
+		BT
+		/UniDocCourier 24 Tf
+		100 700 Td
+		(Fish & Chips)Tj
+		ET
+	`)
+	pages := []*PageLayout{layout, layout}
+
+	// JSON.
+	var buf bytes.Buffer
+	require.NoError(t, WriteLayoutJSON(&buf, pages))
+	var decoded struct {
+		Pages []*PageLayout `json:"pages"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Equal(t, pages, decoded.Pages)
+
+	// hOCR.
+	buf.Reset()
+	require.NoError(t, WriteLayoutHOCR(&buf, pages))
+	hocr := buf.String()
+	requireWellFormedXML(t, hocr)
+	require.Contains(t, hocr, `<div class="ocr_page" id="page_2" title="bbox 0 0 600 800; ppageno 1">`)
+	require.Contains(t, hocr, `<span class="ocrx_word" id="word_1_2" title="bbox 172 76 186 100; x_font &#34;Courier&#34;; x_fsize 24">&amp;</span>`)
+	require.NotContains(t, hocr, "lang=")
+
+	// The font names are quoted in hOCR properties.
+	odd := *layout
+	odd.Blocks = []LayoutBlock{layout.Blocks[0]}
+	odd.Blocks[0].Lines = []LayoutLine{layout.Blocks[0].Lines[0]}
+	odd.Blocks[0].Lines[0].Words = []LayoutWord{layout.Blocks[0].Lines[0].Words[0]}
+	odd.Blocks[0].Lines[0].Words[0].Font = `Odd; "Name"`
+	buf.Reset()
+	require.NoError(t, WriteLayoutHOCR(&buf, []*PageLayout{&odd}))
+	require.Contains(t, buf.String(), `x_font &#34;Odd; \&#34;Name\&#34;&#34;; x_fsize 24">Fish</span>`)
+
+	// ALTO.
+	buf.Reset()
+	require.NoError(t, WriteLayoutALTO(&buf, pages))
+	alto := buf.String()
+	requireWellFormedXML(t, alto)
+	require.Equal(t, 1, strings.Count(alto, "<TextStyle "))
+	require.Contains(t, alto, `<TextStyle ID="s0" FONTFAMILY="Courier" FONTSIZE="24" FONTCOLOR="000000"></TextStyle>`)
+	require.Contains(t, alto, `<String ID="p2_b1_l1_w3" CONTENT="Chips" HPOS="200.8" VPOS="76" WIDTH="72" HEIGHT="24" STYLEREFS="s0"></String>`)
+}
+
+// requireWellFormedXML checks that `doc` is a well-formed XML document.
+func requireWellFormedXML(t *testing.T, doc string) {
+	dec := xml.NewDecoder(strings.NewReader(doc))
+	dec.Strict = true
+	for {
+		_, err := dec.Token()
+		if err == io.EOF {
+			return
+		}
+		require.NoError(t, err)
+	}
+}
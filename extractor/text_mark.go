@@ -30,6 +30,13 @@ type textMark struct {
 	originaBBox        model.PdfRectangle // Bounding box without orientation correction.
 	fillColor          color.Color        // Text fill color.
 	strokeColor        color.Color        // Text stroke color.
+	mcid               int                // Marked-content identifier. -1 if there is none.
+	formMCID           mcidKey            // MCID of the innermost tagged marked content in a form.
+	lang               string             // Language from the enclosing marked content.
+	alt                string             // Alternate description from the enclosing marked content.
+	actual             *markedContent     // The enclosing marked content with ActualText, if any.
+	numChars           int                // Number of character codes the mark was decoded from.
+	numMisses          int                // Number of those character codes that were not decoded.
 }
 
 // newTextMark returns a textMark for text `text` rendered with text rendering matrix (TRM) `trm`
@@ -150,6 +157,10 @@ func (tm *textMark) ToTextMark() TextMark {
 		FontSize:    tm.fontsize,
 		FillColor:   tm.fillColor,
 		StrokeColor: tm.strokeColor,
+		Orientation: tm.orient,
+		MCID:        tm.mcid,
+		Lang:        tm.lang,
+		Alt:         tm.alt,
 	}
 }
 
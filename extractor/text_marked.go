@@ -0,0 +1,199 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"strings"
+
+	"github.com/showntop/unipdf/common"
+	"github.com/showntop/unipdf/contentstream"
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+)
+
+// markedContent is a marked-content sequence started by a BMC or BDC operator.
+type markedContent struct {
+	tag        string  // The tag of the sequence, e.g. Span, P, Artifact.
+	mcid       int     // The marked-content identifier. -1 if there is none.
+	lang       string  // The language of the sequence.
+	alt        string  // The alternate description of the sequence.
+	actualText *string // The replacement text of the sequence. nil if there is none.
+}
+
+// markedContentStack is the stack of the marked-content sequences enclosing the current operator
+// in a content stream.
+type markedContentStack []*markedContent
+
+// handleOp updates `s` for marked-content operator `op`. Named property lists of BDC operators
+// are looked up in the Properties of `resources`.
+func (s *markedContentStack) handleOp(op *contentstream.ContentStreamOperation,
+	resources *model.PdfPageResources) {
+	switch op.Operand {
+	case "BMC":
+		mc := &markedContent{mcid: -1}
+		if len(op.Params) > 0 {
+			mc.tag, _ = core.GetNameVal(op.Params[0])
+		}
+		*s = append(*s, mc)
+	case "BDC":
+		mc := &markedContent{mcid: -1}
+		if len(op.Params) > 0 {
+			mc.tag, _ = core.GetNameVal(op.Params[0])
+		}
+		if len(op.Params) > 1 {
+			if props := markedContentProperties(op.Params[1], resources); props != nil {
+				mc.setProperties(props)
+			}
+		}
+		*s = append(*s, mc)
+	case "EMC":
+		if len(*s) == 0 {
+			common.Log.Debug("EMC called outside of a marked-content sequence")
+			return
+		}
+		*s = (*s)[:len(*s)-1]
+	}
+}
+
+// markedContentProperties returns the property list `obj` of a BDC operator. `obj` is either a
+// dictionary or the name of an entry in the Properties of `resources`.
+func markedContentProperties(obj core.PdfObject, resources *model.PdfPageResources) *core.PdfObjectDictionary {
+	if name, ok := core.GetName(obj); ok {
+		if resources == nil {
+			return nil
+		}
+		properties, ok := core.GetDict(resources.Properties)
+		if !ok {
+			common.Log.Debug("ERROR: no Properties resource for %s", name)
+			return nil
+		}
+		obj = properties.Get(*name)
+	}
+	props, ok := core.GetDict(obj)
+	if !ok {
+		common.Log.Debug("ERROR: invalid marked-content properties %T", obj)
+		return nil
+	}
+	return props
+}
+
+// setProperties sets the properties of `mc` that are used in text extraction from `props`.
+func (mc *markedContent) setProperties(props *core.PdfObjectDictionary) {
+	if mcid, ok := core.GetIntVal(props.Get("MCID")); ok {
+		mc.mcid = mcid
+	}
+	if s, ok := core.GetString(props.Get("Lang")); ok {
+		mc.lang = s.Decoded()
+	}
+	if s, ok := core.GetString(props.Get("Alt")); ok {
+		mc.alt = s.Decoded()
+	}
+	if s, ok := core.GetString(props.Get("ActualText")); ok {
+		text := s.Decoded()
+		mc.actualText = &text
+	}
+}
+
+// label sets the marked-content properties of `tm`, a mark drawn inside the sequences of `s`.
+// The MCID, language and alternate description are those of the innermost sequence that has them.
+// The replacement text is that of the outermost sequence that has one, as it replaces all the
+// content inside it.
+func (s markedContentStack) label(tm *textMark) {
+	tm.mcid = -1
+	for i := len(s) - 1; i >= 0; i-- {
+		mc := s[i]
+		if tm.mcid < 0 {
+			tm.mcid = mc.mcid
+		}
+		if tm.lang == "" {
+			tm.lang = mc.lang
+		}
+		if tm.alt == "" {
+			tm.alt = mc.alt
+		}
+	}
+	for _, mc := range s {
+		if mc.actualText != nil {
+			tm.actual = mc
+			break
+		}
+	}
+}
+
+// labelForm returns the marks of the form XObject with object number `formObj` drawn inside the
+// sequences of `s`. The marks that are not inside marked content in the form get the properties
+// of `s`. The MCIDs of the form are local to it and would collide with those of the page, so the
+// marks get the MCID of `s` and keep the MCID of the innermost form with marked content containing
+// them in formMCID, keyed by that form, which is how the structure tree references them.
+// `marks` is not modified as the marks of forms are shared by all the places the form is drawn.
+func (s markedContentStack) labelForm(marks []*textMark, formObj int64) []*textMark {
+	var outer textMark
+	s.label(&outer)
+	labeled := make([]*textMark, len(marks))
+	for i, tm := range marks {
+		mark := *tm
+		if mark.formMCID.stream == 0 && mark.mcid >= 0 && formObj > 0 {
+			mark.formMCID = mcidKey{stream: formObj, mcid: mark.mcid}
+		}
+		mark.mcid = outer.mcid
+		if mark.lang == "" {
+			mark.lang = outer.lang
+		}
+		if mark.alt == "" {
+			mark.alt = outer.alt
+		}
+		if outer.actual != nil {
+			mark.actual = outer.actual
+		}
+		labeled[i] = &mark
+	}
+	return labeled
+}
+
+// substituteActualText returns `marks` with each run of marks inside a marked-content sequence
+// with an ActualText entry replaced by a single mark with the ActualText as its text. The bounding
+// box of the mark is the union of the bounding boxes of the replaced marks. Runs with an empty
+// ActualText are removed.
+func substituteActualText(marks []*textMark) []*textMark {
+	var substituted []*textMark
+	for i := 0; i < len(marks); {
+		tm := marks[i]
+		if tm.actual == nil {
+			substituted = append(substituted, tm)
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(marks) && marks[j].actual == tm.actual && marks[j].orient == tm.orient {
+			j++
+		}
+		if text := *tm.actual.actualText; text != "" {
+			substituted = append(substituted, actualTextMark(marks[i:j], text))
+		}
+		i = j
+	}
+	return substituted
+}
+
+// actualTextMark returns a mark with text `text` that replaces `marks`.
+func actualTextMark(marks []*textMark, text string) *textMark {
+	mark := *marks[0]
+	mark.numChars, mark.numMisses = 0, 0
+	var original strings.Builder
+	for _, tm := range marks {
+		mark.numChars += tm.numChars
+		mark.numMisses += tm.numMisses
+		original.WriteString(tm.original)
+		mark.PdfRectangle = rectUnion(mark.PdfRectangle, tm.PdfRectangle)
+		mark.originaBBox = rectUnion(mark.originaBBox, tm.originaBBox)
+	}
+	last := marks[len(marks)-1]
+	mark.text = text
+	mark.original = original.String()
+	mark.end = last.end
+	mark.actual = nil
+	return &mark
+}
@@ -0,0 +1,270 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+)
+
+// extractMarkedText returns the PageText of content stream `contents` with the Properties
+// resource `properties` and logical structure `structure`.
+func extractMarkedText(t *testing.T, contents string, properties core.PdfObject,
+	structure *pageStructure) *PageText {
+	e := newTestExtractor(contents)
+	e.resources.Properties = properties
+	e.structure = structure
+	pageText, _, _, err := e.ExtractPageText()
+	require.NoError(t, err)
+	return pageText
+}
+
+func TestMarkedContent(t *testing.T) {
+	properties := core.MakeDict()
+	german := core.MakeDict()
+	german.Set("MCID", core.MakeInteger(4))
+	german.Set("Lang", core.MakeString("de"))
+	properties.Set("P1", german)
+
+	pageText := extractMarkedText(t, `
+		BT
+		/UniDocCourier 10 Tf
+		100 700 Td
+		/Span <</ActualText (fi)>> BDC (X) Tj EMC (sh ) Tj
+		/P <</MCID 3 /Lang (fr)>> BDC (oui) Tj EMC
+		/Span <</ActualText ()>> BDC (~) Tj EMC
+		0 -12 Td
+		/P /P1 BDC (ja) Tj /Span <</Alt (yes)>> BDC (!) Tj EMC EMC
+		ET
+	`, properties, nil)
+	require.Equal(t, "fish oui\nja!\n\n", pageText.Text())
+
+	marks := pageText.Marks().Elements()
+	byText := map[string]TextMark{}
+	for _, tm := range marks {
+		if !tm.Meta {
+			byText[tm.Text] = tm
+		}
+	}
+
+	// ActualText replaces the enclosed glyph.
+	fi := byText["fi"]
+	require.Equal(t, "X", fi.Original)
+	require.InDelta(t, 100, fi.BBox.Llx, 0.01)
+	require.InDelta(t, 106, fi.BBox.Urx, 0.01)
+	require.Equal(t, -1, fi.MCID)
+
+	require.Equal(t, 3, byText["o"].MCID)
+	require.Equal(t, "fr", byText["o"].Lang)
+
+	// Named property lists are looked up in the Properties resource.
+	require.Equal(t, 4, byText["j"].MCID)
+	require.Equal(t, "de", byText["j"].Lang)
+	require.Equal(t, 4, byText["!"].MCID)
+	require.Equal(t, "de", byText["!"].Lang)
+	require.Equal(t, "yes", byText["!"].Alt)
+	require.Equal(t, "", byText["j"].Alt)
+}
+
+func TestStructureOrder(t *testing.T) {
+	page := core.MakeIndirectObject(core.MakeDict())
+	page.ObjectNumber = 5
+	otherPage := core.MakeIndirectObject(core.MakeDict())
+	otherPage.ObjectNumber = 6
+
+	element := func(typ string, kids ...core.PdfObject) *core.PdfObjectDictionary {
+		d := core.MakeDict()
+		d.Set("S", core.MakeName(typ))
+		d.Set("K", core.MakeArray(kids...))
+		return d
+	}
+	mcr := func(mcid int64, pg core.PdfObject) *core.PdfObjectDictionary {
+		d := core.MakeDict()
+		d.Set("Type", core.MakeName("MCR"))
+		d.Set("MCID", core.MakeInteger(mcid))
+		d.Set("Pg", pg)
+		return d
+	}
+
+	// The first paragraph is below the second on the page. The second paragraph has an inline
+	// span with replacement text and the third is on another page.
+	first := element("P", core.MakeInteger(0))
+	first.Set("Lang", core.MakeString("en"))
+	span := element("Span", core.MakeInteger(2))
+	span.Set("ActualText", core.MakeString("Two"))
+	second := element("Para", core.MakeInteger(1), span)
+	third := element("P", mcr(0, otherPage))
+	doc := element("Document", first, second, third)
+	doc.Set("Pg", page)
+	root := core.MakeDict()
+	root.Set("Type", core.MakeName("StructTreeRoot"))
+	root.Set("K", doc)
+	roleMap := core.MakeDict()
+	roleMap.Set("Para", core.MakeName("P"))
+	root.Set("RoleMap", roleMap)
+
+	structure := newPageStructure(root, page)
+	require.NotNil(t, structure)
+	require.Equal(t, 2, structure.numBlocks)
+	require.Len(t, structure.mcids, 3)
+	require.Equal(t, 1, structure.mcids[mcidKey{mcid: 2}].block)
+
+	contents := `
+		BT
+		/UniDocCourier 10 Tf
+		1 0 0 1 100 770 Tm /Artifact BMC (Header) Tj EMC
+		1 0 0 1 100 700 Tm /P <</MCID 1>> BDC (Block ) Tj EMC /Span <</MCID 2>> BDC (2) Tj EMC
+		1 0 0 1 100 600 Tm /P <</MCID 0>> BDC (Block one) Tj EMC
+		ET
+	`
+	pageText := extractMarkedText(t, contents, nil, nil)
+	require.Equal(t, "Header\n\nBlock 2\n\nBlock one\n\n", pageText.Text())
+
+	pageText = extractMarkedText(t, contents, nil, structure)
+	require.Equal(t, "Block one\n\nBlock Two\n\nHeader\n\n", pageText.Text())
+	for _, tm := range pageText.Marks().Elements() {
+		switch tm.MCID {
+		case 0:
+			require.Equal(t, "en", tm.Lang)
+		case 2:
+			require.Equal(t, "Two", tm.Text)
+			require.Equal(t, "2", tm.Original)
+		}
+	}
+
+	// Pages without content in the structure tree have no page structure.
+	require.Nil(t, newPageStructure(root, core.MakeIndirectObject(core.MakeDict())))
+
+	// The structure of all the pages is collected in one traversal. The blocks are numbered on
+	// each page, and a block spanning pages is a block on each of them.
+	doc.Set("K", core.MakeArray(first, second, third, element("P", mcr(3, page), mcr(1, otherPage))))
+	structures := newDocStructure(root)
+	require.Len(t, structures, 2)
+	structure = structures.page(page)
+	require.Equal(t, 3, structure.numBlocks)
+	require.Equal(t, 2, structure.mcids[mcidKey{mcid: 3}].block)
+	other := structures.page(otherPage)
+	require.Equal(t, 2, other.numBlocks)
+	require.Equal(t, 0, other.mcids[mcidKey{mcid: 0}].block)
+	require.Equal(t, 1, other.mcids[mcidKey{mcid: 1}].block)
+}
+
+func TestStructureFormMCID(t *testing.T) {
+	page := core.MakeIndirectObject(core.MakeDict())
+	page.ObjectNumber = 5
+	para := core.MakeDict()
+	para.Set("S", core.MakeName("P"))
+	para.Set("Pg", page)
+	para.Set("Lang", core.MakeString("en"))
+	para.Set("K", core.MakeArray(core.MakeInteger(0), core.MakeInteger(1)))
+	root := core.MakeDict()
+	root.Set("Type", core.MakeName("StructTreeRoot"))
+	root.Set("K", para)
+	structure := newPageStructure(root, page)
+	require.NotNil(t, structure)
+
+	// The MCID of the marked content in the form is local to the form and collides with the MCID
+	// of the page paragraph.
+	e := newTestExtractor(`
+		BT /UniDocCourier 10 Tf 100 700 Td /P <</MCID 0>> BDC (Page) Tj EMC ET
+		/Fm1 Do
+		/P <</MCID 1>> BDC /Fm1 Do EMC
+	`)
+	xform := model.NewXObjectForm()
+	require.NoError(t, xform.SetContentStream([]byte(
+		"BT /UniDocCourier 10 Tf 100 500 Td /Figure <</MCID 0>> BDC (Logo) Tj EMC ET"), nil))
+	require.NoError(t, e.resources.SetXObjectFormByName("Fm1", xform))
+	e.structure = structure
+	pageText, _, _, err := e.ExtractPageText()
+	require.NoError(t, err)
+
+	// The form drawn outside marked content is not in the structure and is read last. The form
+	// drawn inside the marked content of the page has the MCID of the page sequence.
+	var mcids []int
+	for _, tm := range pageText.Marks().Elements() {
+		switch tm.Text {
+		case "P", "L":
+			mcids = append(mcids, tm.MCID)
+			if tm.MCID < 0 {
+				require.Empty(t, tm.Lang)
+			} else {
+				require.Equal(t, "en", tm.Lang)
+			}
+		}
+	}
+	require.Equal(t, []int{0, 1, -1}, mcids)
+}
+
+func TestStructureFormStm(t *testing.T) {
+	// A form XObject with marked content that is tagged through marked-content references to
+	// the form stream.
+	xform := model.NewXObjectForm()
+	require.NoError(t, xform.SetContentStream([]byte(`
+		BT /UniDocCourier 10 Tf 100 500 Td /H1 <</MCID 0>> BDC (Title) Tj EMC ET
+		BT /UniDocCourier 10 Tf 100 400 Td /Figure <</MCID 1>> BDC (Logo) Tj EMC ET
+	`), nil))
+	formStream := xform.ToPdfObject().(*core.PdfObjectStream)
+	formStream.ObjectNumber = 7
+
+	page := core.MakeIndirectObject(core.MakeDict())
+	page.ObjectNumber = 5
+	// mcr returns a marked-content reference to the content with MCID `mcid` in the form.
+	mcr := func(mcid int64) *core.PdfObjectDictionary {
+		dict := core.MakeDict()
+		dict.Set("Type", core.MakeName("MCR"))
+		dict.Set("Pg", page)
+		dict.Set("Stm", formStream)
+		dict.Set("MCID", core.MakeInteger(mcid))
+		return dict
+	}
+	heading := core.MakeDict()
+	heading.Set("S", core.MakeName("H1"))
+	heading.Set("Lang", core.MakeString("de"))
+	heading.Set("K", mcr(0))
+	figure := core.MakeDict()
+	figure.Set("S", core.MakeName("Figure"))
+	figure.Set("ActualText", core.MakeString("Company logo"))
+	figure.Set("K", mcr(1))
+	para := core.MakeDict()
+	para.Set("S", core.MakeName("P"))
+	para.Set("Pg", page)
+	para.Set("Lang", core.MakeString("en"))
+	para.Set("K", core.MakeInteger(0))
+	doc := core.MakeDict()
+	doc.Set("S", core.MakeName("Document"))
+	doc.Set("K", core.MakeArray(heading, para, figure))
+	root := core.MakeDict()
+	root.Set("Type", core.MakeName("StructTreeRoot"))
+	root.Set("K", doc)
+	structure := newPageStructure(root, page)
+	require.NotNil(t, structure)
+
+	// The MCIDs of the form collide with the MCID of the page paragraph, which is drawn first.
+	e := newTestExtractor(`
+		BT /UniDocCourier 10 Tf 100 700 Td /P <</MCID 0>> BDC (Body) Tj EMC ET
+		/Fm1 Do
+	`)
+	require.NoError(t, e.resources.SetXObjectFormByName("Fm1", xform))
+	e.structure = structure
+	pageText, _, _, err := e.ExtractPageText()
+	require.NoError(t, err)
+
+	// The form content follows the structure order and gets the language and replacement text
+	// of its structure elements.
+	require.Equal(t, "Title\n\nBody\n\nCompany logo\n\n", pageText.Text())
+	langs := map[string]string{}
+	for _, tm := range pageText.Marks().Elements() {
+		if !tm.Meta {
+			langs[tm.Text] = tm.Lang
+		}
+	}
+	require.Equal(t, "de", langs["T"])
+	require.Equal(t, "en", langs["B"])
+}
@@ -0,0 +1,154 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"github.com/showntop/unipdf/model"
+)
+
+// TextExtractOptions contains options for controlling text extraction from
+// PDF pages. The text is selected before it is arranged into paragraphs,
+// lines and words, so the extracted text is laid out as if the text that was
+// not selected was not on the page.
+type TextExtractOptions struct {
+	// IncludeRegions restricts the extracted text to the text in these
+	// regions. All the text on the page is extracted if it is empty.
+	// Regions are in device coordinates, like the bounding boxes of
+	// TextMarks.
+	IncludeRegions []model.PdfRectangle
+
+	// ExcludeRegions excludes the text in these regions, such as headers and
+	// footers, from the extracted text.
+	ExcludeRegions []model.PdfRectangle
+
+	// RegionPolicy determines whether text that straddles the boundary of a
+	// region is in the region.
+	RegionPolicy RegionPolicy
+
+	// MarkFilter, if not nil, selects the text to extract by font, size,
+	// color, orientation etc. It is called for each TextMark in the regions
+	// and only the marks for which it returns true are extracted. The
+	// Offset of the marks it is called with is not set.
+	MarkFilter func(mark TextMark) bool
+
+	// StructureOrder makes the text follow the logical structure order of the
+	// structure tree of a tagged PDF instead of the reading order inferred
+	// from the layout of the page. Each block-level structure element, such as
+	// a paragraph or heading, is laid out separately and the blocks are
+	// ordered as in the structure tree. Text that is not in the structure
+	// tree, such as page headers and footers marked as artifacts, follows the
+	// structured text. The Lang, Alt and ActualText entries of structure
+	// elements are also applied to the text. It has no effect on documents
+	// without a structure tree.
+	StructureOrder bool
+}
+
+// RegionPolicy determines whether text marks that straddle the boundary of a
+// region are in the region.
+type RegionPolicy int
+
+// Region policies.
+const (
+	// RegionPolicyCenter treats marks as in a region if the centers of their
+	// bounding boxes are in it.
+	RegionPolicyCenter RegionPolicy = iota
+
+	// RegionPolicyContained treats marks as in a region if their bounding
+	// boxes are entirely in it.
+	RegionPolicyContained
+
+	// RegionPolicyOverlap treats marks as in a region if their bounding boxes
+	// overlap it.
+	RegionPolicyOverlap
+)
+
+// NewWithOptions returns an Extractor instance for extracting content from
+// the input PDF page, with text extraction controlled by `options`.
+func NewWithOptions(page *model.PdfPage, options *TextExtractOptions) (*Extractor, error) {
+	e, err := New(page)
+	if err != nil {
+		return nil, err
+	}
+	e.textOptions = options
+	if options != nil && options.StructureOrder {
+		root, err := page.GetStructTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		if root != nil {
+			e.structure = newPageStructure(root, page.GetPageAsIndirectObject())
+		}
+	}
+	return e, nil
+}
+
+// filterMarks returns the marks in `marks` that are selected by `o`, and the number of characters
+// and of characters that were not decoded in the marks that were not selected.
+func (o *TextExtractOptions) filterMarks(marks []*textMark) ([]*textMark, int, int) {
+	if o == nil || (len(o.IncludeRegions) == 0 && len(o.ExcludeRegions) == 0 && o.MarkFilter == nil) {
+		return marks, 0, 0
+	}
+	var selected []*textMark
+	var removedChars, removedMisses int
+	for _, tm := range marks {
+		if o.selects(tm) {
+			selected = append(selected, tm)
+		} else {
+			removedChars += tm.numChars
+			removedMisses += tm.numMisses
+		}
+	}
+	return selected, removedChars, removedMisses
+}
+
+// selects returns true if `tm` is selected by `o`.
+func (o *TextExtractOptions) selects(tm *textMark) bool {
+	bbox := tm.originaBBox
+	if len(o.IncludeRegions) > 0 && !o.RegionPolicy.inAny(bbox, o.IncludeRegions) {
+		return false
+	}
+	if o.RegionPolicy.inAny(bbox, o.ExcludeRegions) {
+		return false
+	}
+	return o.MarkFilter == nil || o.MarkFilter(tm.ToTextMark())
+}
+
+// inAny returns true if a mark with bounding box `bbox` is in any of `regions` according to `p`.
+func (p RegionPolicy) inAny(bbox model.PdfRectangle, regions []model.PdfRectangle) bool {
+	for _, region := range regions {
+		if p.in(bbox, region) {
+			return true
+		}
+	}
+	return false
+}
+
+// in returns true if a mark with bounding box `bbox` is in `region` according to `p`.
+func (p RegionPolicy) in(bbox, region model.PdfRectangle) bool {
+	region = normalizedRect(region)
+	switch p {
+	case RegionPolicyContained:
+		return region.Llx <= bbox.Llx && bbox.Urx <= region.Urx &&
+			region.Lly <= bbox.Lly && bbox.Ury <= region.Ury
+	case RegionPolicyOverlap:
+		return bbox.Llx <= region.Urx && region.Llx <= bbox.Urx &&
+			bbox.Lly <= region.Ury && region.Lly <= bbox.Ury
+	default:
+		x, y := (bbox.Llx+bbox.Urx)/2, (bbox.Lly+bbox.Ury)/2
+		return region.Llx <= x && x <= region.Urx && region.Lly <= y && y <= region.Ury
+	}
+}
+
+// normalizedRect returns `r` with Llx <= Urx and Lly <= Ury.
+func normalizedRect(r model.PdfRectangle) model.PdfRectangle {
+	if r.Llx > r.Urx {
+		r.Llx, r.Urx = r.Urx, r.Llx
+	}
+	if r.Lly > r.Ury {
+		r.Lly, r.Ury = r.Ury, r.Lly
+	}
+	return r
+}
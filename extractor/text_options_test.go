@@ -0,0 +1,124 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/model"
+)
+
+// optionsTestContents is a content stream with a header, a footer, a body with a red word and
+// a total box, and rotated text in the margin. The text is 10 point Courier, so each character
+// is 6 points wide.
+const optionsTestContents = `
+	BT
+	/UniDocCourier 10 Tf
+	1 0 0 1 100 770 Tm (Header) Tj
+	1 0 0 1 100 700 Tm (Body text) Tj
+	1 0 0 1 100 688 Tm (in ) Tj 1 0 0 rg (red) Tj 0 g
+	1 0 0 1 400 600 Tm (Total: 42) Tj
+	1 0 0 1 100 20 Tm (Page 1) Tj
+	0 1 -1 0 30 300 Tm (Margin) Tj
+	ET
+`
+
+// extractWithOptions returns the text extracted from optionsTestContents with options `options`.
+func extractWithOptions(t *testing.T, options *TextExtractOptions) string {
+	e := newTestExtractor(optionsTestContents)
+	e.textOptions = options
+	text, err := e.ExtractText()
+	require.NoError(t, err)
+	return text
+}
+
+func TestTextExtractOptions(t *testing.T) {
+	// No options.
+	text := extractWithOptions(t, nil)
+	require.Contains(t, text, "Header")
+	require.Contains(t, text, "Margin")
+
+	// Header and footer regions are excluded.
+	text = extractWithOptions(t, &TextExtractOptions{
+		ExcludeRegions: []model.PdfRectangle{r(0, 750, 600, 800), r(0, 0, 600, 50)},
+	})
+	require.NotContains(t, text, "Header")
+	require.NotContains(t, text, "Page 1")
+	require.Contains(t, text, "Body text\nin red")
+	require.Contains(t, text, "Total: 42")
+
+	// Only the total box is included.
+	text = extractWithOptions(t, &TextExtractOptions{
+		IncludeRegions: []model.PdfRectangle{r(390, 590, 500, 620)},
+	})
+	require.Equal(t, "Total: 42\n\n", text)
+
+	// Regions may be specified with any corners.
+	text = extractWithOptions(t, &TextExtractOptions{
+		IncludeRegions: []model.PdfRectangle{r(500, 620, 390, 590)},
+	})
+	require.Equal(t, "Total: 42\n\n", text)
+
+	// A region whose right edge is 1 point into the "2" of "Total: 42".
+	region := []model.PdfRectangle{r(390, 590, 400+8*6+1, 620)}
+	text = extractWithOptions(t, &TextExtractOptions{IncludeRegions: region})
+	require.Equal(t, "Total: 4\n\n", text)
+	text = extractWithOptions(t, &TextExtractOptions{IncludeRegions: region,
+		RegionPolicy: RegionPolicyContained})
+	require.Equal(t, "Total: 4\n\n", text)
+	text = extractWithOptions(t, &TextExtractOptions{IncludeRegions: region,
+		RegionPolicy: RegionPolicyOverlap})
+	require.Equal(t, "Total: 42\n\n", text)
+
+	// A region whose right edge is 1 point short of the end of the "2".
+	region = []model.PdfRectangle{r(390, 590, 400+9*6-1, 620)}
+	text = extractWithOptions(t, &TextExtractOptions{IncludeRegions: region})
+	require.Equal(t, "Total: 42\n\n", text)
+	text = extractWithOptions(t, &TextExtractOptions{IncludeRegions: region,
+		RegionPolicy: RegionPolicyContained})
+	require.Equal(t, "Total: 4\n\n", text)
+
+	// Mark filters.
+	text = extractWithOptions(t, &TextExtractOptions{
+		MarkFilter: func(mark TextMark) bool {
+			r, g, b, _ := mark.FillColor.RGBA()
+			return r > 0 && g == 0 && b == 0
+		},
+	})
+	require.Equal(t, "red\n\n", text)
+
+	text = extractWithOptions(t, &TextExtractOptions{
+		MarkFilter: func(mark TextMark) bool { return mark.Orientation != 0 },
+	})
+	require.Equal(t, "Margin\n\n", text)
+
+	// Regions and filters are combined. The words are assembled from the selected marks only, so
+	// the gaps left by the removed marks separate words.
+	text = extractWithOptions(t, &TextExtractOptions{
+		IncludeRegions: []model.PdfRectangle{r(0, 650, 600, 720)},
+		MarkFilter:     func(mark TextMark) bool { return mark.Text != "e" },
+	})
+	require.Equal(t, "Body t xt\nin r d\n\n", text)
+}
+
+func TestTextExtractOptionsStats(t *testing.T) {
+	// The characters that are not selected are not counted.
+	e := newTestExtractor(optionsTestContents)
+	_, numChars, numMisses, err := e.ExtractTextWithStats()
+	require.NoError(t, err)
+	require.Equal(t, 42, numChars)
+	require.Zero(t, numMisses)
+
+	e = newTestExtractor(optionsTestContents)
+	e.textOptions = &TextExtractOptions{IncludeRegions: []model.PdfRectangle{r(390, 590, 500, 620)}}
+	text, numChars, numMisses, err := e.ExtractTextWithStats()
+	require.NoError(t, err)
+	require.Equal(t, "Total: 42\n\n", text)
+	require.Equal(t, len("Total: 42"), numChars)
+	require.Zero(t, numMisses)
+}
@@ -15,7 +15,8 @@ import (
 	"github.com/showntop/unipdf/model"
 )
 
-// makeTextPage builds a paraList from `marks`, the textMarks on a page.
+// makeTextPage builds a paraList from `marks`, the textMarks on a page, and `rulings`, the ruling
+// lines on the page.
 // The paraList contains the page arranged as
 //   - a list of texPara in reading order
 //   - each textPara contains list of textLine (text lines or parts of text lines) in reading order
@@ -40,9 +41,10 @@ import (
 //  1. Group the textMarks into textWords based on their bounding boxes.
 //  2. Group the textWords into textParas based on their bounding boxes.
 //  3. Detect textParas arranged as cells in a table and convert each one to a textPara containing a
-//     textTable.
+//     textTable. Tables delimited by `rulings` are detected before step 2 and their textWords are
+//     not grouped into textParas.
 //  4. Sort the textParas in reading order.
-func makeTextPage(marks []*textMark, pageSize model.PdfRectangle) paraList {
+func makeTextPage(marks []*textMark, pageSize model.PdfRectangle, rulings []ruling) paraList {
 	common.Log.Trace("makeTextPage: %d elements pageSize=%.2f", len(marks), pageSize)
 	if len(marks) == 0 {
 		return nil
@@ -54,19 +56,25 @@ func makeTextPage(marks []*textMark, pageSize model.PdfRectangle) paraList {
 		return nil
 	}
 
-	// Put the word fragments into a container that facilitates the grouping of words into paragraphs.
-	pageWords := makeWordBag(words, pageSize.Ury)
+	// Find the tables delimited by ruling lines and remove their word fragments.
+	ruledTables, words := extractRuledTables(words, rulings, pageSize.Ury)
 
-	// Divide the page into rectangular regions for each paragraph and creata a wordBag for each one.
-	paraWords := dividePage(pageWords, pageSize.Ury)
-	paraWords = mergeWordBags(paraWords)
+	var paras paraList
+	if len(words) > 0 {
+		// Put the word fragments into a container that facilitates the grouping of words into paragraphs.
+		pageWords := makeWordBag(words, pageSize.Ury)
 
-	// Arrange the contents of each paragraph wordBag into lines and the lines into whole words.
-	paras := make(paraList, 0, len(paraWords))
-	for _, bag := range paraWords {
-		para := bag.arrangeText()
-		if para != nil {
-			paras = append(paras, para)
+		// Divide the page into rectangular regions for each paragraph and creata a wordBag for each one.
+		paraWords := dividePage(pageWords, pageSize.Ury)
+		paraWords = mergeWordBags(paraWords)
+
+		// Arrange the contents of each paragraph wordBag into lines and the lines into whole words.
+		paras = make(paraList, 0, len(paraWords)+len(ruledTables))
+		for _, bag := range paraWords {
+			para := bag.arrangeText()
+			if para != nil {
+				paras = append(paras, para)
+			}
 		}
 	}
 
@@ -74,6 +82,7 @@ func makeTextPage(marks []*textMark, pageSize model.PdfRectangle) paraList {
 	if len(paras) >= minTableParas {
 		paras = paras.extractTables()
 	}
+	paras = append(paras, ruledTables...)
 
 	// Sort the paras into reading order.
 	paras.sortReadingOrder()
@@ -64,7 +64,7 @@ func (p *textPara) depth() float64 {
 		return p.lines[0].depth
 	}
 	// Use the top left cell of the table if there is one
-	return p.table.get(0, 0).depth()
+	return p.table.depth()
 }
 
 // text is a convenience function that returns the text `p` including tables.
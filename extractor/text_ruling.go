@@ -0,0 +1,211 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/showntop/unipdf/contentstream"
+	"github.com/showntop/unipdf/internal/transform"
+	"github.com/showntop/unipdf/model"
+)
+
+// ruling is a horizontal or vertical line drawn on a page, such as a border of a table cell.
+// Rulings are drawn as stroked line segments or as thin filled rectangles.
+// All coordinates are page (default user space) coordinates.
+type ruling struct {
+	vertical bool    // Is the ruling vertical?
+	primary  float64 // x for vertical rulings, y for horizontal rulings.
+	lo, hi   float64 // The extent of the ruling: y for vertical rulings, x for horizontal rulings.
+}
+
+// String returns a description of `r`.
+func (r ruling) String() string {
+	if r.vertical {
+		return fmt.Sprintf("vertical x=%.2f y=%.2f-%.2f", r.primary, r.lo, r.hi)
+	}
+	return fmt.Sprintf("horizontal y=%.2f x=%.2f-%.2f", r.primary, r.lo, r.hi)
+}
+
+// covers returns true if `r` covers the point `v` along its extent.
+func (r ruling) covers(v float64) bool {
+	return r.lo-rulingTol <= v && v <= r.hi+rulingTol
+}
+
+// crosses returns true if horizontal ruling `r` and vertical ruling `v` intersect.
+func (r ruling) crosses(v ruling) bool {
+	return r.covers(v.primary) && v.covers(r.primary)
+}
+
+// pathRulings collects the rulings drawn by the path construction and painting operators of a
+// content stream.
+type pathRulings struct {
+	path    pathBuilder // The path being constructed, in page coordinates.
+	rulings []ruling    // The rulings found so far.
+}
+
+// handleOp updates `pr` for path construction or painting operator `op`, which is drawn with
+// current transformation matrix `ctm`.
+func (pr *pathRulings) handleOp(op *contentstream.ContentStreamOperation, ctm transform.Matrix) {
+	if ok, _ := pr.path.handleOp(op, ctm); ok {
+		return
+	}
+
+	switch op.Operand {
+	case "s":
+		pr.path.closePath()
+		pr.stroke()
+		pr.path.reset()
+	case "S":
+		pr.stroke()
+		pr.path.reset()
+	case "b", "b*":
+		pr.path.closePath()
+		pr.stroke()
+		pr.fill()
+		pr.path.reset()
+	case "B", "B*":
+		pr.stroke()
+		pr.fill()
+		pr.path.reset()
+	case "f", "F", "f*":
+		pr.fill()
+		pr.path.reset()
+	case "n":
+		pr.path.reset()
+	}
+}
+
+// stroke adds the horizontal and vertical line segments of the current path to the rulings.
+// Curves are not rulings.
+func (pr *pathRulings) stroke() {
+	for _, sp := range pr.path.path() {
+		for _, seg := range sp.Segments {
+			if seg.Curve {
+				continue
+			}
+			p0, p1 := seg.P0, seg.P3
+			dx, dy := math.Abs(p1.X-p0.X), math.Abs(p1.Y-p0.Y)
+			switch {
+			case dy <= rulingTol && dx >= minRulingLength:
+				pr.rulings = append(pr.rulings, ruling{
+					primary: (p0.Y + p1.Y) / 2,
+					lo:      math.Min(p0.X, p1.X),
+					hi:      math.Max(p0.X, p1.X),
+				})
+			case dx <= rulingTol && dy >= minRulingLength:
+				pr.rulings = append(pr.rulings, ruling{
+					vertical: true,
+					primary:  (p0.X + p1.X) / 2,
+					lo:       math.Min(p0.Y, p1.Y),
+					hi:       math.Max(p0.Y, p1.Y),
+				})
+			}
+		}
+	}
+}
+
+// fill adds the filled rectangles of the current path that are thin enough to be lines to the
+// rulings.
+func (pr *pathRulings) fill() {
+	for _, sp := range pr.path.path() {
+		rect, ok := subpathRectangle(sp)
+		if !ok {
+			continue
+		}
+		w, h := rect.Width(), rect.Height()
+		switch {
+		case h <= maxRulingThickness && w >= minRulingLength:
+			pr.rulings = append(pr.rulings, ruling{
+				primary: (rect.Lly + rect.Ury) / 2,
+				lo:      rect.Llx,
+				hi:      rect.Urx,
+			})
+		case w <= maxRulingThickness && h >= minRulingLength:
+			pr.rulings = append(pr.rulings, ruling{
+				vertical: true,
+				primary:  (rect.Llx + rect.Urx) / 2,
+				lo:       rect.Lly,
+				hi:       rect.Ury,
+			})
+		}
+	}
+}
+
+// subpathRectangle returns the bounding box of `sp` and true if `sp` is an axis-aligned
+// rectangle. Filled subpaths are implicitly closed.
+func subpathRectangle(sp Subpath) (model.PdfRectangle, bool) {
+	if len(sp.Segments) < 2 {
+		return model.PdfRectangle{}, false
+	}
+	points := sp.Points().Points
+	if start := points[0]; points[len(points)-1] != start {
+		points = append(points, start)
+	}
+	for _, seg := range sp.Segments {
+		if seg.Curve {
+			return model.PdfRectangle{}, false
+		}
+	}
+	for i := 1; i < len(points); i++ {
+		p0, p1 := points[i-1], points[i]
+		if math.Abs(p1.X-p0.X) > rulingTol && math.Abs(p1.Y-p0.Y) > rulingTol {
+			return model.PdfRectangle{}, false
+		}
+	}
+	return pointsBBox(points), true
+}
+
+// mergeRulings returns the horizontal and vertical rulings in `rulings` with the overlapping
+// collinear rulings joined.
+func mergeRulings(rulings []ruling) (horizontals, verticals []ruling) {
+	for _, r := range rulings {
+		if r.vertical {
+			verticals = append(verticals, r)
+		} else {
+			horizontals = append(horizontals, r)
+		}
+	}
+	return mergeCollinear(horizontals), mergeCollinear(verticals)
+}
+
+// mergeCollinear joins the overlapping collinear rulings in `rulings`, which all have the same
+// direction.
+func mergeCollinear(rulings []ruling) []ruling {
+	if len(rulings) == 0 {
+		return nil
+	}
+	sort.Slice(rulings, func(i, j int) bool {
+		ri, rj := rulings[i], rulings[j]
+		if ri.primary != rj.primary {
+			return ri.primary < rj.primary
+		}
+		return ri.lo < rj.lo
+	})
+
+	var merged []ruling
+	for _, r := range rulings {
+		joined := false
+		for i := len(merged) - 1; i >= 0; i-- {
+			m := &merged[i]
+			if r.primary-m.primary > rulingTol {
+				break
+			}
+			if r.lo <= m.hi+rulingTol && m.lo <= r.hi+rulingTol {
+				m.lo = math.Min(m.lo, r.lo)
+				m.hi = math.Max(m.hi, r.hi)
+				joined = true
+				break
+			}
+		}
+		if !joined {
+			merged = append(merged, r)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,394 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/showntop/unipdf/common"
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+)
+
+// SearchOptions contains options for controlling text search.
+type SearchOptions struct {
+	// Regexp indicates that the query is a regular expression with the syntax
+	// of the regexp package. Otherwise the query is literal text. Regular
+	// expressions are not normalized like literal queries (see
+	// PageText.Search).
+	Regexp bool
+
+	// IgnoreCase makes the search case insensitive.
+	IgnoreCase bool
+
+	// IgnoreDiacritics makes the search ignore diacritics, so that "cafe"
+	// matches "café". Compatibility characters such as ligatures are also
+	// decomposed, so that "fi" matches "ﬁ".
+	IgnoreDiacritics bool
+
+	// Pages contains the (1-offset) numbers of the pages searched by
+	// SearchDocument. All pages are searched if it is empty.
+	Pages []int
+}
+
+// SearchHit is a match of a search query in the text of a page.
+type SearchHit struct {
+	// PageNumber is the (1-offset) number of the page of the match. It is 0
+	// for matches found by PageText.Search.
+	PageNumber int
+
+	// Start and End are the offsets of the match in the page text, so the
+	// matched text is PageText.Text()[Start:End]. The TextMarks of the match
+	// are PageText.Marks().RangeOffset(Start, End).
+	Start, End int
+
+	// Text is the matched page text.
+	Text string
+
+	// Quads contains a quadrilateral for each line of the matched text, in
+	// reading order.
+	Quads []Quad
+}
+
+// Quad is a quadrilateral enclosing text, in the order of the QuadPoints of
+// text markup annotations such as PdfAnnotationHighlight: the x and y
+// coordinates of the upper left, upper right, lower left and lower right
+// corners.
+type Quad [8]float64
+
+// newQuad returns the Quad of rectangle `r`.
+func newQuad(r model.PdfRectangle) Quad {
+	return Quad{r.Llx, r.Ury, r.Urx, r.Ury, r.Llx, r.Lly, r.Urx, r.Lly}
+}
+
+// BBox returns the bounding box of `q`.
+func (q Quad) BBox() model.PdfRectangle {
+	r := model.PdfRectangle{Llx: q[0], Lly: q[1], Urx: q[0], Ury: q[1]}
+	for i := 2; i < len(q); i += 2 {
+		r = rectUnion(r, model.PdfRectangle{Llx: q[i], Lly: q[i+1], Urx: q[i], Ury: q[i+1]})
+	}
+	return r
+}
+
+// QuadPoints returns the quads of `h` as a QuadPoints array for text markup
+// annotations such as PdfAnnotationHighlight.
+func (h SearchHit) QuadPoints() *core.PdfObjectArray {
+	var points []float64
+	for _, q := range h.Quads {
+		points = append(points, q[:]...)
+	}
+	return core.MakeArrayFromFloats(points)
+}
+
+// BBox returns the bounding box of the quads of `h`.
+func (h SearchHit) BBox() (model.PdfRectangle, bool) {
+	if len(h.Quads) == 0 {
+		return model.PdfRectangle{}, false
+	}
+	r := h.Quads[0].BBox()
+	for _, q := range h.Quads[1:] {
+		r = rectUnion(r, q.BBox())
+	}
+	return r, true
+}
+
+// SearchDocument returns the matches of `query` in the text of the pages of
+// `reader`, in page order. `options` can be nil for the default options, a
+// case and diacritic sensitive literal search of all pages.
+func SearchDocument(reader *model.PdfReader, query string, options *SearchOptions) ([]SearchHit, error) {
+	matcher, err := newSearchMatcher(query, options)
+	if err != nil {
+		return nil, err
+	}
+
+	pageNums := matcher.options.Pages
+	if len(pageNums) == 0 {
+		numPages, err := reader.GetNumPages()
+		if err != nil {
+			return nil, err
+		}
+		for pageNum := 1; pageNum <= numPages; pageNum++ {
+			pageNums = append(pageNums, pageNum)
+		}
+	}
+
+	var hits []SearchHit
+	for _, pageNum := range pageNums {
+		page, err := reader.GetPage(pageNum)
+		if err != nil {
+			return nil, err
+		}
+		e, err := New(page)
+		if err != nil {
+			return nil, err
+		}
+		pageText, _, _, err := e.ExtractPageText()
+		if err != nil {
+			return nil, err
+		}
+		pageHits := matcher.search(pageText)
+		for i := range pageHits {
+			pageHits[i].PageNumber = pageNum
+		}
+		hits = append(hits, pageHits...)
+	}
+	return hits, nil
+}
+
+// Search returns the matches of `query` in the text of `pt`. `options` can be
+// nil for the default options, a case and diacritic sensitive literal search.
+// The PageNumber of the matches is 0.
+//
+// Runs of whitespace in the page text and literal queries are treated as
+// single spaces, so matches may span line breaks. Words split by a soft hyphen
+// (U+00AD) at a line break are joined. Words split by a hyphen-minus at a line
+// break are matched both joined and hyphenated, so that "self-\nservice" is
+// matched by "selfservice" and by "self-service".
+//
+// Regular expression queries are not normalized: they are matched against
+// the normalized page text, in which runs of whitespace are single spaces.
+func (pt PageText) Search(query string, options *SearchOptions) ([]SearchHit, error) {
+	matcher, err := newSearchMatcher(query, options)
+	if err != nil {
+		return nil, err
+	}
+	return matcher.search(&pt), nil
+}
+
+// searchMatcher finds the matches of a query in page texts.
+type searchMatcher struct {
+	options *SearchOptions
+	literal string         // The normalized literal query.
+	re      *regexp.Regexp // The regular expression query.
+}
+
+// newSearchMatcher returns a searchMatcher for `query` with options `options`.
+func newSearchMatcher(query string, options *SearchOptions) (*searchMatcher, error) {
+	if options == nil {
+		options = &SearchOptions{}
+	}
+	m := &searchMatcher{options: options}
+	if options.Regexp {
+		pattern := query
+		if options.IgnoreDiacritics {
+			pattern = foldDiacritics(pattern)
+		}
+		if options.IgnoreCase {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		m.re = re
+		return m, nil
+	}
+	m.literal = strings.TrimSpace(newSearchText(query, options, false).text)
+	if m.literal == "" {
+		return nil, errors.New("empty search query")
+	}
+	return m, nil
+}
+
+// search returns the matches of `m` in `pt`, in page text order.
+func (m *searchMatcher) search(pt *PageText) []SearchHit {
+	text := pt.Text()
+
+	// The page text is searched with the words hyphenated at line breaks
+	// joined and, if there are any, with the words kept hyphenated.
+	texts := []searchText{newSearchText(text, m.options, true)}
+	if texts[0].hyphenBreaks {
+		texts = append(texts, newSearchText(text, m.options, false))
+	}
+
+	// The page text ranges of the matches.
+	var ranges [][2]int
+	seen := map[[2]int]bool{}
+	for _, st := range texts {
+		for _, match := range m.find(st.text) {
+			if match[1] <= match[0] {
+				continue
+			}
+			r := [2]int{st.starts[match[0]], st.ends[match[1]-1]}
+			if !seen[r] {
+				seen[r] = true
+				ranges = append(ranges, r)
+			}
+		}
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i][0] != ranges[j][0] {
+			return ranges[i][0] < ranges[j][0]
+		}
+		return ranges[i][1] < ranges[j][1]
+	})
+
+	marks := pt.Marks()
+	var hits []SearchHit
+	for _, r := range ranges {
+		start, end := r[0], r[1]
+		hit := SearchHit{Start: start, End: end, Text: text[start:end]}
+		hitMarks, err := marks.RangeOffset(start, end)
+		if err != nil {
+			common.Log.Debug("ERROR: search hit %d-%d has no marks. err=%v", start, end, err)
+		} else {
+			hit.Quads = lineQuads(hitMarks.Elements())
+		}
+		hits = append(hits, hit)
+	}
+	return hits
+}
+
+// find returns the offsets of the matches of `m` in the normalized text `text`.
+func (m *searchMatcher) find(text string) [][]int {
+	if m.re != nil {
+		return m.re.FindAllStringIndex(text, -1)
+	}
+	var matches [][]int
+	for offset := 0; offset < len(text); {
+		i := strings.Index(text[offset:], m.literal)
+		if i < 0 {
+			break
+		}
+		start := offset + i
+		offset = start + len(m.literal)
+		matches = append(matches, []int{start, offset})
+	}
+	return matches
+}
+
+// lineQuads returns a Quad for each line of the text of `marks`. Lines end at the newlines
+// between lines and at the gaps between marks that are not aligned horizontally or vertically.
+func lineQuads(marks []TextMark) []Quad {
+	var quads []Quad
+	var line model.PdfRectangle
+	inLine := false
+	for _, tm := range marks {
+		if tm.Meta {
+			if inLine && strings.ContainsAny(tm.Text, "\n") {
+				quads = append(quads, newQuad(line))
+				inLine = false
+			}
+			continue
+		}
+		if isTextSpace(tm.Text) {
+			continue
+		}
+		if inLine && !intersectsX(line, tm.BBox) && !intersectsY(line, tm.BBox) {
+			quads = append(quads, newQuad(line))
+			inLine = false
+		}
+		if inLine {
+			line = rectUnion(line, tm.BBox)
+		} else {
+			line = tm.BBox
+			inLine = true
+		}
+	}
+	if inLine {
+		quads = append(quads, newQuad(line))
+	}
+	return quads
+}
+
+// searchText is the normalized text of a page that is searched.
+// Byte i of `text` was produced from page text[starts[i]:ends[i]].
+type searchText struct {
+	text   string
+	starts []int
+	ends   []int
+
+	// hyphenBreaks is true if the page text has words split by a hyphen-minus at a line break.
+	hyphenBreaks bool
+}
+
+// newSearchText returns the searchText of `text` normalized for `options`. Runs of whitespace
+// are replaced by single spaces, words split by a soft hyphen at a line break are joined and
+// the text is folded as specified by `options`. Words split by a hyphen-minus at a line break
+// are joined if `joinHyphens` is true, and kept hyphenated without the line break otherwise.
+func newSearchText(text string, options *SearchOptions, joinHyphens bool) searchText {
+	var b strings.Builder
+	var starts, ends []int
+	hyphenBreaks := false
+	add := func(s string, start, end int) {
+		b.WriteString(s)
+		for i := 0; i < len(s); i++ {
+			starts = append(starts, start)
+			ends = append(ends, end)
+		}
+	}
+	// skipSpace returns the offset of the first non-space rune in `text` at or after `i` and
+	// whether the spaces skipped contain a line break.
+	skipSpace := func(i int) (int, bool) {
+		eol := false
+		for i < len(text) {
+			r, size := utf8.DecodeRuneInString(text[i:])
+			if !unicode.IsSpace(r) {
+				break
+			}
+			eol = eol || r == '\n' || r == '\r'
+			i += size
+		}
+		return i, eol
+	}
+
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		switch {
+		case unicode.IsSpace(r):
+			j, _ := skipSpace(i)
+			add(" ", i, j)
+			i = j
+			continue
+		case r == '\u00ad':
+			// Soft hyphens are not visible. At line breaks, they join words.
+			i += size
+			if j, eol := skipSpace(i); eol {
+				i = j
+			}
+			continue
+		case r == '-':
+			if j, eol := skipSpace(i + size); eol {
+				hyphenBreaks = true
+				if !joinHyphens {
+					add("-", i, i+size)
+				}
+				i = j
+				continue
+			}
+		}
+		s := string(r)
+		if options.IgnoreDiacritics {
+			s = foldDiacritics(s)
+		}
+		if options.IgnoreCase {
+			s = strings.ToLower(s)
+		}
+		add(s, i, i+size)
+		i += size
+	}
+	return searchText{text: b.String(), starts: starts, ends: ends, hyphenBreaks: hyphenBreaks}
+}
+
+// foldDiacritics returns `s` with compatibility characters decomposed and diacritics removed.
+func foldDiacritics(s string) string {
+	decomposed := norm.NFKD.String(s)
+	var b strings.Builder
+	for _, r := range decomposed {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,168 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/creator"
+	"github.com/showntop/unipdf/model"
+)
+
+// extractTestPageText returns the text of the page having content stream `contents`.
+func extractTestPageText(t *testing.T, contents string) *PageText {
+	pageText, _, _, err := newTestExtractor(contents).ExtractPageText()
+	require.NoError(t, err)
+	return pageText
+}
+
+func TestSearch(t *testing.T) {
+	pageText := extractTestPageText(t, `
+		BT
+		/UniDocCourier 10 Tf
+		100 700 Td
+		(The Caf\351 on the) Tj
+		0 -12 Td
+		(corner serves coffee.) Tj
+		ET
+	`)
+	require.Equal(t, "The Café on the\ncorner serves coffee.\n\n", pageText.Text())
+
+	// Literal match spanning a line break.
+	hits, err := pageText.Search("on the  corner", nil)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	hit := hits[0]
+	require.Equal(t, "on the\ncorner", hit.Text)
+	require.Equal(t, hit.Text, pageText.Text()[hit.Start:hit.End])
+	require.Len(t, hit.Quads, 2)
+	require.InDelta(t, 100+9*6, hit.Quads[0][0], 0.01)
+	require.InDelta(t, 100+15*6, hit.Quads[0][2], 0.01)
+	require.InDelta(t, 100, hit.Quads[1][4], 0.01)
+	require.InDelta(t, 100+6*6, hit.Quads[1][6], 0.01)
+	require.True(t, hit.Quads[0][1] > hit.Quads[0][5]) // Upper left is above lower left.
+	require.True(t, hit.Quads[1][1] < hit.Quads[0][5]) // Second line is below the first.
+	require.Equal(t, 16, hit.QuadPoints().Len())
+
+	// Case and diacritic folding.
+	hits, err = pageText.Search("CAFE", nil)
+	require.NoError(t, err)
+	require.Empty(t, hits)
+	hits, err = pageText.Search("CAFE", &SearchOptions{IgnoreCase: true, IgnoreDiacritics: true})
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	require.Equal(t, "Café", hits[0].Text)
+	bbox, ok := hits[0].BBox()
+	require.True(t, ok)
+	require.InDelta(t, 4*6, bbox.Width(), 0.01)
+
+	// Regular expressions.
+	hits, err = pageText.Search(`co\w+`, &SearchOptions{Regexp: true})
+	require.NoError(t, err)
+	require.Len(t, hits, 2)
+	require.Equal(t, "corner", hits[0].Text)
+	require.Equal(t, "coffee", hits[1].Text)
+
+	_, err = pageText.Search(`co(`, &SearchOptions{Regexp: true})
+	require.Error(t, err)
+	_, err = pageText.Search(" ", nil)
+	require.Error(t, err)
+}
+
+func TestSearchText(t *testing.T) {
+	st := newSearchText("well-\n known  \ufb01sh", &SearchOptions{IgnoreCase: true, IgnoreDiacritics: true}, true)
+	require.Equal(t, "wellknown fish", st.text)
+	require.True(t, st.hyphenBreaks)
+	require.Equal(t, len(st.text), len(st.starts))
+	require.Equal(t, 7, st.starts[4])  // The "k" of "known".
+	require.Equal(t, 12, st.starts[9]) // The spaces between "known" and "ﬁsh".
+	require.Equal(t, 14, st.ends[9])
+	require.Equal(t, 14, st.starts[10]) // Both "f" and "i" are from the ligature.
+	require.Equal(t, 14, st.starts[11])
+	require.Equal(t, 17, st.ends[11])
+
+	// Hyphens at line breaks are kept if they are not joined.
+	st = newSearchText("well-\n known", &SearchOptions{}, false)
+	require.Equal(t, "well-known", st.text)
+	require.Equal(t, 4, st.starts[4])
+	require.Equal(t, 7, st.starts[5])
+
+	// Hyphens that are not at line breaks are kept.
+	st = newSearchText("well-known", &SearchOptions{}, true)
+	require.Equal(t, "well-known", st.text)
+	require.False(t, st.hyphenBreaks)
+
+	// Soft hyphens are removed, and join words at line breaks.
+	require.Equal(t, "wellknown", newSearchText("well\u00ad\nknown", &SearchOptions{}, false).text)
+	require.Equal(t, "well known", newSearchText("well\u00ad known", &SearchOptions{}, false).text)
+}
+
+func TestSearchHyphenated(t *testing.T) {
+	pageText := extractTestPageText(t, `
+		BT
+		/UniDocCourier 10 Tf
+		100 700 Td
+		(co-) Tj
+		0 -12 Td
+		(operative shop.) Tj
+		ET
+	`)
+	require.Equal(t, "co-\noperative shop.\n\n", pageText.Text())
+
+	// Words hyphenated at line breaks are matched hyphenated and joined.
+	for _, query := range []string{"co-operative", "cooperative"} {
+		hits, err := pageText.Search(query, nil)
+		require.NoError(t, err)
+		require.Len(t, hits, 1, query)
+		require.Equal(t, "co-\noperative", hits[0].Text)
+		require.Len(t, hits[0].Quads, 2)
+	}
+
+	// Matches found in both forms are returned once.
+	hits, err := pageText.Search("operative", nil)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	require.Equal(t, 4, hits[0].Start)
+
+	// Regular expressions are matched against the normalized text.
+	hits, err = pageText.Search(`co-?operative shop`, &SearchOptions{Regexp: true})
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	require.Equal(t, "co-\noperative shop", hits[0].Text)
+	hits, err = pageText.Search(`co-\noperative`, &SearchOptions{Regexp: true})
+	require.NoError(t, err)
+	require.Empty(t, hits)
+}
+
+func TestSearchDocument(t *testing.T) {
+	c := creator.New()
+	for _, text := range []string{"First page", "Second page", "Third page"} {
+		c.NewPage()
+		require.NoError(t, c.Draw(c.NewParagraph(text)))
+	}
+	var buf bytes.Buffer
+	require.NoError(t, c.Write(&buf))
+	reader, err := model.NewPdfReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	hits, err := SearchDocument(reader, "page", nil)
+	require.NoError(t, err)
+	require.Len(t, hits, 3)
+	for i, hit := range hits {
+		require.Equal(t, i+1, hit.PageNumber)
+		require.Equal(t, "page", hit.Text)
+		require.Len(t, hit.Quads, 1)
+	}
+
+	hits, err = SearchDocument(reader, "page", &SearchOptions{Pages: []int{3, 1}})
+	require.NoError(t, err)
+	require.Len(t, hits, 2)
+	require.Equal(t, 3, hits[0].PageNumber)
+	require.Equal(t, 1, hits[1].PageNumber)
+}
@@ -0,0 +1,297 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"github.com/showntop/unipdf/common"
+	"github.com/showntop/unipdf/core"
+)
+
+// maxStructDepth is the maximum depth of the structure tree that is traversed.
+const maxStructDepth = 100
+
+// inlineStructTypes are the standard inline-level structure types. Their content is part of the
+// block of the enclosing block-level structure element.
+var inlineStructTypes = map[string]bool{
+	"Span": true, "Quote": true, "Note": true, "Reference": true, "BibEntry": true,
+	"Code": true, "Link": true, "Annot": true, "Ruby": true, "RB": true, "RT": true,
+	"RP": true, "Warichu": true, "WT": true, "WP": true,
+}
+
+// pageStructure is the logical structure of the content of a page, from the structure tree of
+// the document.
+type pageStructure struct {
+	numBlocks int                    // The number of blocks of page content.
+	mcids     map[mcidKey]mcidStruct // The structure of the content with each MCID.
+}
+
+// mcidKey identifies marked content by its MCID in the content stream containing it. MCIDs are
+// local to the content streams of the page and of the form XObjects drawn on it.
+type mcidKey struct {
+	stream int64 // The object number of the form XObject. 0 for the content of the page.
+	mcid   int
+}
+
+// mcidStruct is the structure of the content with a marked-content identifier.
+type mcidStruct struct {
+	block  int            // The index of the block containing the content, in logical order.
+	lang   string         // The language of the enclosing structure elements.
+	alt    string         // The alternate description of the enclosing structure elements.
+	actual *markedContent // The ActualText of the enclosing structure elements.
+}
+
+// docStructure is the logical structure of the content of the pages of a document, from the
+// structure tree of the document, by object number of the page objects.
+type docStructure map[int64]*pageStructure
+
+// structContext is the state inherited by structure elements from their ancestors.
+type structContext struct {
+	pageObj int64          // The object number of the page containing the content.
+	stream  int64          // The object number of the form XObject containing the content, or 0.
+	block   structBlock    // The block of the enclosing block-level element.
+	lang    string         // The inherited language.
+	alt     string         // The inherited alternate description.
+	actual  *markedContent // The inherited replacement text.
+}
+
+// structBlock is the block of a block-level structure element on each page with content of the
+// element, by object number of the page objects.
+type structBlock map[int64]int
+
+// newDocStructure returns the logical structure of the content of the pages of a document from
+// structure tree root `root`. The structure tree is traversed once for all the pages.
+func newDocStructure(root core.PdfObject) docStructure {
+	rootDict, ok := core.GetDict(root)
+	if !ok {
+		return docStructure{}
+	}
+	w := structWalker{
+		roleMap:   rootDict.Get("RoleMap"),
+		visited:   map[*core.PdfObjectDictionary]bool{},
+		structure: docStructure{},
+	}
+	w.walkKids(rootDict.Get("K"), structContext{pageObj: -1, block: structBlock{}}, 0)
+	return w.structure
+}
+
+// page returns the logical structure of the content of the page with indirect object `page`. It
+// returns nil if the page has no content in the structure tree.
+func (d docStructure) page(page *core.PdfIndirectObject) *pageStructure {
+	if page == nil {
+		return nil
+	}
+	return d[page.ObjectNumber]
+}
+
+// newPageStructure returns the logical structure of the content of the page with indirect object
+// `page` from structure tree root `root`. It returns nil if the page has no content in the tree.
+func newPageStructure(root core.PdfObject, page *core.PdfIndirectObject) *pageStructure {
+	return newDocStructure(root).page(page)
+}
+
+// structWalker collects the structure of the content of the pages of a document from a structure
+// tree.
+type structWalker struct {
+	roleMap   core.PdfObject
+	visited   map[*core.PdfObjectDictionary]bool
+	structure docStructure
+}
+
+// walkKids traverses `kids`, the K entry of a structure element with context `ctx`.
+func (w *structWalker) walkKids(kids core.PdfObject, ctx structContext, depth int) {
+	if depth > maxStructDepth {
+		common.Log.Debug("ERROR: structure tree is too deep")
+		return
+	}
+	kids = core.TraceToDirectObject(kids)
+	if arr, ok := kids.(*core.PdfObjectArray); ok {
+		for _, kid := range arr.Elements() {
+			w.walkKid(kid, ctx, depth)
+		}
+		return
+	}
+	w.walkKid(kids, ctx, depth)
+}
+
+// walkKid traverses `kid`, a child of a structure element with context `ctx`. Children are
+// MCIDs, marked-content references, object references or structure elements.
+func (w *structWalker) walkKid(kid core.PdfObject, ctx structContext, depth int) {
+	if mcid, ok := core.GetIntVal(kid); ok {
+		w.addMCID(mcid, ctx)
+		return
+	}
+	dict, ok := core.GetDict(kid)
+	if !ok {
+		return
+	}
+	switch typ, _ := core.GetNameVal(dict.Get("Type")); typ {
+	case "MCR":
+		if pg, ok := objectNumber(dict.Get("Pg")); ok {
+			ctx.pageObj = pg
+		}
+		if stm := dict.Get("Stm"); stm != nil {
+			// The content is in a form XObject drawn on the page.
+			stream, ok := objectNumber(stm)
+			if !ok {
+				return
+			}
+			ctx.stream = stream
+		}
+		if mcid, ok := core.GetIntVal(dict.Get("MCID")); ok {
+			w.addMCID(mcid, ctx)
+		}
+	case "OBJR":
+		// Annotations and XObjects are not text content.
+	default:
+		w.walkElement(dict, ctx, depth)
+	}
+}
+
+// walkElement traverses structure element `elem` with inherited context `ctx`.
+func (w *structWalker) walkElement(elem *core.PdfObjectDictionary, ctx structContext, depth int) {
+	if w.visited[elem] {
+		common.Log.Debug("ERROR: structure tree has a cycle")
+		return
+	}
+	w.visited[elem] = true
+
+	if pg, ok := objectNumber(elem.Get("Pg")); ok {
+		ctx.pageObj = pg
+	}
+	if s, ok := core.GetString(elem.Get("Lang")); ok {
+		ctx.lang = s.Decoded()
+	}
+	if s, ok := core.GetString(elem.Get("Alt")); ok {
+		ctx.alt = s.Decoded()
+	}
+	if s, ok := core.GetString(elem.Get("ActualText")); ok && ctx.actual == nil {
+		text := s.Decoded()
+		ctx.actual = &markedContent{mcid: -1, actualText: &text}
+	}
+	if !inlineStructTypes[w.standardType(elem)] {
+		ctx.block = structBlock{}
+	}
+	w.walkKids(elem.Get("K"), ctx, depth+1)
+}
+
+// standardType returns the standard structure type of structure element `elem`, following the
+// role map of the structure tree.
+func (w *structWalker) standardType(elem *core.PdfObjectDictionary) string {
+	typ, _ := core.GetNameVal(elem.Get("S"))
+	roleMap, ok := core.GetDict(w.roleMap)
+	if !ok {
+		return typ
+	}
+	for i := 0; i < 10; i++ {
+		mapped, ok := core.GetNameVal(roleMap.Get(core.PdfObjectName(typ)))
+		if !ok || mapped == typ {
+			break
+		}
+		typ = mapped
+	}
+	return typ
+}
+
+// addMCID adds the content with marked-content identifier `mcid` in context `ctx` to the
+// structure of the page containing it.
+func (w *structWalker) addMCID(mcid int, ctx structContext) {
+	if ctx.pageObj < 0 {
+		return
+	}
+	structure := w.structure[ctx.pageObj]
+	if structure == nil {
+		structure = &pageStructure{mcids: map[mcidKey]mcidStruct{}}
+		w.structure[ctx.pageObj] = structure
+	}
+	key := mcidKey{stream: ctx.stream, mcid: mcid}
+	if _, ok := structure.mcids[key]; ok {
+		return
+	}
+	block, ok := ctx.block[ctx.pageObj]
+	if !ok {
+		block = structure.numBlocks
+		structure.numBlocks++
+		ctx.block[ctx.pageObj] = block
+	}
+	structure.mcids[key] = mcidStruct{
+		block:  block,
+		lang:   ctx.lang,
+		alt:    ctx.alt,
+		actual: ctx.actual,
+	}
+}
+
+// objectNumber returns the object number of `obj` if it is an indirect object, a stream or a
+// reference.
+func objectNumber(obj core.PdfObject) (int64, bool) {
+	switch t := obj.(type) {
+	case *core.PdfObjectReference:
+		return t.ObjectNumber, true
+	case *core.PdfIndirectObject:
+		return t.ObjectNumber, true
+	case *core.PdfObjectStream:
+		return t.ObjectNumber, true
+	}
+	return 0, false
+}
+
+// lookup returns the structure of the content of `tm`. Content in a form XObject is looked up by
+// its MCID in the form, then by the MCID of the page sequence containing the form.
+func (s *pageStructure) lookup(tm *textMark) (mcidStruct, bool) {
+	if tm.formMCID.stream != 0 {
+		if ms, ok := s.mcids[tm.formMCID]; ok {
+			return ms, true
+		}
+	}
+	if tm.mcid < 0 {
+		return mcidStruct{}, false
+	}
+	ms, ok := s.mcids[mcidKey{mcid: tm.mcid}]
+	return ms, ok
+}
+
+// apply returns `marks` with the languages, alternate descriptions and replacement text of the
+// structure elements containing them. The properties of marked content take precedence over those
+// of structure elements.
+func (s *pageStructure) apply(marks []*textMark) []*textMark {
+	if s == nil {
+		return marks
+	}
+	applied := make([]*textMark, len(marks))
+	for i, tm := range marks {
+		ms, ok := s.lookup(tm)
+		if !ok {
+			applied[i] = tm
+			continue
+		}
+		mark := *tm
+		if mark.lang == "" {
+			mark.lang = ms.lang
+		}
+		if mark.alt == "" {
+			mark.alt = ms.alt
+		}
+		if ms.actual != nil {
+			mark.actual = ms.actual
+		}
+		applied[i] = &mark
+	}
+	return substituteActualText(applied)
+}
+
+// blocks returns `marks` divided into the blocks of the page structure, in logical order. The
+// marks that are not in the structure tree, such as artifacts, are in a final block.
+func (s *pageStructure) blocks(marks []*textMark) [][]*textMark {
+	blocks := make([][]*textMark, s.numBlocks+1)
+	for _, tm := range marks {
+		block := s.numBlocks
+		if ms, ok := s.lookup(tm); ok {
+			block = ms.block
+		}
+		blocks[block] = append(blocks[block], tm)
+	}
+	return blocks
+}
@@ -14,10 +14,13 @@ import (
 )
 
 // textTable is a table of `w` x `h` textPara cells.
+// Tables found from ruling lines may have empty cells and cells that span several rows or columns.
+// Their empty cells and the cells covered by the spans of other cells are nil.
 type textTable struct {
-	model.PdfRectangle                      // Bounding rectangle.
-	w, h               int                  // w=number of columns. h=number of rows.
-	cells              map[uint64]*textPara // The cells
+	model.PdfRectangle                         // Bounding rectangle.
+	w, h               int                     // w=number of columns. h=number of rows.
+	cells              map[uint64]*textPara    // The cells
+	geometry           map[uint64]cellGeometry // Cell bounding boxes and spans of ruled tables.
 }
 
 // String returns a description of `t`.
@@ -266,15 +269,42 @@ func (t *textTable) toTextTable() TextTable {
 	for y := 0; y < t.h; y++ {
 		cells[y] = make([]TableCell, t.w)
 		for x := 0; x < t.w; x++ {
-			c := t.get(x, y)
-			cells[y][x].Text = c.text()
-			offset := 0
-			cells[y][x].Marks.marks = c.toTextMarks(&offset)
+			cell := &cells[y][x]
+			if c := t.get(x, y); c != nil {
+				cell.Text = c.text()
+				offset := 0
+				cell.Marks.marks = c.toTextMarks(&offset)
+			}
+			cell.BBox, cell.ColSpan, cell.RowSpan = t.cellGeometry(x, y, cell.Marks)
 		}
 	}
 	return TextTable{W: t.w, H: t.h, Cells: cells}
 }
 
+// cellGeometry returns the bounding box and the numbers of columns and rows spanned by the cell
+// at `x`, `y` with marks `marks`. The bounding boxes of the cells of ruled tables are delimited
+// by the rulings. Cells covered by the spans of other cells span 0 columns and rows.
+func (t *textTable) cellGeometry(x, y int, marks TextMarkArray) (model.PdfRectangle, int, int) {
+	if t.geometry == nil {
+		bbox, _ := marks.BBox()
+		return bbox, 1, 1
+	}
+	g := t.geometry[cellIndex(x, y)]
+	return g.PdfRectangle, g.colSpan, g.rowSpan
+}
+
+// depth returns the depth of the first cell of `t` that contains text.
+func (t *textTable) depth() float64 {
+	for y := 0; y < t.h; y++ {
+		for x := 0; x < t.w; x++ {
+			if c := t.get(x, y); c != nil {
+				return c.depth()
+			}
+		}
+	}
+	return 0
+}
+
 // get returns the cell at `x`, `y`.
 func (t *textTable) get(x, y int) *textPara {
 	return t.cells[cellIndex(x, y)]
@@ -300,6 +330,10 @@ func (t *textTable) log(title string) {
 	for y := 0; y < t.h; y++ {
 		for x := 0; x < t.w; x++ {
 			p := t.get(x, y)
+			if p == nil {
+				fmt.Printf("%4d %2d: empty\n", x, y)
+				continue
+			}
 			fmt.Printf("%4d %2d: %6.2f %q\n", x, y, p.PdfRectangle, truncate(p.text(), 50))
 		}
 	}
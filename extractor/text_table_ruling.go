@@ -0,0 +1,376 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"math"
+	"sort"
+
+	"github.com/showntop/unipdf/common"
+	"github.com/showntop/unipdf/model"
+)
+
+// rulingGrid is a grid of table cells delimited by rulings.
+// The column edges `xs` increase left to right and the row edges `ys` decrease top to bottom.
+type rulingGrid struct {
+	xs, ys      []float64
+	horizontals []ruling
+	verticals   []ruling
+}
+
+// gridCell is a cell of a rulingGrid. It spans `colSpan` columns and `rowSpan` rows starting at
+// column `col` and row `row`.
+type gridCell struct {
+	model.PdfRectangle
+	col, row         int
+	colSpan, rowSpan int
+}
+
+// cellGeometry is the geometry of a cell in a table found from rulings.
+type cellGeometry struct {
+	model.PdfRectangle
+	colSpan, rowSpan int
+}
+
+// extractRuledTables finds the tables delimited by `rulings` and fills them with the word
+// fragments in `words`, which must have orientation 0.
+// It returns textParas containing the tables and the word fragments that are not in the tables.
+func extractRuledTables(words []*textWord, rulings []ruling, pageHeight float64) (paraList, []*textWord) {
+	var tables paraList
+	for _, grid := range findRulingGrids(rulings) {
+		cells, owners := grid.cells()
+		cellWords := make([][]*textWord, len(cells))
+		var rest []*textWord
+		for _, w := range words {
+			col, row, ok := grid.locate((w.Llx+w.Urx)/2, (w.Lly+w.Ury)/2)
+			if !ok {
+				rest = append(rest, w)
+				continue
+			}
+			i := owners[row*grid.numCols()+col]
+			cellWords[i] = append(cellWords[i], w)
+		}
+
+		table := grid.newTable(cells, cellWords, pageHeight)
+		if table == nil {
+			continue
+		}
+		table.log("ruled")
+		tables = append(tables, &textPara{
+			PdfRectangle: table.PdfRectangle,
+			eBBox:        table.PdfRectangle,
+			table:        table,
+		})
+		words = rest
+	}
+	return tables, words
+}
+
+// findRulingGrids returns the grids formed by the intersecting horizontal and vertical rulings
+// in `rulings`.
+func findRulingGrids(rulings []ruling) []*rulingGrid {
+	horizontals, verticals := mergeRulings(rulings)
+	if len(horizontals) < 2 || len(verticals) < 2 {
+		return nil
+	}
+
+	// Group the rulings into connected sets of intersecting rulings. Vertical ruling j has index
+	// len(horizontals)+j.
+	nh := len(horizontals)
+	sets := newUnionFind(nh + len(verticals))
+	for i, h := range horizontals {
+		for j, v := range verticals {
+			if h.crosses(v) {
+				sets.union(i, nh+j)
+			}
+		}
+	}
+	groupH := map[int][]ruling{}
+	groupV := map[int][]ruling{}
+	var roots []int
+	for i := 0; i < sets.size(); i++ {
+		root := sets.find(i)
+		if _, ok := groupH[root]; !ok {
+			if _, ok := groupV[root]; !ok {
+				roots = append(roots, root)
+			}
+		}
+		if i < nh {
+			groupH[root] = append(groupH[root], horizontals[i])
+		} else {
+			groupV[root] = append(groupV[root], verticals[i-nh])
+		}
+	}
+
+	var grids []*rulingGrid
+	for _, root := range roots {
+		if len(groupH[root]) < 2 || len(groupV[root]) < 2 {
+			continue
+		}
+		if grid := newRulingGrid(groupH[root], groupV[root]); grid != nil {
+			grids = append(grids, grid)
+		}
+	}
+	return grids
+}
+
+// newRulingGrid returns the grid formed by `horizontals` and `verticals`, or nil if they don't
+// form a grid of at least 2 cells.
+func newRulingGrid(horizontals, verticals []ruling) *rulingGrid {
+	var xs, ys []float64
+	for _, h := range horizontals {
+		ys = append(ys, h.primary)
+		xs = append(xs, h.lo, h.hi)
+	}
+	for _, v := range verticals {
+		xs = append(xs, v.primary)
+		ys = append(ys, v.lo, v.hi)
+	}
+	xs = clusterCoords(xs)
+	ys = clusterCoords(ys)
+	for i, j := 0, len(ys)-1; i < j; i, j = i+1, j-1 {
+		ys[i], ys[j] = ys[j], ys[i]
+	}
+
+	grid := &rulingGrid{xs: xs, ys: ys, horizontals: horizontals, verticals: verticals}
+	if grid.numCols() < 1 || grid.numRows() < 1 || grid.numCols()*grid.numRows() < 2 {
+		return nil
+	}
+	return grid
+}
+
+// clusterCoords returns the sorted means of the clusters of the coordinates `coords` that are
+// within rulingTol of each other.
+func clusterCoords(coords []float64) []float64 {
+	sort.Float64s(coords)
+	var clusters []float64
+	start, sum := 0, 0.0
+	for i, c := range coords {
+		if i > start && c-coords[i-1] > rulingTol {
+			clusters = append(clusters, sum/float64(i-start))
+			start, sum = i, 0.0
+		}
+		sum += c
+	}
+	if len(coords) > start {
+		clusters = append(clusters, sum/float64(len(coords)-start))
+	}
+	return clusters
+}
+
+// numCols returns the number of columns of `g`.
+func (g *rulingGrid) numCols() int {
+	return len(g.xs) - 1
+}
+
+// numRows returns the number of rows of `g`.
+func (g *rulingGrid) numRows() int {
+	return len(g.ys) - 1
+}
+
+// bbox returns the bounding box of `g`.
+func (g *rulingGrid) bbox() model.PdfRectangle {
+	return model.PdfRectangle{
+		Llx: g.xs[0],
+		Lly: g.ys[len(g.ys)-1],
+		Urx: g.xs[len(g.xs)-1],
+		Ury: g.ys[0],
+	}
+}
+
+// locate returns the column and row of the grid cell containing the point (`x`, `y`) and true
+// if the point is inside `g`.
+func (g *rulingGrid) locate(x, y float64) (int, int, bool) {
+	col := sort.SearchFloat64s(g.xs, x) - 1
+	row := sort.Search(len(g.ys), func(i int) bool { return g.ys[i] <= y }) - 1
+	if col < 0 || col >= g.numCols() || row < 0 || row >= g.numRows() {
+		return 0, 0, false
+	}
+	return col, row, true
+}
+
+// hasVertical returns true if there is a ruling at x=`x` covering y=`y`.
+func (g *rulingGrid) hasVertical(x, y float64) bool {
+	for _, v := range g.verticals {
+		if math.Abs(v.primary-x) <= rulingTol && v.covers(y) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasHorizontal returns true if there is a ruling at y=`y` covering x=`x`.
+func (g *rulingGrid) hasHorizontal(x, y float64) bool {
+	for _, h := range g.horizontals {
+		if math.Abs(h.primary-y) <= rulingTol && h.covers(x) {
+			return true
+		}
+	}
+	return false
+}
+
+// cells returns the cells of `g`. Adjacent grid cells that are not separated by a ruling are
+// merged into cells that span several rows or columns.
+// owners[row*g.numCols()+col] is the index in `cells` of the cell covering grid cell `col`, `row`.
+func (g *rulingGrid) cells() (cells []gridCell, owners []int) {
+	nc, nr := g.numCols(), g.numRows()
+	sets := newUnionFind(nc * nr)
+	for row := 0; row < nr; row++ {
+		midY := (g.ys[row] + g.ys[row+1]) / 2
+		for col := 0; col < nc; col++ {
+			midX := (g.xs[col] + g.xs[col+1]) / 2
+			if col < nc-1 && !g.hasVertical(g.xs[col+1], midY) {
+				sets.union(row*nc+col, row*nc+col+1)
+			}
+			if row < nr-1 && !g.hasHorizontal(midX, g.ys[row+1]) {
+				sets.union(row*nc+col, (row+1)*nc+col)
+			}
+		}
+	}
+
+	// Find the range of grid cells spanned by each set of merged grid cells.
+	type span struct{ col0, row0, col1, row1 int }
+	spans := map[int]*span{}
+	var roots []int
+	for i := 0; i < nc*nr; i++ {
+		col, row := i%nc, i/nc
+		root := sets.find(i)
+		s, ok := spans[root]
+		if !ok {
+			spans[root] = &span{col, row, col, row}
+			roots = append(roots, root)
+			continue
+		}
+		s.col0, s.row0 = minInt(s.col0, col), minInt(s.row0, row)
+		s.col1, s.row1 = maxInt(s.col1, col), maxInt(s.row1, row)
+	}
+
+	owners = make([]int, nc*nr)
+	for i := range owners {
+		owners[i] = -1
+	}
+	addCell := func(col0, row0, col1, row1 int) {
+		for row := row0; row <= row1; row++ {
+			for col := col0; col <= col1; col++ {
+				owners[row*nc+col] = len(cells)
+			}
+		}
+		cells = append(cells, gridCell{
+			PdfRectangle: model.PdfRectangle{
+				Llx: g.xs[col0],
+				Lly: g.ys[row1+1],
+				Urx: g.xs[col1+1],
+				Ury: g.ys[row0],
+			},
+			col:     col0,
+			row:     row0,
+			colSpan: col1 - col0 + 1,
+			rowSpan: row1 - row0 + 1,
+		})
+	}
+	for _, root := range roots {
+		s := spans[root]
+		// Sets of merged grid cells that are not rectangular, or that overlap other sets, are the
+		// result of broken rulings. Their grid cells are not merged.
+		free := true
+		for row := s.row0; row <= s.row1 && free; row++ {
+			for col := s.col0; col <= s.col1; col++ {
+				if owners[row*nc+col] >= 0 || sets.find(row*nc+col) != root {
+					free = false
+					break
+				}
+			}
+		}
+		if free {
+			addCell(s.col0, s.row0, s.col1, s.row1)
+			continue
+		}
+		for i := 0; i < nc*nr; i++ {
+			if sets.find(i) == root && owners[i] < 0 {
+				addCell(i%nc, i/nc, i%nc, i/nc)
+			}
+		}
+	}
+	return cells, owners
+}
+
+// newTable returns a textTable with the cells `cells` of `g` containing the word fragments
+// `cellWords`, or nil if fewer than 2 of the cells contain text.
+func (g *rulingGrid) newTable(cells []gridCell, cellWords [][]*textWord, pageHeight float64) *textTable {
+	table := &textTable{
+		PdfRectangle: g.bbox(),
+		w:            g.numCols(),
+		h:            g.numRows(),
+		cells:        map[uint64]*textPara{},
+		geometry:     map[uint64]cellGeometry{},
+	}
+	numText := 0
+	for i, c := range cells {
+		table.geometry[cellIndex(c.col, c.row)] = cellGeometry{
+			PdfRectangle: c.PdfRectangle,
+			colSpan:      c.colSpan,
+			rowSpan:      c.rowSpan,
+		}
+		words := cellWords[i]
+		if len(words) == 0 {
+			continue
+		}
+		bag := makeWordBag(words, pageHeight)
+		for _, w := range words[1:] {
+			bag.PdfRectangle = rectUnion(bag.PdfRectangle, w.PdfRectangle)
+		}
+		para := bag.arrangeText()
+		if para == nil {
+			continue
+		}
+		para.isCell = true
+		table.put(c.col, c.row, para)
+		numText++
+	}
+	if numText < 2 {
+		common.Log.Trace("ruled table %.2f has %d cells with text", table.PdfRectangle, numText)
+		return nil
+	}
+	return table
+}
+
+// unionFind is a disjoint-set forest of the integers 0 ... n-1.
+type unionFind struct {
+	parent []int
+}
+
+// newUnionFind returns a unionFind with each integer 0 ... `n`-1 in a set of its own.
+func newUnionFind(n int) *unionFind {
+	parent := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+	}
+	return &unionFind{parent: parent}
+}
+
+// size returns the number of integers in `u`.
+func (u *unionFind) size() int {
+	return len(u.parent)
+}
+
+// find returns the representative of the set containing `i`.
+func (u *unionFind) find(i int) int {
+	for u.parent[i] != i {
+		u.parent[i] = u.parent[u.parent[i]]
+		i = u.parent[i]
+	}
+	return i
+}
+
+// union merges the sets containing `i` and `j`.
+func (u *unionFind) union(i, j int) {
+	ri, rj := u.find(i), u.find(j)
+	if ri < rj {
+		u.parent[rj] = ri
+	} else if rj < ri {
+		u.parent[ri] = rj
+	}
+}
@@ -0,0 +1,160 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package extractor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/contentstream"
+	"github.com/showntop/unipdf/internal/transform"
+	"github.com/showntop/unipdf/model"
+)
+
+// ruledTableContents is a content stream with a caption above a 3 x 3 table delimited by ruling
+// lines. The cell in the top right spans 2 columns and the cell in the center of the bottom row is
+// empty. The vertical ruling at x=300 is drawn as a thin filled rectangle.
+const ruledTableContents = `
+	0.5 w
+	100 700 m 400 700 l S
+	100 670 m 400 670 l S
+	100 640 m 400 640 l 400 610 m 100 610 l S
+	100 610 m 100 700 l 200 610 m 200 700 l S
+	399.8 610 0.4 90 re f
+	299.75 610 0.5 60 re f
+	BT
+	/UniDocCourier 10 Tf
+	100 750 Td (Caption) Tj
+	5 -70 Td (Name) Tj
+	100 0 Td (Details) Tj
+	-100 -30 Td (A) Tj
+	100 0 Td (alpha) Tj
+	100 0 Td (1) Tj
+	-200 -30 Td (B) Tj
+	200 0 Td (2) Tj
+	ET
+`
+
+func TestRuledTable(t *testing.T) {
+	pageText, _, _, err := newTestExtractor(ruledTableContents).ExtractPageText()
+	require.NoError(t, err)
+
+	tables := pageText.Tables()
+	require.Len(t, tables, 1)
+	table := tables[0]
+	require.Equal(t, 3, table.W)
+	require.Equal(t, 3, table.H)
+
+	expected := [][]struct {
+		text             string
+		bbox             model.PdfRectangle
+		colSpan, rowSpan int
+	}{
+		{
+			{"Name", r(100, 670, 200, 700), 1, 1},
+			{"Details", r(200, 670, 400, 700), 2, 1},
+			{"", model.PdfRectangle{}, 0, 0},
+		},
+		{
+			{"A", r(100, 640, 200, 670), 1, 1},
+			{"alpha", r(200, 640, 300, 670), 1, 1},
+			{"1", r(300, 640, 400, 670), 1, 1},
+		},
+		{
+			{"B", r(100, 610, 200, 640), 1, 1},
+			{"", r(200, 610, 300, 640), 1, 1},
+			{"2", r(300, 610, 400, 640), 1, 1},
+		},
+	}
+	for y, row := range expected {
+		for x, exp := range row {
+			cell := table.Cells[y][x]
+			require.Equal(t, exp.text, cell.Text, "cell %d,%d", x, y)
+			require.Equal(t, exp.colSpan, cell.ColSpan, "cell %d,%d", x, y)
+			require.Equal(t, exp.rowSpan, cell.RowSpan, "cell %d,%d", x, y)
+			require.InDelta(t, exp.bbox.Llx, cell.BBox.Llx, 0.5, "cell %d,%d", x, y)
+			require.InDelta(t, exp.bbox.Lly, cell.BBox.Lly, 0.5, "cell %d,%d", x, y)
+			require.InDelta(t, exp.bbox.Urx, cell.BBox.Urx, 0.5, "cell %d,%d", x, y)
+			require.InDelta(t, exp.bbox.Ury, cell.BBox.Ury, 0.5, "cell %d,%d", x, y)
+		}
+	}
+
+	// The caption is read before the table and is not part of it.
+	require.Equal(t, "Caption\n\nName Details \t \nA alpha 1 \nB \t 2 \n\n", pageText.Text())
+
+	layout := pageText.Layout()
+	require.Len(t, layout.Blocks, 2)
+	require.Equal(t, LayoutBlockTable, layout.Blocks[1].Type)
+	require.Equal(t, 2, layout.Blocks[1].Table.Cells[0][1].ColSpan)
+	require.InDelta(t, 610, layout.Blocks[1].BBox.Lly, 0.5)
+}
+
+func TestRuledTableNoText(t *testing.T) {
+	// A box with a single line of text is not a table.
+	pageText, _, _, err := newTestExtractor(`
+		100 600 200 100 re 200 600 m 200 700 l S
+		BT /UniDocCourier 10 Tf 110 650 Td (Boxed) Tj ET
+	`).ExtractPageText()
+	require.NoError(t, err)
+	require.Empty(t, pageText.Tables())
+	require.Equal(t, "Boxed\n\n", pageText.Text())
+}
+
+func TestPathRulings(t *testing.T) {
+	// pathRulingsOf returns the rulings drawn by content stream `contents`.
+	pathRulingsOf := func(contents string) []ruling {
+		ops, err := contentstream.NewContentStreamParser(contents).Parse()
+		require.NoError(t, err)
+		var pr pathRulings
+		for _, op := range *ops {
+			pr.handleOp(op, transform.IdentityMatrix())
+		}
+		return pr.rulings
+	}
+
+	// A thin rectangle is a single ruling when filled, and its long sides are two rulings when
+	// stroked. The s operator strokes the path without filling it.
+	filled := ruling{primary: 600.5, lo: 100, hi: 300}
+	bottom := ruling{primary: 600, lo: 100, hi: 300}
+	top := ruling{primary: 601, lo: 100, hi: 300}
+	for _, tc := range []struct {
+		op       string
+		expected []ruling
+	}{
+		{"f", []ruling{filled}},
+		{"S", []ruling{bottom, top}},
+		{"s", []ruling{bottom, top}},
+		{"b", []ruling{bottom, top, filled}},
+		{"n", nil},
+	} {
+		rulings := pathRulingsOf("100 600 200 1 re " + tc.op)
+		require.Len(t, rulings, len(tc.expected), tc.op)
+		for i, exp := range tc.expected {
+			require.InDelta(t, exp.primary, rulings[i].primary, 0.01, tc.op)
+			require.InDelta(t, exp.lo, rulings[i].lo, 0.01, tc.op)
+			require.InDelta(t, exp.hi, rulings[i].hi, 0.01, tc.op)
+		}
+	}
+
+	// The s operator closes the path before stroking it.
+	require.Len(t, pathRulingsOf("100 600 m 300 600 l 300 700 l 100 700 l s"), 4)
+	require.Len(t, pathRulingsOf("100 600 m 300 600 l 300 700 l 100 700 l S"), 3)
+}
+
+func TestMergeRulings(t *testing.T) {
+	horizontals, verticals := mergeRulings([]ruling{
+		{primary: 100, lo: 0, hi: 50},
+		{primary: 100.5, lo: 49, hi: 120},
+		{primary: 100, lo: 200, hi: 300},
+		{vertical: true, primary: 10, lo: 0, hi: 100},
+	})
+	require.Equal(t, []ruling{
+		{primary: 100, lo: 0, hi: 120},
+		{primary: 100, lo: 200, hi: 300},
+	}, horizontals)
+	require.Len(t, verticals, 1)
+}
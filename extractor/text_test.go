@@ -934,6 +934,16 @@ func r(llx, lly, urx, ury float64) model.PdfRectangle {
 	return model.PdfRectangle{Llx: llx, Lly: lly, Urx: urx, Ury: ury}
 }
 
+// newTestExtractor returns an extractor of a 600 x 800 point page having content stream
+// `contents` and the font resource UniDocCourier.
+func newTestExtractor(contents string) *Extractor {
+	resources := model.NewPdfPageResources()
+	courier := model.NewStandard14FontMustCompile(model.CourierName)
+	resources.SetFontByName("UniDocCourier", courier.ToPdfObject())
+	return &Extractor{resources: resources, contents: contents, mediaBox: r(0, 0, 600, 800),
+		formResults: map[string]textResult{}}
+}
+
 // pageTextAndMarks returns the extracted page text and TextMarks for PDF page `page`.
 func pageTextAndMarks(t *testing.T, desc string, page *model.PdfPage) (string, *TextMarkArray) {
 	ex, err := New(page)
@@ -3,7 +3,6 @@ module github.com/showntop/unipdf
 go 1.11
 
 require (
-	github.com/adrg/sysfont v0.1.0
 	github.com/boombuler/barcode v1.0.0
 	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0
 	github.com/sirupsen/logrus v1.6.0 // indirect
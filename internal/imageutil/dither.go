@@ -0,0 +1,94 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package imageutil
+
+import (
+	"image"
+)
+
+// bayerMatrix is the 8x8 Bayer threshold matrix used for ordered dithering.
+var bayerMatrix = [8][8]uint8{
+	{0, 32, 8, 40, 2, 34, 10, 42},
+	{48, 16, 56, 24, 50, 18, 58, 26},
+	{12, 44, 4, 36, 14, 46, 6, 38},
+	{60, 28, 52, 20, 62, 30, 54, 22},
+	{3, 35, 11, 43, 1, 33, 9, 41},
+	{51, 19, 59, 27, 49, 17, 57, 25},
+	{15, 47, 7, 39, 13, 45, 5, 37},
+	{63, 31, 55, 23, 61, 29, 53, 21},
+}
+
+// GrayToBilevel returns the bilevel version of the gray image 'img', having
+// the pixels darker than 'threshold' black (0) and the others white (255).
+// Unlike ImgToBinary, whose 255 pixels are the dark ones, as the set bits of
+// JBIG2 bitmaps, it keeps the polarity of 'img', as the other functions of
+// this file, and does not return 'img' itself when it is already bilevel.
+func GrayToBilevel(img *image.Gray, threshold uint8) *image.Gray {
+	b := img.Bounds()
+	d := image.NewGray(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if img.GrayAt(x, y).Y >= threshold {
+				d.Pix[d.PixOffset(x, y)] = 255
+			}
+		}
+	}
+	return d
+}
+
+// DitherOrdered returns the bilevel version of the gray image 'img', using
+// ordered dithering with an 8x8 Bayer matrix. The pixels of the resulting
+// image are either black (0) or white (255). The regular patterns of ordered
+// dithering compress better than the ones of error diffusion, when encoded
+// using run length based schemes, such as the CCITT fax encodings.
+func DitherOrdered(img *image.Gray) *image.Gray {
+	b := img.Bounds()
+	d := image.NewGray(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			threshold := int(bayerMatrix[y&7][x&7])*4 + 2
+			if int(img.GrayAt(x, y).Y) >= threshold {
+				d.Pix[d.PixOffset(x, y)] = 255
+			}
+		}
+	}
+	return d
+}
+
+// DitherFloydSteinberg returns the bilevel version of the gray image 'img',
+// using Floyd-Steinberg error diffusion. The pixels of the resulting image
+// are either black (0) or white (255).
+func DitherFloydSteinberg(img *image.Gray) *image.Gray {
+	b := img.Bounds()
+	d := image.NewGray(b)
+	width := b.Dx()
+
+	// The errors diffused to the pixels of the current and the next rows.
+	cur := make([]int, width+2)
+	next := make([]int, width+2)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for i := range next {
+			next[i] = 0
+		}
+		for i := 0; i < width; i++ {
+			x := b.Min.X + i
+			v := int(img.GrayAt(x, y).Y) + cur[i+1]/16
+			out := 0
+			if v >= 128 {
+				out = 255
+				d.Pix[d.PixOffset(x, y)] = 255
+			}
+
+			err := v - out
+			cur[i+2] += err * 7
+			next[i] += err * 3
+			next[i+1] += err * 5
+			next[i+2] += err
+		}
+		cur, next = next, cur
+	}
+	return d
+}
@@ -124,13 +124,30 @@ func (m *Matrix) Clone() Matrix {
 	return NewMatrix(m[0], m[1], m[3], m[4], m[6], m[7])
 }
 
-// Transform returns coordinates `x`,`y` transformed by `m`.
+// Transform returns coordinates `x`,`y` transformed by `m`. As in the PDF
+// spec (section 8.3.4), the point is mapped by the row vector product
+// [x y 1] × m, i.e. x' = a*x + c*y + tx and y' = b*x + d*y + ty.
 func (m *Matrix) Transform(x, y float64) (float64, float64) {
-	xp := x*m[0] + y*m[1] + m[6]
-	yp := x*m[3] + y*m[4] + m[7]
+	xp := x*m[0] + y*m[3] + m[6]
+	yp := x*m[1] + y*m[4] + m[7]
 	return xp, yp
 }
 
+// Inverse returns the inverse of `m`. The returned flag is false if `m` is
+// not invertible.
+func (m *Matrix) Inverse() (Matrix, bool) {
+	a, b, c, d, tx, ty := m[0], m[1], m[3], m[4], m[6], m[7]
+	det := a*d - b*c
+	if math.Abs(det) < minDeterminant {
+		return Matrix{}, false
+	}
+
+	aI, bI, cI, dI := d/det, -b/det, -c/det, a/det
+	txI := -(aI*tx + cI*ty)
+	tyI := -(bI*tx + dI*ty)
+	return NewMatrix(aI, bI, cI, dI, txI, tyI), true
+}
+
 // ScalingFactorX returns the X scaling of the affine transform.
 func (m *Matrix) ScalingFactorX() float64 {
 	return math.Hypot(m[0], m[1])
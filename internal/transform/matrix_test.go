@@ -61,3 +61,95 @@ func makeAngleCase(r, theta float64) angleCase {
 	d := a
 	return angleCase{params{a, b, c, d, 0, 0}, theta}
 }
+
+// TestInverse tests the Matrix.Inverse() function.
+func TestInverse(t *testing.T) {
+	const tol = 1.0e-9
+
+	for _, p := range []params{
+		{1, 0, 0, 1, 0, 0},
+		{2, 0, 0, 3, 10, -5},
+		{0, -1, 1, 0, 0, 612},
+		{1.5, 0.5, -0.25, 2, 100, 200},
+	} {
+		m := NewMatrix(p.a, p.b, p.c, p.d, p.tx, p.ty)
+		inv, ok := m.Inverse()
+		if !ok {
+			t.Fatalf("Matrix not invertible: m=%s", m)
+		}
+
+		for _, pt := range [][2]float64{{0, 0}, {1, 0}, {0, 1}, {-3.5, 7}} {
+			x, y := m.Transform(pt[0], pt[1])
+			x, y = inv.Transform(x, y)
+			if math.Abs(x-pt[0]) > tol || math.Abs(y-pt[1]) > tol {
+				t.Fatalf("Bad inverse: m=%s inv=%s point=%v actual=(%g,%g)", m, inv, pt, x, y)
+			}
+		}
+	}
+
+	m := NewMatrix(1, 2, 2, 4, 0, 0)
+	if _, ok := m.Inverse(); ok {
+		t.Fatalf("Singular matrix inverted: m=%s", m)
+	}
+}
+
+// TestTransform tests the Matrix.Transform() function. A point is mapped by
+// [x y 1] × m, as in the PDF spec, so it is transformed the same way as the
+// translation of a matrix that is concatenated with `m`.
+func TestTransform(t *testing.T) {
+	const tol = 1.0e-9
+
+	// A 90° counterclockwise rotation and a translation.
+	m := NewMatrix(0, 1, -1, 0, 10, 20)
+	x, y := m.Transform(1, 0)
+	if math.Abs(x-10) > tol || math.Abs(y-21) > tol {
+		t.Fatalf("Bad transform: m=%s actual=(%g,%g)", m, x, y)
+	}
+	x, y = m.Transform(0, 1)
+	if math.Abs(x-9) > tol || math.Abs(y-20) > tol {
+		t.Fatalf("Bad transform: m=%s actual=(%g,%g)", m, x, y)
+	}
+
+	for _, p := range []params{
+		{1, 0, 0, 1, 0, 0},
+		{2, 0, 0, 3, 10, -5},
+		{0, -1, 1, 0, 0, 612},
+		{1.5, 0.5, -0.25, 2, 100, 200},
+	} {
+		m := NewMatrix(p.a, p.b, p.c, p.d, p.tx, p.ty)
+		for _, pt := range [][2]float64{{0, 0}, {1, 0}, {0, 1}, {-3.5, 7}} {
+			x, y := m.Transform(pt[0], pt[1])
+			tm := m.Mult(TranslationMatrix(pt[0], pt[1]))
+			expX, expY := tm.Translation()
+			if math.Abs(x-expX) > tol || math.Abs(y-expY) > tol {
+				t.Fatalf("Bad transform: m=%s point=%v expected=(%g,%g) actual=(%g,%g)",
+					m, pt, expX, expY, x, y)
+			}
+
+			p := NewPoint(pt[0], pt[1])
+			p.transformByMatrix(m)
+			if math.Abs(p.X-expX) > tol || math.Abs(p.Y-expY) > tol {
+				t.Fatalf("Bad point transform: m=%s point=%v actual=%s", m, pt, p)
+			}
+		}
+	}
+}
+
+// TestPointTransform tests that Point.Transform maps points the same way as
+// Matrix.Transform, including for sheared and rotated matrices, where the b
+// and c elements of the matrix must not be swapped.
+func TestPointTransform(t *testing.T) {
+	const tol = 1.0e-9
+
+	p := NewPoint(2, 3)
+	p.Transform(1, 0.5, 0.25, 1, 10, 20)
+	if math.Abs(p.X-12.75) > tol || math.Abs(p.Y-24) > tol {
+		t.Fatalf("Bad point transform: actual=%s", p)
+	}
+
+	p = NewPoint(1, 0)
+	p.Transform(0, 1, -1, 0, 0, 0)
+	if math.Abs(p.X) > tol || math.Abs(p.Y-1) > tol {
+		t.Fatalf("Bad point rotation: actual=%s", p)
+	}
+}
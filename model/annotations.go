@@ -61,6 +61,70 @@ func (a *PdfAnnotation) String() string {
 	return s
 }
 
+// AnnotationFlag represents annotation flags, which specify the behavior of
+// annotations when they are displayed or printed (section 12.5.3 p. 393).
+type AnnotationFlag uint32
+
+// The following constants define bitwise flags representing different
+// characteristics of an annotation.
+const (
+	AnnotationFlagInvisible      AnnotationFlag = 1
+	AnnotationFlagHidden         AnnotationFlag = (1 << 1)
+	AnnotationFlagPrint          AnnotationFlag = (1 << 2)
+	AnnotationFlagNoZoom         AnnotationFlag = (1 << 3)
+	AnnotationFlagNoRotate       AnnotationFlag = (1 << 4)
+	AnnotationFlagNoView         AnnotationFlag = (1 << 5)
+	AnnotationFlagReadOnly       AnnotationFlag = (1 << 6)
+	AnnotationFlagLocked         AnnotationFlag = (1 << 7)
+	AnnotationFlagToggleNoView   AnnotationFlag = (1 << 8)
+	AnnotationFlagLockedContents AnnotationFlag = (1 << 9)
+)
+
+// Has checks if flag fl is set in flag and returns true if so, false otherwise.
+func (flag AnnotationFlag) Has(fl AnnotationFlag) bool {
+	return flag&fl > 0
+}
+
+// Flags returns the annotation flags (the F entry of the annotation
+// dictionary). Annotations having no flags specified return 0.
+func (a *PdfAnnotation) Flags() AnnotationFlag {
+	flags, ok := core.GetIntVal(a.F)
+	if !ok || flags < 0 {
+		return 0
+	}
+	return AnnotationFlag(flags)
+}
+
+// GetNormalAppearance returns the normal appearance of the annotation, which
+// is used when the annotation is not interacted with (section 12.5.5 p. 395).
+// If the normal appearance is specified as a subdictionary of appearance
+// states, the appearance selected by the AS entry of the annotation is
+// returned. A nil form is returned if the annotation has no normal appearance.
+func (a *PdfAnnotation) GetNormalAppearance() (*XObjectForm, error) {
+	apDict, ok := core.GetDict(a.AP)
+	if !ok {
+		return nil, nil
+	}
+
+	obj := core.ResolveReference(apDict.Get("N"))
+	if stateDict, ok := core.GetDict(obj); ok {
+		state, ok := core.GetName(a.AS)
+		if !ok {
+			return nil, nil
+		}
+		obj = core.ResolveReference(stateDict.Get(*state))
+	}
+	if obj == nil {
+		return nil, nil
+	}
+
+	stream, ok := core.GetStream(obj)
+	if !ok {
+		return nil, core.ErrTypeError
+	}
+	return NewXObjectFormFromStream(stream)
+}
+
 // PdfAnnotationMarkup represents additional fields for mark-up annotations.
 // (Section 12.5.6.2 p. 399).
 type PdfAnnotationMarkup struct {
@@ -1865,8 +1929,13 @@ func (widget *PdfAnnotationWidget) ToPdfObject() core.PdfObject {
 	parentObj := widget.Parent
 	if widget.parent != nil {
 		if widget.parent.container == widget.container {
-			// Populate the part from the field.
-			widget.parent.ToPdfObject()
+			// Populate the part from the field, including the type specific
+			// field data (e.g. the value of signature fields).
+			if ctx := widget.parent.GetContext(); ctx != nil {
+				ctx.ToPdfObject()
+			} else {
+				widget.parent.ToPdfObject()
+			}
 		}
 		parentObj = widget.parent.GetContainingPdfObject()
 	}
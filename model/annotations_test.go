@@ -0,0 +1,68 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/core"
+)
+
+func TestAnnotationFlags(t *testing.T) {
+	annot := &PdfAnnotation{}
+	require.Equal(t, AnnotationFlag(0), annot.Flags())
+
+	annot.F = core.MakeInteger(36)
+	flags := annot.Flags()
+	require.True(t, flags.Has(AnnotationFlagPrint))
+	require.True(t, flags.Has(AnnotationFlagNoView))
+	require.False(t, flags.Has(AnnotationFlagHidden))
+}
+
+func TestAnnotationNormalAppearance(t *testing.T) {
+	on, err := core.MakeStream([]byte("0 0 1 rg 0 0 10 10 re f"), nil)
+	require.NoError(t, err)
+	on.Set("BBox", core.MakeArrayFromFloats([]float64{0, 0, 10, 10}))
+	off, err := core.MakeStream([]byte(""), nil)
+	require.NoError(t, err)
+
+	states := core.MakeDict()
+	states.Set("On", on)
+	states.Set("Off", off)
+	ap := core.MakeDict()
+	ap.Set("N", states)
+
+	// Appearance states are selected using the AS entry.
+	annot := &PdfAnnotation{AP: ap}
+	form, err := annot.GetNormalAppearance()
+	require.NoError(t, err)
+	require.Nil(t, form)
+
+	annot.AS = core.MakeName("On")
+	form, err = annot.GetNormalAppearance()
+	require.NoError(t, err)
+	require.NotNil(t, form)
+	content, err := form.GetContentStream()
+	require.NoError(t, err)
+	require.Equal(t, "0 0 1 rg 0 0 10 10 re f", string(content))
+
+	annot.AS = core.MakeName("Missing")
+	form, err = annot.GetNormalAppearance()
+	require.NoError(t, err)
+	require.Nil(t, form)
+
+	// Single normal appearance streams do not depend on the state.
+	ap.Set("N", on)
+	form, err = annot.GetNormalAppearance()
+	require.NoError(t, err)
+	require.NotNil(t, form)
+
+	ap.Set("N", core.MakeInteger(1))
+	_, err = annot.GetNormalAppearance()
+	require.Error(t, err)
+}
@@ -16,6 +16,8 @@ import (
 
 	"github.com/showntop/unipdf/common"
 	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/core/security"
+	"github.com/showntop/unipdf/core/security/crypt"
 )
 
 // PdfAppender appends new PDF content to an existing PDF document via incremental updates.
@@ -45,6 +47,21 @@ type PdfAppender struct {
 
 	prevRevisionSize int64
 	written          bool
+
+	// Security handler used for encrypting the new revision, along with the
+	// encryption dictionary and the document IDs. Defaults to the security
+	// handler of the original document.
+	crypter    *core.PdfCrypt
+	encryptObj *core.PdfIndirectObject
+	ids        *core.PdfObjectArray
+
+	// Minimum PDF version supporting the encryption algorithm of the new
+	// security handler.
+	encryptVersion core.Version
+
+	// Set when the security settings of the document are changed, in which
+	// case all the objects of the document are written in the new revision.
+	securityChanged bool
 }
 
 func getPageResources(p *PdfPage) map[core.PdfObjectName]core.PdfObject {
@@ -137,6 +154,9 @@ func NewPdfAppender(reader *PdfReader) (*PdfAppender, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := a.loadSecurityHandler(); err != nil {
+		return nil, err
+	}
 	for _, idx := range a.Reader.GetObjectNums() {
 		if a.greatestObjNum < idx {
 			a.greatestObjNum = idx
@@ -157,6 +177,51 @@ func NewPdfAppender(reader *PdfReader) (*PdfAppender, error) {
 	return a, nil
 }
 
+// loadSecurityHandler sets up the security handler of the original document,
+// used for encrypting the new revision. The read-only reader is decrypted with
+// the access rights of the original reader.
+func (a *PdfAppender) loadSecurityHandler() error {
+	crypter := a.parser.GetCrypter()
+	if crypter == nil {
+		return nil
+	}
+	if !a.parser.IsAuthenticated() {
+		return ErrEncrypted
+	}
+
+	if ok, err := a.roReader.decryptAs(a.Reader); err != nil {
+		return err
+	} else if !ok {
+		return errors.New("unable to decrypt the original document")
+	}
+
+	trailer := a.parser.GetTrailer()
+	switch t := trailer.Get("Encrypt").(type) {
+	case *core.PdfObjectReference:
+		obj, err := a.roReader.parser.LookupByReference(*t)
+		if err != nil {
+			return err
+		}
+		encryptObj, ok := obj.(*core.PdfIndirectObject)
+		if !ok {
+			return errors.New("invalid encryption dictionary")
+		}
+		a.encryptObj = encryptObj
+	case *core.PdfObjectDictionary:
+		a.encryptObj = core.MakeIndirectObject(t)
+	default:
+		return errors.New("invalid encryption dictionary")
+	}
+
+	ids := core.MakeArray()
+	if idArray, ok := core.GetArray(trailer.Get("ID")); ok {
+		ids.Append(idArray.Elements()...)
+	}
+	a.crypter = crypter
+	a.ids = ids
+	return nil
+}
+
 // updatesObjectsDeep recursively marks all objects under `obj` as updated appender (deep).
 // Updated objects are appended to the new revision and keep their original object number.
 func (a *PdfAppender) updateObjectsDeep(obj core.PdfObject, processed map[core.PdfObject]struct{}) {
@@ -249,6 +314,68 @@ func (a *PdfAppender) updateObjectsDeep(obj core.PdfObject, processed map[core.P
 	}
 }
 
+// updateAllObjects marks all the objects of the original document for being
+// written in the new revision, using their original object numbers.
+// The objects are loaded using a separate parser, as the objects of the readers
+// are altered when loading the document models. Cross-reference streams,
+// object streams and the objects which are replaced in the new revision are
+// skipped.
+func (a *PdfAppender) updateAllObjects() error {
+	parser, err := core.NewParser(a.rs)
+	if err != nil {
+		return err
+	}
+	if isEncrypted, err := parser.IsEncrypted(); err != nil {
+		return err
+	} else if isEncrypted {
+		if ok, err := parser.DecryptAs(a.parser); err != nil {
+			return err
+		} else if !ok {
+			return errors.New("unable to decrypt the original document")
+		}
+	}
+
+	replaced := make(map[int64]struct{}, len(a.replaceObjects))
+	for _, objNum := range a.replaceObjects {
+		replaced[objNum] = struct{}{}
+	}
+
+	// The encryption and the information dictionaries are not decrypted by
+	// the parser and they are replaced in the new revision.
+	trailer := parser.GetTrailer()
+	for _, key := range []core.PdfObjectName{"Encrypt", "Info"} {
+		if ref, ok := trailer.Get(key).(*core.PdfObjectReference); ok {
+			replaced[ref.ObjectNumber] = struct{}{}
+		}
+	}
+
+	for _, objNum := range parser.GetObjectNums() {
+		if _, ok := replaced[int64(objNum)]; ok {
+			continue
+		}
+
+		obj, err := parser.LookupByNumber(objNum)
+		if err != nil {
+			common.Log.Debug("ERROR: unable to load object %d: %v", objNum, err)
+			continue
+		}
+		switch t := obj.(type) {
+		case *core.PdfIndirectObject:
+		case *core.PdfObjectStream:
+			if name, ok := core.GetNameVal(t.Get("Type")); ok && (name == "XRef" || name == "ObjStm") {
+				continue
+			}
+		default:
+			continue
+		}
+
+		a.addNewObject(obj)
+		a.replaceObjects[obj] = int64(objNum)
+	}
+
+	return nil
+}
+
 // addNewObject adds a new object to be written out in the new revision, either with as a new
 // object or updating an older object (if replaceObjects entry set for obj).
 func (a *PdfAppender) addNewObject(obj core.PdfObject) {
@@ -511,6 +638,187 @@ func (a *PdfAppender) Sign(pageNum int, field *PdfFieldSignature) error {
 	return nil
 }
 
+// GetSignatureField returns the signature field with the fully qualified name
+// `name` (e.g. "Form.Signatures.Approver") from the document form.
+// The returned field can be signed using SignField.
+func (a *PdfAppender) GetSignatureField(name string) (*PdfFieldSignature, error) {
+	for _, field := range a.Reader.AcroForm.AllFields() {
+		sigField, ok := field.GetContext().(*PdfFieldSignature)
+		if !ok {
+			continue
+		}
+		fullName, err := field.FullName()
+		if err != nil {
+			common.Log.Debug("ERROR: invalid signature field name: %v", err)
+			continue
+		}
+		if fullName == name {
+			return sigField, nil
+		}
+	}
+
+	return nil, fmt.Errorf("signature field %q not found", name)
+}
+
+// SignField signs an existing, unsigned signature field of the document.
+// The field is typically retrieved using GetSignatureField and must have a
+// valid signature dictionary specified by its V field.
+// The appearance of the field widget is preserved, unless it is replaced by
+// setting the AP field of the widget before signing. As only the field and its
+// widget are updated in the new revision, existing signatures remain valid.
+func (a *PdfAppender) SignField(field *PdfFieldSignature) error {
+	if field == nil {
+		return errors.New("signature field cannot be nil")
+	}
+	if field.V == nil {
+		return errors.New("signature dictionary cannot be nil")
+	}
+	if field.PdfField == nil || field.PdfField.container == nil {
+		return errors.New("invalid signature field")
+	}
+
+	// Check that the field belongs to the document form.
+	var found bool
+	for _, f := range a.Reader.AcroForm.AllFields() {
+		if f == field.PdfField {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return errors.New("signature field not found in the document form")
+	}
+
+	// Check that the field has not been signed in a previous revision.
+	origObj, err := a.roReader.GetIndirectObjectByNumber(int(field.container.ObjectNumber))
+	if err != nil {
+		return err
+	}
+	if dict, ok := core.GetDict(origObj); ok && core.ResolveReference(dict.Get("V")) != nil {
+		return errors.New("signature field is already signed")
+	}
+
+	// Keep the value of the generic field in sync with the signature, as it is
+	// used when serializing merged-in widget annotations.
+	field.PdfField.V = field.V.ToPdfObject()
+
+	// Add the widget to the page annotations, if it is not already referenced.
+	if widget := field.PdfAnnotationWidget; widget != nil {
+		if err := a.addSignatureWidget(widget); err != nil {
+			return err
+		}
+	}
+
+	// Update the form.
+	if a.acroForm == a.roReader.AcroForm {
+		a.acroForm = a.Reader.AcroForm
+	}
+	acroForm := a.acroForm
+	acroForm.SigFlags = core.MakeInteger(3)
+	a.ReplaceAcroForm(acroForm)
+
+	return nil
+}
+
+// FillForm populates the fields of the document form with the values provided
+// by `provider`, in a new revision of the document. If not nil, `appGen` is
+// used to generate the appearances of the filled fields.
+// Existing signatures remain valid, as only the filled fields and their
+// widgets are updated. The form is not modified if the certification signature
+// of the document does not permit changes (DocMDP) or if any of the provided
+// fields is locked by a signature (FieldMDP).
+func (a *PdfAppender) FillForm(provider FieldValueProvider, appGen FieldAppearanceGenerator) error {
+	form := a.Reader.AcroForm
+	if form == nil {
+		return errors.New("document does not have a form")
+	}
+	if perm, ok := a.Reader.GetDocMDPPermission(); ok && perm == DocMDPNoChanges {
+		return errors.New("document certification does not permit changes")
+	}
+
+	objMap, err := provider.FieldValues()
+	if err != nil {
+		return err
+	}
+
+	// Collect the fields to be filled and check that they are not locked,
+	// before making any changes to the form.
+	type fieldValue struct {
+		field *PdfField
+		val   core.PdfObject
+	}
+	var values []fieldValue
+
+	locks := form.fieldMDPLocks()
+	for _, field := range form.AllFields() {
+		valObj, found := fieldProviderValue(field, objMap)
+		if !found {
+			continue
+		}
+		if _, ok := field.GetContext().(*PdfFieldSignature); ok {
+			return fmt.Errorf("cannot fill signature field %q", field.PartialName())
+		}
+
+		fullName, err := field.FullName()
+		if err != nil {
+			return err
+		}
+		for _, lock := range locks {
+			if lock.isLocked(fullName) {
+				return fmt.Errorf("field %q is locked by a signature", fullName)
+			}
+		}
+
+		values = append(values, fieldValue{field: field, val: valObj})
+	}
+
+	for _, v := range values {
+		if err := form.fillField(v.field, v.val, appGen); err != nil {
+			return err
+		}
+	}
+
+	// Update the form.
+	if a.acroForm == a.roReader.AcroForm {
+		a.acroForm = a.Reader.AcroForm
+	}
+	a.ReplaceAcroForm(a.acroForm)
+
+	return nil
+}
+
+// addSignatureWidget adds `widget` to the annotations of the page it refers
+// to, in case the page does not reference it already.
+func (a *PdfAppender) addSignatureWidget(widget *PdfAnnotationWidget) error {
+	pageObj := core.ResolveReference(widget.P)
+	if pageObj == nil {
+		return nil
+	}
+
+	for pageIndex, page := range a.Reader.PageList {
+		if page.GetContainingPdfObject() != pageObj {
+			continue
+		}
+
+		annotations, err := page.GetAnnotations()
+		if err != nil {
+			return err
+		}
+		for _, annot := range annotations {
+			if annot == widget.PdfAnnotation {
+				return nil
+			}
+		}
+
+		page.AddAnnotation(widget.PdfAnnotation)
+		a.UpdatePage(page)
+		a.pages[pageIndex] = page
+		break
+	}
+
+	return nil
+}
+
 // ReplaceAcroForm replaces the acrobat form. It appends a new form to the Pdf which
 // replaces the original AcroForm.
 func (a *PdfAppender) ReplaceAcroForm(acroForm *PdfAcroForm) {
@@ -520,6 +828,116 @@ func (a *PdfAppender) ReplaceAcroForm(acroForm *PdfAcroForm) {
 	a.acroForm = acroForm
 }
 
+// Encrypt encrypts the document with the specified user/owner password and
+// options, replacing the existing security handler of the document, if any.
+// As the encryption key changes, all the objects of the document are written
+// in the new revision. The previous revisions are preserved, so they remain
+// readable with the previous passwords, if any. Rewriting all the objects
+// invalidates certification signatures, so documents whose certification does
+// not permit changes other than annotations (DocMDP) are refused. The original
+// document must have been decrypted using the owner password.
+func (a *PdfAppender) Encrypt(userPass, ownerPass []byte, options *EncryptOptions) error {
+	if err := a.checkSecurityChange(); err != nil {
+		return err
+	}
+
+	algo := RC4_128bit
+	perm := security.PermOwner
+	if options != nil {
+		algo = options.Algorithm
+		perm = options.Permissions
+	}
+
+	cf, err := newCryptFilter(algo)
+	if err != nil {
+		return err
+	}
+	return a.setSecurityHandler(cf, userPass, ownerPass, perm)
+}
+
+// RemoveEncryption removes the encryption of the document. All the objects of
+// the document are written unencrypted in the new revision. The previous
+// revisions are preserved and remain encrypted with the previous passwords.
+// As for Encrypt, certified documents are refused unless their certification
+// permits annotations.
+// The original document must have been decrypted using the owner password.
+func (a *PdfAppender) RemoveEncryption() error {
+	if a.crypter == nil {
+		return errors.New("document is not encrypted")
+	}
+	if err := a.checkSecurityChange(); err != nil {
+		return err
+	}
+
+	a.crypter = nil
+	a.encryptObj = nil
+	a.ids = nil
+	a.encryptVersion = core.Version{}
+	a.securityChanged = true
+	return nil
+}
+
+// ChangePasswords changes the user and owner passwords of the encrypted
+// document. The encryption algorithm and the access permissions of the
+// document are preserved.
+// The original document must have been decrypted using the owner password.
+func (a *PdfAppender) ChangePasswords(userPass, ownerPass []byte) error {
+	if a.crypter == nil {
+		return errors.New("document is not encrypted")
+	}
+	return a.setSecurityHandler(a.crypter.GetCryptFilter(), userPass, ownerPass, a.crypter.GetAccessPermissions())
+}
+
+// ChangePermissions changes the access permissions of the encrypted document.
+// The encryption algorithm of the document is preserved. As the permissions
+// are bound to the passwords of the document, the user and owner passwords
+// must be specified as well.
+// The original document must have been decrypted using the owner password.
+func (a *PdfAppender) ChangePermissions(perm security.Permissions, userPass, ownerPass []byte) error {
+	if a.crypter == nil {
+		return errors.New("document is not encrypted")
+	}
+	return a.setSecurityHandler(a.crypter.GetCryptFilter(), userPass, ownerPass, perm)
+}
+
+// setSecurityHandler replaces the security handler used for encrypting the new
+// revision with a new one, based on the specified crypt filter, passwords and
+// access permissions.
+func (a *PdfAppender) setSecurityHandler(cf crypt.Filter, userPass, ownerPass []byte, perm security.Permissions) error {
+	if err := a.checkSecurityChange(); err != nil {
+		return err
+	}
+	if cf == nil || cf.Name() == "Identity" {
+		return errors.New("unsupported crypt filter")
+	}
+
+	crypter, info, err := core.PdfCryptNewEncrypt(cf, userPass, ownerPass, perm)
+	if err != nil {
+		return err
+	}
+
+	a.crypter = crypter
+	a.encryptObj = core.MakeIndirectObject(info.Encrypt)
+	a.ids = core.MakeArray(core.MakeHexString(info.ID0), core.MakeHexString(info.ID1))
+	a.encryptVersion = info.Version
+	a.securityChanged = true
+	return nil
+}
+
+// checkSecurityChange returns an error if the security settings of the
+// document cannot be changed: the document must have been decrypted using the
+// owner password and its certification, if any, must permit annotations, as
+// all the objects of the document are rewritten.
+func (a *PdfAppender) checkSecurityChange() error {
+	if !a.parser.IsOwnerAuthenticated() {
+		return errors.New("changing the security settings requires the owner password")
+	}
+	if perm, ok := a.Reader.GetDocMDPPermission(); ok && perm < DocMDPFillFormsAndAnnots {
+		return errors.New("document certification does not permit changes")
+	}
+	return nil
+}
+
 // Write writes the Appender output to io.Writer.
 // It can only be called once and further invocations will result in an error.
 func (a *PdfAppender) Write(w io.Writer) error {
@@ -644,6 +1062,17 @@ func (a *PdfAppender) Write(w io.Writer) error {
 		kids.Append(obj)
 	}
 
+	// If the security settings have changed, the objects of the previous
+	// revisions must be written using the new security handler.
+	if a.securityChanged {
+		if err := a.updateAllObjects(); err != nil {
+			return err
+		}
+		if a.encryptObj != nil {
+			a.addNewObject(a.encryptObj)
+		}
+	}
+
 	if _, err := a.rs.Seek(0, io.SeekStart); err != nil {
 		return err
 	}
@@ -707,6 +1136,15 @@ func (a *PdfAppender) Write(w io.Writer) error {
 	writer.minorVersion = a.roReader.PdfVersion().Minor
 	writer.appendReplaceMap = a.replaceObjects
 
+	if a.crypter != nil {
+		writer.crypter = a.crypter
+		writer.encryptObj = a.encryptObj
+		writer.ids = a.ids
+		if v := a.encryptVersion; v.Major > writer.majorVersion || v.Major == writer.majorVersion && v.Minor > writer.minorVersion {
+			writer.SetVersion(v.Major, v.Minor)
+		}
+	}
+
 	xrefType := a.parser.GetXrefType()
 	if xrefType != nil {
 		v := *xrefType == core.XrefTypeObjectStream
@@ -8,11 +8,19 @@ package model_test
 import (
 	"bytes"
 	"crypto"
+	"crypto/rand"
 	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"errors"
 	"fmt"
+	"image"
+	"image/color"
 	"io/ioutil"
 	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -24,6 +32,7 @@ import (
 	"github.com/showntop/unipdf/annotator"
 	"github.com/showntop/unipdf/common"
 	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/core/security"
 	"github.com/showntop/unipdf/model"
 	"github.com/showntop/unipdf/model/sighandler"
 )
@@ -1819,3 +1828,708 @@ func TestSignatureAppearanceWithTimestamp(t *testing.T) {
 	}
 	validateFile(t, tempFile("appender-signature-appearance-with-timestamp.pdf"))
 }
+
+// Sign existing empty signature fields in sequence (one signer per revision).
+func TestAppenderSignExistingFields(t *testing.T) {
+	fieldNames := []string{"Author", "Approver"}
+
+	// Create a document containing empty signature fields.
+	f, err := os.Open(testPdfFile1)
+	require.NoError(t, err)
+	defer f.Close()
+
+	pdfReader, err := model.NewPdfReader(f)
+	require.NoError(t, err)
+
+	appender, err := model.NewPdfAppender(pdfReader)
+	require.NoError(t, err)
+
+	page := pdfReader.PageList[0]
+	form := model.NewPdfAcroForm()
+	for i, name := range fieldNames {
+		sigField := model.NewPdfFieldSignature(nil)
+		sigField.T = core.MakeString(name)
+		sigField.Rect = core.MakeArrayFromFloats([]float64{10 + float64(i)*100, 10, 100 + float64(i)*100, 50})
+		sigField.P = page.ToPdfObject()
+
+		page.AddAnnotation(sigField.PdfAnnotationWidget.PdfAnnotation)
+		*form.Fields = append(*form.Fields, sigField.PdfField)
+	}
+	appender.ReplaceAcroForm(form)
+	appender.UpdatePage(page)
+
+	inputPath := tempFile("appender_sign_existing_fields_0.pdf")
+	require.NoError(t, appender.WriteToFile(inputPath))
+
+	pfxData, err := ioutil.ReadFile(testPKS12Key)
+	require.NoError(t, err)
+	privateKey, cert, err := pkcs12.Decode(pfxData, testPKS12KeyPassword)
+	require.NoError(t, err)
+
+	// Sign the fields in sequence.
+	for i, name := range fieldNames {
+		inputData, err := ioutil.ReadFile(inputPath)
+		require.NoError(t, err)
+
+		pdfReader, err := model.NewPdfReader(bytes.NewReader(inputData))
+		require.NoError(t, err)
+		require.Len(t, pdfReader.AcroForm.AllFields(), len(fieldNames))
+
+		appender, err := model.NewPdfAppender(pdfReader)
+		require.NoError(t, err)
+
+		_, err = appender.GetSignatureField("Missing")
+		require.Error(t, err)
+
+		handler, err := sighandler.NewAdobePKCS7Detached(privateKey.(*rsa.PrivateKey), cert)
+		require.NoError(t, err)
+
+		signature := model.NewPdfSignature(handler)
+		signature.SetName(name)
+		signature.SetReason(fmt.Sprintf("TestAppenderSignExistingFields %d", i+1))
+		signature.SetDate(time.Now(), "")
+		require.NoError(t, signature.Initialize())
+
+		sigField, err := appender.GetSignatureField(name)
+		require.NoError(t, err)
+		require.NotNil(t, sigField.PdfAnnotationWidget)
+		err = annotator.SetSignatureAppearance(sigField, []*annotator.SignatureLine{
+			annotator.NewSignatureLine("Name", name),
+			annotator.NewSignatureLine("Reason", fmt.Sprintf("Reason %d", i+1)),
+		}, nil)
+		require.NoError(t, err)
+		sigField.V = signature
+		require.NoError(t, appender.SignField(sigField))
+
+		outPath := tempFile(fmt.Sprintf("appender_sign_existing_fields_%d.pdf", i+1))
+		require.NoError(t, appender.WriteToFile(outPath))
+
+		// Check that all signatures are valid.
+		data, err := ioutil.ReadFile(outPath)
+		require.NoError(t, err)
+		reader, err := model.NewPdfReader(bytes.NewReader(data))
+		require.NoError(t, err)
+
+		handler, err = sighandler.NewAdobePKCS7Detached(nil, nil)
+		require.NoError(t, err)
+		res, err := reader.ValidateSignatures([]model.SignatureHandler{handler})
+		require.NoError(t, err)
+		require.Len(t, res, i+1)
+		for _, r := range res {
+			require.True(t, r.IsSigned)
+			require.True(t, r.IsVerified)
+		}
+
+		// The number of fields and widget annotations must be unchanged.
+		require.Len(t, reader.AcroForm.AllFields(), len(fieldNames))
+		annotations, err := reader.PageList[0].GetAnnotations()
+		require.NoError(t, err)
+		require.Len(t, annotations, len(fieldNames))
+
+		// Signing an already signed field must fail.
+		appender, err = model.NewPdfAppender(reader)
+		require.NoError(t, err)
+		sigField, err = appender.GetSignatureField(name)
+		require.NoError(t, err)
+		sigField.V = model.NewPdfSignature(handler)
+		require.Error(t, appender.SignField(sigField))
+
+		inputPath = outPath
+	}
+}
+
+func TestSignatureAppearanceImage(t *testing.T) {
+	f, err := os.Open(testPdf3pages)
+	require.NoError(t, err)
+	defer f.Close()
+
+	pdfReader, err := model.NewPdfReader(f)
+	require.NoError(t, err)
+
+	appender, err := model.NewPdfAppender(pdfReader)
+	require.NoError(t, err)
+
+	pfxData, err := ioutil.ReadFile(testPKS12Key)
+	require.NoError(t, err)
+	privateKey, cert, err := pkcs12.Decode(pfxData, testPKS12KeyPassword)
+	require.NoError(t, err)
+
+	handler, err := sighandler.NewAdobePKCS7Detached(privateKey.(*rsa.PrivateKey), cert)
+	require.NoError(t, err)
+
+	signature := model.NewPdfSignature(handler)
+	signature.SetName("Test Signature Appearance Image")
+	signature.SetReason("TestSignatureAppearanceImage")
+	signature.SetDate(time.Now(), "")
+	require.NoError(t, signature.Initialize())
+
+	// Generate a semi-transparent signature image.
+	goImg := image.NewNRGBA(image.Rect(0, 0, 80, 40))
+	for x := 0; x < 80; x++ {
+		goImg.Set(x, 20+x/8, color.NRGBA{R: 0, G: 0, B: 128, A: 255})
+		goImg.Set(x, 0, color.NRGBA{R: 255, G: 0, B: 0, A: 64})
+	}
+	img, err := model.ImageHandling.NewImageFromGoImage(goImg)
+	require.NoError(t, err)
+
+	lines := []*annotator.SignatureLine{
+		annotator.NewSignatureLine("Name", "Jane Doe"),
+		annotator.NewSignatureLine("Reason", "Approval"),
+	}
+
+	testcases := []struct {
+		lines    []*annotator.SignatureLine
+		position annotator.SignatureImagePosition
+		layered  bool
+		fitText  bool
+	}{
+		{lines, annotator.SignatureImageLeft, true, false},
+		{lines, annotator.SignatureImageTop, false, true},
+		{nil, annotator.SignatureImageLeft, true, false},
+	}
+
+	for i, tcase := range testcases {
+		pageNum := i + 1
+
+		opts := annotator.NewSignatureFieldOpts()
+		opts.Rect = []float64{300, 25, 500, 125}
+		opts.BorderSize = 1
+		opts.Image = img
+		opts.ImagePosition = tcase.position
+		opts.LayeredAppearance = tcase.layered
+		opts.FitText = tcase.fitText
+		opts.WatermarkImage = img
+
+		sigField, err := annotator.NewSignatureField(signature, tcase.lines, opts)
+		require.NoError(t, err)
+		sigField.T = core.MakeString(fmt.Sprintf("Signature %d", pageNum))
+
+		// Check the structure of the normal appearance.
+		apDict, ok := core.GetDict(sigField.AP)
+		require.True(t, ok)
+		apStream, ok := core.GetStream(apDict.Get("N"))
+		require.True(t, ok)
+		apForm, err := model.NewXObjectFormFromStream(apStream)
+		require.NoError(t, err)
+		require.NotNil(t, apForm.Resources)
+		require.Equal(t, tcase.layered, apForm.Resources.HasXObjectByName("FRM"))
+		if !tcase.layered {
+			require.True(t, apForm.Resources.HasXObjectByName("Img1"))
+			require.True(t, apForm.Resources.HasXObjectByName("Wm1"))
+		}
+
+		require.NoError(t, appender.Sign(pageNum, sigField))
+	}
+
+	outPath := tempFile("appender_signature_appearance_image.pdf")
+	require.NoError(t, appender.WriteToFile(outPath))
+	validateFile(t, outPath)
+}
+
+// newTestTimestampAuthority returns an in-process timestamp authority using a
+// generated self-signed certificate.
+func newTestTimestampAuthority(t *testing.T) *sighandler.LocalTimestampAuthority {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test TSA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+		BasicConstraintsValid: true,
+	}
+	certData, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(certData)
+	require.NoError(t, err)
+
+	tsa, err := sighandler.NewLocalTimestampAuthority(cert, privateKey)
+	require.NoError(t, err)
+	return tsa
+}
+
+func TestAppenderSignatureTimestamp(t *testing.T) {
+	tsa := newTestTimestampAuthority(t)
+	tsaTime := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	tsa.Now = func() time.Time {
+		return tsaTime
+	}
+
+	// Serve the timestamp authority over HTTP, requiring authentication.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		tsa.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	client := sighandler.NewHTTPTimestampClient(server.URL)
+	_, err := client.GetTimestampToken(make([]byte, 32), crypto.SHA256)
+	require.Error(t, err)
+	client.Header.Set("Authorization", "Bearer token")
+
+	f, err := os.Open(testPdfFile1)
+	require.NoError(t, err)
+	defer f.Close()
+
+	pdfReader, err := model.NewPdfReader(f)
+	require.NoError(t, err)
+
+	appender, err := model.NewPdfAppender(pdfReader)
+	require.NoError(t, err)
+
+	pfxData, err := ioutil.ReadFile(testPKS12Key)
+	require.NoError(t, err)
+	privateKey, cert, err := pkcs12.Decode(pfxData, testPKS12KeyPassword)
+	require.NoError(t, err)
+
+	handler, err := sighandler.NewAdobePKCS7DetachedWithTimestamp(privateKey.(*rsa.PrivateKey), cert, client, crypto.SHA256)
+	require.NoError(t, err)
+
+	signature := model.NewPdfSignature(handler)
+	signature.SetName("Test Signature Timestamp")
+	signature.SetReason("TestAppenderSignatureTimestamp")
+	signature.SetDate(time.Now(), "")
+	require.NoError(t, signature.Initialize())
+
+	sigField := model.NewPdfFieldSignature(signature)
+	sigField.T = core.MakeString("Signature1")
+	sigField.Rect = core.MakeArray(
+		core.MakeInteger(0),
+		core.MakeInteger(0),
+		core.MakeInteger(0),
+		core.MakeInteger(0),
+	)
+	require.NoError(t, appender.Sign(1, sigField))
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, appender.Write(buf))
+
+	reader, err := model.NewPdfReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	handler, err = sighandler.NewAdobePKCS7Detached(nil, nil)
+	require.NoError(t, err)
+	res, err := reader.ValidateSignatures([]model.SignatureHandler{handler})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.True(t, res[0].IsSigned)
+	require.True(t, res[0].IsVerified)
+	require.Empty(t, res[0].Errors)
+	require.True(t, tsaTime.Equal(res[0].GeneralizedTime))
+}
+
+func TestAppenderDocTimeStampWithClient(t *testing.T) {
+	tsa := newTestTimestampAuthority(t)
+
+	f, err := os.Open(testPdfFile1)
+	require.NoError(t, err)
+	defer f.Close()
+
+	pdfReader, err := model.NewPdfReader(f)
+	require.NoError(t, err)
+
+	appender, err := model.NewPdfAppender(pdfReader)
+	require.NoError(t, err)
+
+	handler, err := sighandler.NewDocTimeStampWithClient(tsa, crypto.SHA512)
+	require.NoError(t, err)
+
+	signature := model.NewPdfSignature(handler)
+	signature.SetName("Test Document Timestamp")
+	signature.SetDate(time.Now(), "")
+	require.NoError(t, signature.Initialize())
+
+	sigField := model.NewPdfFieldSignature(signature)
+	sigField.T = core.MakeString("Timestamp1")
+	sigField.Rect = core.MakeArray(
+		core.MakeInteger(0),
+		core.MakeInteger(0),
+		core.MakeInteger(0),
+		core.MakeInteger(0),
+	)
+	require.NoError(t, appender.Sign(1, sigField))
+
+	outPath := tempFile("appender_doc_timestamp_client.pdf")
+	require.NoError(t, appender.WriteToFile(outPath))
+	validateFile(t, outPath)
+}
+
+// testFieldValues is a field value provider backed by a map.
+type testFieldValues map[string]core.PdfObject
+
+func (fv testFieldValues) FieldValues() (map[string]core.PdfObject, error) {
+	return fv, nil
+}
+
+func TestAppenderFillFormSigned(t *testing.T) {
+	// Create a document containing text fields and a signature field which
+	// locks the Email field.
+	f, err := os.Open(testPdfFile1)
+	require.NoError(t, err)
+	defer f.Close()
+
+	pdfReader, err := model.NewPdfReader(f)
+	require.NoError(t, err)
+
+	appender, err := model.NewPdfAppender(pdfReader)
+	require.NoError(t, err)
+
+	page := pdfReader.PageList[0]
+	form := model.NewPdfAcroForm()
+	for i, name := range []string{"Name", "Email"} {
+		textField, err := annotator.NewTextField(page, name, []float64{10, 100 + float64(i)*50, 200, 130 + float64(i)*50}, annotator.TextFieldOptions{})
+		require.NoError(t, err)
+		*form.Fields = append(*form.Fields, textField.PdfField)
+		page.AddAnnotation(textField.Annotations[0].PdfAnnotation)
+	}
+
+	lockDict := core.MakeDict()
+	lockDict.Set("Type", core.MakeName("SigFieldLock"))
+	lockDict.Set("Action", core.MakeName("Include"))
+	lockDict.Set("Fields", core.MakeArray(core.MakeString("Email")))
+
+	sigField := model.NewPdfFieldSignature(nil)
+	sigField.T = core.MakeString("Signature")
+	sigField.Rect = core.MakeArrayFromFloats([]float64{10, 10, 200, 50})
+	sigField.P = page.ToPdfObject()
+	sigField.Lock = core.MakeIndirectObject(lockDict)
+	page.AddAnnotation(sigField.PdfAnnotationWidget.PdfAnnotation)
+	*form.Fields = append(*form.Fields, sigField.PdfField)
+
+	appender.ReplaceAcroForm(form)
+	appender.UpdatePage(page)
+
+	unsignedPath := tempFile("appender_fill_form_signed_0.pdf")
+	require.NoError(t, appender.WriteToFile(unsignedPath))
+
+	// Sign the signature field.
+	f, err = os.Open(unsignedPath)
+	require.NoError(t, err)
+	defer f.Close()
+
+	pdfReader, err = model.NewPdfReader(f)
+	require.NoError(t, err)
+	appender, err = model.NewPdfAppender(pdfReader)
+	require.NoError(t, err)
+
+	pfxData, err := ioutil.ReadFile(testPKS12Key)
+	require.NoError(t, err)
+	privateKey, cert, err := pkcs12.Decode(pfxData, testPKS12KeyPassword)
+	require.NoError(t, err)
+
+	handler, err := sighandler.NewAdobePKCS7Detached(privateKey.(*rsa.PrivateKey), cert)
+	require.NoError(t, err)
+
+	signature := model.NewPdfSignature(handler)
+	signature.SetName("Signer")
+	signature.SetReason("TestAppenderFillFormSigned")
+	signature.SetDate(time.Now(), "")
+	require.NoError(t, signature.Initialize())
+
+	sigField, err = appender.GetSignatureField("Signature")
+	require.NoError(t, err)
+	sigField.V = signature
+	require.NoError(t, appender.SignField(sigField))
+
+	signedPath := tempFile("appender_fill_form_signed_1.pdf")
+	require.NoError(t, appender.WriteToFile(signedPath))
+
+	newAppender := func() *model.PdfAppender {
+		data, err := ioutil.ReadFile(signedPath)
+		require.NoError(t, err)
+		reader, err := model.NewPdfReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		appender, err := model.NewPdfAppender(reader)
+		require.NoError(t, err)
+		return appender
+	}
+
+	// Filling the locked field must fail.
+	err = newAppender().FillForm(testFieldValues{"Email": core.MakeString("john@example.com")}, nil)
+	require.Error(t, err)
+
+	// Filling the signature field must fail.
+	err = newAppender().FillForm(testFieldValues{"Signature": core.MakeString("value")}, nil)
+	require.Error(t, err)
+
+	// Fill the unlocked field.
+	appender = newAppender()
+	fieldValues := testFieldValues{"Name": core.MakeString("John Smith")}
+	require.NoError(t, appender.FillForm(fieldValues, annotator.FieldAppearance{}))
+
+	filledPath := tempFile("appender_fill_form_signed_2.pdf")
+	require.NoError(t, appender.WriteToFile(filledPath))
+
+	// Check the filled value and that the signature is still valid.
+	data, err := ioutil.ReadFile(filledPath)
+	require.NoError(t, err)
+	reader, err := model.NewPdfReader(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	var filled bool
+	for _, field := range reader.AcroForm.AllFields() {
+		if field.PartialName() != "Name" {
+			continue
+		}
+		val, ok := core.GetString(field.V)
+		require.True(t, ok)
+		require.Equal(t, "John Smith", val.Decoded())
+		filled = true
+	}
+	require.True(t, filled)
+
+	handler, err = sighandler.NewAdobePKCS7Detached(nil, nil)
+	require.NoError(t, err)
+	res, err := reader.ValidateSignatures([]model.SignatureHandler{handler})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.True(t, res[0].IsSigned)
+	require.True(t, res[0].IsVerified)
+}
+
+// writeEncryptedTestFile writes an encrypted copy of `inputPath` to `outputPath`.
+func writeEncryptedTestFile(t *testing.T, inputPath, outputPath string, userPass, ownerPass []byte, options *model.EncryptOptions) {
+	f, err := os.Open(inputPath)
+	require.NoError(t, err)
+	defer f.Close()
+
+	reader, err := model.NewPdfReader(f)
+	require.NoError(t, err)
+
+	writer := model.NewPdfWriter()
+	for _, page := range reader.PageList {
+		require.NoError(t, writer.AddPage(page))
+	}
+	require.NoError(t, writer.Encrypt(userPass, ownerPass, options))
+
+	out, err := os.Create(outputPath)
+	require.NoError(t, err)
+	defer out.Close()
+	require.NoError(t, writer.Write(out))
+}
+
+// openTestFile opens the specified file, decrypting it using `password`
+// if it is encrypted.
+func openTestFile(t *testing.T, path string, password []byte) *model.PdfReader {
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	reader, err := model.NewPdfReader(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	isEncrypted, err := reader.IsEncrypted()
+	require.NoError(t, err)
+	if isEncrypted {
+		ok, err := reader.Decrypt(password)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+	return reader
+}
+
+func TestAppenderEncrypted(t *testing.T) {
+	userPass, ownerPass := []byte("user"), []byte("owner")
+	perm := security.PermPrinting | security.PermAnnotate
+
+	inputPath := tempFile("appender_encrypted_0.pdf")
+	writeEncryptedTestFile(t, testPdfFile1, inputPath, userPass, ownerPass, &model.EncryptOptions{
+		Permissions: perm,
+		Algorithm:   model.AES_128bit,
+	})
+
+	// Opening an encrypted document without decrypting it must fail.
+	f, err := os.Open(inputPath)
+	require.NoError(t, err)
+	defer f.Close()
+	reader, err := model.NewPdfReader(f)
+	require.NoError(t, err)
+	_, err = model.NewPdfAppender(reader)
+	require.Equal(t, model.ErrEncrypted, err)
+
+	// Sign the document using the existing security handler.
+	appender, err := model.NewPdfAppender(openTestFile(t, inputPath, userPass))
+	require.NoError(t, err)
+
+	pfxData, err := ioutil.ReadFile(testPKS12Key)
+	require.NoError(t, err)
+	privateKey, cert, err := pkcs12.Decode(pfxData, testPKS12KeyPassword)
+	require.NoError(t, err)
+
+	handler, err := sighandler.NewAdobePKCS7Detached(privateKey.(*rsa.PrivateKey), cert)
+	require.NoError(t, err)
+
+	signature := model.NewPdfSignature(handler)
+	signature.SetName("Test Appender Encrypted")
+	signature.SetReason("TestAppenderEncrypted")
+	signature.SetDate(time.Now(), "")
+	require.NoError(t, signature.Initialize())
+
+	sigField := model.NewPdfFieldSignature(signature)
+	sigField.T = core.MakeString("Signature1")
+	sigField.Rect = core.MakeArray(
+		core.MakeInteger(0),
+		core.MakeInteger(0),
+		core.MakeInteger(0),
+		core.MakeInteger(0),
+	)
+	require.NoError(t, appender.Sign(1, sigField))
+
+	signedPath := tempFile("appender_encrypted_1.pdf")
+	require.NoError(t, appender.WriteToFile(signedPath))
+
+	validateSigned := func(reader *model.PdfReader) {
+		handler, err := sighandler.NewAdobePKCS7Detached(nil, nil)
+		require.NoError(t, err)
+		res, err := reader.ValidateSignatures([]model.SignatureHandler{handler})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.True(t, res[0].IsSigned)
+		require.True(t, res[0].IsVerified)
+		require.Equal(t, "Test Appender Encrypted", res[0].Name)
+		require.Len(t, reader.PageList, 1)
+	}
+
+	reader = openTestFile(t, signedPath, userPass)
+	validateSigned(reader)
+	ok, readerPerm, err := reader.CheckAccessRights(userPass)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, perm, readerPerm&perm)
+
+	// Changing the security settings requires the owner password.
+	newUserPass, newOwnerPass := []byte("user2"), []byte("owner2")
+	appender, err = model.NewPdfAppender(reader)
+	require.NoError(t, err)
+	require.Error(t, appender.ChangePasswords(newUserPass, newOwnerPass))
+	require.Error(t, appender.ChangePermissions(security.PermOwner, newUserPass, newOwnerPass))
+	require.Error(t, appender.RemoveEncryption())
+	require.Error(t, appender.Encrypt(newUserPass, newOwnerPass, nil))
+
+	// Change the passwords.
+	appender, err = model.NewPdfAppender(openTestFile(t, signedPath, ownerPass))
+	require.NoError(t, err)
+	require.NoError(t, appender.ChangePasswords(newUserPass, newOwnerPass))
+
+	outPath := tempFile("appender_encrypted_2.pdf")
+	require.NoError(t, appender.WriteToFile(outPath))
+
+	reader = openTestFile(t, outPath, newUserPass)
+	validateSigned(reader)
+	ok, _, err = reader.CheckAccessRights(userPass)
+	require.NoError(t, err)
+	require.False(t, ok)
+	ok, readerPerm, err = reader.CheckAccessRights(newUserPass)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, perm, readerPerm&perm)
+
+	// Change the permissions.
+	appender, err = model.NewPdfAppender(openTestFile(t, outPath, newOwnerPass))
+	require.NoError(t, err)
+	require.NoError(t, appender.ChangePermissions(security.PermPrinting, newUserPass, newOwnerPass))
+
+	outPath = tempFile("appender_encrypted_3.pdf")
+	require.NoError(t, appender.WriteToFile(outPath))
+
+	reader = openTestFile(t, outPath, newUserPass)
+	validateSigned(reader)
+	ok, readerPerm, err = reader.CheckAccessRights(newUserPass)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NotZero(t, readerPerm&security.PermPrinting)
+	require.Zero(t, readerPerm&security.PermAnnotate)
+
+	// Remove the encryption.
+	appender, err = model.NewPdfAppender(openTestFile(t, outPath, newOwnerPass))
+	require.NoError(t, err)
+	require.NoError(t, appender.RemoveEncryption())
+
+	outPath = tempFile("appender_encrypted_4.pdf")
+	require.NoError(t, appender.WriteToFile(outPath))
+
+	reader = openTestFile(t, outPath, nil)
+	isEncrypted, err := reader.IsEncrypted()
+	require.NoError(t, err)
+	require.False(t, isEncrypted)
+	validateSigned(reader)
+
+	// Removing the encryption of an unencrypted document must fail.
+	appender, err = model.NewPdfAppender(reader)
+	require.NoError(t, err)
+	require.Error(t, appender.RemoveEncryption())
+
+	// Encrypt the unencrypted document.
+	require.NoError(t, appender.Encrypt(userPass, ownerPass, &model.EncryptOptions{
+		Permissions: security.PermOwner,
+		Algorithm:   model.AES_256bit,
+	}))
+
+	outPath = tempFile("appender_encrypted_5.pdf")
+	require.NoError(t, appender.WriteToFile(outPath))
+
+	reader = openTestFile(t, outPath, ownerPass)
+	isEncrypted, err = reader.IsEncrypted()
+	require.NoError(t, err)
+	require.True(t, isEncrypted)
+	validateSigned(reader)
+}
+
+func TestAppenderEncryptCertified(t *testing.T) {
+	// makeCertifiedPdf returns a one page document certified with DocMDP
+	// access permissions `perm`.
+	makeCertifiedPdf := func(perm model.DocMDPPermission) []byte {
+		objects := []string{
+			fmt.Sprintf("<< /Type /Catalog /Pages 2 0 R /Perms << /DocMDP << /Type /Sig "+
+				"/Reference [<< /Type /SigRef /TransformMethod /DocMDP "+
+				"/TransformParams << /Type /TransformParams /P %d /V /1.2 >> >>] >> >> >>", perm),
+			"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] >>",
+		}
+
+		var buf bytes.Buffer
+		buf.WriteString("%PDF-1.7\n")
+		var offsets []int
+		for i, obj := range objects {
+			offsets = append(offsets, buf.Len())
+			fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+		}
+		xrefOffset := buf.Len()
+		fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f\r\n", len(objects)+1)
+		for _, offset := range offsets {
+			fmt.Fprintf(&buf, "%010d 00000 n\r\n", offset)
+		}
+		fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+			len(objects)+1, xrefOffset)
+		return buf.Bytes()
+	}
+
+	for _, tc := range []struct {
+		perm    model.DocMDPPermission
+		allowed bool
+	}{
+		{model.DocMDPNoChanges, false},
+		{model.DocMDPFillForms, false},
+		{model.DocMDPFillFormsAndAnnots, true},
+	} {
+		reader, err := model.NewPdfReader(bytes.NewReader(makeCertifiedPdf(tc.perm)))
+		require.NoError(t, err)
+		perm, ok := reader.GetDocMDPPermission()
+		require.True(t, ok)
+		require.Equal(t, tc.perm, perm)
+
+		appender, err := model.NewPdfAppender(reader)
+		require.NoError(t, err)
+		err = appender.Encrypt([]byte("user"), []byte("owner"), nil)
+		if tc.allowed {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
@@ -23,6 +23,11 @@ var (
 	ErrNoFont                   = errors.New("font not defined")
 	ErrFontNotSupported         = fmt.Errorf("unsupported font (%v)", core.ErrNotSupported)
 	ErrType1CFontNotSupported   = fmt.Errorf("Type1C fonts are not currently supported (%v)", core.ErrNotSupported)
-	ErrType3FontNotSupported    = fmt.Errorf("Type3 fonts are not currently supported (%v)", core.ErrNotSupported)
 	ErrTTCmapNotSupported       = fmt.Errorf("unsupported TrueType cmap format (%v)", core.ErrNotSupported)
+
+	// ErrType3FontNotSupported is no longer returned.
+	//
+	// Deprecated: Type 3 fonts are loaded with their glyph procedures and invalid Type 3 font
+	// entries are skipped.
+	ErrType3FontNotSupported = fmt.Errorf("Type3 fonts are not currently supported (%v)", core.ErrNotSupported)
 )
@@ -460,8 +460,9 @@ func NewPdfFieldSignature(signature *PdfSignature) *PdfFieldSignature {
 
 // ToPdfObject returns an indirect object containing the signature field dictionary.
 func (sig *PdfFieldSignature) ToPdfObject() core.PdfObject {
-	// Set general field attributes.
-	if sig.PdfAnnotationWidget != nil {
+	// Set general field attributes. The widget is skipped if it is already being serialized,
+	// which is the case for merged-in field/widget dictionaries.
+	if sig.PdfAnnotationWidget != nil && !sig.PdfAnnotationWidget.processing {
 		sig.PdfAnnotationWidget.ToPdfObject()
 	}
 	sig.PdfField.ToPdfObject()
@@ -669,6 +670,7 @@ func (r *PdfReader) newPdfFieldFromIndirectObject(container *core.PdfIndirectObj
 			widget.Parent = field.container
 
 			field.Annotations = append(field.Annotations, widget)
+			setSignatureFieldWidget(field)
 
 			return field, nil
 		}
@@ -727,10 +729,22 @@ func (r *PdfReader) newPdfFieldFromIndirectObject(container *core.PdfIndirectObj
 			}
 		}
 	}
+	setSignatureFieldWidget(field)
 
 	return field, nil
 }
 
+// setSignatureFieldWidget sets the widget annotation of signature fields which have
+// exactly one widget, so that the appearance of loaded signature fields can be
+// accessed and modified in the same way as for newly created ones.
+func setSignatureFieldWidget(field *PdfField) {
+	sigf, ok := field.context.(*PdfFieldSignature)
+	if !ok || len(field.Annotations) != 1 {
+		return
+	}
+	sigf.PdfAnnotationWidget = field.Annotations[0]
+}
+
 // newPdfFieldTextFromDict returns a new PdfFieldText (representing a variable text field) loaded from a dictionary.
 // This function loads only text-field specific fields (called by a more generic field loader).
 func newPdfFieldTextFromDict(d *core.PdfObjectDictionary) (*PdfFieldText, error) {
@@ -298,7 +298,7 @@ func newPdfFontFromPdfObject(fontObj core.PdfObject, allowType0 bool) (*PdfFont,
 		// In the case of not yet supported fonts, we attempt to return enough information in the
 		// font for the caller to see some font properties.
 		// TODO(peterwilliams97): Add support for these fonts and remove this special error handling.
-		if err == ErrType3FontNotSupported || err == ErrType1CFontNotSupported {
+		if err == ErrType1CFontNotSupported {
 			simplefont, err2 := newSimpleFontFromPdfObject(d, base, nil)
 			if err2 != nil {
 				common.Log.Debug("ERROR: While loading simple font: font=%s err=%v", base, err2)
@@ -650,6 +650,54 @@ func (font *PdfFont) GetCharMetrics(code textencoding.CharCode) (CharMetrics, bo
 	return nometrics, false
 }
 
+// CharcodeToGlyphName returns the name of the glyph selected by character code `code` through the
+// Encoding entry of simple font `font` (9.6.6 "Character Encoding"). The returned bool is false if
+// `font` is not a simple font, if it has no Encoding entry or if `code` is not mapped by it. In
+// that case, glyphs are selected through the built-in encoding of the font program.
+func (font *PdfFont) CharcodeToGlyphName(code textencoding.CharCode) (textencoding.GlyphName, bool) {
+	if t, ok := font.context.(*pdfFontSimple); ok {
+		return t.charcodeToGlyphName(code)
+	}
+	return "", false
+}
+
+// CharcodeToCID returns the CID selected by character code `code` through the CMap of composite
+// font `font` (9.7.6 "CMaps"). Character codes are used as CIDs for fonts with an identity
+// encoding. The returned bool is false if `font` is not a composite font or if `code` is not
+// mapped by its CMap.
+func (font *PdfFont) CharcodeToCID(code textencoding.CharCode) (textencoding.CharCode, bool) {
+	t, ok := font.context.(*pdfFontType0)
+	if !ok {
+		return 0, false
+	}
+	if t.codeToCID == nil {
+		return code, true
+	}
+	cid, ok := t.codeToCID.CharcodeToCID(cmap.CharCode(code))
+	return textencoding.CharCode(cid), ok
+}
+
+// CIDToGID returns the index of the glyph selected by CID `cid` in the font program of composite
+// font `font`, as specified by the CIDToGIDMap entry of its CIDFontType2 descendant font
+// (9.7.4.2 "Glyph Selection in CIDFonts"). CIDs are used as glyph indices if no such mapping
+// exists. The returned bool is false if `cid` is not mapped.
+func (font *PdfFont) CIDToGID(cid textencoding.CharCode) (textencoding.GID, bool) {
+	switch t := font.context.(type) {
+	case *pdfFontType0:
+		if t.DescendantFont != nil {
+			return t.DescendantFont.CIDToGID(cid)
+		}
+	case *pdfCIDFontType2:
+		if t.cidToGID != nil {
+			if int(cid) >= len(t.cidToGID) {
+				return 0, false
+			}
+			return t.cidToGID[cid], true
+		}
+	}
+	return textencoding.GID(cid), true
+}
+
 // actualFont returns the Font in font.context
 func (font PdfFont) actualFont() pdfFont {
 	if font.context == nil {
@@ -699,7 +747,9 @@ func (base fontCommon) asPdfObjectDictionary(subtype string) *core.PdfObjectDict
 
 	d := core.MakeDict()
 	d.Set("Type", core.MakeName("Font"))
-	d.Set("BaseFont", core.MakeName(base.basefont))
+	if base.basefont != "" || base.subtype != "Type3" {
+		d.Set("BaseFont", core.MakeName(base.basefont))
+	}
 	d.Set("Subtype", core.MakeName(base.subtype))
 
 	if base.fontDescriptor != nil {
@@ -793,13 +843,9 @@ func newFontBaseFieldsFromPdfObject(fontObj core.PdfObject) (*core.PdfObjectDict
 		font.name = name
 	}
 
-	if subtype == "Type3" {
-		common.Log.Debug("ERROR: Type 3 font not supported. d=%s", d)
-		return d, font, ErrType3FontNotSupported
-	}
-
+	// BaseFont is optional for Type 3 fonts.
 	basefont, ok := core.GetNameVal(d.Get("BaseFont"))
-	if !ok {
+	if !ok && subtype != "Type3" {
 		common.Log.Debug("ERROR: Font Incompatibility. BaseFont (Required) missing")
 		return d, font, ErrRequiredAttributeMissing
 	}
@@ -389,6 +389,16 @@ func newPdfFontType0FromPdfObject(d *core.PdfObjectDictionary, base *fontCommon)
 		} else {
 			common.Log.Debug("Unhandled cmap %q", encoderName)
 		}
+	} else if stream, ok := core.GetStream(d.Get("Encoding")); ok {
+		// Embedded CMap.
+		data, err := core.DecodeStream(stream)
+		if err != nil {
+			return nil, err
+		}
+		font.codeToCID, err = cmap.LoadCmapFromDataCID(data)
+		if err != nil {
+			common.Log.Debug("WARN: could not load embedded CMap: %v", err)
+		}
 	}
 
 	if cidToUnicode := df.baseFields().toUnicodeCmap; cidToUnicode != nil {
@@ -536,6 +546,8 @@ type pdfCIDFontType2 struct {
 
 	// CIDs to glyph indices mapping (optional).
 	CIDToGIDMap core.PdfObject
+	// cidToGID contains the glyph indices of the CIDs, loaded from a CIDToGIDMap stream.
+	cidToGID []textencoding.GID
 
 	widths       map[textencoding.CharCode]float64
 	defaultWidth float64
@@ -650,6 +662,16 @@ func newPdfCIDFontType2FromPdfObject(d *core.PdfObjectDictionary, base *fontComm
 	font.DW2 = d.Get("DW2")
 	font.W2 = d.Get("W2")
 	font.CIDToGIDMap = d.Get("CIDToGIDMap")
+	if stream, ok := core.GetStream(font.CIDToGIDMap); ok {
+		data, err := core.DecodeStream(stream)
+		if err != nil {
+			return nil, err
+		}
+		font.cidToGID = make([]textencoding.GID, len(data)/2)
+		for i := range font.cidToGID {
+			font.cidToGID[i] = textencoding.GID(data[2*i])<<8 | textencoding.GID(data[2*i+1])
+		}
+	}
 
 	// Get font default glyph width.
 	font.defaultWidth = 1000.0
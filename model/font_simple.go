@@ -51,6 +51,11 @@ type pdfFontSimple struct {
 	// std14Encoder is used for Standard 14 fonts where no /Encoding is specified in the font dict.
 	std14Encoder textencoding.TextEncoder
 
+	// baseEncoder and differences hold the glyph names selected by the /Encoding entry in the
+	// font dict. They are used for looking up glyphs in embedded font programs.
+	baseEncoder textencoding.SimpleEncoder
+	differences map[textencoding.CharCode]textencoding.GlyphName
+
 	// std14Descriptor is used for Standard 14 fonts where no /FontDescriptor is specified in the font dict.
 	std14Descriptor *PdfFontDescriptor
 
@@ -63,6 +68,16 @@ type pdfFontSimple struct {
 
 	// Standard 14 fonts metrics
 	fontMetrics map[rune]fonts.CharMetrics
+
+	// Type 3 font entries (9.6.5 "Type 3 Fonts").
+	FontBBox   core.PdfObject
+	FontMatrix core.PdfObject
+	CharProcs  core.PdfObject
+	Resources  core.PdfObject
+
+	fontMatrix [6]float64
+	charProcs  map[textencoding.GlyphName]*core.PdfObjectStream
+	resources  *PdfPageResources
 }
 
 // pdfCIDFontType0FromSkeleton returns a pdfFontSimple with its common fields initalized.
@@ -220,6 +235,9 @@ func newSimpleFontFromPdfObject(d *core.PdfObjectDictionary, base *fontCommon,
 	}
 
 	font.Encoding = core.TraceToDirectObject(d.Get("Encoding"))
+	if font.subtype == "Type3" {
+		font.loadType3Fields(d)
+	}
 	return font, nil
 }
 
@@ -258,6 +276,11 @@ func (font *pdfFontSimple) addEncoding() error {
 		if err != nil {
 			return err
 		}
+		font.baseEncoder, err = textencoding.NewSimpleTextEncoder(baseEncoder, nil)
+		if err != nil {
+			return err
+		}
+		font.differences = differences
 	}
 
 	if encoder == nil {
@@ -347,6 +370,22 @@ func (font *pdfFontSimple) getFontEncoding() (baseName string, differences map[t
 	}
 }
 
+// charcodeToGlyphName returns the name of the glyph selected by character code `code` through the
+// /Encoding entry in the font dict.
+func (font *pdfFontSimple) charcodeToGlyphName(code textencoding.CharCode) (textencoding.GlyphName, bool) {
+	if name, ok := font.differences[code]; ok {
+		return name, true
+	}
+	if font.baseEncoder == nil {
+		return "", false
+	}
+	r, ok := font.baseEncoder.CharcodeToRune(code)
+	if !ok {
+		return "", false
+	}
+	return textencoding.RuneToGlyph(r)
+}
+
 var builtinEncodings = map[string]string{
 	"Symbol":       "SymbolEncoding",
 	"ZapfDingbats": "ZapfDingbatsEncoding",
@@ -377,6 +416,9 @@ func (font *pdfFontSimple) ToPdfObject() core.PdfObject {
 			d.Set("Encoding", encObj)
 		}
 	}
+	if font.subtype == "Type3" {
+		font.setType3Fields(d)
+	}
 
 	return font.container
 }
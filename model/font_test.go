@@ -869,6 +869,210 @@ func TestLoadSimpleFontWithDifferences(t *testing.T) {
 	}
 }
 
+// TestCharcodeToGlyphName checks that glyph names are selected through the Differences entry of the
+// font encoding, and through its base encoding otherwise.
+func TestCharcodeToGlyphName(t *testing.T) {
+	raw := `
+	1 0 obj
+	<< /Type /Font
+		/BaseFont /Helvetica
+		/Subtype /Type1
+		/Encoding << /Type /Encoding /BaseEncoding /WinAnsiEncoding /Differences [65 /Lslash /lslash] >>
+	>>
+	endobj
+	`
+
+	r := model.NewReaderForText(raw)
+	require.NoError(t, r.ParseIndObjSeries())
+
+	obj, err := r.GetIndirectObjectByNumber(1)
+	require.NoError(t, err)
+
+	font, err := model.NewPdfFontFromPdfObject(obj)
+	require.NoError(t, err)
+
+	testcases := map[textencoding.CharCode]textencoding.GlyphName{
+		65:  "Lslash",
+		66:  "lslash",
+		67:  "C",
+		128: "Euro",
+	}
+	for code, expected := range testcases {
+		name, ok := font.CharcodeToGlyphName(code)
+		require.True(t, ok, "code=%d", code)
+		require.Equal(t, expected, name, "code=%d", code)
+	}
+}
+
+// TestCIDToGID checks that glyph indices are selected through the CIDToGIDMap stream of
+// CIDFontType2 fonts.
+func TestCIDToGID(t *testing.T) {
+	raw := `
+	1 0 obj
+	<< /Type /Font
+		/Subtype /Type0
+		/BaseFont /Test
+		/Encoding /Identity-H
+		/DescendantFonts [2 0 R]
+	>>
+	endobj
+	2 0 obj
+	<< /Type /Font
+		/Subtype /CIDFontType2
+		/BaseFont /Test
+		/CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >>
+		/CIDToGIDMap 3 0 R
+	>>
+	endobj
+	3 0 obj
+	<< /Length 6 >>
+	stream
+` + "\x00\x05\x00\x07\x00\x09" + `
+endstream
+	endobj
+	`
+
+	r := model.NewReaderForText(raw)
+	require.NoError(t, r.ParseIndObjSeries())
+
+	obj, err := r.GetIndirectObjectByNumber(1)
+	require.NoError(t, err)
+
+	font, err := model.NewPdfFontFromPdfObject(obj)
+	require.NoError(t, err)
+
+	for code, expected := range []textencoding.GID{5, 7, 9} {
+		cid, ok := font.CharcodeToCID(textencoding.CharCode(code))
+		require.True(t, ok)
+		require.Equal(t, textencoding.CharCode(code), cid)
+
+		gid, ok := font.CIDToGID(cid)
+		require.True(t, ok)
+		require.Equal(t, expected, gid)
+	}
+
+	_, ok := font.CIDToGID(3)
+	require.False(t, ok)
+}
+
+// TestType3Font checks that Type 3 fonts are loaded with their glyph procedures, font matrix and
+// encoding.
+func TestType3Font(t *testing.T) {
+	raw := `
+	1 0 obj
+	<< /Type /Font
+		/Subtype /Type3
+		/FontBBox [0 0 1000 1000]
+		/FontMatrix [0.0005 0 0 0.0005 0 0]
+		/CharProcs << /square 2 0 R /triangle 3 0 R >>
+		/Encoding << /Type /Encoding /Differences [65 /square /triangle] >>
+		/FirstChar 65
+		/LastChar 66
+		/Widths [1000 1500]
+	>>
+	endobj
+	2 0 obj
+	<< /Length 38 >>
+	stream
+1000 0 0 0 800 800 d1 0 0 800 800 re f
+endstream
+	endobj
+	3 0 obj
+	<< /Length 35 >>
+	stream
+1500 0 d0 0 0 m 800 0 l 400 800 l f
+endstream
+	endobj
+	`
+
+	r := model.NewReaderForText(raw)
+	require.NoError(t, r.ParseIndObjSeries())
+
+	obj, err := r.GetIndirectObjectByNumber(1)
+	require.NoError(t, err)
+
+	font, err := model.NewPdfFontFromPdfObject(obj)
+	require.NoError(t, err)
+	require.Equal(t, "Type3", font.Subtype())
+
+	matrix, ok := font.Type3FontMatrix()
+	require.True(t, ok)
+	require.Equal(t, [6]float64{0.0005, 0, 0, 0.0005, 0, 0}, matrix)
+
+	// Widths are converted from glyph space to thousandths of text space units.
+	metrics, ok := font.GetCharMetrics(65)
+	require.True(t, ok)
+	require.Equal(t, 500.0, metrics.Wx)
+	metrics, ok = font.GetCharMetrics(66)
+	require.True(t, ok)
+	require.Equal(t, 750.0, metrics.Wx)
+
+	for _, code := range []textencoding.CharCode{65, 66} {
+		stream, ok := font.Type3CharProc(code)
+		require.True(t, ok)
+		expected, err := r.GetIndirectObjectByNumber(int(code) - 63)
+		require.NoError(t, err)
+		require.Equal(t, expected, stream)
+	}
+	_, ok = font.Type3CharProc(67)
+	require.False(t, ok)
+
+	name, ok := font.CharcodeToGlyphName(66)
+	require.True(t, ok)
+	require.Equal(t, textencoding.GlyphName("triangle"), name)
+
+	dict, ok := core.GetDict(font.ToPdfObject())
+	require.True(t, ok)
+	require.Nil(t, dict.Get("BaseFont"))
+	require.NotNil(t, dict.Get("CharProcs"))
+	require.NotNil(t, dict.Get("FontMatrix"))
+}
+
+// TestType3FontInvalid checks that Type 3 fonts with an invalid FontMatrix or CharProcs are loaded
+// without glyph procedures, so that their text can still be extracted.
+func TestType3FontInvalid(t *testing.T) {
+	raw := `
+	1 0 obj
+	<< /Type /Font
+		/Subtype /Type3
+		/FontMatrix [0.001 0]
+		/CharProcs 2 0 R
+		/Encoding << /Type /Encoding /Differences [65 /A] >>
+		/FirstChar 65
+		/LastChar 65
+		/Widths [600]
+	>>
+	endobj
+	2 0 obj
+	(not a dictionary)
+	endobj
+	`
+
+	r := model.NewReaderForText(raw)
+	require.NoError(t, r.ParseIndObjSeries())
+
+	obj, err := r.GetIndirectObjectByNumber(1)
+	require.NoError(t, err)
+
+	font, err := model.NewPdfFontFromPdfObject(obj)
+	require.NoError(t, err)
+	require.Equal(t, "Type3", font.Subtype())
+
+	matrix, ok := font.Type3FontMatrix()
+	require.True(t, ok)
+	require.Equal(t, [6]float64{0.001, 0, 0, 0.001, 0, 0}, matrix)
+
+	metrics, ok := font.GetCharMetrics(65)
+	require.True(t, ok)
+	require.Equal(t, 600.0, metrics.Wx)
+
+	_, ok = font.Type3CharProc(65)
+	require.False(t, ok)
+	name, ok := font.CharcodeToGlyphName(65)
+	require.True(t, ok)
+	require.Equal(t, textencoding.GlyphName("A"), name)
+}
+
 // newStandandTextEncoder returns a simpleEncoder that implements StandardEncoding.
 // The non-symbolic standard 14 fonts have StandardEncoding.
 func newStandandTextEncoder(t *testing.T) textencoding.SimpleEncoder {
@@ -0,0 +1,136 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"github.com/showntop/unipdf/common"
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/internal/textencoding"
+)
+
+// Type 3 fonts are simple fonts whose glyphs are defined by streams of graphics operators, the
+// glyph procedures, instead of font programs. The glyph procedures are stored in the CharProcs
+// dictionary, keyed by the glyph names selected through the Differences array of the font
+// encoding (9.6.5 "Type 3 Fonts").
+//
+// They are loaded as pdfFontSimple instances with the additional fields below. The Widths of Type
+// 3 fonts are expressed in glyph space. They are converted to thousandths of text space units when
+// the font is loaded, so that the character metrics are consistent with the other simple fonts.
+
+// defaultType3FontMatrix is the FontMatrix used for Type 3 fonts with an invalid FontMatrix. It
+// is the FontMatrix of most Type 3 fonts, 1000 glyph space units per text space unit.
+var defaultType3FontMatrix = [6]float64{0.001, 0, 0, 0.001, 0, 0}
+
+// loadType3Fields loads the entries of Type 3 font dictionary `d` into `font`.
+// Invalid entries are logged and skipped rather than failing the font, so that the text of the
+// font can still be extracted. A font with an invalid FontMatrix uses defaultType3FontMatrix and
+// a font with invalid CharProcs has no glyph procedures.
+func (font *pdfFontSimple) loadType3Fields(d *core.PdfObjectDictionary) {
+	font.FontBBox = d.Get("FontBBox")
+	font.FontMatrix = d.Get("FontMatrix")
+	font.CharProcs = d.Get("CharProcs")
+	font.Resources = d.Get("Resources")
+
+	font.fontMatrix = defaultType3FontMatrix
+	if arr, ok := core.GetArray(font.FontMatrix); !ok || arr.Len() != 6 {
+		common.Log.Debug("ERROR: Type 3 font with invalid FontMatrix. font=%s", font)
+	} else if matrix, err := arr.ToFloat64Array(); err != nil {
+		common.Log.Debug("ERROR: Type 3 font with invalid FontMatrix. font=%s err=%v", font, err)
+	} else {
+		copy(font.fontMatrix[:], matrix)
+	}
+
+	font.charProcs = map[textencoding.GlyphName]*core.PdfObjectStream{}
+	if charProcs, ok := core.GetDict(font.CharProcs); ok {
+		for _, name := range charProcs.Keys() {
+			stream, ok := core.GetStream(charProcs.Get(name))
+			if !ok {
+				common.Log.Debug("Type 3 font with invalid glyph procedure %s", name)
+				continue
+			}
+			font.charProcs[textencoding.GlyphName(name)] = stream
+		}
+	} else {
+		common.Log.Debug("ERROR: Type 3 font with invalid CharProcs. font=%s", font)
+	}
+
+	if resources, ok := core.GetDict(font.Resources); ok {
+		var err error
+		font.resources, err = NewPdfPageResourcesFromDict(resources)
+		if err != nil {
+			common.Log.Debug("ERROR: Type 3 font with invalid Resources. font=%s err=%v", font, err)
+			font.resources = nil
+		}
+	}
+
+	// Convert the glyph widths to thousandths of text space units.
+	for code, w := range font.charWidths {
+		font.charWidths[code] = w * font.fontMatrix[0] * 1000
+	}
+}
+
+// setType3Fields adds the entries of Type 3 font `font` to font dictionary `d`.
+func (font *pdfFontSimple) setType3Fields(d *core.PdfObjectDictionary) {
+	if font.FontBBox != nil {
+		d.Set("FontBBox", font.FontBBox)
+	}
+	if font.FontMatrix != nil {
+		d.Set("FontMatrix", font.FontMatrix)
+	}
+	if font.CharProcs != nil {
+		d.Set("CharProcs", font.CharProcs)
+	}
+	if font.Resources != nil {
+		d.Set("Resources", font.Resources)
+	}
+}
+
+// type3Font returns the simple font underlying `font` if it is a Type 3 font.
+func (font *PdfFont) type3Font() (*pdfFontSimple, bool) {
+	t, ok := font.context.(*pdfFontSimple)
+	if !ok || t.subtype != "Type3" {
+		return nil, false
+	}
+	return t, true
+}
+
+// Type3FontMatrix returns the FontMatrix of Type 3 font `font`, which maps glyph space to text
+// space, as an array of 6 numbers [a b c d e f]. The returned bool is false if `font` is not a
+// Type 3 font.
+func (font *PdfFont) Type3FontMatrix() ([6]float64, bool) {
+	t, ok := font.type3Font()
+	if !ok {
+		return [6]float64{}, false
+	}
+	return t.fontMatrix, true
+}
+
+// Type3Resources returns the resources used by the glyph procedures of Type 3 font `font`. It
+// returns nil if `font` is not a Type 3 font or if it has no Resources entry, in which case the
+// resources of the content stream showing the glyphs are used.
+func (font *PdfFont) Type3Resources() *PdfPageResources {
+	t, ok := font.type3Font()
+	if !ok {
+		return nil
+	}
+	return t.resources
+}
+
+// Type3CharProc returns the glyph procedure selected by character code `code` in Type 3 font
+// `font`. The returned bool is false if `font` is not a Type 3 font or if it has no glyph
+// procedure for `code`.
+func (font *PdfFont) Type3CharProc(code textencoding.CharCode) (*core.PdfObjectStream, bool) {
+	t, ok := font.type3Font()
+	if !ok {
+		return nil, false
+	}
+	name, ok := t.charcodeToGlyphName(code)
+	if !ok {
+		return nil, false
+	}
+	stream, ok := t.charProcs[name]
+	return stream, ok
+}
@@ -263,41 +263,56 @@ func (form *PdfAcroForm) fill(provider FieldValueProvider, appGen FieldAppearanc
 	}
 
 	for _, field := range form.AllFields() {
-		// Try finding the field in the provider field map using its partial
-		// name. If not found, try finding it by its full name.
-		partialName := field.PartialName()
-		valObj, found := objMap[partialName]
+		valObj, found := fieldProviderValue(field, objMap)
 		if !found {
-			if fullName, err := field.FullName(); err == nil {
-				valObj, found = objMap[fullName]
-			}
-		}
-		if !found {
-			common.Log.Debug("WARN: form field %s not found in the provider. Skipping.", partialName)
+			common.Log.Debug("WARN: form field %s not found in the provider. Skipping.", field.PartialName())
 			continue
 		}
 
-		// Fill field with the provided value.
-		if err := fillFieldValue(field, valObj); err != nil {
+		if err := form.fillField(field, valObj, appGen); err != nil {
 			return err
 		}
+	}
 
-		// Generate field appearance based on the specified settings.
-		if appGen == nil {
-			continue
+	return nil
+}
+
+// fieldProviderValue returns the value of `field` from the field values map
+// of a provider. The field is looked up using its partial name and, if not
+// found, using its full name.
+func fieldProviderValue(field *PdfField, objMap map[string]core.PdfObject) (core.PdfObject, bool) {
+	valObj, found := objMap[field.PartialName()]
+	if !found {
+		if fullName, err := field.FullName(); err == nil {
+			valObj, found = objMap[fullName]
 		}
+	}
+	return valObj, found
+}
 
-		for _, annot := range field.Annotations {
-			// appGen generates the appearance based on the form/field/annotation and other settings
-			// depending on the implementation (for example may only generate appearance if none set).
-			apDict, err := appGen.GenerateAppearanceDict(form, field, annot)
-			if err != nil {
-				return err
-			}
+// fillField populates `field` with the value represented by `val`. If `appGen`
+// is not nil, the appearances of the field annotations are also generated.
+func (form *PdfAcroForm) fillField(field *PdfField, val core.PdfObject, appGen FieldAppearanceGenerator) error {
+	// Fill field with the provided value.
+	if err := fillFieldValue(field, val); err != nil {
+		return err
+	}
 
-			annot.AP = apDict
-			annot.ToPdfObject()
+	// Generate field appearance based on the specified settings.
+	if appGen == nil {
+		return nil
+	}
+
+	for _, annot := range field.Annotations {
+		// appGen generates the appearance based on the form/field/annotation and other settings
+		// depending on the implementation (for example may only generate appearance if none set).
+		apDict, err := appGen.GenerateAppearanceDict(form, field, annot)
+		if err != nil {
+			return err
 		}
+
+		annot.AP = apDict
+		annot.ToPdfObject()
 	}
 
 	return nil
@@ -25,6 +25,12 @@ type PdfFunction interface {
 // - Stream: Type 0, Type 4
 // - Dictionary: Type 2, Type 3.
 
+// NewPdfFunctionFromPdfObject loads a PDF function from the specified object, which can be either a
+// function dictionary or a function stream.
+func NewPdfFunctionFromPdfObject(obj core.PdfObject) (PdfFunction, error) {
+	return newPdfFunctionFromPdfObject(obj)
+}
+
 // Loads a PDF Function from a PdfObject (can be either stream or dictionary).
 func newPdfFunctionFromPdfObject(obj core.PdfObject) (PdfFunction, error) {
 	obj = core.ResolveReference(obj)
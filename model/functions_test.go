@@ -8,6 +8,8 @@ import (
 	"math"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/showntop/unipdf/common"
 	"github.com/showntop/unipdf/core"
 )
@@ -100,3 +102,21 @@ endobj
 
 	t.Logf("%s", stream.Stream)
 }
+
+func TestNewPdfFunctionFromPdfObject(t *testing.T) {
+	// Exponential interpolation function, used e.g. as soft mask transfer function.
+	parser := core.NewParserFromString(`<< /FunctionType 2 /Domain [0 1] /C0 [1] /C1 [0] /N 1 >>`)
+	obj, err := parser.ParseDict()
+	require.NoError(t, err)
+
+	fun, err := NewPdfFunctionFromPdfObject(obj)
+	require.NoError(t, err)
+
+	outputs, err := fun.Evaluate([]float64{0.25})
+	require.NoError(t, err)
+	require.Len(t, outputs, 1)
+	require.InDelta(t, 0.75, outputs[0], 1e-9)
+
+	_, err = NewPdfFunctionFromPdfObject(core.MakeInteger(1))
+	require.Error(t, err)
+}
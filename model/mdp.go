@@ -0,0 +1,162 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package model
+
+import (
+	"strings"
+
+	"github.com/showntop/unipdf/core"
+)
+
+// DocMDPPermission represents the access permissions granted by a certification
+// signature of a document, as specified by the P entry of the DocMDP transform
+// parameters dictionary (section 12.8.2.2 "DocMDP" p. 468 PDF32000_2008).
+type DocMDPPermission int64
+
+// DocMDP access permissions.
+const (
+	// DocMDPNoChanges specifies that no changes to the document are permitted.
+	DocMDPNoChanges DocMDPPermission = 1
+
+	// DocMDPFillForms specifies that filling in forms, instantiating page
+	// templates and signing are permitted.
+	DocMDPFillForms DocMDPPermission = 2
+
+	// DocMDPFillFormsAndAnnots specifies that, in addition to DocMDPFillForms
+	// changes, annotation creation, deletion and modification are permitted.
+	DocMDPFillFormsAndAnnots DocMDPPermission = 3
+)
+
+// GetDocMDPPermission returns the access permissions granted by the
+// certification signature of the document. The returned flag is false if the
+// document does not have a certification signature.
+func (r *PdfReader) GetDocMDPPermission() (DocMDPPermission, bool) {
+	permsDict, ok := core.GetDict(r.catalog.Get("Perms"))
+	if !ok {
+		return 0, false
+	}
+	sigDict, ok := core.GetDict(permsDict.Get("DocMDP"))
+	if !ok {
+		return 0, false
+	}
+
+	perm := DocMDPFillForms
+	for _, params := range getSignatureTransformParams(sigDict, "DocMDP") {
+		if p, ok := core.GetIntVal(params.Get("P")); ok && p >= 1 && p <= 3 {
+			perm = DocMDPPermission(p)
+		}
+	}
+	return perm, true
+}
+
+// getSignatureTransformParams returns the transform parameters dictionaries of
+// the signature references of `sigDict` using the specified transform method.
+func getSignatureTransformParams(sigDict *core.PdfObjectDictionary, method string) []*core.PdfObjectDictionary {
+	refs, ok := core.GetArray(sigDict.Get("Reference"))
+	if !ok {
+		return nil
+	}
+
+	var params []*core.PdfObjectDictionary
+	for _, obj := range refs.Elements() {
+		refDict, ok := core.GetDict(obj)
+		if !ok {
+			continue
+		}
+		if name, ok := core.GetNameVal(refDict.Get("TransformMethod")); !ok || name != method {
+			continue
+		}
+		if paramsDict, ok := core.GetDict(refDict.Get("TransformParams")); ok {
+			params = append(params, paramsDict)
+		}
+	}
+	return params
+}
+
+// fieldMDPLock represents a set of form fields locked by a signature
+// (section 12.8.2.4 "FieldMDP" p. 471 PDF32000_2008).
+type fieldMDPLock struct {
+	// Action is one of All, Include or Exclude.
+	action string
+	fields []string
+}
+
+// newFieldMDPLock loads a field lock from a FieldMDP transform parameters or
+// a signature field lock dictionary.
+func newFieldMDPLock(d *core.PdfObjectDictionary) (fieldMDPLock, bool) {
+	action, ok := core.GetNameVal(d.Get("Action"))
+	if !ok {
+		return fieldMDPLock{}, false
+	}
+
+	lock := fieldMDPLock{action: action}
+	if arr, ok := core.GetArray(d.Get("Fields")); ok {
+		for _, obj := range arr.Elements() {
+			if str, ok := core.GetString(obj); ok {
+				lock.fields = append(lock.fields, str.Decoded())
+			}
+		}
+	}
+	return lock, true
+}
+
+// isLocked returns true if the field with the fully qualified name `name` is
+// locked by `lock`. Locking a field also locks its descendants.
+func (lock fieldMDPLock) isLocked(name string) bool {
+	var listed bool
+	for _, field := range lock.fields {
+		if name == field || strings.HasPrefix(name, field+".") {
+			listed = true
+			break
+		}
+	}
+
+	switch lock.action {
+	case "All":
+		return true
+	case "Include":
+		return listed
+	case "Exclude":
+		return !listed
+	}
+	return false
+}
+
+// fieldMDPLocks returns the field locks of the signed signature fields of the form.
+// The locks are specified by the Lock entry of the signature fields, or by the
+// FieldMDP signature references of their signature dictionaries.
+func (form *PdfAcroForm) fieldMDPLocks() []fieldMDPLock {
+	var locks []fieldMDPLock
+	for _, sigField := range form.signatureFields() {
+		if sigField.V == nil {
+			continue
+		}
+
+		var hasLock bool
+		if fieldDict, ok := core.GetDict(sigField.GetContainingPdfObject()); ok {
+			if lockDict, ok := core.GetDict(fieldDict.Get("Lock")); ok {
+				if lock, ok := newFieldMDPLock(lockDict); ok {
+					locks = append(locks, lock)
+					hasLock = true
+				}
+			}
+		}
+		if hasLock {
+			continue
+		}
+
+		sigDict, ok := core.GetDict(sigField.V.GetContainingPdfObject())
+		if !ok {
+			continue
+		}
+		for _, params := range getSignatureTransformParams(sigDict, "FieldMDP") {
+			if lock, ok := newFieldMDPLock(params); ok {
+				locks = append(locks, lock)
+			}
+		}
+	}
+	return locks
+}
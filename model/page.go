@@ -405,6 +405,46 @@ func (p *PdfPage) GetMediaBox() (*PdfRectangle, error) {
 	return nil, errors.New("media box not defined")
 }
 
+// GetRotate gets the inheritable rotation value, either from the page
+// or a higher up page/pages struct. The returned value is the number of
+// degrees by which the page is rotated clockwise when displayed, normalized
+// to the [0, 360) range.
+func (p *PdfPage) GetRotate() (int64, error) {
+	if p.Rotate != nil {
+		return normalizeRotation(*p.Rotate), nil
+	}
+
+	node := p.Parent
+	for node != nil {
+		dict, ok := core.GetDict(node)
+		if !ok {
+			return 0, errors.New("invalid parent objects dictionary")
+		}
+
+		if obj := dict.Get("Rotate"); obj != nil {
+			rotate, ok := core.GetIntVal(obj)
+			if !ok {
+				return 0, errors.New("invalid Pages Rotate object")
+			}
+			return normalizeRotation(int64(rotate)), nil
+		}
+
+		node = dict.Get("Parent")
+	}
+
+	return 0, nil
+}
+
+// normalizeRotation normalizes the specified rotation angle, in degrees,
+// to the [0, 360) range.
+func normalizeRotation(angle int64) int64 {
+	angle %= 360
+	if angle < 0 {
+		angle += 360
+	}
+	return angle
+}
+
 // getParentResources searches for page resources in the parent nodes of the page.
 func (p *PdfPage) getParentResources() (*PdfPageResources, error) {
 	node := p.Parent
@@ -521,6 +561,15 @@ func (p *PdfPage) GetContainingPdfObject() core.PdfObject {
 	return p.primitive
 }
 
+// GetStructTreeRoot returns the root of the structure tree of the document containing the page.
+// It returns nil if the page was not loaded by a PdfReader or the document has no structure tree.
+func (p *PdfPage) GetStructTreeRoot() (core.PdfObject, error) {
+	if p.reader == nil {
+		return nil, nil
+	}
+	return p.reader.GetStructTreeRoot()
+}
+
 // ToPdfObject converts the PdfPage to a dictionary within an indirect object container.
 func (p *PdfPage) ToPdfObject() core.PdfObject {
 	container := p.primitive
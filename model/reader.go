@@ -150,6 +150,23 @@ func (r *PdfReader) Decrypt(password []byte) (bool, error) {
 	return true, nil
 }
 
+// decryptAs decrypts the PDF file with the access rights of `other`, a reader
+// of the same file which has been decrypted. Returns true if successful.
+func (r *PdfReader) decryptAs(other *PdfReader) (bool, error) {
+	success, err := r.parser.DecryptAs(other.parser)
+	if err != nil || !success {
+		return false, err
+	}
+
+	err = r.loadStructure()
+	if err != nil {
+		common.Log.Debug("ERROR: Fail to load structure (%s)", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
 // CheckAccessRights checks access rights and permissions for a specified password.  If either user/owner
 // password is specified,  full rights are granted, otherwise the access rights are specified by the
 // Permissions flag.
@@ -886,6 +903,20 @@ func (r *PdfReader) GetPageLabels() (core.PdfObject, error) {
 	return obj, nil
 }
 
+// GetStructTreeRoot returns the StructTreeRoot entry from the PDF catalog, the root of the
+// document's structure tree. It returns nil if the document has no structure tree. The references
+// in the tree are not resolved, as it references the pages of the document.
+func (r *PdfReader) GetStructTreeRoot() (core.PdfObject, error) {
+	obj := core.ResolveReference(r.catalog.Get("StructTreeRoot"))
+	if obj == nil {
+		return nil, nil
+	}
+	if _, isNull := obj.(*core.PdfObjectNull); isNull {
+		return nil, nil
+	}
+	return obj, nil
+}
+
 // Inspect inspects the object types, subtypes and content in the PDF file returning a map of
 // object type to number of instances of each.
 func (r *PdfReader) Inspect() (map[string]int, error) {
@@ -7,6 +7,7 @@ package sighandler
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/rsa"
 	"crypto/x509"
 	"errors"
@@ -25,6 +26,11 @@ type adobePKCS7Detached struct {
 
 	emptySignature    bool
 	emptySignatureLen int
+
+	// Signature timestamp client. If set, a timestamp token is requested for
+	// the signature value and added as an unsigned attribute.
+	timestampClient        TimestampClient
+	timestampHashAlgorithm crypto.Hash
 }
 
 // NewEmptyAdobePKCS7Detached creates a new Adobe.PPKMS/Adobe.PPKLite adbe.pkcs7.detached
@@ -46,6 +52,28 @@ func NewAdobePKCS7Detached(privateKey *rsa.PrivateKey, certificate *x509.Certifi
 	}, nil
 }
 
+// NewAdobePKCS7DetachedWithTimestamp creates a new Adobe.PPKMS/Adobe.PPKLite
+// adbe.pkcs7.detached signature handler, which adds a signature timestamp
+// (RFC 3161 id-aa-timeStampToken unsigned attribute) obtained using the
+// specified timestamp client to the generated signatures.
+// The hashAlgorithm parameter is used for computing the message imprint of the
+// timestamp request and can be crypto.SHA1, crypto.SHA256, crypto.SHA384, crypto.SHA512.
+// DefaultTimestampTokenSize additional bytes are reserved for the timestamp
+// token in the Contents of the signature.
+func NewAdobePKCS7DetachedWithTimestamp(privateKey *rsa.PrivateKey, certificate *x509.Certificate,
+	client TimestampClient, hashAlgorithm crypto.Hash) (model.SignatureHandler, error) {
+	if client == nil {
+		return nil, errors.New("timestamp client must not be nil")
+	}
+
+	return &adobePKCS7Detached{
+		certificate:            certificate,
+		privateKey:             privateKey,
+		timestampClient:        client,
+		timestampHashAlgorithm: hashAlgorithm,
+	}, nil
+}
+
 // InitSignature initialises the PdfSignature.
 func (a *adobePKCS7Detached) InitSignature(sig *model.PdfSignature) error {
 	if !a.emptySignature {
@@ -63,12 +91,27 @@ func (a *adobePKCS7Detached) InitSignature(sig *model.PdfSignature) error {
 	sig.SubFilter = core.MakeName("adbe.pkcs7.detached")
 	sig.Reference = nil
 
-	digest, err := handler.NewDigest(sig)
-	if err != nil {
-		return err
+	// The size of the Contents is fixed, so the signature is not computed
+	// (nor the signature timestamp requested) for reserving it.
+	sig.Contents = core.MakeHexString(string(make([]byte, handler.contentsLen())))
+	return nil
+}
+
+// contentsLen returns the number of bytes reserved for the signature in the
+// Contents of the signature dictionary.
+func (a *adobePKCS7Detached) contentsLen() int {
+	if a.emptySignature {
+		if a.emptySignatureLen > 0 {
+			return a.emptySignatureLen
+		}
+		return 8192
+	}
+
+	sigLen := 8192
+	if a.timestampClient != nil {
+		sigLen += DefaultTimestampTokenSize
 	}
-	digest.Write([]byte("calculate the Contents field size"))
-	return handler.Sign(sig, digest)
+	return sigLen
 }
 
 func (a *adobePKCS7Detached) getCertificate(sig *model.PdfSignature) (*x509.Certificate, error) {
@@ -122,21 +165,35 @@ func (a *adobePKCS7Detached) Validate(sig *model.PdfSignature, digest model.Hash
 		return model.SignatureValidationResult{}, err
 	}
 
-	return model.SignatureValidationResult{
+	result := model.SignatureValidationResult{
 		IsSigned:   true,
 		IsVerified: true,
-	}, nil
+	}
+
+	// Validate signature timestamps.
+	for _, signer := range p7.Signers {
+		for _, attr := range signer.UnauthenticatedAttributes {
+			if !attr.Type.Equal(oidAttributeTimeStampToken) {
+				continue
+			}
+
+			ts, err := validateSignatureTimestamp(attr.Value.Bytes, signer.EncryptedDigest)
+			if err != nil {
+				result.IsVerified = false
+				result.Errors = append(result.Errors, fmt.Sprintf("invalid signature timestamp: %v", err))
+				continue
+			}
+			result.GeneralizedTime = ts.Time
+		}
+	}
+
+	return result, nil
 }
 
 // Sign sets the Contents fields.
 func (a *adobePKCS7Detached) Sign(sig *model.PdfSignature, digest model.Hasher) error {
 	if a.emptySignature {
-		sigLen := a.emptySignatureLen
-		if sigLen <= 0 {
-			sigLen = 8192
-		}
-
-		sig.Contents = core.MakeHexString(string(make([]byte, sigLen)))
+		sig.Contents = core.MakeHexString(string(make([]byte, a.contentsLen())))
 		return nil
 	}
 
@@ -151,6 +208,15 @@ func (a *adobePKCS7Detached) Sign(sig *model.PdfSignature, digest model.Hasher)
 		return err
 	}
 
+	// Add the signature timestamp.
+	if a.timestampClient != nil {
+		err := addSignatureTimestamp(signedData, a.timestampClient, a.timestampHashAlgorithm)
+		if err != nil {
+			return err
+		}
+	}
+	sigLen := a.contentsLen()
+
 	// Call Detach() is you want to remove content from the signature
 	// and generate an S/MIME detached signature
 	signedData.Detach()
@@ -159,8 +225,11 @@ func (a *adobePKCS7Detached) Sign(sig *model.PdfSignature, digest model.Hasher)
 	if err != nil {
 		return err
 	}
+	if len(detachedSignature) > sigLen {
+		return fmt.Errorf("signature too large (%d > %d bytes)", len(detachedSignature), sigLen)
+	}
 
-	data := make([]byte, 8192)
+	data := make([]byte, sigLen)
 	copy(data, detachedSignature)
 
 	sig.Contents = core.MakeHexString(string(data))
@@ -14,29 +14,73 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
-	"net/http"
 	"time"
 
 	"github.com/showntop/unipdf/core"
 	"github.com/showntop/unipdf/model"
 	"github.com/unidoc/pkcs7"
-	"github.com/unidoc/timestamp"
 )
 
 // docTimeStamp DocTimeStamp signature handler.
 type docTimeStamp struct {
-	timestampServerURL string
-	hashAlgorithm      crypto.Hash
+	client        TimestampClient
+	hashAlgorithm crypto.Hash
+	signatureSize int
+}
+
+// DefaultTimestampTokenSize is the default number of bytes reserved for a
+// timestamp token in the Contents of a signature. The size of the tokens
+// issued by a timestamp authority for the same request may vary slightly, so
+// the space for the token is reserved up front instead of being sized to the
+// token obtained when the document is prepared for signing. The same size is
+// reserved for document timestamps and for the signature timestamps embedded
+// in PKCS#7 signatures.
+const DefaultTimestampTokenSize = 8192
+
+// DocTimeStampOpts defines options for configuring the DocTimeStamp signature
+// handler.
+type DocTimeStampOpts struct {
+	// SignatureSize is the number of bytes reserved for the timestamp token
+	// in the Contents of the signature. The token is padded with zeros to
+	// this size. Signing fails for tokens larger than this size. If 0,
+	// DefaultTimestampTokenSize is used.
+	SignatureSize int
 }
 
 // NewDocTimeStamp creates a new DocTimeStamp signature handler.
 // The timestampServerURL parameter can be empty string for the signature validation.
 // The hashAlgorithm parameter can be crypto.SHA1, crypto.SHA256, crypto.SHA384, crypto.SHA512.
 func NewDocTimeStamp(timestampServerURL string, hashAlgorithm crypto.Hash) (model.SignatureHandler, error) {
+	return NewDocTimeStampWithOpts(NewHTTPTimestampClient(timestampServerURL), hashAlgorithm, nil)
+}
+
+// NewDocTimeStampWithClient creates a new DocTimeStamp signature handler,
+// which obtains the timestamp tokens using the specified timestamp client.
+// The client parameter can be nil for the signature validation.
+// The hashAlgorithm parameter can be crypto.SHA1, crypto.SHA256, crypto.SHA384, crypto.SHA512.
+func NewDocTimeStampWithClient(client TimestampClient, hashAlgorithm crypto.Hash) (model.SignatureHandler, error) {
+	return NewDocTimeStampWithOpts(client, hashAlgorithm, nil)
+}
+
+// NewDocTimeStampWithOpts creates a new DocTimeStamp signature handler,
+// which obtains the timestamp tokens using the specified timestamp client
+// and is configured using the specified options. The opts parameter can be
+// nil for the default options.
+func NewDocTimeStampWithOpts(client TimestampClient, hashAlgorithm crypto.Hash,
+	opts *DocTimeStampOpts) (model.SignatureHandler, error) {
+	if opts == nil {
+		opts = &DocTimeStampOpts{}
+	}
+
+	signatureSize := opts.SignatureSize
+	if signatureSize <= 0 {
+		signatureSize = DefaultTimestampTokenSize
+	}
+
 	return &docTimeStamp{
-		timestampServerURL: timestampServerURL,
-		hashAlgorithm:      hashAlgorithm,
+		client:        client,
+		hashAlgorithm: hashAlgorithm,
+		signatureSize: signatureSize,
 	}, nil
 }
 
@@ -160,45 +204,23 @@ func (a *docTimeStamp) Sign(sig *model.PdfSignature, digest model.Hasher) error
 		return err
 	}
 
-	s := h.Sum(nil)
-	r := timestamp.Request{
-		HashAlgorithm:   a.hashAlgorithm,
-		HashedMessage:   s,
-		Certificates:    true,
-		Extensions:      nil,
-		ExtraExtensions: nil,
+	if a.client == nil {
+		return errors.New("timestamp client must not be nil")
 	}
-	data, err := r.Marshal()
+	token, err := a.client.GetTimestampToken(h.Sum(nil), a.hashAlgorithm)
 	if err != nil {
 		return err
 	}
 
-	resp, err := http.Post(a.timestampServerURL, "application/timestamp-query", bytes.NewBuffer(data))
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("http status code not ok (got %d)", resp.StatusCode)
-	}
-
-	var ci struct {
-		Version asn1.RawValue
-		Content asn1.RawValue
-	}
-
-	_, err = asn1.Unmarshal(body, &ci)
-	if err != nil {
-		return err
+	// Reserve the configured size for the token (see
+	// DefaultTimestampTokenSize).
+	if len(token) > a.signatureSize {
+		return fmt.Errorf("timestamp token too large (%d > %d bytes)", len(token), a.signatureSize)
 	}
+	data := make([]byte, a.signatureSize)
+	copy(data, token)
 
-	sig.Contents = core.MakeHexString(string(ci.Content.FullBytes))
+	sig.Contents = core.MakeHexString(string(data))
 	return nil
 }
 
@@ -0,0 +1,250 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package sighandler
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/unidoc/timestamp"
+
+	"github.com/showntop/unipdf/model"
+)
+
+// newTestCertificate returns a self-signed certificate and its private key.
+func newTestCertificate(t *testing.T, name string, extKeyUsage ...x509.ExtKeyUsage) (*x509.Certificate, *rsa.PrivateKey) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           extKeyUsage,
+		BasicConstraintsValid: true,
+	}
+	certData, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(certData)
+	require.NoError(t, err)
+	return cert, privateKey
+}
+
+// newTestTimestampAuthority returns a local timestamp authority issuing
+// timestamp tokens at time `tsaTime`.
+func newTestTimestampAuthority(t *testing.T, tsaTime time.Time) *LocalTimestampAuthority {
+	cert, privateKey := newTestCertificate(t, "Test TSA", x509.ExtKeyUsageTimeStamping)
+	tsa, err := NewLocalTimestampAuthority(cert, privateKey)
+	require.NoError(t, err)
+	tsa.Now = func() time.Time {
+		return tsaTime
+	}
+	return tsa
+}
+
+func TestLocalTimestampAuthority(t *testing.T) {
+	tsaTime := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	tsa := newTestTimestampAuthority(t, tsaTime)
+
+	h := crypto.SHA256.New()
+	h.Write([]byte("message"))
+	hashed := h.Sum(nil)
+
+	token, err := tsa.GetTimestampToken(hashed, crypto.SHA256)
+	require.NoError(t, err)
+	ts, err := timestamp.Parse(token)
+	require.NoError(t, err)
+	require.Equal(t, hashed, ts.HashedMessage)
+	require.Equal(t, crypto.SHA256, ts.HashAlgorithm)
+	require.True(t, tsaTime.Equal(ts.Time))
+
+	// The hashed message must match the hash algorithm.
+	_, err = tsa.GetTimestampToken(hashed, crypto.SHA512)
+	require.Error(t, err)
+
+	_, err = NewLocalTimestampAuthority(nil, nil)
+	require.Error(t, err)
+}
+
+func TestHTTPTimestampClient(t *testing.T) {
+	tsa := newTestTimestampAuthority(t, time.Now())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "secret" {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		require.Equal(t, "application/timestamp-query", r.Header.Get("Content-Type"))
+		tsa.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	client := NewHTTPTimestampClient(server.URL)
+	hashed := make([]byte, crypto.SHA256.Size())
+	_, err := client.GetTimestampToken(hashed, crypto.SHA256)
+	require.Error(t, err)
+
+	client.Header.Set("X-Api-Key", "secret")
+	token, err := client.GetTimestampToken(hashed, crypto.SHA256)
+	require.NoError(t, err)
+	ts, err := timestamp.Parse(token)
+	require.NoError(t, err)
+	require.Equal(t, hashed, ts.HashedMessage)
+}
+
+func TestParseTimestampResponse(t *testing.T) {
+	type status struct {
+		Status int
+	}
+
+	// Rejected request.
+	data, err := asn1.Marshal(struct{ Status status }{status{2}})
+	require.NoError(t, err)
+	_, err = parseTimestampResponse(data)
+	require.Error(t, err)
+
+	// Granted request without a token.
+	data, err = asn1.Marshal(struct{ Status status }{status{0}})
+	require.NoError(t, err)
+	_, err = parseTimestampResponse(data)
+	require.Error(t, err)
+
+	_, err = parseTimestampResponse([]byte("invalid"))
+	require.Error(t, err)
+}
+
+func TestDocTimeStampSign(t *testing.T) {
+	tsaTime := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+	tsa := newTestTimestampAuthority(t, tsaTime)
+	token, err := tsa.GetTimestampToken(make([]byte, crypto.SHA256.Size()), crypto.SHA256)
+	require.NoError(t, err)
+
+	// sign returns the signature of `document` created by `handler`.
+	sign := func(handler model.SignatureHandler, document string) *model.PdfSignature {
+		sig := &model.PdfSignature{}
+		require.NoError(t, handler.InitSignature(sig))
+		digest, err := handler.NewDigest(sig)
+		require.NoError(t, err)
+		digest.Write([]byte(document))
+		require.NoError(t, handler.Sign(sig, digest))
+		return sig
+	}
+
+	// By default, the Contents are padded to the default token size.
+	handler, err := NewDocTimeStampWithOpts(tsa, crypto.SHA256, nil)
+	require.NoError(t, err)
+	sig := sign(handler, "document")
+	require.Len(t, sig.Contents.Bytes(), DefaultTimestampTokenSize)
+
+	// Tokens larger than the configured size are refused, as they do not fit
+	// in the reserved Contents.
+	handler, err = NewDocTimeStampWithOpts(tsa, crypto.SHA256, &DocTimeStampOpts{SignatureSize: 16})
+	require.NoError(t, err)
+	require.Greater(t, len(token), 16)
+	require.Error(t, handler.InitSignature(&model.PdfSignature{}))
+
+	// The Contents are padded to the configured size.
+	handler, err = NewDocTimeStampWithOpts(tsa, crypto.SHA256, &DocTimeStampOpts{SignatureSize: 10000})
+	require.NoError(t, err)
+	sig = sign(handler, "document")
+	require.Len(t, sig.Contents.Bytes(), 10000)
+
+	handler, err = NewDocTimeStampWithClient(tsa, crypto.SHA256)
+	require.NoError(t, err)
+	sig = sign(handler, "document")
+	require.Len(t, sig.Contents.Bytes(), DefaultTimestampTokenSize)
+	require.True(t, handler.IsApplicable(sig))
+
+	// The timestamp is verified against the signed document.
+	validate := func(document string) model.SignatureValidationResult {
+		res, err := handler.Validate(sig, bytes.NewBufferString(document))
+		require.NoError(t, err)
+		return res
+	}
+	res := validate("document")
+	require.True(t, res.IsSigned)
+	require.True(t, res.IsVerified)
+	require.True(t, tsaTime.Equal(res.GeneralizedTime))
+	require.False(t, validate("modified document").IsVerified)
+
+	// A client is required for signing.
+	handler, err = NewDocTimeStampWithOpts(nil, crypto.SHA256, nil)
+	require.NoError(t, err)
+	require.Error(t, handler.InitSignature(&model.PdfSignature{}))
+}
+
+func TestAdobePKCS7DetachedWithTimestamp(t *testing.T) {
+	tsaTime := time.Date(2022, 6, 7, 8, 9, 10, 0, time.UTC)
+	tsa := newTestTimestampAuthority(t, tsaTime)
+	cert, privateKey := newTestCertificate(t, "Test Signer")
+
+	_, err := NewAdobePKCS7DetachedWithTimestamp(privateKey, cert, nil, crypto.SHA256)
+	require.Error(t, err)
+
+	// A single timestamp is requested, when signing. The Contents are
+	// reserved without requesting one.
+	client := &countingTimestampClient{client: tsa}
+	handler, err := NewAdobePKCS7DetachedWithTimestamp(privateKey, cert, client, crypto.SHA256)
+	require.NoError(t, err)
+	sig := &model.PdfSignature{}
+	require.NoError(t, handler.InitSignature(sig))
+	require.Zero(t, client.requests)
+	require.Len(t, sig.Contents.Bytes(), 8192+DefaultTimestampTokenSize)
+	digest, err := handler.NewDigest(sig)
+	require.NoError(t, err)
+	digest.Write([]byte("document"))
+	require.NoError(t, handler.Sign(sig, digest))
+	require.Equal(t, 1, client.requests)
+	require.Len(t, sig.Contents.Bytes(), 8192+DefaultTimestampTokenSize)
+
+	// The signature and its timestamp are verified.
+	res, err := handler.Validate(sig, bytes.NewBufferString("document"))
+	require.NoError(t, err)
+	require.True(t, res.IsVerified)
+	require.Empty(t, res.Errors)
+	require.True(t, tsaTime.Equal(res.GeneralizedTime))
+
+	// The timestamp must be for the signature value.
+	_, err = validateSignatureTimestamp(mustToken(t, tsa, []byte("other")), []byte("signature"))
+	require.Error(t, err)
+	ts, err := validateSignatureTimestamp(mustToken(t, tsa, []byte("signature")), []byte("signature"))
+	require.NoError(t, err)
+	require.True(t, tsaTime.Equal(ts.Time))
+}
+
+// countingTimestampClient is a timestamp client counting the requests made to
+// the wrapped client.
+type countingTimestampClient struct {
+	client   TimestampClient
+	requests int
+}
+
+// GetTimestampToken returns a timestamp token obtained from the wrapped client.
+func (c *countingTimestampClient) GetTimestampToken(hashed []byte, hashAlgorithm crypto.Hash) ([]byte, error) {
+	c.requests++
+	return c.client.GetTimestampToken(hashed, hashAlgorithm)
+}
+
+// mustToken returns a SHA-256 timestamp token for `data` issued by `tsa`.
+func mustToken(t *testing.T, tsa *LocalTimestampAuthority, data []byte) []byte {
+	h := crypto.SHA256.New()
+	h.Write(data)
+	token, err := tsa.GetTimestampToken(h.Sum(nil), crypto.SHA256)
+	require.NoError(t, err)
+	return token
+}
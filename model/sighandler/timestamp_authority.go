@@ -0,0 +1,120 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package sighandler
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/unidoc/timestamp"
+)
+
+// LocalTimestampAuthority is an in-process Time Stamping Authority, which
+// issues timestamp tokens signed using the specified certificate and private
+// key. It implements interface TimestampClient and can also serve timestamp
+// requests over HTTP (implements interface http.Handler). It is meant for
+// testing and offline use, where an external timestamp server is not available.
+type LocalTimestampAuthority struct {
+	certificate *x509.Certificate
+	signer      crypto.Signer
+
+	// Policy represents the TSA policy under which the timestamp tokens
+	// are issued. Defaults to an OID of the documentation arc (RFC 5612).
+	Policy asn1.ObjectIdentifier
+
+	// Now returns the time included in the issued timestamp tokens.
+	// If nil, time.Now is used.
+	Now func() time.Time
+}
+
+// NewLocalTimestampAuthority returns a new in-process Time Stamping Authority
+// which signs the timestamp tokens using the specified certificate and signer.
+func NewLocalTimestampAuthority(certificate *x509.Certificate, signer crypto.Signer) (*LocalTimestampAuthority, error) {
+	if certificate == nil {
+		return nil, errors.New("certificate must not be nil")
+	}
+	if signer == nil {
+		return nil, errors.New("signer must not be nil")
+	}
+
+	return &LocalTimestampAuthority{
+		certificate: certificate,
+		signer:      signer,
+		Policy:      asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 32473, 1},
+	}, nil
+}
+
+// GetTimestampToken returns a DER encoded timestamp token for the specified
+// hashed message. Implements interface TimestampClient.
+func (tsa *LocalTimestampAuthority) GetTimestampToken(hashedMessage []byte, hashAlgorithm crypto.Hash) ([]byte, error) {
+	resp, err := tsa.createResponse(hashedMessage, hashAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseTimestampResponse(resp)
+}
+
+// ServeHTTP responds to RFC 3161 timestamp requests. Implements interface
+// http.Handler.
+func (tsa *LocalTimestampAuthority) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	req, err := timestamp.ParseRequest(data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := tsa.createResponse(req.HashedMessage, req.HashAlgorithm)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/timestamp-reply")
+	w.Write(resp)
+}
+
+// createResponse returns a DER encoded timestamp response for the specified
+// hashed message.
+func (tsa *LocalTimestampAuthority) createResponse(hashedMessage []byte, hashAlgorithm crypto.Hash) ([]byte, error) {
+	if !hashAlgorithm.Available() {
+		return nil, errors.New("unsupported hash algorithm")
+	}
+	if len(hashedMessage) != hashAlgorithm.Size() {
+		return nil, errors.New("invalid hashed message length")
+	}
+
+	now := time.Now
+	if tsa.Now != nil {
+		now = tsa.Now
+	}
+
+	ts := timestamp.Timestamp{
+		HashAlgorithm:     hashAlgorithm,
+		HashedMessage:     hashedMessage,
+		Time:              now(),
+		Policy:            tsa.Policy,
+		AddTSACertificate: true,
+	}
+
+	return ts.CreateResponse(tsa.certificate, tsa.signer)
+}
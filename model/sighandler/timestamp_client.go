@@ -0,0 +1,180 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package sighandler
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/unidoc/pkcs7"
+	"github.com/unidoc/timestamp"
+)
+
+// oidAttributeTimeStampToken is the object identifier of the signature
+// timestamp token unsigned attribute (id-aa-timeStampToken, RFC 3161 Appendix A).
+var oidAttributeTimeStampToken = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 2, 14}
+
+// TimestampClient represents a RFC 3161 timestamp client, used for obtaining
+// timestamp tokens from a Time Stamping Authority (TSA).
+type TimestampClient interface {
+	// GetTimestampToken returns a DER encoded timestamp token (RFC 3161)
+	// for the specified hashed message, which has been computed using the
+	// specified hash algorithm.
+	GetTimestampToken(hashedMessage []byte, hashAlgorithm crypto.Hash) ([]byte, error)
+}
+
+// HTTPTimestampClient is a timestamp client which requests timestamp tokens
+// from a Time Stamping Authority over HTTP.
+type HTTPTimestampClient struct {
+	// ServerURL represents the URL of the timestamp server.
+	ServerURL string
+
+	// Header contains additional HTTP headers sent with each timestamp
+	// request (e.g. authentication headers).
+	Header http.Header
+
+	// HTTPClient is used for performing the timestamp requests.
+	// If nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+}
+
+// NewHTTPTimestampClient returns a new HTTP timestamp client, which requests
+// timestamp tokens from the timestamp server at the specified URL.
+func NewHTTPTimestampClient(serverURL string) *HTTPTimestampClient {
+	return &HTTPTimestampClient{
+		ServerURL: serverURL,
+		Header:    http.Header{},
+	}
+}
+
+// GetTimestampToken returns a DER encoded timestamp token for the specified
+// hashed message. Implements interface TimestampClient.
+func (c *HTTPTimestampClient) GetTimestampToken(hashedMessage []byte, hashAlgorithm crypto.Hash) ([]byte, error) {
+	r := timestamp.Request{
+		HashAlgorithm: hashAlgorithm,
+		HashedMessage: hashedMessage,
+		Certificates:  true,
+	}
+	data, err := r.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.ServerURL, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range c.Header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("Content-Type", "application/timestamp-query")
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("http status code not ok (got %d)", resp.StatusCode)
+	}
+
+	return parseTimestampResponse(body)
+}
+
+// parseTimestampResponse extracts the timestamp token from the specified
+// DER encoded timestamp response.
+func parseTimestampResponse(data []byte) ([]byte, error) {
+	var resp struct {
+		Status struct {
+			Status int
+		}
+		TimeStampToken asn1.RawValue `asn1:"optional"`
+	}
+
+	if _, err := asn1.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Status.Status > 1 {
+		return nil, fmt.Errorf("timestamp request not granted (status %d)", resp.Status.Status)
+	}
+	if len(resp.TimeStampToken.FullBytes) == 0 {
+		return nil, errors.New("timestamp response does not contain a timestamp token")
+	}
+
+	return resp.TimeStampToken.FullBytes, nil
+}
+
+// addSignatureTimestamp requests timestamp tokens for the signature values of
+// the signers of `signedData` and adds them to the signers as unsigned
+// attributes (id-aa-timeStampToken).
+func addSignatureTimestamp(signedData *pkcs7.SignedData, client TimestampClient, hashAlgorithm crypto.Hash) error {
+	if !hashAlgorithm.Available() {
+		return errors.New("unsupported timestamp hash algorithm")
+	}
+
+	signers := signedData.GetSignedData().SignerInfos
+	for i := range signers {
+		signer := &signers[i]
+
+		h := hashAlgorithm.New()
+		h.Write(signer.EncryptedDigest)
+		token, err := client.GetTimestampToken(h.Sum(nil), hashAlgorithm)
+		if err != nil {
+			return err
+		}
+
+		err = signer.SetUnauthenticatedAttributes([]pkcs7.Attribute{
+			{
+				Type:  oidAttributeTimeStampToken,
+				Value: asn1.RawValue{FullBytes: token},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateSignatureTimestamp parses and verifies the specified timestamp
+// token and checks that its message imprint matches the signature value.
+func validateSignatureTimestamp(token, signatureValue []byte) (*timestamp.Timestamp, error) {
+	ts, err := timestamp.Parse(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ts.HashAlgorithm.Available() {
+		return nil, fmt.Errorf("unsupported message imprint hash algorithm: %v", ts.HashAlgorithm)
+	}
+
+	h := ts.HashAlgorithm.New()
+	h.Write(signatureValue)
+	if !bytes.Equal(h.Sum(nil), ts.HashedMessage) {
+		return nil, errors.New("message imprint does not match the signature value")
+	}
+
+	return ts, nil
+}
@@ -887,16 +887,9 @@ func (w *PdfWriter) Encrypt(userPass, ownerPass []byte, options *EncryptOptions)
 		perm = options.Permissions
 	}
 
-	var cf crypt.Filter
-	switch algo {
-	case RC4_128bit:
-		cf = crypt.NewFilterV2(16)
-	case AES_128bit:
-		cf = crypt.NewFilterAESV2()
-	case AES_256bit:
-		cf = crypt.NewFilterAESV3()
-	default:
-		return fmt.Errorf("unsupported algorithm: %v", options.Algorithm)
+	cf, err := newCryptFilter(algo)
+	if err != nil {
+		return err
 	}
 	crypter, info, err := core.PdfCryptNewEncrypt(cf, userPass, ownerPass, perm)
 	if err != nil {
@@ -918,6 +911,19 @@ func (w *PdfWriter) Encrypt(userPass, ownerPass []byte, options *EncryptOptions)
 	return nil
 }
 
+// newCryptFilter returns the crypt filter used for the specified encryption algorithm.
+func newCryptFilter(algo EncryptionAlgorithm) (crypt.Filter, error) {
+	switch algo {
+	case RC4_128bit:
+		return crypt.NewFilterV2(16), nil
+	case AES_128bit:
+		return crypt.NewFilterAESV2(), nil
+	case AES_256bit:
+		return crypt.NewFilterAESV3(), nil
+	}
+	return nil, fmt.Errorf("unsupported algorithm: %v", algo)
+}
+
 // Wrapper function to handle writing out string.
 func (w *PdfWriter) writeString(s string) {
 	if w.werr != nil {
@@ -1085,6 +1091,23 @@ func (w *PdfWriter) Write(writer io.Writer) error {
 				common.Log.Debug("ERROR: Failed encrypting (%s)", err)
 				return err
 			}
+
+			// Signature dictionaries are not traversed by the crypter.
+			// Encrypt their entries, except for the signature Contents.
+			if ind, ok := obj.(*core.PdfIndirectObject); ok {
+				if sigDict, ok := ind.PdfObject.(*pdfSignDictionary); ok {
+					for _, key := range sigDict.Keys() {
+						if key == "Contents" {
+							continue
+						}
+						err := w.crypter.Encrypt(sigDict.Get(key), int64(objectNumber), 0)
+						if err != nil {
+							common.Log.Debug("ERROR: Failed encrypting (%s)", err)
+							return err
+						}
+					}
+				}
+			}
 		}
 		w.writeObject(int(objectNumber), obj)
 	}
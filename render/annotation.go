@@ -0,0 +1,126 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"math"
+
+	"github.com/showntop/unipdf/common"
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/internal/transform"
+	"github.com/showntop/unipdf/model"
+	"github.com/showntop/unipdf/render/internal/context"
+)
+
+// RenderTarget represents the purpose for which PDF pages are rendered. It
+// determines which annotations are visible on the rendered pages.
+type RenderTarget int
+
+// Render targets.
+const (
+	// ScreenTarget renders pages as they are displayed by PDF viewers.
+	// Annotations having the NoView flag set are not rendered.
+	ScreenTarget RenderTarget = iota
+
+	// PrintTarget renders pages as they are printed. Only annotations having
+	// the Print flag set are rendered.
+	PrintTarget
+)
+
+// renderAnnotations renders the normal appearances of the annotations of the
+// specified page, which are visible for the specified render target.
+func (r renderer) renderAnnotations(ctx context.Context, page *model.PdfPage, target RenderTarget) error {
+	annotations, err := page.GetAnnotations()
+	if err != nil {
+		return err
+	}
+
+	for _, annotation := range annotations {
+		flags := annotation.Flags()
+		if flags.Has(model.AnnotationFlagHidden) {
+			continue
+		}
+		if target == PrintTarget && !flags.Has(model.AnnotationFlagPrint) {
+			continue
+		}
+		if target == ScreenTarget && flags.Has(model.AnnotationFlagNoView) {
+			continue
+		}
+
+		if err := r.renderAnnotation(ctx, annotation, page.Resources); err != nil {
+			common.Log.Debug("ERROR: could not render annotation: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// renderAnnotation renders the normal appearance of the specified annotation.
+// The bounding box of the appearance stream, as transformed by its matrix,
+// is mapped to the annotation rectangle (section 12.5.5 "Appearance Streams"
+// p. 395 PDF32000_2008).
+func (r renderer) renderAnnotation(ctx context.Context, annotation *model.PdfAnnotation,
+	resources *model.PdfPageResources) error {
+	xform, err := annotation.GetNormalAppearance()
+	if err != nil || xform == nil {
+		return err
+	}
+
+	rectArr, ok := core.GetArray(annotation.Rect)
+	if !ok {
+		return errType
+	}
+	rect, err := model.NewPdfRectangle(*rectArr)
+	if err != nil {
+		return err
+	}
+	rect = normalizeBox(rect)
+
+	bboxArr, ok := core.GetArray(xform.BBox)
+	if !ok {
+		return errType
+	}
+	bbox, err := model.NewPdfRectangle(*bboxArr)
+	if err != nil {
+		return err
+	}
+
+	m, err := formMatrix(xform)
+	if err != nil {
+		return err
+	}
+
+	// Compute the bounding box of the transformed appearance box.
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, p := range [][2]float64{
+		{bbox.Llx, bbox.Lly}, {bbox.Urx, bbox.Lly},
+		{bbox.Urx, bbox.Ury}, {bbox.Llx, bbox.Ury},
+	} {
+		x, y := m.Transform(p[0], p[1])
+		minX, maxX = math.Min(minX, x), math.Max(maxX, x)
+		minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+	}
+	if maxX-minX <= 0 || maxY-minY <= 0 {
+		return nil
+	}
+
+	// Map the transformed appearance box to the annotation rectangle. The
+	// matrix of the appearance stream is applied when rendering the form.
+	a := transform.TranslationMatrix(rect.Llx, rect.Lly).
+		Mult(transform.ScaleMatrix(rect.Width()/(maxX-minX), rect.Height()/(maxY-minY))).
+		Mult(transform.TranslationMatrix(-minX, -minY))
+
+	ctx.Push()
+	defer ctx.Pop()
+
+	// Set defaults.
+	ctx.SetLineWidth(1.0)
+	ctx.SetRGBA(0, 0, 0, 1)
+
+	ctx.SetMatrix(ctx.Matrix().Mult(a))
+	return r.renderForm(ctx, xform, resources)
+}
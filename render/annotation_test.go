@@ -0,0 +1,91 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+)
+
+// newTestAnnotation returns an annotation with rectangle `rect`, flags
+// `flags` and a normal appearance with dictionary `dict` and content stream
+// `contents`.
+func newTestAnnotation(t *testing.T, rect model.PdfRectangle, flags model.AnnotationFlag,
+	dict, contents string) *model.PdfAnnotation {
+	annotation := model.NewPdfAnnotationSquare().PdfAnnotation
+	annotation.Rect = rect.ToPdfObject()
+	annotation.F = core.MakeInteger(int64(flags))
+
+	ap := core.MakeDict()
+	ap.Set("N", makeTestStream(t, dict, []byte(contents)))
+	annotation.AP = ap
+	return annotation
+}
+
+func TestAnnotationRendering(t *testing.T) {
+	// renderAnnotations renders a page with annotations `annotations` using
+	// image device `device`.
+	renderAnnotations := func(device *ImageDevice, annotations ...*model.PdfAnnotation) image.Image {
+		page := newTestPage(t, "", nil)
+		page.SetAnnotations(annotations)
+		img, err := device.Render(page)
+		require.NoError(t, err)
+		return img
+	}
+
+	// The appearance box is mapped to the annotation rectangle.
+	const squareForm = "<< /Type /XObject /Subtype /Form /BBox [0 0 10 10] >>"
+	square := newTestAnnotation(t, model.PdfRectangle{Llx: 20, Lly: 20, Urx: 40, Ury: 40},
+		model.AnnotationFlagPrint, squareForm, "1 0 0 rg 0 0 10 10 re f")
+	img := renderAnnotations(NewImageDevice(), square)
+	requirePixel(t, img, 21, 21, testRed, 1)
+	requirePixel(t, img, 39, 39, testRed, 1)
+	requirePixel(t, img, 45, 30, testWhite, 1)
+	requirePixel(t, img, 30, 45, testWhite, 1)
+
+	// The appearance box is transformed by the matrix of the appearance
+	// stream before it is mapped to the annotation rectangle, with
+	// reversed coordinates.
+	rotated := newTestAnnotation(t, model.PdfRectangle{Llx: 80, Lly: 80, Urx: 60, Ury: 20},
+		model.AnnotationFlagPrint,
+		"<< /Type /XObject /Subtype /Form /BBox [0 0 30 10] /Matrix [0 1 -1 0 0 0] >>",
+		"0 0 1 rg 0 0 10 10 re f")
+	img = renderAnnotations(NewImageDevice(), rotated)
+	requirePixel(t, img, 70, 30, testBlue, 1)
+	requirePixel(t, img, 70, 50, testWhite, 1)
+	requirePixel(t, img, 70, 70, testWhite, 1)
+
+	// The annotations that are rendered depend on their flags and on the
+	// render target.
+	hidden := newTestAnnotation(t, model.PdfRectangle{Llx: 0, Lly: 80, Urx: 20, Ury: 100},
+		model.AnnotationFlagHidden|model.AnnotationFlagPrint, squareForm, "0 g 0 0 10 10 re f")
+	noView := newTestAnnotation(t, model.PdfRectangle{Llx: 0, Lly: 0, Urx: 20, Ury: 20},
+		model.AnnotationFlagNoView|model.AnnotationFlagPrint, squareForm, "0 g 0 0 10 10 re f")
+	noPrint := newTestAnnotation(t, model.PdfRectangle{Llx: 80, Lly: 0, Urx: 100, Ury: 20},
+		0, squareForm, "0 g 0 0 10 10 re f")
+	annotations := []*model.PdfAnnotation{square, hidden, noView, noPrint}
+
+	img = renderAnnotations(NewImageDevice(), annotations...)
+	requirePixel(t, img, 30, 30, testRed, 1)
+	requirePixel(t, img, 10, 90, testWhite, 1)
+	requirePixel(t, img, 10, 10, testWhite, 1)
+	requirePixel(t, img, 90, 10, testBlack, 1)
+
+	img = renderAnnotations(&ImageDevice{Target: PrintTarget}, annotations...)
+	requirePixel(t, img, 30, 30, testRed, 1)
+	requirePixel(t, img, 10, 90, testWhite, 1)
+	requirePixel(t, img, 10, 10, testBlack, 1)
+	requirePixel(t, img, 90, 10, testWhite, 1)
+
+	img = renderAnnotations(&ImageDevice{SkipAnnotations: true}, annotations...)
+	requirePixel(t, img, 30, 30, testWhite, 1)
+	requirePixel(t, img, 90, 10, testWhite, 1)
+}
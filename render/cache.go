@@ -0,0 +1,292 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"container/list"
+	"image"
+	"sync"
+
+	"github.com/showntop/unipdf/common"
+	"github.com/showntop/unipdf/contentstream"
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+	"github.com/showntop/unipdf/render/internal/context"
+)
+
+// renderCache holds the resources shared by the pages of a document, so that
+// they are loaded only once when rendering multiple pages: text fonts, Type 3
+// fonts, decoded images and the parsed content streams of form XObjects. The
+// cache is safe for concurrent use. A nil cache does not hold any resources.
+type renderCache struct {
+	fonts      *lruCache
+	type3Fonts *lruCache
+	images     *lruCache
+	forms      *lruCache
+}
+
+// newRenderCache returns a new render cache holding at most `maxFonts` text
+// fonts, `maxFonts` Type 3 fonts, `maxImageBytes` bytes of decoded images and
+// `maxFormBytes` bytes of form XObject content streams. Negative limits
+// disable caching.
+func newRenderCache(maxFonts int, maxImageBytes, maxFormBytes int64) *renderCache {
+	return &renderCache{
+		fonts:      newLRUCache(int64(maxFonts)),
+		type3Fonts: newLRUCache(int64(maxFonts)),
+		images:     newLRUCache(maxImageBytes),
+		forms:      newLRUCache(maxFormBytes),
+	}
+}
+
+// textFont returns the cached text font of the specified font object.
+func (c *renderCache) textFont(key core.PdfObject) (*context.TextFont, bool) {
+	if c == nil {
+		return nil, false
+	}
+	if v, ok := c.fonts.get(key); ok {
+		return v.(*context.TextFont), true
+	}
+	return nil, false
+}
+
+// addTextFont caches the text font of the specified font object.
+func (c *renderCache) addTextFont(key core.PdfObject, font *context.TextFont) {
+	if c != nil {
+		c.fonts.add(key, font, 1)
+	}
+}
+
+// type3Font returns the cached Type 3 font of the specified font object.
+func (c *renderCache) type3Font(key core.PdfObject) (*model.PdfFont, bool) {
+	if c == nil {
+		return nil, false
+	}
+	if v, ok := c.type3Fonts.get(key); ok {
+		return v.(*model.PdfFont), true
+	}
+	return nil, false
+}
+
+// addType3Font caches the Type 3 font of the specified font object.
+func (c *renderCache) addType3Font(key core.PdfObject, font *model.PdfFont) {
+	if c != nil {
+		c.type3Fonts.add(key, font, 1)
+	}
+}
+
+// image returns the cached decoded image of the specified image XObject.
+func (c *renderCache) image(key *core.PdfObjectStream) (image.Image, bool) {
+	if c == nil {
+		return nil, false
+	}
+	if v, ok := c.images.get(key); ok {
+		return v.(image.Image), true
+	}
+	return nil, false
+}
+
+// addImage caches the decoded image of the specified image XObject.
+func (c *renderCache) addImage(key *core.PdfObjectStream, img image.Image) {
+	if c != nil {
+		c.images.add(key, img, imageSize(img))
+	}
+}
+
+// formOperations returns the cached content stream operations of the
+// specified form XObject.
+func (c *renderCache) formOperations(key core.PdfObject) (*contentstream.ContentStreamOperations, bool) {
+	if c == nil {
+		return nil, false
+	}
+	if v, ok := c.forms.get(key); ok {
+		return v.(*contentstream.ContentStreamOperations), true
+	}
+	return nil, false
+}
+
+// addFormOperations caches the content stream operations of the specified
+// form XObject. The size of the operations is estimated using the length of
+// the content stream they were parsed from.
+func (c *renderCache) addFormOperations(key core.PdfObject, operations *contentstream.ContentStreamOperations, contentLength int) {
+	if c != nil {
+		c.forms.add(key, operations, int64(contentLength))
+	}
+}
+
+// imageSize returns the approximate size, in bytes, of the pixels of `img`.
+func imageSize(img image.Image) int64 {
+	bytesPerPixel := int64(4)
+	switch img.(type) {
+	case *image.Gray, *image.Alpha, *image.Paletted:
+		bytesPerPixel = 1
+	case *image.Gray16, *image.Alpha16:
+		bytesPerPixel = 2
+	case *image.RGBA64, *image.NRGBA64:
+		bytesPerPixel = 8
+	}
+	b := img.Bounds()
+	return int64(b.Dx()) * int64(b.Dy()) * bytesPerPixel
+}
+
+// loadTextFont returns the text font of the specified font object, used for
+// showing text at the specified size. Type 3 fonts paint their glyphs using
+// the specified context and resources, so their text fonts are not shared
+// through the render cache. The loaded Type 3 fonts, including their glyph
+// procedures, are immutable and are shared instead.
+func (r renderer) loadTextFont(ctx context.Context, fontObj core.PdfObject, size float64,
+	resources *model.PdfPageResources) (*context.TextFont, error) {
+	if textFont, ok := r.cache.textFont(fontObj); ok {
+		return textFont, nil
+	}
+	if pdfFont, ok := r.cache.type3Font(fontObj); ok {
+		return r.newType3TextFont(ctx, pdfFont, size, resources)
+	}
+
+	fontDict, ok := core.GetDict(fontObj)
+	if !ok {
+		common.Log.Debug("ERROR: could not get font dict")
+		return nil, errType
+	}
+
+	pdfFont, err := model.NewPdfFontFromPdfObject(fontDict)
+	if err != nil {
+		common.Log.Debug("ERROR: could not load font from object")
+		return nil, err
+	}
+
+	if pdfFont.Subtype() == "Type3" {
+		r.cache.addType3Font(fontObj, pdfFont)
+		return r.newType3TextFont(ctx, pdfFont, size, resources)
+	}
+
+	textFont, err := context.NewTextFont(pdfFont, size)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.addTextFont(fontObj, textFont)
+	return textFont, nil
+}
+
+// loadImage returns the decoded image of the specified image XObject, having
+// its soft mask applied.
+func (r renderer) loadImage(stream *core.PdfObjectStream) (image.Image, error) {
+	if img, ok := r.cache.image(stream); ok {
+		return img, nil
+	}
+
+	ximg, err := model.NewXObjectImageFromStream(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := ximg.ToImage()
+	if err != nil {
+		return nil, err
+	}
+
+	goImg, err := img.ToGoImage()
+	if err != nil {
+		return nil, err
+	}
+	if masked, err := applyImageSoftMask(goImg, ximg); err != nil {
+		common.Log.Debug("ERROR: could not apply image soft mask: %v", err)
+	} else {
+		goImg = masked
+	}
+
+	r.cache.addImage(stream, goImg)
+	return goImg, nil
+}
+
+// loadFormOperations returns the parsed content stream operations of the
+// specified form XObject.
+func (r renderer) loadFormOperations(xform *model.XObjectForm) (*contentstream.ContentStreamOperations, error) {
+	key := xform.GetContainingPdfObject()
+	if operations, ok := r.cache.formOperations(key); ok {
+		return operations, nil
+	}
+
+	content, err := xform.GetContentStream()
+	if err != nil {
+		return nil, err
+	}
+
+	operations, err := contentstream.NewContentStreamParser(string(content)).Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.addFormOperations(key, operations, len(content))
+	return operations, nil
+}
+
+// lruCache is a cache which evicts the least recently used entries once the
+// total size of its entries exceeds its maximum size. It is safe for
+// concurrent use.
+type lruCache struct {
+	mu      sync.Mutex
+	maxSize int64
+	size    int64
+	entries map[interface{}]*list.Element
+	order   *list.List
+}
+
+// lruEntry represents an entry of an LRU cache.
+type lruEntry struct {
+	key   interface{}
+	value interface{}
+	size  int64
+}
+
+// newLRUCache returns a new LRU cache holding entries having a total size of
+// at most `maxSize`.
+func newLRUCache(maxSize int64) *lruCache {
+	return &lruCache{
+		maxSize: maxSize,
+		entries: map[interface{}]*list.Element{},
+		order:   list.New(),
+	}
+}
+
+// get returns the value of the entry having the specified key, and marks the
+// entry as the most recently used one.
+func (c *lruCache) get(key interface{}) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*lruEntry).value, true
+}
+
+// add adds an entry having the specified key, value and size to the cache,
+// evicting the least recently used entries if needed. Entries larger than
+// the maximum size of the cache are not added.
+func (c *lruCache) add(key, value interface{}, size int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if size > c.maxSize {
+		return
+	}
+	if e, ok := c.entries[key]; ok {
+		c.order.MoveToFront(e)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, value: value, size: size})
+	c.size += size
+	for c.size > c.maxSize {
+		e := c.order.Back()
+		entry := e.Value.(*lruEntry)
+		c.order.Remove(e)
+		delete(c.entries, entry.key)
+		c.size -= entry.size
+	}
+}
@@ -0,0 +1,227 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package compare is used for detecting visual regressions in PDF documents,
+// by comparing their rendered pages to the pages of reference documents or to
+// golden PNG images. The differences of each page are measured using the
+// perceptual distance of its pixels and the ratio of changed pixels, and are
+// highlighted in diff images.
+package compare
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+
+	"github.com/showntop/unipdf/model"
+	"github.com/showntop/unipdf/render"
+)
+
+// Comparer is used to compare rendered pages. A page passes the comparison
+// if its rendering has the expected dimensions and its differences are within
+// the tolerances of the comparer.
+type Comparer struct {
+	// Device specifies the options used for rendering the compared pages.
+	// Defaults to a new image device.
+	Device *render.ImageDevice
+
+	// Workers represents the number of pages of each document rendered in
+	// parallel. Defaults to the number of CPUs.
+	Workers int
+
+	// PixelTolerance represents the maximum perceptual distance (Delta E) of
+	// the pixels which are not counted as changed.
+	PixelTolerance float64
+
+	// ChangedRatioTolerance represents the maximum ratio of changed pixels
+	// of the pages which pass the comparison, in range 0-1.
+	ChangedRatioTolerance float64
+
+	// MeanDistanceTolerance represents the maximum mean perceptual distance
+	// of the pixels of the pages which pass the comparison.
+	MeanDistanceTolerance float64
+
+	// HighlightColor represents the color of the changed pixels in the diff
+	// images. Defaults to red.
+	HighlightColor color.Color
+}
+
+// NewComparer returns a new comparer having the default tolerances: a pixel
+// tolerance of 2.3 (the just noticeable difference), a changed ratio
+// tolerance of 0.0001 and a mean distance tolerance of 0.1. Set all the
+// tolerances to 0 for requiring identical renderings.
+func NewComparer() *Comparer {
+	return &Comparer{
+		PixelTolerance:        2.3,
+		ChangedRatioTolerance: 0.0001,
+		MeanDistanceTolerance: 0.1,
+	}
+}
+
+// CompareDocuments renders the pages of the expected and actual documents
+// and compares them page by page. The pages found in only one of the
+// documents are reported as missing. The readers must be distinct and must
+// not be used by other goroutines until CompareDocuments returns.
+func (c *Comparer) CompareDocuments(ctx context.Context, expected, actual *model.PdfReader) (*Report, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	expectedPages, err := c.renderDocument(ctx, expected)
+	if err != nil {
+		return nil, err
+	}
+	actualPages, err := c.renderDocument(ctx, actual)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	for pageNum := 1; ; pageNum++ {
+		exp, expOK := <-expectedPages
+		act, actOK := <-actualPages
+		if !expOK && !actOK {
+			break
+		}
+
+		var pd *PageDiff
+		switch {
+		case expOK && exp.Err != nil:
+			pd = &PageDiff{Err: exp.Err}
+		case actOK && act.Err != nil:
+			pd = &PageDiff{Err: act.Err}
+		case !expOK || !actOK:
+			pd = &PageDiff{Missing: true}
+		default:
+			pd = c.CompareImages(exp.Image, act.Image)
+		}
+		pd.PageNumber = pageNum
+		report.Pages = append(report.Pages, pd)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// CompareGolden renders the pages of the document loaded by `reader` and
+// compares them to the golden PNG images located at the paths obtained by
+// formatting `pathPattern` with the page numbers, starting from 1 (e.g.
+// "testdata/golden/doc-%d.png"). The pages having no golden image are
+// reported as missing, as are the golden images following the last page.
+func (c *Comparer) CompareGolden(ctx context.Context, reader *model.PdfReader, pathPattern string) (*Report, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages, err := c.renderDocument(ctx, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	for result := range pages {
+		var pd *PageDiff
+		if result.Err != nil {
+			pd = &PageDiff{Err: result.Err}
+		} else if golden, err := readPNG(fmt.Sprintf(pathPattern, result.PageNumber)); os.IsNotExist(err) {
+			pd = &PageDiff{Missing: true}
+		} else if err != nil {
+			pd = &PageDiff{Err: err}
+		} else {
+			pd = c.CompareImages(golden, result.Image)
+		}
+		pd.PageNumber = result.PageNumber
+		report.Pages = append(report.Pages, pd)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for pageNum := len(report.Pages) + 1; ; pageNum++ {
+		if _, err := os.Stat(fmt.Sprintf(pathPattern, pageNum)); err != nil {
+			break
+		}
+		report.Pages = append(report.Pages, &PageDiff{PageNumber: pageNum, Missing: true})
+	}
+
+	return report, nil
+}
+
+// SaveGolden renders the pages of the document loaded by `reader` and saves
+// them as the golden PNG images located at the paths obtained by formatting
+// `pathPattern` with the page numbers, starting from 1.
+func (c *Comparer) SaveGolden(ctx context.Context, reader *model.PdfReader, pathPattern string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages, err := c.renderDocument(ctx, reader)
+	if err != nil {
+		return err
+	}
+	for result := range pages {
+		if result.Err != nil {
+			return result.Err
+		}
+		if err := savePNG(fmt.Sprintf(pathPattern, result.PageNumber), result.Image); err != nil {
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
+
+// renderDocument renders all the pages of the document loaded by `reader`,
+// and returns a channel receiving their results in page order.
+func (c *Comparer) renderDocument(ctx context.Context, reader *model.PdfReader) (<-chan render.PageResult, error) {
+	numPages, err := reader.GetNumPages()
+	if err != nil {
+		return nil, err
+	}
+	if numPages == 0 {
+		results := make(chan render.PageResult)
+		close(results)
+		return results, nil
+	}
+
+	dr := render.NewDocumentRenderer(c.Device)
+	dr.Workers = c.Workers
+	return dr.Render(ctx, reader, 1, numPages)
+}
+
+// highlightColor returns the color of the changed pixels in the diff images.
+func (c *Comparer) highlightColor() color.Color {
+	if c.HighlightColor == nil {
+		return color.RGBA{255, 0, 0, 255}
+	}
+	return c.HighlightColor
+}
+
+// readPNG reads the PNG image located at the specified path.
+func readPNG(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return png.Decode(f)
+}
+
+// savePNG saves the specified image as a PNG image at the specified path.
+func savePNG(path string, img image.Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
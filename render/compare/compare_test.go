@@ -0,0 +1,150 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package compare
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/creator"
+	"github.com/showntop/unipdf/model"
+	"github.com/showntop/unipdf/render"
+)
+
+func newTestImage(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.White, image.ZP, draw.Src)
+	return img
+}
+
+func TestCompareImages(t *testing.T) {
+	c := NewComparer()
+
+	// Identical images.
+	expected := newTestImage(100, 100)
+	pd := c.CompareImages(expected, newTestImage(100, 100))
+	require.True(t, pd.Passed)
+	require.False(t, pd.SizeMismatch)
+	require.Zero(t, pd.ChangedPixels)
+	require.Zero(t, pd.MaxDistance)
+
+	// Differences below the pixel tolerance.
+	actual := newTestImage(100, 100)
+	actual.Set(10, 10, color.RGBA{254, 254, 254, 255})
+	pd = c.CompareImages(expected, actual)
+	require.True(t, pd.Passed)
+	require.Zero(t, pd.ChangedPixels)
+	require.True(t, pd.MaxDistance > 0)
+
+	// Changed pixels.
+	actual.Set(20, 30, color.Black)
+	actual.Set(21, 30, color.Black)
+	pd = c.CompareImages(expected, actual)
+	require.False(t, pd.Passed)
+	require.Equal(t, 2, pd.ChangedPixels)
+	require.InDelta(t, 0.0002, pd.ChangedRatio, 1e-9)
+	require.InDelta(t, 100, pd.MaxDistance, 0.01)
+	require.Equal(t, color.RGBA{255, 0, 0, 255}, pd.Diff.RGBAAt(20, 30))
+	require.Equal(t, color.RGBA{254, 254, 254, 255}, pd.Diff.RGBAAt(0, 0))
+
+	// Transparent pixels are composited over white.
+	pd = c.CompareImages(expected, image.NewRGBA(image.Rect(0, 0, 100, 100)))
+	require.True(t, pd.Passed)
+
+	// Images having different dimensions.
+	pd = c.CompareImages(expected, newTestImage(100, 101))
+	require.False(t, pd.Passed)
+	require.True(t, pd.SizeMismatch)
+	require.Equal(t, 100, pd.ChangedPixels)
+	require.Equal(t, image.Rect(0, 0, 100, 101), pd.Diff.Bounds())
+}
+
+// newTestDocument returns a reader of a document having a page for each
+// of the specified rectangle colors.
+func newTestDocument(t *testing.T, colors ...string) *model.PdfReader {
+	c := creator.New()
+	for _, hex := range colors {
+		c.SetPageSize(creator.PageSize{100, 100})
+		c.NewPage()
+		rect := c.NewRectangle(20, 20, 60, 60)
+		rect.SetFillColor(creator.ColorRGBFromHex(hex))
+		require.NoError(t, c.Draw(rect))
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, c.Write(&buf))
+
+	reader, err := model.NewPdfReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	return reader
+}
+
+func TestCompareDocuments(t *testing.T) {
+	c := NewComparer()
+	c.Device = render.NewImageDevice()
+
+	report, err := c.CompareDocuments(context.Background(),
+		newTestDocument(t, "#ff0000", "#00ff00"),
+		newTestDocument(t, "#ff0000", "#0000ff", "#000000"))
+	require.NoError(t, err)
+	require.Len(t, report.Pages, 3)
+	require.False(t, report.Passed())
+
+	require.True(t, report.Pages[0].Passed)
+	require.False(t, report.Pages[1].Passed)
+	require.InDelta(t, 0.36, report.Pages[1].ChangedRatio, 0.01)
+	require.True(t, report.Pages[2].Missing)
+	require.Len(t, report.Failed(), 2)
+
+	var summary bytes.Buffer
+	require.NoError(t, report.WriteSummary(&summary))
+	lines := strings.Split(strings.TrimSpace(summary.String()), "\n")
+	require.Len(t, lines, 4)
+	require.True(t, strings.HasPrefix(lines[0], "page 1: ok:"))
+	require.True(t, strings.HasPrefix(lines[1], "page 2: FAIL:"))
+	require.Equal(t, "page 3: FAIL: missing page", lines[2])
+	require.Equal(t, "2 of 3 pages failed", lines[3])
+}
+
+func TestCompareGolden(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compare")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	goldenPattern := filepath.Join(dir, "golden-%d.png")
+	diffPattern := filepath.Join(dir, "diff-%d.png")
+
+	c := NewComparer()
+	require.NoError(t, c.SaveGolden(context.Background(), newTestDocument(t, "#ff0000", "#00ff00"), goldenPattern))
+
+	report, err := c.CompareGolden(context.Background(), newTestDocument(t, "#ff0000", "#00ff00"), goldenPattern)
+	require.NoError(t, err)
+	require.Len(t, report.Pages, 2)
+	require.True(t, report.Passed())
+
+	// Changed pages and golden images following the last page.
+	report, err = c.CompareGolden(context.Background(), newTestDocument(t, "#ff00ff"), goldenPattern)
+	require.NoError(t, err)
+	require.Len(t, report.Pages, 2)
+	require.False(t, report.Pages[0].Passed)
+	require.True(t, report.Pages[1].Missing)
+
+	require.NoError(t, report.SaveDiffImages(diffPattern))
+	_, err = os.Stat(fmt.Sprintf(diffPattern, 1))
+	require.NoError(t, err)
+	_, err = os.Stat(fmt.Sprintf(diffPattern, 2))
+	require.True(t, os.IsNotExist(err))
+}
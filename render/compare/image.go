@@ -0,0 +1,170 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package compare
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// PageDiff represents the differences between the expected and the actual
+// rendering of a page.
+type PageDiff struct {
+	// PageNumber is the number of the compared page, starting from 1.
+	PageNumber int
+
+	// Missing specifies whether the page is missing from one of the compared
+	// documents, or has no golden image. The metrics of missing pages are
+	// not calculated.
+	Missing bool
+
+	// SizeMismatch specifies whether the compared images have different
+	// dimensions. The pixels covered by only one of the images are counted
+	// as changed, having the maximum distance.
+	SizeMismatch bool
+
+	// ChangedPixels is the number of pixels whose perceptual distance is
+	// greater than the pixel tolerance of the comparer.
+	ChangedPixels int
+
+	// ChangedRatio is the ratio of changed pixels to the total number of
+	// compared pixels, in range 0-1.
+	ChangedRatio float64
+
+	// MeanDistance and MaxDistance represent the mean and the maximum
+	// perceptual distances of the compared pixels. The perceptual distance
+	// of two pixels is their CIE76 color difference (Delta E), in which a
+	// difference of about 2.3 is just noticeable. The pixels are composited
+	// over a white background before being compared.
+	MeanDistance float64
+	MaxDistance  float64
+
+	// Diff is an image highlighting the changed pixels over a faded version
+	// of the expected image. It is nil for missing pages.
+	Diff *image.RGBA
+
+	// Passed specifies whether the differences are within the tolerances of
+	// the comparer.
+	Passed bool
+
+	// Err is the error encountered while rendering or loading the page, if
+	// any. Pages having errors do not pass.
+	Err error
+}
+
+// CompareImages compares the specified expected and actual images, and
+// returns their differences. The page number of the returned diff is 0.
+func (c *Comparer) CompareImages(expected, actual image.Image) *PageDiff {
+	eb, ab := expected.Bounds(), actual.Bounds()
+	width, height := eb.Dx(), eb.Dy()
+	if ab.Dx() > width {
+		width = ab.Dx()
+	}
+	if ab.Dy() > height {
+		height = ab.Dy()
+	}
+
+	highlight := color.NRGBAModel.Convert(c.highlightColor()).(color.NRGBA)
+	pd := &PageDiff{
+		SizeMismatch: eb.Size() != ab.Size(),
+		Diff:         image.NewRGBA(image.Rect(0, 0, width, height)),
+	}
+
+	var total float64
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			ep := image.Pt(eb.Min.X+x, eb.Min.Y+y)
+			ap := image.Pt(ab.Min.X+x, ab.Min.Y+y)
+			inExpected, inActual := ep.In(eb), ap.In(ab)
+
+			distance := maxDistance
+			if inExpected && inActual {
+				distance = deltaE(toLab(expected.At(ep.X, ep.Y)), toLab(actual.At(ap.X, ap.Y)))
+			}
+			total += distance
+			if distance > pd.MaxDistance {
+				pd.MaxDistance = distance
+			}
+
+			if distance > c.PixelTolerance {
+				pd.ChangedPixels++
+				pd.Diff.SetRGBA(x, y, color.RGBA{highlight.R, highlight.G, highlight.B, 255})
+				continue
+			}
+
+			// Unchanged pixels are faded, so that the changes stand out.
+			var v uint8 = 255
+			if inExpected {
+				v = fade(expected.At(ep.X, ep.Y))
+			}
+			pd.Diff.SetRGBA(x, y, color.RGBA{v, v, v, 255})
+		}
+	}
+
+	if n := width * height; n > 0 {
+		pd.ChangedRatio = float64(pd.ChangedPixels) / float64(n)
+		pd.MeanDistance = total / float64(n)
+	}
+	pd.Passed = !pd.SizeMismatch &&
+		pd.ChangedRatio <= c.ChangedRatioTolerance &&
+		pd.MeanDistance <= c.MeanDistanceTolerance
+	return pd
+}
+
+// maxDistance is the perceptual distance between black and white, used as
+// the distance of the pixels covered by only one of the compared images.
+const maxDistance float64 = 100
+
+// lab represents a color in the CIELAB colorspace.
+type lab struct {
+	l, a, b float64
+}
+
+// toLab converts the specified color to the CIELAB colorspace (D65 white
+// point), after compositing it over a white background.
+func toLab(c color.Color) lab {
+	r, g, b, a := c.RGBA()
+	composite := func(v uint32) float64 {
+		// The components are alpha-premultiplied.
+		return float64(v+(0xffff-a)) / 0xffff
+	}
+	linear := func(v float64) float64 {
+		if v <= 0.04045 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	rl, gl, bl := linear(composite(r)), linear(composite(g)), linear(composite(b))
+
+	x := (0.4124*rl + 0.3576*gl + 0.1805*bl) / 0.95047
+	y := 0.2126*rl + 0.7152*gl + 0.0722*bl
+	z := (0.0193*rl + 0.1192*gl + 0.9505*bl) / 1.08883
+
+	f := func(t float64) float64 {
+		if t > 216.0/24389 {
+			return math.Cbrt(t)
+		}
+		return (24389.0/27*t + 16) / 116
+	}
+	fx, fy, fz := f(x), f(y), f(z)
+	return lab{l: 116*fy - 16, a: 500 * (fx - fy), b: 200 * (fy - fz)}
+}
+
+// deltaE returns the CIE76 color difference of the specified colors.
+func deltaE(c1, c2 lab) float64 {
+	dl, da, db := c1.l-c2.l, c1.a-c2.a, c1.b-c2.b
+	return math.Sqrt(dl*dl + da*da + db*db)
+}
+
+// fade returns the gray level of the specified color, faded towards white.
+func fade(c color.Color) uint8 {
+	// The gray level is alpha-premultiplied.
+	gray := color.GrayModel.Convert(c).(color.Gray)
+	_, _, _, a := c.RGBA()
+	v := float64(gray.Y) + 255*(1-float64(a)/0xffff)
+	return uint8(191 + v/4)
+}
@@ -0,0 +1,79 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package compare
+
+import (
+	"fmt"
+	"io"
+)
+
+// Report represents the results of comparing the pages of a document.
+type Report struct {
+	// Pages contains the differences of the compared pages, in page order.
+	Pages []*PageDiff
+}
+
+// Passed returns true if all the compared pages passed the comparison.
+func (r *Report) Passed() bool {
+	return len(r.Failed()) == 0
+}
+
+// Failed returns the differences of the pages which did not pass the
+// comparison, including the missing pages and the pages having errors.
+func (r *Report) Failed() []*PageDiff {
+	var failed []*PageDiff
+	for _, pd := range r.Pages {
+		if !pd.Passed {
+			failed = append(failed, pd)
+		}
+	}
+	return failed
+}
+
+// WriteSummary writes a summary of the report to `w`, having a line for each
+// compared page, followed by the number of failed pages.
+func (r *Report) WriteSummary(w io.Writer) error {
+	for _, pd := range r.Pages {
+		var line string
+		switch {
+		case pd.Err != nil:
+			line = fmt.Sprintf("page %d: ERROR: %v", pd.PageNumber, pd.Err)
+		case pd.Missing:
+			line = fmt.Sprintf("page %d: FAIL: missing page", pd.PageNumber)
+		default:
+			status := "ok"
+			if !pd.Passed {
+				status = "FAIL"
+			}
+			line = fmt.Sprintf("page %d: %s: changed %.4f%% (%d px), mean distance %.3f, max distance %.3f",
+				pd.PageNumber, status, 100*pd.ChangedRatio, pd.ChangedPixels, pd.MeanDistance, pd.MaxDistance)
+			if pd.SizeMismatch {
+				line += ", size mismatch"
+			}
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "%d of %d pages failed\n", len(r.Failed()), len(r.Pages))
+	return err
+}
+
+// SaveDiffImages saves the diff images of the pages which did not pass the
+// comparison as PNG images, located at the paths obtained by formatting
+// `pathPattern` with the page numbers (e.g. "diff/doc-%d.png").
+func (r *Report) SaveDiffImages(pathPattern string) error {
+	for _, pd := range r.Failed() {
+		if pd.Diff == nil {
+			continue
+		}
+		if err := savePNG(fmt.Sprintf(pathPattern, pd.PageNumber), pd.Diff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
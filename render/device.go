@@ -0,0 +1,168 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/showntop/unipdf/internal/transform"
+	"github.com/showntop/unipdf/model"
+	"github.com/showntop/unipdf/render/internal/context"
+)
+
+// PageBox represents the page boundary used for rendering PDF pages
+// (section 14.11.2 "Page Boundaries" p. 643 PDF32000_2008).
+type PageBox int
+
+// Page boundaries used for rendering.
+const (
+	// CropBox represents the visible region of the page, as displayed by
+	// PDF viewers. Defaults to the media box of the page.
+	CropBox PageBox = iota
+
+	// MediaBox represents the boundaries of the physical medium on which
+	// the page is to be printed.
+	MediaBox
+
+	// BleedBox represents the region to which the page should be clipped
+	// when output in a production environment. Defaults to the crop box.
+	BleedBox
+
+	// TrimBox represents the intended dimensions of the finished page after
+	// trimming. Defaults to the crop box.
+	TrimBox
+
+	// ArtBox represents the extent of the meaningful content of the page.
+	// Defaults to the crop box.
+	ArtBox
+)
+
+// pageView represents the region of a PDF page which is rendered by a device,
+// as displayed by PDF viewers.
+type pageView struct {
+	// box is the rendered page boundary.
+	box *model.PdfRectangle
+
+	// rotate is the clockwise rotation of the page, in degrees.
+	rotate int64
+
+	// width and height represent the dimensions of the page boundary, after
+	// applying the rotation of the page.
+	width, height float64
+}
+
+// newPageView returns the view of the specified page boundary of `page`.
+// If `ignoreRotation` is true, the rotation of the page is not applied.
+func newPageView(page *model.PdfPage, pageBox PageBox, ignoreRotation bool) (*pageView, error) {
+	box, err := getPageBox(page, pageBox)
+	if err != nil {
+		return nil, err
+	}
+	if box.Width() <= 0 || box.Height() <= 0 {
+		return nil, errors.New("invalid page dimensions")
+	}
+
+	var rotate int64
+	if !ignoreRotation {
+		if rotate, err = page.GetRotate(); err != nil {
+			return nil, err
+		}
+		if rotate%90 != 0 {
+			return nil, fmt.Errorf("invalid page rotation: %d", rotate)
+		}
+	}
+
+	view := &pageView{box: box, rotate: rotate, width: box.Width(), height: box.Height()}
+	if rotate == 90 || rotate == 270 {
+		view.width, view.height = view.height, view.width
+	}
+	return view, nil
+}
+
+// setMatrix changes the coordinate system of the specified context, so that
+// the page boundary is scaled to the specified output dimensions and rotated
+// clockwise, as specified by the page rotation.
+func (v *pageView) setMatrix(ctx context.Context, width, height float64) {
+	ctx.Scale(width/v.width, height/v.height)
+	ctx.Translate(0, v.height)
+	ctx.Scale(1, -1)
+
+	boxWidth, boxHeight := v.box.Width(), v.box.Height()
+	switch v.rotate {
+	case 90:
+		ctx.SetMatrix(ctx.Matrix().Mult(transform.NewMatrix(0, -1, 1, 0, 0, boxWidth)))
+	case 180:
+		ctx.SetMatrix(ctx.Matrix().Mult(transform.NewMatrix(-1, 0, 0, -1, boxWidth, boxHeight)))
+	case 270:
+		ctx.SetMatrix(ctx.Matrix().Mult(transform.NewMatrix(0, 1, -1, 0, boxHeight, 0)))
+	}
+	ctx.Translate(-v.box.Llx, -v.box.Lly)
+}
+
+// renderPageView renders the content of the specified page and, if
+// `annotations` is true, the annotations which are visible for the specified
+// render target. The coordinate system of the context must be set up using
+// the view of the page.
+func (r renderer) renderPageView(ctx context.Context, page *model.PdfPage, annotations bool,
+	target RenderTarget) error {
+	// The graphics state is restored after rendering the page content, so
+	// that annotations are rendered using the default state.
+	ctx.Push()
+	err := r.renderPage(ctx, page)
+	ctx.Pop()
+	if err != nil {
+		return err
+	}
+
+	if !annotations {
+		return nil
+	}
+	return r.renderAnnotations(ctx, page, target)
+}
+
+// getPageBox returns the specified page boundary of `page`.
+func getPageBox(page *model.PdfPage, pageBox PageBox) (*model.PdfRectangle, error) {
+	mbox, err := page.GetMediaBox()
+	if err != nil {
+		return nil, err
+	}
+	if pageBox == MediaBox {
+		return normalizeBox(mbox), nil
+	}
+
+	cbox := mbox
+	if page.CropBox != nil {
+		cbox = page.CropBox
+	}
+
+	box := cbox
+	switch pageBox {
+	case BleedBox:
+		box = page.BleedBox
+	case TrimBox:
+		box = page.TrimBox
+	case ArtBox:
+		box = page.ArtBox
+	}
+	if box == nil {
+		box = cbox
+	}
+
+	return normalizeBox(box), nil
+}
+
+// normalizeBox returns a copy of the specified rectangle, having its lower
+// left corner coordinates smaller than the upper right corner coordinates.
+func normalizeBox(box *model.PdfRectangle) *model.PdfRectangle {
+	return &model.PdfRectangle{
+		Llx: math.Min(box.Llx, box.Urx),
+		Lly: math.Min(box.Lly, box.Ury),
+		Urx: math.Max(box.Llx, box.Urx),
+		Ury: math.Max(box.Lly, box.Ury),
+	}
+}
@@ -0,0 +1,281 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"runtime"
+	"sync"
+
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+)
+
+// DocumentRenderer is used to render ranges of pages of PDF documents to
+// images, using a pool of workers which render pages in parallel. The fonts,
+// decoded images and form XObjects used by multiple pages are loaded once
+// and shared by the workers, through caches whose sizes are bounded.
+type DocumentRenderer struct {
+	// Device specifies the options used for rendering the pages.
+	// Defaults to a new image device.
+	Device *ImageDevice
+
+	// Workers represents the number of pages rendered in parallel.
+	// Defaults to the number of CPUs.
+	Workers int
+
+	// MaxCachedFonts represents the maximum number of fonts shared by the
+	// rendered pages. Defaults to 256. A negative value disables the cache.
+	MaxCachedFonts int
+
+	// MaxCachedImageBytes represents the maximum size, in bytes, of the
+	// decoded images shared by the rendered pages. Defaults to 256 MiB.
+	// A negative value disables the cache.
+	MaxCachedImageBytes int64
+
+	// MaxCachedFormBytes represents the maximum size, in bytes, of the
+	// content streams of the form XObjects shared by the rendered pages.
+	// Defaults to 32 MiB. A negative value disables the cache.
+	MaxCachedFormBytes int64
+}
+
+// PageResult represents the result of rendering a page of a document.
+type PageResult struct {
+	// PageNumber is the number of the rendered page, starting from 1.
+	PageNumber int
+
+	// Image is the rendered page, or nil if the page could not be rendered.
+	Image image.Image
+
+	// Err is the error encountered while rendering the page, if any.
+	Err error
+}
+
+// NewDocumentRenderer returns a new document renderer which renders pages
+// using the options of the specified image device.
+func NewDocumentRenderer(device *ImageDevice) *DocumentRenderer {
+	return &DocumentRenderer{Device: device}
+}
+
+// RenderPages renders the pages of the document loaded by `reader`, from
+// page number `first` to page number `last` inclusive, and calls `fn` with the
+// result of each page, in page order. Pages which cannot be rendered are
+// reported through the Err field of their results. Rendering stops when `ctx`
+// is cancelled or `fn` returns an error, in which case the error is returned.
+//
+// The pages are loaded from `reader` as they are rendered, and `reader` must
+// not be used by other goroutines until RenderPages returns.
+func (dr *DocumentRenderer) RenderPages(ctx context.Context, reader *model.PdfReader, first, last int,
+	fn func(result PageResult) error) error {
+	if err := checkPageRange(reader, first, last); err != nil {
+		return err
+	}
+
+	return dr.renderPages(ctx, reader, first, last, fn)
+}
+
+// Render renders the pages of the document loaded by `reader`, from page
+// number `first` to page number `last` inclusive, and sends their results to
+// the returned channel, in page order. The channel is closed once all the
+// pages are rendered or `ctx` is cancelled. Callers which stop receiving
+// results before the channel is closed must cancel `ctx`.
+//
+// The pages are loaded from `reader` as they are rendered, and `reader` must
+// not be used by other goroutines until the channel is closed.
+func (dr *DocumentRenderer) Render(ctx context.Context, reader *model.PdfReader, first, last int) (<-chan PageResult, error) {
+	if err := checkPageRange(reader, first, last); err != nil {
+		return nil, err
+	}
+
+	results := make(chan PageResult)
+	go func() {
+		defer close(results)
+		dr.renderPages(ctx, reader, first, last, func(result PageResult) error {
+			select {
+			case results <- result:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	return results, nil
+}
+
+// renderPages renders the pages of the document loaded by `reader`, from page
+// number `first` to page number `last` inclusive, and calls `fn` with their
+// results, in page order. Each page is prepared just before it is queued for
+// rendering, so that only the objects of the queued pages are loaded at once.
+// The pages being rendered when rendering stops are completed, but their
+// results are discarded.
+func (dr *DocumentRenderer) renderPages(ctx context.Context, reader *model.PdfReader, first, last int,
+	fn func(result PageResult) error) error {
+	device := NewImageDevice()
+	if dr.Device != nil {
+		*device = *dr.Device
+	}
+
+	maxFonts := dr.MaxCachedFonts
+	if maxFonts == 0 {
+		maxFonts = 256
+	}
+	maxImageBytes := dr.MaxCachedImageBytes
+	if maxImageBytes == 0 {
+		maxImageBytes = 256 << 20
+	}
+	maxFormBytes := dr.MaxCachedFormBytes
+	if maxFormBytes == 0 {
+		maxFormBytes = 32 << 20
+	}
+	device.cache = newRenderCache(maxFonts, maxImageBytes, maxFormBytes)
+
+	workers := dr.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Each page is assigned a result channel, which is queued in page order.
+	// The capacity of the queue bounds the number of prepared pages waiting
+	// for the results of the previous pages to be reported. The pages are
+	// prepared by a single goroutine, as the reader is not safe for concurrent
+	// use. The workers return after that goroutine, so the reader is no longer
+	// used once they are done.
+	type job struct {
+		number int
+		page   *model.PdfPage
+		err    error
+		result chan PageResult
+	}
+	jobs := make(chan job)
+	queue := make(chan chan PageResult, 2*workers)
+
+	go func() {
+		defer close(jobs)
+		defer close(queue)
+		for number := first; number <= last; number++ {
+			j := job{number: number, result: make(chan PageResult, 1)}
+			select {
+			case queue <- j.result:
+			case <-ctx.Done():
+				return
+			}
+			j.page, j.err = dr.preparePage(reader, number)
+			select {
+			case jobs <- j:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				if j.err != nil {
+					j.result <- PageResult{PageNumber: j.number, Err: fmt.Errorf("page %d: %v", j.number, j.err)}
+					continue
+				}
+				img, err := device.Render(j.page)
+				if err != nil {
+					err = fmt.Errorf("page %d: %v", j.number, err)
+				}
+				j.result <- PageResult{PageNumber: j.number, Image: img, Err: err}
+			}
+		}()
+	}
+
+	err := func() error {
+		for result := range queue {
+			select {
+			case r := <-result:
+				if err := fn(r); err != nil {
+					return err
+				}
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return ctx.Err()
+	}()
+
+	cancel()
+	wg.Wait()
+	return err
+}
+
+// checkPageRange returns an error if the document loaded by `reader` does not
+// have the pages from page number `first` to page number `last` inclusive.
+func checkPageRange(reader *model.PdfReader, first, last int) error {
+	numPages, err := reader.GetNumPages()
+	if err != nil {
+		return err
+	}
+	if first < 1 || last > numPages || first > last {
+		return fmt.Errorf("invalid page range %d-%d (document has %d pages)", first, last, numPages)
+	}
+	return nil
+}
+
+// preparePage returns page number `number` of the document loaded by
+// `reader`. The objects used for rendering the page, which lazy readers load
+// on demand, are loaded upfront so that the page can be rendered concurrently
+// with the other pages. The objects already loaded for previous pages are
+// traversed again, but not modified.
+func (dr *DocumentRenderer) preparePage(reader *model.PdfReader, number int) (*model.PdfPage, error) {
+	page, err := reader.GetPage(number)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, errors.New("page not found")
+	}
+
+	annotations := dr.Device == nil || !dr.Device.SkipAnnotations
+	objs := append([]core.PdfObject{page.Contents}, resourceObjects(page.Resources)...)
+	if annotations {
+		objs = append(objs, page.Annots)
+	}
+	traversed := map[core.PdfObject]struct{}{}
+	for _, obj := range objs {
+		if err := core.ResolveReferencesDeep(core.ResolveReference(obj), traversed); err != nil {
+			return nil, err
+		}
+	}
+	if annotations {
+		if _, err := page.GetAnnotations(); err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// resourceObjects returns the objects of the resource categories of the
+// specified resources.
+func resourceObjects(resources *model.PdfPageResources) []core.PdfObject {
+	if resources == nil {
+		return nil
+	}
+	return []core.PdfObject{
+		resources.ExtGState,
+		resources.ColorSpace,
+		resources.Pattern,
+		resources.Shading,
+		resources.XObject,
+		resources.Font,
+		resources.Properties,
+	}
+}
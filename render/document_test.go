@@ -0,0 +1,147 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/font/gofont/goregular"
+
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+)
+
+// makeFontDocument returns a PDF document with `numPages` pages showing text
+// with a TrueType font embedding Go Regular. Each page has its own font, so
+// that the glyphs of the fonts are looked up concurrently when the pages are
+// rendered in parallel. The apple glyph is not in the font program, and is
+// looked up through the Mac OS roman encoding.
+func makeFontDocument(t *testing.T, numPages int) []byte {
+	data := goregular.TTF
+	writer := model.NewPdfWriter()
+	for pageNum := 1; pageNum <= numPages; pageNum++ {
+		fontFile, err := core.MakeStream(data, core.NewFlateEncoder())
+		require.NoError(t, err)
+		fontFile.Set("Length1", core.MakeInteger(int64(len(data))))
+		descriptor := parseTestDict(t, `<< /Type /FontDescriptor /FontName /GoRegular /Flags 32
+			/FontBBox [0 0 1000 1000] /ItalicAngle 0 /Ascent 760 /Descent -240 /CapHeight 730 /StemV 80 >>`)
+		descriptor.Set("FontFile2", fontFile)
+		font := parseTestDict(t, `<< /Type /Font /Subtype /TrueType /BaseFont /GoRegular
+			/FirstChar 65 /LastChar 67 /Widths [684 686 600]
+			/Encoding << /Type /Encoding /BaseEncoding /WinAnsiEncoding /Differences [67 /apple] >> >>`)
+		font.Set("FontDescriptor", core.MakeIndirectObject(descriptor))
+
+		resources := model.NewPdfPageResources()
+		require.NoError(t, resources.SetFontByName("F1", core.MakeIndirectObject(font)))
+		page := newTestPage(t, fmt.Sprintf("BT /F1 %d Tf 10 40 Td (ABC) Tj ET", 20+pageNum), resources)
+		require.NoError(t, writer.AddPage(page))
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writer.Write(&buf))
+	return buf.Bytes()
+}
+
+// TestDocumentRenderer checks the pages rendered in parallel. Run it with the
+// race detector to check that the shared resources are safe for concurrent
+// use.
+func TestDocumentRenderer(t *testing.T) {
+	const numPages = 8
+	reader, err := model.NewPdfReader(bytes.NewReader(makeFontDocument(t, numPages)))
+	require.NoError(t, err)
+
+	var images []image.Image
+	dr := &DocumentRenderer{Workers: 4}
+	err = dr.RenderPages(context.Background(), reader, 1, numPages, func(result PageResult) error {
+		require.NoError(t, result.Err)
+		require.Equal(t, len(images)+1, result.PageNumber)
+		images = append(images, result.Image)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, images, numPages)
+
+	// The pages rendered in parallel are the same as the pages rendered
+	// one at a time.
+	for i, img := range images {
+		page, err := reader.GetPage(i + 1)
+		require.NoError(t, err)
+		expected, err := NewImageDevice().Render(page)
+		require.NoError(t, err)
+		require.Equal(t, expected, img, "page %d", i+1)
+
+		// The stem of the B, which follows the A, is drawn.
+		size := float64(20 + i + 1)
+		requirePixel(t, img, int(10+size*(0.684+0.147)), 45, testBlack, 64)
+	}
+}
+
+// TestDocumentRendererType3 checks the pages sharing a Type 3 font rendered
+// in parallel. The Type 3 font has no resources, so its glyph procedures use
+// the resources of each page. The font is loaded only once.
+func TestDocumentRendererType3(t *testing.T) {
+	const numPages = 4
+	font := parseTestDict(t, `<<
+		/Type /Font /Subtype /Type3
+		/FontBBox [0 0 100 100]
+		/FontMatrix [0.01 0 0 0.01 0 0]
+		/Encoding << /Type /Encoding /Differences [65 /square] >>
+		/FirstChar 65 /LastChar 65 /Widths [100]
+	>>`)
+	charProcs := core.MakeDict()
+	charProcs.Set("square", makeTestStream(t, "<<>>", []byte("100 0 d0 /GS1 gs 0 0 100 100 re f")))
+	font.Set("CharProcs", charProcs)
+	fontObj := core.MakeIndirectObject(font)
+
+	writer := model.NewPdfWriter()
+	for pageNum := 1; pageNum <= numPages; pageNum++ {
+		resources := model.NewPdfPageResources()
+		require.NoError(t, resources.SetFontByName("F1", fontObj))
+		gs := core.MakeDict()
+		gs.Set("ca", core.MakeFloat(float64(pageNum)/numPages))
+		require.NoError(t, resources.AddExtGState("GS1", gs))
+		page := newTestPage(t, "0 0 1 rg BT /F1 20 Tf 10 10 Td (A) Tj ET", resources)
+		require.NoError(t, writer.AddPage(page))
+	}
+	var buf bytes.Buffer
+	require.NoError(t, writer.Write(&buf))
+	reader, err := model.NewPdfReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	// The reader is used by the renderer until RenderPages returns, so the
+	// expected pages are rendered afterwards.
+	var images []image.Image
+	dr := &DocumentRenderer{Workers: 4}
+	err = dr.RenderPages(context.Background(), reader, 1, numPages, func(result PageResult) error {
+		require.NoError(t, result.Err)
+		images = append(images, result.Image)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, images, numPages)
+	for i, img := range images {
+		page, err := reader.GetPage(i + 1)
+		require.NoError(t, err)
+		expected, err := NewImageDevice().Render(page)
+		require.NoError(t, err)
+		require.Equal(t, expected, img, "page %d", i+1)
+	}
+
+	device := NewImageDevice()
+	device.cache = newRenderCache(16, 0, 0)
+	for pageNum := 1; pageNum <= numPages; pageNum++ {
+		page, err := reader.GetPage(pageNum)
+		require.NoError(t, err)
+		_, err = device.Render(page)
+		require.NoError(t, err)
+	}
+	require.Len(t, device.cache.type3Fonts.entries, 1)
+}
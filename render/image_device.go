@@ -6,12 +6,15 @@
 package render
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
 	"image"
-	"image/draw"
+	"image/color"
 	"image/jpeg"
 	"image/png"
+	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"strings"
@@ -23,6 +26,63 @@ import (
 // ImageDevice is used to render PDF pages to image targets.
 type ImageDevice struct {
 	renderer
+
+	// DPI represents the resolution of the rendered images, in dots per inch.
+	// A resolution of 72 DPI renders one pixel per PDF unit. Defaults to 72.
+	DPI float64
+
+	// OutputWidth and OutputHeight represent the maximum dimensions, in
+	// pixels, of the rendered images. If set, they take precedence over the
+	// DPI. If only one of them is set, the other one is calculated so that
+	// the aspect ratio of the page is preserved. If both of them are set,
+	// the page is scaled to fit the specified dimensions, preserving its
+	// aspect ratio.
+	OutputWidth  int
+	OutputHeight int
+
+	// PageBox specifies the page boundary which is rendered.
+	// Defaults to the crop box of the page.
+	PageBox PageBox
+
+	// Background represents the color used for filling the background of the
+	// rendered images. If nil, the background is white. Use color.Transparent
+	// for transparent output.
+	Background color.Color
+
+	// IgnoreRotation specifies whether the rotation of the pages (the Rotate
+	// entry of the page dictionaries) should be ignored. By default, pages
+	// are rendered as they are displayed by PDF viewers.
+	IgnoreRotation bool
+
+	// Target specifies whether pages are rendered as they are displayed on
+	// screen or as they are printed, which determines the annotations that
+	// are visible on the rendered pages. Defaults to ScreenTarget.
+	Target RenderTarget
+
+	// SkipAnnotations specifies whether the appearances of the annotations
+	// of the pages, such as filled form fields, stamps and signatures,
+	// should not be rendered. By default, they are rendered on top of the
+	// page content.
+	SkipAnnotations bool
+
+	// JPEGQuality represents the quality (1-100) used when encoding rendered
+	// JPEG images using RenderToPath. Defaults to 100.
+	JPEGQuality int
+
+	// TIFFCompression specifies the compression scheme used when encoding
+	// rendered TIFF images. Defaults to LZW. The pages of TIFF images using
+	// CCITT fax compression are converted to bilevel images, as specified
+	// by BilevelMethod.
+	TIFFCompression TIFFCompression
+
+	// BilevelMethod specifies the method used for converting rendered pages
+	// to bilevel images. Defaults to thresholding.
+	BilevelMethod BilevelMethod
+
+	// Threshold represents the gray level (1-255) below which pixels are
+	// painted black, when converting rendered pages to bilevel images by
+	// thresholding. Defaults to 128.
+	Threshold uint8
 }
 
 // NewImageDevice returns a new image device.
@@ -32,34 +92,35 @@ func NewImageDevice() *ImageDevice {
 
 // Render converts the specified PDF page into an image and returns the result.
 func (d *ImageDevice) Render(page *model.PdfPage) (image.Image, error) {
-	// Get page dimensions.
-	mbox, err := page.GetMediaBox()
+	view, err := newPageView(page, d.PageBox, d.IgnoreRotation)
 	if err != nil {
 		return nil, err
 	}
 
-	// Render page.
-	width, height := mbox.Llx+mbox.Width(), mbox.Lly+mbox.Height()
+	// Calculate output image dimensions.
+	scale := d.scale(view.width, view.height)
+	width := int(math.Max(1, math.Round(view.width*scale)))
+	height := int(math.Max(1, math.Round(view.height*scale)))
+	ctx := imagerender.NewContext(width, height)
 
-	ctx := imagerender.NewContext(int(width), int(height))
-	if err := d.renderPage(ctx, page); err != nil {
-		return nil, err
+	// Fill image background.
+	background := d.Background
+	if background == nil {
+		background = color.White
+	}
+	if _, _, _, a := background.RGBA(); a != 0 {
+		ctx.SetColor(background)
+		ctx.DrawRectangle(0, 0, float64(width), float64(height))
+		ctx.Fill()
 	}
 
-	// Apply crop box, if one exists.
-	img := ctx.Image()
-	if box := page.CropBox; box != nil {
-		// Calculate crop bounds and crop start position.
-		cropBounds := image.Rect(0, 0, int(box.Width()), int(box.Height()))
-		cropStart := image.Pt(int(box.Llx), int(height-box.Ury))
-
-		// Crop image.
-		cropImg := image.NewRGBA(cropBounds)
-		draw.Draw(cropImg, cropBounds, img, cropStart, draw.Src)
-		img = cropImg
+	// Render page.
+	view.setMatrix(ctx, float64(width), float64(height))
+	if err := d.renderPageView(ctx, page, !d.SkipAnnotations, d.Target); err != nil {
+		return nil, err
 	}
 
-	return img, nil
+	return ctx.Image(), nil
 }
 
 // RenderToPath converts the specified PDF page into an image and saves the
@@ -79,12 +140,54 @@ func (d *ImageDevice) RenderToPath(page *model.PdfPage, outputPath string) error
 	case ".png":
 		return savePNG(outputPath, image)
 	case ".jpg", ".jpeg":
-		return saveJPG(outputPath, image, 100)
+		quality := d.JPEGQuality
+		if quality <= 0 || quality > 100 {
+			quality = 100
+		}
+		return saveJPG(outputPath, image, quality)
+	case ".tif", ".tiff":
+		return d.saveTIFF(outputPath, page, image)
 	}
 
 	return fmt.Errorf("unrecognized output file type: %s", extension)
 }
 
+// scale returns the scaling factor used for rendering a page having the
+// specified dimensions, as displayed.
+func (d *ImageDevice) scale(width, height float64) float64 {
+	sx := float64(d.OutputWidth) / width
+	sy := float64(d.OutputHeight) / height
+
+	switch {
+	case d.OutputWidth > 0 && d.OutputHeight > 0:
+		return math.Min(sx, sy)
+	case d.OutputWidth > 0:
+		return sx
+	case d.OutputHeight > 0:
+		return sy
+	case d.DPI > 0:
+		return d.DPI / 72
+	}
+
+	return 1
+}
+
+// writeFile creates the file at the specified location and writes its
+// contents using the specified function.
+func writeFile(path string, write func(w io.Writer) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	if err := write(w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
 func savePNG(path string, image image.Image) error {
 	file, err := os.Create(path)
 	if err != nil {
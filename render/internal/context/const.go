@@ -33,8 +33,39 @@ type LineJoin int
 const (
 	LineJoinRound LineJoin = iota
 	LineJoinBevel
+	LineJoinMiter
 )
 
+// BlendMode represents the blend mode used for compositing painted objects
+// with their backdrop (section 11.3.5 "Blend Mode" p. 320 PDF32000_2008).
+type BlendMode int
+
+// Blend modes. The separable blend modes are listed first.
+const (
+	BlendModeNormal BlendMode = iota
+	BlendModeMultiply
+	BlendModeScreen
+	BlendModeOverlay
+	BlendModeDarken
+	BlendModeLighten
+	BlendModeColorDodge
+	BlendModeColorBurn
+	BlendModeHardLight
+	BlendModeSoftLight
+	BlendModeDifference
+	BlendModeExclusion
+	BlendModeHue
+	BlendModeSaturation
+	BlendModeColor
+	BlendModeLuminosity
+)
+
+// IsSeparable returns true if the blend mode is separable, i.e. the
+// components of the blended colors are computed independently.
+func (mode BlendMode) IsSeparable() bool {
+	return mode < BlendModeHue
+}
+
 // Pattern represents a pattern which can be rendered by a context instance.
 type Pattern interface {
 	ColorAt(x, y int) color.Color
@@ -94,7 +94,9 @@ type Context interface {
 	// LineWidth returns the current line width.
 	LineWidth() float64
 
-	// SetLineWidth sets the line width.
+	// SetLineWidth sets the line width, expressed in user space units.
+	// The line width is transformed using the transformation matrix in effect
+	// when the path is stroked.
 	SetLineWidth(lineWidth float64)
 
 	// SetLineCap sets the line cap style.
@@ -103,7 +105,13 @@ type Context interface {
 	// SetLineJoin sets the line join style.
 	SetLineJoin(lineJoin LineJoin)
 
-	// SetDash sets the line dash pattern.
+	// SetMiterLimit sets the miter limit, which is the maximum ratio between
+	// the length of a miter join and the line width. Miter joins exceeding the
+	// limit are converted to bevel joins.
+	SetMiterLimit(limit float64)
+
+	// SetDash sets the line dash pattern. The dash lengths are expressed in
+	// user space units.
 	SetDash(dashes ...float64)
 
 	// SetDashOffset sets the initial offset into the dash pattern to use when
@@ -153,6 +161,38 @@ type Context interface {
 	// SetStrokeStyle sets current stroke pattern.
 	SetStrokeStyle(pattern Pattern)
 
+	//
+	// Transparency operations
+	//
+
+	// SetFillAlpha sets the constant alpha used for fill operations.
+	// The alpha value should be in range 0-1.
+	SetFillAlpha(alpha float64)
+
+	// SetStrokeAlpha sets the constant alpha used for stroke operations.
+	// The alpha value should be in range 0-1.
+	SetStrokeAlpha(alpha float64)
+
+	// SetBlendMode sets the blend mode used for compositing painted objects
+	// with their backdrop.
+	SetBlendMode(mode BlendMode)
+
+	// SetSoftMask sets the soft mask, which modulates the alpha of painted
+	// objects. The mask must have the dimensions of the rendering area.
+	// A nil mask removes the soft mask.
+	SetSoftMask(mask *image.Alpha)
+
+	// BeginGroup starts a transparency group. The subsequent operations are
+	// painted onto the group, which is composited with its backdrop when
+	// EndGroup is called, using the fill alpha, blend mode and soft mask in
+	// effect when the group was started. These are reset to their initial
+	// values for the duration of the group.
+	BeginGroup(isolated, knockout bool)
+
+	// EndGroup ends the most recent transparency group and composites it
+	// with its backdrop.
+	EndGroup()
+
 	//
 	// Text operations
 	//
@@ -160,12 +200,6 @@ type Context interface {
 	// TextState returns the current text state.
 	TextState() *TextState
 
-	// DrawString renders the specified string and the specified position.
-	DrawString(s string, x, y float64)
-
-	// Measure string returns the width and height of the specified string.
-	MeasureString(s string) (w, h float64)
-
 	//
 	// Draw operations
 	//
@@ -191,3 +225,82 @@ type Context interface {
 	// Height returns the height of the rendering area.
 	Width() int
 }
+
+// Names of the process colorants, which are painted by DeviceCMYK colors.
+const (
+	InkCyan    = "Cyan"
+	InkMagenta = "Magenta"
+	InkYellow  = "Yellow"
+	InkBlack   = "Black"
+
+	// InkAll represents all the colorants of the target, including the
+	// process ones, as specified by the special Separation colorant All.
+	InkAll = "All"
+)
+
+// Inks represents the colorants painted by fill or stroke operations.
+type Inks struct {
+	// Tints maps the names of the painted colorants to their tints, in
+	// range 0-1. A tint of 0 represents the absence of the colorant.
+	Tints map[string]float64
+
+	// CMYK specifies whether the tints are the components of a DeviceCMYK
+	// color. When overprinting in nonzero overprint mode, the components of
+	// such colors having a tint of 0 leave their colorants unchanged.
+	CMYK bool
+}
+
+// InkImage represents an image whose samples are tints of colorants, such as
+// the images of Separation, DeviceN and DeviceCMYK colorspaces.
+type InkImage struct {
+	// Inks contains the names of the colorants painted by the image.
+	Inks []string
+
+	// Tints contains the tints of the colorants, in the order of Inks, as
+	// gray levels ranging from 0 (tint 0) to 255 (tint 1).
+	Tints []*image.Gray
+
+	// Alpha contains the alpha values of the image samples. It is nil if
+	// the image is opaque.
+	Alpha *image.Alpha
+
+	// CMYK specifies whether the colorants are the components of DeviceCMYK
+	// colors (see Inks.CMYK).
+	CMYK bool
+}
+
+// InkContext is implemented by the contexts which render the colorants of
+// the painted colors separately, such as the ones used for previewing the
+// separations of pages. The inks and overprint parameters are part of the
+// context state.
+type InkContext interface {
+	Context
+
+	// SetFillInks sets the inks painted by fill operations. Setting the fill
+	// color or pattern replaces the fill inks by process inks approximating
+	// the fill color.
+	SetFillInks(inks Inks)
+
+	// SetStrokeInks sets the inks painted by stroke operations. Setting the
+	// stroke color or pattern replaces the stroke inks by process inks
+	// approximating the stroke color.
+	SetStrokeInks(inks Inks)
+
+	// SetFillOverprint sets whether fill operations leave the colorants
+	// which they do not paint unchanged, instead of erasing them.
+	SetFillOverprint(overprint bool)
+
+	// SetStrokeOverprint sets whether stroke operations leave the colorants
+	// which they do not paint unchanged, instead of erasing them.
+	SetStrokeOverprint(overprint bool)
+
+	// SetOverprintMode sets the overprint mode. In nonzero overprint mode,
+	// the components of DeviceCMYK colors having a tint of 0 leave their
+	// colorants unchanged when overprinting.
+	SetOverprintMode(mode int)
+
+	// DrawInkImageAnchored draws the specified ink image at the specified
+	// anchor point. The image paints its colorants, and erases the other
+	// colorants unless the fill overprint is enabled.
+	DrawInkImageAnchored(img *InkImage, x, y int, ax, ay float64)
+}
@@ -0,0 +1,146 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package imagerender
+
+import (
+	"math"
+
+	"github.com/showntop/unipdf/render/internal/context"
+)
+
+// rgb represents a color having its components in range 0-1.
+type rgb [3]float64
+
+// blend returns the result of blending the backdrop color `cb` with the
+// source color `cs`, using the specified blend mode. The colors are not
+// premultiplied by their alpha values.
+//
+// See section 11.3.5 "Blend Mode" and
+// Tables 136-137 (pp. 320-325 PDF32000_2008).
+func blend(mode context.BlendMode, cb, cs rgb) rgb {
+	switch mode {
+	case context.BlendModeHue:
+		return setLum(setSat(cs, sat(cb)), lum(cb))
+	case context.BlendModeSaturation:
+		return setLum(setSat(cb, sat(cs)), lum(cb))
+	case context.BlendModeColor:
+		return setLum(cs, lum(cb))
+	case context.BlendModeLuminosity:
+		return setLum(cb, lum(cs))
+	}
+
+	var c rgb
+	for i := range c {
+		c[i] = blendComponent(mode, cb[i], cs[i])
+	}
+	return c
+}
+
+// blendComponent returns the result of blending the backdrop color component
+// `cb` with the source color component `cs`, using the specified separable
+// blend mode.
+func blendComponent(mode context.BlendMode, cb, cs float64) float64 {
+	switch mode {
+	case context.BlendModeMultiply:
+		return cb * cs
+	case context.BlendModeScreen:
+		return cb + cs - cb*cs
+	case context.BlendModeOverlay:
+		return blendComponent(context.BlendModeHardLight, cs, cb)
+	case context.BlendModeDarken:
+		return math.Min(cb, cs)
+	case context.BlendModeLighten:
+		return math.Max(cb, cs)
+	case context.BlendModeColorDodge:
+		if cb == 0 {
+			return 0
+		}
+		if cs >= 1 {
+			return 1
+		}
+		return math.Min(1, cb/(1-cs))
+	case context.BlendModeColorBurn:
+		if cb >= 1 {
+			return 1
+		}
+		if cs <= 0 {
+			return 0
+		}
+		return 1 - math.Min(1, (1-cb)/cs)
+	case context.BlendModeHardLight:
+		if cs <= 0.5 {
+			return cb * 2 * cs
+		}
+		return blendComponent(context.BlendModeScreen, cb, 2*cs-1)
+	case context.BlendModeSoftLight:
+		if cs <= 0.5 {
+			return cb - (1-2*cs)*cb*(1-cb)
+		}
+		d := math.Sqrt(cb)
+		if cb <= 0.25 {
+			d = ((16*cb-12)*cb + 4) * cb
+		}
+		return cb + (2*cs-1)*(d-cb)
+	case context.BlendModeDifference:
+		return math.Abs(cb - cs)
+	case context.BlendModeExclusion:
+		return cb + cs - 2*cb*cs
+	}
+	return cs
+}
+
+// lum returns the luminosity of the specified color.
+func lum(c rgb) float64 {
+	return 0.3*c[0] + 0.59*c[1] + 0.11*c[2]
+}
+
+// setLum returns the color having the hue and saturation of color `c` and
+// the luminosity `l`.
+func setLum(c rgb, l float64) rgb {
+	d := l - lum(c)
+	c = rgb{c[0] + d, c[1] + d, c[2] + d}
+
+	// Clip the color components to the 0-1 range, preserving luminosity.
+	l = lum(c)
+	n := math.Min(c[0], math.Min(c[1], c[2]))
+	x := math.Max(c[0], math.Max(c[1], c[2]))
+	for i := range c {
+		if n < 0 {
+			c[i] = l + (c[i]-l)*l/(l-n)
+		}
+		if x > 1 {
+			c[i] = l + (c[i]-l)*(1-l)/(x-l)
+		}
+	}
+	return c
+}
+
+// sat returns the saturation of the specified color.
+func sat(c rgb) float64 {
+	return math.Max(c[0], math.Max(c[1], c[2])) - math.Min(c[0], math.Min(c[1], c[2]))
+}
+
+// setSat returns the color having the hue of color `c` and the saturation `s`.
+func setSat(c rgb, s float64) rgb {
+	// Find the indices of the minimum, middle and maximum components.
+	min, mid, max := 0, 1, 2
+	if c[min] > c[mid] {
+		min, mid = mid, min
+	}
+	if c[mid] > c[max] {
+		mid, max = max, mid
+	}
+	if c[min] > c[mid] {
+		min, mid = mid, min
+	}
+
+	var r rgb
+	if c[max] > c[min] {
+		r[mid] = (c[mid] - c[min]) * s / (c[max] - c[min])
+		r[max] = s
+	}
+	return r
+}
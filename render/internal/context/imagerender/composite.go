@@ -0,0 +1,267 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package imagerender
+
+import (
+	"image"
+	"math"
+
+	"github.com/golang/freetype/raster"
+
+	"github.com/showntop/unipdf/render/internal/context"
+)
+
+// group represents a transparency group which is being rendered
+// (section 11.4 "Transparency Groups" p. 326 PDF32000_2008).
+type group struct {
+	// backdrop is the image onto which the group is composited.
+	backdrop *image.RGBA
+
+	// layer specifies whether the group is painted onto a separate image.
+	// Non-isolated, non-knockout groups which are composited using the
+	// default parameters are painted directly onto their backdrop.
+	layer bool
+
+	isolated bool
+	knockout bool
+
+	// alpha contains the group alpha of non-isolated groups, which is
+	// the accumulated alpha of the painted objects, excluding the backdrop.
+	alpha []float32
+
+	// Graphics state parameters in effect when the group was started.
+	mask        *image.Alpha
+	softMask    *image.Alpha
+	fillAlpha   float64
+	strokeAlpha float64
+	blendMode   context.BlendMode
+}
+
+// initial returns the initial contents of the group image, which is the
+// backdrop for non-isolated groups. Isolated groups start out transparent,
+// in which case nil is returned.
+func (g *group) initial() *image.RGBA {
+	if g.isolated {
+		return nil
+	}
+	return g.backdrop
+}
+
+// compositor composites painted objects with the pixels of an image, using
+// the transparency parameters of the graphics state (section 11.3 "Basic
+// Compositing Computations" p. 318 PDF32000_2008).
+type compositor struct {
+	im        *image.RGBA
+	mask      *image.Alpha
+	softMask  *image.Alpha
+	alpha     float64
+	blendMode context.BlendMode
+
+	// group is the innermost transparency group being painted onto a
+	// separate image. It is nil if there is none.
+	group *group
+}
+
+// paint composites the source color `src`, premultiplied by its alpha, with
+// the pixel at `x`,`y`. The source color covers the specified fraction of
+// the pixel, which represents the shape of the painted object.
+func (c *compositor) paint(x, y int, shape float64, src [4]float64) {
+	if c.mask != nil {
+		shape *= float64(c.mask.AlphaAt(x, y).A) / 255
+	}
+	if shape <= 0 {
+		return
+	}
+
+	opacity := c.alpha
+	if c.softMask != nil {
+		opacity *= float64(c.softMask.AlphaAt(x, y).A) / 255
+	}
+
+	// Objects painted in knockout groups are composited with the initial
+	// backdrop of the group, replacing the previously painted objects in
+	// proportion to their shape.
+	knockout := c.group != nil && c.group.knockout
+	if !knockout {
+		opacity *= shape
+	}
+	for i := range src {
+		src[i] *= opacity
+	}
+
+	i := c.im.PixOffset(x, y)
+	pix := c.im.Pix[i : i+4 : i+4]
+	dst := rgbaAt(pix)
+	backdrop := dst
+	if knockout {
+		backdrop = [4]float64{}
+		if initial := c.group.initial(); initial != nil {
+			j := initial.PixOffset(x, y)
+			backdrop = rgbaAt(initial.Pix[j : j+4 : j+4])
+		}
+	}
+
+	res := composite(c.blendMode, backdrop, src)
+	if knockout {
+		for i := range res {
+			res[i] = dst[i] + (res[i]-dst[i])*shape
+		}
+	}
+	setRGBA(pix, res)
+
+	if g := c.group; g != nil && g.alpha != nil {
+		j := (y-c.im.Rect.Min.Y)*c.im.Rect.Dx() + x - c.im.Rect.Min.X
+		ga := float64(g.alpha[j])
+		if knockout {
+			ga += (src[3] - ga) * shape
+		} else {
+			ga += src[3] - ga*src[3]
+		}
+		g.alpha[j] = float32(ga)
+	}
+}
+
+// paintImage composites the specified image, which must have the same bounds
+// as the destination image, with the destination image.
+func (c *compositor) paintImage(src *image.RGBA) {
+	b := src.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			i := src.PixOffset(x, y)
+			pix := src.Pix[i : i+4 : i+4]
+			if pix[3] == 0 {
+				continue
+			}
+			c.paint(x, y, 1, rgbaAt(pix))
+		}
+	}
+}
+
+// composite returns the result of compositing the source color `src` with
+// the backdrop color `dst`, using the specified blend mode. The colors are
+// premultiplied by their alpha values.
+func composite(mode context.BlendMode, dst, src [4]float64) [4]float64 {
+	as, ab := src[3], dst[3]
+	var res [4]float64
+	if mode == context.BlendModeNormal || as == 0 || ab == 0 {
+		for i := range res {
+			res[i] = src[i] + dst[i]*(1-as)
+		}
+		return res
+	}
+
+	var cb, cs rgb
+	for i := range cb {
+		cb[i] = dst[i] / ab
+		cs[i] = src[i] / as
+	}
+	b := blend(mode, cb, cs)
+	for i := range b {
+		res[i] = (1-as)*dst[i] + (1-ab)*src[i] + as*ab*b[i]
+	}
+	res[3] = as + ab - as*ab
+	return res
+}
+
+// rgbaAt returns the premultiplied color components of the specified RGBA
+// pixel, in range 0-1.
+func rgbaAt(pix []uint8) [4]float64 {
+	return [4]float64{
+		float64(pix[0]) / 255,
+		float64(pix[1]) / 255,
+		float64(pix[2]) / 255,
+		float64(pix[3]) / 255,
+	}
+}
+
+// setRGBA sets the components of the specified RGBA pixel.
+func setRGBA(pix []uint8, c [4]float64) {
+	for i := range c {
+		pix[i] = uint8(math.Round(math.Max(0, math.Min(1, c[i])) * 255))
+	}
+}
+
+// compositePainter is a painter which composites the colors of a pattern with
+// the destination image, using the transparency parameters of the graphics
+// state.
+type compositePainter struct {
+	c *compositor
+	p context.Pattern
+}
+
+// Paint satisfies the Painter interface.
+func (r *compositePainter) Paint(ss []raster.Span, done bool) {
+	b := r.c.im.Bounds()
+	for _, s := range ss {
+		if s.Y < b.Min.Y {
+			continue
+		}
+		if s.Y >= b.Max.Y {
+			return
+		}
+		if s.X0 < b.Min.X {
+			s.X0 = b.Min.X
+		}
+		if s.X1 > b.Max.X {
+			s.X1 = b.Max.X
+		}
+
+		const m = 1<<16 - 1
+		shape := float64(s.Alpha) / m
+		for x := s.X0; x < s.X1; x++ {
+			cr, cg, cb, ca := r.p.ColorAt(x, s.Y).RGBA()
+			r.c.paint(x, s.Y, shape, [4]float64{
+				float64(cr) / m,
+				float64(cg) / m,
+				float64(cb) / m,
+				float64(ca) / m,
+			})
+		}
+	}
+}
+
+func newCompositePainter(c *compositor, p context.Pattern) *compositePainter {
+	return &compositePainter{c, p}
+}
+
+// removeBackdrop converts the contents of the image of a non-isolated group,
+// which contains the backdrop of the group, to the group color and alpha, so
+// that the group can be composited with its backdrop.
+//
+// See section 11.4.4 "Group Compositing Computations" and
+// equation 11.4.8.2 (p. 332 PDF32000_2008).
+func (g *group) removeBackdrop(im *image.RGBA) {
+	b := im.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			i := im.PixOffset(x, y)
+			pix := im.Pix[i : i+4 : i+4]
+			ag := float64(g.alpha[(y-b.Min.Y)*b.Dx()+x-b.Min.X])
+			if ag <= 0 {
+				setRGBA(pix, [4]float64{})
+				continue
+			}
+
+			cn := rgbaAt(pix)
+			c0 := rgbaAt(g.backdrop.Pix[i : i+4 : i+4])
+			an, a0 := cn[3], c0[3]
+
+			var res [4]float64
+			for k := 0; k < 3; k++ {
+				if an > 0 {
+					cn[k] /= an
+				}
+				if a0 > 0 {
+					c0[k] /= a0
+				}
+				c := cn[k] + (cn[k]-c0[k])*(a0/ag-a0)
+				res[k] = math.Max(0, math.Min(1, c)) * ag
+			}
+			res[3] = ag
+			setRGBA(pix, res)
+		}
+	}
+}
@@ -13,7 +13,6 @@ import (
 
 	"github.com/golang/freetype/raster"
 	"golang.org/x/image/draw"
-	"golang.org/x/image/font"
 	"golang.org/x/image/math/f64"
 
 	"github.com/showntop/unipdf/internal/transform"
@@ -36,6 +35,7 @@ type Context struct {
 	fillPattern   context.Pattern
 	strokePattern context.Pattern
 	strokePath    raster.Path
+	strokeClosed  []bool
 	fillPath      raster.Path
 	start         transform.Point
 	current       transform.Point
@@ -45,8 +45,14 @@ type Context struct {
 	lineWidth     float64
 	lineCap       context.LineCap
 	lineJoin      context.LineJoin
+	miterLimit    float64
 	fillRule      context.FillRule
 	matrix        transform.Matrix
+	fillAlpha     float64
+	strokeAlpha   float64
+	blendMode     context.BlendMode
+	softMask      *image.Alpha
+	groups        []*group
 	textState     *context.TextState
 	stack         []*Context
 }
@@ -78,8 +84,13 @@ func NewContextForRGBA(im *image.RGBA) *Context {
 		fillPattern:   defaultFillStyle,
 		strokePattern: defaultStrokeStyle,
 		lineWidth:     1,
+		lineCap:       context.LineCapButt,
+		lineJoin:      context.LineJoinMiter,
+		miterLimit:    10,
 		fillRule:      context.FillRuleWinding,
 		matrix:        transform.IdentityMatrix(),
+		fillAlpha:     1,
+		strokeAlpha:   1,
 		textState:     context.NewTextState(),
 	}
 }
@@ -117,7 +128,8 @@ func (dc *Context) LineWidth() float64 {
 	return dc.lineWidth
 }
 
-// SetLineWidth sets the line width of the context.
+// SetLineWidth sets the line width of the context, expressed in user space
+// units.
 func (dc *Context) SetLineWidth(lineWidth float64) {
 	dc.lineWidth = lineWidth
 }
@@ -132,6 +144,12 @@ func (dc *Context) SetLineJoin(lineJoin context.LineJoin) {
 	dc.lineJoin = lineJoin
 }
 
+// SetMiterLimit sets the miter limit, which is the maximum ratio between the
+// length of a miter join and the line width.
+func (dc *Context) SetMiterLimit(limit float64) {
+	dc.miterLimit = limit
+}
+
 // SetFillRule sets the fill rule.
 func (dc *Context) SetFillRule(fillRule context.FillRule) {
 	dc.fillRule = fillRule
@@ -246,6 +264,7 @@ func (dc *Context) MoveTo(x, y float64) {
 	fp := fixedPoint(p)
 
 	dc.strokePath.Start(fp)
+	dc.strokeClosed = append(dc.strokeClosed, false)
 	dc.fillPath.Start(fp)
 	dc.start = p
 	dc.current = p
@@ -262,6 +281,7 @@ func (dc *Context) LineTo(x, y float64) {
 		p := transform.NewPoint(x, y)
 		fp := fixedPoint(p)
 
+		dc.reopenStrokePath()
 		dc.strokePath.Add1(fp)
 		dc.fillPath.Add1(fp)
 		dc.current = p
@@ -283,6 +303,7 @@ func (dc *Context) QuadraticTo(x1, y1, x2, y2 float64) {
 	fp1 := fixedPoint(p1)
 	fp2 := fixedPoint(p2)
 
+	dc.reopenStrokePath()
 	dc.strokePath.Add2(fp1, fp2)
 	dc.fillPath.Add2(fp1, fp2)
 	dc.current = p2
@@ -301,6 +322,7 @@ func (dc *Context) CubicTo(x1, y1, x2, y2, x3, y3 float64) {
 	x2, y2 = dc.Transform(x2, y2)
 	x3, y3 = dc.Transform(x3, y3)
 	points := cubicBezier(x0, y0, x1, y1, x2, y2, x3, y3)
+	dc.reopenStrokePath()
 	previous := fixedPoint(dc.current)
 	for _, p := range points[1:] {
 		f := fixedPoint(p)
@@ -323,6 +345,19 @@ func (dc *Context) ClosePath() {
 		dc.strokePath.Add1(fp)
 		dc.fillPath.Add1(fp)
 		dc.current = dc.start
+		if n := len(dc.strokeClosed); n > 0 {
+			dc.strokeClosed[n-1] = true
+		}
+	}
+}
+
+// reopenStrokePath starts a new stroke subpath at the current point if the
+// current subpath has been closed, as the segments added after closing a
+// subpath belong to a new subpath.
+func (dc *Context) reopenStrokePath() {
+	if n := len(dc.strokeClosed); n > 0 && dc.strokeClosed[n-1] {
+		dc.strokePath.Start(fixedPoint(dc.current))
+		dc.strokeClosed = append(dc.strokeClosed, false)
 	}
 }
 
@@ -330,6 +365,7 @@ func (dc *Context) ClosePath() {
 // operation.
 func (dc *Context) ClearPath() {
 	dc.strokePath.Clear()
+	dc.strokeClosed = nil
 	dc.fillPath.Clear()
 	dc.hasCurrent = false
 }
@@ -365,23 +401,17 @@ func (dc *Context) joiner() raster.Joiner {
 		return raster.BevelJoiner
 	case context.LineJoinRound:
 		return raster.RoundJoiner
+	case context.LineJoinMiter:
+		return miterJoiner{limit: dc.miterLimit}
 	}
 	return nil
 }
 
 func (dc *Context) stroke(painter raster.Painter) {
-	path := dc.strokePath
-	if len(dc.dashes) > 0 {
-		path = dashed(path, dc.dashes, dc.dashOffset)
-	} else {
-		// TODO: this is a temporary workaround to remove tiny segments
-		// that result in rendering issues
-		path = rasterPath(flattenPath(path))
-	}
 	r := dc.rasterizer
 	r.UseNonZeroWinding = true
 	r.Clear()
-	r.AddStroke(path, fix(dc.lineWidth), dc.capper(), dc.joiner())
+	dc.addStroke(r)
 	r.Rasterize(painter)
 }
 
@@ -399,24 +429,29 @@ func (dc *Context) fill(painter raster.Painter) {
 	r.Rasterize(painter)
 }
 
-// StrokePreserve strokes the current path with the current color, line width,
-// line cap, line join and dash settings. The path is preserved after this
-// operation.
-func (dc *Context) StrokePreserve() {
-	var painter raster.Painter
+// painter returns the painter used for painting the specified pattern with
+// the specified constant alpha.
+func (dc *Context) painter(pattern context.Pattern, alpha float64) raster.Painter {
+	if dc.transparent(alpha) {
+		return newCompositePainter(dc.compositor(alpha), pattern)
+	}
 	if dc.mask == nil {
-		if pattern, ok := dc.strokePattern.(*solidPattern); ok {
+		if pattern, ok := pattern.(*solidPattern); ok {
 			// with a nil mask and a solid color pattern, we can be more efficient
 			// TODO: refactor so we don't have to do this type assertion stuff?
 			p := raster.NewRGBAPainter(dc.im)
 			p.SetColor(pattern.color)
-			painter = p
+			return p
 		}
 	}
-	if painter == nil {
-		painter = newPatternPainter(dc.im, dc.mask, dc.strokePattern)
-	}
-	dc.stroke(painter)
+	return newPatternPainter(dc.im, dc.mask, pattern)
+}
+
+// StrokePreserve strokes the current path with the current color, line width,
+// line cap, line join and dash settings. The path is preserved after this
+// operation.
+func (dc *Context) StrokePreserve() {
+	dc.stroke(dc.painter(dc.strokePattern, dc.strokeAlpha))
 }
 
 // Stroke strokes the current path with the current color, line width,
@@ -430,20 +465,7 @@ func (dc *Context) Stroke() {
 // FillPreserve fills the current path with the current color. Open subpaths
 // are implicity closed. The path is preserved after this operation.
 func (dc *Context) FillPreserve() {
-	var painter raster.Painter
-	if dc.mask == nil {
-		if pattern, ok := dc.fillPattern.(*solidPattern); ok {
-			// with a nil mask and a solid color pattern, we can be more efficient
-			// TODO: refactor so we don't have to do this type assertion stuff?
-			p := raster.NewRGBAPainter(dc.im)
-			p.SetColor(pattern.color)
-			painter = p
-		}
-	}
-	if painter == nil {
-		painter = newPatternPainter(dc.im, dc.mask, dc.fillPattern)
-	}
-	dc.fill(painter)
+	dc.fill(dc.painter(dc.fillPattern, dc.fillAlpha))
 }
 
 // Fill fills the current path with the current color. Open subpaths
@@ -650,7 +672,11 @@ func (dc *Context) DrawImageAnchored(im image.Image, x, y int, ax, ay float64) {
 	m := dc.matrix.Clone()
 	m.Translate(float64(x), float64(y))
 	s2d := f64.Aff3{m[0], m[3], m[6], m[1], m[4], m[7]}
-	if dc.mask == nil {
+	if dc.transparent(dc.fillAlpha) {
+		layer := image.NewRGBA(dc.im.Bounds())
+		transformer.Transform(layer, s2d, im, im.Bounds(), draw.Over, nil)
+		dc.compositor(dc.fillAlpha).paintImage(layer)
+	} else if dc.mask == nil {
 		transformer.Transform(dc.im, s2d, im, im.Bounds(), draw.Over, nil)
 	} else {
 		transformer.Transform(dc.im, s2d, im, im.Bounds(), draw.Over, &draw.Options{
@@ -661,77 +687,135 @@ func (dc *Context) DrawImageAnchored(im image.Image, x, y int, ax, ay float64) {
 }
 
 //
-// Text operations
+// Transparency operations
 //
 
-// TextState returns the current text state.
-func (dc *Context) TextState() *context.TextState {
-	return dc.textState
+// SetFillAlpha sets the constant alpha used for fill operations.
+func (dc *Context) SetFillAlpha(alpha float64) {
+	dc.fillAlpha = alpha
+}
+
+// SetStrokeAlpha sets the constant alpha used for stroke operations.
+func (dc *Context) SetStrokeAlpha(alpha float64) {
+	dc.strokeAlpha = alpha
+}
+
+// SetBlendMode sets the blend mode used for compositing painted objects with
+// their backdrop.
+func (dc *Context) SetBlendMode(mode context.BlendMode) {
+	dc.blendMode = mode
+}
+
+// SetSoftMask sets the soft mask, which modulates the alpha of painted
+// objects. A nil mask removes the soft mask.
+func (dc *Context) SetSoftMask(mask *image.Alpha) {
+	dc.softMask = mask
 }
 
-func (dc *Context) drawString(im *image.RGBA, s string, x, y float64) {
-	d := &font.Drawer{
-		Dst:  im,
-		Src:  image.NewUniform(dc.color),
-		Face: dc.textState.Tf.Face,
-		Dot:  fixedPoint(transform.NewPoint(x, y)),
+// BeginGroup starts a transparency group. The subsequent operations are
+// painted onto the group, which is composited with its backdrop when EndGroup
+// is called.
+func (dc *Context) BeginGroup(isolated, knockout bool) {
+	g := &group{
+		backdrop:    dc.im,
+		isolated:    isolated,
+		knockout:    knockout,
+		mask:        dc.mask,
+		softMask:    dc.softMask,
+		fillAlpha:   dc.fillAlpha,
+		strokeAlpha: dc.strokeAlpha,
+		blendMode:   dc.blendMode,
 	}
-	// based on Drawer.DrawString() in golang.org/x/image/font/font.go
-	prevC := rune(-1)
-	for _, c := range s {
-		if prevC >= 0 {
-			d.Dot.X += d.Face.Kern(prevC, c)
-		}
-		dr, mask, maskp, advance, ok := d.Face.Glyph(d.Dot, c)
-		if !ok {
-			// TODO: is falling back on the U+FFFD glyph the responsibility of
-			// the Drawer or the Face?
-			// TODO: set prevC = '\ufffd'?
-			continue
+
+	// Groups which are equivalent to painting their objects directly onto
+	// the backdrop do not require a separate image.
+	parent := dc.layerGroup()
+	g.layer = isolated || knockout || dc.fillAlpha < 1 ||
+		dc.blendMode != context.BlendModeNormal || dc.softMask != nil ||
+		(parent != nil && parent.knockout)
+	if g.layer {
+		dc.im = image.NewRGBA(g.backdrop.Bounds())
+		if !isolated {
+			copy(dc.im.Pix, g.backdrop.Pix)
+			g.alpha = make([]float32, dc.width*dc.height)
 		}
-		sr := dr.Sub(dr.Min)
-		transformer := draw.BiLinear
-		m := dc.matrix.Clone()
-		m.Translate(float64(dr.Min.X), float64(dr.Min.Y))
-		s2d := f64.Aff3{m[0], m[3], m[6], m[1], m[4], m[7]}
-		transformer.Transform(d.Dst, s2d, d.Src, sr, draw.Over, &draw.Options{
-			SrcMask:  mask,
-			SrcMaskP: maskp,
-		})
-		d.Dot.X += advance
-		prevC = c
 	}
+	dc.groups = append(dc.groups, g)
+
+	dc.fillAlpha = 1
+	dc.strokeAlpha = 1
+	dc.blendMode = context.BlendModeNormal
+	dc.softMask = nil
 }
 
-// DrawString draws the specified text at the specified point.
-func (dc *Context) DrawString(s string, x, y float64) {
-	dc.DrawStringAnchored(s, x, y, 0, 0)
+// EndGroup ends the most recent transparency group and composites it with its
+// backdrop.
+func (dc *Context) EndGroup() {
+	if len(dc.groups) == 0 {
+		return
+	}
+	g := dc.groups[len(dc.groups)-1]
+	dc.groups = dc.groups[:len(dc.groups)-1]
+
+	im := dc.im
+	dc.im = g.backdrop
+	dc.mask = g.mask
+	dc.softMask = g.softMask
+	dc.fillAlpha = g.fillAlpha
+	dc.strokeAlpha = g.strokeAlpha
+	dc.blendMode = g.blendMode
+	if !g.layer {
+		return
+	}
+
+	if !g.isolated {
+		g.removeBackdrop(im)
+	}
+	dc.compositor(dc.fillAlpha).paintImage(im)
 }
 
-// DrawStringAnchored draws the specified text at the specified anchor point.
-// The anchor point is x - w * ax, y - h * ay, where w, h is the size of the
-// text. Use ax=0.5, ay=0.5 to center the text at the specified point.
-func (dc *Context) DrawStringAnchored(s string, x, y, ax, ay float64) {
-	w, h := dc.MeasureString(s)
-	x -= ax * w
-	y += ay * h
-	if dc.mask == nil {
-		dc.drawString(dc.im, s, x, y)
-	} else {
-		im := image.NewRGBA(image.Rect(0, 0, dc.width, dc.height))
-		dc.drawString(im, s, x, y)
-		draw.DrawMask(dc.im, dc.im.Bounds(), im, image.ZP, dc.mask, image.ZP, draw.Over)
+// layerGroup returns the innermost transparency group which is painted onto
+// a separate image, or nil if there is none.
+func (dc *Context) layerGroup() *group {
+	for i := len(dc.groups) - 1; i >= 0; i-- {
+		if g := dc.groups[i]; g.layer {
+			return g
+		}
 	}
+	return nil
 }
 
-// MeasureString returns the rendered width and height of the specified text
-// given the current font face.
-func (dc *Context) MeasureString(s string) (w, h float64) {
-	d := &font.Drawer{
-		Face: dc.textState.Tf.Face,
+// transparent returns true if painting with the specified constant alpha
+// requires the transparency compositing computations, as opposed to simply
+// painting over the destination image.
+func (dc *Context) transparent(alpha float64) bool {
+	if alpha < 1 || dc.blendMode != context.BlendModeNormal || dc.softMask != nil {
+		return true
+	}
+	g := dc.layerGroup()
+	return g != nil && (g.knockout || !g.isolated)
+}
+
+// compositor returns a compositor for painting with the specified constant
+// alpha, using the current transparency parameters.
+func (dc *Context) compositor(alpha float64) *compositor {
+	return &compositor{
+		im:        dc.im,
+		mask:      dc.mask,
+		softMask:  dc.softMask,
+		alpha:     alpha,
+		blendMode: dc.blendMode,
+		group:     dc.layerGroup(),
 	}
-	a := d.MeasureString(s)
-	return float64(a >> 6), dc.textState.Tf.Size
+}
+
+//
+// Text operations
+//
+
+// TextState returns the current text state.
+func (dc *Context) TextState() *context.TextState {
+	return dc.textState
 }
 
 //
@@ -826,9 +910,14 @@ func (dc *Context) Pop() {
 	*dc = *x
 	//dc.mask = before.mask
 	dc.strokePath = before.strokePath
+	dc.strokeClosed = before.strokeClosed
 	dc.fillPath = before.fillPath
 	dc.start = before.start
 	dc.current = before.current
 	dc.hasCurrent = before.hasCurrent
 	dc.textState = before.textState
+
+	// The transparency groups are not part of the graphics state.
+	dc.im = before.im
+	dc.groups = before.groups
 }
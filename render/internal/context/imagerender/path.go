@@ -166,7 +166,3 @@ func rasterPath(paths [][]transform.Point) raster.Path {
 	}
 	return result
 }
-
-func dashed(path raster.Path, dashes []float64, offset float64) raster.Path {
-	return rasterPath(dashPath(flattenPath(path), dashes, offset))
-}
@@ -0,0 +1,158 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package imagerender
+
+import (
+	"math"
+
+	"github.com/golang/freetype/raster"
+	"golang.org/x/image/math/fixed"
+
+	"github.com/showntop/unipdf/internal/transform"
+)
+
+// miterJoiner adds miter joins to a stroked path. Joins having a miter length
+// to line width ratio greater than the miter limit are beveled
+// (section 8.4.3.5 "Miter Limit" p. 126 PDF32000_2008).
+type miterJoiner struct {
+	limit float64
+}
+
+// Join satisfies the Joiner interface.
+func (j miterJoiner) Join(lhs, rhs raster.Adder, halfWidth fixed.Int26_6, pivot, n0, n1 fixed.Point26_6) {
+	// The miter tip is located on the bisector of the segment normals n0
+	// and n1, which have a length of halfWidth. The ratio between the miter
+	// length and the line width is 1/cos(phi), where phi is half the angle
+	// between the normals.
+	u := float64(halfWidth)
+	sx, sy := float64(n0.X+n1.X), float64(n0.Y+n1.Y)
+	s2 := sx*sx + sy*sy
+	if s2 == 0 || 2*u/math.Sqrt(s2) > j.limit {
+		raster.BevelJoiner.Join(lhs, rhs, halfWidth, pivot, n0, n1)
+		return
+	}
+	k := 2 * u * u / s2
+	m := fixed.Point26_6{X: fixed.Int26_6(sx * k), Y: fixed.Int26_6(sy * k)}
+
+	// The miter is added to the outer side of the join, as determined by the
+	// turning direction.
+	if float64(n0.X)*float64(n1.Y)-float64(n0.Y)*float64(n1.X) >= 0 {
+		lhs.Add1(pivot.Add(m))
+		lhs.Add1(pivot.Add(n1))
+		rhs.Add1(pivot.Sub(n1))
+	} else {
+		lhs.Add1(pivot.Add(n1))
+		rhs.Add1(pivot.Sub(m))
+		rhs.Add1(pivot.Sub(n1))
+	}
+}
+
+// transformAdder is an adder which transforms the points of the added path
+// segments before adding them to the underlying adder.
+type transformAdder struct {
+	a raster.Adder
+	m transform.Matrix
+}
+
+func (t *transformAdder) point(p fixed.Point26_6) fixed.Point26_6 {
+	x, y := t.m.Transform(unfix(p.X), unfix(p.Y))
+	return fixedPoint(transform.NewPoint(x, y))
+}
+
+// Start satisfies the Adder interface.
+func (t *transformAdder) Start(a fixed.Point26_6) {
+	t.a.Start(t.point(a))
+}
+
+// Add1 satisfies the Adder interface.
+func (t *transformAdder) Add1(b fixed.Point26_6) {
+	t.a.Add1(t.point(b))
+}
+
+// Add2 satisfies the Adder interface.
+func (t *transformAdder) Add2(b, c fixed.Point26_6) {
+	t.a.Add2(t.point(b), t.point(c))
+}
+
+// Add3 satisfies the Adder interface.
+func (t *transformAdder) Add3(b, c, d fixed.Point26_6) {
+	t.a.Add3(t.point(b), t.point(c), t.point(d))
+}
+
+// addStroke adds the outline of the stroked current path to the specified
+// adder, using the current line width, line cap, line join and dash settings.
+//
+// The line width and the dash lengths are expressed in user space, so the
+// path is stroked in a space which differs from user space by a uniform
+// scaling only. The resulting outline is then transformed to device space,
+// so that the strokes are distorted by non-uniform transformation matrices,
+// as the pen is (section 8.4.3.2 "Line Width" p. 125 PDF32000_2008).
+func (dc *Context) addStroke(a raster.Adder) {
+	m := dc.matrix
+	det := m[0]*m[4] - m[1]*m[3]
+	inverse, ok := m.Inverse()
+	if det == 0 || !ok {
+		return
+	}
+
+	// The stroke space is scaled so that its units are close to the size of
+	// the device pixels, which preserves the precision of the fixed point
+	// stroke computations.
+	s := math.Sqrt(math.Abs(det))
+	toStroke := transform.ScaleMatrix(s, s).Mult(inverse)
+	toDevice := m.Mult(transform.ScaleMatrix(1/s, 1/s))
+
+	// Lines having a width of 0 are stroked using the thinnest line which
+	// can be rendered.
+	width := dc.lineWidth * s
+	if width <= 0 {
+		width = 1
+	}
+
+	var dashes []float64
+	for _, dash := range dc.dashes {
+		if dash < 0 {
+			dashes = nil
+			break
+		}
+		if dash > 0 {
+			dashes = dc.dashes
+		}
+	}
+	scaled := make([]float64, len(dashes))
+	for i, dash := range dashes {
+		scaled[i] = dash * s
+	}
+	if len(scaled)%2 == 1 {
+		// Dash arrays having an odd number of elements are repeated, so that
+		// the dashes and the gaps alternate.
+		scaled = append(scaled, scaled...)
+	}
+
+	paths := flattenPath(dc.strokePath)
+	for i, path := range paths {
+		points := make([]transform.Point, len(path))
+		for j, p := range path {
+			x, y := toStroke.Transform(p.X, p.Y)
+			points[j] = transform.NewPoint(x, y)
+		}
+
+		// Closed subpaths are extended with their first segment, so that
+		// their starting points are joined instead of being capped. Open
+		// subpaths ending at their starting point are capped.
+		closed := i < len(dc.strokeClosed) && dc.strokeClosed[i]
+		if len(scaled) == 0 && closed && len(path) > 2 {
+			points = append(points, points[1])
+		}
+		paths[i] = points
+	}
+	if len(scaled) > 0 {
+		paths = dashPath(paths, scaled, dc.dashOffset*s)
+	}
+
+	adder := &transformAdder{a: a, m: toDevice}
+	raster.Stroke(adder, rasterPath(paths), fix(width), dc.capper(), dc.joiner())
+}
@@ -0,0 +1,590 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package inkrender
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/showntop/unipdf/internal/transform"
+	"github.com/showntop/unipdf/render/internal/context"
+	"github.com/showntop/unipdf/render/internal/context/imagerender"
+)
+
+// Ink represents a colorant rendered by a context.
+type Ink struct {
+	// Name is the name of the colorant.
+	Name string
+
+	// Color is the appearance of the colorant at full tint, used for
+	// simulating the composite of the rendered colorants.
+	Color color.Color
+}
+
+// processInks contains the process colorants, which are rendered by all
+// contexts, in the order of the components of DeviceCMYK colors.
+var processInks = []Ink{
+	{Name: context.InkCyan, Color: color.RGBA{0, 255, 255, 255}},
+	{Name: context.InkMagenta, Color: color.RGBA{255, 0, 255, 255}},
+	{Name: context.InkYellow, Color: color.RGBA{255, 255, 0, 255}},
+	{Name: context.InkBlack, Color: color.RGBA{0, 0, 0, 255}},
+}
+
+// paint represents the paint used by fill or stroke operations. The process
+// colorants are painted using the pattern, if it is set, and the other
+// colorants are erased.
+type paint struct {
+	inks    context.Inks
+	pattern context.Pattern
+	alpha   float64
+}
+
+// inkState contains the ink parameters of the context state.
+type inkState struct {
+	fill            paint
+	stroke          paint
+	fillOverprint   bool
+	strokeOverprint bool
+	overprintMode   int
+}
+
+// Context represents a rendering context which renders each colorant onto a
+// separate plate. The plates are grayscale images in which the colorants are
+// painted black at full tint, drawn by image rendering contexts which receive
+// the drawing operations of the context. The colorants which are not painted
+// by a drawing operation are erased from the plates, unless overprinting is
+// enabled.
+type Context struct {
+	inks      []Ink
+	plates    []*imagerender.Context
+	index     map[string]int
+	state     inkState
+	stack     []inkState
+	textState *context.TextState
+}
+
+// NewContext returns a new context having a rendering area of the specified
+// width and height, which renders the process colorants and the specified
+// spot colorants. The plates are initially blank.
+func NewContext(width, height int, spots []Ink) *Context {
+	dc := &Context{
+		index:     map[string]int{},
+		textState: context.NewTextState(),
+	}
+
+	for _, ink := range append(append([]Ink{}, processInks...), spots...) {
+		if _, ok := dc.index[ink.Name]; ok || ink.Name == context.InkAll {
+			continue
+		}
+
+		im := image.NewRGBA(image.Rect(0, 0, width, height))
+		draw.Draw(im, im.Bounds(), image.White, image.ZP, draw.Src)
+
+		dc.index[ink.Name] = len(dc.inks)
+		dc.inks = append(dc.inks, ink)
+		dc.plates = append(dc.plates, imagerender.NewContextForRGBA(im))
+	}
+
+	black := paint{inks: context.Inks{Tints: map[string]float64{context.InkBlack: 1}}, alpha: 1}
+	dc.state = inkState{fill: black, stroke: black}
+	return dc
+}
+
+// Inks returns the colorants rendered by the context.
+func (dc *Context) Inks() []Ink {
+	return dc.inks
+}
+
+//
+// Graphics state operations
+//
+
+// Push adds the current context state on the stack.
+func (dc *Context) Push() {
+	for _, plate := range dc.plates {
+		plate.Push()
+	}
+	dc.stack = append(dc.stack, dc.state)
+}
+
+// Pop removes the most recent context state from the stack.
+func (dc *Context) Pop() {
+	if len(dc.stack) == 0 {
+		return
+	}
+	for _, plate := range dc.plates {
+		plate.Pop()
+	}
+	dc.state = dc.stack[len(dc.stack)-1]
+	dc.stack = dc.stack[:len(dc.stack)-1]
+}
+
+//
+// Matrix operations
+//
+
+// Matrix returns the current transformation matrix.
+func (dc *Context) Matrix() transform.Matrix {
+	return dc.plates[0].Matrix()
+}
+
+// SetMatrix modifies the transformation matrix.
+func (dc *Context) SetMatrix(m transform.Matrix) {
+	for _, plate := range dc.plates {
+		plate.SetMatrix(m)
+	}
+}
+
+// Translate updates the current matrix with a translation.
+func (dc *Context) Translate(x, y float64) {
+	for _, plate := range dc.plates {
+		plate.Translate(x, y)
+	}
+}
+
+// Scale updates the current matrix with a scaling factor.
+func (dc *Context) Scale(x, y float64) {
+	for _, plate := range dc.plates {
+		plate.Scale(x, y)
+	}
+}
+
+// Rotate updates the current matrix with a anticlockwise rotation.
+func (dc *Context) Rotate(angle float64) {
+	for _, plate := range dc.plates {
+		plate.Rotate(angle)
+	}
+}
+
+//
+// Path operations
+//
+
+// MoveTo starts a new subpath within the current path starting at the
+// specified point.
+func (dc *Context) MoveTo(x, y float64) {
+	for _, plate := range dc.plates {
+		plate.MoveTo(x, y)
+	}
+}
+
+// LineTo adds a line segment to the current path starting at the current
+// point.
+func (dc *Context) LineTo(x, y float64) {
+	for _, plate := range dc.plates {
+		plate.LineTo(x, y)
+	}
+}
+
+// CubicTo adds a cubic bezier curve to the current path starting at the
+// current point.
+func (dc *Context) CubicTo(x1, y1, x2, y2, x3, y3 float64) {
+	for _, plate := range dc.plates {
+		plate.CubicTo(x1, y1, x2, y2, x3, y3)
+	}
+}
+
+// QuadraticTo adds a quadratic bezier curve to the current path starting at
+// the current point.
+func (dc *Context) QuadraticTo(x1, y1, x2, y2 float64) {
+	for _, plate := range dc.plates {
+		plate.QuadraticTo(x1, y1, x2, y2)
+	}
+}
+
+// NewSubPath starts a new subpath within the current path.
+func (dc *Context) NewSubPath() {
+	for _, plate := range dc.plates {
+		plate.NewSubPath()
+	}
+}
+
+// ClosePath adds a line segment from the current point to the beginning of
+// the current subpath.
+func (dc *Context) ClosePath() {
+	for _, plate := range dc.plates {
+		plate.ClosePath()
+	}
+}
+
+// ClearPath clears the current path.
+func (dc *Context) ClearPath() {
+	for _, plate := range dc.plates {
+		plate.ClearPath()
+	}
+}
+
+// Clip updates the clipping region by intersecting the current clipping
+// region with the current path. The path is cleared after this operation.
+func (dc *Context) Clip() {
+	for _, plate := range dc.plates {
+		plate.Clip()
+	}
+}
+
+// ClipPreserve updates the clipping region by intersecting the current
+// clipping region with the current path. The path is preserved after this
+// operation.
+func (dc *Context) ClipPreserve() {
+	for _, plate := range dc.plates {
+		plate.ClipPreserve()
+	}
+}
+
+// ResetClip clears the clipping region.
+func (dc *Context) ResetClip() {
+	for _, plate := range dc.plates {
+		plate.ResetClip()
+	}
+}
+
+//
+// Line style operations
+//
+
+// LineWidth returns the current line width.
+func (dc *Context) LineWidth() float64 {
+	return dc.plates[0].LineWidth()
+}
+
+// SetLineWidth sets the line width.
+func (dc *Context) SetLineWidth(lineWidth float64) {
+	for _, plate := range dc.plates {
+		plate.SetLineWidth(lineWidth)
+	}
+}
+
+// SetLineCap sets the line cap style.
+func (dc *Context) SetLineCap(lineCap context.LineCap) {
+	for _, plate := range dc.plates {
+		plate.SetLineCap(lineCap)
+	}
+}
+
+// SetLineJoin sets the line join style.
+func (dc *Context) SetLineJoin(lineJoin context.LineJoin) {
+	for _, plate := range dc.plates {
+		plate.SetLineJoin(lineJoin)
+	}
+}
+
+// SetMiterLimit sets the miter limit.
+func (dc *Context) SetMiterLimit(limit float64) {
+	for _, plate := range dc.plates {
+		plate.SetMiterLimit(limit)
+	}
+}
+
+// SetDash sets the line dash pattern.
+func (dc *Context) SetDash(dashes ...float64) {
+	for _, plate := range dc.plates {
+		plate.SetDash(dashes...)
+	}
+}
+
+// SetDashOffset sets the initial offset into the dash pattern.
+func (dc *Context) SetDashOffset(offset float64) {
+	for _, plate := range dc.plates {
+		plate.SetDashOffset(offset)
+	}
+}
+
+//
+// Fill and stroke operations
+//
+
+// Fill fills the current path on the plates of the colorants affected by
+// the fill inks. The path is cleared after this operation.
+func (dc *Context) Fill() {
+	for i, plate := range dc.plates {
+		if dc.setPlatePaint(i, dc.state.fill, dc.state.fillOverprint, plate.SetFillRGBA, plate.SetFillStyle) {
+			plate.Fill()
+		} else {
+			plate.ClearPath()
+		}
+	}
+}
+
+// FillPreserve fills the current path on the plates of the colorants
+// affected by the fill inks. The path is preserved after this operation.
+func (dc *Context) FillPreserve() {
+	for i, plate := range dc.plates {
+		if dc.setPlatePaint(i, dc.state.fill, dc.state.fillOverprint, plate.SetFillRGBA, plate.SetFillStyle) {
+			plate.FillPreserve()
+		}
+	}
+}
+
+// Stroke strokes the current path on the plates of the colorants affected
+// by the stroke inks. The path is cleared after this operation.
+func (dc *Context) Stroke() {
+	for i, plate := range dc.plates {
+		if dc.setPlatePaint(i, dc.state.stroke, dc.state.strokeOverprint, plate.SetStrokeRGBA, plate.SetStrokeStyle) {
+			plate.Stroke()
+		} else {
+			plate.ClearPath()
+		}
+	}
+}
+
+// StrokePreserve strokes the current path on the plates of the colorants
+// affected by the stroke inks. The path is preserved after this operation.
+func (dc *Context) StrokePreserve() {
+	for i, plate := range dc.plates {
+		if dc.setPlatePaint(i, dc.state.stroke, dc.state.strokeOverprint, plate.SetStrokeRGBA, plate.SetStrokeStyle) {
+			plate.StrokePreserve()
+		}
+	}
+}
+
+// setPlatePaint sets the paint of the plate of the colorant having the
+// specified index, using the specified setters. The returned bool is false
+// if the plate is left unchanged by painting operations using `p`.
+func (dc *Context) setPlatePaint(i int, p paint, overprint bool,
+	setRGBA func(r, g, b, a float64), setStyle func(context.Pattern)) bool {
+	if p.pattern != nil {
+		if i < len(processInks) {
+			setStyle(&platePattern{pattern: p.pattern, component: i})
+			return true
+		}
+		if overprint {
+			return false
+		}
+		setRGBA(1, 1, 1, 1)
+		return true
+	}
+
+	tint, ok := p.inks.Tints[dc.inks[i].Name]
+	if !ok {
+		tint, ok = p.inks.Tints[context.InkAll]
+	}
+	switch {
+	case !ok && overprint:
+		return false
+	case ok && overprint && p.inks.CMYK && dc.state.overprintMode != 0 && tint == 0:
+		return false
+	}
+
+	v := 1 - clamp(tint)
+	setRGBA(v, v, v, p.alpha)
+	return true
+}
+
+// SetRGBA sets both the fill and stroke colors, along with process inks
+// approximating them.
+func (dc *Context) SetRGBA(r, g, b, a float64) {
+	dc.SetFillRGBA(r, g, b, a)
+	dc.SetStrokeRGBA(r, g, b, a)
+}
+
+// SetFillRGBA sets the fill color, along with process fill inks
+// approximating it.
+func (dc *Context) SetFillRGBA(r, g, b, a float64) {
+	dc.state.fill = rgbaPaint(r, g, b, a)
+}
+
+// SetFillStyle sets the fill pattern, whose colors are painted using process
+// inks approximating them.
+func (dc *Context) SetFillStyle(pattern context.Pattern) {
+	dc.state.fill = paint{pattern: pattern, alpha: 1}
+}
+
+// SetFillRule sets the fill rule.
+func (dc *Context) SetFillRule(fillRule context.FillRule) {
+	for _, plate := range dc.plates {
+		plate.SetFillRule(fillRule)
+	}
+}
+
+// SetStrokeRGBA sets the stroke color, along with process stroke inks
+// approximating it.
+func (dc *Context) SetStrokeRGBA(r, g, b, a float64) {
+	dc.state.stroke = rgbaPaint(r, g, b, a)
+}
+
+// SetStrokeStyle sets the stroke pattern, whose colors are painted using
+// process inks approximating them.
+func (dc *Context) SetStrokeStyle(pattern context.Pattern) {
+	dc.state.stroke = paint{pattern: pattern, alpha: 1}
+}
+
+//
+// Ink operations
+//
+
+// SetFillInks sets the inks painted by fill operations.
+func (dc *Context) SetFillInks(inks context.Inks) {
+	dc.state.fill = paint{inks: inks, alpha: dc.state.fill.alpha}
+}
+
+// SetStrokeInks sets the inks painted by stroke operations.
+func (dc *Context) SetStrokeInks(inks context.Inks) {
+	dc.state.stroke = paint{inks: inks, alpha: dc.state.stroke.alpha}
+}
+
+// SetFillOverprint sets whether fill operations leave the colorants which
+// they do not paint unchanged.
+func (dc *Context) SetFillOverprint(overprint bool) {
+	dc.state.fillOverprint = overprint
+}
+
+// SetStrokeOverprint sets whether stroke operations leave the colorants
+// which they do not paint unchanged.
+func (dc *Context) SetStrokeOverprint(overprint bool) {
+	dc.state.strokeOverprint = overprint
+}
+
+// SetOverprintMode sets the overprint mode.
+func (dc *Context) SetOverprintMode(mode int) {
+	dc.state.overprintMode = mode
+}
+
+//
+// Transparency operations
+//
+
+// SetFillAlpha sets the constant alpha used for fill operations.
+func (dc *Context) SetFillAlpha(alpha float64) {
+	for _, plate := range dc.plates {
+		plate.SetFillAlpha(alpha)
+	}
+}
+
+// SetStrokeAlpha sets the constant alpha used for stroke operations.
+func (dc *Context) SetStrokeAlpha(alpha float64) {
+	for _, plate := range dc.plates {
+		plate.SetStrokeAlpha(alpha)
+	}
+}
+
+// SetBlendMode sets the blend mode used for compositing painted objects
+// with their backdrop. The plates are blended as additive colors, which is
+// how subtractive colors are blended (see 11.3.3 "Blend Mode").
+func (dc *Context) SetBlendMode(mode context.BlendMode) {
+	for _, plate := range dc.plates {
+		plate.SetBlendMode(mode)
+	}
+}
+
+// SetSoftMask sets the soft mask, which modulates the alpha of painted
+// objects.
+func (dc *Context) SetSoftMask(mask *image.Alpha) {
+	for _, plate := range dc.plates {
+		plate.SetSoftMask(mask)
+	}
+}
+
+// BeginGroup starts a transparency group on all the plates.
+func (dc *Context) BeginGroup(isolated, knockout bool) {
+	for _, plate := range dc.plates {
+		plate.BeginGroup(isolated, knockout)
+	}
+}
+
+// EndGroup ends the most recent transparency group of all the plates.
+func (dc *Context) EndGroup() {
+	for _, plate := range dc.plates {
+		plate.EndGroup()
+	}
+}
+
+//
+// Text operations
+//
+
+// TextState returns the current text state.
+func (dc *Context) TextState() *context.TextState {
+	return dc.textState
+}
+
+//
+// Draw operations
+//
+
+// DrawRectangle draws the specified rectangle.
+func (dc *Context) DrawRectangle(x, y, w, h float64) {
+	for _, plate := range dc.plates {
+		plate.DrawRectangle(x, y, w, h)
+	}
+}
+
+// DrawImage draws the specified image at the specified point.
+func (dc *Context) DrawImage(img image.Image, x, y int) {
+	dc.DrawImageAnchored(img, x, y, 0, 0)
+}
+
+// DrawImageAnchored draws the specified image at the specified anchor point.
+// The colors of the image are painted using process inks approximating them,
+// and the other colorants are erased, unless the fill overprint is enabled.
+func (dc *Context) DrawImageAnchored(img image.Image, x, y int, ax, ay float64) {
+	for i, plate := range dc.plates {
+		if i >= len(processInks) && dc.state.fillOverprint {
+			continue
+		}
+		plate.DrawImageAnchored(plateImage(img, i), x, y, ax, ay)
+	}
+}
+
+// DrawInkImageAnchored draws the specified ink image at the specified anchor
+// point. The image paints its colorants, and erases the other colorants,
+// unless the fill overprint is enabled. Images painting no colorants leave
+// the plates unchanged.
+func (dc *Context) DrawInkImageAnchored(img *context.InkImage, x, y int, ax, ay float64) {
+	if len(img.Inks) == 0 {
+		return
+	}
+	for i, plate := range dc.plates {
+		if im, ok := dc.plateInkImage(img, i); ok {
+			plate.DrawImageAnchored(im, x, y, ax, ay)
+		}
+	}
+}
+
+//
+// Misc operations
+//
+
+// Height returns the height of the rendering area.
+func (dc *Context) Height() int {
+	return dc.plates[0].Height()
+}
+
+// Width returns the width of the rendering area.
+func (dc *Context) Width() int {
+	return dc.plates[0].Width()
+}
+
+// rgbaPaint returns the paint of the specified color, which is painted using
+// process inks approximating it.
+func rgbaPaint(r, g, b, a float64) paint {
+	c, m, y, k := color.RGBToCMYK(toByte(r), toByte(g), toByte(b))
+	return paint{
+		inks: context.Inks{Tints: map[string]float64{
+			context.InkCyan:    float64(c) / 255,
+			context.InkMagenta: float64(m) / 255,
+			context.InkYellow:  float64(y) / 255,
+			context.InkBlack:   float64(k) / 255,
+		}},
+		alpha: a,
+	}
+}
+
+// toByte converts the specified value in range 0-1 to a byte.
+func toByte(v float64) uint8 {
+	return uint8(clamp(v)*255 + 0.5)
+}
+
+// clamp limits the specified value to range 0-1.
+func clamp(v float64) float64 {
+	switch {
+	case v < 0:
+		return 0
+	case v > 1:
+		return 1
+	}
+	return v
+}
@@ -0,0 +1,156 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package inkrender
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/showntop/unipdf/render/internal/context"
+)
+
+// platePattern represents the plate of a process colorant of a pattern,
+// whose colors are approximated using process colorants.
+type platePattern struct {
+	pattern   context.Pattern
+	component int
+}
+
+// ColorAt returns the color of the plate at the specified point.
+func (p *platePattern) ColorAt(x, y int) color.Color {
+	return plateColor(p.pattern.ColorAt(x, y), p.component)
+}
+
+// plateImage returns the plate of the colorant having the specified index,
+// of an image whose colors are approximated using process colorants. The
+// plates of the other colorants are blank.
+func plateImage(img image.Image, i int) image.Image {
+	b := img.Bounds()
+	plate := image.NewNRGBA(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			var c color.NRGBA
+			if i < len(processInks) {
+				c = plateColor(img.At(x, y), i)
+			} else {
+				_, _, _, a := img.At(x, y).RGBA()
+				c = color.NRGBA{255, 255, 255, uint8(a >> 8)}
+			}
+			plate.SetNRGBA(x, y, c)
+		}
+	}
+	return plate
+}
+
+// plateInkImage returns the plate of the colorant having the specified index,
+// of the specified ink image. The returned bool is false if the plate is left
+// unchanged by the image.
+func (dc *Context) plateInkImage(img *context.InkImage, i int) (image.Image, bool) {
+	component := -1
+	for j, name := range img.Inks {
+		if name == dc.inks[i].Name {
+			component = j
+			break
+		}
+		if name == context.InkAll && component < 0 {
+			component = j
+		}
+	}
+
+	overprint := dc.state.fillOverprint
+	if component < 0 && overprint {
+		return nil, false
+	}
+	// In nonzero overprint mode, the samples of DeviceCMYK images having a
+	// tint of 0 leave their colorants unchanged.
+	keepZero := component >= 0 && overprint && img.CMYK && dc.state.overprintMode != 0
+
+	b := img.Tints[0].Bounds()
+	plate := image.NewNRGBA(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := color.NRGBA{255, 255, 255, 255}
+			if img.Alpha != nil {
+				c.A = img.Alpha.AlphaAt(x, y).A
+			}
+			if component >= 0 {
+				tint := img.Tints[component].GrayAt(x, y).Y
+				if keepZero && tint == 0 {
+					c.A = 0
+				}
+				c.R, c.G, c.B = 255-tint, 255-tint, 255-tint
+			}
+			plate.SetNRGBA(x, y, c)
+		}
+	}
+	return plate, true
+}
+
+// plateColor returns the color of the plate of the process colorant having
+// the specified component index, for the specified color. CMYK colors are
+// separated directly, while the other colors are converted to CMYK.
+func plateColor(c color.Color, component int) color.NRGBA {
+	var cmyk [4]uint8
+	alpha := uint8(255)
+	if cc, ok := c.(color.CMYK); ok {
+		cmyk = [4]uint8{cc.C, cc.M, cc.Y, cc.K}
+	} else {
+		nc := color.NRGBAModel.Convert(c).(color.NRGBA)
+		cmyk[0], cmyk[1], cmyk[2], cmyk[3] = color.RGBToCMYK(nc.R, nc.G, nc.B)
+		alpha = nc.A
+	}
+
+	v := 255 - cmyk[component]
+	return color.NRGBA{v, v, v, alpha}
+}
+
+// Plates returns the plates of the colorants rendered by the context, in the
+// order of the colorants returned by Inks. The plates are grayscale images
+// in which the colorants are painted black at full tint.
+func (dc *Context) Plates() []*image.Gray {
+	plates := make([]*image.Gray, len(dc.plates))
+	for i, plate := range dc.plates {
+		im := plate.Image().(*image.RGBA)
+		gray := image.NewGray(im.Bounds())
+		for j := range gray.Pix {
+			gray.Pix[j] = im.Pix[4*j]
+		}
+		plates[i] = gray
+	}
+	return plates
+}
+
+// Composite returns the composite of the plates rendered by the context,
+// which simulates the appearance of the printed colorants. The colorants
+// are combined multiplicatively, so that overprinted colorants are mixed.
+func (dc *Context) Composite() *image.RGBA {
+	plates := dc.Plates()
+	colors := make([][3]float64, len(dc.inks))
+	for i, ink := range dc.inks {
+		c := color.NRGBAModel.Convert(ink.Color).(color.NRGBA)
+		colors[i] = [3]float64{float64(c.R) / 255, float64(c.G) / 255, float64(c.B) / 255}
+	}
+
+	b := plates[0].Bounds()
+	composite := image.NewRGBA(b)
+	for j := range plates[0].Pix {
+		rgb := [3]float64{1, 1, 1}
+		for i, plate := range plates {
+			tint := 1 - float64(plate.Pix[j])/255
+			if tint == 0 {
+				continue
+			}
+			for k := range rgb {
+				rgb[k] *= 1 - tint*(1-colors[i][k])
+			}
+		}
+		composite.Pix[4*j] = toByte(rgb[0])
+		composite.Pix[4*j+1] = toByte(rgb[1])
+		composite.Pix[4*j+2] = toByte(rgb[2])
+		composite.Pix[4*j+3] = 255
+	}
+	return composite
+}
@@ -0,0 +1,780 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package svgrender
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/showntop/unipdf/common"
+	"github.com/showntop/unipdf/internal/transform"
+	"github.com/showntop/unipdf/render/internal/context"
+)
+
+// blendModes maps the blend modes to the values of the CSS mix-blend-mode
+// property, used for compositing SVG elements.
+var blendModes = map[context.BlendMode]string{
+	context.BlendModeMultiply:   "multiply",
+	context.BlendModeScreen:     "screen",
+	context.BlendModeOverlay:    "overlay",
+	context.BlendModeDarken:     "darken",
+	context.BlendModeLighten:    "lighten",
+	context.BlendModeColorDodge: "color-dodge",
+	context.BlendModeColorBurn:  "color-burn",
+	context.BlendModeHardLight:  "hard-light",
+	context.BlendModeSoftLight:  "soft-light",
+	context.BlendModeDifference: "difference",
+	context.BlendModeExclusion:  "exclusion",
+	context.BlendModeHue:        "hue",
+	context.BlendModeSaturation: "saturation",
+	context.BlendModeColor:      "color",
+	context.BlendModeLuminosity: "luminosity",
+}
+
+// document contains the SVG elements drawn by a context. It is shared by
+// the context states saved on the stack.
+type document struct {
+	buf    bytes.Buffer
+	nextID int
+
+	// masks contains the identifiers of the SVG masks created for the
+	// soft masks used by the context.
+	masks map[*image.Alpha]string
+}
+
+// newID returns a new element identifier, having the specified prefix.
+func (doc *document) newID(prefix string) string {
+	doc.nextID++
+	return prefix + strconv.Itoa(doc.nextID)
+}
+
+// paint represents the paint used for filling or stroking paths. The color
+// is used if the pattern is nil.
+type paint struct {
+	color   color.NRGBA
+	pattern context.Pattern
+}
+
+// group contains the graphics state parameters in effect when a
+// transparency group was started.
+type group struct {
+	fillAlpha   float64
+	strokeAlpha float64
+	blendMode   context.BlendMode
+	softMask    *image.Alpha
+}
+
+// Context represents an SVG rendering context. The drawing operations are
+// converted to SVG elements, expressed in device space. The patterns and
+// soft masks are rasterized at the resolution of the rendering area, which
+// is one pixel per device space unit.
+type Context struct {
+	width       int
+	height      int
+	doc         *document
+	fill        paint
+	stroke      paint
+	path        path
+	dashes      []float64
+	dashOffset  float64
+	lineWidth   float64
+	lineCap     context.LineCap
+	lineJoin    context.LineJoin
+	miterLimit  float64
+	fillRule    context.FillRule
+	matrix      transform.Matrix
+	clip        string
+	fillAlpha   float64
+	strokeAlpha float64
+	blendMode   context.BlendMode
+	softMask    *image.Alpha
+	groups      []*group
+	textState   *context.TextState
+	stack       []*Context
+}
+
+// NewContext returns a new context for rendering an SVG image, having a
+// rendering area of the specified width and height.
+func NewContext(width, height int) *Context {
+	return &Context{
+		width:       width,
+		height:      height,
+		doc:         &document{masks: map[*image.Alpha]string{}},
+		fill:        paint{color: color.NRGBA{A: 255}},
+		stroke:      paint{color: color.NRGBA{A: 255}},
+		lineWidth:   1,
+		lineCap:     context.LineCapButt,
+		lineJoin:    context.LineJoinMiter,
+		miterLimit:  10,
+		fillRule:    context.FillRuleWinding,
+		matrix:      transform.IdentityMatrix(),
+		fillAlpha:   1,
+		strokeAlpha: 1,
+		textState:   context.NewTextState(),
+	}
+}
+
+// Encode writes the SVG image drawn by the context to `w`. The SVG image
+// covers the rendering area of the context, and its displayed dimensions are
+// set to the specified width and height, expressed in points.
+func (dc *Context) Encode(w io.Writer, width, height float64) error {
+	_, err := fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" version="1.1" width="%spt" height="%spt" viewBox="0 0 %d %d">
+`, formatFloat(width), formatFloat(height), dc.width, dc.height)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(dc.doc.buf.Bytes()); err != nil {
+		return err
+	}
+
+	// Close the groups which have not been ended.
+	_, err = io.WriteString(w, strings.Repeat("</g>\n", len(dc.groups))+"</svg>\n")
+	return err
+}
+
+// Width returns the width of the rendering area.
+func (dc *Context) Width() int {
+	return dc.width
+}
+
+// Height returns the height of the rendering area.
+func (dc *Context) Height() int {
+	return dc.height
+}
+
+//
+// Line style operations
+//
+
+// SetDash sets the current dash pattern to use. Call with zero arguments to
+// disable dashes. The values specify the lengths of each dash, with
+// alternating on and off lengths.
+func (dc *Context) SetDash(dashes ...float64) {
+	dc.dashes = dashes
+}
+
+// SetDashOffset sets the initial offset into the dash pattern to use when
+// stroking dashed paths.
+func (dc *Context) SetDashOffset(offset float64) {
+	dc.dashOffset = offset
+}
+
+// LineWidth returns the line width of the context.
+func (dc *Context) LineWidth() float64 {
+	return dc.lineWidth
+}
+
+// SetLineWidth sets the line width of the context, expressed in user space
+// units.
+func (dc *Context) SetLineWidth(lineWidth float64) {
+	dc.lineWidth = lineWidth
+}
+
+// SetLineCap sets the line cap style.
+func (dc *Context) SetLineCap(lineCap context.LineCap) {
+	dc.lineCap = lineCap
+}
+
+// SetLineJoin sets the line join style.
+func (dc *Context) SetLineJoin(lineJoin context.LineJoin) {
+	dc.lineJoin = lineJoin
+}
+
+// SetMiterLimit sets the miter limit.
+func (dc *Context) SetMiterLimit(limit float64) {
+	dc.miterLimit = limit
+}
+
+// SetFillRule sets the fill rule.
+func (dc *Context) SetFillRule(fillRule context.FillRule) {
+	dc.fillRule = fillRule
+}
+
+//
+// Color setters
+//
+
+// SetFillStyle sets current fill style.
+func (dc *Context) SetFillStyle(pattern context.Pattern) {
+	dc.fill.pattern = pattern
+}
+
+// SetStrokeStyle sets current stroke style.
+func (dc *Context) SetStrokeStyle(pattern context.Pattern) {
+	dc.stroke.pattern = pattern
+}
+
+// SetStrokeRGBA sets the current color for stroking operations.
+// r, g, b, a values must be in range 0-1.
+func (dc *Context) SetStrokeRGBA(r, g, b, a float64) {
+	dc.stroke = paint{color: nrgba(r, g, b, a)}
+}
+
+// SetFillRGBA sets the current color for fill operations.
+// r, g, b, a values must be in range 0-1.
+func (dc *Context) SetFillRGBA(r, g, b, a float64) {
+	dc.fill = paint{color: nrgba(r, g, b, a)}
+}
+
+// SetRGBA sets the current color. r, g, b, a values should be between 0 and 1,
+// inclusive.
+func (dc *Context) SetRGBA(r, g, b, a float64) {
+	dc.SetFillRGBA(r, g, b, a)
+	dc.SetStrokeRGBA(r, g, b, a)
+}
+
+func nrgba(r, g, b, a float64) color.NRGBA {
+	c := func(v float64) uint8 {
+		return uint8(math.Round(math.Max(0, math.Min(1, v)) * 255))
+	}
+	return color.NRGBA{c(r), c(g), c(b), c(a)}
+}
+
+//
+// Path manipulation
+//
+
+// MoveTo starts a new subpath within the current path starting at the
+// specified point.
+func (dc *Context) MoveTo(x, y float64) {
+	x, y = dc.matrix.Transform(x, y)
+	p := transform.NewPoint(x, y)
+	dc.path.add('M', p)
+	dc.path.start = p
+	dc.path.hasCurrent = true
+}
+
+// LineTo adds a line segment to the current path starting at the current
+// point. If there is no current point, it is equivalent to MoveTo(x, y)
+func (dc *Context) LineTo(x, y float64) {
+	if !dc.path.hasCurrent {
+		dc.MoveTo(x, y)
+		return
+	}
+	x, y = dc.matrix.Transform(x, y)
+	dc.path.add('L', transform.NewPoint(x, y))
+}
+
+// QuadraticTo adds a quadratic bezier curve to the current path starting at
+// the current point. If there is no current point, it first performs
+// MoveTo(x1, y1)
+func (dc *Context) QuadraticTo(x1, y1, x2, y2 float64) {
+	if !dc.path.hasCurrent {
+		dc.MoveTo(x1, y1)
+	}
+	x1, y1 = dc.matrix.Transform(x1, y1)
+	x2, y2 = dc.matrix.Transform(x2, y2)
+	dc.path.add('Q', transform.NewPoint(x1, y1), transform.NewPoint(x2, y2))
+}
+
+// CubicTo adds a cubic bezier curve to the current path starting at the
+// current point. If there is no current point, it first performs
+// MoveTo(x1, y1).
+func (dc *Context) CubicTo(x1, y1, x2, y2, x3, y3 float64) {
+	if !dc.path.hasCurrent {
+		dc.MoveTo(x1, y1)
+	}
+	x1, y1 = dc.matrix.Transform(x1, y1)
+	x2, y2 = dc.matrix.Transform(x2, y2)
+	x3, y3 = dc.matrix.Transform(x3, y3)
+	dc.path.add('C', transform.NewPoint(x1, y1), transform.NewPoint(x2, y2), transform.NewPoint(x3, y3))
+}
+
+// ClosePath adds a line segment from the current point to the beginning
+// of the current subpath. If there is no current point, this is a no-op.
+func (dc *Context) ClosePath() {
+	if dc.path.hasCurrent {
+		dc.path.add('Z')
+		dc.path.current = dc.path.start
+	}
+}
+
+// ClearPath clears the current path. There is no current point after this
+// operation.
+func (dc *Context) ClearPath() {
+	dc.path = path{}
+}
+
+// NewSubPath starts a new subpath within the current path. There is no current
+// point after this operation.
+func (dc *Context) NewSubPath() {
+	dc.path.hasCurrent = false
+}
+
+// DrawRectangle draws a rectangle of size w,h at position x,y.
+func (dc *Context) DrawRectangle(x, y, w, h float64) {
+	dc.NewSubPath()
+	dc.MoveTo(x, y)
+	dc.LineTo(x+w, y)
+	dc.LineTo(x+w, y+h)
+	dc.LineTo(x, y+h)
+	dc.ClosePath()
+}
+
+//
+// Path drawing
+//
+
+// fillRuleAttr returns the SVG attribute specifying the fill rule used for
+// filling or clipping paths.
+func (dc *Context) fillRuleAttr(name string) string {
+	if dc.fillRule == context.FillRuleEvenOdd {
+		return fmt.Sprintf(` %s="evenodd"`, name)
+	}
+	return ""
+}
+
+// FillPreserve fills the current path with the current color. Open subpaths
+// are implicity closed. The path is preserved after this operation.
+func (dc *Context) FillPreserve() {
+	if dc.path.empty() {
+		return
+	}
+	d := dc.path.data(transform.IdentityMatrix())
+	rule := dc.fillRuleAttr("fill-rule")
+
+	if dc.fill.pattern != nil {
+		shape := fmt.Sprintf(`<path d="%s" fill="#fff"%s/>`, d, rule)
+		minX, minY, maxX, maxY := dc.path.bounds()
+		dc.paintPattern(dc.fill.pattern, shape, dc.fillAlpha, minX, minY, maxX, maxY)
+		return
+	}
+
+	attrs, ok := paintAttrs("fill", dc.fill.color, dc.fillAlpha)
+	if !ok {
+		return
+	}
+	dc.writeElement(fmt.Sprintf(`<path d="%s"%s%s/>`, d, attrs, rule))
+}
+
+// Fill fills the current path with the current color. Open subpaths
+// are implicity closed. The path is cleared after this operation.
+func (dc *Context) Fill() {
+	dc.FillPreserve()
+	dc.ClearPath()
+}
+
+// StrokePreserve strokes the current path with the current color, line width,
+// line cap, line join and dash settings. The path is preserved after this
+// operation.
+func (dc *Context) StrokePreserve() {
+	if dc.stroke.pattern != nil {
+		shape, ok := dc.strokeShape(` stroke="#fff"`)
+		if !ok {
+			return
+		}
+
+		// The painted area is extended by the maximum distance between the
+		// stroke outline and the path.
+		scale := math.Max(dc.matrix.ScalingFactorX(), dc.matrix.ScalingFactorY())
+		margin := math.Max(dc.lineWidth*scale, 1) / 2
+		if dc.lineJoin == context.LineJoinMiter {
+			margin *= math.Max(dc.miterLimit, 1)
+		} else if dc.lineCap == context.LineCapSquare {
+			margin *= math.Sqrt2
+		}
+		minX, minY, maxX, maxY := dc.path.bounds()
+		dc.paintPattern(dc.stroke.pattern, shape, dc.strokeAlpha,
+			minX-margin, minY-margin, maxX+margin, maxY+margin)
+		return
+	}
+
+	attrs, ok := paintAttrs("stroke", dc.stroke.color, dc.strokeAlpha)
+	if !ok {
+		return
+	}
+	if shape, ok := dc.strokeShape(attrs); ok {
+		dc.writeElement(shape)
+	}
+}
+
+// Stroke strokes the current path with the current color, line width,
+// line cap, line join and dash settings. The path is cleared after this
+// operation.
+func (dc *Context) Stroke() {
+	dc.StrokePreserve()
+	dc.ClearPath()
+}
+
+// strokeShape returns an SVG element representing the current path, stroked
+// using the current line style and the specified paint attributes.
+//
+// The line width and the dash lengths are expressed in user space, so the
+// element is drawn in a space which differs from user space by a uniform
+// scaling only, and transformed to device space. This way, the strokes are
+// distorted by non-uniform transformation matrices, as the pen is
+// (section 8.4.3.2 "Line Width" p. 125 PDF32000_2008).
+func (dc *Context) strokeShape(attrs string) (string, bool) {
+	m := dc.matrix
+	det := m[0]*m[4] - m[1]*m[3]
+	inverse, ok := m.Inverse()
+	if det == 0 || !ok || dc.path.empty() {
+		return "", false
+	}
+
+	// The stroke space is scaled so that its units are close to the size of
+	// the device pixels, which preserves the precision of the coordinates.
+	s := math.Sqrt(math.Abs(det))
+	toStroke := transform.ScaleMatrix(s, s).Mult(inverse)
+	toDevice := m.Mult(transform.ScaleMatrix(1/s, 1/s))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<path d="%s" transform="%s" fill="none"%s`,
+		dc.path.data(toStroke), formatMatrix(toDevice), attrs)
+
+	// Lines having a width of 0 are stroked using the thinnest line which
+	// can be rendered.
+	if width := dc.lineWidth * s; width > 0 {
+		if width != 1 {
+			fmt.Fprintf(&b, ` stroke-width="%s"`, formatFloat(width))
+		}
+	} else {
+		b.WriteString(` vector-effect="non-scaling-stroke"`)
+	}
+
+	switch dc.lineCap {
+	case context.LineCapRound:
+		b.WriteString(` stroke-linecap="round"`)
+	case context.LineCapSquare:
+		b.WriteString(` stroke-linecap="square"`)
+	}
+	switch dc.lineJoin {
+	case context.LineJoinRound:
+		b.WriteString(` stroke-linejoin="round"`)
+	case context.LineJoinBevel:
+		b.WriteString(` stroke-linejoin="bevel"`)
+	case context.LineJoinMiter:
+		fmt.Fprintf(&b, ` stroke-miterlimit="%s"`, formatFloat(math.Max(dc.miterLimit, 1)))
+	}
+
+	var dashes []float64
+	for _, dash := range dc.dashes {
+		if dash < 0 {
+			dashes = nil
+			break
+		}
+		if dash > 0 {
+			dashes = dc.dashes
+		}
+	}
+	if len(dashes) > 0 {
+		values := make([]string, len(dashes))
+		for i, dash := range dashes {
+			values[i] = formatFloat(dash * s)
+		}
+		fmt.Fprintf(&b, ` stroke-dasharray="%s"`, strings.Join(values, ","))
+		if dc.dashOffset != 0 {
+			fmt.Fprintf(&b, ` stroke-dashoffset="%s"`, formatFloat(dc.dashOffset*s))
+		}
+	}
+
+	b.WriteString("/>")
+	return b.String(), true
+}
+
+// paintAttrs returns the SVG attributes used for painting with the specified
+// color and constant alpha. The attributes are prefixed by `name`, which is
+// either fill or stroke. The returned flag is false if the paint is fully
+// transparent.
+func paintAttrs(name string, c color.NRGBA, alpha float64) (string, bool) {
+	opacity := float64(c.A) / 255 * alpha
+	if opacity <= 0 {
+		return "", false
+	}
+
+	attrs := fmt.Sprintf(` %s="#%02x%02x%02x"`, name, c.R, c.G, c.B)
+	if opacity < 1 {
+		attrs += fmt.Sprintf(` %s-opacity="%s"`, name, formatFloat(opacity))
+	}
+	return attrs, true
+}
+
+// paintPattern paints the specified pattern, using the specified constant
+// alpha, through the specified shape. The shape is an SVG element drawn in
+// white, expressed in device space. The pattern is rasterized in the region
+// of device space bounded by the specified coordinates.
+func (dc *Context) paintPattern(pattern context.Pattern, shape string, alpha float64,
+	minX, minY, maxX, maxY float64) {
+	r := image.Rect(
+		int(math.Floor(minX)), int(math.Floor(minY)),
+		int(math.Ceil(maxX)), int(math.Ceil(maxY)),
+	).Intersect(image.Rect(0, 0, dc.width, dc.height))
+	if r.Empty() || alpha <= 0 {
+		return
+	}
+
+	href, err := dataURI(rasterizePattern(pattern, r))
+	if err != nil {
+		common.Log.Debug("ERROR: could not encode pattern image: %v", err)
+		return
+	}
+
+	id := dc.doc.newID("p")
+	fmt.Fprintf(&dc.doc.buf, `<mask id="%s" maskUnits="userSpaceOnUse" x="0" y="0" width="%d" height="%d">%s</mask>`+"\n",
+		id, dc.width, dc.height, shape)
+
+	dc.writeElement(fmt.Sprintf(`<image x="%d" y="%d" width="%d" height="%d" mask="url(#%s)"%s xlink:href="%s"/>`,
+		r.Min.X, r.Min.Y, r.Dx(), r.Dy(), id, opacityAttr(alpha), href))
+}
+
+// opacityAttr returns the SVG attribute specifying the specified opacity.
+func opacityAttr(alpha float64) string {
+	if alpha >= 1 {
+		return ""
+	}
+	return fmt.Sprintf(` opacity="%s"`, formatFloat(alpha))
+}
+
+// ClipPreserve updates the clipping region by intersecting the current
+// clipping region with the current path as it would be filled by dc.Fill().
+// The path is preserved after this operation.
+func (dc *Context) ClipPreserve() {
+	id := dc.doc.newID("c")
+	var parent string
+	if dc.clip != "" {
+		parent = fmt.Sprintf(` clip-path="url(#%s)"`, dc.clip)
+	}
+	fmt.Fprintf(&dc.doc.buf, `<clipPath id="%s" clipPathUnits="userSpaceOnUse"%s><path d="%s"%s/></clipPath>`+"\n",
+		id, parent, dc.path.data(transform.IdentityMatrix()), dc.fillRuleAttr("clip-rule"))
+	dc.clip = id
+}
+
+// Clip updates the clipping region by intersecting the current
+// clipping region with the current path as it would be filled by dc.Fill().
+// The path is cleared after this operation.
+func (dc *Context) Clip() {
+	dc.ClipPreserve()
+	dc.ClearPath()
+}
+
+// ResetClip clears the clipping region.
+func (dc *Context) ResetClip() {
+	dc.clip = ""
+}
+
+//
+// Drawing operations
+//
+
+// DrawImage draws the specified image at the specified point.
+func (dc *Context) DrawImage(im image.Image, x, y int) {
+	dc.DrawImageAnchored(im, x, y, 0, 0)
+}
+
+// DrawImageAnchored draws the specified image at the specified anchor point.
+// The anchor point is x - w * ax, y - h * ay, where w, h is the size of the
+// image. Use ax=0.5, ay=0.5 to center the image at the specified point.
+func (dc *Context) DrawImageAnchored(im image.Image, x, y int, ax, ay float64) {
+	if dc.fillAlpha <= 0 {
+		return
+	}
+	s := im.Bounds().Size()
+	x -= int(ax * float64(s.X))
+	y -= int(ay * float64(s.Y))
+
+	href, err := dataURI(im)
+	if err != nil {
+		common.Log.Debug("ERROR: could not encode image: %v", err)
+		return
+	}
+
+	m := dc.matrix.Clone()
+	m.Translate(float64(x), float64(y))
+	dc.writeElement(fmt.Sprintf(`<image width="%d" height="%d" preserveAspectRatio="none" transform="%s"%s xlink:href="%s"/>`,
+		s.X, s.Y, formatMatrix(m), opacityAttr(dc.fillAlpha), href))
+}
+
+// writeElement writes the specified SVG element, which is clipped and
+// composited with its backdrop using the current graphics state.
+func (dc *Context) writeElement(el string) {
+	if attrs := dc.compositingAttrs(false); attrs != "" {
+		el = "<g" + attrs + ">" + el + "</g>"
+	}
+	dc.doc.buf.WriteString(el)
+	dc.doc.buf.WriteByte('\n')
+}
+
+// compositingAttrs returns the SVG attributes used for clipping and
+// compositing elements with their backdrop. If `isolated` is true, the
+// elements are isolated from their backdrop.
+func (dc *Context) compositingAttrs(isolated bool) string {
+	var b strings.Builder
+	if dc.clip != "" {
+		fmt.Fprintf(&b, ` clip-path="url(#%s)"`, dc.clip)
+	}
+	if dc.softMask != nil {
+		if id := dc.maskID(dc.softMask); id != "" {
+			fmt.Fprintf(&b, ` mask="url(#%s)"`, id)
+		}
+	}
+
+	var style []string
+	if mode, ok := blendModes[dc.blendMode]; ok {
+		style = append(style, "mix-blend-mode:"+mode)
+	}
+	if isolated {
+		style = append(style, "isolation:isolate")
+	}
+	if len(style) > 0 {
+		fmt.Fprintf(&b, ` style="%s"`, strings.Join(style, ";"))
+	}
+	return b.String()
+}
+
+// maskID returns the identifier of the SVG mask representing the specified
+// soft mask. The mask is written when it is first used.
+func (dc *Context) maskID(mask *image.Alpha) string {
+	if id, ok := dc.doc.masks[mask]; ok {
+		return id
+	}
+
+	href, err := dataURI(alphaToGray(mask))
+	if err != nil {
+		common.Log.Debug("ERROR: could not encode soft mask: %v", err)
+		return ""
+	}
+
+	id := dc.doc.newID("m")
+	b := mask.Bounds()
+	fmt.Fprintf(&dc.doc.buf, `<mask id="%s" maskUnits="userSpaceOnUse" x="%d" y="%d" width="%d" height="%d">`+
+		`<image x="%d" y="%d" width="%d" height="%d" xlink:href="%s"/></mask>`+"\n",
+		id, b.Min.X, b.Min.Y, b.Dx(), b.Dy(), b.Min.X, b.Min.Y, b.Dx(), b.Dy(), href)
+	dc.doc.masks[mask] = id
+	return id
+}
+
+//
+// Transparency operations
+//
+
+// SetFillAlpha sets the constant alpha used for fill operations.
+func (dc *Context) SetFillAlpha(alpha float64) {
+	dc.fillAlpha = alpha
+}
+
+// SetStrokeAlpha sets the constant alpha used for stroke operations.
+func (dc *Context) SetStrokeAlpha(alpha float64) {
+	dc.strokeAlpha = alpha
+}
+
+// SetBlendMode sets the blend mode used for compositing painted objects with
+// their backdrop.
+func (dc *Context) SetBlendMode(mode context.BlendMode) {
+	dc.blendMode = mode
+}
+
+// SetSoftMask sets the soft mask, which modulates the alpha of painted
+// objects. A nil mask removes the soft mask.
+func (dc *Context) SetSoftMask(mask *image.Alpha) {
+	dc.softMask = mask
+}
+
+// BeginGroup starts a transparency group, which is represented by an SVG
+// group element. Knockout groups are not supported by SVG, so their objects
+// are composited with each other.
+func (dc *Context) BeginGroup(isolated, knockout bool) {
+	dc.groups = append(dc.groups, &group{
+		fillAlpha:   dc.fillAlpha,
+		strokeAlpha: dc.strokeAlpha,
+		blendMode:   dc.blendMode,
+		softMask:    dc.softMask,
+	})
+
+	dc.doc.buf.WriteString("<g" + dc.compositingAttrs(isolated) + opacityAttr(dc.fillAlpha) + ">\n")
+
+	dc.fillAlpha = 1
+	dc.strokeAlpha = 1
+	dc.blendMode = context.BlendModeNormal
+	dc.softMask = nil
+}
+
+// EndGroup ends the most recent transparency group.
+func (dc *Context) EndGroup() {
+	if len(dc.groups) == 0 {
+		return
+	}
+	g := dc.groups[len(dc.groups)-1]
+	dc.groups = dc.groups[:len(dc.groups)-1]
+	dc.doc.buf.WriteString("</g>\n")
+
+	dc.fillAlpha = g.fillAlpha
+	dc.strokeAlpha = g.strokeAlpha
+	dc.blendMode = g.blendMode
+	dc.softMask = g.softMask
+}
+
+//
+// Text operations
+//
+
+// TextState returns the current text state.
+func (dc *Context) TextState() *context.TextState {
+	return dc.textState
+}
+
+//
+// Transformation matrix operations
+//
+
+// Matrix returns the current transformation matrix.
+func (dc *Context) Matrix() transform.Matrix {
+	return dc.matrix
+}
+
+// SetMatrix modifies the transformation matrix.
+func (dc *Context) SetMatrix(m transform.Matrix) {
+	dc.matrix = m
+}
+
+// Translate updates the current matrix with a translation.
+func (dc *Context) Translate(x, y float64) {
+	dc.matrix.Translate(x, y)
+}
+
+// Scale updates the current matrix with a scaling factor.
+// Scaling occurs about the origin.
+func (dc *Context) Scale(x, y float64) {
+	dc.matrix.Scale(x, y)
+}
+
+// Rotate updates the current matrix with a anticlockwise rotation.
+// Rotation occurs about the origin. Angle is specified in radians.
+func (dc *Context) Rotate(angle float64) {
+	dc.matrix.Rotate(angle)
+}
+
+//
+// Stack operations
+//
+
+// Push saves the current state of the context for later retrieval. These
+// can be nested.
+func (dc *Context) Push() {
+	x := *dc
+	dc.stack = append(dc.stack, &x)
+}
+
+// Pop restores the last saved context state from the stack.
+func (dc *Context) Pop() {
+	if len(dc.stack) == 0 {
+		return
+	}
+	before := *dc
+	x := dc.stack[len(dc.stack)-1]
+	*dc = *x
+	dc.path = before.path
+	dc.textState = before.textState
+
+	// The transparency groups are not part of the graphics state.
+	dc.groups = before.groups
+}
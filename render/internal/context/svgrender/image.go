@@ -0,0 +1,51 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package svgrender
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+
+	"github.com/showntop/unipdf/render/internal/context"
+)
+
+// dataURI returns a data URI containing the specified image, encoded as PNG.
+func dataURI(im image.Image) (string, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, im); err != nil {
+		return "", err
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// alphaToGray returns a grayscale image having the luminance of its pixels
+// equal to the alpha values of the pixels of the specified image. SVG masks
+// are defined using the luminance of their content.
+func alphaToGray(mask *image.Alpha) *image.Gray {
+	b := mask.Bounds()
+	gray := image.NewGray(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			gray.SetGray(x, y, color.Gray{Y: mask.AlphaAt(x, y).A})
+		}
+	}
+	return gray
+}
+
+// rasterizePattern returns an image containing the colors of the specified
+// pattern, in the specified region of device space.
+func rasterizePattern(pattern context.Pattern, r image.Rectangle) *image.RGBA {
+	im := image.NewRGBA(r)
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			im.Set(x, y, pattern.ColorAt(x, y))
+		}
+	}
+	return im
+}
@@ -0,0 +1,104 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package svgrender
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/showntop/unipdf/internal/transform"
+)
+
+// segment represents a segment of a path. The points of the segment are
+// expressed in device space.
+type segment struct {
+	// cmd is the SVG path command of the segment: M, L, Q, C or Z.
+	cmd    byte
+	points []transform.Point
+}
+
+// path represents a path constructed by a context.
+type path struct {
+	segments   []segment
+	start      transform.Point
+	current    transform.Point
+	hasCurrent bool
+}
+
+func (p *path) add(cmd byte, points ...transform.Point) {
+	p.segments = append(p.segments, segment{cmd: cmd, points: points})
+	if len(points) > 0 {
+		p.current = points[len(points)-1]
+	}
+}
+
+// empty returns true if the path does not contain any segments.
+func (p *path) empty() bool {
+	return len(p.segments) == 0
+}
+
+// data returns the SVG path data of the path, having its points transformed
+// by the specified matrix.
+func (p *path) data(m transform.Matrix) string {
+	var b strings.Builder
+	for i, seg := range p.segments {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteByte(seg.cmd)
+		for _, pt := range seg.points {
+			x, y := m.Transform(pt.X, pt.Y)
+			b.WriteByte(' ')
+			b.WriteString(formatFloat(x))
+			b.WriteByte(' ')
+			b.WriteString(formatFloat(y))
+		}
+	}
+	return b.String()
+}
+
+// bounds returns the bounding box, in device space, of the points of the
+// path. The control points of the curves are included, so the box contains
+// the curves as well.
+func (p *path) bounds() (minX, minY, maxX, maxY float64) {
+	minX, minY = math.Inf(1), math.Inf(1)
+	maxX, maxY = math.Inf(-1), math.Inf(-1)
+	for _, seg := range p.segments {
+		for _, pt := range seg.points {
+			minX, maxX = math.Min(minX, pt.X), math.Max(maxX, pt.X)
+			minY, maxY = math.Min(minY, pt.Y), math.Max(maxY, pt.Y)
+		}
+	}
+	return minX, minY, maxX, maxY
+}
+
+// formatFloat returns the shortest representation of the specified value,
+// rounded to two decimals.
+func formatFloat(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 2, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
+
+// formatMatrix returns the SVG transform representing the specified matrix.
+func formatMatrix(m transform.Matrix) string {
+	values := []float64{m[0], m[1], m[3], m[4], m[6], m[7]}
+	parts := make([]string, len(values))
+	for i, v := range values {
+		// The linear components of the matrix can be quite small, so they
+		// are formatted using significant digits.
+		if i < 4 {
+			parts[i] = strconv.FormatFloat(v, 'g', 6, 64)
+		} else {
+			parts[i] = formatFloat(v)
+		}
+	}
+	return "matrix(" + strings.Join(parts, " ") + ")"
+}
@@ -7,133 +7,350 @@ package context
 
 import (
 	"errors"
+	"strings"
+	"sync"
 
-	"github.com/golang/freetype/truetype"
-	"golang.org/x/image/font"
-
+	"github.com/showntop/unipdf/common"
 	"github.com/showntop/unipdf/core"
 	"github.com/showntop/unipdf/model"
 
 	"github.com/showntop/unipdf/internal/textencoding"
+	"github.com/showntop/unipdf/internal/transform"
+	"github.com/showntop/unipdf/render/internal/fontprog"
 )
 
 // TextFont represents a font used to draw text to a target, through a
-// rendering context.
+// rendering context. The glyphs are taken from the font program embedded in
+// the PDF font or, if there is none, from a bundled substitute font.
+// Text fonts are safe for concurrent use, so that they can be shared by the
+// pages of a document rendered in parallel: the font programs, including the
+// substitute fonts shared by several text fonts, are not modified once they
+// are parsed, and the glyph cache and the PDF font are guarded by a mutex.
 type TextFont struct {
 	Font *model.PdfFont
-	Face font.Face
 	Size float64
 
-	ttf      *truetype.Font
-	origFont *model.PdfFont
+	program    fontprog.Font
+	substitute bool
+	oblique    bool
+	symbolic   bool
+
+	// matrix and drawGlyph are set for Type 3 fonts, whose glyphs are
+	// painted by executing their glyph procedures.
+	matrix    transform.Matrix
+	drawGlyph DrawGlyphFunc
+
+	// glyphs caches the glyph outlines by character code. It is shared by
+	// all the instances derived from the same text font.
+	glyphs map[textencoding.CharCode]fontprog.Path
+
+	// mu guards the glyph cache and the PDF font, whose encoders are not
+	// safe for concurrent use. It is shared by all the instances derived
+	// from the same text font.
+	mu *sync.Mutex
 }
 
 // NewTextFont returns a new text font instance based on the specified PDF font
 // and the specified font size.
 func NewTextFont(font *model.PdfFont, size float64) (*TextFont, error) {
-	descriptor := font.FontDescriptor()
-	if descriptor == nil {
-		return nil, errors.New("could not get font descriptor")
+	tf := &TextFont{
+		Font:   font,
+		Size:   size,
+		glyphs: map[textencoding.CharCode]fontprog.Path{},
+		mu:     &sync.Mutex{},
 	}
 
-	fontStream, ok := core.GetStream(descriptor.FontFile2)
-	if !ok {
-		return nil, errors.New("missing font file stream")
-	}
+	descriptor, _ := font.GetFontDescriptor()
+	if descriptor != nil {
+		if flags, err := core.GetNumberAsInt64(descriptor.Flags); err == nil {
+			tf.symbolic = flags&fontFlagSymbolic != 0
+		}
 
-	fontData, err := core.DecodeStream(fontStream)
-	if err != nil {
-		return nil, err
+		program, err := loadFontProgram(descriptor)
+		if err != nil {
+			common.Log.Debug("ERROR: could not load font program of %s: %v", font.BaseFont(), err)
+		} else {
+			tf.program = program
+		}
 	}
 
-	ttfFont, err := truetype.Parse(fontData)
-	if err != nil {
-		return nil, err
+	if tf.program == nil {
+		program, oblique, err := loadSubstituteFont(font.BaseFont(), descriptor)
+		if err != nil {
+			return nil, err
+		}
+		common.Log.Debug("Substituting font %s", font.BaseFont())
+		tf.program = program
+		tf.substitute = true
+		tf.oblique = oblique
 	}
 
-	if size <= 1 {
-		size = 10
+	return tf, nil
+}
+
+// DrawGlyphFunc paints the glyph selected by character code `code` in a Type 3
+// font. The matrix `m` maps the glyph space of the font to user space.
+type DrawGlyphFunc func(code textencoding.CharCode, m transform.Matrix) error
+
+// NewType3TextFont returns a new text font instance based on the specified
+// Type 3 PDF font and the specified font size. The glyphs of the font are
+// painted by `drawGlyph`, which executes the glyph procedures of the font.
+func NewType3TextFont(font *model.PdfFont, size float64, drawGlyph DrawGlyphFunc) (*TextFont, error) {
+	fm, ok := font.Type3FontMatrix()
+	if !ok {
+		return nil, errors.New("not a Type 3 font")
 	}
 
 	return &TextFont{
-		Font: font,
-		Face: truetype.NewFace(ttfFont, &truetype.Options{Size: size}),
-		Size: size,
-		ttf:  ttfFont,
+		Font:      font,
+		Size:      size,
+		matrix:    transform.NewMatrix(fm[0], fm[1], fm[2], fm[3], fm[4], fm[5]),
+		drawGlyph: drawGlyph,
+		mu:        &sync.Mutex{},
 	}, nil
 }
 
-// NewTextFontFromPath returns a new text font instance based on the specified
-// font file and the specified font size.
-func NewTextFontFromPath(filePath string, size float64) (*TextFont, error) {
-	font, err := model.NewPdfFontFromTTFFile(filePath)
-	if err != nil {
-		return nil, err
+// loadFontProgram parses the font program embedded in the font described by
+// `descriptor`. A nil font program is returned if there is none.
+func loadFontProgram(descriptor *model.PdfFontDescriptor) (fontprog.Font, error) {
+	if stream, ok := core.GetStream(descriptor.FontFile2); ok {
+		data, err := core.DecodeStream(stream)
+		if err != nil {
+			return nil, err
+		}
+		return fontprog.ParseTrueType(data)
+	}
+
+	if stream, ok := core.GetStream(descriptor.FontFile3); ok {
+		data, err := core.DecodeStream(stream)
+		if err != nil {
+			return nil, err
+		}
+		subtype, _ := core.GetNameVal(stream.Get("Subtype"))
+		if subtype == "OpenType" || strings.HasPrefix(string(data), "OTTO") {
+			return fontprog.ParseTrueType(data)
+		}
+		return fontprog.ParseCFF(data)
 	}
 
-	return NewTextFont(font, size)
+	if stream, ok := core.GetStream(descriptor.FontFile); ok {
+		data, err := core.DecodeStream(stream)
+		if err != nil {
+			return nil, err
+		}
+		length1, _ := core.GetNumberAsInt64(stream.Get("Length1"))
+		length2, _ := core.GetNumberAsInt64(stream.Get("Length2"))
+		return fontprog.ParseType1(data, int(length1), int(length2))
+	}
+
+	return nil, nil
 }
 
 // WithSize returns a new text font instance based on the current text font,
 // with the specified font size.
-func (tf *TextFont) WithSize(size float64, originalFont *model.PdfFont) *TextFont {
-	if size <= 1 {
-		size = 10
-	}
-
-	return &TextFont{
-		Font:     tf.Font,
-		Face:     truetype.NewFace(tf.ttf, &truetype.Options{Size: size}),
-		Size:     size,
-		ttf:      tf.ttf,
-		origFont: originalFont,
-	}
+func (tf *TextFont) WithSize(size float64) *TextFont {
+	font := *tf
+	font.Size = size
+	return &font
 }
 
 // BytesToCharcodes converts the specified byte data to character codes, using
 // the encapsulated PDF font instance.
 func (tf *TextFont) BytesToCharcodes(data []byte) []textencoding.CharCode {
-	if tf.origFont != nil {
-		return tf.origFont.BytesToCharcodes(data)
-	}
-
+	tf.mu.Lock()
+	defer tf.mu.Unlock()
 	return tf.Font.BytesToCharcodes(data)
 }
 
 // CharcodesToUnicode converts the specified character codes to a slice of
 // runes, using the encapsulated PDF font instance.
 func (tf *TextFont) CharcodesToUnicode(charcodes []textencoding.CharCode) []rune {
-	if tf.origFont != nil {
-		return tf.origFont.CharcodesToUnicode(charcodes)
-	}
-
+	tf.mu.Lock()
+	defer tf.mu.Unlock()
 	return tf.Font.CharcodesToUnicode(charcodes)
 }
 
 // GetCharMetrics returns the metrics of the specified character code. The
 // character metrics are calculated by the internal PDF font.
 func (tf *TextFont) GetCharMetrics(code textencoding.CharCode) (float64, float64, bool) {
-	if metrics, ok := tf.Font.GetCharMetrics(code); ok && metrics.Wx != 0 {
+	tf.mu.Lock()
+	defer tf.mu.Unlock()
+
+	metrics, ok := tf.Font.GetCharMetrics(code)
+	if ok && metrics.Wx != 0 {
+		return metrics.Wx, metrics.Wy, true
+	}
+
+	// Fall back to the advance width of the glyph in the font program.
+	if tf.program == nil {
 		return metrics.Wx, metrics.Wy, ok
 	}
-	if tf.origFont == nil {
-		return 0, 0, false
+	if gid, ok := tf.glyphIndex(code); ok {
+		if w, ok := tf.glyphAdvance(gid); ok {
+			return w * 1000, 0, true
+		}
+	}
+	return metrics.Wx, metrics.Wy, ok
+}
+
+// Glyph returns the outline of the glyph selected by the specified character
+// code, in text space units for a font size of 1. The returned bool is false
+// if the font contains no glyph for the character code.
+func (tf *TextFont) Glyph(code textencoding.CharCode) (fontprog.Path, bool) {
+	if tf.program == nil {
+		return nil, false
+	}
+
+	tf.mu.Lock()
+	defer tf.mu.Unlock()
+	if path, ok := tf.glyphs[code]; ok {
+		return path, path != nil
+	}
+
+	var path fontprog.Path
+	if gid, ok := tf.glyphIndex(code); ok {
+		outline, err := tf.program.Outline(gid)
+		if err != nil {
+			common.Log.Debug("ERROR: could not load glyph %d of %s: %v", gid, tf.Font.BaseFont(), err)
+		} else {
+			path = outline.Transform(tf.glyphMatrix(code, gid))
+		}
 	}
 
-	metrics, ok := tf.origFont.GetCharMetrics(code)
-	return metrics.Wx, metrics.Wy, ok && metrics.Wx != 0
+	tf.glyphs[code] = path
+	return path, path != nil
 }
 
-// GetRuneMetrics returns the metrics of the specified rune. The character
-// metrics are calculated by the internal PDF font.
-func (tf *TextFont) GetRuneMetrics(r rune) (float64, float64, bool) {
-	if metrics, ok := tf.Font.GetRuneMetrics(r); ok && metrics.Wx != 0 {
-		return metrics.Wx, metrics.Wy, ok
+// glyphMatrix returns the matrix which maps the glyph space of the font
+// program to text space. Substituted glyphs are scaled horizontally, in order
+// to match the widths specified by the PDF font, and slanted if the substitute
+// font program has no italic style.
+func (tf *TextFont) glyphMatrix(code textencoding.CharCode, gid textencoding.GID) transform.Matrix {
+	m := tf.program.Matrix()
+	if !tf.substitute {
+		return m
+	}
+	if tf.oblique {
+		m = m.Mult(transform.NewMatrix(1, 0, obliqueSlant, 1, 0, 0))
+	}
+
+	metrics, ok := tf.Font.GetCharMetrics(code)
+	if !ok || metrics.Wx <= 0 {
+		return m
+	}
+	advance, ok := tf.glyphAdvance(gid)
+	if !ok || advance <= 0 {
+		return m
+	}
+	return m.Mult(transform.ScaleMatrix(metrics.Wx/1000/advance, 1))
+}
+
+// glyphAdvance returns the advance width of the glyph with the specified
+// index, in text space units for a font size of 1.
+func (tf *TextFont) glyphAdvance(gid textencoding.GID) (float64, bool) {
+	program, ok := tf.program.(interface {
+		Advance(gid textencoding.GID) (float64, bool)
+	})
+	if !ok {
+		return 0, false
+	}
+	w, ok := program.Advance(gid)
+	if !ok {
+		return 0, false
 	}
-	if tf.origFont == nil {
-		return 0, 0, false
+	m := tf.program.Matrix()
+	x, _ := m.Transform(w, 0)
+	x0, _ := m.Transform(0, 0)
+	return x - x0, true
+}
+
+// glyphIndex returns the index of the glyph selected by the specified
+// character code in the font program (see 9.6.6 "Character Encoding" and
+// 9.7.4.2 "Glyph Selection in CIDFonts").
+func (tf *TextFont) glyphIndex(code textencoding.CharCode) (textencoding.GID, bool) {
+	if tf.substitute {
+		return tf.substituteGlyphIndex(code)
+	}
+
+	subtype := tf.Font.Subtype()
+	if strings.HasPrefix(subtype, "Type0") {
+		cid, ok := tf.Font.CharcodeToCID(code)
+		if !ok {
+			cid = 0
+		}
+		if subtype == "Type0:CIDFontType2" {
+			return tf.Font.CIDToGID(cid)
+		}
+		if program, ok := tf.program.(interface {
+			GlyphByCID(cid textencoding.CharCode) (textencoding.GID, bool)
+		}); ok {
+			return program.GlyphByCID(cid)
+		}
+		return textencoding.GID(cid), true
 	}
 
-	metrics, ok := tf.origFont.GetRuneMetrics(r)
-	return metrics.Wx, metrics.Wy, ok && metrics.Wx != 0
+	if code > 0xff {
+		return 0, false
+	}
+	name, hasName := tf.Font.CharcodeToGlyphName(code)
+
+	if ttf, ok := tf.program.(*fontprog.TrueType); ok && subtype == "TrueType" {
+		// Symbolic TrueType fonts select glyphs directly by character
+		// code, through the (3,0) or (1,0) cmap subtables.
+		if hasName && (!tf.symbolic || !ttf.HasCMap(3, 0)) {
+			if gid, ok := ttf.GlyphByName(name); ok {
+				return gid, true
+			}
+		}
+		if gid, ok := ttf.GlyphByCode(byte(code)); ok {
+			return gid, true
+		}
+		if runes := tf.Font.CharcodesToUnicode([]textencoding.CharCode{code}); len(runes) == 1 {
+			if gid, ok := ttf.GlyphByRune(runes[0]); ok {
+				return gid, true
+			}
+		}
+		if int(code) < ttf.NumGlyphs() {
+			return textencoding.GID(code), true
+		}
+		return 0, false
+	}
+
+	if hasName {
+		if gid, ok := tf.program.GlyphByName(name); ok {
+			return gid, true
+		}
+	}
+	if gid, ok := tf.program.GlyphByCode(byte(code)); ok {
+		return gid, true
+	}
+	if runes := tf.Font.CharcodesToUnicode([]textencoding.CharCode{code}); len(runes) == 1 {
+		if name, ok := textencoding.RuneToGlyph(runes[0]); ok {
+			return tf.program.GlyphByName(name)
+		}
+	}
+	return 0, false
+}
+
+// substituteGlyphIndex returns the index of the glyph representing the
+// character code in the substitute font program, based on its Unicode value.
+func (tf *TextFont) substituteGlyphIndex(code textencoding.CharCode) (textencoding.GID, bool) {
+	ttf, ok := tf.program.(*fontprog.TrueType)
+	if !ok {
+		return 0, false
+	}
+
+	if name, ok := tf.Font.CharcodeToGlyphName(code); ok {
+		if r, ok := textencoding.GlyphToRune(name); ok {
+			if gid, ok := ttf.GlyphByRune(r); ok {
+				return gid, true
+			}
+		}
+	}
+
+	runes := tf.Font.CharcodesToUnicode([]textencoding.CharCode{code})
+	if len(runes) != 1 {
+		return 0, false
+	}
+	return ttf.GlyphByRune(runes[0])
 }
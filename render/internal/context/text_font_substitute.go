@@ -0,0 +1,189 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package context
+
+import (
+	"strings"
+	"sync"
+
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/gobolditalic"
+	"golang.org/x/image/font/gofont/goitalic"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/gomonobold"
+	"golang.org/x/image/font/gofont/gomonobolditalic"
+	"golang.org/x/image/font/gofont/gomonoitalic"
+	"golang.org/x/image/font/gofont/goregular"
+
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+	"github.com/showntop/unipdf/render/internal/dejavu"
+	"github.com/showntop/unipdf/render/internal/fontprog"
+)
+
+// Font descriptor flags (see 9.8.2 "Font Descriptor Flags").
+const (
+	fontFlagFixedPitch = 1 << 0
+	fontFlagSerif      = 1 << 1
+	fontFlagSymbolic   = 1 << 2
+	fontFlagItalic     = 1 << 6
+	fontFlagForceBold  = 1 << 18
+)
+
+// obliqueSlant is the horizontal shear applied to the glyphs of the
+// substitute fonts drawn in italic styles which have no italic font program.
+// It corresponds to a slant of about 12 degrees.
+const obliqueSlant = 0.21
+
+// substituteFamily identifies a family of bundled substitute fonts.
+type substituteFamily int
+
+const (
+	familySans substituteFamily = iota
+	familySerif
+	familyMono
+	familySymbol
+)
+
+// substituteStyle identifies one of the bundled substitute fonts.
+type substituteStyle struct {
+	family substituteFamily
+	bold   bool
+	italic bool
+}
+
+// substituteFont represents a bundled substitute font program.
+type substituteFont struct {
+	// load returns the data of the TrueType font program.
+	load func() ([]byte, error)
+
+	// oblique specifies whether the glyphs of the font program are slanted
+	// when they are drawn, for the italic styles which have no italic font.
+	oblique bool
+}
+
+// goFont returns a substitute font loading the specified Go font.
+func goFont(ttf []byte) substituteFont {
+	return substituteFont{load: func() ([]byte, error) {
+		return ttf, nil
+	}}
+}
+
+// substituteFonts contains the bundled font programs used for rendering text
+// drawn with fonts which have no embedded font program. The Go fonts are used
+// for the sans-serif (Helvetica) and the fixed pitch (Courier) standard 14
+// families. DejaVu Serif is used for the serif families (Times), with slanted
+// glyphs for the italic styles. DejaVu Sans is used for the Symbol and
+// ZapfDingbats fonts, as it has glyphs for the Greek letters, mathematical
+// symbols and dingbats which they contain.
+var substituteFonts = map[substituteStyle]substituteFont{
+	{}:                         goFont(goregular.TTF),
+	{bold: true}:               goFont(gobold.TTF),
+	{italic: true}:             goFont(goitalic.TTF),
+	{bold: true, italic: true}: goFont(gobolditalic.TTF),
+
+	{family: familyMono}:                           goFont(gomono.TTF),
+	{family: familyMono, bold: true}:               goFont(gomonobold.TTF),
+	{family: familyMono, italic: true}:             goFont(gomonoitalic.TTF),
+	{family: familyMono, bold: true, italic: true}: goFont(gomonobolditalic.TTF),
+
+	{family: familySerif}:                           {load: dejavu.Serif},
+	{family: familySerif, bold: true}:               {load: dejavu.SerifBold},
+	{family: familySerif, italic: true}:             {load: dejavu.Serif, oblique: true},
+	{family: familySerif, bold: true, italic: true}: {load: dejavu.SerifBold, oblique: true},
+
+	{family: familySymbol}: {load: dejavu.Sans},
+}
+
+var (
+	substituteMu    sync.Mutex
+	substituteCache = map[substituteStyle]*fontprog.TrueType{}
+)
+
+// loadSubstituteFont returns the bundled font program which best matches the
+// font with the specified name and descriptor. The descriptor may be nil.
+// The returned bool specifies whether the glyphs of the font program must be
+// slanted, in order to match an italic style.
+func loadSubstituteFont(name string, descriptor *model.PdfFontDescriptor) (*fontprog.TrueType, bool, error) {
+	style := substituteFontStyle(name, descriptor)
+	font := substituteFonts[style]
+
+	substituteMu.Lock()
+	defer substituteMu.Unlock()
+
+	if program, ok := substituteCache[style]; ok {
+		return program, font.oblique, nil
+	}
+	data, err := font.load()
+	if err != nil {
+		return nil, false, err
+	}
+	program, err := fontprog.ParseTrueType(data)
+	if err != nil {
+		return nil, false, err
+	}
+	substituteCache[style] = program
+	return program, font.oblique, nil
+}
+
+// substituteFontStyle returns the style of the substitute font for the font
+// with the specified name and descriptor, based on the name of the font and on
+// the descriptor flags.
+func substituteFontStyle(name string, descriptor *model.PdfFontDescriptor) substituteStyle {
+	// Remove subset tags (e.g. ABCDEF+Courier).
+	if len(name) > 7 && name[6] == '+' {
+		name = name[7:]
+	}
+	name = strings.ToLower(name)
+
+	containsAny := func(substrs ...string) bool {
+		for _, substr := range substrs {
+			if strings.Contains(name, substr) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// The Symbol and ZapfDingbats fonts are only available in a regular style.
+	if containsAny("symbol", "dingbats") {
+		return substituteStyle{family: familySymbol}
+	}
+
+	style := substituteStyle{
+		bold:   containsAny("bold", "black", "heavy", "semibold", "demi"),
+		italic: containsAny("italic", "oblique"),
+	}
+	switch {
+	case containsAny("courier", "mono"):
+		style.family = familyMono
+	case containsAny("sans", "helvetica", "arial"):
+	case containsAny("times", "serif", "roman", "georgia", "garamond", "bookman", "palatino",
+		"century", "cambria"):
+		style.family = familySerif
+	}
+	if descriptor == nil {
+		return style
+	}
+
+	if flags, err := core.GetNumberAsInt64(descriptor.Flags); err == nil {
+		switch {
+		case flags&fontFlagFixedPitch != 0:
+			style.family = familyMono
+		case flags&fontFlagSerif != 0 && style.family == familySans:
+			style.family = familySerif
+		}
+		style.bold = style.bold || flags&fontFlagForceBold != 0
+		style.italic = style.italic || flags&fontFlagItalic != 0
+	}
+	if weight, err := core.GetNumberAsFloat(descriptor.FontWeight); err == nil && weight >= 600 {
+		style.bold = true
+	}
+	if angle, err := core.GetNumberAsFloat(descriptor.ItalicAngle); err == nil && angle != 0 {
+		style.italic = true
+	}
+	return style
+}
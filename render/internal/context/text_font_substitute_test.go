@@ -0,0 +1,82 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package context
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/internal/textencoding"
+	"github.com/showntop/unipdf/model"
+)
+
+func TestSubstituteFontStyle(t *testing.T) {
+	descriptor := func(flags int64) *model.PdfFontDescriptor {
+		return &model.PdfFontDescriptor{Flags: core.MakeInteger(flags)}
+	}
+
+	testcases := []struct {
+		name       string
+		descriptor *model.PdfFontDescriptor
+		expected   substituteStyle
+	}{
+		{"Helvetica", nil, substituteStyle{}},
+		{"ABCDEF+Arial,BoldItalic", nil, substituteStyle{bold: true, italic: true}},
+		{"MicrosoftSansSerif", nil, substituteStyle{}},
+		{"Times-Roman", nil, substituteStyle{family: familySerif}},
+		{"Times-BoldItalic", nil, substituteStyle{family: familySerif, bold: true, italic: true}},
+		{"ABCDEF+Georgia", nil, substituteStyle{family: familySerif}},
+		{"Courier-Oblique", nil, substituteStyle{family: familyMono, italic: true}},
+		{"Symbol", nil, substituteStyle{family: familySymbol}},
+		{"ZapfDingbats", descriptor(fontFlagSymbolic | fontFlagItalic), substituteStyle{family: familySymbol}},
+		{"Custom", descriptor(fontFlagSerif), substituteStyle{family: familySerif}},
+		{"Custom", descriptor(fontFlagSerif | fontFlagFixedPitch), substituteStyle{family: familyMono}},
+		{"Custom", descriptor(fontFlagSerif | fontFlagForceBold), substituteStyle{family: familySerif, bold: true}},
+	}
+	for _, tc := range testcases {
+		require.Equal(t, tc.expected, substituteFontStyle(tc.name, tc.descriptor), tc.name)
+		require.NotNil(t, substituteFonts[tc.expected].load, tc.name)
+	}
+}
+
+func TestSubstituteFontGlyphs(t *testing.T) {
+	// glyph returns the outline of the glyph selected by `code` in the
+	// substitute font of the standard font `name`.
+	glyph := func(name model.StdFontName, code textencoding.CharCode) []float64 {
+		font, err := model.NewStandard14Font(name)
+		require.NoError(t, err)
+		tf, err := NewTextFont(font, 10)
+		require.NoError(t, err)
+		require.True(t, tf.substitute)
+
+		path, ok := tf.Glyph(code)
+		require.True(t, ok, "glyph %d of %s", code, name)
+		require.NotEmpty(t, path)
+		var points []float64
+		for _, seg := range path {
+			points = append(points, seg.Points[0].X, seg.Points[0].Y)
+		}
+		return points
+	}
+
+	// The Symbol and ZapfDingbats glyphs are available.
+	glyph(model.SymbolName, 'a')        // alpha
+	glyph(model.SymbolName, 0xe5)       // summation
+	glyph(model.ZapfDingbatsName, 0x21) // a1, U+2701
+	glyph(model.ZapfDingbatsName, 0x6c) // a71, U+25CF
+	glyph(model.HelveticaName, 'A')
+
+	// The serif italic glyphs are slanted versions of the upright glyphs.
+	roman := glyph(model.TimesRomanName, 'l')
+	italic := glyph(model.TimesItalicName, 'l')
+	require.Len(t, italic, len(roman))
+	for i := 0; i < len(roman); i += 2 {
+		require.InDelta(t, roman[i]+obliqueSlant*roman[i+1], italic[i], 1e-9)
+		require.InDelta(t, roman[i+1], italic[i+1], 1e-9)
+	}
+}
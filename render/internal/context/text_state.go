@@ -6,7 +6,30 @@
 package context
 
 import (
+	"github.com/showntop/unipdf/common"
+
+	"github.com/showntop/unipdf/internal/textencoding"
 	"github.com/showntop/unipdf/internal/transform"
+	"github.com/showntop/unipdf/render/internal/fontprog"
+)
+
+// TextRenderingMode specifies whether the glyphs of shown text are filled,
+// stroked, used as a clipping boundary, or some combination of the three.
+//
+// See section 9.3.6 "Text Rendering Mode" and
+// Table 106 (pp. 254-255 PDF32000_2008).
+type TextRenderingMode int
+
+// Text rendering modes.
+const (
+	TextRenderingModeFill TextRenderingMode = iota
+	TextRenderingModeStroke
+	TextRenderingModeFillStroke
+	TextRenderingModeInvisible
+	TextRenderingModeFillClip
+	TextRenderingModeStrokeClip
+	TextRenderingModeFillStrokeClip
+	TextRenderingModeClip
 )
 
 // TextState holds a representation of a PDF text state. The text state
@@ -14,14 +37,19 @@ import (
 // streams. It is used as a part of a renderding context in order to manipulate
 // and display text.
 type TextState struct {
-	Tc  float64          // Character spacing.
-	Tw  float64          // Word spacing.
-	Th  float64          // Horizontal scaling.
-	Tl  float64          // Leading.
-	Tf  *TextFont        // Font
-	Ts  float64          // Text rise.
-	Tm  transform.Matrix // Text matrix.
-	Tlm transform.Matrix // Text line matrix.
+	Tc  float64           // Character spacing.
+	Tw  float64           // Word spacing.
+	Th  float64           // Horizontal scaling.
+	Tl  float64           // Leading.
+	Tf  *TextFont         // Font
+	Tr  TextRenderingMode // Text rendering mode.
+	Ts  float64           // Text rise.
+	Tm  transform.Matrix  // Text matrix.
+	Tlm transform.Matrix  // Text line matrix.
+
+	// clipPaths contains the outlines, in user space, of the glyphs shown
+	// using the clipping text rendering modes in the current text object.
+	clipPaths []fontprog.Path
 }
 
 // NewTextState returns a new TextState instance.
@@ -38,7 +66,7 @@ func NewTextState() *TextState {
 // See section 9.4.2 "Text Positioning Operators" and
 // Table 108 (pp. 257-258 PDF32000_2008).
 func (ts *TextState) ProcTm(a, b, c, d, e, f float64) {
-	ts.Tm = transform.NewMatrix(a, b, c, d, e, -f)
+	ts.Tm = transform.NewMatrix(a, b, c, d, e, f)
 	ts.Tlm = ts.Tm.Clone()
 }
 
@@ -48,7 +76,7 @@ func (ts *TextState) ProcTm(a, b, c, d, e, f float64) {
 // See section 9.4.2 "Text Positioning Operators" and
 // Table 108 (pp. 257-258 PDF32000_2008).
 func (ts *TextState) ProcTd(tx, ty float64) {
-	ts.Tlm.Concat(transform.TranslationMatrix(tx, -ty))
+	ts.Tlm.Concat(transform.TranslationMatrix(tx, ty))
 	ts.Tm = ts.Tlm.Clone()
 }
 
@@ -76,45 +104,107 @@ func (ts *TextState) ProcTStar() {
 // See section 9.4.3 "Text Showing Operators" and
 // Table 209 (pp. 258-259 PDF32000_2008).
 func (ts *TextState) ProcTj(data []byte, ctx Context) {
+	if ts.Tf == nil {
+		return
+	}
+
 	tfs := ts.Tf.Size
 	th := ts.Th / 100.0
 	stateMatrix := transform.NewMatrix(tfs*th, 0, 0, tfs, 0, ts.Ts)
 
-	runes := ts.Tf.CharcodesToUnicode(ts.Tf.BytesToCharcodes(data))
-	for _, r := range runes {
-		if r == '\x00' {
-			continue
+	charcodes := ts.Tf.BytesToCharcodes(data)
+	singleByte := len(charcodes) == len(data)
+	for _, code := range charcodes {
+		// Calculate text rendering matrix and draw the glyph.
+		trm := ts.Tm.Mult(stateMatrix)
+		if ts.Tf.drawGlyph != nil {
+			ts.drawType3Glyph(code, trm)
+		} else if path, ok := ts.Tf.Glyph(code); ok {
+			path = path.Transform(trm)
+			appendGlyphPath(ctx, path)
+			if ts.Tr >= TextRenderingModeFillClip {
+				ts.clipPaths = append(ts.clipPaths, path)
+			}
 		}
 
-		// Calculate text rendering matrix.
-		tm := ts.Tm.Clone()
-		ts.Tm.Concat(stateMatrix)
-
-		// Draw rune.
-		x, y := ts.Tm.Transform(0, 0)
-		ctx.Scale(1, -1)
-		ctx.DrawString(string(r), x, y)
-		ctx.Scale(1, -1)
-
-		// Calculate word spacing.
+		// Calculate word spacing. It applies to single-byte character code
+		// 32 only.
 		tw := 0.0
-		if r == ' ' {
+		if code == ' ' && singleByte {
 			tw = ts.Tw
 		}
 
-		// Calculate rune spacing.
+		// Calculate glyph displacement.
 		var w float64
-		if wX, _, ok := ts.Tf.GetRuneMetrics(r); ok {
+		if wX, _, ok := ts.Tf.GetCharMetrics(code); ok {
 			w = wX * 0.001 * tfs
-		} else {
-			w, _ = ctx.MeasureString(string(r))
 		}
 
-		// Calculate displacement offset.
-		tx := (w + ts.Tc + tw) * th
-
 		// Generate new text matrix.
-		ts.Tm = transform.TranslationMatrix(tx, 0).Mult(tm)
+		ts.Translate((w+ts.Tc+tw)*th, 0)
+	}
+
+	if ts.Tf.drawGlyph == nil {
+		ts.paintGlyphs(ctx)
+	}
+}
+
+// drawType3Glyph paints the glyph procedure of a Type 3 font, selected by the
+// specified character code. The glyph procedures paint the glyphs themselves,
+// so only the invisible text rendering modes are taken into account.
+func (ts *TextState) drawType3Glyph(code textencoding.CharCode, trm transform.Matrix) {
+	if ts.Tr == TextRenderingModeInvisible || ts.Tr == TextRenderingModeClip {
+		return
+	}
+
+	// The glyph procedures may change the text state.
+	state := *ts
+	if err := ts.Tf.drawGlyph(code, trm.Mult(ts.Tf.matrix)); err != nil {
+		common.Log.Debug("ERROR: could not draw Type 3 glyph %d: %v", code, err)
+	}
+	*ts = state
+}
+
+// appendGlyphPath adds the specified glyph outline, in user space, to the
+// current path of the context.
+func appendGlyphPath(ctx Context, path fontprog.Path) {
+	started := false
+	for _, seg := range path {
+		p := seg.Points
+		switch seg.Op {
+		case fontprog.SegmentMoveTo:
+			if started {
+				ctx.ClosePath()
+			}
+			ctx.MoveTo(p[0].X, p[0].Y)
+			started = true
+		case fontprog.SegmentLineTo:
+			ctx.LineTo(p[0].X, p[0].Y)
+		case fontprog.SegmentQuadTo:
+			ctx.QuadraticTo(p[0].X, p[0].Y, p[1].X, p[1].Y)
+		case fontprog.SegmentCubeTo:
+			ctx.CubicTo(p[0].X, p[0].Y, p[1].X, p[1].Y, p[2].X, p[2].Y)
+		}
+	}
+	if started {
+		ctx.ClosePath()
+	}
+}
+
+// paintGlyphs paints the glyph outlines contained by the current path of the
+// context, according to the text rendering mode.
+func (ts *TextState) paintGlyphs(ctx Context) {
+	ctx.SetFillRule(FillRuleWinding)
+	switch ts.Tr {
+	case TextRenderingModeFill, TextRenderingModeFillClip:
+		ctx.Fill()
+	case TextRenderingModeStroke, TextRenderingModeStrokeClip:
+		ctx.Stroke()
+	case TextRenderingModeFillStroke, TextRenderingModeFillStrokeClip:
+		ctx.FillPreserve()
+		ctx.Stroke()
+	default:
+		ctx.ClearPath()
 	}
 }
 
@@ -140,6 +230,35 @@ func (ts *TextState) ProcDQ(data []byte, aw, ac float64, ctx Context) {
 	ts.ProcQ(data, ctx)
 }
 
+// ProcBT processes a `BT` operation, which begins a text object.
+//
+// See section 9.4.1 "General" and
+// Table 107 (p. 256 PDF32000_2008).
+func (ts *TextState) ProcBT() {
+	ts.Reset()
+	ts.clipPaths = nil
+}
+
+// ProcET processes an `ET` operation, which ends a text object. The glyphs
+// shown using the clipping text rendering modes are added to the clipping
+// path (see section 9.3.6 "Text Rendering Mode").
+//
+// See section 9.4.1 "General" and
+// Table 107 (p. 256 PDF32000_2008).
+func (ts *TextState) ProcET(ctx Context) {
+	ts.Reset()
+	if len(ts.clipPaths) == 0 {
+		return
+	}
+
+	for _, path := range ts.clipPaths {
+		appendGlyphPath(ctx, path)
+	}
+	ts.clipPaths = nil
+	ctx.SetFillRule(FillRuleWinding)
+	ctx.Clip()
+}
+
 // ProcTf processes a `Tf` operation which sets the font and its size.
 //
 // See section 9.3 "Text State Parameters and Operators" and
@@ -148,9 +267,10 @@ func (ts *TextState) ProcTf(font *TextFont) {
 	ts.Tf = font
 }
 
-// Translate translates the current text matrix with `tx`,`ty`.
+// Translate translates the current text matrix with `tx`,`ty`, expressed in
+// text space units.
 func (ts *TextState) Translate(tx, ty float64) {
-	ts.Tm = transform.TranslationMatrix(tx, ty).Mult(ts.Tm)
+	ts.Tm = ts.Tm.Mult(transform.TranslationMatrix(tx, ty))
 }
 
 // Reset resets both the text matrix and the line matrix.
@@ -0,0 +1,43 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package dejavu
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/base64"
+	"io/ioutil"
+	"strings"
+)
+
+// Serif returns the "DejaVu Serif" TrueType font program.
+func Serif() ([]byte, error) {
+	return decode(serifData)
+}
+
+// SerifBold returns the "DejaVu Serif Bold" TrueType font program.
+func SerifBold() ([]byte, error) {
+	return decode(serifBoldData)
+}
+
+// Sans returns the "DejaVu Sans" TrueType font program, which contains
+// glyphs for most of the Greek letters, mathematical symbols and dingbats
+// of the Symbol and ZapfDingbats fonts.
+func Sans() ([]byte, error) {
+	return decode(sansData)
+}
+
+// decode decompresses the specified font data, encoded using base64. The
+// line breaks of the encoded data are ignored.
+func decode(data string) ([]byte, error) {
+	compressed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(data))
+	if err != nil {
+		return nil, err
+	}
+	r := flate.NewReader(bytes.NewReader(compressed))
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
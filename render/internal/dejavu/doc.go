@@ -0,0 +1,15 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package dejavu provides the DejaVu Serif, DejaVu Serif Bold and DejaVu Sans
+// TrueType fonts (version 2.37), which are used as substitutes for the serif
+// and symbolic fonts which are not embedded in PDF documents. The font
+// programs are stored compressed and decompressed on demand.
+//
+// The fonts are distributed under the Bitstream Vera Fonts license, included
+// in the LICENSE file of this directory.
+//
+// See https://dejavu-fonts.github.io for details.
+package dejavu
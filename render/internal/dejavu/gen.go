@@ -0,0 +1,83 @@
+//go:build ignore
+// +build ignore
+
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// This program generates the compressed font data of the package from the
+// DejaVu font files in the directory specified by the -dir flag:
+//
+//	go run gen.go -dir /usr/share/fonts/truetype/dejavu
+package main
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+)
+
+// lineLength is the length of the lines of the encoded font data.
+const lineLength = 76
+
+var fonts = []struct {
+	file, name, variable, output string
+}{
+	{"DejaVuSerif.ttf", "DejaVu Serif", "serifData", "serif_data.go"},
+	{"DejaVuSerif-Bold.ttf", "DejaVu Serif Bold", "serifBoldData", "serif_bold_data.go"},
+	{"DejaVuSans.ttf", "DejaVu Sans", "sansData", "sans_data.go"},
+}
+
+func main() {
+	dir := flag.String("dir", "", "directory containing the DejaVu font files")
+	flag.Parse()
+	if *dir == "" {
+		log.Fatal("missing -dir")
+	}
+
+	for _, font := range fonts {
+		data, err := ioutil.ReadFile(filepath.Join(*dir, font.file))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		var compressed bytes.Buffer
+		w, err := flate.NewWriter(&compressed, flate.BestCompression)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if _, err := w.Write(data); err != nil {
+			log.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			log.Fatal(err)
+		}
+		encoded := base64.StdEncoding.EncodeToString(compressed.Bytes())
+
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "// Code generated by go run gen.go; DO NOT EDIT.\n\n")
+		fmt.Fprintf(&buf, "package dejavu\n\n")
+		fmt.Fprintf(&buf, "// %s is the %q TrueType font program,\n", font.variable, font.name)
+		fmt.Fprintf(&buf, "// compressed using DEFLATE and encoded using base64.\n")
+		fmt.Fprintf(&buf, "const %s = `\n", font.variable)
+		for len(encoded) > 0 {
+			n := lineLength
+			if n > len(encoded) {
+				n = len(encoded)
+			}
+			fmt.Fprintf(&buf, "%s\n", encoded[:n])
+			encoded = encoded[n:]
+		}
+		fmt.Fprintf(&buf, "`\n")
+
+		if err := ioutil.WriteFile(font.output, buf.Bytes(), 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,6863 @@
+// Code generated by go run gen.go; DO NOT EDIT.
+
+package dejavu
+
+// sansData is the "DejaVu Sans" TrueType font program,
+// compressed using DEFLATE and encoded using base64.
+const sansData = `
+7P0JfBTF1j8Of0/1OmtmpjuZIeuEhISdGHZEGBERWWNABARMANkFBET2XTaRTUBFxIgIiIiIiICI
+iIgL4EUB14iKqIgxRoyIkMy8n66eJJMAXr33Ps/vvv/PE+hvnzlVXV196tQ5p053z4AA6ASICLRr
+171zXtfEVIA6AYi/pe3N7RYXrHgToCEAW35LVtduPWYcbAwIJcDahbd0u73NG8cCvYB184HoU51v
+7N5+Y2azqcCuIYDcqmu3Bpm96o2vB+zbBKDHgHtyR5+3dKsHvA4gbumA8eP8GJrQHPhsKUDBQaMH
+33Nvo/HDgPylAJ4bnDt2NBQowBctAdgHj5g4SPHXDABftIc68+CQu3MH1jh4ehEsd9kBNBky5O5c
+x5NJh2G5qy2A1CH3jJtQY93n38Ny1xAgd9Xwu8eMtD+mXg/LGwBal4wYNSA31597BFaUALm77smd
+MDr+M/leWPvvAOAfmXvP3UmjOj8Na/8TwA3q6FFjx91afVV3WJ/MB84PHD3m7tHXK78AtuUBQBoC
+gADgy5++vQ8AvspYc0f5ngDYAZbG6oOhFwS2DcReZG9BYIfYIRB7m70HgR1mR0DsKPsAAvuQHQex
+E+wMBPYt+wHEzrFfIbBiVgxiv7HfILAL7AKI/c5CEAQIAAkkiBAESZBAgizIEARFUECCKlggCFbB
+ChJsgh2C4BC9INEnxkMQEyQHSHJKTjApSnKBJLdUDYIUK8WBpHgpEYKUJCWBJL/khyAlSzVAUpqU
+BkFKlzqCpE5SJwhSZ6krSMqSuoNJt0tjQdI4aQYEaaa0FCQtk5ZBkJbLGkjW5XgIcoLcGiQH5AAE
++Ua5DUi+Se4AQe4o3waSs+U7IMg91d9B6kW1FIIatCWCbEm2JDCb31YLZKttqwtmq2ebDrLNsM0A
+s820LQPZltsehWB7zPYYmG217QmQba3tSTBbnu0VkG2XbTeYbY/tQ5DtuO04mO2Etg2kvai9Bqbt
+0/ZB0F7XXgfT9ms/gLRz2jkw7Ud9MEgfog+BoA/Vh4L0YfowCPpw/R4wfaQ+EqSP0ieC6ZP0QpD+
+s34BTP/dmwzyVvfWheCt550B8s70zofgXeA9DfJ+4/0GgveMbwQIIgDGNxd7sVwrGDvKPgZjn7BP
+ILBP2acQ2WfsDBg7x34CY4XsNxDXBMZ+Z7+D2EV2CYxdZqUgFmQhMIEEBhIEQQATREEEAXyzUj7r
+A71tu07dMSl3TG5/zM0dc89IbOo/JncodgzIHTkW+wcMuXsMjgyYOGYEPhl896gxKB485u7hhCG5
+I4eSa8jd/cdQ/PDckblUe0TuKD81GZE7biS1uyd33BCaO3L4KD8tHzU49x7KG3PfyDG0ZdygkYNp
+17ghuUPpEEQACIVAAGIgdLw924+Ybl3a+hFze7aBvFQAwCBXqv1XaBdY545t/XB1y27jh6u8LQHK
+VY/5szJzXK51lAgVjaDe2q2zH406cuzEsXPXTn406sLp7K4GduvYzUDOCR9P/8JZq9aSKpVYDGuH
+Zhxb3ZM7ZjjacezOsQ/HgfcMv2c4hnEcw3ECAEIUAAYn30uQIEOBCgvn2uHgeytsACj8CWF9MvWX
+QYAL1VEbmWiBNuiE7uiDgRiBcZiCOViEtdgAGQTCovD+FGQAxDaE95/w0WbCILkdJBDIWgLR2NsH
+mfvklia/egvzc8pFfnZqttncN/8QEgC6fhZEiJBvaHQTu2nWLf6eR8b4zR6P7WfuH7eDkIE0EFrA
+DgEqnNBBDEE7xxMGlv4KgiCNlyZK0yIoAYATgA4gFsQAVEZ8hq0gkmGHjtrIQBO0RBu0Rxd0R2/k
+YBBGYAwmYBrmYCGWYhXWgBiUFgbK6ytoKb+CVtSq/IqaFJTHVJSqJQZaDlbQaqMIDqeVY5yTA4Iu
+dZLGSfdJ90sTpEnSZGmKNFWaLs2Qlnlnemd5Z3vneB/wzvXO887/W3V1ANkAegLoB2AggGEARgMY
+D2AKgFkA5gNYDGAFgNUA8gBsALAFwHYAuwDsA8D7Lm77f4GIRwwIqbCDIIJKR5R+BQLJHUBg8i1y
+ezAAVgAuUOkmoAzRBa1A6Ak7ZK5hsfAjDXVBpXPkC0ZLyv1VcJAysqwUshyQb5LbyjfL7eRbwcLe
+EZC55gF+AGkA6gLIBNDMaEXM4TgtAnMq09Sb0kC0Anak/BOtXIdN2Iod2IP9OIQj+BCf4BTO4ByK
+cAElxEglJ+mgIEpPVWAwugJLP6vKCeOCqhiuyVsLjotAs34Sx+s5+iL4za7A1yNaM45iQY3TNo7O
+P0UtfCyDyj0xeGQmshPsNCzc+/q4r43nXjaJ+9dkHnVVB4OlyjEfw8I+Y6fh4EfG8CNj+ZEJ/Mgk
+fqQfyQDmAlgEYDmARwGsBbAewGYA2wDsBLAXwAEA7wB4H8AJAJ8B+ArAdwAKAJwHcBFAECARICtA
+LoBiAIoHqDpANQGqD1AjgFoAFACoHSgI4L8K2b9yFGtDp0BsMexogfGYglmYj8VYgdXIwwZswXbs
+wj4cxHs4ho+Qj9M4i0IU4xKBZLKTh3yUSKlUmzKoCbWkNtSeulB36k05NIhG0BiaQNNoDi2kpbSK
+1tA62kRbaQftof10iI6AgpCHXIE3cNQjsO0VnLcMVMQrjt0UgZyj9Iho4eGIFq44o3IsombbivYj
+Uf6sjAaUkVfDKv3Ur3FG/a+idPEv4OdVOXJiOE4+ALBCVggPK2K/QuMrIy+PhH08Bq7Go994Hvcm
+8nVQEl8BJfM1RQpfKdTyVvdWR4Y31ZuK67xp3rrI5JF5czDY/+45eLtJvN0aPO6vy9tqimYATgM4
+C6AQQDGASwABIBkgO0AegHwAJQKUClBtgDIAagJQS4DaANQeoC4AdQeoN0A5AA0CaARAYwCaANA0
+gOYAtBCgpQCtAmgNQOsA2gTQVoB2ALQHoP0AHQLoCEAfAvQJQKcAOgPQOYCKALoAUAnAGMBUgDkB
+pgMsFmB+gKUBrC4oCHHyFbjNQIn9GV7lqGvgX2ph2189i8Sq0n9+1J9fxb92RX+G2IWtPIq0Q4UH
+qWiC9uiNEZiGpWCQYQcJA0JrQHgnNOw/wyn97J9zrtGOueYFCFGSW/JImqRL0VKM5JV8UjUjjyCl
+hSPnKACtALQF0AFAFoAeAPoA6A9gCICRAMYBmARgRiWvSGwJ8B/BKyJ23acdAuktYWf3i0XiBbFE
+YpIqOSVdipX8UppUV8qUmkmtpLZSBylL6iH1kfpLQ6SR0jhpkjRDmistkpZLj0prpfXSZmmbtFPa
+Kx2Q3pHel05In0lfSd9JBdJ56aIUlEXZKrvkGDleri7XlOvLjeQWckBuJ3eSs+Wecj95oDxMHi2P
+l6fIs+T58mJ5hbxazpM3yFvk7fIueZ98UH5PPiZ/JOfLp+WzcqFcLF9SoMiKXfEoPiVRSVVqKxlK
+E6Wl0kZpr3RRuiu9lRxlkDJCGaNMUKYpc5SFylJllbJGWadsUrYqO5Q9yn7lkHJE+VD5RDmlnFHO
+KUXKBaVEZaqqOlVdjVX9appaV81Um6mt1LZqBzVL7aH2UfurQ9SR6jh1kjpDnasuUperj6pr1fXq
+ZnWbulPdqx5Q31HfV0+on6lfqd+pBep59aIatIgWq8VlibHEW6pbalrqWxpZWlgClnaWTpZsS09L
+P8tAyzDLaMt4yxTLLMt8y2LLCstqS55lg2WLZbtll2Wf5aDlPcsxy0eWfMtpy1lLoaXYcskKq2y1
+Wz1WnzXRmmqtbc2wNrG2tLaxtrd2sXa39rbmWAdZR1jHWCdYp1nnWBdal1pXWddY11k3Wbdad1j3
+WPdbD1mPWD+0fmI9ZT1jPWctsl6wltiYTbU5bbot1ua3pdnq2jJtzWytbG1tHWxZth62Prb+tiG2
+kbZxtkm2Gba5tkWG5betta23bbZts+207bUdsL1je992wvaZ7Svbd7YC23nbRVvQLtqtdpc9xh5v
+r26vaa9vb2RvYQ/Y29k72bPtPe397APtw+yj7ePtU+yz7PPti+0r7KvtefYN9i327fZd9n32g/b3
+7MfsH9nz7aftZ+2F9mL7JQccssPu8Dh8jkRHqqO2I8PRxNHS0cbR3tHF0d3R25HjGOQY4RjjmOCY
+5pjjWOhY6ljlWONY59jk2OrY4djj2O845Dji+NDxieOU44zjnKPIccFR4mRO1el06s5Yp9+Z5qzr
+zHQ2c7ZytnV2cGY5ezj7OPs7hzhHOsc5JzlnOOc6FzmXOx91rnWud252bnPudO51HnC+43zfecL5
+mfMr53fOAud550VnMEqMska5omKi4qOqR9WMqh/VKKpFVCCqXVSnqOyonlH9ogZGDYsaHTU+akrU
+rKj5UYujVkStjsqL2hC1JWp71K6ofVEHo96LOhb1UVR+1Omos1GFUcVRl1xwyS67y+PyuRJdqa7a
+rgxXE1dLVxtXe1cXV3dXb1eOa5BrhGuMa4JrmmuOa6FrqWuVa41rnWuTa6trh2uPa7/rkOuI60PX
+J65TrjOuc64i1wVXiZu5VbfTrbtj3X53mruuO9PdzN3K3dbdwZ3l7uHu4+7vHuIe6R7nnuSe4Z7r
+XuRe7n7Uvda93r3Zvc29073XfcD9jvt99wn3Z+6v3N+5C9zn3RfdQY/osXpcnhhPvKe6p6anvqeR
+p4Un4Gnn6eTJ9vT09PMM9AzzjPaM90zxzPLM9yz2rPCs9uR5Nni2eLZ7dnn2eQ563vMc83zkyfec
+9pz1FHqKPZc0aLJm1zyaT0vUUrXaWobWRGuptdHaa1207lpvLUcbpI3QxmgTtGnaHG2htlRbpa3R
+1mmbtK3aDhA7BYDY7QbS55y+yPE5zoniWLeCw94wEN9y/tOck8zpAk7v4hg0EM04/1kDBRfHKaFL
+ILaa17nNQPCaLJ3jQY6M13RyeqSBdPoKTAitKcfioN2gjfUe2xscXcYX5nC6ODTMoCOwbul4jpvK
+aDoQGlaOZmscWc/STVdgWxC7MzSsHD8u3VSGVJef1x9qZvSk9LMKOtgKhCJeszDYqqxX4bPwPofr
+8x5G0mXIezsn2K8qJ4Ku+1ex/Er7cRxdjib/1+C6KjK5QjJ/Ha8qwwqMkKEpK2FncHQ5hjml48to
+UzLlsh12BTarwNLxEfwINFsubVvGMUenEhaWbAUJSmnbsvEyUVBKPeW0PzSsXJ7XuvbwyHI8WbK6
+fNxjABC71UAy51Q/jk9EYE4FLSwHyo8azrEB50sR8+skp7/gdEpEOzM4x5xTsRzvrqhplrJeEaUc
+WVwFP1wa1jcDqU7pZ2X49/kVWk11QmvK8G/zI8balCqTQ8P+hB+h/6xn0F6GlfkhuQz/Wv0KfnjW
+XGvu/OXZFKmrVLcCr80vbVuGtLR0fBleq36ZPpduqkxXka1xbHakrSjdVEW2EW3+bZvA54KJkboR
+2YfIuUN1guvKkdvYSMRbJh20l3GuhsETFXiNOsXIrOJZrvQyZb5mUxlW7mdF/yOPNT2RiZWuJewF
+qnqov6T/lfVwXRlW0ec1ZXgt/t/Vz0rWe2FoWDn+FX5aaFg5Xosf4VupWwVek7+wwv+Gr6gwtOba
+/GvZh3LPsulP7EnkPIrwF9QttKYMTS9vYqXrusZci9SByLl2TT8S4QEjdelaFrLKNT5WmVNZ03g8
+UCd4oAyvpXVX6tVf40RYy8pXV0WGV5n14fq8PwlAZWS3VkV6riqyflfgE38Bc67AK+oIy6sixVRF
+NvwKbFAVBakq0rNVUThZFemLqiikVMWrXNeMqiiwKzD2Cry7Kl559itbjowiWK9rtBx7jQgk7urR
+yLXwmjHtX4hgTVsknA2OLsMyTulnZXhNTjiqrDjqSptWyWpdaZd4xBsZRVyNUzq+wj6UbrrajL6S
+E44B1lfgVaOLZlfEFWYssakMr3YU7+HS4LoyvLLOVWd3VQ6Pt8MxwK0GCkrJyir2TSkZUNk6ldnS
+8viBwSGlS20BKUsai0RpibQUbaSHpa/RVroga3hY+1HvgRf14XohJXgbem+gp7ytvVm0xTvCO472
+ecd7x9PbxhM69I63yNeWVQfB9ecZTTkOLgBtALQH0AVAdwC9AeQAGARgBIAxACYAmAZgDoCFAJYC
+WAVgTXlW8n8gr8nMZ0OKcQiES7DD+xefRii770tsABaB6AOWAcJ5A2mLgewxA9FMiANB55yXDYRs
+cGg6r3kvuwFEQ3npSQNxltPPcfyErgMJ/Fjy0FEQG8TpMwYKw4xj2SGDRpYQBwZFSpfqAVIDqSEU
+qbHUGHapqdQMDqmFdD2ipBukG+HWXtNeQwwIJCWAAMjlfTWz6mf/PKsujwFhlaL+Bc6Zf86R8v9l
+TkVWPUbyX+upC23fFc9dxPxt/QPWAdgEYCuAHQD2ANgP4BCAIwA+BPCJoWVizn/w2Yp/qTWtk6cQ
+pC2CnfUR94j7xUPiEfFD8RPxlHhGPPd/ufv/y93/X+7+/3L3/925exDL5ravGsduBlKCstZA4+k8
+OiCeNmjDG1CxpJfRwhzhLC/txOlsAw2aLRTOViA/dimvudSg2V5WaCAv7Sn4r4p3ymPKkA6I0wyU
+JoCoLre8e42zULGBbC9/9nCh0Su2UNhZdl46YPSHillhZQz3udjss3i6rM8mv+wqhLMVWFHKFspO
+fq78qtdYlUMH5EDFVUdwDkRI44BxFB2QNpfRbK9xjWyveY2s8OrIz7JXPF2O15JhhCSpWB5TJrc/
+RyqORGlCZRSaGxIQmitqmeSvrHMlinvVRhE4KGK8uMTM65LuKufw6xKyTV0yaCo2UWrHcXMFhxVW
+oc1xj5GKjLW3gfQcp/txfCICcypo+orTGqdHSkXlLWzk9LMGCoc5/QWnU8LHnjdWlQYKjPNjOX9F
+RU2zlPWqKA3XiZOKyvjh0gitozoV+Pf5Yd02RrCOpJfhn/BPlyHVkTaXYWWtMLSXyX/Kj5wRPSPw
+P8SvPJvE0xUo5VegHCjDSnMkPLOqaiYuyc4yrMJfX4Z/hU+aMqIMw/q8kC0s1+q94t2VZWvqtpAt
++CvmmuAvm1kmkqasLccyzW9Xoe0mSpsrMJLP+9CTFZZpxVWwrE6PKvy68voKNJ7gxlvitjLbS3Wl
+/ApUWlRgZB1FjcCKOtxTUIJyrALVR8sxckxNXxPhcVhPMZZLr105RtS51lwwz8jSIj2UgVRHjC3D
+a82Lv6+34uly7ClOK8NKertUPF2GV7PqV/jEhcKccozgC9lhC1nV/qdFYGX+2XKM9K3dKvBa/LD/
+LRbOltOFJm3qf4R3LhSnlVmGMvtgjBSTxW0Rep5dmR/24Bwj52OZj5D08p5wLPfy2fyKKjDCm0SO
+Lx2oqBM5166MDcJz4Rpz50rtqhSHyMLOiquL4FRu4QrdM0sjtY5fe09pczma0UVPaUI5XoVTVesi
+tcv0vNStAtles2/itjIMRx2mlBKkosrIbq2K9FxVZP2uwCf+AuZcgVfUoa+qItOqIo28AmOqIttY
+FenZqigcror0RVUUUqpiOBKIxBlVMRwhRGJsVWQrquKVZ7+y5cgogvW6estC7DUikLirRyPXwqvF
+tJER7J/Gq2W2K7YMr2XlrmLfrmLZxNPleKXVCntnThdKE642W6+0Udee0ZF2zORURD7muTChAq+M
+LszImTR5fRleafGuFWNcySFNHVSGV85uM8ambhV4NSta1U5ehbO3isVjiJJqSjUBKVPKBEnXS9eD
+8ey2wLPb6Ty7XYtntyfw7PYqnt1mPLs9l2e3l/Hs9tM8u72FZ7ef59ltBYTEa2cWZV2OlmNkr+yT
+q8mxcvxVMo2JV31K93/j3ZX/dCYS4uS/hf/S2UnGBRC5YEfC38jAV33ziqS+AEiawHGmgUJ+MAEk
+ySY/FABJMzh9J8fqHGtxfitO38wREXjBQNmsOS0C+d1CaUbEGTM5RnHM40c9xGlXRR21RTkyMDlF
+vgGQ+8sDEAeCYLwvJ3eo+hYljL9KSNVJBlEr2JGEANqhE7LRE/0wEMMw+m+910NSX3G40TtxoiEz
+A4V8Tsvi6nL+DHGSITNxkCEz8X5DZuIQQ2a89GaOMEZegpRlyEy805CZVM2QlkFL06RxhszEnPLW
+zJYzDa2Rojg/T1xhyEycb8hMvI/XWQoCxA5lqLYQ54eRQQzL7zb5NsTJOXIO4rksE0CwR74fKGfL
+3eTu8u1yD/kO2MvfCPyrT9wTIJrY/xpoluZwnBaBOVVoPx/P3mVvSxob2xDMBoHw7DXfkTsDxz95
+uy7lL78pCrwH4BiAjwDk/4+8gUNBfm+tDCPfQQsFq5b+L2HFedm/0oK0TXwfJO2CHY9SJjWjVtSW
+OlAW9aA+1J+G0EgaR5NoBs2lRbScHqW1tJ420zbaSXvpAL1D79MJ+oy+ou+ogM7TRQoykVmZi8Ww
+eFad1WT1WSPWggVYO9aJZbOerB8byIax0Ww8m8JmsflsMVvBVrM8toFtYdvZLraPHWTvsWPsI5bP
+TrOzrJAVs0sCBFmwCx7BJyQKqUJtIUNoIrQU2gjthS5Cd6G3kCMMEkYIY4QJwjRhjrBQWCqsEtYI
+64RNwlZhh7BH2C8cEo4IHwqfCKeEM8I5oUi4IJSITFRFp6iLsaJfTBPripliM7GV2FbsIGaJPcQ+
+Yn9xiDhSHCdOEmeIc8VF4nLxUXGtuF7cLG4Td4p7QYBU9H/4f/h/+F+BDDWQihu47+uLWKzGajTC
+HuxBYxzC22iCY/gAzfArfkULqkm1cD3dSDfiBmpP7dGKbqNstKZe1As3Ui7log1NpMm4iXmYB+1Y
+DIvBLSyOxaE9S2AJuJXVZrXRgTVlTdGRdWfd0UmIE+LQWf1A/QBdLKrFja7uH9w/ope70P0z+rh/
+c/+Gfu4/3Jdwl6fQ8zNyNVWzYYDm1uIwWEvU0jFGq6XVwxQtU2uMmVpLrT0e0DppWVju7ebthkdA
+YPoQfVjkdw9UkkJ/dAFhWPh5Bx8SkVr+bRTEOsg6iBUo94DYKoOmJM4Zy3FquDQLJASVBSCoeBPH
+cBI/URTVs6gWCwiMvci2R54/8i1GYRH7iD8RZ0evf/4NAhRLfkqjuv8tfgckhgCQ0MlAVo/jWgOp
+PscGHOtUoGC+w7KOYyHHfZz/Aac3cBzEcVXE84FJVzwrOJYjPwubytEbccYrUEiPaOcKZGMr+iCG
+gjMMOjijvA8NIur/BZqNjehVn4irPli1P+a1m2dh84PZZaX/pP15nD7A6WyOHTgnMeLs3j9rTUiv
+qClPNlCayOnGnG7L61zP6xyNGMGYiLEzx3d5RMvm2aca50UvpCIN6aiJOqiLDFyHTDRBM9yAtmiH
+W9AeHdEJWbgN2eiBnuiLxViCpViG1diKF7ANL2IP3uQ26CTO4UcU4CcU4mecx6/G3KL2hu2hXpRL
+E2kSTaZltJzeo8N0hI4yD4thcSyB1WZNWXdLXUs9yyHL2+4f3L9pHk3TdC1aS9VqaG20m7R22i1a
+e62rt5v3ZxD/dp3w01BBrk0uj+N9kKsT7LTrfztWEg+I74jviyfEz8SvxO/EAvG8eFEMSqJklVxS
+jBQvVZdqSvWlRlILKSC1kzpJ2VJPqZ80UBomjZbGS1OkWdJ8abG0Qlot5UkbpC3SdmmXtE86KL0n
+HZM+kvKl09JZqVAqli7JkGXZLntkn5wop8q15Qy5idxSbiO3l7vI3eXeco48SB4hj5EnyNPkOfJC
+eam8Sl4jr5M3yVvlHfIeeb98SD4ifyh/Ip+Sz8jn5CL5glyiMEVVnIquxCp+JU2pq2QqzZRWSlul
+g5Kl9FD6KP2VIcpIZZwySZmhzFUWKcuVR5W1ynpls7JN2ansVQ4o7yjvKyeUz5SvlO+UAuW8clEJ
+qqJqVV1qjBqvVldrqvXVRmoLNaC2Uzup2WpPtZ86UB2mjlbHq1PUWep8dbG6Ql2t5qkb1C3qdnWX
+uk89qL6nHlM/UvPV0+pZtVAtVi9ZYJEtdovH4rMkWlIttS0ZliaWlpY2lvaWLpbult6WHMsgywjL
+GMsEyzTLHMtCy1LLKssayzrLJstWyw7LHst+yyHLEcuHlk8spyxnLOcsRZYLlhIrs6pWp1W3xlr9
+1jRrXWumtZm1lbWttYM1y9rD2sfa3zrEOtI6zjrJOsM617rIutz6qHWtdb11s3Wbdad1r/WA9R3r
++9YT1s+sX1m/sxZYz1svWoM20Wa1uWwxtnhbdVtNW31bI1sLW8DWztbJlm3raetnG2gbZhttG2+b
+Yptlm29bbFthW23Ls22wbbFtt+2y7bMdtL1nO2b7yJZvO207ayu0Fdsu2WGX7Xa7x+6zJ9pT7bXt
+GfYm9pb2Nvb29i727vbe9hz7IPsI+xj7BPs0+xz7QvtS+yr7Gvs6+yb7VvsO+x77fvsh+xH7h/ZP
+7KfsZ+zn7EX2C/YSB3OoDqdDd8Q6/I40R11HpqOZo5WjraODI8vRw9HH0d8xxDHSMc4xyTHDMdex
+yLHc8ahjrWO9Y7Njm2OnYy+IPQj8y7i3AsPeqaDCO5ko9Kl4jjtsw+oHs8tK2WO81LSmv0VYzbUR
+tGnjH46wu4UR5/2twoKyMRH2nltr9grH9Vd4PNPu8lwA21BhsykpgsMtsfBDhM8cG3EU9ySmP2Q1
+DKTiiN7248fewun+HHMq6v+5/xS++VMvOjWiJx2u8KUFVa9U2MzpY/+SjzWvekEwu+yMol5x9sjr
+pesjpN2nog+VfHLTCm9p8ikmOKlcMv+Sl6aoin5ScYVWRGpIWNNWVOieOcqVtMsboT9mf7j/Z905
+fsTxoYiIwJRM92B2eZ0BEZFCRLzAAhFYGKFjwyLQbK1/MLtcW6ZG8Lm+mZ6fFI4zOPK5Q8GwJI36
+hcHsMo0VfghmlyFbEIkVIyjyK2I/VJyF8dikDPl54zk/l9OzOX8cx+sr3pxm6cHssj6E8ZcKmjk5
+XRzMLpf2lXOE02xgRVTFvJG0gbSNc1oAFXTEOOZybB8xOk+HLpRL+zmOd0VoV0RrQtDEYHY5fYtB
+i4s57TevJcJ2TQtml4/v1Ah9blB1tlbSsbUVfRPYFaWmBGIjtG5DBG1itQhdMkt7Vryjw2ZVtEYv
+cvq1K5BfBVsagWbLg68YEW+FdrGeFaVhPYm0MwXGd6axQRwLjG88Y4M4FgTHcXocp6M5Hf0n8TK3
+8HS2wpuIbTidzcfFPJfTQDLn4yleWpdjI46pvKYzwpbO4fgoR3P0L3GcVNGmeSz9zvmHOIePu8j7
+IMRU8OlXqPy9JQVE5qjxowTTYpRUvI0UtoSPReDaCmlTcYUOsA0RpRsi/Jc34irGBrPLrdmboTPl
+I/Vb1RkUbvPpiFls2uoTpg81LW2FdZVGGihq5rEVVkK43/TRpp4He5TpnoSINdx1nP6Y029V8AVT
+DuMj6dCFCjrC+2/lnJUVqxx2mNNbq66NIvtZJqtgdpl3Ew5G2AFzdn9eYWkFh2lLOf93Tv8WMRP7
+VLRWaRQi6lTyEQ9XePywTnZla0BsuYECfzeOdaqQvxnbmNIWj0S0zH2B0JKjEoF9KqRBy4LZ5WPK
+dUbkoyCkcQ6/48bMN/BMj3OG15nAOc05xxz3HRF+OTJ+u//vxXuUWCFVWggqt4pvl/sshhxoiAd4
+xsvKM16pWIxlaMXzXm2wCZtwE7ZiO9ryHNiteBNvogNfhXbkmbDOOImT6Iqz+BlZxloUPXEJl9Cb
+HOTAnRRFUehD0RSNvjxb1o/qUT3k8JxZLt1MN6M/z5wNoC7UBQN5/uxu6k7dMZhn0YbwLNpQGkEj
+MIzG03gM5xm1Ecb6FqPoPTqKe+kCXcR9TGJ2TGBO1gEzWCc2GK+xoWw4PmX3sHuQz/NqXwi3CLfg
+lNBB6IAvhc5CZ3wlLBAW4GvhceFxnBZ+FArwjaiIKr4VU8QUfC82EhvhrNhUbIofxKniNJxTh6hD
+UaAOV0ejUB2rTkaxOl2djVJ1nrqImJG3I9XI25HFWGeTZqy0Kdpy0fIHeW2P2x6naranbRso1rbJ
+tpkSbc/bnqdk2zbby1Tdtte2l2ra9tv2Uy3bQdtbVNv2tu1dqms7bDtMDWxHbR9Qhu2E7WNq7Dji
+OELN3fnufGrhPuX+kq53f+0+Tze4i90XqKuRHaRsT8AToO5GjpBuN3KEdIfm0Jx0p+bS4qivlqjV
+o6FGjpAmatdrWTQ1+v7oBfRw9FPRT9GG6Kejn6aN0Z9Ef02bvN283Wm7d7h3OO3w3ue9j172TvdO
+p53en70/0yv/d1/uv/e+XMwIbTkoZhfsbMR/MGeyR9rP33O6WsZEl2Nlv5wm15Uzr/qWk5kvWSQv
+lx+V1/4/f8epcraku9pbzVEHqSPUMeoEdZo6R12oLlVXqWvUdeomdau6Q92j7lcPqUfUD9VP1FPq
+GfXc/4M3nP4vV/KXciWOA453HO87Tjg+c3zl+M5R4DjvuOgIOkWn1elyxjjjndWdNZ31nY2cLZwB
+ZztnJ2e2s6ezn3Ogc5hztHO8c4pzlnO+c7FzhXO1M8+5wbnFud25y7nPedD5nvOY8yNnvvO086yz
+0FnsvBSFKDnKHuWJio3yR6VF1Y3KjGoW1SqqbVSHqKyoHv/F7zfVdzdyt3AH3O3cndzZ7p7ufu6B
+7mHu0e7x7inuWe757sXuFe7V7jz3BvdW9w73Hvd+9yH3EfeH7k/cp9xn3OfcRf8N30xmRDtS0b+M
+eytQ6MTpAo5rK1DoU/EkKDXgWL+ilGrz0rGc8xun0yOONempHB/maNYsjDgvP4od5TiG4z6pCIx1
+ECcjJfxMLGPrxcnwgoQg/7RKnAxP+VO2w8TJ4XrG3bgG5RjxTK3wg1GHLRAng7Gx4mTYjXiZ80Zx
+9HJMN5Bi+TmmipOhG2sVgyfcwsv7c8ypOMK8QuEbcXJ5e1OlovA5YsvOzvZGXhG/BhI2G0i3GSWU
+IE6GWN6C0UsSdXFymbzIfBLW6FH4miOvjfWpGD+zbdYzQvoHI0br+ooRZa9HjKiJ3og2Z1UdUVMD
+2H6pqFxOMRF9G1uVZhsjRtSU2D6pqExuYXoVx/kVkqQoqahMAmZrlCRODkuwkt6EdfQhcXKZRpqj
+XknnzHNF9mEex+4cPyq/Ckbvi5MhlI1AeIQ4sgG8bnY5GuM4ha+tA+LkciwUJ5f3dViFVrINEXpj
+4tQI2ZmYyLVV4fwZHB/j/KBUdDUtjkS2oAqamuc08mWcZ9bawHvUkNMNI2iu2yyX42gDaRanr+d4
+W8SciOzvLxUc5qwYKfPK2NSKlsOzxnzKfmDEPLsCaRuv00IqqkyHR9PsYfuIa3nalLchOfZcVdlX
+aiF4Bd5ioLiY99AfcV2mXevA25kmFZVdRXhGl436zRUaykuIXOLksh6F542pZTHi5PL6UytkHNaJ
+cRHSMnUlluPmCF0xrB4J/SJ6ZGKeORsiWhgXUfPniLNsqJBTWJYm53zEGHKO0L7CSpv2QDzJcUzE
+7N97BXJNFcZVzEixa4QN+K1i1gpTKzyF2Volu7I2wgeZx47inHsrvEO45nZ+llsqMPwuwbyIXnWp
+OBczfYQ54x+P0KabOV7gyOeK8HjEfJ4VMUYXeakUwTdl/kRVOYfPwi2NMFwqKh9Hs2ZHzn+2ApnM
+6QRzLDhuj+jVuIiW0yvGMRKFS7yFX3lpI/MdD84xfSvXB/qV09yOhWXC2xfmcH51cXLZqAmrOL+u
+OQpX8HVO15AuVVhZzk/i9Epxctk4sg0Rx5pSNS33uKvTph6K5lsfd4uTy8fa7G3HiPm1oGIsIvsQ
+Hs2jETK/eMW5LkaMYL+KGSfqEWNXNo6zyznVIrSCl5Lv6vpAL3L6taoYbt+0Bksj0Gx/cMR8j7Sa
+pl0MVpyR9Yu4CrOfBRF+6i/Qgjm7zxr2jM+1GCNvzUuyOQ4ykA5x/IgffUoqKtMIoZF02shb8z46
+eRvGCNjLNelRjncZtdgljpMqWhVM7XxPbAti/AzCYgNFM24xLZF55l+l7/n7Sd+DaKp8nZG55uc0
+PXiJqSURdrt2VTqshVMrZr7poQRWUZOtjaAjIpOwVRobYU3elI6Xj40ZEd0SMVqswh+xzREels8f
+OsE5D0ecZX7E2U07+xJHu1FTyuDtHxInl9lWc66GbbGvgh85B4QRvOZnvAX+VpmoRWhY96r8SDkI
+95f3x4xQ1TKdl8DLP+Dnv47TH3P6rQq+YI7G+Er0vZX54WtNjBir5Rx5HEpbec3r+fmPiuazR5HX
+EGFRzJhZOBgx+0ddEZ18Xh5RA8SKIrw19xz0e4Rn4vxwPDwsoq8xVetUiicfroiBTd1mXaWfQWy5
+lFhu2U1P1rhCl6iBMt/Iql/hF1vKIkhQpKJy7BMho2URnq/YQLEa56RxzkyOJytmMDvD60yQfjey
+6gayExx38F71ESeXr/1MvP9vrhh/M8cyIuI6FyH7pAp+GN8uX0sBDBOvkm83Mu2tsAnPojW2Yztu
+5Jn2NtiP/biJZ9rb4gN8gHY4ix9wC3/y9FaeY+/Ac+wdeXa9E8+ud6bG1Bhd6UZqgyyeXc+m9nQr
+uvHs+u3Gs5HoQT2pF/rSndQXd9FddBf68xz7ABpEgzCQhtIw3E0jaDQG0ziead9CWzCOLtAf4Rz7
+RJ5jn8lz7Pt4jv0znmP/gufYTwnthH74UrhbGISLwjBhGC4JI4QRuCyMFEaiRBgtjEapMEGYgKDw
+gPAgQsJDwkMkC8uEZaQIjwmPkyqsFZ4km7BeeJ4cwgvCUfIK/xDOUBPhB6GIOgnFIqPuoiRaaYCY
+IqbSULGOWJeGiw3EpnSPeKN4I40Xu4hd6H6xl9iLJoh3iXfRRHGAOIAmGTl8miwuEhfRVPFhcQVN
+EzeJm2iG+JK4g2aKO8WdNFvcJe6iOeoQdTQ9oI5Vp9BiI8NPjxrfeURPqEvVpbSe5/mfsVgtbtrA
+c/tbeW5/G8/tv8hz+y/z3P4untvfzXP7r/Pc/n6e23+D5/bf5Ln9Qzy3/zbP7R/huf1j7nz3BfqA
+Z/ILPGmeNCr0tPC0oJ95Vr/Ic9LzCf3i+dzzNf3m+cbzHZV4fvD8wOD50fMjIyPnz5iR82eikfNn
+qpHzZ1Yj589itAZaA5amXac1ZulaCy2L1TUy/+wmI/PPehiZf3aHkflnPb3Z3u6sv5H5Z3cbmX82
+yDvRO5EN9k7xTmVDjLsAbJhxF4AND//yzVsAK2S/Ipr/5k0c/82beP6bNwkCCQx+/ps31fnviKbw
+X76pyX/5pj7/5ZvG/JdvArgxnMd3ht/fu1oOvxVA7QDqBFA2QD3/q37ZBiwTYM0A1gpg7QDWBWDd
+AdYbYDkAGwSwEQAbA7AJAJsGsDkAWwiwpQBbBcafhhar7JVr/vpL5XrqNWso//S3ZBYKozg9JgI5
+P4L+t7GUrz6D0zneyJH7lpJz/2bL5lXaw3vXf67HJlIrBEHUFnYkohXaogOy0AN90B9DMBLjMAkz
+MBeLsByPYi3WYzO2YSf24gDewfs4gc/wFb5DAUj8BAAJ+w0k88mQmhFPQ63huC8UBAkv8dIRBhqa
+X/ZUjxhnIC7xO/7eiBbGRdTsc0X7Vzy7Ffn0NLWtaMF8kkdqWcHn7wLURF1kohla8meX24ffr+yD
+vliNPfiV2lc8g8yfO+bPHLt/8HYDgdinZc8TlxbDmDBFEIHkjBQ/GiML/3/yFzoeeiVMdsQYNEdH
+INQWCM0PPR9aHXJc9aC08qPf5Zh/ZXno+BVHdfzbPcsP5aN5WfsGcg5CuUBoamipSZncUH75dUTW
+nx96HgjdF3qlnBPe0Lz8uPJ/5W2ZrS4NPR/KDd1XxuH086GlwYshAQ0q9TT/itYResM4p9HHv/IX
+eqPiyCtbLqMrzsP34fYrHxV6JbTU6PlfPG/+X633V2v+Wf+v1J9r9yB8rUv/1f79tf6Gll5Jh46H
+3jVmABB8JZRrUn92rlDbiqNDw8NjMLxSi20r6oaWhseobeWWy47no2poXMSxoVdM7Q6f6TgQnP+n
+V1UxE1b/z47vNebt8WvYhf+Kv9Ar4bn5yn+uj/9R+Rn9M7SkVrlVWVpFS3JD75r9N7Wl6kiHVlfZ
+Dw9NDVu0fIMXWv1X9eIatuqNCMk1uFLzgt+FVoeSDG0NFv2ZJSjft61c9u9JM5hb+h0nmoeev3ZL
+ofwyH/KftJN/cX7k81l9PPiG0Yc/rwuE1ldIln/O5/agkr8ol+R6Uyv+rf6mIS34iukh/xv/uPym
+AqHn+Ux5/korZ1hf0weGppZFIqYUy6RUZW94zHw+L44bvND6yNp/98+YbVf3df9df6G2IUcoiUc2
+8/9M24PPB9cDQVML+cwPrg8eDz4PBN81SoHgG2G/Nj/kDn4FBIuD35v1/tURDl7865b3/5n85gcv
+hnqFpv6z3oRyI/B4BW3EpeH9u6Eu5ZY9HGn+e30LfsfnR3Ro/j+3cGHfMD+if/MjbQ63OEvDXmaq
+US80v6zkX/kzWgpejJy1oeHl1NRrSvFPdaki+vp3Zm7ZeIWe53bWEcwNFoVyy1rHNeLRIPc2pqcL
+vVJBA6Ho8uOSTJsU/M4oCxZVlPz9P2MsghevLqX/hr+KtdR/qr3/oX4uNaPtf69f4Xjllf9gL2+M
+1Jy/3I+IKymjQ29U/mz21Iww8f/Zv9Dw0FJuBdL+Q7H9G//x/r3C45Kpobah/L8c8Ryv0LjQcZMK
+l75baYSPmxtQsUf7v9nFHoZnD70byg0t/esH/TOb8J+0CGFr2/a/s39la7e/M4MjtezPNC70hrnh
+37aMofxgfmh4JOe/R37B45Xbrrwq5LGmGbEP/yv9Ch/3yJ/38V/McL1Sthqr6CFf3XXhq4qkv9G/
+/7Cn+9/Jn1bGq+VPq15bWf70v7N/oaX/nf0Dqvbvv/Uv9Hxo/X86r/Ifti9pFZ74v1B++aYF+W/N
+HPyd+wv/L/4M7Qu98j8xf4HK87eizQjf9FfsC/vP2pfKEf4/6x8Q2T/gWv378xn6796f+bf82ruh
+tlcr+UtHt/5f08P/tfg+lP8vxff/A/m/srrBd/Efyv/9T92fBv7d+9PmX/CicTcp9EYYX6m0Dp8f
+ee7QKxF5h7K7e69U3IkKTg29Enn3sOrfv+ir0vjdRiNKfQUNDNttZqyC80NC6JXI+4gV95UrriIU
+HWpZPsvTzH3la/yX+8eQDRdcIKQilX9XZQ0Ixr0HiEhHOiTUQi3IqI3aUFAHdaCiHurBgvqoDysa
+oAFsyEQm7GiIhnCgERrBicZojCg0QRO40BRN4UYzNIMHzdEcGlqgBXRcj+sRjZZoiRjcgBvgRXu0
+hw+34lZUQxd0QSxuw22IQzayEY/bcTsS0Au9kIje6I0k3Ik74Ucf9EEy7sE9qI6pmIoUzMIspGI2
+5qAGHsADSMNczEUNzMd8pGMBFqAmlmM5JKzAo7DjcayFB0/iScQgD3mohafwFGpjM56Hjz9DWQcv
+4SXEYQd2IR6v43Uk4w28gWQcwAHUxUEcRF0cwiHUwzt4BzXxPt5HTRzDMUj4EB+iPr7AF/DhK3wF
+BZdxGTaEEIKNGDE0ICtZEUcxFIMo8pIXCRRLsYiiOIpDBvnJDxdVp+pwURql4TrKoAx4qAW1QAy1
+ptbwWn+1/opMa8gaQkMb2QiNNFmT4dYStURYtSQtCbFgWBcebzfcYEhAAgQ+9iIfe4mPvczHXuFj
+r/Kxt0SMvRX10QAqH3UbH3WVj7qdj7rKR93BR93JRz2Kj7qLj7qbj7qHj7rGR11HW7RFNNqhHWJw
+C26pogcd0BGx6IROiENXdEU8spAV1owErhmJ6I7u8HL9SEIP9IAfd+AOJKMneqI615gUrjGpXGNq
+cI1J4xqTzjWmJmZhLkSuH7W4ftTmmmHjmhHFNUNDHp6CinVYhzp4Gk8jGuuxEXXwLJ5FDDZjM+ri
+OTwHH7bgedTlelOP600CdmAH6uNlvIxE7MQu1MereBUNsBd7UR2vYR8acK1K41qVxrUqg383xnVc
+tzLwFt7CdVzDMvA23sZ1XM9kvIt3kYn38B4a4jAOoxGO4Aga4iiOojHXQhn/wD+QyXVR4U/+NsFx
+HEdT/k0bzfAxPkYzfIpP0Qyf43M0C2vqKZxCQ66vzfE1vkYsTuM0GuIMzqAFfsEvuB6/4Tdcz7VZ
+5dqscj1OICc50ZB/V0dLcpELDclNbrQkD3lwA2mkoSXXdZXrehJVo2q4nmu8yjU+ieIpHg5KpEQ4
+uPY7KJmS4edzwEEplAI/pVIqWlENqoHGfFY4KZ3SkUw1qSZaUx2qgwD/dpAANaAGCPA5E0XX0XWo
+Tg2pIQL86eYANaEmuJGaUlO0oWbUDDdRc2qOtnx2aXx26TSf5qMRf3L5Znqenkc72kpbcTO9QC+g
+HX1Gn8FB+ZSPW+gL+gKNqIiK0JB+oV/Qnn6lX9GIiqkYt9Jv9Bs6WKZZpuFGy3TLdHS0zLDMQCfL
+TMtMtLHMsszCjZbZltloYymyFOEmyy+WX9CWz+rONtiALnxud+WzWgUhFy6kogb/LtBaqI06qMfn
+ZyYaohEaowmaohmaowWu50/Ytcet6MK/FfR29EJv3Ik+uAdTMYvbywcwF/OxAMuxAiuxCo/gUTyO
+NXgCa/EknwGb8RzX7+14CTvwMnbiFezC63gDB3AQh/hTicfwIb7AV7iMEDGyUgx5KZbiyBi9NMqg
+FtTa+qs1ZCNN1hK1JDDkQASQip6QwMKe7BMg9CIQehoIrQFp2fCCwCBABAFhml2FFsM0acMMBIOo
+ZWs9AK2n1geS1k/rD6s2UBuCKAAEwMo6CLugt23XqTsm5Y7J7Y+FuWPuGYmD/cfkDsWHA3JHjsWp
+AUPuHoNzAyaOGYELg+8eNYZ8g8fcPZxSh+SOHEqNhtzdfwwFhueOzKXsEbmj/NRvRO64kTTsntxx
+Q+j8yOGj/BQcNTj3HuYac9/IMSx+3KCRg1nNcUNyh7JGEGH+GdcmwYIYCB1vz/YjpluXtn7UvD3b
+QCAUggwZdrjgQQx8V3CqmS2FQmCQYC3/RJCuSbvAOnds64evW3Ybf7hNghJuRYF+1SMFXif2qmUi
+GFQ4EXfNc7aD7aYbu/vR++ZuWX70vuXGTn70vrVbZz/6d+TYiWPnrp38GN+F09ldO/sxvlvHbn70
+78Y5vDWFy0yBDVHmuxD8DGJ5WVlJdLhMjuDFX9k/ACJkOOCG9y9JL5JO8Gff1hbbcnNHjMNOjns5
+HhiQO/ZuvDNgwD2j8T7HExzzOZ4ZOGLoYBRyLOZ4adDQkbkEjvKQEUMHk52jZ+jIoePIxzFxxNDB
+uZTKse6IUQNGUCbHZvfcPXAoteLYdoxxVAeO3cfmjhhHfTj25zgEAIVnjPnkbzWOiaj4BRQRAAML
+7wW+F/hnCQAZ2gJCNMcYjhaOKkedo5ejg6MdAIMbGv/k4ShzVDjaOFr5WZyIgovXN/dkjCAIsRwT
+UBPZ6I3+GIYxWIQVWIP12IId2IuDOIITyMcZFKAYJSSSnXSKp1SqS42oJbWlTtSd+tBAGkHjaArN
+oUW0gtaAABBlQ4YFRDmQQSA6YfaQ+fneKq2QCuS28hq5WOmkLFY+UhPVQRBBIHWx2YK6JbzfxuVB
+6o7w/qK5t1wy99ZW5t7mD+8Hmu3Y24X3kyAafAfC+0SIAMjRxyx3LDX3zvYm39kzvF8T3m8J7/eC
+jH9RWeF9d7N/UT3D+/Hh/QoIfL85XC8/zP/K3Ls84X378D4rvA+348oJ74eE96PBYIfgu9nXzncL
+CAIGYKanJxhcqMv/MQDtwSDy346CnCwnI9FaZC1CkvEbUfCXlxEvI15GvIwgQIUPaWZvw/8Aazll
+bKYmlnHsYPw76P3wQwDQxiyVmpfXZ5Akt1QNTIqX4sGkRCkRTEqT0sCk5ryeOSuMPQMBMPxXDBbx
+fxIg2AUzL0Jojk7ojF+YjdVmdxnfUc9eYjvYy2wne4XtYrvZHvYq28teY/vY62w/e4MdYG+yg+wt
+Vsh+ZkXsF3ae/cqK2W/sd3aZlbBSgQQmiMJ84UfhJ9EnVhNjxThLHcu7tuW2h20rbCttX9m+BsMw
+/jaYgJtxMwQeUQs8lhbQER0h8D4RuqArBB4nC5iFRyFgNZ6CwCNPgcecAo82BR5nCjzCFHhsKfCo
+UuDxpMDXNgJO4AQEfISPIOATfAIBn+EzCMhHPgQeSQo8khR4DCnwGFLAdzgHAQUogIBCFEJAEYog
+8NiScB7nIaAYxRBwARcg4CIuQsBlkiCQQgoEspAFAtnJDoHHnAKPNgUeZwqkkw6Bx5kC+cgHgUeY
+Ao8tBR5bCjy2FHhUKfB4UuCRpMBjSIFqU20IVJfqQqD6VB8CjyEFyqRMCNSIGkHg0aPA40aBWtD1
+EOgGugECjxsF/r10Av9GOoGyKRsC/y46gXpQDwjGWw0QaAktgUDL6GEItJJWQqD1tB4CjzYFHmcK
+PMIUeIQp8NhS4PGkwGzMBuK/+kDsLnYXiL83xfh3qInsKDsDkX9vmsgK2W9g7AK7AJG/ScX4N6mJ
+/H0qxr9PTTQ0DIx/q5rI361iwnxhPkj4UfgRJPwk/MTfmY0Ds9Sx1AFZ3rW8C7Itt60E49pnzskY
+pFWaIcn8E4NY6bMAMYJTdpzBL5uLfPZX+mweU7mGB/F8BSsAaIR2YMZ3pbDngPJaDIxtZBtBbBN7
+DixiBhv/gOpX4fyVdolthGTwwfi/qq2kXIXzd9ota7lqK6lX4fy9dll4bCq3UuMqnL8u381g7Dn2
+XCULafwD0q7CMe2wDzV5uxlmKdtYMVZatnZ7eUQP3gJFfE4vb6E+b6FJuIVNYBDYJrYJpPXQeoBp
+PbXe/0I7z/J2nmXPgrQ7tT5gWj/trn+hnc28nc1sM0jL0fqDaQO1gX+pncqSeQ6sTL7a3dqQP22B
+kAgGK3SjTHsNTIrm1Otgkg8EJmVIbUGQo86BQMIhg45pZ9BkB0MbJHoXeBd5l3iXe1d6H/U+7l3r
+fcq73rvRu9n7vHeb9yXvTu9u717v694D3re873gPe9/3fuA94f3Y+5n3C+9XYCDTn4OhEOP0Kfo0
+fYY+S5+jz9cX6ov1pfoK/VF9jZ6nr9c36Jv0zfoWfZu+Q9+l79X36wf1d/Qj+jH9hP6Jnq9/pZ/R
+z+rn9AJvQ29zHdpFrcDb2ttGK9JKtGLvzd72uku3ezvquu7Tq3uLvL94z+uJerzu9/7qLdbTvOO8
+v3kveEd4f/de1FO9f3gveUt98Ak+2Wfx2b1NfFE+jy76onXV5/PF6TV9ib5kX6rexpeu1/XV1hv5
+6ukZvgzvSF9DXxNfc19LvZnewtfa10Zv5e2it9fb6R30Tnp37716F+94HqtkSUMAaZg0FtX4b2Om
+8N/GrBGW6kLvQ96l3oe9q7yPedd4n/Su8z7j3eR9zrvV+6J3h/cV7x7va9793je9h7zveo94/+H9
+0HvS+4n3c+8p79eVpLoZ2fpcfZG+XF+lr9bX6uv0rfp2fae+R9+nH9AP6e/p7+sf6h/pn+mn9NP6
+d3q2Xuht7L1eZ9olrdB7o7etdl4Lahe8t3g76B7d6e2sx+ix3hJvyMd8kk/12XxObzOf26frss+r
+W32xvgS9ts/vS/Gl6W19tfT6vrp6E18DPdOX6R3ta+xr5rve18p7g97Sd6OvrR7wZuk9vGP1LDBY
+pe5Sd0DqIfUAST2lPmBSrjQEivGNkIiSxkhjEcMl5ZMelp5CrLRD+hS1pFPSV+galtqD3sXeZd4V
+3ke8q71PePO8T3s3eJ/1bvG+4N3ufdm7y/uqd5/3De9B79ve97xHvce8x70feT/15nu/9J6+QmoP
+6A/qy/SV+mP6E/pT+vP6i/rL+m79Nf0N/S39Xf2o/oF+Uv9U/0L/Wv9Wv03/ydvI20In7Q/tJ2/A
+e5P2i1aq/eZt571Vd+sObyc9Wq/mvewN+sgn+hSf1efwNvW5fJou+WJ0i6+aL16v5UvyVffV0G/y
+1dTr+erojX319et813lH+Rr5mvpa+G7wttSv9wV8N+mtvV31271j9K7/ttQImRBgRwz8IIje2WDS
+JE7N5RSDDDunJ/Ky8SAI0nhpIq81DTK3+Wlohg4YwY+bWd7CrIgW5oFJU71zIjjzOecBzhFhhQsx
+iOefJ/B69/Pj7+PtjYs4ZgJv634Q5IjfgZ1a2ZLpv4Hp8zl1IUwx/VP9MxBa8VqpPIpwQtd/BdNn
+6b+A6dP0n8H0KeUlxbzkPC8p4iUVrTQBQYUEO3TEozpqai/z2LmrtoPvO2kvgaGrth0MnbQXwXAr
+CMRxOyS4kI0RWItNV/7SlT4BTJqsjzcko48zJK+PMa5bH21ctz7KGAX9HkM2+nAum3B/pW5g0jTp
+NkOuUhaYNAWywUcs/EhDXX0ib/d+3u59vN2xvN17ebsjeFsEUco2WuBU9zAlQZBul4ZK90pjQfDw
+aze8xDkwqSEIJKWD0CasSc34sU4wyVIxstpeMLFY2wMmntd2g4kF2i4wsZS382qYEsQC8bIkVTrH
+K2C4AwRCj0r8nWV8uhGE+TwmrIkWaIcs9MYgjDF1xlYXDNVtSeGY0uDUMWhbbYMPgmg7zu9rXM/p
+GWD8PKJtFxgyOLUbAmqiAacfgwAPj2xkOG1P8k89bWv5Ppv3TUR11ApnU43fVyLIzrVcRv5KNIMO
+RRgiDBaGGt9XIQwXhoGgCKlCCyEg3CJ0Fm4X7vzLtVyQvNO9w5nmvc9r9ENCXdRDW3QlOwi1+TyN
+QSwSUR1pIO90kHc4iGkg730g4xeX+HFy2XHow4+UnfPCq16GRNi9Nbwp3jRvqreWt7a3uvcbbx1v
+ureutyYI9vJVq7liNder+9kBMPjhwK/0h3ciS+bf6fwH/zTFO9U7yTsZBEfk6tdctfLVqrlOkF2b
+K0WSsuuFKp93VPm8u8rnfRGfuay0YWX/IkrC+V18hbPIQks7lC8AgM0CxBxAOgn+J+aYe6DyZxoS
+pm//5zdTyQmw8f+8HnqbddHb3MgZ5uMq/N4VG42poFkz4PISAC3LD60pXpJaIAN2fjdrIRZjOVZh
+NdZiHbZiDw7hQ5zCOVwgRk6KpTTKpFbUgXpQfxpEw2gkjaFptJBW0TraSnvoEH1Ip+gcXWCMOVks
+S2OZrBXrwHqw/mwQG8ZGsjFsGlvIVrF1bCvbww6xLICNDn/jxDaAHQMEERBiAaELjD9mX6109N1q
+zeL040pHX4cwvUbp6OtozRICYPadygFfJ+tFzn9FOeDrHKZ3KQd8XcL0buWAr2uY3qMc8GWF6VeV
+A77bwvRe5YAvO0y/phzwdQvT+5QDvu4m7eiodPTdbvbB0Unp6OsRpjsrHX13hOkuSkdfT2sWewdg
+pwBWDAhW83rco5Rlvl7Wlzg9Wlnm6x2m71WW+e4M02OUA74+5vncY5UDvr5hepxywNcvTN+nHPDd
+FabHKwd8OWH6fuWALzdMT1AO+PqH6YnKAd+AMD1JOeAbGKYnKwd8d4fpKcoB36AwPVU54Bscpqcp
+B3xDwvR05YBvaJieoRzwDQvTM9XnfcPtbTk9S33eN8J+M8sIf/tIH4CNCY9nnJTvus2ax+kEKd+V
+HaaTpJ9c3awvcrqBlO+6I8y/Tsp39QzTd0r5ru5huq+U77o9TN8l/eTqwY8FBMfLhm5b7gMcO1AT
+NS0PgRwvoSZgWVV218Sxq7zOK+V1dlap82p5nT3ldXbzOveW19nH6wwCHK/xOtNAjr2V6kiON3id
+MYDjAK/zKMixn1MLK/Fe58fNDh/HcJdxnAoAdxklKkDhFt+6SosHr9Lim5VbtP5R1qL1j0otCo71
+vMVRgOMQP3oB4HiaHz2i7GjHHuCqUmCO14CrXjurIkMYR4dLKksOxtFmCfxGicQA8HtYEisvaWOU
+iDUBtDFKxJrlJcYZa7IeAO41SliPPy1hsMIGO4BWPBIMIAAB/ZAD0fqH9Q8o9tX2NVDtO+37YHN0
+dHSB7njYsQo+9yj3LMSDYIcfbXCvLdkeZ0+wJ9kb2K+z32nvazfGjEQnLYUESA2lxwBKNPfCxxjE
+PACzyYKgioyJ32Nk6EOUhqypObVE+O1Zg24eiAD8oZCsB3VardxD3+SAQl+GynQJgACgLgARsHcA
+AUC1uRA4VRcCrJwv2hMBmkWAglkAprEfWCH7Xegq9Bb6C/cL04WFwiLhKamG1EO6S5onLZTelN6W
+zknFsl2OlpPkFvLtcm95tDxOfl0+Jp+U/1C+V35TLjlbOx9yPuJ80vmcc7fzNedB5yHnUeenzi9c
+b7lKdEWP0xvrHfRs/TX9XLQS3So6K3pY9APRq6JfiP465paY8TFrEvSElIT6CXcmDEuYmvBAwusJ
+7yZeTopOSki6Oalz0h1JfZOmJu1IOph0POnzpJ+TipOC/oH+e/2L/Wv8L/tf83/gz/d/nWxJvjW5
+S/IDyQtS3CnVUpJTaqbUTbkvZWrKvJQVKVtT/pFyNjUldWjamrRdaW+mnU63pKend0zvkd4vfUX6
+C+n70g+lf5X+Y81QLVutG2vNqXWy1o+1LtdmteNrN6g9vHZe7T21L9WJrZNap3vd++tOr1ej3t31
+RtX31a9Rv37n0PrQhls23LFh5IaHNjy2MWZjnY03brxj4+CNszcu27h5Y+jnakVC8Y0X3rosX/Ze
+fvry85e/vPzD5VAwKpgSDIVKQ+b4+ZHHCtivAoTbhD7CaGGSMEdYJCwWnpauk3pJudICaZt0SDom
+FcmyHC3HyclyQL5DzpHHyRPlQ/JJuUjZr/yoXHS2ds50rnA+5nzK+bzzVed+5yHnO85jznxXnuuY
+TrpFT9CbGjkGfb9eEO2KDkRnR4+LXhidF30qpl3MyJgZCUiISUhLyEjom3B/wgMJjyccTEQSknxJ
+/qT2SVlJvZLuSpqRtDPpUNJHSV8knU+64Id/mH+cf6k/z7/Lf8z/kf/rZCTbkjsmZyUvSF6a4kuJ
+T0lNqZMyNmUSH4PHU95P+Sa1empODaStTdufdjqd0u3ptdI7p/dMz01/NP3F9LfSv0r/Lr2ollwr
+UGt2rfdr/VjrUm3UttduUHtY7am1t9b+ow7qJNXpVjen7sR6qJfBxyCpfv3O+9cf29B2Q6cN/Tcs
+3LBio7oxbmPGxnYbB26csXHBxryNF39GEYpx4a3LuKxdTri8+fLxy2culwQR9AdHh/4wxiD0TehA
+aH/4KYHnQmtDa0KPhh4JrQqtDC0MzQ/NC80N5YbuCt0RygZCTYFQfeONoFByKDEUC4TswfPBusFa
+wfTfvwF+X/X7SuD3Jb8v/n3R7w/+vvD3+b/P/e3gb3vO7zz/8vkd5186v/38i+dfOL/1/Ibzz5xf
+f/7x84+cXwz8cumXE78c/nHIj4N/vPvHgT8OAH7s/GOzHxudC567dO7iuQvnis+dB84Vnis49+O5
+c+fOnvv+3Lfnzpz75typc/nnPj/3CXDuAHDulXOPnXsUOLfi3LJzD5574NzsczPPTT/XHDhX+1zi
+Od8PfwA/bP5h5NlPzn589tjZ7cDZZWeXAmcXnp1/9oGzc85OO3v32ZyzfYCz/u+//H7V9zO/H/v9
+qO8Hfd/9+9jvLd+VfLfvu93fvfzdvG+nfDv5zOUzv5w5eyYAnMk4+ORfiFUR/+M1CqicmATQjD9v
+hcYBNI1Ts4DIffjTQo5r/95jlTTnCs6kq5/7Wn/UH6BhYXr0FaWDyqmBVY8CaOQV9Uf/jb5ncezJ
+fz36QzpHRXSJxbAmrCebxGawuWwV28x2sXOsCBBGC1OEOeJScZW4Vdwl7hPzxdMyAPYC+B/bxu8N
+CuYnQRQkQJClW6T2gHSr1AGQOkqdzVKpizSd72dIn0mfS/mc/kI6BUhfAtJXcoqcCsg1AEBOk9MB
+uSana8mN5SZyU0BuBsjNOa+FfL3aCgDU1ur76j84dQxQP7B2AKwdAWsn22LbEttSALAtM+5Sgv/Z
+VtkesZ3m1DcOfhWObY53Acd7nD4ctTJqVdQjUY8CUY9FrY56PGpNVD4Q9UXUqagvo74C/4v62t3H
+3RcA3P3cd3l6AYCnt+dOTx9PX8+TnjzPU551nqc96z3PeDZ4Nno2eZ71bPY859nied6z1fOCZ5vn
+Rc92z0ueHZ6XPTs9r3h2eXZ79nhe9ez1vObZ53nds9/zhueA503PQc9bnkOetz3veN71vOc57Dni
+Oep53/MPzzHPB54PPcc9J/h5T3o+4vuPPZ94PvV85vnck+/5wnPK86XnK8/XntOebzxnPN96vvN8
+7znr+cFzzvOjp8DzEz+i0POzp8jzi+e851dPsec3zwXP756Lnj88lzyXPSWeUk/QE9KgkcY0AQA0
+UZM0WVM0VbNoVs2m2Y3vZNSiNJfmLv/15RjNq/m0alqsFqfFawnGU1aaX0vWqmspxi8z83bStHSt
+plZLq63V4Z/ravW0+loDLUO7TsvUGmqNtMZaE62p1kxrrrXQrge0ltoNWiuttRbQbuS/7dxWuzn8
++863ah20jlonrbPWReuqZWm3adlaN627drvWQ7tD66n10nobd1G0vsZdFC1Hy9X6awO0gdrd2iBt
+sDZEG6oN04ZrI7R7tJHaKG20dq82RhurjdPu08Zr92sTtInaJG2yNkWbqk3TpmsztJnaLG22Nkd7
+QJurzdPmawu0hdqD2iLtIW2xtkRbqi3TlmsPayu0lYC2SntEe1R7TFutPa6t0Z7Q1mpPannaU9o6
+7WltvfaMtkHbqG3SntU2a89pW7Tnta3aC9qL2nbtJW2H9rK2U3tF26Xt1vZor2p7tde0fdrr2n7t
+De2A9qZ2UHtLO6S9rb2jvau9px3WjmhHtfe1f2jHtA+0D7Xj2gntpPYRoH2sfaJ9qn2mfQ5o+YD2
+hXYK0L7UvgK0r7XT2jfaGe1b7Tvte+2s9oN2jo/Gj1qB9pNWqP2sFWm/aOe1X7Vi7Tftgva7dlH7
+Q7ukXdZKtFItqIV06KQzXdBFXdJlXdFV3aJbdZtu1x26U4/SXbpb9+iaruvReozu1X16NT1Wj9Pj
+9QQ9UU/S/XqyXl1P0VP1Gnqanq7X1GvptfU6el29nl5fb6Bn6NfpmXpDvZHeWG+iN9Wb6c31Fvr1
+ekv9Br2V3loP6DfqbfSb9Lb6zXo7/Ra9vX6r3gHQO+qd9M56F72rnqXfpmfr3fTu+u16D/0Ovafe
+S++t36n30fvq/fS79Bw9V++vD9AH6nfrg/TB+hB9qD5MH66P0O/RR+qjAH20fq8+Rh+rj9Pv08fr
+9+sT9In6JH2yPkWfqk/Tp+sz9Jn6LH22Pkd/QJ+rz9Pn6wv0hfqD+iL9IX2xvkRfqi/Tl+sP6yv0
+lfoq/RH9Uf0xfbX+uL5Gf0Jfqz+p5+lP6ev0p/X1+jP6Bn2jvkl/Vt+sP6dv0Z/Xt+ov6Nv0F/Xt
++kv6Dv1lfaf+ir5L363v0V/V9+qv6fv01/X9+hv6Af1N/aD+ln5If1t/R39Xf08/rB/Rj+rv6//Q
+j+kf6B/qx/UT+kn9I/1j/RMj46x/rufrX+in9C/1r/Sv9dP6N/oZ/Vv9O/17/az+g35O/1Ev0H/S
+C/Wf9SL9F/28/qterP+mXwAA/Xf9ov6Hfkm/rJfADgCOcCaIqgHwA5gGQACQB/Bfee0PsAKAFQJs
+C8B+DW8vAOz38LbNPEToCrAXAeE2QOgGCAQIdwBCz3BGp3d4kwGhDyD0BwTF8FOAcD8gOAFhEiBM
+B4QYQJgDCAsBIR4QFoW3FEBYDAhPAUIqIDwNSDUAoRkgXWfc+wDEVwGpFyDdBYhfA1IuIM0DxLOA
+tACQFgJSFCBtA6Q3AckNSIcA6W2zLekYIJ0DpHRAKgKkYkBqA8gyICuAdAsgWwDZavhJQLabm9QR
+kKPNTeoEyHHmZvhQOcncpC7G80qGDwSkroAcAOTbAWkoIN9hboanlXubmzQDkHMAeTQgzQTkceYm
+LQfkiYD8OiA9DMiHAPkdQPoMkA8D8hHDPwPy++ZmeGn5mLlJXwHySXOTvgbkIkD+A5BOA8p+QPke
+kAoA5UdA+Q2QdUC5CCiXzGtwtjY3uTrgnAk4ZwFyCuCcY25GFOCcBzjnG7EA4FxobkZE4HzI3ORa
+gHMF4HwEkGsDzscA55OAXB9wPgU4nwbkxoDzGXMzogfnRnMzogjnZsD5nClH5/OAczcgtwScrwLO
+1wD5VsC5H3AeBOROgPOQucm3Ac53AOdRQM4GnMcA56eA3BNw5gNOQ2b9AVce4HoLkAcCrmOAqwSQ
+FwI6AboCyK8AugXQjTHeB+gJgN4YkD8A9KaAYcvkc4DeCdCzATkI6N0B/TVAkQB9P6CfAxTDThUA
+0QqgrAaiXUB0K0DZCUQHgOgsQPkWiM4GoocBygUgehwQ/QCgRgHRC4HoVYCaDETnAdEvAGp9IPqU
+uRlxVvTX5qa2BmLaATG3AGoAiBkJxIwH1DZAzAwgZg2gdgQSACTogDoESIgBElIAdSqQkAYk1AfU
+B4GEDCDhTkBdAST0BRKGAep+IOF+IGECoL4PJEw2NyO2S5hqbuoHQMID5qZ+CCQ8DiS8DqjHgYSD
+QMK7gHoRSASQeBlQg0ASgKRowHIJSPIBSQmA1QUk+YGkmwFrDJDUHkjqDFhbAklZQNIdgLUNkNTL
+3IzIMulOczMizKS+5mbtBCTdBSRNBaydgaQZQNIOwDoCSNoJJB0ErE8BSYeApOOAdRuQ9BGQ9Dlg
+3QckfQEk/QxYDwJJ54GkYsD6MZB0AUgKAtbPTLPqHwhYvwH8wwD/vYCtPeAfB/gXA7YswL8U8K8B
+bMMBfx7gfxmwTQD8uwD/HsC2GPC/Zm62ZYD/GOD/ALAtB/wfAf6PAdsKwP854M83ImXA/7W52R41
+H55KtgC2x4BkG5B8K2B7GkjuaG5GVJ3cxdxs3wDJWUDyA4DtDJC8wNzssUDyUiDFDdjjgBQfkFIN
+cDwBpMQDKcmA40kgJRVIqQk4ngFS6gApdQHHZiBlLJBiZAG3ACmTzM2I4lOmmptjG5Ayz9wcLwIp
+K8zNsR1IeRxI2Qo4XgJS3gdS/gE43gZSvjE3YxWQ8q25GauBlLPm5jgMpFYHUlMAxxEgNQdIHQo4
+3geMgDZtDeD4BEhbC6TtAqLuA9L2A2lvAlFLgbTT5ha1AkgnIF0EolYC6TKQbjHWGUC6HUhPB6Ke
+ANJrAekdgajdQHpnczPWIOldgfRsYy0CpPcwt6ivgfSeQHo/IOo0kJ4LpK8Aon4A0h8F0l8AXAlA
++otA+j7A1RhIfwtIPwS4bgLSvzI3181A+ndA+o+Aqx2QXgTUDAGuTkAtGailAO4+QC0rUMtmrHuA
+WgGg1o2AOweoNRuoNQdw9wdqvQ/UOgm4BwK1fjQ39wig1iWg1mXAfQ9QG0BtBrhHAbXtQO14wD0G
+qN3A3NyzgdrDgNrDAfccoPZUoHYe4J4L1N4K1N4DuDcDtf8Aal8C3K8AdQDUiQXcu4E6SUCdVMB9
+AqjTDajTHXB/BtTNAereD7jzgboTgbrTAXcxUA9AvRqA+zJQLwOodzfgSQbqjTI3zx1AfZ+5eXoC
+9ZPMzVj71a8O1K9hrACB+vXNzdMP6Lwf6BwCPHcB648B6w36SWBDW2DDLYDnH8CGTsCGzoDnOLAh
+C9hwm7GiAzZ0BzbcYazqgA39gQ0jAc/nwIaFwIaHAM9ZYMMKYMNKwFMAbHgU2PCYsaYDNqrAxhjA
+UwRsjAM2Gv0MARurAxvrAJoIbMwANt4IaE5gYztgY0dA8wMbuwAbuxlrNGBjD2DjHcb6DNg4ENg4
+GNDqAxtnABtnA1omsHEBsHExoDUBNi4FNi4zVmvAxjxg42ZAawVsvAhsDAHarcDPAH6uBmi3AUUA
+igRAGw4UAyhOA7RRQHFNoNjo2yrgwlvmpm0DLgO4LAPai8BlDbjsBbRdwOUE4HITQHsNuGzcnWxp
+rHyAy63MzVj5XA4Al402vwAu3wRcbmusg4DL7YDLXY3VEHA5G7j8tLECAi5vBi4/D+g3AJePA5fz
+Af1G4PIp4PKXxhoDuHwGuPwDoHcGLpcAl0OA3g0IAghGAfodQNAPBFMAfTAQHA0ExwH6cCA4HgiW
+GOsLIBgyN/13IPQHECoF9IsgASAhEdBLQYIfZMSt0fGg4wAd7wFE+0Enm4FONgei7wedvB500vDV
+E0EnA6CTbYHoqaCT7fiG6Fmgkx1AJ7OA6Nmgk9mgk8O5/6eTeaCTx4Ho9aCPPwF9/CMQ/QmoEKBC
+AYguABVKoMIkILoIVJgMKqwOxNhAhal8Q4wDVJjON8Q4QYW1QIWZQEwUqLARqLApEFMNVNicb4hJ
+ABVezzfEJIIKc0GF/YGYJFDhQL4hxjjPYFDhMCCmOqhwBKhwDBCTBiocByq8H4ipByqcCCqcAsRc
+ByqcxjfENAYVZYCKHgZimoCK8kBFW4GY1aCiF0FF+4CYZ0FFb4CK/gHEvAIq+gBU9CUQcwj0qxv0
+qw+IOQkqrgcqrg/EfAEqvo5viPkSVNyYb4j5yvxJhQspQMzXoAs1QBfqAF4JdKEe6EIA8NpBF27i
+G7x+yMwKAISKfGb4j8DCFPtnqbyId7dkKFBhCd+RcoTfYnHDAw06ohEDL3yohljEIR4JMJL1fiTz
+368ue4+vZvhNvrrhd/kycN013+drhdb8Ny7a4Ca0xc1oh1v4O37Ge7PG8xfGc/5Z/H2/buiO29ED
+d6Bn+Zt/fdEPdyEHuQDmYh4W4EE8zH9p4Gmsw3pswDPYiGfxHDbzt/5ewFZsw4t4CS9jB3+T9RXs
+xavGG6w2AWMwEHdjqE3CBDyF0Rhu24PxGGb/EfOx2v4sxtp/thdhMO63P2RfY18t3G7fiRGYIhi/
+VrMHMzEAI+3PUHf7cfsy451EmwX9MQsP4BHSKdp6wfq7NWSD9bK1BLud6/AGtbCl2+bY4m0JRrbG
+JmKitdRGNg1zsAiz8RAWYgmWYhkW83cZwd8RegKP41c2n63FvWw1e5ytwST2JHuCLaDG2MXfoH0D
+m7GGNmAXBvG7jIeRx7bjAdyHXXiTDtMCVg+HsQFFOI7NmIfDwmYR1IG/fwt8KjEUU3fswBpqTjo1
+V2QRYhdxh5gt7hK/F4+iqThWPCrmiGOpobBO6iFtkHpIzYW3mAfvIgm76EuMxR7hB6GhsFdsKzrx
+pXBU2Ixvhc2iCOAwlmA9JmMXdBqF6Wwyy8YSvC0d5b/7MwqHcZTW0nEUYQ/Nxkk8KoisPdbSSbyB
+w7iA2UJ3ZqzKG7JBOI63sR5HsQSrMVaEdJKsCLI6WG/0HkB/jglCPekk/1eE6ZiM7lgv75J1JYWO
+c4ltoDepQH4YeTgu9BHuFT6nB8QUcZPYHktMCQg5WCKdpNXGMfIgmijm8H+TjdbZ/WIObcYPYo7S
+H5PxlnFFAHawbOkoBmEvBuF+2YWjdD09ICzAEhilCTiqdBAbYI3RgjIVowCMEhpjGEZhMrYa710L
+q7CETTavV24qXcASrBG/ZoOwhB5iF3BUaItaGCQWYiygA6uAVxRZEgVGqOt3bWM1bh24LXBbT/87
+vZLr1a3y0e9S/NuQtc0x0b8rFMrqKcZJvbZJ8duEGuo2sUbK19cq/Lpe3Y5ZPf3bSm9uG2715py2
+9ep27NZzG6thfGrbKzm5Xt2b2/Iy46TbpBrbpBq35mzzDxjiX+hamNJioevuFvUAgEEF4+9hMgwK
+rhIHSeshGG9kBuziZciXSZWmMxENDp4ouA6uEwUnCjI0d7K7RrI7eZCIkrFCXMm3wVWK8+L5MXIt
+MGoMMONpKAEWNA5EKZgtzmSqIpEgGmu0ko7bbN177gZC+5v1almQ2bz5dWhwpuRIBu2EzW/Lsgl9
+azSMTnE3dAspAjU+fPiwvi46GJROlt4bfJzuNnv8lrCZ/cDPoaBNIEqiOSJmqqIkQ2KiapzD073j
+Nq37nT13gxknKsk3Ol/csiAzgwJRGZaAJcuSYxltybO8blH6kjvFnexOcdOozSx1s3Sy9CSrY2wA
+GNYCslfaBwFJyAlcF2fDPEVMsEjVHGyeV4uKdiclxsfFemOidc3jdjkddotapgCxVp/sd5Uc8RrX
+2PJEy5YlLQ08mFmQmZkRcNvIxmx2m0MXY92xHj1Jpb6ULCTbKFlrKCRHJ/MtReNb42S+id7gF92p
+RtshlDo0bzA1Cz6VTa2Cjw/JGxz8fPBTQ4JvUU734D4aOkh4ILhdmBfMpSeDuauD2x8L9qe1xvYY
+dVlNTwICNgePi/fIOpL504uvBW6vzqw2azql1WQ2mzWBEuNZg1oN4litWg3aaG5XdWtcLdErW2on
+S8w7N0aeK7lTYhfGLHCh9kJpAaunxlgTlVqxHgGW6i6LQEqa6pDkDGMsLMZ4S8YwdNxm795xm6v7
+nR23RfHBkRAwtKDY27LE27L4TIGhCwUHT5wpyDzoKnQVuj3N3c3dHm9zt6d5RsduPRWX+JPicv7k
+9jYv34k/9apOKUJ9Sm+cSF53fWrcqEnTxg2jE8kbXZ/S3YkUrcuK4HZStB7jdbeixo3S2I6BNPTD
+YYM+GPXFu58dGdh3a7duL/T55qNvPho4btK9X0+fOTl4nOqxevV2BG4keid1y6qn9zp/PCsmxb1Q
+u74YvD1lx5pn34wSQKsdw3r2yDkZ7Ooe2bvnEACQcG/oG6WWVAQbvEhBOhpiQaBJalSNtBppUemp
+6W2w3J64vP5DvuWp8nL7Q2meRTVTlzVKT46rYREc0U6LIyrZUccZ54i6ztbIFJ4xYe40JHdnx226
+AU4DHFx+1xlSPVhQXOAqvFBoiKr5dXCdySxueYZzXIWm1KSfjK1XdaouR+sxDTObNG6Ulp4ZE63L
+KdXTGjdqEsmv0ZBStIgy6YM7Bgy44/YBA25fu+fVJ/L2vFrySI8B/e+4Y8BA4bq8kt55SWv3vvrk
+k7v3sGUr5sxauXLW7JXT81999fPPX937OctdOWvOihVzZq6a/sevsuPzV1/77PO9e/IBgGFm6Bsp
+KBXBggw6GljlIKd9rtvjts212jwet2UuLNEx0QrJ6tyYmGhGAs1NSEzAXAsSExP8SSw50W3VrN6Y
+aMGnuzWrojLZ4tY0KyMmwCxivmi3ZtUNbhuPzSLIsCYKNaOdcs10eaWvzsr0FanLfIucmrW+zWlB
+fU90ujNRqO9JT3a6o7RkwRPnus5VUnDwxIkC1yFTuM3dxn+3MZ0PnbnwXaGr0HXI0FGv8b9c1JKp
+mJV2lXnqFVqs/mRu/GOv6tujMon6BrYrNqm6pVY84imJeW21UZvSPC3QyNPW1g+9qLf1dk/v2N5J
+vTIGJ07G41hNj7FH1JW2h6NXxqys/li9JIvNYlfd9nR7TR+Ls1SzVbNXc8fr8dExCUmZ6UinWpYU
+T22ttl4zukHm9ZZGnuZa68wOlk56x+h21bpm9qDell722z29tD5Jd2UOsw9152TeRxPtk9wP42F6
+hK2U1ihr1CfV1ZbHbavtSzPzMrdlNu+LvsSnWsPMJk0t1IqaNpSZQinpTkqpDmNu8hnaMDPGmJ4p
+XPHo/J23n8i7a8OtwclUcn0beY99SF/yliwZcmb2L8H3587NyDy1q9szt9+xtu3Q2dcLKbc91XP5
+gdYBtqT0Yq/DY+YEg9OC3zzc6w7SPp7x9YDWU1uueys1dWeD60b1bDgYZPgKGsV9RdOAleZgpgiJ
+ieJVnAR3EQUZFHAEpCwpRxotLZXyJJm7BxoV9gsACNuC59lk2WN8O00gSn4UjzgdCgSPDM3qdOUb
+jfbcDatp8aI4bdi5M5kFbsPduUoKMkhm0brHm5LGGjfyNGWT586a/UDeqpUrHpE93wVbff998Ppv
+f6RDX31JBwtAWB88z0bx8yUFohTjfArB5hE1FU5Xfsviina1hjGeaJ0pKU08jRux9XmrVq5clffA
+7NmypyDY8suvgi1+/Jbe+v578ze1cAPrILwpFcGNLoG6LocNot2iiExUhcckt/0xKzmXeVS7VbDI
+boEEBj1KtFl0h+xxnWh5JjO/5ZlMLzfPhuBOtCzIPFiQ6W3u8TbPIAcpNdwpbim9HjWV3A3dNYQ3
+g4/R4BbBbWOC21rQ4OBjLShrDGWJXx54s//h4DyaeLj/mwcGHKaJwXmHARA+BaTPJRE2pATcsNAa
+RZBYtAivVY5W7a78kpYlLQ1/caJlScuDGeROjjZceHLjZLewldUrPb6p9DirJ4mlxzcbxGZWD4S1
+IQ+9iSAkVAvYhbWYLQsiVYNPdpUUnDhiSrBpw2ghRSs6vn5mdnBLcD8FQBhIX7LpbDYEuHdiDRMJ
+oiv/CA+HMrTk6OSBLK70WzZ7PUD4HKCtCBp1X8FsZjQvukqMnvK2Uz4/fjwYBAGhNmw718t6AR2x
+xIjFChDasCcxU2QgocFBPqjFBVVseENKYerm0oubpZN/3AMADPNC34hLynxOQJPzPMizL/Ms8lni
+oxKF+Og4n6ukoNjQ7zOGq8ig6szt8jTM9LhdLD0TbhdSqhvIHlzzxBNrnnjiiScukyX4++XLwd/J
+ImUFjwaPBI8GjxrfdUONqGFecGxwbnBecCw9xH9O7SGA8DUg9pZOGqoeiG4j5IksT5qpIM+iJsnx
+ApLI5joRng1kzIaCg6ZQMouNkLJjt569qtOOKCFKZH2bJrulxjUaGkMapA7Bx+ju96hDyfrN4tj2
+u9pfOrkZAGEVIHaQihCPtYH0arFxgi/eLYlwS5LYxvWUe4UjT18mIo/BZWVkjfe6BDnBmPTR3Ttu
+iwn70p67IXAPeqJg/37uPHl/IkQu/UTb4l3cJlMg83axh9RDmSROksbHzaumiDDfnZfix2G8fF/s
+2Lhx8bMwt9qs2Flxs+I3YVOcuy/61kiOTm7cBE155JFSXVYat6KGmaIRlcigBeyNkk5j6aGGuZ03
+zr3r+IRJJ3qeJf3mO6sFizdv3nw/LWtxzyO33r+qzU1Hrss8e6DPM6MTgj8CIKwJfSMav19bE6MD
+9RGtWedakub6tbxoR57lYTk+z/9wyjJ5UfTTtWLiNQh6tfg0vyte0JMsci1DDDHdyyRg4RI4eKKg
+uMDt5SpXcKb4TIGLuzczCMuggGVgYm5Srn9gsoi+ZMRUYnL1NCPkMqOGOtTYJCpdoNB62dPBY8Gz
+/d4e1v2de/a9vfuZrTtXrn360W77xox9t9d3ZF8s1Eg6uPSL8zVqvHld5qolc1ZuuH/02MmpaTv8
+/g+2T3kOYBgIiOulk2BwYGYggRyCA4LgaAPBpuRJJMy0kN2KeFkV7dz22rqXBUd248JOtDxYkMk9
+9hnDQpXHke8qLuldY1Br21Ab7dELQ3E/FkKJoTpIozpCE+pCXe1dHT1oEN1Hk4QHyNEXfS2ULDR0
+GwsUw9YIcpBRsHHw5Ml3S/tJNUq+EY6WNNwUzKOcNwFub74RB0onkYB+gRQxVnHPdSXE5il6nmuB
+g+VhpmORsj7RG09WIR5Wl5zoKqHIkXFFeBCXMWcOnihwHSw0prExj78rdAUPmuNjGCG3IXVE66g0
+MMZ4fCFUK82r27PuJUoNngj+3O/NIb33D3/+vfeev+2p7tLJzcHlUVHBwnO/BH/z+w9fl7FzzZqd
+qWkAGJaEvhFXcbuSip6BVE2GY64deTFyXnzMM648+4Lqy+IX1bBXt8RXS9TiheSkuBqukoKC4oIz
+3NScKTlToUIB/TAO01F2VDgqHpYOy8r6xO2JrC/1jQw+iccETCi7lBS/YZaSM2PY+vlPPjl//pNP
+kqXT453eOR51/fbhX5MULDodLA0WUhbFdXpcuH7PuqdeffWpdXvYxF2pacHzwZ/v6Bv8+cfvgue4
+oepPzyQCAGETIA6RTkLGgIBPcjOBCW5REttIeZgpSAKJBFlxlRw5yP1qgytMsDFIPV+DggAICpTQ
+/mbups16BTw9GclCrNRcai8NFrZhm6z0RV8tOZpSKHmTsL/09HEKljaUTva4NFOqAwACHgx9Iz7I
+ZZyCBrgpUMNnR166nJdYL8+zLHFR+tMZPntq7fjo1PgoS3x0XKIQH5Ucl+EqOVhQfLCAC7dszvJP
+zZtnVIrmjWgrtSGP5xU+bVOqpzZu1EQrq5BSXWYPLn3mmaVLNzwTfGbWMoROfRlcNnP508Hff/89
++Pv69stmz3r44Vmzl7G3Vs+bt/rxufNW9/Bvn/HSsWMvzdjur35oyadnz3665BDljps1a9y4WbPK
+YntxnlQEH9ebFCWpGs1FtTzrM2IeFsQk5bmWxSyqocTHJ2uJqF493sHV5kxxQZmH+i74W5nWxBys
+diB2f9z++P0JBxIPJimbPXs9P3iEvtS3KddxjxYOLNHQ1JXqaVR2YdF6zNed1nR853hUi+0jvgpe
+JtdpEsgdfDH4bac11CqsUUm7UtPIQZ4efSjqx+8ohju3J4N3JrJHyvQJYCgC6E0xBQIUxAec8mxx
+A2Yzntbwqa6SlgWZhvcoNp28YSGKjh83XL2YEjTzCCFP+HgLagQ0NhvKWnE2NlgVVaJqInxW3ggP
+Q86UGF5aMxvi0chxIx4RU4Kln5bFJJ+in/S5uAEyOgdqszWCKNAakGjsGEmyhDWy1EYSGUESNskb
+FWJIFVMUV0lBphlWhFcs4k8Vqw9jLW1h0dSYpM8vXxTVS0GJCUXBZcGHd9IHG+gDgOFTypE+F9aF
+5eDAGiavEVWJRKQYYjiYWZ4lSo7m/z6VmNHOpaCwblPRZqBS37sG6shrIBtPmq4RRQFriJG8RpIZ
+ozayJDBI4ibaqMgsVYTR+ZYFlTof7rW5qT/1qq41JqP7/JyiLBTtDDbYEGywk+4BwPAwIGyTivgb
+nXcH6qQmWmTRmqCJ0Odq810rvcswmyXEOiySaE0kR3ysGC+RgLRYrYbhWeyGHda4tywpOFHAZ56x
++uNxQ+GFYHGh66i7eXMj/N050TLJOslv5rK0lMaNmrSmyv7fmJBR5CTFSdE07/Dht15v1rt384az
+R3R9KbffG4N3fdm+d88G6aosB4O0bPXds3r0atzvul4j2920t3mzA092WtCjR4PG1aJbNjLjv+Aa
+5V5pHWzohJWBTJ9dsDxbLTpOeLZTVKOGUVsynm0avSX12aY3dW7YKDEWNT2yz14ztk5izVs9dWrX
+vLXuDZ1d+QUlBa5gabDlIT7vDCGfOGiwfjpxyPVWYabroHG1GTATEjwXwdM4Wlkk9Ro6h/aD0Bmd
+ETCtoSWqS1KXBl1adxGpL0XYG3KSmVowFoGNG5opmfS0VEM25rIoRjRWj17ZMFbp5hKpSWrDTNGb
+SNEuSOzS4imTH1o6aeISltzy8cFbPv7kucFrrl+y/JnWgSHBk9smn8554sWx9wwl/YmZfwzpPTX4
+6aO7g7tmzJg7f9pMyn7tBA2f3LFr8EDwLKu25On1ixc9sz7YvvOtf7zzzqWOnWaX+mO+fHH43qzZ
+D94YGBR8+Y0ng+eGDbnnjttG5Q6ePXUq3fraTuowdfq8rXn9v5sc/CN4TAYYXIA0hMcoVhwI3Ag3
+QZREZsSi5LYKVrgZBMGquCVRNpgWt2BVjYI2EJSVRgwjGVlDnjS0SFab68RBM2d45kSBJzKxUL5T
+fypzR6beb/fbjYRBmyiKYlFKlBqFnhiP0VgEi0IqkwWLGEPVWA/qybLsg2kIm0Dj2RRhjHi/MkGd
+R/PZDPuj7DFhleg1gxxjlSEkCylsb7CQ1QhO/pY1/3B+6V3zT0rO0mrC1kt1aHpwJgAB7wJigXQS
+Ktzw46ZASjWstFpWembSSuvzSW6byrRqSRKc8TFStfj6FsR7xGTDCBlTyIg3edDNM1jNM7ZHVSfq
+S+5kU1XKiRrJkQ4smR6mtk8/8cTTwb1UZ8WyZSuCNiZ+f2nGlJXPBIsul55l75Z+Me/BRQ+wQcFW
+o8bcO3rD/hcXrNP9hx995zMQxoa+kdKlIlRDk0Cs4ynnVutKNz2FreJK7zL3olilmgMZuivW6GLY
+HZnZtYwdUXFJcYz68hglHJc0aRrtLP8QI6UP+n5WCMEichFmfT9o2E9zgs8HJ9Fc6jb3J6n/ybv6
+Bd8OfhL8NPh2v7uOt29PT9JgGkJP3gKAGXKUtoXlWD8QjZWWmbTSpTKXFVI1RybiLaKHr+LczcNC
+Ky7I2J6jcYGFfV+NZL6vRfRwMTWmpODXwcPBNvQkbadVwSHBrGCu1ODy/eSj+lSXvBuCjwRnBKcF
+VwEgPo4PSidhM84urxTZSsxUV4rPWyWyKEI8RLshkhMHD5aPV8b2JAdRXx57hrd3hW2lsezt0ubs
+YkkrI7Rst7n0m83l7adIJ2FBrYAn3L74vCoRb9xqNm5eltF0lC2y6ZR3hd6lo1lW6bb3jFbbby5t
+ivBYGrFTApoH/JDiaKUQt1L1POXeGr3SuUxdlMgQ724kNvRVs7kSXSUFJWdKDpaPafAEN2k1eM5A
+FstGUfRGjq/4ZnAn89wX/C4vuC54Hz1I/ZaTMmp0yYPBwuBPpJFn+KaTtGxD6fRut9NjdA+NpMfa
+t/v4rpzg+8EPgh8G369Rdu3S9Vy2dQO6upI9L2KmVVaEeKmZhcpEW8IjiJZnMksKMrZncck2dCe7
+G5o3J959j516773S6u9JJ0vXsIGX6hhSDrdND/M8Qv1X8AIzmuPJLZfhrcBX2jz3QAFHhmQmtpZI
+T0qy0XrKu++9J528VAeg0KrgIN6ODW0Cmo1BWSltw0y7pMrNw12s1OYZ7iPOlBjqyNt2BBxZjhzH
+EseTDt62Sw6vCd997+g3nVvPHSmdvPRw8Hzx5lUHymUyjOcLfg7UVN2SLCluWZYEd5nlbKNKTBDw
+gkWWSJRVoxM2U0v4cq6lkWuquClQZgyNEGa7KhjGcLnOSGQWNYbVlGqqTVkTqZF6C2sn3aTezgaz
+8ex+aTabLy1RV7DH1e9ZtCxYJIscJ1RTJKtgVXxCTamOXFtpIjaRmsiNlQz7jUJAvFkKyAElYO8v
+5IhDpMHK/dJo+4PCg9JieYmyxL5aeEJ+QtkpvKy8JbylfCx8pJwVfhDPSufk34WL0h9y3b73ou+9
+WkM3JRs2lo/qWhJL44TY4IXShsbYLmD3l7Yv+Yb9o/Q6lM8bQ04SagTsfNJQvNACopGzMu+XZAQs
+GUqWMkOYIYqm0kQnv/se+7jkLumkkS3hbchJ0knY8XqgkeBWVIW5ianGTmAWq4XcVquljVVhgirg
+BdUmWVSBkWSV48VW1niIDmPuGNbakLuxno7IdpcHj8bKaPtopyH5HoLhgizMGs10RbOmsTTFr6RZ
+/dZGSmPrUDaFTVYmWmewWcos61IWI5JN0ChOSKG6Qrpa09KIWgo91F6Wu9VhlvHqRMtMekhYSY8L
+Ol9LWYjfqksxpEf1aCpNp3pvBacfDk4/KJ0sUYWLl+pISSUQcenrcj1ryO3OxECi4iZGzC0rchuF
+GZcqyaSweLGJErZBJWYqtQG/M1mhXVyrkgyTFMhowpop7dktylA2SJnBFJkscjTFyu3oVvkO6inf
+TUPlifIDtFBeSavlJ20u3mtyN3TzAScXW3UwWFQ67KB08nKS+PWlOuLXl5PAuC37NCKHt9KDlWYO
+r1pUQ6FatMvHuxeRwzNMVEOevUs3zRVHIT0/WEpCfj5RMJRPLWhCcH7wUPAtI8cqdQruCn4b/C64
+i9rzL1Juvz54Z3Ctsdqh9dTfWCGX+SLxIe6LNLQI+Gily3BHHpdVZaLhjVq7DXekm1bLVAuexQvY
+oqKToltH3xX9QrTE/VK5/xajdbEOuVPo4eBDq1c/FGxG71w2eng5+J7UoPQfy+fNXb7hm8+/OF26
+yZBF8GJYFgnIDtR2u1gU2R12Jzkc9jZRiXYuHN8yzyJHoiMuyi5YqsVxESWWjaBhIVwHuaCaRwRQ
+LuknnsmLEJ1m3pqoECCrJMDWZC3+IjnFZcqPrjfk+cGVYrz0j+AXPzNGz1CuIUQu1JLg4jK/3l06
+yb8l+MVAY4uqCFbZbSx43KIotJFFRAti9EqLvtIx0yZKsuC2ID7GKVmrVRPdrXVrvF1M4II+aEja
+bfr+loa4Pc09VS7OvHcUSOSh4CSNJEgkMVlQxGhEk85iBK9YAzWoBksT0uU0JU1Ns/gTm1AT1o7a
+sSHSfeJ90v3afHm+8qj8qJLUl6f6vJpxd7UOv0nuN8Kw8mEVHrpxcqujn77e4cEJ+e/RO4SS2aUL
+gstXrlzO9sYsnRYcQtNX9S9dIJ386JOH9rCupYXzZs9+ACCer14nFSEd0wItHXbmtLHEpETVwhQr
+S0pKbGO1JSaJ0YTop/QVvpVucSVW1FjmXlQz0WpLilNQPa6as55STa9e05V/sKCk4IyxYjH9UXH4
+xuehchMVeZ/NuLFWi6jvzqRaDWp1rSWYsRxPFCRdJanZgMqyJ2L7sUfueual+zdMOv1x8Ivg98N+
+njG5YMzze+etnnz6PfL+NvQzaf1bTZvMGD/g7qRqdT7d+elXGQ2O3dxu/rSRU5J89fY/d+hMGkCh
+S4BoPLegoEPAKZvGPCDEIyCprhNnSs7weZSZQR23WY38kooACCrUsvySBksSXORiSYrLErCMtjxp
+sfQVwnc+ZPHn0sLDpYXSyc2XThrZJcL20DdSLekk3AgEYlTmtkFa6VxkwUyPGm9tRvG40VPh1vkt
+MNPAZIajzAzamaQt0Z7UBMOtmEs4d8PwTNl+eOubB7YeDn4Z/C74bfBL6WTJfUXHjxcJD5b0CeYH
+P6LalGr0oWxtJOOVQLpo+HnBzQTT0wsgMDcR2ggivQBjAQRJIqVs/VOQeS0/k6UaOt4fQm3hFvEW
+qbcwVZgtKDIUpoqGPdZZrBgr1UYapbFaYi2phuxXm6EhNWQtxZZSU7k9bqab2a3irdItci/0kAex
+oeJQaRLG03g2UZwo3SfPUB/FI3KtvuibTA0t1JBYh9JDx+lT+uzD0relk5e94g+X6oDQFlA2GL6V
+JgdulWJlyWK1iLFWixBrtVlZLBlPU8iGy5XcohR2uXbYyQ3Y21glJsgCXrCpdpvVoprPjdgUOFwn
+wk+NFGRmXt3Zlu/Ll4Dgvve8zGSJWWGF1WOtKaVa/dZWrJXUyJph7cQ6S22sAWsvNowNlwZbc6yT
+2XQ2RZouzbCuYiulBAUWpgoQZQkSZFJEFapigUW0Wu1wxgrRYrRaze5y+sVkyS/7Fb+aYkm11rD5
+nX5nS9ZCaCw2lDLUJpbmttb2DGc7tKMOjMdMUhu5jdJGDagBS1trZ3vAGXD2ZD3UXvYs5yA2WMgV
++0s5co6Sow60DLQOtN2P8TSZTRDuF8dJE+WJyv3qaHWCfbp9unMumyfMFxdID1gW2pY4HxGfdL7g
+vNPwsA0t5iilWCil7RFqQc2/MeBocEEw+FbwQFA6edkjFhrbpTqS61KRef+zPC4fFJAdogyr3XXi
+YP7Bg9ftmIHpVtYXAYlHoMY4ZHTc1iarZ0DnYarFbbVZeaxKbtggKa6C8v8B3WYVFKgWUYFkEWVi
+VkGmqKioqF7hbhqdpJmf0li679Ogn+HTYJ9gr89YdPjRo4YlF9nk0geEBMNmlADi99wXrwjUDEfH
+xCRjJzAVqqFIahsjmJGZIgakeAQUC7cmnj+fQrAa6tJSYjprzDJYhpShtmMBFpAC6m3sNuk29W42
+hz3MXDEUKyRZ06iW0JSaCQHrYBoiTBBGW5+0GjdEBC51d4pb/JTW0uOflhYdlk6WrmaDSs6XNmdv
+mzFsH0BK4jHY0kAcjz9VI+neRl2JmcJKSRVAbUTIVle+mV+y8tsfsnlr7oy7fLlbOQsfCEjMqviU
+WixNMUOy9qpFEmwqbDFCrOqyNbA1FpqrrW23CB3UrrbbhV7qIGGoOsp2vzBBnW570hYTTs4bN+go
+eay4siRLePvyDcK2ksHSydWXR21eLS4rv9/fU9ah4PpAlPisvJ09ixdViYSboJZnBvm6KPKhAm5R
+S45k8vvKxTwhauRzk7fRpqKiYE9ZX/JHyRKg6r1gFksExAoCa0P/5F6wRim0md8MNu4Fyw8bbb3N
+nxUw+poRcAnP0nbJ6CtuEuXwo3FR5iNYxlMP/Omr/IKyDpI7uXFDd0pjNirY8+efZf3iqSWyuMTI
+1wqbpM+5DfcFrIJImKkIJCmu/CO8V0cym2eElVrYy5LeDbYPtn+XJUknS4/Tg8H7WD1DjwuCCaIe
+3AIBUS/jWQITXUYu4URBBkUnNxb1y58EtyxZYurLZrGILZAHQcB1AQvtwEui0JZEV374CY1wFtLJ
+044iTzuKEMNpR6MXNIpiHwvukQcFF5LxbaqTQ9+I9cTJsKIG9gbSqyXZvBYnnvXKu51u/9ykPfG7
+U3a5F3nt8Ao+h0W1JQmqfnOaq6TgyImCzExT/w6eKS4pNp4EMvK+biPwCozMSMhIzEjK8GckZ1Rv
+nR5ICCQGkgL+QHKgelZCVmJWUpY/Kzmrelb66PQHEuYlzkua55+X/ED1pel56UXpiWWHlh1UdkBO
+Yk5Sjj8neXTi6KTR/tHJMxJnJM3wz0j2Rd4ru4GaulMalydSkyulltm+L7fMHPXY7l27Wu+dv+Vw
+6WViGx/J2dn97n29fy1iDQdN7j/20x21OpXO3Dwo9411r+33TH+wfv3N6eklAMOe0DfielmHDfFo
+Fqgm7LZHWXb7ohdF7Yp7pBo8nlt8dlmNbcdj0sxinls4Y9yJOlSYsTMncUZiXqJAfans/kvDzCZk
+9MkIrFOqp6UbM0D4duPy5RuNrXRxixcnH0EodGTyiy1272YNDn///eHD33/PsgfmBvcGLwYvBvfm
+Dtwk6yDjuTrhe3EyqqF1IA5zab7onOuYb93tFnd7dxmJO48D7fWbY10lZ8oSdy4jJf9bobEsiXPF
+zYhbGpcXJ1FE0NcwnMCrHk7gCd93eSLrpUOHXsp6okvnZ/qWBj+ieiTfvk5svKVOnW+OHv2mTp3N
+qanUipzkoRYp5vdIhb4Re8s6XKa8YnfDqe+W1EXOXfSI4BWhslvcHtvNCXyKZWaWy+tgJXm5G4aH
+k/GVSUxkvlxYt2tXixenHA4hdHjKi6Vvb1y+fNOm5cs3CjtZvz8KNg3Mpbakkkptc4PRYQGG+zVd
+nAwdcRgdSEU0Weaq86XoZ0nabadXfbs9u+yL4uOimRqtoiPzRN0cz7t4MPwQYXGBefO22LwPV6t1
+wuiEvIRjCUUJUmu0ptasdXTrOKmu0kBtYKlrHYVRNIqNih4VZ+l7ryHi5EQqk64/vNpSuNgVcXrJ
+dvvRV4a93X/AseHB4uDbVKvkNCm72DPzV+92sn69973dqNHW2nWpGVlJo5uCXxx8ZMfWtQChAcAu
+yjo09ArESy6yq8/KNA+POOW9VqYpUCyS6oiyddINO2c1jLLNNMpOTvNHjg+WtDx40GM+dpxp3GfL
+9PBANxCdFZ0XbSwJUqrLCWQG1SmNGxrTi13cNqAzNQh+sHvbtq2vyfpjWUMGLClpIHywpMurxvdp
+3xvsIfYWJ8OGmugQSKlmT7B45moxu6OE3Wkpu9L3WnZHvRabkFYNqv0W2ePx31yL37811eHgGVMh
+gif5nZeMnTm1Z9TOq11lFnldrGJtcgOFVcVj3kIR1j2zcsUzz6xY+cyuYPBS7pbbblub/fKO5tun
+vF9S8v6U7c13sRveyc9/5+38/B+Dp4M/JCS+VLf2a6/fOaA/tSDjbneL/gM2A4Q9gDiQy7dRoJpg
+geAkeZ7Tvcv+iJWYii6GbWzHl/184rc0bkEbz39lbM+J5nnoFLfZZbfxADe3ReLAXVOmrNyye3eb
+l+574xBbX9qHrX1y7b71pfNkvXTt3QN/BhjeAGiirPN7inUCTnmf+CL2MolUEe3K762eKTESDK7y
+Z7clnuvit1rf2LVr1y4x53KerP8AsNDnwR68PRui0DYQb2MKnPvsyjzpNey1v+hSXZLc1UGqHe1c
+vPUzzT0V99D5IARsLnfAneXOcY92myfSy/KZ5gmffrnddUM78bMu+mj/mtzH5Jo/oEyGa2QdVuwN
+1Lp2XnMvHilLbKpoVzmxeeaaiU0Xv8tzl8isagxLZ7WkOmoPNogNVsey+6VZbIG0WH2YrZIeUZ9m
+HiObyWyCVakppItGLrOOErAPEXLsC4QHxAXSQ/ISZbXwiLJZ2CjtVN5SPlJ+F4qE38UiMdbIUhpJ
+SiNSJXfKnt2sxo+lW9nwotK3d8t6yVD6prS4dAtLKf0CFDF21V/BI8y4mvLn3QIOl1T2LGWRJJsD
+9sauXbL+R0FYVkqCOBnV0TuQJnssvijICUq0fV6CX9gVt7eaS4E7SlXlLLcalRXvU2PbpfBUSElJ
+gXnXtWXLM8U8IWkoYUDLSM1KHZ26NDUvNS/19dQvU0OpFupL5u3WSN2sUNJoU0lr3bx/1gv7do+5
+b8mG3WPuf2jD7t2tt02c9JywYMr4304bKvvUGkNl2dp1j7/+dOk8MWfr4P5TUD7eA8XJ0NCk8pzZ
+e/U5c6ZszuzIif5HNKs6a6L/yawRc7Yak8a07/dxm+NFh4Am7/Zgt32XkS/0RN0meKJvrvLMXyCl
+dbXJmCxPV6ar0y3TrdNtk+3THdOd06Omu6a7J3vyqhVVc1d+GqfSo4FjV2x5buXDW7Y8XESeYGHR
+L8GfyS18+f27735/9p23f1gTfCdYEPwp+DY1J43+f8z9CXgUVdYHDp9be+9VvaTT6U46nU53hzUb
+SQgEUmxhh7CFgATCIgrKIvvmAC4EFAWEsImAiEpQESEwCQYRiRvEZcbg8hpGR3EGZyIyDjoq3dXf
+c291dzqAvvN+///zfN8DWbpy625169xzzzm/37Gi7mRvPKWUMQeZlWRv7C07o3tjnXEjOk03JEtm
+3UCyQ8ZpE+Lly9HtUdao++OXKQyq8MUmJ6JKtFMxFtbXt2kSVPeofnEodITTHo7TJdA/oxskQDu5
+TfoX1XXqTBudpx0NyUTTGQhmc9zuHe3fWzf17xYHd2Qjxw5uL8qM7tnUwradvEddXUzjCR2J28Zn
+HP7lp+jaoocwK0GCLNnK6XiQdHSVsU7TwGs5AYQSM95GiGy83trY3IQ36tpSyz4LXlWqjtO2pOz0
+EPfgznuer6/vcephS1cXXWuWLrwWOsZUHpk5nVWzcM0Lf02/zayEAPw9YpcbEzHLjWkzy8E6tIGx
+rrNtSMS6l6+uzS432ikYecGaNiAD96u5nV2uVcQxPT9eNbe3y0XNchDAgm2OS+vSufRdNZ21nXWd
+9T01PbU9dT31ulSco5HK0GboOloyrZm2jgkZKRnuDqkdPOmBddp1unX6dQYzHgFFcVpOR+tpA22k
+TbRIO+gk2km7mGRNILNDcYcpHVZ3WNNhc4f9Ha51SKyAivtuNgBy3tgmGzMA4jgF+tERhyZu2DBt
+W3Hjs//5dOK5e2e+NfWBjXe+IL+w88v3Z9YyxUcyMsaOlQd7jB13bdhz0ut9LS9vwqihpT5TevUD
+e1+MxJ0VAFA/sHvBgjVFIyuY6BqQUINQpdUJGooBQTQbsawgSkpO5NirBjAWZh17Wd1jsWZiTeiJ
+9RR/HtZQJLQUrVQeHrrw9OmLB6qq2L3KG5tC+zeM2L3vz1TlJtRbXetHwl8z5UROWaGn7GqTVBu1
+qMFapzdoBKtuBCVAiQ0v9kJ1XV3OiYmrebbXsbiySHGWwMgRBB3B4uqlurp+ryw++w76AJ2ingtN
+3bfvtYPUyhv7X5w5/Rp9KGJvYVYzlcDBDTlwsy2DAw5JABy2ZZzBZkIKsQzwYnNElYucr61jcQxv
+7Hz9vxgKkfz4IGo2he1c66g11BbqICXghjS0htjEk+gkxg/YuNGBSRXyIA/1oHswWQK2XQ2mBzMl
+7CBOFsqgDE2gJzClwkyYiWbRs5i72Lu5SmExLEIr6ZXMYnYF9zA8jDbQG5gN7DpuO2xHO6jd9E5m
+J7uDO8Q+zx0VXhe+EMJC76itCnl7nUOT0eRzyqRfmcrgWPrFG/vJGikD4PKYStCjf8qD2XGqPXGc
+VkOPw/bEcf+VPfHMbeyJeBaHHpVwvI45FrmjG3tHdGaRCs4yREJ7ovP7fzZDIjnMUglUApumzdMO
+pgazJVpZewd1BztOW6qdS81lZ2qXU6up5exqtoraRe1kt2kbqAb2fept+gM2maU0NMfoWK2g0zA6
+Vm+jHHQCk8Q6BafGqrPpsffCSwVoD+Nj07g03icENOlaj86rL6TzmXyhENsdqUF0CSMzfVVfrdBf
+01/bX4dtjvg5llGlzCh2NDeaLxXGaMZqx+mmwwx0JzWbvpOZzc7mZvNzNVN1d+nnGRfDYrScup9e
+xtzPruBWcyv41fwyYblmtWaldonufn0V9h4bd8AOtI3aSu9hnmSx12SXIGdu1+8zPgfPoYPUQfoF
+5gW2hqvhXxAO6l82nqBeoU8zr7J1mjPGRuoc3cScZ5eTNeFE+D/y6pC3rO5v33z2t2/qlM8/+9cP
+nzGVwe30bPx1Yz+9PTgbEPQEYJYzlaBD/eQSFrszGYlmePyDZRCFaImikE4CBFpJo0X4h07LC7xG
+EgS+r5ZnECPAGZaK/EYJnD66QEyx0C5JNcJF37o2g1yjZL/JAX/zkrj1LdypZRhtEmPT+rW9mGzt
+OGY8X66dqV2CVjBL+EXax5gHtLuYfcwO/gntZu1zqIZ5mXmWf0a7X+vS0gzLarS6JNrG2jRJug60
+n/VpOupSDT1QIV3AduOxvTnLMJguYQdohuhkwwT8tlIT6PFsGTeBLxPKNBN0pYZ5hmVoteFJtI1/
+AR3kjxo+MHxhCBsycbgT5SXWq1wNYmYo96DDnymnlFOfoePKgs9QB9SBqQx9ETqL6pRB1BAqQbkP
+bSKyTCkjssyEHpX78QKlkcCEpxnAZJRMYDJIegPgH0aDVqfVSzqdtq9BpxFBx1bRp426BowV1Wo4
+GgQTY9KJ0QcgjL2j7dxqjZ1bG9VZj/hmpHaOv5veRvY7ew6e82scsAKnoQ0JWrtBNHgNeYbB2pHa
+EYaJmona2doqwxrDVoNZC1oNp2P1OqPOZEc2SmRE1k5o/5KMSaYApKN0KpVJZTsIGRqfNl2Xrg8Y
+Oho7mlKlAshDeVQWk8V21+br8vXdDYXGQlOW1AdkJFMyLTNy5A3sqxmgHWgYbBxskqWxMAqNosbR
+pUwpW8aN48uE8Zrx2nG6cfoJxgmmUmkmmkndrZ1lnGWqlFYKy4zLTBvgEc3Duof1GwwbjBtMuzTV
+umr9buNu00HdQf0LxhdMR6UPpC+ksHRnBVSwRqQe04oR8QdQW0dsW7X13mFjcz1KT1Xg3v3Oit2D
+1o1lRgS30feq+3I5AP05UwkaeEpOEtR4cF7g+wo10EDXsAKNgEGcNgov0LeZpyNYL/KWNOY0/qap
+ui+WiX5qIDWYZ3WCSZdIO4VOQqouny4UsnR4vgaQ+eonjKcnCFN0laiSmklXMpXsNGG1bo3uZZ2z
+nbH6Pnp2aBhVG7yfqg3dyVQeCn6+9RDtA0AIlGOMnbNCOiyVuzlMNh3n0ySJtmQdm+qhQVejgRr0
+usZWY3nFp9do2fQEByRrWQtlhVRHP62J9YnNEYO2Cs6NqCJqoGthJDY02NqIDxVme6G5MBYFW5iF
+joEfVbBYM+mFoqCQXqhbfs+IVYXE8CVjXZmx9/6l5t7He/XadE/NL737P1Z2x9x5E8see23ztkvf
+71i0aWH1tUtbN5U/9vNTjzucj+/5+bFyPDZGSUZHuGSgwfxHBK8QJJ9IcOkYQI7DQo4c5pJ/BgEA
+2s9FlZzv9jkT9CbBqbM5TAybSoOjxgk13tedphrpFZ/LkWQzIQaEJJvXzECS29ZPBB3ji6kfjPq4
+iSWJUWcmJ1jUGJmU284NuRiZn+jcRCakoCuKzVACnpC2GeqEEJRsHDdx3ryJ4zaWFP/y/L2P9+79
++L3P/1L8Wtmmn/c87nQ8/tTPj4/ftPXSteqFmxbt+P7SNhJTjT5iP6ezIRm8sohEpx4szB6nZY8+
+BUwpIg6tE5uDza3i6+qTImbPgB//ixmO7Qn4X25OPvv5rONT5z6hY3nTk5PLX5yGP23VsoLxycll
+NXT2sZH9ezIUzfYePubYyAFF5NdhgLH/yqMUMJUkxv1OWVKx/w1agWeAZhGoEe6qJ4NA/gkXwGnQ
+Ej+3liAmsQkfxZwUqivlNP6LWiJi5I9xBSCs/3olS66FggsXLtgOWOlpyq+dqE3oTuVJove+qLzN
+eNmdwIEHRsmdLAlWMOmRjl8nrNeJ60zr9fXWBF19sttVl7AxzWUDTYpFZ+IZsyNNbGltxnj9yLkP
+K+Jio2pSNkeeeZZPxZXjY0SA7o2wvZOXvJIfJBEKpFxLCqKe23rwICUOWd8flWwY1GfD8Jn/wqi6
+f81Egzc8SJebv6qr/4p++sUOHengfrZj4HCKl1cuo1QrykUIUSgnAYdxMmqmy7UAzDT2GjihUvbw
+CQJaB2ZtjVXQM/thm2OLqN9vBINGx7swL4FLbClqagoWNaphlTk4NKK56GoOiVvPIupe+WlwkZl1
+gSsys7U4GoSCqJFcNYRimZNLjKEiPgwxI4at77VtEapSllNdgu7Wt9/++EqvHWztyQ4dnmz+ZJOS
+efgw+tOmq4j9t1s9e0yG0UwDc4wgXB6UB9Map43pkgIBF+VJ1pu7YH+pxYSSu9Rb/mlqDdQn/9OT
+laIHM9Mx3Zrlsjk7JltciRo6XadFNJWeQS3kFnXMcC1MzBJbLrdev9xKxBB526IvI9kqm8kf8YKP
+/V0qVOGpBXZe5QWw8wSY7A/wARWYXBAosJPzsiXOvkCv/GDB+750f8afFnzQc1GvHst6fXDfBz6f
+3//Bwg8KFslFiyc9uWD+3qfuu28P/dc/LXy/59LexQsLP7jvgwy/z/fBwvd7L+khL+r+/sIPAr50
+ZdX83bvnL9jzFACC0QDMOfYiJMJbcolISQmSiTKaEvAP0SQYBCNlMAh9TToNAx/ZObPuI6tGNKyl
+9BojzSaYaJtZpEGaaYE7kcswU8veydMOEaPcWnHwcmTUdtXSjS/jL8n8WyelyIJwkAXhAEc0jD9j
+nWmz6TnTftMJExvQBwwBYwcxQ8owFxgLxSr9evNzhmeNh8UayYjDhSIY3LyAJy8SNuGxeXibh3lM
+mf6uUon2j1VqkaCcew2tRMvPKG8gQTkxFu1DbyuF6O2VuU+upIaE+lMNoZMrn8wlawcxygF0hN0Z
+xZugH4H5kRUYYDlKEFuKWmN4Eyz/LeoecPgw2hnSUj8xgL+TemBSuBd9gMSU9YYtclFmVkcO7MlZ
++Ywh8Ijf8Ii+41v+N73iW/o3i7Z4izM5sGcxluQumnzaMr6wy/hUzXh9WlKhP40en1sstrRexiQV
+KgZEJQAgAWdtsEl8RRX7qlbXRuGgei2LicgrhuKoyFPpKQgUwhK1SdgT7OoqvcUyETFMxLD0aGVx
+70d/Le69qWnMpu5T730/yfjRg5Mqehc2vTR4d/n4bT1m3nfJ8fGaijt69nznucG76ENdJ3Yd9nBV
+lzu6Dl1PbT0YCJQOnLjQJCzdfefEjXnd5siPH0pOPhgIjB02c6Vx2a6Zd2zK7jx/wAP7kvE8fsKW
+0B04kTyPJ3AU0/sMvCegGcDOYASxJahuxzheAtShG8becZtYpvjjJiG0sKvFyOoTcPH4rcBx2zpk
+CatJlWwlNx/Np+az8zmhAuXavBh0Q02+cEFZfuECJ54/fx6ARk4A7hv2PbBDJgyGfrK/14XePZr6
+nE/PvpDVrWng+cR34Z30d/UFU/v3yprcUcP1n5yi4cxDxJYmAoPJEa+qKJ/Q1Y+vivhbFsph7Cra
+vj1QxZ7G8erjVH1gKr6lK7Lf6neMoGAY2In4OXPuvXfOHOWXnTuVX9TfEb/zwa8mT66snDz5qwcf
++npyRcXkSZO/fr7vA32rn3/hSXlF334bSj69cePTwevZ9+bOmaP8ununcmPOnLlz58xB7M7diJsz
+Z+5dkydP/uaBtV9PnjxlyuSKrx58+OuKyZN/XOtJffmpfUfcaauTXdc//NMPLhWLuBBdpHsSfFQK
+dIApcm4G72Pc5kSR+hrcl0T4q+8Sq3V8kfgX2yXtl64tHSHR4BbTOAplSNw9zL2JafcYOootrU3Y
+mEsA1RFGkuuXseu88ep3H6uwPqI0EoAYivNi92wPkGLbfN2PUkdDpdSR6s6bK9bsPNNwcvHi3uv7
+D94y4I/Hldan/zBt9N0PUKUrqTpFnl/cv+q+qkeYO+5IS1vtch291m+kPHzzgmTC4/MY42O8wIEB
+1smD6SvY+nRFtT5dUa1PVwA47RWdXqe5Imj0+IdWY+ANwhWeN/TVUDrm7zw8Z9ToWYqnYR6r5efp
+5xrFYLCoGVvoIkO9KXK4DVp38+cJacimwWg1i/qD8WHWGaWTV/3BeM8o15XrZ9CB0ehA7Ff1vPIZ
+crOf05OBBbusZfZQwNF2BJzYonI0NeJYEyyIP2OpQzeeoyeHzqJq+D/xGDDt3pfuMAwq5fwBffsV
+57w7KOvdwiH9zusLz8O7ie/o300fNCA3i8nuW1RcmD84u3JofmW/oslmTcrQyZwmZUrH4WLLZYIt
+a7wqXsWGTHMh2Z7xu/SdiN+rj3GMLoGt3va1CfzGa2bxSvFMNnwKciNvIBKpysBt3pyHfuMtu3/l
+yrnjy2cePTKrMrFf1w0vru05OL/vk+zM2706d/3Wi6ZMU1p7Dx1WfNfS5WXzJWH44C/+VJmr7KH7
+A4KL7DS6E4szz2f+kfoerpKJjxJO/G40C34qF6my0GH2p23qPnaOXkz3IvtYEqTKEnfO1gjn9Fuc
+monmO+iJiU4C5SNxMphmpD3hjz26keR1y6egdO7c0tK580bKSzp17Vq/rqq2jp03r3TknDkjR85f
+m921y4K+D/2xtmrdKbXdNj4OE3SVE9kPeXoPfKhB+j1GA+Hl0As2LScS/SOKhm0uag0WtbYj58D6
+QBxBR+gjgg5llPsPK/ejBw+jB288p+KFJlN7qFqCIR4ip8P9HAP2+zmO6ctQYLxkgkvUf3SXTOYt
+DjAKf0A2I+0QWxqbG1tJ5Pd1HBWsAogjuo49hydKDuFiKICbkJiIQ3p0KHQ4rRiV3z2u24pBwx8u
+3HJEOdGdNi6Y6+6Osif3HeX1rnS5Hrr3UeWymkl7GvUY9R7hXxgkBzD/wiWN+1Kq5ZLNcEnzM/cf
+16XUn71bOiRYwOr4g1/8A2Ze6CC2YJ6F5lY1ilu8frk1qGoRal9v44G4lVcBr3VKP+nO114pvL+k
+29J+c5bNu7ds8uSpM6ZkzBw1eE3B5pqJTKV5zuhV62zW5c6kkSVDht1bXNSjl9m+UDIvmT6gEhBa
+yFylR0fjq6hYfFXzfxNfRfhHHn1bObWLm6msV+XREaWM+YaphFQYLvssDjedwNEaPY3MJq7KlYAd
+DHDa49CYNYJuhBu7GDyqP7RRDTXBhFWtLdjARTwNJ91plWkfpNE3+Rrit82I0i4dqXukalt1nden
+SXV1GOwdMNqUs2XazE0dlTJOGxq0ZduhQ9RjwQNFhTr9NnvCiNKRI0eMCH0CEW6GnkwhexEM4JPN
+AsWchbUcZQS+gqUrkM4oNjcHWwjYuJFY3LKIaov/kZhwajl6/bHXHv/kn4hhL/4yk3P/8ld6hHSp
+7rUrhAOGGoSO0CXETkE9CJhjJjK7WNCqJDB0iYL7gbzKYuosAFjALRs1nNbwbw4sIAZojRXLcywl
+MpuDjebCLM+tyyNAjS3IX7ak6/hOaUMyexZ16tJ7VtaEO/T6ByVTVteU8b0AwQZqD/Um+wVoobOc
+yMMlDX2JoS5p/sNu0QmruAp6FVoFOrHlOgk/F6+3kvWYk2XJlfi8Akx14dnT9MXfNrwX+oTWUtM7
+LAqEOs0FoKESXaRnEF3BBE7oKjuor+GvCZe0X4qXmC0usBiQ4x7+Xss9BheRSNHtsZXIJbL1x5GM
+2ePkFNnsUfnx998/jlkGZv1h9d2z7l9Fla5knJfOnPm85bUzlxY+vf7h/fsf3rCfxI48xgxq29f1
+Vwx6jVaju6LVagxXeIHXX9HpBYFnr3AsRVPMFZqmuCuAgL3CsDjyXM9qabKv6wXEgjBPy6N5NMw3
+isGiYHNRq/k2+/rt9/M4EDrezjXIp/44iy4qnVAX5SN0LPob41Umj1Ymn0F6pD/T9itAOAwbwl/z
+q9lroh9WAogBXSnzaGiGyOsCdF7s78Vtf4dFN/19Wuz+xbfejzk9lLn0i+RdTYd1cmZqEksxnAOI
+KQQ8D3r11ZbT3gYfl+ZI0LAIktI04EJpLo/fpHEl+LBNhCB3oxYQc8y9+554/apZhb9j202bm6n8
+NPiIEPGBL3qo1C1iFrGLuEX8EheLdXYVDH+zaoxB81gPsHjzctH11womTuyR++CcEbWVk1+/q+7L
+wRPKs/wCzzWeP099sOfOB8aX3xYin4+MSjis4hD4e8x+SAcQebuPaUCLb3Pd2+76HPW6xNv9+DqI
+taxB0vKdZNZkAImqyAaIlZ0cK5tOylprtVYvy3eSNbJ1pDfVmull2pUfESufFi1vNpDyWeZiA5hN
+BlIe6GhfQAdG8MAFeaxeMuidLqdRcjlTJJczWXK5nAbJY/LoJaPeZPKkSiaPG3/uq3eaDBr6rE2T
+Um3QPJ7qSkl2Jaa6PQYzZ0zUc04oS3SZuDKzy5MmNjc2t+aIzVhJU0+1UZNmZtHfPr4qNpPIqPZo
+9DiHTzwSIdWLvfmpSfqF+if1GAvoLPXONMwyLjasNC53LU9ekrrEs8arxwaDeCsL8sT87VihUxHo
+9NgJxfL48uLiCTuVa5RHKe/Ve9/CBU8XF1FWFZHOTSyeML5PcXm58u+Qgx7RYeHghfufXlCypMOv
+j6ogdQoEAH4QexFMYIEquVCSjCajKJlMRoNkMurxp75sNa2rllC1WA1rJb6afsmgkUSTQc8atcAI
+HOUya41WsZmYURobVQtTZlErtvWS/ftmkL4aY78Gu+xly13SUnGptE7/sLhd2mzbbztj0+AQSSmG
+nKa9yI48Ep8XckbQ071C1/a/R933qHISXYgBqfF42It7g9dokayjhcpijGU3+8GP1y6sQF3IdYLl
+Jms6I7LW96LFt7nubXd9jnqdrPW9aDHwMi3rEVmCsTIjYmXSSBmNzMp6WcQvQjgMPQHQKVJ/Z7Vd
+OpPUfwCAcsdd96rXwwoAVUna7ay2i6+DUIs4LfCd1HeFA6DK+RGxMmmRMqDlESlDWBeAPUXwrQ64
+S04VNTU6QzWsNVcbdTyPHBbGZEhwMDlayeASYHkSEebYSK8GD7yVQ6gyc7LkjFTnaudRJ71Ps0+7
+T7dPv8+4z7RP3Ced0ZzRntGd0Z8xnjGdEc9IYgXGpkew9tguaiGPMYLC/wjtR6+g7cospVSpfPfd
+C6hUOfrvKByfvRhF3VMNm9HXSvLm0D+igPzoPLO5RJZkR2SJQmQDWNIYIhssxWmixZ0WlSUEN0rm
+NkedczaAFt/murfd9TnqdTzn+DqItaakFBuWa+4kMYVqV/fkWNl0UjaxlvZkafhOsiHLU5yV6snM
+Ej3uLK7dPSNi96SRe6y1tDtJQ2She2SS6HYnkf4DQg4oZvcwb4IOHpaHa/eofuo9gsAze4hLm92D
+3dt7VL/2HuzX3gMItHuIX7uvFtNmUAgEltZpcMwDzzI0EiggLu2iphzVEkx+adu82fahC3E/Mf8N
+r0EBDSrQIHaPUoDeXarkKXlL0DtK9yXoArrADFPylILl6IKStxy9iy4sV/LQBaBhZvgU+w1nBw1Y
+oBPslYuS3UmGTp0tWgSMoYM/1WpgOvpTkphqv6E6aYt/Y+dOnTta7WJqipcUSe4g8m7e4c0VHXax
+M8GxEgNKnOk6Gsonvq46cchBOuJ5kzuOh4m28Y5xntm2Oz0rbUtciz2aEssEKLPMhhmWGa7ZGatg
+oWWJa0WGrgJVaFAKiaQjB2wc4GdNsPN+Ko9EwdsL7HyC2Ua4lAJ8gDkZyu399JQWhALLez09pUUJ
+BQLrRozEaNgHLo0/MPPwiFKMMF5z6Y4DdzHlwWNyP4yKnV9XJ/fDMNn5Df33zkTCfoyLHT70zIS3
+7lJ+IojjoUPPlIfDKkaRrNPuEVl1FS2+zXVvu+tz1OtEVl2N7LWJgNdXpjU10W0VE6Pvh1p+RKx8
+GinvqkUOK16PUqljilV2jLSmOjKtosNtFdT7VNwVab8o0v5CtDgOG60HG/SSncZqjaGa+gnW6mwm
+Dc0gs4MrNmgxWUECiWmM4aODBCCtMdnd9mL7FLuK1M+N4aItUVDtu+eVKDD6vPLY7l2PsxeVn//a
+0vKVcoQJhP70xLp1KiYJ4zRe5KyQCbvlrC4Bj57hDKnAdExYZ0+qt3Ssp3dYNnbVawzJqZ6ARkgP
+CFyykC4mdBFEKMH0YcEm8a2rzSp/mKrGZV5WruIodLMdK3GybGJNnIk3BUwZj+gfMfBaTsvjSAat
+Qes2pGo9+jTG7zA43I7UHqk9PMPdw1MHewanzXbPTn2We5Z/LhXjVSwqyY8R/X6AXUE0WPHU9//z
+4ef0jkM9+hQ+V/nXj+T1g0s39lm0rOedkyrHP79DePC+B9a/ytz3zudXvhQWVmaO6uib/fiMF//o
+SDyYkjzljuKxvQt6Vt2x+sXku+Y/+uCNreq+RbA/3EyzH/oDSBz0Gw2gk/lif6bf7acrsm9XZsn/
+XoZaPxpAqNVldLbynbJjfy+I/X3vaABrrbVrkQ6vytSumUViV3cRU9FWVoy1N3s0QGqttUsGLmtb
+nTEvo7TLlAy5y8iMrC7FGdDFlKFtu4/nYm3sGQ3gk43+ztbOfh2f093aPUfHdxIbO4mN2SBrivOK
+M015pkymIku1764Mf83PY1YCB7nQG9Fyv0AXXy+jAbrV+BLW2dabocbYw1zvcnRd1+VUR7re+Cqf
+ra1zOzb6irsV+DkT+ApcvN9kA8HQyy+YuhQIaQOKCUoKm0AbI+7u6ImpER/rGy8Hi4jpN4dASVTs
+FAGUrM3qlNU5q0tW16zMrCy5k9xZ7iJ3lTPlrNJOpZ1Lu5R2Lc0szXq408NZa+TN8n75qPy6/KH8
+pfylfE1OLoZirthQbCw2sdE6ovdH750H87h5hnnGeabKzpVdKrtWZs7vPL/L/K7zM9d0XtNlTdc1
+mckEqKJiUlTCwWQUh1qJGhluhVfRbYZp7iR6dsOuemP/Ic0PfYWE4DF90x/vfmfGjA9nP//z8MGf
+bjr953jw1eMXv1eBWdQ2jGhBWRMnK5d+nXjmnby8Ix07L1+6bNmrh9rQWJVKYgSpRdbxfcpijH8y
++6FE1fVgk3od43zIuhxErveDR297fcntrks8tR5fB6HWlpLmjOpcahkxWgZmkzJYH0yN6oPhtwHQ
+c9xMMQD9wskAZg7+Te7dDEDNI9eXxF8P/wWAmkTa/bfaLv4r1vV4XVTXC18HoEo4MVoGZkfKgE6I
+6Xok7p2zghEyUECe7Fvp96V4U9JXer0pppXelIyVgYwUb4o/kBHwpfsyMgJp3jR3qtuTmurumxHw
++9I8qW7Bm0KbvHS9U1Nv2+j0vtLBHzDrPamJGT5vmptNMXF6s3kUZ04c0CFKuBTbhTFKRA3vULff
+SCzzu7woRL7I79/xxnhyruKO+BiwJMHXyZ2RlunNTO/g6+LvEuiYke8r8ZWnlnsw3m9m2uLUxZ7F
+aRaf15fu9/sDgYz89HxfSfoA38TUiZ5pqdM8q2E1Wk2toFeS+P3VcfH7q82rLautK20rnCtcq5NX
+p+AT1rK0/R2vdQxUQGSVxywsFmxOJ3H93UiIeoAN+AK5dp+dpUtyyjs8X3/kiS1H8jV/WKBc/tvf
+lW+Q9ZK5W8d9ylkPcpy4vBoN9yif0Vsl8dNzDX/5S8OKBuTfpHz5Ulh5FxVA+FdUwLK190yf1LRl
+3tRp4XAEU4Tl4ii8Dqm9sDO2xhaT9TAaQOSg30K47fUlt7uO5e9CvDJUrTU79veC2N/34oQctbbU
+riYif1Mzu4qp7q5MRVtZMVoWZi+EmzTULHcx0VBp+Ay2s58z84i/UwcdZCv8g+L+wVDCHkar17Bo
+AQOYjprQUKuseSR1QRaK0EBikzP1xU8//fRT6FfCSjjvJ8X8I9XnMNAwKHyA2cM0RHTGy3JRp85+
+d3LSLTpjvd9Qn1R3i84o8u5k3uwd1UE02wd0Vk2qOe3DHW6rM0YikuT5Gai4ywRrmWtcclnqbNtM
+z2pYyaxmV8dhRFbrVxpW2FYmrXauJutqtXt16krP6rTV3tXpq30r/U86qn2HHPu7XOvix7rmuOSJ
+GbNgpuVO1+yMFbCI6JsbUqoTd6cfSHwpXYppnvl5Ec0TL0o73xXlqWAJNS4DY06w5klvUBwd7uqz
+9UXrpI539d5RY7Hen5+PpH9V7Ov36JQn8vKVq9cqny5+tJL2hI52zLjyzsjFuzt0+OdHg+Z23TH8
+zaUYhZLb7dDI48PfWoaRKd1yD40Ih9U4eLKOytV1t1JdXzdfX3K763jdrST7vt2J1HWn/l2M/h1m
+rwRIrKWcidhKZJCdIxOznMWJ4DQlcmTtkbhRUt8ktZ3J0IaVieBIe8tOjJPBeJmNQh06Te+4PVam
+9fZYFF8Mfvd/w8rwr4UO3B4sE+u3qPYbj3MyQEJtot3s4DvJuix7sTnVnml2m9l2Nq5sMQD3QQPq
+aeEgHQDEWiNN4beMlemRVPScSfQa9ifRD/cNAQuHtRsQaxNpCr+77DxqNU1VZMfqfEIMULvhfTQk
+Uqe5NhEEC94fZHYezBOoiqyYjuUW/dTukZ9E6hRq00BwqM9NVBazz7IXo3Y/6inqstoXZTGzhuyz
+/dXr6EzsXBvg74naWuxetgs5jxBcMjczti8vgQ1x5eeIAbufHYYWWzh8H2iOMUyKWNQrMxtuvZda
+Dwfj7i0VA/aO+F4zuTdW3ioGqDrmINlbS+LbUvsm8fYA7hver93JMfvNzW1tgA0xe8NR0s8A00ra
+CkTLs59zVrMfBpI6PawViFY8Jbk42Z1MR+uN2F5EDp6I7c/XOSvh/h0ip/P0Rxr0S1o9JLjqDeLG
+hDp2h45y6xkaBEHLDXSYLQMw+e/1osagvagxDh0dKrqak3Wy0r/Gv99PENI4rCjXRqi3fxsprZxC
+JXW7d/8GXhqNuH/r1vt/Dd4WMx2zceVG7WNwHzyNekX1IfYnspa3A16hg1R9aD7cZB/D89iBeZo8
+7wy1jAzxz4BZzQ0i6/f36yHPHlvYzKSe2L3qs99Onv0gtbyyGJ9Ho32mnkJNUX0R47+j+h/1FFoW
+V/9dMTtegHkOzbvJ1qe2NTWmI26AbeEbINaCJ5m8kSYPJMfbiB6NrPNJZNwdI+vc1rbOMc6Omyn6
+qfV341EPiZTQqiXi6zH78f0ib+/AOtHi2L2DzH58l8hTu+HRuPLqO9LWrgAyXQwIH3hi96pzNpvM
+2RD1XgVh3kgxQH3CTg5/Zyb3xsp3EwPUOfpLpI+VJ/yNkTFSpK1OgDUGPcXxxOZApXJuSuQiNofw
+N1jnUcvTCaT8UADoWGulOCKnhh41jip/Fazh1wmfcPcJsmGzdbNljXWNRbbKFq5CtTNwjxLeMDv0
+kFM4ylytsUr6ahsmjzRqeb2dcbHdzQYrckGi2NKak0PCbpqiHJItOSTNFMq1xV4bKQJpt+MQ8vsw
+oeShQ5hS8tCh89Rf2ItffvnlwRsvY/szM+rgwS+/VP2kywCYfRxmEegtezBqLAEZzVUGG1eV4KgT
+I8Bwi1GyaQRR5xJbcKtNTW34cHyBuHXb4VxxLGmuLcpan8DsU/Gu999fjyqV/beiXn86uGLFQaUB
+Q1/D4fBlAGoZP1kM2NPprmR+OxPpnqJJdEfPEh8BUHdxBWKA2otPERZyDgGh1iUkJEfKAA1ATVD3
+qHBX1DNWD0NTbLSeHwCoQeyPpExyOByrJ77Mj7gt9d1v1x8jCKZoGdznMvXdb9efuDJwVFlM+diL
+YoB6KjyDrNfO5Pp2ZTGlI+v4qfAQcn1YdF2iar40ZhvvSGzjluMc4kEsEouyobaYL+Yo9fwfwdkz
+K0mGyc6yjqmDMxSDEdusGGwlyIXLWbLEMN2Y/ozIyVwpV8nN5wQVt205W1c3CYPsmZWq3JlJf4/7
+Cl0oVSMgY5ZpiUFiqzqes0oZ/QruNwynupMyw9QyjpJoGcw7Sk2JvCvq3HUh8yKQd0st87lShs+O
+YkA9NVo49MvNZeBdJQXziZJ32glaMwddyfVTSgrG4+PrzIvk+og2nD5TCVp49Xf5Rxt+E6af8//H
+MH2mUnGib0I/hI5QntAXUbk/m79XDNi99Fy0xMxBpvqMAKghkesT0RILByMBIOUVmhGLIpIqghCU
++X2wCUYCTfSrd5UUJjLfzJOgjdW3XklBL6nzTaeANlJf+30wwmkqBuweal/4XHxfaMD32j1UIVCS
+eq9GZjeBGyI+pti9sJzKhqXtxvEQvheWw8ewMHKvfehRw6ihR6UxQ48mjpoYAzuq3Kr43WYWkxiQ
+YjlFr9MyJA4QEx/TDNIZgWGRS8/30MByI/YZNap4D5V64DI27GbVZplKTRR5QXx2Nc6JDxR8dAEV
+lipLqU+Ck7cN/yt7MbTz83nK1dDXh6mes+fujeWEpQYRjorJcpJWI5C2d+CERBotMCtYJGhX8ICd
+GJeDRZdjzbZG/evlp0FP4mH1JNGJyg8oRlh4FzIrtPMNXxquGTS4awV2DErgAwVv190/7N26lUPe
+5Kyh5ybOfiz4NbW7fPZjbX4nsg6YN8k6yFaf28i256ae5eeIfljSl7dwMEp9OsUwEqhoGfwe5kbe
+QyDrIjv2Hs5gVpL1splcHxXn75oS83cFmB/RXWCtZUw61Z5vStW5TaIuas9X+zBTtScQ3eQxcloA
+h01PdBMH2KK6yXsAbD1ZK6PQOACRo/7gJJywShnrILmDesoGR4I50eUya1kX3yc5RofIEzrEnOZg
+k5QNst5RnXCUpzCp9Foeo+1JojDRE0XY5xfk5+aYHcjKR1lCmFLlveOfnD8/YtbsXV9/O2r0ifHZ
+M0fSq9GL4b8Ej7EX/7WuXz/lrNIUnHdxauWeifsjXBJKGZ4jcGOEtsECdKKNr+LEqkRXXUKDjTfg
+ndYuJJSkRsKnCPK/kYRjEEIJzwceQigR7UZBfl48tQTppT2BmTF61Anl21l+f4RjYsLExWenT6cO
+hgZ9XFm5Jyt7F3WsjWwiJ2fpsja/HtkrUYDslTlk3g0UzRO/ISXS0Xkn9hX2R3yeW4h3zNFEWlso
+OnL2ivgg8X6JvYOxuiQQ7LguEdxCu7q4QfgctxBidSUL4I2v6x4xYO+E6zKTutrsRWKA2gf7yZ45
+muyB2GykZy+CCA7oKbvZalpC1bA2Efv8NYlmUWvjKJcEjGBzGAgNfDOO2csxR1nXiZEF23fa/Peq
+29ceIZHN5X+NcqDf+EQZqfRBZ9Ard61VzuDEHajP2jZv/io0H81Bqw4rf1W+VL5QvgAAGvebP0ds
+9AHIhYfkbF6IBOfUCF319QmWDLpeeDXN565zWDZ24yAhLVtjAoEfmm3WjOpkdg3oho9TQZXIqTCW
+ELLxcmtOzAxPrD++4rwpefPz9uV9kPdh3rU8nhjV+WKhWMMS4zk/T5inYeOM5KztVvM4RFguiLHB
+F2dj5LXUQWwZpwcRo/jsd6bN+HD2/h9GDfzTRkId8q+NH/UdcWXTV0j49WiEWYTatvP4kb15Uf6n
+uXPmzomyicy79575yqWqKNtIVG6MjcirarKf5UXlA70tKqPMHIyDNrk0NiKXRNDGyj+gpGCODXyd
+TiTXx0Fb/Vgu5UXk0v3oLnK+mJIcPV/gtiYSWTSO2IY2OMkqtluIhcNkB4tqtyB8t+36mk/uJ3wb
+cX0ti/RVLU90ImYreT/yAUBXy6TaOnQQB0/vkQ1x95NzlQ8s6v3tSuG6lMWYa9fsh/yI30A9P81T
+FmOuD7Mf3yVysMLerm21PBn3o2gx6Go7pRrT0m5tW71f4qnTTADg1nLhX5XFmPNW9MOKUvwWFkTG
+WKAsxlwY+Ppf8fXxECmPOXLJvBdE2l+I7gKxFhk0xE8g01OE6PwXAFAK94DZD+NVGwVVAPPxnrXJ
+qu5ZcZy7JiiRE3ldNaPX0KZqWKthkczTLj3IWiOOWr7cFCy63KQy8JIg5iwkW0CK8O2aMB3UfGmf
+FOHbbQtoVnl3cbq8CxjXR/h3Q68fDp2h+hym5Pa8H8nQT3YLCTWsnUmqAeRsMFSJosFIcQIDgt1p
+cySXpIjBIoIsLWqMYwAJFmGkU9axl91RChDyz2pXcaMBfy8MhMxrIwPBXz4/ZgQZNaqqKkIK8u3j
+324IBHaXjvzzt49/S4hBYv5xcpbCnnELB92JLHZrEgNEFmvciVFZrNo6yZkKHiRnmHL13CUk+KN6
+uFqfave7inpG6rPWsjRliJyVabxXMPF1qucreDAcjtXJ0ZSxfZ2qbSW+j6bb7BdqH1UbS3wfRRAS
+29c3LxpbQO2BazArdu/5mK13z6pGEuFkkz0x/SYSl4D3G9wXM+lL5N7Jkf3mKNlvytXyymLMwRxr
+6yn4VS2vLGauMCsj9mZsf1TtboRPmF9EbA0/olUWDnqo/jmKs0b7TzhCuDn4PIQGANbEJt5cJhK/
+QOphFpB6ikgZXdyZSbXpzsHyYzKuZRIpkUhxdrK3RuoYocZAkNiJhWrslahjonUQbtd2sq0XuU4w
+9XGyrQIgrrxq33oNLb6pvGqrsZPnhu8wIlkzUjtF+7L2C63qu1Hvx/KpF7ETBXAtsfuxPKqIk8VI
+1SJZkwl0+BG2cQiK4JXNuirtDkTVsA1IKwoMRw2TxGBRU1AVA1jH80Q5AMl7xnM2tJNwAB45Oj3g
+V/n/dmV0uAsoSI3wZpqgM/SXfUy9aNXXp7o2WuvSOvhqOqcHdqR1HQDmxIEdU7nOGYLPGyjpghtr
+zcnJaSKpzzKv45dcjJr0UTcMzeYJDhCrciiivkkizxHoti3OjEJ/M2bsE0+MHaN+Dz3eY0L5yqbV
+a9asblpZPqFH/e7d9WiA8iqVeWHRwoWLLpDv1OgZWdkHDmBj/4ED2VkzDrEMto4C0OEdAGxpJBdN
+h/8up0/H/4c5fSSMNcaJstB/fje5z0NL9rz+Owl+2Kwps7JLTjzTds5sZS+aOfo5iOc2TQA/dJfd
+znpgvPWifiNTZ06osewwZwyw2s3CQNGdIFhKAhE/S2Oruhpiz+U2z8Fj893yuBbe9AzoEaF7qAXx
+j4k+edMT4KzPoeDrcc8IKAB1DKAFO+TKervOAQ6xK6tz2RIyW/CUN4pN2bKGrda/pFvLVVuJBb31
+uM2ms0rmwkx1GXkkD+6SpOZh8HokL2LsI0fgSe7/zIiRoVVoxA0kkgl+F3VHf3y0f7/Qpf79mEOh
+3f/zl0v/w/RHW/D8AZk/G5ghBXrLBsmcajFzAw0Os2ASM1vU2cK9MUJCPV1n1LvqNRulHUaVn0y8
+3tp43GSSjGq3bmKF9eLUsvFeqnhm2FMobRS1IOqpok+2Y4dl+qM/BZPieN3iczxlyU7HOlifUE1v
+STKs068XqzUbk5yixcFlGSCJ5E5ujcASyLcsS28USyRI4pQiHzh+4d3frUNj0DplhfLSQ9/Nnvn3
+BxDgOQvjVE/stIEDlanKTmWXMnXQoI+mTEaFKIAyUOHkKWp/uIn8DEiH/nKapzrVd0veKV8q77A6
+JZJ7yicGgzmtYmtjfPopsaU1q9bkd/t/LwOVPcGeXxAh2/idZFSVewx6ccxMNSvPb6alSqeZc9NW
+HN6ucu8yTZwV0mCEnH4r964XVaXs8PJmq0NIISS8XjF4PaepVVSn9iYq3nQxfQ3haPw9Kl57VORy
+t+fkHVjy9IkBWbOGMX/48TbMvHoTpuS8rX8KyzbOSmRb0m/l2XL+13m24qTWbyTcwvLqtkm32iQV
+FZVTwIMVusr6BIGxuLRdwWEUCRdba05jY7ast1Rr1mqZavNLdLWWdEs1QnRAkieNkzySB2f2TM/1
+SB60F8mov9LwzFP0iNCVG3gN3EAjQqvQH5XvKW8VvfXXTvi1Rq/H8Rfjd7pYTnbVS8YElb+YNleh
+HbSDkQRqIFjMugFulduEsNYGW8U2GuPUNan7U7GTLs/T3jlnl9rxIhro4XGeuR6hx6KuOXbuc+2Z
+jGeg+uDrt3A1LmamgwMCMFa2GvS61A4D7DozlWS2j+JSzRJmHWxtvH5stR1VYBATxqgUgmw06JM1
+HK+pZ5LrrSczxOutzUFClVgoc+AbozfoeaPYWpid5UuwiZ60QF5Cbk5eN58/4CdDANpK0fkRwWTH
+B136nqeUgZeWzfzpJ+XnDxc/jszfX0MW9GQAvbBt24sNf5Bzp8/tO/Ix5VrJrpLFk7J886uQCZlQ
+ofK28t0eJYj2X/jmyrk33tZxxo4tAAjrqMwAkoOgk6wxsBoX6HhKryIFc/Bj11br1lJcNf8SXU21
+PXb17EG+mAHB3tTPmG0/lISzeR2mkg+jU4eBgsWRnEIWSIL+sh9ZQXiaP8JAtdVeLUG19SWmWrdF
+2uhE1qQkhynX6uBBdKrUxDEW6OvxNNAWjyoL8/LTc6MpcwoS8DQVLGY2Kd8pVx8Nzp+H+CfQZPQo
+yTv0R2VHiwHC589D2EDrkR7lVE75pGSQcpfyBEk79Memc8pl5FLzJR8Mf82sJJhKWU7XrtOsN1Rz
+WzpAQrXlpQ7udanrXdXejR06WjVu0UE7HH4Rx2c1YwNNU5sMLyS/ZMVSt7Sh7lV5EidrUnEmXPTp
+g48j/d8mvLtwwWtjdj6zt/rkkWfr335tzjtjZ789WbmifPgM7fO8sOrYn1JTa/3pKxfOX/pc9UOb
+tudkn/P5fri0uTGSO43ktpOgj+yWBLOhWn+0LcedWRKA10cS3ZkjWfSiwWQkOOd6q1hYeHPOu7YE
+atHcd9E8aiQB3r62XGrh1UoZ0WmdcKfstWhNVYbTyWwiskbosRO4BhflVOmxbXbLMFeMHtui0owl
+xDKbxtFji2302HhaCUd2cmny/uR2HNlttMg3c2UTJXl4HGH2jX+++sKu0ruZjlhrju7T3A5+Bvhh
+sCzaKHAxkiupG5PrceiI+oVZA4JY9iUmVNsCwKajajq9WnA+nXQkhST4C7RP5oc3yZvz+SXY2+in
+fjex3x3P9H+XTPDvZvdzOBr+cXj7Gzel+AMKBGU0u4TYGXxQLRsCpWZkZhx2vcOd7hebMeq3uaLx
+hCgAzegJfEjWQrXztOQzp6/148nG2tNxt11wqgH9eoskmc2C1yelpws8K7YW8WJr0UmLJJiFdIEX
+xNaTXklIF8zk91rRZ5G8JllMsFlpuyVdSPOk0m6vWWB43Bj5hrA9uFvAH+BULdWCclGCPcFmVfXY
+vG4F+QUolzqT39nlzu3cOdft6pyPPvtL6NTd/boOnfHkkzOGdu13d+jUX5RQqrVv4eDlkyYtH1zY
+15pKr6An3/jgnSNL+z7aZ/z4Po/2XXrkHSY7yu88kLNCRxgpS50NZkaQOtoE/wi3U0gu6RQxYjc2
+NWPfo+wQqjQBqx+qJJvTT6dKiVWpGm9dyg7JhR3JRSoNqdiKl2Nrli86lrwEe4JkhU4Ik6HeTD5u
+w6Bhdlj12PFDei//cs485ctLJa8+FcesPGzWnml3PvmX+VM825I3bVFaf1T+9s77SGhHUH5F+WHr
+XbFcfNRbkRyZoo4iriKeFbjbJUhsLIx4ifCbQ7InqZSt0rvn564rHv71e+zFYJc3th++joykbi0A
+NZrUPUCWKF01K8DLHM0il1YglRc1kupfJSwouAnsniABMy1F13Num4JRdfp783LfPX/mzPkzZ9iL
+oQTVMKWOhf0Pew3sMFM2ggvxgonX6EwuI5MY9XKKza2QDbJPJwkaQStpNIKEafFEwiKpE0TaqLdT
+bLVli/FlvQY/pNZCFcF9W68o1pPwCUnNfCjlSogco2zsfw4P9pf4QpciSRDPU/eOu2/9uHHMoOX5
+pd7QL2oyRJIasd/ISXfMBQT1EY51M1yQdYxBozeKGgFKLNGO4+h3U1/RoKV1EldN79A1SMbMosvB
+y9HeyVZRMhgNJsloNOgkvUGvlQwG/S19PiZasSM3T6fTmjJ0AX2GoZOxk0m23q2rtG4gjISPGTeZ
+dut2mA7rnjecNL1p+tj0H9013X/01/RJFbUag8ZI3Qeyj9FqxQRtQNvB0Eks087U3iUu1C41PKDd
+YHhc3KrdbtghPqM1Y7BEZIuK+XTrnzlx5tCsYfGO3T8QtvlQOI6EPbZ+OhJ/Yo7Ms0gQoEQfoc6X
+BUpXwwqwgyOUDDmYlz2yVuYbrhk41G6xnK175ZW6P/6Rs4a6b8ZcTJsBYty9xL6xX7YIlI5FRt4g
+6DkNw0l6DkSxufHYGg5VNDeKjU14nmsxVT+2ucgmtTjHAaPhdhhw+joVQpkl52jpGqoG947R8xrG
+JIFRQgYOAStRklEyGZEEyCDpeb1OEiReMnAGLAtbW3mRbRVahVa2tRXLP1bEP4qKWluNwaJc1BbR
+jIk9e6F/DlSaPajD0He6oR4e5V303TnlLeUPTE7DndOnv/nI3VPv/vUTuijYGMmZxgfYixCAIbIZ
+zNbUZFcHd6nZZS3VugQG65jBYLApWEQUJDmBt2pdEnAewZhY7aHTN2aYk4W1qZnXi4rENmb6Qp/K
+tkdoDOwxQgPVUNDGoxzw089hIvopUy4EChdveu5CYeHSlWPGXLiAyejLxl1ctWTliq8Y543vT+WN
+eO0g3Xrj+wMjhk+pfIZu/bXDkbum9e4d0UVIvksJNsod4vJdChIQtYTkvYymvdQZ9YV8RCu5XFgY
+zX6Joe4i+fD/RgpMS7sUmG1qTSwV5ntfDyR6TSwfZlVUtwmHoz5RkaOHqRxFsTyZZnBAtqxziA4u
+12RxgJq0+TpZ8aaEao2l2vRSkr6a3qLZmCSqPluxNcsSTZTpSQWUBrzHT6EklJtjtixEy1EP1JMk
+zHxTeUupok6cD4OeftGohMqEGy0tPwvUt5H0jvuUvThnJv2mcvncOeQKrVDCyhuoF6JIn+8Kf80l
+c1bRDznXsW23iT2AuoXDsCT8NfMR808xQDUx5aATORjNgpr7k/uAvQZWcEOu7GKqxZfBVm3YAs5q
+fiN4LC5IcYlMokMrYs9vc1FrC/H9qkPCVkq880mcJ2oG8OQkOFA0y2WB5OfYuSsa1DFh2N78qSjw
+9XvBcjXP5ctrHqE/emP7jUc/iuYAdV5Xfgh2IVkucWq/uruYxJLoGYydwKyEBPBADzmVrZIa0uz1
+xrq05HphY8LpNAmxXpuA3AKbZNYNSCPaeUtcRhkxenjBNimJi7oP7WQfkKJkNQWSn3na75+1QU0y
+8K8Zoz89MXpUKAm7CdfKY5UyZtGu7Kxgw1NR76CzsvLjGxvZDdg92IO6lh895zP/Iud81+/kME3+
+/yaHadyp/5Zkpup5v11C0zibJFBQH4nzxvGxI+V0bz3Yk+uNUp19Izrto/WaGm6HL8lsHchpaD5V
+jwO+fcQQ2SQVFl5XDSqFhWJ8kgQSIvulPxL43abt/mYA+KlnTih/nzXstzImZJAd5p3bRoJT8K7y
+M8lnaoNkKJPtLEdbQJOcKLLaJAdjKTYmaM16JkXFPsalLJVFsHM0Y6/WJFSb1jKGtbrM1hY1iynm
+MkayJtUtuxczq1jqpqSjuVJuW9pR8aaso+eVSNZRjJREtbGso8oWDJuM5B390xNIvwUAwYLw18xj
+7DXwwAxZ4hMdXLLWlOxgukCa1mHDfJ+Xg6rbnPTY0deRyHOM0QPGp03b9NVSajVso7ZIG9Pa8oy+
+H8kzKmsTKZ7jHRTH8Ti3Af6aYMlPZURCbEGWtY3xpgZUWov8gvxuAX8q33QZvYXs579aubuq4aW/
+/qyEPlb+/sSWT75a8VxRzwkTlacvsweHXVY2vd4l0b2qdf2jH06ZP/+zk591crhzcj+T5TmE0yv8
+Nd1AcjZ8IEvalGSNwWP1CJxBgNFJ2CSCCYaiY5IdKclaDWOBdYEN6dZ1lg1ivT29nq6zb8y4OUeD
+bHa6qKTkMRptqpvypIzBCaJvl7cB4Q1Bujm3glAh+1IhNZDuz0jJSO5oy7RmWjqaM7QZmg5CBz4d
+rUtZl7zOtc5phgrZ7kpxJbtcXd2dUzond3b1dPdM6Znc06W7zxKlO/otapAIKm//sNqZ73+58wX5
+hTs3PjD1rZn3npv46X+ebSzeNm3DhomHRjDFKSkv7n2gOt3kKx06akJe3mte78k9G3Z1NHoGy2PH
+ZmTExV1jOyFNcpcoPyhl1CfEV+CGfFmfbNTaOCsS7NqRqWIwJ5jT1EQYvGST1V0FOmeVuCOVqeJO
+YwEtXlfVLBSfpyE1rxsx0ObafH7VU5CLlg6fjn1Jryk/oMwTo/PMZ5R1guSZu4qmlzKzb/S6u3TX
+C68yR/78aaU878ndnROz+uRG/MlKWcSfXCwbjVZBoDSYudiVSNzH2FucU4idWLI5kRUcdA04cZIJ
+m1hlPG3DlrvW69jvRE4mbekkrNGYoV7olsQSB2tLsmb7/Refj0svceTj13GskDWWZQJBYszXXi7b
+DFq9muIWeJzkltW5DKyIT8JikCSPx472xsLCWLZbkUSziSC2ZbuVYtluY973aGKumAg+QvcMXb0w
+mchdNfPtxIi0jfjdiV1ksCw6EnQCY1fTbFiMJa5omg1CESBbLOCoStihVbNyqEk5sOudTJNq/4gk
+4LDdkoAjZqtun4hDNYAw56bHZePA/sJRalyCMjMyV2NlK1+tPS0a8WyJgpoR2CCSHJ6NRODnkF3z
+f5+nm6MUor10IDVKYe664hFfv0dCFG70Us+ezLlwGF4K/4ebQvSV7jesRF+5TPSVo+Gv2VkRfeUq
+0VfKKQAEI8Jfsx+w58ENPWQxOcnhEYHJ1UpWh4FoKBgChynY8DHMaJBQcnWKuDbVXs1vTMUaAD6U
+4Mh0j0oykApSN3OBJ89j4yibFdsS87pRgTwP+4Hy+tOPK6uVPykfKGu37FXOUuXH3kaaTz9Fwluv
+UOVs4mPKp28oF5Sl6BHUuw51fPTYsalK7fnvlUso7dp5NGzqMXJmD/+V2GWc0EU2iDY9L9AOfaLL
+xriIepIZUeQ1QsJaF1SLG11ETWzM8uWAamDA5IMiz3kwVbYDER4y6q1PkTC9z9zjTz/++hnlg+FX
+pt51fu66x3Czz80Z3x+pHXuzkeIWrUBG+lpQfGN7JJcre42cuWfIJovLqmd0PMisSyPziZGUrY2F
+ma3ZIOswQrPauEWneZnLbC283tqEbUIOPY5O1klaHYt/6HXRc3nf21P6pvEcT2jRczXqYZu8M37m
+k0KbT1IeuhC6hpO2jpj4zPpx4w4PZgcaEx2hM9iQTBtDu9Ev6yfdMffB5fmlEMl3wH6KYxPRbNlu
+Ntqc7kTB5o7lMHAzbl3qf5PDQMT0d+qc91DTJrjVtAluo5QMbjVtgvvmtAmpatqEVGe9uU7XYE9V
+k7v/16kQHotmOWif4eD22Q1uzWvwWxkNfjuXgZrFYKXQlrtAzVuAcxaUVIDs+69yM9yczoB4niMP
+Ee8g/C3pDZJsWeVVD9YfG1VY2MGqjUt1QL3eLbfyzLoFC3qM7Wh1xOXkleB1OY+jKZ7Sm0SdyEs6
+USdKFE/1ZQ3VjNGMCZH4ao2eo02MkRGMIPKA+gqgNbfP1qtty9YrNoqRHCHYNEu2wrh0CLzIFhWR
+b9EcvrpEXQfKr+uuG0QN1A0SNSyvE8GSwCeJoiXTkscXisWWgfwQcaRlHD9BmsnPEudZlvLLxNWW
+fZa4HL6R1Ah5HpLLV5mPHMpCZSzO6IvqlYtoH3qQ5PVVpM2hq4d3U2mb0RdxuR8keFPOJlNAJoNM
+AZmMvmINNGhYQw1j5Gs0Im+iOT2HwMgISGtunxJCG5cSQhtJCdEYnYrmIlWO/+ZUtOWI0LE60WRJ
+5J1iJzHVks8XilkWkiOCxxm0+4nj+QniFAvJEcFXCpWaaeJqyxrLy5ZIjgg6NzoZXjwZ99Gzb7yh
+vKGcw/kiUKdmVIoMJGmE0rJZ2bT1EJq0GRWSd3x/+CzzM3sR0mC+bNQZDYKWcRjFBK0hxUscUoWN
+rebC2mLjSCNVob7CFq2mWjTpqg1rve6nU45AddJGb8wT09xamCVrRZwqWyeZRBO22eAv2ajRFpgo
+VtTpbGJPHYMqoCJq0O2EyCO0oRx7xDbtSYuYealDr0+fMani2SNobTDIgHLn7DXTykbt3KlcWXr/
+H9bNXv2He4YO3bRu8JCd6B+HdysJ3TvOufuRlhXLLWbfuWyHg+ShDp9lasn4JsumxASb020xOBJY
+t8NmwAOszbLJNqoCO5QiNBV4hA5ntcWaWJ2w1ss8zR6Bas0tI7RIjkRHomS1WKMjxKMpsHE8p2r8
+BfjFRXl4jdqiMSLUixv+sHr2uj/cv1S5snPnqLJpa2YrdzIQDKK1R56tmDRj+j0OR/Y5n9myfEXL
+I3fP6dgd/WP3YSVh55DB6zYNHarqgXcrw5hiwuX3jKwDjtKbnRaaozxiSxN2H6oDSNI7IZHrCzU8
+T3uYGtqyzrpVrE88TTd4xMvYu0w8pZmtrarfQeAkYCXEU9jOxoitspNmWbCyCeBlfVAA3dgS6Mfe
+AWPZP4ABVchOhmOQlbMiH5eO8lABNwCVcGPRJG41Z4AKWcPZ+IQkW5KZqdDcNAO5OdE5SleniLpD
+uZOeHboTHVFngDjhZkwnXjg8QagFtRzaqhieGzL0oU1Dh2IXnMX8wyUyO2Q+7lPK6CtkPgC1PQGp
+my89r5s3y6MurFSbyHI2K7V67PCRo8aXlk1VUtF25Pqn8hf0TehOerayF81Tsr5SrtpsnY+nGozI
+cTXPN20V1X3rIWXGN0prduLURwHBdgBmCHsN0gF85F3DKky+uoiJbCZkIQxewXyuzcujDdTZ4DDU
+Y6HyPPpqii8wZuy6KbNmL1vRXH4FWdPcQ+5uzcK89fLizXMGDRu8dOhQj6dfU3bOlTcmjR5cuZlw
+ZUFF+CzzC3sRHJAnG520IGl1Ao8RzSVJIs7t0drc3IrVfkNCNYIkrtqgq9asTcLHkVaxCVPki6nY
+UqT6F33p9gTRhDieS03PQ35aW/9s6D/006EOaCGCA6N6uqxddoYalQ/RZuVz5Fu3le58WPEh9OHd
+ypdlHZLTl/9wqd0aTIJcWediBLNWLyQJJcSNHOmOiaqCpMTTTr7KqK/SNjjVDgWbclqz7Am5cV3K
+zUlBapc8aYG7D7+Pttc/q+yjHaF9l2Zdfo70SLnyavPz06P98U9vjvRmCMnjE/6afpbdi58J4m8W
+H/hoU0AkDKaTxGYFG+WhDs+/d+4G5btxo0YNGzx2ROnx6YWoR9nrvalvvjn/d/pv96csXXj41P1P
+mSXvixmJCQv6PDxt2AJkOoisSutBaJfj0gh2kGWdQxIMjB2bbYgbJUedAX1fWxWr2Ug3ADqdiBlE
+rjeRjeCknTVVARh5RvwOxKYJbDxOwyYiiKwmDNrvVjXqz3XDRwQ/oBYUdl/W8tIK5UNOe7jv6Oz5
+9+1SxiuX0eZfHu7b5/sIvgVtJfnk/bIWdlCnmba0rGLrb+Rlfff8efbiz2vVWPkyOoBj5dEDaBuA
+yKEHyLO2KmX0HoJJ7SEn6zU1Js5C1Ygsq4Ud2tNWntawSDAb9CJYxeaioiYcxUzS37e2SoUYMEeC
+mFFuNH45kisC79gLz6MpytP467z6A01hL4Z2HLp2ONSZaj587VAM77iNxPIw2yKftR/iuGBUzL6C
++xnBrr8LoDmKY3GRzF6Mu35f+Gthi3qdOUmuD4QIlpFLV7GMOOO/GMWUYZzQX1T8InNn3PWzShn1
+vXodLSHXR8bq+UGth51CrkexiWXcH9XybO+466duqX9UHHdCG/coiXEF7hUjiEXZt+Gi2jCRRPZ2
+CqhcVLfwKgYILlRXy6cigLjY/C8BqMlxnDwbwm4Swy+0L9eeMxDH3Ea4BG/mZNmwBIB7xanHyEG4
+DVdbgPmJjMPGquO4mVsD36+rTUzVIRTDL5A6hFzRbx+XBWq0dVucdyTOfCXqaebgtbY2hYlqmyJn
+L8+PL58Wjbe2l4dfU965TfmKrNuXrwg3xpWvjJWfkMXdtvyESP2RPOxmjnK2PVshV322ImeP4lPI
+M+WzzX54LsI9MBr1vE358fHluetRngr7+PBW5Ww4rHKd8t3wvFCfoN5mDtZHy/Pz2B/wdXQlHDRz
+cCgWg8Gw18AHY2XRk56W4pK6UcDkJjp0oh97u4qaLuMYDJATGTdKA9bprfY5qwXz09IRGwnC8LeL
+wWg5qhs6tvyVNJwhqwnbwHJtXltbOAZjbxe8iCELeZ489tHly+NjMZ5A/Lx2YRj9QiHlJK1XPi4P
+t8ViDCqJD8MInCh/6Lm2WLFfSV7fblAuG01pOT419Xpi5wF50dTrZEzOVMno9ek7aBLXOVz1Nro+
+J7Mut0FTbzvt73BzZnbMIpl1sjJ/Tf7+/Juzs1vxHk/2rQi7XUE+0Qd+J1O75fhb7h6rY+naJ9qk
+0EPU7oTbZm2fW15SIW8kedun2F0DBk6+KXf7zfwJhANkCOb1fMVjFItkfptnrCfdQ1dkx/EcTBf9
+1IYhIZXlIIZ5mh3hAjlAYvMJhjr8Lfa3k7pHRORJEloMHWuRqIc4PgMUfh0gymeANsMatAYfnIGr
+iHDVfkY4F55C3QDacHrKYpzHGl+HNVGcXrR8G97QzKEX28pHsYVmDr0U6Ts5u0T6TnAFaDm5Xq4M
+YzIJRmqDEyNAxwLEMOUzCF5jQ9iHpQ/BlOtq6VSB5+NkH9ljiEzvdeseQ65X3GaPwTK9ot0eoz4f
+gpNgnQQnEc85MT3Cb8HBEmdIjTUJfy10YS9CMtb1jC7JQbu47k4caZKi+qxyMKG8bDQlVVOYEsKl
+rbZtdGVebiVxW42YwN2jOpntESczVobwcQTTQLyL2R9699q1rW/v8zMqlb8p3901I5R2nr0Y2kPN
+uNG6r3QEGjn0ILUvNPGLNcuWLfkWGUKF6ruF90SKvdam77iwvuOimJv0nWqs76z9X/Qd3836DvEM
+ptFo6/nz3beM/+qtMWODW6inexWt/e7UntApfnX9oPF5y1YcVAqU99ERpN80sEQJQxyOGONyfGwK
+WbvxeOEZUf4x6IcSw2184AvNfqhS+Xy4Sej+iFxtIdefV6+zrej+mFwlz4+bhJa0l6vk+R1S+X/Q
+neFQOKzywarlGYWU303KE84vUv6U+rxdobg9f36Mh7wz8w1aEZPzM8QA9TqzM6yYCZNRrHx+rHwG
+04B6x8pj/NMbWKeIlVd5X+bHuJ87M8+iFdH9iZtO6j8VDrXxypDy+bHyGcxzqHesvFp/TRsPTfh5
+AGohPz/G8dyZnoDrD58DoNaq9dMzw6EoHyCwANRSPj9WPoPujesPfw1AjVPrp9PjeAVVHWR+TAfp
+zPaJ9X9xZH72hZU2+UHK58fKZ7Au1DsOT4z7vzNO3rxN8J7zVbwnmZ/lpP4HcA72yPz4wqGb8KH5
+sfIZzBrUO4IJnRypvwtArLyqC82P8dl2Zm1oRVRviPS/Oay04cZI+fxY+QymFfWOlVfrfyEOZ1al
+WDHOk+DrlwC0YUwVK8Z5kuudyfWyCC7TSnCZ+PoYiCAzCS7TSnCZ+DpAFJmJYI8ymlnIXsM7AmIk
+qxHpEQ4DjnjhTCivoDed160r7U3j8/LxQc5mNVJ0Meo2rz4Myj92vYOy6yfdP3/Kwmd+Lpp5dy9v
+wdBB/rp/f//+gxdW2XvMpQ70Uz6f//7+9ZVp+ws27npq5J0P5Q56YXZSn+mPji4oy03T1712sOzk
+mqKZ9y0CBAOVkcTn2Q0ApdA2q5Hl83ozbR1h1I5wfF662hOOer5kfm9P33t3PnW8esiBH/dOz1hS
+0bP40y/S+owv7uLO7tfHO+DQVqRdN79u0V3oPaH/xHLf0EfunVrSK2B6oGvlHdPuyC2f7yh7dJwh
+tWjAzMLOJR2d2p4Tdz888Impc3fBTRxBAZYh8qcTmedrMS6gAO2J5wJi0X/JBRTlK/gBLNBVNtPV
+xi2ateajgsVl1nEuoY81FnkoREkLVB6t1iwUh/7vjeyYosDLlO7ehZkJRjU99fW379KrP7kYoSSo
+WocwJcGvn0Vw6PO5/pwVeMjA8+wjYfGBND4/N4fEyXO8N7/An8epwaOiKsLtUi6I9oTUgnwRu7eZ
+k5Jvf7du5+euv64cHpyXltiY3C+rR/mEVRfWrF695sKqCeU96oIXx4xF6WPHjh2rtIx9gH25C0qt
+e185YxrUY8DWonQCkKJEAo+iJgf/pszGsCi0fdHChSp2gfWx10APPWG87EoHay6TKPYaoHVl9E1O
+dHm69xRdBX0ZV+aAIoKjaDqW2hNVXG/FeDuVwl82p+fiQPgiQ09X8pYiXui8sYhEqTQ1N0WintN4
+Th1SXjc/jR3RkbAt4hzC25aVi9oaosY+v/rTQ12om33PPbPR/Hvuab7+wOEpPZsOTakcNueBy9sf
+PbzzxSmVe54MnXpyT+XUF17oN+6BRQhRbw4frvwwfPjwEeuqdlMBetXIAYO69Omzfm+n4cKMu6ee
+WnMsNW369E2fLVq0aNFnm6ZPT3OXTCh/YMyQKO/afawJDNAN+skJ+QO0QmJfY7ccQczo0Enw92UE
+94A8dRbwJp1DgE+ysVsHaxrUd30pj693bcwTVbNmYVNOu3EH/BYyvnbjjA4zl2ubEeq+zWNGjx6D
+UseMGTNa6dFt0ANPjB718EOK+aGHDz6xuWe34qc7DR704AODh3SOH+rw4dQQedoueUL86N5K85YN
+fXzC4D5X+vTp02fR0t7YfquUEZ+FDfbJepNVMvfVCTZOMiS0d0UYVGdTc+tlEs4m6/vy1azeVC2t
+tVbriU+ipVUN2rDi3A8myWK1xJI2YJeERWtMNHaw+I3djYMsA42DTBrWqjOBPcGaZBLtmfY8a6Gp
+2D7QOsQ00j7OOsE00zrLNM++1LrMtNq+z45dEqzIt7nTPRGTtod17x20gDBv3PiRPhq8i6kOltJv
+W5UvSn3Elb6b2XJ49415ak5qpYz4JWzwoqzXCTaDyWqWzJwhob3LwRDncjAQl0NjrWyuNFMVOBm1
+2Fwr2yqxTVudhhpWb6qRGqw17aeBzACZi7hpyGEtCRa/ZaBlsJHVmUz2RKvT1MmUas+3Fpqy7AOs
+g03jrRNMU+wzrXebVtvX2F+2W35z4PTn0YGH8nDGajV7ddvAD9G+rYeCn7fpNSo+uIHI0fS4866K
+Dx4Sr9coi3HcRlRPgRVw4Db8dxysWBlnyxByI3oBZx83LY5flpy/6yLn74Wo523KT5oVV577NsJT
+y9snhU8oJ6Pl1f7fho9E7f/C+HPOTfWPn31Tf9C/4vsTwWSrdo8FxO5R1IblVs9Fk8NmFcsNQMEj
+4fn0JM4IPDh+U4oXSLno/VtFdTaqu1kgT2SzCI52PjeCWQk8dPqdOm9lnrExr9+ulXY0NLe0iF5v
+T0ZzRR1XQXg+PZXdCzx4fnt3klRLL3Y7fBTfcr6HtFxVVY8DNm5pkvr3JtRbeWMTAMLnRLScqQQd
+dJdtOooHvoo9DQ16Qc9yI5EaZd16OZYHSXWi6Nriq1liXY3BZM7WPXOiJHvWMKbyxkYSZxflfNnI
+WUFDMiT3kS3ZA3xm60DWaRA6JRoHIrNnQBYhySwKFqmIVVnMEOq1XZn6hOSNkrbOsMOR2Xo553pO
+a46IM69iwZ0v+lN5S45dypXyc3MAxwEGbN686HYNZIdWRTzKxHvwoUNKy9j6HxfUL7hO4gKvL6hf
+8CPZqdmKMXh3HkN2aupXvAn/HW3/+0JkeJOaHDrwptIQuqY0vHno0JvqZh38luzQi8hujcenlPEb
+iT3GB5nQH48PmdMHdpKSzbwxZWDAbL9lfGZtx3oL07Xek1Snt2w0nU5uyBIvq5niIkNs6z6dFpC8
+UnRguTn2vFybOuS2ieDeixtCfdvQLsTG++uB2CSgGXH9V/79ZugINToytDcPH44Ml7bH5gGADofC
+p9hVnB3MkIDfNJRrQXYNTmFkQl4+4C0IFOBsyLSHpoZ8sw5NnanMR6v+vD708Xs5JT1HU8iaSW1W
+riageadRxWz2ovI0enqCEgg60ep/PV5SgqTuTGPIgV5RRqJITm19+BT7CGeHNHx+sgcsfkksyCcR
+ZgV23uYPYGiPJ84NaUFevzeV5yTrgpkti8+dQ2PWLenV486Z07tmB69rdOsRPLBo4czZS5c8pNS+
+UUh9ihY9EIb160vKEfsqsqO7P/gw3excNmHJq508wZkG/VOZmVM+GpSe/jay0ZMy9zylfPqS2i9N
++ABTwTRAGpYN9kCBP+/WfuXG+pUWsKAIbH5W5elxO7Yjbtyorl0mTFniSAx9xHPTj5eOGDli+Ogx
+Fde2FqCrAwcenz598oi/PdaEzE89JWnFe0duGmfUhSYLwn22hE6H083mzYilyqT7Frx3VwwXgfni
+wAA95QQ9q62m9CrDG4tcfA+9FhgjoWEIFjURvE4EIIF53dbwa4TVzGq6DSBBnBYqnob6JDiFQGqW
+4nACDJOgwsfCp9hszg4mMAMgm5fE3gQIVrPArkEe5ttuStoFxXAXNTF0ldnVfWboOdRZaWZn9Q41
+hTQUHzpeTr1NjT7cd4KiQ9PU/s9TRMbBrIReABjKn4mI1pUfQzaqvlR3FMnFu1FCLqGEISXjfF+0
+Nif3BfmFYcO+fH9m7bARhyZu6D8gO3tb8VulY1aOf2jf1o7DAmMXDJzwXUq6KE29d+b06VMfKClB
+gaEZGT5T+pChD+x9MSXlSEZGx45jZV+6x9h56LANe+6QLfv+kL67l/+Of6eINGX2vpaX5OzSWdVf
+RCaXqYREAByfkWBHMbKaTOTvhHohEpkQGjEpGwlbOw67dytpvdfEyVhBYCoPBS+J2crPkdqL9+hp
+H5mP0kj+lUTIlyWzRa9jTMAiwZGAXIzJoSLtpEjec1kH1aypWtRVm9dSJLwbO0ExziWaXZNgWXsi
+EtpNbQmc/xeJ5x7w8sidLyjXkaD8h5oYeo6GLVXrN3XsfLKk5a+f/F3tA72JWQmJUCCLWoEzMXoE
+jNmSIIA+0oVIllvZkFCP7Pp1hvVCvanOnnm99fpVnARS5UCPeorVzdHKYRqEzBmP7R42bt3gsrIR
+O1+4cf9DzW+cR89tqXqszyCbrdPJkrmLPr/4jRJU83loI7hxPdggAybJSTaTnrMne1kwu5I0jtSu
+FGMAl9+XwHSIUI00xVF0yLYMVO1jGGCTodpoqE5Yy1YbX/JGmTtwUAAhHTJ1jIY92v43Eg+cp28r
+ukN59nc5PM6cod59d/P536DwQPM3q/Lta/Z99iJYIRmmyCYb7eI1bPcE5LJI0CelDWxHUKtNOOds
+E0F+JCTbRS3wVrpa0iQkQFK1YSMDa1MwCRrxQuLgQwLtz0In3e5N7n1uuiJiK8WeCjUgEbslWE+E
+zOzYBeqvF559VvmCoCG+eO45+sWQ+8i5N46wF0Pbqdmh7Qe3Ky3Kx6gjSt9+8OD91z766FpUjyXx
+xGoOv3fDfZlHua2Ez9eJ9wfsMcBRmviL9xZ4JQ+yeCVk8Uh03eE+5U/sQFqloZ7qGtrZ53Cfo9QR
+paj+M2TZoZRxW1+LMMi99hp78deLVHLoa+ptdEopAWDgvnBfZjWbAiz4oQtkAxAxQLM2D90WQR3g
+vZYowt1ekIvPdayFBFM7kTeBfpsaYxTnod1Kp21Dps5+e9r0D+9ZOb308ByP6ynUIdg9e+DhSYWZ
+D4YaqqwJu+uNE+d9oKO27ZgoGpQf8icqO1yEvs3zBtLYk/cgLbKgfpoXv0zLeeJNNIQ6sleZjowv
+qtxcmPt1NPEnT5DdOorXI3212WTlq9mj4lorSAZW4AqR5NKLTJuRQ8SnnKLWnMuFhY0i4cgIqkFm
+RPXCOEibbCu1Vdo22fbZuArkbYMcRdTBXGr0GRVLjWGR1NLQv1S8EWV6jqoLDSL7GPEPuDgreV5j
+5HT7a9ZXEk2cDnRVmtOJlKWGFaEhUZ+o0Y60sUiwioAd/mR1RZXBlqLLOOBLUgHUWCl0yI5SR6Vj
+voON7SjW9n2zec/WPfOMqiKeOFFXx1QqwkFFRWSgTw/SL35L8ELKTO4o+y+CIeknp9N6A1etOepL
+q4YEVzVmWd+CXvZpOIFyG8BFORwWzCnS2tyakyM2qbDzCHxEjOBT8+LwqXltfCIJCPutzARDQjHH
+G+euGzD86/eGtz6r/KC8iMYi47OtwS/+0fTeP//5XtM/mO+u/LpVDSpe/dAa9AKahCahF9aElilf
+K6+iASgZJWMOoiiOSCbyMx0GyWmJVXDal1YvGV0q8YS5wYfsiRY3IwmWgQ6zTmWIv03vr7fGM8R7
+0/y9UDz5RGRU8RQUrFxy4pk4Coq6YbOyb3wfpaFQyl7/uCGeheLwjY27ptK92ogo1FyDGP9ih1R4
+VU5kOdqmgdQkM6tNdjC24kStyyjpGY8qb9shYDwagae1nEQzNCsxDN2XY8BBM6Kj2qBJrJbW6pjM
+ouutzTmYbNLcLqqVjaAtZEylJPddYUcssIilOJpnbGBDViqBtjM+8CEf5acDnJ/3C35Nalo+yqdK
+UAl1N4t9Zkvt67n1/E5uJ++ugJtANnjxedpgNniy2uFszpxB70SQNk9UVz9BNcSQNqEN1NLnqLoI
+1OZq1YMPPkz2aSO2m5Nn3EvWahNpgTMLTIkDR903BouK8BHGLOlsVcY6WkisYSlNA515ORIj2djc
+lFVbmrQviZDdRHCpxISZm5PgjmSzoVJeqH/xxfoepx62dHUh9+DOe56n7j6oFKHGg+jnIzOns+xp
+s/T+0/hd/imyT0pgBaBz7Z4CLH0JDBl5sKS3M5/toPTbP9uxR/lR+emC8pNy/SlqqfKnnSorurIE
+B0WjRw4e/IqsAZ1Sxpwguo0TIC5Gl/bk5dK5Fo8lF4dgdkKWuPjc95SPr712rg4NqztX/73yydn/
+mROJzkU/bELvKbn4a5NyjdSPbfyVzErQgg+OyaIdaG3ATQvWRK1B0Jf4o442lURCtkGNXW/k6qU6
+f+o69ylXvXejvsFPTqMEi9IoNl8+6XDr7BojmCMB03J2cUqxuzi12DMyZaR7ZOpIz5SUKe4pqVM8
+81LmueelzvOouV5WezalbHJvSt3kSayQfcXJ0buK00YmR+8cmTYlOXr3lDQbqkDRty0Z8dGnhrUb
+TzsQD5WsJsOqqytuWP/ihdANRYkmyvr3NapTJPfVMGr14ZlTzx5oy4sVhFieiYnMSpIvpbvswLlS
+rKr4QA00yZQyUDLrBiRHREdcZomsk2quFEJYk/t72STqfiOPxOEb+2+bQiLKMfYMOeuSftH1epOm
+PtG20VTnbHCA2TwwUc8JSXFZXCL9eqtdv+LxejcxZeXS30R7EnoM9w/CqiirozIj/aBGxzNkHWYq
+47jPdGTPcMf1y+GpSdlBupbicau9UyGHjSriMMb189bVeHH7O12MAdnpb6LAwtDj8YRex59Vvp14
+x2/09xDzh3Mf7bn/fvX8szr8Nf0jszKOy6uerlO5vOoxl9cAi5kb9NtcXjnxXF7RJ8uvxkxSqKPy
+kfLruAPD2gimmNz0dOUN5QflqvKW1/tilFpKXW8Lcf41CEAmjJRNGTq+c2ezhTdbYFAatl5czolQ
+DuGYYcKRZarv2rHeztT76pz2jVni5SaMfWlpjdBgZYvZa7I3Z+/PjqfBUgm81NMAOR1zNm+3AlVT
+w9sxMXN50yKcWNN5LtB78OxnhhRPGfNk6WBul74wsxqzY407oDzSY/gTD93J5KljWCBwozrnDuo1
+4f7MDlJh/7tHeXOfRnpMleUN9rAUDo3uyRFc6lA5nbGa2cSam/blHb7EkW7GfNOufDl+Sw6qFrC4
+pXzLtiz9P96Wqcm/fHrzvox9QfWR/veSXXEZZ3xcDbvD59aDoGvLN0NEghi8LN5OKLQRMfyOcHjm
+xJnDs4b9hoR4h2hsNx74DTERkV+rybpOgwWywZmYlGgWhiYJomOAVw1RwuHbsh2SHKZ14nprUo3D
+Wq93vOquZ+r0G71EvF8WW8VWVcXMkjsUp89P35/+Yfq1dLYYih3FicVJxU62s5QpZpo6G+fBPMe8
+xHlJ85wazOls6dbGYuhJa7MSFHgK2Ag+8b4HH/oquK36qT3K9Xs+nD5txvTZf5x5lz54rI7abKyY
+tHv96DHU5F+f2PL0AeU75ac9ezp3PNItKWn6jNc2o73jx0+cGDdGjMDrI6eDDWnWCetZVGNLrDfb
+XmXr9XXmjS7K5nQKpqE2s4AGuG5in2pHPpXnUYmo86Icfd40KFApqe+jR+jf+6N6VEF6VKh8EvxK
++aWOenb97nojBVFOaeUd5SflO+U08jbiDIz4OawKf809RPbabrBUFvO7ZgiBbpTZMJQ1u21qtFiw
+taUo2NJ40p+gYxjKoLL3mDJrugbSkurT6vI09dLGPFB9Z2JzS2ute13Kehx2LetYm85AMf4EIU1S
+CS+03XQMK/kTbAEbbxSJwZMp8uUH8iOGMZRgi/JK4oMbz0UiyVOxAS03jvE3jx4xk0La0I6U1NHr
+D6FKd/W24OUHJrkoxeIc9qd7p85Qp2JPuvNCnd8/q5P88vjRHz/yQe/uyp4B3onU5solyncHnt5y
+1x70asA7AyFHEp6gPd4Oi7ftys7aUznxHdUP8XD4a/odZpWZo4fc9LmJyOeHw19zHZlV0BtmyaKc
+nyUUZaaYu1rMtCOgK1ZpzJrV+cuWE/zrAuvT6jvWFSfWOIp167TrTfXcxmI8dfgM10p+nuzVFTpC
+okNNeVBYW1CTn9URT2vW8aJeXTN5RixCqr0hPrI7gh2J/toWgX/LvLEc9caqRxTlaum4sRMHjhtX
+sWPc2BGDNmx7clG//iUDlg4cVTp12uFhjfdNMbXGJk75UqYmJT5x70snjUbXCz6LuXv3ieXdc3d2
+7lIid+1qMCQ/n5g4ou/sddGpe64k9o6/yayCJMjEmQbsZne2WW/2M+bOxN7d3IpnB9Nl1jvrsqQa
+c5ZQ32FjFllJZDKOec0SXvkojlf0poEGIntFxASDX4uCfOpfNyqnT7/7z+1GdnfjfQuKiuaToStv
+KR/v2EG3zF3w3ofzFrUbzP4RIx+rkPskktGqhIpP7VX9IJSLqQQLDJXd0YOwNXIQtuqtGu1IikWC
+RYQS628fhPEpOHI8n2/7jVNw/An4pvNvZJ+qI3pMFoyTOzj0yRrzOktCvYmu93vrkrvUdNyhqTed
+Tkru2KWT36GGfZpTB2THwj4J9rjxMtES2oI7s05W5qzJ2Z9zc2CnSLUpDzcFc7ZpOW1BnUosnrO2
+LZDzmROv1cwa1hbNqXylfEtiOU+faRfHqW4a6hiZiSQ3jRfraok6lyCtM9vqjVS9t0GoN552uBLx
+bsaaJfeA9MjIYqNqG5FvjW+/T02e9rsD6mbO9dAHlMNV239jEJSb6oXWv/fq7Xv/C+qo6mp4vY9k
+VkIyZtcF1olqaGe9YF4nrbfVG+uEjSkUCFI3JtGsG4CDGFsuB9s4TtXo4Syf5M3zSG1EoHH8fbk5
++XTNk6jPnZ+sjLCA3v1mxZTXp2PdbeSqvUjaGLrWxgFaUHC4d2+svpnU80KlMpqZQdavF5bLWh+V
+aOZTzQZzeoScrfEEuKx0YmqEm81arz2NLJS5IT3GzWbgXVoysbUWyWx2YXzUcclMuQSxqBZJFEWu
+nABEU2Z8jRGLjtNgASzmj0uAyC+aKOca502LMq7dzMtGTUN9X1emzPb33L59+/ae/tnKlNdR335u
+lyujR48Ml8uNrlDLgi2vjFkzfe0DD6ydvmbMK3T6r487E7sMGDxj+vQZgwd0SXSqYw5//X8c88b/
+3425w2fKgoGdB+AxD+g8UFnwGerQz+0KuLt3dwdcbupPaFPoH9OGLev9wNq1D/ReNmwalRB0OxO7
+eOSckpIc2aOOGcERpYwpJ2MulJ04X5kRcVVGaaMWNehPWw0aQTeCEoiMUl8aETsVG6MZVGwf2HA2
+H6KiRmiavGQ1+o/gDGUv1dX1e2Xx2XfQKeq50NR9+147SK385SeqbOb0a9GcUjMI30C/aK40kibN
+pbeZa0yRXGkms2jTCDpMQNAaO2IVBTGrS7QfxyqTCd1sXMK09rQuCcwMNWEaYXIZfmu+NJUlrAnn
+S4vmleGs4IEhsg/3KxkZqpLddWkWl6MmYYeZr2LMnFaAEQkOu0sUzCVpsb5hnqGWVvGm7nlJ99qk
+tnhLL/NJJ5/BeWaG/Rzr6IN/J/0cRHpHHUO94jv77vcAFBgBqBQi303QW040vqZ/hVPpyxo4ljIg
+QdDpoUTEWwzhEcBOO8KbHiQmVkmWSqVKab7Etmc1w7+9UXfwYF1tbdSqii1F9IvfhsPwHAA1l6kU
+OVrNDbYI55IiPv+ArKU5gRFAI2j1IuHbxk4GDaOrQQ2spkbIJJewbkrS/XrycqVFlHjoUOjaUbrh
+4MHglYMH6URc51AA6gvOCl4okg0+XZnWjASwuMqS08WWYEtRsKgl2Jwtm60S5WVMHu6cKVnnOZcI
+OyyQeTmzNae5SJ17lCtFNfVc1YEqeaWIwo5jHrBSj3rVUUgy+M1m6n4qN/cOq6WOY+M+OxI4a/A7
+hDS+9NFVg4cwoYdpiqEjnzigYBgAPZazggSJkCfrkhiM6wI+weAQW1qai3KCRVibM0qmequWrTFT
+FA87eMi8rKK8mlsbs1B06rsFvKmkUzzuojUXHaj79dc61Eu5gtCPeR1Fe8FwNImzBpX9ocepsSbT
+6Z8cvz40tFDdTzDOagpZCxkyb+BA0OFoi2AzRm7otYgF3Q49W8Wd1hOWadX+H0fNgZb3WpZdcmYX
+Z/3l/VWL9jSd5zKi9Q5j8zkrpMMx2ej02sx+zuhNThNSseEjWNTc2NzYKjYdA6+TnMESnX2hJikp
+zZ1ck2ZcZ9gq1WtOpzWk7nBD5uV2CNrjNg40ERitUwKXlJ7kjcFo9S4tOF0O6OjqCN2hp2swDHON
+hTtc90VgtE4m3eq0pvuc6el56QXOAeklzrHpk5yrnQRGy+s4fZI+ycRURJHRjIpsVMEmMeg0IUzy
+2Oh+s9ZOHzdK+VC5Mnn6jNlj35nz2vQZk+54/gg6EppdV0ffiVq6d7r37kcu/WC2+M5l5wwduumh
+oUOeQ/8O5nJWZdihSD6q8N/4ZGYa+GGyLIHZmuIUkkvNgrVUKwgqQWswqDLhESK8JAk4t2CwV7kZ
+78aA0yw0BEBsz4QnW7IySjPmZ2zO2J+xP+NMxhcZ4QwNqvCRpazy48VI8dokcDTKlD7Sb1rlAy+/
+Vr9g8aYxY+oXLH3sufr6XhPLMTvep8t7Ff30FXUwNO3AnsrJB6kV+JfXD1Arbuw9emduTu/eqhyM
+jGfq/zaexNuOJ/l0AHP2/r8wJOn/yZB+uU5NbD+mlZAF02WzESSTReBLXUJSaQefECjJJliRILHK
+YGBGorHK4OSTrb4k4Do6Pfaqjl3ouowGvzOz6HJsSMRBLVuyckpz5udsztmfsz/nTM4XOeEcTQVS
+j1S2+BRjdNsmFUmBEIk3xiWZDiqjYTTlGFqweNNz9YWFSx8bM6a+HhMbjiujNxBmw7j0Y0/vwRsD
+tVflNySxX1F+w8+VMuYeZiU4YZRswvup6DIYR2ptglXdUfGh4zoJbY3bgK0NiGRKw6OTNRatyYo5
+kl3RQ0ltZfIHyVS7Hbdtb4sYsJl7iAKgHkzqb95xF5ItjFl0hOy4kT2XHUJ04Z6y3p5EC7YRGmy9
+T1FJCrEANWDFpE7foEuqcZ7WxeysJzS0lTZqVXHfjtXWFmW1jZxmaWV0HJmt34+Jj5STlR/H52+b
+8f2V0CB8LAUEx7F+wlSCBpbLKXHckJgTskEgpJACM4IXoESLX4YIG2QRpoP8f4MIUteOCNJ7HIno
+UqNyTfE2MpXB5XTVjf30vcFtajwcxoQBBwZwQGc5kanR8fVWqDfWWTcmaRmzNEpHm+0D2ggf1Sz3
+La1ZOKLD0xXROfl5HmuCJSdiJpJEoO7bWm2mFpmrn6g+FFp3aPs1ZFauqhSD9BdnV68+G9x/Zdu2
+K3RQeUdpxQnjo/SCEX8DAJfOVIIZkmGwbHAzoOEowZJoNpKzTDNh9W48rjVTFtUto6UsNeYGbTJf
+o4XMYJF4PVgkC3QNxxmNFqxTix9mtrbLIhdQQ7js0ZienohLCDVTttA/qR7BvyqvPn/o0PNoQEff
+tWXLli275mMqdyuXnlS27Xl8E7Ii8+ObHrj3nk8/u3cOULAt3ML2ZlaBBdIhRzb4B/Bmh0UAvdlK
+jJ/NwWYSY2cAbZWdNlWleDfa63ziZTW2rjELk63hF1ukUim81vBBkRUhFSQrx3Mk81uanx5UeY9/
+8IfK3POD/fdUKgzqS6Whkh5dp35yQG9+6uNPqG2Nz5zIuuMpZELZyofKtafuyDrxTOM7aCyapBxU
+1tx7LH/+M4uX7/sOwmGYr5Qxf+KsZo6eTd4dCH9DbWYqwQiZsoanGK1RMDBqihE1otugBR3LAF9F
+bdQ3GHAscyPh9M/ycZ60TBSTsuiTBcovvu6oqHzP8/X1R97VnvZeeI0acWM/tLXDaf+7dnb8V+3s
+Oxhp5/1X6Z9/+SnSjlJGL2EqIQH6y3pppAE3lRDflHgZR64kGHQg1ZhP2+NHZxdjrUaN6+0bj+k7
+7QY7jJCgtY14466pe17/mMilcuKXlSBXTuB0PEg6uspYp2ngT5u1nABCiRkbFHJUo350vLWlln0W
+KpoKME4Y2ekhxPVaH3HE0rVm6cJrQS0nEBdsTBaSNh3QQ07iLLyuSn86CRwWuiqxTmpI0pk5AUTM
+YdFIKK1Ftd2IYbu21LnPGdd0m37XrgtPPT9s1rfKiWfa9SO0lwybWRTtDIKHAOg/MCsxT7vMU3oQ
+WF4UW5paceZsWQu8vkrXgLgqhij2RKFUtfoYx9uXBxU4qB5sqG9CZw4epPqo2UbIuSGubpxnktTd
+FIzUra0y3L5u1aKXQJ2vDV07qI4jWjceAPErAjA7yLnWBUflbreJJbDRjK1GY60xNOgYlqMlDbgS
+jKzWYWYkq1bQqy7HlriIBBJu0PqbwQYparCB5b8LNki5KdjA8tvBBp6c9oEG1qyFOUfOHRiyclRN
+PXrofz6eH/rxraWrF6+lDNpFFcr7yL2mhxJgKvc+O2sjekp5uWzE8EqAm+dkgGzT8JyJ1rsSzCyF
+UxIhqwC3Dlo2cYLeqhHoGqutRoAGS+blHBIyiP9h1Cx2J1hyaSMiJlXO67FEO3qqvmbUyiEHzh3J
+WZg1e/7H/6Osqn9r7eLVS5lKJdBjDXIr71cs0jo3znp2L2pVXq4cPqKMxG+WYfwdZMEauZ9BTxl1
+YzICgobitWMCGYG+jpqk09laXSCDccM6tIFxb/DgtDpd66SN2Uk2ISWg1WWk8zA6XTDyQkon1Y6J
+kS2E0VNsbVJfF1H5USXuxMfg2/F0ZqH4bDtupI4tLRNFE2P4e6GIdyuT5M7AB3j6URwmumHatuLG
+Z//z6Yxym7o0m84+sPHOF+QXduJIUqb4SEbG2LHyYI+x464Ne05KjJ68czdmTxg1tNRnSq/GkaNq
+vNdnEU5NC3STDZLVaBrJ6rQCUi3GMYOxbBCrTKetWsRxVewObKiJRFDZ2xzLMf3sNZVlkahlQ63M
+GyTFxI0O6hsZ32YqjJTNhlQXSvBYrCNZUSs4UlCJJ85ULTZh4SPbU2qgwaN31ZictirraU+kH55Y
+P6JEXpY4P6H91lgub16kb+iAas4eWlv7MlM5gvQwdIiOM2ujWZuivJ50HnMnWCDtpGmkTkCcVWxu
+wawtstZYJZ62MlVcgzXCoszGxEabTEQ7VEbRoUT6W+kMQiEa/I4IkfacpjmyPkljoCQ6QbAbbuI0
+1bt4OrEKLFXSDh2REmJrTnNTlkflOCBUprlxTn5vWiTl2dI/njHnjT6h/AkZI2M/d3wpTa+a65Ge
+nCdXfvpn5ggZ/a/P5PbJSoxymb5O/cBMBgvkyhpBZzQLLNJQmUXNRO3EnTGyINToGkx0jZnCyuj1
+VkIbiuk4K9Q8OG0TgR8DOobzX0ZZOJXXUe9NVCUh4Azt36S8oc4z9RO7FyzYU6wyfoKEGqxCle60
+VSeoHKFmI1mWhCMUd4QQu5IjUhsXqHQLF2iEM3XYrPNfnngmwpYadJI3gv6G0KSqedRYM9EHUrB9
+34BcVSLwTI3WajeIG1nUoLPrGYEbkYgtle6IkkssXTnXG1tycnAoXFAyZ9VWpn6QGrFXRgJYIx5r
+khA0GsF6pI7qUXfqlGq6PELvC729atWmY9Td6GdFwF8HlaIXZ06/9nftwWepfftORW35XzHYBuaG
+MbIhOcnsAUaymo1aRiX4bG5sUvk9pWQDqkkRG1Lt9Tfxe8qOL6Uvxe+Tv0/5UPpQ/CL5ixRZkkUc
+N6SvQF4sgLBmjoGE3jyPLUrxm9fNHyjwMA+1Tl2tXP7rX5XLq6d+h07NXH908+aj62ciVEs3DPrn
+zn8qLSj9nzv/OehgS99PHt33xhv7Hv2kL8mndBaAmcRMgxToLOtTwWx1sKJe0OBpDDZifxteU1qp
+nkmsd9TpGhgChGvNUlN3t3H0F0QCtImD0Z7ATHp8y19KToweVefPuCt389Ehg+feXf58bhplurrp
+icrKj6nzofxd2TlU494rd05P2S5k3fHCwri8CUwlcHC3HGAkmqZYiWMpimYllkGAOAkQ9GUpdIbm
+WIZCLA28yqMZTSWCKTSHHrWNvSMa7txoj5D0Z92e9jMX5appDw7+ig6eUw4oB5jKG/vpF4NjAam5
+Q5lK0KPvZZOg0er0iKIZltMBb4jyd0pj7xh61Iy/WfA3HWmax31BGHc49KgBXxbVy7hHTcR/oGUp
+Wi+c4WkAEmeNjSNZ1DhE6bQ6epxWx4zTarTsOI7VaLRIAj1QEk3p9cBKDCvwAifx/G2ZTGM/1V8x
+V2JYyzpYB9VF20NbwpZQmDtzLDuWmqidop3KTqVmaZew89kl1HxqPbud3UE9qT3OHqfOsu8wr1PJ
+LKthOFpHafU6HYNTIrEOJoFOopx6p86qsQk+3sd52QDjoX1UGkoDnz6gS9d6NF6hkMmn8/WFQpax
+hB3ElNAy3ZeSkQyyvr+uv7a/RjbKxjK+jCtjS+lR1Gg0Gkr1Y3RjteM00/kZ3J3sbOZOejY1G82G
+ubqpmruEecbF/GJuOXs/s4y+n1qBVqMVsBqW6ZfrVutWapdo7heq2PXUJuMOfge3jd3K7KGfpHai
+nbBLL2duF/YZn+Of4w6yB5kX6BeoGlQDL+gPCi8bT7CvMKfpV6k63RljI3uOaaLPU8sroMLj1SH8
+H+U6US6XV/e3bz772zd1yPfZv374jJ4d3I6/mMrgbHp7fL4PCUpqLZwRzGJzc2Nj9gm+htthBLIr
+ZP1RNOgl4uZhiT3+JF5PDK9Hqp1FlCQ9SbHCi61Cay6K5ibkORNSF2hr6aAhOD6yY9dMD3rznPK0
+8gzz57d799qd7q2afOMYMQcAgpkAzD1MJQjQXdZSAqtlWEEADd4bm0l2geMMNGADdE6WrKcxRy4l
+IQkEIMbXVvzdkovdUJJXmvkZ8qGunykVF5hKTNwf2qyI6BpEMbfMGaJPHpbTzBJFU7QkmaW+mhrt
+aZO5BhroGpN2JGOmETBItLZH4opxSFwxQv6Jj3atER7UYDvqz/aEnxKrM5tsibTT3MmcasunC81Z
+NkL4iSlnTf3M4+kJ5ik2QvhJVzKV7DTzatsa28s2Z4z9tE2L8+SpwJphVO2wWZklJ54JFUQRNZ8/
+TzYiDLcl+1B5eAvBGCeBB16RpQTGLDlTzHoHjUCPkD1NbBkaG549bnj2+OG1qDlmDI4aaLDYhfpU
+2lljzwwWtbSKTSTs3aFOZKIjse9NE9AjNgGJrM5h8ibSTkcnR6o3ny50ZHnVCejnGE9PcEzxqoOf
+5ljtXeN92WuPDDw3Gi6XgMU3iZbD40c4To7nyDS8nDa2T+VDPfyo9+g/zKJ30cp2PB0o3P2RknIy
+KQcGFowvHF+Z2Su3qGe3Q7Qv+FZ+BUWp62EYk0n4JGtkrdNtM6dxKW6jR2yJeQjcqocgQfUQpHiS
+a1KiHoKUBg+Iv+8eSE1y/x/cA6lWpzXV50xPzUstcA5ILXGOTf0/uwfyPDaqiPCm3uQawLSqRzAw
+G7UQ4tRbHANbD6lnrjnKMOZephLSoQsASi3IZxPst2duTbB5uwWyeK5A9fzkFKC/K69QFOr40179
+pMkHI+0pd85aO2PsqMCMXRnWHZr733wQ7X1tujLs9A2W6dkx++EhattKevdO99716H3j7jw4uru0
+/NeOQzMjHKjlAPSznBlc0E3WpLDmhCQBDMliS0sQJ0mRjYZ1Rr1ZU2/e4UyqASdkNl/GOsrQ0vIW
+Z/MEAsQqyM/zx5BYBfm5qZGIGsmLxha/XtYDFU4/XjpyxIhho0aNQ5ZH5tw7//B5OmXBsGkP91mQ
+YOv0olcyP3X/qcMLl6aEFt3cpzxZmyKCQCeaeRKK3ELioIziOpNeZ63X7aDoGqBu7ZTq0yMcEng2
+bdH+4Rgg6tB5QlqJLJi0csTIGGklnRL8itoYx1jZyRZhrGzfpwJZk5Jo5mkBxLZ5EqNd2phM1wDa
+kQzi9Zt7ZSG+x9uSanrRWIS7NecRlUtzxIiR0W5R1+mU0KLbd0t9x+hniV+0y0lfkmA2Q7rY0tpC
+zDGipkowVDE70pOqnAlV7tPpxNBK6EOzLDdPjk2yxiNLMUdFeXSWMLXn0Zk9esw8iuGk+EEq322Y
+y8jtqD1vfNnugcblGjIAxtkC6haNzeXSAn4gIYj4G4rmExKBOpGWmtU1LbXv5NQ0NEIZhDPsoDqE
+FC/qQRILvaW8iZPwUN92TUtLS+ua2dfjoZ5Q6pRvlL8pdWgQSkJO1Krcoewl2TEPUo3oWYjxWPUm
+Puq3b4kt6yUbs+1mt8bHmDubzL5IcJnY2EzeABJdpq3RZFnq09pFl2VoNfHRZT3jqXlVmeFLxRGi
+QDLLxmhNqAFLB5T077foyW0bBo0YO27Hn++ePr1S+QlN3bFD+Vh566qiPLJqflHRgvvolq5d5ZIu
+nXfmdi+f2L37onkfvrdg7v69TylXlR+UN06+dO8TiYl95IrHRo5QY4fYC5wVAjBQDpidkiuxxrYj
+A1gfqqF99YLkWu8mMUQZFAjObkyaWUcSvsZiiIKthdEskFEz6S2hRHEH9t8OKiqYla0GK/xmaJHJ
+5CEnd+WbaIRR5PmsJc/nzwBAk7N2V84KNJjBCYWypLGwTILZxCTziBWAIQkosCNdbFHDxmVdouE0
+0wCvaCycGTKLmnIuXw5iHjeb10aOdrGjN+9Ns2N5mn+2rq7uxGlzwdAGfOQ+K9QtRqundErmrN9e
+uLH/0WUDKy+992jfPlmpqg12GLOHxJQB8kpY/cpFUi6zpz70+T+p0aHqa1RhvTKM3qEkU3pqbOgS
+5Y3YbgHofzGVwIJTNsAZuoZjeRrzHnBiMNiEw0Mwo6yFkCycqqNWB99lKoO5W2P5on7mzBHMQUoU
+c1Bn2ug97XNXuXb4MNwgbSCYze2islsj+Y3euim/Ubtkwu2QXm0P1osyo2HY1MK4kHL1mdbHMAeh
+I/ER5W+RB/rL/7SX3+kwTNb5WXOCO0lIBaNPbCGBGq2Nx11JXhCbcK5zK9lg0p01nhQ33mbcSTXg
+jkp0Epjy3+w1tligym/uOX//+/lvLt9+32k+qLQi68Gb+j5c1vvFtGTBS3YfPL+k943HU9PAG+k8
+kfpUSk26x4u3Iy9dk+y9fectebl5v7Un9USRaI///P32W1Pvb745j6wHm2+3Cyi7Se+jPBjnSLyH
+HzoAxFNJ+UhYA2a174RuIZ1CKDOORIpKraNnYfU3uCNpbzu2qR+o3Sp7RYRCahA1WRl2KPj51kNx
+PFPDg7/QfJRHdJiAMaDdQAZAkveWhkmffk/hYuKGwEysu4n7Cms/v6mM/dojbkz0e8HcQ+3JsJRh
+WCu6ra72UdwYVUzwFv4NMq9GyIUeN88s8mFN2oyH5PGqOvRthkp0ai/Xfqo/pXaF7qLvDg17uejz
+rxFSjnUgGvZTNw0UhaxHSsrbzf2U4K80h0l/UOpPp4nm3X54v67Ov4NSc/hwMrsX/4yY2+wJuZ6c
+gvaWavtNpm2e66Aac9pMcj7/Qkp+oKRk8rGI5XpY1Lj91jOrhim/hBovjhrVlt8oEOjSudvg4R0j
+BuyUmJW7844NT9zZu3RkNO0RUNieyAqcFfyQSXqZhd+S6HZqscYxInnbOkVJ6ljQUrRs/KhzR45O
+9weoUaOxpdjvP036QuVSynqffyHu04gTd5TtysjAtm01SsIa7cKvQXQAVZMuA415cdh32b3QBQqh
+vzpn7V9Y0Y2w3Inii/huBZ62TpGdXy3HYuaOWBdXVkO47aWePl0Y+T7qhO4c+tTYwdQuTvkn6S/d
+s++YKTY3Qg8uyGCVj9c8quyK9H5FtWT2vtgpwXbfAmXmAs0LPy3pkGLOGUaP7BMbxS+JvC4wtEyr
+eepnxD+pDgcQZCpl3DzOCnl4LHFRJ21hER5VcMbNeTLy9ERR0iFuXjT2hMRKKB/VHz06fjy2Tgf8
+qAv1xwjvEAlLjMafqOET9J+CmfSfNo14dRyZ+ekq/5BqY2T7EF0F8Mb33yzGs3Uo9HuLbyRn/XXd
+7644oHC7zFecFbRggMdlg4GldRyt0eHd2KgGruVEEGjavoYaqoGtgR084XNQeb5YnOrWgO0jcTxf
+fVkmgXHq/cxAZrCeMxhMpkQ22dDJkGUqNJCUNzjhjamfYQA7np1gqMT2B66SrzRNM8xkXzadMX1h
+SsKH8TbxbJHQ2TgJXEftJiFlwc+30u+1ydb88Ff0t6wb9ODAs+gkVika26fo+A/5i7Zs3rBl86L6
+9Tt3ra+P/0CNU+zoW/wVeoyiQgrbq/1n3Aa8RNrgwQS5sl7SM4IGGbDtSCS7W3ORmmJfZzTUCCLN
+sTUggtgcbMoh37Isnsjyinzl08c3he7ZpH6nxinliFVuMAvUn8TPP4TZzFnBgVtzQoJgH2ES2BJt
+kthMlBuSDMFcBdTGJE2VbkdCQ+LpJLGVIBGaWrN8arxYmzNDjIYX0J/gwFnVpY+DaetffPf7K1Qj
+MeaE+irnXjtILf+lNRZrwC4hsb49ZEOyF2zRTqRFO4F7YSa9SNNW6XakuapSTic0pIkqWvj3u8JF
+zEyBm7vElN6dU3JyL3ubnt14fNvMPfWXAMFiag/VyNFggSyZtwFt1Oqwg6sos6lp6FHDqPJXbHL3
+CdnHVttQBcgcJ+kp/PciDAdvFwwdSe9NNS4aMWLyvYsXd7hvyPL7GbvyMFo6YcI9k5S99F3KC927
+r4O4Np3QY+hR45hyWUgGWkzQ6ly45aZY08lq08m4aZ5zSCYKlyhqbmpuyprwe6HY0V7ctWD/E32z
+bukK9dCye8bnly0g3QEq/C16Ca5yNBhAAq/MW0xIwMbVlqKmFsxAJPNGCQSemFubxJYmrFnHS71c
+m3fFom65Czq7+yYmLlrEmkIPLMvPL3c6y+gFD0THa2ZTQAK3zEo6XjCLLU1AagaJp40aIRPHVuAp
+vSWWgzJburqmzVq8OH3xWH2ymXlZq7nvHuUktXBqYQFFq+trEPUYusyaQAJnrcDpJDNWipuy4QRI
+tJHXZDaRGbOkoOjiVf1RgUGk6kWL1KqpIK4aDQk9plaNYBB6iVrMmsAJgZPJRg1nAxeuuaUFSzOD
+yQpOOlHQWDOLSANiS3wbbdZPql1jWX3dnRfcdXOLurL88nuX6WJjoiCV2kNtYk0kry1ERHYnHKaG
+57wY5WIUXpxah57sV9Kv/4bri+7p5fNlzFw51+NJUz65f2igQ4fA0Pvpb00jet7RpQu1UPmPzVr8
+9At5KSlhsCdmdk10AIJ8ag/9LWsC/U1Sr52gY003yTIEfvQSdZHMOyAutiBwXjAnykX7sjO7jqjo
+n5XkTLUpX6281LKSmjY/zTveah2aSc1SEtA/CEc6swjt42aKHG2GyGfagrnR8WeRp81oEbl+hP4W
+fYS5a7Q3yOdjtII+IZ+DZA00MlfRt9z/AAt2WUvvBY6mwMFyYksQu49J+KjN4qXz0I7Raw9y//Oi
+8jqSX8Rrk/4eVXFWMEJ2LfCM3iS21BbrR+qpiqaWk8CLfCpPVxRlw0nEAC/sMInXm1qOAaCKwqYs
+S5568sOUq1aeWyyXl8sLS0eOLKW/X1kuF5evLB35t9JSdY22a0fQMSaxualFNgADOpERdalMqo4j
+7Rh1IPCkneZoO1htxHYem2oeQFWkkYW4Ofr70tK/jSxdWV4sl0P0XdvAUeCCTrIGh8kkJBkhWWxu
+bGlpiqQVTAKzwagxu5LF5ui6vc0xMKfN5GgNLC9IR97i+3JysrOzcnPzjozv37/knjXMHXLHgqHp
+sl6fWGnVaMtL7p4i95OUre370UXWuiDRBIk80MliS1NLk9gY6QkNOtFk1VHxPWmzM+beYmdE/1h9
+z8D+A8a/lJebm5Wdk51zX+905Mtfztyh9EFzpH7ylLtLyrUaa2WiXi+nDy3oKAMAQjomh/qROwoc
+APLZDMjmQzr0D2UXuktJYF/98dHa2kd/vLkcm9cF5bHUj0oCukvZhf7BBmLl4Cyzii7mZgIHvWoZ
+ajHHiy3YhXuCYSk4zovXm3JqRUpkqYrCLFBJYI1j7yg/jf2SgIAnbkucJhPlWojz6+Q2Ujc3U20M
+2rcxrJZmF6NoGzQwCLdR1HxMBPS/tSDr86AHl0f1YJhcDLqrQESTodFJdTTMqug8qG1ejbRZIOsZ
+lqqF43zc+HDWz/9iRAE+fkTrf/wRbqmbhlo8irhxideLWv+3uulce0Fc16+iZT/+qKwHBB/Tf0eT
+OTPQYJEZFmhGbGnBuwtFU5mNZEWhyes5c+giRfiv4SI7je7E/iRycB9ZHwuZq/RobibQANhNTT36
+tnJqF+47IDjMXKM2qH/TIC9C81DSLuUUN1N5BKfZQfCx8ic0GYK/0bZPbTuoNk3aVl6nO4XHEvZU
++GUymZvDiovaEH4Ct8G2tTFXbQL3T3HRo8PbyN9j/btXWQ9AwTwA1Mx4gQIWBsg6QDRLA0OxiMNa
+ytCjYmn5cV6m5O7dJ+SoMNPmxixZY6IBKmEezVQg2VDKV/KVTCU1g54BXAUmhLR4kOcYuvP4ceVJ
+6jM0JBRQTuK4MeqzSFvdTgKigUK02Bxpg1HbwI8SN8Di6qkKJGtKGVI1MLhiUiuukczFYqSgKsYr
+8tQaAMV007W16jVAyI2aqb/S24EHQCQ4MVei/qr03r6dmr19O2YrvKkMAT55JeRGb2zfTsP27Uoz
+6gwIZkCYOkWPAx0uk4vtWzZvnjcP9d/+5Zfbv6QW4+9fblfl9Qz0BeWnFpM14cnzUH6lN3oDfUH8
+0G+zHehCbhAYYLxs1F1jeDhu5MsEVAZGsaW1mTB+FDW3qsu5/DQYyUo2gjGykmHoUdNYEqxA4hpw
+CZDVEpEEupZclUMgl+gOby/RTR1fVmlYot27ZctebtDQoUOGbdq8mczNbrY/NZMTgYYRsoZ6H95j
+0AxgRNKJIOmCVg2OKD8NDGmFASbSCjpqGDr2qHHo7f+Ya/Oi3U1NnNgEQMFV5q+0medAh1mmZIk+
+a/tBc9b0r0Qo0y/jysyJYnPr9SjVSVY8XLkggrHEp1BqZ7ncZ/z4PnL5in0LF+7DX+z14vLxcp/x
+5fLC/fvxNUBomLKYOgsAFkiVTTqjlv+38Tog0DIBySo24zhczAATbDQXZnlyb83DjeqWdulUkJ/a
+2+3vn7JiYV6vcnfXribTw1rt+IqsWYDgJJtMD+EKwAnT5XQw80Ydg8y8SceYzzl45pxN953LhIzA
+28oSlvJlwhIXIQGQ1Bzs2EROkG+Fsf9RuaUnE+kiT9sFrqjcCqgUEd4IVVT8k0XBwgSPwZ9O3U/N
+vMMv+9p9YicWWC2DR1dtdXmiv6i64TXmKn2EyCQe+soSR9UycFxgeBpYRLO8IDa3NDdFkkViwZrT
+XrAKpIMCCDGhTXvpXNpLBY/9XLX28BcbsOjDniEAFA6jv1AvMQKYAGhfe9IChBLa2Aj+Sc84GKUa
+OAAIvmeu0iI3k7xrAd5bEMDCm7dTkyZV1J44UVsxqfbkSebq1Kmvnq6sPP2qOq7F6AUqRPjibQAo
+wWbFvoUCIvYRZ7NiYx/FHvtB+fHErxvO/HGD0rBh/Vq65Zfahx468wPK/PJL5ZEjO4cP30De3xXU
+HnKmocHwCkVnNmXDK0Bnkhw03hWLFnF0cFdMX9rMZkIOli1Rt108e2kcpQPRtTNRnHpCzc7O61Y4
+vlMnt7si4/6cbiOGry/s3au/XNy71+jyVNe8jHuzsgaWPCAXFrKGqQk6nT0xM91qMwuJHTsNH5+b
+q9Va7kkxmXK6dLRb7YKtQ8fB5VnZGiHSL9rDmsj80YhsqngiaGsY6Te8994GZL6x4dVXWZPygfLa
+Tz+hQajTF18Q+anqXSJHPUjG9zEAncyWAws2WYNqaACBoTjsh8uGzObWLA+JVqSqlT7UQObD9b8e
+iruHh4CcWMwUo0wmE7kZN+JQDcMCMAJHCbgKHP/X3NJaEakll9SDzqyn96xH3X49BO3qcsi6299+
+893U3eupu3+N4P3a9WU+Mx9lMVlIZETEMTXov+kL6rae3vProVvnAmowIxcTnQucXxsbz/Oo6vWh
++vXMh78eWo/z6oVa6eLwxyJPVQH8Ml/dw5mv6E7cEnOA6A9rzFiPWKOeUeg57EUzp1P3+quKlTZD
+ochTewB+ySXjOaksoYeEN4MHpshGM5JAk1TmXKop0y5Ji5cysg0SNJKRRQkas5FNOOfWsOeSjGli
+828In9vKnfYYXHVJx8F0ifSRxDYQLhFA7S8oxwus2ggM1+WJ+x2ACh5iVipVRA4xkC/rUS0cZ+pY
+hu6P2IhWhxN+tJM9DOkkA0ykkxrs9QvNIQoPKlfVKsUGVOg+ZmW4tK1uKr7u5v+ubqzL2ZUNWFna
+hEXaQtzns8zKsKqH8lAkmyJabp1AFF0BdztS93+p6rKhiK6LilVtdyF59sFaNjk8iCsQeWrvDUXN
+7xX8jJ0WzmR/wrpfkFGv3WhlV4GV04m8zhOESLkzbIdwNjdI5KndN34mF6nQAWalMpH4bhnwyXrq
+NXgFzwcSIDYfeLtHMs8w3Zj+DF2BN3B76EBd3WTO+u1RADr4CbMy3IHoCjwIMBjvHO8z8J5Qp0Ez
+BGBnMBp8XC5sJZWRvJiylxG6Cf0FHOlUSVcC+clWcpXCfDSfms/O5+YLEmnJl2vzssrkCxeU5Rcu
+oA6ceP78+QuAQgXMD8p/OMIpJWusZTK7Rl/mMGQ2tSa1JOEgcs5+VqZeN2Tij1kEom+zknSrNm9e
+t/xipLpW+VDB/Fl3ry7v7GX4pSm5Xbr2HjhxBjcod1y/Xn1SkzRiqj4j35eWlmFL7xYOB1uZv4ZF
+nsPvXWzurzNXw8e4mSJP7VPnHqjgm8zKcL+2tRA5ldQJ5GAStxb+26MJHzqlnk1GRA8neDGgUAPT
+qvTnDgENxmOUE3A2/VdolKkedpX+67lDSn/UoPbzGtMaFrlDYoB6LdwNQOSp16Ap0t8K5mrYeeu+
+zyLgacSz/+d93+KlPRYvHTK9V/UzBa9t+CK68aNlbeuQL8P5IQBQ37j1iKJrFPSQeELVfTOJ5lur
+vcYAn1nU0ppFe2OKT2rCB206LErby3aI02EVN/1tuD+bATRoZZpCDBBToNeSq/zw0cG19LeomLQZ
+2sy8oTzAnSXxAqI3Ky8hL8ubl6s88MP8+T+w55TwSnqDcnO5rLxcm9dakGVLC23+1zxSTtlAr8T1
+hZczu6GKnBsB6z/y24t3cWaF5FALHgp9oVSFx+HsbDeUn7/H10L3hS6FS8m1Jeo1QKF0xaoUhd8n
+5w7s8cmVvKHG/fuXLVNOLHtaKSFlGhWr0qKWYSPHjlDjsv37l+U8vexpQMo4dh0U8/OBBaDx3uUd
+rOxF/1jPXVlPzuXKNfoeWMl8CRbIljm+zMhIkBkX9GxUw7BxAGRLTgt+qVgtcxakzMZgUxaKkQwG
+ciMAG79yrb1KTH/SXmHGfV6jmJWy8GtgAJfMGThtGW0kDgj8ynJnecNZyAwSbCtR7SOWKaVs4qpV
+EzPy8jIU8/0TJ6xalhfIyFOfnU6xKj+Gh5N5IgizvFyl/ytKwivrlT3r179ySxkcf4/nqeEV9I9X
+1itvRsukR+ZSF53v6Hzu349nFM86ntRIfeuVH8MiOcPleWwhbPRZv349+dsKpSz8IawBA0DAFu8O
+zYq65tZsi/jecLxvaKlSFn5PLd9eDQ4txUrwt8qJUaPXqApw5ceAQgeUDcrE8EL1zG7zYjn82D/C
+4eBFZUM4EB4j8jD7hvJLPyLbMxSz0hx+jZytUmThpiOVzNnO0pqzJnW+f+NkpdyRH8DzHshfvmoi
+fggTVyn/8Ofj+c/3T7wfP4xVZNyzFauyKTxWfddoUN81Npf2hho+Orj2ReX1cDjkVRYrZ9XMNdE9
+KTQseo16Sr2G5ZpiVfqH97eTa4jObMpiVblWqSxCGwEFrymlYXt4N36uvEQerPTtBuWfG2YqJ994
+A9sCAAWnK4XhneFysAIgzptGd8vLxbpKLk4VmUvxaSGlaNH/SIuNd99pWqKfNdO0xNSySHl0NEqi
+6O3bq9F25StE3ts3FWu4X3gjzhwcZH7xQyAmAmOB0XcQEU6OuzzBCpAzOZZ3ijXci9y7/obyf763
+MJwdLldl5C+iKiOV/HB2eBzoAX5HFir5MVmI9QalMDwo/P8h7j/Aojq+/wF45rbty93OwlKWBRZE
+aUsRRVgLWFDBhlhQbNgr9o4ae+yosWtMIiQqGiNgMMUakSTGQBITUr7RdGJMYvJNdHf2fWbu3QWM
++f1///f9P8/7JCp79zJz5syZM2fOnPM5gwS74W+LYDegtzxx2GdEbW2xG1AQ0KNXeAno5gKtn3nu
+8xL5I+8cPd4Mmz0naSUIBIAWcNYj7S2QhYkkk8SRmDIoZ2vu8NlGRb+Jjtxne/ffMSh5fB+lYW5h
+9gZaeapzqkPOWP07Z55KTzGGsPLULsL8L4RXPOupSUR/GqzJC90PqEnHSQ1y+OhneMXjoSZ5196j
+n1ktvEK+hI8neY55aplawADAxtuh6fE7RfQfo0f1Z+pzye9qPRc82zmT7/tHiyay5uLsAo4dRvp9
+BWnRqn/RTeBtleRtjqwVVoD4FZ307le8uqlbi2qCrl/RCI+/5wWic8TUV9evG6BhAxoB+1y6hM4D
+ym1FenTX8xHZdwGrsWpgss2A7qIM2Od2O+wXQudv324rw2yKI1GnN9iw7WKLtIdJbNrURDfqPPdT
+zXzVxGJ+vkKU4UcD0beiCMNgKPA1hvkdfcOdJDpOYk+1p+I6JSYJki4rWub9n53V6gOAiGMueUK5
+2yAIAOHSP9UgFIYT8yrxdJPkIdTdatm5bmBvmVKb6vuJvW6Jt+QNoGZQgRa50k8d0/Yjli2mE7FT
+7YKdKtir6A1hr2Qe4HOAsFeK+mI2cx/b78JeCf7VJvbu1TxHXSHvfMdme8ZKbvMSOsjFi+9cQ/no
+mOc4L6GNwjMAXY1skCeG641nhBZUirVxN/poN5XPBmGnINarISgR/cdTLcwbFOu6o/+QGcN/Ehfu
+XojfMyA9+slzRJxfnDuPd3HD7t3QuXs3UWJoLp6XEKRH//F8hPdplly/u7GHETVgGWgPoOs8c9/T
+hysGdgCgUMGgNShSJHaDPAGLZHR7uk2fqjC2PnOFBlu7zZiq0rd9xnomlsvU8vCIgev69Fargiae
+UKh8nwDtOs9s8PTh6kEQsIII7HMhizwI2pJSWrxOXaDgdsJ1HnAQp9uVZg5Rhkctm5gf3iU+zRTm
+Fxq1jJo4OKzz97t33969m51kVSsSO3AT5vOBwSqlI5obv4CP3U11303m6jxz1dOH28aHmcJBFU+b
+wgEAL5F1EOU56LmE90qYajXZ7KkOidXuMEnQrDXIvGbF6dMr1sDv1iw/fRpF7d//7rv4D+avA+nR
+u57jQEr2dklyqslgR4nj8RtIT95fvoboHeY/aL2EA7xw70gwirHvBuO4ofXOdu3bt3PO6Z+X138O
+twm2a+/sGhMDl8DcvG/z+kOiq5kiTwL3Ca5XCgDYxnNUNfjG43HHID36xvMeL6HuA/C3FbTuS0ts
+SSO56JPE4xsyvJrcC4V+SJ/cpiWwf963eblwCYyJ6eps387j8cSyPTyPOJ6XUPvdu4js0q5tSOuZ
+6XkTSIABr1dgTMyAkWFcakqiEYdxc2GRSSmJ2pSkyDBOb3TLknOTqdTc5P9mvLZ06fkuGeeXLj2P
+UlJyk5PR5eTkfqnJ0zOXvrqsq3PZq0vReyn9UpJzkz0e1ywUSG/zPMNLFGGuG+L6m8VNpbdJGoVn
+0jyyjmZxH9PbJFMFP7fB6polCf3rK8nUXeQ79Ba9jdgKgPV998ouwpd2nIr6SGIHNgBo+5NS/aSn
+wR7JbLPktg+fkJzNHmNmz3pnRGbacPJkTFpPxvuE65Oq0MrD43sSmQ4jH9rnkA9e+hl6m2QGL1HY
+hDEB6FqAouidnktEZpKJkNlSXQu4EkFa0GUiPWLt4Nn4vljzK24Lxy3Rt3BstOY3obY1AJyFbcQ6
+t3WIzQ12UB1aexOtrWMbXQ+psY8a2Rj3IeF9ZkKr98X/btAHqmtqamravI3bF++5AQ00Tg4ChsJO
+enIzXR+Pb+SOdH1jBjMX/obUQHgf5cPDoJfwPkUzgGp5P4K26eDhrm/M6IXU8LdWtabx+OiTvtj8
+RXh89Lu4PXge5VM7PMfJmsQT0gWKViy1Y1dAgL5MrlTbUL41IPabb9RKgd9enHOeo4ze+n8Yb5y3
+g0Uw17OG50B2m/fsYBHY4lnje/+QRwsvMzYtB4LxZ1xbBe7E/jHQQbjX8t27JThlxMND94Di3cX/
+xrnT6qKO9Pcxm00PlNzWRoIpAAeWWACA04AeOCWnpYelo6V0oZMdLfWTUrPjW+pV43rrtAV9CgDP
+0UK99M+oXvAUna3lAKnX7vkOAHae+N5cEpUQA34Ua7b/DgAXIn63knzXAdwTv/sZAGqZ+N1i8l2c
+7/d+89zloPjdCtJ3chR+/h8AuO3i8xnkdzrBCm99eAA4VvxuEvmdjBRv3eqVktu8nQ7yxAKg5ej+
+fqCl3o+vXuUNoeaElgMRXpkndchYYHYqmTJYCiQUY4GML4z+YXM8CaLX3KCPuqPZxopHjQBQ3nYB
+hW/AncpuoIwphWW0lKMlPE7IJMmFJLxPCzQsQ1MajmOBhoEammZYCUYIx+mXfPMwGbSy0AGpWlRM
+Jz+uoTp9Q6Wx6sf7TrCNj2Jaan4TmqPI55EA4HqrWg508X7Pdiaf8QVrS34MznGwgkSnhSnTbQ+j
+y8DJMEVZwOYwGw/9zEYHkJqDeQwpeeVh8xVB3oT/47EWxOknJIw/NUXD2yPtOJlGwnnhkOBV6pI7
+A45tKl0F6aam0pUrS5twKk1tLU6lqb14sZaeVUGtPX5cyKA5fhxn0Bw/flxIobn94e3bcCwc++Ft
+L/3immjvG88U8jmWzJGX1xxIdyq6cWVUKQulDPQyOt3LaE5DU5DgrnAaCmggpNow2mHDCwZ+00hY
+fBtFYgazap/u4ASexrfWJUK9elwnCgBmLNtI7CMFaOc0SsqYUgXmqbRMoWKgjAVShlFiZIKG9Cve
+5FKRl7SIJQUTqevutMn0c66J5M/sClpd8XhFhbfeKPvAV5fUN6f0OZ8MPEvocfjkdhPRvdlOTTc5
+VyYpBUwZrYJSRkLIaCCF8whEgcAdhYZlGTkWQ4UGSyLbVgwjrAZrsgyfU6wQnqE+dWXAL5GVrnR3
+hF9WVFRQsyhNRYX7d1KjHABco9xbm1yofUo+pwvyBwB7h20EUqABdpDu5KNVZqs2AFgigjSM2cjj
+BKEGgrHe0CyAFYCyoIDAUrtMWhahtcc1pGNcLvx3vM+2cYjWhp2OFCQwNdlhMApA+RKDjbq0e+yq
+VWN31+0eu3Ll2N11Dw9dvHjwj7qHB2trDz6sY7YYhpZcu1oy1GAYWnL1WslQg4s3rj0C/aDf0XXG
+w4dNa4+iX9FvR9eaDpKarb711JnwGo/nM7YRBIFYp4lXlQVSBrWkFIQYLEZKbQn0k1okwfjSO92V
+3tBMyhUmJuI41nhrih1jCWocGl5IS0hJTTFDh4aScDbORF2C8KPaarZx1YyNdRtnrGpkq2s/gnBt
+5e16OAk+hyaib49vVtO/uvzUm/H6wU/21t/udR/T1B8A9n32OxAJHM5ARVnw9khaayij9LTEHMWD
+MEeAVm02BPB2ggqBIWJdVwTMOIwfHB9hsHrNN1sYEIxHa7IV3+phrDOcXoZtPPZ994kz48atWfPJ
+J+i/48afdZ+AmYd693rrTZgA495+q2fPo2fPnh3z4ot1D7ZvR5//Uvfii2POnt1UVlYNM65evXoF
+3by0a9ezwvriAKAKJP15uymMjsP11uk4AID0nIzmpLjeuk+OhHeY2eSdTuQdJSXxvgNme75j7nHF
+2kg6m2h/y4xWePgkZs6SBwRUfMEGIBi7ZL8A18i+0BcAEVt2L2mnP9lhLB5hHy/zfM20w/snPZy0
+HxTorWvzHXOPWQRUoB14x6nQaiTteZNEG5EVg4X5yhVtWppTnsnH8SE8vZWHhbOBU6PVKOia6Gqz
+osa62a/WuM5Mqr9j/Ky0NNapD9IAjVqj1Wh4P41aAzRBGg0v4dObm5ul5J+EeOhUrMEw3XCDaiXP
+Fjp1vXW9tb0tfYL6q/qC/rC3OkcjK3RqOqnTtJ00HYM6ajJV6SADSgudihhVDGwHotXRGrbQqTL7
++asDVYEwAJg1XCFwsjHBMSFUIWy563UkpmITzGT0efXsotmKF5sjlO7fvsPZtV365fRdtLbX8XHH
+342S2aJDC2aMGhytiR3VfutPc+atePYaTKVk3/08Y+P5pslTG+F3MMJ99LOE6PgDg9sVzBwavfcg
+1MHIjfswP99C+cx6zgrUJPPVz18rCc7SaRVGYJHSAjoULhTeQBAjA2pYmaHGqKlRbaYuBmHgtQZX
+WkMziSziraEaXsJoeGsYbXSEang2OVSTZAvVwEU1sAKefQX6o+CGYTOvwlAUD6f+iB5nIQ1Hw3fQ
+CnR//HD0B4qEfU7CTjDAWAGdn6F3AAShuGYzwblzOiU8LZHikMiGBgHgVkIp3+QuMpK49OZ7ifGs
+Uws1UgmFEb/UKqgBGolUIuGbm9PSCLoC1AnwBlAI2YaT/4CjLqPO1ejT9qMTsq/Sle6+9PjH2NCt
+oHssP9RSQ2ke+wDHDYLBTj3HRjFxWZYorXyAltH6W7XarFgx4/bsCjksbL5CMhWBU82xEZQFUDUB
+ETXqzbE4IddFcOLSnJwxaBDLsRI135yWEI9ruxr0rKg+ySST+vjJvDXR5FW5XqSVSLq5+Bc3lN6a
+t2XDhge/bNywITnnAKz5fCHy75GaPyS/bOcrI8Ysd7Ka7Hkj4yNmrUM/of8eOHjwANTVv+AMz30W
+8tkoKqj37Pqbc0q+f+N60UQFB6C3XgVIBF2cYcq1ivV8jbTaEVju77CvjVpvq2m/2ZFkVtj1UiZE
+Gp/lIOPFSI7eSkINYjGhlkiEVinH/8ya9Za1JbExm0ga7JW+FWPH5A3o2TZrtnDIkJ7DBw/Ju19a
+upEaKebB+vu/FKRStcma1eoiXrao1SNGnJy2AwAIpqKB9F8croqZ4VTI1doQxuxv0eqD+YbmhoaG
+K676nEp+QEENMOIrCizU0ho5qNFuDg4wrfPfE4zjCvmHzU3N8RHemIukZKOooUNpnqTwAw1P1a3J
+Hdwn7/msdqGhIZ3cxyDV2d1MF7vbr4A5uQtL8obm9GXb2yKS27ejHLAZDqupQbuQHU0GPrxxZgkw
+gASnkVun1lYra6mLClM2LQUCCqpRADXylb+Kd7JHTCtN1BOo577K4V688xpfts7js0yvtoXBhZqo
+sz0/MPO4cGITWEGuM1BVo90TBss0YWwNNkmzgExrHAA12uAsgnL+8OwKIyxsThTt0ganJFMTovHT
+0IXNQtVGM2dkFjBHbCttuFKTVSvMs44SRICGia2Em09Noc+4V/tl5ZftHtRbZknJ3/ncAKcOdnmw
+YcOGDQ9+Xb9hw3rqGmpcceDgRngDWsYfOrAcuahzDR+ijWhTQ2NjA5wH53/YAKAXkxyoQKBT4scB
+qYrk+BDVwKpq2XXSuOZ7GO66VUUYOC4sfNqwmecGDoRMkTtme2z8gaKxDVQdnpM3AWCeIZjmvZ0y
+FcfIoICmjfUfaZMGtRJFXDrGUDFKNTKGlWATTsrKGFrDUBqZjJHwTDPPNsvS0yU828xLm3UODXTg
+SE2JxvbmG/DuV6gnRP9BYze8wRShOPgBGu7OprqVosxWeDIqkO1k/aCCVfMNQs8czkKhWGJeO/1p
+DQQco8E6RMNp8CdAEYgZ3KWsuZltFjsn0Qw2SPIsTUZqMcVeRtfQ8svwpz5xU4vmfc1OeLSfTndd
+YRKvLT9E0SSuHeUz3+BzOlhA5LQPAIw/UwQMoJtTYpJoNQAa+QYhbZ5R4FtiAilj1Ou0tMag4ViG
+logUQR9FWlMai/+yQoJwg9Pn7I4EbzlmeARVXIPWheP7MpS/ZcqFrNh2688sYYpcg5e+UjorefyC
+1cOWzul/5fS9ucLaZiDJI3Q6/eRqbRQTFmQJ1fpHaPV2nlRswCxz1Sc4teKqthtt68KCA9dZ9mCo
+bWwWpv376tbwEeGaJIK2FarRs4ZwnZb6tM0y3w77oe+bbtEG9yaKd1+j4D9WO3wNti8Ih8zVgTU1
+6PM3ZRVGVIt+9Xi8mL1aDgwUeOu5w+xlioAJxDkVZjnUSmmdFBj9+aYrpPBNfYJTKVmn3mygdaZ1
+oNaAD8CJAq4edjC3CTMUUvz6MAnPJqT6tZfY+kQsHInG1W5liqrdeb0yWfiMSrm6kjrgKqBPEFxI
+lM8cJxinQSDBaTbUMNXBgTXyzX4Xg0OytFrJAJXUX8sTKObmh2dXSGAhqRznPUgRneMtZ4fVEdu6
+1p2+y57O532V7OgYb4U7em/xcFtLFTv3Kfr97z73VvyCYDYaSOrHheMImyCr1hQp68VraSHvvb7B
+dSXBqQmsCdLVgM0RsrXy9aoarjoCzykhCt+whD6lIsqTk0ydQL8LMAa+unanV+f5ZjjIC2HgK27X
+t+30ttgFHxHdEwwSnIpQP7lWN4DRBmZhLLqHZ1foYGFzA06ck9SYNodo1oEadW0IVp8C/8IkIq/I
+tm412IyGVgqSKtk7MP/lsp2v5Ofuo8a9N13Ui7Pp92aUvHHju+/fqVo8Y0f1gc/Rz8LmDg37S0k8
+MWCmM0Uka0qqU9F+UqVcyzdccZG92in1U6xT1lI1LD4OJ8ZH+JQiDlDDWcPUt6JqRCMZGRpaL6hG
+bXW1++52LK/EHmeKSC6HPDhLptUBqYUYiA3N95ySzOBMWaaOLsT6SmWpZWtMcc0P0wX960h8ctnb
+BXU8MDF16u7Jnf2kwZYpF7I7tFt5AL06YSzRzeucWbfmv168csHq4UtK9rlfvwcXzr9K1Qn8J3jI
+zCQgB9Ggi5OPyZJo/XSUNNgYZdMGZLUjp9p6vNix1cqDkBpFcHU72r5OZ9xsuNgOA9TjTZ4EFYUZ
+NK0qRZGNSmPTiFNBjBViuWh4ukvX0aOH5GenbF6xYUPpzarra9evL36xW6f8/H6z6Jvb3QMnFX55
+a9aIg2ueRXfQr0f3ohlw+3N4eoJ3TLc8b+pf8kZdyUTqNIwFEHRGQ5jxzBhgAqecSoaHKkbmp/ej
+Zf44oS4dg4OdMfvxVxKcOvKlwU9a7ier9deXG6iL/jjol4AI4vfiKyNE15ZGqWFpNWDVGj9KQ9Nq
+DJzEN0uk+O9zKqVGTfudY1iA/+GVGsrP7xz0owDrd46TsCwjYTXatHMmo8b7o0Ku1CiFH7U6jfjj
+MJaGNkiTHYUxYYtah+XIHk41laF378Cz5xP2JWRDqusu6LiD+pNPCD2CK9y3aH/48fJDlOQlVErF
+u75H0fgDlithLotAIEhxqoJ0Ur1ZJQVKTspaWp/knWqdkq21qBXrzGBz4EWLbw7T43E5PhGUUDzZ
+k08kW4jK+m7q6gtD+iytWt19yIXV85uqzBFjFvxObXAvr+rbTU03utqruw0+TZW6F7pmjLNhJyem
+aZ3nLmcnuF8dnXqtVmfJ4rUGhVQp0VKslqGyAkmdSgEnnMS4yBRaplYtW2ciJ/uH6Vfi2VBNks5B
+GXhHosaB/0qiHVoDbw2lKSoSjdl/besXNytufrH12n40Bj2wRgdRA3+CTvTWA5RLBbi/hWce4E8/
+uU/BGNTo9asu43h8TvYDOGLDE9tyTuZ4PhJMCcKn6uHieZvsN8I5fBl+PhiAVu+T52Gt3ydjJufw
+UcI5HA4T/FsoH/sAhXw8DbTKKJsMamw36qhDkHEH0gFox1rIu1V1bCMudezu5bqL8uEa6g+34K97
+0fMD04+LAHKcuQh6OtWsJSA6K9KiAsEWfTb2QCU2NDc3nM0MgIVkuVoUoCY4KqxGszlKUmPaE+Wt
+G3g+Mzgk2C+YLiTI0Il4nlOwhQmJRgm1R1KpKaZgqMXnC54onQwYCSfn3eyxCNYvLkyB9//svByp
+N82kFq69ffxTqvDxsC875Z3aOtQfnqRnMV2m7fziwjR0/aUfVo1ubDxBt3+jEcKv0Tfnd+vRjysr
+D3T44JCo+wX7WQ5C8HlZAaTWLINE6mcIkJqzQnlXcz0WDRcu4Kxm1mn3hIJ1iqB1xs0BoRjurUnY
+AYi7h2gWFvtx8bWolmAlmQy8NlRLn0roVjZo4aI+UIfqV3946/Snf6B7yIb+qK2EcS/Qs/5cXrFh
+eMXw1Iquk2HKkS/QeJcLTYDn4W14BP2OLsJuAIKhALAD2C9BKLZnw0CgnrH4Sax8U70rrenKuUzg
+DKQK8cLSSBg9qy7zCwFlqjK9v2Y7G/cwvb65ub6BHGadspkhK0K2hrwfwhTGQ44W61hQ9sgwSXJK
+oonRaSNSfPVYmewjA9vdbDfwSL8FUSEzh8DuvabCkMjR7QZpzDnPsJpe6PdnX3DPpza+8CxU9bLJ
+KuSqeDQHLukuC3WOiI7r5r1zONrmzmEizBNllIxJ9EtdhfO0HD2RyJiIjw8MIBjYQVcnHx2g5WQw
+WKrUh4Zr+ay2fk6nNjh0HdRXRxlD1smUmw17FBejCJg78XYSZ6fmn3uBQ6MTtzEbrQmTcNhBQ7mu
+P7NhwzPXyWaw9nrV7H6DIczvN7tqdt+hEA7py+nRuOcOoJ/RzweeQzPF/UB34Dl48NGCiSV178yb
+6Kqme02c905dyUQsW109X3N4D+dBMMhyGhmNlmc4dWgWHaTl/KVmvRaqsYlxhWgeoVoHzpj35zX0
+OlgbEmhcJ1VuDuEF1dicmFgv1F0yGviIcJqyhmqSUqMhh3US7aBSUzRJXbn2O/uhMejXsGj0FxrT
+j+nk2tHzi/eZCub9L+gT1dXvH3efhBC2Q43UwOPvf/ASUU53aRqeeknQ3SOYd5mJIA0Allx5S7BD
+NSw5CXqLTCbj0ymj0UtStdhoNOih1xNASbgwe7LRkUj//adVrrpY9E5qWCoMz1AHbEkb4RwREAiD
+goSHqEl4GPlu9rHC0b2Py0Mf9C5Nnob+uzQsdx+UbVzcKWXStK/iGTu6PKLfNCjDj9F/hcfur4rD
+E44kAAqUoAJyF6MBIaCHU23lg8xqObDQRrOEx8u26TygeTqepgtdaU3ngZpXx6tpYvc6lboy+fbQ
+gDJAw4uhPMa3FvClSXKo103LSzgqFN/UEOQYDW+n11S7jly8WAs7w061tbWHl6BeuBwdrC5dudIf
+zYLbAl69TV358PbtD8lNzKtsb+GS5vhx8ZLGi7GTT2p9qEAI9uBbs+RaCzBL1FoNozUQhdN0Nl4D
+C11paWlNYtmKGnN1qKRGrdnsh+l9mO4zO3mfN4kWqNfwoi0v4aiSXfmCFyk/P63ijakbNqz/9QHU
+bphCf1kyF82YW/L9O9fnznPJ0Ay4I3AZlmT9gQNCLZADS4R9cxMayLzMNoJwEOuUa43aSKWNsQRH
+8E3N9Q0CHLKKL9NKywI2R4CysNII3mu7Q5Fz/zTYbeI9VzQ00Nno96IlI6a/s3hYy5EMvbWlrGYj
+XAgnsDEL9qJ30Wsr9g5ua7Pf/AhOgP1/PlMh4DajgcwAglmZ5FSYg7RhOolSS2dh+HJC5Fk/CcRW
+rMpUY/arAZutshqu2uqjlCVgadHQYP23E8bEeuGAAU+iEa9euzqz5XgR6j1ehBy4/t4/jhbi3ZKN
+3HdgdCfocaJ8OpvgqKRUyfRGnTYURGENnk4wKvTGdTr5OplOvY7dExW6LiR8XeDFKC8CYH0DRm12
+aJ5MXTYIGEFtUolSU+6vLypPSExwhEdHt1/YKQd2RW9uGnsyzeGITUhMjB/qHMisOrJlvEymPW5R
++2UMmIlubHVn7nihRK4wHg/hNTmjhfl/1eNiGthGYCJ5QjRnMqYawuyRqTqoS7FHSnBqOpUyg1b+
+aA/stvrbAaN1+9zcK+LPzDo0Ai53ty9qN812MXvgefgp2k5tEz+J/pn1nI5Em8shxbG0hAOMlG/C
+2MrgfDe6XMLugXH3GoQ7SJ7WYC8EgBINw2nwFZtgD5O/caaHzgodNLMefVf9tTsSmqu/pme5tlHX
+6H7uQjKWOSifK2IfEMQDUo4qOcUaqtNqeNoGNDypy6ixpWiSMiFlDaMGVLw76RZcBe/8/NGf/4HM
+wIGjne9S51+/hY7Hs53cm6neOZOvvY/eQ83uS6gA7YB9YR38YFlcv6Rb7mtWSv/frc9CRuDhArSN
+2c0sBEbMQxuMtEP8V6QtOdRk1Gk1Nt5Oz/ilBo5XJ6LsGipkbGzth7DDb1+9MvdEEUxC9fAtWz/3
+LapyhXXN8U/eQXnoS7QBjhLrrJSgHAnLNoIuYKjTkq6CvBSkaTtl8skWFdDEWNqHWczB2iAzn+G1
+b9Ma0rCFyzflDCqolKUPwwvDTybNiEkLKs1QRSaUGTZnkExs/mF6PV+fFg/xLSDHchI7dvVEtrq8
+SNLhAByaMwi2WYoY84F/JPaEw2jSStguPT47h5rnbx6zcdG7xyf2GG+3j5+w48NFS8Z3Lvx78Lru
+vXovm9Or5/Xs7Llru/bsvm7wo9Edx1NfoV9ufvvi+vHtTM/4xw9Yaeo66uTJUaPOnIN7b0D14RXZ
+6M3DVejdqdMnT7r266/XJk2evgDaqw7DrtmrDqCfBHsfDeGimCIQAQY5zbzVbNSa7Fl6LTBTflJe
+oeVkWimXFflUpoSlYyeyX5g10s8orY0EWtU6ZnOklydXME+s4t6LjwOUyShuvfg4YMTMkgjMYtaj
+n4Nt6Dc0Zt2nW+GXt9gK+r0vtn66DqZNvrrv9tRZIwZsug6hCv1MdRIPCnsghA0ohqLgJOGw8MGa
+h4eW9I7gpyliMsX5zmd7sQ/Ifancz6JRAsZiFkrLNqWTGx1QFrRZXRosLTNg5w/x8LjwXsFJOO/J
+Hd+TCuXYiCrWpURQzeeKnpkx9vKVsePu3OkxaVKFBxw4dmEPjGL7aNfUoPt/v7x373NQQTX/VUpt
+W+PMbPwc9oQpUNoIif7NZzYzS4AF06SW8goLI/XPCmpN0zrLZlVtkOSIfrP6YlAbmrDEhKa2rRBH
+Lh2oTfvy5owaBPsOHlyNUeS2ffrMhkMr3LRbPe7ZWxdL55bMvUkl/P0nNRCDqJ34q/mdY8LZJof9
+mm0EESDNqeZVSoPFnsUEWS2hTKR4Lmm6kjOo4IxKyZNpVpjLQHWwSnkxtDQ47p6rnmyr3jta70Sm
+prSU2BbAeFJSU+i+0H7mjfTuFZd/rek9Zu2yO3vXt09ZXvLuzZLZdXX9J09mlrx6Cbnrj55duTMr
+WfmMMXHo4umTVrx1cPfa0uVfIDvb6N63rlt3bA908NxldjD7gAnkAgBJLxLv3bw30C0lwodCawuT
+xNvJFiDis5C38W2SXbywSRWuEFJS400c3X7FsyGpQRFBvFrp5x9gCw029+zcOxwqpq2Yif777Lic
+5K4DBq8bvWpg5oiDuTkJgSHZ84p7RvdKdMZHBweFxGbkTRh1e1vHdutT6R57jQprQGigNdRg0eg5
+1qxP8E98q6HywPpYW9edfWNto4at2DzbbEqKiaSgXBIW0CupW+fOXeIyw2IO50/K20HkNxflswr2
+T2ADgGWsoRC0cFYSb0sRLC+DXgKg0RGaGs888gD0MzqNaqgLr06bNu74uVE2/wft85+H/lB39Ngx
+9F/kf6ARRn/f/AZ8Hv762ZoDz04o3JGSebT40rbtv35yHv2GnnntVexLQ/nMFGYJwbTlbE/p15HI
+YAYTOLh4ZuSnP6L76Auq20sjRw7e9FxesL4+ovem+99D/cbTKG57ufsq+uU5uAgOuzR12cxBOfPa
+J5YOPPD9peMHrqKvLmzDtikA7Hn2F8DgOIeIeGu8DMbLiNYP84NeABSTDHp/ijd4iZGR4MZ4EiuO
+K4x8Ss1A7yI9XImadAFhmWZjljU0xDInp30cpKAsyZ4zJyg41NrD5J8eqQtAt+FcThKU1fPR5Z69
+ApQVsA5NorrA14MCwhj56wqW7R6VjUrhihxbd5ZVvC5nwjSRKMt9WSa1VlRYxbrRKQAwN5klLbTT
+MB7ST9Ie+BTa6Sdo3wfr76ERMO0XgyYoxaDrZAkM8F/tjIi6ejUqwrnaPyDQ0klnSAnSGP6EHTjW
+3Kn346m9O5nZQ7Ajug+Pwy4WtZmWPCtlGEdwMnoEueRgB8NIn5XQZrUFVaOJUtZ86JCZxfz23GUj
+OB4E4TgtGC7hJIQ6gEGKxSpz3qtKLd/6Zp5uhz4a2tE4YMiSVX/ARPQe+hu9d/z5TUthZUnZkZxR
+e6ZteedC7aTJkye8WTuW7UCV9DmSH5aAat0PUO2B/TAPfnH7dbncfiZcyrm6wlXflsx96+LCkhLB
+zvfcZUZwPMZGxevaS5PJ2Mo17KNKl9QmYCCF2n+3d3t99IQRC+tXrFy54jPPhvVzJ8Lc0SMH9SzM
+Gn5i0OAdZfkFBYP37Bo4iE2lFvYpz05JOHbM/eDYsVZkvWRnOddf784pKZl78/rCkpK5ZA22R/lM
+FdsI/HBNIloL/BiLRKpVKHHlLoxhj/FesblMyRhJmZ+yVlXKXuQxaFt6c2J9Is4qTCbhqzgoXuPQ
+MFU30U/4f/gVLjDw2/hHR9ii8VAN56FNYr2bIYyefZ8g0qloKfBjpBKp9B/9KXF/63B/pLv6Vt2R
+2Fdvd/qT39R8cxLuqGFGe8CsR2vZxbMggLPQNnJ+9fxGzq8OAOD//uRqwPOBL4qoqP/zoRU/RHeD
+4HP/OK5GoLf/cVzFj2HmiH6t7mj8QTjo5FTrI7MCtPIBGmmIFmRHiBczOOaABG756WqMFnwhElaj
+2my8GCFe1fACDHgbObJr8eElVBNOwE05cly9MDzlUOfzRHTqF44Y1omFofAhsiAtHTMIw5gOIpCm
+VMSt58eOsglykxA/HhVUUJ8mEbDSuQS4tHVcrlaMx8X1SOEiXLsQ9CPf++Lr4BhvTXz6E2YJsbO9
+odtWg5VsZZnQIJgdvAiqS38SETFuSlXxaC31gnuEdnRx1cp+fXInTUUPyV2IcsKMKDt9SiUPGDey
+fFv5yHEB5pe0xgMHqKgDB4xSJenviucuEyP0p/NCDON4yrA4mCwsplQhAAtTcsUeNWMCVOLG0cOp
+k3L79FuJO3ePoF7AnU8ZF0Gfel4pNR444P7kwAGj9iWzr2d5m1jBI74YqOOEF2/5eGHHvACRvrjv
+EOJr3eXkZVIcRCnnLEyG3AIYlVBxlHh8lN3kEoqW0uC0VMHGkZpLJlLsBl8PS3AUDKSk+B+aksll
+UCOXy/5RBufsLDWuX6dhKE7CyVOo3pLe8mGSYXKp00BBFW2UmmWRMJpOhp3oLGkfmZwUlJCRAsrQ
+hh3AsANcBlfADlfRiptoxRW2EVnhl49i2BBkht89+g8ezwqUz7yKa+mAXKdMnS2RMnIpUGH4esFR
+QYYho0GtTHmRi2tuNQ4tpZHKZeJghBGwEv5n/mcJ/7OU/3mYzqprIWUFDEQQwMAa1Pwc+qmGKUJL
+4DOPj9BnURE88kj0owwR1xP2QgOT5h/LAp+LCQIPaLMwuuzxLowlBcM6wW9QYM0EelurVbGNeu27
+zcXDWy2LdypmzaqAD1svjNZzDXu1mftIegvJblxEOVo/J/Hr90ic3I6W2GPJVG0kXYHfN0Uw9+E8
+3/P+wnONxBTGHITzwDNnduzpVrmzcm+3MyuZbmdKQbellaAS2npX6irVtt5jKleOnVSpyx5TCbKL
+Knd0r4RFtm7Cv90rwZCCSrX4oWOlWlMJk4edWQlIK/6VgMdN4KYmda7UaSqpZFAJKnW23pXOvIJK
+XaWzo/AU/xYA0PMIFbOvsI0gEPtbWLklUK82WnQaC9+A/RhNpPSjH1vGVPLqMhNv1JTpSnl81S0E
+FsVH8L6icUmRNrKTkPtKXCORfaX+Xt+MtTOXLkXrb6KBzCb3fXj0wheT0G8PK3ZfgmGHD7nT2JiK
+CpT9w30fD6cQHp4gPLQxp+A8AL08B/4YtyhAqwAavZkz43L2DYKrxaQr0540K48qTqnLZNvN4Cg8
+ZSqjN5uFECjxrzNade8zJl3vvIJXtTonZeo4LB4aOKtY/MBkiLSGUkS1aa2hrL0B/YHOoMVwLcyF
+frc+9AD0130jLlFhvA+lEMDsD7fBw3ASnAgPb2m8iO7+UvELuifc0zDHiM5IeWrMtje+kwPbn5Ln
+QIHBAEjj2EagBxaMwmoy88ASqIAWOYeB77Bzk+yv6sAy5Uk9LJOXWrgyVi/UlcMgTtBq4Ck2OUWr
+wcAm2A5mbNaIUIKFqmFmocB30PY7ZfTCHx9DHj14jPrCCug8+PxXbEy1u879uGNFHHyRKkDufZ9+
+/sWnL0LNpnXuzzFdNwCQ1LONpBpAslOtM/tJgcUkl1AWliGFq3Egr2BqmGCZQnJSxpb6lwGGhP8R
+gAirRqzurnF4CYOEKkdiCqNyr62roxbXiWTBlIPPf/XV0cM4DJxtdB+gxrv3ffrF55/CKkzPOiy3
+ABC51YH2TjlnoXm51qJW6UWxxXQo5GU6pVZV5lfKKuPSBZGNhxgN1tZWVhn9hS9utBJSevYP91Ex
+3Ngin2Kc+Voim6dF2fStb9ZBnp8U1/035LkNAOaiZDpvN0Uzc8hdSLV4X/cmjpkGChDtVKkYziJR
+lMloC4Vj0b2VjasUTBlHlcogDmdqro+PhiLbrBobdRJZ6qgv6pClgm3EOUOPYtCbT+ZvAMqbvwGk
+wABinUqTDJj5WEZhMTBi7NoVUilRUWYo1TNlypNsmd4XiU+4Y01M0ZGFjGcqGmoY08HnUS3s8fzB
+aW4e9sfz9Ddyub8Xp4Q58SJy4iliG/+f5o9MQG+SmHIJMIEkpx9lUevKzLyJtqj0ZSZ/AqFG5pzM
+OF2mKtUa/YAJDyYxsZ6EcOOMAgyOnJKqNUMrcU2QrelDahcKnGVCZ9ZGjqEXVlQ8/qKigiltQCr0
+Wo+YzIUwiYqEAY8akQG7W+FPT+aXAIj/ls4nMrjXqZCykOFk0KIEegGc+YpQg1nTTaXkdVIZS0m1
+NDjtJ+dIcblEcSc1SjScVINLurEkTo2VymSMjJFJ+PR05mdsFUj5n1keI13rVFCqhhI/yPGQ1UBG
+C2nA9+7U8h9wSnUUz6qkShmfLvwHxRA3fCesIf86IP1jHVXxh/tLqseHSIdQHUJI9yHVw/3lH1QF
+2+gOpr52v+paSEP0AwZehSaXh15P9XMHe+/emX3sAxAFMp3KwBAz3c5olkXwXDTvSmxorm8gG4Yx
+oCxQyR/1O6UrU0YcjTwVVga2KzdHY6gcbPWSsNR4iCWLI1VqGVtYuN0HPiFuKCRoCaciU73/hB23
+rN7+PDp4fzG61/658qW1t2ou1ze8euj5a2tHvju7mX3wwdmlL1tDrmz79odullvDYraVrSl7ccGs
+kiX+/a5GhYnyh/NUhNrxtEYmk0pJ4WEpLeNOU6yMwSlgKRILx/xva8dLO9K9pD3pydJieqVUwjEy
+mYEJkGUzvWVDmQLZBGaybJHsGWajrIzZJxNqx+M5IBIIWccV9xT04Aq1G+fWMP95HMI2Pg5h/tMq
+P08yXdApGokpmvkDzgO2c5xcCyQxlfKcwZWKnMEjKnmMxvc60AInkHve6ojjZb1rjV1LdMywFrkE
+RMP4xFLRDYskQ4PTWCCb/6/FUQg2gN5gg9uQdr9JjW5yP0K/1IlCdNa1SBAiTNNgANhO5Jwa4ZRr
+GLlForBwkCd7GrE2pYoyrhSWAVlcOo5XdiSnpGo14tmUp7nBdzKG0gvrUGDx2Iq445CiCtCjGLJp
+ob/c/5KX5Mv1ejJvSORRZ6JXQpw8UyYppTgZQ1sAY5EwUnJL0yymTEFRYbCd69xhdSQXKuZRI1Zz
+/8g3evLM8GQOEIDCvsA2Ag3IOKfTcCqtGEZ6vhuvKuXK2Lhmbx6SnwaolLxGowF+GqVao1Ip2+Yh
+tUVRlkEHtdUQmvu4X+aCWIu2A7LegSVw3p3XEiMLKwI17aYwU1276GnkPn4RANwUkieGby27ODVW
+Xm4OUAODJUhlpM0aPrTVhTyxNlSgzKQ3lIZI2LIgdUgcKa3ysIlEaBFMRZMDIwZE2ulwbE1FJDsM
+DP6BNdho1Z1vv71z8869e3duosuoHA6FXW7CLnAIehldvskc09dfvXpTr7959Wq93sWbYAyUQRns
+YNq0yYQa0R/ov6jRdPYpuUZ4H/1G3EeVKlkZ4CwS3zbanE7UkJSjShVSpgzGNSfWP0xv2UcdGhvb
++fEWvI9WeLfRRmTA7Z4GgHmVyGk4llOosqglFo4nGw0Osq6iyhQnVVwpKWZL4OjChPAmowO3TH+P
+RhNrAi68SY3/4T7ch+YfPkRdfxQjyJzkKqG5Hd77KQsttyiwymm19zulcoorBVIsCYk4firC6tv8
+HcwrVG7d30wdlVtXgXd+gXQvP6QzCd2JTj+NqgzILQqRJbzYusAUOSenSv2UIlswX9JbGxiEOZKr
+QidUbiv+eHlEgXQAOH9yDjbhDD+qjC4F2jL2pK4M8CqzTMtYIO3P12P7nKR5iXaFALZn9Zp+dsHK
+SE2Bf6Ce1GrY32v65fYnhsbRvFw2psJn9/Xo7v68R3dfDmI228hH0gLyTHvm1TY2jxwYQLhTx5ap
+TipKuTKDCZg1rNKiEEwfkaZ73kAfkQiHlzIrYxIpeL5/rutsnZcw9/c+KpgTAlkY59Zzl7X5zias
+1KyTALUZ4+E3CAUTTFyZ5KRZdVR9SlHG47OJ/ymqzPjk2USi6H2G4vDZRMIJZxOd0RqqJb4OyhpK
+bjeFg4p95ocQQOl9o+trOth4H/3lAR/egn4wF65Fi9EZ9EcDzL4ILb9U/AKDLjZuQWPQc2gvGoP9
+2B967krj2QfAD0SCjs4QUKbfbpeWWTbbrWWRJ8NLbVFKsz9jDuWtEZZwxk54ld4sZuq15LyGeWMB
+hIRXqG2JsMDuWVqw8tnlTatKS1c1Na0sLV3pKlGfOycGWpDE13Pn1OhWXR3b94mYiuPu36H/u/Vi
+zMWH774L/d1T2Ub3FDK/6wFgrhGb1gicToU/o2ctGmCWqUx8wxVxfTqV3bgyvlRmKKNP6mSk+LeQ
+9lpl0MiNGhlxVOA7ZkhWrDWMk8HI8OSk8Gii5yHcX7YDdkLXdpShn+He8R4AGUhRTB36Dc1n7KvX
+UdpnHudTzwaUf/zeV49iqAXuDcI+sMRzl+lA/Go9hBhYz11mBZcKgkA09g/q9DFZdi09wGLVyrLa
+idkjJH1AYyzX7GkXXsMF18gsoJrb3I6/9zAtrZm/T7iNgz9wnWlauA0Kj0hJNXEE9M4PppjCfJHK
+kdSyB1CL7j/4Ff0p4dhOI2BnKF2c4kjo8Gh53+s6yaoPd72Co5ZfYZYIQSHoOoyHlm8WRB+ZX3OE
+gpodeqiRtZ/3XMcFB767geOYr/+A9wqxbh/JRwkFcU6VWmsMY7UWIJNqs63ieiLpZwpQLg+VlWtr
+YXlonNfG01gFS46c8vAPEdZEo9fs42xWOAdyy9esWY4e/TZpzZpJKB+ev9owbcqKzd99hNIoBm0d
+PXz4KHgD1Q3q0yd357ZnJi7y8987Z/dxX223W6S2m/m8hGKkkBR1w5Da55hyWNvqTHGBNrqfZ4p2
+uhx4bqaib9ggUtPaSM4UUpW23N/PSEuVunKjSTxTXPGeKcqVtRqDGhjLdU+cKSRsSmoKOWZ7jxSv
+U0a0bD76ZETUWOqPnTtdc3fupDttRuObUqKGfAjLG1wONBAGom/gKV8M6AoiM73IeLIB4H5hitqc
+J6T/83mi9v9P5wmcMqOxi+eJzCr4w0doBqy/ita9XvU6WncV1qMZH8EfmCL3b5QaDXDnUik30E44
+/Ya7jjoDT7sFHA1vblsU6O9UmLWR7XgtbQiVR/NNV654pcoYujZkvaXGVh2tLzdEi5kFm6P5h65K
+Zc6AAlfgw2FCklurz/FQPFRE/vuhgoBHctSlpRsRup9XPrxnReGe8t0bdu2fu27Tgp5Hx4yt6Hvl
+7kJqpP+OaSfPWywvR0TMGl4wde+wbOfIgKCX/P0PPltYKsogxs4AMpDpNJDTRC3FKrLxeUIKJFIu
+W04MEk1aGrEn74nnCae85QwiITYdKdICseqEzKIq973mKiqQ1D1f7VrGFLlW0KUtWB2cXhuJsTo0
+Emof2OepB7ZzErmWlsRggG5yLKg0/MvZAP/+dqaozdlACrKffjao/f/+bOAVkgtVlORrNBjefQ+V
+XK0SBSLXPYBKfgftBBD0BYApYYpEeytbLpUopK3PBVWKcq4Wlrc+FqT4jgUSru/5jj2oP6rRkoJR
+z1mWn4FXqx4fod+difIuve8bL5tLfNz9nEavX75Wxim9/u5s7LlvEqz9/ysPdytn+wWoghkwEypq
+0M1qVFfDFLm11P3HR+hKt52648oT+V5K+G536mXlFK74rVBlSxkp2zIBTc2JopQ0CTUpvarlQg01
+pKbG/TJ2nqfCG4+PUIzb1RJH3ipvyduPcK4pl9RSHC2VZQNGKsl++rmGKRUaxoxzOZiilnzw46Td
+Qf+8NwIQpIv5LBJgOS+DtJQDUr6pgSjf12hJOahlsZcYJ0ORuUpnElC311A3ooOx6BL8ACF/WMuB
+kd44YW4isd+igAOjliZnRWutSs4stccG4CKbSU+cS7TtpTXBhmrznqQEuibcb7PiYpKYeYCBWBPj
+ScHNVA1e81AjFtzU4gxYsQST1pskRaASJRqSIBW3fcnYOXPGLtkOv0pbPeilH354adDqtJqWH+FX
+3heouJ+un6D0J64/TqfSRvWHyTh8GHbpOwrPzqi+6DIOLUY3+49yX6fv/HT9hLv5xHU87s6CztBy
+YIQwZ+gbVk9kIwqfZco5KZDQUorIREN6MzbbqziqViFlyp92lGFKXWlYPnbuxKVL8SQiQRZ2A8AA
+knPU2amhylV+klqgy4Z+Ul4qlWh9R5q4e4kNzZWgkrL1rlRV+tl6Dyw4Q6mqPW9VEkMUG0m+FA7h
+kNMLlT9XPLI6KXkSjK6GH4/Or4YW9Me8zp3hn4+P+NYdt5cpEv2c2ZSUlksVWB+29nPKKa4WSMuf
+etRh4ac1j8Jq4J2anfS7j48IQwNefklimCLvWadcLgUKTkpYJp510pt9Z51aP6XIt6efdbi9Qi/w
+01YM9DKRAhkAsLFEfxhAe6fClKXT0mqFVCo38lfIAYcYCdJyRa1EXa70K5fECQGexNOMLRxy6GGt
+oiFEz/7ou+8+Qh1RGRUOJehvKFm+ejW15/kTJ47T7+5EqTsFi8dXM5ndT7CSfmfCCMrCf4ht2WKT
+6UCEU2HIAlo/ViGVZevFsKgEUMWUK2S1LLZZcMilz6VLjjkCYfTi1ctxHMby1W5nDVQTutJg3+Gj
+0NbR9AyUimkCvjvg78hddz+njJVqdb0kakDOOA9J7XyTeq1qvcJ/LVhP1Rgl5dzrihq+Gh9xsL/v
+Po8e3uf/uP+UI04qSZK0JltjoXCjLxxxwuyzDx3ceVvnfodKVB6+vu/owRchBzugz5Dn4s7G/Jc/
+3Tn5y/xXstFljEWCLvUiPHnbc5fryywBChAMOjn9QrNkWi2t9Veag6SW7BDfWUbIOKvxqw7kagyb
+A83lwZbaQByV6jvdtFjUMEXr8Jrfdhs51TB9RCvaPVv39wOvwf237FZ1Nf2l12xGl96AFp+RHfuX
++79MEeoKKDAXACaMzJsRpDtlelaqAdqW0wvZeblyvlZmKKfx2aXhfzi7dIYaGz67CEUbSYIphItX
+LvjrrwUr0SOYP3ffx3fg+Zpv0Hk6aNAoOGakayv8KWDD1mNHHx+Bd5DdZ39y+O6iDksX6D6cbbFL
+SS5THbYxwBSsSZ56Z+lH7ixtlcHeO0tb9phKv6ffWQb77iyD8QVkqztLPx43Id5Z2jSVdDKo1Fba
+xDtLG76zJE/xb4k1NUmdwhCQ6fTTm6R8f2mQjJVCGmSH8q70erLUiQLQ6Mt1IVJYLqGYgHVyFbMn
+OK654YpYGxhXYbeRCjF4kyImoUYPJMkp2mRvBv+XlZU1VJD7brez889/gso/XvzH0qX7XqqhP9gK
+z6G+W9H0CeN/QD9+jYZFbzbC2Nu3Dl85hB571y79CYd9uO8QH8V8sBZI2qyl/k65XCHV8lQvY5aZ
+d91LdAn+AmotvZ7VrOXXq2t0snL562wNqNY9uZhkbO8zajleTDK5eJeZaDIQ49ZqsJIivgbORtYT
+dfzFg0f3XT+spBLd7+hu7zx4aOdF5EGfwQ7MkuxX8r+cvPPTl/MrepHYdz/YxePBPg36Otnr8734
+d2KMCMGE8TwEgMrmeJ4DZ/4ZQwIo/Bb3JlMEjCAEpDjV1qwArVIjDZZopRwMFW8xRcdicLkspJzX
+CjYeT2w8vrmhlZ3n8KlRbO5FiLa8hth9n7z80ffffYT2o30Fo+iF+Bj5vGgECtpLNATnY42KtgKv
+7uSqmCJyj9nRyQdlGbWsXCsNAAo/qSrbd5NJzh4qXbksQFEOea26VqYiZhmWG2w6tZAl3mlCL2VM
+f5Eq9xc1NVQYHEnoYooEkrBlINhwLodIFsQRZcwGTo9rPDr9pGadlO9vFuXZIsrzw2aepFkKsmwk
+sqwNiEv3SfM/RNnQCojCJ8avzrtYV7106d7yNiL848/oFmx3+NDbR9Fj7xljO5Hd94lemD9T0urs
+MVU4e2B9Uci22HHkTPIJfk7tG1Xv3aeZ0hYbgJNKFOUyn2Hju+ss56jap991DkbF2LBBxeLG/PgI
++kaIByBx8kk4/zNcG5HCh1qijRaTysLItFKGT/5H/qcxXFqqsZR1EPM/6+PZ/23Muzf1GwfmUp3+
+51D3JUs+2DGBxMQH/e/C2797AxWfPTOKBMR7czwJDuMoIqvBALBnyJ17EPYhhejMrEpj9gMWhUwV
+jLdfV3p6PS8k4PrJtWVKXl0q8y+DJ81lMsHp2kCsYIPXs4ltkWSHwWpo5fikClocnGgbnFUHZ0Nd
+iwOUGfA19nQyA45/9dVxd7HPDSvSx3zH6XG9Fiy5gVlAa5JpWJ0fJjCgFYFkqcs05Qo/FVOuU+1h
+y6XELuEJfeGiccKKxVjE+BGeszE20URxX0avw6waWI6GCpbKb+dGoa076Xd3YiHeieqeR5WCT+hD
+z11JIPuARI6SpenQtq74HiqEr2lNRl+wJg5go+HBurrbwQcvw0Fr5y+YVFwyd5UHrJ9ops66syWl
+G4Bn1dyS4ikL5q9BJy9fkHmjEqD0pVvhtp6No+PiDiy4VoF+33UwLm70h73Cw9+/BnMF2+ltz122
+lFkCbACIGrpVAB3R0fZI0bjA0G1MBlbW344MU8J61F51aMjCg4d21qLfdy2R76yu3mtavIco7XNd
+h259OMqRX9Hr5LZ7RKM46lYeEvEWub/IHYMaAKuNnFGt0AYdOhnk/voP+hH9UIe+Qz/+h6a+OYOa
+UBMV6P4G/2EbXb9Tqe4bgAIOADgNU+Rtg8bk2WjchgJymsvNd6u/ab5Mbbm8/fffYRmaiv8wRe4a
+eBSN9vrhZXpyL25sQ4OoKwktMn0rWuBM9191ddRomN6WppbwDx9tbwMgucUUedv20dbStgJKbok0
+QguaWl0Nf4DBrWmF3yEzU0Sqk7bQ7MXiM4EAsMqpNweoVXqLn04NJBalVOUPLHQgLvJR33TOT5or
+pQrTmnlc9FPnVDt1dGFas1i4Wt1N6acBtLnMX1Wql1Jx6ekP05qJlGOngt5f4xfgb9ao/dT+GqXZ
+P0CjUqokLPEoiP8MO+u0QAFGCCd52DDMAbTiUGycWxRph1Z6U93O8asaLy6sQANYLco7Pre2cWXR
+XzDDvZoa5n6JGmZZfhx9A8feXptA/ejWJa66jQ7B4KOL66go7PsEgFnCFAEKBIDlTj2AdIDMwkhp
+ld5iNgRQKgsvjvPsSgMURnk2noatR2hmNCoelFEBtTKD35MjpDQMpACG+aI0ZkBBTYA54IkRnptl
+2WrBUElWnUwYGIZ3EAZsEAZMZ6HCDygpen1qzobL+Z3yqwd1zL+0oc809Dp9Hu2m9O76yPwDqPn6
+3NwANBIeC8iZewPqDwyxU0neWKUrbCMIBlYAZK2515qr4j0FtNK/oMMtjHxO4O6QGe7X6uroNZit
+9GxqfCtGhrZm8ugkQURnUFHCOQQADp8X2/b95ACFvqFVR6ei7xj+iXEGhqAd1dX0CsyDBfCHpw82
+xF+U4ky0W8glYkoki7G3BpL0QQJdhrcyovpsYS3JOFYRUcOWnChmnVjx6SuVduh86SYGIUEA44Hw
+GP+MKSnZe63HBVtRU9eVc0PHDu4xt7MrfVmf55Mz58HRk7K7vl7wtzbf9PzAeejqC18cKT1ccmRK
+sJ/JZOsWYLMr/MISh784dg765dmBQ+Ab709cL101R6mgJBEv2QPbj9mxeMCogoFTLqaaX6QiU569
+3+dcBxVcjVZ/4fS3awPlQ1lqZp3/0PEvIXdW8MgiACAYgebS73AcyG0zVpI760hsNVjeYRVBXMJw
+3d1ER6gveNw7WPF9P4gTRowmI/3O/OnHepww54212tuf7TCuoPPYhNuJkzM3XIHth8/onz6HPauw
+hhpg8qheI7dXLy/GA40zxAwIsCX6hSWOgPHfz+yZBwceGpUvLR0TKAu3bwozRQzcA5VFw/p2jJHu
+hUcGtLOmvhSiICMcZ0qUD5XMrIv77Uqi0dlbxHDl3mYbgQUAEXVQAomOgw6I7TuHTqCae7uTOTQi
+qFs6uveo7hEaeRmOWla37Pv+k7qVOjqyeglt7njULUd1MAWDNdE/uXTUnIY8B4/7SET53C0u+P/c
+x62WPqrEPqq8fdBfePvIhxW4D3bKo11iH0/Gkbf2U1KpXqxaDsd0aoV90UcGdhxrU9jyzMweRnPv
+sJ6DUek37msfwjuwY8kp9oEBp7l0/9ylZxsfm+guMLEMjwfj/DJLgO4fbUE8Euaco2MnXWREUNcu
+6J4wjO/zirsxS3icfJJ6zC3H5FOzGnMdwIv1zNkls3i7qT1zDy7WcoyMPCf9YOwD6i3mEo79ZeQC
+jivKl7xKcteDcZSnrFWB61QdLnANeQkVSkfyqdpQnZEWApjFTHTJq0g/MLvP0p3T+raLTF2Kjl12
+u92Zo5cjsKKoaAUFlo9utHREeztZgtLgpDR8I9DxZqcu+8KtG2AqPdW9kZqPNqLPb6FmqPuA6vEB
+1KOfP0BfQG7Ojz/ORY+Ra+6PP84huHIon/MQTKkQEPE0GoFo6JKMcxPF28J4B+d5CnHzRpfUvze3
+qGjue/Ul4z/76qunkfQy6vXSjXdehLUvvnPD9S5d5DoChLweSQf2AYjGvuqIloLkJOctjKS8tcAS
+Ek2E7TajxJti4Gh5ZnJ4r5xtLb/NFAb/NGflyjk/BSv8jIEBGQGBRl4e+9OcVavm/BQr572PUCw6
+uvfixb1wdAeF8DBEyyuC4Ki9tbV70bEgucb78NL247/9fny7Xm1QKhRKA6/dfvz3345v1/Li56MV
+t6m5H1YY/PBntU6jq/gQXUPXb7+s04hPvLlD7FFmCYgEeU+MWw3Dnhx1BmwZdHLSU0Zt0LcdNT2n
+w9Fe+fmZFR0kcm37pPZauaTD8z3zh/R6XnhgDFTJbsQiz8ING+ZCNlYiUwUayUNJLKQWbNgwFz1u
+9fB40dyql1aPUcg1er1Grhg9t7p21mj8Sa70k+/ccOD+D8fWy+V+Sjn+LF9/4Jfvj23wffZ4wHzP
+XekjyVw+UvshALydCeT2YP8HY+biod3jAXs9dyVHMR6OtkH4nnWhA/h7tsxzWdAbElbi4O2mZjAO
+DuI5+o8W/Bv2Kz7S1Ew8oX8C4MuhtZMc2jSnHFgCVfFGM8vjHFpXw7m4wMxAisDWKNVHVae0ZbLt
+loAyENdcTzAxz/mFhIRQsNBq86WVtTonkCAHq8ZGPzqFXnj98uW2Z4P16/c+gFNvXLsFB8FJ7/uO
+AQfRJ+jDVbvclQKWQT7x9RDagrQByl4GLUPyex8S/4JSuVa1XlMjrbaYywlRrj/uxzsVfiF8yMqQ
+bSFHQlhYaA0VsYbboAQQWdHYNNRe9PjZsj27ITdkwIC+/XP75706bty4ca/+cWP34UdQe/CgRmt7
+JcZomD3n3Zo5s92XYQWmq7PnBbqOfonoS4ldYpLYxcoxQvUYum78J5M/Gf/JslPLPhF+mnxqMqU5
+tew14cky4afXxr8G4ONPmS883bjFJCvOqpe0iGWr7FWr+IMgwwKwICfhUA1yyBU6vdm/o79Zr1PI
+hQ8W+G7LU71cfMpODDQHaoWXtIHmQIvwqeWhwd9sET8BAN0SNhrIuH5ABYDO1qpUfahsvulY4Yid
+6zf1WbAMhrPR++NyTGvt7boD6H6VuYRe4MaQ+hBhGqEYHHrht1+fWctcgopdhw7SQs2d1u/xtmRj
+Mq4s98Jva5/5lX0X/TGRPkjwaeHfdcwdLw1Qa01kmQhDuD3cHin5u24dPD0HZsFEGIpG/4LuFH++
+l4Ww75K5cK1p5GyJEl9eSv/7EdpGZ0vw3YMZ41cDJ+gHhoOJAMDWFaLafGD/n36DrrepPkV3bPPx
+71NtPnLF/9O3kqo2H/+rbPOR2dC2qa5imSs0QPxhq/jvI/jEAyr6iQeP97aUCwTSvwrcl6lL0g6A
+BQqgASYQBGwgGkurPdWkw6UQ7XSqDtpTI0wsNElYewRMdrCGDtBgi0hmoS1Z51BAh4G26abuXjRz
+ZPnjBeQfNmT3Ypg/Et191LdsESofAS1c0Ss4yf+vS6+gt6CTGf3h8dKBH+K/hu9ehMpHwiD2td2L
+4NCR6C76tWzxjBFiSyco/4bjqwY24L+oL1s3gePcUD7rkkznI00/k9wCEgeM/bEon+3I6flI0338
+nNoHjnjqAQWsYvzb/zt/kbdqXutAuZ0t/qITJ+pOwKb/hbOIApEAMFnsISAHwSAN388ArcYYzCkt
+Fn9jQMgTviKVMbjc3xLAlisDuHJDXPMVr6uoNR0aq5geRsjEZquBLs3ovhJfqpV2y3D/Bf1uLZo7
+t+QjlLZ/f/VB2HdUu1i0JzaG+hLVDRmIKgcOxD7QDHRpqzd28D8kfhJbxSaJnYshF+QEQMaekipm
+qqTaU5khm6fsWP4RqoPvPF5Z+rUS7nsWoTk9P55C2+YtXzLIfYJtnD3nsx0d2qet3eF+P+7cctAa
+05PH2DER9lQTsQFCRcwfFnco5Lm1Qg3mJRzdbvOMjbnefLYd2TBw58yyodNwsltNTadhBUtw8pty
+70uFL5RcvTm3ZG4JTmjTnyp8NTY+Ybz7FCevwIgKOPfND7TkYUgkQAICnSqaw4kYNMNZIA56Jrf3
+cQ+b6+O9Ltgb1H8rcDIGTEThjxrFe136Fovr8wVVyWiGk8Js4TfJxT8nhl21tHCBWr3TfYwpgp2Q
+A0df4TZ6AsD8wjYCFYh26qGyTMZIy9hS4MfJlTILxUC1IA71Ql6Iq0nMHkq2asT6EBrYH88yThSh
+bO7PqV0V1J4KpKtwTyPtdwSAGULwP2OcctKqtFWrTeRmGCrLZYy0nK39tx4ySQ9G9zF4BBXBhxXw
+0kH0WQVSYh6iYqae5Mw5nEqtv4UJUgLOQuM8KFd6U/0VcvWk1pZpKilQJqG4MrqUivNicQtxFwar
+IFoa3muFkwQ6uLMODwoevTB7Okme65i6dKngBatA2Qvm3Efv4ey5nBySPufFqlYwRSAI06IzS5kQ
+JeCkdHZwG1p05dqLEJRLIFdO10KRFlcLLcSu1LSUtkzW4IUG51RjBjyCkuyBAwe89vYrM6yRkQlr
+Bf9ZBXq754Bv0G+k8GXqTiitCI3GOTHoTel2EWNICAPCISxPzT+BH6+/Gv2vGSjuMJx585QsFAiy
+0TcSmil6so+nxqQVL662/ltU2veoLwx8MjIN25poHs5x4SPB4jSc4fmKiG/3DJqH49Xw8/fw83oA
+fLHo54juEDEYW8bsTY2APx+5mtUmOcJtaxmfeFfyKlPkbaP1mLwhVAu2VHdsE0T1I6GfBFK1yfvQ
+kjZSNQ4DpgUHKGkkmJST4+HbvjyN8ScJKWfg4PvwppirEQHnncHpGq3ixMS2kh0GIaRLY0smJBVN
+gN28cV0TighNYz6fD7OF2C50tWEMpgoCq0iTDreD66+2pI1gtX2j7sSJtskjJ06wjV9tQ1taJ5Aw
+E7YBEYN7O6cX2yKr1BeLaEt2XKiC49GB1uFmy5Zx+sXL3MmtQs7YoGVkfIkAcGeJDhrdOndLzTc0
+tQTCqbupjoAn82T45v/LaDhrqyFbE6ln244XdXkyXYbFuQLtAGBXMUVt6JMq/gf6av9/oc/HRms7
+qmNrDqIVT4Ts0dd8Ms8xROZTnAHKMuokA0q5MplaLimTK2gL21GFU45wOE6iqzkxnQSd1eNU0Ob4
+s3kaCAt1vggcsXoazsa4cUPIscEufyH1Dis/co+CvmGdZH0kOANUJH6OK5cp/DTZSkYqKZezKhxF
+x4v3t82Jvg5bIul8Xb1dTQ2trq6udpdX45sT+K4rjawkElMnrCOplshGB6c/V8bIheEppayMtkg6
+KvDI1OLIrgjxeq5mXuxHDHgTBoSVecuIKh41CsPB4wGA+1bco4xcOaMgQ5KpWDkjlSjwWNRiROAV
+b0TgP3rA48AKumUgFa4+ZBRkj5LOJbV4OjrVIX4WXQBtAVKc3RQsRJU11Qu53UFl8pMUXSYp5XRl
+eo7C1bi9sTC+0nZP26qEdChmzlP2KpIe5T7wz+2KzGO+xMoUgRDgcKqtflJ9IC0FUhydGNqGLkWI
+jMbRizoDxcWlN2Esp6b6ViQ9dceSMOsxL+jrT9uy6GuuTthh3nbXOh4Zy4q+Ke6/7K+gKxgGQEQs
+nZyUwTgSgxmDXk1JgqEPzT2WsuN/wiOp5CRtBkw1qGmDPpjC32fQyUmxjC1MzeAnTEzatIGJunY9
+xpQO6r9sTHYHQ0DmlAGRkzpnTHDELuwSOWDI0JhulxdVoIe140a88t+DJS/P6pi+5I3SgSvPZA6K
+1ytM4fEZkQm9HFatJKrb4FMJGyquTO22cfXcoYmJg2ctWdFp0LmzFX0CDLqAEyatVG8LjEya+z4M
+OnEE8jeXqV8yFu97d+myL14aa57eae2W7VlxxeOGdY+K6TmiaHTk2Je3zPD5pRYyi0Em9kvpkjKo
+1qMhLKAcicGUEK7vSBS+x/GS+O9YiiUDpmxhasqgn52z9YO1hRtHdEgs2lhgz7eEZ8YFRuXN7ddt
+Vl6HkaNtPYIDO0S389dEdOgYkbqqe3B2754h6xq296XzwzoEKIzWKG1Ae6uOilr9yYECej+XPWdv
+wdgjczIUmoiJC1c5Cw+XdE2btGPY/l9H6eSMXOd3UK5RSizttYFa2eDnPqqOHDV+XEzO5G4hkb0m
+d/+vkIeH5ok4DYthB4LTcF7wQ6N5Yl3CxWAref4REHAQ3sR5ekAB2jtVKrkTWhiFRepkpUoc5+dK
+J3WhnDI5VybD9evYuHoBqF1UKjiRnPr4xo2b7vvML+77FVh14dxxIVYWfcNMF+M75SoZlDIKKSdV
+8k1NLizvuF2FpFxaC8qf3u7p6mocOpuAuj2H9RR9vqKipQ6Ur54Y9MbTAimY6eQZaS0l42ggpRn8
+R8Y35FTKB+dUSkkFeAUur5JTqR+Mf/a81XFYg6t3J402LQHw9bhmhFmikQIpp2E5IAWchpPganwk
+YZYkwz4BVhKGKwwJOYhXL6POl+EoOOqyFvWAtUx/knUIvbSSOqxi6n0y+Q1osNJfor9uYnPb/eAO
+PAT333E/gFJYgp6FJRUVtNq9jyp2/VYB2oyRxz5sgwAK4m2LWYGyIYceXUa/QI1IBlP0+GxBxYUL
+FQUiKaQNIQffDwCBz4Jhiolhbs66qkcj0bBPKQOKE1IoUSiZTmqJ+xk6iKp2N1Ixbgfxo6FvSOz/
+09qRzq62ogvowh34KppzB0bD6DtoKpk+WI16UX0oI5oNt7q/FGVP0pPQk+zkNWyZFGcGqpx+FqVT
+zvMN91z3eG/2nsJPXkYpSyUMwEf2RIKrJMTNCpHigsB8RLbSx1tEYRQy97wCCQGLvuG6EbodTrWG
+LaekUlquwhUJeL4Jl0Hzxs8q/BTllLJWwoDy/6G3hdTw6upqV5ooovR1V1qLmJKcSvQmu5RgWsQ6
+ldDIcDoLo1dZlAYCId+cKIQ+Sso0J3XKUiAsLpJd6V0F3vhjEn7MvHrjxk00+sLs6UKapTA51xfM
+uQ9T0Z3Dh6jxJPYZfcNUk9oo2U4lNGVzeiljUEmVxtZ9yiXlWr3Su+5IGDRbKbH1rtRW6kkYtETr
+C4NuRYs3TggTA6qrq1tHQ5MppmSj86t//BAHRL/RKqfUX6ynKoylMyR8FJq1Uas6CyPSbvvo5s2P
+trUaEExD16nxj2Ko6+j9NnHduD3snhHawOg4LcTBv4+crq4+fURKSIuhjl+6At94fISSXbki0hYP
+/pnvKrVwSmDxE+w24igi+a5+pRJ1XPrDh0JCV2sEDbgYHvWiZ+A9vxVyRqMvZmw8iQ3MIXuO3nNX
+omcfgDDQDvR2amzhMr9ws9QhM/sHa4OAfwzfRCydh81XiDZyGjGMgDLYX1oGwk8qy/RRZcx2/eYY
+HA0phPoLKI9iEqLOikuMtIrr4XwMicCgUaESDp4N210LO8Hur70OH8+CWqjd5Jo1c/WaHejY1Vp5
+xfzFFTndoJM+6T92Feq1cgw9Hcpfun37M7QGPUTvFY3+ODs8/N1LcAicOPXLvhlHuqZRby49fnwp
+GZuf5y5bzCwBESAWTHZqYiMNQZFSXQ7gtKxBqwBxfFNic5NTAdhZ8SvYyvj341lSpEkcaYAuUh+8
+Nmi9mVNEluvNNWFA/3q7Gr/qsM1xeLT3zs7Uw8JEHkOs1fMPz8YFwUIckxEPW0lkinBREAStxtb1
+vELtkT7wLliwbVjRgfi0PgFzdwDP16vXuM4qiydWTRk/buykcTMmoN8P7KL7UwMHrd9XOFJNMQOH
+v5KdsMcaeXXV0e07Hg1/Y/y4gICkU+31xgO7YOme4cOHDhVw9/VoHZlXCcaxgSKvJU+ZFnzKCG8z
+M9QmH7d/fercTF9wFrmPeCeHnk44/uY/5iWYRc/DcaEvXoaDfbMjzAuqIvMiBQktKGZ+/2e+SQQQ
+buaUlx3X/gfW5fZ58dKSXdQugSdb/41vPINuQ0fAkl1PwQYieCICZgw3gmDGvEyek7xArhdvp/bB
+E8RmuSmewYrZZDFWwPEUE90bZUKVb2pto6P1daQ6BD37SQudhLJsoBZgPGdcd5DEsQD4dO+Q2LqD
+GqWHbK8Wa7v9JuSqqaEifqIWtDW1XwyPJiErQfCusC+koHyOJvsCsIowD2yyF6bFAG1spzoUuGYs
+2t7QfR698AbasZaNaUD+qMLd8aXYEzCCSsYALRUoH0AQi/JZnBOix3uxAdp0rRrEgcRMSTX6Ysk3
+1WjJ/FGo/tXOY6k/nnuOlBO8vhbtQK73g54LXPE+fKlB5GtnQleCU41xjoyMvKOEtrCMQTj1udLJ
+7qjmGapMelLGlTJx5AjYVB8f0QrlyDcbzGx3WB0+3753l8zBJGq0N7Dt0U6B/+LZiPDcgPNseSmQ
+mrLlElrKCuXmmsRu5X4ahiqXyrhaJu4hPq011cezrXptmSf6lru8Gp91vXOjp/yFYEH47uPNwryI
+NUTIWZ4X5sHXEpmDznUi7Zj/LQF5Xr7juwgnU/TU3xVOY+Swjb5YIoY+k+P2c+R3T6M3yd6jx+PV
+WzRQbTEyuEosMQyaXYkkoVtBlSlP4jqyZTqNzzB4wiYgeyjBXvBaBTdvVFBBrWwCDJMEfwLAaxsA
+wueeTpVBqoV+UlM2zokytu5XLmZMleu1XuNA+285UhFP2gUCQW3ypKqrn4OzWuwCHMsMz3lruHDf
+E0wQQXahmFaowTmGXAhmPIwRcfy8sH4VFVh42RAXYMCj/7CNLin9lyhDnJDz5VsHLW2xvcgiOEuy
+FM9CjiQtMuI6EHMVhdzFJ+L+c1tqoEt2YD0E3oN9dBz9AgBAe84fSHW47qyTnQlmSqnCeG8+PBfC
+R1L7cj/WcfRVUqE2DEjNkpgEX1sYF+8FERevFs4D+nNyrYqVxDhl8dpMFdD6qZjChJb8epKTchUA
+DQemDAQg9Jy+Q5RCEuM0rIiaGZXXYXSUs0NuVHyHzCjQwS9KXoj7kQIg6UV07HpvO5KZJLfhRCvc
+PgGn6yippYuNVomTdgJcZ6BVPgzRv2A3ADqOrhPemdXyzjxfvNOAtrndVGDL90JtFDYU63Zmequ2
+SX2gwTiiaAYArfYC8j434B97Ac/bwRR4yLsXiLhz+4T3mXXk/Vda1fsl7Xfy+aw9HnDE8zbzF+YL
+JHXrQQHqy8ThnERqcCsMogTeDmaDP2BnLUef9Nlzi9g/yPMdHo+Wo99t9b7Q/x9wXtv3BXrBDgB8
+75cI/NBG0q8K88/ayfzTIQEyPP/OkNwAPiQkwDv/Qr0kPP8fCvNfAp54Oz4kE7/9f4o9a903s4zc
+q5K+W/fBLCf5DbgPIOTDsDZxbBPJ2C615MQKvP0aj+xrkbcC9pvAuzmEd9UteRLsH3wkmD0Kc+6T
+Nu8L7fuw4rzvC+2PAv94fypvN8U85f1iPpI63OZ9YuOL7deT92vJc3KOaNV+U8t9BsFqkwGbU66g
+uDIZRmqT4xulZnwndx7jt3FSEFdf70NYwBqPILfduME2VjxegQ9lYg4IwWjwtoVv3WC20BZ2fZzH
+SA1PaesCcWxWM0UVrrv4SOXDfSEy85Y4b1VwnjdPksjGV4DU3oJS4Z4R5ZN7RjNIc6rM6kDhftHA
+QJybIN4wEveLWm9Qg5aLSPFGkK9vqn/iVtBq8O6uKclt7yDh5J4D30Q/TO7bchnZvOudohkHxpwU
+7iRRPrmTNIPOTnUgJzOrybWkSMwV4WKSb01Ny/1kcxMuffR/oKbtfeWM7IFvoR+m5LRcXG72UoP9
+H8VsjHBHF0FqHHgDAVv8F2w7SPkNKr7XN2NdapQCjXrCIRJA+b8xdnFFTnIJtbCtV0Twi+D6ueR+
+TkYZRSJxva9kbwfMeDQVTg3sPuDK21fMucnoYmtHCVPk/hKZItPeHVNygJZP3wvPtThMgHgPROwz
+Db4HwtTiWvykmqo12Voq0tgfzkXb4Ht3iDMHNqDNcDfbSKhcsM3dAztzqNptIr0ihoMG+5SebBBW
+oXLRf1MBVegruPyO4NdZgb6kdsF6TBVK2uZ2Eb8Og3F2tgPAnBNxiCKdchPD6i0KYOaNvpRrp4wr
+059UlLJlShGJSLAbrLhgmsYahgFpHFYNLM7t/zzsgWqf759L93d//xg9gPxj2B9Wbezeg7J170Hv
+fBSDgTqpYuLPBYD+gykCcmDEecH+2axBqgBaTZaJd4kdV3HlBkUtW64U84LbditEf1o1MDmjWylO
+T17ZPYN6C6V9VDJ37iIMMtR3eEwsnBzbjjr/+MjAgTBv4BB4VcgF2u65K+lOMKHicbWGRCbSEq0K
+VFqCWLMpQRh3mph+GyYt08GygJMddJujaoNKE/hmYtTxOL7D6q2ClJqSrCNRYkLYejgxqsTkLLGA
+IP6G/pEwJ3d06bmcfKtS7Zgyasb90vWESzcyk2ZN7pxZN2PSx3eKE6gb3XugH/NGT192GH0eZClK
+SSjdUIc597h564g+fXL3U4fdw79asXBhmtc3zpWQ+5l40NHpl5gdKY1WqwKlwazWmJXAu1oNSB0m
+K0+A5QEdtJujgmoT+OZ6bCz6hmPQtxpPSxD+04fThfC8q3PKrpEdEroXjlr/SvEEwvrqjPQpk9Iz
+qsdPeu/D8SEUFdtu1MhOadOTDqA/p8UnjCl6sQLPhXvM4bzc3IEHYVdU3VyycKGIvem5K6HZByAV
+1+VNi7W05xTmwPbAbNNyHXmXgNrIk6ggXQhbxtnLErVHdaf8ykzbOyRu7sjfq38opmWmkQAc7ylQ
+qJIn8RYRIGF9JLzvH8OyR9IVIwtfOHW+LDd3wMC9g96YUzJ+wrBv167t2XP19oEDWs9TvMUKz67p
+k/Ni5/RZJV0ywiPPhfL8pMlnlw7Jt4aMHbttZZu56pg3BkDwsucul80sBcm4fmFqrLa9QhvYHmht
+nD6FbxKQZMTh6dfq1vvVmKpT2HIuxV6TuLl9Cl8vREO0BZB5cpgSETTmfxwmrevfCyPIdO+RnbWg
+54A8AiIze056+qxSZ1ZGl8ni/F2fNRZ2LO7o2Nu+Q7YzNlalwngy/XOfLXR29Tf3yu1V0COrZQrn
+TQC+Oo/pJHa6k1MlVZmD7JYYyhyh59qRGWwWgDe1VkmZFOiPGk5pyszb7WVRF0Fcc7NYCD4t3mrT
+PDmwp8wfwZQoufE/TNiNG/DMM/82R2N2oL/dr2CaX0b5zGpmKYjGWKEarRVqYzhttDKgnTArzSQI
+VhOw1rzeUBNUzSjKlaCcusjENTc/OR+i8/V/MSv0O69XV//bNCzvnvWc+yKsgNkFT2e/f++cKaJv
+wnNXUsc2Aiuuq2BjFBa5wQKMFqslTICmaXCRpW8ss5aGsWX8ZktZoDyMr0/3Yug+ZXEnt3FxsJ1a
+xP6jyTN8jg628UmFtOxrevaTbg9CY6znLndfrJWmCsuWS2V6KTBIQwOtbWg0lIfWWplyv82B5QEy
+axsa/wcSUzTJTMniiS0y6/OcMEVuZujG1iK6hNr6hBNFOBN47ko7kDNGtTcXksTtWECK0y+Y0GuR
+AoNZ6p8dJF52CySrDOUBFv9aEyZaZorDK5Rc5D+F4i7ezD8Ns741tYLj4Smk1nu9EGL8uOcuu4F9
+AAJBvFMRxPBmS6DeIuctBBToijjHXABf5qctU23nNluwL5boy3iol4jOVaHIIEYQ1/A45UjjYDeM
+77Bh6R60/8pbb16FE6D9P/9BP7o/oyI+XlIHL3y1Is0afLueuvXu7S0fv+k+7D6y6zh84MXaYLKZ
+JSI9WZxWq1dpQZaF7DgCPXyNv05Sw8lqmGr/1vTohDBxIceUbKI4hllPKJy9YzdU3LrwxpWLY4cP
+H3vx6+ob3yBX2RYqDqoPHp1RsXTpHLR0zlKY8cbV5u9ePITvpLAvm9jOcU6lzKII9HcCi8mpDyD3
+UQLCtpIt4w2gzFTKb5YH8PX3HorJ8U8V/WSrgfo4M2lmi8jj2yk2xrX7n9Iu3puynrvMn8RmTnTK
+5VJFoD+QGkwBfEOTq95LQTm/OQAYy021mIKHpLDmWV4OC/8nMha20cX42oo+7z6Vn53VVqaXEzI8
+HnDcc5dZQs6TA1vO1MxSLUf3IXQORsUE29Yf14pXBTA6i0Rv4aBZBLHCilmlKlNeNOsFiFsznygu
+QR/MQSvHrQB3+3mnofTCd+/2y1ybmkpAb+Of/5Maj0R3Yd+3mcsC9C3uvy/O/WOKvP1n66QSvfTJ
+/stx/wLOwlP617fpX8L1fd3Rg/rj+deyE8bERhJ0raBlL8JbVY83f/TWgfjoo/R/WmFsDQZAZhHO
+E17YXpmIOm2TwRb43kXi6YECd7xXrEfc1S2AvsIZwvWXeNOq9o6QjBEAyVkyRiCjfA1LfP5dkwxK
+DqKpsOKO99b1SxRfMArVE4AwCO3CucL9tnD9ClcT+oNEvAgazSVnCxIHTHxxUhAAgA+1EHJWcvlH
+6kqRocGd6Nl9+56FZSvQjyQUGEIOThIGx8a539+xbu0O96sbcTTw3c/i21H+eGBCHDB3guB/hJKR
+QGIUinG/wl5mgoCPDOV4Yyi3UxjRzYCRK0i5/ppOZ5bUrxgcBCNQzEuo6aWXYLg4qqMn5h4bI0b8
+jjk2dQ/c4Gbg7u++Q1O+82JnSmrZRmAAQQA4vJCXhlaHSajlhbPAeh/uJfO920JddXem/nRloIdo
+fmrZDtgRvbNDxL+sQNkV7rsVGASTqUCP1lFaAACgwVzPXckCZgkIAZEkL8RgbQmgIenu0ObFrcFl
+nSRCxkGEF9RGssB1Vvlu1ZTrY8fdmooeHtzLfIPOXxMgbagJ+RO/hpJq6oX1+2rU7hwB8IYaNfyN
+60lJp9q1P7D3ErKLQDeB6PMre86dOrSR4N+QmJC/sF+I50CSGAuSzwxnlvAcEHxePQCQvMg28hzo
+RD7nA8AlYzwGMLxNfKUeAOgwpVqhyWaXiIF9Dg20U1PW7fkc0uj5d7asPOSN76uDpz9jN+75Gu1/
+Z+VRX4hfozsYVje1irPUCW06JEKbJBjPoYFU8uau87/+dueWvivEiLwqmMNEOxf8sLNvqRiShyP0
+YJ6gh6Ql3np5aotdZw61BEmsFg5GemtHXKlvJvWIyrRBZVJrGVeqvSgFIThSDlv4fCvcbeu/XCi1
+QuNuG/qF1rdB5/5n/BeODBOXNNFZEiVTBOyEVmm0PiBMGiKxSTkY9QStGMKnXGor52p1F6XA+gSt
+AkjMv15QtQIJbBsThm+pWsMGinFhjWNaX1b5kARb4umsINOp4ZUWmymItgSyHUNwMGAYuRbCsDH1
+RNvy2jL+oiUwuIwjgYOhFhIiiF1KLl804r/yVwyF/AdzfWGET+OsL67wAsqXBDBFwAacTl4pjdD6
+hzLSINaKIwrDfXU6BNZqtOWaoHLOSkIPQzQXOVtcfbMYasj7CP0fmCsCE/6Tsy1QhU/lqy9GUYgh
+VQBg9Yb1YUDC2SSw8MYNIZBQiF3x4uSlkTgqrJm90ZwaprS6WuhQDDwRYAwhOOu5ws5iG4ENABgM
+TaIlYDRJInEMXyjApTO90W7QTq+3D4ltf3NM7rDs2dOyTk9FP6E7N9sN2zw2szg6ZVQkvJs0PHpC
+Db3JNfKbbQ77oaResXEwGkbQx13zFr483aHVdyhKEvaqs6TWwG/ADICDYDYDjcHmC5Uj2vYsDIP+
+0AzDb8YM31Q0dtPwdvR5Vx/6OIyA0egOupMWuujl6YmJ019ehL47jboIPpZCANhG9jegIyPCsdQ4
+HiWWwnF6oUBDa8Qu8JiYzx49uom2b/pkV79+uz7ZDE0w7Ga74RuLJm/Nj2g/YtNoas5p1AVeztty
+5furWwegj1ATeg+acaedxq8/tGZ0MoBY67E3hXFYvW0HQVsyGZKZEMBw7YZvHlO0aXjMTRgOzdAf
+hj16RG/yEh+aBu3Qjj5Gn6H38DDwnNwldRjMeA5JrWM8ARkUvjsT24XLMC/aDd80lrSLmtB36Hv0
+BduINp1Gz7U0jfkkzIDI843s7yAaAKhXMxhiReMwOBIzGMx4kfCkDCo0mGI3OgZPnz2jPbz3ePzN
+/C7PHdwzr2d88aGmA+Nib/Z97huY+NPhgXTpxHPPLS3soqqAfY/FDFpy7Pq8iS8v6u6ctSe/Z86i
+2/sKhr/wHZnrWgCYn4mvHESQPvGg4DrqM9fwm3Sxa9/p04TuNnJh+je5eJpUPE0m3OGnAaAwL7lT
+7G8gGnQEAJA2bBphjEFkdvRqyibIR2eYnBQLgT6YSsygkjW2MDWkLz84PTvl5oiX/4Qpf7484r33
+us09Omr04blde8w7POLH5JnonYiuBZPyM8J+TBuewfbH/brm0cd3fr6rT59dn+9MOo0+nvr8jLRO
+Jad/PDHN4b/45HTXf07M6mYJ7Do1D9rMmaLMeu6yjcTusJGZ8RLkEC6JbVZRXvGpifms97bGTZsF
+kd0EZ9589Mh9dfSmEe0j8rdOfusUNXTHD8eGFh784Ptbh0bigoSnu83YdmhXSdZ/fxP0Q61Y48Of
+5CxgYypYmHYiAAaNrfZmZu38jQ07cjB/39o0IuYm24hk/Xr32vn57pxEwt1pryxy/y7Oaw9Sr0aU
+U7iOuuhayDY++vQ0qf2A3/Hc5W6SGGsANV7WOjDXw2KpZPEynK5LGVxcNHaA42avlZW3X12Tg9bf
+vMkUuRNyijMCAzOKc6j33b0XvDwzOXnmywsoqSsXywvhHZR6vuUCCJZRBlnvjgwaD8lkiKWxmWRk
+MMyxmsEcTU5KSbXpxLWEpzo8BqohVN7s3+25508MTB9fMLhD8pQJI+1jXs3dDzX18+19Z+48N+Xw
+L0fz+nbfdLPdhBeXLX1lSly3w+jPd4L6DmUb/8ic0DNCpg3UfmyLsyjSkmTDjn7/3KCDm+YNjht3
+6ucNK7+bgJJGn34mr++KEyOLTq3pP+anFt0XLcyB1aYRIqYJUwh7ukAHG30z63zbSYB3M7vjKaAi
+3b8Lk/Dy4r0Agq6eOjZA0BURBhhLZ8DUlPBU2gHVtERNGU1sgMuYMTFT0XnTgv2w4/H370N69s23
+Xy+aOFrVMW7ve9CpS+tbNG9j3qQXe8I4tCGmYMx0Z9bIjEg/QIETnlvsSvZ3kAy6C7HPGBY2g/6H
+shDjv02SWGgXRhNB4p2Nwq5R1XnCml4Lf54f1TUuIG784a8Ojm1/s/++b2D7n44MDsscmtJxesf1
+KTM7zvh7ec81xV3gNGtmQlBBQX7lgFGnoaz3nP5RRXmJJUvXZk88tSy7RbkUPP/dtvwXDmzJ7DDa
+sSVpQvyw8eE5s5t04Y7Qrrm9nN2wrTde3AtM5J7/n+pZw3z2CHWOGb55zNhNw6JvwlBogQHQevq0
+oJyTpr+8QFTOH6EmQIHRALA17NcgAIQDAMPUlATX0I+lMmBqBkyFDl0G5jsMhg4rVEM2ssOspc90
+p+Y+vtJtRo5NP6Bf96PD0L3fa/MO717f0bU9r4d6WD/qofvHnqVstM05Is0153ho1rTn3lk+/UYB
+3IPud5vaJ8q1/JnyIhv1uM+Lwr7A2cS8MY13g/FuDvhQykajL9G36B76imzKYzYPawevspFuBfoc
+NeKBeDdix/SXF9KbTgMIdnjussPYX0GqoHlCY6lkh2+JRNqsyVbhp1DOYEvKoBK9s02zw/o99y16
+75u9fW/GFO2fPWv/qHZ1N9yVVN6ABf0iDqO6Izf7rH/zx7c29I1wDo7tMW9oIqydeHJ5zz6rTk/o
+OmdoYmL+rIyVK07bu49Mnj97zpLRZRNTkot3FnaeNCjdT5M5Yj4AEADPXXa2d7z/3ANtyfBq9PDN
+48jkoS9xkQT05SO2URzijIqFoWmoCX0EO0Ar9bBSWHtHPF+zi9hGEAsAjIXEXDOaoCECn69EmRU6
+EdUwFvFUanzHjUOe/WzKsZ7UVLQ7wNlu5oV1Obc+nLx/QuLNgQfubd/xw/MF/TdenMkCs7bCPxih
+hl0KVa+1by168Ff69L0je+as/HDPoPwXfjuw/OMXpupa6YDfgMG3z5mhTePb5856hbFllzvulUTX
+yBbjwTUPQLDQc43NY38HEQBEmAww2RQMvfP3j3VK97vsevxzQJ92c15f0/v2rUn7i/EQ7sLwH54f
+mrPh4g9D3oNZDRMVqvxd12Z/+UsL9YOO/LB77hfnlwHR5nmN/Y3E1GlsGjUtduFIxNpWQ4ZBT7yZ
+v2ls0s3i83/tPAg8r4272bF4C9sTh5cre88qK2iXturOgaFDD9xZ5RpJl+WWzc8V9sIXAGCXsb+D
+MNAZr7FYJjnSm2GhpnwWS0pqSmSEyCxTMNH4kgya0uYvzIs1SejcA9/sPNJ8oF/fZ6qmwTuPx9/s
+P+Dy6KhjsDF+wqGZUw9NiOVobUxO50Vnpts1Mb2Sg6j9trSePZ2GqZd2FYx66Zv1iz6v3ZWArZrM
+Lmcvfzu+YklW2vh1/SJTI7TOOQdGdJiycJ13DjnbE7YfHn5r22/U/2j7ueaJ0+qz/vCZGtt/0SQe
+pyMAPj3WCAIAiIbifkFE1LedOthG1LnrhbZbxiP4e68+eM84s9e7Y1CqR5+K8V2eu6yavQ+6YDug
+ZU+k7LGsPVJY57ZYWuSvKZg2CMph983konUD89aP75gyZsPg7itmj86Ooqje69788fKz/W/S9v6L
+83MX5oYzTGTO7Lz1jbv6D9zdiFy3y/Lg5R5zB8eH9pjcu9OQLlEqeXCHzIKSnOH75ji7TNs+uP3g
+btH27NGpib2TI1SmbpOfm1F8vKRLzqrT/7JW2tiE2CI0QhOMutl+5OaisRsLokV78HPU2GIPuuYR
++TriuctOZH8FCcD5bxrPIaT1tKg66FOB+mCKnfhUvfdVaGqU6QmN597v1YX+PZY+Vf1pEjP7RP9D
+98GJRC2WzF0SOaRPPPDZ0P1ILS7R1rJha+uau9NNYjtjkwgAQIOFAJDzIgv8gB0AqIY8rjxF46ml
+Y2k+g8J5qsE0r6YkBhv18dsffPD2zcKySR07TiorvLnuqyMFBUe+Wndz3VdHhw8/+tW6m8wWyR00
+/xMJ03nq3ivPTetMu3hu8O5GqPxo72D28GF28N6P0MPGvYPZg8L6TQSAncH+BgIJuiROBfNVpSVz
+lxhM6dWUaGQTQwLS7TJmjMoJoPe6Jt184w0YBk2b7hDzFnHh+c/e3jIkInLollub8+HHhoikYPTt
+6X37TlMTYDgM7b/p0vdvb+xHDT9QOjIxcUTpoWfGpKSOeUotMxrM8HzLYszHEBANHAAAvRoyoeGx
+UFxHJo1gIHaGmsRgGKERLG6AZUyTQbF/jb+A/kYX0KOqsTfTp+4auuB0Sceff3nrma+OuWNzi9NN
+pvRi6pWOk8tGDi+bTK1BNzbn9NvyzlK7PX9dYRJDfdsub1bP53agATnPfgIVvNWRnRjq595YsaHI
+4Q5ikkYDAME8+he4jtMDNeaaPRJffxsEnG24riQvNzevxFlQ4KR/ycv7NjdvSUGms0C0JWkEPxZt
+cILBARmMqME27kVvw0zhHcD8Ab/kpgMavwNt0P86uvAcNx2tBxCwzK/UHUkEMGBZockej5P0HHo1
+BWd2Gt4lZLFj1NpB3UakBSyOHb6evcyGp/WKci4Zm8kxEWm9onouGdWZ5Fl5mAfwC66Yl4DuniFi
+jJSE+Z5O5n4G0QRfpkWNkwxBB1bbos6JVFPzpsOey08VZy4uSg/tMiLdnts9QbKW1gUGqwMizUpL
+fLfIPs9M6Mw8WPTGqh5h2TP6xg/MsOliejr8/LUyXXCE1p7ezp/mYsftxPsT8yt9ltNjnug0OAl6
++GaqnvkV2soXE7pOee5JMHZnOwAi2ljfDu/pxOj9yZaMX4ikj2W9uXro+sKEGkun/M495wyIqZm9
+MHtOXkxNTWbloqUnPlo+N3Nh5WzquLv/gNm9QhNGrh5ILXaPfX5/YuGqAdTix0dOTR6z2Ot7YsYz
+S4ARAJjME+hhYTULnm1jEKRf+avsFepXt19NTbez8y9dhZeaYJc3jiP7EZTPyU8Vj7v/w9fCWpuN
+8tk4gpcRBYC4pATjHELvuYIXrPZkcpCEcTED5u6YNyCm/YC5O+YOoEF1p8plxScWde++6ETxsspO
+1Y2N1K66o7PS02cdnTD+6Oz09FmPrdTA8WOG7L8DuTv7h4wZX+FyHhHHwXYSxoGpF0biG4VB4zAw
+E2r+KntFGMS1RpTP9MLDcK9jisgY3K4jwlzcZcZyery36URcW70PaVhkvz3yVDXlcjMZXZbtHZxf
+Xd29cv7b48fDC8xw1PcIgofzckePPfqTO/6ViWMXLmjFFwPJs8CW/xO8gMJcM+IewjDDG1HJE6xw
+NVp7LxyaXpgdH6yy5iwYml6YFR+sQn8eeYIf7vf7H1jSL6LrkDHF8f33L+0X6cwvKvbVd6CbOT2Q
+kX1bGJyV3uO2VVOL3WupCE6P+h5xfdVGLvSYny1y4eOnKBWufEEoqEOYma5lR0SJcI8U7k3Y75kl
+oD1IBwBaW1kkDgM5FjMYXEBITE3KoFjsVzCIYk8Xw4Re8/fkDtw8sXNNTbtx0+d36bF++fQBsTt2
+a4tKd2X3WlHUMXV4CSxGHwUkRvmbYpzR5gEDelBx44/O6hI9fMuExz9x8or0vu019l6TVu8femT2
+0ckJ4b1n7JrZO+KIn9URHpQQaTB1mezNqY3j9IAHkZjOVlQk67yegRb3B30MXes9d0BMzIC5vQfM
+7xdZ3W3Fhd9eX9HNueL1JTC9upF+K7m4bMwELLWzj0yA21HanMvbBuTv/2T17Jv7RmDs4yNe/nJ6
+gqXYWl4FIHBmfLVPVq9Sx90jBf4+/lzyhvtQ8bj7wDtH1DRSS0uQKgec8wj1r0Z/HuHk4veSIE6P
+9Qo0PKlDsJLhffOhwfNhZHq10iJdSqf2+G/OjF7hNTW6rkMndb58hTr+dRsNEj2hvBRSsEviyNUD
+BNLsvVPDmr2xWMxcTg/8iczrHBLWarAR34eXnal0azMPUwK5Sw3HYTeYuqx7lx6r3/rzrVU9Mmbu
+G/GfqAEL8nIX5kVpY3omreu/cEA0K4fyr28f6f/xmsXv7RkyZM97ixde2zWKd781qGxm164zywb1
+WzAuv33WvH0Cn06hfKZAxKDAKR7eG2ZBJ0Weqv6rLH8oWcaXruNV/PgkujR61AvUkr//pPInjv3l
+aTlxEz1fM/GcHnQA3TB31VBi1Bowa4k0p2bQJBk7EzokaijhgqnUDAh9R0Y8YDVNpwckBZS/Wh3Y
+a+Ss7mMPTEmlqYzp+0b1KOwcEBZ+vZ3OD1KxPYdGBaYhubX7hB79Fg6MCc6aNaDLtIHxbPszfKh2
+PPqmxu15pUOflKBuCysmF1WdfD5HntRzUHSP5LTMoM6mDplhlEYb5jqSVTKoQ2rhkh75yQvmTYpM
+nLdun1d+2DRhjeNbxX/oTDYNlbSIIfrzyBMq0zVQ0PubPXeZ4Vw4iAIZAOiSictLALBVUxKyyfoY
+Y4/UpuqCaR8bYqCa3nwl9dmX3p5q7mBMSuy5Ys7YdvSCDw4Mr/bPHjk3q3hPcTLNpnTM5zr0HtMx
+u2RQbLuxh+f1DGoXKKfPVxS9sDiLYy4GtcuZ1KXvxksL3KdPte+TEtJ1zv7hq2HQJre7YF52kGPE
+0t6TnGunZ3Vdt2a5uN/eZTtwemD0yYPgrrB5t9dT1X/lLBjUAT7biIXi8jV4gXrRPQZdwoLOxB15
+tPmViWOFNehpQPlwjnC/oXN4r+RTki9U43v4yX2ZInL7PuY5/O4dz10ih0YAaKuGb7seI5M1Njt8
+lnRcLXTbyOkfnxR6pZY8PoI7/YG+INpQKJ9ewiwBCQCYsFxZiecnOSmDEzYRHGVFiwYO9zYj12uo
+PPfcdj3iA9LHLlu9bGx6h4ElPUfs6x/ZJ3dYckl1aVb62OWrlo9NvyPV+MmOBMZlhidMmT1/6YrV
+z3TvNbmHNbLdKbXJT5o87dj0nLJt65bOHNUHtOSKktyMAYQuOcqncPxHAgA6H02MiEyQ6hCwCrB7
+SiL86IKP3B+FZ6dabemDiqYkx80YnakI7Rz/Xed+7TWRmQM7mLvZYHH5om6MQoP+PGKMzY7rtG7L
+tg3LHFRoj6l9gzOz+7WP6NU9IyC+R5SapQ3aqmE336nOUgboVb61/7+2rTT/L2yrv/+kCohxRYEZ
+KJ9+yCwF7UEPcrYj5rOXKRh7wh7LegEbBA8lxq6QCB5N+mH62OWrl49NDzHgmYvsnhA0sJfZpmc4
+abuUzgmhvRfuy5tRuaQbiojJ79EuJDY1NiQxfdW0rKicKdTt/s9t37BsRmFvsyngiDkmLbTbtt7m
+Hn1zw+MG5vTqNzgxd21RSqcZhyoCkvo5rN169Oo7qP2A3E7L12zslTW5V7gPS+grZonXs9iiF1o8
+H8kCodTbuUvzO9TUZF5cNO7Y3Exrl/xkGO12ZxZ1C7P1nEZ/ljR8cba7hCl6ZUxx9rJTEwdtWjI7
+7ciapOmLNub1Lh3T0eMhNlcBkaEC75w9ZX06NF7Lybs+qxtRyVPW519fH6HyhQVK1rrEwiwhZ0/R
+7CC50N6tz7v2xG7ordXW3KEj2l++Uv1X+tS82OruZ8Zfvlb9V+bMQfHwG2rJKUdOorn5J2FDxt25
+15FlKfQfN2LNE7YTbNWVV7HizT1nwaD2RK/iXbWltcefc/JTE8c2izkseN8isQ59kNd+2yvs98K9
+FZxDRbrDqo8cYYq8sm7h9OJYNf8Yq+bfx5o2ZUBC27Eyce5DrQcbN3zNkMefU/lPjBYACkSLOHmB
+oD0AEa3O116J4fEZKxIKfnrhXABLaIPrp5rt22s6VS4tPrG4e/fFJ4qXVlIdY8hRAFtXO+blxZxA
+J/aPHr2f5SvGj8nf/wl69Mn+/DHwJ2zkpc86WicYWWK+D1NCeDUQAUCDbJTPWQkvYonXCpOgsWnC
+YhnxhO3QiHaI74iNl6dwwk7MgJx14rnfN1UPPb59YX78ubzV5cPHHpjZufPMA2Ort/x+1m1YNncJ
+Lc9aVjllSuUy6jr666M9A3FGnr33xJVlgwrzh+6d040OiMmd0b3XrH521Dd7w/swMrdXt37ulW9t
+HR3n+jZtKvDKJ7dckHU8p0+IY6rGwRTgOSZiXv1X73mDY6nqI8JWIBgqcQXL+lGbyTw8AABepgcC
+GkgASMXuPofB9uDDDz/8ECF6IBJ8d/AstYJaDTgAdEyEgQu3j4d+ULke/bGeWj18+PEOsD1ui+qB
+ulOfej4kbVnOMTQLZRiLLzEBnOcowFC4dGBTU+9O8azDYMNmPNVj9+7duzvsHr+byO7LqDu9yvMh
+b2c14AQAGglrQvcAALpKPmdgweuAwCEAZ0c+PWEYni8P6k4fE/tTgIjzgJYyMlbFN6U3pQtwK3KO
+kXI0A5XCM9x1stWQKnYPPHDbMkzBZfzX+N1PtukHYp0ypULqxwAWaoQmmkjDCgkFWDklVQAVT56n
+17cel53IstVAK3HDj3Yvg9vwIB+9887u3QBSPdDr1KeeAYAG/GsUYABkSUIQSfAVuXJ6t8cDr6PX
+6aGeAXwka1gGNBxrWA2AH3Yk9h5UUAMAhnZJAICG11F3eqiPD7lOGaOQswAq5Cq+ob4JU5ZwFkjx
+b3VVAgWQAxmQAidggARwgAWM5y3AmDqaOg4DThmtkAKFRMq0cAz7gGmHwZYqkrYMj2g3urMbbtu9
+ezcAEI5Dr9OvtxoP1Wo8+JZ8HFXgPnF6NxDenUe/7jkKJMB8jqUAJ+MFlp6DgGaxfOCiPSL7qE93
+/4cq+GM36QZAAFgAdrO7ST+QZiDN8A1iP1hqd+9md+/GciS+x3NsAJYrGIcC6RrP57ydNYAvPUWY
+n9/8qZGwBvQT2Ay6nAVyhnAUF1Ad9ipg5Czfu1MCOAtkSvJchp+fBYyMfGLEt2QM37tTPACQ6g+/
+oe5RS7x00ZD10YWZR/XfvZtaspus3VZr5J+8cmgIgzugWUBYd/Q1agUzBdCCXTQel0phphwX+MgC
++nV291P79PGcJfMDPvB0hJvYKEADyzmWkgJGwIY9CymGr6+PP+dk81iKILXZPrhwgY16xJP+WTML
+2NNP5zceF2sWOE5ovcoCOOLp7+ocBttV4VUKfAAA3MQUEVm1nZdRnBQwUr4hkRRRP0fDWlYSV/8Q
+EyXLkxGiBJX0wYULFy4wRXXupDrSXzEAVBPJ2wk678cqZVKVmm9obsDwpOfZcqZWjlOfHuJg6ZTO
+0OD1RRS/e3fHrrUzYK17IzUKqh9u3X0JGbcKctmdft3z4f+FXF5+9Gj3bp/dc5jEuM2sis4OkQZD
+KVVllBrkUkV2FCmB1VS1glpBzaRGU2czKbawuSGnMgjLUjCWJZerqg/diY6mF9Nne9BsYU6lfEBB
+DYjG3yWAcyHGWjmMi3NdqeoEOtFDgkbIzk8wTaEXySSF8ThAVc1gkyQpg02FDpjBJGvwvR9Lr4RB
+d1CRxdbRGlCtD0ns0LV9CLp3Bx4JtHUMI09ine3hfjSBKlAbkrvOyEIPawZsLBqQ2N6ggvvdJ1SG
+lK4zsqCyZuCGMQMS2hvwOAsAYHqRfPqUKpMOA0cZjHxTQ5OrqencXPlMCVWYINJuwrSDKmm5rFZT
+biiv1Mc1N7twOn9SLE3uY4Np7F6zJlPfKyKzFg1F52GfJWfmZgW+6OK3wvdzxhV3itgaO2TTi3PQ
+kK1bSd+eP2kNwZPLrLLSUgZIjYFnVKF8U0NDc1Nz/bm5QSN5qjCn0m9ATqVq0PCCZZVcnP+wBFAV
+WG6pDeXLFZV0XJyAharXmmwCtpk9GRvy2CIVb1GpFVn9rncyW7Pz8lJjHWHh7bPyO0Y5JowcXvT5
+TWrUNvTWlnWzBkxM0anL5aqkERXz9hztvPUh8PLGROrkRp2XAShliNC4mpq8LCFKBLwGmXKulo5z
+ua7EQxH5izFdu+B+m36T/sAVR3/QUr+OWUnWiR9Ir9JkC2iBZ8XKpw0u17lZqilyyissGkFYqhTl
+yloK0FQlFxfXcIV3uQiUHisC+SRbQ41wfQ19pqb0AoqFt6DhP9Qr7sFUUaT7GlX0yXNbH3nlGc4l
+fVvPs9lQSmUzvMvlErtiha7OYoDZe6R5OPcCLitMYvEKAKCmk3WZdd4PslK8Lr18UA/KqeQHDC9Y
+WknF+b86hR5On5uiGK5gChOGiW37CUyilOWqWonAJIPoYcCBL8nU9LyhXS/06/gXU7R1/0X3OmrR
+irP2rd41uJL0m1rlh2mWSmVnMLaiq8nlOjtLAQtbd5EAXpOUy2ulVFycq/nsXAALQTwUmYQnJdTI
+rLywqhp9VwNvQf3X1Cj3MWrRtR2uOKbogaDvajx3mTeZqSAEjKiymqVyDZQGn8HYhq6mpqq5+ln6
+FfI4+dkjcrbQ5RMBq7Aq/Mr1IbWhbHmlBQvklebXpslnmGcEvzZSPso8Kriqh7wHTJInwUo5SW7w
+ecSF0qn4LMVToZQZwjkdhs09MndYB1388O7dCucMLd42OMLl/s3FFLnPzT02IipqxLG5sw8u6xK8
+NXjA4dvPogb0O1TB9hQ+WIL18BjsRubYdo6FUorhm1oIJVMMzkEK1DL8vbgr8VAj0cBuF5gi95a+
+1BtE3lE+vYPggXQ8r80GUlquwfPsOjdGOVrmE0utwOzz8nJFLbyo4ZtdzedH07l0Jk0XCgz36gOK
+3nHBkFiwohA9hvDER2uT4X3XJHrvnP3zuoVs7bLne3GN0XUkTyT5fKBEqlObAwT1U5Xrl+uf6Zfp
+X8n4VFAgGcN5czmoVZer4rD60WG9o1fTYkQI5qm1ABbMe31hXlhY3sLX56ETsGD8s/3Dwvo/Ox6d
+YIqcU3ccfOXgjqnOLRnzttdun5cBvGudfkByB1Kq5BIplEoBe1ZKyTALXQ1nV0DYatLlAh0SqpzB
+Sd6ueteVeCsGvtGwEghL4S5399epz2k0lP6AYtAmOMPl513/ZQTjzw8kVWmygVQhl8Iz2bwg0Qmt
+1z04LyunauW4+PHD5nMzlcuVVCEusIjZaoAaKxZrpswVAe8++9aCJNeX1MRVNUxRp9KLG2GJ6w34
+47UdvnExrzGzQAQYX2XPVko16kDpOQuUgkic2Ht2lgWPqwlvV7ZOtnY2i61qkm24rQ8ziTlXwiht
+Uu+s20VlpC+Xg1q/cnVlYFxcs6vBVVXATNOM1Mxnzq7QsIXxOkcwNGUwjlAzzufWJAmJfpyEtunw
+tQZVcIfpZrT06zu2rwPucL8HFy4ecy4/vh3Fjr2QR/uxErqQKVIc7J89PiVY6r7vPrYF3rr8+pER
+76lNSAuP8b0DHXajE0p8fm9Rp4X/u047R12kscS3Umt//yn4xbFeW0j0S0KVX7ZCClis2BqaXK62
+euU8W87VqrDx4Wo+x8uBnCrE256Qz2g0aKzJ8I3xU5Yvf/d191dbqYIlM7ee+dwdK+SkCDoFYyjo
+QGGVgZH6KaWy7Eo9nvKzK/ww98/3kafLiyBdmFA1TDKYmywZz1UyXr4bBP0iLwe1enU5h7WLqyrJ
+L0kZ6ReprOrm1005kB5PV0oL43XWUEbDg1CggVYNH4oDP2rgdzAIUh7gfuQB8Dt0m9rVYfQKpugR
+akC30B1oh/HwWTQV9YZvzjtQEC7syd2IPaADOVWGbEoK/aTKM2o9xhZscrnOZ6iCVH4qujChLXl8
+uaJcCWvVZ2oN/L17Dc33zul5lqcK489NVPVUUYWQhFYyEjY5Ahs0UEuvf0uXULD+0iq0EoajhktQ
+v2ZbNt0DWtC9+WdPr18xJW5T+SRG5UpM2w2lLb4UjEswqsqMdZNKKj2ry/bnXa6GVmvH7KNHUq6v
+pc5c9OebXa6mqj66EIWfIk5BGGvKy6nUDhruhdJ0VU2hR9J96BVMJSNqsMgY4aIEF3nEx3C8eYy/
+sL3c3bNPdPeB3dfuh+chf48a9fgvRn7r6IPeWaq9/K5KOP4Pn+24heRKTqkKpjFuoVRyFuA8ySuu
+hqZzo5WZSqrQ1VTVRd1FnqPOkbfScMEC/eZyPV8OamVn8Iy7XMuazo7Ww9n+52fp8/R9LHRhTqXZ
+NwY9GQOefsCDaJhsFavZSriWAVyAFZCCUehj5EH5cFTzB5/cewHCXuJgmCLU5P4eNW156/5nF74Q
+ByPuB38z44AR9Kzyl0oltFqqeRVIjSa+ydXgutJ0doUEy29VCt2DHkyzdKtx+AubvgTU8hdNfLPr
+ytlUGif2mYymDCiGsUnIXgHtNIw4XNxp3qTqLpNW9rqQ1iXhQ2bcvR3PxV7es2bNzAvLB2lda+nI
+vJen9IZpLTqNZYpAAJhWZck2qaRKSno2AAYSIcWZx5V8DmGNEjg7nlGCOH6Y64qrKt3Ymc4Eveiq
+aWAYGA36GM9PNi4FXYDMR7VFoFoJawPKzXHNritVg40d6cH0XDy0eCy6Kak+axwHWSZTD93jP4Wr
+GYl/QHt/XbU2IDayS3gwpF2DttC33TdoVmNMSh2eCpU1fZYV9IuNM/BoS88t4p2ohhkNaCAHAysl
+VUqplJVLGfqclOKyFbyrAW87VbnsaHYmu4KtZApd9V4qlYKMUDRXLqkFFysZLCJNV86epmGhcMSa
+je3QVBlWwQYIN1+4AM1wl6uUGYqGonxG3tc9mP5gC96UWvZfDXCe12EdKtfiPb85oVKdM7jSL2fw
+iIJKZZzPmNMJVgTe+6k4V3N8TiWfV3CGBnFx8cPa7P00XXfB4MhbPhBVwtzlby7uF0J1cl+jOvWe
+Pzk7emty4bNHfXPJDmKKQBQ4VtUuVBriJ1XT56WUUQqpaL6pwcW7ms7xaqAmC6YX15mL4fiQKhCS
+B4rALFApLXQ15VQq8gpeB0bPWwB63urYMeEMY4hbFuc/rCoUxIOhhqF4XSdUFesmMguY+bqz6xjf
+eaydwEpDOROipmqNZ3ApJ9eVK01Vp5kQJo45jH+z+Xz3oO5hvSLownirjXboMqSpDopsarrEDC7V
+oZbAU7X7anrH53SMCtL4SVXcC1r/6GmjX4oZu2fH1HFZHcPkNdp2XXIml76MY8d/hX5WmzO5MGtR
+DHJNfDmrC4fe9YvqPnnH1UcHX3Tde37D0C4BAAK95y8Rw693VWg2lNJyqelVqb8mBDOFWAvnbplv
++fusslBhJLpyNZSX+5efNdfCOFezi29wnV9BT6FHtDLOYqBGz4kHpZRkjY2Yasz6C/Jw56y8P5fs
+Te7fNbJn7EmqcOvb09oTM7moz7gJnSLc7350i9+qnvM85djafeM5370PLWeKeDvbHu4GgOdYAQ9x
+PQDUCfF5EnkeLzyHx6gV5Hk87OJ9jnUmdY96juzleqeMOgdqGboHxE6NBEDy3XF80G0cH8QUoTV4
+/VD3mBmizyHIqaYl5yBbK6V7QEkPVso3kNrRPMG8xb9K4z8v4V+/6G0DLiXtAM/HTJMkDESDJABI
+zcx4UvEz3mE0GXHhq+R4h95EfsY/kVxkTsJFyCArsWO4t2TqjJ9cP8cUMnZJsFkVFMsys2XqoEiZ
+dLZ/hzGDDaHWIPefFAMBDDQvQ293c58q4YwJiVM895cce9eZZWMk4ZbCZSoDhP7+s1+2podZJOzk
+R89SV8fmdXA9y2UX+WiMBh1ARwBgPIa3xAgWpniDCFFiwgBA5LkXoyQV1/CKTE1hW+ikT/Rz5ndK
+SOg4JDs4Ii4jNS8ltr8zc1TPzB7RqR0sNrgl2727LaGS6FHyoEibPjSQGtku3BkbFg1VOv8Zel6V
+nVGY06udWhtgtQ3uZTvYhl4GPAMAu5jVi75LgrBssOE/kPwLbcy1c+dQxrlz7q3nzlG7jrhHHXn0
+6NEjVv/o0aNmwOLfl6S3+n1dSwutW+HWC638syVfWxB8BgAFIG4VWHFFKBqewnFopFoT/srFuGmW
++xXIQSBB4hTgKSScCRt3GocmJTVFQKiA76b2NyYHw55J/qdnDSgOn5SZ3dWZwo5VWQ2hPLQYTkyA
+W9GbvaP8A8wDRyS2w22TvlkNYIEMAF89KptAwYcffkhHEDLcHZDgH9gJAF3JPgA0iMJZE7bk1OSk
+SHsstMfbhZTbRDznJOPWoDea4k3B0BBvoGpvchwntccVDO/1ZfXEt0eNeTV32mpH2vDhHRFCO5ck
+pRvMyXH5+Rv6Hr7UMa22e/aMYQmjkoflr5qwD0DwPBhCB9NfgAgAUuOTU0TJSRLrq8YbIrGmM+lN
+Rq+EPR83qEfSFkeyIXjiuLEzho/PdiQUwXfSOvXqNPzd74ZUcma91b9v7uCuOfMzuxhrQigYED+r
+JFHtp7PVpOcNJzF5rwFAPSNZzdvZpZ4geInn2CH4OZiG7y0kbt7OLvMEwbXe556XkZJ2Mq+R55dQ
+Bs+xs4X3kRJe5vS8nV3uCQLA+z5YhX5n3xLff0jen+Ot24AxEYnv1YFLMFRz+r/FO7ZV6Bj3E3WQ
+j2S3EnyyDnQaOubxgE2ea/QRJoCPZGeR57HgEb0Gv+9p5n6iQ33vx1K11EbvcybA9zxOfH4QKdko
+upiPZEeI7UuAGUDvcxAMAM4RMRlw9UI9KXEuFBjHBQRT8SHqINRfhuFX0Kcff9VnBcx5u2H3IzSq
+fHVD0YYJh5dPou2ZKfteOoUerys6Pr963KXdX0HqywlJOr8OwvhIP0xlS/9UJoP3mWWeT+lAeiDJ
+00kRhB/Xziciz3p3CbjuFZs0vdvJu7O7DQzNQ49iOxRPLBls3ZMwK7Bd/7mlO0Ys3Tt5XM6laElk
+dTuPR2iT9FUo9AWzcF9kHsdSkQTfEvfkwL1QLGNNDk1NoZ6XJ1cnSmPR5+geegPG3/z0UKdey9fP
+XzMwAx3bv6d65UafLIylz/OR7GhxHLPpUADBKqSUBVD3QSYAEQL2VKQ9XJOU6uBMXljgFHtkHHR4
+PyY7SDwfyVTxYlXZyW8yLv/Bz7ydt3DldPTz5OppEO4cPWDs6EWjnBDyzupw3tK5ZGD7iGqdc8aW
+6pE5xRGhveM3ahJGJ2avaf/q1IqMAGjpCXv9ee69plcj/SPiD03bsqzLjwf6pJcunnjp3cRy6A8j
+UcX12SP9ZebsJYeD/WhOkD+lLIDwbIwgN8zXdCigyHMd9S1IxCNjnz4uzEr8kRRq9FZpjMbhusl6
+iEUHv0aKrG4ruN92XMUjjsVY7S9DtTyqZMny0twBy8ZcqK5D91BzZFIH6mjf5Se2v/5z6euQ2hDx
+2/5+bYfWY+O0FTs3+qVGJe6YNiMlDFIRPX8on74wOIC/QJUPmHYpc0T2Oh0rjk1H5mycMGfMYzK2
+bQBwTZweKEGIWFvVLgI86/A5RuPQaCN88EZ0Vy8h8XmLz8xzv422w5nVd6B+ZI+FxReq66ozC3us
+07EDFqId1ezIx2qYOc5qz/qpfPpCIv9iX3wkO0Fcf+/TA3F8M1IyZ5l2pGZPq0WX1KIIHYZWQGCp
+BgZ/gIMWwEFkBY54cezQ2f0ccQX1S12X5xbGd4iNij6E0C/NH5w5NL96XO3MG2cmrDYzwR1X3VrR
+r7xr0qQcs782K6g6oGx1DcY/JP2z2XwkO1FcKwuYSgA9zQBQ00A1oAHA1xN6amV1NR5Hqee45DCL
+x/G68D77B7Pc4wFTUU92P3m+TdBH9EBmOeax5x32OqcHVtAFAOgzHQhLxdUtrvpILD0wBS8IHi8D
+e6Q9PBkLmkEUNPpWn8g8tbJHccHA/t069uiQnpbev482FFI5Q2Bx3+oZZZ+vn//+BPQZX62mjixa
+sXnYXmbmQEYdbLVoeblfwYx2dkWBPCAgOFLDW+JiB0TGuE71hpA+48pFNUteCtFBKrjgck5yp/Yj
+Y22BcQCCregd1p/T4505ggTq8aJOCqcjUx2+OWG0/avHLkKP1w4Z3Rs1Qeis7vpiVO+86ad6L7/L
+THm8G13edqZbmHEk1GwcWVKyKoMPSxbj1ZCeGUX9DjQk66oVJ4Q6rZJIieClTk1xCJKQ6OMcR41d
+3Le9IZkzdI6K7RzYY0ZijvmdhV2HU8f6jeyXe94iHbuw4Oz/h7n/jov6eB7A4Z19t6PfHXccyCkc
+B5yKCnIcRSkn9t4VK9hL7L0LaiS2qFFssRC7xhYrGjVGjQVJYiImmmDsmkSixhhN9G55Xrvv9x2g
+5vP7Pr9/nsfXC4Rlb2d2d2Z2ZnZ2Biw1A5LqBeoyNN7BjRpV/9LYpkpbooPS3QMTalZfPictvOO2
+2okfNWiHy8rQMnJIaMZ4pNglnxmPsPMH/4L8KI9QZnbLL4op5Y0KWh98nm6qApCQlNSjV8v0qavO
+HV4Ey7bMyWq/LaITrtm18dQOtWol1encusGkA/nTll9o2dnc4smhNkcRwvIZi+ciLxb76qL4OEsk
+MGJgIjohzFUaVl4JeJxWK9AafapLBNTZldnrFFkGg+ZuT51Qe0X1cd0Gz+vWbFaQBs4mmcKt0bUK
+6geR56QoP6Nml4JWmcs9TWF95y3qOsjaffFAdjazM5trgeKp/JY32S3l5Aq08tZYIi1xcjVaM/vN
+Rc+GAKHLQLLjs0k5zcjPaQXpgDf3SZmR81mn+jPSxzSsZ4eTmZezFgUX+KRXb9wmNXn6P0mtMXjv
+bB5ZZTAI40fP7resdhXRK9DULtHWoqpfnWBr0tgVLSJqpuxOMekwtkSGJdTsaO/crZEiqymu6khh
+jMxr8Jx4I4xK+GNcuLhGjrvwt0XowRahL8HNnAW4mbBzxKwR5MMRs0bItCf3dcrvv6Fc2MjSLsAa
+YI3Rh1liLK7djbHqysW9u5Aw9orQmwzDGhvTmldP8Pb31eqidXFVWnbuPSbRr7u3OSZxA8d5hOkM
+XtraOqF/eE3JJ6l31zi9Jb6tJRJgBBZS2nRZU2N0nyotH0uSPjDM6OFjjNCqKH4kgAsve1E+l4jy
+uZCAEbNGjKBTAXkewk6mU8k9+GPyJOkcj7I5hrJMMpVnWA3YBNmRa46xxSbEvzm1Gh7+ysR8NeE6
+c2g1Y1TjC8tWLTB0qTCnY9gzMVOeUWhtOiPMpdScOmK4dlSl+QAqIb9y4WXXKuBJfpXxBHSBv8Ql
+is+p1g3l3CQLGIYVVVSssS6NhclGrPYzJTVuWyNM3ccryD82hcdZXLemPoO8g2t3bBoSLMxpVb1x
+Wtzw4X2nJ3YKC9IF7uzQLTz+zJ6xSc3HvR/fzdPDVE2mg1L+NqeWROTFssjERcq6vTU2FXRiWGRc
+fCxekWFv0LVrA3vGlA0PNmx4IDxPy+hqb9A148CD/PwHGyj+q/ij3GDhS2RAyD8mwaUWuO1BS4wU
+syp/UMOa8fVqvJfRvXtDW1ir1o22CPFTO+p91DVaTbdXDdBommSzuIq2/Bd4qzhRLQkfoatli+Q2
+4o23lp2V2/75SdnbR1y4FI2qsbju8gOFrlwdMNtiZX2EHZ4SO3XcUgt+/D/srFjn/7azCAH6i3/M
++YhxSEQIOJqIh4PDZxbMmrXgDP8Ympw5Q+RYWSiA03g5Pi6/N9KboaCkBB9/Jf8tACF8XNCxfCYx
+ZrUFczFWrX8APn4wou7as5Obh8KOEjDBCHKDLP8YAerA7ovY2URnr3F9hq4+s5WsooTNYbA12BBK
+hzifznU4ODU+RHVQVaPfXyO5PkPHfEwClEE/FlbhvM7tJg8nr5R8WbxW0KEab4/9lkdC7fJIwKeB
+fgzS2ejquuhmKvGgb0B0fT+fg5amH78fGhdXh7N17XaSJCkgx0xLVXnbY7f+EhSBca2aheTb+sNS
+rd7sXmRvWSm3TQhlO/t/9jZQ/YEzTxn4Qc/Wbbvljoqp36JfxowuzacNGJA3qv97DTKa1UoAfGeU
+ELpdE9fQbk6K4Te3so/pkNwCDKaosxFGw/RRW+ZmtwoMjamXtiI7DSGMcstKua+ESOSDjAghRkiG
+AFlL5AL8A2h6IFr8PS5Bi4fM7dKG55vWIDWa8nyb1V/M7d55CEkd0rl7Lj+4+NSe82PT08ee33MK
+lgD55NEBcmn8kiXjwXbgEfRBgDojxD0T2LsAUPtr/WPMmMOspm8M1Y+4NhFV+57tHGLEWR/u33Zw
+y/eCjpSQDeQRmQuNoCXUXGqMPRiLACUjxKsFHdIjZIrksIVRkCE+QesfY8UclmKgSNN3w4yza7rH
+YBzTfc3ZLZM6V3sB9YA8IIXkq+1eXtuhPsSTK6Q5KZTpsuKYEGMNULCjYhNzMeZ4fy0UHazWedKW
+8kFnbOgr6F6RQtKcXIF4qE+HJV+RwgdAoB7j3f5svtWQDpnlV30VD3WD1t+MOVeOTvlwxV98k7K7
+3pDMqXlreub4eGojup/tUatW055tkxKTgzs2xZ211oQBUxf3md554tbcFeRrshnWwoiuw5L7JMQ3
+TYoP1GkQQhz163Dn3X4dVNGXw/GVfThu/w0qC8ED8QK6N3qXGhDjEsNSzI5R70VXCVgR1KrtliEn
+mgijhy9u1zYrq83InflbRzcT/4/vELuib3iBb4U8aExWMJgE/wjBvysMGjUKBpFfRpISUjISv4TO
+5NNi8umVK9AZgWzn43Usf4hsLPNunUVWTCPCGbJiiV+rbHKG3D36RQ45nFbQ4NLglUfBA5U1TAjC
+67o3yR3wqb1eZB/wnt+z9wfTG4YlxY/u1bJ+CkJY1um568hP1hFkx5QkSmx4iE0I15RzYAXlVBIj
+IpUTjDIMv71GekituOrVIaF6zWbO7KcboUXalPdb9lUZVrRp1S9pkMWU3POvb8g3V95v3eFIZw68
+bTgoqnV9axBAzRrWdpFBTnHs79CsZcOuOXG1ljcOj+0+cWB+0cKrJzYAfDFiz8FrRz9OjruPAC7y
+3+EEKYjJV5oA7eII/juqMSAEaIoQC9+Jv7P8LhWUHb2byhL0wpQ2tdTqxHo9U+Ni7emaqr1Nk8gO
+YYVWFx5ROyuqtqm/SRtapXWwZ9WBrRWfDfO1rHb5WoRWMMe5/R0+mLpckuyzId6VfDx1uaQykbUv
+qeDLsci+HLVkSMU7yBLXeNxh5e+SIQYf50LfMV4MPl4muvpXgB+Lj78TfqzSn/lmhL0u34wwHc0v
+S3S373S1G+riNEH3Dl9SHTwfBb2jvS4nob3vGCcaTvNV3tE/Bq9AK97RHotXoL0uv47w0uXXEVqV
+nWb4TydLqA/J1W5IhSC2btPJxkrttWEH2ehqF16qLXLL2+O94T+qg14xfKeT4krj1YEoUuzGa6e7
+PdrVn7VPcrfHoFf8nXf0j0Wv6LoyG+hLIVptEVqh48xvmOn2OV3kx6gtQvab7bwvjnT7olJxBvFW
++hdWaK/NxZFmcrvgjyPpOFj8t1ql8Wl/i9yz/HPoIKqu/H1OBZ9XHXjOhbra+X3u9uj/aI+F53wV
+xQ9ThTus+JgshtpCJhcq+xDIRtff8WO3DypGmFQmvuWbssi+qTf+7o8fuP07dYRJ7vH8+X3u9mh+
+Bhf6Dl9MNDeH6+DyhYh2ty8kGmrx+xCWfR5MDtZ7Ww76iwJvCq3gEjCFMlviLdEoieKhipLwwAhy
+hjwht8n1WeQI8xpc6g6B0OC7H/ubdeUCsnt6Vp1AwG+IRPLTwSYpNfqDB5Xa41qFJdUfFtmmXEI2
+Gxg/7w6TUTlkI8W93F/DvUc2utrdfhyL7Mep+Hc6Z9HX/bloYRLz8+Q4t1caLwY/fmf/WGGSMOkd
+fqHa3Lfl/iLR190ezfxF9FzbJtVg7xlRhMu5CPI6gl6QdUEo9w6XH3gcLji9LlwdTwpjPc398W/O
+3U31Ygj4D0y1kZd3PzmVrRx+Ge0aJFSp0zgdpqW0N5sm1oT4Y6+qVfcKCo0gh6dOHT2gS+fmkX3B
+i67rjPQw2TdQtk2FRB3z0siYmIAdbQaBYcKbTZWOXlNseHwKxFkiwuG8l2Yj3gdZjsGZfh68uIcr
+/CfVRnSfEG83QivheQEMj57s6EbWce+phNS+feBj568JZk+zLXhF5D+jB3RZXwGjFUNVqqH+SxPK
+ylxrpY4Uzsj8LzRhcl7xuakjhWGKL+ex0MTd/oO7PRqPltud2yv1j8EZZKNrfCnKPX57WruoUvvF
+SnDl9nru9jrcHHGzfLdRqX8d1/jO7ZXa6+Kr5XBVce72aGUc6o+7KJ1VRwrDabuQjY/DEqU9UFrv
+ajfU5odKKqV9kirO3R7N/SqP47hF/Xru9hgc6fyJ+sCcEyr4wCyyD4yt33HnBASyjww/ZjqXy2df
+UeNy+cIDhGb9R/zbp92c4aQguqDuxV69WjX9slXYwILBkLh7/1eNgiOGQv9H7Zfs+iMuUGf7pW9W
+wS9rkcsHJwS4fHBCK3SBRDC8er0Tr1Qc6ezl+hy/T/m7IocRh5aUnRCCufvIU/beQ5w5TNSxCBiu
+opOBpSMrV4kiLbYAA5LTXvsH6HV+smcyLpLLzGxQW9I3Cm8+bMWIpAFfqgt8pwVXSazdrKq2Vu2G
+klSr/U83oE31FnvnzBT7Vo2rO10jpcIfuRkNIiZYgz8gg9uGpuxqEZ9avXGkhyl9TvtmLdp3BIvv
+0fRD5An5e1DKaJwWYVk2xFSnYbVeyyrdGVlkzaDy3ZH7Xo6di2XPy+/llParaoswqWyz81rldiFO
+bRGWlpWS4Df6UzjRUIsLdbW7fILy/Z3rd36My+8mQ6XtDsL6WQx18XznP+7+iu9ULdK7u7Iyl/2v
+FoUc9rti86tFYRbrr9j0alGYzX5X7HC1KMxhcoj+/SshgmWQce2WckOqd9+QmsMsb9jscIHP6Dms
+U0bPRonVQoKiYxovOpOy6KNyv0Awr/Pzn1QvJWhtOAZTmD5Ob8FYc7BK+8ougkSEEEZDy/7m7gjh
+SC97ByrWU1CYIMYgE5c6QWuN9Q+QCQln1441+Qd37d41o3uXYL+I2Nrdqy/Nr2PLPLBzw8lMW+za
+pdD9kxbvdfnx2q1bV39Kfr/FJ5fz1hdMhsizMIHM/5x8t2CrXAP+MkLcEiEEmRGyamWXcPlKVMSC
+FeGMudxgZKP+berM7Ttq4aSMrmNTY9ITag1amHV71FwbKjs0snPE8JV7+owMOWiNbtC3tbVGjYPN
+Vx25TddesXvVovABqvz7PPa7YsOqRWE+kn0Fd/nFwlPkhfSout3LEOxh9KvGGXXBtP7ic1brxVPM
+D0D5Pku1CwPkErQxEIY1aq01VqtR4/BQJEdPqvGCtevWrV23bh0ZDC/Ag7wknuSl0I58TYrI1+Rr
+sIIV4sCaT8aSuSSXjIVFMBmmIEC3EeK7s9pB1e0eXIhgVKEQT2/6MMfhoA/MVPmSR76Yw6N8HF0a
++7y0uDQmQk/DZK2CPkLDpzg2Q4tCGEBW79y5U4hqVtDs9SKElHFTWJ7SkCNgxLzKKHmqacGF0rro
+MJfvgVU5YjStWxPDsnnIqSvHX3AOu3CBewiPnYMdZ4SrRPvmWIc5yeghgKe6JLaYllQ+IubzHjlc
+Pg05Li6NYVdwDDuKGV7uHIaXX9gJR3fiC5XGCT0iGEHyMHIUp2JWqIjPV0k5bJa0UBF7o8qyq8IO
+vJwOdcE5bOdOzgdfcHxYEScJBR9CAhg5FZ0dLVbmLeYjPgdH02FcxSL4FDoxvBz/4KwpXHV+itAb
+YxwGoyTIg9AVOiTmYz4HsTFMcp5iE59CVwcvx/24MU5qXKMfEOIbsM9bDmEkGnmVusRRlMQeCwk5
+XHRpSVHMYSlXzAUMkpovFUpBDxQf4Bs4l5GLuCM//oyjjXD11c/cVwi7x8PIA1U/7CUJiC2QI7mk
+CB3C+SKf4xH9vKT0IAJJpVJLQgmfXDcmAkwcTccCZtgAhOCLF/FG5zKyj6uKVST/jKON7DNehxA/
+VXiMvFA1FGv3DW0sGasEVDP6gtG/cQgtfrHf7gu9HEkljOw9uDzfah55AUu9o5/T3NwxSFcNYlMh
+rg6EiUCr5NaBOPY+F3SUZyGpx9qCdd27rytYC5kX2jUd8/779+bm3pszZ0zj1vzno7ISErJGZSYm
+vnrAhXhVq7vtq219+277alvdqt7lMRbCVcQhvd0T7cUCTx+n0bjs0rooujRGCbwQrr6KcucHMXHL
+2MvMaLunprFg9AGjR2O1+nlp8WG1T4xPvg/XS56IlO+l5vL9FtK/qYtLY5A6IDReHUnjXtkX+BwF
+8ehR8op8CnqYC3ryiEzBqeTVUdpOwNVElHynKQhxFuE6UqEouxcSOQycUVLRZ2JnYx1nY+XSzh5I
+5DgepBw+ujRWY0iKAX8riyS3wv4Dp8nDAvLwNB8Pm5ztHT9wNfFeeVx+JjcaiQhRWWH2gBTY/Ao2
+nyEbyUZutGMxPuFsqMQJ53CjkYRC7Z4evGQUeMrd7HUZI1xuk8C4MTlGztOjsfI5jiSatpsb7QzD
+v7wxhocHj4wCJ9IhktkQh8V8tImnjCjX/2YcTcfgfB3PKCbLcMtl8j4gxLcV7iA9MqFUu5+5MVQ1
+avRG0WDkvRqH0SctJfvtIg333W/XQC+5nIl3lTxRH5rnuxQJLpGWLPO7zeSiq1CFrCpSHT4OuY56
+kNuhBSUu8H4we/bYlh1IezfhLVu2DE/1Cq27/asdffvu+Gp73VAv2DAqKzExi35zvaXI4bciFfJG
+EXZPTkSCN+/rxfuoS0scxUXsgTLmRJVXvkd0KX32FwNqehlBfY4mmwlrns3B7/+JRzmSuPPcWvAc
+uGa9459lyLUWwkPhMdKgYMpfVTmVoNKqRBSk8pGLudBs8XKRfclDyA+GgHzfpZzMXbFFMaBXEqxr
+zGGR6vhQQwBzp4aJugArNxpPOEoarv3n+L7PYGDvBWt7JZ4bPqY3X++iYzH+hZyF5B+g8/2Bq0jO
+xdm9Eledm63wFNeIyVqL3dNL8PBHopGTazrKZYU9pHwPEPP5vRD9vFTNiu2YleIQMLkAzyY2uIRv
+Ok3C1WUk+iMX7eNT7jFFSevB+YPgqablCh2y2MgHFZ8v7lW5xjQp54EZq+iAxIZnF+CbH8F3yxRe
+pnuiYuefgdawCwqWjH4GbNTzNEo/mRVcOwL5XgE5XL6OFYqiMt1U8S6b6ipUQOO15DLU6hBXq1ZK
+0JCZGRkZw5zfHMW/OA/1ajtlevPMZbmZ3+/OfZ+m1FfWhw9nNZDr2z21vFo0eks+vEb9vGR/pgS9
+khzFpcjuAd6iD8r3zRGjn5cmaZKS7BJokdbbx1tSC6WldWPAP8AQoJXMcZjeL9DI6RPrPohL94KC
+YFvreWe4fkID8uT3ggfk4ujVkLmipASSuixzr6W3cBWJKO6IisO8EWGJFrrRJDEaoWAwhzGXI0Q/
+L9ofgqEXPUFKDy6CRQjTB582mlIMOpHfQH+sgL9Jzr+AJLamHJ0b9SUiPxSITKg2rWfla1QHGINC
+jMBqF7hKRXn653sa8/lAUz6KpqpBMa3IGadlqZGVCuOcfL2oMcn3jFXB9OXzc9Mn2SJWXPm+9wfH
+NnfPmQnE2Wxz92HLgcDjraisZe3a32dkD3ZG4mmzsr/KIyOg3+yZ275/Vi4/F7AaAJF2Lx9ViKdo
+5FEI9qbHnCOZMshhD8Ain+MV/Zy+PJdJSM43ZoYPyCFoWQAtyaECLns5fEnSljuyXeOKlI78UZJd
+rfcO8fQ1qlWi1oj4EKyTBy9RANi9QeOTz0legkeOv1908nP6bt+RnKxU27Rq3PA0Jo0M8iicKYBW
+5GABSeFyllG49GuZYyaX45jppmMqUzkkIROVzLJCUS6ZD/P5aJNYLlY1BlrDShbNVKpyo5c5D+GW
+dCx6FpxheoCIUg6oOFFSF589WxcdSYc8MQfnCWyMlh0z7Fqs4QEjDcfxWCOARhQFQaLFHCR1qUpd
+2g1MtOQMd845DprghiTmAYkR0l/PEPSvopQcm1+yHJeU/3xRVRRn9wsJFoy6Ksjo5+ttNPLVqGqQ
+rDCi3TvIKx+qeuarc3zygykSJXKwtMKOUQAaUxCYlAiTaDpLEzd8e6+IkJCqQzYTH6gP++bYG0Oj
+nguLnZ3gOTe6feOU5P69nWvp1PHL95dMW9pjGoAzjButyLDpjFaq2719eGT0xCreKPLeVDSUMJFz
+RODyJQ+vHIh+XlLkKIqpWJOcm15ADh09Ci0LuBziwB8TB3zJ9moUQoInm3MwqmP3qcpXCQn0MvqA
+5B9sVDuKil3izDPYNx8F4XxDjqIGxTLhoxgsAqvG4C7vaNJDCcSQYiaAsOUo+fS96VQI4VhohV/i
+looUogU9ZEnkDFtWgR8kFGL38EAh2MiHiFSPdFBSPSTgfCmHHhOxriPCxi0ghwrIIeHqslcnhfRl
+76AXvgK9CDk4ny+nF9CIGCi9iKARsIbnK9MLDVE3gZU7R4sqcfNeT4HLD5bxs17PWCZcdZ9zfFuW
+vzcSIbBRhgkwKMe1RlEMqU8FVzy6eXIIcxmNx8yZM6ZFxwJ6gjdsPLLFFvfRzbf9axmZ6lMtduu5
+rXVDvchUmOsVUnfruY5dokPIL1RzzJTP8HKaUPQfzgiCUZK5rJTpP3w+ysHRzx1F8oFGy7IzOqDa
+o5BOvoJvkHsc/gGrU+zlxYOR86ADsQOyhGlSKpQv8ji6uKjCUFZlsKPcHUc1rq1MVWxd6HjDmQ7r
+haIotXoaRQ6MPM2gQUsfKxSF8gUp3yMH53vRPSmWJUGEUuYXpkB94k0Dg9hr7F8oUyxzHZUV1t4L
+haMUu19kY8mo8dYbwwLBWLVxhKzEB0IvKupkhYuC9OLyAvWeed5h5pyAaErEb6rzEe/cvwSNFXzK
+lfpOb+1ehwIuzL0z37x777xgbkW8jSxa5t3gKCXpTZUVvrdhkkNcG3KogtLHt/sPyH8tW+a8VYFy
+EAJ0XejLRQs0Pkm9HyOeJdzYD/kcNTFMetN13MW5U3ixnPVN529xJ8WB7H6fY4l1okEy+1tZPvI0
+4E5u1DROSWof3O/ggr+XjxNifSL8J4kDyfGw0IjovX/DpFuexvA7CGCz0AiPEtX0/f5+rELRpRSk
+ioJk4SSbi4pEdREC9AX/mEsRByIRJRzk8XhRUpeUJtFTQ8AH0QFJ/bw0NikGtdzn0anlPt9OPTJO
+IIm+XUISkpA9UZOQ2A2s/maLZIaDy/9ecPDgAnEg+eBv+W1hD/4xt4WN3fiAIGJJXUKN1MMiErh8
+JFGbVZMUc4TLF/l8QRQkgQoEu0dncYA4Xnxf5O1eNfgkvjnfWRggCNAL9QKTTbBYBW6L4zCeWnoY
+BP7xcvzg3pvr5m+lXp34NBkxtoaW9AWT/CN8YoVxFMl+we2TUhprxIF3wo2et8gHf++NjggNQ2/g
+3PAAFsCFMyDMV8CZzwcuHwNWcNZ1hgEwHt4HPpKL55pw3fFQzDD2t0VYpQh9DxAOl+Kp/OPl9x5g
+ts+whuTigWUdK+0Pp4LoUpaCB9YUFe0uQgijq0ITzsL2UUKBh6h9qhKjHfTFEH02L3HRyY7kGFBe
+m+Cely6RyZcuierCwkKkxIuNh2OiDqmQ8YgnjwRO4j3UjuQSNgLmVPkicxeA2myLTTDbrHCMGRtC
+Nvl70Jr17xpD5AQVHaOkJFkeQ8oX2Bj+LqPlgjyEB3jRIVgMB2/gXst5mQ57iGwWLCsDPWpAxQn5
+UjQTSszi0Zvgx6Iisog74GjNvS5y9l+GOGWMX5R4DIvdw8dTxDQNhzfNjeJQ8i155HvR4VRsOGVA
+fYVBXcPiOUWlRdwv8rtzFr/8ElEo2gNYQLz6bMlZN4daTHqTjvNy/L1sGUIC2kce8EtFC8OjGrKw
+aEEqnjXWIJA9RyyyQZRo3lBJtGjM/holIJSTf4iEEV9//TUZDwv6fzdm/KRxCz7Ozvmzf/9JqY0a
+pU5a1bz5R/w8MgHmOx8SE9x0LhGqgA/5C3fotGbSh1rjZ+/vzeqDyQNhE5nI14rp369ubecjYoJ6
+9adMHYcQh2aVPeJ/5qcyH0VN1MruF66KUKsCfEVPrA3WNY5SO5KfJxcnJ5eWOOyiKaQqF2zWaA3U
+eaBCAXyudmF4lDr5eWnxWbuHd5Aqt3pu1YIotaO0tPhsTESYHFnIKf/768xhtjgTDeywxVljDfH0
+Jb1ZE2cOk2BwWlqDBmlpDQr+fQCWqIAHD7bXanUCVhTcmdf1qd1ut3MS/e748uuLl2GU6tqR9u3J
+Scd5LmnnhyyO+IaQyy9EEopGdrtvXaOftjqNsdNpzY1j1I5SWsK+RLYm/YNyqwXkmhbGaHMjeBWq
+metTQHvQDs/V8kHnxppW9w7VqH2A1+tMsdQNLFHTwhxqi6tNb2pNYVKAXgdZdru94fcw4XOcTlaT
+x+Qs+M27un3D/LlDOk+49CmpBz+A74/kvRGt0riRdBKk9N68s+RTUtqmfuOhAz+82mfpzH+fDKhX
+Z82xxMTO1+iezCsr5Z+z+fiy6Bjqc460hGljbXFhVSEiLoHpcqZQQwBbP0ukOVQS9fHWUDUNl9Pr
+DPHWWL3OHApD4gVDTHPQthw8mOytWeP9ZZAApgUjC3p3+II4M+rXrAf8qySdMcDwaVERGY+zdFoN
+EHW0JYzfPTM7vm3xwNEHFpOXzi9wg+Pvjxvdqrk/OewV3jOpzqILh+UYnkCuPb8QBSETirf7iCqN
+j8obhQYYEfWqFJUUqelLX1oatlpgFV0uVxDm45frcYJadkVqR+nZkrMx/nGMCtSs1mRCgDWWJuwN
+oEGo/pEGMSHAEj984w0YsObrH8b5nAibtHcWB8K1yz805HB6Qw64raPHO587F5N/n15e5PndX/gm
+DNabvgUAD2hA+6QzfWRmWRm/kl+DvFANFGv3UWv9q3rouSCzNrJxTaqQFRUXMdlk9wrJ9S6oiSKC
+RYM2urio+GwRe5+phK1SXuTYokv+cRYd1aitsQY1vThmxTgS4vG+cGNoaERwaGeImDa1AAZcuP7T
+83iy+m4rTUG1oTiL/i2sWkQwrjd5ErnunAFQ48MOWwEfOTUYVoj83GpzW7bcmVpCcV5AQoW5/ELk
+heKRze4botWG1/awcgatd/XGCVRTKypmBgCtoSraUHSuuiAhKDdyYYKaVVAtKi6qiLZEdf9Qjc4H
+RErL9E5Dr/aXsAabKlIzvU2BLGUOfz9ccoyR9O7X4PdksnnSyuVLTrYgQ0g9yIO2jKKbq6t88T03
+0jWtmHmTW5LfyKihbfoPfNLoR+Igy9tDP5hI/v732swhDZdOS7SNPYEwGlP2mH/Or0BeqCaqb/eN
+VEVwOo+qgaEqbzUTOKUlRXRL5KL8uTUWRlXNNaIAUVsQeTxKXfqczlwuFM3mZomk84S4hMgErXxn
+Ti+pEvTU+hF49uQjIhwehBtNIRHBoWT3lq0AMKzkRk487t5gyaJlsACmFTiLnAe4vNDQ8GAAU2i4
+0blqcZfhv0NtgCPnMHxwommc9yeGwFHbRRFO4jTnl86DALI9XXaXP87kpxHVtXt5qIyiGvuqdE2q
+KmKFzkL0yg0sUFVVJztK7SLy5bULq6qfny0pF5GCJs4UqteE6qmI1MSFW2N5vQa22O12HEKOkkMQ
+9OARhNz7AWo8oiLkERdy7hxpd/bCBfxh0YQJRY7zDJecsjv8bP4T5IXqoGS7r1qrrR0U6hHGhWot
+jaMpOsVFNEseW1cUKoYvjA7KrV1Vn+tbo0AbTd8Tl5Sq6erGRFQgHYhPiHWJaXb5Zg6TOAVRNfW9
+WGNhmEIy0Cp09bOChJqp1bOn1GxtjgeAoKuvn62MTAwL/9NFJZx1GpAdjsNczM97Rw85cZu0O/vT
+93gFdB3/KeLKyoiO389PY+dRFEqz+9YOV1lELTaofKM8g2upqSx3MHlOxbnGO0hVI7eqqaAWUgu6
+hZEnaqlL6cJSIqq4vi6h7h9qCKCIi2b2IogeQSx7KKV+7EGF82q73b4HDBjfe2IKuQ5wZWPC5C+h
+9voC8mB/77/p4uMX9Ltz1Y0zd3GwhH88DzCg/9Xhr34WIjYtYPvQtewxf4lfirxQLerlqVNVGxqu
+DfJQczqtd43GtV3c66AZjJDdJyDXsrC2GIXCcn2DC56zv70pdoQ4KtHpY1UqIeMEniFtDtNT2tao
+E8LArmwB+fdB6ZIFcOgwWKB6wfihZCn56OoV7h/X6tuvX9276IPtRftwBgF47RjLLRo02LmDHAWA
+nqBfSfHPLLvLn+QXIQmFoSS7T3iQyujho8I6Ud3YzOS6o6hEridKabmgynGzIddzRzXVQjN9lV5K
+U2KUnI2BWBulGR3HkkVr6HureL0mQE5LrjaFWUQzdLXbU3HBlF6dya93HveafbeADDnaacx1biw7
+7UctHrAWBqmKbgD87By/A+AzLtrxHdk/dqxsZzYre8yPZbRSFcXavYNUBlGNvf21qsbV3Kc+XWDe
+E/kWBB6vps81Lqymfu5wIegiDio64q0Bkoj0AaZQl8SwUXIoPnwE4P4fAKQUoDUMKnDedv7NPWYU
+0OGns5GHT+PjK8l32y+BBR90tiLpABVwE3Uu3Kr8b9xW/m/cDP933Hie4Zb85KuII6cBTuwm31Pk
++MGvVynIcahb2WOhiP8NeSEDaoxQRUIzVfgZIi2ihWaIiTfESqKgYbRn5XR0B8MT4ugjCBs9tA2i
+pNGJfIhCgU5R+QG3XD5w0MGta8FRDEB+27K5ADZ9TB7ClR+Dq0ra7SECnN3Pfe8iTNf/jqrQvCVs
+WoP73saPzgtkwpw5EEcuLRCEWbBQdekxwKq4WHtC7V4DvxpBkraslmWwMFjUoaooAjWwqy1IFWxQ
+6dThPqFqlapJpCyIWbIrSrQqfZA2F4sLI9XP6RWDXRXmV82rwLgy0rX0Mm2GBgFjOpMyb+U/18YI
+g8kOOj1y0nmF/Lplc8HGjwscqXa7HULJmRYtIAVPu/moiPTcvBns5Iut2/jnziK6MwhhNLjsiRDA
+T0deqDZKs6sDVXqNyreWR3Wulio8tHEdRT6oix3FTFSLYSiyIOB4HT9/79wq1RbWYXifVd9jorri
+5vlXwFPhsFAN0xbpRDj6trO/sjcNGMKuKaye96D01/TyvXAc2EbXfMdOxm9sOk+3Rqz/p2Qe+bP1
+km3uOYi6/+/msPL/F3Pgf30dWHEOC8hfrZZuQwiQveyuEMDyLoXbJVGlwioUqC5h6TXsnhIcD+Rz
+vfwWBlJc1cxq1qhN7FG12hRqUawYKdYGywqgyr8FpC05UMN6+NzaJZiWdK9F/gB/XOz4ipxMTFy7
+YqpcO1PWfUTd/wvdZ+X/P+g+3E+OSLfus6DsiaBlOmotlGD3rqr19rAEaTm1mZ1xRVSxpjJOHZUb
+srCOiCJ8c4MNudqCOurnjmJHcck7TjlTKF1bameF6nXyLxZl66UAayj0o1sfbgzFJ0i2sxjHQ98H
+4/+5TxbCR7iK8yz5NLfzlVsBLY9AAGSwfqHhRvWvkAjhJVM/X7iBLCYfj2g89dL3bXQRFP+2ZY/5
+nfwi5IViaFXq2FraalW03h7aCC0XGdC4rjIHGmlBz2mNb25EIFKLxlxd9MK6ptyaBVTTlo/yotJK
+OpNAH28yK8amNoX6szNPbQqVRMkSnxBPN0YXEQlxVOiFG0PBq9anjbzvYvztv1ADkrtGNJpANpK/
+LFXwEVHTFD7BkeTkL/+YmHwND672Fwed4MgPAI9gHtm3FPAe2A6BY1r0041M/heAzHMWvwKg8xtb
+dp+fwHRTE6pnl0xaFday++7iUrsoBnirjGZK7mepJ8SEdHyVXL+FYdR4KCkqsgshuZ4FYSwjp+ss
+0tIDSG0KDS/fERtVXCXoRM+jf2rA1/CchJUh8vP9G42DFkM1UkSuB7K/2uEb6EZ+dBaQn9ct/v7H
+lOaqa3C2SL7baVP2WMgRRiIvZEMpCFVcyQiNaApntm58CrgPm4hIi3uFE7TyCkOoherQOvey4hVN
+yCPnLxjjavDhGLtnz/xxPPxATv4S5VruVwBQXVnwLTAOyCIyz73OKbDaFxy/rYk34k59u3/aP2+r
+88wrgN/eWn78XePhrWnIFppR9lAM4tcgEfmgJIRQmF60xiaEy/8B1dOUs1wS/QMiwm0aMQpoS6wh
+XjHl5dRLatieDQ3SAG6x/8i/xLjpNMD9xxjGz/eWwAhIVxU/oMqpbcxKZ0GHjPjIcJBPfqo3hQPU
+Bs8r0at/BCheMH4++X0DwCHY7yE+vgj9+gMmpxw7uK6zARIsRucP5ADiaO02hVZCUXVqPZi0vqoQ
+MRBHaD11jWsoCjW7cbCLvDFXs7AGpRS7Jwqw5KrMud4FNahjn/kGFXpJYPwbBDpTWCWKEf3ZRplC
+GWH84XyG9fDYSb4n3934I6WBHWwdO5PPSQE0g8bQlE7oEsSBjVx2Lv131s4frjetYd4LITaI//jj
+fOSWQ5P4a8gLxaFUu08Vi9bXo65Zy2lrN7a5BRG1lP29c2ssjBdRtDo3ompuoClXVxCvfl5aUkrF
+0dk3xRGEauIiwm1xJmbk+BuwbP1QyVpZMMFgl/0TDivJVOcN+ORSn6FDB9uXt/5r2+iW676GybiG
+81NyPnf47R8z18IC1xlF/gV0om7jB45uw4CPIcsntevdN7EDOU3Gjs+c9stPvZoY2fwKyW7hKf8J
+4pCFUpUulrMAskT6gWgJ99earLFM0sQlhNFzMw1YUh/gGmRn/5mDO0Js/aT5pz/KJ4MBw+CviwGu
+FPObf2rT+ccZa/8mn/E+2a9D5+IoqFY/aeiK0QCwBJZ88wPAZZ2JkAfpW/twwtYd5AIqK0PDy+7z
+R/hstUW0C37O8WqJ/v/qIsXxdW/BWLZdoi99g2nEkY+XnwfS+uk8AoVoputqtEnMaRggVcEaHy2O
+dhSpi0qKYjhsCmU8IIlSgDXcEmdJCBDipDC9aAjohp3nyeEzAPef8WA2NYAe0GX+dscv6YmQlixW
+JWXb37sO19qF89C5TpP5i1unQWqKS/+eihB3kdVpC0RRdg9Oxeu9NH6Ng5j9wo5XL38fZPCQclEB
+H0Qtc6oiFsUA80mqzWFSLLNx1f6Mls1hWAtHCspGpPx0//bW+g1T7fxUMtQZjC9BUJcvz5+HPaRD
+QkICe7vlWENhi2PUkWJXhNSi2ADRf/DazpeWDZWGIA0KOYK0Ivby1XpElxaXsEg+tYS9Oa/o5KJY
+qhHpTHrGPRo1zU4QZwp9TSCCVMWRmCdpAJgcI6vEiM9nQBqkktPkyxmff+WGgZA0BOlQuN2DAvHV
+aL09oosUMIf9JW/sx/lSOJUB6SSa3zo0Ib4irNRi5w7AAORoRXATW8xQ4OEKc9KjmnZPpBV1WOvN
+JlZCBQYLt1FL+nx/1/SoG/8dE6TJyt8xSbj5+I15tikpQbjCPANRHbsX0oqBOMA917MlZ4vlwBFJ
+n28on/EbsCvMuTL4ivOuhIE89U4l1EfnIE4rOVy2H/FIjxAfprPGc+xVpOLfpvqY8/GsBg16LhGq
+Nzqwc0CbHkXHc4B8O7KHRh2+/libHgvG/0DH2eb8kFwo28tsxQi7KjhI1GJfvWd0EauGQG0WifdG
+6ioqQ3RREV3VGMHlzwizuXVjUe8yVJzVAgz6MX+lthg/fvis9XP2BwToDcT+0dhUzx6Tx6dNbZye
+AU3IsTNs/zIVvmWvvbkAyRJvi49grkB2uMabQlGcFGbQWbvB9vldgGxpYKrjqb9+H2DqJKiCkxy3
+k9MgUfTMXDy/SZ3OwDfqug/geqfMLLJnBqSlMBhG53nkU/YZklAVZLYLogYH+kQXsUsTu2dArgr5
+8V65/sHqEmp/JTsUz4FeJ4Rhc4DMhaZYrTU2wSC+LomJicIXf9pV0PNsAbT65ZesXlvnfkHaxETX
+qTsErKFjvtgGDcnlc2OeUn8Y0fHPBZoJNRYhIVbOwMAUZrb7dFQ6uoWeRUEQh+g68rEJVNCHm8N0
+CJ65LNnPCDidMPz69Q9rQ3to6sz4dzx49J6WPe5kc/JXp5b4cJpL3cQHnFnEDpqZUOvCd2PfWwGN
+8ZjpYL32nfNsJ/L63z1ktSyfppTdxatYvUQTqmn3CEIhXmqV1IQqXMrKePnmehSEGXUBPFXKqAXr
+cMREsJchzDRltxF6VktPio1PkOcmBuAPoWHDj5/9WjS0SbsPmnm1bb//UHgwUP0fTBtHWapDEzzi
+XosJtds6TdZeT1xoU7lFceIv8FPVkWIrhNSS2ArHUFzLrpS94OpKtZj/vJK3ACIl0Z9GX1mpbsvw
+ssQofnGQcBuXT2rz2NDwSwsHGIADuIJfL1kw+uKqKTD8fdC6TkIhcEmVquTfg7MPkaoPvwVIxLF7
+jh1cOnnrWlJrAl2vnmV3uUeSGVVBYSjeruK0aknrTd1RpSWldskUUNVfhbypNivXOxFyfVeZg3Uh
+gdQnxRyCjhiIC7fFRUTqdQaJ6eUaHa/XhDP6SnCpuKdhCLn2V/6kEvgU7pHFEHTnyRN6/fSwoCTj
+GEBBXP600ixoR/Ztvn7A+dLzfsmNNNmqpzgeL/udWyoFIC8U9sY6MT9JCJgMol4TruxgghnvcS1Q
+fzxu6xyehzr1b0EVgKuv1z4B0e0wvfDBurOZ/4zKO7FrFZnn+edlSMSpq8crefVIBL+KH4+iWe3u
+Ck7aqhBO9yRSY1O8tNTpHwRqSWSqS0KAwGsq3WzcCrM2jJ05JaFFrTCKwxX8+vD70c3b9EyJmXU4
+vStZQT7p1PaEHqzQzLlDwZvLGNezUaOe43p5/vktcGB7ATi5Z+eGhDh2kpcDP5g4aoJnocoDBuFm
+rrkghMCp5f90nhO7IA752gWM6HW2oy6KplU6NGan9uBBsQv5GOHXM/hbZZukMBr3jix2VbDKD+tF
+jXc0FYuyXKSiwz/YJ9A7uiRZlowQYImXwgxihA7XAFu8TaSOqqqgk0LjbY6gKAw1owC4Qz+SOaTz
+kiOwbmlT+OwvcThEReEatabfJzferzNKWvcBwOwjPasvYvUFXqfy51Ed8S8UT3eV+gAC5JeXVPdL
+iGdP0U0xZls883eKcpIlvc4aQzMrWZkkddR5ChGxM2pGNm/WrGTdnPS2wmo8qDq589XlVoHpDXsN
+iUg1fDQgtU7WYIxTrfwF0IFhFkCDtB9npPA+6sGzeoa//17fxHr1AWo3WfIJzuwO7w2MpczoaMPf
+IgulasgLmeySJKh2IExPWUdyCTs2sLDDw0slN8SAzWqz0uttvdnmaHPtw2vXPhQK6Pdr1yj/vzYK
+BuQjURJpgZDUDtGzaQ3/gqSKY5g/3mDnvXkVipbXnvfFniL9OUZ2lJnDEhRVybGm+HLx5TmtOrVr
+J5w8ePDChWbNmrnfscg1UMrzMIWyO25LqBwha5KTXsTjBYt7t6rXBvpm1F23DoI+6DdyIXlJ/sge
+PfIj7tScwQdOZOWs7rpnkyP/7Ee/OT88sGU5CA/yziKM+pUN4rfzaxVdWRcbxyXEa5RHRJJI07bo
+da6kEAIFqhakGDic7fns+1HNjn80qB9AtG3UtXtzmx9cNB68QJOzlXzzAgdkZzt/zx0Pl2aOata4
+4bABPQdhfKcYQ+fikjXzxsL4lRec327PZfAn8z2FzxCHwhFCsXFhXGyCaGYikb1WorynToNwm0EN
+cjZELio7e8zlCD/rjVt/9BpYQr7O2frtFQgpIMuvTxvVDJpkk3aH/blz18i9pwNHjl95ATpt3HLr
+NyDnyf0dfcjrmaOaIQQooOyRMF/cjKLp21Yqh8NlHcQqn2eK4RLALgwq+K6ok43T3DlMDj/5xNqr
+VTQ90t7rXndOA2h/ggwkJdUVh1bBbGgEQ092WHnuQb2h9CQbnNiy7YVR58g4Eo5xPHNw4aXOH8gf
+bA268t+wNagurwGEC7xr3jEGNcTIM2cPAiOtTPU3xHCm7GzYTe6QP8nJMfPdS9Ap6cmwftcxLi71
+9poxaSOsynamtQKYsHjbGwsxvFty4FeX7127KJxviRBCXAU86iCrjImgTYi0UZDxNoOcZuMdmOgZ
+ulqdNTaOIuVMx/eXwr3XfmsLDkzvG774TZRcKP+T0hBWZV+CwZ9U3/lw9+6NcOLRZu+0gRnkWUXU
+1jHkuQvjxjH5TX3xz8WZKE6ujS9aXE73OOtbTne1JbyyikJ3tJIQF6T9W2Snu7OCz/062fVK1lfG
+n2hOnnVsBT1vOlJdNmutLavB5XK/+abHfSg07rPm2neOM1R56Zr4/QJyEqqUC3MOzSI6Fj8iyNnV
+IsLYqrHv/2/jPbKzOSk7u3KsB71d2/kh4tEK0kEYxY9DXkiDWqPOlc9ZRCMk9IJyu5cQUfHSkh28
+kjkyCCqdhwkGSR/vyi1iEf1BZI8dYZ5L9bs/YgQ5OGHUXAxRtfOgSlhsozcOyTkxyiH5YYtrEdq8
+Ota03bZJE8gci1/gzpz2sNa1Ws71SVbr0cHwXnS0deJt/Jl8dJKbytkJx1yHp/PCmE67P1WBanmT
+2rHA9W0K9cnlEH3YrL615DN/SNkL/iX/ETKhCIQg3D/GX2cIsMVQ1V/gbTHsYjBGuUyO0ai1lAO/
+guEAMxYVkFPkq8NbIPCKc1d2Nr8UyFHnjgEcN5Rb5Bj7GoDgjH2bSc+iRzfxNEhySNmKX0tapvi1
+mr/p1xI0AVb5Si8izCJG2Oj9pOzG0ka8y+VljjG7fV6V/Vx4qVenfgXkt7417eSHn2WnFpBm/9v5
+5fZ6DXeOjOfzuRaOw+QE1IrmCorgdrmHC/6fXGII8WyeF5V5tmJ1Ot+aafm1BDWOrLGG//tkuYoe
++zcmXmXgpAJS/H544obrgnA2PKpD31r+Cf/Pk3+uOPYrLMKV5evZGpzsNwBz575Uwd1lGl242vp/
+WoTXv5ffAiBAk8pui3PFDSgIIVAjE30/ytLum3iNGkXEIw111ohzSX1yhHxG2kIJGSe0cI537oU4
+0LyCjh0/nsCpyQpSH/6BDDgN/PYdD0kYeUZ6AcA08H7/i5ZfIQRoRFmZsFHcgPQICVRaIJs6wcTL
+WdXYEwfuy+Mr7pDbJ8GP/Fk0ev2xg1Ab8JctoNVNkF6+/OfEjQyu7iu8kY1FvhNixQPIgBANWY6X
+LT6q8UfGywVNNPwMsghGjZw9o/+SpUCOnLgJY8l3UEfgFs2dNHZQ/6n7F++59WTf64WkLq1vW3ZL
+TBM3IC9kZKtgDkMaNbLGogQ1iuCRRh0hRwTreWEW2fj1d2QrNH/wCJJPkHakiFwhdrzSeVkQnd/h
+ZfgX8IIUcpq8JE6yFbKcpIA0xwAHoTlOWZu2Y0fyFqWOHkL8UPEA8md16d0z0MRRuEHAHf5m1vLP
+5k/+BtTP7kEo3w34I58uvfL+oi+g6j6II8XkyuutdJx2CITD4gG2fywsPK58NVjG7apg0giYbD/0
+UeZ7CzLmfOQ8dOwx9sYq+NvBC+Z5g4Z81Pq9aUvydz7/ddds5zAme5LLbguLxQ3Ik41q0lQDaypo
+4iIB06WRK+OYQhGXSgisPzD71G3YgMnDB0/I7+QABgDwhCack0S93gXF4P3qE9KV3CR7AIONe5jv
+JOQXhNGkspuSTjyAVHQXIzRmSnjIRKdg4pE/LQRCd7FbNtlIjkET6I3nk13kBzKA1AG4AJuhJhZy
+hOVkCjlF7pPWMBRrXt/HcBs+hNlwx/mbsy3LPQZcNdGJJArBxMru4vec3x3A0SAchYab/90uy9pJ
+CPHT2f4H01WkKyjResrx/jSIBNErtnhrLNJruMKDa/OO7dswruTM0r/Jy4O/DPsbtFiEQ9NvJpHe
+6+4BaQQjO2NoBByXTC61IUcesyc5bE1viU3EfBeNMRBq+rQJRLqiLKY/3hqK1Ej44N6sRasPfzjx
+W/LlI/IH2XoAn9q8CATQkTM4ETw+TyHZ5NUhjDfTgI66EMN94XyEDzlPJZMvnGvJ78qcym6LK9ke
+hsjnJ40Vj7dp6LKqcYRM4UqsvwZ/OusZ/jz30BpsIOufk8fnyTmwEQhxtuKG4EDO8SX5+dbNEvjC
+EcQ9JA1IKSmgUqYmWCAFMnY6yetJcp4TBHyuLEsilFWkNdJoqehI0aB3reGJNZ8tPHj/T/iC6OeM
+w9t2OoAHPPHOxj6OgYJH0cWdkLFldzvyPXEupXHCZIc4RjxAbZ+KvBmK/K1qUygnyXTDvksB1njh
+INn9+gn5FDIfAActPA+Sk6Q4KId8TtLgEiRCEnxPfj0JeQu5vuAPG8gQcp8Uki5w0XmI7ICmvjgY
+BsBQeEgCyQryB4nr9JST+TW27I5wUuZXCKBY2Nja6QKsqWDTWIUTQB7cu02ukak46dK8RScPQNkt
+cgZSfue9NrzusPtHuLqH3yPzK58r5rM6ubIcjEugCZJFrhrIopAr3rj7GXm65Rpc+xVg/7KlJw8A
+LuwCtR9uuJa/bAJ5cHRvFy7u374yXiPK7ohXxHx5zU0atkA2dYLBveH0+ZFoIs3Jg5K75MbxsWPw
++ew9yw/iIbO5ySSRfE9KIPoKGBePHv/Vmgs3r/DDnK9f/yqPPavsDj9PzGdzxsgciuhoTBjY6KnA
+z8Pk8hfkOXlxAE7n53y2MvMFrkn+Jmdg6CN+yOvlXz2GGz9/9+GSLxHNA15Wxn/A+F2PEMTTmnam
+VEjQMUbXy/KkEP+8ltyC0HXXT5DviIPcwqcdC+AKILDyC05ecgIc/uIncpZEfSIeJF/KdN6fLHgX
+fcQif7XJRkNqRVM5iXABwkGy759XZB/0efg3dD5Jhj/AePo14iAHSGM4Dc0hBc5dvMj1AX/IJ73I
+n+QKaQ0Fzu1kx/rjExI/w2GQBZnwlGjJWnKBXMtmNkh/BOI8cRdSIQ3zCpk11lhtQiSOMItYr0P1
+gZ6stLCimWcljkIRn9C378ESwMUkfsCBx6hs4wCuD/mMOPvuHkAcZAv0BoBxwEMb0eCIg9DC78EP
+MPnz8jfkLjwil8hB0goOArTBgyGdnEUIUHcEwigxH/m5zgH340ENf5B8cMD53QkYcwDH4YHQ3tGC
+OwTXyWXcwPklEVx7fZvtjwYhgR4iklzhMJIupYH/4OAXC+Zf2g9Q/fZ9MEAIv2tXCfy863Unfvtv
+0IAUXJNpEQE/XsxHGoW2WbFzUTnhuS+/X+L4+yBekV9w4CDg061B+zNOcX712frOnO3fPkjWRfgZ
+Yj6TWDosRIazQktU708B13AKl/AznpPfvyNXTxzO/fDS4R0vyEHYtnXz7gPwTQH5GuOnt8JNSzZv
+2fa6IyQdxYnOCys/z+BiK8oohmO8IqOYfOeDgCuG1d/m7jt4YM/a6xAN+NNlJ2IcNnG98+uYMnLN
+uVDRl6rK561gE83hSIORiWfRDJTMIgLoXIVBLzaR8SQe4CZMBOHFwJ9PEQcZA9mgy3cehj4zAUsw
+AnLhKt6yr4AsJfvJdyQDw3p46fjNJb/viFPFfOQp+1GwYv/I8hspx6WV7S8+f77w/Mcf5GNP8in5
++1vymtyBpqD69xAWOQd5vv6nF+d/hBKHgfuNhJOfyK/QDkLBD+pj3ul4PdHNmxuQip33cW7eFM3s
+5amNkVNsAFc4qhdJgvPtZpz4/ihJwsEHyKqDeOrR7wVtznCiwzOzd74sI1Mfkupi/h2m45Td4VeJ
+B1Ao3U8mP6yilllxEeHsaa9Wb2bKm0GH+FXk0T3ynHz3BXy9a/Ma0otkkeI7j4/BzsnZ8zHXCL67
+Qu7BAMch8nzHmgs7d0JIPviT29+dW7Biwjdko0LD8jz8FUlgYZLFSlV6mhae/4D88nTLx7jIkX1w
+0ck53x7kvydF5JqBVNsl5jsWBeSDCHfZWY1AWCJuQBj5IAQmDzBzNAmC2WYCfopzPfjDnh8fkfdK
+cBUytUzo5HwivHD+4/gGXjjbcpl4xQ7HWiX3adkdsbmYzyRegCw/Zc50iWaxKSZXL5My8hfZdxAf
+wQe255w5gL3/Iheh+2XuOZmHDa97L/jzu0/xehftfiDzF7ASafLrUqrjWfWcX8Yc58GDgE78eBIn
+bPh4gUMQNzgdJ7b1zVfOCYHqW+VSMpIeEjJVaZGsK+rNlCECkKAjq57/RXbCkXk5D7ZfOY8dr85M
+X3rmNCydjjGHsI08JOegHpgfnJk9b8O9Hx8WgRFavcjZsOHDD6cXkK9k3YtPd8kCcyROAXbKBdRn
+L2MWP/7hu5Mwwaf7hj8PAB4HIBzUbRg+8F9uPpUELlnSQVk7Nc/e54VTRawa1Ad24vIdyOlWn/cg
+xw/Cpj6ze+cd4nPJ9dvgnI2nw30w4yDn/fM7YPMlHMxkdLuy2wKlRU+kR1aEaA0aSZ68/JjcoKdZ
+rHwhih1Hel+QWMma+izphI1ewATgtacbv49nNms3EadMyujXBw4MnvQF/HLqAHQnW/EPy/f9dPXQ
+aTILZuCCT07ewUm9ZkzPHJzF+/Tvltv90OT+G66QhyufrL2E8fn9y25DUN6hk6ePI4VPuF38Cbav
+9M4iwSqprbGaSGSzBiBuF+lBekXuC3v2BMiUzwFmwE6SCN9/+y05BsnF8F7xZrJCOQvL7nKnxANI
+i6ooI6kjaLZhVirED3hObRGpa5s7RbqRlrDAP3rZsiM5s2L8IfXgCwLwFzY5T+NkrGrQcNuQoR82
++OD9g82nklg4oteRZoT5ilF3Uo1vxQ9BgSgcoQidrG5ExCfEmcNCQLJQnUwn8VxcpEVUZBbfipT9
+9Sv5Hb7/FA6sxWK+HzSGjngHTD+4dNuOnUeWzvmi5o/kEsT/AFsXTZy1bG7d6l1BRe7NnLWD5Oa9
+qGokZfP2FF9lZ3110Z/vg0KQBSHAJhY0aA7TqAXeYI6MYJFX4XSChnhTKKc20wvgWNEfSG/Sxth1
+/AsYgKOE815k8IpxU95bS9bD+wdGDkRlsOngE/I3eD3B7Zx3cfUP0zeT3cTry8Li9f24jcNGRzvh
+LpTu+sz5L0whuUTRu/qTqtwqFleIhPI9CwK1OUxvMNN10HCryDiSYdoV9PzO7QsJu+rCCFiQRnrC
+1tt3ya8QwPkDfn6nBOaSkcp4u/gsFFZhPFOAxhZnMdus7NbBNaxOCtBzu8hI0jxiTyjwN3Zuzbnw
+6ELAPgNMhg1Xfkoh3SH//n1SiqHK3GtVVvW8/u0XWz6GTmTj9+8PJowXqpfd5daJB6m3n521jFIS
+Iv3VEbxGlHhOLUXSsH8u3hxmwIZ4bh3pSbp/WQrQA5rAJGh18Nmffz0+Dp9+BrB3D/Zx/oBrwug+
+o8EXGms0ZNdn4xoRC2wBID1JH/7iqxJyX45V6192V+T5LBTAqgWxfbKGajBnRhotlTt6bAqlC0iB
+94fNfw+e8REsxvdvgT/+dcBIg5GMJVl4+bPHpc+xF+58/IizlJwiD0APdvjwWIsTzmJcHQpJPOkv
+29fcKT6L6UNqzqylFUXV/masUYdypxYf+Yh4Ly1YxPXEjchzchFCwQxLyHByi9yERPDDQWyPu5Oq
+3Da+CzIiJGgr7gmtrRXuSqfPa7gtmAwnTWJ3VgePn349OAhqHP5t8hPgUkkPWPXkAXmEIXgdWd7k
+1IFd249826cH+ctFQ9VVofxQFIMQiJxZa1ObwzjJbKFLoPynFnh/c2QEX4G0ZS+rKhTO/1j68zXs
+tdK78CvPFZ6//MypYH/DvXVJQ7JvzvBZD5xfweKrPbOOf53R+xtYjHeWkufAP4co8u/t2+BNnt++
+DRLZRFJvDSQFDztVW5U90nIfrsLdS1evf+uSJ3e4XeIBpENVXVRiCjBQ1yN9C8VIPNLCmEwjEyQc
+mzng8vUjsASm7Gy0gjwF6S4cJ5E4DZu86kKbKxeio6ETySe1a3mSoaQNdJPhzCq7zRWKO5Ba9kKx
+CMQaoDeF6TXxCZSRRYnRYyHpQAZCG9hIYiYs+AhyDr6eOu4PJw5yXoA/uanQ86MPyQi7l/NHWOdZ
+n7xHlsqytUx4wGqZItCCGWmwOUyjN9Ol1mD6u0KGwn0gf3wH0fD9wy0PrwAkfkcewAe3u/Ypgfdx
+F/I9uQYBYIcZZNv330M3Mot8Q36GcLBiz7t33TJc3MHgVJDhalZjNyEu3GbV8fQuURbn2v3qJ3+e
+35Nz2kj2fgm1D97G4LwN1y+cI5/BMhi+4tMNq0/3v7B9b7PlyC0bqMxldMi5ZI1Ab7ZsoZb4CFsE
+9ymQfqQr1h3UPXtJ+t8lSVADgPzI1bSTXrBx+XLyOwRxL9vmjiElePOK8U0n7Hf5oYRX4g5kpnhX
+WB8tJT62Pv7aCD0P+gR5mV6RO/ehLb73yzYo+RXaPSa/EwLbyQRy9vD1Hlkl8BFuSX4mP4MK0mEe
+WX/mDPQlE8lJ8hKiIBQWQXNoZt9mIO1rtHY6FRuTKxTXI3/XzrMAbE08tiVYJZ2WKyQDSYedwD+A
+C+Lh4J/grrMMq/ldrzuRaTB+B6hn7tzcixQh5Wyqyt8RRiFvSkf+6gjOyvGy6eeP5Sx5Ef5mf747
+Fgz7DVBGviKvyT/k1EEoKdj9gOw1fBZA9kI9fCET/MgfPZ2x5EtSA76HluR7sg56QDyO+ciZg2cs
+QQiQJ6nKHeY/QqEV5XVsENBbCM7MxUWBJcEgs6qGO0xGkKZB+w0g/Ipzp3ou9lzbYbiua7vFbRvm
+Qwzdn01375LfwMANOryQbIEek6p/Evv55+Q8O/OqimF8FopF9ZjMDKUjcjopoCqIvCk0PAXi4und
+t2wlMckUG09FB31srK4oTz8Brtek4zAPXt8Eco38Tu6QX/v1X/3Do/wj7YI7HuWKN/Tx9CS9SDe8
+4u8//vgbD4DX574kHV5tGrqq58HbxydWDWnYqFrVuLFrRmZsW3noY0jYk9wzjBjgPowmS8lW15ko
++38qnYmRFuXwCjBorNwqMpF0Nh02v7w5aPfm2M9qQuq2aTvpubj59m3yKwRyJ1t6XXzxDUzzbIdX
+K3KyhvCAz0QRCAnxCW6W1egks9aGKwlLPS1+KYG63QHSHhZey+pRCKu3XzyFb/3MrRdu/YQxjDjw
+4IvTB5JtUAPy4O7Dh8R2/RVwl6EB+e3sWTCQbaTG7Z1/bhsdtRuV814bFPzGnEQpVM7cqrbS/BTc
+LjKItNcWBDj/mJZNpuz99tKVpe/tTCeZkL9iGfkNgmBCmifsLvnh1vfkF69UPF/2Cd7hNooHUDWW
+i6KyfNWJkSkQb5ZsLhrS1AEL20luI6EJsTbmTtmc3bQNzFgwt3tGh82QuDN+SrNnpBR2kCRswmme
+tWD1whVdbBaLLSM7rrCggDx+fQ+LGr1AWpP3FH8d4grFA3RukMBemlFI8mkpG8ZqU6hewt0D8jcv
+Hwcrdl+59GTOhJ3QBhpohIhV474lq5uSOPIz2QcdoRo0SnLMFjpC1t+r5bOTT+QbyLafFEAfoWps
+an8r1lCq1GBGqJb4SIu1GvBVzt8HoiN+4z6fCqlgxz/u3noe4x89j3mexZ9uy+pKigjPVdsE1aE+
+KSLnVjlHwDBIyf2m66LDc/LKdd9dzN9JJYmWrWU8JTdNpMwAVED2oKzWc8OOHXMmPX7yGl46S3Ec
+joKmeNPrOrAtnfQhGfJ5dIf7le2LBSF/TKmtPugtYQY925XylTKFagxq+oM5UpoNkLS5Q0b39+fP
+gDZNszfMnA4bSRRZtPMOqJ7NnCx8AtqCgsK47AybpXpclxUrSFZT51XnJfg3nByBHbABUt309pW4
+nt36scxP+gCkV7uFvn8c4mz0Urg+6M00RNmKPbeDP/kDgzfpTXpA6N7gZ4+hDHFdTq6JjibF0Lm9
+uN65eVbLV0TzDcmET+5TXTdwK/YG3PLI1rkAUVAfYbcc9qwoiamoYZQgn759SZed82bCioNPsj4m
+pbi68xiO5hc5Z6c5L8Cu+qQVmSfrNTpSjfuQX8bOFCmC4w0ib7bFxtsio8E/IkFIkOt72+TwST/g
+PmxPfl0MHgDkwOP8B3Bq7A/kg/YwsIt/WqvGjZa27WDuCVez95HvJx06fnnF2FGrYhtD/rGFp9eE
+RdSOy61Te2HnBmvL7SjxALMOKpzBTIWOtcW/eRQrx3Ef0rX6wepP/hy9adMAPGjTxu6YzNoGOZuO
+fHYCckgfOLB8ObkOXiV41trs9z8anrely/wj+SmNP1jabDTzB93hTpXDZOcXhRkFFWFSEyzA4IJ5
+ihLizr9eTjxyZCQefeRwf+w49oTffeSz4wzgE77gdTOyDrzuTlq5eOoXw9t/+UG3jQdSG3+U22y0
+4vO8xWwFDZW0hlimGIZqtEKAVU8Lq/irDfGUgRetbEJewVbIx+SPDktvL11/c8Gz0sdPO43dD5k4
+xnmZbDwwtqj5d9+25DqSdVMmQ3/4BFWQeZmoarnM01pjNTa1ZLZZw1NA0Js1EWqBp1pfN5IZ8rkF
+nj25cWgkmEn6Hi6E/ASR1ArZfOcGuQshm6Eant39+WT/5zA+xHklLZs8d8EQD6CIchh03RTdWiOa
+6aT0oXpdQmyCRivweu5Tkkm6hx4MfVb6bF1DaN3zQ9IVdpQh59kbQO5BcAPSG7b/cofcANN3zvka
+OFQDQy5MmPPMAfvIfZLdaaKD+WrL7vAh7O5QtiL1splMHdBsFWnolMaqF0xytCDorCZqfHG7STfS
+GzaAJ3lB+sBW2DRmzBjyG3n96MUron4EwqsXOP4xWQMDYSKAP0yEIWTlkw/IH/PhwZ0/uMd/PLnh
+8ldXFevzWSgYhTM5FWbRU0c1TYlAw870LiWfMZ5Gr06IFZMw6fZBFun9zz/PgCfNYOvDXt3+gG2H
+Xt799Rm2OD//4DyZsvAfvAw+IR3JgPkcDsOJpaVOGq/+1UNyFFy2DK/ms1CIst5q+urBHCbJRqW/
+TuDZSlCbhlcTHYmHBIjf8AUW1npCX2iLySVSCFUgLutklyASD4WQdPzEor07k6MhnXz7y9GCp+Qo
+tMpZtf6NM9Of5j6JZcPq6ZU6Yw6L1UBh9d85bMmVm9ePkimzprx+UvVgVZJJuvGtncNSAcIOOX/8
+mXSMInvIbQhetIT0hG1s/dqRqnw6P1j2P/jHaa2hWj1Nz6CzxkZDAh3XPy4h3F9H9T05Frjd305Q
+/UP42Yr3gXxOduM8svrgsu2nP1y1f9FB3OwupJCTv5AmAxT3A/kHjDNm74T+2eQFeMw8VnJDkS/9
+uF18H6YLKEmrZbMsIlQjmRNkFSTAgH+rXz9zdsPZDT8g38BeWO11psodAumLpq0DrnXLJW2Hx3wJ
+c+ALTDpdOg/h5DjZsbLWOoQ4NKKslLNwt1z1hfyterOgfI34+mt4dv488S0s5G6dKDz2z+eUlrie
+uIZ4gNnxCGI5tURjTutTy6kqGNjDbGsstLgJ926de01KG/w+dQSMmyDsJyXkW4BY6Hc6q9WOy0dO
+nEEYqREnDBauyXnb7N7pKE/MgTyhPOFfxbxtvChgDcdzoBGQRqR5mirkbfPAEWAGMMNqWAtq5/n7
+znNCnddr+f47WaK/sjKkRkgYrGqptuC16BQ01oriUeRuF65qRa5m+e9SW7XFUJM/BePkfgijiwgJ
++5Qcc/Yj2EhzE/MSS7tWFx1KR8Dv5aJLk5JcaQkFXgQNJ3KYossLPC+p/1D/Qb+p/ugGGg/QgB7G
+cpteH+Af8qcdbeAnZ4Bw1Zl7r6xMhqXgUIPh8DlC7vY2aoshjK8B4yu3C1fVFvFnGICQ3I4wmoiQ
+0FvBOe0wNtJ8bJIrw3M6wmJFlEEjiHylFa6Esgf2BxvwBx29uE07XwdRpEl1Ekme4kn38GS5thCD
+J+Mt1GV4f6Hgx4nrhGtqi3gEbOzlys/KWnNiPGv/mf+EtR9xzUdcJ1xVR9J+akk8CjalPxLjhasV
++h8t70/hisfeGB+J8VJbdaR4jI3DPiX3lxqzcT6HvAr9y9t/Fvax9s/L29n4x9/uz8Y/Lo8v7HO1
+u/ZPvIhitKJ43NUu/sXG/6LSOBMVuJHiF2+OI/7F4J56uz+De6pS/wrrz6UxuKcQQoAuCh6Qx3Lz
+Mf6+WFQkXC1CGM3Da7mHwk1GHwg4kwBWwIkv4LGzGwxaAoOELOfOEVxDxwkEqDf+Cd8XjrAxwAC9
+cdAI50MuAWf3cmajyn8HCRLw/RHOhyO4hF44uxfDYR4Xg3cL/ZlvnVZptGlirDEaJcDOFmONwbs3
+PNgwhdVs5BuOzc8fCyZys0HXDHvUC/nzJrxb6Mw+r4kxxylvLmI0MeYYTVx8bADePYUWfaQFH/l0
+cvNFlD2jawNyE0xj8/MV+IeEzkiNEI0lkR9t0B8CdCJsIg+gij0jw07/H7thAxczNcOeljF1w4N8
+hLCCe+f/UW/SWrneJN/wjXqTGDXD67hUQc4bhjzAABFgAFgN1XLJXWKBqrnkLv6GU9d1PK3LviN5
+f/AW4Sb7TMgRD64XknrRhGWlJaU00TvHvwRhqYq+FY5VsthbNVi/xHlvCV5Lt41+sf3HMyCPa1lh
+/7mWX9M14UW8m89hux9jirHFmPBuZwkO58Wpyp7VxbuFLvRcAL3ZZlbCvOHalKtXp1zlxatXp169
+OhVV6JtLZ0eviOjFC+2HwwVvEgE/T50q87uqHuP3YqFzBX6nfHqe8UUxd6cyX0thcjufUoEfJyKk
+Gi23CwtZ+xeVZTlKQu+W+fffkPl1/0Pm32dzcslPEdU7BEaeo3n3kqnwPJDO7RWj3UcTx1OBKQqg
+oQKUqyzrE2iqYj1M5FtTUf866DCOwzGfO3PvOT94g2cVWf+wAi9XlPUV2mUZhQ4zWf/wLVwFmuua
+Cnqa0ftAOk1xreAqangOIw0AFjWcoOF5rhKutPou6EHoTQX86wOHDzPh/rnz8n/J90eV5LVFvPu2
+/BWuaiNRkiyn+E/e6H//Xf3VkeL9t/szOfjgP+T7g7f6y3L24X/I2Yf/IWd//Q85++vb/dn4j/5j
+/Ef/MX7pf4xf+g45zvZeqMv2/pHMY/hjLl+4iUSUfBAERLe4KDaJnuXwkl8qqZ/fU3Z5Ot3l6XSX
+p3PC9Dd3GeRd5vIdv3EGZxbnOxb6ZkPfseQ7BmMtBDGZozuAe9EkoTS5IvcSlvL0HbO/xqqZp4gX
+huv+srtCDcZzifLvCAk1FJ57SnlOkuUDaxeeIQ19leZpVCVqjKiBVu1ouU/dKYOy+6nEbqUs76xP
+Hr/UA0UnP6fJuJknlUV3y2m494MJgqEKmC7tOX1mD7cZwqEm+YGUOHpyC55eufLUMd6Fg8JTD2Gc
+GwcXj2iQ8TAf5BOLPLTqkuTn9PmqlOeRA3lqpnAmyNE7tJaH8sNEOAk2CCG3ySWSDhtgP6wgg0k7
+0mcrBEIdqAWGrWQlySYzyIo3efo8g4/f4unzML5ye0Wepu0I0GSEhCyGb9UjyCPWN0hQa2llluS6
+6LBXnipHkydn047QuxBVfjAJWaQPaUcGwwrYDxtIOrlEbkMI2LZuhaEwC7JhyFbyGykm35Nfy8pk
+OApfhzF8RZluy+6KuyroYQRmu/Zc3KLoSWpGz8RN57uEZ2qLhGB2BTrfj5C4RXimjpSQQufq8v4U
+rgRv96d8IcFb/RnfSfhNPhJPKPhUp+0SLu/Pxufe7s/G55Txq7vPm6psfBFOVOg/+a3xxfL+bHzp
+7f5sfKnS+E/fHN8wQ+HrtZxKuEnfbx/yQL4zBK0iu+0emhvw0uuGaqlW/ZzuNOjNNEtGuMmqV5QQ
+TjWCPCVlgEAzIufr1Se3c54jLv34Y+GIZflHpyp7lSfcVFtEpzCtwl7RNc4TnpW3S24ZL05X+vev
+sJZ0TnuV9hWuNUAYNUGITxWeIS8UhCLsHt4eRgMXpFFXYQ8o2RTEPG9flKdbGhj9vLQk6XHlrDyi
+RHM4yHnpzDh9yu4Lmdn7i4qy9z8iT8B8vRTUhUKHzSu3L9+xbPNKp7U5PC19dftp6evflfXnv6x4
+vkvVXLYa/2U5Th5Beh/O6MdTnGLlh+NinncgytMu9X0HTlR9kTHS8eZI/NXAov3ZmRd2k06FoC69
+Dmby5NF+ocPKzct2LN++crPT6pzKB5Q+vf2q9Cm4cKqoSyg4NSm7y6ey3NVBtNKRN2f08wjSV1gn
+LwWnhb5Lq6hLnz8uTiqNiaj0ttiq0fFmebkCcLqCFuwqJE9Lr5MboHm0f7YLK/w1fv/17wpWbP8Y
+fIXHrzC8Qt3tDuWsucJkUqi8hmV3+S/L8eWCNEEeRkOFNfRS9nVh4Lvx5eIT5CUMC7dprPirClsL
+GnLjeil5WjjbtbP4a9fO8gHIJXtc68jZGL5mN08HMhljhJ8q8FwThIQDtF38ma/G6NPo7p/G+leD
+GZVtN1Gj9Jfpv1p5f6mtS+ZJITDD3a5hPB2i6BrT3DIykPF0aGV8yu4KB6jslEKV/tXK+zOZYXq7
+Pxvf9Fb/NDa+uTL+ZXdFDRvf/BY+aWz88Lf7s/HDK/V/qowfSWWRWpKhMNtjAech3GT7H36Em6Hx
+COoVWMVVQcvuKd7wRTd0L+nmP3/uKHYljnLtPQ3s0tHkqzS12cWMdr1b9OzUp0/7zOW7jszL+3QJ
+/rdLk07NOjdt3xKm15g7Z+GMuXNwCwY3ES/ABQxuFYQqjgnsHRIbksaNwaS2fTr1bNG7HaiWfJo3
+78iu5Zn435btm3Zu1qlJF5gOQ5yH58ydsXDOXIU2lsqyjp9UmTaEM0r7zgo0QPdiqXCzQv9Q1xoK
+Z4SbFfqbEULgloF+KPiwp1FCRlAr6b4OIarN0GowRTFgdsk5WZkBdaEi4YoGZu/nDbJc4w2bV+54
+p2xLlm15Rbb5IeMRZPQTjR68msmQuuiQH5fn7QKm6EsuUca93J89sEiRYLzhox0rN7/+TZZbCKF3
+yi0Znktu+aFqh0WjBzL6ueZ2BHF53gulpWr1veePafaLACX2W+Z5UDOIinA6SSHyBlkmvVsepf6H
+PJLvqFzyyI/mODGCp1Fyz/sIXeSFKjciVpfQkd0L3MtCRdzQdYaTspR5/ZuyzpXljFqUGrwhZ+q9
+U85ESvUq86lbziS/U85ESslK/2mV5IxFSnm7P+PTlP+QM6n/IWdS/0POpP2HnEn7DznT4D/kTIP/
+kDPp/yFn0v+nnGkALt1HljOUvlAv8OwlqRUP5RHkc4N/Sbf1OatmYXXJE3lb8eQlTJT0aduzE5cn
+SxDn4XatW6FK42oQUiizXH7EQFCnnm37MLnB5bVv1bodbiGLC7edwAey+veGQ9iI6nGimiWoOsjl
+8bTSilwTz2biAwudNbhPhKid5Tw0VOHZHYxnu7h5digbL9DuzedBDuKNuB7iRZrQvC6KZpYODRu+
+yH3irCFc3fnqavl4Ck/ugHHu8SrixxuhIn609sc9V9kyPtCRiX+khT92ClGybscHKTx3gI2X4dL5
++BKF5w7AeHe7grdWRNFv4qOcyT1c7YKt3E6QOkFVt12zV/HXVmHyspO7/x8V+neB2e52xa7gmV0h
+dXG3/8ForWslO4H1Z7TWVaE1dSV8LFIGVK3Q34VPpJSh9K/i7h/Pxu/2dn82fre3+v/Bxu/xNj5s
+/B5v4SPj3/M/8O9Zqf9T1/oovMKgIHD7AwUUdBBQL7rtNF3uYXQDv+SXiurn1EJg4ecQBAud95Zw
+niO4hi5+WMvl/x9tDJaJL5xFw8k2Rj555TIyQCQP3zQzKuPG6hCYbMwRyXniq44TI+hdRNldcT43
+vVyfNOg9gvyCqqhLikuLk2SVIk+7sIoB5XlXUReXxqqfV1bLLW5tMi7BZOMXrV1wITPzwqrFr4Aj
+T0ofkSetnGvwla0jti9fvn320p23b5Q+fVq6c+hOWeaJ3URPxpdDynitKI126eRiNwWnGnZPjyA/
+DRfkY6BK+dlypHQLq/j9J1ImvSnAhVY4brh49YWs3hcWfMwHOFe3Jk8elZInwOErS2dTrEZs3blz
+6E6K1o3bbJ+9yu6K1QQD4+8hZckUr6+UextxPreG4RVm95DxMviUr9VhMc8f+eV5s6JllbRug8lm
+irS5zBd+0eJVdJUWrH39Ox7YCjSPSkFDnDv/Ax8GV8ZH3MnwGVuhXU/lQ3m7bPuJ3RQ8zXZPeU8D
+/Sqs32ExT4sC34mo2+7TSSY9bvjxggu9sy6sXvyKOEFT+gg0rfGgnW/vpusMFrsp69aJ4TPBdfao
+mnHT1RZpJJysyMdld6XLtF38WaxP26WRLv5TWbk1aos04c0zns6LyaHJCJWPT/tTuNLEt/sLBm2k
+NFHh48kV2tm6lePJ7hNu8j2Fe8gLBVbWcQ1MuQ0NYGsCF4emZjZv3K955rpNu6D6rhiYzbdukd6x
+ZZOMhs1HzJg2Jzd3Tvv6I5Szro5wE2kRggonpHLiQcn2T5f06dunz5JP8QLXKek68t7AJ+gNfGJk
+cmIoiZwxs3m/xs0zU4c6h8PsGIrTJr5184YZTVp2TG8xYkT99hSlaTNcZxSjYT9U5RDigrxVakq9
+tM6KmOeB8vyiS8sJgl7DMObmF/X9atXi17/zATJXb/loi7L55eup8PFmxsdzXPo3o0OqfyOfIBWv
+VlMKpMA88ySUBzIwvUxyJkWs4YaMO1/xAa9/X7z63JYtlA92Lp295aM3aWxzWbIbVqV5+QTxHu55
+oTzwzFPqx9jk+bgumvhFjO9e0ZktXvUV/rsyrLf5bu5/8N3ct+fLBam8y+eL8vzEPA8ZCVlO0emy
+eeOGfc+tXkxnK/MY/tu9uu+c7zw3zTdjPDKrMk8hJF3m1qgjpVkyzYv13f1lnprzJo9I51j/OUr/
+um/w1Ptv9xcM6kjp/bf6y/jM/Q985r6FTzM2fu7b/dn4uf+B/7z/wH/ef+A//z/wn1/eH4Gb1zTu
+DCMxdIeYURsDJR2GtOuGP3KOWL9p1zZhQqPuzRqOmDF7em5l/7U3QvSmpjZ14/uAXqD3NdxQxwr+
+oUPHlTp0hw9D7L3iq/cg9vPfSgt+c9uonuyzxiOSkU9DRi8fdYnscefyJC9a4pMSDXvhZ9aEI43N
+CmroBA37FZJXxLtQ2E5WkxNOjDM4GAnrRij6S6ys7+JQpu+uc+u7ixisanYPMOI00ajifdQOGZpn
+ngg5KhmazapXI1pI0BqKuDOFxJu8KuwHDaGTsL32CNKPLOWc2zGBhuX69SLlXj0UxrnhVZybaPRI
+Q0bOPTfPPFFSimFH0BB8FKGx0pf/1Pp+DmJhP3KC7KpdewSsg5EcznBicsK1f7ynoitvYrA2uNuL
+FF15E9OVN7w9Z2T0TBONQvmcuTxJyPGSsWDvcq0aHmn0Zu4M2UVO9CsEEZ4X1oZB0BAT53aOLCX9
+Rrw1Z87K8NjoahfuMR3047fs06WyDs05aLv0sau/qGb9172lgx6U+/MhrP+68v5MZ13/dn+ms65X
+zrqQN/DZ8E58IqUNcn/OUd6fjZ//dn82fv6b/RX8N74T/0hpo9yfD3kD/03/gf+mSv2fKuO7dO6N
+FXTuX4WbdF8Pe/fyknrxPi77VLrBe78Ulvqonz+npX7Z40j6WLAqmHFGwbfNltwtXYLPnV8Lh8lR
+/f1dXVx2KS4VHqMohEBfB+S8GQEhIFfrotWKbPJLzThLZDSwhGLUmYYLGk5MSB9srp3YdGbD1B2b
+Uxa0aDovvcFwS53opjPT6m9cnDJDOJMbUq1ZDbu/0c830J48cPKM4CpzLJZWiSnm6h4+hvrxmSOz
+AyrNyRchiNGbbXHxCVRVoD6poCWld5c0+7YAn6vZZdd9PTQlLda6/EDCMOEZi9uWtQsrK64UpHia
+zGqKpoZL7dIzq0PhsdM7v3f/ACevDO5+mzw8fQQicAb9GYJOHyE/Vxo3hOKikQeJMbuCOxgITYxV
+tvjHfb/z9LHCDlk9u7h/gJM4AyKOnCYPb3cffMW5nfx85DQE3e4+2OXrGKbw8F/Mt3PEBVMs4Y6w
+PVAWO9IcYwvV6kVsCsOWGIuyMQHWGH0YtsVpTbFaQwz3yjRnzJRpLT8F7gJsItd3/RgXGzpn7MyJ
+nT/59/vNYNn9Qxzsy7i0bVrW/S3kzO48cgGCZoxSGqBF/hqwkoczFHukRD5rhQVlyWpROloJrxp0
+LRLiaZyhQYdZGDNbEClGErE5FFvitCa9ywrhqsfG/bgLLJvg+38/6Twhe8wcU2zcD7vJ9U1wAbhP
+W06bMnYO7Bs1A4LIhbyt5PiW+1nTtl3KGDWDPITEvN2QIjegd+L1uUuvloD6sBR7/TDkuXxJ4mza
+Lh1WeOlxpf4W6Uile7fy/kfe7s94teDt/oxXC97sr7rOHVFbpKPgrNxf1Zm2iz+zyCi2rq7+7Iw+
+9nZ/dkYr8WHiqfL+bPzP3zl+pPT52/3Z+Mf/Y/zj5f2ZDvAtv1d4wF5gpOKE2ACDTqy0y3KtyLh4
+q3uXcY+alrEjT/aFOeuap7RIbhlraOC1dOyxgTBjU+vElvXSG/NFTVJWwPbWvQ6NmFg7eFgD8wT9
+sT2d+nz23uQ6dQe0Lvdr1ReuIhWqcwgbVSLvoS4pYtmb01V5WMxB7FBu2THjiKgRJKU47h/qP7ox
+dxdYwcTXL3SKOKXQeQa/whm78WVnbdcZJesBZXeYHvBNpTNRhaIP84JkRLRcY2kxdVmkQ57EVQCI
+NQJXAaBVYwIrLfh7Eb9yninEKU6Rw/iKM2a3c/vb5+IdGOeGWXGOkhE4sXyOOE9COXz0vXeDBI0J
+zEC9ZvXdUxSuOoizNr68G2e4/GR2RZ78xGBedvuJOEUn+InpBJffmr/AQ6X5S3kcyhGj7717wf2p
+PxDMYLpY6F4A4apz+25nDL7y1vxlX5y7XfiHnZNFle6kKY4XlHPeynij6I1z9Zs3z0lRr/TPZv2/
+eeNc/fbt/oxXv1V4NfsNfC6/E59I6bLS3+ru72Tjf/d2fzb+d2/2V/C/8k78I6UrSv/sN/Av/g/8
+iyv1f1MvuOLWCz5mZ6gKxR0WJA73UnlQvYBVr/dIxzdUwku01ENWDdgGTxek6TwvSGqh0gbPWwLf
+ki1LYTgJFW6SI5PJDFhE4Sqxd1pRvOH2341k92LI3xoMVg8we4EZ9i396txS8gdELL19dylECzfJ
+LxBGJpNG0IHsgePIdXcilnDTkT+tV+ph9AajJ+J1zEnDfFxCnnqhzjvPA+nUxcml1Mfl73IhMLe0
+7N+6WPjNgN7F+J7zgezY4qaT9M2rlm+HkztJKXXPyPyn+Pn8UcQRL6PK34g8dcxvxSD55fELdao8
+z3JIoIzuymxEYYrzZY+e8wG+dyVzwDcUEgUAup0kffvyVZvhpAxLscX9aXSk0Qcqwjos5Km98zxR
+dDK1hU3uACIKRvY7ifMLvxmQecVpxFVkxxicpNMh6TtBp0xHoYsSxedwqoxXi9IjF2+LJQy2ye7h
+ZZT8jR4V1vSwXx6vckO3KhDZYip4XJS9XLiK01jce8A3cJKCJKU74SSdIEl3w1Zs8lPMJn9USa74
+U6+bOkhI8zQiLwo8mVkbR3zyVCgPcryiWeFRf8W7wlJ3KIGvF+ECTcpY9poUwoVFa9YsEqK33v35
+xh1qbX2UO/ejt2QsH8BkzB9vw+eD/Ch8jwrwpTwvlOeR4x99j8JPUGDS6FQlqsJ0UYZKEguJgkfi
+1o/m5n5ErZ87N36+iyrZdf7UC+pl9Fel8UF+OmrZJbvB+Od4ytMExVlNs50oURIm3pMkUjhwoZC8
+JmUAr+GCEE0BUTtWBlTZ1uPnsHk+fRu+h9EbpamDhHL4PtSR5ZkD8jQjFD8LSzai4ELhy3BJoYzJ
+VrbM1M6ky1zJDvrznXZQuR33ZyW5o0PhR7x6qRA/Q62ngofVGfVU3fBCNzxe6pbq1c/vOd5aFdma
+MOFfye0uHTLaQciSxct7tN+4HkKE0Y3bpbcgLaFZ07RpU2e+5W9IqF3ub4CJ1MlAv/iHjhXc0NdB
+hx//VlD62+fkm3tXi++RbxDCaAWLsX3Ocs+1O+xh0HFVfKuqi88WuUSkaECawKC9xmhHEi10xCIX
+aUEBXaAm2NsYpKkSXCVQYzRovL2Ngit6UaX+g9b76FY5V7wtLt7Awg0kD7CCGbbGJSfH1kqvRx7W
+2Jc1dNuGGRAGP33vPH9faBMdY6+TXisshZwcOnnIWlg6duBaleOO4PsqCpWVoRVltyvHC0v/IjYX
+2i5cdc2F03v4BVeeixYFanz2qqNLK8xF52/UazS+3j5qjZ+fr0bjo9V4e/u8NZdKMTMUfw+gJZtF
+sxhpi4OtsfXSa8UmJx+ABfdx0vckkvwyY8O2oVn7clPCaqXXscdEQzrv/SpK8HXcUa0dOHYprB0y
+2T2XSvf58lxaIyRkCC3ZXDrZPTlvD63RGKQNrqqmpS3URVQ7OZKOvP1Eo75KdEkSnaM8H18fP2+j
+JqhKoLfGEGgwaoKCNVWCqlSMLJX3psJ8IpgiyyxYA4s6hV7KfKCR9+as4Zv2NG3QglvwOohvzd1w
+Tah+n5mf9PswJ4LE33N+cBjHybKI4a3MZw+bz2v3G5dZynw62D08vDmDn49RXZXyRLF7NlVEH51f
+pdkYg6t4+2jUfhpvjVaj9dGofTV+ar//ORuLB5tDeWwLP0ahtF+ce/nWr4O4BS0aNN1zsPuI1dwN
+F6XVJ987i2gcNRRG5Hw4fGWuosvMqhhHLTnd8YQG2i6Rd8cJS6Q8TpjF6HzM7ReWIC/24rkipgLF
+NCJeKRYimsPMYZGcaIqMDDHWjPiAfILzHb/hhDo1almaxcY0BWzvy58ODg4Pigj0NwetJ9/RiGCi
+C4puW79WVHMGS47zfYY8UXUXLAZKcj3i0LiDdeW0TgwsPKqeXq9/vfT0PWdO77lEbpIH5D65uXv+
+0G3NW8xI5rPTa4WlpobVSv+AdKDRu9wCR09SQn6AmhCO9w/dsmRcZucu6A34NSvBN4gusRYboI+R
+UdDElF+BcE9rMQxWxCTPaNF829D5u8lNcp88IDdZBHEFFHRdOmeOW7JlqLONCwVus2M8RasyfIvL
+cyvfACqLLMcmvBGuDI/eBF4pfBk6vwUc738zovl/wE7QuKN8FMBKfBTNIMw9rbj0YIIqEAwm19JX
+AA175MDp8mBqZxtl6V3x3RVjqVU8Qu9sF9g+dVTODBWKQEhLEaV5/QQmnkXZy22tFF5tifwreXBy
+fZsN6uoWvD923abhB8rjq+W468VzBciKjs6KBqhVd+hKjls5qt8nroBrJQR7YY4sJzq+GXutUjG8
+aIzzHIZXJEIoLtIcKeoCYiN4SaRrR33wcuR0jMzdFpqJi79X32arnzyYXCaP3l9C46fhZHlc9YHh
+m9aNfX8B/0lWHYjOij7ukbNQjqh2x1h/0m8URXZoXSXGek7FGGuVp5vnG9B2lde7Y4hVXuUxxMqb
+rRPMDoisnKNcYhsvSq5Ln9BwdbhJngynD4mMNAVHRlZr0s/ny+atT26HoPIb/TEdMvsKo4PCg4Op
+BPi2S7LJyDVpkX906ohLsKewW4f6XV13vXVYTK2xMlx/TcUYXjDAO6N4ocsaMrg8kvfVHvy+K+LT
+sR17D3SuVe69MxmMqpVhgAkSoEJQriWG7+cOyyW/kMFroEt5cG52eXTuqz24/0Dncy5DCYVt7r5X
+y1TuEXqzvVC74wHqKOc8qhR7awIJKkTfGiqE30IYrFpDdpYH4Wa7o3CFDs61A7G3Y7sLuuLTqlMx
+7k2llc+xsrti5rtgc5qKgbRgAb7fO0Jpyc41sModTit0cE51LS+X4Xw+EPdX7sFd8+Zl2DpXrCev
+EW5SO4q9ruNiLNQCLD/oKtU4Wnou/eUnrkhOsCuxnVwenCIkBdqSlXJAZ6ArwtNtSwj1WSxRFPtd
+sWe1ovRA/h0hVRWqe0lVuW5M3/27vJ35DP9m9nc1rlt5u9TW3R7iai+7K60UbrrbQyu2V+hvKm9X
+VanQ31yxvUL/cFc7XiD2pTGgUlw5ngjc/KFF9eweHBaMWi+jtz8LlSxKYkqilyoP+S0FPrroeVIp
+00KOgAYjDcdhpnb4/tGNvgl0WYhKtCifyliH/EKDF5WA0ZOMYVik6Ed0i122cKYLvsoo+RnVSOOv
+LilJkuvOeqRLXnmIX6r1qwBfq9EgjVqjdsNXeMzsMpYhAQ+SGauQRnMyVjspc5McQor7D0Ru3zXl
+HS1KsXuoNb5GUBklf1fVW+90rZ/klccvRH5L/dX3kp4/LlK/GwUXm1ld/geQ+FSZuRgKjN0Eg2O7
+Cwfe4Fyr4ODiIS1Ko/ait2DkEK6wBt7pwHur8vwWIv4NLN7cCA+wgMsdoYST4kGMxyCMbYTMdYKB
+8dbr38p3QqFNj68ZLdcXvCmNqGqVt8sxom+3y7GgldvL7kr1hXs05hM732yXYzvfbPf4mvVv8OY4
+yvjp5e2M77cL1YV7b+l0hjCXQkUL2SlpnqyyKEgBuNi3yYhxI9plLN+1a3nep9FH29dq2HjJp8P7
+7OK7d6rXsnXrlk2ajXh/2qjc3FEZ9beNTG86dNu2ObkfzE5CCHEUpiqcwbSi1EpQTf8LqKkCSqby
+z7hwwWlRb+DyulBBDx7JXcqRq5/xNm4ufF19yuMshHlIi5LtnmoteAhB4K32Z7EWasrVR9K1OM9P
+JQdcyIaFp0aj0WrVGqRFgvu1v2KEuwJQIEHsxmJO+soRKFyG89lg7hkhNCCEhYZA5zXumIx55TEZ
+qjoynRMN83tpUeIRDyHIW61F/uqiJDkO5Ui6Ks8D8rRqWq+w9L9wMrg8Qa5IFZAm8gEMJRap4tiO
+fQZlAHZjRD5dI5+dFLYSq9KT4RQjrxXRsJgUWf6B1jfIU6JLRYukspXyyvPU5AFXASusAQBOg8CN
+lUDZTl4k5ebDJHZzrh2MfR3b5aAVOYQlgwxZA51p5AqLEGL+Egq/YiydKlbxlxxi8RYGhECIscRE
+xLiCLfSu8Au+J/ksBeo4i2jQhRyAwe16mf4rDbwYQUMwXL4QXriK1AhF0IfQ8n2IhhW94kZfvOi+
+M7h4sfDW5mJ2NUJmbb5FP2tCiN+jfFZ+R61cb9DH1AcLL5Zfcly8devWZpjBbjqKN9N5sc8qZzbH
+zux49AY+IBffUu4vbGYbN7qwIkL837dubSaz2EVG8WbF/uQr3mOoEtEbeAKdmOyh1tDpWuFgRTQv
+FvJ/by5mtxEwY/OtynhyXdiY9diYnoq/Ss3enpePSldh3pKZM93e75kzhZvjRwxkDvDiEW+8QQpG
+tWh8oSYg2MMYxBvpIyT5FZK3mBcgP0OqsjQomj1D+s93SBZ9mPldL5GOTG079e2nSM2ihKvluTW0
+IrIwnMYiJHRndoQGhbBIj1g+yFd+l0kdv9TxKeV5sXeZ4EqZ7a9RrAt+yXPXU8znheWmhBDteo0p
+RL+KcpkPbr9/G+Em8kDI36Sn0b02MK3EO5z/Yr2zPlYRUbjZxZkzogueKctXKOXaiIuRPwpg0Xx6
+MyVVllDYSpeeXjvoEzibhMOHkG8glpRA+BAwknvU9Bv+wyy8dt++LjAJpBH79nUhG8nNEfjq3LnO
+1fn58hk7HiHOhMeynAAcmOmzJM5qSOB0ZeA97+uv54H29bzPP8djybfk5IsX0Ayibt5Uzjhppfxu
+6039zuMzqt/RM0ktSfWEsa5274usvTZrT1apytultu72FFd72V3PBOGeuz1V+Le8vUL/tPJ274sV
++jcoH6fS+Om0HUFZKbzk7uMNiKPz15t03L8OCW/YLv+tDHgB01zmiIth6cs1Jh0fRLoVkgy8YbvT
+E79w9RPO4Q3Iu7yfmfUVfiLdCl+W96Zfcn8pF29A6sr95c9IH7PPvPGpirA8muENSPf2Z+XPe/RU
+Pv+OEcrhe3ngDSjw3WPI43hVdY/zHyOV4wQveUFYrqwTq8Bj0sH2Qtj2+iHegP92em139RPOCcuV
+daLXBuV9XxbCtleRcm/5E6y/lCssV9ZJ6V/pM/RT/wwo/1QlWB5NheXKOlX47Buff1kIW19sqTxC
+BfheHsJyZZ3eGOOtcSguz+++PVIFnMqAVwvLKY9xJn8rpTfO7G/Swenfr/4Oi0ja71d/J2OUFSvf
+K+GKsBz5K5+xuj9nLv/s4/LPP64whnucCnS3QliOgiuMVXm8N8Z8Y9w3x640fjkMj27CcmR+A8bb
+cN4B6x3w3gXzLbgV6NooLEdR74D9bvj/gcN/4PFfuLwTHxdOqCMv8A3Lad4lRxh/KKIENpOe22nW
+UiTTCeoonOMbVqbdinIFthe+KITtJKPwhfvz7jEqjSPN4Rsi4zv4pxKvs/H+KaSfLfznjTErjVtp
+bI8mfEMU/h/89ZY8UWC8KIStpHvhi3fCeQtWJXheHnxDVOt/8OI75Zgb7j/yev/zP2C/E34FHBT+
+1VD64uhtPSUpf3phT6lo0e9X16xhhDPm96urV7tohfjCM0Yf8IRo8AvE0XHEIGE5wsiXjgXBEEH1
+RpOeanom4Ew49vJ3jiDoDY1+g0bQm2win/9GPiebuIBvYQO8JFXgwXY30eFFJNiFX1exH96AfOSz
+jCkHtCSTSSdOfP0a/iXfwb+OsUyWE9X27USF6eEvlJUSvccRdqYEomAUwniX1ijzoP4xwWSRTBEW
+YL8lRJgMCWBIsCQYwKTzuESOOZ9jb/KKTJwNInk1ezZ5BeJsMpG8wt7O5yDCPLllNv3b7NmzYR55
+BS+3byd+xA/GT/t32rR/p5EF8Cf8SRbQ34jfNPoPxiOEPGUZLiWgKBSNrCgB1UdpqCFqilqitnSG
+LHGR68uqr/SlN7u/2LLqrRHKl/AfP1NCkf/Jwlz+Rw8H8dHLlyRN/uJmvftnvGH7u/5xT99qQris
+FG0TFjCdQ2Lan4nu1mHuH4eqUNjwqg+03Q7/bEeARqN6vJnvQc9V0JvA4gEJwJvJdNJwDGwgfcbA
+cbqT9BdYP4Y0UvikHrde/gxnAoMHSGDSwRw4Pob0gQ1jSEMyHW+gv/WlA5xACGGUghA/k89CEvJE
+yGTmTJyZkiPHzzwzkKgHnoHNr8gZyD5Fc8PyWc6mWJDvbrwQEnqwvPj+CDH61bi/OOBMGi+4zg11
+voC/iA82OKPJyXGw5lfg+azl5Mpy8sEyOiD3tfMnHMl03Klld6WR/FQUh1JQC4TYLaOFOglo2KYk
+Sqx0hiHAIP/HbqJMelaNNT5BL8qFO2nALa3VmRAhx9rSD1YFXmpRPOfOnDlcG++iI4P79+/f//LQ
+bdOmtW5+bfGJIUNmznhNSMdOK7MOdxqQmdn9w6HvOV5Cxw7zVvfs5QtDbu5qYB+5umevj7v3XvrR
+q+5fXAiuYttTs1ZK8sRJk3r13n4iK0s7s0njOnV2WnT+MTGQu7J7965d12cNnNq3XtLwYQdryLVl
+U+lbcj4LeSINMiMUEWqLqwGMPmmibY01NkEnyffDJtmQjA3AkwATQl6VlMAvpc+elR79YebChz+Q
+pGnT4PBXxasPHRy4OROvW0p+2nk5JHDVmBWb/9mMzy4pK0Ojy+5yD/mpahE1Yft0DCF+EJ/FbBv6
+ik+2oiJcyWVg7KLrly5dJ8cgAZpADPmGHCcXua8d58//cPX8MvInrWRA6H1VuDKOnBPd9XFqR5r0
+ZmXYKNBUGmfFCpjyJRt/EZ/lGgv+3ryZbKDDkyIEFD9uAZ/FYqAqENExfLacdtw0w/K83+W+4qeh
+ugjJ8dWSHGCtbD3ddjlXrpsCJBsrwM3rdSL+YlRy8pjRZ1vt7NunXfumExs3adRw3MfL5zVr06nz
+yl6dOzft3qlzu8eA52MusIG916K2bQIDt1X18alTx96kdq1V1sSM7omJWv+IT42+vod3D/sIYfQl
+aQWT+SyFn+kzC5tV/2VBQQGf9TqftPrtNwQIld3FU/kspEYohQUnJ8hXqxTzRh+1GVQnauX6Y00X
+pxxNTmnd5Ec82ZlLHvxeS/Y70v1by2epRcTqiyOMEOfgp1I572/VJBgktmKSJeHY0XmtruJ5jpMj
+WhTwU0n/PkNXkvd2wvE2Q2cr4wht6Tjcd+5xJ7Pfv2a/jy67y49ndNOB/T6z7C73N/0dBzA6Go1q
+Cx58XeSJAli+vFDlJidBR28pbXH0Oz/8IRnx8NcL53+jRc1KyR/kPCSBP+ggEUcv3/Vp3rJdu5Y9
+JY9B+/RP0JAniMm5cYIH9zvVsyPMtCCcPLLBXAfkQTU6kT+zKyrqLhl4NypqZ3g4pIIvaKGeeVyb
+de0OnDt3oN26Nq239HJCTXKFvOq8UeY5KxKE1nw35IH8URRCHuALCsKpoMTkWlLBGoroJaZBsvhS
+w55GzVsSuP3OfTWr/3qh7fg1NWo8utJsRJ2Vrb+aSGdhjdve9kDrc5PozOKs29tAIDysMajBsl26
+njUHpa7c4a+bHh9PnvzZa0PDBZkf2eJB+zTrk7QFlDpGl92VzvBTUSSKpjLX32Zipd8VwRZgYDRq
+CNCoZVFmkeVfgkuiQWxCvNqilOQMGM218R448MjQC337Xx6a/6x908FDFv75wbx5H/y5cMjQ9Da/
+Lr4zZ85R3EGWYli1vEuXzl3ylu3q0gWj7if794uL21Oz1ojhI+IT1q0lf5DStWsTEoe9N2rpR2dX
+de/Wtet6cnDsOPho3NhfL5wfN57uEY6mtMFqoChR/GZWG08Tw+3ftWz5rtOg+fMpaHH0+d8eXqQL
+Rbdf9i0wusLR7LPK9b1VrhsVA3Hss+TJn0/JY/mzpJRcgETQyWfMSoS4InY2MdllovfO5ghTbIBa
+NJs0ZmgD0KNfvx6kGRQc//LL46TsCPcVWdV7V++Pd5LDO0kqHSMWId6P1YoxIQSUqBLilcgpSZQ4
+s398Qjw7Yei61gc4NHTUe8Ouw5yjO/rUr5+28cweW5wNrp2bmGa3p008h32CFw7Z2L7d63y4HhE+
+mtyG2N7x8XCxZs1OHaOi3oYXaYm0cFb/AEOAEkLGwhpA4wJohUMJtjjbnjMb0+rX77PjKJl2fdh7
+o7CXAg931MTH94ZYcnt0eARcf53frv3GIQtJP2gfFdWxU82aZWUoGSF+OOPjH9l6Hyu7xevYuYzC
+Q+XUHWKo/H4UHgAee/Q6ybh+dPqHG/EBZ/2fb936GX/lbL1R3qs9qJUQxb2gJ3pExXyaejM7nbhO
+zu54w4YNJzc73+ezdg3s97RVwbRpK3YVFKTvH3f6gsx3e1Ab8WfuHyYPad5mm1XP2Wge7LfH21NY
+WMgtfHNMPKmoiEwpLNxcMG3a8j0FBY32TTh1QcYvmgTyOxkPIRZPRXWACsO6ZL4bgiRyBdMmTJl6
+x9kTr/9k7cnNzly8fmPrNlmZm5y5fNaeQX1TU8G78alZezMzj44Zv3jr0aSkiYs6djx6NG3f5Cld
+OqOyMpd+pBaRfFfYBSHRxn7vzn7PQIj7mf0u50SdVHaXOybXc0qg5M4y1EMETZ0Zy2Ydapn/yZXs
+OVNHzARPZzGHyIsmK0feI9Mvnkjv/3n/JZAKy5aR0y02DM8/nIAAHVDkvYCQbOcc4Jo7c/ipOx3R
+qBJ+uA2DT3mmlM9y5eyklM6VHiaGw/Q84o44mvNZ7HMKb6lF1Uwk131EfG+WTxzRhP3KCSWJkt5s
+i7RE0nz0OkOAAaOCzkldTn8w/LOCvcPnn+2S1JHPctat0nrsRahFiknp2ulBjx8HTV8LOvr7+fGt
+q5SVoVYI8WMZjV5mfO2J13KDhVv0J5ajlrOC2WKSzHB0N3T/ewkMmtOFZHSZK4wmWdjPcfyHHxBG
+Al7LrxBuyfomdbMrioKNfs7AmTT8fFIHviAN4BfnRnJgQA7M73oZwoRbmf9kku8yf/iBa5RBmsMR
+hDg0E6/lqgi3WA23SIT8NW51gdO7f4wwKXqmSzUjRYPqxsbWHVR0vHftOnVq9yZfQeOlbdvWqN6m
+9+Bd68lePop8ExnZum1EBGQ4QkLsaaEhveJt/VvVqe2lHdN51BRZplHYeQrsipAjTKEyuBpA1pzu
+0b17j9PkIQignZmdnUOektfCbOeENm13bG/TpteAAT9eGzhA5omZeC2+Idxy6U+uZZmJO5DacIrY
+4aZzo2sRaP+OeC3+TO4vJxRhhqINf+bcCL8QO5witYVbjuOZEJ35TyYC1Awv5GYIT1iWeRst4ajo
+2nJAkiHAoI+0RLrFWWw8hy5DmxbdMgbcmDZ9+rQbPTt2aNKkXduuu7t26dJ1d8OhnP/tXmR1tCFw
+zNiir8eO0eksk23BwZ277NzRpbNOmQ/XSpmPnP2WEfHMmfBNdjaJnSncIjugq+M4fEjGKP0REW7J
+eXBpv5kUfdkmPgGH2d+82d90kihXtbHNnNnAvrhu9U5NhFuOFysbNc7qNMy1ltwQtje0P4vHBwZ6
+62ZSG55vI8kU/AboQ6aQdFhGhivrf0G4hQQZX71p5kwodB4RbjmDM11j/uzCIRjodDgzpzHPPPH0
+2Ylpz7L/mirccp7HSY7j+BfnGZziDHPvK9dUuMXuCKz07GCofAqfTNtFMulnGuPPHcfx587Gb/Wn
+fE9rx2qAazqNZH46FT4Rbsl9uUb0c3Lu4oXcXuEJfW+OELj3T6bGcIUWQy2RanDM79C+fYf5C9q3
+b9+e7IWFEydMmEjGTZwoLOvRc8P6nj169Fy/oWePnhMmnPtqwoTxE2Aho/OmeCGXysZPf3t8Tqz8
+uyXG5mK2cp2eUlhCpEJpMXB6bMNGjRqOHdeoYcP0NR3ibckR2UOTU2Iad1jSOyPeljUsOaVfn4Ij
+Z1NShg2rGd+h+Ua+RlRUq5a1oqJqtWwVFQU2bDbXiqwRFB7RqltgFJ9YL35I09hYrX9CYrvJDRrM
+mtwuMcFfa4mt27xOTRef4l/Yerr51C0VGCVpzCS/W0zdujHd6N3Pero66/NnCrPJuRo1VtSo0SvN
+/sKeRva5eL6HcAsZWExnAH0aarZEWiKV6H15xm7bDKfUiLRYcj6YXKdWrVbzZ0LIJ+3a1qhJxub3
+7N27Jx/uXaN603s/dDIGkw1co+TkyW3j4qAl+bFV8xYtEIIyB17LBQq3kKT4gcwaE88Zyf5ssl+4
+lekMxvdlehmFF3J24Rbyl3kjwGWUyLtuG5U9YsrUhPjsHj3I2h49hFuEnzuhfv254HC+2NSpY8dO
+m+gYUXgt/30F2qZOMeoQi8o+99Xic1/N+iP/3MV84ZYzBZ+hX47j+AdnTRqvjBfiT4RqyBtFuWiD
+wU+BBI3ZJiq7H19fVtTdFpwhAI4tjE/o0CSrXv2xY6vXT+48PDt//aahmcn1kmz25PpJq1u15H40
+Vm2cYE9J6W+IImsyk4KDh/UYMsyzaRsfnypZei+vJk0rwa+NEDAaVHwFksaqV2Dp2S4rmJW7GGwu
+DMaNYxjY09q1LcchOXF1q1bcFRmH5AGGKBhIcYiP7z40Pt6Fhbdnk6YuHsEnhWpKjJbN6pq8JVIt
+48DrDAEy4YVJuMW4cREJiW2y27dr1z67TWICWRNnK7DZbLYCG78v07mod2JwcPceUL1H9+DgT+41
+sNsb0G8IcXS+/F6hGrKiZESJxO1bcZkgypRlI8Q9c8W5QntHRiiGSBpUsKyrAp4fPbfjhx07Yb1k
+bzC2wYy0tAbp6UPbtImp06hRj4lz2rXdsDHOlpkyxpY2KanbXBIAcXFdsurX87i7KD6+RvUmferV
+w39Z49p3WBj7XmJitap9Ar29w0wNG4aZBvdmC2axVKmSpQ+I/6lTrLVu3Yx4urChoXRlEcJoPF6I
+S0VOji1EbJVEtmIge4nUsm+A+ifwOLpYcfQb2Rk+qnVOu3bt2uW0HhU+fjzX7569QQM7/QaLEuO7
+9yDXenSPT+zNDXLvkci54uj+j3vUrn37dm/tEXR0rK68SS64CpyrQjVUBZmZfhNgqEx4rvNWUo5b
+CV91PlLZG4xtmJaampreoNGOvv1699rwYceO4+gi96lXz4N7Ejs0MdHPly1r1655a3p0b99heCdr
+bN2YDIQqwNShYNS65T7vjhl2CQf7eeiRUV1cdLa4KLblPn3HlvsC23dvuU/TqUfGCYTLTiG/slMo
+uOxUYmJiNxqExnnoseAdqKWfiS5ibyW6+duY385l3coRPwnsXXZCU6yXZo1tOD01bXbDHV99vuHD
+reNgcJc+41Xc57HvjaxatU9g4J68NYc2De80oG8Gk1Wd8ELcVwhBsQj5R1ZUPepUsNz05gqHjLJs
+rOu/TSMjmjWdGhNvS00ZWKtlrVpRNZv37dW1dl2bLbN+vK12ks1mXbExqhb32Dc8PK5trVqS5NNb
+4xtUJdwSFNS5akhda0iol6c+Sy2pBvZJrlFDlp8d8EKcJYQwP5Rii4RZXH6od7uhyl2X8HmTVi6U
+Yq21W2YwlLrUqWuLz6xHURoau6JD+1pR3HGfZnFta0eJkm8vtaSKYxgZQ+vGhoZ4eumy/NRWq4wR
+Rk1hN5MlPqiuzAtmmRmssQmGyshROVuZhA02K24RF9e7V1xcXFyv3nHb27XbkpIQb7XGxlrHJCWF
+Jya2mdW2Xbu2s9okJoaP44bfSklNTaHfmuS3aeuhUg8I8vaKs71nTTAGZ2RAWEZGsDEhE2E0Bbaj
+x4JfuW9Mb7Pqp4wbN07wcy56PmsWiz3Aa/FDkXtD5xo/HrovJ/Ng7kqyZrzIOQn4kp/Jfogl38if
+4acK1VAYirN7hgcbdYjz9fQyq4tLkqOLkqJLiurunxkOvZDdM1T0NmjMRkz/mJysLk4+W0TLKpjL
+g+ytetn/wr6783ji/eOatew2ePx4y8jWrYZViZrcYeTEcc1adB/CEbIeJvbo+l5Psh6P7ZOU0Di9
+cfq8GeR9mNg94z2KG+zGZ4VqqBqKs0sS8jb460JkxIpa7vNpn/FZqD2xW939M0Mpfio/fXCIJNIe
+xUX08RF9OulWUysiqUhofDbOOiZ/WXpMmza9h40fXyMxqcXktFTeQKZMio/PiO8Cs7p1U1BLNBrT
+GyE3n38rVENeyIDi7YKHH6cPVFNGfYO9P2es7VF2SuZsAXmL2kDG1W9kbXO9jYiLhwW9hg/r3WvY
+sF4rd+9euWrXLv58zujRObNGjZzddc/Ha/buXfPxHsYvFIfxQjWqAflXOGEr0aWLU5rGWK3xW3pn
+9uqdX89mrVs3tq51VFpqatooa93YuvxLnadHRsbqld26eXrqMgO9vdPsD+xp3t6BMhzuKpurBiGQ
+TwR9jDlOhheDO8XHH4tPmLJr1cquXbirI9MbNLCP/nhP164fu3H8lrtKP+svS3VbDMtQaYm0xZx2
+f7RL1zc/Ss8i2I1LGdyqVF90EZbrEAqvcDr9Pn58hRMIBrpPJvyKHOD6ZrrPoAoHBMPPjNfCz8KX
+SIeQP4sO08lyzxJpYwFdpU+exDSbOHGu1dq5s/Dl/nZkV+taXWrXmgCd2u1ntdDxQnxC8EMBTKPV
+uBTw8kMtcsQ4i6VN7/iEceOiEhJbTJ7BLYep5INutWrH23pCP+eirCRjsJyvagQ+zzXgi1GAUnvl
+bd8LDiKdYeuA2Njk5EFkFP5ymD21ZSt8fpA5rEnHujGDBkX0bzAsMVGWAYv4qYKaRawqcrsyeu/A
+FLePSk7pMGLS+PDw5t1tcePCw1t2i7ONG1c9IbFV62GcvrEpbP5MmELm9YiqFR/XE/rJP8V3p9Po
+Wz/Y2ERe00i8Fl8V/JCo6LBgjpx6o2Qq+We94Od8hn2di+T9nQy7cYnohXyRUd5fWkI1QAowaE2R
+lgBrrC1OsAWw8tp/jANv0ACM2AdAvn0/qcn0Q+TnB8u34DPkd643oGurE6155AUU9Nm33juTPzJ1
+L8VDB7sZHr4IBWMrDfHi5BPk7sclU8ndcUeNySG1pvG7yTR44Fz05AkUN80o5+0nKJjp0rRqLHXT
+uKw32YayxZliDXRlde7DyRKJJzdYkb9tVovuXbqS77t07WqK7gsBC1qRoqiwhPj43r2GJaW0qy6M
+qZ4eV0Wf1nLt8q4ZGV3XjOkVrqvVdpWF/KOpbr+X1mDW0FHxSSIvnz+ch6BDnizTSZhe4zLtXIyu
+N9sUNYYdzuyk1sRhzxop9eMTapsy2nfp0j5n7IyOXbrYB9eIiI+v24Q/3p7MrGebPi7NmtGi7ZZV
+3Tvfud05Y01+hxR1T+9aDYaOtteDnB0IUASTuWoWD2rhzMBWjlpZ/lbwpyxiiYSve385D7RjQ/qE
+1NpUvfeX88iTMexnrP6RnIPjIDXNAL7Xj1CPNCL/NOmG+bIyRF02j7lBWsmrLULO/giVlaHxCGEt
+nqWV8Hh324iyp/gEHquVVMfdbZEI4aus7Qt32+QyDS7hRmol1Sl3m65Mo/T7Um6jtFamwU/wLKRG
+Rpe0DGCXRRZ6PgnlxYri90dmRozNzs/PbjMyYhyQIT17DB3ao+cQzCXF6g6Ra4cTEskBHJYzdfSs
+WaPH5OSUlaGmZRrOA0/SSqozbhwiyjT4LB6nlVRn3TgcI134TfxUZKRc6a/WmmIVfUusyrRrrUaN
+ZeXa33UumMPEYwTEPrvat1/f4dDBpP3TvnE4vpm2P6mgYEve8i1bludtwU2gGhiqVjtQq+aJL3r0
+60vOESdxkHN9++3E5gslJRfOl5TI916KH0gtqlYxPh2HEJ7DZzF71cauHNmXiVlNVs04rKYX7M6n
++/bt445v3kx2bSa3Nm+G0M3QCSHEoVjSRQjnp9Lsm5RTIlg9I6tbShuserMsWMCleivmkSTC2KMf
+fHC03mdTL2UP1L+f4zUu+9LUz3CCc4mqRaePPurUwrkOD/RLpT+mbnfu4fYsgaKd/ftsnHVmye95
+G/vAqT0Dx40bCKlzuowbJ+eLWkq64GhRJ8cO60RFUbQkUJ8Njt58sEnM0MiaPC4oWLPnh1NrY+KX
+60Tdq7nobZvRX19BN1BujiqeNJ+PGxeRmOAyRxISYaAtTjZH4vCrTDxWtkbINdkaKTcZK5zX9NWW
+y8XnVq4rPAEOwOPr1o2NHZ2alpY6Oja2bt2YwfU3HD+5xWa18i+zAr297WkP0uze3oGZOt3+lav3
+eXi65tESTxCqIYnJLSpAGQi9FJfgSpBVwblIZRprMYdB94iYntDBbuOzVOHVep/cEhdXNzY6ri5D
+AYu2qccBvrfix16hNdeERuL60f5Vu6+gcHVZBh+mK2Ch7/whbtkpckiD6sh3NWaXL4KJroSKvigZ
+H4tkSbBYDQkGCatDg41VhtSrP6JnjxEajdg2PLx7t6Uf7d+zwFutDu5/Uf/ZmCXtvtMf49d68kJK
+8vCh06YNbWSL29Kufbs+i7t23bN44R4M3IDkROuY9qnxCZXsnEp325Z3XG6/w6gQ4USbqFrtO6yI
+jbcl1rbF18uMt9Wt06VXn+Zdake1rG2NTUkZFj2tabNW3GOfGjWS+1itKkmdpff0Cg2x1g2p2jnW
+El4lSJI0vX18a9VqG9eM4lMNr4WbIof0LOaYGfyU6xjnMCMMbo5MDQr84ovxI0YkJEwUuc6GgHqJ
+7Zw3sLldrSHRMQhB2W+wG3oKfsyLpnfpttQEYdynseofx1nH1ApJDwwcM2bcCLzWiyqywcbOXu2w
+2XmjHSq3bfyQN6rFvNDlpOiv+U/bhiGIW5xzGTVx4/7LquFuPJLNmRTY6Pw5v01blUdlk2bprIwM
+pvPA7v/SnzRv6U+JCW/pT4718P+h7T/goryWx3F4znkanS0siyBlWWAtlIVlWREUxIYdGwJSVLD3
+gmKM3diNNWoi1hhjNkaJJgZN4jWJSYyaqsYkmmqK5hpjck2D3bPv55zn2WVBcr/33vf/i9nl2Tnz
+zJmZ0+a0mUGyAYWhD65F29naRTDbJWO6oIMk/dNn1pzx4+fMmcM9XorCyfelTieetEy2Vx7CtWgR
+0wVo1e6Jb4JVfVcf1j0qcdY4IZjMGN42YsSUeYjFl2f5CMHg39Rv0j6zDxowaRI5MZ0/UVpKepaW
+ope9cN19rHKMyqAcs2LvsLems/fOlO4vLd1fegYAOFcheg6NEjngQCPHa9UZuVhrOtv2lPtWkzFW
+VthDc+aM/Yf9oTmz5vvHWkJm9cN4YF6Yv8gtcyQ+PBvPdm4YkWO07oyMiwik8qLn0CKRgyBZ57om
+kR+ao4iMv+WiHV8PLYyIKJpSQ2UeqfCipvU2RGrewaJRlnRW4SI4PGcO/nU0q3BRQ/258Y6flT1r
+XIuXC1FNa/ozF6OtzrtClPNztqZfjZ5j8890AOSey6hD3NO2BFu6sp7IppMmz2PTLG5vxy7ZQ6Zn
+ZY+btX9rnhmh/L4l6VbZhE2vrjZ1sg3on5HB7+hpMPTssYg8VDOlKKPQfKtLUkmRNaOUTUATO1oz
+lClo24hYI0ATX/+hHa3+D+3oWMPaRWgBs56tVo8dbR2JKh270eDOsiHN2jl+R4hio5LXFNY9vnqr
+Qmnx5jkdZvR9qGvOHFO7geUZGXizPIXFi0Z36pTXYymdUhcnJlkzSt2yccPl+SNqTac6r8kQLmCt
+MMMzQTbnRSfOsqTzWplg0/x4KXmoMKMoI6PGU+507t5sz2XmsyhpwbPk8gIhikjoL+ci1EBEea/b
+Pa8OVlb2vea0tPcJpR20IcGk91gU63bQbbAdO4ePGDH8t9MZKRLaLKakknn+CRnlZRkJQtSIEbue
+YIb2rhEjRuRmlo7s9HPPpG7dkpX80FE2XkVCAhuxjKyRKiOFpLRUZYHMpldnKJstatnyR4N2oAM7
+ZvQqLOw1Ywcp3zGt+4gR3afNyYjPyIi3pxttGXHC06Wl64N6UR56Bz1SWroyqBflpGfwSNIhKLHb
+jOndkkgmuhLUods3uR3p/TD0HB4tREFnt99LydvxJVVECtJ5iqULblobS3GfxEgLxVmpkzqN6JAY
+HV3WbkF6+oCBqzO7Bprztu6bZUnPzenaZUhRdMyU9pNH9e61LHcW7xyjD9WHpcTpQjQ+YR07DBhh
+sfhyklyGsZMig4PTkjroQ3U+IcP6FJnNKnDXTXRB5EAPoMVcui3DvVrYVFMfQshmeWja9K5hbbBS
+PfFmVIYGjLWY6ajm7MtqpzIPR8+xeXh0s3m4h1YX1HJKrlDkms3MR8v13d89QcdQjtdzmwRamwzu
+McHgGRfk5toFtUfuRc7yaXPQ+DnV1cpYgz4jT7B6n6oMG/LQwabsywRMB6EUUGy7dfgTIZjtfVsN
+upabcbRWtZhC9kb6nu3a2zqVjLR16pCYgzTbesfFlhSTb4tLDLF9+ROlC0ydc/oV2zIybMVDOnU1
+LijtlNO1//IhQwYPWTIku0tnpX/Ca/EW2RZAblfmSnNhz6FoxeD86h49H1nZs0d1Pv6moAQtJ7MX
+UNNyAdpAFpYUeMZA/A8hGKIAkCXUvVXj6e08exSmBDT+Yr4xtrjk0cRZ4+bow7vFlHSytU/rbObP
+KfzRYZIOmG0RyrAVD+7UPTrsgXUB2SubBV9dcOPzBch3D67FQc5f8WyGZ8O1eJoQ3PwuV29sIv3R
+C8530AukVAguJ12GlKM3ZHz0HHYqNgTDNzYNaV2Q18tIGd1I1yYi/vIY58+IYbDhcpZ3CNvDpdfL
+bUYrXY3RIQvtFEOMJrowYRTRw3gU/o7SobSnJazdiD4KDJyaaMO1kQZDZDl6Y0g56XJg7aQh0RYE
+Cp+H8ZNCMDvFlKZvZo1GIS9jVM5uQvfBXTMso0bVZeXkdLcu6lnTNadz5wnTUDApxQnc0zH6LlkD
+Hu7ew88/ZGpE2w4dCvp2aF+GwoaUu/vRjfh9MQB8QMXmG57NjwQ2lZG0nmU0lS0DDyXTxRTaX6YI
+vh0zTmck+rytdKtHCgv5DksGjCga8MXU3sVFvaaj5XPnkfJ58+6iA/PmMrkcI/m7rqfEcSDI61Y2
+C6lzPov1h9fxd7d92yKds+gNOmfBb+sOYz1/F9V8u+1BGlqjyWAldb+tO+y8LY4jq1vDMegMktFZ
+gIc7bx9eJ45j+eTxd11nxHFsv96ipXfuOIueWLf9tu6FF+gXpfXbb6jmtxa4epuFo9ha58UXXlj3
+2zb6JY777TcZW547q4iW+rZSmfDH/CuANCLEMfgCouWT+AUMPozBe3h8wt2RCth91Feor0uIZ/B6
+103+oDREZcL1XCjqqhGhp4xP1vB3WAzYeACVhK/wTzH4abKGPyiGaBKgpwJ/QsE/2wx/I+xQ8M82
+w98IGxl8NqkWTNIUlUlvFPqjuRoRZL/1M0k194M4TpMAzwCoRJjb5ENcqFP4v8P4N3nOtJZIayj/
+AmHw3go/a+XY6vgKfwdQEz5Zy5eIIQw+DpAX/msK/jct8F9T8L9pgT+P4msSKJ5KhIdGYjf/FF+T
+AL1l+O8K/0Sn0P+iBX2dQv+L5vQB+HU+JZoEYJEr9SPvgkfexVK5yqQf6fqUxlKA/FbxS+40w9ck
+UDyVpC9xXXPxjJ9+/DrhqoIv4S/458HG+OnHL+YXuPHxF3wdg79D5jbDr4MTCv9zm+HXgeK3nFTz
+66SpKpO+Pb8fVbvfg4fgjEdPi8V85T0TfgJWyfpq8jvCG5X703PpvWRoz+ApAPhPuby5r1h5y75h
+ZrtuCuukME0CdGB8rENpKJblU8iXCL9rEqAvpY/X9fHya8J8v3YQQtBsj3+TegC+qll76OduD9QP
+Cq03Agbw4NP2UKXUp40ATfiMfoEmgeKpJJiEfFCuy+X6idEfqDLhU83oq8kcSt+D/xC563K5XIDJ
+HEpfkwD9FPiXDP4OyfTGx18Kfgo/md74+Ete9vkMZC7exvAT5XJy9aVw12UyF49n/Ne5Ihn//Rm+
+BICLfUrc+PoiMofh3wfAvaRQTQL8S4GPJq8o8k6S2zP/DWvPyU1n22U4V8LgAz36nMT4SVb6kRse
+fdYy/gcq8M3u/qUZ/kYU6OlfmvBFvHEuePqvSUr7f4KmuGNhkTVohVxenNxyB/lTOEfW8pOkySoT
+fpHrgKrZe4+z9sjecznJGjxUSlWZYKarHcpSib5WT78n85Ui8wWPynoiZ3Ffls9GVzTT6yAFP0Th
+azXs+CvBQ/8LEoJLGf5qV+RfCU34AGK00k/OZvozu/UqRbJ+soDmC3PRNi982UfAPDSnBX4I6ydv
+M34K3PoQo5V6PYfpw+wuBymSjSNXhO8ZvMBTry1KP/wba3+pnnKW20E9v4bRH+wpZ4tSDoTRSW1q
+N/wCd7tRNeGfpfiaBEhV9PmHp5yrWD8zWIGvd+tTob8aCNObm36IQn81PMbggz39xKesXqexfnLU
+uKZ+sloK0CTAEFavR7mOk+Me/EIPfvkUb/xgBd8Ek2A6uFSSPo6fBZpW8hk5yfu9cpU7n5GuF118
+K/glE5rjm/QlrlMu3nN3Q/bxKre3PaxeWBS5C/mDrF4MlcfPtAd9wjJfqc3w5XI7xMptqKech8n9
+I7+c9Y9WdzlzfRV8PcMf7innYUo5L2Q5W93lzPWVy5mLYXAvfLk9wULWnoqa8IXfVAkwM8ylEn2L
+ATz1YhirF1al/L9j8GHkLKWvSYDhMty1mMFXuW7yBxR565m8GQw+3XWTe1vh38T4l2PVrSJr+APC
+Pcb/Wsangk/Wcm8r9TQeUBM+oz/V3d/RfLiDaEuzfL5h5VAIoErAu9YX0lYKmwCa3vcZoUrQV3wL
+8ltN/EnvMP6S0IsaERmb+FPk2UXl8R3VxJ/whSyP64JG9B1N4a4GAP62gj+L4oON4dsA8K/K+BnO
+2u8IGZ+s4W8r5TeTya/gkzX4V2EPK79bDO7GP0vxNQlgk/vdMaDgn+WihT2qBLzxPsjUFfwQhf5q
+mMXao5t+CGdi9Fe7bjE4ow/HyRoan15lwlf5ewCeOPVHyRq+iJXHVX4Bgxcp+HMpviYBOsnjG/yq
+4M+l+JoEKFLgGxX8fs3wv+C/YnbOUdKvGf4XfDXY3H4TfUpUCfpRyQo3Mn3XTb5IuqNKQPK4PRe2
+o5Ue/HRVgr4w7QF88b7KpC90nSdnPfyrqN9daaEmATJZvnvgJ9TLffZftGkSgHnfwHumuu3/Ncz+
+d+Nf4V9h8C5kDbsrIONTeDmD9wCQDkmzVCZ9vPAbelgjQuemuw7iZJUJuiNfEDTuOw9N+MZW8Keq
+TDAXrQSpBf5A2hOi31DXFvgqBu8J8CC+voPwG5rdAl8ep3p745M10iFWXp1luYRGGZ+soXc1NAlQ
+osD7uu9MCFlK/b/A6MtxorLonTil/Y9h9Ed64Q/UJECWbK/BBdTVg6/SJFA8lQiT5PHC5QDgf1DG
+wVmsHWUr+i/kFyv0RzH6pQxeCiBEs/4igT/D8Ls03S1h/UQZK9/V4XL5lpI1QjSTt4tSjp/K+GQN
+vYui4FN4qoJ/thn+RnhbwT/rhS/ijRHQNJ567EQRb5TlAp71D1QPI2Q9uJJQV3e5SKJHz7Uor0le
+Bi+V4bBUnoe6bgqjGf0eDL6E+6x1+x11c995xH+K9IY6DbMkmWzyBpRnGU9t0wto56mtfT+vqzv6
+6tG6qoSEj/uRL3HIhEm7UdTGgS8/+3i79uPJnJ0T35fvESn09Iyecos8w7MsKFl0RrTzVF1d3YnA
+3iMYvSjR6eJ6bly/cU2vwsfbxfTYyOjQdvyC8CvY5JMXQRxb5e7KWdJ4fSyvC4kSZB9F7ug/yZI1
+PYPnph7NHVeRm3Axs2rTocpPkdn2PnF1n7R06aTu5I/LL7wz9fnpY3a07zWyqmpkr/Zf8vdLwnIn
+PD3dsZrbvPz9daXt3nx1YUVS96SkrsPWlx58p+KDnr3mFlkLLO1j4tLyxnff+LLbLhDuqUSuT7P+
+gPbfLwF4rQesUdYDrvAGAM96gNzf3NMkAIsrjk/TVQG53PgkcYGn3E7zw5rwfTor+KJ+tNKfUfxj
+4psqk360awdZp2pG3wt/jBd+kviWSqGvH+PaRtZ78Ed68Cd44e8Wv1eZ9BNc2x+gX+3Br/SmL4HK
+pK9sjk/1I03VJMAKpd0ca9IPa38HFXisF/0SD/2xzejzKpN+rGszsbfgpwl/3AP441xbHsBf58Ef
+3wzfqjLpx7u2PYBf7MGvaobPefRZRXPxyLtFkwDnFLnOeskbrUmANxW4xTNfWsfGk3YKfI8yT6fj
+yQKVe35/hV/XtN7A6k87pZ7sQdVKvVwsNq0HnOY3eOFTOzaOYmpE+p4H36Yy4T2wA8B7PUMI8+ms
+0G+qbxT/llLfPmDl673+0YQ/xnu9RHxLZdKPcX1A1jfD/81npAd/ghf+NfF7t7z6CTQXD/1qD37l
+j170lfr2oTc/VJ+svq1/UJ/iONYe1wGoEnxZTCW8GmS9lgDgcOmee96NT3NGptceAJgXH3bPu/Fp
+LhCQnA/ayspNxr/CyfPuf5A1iI5zCdBfgUcq625rhE+Feyp5nkHHS5OHr2p+gXtegq/ws5vmS4wf
+Gf+0YPKUczUr5yFKOS/0ml919sxjRnvNl95Tyu0kWeeZx7TEH+M9T1LK7UWyvgX+SA/+BC/8V5V+
+4kH61R78yrFe9JVya4ZP9cPKbfuD+lHKbTYrt4vyeD1bpjfedVOMpOsHeiPVkEZEYxh8rusmf1kc
+p0qAubNBI6JKaIkf/zf43VvBv6dJQGNkfSvlIOMv0CSgShnOL/TCZ+2tFfo2VQLe05w+WSNG0vEa
+jWkm91yyhr/M/9ND310v3Pa5236+wv/WzD53289X+AUyPvM7fs9jb5/m76Jqjz3cZG+7+X/O9YdY
+IeuTYmpENJPB61w3hYmyPhdQ/mcBtMCP/xv87q3gU33ObMaPjE/1OasVfuT+60H6cv+1BJQcKD5Z
+I1aIIR76bv3UkTXCRP6fHvqKfhR7cpYmgdqRKkkfz89CD3vsycmKfSVCd4Mg45M1/A/KeD+X5utt
+f/Kj3Os5bvtTof+Hmz4+3Yz+Mo/9Ru81Sx58Wd4H7VtWf+Yp1D1xddoKV+V9dbfDpncu3LqArzU8
+J1xtuCp0bLhql3FjALjjYoh8L83tz8ft0slqea3+q/r9+9mXGLJ169aFDQ6Bp5+FrN3+wH8rjlYl
+cL2oPvzsM9ztuTn8m3xv+DgFLvm9BNtahZ+DFa3C6/8G/ibsa4JLUz35npoLrcLf6gFN4zjj08To
+2F3GVuHfkH8xuAAg3qf9DmdC62UJXA8rdVhskSb6nVutrN2KoTIcjspSuIaCx74Xi5S0e7IkrulK
+mgQgPM54NqXSlFOuwe53hK9keA2Fv+WS13Afcd3kzjOe+8pl0QNahX+T6Q0f54G/FNM6/Fx8E/ym
+F7x+kAfOc17wN4c08Z8kjlaZuI5wQNYuWQp/l/aNJ00AkGxMJx3xkQd1LPVR0s600PFzCjy2FR3f
+VtLKW+iYrrdVMd30lzmEQ63Cv4EDXvBxHvhLsKQVuOh3bga0iu+uvy3hb8LRJrg01QM/BbWtwt+C
+VR493mZ6TIKjrej4eyXt2VZ0/APTSVKrOv5JSTveXMfSNAVueVDH0l4lrerBeiweo3NtLgnNAU9N
+9pTNBSVtI3hqM0vbBYCnsTIYqLRNHYNvbgH/xuVP4S568feUOM4Df8nFxkzXaQD8thf8nCvbrQP8
+nRe8Xm5fro8A8F0v+JsutjbgukjzlaZ64KdkflxvtYC/5VK55cbdmP5TWisbbFXSWqn/fD+myxRc
+/2DZNEvzKhvubQWe/mDZ8JySNuPBsuFimf5T0MYHy4Zrr6Rtb1E2sj1Iy2CY3NfUtA7/Zpo3fJwH
+/tLY1uHnhrUGp+1mdavwN0Hej5JcNwUHy9dq9GhahouFMnyhR8tUx66bPoOYTqx4bksdu276FCtp
+S7x17LopBctwlNFSx66bUoGSNq+Fjh9zfcN3YDyUyLrq0jr8mwhv+DgP/CVT6/Bzhtbh9aNah785
+wgsuTfXAT3VqHf5WWFP77cPqamfZGvWqqyKA5GJyd+bCvHTFA/hEKvCMFvqgfYWF1avOuH+LerXK
+dVM0MZ3IPbUdlbcK/wYN84KP88BfQsWtws+h8lbh9ehgq/A30bomuDTVAz+FKluFv4XKPbqKZHx2
+HdisHgKIM5kOu7bW3n0mMl11xU882N59qpW0Qy364u4yHM1pWRcBpPlKmv3B9i6pme67ojxZIu/2
+LhUoaUsfaO/e9pbo9/Gc5nCR69aq7fDxsOY2hcjltTr+fQxvNYOLXHf627WB9fVNffHHRI41eAgA
+72R4PVrtRz5e0LwfEbnRrbaJj83N24rIjWm1PnyMBjSDi1ylMg8tlO1c3wZKjwvNhxbwxlbg41QJ
+vo6/gTv/Bk7+Bu56EE7rpR/8DRw1g6tIoWzn+mEm5zNwugWcawYXSCGzcRP8eBmOezG46IELzeAS
+KWS2b4KfKMPRBLmukUJm9yb4SQrc7saXbV4/HyXff7rxZZvXz1fBT2tup/mFUjgX6urUAq5vBU7z
+DfsbeJu/gYf/DTziQTjjs+3fwCObwam8txmfUYpcezzw7xk8WoHvcuuf2W0JfjEynKt165/ZbAl+
+BgV+wE2H2WwJfrEK3OjWJ7PXEvyMCnyYp7yOMT7jlHKs8pTXBQaPV+DKvi4plPtlv4GyXKi4BXxQ
+K3Cab8HfwAf/DXzI38CHPghnfA77G/jwZnAqL+uv/QoVPZd64DMZfIQCr3Prn/XVCX5Fit4Ou/XP
++ukEv2IF/oJH/90ZvETR2zKP/ucz+EgFXu/BVzM+SxV4gQe/gMHLFPhGr/43390v4yce8uqXhd/d
+cJg5v0W/4Vf+YP/Az3fjP9jPcN2aw1m+Ns948Ow0b3ybKsFvfzN8eX9jqns+rW/Pn0bVHniqGw4z
+4RWUJa93CHaWrymP9b+uIU10WP8b1dRPCVfd77v7KbSSfx3XiKOptcV+nySFeIvrIOUIAJA7HXwg
+/wXBR8K+qivZN47n+KCys6knQZREDvuC6soLObgC47I6db+CoksRZ4svXTqeI6Eydab5eZHr87wk
+9ikoOiHkirmdOhUjldEaSre1cM2vKx/5RXiX/Dae271Hlv+kEMn1FW10x1j53Yvry/Yf43hAKzQi
+TGrRr4x5sP/gF7jHywf7Ia57K3CbZ3x9FjY1h/vVeeMzfctzvo6Kjcc0ztJ+ABDkMTTGjSs+I8/1
+0LQWuP+i550YrkFZdy9k5/QSuCSlfFwMvoZ/ndtCafjZXZwywvrKNOAF/nVum5KWzGrX1yyO80n+
+dW+ducvW9U9mI+S7bQT8hIvttbluUrjwuxsOM11BDP4JhUsfueF+V1zJnvngEukjlcglMLxvAXCN
+aPPgPSvjub4HwA95zfeOuNgZLddvAHg8g6fIdZxLZnWcA8DFUqobDjNdye46zvHyvAzNbKHHnwDw
+QqZHef9rEf86t47p5Bvyp6Iv7J5f8K9zz8tprmBZXxWt6+sbL1sn320D4Se85xfC7244zIzzgksf
+ueF+VyKaw0Wup9fZJWr7FiprKS+3Cv8Gnvfiw+ah+6yp9XnIkXDvPX4K76z0IXNRtQee6obDTFji
+1q8oz4k7o69a6PdrAHEz02+cZ61Eno/b4HAL+9wu9OLaSx9pEmCSTAUATXG54JrQixvC5Jf3cuz8
+PbyG0oTuLcaoSQ+ORfwot2354JjGVTaHM5vT5rFFn0WDvPBpW/7MG9/lcN2UQpS5aFYLuX913RQR
+k9vo1qmkVeYKPVrgXgcQBYYrn00fSAqF94Uf6NxGGRNZe0Kz+bvcECb33Af6Vizx4Ku6cvYG6zez
+U08ChzlRkvtWqUJq0bdiuW/lxD7PY472rVwuz/pWq0VnDLHpYtHKX1eu/FV4d9ue3dx4QFCFvsSL
+8XLgQH0SajGPgFfduJQKKhqx3aAzVOEI53d4OYsBpeBqRL9/UT6hCt3mT+D5wIHqJH4GbvMIUt5K
+BZVDeZUf1Pg8nr/JjcvV/htcrpPjvBeuJOMGv4ifAYrqzZHU6U8FFRAAuu0DreLSBXsf+BPw/E0u
+F+JJJP5TjFQlQAWr4xVIPi98h0TyIa67qgRgszn6jfIAwVHuNrosXAUBwnL9YQ+3VOR4aINE1Q0H
+DeasYmGVrFoLh5ZePrh0CHf7OZRDXpN5Os4RdM39LrcH6LuoDYg0FrT7XYuOM2oRT98Vru4kr6Ec
++d2jLg26DMTz7nL3u1fkdx13zPHyu0DfJUfIWZTbLE9AOq2Rs6JHhiw9qJDeKd9fP8rd5qYyHH8w
+5WpF2OPLLQ3w9RPbSBwvAQ0qd8Nx6colFbnyHmUz+06aIqTgJSp6wkvg59j3g7R9uT0iUNpSGxEx
+CQKY9Kobd71oM0G8xZFpC1eZVEfYN6Xt0nBTgTSjvTyAUZVYDs1pOyjteC/amFEl45oUppBuwbei
+OQP7RkuHLD2IpzIlPkeV+Jz8DQgyXVnc2ywemCk3xIc/5Ydhqfh6AFcsoBG+xRIEqK5ky7H5Vd/e
+vyJL6o6jYjWgw3VoOtlcRzaj6cJVUvp445N82ePgRVcLXXNjNOIpUPmc8uMBB/kFLMWvhwRDsToQ
+jfAViv0lLoQ6h7qT5qBfLJ9zaapzaaq79H9zvJIV+1iUAAdvK3nST53850saK+QJHP04jRryuPNr
+QMiCPuQe4Q3gD5qXfN/mAsRSKs4duUm5Q2oznxOW5xY9/OyRhQuP4IaHn3324YVHjgB7fyl3lZ/J
+6qFZbUZmZMEH0FJ8j6xm9fsOAHoNCO1vTqA2wDPax2E5Trljtll0xjtXrxICmOLxkxmeBOEnoQ0S
+2vA+qisOiv4CD8tFnKIUs5F+uO1XrzoL6buEAABH35dClPf9wZir9uFhuZ+I+TYCtEG+bXwCGDFK
+LoXqsImUTO7KFfzM1asNz8kkKVEEN/BkdIybKPtt1RlvXL7MTSRA7ZGbfv2Fe8xXlRFMYIEc6AdH
+c3vEBccnxCcEm+JMebmwJSB5S9SGdlvCeolb0gM2dE7QrI8L29w/vk93U2rH7oFdfAODg7hAXWpg
+RJegPoE6W8fAiCCDf3+V49s7V87d+VZ1V3X397uqu2pNplqTSYs87X529rdpd9JkeBoNZiiphJ/o
+pzgW9asLGdavznfYyH51/vQrgH4F0q8g5hWvP9Buoz/0d53tpLZ1Kv5bb1lezwYLMmq98LzT9F5w
+n/sjqqpGDK+sHL7n9Mu7951++a85e155ee/eU6f5+H2Okn3RhZVjRoyorOKOK+l7R1RWUny8eduK
+ZY89tmz5Y4tvvPzy9esvv3Idf3X95Vc/u/7K6Rt//Ut49LFlK7ZtW7F0u3OvO32x+w0AAF9aFoGz
+WFmEQiwksSgsPaEASptHcjYp3mms6RlC62CIobLFJFjT4y3IqPFEO0dNKN4iB4S3ENk5vwXgz49R
+9isvk9dP8V9SHVQfqRxztJIb+G80QNY2l9ADaVUnxPrAC4CgHT8K7ROuAgdAPUW1e+4i+ZIfharJ
+Otk2a8ePwpfo/MJfR8c/fx1KdMO5P+l+vAxpSseyf0MvuvSiKdo3gnx5kRJuRpOtEfmHtaApw03+
+YbioKR0Q+JNI/KzrDdZ3sIh++NltL7/yGBpCIv/6Cx1iOHtgA57NG0GE3rnx3C1e4PEthAX6h8Mi
+iOgWiHmY/wEOSZiD6cI0iQZpzGSjbiZrIvxPkspH+fC0peh8kQ5Z8WxyGSWRjrzxH+Q+uf8PdGAI
+ze812IC3s/z65CYIt0QBc5i/xXFYvAUIhFu8gBDkCRzNUEDTOZgh0QHIO0MlJ/nj81NxrJbm54te
+Q1dJR5RELvNGUj6ElP8DBaAAqqc3gBf+KVzSJMDj0E9lArXrFqYnkdU4FHj6l++CB6ok+pt/z+WC
+rQDcIFpWwLuYhQs8tXBZP0jT6oR7oIcI5g+b+VBocnEZjIKQFIR0nEVn1KpZnAlTAu7/j+iYktHl
+r42v/zK/pCjF5COKb168+GZSpqVbPNrMPecTaOz+Sman1/f2X1NYmGJto8tOJ78SIY8MSbKWpxbb
+omX7947wMB8i+stWlVQBAMj1NIkQ33Y9AoEQf4IP9gtSnbty7oQEwUFqTWZmaq7kz/nxEgSB6sqN
+G9nU8WFanE0n0sjHJmsoz2X8gN787p+k81dfkuw736PXyYsTJiyaO7d63qKJ42X6Ii++LU37W/p+
+vD8Hkjd9Xq9LiDPG8pI1I47L+Aq99c/vSNYPP5Cu399B56Rpc+cumjBh/MRF86oZfTuJxH3QVZAA
+mONHes36hzHkGxQ15qefKtFVEkfi0Foyl50tcb0pvSEuV5kgEMC5gP7l6V8JAjHQkgEEn+OlnIWr
+YGOKQWf4HBc67VzFFzSNrHHdxO8K94CDjrlaCEYY4WAOuDy8HjbziONVzCL89tsWPb8FGXHSPkfp
+PuHeX/+iedSRX3GRGCL7T7cwZ+vUCwWacI8UocP37uFyp3GjFLvRaewLLfC1zFcVNSQs6rp792gM
+t3u4vC/+fOOfX2zEn8t9SF8ASc/O4MW+RGtfLHVDK9dLoa8Ml2sl17cZvKm+xqInlHM3VS6B286X
+gT/YXhJEyYfnqeV4RU9jl/vl+fs8g3l4RQxQXbqffe4OG/Ne8lELkloURElQ3VHdKdbSe9gSMiIx
+xlT1xI+NJ3zIVzuRTzV54zxfplItaWzHHw0OZraIK4tfJVyFIADEYu1IZuXUDj5yDfH/vPbomQ3C
+1Vtn6j9XO46L0X99LT7B7OhP0OtcO14DHNOoRS2xi/zpGRY8/9ixuXjhkecW8Rrn8+jIwoeffRDf
+pM6Sx82OCK06dmzuoueOLMTodYQHUSNKqTfcG9xkjSTXG5oninRpcB5vBI7dqNYZrVrZSr13+fKQ
+pQd5IyGO67JpK7c91s6kaaoEf6p70d+ozMW7uH7l3pCma+Q6eVjF8kDyWvRmVx7XnfUjGrorTHsa
+9CgrH7XrUz5fmZtdlmq5VNnHluyU2eQdFYZeu2cuM6TaPd9v2XZH5eOnv71+xY1te77fUvdRkE+A
+7vb6Zde41OODemTxGItdBww9PqhnNo85oWt/xneI+LZrkUqiHP+ponmiTnBeeFgIZN6MaQmZJZPN
+bLAazCabnjphMNv0Eo0LatZLnH3fXzP/ov7uG2Y27GuY2YBGk70NM3F7sheN+mvWX/saZjWiMWQP
+Tf1rFnDQgNK4k/xJxU5ksT+QEv+j4TxKPH/+PN50/jw5cP48fuX8ec+YKcQ9MDaa/HXcCq8xkkfj
+EAgD+VsK7WA5QrLBKwc07jwuOu88rORy/jyZcf48F8PyAQGNg9+FgfwXnve1MgV3fBI3Ffw7ffW8
+I/H8jfN4ZRMRx/3z57kARgzDdQB0VLjq9msrO6O7fvny5cvCVeIwEQAeqsh3glP8uYlfrWIOIwW/
+6vJl/q3Ll5276XvcREIIv4L89SsBAWYAiD2Eq553owHiW/Aplx0tN1pmBh2/0s01enifcxx+Yh9+
+4vfmrJMVMnyXc+w+eTy7DgXoqNDVU14Wj1s9WZrLl4WuxBlE8O+ElgFcBzU6KsxrLpP6wbcuX8Zr
+CHeHOLYT7h6R58uFeAO+K9yDQGgDAIqnGOZGIsXb8sU+F9IsljT6tWO/3b7/gN0u7CgsPPJcYWFh
+4XNHCo99cfnKF19cufwFpfkQroW7zOeUBNG5oq+AePBR3ci+kX0pFXJFXgIOfFRXzqluXDKz0FwW
+nfGhOYr/YW78MkojB9fi+8KXgCEQruSGAucvfS4g3z+4zUEowA/KRB8+IEh14wrtIdXMEnHQWagm
+04yo9d+vLphOA1T0S+2ZH/ixWUEQmxUEQZAyKwCK4p4yBLnOyomQyxJzh/mjSJSCMlEf1Isr5iZy
+67hnuZc5fwTBKBgHC8FisBTsE+wfHBAcqIVoFI2jhWgxWor2ifaPDogOjAUrDY8YOBRGBo6FFfAU
+vAhRZajMFxk4tw8ZgxV9Ti4ia+ihVas2kTHCOFKG2zq/nXr69HwABOPwBnxZ+JJ5n6F9gK6Zo16P
+c2s5etajiJD5bSPbtt1fXj56VF32orzMrl17Tl+2bHr6hAzhy4pLvjw/oP/yVf36ajQzI4ICxx0d
+F6KT68JIvAH/S7gHwWB+MDaE1ux2+ic1z97t9hudKLJYLJaiEfT7WRqSZUmPHn4JmZ17bB42rGjE
+5qfrpkw5mZqWxk9Nt44sSbda00tGWtPRmPEhfv79+j+eGh7ef8CKxYMGfnHlreq5Pr6AYArz9fIl
+a81mOZT5FDyRHFyN8olF+NLs+Al/C8BBMd6A7wj3IAA6QGd3LVY8F8fLXgtjWoaxUBzoeAlIRcNw
+2mqdVFaaYbWSO8OSUw6iqCGDk1OGlnSyDi/cs3d4oTUjKSV5+OBUszll6FBzCt8hsWO//oWJHbd3
+LNOkpuVXzencuaqvOVWj6Wyj8Su6dZs/MLPTWzkJ8fFx3dg31fNQvAHfFu5BDKRQydwu8mxiM326
++dQ2uRWk5bE5NS3NvLRHdz9jdmbPTYdHFG1+ekTRZKbZS0XjLJai4db0dAs+Oj7E369fvydSIsJ3
+rlg6YOD06bJa0awJpbLyS9PTlZjndcJkJe6Me/1GjQejCrJ/ItmPKoTJ5HwFDf9VgTrJ9ssa7gpX
+x1vc9twazuT4lLdUsDq0hhvN1fFPNcXR1LkxGNZT5asqGI3pWECbRMxG5iCkCtUbE0wJtETQpmFD
+hgwbW56SkpJcIeKlDkLIkuVLFjcsXrQCEIzHAlotYrmXC2UxPyRjBgvCNn5sRUpyckrF2GHtO3Ro
+L+IVixY3LKavLkZ40SLFp/k55qPTnCvpmHPykGY+wHWyD3Ad9QEuiuoATNOzz1251KpvcnxujuLu
+e2bf+Qt5PfOM5nHz3WmlfNfR9Qu+yw3TSP4DPX5d1wDgN7nuGgkWeGDlAPgFigdzPLAxrl/wuwzW
+5NM2BwDfp+/6F3hg41y/4MsMNtgDG+n6Bf+L5TvEA5sCgK8yvKEeWLHrF3yH4Q3zwIa6fsG3GWy4
+N89cHX5fI/kXNsFQJ64O7dBI/iO88N7k6rCvRvIv8sCmuyaiTdw4jeRf7IGNd01EqxmsxAMb4LqH
+91Mfvj5Pe2C9XffwVQZ7rhnsfQY76e3/l8bK10j4qVbePeqNh89R38F4VjPYDxTmc8ID8/gYxk96
+4/ELGL0XmtOjMP+RTTCXGt9heKeb4W3CWzUSPuiBGQHQdS5eI/m8LMMAsTubR4WPWRo1PYEG9HO7
++FWxyB9qr3ic3OeDjlcSch3Fk7HPD+z/3Jizly6dHfNcf3zOeRmHOn/EnZ0pqD4jA9lwW2SzZuzt
+3etH0uPHXr2x3xLy4hIyeQlwMNt1iD8jZoIJkiGd+Ti1WSTm5BV5Io2YJKMtQf5KUKtsGfE2iyRK
+ojpEjxJMKFTPTejWNWpXSrv4zrnkNc28y2WjP5kfEa7Z1aZbZsKublr18eP7kI+u9ubmooVDcHrX
+7uQ1azfhnz7aiU+Ftr2a3b5xR3nF5fx8nDNCE7Xqe43ufTQeTfjgVEg/R/SM3eSTu9u4i1q+8bF2
+OgAEo1zf8ieEe5AIEJ+h+L1lgU89zpCxWqUP1bsbajRi2tNxyaOLJpWN/nQ+uUB2obEoY8GnFWVX
+5v3418Plj+80PzEtOEGPwrPjlqxbK5lSKi7n90Z7KBtob+/eVyvKydvkE/JpbXUUV7a7tJgX7Krg
+k8+yMnsEgL8oXFWiISqF4/bIimZyJc4ZuMBZ19Bw4Q5axI+wk3w7ebEnDcLZk72/FIAfI9xjZU7f
+lX0nM9+eqgyLl4NnuTvFVpzkiL7zj3+8/fbHt7rsGNR/dZdtc9AqMh9tRHfIGbsd5W3cuLECCf+K
+jjzZvv2uK/kbN26UYxDmSZFCFHQEEIwev7re3hr1WouU4R1wgT7gS3Y0VUhXQvB1LS6a/9DwjPhH
+7EWlNcfOyPH5znA1G3oPqBh9gMXvG5eW2jWhK+r5seX3r5tC/KFIAAFiAfj7wlXAIEIsJDCv5Cad
+1mTUcQb5Eors31Rt4NwebY1Wi6C3WDm9FX1S8FKnxeeQ6sr35w4cOHCA3FMesLbT4oIC7th775Ff
+t27l0mn4YudnC7YukB9wwoKtCxZs3Up+fe89wPCO66bve8JV8IECr9i71DWnJ/KT3h2+nLoQdN8T
+VtwjehWJLiQ0Gslx6Kgdxjx7olU/P7J58yPoc3HmQ3d+PoOeu/PQiLDBR9FYsqv3lGdHvTzyoRUV
++7P3b7z8+qhn8vvuGb50adm6rFO7bl8c/tK4Z9/ZKaQ439+yauUWcun8pJnOw8LVmUNWo67k9Y3O
+fWsGGq3W4sH9CuKD4x5btudIVNTRdu2GDcvtYwjq8Pia2pNGI9r7ER7FxuF3XDd9LAJdw+mpyKhK
+MCK9kUtGspBs6VeKQtHI7f7x34tnSkBbyZbHHtuC/8F/+o++62puXEDnETiWL+y2oOvIMaMqRy9b
+P/bZ3Gd3fvXeuBf6DzxcsmbNmG05557645OS0YlCivPuKiQ+0vCJhBZvH+NcI1z9+JMNp+NCNy16
+wdIm4t/IpPEBAGCeACQ6t4tWak0SpAJoTUarxWTRG2zsQy+I0w+n/LMKEQjpDTYUgSWLyaA32E5G
+lXVOvWTGWvNN+vVuamZZ5EN7viafvXGDfLWXP/vUU6Q6Em2ObIx56qnvIhe2dfanv/jg6o2vs/82
+Vm90LOGW0M/Gjc7x+HHEV1cTh/upuprpvsJ1U/hFuAqBzONufAy0Qaw5GdTG9igWWMzGUIuBs1Rg
+CVxIrN9dR56vFx51Ol2koX53HRrK1wsfceRjcoNsRoX3LjccRx1QHJpO7PcuCwOVM1Rn+fvsruhl
+xXfAWnmv2HWT3yjcg1TUNrdzjOZyrKqjdNm3zf7wo6GPRaL9cJR/LGVz5Pr2vmkdYw3tjQkalR8X
+6Kv2SYxJ0EpqH3Vg2zaQ0MGQkn3lXJrj3DlNZqYmk27GqPXsIZNOtc6lfXv/zv07yp7M59l30+ik
+y3eY9wYMm37p6VcYm1elsXlVGqS551UPBUEQ8sf+qcNCJgZMCZoWPF01XT1dM107MWRy9PSY6Ybp
+sdON0+KmxE80TUzaK+312eu712+v/96AvYF7g/YG71XtVe/V7NVuj94bs9ewN3avcW/c3vi9CXtN
+e9vtbb+3w96OexN3J6WBD/iqfFS+MT4xvsN1Q6Mm6cZH+5VBmewXVZQMkoEFINYbJENU02gHnNFm
+SacTB6vRZtHQIZjfOPKZcbsvxOBuNuf3GCGMznQia2Mu7B73zMjiU6N+IhfbXbatQ+h1W207lHFn
+zClhzNWJJedfXhCWbIxJDlvw8oWSiZfz81Es4hboU2KMKfoFCKHY3nSfAUAIlccP6ksZsbDtLN6O
+2mj9x6u4+l/OJchOinDdX86KV3dww/BS5xo8z7l4tjMav+9MxV/LPi9DAAROuAp6JYakzkA9MOhs
+ekFN3TPEWyUTTnDe5X923v2ugFz8voBcuPjDAJR+awC3Ae2x28kv+6d/svrA9E+d9ej0/mmfrj4w
+DQAE+IBk+h5k6+vJUAk1sAIAmbQ0YjPtKuSJleyD0qyl80N3zElbhtvfp5Bh09I1eRbcmUX91svB
+SKQgpLycjKj7WY+T8AxLGuJESR/aghLyCmop+Zw6ENs1dfvovgviu5lTB48cYeuaEvXHiNQAlZ8+
+pmtcdliIVh0ZEBby16kA/xeSY4xhOvXR8Kru845dREnlw4e0Dc+LG9W1R/+Kfl2TLWNicoODNbqB
+pdX9B6yY2Ad9+3wbXbuhlkzrlCcyE9uGRUV3WuHs3S4lIShQr49JIpnt8Qc7Jg6Z2tlStGVe1Xhb
+Tow+Df05Kdxm7GJtlxEXE5GRljksJSTA/6V2adwAv5BQQ2Rn69CFcbkLpo75benM9csW9Zydkh4W
+6euXpE3w84vVD+uWN6rsEW6LY9fDuRGdQtusnDZkyeTKqrLR3Wsb98UGBQWHBQcBhmTXTf5R4R60
+Byvt/9iobUmzec8a5ZmjVZ7WMnUaY+O95pCn1m2d/nBuUazZdrOgePWc0cMmv7hokmPlyD7z1kyd
+2TfNasHmHhHGtHRDG8yPujdwct7z5EjRqFFDn7/57JP/eH3lzAN9s7cWzVo0odenU44VpubkmEfQ
+bSvgoQcAf0C4CpEQBx1Z/6yEzZfjOISGSLLH8Y5yzRYMOk88h45IMOgMGek7hgxB5xuX9o8uzUeo
+d0n0gKWN5MJQri1BZMMAPVEjl0o/YAOhPuixEXN3Cueg6Jk9Js3RJermTO4x03mYq5kz56E+oag4
+Qtfnp0JdIgCGMtdI/nmxBuIgiVo+VsWEtVkt1EkzC2Zgol6ZbWolkKpkot/UhsR/rf9yzeHDa75c
+P3HUjPKKyWMmD8zJz6xBsZU1E6rXPzJo+vRBT22MfvoSmoqmvXsoeuMaPjqu17UKcyLfmIPVGhPa
+fMi5eGiHeOE4/3TMlMfJh+Sjx6cAcOyMqsDaagREUU0ZtBY6XaaK0ho5A2fUGq0Wm0UypCALZ0Gp
+L373IjKTxt5ntr74kZ2I9qsvbnm1z2vd0TM9Gj6ayA1ZgH2cfy54f4HzT/qAfRa8//77CxQ/LdWu
+m8Irwj3IkP2i2wxWQ4YSXFFZ0eZ1tGwMYjDtedwR5JlZHWdlzmtL+dC+S4iV2xIQmHXiqYpLs2d9
+NvE3pG9zjDjHf9IdP+IYmvrKc7PuLFjy8yTyA/lccxjvl0L7Ji7w8e04ee6SDNubCQnfvjU8b0Fp
+h8AFWeWLVvfq+UGK+atP++UsAAwHyWV+lhgC0dBB8VitTpOj0UhqozrBlKC1NhUX7RVESaQdAz+r
+9PDYsYdLS0qLRladmnMFXZl/fGxZUSlZh0Z2nxscFNRmSnf+4NWJJY2/0L43LhaTe0gXOvdjlKlG
+eRfn6G99g2PjGg9yf4V2r7bvH/fClO5s/Hb3o1yz6L1GtSGE/7lRTbvPi867wlV7jb3xD95XXhOZ
+BMAfE64y3802g2SgI4pRlFec9Fojl+AJPGcz2FgEk0k2PshG9gzSIavtd6FLt4XdumR1e/fTf/Tr
+0avmBtnSt4foPGPDoEnqu0UoWbBg4NIFi4aXxYXm91hGJqDFO8yph7p3nbWge7q8V+0HwH8jXIVw
+AKQCg7u/j1dbPGNAGqj5b8gEsu/I9wNR+q3+KP0CHQJuFZDz36FofIcsIttx1P5pn6w5MO1T9C2x
+H5j+6eonp3/mbERhKBkwdAfgPxSuQijbpTDQWsN3RIpyZEs9CzNLsiNCgeh8I0LE1UhwB+fHF9h/
+ZMMTT2wgwtWb1z//5tAhch8FHDp0iBq7sm9ejaBzXRRDZP+9Fsmgc2g+W7QCfyfowLWrSsbpIuhc
+v8s4nMFqMFn8HL3xdysWCbqqXSydf4jR8KHpeqOJtiCrM2LFomPHZFK73n13V3M8RMnoJaPN0QV/
+JyNSahSPd2j5Ba47okpZWw8AQF5HaPQO7cWLXNDFi2T+xYvjRBUT8iIACI0F/AKo87wXDCoAzutN
++hEiLl5snNxEALVXKMg0mngMBECKKAqj3yvyZLvZpdzSD+uHu7velz4RefCDOGgnx/lV2rQcydUT
+Jkbv1QWE0iMgyGP3N4UYlWcEOHjfjKH54xa/8gr3+NQNh95965np43ssrSzd1vv113DdJ44enwjP
+lE/vNH/Nmvmdppf3Wdbnwp27H3Zfwv2eN6R7n23L/AaQwK7CoD1Lt+8SR5TG2I7HRV1o+Osit+J4
+elTttq27o9Ofj41o+PDKXxEK/0TkIQY6Qk/oA4CSUXoGr9eZWBwtNicJEY0JJqueRfhKMP3HAoqF
+qxafQgMfipux9IWsiebH11ywH9scv3Xd810WNK75vyREC9s+/POjKP0VTWZVXGKYn2Hxvx4TrRe0
+/cclpkbgFf9WUgDM/JqYhHsQBgaPZ351U1errGAg99gjmIpv7Xhy/vwnd9wqnvTTCvIceQitRENX
+/uT0N0bv3h0tjJnbrSS9MSm9JK86Px/tVdYqGrX8yAjDEXKavHxEPl//huumz1S2R838VwC7jwHY
+9TGAEMbmwR3h8XpITKmHpJR60N2oB7hRD4mqemj3QT20S6kH/ka3CAgFHhKAhwzgoRfwUAg8jAMe
+5gIPK4GH7cDDIeDhJPDwFvDwMfAQWNZNgO+Ah9+AB1xGryPXg+8H9aBT1YO/8jf6Axlu/ADqwS/l
+NBggFriO9WBQmVORYlDq1Q+M12yIoP2Pe0hH8/pYKistfS50yhjcf+BAW6dOtoEDBwxJ74SvOSr2
+T35sx0T+RGTnWe++OzMrkrvy0DTeX2M80jE0mHcc5FW6jvY4jT/fwf5FzKy9KAhpdk+T7Zps1/vS
+dyIPbSAS2kGau+xaayasEblTVAlxngNWnNhK68Cf02rFTx49d+7oUfPmkS/Rss8+I4s+FXZ4NYtI
+B20W/f66yPF7tmzeuw/F7Nv959Urf/55hY5Q2a73fQaIPGjlGTFSy0GjlZh8jLN/z61Q+srggsrq
+V14RN43as99p/1s2UX+scv42a+LSanTPsamHb8FrvKs1NuX944Oum/wC6usMDVF8Xn4sGPmbKol7
+HUDxk/EGgJTgVS/bcxyw2Dium9J6oRGmA6BgpDYw8997S4TtQsmrj+69E717CkItYYNnRuIOEOVG
+y2iDPDOUaBTi9Q7LIpT7B56C/myXmdevckGXkISI+JDQyHizxdAuPDS8XbghMdQWacmMjrdFjprS
+O8sW2TaQaNOsZc+dmpCXnmiyBmv8Q31NeSMH94qLX11Waerti319NfGGsMgQX5+F/WYt2pr4tPBj
+dKrzlQ/X7+wzPqR9ZIdwQ9sEQ7seWfM+Jq8+tWxhYqjNmmWoMJXZOs9f+MzIF97f82Tp0dz0rsbE
+YI0h0d80uJf9aXDhSaHh7fr2jk5XxcUlqjpEatqnZK6Y388YLzL9YfKXsE74FAoABJsit3v+RLvD
+UH2ohCXmxJ9NIJQkpoRQfShbUcVMMbKmMmxU9yoJjLFch4ntiuKjjRHhmsCItqm9lg2t65niHxh2
+cVCy1dqrOvTVguEjB/caaTbPmnJ5UW1ErLC5X5+Fhqw+vWZnRUdP2//Tr7uzKsjtYeXvrh8cHW3L
+XDjv+PbyKcm918yfVToynTQMyow3TjR+UT6gKGvJU/Pn5lZuWLF1oYTC8djkPj1Kw0NtfbK6phX+
+8PSa6st15MBl5Rws9WPDKfvyWrkN0CPB8Qa15x9/9ULjoxfIR2gLDkVbyDTnj2SaMLPhX0Ig/VQ5
+V+Oaho74ujMeAEE+AL9KDIE2AIgzarsixVTmjMjCuU+V8dXLTy339ZtUse3TmPn1KKV+Xq/ParY9
+6YsPOkvx7pR83bDy84ecW8UQZ2351H2b44bIdmIJAP+CQtsixesFjlY7iTNqNXIWWslkQ6tPJaEN
+QaQg3f7DazuSltcv6/rnYx/GLDj15YdBv4khPz48ejX57Z2fb9HFVdSFLq7yi/Mq+1P6Wa6vuTnC
+PciU246qeZTqpnZgVbvbQUjzHVHWCuZETXht2cZp2TZVuDEi2sffPyIkq9/groboh0tGJ6aLIdGh
+YeGh7YoGrHvsRcNC4WZiqIOQla8vy7b1yAyOMEq25AjtgC4rV5zh1OGRyak5EaF8rq8pWhPabsDi
+oj7tTIHyfvMk102hl3APDJAGkIPcc1lWHa3pOYi10qY2r3UjUADtAyatn16duWKTYX5V764FBVcL
+CqwXTv+asfSRTuXTpm5YsPihJQsXnl83fePHGx9aUvKUqaBwzeh+i2ZsmVq1b/26+ZO3jXml/Ncv
+BhUIGZ1SUzrbO4/pzMqnh+umME34lcVjF5riu9k8fJjijFaqOlO63Ky0D/ROcjNTVjYkUdJb0tB1
+hKeVDM0eX5jnmxg2bf789HFFG0njkB42a6C1T3hqvNnmLxgNuZUfzuqdF9Q+OjEy0F9qb8nsl2yM
+4/mQ0ARbHhfcFVVMM4e1Kcx7YkhU0Iqgb6fNN0bnr/HJy1kR9lqRIS4sMw8tfmbFkgHzutqmhKuS
+I/yzbPGJWe0uP/1q9fzEHmaOjfnfum7ij4R70BYS5Ph+NovcVdJdVFoDNFQYnShhpNFm0CAlnIjM
+7Ud07vdIdq/+vWpz+tVOKfCLrp6Qv2Ah0uST+pczo9pMHR2BAs7PHmfNXDdt65qBw3fuxFnVg58b
+WEaWkz9QKQrdmtBzhLNhM2CIcd0UI9hcz+TxJEUnELRZgTt0pdurFKgSYkRVaMxr9fWn8PPOQZ2f
+X3AJXK5LC57vfKq+fv9+ftfT5MbTT6M42ecUOV41mrzivEdeGV11WHE/1XgTbf/hBzLpB8DwFoBw
+gPUTgQDxyOiLDKyvQGqjEOMMvYt/dGxDh/EHVaTIaa6qYn3DzMZo/uuGjvzXjdHMFsgAkF5j9xei
+oSOk4NDnt+zIq9tatzMP6iDlRFBwYkodr89+Hj32Ux3UJX1el5xdl1QX+UWd8V2V6nl+ac7zgl8d
+1mc/7//YT3VGdZ2/pk6fWaeP6TUhv06vqmtfZ6yLNPaZmPX8Epz3/FLI61IXWac39qlbMmZiXWSv
+UXUhvUbXbeleh0YZ8+S/3etgeFFdiPKjU3FdiOp4eEJMHafPfn4JMBphZlRnVj3P8aSOz66Dc3Xc
+8+q2zjpVtqqOez4ww1EXlF0X+LzY1lknZdcFZ9YFU25UdYF1fnVQxxn7TMiq88+mv0M+rgupi/m8
+LjGzLpFxHFOX8HFdQl04BVOu61LqEutCjH0mdFbR/+rC61KNfR7gt6DoxYTwkJjEFDdE1HfqV+c3
+pIhm8SB+bkGR59Ej/AuiFBgU7IYL+k7FxcVNOgupC1d0FtJrVJ2+dZ3pm3SmVx2PNLZvprPUOr5O
+MvapgyFFAc+rgqW6YNXzovqnOpXqeUHt9AvAgc8Lok8dr6oTsv39Ajj5p6SqE7P9/Yot1jQrrVjp
+GRZdrI4+hYjeN0tsXsFZ6W2cjK1IhbfvPr2V3HNO2s3f3DR+/Cb6WZ+ZlpZJP+vRXftk/mxjNHdp
+yWP2yY25/NeO9CWP4Wlr//GPtfQzcPz4gQPHj3f2WLAAgEcRAOJ3wgUIYJFVk2hbp9OLGODot2iI
+AbWG/lSrTIJ7ByzeoJzZVBtF/89IX/IheZ8MEdELKBWltSd/kAayUkDVSEQSmv+y8/LxysmTK+mF
+oboXXqg7voH/5Tp5j3zAgiTRSzEnx5JHyF+kkaxC85GAfF+eR44VDS4oqlqz/JFVRLmvNY1kCd25
+38EM2SxWUoi+eW+aYfM6oIM0nqFKxTorTp4ZMARsXR098tnrK5b36lM7/+jY/hrf9jMfKuuZENP3
+VbJ3Vc/i5TM753yZfGvb0mhzcre2Mdxby84MmDx226v9C5797NrT2R0j262f8MbD+V3IfPLFw7un
+PdolbyXyd+xZfPeg4+e3FlQn90Ip6RUDAXg4T/3sK+tD8lqI7DwvzaZmSpTXRWihK2YXSrxQhZLD
+9PowZy7e3CAvi5BpXFF2UllpomyHOCdhvAVXXb989TPnaqHE+Xho+8K9ewrbAw+zyZ/U/yeooQ0Y
+oD3ru0ySqMwY6TeOD42xqRNiJBZUTqUPZVmrTAl8HvK7/7lFrSJvsmLIQp1RzYfOjlOQasoUcm/K
+xLiYXbti8BfG6F27oiPI5z9zCD2FRqMx6CkykuwhDvKof1oafy0trXESPywy/qSz9sV43tp4LDLu
+JK48GcfKMM91V2wrpkEAVFBdeArMlKDlTKIk/lsbRBeibAUoKIaMHKTzRLaSHjDwubzoLhvLJg6N
+KC3Z8V5htMXca++pabas8DbG8NAIdagqvNe4oZkGww9Ls32xKtJojUyc8CqKjczKPrlmRVrRtPFP
+lAxZEJ4YGW1URxszu2b1MwwW9jv7SKPHv/7k0IiQ4MZQ9L3v2dVrejxpzbV1CA8LDY82qcKHdp6/
+cA/XYZktv43Z1xpsNESHJfbZPOXlwMi2uW2jLUVP/2PaBPMX7y6Ijm6XFjarc5foQeycl+umOEX4
+BfpAEYCQINfhYOSpw6F65aylOwQj3dXQe6uqqSlIikr0Nj0K1andBr0xVhLwkNB2gzqU1owfv37D
+uPFJybm5m3Jyc3NKB3fLzc0dmrFj3Pjx43YcGBuluvzyjJS4Rd3yFnZOjYuISdrUwXFQ98SmAX37
+hkXnjot8ae/6kYc6dnr7vbnV1XPrAgIeKhwxYsR2vOuxohEjCi8Ujiitnlu7u7q62hgzZkRQ6vMj
+Fjxcnho3YcHyJXO7j+SH9islj/XuPmHc/B0xfikg0DYipjHfklEsKmKaHMm3I6JnDGiwaa1B3oo2
+yFvRkoiUBqRXqi5duuQb+/foWZM0YOzGt8mf1pld3yX5dOs5hEzDTyNwVKc6H0M9abva2a1jSXEH
+x/ELbGU05R3yHR7UZsUiMqFpu/mDMe3xU3Ir07PWhboOb59Emx4tq2cAxOVsLSQanq6HmJR6MKTU
+Q4yqHoI+qIeglHoIpOsiH9BFiXrQ3qgH4UY3AX4EARpAAFzWLQLagADtQYBMEKAPCFAMAkwEAeaD
+AGtAgMdBgGdAgFMgwDsgwKcgQGCZvB7im3IaoiAItGpNJtRDYEo9hKfUQ2TKaQgGYKshwarTgMGf
+PWO6MtJ0dEE5uEDX8fU2950NNJstuKKY2c+RC2w5duDRM7fIu6j77fev3EJ93EcNnBUZ9KDBzetH
+Jl//kawkkxFPHOgxZu+844oXholvg8D2dJJZX6dNMGqblrVNiAZwNaTpbfE2QT5gKfojVj+R1oi2
+fng+p9TRrWtntrbdM2MYOTq9Vzk6v6jCn5xORCc7RegXLX/46WGWvs7rGzfSgwLoz3DnhqZ17gRf
+R13iMeFqeMSy6UfXq7STP9lwGv1AyoKTeiwf41iFxgMARhy3TmwnfAImdoKp1dMZD57isFkiED1/
+zBk5tRF/P3yEqVvvlJ6dZkzctD59du+RVSk9+9lyOs2oXPuwZeIH9fsP1K9dv2rDGuGl4lhth5R2
+Mf6+msJug0ZqdaM6hCWmJXbw89UM6JJfGiyQI2iY8zzaRj5B7cgUWnouB4CPmfppBS2d+3HKjoLg
+dZZLvkDLcyfIrJ1kNv4KTyeh7PrU785H8M+PoK+cu/hRSxwx3FejxpE/xpGXx3FLHN8zp+UYmbit
+Yi/hKgRACHTNNSBRUvnxmiBfTnwnEMNSHfIVxnC+Y/wDK4PHaCvVOtWVbx3f0ruh8uHp7Ow7+rQr
+2dnn1Jlm5KUUtVE5xsT3PXrQPvMN28VZMy/Oeke46gIEzjPoW/IhSiFtcV45aYu+LZfnuCpXrDBB
+PAcqAF8UbxOQlt7X8UcmX2RB1xBi5U4OHEKffeR8+7s9zukoiTx5mRuAVy6bftSxifTibzd0RHuc
+KxLxbxzbG8AsFsPjXrY74jxRHdF5rtOPjvOThWDHeW5QwxtcJ8eXivFe1fAvascDgiLSn09h8w7Q
+h8awVkPfVeIkGnQoLQrpVJJoiDUV2dGTr516itxDfxBf9IfzHBdHTOhTYjry5aENpwh5+crTlU9e
+Wrn1EBq7FY3depjUHSZ1o6+sJZfHrezLfCAAMB8IIvWBQH+7bgrvCxdUIpL36cPIe/xjYg8YDoC8
+OnJ5ULBZRDbipWU0ba7LMdZ1Ko6ypyzjSCaPoeQZT91J6NGKhfMnGVMHhy3ZHl4aF7bTaDQalyUO
+U/dW9y4Ny162YgrundY4Nq7uV3Jt9mjMq8KM5p5h/YoGZw9Q+7eJyt1Z3TfBpA4xjK+bUoYPr/Wd
+P2lDsjHZ39/YxnA522gQxNCwrN5PLl2RxwXtnLeE/JM0nA9b1TZRFZYd1yXRaAydN7xN1Jx+8+fv
+LMzJNUxKZvNqAOzHj6KlZ1C746XT8MBoYGzclKJpJwuG4Kubk821o8ZccWY0xXujcbfcccnc/oFE
+WAUAHKsTX4p0bMmBIQBIr4yV7ZGtmX3RfIUj3qb3mBeCx1IxqkOQ0FyLoiTyM6VnKirm3yAnnLOz
+tq/MjIw+tWyAf6DWkNhGnTBzH/LZ6fvW5o3mPuP7kbWXZg7t1WHslJXkFipdPWWCuagwediAuf36
+RCcXccNUo8uXHF34WfXKrHUrLuPly/oM8O8iJAZatIZQY+HKOXtNfagdkT/liQl9LTOGLK3u1SEi
+zPl6pLMdDo80F52aVZgYFibemfYeqz/LXGcFm3APulCZM+KjEBWHLoa7F+xC3acrTIo8NqoIlT40
+CdEl8zj3ah/u13snSvFv569WhU5cPyGrun1kmD8XHBzca/Zb4SV9jMkG7cI+E+u+QJMRujb2LNm4
+rCzZXH5WsBqnzGo7L7pdcKghuUdQ3MuBsW0z83qHWtL6xW/oNS3aGNrHOMdyas6QspWfkVN1Xycs
+zlzdr13hstKNm1/88NWtrD0vdP0gFgtJEM7s2VB9aIwtgy1FxMRha7omzpLGa3SGWJ01xGJIs6rd
+JYI2dS/bjvrunDRgesWjJ8hDZNdb76DxZ15F4y809tVquU0hvSp27t23s6LHtwMsw4Zlj6rpXXIC
+dTtWh3oePUpertP20WrRjALLkMHpgxgfI1w3+VF8D2gL8cr8Q25MjBVZS2CJofFwqSbpbC0GJfKx
+WXvmZI4LW9XusQmkavrA4FQ05B5a9e6Hk2fcXHk6E3VDOxNiy7tOM+YMXlzc9fHJu3oMcKJ08s+3
+x0/8asDMZy+Rn1g5HnDd5FcK99gJvWbr1xk5KMH0wEUAa7pcmO4DMPpQi87ruI0tw8abKqJVxxs3
+TpzSs2Ll1ON4SpdBlQt+WT3MkpQW214f2DY8vuO40bmjC1JKKh5+dsG8zlNHl7y+tLprIOozoZv6
+SGx8bsUzR9dOCdPFdSnYtq7yoaFphQOT0kKDDG3jOkbHx3XMHbtj4UCzPjwjKjJz2uOHRo+s7koN
+fhjm+lJUi/4wgMqhiKE2SEb5YJChVdu/CzLYDGpFFs9KPMWjloROxhPuGotMnU22r3NQdhdXhw4D
+3iL3l1SkR0UkRwdqQrUReeVTFnSNNJAdJpQ3uutr03pLIW2M4QnG6HlPo/S4vCde3mgONRuEX7VP
+9gvp0sZ5o0flzhVL+l2oSM9rFx3uH2K2hOct6Fo5dtmpHrhP2LRew7pE90kMj46O7vXCvKd9bP2S
+Q33Nip903shsWWiP3B4neKPjEN7krMMFF+yo3o7fkce+TNfbPnkihskAqMX2BCtddTq2iRzmJBNm
+xpM6pOX8iB13ssqqCUbu1UVLqFaj1WtsmuZ1wt1ViddCQxMj2lrbJZs6mHqO2ptp04iScHT+Atvo
+kKxxtqyuvSzJ5QVjB/ToEBlmtEW2zU2cZGibPyE/u0tIcnBYRHzF/I0TpadmzLD4D1u4pHbn8TxT
+pCYqMc4nVPLn24RHhncQIp3WG0uWpoQmJuYasnqMzrTtGYZRFNocfYKcI++QwgHB6HuU9cy0eb1I
+8E8vnygflJOSGG8Iy+o6af6cvfjjouyshdy4nJ6mimlPkQZynzzvj/LvI7xjpyky0qQy+oYGRvJh
+ockAGCa4fuD6cu2ZrwlAdI0Es80rDUfXJzDbvtLgZ0YMmrqhsGDqhtd3Xvz8wM6Ln6M/5iwk5P05
+CxCPnJ88+RZxffLkW0iJmzzedVM4LtyDdABfRNWXbkKSGI1C5fDg7L5bQnoOYuUQovdVHqJl3BTE
+nUPrKjelORp3dc07UtWlsOdv51LGWNc/8UvlpjQ8BbWZU7kp7WTlpjQN/oBUV6Yu6z80jRNqc/KO
+VH2c2LVTTllkSmXGKxt2/SInObfTN9jjSfmPBpCr0XVTyBR+Ybev1JZQXQi9uW40xFJzNQd1RRY6
+ciYzCGdNZzdRo7DFkBbKnxmUGLVKHRLbeD80LCz2EXVIHLciJCQ+17k4y5ofGcqrQtoEBHSIbos/
+Lh7Jn3QY+biQiFUaEYdoVLErVSJXkxaoEvmw1JG928WExERF8yMAEES46rlu8jl7RA9xhQQhKQpp
+5JDPKTJvHs4MbFORtXE0DBVMidIGmOMx7uIXlhSqEYOqNIlkai/GGnqEnJ7aOyQpQJ+sEjbajB3a
+pfiFL3PGkcbYoVHRo8cGtVMZVqlE7t2Zi/LaRGWJmN2LcNXzp4V7bNWaZdUkfhTWyZzpNe4bcQlW
+3s8JU3t7BOf8rL3DRcYRn+mnTw5TBwTwh2YuahcdEh0Vw0ebw/1VjI0EZ9+tsw1DTT0G92HjQy8A
+fI3dE9cBaJv5hlEO6htemTGYngMbPAMt3+mcvmMH+ksYNnj69MGDpk9rrJ4/n7POBwwlrqvSECEL
+fCECQMOWbeiXgHQSNTzlZRwwxPAE5aMIFI7yST35nnzH3UbGnzEmI8lesoeMRE+hMYhHs7ixJJ84
+EYfqEXqdLvEYjM2XeAB42AHg258fBb5sVhAjn2WSL3khzkBtcpNBMiI6CfKlq+FGtAMvJyHoFdJj
+B7f8RTTJBTvQvLUlpEfJeu7jjb/9vom8gYZsIv8ktzehUfyoJeTkYudtzod0x1bHku+/55aQE2gA
+GUKS0UKyHH0BwEGc657wED8KguX96XirPMapaXB+fWgaR7tHnagE5lcb1HTaZkzPsNDr+yF0HiKE
+rkkM9w1aqQ/J/LrYZ0q7/DkrnB10T5PjXUrSqmY9xQefdBpWrixJzzUZOh3AAc6PDz3UpifKO+b3
+i+rCGCe66eA5R//ZhxdUEU0xOcbtBAxAZgsl/ALwYTe8Q7UatUXFYU7FYWOsZKTdcIxWo7VgDqvR
+taPDey7rOSW91/o3nwmr6j34N/TCmmn7ll3s3ouPfI2UkqdJDdqL8pCz4QyOQB3wiL1jBpU4u6An
+UBDZSN4hZ5xOVo/omqFZuKrcbae7k7Qo1CjxAtfZ2cG9GVlV1bBRuOre00TKnmZw054mcu9nXr7Q
+uOkC70emOe+QaUKnpr3Mho3CTEYEQxsyTxwqvAYCqAF8scEX6wy+bNLki6zoEKdyisSBGxz3hB2O
+NvQvr//rLU5y6slnXDHXwfGx42nxhyr6p7KSG8rpFgOCJ103hZeFe0xzrCplxFnkqku9V0Qh9w8R
+TUZ9nXerUfg+VI7WkWpyYAv5a/oMxzqkQVryE7k7+TBPyPRDeOnQ4WQ82Uq2kPH5va5VjEJpiM7Q
+0+IBALFzXM8w31X6F3Fb6MxR72TUPxL3GL8Updy/YzawG4vcMxec7bn9QkclXokEwB1W3uPbIu/3
+YClO+faOmR2RVnOHHRX4mnC1oaNd6AhI9hPI1oHAwJoEXYX64VMyhoz9FKsu4nHOJ7gg/LYzE3gY
+ASD2ZT6ytKCHWAAkXzPF0B4hgbOk2bQJRgNn0Qoc2yikxhv3MDp+eI6+H4klBU4nikWLGyt/e+6b
+rb+T6Y0XGvGxdyPafNAtZJxGuPriw19WzqVn8oSrVQ3/6sav60aLt6bxpkq7GxWMG/76VC3wUOK6
+KTwnhoAfRIMR0gC0sm1Ij1sjg3ylFMtWLLvfY5BoY+e8ZozG2B+iO8RkHMvrNI+rIccNA8eMefji
+nlgj6nrx4UGDTHPRQEL+WIl8nWmvhoU/Na8mOrqT7alwPGdPv9mDusRwK2O6LHP8dLjKnIoCthUc
+P456pJqrcHlVwxFhGKlyZHDLlu3d03fOhIny3faRrpv8Vn4B+EG7FtxqZZ6YOSRb3h5bJ5gai27b
+97OAgaFR+xOM+w73rpi/uKRUP3nK1Mnk/sKSYOuLJxzfjuul0jg3hmDklznwmb7dsTnbtmFCZCjX
+Wdd2mbOvLjq0XXWH0NDa6Yd6DEDaA/s3V27tVVBS1Slt7pDZauCALtwuFUPcd++RRTJyFq1Ra9Aa
+0Dv1yfVXVh5fGUeOf4YGiiG3GzO5nQ6eHwWcK5QUKu8FQLjyntbIscvSgtHGRNMa6NlVRmbchZUf
+GK35af3IjRshqRPNKZG30cAv2leNFUNuN/yD1zR+8OKwYHP+/Spz6uP8kcb1H48aJa+DZLluCvVs
+HDQD+KIgpDOma2zIomcbqPKVnpYLXramEwynCZ+Vf3pOGbnt2Djh2+W/oLSVK81pX9QPfWr4iD09
+Ji7PShs5/Mq+ikN9cC72Kb44awXCi1D0Y8UjyE/XlnxdmbMw+8CbcXEnU1KnF1nGvzd4f9GW13Ny
+ARAMBuBWs7EmLtdX8PXx7wUi9lOdO3flDnXelusjrRK5VfAKbXpX3I2PfrjVjnfwYiKhv/hRjfu2
+oulb0UV6j9R1UxpF/af4mzgrgCoBFjC/E0P5dc4qJX1OK+nDm9L9jj6QboIFNF0l+Q+UznroFAn3
+3OkKtgTVCp1yT/qcVtPp++UPvu8/2Ov98gff96SP8aRXt5qe4073L2g1nea/qJX8hyjp49zp/oNb
+Tafvz2vl/WFe/M1rhb9hXvTntULfnT7Fkz601fQ1rpsi9uQvyeny2g+LkSdCovs3Z2qKmQeJTbHT
++Zc9cJMMp+lcO3e6NNjrvUx0u2ktidHPdP8WdzbF5oVEQXDDfRI9cJMMp+ni4+5037MPpJvkdIrn
+k+ThQ+/FRxa60RTDlvGR5f4tHmV4WQofEW64T54HbpLhjI9jHj3Ia2Upnhh6Jnr+DhLc6UIdSzc1
+xZxnv+XY8a8BsNiDIvR3/8a3m2IRQn80ww3niz1wkwyn6fgrd7rYzeu9YvS6m38W40yEYo/crzK8
+Yvl9vocbLiV54CYZTtOFFzx62P1AuklOp3hSnIePvl58jEQfNsUSZnyMdP8WrjC8kQofHrjUwwM3
+yXDGx8UW+hrkjiHB/cAvUInQy/2bL2HpvZti+bP0AjYOzgfwXcjuCMo7yMxsai//MbitKLoCLz+J
+VnQcbScTSIFzPSkgE9B2dBztvY+sKJp8TS7ev3CfXCRfo2hkFa6SHWQJtRvQJLQMLUETGz6k5/dR
+ItI3zuPXkNvkCvmI3ALOXSdADeFghMbTbHJCN5d8VachCPTsOUh1GqJBYM/R9Oyuiu6C/X+286Wq
+B41MTQ8NoHdT00N70EMm6KEP6KEY9DAR9DAf9LAG9PA46OEZ0MMp0MM7oIdPQc+oUf7ibwDdJYtV
+dslOQ1vwYc9tVadBq+ykaVWnwQ807NmP7qTp3BrXWdiRXSPn2VszKEWDtjapOx/BvV9c+QP3bt26
+l9xCe92FQ0aj4hNubR9//f0P3sB7nLeWrl+7FH3hLhYAAMFT/lqIZB4c+wEgd95mo5sdM6dwYTZq
+zcoUUfmL1MqZXrPWqlQSs8Wq+LA0C6MUFpt4ZswOvNpUf/AcmW3H83KSpwrdz3f9cg+BkMIk8Mjj
+qKOCOHzd9cpTz77EYUvXrl+K9xx3V64Tb3zw/uvN+yEkx1hd7LrJ/UbbAQ4FAASzSSFNh7bKrp1y
+Cp7exVKLnjMOaaGCadwPy1xA7iEVgmU/jHsnIGCu15F4YYznhj55u7zCqUKneD7OcyYeFF8c8lju
+HoVM8iiuEv0Hyn6/4JKYw3cCX1j6AvYVwU915cqltFzfXJzra8ZmX74sNdcPC74IRKFW9KPuYtWZ
+mZdUl8y5AT6+vuAj+Ui14AuSoHJk+4iq7NwADmPE8RxfizDygOtU/YYWwQkO+/iqslXZL/jU+rKX
+7rzA1WKGd6dYa0V6id4Hk6rQmprb/BuNXW7X4OEl9If9dg0AIPQkgBAs3KD8evPlYSsVmokxCA/y
+zcE5vnxZS3751vnlvflFLfnlPfzyqjvFyIIkE7tmyiWVoNU1t+w/1gg3yNyaHxuz+XM/1rB7aHBJ
+LPDoF0RvxipwhaxfXxEjEMRa4f+tfpEOmehFOJNNLCDzam43duHfuF2Dj5WQeYqCEXrS9ZcQzNZ9
+l3rz1Vy/IHpXkwJc8P9Gv4IR2fTsMhe3qoSq1X6rRkJra37kzzVm/1jjqb9Uv8Hw2UnJzxcHg0p1
+5VIaZS6wABcEV/hVSIP8BkliWSrkBkuY9wsG5OPLB/K1gSoqlT7zUprqUpo5NyAoOBiCAoMCayH4
+/1R3boC/n5+vf4B/QK2vr58XWJQkH1EQhVqqiOaF85K/nyhxOCiYCsyEDqoNDm5RSC/41/r5+bIn
+sVaSfLwahskmF6DSPLhXbtegNbToHD08zeR2DZnHipM1F1aeP7P2Egxf/DvxU6Gl9tQVuCK4wK9A
+GoQHBef65Uo+ZS119H8UcQsd8a3rqHmF+DsdNasYio54j46aGqNNj1il8TTJWzWkhtUdd9N0dP+x
+hsyltYh7Va5DKAbShb38CbZKZaOHEiSkNqKYHJxITnLlNY4Dz/Bzcp5xHKjhysnJFvh6tVGNTMhg
+FUZ5UPgTOc4rqC/79Uxz/HiJegq3IbVF2OuFNDvnGZYR6tuCn3gTXQDT0836Cg9Ouhdn3n24GgBx
+Jq1BLyFWYbR6zqDj885v2+F00YpBKwiuOr9tB3oCJ5GnhiOR1iBWb9DI4aTB7tVfUVr0fXelo3St
+eNb5bTswctc7Z+35bTvIOHyNve2peowypZUIl8U9XIHiX4cudXRFNs/RcX2oXkpo2naWPIfmUui5
+HptBJ6CfjCntO1kTzb10sdlZA/sWjew9xJYdu1tnNKRReEqcURedkzai98iivgOz9j3D1ILuG3Ra
+ra6XeVB259iYmHhb9qBuZqNBr9WGxBnNffKsppiY2M77yEmcmPMMAgAEoXBZXK3waYu3GmiZSmLT
+ZpMXkyZv9ukmNF3k4N9WyO2TmRyRlhOtM8alJFo7tU8zGHW7Y7NtQ2Qms2N1vcwUnoLu70PPsCqw
+j/Jpsub1MRvjQrRavcFo7jYo2xZP+cweZO5FxYEW5axFesniHi2RUS8h1ifcQ6NrbuObzsjbNWTv
+PVoi+BiFU7D9dg0aTYuO1fmnAAS1cIOtkiLJpHOPZMgqmRC3irYPNIb1uGjUrRq0Wm4898iemh+d
+bfG3P9agMfd+rEFroXmd8aUHo9yjDLKYbEgeau6RvTW3nZH4JmXiHuUCD6dwCrZTZimvjC/XX4Ka
+2ecgIJve6h4BkM6mR1wKZYLyYL9VQ/bRts3Gg3uUVfyts+2PNWTPPdq+m+kriN41p8pCel8kIXcH
+in9Eo2vI66hrDdnrDPV0oQxIXmfKAvCWL0iWz0AP9diQwSOcM5TsraH+amrQaPxjM+FQV9TVI503
+T4EAyNNQ6b014bHGH9yNlG9jt+OkpsZZ7DjoLjPal9PTQLSoWI9Hl7Y5gRYR6+9QieOgnQ+nRcMM
+ke/t9mYyBMLKl2hoCFzLB6mu3EmTjRHfQQEVvhUBsjEi+PuJtQEcos6UL11yZGZeUowRPza4+zUb
+7wIDAvwDIRBq/QP8WxojgQFeg7sfG9MCawMY3p1iRM+CWdRKByN0avzBbufbKF0LTiJPc6XkaXef
+AuCuG8Fs/gjI7eqDdfecQA5zpeSQ3NnX1NwSOtrtjd+7O3pv3WlpG9ezDQGDPFAwNXLH6LtomeMg
+WkIJNOnzdUrFbmcDBnartaksu/Ir2SkKa5pyAkXuxjxFS494sKVI5hJE2zU9LaVjUsckU+fUGEe+
+u7zRp+jT0DCtJkSr1YaFcjfsobqk9tO7ZE9Kbhce6qkGfn4adWp4RHKIxs+P5T+XlelK5k9K6UPl
+MxL6UIun41b6MKtydceC9kfQrPz8/AJU2rDQCG6Hx4yY2C21sylJFxqq75iSlt6Vu0FzDNdHhOvb
+Bmv8/DwdfGh4u+T0jOwuGZntk3Sh8hqtWxdh0BUANb9/Z0pwcyd52FAORsjHJeh2NHUJqiiN37zM
+zaOfRhsWmhEaplUF+D2j8NWcy2XPOLd5BrdrMsfhEfpwtfx6cFt9xLJlCqsy412y5V83bnhUK8vg
+1qcsQ0ve3Zptj9yq1RmtxpbqdcuFjbJ+M7wFiUAd8RTP6LntmWWsOuhDQ3W0QnRTfuFryyJkjXv0
+z6Tx6P/GDVo/MmkJpNMaIv/q0sweLgJARkWfFrluyi5lvLn0ALPcMrnHYq+Rz2t0VqBopZ9b437m
+OGOcX1hEmJ+foIqJjTclmeNMMYZgwY8BTTGmJD+3wH5B/oF+0e1MJQntomODeT+/QP+2/oF+vkJw
+bHS7hBJTu2i/QH+vbk+jifMLCPALCEwyxqs1mpDYhA6BARRiCtZ4dCGKfrEhnkRRFEU/X1WHBBnm
+J4qyTs6IBXwv0FN7qrl55DFvVPtmkFpPezywct8MVOVmnJSf6YysTd3x9M7koocBQKiDyyHsFj6h
+/ZIvNZoQF69HJmTT8r5Ow+6ny/GXr6HAMSSzmLy27gzvQ4q4mkjnXXS4sf7MOnJWhc6PIb8AIFQC
+IPgKv8p+SY1qN6OCr51oPUPD5sbp3A/eFRehIwDCSOU9j0RqIzqCPW2baO38Zg/PjjbsPbqP5sv2
+8pqNRYKvc6JHEz/ZUQ9PbtwPcn6um8JI5b2mLtyGjtjRT+4MUQ9+s6NNk5boe5+7/hJD+Cvye25L
+3WDlT3jQnFf4K44u7sy5NnZ3nRZyBO4BPnOaoXrVHCzHnUhC+8SxQhE7P6Fk526nMUozNaY3a+M4
+0zNfSE4qLZk2BcGUaSWlScn2vLzswr5nBg7JzTUacL17ZElbPGNyRWFqcnJqYcXkGYvLDuRlmQwG
+Y07u0MFl8hgxCO0TVyk8ePcobBOrGS+RCo/8AoMxN3fIwDN9C7Pz8uzN+aAZ0xEO16eVDR6am2M0
+GExZeQfKmvORpvAHGL6AdHGA+wyx1mrQCZ4ZzhfC9IbLXlMJYVZlJWma52CY4dov9hAqFZ9uzKKj
+loqaljUtBzaA07KYwWvoyGi3U8Wh1xvvNo2ZvAYhOxtbBzp9UAEdW3ERHV1JHf6THKdDLNPTZVgs
+thFmQAaA7PyA6SRNGT2bJgUG71GjWe+LcY8Yg6eY8vIMsXlGey4Vz1iSkpxsLh42Z/wjS6bMKCo2
+JycnlfId8wYPLitxl1jegZKywYPzjNSQz7UbS2bMWPzwjJnFQ9OTk80jimfOeJhqGBDshsXCTYVP
+29+wkoMMTZOIByThX6gpTaL8FM2YsuSR8XOGUX5SStw558Ua8vI8xW+I4TvWVEyWmRlhTk5OHyoz
+M6PEaM+lswejIoa7KlAxaJvBSczOFKHfCSyCRE25F3KFAgGXpb6IkVArSqC64si8ZG4xe29uw51k
+k24Oq7K9V+iU5TmctIw+2D12Lb7G8hNwi/wEEdVimt8dlh8vCCLP8VytHNyhWX58LU0U3PnJ85sq
+4Xoja+r4mp3NYBAnKPacCKAsw3HCMrYG516BA/d6IXdMwZMnONwxO62swo2GOP6cPIVhfpKT2LjJ
++BexRNe3XhgkVCj841rUpK//nH8kz4PEgoZ4utSG1uAke9NcgNnkis4wiM3zxEisFZp09kAZ+Up/
+U0bKKp97iQ9fW0Yf7M11ZpAnVZzA2qZwg66nkbnN9WWQl9+4Y8vY2pu88MbiBbr2iQVClcoUEAFA
+rqikgEgAJRbQPNdfYpZwQ2UKiEJs1y+gLbmixKhoei9SeS9Cfo/FB9kn5ghV8hqGZx2YfSErqnS5
+F4SvXZOXhck8vAxc8tLwNblWlFA6NQBitjyvRVrP+iz7QhauhJRQMewff2JnFj5/A4F7vfaTTxqz
+3RUCwVzXPnGwwo/gWTdlX0gnDAGXewH12jV5GRWtwctc8koq44fMk/lx/SVmy/PZeORZz2RfyMiV
+uIDNV+yffGxnXftfLnAvcH7yibzMKcecqYIgsYD/XWUKoHuVeSopoDcAnIHmaflKWj85TbHLaT/A
+sxMaBklviKdalUw2z0hqo3rm/nA+PnEiuosS5uMQ59PVy/qQD8rkwbUMp1cv64OG439OnPjRRzUX
++i0uUwbasgv9FtfIZ1yq4DWxgO8OemgLBmpn2Yxe9C1uq0QrGWym9kjSGzhpQ9c6dw5o2GD2i9kO
+ZeRITX+0b+JEEkBGTZyIhr2WfsST41f0WbYWylDfjyZOnDiRyeme3ypy2kwGgZaWzWvdlMpsxa/i
+8RMnEg35bL7zDi6mgpnd+TovUbGfxW2a5FRycsvZbE0BuWsnq6cS2sQfa3zHLRFvaxw8Ebep8TBe
+M7HZukuQpz9SKqkJ/1JTymada2vKaybwdY0FfKdyeb5Z3nier5vQci0C2UwGq0c6qxA3cWLjYN7m
+lqbxHSpIjUeGGvBeX6Hv621KPWTVEv8yaUJNuWJflNag+AmUifPlsilRznfy1CeavwA6Rc+S4NGC
+zAvaxG2bONExZSL3iLPUU4cOKnxRpiZ+7VFLmWeGj9FB1z1BJdxQaKv1NrWJ82hI5hP/0urbfJ1j
+ysSJ3LaJjgWejMo8mbM1VdeHIsefkNdwJKRjxoQRSciI3uEKhjsFunq4HDcOd9Q9xs111A3HjSvo
+kp5TGM4VAIJXXB+yNVmqN6sRmZCRDfFWZOJ/f4yhL3ejz93GftPFvRVOYbhcb16ha7pCqJy/DVkt
+aslgtSAbsvC/e9CXs9weQzMZRyucV3KeYRzJa8IiJ7+PdBakp0dxTTTYkx698xhDX66go5nbPAKt
+wI3Dm9fbYKXeshprRfQvquRvNMat5Yc4EmR9cZ81HsVnatbWKGqu8ap3wfLaC615rLANVoMwuDGO
+v7G28Sj3max5RwI/BPei78ulU+OVf5gcA47988xP9Tb2T8xp1+sF+t+0Srddv04B4CTlwWPjK7+9
+20QYtVJlUjZ3j2OUs5KqpzH8XuvcE5RKBYCT7MqTZxai/G6239jGbWd4uUa0sn9New2oHbv8+Imd
+fIYS6Me950Aetdvt9IKk3W5Hs36s8dpna8P8ktv0HmoWTw42PfcIGx1kqqidXaZKPvtRXvZEsyi9
+YeQIpf7ojzVyP+zezw1k68SeoRRZDTqLVbHd0Eyy8XYNsoPLDq6mHVY02m5n64UcelLppxQ6TVud
+iDkrVxu5JHkopSvB9C23pFQ+UkjsLnlcaNr/DHSvD8udVrxBZzTpjIqdQjaimbRkGt8Dl8vuktdP
+2SjaTmGJ8qT0XQotwbM2TKMQcWqLmktilgodM5fZ7XZFSVQ1ZC6XTgg6jMAlt4fJcFHM4TPZWqLO
+ezIj5nhPX/A1ZiySRVwpWaKsJUI1XBT7yO9qvSdFhmrveVDjXXyNEkJLHAcRM6EAMNhgpZjDLwKR
+vu2L6YFArS+7EY7eJ78jPz6g8X4V4ZGDPnDrye/k9+UoyLkQOZCD/Ew6AGBUDiAUij5uGvSUtODL
+TknzVQ+8wM9AfuT3xvt8QBVyEJ4+UD7yYaVY4OYDsSPyMhtqxNdTJORHtrC/Dm79cvLrcuRHeKRG
+HyM14SkPrneFQuGq8j47xS6zYEB8VUt8NJZKQ35H09hfHvhmMqjlsURND2pzaosvsqgpK/SeMN+T
+dCA/y7JULZeFm0U6oFnkVxSE49AtKhN5GN2iQpIwcoUcRKVV2+T7we48/Cj99mypgvJoEYw6g9XI
+MhICHU+cd2dw3vnWebznvJJNKa6jxJ0FVdu2cTWUPq2DuQDCHNGHecFoAxCvkDIZJAsyeHJAFhO+
+TGntryI1VftxZ5rLhzgch5MPzzs/vTXnz23buJKqqsajqBRvweHO753TOPsc4Lz0ooYwmb66OWk1
+yxCfO4UiP5mD+zieOEUI45+cIotR5Kk5f27jv/2kcVOTANydxrbbuBqAFvTpepisboGWG6VPAzT5
+IouN+7pJ2eRnFEZusSxuoTDy8+S1NGntkip8n2rFGUAfwlhB3EIPU9ASaJaXH7SB6CZd+SJFHiVH
+qi6ap6wxOV9ZYy0yPk/eVbL+c9s2PIrm5DiMStFOd+5kAhfiyZ/ahCvFhfwiufzlECK0jus4yoWV
+VnhddSvF/D437jyttbT+nsedzzvHnF+O/JDfchbnC4CtpwhsJGTjQNOesTigYVMlivVeU7la2fCo
+cJXs9V5XaUbDwvp85NkXfkiYUUm+8NobrhRmNXREo712iMHd/kQf8FW8v9FSNPoiE6sk9Mtdgoz1
+aLc87qqOxnL+TH+/0U6HdTi4O9WDd59N/W13U/rs5ivANAaBspbsXkqmHWGcvI5GXf2atAbPukeG
+0sfvF/0i0lJzu2zt3D05LcJPPHOmBQBV0DHAUTHE2D4sPBhNUYdFxk0gO4PaRMYZhxjjItvI40JY
+WG5aakR4eFRyWm5YmD28TdPPNuF0zNgdrAlvG2XUt1WRe5HhbVXBwaq24dBiftpWmZ9GyfNT2q+T
+OWKBuImN9VKLSZ9iaUuCWOBqOflrmgK66GjqapoGumeCLpdsg50Vc/geTeOy2hM+3L0labGj70m4
+3flu03bkVLLVTraiqbeV80RnxQKZhq+8zOsOBt60HfmunYSj7+3Y4rUVuRVNtVNSyj4rVCl09M3X
+cA1e686cYEdrPAvI8+xkXtM+i5tUiZt404p0k5xtvOS0eGQ1esmLnqYWTBT5hlowxc1k9sj9oOxt
+mmQ3euS3NNMBKaYUv0FRlP7TzfXg0UVr+vB4NmquE6OXXnDt+qr1KMajma/WV60nXzXXzQP6aa4j
+agecE3P4XMDy3ShJkLsm9FQD6kvy0Bm+L1qN7jd0I8vRGXSGLF/G+rNz4kLPOzYTdQat9kVCcEND
+QeNJvi86Q/LQ6oYG8uYytJDkkTzgwOZ5R+6xbCaamdwRKu86tjfwIc4Mmiu+4KER4J27Qg6w+ww5
+YPADiEcGLbJoDVoLwq8grfP6PudbV1fj/as/cr79Nv7BMUS4SpaixQ0d5fa1ynWTn8buUU31dvDC
+vOwGQwIEQwYEQy8IhkIIhnEQDHMhGFZCMGyHYDgEwXASguEtCIaPIdjtZTcYfoNgwGVwGjTKUV6N
+6jS7k0KfOZU5VauSLwIZ2TkOrFZpPNfdjbGwCvk2Nj6SZ7igiRi0u3b37trdgyI0Fwx5jzQ2kj/w
+bfQQ2vBW5aY+tsfJu+QSuUTefdzWZ1Plm2i9O+4Jd07oDzFgBhC8r3277+5YMyzKFSlJRB6/FTaa
+JKIXN5ROHVV5vLo0Iz4wpvcrvafNmYACZlnb5Dy5hLyWmJKfa8m3JoYWP7Gic1zk8LEvndm2s0/H
+/MGlqwY+l3g4+vc5z81cTuprH0JDqzIyk7qOHVp87egUWdf0bPw69x6N+96Jwcqvc3TFfzp96Idd
+AEN37Oifsn0N77gO8OtEK7vZFcHek4w2o9WiNVgN9GOzBCPOokacQc2vc/aLtEdW7txJPkBVOwmP
+zVH2qPSdP5JvdqKTlHJ5uUJ71Cg70aF/OieTEHSH5bPLdUcsE45CBLQHG62TNPJWqArzbBFdjrel
+ou4Q9KG+yEyVyMtO5X3lY0RxVH2mBMGneNKSiT2rzpN9p16u62ReNNGek1L5Fhr18qk6jeMdfD9o
+8+NPkM+HDEZocBw33imYkjY/8TiKHToEoSGdhaxpu4c+dJwsIbvPVESZaj/KG/Y8WoIqz1T4Nd7m
+nvIZuHvr5l27NudyPRxDosMGyb+Y3x1XA4AwSngNMIjQKTcYOMQJPIcRCJJAV3EvqWlY45Rzl9SZ
+5tyQSjQVz0A1eDGeL8wVpWmwGLAcC5PaQfgIKed6OH/+CndveIK/23hOCG7IaZEHbWcUHR9BBxyv
+IIvzVWGc89XLfHbDL8JrDTmsvI+57oh9hKPsTLEyIOsMsYpfCRrgQB6ZdWiElNN3/Zpt+JSz91my
++8wZVHmWPm9bs65fri//G57dt2bdwP5oL9MjGnV+LxowaG1N2T7g4Bj5TuwjHmPerVKY3wMajY1+
+QmkWsSbOpE0wJbA8sxCSN8y1Nq28qazjP9neB8WRA6ew3nmsLi6OZtstp2r7qMTE5xkfPzo+7zV8
+aWTkvqz6uq1rFxb3EY/ZLuwl/D4SKez1J3dtyEcbVqnw5Xizb83mfFUgqt+HHAN7b6kpY3r4FHbx
+3/HjIRQgngZyiGuPdIIsuoGdi9BxHckn5GPUAbVDp0mvWxMHRnJzHWvje836Ep3e9fu9e78/itCg
+zo88utk8+FF4QLey+WOwGqivjhDMG+XAEizChLBf1q2zNz51FlWeOUN2n6XPsm4b/TmTW7dk38un
+yL5/p1uTTWl09Dql1ZCm1+q5UH0oy7MjQvJOIidxyp1GHLO9D7mByk85b+PBdXFxNNsm3ZLdZ3/k
+jL2GLfPSLfnOdmEvcuxDN4W9/khjI39qwyoVvrgsWbckfx/hW9UtiqEO/9oja3yaLHqCKUEyWPFW
+1A51IB+TT0gvdJrq1rGWmxvfa87npBc//vdffv79UVTQ+ZFH0ebUIehRAERtMO6f3DfMr2A9oJR6
+gBRzqnxNGP+D+8YRJZdBLZknzBReAwmicoNFfI3/GI75cOORMJ6G1b1z5U4qqBx37qSZtQb5TnUt
+f/We0+9n4bU/T4oL/1r+AA3uY+magI75COMlbjxqopHtcJi1ys3j2p/x7/fIPKnvX8vFhX+epDTa
+kHlClvAa+ENZbogfH4AFCYMU4CfwHAJR9AlQXTmnZwGjrlxSvUeD05tzzYO5QqFAKPGbzc3g5vLV
+wgzhIb8l3BIhoMR3MV7MzRBmiAt85/hvxBu5JcIS0b8MlWkNTfeWbfyPjm54n7Mv93PDF/iqs4/w
+2mtOi5M/+xhnwDdl/XyKk4Tr+BpwoD4JtZhHwKuunEul+0dmSutTATcQfM0OgOFTAOG6wMtxkw0e
+A1JnoGtE3NHDzss46TBOcl52dhQwWm5Hy8lCO75mt3vevcreHXEaBBDZMCuoZD9xoqoe/JS/AR/I
+8EDq4V5IOQ2BEMSQA5lPN065rULH6HqQPpCffT+gPt48C2xt6HITd5QyI7N1mN9HBDsRUKMdNSIr
+dY1L9zzwcXavS0NtfLTWtYXPFuNVEuSgtezuEUICgHBM4NlaVrxNoJfR9YFIilcb1YIJP0+GDUdH
+DqMjw8kw9nWYDBuOl/2x9Q/0M1H/sfWPP7b+QdTo5z+2Uvo9UG/uDPe6SoJZsNT5EcuzCBVzdu4Z
+legvxK8GBF1c14Q24kLwgw65YX6+PpIo+GMeg7+fryQKPAc+yMdfdeUSqytXLp1Ta/SZZiosMiLJ
+FI/40ldnO4/mkdcWtkERCMSFo/46zc1OKhjjVO7MUfrSXE1CwFPy3UyYjVZ64GPdcP8CCgcMN+A7
+/jr3Hfgzj/lpNuz2qCPolK3rB11WsoNBOmOspLHg4KcqF6+psmRkdOI6DuiTkZEendo9q8+cVcXl
+5UM39snI6diuHf3dL3fbnMVr0OR7SMwfMG5Gz154RVRETtywGmvu2EnHDs8aG2XItPSsseYuv0ca
+ADjKl/Ae9x0YIQWyWP/aKh/e/l/ivbhHlDnFoRX3FOWp72zK07BH3Tw1/c4gGbIQNlsn/M/HZi9a
+yx5RWSvsKb8iBngEQffvkQb5EQChCLgoPC7Qa18Qz1qP8HjjMjt35SK/yJFIxwwAsQ+b40M8dXOH
+xD4N47hTvzouXrggXG34DQDDMdc2BScCukE9SCn1oEmh19CClWtotFGdBh40IKk1mbS5yU/UbK4H
+3QenIVSBmFNp1GcTczunjP70dIvJgBJMtlB+lKlvtxHkvZto1K+k/8whZR1REXmHXy84OuDAqOiC
+yAvrVs4uJ1H4Jm7nHDJ769N1F6w2m5XL0IVUa4Hy+pOrQbghfAh6iAQrbcptlaYM1I2jzDflzpfx
+Gep5aqM8mVPptgflRR+vE0IlnSgJunh6oFrrSx3+cLOcCzB+tE3kusg26ApZ21OUeqNHSHzbqJXR
+852f4/B0XkLpaMhZNMIQ1d8Qd/ejMyfe8t2/9R8f/ZJg6B+Db5MfyL0FS/cAIFRH+uICsSNwAHRV
+BNXt2yd2/DMaWFxx0pfbJXYEf7CdBlGZg9DeCivxOHw+UMYhKeU0uxGPmBy+yhPzQKl2h5dDdfsa
+C8iL+5wDcCF31VFbN/0HR3tu/PPTAAAjFemL7zE+JIUT+lHt24dyZY6kr/+M9uJJCwvqIYRGI0mp
+hxClR4WUegi6IfNH44b43aCRSegVvnoQP6gHXulpKe8qN+9CSj34p9RDMJPBX5FB8jwFep7U4O8t
+F5ONRqCSZPlU+/btG1nw7L59+8oGoO/5no6OM7kqZ0Du9JrG09y1GY7H8R/d6B4M8gHgL/MH2LkB
+6mXFFyEfNLEBTXiDfEE+5w80lnNHHMMAWuCylT9kRD5v0Bgib5CdDfwBxzDuSGO5F54WANE5m0Zt
+9sRoNauNyOe3X+79dmTbU4e2HcEFbsPDWYdt9y9fvu98p1le6hZxQqjbdYx8jmw79NS2I7/d++U3
+PAQXON+hb2Kbs042Edma0ADupBjEVqi7ojR6Z8EYK3FBKJbWZUuaDd30ObBjUcg2dWZvn25Nj/w3
+Tz+ycH3wsmMNdcqDvP5UTQYI18UgVULASbb6dBLHu+E+4IGbZDhN5/vK+ygKH0mQATlyTDPWO8rs
+uA8ice6TSIw1rftolNZ9FJfTKidZ0U2foYP6Duhtk7kNqsybNq1wdnAzYHBZn2nTh1av7ZLYs3d8
+4quhXRLz+8Xz3zzdo1svGxWIfBzW7+EttdOHa8kZDxClhvWZvqt2dqHWuUuVkj9+Uu84DUlHH6uT
++04Ynx8HALxbBzAABkMJVD4gjUH7H0ln+DtpDVpDk6CcIntLidGi4P9bBUFkWas6WYMWhZFlZ2SV
+cIGhTEXeqvkG2f4PPcWRN5p+mNu4lbYF/UIMHnWh06SjokUQ3HUEpsJMqIZFsOr/1Nz/qMm/0yzS
+Grj26H/S7v+m7r/TP58YRpaFOa97F4OQ9n8Uw39ZJv+mgIL/tpCUdeT+fLIYDBYA5FY7Pd0pGU02
+vdwOWUAiRbtUl+wsqFYOLsrFqrN6+qiKc+aV55/JeCW/dJAlMdFnX/6gJSHbaErH3JTCcVMLp6pl
+GP/7smPkRFhOdW1UWf6rr+aXRc0eYPF3jH9q1UMbg5cd41arkgtn7ZpaqEYVFCT3JXykGAQ+oGYe
+6N2lGZ9u4roim8mmdXOts+nZuoFXf9fUzFh3iF6StY+W7AztMth3Vf4UymJQcf+p+flPh2fTEt67
+U2FcKTwZgvoyBY6fkB/3/eNZpKNqrHZ1Pi6jRRM6YPrD+flPd88p0ZIzihhN/QrKPrj6oY1Kn8hH
+Mzk0kOUlB0pGTBDJJAtCAz5LppD/SIypxozE0C4TpaH5x5kgles0B/P7vf6fiIEy8swa0lG1LHdY
+Pv6Z1bHdOQfz+z189t+J4XLBfHiX38NfVZkCC1x7yCP0L75HHlFJgQWoN3oAB/V246CNFMe1R8HZ
+D++iOfzHjM5baBNLe4s80vJ99p5Jfs87L9ceBZfNJflJLC0ExanEgN1sDPoU9go3+ElNfoJ0Ri2N
+6WfQGQSfy5fR5cuNH3BFjsNoN+EuksfslNYn8juqBEqD5eokI9hfguLoXz6JjKC588kojuYNe1ne
+CQFvy/hYK6dTTug8F10WrnNpzBeXHKQ/wR3iiUW8c/vbzBCuTzwxetoWP0kM3lVedGQM/bXVX6C/
+Cp/h0k4M7JnFc1js0n8YfRQwFrv086IfDaCN8txjoc3SLAevU077JpjMn45/prB8V7Ao+G+dNvrE
+xDFHiugvyW8L/cWlDe/XRcRYyOo58MSw/l1EzPFZPeU79wdQqqjhrrB7O7LT7ihkkZjLQ7kHkJ0Y
+JSOjjXpoDmGdBdd58N78IVV4wkA0sba06tFpNa93za1rDjmHk+fMmZLfpXjXoCfy+x8oXVg0coP3
+TyofjBCu888xewgbYzlsiuWMGkuaVqNP0wrXFzR+XzVu3Jwiu/sBX3MuQR+jG4gY8RLSgcQh9DkA
+gg8AsFnAtBwMRsq5+8JPgsmiOOKVDz9j8wsDe3TmeSxm9x9GH0WMxey+E14YNW2Ln48YVEsLZ9Lx
+MdO2BPCi6onyQruy1rRbuM5PAYPch7pL16w1681cU1A3k5kzm8z4+8oechGXzT+y6/DYnoXPjJ94
+YvSo+c/uwkNjWAl3RIt6kfUx/YafGNgzkTzSi62DIJVwnTsAkRALgMyMYApqtTYhM63kcpVSt1aj
+GseyBZbptDJJLaoVWn0Y5PxGCde5+xAOUe7a2zy7aMzy+pusgkObMuLuHx+kZDT0+MCeWaJvl35k
+sl3e9/4UxgjX+cvQkXmxVPyeKeqSqCBmyWQzG6wGs80rb2TWIoG7GB2fX3osYOKJ0XkFm/Bex8+n
+1kwh36HH6zeXuPmw/OxcyE8nt/2TimKPD+oZetpOqp6w/6uTzEko6uPYAEp/US9c51+DCIiB+L/R
+sCIy5Upn/g8Epx/+tRbCSzlU+Ivy+lSYkmeX/yNHo9VsMFM1sD96t2aUb5P57zlx9P8BPfah8wmu
+zfrpWoRIvw/wI453Dk1vjS9Hf/tF+9rZevtF+77ZtF67fheuC3mQq0SiyrCZm5eMJ39bCw5NzcqK
+csyP8fPtN6rvzIknRps6luKOzoem4F8dJyZs6foXGjaV5OC21WlNvFeu3MLHoviosuODekrP2i/a
+Xx84x37R/lmIwm18w0BPm7gPkZAFObnRydGZHSApVotUEQFRHWpTM2tj+dqIgFptdhQkBWerrmSf
+S2OLjFfu3HBcuXNWLUfOZx/zv++gtf979z2+lTQ/QWJpeHqrXTv67PggN5iJy2OO9vhyXyMI13kX
+u/Xn3bVI3j/knkbr3Rd5Wk+a3iz3TLw6s6PMXm7/vltL5MflC7UbDq7J7Chzmjsgf4vclF7fp9lw
+kBsd2G/48UE9Q17uxPqmLF+yMxeVBzLeQ15WGpWExuV6t6l3IAJszHb7+xqu/T+a29/Xb8r0zI0t
+Et6yePqe/g9Wc/RRK1X/rt3N83zhOv8h43no/8rzv0n695L8dyKia/+ZcK3BvPu8KDBCO7cNYc5B
+zU0Is2JCIDON5kC/ZGMiNNjblFAzS6Kp1xveL0cSs3oOPD6U2hNSVs9B5LzdTtbZlX7/E7gmXOc7
+KmeCYuKtvBATr+OFGEEXF88L1jhuJPma1KLxqC1KQMXkAPkKxaMS8iT5gnxFdqMJ+PKh44+pDx3f
+ceLQ8R3B+ODxHcp4kiFc59+DSOgMEK/sdilbinIDpoMJa7laZZ9R2RRLc+9EslbOjeye1Ckqgke7
+yO9BbW2Fxe8Vj8iICiC/o1o+IqpTcvfuyTSZVCG/gKiMEcXvFRfa2gYhPzKWJid9ykcldu858nC2
+eWSaxZI20pJ5uLRHj45R3EA+qmOPHqWHMy1ygjn78Mie3ROjPGMu/x60hRj5ZtnfckeHXW7kv2fB
+mcVKouPfZOi8yWxeOU8Xs1516JRKpHlTOGAPXOs8rUqgcMW2PcXSBeELOd1F0KmmdN7sfFVJ/9yd
+7nyVpmNQbGf6/vsoQ0rj3mfp98ld+S+sUUkQg0Yod3DeRRmShbtE373P7GvhEVhJaQiPkLseG+UA
++DEbhU6nLHSCb9ab2ZV5Omcys7v3ofoQ/H16+q+W9A1oXgNBi9pEkCUCJmvCEW4w9+ltNvfunXbP
+bs/p1DXr8OHwik5yXGFGX9SxOYTa7Z+Z/pMjCKdlpCeY0pSb9LJjr0/dDQA/kl9VtblqbH7WZFOH
+DocfWnDwae7A4Xt2vseYXj1HjVo3ZmbHdu2qOs/ev2fu3CdZW6R5Cf2UvJpyitHrEgyiZPUivQzB
+118RF/n+NiPJ/XPH8R34MVb/FTriYkYnqImOrxzhxUMExSH+BcTtPVPPaOBleLezksZQ+Tc0mCNq
+ZPSicaj+zF7ifIGREKJpEBZnpef9Cy1l0RoikEHSmiSth0QD6lhWjjr8Wn7/X2WyfgaituTbOvJt
+XR1q21QGVxgtv2bccAbOggxeajmEBn45AxmcA2VKZ8lAPM4ZDZJCoy2jEQFmsEFvGORFSWeMFSPp
+DFEJk5+eYeF0hgfDMUvycq7J6gndzMCiXBViPazwB3PiIqZO7Z6Tk9PjJ/L+wLyEfGNcX9OomZNH
+Dq7onp1qy38rL0+Xm9intLRPYu672RO7dJko8/yKLSouzkL2oVGWtj26EHtMj3ytpmuwasaoyjkT
+e2fk5WRO/Mz5QZc445wz1cbxW4cO3TrUo2/ZJ24HL7nkULieZWjvB4uOJTZpb6+hLGd4aYkts2dB
+ia1zj4KSkqjCzllDZba6dOlcM528iPIHDSgbTC6g/IL+5QXkAo4fnmbu4t1WPlPm24netUZHD+ak
+Z1A/9FbveO+6EJE60KfXi5v4SB87dkxF26SktuF5GaYSc8qo9NLpcUmJ+hCe4+TqjhafH5jWrWNi
+REpYks1oKG/bdvSghJ4dO+ojAv2iwmUbySRc5z8HLXTNjQ0M8OPw+zzUigHvB/nVakSVT4gmKDDA
+h6f+vIRgCFHdyD6XJu9tZl9Kc2TfUZHsO9QqNGsNyn0pKgc75WAzfMrxCBYeRSkk/w28wNFAyMKj
+5Co6+YZzFXcAZY27evienbw97rL9npcN1F/RiyE3iBcB+/gF+InYh4cA1Y3s7BvZ2TTvG/Q/s9Yr
+4JRHK8p+OI62O88q/rM/db0nXBfSoSMAkqdHD0wLmoxzOZ1+c485e3BhSx4fjGLJ0lnoF+eL0/Ap
+x+2ljw8hn6Ol04kK9+cO2G8N22a/aL9o/3HYdjpTYeXL9tJ9FTmCoX1uCJUkIMjHTxXkJ3KYKhRU
+qhv67Bv6JnlaSPSAVPTDbaOSOfrRb/Ds+fOHQQQ/GJabjGs5nkO1gHj6ByNBFKBWFPIEHiMQuA/F
+p6VaX+SDIU7ijf4qR/adtDR6punO/Tv6THO/oUUS/5Ok8lE+/E/Fsb5Yh6y+8tzVkcud9eLlT/JP
+cvc4mmFH0x3YDg/wU5ibItaCyPGcUMvzHNA7rmKtIGKM8kSBwyDwH6KnpVofUcJxvjwwhrLvtGBI
+4UT++PxUHKu1IsYSta4YH6+hbpSbOrLZTjYdRxrn29Ry4uAzV6FwQ4gATu4N6VmmJmk+k8VoLpJd
+0D0g0wN06ICpbuJBuKGo4yzKayB2Oz+5OSMYPiPPs/ejIQFA8KphrU1PGYcIm8gJ9Nko51v4/Jq1
+7VEBia3AXZwZa9dyX7g5tttn2w/OTrXPtj81R+jVxLZcDv9VnsiK/m2m5IYsYPMsPWLS/LZBsnSJ
+/9J9d4WSE3wZVemS4y2uc+MFPsNB3E+8mswgs97EPnux33tNjy3pyCWNOPnPNj6j8QLXuZP8h1e/
+5/x9r/PPN9GjaJPnkcleR37lRFEDbcDEbEP37kio3sAOGLJFrQSTVo7NaJKjjOIF7Y3F6X37phcb
+25OzTc/7QtXduqlD2beo6d3ryT3ffPnVzT0Hevfu3fvAnptfffnNnid79W5Iy8r6Br33dVZ2dtbX
+JO2bLAAEn0C4cIO/BUbGhTXOZrbFxVv5eDallDJsOl4yS7ygixPk1a4RL7yDfL8cS34nL6PjCM5t
+W/XSedL4bRVSoRwy0AXntqGy9mXHpyecDNpx/JgfGjPkfvtRx2fGvxi48/gxf7J3CGt/gnBdqII2
+zM4zC7p4eX5rjrciOV9zvE4wS+ZAZOZ2467Ohx5ftZWouYjnVk9F0eSh86QXykZfXjz5I3k1F2W8
+cAjFoOm53084ua737hmUvmsfm1Mr9OOtAqPKmQUdYjnZzII13mwzJyEzp3e+jlc+vmor+tnx3XOr
+p5Kv0crz6DQ5x8VfPPkj6p5LLrxwiHxFNjejnyJcFwqBp6MzYlyyaksFiPcSgFNEc1dhbgtZg1Lu
+k+Xo1RrnRY6bezAfJZE1s9Bt5/VxeJ3T2v8gN2jKcOcsO+p+wP45yu3ydmHdFPLh+uN7yE/2D7vA
+g3nH03mc3GRkkbyFFbzz3ox8PyaR5E/UewQZg26NdL6KF684uBh95pw6lQPHawMP4h39uzq+tN+Z
+d3zdb0/XvYTGdiGHV9tfJZ+wrFnem4Qb/GGIhDiA+H+/wCK3K+EGW131E+nqatGRMXTldZufSFde
+Cw+Pd/cS91pdpeXKH+gvXHUs/wi2Zirnn4y8s5e94mUga7PcA7wzD2B5N45TeuWg4wN7ZfO82LX/
+MM8T19Wr22BytxduCAWQCukA8V7ZaeOt8Vq9FK8TOEEXz3mkt3l0k4IkEwpEeHJUoMyBqeNk51uc
+T/VTPVE82Tgbfev8erLk1sgEj7ZiC55Cc8kKlJrEMZ1IKPJJ+xcos9vLw+omkXfXHq8lN7Gsp0K3
+9gI/yebuTBuhjHGuFOGGUCjzjAQdixT6AHt0O9BEg1ZI1gzqO0SwxtvirYJWz8WbeB902Vldbs54
+kLveWRMOFnbKKBwzoWTA0GwyGt0ud57Cy5ccvIXwe9w/Vtpf/iMwlMfYo1WMxchg8kuXL33DEoYd
+H2iM4vfPO77u/uG6ejSui3NJ3yzG8weugcINYaF3v+rL0YL8TBm7OrlrDDZ5Soh72auatKChjKRK
+bfjI/Xajj0wPm5peRb97lzkHH5Cpwg1xFPBgBitAPOsrPO2b82rfktLSaAcmsHS5DdJuYQAaQG6M
+cp7Gr5cc6oFWkEHd0K/OW1E419mr5FAPMgfV5xIfHD2VHEEdTlQMQyOetl9AqxPIlel1C8m3jx5/
+YulT9vNkXgJKmnV8EQrbXLcLfTFmeHP+fCl/yCzpzchso2uFzbsAd1/q3cvGK90FPnt0J+p7aMtB
+51z8/OhDVeio804sTnQ+k4iXOwtHHRpD+uO2sc5LuAL1SEvET6Z0SHml7k10OIEMWmDfuW1v3XOv
+1x8/R0YmoKML7U9sO3D8OcWWPS5cFwrAj/4QeKSDeGscEq6TWc5/YV+0hUx3bORmo438aLJ0dDlZ
+9DOGhw89jFu+q7WCoOORFeLjPkUbudmOjWQ62oJ9nf/iR/9M6CsEfkbLyke72+h1YQQIoKLWD8Rb
++eZ5j3fs4ArREbKUxKEbaDFZ6ljILUeLr6Ezm48+gr8k35WVke+uoZoVhx5GSwAeoGmBeB2P1N48
+HeEKHTvIeLSYW+5YSC+boBskDu1/5OhmkieMuEaWPHxoBVl9DUWUlSn0hjB6kdDu/+w/vXn/d6vR
+LeV5vtUVaD6udfn+Y35Qk9z/lp/Gsc218XfstFAO5WW5cF2MhLasb0+It8bFmxGtxBk00KaZ4/U6
+LFdgZiyZ8d175Dp6A/VEUctP7Zlz+uN1KO6Pm2TZP5ehyTv2L6xGdcPbH8p5Co/tR+ZUP/bziWpy
+ZfFPP6PYQ8S3H+eXGaU3KTog3wnXhcVKvqGCjhfMiDYT1iWatXEmq0ZuTojaY2burXsonnQhL5Nv
+lHzJjT9uokX/XEYeo/lyQ1i+zl390HqWL0pc/NPP5ItD6I9+jt/d+SK4BE8Kt/gK0AHEG2KBnTil
+g3WsHF3SbBBuOV6/QO6sXXuey3WcXX2mfNRqLvdJFHr+rQ3rtp9dvbZ8VDM6WgCb1cBOoyqH9kN1
+IWC8xOWuHlV+ZrXjLJd7fu1acufCk9tHla9dfXb7ug1vnSc/Mh1ccmUJt4T3WexwkAM560OzaFhi
+zn3JwqhVeOQONDBezH/Oss9C00jVBTzOOWWGfcZ58iPl9R3GGpeAZpBN250ztpOdaILMNCh5WYRb
+wttKXszbPg1O64+M7ps+WgtHA+tIopHTeOVFtqDaC84n8DaaFwpdu/Y8ymR5OT4jm9CM7XjTdjSB
+7CQ/NuV1hs3zaOSFcAB2UdYTG7OZqn/lbzfq+duO195Zu26drO81ZyqovrdvR4nr1jVTuTJ//J3R
+DZPpqh/QvCQaP6VUqforzqyR1b9u3dp3KEWvEli3jvLp0grXhWsQAJ3Y7N/NGP3X6tQ/wSOF11SJ
++5BcYGybavFCZ88u+E/HO6NX9ie30bCup5ZRwdDsypX9kZls4YcwabZv3/6TtX77dp/lTMSfbfXb
+lX5BK/wuXAN/yKD8uM8407HFMztT5isd0d8tTJS/vHpURUVe4eNOX85auXLYjCIq/h85+CvHzTEr
++5MP0NSuZDi6jV6n2hjVPcR3+8+2izFFTCkKY9uVcnRNEq7zt0FLbV7DAzNEk/lvyhWfI8k4b2ZN
+Evqn86mZNVyPVku4JHv79hI0sVkxe+fZ7m/ybK3MW+RITK2Uv5Jf80pA5fQ6LxrR2olRm9Xm9qfh
+dXb07rnsOaiS7J6T3dop0gtJb2ZXD5+Tze4JKPRVCQEvymv76BmXheUr8Jf5p0ENka3li3TU7YbR
+SpdSvXPGYXb7WLsdj3gwW/T1s1XPPlv1rLK2xdrhOrpamysggZN8VDdu3MhOhVwBcYLko7riva70
+qYAbD/NFDYRfZ3e8Y/+b97Nv3KDvSwKHlPfdVw2F6w2EL2o8zK9rrLZzNveaj6tQuC74y6eH3Fnp
+vLNj6yv+NMslrb3jJo90TVnIr8jZNPxmd/M6VsAgKX406R2PsXbHebuA3e8ANMezKDd+PrVznexu
+bgA4eNl1U/hLiIM2YIEcAFB8NzZ36kcH7LiYJoe2QSjGyyus0HSdRc29+XFZaf8B/fsP6F9a1vRI
+jqKQu2MqU1NTUyvH3CV3PM/oXVRFau2kFlXxj5pM/QfUT51UP6C/ycSeJ02lz69WjamcN3f9mnlz
+K8dUVY2pnDtvzfq58yrHrCC77GQXGmtHYwG8ZeEgkt27NOgM/4s8aD83y/EojvvvJKFrraP/Swn+
+v9E/kvTUBZDNZNPb/lv945+cjhqHYz77/q9LgJxxOGoczvnsu9Uy8EV69D/J9D2eUePchMb/l9Vp
+JXuNW/LfFkSr9ceiM2r+B97xvStXrv53fHOI4HX/A8+8h2dfCIM06A/wd/uMXdH/IApXUjBt2r5p
+0wflzu2YnHxq5aoX6u/9l5J1m14waOrUPTOWpiYnzcpb8dILq1aevvS/txM1dGSx1T1325KQfNsN
+/w/i8ffIq6h7OvlwHPkwHXUnr6ajlHEo5Y//UsTUY3VFL5Hn0NCXiuqOFb+EhpLnXkL8fysj71X/
+JDCwE7/K+PG/tCAcjpc4l9APeva/lGfS6rOr8f3///oy2//Wl9FR87/twzpRIf/7vktvb97mJdnT
+jEEdT50FeY1paDCvaSwSuja8ju83jVZrGu/xql0N/+CLvYah5vT85RLk3DTZuFLgIXbNjksVIs37
+Hxp7NoKuLEm+LBSDL4o3q80Gs9ocz74NZqELeQxNnk+2k+3z0WTy2Hw0CU1ybHCT5u7QlBo0SUmZ
+T7HjWs3L66yzW3baf5HRHlqI4P8fef8BHlXRPY7jc2Zu2fTdbEtPNpvdTW+b3U1CGi10CITee0c6
+ggIJRaSD9CYiSosBAelYsKBgR0FARX0RG6Jix2Tv5P/MvbvJJmB5v+/7/p7P8/wDu3f2zpkzZ2bO
+tDNnzlnkTarc+2vPb0EiCi/xx5yAVJyAEVtsFXychdQFbJXktYXydAUfVXOD3yJNr5AmNUpbfytX
+aF9Rc4OPqsCrK/AyVH+vUIYBTwsI7aSpldJUfktFzRd8ZAVCTeDsnuu9T+MVlXhFBR9Z80WFF4bM
+9sDUk4Q7S4e9JPnABMg01Rung/QKGVKBpgkVN3zybX9veLmwcoHr4bFPmQMUq7TyspAZuAqtICfd
+beijFRUecvgtiNTDk/rby3bwSSXT1aYC95SqlJQ1IxtS++ZH6lM3oRKGVcgZs4+S3EMtswPwtNCe
+/xaFeOpL9stjAhvI9WaD8qn0LB4r9adVU6GQ6ljG+GOH1KdCinXip6RJyp1PBYcWGRECI6k/Cdd6
+/GEQl0nP7Z16E4dL+8fi5yQVpVO/oaMrKvDMXWOll/lvHVRVcaNCet8Jv9MERh199MYN5Is7RO6p
+DcTJeFk5+flT6VlYTZ0KhY9WVMB2d11FBf+tD5WMbPePcnUhUo/TYxNEadPGdDPMzAYWfqSiAn4B
+PPUm5NPlY+ET6SuZ9lssI3x4LG3DqMVpTQrgflEmX5Y77uHf4AegFCZ3TLAo6uTmeDHTprigZxen
+jYorevkSh7IjTrgKW6Hk3IjCTkVlrql9dyw+uO5zugTu/3zdwcU7+k51lRV1Khxxjp7B6XsT+7i6
+ZBZk5hfNHbtj1mP9lhcULO/32KwdY+cW5WcWZHZx9bmLDuB4RY2dafEZlZWCy2lJsCnqK/K1KsU1
+BMfzb9AR9ExjQuB+uqQxIVACW3H631KSKMs4YviPuC8VGyCg8cMO4LPcOzkV2yhBHH6Fbpe2Yc1u
+rPHKRHzg/TDRgB4WkIHunVUMHIbCUHc+d076YTfToajbLp//EfkEHhnZzX85h6s8rvLmMbSqCiPf
+bO6ZTslJ3sN5MpPT+eaH0SwYJT5MdiKjbGHJmW3QyaqDTImQqfwwKafJycUrej/8Y+XfVu35qatd
+K3XV2bv/tHP3t71ytPhpnXv4lC5dpnYhXcr3/vBkV3vLFvZuO3/a0yOnZavgsildukxW6qFxXj74
+XT75Jsj6RYIOD/HmhZ9uyEvqqiPb5Lzg1F/mNRRGCd3IThSE9AhpXb55JFiV4gwFckab1e/bXTt/
+Ks/RwpYuU8u6TOuC0/e2yuiz56ed3ewtVpZ1ndyli7xenVW3Q2gm168KBSK1cm+Yz5Tlfxq7hXl9
+F/buhUQcJP1cuXcvvSL9jIP44dLPlVXSVBxUWYUAfY8QKeC3euYRs8OkN+Puc7/Gnb/moyoqUL1v
+fi1/S9ZVYDOE3nMT9xzXofbX+su4VVX8Lc913KZp2EpEq9z0ZWmOljTc9uVvVdU8X3/l9x7pjA15
+HS2pz4y/VbXDkxmGSIT4zdxcFFhvu1Hxt2VkSw+rfOQo37jv23PX96WWUNC5U3HrkMjCt1cVc3Pp
+t6GW0u+f7NkXt5KebV686u3CSOSDU97x+4GJd4Bdw/3g/oAku1PJxSpy0f1BVRW5WDsfIYSgrobe
+L2zgX5R7E8iO8clx/Okb0hxpzhv4mmxZ5P4/lojt74KVi4evSqY38AK84A3JLNsQWSLcr9gQWU7v
+Fzj+RaRG0SVCYHAoTzTqjz9WK9KUoFAV0qg/Zh4gIZ5jemVqFIfCwazJSSBtP6Jv7ofngMJz++k7
+fPTn7to87jNowezD0udr47jXar5A6B55aHgSes88+OwEWcgk52HX6DjS9iPI2U9bUkxb7oesLz53
+c6/VxtHnmQFaaMF9VptX8wXCaD6az1Vylcrddj8RpoAagultioFiRDGeT29DMKjpT0Apooq85SPc
+Fg6QUkRQ6HG8AHEQzoyU3PIYKbHrzR+9/z4ppTLsH5DF+XHhyA+hBh3HP3xUG7HUSKtR0Qtj499X
+Mk3hyppNw8yHyKYV/TwTIlsz4k9/++2337rRjnCELpsJr8PrM+kyOEI7wvSZ1EmdM0H7K3f+N1pQ
+Jb+Qo6tkSOr02Du/WveSaONbIB4xW6DIyAyqgQ3Y4yqxEZv76lYaQ8LgehFfKR3EZTWVuPNkGCi1
+goF0J37VI9tScMgYLMz+KjuxN4FGtLmvumU00jT6Oo3Z+Zm8zpIRXaNRDM1d6bUOOb2DpfdQ4L6K
+l4MTrnNDP2MrtZpKXCYdvAY36E78rDLPKelFNo4rR7RX8XJpmtDZfbXoM/gXXL9WO+AaLmsCy8ug
+zGscy0LoLE3Dy4tk1NwT12gMjfW96xSD4v9eC/5v9d/JLz06FPrdrfuu8PowhPhZ3E55dLEjbSjY
+ETHjYZdhenE7GHeZ5o48NZLbSVdTC3wIk+k89zVi9k0XhJAdaTPtoZBpRgRD5jA4N/LUSDh3ma5v
+V0yXQQUxu6/ReTAZPqQWulqRh9fwH/H+SCvf4jPFK4sE2QqWvDQwaUxxNqtG7XImgd7ksVZiN/Ef
+SePyExPL8vF6aWxl3z6H5+EN0jj647PPPXcKQvBGaawiosbrSWBiUl5+WVXf3vMrj7hbPfc8BEHg
+C89V1QurWfvX8L95adA6TMoaSZaFK8bnvPJxmywgjzMaNGpRMF3FG+Yd7tO3UhqL1+eXJSbmS+Pw
+ekUoL43FGyHk1HPPPUt/JIFVRyrn9+5bVZafl5To/r5Kkc5XPfcC/YX+/PxzyjzyHkL8db4z0qAE
+tn5nU6qsFCvfmdRrTGxkMRrk4ctmjRMFvYbcKJtWXyyYjcPOPFu1dDmdtHQ5fjW/rCxfysfnKvv2
+Plwp5eJXQQ0znz1Fb0u5+Ny5pUuXLn1dylfyrfucv85nKfmChs2zbByTTcRYHUq+Nqs8pBkNcS6n
+Q4Pfrc+XPEsXSl/L+cLqpcv5LCmf5YxflXLlnPE5KZ/epoufPQVqfE7KVXLGryKA2Qjxufwl+XSA
+rT4Vl82eIrvkaYqpB3Mr++Z3qOqQ37dq+fhHqtaOX1qFH6vsk69z55NXtfl9K6Uh+NFzy8cbYQh9
+zDh+6TlpiNyeObJtJaaNh/h645YOHwOXyj7F5jDrOdnC5VyYzz7MyuV85UdV1Zs4VbqIU6tu78V2
+6S322Xu7qur2bc8acSD/EbdbmYvZCKno4HFPvv8+uGrvKOs+gih8Tx+ha4/Bhd1w4Z7pPMp2UPj+
++8NlubogpzpGM3bTjGMwARGmo+fv1dHTNdH28zz865X+JPSP1f98ggjdnU9jbcB7KgXm/5VuoE/Q
+M9bTdFlfnq14NQhpPeOfYn2TIb9KXnQXN6jfenVc6Cegh9DDdHUVfYTQKtK5kTLVXXhlVHo/ZoTL
+qy7zkYyumL7gRU7O0E8OwsQqmHSYfo/zqqp81Fnv0qtFlgaMHlQl9HkeH2yE4W59XORqKGGToh1s
+VKKm+hmAeEcCaBSdCT3iOf4j2t1dRcbAZq9uhKIrwVXSQQ8deASer9l3GZgWAsy8zLQj7qHzIWug
+JACyJHj0H67CZjLGXUW7K9oNdJ5X24GrvExbPHLgIcnEFBog8jJdvHD3bFqp4KRrZd0GE7Iquilg
+A6vNwRldsp6D1igrOYQAZ9RbLSIHLkE08B/Rn2ky/Xw+hJy6hOo+LL5Nr8BRaPbbgt3PzT93fQxE
+//YhrSyBLXRA5uT7B14Gy5PS4x1wc6f5QGLzh0tTdhc8gAM70MeGz7+8bvCno45d/uDhxevg+kiz
+MfRumrQOAw9GMBj1HC8msGtbxCYrQBQDJzoSLK4EXnS6rFdr4AroFlD3C5/QE9TdG2wwh+bSY9/P
+X7Vl+u4vh9E3T0MotMil2+G3ILMlhqu8XLscd+ogfZAeuzuz63PTmh3ouJ3yHSBrVMXlnTMuzTl+
++Y0x949ZHakSBYWmT/mPBLPHAmi9hUf56dHW15hAa9JwnXb0hlI65xoeLA1Lx2+470zY0Zuehvnp
+tIyI9Mtr0meCOf/XKmlTVWUVvZD/a1Uledb9PR4tbbpnPppGeShqCEbizeeuLOC7a5KTiDOvwTGW
+jbuVN5MqPLqqStqER3v38sX8R9wv8rjgOXE0eh3gyFcPlMM+2iOyE1yTnlqzrBM14x59pa7cL4x0
+7YSqd4ZOqKpsisvSgMFrW9Uo8h81QiAfNDLEJxQcjDJ3K+2Eep0XpkdpkvV+Gt0qlY/2fa+hNmh7
+fzmspaLCOHDmfVvHjGitKAQOemD8VjLAO+poYmVtxeSfS7+JkxUTU26Xcm811eP8N/P3an7/OQG0
+nzI+3Tv7RjrgV+luuX+bUCpC/N/n3aCH5XvRf4Kcv+eiP6uApmpY6Z6b/4wS5d7/j6X8uruUsDD6
+F93Jf/5v0NOgh4W//1N6aL/GWlh2DzW/lN70UHO7lN/URAeLzQm/8R8JgbJ0M1SWELo0FmLTWtjy
+XiQ2Lc99tX3BVenkR9s/lq6TjhC47N3tj1B/IbCEvtinNve29HUJDv9sN/R3/4BfyaNcCQx5+x54
+bVpRw3RMebbtcDFlU3hr+4KruM1H2z/GMe4j9BeGF36jv5VAcR/u3G0cViJ99dlu+iTRSAV54C6h
+jyl45/AfCQ8hgoyy5yWNSMBh0locoFVIVzo1aHk+a+U7Eg+zd0g3SLsXt8/7SDr+EH7bfWvG9v5g
+W02DYDt+JZ/y0uQqWPTpbugHl0vo2b61uT/S01X005J/4Xdh0FvyvHmJzuEvCw/JMkmmVaQBuSDg
+ycvMXNNYNBYglkt41zfbv4EXpLwFpL170ozt/U8BGgAiLQU1tBkLk7dx5+g3OLdEeu3kbvoRPSPn
+VHUDzzsqFUyCgdS9qa6ObKhrwY3hT8j3Nk+7a5QnniLf3Dxdd0y+V3kLqKjFbrU1qExzTIGoO8Yg
++BF1x1h8XQtRq+BAGgUHP6IpjgYYfoQCgzS+MAhQ77pb4l7+ADIpfnicLmcj874mH9u/NivW0VZw
+KqTTQBh+Bp+Q2qxdurRDcxX3S22wWNJ+2dK1+KTU9gzdNrAtnrpj3aqD9fZovWZqDz7UyP4tG6kN
+oZyCHas9hoU5rDiw1kAvcQkY1ja1LLyWfrvMj+uxYwee2p5KW2TLwjBNtiy8jdIBoJZKQN3Ivmy4
+bLtXneDZOTFHSUyKdS/zvV+O6xyNv165UgrzGvCFz35b2bXZwmoaUS1b8K2rU+gX26qtgWs9921z
+OSzLy28J7YT3PHeD/8pqMhvh/85ycs0uvt+fWk8mL+zwrrO2cl/wc5ABRfyphWKW219ZKXZHki+a
+Wirmeir2yJ+mH3psFf/Py+QetaOxfeRYj2+pP8/TBL24n2pbatovX3o3nyxd1iE4kHu1NhhP3RF/
+d46RETua8r+MuJEJZpOPfWajgb8Fz9LSkE4D6bYzUlt8UuH/2kDuJ4X/pTb4xBkYPrAtsf1T/rfi
+BAV7qNpj/Dkh1KUcsfCPi0vozbVNrT+vBf0yv9qnduwgtvZAtsjWn+ly2frzNsAD6G18ht5uyv8Q
+x6m5JGX/zhyVaezyLXWzpYmJZcb/UtjKlfhrxhqykWUa99vKrvkPVcOX1Y9klil7mKa8/ldWrNmw
+8neWrPl+jDP+1J41CdhxF6//icVoJtH+K6vR5AvG7Xfbjl66vF6vTYjlX1LOkhuuRAqxtcO5bTWn
++FL2xPNpYhX/UhXVyfNH+7qPuSVclHyKbEYIso0uoyir6sYzPSGrI0dZBeh1seA5ZsKjT62aPmzG
+ffSVaydWzBg2fSIUwJnhlTu/aTNs2NwnvmnNGSfPGDZjxalr9JWJcuBTKLjU9ptdlcOGtvnmicqh
+Mq1ToCPXjSxX9NLAwWtAj3dK78JBnIkfoO9B+5dqIJ0eewkhjNYgxM3nXpHvFiA7Eyta2G5zDdyB
+O+5j+El6gB7AO3CC9LE0uAouwSz6sOKvjHwo+yuTJdyy6zwgafT7mbCUuSijt7mb9LbiVBDU9f7N
+sCwRtzC3cmZwMc9eao8b5J9Arfj/Y7qLMiwpVluNlfI4uhIht9X7notU29h79LEcf9kbjwCa1Z3F
++4XOMl8bBZ3RoBOVb5szx2bNccnfcPyBjh1nz2Yfb0DoPKPLpLJ5d+aVTSyb98c87w/2LdfnQfoT
+7i3oEC+v7c0a80HYe/uEoFv1xwQ5/jX6E56oxIN8DR1PPHFb0N35RFi7qml6Jl7RHzxxm/4kxv8x
+YdVd6dkcZMcTae/bJ+hPq4S1CGH8S91QQYvnN9h3Zf6V8S+rV5/DveCUtBevXi3LCofXDSUL6+GY
+7qJdY8bPKgB4/urV56S9Mj74SdDyoR44h0lvMWvs5FncS5q8ejX8xPD2lvHBT2RhPZze5GBwDB+J
+U/DlMjhAVyGW/4gMRLxXV4D/qHb3XjKwio7DxQgjATCn50ORiEIQ0ibwWMtZQiGUcBasTVgO82De
+kiW0glYsoT+eOIFFrDpxQroDS5csgUqYv3QpnSvdgV9w1KFDNFD64hBCCAnodwSin6BFBGWhZqgU
+dUF9lJ6p0euEOCYabQYa5fZ/nCEaTIoVAL1OSAGNEjDHWwvBc6tdZ7Br5Y4od0Omqq4IGGUNLgZC
+xrOjj5PUfeJERVVVxYkTwJ2ExJPzFxw/XllVVXn8+IL5J2mroa1bDR3aevfu1kNZCJftaTV0yNKh
+Q5cOGdoKz4BE2qqq8viJBZWnIPHUvPknlJQn5s87Ra+cqlzAfuOyPa2HDFk6VDo4tFXroUNb4d+H
+Lh0ypLXkt6eVjBKhpvWt2IT+qIbu5fpCLC6m4xSYg2QUyffyFJPvkXwqrMZL+YXS53i/ArOIjCJq
+D186GNAivHQ1v3C1VI6jZRx1k0g+jmbxbLCza0i+NGP1arxf+lxJXzeJqJV4u0zHIqhZLc3A0VI5
+i4enKSI14ni5P2pyXBHgrXgbO1hjRuH0mRp4mgQ+/3rZ8BJXSlRYiOCnHq1RUcSrEuZhAs92b+4s
+1qqtIW2l9fR9XLNJzjeZRuDp4hIFr070r7fyYFRM2mlDHZkaPP1uvOKSml8T5kkSbVWPF4+DNMyP
+k8frbfCuahu3xXNLvn5cs5gcSVhv4iZS60zcrS8um0kT6QqpFJ/CS/GzUiuylybOxN2kAzPhSluv
+nGsbQvW4kNYkHwmZLCa9aRs+hW/goVKpFCVt51sxBNwWt7qK3K6qQjxLF9BF0MnjcSAKQXo2Kpv8
+MPO8lQR+YPJjjtO2ifuIv/u3O9+uwDOkpdx3tV+yc1ouQvoZX5OW4hkr2WtB5/7N/RtfWavDM/AM
+6WfJhIPwp9JSaSmehmcqeam2ybYn2MlOMNLKeWnANy/NNhW6s+7PshF0f9y6VwbcLYRS0EyEAnSy
+DX+jx+56G1SOBqFx6H60AK1CW9Ee9Ax6Ab2BLqPP0Q+oFkTQQiykgAtaQhfoB6NgKlTAMtgIT8DT
+cBpeg/fhBtwGN1Yxvs7Wa3RmU7xDk2PU6OymbIcmx2yKB70uBeILIacZZBt9whafMP8nMH/2/p+E
++T/Jy/g/CNt8wuL/IGz7H9P/Z/UmsiZVfpnZl137Z4nkWFN2NOjM0EN1/dq132OurW2vut6u3e8x
+7YFY2lss7S2fWjokJHSwQHvlJy1VnteUB5xs9POeD1hpqbVYai13Wt4r9uO/R5DwF3Ef/w/SeR4Q
+Y1lmYf8tlt+SEyyWdY0q5I9dFgvHWSz8pWu1X1y7xkVeu1b7Rfv2XGR7d1Q7i6WdVYE+Ln//u/+P
+/4/hj/w/UaX8/8hCP7FY1sdZ2lmktj7vPdWDEEI8AoT8jzQeg+XhUDQzlQus8QPCQuB/5E4v92/E
+X9y3bgUbDlfULMJBbISUfn53pWcYJv7Ef50yJDINmlqdPILekhZLy/EMxKMNdaXCSN6NeHnOkbX1
+lBzkg37iAlFjZPMQM0Mvj7JLpaVT8Nf4aynMmgef5OXR+DwaP2UKOJhuKb7CtFDdX5L+sJ+Wu5+k
+5XzzYfTWMNqffeMa6QqTU+xGCN/HDVYLpLMsDzpFe3JPcg+qBdJT/v1w3eeCTY4fKP+eXPcVd4Ob
+pRaI7KsYRdB0/lf0bv1eSL764jDBCbwDHv3Vrf4NttMrcKeKquBOlWcuPMvXejz81ru7kzeA7Mjo
+OrxR7+RuhRQlleKh+Dp+lttS79nOrVa882JkRki4yr8r1xeymORJyuQHOBRfgfF0nZRI10EK7Qb7
+ST84QMuneOosTKkyKUxe10huUiaukek3O9TaUK0dEayBqS/vOLV7B3X3rZDcN8lGuI4zgUg/00XS
+9+7vlHQwVVyCCEJau8Z88OWXxSW/TfWst6iOlIlLZJx6htKMCdY4YGrF2jd2vLt6h7jkpnsX7UV1
+dAS0hu+xlWTfbEqLQ00w2EO1oRo9XlHRl7p37D61g5GixaEwCwdTSXqXxrjH3ESAV8jpliBO2XNk
+kjKZGL8VTWix69UEEzNDile8vGP1uzveWFvBiHlL+oiG0tOwFW7BPtKvgZYJ8prRLPvpmfoy3kHN
+kttv8sv/sPwsLUuqgalwTRosLvm988t+0xFqil8+y8ArqBnvkNyqAy//tkqG8ZTrAa9PDbzi5ZfF
+B36b75PHA3J6O0OAV7wsDYZr4gO/bXkZAX5Cusn3Fid4PAUz1QCTohJBBpyaOOsUaOlXI1rlSTfF
+6Z8+sv3gyF6bEaC9kpsMEBfJ/sINigKB2ma12Bz2OJeTHeurRQGvy08eceq+DrS2sBBIp/tOjUjE
+L8YVrqUtpa9xIBwf1JyD062HwTM4UPqatt1UwGihOr63uEimRaMz1qtsaMiAvFYjIJx+d+LBieKi
+O+u29Bx5cPuqf4nDlfI/If1CBojL5fKzXdmAU6ekX/yUde9e6SYZ4CmfJsdWfxtRg5/IazWCfgXa
+U7MmSjfFOZt7jTy4/ZFP7yzz4nTzvT1ltCrKCmqjwWTXi5woyCV2Ocn4gsQRp+7rBKSwkNZ2uO/U
+iGT8oqlgE5zAYdIvtNOw1qeBaz6ItpN+wWHw3NpCRg/VyXUXJLeHVyWEeQl74tTEB0/Q7yB8RKs8
+cdGdbf9atf3gyJ5blD2BvHYnyu1DePpVivyW/Ha/12d1CX9JthePLIqbaGIiTGWIuVrAdtl9rAs+
+AOa7WnZiDfQnWKS4msWja1+CKYozWjKDnf4xnKcQEi5xryBepjLTlAmZ4FHeFy798QUJIsF/fCFE
+MhFHbQGw/T7XaEyORSmNRmSkq/c2K/tGY3JjrC6CuBhQsysUd43S9D6ns/+ADwf0dzq9gb1Lv4ew
+75cu/Z5+/f2fjdvkXKMkLECr6lMtXcrGZQFtEUxkpVoIXiLvX/rQ37l3cF8UhMJRnHLvg2kDyao5
+GeDd9DpynKARHS67hnzzOjMryb42Pl5V9fjOqira89Mvl2Do2bN6f8+ePXvur+759CfvX/zkk4vv
+f+KuxheSptncz8m+CefXfc49Keg8uq/sFAuxC6RWm55dBrI47HqLkUdqa5ygNsTB1PxDDzIXWm8+
+eCj/JP3GBLknwWyiz+Ipe+jHe/ZAAjk2fAh9VrpNnx0yfC/35YBho88sGDJkgrsENnz1FR37lcz/
+a2g6Nx+9K0sjTMpFSsjHO/a61XvZfCMNxDvlethUd0tYx3+NglAksqKPmKMjwePo6BQyo2g5bFaf
+Qgjp5DBSfIMGIysKRk4UjEpRMOqJgtFIFIxmoGC0CAWjDSgY7UbB6BgKRq+iYPQBCvb6Bg1Gv6Jg
+hNmPm0iHapCO/YhE4UiHkpAO5SEdaod0qA/SoTFIh2YhHVqCdGgz0qF9SIdOIh06j3ToKtKhIOZh
+VOPxWqZRn0L+KEwO+6tPoQRkkcMJ6hMoinkwUyNTHNIw6Xc2F8p7fuEEczyHwa4RHRYmbjIJ62gx
+fYm+QovfhsnPvwiT36rpBasgCqJg1TF6Yv9+euIYzL56C+DxD6WN+CZ9kTaHF6D4VZjy6qt09Tl6
+g06C1RD1DLQ5dIielOrw3JRNGe4vV6K76jsXihvXtx1Z5bD9/2x9M9pOoOh3T6DoDBmjFdUgqxej
+FSUhK8pDVtQOWVEfZEVjkBXNQla0BFnRZmRF+5AVnURWdB5Z0VVkRUEDTqFUJCCkCc37i7aMRjFy
+OFp9CmWiJDmcqf6bNmW23pwJrrutMYrB8E9aGXeBI1L/biCYijIGdW62cOqQRwqfOJtZpDn8D1p9
+5bxOP8YX90gs7mzNfyohduOc/DGloXvu5oFWcLQxD7RADjnc4v8sD8hYHKgGObxYHLJb8jzkQO2Q
+A/VBDjQGOdAs5EBLkANtRg60DznQSeRA55EDXUUOud2jkAO1kB1epXpCJ1CqwlWpqAalerGnoiSU
+ivJQKmqHUlEflIrGoFQ0C6WiJSgVbUapaB9KRSdRKjqPUtFVlCrzaezHp5DVw1mnULO/57EkFC+H
+k9SnUDHKlcPF6lMoG2XI4Ww1IzkDZSueuf6C83jvDVabQ/EakWNNAYcSaMSI/4QLycmgGVdeLlnc
+u9+cVtOnjhu2/JG+vfv3y5k1btDiwsdfHP23rLiBzBuwYpsxcm9mVJeObTovL8gvKNbHbQzTLZna
+dYpyH9eXH2NQEvq5MUfGefzKxf0fHpXi1CdQwrsnUJT6BLK++xetHI/McjjeM4yZ1SeQ7W9mBwuY
+iF2jGHswOf7R0PEC/RmCwt5eseIYbfm3TdSFzsdtpRMr3n9/5V3jQx50aNwasZ7WiFUz/rbLYev/
+2ZY5hdI8PU/GZ0c1yO7FZ0dJyI7ykB21Q3bUB9nRGGRHs5AdLUF2tBnZ0T5kRyeRHZ1HdnQV2f96
+vm+YFxrPF2bk9Cxi/n6+0AvO+gOPhs7L+uw/WhZswoV0IimOPfjOpedLHuo96P5Wo2fMGJ09os/f
+sgCEr3wK/A+u31StC6vOiBzSo+cQnaVp37ShEnikKTc0q+cGh2fB4/i/O3MwWphV01jkRLGoFMWi
+nigWjUSxaAaKRYtQLNqAYtFuFIuOoVj0KopFH6BYLy2x6FcU66XFgmqQxUuLBSUhC8pDFtQOWVAf
+ZEFjkAXNQha0BFnQZmRB+5AFnUQWdB5Z0FVkkTmTpXH89ZwQ75kH4tWnUBFKlcNF8pwQ5ZkT/mYm
+qD8ny7Fq5SsWgujDYo6cUGZX+58MKI937t+/c+f+/S92H5JxfwXvV9l5x6mXdpw8c/OcY+zfctdj
+Q/v1HTq0b7+h8Og2s2FdWUDhoXWPPCN99SEEaJuMN/Ho2xPInHECJTC3zx8rAyTzaSnIPi//O9yk
+eMbUffzfGmPi6ud50z9ZS5o8Y8Pfjgdghn+2HxiHn6LrXoSuNOsf9PQO7idIBEJ8k75djDrgoKa9
+u7C+d2ehUjmc9f8PvbsU1aBSLy2lKAmVojxUitqhUtQHlaIxqBTNQqVoCSpFm1Ep2odK0UlUis6j
+UnQVlco8YUGlKEteU1oy/lsjRgoqRLEyzpSMUyinIQf1CeT6+ARKUZ9AJR//5XiSXz+epKN2cjhd
+fQq19IwnLdUMbRRq+fdrTJ+RxcJ8fXlGFdHHS4PLC2CO/0dLTfx090GDuncfNIi+yo09/uijx8Zw
+ITOe37//+Rm9unbq0K1bh05d/5a9vyxr166sa9t2Ze1gRvm0xYunlc8AsqD/0o0bl/Zf8GJhixaF
+RS1aNJnXcu6e14pQUv1g28Dtp1CGZ0DO+L/H+UXIioqQExWhUlSEeqIiNBIVoRmoCC1CRWgDKkK7
+URE6horQq6gIfYCKvLQUoV9REcID2NCUhIq8a6VcVINyvVTloiSUi/JQLmqHclEflIvGoFw0C+Wi
+JSgXbUa5aB/KRSdRLjqPctFVlPvXa6U4lF2/pE/1LCFS5SWE1bOE+JuxUVkbuZxCvdMIvY6JtLRe
+ftPr/tG8Bqt7DEm/v4L3r+zyGJvPXvjmnGPc1c79+rG57u+32cu2JejXdgkqeHrdI0ekrz+kv2nh
+yNB+/Yayj3I+1Vi+lY2KYOufy7iSUYEcTlafQk6UKYed/7f34ZmoBmV6sbAlcCbKQ5moHcpEfVAm
+GoMy0SyUiZagTLQZZaJ9KBOdRJnoPMpEV1GmF0sBqkEFXiwFKAkVoDxUgNqhAtQHFaAxqADNQgVo
+CSpAm1EB2ocK0ElUgM6jAnQVFfw1r6WhPDmc1kgmx4RdNo+w65/J6LR2RRJr9/qlUcY2m4+s9p9w
+3Fvbq5+d/hgtIlxJ69YlxaWlxfMXL56/YPHiv+U2WoSLbEuSJWcl/mTjEzs3btz5xMYHb1x498aN
+dy/cQAjqbuGx3BfEgAhCxGHS67g/8NjqauXe+i2s474g4+U4jUnD4nTQm+5FCHAYRfiEOM8r58dh
+Oyjym/dbBUIA5ygiKm+cSQPnoMQTSVABHsutl/NT7OE4ZHdA8odMAbPUQvkwItgfQnIaHbeejK9P
+w84NPP8apZGJ834QwWF0Aj4hahvS2TXE88FhO9gfnYDHShtITMM3QgTO0QlE5ZvOpKn/sLKc8Xzu
+Tsw1KR/Th3PY9XafT8GzM9m/57wllMvINSljiEeT0OdffbpGpQS5ORCHw+h9+IQY2pDet7Q+Jd6x
+g58EO+nAO5R9c9XsW6pi34jhgXP0PqJqjMen9E1r4AyU/Ck6mUdk3qo/M8Rh+C3pfYp4Q3XtBuXs
+SoEZ74HR+MCMp3trbyEPPzXGA+dINDX74oFzTfHUw9TjqbvFeJYYfM4nw6SL+E2KuLHVNTeRD0wj
+ejwwOuhds84LQ1S+eOAcTSBRTfEQVWN6PDD1eHAY63viPAWGXcrFYTtwGh7LG2vXV8vxukbxGiVe
+x0+o/Zbu9ZTbFwc7rGftA9e8SDwwukYwGg9MAyJ5HJD7My+PA+wGNE7dgcdWc+NqvvGMBfXxmvp4
+HfTm9DVrlfRE5Ylnt9g0OvgYSrwI5PT18ZqGeC+CBl4Q5yHRWx+eEjN28JtXu15p7LvhNI3hvlUa
+3MMT4jzk57XfqWAsY4VnsJxSSbUbmsJ6rVjBubIdOE3hNG6ch4AGWNFb594apWYfQu+Vv6Zx/p4G
+kNnznjRoGtEwntN7wO+mQdOEBg+cws/1cHLLyhydukNmV27cbxUI3QWnaQwnt9JvFR44ovLSaJJb
+2ixDsuYs8+Cs+aZ2w71gWZlYP8CpO7ygtetZj2mAFRvwMkiG1YfQpjg1d+fv4ShPnd4N34QGGbz2
+25p1d9GgaUKDtxJkOHk88tKqV0YJ7g+Fqao9fOqBG1+ft94Lid9UmJXh7Fldu+EuWIdSA0oLKOMh
+N7a6Z0ObyrA+dPrkL88PtbfuTScrD/cHNf89ncp4pfDT39Gp1JIy3v41nT75+9DpGcNE79hj0suj
+SxpWOKTauxZR2lEZf0x6L5T0vjKM1K6v7olQE1gFn8bk8MDaFZzf0r2NYcV6vBpP3h7OaBgffWlk
+tSSPYHBNQbi+Gt2Vt1JmfT0kNfsSehedJoVOBdb9uS+hd9FZP4LCNQ9OmVBPnYvzlHWLx/gfm1lO
+7JAueuq0dgPnU6++8CZvzXr7fgObylT78kt9Gm8NN0njpb721j3SaO6dpr4ktbfuTuOpdVN9b4dr
+DRn9JW0ebpbzoeYmSf48H2/fh2t3JWpIE+SzHtUzVbkG6qi5zKfuetbeuiud2dN/PPMCTfAplq6n
+TwXeIz95U9FQsrKGnsqqsOe96XTI1098Rrayhirp2VD192qvhnHGl0ifBvvTNm5c93/Zxpo/qXtf
+Brx3e2nu3V5/mY/mT/JREuFGa3l2g0LP7JsVwO1ZcFtZvSPcaN3eMBrvgtu1e+B2w1odIYRxGD2D
+TwgvemF91uVkPOnhfqq2O+nurlbsx5+jZ4iqAbbx2rsROK67Bc9zX5CXFFgim/2Qd3K1IvcHMVRX
+071sk4FhJkWktzjPi1PZV8BMtg+St2ee/ZtnTf6SZ4zXe+ctNg8pHZV/qbq62t0CIYCZnjnGCysb
+m4GZZAKdumOHZ4XOja3d4Mlfxst7WkFf3ztnkgmSnXHIVBl5zU1urILfsx5/yWe+8a7bU5V1OXm+
+urq6psgDS3oTg3c8VQzf6Mj4HTvYSpYhZbTI9euBbaBF4SKG+4SUQ8bTODoNp8r01xSR5+W5D4fB
+897xGuqN6HhWnjgNnudfdjdX9nMAM/FYX1hlRSnXNaxQFufeSQPDTA9e3rtLNenBO2rDCvfaHfgt
+YuDGuZtX0738yzXfyOM2PC+v15Syekz76BSLPTuA1Ql5oabQOyeNJb3rYT3U6GC5Ui+MDmWVjxRe
+Ir29tCjQoOCG5WwKeXMHMVSTF2rX073VvLGmsJ5fvH3L7sMxnppR1uVy3bhbePlA5sMA39oxaTz1
+w7hA4UfveEvq0/DID4X41pKS0ltTMg95KqvmJkPi4STfNW6ALy/ZG7gpdYeHncgLv1V4eUSecwN8
+V7Csz9BpcvXVL02VroNIfRpF39tTg6Q+rVzrjLV2eOux5qbMXgoC7FkzveSTJ6sXdh/Qs8KTcrg/
+FDLdLXhDdbXPOk9pMX0953N/0KlKG3s6ITfWS6NnvPDz0Kj3nSt13B/utTKNSmVWV3toVKrSM954
+288z3shp5TFHTsc4EHpXkxfczasbrZ8CfHmQrYyWe7qEhw+VPkF88mig0Tcdy8mTtj4vlr4+Pw+/
+aBu3dQNv1rd5A4962t7LqE3x+NJdX8ceGrwVXV+Ihgrn6nEwntAgo3fsU8Yb31LhE/IQKI890kUv
+aiTXu9wCvKFRMX1kLDK35ITaFZ8pGubPg93l7dOPIjJpF0D13Nnu1T4yEAVeUTFmGsZh/frQxTBz
+bzWDnzu7mu51r/aVCyjt5jGOJph1MLNPvx3Ve/HY6rmzyWT3qr0UNZJpBMjW7KwOxQOJifuCPry3
+eke/PjALj6V7WYrZcxn+PVTHJ4sTZBkj2KEPd+FwbQbV+fX5bQ9qEg9msPPJh2szDrN4nziWl1m2
+rCkbzOSTD0u3pR8P4zHSxsM4BKsPS5uojkNuxFVz+92IQ7Xl9TKP0T6yTzy7XvaJw/CZJvJNfIZ7
+sbaYxY1ukJnKPXM0nuNJ6JGL4jON5KLcmdoSJT8tHu2Vp/Ialp8OegPdK8tozjSVmXoyZPnVy2FZ
+nJyfIoj1yFrxGd90ngwb5LeiZ46Qd0GrWbVgeZPW00dW67siOFcmb43l7BnlqAkuTy/y4pI3h3fh
+Mnt25mVQ0ghZXV3dLXxGaCZWqsXg/ghxZ7z9TGhWV1wvaxa6UATF9MX6ODS2UZwiq/XEwfpGcWS9
+e1x9HD7TKK6+LVgcudUojr9Vo6uP442N4kTjnW/q44QWjeJULX5/vj5OnNcozrOmYnH8ZJ84/qFG
+cdwenzjudKM4cs0njvzYOE7rG2drFIfb+cThAY3ioMInDtY2ikNHvXF2je68N6qujmgpEvLFeWox
+eDixIfanRojq+KFy/xTrbzgxu4NmZEMpKEP2UNAMsW7jsOtNDru+6VP7D38T7Vl34ivk6lm37Szu
+wr7PNrxoGhRWX7t27Rp9/ZNPPvnkzikWBqf87ZK/Hew9uORv+T1CCCWysgg2cfzflqUlaoM6oC6o
+HPVC/dAgNAyNQuPQRDQV3Y8eRBVoAXoYLUOr0Fq0EW1FjyGkNTvsRp8zAJNdb7b4fHi74pJJozc7
+jHa9OavJ08XK7/PJYnp9Pp//NP6VV145K//VDj17FpY2fNjvV+RY7+ff/RPmfu/5u638/XD79g/U
+9IPyd9vzhE9/+AE+UZ6369//47fI39N2jfmQqfcWouYI8XaH2WJ3mL1Pbxg0dofZ5yPv8DRmh1lv
+cpj1oLc7XB5IolUqxH2roXJYmN7/ivx3FndmXHlWOiRHKZHHrvW9du1a70/6fPLJJzXtwHkNVn0C
+q9j3tWu/7WF8J9y6dq3vJ598gpRxUWjBxg+1GNwHIVUL9o6fXN/nRvEPoQaZps5nXCOJnnHN00eb
+4TNqMfg+pY8q4y3RyePtfY3HW7HSYz+AWTpp9schkigPIZXecVqBUduUdA04m6RnyTVCM/dV9xWx
+sraktsSvsikMkfPQCV3cV9xXxcrfKmUyGL31c8F9xMadaZSvleVbH9MYn5ynjtiUsnNnfquU6UKn
+6jjhkuxvAxk1Js0pIdItxP3xmXJXqD5ORIhnl4/YjJnKu2rOTxEil/IFNd3ugg1BsQhZdPZMU7Yj
+U5NjzjTF6zNJpkZtyjTFaTxPSL1BJt644X7kxhToSSZCT1rlfoRW9b1xg0y7ccO9nEyTXy2nVcp9
+TAW32hY8HzQIqYXgWQghoRF9Xh6WS2HxLGB9/50SImt6MdKbfuTyTjk45bDy+Uu87Nppk88pIZL+
+6+BB+vHhw/SThqeMdRqfW3Ou8QehiHvi/6fjptw3vR9o3B3Nmnu8+3d/y+/Yqqnj4cOQcPAgxB8+
+7N5yoP4P4g57/lis8gfxyhv2bn/9Xy0nRC6W05CPD9D0wzT1IE09TNMb/cQn/j5M0w/QT2ROU/nU
+nSB7+9ehMBSFYpX2Ab2d6MEOemBXal0OsIPnY3TI7X/9OiysleYRU23tDe75WbUtdnJR7k934go6
+e+d1ucmg+3Vc4aZzuCi3+1PgCypqW0AF91ztDTeJk+a5YcH16wj9HR0Wu4Nn2RqVjxkc4Pm4ZH6p
+vi7Nq4WFtTdqianm/CyZEi6q1v0prqils68rlEy5fh0WuKV5QEzu2hvAPV9R2/JxLsr92eO4gs55
+nJHhw0vsbnYwCkVG2dJUAkpEaSirfkQvreej/mgIGoHGyrMvAqPLZtYYXTZRDrEv0uiXz8fR9IXy
+sSvgjiYxdiVNwy/eaHfY5BV3avZsx6zsuXOzocvcuY41lM6dPZey3/iW/FuaO2cOPTpnzlx6dO7c
+uWTJnKxZVJo9dzZ9hn0dnTN7Nj0q/5wzZ3YdeiBndja3eG5WNj0ydy5LMnsuPcZCzzjW5szFp+dk
+Z9OjcxmynLWOuRCb80B2zRIhkrNnzc6RbmTPnZvFyMihdXNnz3W/mTV3ThYjI4dKCgFz5sjY4Nbs
+rAqFiiNz5s6mx2Z7qTgye84cOi9ndhb+bm725iwfKhj19EhOzlyImZu1OYtRzqjImTs3pyLLw8+A
+TiEDd5gLRQQhFxt/yR0Dcbs5ZUytjxPZ3W7v+Et07ltTyJ3FJMw9wwPL8GwRLpGViCiQ7C6nELkF
+/6Lg8cZ5ZKNsLBeG1Zzn9zEoCMRq6fYU/IsyHwhkPx9fPx/gn2uEqD9ueMZ4b1yj+QCHSD9OwT8v
+5nNrenpgwXsXU6EHTNym2vtgKfeITBHD1RCv+IQAnkENBbW7nP5AbijQPpTJOOs4wcQnIIyQ0Q8E
+dlfVM1lhnzgeIaPJjxzizkqPun9mQEu512te8dS3gAyEcqEMh8sPBHZV1lPh2CeOR8jFcOCd9Gl2
+yRbci/Fu99cyYGM6NCDIU6b7tqdcjehgt2RhJne2ttkUIbIbHolH1iz1wjamRQOC3PQUe+vHlxYF
+D94pDZxC7rwI5VDuftoLK+MRTFyoDJmpyYRMQYhUUGGfuAAWy277EhlmJre8dtoUIbIRtkZl05rk
+sv3EJ3jm+EZl0/qBxQSH8FA8rGY1A+MO1zq516f88ZmXJm/ZGB5yh3JcqIevG5XNgwe6Qlf3MwyM
+7JD64t1TPHztSw9oBCES64j6nvTIPiBucmdrejEgaQv3prTFA9qEHtAI5A7WAb0nPQoevNP9AAOi
++/Fuut8D2riutZmQqcmUSfLGN6lvkCE832ZuuUKaD8qG8okKPvZPYeyfWEHdt5vylWIzzAPp/bcd
+T2d3rN0/e1NKi/FMPFNa7MVAGqU3IuRNede/IrgEl2iKtKwpSrhMk2gSXG6KGhE0idYJy/gkWf8y
+CaEEtUu2PaVmPgFsSM0zJ04JambsWERqC4vj1DyLY3bzKyrnUjq3smIuHQGddu3esws60Wd27dm9
+ixbBwy+//MrL8DCd9fIrL7/8A26/pn37NdKxNe3bE6eEzwwefAbTM4MH19aRhdcnT77unn198mS5
+rqiZfC9Ml8eW+BINlp3BqQSOYECC7HP3TWMe837ypiYvNC/TT4QaWsHMrtEK+IUG0kB+nvsd92sk
+j7PUflT7PpeGcKMyotC/KNMXf10KsvbPKUdIhabUgbCEt9XbiMpDLVEn1AshC7PumSky+5GZPLMY
+mWlktj0ztfIblxxrkd/Y5FitQfacFW/LBGcOA9CJmWCVnXplGzNB0DFkOa5M6J/SLD03qzSvg4N8
+mJuekz28Weu8NttS89NzM9vktXe6rbnpzVKGZ3XJK/2u45aO1kFnBpP2avV9Op30eMctHW2Dzwxy
+n5B/ryrIssaNTcwsbD89zpravLBzfmYiZ/N9mVVQ1LpFZmLNRYPhvshIPKjdjna2fq/3I+3l39Lj
+nt8ICT71zdbGFnlVjJyNalrLfD4YmRsIUVAbQcOaRGM1xxs1rAI0VpvVqHGqJzWu/wBjePPmzZuH
+L3nqKdiQmpKWmpqW8lvzkgijMaLk86puPu3Bna19PiTEYuR+M1pCJhySPj7E9at5VxT5TDGh1t9o
+CVFzJeoQy0737EM4HiHENekHLoSQ2siIcqptjE/wP+4XePOUvLz8vClT8vLz8r472LtX794HD/bu
+3as3Nf41d+FN9E4Lk6kFqFqYTHQibBhht4+gY0fY7fjpv+I7QJPqOGEZH4eMSFsiCqFh4YEoTP3x
+xTdZN3klUys7wWTEe7xjKkbrrJPGjD529L0LR4+NHsNCF95jIRx64djRMWPGjDl67EJ9CCFcn4fs
+sytUrSgAM3Ou8Qm8pyYmXYBx712AcRcu0PUX3qPrcYG3sNAcdsK4996j6+lAuv6994YoZfkbvBAv
+cqyi78a7exd9hlVmU7zctcFnWFU1wmu4C6/SbJymKWLukqdlnmmKGBdBBWsBOg/9NW7i4Yy7cPf3
+Nv7dqOk8hhoqkC/uABSh4I6TUcfV1wZDnmnKZPjhCQU/HeBbKSwTadSfVY2SkW8+2rvz+fMM8KZ7
+IabzFD6kdWQOn4RUnt6eqYm3yV345ZflzvnHB5OV9S6tI68pcIh1mkxNtpG8RmcxQPIBY2whebK8
+Zr3FbxES5T0aAq/5ynhB3uJCdU31U7Pn7qcnuc9wzZzq6jmzn3rKPUMo+OMVhAg6RZHoJyTK/TmW
+zWu+fbRx/2XooN1fd046SOiyXu5/Si1yIPe/63Jf/KOtKvH3Kx4bLVgn5yv7YNOycwEvtyWBh4ZT
+QpcID9ZZ/O6ad6DT7l27d0MnrON0tbfgJYaXFsHcFwcPflHBycqCdV6cdi8b2DVgUgqUcCrCS29E
+TQmfRZ/ZvXvXbvoMU/OgRQwhvFR7i9PR+QpSQALWES2fJFt0lNtKL7e7wNDIDa1jhMyob1tf+Gwj
+p8mUy5UpvPwyPDydPrNrN58kU11xZrACT5m8SyfDZzpkprWreUHYvQs6TaezXqZ1MxgrMuKawkO8
+TYE22DOJltXSdNY4tI6VgnUWmdewTh6vtR76G2iSy1E/c0z3dmmlQF4afXlWWIZ1Mp7M+prNtHvq
+ddJ0L19M9zYZ1nk7rFKzd+9v5LWuVxT3J3uOe8aZlLi71vIMn7ySx2CHC+QhzoT8kb5RnxC9F8zi
+rXDf0nNLlwwbsazZ/rmzn6quqcY1w5cuHT5syZJhs596avYcWZenQQYS4JF7eCwJMSniT3ibNFwR
+ZPAFCKG/ga/phbdKI/4M3s8X3iPJ+1tY8GJukPIxaNKIDm1jSqCBIikFD5MelVLxUGm7N3XNK0p+
+vutpfxSEEMpR3E8y82u4otfsnj3nSB837EAhdXbPnrN70h31O9G79i+8yY8QLR7mTbLUB/Tu/a3G
+Dx/CQ++CZOtUpFooJMrrRxSqBkOcC6nBGiciNSTE8UgNXJzlRzh7HV65Dmdps+u04Lp0CvNAMAcE
+81ItlSQ3lbiY2lu0dsEC4Dkd8AsW1DzK7YXkDRvoB7W96QcbNvjWI9MxCVIkAh6ZAPNVk8q7WPVN
+kdt2qLR9KV8gJUlJjFDSDo+4Oz3Up5e9Uqfii3hYzfn69LiTlOQVOU/BI/4qf0/6ofiSnF5pyaX4
+Mh7RkPwf0Z8ibfdJPwJfrunmQ3/DHK5FcXetEASRIyY+tH6WsN01n2OyZAl904gHSE8YIUcZwOkz
+S5beNT1+GNzlMK2eQqsPDx78Ina/2CVYSmmUf8Td+fNO79zkIcN4d/7Tly6BTp5x/k0jfkfKMkLO
+kiVN8x8Y3IXlOniwh4guwXgY+rv8sZplacLK9Oh0JWhN91jPLFmC3zXSNxkFsHDpEsgxSjubZk+e
+lbgXuwTjS9D98ODBtZbgLoeh+z/Kn9U8G/lEwPeq//6eWTPHKGUuWUKuG+mbS5beM/fBgw9Dd3wp
+uItUA90PdwmWHr2rT7qAsY5XqhLHrWGsco++q1iU90oDuMO193FrptxThqXIjhR8S7k1NfWyrlN1
+s/ib3NNN5XBcx9ojU/hMPdettoB31rzuOd/xwiryPD7zYU8cw3ONj+c63CVfXOiePYUb3YUsdXPc
+fbVrPHi8sAoebrTZE3fXOCbbhfOUz4duP/QUQkIlfwmJKBBpUTxKRtkoHzVH7RACu1Ejmm0a3qgX
+bRajaLbaHHx2LOiUnQZz/gwmo1402RwWz5PYDQzYYbF7HzIGMrWTIHQSsCv/TqFbcheSc2k4890+
+Qc+3SX96qPl4S6kDlAsC3S9w06GPKNI9QhuyaErhHekoafZbEY6UU/OXBLpTEGDg1mJCi7+nJa+5
+uKP/GvzccOOe4v5HR0XS74Qugvc//Xr+LFIkcckFhBZzMzwpEaDRdbXiRvwvFM/Klx0LTjvbPBmi
+gRkGzHY5m4EtpxiM7Cvb6OR+NVydrrc5H44fFVr2hz1vUXqOKXCHLZl/s2Vm+kz8r697miMnZOUY
+20aM8DtgyxqfxXGBa/uqUobL7TMcIeEcf0nWHLAb9fY4MESD2WqzpkC8qNeJNqtZowuB+AxwuOxG
+vaEZZBeDJl4UzDaHy2y1meNDwOxwupwmB1mWL2A4AWEp2KA156VumKQybVw8NqafBQspfECRv0sn
+vN9TEyyKXMuzLYfF60j+mR8gl78kzKERPYNUIelhI+whuEWhVD4iDA+OihCEwBF5yfjJwjwh0F4S
+WRiKV3/46Rzl/KKqrk6YL46T927RyIJaosFoOTqOPodgyIfhsBpOw5c4BDfDI/AEPB3Pwfvw+1gi
+NlKOEPMS4bILotVmZyGrzekyGAXe8ySep63+IqnNasu2Z7vqry8bDXZB63RlxwJzjSDKm1uO+YZx
+OV1W5iEBjDarPTsE2N5XrwsBBmCOzwCjwRzvcDHedOQUA+NPezaD/Yeg/L+BVvvP0f4ptbp/Tq3u
+XsTm2LP14j+hgPx7dfAPQWOhCWiTUtUDumRS/xlS15/lf3cLNOEro+f532AO3T+vl3/OcP+cMe7V
+hG6VqkV4OF851y/MUiA+kAwkxUYI/TaJkJREQkqsmNgSMH599u9PH7gze8QyTArSms8Z0H9pizTX
+an3E2oVZuLyjLmxir5X0Bh1LP1/Vf4Ze27GjtC9JgBANFhI5Tq3KzPBTc1yiAOpgPpHwwX7pWWII
+D4kWAcdgwcJxwWJqshjIEzMHwUF8AuGCVEnJqmCOjEzgcbAaCxbCB4kpSapAASfwEBLImTkSrEpO
+UYWQkU2wyBCNsNS+ocUgqoDoAKtImJFTYdBiUcBaTATOGEZUQIx6AqHAGTD24yLCeBXGBk5N9Bir
+uPAIzg+7T2s5UcVpCVbxBgMnYqIloBLZC5EzGHgV7q/jQA1EfmE08F4IosVyEl6FIUqLZRAgKgai
+IiCDYC0GFW8wciqYqMVYrRDKGZsQajASgfyuJ6IfEANwKj4iXFBxWIfBTyR6RlhYOKfC/QzekqiI
+pyQE1FgPRMXLRTlgwTjJTAg9zhrXAuC2Ki9gsJmH4BAQElhtJyepgniSwOHgIN7MccGqlFQxmHP3
+UCpKT7AfH87QE32jiqJdjRyEgvyGRDCCsB6DmjMC9hPCGASXeU8IYgCiEsLCeT/sbm1hzU5432bn
+cEggl0C8zU6WgLU1L7a09sTJqYQkpXE4KY2Q5FQaii1WjC3W7SPXPvLuu4+sHdkjzt529MgRI0aO
+bpsdA1cnhU3XDvy6a5g6qnTqrie+/PKJXVM7RIQYun7dRhptygsJyTNxmgCiN5DAEGxyqdUuEw4J
+JAY9CdDAhdicoKCcWKIWeZ2W+AXjmOyQkOwYHOxHtDpeVHeIzQkOluOJXsvJ8cHBcjyn1RNRDZE+
+L3TEL4REZwUHZ0WTED+ikxNsUUcJQpQaRAH7BWBOhYPDRTE8GKs4HOCHBZF+ron294/WEH8egvyJ
+IIImRhRjNCAKxD8IeP+96ihRjFJjBQGvwiERKlVECFbxCgIIU3LAKo74+2NehJBwlSo8BEQe+/sT
+ThUWEimKkSwB+AeAIII6UqWKZBRBgD8I4uNKPIgcBPpDIHh+BoJ/IHCiKzRWFGNDQcXjwEDMqyCU
+kdvw+2pDfEAg5v2JOsrPL0pN/HkcGIAFseYzT/NdxxYbJnJYfsK8aFZ1clVpdcQ/mIvMCAnJiOSC
+/QlrixA8KjRGpYoJBX+OoRZUWBPt5xetwSqBoeb8a+uzJixrPwiN8vePCgU/ljXhVTBfwxBosEoE
+BUDDaNMoACCqpIr65uV0WuIfTDwkBfsTrY4T1QiFoAUICftEtiNPQ0WoCxqMJqP5aC16Eh1Fr6LL
+6Gv0B/gjBLJoPQSEFGaV2pFTjJ32bLuBlxcqskesYpAtF7MdlTbbaIhWlscZwBYs/40XpOkLaPrC
+9bc47kpi+fdf4FbpEbps/eAHDTnWjIKkwBGLOVJEa7r48cHBMak5be3duLi4TFeH36NDxIiAoIQg
+iAja6xNeGhUiRvgHm4MhImirT5j4hJ/zgQffBC/5RPiGpR6+KUJ9UkC8b4xvJjjO1bWwQ5uCiKli
+Wm5Z31l5wQ8EVg4aER9vUIeaM7KHT4uIzLRmw9jYwBAhNlRMDSaxOhXkxwSGiDFaVXogH6NTQU5s
+YLAYoxM9Pwu9wEFcrE4l4Ya0cVoVdccGBgtxoWJaEBerVWFRQSWmBfIxerGm0S/g4gKDhdhQlQzr
+VxvYKNv3Y3xyFb/yoFVyeb0+E/YLIQToobrfhT38eeTHZI2RYAKtH2gfwr1OncY9adQpGk4jTpLV
+OE96jT4mnYPBdAfORQj7pNP6pnQRPyBgCgBTExytlsN0qNwEq2jEIytpWFOUetoZAumX8BKEwXHa
+TpGPViGOfMtfQhrZpr1O9AOX1uICtqsEJzmYh/2kOfB9Tyt1fSZd0zbHawP4S9iZECg9JP3yJc21
+DcQ5RmNkMV7k78WHhHn8VRTOKM7WN0bIxA1Wm8b5Hd+uJFJ6Eb7qY6FZX0k/hOTAF7V7oW0pAH+1
+PD5SOksjL9HC+PHYoE6LyoZPa9ytAFry/fIQQkTOYz5/RbZdbkXoLqM5is2cOA7zHsM4RZAdA8J8
+2p6ep2/Q9udh9KnnYPS5msmwFGfC0iP0WVpNnz1CBk7o3Om+7L4D+2bjW/Q87QBHwfUSjHnxRbr5
+Ffo1vR+WQNghaHHgAH3+UOcJEzpn9c3M7KvIPOcgJDzGX0D+yIBaIoQaLPRYfC2VydsohSh2aujI
+SWAuCNnRoZq9VbPNlSOHlUev4w+tGzWqfbvRtdvajR7drv2oUZwx3NDv2Px5x/p2nTiLHqJx9NCs
+Ob36HptfebxfqPYBOAPNoRWcgW9GtW83ahRL0V5J2UNj6D9/3vHj8+b37+o3dfMRSTqyeQrXqz91
+Hz8OXH9NyMpjd2Ag3XnnkE9ZzstlaY4QeA1aZTst3qA92wk6RqYjh+0G1Uw4rmZnn55Nos33pUEI
+k+lfN8rdyUsSZ6Yl9Fn6Ai15QBva73jl/GN9e82ZBV3gM+gya2LXvsfmzT/WzxA+lXvEU/j2cnFG
+jaKBv9MnYMDvR1aEhPYDfOwYpf16kykbj9TWHtk02a9rf+Vdf4NmGUIYzamTuOv8qyhA9pTp0xRM
+K0CNzPFIjezZRjDiRavOn1+18vxrz2tDN9G3r16mb0PGh4O7Q/AWMujc6pXnz69cfa6ZWruZltF3
+IAsOQnY0vN9HqS8Qt/PnZV8EWXIurK5yrIgFmH84UBw5IkcOks+J5Ta2ZyP5rc1qw/aZ+/bd3reX
+PPPT3r2zZu5zP2+ldceP0jpUd/xkJcYfR6G64ycAsZfzAgLwh/tuM3jcV35KN7oPP0rrAI4eB6gk
+eEm7HscBFATzAgIRQigYLUfIbxx/CZmRAzVHnVAfNAJNQZVoBdqC9qCj6Cy6ihDYOZ7TM6mSRWZQ
+R47NJM+wep0on9fkMI/3dvYmHEzMFUQh2PXEx8yet8exprf5hDU+YWwQRMFqs8qn5mCQD8yVnwaI
+gWCwMXN+riLgmCk/Uf7tciY4DUZPOkeOxan0IwUNFIExBkTZGTZLKnsYMHoSe95xv/vhfgAQkjBK
+EOmYgsBW8bXb4lsFFtIxojDSAmpApOPqH7/6o9fXtx956tauvTe/2bfnJp6yYOCABQsGDFzwWFyY
+0WQyhsV5nxciBK02PThYpQ3R8LA1RNSFaPjIkCBRq02ngWG64GSDIZSoknDvVA1RJRkMelElPZ2a
+LIo6Q1iSiuAKQ5KKaAyGFEHUU31wularCgmK4jUhWh7mhETymhAdFxgUyYeGaDn+kmbrk1WbYXlo
+ija8TZyREGNcmzBdSuhy2Fz15GY6o8OmDnmD6HVqgJsQMyivw6YO0rU9N2/u2X3r1q4BCxcOGLRg
+wSeG+HhDWHy8NDXMZAozxsfjXtb0oBBRq0sL8ZduadND/DmdNi04SIAhqqRUvSDGB/n5GfQvB8cH
+qfwMepWYZDQIwTL1gt7gpwq6JcoPk0rUGwxJdF2oGBScodFx/iFRvAYHajn/kEguVK3lAlm5kBo9
+gpDfJHmuYdKstHpOHI4modloMVqHdqM30U8IgcOsZ2OJxsRcl9gcZj3vY+WM8/Qv3OQJwYwPXEWM
+YRqFBYPR4HQ5rTarwLNwEchBb7wYDBafMHhhRcHgG9bexfiW+r6RaY5nmkUWQ7aLMxqMgjnTXN+D
+XAyCQfKKHC7BYtObOV5gPtxdFgbBIMmtrJA3XuYffeV1VS6t2f3Fl7ue+OpLQCfmzTsx79n0uDjv
+f/pTqCooJE0bKgQFpUEfrRgUnKbVisFBaZJbNOgNSaKoMxqScBtRZ9AnBbMv6SnWdDpRTDLoDbiH
+kKyXf+gNOlqkTQsKUYVq00KCVDBXmxYULGoZD4g9F/3ryh/nr/xrEd0X0SGqGV3qP6t50J07uGVY
+ZhhucedOUPOZ/nRJs6gO4eE2Z2wslIaEtC/yv0VfowmZTmqmr33rX9Q+JARKY2OdVvJSycqSrOY/
+0hGw9cfmWSUrS9p+9cSTX3755BNf7Z93orLyBOjkwklH5QduHZSmVUqpEaU6uXwhQalarQhZ3vKJ
+ouGSPkkU9amJKlGXZ1QKZBBVifv0BqWkophEp4eIWk+pUuHLIFWot7wQ12aby9WL/kqd8DoE9HK5
+trX5QgiNa2WNsAbseuO1Ecs0AJplI157Y1eANcLaKi5UiAqNTyw06HXaxcLGRyfNCwcInzfp0Y3C
+Yq1ObyhMjA9FCGF0AiFhuizHl305GjWiyaZhpwLP8wdFsaZMuI/7jL8k1uQKAn9udK2Ku6OsH44j
+JMzwpItvnFLUCyIiglkw4xRwuLKNdtkt8nP8M4JQ00mYyH32cMckerJIUE8PGvGWcefGdoK9eOjW
+IfwloSZXFD3ZbBea59NS+lyzDMBiuwW7pz1zemaTfCMa58v7gdEP9CKYwWzzA5/8an+HrbBxEB0N
+m/rRERwdw/WDjXRM4wwX0TGwsR8dQUcSFju+H2yBrQghQFvQDrKHL0E6hLR2cEK2y96QESDHjzwk
+BUAEJ3A96HuQ3otexPQ90guy6Dt8CQ1QwSchPShHRVIOv9OAnvAH/CoipJLl/L+KM+R5OAKZZbcK
+RWguQmBn+8ZCyKkPWLwB411R/F1Rlv/w0AA+IDH9hXzcE0f3F/NwjPzrd05+F0OiBwj5YMVRA8S8
+fnKM9NT/0xmDAO/4m3EuDH9HZSauz99RJRDnyXdUCTgXmr0tJhDXmHfY97fv+Jlx3hZ+Ei3rGCgG
+JRn6pgfhZk7psb563DPMKAj+fe0W3Mdp5wPSc8Ndalz50quTEELID21CSFjLX5VvnkchO2qFOqFZ
+qAKxy906vc5ocrpyzCZBazCanMUgiPIC12E12wSRyVZlf+t22R0SizCDXSeIOoORVaoth7kakhUC
+XSYDz/bSeh0vGnWiyVoILkdCBjicdoPRqsmxmwx6L7oUsAHLzQUMt1l2deKwx4A+E8wh3LwAElO7
+I6qYV5Nfgvza+eX3yoyGuDwMzmatU7vGZYv5Vu14Z3TYTCnTbygs5lTaCSb/ifSjE88dOBTf311k
+Ia3pyFCBbp6L1dKPGo6OyCZcaI8Pzju5USbnqp7mSY6WosadIvhzLQM4uknQFgcIpCyP9y/BRwTR
+1Tant4Gjb0WEGgyvTVgEnVzdBF7TMyouY36nRChsmx8Umzq5Y49WJCl3ca/J4w6tGZFWxgsjZtb8
+HBSg3ti5V5i/v7+6MME/RCWqBYx7hAZxAX46ChmZOGzY1s1JhrYcFEeEasPh1+yokn73tSrN7Z6Q
+3r5YHoeqEcj7MHaeiPxAK5psWj8gJgJkO17VndTkCL3xRumtDtzPbaR3+Kv0/tojROA6wiLqcA8i
+j0MHZT9XLe/n3kV+MhYjQ4NcZCte3oswHH3xXB3/Lr2/9gVCBK4VVAoI2C1VYQV/EbFTKCJPg/Zs
+Q7asIqnXicTSoCwZDHqdr9IkMejVotmpybER7ys24zMzp54dkz3bSGZead/bYu3dPLVFfv/coH6v
+QetObfMcZnOpGDreEhhgyisxmcd2fMdv0qA14tudxpjji3PjAwMs40PFUrPZkdu2M1FBzuiJ40oM
+3bpNSbZUTKPfjojSBEa2aKHX59miNa7k2ARLZFJg/Egw9C6n1776CRyt29GbI+MDkyIt5phUlyba
+lqfXtWwRGaiJUs6xn4IYYR63CRWjUs9OWhA5rd0omgUx3pbDzuDYibvNHC8a9AKfQHJc7IhWZ8x2
+hVoM+ky9TnS6sh1yz7AStlxwWPk9E3XtD+oAuSK16USzaWi4Tp2HoVMQrRHJglaL/OhhaXNqC7sq
+aHKfIAckv5I8YHhh2855lofhizhuEz+1V3hCaiS93T7ogN+A7mmdOv04oWTEb2oCw/P9SKrR1jew
+E3yiE3aobMM66azdkxISggroLpXfwnbkqNL2XwnzuBUom52AKxa1nS47G9zkPXUzcNW/NSnBIrAz
+n/12ZgdCcEplKYVR4aFaJ7c4Izw2OjviCNC6VkXR4ToDdMMSSSmKzo4gqSp1bArMSC76qiglMjvI
+nwTiiJjUyOwo/8LUiOzgAOxfmBppjzBoYotSEWpClzaHZWY0GE3ZiuFvk9FkMHqDdvklGxmM9vq3
+1UXJMCMlRqMiqRFZMUUpEsHQzaALjypsRevgmcjsqJjwzIc5pzY0LLowRSrjzKlFsRpDhD0ytdAf
+BwRnR6QW+kdlR6bGROBA4h+UHZlSpPDAYXRReJBbgeJRK4QsWmYRI140ZZrjdXaTaIrXs4c5Xq9r
+BvLDLlptnvpkDxNbQppcJqvNySqRVSERVO0yTfQxp9F9letSjuuC6Pbycsj3u1ZefkBbVF4eNQBg
+QHvuKfa4gemr7AmjOYnrD9CfpMa1YPXa4Ueit9jz1nTs0K5Lh7YdO7SLhtGjIJh9aUePghAYPao1
+Lk5zP8PKsBYhriP/OjKxMrh4ZqQjx6ZxmuP1GpsmR344cszx0SA/9PJpn97oUB4axtQapmNu0GsM
+Rrbps2gPEX1CTj5u365jx7ZN8w8do+TfCheluQ/D1PZZcTDUaSC28nIJB8Gwbt3oK36flpcfCC0u
+L49iherAVbPHDQLN2JNu4LDESu2+aGpOlyYXdcBb5bY4hN73aYtsR04K2OzKw5TtyDGbbKbsHHOm
+2cQaSdBrRcI4ifGRURBlJpIZyGD0MI8gkhfLy4tDD5SXf+oHBd260UeDMC3vwrmvGpx0e1xW+8QO
+jLGam0gqo0ciHIyVKTxLbrBnNdeBPX5s16Fj2w5d2nXouCbPbnF/G0M6pxXj1qNGQwiMGq2FUaMh
+GEaNAbkt6v7gOvIvIROTKGl19uxCcOmVh0Znz3Zo5IdNw9rIauZtFmZ9RRA1OiZkE5mszSEvHq02
+h/I4pGTetmPHdu1xfk6Ckn0RbqVkPybUk/1owM+UlxfqDpSXX/Ojr5aXw9AgCcrLic3ohCGmzPZJ
+HYqS6dIWce6LrEzYzdFNMuvl4xvs+RTXnj0QipZlSU/zV1AXVImWow1oFzqCTqOX0GV0Dd1AX6Nb
+6Db6Bd1BbkDAgQoCIRJskAIZYAcXNINihCBOEYEb9TrR7nQ4m0G2XlYUsQmi3ihvr5iv5wxgE4ag
+F1Ig3sFmC6PLYRHjbWzeaAbZseCwuawOp8UJOqPdYWV4jKJRL7I9XDS7h8m2azaXw+YyRINodBZC
+DlvsxYkCL09RZrbJy5EZI54tV7T2BLvR6bA6rGa2V7UIZoFRY5Q3dFamrMOIYEltDjDYZcGeS16z
+sPWOQa9zGRgdvD67GOJFXm90WPQuB3va9S6bKH8UIw+i3ugwyk+7g7eJeoNeWShZHYJFNOh1xuxi
+sJpFi2iUQW0yuMvh8nzIHshakz9CCBd2pCaoiksjivvcKbpZOqhjc8He+vmO1U+3vWBPDc7t2Kfj
+Dfru+NZiKWczZRY8FB44zgziKLqlVIwNCArSBsVpN7ce0afiFVOEKSg2PFZo81nlJCvdP0YHk9Nx
+bl+hcwK884ZgGdyi5bcChIWNjCsVdVE5f7jjNcE56YOKfxzxRjgeEWy3Ptg6MYv+mvbB1RSISojq
+MC33hUAxzaDWhQt+8HAICQ8KoxtCISGCfhD+ZiRuYyRT43Thu8KnhneKk/6YIrYWgrWlW+O1p0hI
+6OfZ+sAiIXaQ9ASJDg+fFP5mZEi4LoK/8uL47q2njBwoONKLCvNmO+LD0sTxSaHDg0JTrUUzZgwY
+NmxYeK9JLdNSgoKGh64Xc4MiW0yaVCT0GjDxypdDounMGdPLJuVma1NXttobODAmNzUsKGJgi4QO
+p7eFpugC4rMOhHXrl/LeiOQfm70blB0QtHVrL/+uG7cSXEZD5/Mq/3bWYr9Rk7TfTrSEB/Ch9wcN
+DC9vnhAkPpYdmDcmzXFfpip7J130WvAL/oHNx1gPtDP0GVg2oJV2q2Vx/+Li/l16tQxK5VP5LP+M
+kGw+K0uVIRTiZoLL5XIV2vns4KxcMSvrQZ7X5mXmju4cbYkZ2r/V6NSQpNHFofybWYKrXbaQZcoS
+s8OyhWwum7f7Z4tZDeu4K7K8XFmHgagzWtgy28zH29g2x4VkeQX76chx2bkWqybnzJbGBwwh93X2
+G+N+/PHxkcvwBF3A0AD30byAocH8lRWduiwdKu0o6hNxYQjp8+oBR27Y0vHYFRlpKIt7syymRJY9
+CvXyeg1KQJmoAJWi3qxXg0Gv5oUUiGObLDU4eas5jgh6ppsYp3UWMr1EJr7Tsq2VSyuAEA0mh9ri
+dGkFi8FoYr1Ia/AUhnV6pRBOsgQqQDWFvvzSxTi6kNYMg24ncczuPfTit3PBePhT6HGzD9D9PL0Y
+tXfFhcxcVXb6xRX0s0G0F8SGat8T8aJBhPaE2PiU9+bQdt1yw1slpYtlebq2Nv4KXfH0B/SgtBYW
+HDgcBeWAF1YPoFcPvURfub4U+m7YHQJ9PuNq2/aBibHRdE1vkthuEK+GuI+CAukabtUjB5ulZ4fD
++C70nTJLehtnbDeLlW+XG4WQ4NFRu4JCZCcYndF4tEr2lerIYVMsk3VaHTlE1kRnC4RspmOjY9OS
+LCw1gLJY9iykDbJvCln2xJR52GqS+asAZdFtNxgNGuaMSRBtDMiz6ARlpZ5pt1ltoZZgUE5WWVrR
+ysY+c7zA5bZqCwkJeFYP/+DijmK/IpO+1zTxofbhNLFfKVGPnoiTyYsTdqdnP9kfCu/vP3h2ZY8y
+14n8jMjW8XGx0SURruIdrcvo7p0D1K25MTNfDnpv0vH5pVByrLsjrHYRN2vCkVCcijWgOxRvHfEC
+tMAlk3cHE67yMDySuKx5YGAwrw0d9LIlLDwYB5m5FENUC95lojc7r4qzxqe/0UUdIkiHy4WEKty7
+dUaHxOZDBgzvWJYcZrSVthzSZ0ohv6Pl1ZycCRP9W86ndf6PHvNfmyiKpeEdYrf16hOx0l0aFr7+
+8Rl46BIInjT6iK1Hq022btEFfv4Ji9ciZETD69zcb/wFJKJoZEYpqBj1RBPQAvQEOoCeQSfQc+gl
+9AZ6D11GH6N/oa/Rj+gO2BBi9cekfsZ4uWFcNmXvwxoxwZEDgmi2uoiBLUxsVq0seWczE9Og0us4
+pumptzkSbN4Xep3SYk7i+9oocEalKcUGUDkLBqu3aRmww+nKtGcbRXn2sprjvSAJNifoYsBuzXHF
+BwPPziZsPl+iy2rT6XWiMdORYxNEo9ORwxudLkGs/4qFTDavyWcaDkGfWQxWplRo1Nsc7Cw+zuZk
+h152h1WWw8sncbLkXrTrzHqnizMKJqs5nvTiAgLD/buIqhaucEO/8eaigKA3g/CK0kchlC4eFBsC
+PUdHBvnz8T8ERMSTA1xJemohOHFIxLT29GsOV+b0PADHJmW1xtC1PV1L+MmdxrwN/ngG5jeMfkKi
+P/MwqW27J7CecAdM+U8/1759ezov6C1VYc6E/saw3BYqMRm/J4gHiieCiAPpjFBTaEiIJj4UTk1L
+jRy8cao1edDcMk1W0TwHFNpGGQqLsnMKUiRtZNrUfqnJ1qnDdVmasu7qInv7kkKDbXRBTjZ80T6t
+rTmZ/tqnOwwy9sFAsM5oTEpmglhCAHqHwcDufeivyQmtuDgV5+evK8ZhvDG8CHNziuI0frrkTPe0
++IK2ZrI7QMX7+bnTciNyk2KcWWFBgl6f5ByZu79vUP+Utq3itH4RtuSi9unBAZponjfC5uVBS6xD
+HQadGBKNcU7LHq3jIm0RtidNg/NiAkVTamKn7q31IQExyabohwIxV6Gao588lysMM/JhOCMlaWQ/
+nTokKicWjwyN05haxGviCkoyrXn6YcXxPVMq22gndlrbQTV3iCZkYXFA4JyhS/LCR/QPKrNmlmjG
+xRcPS16ibVPZeolKaL+y94qA4oUhmhFzuPC8JUFB/Z96W3gpJ3BXf8EZA2GA/W0Gvc1gsOkNNn8M
+YIhxCv13BSn7qCqE+M78VeSHUmUv5Ez71OnI9Oissq2ycps1M4W5Szc5HRo2Pokag53MwrNmYdXU
+B8/eH0hef50seOLogX3PCE8Oo7uH7RWe2XfgKPfJIYwPrZzc+zH644gTP2P8c/Dx3sPo704aePTA
+gaPwixP8hrEz5jqJW8xfRQTFyVTo7Q6bw64YueL1Jo1RbyNmYnMYZYOQZofWrgSYNTuc9vrxp+bM
+8YN1dNiqxXAIFi2i42DDjCfbQCD9pQ39FQL4koIC+8iRO3vD09LXy7Y9RL+HZxdtnQ+TD/be6X4H
+TrcZNqxNfV1sFdsjEcXJVvWynWors0Ejn4ekgMZl9wMz0Zg1hJksJIkXplVUTKNLYcbbFy++feHD
+j+koOPDRD7vpR3Cc3vexsEe6shpUq90c99inV65+Qt/6jragiSDQGjDSb+BfcFQ5wyeH+cuyt24m
++jTqRbNe1iyXtchNNofL5GBiTrsDLj8l7OL5XZAgCPRj4SmBfsLzEP8Ezz/BX+a3CuuEycIm3j2f
+58lcfpMwWVgnKDLgqjpJmM9fQikIycrrzLy4S1FTZ0YsFTV2FzNkybJkElaTxmxz4NNwTBB24GbC
+WEEYK0hndwgCbS8IVdIrwlRBmCrggiqmoX6/IF14XBBoO0HYLp1TgHHudkGA44LwOM4Q7pf360h4
+kL+CIuUWtmcbzTbvAsSil1fqccTKVhwszq4XDXqbaHa4bA472aa/9p7wA913YSD0XLwNYMfSrs4o
++sj3C2FRNa4gQtGFvc0P7SMtqi/xV9Y46evbT9I1H7TJFsZFBEDRmW+g6vs+1H6ezD2tmT2bnJ9L
+EEKcvE5awF9FBtQFDZVt0LDpm30cdiPTTY23yXsPth/N1hN7gibH5jBo9OyMnQmg5a2PaGOTCEsj
+C8/UxaBnRXIQhytbLhUbm2URDrvWl2MxKJsrQcxhh1pqC74ZWZJusqS20PO4dUyq2ZTWVX0YZ8er
+/DEk3PfJZx0zynZFTG87z9C61ercxQWzevaekzDsNdN0sIYScx0a1HzRGrx9gBUCZ5nxgwvnH9zd
+J9bZn37AX+2QmdlKTOSbRXKpqSPiHy4J4N1xN2DSS++Qy/TbBPrjtRYGPpE3DRsWxSeTkintDx0C
++DKWP3Od7vkVE6thX7LQetibP3zzAW7/zbhREPhWKYdjJh2/knHxWFdB7Pb0Cxgm/OLVD+F5/irq
+j0ahifJ6N8Sz9DGa9dnKwshu0KiJPA+yCL1gVhZQeibHZsrTzkIQ9J49HxNWx4tYk+Oyy/Otwegw
+yzvMDHASndHulOdGQT4OdDpy8JUW3bQhFfbct2DmrJUPz9HzN9fP7JI7MyYoOdAvVWg2sGz0xLIt
+Yp6QFLwhvdu0LTd4/ZwPimfd/+b3Yepebz/MlUb3sSfCpPjmq/mrHUJmp5d17fHa0A4z5z366sEp
+2l2U0s/o+QNpUWutyZg0w/HfZ20gXOnkZgXTOq0Z8IK5WcmT8Sn7wQSdvtmtnXWg9omM0Jkd+r/5
+/KjZoW2lujXtxywrnxvevnjn1GFLRhbu3YQQyHdP5vGXUXb93sAommVW07MTDye7nGHPdtnYekTL
+6UWD0eHdHceLTvL1dxc2zHlpOTm562rVKuomb3IR0CdMnLz9hY1Xxwun/b6ByLlfRreoXXK/ZejM
+Kv7yc1svn1+6bkHVfb0JzVohhsOm6B6jX506LRBwMP/Y08G47+wEOqK1axVCfP3eIQCFIgOKQCZ2
+H1XW95G/tTojEAFpDcZIcLr8mA4gsmmq4Bg4wQVHaQd6nr5O22+aBVX4FfrE/oNn8KC3qugSOD2Z
+Pv4+vgkupgolA3WEI+D8Yib8Ci9x9Alp6CEBj5Y2qJ6EY3TjpF//gAEcbYHq+ymjKRTFoESUgxDo
+FVe5MklE9m7tqwjB51i9+jM+pJMN/mdXrzlPh9Ib9BP6oC7s0PpVr8LrhdbkgoLkxGZS62bd8vO7
+NSNfeAvhLRS+E7HyxXe2gAE20Qfp+erDZ7Yn5ecnsWTsk5RfYPMWRykeqqvz0Hwp1BoyFCG1jX3j
+RWqRPfkgT/wC/qJPPOCH1GLIMIT4wHukH4YQfqhJ+nvFD7srflh9/vdO3zj+z9IP+5v0jfJH/ujh
+upvCLv5fKBA1R21QJ1SO+qBBaAQahyYjhNhtl2xnjmyKgrfmuOxOl83pMjpdDme2UW9gYh3RYLQb
+dEyczxbbgsgGB5vDahOtNpfVBk4XEURl+QxOl00QLQajxWoDq403GC3yUpkXRK3TZbHaRIMR/5KZ
+0bLFwMyM5i0zpIqoqEmR47qHDOs2+yVb58RjS7qM1PQYEzUpKmqSbnIPzcguS4/ZbJ2PLu06RNN1
+dCTUmhICVw6FyqTo48OX06dVhjc6GvHvYQKEartK43T+9LahAz6iMrzZTUOnmSyBK4bRimjbieEr
+iHVhRsbAhZmZmS/bOtuOzy4bpC4bGcOyMUzsGjK466yTts62gwvK+gd0HRXNXhsndA3oXzbrFKRq
+/SHI0KnKX/tqZz0tskY8NXi+Kybev2Io7RCTrFne8xdrfPWoB5eEBtDbMZ12+ele6hSj+B+sqhsr
+7hfuk+9Tm1AaKkQI5E4h9w8LuxqsKHbwvr3aN+x0OeOMBiOz7hInCiKe37VZfrdu+c264hbdppWX
+5zfrWvslDoZpMB0HSr/QZXS59NPD8AIUQCE8R1vSl+krtPmtBPsxzB3LtkyNtq2k41cmRhGpWdeu
+M7qx9F2b5ZeXX4XpzFiXnP4XHAjT6Cu0JTwnozlDSyRLrv3oUXtu21TbyhWJKf+lsjEltDiX08XM
+1cTZrP+PZZsalbgS1q20RU+1ZB+T3Mfs/27Zqtqk2FautKW2lcuojHGNypYl34VnXURR8vIofMUL
+4FMai6cwNqUwNt/SyGRM63Z3aWDqPVomv7zbDIVyVgJ6uDH5/RpaYqUtBfnXj8d3353s/V+9Panc
+imMLCDZQkKmdBaGzgPPy7hS6a6Uidpky63L/oFfaZh4dYXmutOEy5f3QVxTpbu9lyiOk2e+FOFJO
+nTA/vPXsuKxFfsVrbPwVgT4uCDBoWxGp816svD74ueFhe0v6HxnNLlaWCd7/9Kv5s0ixxKUUEFrE
+3e9JiXu2ebA4dYqm5fzmWxBCCONr8rh+GRlRilfmBrKwzJHgcmrY1WsmKddzGjUv6pXDeSaMs5rJ
+XFgG6rF02jMHszK6gn7OVnp48YD1iXCePp0i9Nz+TbdouuuJ7fxl+vD+V+lMWjc0ROhy6HUIhNWf
+lalJQHAROUt19L6vw7jyTb8MGOAHhcCP9O5puDL+ikKTKQ6sDjUwEb1Rz4mCht2NloXmCRq1xeUw
+xzOhVjSowWAnT9Pp9IcxsPyZQ1npZfTW3C3QmdFEXVCWKvR87OvyKOj75HaYtf9VWAwwLETscugN
++iud9K8uas6fUQTfwSNfh5HyDb/2H+hHX6LukQijp+syuXz+I5SFiuSzW+VEWu/Si0azzeyw27yn
+z1YmYpS1wGQdHDksCjyT/6tZl1DbrGRMYsJDC8evuvIxzIPXX8drJzz0UEJiYsJDK/v1mG6d1qPf
+yocS6O/W6T36HZ9fcbxfD65nRMKC556dPTz4Ah4Or78x9ELQiNnPPrcgISLcsuBZlf/8vuWiWN53
+vr/q2QWWQUL3PtRdVQVcn+6MblrHp/EfogBkbqzbCh79UrYx8DgYYCbbrfj7J7/66snHb96Uzu+D
+sh9uQfm+xUdATW8fobdBfZS33XjyiStXnnjyhvu7R7//ATrSIzR+29xQemc2Uy+dDX4auf2ervuc
+TxOeV/K1+Hi89eq5MjVmHycQhvp8sWsfPfjDLbp/3+Ij9EcIkTM/usGbLdE++v0P9Ah0hE+2zQ0F
+1Wymxjqb/q6ReRntrPtc2MW/jPxQjFxepc6tWoFliYwGI5hcJo2oYWTgf3myrN37y7e0Gvp8eWMS
+jKErlsP3q+iWSWTDDXriyhVoe8P945NQCB9DDz/VKOi/kupWwXT65JoXv0YIIYByhPjL8h4RgcMz
+/kWD2aO+KzIb96xySdADVfsenPXUBsAnTgOe8PnGjW4t1sJTPz311Cx8EjjqPrn3QXK2JhFmb2R4
+zyDEj+WvyHtss8OuiBY0Tnt2qF4nZ+CtVY0ZJ48du+nw4YmbDv+wfOOKrRPv27KJW7HxyJGNdM4E
+Sk/3/WIWXvh92wf3VT1AS+V6uoOQkM1fQf4oq5Emus1gVKamHLl9NMyBk9lR71xJb2ZuoDQm8tak
+Ll1yXV3yCzM7bpowbnPHjCL6GX1761bIhriNEAdZmzfTd+hnsLyLK69z5zzXS6GZHa9s3nylY5YG
+ltMOG/H7m9hqlX02SWkbmUwBOIQEG38FFSBkUQyUO5gA3yHa2N7exkovWyzz/NYo3csnmokM9XY9
+ub7x4MFdD2yA1fDIptWrMYbRnUc9Ams2PvLIiE5jPW8BNjyw6+nTp09vXGZ4YMPl7iuXb968fGV3
+vHF0Fx1+fcWyLVuWrZBcus5jN0AfJa4P9/6GWcZlGxFCGG4hxBfL6/tohMAUZ2RaKYLZpFHmB6Yc
+xwZKtlyEX2AzpA+Pq23mpFX4AZXKLakegdL5bdWqnZau/JV3Ow2iv/XXSPtV81Uq/rUFNGF+RNmT
+BWqEEKABCAlv8x+iBIRMTBWdzS9mec8QpzUYna5QWcojmhVWQBrSk17fNy+++6IBT6yBaQtUPKda
+CFPX7hywqEf8/L30Ou5N+LPxlZULH1942l2blZiYRfjTCx9fWDkv/hV3rbQXISzr+03nryAVSvf0
+oTinmgnWirBdH4+9N70VbXVlyMNmR3YMGA1k/dq33wbrO2+tX9Cx55DHTz64Z4HemJ6yhl59/116
+ZV1SZph+3lMPnDzVtkcn0v8d+qEMvIEvbHHkwHh6brw2JCZp/XsX6FWwXXhvfXJMsHY85I4/cKRF
+IaPrDELCWP5DpJXr3GpzcHFMWqExGPUJcbzDWQxOe6ZRwxbdOHRvFfTDd6DXwd27D9J9koruqtq7
+adr0b6ZN/4ZzG3LW9e27MSOs9roxY1O/fusc+sCgIE4MCpLXNV+hEqE1PxGpUDSyMm5sUNJPBZvD
+wNmzbZ5tm5opA7MuzyAgEIx6a4I53ucCRxHwLZ5fuHDQwIfoQHqOrtsswfhm81UL3lq7/u3R7X6T
+nhr40EPPLnoIoiEPxm+W6Ib8h7yRv+ITC59/6KHnaSBOTJnd3RAxfs/ad96hH47q1BEfW/j8woXP
+L8QzcVLKg931EeN3r3vnnXVrRnXqhDDEICQE8VeQFnXyng+lgzle1qSSD3m0HkNFSG8wsrMfmVRU
+P0QbPRoxis59vU5VtiPHitd1yA6OnmxLMHfICo6ZaDNnfnqk+kP6CbeozfDmFROHrYbYJxdWbEgP
+t/jF2afl5hbszzBE2qe7XB3bqzrB8ujJNrO5Q7acMqFjFr79+PlrB09T/yOzSe+298+a+Br866VF
+u7bOK3ikaGilplerqWmGDjOduQUFU9L1SUERJ5QxfgJdJTwvjxNtEIJsg17eTUfLOv0GjRpkdUFB
+dDhdNk2OQ20LBlFj1qjZCVUmOxzTmDU5NrvBWASgSWAqOOwt3lt8f+KrHYq6ZS5oVTCcLoA1e9Pb
+73n6hdl+6fkPFW+MNQMkPaNdv2nljATjcfwK4LC0KC5mFX/l1cr739nz0etz7v+a/kBH0xcGvpj0
++AhxWlSvq6RXqAbCAa6ecw8hW6D52OuX3dO45s+/0OXggGfda6R3a6Dbi8veVnTD0DhhHp+HCJsv
+tSa9CUwOU/0VEJF5rDYrZ3CK4QXjU/wHNcnMjxAMbQlw8hSQVrBQehpCNT2rfqnqodYC2QSrNo1e
+cBIIlU4uGL1pU5Cmx6wHqqoemNVDE4QQoNZ1BcLX/BWUL6/cjXaH3SjaHIqS3V/Xm72h0rU8GtWn
+D/fGG1yfN1qlqvT3qK3WnpoFCJk4B2bsf5qbMbP9DGL4i9rxVKq0kNG5j9m44K8gHULMuKzoBxnY
+D4qxH6sjDX9TKobC8fRl2hvGAuylL7NHIX6RcgThK+CWiunL46HQEw2FMBboy/jFTVLDmHIFJcn7
+l+xQl9Iz2Lo/GJgk16m1YnM81uv0Yg62ObINWqerCEDR048n5eO6hk94edbmVSu3PpA6d+neLQ+M
+yS7JT9iyfcXCtY8npqclPQC9Bsx/3E98bBN/xb2du/b8qEeeemjHZW4GcDBTTfQ07eWQYCzBbWHI
+J/S78737bXBbHzn7qBZhdBDyhAe5nciGuiKkla8FKRqS2YxKrcgkEYJ8Uid6Tuw4s00jw7FVuudR
+D2n2AWWQB6H11LSgsEnVuc6wiCk9U5JmTxhYPMhe0HNSeFQzV3kvV254mNNFKe+AVtNSg8NyXT17
+3WeMzM3rmZI0RwHt5cqNiGzm6t4z1xUedh+t4x3wKIQktlnZpk2bBPqK/aSjY4fOLdu0Lb00FK5C
+kK20tG3b0lIzPZt90tmpY+dWbdq2vTREXkMxrxk3+SsoCiEQMYhIa3KYMBiR1h4KLkRMoikUbIhw
+wT/G0Zu7wB5Jr0BiFH1rNxjifooF4y76FtMKoleiwb6bfhOLNxN6gL5AYBC4qsEJgwk9Q/dDOZQQ
+uoO+Xk3P08cJNIduigziPELCMPlsnVl/787O2kGe2eJl/VOmPcg07thgKo+lbA5U3CR6Dy3t2UZF
+z9FRv1bTs6E0Gpz2+gNNjXyQmu1FEwykDLDZ3n7b2u7d2huM0GsF9AwztO9avrZLp/Y58RhoMf7V
+fTLZ2nr8fa+OLuuYEBekHj9+zPjx6qBYS8cuo1+9b3wrW5L0QXiaMSwtLCwtzJgWTl4jJCUqqev6
+sq5rEoNVquDENV3L1ndNikohRDpBL0AGnQcVQQbnK+PGnx0bixOKQ0XOWdqrV6mTE0OLE3Ds2LPj
+x73iNATRedyc8JTw8JTwsDSjMY3pz19HSGjPX0FtUWfUDY1Gs9FqtAudludjuRJiQC+IWhLv8yuy
+UVwkWB0Nv4jvL7NsQMkhmmzR2OQAI2mocNYKrOZcnreel553Lq3Bnt3wkjUX2El2o9TySw9gk7ed
+BgVGO6OjnFFc3g855aHRjtgoRzROfy6lJY5xREY7YkhL68n0aGdkjDM6oGd/gJRoR1SMIzpo2A73
+EyThKF1/HF9213X6ShRDdTqzLtSs1yWEin7gXHxa4DVabUKo1qzTJmhFFeyfOpjntRat3qwNTdDp
+QlUi3TI8U6vW6A3x2lCLVqsNDoGk0bsDArRmnT5eqzNrtaEcIbV4UJQ9KsoeVWKgAn5+QFR2VFR2
+VFq8pCM5MZlROREROVHOMPfr+EZGciQDjOyhltpjEp8SZY+MtEcNpFnSBZxBP/1Xh8L3M598Eron
+42DC+Zu1OjOjWWvWgXQnDv+Bib/ZmqDVJljZG20kvgA67wt/Hkv+pIW/vwyvY2n9Vdj9YTLcJPp4
+VkJtglYXH4AxQjz0qKP8B/wHKBqZkA2lyWcHilNTs17UGPWyoyV2gGe2OczMGQ7zbgYuh0lv1Ih6
+k8Pm0LhMGqIC/VMQPLV//6m1FVOZzuBU8KvCG2u/J+27uT/s1o7o2oM0l/9g69adqzdIx9eveuKJ
+VZtw642rd26tqXW5yM0FgwYtkGZMq6ycloNNWLqGEAfHEOJn8FeQC7VEXdFAxr/etays7KIE61nT
+Y97IaDDqBe/6VxREMPmBw+5gHnyYYyOtkzGWzFdGYkK6GPAypYDfiI1TBRQkJAEkpXSOionQGNI6
+Jyd1TtOrI6KiO6UmAyRb8gNUsXEYQ4S7BEIgsLp6RnX1zoK0Tt07pRV/gpfSDtGOfGd0jDMqOgfW
+OfIKHJpga3HbMq6sXYfkYP/QaFtSSofk5A4pSbZorV9wcod2ZVxZ2yJriMZRkO8kHJ0O66Dz7PGz
+Z4+fTQ+ntUvpnJTUOaXESav58HxHVJQj3xEZiTjoihD/EX8FJSMnKkaT2EjoyPEqKiKtvCxxZDpY
+Rdg8GiROl6JjZCTOZkyfRZYeEaNONMcXglMrq3soVca+DUYXu/jhkpegOqMFQ7ZD3sF4wUgMZyh9
+QAU309zOIFjaNzd9u1/qstjOOLOtXjSNdu60DBZSWutVnCC29Y9KumLuFB4d0CyVax8+aoj54egx
+3QLo/EAyzkZDgYsN6hQbquK+VgXoU4KzBo4eFRFJn3NPzM3fAYklwlSyMtQQk172x5mYqAkPxGn5
+yPsiZ9Jvi9PNWTEhdJI5JyTGmIX3DLYWWSJNoeqQIcNSU8+e6RjWtr3/am5/TMxMemuOMweIC2aD
+f0pgl/btk7QmrSaE8VhmXR1fy19GQSgalaF+CCHP4K+whLbJVAB6k4NpcIV6ec/DhB4eVFiwgRdD
+5QtG8sqetQR+IX+EK29Ebt5Il3PEYHVUiDpKrY4OCY5W4361V8sDdq2xJsbok3t0ioyOCDGmdk5K
+7Jym14RHxnTomWSIsVrX7g7sz5W0/rVy3q8tm3PkxUnDc3OHTxrldEKwOjIkJFIdEqVWR9WcBoC8
+/B1g6awPibZ26Fa+MTnYXxtt694pOblTd1u0zi84eWN5tw7WaLWuM/1oR37ewjmTZy1YMGvyHNk+
++93z7Gg0Cy39/2i2ZVXsMvngYIpQdsa+PqlM/4M5GZ6Upr5Nvw7VFLZodX9JUWFSNP4RRycVFja/
+v1WLwtBQ+s3/bNZ2Hzt37m0x0DSrZYsHW5qMCQRjkmA0tXywRctZpkAREZ85vSMq+y/O5LzHNfR/
+Or9KSfiylPTfmgHf6NyZftClC+Lq5yeCRGRBSYp3TfbR/u0sxX0gVeLX3bfcfzY3cQsHDnRnTJzI
+m/9kWkK4fg7KQcxF5L87+4AJrL6TD67+hxMMGNj0Eg4WeX7B+N+bRuh9sM4B4cosIp91Q0eE+M88
+5WiHujTMFzLpdp3ZO2fk2Ji2hGCON7vq5w3v1GKU1VOyHTkuc7xI2AUYp8MWL28AMDFYYvJ7hMRz
+GIfJM8J6aDHdv+8ojmSOmxLZG2cOI2m60blVnErfOkUYbJka5d9WLILzOXC/0CmN+9JoC88zpMd0
+7ROUEQi0Whn8IyerxD1rRpdFlX/xfGTEhNH+flERZkuLyAfq1DFZ8ZklNCksOsQRj0v60J8ljnRT
+ZL3eMT0dIf7fG6uh2T8Zg//NwVWmqSVC/Nf8RVnCb9CG1hsDspn1aqPZ4dTYzPW2gVAxkE0Dr3wn
+2HY6SrYMSi6/3INYM3pUnO7odxqa9SH8xT30Fr2Z16Mscc9gw7VA+sUGYSNEk94PXWiXfvwNmL+L
+yPKKkwiEqfwFFKZohGgN+mxXqF02RKQWkWhWllNErzOCHRRNEFlz26wcoAs4+cNpUULQpG8vPGC9
+RgsLBGnHzNe6fvEufERw0ejn2uTTOz39hbYjnjswzsJfoN+VhZWC4/3OZUGUfiVdbYG/O3zsvdlp
+dJX6oxvSs/j04xut1ZOiaHNrROcLnx0qVXza90aIf4+/iFQoCCE/0CORnXr4cWQDLpA+jw2hxlKc
+TwkGDHQgf1GKEKVm8QG4smcQ3iCNkobjbZgdIqAbCAlt+IvIpei+GHUhYJfPopQVTTxh9aw325wa
+hxl7DwxZvCy/VhTON34wIojMLCDtu2u/H2y8kNjmpxGk27tCDzL81xdGhv/Wwu7XszPpHl1wuQ1/
+8fOc3Ok7CqJeb1Hw1uhAAUL96L4JglAJnQj99nyr/G/KA6NIm9b3dQ0+P1qLEIEWCPib/CUUjwoQ
+c2JvAIUD2CLMywJm5afV4WJM4BJA0BuMsta8PVt5yo2Eid1qixfJxrlQ0fNofKbhkaHJ5Zd7Juf2
+GGPY0TWcvA3xg2bTA1391xmS1PcPPi7Glo/SLmnu/8aXK4uDQgQ1f+lf1LlsEbnQWf1hEP16q7AR
+zCOmH2hRHETOXYRR7V6jE+c/dNrh92Hpuj2JMbEH34HY2knGvt1kWfcxhIT7+YvI6Klnu8dnBus8
+HobCiW9MS8bE3H/8wxfmxr0r/SBIb+99rTt/8dsBWTvHjf+svF3N59JD+NBbH74/O431jQYeQH5g
+0IMVclxkA/zeWnolUHp7In9RmtojSIpU4WvBSxEiclu35T9G2WgwGqXQwbTq/lFzy+ekTqKLBT1T
+ewoB+ShY63TZDYoophByMthyRtGmlzsC6ER20YhsfWN0+BRDbGHSnf7q9+Lb/TiSdLvAuOO3M6PC
+f29ptxbGDou60ub2vsBxM/x+LhQXF3Z+b4raIFrK+27f2yNQcgozxs2LizjtTDpRDmJUQRT/8dul
+eQt3G4XLufnvDw8UQedH98hc1IWjX7/ROv/bboHCpEPWN8Zo6TNb3wwkfhdbrhtgBVtk85mLHno+
+PUX4JJP4kSX3dUo91kt9rvbtqMFkmEHRbbpSR4Xu/EWkRiaUjToj1OiWdqYjx2lRXmjrr23zTSG0
+8mqLi0uQx067IAo6AxeXwPRrbHhG0CP4TTEo+onMkF3QPGgNfksIit6ZFbIb+ig/op7ICtmduvf5
+k/QQXUcPnXxuL1nQoeceSIf2kLG7Z8cFfF7x1mHzZ53fPc3eN3jK4u8Gf7fx7Gn6du3hzstUfocu
+9OvyypzHt0jvPtJiSvAj+2lM1qEhgw8OHXpw8JBDWUmtO+3d1KPHpr2dWid57pLW/YaPe+xf2Y3s
+Rj8bTGWrw3YNHt1WfCXTfDGKe980rC/HX1LVSE+kcQ/Fzg/jS7iLnvTcRP6SfGrBzADIjGSW5z+r
+TcP2SOwuTbZRg3v3UkXuv/0AF1N4o3/4Z3k/XTQO7sNfEm3O4tKlawbHZXUvbZMcUfvB5rS9C4wl
+3AcIYGZdBv8Mf0k+7eSNGmXDJZOmsSiUen9iCT9DzjgvHbL17Cp9vKFlxJWqlD5l/KXXuZrvtiY/
+yc01kHwuYF/tR0tyVVvFB+K5ltz7irz92brfBEZ/mCydM2p0fLwth9mYYG5YRXOcg/3QGPUmhzCO
+o7XfhH4zoQtx71L9xFF6a3jv3qQff4mrDb2kf3JpcDO8Xur7TsKhOeoS7l/vbNvG8B+tqyHx/FWP
+bw27UWN32g1azNs0Wk2ChlVT/Ok0nlQ98rkuWnqG5MJOWH8/f1VwF39HaB3t1ZvwH9RepI8Og6yg
+wHUqz912hDP5q7JteRmnxaPiYdZoNXaLxnwoSYDOmEvk6dM4B0M05q/yNONxXDuM5+GLHdxSaTOd
+sxoqEEb7ESI5/FWUqOgAGzUutg7TuNiXjFP+Fh05Lo1ZdLiYJrLGogGNRYMfTufvJ+T+B2AWn87T
+z/hkft48XDGPT+Yhjn4GcSzTdwvwCrGALnM9zLWklzkONMvpbo6D5gUrhQJ4MHdZc4jlOFqzCDpJ
+G+jDy2CacleLL+Ovyra/Zc12ptHrualjNzQDbyibDT56IRrsnhBT7WX3Cz2a8Xp2vSRe1nhxOvCx
+vdwzZblhAcOG4bi88qN7FpDo/LTlafnRTEu+PNeEhw0LCHeVPcM96RNDNH3NzbJcpfKPpz1g2JRb
+fvRpGarUldXM3Jd2O1qeF4c9mOU7XwsR4rfxV5GAgpAORaJm8nkOWzDJdxzZgkrW7DfaiZmp97uI
+nd1cEZnPTXYvhVU3O5ZkrjGZ1n8IsIUw8ynvMDugmSEzwzgIfzfQmJFhpItSM1LTWqVBWsu01PQ0
+8ca7/g884P/ujbljx4wePffGuwEPPBDw7o25o8eMHjv3vmnTnNOmkSNp6alpLdMhvWV6anoa3MfQ
+DHJ3G8Se+Pe5Y8aOHj2Xdn43YNasgHfh8NzRY8aMmfvBBYbowtTH0mklVKY/xvpPoOdeXTXSITNK
+R07ZI9lodD9ajjai3ehphMCUHQsue7aemFirOTBbNYjy1IdFc4LNEepy8nqtqLe7RGsGgFErq9WC
+QWtU7rVZicOoZfYtczJAsMgJRbMNhwDTZSJWeQhgyvhGvZGpo+gNRq3d6LCx0cjELKvoBSKkgF40
+40KwMesuymKRF412R45stYSZ2OAFUa+LBZOGJMKy/OhOQ1X8fvokH9rttDp9snGSK+agmtD3ue2a
+VAh93fD6oKjJap0Uc3tHUaeo7qlka+h6vsyYsCbwiHPbQ84EnDnohqTV+U238/zwIyGEF4lhv66H
+Dg/nK0PJNNh2DkcNwnN4HqYmJ2BeeCU8/mVeVd1NxdMPpmUKvXHvQYN41VeGjDF0nEo6USj0jYFg
+vrrNolyRXuR5TTIPpToDxwdVZvnTVzXVKgwRqnQjpo+GkP1BZ4N4emEpGFePpL9q6A9Juk4tQwOg
+kA+hN0dF5LXeOW/c5S5BfB59w6RP5CMhLSJWjFqQ1IznrXwQVr3qH0FhgIpU04v8NBOP9fRQ9Cwe
+4oPIMyLdxS+yBmXPDqyuUkUNfQZ0qughCf49UgTQs7lzG4oTVvFXkT8yo1z5fNauEdnu0q43sQ1F
+MdhcWpvoVSMz6U3xNqtFEM16m0tvV9YNxKU16HVgiAUXsbmU9bRIbEwLEl966tTrFU/RSOnnVGv3
+om/DCoeJRvOAEDVsoFEd8tL5ZT2e3vPee6/mh4tZry/+2eL+ct/g7sXhNwuGxeeIYrHjzcU/2nIz
+4CZsiRgSQ6H/iU5d2nZYkzCO1iVU67TmuFR4zBQ5ZK1/u43rSwNcGm1OWxjchQ5o0dzJbYc1jyTQ
+unHm6kTwG0cf7wxPNjdF+pY5CjlQezRIlgKbHEUgy3fk64oO5UhRJ7Dj9P+8FsgA6C79WPJWeFZC
++4KMUX06Tsqs2GLNDF4m+f8ntfLegB5Z2/VJuRGJ9jDrfeG6YV2trdKCJtBT/3ElNdRRImqOeqJx
+CIFiiiLeanMoiuM51hRw1BvRa6iy/0J9cX0DBu1/1D6+tO0Ix8D+PbtMnNq2Tft2SYN7dpiQNW9L
+uXvsf1Br+HlcXtB/RHDo+JjQbHtadj+b1ZYUrBsaEtSnQ057GvYf1x1fX3cYBSEraibLsIidsD5l
+1psdJsd/g6WOffml7tCsWY/S+XT1f1AdpButg6V0xqCVKwfThf957/LtX9HIiTqgwUr/0gv1J9k+
+LMQ4579QG/g0vEzP4qywZTv3bMgeXdpxgKPbgAHdbGWlNOg/qZx3B00Ccdqw4VOCQ6bEhpbk5pUE
+hdPy/7yWGnjED8XLHDLCY4NT1lfW6j1S9IYqU9R7/huMs8jVqpUrv0WLzYWl8b36E3FA7vSHV0x/
+aPm+NdZO7l3/Sc96tvmMkpIZzSF4pCF4sENMnDpk0LRdG1YdCHRL/wXGauArAcWiHHmladKDWWv6
+bzDQTozp7bWUPkGt/wnDnMqWTuII6cH/QnlJfXnDUBqTo7j+82Ke+w/K9sF/oUyCvPas4B9HahSB
+bCgPtUXd6tebeiyaHE4XsZrF7FggCbZQlxNC9UbPktLp4kON8iLSTGRBrc1hB7u8YNTJy0Uz8a4V
+c1wkGRblm9oPEx5V031D+FfNQSPHJGe9SH+NXG/IIn134yEhiXOCnw477571FJ50hiM8f17FlUUM
+v4GvYFhqiiHnw2Lf5PcO5Hn6ybQMvjv/ePc5Lky/0/MOPxgawffvSY9t0QIXlUF70ptG28h8VQjk
+GydF0s+CiukxdVI09I+LrUwKyCN019QIP/qkcSpYDgp0H1+ZmTkfIbH+7qQWWVA2KkDtUX95HGCq
+lNGg2Jlgi2W1xWnUZ8eyQw29yelimwyrmZln0VrNSuvyeq0925WTAfG8URB1sWDPKQa70QbsBpDD
+xVbiDrNgNtkcvBANLF5DlkTCsp+H0IlPHCe07OtyKHh+idU+KzIfwvwXkvIuofoIafFPftz6Fsbo
+7EGOzkF43uYZQyNGJAXXvlXWIWBZNIlc6ddxGfzxsDCPCNUJhWOJIZ6+Ikg3m4+NcPNX6ZoD7wi0
+y7cdYdHeJxww6McFa8m4t4khlqhupy4xBNJPl4UAzA2dNSf2E0J/WZrxaKeCnAezDF83axl+J/VH
++kUtudVPqDxE1MKgEWNsgf2SOEhQfCp7642d2WWgfNQCdUOD0ViEIE4r2+cwe+/AqnlDM4jTOh3M
+c1ocERRG0wuiyeGyZoCZsZlsysrJRJRCChCLid0jNGNRbyW2HJdNYTA9Ec2i3myzMFOTTNjiJJtg
+43ej6MInL9CBXw2CxVXSHzD00apMGP1Ff7phxwn64Kf9cT/Id4Tld50QSW93WhMR2alfTNoW8nno
+/VGp+Ikl+vHDt7mvgO4RQjqT3ULgNtyzM5YEaKML22/cJwgP06WQIgi/drUJwF+lGw9fpL2/6QPT
+dn4E+77tLoXSSVd6Q59dZ2H5Z+V092MwofXQVPKmmeRQW2BpT0or1Jci4uie6ybjfPDr8wvJojf1
+EQEQqolakUVvDdIJ9GM/ocf3y3n6LT8qNQvx9fUqIiOKRS1Rd/lmAdMrE+6qWWYK1umygt7MjlDk
+w3YmV3NlG53MlqFOL6+e2VVUs+WuVngJH189pNfOp+Fik0qEf+3sNWT1cYxpCplxOHj5A7D14Ucr
+Krc/vBUeWB58+H4sFdxdy7+8hd94iSPYelcdXeNeegO/9QL9Oeq5kJNloorjp22GqirYPI3nVGLZ
+yZDnhjSpRVlOn4UwXyOPu+kIQbZeEJmJLdnCltbF/AYRUzzJYeZL0hUnCqzPxUA2C+Atm6EyDLSB
+ao5+FwjPBa5YlMnT79XrqTmwB9yZ/ODs63NKTBmJ4bh80+6Nya3T+asrn8BfruFGCHRK4gpVnzDY
+TxIeqhldPOdfc2ZPKUi/WtCyfFN5ecd92fKcsAEhYTD/lnyvNMZzStfIkbNsfxA8ThPxD1pdbGRK
+clSMXqvVxUZ1ZwFpD/96zUdcZW0l/sYb0wATKbXg36qx7+IWyHNuQ35pKAs5vDne5Tn6bwiBk/XZ
+N+SGx9+DOvdkL3Vw4B7U4W33eOkObCAZ8Y1obiWfIv+7VP8/FAac9ygMOP/9EsLIe70c8pfFFpqU
+uR8ahIb9u6W2/I9bVlr37/MAF3svDj11j5cp/y/MIt5Vb1PQDPTA/7jm/r+vUPr6X44D/4Napr/+
+zdCCVPV1b0QZqBQNQNPQMrSKWXOEP3FPb/mT95r/D/icS7hX+aX1f1Ir/7Mecc8x+14hWHuvFuj5
+D1MTdI+XtR/6tB+zWQHCfPmcJFLWVsn2uIvH6tAE0IVycQmhjhycYI7nMOdVRGDeDaxkw7Mw4o2z
+dCudTbeePQ8jaOIBKIDeUHDgGfrKnr305UNkbXyBKa4g3lwQa2rGX32RbqIP0EdffRWGwSIY/aKk
+20/PHjgAzfZXQ/FTT9EX32pRYDIVtCiMi/PaO/ClzfnvUJfgDaVDjvPfJVUqKikwe37/W2R/HFfo
+CXvum3rpj2hKe7avtvFd9OH8WEZAnKkg3nyPinNvqM8nrnEbsv3YX+WU0KAsGC/8dbbuXZ5QQcnf
+kPBYQ7Mpfnjmo3OIIMR2tlX8lZrEc+fq7St54kQl1sIg8GBpB5nJvs+dO3eu3mYE6eSx6SAqNhnu
+8X5Yo/edPTYYFPhF93jP4BfJOhwC/x5/FWlQMkJMt49jdp28dxkywGxjelpOz50HFpHAzDyZsZhT
+UAmdT5e2zXHsObTshWynblgHgOCODvtLT+17vHOHsSOfoi9XlpUOxo9pngjEQ/0HzfXDAxyOCNXM
+/nopKGpLjPQqgvr8wxD607zgyXtj5p9sgg4BGotAeJH/EMUi5AeizWVzGV1G0Q+Up0HrB0YQwSaC
+DYiTPnPy1VPpS1NOnj0BnaDTiVdPpC5ObW2m79NnDkPxSfrTM4fpTyegEM+FrqdfO526NPXUuVP0
+IHQ5fe502tLUyXQaOOjTz9DbJ6HkyBF65iSoWdu+WndLGMF/hEJkWUATFUmtXjZzw5TK4hOYuUDC
+i5my7plsvIrpTnp7r3zdkl3eVTTWbBbCrvWLAlHb2loT2yQmtbFZ2iZ+rrLYCBHFffSNnuqgXtFg
+7yW0WzhhOl0C90eEF7VqPad5i9ltWhdGRsAEunb4wIXtMekFOTG9AjU9t07lPrC1tdna2hLZd81P
+QADjwb016ubQYXaJ0DsrUcQX59lVwfGzF89p1WrO4tnxwX7Z86QM//is3oSUzKZHm6s1vbPMCAEE
+ISTE8h+gDFb3stKB0WAMBnakxmzjuQJANiHBdDysNiczUmc3GP3A5gciuQ6GDpH9wgdsK8JCQUob
+7tFR/WwjW/zyU4sR1v6jt0FxYgcOircNiOgf2QEM0udxeDFeasLP0XebFUxvd4yUJMcD12VvrqqF
+Jgvi6SdZmhaq3L1tA6ITOpJj7aYXNINMvHC9lI9fXa+sqefU3SKX+I+QiCJQuuzzTrb01MgGYI7L
+awKQJDhyLOxslzMKvKxYoLFpNTYNs/WXfZdJP57ZBXTkWGzEqwerqL5C96igAD7hj8BIMzn6JDSH
+ljgk6iy9DsFP4VTC0e/g2NlOnTrRGVzQJY40d0waFBaR10olpuEfBJFugFAM9PNOvD4sLNlgTA4z
+6rknA/0E/4DadS2iSzLjS7pFZISFZRTd3xyOWycOy2hltaf3GdI1wxGfZYunR7QE6Dw1TNcuXc23
+jgkXIrEzO2P6UnVhXCFg0wQR0gLSw8LTw8LSw8PSA9hYOqvulnCY/whxyA/lIWTxA0Oc/AX27GjQ
+pQAIojKvODIdYIJMvS4Y2JVGG5jZmWgzcBntkWB3CYPoLXprGegkC/2O3loKOuL3QW7SS6DDmRn7
+Bw18aiCopU4QOrD62f1ZGQDaN+PyLjvs7nnz3DnfkQL3K1ar/E0uJIcnul9rVVTcps0DLVTE6X5d
+aPlgmzbFRa1IniYqJGPrVmhJn9vKfE4hJBzgP0IRsrXVJmssRatbFIgtFLRmYvr/Ufcf8FEVXeMA
+POe2Ldnd7N2aTdnsZltI3exms2mEACH0EFoIIRQhQOgdgiAgvUhRAQEBAQsQEBF5EbGgPBZUVBRQ
+EfCxF+RRbI8K2cn3m3t3k00IEHjf7/f/PsLuzr13zplTZuaeaefwNtpK27TUR0e3Z3ne7m7jk+d1
+L3Zv62PNcaWXPP5WzbfMf4bO74e/Wgn467L5Q9ll/WSup0p65+StzrVlP9elR0JH/O8i2ByYG3gK
+PsFtyIeqEPfItUGIA/YCUiL/DfvT9V43cRKqY4h3dKfP7XPaabOwkSDUDQR7Aeo7zyC3Z5AnsyIj
+fdCLHL3xy6dxG5ePSkpmcWT5Wz/dr1Rx7fNLBpRve2p7RXlJDvN61UCPZ2DVILcbp2497vx+yyPL
+/9yzRbd4LWRQP8KjElvy1v3b+/ffvn9rskW0fU7UX+LGsqdRO9SZ7DBFJOZ00OWO8K0LutwRviOB
+S0iHkN8d4Tsz6KxH+G4HyIJIDDg1o+MSnJlZHnpseXJ0x8J5PQr7pVufdKjXM8x6teNJa3q/wh7z
+CjtGJ9/6MSjxeoyBgvFA4a8HLRg0aAF7mn1RaV6cWrjVkx/fr7KTrchWPKhffL730cK0xXGqYxx3
+TBW3OK3wUU/b+H6Dim1Ftk6V4uPURXHKF/HrOIA3CDjHzSD4yPEBFAlc/X84B3sJyZAKaVGUcEKZ
+nKzxo3zUHnVDvdFgtDOoSeKvk7srd53OoLNOf6OzTjbMd4TrhtcAcdRJ3hGCs06yoUSsy7Re2HdK
+i8eIyRs7QfD1SdZFyvh4jUrFW+D3iUnRgx6c6HBV1PRQu/PneCDPOVyfl5/uyU2smxadNHFAG5d9
+0jCdW92jNDIPPMX5eQZwjsj1pAPE+nv08Pt69qigKa1e79LrnAa9lqIBMtvQdKkBSkr748SiWCYz
+MRMijCYpUN7ETDq+TUIi7l8KJfo+ElkiuyMhXmPpkMDPLHA7s3X3FFj7J83rpJ3Q48Hu0vuG8er7
+28kVc+5Z7o8eMUjZy+kuUI+1FtzTZqm207yOy6XdHxywSt52jk5fNYeO9i9XKQcl9/SJRKVWEBeW
+SS693pUkOLAcKEukmQU+btDjCoVO382XKKv7uNIQW0XHyhJ93TQ8r3h8EJf5gFzmEnXNCv4QzqBI
+FI/SkR/1QkPRY2gfeg69iN5FZ9AF9DX6hdiQpPsOdnSZBSAhe2aCKxZkztBBdtQE47a7nC6O+BWX
+iHvNneILW4wuaAwGXdEG08EdihzrF5aajXohnGqwl2oIzkFmHENjurAD46Ffj9fDOkU8ZAuPUdzI
+TmgK7pgWnwkUZPmzhFxOX6Zw5XQ5hV+BRklwD6XfSW/U2nU6u1ZHvr/s1Xm+1yE1lkllIzap4CtO
+tXmETFpmlDoy53fuQVE5mZkT0jM8ngy3J52i0rxub0aGJ402RXK9iu/LckqNZbKIkYHDkDCrXcHM
+hCcm08x0i8KWvRxW+q2K+JncxCcgITevsH1+bsKuiVxNfIQ1a+XyLFuEZRpDT6YcMWnpGd70tJj+
+Zf0q8wsS7FYrizfIWGuCLaFg3IYREbIyg8yZNbe4FyfL8XpzIIeV5RfYEmwfF+TLWEuCvaJf/+i0
+9ExvWlp0Ge3T2vXiYTy9XXvelzO6X55To3IxORVDhlTkMC6VxpXbb3TObleiy+VMbNPVHBsbHR0b
+a87Ozck2x8ZFR8fFmrNzckFRMdSXM7J/nkurSgRWRj336qvPUblUti4mrWDkDJg5oiA1Ruencw8O
+HzFi+ME8xq+LTm03YtaMqnbp0bpsqvuuARUVA3ZZkpLUpjiNTq8rKiws0ul1mrjkbBYSI7Wu3H4j
+c3xDtjnbJDpdrsSPi9qTfLrcOFP7Ir1Ol5Zk3T5w0KCB261IItRhJ/sxikA6wf9VDVqK1hOrU9Av
++XBsplNPdiNnic4R/WlA+htHpuDTlXyyQKw/om/XLHFdjkx4S8xgzPKLrpJJFuK7RKgpnE2s2Tah
+qyLRVcTd+f4srskrzhE29CwA+n2XP8Uro72OdDe+2CZF6tfDv4FvS9NJYMOfuJOSvbQqzeNx4b+y
+LSqZZeAoinp+b7+UDNeT8ISrTRrbf89RoEYNtHCquIJoXU52lCXBA8oOnrzioSaLpbxDO09HJXgT
+LMbsHF3mmJeqR780ZtwrI0a+DAl9HxtU/ljZgMfKKx6jFj++O9I2cnXt2NjIye/1HAEl/WZ26z41
+xlSRUTa+xwCqZ1cZv3ey0jy2dsk4R+RumNheWUZRc2ZR3CwTPyhj2R8AfyzLGKSMpmdx1Kw5FFWm
+LDTbRl2sSrV166nwd0jXJ6xYkQA6d0e/oqR7QkrVxVG25e+/XF398vvHR4/u3X/HgW3l5dsO7Ogv
+vHf/g/qyP7DbhXFghDBmAdqqt2rBCDCQWZtyfUoh8/z1roVCkt2OTz4BftyvFvcH/xP4JGoJBxjB
+RQ5igQT87NyU61NSmOvXuzKzSIrd/iR++wn8fW1tYN+TkP2EMLcg+j0/iyRIifTIgRB4QW912ZCR
+oEESj56yJdBZ2mb9IP2YhE7452mpjI4xBqrZnMBcXc9MkzHL5NU69DqnVmfX6+3s2Ws+mYytffR6
+L17GbDtRFHiUmpgXUOrseqF/0entumAMXOYPwaeGsIcXdF5GbwSXD/kybfZ0YD5U6QNrBsEiSV1v
+FbVsFn5YLWE/1UsCX3Xuq+oc6K+XUAOUnbvxnYlt/wyul1SwnyEzSkIZqC2J3AFOIcRoVjOHTCGn
+TEFHRn6n2u/Ukse80y86NiK1Xs1mqV2cGsqJw6bN99336BXosyfktGmp6LcJIomvo3Y5K1flfFI+
+Eu4d1SWn3Y7Ll3e0z30AfzJjxnRKtw0uQY/i4qb+nEI+negHv8EvfMpvgAPbNHXrU+X4b5DJn1R/
+Cp2/0WzDpdnjofPUqfgFceyyCw+Sutl/oQhkRykoW/CY5bRwatKZI78TdMgivHucasSI/phEj0w0
+CKexrTSHSFO2EF9Z4MnyJXD0XzPwi99PT/OrwX8qllqC/wdba2fYl46vhYHw7qSg0yZYRdw2bcBb
+CmOmFMEY/J+/VPj053GZ06HT5RlpgdU7Lz/xNW2f+g0+Oj4l+n3wSrIC637ALz82/iHHyO3EvRMv
+eHeqCfl3omfiJ/qOiGkHldh/Cn8S0TZj/KfQZWoqfvvTJ75ADHoGL5L+mzuPWJSKclAnYk8bs9Qu
+ECK2NvWq5QrqN9wBlSNMqRCmTfohmDZjBj0AP/f7lnkDqhp9by0j+n1k7oIt306CsfiBB+DnNXjL
+5OsPi5pdsSoXZma323X58q72uavpk/joeKIV/NP3+KVOKfIwB13zBFUXdeOaeLN6M6jh/Y9pAko1
+cXul2YZ7IdGHGHdI0h9JUCJCiGjSYMliRY7C3Gk1cyTGzL6MX/z+e+h0ue7fhPBXN0B1g2+tJj7F
+mI7fkLI//UGgayIMaqDpxC83uBZDFCjrv2ZPc38gD+qOELjFo3HEFglNLZOevgCEyWW33+3yG91a
+t8Tl0EpcbtptlIDBIzzOFI7PJgiAggXEJBZ7E4oSnA7bfcPLhlVUDMkrSnA67YsGlQ/O9XbGOXjp
+l7nw/qurBi/FuYHitvPa5ldEm0zG9KR597bLHxhjiopKS2RQrdXepW/l4UFli5IcDldBl76DnxtS
+vtDpUOwbs3IMFA1ZdRx7cr7EL49ZVVcXbTJVts1fkJ/jSc0wmcrb5S7Oz8lIQhT6AD/O/izRoC7C
+ulKWx+jWihzQwSBVgk3k1ruteqtbb/O5rT6rW3CXRTo/Ylu5XW6Jy+92SVxaVuIyuv1GiZuEGJCE
+jtgSNyLiFkwiMernzMlZz32VA++/+sDg59ISO3Xu3KmwIjUftPg/G/F/QJudUVbUqXNxsSsjHufg
+foMfeBXez/mqH87FKzv7CpLTMjLS0nNzVjYmmWUKBWyvXHkce3K/wvfEJXbaN3zovk6FiTr50Y1X
+r248KtM7OnbaN3T43s6J5i5jVo5996tceP/4ysp3x6wceyYqNjltVof2s9LSo6KEdPsOQhpRsKD+
+azaT+wO5Bf3zoggMxjDB2BLCBJHld4vi4SXCVE4TtpsIhH6ScJufWlFIuC8q6zN8VEkonZFNhLB/
+HAyH4WP/7piTm07YTC7wrWxMsufw9xsflOsSCwlb+zp1dNhsiYUi2x0detmDG8H09Di4Bz829npp
+VFS6yGBybJSQJgwmx0aJ868yUHM7mdeDPNrcvI13EztbsLidDd4GRcuc/Lm9bt7Lu70uvwxcDp0g
+DSHER6iei8fEOXbhxY39b05i/40XQxS+fTMKqceIKG4mJiLC/ePwdrz9ZmIibXg+qFkf8waKIHsf
+CiP1GjpSI4syaTScQoOi1BevnL3yegZSn71y9oobGgNhI39YIGmYD8bXXydO6V9/Hf+4+eUlS14m
+H+qn18GAL7/+Br4MhhPk3tKXX0YIwsrUFkpomULJIYX64tlTpJzXm5TSFHMThIiCa7CaOcZ0F2hP
+LozUj6MjxxHax3GKcSLt3Q+q+5W/gLj6V7MHhvPRuL+wCR/XPnjwwQ/IZ9OMvn1nkA915aH33nvo
+wfffXydcz5yJEANVsJotZbojLbKiNsiPRnY/GNGnvDCelij5uCRTmjQmJdKZwDKJdvk4b8q4RPu4
+GN04lC0UTv54jTGHfDJQod6ZYIxn6fhIIx+fNU5Cu43jlHy2+mz+FQ/58Joc8X+Oe6BAtbGBclcD
+9d7gmL7BqbngH8ITYguqPniwT1FRnwc/CP1umtGXY9sVsGzfGTDE4/FUFLRjOcIfS9i1WAjDVith
+uXzK5AEw05Pds0d2do+ecwZMnlJOpIBY1A8quGHMvYhGcSgb9UEThB30NnSzji0dPOEdQ5N+gbkb
+IDpy40Yqro+tTVScOT42zm7rY7PHxZktMXH2Ht1zO6Z5fD5PRmHbhxuTgfg7yc3cW/cFVRGpiY4v
+bpPYLSZWHRmpjjV3TmzTPTpW/WS0Oc0zv2vxfE9GTLSQLu4qpDfeKQCxy+WwmqtluqEc1FeQI3kn
+Bldkm/HucltV0HgZFEUwp9Ftv+lr5eZP6L8ktqyMwrZdmonB5+DwG5yrmVSEXFk2CfWCKEBzXIMs
+SfL6Xy2KmPbFD/KFGG8UQuawqLjBzQUSbU7zDYqfH6mOJXLsbG6UY3E8SbcsX6Gv/gi+41RMH6E/
+QOD2uR1u8mY2usHtcLNu3s2S35q6Uxcv4jUwlX6nLguvoT5ZObLnsZ4wZGENXlyzkPSJz6IylmY3
+oniUR/AE52vcPjd5gdkEjMHgDG7yZ3WLgVPJm97ntrr17qBnADc9bejMPvaS3JO5JfY+M4c+jq88
+MeeefinlVGF5Sr975gB/+N+ZwyvbZOxytG3r2JXRpnJ45r8Pvwi5l4e9UtndVHTtWpGpe+Urwy6H
++Y+VIH1T288iDlRsNxh6cEFwGXu4mVkXHFcE5lBLeQQwENezKexnZLU1ZDGKJrBF4sqyhAZBdNf/
+IRZiYubOH37AL86bsQcuQOnuFc9BpGgRHpKQEcjY6U/hw9iyRTAVid+0IG4t0YdWRvEerz7Bxgv/
+fR4v75dRB6kCqgBbly1fvmxQxfLly5eXCRQeph+j8gJvBH7EfwRtTsUemATd8BGEoAFvPELgN/Je
+3m8kO+1B4gqzeyUu4pUO4uYEnqubg7+BFXge6O8NNFi7gXtBj+fBCuafewNQhbfV3Yu/mYt/mhMI
+HBCLpPoE5oB+LqJRTX0k1491IBlSCjVLbxU83smAxHIBq9Fv1dtkYOP64TO4HW6Hz8Au6AE9ft2P
+ZwHz8cfArCEsrYFuc3F7OD4XH4Fu9IXAU1RFYPq5c8KlaE+vqU9hTrDPIAmKRcguxMl3JlBOwUOv
+l3cYlSBx8AajQc1RzhlPzZjxVMEH1V0G7wdHGd4Mo8vwhYHF1R9cIg9mBs6/D7bRnQ30+/j9p9d/
++NH6A/gjQ6fR+NL7CKAI1zPl7JfI3KjzYIwRP5kY5PRkhMi59FY93UWQ1dZRJdBm8IEuee8On35t
+QP9uE0EtasXbY9OMnZD2Qq5aYsxbsu11/N6u7EiHtn6uMN9QhqTcEGaCECfNi5CWTC5ayOlr0ek4
+sojzjRYxyocZ9Dzp66wW0rPxpGuzWo7te58qeX9fWvbVwPj/tKO3BQ69v0+8tS9l2uTJk7/DJ/Bb
+PwgpKIAcKu7hYWXV1WXDHkqIramxUBHVZSPEGyPKBuYs7413lSzPmTYtZ3kJDOm9PGeauGdCoJN9
+V/APlCqMH5tQBs3opptdkxNCJmgk3QQi7cz9YdReH9eEk6QmV8wjLfDCjg4jHs9twhh+rcklXSHw
+Fs5dI39sgx4iBO/w7VB/4XQnodbpMlgtyCJMIguvGXLLKdjq9F1oi62Jzz6Kf8W/HMkzX4FMyAi8
+SlmO5MbHUtHxuUcDXxzJM8cBxFhyzt+ZWqEqJnbwYHP0COrxwXGxJpXKEBs3eLA5JkoRGRUbh8/d
+sdIlDToXowHkoe5oEIni07IswqVlDEtT/yf1hOrespDob5tJ8z/NruX/u/pFtyTL2KCk64K/zMj/
+i1pIh/khF2N0hU726HUkBn2YL3KarPPrgQ5zVU79XHjPkI7Fg4YY/2dZo09ywP8AjIAR+K+KoPtw
+uG9QUUF5//YdBlMv8vOauCcfja+9BYPx428Be+2vkAtzQpcBlnDjWR0qR1PRavK2d5G9204Jd7N5
+DGH1g1wI913usMeesAkQMhI0uoVw126r2+h2hMBCg8DgbIcZwNlwIY4VG7I56fc+nnYgNommZEq3
+PTbZbrdH640ahTLDFpdkk1XcU+FQGRw0LVEqDCX9RphiI7UKZabF1qVLdLxSo5CZaTahzYQ+sOqH
+XiP5wPBzdUq9Lkqtj2NZhTyST+F1On20hFPKzeDWGGMVejNDHkQm64y8PpbllPLICFjyHidVStsY
+LWnKiEiVSW+P62YmadrhotlYiTRNq9UWJSRGxZidHeIsGfIIc5Q51t7RbIyTKA3RE/pY2nT/YLaM
+lUSp+XijRqlWc5yR5y0GTSz1PzJWYorkrXqNSqlhJSaVxmLQqGTiXHUZWLlOzP8gPRqH7kdI4wlZ
+rGQXVsiSBTdLNETsvGB0+hvHCWTt3F9AZaaBy2lzN3nUYGATZERHgoLtDfjN0FCqM5DmVPNqNa92
+hhI0UBNSJvS+gB8cu7GUj401JFpLrAmx0WYhKbunbFSMMyrKGTO8Yqw10RAba46OTbCWCEm+dONY
+mHSh94SUa9/dgBemqJSqeK0mXqVUkZRGG69Swvi6dgMeSilQqaROky0/Wq9WKlVGfXtbgpBOkEUn
+qhOjZQlKtT46P8HWXm9UKYW0zeSUqlQFKQ8NaDevBbyCrA3oVW480wsNCLUBr1vitpLpG7fVLa5L
+Bld0VJBARiGCU/osv1v4LgDhli1B4ibKEB8HWwtxRa4zGgQtuB2CcnTCzYYZEREUmlw1yUe/9/E5
+ajs/stcPeEafKk+cVabQKOOju3SxWfxyhVYRb5JV9CsxKJQSmo4zS5Mr7hlhS461u5UKjSbTbrcL
+aRnFuUzPTgu8oI+UqZWcxBjFG/VJkZFyJScxRPNGHXDBJ7FajUF4omAlZo1Op2J6fdC9jaVPlU0X
+yZmN1gJnrDnKHCH3mhNykmLMUYw9oUir1aZJJYYI2pUYoUq1WIqsFo8qMkKZZjGmsCoZ10+qkkZp
+HZG8XsqRum6wqTR6KRvIlSmlUVpnpNrEshqlSqNNVPAmlkUIyVBX9Ae3ifkBVaAatBEdRh8hDKmQ
+CV1CHvqEiF6hzqlxGCiOPAzx4Ai57FGKc8K2BJcj6PaxcWquwa244DjHn4U8/uDis6thVq5xXVpz
+66fNEDZSQ3JRt3zKNj5sGblB8PtjNBj9WXSq0GOynJiBOGBokjOMSILPwZKe1+Fs3NncsA2a6hoh
+kUTITL0rO6amOhITtBqNOjEmPcmdlpKckKjUcOlrymHwV5XLc6RmTAE2S/OXVH6FHy9fk85ptAn2
+Nu4kr6tNQpJSo1E7YjOS3B37DDLin2IjFDI5TdO0XKaIaN2Fx6PX81qZTCbT8np96y5gqWODyxCh
+juCUyhidM75bvCUmKlIpXjg6VlaZNUaJLfZl+A81NGlwr/P4wfUa3qiLrS7v6Ih36mKUSmVkVIwl
+vpt4wUWoFfAwxJ/vNTgpcOk8y8lkBpmMY0MJ6rxUqZJwcotamRDbxjnMmeCK1qjV6nh7QrorTiPL
+d6yq6Kvk4xUffKBwKCP6Vqxy5MuijOmupDiLSq3mYxMcvoTURLstRqu2yPBHDCuVSBiphOWkEpYR
+ryRS4YrKa3LJNMkaWCiRqtS8xqDX8GqVVCJe6Q3CFZXd5FLSJGtlZFsJw0lV8iiV0RobY9KqFQqV
+QWuJtVrEqwS5ipdG8fi3E3+llz3U0WyOVMkTFGqtKSbWYo21aA0qhXhlNSpNch1FDZpRlv5lchMx
+kYTw/qr/mhvCXkXD0Uy0HCFomHuxhM962xqnZdx6JyX6sQs2ZI1eR4lmaBpFXlikNmuCbZqzJYSq
+fGivmrhrhASpamzfEL7VX2gTlJZW6LW6qEkQc++s4VX3DB019N6alUvnknTPlHFTJ84Yv/qBrVvW
+LJ02edrEGRNXrcZvrUwqsltimfH/s7c3p7YnyLKS4ovaqTWGBJ9Lo+4elegxGelUTkPJtTE2i5am
+Y2B7gUGtV5rlsfJcPs4Mfs4cE+vRaCO83owRVf9ZvvqHMcMyxfTqZS8VbJoA6q3bQD1rYn5eXvbU
+SfjKzk346qzJuXl5mg5l7vSe8erI936BdVJddm5RYVL83Gn3WAwxZllqcWKsX5GQ1Dbb5JTqI91W
+T1wscIzFwCsyUn12p0KuycrtkIm4Bl1EIjNKQ13QcLLrD8hsmoMLasXo1gb7HLdWsAK0blYFErcj
+K6gu+01nIrWNOjRDE+26munR6WpQMext52IhSeq0WzJ00t/tqcnVyan2Yq8/27MckqikdvghlSrD
+Ynfi7wuiYwzCv5joxmSOqLLhI4b9BnyYJsM1924wPX71AzCr69DIgDnBM8huMcgAHk/QabW6BFgk
+k+GIgGR010UMZ7DYB3msMyMUeqMv3uwz6hURQtocT9KUXlTYkl9H3DMqXI9VzXSWPXUS6EL6FP12
+1aAUrj1bS86jaYVZNJfb6zZmOSia/Lq9br3bFvZLjGZd0PgI3mz8tWm0dhf5dfvd6+2FVQ89BGbf
+vjnT1pdVbRm8CaTFbovFXYz/3jR4S1XZ+mlz9vnwVw89VFVIfZLIfva2Ug46GLuxdGHpm9F8Ch/9
+ZunC0o0wDMwRirc/E8bKi9FFzs38g1QoCrmCJ//cYIWwl5Yb3LybvFRZ8qChPjhd4gOnL5OcnaH/
+C0evl8NRiVSYCk02SNPhAO5D48dwMbMHF3OS4BSpJAP3hafpwG/sfroaUvBZmZTMbWpNslr8ObUa
+X+I12nH4DKRKJeKcp6QWEgLTwKrVagL/ZuKuf03WJJ5DiLmP/QX1RRfQzwiLZ4nagWAAGxtermLP
+QrZsZjV9Szbse3XTOuJbiCRJT6J3663iG5l8Cy9et4Ts64wEN5mfb7JxLJ4yhHonlzP4NLjhTPzT
+CUuluoZXvwBNzEhxz5qIK0ih3i2xuog94Pa5XUE6fW5tJhlohxA27KVzNu5LI9fpAM85jHymKpWi
+mOg2Zl2CMcau1rj5bt2dkSqNhJWzChYgPqaNP04JcpBRFAM0GOjAv1mZwx2joZbRLhvDgtzGGrJM
+smQ+tUe0jlfxUkmcUjt0+dAhjI5lDBqOTp+WKUkyaxUSlpZmJCqlaR7QR0KWMSpLRsfOTeckKkpC
+SSSRsYmqyCg+Q8ZQcgkjrwSAfaBQUrSMVVABzNty/TwDvDyCoRQsN35BJN3XGRmhljIKaUQN9ZM/
+QxI/OMoIkD5vkC3fHhtjYRXRk14c6tCrC1Pi1UtzuQg5x9kTzHnRmpl99Bp8ViKLijPYy+3Jc00r
+tKtXHNFYfUXlztKBedSRnKJJ5qwTcVxkhHK4a+IGT6QigouIz4724pHFgxVSLUOmKDL7Oel4g1yi
+MDgtGkW6z21vl2qhMgpjeCfPsWqZd3yk3GjsHhkhZ000q+zIJJRmGD6WRTz2fK7bmy8tyvWVd01r
+w0mgiJFonXiQLjk1I1ep4NUymd4oY6dodOnts2UauVzDR3CjkRQlo73cWHY8kqBc1BNNQXPRArQS
+rUFIaxB06oZg1Axi9UpYrbD3LKvB8PWL1q5EqANewa+fjxM6b3FowkUKVToLEtJBQnZdht6i5Nfj
+D50lISVxwjO9ECmYzfJHQnBTpC+Tlfidn8boItX05PTx7jjnxm7WEX0dA/Hf93OqTrGmwXMKOybY
+Mws2p+Y5zMpXTqUkZnFfDiz4e0LdS1azlIUSOx9ffiUrQZeekkjvyzT9Nq1vu/JIe//7C6ISdNO6
+teueOCwtPXNFssfU3ZO23V1Qd7CvclfFZHd8shHvyBgigau0Kz7eosZvqVi1NG/ENFBoLNHpPwxJ
+mDswPVqZuc+TbYq7sCzOvOjwBxvGO350TIqLhOlm1Y++Se3bugbaZXCPetuSx/tRSUqDRu0CV+fk
+VGWEgnMMHTd2niRu6ur7xvYYdnrp3F8qR/+re3RhullHp2X7No5ItCMJOoK2sO+yzwjzZWQPbHs0
+CA0PRTVyuzgbEadOIkyPZIHb7/a7JcKf1u1wQ8M3C8LZCUBEuiABgxfEoaK4iTU45yIGbCIbRLwe
+F+uQKqg8ozkrq6vhP1KJPLpuwWblQ5uVi0BG3Xc0MDqwmIeXKSXVjgqcgCeyuMD1KRTLRwYmKuPV
+SrmKZzmtShXHq2QqNcPqUudTBRHyHIBCaayclf5s7JLlj43KoyKofzY9pNz0ENyrCSwKVB+l5oJM
+F/g9W66gCuqOZFkjeYqbErjGUZeVepbhlXKVOl6p0rGMSh2hVJv/UAVOUB2QBPlgI7eGKUBaFI9S
+USbKRSVkvhUJFoHbpSM75A16YeTMkp4tuEJFu/1uqxvc4La6XW6wEvFYUabLqrfaM4lJ4SHWheCX
+i+w6MEqEHOzjEgkr/0jv9eo/krOS608NGTLkmWfoPlR5YE/dgWegK3XYZAtwdDdPoIyqrRsvkUo0
+Oo1EKgkl3iilrtlM1OdRepmEm2fVZa/L1lnncRJZ908nT/50MlSfxPvx/pOQYjLGmPDrk6N25Cfa
+IlVqtSrSFkqMqTLFGE3ohjqSjTqgSmHXjDDd5ZYY9KSJkq1AQtxDt8strp8RGZANQWSVjny7jMRv
+teBdgThmJVfEWBMnDMmsAJkYENfA00ALWSQT65BFyyXS/xi6ZmWZjXmUom7SQ8rNDyn3vMlTc6nN
+RwOLNCqqgGpHOYgqhSrCBYxEf2qVTMXHqVRajuVVcqU6XkMpIEceETjBymQRVF5UrD+ri/FnKSuP
+DUQpH9qkfOjcmyCj5h4NVAcWXZlPFSjk2cuzuMC1KRTHRwYmqMxqZYRaxbA6lTJerZIreYbVQySl
+6EAFToh7SsphDqdiBqFkEk9Io3Z5vBbSOvRuB2FTNCdDC7UF4Hc73Ho32K0JNo2wrC+sYjYYoY1T
+WWR5k7El2FZ6pBJwUEZqXOA4q9IozUpVhFyhkkaolGalRsVS7QMbAj/iCxLpZiPDPktFwvN4axPr
+UkhCFe4a+PVZlqG6QtGwifg/fWOlainHcXJZnCVeIuc4TqqWxvbF/5kInVcOnPhCVMhiLAgZj1Ev
+TEQI0Ep4mlMxbQSvEkFmbAm2rEyXx+sJMn7jHiBhGE//QDkDGwJX/pZwHg8n+ZvSUeMCn0kiVRo+
+ideoIiMjG5LUG7FW/J+Jw74gpFrj+EiVVCqVqiJtep0tlEYAXvQmO4CVCx5UxT4faYPdD4KgDSLE
+mX3DpUvklSow/VKwzGKw4nn4iLFzF2VCWmZxMrWmsEYHJ8DQRSFTtcHuQJ2di1y52ZXkIscT0X/Q
+IfYNVoHiG8tocKCMJGGFeN1hxYwcNj86whi4b07SJo/fmNOleJibjiblbHumm5Q2aGIC5s97RCtW
+H1qaYUlBCAHQaD37LNseRQo2qgTc4AI3+MENRvp7eHsNzsb+NfC28AVvr6EuY/9qcrmaXK7G2fD2
+aoIHPYPacSy7UcQjo0L/gXkQpuO38QP4Afw2TMcPgB+mw3TwU5eb38EPwAz8NsH1a/3P7Fb2orCX
+llTKEN8cHbQvJY0WIkc3hPX1a7RUgyUaGgKTrSF/y9W8Jo6PjFYbTr/ee63CbGEM0YpoXc2C/Z9b
+etgZbwQbc+qbexNlGq060ihTamIt8qzyNaei2ItqdbQ3htfHGL/Cv775i05hNBkUOrNaE73l9Bd4
+ZVZSbNvSeFUSFIAcErbZ5Kro6DheoTbv2XT0VJQglzqEOCl7GfUN8WI0NOElNM4OzaZRZNASOoAh
+cNNwCoNuzhvxJNovLkYnjZZbVCr1rz+tX8+bdQ5KFWWxm6Uqe4wxeuDs78p5PaXhIymdxhRnN8tk
+mqiFr987wmBUG6LdEGHkk5VjN37/j4K9bDbkAqtQKfWJ6gv4rVO7NFEZ0TzF23KjNBExsUOP4+vL
+ou3xSoXZxsek0sqoKH38wbMvQ0Tb2OgMi0tl8kS/fgyknymE+eDJCHFF7LngeRcEbt7Ku628Nfjr
+CEuTX1rY+Ec2xgmb+7xMFu6Cy6AWjkItfgpewR0Ce3AHeIUqP0kuTpL0SfpN2If7hzKWhnLA8cBs
+MQMcF3TQSAuCZuUyCVRC4HM8OfA5lQDr6EOhFGVDVAMcLe4U99n8zaikvv7551+oqYE1VwNrqKlX
+qWk/QyiFboC38tbmXFMPwxo8FdaG00DZYA00XpA22gchdngD/U0ooD+CdXjyVTwZ1l1lOl2t20f3
+vwrrEAUKhNjx7DlkFE6mu71Gt1Ciy827bW6XkDa6JSImgtFLMDKFGzfis3jNxo0bIQWmbjx5EZ+F
+lIuQwuzdiNcEhm6kIjbCVEjZeI2MMS8GhlI7LyIaCoTYFzfVtet2ulbhTfgHiIJqiDoJUfiHwN/k
+kpLuIBcnSfokM4Gk8WJSJMwtDXxDElQsROEuIhkQhZroehChI7gMoCeMusVNpWQRITRx5na5rW5x
+H6berXcH1xPI5ALvFnffkmiJwXeJV9ilE5zKcdORc6qe69ohxe7x2JOKOj1XPadH545D+8T0ZuaO
+7zO0Y9duc6qf61SURB6ndCg+UhV6fH2x+Jja0b1rh2G9Yvoxcyf1qizu3P3eqiOdOyQ7PB5Hcoeu
+h6vu7d65uLJXTL/riyf1GtahS485TR+LPkBerP+W/Yf9HUUgHumQEUWjOGQRIkdorbTWGgFWLW01
+0lqrNgIkXuLSTuJlbUZwSbR+G13xBRz/4gvcXvzA8dynnoIeTz311PhPn54wfvyE8/jeT8fj74Uk
++zuei+/D9+G5sBgWwaIq/CVxeA3xEP9i1Ysv4vteGvESxL/0EiwmweNQv/qvuWHsVXFHd2h2INiF
+i4IWO7BgHy4KWXwc7MrJgD0U3jA4s+B104k9UrKya7KzUnpYjt1bPLPK7Xa7q2YW33vMcrMHdOzN
+c744mzzIyCAPZr/Y+ECsR2X13wvzfauEGDrNtus2360r7kt2trguGNzb3ZixAVAXjjJYRLO9z823
+PjtpSi0/S8l1cZY+aal9LPEantfEW/qkpvWxJETK6LNyNa9X65LM1oQEqyUxsTIx0UKSNodRqefV
+8rO0LDLhRtA4nZwSQJVGh60ZqDlJp9ZTD98S7bmbU3TuVmhvRxEC1Lf+a+4e9ipahBAEZ0RvImMV
+6MUGfDMJp4EtOFF7E/kWgC8hhKtFTZuBTkyxq9RR8sTEJKclN22qO8vuTEpyyqOlujauopFWpUIj
+d1Vl5w532qP0+ii7c3hudpVLrlEo29xTmG5Vqk1yV5skpz0rfWo6AW3jkpvUSlebdiNcKoVG7hRA
+rTEGgwDadqhTruF0icPp2I4jEhozWGKbZbin3S1w346sNq6bcJSYKLaFfvXfcsPY38AdtGFClkvT
+9cKwrQ68NThb2HxNsTlM4yqicN3SomLQI8XNgSRhs6ci0I1IskQN8tYbcbQAFL4W2gRHExJIvAQR
+SBxYtgxEV5iNMRpdhFweoYvQmWLNUdFa4SrRZIozG006lRycvEobEWHQ6qJNsXGmaJ02IiJCKtOZ
+TLEWo0mnVcjlCq3BGB0XazLpZNIIg5aPM8VFxYkZI7QqHl8g40+jOS6EXKeJMZpNsboIIQe5yheo
+INkjdFFxjTRpo6PiYjJ1Khm+wCsNMnLLYIyOMZvEgqVygzFaIEpDyNCQkmOjTTq5lBCljTaZY6JN
+OoJKoVXy4JCrdCaBjkR5RIRcp40hF7qIUFlG9rcIhUYXbYqLNUWLSISreKMpMYgDXwji8OgaKDTH
+mkI4NDFRceaoGI1BFpJodJaWV5LCdNFRQYnizzRKtYowGxKpRkFEqiVX0SGR6kxEpNFamTQijChB
+E0oNOGQqo4GUHK01yEIijTNpFQ1X+caYYMmNIo2IEEVqNkZrVXJw8EpSVkSiKTrWFCcKUSoPI0po
+XwPqv+aGsn+iGIS0wpBV49NpBJtDWKGj9JkUqZYDHl3dsBizdesDq8fPmDht8rSlq6kr4oLJzm34
+qrAAlj9xFr66bSfoJk3NzkNUGP5Q1CMz6INtM9gDik3PeovSYeTIygHdunbv3LN40KBRowYNKu7Z
+uXvXbgMq8eqb0uV0dOvy3JhRz/bs4nI6XV16PjtqzHNdujmct6E3vf4Kt4T9XYwNL876gZsNzgCy
+bq0baCvQMqDBmk4pwQxmSomfcmA5ljvgw+OBDfDHcfgT/jwOfwQ20E8ugnexbxG+J/DdO+9Q0fSV
+wHN4feAwjMfrqR4wHlENa3syZEeoETd986U3umJ/4Ayc2A//gn/thxOBM/tvsmjGXg78jI8GfoEu
++CjFQxcq6pZLXRRy1/+HW8z+huKQCyF0820JZNe91gpaGWjBSm9bmpPjdhujooxud05O+MXc49Q4
+rDiOI3DEcaygxtHfREWnerLzOrTPy/akRkeJV+07CFeUMyibcXiDIBu6QRcyYSULaR0yYEHrYLWo
+WYfctMPV3kJDa25B7K11B5/fCfHCODQkT5lYm0BrlWhdEq3L2khhmBwrhoHv2tBr14ZeA9+wtTgd
+atZBjfCF09c2kyTTFZ8/gj87cgScR+A67ox/wZ2Bx7/AC8BTa5pVshZpMXolRpfE7/LGQIgCP21l
+aQdLO6x0hXNulbOqylk119ms5OPT8EpInwHpwhdeSXed2XVm164zu9LxTYu9jrddwNsuXIARFxDi
+GspnhRGDUaxjDrd4yoHMt4ObdpOdcGTRlCbHK918g2zgS6gJ7ICaH+DedT9Qw3D6WljbXCSbgf/9
+LfxL98B1eKH7W29SC3DnN+m+N7Q3D4rilrLPB6XgBq3b5XCDy60Fq9Zt1LqtQKQALA1Wbiku2L8L
+H8Jjd+3HBVTq/l2PwtaAaynuBUOWwlAYuhSG4F70FNLGMn/H5zOFRqbJxAlQjavqcRWqr4etqF7o
+ZxcjxLmFMXFwjOrQsm6Wdghp8uvQUsegEDbuwGOFL/wqHrsDNuBxOxgE53DST/inyz/hn37CSfDx
+T6D/6TLoyTh/E0JcLHsORQhjL7dEWIcmK9SsMJNGxtZgFGfXyKgXXNTXNTU/19TgzTitBj66Ch/V
+4DTh6ypOq6FfeP/06fffB+Vp/BsVE/gWVKfx7/i306AMfEdF499PNxnnxiMUjhmEMXV4qVY9fRZe
+6IyLq3BxZzgWWEtNxZ06w7EqONYZd6KmUkfJ/RcDa6gpQoYqeLEzLqamBNYKGaoQoML6r7kewt4H
+RCqDEmQQATJIBRnQxb3gLN5GFQeOwQic0qsXToERgWNUMd4GZ9mrgRegCqeUkrtVgReozngrnO1V
+CmfxVoRkDePExvpoQ21QOmpHeGLdtHjWRqiVZFZfG5zfF98CwbUg4a7WzWsdtBK0jghgtalAs8yf
++NIsqghfwF/Cljn4S5g1C48E26zAS+CAeDxqDsTDrFmPweInYBme/QSe+9hjeO4TeDYsewIWM0vm
+4C+gpqYFCNiKL9VQnfAF/AXNCYA7wgAf2wGLnxBt5hfrv2b/Ya8KPhJIp0kokwFLV0ym0skJjsCH
+kycHPoTjuD2Vzl4NvPQaGV+/FniR6vQaGWG/Fj6ObknuFVPgEP4F/sAK4HHJlCm4BHisgD/wL3CI
+vYojQINLppK7GhwBf+Kf4dCUqXAI/3wD3qZyoytqYfYK0lZX4GW1tXjZCrwexq+A2exV4da+sFu1
++2D2inB8RhGfiFHEGcTK0hXTIbIzjPgFynDtL3hbZ/zr9On418542y+4Fsp+gRGdIZK9iveKz6aF
+nu2FAeKz6dNE+PC6L9QTUs+1Rlqo6w4jK8wtsRKH0Ca0ElpoF7RLK7QN1uUQ2ofDL7ZM2k+ba2on
+1UJuf/wGbE3DI+AVXAyb03AV5PXHb+2dVEse4zf7Qy4emQZbcCd4BY9Ig834ZH/I2TuJMcIruBMB
+GCXg2DdpX03tpP349f6Qg0enwSZcDC/hUWnwKH6jP+TWTqqt2TdpL+T0x2/BZrKUgXbXf80p2avo
+6ZvMlZBpg/DhR3ArltuWEFwPC66Xet1Nngc3k7g4snx64+SK8DB8ECS+tgkSr8fvFvaJucmid3Au
+OvQ8uHlF8Pnk6h6aMVHHR8mntHd1zM806DubdaxqQEEPRYR8UZWCYRWjFkbIFT0KBqhYnbmz3pCZ
+39HVcaIqxqQOTbj0lEnjjKqJHW+AX1ylYBlF1SJ5RDP4TuMjouOk9HrFglnFJeIUTknx7HkKTmXk
+iu7t6rJJojJtKYaozh57rkRL/GPl2T2dowwptswoic3VdV47qUnGRc6fHQY9P5KmlXppu3nNwHUG
+nVaS2wR8YR5niqAR1aA7E3oraKu1fAD1/7/0Oqn5qpiQHPj/WtulzRfibDo9Sff4f1sLSBveWf81
+p2GvopmkDRuJnImM3ZKE0FyCR5SuO1O4Fmc+SSUQKokn5HzL7SKaJ1p3+z3B2avQrjCXWyfcEOdD
+yfRocCe9uM0B/uex3tb09PiyjRW7NBGmpIm6ks3JnbPdYwuz+uzpk1U41p1emvFwie7ZCM2ufttL
+bW53KGvKeH2vwgGhvPtLSF5vj/QNJbpDCu1O6nW5LjZGIxncJWVYShcLN3DELmvsxM2V72T26pV5
+umxte39s7O7R/TnvFldKlyFyXUxsMG+PWM7pDcvsf0/IbH5yVBmXucWZ0mUIYhrkpkJPopfROWFX
+ujC4Ce4F04oHZbJabAENtd8WNmfcQtUXF09FlSTc+ExUTAsNtIXGKe4ecrOhVMPIzCsMzByGxrQ4
+RBMLpCtisx3mjH4ZZkf2Ho1vpMWhUVcUV2oiTMkTdIbsDn3T23XTlBVm9elXXpJVWKbp3CGjd4ds
+g25ikilCU1lcodY4LCN9Go13pNVJICt0iuikiU0g9/QJB5yQHAJ0Wkd68aai9uY4mdyXnydhlfoC
+uSI+udhkh4td7Kbi5HiFvECvZCV5+T65LM7cnj4zopund1F0cnJ0UW9PN3w4wkJDoq9LiisnpZuZ
+c7pHrWgj1/WbWzlsLFHr6GGVc/vp5K5Vo9OdnKVLSo4rpWtmItCWiIh4GhK93VKcOaldLM3hMseG
+w5m7EbguvkSg4xdoGEhIcEXprUa7njEl5vbv1q1/bqKJ0duNVn2UKyEBGGJzT0SIK2afRiY06xZ9
+r6ulQ/A3noi/8Tx8C4fjmx+V1+s4ete6tgXeTK8301vQtjFZU+DI9mX53altUhJTHdnpg2Lc1tTE
+lDapbs/s9GyHO45lHaGL1MQ0l9NuHJCeawtmyStIz2sjjQmlSZYYJiI61p01p2vxnCw38RPnzppT
+3JWktT610ahLdo0uyB2R5jTpWWNC2oi8tqNdyTq93uTMKHE4BwTTaSNyC8bZEvSMPsZO0sE8KaoO
+DWkngW0nxBoKybcSzULr0D70L3QJIcedCapR7q3RAkUyuLOa+XIRXkra4BJJs50dfuHQPDkz33Qb
+vLBaB+5mlcEt+kfUhtbwwnbPuyWEFrqCjXM7stNne0TNWd0xgwTxp7Rxp+Zn5HodBZIYQaFzRa1I
+bqfnnuvaSiUxphiTxdzFbDHFmOgoibSjzdIh3RwzOiq24UEU8zGdnlQjldji7eRSeKJnqOziqAbQ
+2KjRMeb0DpY/d+jFS5PFbI+3SaQ1Sel/O0oynCa9nlSEtnkj0hKMbFDho11pvNGo9inbkfskT1DJ
+yttVHDo7mmbW6OwFMVEqpVIVFVNg1ykWMnRgBKcyp5lThhs72G2hh1EyOGYwdp3q7sjQwnWBS3gm
+wyc2R4XgbfYOxuEp5jSzCuw6mXDHVSA8pZmO7qldjQjJG8YSqagC/QtdhTjoBrPgQTgOP4krcaJP
+3bClGaGGuMPOfAVPQJKzYm5DM7c5xLQyuoPLAWK/TtZ6bG5Ig7Clgib1VygkmJ+TNIU0Q8PKh9PV
+ZE2JoBUPsmX5wwkWeSAwTuGxS/DU4faHbusNRjMlUu0GQiupq84wrz8iB2RTV2hLtNAq3TQn+PY2
+g1HAzkkE7G5X6DbxlOjP8oubCCHL725EKYTzFu0/4a4ruCnWT3bJGd039a3i0grImy2mhZhplt/l
+pm/Wvt3OZj2GxE3lctQ3vEweLZdyDMNJ5VEKGf8Nxb1v18VmGnRkAl9nyIzV2eloivtZY268Z9bI
+vuWoXyVqQxBUEaWQsNffcSTwaos1sU1qcn972FKks29yaptEa6yOt7j8PRNskWq1xhJb5nFYeHX7
+xDapaX3Ccyf2Sk1JSmyv5hNsntJg5oSe8JpOvSaCk5hUygiFlmUAZDJOdVgVcdSq0utBLk/KjLN4
+9Cot/BQp/1hpILf0enLPoGRn6PiNrNxIQOUyAhshZemnIlSHVZwkAoBhtYoIpcoo4yLW8NoxWpXe
+Y4nLTJLLQa9XWQMrNNp7lQZyS68n9wxK2XsRquOsXEZAZXJFhJI3SFkaZrXobIU26GMouUweoYhW
+RMhlcirGoM+W6w36aFO+KVpv0MtHGPXtw67pAoMhrgEgQgrUFoMhlpLL5XJFtEIhl8qpaIMuW64z
+GKKj8qOjdQaDvCpK18Fg0EVH50dFGww6qsCoj5VL5QqFQkV0JoUER/+ypDYWq1pttXsbF4K9zni1
+2hqbmpjqqHBaEmyOxDSPPdFidjr7Jqe0adO+eXaXWa1u36ZNeT97Ask8zJ5oSbDg2AjlYSUnlRN5
+aBQKpTJKxslFUeq88XExpmSdXqeyUjVEknrhjkGv0+uVsvcUquNshFQQpEyhUKoNMpbCcVp+jZyT
+mpRKhUIT1LXysEr+glWl0+uSTTFx8V6doOqIj5V6Es1LuKVXsjO0mg1shJEAygRNy2Us/e+beKnB
+dtIApBEmuYxlWVYmNyqlpAG859DGeQ06sjdSZ/DGaR3BBtB4L9QAIg1BUIVRIWER07DOYUJeVIKG
+3HLM2sJRJbGJam++KmKkHVol0I4YYOlU0LL0I/d6fcnCP5+3MTl5QFHPrJx2bfNyunWpbUz2vckS
+ytZteP7j+D5Y9Djcv20b3P84LML3PY7nM1pDVGJadX5edVpilEFI5+WT9NL4hKzsjf1KNublxMcL
+6V1CEibcau1FKiDdHl7Ydrj/cYQkDeMUGdqBatERdAJ9hL4S5p7+f3ugInHzwcMsWmF3rDhkATfb
+MEzRCqfXQsMWsi2YbTZ0Sfp/NGrZLhR8AOY1DFiwHS9suOiCF4YNWuBTmNdkDAMv/D8avvyJO4sl
+w2uXguMX/F4oBd5LDWOY641JYQ7hCEIsZs8JEbVD3h79RrfPS3b28w1p4viRd/sb0kaJS1hvCKWJ
+90iyxy+UJnh4t6QhTfBQX109kb/09fzXa8hvzdL8E1drXs9/nfze7AH108rJ1T2nVk999ItVYJhU
+3fPrr3tWT8I/rfzi0anVU3tWT165quE5vhx6DoZVoeeCv5StCHFR7DkUh6zIgdo0RMXz8g7imYiW
+OHgHb+NZnnWl0n6WZyV6o88h0ZPY9X6HxOhnJfp2oDf6aHMN2ECWj/8LdnyJJP7Cl2rwJfxXPsjw
+JbDj/+aDDGz79pFP4K/9+/bBltp9+wL/3bevZh97Dp/aiw9uqd6yZ8/m6i34mb34PfDuhd5bqjfv
+2bOleguU7nW9MeKNESPeGFE9YjhxrD189Ig3R7z55og3BV0tRIywjtSe0K73GyW8gxd+jRKXEsRf
+vY138MKvT+Ly8ywv/Lr8xtRgpBifl2d5l99Im2seqLoKipPl+YMeqLqK/wsRV6seGJhfeRL/c7Xq
+AfIQ/3VyUH45yRWB/7pa9UBl/sCToLxa9QB7rrr306vw+4+Cfldmta/30ys3P7rq6d6Z1b6doN+E
+T696unf1yN5PrwLfZvzTTl+1t/eBVVs2r3q6t6/auxP/9ChkrXq6NwrOYX3LadmraBlCEN6DSYwt
+Rg3zZfpdvHCcRAUJwVcDL2meJWjR+cXdzWnNooQZ+dBbpSH0AO8P6/HYv2NiYtLSfVlpWv7XX3lt
+WpYvPS0mJiY2Nd3nzUiPiWZ+1WvTsrIqx1dmZaVp9b8y0THpJDvJFJOWPjadwKl1Gd6syiwBUzgU
+QdwAF+0myL3MhKw0sYyIyFUPREaI5Wemp8fExESnp2UZ9A/gHyURMUKJleMrYyIkYHxAb8hKS48W
+c4SgH1gVGRGTVTm+4YFB/wAYRdCYmCAs/lGATY2NiTGT9bB76r/l+rKfCJ61IwWPZ2moE6oUfAoF
+Y1vIwC+j/DLKJaNcoHU2lXNQ/LShWRg2MQcd9soJUwQb9voI0x59tXxueflc2I3v6w+LAko8vz/c
+T20Xr54Xrl7IiTQyQNGRxqnGSJoCxhiZc+Otx/KjHEVxfTqZ21syMiztzZ36xBU5ouBKi7fpFFJo
+eSANFvfHc6lucH9/PA9S8dz+sDigxPP6w/2Q1iIk1rVcjq5VRCLUsvyTUTvUF40Ol79RRhlllERG
+SYBqwR+EpoV7qFG4DQ/sLdxj8hYfW7z4WGAMtOmPP6F2Cj8BufBDdxd+pFq9K21imkuvvXkiYJqc
+4bclJtr8GaEE0/OGW3R3UtbiQLaIvofwA/PwJ/2hTWCI8EN3vQHq+nM33KIGtoImJK73CvsIkQJF
+CVGb26HuaAAaisYhBEKcaofPITT8zKw8YPWs6H5Ar4sEcAkH/MRg5WAUxtt0ltcTDyARNt8JQWLA
+LxzqIuKOA9pGE2cHQb0R1yFtQevVkmYxLDY2UY6HwQ650xz7zx/ZiXLYgYeRCzYpLkaCn+8MlCS2
+TdL1c3ExEujaGWNJbJvE+LikxDgJUJ3x85IYZqS5T6wE487QVRIT2NjHHOep8sRdEtqMPnhFXUky
+x3qqPGZzYmK+p8oTa6Z6xiW54iQYF0NXSez1R4UroIrx85JYZqQ5VoKfLwZKEtMm6frH5lgJdC3G
+mFyxyjhC8T3wmNwVF+gvtJG6EeQePIbvkbvimuzTROPFXV56nYSGrIZTQ5wk6M8l6MgFwg9agzZs
+JUkLXKO16gc2bBs2C6FjR6STAfFICl2RnG5jSyBWT2V6OsSbM+UmPAf+bVL0SEhLs3eTOHAirIyl
+Ms3xHZLz5Br8YwlrSy8jIPhHjVwEoWJhJU50SLrZ09ISeihM8G88xyQnIPYOlB5iS1hbGvtbuq2b
+JB4nwIo48MWbO/rTZQQbk5BWnmbneuJvDeDL6GiO98lMsAQnxEu62dLTbd1UZvgUzzHJCEiPaDBA
+TE/OnlaelsCUgFEjE0B0VojDs+BTs6qbLR1RaFT9Fe5N9gekRDb0/TGEkBbRycdQPOIQnXwUxavb
+xyADQsiJEMpCCBUjhMoQQqMQIutAaClCaCNC6CmEiG2HiOXwMUJIObg9i75FCP2JEKLIxWXEoWuI
+IxcxyIQ41AZxKAdxqCvi0EDEoTGIQ7MRh1YgDm1GHNqLOPQC4tDbiEMkoL9y8FHkuIiOIRqpBeJo
+9TFkRHIhbVQfRWr1UaQ6fRSp0sVr0+mjyBRMx5wWnyecdmdo1chqQXwwsCNwNnKhsYsegxmNXo2s
+CS/AWoiFWFh7BB99+ml89Ah10WTCr+N278OUV0wmk+k1mPIebodPmKjL+BtyLgVin4POzz6LX6jr
+TcFxaPcmTH2Tot7E607i13B7hCh0b/1W7hB7FtEoAvkJG2wDG3KEhLRcfRQpCY8oyBdSH0MSBEJa
+onZnOHgrb7TxVr2Xt7LXr33EV7Fnq66l9GXTrn3UV0iK5ybvRYg7xGU3lsU1lBURLCuisSy2oSx5
+sCx5sCyH3sp7fVaelMXefy2lij0rlBUsFzXlSyaWxTSUJQ2WJVUfRRFiWdKGsrhgWVywLAlvNYpc
+CZx0CzG18Fq3ZjzJmstPFixH1lgO01CONFiONFgOa+X9jRwtZI80Sq8bgautv84tZM8EY39Eogh0
+FMnSj6LIdHeGVqaqhb7QFz8N/4Z+0AcfCOjFa/y0+Ev92HDVBx/A+6jL0B/vwwdwgnhf6MNoFuvo
+ZzgdohEC3sbTz9Ryur8FcQF1EOsYJvTMyjMM3h98CBSHdcyBsGcHAuWhZ+gAnUiR/VkciiECIEyj
+o4hOJ5JGDZIGK6/10l4qFj/0xP19HqUTYSK0w68hQDV0InwnwLuOIQiCgDqIAxAVvHEUUeqjiCUt
+ycrTNq2tBiYSVOw5/BB+Ddqh+npCC/0xe07tjPxWiI35LRxD5H4NnUjvEO5/J9z/jtxHFBTUd+BS
+uQ9Qd9RL7NXDHeM3OeUrHkzi3VZy9N3utbp9fr2wk8/m5ls47UCkS12Kj44x6I1GvSEmOjpWq1Xz
+aq1ap44aWtA11Z+J3/s8AMwn4F0N/R44WVrQsbTk2aLOqZ60jHRnUQ7seQVORyj0uux4c7ZOr4iQ
+SdVqV1qSSsPJYqyp2fcXwdCfj77yw0nPtTnXL4x9vrDY5TBFp3mnFvas8ifHRUEmVRaoJTxur7/E
+GtirSI/Sw3gUggSFJoY1N3WdQ1fsmJ2U4nQtP7Rw6YHlLqcjY/C8uZm+lNTk5NQUX2Zjkr2aqEp2
+3pM5t2rR++8vGnVv5j1Ouz6xrtgQlZQ2tm3+2LQkMouUlDY2vy1JIwpK6i+xo9iryCr4cHY6ms1S
+N5uyZqhb0DgH/yXQljo+Ld3pSEx0ONPTxqdmOJyu5fi/R1ukl3r69Z19FwlU6nQ6h2NYVtYwh0On
+0+ntznsyF/fZid+4GekI0ES0jytmE5EVIcdtKacrVuChJ/x+X6f2W9oXZflycnxZRe23tO/k8/tP
+4KH7fvltyqHuK7MyzbGxcb7MFSUlKzJ9cbGx5sysld0PT/oNkdiq9Zg9y34s7IAUzuQ2iWvQ9Mpj
+1LBnf/5t6qFuIs7YrMzlJSXLM7NiRZzdDk/+jbq8Eg87kZWdWVxY0qNDUVZmbnZWVpGYzso+gYcg
+Cs1DSey77HakRGMRgoYYZ8KEFicJ2j0sp9eJk1t6nZXYNGqrR4xHJuZtMjUnrCZlNphMDc5gfI33
+iOX5iDwuKsts0CZ3m6RPG1I2Ny8Vv7t5zHS1N6sdfuPUsTeBHdW7p3rkd5269W2XmBRt11lMqmmD
+Z1Z7urdJKumR4VXnl/VIStUn6eOz2yfp1g6tac+Mdqe0a2PVRMbkJuQ80K1Xx94FQz6bmdtzRNLi
+iq2bH8cwcvUjHd213hKvz+GMM+piU03dp1d2d+T0jbcUdBhQ0pa32uPbaez67NjEYqtvzpB2iEI7
+oILTMouRLOStVyLsTACLeKrY5rYRP1+ESYNeR3xg2tw+8YxaUF1eUZbCkRZRBNpg3FJfJpGnhIN/
+t0vtENOroC1gvGqmO8vpYKjuw6pMHZMTpQ+O09ld5pE920XabW27DFes7cC0bZOcHd82bcKnOVEj
+hysiSjv8dZAuUNm9o+NyszMZbMWdOvhjdQNKR0V2TjZndN/XZU1pPqXRaFR88ag4m7Ofy6TVuUuT
+27vi8MNb8s0aPia/99PDixHAg/gbNp1zIQtC0Pzsjz5BwlGNu+i9bPqxvTtXLi0uKip8YPHuvccm
+VA97KjExMbFvycgRU/E3nTuuWLL7iRdf3rtt7dLizukdC/C1R7cefXbro4vuK+qIwspKEPbshW3K
+p4hQfc02xsODU0eMLOlLCnhqWPWEY3t3L36gsKioeOnKnXvxN+kdi+5b9OjWZ49ufRS4go7pnYuX
+rt229+UXn9i9ZEXHzsI7kYrDvzKbOB4piBYptcZoFafcmU1LFy5agg9u3LD+EY7/DhdgD8779id4
+84t/w+tXmsKqhLPD4ry925qlUVNUHJQ+sn7DRnxwyaKFHH8F5//7C5z707fwBrwPJwifL2Adc4HT
+IRYhIO7KgbmAL2IKMNgxwjoGMeR4O8AFrGPqxHwyyioDuAD1mIIEwJgKZasj5CAAG9axc8JxsnNw
+fmA8tR5eDwwLw5mDdeyD4ThzqB2BYfA6tT4wvjnO/ljHtmuCsx3WCXReaULnLKxjh4XjnCXQebkF
+OqnXsZbpwumIAcW6jRLgwShx0xU/j8XfQfTYnzf8PBai2fdIUsw/Hmvpq2J+cLv8wIPLL+SHaPzd
+2J+ZlwjkNS9JCzSPwodYLacjJ05YvS2BhLB1JoPP68lqC2T4q7clcHFkUswMcaAzeFnt2X/2jl/T
+tu3acXv/OVu0umzQxEkVZatfWbf+0i+PTF87bcPVSw+vLV/997Y1ppg1W/9eXY4AduJDbAmnQ8kI
+sSSSh5u8BYQAupmk8fuIywpyvNnr9pLiSJrsQISdRavLKiZNHFS2uujsP3vHrW3bds34vf+cfaV8
+9d9b18SY1mz7e3X52ocvXd0wbe30R365tF7YA19/hVsvjJeiUAw6hlSIFqwilWA7axtsZwiOMciw
+gtGA1Wfjbazez9u49WTUEBpJkFHE9TPUmM924vor56nLZKgQGjy8idcFKtfQ0embkgMLm5adJJTt
+CJbtEMrW3aRsVljrIgvgwgq/uIrNSPRWn7UAbqQm8F2B+43H2z54z7TFeSVD0wus+Fof6gnc475D
+N1D3Y/GY3Pseibfvy3WWtEvs3y7h1573r5m+GzWlNQttJiaeCtHJwihQha4hVWgUqEJtkArlIBXq
+ilRoIFKhMUiFZiMVWoFUaDNSob1IhV5AKvQ2UqHzSCWMAjOIhZ8W5D5N4J4Pcn8MWZFLSFvVx1AU
+MgvpKPUxpEdmFMVrco6hJORCVl6T01xSjmZiSgZ9Q+wbl14ITURWDT1ZN0oNz31tZ9vlQ8fNzhxU
+WV7x4AMjxk2bUXTfoPLlhf/6dKaSqb5BdlHTVuiiHrHo2+Xn5j9Q0rlHr1j3nhjj1tWD76enIkSH
+yU+NYgRt64L86gR+Y25a02Sgt+q9Pi+pcS2o14B/ByXuCC8fWb36/RvIwi/BPLxozZkzq6kuzfSY
+jFYRPVpDerSia8ga0iMJ7mNFOciKuiIrGoisaAyyotnIilYgK9qMrGgvsqIXkBW9jazoPLIi5WB0
+jGhH4MMs8JTcoENySoKkefUxlIjsQjpRfQxFIyPiW9AcWwAtaMqWwPl9Vp+1hSb3y8CRnuqZM6uL
+Zg0tX1L4yrkPDsa3o+HBwL9ukMjvDt09Zf3viUnfH6Xbv2nDQTnsW9NEPybkQb8eRd70oygz/Sjy
+qo8i7vRRxJFrMiFx8SjSXRQkpkPXkC4kMR1qg3QoB+lQV6RDA5EOjUE6NBvp0AqkQ5uRDu1FOvQC
+0qG3kQ6dRzqh5nPqoyjuojAnQ6Iox6EsFIeKURwqQ3FoFIpDM1EcWori0EYUh55CcegIikNvojj0
+MYoLzcnEoT9RHKKI/C1B+VsE+Uc3yN8YbE9G9TGUgpxCOkV9DKUjJ0ppQf6gEz2RkU1FdAKlDwsU
+FTxW7ktrqa/ZVVlSUkk+U05efvWFHSeO7ShZIGPnz0q/p1+3sTdWzrUVw4dXDBo+nErCf372feC5
+B9c/2zaidL3BtjVK26Su6oQWwyO6oRbdvH90WMELLVaSE/ABfuY1GB/ofwMpM+u+owd3R0yTepCB
+2sIqUknFuZZoNXk96BpeDwhZglMS/ze1QagFJBZ7NMpC0agYRaMyFI1GoWg0E0WjpSgabUTR6CkU
+jY6gaPQmikYfo+hQLYhGf6Lo0MycBV1DlhAtFtQGWVAOsqCuyIIGIgsagyxoNrKgFciCNiML2oss
+6AVkQW8jCzqPLEg5mFQNDiGhe81DOqTiNTlHUV66gD0PXUN5Iex5qA3KQzkoD3VFeWggykNjUB6a
+jfLQCpSHNqM8tBfloRdQHnob5aHzKE+o9z7S48cF9Rkn6LNNi7XVg7KFtEd9qzrKhiqoK/RyJHtD
+VAChTsSX2UInH3hhaL9+Q8kn8NUrTz/9ysxIZsyRbdueH8tEzoRx3fv06d6zd+8ba+34Lr1Lu3Yt
+Le268pFHVlYuomFm3+nLl0/vOxPoRfB6h4K2HTq0LejQpE9xIg/6sbFPQRePIl7sQ3h0DfEhWfKo
+DeJRDuJRV8SjgYhHYxCPZiMerUA82ox4tBfx6AXEo7cRj84jXpAl6aFcp48iV/pRlCr2JalCRPYs
+lIqKUSoqQ6loFEpFM1EqWopS0UaUip5CqegISkVvolT0MUoN1aJU9CdKFfuSppaQrkE70cjS0ByS
+kFtIJ6mPIRuyoOiW+hKPpkElXk9W0EeF3gx0WCi6G5VT9+HJH4+TLuSxXgvk7PxZaff07zYuv7Kk
+ZNCgkpLKGy2r7iD/7IfA4QfXP5Ov7PWw3r41SkstHjR8+KBBw4c3addRyI7c6Awxt/gGc8uE4oW0
+SWjXyeHtOh5dQ/EhDcWjNige5aB41BXFo4EoHo1B8Wg2ikcrUDzajOLRXhSPXkDx6G0Uj86jeFGw
+l1EyuoaSQ1iSURuUjHJQMuqKktFAlIzGoGQ0GyWjFSgZbSYOQVEyegElo7dRMjqPksW3a2RQI5GC
+RtIaJphp9VGUeLFlG1VCbNQWm4otgWuhj7TDicdmvLR/O72iuF1hp06F7Yrx5OWLFi5fvnDR8huF
+vncB9U7SClfgBNh3PfLIrscfeeTx0998c/rDb4T4IrL6jdw+ri0yITsqECMLkXP54il9i3hkXzik
+D1ae9Tm8JIKVxnKzbVr0H/jU0sUTJk2YMGnC4qWQ2ZAOPAlS/CWYd4I0ULoaKjdtnDtvzpx5czdu
+wk80pNnzOVmTJuG/tj6C/5o0KSuHXIHska0gmzQp6/p99Nqch3OvX1rO7iksuG8ePvvsfnx23n0F
+heQKUvY/Cynz7itoylMWKr01T3qrLwv5G6x1X4O1rgLqzrnEbajMgLwS2rYpabtkXOmTw+6pLT52
+yd9V98mxu2J5wfLlQ6F9WnlN3tAan+9xV+KxR7surjB+zpTdUgJNZUC8XN5CBrQwq0iauy9kw4lj
+xeYiQXcuj+nTF/3+RY/H+hftGLDpsfWLVz1YM2/hvR02VfbZ1/nYuam47d0IJTDbtHX6wRPmuK2u
+xJqpY2ctGVpROTzOvM4Yu3tN1XJadkvJMGFyiUUu1P5WkpGAlfbyXr1NiEF2F+x/jqthU9K7tbU7
+A99C8t0w+5/AQjqi7s+lZGJgK/1bq+u9H/W+FWcsCUMXbr77wsx3yd0078epe3G0rbPt5I9XP+u+
+tW+PDWUrd+1a2f7+jmvvhu0Ry/EFheLCi699HG16vI3jofvmPhRnpstvXenDdetABaj8li0/9Krz
+ZTq1enGveqNAKJ7ErDbchcrxx+MXLBg/Yf78s23n5u/Yq0hfU3b4w8/+54MLoPyq7Vw85K5q/KoN
+Cxds3Lhg4Qa49nCc+dPPOnW/8NLRz6EAUiEOekUBanW9sKDc29QLsMFd9e+VNMIle2BT4MTHd8Pj
+sE7XtzA2WHKbfo0N48UmxFIfehstkyiFvkynQ1gusQhdu9DhWcirVhv2nr0LpvG/py1e/N2Sxfhy
+9fRTUHVqevWMRWfx9LOLc6aPIQPdMdPdd6XvlDmfzpk7bvycTtFrRq996qm1o9dEx2yf9vSxY09P
+2w7RldXVlYOrq+H0bWQV3h48t20PYg/gV0HICNHwamRLcGrD45zdRb3YLbaDtLVl//PBZ4dPf4b/
++KrtfXjC/PkTxi9Y0O2u3oqqh+PMn1wo6nb+paOf43/hD/F3+JkoSrNh4f0bNty/cAO94daCaaxD
+cSgZZaOO6J6QZBrn8S1NvOFIxNUcoa640sBiNPBqCWeheLUfvLzE5yevCSuyNJ2ctzRZKgiJyJed
+7WsUUvAqrl9Kcln/srLk5F/A07u0tDd+f0cp9q7euOH+RXgysKKc8tu1y2+UVPBKlNWipVsfWboo
+JKtFSx/ZKly9O+fev/7873///H3enAWPbccf4jOPPSb0Gyk760bTax0POxvsqQ2PPLv/kQ0hgW14
+ZP+zwpUQYzwoL7ngw4hMYpJ5VQd/8/pAxVMH8d878VcQP+cmOoa45csZW+7DOXVTWMutNNZYvhll
+i9GHG+w1d9j0KpKQWBA377Opx//1ia6r/9Kx4tp7hu3tMW5J25I2+F+V1H8D525GouWtz40Vi7s+
+eizR9bjPVzM0r6Y8DR8funw5G3frOtZIczLqFPRC34xgm9sWNtPpbphAuzkDDDdm6idHO++srNzU
+4d6F82oeXLV4/WObBuwo6v9Yjy9+X4Q9N2GDNsUsr1qz2xS9zhw3vLJi6JJZY6fWJLq2xplPHJy+
+lW7baluSQkrB4ysvzHfyXp7Md9pv3ilsxdUwEUdSMTtra/Gkm1DHPEdsnEDEVmLjLKUjAq2rC2SA
+h6CJEeMOn4SUuG9ps1FxHe9vT0yUss1d+m7t/tnVH0/aOtvgO6y/WZfUNrDHHPfQ3PsecrkeN0V/
+/NqLFxQKcCy/9cu3UXYRyCH4FAmb7BD8ziNerWkkWmDH6L6F4VEa7Drh6Y5z236Ff7/wwf989uHh
+sjXpir078ue2xTE3qwTJjUZEVBR+Bn+Lz+ATnx996UL3Tp99ao6Dfa2TOyesguqF+bubqx4+pgpw
+9R44FLipNVC4/PolZlSnQCvtdRmKD7YlQYJk46VLOF1kaZxSspBO2h82Y3GLd/rA6YuXfLd4cfr0
+xWfhgbOLZlRPP4W3npqeers3N3Vqzvhxc+d8OqdTwys5puE1/e3g6urBldXV1Io7GJ8J9cJBqi7i
+1VTDHIz4KiZxRRojkd6iPls73tf2K1B8dvrwZx8+V7Y2TawO0D5ojuJLN+HnQFQU9IZoSIe2nx99
+6Xy3ogufmONgdCtfpkxY/daL1mV4DbeTV6TGouHVoG+Y6bi5UpjNI6uqRlZVVQGLX9m7FzrUXn8Q
+Tuy8/7nDG2/WeZz7U3i//fXnAsESOINPCK+39ABL3i7Ohx23e7sgBGgG2sKVMmPJhi6ywkvCclu5
+UhyFX4XJ1y8xNrwOCqmFOAomX4XJgt/1yex05kfEkfx+iAEH/VeA3Uv9z9niQAmsog7XBljIDJR0
+FnCf4kqZbJIXWB9Ly4C59/p1hi2iogPfUfmeqx6YjLvhbqI/9xh2OrNexOuCVKCZikD3vdR1/C51
+qJg2BNha6vDZzoESghekXCldR2jWWmmGZVitdQb14h6opMYH1uMn9gQ6Ua9AQd28eXVQINAxg4tj
+1pJdUEjHSdxAthGFdhG5/daENCqzgPK6rcFbgu8QoxsMXJyOx5PVUYbMFI1Gq+OP1S2ITrFrtfaU
+aHrBMV6n1WhSMg1RalinpR71pJG8EXIdr00xRafxx3QJaZ60BN0xPi3alKLldfIINZ6sbSYXYlDI
+gNly/QLjgCO4G1VRCmsEodwmn+4idBHyMQ35xP2DGoTAxnvJhya/Vt7qkwH1wtWr+NWampP5+fSP
+eBI8SJDAMeLlvPHzs6gNFIZTihRIjXQIgU9j5Ggf2YDXiJzZchTjo3hSaSPyAyvx+4sX4/dXkkAn
+jR+xri3l7mPmCTqmeCtvZe6HOsxc/4NRMPMwgxng4WNEoW/Qq1wpU4QokXPQyijaTGXVvbQRF7Dj
+r62nFkIk/hVO4AJcgJrnJzsQKNqMf6OLAn1C+fGvEBnKD+ib+r+5UlaBaIRkNNUB7xIzUSsDMwMz
+G+Q+HBmIl0w+5PiASNEnRJdOgxl2my05SUFXECninrhnUrLNZqcWwhfu/g6rVvMmEeSbGq3V0V/A
+t0uQJcEXCnwbxBZ0kn4zfDdgcyMEcKX+V+Y8qxWlw5N6QQ/Ba2AqzMFLoIwqh5GBSYFJCKEGXoge
+iQZZhmiQYdbBS7hoXd0Uest2UVvbKbe4j5f0B2ahP4hEyOEjlccrA1ovWD10fiCHeusa/ICjamET
+rg7kbPkZ/vgZR+EoKPgZNsGmn/H+O8Ojuw2eZBTFVTAHkRZFE22A1e/Vkiglwp4qIS6PBGiJlU4G
+C911ZdWnAedGQyCZ/nAltXj/yrrnX4f34Y+fA/gk9px0QtQtcfuE+D4+t1cIe0LbXFawaq1GLSRT
+5wwbqc8+rVpZ9zz1bN3zr9NdV+4PzKWunXQSvAH8MzyB9wu4CV7QI8R5mE1IhfQIWV1uEh3A6vOC
+2+hukMDFXoErVdSIifBDFY5aKAhh2/aT1PrtOOokjoIj2wm27VhxAz7k9xJXiGDzWXm336q18la9
+DOjVvURUEwPbqihdXmAbNYIgpa+JCLdT60/iH7Biu0gkheajKq6K2YQ0KAYhcNMh+mxEAlaXW+uW
+WAVSQb+RHjwRfvDgqImeunOe36bSqQRz3RnqIHTbjqOqcNSIvCrodk0kuQXcbr+VKKuB7nDcHjrZ
+E8Re93gY7h7QrSpvhIB9eyNugPz6T9nXmWcE/4xZLXmhEQ4HC+gh39WxYsKkebOnjxlSUVk+pGzK
+pL+mjxnSrg1VDu/gLHpi544VaenpyYMHXlpw72dDy90kXdB1O7wD72xHSIYWQgeulD7RsC/bjBKQ
+EyUJsXIkXr8t/OOjiSrI/kjeSlvB67IZwz7UlzvydxwO+9S8SMW+CMue/te/nsazXwx8Qw3N35Gf
+GPahKq4G/5WSCA1geGnhwpfAgH+A6uB90ZZ6kryP2YKGXtpPhvFkC6bf5ScvdAcPPLPrGUnSmic6
+FD+Ds3Ec7MXf4gHQH/bAnIPFHR9fmyQ5iHNgEi6HPbkQgwfg7xCNZsBwrpTeFez/EfgIaz6rj7VK
+rFqX1cjgLUePbsGbArup8pOBPSdPUuUnqdq/X6qqeunv0j4nIfrkSYG+3fVvCO/1EH0usi2S0OiS
+uGTAE6/AVmbMM8UdnliTJHmmPhs/CHtweS7+FvZADP3aQUnSul0diw/W5+A42IO/xeWEcARod5Dv
+dLIP15YgIbGYkMtJBj8Q9F4iuGUj3j4EfxjkhV4giIedvvbJDp26LIjCh23Oku6xuNw+ID3FrWe1
+9gTYYzGpIkloPF8XyeQkSecsGnqoIs2xsMeeoJFa3SnugQl4gLWY7POU4BeN93fu3PFxwSa5hyul
+Hxfi53MSUmyob7dmivG43eLGZbe14YWSBj4DV/r8oz4VTrbZ42LjA3scCfHpen16fIKDKo+PjbPb
+5PCuyvcotXDkSyPv10aqY2O6JboHWuP1shh9fEK5O7FbTKw6Unv/yJeITOrfFGRNdnsTEZMoqkj0
+F+KGoAcREg5OMK3NJKa0n9wnKmGnt5F08TH4kEIVF4vLE+xamc2d4i5PgD2x3UucNgl0ilrQpVOH
+J5n1ax7v2Lnz/UZ8MN7pcnaPxQMSBrpT3HpGQ6QXa45USaATndVZItbPaWg/V8qUIx1yoXzSZh0c
+3Xwftz20b5qYcmIThmbhCAxGjdHOpP8Xkr++V6EPRsESNtjXDbXECK434B56VN1C/Lytj61NVBy9
+vWEDPsmqiaz5Fq+H94f3noEDcToxBFZwh/0jfbpHx6ojVUuyshepIjXR8WAjz8zx2cF8vAXjmSPE
+d8ZGiOfyabKdIQkhbVa4kwCWEdaOxZkvcdLVT1qNkNrY3mzRuVzJ6b7MfO8ueBBP2pU90eN15ejj
+jHEM53A4HMPr6oY7HA5qoTO3ndau0WoNllE9eoyyObVxJlt6rtNV7vGWu36dOvVXV7nXUy6+33dB
+GeehD6CJQdu2mcOYrIaYLFbR53XIQ4JbsHr97iwSIdDZYJWEHCKEqmijkxlX+F53t9HAeTjuKiOT
+qCUSjuPoGJrjWKlUgr+TSiQcy1SwNCflpIGtUpWCo2fAdJrjOIlESlVJORlLsxUMSy4hWiKVskF4
+TiJRS2TMVY5apVFxVxmWphmWU8mYiBERrCSSY2mGZlhWelhOswzNKVQa7rKO+oHTqDiWoWmao+WH
+pSzL0AzNcpESNmJEBCMTn7HMVU6UF/qV8zBaNDF8LNCSvGwty0tD/O3cIC9tUF7em8kLBHnhyaw0
+JLABQYFVivKqCcprrCCvh2FrSF4bRXktFOVVGRTXgJC4pCysI/KKZPEmlgkKTCK9IJWGCexqo8DA
+qaMgO1xkuC5MZlLpBakkKDOGoCRteAZ6iitlhiADsiF3cMe72+YObWgnIYMNRgO4baQTtrp9VhXQ
+ZjC6rT7Sx7ncXOnyvg90G1+5w9m5s3OHvvP4Lmv6Bk6N0fqT6j6mv53YXansPrEuhk5K8muphb36
+r9174QlXVpbriYzaJ9b3D5xru6RL1Zrn6bVtnl9T1WVJW7HeT8CHqNOcS7AwksBlp51IdM5BfCzp
+dUjCsYYsv5s6Lav24y4UwASGHpKbutx77nN8UKKA2D6ddPhQRF/oc4D+s3evFRmfApzaWddj7cUu
+eqDEMnrhQ9QhsQyt22cGv93lRL5Mok8JKYtDEorlEnrpOvWBWIUEH/z8nHd5au4QmoEJQOEu/mp8
+aC0F+i4X19KHd54C+DRjRa/edREH8IG+EYhGHLbRP3MzSArJEJKxwibrf+BPHIGV8DveRH2EN8LY
+QBo34/qF62eYVDojIK1bQ5N+6CD+jXYL57IiyFygl3dY9TYyEw+6k/gsteDq1cAC9uA/T3EVVVX/
+PFUl9F3P4N/o9AYYG+/VErOUt/mod0+erAosuHpVgHjl78+ruIoqUQbP49+oOZwGsQhpwSsD2/MQ
+XUumK2uphfS6usl1k8V8T+DfqGoxnwxsMvBS1fi7WrJJu5ZaWDeZXkevE85jPY9/oz/gNGqnugtC
+aom6C/QT7j+Bf6NfEu53Fe53JfcRkHhT3CD2ouhbHCQu3sYLHwlv44WPC+gV1Ec1lTUf1YwdW0MS
+1EfsxUBKTWVAR488c/0kc7C6+nopk32m7lHqSmUNdVbAO7D+H26g6LOc+Cw28l5e+Ph5Ly98jEAn
+B9LC8QbSJNTZmkrqSt2jZ5js66XV1czB6yfP0CMDusqaQEqwzqDx3GK2CJGBptsf7qarwT0X+XP7
+jWADvzHLf9MoLNTIWYV9Sl7pVpbfoYPVVhhKW42SH2pwTQWeWfOj1O4e2G/66CULxk8ZUJGelpZe
+0XcGuRoL1pp2NqvVlddh1+CBvfsWBi9657S7XINnwsqaH4eVTxnYNzMtzT1g4JTJc+ZPFq9SgzzM
+5Raz/YT3dYsxX0RijUEenE1YC3N6xEwcMnb8giWjp/cb6E5Lcw8snxy6skt/rMEzK3BNzQ8So7VD
+flm3V0r6FBbarB1CacioSE1Ly+w7cMrk+XMmTxk4wC1elQ/7UeTgcruc3h3yXFarrV1h394DB+8K
+XpD+qv5r6eesHTlQDuqJEOjB5beK4YcsIrE2XzBklEXkxOry20OqIZP7Ypwdsu/HFoxt0xAkQvo5
+nlXzI94RYc0YMfxnfOXn4SMyrBEvvyxPINegI9cJchj2Y03dVx8PruzR02mUwYFaOCAzOnv2qIQ8
+3KcW95EbyMVgMYOBeqaCIK3oPXPE8Kqq4SNm9q6oHdRnlngxq8+gH2vKXK4ePSv9bWEkfrStv7Jn
+D1fgTfwojMzLImmX8DSL6K4KnYqgmWwkQ/cfpmQckqvPnj3lKZQVUoUyN+WWMYMzCuUUKwPEsVs5
+OVKfvcLn5JxSn3IXKqQyGZJKpJKtSIYkrLouX8qp8wsVNEUBeZFsBQoabh9Ud+9bjp6jKalMna/O
+PyzdKhOArhymt1JCvisDtT4wSqx6q1FSBStqfpQt++/sH2uo/hXkovbHmtD8RISugV7EhejtRfWS
+DaWGivTKOAoQy21l/79Lr1hRfFaXP0JH9PHf2bJlP9YElSMQLMqX0BuJPjsikcuoSKRWnz3lITQr
+S6nSyKHyoZJe8l4SbnAGKoyUUIw8EoFUxiiZrUo1Id6Yc8qjPuVxFypUkZFIpVQpt6LI25JfqIiQ
+y2URigjFVplMHnabGAUcy7FbiSCaMvt8hJyT0JQqkjAsMK3aGhnZjOnDEVvlcpmQ4rZKJNIwxbn8
+okCC6pMu+LEGVhBR/LWgQY0/1uBZgngEdYbVP3IuSlA9BCuBBNbJpv3FDq7Bs2BFzWDp9f+uHkOZ
+agbXwAqCaXDNmCb1gcD7XVZfAxU+2Q9jxvx3tfT6YJGKwX+xlGnMmJrBQQoG1wjvuJB+WKQnFPhd
+VgnbQIWIC9ZJy8eM+WvPGGnXvzeF6JFUB/ESpGO+bCArhD6cNyXBHMLJe3mbfOBfth9FRD9KL9XW
+Uqk/BuF/hIF1T4TzpRTPX3v5EGMQX1srvRQSLZWKd9OVeHeDXEM8ieVqwksmr27eZ5UY5QP/eqZB
+rr1ra//yjxkDOxtYeK/uCej00ZgxwXm5EB0CrpB0ie3A661+F8RLezdI+JnaWunbY8bQIxqE/B5d
+iV8UcTXy5EBIa/SH/3mDeG0SV/jfjOLDYf+KV4W4HjGxyX3qk6b5GoTRNBuxGyCF1VHlXKFaYhxL
+0uI96hJVzsjEe9Slxntcodol5mv6DO2myuGMeA/tbrzXPH/4M0YmPqMu3RKuhTz0YlbHHBRpJukQ
+H8K9sPIan1GXGp9Rl27+jNW1nAftbsyDdt/8Gau7fR7qUuvxtJyXXkxoFvVD0i3yccOzpvK+KVyL
+edDuRni0+8ZnN+O/JXjq0s3z3I5/Ma/Iv5iXyOR2/Nw6D3Xp9nlY3a3zNqsft83D6lqfl7rU+rys
+rjUw9GICI7ZXkm4Rzw3Pmtefm8DdNA+caarLW8Gj3bcsv9V4Ws5LL27M2yibm+O5dR7q0u3zsLrW
+50W7b5+H1bU+L3Wp9XlZXWtg6MWN+iB16vbyaylPU322Bs+t84b1T63Gh3bfHh916fZ5bye/pjA3
+1L9Wy7E1ealLrc/L6u4cBu1ufV5Wd+cw1KU7h2F1dwaLJ1Pl9VtFmwJPbrzX3F4Jf9bcXrkJ3E3z
+NO3/bg2Pdt+y/FbjuXne5v1fS8+a938t52nap90KT+vyNu1zbo2vNXlb7staQ+etYZr2f7eWH3Xp
+ZvDN+7TWye9W+Jr2Oa2T3+3puzO8rYdp3kZvWw9vm7flvqzV9fK2MC33La2up62k/+7KuRNYsT8i
+feLt5Y52365+tAbPrfOG9Y+txod2t5a+O8PbephGGbdGjq3JS11qfV5Wd+cwaHfr87K6O4ehLt05
+DKu7c9iw/rfV8qcu3Q5fkz72juXfGvxo9+3z3qKfvuP6fud83Alskz7mjttBa2CoS3cOw+ruHhbt
+vnMYVnf3sNSlu4dldXeDg15McIj2L71YvHcD3hueNR//34aeG/I0H//fRo63Kr/VeFrOSy9uzNs4
+tr29/dtyHurS7fOwutbnRbtvn4fVtT4vdan1eVlda2DoxY36aBxb3d7+vZU+W4Pn1nlvNv5vvf17
+2/p2h3TeDOaG+tdqObYmL3Wp9XlZ3Z3DoN2tz8vq7hyGunTnMKzuTmDpxY39UahvvLXcb98ftQbP
+rfO2bP+2Tq6t7C/vkM6bwbRcf1sjx9bkpS61Pi+ru3MYtLv1eVndncNQl+4chtXdCewN/W+r5X+r
+vLe2f1vdL98WP9p9+7yts39b3V/ftfxbhr1p/33H7aA1MNSlO4dhdXcPi3bfOQyru3tY6tLdw7K6
+u8dRv1WEwZNvjx9Pvn19aw2eW+Vt2v+3Dh/a3Xr67gRv62FCsm4N3tblbVpn74Te1sE0rSN3Iuc7
+of/uyrkT2Kb9f+vkT126Hb7mffmdyb81+Jv2rXcm/9bTf3fl3Dls877mTtpB62Ba7sPvpl20Drbl
+PvRu2smd8Pe/K/ducITZ/63WW+v72zvB2zqYFsYHd6yXO39f3A0fdwLbxMa94/bTGpgWxgZ33X5a
+A9uC7X7X7af1/P3vyr0bHC2MP+7wPXRn7el/067upLwWbP+7fE/dXXu7ez7vBkeLtvJdvsfurj3+
+b/R6NzhuMUb4X77n/nft9f9C763BJe6H5trdYj80u6FuWmg/NL26tvb69Vvuh+ZKG3G1sB+aXh3a
+D103rbaWYW+6HxoQQuxh9iLBJANy2j10Wkk4qASISq2gUoUTRR/VVNIHqLTARzWVdRMZ+ZnrNubS
+mev/pR+qFPDU/8MeZs8JFDWcTBIPJbnBBiiEoiZwpoJy1VTSD13/7xnm0nXbGUZeN7GyJvCReFa1
+F1rAurj/QfHkNJIjeIpHLRxjcYNREjzXohbPvdAkGoeQ0gkH6r3B8y7Bg0vUy/MnjxtalpGWllE2
+fCrZ2T6sPHQ9dNzkrpdr8NHyTi0fOlqQllpZMXE8oPETK4amEVipXbhTT+5UpqYxbX6ogWJrccvn
+nZrwYkb5zXnJ8rv80IQVTtLAiBCCO4wRcFEvzJncSDk5TwQrpg5v4OTZm5wr6lSOj9aEc1KZKpxi
+mpU2tAknNznPVGzFx2oQBQgfYv7N6ZAapaHKQk1anEUbzccYOCVy2SMdyZZ09cVDyAKDux9U9Csn
+Ps8Lswfmn8rJyTnluXg4kovnqMEXT3lycvicnAxUqIp07XUw0bGvOmRa/tl09R+nPK//ccqjvpLj
+FqLPO318pl8FcaCWGITQJ3peZ8gDvbV5YBQvtbX7QY/7YNenn6Y6+h7o3/8BH/7nn93j1xQUrBm/
++x/Q/LJpgqTzNub0zg6ScZuu4p8Liug/qgasue5eXV4VFioFoWY8DijUaGxpsSYqxmBhkTzVmeZo
+k66+2P2gnLDXpv7V7IGEnVMXD0cKXqzzLwrcBtmjTHtjlE77qzHS1Obsqf1OnhzWNeh5naQAfGD1
+GEhEl8YQLzYr4c7HEz7pjk8/3fWg23Owe+BlkDQyhv8RmQUNqIoKQHN10zhJh53X3ds6SyZsYn8N
+C8ZSVb6aOb1mAEIMOo897AX2H8HfjIyc6GB5GeUDVgY0D3o2o66WQdcwS13fQ5fV1day1CmqCr8f
+OAVfPgVfSsADnrotTDWOfwrR6DwyS1zMd8IZUDlCDuJcR8AmcdXWYnndLkZKUJmZk3W51Ot4e2Ar
+xT9F8QjdCEt6CBlFSDhfCz3oIXW7agXA69lAwXAYXpfLnAz88pTYN5yvPyFxsR0Qi6QIGXniisEP
+/HnaRbvqzj8KXxWwCwIHqdJrC6iSKXgX9eZNYFyNMNgcDgNDAkUIQRhMECKYPSwvCs8nRcihlwFP
+/HdIXHXn64T8gem7vqBKAodEoEs4tjmM1ifA+ELo685TDzDDvwgcokquLaBKAwcvwTcCTOOZWySj
+4Rr8gRVYETpfS3AixF5gGaQVT9UKhwH1wjFA8vFRij3QYw948Xt78OE9+D3qEyo1cIZ8asUfKrUW
+oTA8MSKehj/Bo3LDnw/27Dmy58ieDz4QfxrRNUEZQotolIvHSd5kryMaxSG/6P/n5p5N7Ram8VQp
+Y7GHBa2uBjc+Ddn41QdWTJ0+der0qSsegMKGNFbCGihbs6ZzcXHnNWtwLZ6Ka0NX1A/4IfpkXu7U
+afjK44/hK9Om5uaRK9A99jjopk3N/WPd6jX7a99+Y3/tmtXr1q1eU7v/jbdr969Z3YR+E/IS3293
+Qzsb0ghxZbTjzjlYQM2nVgem7QlMo1azHe6ckQXdA9NqCXAt9X/Gk5EEwAaJDFwyuCueBsN78H4N
+9sJ7s7AXe2rgPey9K+Z+IDhqsAd7ZxEcNfA+vFdfj2bgnnIHp1K7FEe4SQipnYojCJEryoGQWqI4
+wnQTz4ThnvQRToXiEGKJqxet1WfVFoDHYBSOzNNWvZVWQYLTBTI+r5PUgn+GaJP0sU0LdOvV+Z2k
+UaDB31mF60cXPkNtqaUUTy67d23kwmewsjbQk6RRk3IcYeX4byxL0qw8A/4b1Ar8DdgjxULJTRVI
+8VUFxOKLxlDJ8H7t1T1QGCr7/J6rtdgklt6ERxAKcuhZsRC/QArrc4hk0AkEfTR0xP8T31hcNH4F
+uonXzH8XPhP4/siOY4EJwaIo05Edx6iHg4xSDWUlIW+oNMKei87yGzlJeKmhuiQWDdqgR1+RBtXm
+rlMSSw0WfUFWURjnkYO7TpzUd4Z4B4OhbUqX7g5CFP64zfL2Xaw4meMn22X4lSB1kBHVddKjW6eV
+aSH/ieX3rq3rBB/zad1GV3exi3JhUjkV8oQozfJntQWJy+81SlxCpW9Or5eYaFrR355IaHJhetng
+Li9nvdSlcmL/Cbx0xyMCqUUy9aCCWaNKvSkp0h2PdM3SEyLratTp/aeaB3d5+eUug81bx5fxeGeI
+0J6mgllbppZ4I+jNTy4rzlwbLksaWRECr95GE+9ZTWoMb20kEQ6dOaPmYRZ+Uifd9cg83Xo+p1im
+ghy8Uynd++B95Loz9RQOXIaplBsO7F5y3wOkrvxZ9xKuf3o+uWhSZrJYppaThJcYtFrDBUMM1kwn
+KX1Em3hDqOjOUtWIDhMnlk2LbCTGkJg1adl66ikM8ygW/rV7ydzVhIKPo7rPxR9N6q/FrwSJgiPq
+hz+dH5QBM1mgJ02kB8QyjQZSqC2hCXHE9aaanP8XyaPWnjmD7yVaiknNnvzmxpVTzvWN4nY9EqpM
+eUV737/46ICuotaoAzjwPwufobwFsVkPfrh2JzWY1BhC37vaH/FHF6z4caGW02EyikAZIlWGrEwn
+cWBOh6qKuqFmtyA3ePrMmXfXr/x43dpdN9RqsYWHREfuUAfxves+XPvgB+twarBKg7+hSjcToNgQ
+6bA2byV9jv8W9cYfA7SXt9p47ybIUzfqLxKi8Osq6d6188Vr5RtR+/Eb+x5+lMLQNVR7Tgco/PnT
+8+esIVqTUdOxAS5jg9Dn1b+CezITOBVKDbUvq8eg42w+4oYlvHxtw5C4SdMygapq1bqhw6TSYfgn
+a0g4RTILvvjiMPwG5A17EZJipI9tCjavwC9r33xgOTNyOBfoGuqdth8bBrldIHfYMSop2K4a+0Nb
+iC6/UWKUuMIpkrj8Lr8xvFfUbaupq6t51tSoJONj9+K6mkPGhp4Rl2+bXVdXczDwR6hVf7WD3HgO
+Pg32jxDWPwbfA6Tb42185g3VRbjrCn8ZpLa3ZM5+dLaqkQRlRUHZ7LmpobcA/ZTaU7oVn4SuH7zE
+Q3LobfBdVKd7PsbPQ/bvnrpuAiWNdLga+j4TeHk127zH4728rokckh6ajX/hG0lQV7Yrmz0vuUEI
+daudIIFs/Dx+wIV3hQTRw1h8z8fQFZ/8M4NeIgpD7Gte5f5BRsHqFP0pi+yL/nQFKog3Wvhauqt7
+j2C1bC/d1aN7ME2Z635hvgrWxWsHgwnJ6B07CP5xgeECj7fG77Pqb4ofv03zN+IPnN6xg8wRmVA7
+divzhpqE40f19eg8nGEvMGPVTsVbxMZQllI6sJP76LGw+y5lKfoeDyDP6UKwC+MUhr3AkNGVHCFW
+Dz4ZpQcfsBeEQdnf4oiq+Ah8eIQaiR/EDx+BD5+CD0PjojBYI+iB9wEBPs9SBOoaZqTF8OERnM5c
+OYLTn8LpR2CCQGs9w15iniLUMOn1L6o5fikK3b/APKV2KksxubsMocb7rIvkh0j4sml+8T4dJ9xf
+1nD/EqcR8VOnmubnlOR+PabeCpZAo/P4V/YiW4JiUS6J9OLQN7oma4hsYzSDMDuibfFhaO5EKxME
+wF4ce2j4xIfkHKfeMqR8//Dqw8MmrpcrVFuHlO2pbukRIxWeja4rpF8loit9rqRTHkNTXNse/UiS
+Zbi23eHRQ6VFuQxDcfk9+hwqKcplKIbL70YPwT/hnw/B5FqYhP6P+QHf/4qf6yNJLaBfvTN2DuIH
+a/G6Q6AR65iUvcDsCfrqFb1jCI5HxHpK799YAb8HDi3ZWIGVVAl1OCRB2FW6p/bv0j21dEmYeG6B
+L8hqM4SBQyIHYejCyCP48FBB1lmoLUIgClMiTKMJ6XRi0oUJNpgmoxhDPPiNkua1pqhv5gMDRKlO
+eFha+cTAwnRRqp36eleVkWTFhJ4PTZYMemJgYUZYjYGNh0qLjIsyu/cnAqZ/LPxKLcjauDCTSDch
+SapKoX8s/DryhvrSyINP8GLUWh5IuoEF8N0JC6SOhDgI1ZHbMFCUG6I+XP4MOh9YzF7g+gmzOrzg
+x4TQoWcF79ypJCkjSbE/q1tFzxB7JnpG3apQL8VS9PWDkF4L7kMfBSprrw8599EhfLoWf0jPq225
+DK2AGHifVc8Hi/NZ9eebIq1bhacR1CFs+Mva2jppqCD4p7ZWaKv1lCD7aGRGjpD00yG8NcZTgoSt
++mCdZy+Ofe6eiQ+pw1ucyiC0t1FC0dCkUuRKKS6/e18iQk6e3w2Pr2W3hteAO6SB9kFriIAwrbZE
+QaMWSX+lYS9wChSNrCjxVuVrg8IWxc9eGENI4LnIRwkJ5OLhSMOjQ8r2jg7JP6QR6pVDvTrlSUgH
+1PdQSac8Tta2O3QL6eJabkhHof6zlfSEKphI1s3puT6yedVomaAQGdePh0gT6x9OYS+wn9xV/QNH
+eP1jEkOI/zlfW9si7rtpP+AIaz/0vAY2hgTbD85iL7A/31CGDHwyCLEC+puXQW9owF8G6Qfxh4Gn
+Grg6fRDSD6KWy9E2IucbioQb5CQWSG9owHmB4Ay4GgrtLhQatHkWsxdYp2jzEGwi4qDBFDSaJhNT
+58PrOtFYOoIfRjfCaoloKQJ6XjSVrv8t/E4+gtOPBB6FSTBBNJpCNtBi9hLrFG0a/LOa47c33L8g
+3q/H+Bs1xz8m1OHP6o+wF9k5QlkqUlqoqhBX6vrPgqwX4ldYivmwtvb6hoMwqRYmH8I/Uzm1tagl
+HCC8dRtqxMWgll6tK2wUJvNh7bWfQA+aQ3hdLX6QxrVC//Iqe4F9T/ANrRI5hwaVNFqLhB563yGY
+fChQQr7rHmmgKdgum+IRpA8+Gd1U/o0k0fsI8DqsEH4C/RvpCuoD8exFNhHFCfGKSJQViQokos9f
+Ic6VvwCICz+D0U0HtXyxek/ZkK0qhXz9xGGHq4fvLx+yRa2QPzRx+KGxEOTgnX49CjiGyS8uOdSQ
+quvUyAop193acmXU7Yq9FqBfq2snsNtiyVXhXJM2som9yJ4VZEjiyiIjGYP4rHptS3QEfRg2CiCo
+LGiBHo5rIoeC2lr8cW0te7GsWz7HcLlFJYf79cjnKIbJLSqpK2qUx93TJKNaSdK1QKhK3IaqcFmR
+NaNR7AXmfUQjC9GU1qq3QtDvoc8a5m7Zqhd9IJLXo0ANtbBjWrY5hsFVIFeYswYMfH9gmT9WBXI8
+kokxZ6cG8oRcFbXUHMacXFRUuSfHO8jj9XoGufP3DOrUMcVc92NtqM/4gL3ADkA0qfF+QgFiGdAj
+h88e4hzfj+1wEebj++vuoxfB/Nm1zLP428GD8befQM3ip+bCgiZ41GREYPXxPsS69Qy4fchhJ60H
+5tOL6u7D98N8uIjts2trGckneMHcpxbj5Z9AzODBIVvxEwFPBDI0s58b6brBKG9OIUwJWtOMpBmh
+tynDh1g9A4TiG+z0puSHldCcCdKPyNgLbHehvmkQ8gdrG49Yhm0q2fcCn1JWyMWP1w2in4TB3mDt
+GYe/Te391ycwvfypMph5K5whiu0Oe0M/B4PpJ+sG4cchl7IGPm3A+QleWfZUOX7gk796p4bkMEXA
+GS/M2LitPqvbH/w2uiVuq97a8O1yQyP1lBM/B58NC7xJnVyxsg2U4oShVNtA1sqV9OfNObpcO632
+iWkZtdNqn5zOrm3O1h3T0MjtTWnAF5vy35SCZkIgtths9gK7CsWhXBLVib1xuEqGUqHh980HswWg
+bRRQ0FKTS0Km2qHhw3ZGsOSqbO/oZs+IGSdnJZG12w+Obi6+xCYD2xwF156lKDK23XKoV+iBYN8x
+FM11VHSirzQV8f8lf2HCv1v+ro9sqps7Z6+Z/ih0PvAf9iK7CmWh9iFL+s7Hx2Gau5sxcnOtgb3V
+Y+Xvmurr/4afcE3dBT/NtXQn7LTQvnayF5gDIZuKbOniQS+jm8zjsVRoRPkvMmabDH8IP9S+ZuPI
+prgcosngC84KNrXQ/nUIrztEHSLf9JjwIT2i0P/Uv80+xjyHOMEDqJH88VqXjHJZ6RODA7bj1KXK
+ka8FbPgwdekE9QFTUincGww1gY0nAjboQV16jeABS/3bnLwBj5X3g18GvFUio6gAXl5JXToesOEF
+1AcnqEv4cMDGlODlIvrAb9QHwQIEelAm+xhrDuKRkD8r65dRfp7NbaFsih8soK4c+Rp1CXoEbCcC
+GSI9KJOTh/A4XOACF08bZZTRSgVuBNksEjgYL6fGBil8TZSPHy3lSpl5SIa0CDkIS2S3iJXMVgvW
+PVBezEAdZSURowL/ppYEjPi/IKd+BPlSqIM6/AvwwRBSfy5ahP+L/7toEcjFtWKCu50Yd0rEbBWR
+MYrrfwSBcRICGIIQW8ZJg1GkeCsPQ0JPGUUoUhXJV/8eW8aea4i/ZAUYQkJXEQrgbYIVM6TcRp0j
+EFTNPtaoYvgmTK8QplckqhMsDUqEb8JUhyBMd8ghqIw6HcKV2UQ9CML0g0BQC1gas9B0mBIgTAdh
+Gmgq92ayFssQ5MFJCRwQF/YkRqaV6RTKhH8JAr0tYOpIMIlrN/X/cH2oT4g3epY4Ehd2dPiNzEnB
+MXhdOjPnco2EuPqG2Fqce1nwwTuDSuX6sBEERkbcwRMQiQuYkyTf9SX0hz/UwHI2As+suYxzayH2
+cg2sJHBd6v/hHMGyhKL0pCiAj0hOZk5dOnGqLhb2Zi3+hrhJF2jsQqVyDrE88AoF6oUCP6qA5TU/
+0B9eX3K5JljcN7Xw5uUaRKFj9Qx3jrWLfvaNvJV3uK1u3m11H+Nirg1g/dferuMs/3wxldou1v0b
+8kOL+Wk1tb1JfiHeGWsFmoCkkHxTuZiVbP61PiQ7aiEvbyV5uRHNc1KIq2c4K2tHrLCfzQ1hf7SW
+mhlYWfc7raRVdb9VUTVUTWA5ra67WncVoRtgtW5w8w2w26kZIUBKF1jOXG2EbAGO8N0AR828dl8j
+JFVzUzhrGK3bmZ8aSaV04ZQqGmTBCrv2yGxKDIpHNtQGpaIM5CeWis3n9Wn1NuEDepvPFvoVUuKV
+V29ryKPVk3vcua+nTJ06dSp+8tTld5ieU6dcPnX5HfzE1G/IzW+mTjV8N30qHDl1Cg6/806dffr0
+6dPZ/d9NJb/Tr705dfp3oWdwevrU78Q+y17/DneasyEGIa2MstrpMroMH7v+BX2EmhB4uE5H5NCY
+R4aQFqwy1loLWvpe0AbcdbUNEKvqnqV7UQocH4IksBvri7lRbB1ihZZEkRhypLkDSKmZRN2BlVOp
+H6gfAlHgwxtgHPUpjMMb6r6jK+Fp3LfuCdwXQQMOCVkPd8uAd8NGEej3JvkS6l/gzrMrSD4W3GAF
+N8Bp3AcO0IPgAO7TWYQJRBG+J2MkXcwlEqoMarBbWKQGxuKYTLFAUwzQFBu4jgOBOhwoZPZA0saN
++OPr5fjjjRsRoJ71O7jdXE8iMxnNM7kkGmSdjutRV1tXC8WMhfDdmIdE3ABexvKMqu4QXUIpsEUE
+YN/EP9ctxVeo03RZIygCMsPFjGXPCbNwwrZA8iduRvN5fTaf9+13YBB+knyoT+qGHj9+/Dh7DkvW
+rVuH59VSKevWrUMhPFQSpxP2GAY3BIrw4gZBmP3ss0eff/75548++yz10rrAWQGUyg6cFOHrPwnS
+YRPoEMxof5bfK9htAjmCKS3hJDbBXnv7HQp4pVOjoe6jRg3SaQltHNt4x2RgzwVSAGQOe5+l6xmc
+XktTDC1ecURmhO/V7DmkJjuetCTmDdnMkwwi4Ua9GMM3GfRW/dtw8vqC++9fcB2/c/z4O3j1li2r
+MQxiz02ZeuHSlCmBPfQH69Z9+NCypQ+dXifWw7fxKLYfew7FIDt6qzCaV0VGsBxtkiG72cDKrSbG
+1C5WHqtjHOq6K56614n/fD4nJ0d9Stgg7JRJJbSc40n0Gp5h6A66CLlqA3+Q4RgURzNxG2T2Dfr7
+mfQ/rpy9oskhf+o/SPwAd/e+5RI1+x/y4Y05AxMOFToBBhd2uDcGWMQCS3G0hNEjPegoA21kHMgB
+DspJuzinxCl1yizOLMiiiqGYqmZnMDPYWTHLueWSTdwmSfxgNBjMYNTa6DRIBj64IdlpAqsQgR94
+UVo2enX7OQXvnT/ebVXNxYlL2/X8+j04CahuUWAFfmjDhoeolwzr5uFqmL9xeODXExtr/wAVO+Hj
+T1cfo3oFfl62aNESxKAu9buYrcxLyIYSkVvYDcrbfKL17hJin+WBv8moitjqkeD1ZUrIrp+sthDc
+JcakHm330vJllaOHTcwLXG+zPULZ75FhR/qNfKXi+5r1Q/fkvOjLfga/VDBi8wuBN74d4S2j5gfu
+rx11T/59JUvK5rz86nGFolNaWq3LNfW5Xu9OS26viTqGa9LOH26DvwK9wuydYxHr7Q/1HWgN9zCK
+JZR6tV6abMHSByXkcrpom5ZUKB5mZbxa++qOD2u7T3v55XO9lfJRtR8vW8Zuxycob+C9tThy0oqS
+LaUKNf6YmkrFrYWC+nr0Nj7OMew5Ncf4hTZ2DH/LxnI6ZELIauPFKP6C3/1gABM98dvPVB2dO3fD
+/hde6DCwYsZrI0YcPUptf2z7K08ElnG6wPaRHs+smh8Cf+IeCAh+lrQ5MgYg50xsohd/sALz2t43
+OgW877xDbQcmEFOL9dCe+iCQwZ4LrKBmBbqgED3MVk4nzBV7eRJJJ4Tl2AuU46fAATj12NHswFsv
+cLq6MfB14A/cCfeAGMoWEG2+Snyc/YE9R/pFrVUvBibxgXUas6GulFn39vW29MG60ey5LdcnkfK3
+MA8iQOX4W+ZhZlhzmCn02EAPevzRuvuow4GRzLA9dRdwH4jZQzsQhabVf83OYn9FahSLUgujojcg
+lW4DK31A9SA8QxsZFEvxpgh1nLruytkrHk8GSr/yzR+vX1G//rMbhFBCKrBZEK9GVo8BSHRvjRDX
+mWKee/31nleexL/h/dAPVE9eqfv35VPv/fTTe6cuM//54Vry94sXwD6ohErYtyBQg7/GL0IniIM4
+6IRfFPh/G4/i4tlzSEd4ITammhNPL/h4IFJ8G1LhPpgPqe993bNo6UQ8/108/3X2XJ2U/vvaw/i3
+P2o3nmCer0MMuval0O+oEWKr2XNi7+YAK9jAq7VqvdRLoAlcgCXfBt76aDm1c/m5t6jv6/qw58gk
+HAqTTySKIqNBEnKpALwWpEbWBCcSeFVrLOAX2AUV9MP78W9PXqGtAruw5fsf2HPfL16A+we64P4L
+YByVS1gMvCmwiYCsuLNfMHMIn0AO+3hlQCaG48DmyyQhdhg7fv4arvwXDAFdv88XvXCo99Q2enpC
+3cPMsLp+1HdfHl86dWpuvyQ9wVWEkIxjr6K4YEwmARtBKDYBEafwohiF38b4DXwC45WnIBdyvoZc
+kGjyy1Ys79+/tndaUmIs8+X1eFZ97Sp77rqG+fm6hnF2LqysHDRx0eys0sRYkfYyhCTPMnOE3UHi
+fHYD2RLaFkHKjwGvli025gxaLJIexX17/tffzuMLR7/79vx39PKytsOCHJh0dQ/TG+vGMsPqNtJj
+6zYiClUjxMxnnkNaUoJW7cjyevS6SNCCF2y0xJfVDrJ8TluCXncS+uk0e47O3wdD/oUrr324JyP/
+yU8h9Rm6J3gy6L83Dy7E6fT+6zsoT//XdbGlEzMzdzhIHUOImcKeI3aK0Fi8vJWZEkh45x3qc+qt
+QA57LrBV4PM1hOjTzLDwfPTpwN6jR6kBFBOoY4Zhr1DHJtd/z3zIVCO5sCssQc97SHw5l9PlMRp4
+tYSzSRJcTp7sbNQHN+t6eTXdtv3Qof3LirNWLVix4v53nz+5ZPnykbs75JaV9ZxMv/tgoE/14H+f
+njxo2+LV+Dz+decm2LwVtGB+aELs48aSaa+8M2009QykCXSi+m+pdZwOGRGy+jhrQjoYGg7E+MRO
+ED6Ziv9xZEN++dbdPcd8j5/v1efA2/KXbe++Qo3ffM/WYcPPXl8ozKlMqf+amcHMQRIUiUh3LuzO
+9GRJgVR9ixSEAPTGYM0yZvlow5BlgwcvHxwYBDx8CRr8M47Hv9Dt9+Pz+3UGf+WS5QO9Rg3cM3TI
+sspKqhqy8cnAefwW5MBnP37/9rCenbMHD/V06DqI8DEcIdor9mMgDPjED+0NpFNGEikt8F9m2PUd
+D0PNekhdL6wt1v9Yz6Of6dEaCTUdoUCVaHdRW+lq9gsxxjhpCgBT8OYlkATJi/FmaitdFCikXq17
+ieRdhtXMSfaqENVdT5ZsBP3EQ2j7vtEMwmsrjRIW0V1Ol7CSQ17t6UKsTbvXY2C6TDs19Mnn8rP2
+3fvd6PEPTXt72dyHi6PaJZSMnpP/7wyVxzVrwZwrU59+admWOV+9A8Y/x3zGPvGGP2vBzBHeNJsp
+fcz4I+cnD9TMmti1Vu3qlf1+qtwqiUo/3al4+byJc+OjUl/d9+Y3TuHdoGZJ3xgl2pMJaZTLQVRM
+ljT1YIgHMzDPjX/YNXDmA53wmZIp95F3BbOhrv/UGXXLH8PHBOy/t9vCPFi75fok5dD3JnYV5NW7
+fh4znRkmxNq1+bNsCSzNeT1ZXg8tIZfE0ogBr8Tu9UiY7Fe1prHr8MedYqYtuFjxfLLmuEabOfDP
+ja8mTT8eBfc+9JGJevr+/fsxc2AL/mrhwsWwCDLo9GeffRYBisVmZiozlNQr4AwSjrFZ7H4vZ/CS
+iWKnn7El+LVemvMaHFovfS2yOjoG//ERrseXDMciHj4/7lWTdqwUz1p/xrTppcVRU7eBespxSK35
+cPuynfs2Fs2FLjOHnel98Bl6XoA9QB2krgu8lWIz04UZKuxZ5Qx6HWNLsLt8Xn1IiX5tFtnYaNOK
+/NF74o/jVz46iD9VvCh5bP2G59+yV5iqH6zLeaXqZSP0WXs2CjavPnLw5Xcf2z+wdNDs+xct/uEo
+jDpwIPA1XXrw4EHR1i2u/4lZz81AMmHvdZaEkdCc19JOiLFmiYEsh5aItB14JRyx6L0e0n06I0Hr
+t9N0bcw/+KyrU8x2WGU5EXXhwn68NSfHAkuYiiVpT1Dy1+Xyrh3e+fCenTOo2U9IqsGeSfc+HGV5
+Bye+9IliR9UIWAPzv6yAkTr9vOWl7TN9nWvaOrQuvF4WgxcReYzBZmYEMxTxRNe0VlB2A/NM5od9
+qk1jluEPi1+fcdwE89Z+FDUDm6lR1J4DBwKL6IMHDx6sOxeUaxxXxwxFsS3gEXDZxHuM5t3+403V
+D+Gver8y/VUd1Kx+xwjjHz5n3P7aCtOkB/GVcTiOGk3w151lhh4USuhNpxw4ECgXZInqf2Ge45ah
+CGG9WaybfjIMMnIsqTAxxAz2efWGPPB7aaHkt01j1uHzvfRDz84Zpzvuwu/hv5efiRpbfeQg/6Zs
+7IDIg/fCnHVnTHTygQOB95dCdod59F8d2j9DfQxF3/0YGJeFBz77rFB2F4QkPZihSIYSGsr2GhwQ
+zp8tWG0J0/Te2JFr8Yt9oqeuhjxwwZg1Z6MefWVZVPU6fGXhUTOes+8z+7rjMbB57cd0yoFDWE6q
+6X0hhuvOnjz5DH3w8GFBvmsQYmYxQ1EksWubqemV48tN1Q/ji75XhrxqhPtXfGoh8PhBatfBg68i
+Sqjzq7hlSIaiG+n2OMhYRSCW9mpFRDvjJ63Db5VETXkGDLUT3huMV+4/E7Wu65nMOHho9ZkoQQ1/
+Hji+LbCCevDVZ+ivKS7wy1ukllOoFCHJAGZYU9l4HNqgPLJsXHh9yJJwI02TFuLDJdETlkPXda/F
+4tkvPh81yDRqNj469Pjy10ywbs1JI2w8eMJMpxzYFvjzwAH8/vHjz1D3HaD2izXjuefgWbF9ldb/
+wvxL4K8NQiC0LlIjQDgPbPdrsxw0GQfYEtqCV68TejKj1qundwV+0XWKOzJtdo0S4kdEvfriR/j5
+uDIT9YFT123wuXvv/5e/76Gp+20Z5fSF1EU45s+8thOoJwJ5OwoLOe46NfipcQBT79+wa1a7ua/i
+M0tFW6gUIWYOtwzFhOvJpbZajHqbYKRoBfbpI68+HDVmHf5s2vHNCbADllAvUU9jCw/z1lywiNpn
+ss/A3J1z7mn/OH5h+kF4tgG/xMQMJZp0NHSQHkfjjyBwOgU/tPWjqKdeeTh66jaQLHt1pb5yG0SP
+efXh10x4wcpXnnnmGfpg3ZkDB+hU8fv6zmeeaaB/qljPmuG3BZFueXUFQcpPe3XriTA813cK8DXY
+zNm4Zcgs9AOi8hvx+IPYaK+W5qiPekTil9e9E9sBX5m/11D5fol5uufjV3orO8wH45h7T1XPx2Yq
+qmTxa3Bs+SPwCT62dlrg6yGzqYvUgYCrbk2jPEh55ExWY0Vu1hhJobRvQr/3ChUwdfW5qK1iI/xu
+2qtGvHXrR1Gb/rWSsOShEdU/sHN2YwsM8deo28HcMtJXihyESdz/n54X/ZOjq7eBZMg7y98R8TL3
+UM7AJ3VnQxJ+RqyvmdjMzBLsyNhQW7GT/osjEzpGzmqhxNdrFk0vjxm7Fp8fCXXGl6yBopgZm9a8
+P+IE/gJfe9Kz8mU91Dz4carQb+V03x5zpfjRN48tOQLFkEm/9eyzgN8V33eS/w977wEfVdEFis+Z
+WzY9d7ObbDrZbLIbSEJCNoUSIEiR3kvogYTeQYqAdOlVCAIiIKJC6EUMSJdmAKUFFVGBUBWRD1GR
+7J38fzP37mbTgM+v+d7/iUn2zp09c9qcOXNm5oxOaIpCVHmWozMWq47TmYTvyZS5p/0Y3huOlNSb
+/hFn9OOGnN++vbTexDwgd20D5BnKnqZWJFTTitlHHTurkqwxK1YAWPcXDao9sGh0VDWMDi+C2kru
+Xa9ha8jdVwOHbwc3zjfgjc5ke2P/BdnAZX8SSK4sf821wxdpgUQm/7gcQ12LdpeP+EPrrz6L5YYM
+xbCDaLZug2fbYTsRt8F28uX27bG1SAycPbEdqnFVd+7cuZOcY3f5kVCxoTgLGRCK1Jk4naAx6ayc
+opGRKVbOpBO4If2+aJNMuoQcn3CqFXz4SodjDa2wLvjMhLOtSbdh8nbcFmZM+JkbJj+dCZ/idvIE
+snncEdtaHDxJ1ZHXlP5TclzQWWE5TFiY7//O57P8eywm34w4uuYwt71Y1/iedv9kJJ/BdrY49Rud
+ldOZOC+y/Z2L/ssOTQ0c+e6vsz9fPf1qsUCq2tLkScjh4/TneyK/Mn0YI6MpyZoQwVUiq1de9F9x
+TOnKA46MrEWqkm87NLkLnT/4oFjGS0gn8tW8H1SYg8VZLHZJUSnV12DiwMyrzd5uery2N0xYkO8/
+7+Br/v0XczFyoPw19uWr42B59zyVVoZjAgnmB/MZNE4DVOlNIlJHTXdQWSb64a5jz6Sfv1pIbka8
+dTAIou5cjp52aFLA+6PIH31C3yPvbh2/C4P1dz5o5w5yTg7Ed7ZtkwO+uKqMCQ/5E+IspEG+9jEh
+LAVMHBbCgsAnkqNhqOWyzb9+pXNQPQAWDciKkb8lm6rUseBjAy82G849snxMEq7swp/I3cidWEiH
+/RBUH1eWv3HwWOPBZ9AebFTQpUwp6+/gJ6OCei4h1zO6XW0w+4geRi/+3A8GLL/g986xOUEjFpOf
+XmPS/02W4SafQfV0p10hWDupJJgfwWcwH1kFmWwI00qRIj12E6Y1G8M1bFw1JHN8+JHKvv0Wk2vd
+f3wbdst77v8U+t1tWKchX+8+ceLdkyEQ88P5KtgELtt2ym+PArKA9MDQ5tB40P8wlI/auQuoyUCA
+EAnhe/E92T5JPgisXBhVISxgIz4tV89YdmgeFoztj/SIkGukwF0SCrg+V2SLfIR/sl0iRSFkHgLU
+iITyGfwcNg74GSJ0vCksDqwGP2tYihWDn0ETYRF1Jp9IswXaRoyFrUDOPCb3JPFrF/E96LXkPV/5
+tys7apGifWfCj2HQvxODp2/++hpczPq57ZA2y+SR5NC21Dnjdxwiy+Grw2eeOsYCxPdUxl5q6YKg
+pKOk9AJx3DF/snxtXkwTw6Dp5HrfzxYe9oOwW+cDuvj3GwD1exzevh0S4M9t22xX+B47d+6UZ9C+
+oNgO/jXFdiiAwQQ6HyOdemm4iBQ/DWfiZgcNmEhOdsf4d1JtdxUYvu3HJUEJMG7VmYAeR7iYbTny
+ZHkY7ssfgfpz95Fx7wPeLl93jLsCZnM5bAyjXKcaq0vmU5KsviKdFWi4ohh5C7lQxXTGHHgCqge1
+8pMnwnk8882Z/4iABPIFJIAL+WPrPpyw6tjdyxvG1Eq1OezBEKXvRuqUQVmB76fYvYKh11phsvid
+czFzD803zOoPVUa1vFRrhrwKRw5m5tN2cSvezhlxknzODu8i35PBM6VQYy46Wxm+4UGXy0POND2R
+dth/8bGZ+kWTYeSwzxr1bCgfwr5Walz2LFrJveLw3cTb4mykQZHF/VQw6ZI1Asf4kBypxUaeeU+a
+cIM2MozjsmUS+Ir/Zng1mDMcHBXch6ztW1neR65UaRKMj2NYAJ0gDzYdwdADrFW4b6IPkvhdu3bj
+g3muvnJTyiYccLQGtAWMD7Wo2xWWk/0Wch1hFuv4lKNrvgYUkeYW0NDVx5vz8W3oz6KeD6qhNBe0
+z0Pc5zPfX6IrC/FAowN+ysTRksArs8mkxAi8fOUnn6ykP39CDLn855/kMsTgfm+MGvUG/dlw6ejR
+S/QHcTSuKH4kPEI6VAlFo4Q0z1jJqA9wWxLmG4CkGLr48eRBAm3ayzc7bGtgtrc5W1jiPT9GuvXk
+gfTgyYN4XaJPcoQ1gffz8dVjkSJgxpHG4kw+kUazhW09FTXiqEmXwbx+PZgvT5p0mVxdv55cvTxJ
+6Fw4NWhuyytjxw76unto4Xx+UqDjCf/4LoReGD/+Arn57hpyk36C0CZ5S9plxPe61fTPz99qmxHf
+63YTJDjR4Y9CUDiyoCZpvoHh2f4REaGmylJoQIDbEpM+AElRdpq0PjXiUh8kVENpPoH67HDT1iBD
+trcxkpEXRclLkB4kPEl9kFAuiSl+yKCtDNpkpDMjC/0konLJ+zYQ3D/GG4j5Y3APDCS/fSx3g6sf
+k9/KJ+1zcseIebnQCIHw2ucQaJRtmDeSO0xX0xASkoR85IY8UWaaJ4c8smGq6MoJyNVLunb88vFq
+KC71yYMaNeLTfD21SESiVvAUPLScC+eidXVzfYWtPsHPzj9pendXD0/BzdUDiRokunA85+IpeHt7
+e3cOpxvd1QBuChjH8NkkCuNHZAwZ/Qjj16G93EmIzlklT5YbYYN8H+9/H75geNZHSKheEk+NMBUj
+DSc48LyV8O/DM5Jt1mGBNQ0YBa/CQXBWJo9gHsx9JJNxZDPetGpVDh6B98v3sUFu9D5JYP5iGkJ8
+PovFC8gbDUzTenhDtudUNw2X7ar11rh5SNK11MupZ+lqQ40aKmOR1pvzBq0H78FrNaDhtG7IrXyE
+MWjckAgab07gvHkPdw/eDSkIs90qkOLYZMR9I3eCdLIHbyIbx8KFwkFwgcTx2UI06SRHwabl8tGc
+dbg5rpuzjuG9HCGOrslwSIPckSlNy2mQC7/EHYKRa7CLEMx7SNds12zXHlRDcU9SU88mxLMD9+xH
+a+KCz56Vx589Cw3y8oT8InrwoehZNP2jrk1IIlcNlXhGNdgzjVPPY89R7Hk6QnxD+gytEUICWl5U
+wF8XHjH7ZUJxyIoap0lJDatxQT6+sfGuRh9vS8NEyZb6IOH4ZfqLmhU/6774+Coeil1bkhi6r4r/
+voj5icy+sV+0mz6IB2Xxktk7A7NxVmbvuArK6fZx/OnKfftW0h9m//5UbGE5ZXIKt86WwY+qkZhY
+vXpiYo0NC6dPW7hw2vSFttwyRVw3Jc/OtwiJbwj5SIdqpnkKrh5csMYTByPJRy9dO5vwIOHag4Sz
+1LZIGp9s5JmNtyJ+idYl292b2pxbCdR4JsTr2C4udS1fDS9/i91hHnYnh6Ge/BsZLf8G9chhugSA
+s3IKJ+fQBYEcfirrZ90QEtoK+cgFuaP2aZJ7Np6qQW6YF5F7MOY9pCcPEi4f19ZQrEJ8WoCgRbyL
+xsVVi3ik0dLb213ctDziX1FXpVNT6W+kkfifNZLLz53DjSYtswAggBHMefwWEopjfiGtySh8Rw4U
+8lfJY+RW2HCJu+vQy0kOvYxN07lnu2zXcNloK5+tAeQquFDNTD2bcO3snrqedQF3pz3L9kCyPXBo
+KN2IgKcQQvwIIYRrc440OSfknyuPXiROdc8W3EUeNG7BYgl6HzxJjU8LwFoN54JcXLUaToO0gAG7
+uGs5DVchvdSG+Bq1VojUGvnMX+TLcLOwfR4soPQK+XIrecwqIV++f8kWwOgtOXampGnDsoOC9OZY
+yRzgtkSvjp9PHigqXA2leQQas72DwooH0ASpotFFSSGnFTVeANrklDrA1m/M5Y4w+VW7f1077eOp
+LbvhHPqbPpY7uqx5Y8StXkEe/G7j7HY34LvCFsY5bW+8MXH47V5BKj3Z4kdiXRSATCgWDU0L9avq
+a0CBbi6ewSEWs0YvBka4xkkRAZ5LAgOQVFUh7rjWx1DDp0YNLf2heyNCNa5CJb+q9Ct8Jb2Pm6FS
+ZV+3ytk+lbKFJT7zqyqOQ9xxWt+nBvsu/WhnA9srYOcE209AmUFTG3hhIdFCV5LqYIUNBcfXTXt3
+6BTGiFvH101dPXRy/hL846KPxl5Z3Y2fu0T2Xcw+qrwYc3zAsYHke4UdY08MOjoQjGvubONW9Mj4
+4MbE29tt/egHhHiqx7DMocfeqGqaQZPNc9nIJdvdIxtt9/Z0FcDDhUd0fKD2lS1I33pw7YH04NqD
+eJ3V1+Tr9LOcqP8J+ed+OHfj3I1zCGikkL/B1vxqprlqtoPgqgHBRbp27TgdblJvxafpOS3S8FgL
+CBCn0Yq8VhAFDUg/Sz8nJGikn12kn+2rq3gXrnqPdCf773CT7+H9ciMcKt8U8pGytijUYuv3sWme
+njwXLOJgd02wG+8h2egYYbNVQ2luaImgycbuW12FuNQnqQ+kW/Fg1Tqyj+F38+BynhyeB5epIbIt
+okaIG8HsYB2EhFS2P2FqmgcXzPMa5K1xRZ7e0uXj144fV1R/zxQ02RN3R2miyKHtHq7UBibEN9vx
+Suv0NL2b1tXF1UPr6eUpajUuGtAiLyRopAeO/9O8XcDVQ+PuoXFDXp4icvP29k5zE7QuLhqs9UJe
+GiT9TH8666w05RlYVbZMuwq6PHD/moRh9DXpRtKvYj8hn2TBajIKFshW21M8QX6TC0GqzLn1TjKv
+lqb31mR78NtdULY7JwCPPF09XCTFfKWeTdhTV1ANWKrqJUaq1sthxTZQmcuP6e9z1IYVnqC/VTvm
+wvwOV5SSJuFsYapLNg+Cq4iCBd6NDnt2M/YgPs0VaV2AcsZutnjp587h1IGg4wY1zNQYczcL2/Nb
+bKu5LGjA3X2Wz7ZkUJuM+/Nvcf1VuiKor+COeBdPCEZCMO8a7OIhXXtA/zG1o7pMRW+y/3Anz54l
+/ZUfrn8RAqT8lPQHuOpMF+jG9eVM14xpkjuXjbbzLtkaAbhg5OIadzbBdpZt3KDed7xOHfbokDeM
+M5NLUNV2FWLJRSGfDnPKEEdhFt3UVBMeoTgUk+bnbczWBy9xtWTH8PPjq4VWiQjw8BHjpbMJ1x5c
+SzhbDUm3ElRh0LUtY7glyc+q7AoJ1yQlW8PoCnFKsjHB4Gu27xZJ8RVNYRYzdz4y8RjZMmXkkNcB
+gUQeFaHXh4ycAq1OJUZGJh6D9vRNESKPQAL2huw6nQhVXkk+b9xeBWqCBaKgeuXtYV8m13sl+cuw
+7ZXJafI1+YacrLLdeD4ZYcob4Szbb1YJtU7TuWtcPYVgqRKvDYFgv0B/PQqTLlP2P0hIuJyaaqMf
+q6E0vWuwf7aIg5BbiCHbU6vn0XZvXVzqrYRrqbQLScofpbNaHZ6EPWkg8yjYvt4lefBWHviSn+hP
+HhmaR76HcPqTB28J+WQczCbjcsjCHPI6zKI/OTAyJ4cshJF2OQtWJuetTCZnEeJnM9sVnObFZ8N2
+IRuJWAhGvItke3A8QRnV4+0IneUOyF74sSJYFR4spfBQjF1vGG90KCXNQ8MJrhDs4+UhIb3ENNLO
+DC9JyMY6JHpnu3qg7e5xt66lPqAseKAwoJh4hXB8OA/Xlw/SnzyavZH+CPnFdFIa1b2ACEG22vcp
+BojfjgUI5hk9zXZINGMooglREx4w2s6ejYc0z3jXNNfWrhmui1zXuordU2iKLavW9PnZszgvj/b3
+wg8pbWuLCoQNQj4KRnXS/H29swP5bM2SwPkh+gBfnwCNwSNARHRX0/EHl48/uPygGlKGx+PxaSKv
+dXF1sc8sOofrEn1SlIV1L7BP+i1WuoJsDItYe+hTLNk28FvkcZ+c+Pbzm/lnDt8gn5J95AuyDz5b
+C/xYaJmTQ/q8dmvs8g2zZ+/+4vp7X9z5k9q9zKICfquTz14jzataQ+awK/56PPPXqbtOPRhJ9dPj
+VT89vthP/6dddDzbEaRwds/LlvGBrZs0aU1/Nnyw4u0PP3x7xQey1lFEH2kxwk7+mAVFpbnTWb/i
+h0Uprgod6QKyvU3FU3zpQQXuVyimfkZSYlVMHY/y3a5uq6+Mpf6F/W8FPteNDzIyPrjxxkTlr7KX
+awnD0w/FoNZp/t5ebpXCjKGekmgweVWVTAFuSwwBSIot6V/RuZK3T1glY6jkKUYFewdn+0QxjypW
+IeSIUq8kPTQdluhEUgqkQHI5VE0evH7qR8dvF9P10WKyZDoMXFxM2xoIPT9+/Hlyc80acn3AqUF5
+Y8efh9A1E29uyOgBhwaSJttu088ZG26icvwOMZh3CXbHwaik3yHAEjd3l2xuq1sc9YZvJcTr7MlP
+tSbt53n4+zwSk4ezuBFyDXzKtojOgpS1HtpnTznG66g0vfNcw9MVBMTToS019Vpq6tniaYZOHZzp
+z+eElJ5jCNQPEPo74HojHaqbFqSDbCxt93bPdtnqIWYL3pwGi64unloPSa/OZ+jvvXU1dbm6rpzq
+FNAGmW0ysQ2KVucZjtCf+Qb+9nmO4HLuWfQ5PMOOCVdCl6NRnTQpVnKrHBWlDy0xtdDWiDsrPdgV
+Vxm6UwMZ/UXUVoeCF4fo4GV0XLD6ml5Oz+EkIS+l677nFPs6quiW+JFYx67vri6ekREms5tGNBhd
+qkrGAM+K9N1FcNOYzBGRYlSwS2l9P15S30vMG0qQR8+GmYv1/dbxddPXDJ1cDn2LybJpMHzxR076
+TucMg8j1NWvIzfPjHfqeseHmxNvbSN3BcLKHou9MXsJI4RcUiaqh2ujVNKm6OcDLP9QlICYhXheA
+pDqUutQHCdeUkKMhLjuoVrZ7kntQZd5P8ss2SdncEtP8OgptlLS4B3EP4nUSMoYZQsFXT0/tJyWm
+KF4L3WNtTaDbujWiMQwJ9hiwKVzU2U2tNSF5FMyEdrC0Z5h+5ZszVlJlKwQBsP/KGW+u1If1JEPI
+VjKeWxkTHBxDfxbbP+D70A/WjrK0qjV1yZKpjZPJIXKT3CSHkhvT51qtLKNg7fjUatVSayUkcLPY
+h7g4uj6BkHCE9XlTmlZwoRMKtF3DCTjYzcXdQ7qcetyWymJ/T5zGbDZe4615uIn8SZ78CW4i5MsD
+c+RBOJv5CrUVOyKJqFYJPtOMt81Q37SAAP+gbN/Q0EqNEgO8IqNdApJrxleiHaQ5i/OqHNfWiEu1
+UTuQVskQrPfPDg2snR3VJNs9sL57VIpgkkzZ1agAqs1vXlIAtB+fPV5WDOEa0ZeemaAzPJPWRHdQ
+OmTiBf+MUB6+PfnTs/fvn/108tsPFcHUq/Um+eUlJbN+7g+oqCinCBX9MHe9XTytDInly0f115EO
+1UoL9nLP5nE2miplu/BbfQSvbHcXV9B6c8GI11PWXT5+XNHVuAcJD548kB4mxO+qpAfornjuDuKZ
+1/U5t0MOBO3ZH388S37BT211hPwc0khFTS7IQSXjiVyVEmOEBlVKk0Q8lc9Grjx1I4OBd7GvebBY
+TmU1J7ZQq3AhHRSE/Gf5QrQKg3+dxYRqp3m8ouGy+akgAM+7SrbUyzT++SCBHrpI83HRYgwaLcdh
+Fy1m0SA6yWQTTBfpQWejiUVijQA778EPxMjtkKvDD/gIHpSTI69S5iKjWG5tev6lVZpfpCR6B2i8
+cIRk9A8IC9C54WB9CMQxh5y5S5LdafJBXHaIWxiIU719fbKD3QP03nGpqfSt9ET5G0/JS0hOskrI
+pE1AWlOimZ7OCRd9TRKyasOR1qr3w8fy5j6+RGZduQKvX3o8lz7A61eukFmXHvPRkEM63jxNHpL9
+5Fuynzw8fZN0hJybp8EHGkEkNAKf045xmfudnSGtmubOoe0icHww4jUlHd0Hx2k/fXA8fg/WchxP
+ow46o7rfPRDrviejjwv5ciwOeXbDiS/RqG9aoF+sFCIFBGs9YiTQmANES5i/3iPYFM25x6Uy5iQo
+sV+FPcerobRA/+h9vgBVOE+3bD0nuYtTQ8JM2QFVLOEhpfikLtnEQ5IxSWGKtZhx7FhUGeYlAcBR
+xqS5KvvgMKlXgoU4O6eOwqFiLtbJqV2ajbUZ/5L5WO4+01lDmquIeZaqw0W6dvYa9TLOHk+IB8V9
+sXL3bS0W4exFfCxspIZX8VsWkaHcFr4Hy2VJdxCygyHKeXdqQepCHaDRPp0pycppNdTVN/jBr4fD
+wjr36nG0X+4Pjbukx1lcRPHEmTMnYmtYX4nA52ztXDxN9Q/UqH5sbfM5HTvGJQX4piaCF3l2CLbF
+JvWo1jmlkpr3iOav5BKc8lrqSCcEaAdXE6eLeiSw8xomrWkHbHyUK+oXyR8zmk9xNfEw5T2Ykoxa
+E+z9BTbuE95cJJvp+x3kMU6Hs/S9YGXx0/6PSHru5EX4qvJ98hgPU94DvS3FioeR9F/2kceLcDOK
+11KEuB3CI0kUWjI8c8gBcZqQL1kMtxCyJUui4Vap8p/U8p9Kld9Vy++XKr+vlv9YAfyS9c20XNIo
+3yoXzk+l6t9n9X8qU98O/56j/nQn+LdL1TdTCJLGcKtUeTG9t8rF806F7d5BqBz4P5Zp924Z/KeX
+4OftUny7V4afSv3bFfDnXgX8uVsBnj+WS5e9vGI4FenDrXLh36oA/t1SfDBTuiSN4Z5TubPc75cp
+v1MGf2c4FfH/Xhm6fqwA/7sv0JPy5V6Wn/cqwPNOBfp5v1z8i/vF3VJ6/mMFcO49l89l5XKrDH+c
+6/9UQT+6V0af75erz3a6KoJ/pwL53qtAjncr0KsfK+DDj2XavVsB3+4+F5+K4FdEV0VyuV+m/v0y
+9ac71a8Izx8r0J87FerPHUdc+YGQ7yOiSMfzAvasnG9qhBDvxnw+ugNIq7rWgpEFl2qB1sqlsRPA
+cJpULyRFAIUk70NcjR7//bDg2+9u4nRH/FrYweBa6HPRM4T4++yZneW0PyMNQpUhiQ3r/P1CLfY5
+g31yhOhn+UVFbD4yT3jkI6IqDpgDGQx2FqooHyF+DNsLQc+DKgv/Wis/Jk/uTWz4tu0OuOFzeXis
+PAfnciFyNfylghsqKuBaUTiGQQip7VhYO+aSz9CYPdM1hUqs3doOnpnYc+WSz+559mdRqR/vHOP1
+EVE1R32Fjjj2PKuogF/P2k921G/P3icxmdRCiD/J5hZBLA+fhbPq6FElyrQw6tkAlZPkZ8W4+Pxx
+HqlehGzT8Vh29JgL8Fs8CVaQqct747HPoumhY3kn9plFnr1ZYv7gI2JNyefi/Ql0PuEjomj2/H5R
+gfApxdn9G7vvy/sLj9neWPVsldaklei0jffveqz/ohF5l/7IOCSseNCmTfYZmw/3EAJ/jFHaboCQ
+5kMGuyZympf6sHlpUVGRDSH+LntOZX4eTRC4h+molu2d1BoVLXV8EIzM4UtRzmwZuR/Jgfffffd9
+aEDyly1Zsgx+k213n02ZmP0BSIXYn/PAplnz5r8pr4Jjw0aOGP7hkZ1z1uvDzqw4fbUUb1BEyWft
+T85rNT5s7wYC+94N5K7qtho05xvaWuM7NNgmu3DHcmB/Dg5xjtn7sJi9qn/fMH1IYM+7igqEyuy9
+sha0CyGhsvAYaVGVNFe3YJfq2mBUz6d4lkEv4Hpge1ANfeyZzS9xVbYoWGlwIwwFgOPk9C4wQhAE
+gvHMtmOfbeM2QARUIVfINVs3bt6jS5ce2UYXFSEXhLhNTLevKvEmhIQuKu8rpbmiYLcEPsBL8mEB
+pmpoL8p2narJdmfLa/Zjw1ad1qh84hc/IWfIDagESU/ySC/SmvSH5bBLiCP3yWVykdwT4p5Fw0CY
+BlOA3b+HtiMEn0AdxFH/WWvcDi7kKdQhx+i7t8gR9k5k7ziTzrQdXDZM3TCLViBHIK2oiH6f94M6
+ksXnFO6JkCT6nGI83U6OqOWni8sRVmDyJuX2LRWqVmmYQVabp9B5E0UDYQVH3sTimkjZd2LHVK2u
+VlXq8gK+otSlq4xsN41MK8q7aG4KWh1f2cjqQgYvcOvtcJXcFNtpJfazfuOjHEbLyKICfgH18SnP
+iopQVlEBd4CfIIkw0K6f+CTbS5TOnj9ECA/lMyQRRzMejykqcHkmVEaJqE6ab3y1mGDkb+DdvKtE
+hIooOThY1zBJsj04e9ZQ4/JZ6cFlGunxiMh2qxKazXvPjzckSWcTnrBdOfGgpxkwlaxv3sA+sutr
+rPQorGRMSLGfJaW7m+mmC6ufwc/lWd/Xfuw6tFfG+HHjJxwb2vXH1/rWf3XYn5O6dgA0t3vzZn3f
+fSerWdPucwF16Drp2bBG9YE8nremQVpagwZTGrw77/HJmw9WDPr15l6yP9lqTaY/0GjvzV8HrXhw
+EwFaUVSgeY87gYwoNU1nRJVcsJcn7xriw2OT5OLeMLwMZYZNmhCfTbzrfKNnuBNlPlrJGB4RzpvC
+tJLOin0lZQemQkyCNpHTGOaSke1J/iNyuS0ZOffkW+frNBnnm2XL0r/epO75t7gTpID8RFrfvAk7
+QQ8hZN8zUli9q0dKikfX6qB5Cq8qtiYVIc1IOp5zh+l4jmKEH1h5HYTEufwEycz9wMp/FbRq/LdA
+c154hFxRCEKI9TL6yxBPh1S6vwCpXS8M8UAa0xEbcoG7CPF5eeS8F1lBTpCTZDaMg5pQC/oLzUku
+uU3ukFxoDIEQdAIvfxaNs8k90hc+gF7QGz4g/ZRz0AVCIT8BudF2HXtlJTPEW1nDJhGZwhk2Pnjk
+0i1blmZv3gL983Nzz7uSP//xiDwEH7j+J/fD/VOn792Ft+6SY3C4cB12fUouQg3QgR6qk1sIo0sI
+aQ4L+cib5cGnh8KNnEmnntCmKaneu41rXJwt95ydbyM18/KwBoaRhzhSyH8WTRN54mx5EI16wpdy
+AMX7aFGByPMTUDg7xaLm1qBaqoJMthqTEovTa/g5SKsNIk8zamw5E0ee5ubCxYHZgD9SU2r8+ogs
++WHL1GEr96lpNI6uH9Va/oPPIPXCfSbPY4k0bGRP3wm9R32zpzLFow857OLC7gMNRCglOcUngBoC
+hXUUFTD6SpyoMW6Zae4ltpIr5+Tk2Tbm8JgEDTdE4WU5dcdBIjZDYE7hZJocghyGn/ipl4kn+bhB
+NLvXg9wW7/IZyAcFMPjJ2qQy8L3BuKNrVG+hrfz+0qX75NilXF/yxpgQ7Lc0qsMl2HR5qe0kTSJB
+bsM2ruZ8knUtWYnVtUfIRSvkIy1C1iQHaLqfTeLE9t/V7EQRziNBfXvnxL//O84iOTSimCvnkacy
+/X5zhMQLDDfn70cDBaARm39qbSC0k9+HXDIhvcfKkDc+hPOfLLVZuXPDSOtjXyq+hsvvTCeUvBHK
+l51TRvC8XPmEQGYUJ4ygKPjYPNVcEYD2I6TZwmcgr2IYJgXEfvCEOlCX6y+/v4+cySV5+/gM2Qc/
+pCjskC34G1vr4jFasqDX4SYdP9SxWj1TL5lRW9ZPX4eqRTMQT+uLfYRHzJbrWJ+x+prothafMHYR
+Uhi9+6i4/+Jqly7BhkuX/iE3P//ll+fxnvNffklOk0ByHcLgDoRxjega1zsXL+Ljly5evCSnXuTf
+LtlzEU9xEerzE9Rcr6HFbXLMW1S2ttutg1ZCcODSJeh/6VLTzwpunDhWUHAMwqAJ+YRcJz9QqDiP
+EIJ1d8nQu/dOn7q/mpwmD8jP5JS9v9p9F7eBwiPJrDMjRLkjjlT5cNPOH81efgJ9ww8rmiFZFA5J
+os6CEPJGNUkn8QvhH05rhAZUCVlQHEpBddGrqBWait5EKFKlBEr9RUoKg0Qf9W9EBc+o1LOOrfiY
+4k30T5jFbBE1YhhbjVBih7WA/lY3udCkPxraIt905kz5l5kzsVb5S57OnMmHz5xpm7BnwIA9/dex
+37CJ/XmP/ZZnsj9ksfOrVxds3ryAT9xMCjr4eXv7diA3N1kxLNq8eRFO2AShHXy9vf06QMjmRHn7
+zJl+q1evFpqsXr2aNFq9ejV0X/jHwoV/4FuLfl+48PdF7DepwcqU/0mK0wPX9uyWTWe7vbn5TFZQ
+SEhA37wtb3bL27zlbJc3N5/tExgSEtjn7OY3u5CLq5EyRyN9RUnIp9rKsYUJll5CTdKSwpJOMFti
+FPlf5Ifw3v4RQ87eal5nZvWUiRPJ7DPyQ7ZSMXbkQ/IFzdbSrNkxCF+7Rq5BVxeU808dhcZKLgK2
+8EE3EdGbX50asKgtpPLVyCvPQNOobds2Hx/dMtRoNsfMI7aPySt8xlKS82qb2+Rxfq+M1fEpS8Hl
+w4jKJIA7V2Ke6a3S4JS7iWE9hKVpYqjavlJyMnGVKW4I8UP4DHYelH3PYE9plKRg08hkGpI+dG/r
+dqQhn7FUPrSkajxNrYFTkN1v5XcL+ciAqqS5B7gEizoPFOzN0+MmCWcv0x1haW44232rb7bPVI0X
+9dCpw+xrTFDutFLITky2GunNtzAe3tv//eeMq+9/DK7XB7Tg635x/yHpC3MpQ/m6V46s7sX6136E
++CxRL4k4yrk/+og6s3P/81H6G0ZXEOJChHTVx6Q9SWvVXsnJgcOzhfTZhfFC+rONL6jHR8y2Zb1E
+PfxotrziJerBgdnkbLn1UtRt9kq92bNnK7XK1KN9E0xaKx6Vk0N+mm2n5IX1VEpeWE+l5IX1VErK
+1KOWyuhUj2KIwFFHYPEMumDWYzZ+ldQT0mcj5PRew95b1Rqz6aKLkE7Ozn5hHXnFi+vYskrUERCK
+ZH0TesBheZ+CKgLUF6/GC4VbLD8ovQqS3oZugR41/mj+1qClzYVb39WfLnfAm6fXZ3VX4IXCdVbX
+ZGG3n6cY4O0aT5svHfRWc7ziu/rT8Wa5w/T6CKPP8GqoL1xHHHJV9EJr1WpMls+mTJni2deTq7KQ
+a0CWnD6NUOm6ylYQbYrVAPWnTJni0ddLaLvQdgCGnT5N9f4zhKA+10DS6LAjd8wchPBXwmUkUT8k
+DBuVH52fxk/n8yU01ZO90DSUrMchXq7bckW397HlfTixnsy/nTmEFJIuDhjv8BnlwDD4fAoxerrV
+KpRchhteLjMWiW4rYeJKCFxB7q1p0fkAyVXXPuEM15T58AgkgwYnSUbfMIgdvWEe/kou4M4RcrEq
+VLuMw75V66OOXFN+QgX1cbqj/kFkz7PF1oJ1So5DrdUp0ZZRa2Jbgc4VtKg7c6gcnqcch7Dn18I9
+5RpqrrOO1K6wU+1OmdeYj+yr1TtMNrdFzb62e/TRk+9/3KjaUKO5RAK2rF/uyY2vHFkdX/0tZYxp
+LtI8hFUU++xr9GW3G7KEPEY6lUxScyHSTfos/41jwOF6kD4DpmZ2aLNiBbk3auq0aQMnT5pzJDOr
+W/cPtsFUeY0yAJ1ZRfyqRw/uP/fa+Nd1uojPqgUENGu2aGaTpivgR1tTIRoBSiHNhURhDYpHyN44
+r7QdCiWbto8eSVols6PRl6vHUCDnyb0emVkD258ecigzq1vXj7bBNnkgy3W3ftasfVwfuMaQ+O6x
+jy7ys2oJzZotmtGs6Yfwq7xhTstVay/ijEV04r+R2egNRQX8BDafb8t4/ybpx53mJ7J7NNUbYem9
+Er5qSkhf+9ikfLQmqMmn2HnFZJg3PDV15IjjzXN692rd5tWptRo1qP/aO8vmNG7ZvsPbvdp0eLVL
++w6tHxIyF7aEdewwYX///oHmvbF63zqNhwxp1HBFiw5vzu7QITi4+qFInc+j24uOKr4zN4nlA0E6
+x9bQ/fi4/Dvkk+j9fMYycmmZ/BujZVhRAXeKxiJQR4SUWx1cH6rzOV+ElJNldFIXDXRm58qiuLjp
+bTgPF+wzO479zsdb6MSOG6VM7wrr8MeUKR79EbzkAHyXTvEQjyYUFWgsbG6ahNJQC6f5aW2ItKdT
+9HVM/UpN+QzxhjJTP/Az2GtpRHxImepxu+h8cGgbZVYoFzpNB8f5lJgUyrOT4PVT7H3XetiqTgOb
+s5mixY1NFw8esc8TJ+kbOE8Wof/QD1N9Js8zSAr/igpcY9neNT8W+7Tq6K5r1Ym3mC0KD50mEk1v
+58YOPwC1oCaMI7PJSXLiQOuqcj8HO2eTxkQGDnIBhHwSuu2Ni6QrWUPWkq4X00cqjOUiS00tEMd4
+PEGdR1en/YY1aDFbvHA5TA0zJDtzs3j6Ibz+SMkjNjvF6JMxf9fOs858hCoj5pJW6sSaX7Zlc/bS
+LVv4CfaJx7t12jacVYJ3Bw/KuXa+bbFPVJTcgEUFLiPYuQA/hOhCgRX8jNQAKnFRjjIOH6AcIRNu
+w4GmAMe2nSE/kDtk1JhIqn2MEdsKRx659IibZ+tGrpErkNifTEWY8aISPwFFIStCkeVrlZ9WoZ42
+qGRUdGIIt7Tx+l07S6rQo4kTs7fm5tbfOZrcJt8qPPhhwTBnam1F6NDatYc24AmF67b0zcStneIL
+EkKaPYxeT0avjvYupbcpZJ5S+lTN23ANvroon6L0yd+wvpRfWIm/8Sya8W1CUYHYkvWlqBL9SFtB
+7/ELANqE2kXK9oxHLH2fow/I50ppv60IkSSaxw9xTjQwW+EKlbGvkTMplLB/JoNCDJ4E/Ujnt247
+aOp3HzIvyvMGJFGyNqo2It/WlttWmDNP1V+xpaq/8QgZKzIGfgZ7UlMn4o0mLTe6nF7fz/vkqs/e
+eefCJaJX6IdXF5Xu3UPOwbskc7F8zsEDpkMS6ah5h9Hqr+in6JgG1QWH7BRqYXCzFk0nfr25eZXo
+BJ2D5C/hzEUmxBEn69bOiYh8M0EVZhp/RPGRJ5COjGYLSqiYYkWAXqA4CHXBWU1HVyBPfctlE5cO
+bt7eGkieKISXI9Wx3MDC6qfHr2o88xUH6ch5vU0SuWXseXJRAfcbHTO4AHtuZZan1Yd5hNQnVNf6
+WK7iz/MOH1bStHKB5HfZmkczNO/LIQ9oolZbAU3bytPTCIC8EOI3iHoVDh212HkmFc6xffTYBsvb
+igc9kk/t27NH1G/ZIO+jmVvlLTRrK398g31vXV8hn41/1JdlA+DneTaahBlv5aLkHi3kKjn4mZpX
+EmeKeqSx1zVpj+b+mItXc+NkoYltDb6t+GjtEOJXC/nI00GjmoO6MG/jRgp440Yh//oGW+ccvs0G
+BGgcQvixqFdy2GsdGaehc+6NG7k3boh6cnND4Ta+7QZ6K1pRgThQeIR8UQjLxBBm8KOeNaZ7KS1m
+zpRk9UlJ5sPofVFmi87oZ/ALS0nmakHNA+8Zpk5y33GAjRo73SdNNaw/gA2k8dSBem6PbsBkWyO3
+sVMhd+oY4dHFj0nBYnLo+KWLx6H+Ygj5+OKGDTOvXJlJ9M/e3rDhbTZ2uRUVCAv5CcgXhSKL6mtZ
+fU2iumhgSrLS+0fCFTzMDBGq//jcoznDfI8fcZs059E/Zk9yO3bSd9jsfyzrWFeLR2nrdtwsT3dp
+3jF7accW/ITVk84tfrL43XcXP1l8btLq1aM6TJ/aYTSJ3Nxv1Gv9kH2PK50Po0AUwTL22c8tFY+g
+yr5fCfGOMbT4F2eBmgfiTKaqB50H1INVI0xVGZPILHgdG4pHVG5x8Wfh0cWk2rWTiofXxNqpiZdg
+Az4OH2woOcBC45LPiLPP25Eb8mV+YPEQaiz+aElQqLGy0ZgxlTGXPgl71PHTFqN+WPpojq+Pj++c
+f7Ah+NE/lKdH/6BXQXA/2EdP+9/Vq/2DgvwdQb936dO7xaE/55ziLiy+aU+KHVmcXNy3WE+XKnnE
+qztyi//8M+24cfKXbCuBklv8+GJiA34xQriokHTkN7BcpZUQQpI5TJT8wmh6YparN4Fl6TVbtGzf
+bBJ3+yNy7aOPIMJ28PC+mjsnnEVFRWcn7KxJyD7IguV375KBd0m/xfyDnKxe5ID8iBzolUXOLl64
+UKEjASFhpZCvaqmRnahRMqNTF5seHtaobngtTk2TDvlwuhCAFBWSvNhu8TUh0JDaoFXDRpN7rTeq
+KdOFfLo/Qt6I0/0r1U6yhPkvMzRp9sbS5rUowUq7pKNwjJ+APJiE7dMemoWVczj6jqatCQZn2i1a
+E17i9u5bC9PbUdr3WSvHJNYMUpFo3y8pxc6HvCMb90GCe86o4V+euXv3DCkI8A+qlxilojOzcy0D
+/tDBlvXyBbrGRJbRWDdyRSbnNSawpFholktHABlr6EkBg8nCc8VqT6Y0zhlk9fFWOofSbS6cbPhV
+4wO9n3iVXm56tviN3q9x4Fhqol3FRhamN5v4xuBB3ojmcSEdhQB+AosKK74WlhCdDSVZVeGbkoxI
+8gtLlsxh3PqaOyeeKUJFZyburJl7+HAu3TG94COI2LiRXNuY1QsaYAka9MrKKdQvXrhwMTeTDLx7
+F5bfddJlDQpSdVky0ygzbcOawEt8NDhp8sqFpHrezz8TuwocPsxb5AtvgccS2Ba7uNkN0vgm2Sb4
+K/nxFV0W9SV1OVIBl6QY/2KddugySdp3+PA+Qor12aHL0fI28Z3FS5cuJiccCl0xDVoVf187v5xo
+sFPw888laCBPKQk9FmdlLa4If9YXtX+5L4rr5PVlOyPQ8Y3bIuTbY2JJRm5LnlyZe0+IzmF95ijp
+CK/zE9i8D4GGHq0N6Zaby08I+vN3JfbRUfRi9h7RxP1WXxr6LQ5g+DJr6SMEDJv5j3OrVu7+Ki+v
+Td8+awruc2+QN5VwMLzxVX7R94Uumsn/mFX/FXLUnvu9oxDIT0ABxXAtTinpWcf047OaDs57H5wi
+IngDubuw1+qj+eBCYyKF34lu2/pk/aL4QY8Qgs94k4/I1nIxWgdNcG/O3b5XIIn9w71JHThGf6DJ
+8uXLEQJIghsYMR7VpPvOp4o8BxxCIt0R4l68I4Se+5FunU1zDeN6cju4Xzi+ezzsRZowTWsN1x2s
+Ws7EQdIZ/XpfIV8eQd6BPgjQCS4HhrEzWylpbjADTeWRgHmegvZp32yHrn3X9H0IM/jVkERj2Gme
+aUJrIUMYLiwW1glid9pPYFiOkC/nY2XvkBob9xG5A4zO5qQj117UI5HqEz1PpNFbU5R7M4ojLvGc
+KYzKCt4ypLSAbq1znz2rPa5ao8MrcSq5B/BbEuczo1mNZ9jFRtbJwye+tvpsHp55UNWBw8J3qm9F
+d4gpK+NstVDCy4/n5JBH8sDj6rQnny6n0szoxTn+/2D2hvYg2kfLkfLSpbklZCz/QtdN7WEvPoOJ
+GBBfVCDWEB6hKLqzjm4KEnlThMWs3OxAD0zzBrp1KkwjcmCgWamNCcn8Z2Qv9hlN7qwj608uiGof
+PRxgHvR4CzTDhtvmkV/mzuL4k9GFfgED54J20MZ8WPKhPLldBzj71s3gyZGhlWAorGzc6KueGSvf
+Jt9A9mTS88uxKyMVX4gnHQWJn4DMKB7VRChSwsZwe87r2qBe4acg56Oa2BSDNwjKJX9K1gwliTbf
+hZBnvba0abOm7cd7auya+MWk+pX6G1/T2L6YuKtGbrZx7z55rb5q22XZH3jqEtouX/YBnkdukvsh
+obtjqhw83DWz97vthpsyLdXrAA81e2fmkJ0JmbVrn+mUOvTaKd9JvesNvXY6JJjpCq/OBWj8g926
+YARDpEEAI2hYCDol0uL7uXptw+u4bbT8M04hvxP3r/NWRZ+A6kPtNziMPzF5h3wK/mhM2sBg6+R6
+/YcutcPn14j6YvhWBb6Jwk9S4Ku3O/SAh0bSCHIfkTcP7cs27oXwAfaLHrpu6L2UpMPguuTSo4TM
+1F59ZjN+IyQ8VXEPYLoYyULhGq0RBBV7Thup034OEvSA6sdXRZ8gj8iH5Dj9BEds8ONTIV8e1A9X
+JTrr5Hq2D/DCAfK3cMs6uV42fCtf8CMx2IwcfJL4icig7JAzadUGFKWlVzWmGDSqAEOAz8qVT1Ea
+mAZnNtUtMW7Z72qtMzF7C94gd8MDD5H2MCwhszaevq1PFozrvLJaZo340WmtDm3oqeoSQsI8tlYY
+gaIRcr4uRWnXGKa0SRdYY1l6dZPW1+qXkpzifGsKk9JpQJOaVLofXQTT5Tk4wCem6VJCljat7e58
+gcocDqyTX8GNBtXJajm5g6fXgv2Pd9eNegWIXxQ0qJ6JaH9DiGuq2mi2JTEEIg0l+JDgZ+CaVmoS
+s/oj2GUksYwDNfe/qasazO3x0Z45lLi492qF8lQ8Y1vfTEFASj8WJJY3oTiPvF8lCtseOy6ROV6w
+RDKfzSl7/NgP2+PwaLKyU/Jg8h25O/AXe874XvLNaOjTIr5PiczxfSppP5481lJ98N5vrsc7ksXr
+9kweG141gyWMRypePI17louXgZ2vKw8vGkzm5rXc2GXOnN7LYmG/kVxpFtMW/vi6y2eD+57sNW1+
+n80RpJURTHXMza9/0XcPX3dbVFT79mlNjG5LMzPDoprPWb3XZDqUlNS5TbPWkS6LM3sFmZtOX7Ml
+FCEoehshYa1yxwd4Abs7IrkWaNXdSVoTxM4Ys/rIFboEQbOgCPHQY0C1Rh+/zxYhomkyFEWfJ+PV
+fCXhOtsPwGbvqqPEgChXFhmT7k2BLyYPHDiZJEwZMGAKfCFcf+ONN7rJ2bCQjMSDunWbNInp6qt4
+Pn+L3Z9nYZ42Y5VG8DUy3qgHhiyO8EiCn4GpCtsrwJjHD4N+nTJGu2Ct/JPLlFH1J9WpO71+nxXW
+2BnpK7ZsWZH+ZpVqb3VZ+JHM7x8yuEf3wbxXh6xenUMTBg4PCenl71+7dp3U7ave2VYnNbXO+82n
+3pw6bfgw+9qtuILP8BE55X6YsQhxF5Q4AzhyL3AXlIwLw+kOJ/x5DuTmlNm3rYq+FujYpIXlBVD3
+bZO8PKLs2xbi3po56y381bNonH7zu28LkH0fL7uTxQMhK0v4AspGq8+Pw9QzMPUEuUSmkulC/rMb
+PLIhodKzaO6pzcXxXViqGcL2atJ0DXl5miFPlyrv6L0lHZR1+Eg16G/PsMM9gTr0cpIa58gcdmNJ
+ATlJTp2DseSEINGbSZ5F01tK6A0lSt6dY/x5nM3WUrV7YRPmOeCl45erIYlmSqAuUjaJhUv8+dkI
+0AJuNT4opLM71yxg9b3SBLtEcatHQRNlTXUo7o9vKeucYAILWPEtieD+2NKKPy+v6IRXIkAZkIgf
+l6jz2Et+PAYndefP27IacNcRoC4I4T9L1PnTXb43EdfK4s8XxqcIxlK4gK8JrsDdKPkpW7qFJkVF
+6Bi3GmfzEZJG1wYhcpbpxALcHx9kZW0dZUMhEd9iZe0cZRk0HsTK2itlpWjzpXkG8a1W8jf9geBH
+s/HKTvKKEvA7OmAdw/1xNn6k4CGvUPCARHyQlbV1lA1FCN9iZe2UslK8Utp83F0+MwZ7wYHZ3PUG
+tqwS+HctptMBv6MD/jFIxNlwQMHDlqXUQwgfZGVtlbKSvI9U2vwzSz4xEfvPni0YUwrjS/Ino5iP
+Dvy7FtPpgN/R0eYxhHA2mq1leBTGI81OxCMpNV5dJ4ceTBe9PsaAXDheoqlGjh+nveeKfYOCF1qO
+kKamcK3EviblpkKagyAOWVEKXP0kLiI+KiUmsbp0jeZWSE3dW8kUHWYNtrCHa6l79R5BOhfJ3/6W
+03jzBuSuvt1R4+yO9GZt03dUl5rtcOvdN2wHap++0xqfmto5bEda+/Q0N2t8XExKYnSUJSLV8V/n
+sB1T2qfvNoXFxaiPR9qn705JtD/mAmqf/jH9Tkqi/Ru5kMbqVAq2P09pn76zUrDy+Uj79E+io6zx
+dhisTq6EeufiKZnNTL0UvAx61hhQvLw9gvwNep3k7lICL9w+fbeG10nqI9c+fbdOCvJXH/n26R8H
++bu7eHs4viC0T9/NIUcNsX36Tg6xj5r26Z/oJIM+yN9ePTW18w7X9uk7ae3OQXu9PSiJ1SXl1Sca
+nkPuLga98pia2rka+oT39A9LrOwRFadwP/VjQR9gjE9SH5CvFFwtRnn4xDUSXLQhEbHK47VUdgiZ
+o/bUft+61qr1Vf8+7514JSsrSx6DzfJVbJbHZLFfZHg5hVwkvV1ebkB/C95ZWc9mZ6llA7Ky5K30
+Az7wwiovravX/w26ipoB01bUDP62+oqawf/T2P+QxgbhdHmj3ID+FgJUdWRlPbOy5PfpB3zghVX+
+i7a1OdPW5n9bXW3+/zT1/9lWu21trtrW5n9j29r8/9nWv7dtBTS06CafLK5FvgiBr2QM0ybprJzy
+IRFprX6ITyZm4lPrdEOIBzPx/hjgF/zeWvk6jpwHruTqKDIF5ub+CWfAcIJolLm8HaYr203oE8kr
+AFN8pTBtkhRpTaYnw/R+GivPTQPyObkBYIb4PbbqsFSfOPNN/OXVSD1k4Hehn7sLmdaBtIStzVLk
+tbhXKvxU+/02bXcNXvNrQ7WdReJu5IqMCAFtwt6O0d5Qsinc1yQZwzhRw3MXySfkfUiHlgflf8gP
+saZHCwyH9m6ATtANPBqAGU+CDaR3T9Id3u/VSd6N64yDKy279Vp1HLLI0XkdRjLaEBK3i7uV1eXI
+5BSkTZKMCYZk1rgQoU1KTDGb6Gcw8Aaj2RKhoYhxvliI0IFn5mQyGfZtWtsHMHxDLCTR0n4rKYSN
+l2FWdfLU8yEhv/FvktvkFwDQQSB81tIIHeHkEHi6e5NclC1fDA3G8WN7zl9FPskksWSnf7srbdPS
+9vDdYRFZs4qMDQmGwcp8XJHBbhbXlpQ7KSQjHwCJxnCtwcRRDvHJxI+EQ5fzQ8ECNcmtX9sdagi7
+4BM4TQLhPsSRC+QTgFbcqAuk6HfwhDlkXknYlOuixi8EpDCtzsopSsOW+blEE08XCiVjGO8lnHtm
+q550PBUsEH8op+s1bCOEi9wfALvhUyHozsRR5A1iwAAGcp1shJajzkVtag1uV+G3VWtgMUIca+8M
+k7MOmalGacLZvZXWMjJPpLFsY5hWr7Hy+AK+cqF/3yM+UJucJnvgVah9TC6S5T6H4E1Y4uEOjfnv
+IRl+2NMz01W+DbPJmGakO7yT3kzOxW0bLTiw7AtYSKpGpk2267S4TvxYvSNNMoYFsMYjMWeM0Gkl
+UzinVQuTDcnWMB3XhPxKfgYdeHKd3M6SP8DF8PgrHalKUuGL69mj/oDpmH8LppFJ8lLSDTa8QxqR
+Z7fhW7gIr5Of5ViaexMPwUZ856p8n/EcIZ4Td7N+KnEmnwCQTLQ5nQkrmPDcOyc3/Ea+3XZ6AxTA
+LlxI5pIJUB9yYRVMItNIX9KO7ILXYRqsohmOi+W4jvVSbFRkKNCeQ/cGRSQlWox+vpLGT8snAwkh
+wdFfMBFevLYdFZ0G/6xnz+AkCYZfIYJcI9sAGoyD1I1Tt2fvOzSE/JGVSdaoeLv8JO5GSay3RHBK
+bwmjPNL66jmTT23wMYWxs+QmtuhiNoZrfX2EZAMPRnNkBED3UUtI7Xj45N7wLFgzpMevsO2HczaQ
+i9CWgm+3YkKexsKu8YuxF/iQ81FkJXmHfP7huPq/wqMJ8NOuTz/dRSr120+yYOfpuvDqXdIFPgSE
+Cf17l3zyOql33+BuImM+6NaVfGa3XYlM12h4U2u2sH4dplNNSoIhKdEYLmqoTdFy8wbmks+gJkRu
+IgnEBQrWvEturJwAu2A/XIarcKYukKO5JBugt0ww3gYP9U0v/DliMEwkmbStaUU3+abiOuTK1m8l
+Y7hG69DlAJAqg68xPASSrWHccvL5xqmQBNF7iC8xQRvMEbxl03y4jzdDq2bETLZCA/KM+MM9rj1M
+W3fnVAeV9+JMcTdbIZDAhKiihNMD4uyPvSSByUKcSU5dhx7k8e1PyB8/QZ2vyFU49P24afvgc3yM
+9CVfw1DoAF/CdPKlPA3PgijyBqlGPiLLIQregS+x8dEj+Qen8STUPp6kWDXFhoFGKpOset5Xr+H5
+ZBJPXPSnQujO6x1XRwFHul+DCT+hIvgqksTDD7t3k6NQt/6R2L11d7w/8snyhd/ten2oorfX+STx
+KPKjdEWGaa2MXwLvK0Um8ZbkyKRIPon4E+Mhug1U/goy5FxYD5h0507CBeKNcVPSEVrxtRLGXSFv
+4F34ix09P5qm8uuGuJvZGF/KHirxMI7qJrMzakmE0TeCS2EqLN4g63+BWaSQ/AZHyB1ig2U/kZWg
+B1+cZ3u84w1wmzv6W7jGhU4kvYkNekOT+dAMk6/IeugBUWRPNjlK3gUe1oyF922F64B8nfjkCUII
+VN0oHpcDwKpV+IgtKVaNHvFNqSrsofv4bFzs6abggjWxxAW35QcVDiFjoEmz3WBe+c2diaRQ7evC
+IDZu0Ti9RutH7/lO0iZii0ln0tFgO++j0fohYxhK0ib6pGC3GekYLvzjl3NX3vLRf6pf6gNVnwGQ
+r8gmcoCcOnJN+Cju0W5ycAWZDWNXQtpeaIBtP3Pauw26kdE7yd4LxAxX8qHJDljQFiFACUU3+Chx
+N9MLH2MYp4wXAXQ12EB7PdUORRN5C5BE4sJXP9MAXoEQOPYRXqtfs3T+O80GbIIR8FD+FX6EquQr
+sguSuD2b2pP+0GK+bu3atWSmaqfns/6b4uwTgIiMNFBupVHzhGRm4lKSk2if89P4SpG8Ooxbw7SS
+xo9rRH4hf4Af8FwCkMPkftaX5OMzV6c1PXlv8sRal8kfbw4zc6QJiYbP5CHzP4WcB1/za2AMWTSP
+JkeWj1wm338ztdaUc3MA3sZzfoCkKcOXjZS/AsCV52Hp1FHShdx+a4JjLE0S17I1rOLuEgDYImp0
+JoskJOi0Vj6J+BFTytmGYIS65/cch1d2p8KQLd9tgjza57+7Rj6BljgCdmHYSIr+hB6Y5MB6O3zx
+U3E3qsJOCSveis7HGqbzFZnxZY2ZtFKYzpde7aX4LIJt3CqSASe/mTPuDpwEvP32FXp532b5AQbY
+PaYdfFCdPOVk8gv5YWfvcAjGYc3gD/InaEhYr5/IMsh43B7ak5E0iQIsIBuHkBZkK+8z/EbbzIaH
+VJoTxfdQiEqzXRdEDU+HcKsYApKQnKK18okkgBhDj4VTuj9YTJIW39gN3IZ3NsIJEgKfgiv5ldyH
+BvA00x3DvqhNn19eFkWugttruIazLXdBJmbLqV+GVVPuY/QzaBNN4WzFypikDEjc/FGnyLeAMzaS
+asQVoGD2rm27N5C7EJzc4TI0gzNwsglZPJLMhe6Ewx6L4U9cGzIm7qlZc8/aI0fIbtpvEeKbibsZ
+bSmJpnCtb7KB+r8KswUzXcXGxjCdBi+SPji+7R04C0eu3SNXp+7bDdABJoNe8HprNLlzoz8PpHYP
+IpOPYPSObaAD6G2byD+Cz8jPb6k8/Excxyjz0/Bsy1YAYJq6QBsIhgSfJLM6ikekWPU8vwQ/hShy
+m2ByEo/8aiac3bx9C3fkYhdwJQWQCxGCZtv8CeR3cpNt1RoO70IM1CbznpBM8jt5DOC9tfD9Psem
+LpnffjYq5i318X0dvJWM9nHSmGzQWrn54/PILQiGBhtJKAmGWx8u24g18H5rMrwzGQGD5Se4TlNc
+G9riJsq8gdq8JuJutncT6RS32jeMScgU7qtNTgnTsTasaiPhmunQ9XRGJISSuxs+2v7hXLiBiSuJ
+x7vBAE3Il3M+FF6HmgcPrl3XoMG6d8iaDkC0Y+VvcRB5E0bMg7YZKh3dmA9kYrf00VTkTsMWR/NB
+GK0Wq8EK+lrAmcL5rtfqrpnUiXbIWmcbQxgk9bhB+sHqHpGnwx/bHj7Ob7DwN2EA2beAJBX3TTgB
+sZC8cSP58gN41UFrUwf/JKNYYuy3apOtPLecnFk/G2qAeQ+dgn383hy4jxfAZK4DSSdjYMrQnvIF
+HMmvkA+0BOVexqKb3C+qX66J5HiDyBuTwpKTzJEWXWSKkJKcaDZp2CXyem/gfmlBPloCrYHOwFaC
+4XMgz0bdJ6+3hqkDdU3Tpq2e0bRZ6HDu7NzN5Keh107eWTd5/JGohpB9aP6JY8HtquveadZ0V8px
+JxumjJuUCCafRLbBXBn2E5IS6d2VzC/38/XT+BWPz7Gr7l5ateru3elkyJgxuM3beBNMXw4rSRPM
+k9VgOLF7xuvw8crcNSTnnV4tZy17dSgCNK7oJl9H3EXbExwDo9JQCtP6ZNaltaLG5GcQNX6+Eu5G
+gj+GeLBM37RpsCBM//DDYeQruAtr8V6ArUdC4Q7fonA32Q8+1/HO6W98AnumriEXP2jXb9KUbh1v
+kJb2eW0PNu9xZfuYJCFC8by5hBTFk6JOG9CtYpEm3gIxLN/OV+TbQ9/UxeRHuAm3yO2qv3xG7vqS
+hyfwRdJ/3DhYAethHcko3NQOeOgBH+Ja8lHSg6wnT1sdunMXiudbieLHzE9nqm9VZ7ish2sFX5M2
+UhJ4LZ9IDCTyIERC1ONbE6Cr/PFR7iTZD41gOWkOly99R66A/h0I3LAVxhkewCJf+fCaEeSio41X
+xD2Kr1jchkTlFsGJJl5r1RsTUqy+WOAV6R2GMIglPx4ZCv3wR9OZNL9/Y5U7yYfKbAZ5Z+FC1nbG
+1lD4dBiGsSdP3CBTPlyt7Ceh7R0VdyMN8mZ71o0RVIpJ1OvVJhrDFN9RijRFKL6Xkvubm0FOkRtg
+hgR5I1SCGuQLcpVzpV0RPID8BregYP78+fh7TL4gl59wvcl+WFtQeHUcGQxnwTgwA+rCt2TS24dI
+Xq5qwzTJ4h7khmIQArrvK0ynVZz9cIuvZEwKS6KuMh2OjL5hXJIUQb0+I+/jK06c+iH5HkMYWPYT
+/ymrSBzoydV5KyGShAD4nwF+zhgAsOznPgbus1XkGzKIXAaYIX83aw0ZvO4JNw4s7xyTyfYP4HcZ
+4Bl8/TWQ44cRx+4mNbG7T72ZRVJuxI40RLDL7QUQBeXmb3q5ImfVsdt3V8m2oFcMw0dC1c2Y92oc
+tKgLKcBZYXKD7l92J6c2XarSOAIfaHWoMSQdvVyFs7XcSGK2bdvWqD8829ZEfu96lPwEL2HXZj6r
+iaPkgUOJmns8i0SJOvESikepdJRB1GvXYiGCS0yhO8+1ksAbTOZIUWNJTonQSpHmpBSDRtlCYUZJ
+ICkXF9LJHDcIoGMoDPMlI0lvfWqjgR2+gz44WvjWnUxc/tr4QavJGqgZYV7n/3avYVOAbMEf/FIp
+KDm9vhvAbfHSBnJnc9+GehJDwvR6+KnvQjfXbilkH9my9cj3GztzKwb3i7sPr8Dh6i1G76z++sLF
+c+aNh6DLhV7QVkgM7P4eyXZzU+gpusltEUMRx/Z+0VPRdktvTdCaI5JotqJvcEc5B18iXUl3yw7T
+41+AjP8UHzoshi6zhZHqcOj8efIFpH5HvttKN5twKszqLN4bWJyBoxzIuPmZM+T1M2fw3TLAq+fl
+5dlSy4JHvAJfg5AP8kOBzHsuC5lmN7E3zG0pDV32pCTBHKV1yCndDN67DEfm5eUhJx7NV3hEd3GU
+R4nlJ0K24iZl6JhPntrM5ZBh55PGv5hPnElTvgSwZd+tlcuZGH4o3YLGnzx9fZnto/LasOP+OnJh
+82kcxlnpFntd2Sa4KJ28RzcmIGeL4VkZIl6n+cuWLy+sVk4jtE+EcCuYDtE2SlJAYzLhvkrYSwvu
+jIQF5DWSbtwS8OTmjdMpW6rBUJgnhi57lkK6wYc3Csg98ON0gJ/cvAYzyTAH/CwVfkn+l4CPh1Ah
+wC/lwM8iT5/1Kb8BRRa0DU0yk0WlcmVRsqXOX936RBHIyrKtaZKpSJ6tq7A9O01ULmHly6VEc1xr
+h3AOlUOcKp4/G1fUIEZZCHFHxFCkdUTLaIBOS2NldDShUuOOrNz61u9kyba3YRfkyCmMugbkCTkN
+RjDBYjKEZsWBmuCJA+DQMuQEN6tCuPSgcwm45JMbhLzP4H4OYaXhYp48dYL7OtKiwPLgcnaOlQQ+
+3MGmChsIUHil9I0i4a4YioKZDHxoKAPT2YkpiTaF6bM6AabsEe4A+fkCxMHFux/evQRQ/QK5A7Nv
+dOp9Dd606RmzOpIL5BvwgzSYRD66eBE6k2nkHLkGEWDFbgUFCtM4td3qrN2IF7Ws2rDntS6/o1rR
+F7X/NTVpvNK+JkptP+olaH8ZLGw+zjb1hbik2C2sXQ5ZLyMHKzyfE2OYcr2gbUXJ7O2+joJR5Iva
+tavb8xon84q170UYOLSQ4pFKQrh7zH5GlBqDjQksGuTLmbjEaLCkGNR40M9M3QrJUPJqwC4DCPfw
+rAlui9xWtx2i79R6Uav66yCeWtRkumxTUEDug4Hrt3c++QC6jot6L+HTT8kphDi1XWWcjipnnK6o
+dfyaKuPnI0BH8Gdtn4cC4hUcNKnq2nCMSv/LYvJTCZV7LjrCZkXh/mz5HIzssshSZEFHgRdzo/NX
+T9/H7Z7LCjr6ZL1Eu3Q8qOw0Hrywca6RQ+fA/7ko2MeIV58nEDseF9g+YQQ6k0Vj0r2YBem/zduz
+Z95vOOK5KFwgs3/7DcY9/eW5OqHcWz5a1CNXZg/oUc8wunsbJJbcluaHMoaz89mIRnhoqsXNELVl
+izzos1b1jq0BG4k6MPqtn8GX/EzCyC/qsc5Tp++T1tDinbgF1SYuWzqnSSI5LV8lJ6GGMp+MQ0jI
+EfUoAjVO05mRxlVw8TD6e4a6aD2Mkr55pGRLPZ5A75uRUm2px+l1FmlaV0/tJh8cOAv5vy1W8jlA
+7+K9k5BAUh8mSA/pLXq+bPt98Q169KhbYrKatiQEjJQWIWdHZgu6rriPaPbt2LHt4LYdmRYz/Lnt
+oKhf2bp/5iJbHHeB/ixq+enmlVFR/TNbfroZYYov31TUI18UgNqmaYMaIYOLn7eed3Hh/NzE5oHF
++JJUdmmIC7cJSbO8/A/57fR62xUdEIBi+5A8lB7Su1jS3IuC1gUtDpoSJAUJ0B3KoiyGgJL/TcF0
+6+59FPM/9+3jMwrX2XH8ZDtFGnbdV3mq4piS5m1oBC68m4eLi8T7eDX3o/gp6FHsPL3dN7nys9Db
+WtcDnpgiRhhWSuabYi4q6OCmGO0jn6h827Fjm6j/84Ewwc4tyimmR3EIiTZRjyqjWNQlzT+ukSHa
+pYoU5OsSWMUVVRJdIkJdw83Nqxaz6ngC/W1jDDMEVTJtitDCLBR7qMpOCb3tp4k4EBBijEtNvZVg
+v3FZeqDKWZUnTTppZ5Zd6sV4W+mdWPipIuBOwZaW07ftyGyBd1GGqtLn/ty3T9Srcu4QrLNQdtrZ
+aycO33foLKPPjFqm+UU18nSR/Pz1LpIrChZdjEGulUzNLU60MdKYKvgHh20yavEsD/PbvhrjAe/A
+UIWoJ6llKUq2lhKBEzl2UrYddJaHSomDis3OsnGSj9Lnmot6lpVUyTdED/za4VNOJhj8lHadU9GZ
+kjj/iMg+4ZkW874dOzp1Ohh6sFMHmEDejDTT3ENt2sziMzL7R0VFRfUvrM2a69CxY7uDLRfJ6+ZY
+LKtat6KZh5S7QAvEXcIj1BkhcE4sVAno1UzqCX3nXExxxamIDOxvSgUIWmltDQPHtenYpH2j0XVr
+Vau2rO5nH06Y+HVGt16D+2Zm9prWqFFC7M60zc2b9x3+ceMWQreu6+f37lGCkFnxyQ0aJifydbdF
+a/VVLJ3SIiOMXtEr5qzu2lXrYzqUFBgUG9OmWZSpindE02bTPmzZ0T2k0qye3SGj2+zmJchtWS86
+AarFp9VGCNDmogKXAlGPuiAEfr7xjoSwWqtWzdeSaIk3qomMrfHWeN94U7iv3hpvjC9dZog3xBvj
+uZJpXujJRW0838qVZnI5epqeE9wqkHYrurbuMnZk587Tp8NgQ7JryoUH32d41YLvwJH15ehpbm+k
+0bZ4S9/MR3ehL3mPZnqBJRHBPdotmf5Kp+0T5laf3qyS7/jt0ZXDlsi91q1x5IF5dBdsTKe2qbTV
+RwjijWaGYYU0WsyUFqM23hjvG2+MZ29SStAh1CC/Zni1qOZEidSiRVjKBbiEH8teEyeuaNdJRV3o
+AzGW8APjWqj44w/lXgr+dS0HPn11PGmeQ9zXru3Z+z2oZbvOqlB8SUeGb81/Bl81A1nxscyK0bzM
+jmcqRzVfhKGaqaybcoiz8DuEsAO/OqgxwzCc4sCwiNfaWVZa8qZ4rTXe16gtQQHlK/0KUyRuHfk2
+u0c7jus0agx8ByudNOBGPhnloKJTR/Lt9OncXkX+pLmCr5PMC19ZjzsWa0vPnhvwBBAmzFV0gXR0
+f0fUoxb/DG/Z4Wi/ENAbSlJmMdeGl2B3ZmSXQUdP1qk+dD4jaPD23lkTJ457Gd5P9Uv45V7B2+mt
+HASOm3Drll0SqKgIzUZd4RU+w8csxCMkaQRf0hLWIYCOAuJOi5WQB0JgVc5zWb0gzAwdx7j36tQx
+w3OM2xry/RqxUrNmTZsvWryYwmqKuuL1DFYDBsuf3KWwUEuEeJrnzA8h0LF037XBqtV7CSZ6Dh0y
+jz7rluaC93uZ0/rMOzSODAT9UT7D1ub9EY9Tx/xBrry2d8fUYQ0tO7ntlP8IibF8BgpDiB568uKY
+ytbBKWCFOlyS1uqr9+L43TD+Z7lZbO+utXKbjB1fl8z6Ge+J7d2tVm5Cy/4ZMIC8jd8Wq/d4O508
+AbesrWNb+sFAeQArAY/fO64a2iUFIUDpCAkH+QykQQhMysEx4WDeflsloY866ihzLXreLITPUM7U
+US8nsSpPSUsI5Wk2AjFkn6elztD5A+RonP/m8WU9avkXDtoHPxMd/Dxw3mtpMd6LAut2X7Krodwe
+Z9jb/ZTPQEF05pIQyum9OAauDpdYlTMZ0/FXy+8t6mYydVt0d7kchb8esbFvbT+/2n03jpCj+Ix6
+A7JX7liZPaDewiqNh45eNXpo4yp2mKIeuSBkVG7/8gW8Dncr7PYpv1HewF3A9UnzGbYIZ3rYGbjK
+oDUmhGJfetaQ0iWGPHuXS3r/0vSkwk7cLx5Ga6chrfiM1MXffAQHbYfgzR7T+teu7I2c6fC2cwUr
+XMHCp/tD2s9/zXaaS1l/aVoS1ssPsH7me0MqL0pd/I3ih2QUPRX28xko1P5dzmTU0qOXliSlD5kU
+SPv3u0WkDW9NIiasSGpZz/xq1a1c/UVHB8fgHvJ6nNE0s0/NSPnclfPSIq+R72Provpz9yjwv8Gx
+wrcCb88IQBkifPuM4NiNOBbH5uBY+RLrH5SGdsJyySyEv4d8RCENKf2mpaM8sKVzub2+RQjE6TZv
++r23n/feIoQXNkdqDXp2kXzKr+UzJLPgz0ot6vf2k9FqeUCpcnt93xLl6QjxjfkMySKEkBvsjZLH
+Nr3od06rlhtZeWVHfYNaPpWVV3HkwZ3C4Iew0mgVfmeEcKha35319hiU4/TurtM7UYgdT8v3FRXw
+h/lBDlhx9vqwHgc76q+TNEI8umqng3Tk3lLf7WTfqmbHl8srUZ7gKH9E7+sRQkgbVm510PcxP5y2
+HU9Lk4r5hMcV80MShRRUjGsx3dUd/O7IZynlI2h5TXs5QvxCpXwiLa/lJAeBz3DCp7YdjqDle9L6
+F2hpHYQqoKtusd6w8kBcw+bp0Bd90VN+thOe9YrxgdeYnoUX7mFvYor1gpWHFY1w1gvarlpez4mf
+jC6m52HIQZfSt/kloh7FqHaY99WakhLrCCkcTWdq1UAdXjHGAjcSQr4hGcGm6sbAXH2lhNh6MZX2
+5346DsOaIFP1cFZWNS0G3iF9cLqXb1K9oQ3Jk31t5ma0SYjx9QRP/AppzsmbPX2T6w1tCB772s7p
+1aZajG9REZpGPMSf8LuSxVCNq1EkSqKwiOE8lXhofnEuNzAdKHpAPGAWTpfMhkR2x1M1dL1IVMrx
+JJwuWZQSSTQkIVQCfjw+UC58tdwJ/q9O8ONRZgn4ZkOSc7kT/IQK4Cc8F35CBfAT7PCLHog/8YEK
+H7gZxfCLHmh+cS63w0cIZvE3nfnDN1fK8ST+pgO+Wu4EPwEfKAe+2WBV8KFvVfi/OsFPQJnlwlfL
+VfgrJYvQHGbIG0vBX2mHr7xV4cQLvezwheZF0WS4Hb7Qyw7fXo6mFm3QrBW9JIshThjHT5JE4VMV
+/gcu3YRGktmQzPCJE8ZxexU4XF3RSzIbUpRyiOEnKeV8mDBOMhuqq+V1uHsl4CcI44RxFcJPEMbx
+O8qBnwAxwrhy4CdAHf5mURF6l3gIUVxfJkcN2i6JQleE1PI6Sjl+H70hifrVqv7U4btxcZJZ/64i
+R+xZVF0pF+qw8jXO5U7wq+K5KKAc+FWxuehuMfyi6iXgV4XFCvyi6iXgq+VO8OPxcrS8HPjxOAAl
+VIh/PCwuF//4MvAT8PJy+ZPwXPgJFcBXy9Eb5DIXxLWlfIBoclkShe4M/hvkMv+qc7l+g1JedJUL
+4nfQcvSMDyyuX3SVf9W53Lm+kEP1s2x95/IS9f+g/aXoWJFYqr5TuVK/aDPx4GawduPgCRcmiUJP
+ezk/id8hmfUbVX1+woWp9Y8KcZJZ6Kn0I3SA1LHXF+Ls9e3laFrRR5rKNIe+8Jm61/YjzTeiHqUg
+FKkkK4zQ0m2IjmzLNCACShAEfAWRRU7AzxEFiQNRWJ256dwnR6aQvXVz653p/3busTUmKZnkJbiZ
+svB9eeurvmIl/KqfOTotfUa9mv2MvQLGNU9tYu4N7nO79Zg96ZXw1vVSAqs2fAUm1m5jMo6tAsn7
+n4VGuQeERcp1A72kXP/oyuPSVFvIfUlx1+fbbSM/mT1fQchOmyZashjacHnMb/jMUf6NJloy66MY
+79hbOzxWXpnWNrQZjorhsvIqzuVomrxRhV8N55P1kih87ihX4MeofTWfrKdw5Ksq/FilHBqSy0q5
+Cr+qc7mKf02qc9wMcUMxfIZ/TQd89taBf00H/Kqomb2cn6yp6YCvlqOl5GOhMX4oicJlJvulxEOM
+xg2RGSEqUbtMRU2FisBfjwnyS0hKbTbt1XCu87hfenV7bQTJjcut9nmX5umtjjUz9sENe2W0Tk3L
+jR+y9NQrQZGDIHP7gjm7HyT56+ML+gzPPYPseAh+VPfRaRKp4MPKPcRoPlgy66urOntajv4LY8ji
+ooNCEHdbEoWvEUIcWkw8hXC8GbmiaJSMUGQdoJsqNaJvpEKgnzXemqTcU+Mr0t0Afnp2Q0q4xSww
+6mn6SRHvrHu+fbOmZvkHKddror7S3NeAb1DVl+5l3/h+1YbkyeOaI45io7l1w/aHq+jWBm1IHL6g
+8ejApsl1ohqaU8B18qDM+iNf7507+OYrmd//UCkpbkD9BcMT3/bQqHb6IHeQ4qy/rTwTT+443iyJ
+euVuy8VFp4VT4hVqF/AIoZEkCoPV8lPiRfGKZNZfVuwCfevQjcGSWZ+vlKNmwjWHbtDyKyXKqR1J
+4/MlszCC8XJc0Qb5a6WcT6bl+nuly7k0fqRkEaYUPSF1JJF+z1HfqVx/z17OpQmJDvhLih6QIHt9
+Wq6/V7qcS+N32OvTcd1u7/hkfoe9vr0cTbMR4QjXVDILI9U+OFd+qpSLhzFmfXau/FQS9T+W8DuL
+/ctEhMrx29hbBIofhtORJ7WTavLi2mD11UN043e7mDJjquV27JgbU23gN998ipT6FA7Sl66v9jKI
+brFC+Vq1mMyUocvtXx5YLSZX8XN/ZfSIlB72/IjRUYz/ZcXv1LdR+3imYlvIZQV/fdvicgS24SKP
+kGYockFIG85rkpIjrPF3Se07D+DED+LlfgMmjR2NEAKERJ5brBmKNAhpk2jSNnPED3DiwR1SW7y8
+avTYSQNYrlmQq4ieCGksyBchbTyLl7Mdbyb7LXjx/OLgVjERfZIaCev5EcNPd61bvZPYGKe4+7hF
+xL86q2kTL88wpT1PfEVjQTqEtJVAjcGzS/VYVF6B0qvGq3wxlAcKlJhmdiiUPy78ftxaXCVphNeK
+YpDDjuPB4irJLAxAyP7GUVeWNMJopS4CNAAhHAODqHyhOMZfG6xgrBaT2Wzawm6dcqF5SrWY3G6d
+FkSrbRI/3Lrod0kjjLG3qcAWciSNMLYkHtQ3UMokURig1t2n4jGuFM6yZBHGlaxL7uHWRV9LGuH1
+4rrkHh6MciWL8Lpz3aIn/BmcJT6RNML4UnCfSBZhvHNd5M/fwKkaUdIIE0rW1YgOvk1Q+fY+6sCF
+ct9LotCK1fsYIfymZrpkESYWhcAxSRQ6lCg362ez77O3RUVoMELwmUsNySK84VyfluMZLjUc9d9Q
+6qs24GNW/1gZG/Oxw2awtxQO8YDPRL1kESYVhSBUDJ944Bmi3gGfvbX3MRW+YsOc+hr3qWTW/6jA
+R+lyA3WudIG/a+9zwhvO4xB/197n7OXoKELwOvO1OiB7vuwZop7laqQxaYeaHc1lOraqdScYxb9X
+OIgagW59VFzWq/PRWK4GWV883yLr2XzUPp9jb1W74DyfjkXXS9oFQ5JzOZpXdJJbx+aLVdEzNl8c
+zuDTe2h70Pmi/pY6P+jBzbDP/7gwWh8fwHNLzv+4MAc+7K19fsndc+BTFWVybe084+458FHLneav
+cWXhO81f45zhO81f45zhO81f1XKn+Ucspyln/mTWr1b4gw8UfaHwrcT8IxZ72vlZYv6hljvgM7gI
+7HBRvHIzErtA1KJ40X7GhBRf0RRmMVPng97GphE1SRHWMINvuIa6Y4JfVLWpE9rWFpuM7H296VRo
+cvTy8mekx6bplzPmeHj2nD+rJ0hHwXx6SKUQa+MuubnLs1Z77/Jv/YltTo8NY3Izjy2/DviHPok6
+XeXcusmrPLuu6zkWqWMTpYnFcSk6Zk0SXeHkDb7M9XMk509J5tq169dhT9v3GjdbvwXwtebpHzZd
+0uy796dkFUzt/1oPHGIw6CSNmKsBU2LvVf6JvV+ZGtQ6t3HNgR3t7VAesZ0uDHjxtMFSsp2UYjTw
+JMDX6jVJCO0/8pWOFyZF1WpY2anJPg58OI/E3qv0lVuGj83dmNyraqS9ZZMDJ4dco9h8KhbX5QNL
+ypvf4STvunyg6h9145s7y9seZxDq8M2d5c3tVeZxbL7J5nUIlHkd15btaFHugHUM+Uq+chYrV9xp
+HF5jb0Gvtm3a0c6/tFf3L6vG9u03qr0xfIvJBdcdv7LlnJmjm7SLyZ02xagx51ZxrTY8qEqDEnPW
+WGhYzpzVrN+g4Ejfqna0NzYrc0kEytwQm5E/QmB1cvyxhp6ZUXEblxvKuTWp0qfZtGkJDQrJLXII
+4s9cNblh8/DQfi1icuuQH998O3fK3PM1G0+yt8HtZXweUXreyu11zFvZWyXO5hrI5iK9lHko8XB7
+FT9EjRCK9EtJTrIyx5secCnFPqY6dgUymdVZisHuOSTTKamVPgq5LeMjc3VpQxfmMgYvGdNgSGRY
+k/i52mo906rPsfi3f/No63FThpCfB+QOBljas03vnq/3SAOQ0nIjpOCaH+OJPfPOJWwCfzCTnF7t
+YnIzuga4BjSasDZUj3Hq7kE5dQIh+FVo/PueL67tNvtHxq8ZvPCN2j+ubpo6Sblj8EFRgeiGH6Jq
+yty6pBdoTVCpTElmVGqcyKN/YUp09eaZ05plxrTOjYnuPyRGpaVbs76Rpropc3ziMxrU6904dW4t
+Y9XcmNZZR1anZWWO73fsXEIOYPCjOJ8a0c3fNaD2jNVG/2pRCj6aFPwQVUdIKNGW5YXYOWTAyyVa
+r1w+knaG6x3NN6kYTcbaLnadUGIf/E2mQ73sMV83Ra+3KLacvmVzpgLRjZVvVcrxXHu5JoWVb3Mu
+Z/B1+I4kCpnKOTTi4XoD30GpqAldU6MbIfQVaFVSooU9UluVrN7hZe83cSBy1HTTmtSMiaPJA3Ni
+bNP0hyXVq2/X9dFGy2bwcosaNWHw0sbpb/TaX8VLqt222bTp/YLP+jSftHHJpz9P/RTwnMjQQGn/
+lHdalFSwBnMHT1461zslKuGtwT0ahQOOfPV+i0Ye7jG53QLH4JQ2g4/V7dpolk5ACFN7JSbg28iD
+3QShIEc3q5jCOXb9Vwl5q3RZ1csMuPMMkf3zIuOb7ycPmrasZ+m7RRGyT2yfnisXN+swatD+3Dy4
+Y29R/ryKqfZcKt+RLf1bJ8xsP6eu0dLgwaYh41RcNDy+jaJRdZXPz2vema0l8F5B7pWHyoghh5y4
+eL0Y9XJRatZY5Vcx8nYdc9Up9osvZLqX6Si/wezXHsV+8YWKjlH+svKPlXL41l6u4Vn5XudytBgh
+8RrzBfsw3VtM7+hld85VRqgyZqudPpHl8CDSrlbUPeDT5MNkCQzL/Qb03RqMHnDAiXL5vl154luP
+35krdCv0grqZRkvDnxw6klu3e4NZOqHNOCVXPx3TLot65MHuG3BuR1cZSmEk4hrO4EfLR8lv4G7H
+Y39ungM2ecu5baYCqj5u+Kfp5arLh53b+RfodZIB9f2+ZGN2H3s5lYVk1h9Qfekv7T4k5Y9k1h9U
+yvEpezmlRTLrDzmXo9HEg9/FV5FEoR+TMXvmvFAkyxrNLqRRtramJKtM1YQCpTvMYvcHITkx3FfP
+71pBikjXd3t/WrvBG70e9pzctUaPBns7rFtMfn+6qvIg6Hn0slxt9hdkWOaB8fDW/ITXqgxNi49P
+G961fnRYWovcW1MHDa69ZgxeXj3DGqH4YdxTvgq7jTshxaz4m1bfcFEx9JG+Ah8NSanv5TVLTD/7
+RuHV/XXaNUppCmGkG1m/Kz23u3+trPng8kaLTXOKEhsZp+ae2JBrlyn3iPNCESjOKcqRou6dUy+Q
+Z2Ta5YuVnXcspvzwWN6YjEGv1Irq1SDUU+rf+WHn/pJnaIv0Sg1qD88Yk7cxMTSuX7+4fftyoyIs
+HbpYYitVTkmMjU1MqVwp1tK7RaWYqFzcQeMRFtKzZ0iYB5Mj47fQiPlFY/kdihwccqG+23G1T25y
+rB09pWs++hNq+VjHms8jVv+kWv4+t9e+diQw/RF+K702xTd3rB3FCr8Vr00JesfaUSxsKl6b4ic5
+1o5i4VO2NjWIvCq8w+BX5doy+IsZ/EHETTzCg2Q21LDHf7lBJeDXVMohphT8Wmp5de6eI4aoV2KH
+CKuxQz0yogYIga86K1FE5nBUlVT4VJw66nZFsCQFvo6BsaR3wJ1vam7t5dGgb3rblq9UbxCbWiO1
+ZVOfMMDNOswdsuTLPuRbKdcLr3t98vzOK3o3V2a9K0es4Ye15b1CjcE+kpt3+tAqFvd0t8DAULNW
+Co6r2sYcXThcj3Fo+mfNkmrGdKtqCorr0QSA26nMkydMss816NoAtWTUdiVUsG5BSYNksheSl8Uq
+axSV+tvXKEDXt07P3P4REfaliDHRxUsRZO+ECSP6vOOYP01m+42f25bzGknFrZZYGfHVVohA8VpI
+1aB3FHu2iJwW/Jk8h1C86LNoYnvbUSTb0ebsOjOPL4IzO4X0eZ+WigiGt/QJep0UzuyQ2ZBcA0jL
+rfdhVJPWQ7Y1mVTAD1TYbK0atfOVcL+u4DO326hRk16RwpPsvIDtSkwDnG+3tsL2Tq1X0ZG6Wi6M
+2tWnGw1v8tn27+D9or5EbNQZXWjXYoXyTYadGhvlsxVMFNrnEz3fA/8qicJQpsvziV7oh19DWpZt
+yUlzlc2+gurpKvuO6dZW+/7e4vkP7j2+eYxvkuhbK6pqraAGcMTb881mHy9IaBZwely9Lnh9i26N
+2+dEeUeZBzSbtn1jFb8aNf316VqPoAYNojZ5ubRuGdwysBVZCkO29k2pErVsRt2IV3fXj2H4UltZ
+dI77EA9Bvkw6FBeFcifPpxhrmrJd5LJ6Lf1iaFr/da8ldhL0s3q02dG1l/yozto4XWJUlfSqXb01
+iX17x+e+Wu3j1tXbd85d1LKhT1LDZubKqb0iWXtf8HvwEBSC4kq0l+Jo0EF3aW6xpuddUZqOiaZt
+d2/8ylGtYI0Y1GzaDqJnOAh+NS2WpMoJKhoRJopHSLuPqtWJyT1OUdH5UOYYe0Uix5qPOj+sy4WV
+XPPBB+xrPvQtNtv3PXD3aH10nY7V9vi8GjcqLlf2hbA4Fv5eiV8hrMSv8EPkhiLUKIeoEQ2hoFMm
+5U6BCJO6ARpv8Pb09BjXdhiGPa/xXC5E+FavNLzZtC5NYs1zV1evefDsEAisUi2hj0f9znUqJ8AF
+S/TGbX1axeRaK9Vq4h2/auvkBoizr+MgbxSLaiMEyXaJKsZVk2AfHg1syznvsLZUPKCOlnZ5wLKI
+cIu3X816Bj+N75+JLWO9fWLjW8S0+eFrQGleUU23T1817q05eLPY20ObWO0NrcYYAp5BaY1rV41M
+jI02hUaOme0+oGHLtPbturhavPY12H3h+8Qa/WqPGFw5FxpEWpYO8NJVrR9qTG3ZECHE2decGM/i
+VI9QWSnT+Djcc7o8pqJMr9ezL5BZzCZop6uSMZIukeW6Th08dW7Dqu9DOlsbmzt18FTX7dDP3LRZ
++5EZVXKbd2FrY+A2ia6JDRucO3jYyPqZg6aAS27u6MYLhid2ae5YM8IzlbgvwkrcF89Szo9FMn2l
+2mrwo+ctlICAEr9g0SRl0FL0HC+Q/Kumptb31KbFwajfjzUKmNpuwBGyFAbM2FhnTOw860A37Ba0
+o1a9Tg3f9PPMbV6jdWjlwJDqzZKj+FxYPbVdXMfc5j2XcRr/6M6zKtV4p1kA4GrdFrQrvU6DwL5O
+g1IQEpKTzBZV2gr7VJ2zK4PBr9yVVr55V4gLCY1JbN0+tMroTlPCTKaRwwcHRnjH1mvCcSOmXO3c
+/8Bo8m3d3HofvrK7a4tso9UY7ekSVat2vdQeXuLM47lbPhC1pvig0Am9O2ZU8qjcD8SVXbqsHNmy
+kou3fU7DcKZ7IZ4QD6e4t4e6VqaW/5W1pqKioqpFD7jD4s+SWf+bEv8nyyFGKeevsvLfS5TfpvU1
+7zvqv1Fczl/VvO+or5YjA/HgDot6R/1J5DTD30A8+Kui3lGflSNA7Rk+ehbvklSWxzPvJh6srIdq
+rdzheSktzq0Z2Cyjw4jZxG835IToI3O5V8jlqx1e3ROWG7aCb2SrF50yJxdvU2HStph1KR8iHc7s
+BygsZi6xVpO890qBD09rPWDq9r5aSO1ar1Qbtgbb2q/J/bUyQhwKLWrv4qdpzs5OVkHIlfe1JlmS
+Epklr6tYGDZkKLe++OpFb3YdCR/I2Wy8jeeWnxFF0UVniEnvFh7xQ26/oz167W41eLq1Rpcu1TXN
+WRWbvI2QpRMSU30DAitFd+yS1rD52mPVaxyo32ho52o9kjp3nNZnlZpnoEDIYfeuuSFEN3hrOCMH
+Ri4Lx9/B8RuPyvOPggg//mYSdj1rmQa/EzecgycqZ+9PF/3M64V7yFXJckRvbVNwB8c8UL0aXVf8
+amJi4djaJ7eT72dnPVr/xVdXRm8mB7Nm7Bn2Y4+YB4fxJGIaWeuVztlcJ12vCefzx4+/tXD4Bp2Q
+SHyn1K7ef6GytpdVVMD9KPyKBIQEVzC4AjfI9ienmSyPw7NDOBfOZbI8Vh7Lxml0GiFeFC4iD+TL
+bgmhN/v4SRqRN6bUwZKlKlhOd4EZa9YGuFmqk33kWJcvTtVz8zlAhIvjxv/Zh/SenLv1iEvmZRjf
+bcsSNwQUHrdaeMz8I3pTkSZS/YGJe/DlYNmGw5RfkEzyhMejd7Ujn6UrvxBy+r6r8/edvstVsV1x
+fA0Bogfe6R3k7uyELU0cY0yyak3LcfrFi/LGixeF/D2FS/fs4elNJpTW6uz+M41Sn14cZtQKRq3x
+NDfcthgfkJ9iF/pD72PhGsI1ErGHmBBCAlpeVCBuEH5F3kiPAlAooqVY5MMizFjySY4I4/18IBml
+GDmt0Q+BUSsi0BrNSNww/jy5Tk6S6+fHjz8PYVATws6Pl9sdA59Q4rWHeIXSVJbw+Bh5iGfD41Dy
+ELck189PmGCvO2HCeXJd9gsFn8+IJ/z6GXmI58CvoeQhxBPPz8BH5RlMZHSxu1pO79lD8Vd04XRR
+AT9d+JVqr5HuiqXqoAGYuGcPnj1Oroq/GSePEPLlG7j2THmqPGEmro8ccigU8pGIkCsYwKS1wkQo
+miK3mLIHrBhNkVso97ecRsjtsvAr8qBtu3IGV05rOv0ano6r4zhcdYrcVr4oX5B37xHy5du4hnwB
+x02R2+Gq8kV4WNhc+b44VvgVubDvU+zs36fftX9vityO1RdY/dVCvlPObcpoLaiypP909HkiXJOH
+C5efxXAN4FrhMri2B67hxSQCrnENn0ULRvqJ/sjD6G+lvxbLuBKyIISSE6vicC+sD8UJyaWFzYvh
+5sTkBD/hrR491n7/6Pu1yh/YWa6832z7Wtu2r7WFZT88+mFNz55rlD+QXlbUX9F6bR0y4O8K95AW
+IaDJ3WjSUJM22RqGtJLARYE7uURuJqXsWT8JesO7ZCru3Pfy3j/FZ8eFy3pymDwgO96ywxGrKH3E
+qqX39WhNWlfQnt4DMsh79hCOcEK+vB73eBbNH5Tfwz0L6zPbsBwhPlB4jNzZHYaJVSHcC/ShkFCH
+jgAalsmxKiTWgYRQ4Po2fuOtNxrTX7bxfdMa73m1Xv9xr3/55MvXubtLJzZuPHHpG40bN79QK9KL
+XtnmFZl6/smXr7/+ZbENaiDcRx7IgJAL+OqxRjQxSrkwhzuJv4bBp4vGzNuzftJgcH1z5ryRh6Kr
+CvfJbdsCcqFlBOkm5Ottw0aNXL5mcDqv8G950WP8WPiR2hJXTuPKGWAZ9CDrodIUksk+vDuFb07W
+k/Xk5hRYAz2gB6yZQu0nQrxNeMzOZWjo6ZnIFN5WnSTsIeHV+blbsgsX8FtXbimW0R7hR1bXQC2W
+VcsnTrHRPvjjFNvn8jkhX+7GcCng7zJeIldIYYqucQULbkMaLoe3YOly0hA+XU6GkKHL8c/4/d5U
+DL3l7nJ6b5wlr+6t6CdC/E1251wQiqD9mfpbdCk1yeqrWG1NAB0eJYsZtGp0g/PbkzP1vfembFb/
+ZDQbMqQZecD+nA2c8h6cWj8l8MyZwCnvkRrrpwRys+R4Q/PBkDC4mdwYnzc0H7pi+dDmCk9EPwV/
+o0kLKSy9uQG4t/fIU6dA0R5qG6biJ3jSFIKggIRQEzGN9al/cOuFfUij3FeNEs305q2ECDGMYU4J
+SA5jqFO3VX2LH07YNmHCNoi7TQ7dHhmXkhI38ja8on7ao7wLo78nVLt9G14ZFRcUXHUk1L99mxxS
+P3/G3iq6xV0X7iE3pKPan2xlNwAbA0C50Dhcc3rG1F8hjGwiv+bd2bO+SryQr7tz8Pw1uR/XcvKY
+zNUihVH0M3ddyEduSM8sbBgFYQq36OK16mXNp/eAJ3Qi13+dOmNPfJX1e+4I+XK/a+cP3tHhWcRH
+XJ05ZrLCQ3yA+RJIl6TcmpYFG/bswUv24MXPovESJT+MkCM8RiLyUk41pLgCstuaHPn8Hfn8RiiE
+QrJEMSnU7RB2pT27Kpjlr+22o6jIPib4iL6i3SdgfoyoejGuoHowRCCC4rwI5mdX2XdVXfURffXK
+dxESLaqNpqMHvQQ0C5/GhePlb/cQiYvBp+RaM4AUpvPvEm/FlubAdXEa3ogE5M5sdLwxyRgvxCfF
+G32N8Ub6Nx5kshXacQnQTj5ItkFbuH6a/qfgnwO1xWm8u2Tx7o0QV1/S+IYhVNRdfZciTuO9JAsr
+S5c03pkIcY3UdzXFabyb8g56Sxr6faipvqulvKP1IVWBCb2d2lO/V9S95DuExGlCYwUXfopkpn+V
+J5iitMCVV89eQ6EBSaJ3Zkl4tA0SVqa+CvFFbXNIoRymlKiXWdwm/5y2netxyFFfo+BUoq7Sptk3
+DK0rrlcOjmbvzJeqV4Jup7ZF+s3SOHLFnFbw0Ci1K6rnxMfn8tuJR4r+oHJoLuZSpsrv0m0rNBe3
+nFmRXBxtlwuxZNslOGivKdLncuqVxKBcWsqFp0q6NI4Q9kJ+l6ZZ1Vrl2+XRXApueTSX1lu17fJh
+Qlh5VJflN4SV6WNqTYTKq2tvm/GrLL8rqpdZQb1SPaEsv70ziznopOes5XLqOdFs1xyY8hLwyuvT
+xXUzS9mol2s7s6K2S/XtcvldCs8K+V1BvcwK6jnxu3z99s500q1ibcwsY7/L0PzceqXt1Av5zRVb
+lor4XdpOlGu/y4H3vLadbBqFSLn1XJgK/c+p52zT7BBfzO+yfdoZnpOdqJhm5b29/nP57bAXii2r
+UG+VNyrNz8HRLheVdrXmc/itaOXz62W+VD17myVqlrY7eN2L/RO13kv5J3id8peEPd8/Kdt2xeMg
+azvz5douVa9cWZdoW+XOc3HMfKl6pW1ZGf12xvF59rvCei/L78wX8juzIhtVguYX+Ccl2s58cduq
+va3IPylZT+1ZLw2vAv8EryvHTvRGz6P5uf6JM812e6J847n6+Fz/xAlHJ6phyvNosduTivwTZ5jP
+80/KqZf5UvXsPYtDEkLCNRZjc6ezUHqvsysYOSNnAgMYue7n4OE50gnXJN1WyFNWjsU1/EkBri6M
+eParMOJZNLxHegr5p2x/IIRE9DlCQkt2B7We5cGMpBF9nTGBRb+1et5kZGFxrdnEaa3JVq3ZRCfG
+kJyUGEd/WT7Hfe8+O0LIo0J5Jiz+sZbVWuvHKxFhYREyycOm8+frnD8v5B/Zebt7L3Lj9FV8X56g
+wWM1eLZ8jcOV8LNfBc9CbYNrtxssTz53PBnx6B5CopXdrS2xSC7SGWk4DVUG0Cl4GLVWDpK1kuUe
+5mAXLCf9SWtZhrcg87d1634nw/MAn/zhByGfvE2mkElkOaW7Hj+vHmtrUDZMgBGrlbjW5yzPBuUj
+yz5i1UbSjFrqD8TmkYv8ODmQ3ouOnxaOYfzzzMqSb2XJo7LU7/MP2J3hnkr+SOW7NBj7OW+Sh+E2
+8nYyFKrm5Qn57GveFIbS9qiiAmGe8AiFsNOO5ghTuEarbGFhd4+LvImRbUzgDSxWpJUMfkLWZGJ7
+89NZsz5dMrlPR9IW+4wmd9aR9WQ0zIMeb4FmmK1tcOCmTYH8rV6vphXWSjNXSoK7WfLkdh1gJQyB
+obCycaPCTL6JX+B2sp98uj1QwaUvQvhQKT44IvVQNY8MxelZWfLGrCw7D579mpUleCKEi5aTvrgz
+k5eeflevYYtNkUatJJqMdD9BEsR2XFy3FbkMi88VkJ/rzhwqjHi283GWW9MePwquWc+WksdPspYf
+U/kpxrM4jheL/lO1NoHWSA+nfA6LOW6t7X3urZW2Y2R4Vp6QTyOVcprtJ/ylXE1BTJVpgpCPBOSq
+rCD4ql9/C39V+Db92rNFWcIwVhshDh1HSDOM6T/VN2TUggk4I72F3ciuB6TaLnxHer5KegMZCl/D
+GzAZviIX856F5AkjCrfxbZ8tEvJtLtzTZ9EKEipoJKCRRQXCVeER8kYGFrGOor3Z4KeVNGzvnVZK
+Sdb5haVozWEajspYMviBVo0PcmaoeeDQwYPkJDlx4ODBA68OBmnwYPJo8ICIsHfeCcPfmyq9806l
+wrPCo4v5ZB157/KlSxehF/S+dPHZwoQE/quEhMKBfPuQyL3y6o8j+aTC7SERe3Hm3gj5iSLz2UXX
++LXC70jLsuCJEks+J2mSJXMcVIXESF9zmKhXCvmwyCS/sORE9kaKCIPWWbO3DNu00Tx1dc7QHWs4
+7y/IifVDlg3N+5xcJzpS+AP02zR+7rC8T8APTgqxXUneqsqBln2kqGU6ZH1qCbK8W7Bn+Og59wdE
+BCX8RNIf9p2Y/ZY8Myww7kuY/4Tq1DOEhB2qfXPql1ojHnnadkTYKz8kw+UHDl1cxJbjEYd2FhUI
+v7IYmie780UraSICwKiVBLOOqqSe5vPUGtn9LFr+ZDoYC2eCsTOQS7BkCoRNgSVki9yXbBFG2FaS
+O9N6Qy8uzXaErMmYCJW4flm2pEeXLj3izti6kWvkClSBCISZLf6E4eqGkA6sEGmkF+4bV8HD08SH
+bIPF8A1czZJPkbMqwoUG/j5d/eFQA4RctqlrJXoaTbSCUYWgAHEFI38cGpwmBwqT2B/yKSymt/cr
+v7PIHDKRXuJPphSrXha9xb/4Jn8q68EIiaK9f7vacWSNmACiuG4nbRvIJMiFMTCjN2mOa5LGZC6Z
+mAV7VZTlL3CCPMP2EU6Qv8AT2N4HG0LCFtbvvRSYbJGH9VcrN5RS/IgMlx/BMsghCafhbB6nw8ds
+j+Ua+BTnZXuMc+SO8ruIRxOKCvil/ASkQeEoBlkRgkSzxc9Xa9VpjWq6NJoHVF1RTqoD1jAekqi1
+rAMpyYLWC3z1fnzc8trNZmeRHXUPdNxyRi48P/rtjL3tx/5QHUIhkOw4nxzXo3etmqRf/dnj8eRL
+M/27z5Y74yB5ak7fXkfXHzzSePK8qlVfs1hsZF4HXv6Zb9n41Wsg+/SbN/Y1hBCPlhUVCDpRj7xZ
+1v9YZlfo0jaSsCncbPE1At37oWXr3yzurm5bUjdQLIOWNXdOpCfNz0zcWZPs4n16xKSmxtTw87a1
+nQl/BgR+0KyFn1fP7h8EBoh6eX1WL3JAfkQO9MraiF9bO612mG18WO1Wo5pxV59tEdqTLG5A/9ea
+rlk7bRqn5qzl7vITUCAyIRTpSzmmjBt+BjW1pDKsRDoWHO62+KC7TK5ALIgd1jdvOOTVpumN67dp
+27gj8Y+JmDA+YlFWRATUAS/wgZomU1Ylf2+9rZNO0gVx7QIjp/x4Z7q5XJ6w1T0mMSwha4IfsA0l
+Btos44m2xK4u7gS0JLsoW4oQZYs8xtuvBuVKDy5qpjw1IPCD7j29/Fo0+yCQ24t7/PlgY1YvaIAl
+aNCLdFnTbFSr2mHczLDa0/C7Ck9sydy0aWvXNH2t/wDE0fya/Bv8BKRD/iynvlFH26a8YWOqNQH5
+gqj1MeiMVcEUruHfkOdBs57ffDbks0HkCTkFlW23gZdNJ+HEAAzc2U1N0zBPevt2Hx1bGaqDG+ig
+Prn6B4wYT74lvy6ES4fuau15Xzmas9CNnbai/NZa6d6+SHtaU2bF1CSjVqztV7XGLD8f+Me2ZTVr
+wAwykezcsaNT+gFhm1ts9SWFe/mmPRtUn5dVI+WtrCxbIHcnK+tA+46U9yNIR/4R6zMmVJXt6tLq
+NX7JAQBJLAtfbSgWgyGJ2jtjuJnTq9LQiFB8iIQqCC8OFH6yDW6zpu3He4olUhNakivk3Mi81+3a
+CnHOqso9s9XOmy+8Cz7csGdvT4mpcvDwCIeQsjbaYslNcj+EG7t2miKrVqOaycecdZdD+xESGjN+
+ebGbPOlWX8oxujxm1Voc+SaN9nyTRh65urw6v12S7disn6BVk5NTL39LtgwaNCWb7OQ93T36H5KX
+4bSjXWvC3md7hOZZjsSS52k6Q7kD4tAdhHCSqLfvMaANcVZfk4b6bU1nrdu3rxm0Ijs5m60/twL7
+3z9TuI6NLUVfkY7Yg+XG80OBCAlak+LraEzqdlEjPTJFQcHGWZGvRtV+8l21wRGR0KpN2165uX68
+T+H5j9t7xzd+khVfbSW/pXD+lYwMzaH79tyAQoLKh9J+z35ohWfMlxvjC8vlIWRn1ixRbxsABfLv
+cg42yd8JOyihCDO6fhT1qh9GZU0TwupYXlg68ll9oWntqFcjZ/22t20baNV8ACxb1zjeu/3HfPyz
+LhkZV/hJhU1X98hi9m4/Qi6z+AkoHJlRNZTKfFsmCkMZgTg+UFwjiwXo9HG/Iqh3g9p/XywuVWhZ
+s3BXRaK4QPnLZ2zrk7Wl6ZQxDrltLpafIhatk6B1Tp+RQ6f4CeXoVCTTKd+/rFOYtp21rZRGsf5I
+84bzE9hKWhC7KSgBaSWkWGKdH521hJfx8PCoR+BDHj76B/kFtNOs46zWcdasRMvw4Ra4ao0aPjyK
+n0BOkwfkZ3IKaoAO9FC98Ct3d26/u7ttEDc8JHYKiZgSy7W1vRUcOxW+nRKLkLNtcNjlSGqXfYuH
+KzZWKaMVWyjUOm3/sybAYbLLebjCc+122fb1TDzZyS5vlLeJLhsdgxV8qJhlOlhNk4cJjZ/lwmIu
+r9gsO+EmsZkmPYHsZKfik2gXpGjEq1aKTj5KWqp4bn1JA7VLTrVbJy5q5kyicbZQG53M0Z9PuWVO
+lsiWIDS2NXa2Rmo/5H7gJyBOuUfPoS6VwLcqKMoCoTN/hJY1978Z5J1YqUnM6o/ILkUps7b1zeQ0
+b/pozxySxyNMxyDuFD8BhaCG6ljAEjwrpxmSS6R15pjRTlL8a5rfmo7dSoN6NSe0SP0MGGAJbma2
+jEj8oPYrkZEhHaKrzrAurvHqpXbrWg0c0XxJ4qIx17/ou6d50249hw1vPc763ow/vu7y2WDuT29D
+7TF9463hkrVW2oS2dSXDK6MGW1Ms3lVS6g9rbyMp1Xt0aNkqRop5e/aHH4dVGl8tonN6g+Ym77g1
+izcciYxEbO8oN1tYgzQoCKFIcwrNV+qnY5NdeoWnrzFcST6thfNVuFnQbTHNJd1s1EGyNX/9rFkD
+O0zkimwpW1sKUWRLltxhTsustRfx6Cxor/jst4UGfIa9v0aaWSJruleRmliD3QdUbBjN6WmEP5X+
+ytWf9RPZwvortKf9Fdq3o90VD5aP0O7KrbD1V7or3iDH0+6KNytyro0Qv5PPKOOzn4PO08n75B/Q
+nu41ySLdyGVVuLb23BY6EnCoI0LiYz7D7rODzsoZdVYu0hgExiAKxB2M3D8ezXps6/1o1mPyJ7S/
+cxta3rmdRb4lef94TE4pEBWoy7mB9KdwHbfcNlDBrTlCQjCfUYGvrsOb35Y7kPWwGEIgZCL5AG6T
+4aSAFEyEriqqZCpMJrHyKJhMpsIlhV7Skf+MwfSh9Kao9HqBuuP/IuyqQ7LJHegB3aHnYKJv/dbE
+pUvT20RztsJvV3Lzs2xvcwMKq5+akFWfpu1keR17M3iedhx9qXOTBEaumzxmPp5rm8P9Qx6L5xT+
+jufIi1XMsmz9s7K4FUqsS0JI6C/kSyKKYM9qLEUSkbI/n8ZHLMIjSURm+3s6J5REZHHUn8eeoxzP
+JvZc2fH9eez7VRzfr8XeR7PntxHCv9Nnw2T6TOMYsJS9j3XUH8ieqzrgK89x9mexEnuOd9S3sudq
+jva/Ye0nOL6/gL1Xco2OIk/V94mO77dn75XzA7OKCvj17H0yw+8ZQvx99l7JPbqrqECozJ6rl+Jn
+DfbcACHNh+xZ2VtR20F/LQbPxvau0edU9r4bQoJCj5J7lM7LYvkJkogaKDk+iwp4esZQpPasqMg+
+75BE1Mj+zHdh7191PE9m7xuz5ziE8FP2vonynnTku7D3TR05RLPY+2YIOecqoT2iqKjoW9JRfW7h
+qL+aPbe018c16LNhtP29JoTBb10Mnz23ceA3mj23deRs3cCe25XCr739+1xT9qzkcFHtuyRSi1BU
+RKWCHwtrJBF1Ys/bigr4dPY+3c5/fjKfIYmoM3umdiSJPXdhz7UQ4l9nz0o+CNpnlfrdHLlXv2XP
+3dk4P7uogF8sPEIaFrkNQAiFIYn+0NBoHJjpvC8hBWjcEBbD7+BGfidu5Cn++uzZLOuExMQJVrI/
+L094REbJnmQULMC/2kyL4DLkL4r39n72jbc3/8diEkNiFyOMBiHEb2HxAy9lXEyhtj/JqBWSInFy
+Xm9oWngNmpKV0E+NFzxbRMODjbMaq3fJIsSvEh4hifbVFKO6Bc5Ch0Iv4CCC7fnyAg21ISnJKCVZ
+ooN9ISConBHefHCD3lyXhtMukLvAffwrVIpv0508w1GF9wDDmtqvzxqy4mgWnMtOWwQ1utXw54dv
+zNr/JfmOr9H/ydneW5YvIQOysgRcr/dhCNiYrcSJVhcV8KOERygCVaG9Vwi3JLGJutasHEmiLpE3
+JCl70eiZWF89n0QHaEsA0FxtOPrTy8bjOX1aJdc9e7lWQs3mq78JurP9DngsmLJwPTl/dnSnhlkh
+TQd6NJBwtluWCw5zS3Xpn3Zh18QcY6UTi797/FaHe4MXRODlJDDk9fQtCk5dEOJzGY9FpFVuv2Ux
+fS29bZPOrLmPznCnyWXSBrZzsHTrVjJc/oGymz9qK+DO2axZpBtsmMxgLSsq4OcL+YhHLvbc2MmQ
+LFk45uuxcdsDJN6oHKmEotP9YO/Zs1nVBu75gmtGGoIW/MllcnDf+YPXpnJL4LosB9nudINrGXIT
+/JVbM/xLFjG5/kYa/Zk6O8vu/+YIjxCPXJEWRSIUScPzVPcqger96hIMvhZzmIZu8EMSMlFl5Sbm
+5RHzuXNZNTfHRm2u++SjjeEryZUer/T/DQTy6HfyA3kIrYdnggf4d4O83WQJMWUnSC5cuKjD81MH
+eiTHkr5+5BcSR36EBfhb+AAhjOohxF+xx+McYxMYuWzieQV+LbzCZ8t3STscKBtKBRA1aF5RAT+P
+0eGBIlBlVA3VoPAi6fFvJCnTZ10S9dEiwuixBo0vU42wiKTEZB11WgWV2gCVbEH19bn0CxrNZ/L+
+nsaYmLDmUxajIjhIFk9Zsp78QXzIH+8v/kyjgaRqw6tVG14tfmR8/EgQY8Iyehqzmv54qyW2kKct
+gnzA5hMkX8NhO6fsPn9+95SdYWA6seibe/e+WXRCHoz7trz1Yy9RxMs1GrxePq/R4HiNkfA+QS3A
+pUUQ4tHAogL+fWcZKabB2VhwrB+EpWhFUziSkJXKCFbk5cHVkjKCKlRGpBCk38EIPmSHkJlJnpB7
+3UjybhgG31Eh2b4XdfJrTEiwyg+0cAH8yCg5knRVx7ZFbGzTMdt2AyG+Cxt79Mr+RoT4puy9L3tW
++6skIj/2TJVuA6tvYM9rigr4LPbsr5xDLSrgl7P6Aex5I0K8MjYGqmfzmJwlEQWp56UL+FnsORih
+0nmynHJeiUJHhErkVOpSnFPVnnOn9Dkxp1w0opBR6vtdS+QBsJ//t5+HEoVRTrkW9Y4ciyXO2ZY+
+e+p0tlEUhiFUOrdN6bwATufIRWEgQqXzkjidAxeFQcX02PP3OOVJEQVlndeek0sU0oufWV6gziVy
+IIlCtxI5b0WhR4ncF6KQVYo/fUucqxeF/sXw8buO3Fwl8ora6eO+cZyPZ/Rw39jPs7NzY1Nxuo/Z
+v7F6/v2q/TwZf4SL8zH7edlzBZHLyplKvt1fOlNpMpY5U4mrlH+m8ttrINSyn6kkN7h6yqHKSdIL
+DlXW33PlG+dDlXNHOh2qVM6KH3m5XFuflJtr69bpF+faUnn6M8tnWRW6k8s+IuWt2v7nL3cuHsfL
+B3D80qovOBcfai55Ln78RKdz8XhT8VlwdrEEOwqHN3VqvYrMgIalT4Mr55RT2bnoSPs5Zfa9Cs5F
+s9PJz5SD0SQP3Mo7Gh1btYqugrPRoUFZkcU5Dqdy95x1kGur5mwa+pdzNt2i6ZDubP935Wyy56ig
+toPmqHDK+WBRsklIoiHFkV9l+V/M98MnlpPv58525yQ7L5/vxwlHUX9BwZnylN/hY/bTq/mYHtpz
+5eCfnWRQFbo7cvf9zN9kObC7c20VXVbK+SN8c2f7YM+zIS4vPlvuI/oZEHLK7yHqlTzKR8llfoGa
+/+UO7SN+ifZy8axSzr/BysOZX3e06KAYK+qRBaXQWZYjZ+PzznBTLShlcYRS9ople1xYwWnuglWt
+O/GNnA50k/1O9sqRHrKCY93d+kCtEue6N5UwXEoOSkoriipBT8kUfCWskz095axS+fecbdRV58yV
+JZPwORmrbn0Qpu27NGA5h6JK5RyKVI/Mlso7xPJF8ZscuX7ajd1ZuJgiBFWdsg+RW7Zuq1p3ciT8
+EVRkSmYi6tbHoQd/ikqelQF2PVB5U1fJ66GzI/M8g2ky2pkDnSswmz+Qbs68eb4RpeghyqOiL0Q/
+ttYX55zLQrF+QrGiOXJmOFtLXixhB+G4YiyfUe5Ui8l8u9hinnIyirv69o7v1meXiqbDbiK7f1bc
+b7i2xfwqKnAZL+pRLQe/aoHdxLzYWFpKqNfQF9lMlmnumjMzX8KA0qxz3frYZdtfOa9dqh+XkwvJ
+jtSo8lMi7XLGopzsSN36OPgmbFftSgA/yUf0S1Lki5DLelGv5EMrw7lSxtmRL41aFme7Xsy2Ekaa
+3LJbcMosYXKxaXdmXGmzTc15tz4QWSInWjH+VbnVdvyLiuxyZ3n6njF7G+XQE0e/sutJifK40uXF
+dpfbq9jdoiI7f1h5MwY/2lHeQCkXUhgckwNOf1U/7/I7fES/yvb+TPUWGZhnYSzRY1Rm89Uav0v2
+qn3jmpLFu7gf2NN5I2znBaqCajpkxqxDSnIKS0hZnOVBkZtqHtimPZamQsM0TDEX+vZvt0qt5F23
+17SWvV0My1s27/JKRlVDy6YThywhfegIIFR+pVJMYlQUpERVaewQnMGje3xCCsYNO02NtS5rGJHQ
+uK91/vlFs2Ms3fpAenSLWtYAgCqVra3NAco50kZuRqEWi50jtrxDT3dXXbCgcJVrZ+iJP7NF8L8V
+uitn853quiOUojVqLVqjlhrl066dF/xxlPtmQSNaewH3rS1yPv5Mro0Q4h3f82PrX+EIQRk+sJy3
+4ARQEy9pAw3mCP9ArSRpA/2b+Af4SH+usDeDP4swBPpIkhRsiIikr2gdeWPpxgWntmNQPEqkvYl1
+FnMZFMoUK6VOOLnsLK/RExH+gT7e3togQyT9JEnaIMMzFzuiUFeSgvwb+wfoJEmtopWwVtIGGxqr
+tf1f9Q/USbbfSyEvOuEejeqhhqgJu73whdg7If/XSCJfRBgCdZIzjgZy8Z8nE1xp9aYGtXbjgEAf
+b3B5CdKRxkG7L6O9E+qKeqJMu+YU06IkS0bl6lNEqQRzSmUozY4yqidaKBMa+5fgC/ahJBTziL6W
+vy9bEeOy9aSni+x84s4qGi1RvqjMku83NZTgW4m3jiLcruxL29oy3Q25lOHdUDQSjUHj/3PcE17c
+n//9TCV/PNdC/Js5feul7I2rg/d0FEhDHdAANBlNR7PQvBI9t5h/XLilZC5EVhpWuuMaaDFipZaS
+dTEtrAolylB4yZxChlDQiJoS5nVD2S4eWbaInGuqEmswRxhol9ZqA3GHpn6BPvRjkKGx3SiULZIn
+lfPdZ8EO+bS1t2T/vk6SfykfD8VM0JIIanswLiEuZnbk70vUa1xBNVvBc8YnXxSAQpCxRD+pQJ1x
+HqWrqUMhAwK1kkz+ydHJeXzydRqfym098sV9DJ91VlnaS7QSn/XPj6ORL4W9WAL3V1Aj1BS1rJB3
+f3XMLY/Rwt1/fhwul1Dy3l8dnFHJMSqmgjHqn+LAPzNuvzRb/sJY/vKs+ssDfNkxaggagUaj1yvk
+3n9h3C+Pp1z3/5wvUC6foep/2kFwLYf3M9BsNJ/G9P+73H8Jv+G/I5QX+BL/DUm9lIPh5iQ76l90
+RIPQZJSNVqJ30XsVjhx/I7+jPHHyV//Xvki58t3zN/FPhBL9NQ5ZUQpCqNzRBMoVqjP/+ZGltVEb
+KG8tq41kiGNml1bch+wdp9w+ZHtSBnUklpn7Vy/2EZ7b7/l/gsAXxArIJy9H80t5bZVekhvO/kFx
+7KElyvoX4g//DE/+SqziZRn1l2MaL808l3JjH5nMP/i3+lH/Mkv/gn/1L7H5r7pcL6+3znPYyqgO
+aqd6tq+hOWUjCE6OwIv8gGI34Dk+GF+exMqVbamAQpNiH6DJc1yAJg66m1TglZFPmvg/RzqOl9zZ
+smXy/dLfdX5b3vDfxL8CR62kvCpCGyFUwicoGe9ZjD78n8Z8/pnO9V+PD71sL/wfxJFevq+6Pyfe
+9B765O8Vc/qnhvT/eXzqpfXjbxHHenmVQWIpe6GuK5TvUUaWP4L+JT+T7C1v/Lz4zzqffyozCOde
+Da4v6Y+WXldIR91QBsr6d1D/MvbwX2EKn/9cc/avcGrRS8X7XMrwzrGu8G/Qnb/sE/8rPBU7/oUF
+vX+B0fLP/0K80bUM/4eoln7Rf0cC/5Rf/W8Xy19ZlPx3i+ovxzvdyom5TVejNh/8O6QX+V+Ij/5L
+MuXO/ucCqf+KnMf/x9dj3SuQ/Q60B+WiA39n6f+nxzTu7H/Z6f/7aMrLrSd7lNKdjmgwmobeRpvR
+UXQCfY7O/Tu0x/h3miP8K+okNPlfTxr+Ff36m4SbkaaC+M9/PPYDR8vEPKgxsAd3yrMFxaGR0rUk
+22/l7AT5T0RqSu+5UfYQJKNmKAONLhnrroDu/wBfXxASt4n/TlY7Bc6bVGTL8JB/qxBK+MMl4+vj
+2TrmX51P/KfCZX8lHl9KSo7hsQSMcuRkHx7L1JP+cuQeDylrbv+F0a9k3LJ0jH8R9Yn/vfOR/6pY
+/8JU5b8k6r86gfl3i9+9gv2diuw//e/s8/xPtfGf3D/679eT//CO1H+/4fB47nrHAXTxf7rm8X+O
+Ur1gqvS317SXmUH9u7XP8wXrLedQwd9rzeU/pY7/+/WZ/4B+/i1Wcv7tBtOl1LkdI0qx76VI+S9o
+ilDunrTT/zkxKnat5BazRv9RHpdniUrOT4r3PtnnJ/+7kaR8gfxzE8b/kJheagfWf0N4bs+ZW374
+N5xdli/Tv8ecszxB/13moeX3XOeYfXlz00//T5md/hNq8beYs760qvxN5rHlqo/HC+a2F/7Pnt2W
+r1P/Z815y9Oz/7PmwRUYLs8Xzo3v/V85O/5vKeV/fc78X9DUW38Tn8vrJebWf/7/Y3ZdgYv+f+Gc
+u1z9/r9xHv6iOaIyNw9HNVFr1BdNRG+Vmp2X1sWyeu+scOVrPpSn+SJXSvUVCOUrv2PaLs94yXPD
+5JzDS4tUNUoKwvERBmfVc7wuXSZnl/PtUpN5eFxit4DCcvkXuw47q+pLbpKVH5av5mVqIrcyc/wa
+qA3qh95AS9Gml5vl/z0E+4Lp//9S3C8VFPhfKkGp+aI9XtDKoQeH/5WIwd9DP/5KKOF/qTR/OcDw
+v1Ukj3LjDllsPNiIDqHL//bIw99Yvf5CSOJvp3J/NVDxP7ZnnhXEMMY79PDH/1IU4++hn//J8Mb/
+Umf/w0GP/6kWe1UYC1nCxuR89BPA/zYa8n+rcr8gTPJ/kcbf+vv7pt4viKucRTfQ7+D+N4uslNvs
+yyGjACunWZErrxCV9/WXjbWcbVJerKWJoUyspWyR/EaT58VanDpJk7J9xFFEzjb5V7rIsnK+XSr+
+0sTwP4m/lO41bOtv6V7TpEynqeikfKkuU1G6AJbH9TTRCwc1Q9ScqOwOLa0RbthzoXKaBQv4vr9/
+pOREdarrrtxunWLUGunN46dLEqQZ8vtHC1zq2iwLWM459XvFOVFLDyuKSoITQK7hy1gboRzbK/Yv
+bltwtO2UT0YodxSsIBuUE0pw9C/OsyaWVWVcqxzfXnhajDk7u+zgm9PZZaHccdlc/omDv0bTX3Xk
+J5YjMkgoa0mg6ouI15SQm3O+uVIUKvYRlatPPqVFzCpDaXaUUT2+5X92dcyrHBdEflh2QMPhZSvK
+pGw9STPCWW9cyvCuOCfqf4h73Iv7M9/yv+6L/PsZDa4vsDeuDt6XkxM1srwuGlmuQy2V7rgaWqw4
+6ikl6zI/vQ6UKCvj0XsB9d2dzevlv8O6w5KXcjzAWs5Cjbz5pRZ58LRyvivccu4vvFN/sedELa37
+f214EgaVs+0LO7ftPD4V50Qtt3XdXxwzXybBqTCgbDLVwtUv0HWxBO7FOVHL590LR60KBiiu4csm
+6HyZkbg8iTwLKKeFlxifNSXoL3+M+qc48M+M2y/Plr86mL80q/7KCP//sfce8FEV2+P4mVu3pOym
+bUJ6NslSQhKy2SwJaSCd0IuhCUJEpCMgoAKShCYoaAQbakRs9IcBYkSKoBLl+VRAn4o+7Pq+0YeK
+DXbv/X/m3Hs3u5sEEizP7/f3R8+Zu3Pnzpxz5szMOdPSdIxqvBO1een9CeN+szL9g+8obU7Krif+
+UANB34zsG+9E/VOlz15ln/ZfuI70z6moKxkYBq+6a+5O1OZHjr+S3dHrr3r7aHMVfCn1L2CgNOvD
+QlvWGLz9vZeu8oZT9ljTywqaa0ukqiUf1vtO1Cu3e6YNDLbKGGvtrWFXmFMg/VonB2/7wO9OVP5q
+baG2yOSqJytaK6fb2241tV52umbnPvAcz+87//GbRfpH31H6O82ptF70zdgHjXu6/5T5l7bUyR88
+V9PaWvoj53TaUHVgaFJ3vvej/vfsuP9ipf6e15D+6XNKra9942XmnvB+1L/S/FObhvfT/5tuHf2v
+z2m1pb8Q/PoL7X7UZmsn9DJ/L6OtNufVXmzanBlK+rfSRfS1Tf3XGBrvR/3N3LeiPyQv/bF3mP4m
+QV3qdIW5P10T2TXej/rbdedq7WPy0u98B2Zr7OXfJOhft13d3KO+ify970f9E2qgTWuML/1Frib9
+/avqauY+Dc3Mv3nfj/qbay/0T7DRyUt/lcslXY/9jpXsyv5j12aNLdR94/2of9na/4PHtD//xtG/
+mKJccW05wE93mrsf9Tdrj/kv5CP8FnX6K3gMv0nBLh74K6yNi14+pe/9qH7K4NXJXKmPaexiWrcH
+54+4x1SY33SHnPvbJrd9MklNkrmlJqlMrLGl/Tf+96NeeVz9A+TqNT3+Z11ZeoVJdPbi7yl+H3u4
+mftRr3q+/Q+b7Dz617gR5iqm8P1qzjPuNTnt7Fd32rjXJJ0JDC3O9ePdqL/zfsc/s0r/Ije3/E5L
+CH9A1TdjD/vfjfpn+DJ/UBl/9D7S31dL/sgljD9CdSCgiW1yk7qL6E54DGqg/o+xUtj/yhj+O9tD
+n/3pFoGfevVr1K5+l1Gufh7d6tcWZ+l3ty8Cr7C2gneh/pXWV/6wlb7T/wfvK/mvL9n8If2jzu+8
+jtddqKF/hqY0uxftxJ9+8Yw484+TstIN+e4ZA32ze560u1BbMYv2p1ZIq/ZO/QnV1DaP8Y+qO0Mz
+e+1K4Hq4BdZC9W84OfaH2SF/gGf5B9kXbT8E9/uO4s3Wt/GyvucL/1u8z+ab9l/ZJ22uC/jL+qnN
+qg4EXMF3fet/t/favE797/Jpm9Oy/11+bvOqB4FNdK+5u0//iyuB/5uV8i9ySeifvtXwT9LeoFb4
+0r/+P+JNN2+Sn/5/5E7Q/4N+dwv9td7PF/e5+9THG2/+JqWUJtHWy2g+aU7zU1P8VL+Fy59Q+U/8
+ZW71aXTfmdtbebqZ2Ju5C8n9ZiuviWGmN/O179765u8/bcWo+Neo3NZdrfLfq/IrTAX8t/XA2Mxa
+s8/9p1e/3vzX0I+rnkT4L+rMVSxl/7f1KKDZuQef+09/59mHv7B6/S+8O/R3mq74r6phYAtzGL53
+n/4Zsxh/Dd38g6c3/ova+kdOe/x3e9KgFvcBUH9yP7wO5+DH/9pegGYd1ta5sUpv2YzDmprSXCQ0
+9/mfv2/gv3OX4Z+6vYC5vVXXVZLsfr9F22f0a95uCL7C/InnjtO/1AzKf7kdsKf/373ns5XTLNn9
+mptmadX1rkxFv8tNs/x32wveWyreKM4EI1ggmt79G24OsydmOczZ1pTErHBzmDUxyWHOpnfyktpF
+i7jDixZd6s4dPn/+UvfzJI3rI/y46JdVixaJixctOv/LqvPnxcXnfz4lkl9k4D15R0IyZIIdugGQ
+CKCqZ02yWcMs1hyHVbAmUTOo5YLZRNZOrLZE0cq9251000cfm9EjrtsD3eJ6zDgWrSfd3El+VDED
+VpKAtx8iCZU9pf09K9nIxdLS1JRBnz18cvr0kw9/NigllZRzo5pQLe2QRjLBroPvv6/de+dFe7+r
+oTwxPNGR4wRHdqo1SRAdhcSeFREeRoRW8kEeJc+4tyUVkdKpI7Nv7TtwRe49u6R9XVvLj6vo5lnx
+XUmX63oMtVpvi4mpnLFW+iyzGd5GXw1vcSQ8TLAmpdocccSelePITu1EHMqDN7Ot5ZV5aNwNh/6W
+u6R39sJrZi6aPWPUddddXzah/ZSh/e5wbnh2TGt5dt8TMnPY7SvDwxZHtxvcu3/JjKL8vIIQyzxz
+yC2Te03U7gvy0cnCq+BeTxJZu9kebg23OhIdrWXxbul14oh4atWqDdKkVivl/dJ4Jsb92cy6usXQ
+TN0NvQrq+cTwxHB1aKGDANZihCXCnpWjVF5r9TOSSNLimNiYmMevu+76iXvyl/bILSzsNbu8fHb2
+1JzWcnh+wkk9xw0sqVg1oH9IyNzooMApu6aEhTdTV8Uw8Wo0NUkUwsMod84cR7YtNTQ81ZZqTRIF
+0VcEjmzlZ2trs0+p3W63l15L8fYuWVld7ujZ05Cam9fznhEjSq+95+k9M2bs75KV1WrNfTTbMXZM
+tsORPWasI5tMujHMYBxQ8mCXdu1KBlYuGzzoo9OvLLhFp29OB5xXIRVLYjixksRWMnueuUnaupr0
+leytrtVM1zfMZ+odCl60FsBYmHYV9ALWIlZiti0FXeecBNWGidDqUHWovSo7SWx1B3R/ncMxbfy4
+HIdDahiRnrGVxA0bmp4xfExXx8hRjz42cpQjp3NG+sihXTIzM57KzGh1tY5N6zSgZFRap02dxod0
+yepbNj8vr6x/ZpeQkDznkMXdu3fvvnhQbtdXilJTUpK7I26q90NgwlVIjChN2pnjFHz0WxNVaBZK
+yKo2j9a2+fQuWVmZy3teY7Dm5/ba8My1pfc8fW3pdFT1k6VT7PbSkY7s7FaryaV/3RhmNAwY8FBG
+dLsHKpcPHDR7tqLn5Oap45TWMC47G5rqUSH0h6FXrUeWCNSPDIJDFAonh5hFh9NubqUc2H/fnWW3
+Z1G0uGLbtoot69ZJ81esW9Pqfv3EqFE7do4aNWrUzh2jdn906vRHH50+9ZFrB/NWh/k214tN2njB
+1fTzdnOYKKjDcmvrd++y7sXru7Qf0bvVLd19dmPvXhNHzGhCc/+r0dogolSHPSvCEp5OrElKs89x
+trZi8rvckLvvRH1N7pTMjGk5t99+2xLntPRW6+P/WCI+fePvn1ss85OSt+3Yvj05ybs9miEasqEY
+SqidLHgIDQ8TRIsYYqKDpynCnsW3lWvmEmfmH31486OPbR5VunSCYeT1E0eOnHg9ua91LKeRd9//
+4N13P3iyvv20ruQOMufRR6UNj85qHctNx5OrsdItdjOx64mVmFtrpZ9fRqZtlW4itU9Li5a1Wtmk
+x8j10q1SD1IlzQQfGyEcEqEzZOId0Y0ju554Or7WOBgnI5yFI9YWhi6RjktHloQW3Tkq3+lmLu9f
+kP23FPbZ2Lnbo49Js8i9j1R367yxT3fuZGv9C4Xu3m2mukXnolU8tOBbtJaXVvgWCl+j2sxXqx2L
+1tXV5f2K1vLbBr9C08NubeXcz6loHXvePkWrFbFFn0KhfVBbKb+yQ9E6nbysP9Fa7lrjTyh8FsD4
+NmtnW52J1tViK32JVmvr1fgSikyy2yoRzZFoFaONfkSra7OJH6HQmQelcGNbaf0NTkTr6rFtPkSr
+q/M3+RCKvAbCuDbrehsdiNa18db5D60VzVX4D4o8ukEfGHR1+nM556FVMmjJd2h1/91q30FrK23t
+z30ch9bVq8dvaHXLbsZvUOjt02ZNbdlpaF2FNOsztFoHm/cZ3pPCxEWqz9AFCqDf5X2GtnHcksOw
+pjl2Q1vnL0iZreO3SX212eL2dhZaN254+wqtVrCWfQUBQiAOEqEDAMnUk0yS2Rrn4DXygDRVmiKt
+vrw/wPzq/t59kREEw+VdAOD86HFemZoWjf6WaGvBzr8MjS2a9k3o7XNlelttzLco28vb75fh44om
+ezP6kHZFjvyM9BbJ9rbLL6cQ3qa4v3yLrkjNlQ3vFvXisrb2ZShu2bxuIs90GHRFDtpsULco8Vba
+0JfTmFaazf71lHJFLjUjuSXiG+3iy0leNYVB8Cu/E/SD0ivS8BuM3xZl3jZ793KivwoTt4m+FcLA
+K+tbG43aFttP6+zYy7DcatO1SX2nQVcoamV9X85YbYm3luzTy/VjVzBJm7SZTlfu23yM0BbrwWN3
+Xq7leEzNJnR0vbLGtGxctijAZu3Jy+lCcyakj35HQhKkQ56HWvZypmQLnGj0Opq1HaUF/q1bpZfp
+3qy5yM5uZiq5iXyvbGF5G4Mt9o/e9t/lKtrH5Gvc26JHSxVIaCIJ1ZNQktiiiCLvJm9Ja9ZLa6RV
+G6Q15E0p048UvovUR/qP1IeYpf+Q54mZXdKcGLzqzgoZ0A0K6Qwvn+MQtG2/9ixLqiM7ITxM9PQ/
+omALs7TGEB2Z23ebI+peU9H2AV1uJ/+8vcuA7UWme6Mcz/bLddVf3jh1lVw6xpKAazZmJ98VWj7t
+3/+eVh56V3L2vb0DCP/ypRLxu1bbrApfI34jVy0atG3hsQUjt+28tsb2Vfgu+418t9owblNdX95Y
+brs82mJDa3re77dJxs/AbhP73kb3VSh687a4wte438bVlQ31Nun7ZY33tnPeKptekcMAmP4bNb+t
+Bn+bNKCVTsBVtIQ2+waKvHr8NmlpjkNbhNDoTFyFJjT1MRQ++sIUWPDbePkNDkibdKBtTslVqMJv
+81UUeeIuqN/UjtroyLSpf2mdc9N20V2Nz6PIqx+MhHG/h/5dziFqi4xacpKuYtxpve+ktcXfNhb5
+OFZt0guPs3UVPUtzPpjCz8jf2BJadtDaVKHNOm1XoeNX8OUUngfCtb91HG3ZAWxbf2lv1h28CsYP
+N+snNq3v3+gJeTuMbRoXvZ3Iq1BgH9+S8/ItQyEe5zPYRJ5N4dmURDaUJ6EpfChJadnPLJMOEuEG
+IhJ+MuGlFybtJHul2onU75wkHSDPSQP9qOdmSW8ckv5+6BCxHyI/SrPbS59II/71L/IMSWrPjmzW
+ByWwTZ4m7hRmgREg1ASJCWBGvI0JIvPJAibAfUFaK61zf8/8myxggt3f4a8LTAAA4/mW+s7AK59y
+ZhPxyodLIwXkReka6RiZeCnOP9Ng8iLJJwXkyHfuixJHfvIvwqsMI4QAeOdMlAdxp/t7aZ20ln5A
+FpD5TJBrKcknh6Xu0nHm30pGWGiw+7tL92rlgV/e4c3mzZlNl8teeqzlApAh3zIsvhK+rLRXkcMk
+X5OcdFzq7i9+Ml86Ll2jlSYVN+XHO38CZlMK/mhSEltOJkrVtAhy2L8Q6TbCEhcjfCcVIxXX+JQR
+6ldGprmlElrKnf/JkzE01ScPzT761Anb2DHKO3fYv26C/ehlfvWtH9DBg/I08bA4CkQwQxRYoRPY
+YRn9646QnZMVESYkOenYgG52cpiQlJqdk2XxxGhpctQ3QlYh6wy1iIWcIzvVlmorJOicB5HwMItI
+e67UJJH2VrYcR7Y26ljoibrwsDjWYnOGxrEhWRbVkacpgxiRF1NEgeIgkpRq420pqRmEt6WkszQ7
+dQhjvugxrkePcfPNnQPN6WQ3/rCY0wLNnaXZEQkREQkNiB+fMSg9Ws+YVgZ0mztvVM41zszu/SZN
+Gjy8/JqQcgvp+ExHYikPuaZ8+OBJk/p1z3RekzNq3txuAStNjD46vesDJZ1eW7925/jNAyoeGE0e
+49wNhOHIpQgpMOHZi88mSIER5BLHEHcDRx4b/UDFgM3jd65d/1ontuuGG2/ccGN5QWRIYaR5Af44
+q/xwJ+XYbDm28YjPJeeOv7aQ5AmX4kumz68q6f+A097eWlE69KbpW8f1KEodPiI6iGWDokcMTy3q
+MW7r9JuGllZY29udD/QvqZo/vST+kiC9Unjt+FyBEVYeOrDuzpt3nJhx4vGl9nY9rSQ+flfxjpl3
+3jlzR/Gu+Hhi7dnOvvTxEzNO7Lj5znUHDq3EO0gGyNP0nYU7QQ9hOHPaHtLgbngQHgM3EQEIrV87
+rWwbrXjVRraHWxtfEKdFtDlJuNWRYhHwNZ2noWcnqVVtc0ZYCjlnhD2rkHNSvbClc9akICaYCGJq
+cgInpmaQdNaaFMSKqBVxvD3LGcdZclIayyMilpDttKXarEnpgi2dOLILiZMqTBxjiYina+oJlggL
+PcXJUsWIY+hAHyR2IkIw0Y6iUoVLouqJ/xEhwaZMK7CLswoL7FmFBXEhnYPMlgjHrFlMP3tBYZa9
+oPDScPvLuUUd2ftmud/uUWTmc7P/tvamXnf0yOXNRT0iQ/i4wDADGWZL6JqSEZET0CU9m0mN69Qp
+xJxgNYWGFo0ukH6WDkk/F4wuCg01WRPMIZ06xaUy2eldAnIiMlK6JtiGEUNYYBwfwgpK2eSbbR2L
+cl+++HpgeK7JkNitkyPaGVpk6JHXIc1qX9U91p4VZUkxd2/v3Eq2bnW2725OsURl2WO7r7Jb0zrk
+9TAUhTqjHZ26JRpMueGBgUkT9REJPXJWH1+d0yMhQj/BGshmXy/VXW+Odz4yfSnz1qzyWbPKZ0V3
+DzfHx0aFnH6brFVimH2d36+LvM898O23q25J7mmOGtDNmaQL7NltQJS5Z/ItgcGhUx3XDrSFGQMS
+zF0MHUuc6dlM+3bW0KAkc1TUoIobZs68oWJQVJQ5KSjU2q49k53uLOlo6GJOCDCG2QZe65gaGjxI
+LZN56IX7Iuvev/SfjmlDPuyn10UHpgXn6vOmD8ue4EjKGh5ii4yyhQ+LjR40KDp2WLgtKtIWMjwr
+yTEhe9j0PH1ucFpgtE7f78MhaR0z28/r0TOzb3pqdHRqet/Mnj3mtZfORUQkh9tS8Q7rAnmaLlYM
+w96uPWRBdxgEy6AWgFDdRLBQb1CwJhGLkGRzRmQRodHNtmdFiLTbshQSZypfSDTzi75vdMCtSQJP
+uzjGlmoTROpR0jCIDQ+zpFj4CAVFJDhznClOHlGqI7uQdeYUEUscseTQPrCIHkOO48LDqNJTY9XG
+iEJigi2VFW3OVJtoY54a12ts3ogReWN7jZuxoX/PXv02kNrCW1+6tXDMSsnVaWhC+9454Y6M7r1v
+GJScyXfqFRJBavmy3j0yHOE5vdsnDF0c0C6zvYkLCEwrJlUhBkMEF93xhwfnP/zI/Ad/6BjNhxsN
+IaSHQTocZCFx7aSPb9y6j/x739YbpY/bkbjwYOmI4eIRsxgaEM9bUoPz17vH3/TJTePd67uZUi18
+fECoaJ5hmBmcMKvdzAXH3pPOSf967+gtM9vNjDPNNJB1k4rT0oonlcXEkGcWFRQsSidCSYzRmty5
+T2ba9amZyUHBYnaKOfaa69My+3ROthpjODYiMiYogO32dPn89CmFt0Q9/nTe8fDw43lPPx59c/cb
+0ueXd+qR1qt4ceGtXy6srFz45a2Fi3NL0noU3L4+d9rC7lWRC9bl6SPWXB8YeP2aCH3eugWRVd0X
+Tstdf3twj6Ch16YXpks/3/3oo+uIMb0wfei1QT1of7hNnqbbJBpxXIyFFOiC+9+mwgOwTfm7ev6j
+I5uk6AfVBlDHQ5Kt6AfVDuJJiZqW47QIiUmpIQmoMYWMqjZiOlH6ujhioWoWksBZIiyKx4IdVydC
+sSgk2NIJdoOpilNAHQF6gwRVNhxvIywR3UiOMzWFThVp/TBOHLJRw+YPGzb/nginKaKrKTItJS4y
+LYUJxEhJjoxPSaNA4vC969Np5W9MrOk27Rnp2zv+fSc58uTtI+Ji40eNKe8zNz5mTp/yMaPiY+NG
+3P4mmUac199kioqdtkEXGJO0crQYnNd1+8z7pHP3zdzeNS9YHL0yKSZQt2FabJTppuvfiUwIDG/f
+SbT0iU/ldPkOfr7Jka/jUuP7WMRO7cMDEzgdJWfY1C6hQV1Cg6Rh4QnJ8RSYAIyX+PD45ITw+GQy
+XUnhfmbE1tKSEe/cLH30+kO1KzemZV7X5c6bZvdyOHrNvunOLtdlpm1cWfuQAFtvX/2wsHVVWs7Y
+ogE33TZn1k5nSYlz56w5t900oGhsTtqqrcLDq293nwwXg4flxdnvzwhfddu1Eydee9uq8Iz77XF5
+w4JF5e+lNtp9EbjOmA25UAg98FSC5mFak1JTsnO0R97LbGO9nkmiCRKAz3GarazZao5IyXFaeYc1
+gikf2i1v2LC8bkOZHsPmDx+e122oa6a/HbzK37okYdwH0ka3S7qP2VtasnX11hImoG9MmTvhhhhW
+7jZ0aLc8zAnDD3xtZumYn009TZKkTWTav4wdSYr0FBkjfdDxwWFltbVTfPi3oI/qgDwogmtwh1NL
+jKIsrNmpSUJ4otez2IIASJcrcsseXHd83aqCEQXuDurDv1pkn/n35dllIta+/PLa4QsXDtfCHZfh
+f4I8TferMAvvXwwAsyqF0bAUgDisEXyOk3rb5ggLZSsFOQxtgX9VMcJE5YoJeutEBmFESEwgqSxe
+Q4FDTxFJdSops2043mTQESLVlpqYQJcCiggmtQls/g0xbIe+MWVSydaSc6UlW92DNJmUqkIi9erD
+pRc1iTLju0XG5ZTER4UYO74lXaRCkX59q5MhJCq+JCfOkj+O8fhgJDolNT4qpPPqDpEZD30kvSQd
+P/dQRmSH1Z1p2mhCHq+dMmRYmSowIxXeKkWQZJe/hLdoVRAbHR8WGZ+SmhMflZsgff2WdJEIb5KI
+uNyo+JzUlPio0LiYWK36wkLbxUflru4cFPzqQ+fOPfyKKbDz6tyo+OgwAKGZdpnfqhbp44pidaUk
+Rlgikp05zbTDS19c0UG9S5M518N1beK4u8l9d49r2gAv78RKzytyu23BuLvJ3ePAlz+t3RVCiy2r
+FQw6s5w5TETLetFqBl977e5x7LoWK7h1HIpN+OsG3fFUpCXC0xL438at8Ez3GELaRZfkRF/a/Tsx
+zqXd0TWpU7vonDSrI+E3yqCxjgUIhChIQZ8cSIpFtIgptnDe5rQ5SatUl7ud2Aa+8spA6b1q6T36
+4P7xqhSXs70xa+2ds94YOfKNWXeuneX+sa16K8J6eZp4QpwMwRAPHcEB/eBaPKPtjLBEcE5qDVGb
+JlXjK4NQW1cU+MbWmqL6mdakVJKdnJhATeMIs5remZqS41BjLTmJCRZqB0XYs5jYeTHt15Oqu22x
+81Ky9rtd+7Ok/2gsfxT0ufSu9M8vgs6x96kt231Ia+xSIBNHlpE7mBj3J5qwPpX4Dw5qgnrxAulP
+Blyz56MP9+z58CPyVp+01LvI3ba0vrlZ+/bZydBGmZwLys0NOtfRq/HfMmw4M27loUMry8e5pCc1
+KZKxrnFMTv+hN988tH/OP36cNStG+ZupqA9iJrAQhH8BtDPkYpvgxRTSvHwsvI04VRfDT7IUk5wE
+S4q5UXhm5m2SMVB6iylqIgaWGyi9NdD9yryY9lQVmhOjJhDySSXpWEle2KmJaxd/4+nZZ6SDvoyT
+hllnZrsL+6Sl3t2stKgGMbEdOza43/xSessjm4wvmUzgPW0jGKLBBlmQC3A5Tv3sD01AnQk2Jia2
+FWw1NbH+qQmHXNTaF3nrsvw0sTVWKbIoU9pVE77sUNSUL7aN/V4z3KVdRX/nz9oPbezflD0R3v26
+E67Bmwguw0+WM9UmWBPAbA+DBHDYw8SIWJLTtE55XAhH9ybblspN8TcHpfsoO6tvuaF7p86SJN23
+isSS+0jMqtucb7VYv7xp8Lj41ORoU2AiIUwzYxrlr0PmDJ5MI8xr0uckpv5Mddlk7n1ib762c65J
+bh9p0YXm8kI6tK6uCfUgHfawiKwcR6LXM2l9XXOgycCpSaWorZXN/MefeWnSlWq7kT8Ljt996Lod
+ve4wXAgmVluqI9WZY4+wRFjs2c6cRBpvV2+FE8MwgS3bmdPi+OYnJ/6pdlH5JOsZ5rFCYkgt6pAQ
+YyCBVne/AmsgMcQkdChKNZDCx5hnskh+lBRyRfYbmkrxSFBKUffCfxzqWRIe1ZMkJHQJiu3TJzao
+S0IC6RkVXtLz0D8KuxelBEkdLy8X9zYfweL+uEY5WcGOdqpm31vDsc4pKiBWQfSSQIrfiMkmqi5A
+o7s0YtZTDU891fDUrJFN+655TcbDidx9EZGKGR/Z6CMdqx5cdvfdZRQGV/vp81jfoY7t1i7q3Lko
+33bOqjMndDerg25ZTAwPzVKpTmypdrkHyLZLp8m2Ga+p9EmSa+kVK41dt2rVqlXuHSpBTG5b+iNl
+TKXzO0in1xjRCkeBKWGPuG65GveAzF21ilmnDAKaN/Be2+gWVRvZI18aXkmy0jr2yMVHWyvSz1et
+Iotb396V+/A64KmsFsZgXqGzcSeJI9HnVyt8frKFPeJiyCJ1CB5FFim2xZX8e37VKqbzKsUSYRnP
+A+g89OsgAEwQhhZqDt2HRiwROJPnpKINTXEGEluKpW0qIgzpHp1TkhPtKmCPGKVnBpLSSx8OJKUD
+pa+vRm3YHXc4BufkDHZIvVatYp48P/u7WbO+my3t9lWke66oSN56pI1CqEehbR13StgjOVczzsxd
+tYq1tHlwabbdpthIGyuF2ZjL3pjrWnk1VbDjNrLpNvJC25quv7zjUbso3S20Ez1xEjZJu22UOjM2
+7RJSZS6XPoqpTP0EEjTBPeaKjYb7XPp+Agl6KLuDMbL38d4xAUmOrgmLTVHqc3b7ABodaVocz46j
+WUp3XGFu8Aua6Hvp2ptikyaRye0TJ0zIbZ80aVL7pAk3xSVNmpTUPneCn90XofrzLc3GsE6bkw8X
+LejgtqhvTSdj3hiyoTth8/Mld/cNQ1w1V25D/rMwUnLhoFvuKk1PL73rlkGFxHqFuhS8xu0k6IA9
+xaCWLZymXkerGBWmtWuXz2Q9wzxayBhSi9snxBiYgCTXqKaux8o28s/sbsaiIYN8HBLJ0iaR+I0B
+9Eak/Jbt+9ZVdJM5qZ/bWs9NDHdpfNu48uYrACI9fPnQ38YOk/3Jmw2m5Gr6zq+92WCOtt1Eb2ae
+tCsUttgyW1q5SHEkhluR50QrZfoqVyy2sEe+X3d8HRn5RsGIgrYuUkxetUrlG3z4MqjWpx1yNb68
+22DbWWpscK59beXJ7dO4vmo9R77zvfTcLJ3xbXmFRZkc5JWpQuLHS2vWU7KUeULyqsas+01vTq64
+hvI3ZYbwDbUbEb14EX1s1xCsHQeul12VYRWq7vUQ6fCYSieHLb/ZvGpIydrPcPvtydQNfI06IVzE
+1dlY7pSu9n377F37ptnupp4S7j1o9PtDceyg83i96H1fKTbPiI67938nibBnFpvUYV3UxY2I0zHd
+/gARPYYDf1L73FBTfr6JLfu9BCb62XqN+mLhbXyzksEZ0dZKh19JZzalTk2kIAXhZKiUchXC4G+c
+dWb2R76ck4bTs8+4v2sr/436YoIY3I/lxPVl9X44H15a7BAuO0XM+qkdE9uEH+nxK/YZTPvLzx2X
++ykhKfHllHx++T6F/a6lSWRXgI/qNZlntEI2DL5Mb+k9XDeRKN1u0bjoVERSbZ60rehH+2rj+t4m
+ImX39s4bmKAtQSUMzOu9qhU9KxuiDe+u477yYyvWTRoaVOS0qutPRYHDJq2jCX3kYcLxg/q1zWhP
+q5aWmtEO0tD29SX/6meT2rS25D0m0nEkEu/TyVW8KT1x6ptyZ4mg+/2ynS2N+YoTRWrRQ3JlNK2w
+93tEk5ySnJhL4pUrXvGevkfkvsePVavqvR+6fF3/XuvYJDGbbqygE4QJonB169jcD65gYiNPEJvr
+WebFyF7Tpben9WrrQrZr61ekt1R3/cSS+QtKwGcs9LYFusE1V2sN+LL6O5gCzfJ9tdZAE/6DfPaN
+R8EgGANTYAYsgkq4EzbAJtgMWwAIHspRDjgTlTceOfXMFIdbzak2s9URRJJsVkdqthN/mgXRHBEm
+hluFpFSaRBDNVofPNLKPKuU4HYJIslM954BsYY1HZUh4hMVBd5mF5zhTUm3hEZYUQaTPghgeYeE2
+ze8/8qbZg0tulpyN+lGkCe9SoCZYR8+4lJ5xM+/u2rNTp8SknkmJnTr17Hr3rNieKXE9c5rOV8xN
+skQmxreLsLofTbBaE4j+5pLBs28a2X/+CmtEu/jESEsSE58cn3BjYlJ8MglKTOoU6RpsTYjqSIKS
+EpPjuXbDZizoN3j2bNfXKPMvUP7EqVXLwyvqSLf0DtKs0Tl9kovzN+eTAo64pzGbCCcdy9+cX5zc
+2zmaVHVMl14+uHKzn427NTIxMTLCmuAykC3BIdI9g2fPHtxvwYxhUQnWiMjERLL4CWJYF9reoPs+
+TJ8aF1mZ2PntqNjNnRPdovGYPsxP943q/FtncHj1YUpIWtmwffqvD7x+XDx4lXoe6tWLSaVeP4ra
+puiNNpxZbePdoQ+OyU3+DhvbRkfaVwb88ZEp8VERgYHJIQY+wBbfLeQqHOtmZcGcmJ8VbOQCksLC
+g4KTw0zkSFv9bT+h/Kb9Hb5cX83+juYrvG0bPJr0Z7xnPxwPARAKBTAJIEXpqXx80t95r5uwVWPG
+XevvubrSf/+9ba8j38x8Hy/3q993E1ujzSZCqGazafuN22gBsGmbTm/adFo6clVrVGPot5vYbm0b
+7AGa8/VT8EbHK4zuHi5bmhS9wrgufTVg2oAB01zPXbkVtDSSS2E0hwHkqSsy6b1uZMJTM1a8b6i3
+17qRh6HftHbkjsVaZEb+jutH6UrdDrvqJSQQwSrn6xyop8qMRjxMh/sAIFXhOkENU2wpFj7FyXuE
+4UgnNsacTVf9IYFEWOJIuIkNImK4VflToKF0mpi28GASRGKJ1SEk2Oi71AzqhdnS6Vb6ENqBxJKU
+iASn8hH+5TYMlL5RpKd/aCL6XzgfPpRKyd1v6NAFw4ZJv1IRMktRnq4TKF33431HRd45taDbLiIy
+XxNhV7eCqXdGjuq7KnV57K2MLS9FCLqpQ9frbNGrZ0mXFq+Otl3XtcNNQUJKno25NXZ5KilZSPQz
+ojPv6WrONzO6gAB7sDlr/UfulefW20OC7ZE6xpxv7npPZjTbfviCoUMXDKf4lmHfzvpu9vnzs79z
+vYP1IS16vXBsF2IhtuHTDf/aQ3TSL7vPGaYPl96Tvu4ytvD1L5PjZ+WeJyEx7a0zf5ibllIck190
+qSQuYdClovyY4pS0uT/MtLaPkb49nzsrPvnLD1JTEkd3u2FUUmjPRcEru+ZkBhn2bTh3bv0+Y1Dm
+rJXBi3qGJo26odtov/3s2r5LOk6PxRujvYxBi1f/4/SyTi0+/VLzhiRzhdbNfn/vuGmVaydPWD9/
+/YTJayunjbs3UzMuV2jmoPR0E0PQjPXn+urKjb7dlNVVYyevXTuZwtiq1VNUK3GwatFF+Zly7vZY
+K0zqlVqDwSO//jAKroNJMAWmwxyYD4txR7g2lpmptR5uoda4NdwDZrvZbrY5LY7UBDHcaRHxt28K
+G7XQ0a73EliyKtBQMz3f7bBZ7A7RZg63OFKJQwxnBTHcaXaItvDGCYvinnGpgtAzu6fyb+zATU9L
+k57eNHCsGpHdUxBS4ns249cy2LOG9iSmnj1XZ7NdsqNcv/bsGR2V3YVtnLrYtl5aQX5Ia38jp5c6
+r8Z/TFfpXWIqmn3TbRMn3nbT7CLpPOngPqG8I6f03NT2aVIAWbJhu6+Io5ReuIzp6j5Bgv+WcW4y
+l0Ssy5JvjZBmn8v4G9kaalmcLH2wPImb7PEnA+aKM/BsuTba0BswgCSGs/bwREeoNaWZJ0+f1Ebr
+0/ATue2XX6TbfvlWsjR9cq1GaTEDrsIIFWdMlS6Mm0oCxvmFF5ehTNieV7He4z8v1gn3aFC7zOKk
+dpmtpbkRTpOPV1pm44S5cyfMvZnim6VOV54gLMcGSuK8PmPba09zJ8zdffnpkBilEZZ5fQE++6cM
+6q3K0MZdAFc1IcK6fUfK91rfNxjVcZKu/yerM7o+FNEVG7sjMVwbMelfPCWJEYkJKTmhIjHbQgij
+mMCsJbHJuXIyg2z7J9l2SfYe23LZONcn7AjXLccJIQwJez3i40unP4ng340+pFi3h2LOcWlNTtPX
+0e0/X2hj0yoSKV1f9V49MT8qHSf5j0r/Ic8R5vC5c4cl+RHQQ548TZcqUs82FobBengEtsM+eAGO
+AoB6nNKJ+xbQ1+MSkvEsJb120ec8pLKxr/FMZHMnKMXWHJQURO2oZKpNPSyZkp1O6Dl0K6pFos8v
+QrMOj7BnFZHwHKdAzx4l2SLYhvJxhYsm33BLYfXN8x+9l4/Nkb6RNksN3azsxurF7vEiGchLNbog
+kh0hnQiJmPIEPR75+I3tTNLfI0h6kE6q40kfobljlXfwlzk2yd/ETdeFT40oC42ef0Q5OHlkfqzp
+uohJYfobOLZLeJceXcJX0QW5es+jlJfcvl1Wp+QOCasn2vvmSX3Ci4qyGVc6PVvpSumRENWzz/Kq
+9UsGDLdYepBXkyeFXxPRv/tNRTPyunyysLLylk+7OqYV3VhYEn5N+LDsZo5Xvjiw1+WOUfYcKPYU
+R12bXJxEF7XX0YOUbHFOQnHS4Iliz6GdxvTqNTpt3fHj6xqfJFY0ioZ7eK5ysq234frr9dcoZ+om
+ytN0v4hhUAR9YTw8A/vhOLwBp+F9AOKlDAmNp2mpJ0jvsrE1q0O08VPHkbusEvJonxSRbNSVK6qX
+dg1hBkE1U7VMIG1WMLGOodrRruP3D82XTsx/4ELHGD7cYGAih6xOM9vTMmN0Jk23tu4jX+/fMqWd
+SToZQdJNupjMtGxT+p3DXCeojk6aouqoEJcjfUt1ND+J2VS9yHV7irMwe4xuClcm6EICErjI1KD8
+9e7rbvp42nj33fmm1Ag+PiBM4KZy0wRuTHZ+TnJadkhQZNr8I/90H3n/yPyO7UzJzGcRmT2ywlZT
+hXvN86goXFpy+4TVE7P75km9wwqLs9nb26c1dzi3oy0tKm21PT/l2hJ/nes5sX2+fXVa1O5mVbV7
+TkpatjmW7S/2FFqhgtlRsebstJScfHvHqZM0RRw/p/NscrzVSujbl3WECbAZtkENHIM3r9SX4as4
+koVq92d2bGiWq0fUnY1n09VD6bYc5dC699n0y3dtc25eWFTkOPLvF7P65i1wLfmv9HPkk8nXFI4X
+M0sS21/TVedWzq+HM7Ku6zUpyQMzdFmdu10zeWtAu/SOygH2Fjq7qfH6XiUDexmYQX9qp/dY7wnX
+dusVaUy09OuUEBgoZCQGd461JAZEXdOjY+qEW9mw8KgAI+vA/Sre9xH0hwp4AHZe7i6ClD/8WgGi
+Xr+ibsVXLphKyVI2s9mzPHvcsnMudwEBU/dn3ijAfGrI65xeSDf6d2GKM+jm/2c659GTAAUZxUwX
+ejqgsOmtA13/lMsEyMbMHgVd+vc/9I+CHh379ok23dwj0xTdp2/HHgX/ONS/f5cCAGojanrA4mxK
+HLQHJ/SEBbAL76bgxc50wT70r30jBfFs8FfPk7GEbgCY97/hUgr2P6/d3T5mXpJjn+TGY2uzZp2Z
+LRX8Fa+nkJbd3b5T3xzHPu/7TIIgEmyQDTfBM7APDgH8rjoS4dESdTQrIqqegI9mCOGCRzk8Y5U2
+VDlTbd46Feq9jZLRxlZ2xu+hJIyo91YTRhu12EZFcZ/yVQ3CBmrKwTWOSKyqHjOCWG+1esJ7E6ex
+cmzhwrIbbilkn2mtnlTMa05PgpPLu2uawiujFK/qChvqqxxijHjntZp+6LQRSKdqSPDE5R19VOtS
+XsGghXeNTu88+q6FgwrI+zha4r1P/vpzAzwKT8FuqIWDcOR31qLfsafJybKIqUliSk6CBcvw2Ga0
+m0zhxRR2xl+5s+nT+3jviXEj4qQN+xhh38S7XzuxgVg0NSI/4ca025Qu9Jm/Vt8TOnnyhPz8H/ft
+m3j33eQi6hFTMOvM7NOnZ5+h80yaPiVAGmRDGdyN88eisiDiyHYoA5iTem7ppJkJNxZrNEEkqaac
+IuKvFPEkjngpBSSAphF8SGJWsreFnUEancVgIjSqn9DjjoEJdF1l1ku3FI6tHGsXgxMG3uEzTfez
+UivSrA3uM+aEiLAm9RvWydhYu1KZ+7A0Ratb0u1d8tGPZLSXmpDuIe3iTJ1+eJD6eVS1TAmhjYrF
+rV5bE1REl2XSWNZRsCg9fXJkbFFQjc8cn+t9ReKv3E6E49Ou96+1609Wn/CqMuk06eipsi+kuEta
+tXcqH7r6rl2TPCoy4plZ0zUFAR97NBvmwW1QAatgra/3/UcPC2zjPhfa5QBL90HYiEXs+F/p6Vf6
+bGqpqnr7LdJ+o9dOC+b9P70v73P9gPkLBnz55dYnpDqyFLdlTKCkALRwPt5rRxxdGGtx77vX7KkD
+l+u1LX9ZKfNibXdLM+5uHyM9fhXn4zmRboTLyqUb4e5OTXtbOiYVkyOkgORjNsfJfPya3l35HRM8
+KW/4sFuGDjUri+xA5Oe5h+BVIQRYMBSzwHJgOn0y02xnra8ueFAIkVYDMK53+SC5A/8e2s9PFgdy
+8IbA6EgZ8GWcznTadbahC5hO5zdkZcKAPUEjBuwxjxhb+iLooBgI6EAnH+lqdnYdTZSXgSPGDthj
+UVLIR5QUUKylMI8YsCeq2c9hwJ7IEc18VGyeSCYyE/mJwhwyh5nDzxF0ZDyxh1tT7OFW6brXX5cW
+v/46/97rr78OAMQ9j/tWOs//BCwsKzYzLLAEgO0BzEWOsCxnOm460gVMF05mDhheKpr4egqjk2DA
+ngBaNodlc8CpZSO/NB5p5eh7haYEgQlnUkgKk8P0ZkYx08iNjD4Ygkk8xJMMyCDCeEoja5Wcz3/2
+IP+T9AulzbWfD5L7819DLCwoTowwBbOhASIBVmcJOMaFHovWXYwzkWAQI0ZFiqP0cabTDacbzLm5
+ubmU4vyG0w1ZIZZcc4glN1OjNw7pjYM4P3rjkN44+h7ja0zG+HbMeGLDi0NjiRW1lQ4I9qycAmLH
+K63drtyo+IDk9ktuHJVckJlrSQpOaL+EuXFEUjc+NzHImNVZuOEWU3RcYIC9g1C20ATEHcB9K61F
+Wa8pNhOGZYBlmR5Afl9ZdxRAIBEQQpIhmTjINWQkjCSPwWNkN+wmQcEQzMZDPJsBGSxKPdTKul89
+/uAXVOpEh3L/B/et3IX/BQRYWGzggGcuihwjiKbTxxXRHm+UqIhUiSD6USUiVSJ936jszaQvDqVq
+EMBRRYjlqCp05vQwnthDrTbR6t5y8NTap55ay/8i/bR3rx9tK4sNLEfgosjyxEPb8SyVtgF79LRh
+GSgyjhjbTNFtJxWAZUEwQQhrEhIgiU0QKKms3eK0s+4tSOkp7lti2LtX+onS+iovyLn8LxAIwFpR
+lUQrzni8ekvg5OHDrjfdYqhev+ExXsjJdXZdeNutvt9MKw4SgTtmvBgkjtKRURCECk6ZdJ3M8lRA
+EFIZBEF+XAUhV0H0vXdvEzxibDMfETtqtlNRbJcfeb94yAPifogPkqbwHwALfQ7AGwxHyoAzIVmn
+G3z7O3/F1Dosf0WmfZP7oZMn+Q/+DozrC+5beS+2ERFCi0XgWFEHnOkkdqknszJpL8HSnuLX5z97
+cK3aW9C9C8C4BnHfyu3Ub4EqdiJVbvH4g58xuuMPfqalJDogQP9tgk3AAjjs4dZNmzZtAgCGRpMN
+GC8COO3hVps93Hpx06aLmzapaVglDdmg7qmEUHu41aKmXLJp0xJMTDZsKvOklwVPnkYAmjbUP30Z
+/UKjQRZU2kSAcDtdtFcoxETKeqRvnsFKrjSdqIZI8EXPV4iAwHK5QfwP8wikUluZXtsYjjO3gshZ
+k2zJbKrTrvz9l2T1Ml17hPCf3kVF+VPm9xkS+fDXkvvU8dulA4QU13Z/fer9hDv06m3dYmtDyS9v
+dnPU9hveo8KRl3oD0d057rrVS65JYpx5M7MXFjoHAMiy3ABAVjGlJiFsqPabWYq/hyHfy+UndeNY
+M6RBIUCKIHKJyV4kJWalONU76b22c0bYE8MTk2zKvb/KX6rB1MKiqbOkr6Tz0j+WaOQOJZ2+IrEx
+SbHDuIiDednDhq4J4YboRSJKF26aPZUPyk5qHxlRECp06JLcKTKf2G4kAmVj3sD4HC467fbF8ckP
+D7BkDd+UErdhGqn9V/dbw6L72KIMUfmhIV17DAKG8sMWse9BMGQqdqwNz4qJ+OdS1PuJ6d8DacIE
+dXwTmVEdesSnZbdvT5ztO/Z17/puxP2D8+ODi64vHzRJZ9k0qGRMj4nplkH9b595j3SYieo0sJs9
+ipCOHexDUqOkA5aA8ZlZTobpde3y7LT7eiVn9Z1iX/fm+tVpNgBQaOMSWB0k4t5frVi1/pU/N+Et
+XdEjSIt2qXIqM+VApC48NtlWtey91c8/v/q9ZVW22ORwneX5uO5FRcEhXSMNkfE9i5KjjJbcUObi
+kE1ZHft1uSbLXJubW2tuPzC9JC1z/bVBtYYER3hsJ0fPiPBeWemR7bITDQAMTJcMwhHmM0iHYoCU
+VJtfTVuwnq1UmoJNWQfRtMKZE5rjW/eWCK62R/ri3CqhfVWHa+d0nZwVJrTjaD1ndi6449Hdf5fu
+vq5XYW1hdD4R1m06M4OLCM1IsiWF28MNuSVlGQY+0Npp5vMF7Z7sFZpVek/eO6T21t73DBpFmEN3
+Lnlq4vSHS/LKe04iY269doA1yhCdFxeVn9YtTA+aTlMdMAkWp/abyt0kWLr6tgFLtm8bsDg8/c9+
+ckC5sz6F/oEdZCmrkB4SSrKlZtNTVCQ/JmZYVwq3FhXXFhUXF9UWn20fE9u1Q8eY6K4raouLi4tr
+i4uKZBmWSxvE/7AJJpslnTnI3GmyWQqZZ6UNJsFiV8qXLjAC+5UpldJDU8FkdphJtBTCEOmC+n4p
+vnc0917pT9j9JpslkznIJjTmC0B+4D5R8xUtmTCZK9H45T5R8/PEwyNSAF/I7zalhm2m8fwSuFPu
+qspvHD/AlBr2iBpfKp1W4vlCjH/UO17NZ5uWj6ULU8SHefLpreVj6cIEcns8+fTW8tHim+STQV7i
+2jWTTwbZwO5vJh81XsmH26PmY7N0Zoq4dpRv8iVEoXwLuXFciZqfzZJJNshdTaKlMxOo5CsV8oVc
+iZovfb+58T0QAN7OThA74YiXSTId9I9PTnA9zk4QNkqPbiKTAAickUbxNv4MhEL/4kSzmTCEMZvM
+ph7GjQZq8u82hRpNxMzEcDnmGAgznW1wUTv6ZBfIyG/Iys0926BYpNw3FEYn7Y0PJ2R8qJ2YG/9U
+jTPHQczWM7XMJ7XDhu6Tvr4pNdUdV8ufuVTBLbloeWfixM2ZXXZySy5VUHqCAZh4biIYIavYzBif
+5XVwUOAZotMZoXeAyZWf35CV1QUyPjtLnahioymwOHBI4MTAOYE8LZeObmar2eqwH6vdurW2poab
+KOm2Svnk+Fbs66TvpVFMOjcRgvDvPiQ4shPpJjd7OO0+WGuSMn+YKL1Fgo7W7jsc4hiWsG8hy912
+U2qINOrDv3MTL1U/PKdoIjHZu2daQJbJvcJk9iud3WSDufAiU2ASLUncQTISlHfXs1/pspV3ICvv
+mLHqu+uU7ywduYNkiElUvsd3VcJk9nPdGJPNYuXOM2U+eTa+S+HWMWXNlOf1nVKu3zv1O693twjT
+OAPSkswdIeN8aFkrTGa/we9s3EFmkk95ynejTDbka4lJVL73+u5a5TuywCRSPpnbPd9lKnTyxUyZ
+/3d8pC7PZLOkqt9ZhfZMT+0dNxPfpfCfMD1NopJGzXMyZ9At8PoumTvCrFPfjeIMutEmm6WDImt8
+NwffVQij2B91Y7zeoexaeIey83q31mRj6rjtjMkkKmk8PIi6sfSdYCLnvOl0PcTdJg8RZplSmb20
+L2BqpV9gjGJzkjSSBiyAme62SpNOU6DRAPKn7GD+DG0bBYqf6TDbzSY6QTL2panr57526ueJhwoa
+hg7d+Dr7rStE+uLfadjPbpcC+FAm1ZRqmYn94B2M8GscEBrP/cSkQiQAwftB1KGeEblER4Ji3DGL
+auNYQ7+ONxy7WJ7V85L0mXSIZL7+vtXApM6Ju3FgWm2h9O8V99feceebeX2XyjKsl04IVvG4ycbf
+wRwkG0xC2D89Y9hEJV4ejvHveeLrfOLfp/FQLp0XDrO9aTwcdIeYhLB/q2PRaaYrV4zpO0kBymgj
+nW60F6XTzNJm3w/TZMEt5W5W8pUKTULYMyjbowBMrhAGRsjdJzA67GMajg/YEzR8wB7T0DGlz4Mg
+H+k6ugvUwP3CKjGj4bPM4sDGTud8oIDdTpgiwlTH0dqSm4wJ+54Qwi6VP3j95iPvyDLcA8Bdx3xr
+EsI+Qx7nyp/yKfx5k0A8v7m5fLBJIJ/j703SAsbI3WZKhR9QRx6R+wMAA4MBmIXcRM3/CLWGJoYm
+vrpy78pkae/7ZFAZ+4CL4yYqNl2ENArT6iACgA+1svhXGnmrE03N0ERWEAXieG3lm1ZH36wB0tmz
+YV1uysyI/ZoM+qhD2Q0RXMilN/eNCM7se6Ess8uD3I5L696ZOJHK8ZR0C3Mjd5vJxuyRYwFMAqVR
+luGwtIYs9qL5NBuLNJ+Hf5Jj3CWF5lDV/zh/6tSpU+QdibNKAATuB2B+4s+AEToVRxiYjWS5ntso
+8EwMx8YYdMYYvSHAdDqrIcvkymqgfX9D1oWGrMzQRMws0WE3W+9nrnvtdfeW+tf4M9suLdu2jVsO
+QMhOZiOvZ4uABSDhiQ5e79rKFm3bhvSe4F5nu+q+N9n4+QDUxuJvbYzXjzTZ+AV+8XVsV91XJhv9
+TS6YBH6+Gk/zYUw2fmEL+SxqJp8ENZ80k0C/k2U4yx1mk8VHlXww/yWN8brvlHy0eFVvB/FngId2
+xYHcCGY3cCLH6ggnmFwu13EqpYZMZWg7WnuGP3PxUBX9Tm5QvxMhrjiYcCPE3QzRc6ATOE5nOu75
+NCszRRGtnSw+4370TG1VVRXfQ/meLNbKZUZwu0HkQMdxjEC/blDK7UCUL6V5Z2rpZ8Cgjj/FVoEI
+4dC1ODDc0ouP0YWaSUxgrwjThYbT+03mTHO1mR2fm9tAaSg2itXBOrbasC60WpfRcKEB5xBMEQk5
+ptQEIliTwAQJEB4m2sNJ4PNEeP556SLpOGj0Mel/SDhZScKnfsMUShefp6/c+lglTvqfY88uq1J8
+Hg89UdC92BwZ3YuPCTLqLFw4iTH1CmxnOo5EhWeGV4ez4y0qUYFidWgAJSrIUh0VQE0gf8KSbIkO
+e6ojuzni9g6S+n8ztTnyYquWPXusCX2JjfKi4grVBZMYQ6/wCJW24Mzg6mAUWIMir8BQttq8Tlcd
+6i8vViHIBFZKnockjRbpf46NHiT1byRos0qNdKv0P7GoN1AAwC7i3wMDZBcH8jrGECAKuhiWY4ym
+s8ezXMezzh43h+R2geIAntGxLBHY5YZVhGoSzrJ2IGZijyZ2Yk5kF7k/kr586bnnXpK+JNPYjsxu
+aTx5wj3UfZOqn1hOAOQUB+mMPAQZ9MYYQeTZQNNxv5J0vJElemF5AEtLymrIMltyM1PoHYRaYewi
+13wyTSutqqqKud+7OLU8m8aXoGcDdKI+huNZYtSKO6mWJrB6ljOI3HKyyuDhy9pYVphXQY3FuN4B
+TX7cm+xc0AF0IPRP4NmJnXtTapC2SFuOka0XyczVzIvua9i57iSNLkxvoOkddpo8PJEQkBpWk5k0
+Nf20ivnInVTFvKimX6blj+mtjam3HsO0rvXMi5SWOgB+MDsX9JBcbNQbOTGG52IYzmByNZzGDmC/
+wD6h41GoF/Jp3dnNibQr4Qdfmv48M/L5NyiZtGzQaMX8jNC+OMAYyBlidEIMxzEBpuNajgd0/BOs
+gBqRpeTpsDfmevGRNzBb5McnX265RqeRAzVbg5JtQxfYj7mugowG3zy55ZemY45qhn48G3RGTohh
+CEdZzlcJ5KqFJ0SmWhldMu2ORDPVI3t4HRvk+p599Y0qZkCVO6nKm1+vvDiOULKazcvqySuMPdtM
+XsijCAnFej0HMZzAsTrMijIoVMMTXDVDG3QmwVwSHZQ7zGiuaz3NCcfZuQDcaf4T/IuGecVBIYm9
+omMCTDEkPEbolWByNZzdW0zIeNfZvcUBZLzaw0ZuJKa4jfp7zJTSCw2n8xsyE830XFt2IUmIo8vl
+gmhODIewOJJViBuLBYYb1v/mFSs+IwFfVFTMGzCMiXTljt1c+8iYMY/UbmaDjAldnnn52UmTnn35
+mS4JRveKKm7nnIldu06kqHGuidIZDolQWBxMrL1iY8zhMYIlxtiLS6I1e3ZvsaBQatYoDWi3UQhP
+2Bh0D/BUFlkKrSl43ag/wT7UHmQiL0Uykb40S0M9FNMe4TZfosljXhQr9A5ulCsVa1BIDBseo+tF
+EpqS26DIlQ2J22i8h1F64tP5ig74S9ab0rDLEelPout7b7Eyim5zT+G9oanFBkYA3sgFBgQFmk4f
+N7nOnjyZ1QX2MywvGqv1GQ1nz549mUlM9vAkOh1MEh2JjPn7SmbFd2zhpencRnYzMUx56FHXL1Va
+fXnyTjrACsAHBBkDTcdPH29Dvhcf4Sc3zZdbruabXKynNAdwmPPZ1lPMzPGjGNuBsI//Fk9fOYuD
+Q+LNOsKF6ZigaJ2+Vxzt4fIbsk67zuJozvKkOjY4strg1QSyTmYmmhPpHEJSqomuV8cS2nQJ/kFr
+ISzCzqdL12z+5eCev5Ep161lbnnDlTC+6yszb76O+YgeSnmHjPx8ygP7691JVezc+orxXR94pULl
+F+kKh3joVmwKSaR0ReiYoFidvlc4apIXZUGUsvjgdtWGe8BcHaqpfNbJzJRmaEt0tEzdr07ma4W+
+KmaAH4FVzEceCrW6/rJRdj6iC41rJPGkr+yg2pzR0DJ9l6HuIt8Cca71vrShvctuRB8hrdhoCBTZ
+UF4fQ7gAk8uV78pHoRn5ahG4ama3QalM01lqASeGJ6pwlKmQHOR1ycHE8GeqpIx73TUemxjzDoTM
+4oCAYD0fKhpjWI4EmY435h4gVutBqOZ2MwFqx07ztzq8Swg701hEFd/Dv4yeGv2Bgp4WoQ6RtAgq
+UqOu2gBiNb+baaQfxzS1ALSntQLU7D22mZdsOF0IcKGMEGA6m+/Kdyl9PVfNAF8tessmUR1PqAox
+OpXuijPMv+4lb1V5+QvesuEMIQITyumobBpzD+CrWdBVG3YHMD6y8S4hjInxFFHFlPiVwRzxyIYz
+hrBiKK9nAtQiVOuWxxJ8ZeMpgDniTmssQM0flL6RW8efAQNYoGNxQERUtBgTGsTEBHCRVHVOKwOM
+nlQHBy5nI6qNpoashtMNmVRv1Y2EaP0oWwzt4cxm6U2SNiw7La3A/d7zZORNy0oHn2I+cu8bP/jW
+Jf0muJP4M+7Nqya8vbW8qnG8o+XTm+PSiwMDo6MjYgy8mapXO9PxRgoMpmqBW86EBKIAkQarw+5d
+tBdJYW9oRUsmDz1VfA+t6EaCPDToUAZRVAbR0WJMuImJCeICNBIaFBmYg5cHstXGjKYy8CZFsHpL
+wajSUlo6w1sOGjU7V63Q2i/3K84zFxcbQ8I5vTmGB4ELM104u3cCkPG5rtOu41BsNPIgGKrF5WqH
+nGvOzS0WjSGGEF7gRRPf0NAlMzGbbgFjHYnhfKhyrj4xnNkW7Ri45hg72f3gI6uzewgMIQ88SCZs
+OnuW5I6qItJ//l37xebiXI++IS0R0LM4IDySCwiL0TF6jlhMxyk1jIeagCAd6AOrDcvDNL1W6AkK
+CQzR6XUqPVZHovI3PH1JCmNeYHWBTaiqKh2/pzmyVLqSNRmFcwGhCllh3mQ1QLFRo4rxltGVaAoR
+mxJkJE2okernan0K8zF/BnSQV6wXDRzLxxBWbzp91mXOVQZPJoSEsBzLcssF9NBP7o1nyXjRxDU0
+1NzF3EWY8ZmJZuIgdAqCjJC+JuF1Z7h/Sa/+RHKx4Svt/mPUy8Jigz5A4MUYlqeOV2MpQVwIS6ue
+F5brmGoDrYOTe+N5TzncXSwzXvHClJLCyFSlqCquxL+sAI0fg1qUXi0KB7TGkhiNnxbL0UrxFNIJ
+gKU6Lh7h2oEZ2oEVuhQHmVJigmJCImOiE2MIl0yND9UdKjaGVxviqjlTO6vS3un2jUy7OTuEHqJS
+tzRTuSlXGJgTlYdYknj0witLFjlSNp16+7rVZ6S5W8csX0Ykd9+tY2bcRyTy7VMgD+jc+e3SO6a6
+n69ibi+/4+WN0iwyuWLZ029/DwCs3KDSGAoxkAKO4uAQG2eKCWsXE2ulfVKq6bgXlQGW6oCEasEc
+nYymbJZKp9XRGkrDzlyO1CoytjW0Cv/jLc8UzqSKk+VIskZqgyLPgLhqgUmKosZIi3Syl6ey7nI0
+pjYlUR17z+L42Blth3ijGMOTeDbIdJaO64o5Z9CTalYUlqPl4KKDmjr4amM7WS3tIwNqyQBpH9nM
+Lq8iR6WiKndIY7+AZQRDl+LAILMxPlAfI7LxPDGZjiulnESdMrLVvF63nAlSOissx+rwLSmMbPYq
+qor5tpmy1mr8BAfEgyFGx8ULTJCnKMpPAFctGHRqB4TldCDeBTHF7h1eBXmKwTGRykwo5c8AC+FQ
+WGwOs5jig0JiIMDAxHC6eCFCEd1Zj/iCeGKuFo3B+sDlbGg1h97+WVwIRHNT1Mr1MMlxUsHztPTn
+ybFaUiLVIKfukCpVtB6WFbu8FPtcC/QoDgmJCooPMMWEGXQRMYwQz0Gk6bgfNcHB4VTOgaJxudlC
+lHbhocdL3B5qKI2q2Bvpce+QCjSxaOQgjSpNdLw2K/KxBMcHmkNCjPqwGFaM50mEN0m0OoJMobQ+
+ggJ0y8PVgYrS48r3rRaFINacaOYOXsr0Jai2CTUKLZ55Ex70kFJs5IwcE6MTY4jXbNEBoZp5gtV5
+pk7M6C+ZE8117KtvuJKZkXS2CG0SZkCjT4lzHkZqjwiBHBdj0NM27TVjVKzXVXNPMHpe6SAvqLa2
+mnXYG2re6hSYmjnjmU9R6TVyPCXXZ9bogL6ae4IVNXoVE5unYqHTRuyrru8x2yqNXqqvfBh/BhjQ
+wTU1ooHn9KbTx49jy+5BNnLLmY1iNZvRQCdpBgwvLQ5hzAJhwMzzAmNmiZnjWF40NZgaRFODztQw
+miQSkSTSC0Fecc8nvedJmdxKaSr5mu9xaekRbapelZNSrgF679MHiAJHB0cs2NiDbBSWMxs5PS05
+y6toHS1aFHWMmSdmQeD9i+YdiV6Fu2eQr6WpXuVX8T0UAhRZHvPwbRAFTq8Vb9CK9+O7dYX7FZ3p
+KbgTqOMo/wPaBUEQD12LTXGJHB9jiYWYkKCAGBOXgG68x3QOjDFWE7OhOnR5YFx1MFYqbg4g2lGg
+ToSqDAW0YAVRyCDmxHB25jPjU+LjY2/aKgWSblIAuUD2VBb3Ij3HrTvNrGTnDu1VkF92nXsz1QN3
+EjuXKVux4fZ7xt5OiHtflTo+eehMhPxic4KV42Oi4iEmLCggJoQzJZmO+1AaFGesJqGG6vDl5sAE
+JLUlWh32y1Prdp65PLlVzEfN0jvLW65UrO2CISY8KCAmlDMnNJJLx9TAeGM1CTNURywPCaw2tSjX
+0EQ/Yn0prXUt8yH1soR6fH1uKI5B6cWBAcGcMUZPRDaG54Koy3ZWdToNHFMt6AzKoHrh7EmXNnGj
+TiuHMy9L+55/ngyoPc0ul1zMw5KLHL14qHGcwzKCIas4KMjMBcYYWT0fI3J0UPUqxShw1TpDQOOg
+SsuxOnxLCmPe04qqYr5trix2icZPMBfgKSpIK4qK26CV1MiPbzmNpXgX0kkZU+cA8P/Cuo0Fe3FQ
+TDwXGR/RLsZIRHN0nMl18nSjsx4VUA0Wpjp8eXR1rDZxg85ekrJFjpgTtZN22sQGOUsypdPo8JFZ
+0vbpS9Ddy6IjB5PI/MwMUN0+PVOm+HzupCopQx1fVbri6ZxSXCLXLj4yJiaQiKHRAVThvCgLjA6q
+hiim2rI8NrA63jPp5UObI/Gy1Ekj2ajL0VdF3vIh0NPPGhplF+9NYpyHRFpHAR4KA1uQXVP6Lk9d
+C7S5N3vZlto6Q7wQA/GMwXTW5UJT5AARqtnlIp0ipQviONB7zEl2EDXw+B5VVZeG+tmRRuhA54mE
+eF0ME4+DrpZjsV7HVgvLGX21tspudTRmi0aMkm8Vt1vLuNFmRDqNYrw+ho3nGYOS7Uk64HLVwnJW
+8VazPDZJo5lI88Qcocl4W/yczsDicEup60E28ssFBoc8bdAhZp4hQAcbYuYYM8tyPoNOip6IuHrG
+viLVSXVu8uYX5IE1VVz5paVVLYy1PZ7TB9CR1muk03FKoV6lirRUnU4kZoGhQ71/qbzD7lWutHcN
+eaCxZHWU9R1ji58z6Di9Wq4ey+X8eW1dqT5lvtlYZCevtaIzeJs/qOsSuP+Y/hE0c4RFXaAIb27p
+55pes/sPk/YxbGmvmysrb+4/3GcFiKw0xnd56pXhozLijT9USbcFxmU99cpTXRKM31exSXMmdO06
+wXtdhdJA7xUFqyPR0Uoq1IWSy5NR9UPryPCs76iyuBwd5tZQIQ29Mg3Sbb40NI53aLHqjByJwdEO
+LewGZUGRqeaXo8V6wTPMJWpDHHPwNN9Depm84TWfivmhdW0IpBY7FyNo1rU648lz1TpPQ/caaxI9
+4xlz8HQVt9uTceM4plrWbIwyiimWNTUZDgiYqUZnY5Zahlp+nRr55r7wrBvrY5RhHif1z6q+ha6a
+Y3DS+rTfCK+O7wx7mh2sjIauv3nxz32BPmxgILUffMdbzb8wVAucOpt/uslwqw3qDHu6ijnoVYAq
+B43uwMbsA9TsqSSUzD10e2ftybgx3zhsl1QePdAvDoQMlXKRJTG8nlo++Q0emwSqBR2d/wtQnGGc
+rNUsXJxsJ7cqBq0UwOw4ozhH1IL1zLkreq+UFUzHvGBqAelZEiMaOZ5aQI2lBUC1zlAdsFxggrA4
+r/Icdp8S0Sj1KbKK+ci3THZmI3/BXkUGaUU2KPypJfrx5ynPpzS/wnz7OCOkQo9iU0r7XmJMfDtr
+jCWQxIT0sikr4oFkPPWj9xbHayvNgdxGa6BhYztL1PLk6oCMBjrA0/Vb78Yf6tVjmu0+PRUJ9LT/
+zZ4Ooraxj/Jq+a6l3p0lWenTS3mvlVMe2kPvYnNAx15ijDXOFhNtJjERvYI7qMvPZo0Lq8ZFELfR
+ZjZsjIuOXd7eVB2E8/lN+aB74hIdLfDixQk7Q1tD92XHixm3uarqh5a48eln1frwrY5Um+l40wpp
+8K2PgOqUFuqjBR7oyuNl68Od6cNCSwxIt1WBj07hmOU/boYnhrdq5KS2TivGzqrvrzx20rsNIMWv
+FpvQ4k0Jd9qnKn2p8d0X8UNL1DDrG4lprFeUSQdyWVJ8t2a4M1tFSUt0kEcayZBlVwN7STYJCSaR
+2XxJktvh3sltzEqhnD8TYgueBMCsNGHIvmYSacgHetJU+KUhbL1JDJ7sk8Y3n8kAbH2TfJpP01w+
+k73oaSkf/zSXy2dyK/LxowcIuZ+P5KP5c2CEvOJAhgAr8Jxo0OuMAabTxfoi42zjeuMGIzf+bG7u
+aQon6fHp/TwnEgNNcjLrbP7JTCJabVYV+OiyrKzdWQhl/LkTZWVlZScUn75eGsW7cB0UEq1mum3B
+rOyrsPL24+5p0vnjzCZiqpfuXcl9fCmeP3Mpnvv44plt0ihqJ0ij+K7cRO1bOooqq0pW7o4D7s8a
+DjDR39RKH93GVriWcBNdy9jlrtwHpVHYZndJo7hS7jaIhwSAFAceYqW758MitG30TGJSqs2B2+oJ
+W33N3xYcPVF7++0bd9ZK0sXrdwwd+uiwfTXSh9N6TJl8/kvmKff1jz12aCtzm6uT9In0dWzcc2kd
+X+TexPNdsETawPVhh9FzVCSKnqMK26rGb1HjO5NnpS2+8fzPJhtfIr8kC03fy+9zffhF9GQPXOQ+
+8YvfZrJZsuAiH6bFK3vcmVRaPlMqBSh73H3iO7PZUt/m4qEG2jf/Hs9vpZMLbIJvPLeHlk8ucO20
+eCiXAgx92P2m1LAdynkmfgKbQM8j8T9KW3CP/hbBQM+Lhe1U3rMinhfrzD6ivRed+H5Xc++V/Jlv
+1fxFSya/SBbwuwDBwHyr5itaMtkELV50Mt+q+XnilXy4PWo+NksG9wmb4J8ft0fNz2bJYO5kExq/
+V/Ll9qj5NnlP89d/zHxhSg2rwXLT+UUe/rOYz02pYfuUeKbUwzeH8fu945V8uD2efDK4pWyCjGcn
+hCxujycfep5MjRc5bo8nHzUeNgCIO4QwU2rYQSWerWSHKen50xj/ohLP3K7FC1sx/pB3PCyQAri9
+eI7tuJp/mna+jf1FKDalhr2sxWvn59jzmP4V7/SwXNpCz/KaUi05GN+Zna7K4TSej0y1OJV48oxy
+nk86jeckUy1dveOVfLgSNR+qbz+y+/3z48PU/LB1cUv98+WWqvnS9y+wX3nn737Gh85M5lslX/f7
+PnRm0pM6SrwPnWq8cn6VI6ZUS65CP0fY6dp5UKQvT+XrWW6pdi4U6eqmxr/AfuXJ55InH1qPnnyE
+IE8+VP6efPhFnnwySCH7FRAol5/WlQth4ARIyUrOKSDZtpTkAhy0SSK9acsSYeEjYj1nwFNtAmdN
+TLIlm7WTtXbup1oyvcti12jpEWNoNbOHTHRNnRCs54Rd7Gu/ZARHpI8Zl2scLs14XAo4coe0vwhP
+hJdumsHrp4fc4zwkCoWTJ5KH3V85rQarI3pT6i+9rjPztVmZueWpk4iRHq1e2iNJ9bWESoHe40S9
+8ywnPc7bDLG4LSWM11Wlh7KBk5Es8xY/sgon/Kt2alrMrOB7nId0fOGk630ImHvDw2p5oiCEQQet
+PMeVhUMLXp1rmtN8sSiNMV2NE5+unRobucxMS+eK/ErvNcHE12a0v1bpR+Wnxfew/bXHeivke7OV
+aj3/A+PPKPHkghbPLcP4d7zjYYP8qvA2xp9W9Ij5lu/tyedmTz6d4QR/1pPPzZ581HjY4H7GJ59M
+rd9yv+9DTyac8LQDb3rUeIUvsZMpNSytKV8Y37kpXxif7h0P5dIWn3wa+9HTPvmka/RIp33ySfem
+R5ftySeDrRS2avTosj35ZMAALZ5bpsv25KPGq2ffHjWlhv1TkRs3TdQp6clujH9PiSdEi2fqMP59
+73glH122J58M9iutXCZYl+3JJwOWeeLrdNmefNR4WO86J1iFME8+mUyq+31l3CI/CGGefDJhsjae
+MXVCmCefTFhO46HK9arQiTloSg3rqp1PZlJRjw66XlXkSlaxX9E3cI4d5jnP3RnOaXJnlvq8d3i/
+hyrXrmbzL2RSXbu8zp/75+9//tw/f/U9VEkBQid2v5o/7f8uMKmaHJhS7/PnHjksZUo9+WA8MMQB
+wADOW+hhUnGwCBXMck4ncsDyBOgRAnoSugtk0DkvQq+UKX0RDHjDiQEMjXesBI8YsMdE75wJxTtQ
+DHjXiYGmxBT7wZhgHGJkxxO60G01h9pDGXj99dfDt4Rx70oXOzHryQ3Sw5SU9fKn7A70/5JhZXFG
+Qjue4YQoMK0MXh0AiRXWgI2h66zLU4SkqAg9T6Bdkh5iSFJMYmqwPiYixXSWLoOfbjCH5Cr/0+Mt
+ps8uNJikv5sufBuSm2vJpWyYRgzYE9JIbgqSmwIpKrnFxvncfH6+MF+8JYanl0iF4Z/JUf7cijNc
+MbTpfeaFxFlIHKFWh51cOOQcMybPXjFzUM3E647cWHuu3+jSzFSdKBx/7TWu5+Ybyq8tdVzXZfSs
+3tcczO360mMla0aNynBEhefnkCBJllW+T4WIIaUA9Dytuq9rOVMKgQApgjURu+wCSg2T0vcRaQeJ
+nZzWpXbUqNq0LtPee+8FxRf50H1a3Kuz4olEOi2XaE78UDgj7bz0prRLZ/3xb/rOP53yT6ckM39I
+hnGZZLj7tGHwT6f0nX/8G01ndZ8Wp+iseN9LojlRj5ue9WSQ8MPFGna/qxO/4ZcXOcbVQWeV/uMy
+XDohnT/FzeTCkZYn3Q/yxboNEAFACklWhHKNJIvis+HVy8z2gM/eeS7m7cghZQHjjF9Mm64+C181
+HNzzanjtL78sUR5+pmPHk+4HdX11G0ypkSVUhyNLWB2o8cahug0mW2SJYKatTXljEmg6YD10dIYc
+KFLuarNnWTRytDtBWPUaToWyUO0iDvo3jW30HhASSgfIMEsEsz1gwZypM8sGxSKtYevGb95865Zw
+4y1ekeGVUzY/suCpY6OKJpGy7CLpx/hRRVPItGzhq4aJ4yYPpKxJY5Om7f7Hh5sXxUi5WuTPZE/S
+jY988OGWW6Ndj1h6TLl3U5k9yn0L80Bk95vuvXeKHQCA0+QAA2EojIHJTThKDG3CodgMh4ktcZwY
+muhhlrAq//5cMzHhAQvm3DSzbFDMW5FDqRiu20wevnVLhNE7NtT9WbOCeYmJSXJ/Jv2gioUbmqBI
+yls+M0jtFYT1iFTc+GOXdaoquQ3MYfdYj8yYue6bVFECj7oyRLcBZsJcWABLYdUVpZcY2ip9SWyl
+dEloItuB+EvY0RoJM7HhagqMMPpo3g2DlKYT5v6sdVXAf5Hk/izJ9YtvTYij40cVTrqh5YpoY61c
+poq6t1hNat/xtW4D/tVGTeyxxGkhVuK04L083v0IlWW4FZUXLxqzcLMih08yWpaOfmbFFOnCQOmn
+KeVz+hYWGj+ZMmdf7Nv0XcHYHrdueOjWhyKVOJGr/UXqkFT69NmOFTdIF0jADRUdn5jZ13Tx668P
+73g9vPZnTrb0WLTlg4dvtZDvvj688zW1X3lLtwF0YIZCgMYazbaxhcRpc4ZqlNO/6kNnCX1o9rQ3
+hWRmploFTPy78aPmBR6+4UFKZ9jS6Q+RG6Y0pIykNf3xjOkq/WotqlHkFArynqopdtLrnyPcN1nu
+jj56A1tK6yh+5iO7b7jhmwmly2KkXJWbxn6GHP7qyM7XPP3kaeQnBAq8+UknyJBoUxiKI/FEtIVB
+K9nhugwsjB+10XDLFBcytO7lqK+mTJO+faJ17BwY3zPKfZPlwNiFU7hU1LmPRn81Zdpu6Xz05dgB
+Iv/iPq0forOCAKAndOgifHcuWPrYNc81T/qYC8DxMOWnDwyDW0rPiZe+I/HsXexdJP7SBRwZz+qT
+6cgIQOAV92lduc4KJgCSAFHEajaBFnJbpdelAReYBczbzIIL0mCxm3REevjXQuFzcoJska6Tuv4a
+LbxEJpFrms/LruZlx7yIg+y74F7rTnevvUD+Jh0k3ckNwtFfo6Wu0nXkCfKq8PmvRdKj0kHAehzm
+PmaQdAuApWedAOiQDfRSUoEJDwvx/KmMEAeeNYAEjkaZTaKg/q0NW6rZRBNwb5Fd0jDyvJT/ykuP
+b3388a2Pv/QKOU6ft2zZ+vhL7iLGTj6try+bPLmsvl6Kdf9ditF+cTHuS/zoEcMef0K69cuPpVu3
+PD58+PBhW54gK899SVY+8fgwMvr1E/U/nJc+lb7+/vxrr548+Wr9dz+QCBLz3Q/1r3rzEAV2OmZf
+Df08blGx4qKacGfbudjHrmYfdt3wresG9mFxzlUys2+a64bvaA7fsQ//TnyF6ulfLhb1xKYnV8VX
+BXMXc9c29zzmrmfc89zztjF3ueddPYNSLc1pG83pGZrTNpq7ZpcFjfbYa17WmT6q0X7zshPjAXhl
+7HQkqr2IOgSHJ6pdDVlIO4vO0hKy3qr2HpYRk4xJpEK6OUOJ+KD2Z3bXd8zntJMOr/3FXfSdK4N2
+E+BTls27rObK8y8zXlpLlpulWeQR724rjCyQ7jCTe6TJCZ7SmbvPkxHfkJdVAqQJ30g7zrsHN6Uh
+XhvbSDjx6UqJg/iMYymkXkpL8yo2Rcolb6sRdORy6wn5RPrVNU7tD5lfJOlTIrJbKQ0AwHjKTAOH
+Virl1sbiX5O4XEfuGQYUWsL+ObU6d25857jSkutb7sDdnTV7go6rY/OOjJuS7r5JF/moPbDFTvti
+nafTb5TTV7oNkK1RnEP9H9FG7ES0KTaYP912eg1eqHIkQhUeHegrp5CAEmKYUr550UORxk+nKURP
+DLQsL31mw9y+RYU07saSeErtJcbSY9Hjnegn0oUplZ0+fODWSMmk0fyutfSZ9x+f1dfEW74+PLn/
+a96yZSFR6W1Z6iqFerk8NC6IqJQyU0hH6R1LJBMqhccYP39nLzXLJgeGkRek4BDjf97YQ3/fwB6W
+HnQHMAFsJDOx4eDfXqGatOrSJOm+H5+jP3zLTVPKDRVE71IjLBEmfyFRD9aUqpCwLi8tXivfy4/5
+Ytp0laT43JJHDr9FKWFiWJFZ2nBw9wnN7JNGPoJmH5L2MzPD8ibJeg40XRP6IF1dFLqIUrRFKdya
+IPq0NnoDOV7foRLKPko6SWfc79HKSy0e/Ohd604fq5ZO3ZKk+2KaR9uGT/wPSZZOfHDbjWp9sq9J
+D7peqv2ZjS1tX/J30v61z9hSqlWU3gHR0gFphPR6hhSitELWS3YBqF+J5kSOXidC/6Yqq6mTqbEV
+NCdRZgKVIol/65j09snXPm/aCpQuQpMrRrF/lx7ceZK0f+3vVfeedM9QWwGp9bQCj3xHoFntT28c
+JEEqgLM5/fLqQFi7OdFqtr9LXrQ01nA4WSd1DzP+Z8oNzykRoSQi6Qfpmu/f/IDrRt7WtGzYpZel
+KT8+t6ue6vtCdpF7ALPPPQCI/IT7QaGHbgNkaO0xMSvCJFgd9M8B+5ZPDwE4iIUk+jbFZLL0rpdf
+X7XaaFwtLc7wyGhiYGdpMtGvpn/TcbX0M3kqlb5Sm6M77DXpq1eOCOvX6lwd1FbI1ki/riYHp5CD
+q6VfWZPSEBv71RSNPicdJ30dBdGmJ06LT+8a89Gz0mZS9uwlq1etJX68k9wobd7mSvDqZZ/9aLv0
+sLR526/uaK2j3fbJdlJGbnjWzWxW+1ri1dcC7/FOzFazKaSJNqGxaPMZaIrHde6//YPtYV7EhC4r
+vXX77mLPCMN3sPSe+6HUh7xFUoghkjytDTU3J01aI70lZZDnSWmfi0dQeRrp6eDpR+lfszXx/r0n
+Wpu+ssl/g2yXlkZ60WIpH33r9r0FjVK5lJBD5pE6Kd39vVMya/3kO4mT1pAM8rbUW3qqF3fRZxwa
+pD8LEdCuxVkhunmH2d6ot+OMX0yfpj4z8qVnha9UVf1lifLws2HRJ58ofc/9rhJ1zuky+TsSw1vK
+X+rLjWqav+urTz9VxiQA5rrauhrmfyYE5/8I8ToaC+cyN1+rhT+/4yoJGq0/CwA6YPAL/E6cKcUC
+BEk/v3NxaNBoNd7zL/I67u8wBVnYBsCsBWAdxMHugpeFGniUt8A28SGYKxTAcsYOL7PxsIeNh60c
+QIFQA+8xa+FR5lMoY9bCB8xeAN4Cq3gLfMxbYBNvgc28Bcp4C+aznrfAM7wF1vIWWM7shfPMXniU
+5qEBVwRVOoBV/GIw8Uugnt8E84QOUM8HQT33ENQLdpgncFDPjKMgb+KLoJ5bAPXCl1DPu6BeKIF5
+3Ckl5NdDPR8Gq7hP5Yv8B7CX5il+DT3526CA+1R28bfBOMoLpZmNh1exfJAbOIBt3JdwGx8GddwU
+mMuHwVyuAeYyb0EGfeZDoI7JhaNMrvwBt0V5Fl+HOhrPfY7p62g6tj/UcZ1gNmsFJx8Cu7iDUCCs
+hVHcQehGnzk7lPIWAsxewtFQlSXKnrfADioj3gLX8RYYRtNwAOM4gHd1QKLZl2Ee97kiOyp7GscB
+nOEAjtE41gHXsQ6YxAGZx1tgF5U/rR8OiJV1wBr2ZZjIfQ5HxaOwRoVJXBGsR7k3A+LroKN1QevB
+G5hx0I0ZB1uYcbLEjANBsINBq4cmUEOihBKYgnXhBbQuuHehnjdAHZV7cyB+ALdxU5R68AYmV36V
+yYUNTK78EZMrX0D5q/XgD1i/n0NfWhfeQOsC65qGi8GEde8fboJ5qAsthKijYTAX+ac6QvW14coh
+1Wc+7DIhB/V8iPy5OALq+fWwiBknf8bkyqeYccAyufL3zDj5RyZX/owZB3uYXNiE7SIXjnK1UM/d
+DEdpG6F6iu1kC9QhLFDTKOFqGrKg/j4IR7ll8vdMb2BoPVJZ+odCOfzd81yCejjXP9Sth3m6DJhL
+2yBtB2pY7vlN22UnmN1SSNssbTf+IeoMrbdWhrS9Y5ujOnawsd1j2/ML1fadwH8s3499D23HSwD4
+MAC+GuZ56toOBuEuTxrULT4IKoQSWMD9G7ZyD8mbmLfkZViHS0En/gvqmNPYVgz8z1gPzzO5YKD0
+iK/DXt4lb9JkKQ6CG7nP4RbxfpgnhMJc3infz4fB82pfdjPXCcqw3e2RvlfkApGafIQPYScfAnuE
+WBjEH4d6/gfZxf8TCjx8joBqfgSM4x6DqZwd5mL/PALG4+9wKKX8Uv1hz0M9uwPCmPFQb5wI9YYy
+qNfnw1xhN9QLA6CefRqOivOgXiyDeuF6qNPaCPYBufI5TQdaW0fYHvzaG+1vaJv3bw+KHstf++sb
+5Y17DEqprvvQrH6no+PDAmWMQF32L6cEpmDf4N/u/dorM05+msmVjzHjgGdy5U+b0LEeXvXRc3+e
+/fSb52AzlwZ91HZ+nv8E6oUa7Ffmcp/AXE/d+dHTUrvTQk3f2ZNwp3AI6ti3wEllI7pgLgV+sezm
+N0EANwX03AIwcJ/Ke5EuO5Ryp2AIewaG8EvAwH8AATgGNPKP/YlQrNbHejCw/SGI+1T+iTsIRu7v
+yKM2BtZxN8AybQzl723st4W74XZuOKzgusMKIRtW8EOwL/+Av0dNq4e53HGYSEHtp+vUcTeI2QdP
+Mb/CfLYjDGC/gBImD47yTyljsAZ8J/kD3orfPIdy+RDm8qfgPr4PzGGHACAMBqB1xPaDOq4QKrlC
+mM+dhjqETjCb2SO/R4H9Gzi19qaO5cB9B3O5ejjqNaYXcJ/CFJQfhccQ6FpkqTdg/TwBdZRffhvM
+5btBHTNK+p4fBXVcENTxb8FcLa0uFubqboG5unYwV9gKTu5jcAp54BTqIYN/Eo7y+XCUi4ccCnx/
+evIbgM2HBWwXWECmyF8zIbCAGQ99meugL6uDBJqOuQSpTB5Us7ugmlkLu5i1sJdZC8eZBbAA4UcF
+OCBGBeCoNzB2eEexM8g8aic1/oZtNI7ZC7MR1Pw4IPEU2DgoY+6EMg7gVQ7IQxzAtxyQEg5gPwdw
+nlkpyxzAf9ipsICMgFsZFyxg71JoYb+Hd/xBpecMlwK7lLxgP4DrGQD3XADXUQBXDYDrPYBLDQCu
+wwDuLQCudwHcTgAXjbsA4HoZwH0QwHUewDVeSUdBigdwb1BAXtyYrzsZwH0cQBoJIJ0HcN8B4Daq
+kKyGtwK4F6rlnQFwtwdwTwNwWwHcJV7lTVbK18p0HfalGWEdgHsRwMVvAC5NBbgYAuDeAeD6DsCd
+qOTh7gQgCSqfGv81Cu2uLwHcr6h0UJ7CldC13wtoHnbFD3AvUuhwd1Ke5XQAF81nji+4O6rPC/l8
+qOfWwlHhc6jn06Ge6hYF7GftUMcB2a/1ATSk9j7tQ1T9eZe/E0zULuV/kb8UfpF/YHvK3wh3yN8L
+D8ofCw/LHzD7gNd8AWqfaH0R7Rdpn0nbEx2z+CLlneYH8EHKO5qGO6X8pn0u7RdpPPUB8H0sDKJ2
+K43ne8Nc2idhP7MPnuIeh400jo+GI9xBSMB+lfZZY2C6ZmNiugNwGG1HC+ziFkF/7haYjmnfgv7Y
+B+5Fm5T+LsM8+8Ac7jHoRkPhOVhFeaTx+M3jsJHG4Tj3b3iKuwBz+fvhWuFF+WMaqt905wfDHM+Y
+kwlraRnUP2K3y8V8H3iO7wOHhQa4medhobgI5tE8BTPMo/wII6Ge+x/ozC+FwdwHUCYkwzweIId/
+QBs7II3rD05ahuYDYZ9Py1P67+Nor9M+nvpnVOYOWMaHwUjNTsJQ9RGE/vJF7mPFl6Pjn+K7gUD1
+QHcERujGKWn4XUrdcefAKnBqXVMbi9b9x1AvFMMNND/do9CeT4RVWM8UdkG9wMEISoemE556pzYb
+rXea56ewGOs9B+r522G37m6o1x2BeuFZyBeDlXL4TTBb9wSc4tfDavQ9/gNzuANQx2VDHb8aplP+
+RQf0puMW1W8K3Bmo42OhRNBDHe3PsU66qeMf1ftnIR/HiXdhDvX9+JuhjquDTeIwqBPXQB3/NBQK
+Q6EOyymDuQK1wd+E+dpYyQNkcNnKOxyXFN/zAo7nXaFEzIQ63gkZ3BmVHqqL3aDOU+8dIY6fCHHi
+nXCKl+CoqAeT0BPs+j5Qr2PhqKpH03VOqBcegKO8BeZxw2Gs8D6YRBayPLZFMZj4YZClheJ5mCfm
+w1yxGuYJZpirnwO36HLhAa1czV7meOgGcOl9ALcI4H5OgV9fY0t/foct/aVUSId6fhoc5ZdAIncA
+UrHeqOw+hnqU+xTowz0PXTkJ6rkCqKP1T+uA6gDWwy6oR94bw0TuDBQI70OW8DR0RF3qB0d1sVAv
+cnBU9xTUi8PhqCjAPH5tow+HekLrystXoCHOFxyEbuJi+aIwH3iqO7T+vEPaH4irIIw/BcFaSPXc
+Q2sR0nZYcEAOPx7SNZpQF2+Go1peYjuoF7Jo/cLRlmxiLxtwgXfYRC6qTaz1xVrYks1IdR917BxY
+kX+/UKNRqxfaZoShjfWjyckTLoF7uAMwVxcA94idYa54EuqFC7CdnwX13D7YrquCHPEZSKe2ufgi
+HEVbvQ/MFTLki/we4NGXGg4raNum7Us/BkaIP0CJaIN64d9Qp+sOq8VPYT7252EwV/P1ND3QPQkj
+xEIowfougTqhFOr5W6BOSFTmHQQRxgsijMHnzrCXj4eD+HxOecevgoNcTzgoToSDwldER9/xddBd
+SIBnBBHKBCtMEEbCXv5tuJc/AyAEQzWmqYFFwo0wRkiBJ/G9UlYZvxU28XfBXv4HqOYGwkHhdlgk
+BCntSxgGs9BmWgt7yTkA5jPgyZeyzBaCyAGMEV9XbeBrYC4/CO1atLO5OHyu479S39GxKw3qxHeh
+jruEv/E77kO4kQ+FOl4H62gckyaf5kPk91g3AH3P5oOBlsHdBrP4TDV/6j+/ppZrhtnsrVCHvy3Q
+gTsHs4W50FtYCrtwHu9TKAOgf00EtgOArAD9TV5VYbIC2j+SQe/Lp2mUb8lkZhy8BcBHAcDL9Jld
+DVNoPD8a6rhqKGVLoJS7Dkr5PKhj0qGOeVl55jPhefIarObo+25Qyq2DUn4w1PGzoZSmY7OhlO8C
+z/MMlPJxUMfJUMcNhVLuMJTyO6GOq4RSfjuU8j0gjD0OdewkWM08CavZ4VDHt4c6ShEFIQhWiG/C
+CmYjfAAALpxPK4Iq9hg8weTK+5hcmMF+KG+noXANlOO84Goo57rAWhpS4DvCIxqwG2AJwofydgr6
+41COsEMBcQpsoMC9AgsoMKVyg24ALBccMF14ADbwb8J67gKs489BFS2D5kHLpQAAZ1uAEwDQAAAP
+AJDBCsBZDuAHroTUciUkgiuBYVwJlHAlsJsrgVVcCYzkSiBfhTKuBFYwD+I88V5uHFxL+aI0AZB6
+ZhTcqvHaEnjLwB88MrkcqPJiXfJ2rkDezn2ihJ54b1n6yZPKkcqwOUC5OmC62AHKdbOgnIZUzhrQ
+380BrQcfOAdVTWATbED+PvSlU6svCrQOm6kDGk7jSuBN7zrwmmNvDt5R4RGc57sX5rJlUKDZBRS4
+I4qNIjwOc9nlcJRdDgXcIrRdjjJ/hwJxBxxlT0CB0FP9BqCA/xcc5QrgKP86zKHvaBzNh8YhpMNR
+/H0E5gLAewDQA4BsBYDDADBW+U1D8hAAnGHK4QQF7jYoo8B8KjfwFtjDj4dyfhGs5ZfDGvYsTOcF
+WIa/74Cb+bFQxyfBcr6DLPOFcC3fCybwAH0RfoLRQhLU8UthKsIjUIzf0G+zYDAfjHbpIOEuWMqb
+YB6NFwbCa3wwzAS4NIEbArdxQ1wPAVwq9gWXBOB6mqYBuBTDD4LlLMCtfA+4ld0ln+Kuh3Hst3CQ
+L4MqAHcIwKWlAJcKAVyDaHoA10NYXwkwGWE8RPAAkxGqYTTyWwab+Glwky4PllIQJFjEfwSz+Fkw
+S9gJs7gGtNOHCCLki0NgEVsN+dyPsEg4hLbrIk6GcuEdKBOKIYvXwxBxAMzifoZy7ic1/hso41+D
+MbwByrmfYRYPsIj7EcqFtbBIcMMirgHKOReU8QDl/EnIF45CPnccyvlymMUdh3zuCMziv4IhnAnK
+uGQo456CMcJaKMPf7aCMI9BemKq+C4cxukoo49IRyoX+arpkKBOuU+L4fBjDpYNBeEj5XuivfpsM
++fQ9dy++p7/LuQ7KN+wWCFPzWYShRstsKBMegzJuGozBuHsVOtmNMIu5H8r81zIEaj/4wkIE6kfs
+BJN/KB6Eel0Q1GuhYPM8L9RC+j0TAPVcKaxhJ8N1CLGwBiFFDeugLxsOa2g6z7tYWKOrBZPYC0xi
+NdTr/gML/enlz8DC5oDSxy9upNOP3oUthfRbzgxryBpY478Ww78LC/1gMQXxOqgXY2Bvk3AG1IvZ
+sNAT3ofhYq/wPLsY1og87KUgfAMLxXhYzIvQmxeh3gt6ewHGCdTn6wi9hRVQL6yCek+oxbf0fgWc
+Z7vDGrY7dBUI9BbsUK+Ff3i5t8IaCtzHsJDOV3iA/tZAjeMlqBdSYSH/C9QLMVDvCbX4lt7/AufZ
+CFhD65CGwpuwUHgTeqtgVH8v9I5HX7cPLNQthnr8rYYUuL/DGvYxWENDv7x6XykvXQH09oRavBZu
+U+PVkOaPuncKelMfzwt6ewHGCTuhXtBBb2EU1AudoN4TavEtvR8F59nRsIYdD2toyO+F3hqISX7Q
+B+qFi01DfTzU6062HPIJsIbnYCFPfbhGWOgFGCcchXqhCBaibqyDek+oxbf0fpXKA+0fzsEacTj6
+qwtVqNegJRlqskH+z2B/sskH/gEDVVhIQSxT+gWqT036g8Z+YbgKSn+gtm/6jfAk9BaehIUq9Pb+
+zZXDGurrUj50E1sG4SGffDA0hMIaDXScAtpv/kFYo9sIazSd9NdR1LUUWMinQKIKC71/s6PBgG29
+A9QLVpjHHoY1CHfBAkqP4VZYExisgBEU0H6zb8kN7HdyA79XbhDnyQ36bLnB8KNfnF2Nc8kN/GG5
+QSyXG/S95AajzivdHLlBn9WYTuDlBmGw3KB/QvmeXyg3cMEwlwuSG9B2exGMuA6+BwpxvoL6eO9C
+MvsN1HHDFX+DbQ+Aa7zbgBH06nwQndObAsv4xTBXBTtdm/WsjWfB/dybkIVA5z8egDr+c9glboRd
+fDvI8Kxx0HUNByzitsFzNI6rgPvx2+dhDPXP2ZvBwH0FPLsAlrEfwzI2A2E4+yX0ZV+DZeR2CvKL
+7CBYxrwBy7jRsIx9WYWfoS9bCX1YAyxj74BlbJjsYvfAHO4SdGKTYCBCIfThM2AgOxMW0Gd2lALM
+JzCCOQfDaByZBbfSNQSOrh/0ggXsYOjD/AR92MEqzIQFzDiwsgUwi30JZmG6UZDKOmExcwnC2HDo
+ww6HPszXkELzomsomNY7zQBYrKWh81SUN7oexY+CLCYX7mELlTK1cpG+CRBHPpS/RroLYBZjgX5E
+hluZCOiHz+fkUfQ3+RjGsizMpXQhbf3kr9l3kJe5Gg/MJ9CbGSB/TfngTHAdOxr6MJ/BArYYbqV0
+Mt+Ck10KTgzpmtVg6APgGusHPSh45piUtexar3Vvz/yuz/6GK4St3fdA56rpervvPgeoVcM6JZS/
+ETgw8yHAaPHMOAB1/4NI50C1fQ3+Ie5lGIjjLMsMlN34m+4PUcKP6FwxXSf0D1va83Cl/Q5a6Jm7
+VEO//Q/+4Ygr7YO40n6INu+LoPWt7XOh+w/UObMrhf5ze15zo83upcA5JStwnrVRuj/gLchg3gKG
+hkIq7MU5x5b22/xeYSv1saWQGQdjmFzoqe6rOXyl+m8p9OzruELoX1+ePR1XCP3nrP1D6rOwb0EC
+rmddDrT9XjzwIg+CYANRuAk4fhuI/HfA4fpXM8DvAZGCWAe8eBIEMRNEcQZw2rp9SyDsBVHYDaJu
+D/C6/SDonCDqpgInLgJRrAKO2QcPM/vgHmaf/Ddmn/wCXStj9smnmH3y68w++RXWACIjgciHAc+H
+gcBtAJE7CRz7bxBZSm8z+8AoCLNBFOpB1L0EvI7SWw6ieAw4XIe7DAi9QBQvgaCLAk5MBw7XVy4H
+00EUDoOoOwC87hgI4j0giq8CJ04AUXwUOE3uKMfJytojn0/XH+W7cG1Ro1krX833t9bjb62X34vv
+y9HOvyC7+DuVZw7ISlxLxhD3DOz30JvvRfcc2UXXksWbZRddT8Z1QIA1HEAN/YbZJ/8Ps0/+lNkn
+/1PVpc+YffIXuI9uHLDs32UXXYPmAJZyABuxLD8d8Oxt0eJoG6PrysdkF1235m8DUdkPAe/SsDn5
+iKtkF13nFq+XXXSdm65DK2viyB/oAEALmScAGAAgowB4i7I/lzwIACeh2X+4F/dTnCMs4wDKdAoA
+B4TjQG7w2veBIe6h8AaAXIRaYqfA7IQG/lpo0AHQb8/CZf5RugwHYW7gJzCXvAXtGTe05zpAe+9n
+Zj8YWTs8ytrhqFADx9hPoIrOo1PahFny0xQYkLfpAAo4gA/JKGmNuud4jzgR+vMfQhUF9iLy2MAB
+/FOFAmYviaXf02f2QbiH8o551pCu3B64yA+C9kINmUKBzt8L10OZUANz6DMF8qrCR2v/sQ4YhTan
+A4pYB0xhHTCWdcAM1gGjWQcM53JhjQbkZ5hNfoYb2UxYQL/DfbjqPt02fU9t4VGqPXsX2q99cN+P
+uncG7UZaBrV1XYq9K3Iwiq7r8haYyFtgBd1bjPaOBZIM6VCvux/qxa+hm9AbJvAW3BtIda09b4Ew
+Qzq8yVsgnbdAT94C43kLmIQusIC3wFb6nrfANN4CBt4C1wC4QgFcBV4QCnBpCH3WpcI1FKhNqXPB
+Md4qv6NLhXzdGsina310/VF8BI6JX0E/QQ+McBJtLrpfdwz7EnTj74Np/DHoyQ6SPxPWQoIwFV7R
+DYMcuveZvw9m8ZvhBK6lTYIeQgUMF0bBCTENnuXp/ER7wuoekF06IDbsW37EfZCltN1R4L6FSGYf
+9PTsI+kP4/hqnDtdwh2Ea7l/whahP4ygdqvubshl98JU/ie4kS+TL7F1EM23h1GsAXqLU2GM/i64
+n38Ukvm3Afh8OCG8BvXC7RAl2OEJbgHouAUgYn9xF1zLb4MxXAOMZf4Bg5l/yBFCAXTjcmCoti/b
+8JNnf/Z1XuEk8SgUqb+naPu31ecZ3FpYo+3j5CuhXpyk8CReDybdj2AyrAGTeC3uoSkQF0KBPhAK
+cD5K3WtPbWH0JZ+Fo3wUHBWWqvvclkCB+A0U6MdDgXAXxnUT7oNuOg660fTaPj+qV/qfYDHNi4Z0
+Lwjd+0PzF4/CKKGGtjnyhFADC9RQ/U0SfAHKlDSQJtRAhJruSfW7J73e+8V73t3iF9J0HYUaMkao
+ITu8wg/V952FGhgs1MBHtE8QauCUUAOPNH7L8o10wwKv3/T9wsZ0sEgtc5FXXFnL9OLvrSrPBxXw
+4b+sUVbe8tLiYLryDE6hhlwn1EBfJfRAse9vTHurAp7fC33L8qcXnOqzE9uPBVZxVpjg2ePzCzzM
+j5EvUhB+gd0U+EPwnvczbyFpQhBspsCXQZT3uQ88NxJJ7hRqCM8zpCfPkFI+Cgo8cADOCtvgrFBD
+osU02E1BqIFvOCB7OIApHBATDfkPiM4buE6wQBgCC3R0L6Lfswa8BRZTYMLhcRp60fVPbxqFGthC
+QxbgTb/41sA/m8TtV0AH8A8dwN+vIk9/eN8bdBPhPgrsftjTbPmN32jPb/rBlco76QeH1Hjv8JB3
+fTTJo1CFGnihzaDqlgZcRwX845tAC/zoABZTEGpgklADi7mO8C3/AZzQgOoewgb5ogKwjgLPQDnu
+YWTgVzWfJkDj+bMwmYKXfN5WgNzuTYfxeriPwm/UhY/9fp/RAbtJB4odp0Jpc+1Ue/Z/r4bv0X2N
+3FtwMx8G9zb2SQqwX5Fuqq32qhYKi5gLwiIo84T0HE40CDqAn7E9HYE97BFYqYVcJ7Kb6wQd9V1h
+MwXjEDVcB4uMQ4AILthEfSNqg2u+kBAM7WgawQVWDmCPLzB3NY1rjBeAeUIAeEYJvZ/hGUoL7fPE
+9VBHyxVsIPAWGM2HyRWUfzENHmgNcG/JDQowkdxb5AT3FuQr0NJvJlIBckIB7XvvfNry3h/80//V
+wF8eNI4sUn8vUsCf37bEtwaYSCX0PD/tBZqMpzamw3qb6kWvJxRscsNvAzZUsAE0Ao3j5za+a+kb
+5Znqb2uAn6GA9pu7UQGq997AcQq09Lut6RAuwRz2ghIKl+BmNbwscE6EOmEE1InHGoGNAsEbPN/4
+01dL7M3JwTuN7gLMafLenxbvfLdCHQX+rAI0TkyD7TqAqWIalIlpsE1MgwoxDVZQ4DbCNrETxm0T
+o2EJBX4CLNG1gyX6n2GdgcA9YhrUimlwgALXAx5S86F9yw4VHqNleP3ey/0LqsQ0+JsaLlHjaTll
+/Bgo43+BbXwWbBN6wTZunFp+GuylIb8btqn5bWslrNJdC9s0UOKYD2nIfQ27+bMKiGmwRUwjw8U0
+ckRMg1/ENMKJaaRBTIPxKn80/ksxjcSJaTBTpaEX9ePUd3vENNJdTIN6MQ0+EdPISDGN7BfTyFAx
+jWQ2E1+ixJNrxDR4XkwjpWIafCamkR5iGuxv/A3/ZF+BHXw0lRFZJKbBQfYV2MechR3sv2An74Id
+QgJUqjLcfAXY4Ssb0kW4AJuag9bLl5QqANPENHhFTCOB3Ais08VK3ZIOYhq8JKYRQUwjvALwjQpl
+/KOwTaeH3boDsEU/BXaLudQOIoFCDfxDqCF3CDWgF2rIMhUuCjWEjtMjhBowCEfQRvmbUodkNH8W
+9lAQTsIi/iy5mz9Lego1MMobaFrVZxulhv283lO/qFKoIc8JNdBJqIH9Qg041LBUqAHqB9ipfS/U
+EFaowby+E2rApfplXkCGCTUkQKghhWrcCyrdtJzh6vO1KmSo8Znqc6YXZCk0wQNqPsXqt1p+GtB3
+T6vwuAqzVNDKfVzlZbNQA+Xq7+tVwHzENJgipsGtfrBN8SmZPUINIzD3wS7mPljEDYJd3CBYpPBI
+HhVqyCClPEJltVSogWqhhtyjABOrAHleqCEfCDXEKtSQXKGGjBRqyEKhhjku1DAz1Dmwx1trvyj6
+8bvCArU+vcOW4DMvUONIg1+aTgqQcAVgmQIkX60D1d/21J32PE+ogU2qb0dhARsPM9l4GEzHIvYn
+2MP+BLvZeDiAdx7MgwPcPNgq1MBYoQZGq/MFg/U9YIFxEZQZF3nClp5puIA/C8CfJWktA1v5f/v9
+/+vwf71+///6vxx4zekBBX4hDEb4FwEKLc0jaIB2cuO8RZ4Ghod857YuD/KhZuKn4z0dv30u6rfO
+Yf0Ree9T51W10Nk4Z0qu84r3ek+uE/bAAhX6Uricr0AB4y5AMgKdM1AgifoRwgUYSIGeucc7IBbI
+73KnwED3g3B0n5RV/krb6+LZ06LuqaBn7oUv1fUJev7+HBToBegp/g33WEzVzuJqZ9zpujH7DUyi
+Z8Hp3iy6lwTzqoOh3EsQw1XCEI7ulXsObhL7wRCeBRAfgb7cMbib+wCGiI/DEO5HGMIdgyHiTTCE
+ewmGCPNgkTgPhnA7YQj3ImSLk2GI+BgMxv3Xx5U0XC0M4Q4iZHE/wxBxPAzhDkA+9zcA7inoLQ6B
+IdxxKMO0L8IQPgmGiA4YwqfBXLEdzKPAL4BifhFcw4dDMbcU9y2aPGd/g6CCvwU2CTJ8QPlhv4FN
+NNS+ZW6ETTwHHVCORVDIdVXe8+1hE/2e42G2+BjMFvJgNp43/RlO0vUS+htDDh7jTTBZy4/mw2zA
+fbmbPPTRtZJkKKNrKPSZu6jWC6fOhw+CedwaNaRn9ItgPc51+c2N0XXPNvhY/yeBX9K4h5WuGann
+tC/i3jC6Hlckn+EiATzniqkOP6TeB6We98b1K6rf6/GenG6evf4c3hn1BBcJ9T53Py2Be/x1Cuue
+niXU7iVaADq6N5SZAruZKXAv9zLs5l5WnmkcV6OAdi8L3vGyD57SzsHqukG+WAyFuidgnnAQ5ohv
+winhEBzVLYMbxAEwXbcaRogOKNHPhHqdyWtv2T9hHv8czDV8CPN0qTBX1EGe0EO+yN8K+doZVe3M
+qZamuTsSWgvMBZiCwMMxDfCcwnuwhn0F5lHQ+iRRkC/yO8Gpnc3EvaedYLZeBpP4MdymrwaT+D9w
+m94KJvE9uE18GUzCWq9whxJ61gSLwMBtgyB+LtSzVjjKPQ3D2WmwSJgPBn4LGDT+sI8Mky/x/4Is
+IUs5M4nnH99V4j3hAjDi2dlP1P2Ce+E8y0A1vVOL3l1G5UbvDMEzxyOhTrADx4cBxxcBx20Bjnfh
+3hiOXw8c2195x3UCTpgq3889Ast4G/Sh57a5h5R97LhWOg7q9Q9AT7IPXiJfwl1MEMxiYmAiY4Ux
+9HeT+CCY1ap4K4zxj6f3bYhvwiZv4LbCLAQ3zBJjYRa3S/nN58IsMQhmcXOV3/rRMIvbD7O4WigX
+LqjfHIBZ4mAljn8PZnHbIEu4qMZ3UNNTeB7K+S7q8wEo515TvuESIYt7AmZxO2CRh44nYRYfDbPE
+rTCLjhXCQvWsTmsguRGEAU2BmwD5zQLdS1gBGbxBAfGgAvwSyBA+hdn6R2C7/hHYpb8HdumXwK6A
+fNhFz4wCkFHMk9Cfj4BBwnuwS+gCpWIR1AlpUCochlLhXagTFkCpSGAiruFsx7Oig9SwlJ5N1aDJ
+GdV/wmi2CEbT86nMVhjtcz51NpSyWeq5VO1MajPnUZl0JV/8Tsbzu6V4jvIDWM7kyQ3MNLnh9/6N
+5yH7wnL2lNzABTT9rZ3V5L6nz23/Tc9wcnZY0mL4obydmyZv10LtnCX7D7mBmy43tPU3PX8pWKBK
+CzU+8UxmEGzgusoN7B65Ac95HoIN2ndYfpK8vaUQz21uh3ItPy30j4ff/M81RwnpvSsATCno6Flg
+DCPhJuW3V5xPKF9Qf0fS0HNWeY5yXpmZAzM0frTfTfii903MgaP+eoLnVfvDPVfSp9+sH376QM/0
+Gux0TkduEK1yQ5Pf9Lzv36FceF1uEM43/Y3nqmldXyc3CBua/sbz1j3omWu5gT3r9TtD+e3fHvDc
+8A6Y7vntp0f0TLAQDOuZOXID85TcgGe2h8M6rp/cwFU36qe/XDW99Nc3//rhNsjp3CtyOrdB/px7
+Rf6c2wAW7hWwcBtgBPcKjGhOo3TZECcEQRl3Fk5wd0AZ9wac4MoUYCthEw9wQsyDTUxPOMFHwQlu
+ApwwToMTYiCcEMuUd/wSOCF8Dye4PbCJex9OsKthE38vlPGRcIJfApv4RbBJuBXKqP3N3QInKLCV
+UEbLpfnSkKaj50j/X7eB/0jgvwET/x7UC3fBV/w7UM9PVu5RZZNhCpskbxJ4qOcLoF5nh+NCCa7r
+r+a3yReFrfA3/gSYdB9CT+FmmME/I7v4AXAbvw3uw71eNGyA2ezzkIF3mX0Odcw/4AvmH/K7vEN5
+1ifhWSLlTpxtCrD/Uu9FfR6c/L/hb9xZKBD7wih+KpRwMhRwtcp9d5e7/5VdB/f/Hve//nn3vMJq
+3gLTeQuUq3fijuEtcJ+617I73okbBtO8zvVf/u5c9W4Jz50J6n0C9Dftz7CP8LsjgvaT2v0HtE+k
+/ZZ2V4L33Qw0P+/7EjD+HFRpdySw2XIDP0pu4N5QQvqbq5cbWNoX/Uv5LUbSflNuoH2imKGGkco7
+7XsaT/tUvhiOipPgqPCMEur+DkdpnFAER4XnlWd9MhwVc+CocA0c1XdTw2Tlnfa9vpvyrZijxNE0
+hq/hxP8FEFb83wBdxp8IxfDen1qeP3ithzcLpfCgfhEM0F0LBfrixnj9aJjQTPr1V8zvT4RmaLRq
+zwFnmqTP04+GiTSkvLYA2wDk59U7GucpdxO6AwBc/1DhVQXcDwG4vsC7NTYBkA2NoP328WW87TQv
+G017T/b7pve3W71t1Cs9sxMAeBecYXIhmPlW+p5fRO71gioVtN+38IvI2mZC7ZlChRespfeIkDQA
+OheGd958Im/3tit9bMJpaM8fpXakdpcs3g+t7jPHM5S5cBjnR9bB/UIN2QkAJ1qAsxToPauYf57c
+QO+U4e6lodxA75Zhy2ionFPmDtJQblDumpEbFGh8Vu6IoaFvPL2LRnichnKDcieN3KAAFGjPeEfN
+IhrKDfSuGu5zGsoN9M4a5u80lBvo3TXiDhrKDfSeGvYEDeUGepeN0JOGfrQBFPBAQ7mB3nHD/4uG
+coNyrw0N5QZ65w3/Og198/T+1psv7299nukdOek09Evv9QzgariSTSc8R+733JtHbQ674iv5wDTF
+X/IGbz+ppWcf38nr2cdH8vKPfOYKWnj28Y+8nuk9S8L3cgMFb5/d23/yfvb385t79mkTLT17+Vst
+PdM5QzpvT4HaLLoN8CEF8TGw8p/Bk7pv4Enjj/Ck/7MG+j7yLwrAKxSMQ2CYBkGSkqbpf/ITnuf7
+gf4jAEW/ADE8nQlgDP43mCBZPgUmSJEPgAk6wgAwQWe4GUyQBblggmyMcco9wQS58mowQZ68E0zQ
+TX4ITJAvB4IJhkEqmGC0XA8mGCefBROUY54ViCsRr0C8EvF9mPNGxJsQ34/4AcSPYZ41mOc+xPsR
+H0Bci/gwlnIU8UuY53HEryA+R2kmqZRmkicHQjKY5FOQDGb5LCRDLOJkyIVkSMXnjoizEGcjdsrX
+0xTyEkiGPHkDJEM+xvTGt30Q90XcTz4AyTAMn0cgvhbxaHk1JMMYeSckwzh5ASTDTExZjuVWIK5E
+vALxSsRr8Nv7EG9EvAnx/YgfQPwYUvIsPm9DvB3xDsQ7Edcg3od4P+IDiGsRH0Z6jiJ+Ccs9jvgV
+xCcwTT3i1xC/jvgk4r8jfgPxPxC/hfgU4jOI30X8HuIPEH+I+CPE5xB/QjEJQhyM2IQY64iEIA5F
+nEz5JSn4nIrPNnzOofVCnFTCpCvG51KOSB4+r8Y0KBOCMiG7EO9GfBbTIFXkPOIfEF/A+B9pPvql
+NH/9Mpq//g58Xo7P5fhcgc/naXr9dzS9QZZ+gWQjyCwkGwlkQApqVwpqVwpqVwrkIM6Vj0IK5MkH
+IAXy5Q2QAjMxZgm+XYP4PsQbEW9CfD/iBxA/hl+dwOfXEJ9E/AbijxCfQ/wJ4s8oRmmnoJxTUMIp
+JAZxHOIExEmIcyhtpCstheQh3oHxuxC/j5jKLUW/lKbU34G4HPF5mt4gU2wExETeCamQL5+FVJiJ
+eAnixygmXRHnIb5AsZ7mnGoESAUbJMtnwQa5iKmsbJiDDXOwQTniCsSViFcgXon4MUz/Ej4fR/wK
+xciXDfmykQsUI802gyyfApsR5HqwGYncEzqAWToAHSBWvh46QDLG0BrsAE7EPTGmr7wBOkCJPB06
+wDCZph+Jz6UYPw7TlCOuQFyJeAXilYi3IH4C8VbETyJ+CvHTiLdhbtsR70C8E3ENlrgP8X7EBxDX
+In4B0xxE/CLiQ4gPY55HEb+E+Bji44hfRvwK4lcRn8Gv3kX8HuIPEH+I+Dvk90eKSTAtl5gRhyK2
+YHwU4mjEVOs6oNZ1QK3rgFrXgSQjTkXcHnEnmj/pjDgDcRfEdsQOxLmUQrITy9qN+H389izi7zDm
+R5pG/x3FWMsdjERaDR3BKR+AjpAvPwQdUa86ol51hMdoDEmlb0kefcavOhoBMZHroROOJp0MsnwA
+OhlBPgqdjEQ+AJ3BhJjy0hnz7Ix5dkZd7Yy62hl1tTPqamfU1c6YT2fMp7ORyBsgHUxyB0jHfNIh
+FWOyENOxKR26yvWQjn1IOuQj7okp+8oPQToMQ1yKeJw8HdJhprwE0pGGdKQhHWlIRxrSkYZ0WIP5
+34d4I+JNiO9H/ADih7HczYgfQfwo4seQhi2YzxOItyJ+EvFTiJ9GvA2p2o54B+KdiGsQ70O8H/EB
+xLWIX0B8EPGLiA8hPozcHUV8Ail8A/GH9C2OF+kkk1JIumAMSswgy0chHaWdAbQ/zIBkyIAMyJIP
+QAY4pS8gA7rKD0EG5MrxkAF5ck/IgHzpPGTguJ+BI34GlCIeh2/LMYcKxJWIVyBeifg+zHkj4k2I
+70f8AOKHsazNiB9B/Cjix7DEbVjKdsQ7EO9EXIN4H+L9iA8grkX8AuKDiF9EfAjxYaT2KGI6AmaQ
+VMovyaQlki4Yk0fLNcjS9ZBhBPcXQP+oYS5kgVneCVloQWWhZmZhz5wF3eQlkIX9cxbqfBZqWhZq
+WhZqWhZqWhZqWhb2zFnYA2dhy8oyAo3HXteO1podzNJRsEOyvATskI8xPeWtYIe+8gGwQwnGDJXP
+gh17XTuMxOdRiEsRz0RcjjlUIK5EvALxSsSPYT5bMOcnEG9F/CTipxA/jXgblrId8Q7EOxE/h6XU
+4PM+xPsRH0Bci/gFTHMQ8YuIDyH+kL4lBvpMLIijEScitiLugjiP0mmQIRXsODLajUQ6ANlYL9ko
+w2yUYbaRyEvAASZ5JziA9sMOiJWPggOSMSZVfggc4JSngwNy5Q3gQHveAfn4VU/5FDigr7wVHDAM
+cSnicZhyJmLamzmgHL+qQFyJeAXilYjXYCmPYZ5bMM8nEG9F/CTipxA/jXgblrId8Q7EOxHXIN6H
+eD/iA4hrEb+A+CDiFxEfQnwY6TyK+ARS8gbiD+lbkkp5J13wOY9SaJBpjBHos5HIZyGHegaQA+WI
+KxBXIl6BeCWkghNMck9wglkOBCfEyvHgBGqpOiFVXg1OtBCc0FPaCU7oLW0FJ/RFXCIfBScMw+eR
+0ilwwihMUyrVgxPG4PM4iaaZifkvkTeAE8ox5wrElYhXIF6JeA2WuEU2gxOewBy24vOTiJ9C/DTi
+Z7HcbdI5cMJ2fN6BzzsR10gXwAn7MH4/Ph9AXIv4BelLcMJBpPNFfD6E+DBSexTxCaSkHnN4A5//
+gc8fUkwslF8SjTiR0kmsiOmI7yRdaM44wjqNRPoFukKBfBa6kiL5LPUL5dWQC2bpF8iFWHk09VTk
+JbRPwrc95eshF/oipj1DLgzD51J5AeTCOLkecmGmPAhyUZ652CfkYp+Qi31CLvYJudgn5KLtnYu2
+dy7a3rloe+ei7Z0LWzDnJxBvRfwk4qcQP414G+LtiHcg3om4BvE+xPsRH0Bci/gFpPYg4hcRH0J8
+GOk/ivhDmpJ0ofFGIn0BedjS88Ash0MexMqrIQ+S5Q2QBz3lJZAHfTGGyiQPhuHzSHkr5EGpfADy
+0FvPw3adh+06D8rxuQJxJeIViFci3oJ5PoF4K+InET+F+GnE27CU7Yh3IN6JuAbxPsT7ER9AXIv4
+BaTnIOIXER9CfBipOor4Q5qSWCj9JBpxF5rGINO3qDPdwCQ/BN2AtvFukCUvgW7YErthn9YNOe0G
+a/Dtffh2I+JNiO9H/ADibZh+O+IdiHciPoHfvoGY9ifdUHu7GWT5euhmBHkndDMS+RTkg0kOhHww
+S9dDPsRK5yEf+4d8tOXy0ZbLh57SF5APffFtiXwA8mEYPpfK4ZAPM+V4yIcl8nTIxx4gH3uAfOwB
+8rEHyMceIB8tt3y03PLRcstHyy0fLbd8tNzyYQuW9QTirYifRPwU4qcRb8PStyPegXgn4hrE+xDv
+R3wAcS3iF5Dag4hfRHwI8Qks9w3EH9KUpAuNR98wH0erfKy1ArTBCrAeCzC+APvhvjBWPgv9wCRv
+gH5glqdDP4iVD0A/nGPpB2MQj0M8E+OX4PNhxFRn+uFcQT+DDN2hn5HI4TAI7eRBaEUMQsthEJTK
+R2EQjMPnLfj2CcRbET+J+CnETyPehim3I96BeCfiGsT7EO9HfABxLeIXsJSDiF9EfAjxYXx7FDG1
+BwaRLvJRGIqj0lD0oIcaibwVhoEJUmEY+h3DYKx8FIZhmmFGkA/AMBz3R4IJsVnuCSPRThsJveVT
+MBLnrEZCX3zuj88DEA9BPBTxMHw7Ap9HIh4r18NIlO1IeBbfbkO8HfEOxDsRP4fpa/B5H+L9iA8g
+rkVcj/gfiN+CvjASPsTnj+m3aAWNRCtoJFpBI40Ao2CkkUj1MBpMMsVm+XoYDbHyBhiN1t1orPHR
+qDOjjUQ6D2OQ9zGYZgymGYNpxhiJ3AHG4gzeWOgrH4WxMAzxsxizDZ+3I96BeCfiGsT7EO9HfABx
+LeJ6/PYfiD+Uj8I41ORxYJYojpWnwzikYRzSMM5IpFMwE+hc1kycHZoJNsQdEXdG7JQ3wEy0zWZC
+Hsbky9NhJozG5zGIxyFeDffDTJwvmgmPYZrD+HwU8QnEbyB+EzGdEZpJaP8zk+TJ02EJJMsHYAnO
+/S5BepYgPUuQkiU4ti7Bud8lkCsPgiU4F7oEuiHOl+NhCVK1BKlaglQtgXLMrQJxJeIViFciXoNp
+7kO8EfEmxPcjfgDxY5jzYXw+ivgE4jcQU16W4OzuEpInx0M5+gvl2C7KUefLcXa3HDkqR9mWIy/l
+OLtbjh50Oc7uliNH5Ti7W466UY4tpRy9vHKc3S1HX68c20U59jzlOLtbjrO75Ti7W45tpJzmCuU4
+u1uOs7vlOLtbjrO75ch7OfJejryXI+/lyHs58l6Os7vlqJPl6PeVo99Xjn5fOfp95ej3laPfV45+
+Xzn6feXo95Xj7G45zu6W4+xuOc7uluPsbjlKshz1thxnDstx5rAc53XLUcLlqM/lOK9bjjO65Tij
+W44zuuU4o1uOnmM5zjSWo16V40xjOc4xluOMbjnONJbjjG45zjeW44xuOc7oluOMbjl66OXYS5fj
+jG45zuiW44xuOc7oluOMbjnON5aT7yjGudlynI8tx/nYcpyPLcf52HKcjy3H+dhynI8tx/nYCtST
+CtSTCtSTCtSTCtSTCtSTCtSTCtSTCtSTCtSTCtSTCtSTCtSTCtSTCtSTCtSTCtSTCtSTCtSTCtST
+CtSTCtSTCtSTCtSTCpo3VKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCe
+VKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCe
+VKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCeVKCe
+VKCeVKCeVKCeVKCeVKCeVKCeVKCeVODsfQVqSwVqSwVqSwVqSwVqSwVqSwVqSwVqSyVqSyVqSyVq
+SyVqSyVqSyVqSyVqSyVqSyVqSyVqSyVqSyVqSyVqSyVqSyVqSyVqSyVqSyVqSyVqSyVqSyVqSyVq
+SyVqSyVqSyVqSyUtASpRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypR
+WypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypR
+WypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypR
+WypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRWypRW1agtqxAbVmB2rICtWUF
+assK1JYVqC0rUFtWoLasQG1ZgdqyArVlBWrLCtSWFagtK1BbVqC2rEBtWYHasgK1ZQVqywrUlhWo
+LStQW1agtqxAbVlBy4EVqC0rUFtWoLasQG35/9j7H7C6imvxH/7MzN5ztieoKEiQIDlGjKjHiIpK
+I1WiRIliggkqWqyQQAJKAsKJIYotVoKoqBhJgooGFZUoKioqKq2Ip4gWLbW0pYqWKlZUqmhQ0VB5
+nz0h8U9t773t/d573+f5xYdh2Gf2rJk1n5k1Z82axw2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2G
+lg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2G
+lg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2Glg2G
+lg2Glg2Glg2Glg2Glg2Gkw2Gkw2Gkw2Gkw2Gkw2Gkw2Gkw2Gkw2Gkw2GkyrDSZXhpMpwUmU4qTKc
+VBlOqgwnVYaTKsNJleGkynBSZTipMpxUGU6qDCdVhpMqw0mV4aTKcFJlOKkynFQZTqoMJ1WGkyrD
+SZXhpMpwUuVKo8pwUmU4qTKcVBlOqgwnVYaTKsNJleGkynBSZTipMpxUGU6qDCdVhpMqw0mV4aTK
+cFJlOKkynFQZTqoMJ1WGkyrDSZXhpMpwUmU4qTKcVBlOqgwnVYaTKsNJleGkynBSZTipMpxUGU6q
+DCdVhpMqw0mV4aTKcFJlOKkynFQZTqoMJ1WGkyrDSZXhpMpwUmU4qTKcVBlOqgwnVYaTKrOqVBla
+qgwtVYaWKkNLlaGlytBSZWipMrRUGVqqDC3V3qmpB6mewVQT1eZU6BpzinqN+U5xjflOcY05Rb3G
+nJ9eYzzDG41nfqPxzG80PvmNpvxGU36j8cxvNJ75jcYzv9F45jcaz/xG45nfaM5MN5oz043mzHSj
+OTPdaDz2G82Z6UZzZrrReJ43mjPTjcZ7X2c80nXmpKDOEF5n2lNn2lNnTgrqTKvqTKvqTHvqTHvq
+THvqTHvqTHvqTHvqjNw6I6vOfNerM7I2GVmbjKxNRtYmI2uTkbXJyNpkZG0ysjYZWZuMrE1G1iYj
+a5ORtcnI2mRkbTKyNhlZm4yszUbWZiNrs5G12cjabGRtNrI2G1mbjazNRtZmI2uzkbXZyNpsZG02
+sjYbWZuNrM1G1mYja4uRtcXI2mJkbTGythhZW4ysLUbWFiNri5G1xcjaYmRtMbK2GFlbjKwtRtYW
+I2uLkbXFyKo3suqNrHojq97Iqjey6o2seiOr3siqN7Lqjax6I6veyKo3suqNrHojq97Iqjey6o2s
+24xH9zbj0W0w+QaTv93kbzf5O0z+DpPfanxoW40PbavxoW01PrStxoe21fjQthof2lbjQ9tqfGhb
+jQ9tq/GhbTU+tK3Gh7bV+NC2Gh/aVuND22p8aFuND22r8aFtNT60rcaHttX40LYaH9pW40Pbanxo
+W40PbavxoW01PrStxoe21fjQthof2lbjQ9tqfGhbjQ9tq/GhbTU+tK3Gh7bV+NC2Gh/aVuND22p8
+aFuND22r8aFtNT60rcaHttX40LYaH9pW40PbanS71fjQGo1/rNH4xxqNJ63R6PxO4zW605S/03iT
+7jTxGPcb39oDJm0x6YMmfdQ7NdXEo6bko+ZE+zHjZXrMPHnMeKLaTJ1txoq1GU9UmynTZjxRbcYT
+9bgp87gp87gp87gp87gp87gp84Qp84Qp84Qp84Qp84Qp84Qp86Qp86Qp86Qp86Qp86Qp86Qp027K
+tJsy7aZMuynTbsq0mzLPGs/Ms8Yz86zxzDxreH7WrIrPGs/Mc6bMc6bMc6bMc6bMc6bMc6ZMl7Hg
+XUZWl5kvXcaCdxkL3mUseJex4F3GgncZC95lLHiXseBdxoJ3GQveZSx4l7HgXcaCdxkL3mUseJex
+4F3GgncZC95lLHiXseBdxoJ3GQveZSx4l7HgXcaCdxnb3WVsd5ex3V3GdncZ291lbHeXsd1dxnZ3
+GdvdZWx3l7HdXcZ2dxnb3WVsd5ex3V3GdncZ291lbHeX2z+6jO3uMra7y9juLmO1u4zV7jJWu8tY
+7S5jr7tMzFiXsdpdxmp3GavdZax2l7HaXcZqdxl73WXsdZex113GXncZe91l7HWXsdddxl53GXvd
+Zex1l7HXXcZedxl73WXsdZex113GXncZe91l7HWXsdddxl53GXvdZex1l7HXXcZedxl73WXsddcM
+piRdxl4HDRtBw0bQsBE0bAQNG0HDRtCwETRsBA0bQcNG0LARNGwEDRtBw0bQsBE0bAQNG0HDRtCw
+ETRsBA0bQcNG0LARNGwEDRtBw0bQsBE0bAQNG0HDRtCwETRsBA0bQcNG0LARNGwEDRtBw0bQsBE0
+bAQNG0HDRtCwETRsBA0bQcNG0LARdHtJ0LARNGwEDRtBw0bQsBE0bAQNG0HDRtCwETRsBA0bQcNG
+0LARNGwEDRtBw0bQsBE0bAQNG0HDRtCwETRsBA0bQcNG0LARNGwEDRtBw0bQsBE0bAQNG0HDRtCw
+ETRsBA0bQcNG0LARNGwEDRtBw0bQsBE0bHQbNroNG92GjW7DRrdho9uw0W3Y6DZsdBs2ug0b3YaN
+bsNGt2Gj27DRbdjoNmx0Gza6DRvdho1uw0a3YaPbUNFtqOg2VHQbKroNFd2Gim5DRbehottQ0W2o
+6DZUdBsqug0V3YaKbkNFt6Gi21DRbajoNlR0Gyq6DRXdhopuQ0K3IaHb7RndhoRuQ0K3IaHbkNBt
+SOg2JHQbEroNCd2GhG5DQrchoduQ0G1I6DYkdBsSug0J3YaEbkNCt2Gg2zDQbRjoNgx0Gwa6DQPd
+hoFuw0C3Gf1uM/rdZvS7zeh3m9HvNqPfbUa/24x+txn9bjPu3Wbcu82495gde4+xIz3GjvSYHXuP
+2bH3mB37K6bMK6bMK6bMK6bMK6bMK6ZMn9mV9ZldWZ/Zj/WZ8n2mfJ/ZlfWZXVmf2ZX1mV1Zn9mV
+9ZldWZ/Z1feZXX2f2dX3mV19n9mt9ZldfZ/Z1feZnVuf2dX3mV3Eq0b6q0b6q0b6q0b6q0b6q0b6
+q0b6q0b6q0b6q0b6q0b6q0bKq6bmV03Nr5qa3zC7jiETiTdkIvGGTM1DpuYhE4k3ZCLxhkwk3pCp
+YcjECQyZSLwdxmrvMBFcO0wE1w4TwbXDRHDtMBFcO0wE1w4TwbXDzJodZr7sMJFCO0wE1w4TwbXD
+RHDtMBFcO0wE1w4TwbXDRHDtMBFcO0wE1w4TwbXDRHDtMBFcO0wE1w4TwbXDRHDtMBFcO8x82WHm
+yw4zX3aY+bLDzJcdZr7sMPNlh5kvO8x82WGihnaYqKEdJmpoh4ka2mEiuHaYCK4dhv8dJoJrh4ng
+2mHihXaYCK4dJoJrh4ngmjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJa
+mjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJa
+mjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamjJamnK1JCRLpk4SXnf3
+KLzujld43R2viHCjLESEG08lItx4KhHhxlOJCDeeSkS48VQiwj2zExFuPJWIcOOpRIQbTyUi3NgD
+EeHGU4kIN55KRLjxVCLCjUYQES7nIsKNpxIRbjyViHDjqUSEG08lItx4KhHhxlOJCDeeSkS48VQi
+wo2nEhFuPJWIcOOpRIQbTyUi3HgqEeFGL4gIN3pBRLjRCyLCjV4QEW48lYhw46lEhBtPJSLceCoR
+4cZTiQg3nkpEuPFUIsKNpxIRbjyViHDjqUSEG08lItx4KhHhxlOJCDf+QUS48VQiwo2nEhFuPJWI
+MNqLcOOpRIR7ji9muqfSYqZ7MitmurZPzHRPpcVM14qJme6ptJjpnkqLme6ptJjpnkqLme6ptJjp
+nkqLma5dEzPdU2kx0z2VFjNdiyZmuqfSYqZ7Ki1muqfSYqZ7Ki1muqfSYqZ7Ki1muqfSYqZ7Ki1m
+uqfSYqZ7Ki1muqfSYqZ7Ki1muqfSYqZ7Ki1muqfSYqZ7Ki1muqfSYqZ7Ki1muqfSYqZ7Ki1muqfS
+IspQEWWoiDJURBkqogwVUYaKKENFlKEiylARZaiIMlREGSqiDBVRhoooQ0WUoSLKUBFlqIgyVEQZ
+KqIMFVGGiihDRZShIspQEWWoiDJURBkqogwVUYaKKENFlKEiylARZaiIMlREGSqiDBVRhoooQ0WU
+oSLKUBFlqIgyVEQZKqIMFVGGiihDRZShIspQEWWoiDJURBkqogwVswwVswwVswwVswwVswwVswwV
+swwVswwVswwVswwVswwVswwVswwVswwVswwVswwVswwVswwVswwVswwVswwVswwVswwVswwVswwV
+swwVswwVswwVs9x9iJhlqJhlqJhlqJhlqJhlqJhlqJhlqPARy1zh42cmvcqklSbdYNIq5oo5pswc
+U2aOKTPHlJljyswxZea6EYZirhthKOa6EYZiruv9EHPdCEMxl+OnBsVcN8JQzHUjDMVcN8JQzHUj
+DMVcN8JQzHUjDMVcN8JQzHUjDMVcN8JQzHUjDIV7F8Ot/4qpWjHX9Y2Iua5vRMx1fSNirusbEXNd
+34iY60YYirncZd6926RNJr3HpPea9D6TbjNy7zfpAyZtMemDJm0z6eMmfcKkT5q03aTPmBZ2mPTn
+Jv2FSZ81rX3OpD2mJS+a8q+Y/K9N/g03dSMMxVw3wlDMdSMMxVw3wlDMdWO0xFw3wlDMdXcOYq7r
+MxHxrq9JxLu+JuHG0e0p5ru+JjHf9TWJ+Ubb811fk5jv+prEfNfXJOa7vibhxtQ9Kea7vibhRtPt
+J9w4utnCjaO7WMw3+pxv9Dnf6HO+0ed8o8/5rq9JzHd9TWK+62sS811fk5jv+prEfNfXJOa7viYx
+3/U1ifmur0nMd31NYr7raxLzXV+TmO/6msR819ck5ru+JjHf9TWJ+a6vScx3fU1ivutrEvNdX5OY
+7/qahBtr57a2w6Q/N+kvTNpj5L5i0jfckq6vSbjxdbVi/gxcLRm9neTuJcRJrpdJnGSen+TOaO92
+9y6hd7sbT+Ld7t4l9G537xJ6t7tx4t7t7l1C73Y3nsS73b1L6N3urore7e5dQu929y6hd7t7l9C7
+3b1L6N3uxpN4t7t3Cb3b3buE3u3uXULvdvcuoXe7e5fQu931K3u3ux5f73bXF+vd7npJvdtd/6V3
+u3uX0LudNlPn4yZ9wqRPmrTdpM8aKc+ZdMhtoRtb4t3u3hz0ThH71YR3ioOnnnNjr6ee9E5xiMkf
+atLDzJPDTf4Ik84z6ZEmPdp8eozJJ5j8sSZ/wlS1d8plzzvlfnfzTnHWVK3X3Wtd7J3ibJM/zzw/
+36RZJv2ZaclVJq006QaTVpm0+qsrvFNcY0rWmXSTSTebdItJ603aaNpwp0kfNU8eMxLbjPTHTfqE
+SZ80abtJnzUlnzNpj0lfMWmfqWfI5HeY/JSbCq9bp4gw+ZkmH2Xys0z+JLfvYTFTz80Q7ko4Q7jM
+zBDuXJshOMTkDzXpYSY93KRHcOQMwTyTP9KkR5vyx5h8gskfa/LHf/WXGYIffFU9QzB/Ss0QnGCe
+J5kni6YGZwjOMk+WmvRsk543td8MwflTSTOEa4tnCHd9niHc9XmGcNfnGcJdn2cId32eIag26TWm
+ZJ1JN5l0s0m3mLTepFuN3EYj5U6TPmrSx0zaZtLHTfqESZ80abtJnzU1PGfSHpO+YtI+8+mQSXeY
+dMpNhdekESadadIok84y6VxXM2K+256wmKknw7RrZcK0a2XCtGtlwrS77oVp18qEadfKhGnXyoRp
+18qEadfKhGnXyoRp18qEadfKhGnXyoRp18qEadfKhGnXyoRp18qEadfKhGl3VQzT7qoYpt1VMUy7
+q2KYdlfFMO1amTDtxrGHadfKhGk3jj1Mu3HsYdqNYw/Tbhx7mHatTJh249jDtGtlwrQbxx6m3Tj2
+MO3GsYdp18qEaTeOPUy7cexh2o1jD9NuHHuYdq1MmHbj2MO0G8cepl0rE6ZdKxOmXSsTpl0rE6Zd
+KxOmXSsTpl0rE6ZdKxOmXSsTpl0rE6ZdKxOmXSsTpl0rE6ZdKxOm3dUyLMa9yRUW436DDotxCQ+L
+cbkNi3E9PGEx7k2usBj3JldYjHuTKyyGFFMyderWsBj3JldYjHuTKyzGvckVFuPe5AqLcb9xh8W4
+37jDYtxv3GEx7jfusBj3G3dYjMtkWIzLZFiMy2RYjMtkWIzLZFiMy2RYjHuTKyzGvckVFuPe5AqL
+cW9yhcW4N7nCYtw42LAYNw42LMaNgw2LceNgw2LcONiwGDcONizGvckVFuPe5AqLcW9yhcW4N7nC
+YtybXGEx7k2usBj3JldYjHuTKyzGvckVFuPe5AqLcW9yhcW4N7nCYtybXGEx7k2usBj3JldYjMt5
+WIzLeViMe5MrLMb11YTFuDe5wmLcm1xhMcJozL3JFRbj3uQKm230NtuN7A2b7c7rsNluZG/YbHf9
+DJttejTb9Gi26dFs06PZpkezTY9mu5G9YbPdyN6w2W5kb9hsN7I3bLY7Q8NmuzM0bLY7Q8NmuzM0
+bLY7Q8Nmu5G9YbPdyN6w2W5kb9hsN7I3bLa7ZobNdtfMsNluZG/YbDeyN2xp2EVTA2HLwi6aeiQs
+I+yiqbvDzg67aKoh7BzzPNOk55lPzzef/sjks8zzH5v0QvMk23yaY/LLzfNck+aZ2laaT1eZT/Pd
+5+z8l/Kdn2YQ4SBGQaWAVQi6Hzxx4KkHzxg4NeAMwR6RsEcK7FEIe7SDNwm8LTCjBULqYM902CsU
+9hqBvQMQmgr7RMM+WbBPC+wbC/vmw75DENYO+yVCRAJEDMPMcpg5CZFZENkK+2fC/m2w/yREFcKs
+Opg1ANH9cEA5xDgQUw2zM8CXDwdmw4F9MCcDDkqA2DE4uA7mZsIhkXBIGRwShLhIiMuCuGqIG4DD
+LDisAQ5PgMN7wd8IRyTCPGBeGczrgSNTID4B4ovhqAQ4qgWOToejx+CYWkgAjs2GYwfhuGw4vhJ+
+0Awn+CFpAn4YhBMb4cQ+OKkDkpOnf/phQSOcnAmnFENKHaQMwqmxcOogpAKpg7CoGRYNwekhcEYm
+nNEEadGQVgNn+uHMEVicCItHYUkqLAnAkn5I90N6G5xVCWc1wVlBOGsYlg7C0nFYFgLLEmFZPiyr
+h4wBOLsVzu6Bs4fg7Ak4JxrOSYZzMuGcYjinDs5ph3Mr4dw2OLcXzh2GzFDITIHMAJyXBOcH4Pwa
+OL8Jzu+HH4XAj2LhRy2Q1Q9ZE3BBNFyQBBfkwwWNcMEQXNgAF7bBhb1w4TBkO5Dth+wUyM6C7ArI
+boLlGbC8EJZXwvIGWN4By0dgRTisKIbcYcidhLxwyEuAvFzIK4eV0bAyDVaWwcpGWNkLK0dhlQWr
+omFVAqxKg1XFsKoaVjXCqnZYNQirxiE/BPITID8FCoCCSChIgoJsKKiEggYoaIOCXigYhoscuCgB
+LsqEi8rhojq4qAUu6oOLRuBi4OJIuDgVLq6BwnAobIbCXigcg9XhsDoRVmfB6gpYXQ+rW2H1EKzx
+w5p0WBOANfWwpgPW9MOaUSgahKJxKPZDcQoU50NxBRQ3QXEHFI/BJYVwySSU+KAkBUqyoCQAJU1Q
+0gclI1BaAYFoCKRCIB8CdRBog0AvBIZhbSVc2gKXBuHSQbh0HNaFwLp4WJcJ64phXTWsa4V1/bBu
+FMosKPNDWQaUFUJZJZS1Qlk/rM+E9eNwmQ8uS4HLAnBZPVwWhMtG4HLg8li4PAkuz4DLC+HySri8
+GS7vhcvHoDwUyhOgPA3Kc6G8HMqboLwDyofhiiT4iQM/6YOfDMFP66DCBxU9cGUm/CwSftYPPxuG
+q8rgqnGobIANAdjQB1UVcHUiXN0O1cVwTRJcmw7X+eC6crhuFGqa4foOuCEabrTgxiDU1kFtE9yU
+Cjdlw01lsNEHG3vh5lyoi4W6IdjUBpsnYUsIbGmG+mSo74NbCuGWCbg1FG6Ng1v74bZsaIiG20fh
+jnFoBBqH4M5WuKsT7vbD3Y3QlABNnXBPAtzTA/c6cK8P7m2Ee1vh3h64dwjunYD7QuG+OLgvGe7L
+hPuK4b5quK8Z7uuB+8agORKa46E5HZqLobkOmlugOQjNg9A8DttCYFssbEuCbRmwLRe2lcO2OtjW
+AtuCsG0Qto3D/T3wQAc8MAItQEsktGRBSwBaaqClCVo6oKUfWkbhwXB40A8PpsCDWfBgAB6sgYdi
+4aEOeKgfHhqFhy14OBoeToCH0+DhXHi4HB6ug4db4OEgPDwID49Dawi0xkJrErRmQGshtFZCawO0
+tkFrL7QOQ+skPBIOj/jhkRR4JAseCcAjNfBIEzzSAY/0wyOj8KgFj0bDo83wWBI8lgGP1UNbCLS1
+wuMd8EQjPFkD7ZXQ3gDtbdDeC+3D0D4JT4XDU354KgWeyoKnAvBUDTzVBE91wFP98HQcPN0Cz4TD
+M2XQkQk/T4Kft8AvUuAX/fBsMjxbB51p0NkEz8XBc63QVQPPp8HzWfB8EILF8MsQ+GUzdOfDC7HQ
+kwY9A/BiHLxYDS+1wK9S4FeD0FsHL0fDy5PwSgf8uhD60qFvHF4Nh1dj4dUE+G069EdDfxP8rhp+
+nwy/H4Q/9MFAEP6YDH8cgNcq4bUJeN2B17Ng0IHBWhgcgTdD4c0h+FMbDDXBn9Pgz0PwVjW8HQ1v
+98BwGQxXw/AYvJMJ7wzDXzLhL8PwbjK82wAjrfBeBryfDe/3wwcV8MEQjAZgdAT+mg8fhsCHQfgo
+Az4ahk9C4JN62A5sH4NPQ+GzWPisDT5Phc8HYSIAX4TCFw3wRQt8WQ47kmBHGuwYhMlwmEyHvznw
+tyB8VQFToTA1gKAOIRIRIoiQdQgVh1CNCKsQYfUh7GSEHUTobITuR3hyEZ4gwolFOOUIZwKxRy5i
+jwbEHkOIPcYQXgfhjUR44xDebIS3BzEjHjGjHRGSgggpRITUIUImEHsWIvbsQewVi9grA7FXLmKv
+CsRejYi9+hB7DSP2thB7+xB71yD2HkTsPYYItRChcYjQNERoGSK0DrGPD7FPNWKfccS+GYiwcERY
+BSKsHRE2ighPRIRnI8InEPvFI/ZLRuwXQOzXiYgAEZGGiKhARNQhIloQER2IiD7EzAbEzAlEZDoi
+sh0R2YuIHEJEjiP2dxD7pyH270REJSCiUhFRWYioICJqDDErBDGrHDGrHjFrGBEdgohOQERnIaLL
+ENE1iOhGRHQQET2MOMBCHFCGOKAHccAoIiYUEZOEiMlExFQjYtoRMX2ImGHE7HTE7ABidjVidgNi
+dhAxewThi0f4ChG+ZoSvA+EbQxxoIQ6MRBzoRxyYjDiwAXFgO+LAfsSB44g54Yg52Yg5lYg5LYg5
+44iD4hAHVSMOakIc1I44qBdx0BDioDFErIWIjUTEJiFicxGxNYjYFsTBcYiDGxAHtyEOHkXMDUfM
+DUfMzUDMLUTMrUDM7UTM7Ucckog4JA1xSDbikHLEIfWIuBBEnA8Rl4qIy0TE5SPi6hFxPYi4CcSh
+cYhDExGHpiIOLUccWoM4tAlxaAfi0F7EoYOIw/yIw7IQhwUQh1UiDmtEHNaBOGwAcdgY4vAQxOGx
+iMOTEIenIQ7PQhxeh/CD8Ici/D6EPx7hH0L4xxBHgDgiF3FEAHFEJeKIYcQR44h5FmJeOGJeLWJe
+I2JeK+LIYsSRFYj4cER8EBE/gjgqBHFULOKoJMRRGYijChFHVSKO7kccU4w4ZgyR0I84tglxXCri
+uCDi+BrE8YOIxCxE4hAicQzxgwrE/CTE/DTE/B7ECVmIJAeR1IBImkT8sBpxYiripAzESSOI5HJE
+ch0iuQWRHEQkDyKSxxELEhAnW4iTWxCn5CJOqUCcUo9IyUYsDEUs7ECcGkCc5kOcFkSkZiNSixGL
+fIhF5YhFo4jTcxGndyLOGECktSLOTEGc2YdYXIlYUoxYMoJIz0ScFY44qwexNAuxdByxLBexrA+R
+UYg4OxZxdgLi7BTE2RmIs4OIc+IR5yQjzklHnJONODcecW4HIjMJkZmGyMxFZJYjMusQ5yUgzutE
+nDeAOL8ScX4d4vwmxPltiPODiB9lIX40isjKQmQFEFk1iKwmRNYk4oIQxAU1iAsaEBf0I36cjfhx
+MeLCXER2NCK7B5HjIHIaEDmTiOUhiOXRiOV+xPIAYnklYnkdYnkTYkUcYsUgYsUoIjcFkduIyJ1E
+5OUj8kYQK0GsDCBW+RCrhhD55Yj8GkRBBqJgDHFRHOKiVsRFnYiLWxGFAUThMGJ1LGJ1G2JNOGJN
+A2LNOKLIQhSlIYqyEEWFiKJWRFEnorgZUdyOuCQUcUkN4pIRREkGoqQPUTKCKM1ElE4iAo2ItfGI
+tamItSOIS2sQlzYh1qUi1mUi1uUj1pUh1lUjymIRZe2I9amI9dmI9WWI9bWI9SOIyzoRl/UhLi9G
+lFuI8jrEFRbiilTEFdWIK4YRP0lC/DQWUeEgKioRFZOIK0cQPwsiropDXFWDuKoBcVUL4qoJRGUq
+ojIbUVmG2BCN2NCIqKpHXF2JuLoJcXUQcfUg4upxRHUt4po4xDUBxLUhiGuTEdfmI66tRVzbh6gJ
+R9S4v0cQ14O4vgFxQxLihgbEjbGIG3MRNzYjah1EbT6idhxxUyXiphHExlTExmzExjLExg7EzSBu
+TkPcXIe4eQhR50fU9SI2FSI2DSI2pyI2tyC2hCC2NCHqkxC3xCNuDUHcGou4NQlxaz3i1jHEbSBu
+a0DcNoxoiEM0FCMa2hG3xyFur0fcEYK4w/09gtiagNhah9jahGisQNxZjrgrEnFXK+KuTsRdA4i7
+xhB3pyHubkXcPYloSkU0dSLuSUTcE0Dc04q4Nw5xbzviPgtxXzrivmzEfTWI+1oQ9w0imsMRzbGI
+5gREcyaiuRzRXINobkA0tyGaexHNw4jmScS2cMQ2P2JbEmJbGmJbLmJbOWJbHWJbC2LbIOJ+B3F/
+JOL+OMT9yYj7MxH3FyPur0bc34i4vx1xfx/i/hHEAyAeiEQ8EI94IBXxQB3igSHEAxOIllBESwPi
+wTjEg9WIB0cQDyUiHqpGPAzi4XJEaxrikUjEIxOIRwcRj9Ui2tIQbWOIx2sRjzcinkhAPBFEPJmG
+eLIP0Z6JaM9HtJch2icQT5UhnhpEPJ2CeLoJ8Uw04plqRIcP0RGP6EhGdKQjOrIRHW2In4P4eQvi
+F1mIZy3Es62IZ4cQnaGIzmpE5xDiuXTEc02I5yYRXQFEVyOiawzxfB0iCCKYhggWI4J9iF9WIn7Z
+h+j2I7pHES8kIV7oQPSEInpaEC+CeLEa8WI/4qUUxEuNiF+lIX7VhPhVG6LXQfS2IV4G8XI74uUh
+xCvZiFdGEK9MIH5dj/h1H+LX44i+UERfJqKvENE3jPiNhfhNBeI3vYhXUxGv1iJeHUb8thDx23rE
+bwcR/ZWI/gnE75IQv8tC/G4A8ftcxO+bEb9vR/yhHPGHIGIggBjoRAyMIv6YiPhjJ+KPfYjXshGv
+DSJer0cMWojBcMRgNmKwH/FGNOINP+KNNMQbQcSbqYg3MxF/AvGnNMSfihF/qkf8aQAx5CCGshBD
+nYihEcSfQxF/rkX8uQPx5wnEWz7EW/GIt2oRb3Ug3hpBvG0h3o5HvJ2PeLsO8XYHYthCDGcghmsR
+75Qh3hlH/KUO8ZdhxLvZiHcbECPhiJFKxHspiPfc3xOI9ysQ7w8jPshEjCYgRgOI0QHEX6sRH/oQ
+H/YiPqpDjPkRY2OIj8sQH08gtocitrcjtvchto8gxkGMxyPGyxCfRiM+7UV8FoL4LBPxWRnis37E
+5xbi8zLE56OICQsxEY2YSEBMpCEmchETFYiJesREK2KiBzExhJiYQHyRjfiiDPFFL+KLYcSXcYgv
+kxE7QOyIROwYROwYR0xmIybLEJMTiL+FIv4Wh/hbMuJvmYivwhFfBRBTIKYiEVMVSEDSgiSIZAwp
+apGiHSn6kTIbKcuRcgKpkpCqGqkakKoVaSUhrVykVYG0WpF2CNKuQepwpC5H6iDSE4n0FCI9w0gn
+EulkIp1ypNOK3CMcuUcQuccQ0puA9KYhvblIbznS24D0tiG9vUjvMNI7iZwRjpzhR85IQc7IQs4I
+IGfUIGc0IWd0IGf0I2eMIkNCkCGxyJAkZEgGMqQQGVKJDGlAhrQhQ3qRIcPIkEnkntHIPROQe6Yh
+98xF7lmJ3LMBuWcHcs9+5J6jyL0s5F7RyL0GkHuNIfcORe4dh9w7Gbl3JnLvYuTe1cjQaGRoGzK0
+Fxk6jAydRO4TjtzHj9wnBblPFnKfAHKfGuQ+Tch9OpD79CP3GUXuayH3jUXuW4nctwG5bwdy335k
+WB0yrA0Z1osMG0aGTSLDw5HhscjwJGR4BjI8FxkeQIZXIsPrkOFNyP3ikPs1IyNCkRE+ZEQ8MiIZ
+GRFEzoxGzsxHzmxFzpxERiYjIyuQkX3I/f3I/XuQ+08gowLIqF5k1DhyViJyVgUyOh4ZXYM8IBp5
+QDHygCAyJgkZ04GcHYmc3Yb0hSB9NUjfAPLAFOSBQ8g5icg5QeRBDvKgAPKgcWRsEjK2Bhk7hjy4
+GTk3Hjk3Czm3CTm3F3lIHPKQOuQhg8i4FGRcITKuBRk3hjw0HnloAHloH/LQSeRhDcjDs5H+eKR/
+AHlEBXJeAvLIUOSRg8j4fORRfuRRY8ijW5HHZCKPGUcm1CKPzUYeF4o8rh95fD0yMQSZOID8QT1y
+fhnyBB/yhBFkUhPyh9nIE5ORJ/YgTxxGnhRAnjSGTHaQyYXI5EHkggzkggHkyT7kyQ3IU0CeUoZM
+CUemNCNTOpELU5ALR5CnZiFPC0Welog8rROZmoVMbUamdiAXOchFhchFo8jTc5GnjyHPKEOmRSPT
+BpFnpiEXpyMXdyKXNCPT25Fn+ZBnFSLP6kAujUcuLUMu7UUui0cuq0Mu60Bm+JAZtcizLeTZmciz
+h5DnxCHPKUOe048814c8twx57iQyswKZ2Yk8z0Kel4I8rxl53gjy/HTk+R3IH4Ujf1SJ/FEvMisc
+mZWLzGpEXhCKvCAdeUEj8sdJyB9XIn88grwwF3nhGDI7GZndgsyJRebUInP6kcsTkMv7kSuSkCvq
+kbkgc7OQue3IvERkXiUybwy5MhO5ykKuykKuGkDmJyDz25AFociCCmRBL/KiVORFnciLQ5AXVyIv
+nkAWFiILe5Gr45Grm5Fr4pBrGpFFIciifGTRMLI4BVkcQBZ3Ii/xIy9JQV6SgbykEHnJAPKSMWSJ
+gyzxIUtqkSXNyJJOZMkAsmQMWeogS33I0kRkaTqyNB9ZWoEsrUeWtiJLe5ClQ8jSCWQgFBmIQwaS
+kYFCZKAGGWhBBkaRa/3ItfnItS3ItUPIS+OQlyYjL81GXlqDvLQVeWkP8lL3swnkulDkOh9yXSJy
+XSpyXTZyXRC5bhC5bhxZFoIsK0aWVSPLGpFl7ciyPmTZCHI9yPWRyPXxyPWpyPXZyPVlyPW1yPXN
+yPWdyPUDyPVjyMsykJdVIi+rQ17Wgrw8Enl5PPLyeuTlrcjLe5CXDyHLU5Hl2cjyMmR5LbK8GVne
+iSwfQJaPIa9wkFf4kFckIq9IR16Rj7yiAnlFPfKKVuQVPcifxCJ/koT8SQbyJ4XIn1Qifwryp5HI
+n9Yhf9qCrPAjK1KQFVnIikFkxTjyyhDklbHIK5OQV2YgryxEXlmJvLIBeWUb8spe5JXDyCsnkT8L
+R/7Mj/xZCvJnWcif9SOvikNelYy8KhN5VTHyqmrkVYPIq8aRlWnIylxkZQeysh9ZOYrckILckIXc
+EEBuqEFuaEJu6EBu6EduGEVWWciqaGRVArIqDVmVi6wqR1bVIatakFVB5NXRyKszkVfXIq9uRl49
+hLx6Alkdj6xORVZnI6uLkdXVyOpGZHU7sroPWT2CvAbkNZHIa+KR16Qir8lGXlOGvKYWeU0z8ppO
+5DUDyGvGkNf6kdfWIK9tQV4Xh7wuGXldJ/K6AWRNOrImH1lTgayZQF4firw+Dnl9MvL6TOT1xcjr
+q5HXNyKvb0fe4CBvSEXe0IC8YQx5o4O8MQt5YwB5Yw/yxiHkjRPI2jRkbS6ythxZW4esbUHWBpG1
+g8jaceRNIcibYpE3JSFvykDeVIi8qRJ5UwPypjbkTWPIjX7kxgrkxnrkxjHkzQ7y5izkzQHkzTXI
+m4eRN08i68KRdX5kXQqyLgtZF0DW1SDrmpB1Hci6fmTdKHKThdwUjdyUgNyUhtyUi9zUhtyciNxc
+iNwcRG7xIbckIre0I7f0IevTkfX5yPoKZH09sr4VWd+DrB9C1k8gbwlF3hKHvCUZeUsm8pZi5C3V
+yFsakbe0I2+NR95ajby1EXnrJPK2cORtxcjbqpG3NSJvm0Q2hCMb/MiGFGRDFrIhgGyoQTY0IRs6
+kA39yIZR5O0W8vZo5O0JyNvTkLfnIm8vR94+iLx9FHlHHPKOOuRWkFtzkVvLkVuHkFsnkI3ZyMYy
+ZOMQ8s5U5J3ZyDuDyDsHkXeOI+/KRt7VgLw7HXl3PrIpGtmUgGwaRd5jIe9pR97Th7xnBHkvyHsL
+kfeOIu8rRN43gmzORTaXI5vrkNvCkdv8yG0tyG1B5LZB5LZx5P2FyPv7kA8kIh9IRz7QhnygF9kS
+j2xJRba0IFs6kC29yJZhZMsk8sFw5IN+5IMpyAezkA8GkA/WIB8KQT5Ug3w4FPlwPfLhVuTDPciH
+h5APTyBbQ5GtccjWZGRrG/KRbOQjQ8hHJpCPBpCP1iAfC0E+Fot8rBD5WDPysWHkY5PItkRkWzqy
+rQbZ1oRsG0I+not8PIB8fBj5RDjyiQDyiRbkE0HkE4PIJ8aRT4Ygn4xFPpmEfDID+WQh8slq5JOt
+yCeHkO3RyPZsZHsrsn0A+ZSDfCoF+VQ18qkB5NMhyKczkU93IJ9JRD7Ti+wA2ZGK7GhC/jwc+fN6
+5M+HkL9IRP6iGPmsg3x2CNnpIDuTkJ3FyM5mZOcE8rl+ZFcysisd2VWP7BpDPu9DPt+KfL4fGQxF
+Bn3IYCYymI8MliGD1chgPTI4hvxlKvKXA8juOmR3H/KFEOQL6cgXOpE98cieZGRPObKnE/liNPLF
+WuSLjcgXW5EvdiJfCkW+FI98KRn5Uj7ypTLkS/3Il4aRv4pE/ioX+ashZG88sjcb2VuLfBnky/HI
+l9OQL5cjX25FvtyHfMVBvpKIfCUf+UoP8tfVyF/3I/uSkX35yL4WZN8w8je5yN+MIl/NQP7Wj/xt
+LfK3E8j+QuTvwpG/a0b+PhH5+17k78eQf4hF/qETOZCBHBhA/jEZ+cdy5B/bka9FI1/LQr7WjHxt
+HPl6MfL1euTrE8jBeORgO/KNBOQbNcg3GpFvtCHf6EG+MYh8Ywz5poV8Mxv5ZgD5ZjXyTw7yT5HI
+P2Ui/zSMHPIhh6qRQyPIPxci/zyJfKsG+VYf8u1w5NsNyLdHkMM+5HA6crgcOdyOfCcU+U4K8p1K
+5DtNyL8kIf/Sjnw3EvluOfLdFuRINHKkBfleBvK9JuR7k8j3c5HvDyI/iEV+UI38oBX5wRBy1I8c
+rUaOjiP/mo38awXyr03Iv/Yg/zqC/DAc+WEK8sMs5IcB5Ic1yA+bkB92ID/sR344ivzIQn4Ujfwo
+AflRGvKjXORH5ciP6pAftSA/CiI/GkR+NI4cC0GOxSLHkpBjGcixQuRYJXKsATnWhhzrRY4NIz+2
+kB/HIj9OQn6cgfy4EPlxJfLjBuTHbciPe5EfDyM/nkR+Eo78xI/8JAX5SRbykwDykxrkJ03ITzqQ
+n/QjPxlFbreQ26OR2xOQ29OQ23OR28uR2+uQ29uQ23uR24eR2yeR4+HIcT9yPAU5noUcDyDHa5Dj
+TcjxDuR4P3J8FPmphfw0GvlpAvLTNOSnuchPy5Gf1iE/bUF+GkR+Ooz8dBL5WTjyMz/ysxTkZ1nI
+zwLIz2qQnzUhP+tAftaP/GwU+bmF/Dwa+XkC8vM05Oe5yM/LkZ/XIT9vQX4eRH4+jJywkBPRyIkE
+5EQaciIXOVGOnKhDTrQgJ4LIiUHkxDjyixDkF7HIL5KQX2QgvyhEflGJ/KIB+UUb8ote5BfDyC8m
+kV+GI7/0I79MQX6ZhfwygPyyBvllE/LLDuSX/cgvR5E7LOSOaOSOBOSONOSOXOSOcuSOOuSOFuSO
+IHLHIHLHOHIyBDkZi5xMQk5mICcLkZOVyMkG5GQbcrIXOTmMnJxE/i0c+bdc5N9akF+lIL/KQH5V
+iPyqEvlVA/KrNuRXvcivhpFfTSKnwpFTfuRUCnIqCzkVQE7VIKeakFMdyKl+5NQoCgtFNIoEFGko
+clGUo6hD0YIiiGIQxThKhKBELEokoUQGShSiRCVKNKBEG0r0osQwSkyiZDRKJqBkGkrmomQ5Stah
+ZAtKBlFyECXHUSoEpWJRKgmlMlCqEKUqUaoBpdpQqh+lRlGWhbKiUVYCykpDWbkoqxxl1aGsFpQV
+RFmDKGscZYeg7FiUnYSyM1B2IcquRNkNKLsNZfei7GGUPYnS4SjtR+kUlM5C6QBK16B0E0p3oHQ/
+ygPKk4TyZKA8hShPJcrTgPK0oTy9KM8wyjOJcsJRjh/lpKKcbJRTjnLqUU4ryulBOUMoZwK1Ryhq
+jzjUHsmoPTJRewRQe9Si9mhB7RFE7TGE2mMS5Q1Hef0obwrKm4XyBlDeGpS3CeXtQHkHUN5x1IxQ
+1Iw41IwU1Ixs1Ixy1Ix61IxW1Iwe1Iwh1IwJVEgoKiQOFZKMCslEhQRQIbWokBZUSBAVMoQKmUTt
+GYnaMwG1Zxpqz1zUnuWoPetQe7ag9gyi9hxE7TmO2isUtVccaq8U1F7ZqL3KUHvVovZqRu3Vidpr
+ALXXGGpvB7W3D7V3EmrvDNTexai9a1B7N6H27kDt3Y/aexQVaqFCo1GhCajQNFRoLiq0AhXagApt
+Q4X2oUJHUfs4qH1iUfskofZJQ+2Ti9ongNqnBrVPA2qfNtQ+QdQ+g6h9RlH7Wqh9w1H7+lH7JqH2
+zUDtW4jatxK1bwNq3zbUvr2ofYdR+06iwsJRYX5UWAoqLAsVFkCF1aDCmlBhHaiwflTYKCrcQoVH
+o8ITUOFpqPBcVHg5KrwOFd6CCg+iwgdR4eOo/UJQ+8Wi9ktC7ZeB2q8QtV8lar8G1H5tqP16UfsN
+o/abREWEoyL8qIgUVEQWKiKAiqhBRTShIjpQEf2oiFHUTAs1Mxo1MwE1Mw01Mxc1sxw1sw41swU1
+swM1sx81cxg1cxwVmYuKDKAia1CRTajIDlRkPypyFLW/hdrfh9o/HrV/Gmr/LNT+AdT+taj9m1H7
+d6L2H0DtP4aKclBRkaioeFRUKioqGxVVhoqqQ0U1oaI6UVEDqKgx1CwHNcuHmpWImpWKmpWNmlWG
+mlWLmtWMmtWJmjWAmjWOirZQ0T5UdCIqOhUVnYmKLkZFV6OiG1HR7ajoPlT0KCp6EnVAJOqAONQB
+yagD0lEHdKAO6EAd0PFv/IygDphAxcSiYtJRMeWomCZUTAcqph81OxQ1Ow01uxI1uxnls1C+bJSv
+A+UbQx2YijqwFzUnBDXHj5qTgZpTh5ozgjqI//gntgZ1cAPq4A7Uwf2og8dRc0NRc+NQc1NRcwtR
+cwdRh6SjDqlAHdKAiotExflRcTWouFHUoZGoQ5NQh2aiDm1EHdqHOgzUYZGow+JRh6WhDmtHHTaG
+OtyHOjwddXgu6vA61OEtqMN7UIePovxpKH82yt+B8o+hjohEHZGJOqIJdUQPap6FmleImteAmjfw
+X/850o86shgVX46KH0YdFUAd1YA6Og119ADqmBDUMXGoY9JRx1SjjgmiEhJRCWmohGxUQgCVUI1K
+aEAltKIS3M8HUAmjqGNBHRuOOjYOdWwS6th01LG5qGPLUMfWoI5tRB3bhjq2B3XsIOrYMdRxFuq4
+SNRxftRxyajjMlDH5aOOG0QdX4NKjEYlZqESg6gfpKJ+0Iua34o6oQKV5Ecl1aKSmlEnZqFObEKd
+OIlKrkYtiEUt6ECdnI46uQN1ShbqlFFUig+V0ohaaKEW5qIWVqMWNqMW9qEWTqBOjUedGkCd2oI6
+rRCVmoJK7UCl9qMWTaJOr0edEY86Ixl1RgbqjEJUWgvqzAbUmaOoxamoxc2oJRmoJSOo9B7UWamo
+s4KopQ4qoxV1dibqnBDUOeWoc6NRmQmo8+JR5w2hzp9A/agRlZWMympEXeBHXVCBuqAT9WM/6sdJ
+qB+noS5MRl0YQF1YibqwDpVdg8pJR+UMoJZnoFZEo1ZMonLjUHmFqLxO1Mpc1Mpx1KoK1KoJVH4y
+Kr8NVZCIKuhFXZSMuqgYdVEb6mIHdXEZ6uJW1MUjqMJwVGE9anUqavUIao0PtaYaVeRDFRWjimpR
+Ra2oogFU0QSqOBJVnIgqzkQVl6GK61HF7ajiAVTxBOqSSNQliahLMlGXFKMuqUBdUo+6pB11SR/q
+kiHUJROokkhUSTKqJBNVko8qqUCVNKJKWlElnaiSPlTJEKpkDFXqoErjUKXJqNJ0VGk+qrQaVdqK
+Ku1BlQ6gSsdQgVBUwIcKxKMCyahAOirQj7o0HbUuEbUuFbUuE7UuiFo3jCqrQ61PQa0fR13WhLo8
+iCofQV0RiboiFXVFGeqKZtQV46ifJKN+MoL6aS3qp4OoijhURQBV0Ym60kFdmYG6shZ1ZS/qZ7Wo
+n/WirvKhrmpEVfpRlWOoDW2oqh7U1XGoq4tRVzejrh5CVUeiqtNR1dWo6k7UNSmoa4Koa0FdW4i6
+Lhx1XQeqxo+qaUDVBFE1o6jrs1HX96GuH0fdEIm6IQF1QzrqhkLUDW2oGyZRN6agbqxB3diMujGI
+qvWjaltQN4WjbspA3VSHuqkftTEctbEYtbEXdXMI6uY01M01qJt7UXUhqLo0VF0zapOF2pSF2tSG
+2jSJ2pyC2lyL2jyI2hKJ2pKB2lKP2jKIqo9G1Weh6jtRt4SjbgmgbulH3ZqMurUTdVs46rZM1G0N
+qNsmUA39qIYx1O3pqNvrULcPou5IRN1Ri7pjFLU1C7W1FrU1iGoMRzWmo+6MRd3ZgbpzDHVXJuru
+SNTd5ai7W1BNoaimNtQ9DuqeQtS9oah7c1H31qDu7UPdF466rwV13wCqORzV3Ixqbkc196K2RaK2
++VHbklDbGlDb2lDbelH356IeiEY9kIR6IBvVEoJqaUe19KMeTEY9mI96yI96KBP1UAXqoSDqYQv1
+cAbq4ULUwzWo1kzUI7WoxxJRjw2g2hpRj+ejHq9BPd6KerwX9fgE6olQ1BMpqCcCqCfqUE+Mo57M
+RD3Zj2qPRrWXo9qHUE+lo55qRz2djHq6DfVMBuqZcVTHCOrndahfpKKeTUA924rqdFCdQdRziajn
+0lHPFaOeq0U914p6rh/13DiqKwPV1Yl63kE9n4Z6vgb1/AAqGI0KTqJ+WYHqDkF116O6x1AvZKNe
+6EX1ZKF6RlAvxqNeLEa9OIx6KQP1UgPqpSHUr/JRvxpE9SahehtQL0eiXq5DvRKOeqUG9etk1K/H
+UX2JqL4K1G98qN90oH4zgXo1HvVqJerVftRvc1G/HUP156P6h1C/i0P9rhD1uwHU71NQv29A/X4U
+9Ydy1EAIaqACNTCO+mMG6o+FqD/2ol4LQb2WjnqtEvVaO+q1IdTrFur1PtRgJeqNDNSboag3g6g/
+taCGalF/Tke9ZaHeykC9VYt6axL1dgLq7WLU23WotztQbw+hhi3UcAJqOBc1XIca7ke9E4p6JwP1
+TivqLxbqL8Wov0yg3q1AvduKencQNRKOGklDjbSj3otFvRdAvdeOem8Q9X4o6v1U1PvlqPf7UB+A
++sCP+iAT9UEHajQaNZqOGi1Hjbai/hqN+ms26q+NqL8OoT4MQX2YhPqwGfXhEOqjaNRHjaixWNRY
+A+rjcNTHxaiP+1GfhKA+SUZ90oza7qC2Z6O2t6G2j6LGY1HjZajxXtSn2ahPx1GfZaE+C6I+D0V9
+noX6fBA1kYmaGEF9EUB90Yv6Mhb1ZT1qh4Pa4UftSEPtKEbtqENNOqjJYtRkD2pyCPW3DNTf2lBf
+xaG+qkNNOaipYtRULxbJWDRhMYAl0rFEOZZowxLDWDIUS8ZhyVQs2YglO7HkEJYCS/mwVCqWKsZS
+9ViqE0sNYVkJWHY8lp2BZZdh2fVYdjuWPYCl47F0NZbux/KEYHnKsDwDWCG1WCGTWHsWY+1ZgbVn
+LdaejVh7tmLtOYS1lx9rr2KsvZqx9gpi7TWJtXcC1t7pWHtXY+3djLV3EGvvEazQEKzQOKzQVKzQ
+cqzQfqx9QrD2icPaJxtrn3asfUax9nWw9s3C2rcWKywRKywVK6wNKzwOKzwda79OrJlBrKgMrFnD
+WAeMYfkysA7sxYrtwTokHuvQBqxD27EO7cc6dAzrsBCsw5KxDivDOqwV67BRrMNjsQ7PxTq8Bevw
+CSx/NJY/Gcufi+WvxvK3YPn7sPzjWEdEYh2RiHVEFtYRFVhHNGEdEcQ6YhhrnoU1Lw5rXjrWvDKs
+eU1Y8/qw5k1gHRmHdWQG1pHlWEc2YR3Zi3XkBFZ8LFZ8GlZ8OVZ8M1Z8H1b8BNZRsVhHpWMdVYZ1
+VCPWUb1YR01gHe3DOjoF6+hirKMbsI4OYh09inVMJNYxKVjHFGId04B1TB9WgoWVkIiVUIiV0IiV
+0I91bAjWsSlYx5ZjHduGdewI1nHRWMelYx1XiXVcO9Zxg1jHO1jHJ2Adn411fDXW8a1Yxw9iJTpY
+iUlYiflYiXVYiT1YieNYP/Bh/SAD6wcVWD9owfrBENb8UKz5KVjzq7Hmt2PNH8U6wY91QjbWCbVY
+J/RhJVlYSQlYSQGspA6sH4L1wyysH9Zg/bAD64ejWCdGY52YhHViJtaJ9VgntmGd2Id14ijWSQ7W
+SbFYJyVjnZSFdVIZ1kkNWCcFsU4awUoOxUqOxUpOxkrOwkouw0quw0puxUruxUoewVpgYS3wYS1I
+wlqQibUggLWgFmtBC9aCHqwFg1gnN2Ol5GItjMNamIS1MB1rYSHWwgqshXVYC9uxFvZjLZzAOjUa
+69R4rFNTsU4txDq1GuvUNqzTQrFOi8M6rQLrtCGsRdFYi5qwTvdjnTGKleZgpSVgpaVjpZVjpdVj
+pQWx0oaxzozEOjMB68wsrDPLsM5sxDqzA+vMEazFFtbieKzFaViLy7EW12MtDmItHsJaEoK1JA5r
+SQbWkmKsJQ1YSzqwloxipYdjpWdgpbdinZWMdVYd1lmTWMssrGXtWBkBrIwBrLPjsc6uxjp7GOuc
+ZKxzmrHOmcQ6Nxbr3DSsc6uxMsHKzMDKbMbKHMA6z8E6LxHrvHys81qxzpvAOj8c6/x4rPNTsc7P
+xjq/Gev8AawfOVg/SsD6UTbWj5qwfjSClRWOlZWEldWMlTWCdUEc1gX5WBc0Y/04DuvHzVgXxmFd
+2Ix1YR/WhRNY2T6s7DSs7Hys7Dqs7Fas7AGsnB6s5QlYy0exLu7DWtOGtWYUqygdq6gRq9jCKq7H
+uiQXqyQeq6QOqzQRa20HVlkjVtkA1vp0rPVBrMuisS4vx7oiDuuKYqwrmrB+Uo/100KsCgurogHr
+ymysn8Vh/WwU66pWrKsTsa5uw6oewLq2DOv6Yawb8rFuGMO6MRfrxh6s2jSs2kGsjbFYN2dg3TyG
+VVeJtSkRa1Mm1qYyrE31WJvasTYNYG2awNocibU5EWtLK1Z9DdYt+Vi3hmPdFo91WxCrIQPr9hCs
+2yuxbp/EuqMY644erK2xWFvbsRpzsRp7se5MxrpzAOuuZKy7wbp7CKupDeueOKx7+rDu9WPd24N1
+XxtWcwLWtiysB6qxWmKxHkzGeqga6+EGrEfisR6Nw3q0GeuxYqy2LKy2MazHc7GecLCeSMd6Ygzr
+yXas9hCs9iSsp7Kxnk7HeroW6+lRrGfSsZ5pxeqwsDrSsTqGsX7ejPWLWqzOBqznarG62rCeH8MK
+jmF1h2O9kIrVk4j1YgjWS5FYv0rH6m3GerkV65U0rFc6sH5djNWXi/WbBqzfRmL1D2D9LoD1+wSs
+3zdg/SEO6w8pWH8oxPpDJdYfGrD+0Ib1h16sgTSsgQasP/qwXovEei2I9foI1mAT1ht+rDf6sd4c
+xBrqwXq7BuudNKx3arHe6cP6SwbWXxqx3q3AGsnHei8d670hrPeDWB8MYY0Gsf7ahPVhJNaH2Vgf
+DmB9NIj1cRzWJ8VYn7RjbU/D2t6ENQ7WeCbWeA/Wp/FYn9ZjfQbWZ81Yn1djTTRgfRmC9WUT1mQS
+1t+asaaSsOUktlWNrR1s3YbtKcT2+rFn9GKHjGPvOYa9dyR2qIMdWocdOoG9Tzb2Ps3Y+4xj79uJ
+HdaOHZ6CHV6OHd6BvZ+FvV8i9n5N2BHx2DOjsWe2YUeWYUeBPasSO2YYe3YK9uxxbF8utm8M+8AK
+7DnJ2HN6sQ9Kwz6oHzs2DTu2CfvgSOyDK7EPHsSe2449dxL7kAzsQ5qw48Kx44awD2vA9jdhHzGI
+HR+JfVQZ9jFj2D9Iw55fiZ00gv3DXuwT67BPysdOHsU+uRD7lF7shX7sU8uwTxvGTk3HTu3AXhSL
+vagGe9EY9ukh2KfHYZ+egn16Jfbpfdinj2CfAfYZw9hp5dhnJmKf2Yy9OBp7SRJ2ehx2eh32WRb2
+WcnYZ5Vjn9WEfVYf9lkT2EtjsZemYS8tw17ajL10AHtZEvayZuyMWOyMPuxzQrHPqcc+txX7vBTs
+89qxsyzsC2qxL7SwLxzAzh7EzhnHXt6HvaIPO3cEO68Ne+UQ9qoJ7II47IJm7IuysC+Ow764Dbsw
+F3u1H3t1E/aacuyiFOxiB7s4Ebs4F7u4Fru4A7t4FPuScuxLmrAv6cUu8WGXBLBLGrBLerFLJrFL
+o7FLC7FLO7BLR7ADkdiBbOxAM/ZaC3ttFvbaFuxLwb40C/vSdux1sdjrerDLfNhl+dhlndjr07HX
+92Nflo99eSL25T3Y5RPYP/Fj/6QT+6ex2D+txP5pJ/ZPx7Er4rAr0rEryrArarErmrGvBPvKTOyf
+RWP/bBD7qgHsymrsDYnYVZnYVfXYVQPYVydgX12MfXUL9tUT2NUp2NU90z+D2NVj2NeEYF/jw74m
+CfuaTOxryrGvqcW+pgX7mh7sa0axr3Wwr/VjX5uOfW059rW12Ne2YF/bg33tKPZ1DvZ1fuzr0rGv
+K8e+rh77ug7s64awa0Kwa+Kxa7Kwa6qxazqwa/qwa0axr3ewr/djX5+KfX0h9vW12Nd3YF8/gH39
+JPYNPuwb0rBvKMa+oQH7hh7sGyaxb4zEvjEJ+8Zs7BtrsG9sxb5xELvWwa5Nwq7Nxq6twa5tx64d
+xb4pEvumNOybKrBv6sC+qQ/7plHsjQ72Rj/2xlTsjYXYG2uxN3ZgbxzA3jiJfbMP++Y07JuLsW9u
+wL65B/vmSey6SOy6JOy6bOy6Guy6Vuy6QexNDvamJOxN2dibarA3tWNvGsXeHIm9OQ17cwX25g7s
+zQPYmyext/iwt6RhbynG3tKAvaUHe8skdr0Puz4Nu74Mu74Fu34A+5YQ7FuSsW8px76lEfuWXuxb
+JrBv9WPfmol9aw32rZ3Yt05i3xaHfVsW9m212Lf1YjeA3ZCE3VCG3dCB3dCH3TCKfbuDfbsf+/ZU
+7NsLsW+vxb69A/v2AezbJ7Hv8GHfkYZ9RzH2HQ3Yd/Rg3zGJvTUSe2sS9tZs7K012FtbsbcOYjc6
+2I1J2I3Z2I012I3t2I2j2HdGYt+Zhn1nBfadHdh3DmDfOYl9lw/7rjTsu4qx72rAvqsH+65J7Lt9
+2HenYd9dhn13C/bdA9hNIdhNydhN5dhNjdhNvdhNE9j3+LHvycS+pwb7nk7seyax743DvjcL+95a
+7Ht7se8D+74k7PvKsO/rwL5vAPu+SexmH3ZzGnZzMXZzA3ZzD3bzJPY2H/a2NOxtZdjbWrC3DWDf
+H4J9fzL2/eXY9zdi39+Lff8E9gN+7AcysR+owX6gE/uBSeyWOOyWLOyWWuyWXuwHwX4wCfvBMuwH
+O7AfHMJ+KAT7oUTshwqxH6rHfqgX+2GwH07Cfjgf++EG7If7sFtDsFuTsVvLsVvbsVsnsR+Jw34k
+C/uRWuxHerEfBfvRJOxHy7Af7cB+dAz7MT/2Y/nYj7VgPzaC3ebHbivGbuvAftzBfjwD+/EG7MeH
+sZ9oxX6yDLs9C/upJOynqrGfCmI/DfbTCdhP52M/XYv9tPtsAvuZSOxnsrGfacfuKMP+eRr2L+Kx
+f5GJ/YtO7GeTsJ9txu7MwO4cx34uH/u5CeyuGuzn47Gfb8MOpmEHe7B/mY79y37s7gTs7jrs7ibs
+7g7s7kHsFyKxX0jHfiEf+4UK7BfqsV9oxX6hB/uFIeyeVuwXy7Ff7MV+KRr7pSHsXzVg94Ldm4Xd
+O4T9cjb2y2PYr1RgvzKI/etk7F83Y/eFYPelYvdVYvc1YPe1YfdNYP8mGfs31di/GcB+NR371V7s
+32Zj/3YCu78cuz+I/bsQ7N8VY/+uD/v3sdi/L8P+/ST2H8qxB7Kw/5iE/VoI9muD2K/HYb9ejP16
+O/Yg2IOp2IO12IOj2G+EY7+RhP1GLvYbtdhvdGC/MYL9Zjj2m2nYb1Ziv9mO/eYY9p/isP+Uhf2n
+Guw/dWD/aQR7KBR7KA17qBJ7qAN7aBz7z43Yfw5ivwX2WynYbxViv1WP/VY/9lsT2G8nYL9djv12
+B/awH3u4GvudIPZfAth/6cP+yxD2u/HY7xZjv9uK/e4w9kgk9kgr9ntgv5eI/V499nvD2O9nYL/f
+hv1BOPYHSdgf5GJ/MIw9Go892oI9OoQ9Oo79Vx/2X3uxP4zF/rAG+yOwPwpgj4Vij2Vij3Vif5yE
+/XE79sfj2J9kYn/Sj709G3t7J/a4D3s8G3u8D/vTJOxPK7E/HcP+LAv7c7A/z8b+vBH782HsiUTs
+iWrsiRHsL1Kxv2jF/jIW+8t87C8HsXckYe+ox94xjj2Zgj1Zi/23aOy/1WJ/lYnt7henhtHUoEUK
+WjSgRQtadKBFL1oMomUPWqWh1SDaSkJbvWi7Aa2b0B4H7SlGe/rQTj16Dwu9RzF6j170HoNobzza
+O4Ge0YaeEUSHWOiQcHRIHTpkDL1nLXqvLPTeFnrvEXRoKjq0Eb1PHHqfcfS+A+iwQXR4PDo8Gb1f
+OXq/SXRECDoiHR3RgZ5ZgZ7Zj47sRO8Pev9y9P516P3b0Pv3o/cfR0eFo6Ni0VFJ6KgMdFQhOqoS
+HdWAjmpDR/Wio4bRUZPoWeHoWX70rBT0rCz0rAB6Vg16VhN6Vgd6Vj961ig62kJHR6OjE9DRaejo
+XHR0OTq6Dh3dgo4OoqMH0dHj6ANC0AfEog9IQh+QgT6gEH1AJfqABvQBbegDetEHDKMPmETHhKNj
+/OiYFHRMFjomgI6pQcc0oWM60DH96JhR9GwLPTsaPTsBPTsNPTsXPbscPbsOPbsFPTuInj2Inj2O
+9oWgfbFoXwLal4L21aB9DWhfC9rXh/YNoX1j6APj0Acmog9MRR+YiT4wH31gGfrAavSB9egDm9EH
+tqMP7EEfOICek4Sek4aek4WeU4ieU46e046e04Oe434+gj4oE31QPvqgMvRB1eiD2tEH9aAPGkAf
+NIKOzUDH5qJj+9GxY+iDw9EH+9EHp6APLkMf3IQ+uA998AR6roOeG4meG4eem4g+JBx9SBB9yCD6
+kHF0XCE6rh99aCz60Bb0YSHow/LRhzWjDxtBH+5HH16IPrwSfXgD2h+K9lei/U1ofxA9Lx09rxV9
+ZDj6yDT0kTXoeAsdH46Oj0XHJ6Djs9Dxlej4FnR8Pzp+En1ULPqoNPRRAfRRDeijOtFHDaGPttBH
+x6GPTkMfXYg+uh59dBB99Bj6mGj0MfHoYwLoY9rRx4ygEyLRCVnohDb0sYnoY3vQxw6gj8tHH1eH
+Pq4JfbyDPn4YnViI/kE0+gdl6Pkh6PkZ6Pl96BP86BM60Ek+dFI7OqkHnTSA/qEP/cN89A/r0D/s
+QP9wFH1iJPrERvRJseiTBtHJFejkQfSCVPSCTvTJmeiTh9GnpKFPaUanhKJT8tEp/eiUcfTCcPTC
+QvTCTvTCPvTCCfSpGehTm9GntqNPi0SfloE+rRGdGo9OHUYvSkEvakSfHoI+vRx9Rij6jHx0Gui0
+OnTaIPrMOPSZnegz+9BnTqIXp6AXV6AXN6AXj6CXhKOXJKCXZKKXlKOXNKKXdKKX9KOXuJ9PotND
+0ekJ6PRUdHoWOr0YnV6JTm9Gp3eg0/vQ6cPo9An0WdHos+LRZ6Wgz8pEnxVAn9WAzkhFnzOBPq8J
+nZWBzmpGXxCKvqAS/WM/+sfV6B9PoC8sR184ic5OR2e3oXNy0Tl96OXx6OVN6BUOekU2esUoOjcJ
+nduBzotF5/WjVyagV/agV8WjVzWh80PQ+TXo/CF0QQa6oBd9kQ99UT36Ygd9cQX64gl0YRa6sBe9
+Oh29ehi9phhdlIwuqkMXjaOLc9HFHehLfOhLKtGXDKNLUtEl7ejSOHRpBbp0AB1IQgfa0GsT0GuD
+6EuT0JcG0euS0Os60WXp6LIe9Pp09Pp+9GWp6Msm0Jc3oMuj0eWT6Cs60D9JQv80AV0Riq7oRF+Z
+jf5ZNPpno+irLPRVieir0tFXNaArHXRlPLqyAl3Zj94AekMqekMlesMwuioEXRWLrkpCV2Whq8rR
+VXXoqhZ0VRBdNYiuGkdfHYK+OhZ9dTL66kz01cXoq2vQVzehr+5AX92PvnoUXW2hq6PR1Qno6jR0
+dS66uhxdXYeubkFXB9HVg+jqcfQ1IehrYtHXNKOv6URfM4C+Zgx9rYO+1oe+NhF9bTr62nz0tRXo
+a+vR17air+1BXzuEvnYCfV0o+ro49HXJ6Osy0dcVo6+rRl/XiL6uHX1dH/q6EXQN6JpIdE08uiYV
+XZONrilD19Sia5rRNZ3omgF0zRj6egd9vQ99fSL6+nT09fno6yvQ19ejr29FX9+Dvn4Iff0E+oZQ
+9A1x6BuS0Tdkom8oRt9Qjb6hEX1DO/qGPvQNI+gbQd8Yib4xHn1jKvrGTHStha4NR9fGomuT0LUZ
+6NpCdG0lurYBXduGru1F1w6jayfRN4Wjb/Kjb0pB35SBvqkXfdMw+qZJ9MZw9EY/emMSeuMQeuMY
++mbQN0eib45H35yKvjkbfXMZ+uZa9M3N6Js70TcPoG8eQ9c56Dofui4RXZeOrstH11Wg61rRdWPo
+TaA3haI3xaE3Jf83/OSjN9WiNw2gN/vQmxPQm9PQm7PRm+vQm5vRmzvQm/vRm0fQW0BvCUdv8aO3
+JKO3ZKK3FKK3VKK31KG3tKC3BNFbBtFbxtH1Iej6WHR9Ero+A11fiK6vRNc3oOvb0PVBdP0E+pZQ
+9C1x6FuS0bdkom8pRt9Sjb6lEX1LO/qWPvQtI+hbQd8aib41Hn1rKvrWbPStZehba9G3NqNvbUff
+loa+rQ3dkIhuSEc35KMbKtAN9eiGZvTtIejbY9G3J6FvT0Pf3oi+vR19ex/69iH0HW3orSnorcPo
+rZPoxnB0ox/dmIJuzEDfGY++swN9Vzb6rjL0XbXou5rRd3Wi7+pD352BvrsT3ZSCbspCNwXQTTXo
+piZ0Uxv6niT0PXXoe2PR9yah781A31uIvrcSfW8D+t429L296HuH0fdOou8LR9/nR9+Xgr4vA93s
+QzfXoLeFo7fForfVo7f1ou8PRd/vQ9/fiX4gHv2A+3sA/cAYusVBt/jQLfHolmb0g7noB8fRD1no
+h+rRDzWjH05DP5yLfrgc/XAd+uEW9MNB9MOD6IfH0a0h6NZYdGsSurUW3Tr5/T+PVKMfCaIfTUI/
+mo9+tB396AT6sTj0Yxnox2rRbX50Wyq6rQLd1oxuG0E/Hol+PIB+wkI/kYZ+ohL9xCj6yRT0k+Xo
+J5vRT/ah28PR7bXo9h70U6nop2rQT/Wjn5pAP52Mfjod/XQ5+uka9NOd6GdAPxONfiYR/UwG+pli
+9DM16Gfa0M8MojscdEc8uiMT3VGJ7mhBd/SjOybRP49F/zwN/fMy9M8b0T/vQf98HP2LaPQvUtC/
+KET/og79iw70L0bQz4ain01EP5uLfrYG/Wwb+tlBdKeF7vSjOzPRnRXozmZ0Zz+6cxL9XCz6uTT0
+cwH0cw3o53rQz42huyLRXSnorkJ0Vx26qwPdNYx+PgT9fCL6+Wz089Xo59vQzw+igxY66EcHM9DB
+cnSwGR3sQwcn0L+MRf8yDf3LAPqXDehfBtG/HEV3R6K7k9Hd+ejuOnR3B7p7GP1CCPqFBPQLWegX
+qtEvtKJfGED3WOgeP7onA91Tju7pQb+YiX4xiH7JQr+UjH6pF/0rB/2rDPSv6tG/6kH3xqJ7O9Ev
+Z6FfrkO/4qBfSUG/0oL+Nehfp6J/PYruq0b/BvRvGtCvhqNfLUf/NgX923b0b8fQ/Wno/lp0/yD6
+d/Xo34egf1+P/kMC+g/96AEfemAU/cdU9B870H+cQL+WiX6tBf3aJPr1ZPTr6ejXs9GvF6Nfr0C/
+Xot+vRH9eiv69U70633o14fQr4+hB0EPhqIHfejBePRgMnowHT2YjR4sRg9WoAdr0YON6MFW9GAn
+erAPPTiEHhxDvwH6jVD0Gz70G/HoN5LRb6Sj38hGv1GMfqMC/UYt+o1G9But6Dc60W/0od8YQr8x
+hn4T9Juh6Dd96Dfj0W8mo99MR7+ZjX6zGP1mBfrNUfSf/Og/daCH0tFDDeg/h6D/XI/+cz/6rVb0
+20not9PQb2eh3y5Ev12OfrsG/XYD+u0W9Nsd6Ld70W8Pot8eRb89iR4OQQ9Ho4f96OEk9HAaejgL
+PVyIHi5HD9eghxvQwy3o4Q70cC96eBA9PIoenkS/E4J+Jxr9jh/9ThL6nTT0O1nodwrR75Sj36lB
+v9OAfqcF/U4H+p1e9DvD6Hcm0X9pR7/rQ7+biH63Cf3uAHokHT2Sjx6ZQL/noN/zod9rQb/XgX6v
+H/3eKPp9C/1+NPr9BPT7Kej3s9DvB9AfpKI/6EGPpqFHc9Gjo+i/lqP/Wof+MBn9YRv6w170RwH0
+RzXoj5rQH3WgP+pHfzSKHrPQY9HosQT0WAX64wT0x9noj+vRn4SjP+lDb+9Ff+qgP6tETySiJ9rQ
+XxSjvyxG72hB/y0O/dUwHgbwiDo8shaP6sVjp+LRE3iccTwz4vCExOLZMxvPXkN4Qhvw7FuPJ7wf
+z8xUPJHlePYfwzOrFc8BLXhmj+CZk4vn4Eg8c2vxxKXhOSwNj78az5EWnqM68SS04TkugCexHM/8
+IJ4fpuFJDsVzSiSeU1PxnBrAc2oLntPi8Jw2jCd1CM/p0XjOqMdzZhqeM3vwLK7Es6QST3onnqVJ
+eJZN4Dl7FM85zXjObcKTOYTn/Cw8WZF4fhyOJzsVT04KnuUVeHLBk9eBZ1U7noJxPIX5eFY34CkK
+xVPci6ekB89aC8+6YjyXxeK5vAHPFZl4fpqK58pKPJXgqerAc00rnusCeK4vx3NjEM/GVDybQvBs
+mcRzmx/PbdV4GlLw3O7Dc0c0njsG8WztwdM4hOfOGjx3deK5ux9PUxDPPWV47k3Hc188nvtG8TQ3
+4dlWjuf+AJ4H4vG0ROJ50I/nwX48D1t4WsPxPAKeR1rxPFqJ57FsPG2ReNqa8TyeieeJODxPtON5
+sgxPezae9iE8T43geboPzzN1eDqi8XT04Pl5OZ5fOHh+UYnn2Sw8nRl4nivE05WC5/k0PMFyPL9M
+wdNdh+eFIJ6ePjwvNuJ5qQ7Pr2rx9ObiednB83IKnpeb8bxi4XklH88r/Xh+nYjn13V4fj2B51Xw
+9I/g+UMonj/G43ktHs/r8XgGe/C80YznzWo8f6rBM5SN588NeN5qxvN2BZ7hbDzvFOL5SxqedwN4
+RlLxjIzhea8Zz/tJeN6fxPPBGJ7RZjx/zcbzYSaej/x4xpLxfByJ5+MxPJ/04Nlej2c8F8+nuXg+
+q8PzeR2eiR48Xwzg+bIFz45aPJPFeP5WjGeqEIdMHFGII5NxVA2OlY5jJ+DoBBwHnD2ScbydODOG
+cELacPbMwtkrCSe0EifMwdmvDGdmBc7+7TizJnBiUnF8Ds6BPThzGnDmdOIcVIMTG4cTO4FzcCPO
+3CScuSM4h0TjHNKBExeNE5eJE1eJE9eAE9eJEzeGc6gP59AUnEPLcQ6dwDnMwTksEuewAZzDAzj+
+cBz/II5/FMc/iXNECM4R0ThHFOIcUYNzRAvOEb04RwzjHDGJMy8cZ54fZ14KzrwsnHkBnHk1OPOa
+cOZ14Mzrx5k3inNkCM6Rfpwj03COLMaJByc+Gye+FSe+Fyd+BOcoC+coH85RSThHZeIcFcA5qhbn
+qBaco3pwjhrGORqco6Nxjk7EOToD5+hinKNrcI5uxjk6iHP0EM7REzjHhOMck4ZzTC7OMe04x/Th
+HDOCkwBOQiROQiJOQiZOQjFOQj9OwjBOwjjOsRbOsdE4x6biHNuIc2w7zrF9OMeO4xyXhnNcPs5x
+FTjH1eMc14pzXA/OcUM4x03gHB+Kc3wczvHZOMc34hw/gJOYj/MDB+cH9TjzwZlfjDN/AOcEB+eE
+RJwTcnFOaMNJCsFJysRJasJJGsP5YSLOD4dwTqzFOSkB56RanJNGcJKTcZIHcBaU45wciXNyAOfk
+AZxTEnBOqcU5ZRwnJR0npQ9nYQbOwgGcU3NxTp3EOa0a57RRnNRMnNQOnEXZOKeH4JzeinNGOM4Z
+FThnDOOkFeOcaeGcWYtz5jDO4mScxXU4S0JwltTgpFs46S04Z2XhLLVwlubjLO3HWZaIs6wPJyOA
+c3YIztlZOGe34Zxj4ZxThnPOGM65uTjnjuBkFuNkjuOcl4JzXgPOeaM456fgnN+Ec/4kzo/ycX40
+iJOVinNBCM4FAzg/bsC5MBbnwlac7ESc7DSc7GKc7Dqc7Hac7EGcHHByYnFyUnFyCnFyBnCWh+Ms
+T8dZ3oGzvB9n+SjOihCcFbE4K5JwVmTgrCjEWVGDs6IJZ0UHzopBnBWTOLnROLkJOLlpOLm5OLmV
+OLlNOLlBnNxBnNxxnLwQnLxYnLwknLwMnLxCnLxKnLwGnLw2nLxenLxhnLxJnJXhOCv9OCvTcFYW
+4qyswVnZhLOyA2dlP87KUZxVFs6qaJxVCTir0nBW5eLkh+LkV+AUWDgF0TgFCTgFaTgFuTgF5TgF
+dTgFLTgFQZyCQZyCcZyLQnAuisW5KAnnogyciwpxLqrEuagB56I2nIt6cS4axrloEuficJyL/TgX
+p+BcnIVzcQDn4hqci1twLu7FuXgUpzAEp9CPU5iGU5iLU1iOU1iHszoWZ000zpp4nDW5OGtqcNa0
+4awZwylKxinKxCkqwylOxbmkEqfUj1PahxNoxFlbjLO2EWdtH86l0TiX5uOs8+OUWThlYzjrI3HW
+V+Jc5uBcVo5zeTzO5QM45ek45SM4V9Tg/CQJ5yedOD/NxvnpCE5FGc6VFs6VuThXBhEQuVplcDj5
+hCAJ5RYcsPaTEThYQJQ4EQG4/+rF0dN5wQzx9nReYokvpvOKGTJiOm8xQ86fztuEyOzpvMYrr5rO
+O+wjH53Oz+AAxXR+z31vjzt/Or8XCScsn86HMuOEh6fz+2Cd8EsUwtoDRDxM5wUR4lfTeYkjPprO
+KyLEV9N5iwg5ZzpvEylPnc5rwuXq6bzDHHnDdH4G82VwOr/nwfNVzHR+L/LnT0znQ4k4Yct0fh+c
+E57hFIooZj0lFLCKfAL4iGMFh+LjaOKJ5xh8LGc9Pk6mgAClBCghjxxW48fHItawgnn4WEAhhfhY
+uruuUvNXHqXkUcKl5JHLPLz/CanH7ZaaQQ6XspqLKGINq0w7csj/L0pMIY+LyOEc1uJjBfnkmNry
+zBs5pkc+CliDj2LWspxCCliBj1yKWE2O+cwLpxQVry8pWJUf8MWtONR3dHz8Mb7l630nFwRKAyV5
+Oav9vkVrVszzLSgs9C11S5X6luaV5pVcmpc7z/t3rx7nvpqRc+nqi4rWrPKdnJP/D15Mybso55y1
+vhX5OWtW5ZX6ckryfAVrfMVrlxcWrPDlFq3OKVjj5TtdXGY6WArTLy/LWVMKJ1NEERfDyUVFF//n
+XvnPlDnHaLuUAjNG7hjO4xiOh3PySkoLitb4jp53zPHfruo7FX2frJWmtp1jGpgmbpfclUVrAqW+
+QF7Oasy4ByhmPkdyJLnTdVzKWuZRShFrKWEFeaa+EjPm81hDHgHIDwSK5x95ZG7eRTmXrp1XWrS2
+ZEXeyqKSVXnz1uQF4NRvtGAXI7s4/fvZ4H7mcpdn2M2jkCLWcagh9b+HP7em0yhkPcXkmzcLWE2x
+6VfAsO5qrcS84c4Ot9ZLv6PJ7/bj6/m19lvz6x/1xov3e/u+k4EcfN/S2t/PdC9H/Bv/efH+r6xZ
+vv+gzwWU4jW5gHniUrja6PpifBSx8j9si9uzdFPfalPb17OpwLQp33yWN92vVUbKmulR90+P+87R
+2iltJ2M7efebdhWZ0V9j3i+enrE7JRSxnMA0YwXTFOSYOnZq2jtdZ8C04rs8rTDlXA531r6rBrf0
+zrbvZDnPTPid7M35BiVzzMi57+aa36WmXSsoIGe6f14zC1awltWmloD5ZJd+VlJA4fRMitvdxq8l
+uKuW2/4A66bpdyV+rRP3SbGZNbmsNe38ujW5FEyPWAHLWWvas0sP3n8iwT89m1dQyFpyd+tknWEg
+36xKgWnNrKbgOz3a1YeSb1G5s7VrKZ2uf9fouPnV5O0ea+83VpBS8s3n39cP/+5+HmlWEJ+peed8
+2Fl3wbRWvz36/7zXuzS3s7XFu4kO4J3+ayd1X/dondHH6v+UhF2zYaVZ1ddM9zDvGxJzTerK8Jvf
+riYuIo8VBHaX2TV+LseF0yvbrhFaYWTnUrB7PEqZb2ZnxnTrclhOkVkZvh6Db65FX2vg71eCNRSZ
+elfsXvl3ld01V4q/dw345ns+0+cc03Ivy6e18jVrO7Wx05bk/JPxLDJW0Dc99qvN77z/wmi7Zdab
+9q40q4Bb97xvaeqfvevqZP20bdkp3dX5Sgp2r2hu2wPTq97OJztb6uo09xtj/k3qdlnQHEqm9bWW
+QvOXd3ePck1L3fFa8w1trPqWXd0padcamsOK6ZaU7pbxXf2U/od92tVK73QPviYsh8L/Ugu+Lee7
++vi+tvmnx7vQvFfwD1Zz7+7RKTHrbA6rv1Xvrielu4ncNV++az3ypte5PNOLXZLWmV7lmvfnfI89
+nLO73999w8uc3dZ2zjco2zln0r5jX5ab+V70jbaunZ4Huzi5lCIKvkdjeZQZPa+ZnsnFFE9brxyz
+oubtfuOb476zzbueeL93puSbFd5nfpdOtzGPNf+Ek11r3fet3bnGEqwx4/5NfX2fVr3f0Nw3x/Bf
+naulZtXcZau/nm27ZlKOsWu79h4l0298u8ZiQ/TF5Jgyvul+7aTKu3tV/X+5Uv3jXi2fniOBaXu4
+cremUllo5CxhMRlGzhJOJYNzWcBS89kiluEjnaUs4RwWkcJCUsy4LGDZ9OdzzGw8l0WmxiWcbera
+WcdSFpi6z8Nn6vaZv92/zmARi0kx7y4k08hYyDKW4WUJS03dZ5JOGotYOF3OfeMU0jibFJM/zexC
+d8pbzBLzO82857ZlZ0szWPINqd9u1SIjcVfLzmQhSzmF1OlPF3Ayi0x9bvv9Zn/k5hdPt3On5paa
+2l0duTW7dZ7C2aSZv9ynZ7OUdJawzOhzgenzztYuNn04laXTfVloWrBzJHa26BSWkM55psRppJKB
+f1pSxnRJvxlHtz8p5n1X6hmm1M6WLZke5aX4vlHLvGld7myHq/9zdkteZvqfRprRrdvfZUbCQhZw
+5u56d7FzmqnBbbfXaONs078FRg9LjISTTTlXi64+03YTt/Qbo3KK0Zc7bn7z3XKB6clpu0fnuz3Z
+Vds3R+f76PDulnCa6d9Co6k0U3oZ6SzkFBbtfrKTx0Wmr6eQsfvZKdPc72Qi7RvaPcX00R3Zszh7
+usTO+r7bC3eczjXt/7oXO0dgwXR6yjd09vXoL54e3V3tyTCSM75HK+eaubjQlFpgxnrZ7jlyqpm/
+Z063/OzdhH29Bpw9zeeS3S37tn53zaNd5f4za8fOunbJ/vYIphie0qZbuGy3NnaW8P6TeneuXQsp
+M+te8bRdK/07y/3NXePXu9Fv7jv931hrv7kT2LkKn2bKrv5Oua+f7vy2tNNmff1d55t7t+/7hr3r
+27H/W7ver3cfO9fund+JvrnrzTX78517wNLdu5Iisw8s2r0zWWc+zfvGt5WdvpOib33PcyXnGNvv
+3y1rly0q+sYuJ9e0unBaWun3aPMfWyjv330zLDb2fqeUdSYfmN6ZuP1bO13WfX7Zd74Nl3zn29TX
+Y+D73jHY1Zfv2zl8U/8lZryLp79LFRgNu/vJedP1luz+Xva1TlwN7PS7rf7OqH9Nn1vb/L/zKgTM
+jvjrlucaXXunfXiuTK9Zr3b5uP73vU7/3T7r/0v+IO+3/EG+/zF/kPd7/UG+/2F/kPc/5Q/69k5+
+xTfaVPp33zP+cx7U7/OweP/X/Eq+v/Mref8/v9I3/Ereb82H///zK3m/ZWH/9/xK3v+jfiXv9/qV
+fP/DfiXvP/EX/M/4lbz/Zb+S7/+JX8n7D/xK/8j6/mPv0s7v5zt3Ev/XvEve73iXfP+L3iXvP9Gu
+7xsa/L/tZfIaxnz/B7xM3v/DXibvd7xMvv8VL5P3P/Qy+f7HvEze/4KXyff/zMvkNTo4hzM5nSW7
+tb2A1P9B35H3e8f8f8t35P0735Hvf8135P2HviPf/6DvyPtf8B35/td8R7tW1n9sUf7e4+P9Fzw+
+vv9HHh/vv+Xx8f03eXy83/D4/DO/w3+Hhybwd/Unf8PT4GXe9F/z4FQToOWGqrnBbrvj43xxpXl5
+vuV5hUXrDp3n+08Ets3znVa4vji/1FewurioJJCX61tZUrTat6Ak79LpILBdMkwg3dqdgXTfFOP1
+fi39nLySHN/Opu2OxvMe8U//eb3/esif7zuSC0q9Ob5ASU5u3uqckot9RSu/W4vXm55Xsrqg1ATN
+FZT68vNK8pav960qyVkTyMv1+1aW5OW5r63IzylZlef3BYp8OWvW+4rzSkqL1viKlgdyCtYUrFnl
+y/GtKCpe7y1a6Qvk5+3S04oVRauLc9asdwsE8gtKfYUFK/LWlOb54uYYlcw51JezJteXU1patKIg
+J5CX680tWrF2dd6aQE7Abc/KgsK8Ul+cW6N5wbesaGVgXU5J3pxDTUtK8opLinLXrsgz1eQWlAZK
+CpavDeS5bfB+6wW/r2DNisK1uW5L1hUE8ovWBnyFBasLpgW5Ekp2qjJQ5Ftbmuc33fH7Vue5vfYa
+QErz/d+Q4XdlHllU4ivNKyx0Sxfklfqmu/8d0W7jAkWuzlYXBLw7VWcErcsvWv33L7jDsHJtyZqC
+0vw882Juka+0yO8rXbv8orwVAfeJ27+VRYWFRevcDq0oWpNb4PajdL7Xm5Gf58tZXnRpnunBTopM
+A3ZDsKYoULAir3TnU3dUir8mYOdnvtL8nMJC7/K8aa3l5bqzJOdb/Sxak+crKvGtLirJ+95u+wLr
+i/NW5qzIK523s1Hf/nR1znrf8jzf6qLcgpUFLmg5hYG8EjdTVOLLyc01Pd+pOneC5pQEClasLcwp
+8bqCcvNKC1atMc1YtXOuFpUYQnNWBPJKSt03drWn9LuS3Cq9Obk7FZZT+P0VTL+zqx1f1+b3Fa0p
+XO8r+AbmXrc7JXlrclbvLOtmSl1FuuOya3rkFQTy80rMS+uKSnJLfXN2z8M5vqKvP/DOcaftHKOy
+glJf2vR8WZ63osjUuraw0Ojk0qKC3Q3LKwvkrQn4coqLCwtW5CwvzHM/2Nn3ohKT8X49KPk5AV9+
+TqlveV7emm/pxKXua7pzfWvX5E43+Oumek3jdvbwn41qaVGhO6vNsLmDlOMrdFePEl/proLFOSsu
+zlmV51u+NuBbU+R1Uf2vQfUtUcvX+woCpXmFK91GpS70nbpkcYZv2ZJTM85dsHShb9EyX/rSJecs
+SlmY4puzYJlv0bI5ft+5izJSl5yd4Tt3wdKlCxZnnOdbcqpvweLzfGcsWpzi9y3MTF+6cNky75Kl
+vkVnpqctWpji9y1afEra2SmLFp/mO/nsDN/iJRm+tEVnLspYmOLLWGJena5q0cJlbmVnLlx6SuqC
+xRkLTl6UtijjPL/31EUZixcuW+Y7dclS3wJf+oKlGYtOOTttwVJf+tlL05csW+hbsDjFt3jJ4kWL
+T126aPFpC89cuDjDreiUJennLV10WmqG35e+IGPh4gy/N2PpgpSFZy5Yeobft2Spb0lG6sKlPlNk
+nm/RYt/iJb6F57gvL0tdkJbmO3lRxrKMpQsXnOmWdbVz2uIlZy70nrrk7MUpCzIWLVnsO3mhL23R
+gpPTFu5s2+LzfKekLVh0pt+XsuDMBactXPa1kLRFu7rztTq87gunLVy8cOmCNL9vWfrCUxa5mUWL
+UxYtXXhKhim5KGXh4gz36ZKlvlOWLF628KyzFy7OWLQgbZcIv/fc1IVGxKLFvgWLfQtOMS0z3V+c
+sXSBW0/GkqUZu5ty7qJlC/2+BUsXLXNH5NSlS870+9zxXHKqIeDsZQvdoosWT7fXHSP32d/TsWSp
+eXu6gykLF6QtWnzaMrcZGakLvd8qO8/rXVi2Iq844LI9Pbl3Lo1mGd25dvoNtTsXgaKVvtPWFK2e
+fmay3lOL1q7JNVZn5+r2tcF2zbF/59Jrlo/lea4l2rn05l6aVxIoKHWXkqISb5G7mKwrKDUzvbik
+aHXRTpvnK80pzPO7b7mzyJTy5eblFBasWVW6u5nfmlDeXcawuKSgqMS3rqQgEMhb48tZG8gvKim4
+bNoMlxSt/roHvq974Er5enHY2f6SvNLivBWBgkvzCtfP851aVOLaMtOSgjUri0pWT3fdqG9FYP6u
+rULAt8pUnlsU8BaVrJrn83rNjuvf3jr9Z688/Pfsg7w790G+f2Uf5P16H+T7F/dB3r/fB00v8itM
+TaW7bMb3bFC/3rB4/529km/XXsn7f2Ov5N05Dv/P9krenRP239oref8b90rer/dKvn9xr+T91r7g
+X9gref/RXsn3n98reb+xV/rm9P3Wdsk3x10k/ru2S96iku9o/l/ZLnm/1VzzvfG/e8vkXVPk+7e3
+TN7/1i2Td3rL5PvXt0ze726ZfP/Klsn7vVsm339ly+TNWHDOmacvcZu9IPVf2h15v+75v7M78u7a
+Hfn+nd2R95u7I9+/tDvyfu/uyPfv7I5cWL81UXZvfLz/cOPj+y9sfLz/fOPj+09sfLxm4/PtvcN/
+vKEJ7CqfbDYN3nm+lSXz/p07g0cav93FFHCk8Z3lmlO9eeZ8tZgjv3Na+M9vGB65ruDigiML1uTm
+lc0rzi8+cnrF/JfucsqdF6CnBjif7/kXudrciZbm/yNso/HgsAdeZhDCnuzF3oSyD/sSRjj7EcFM
+ItmfKGYRzQHEMBsfBzKHg4jlYOZyCHEcymEcjp8jmMeRxHMUR3MMCRzLcRxPIj9gPieQxA85kZNI
+Nn7uU4w/+lRz5rGI0zmDNM40Jx7pnMVSEz17NudwLpmcx/n8iCwu4MdcSDY5tHA3lWzg52xmhCpu
+4DpuZxtNQnItr3EVN/MJ27meLVTzPG/yMXdwP58yzmfcxYO8yAs8xHJWUEsuvyKPHl7i1/TyMq/w
+Hiv5LX38hodZxRg38Xv6+R35fMBfuYaLzNivppA1NFLEJcZrW2q8s5eyjvcp4zLWczlXUE47d/JT
+fkIFVzLKhzwtlLCELbTwCIe/8ZXYQ3jFDBHClEDsKfYSewshQsU+Yl8RJsLFfiJCzBSRYn8RJWYx
+wRciWhwgYsRs4RMHijniIBErDhZzxSEiThwqDhOH8yV/EH5xhJgnjhTx4ihxtDhGJIhjxXHieJEo
+fiDm8xZvixNEkvihOFGcJJLFAnGyOEWkiIXiVHGaSBWLaOURcbo4Q6SJM8VisUSki7PEUrGMHUwy
+zDsiQ5wtzhHnikxxnjhf/EhkiQvEj8WFIlvkiOVihcgVeWKlWCXyRQHPiIvExaJQrOYvvCvWiCJR
+LC4RJaJUBMRacalYJ8rEenGZuFyUiyvET8RPRQX3iivFz8RVolJsEFXialEtrhHXiutEjbhe3CBu
+FLXiJrFR3CzqxCaxWWwR9eIWcau4TTSI28UdYqtoFHeKu8TdokncI+4V94lmsU3cLx4QLeJB8ZB4
+WLSKR8Sj4jHRJh4XT4gnRbt4SjwtnhEd4ufiF+JZ0SmeE13ieREUvxTd4gXRI14UL4lfiV7xsnhF
+/Fr0id+IV8VvRb/4nfi9+IMYEH8Ur4nXxaB4Q7wp/iSGxJ/FW+JtMSzeEX8R74oR8Z54X3wgRsVf
+xYfiIzEmPhafiO1iXHwqPhOfiwnxhfhS7BCT4m/iKzElkUJKqaQlbamlRzpyD+mVM2SI3FPuJfeW
+oXIfua8Mk+FyPxkhZ8pIub+MkrNktDxAxsjZ0icPlHPkQTJWHiznykNknDxUHiYPl355hJwnj5Tx
+8ih5tDxGJshj5XHyeJkofyDnyxNkkvyhPFGeJJPlAnmyPEWmyIXyVHmaTJWL5OnyDJkmz5SL5RKZ
+Ls+SS+UymSHPlufIc2WmPE+eL38ks+QF8sfyQpktc+RyuULmyjy5Uq6S+bJAXiQvloVytVwji2Sx
+vESWyFIZkGvlpXKdLJPr5WXyclkur5A/kT+VFfJK+TN5layUG2SVvFpWy2vktfI6WSOvlzfIG2Wt
+vElulDfLOrlJbpZbZL28Rd4qb5MN8nZ5h9wqG+Wd8i55t2yS98h75X2yWW6T98sHZIt8UD4kH5at
+8hH5qHyMAf4s2+Tj8gn5pGyXT8mn5TOyQ/5c/kI+Kzvlc7JLPs/rDPIGQ/yRP8mg/KXsli/IHvmi
+fEn+SvbKl+Ur8teyT/5Gvip/K/vl7+Tv5R/kgPyjfE2+LgflG/JN+Sc5JP8s35Jvy2H5jvyLfFeO
+yPfk+/IDOSr/Kj+UH8kx+bH8RG6X4/JT+Zn8XE78/6h76/+4jf17OI2YYSWty5hyU88It6xdSWVm
+rpM4iVvHTg1lZmZmZmZmZmZmZkxb93nZc5y7n/4Fz/f+cGe10erMCM6Z95zqeOKfE+dM/Gvi3xP/
+mTgy8V9uAjcPN5HjOJ4TOJGTOJlTOJXTOJ0zOJOzOJtzOJercR7ncwFX5zq4ebn5uPm5BbgFuYW4
+hblFuEW5xbjFuSW4SdyS3FLc0twy3LLcctzy3ArcZG5FrpMjHOVCLuJiLuFSLuMa3Ercytwq3Krc
+atzq3BpczjW5FldwJVdxa3JrcWtz63Drcutx63MbcBtyG3Ebc5twm3KbcZtzW3BbcltxW3PbcNty
+23HbcztwO3I7cV0TzuemcFO5aVw3N52bwc3keriduV24Xm4W18f1c7O5XbkBbpAb4oa53bjdJ5zN
+7cHtye3F7c3tw+3L7cftzx3AHcgdxB3MHcIdyh3GHc4dwR3JHcUdzR3DHcsdxx3PncCdyJ3Encyd
+wp3Kncadzp3BncmdxZ3NncOdy53Hnc9dwF3IXcRdzF3CXcpdxl3OXcFdyV3FXc1dw13LXcddz93A
+3cjdxN3M3cLdyt3G3c7dwd3J3cXdzd3D3cvdx93PPcA9yD3EPcw9wj3KPcY9zj3BPck9xT3NPcM9
+yz3HPc+9wL3IvcS9zL3Cvcq9xr3OvcG9yb3Fvc29w73Lvce9z33Afch9xH3MfcJ9yn3Gfc59wX3J
+fcV9zX3Dfct9x33P/cD9yP3E/cz9wv3K/cb9zv3B/cnN4f7i/ub+4Ua4f/kJ/Dz8RJ7jeV7gRV7i
+ZV7hVV7jdd7gTd7ibd7hXb7Ge7zPB3yd7+Dn5efj5+cX4BfkF+IX5hfhF+UX4xfnl+An8UvyS/FL
+88vwy/LL8cvzK/CT+RX5Tp7wlA/5iI/5hE/5jG/wK/Er86vwq/Kr8avza/A53+RbfMGXfMWvya/F
+r82vw6/Lr8evz2/Ab8hvxG/Mb8Jvym/Gb85vwW/Jb8VvzW/Db8tvx2/P78DvyO/Ed/FT+Kn8NL6b
+n87P4GfyPfzO/C58Lz+L7+P7+dn8rvwAP8gP8cP8bvzu/B78nvxe/N78Pvy+/H78/vwB/IH8QfzB
+/CH8ofxh/OH8EfyR/FH80fwx/LH8cfzx/An8ifxJ/Mn8Kfyp/Gn86fwZ/Jn8WfzZ/Dn8ufx5/Pn8
+BfyF/EX8xfwl/KX8Zfzl/BX8lfxV/NX8Nfy1/HX89fwN/I38TfzN/C38rfxt/O38Hfyd/F383fw9
+/L38ffz9/AP8g/xD/MP8I/yj/GP84/wT/JP8U/zT/DP8s/xz/PP8C/yL/Ev8y/wr/Kv8a/zr/Bv8
+m/xb/Nv8O/y7/Hv8+/wH/If8R/zH/Cf8p/xn/Of8F/yX/Ff81/w3/Lf8d/z3/A/8j/xP/M/8L/yv
+/G/87/wf/J/8HP4v/m/+H36E/1eYIMwjTBQ4gRcEQRQkQRYUQRU0QRcMwRQswRYcwRVqgif4QiDU
+hQ5hXmE+YX5hAWFBYSFhYWERYVFhMWFxYQlhkrCksJSwtLCMsKywnLC8sIIwWVhR6BSIQIVQiIRY
+SIRUyISGsJKwsrCKsKqwmrC6sIaQC02hJRRCKVTCmsJawtrCOsK6wnrC+sIGwobCRsLGwibCpsJm
+wubCFsKWwlbC1sI2wrbCdsL2wg7CjsJOQpcwRZgqTBO6henCDGGm0CPsLOwi9AqzhD6hX5gt7CoM
+CIPCkDAs7CbsLuwh7CnsJewt7CPsK+wn7C8cIBwoHCQcLBwiHCocJhwuHCEcKRwlHC0cIxwrHCcc
+L5wgnCicJJwsnCKcKpwmnC6cIZwpnCWcLZwjnCucJ5wvXCBcKFwkXCxcIlwqXCZcLlwhXClcJVwt
+XCNcK1wnXC/cINwo3CTcLNwi3CrcJtwu3CHcKdwl3C3cI9wr3CfcLzwgPCg8JDwsPCI8KjwmPC48
+ITwpPCU8LTwjPCs8JzwvvCC8KLwkvCy8IrwqvCa8LrwhvCm8JbwtvCO8K7wnvC98IHwofCR8LHwi
+fCp8JnwufCF8KXwlfC18I3wrfCd8L/wg/Cj8JPws/CL8Kvwm/C78IfwpzBH+Ev4W/hFGhH/FCeI8
+4kSRE3lREEVREmVREVVRE3XREE3REm3REV2xJnqiLwZiXewQ5xXnE+cXFxAXFBcSFxYXERcVFxMX
+F5cQJ4lLikuJS4vLiMuKy4nLiyuIk8UVxU6RiFQMxUiMxURMxUxsiCuJK4uriKuKq4mri2uIudgU
+W2IhlmIlrimuJa4triOuK64nri9uIG4obiRuLG4ibipuJm4ubiFuKW4lbi1uI24rbiduL+4g7iju
+JHaJU8Sp4jSxW5wuzhBnij3izuIuYq84S+wT+8XZ4q7igDgoDonD4m7i7uIe4p7iXuLe4j7ivuJ+
+4v7iAeKB4kHiweIh4qHiYeLh4hHikeJR4tHiMeKx4nHi8eIJ4oniSeLJ4iniqeJp4uniGeKZ4lni
+2eI54rnieeL54gXiheJF4sXiJeKl4mXi5eIV4pXiVeLV4jXiteJ14vXiDeKN4k3izeIt4q3ibeLt
+4h3ineJd4t3iPeK94n3i/eID4oPiQ+LD4iPio+Jj4uPiE+KT4lPi0+Iz4rPic+Lz4gvii+JL4svi
+K+Kr4mvi6+Ib4pviW+Lb4jviu+J74vviB+KH4kfix+In4qfiZ+Ln4hfil+JX4tfiN+K34nfi9+IP
+4o/iT+LP4i/ir+Jv4u/iH+Kf4hzxL/Fv8R9xRPxXmiDNI02UOImXBEmUJEmWFEmVNEmXDMmULMmW
+HMmVapIn+VIg1aUOaV5pPml+aQFpQWkhaWFpEWlRaTFpcWkJaZK0pLSUtLS0jLSstJy0vLSCNFla
+UeqUiESlUIqkWEqkVMqkhrSStLK0irSqtJq0urSGlEtNqSUVUilV0prSWtLa0jrSutJ60vrSBtKG
+0kbSxtIm0qbSZtLm0hbSltJW0tbSNtK20nbS9tIO0o7STlKXNEWaKk2TuqXp0gxpptQj7SztIvVK
+s6Q+qV+aLe0qDUiD0pA0LO0m7S7tIe0p7SXtLe0j7SvtJ+0vHSAdKB0kHSwdIh0qHSYdLh0hHSkd
+JR0tHSMdKx0nHS+dIJ0onSSdLJ0inSqdJp0unSGdKZ0lnS2dI50rnSedL10gXShdJF0sXSJdKl0m
+XS5dIV0pXSVdLV0jXStdJ10v3SDdKN0k3SzdIt0q3SbdLt0h3SndJd0t3SPdK90n3S89ID0oPSQ9
+LD0iPSo9Jj0uPSE9KT0lPS09Iz0rPSc9L70gvSi9JL0svSK9Kr0mvS69Ib0pvSW9Lb0jvSu9J70v
+fSB9KH0kfSx9In0qfSZ9Ln0hfSl9JX0tfSN9K30nfS/9IP0o/ST9LP0i/Sr9Jv0u/SH9Kc2R/pL+
+lv6RRqR/5QnyPPJEmZN5WZBFWZJlWZFVWZN12ZBN2ZJt2ZFduSZ7si8Hcl3ukOeV55PnlxeQF5QX
+kheWF5EXlReTF5eXkCfJS8pLyUvLy8jLysvJy8sryJPlFeVOmchUDuVIjuVETuVMbsgrySvLq8ir
+yqvJq8tryLnclFtyIZdyJa8pryWvLa8jryuvJ68vbyBvKG8kbyxvIm8qbyZvLm8hbylvJW8tbyNv
+K28nby/vIO8o7yR3yVPkqfI0uVueLs+QZ8o98s7yLnKvPEvuk/vl2fKu8oA8KA/Jw/Ju8u7yHvKe
+8l7y3vI+8r7yfvL+8gHygfJB8sHyIfKh8mHy4fIR8pHyUfLR8jHysfJx8vHyCfKJ8knyyfIp8qny
+afLp8hnymfJZ8tnyOfK58nny+fIF8oXyRfLF8iXypfJl8uXyFfKV8lXy1fI18rXydfL18g3yjfJN
+8s3yLfKt8m3y7fId8p3yXfLd8j3yvfJ98v3yA/KD8kPyw/Ij8qPyY/Lj8hPyk/JT8tPyM/Kz8nPy
+8/IL8ovyS/LL8ivyq/Jr8uvyG/Kb8lvy2/I78rvye/L78gfyh/JH8sfyJ/Kn8mfy5/IX8pfyV/LX
+8jfyt/J38vfyD/KP8k/yz/Iv8q/yb/Lv8h/yn/Ic+S/5b/kfeUT+V5mgzKNMVDiFVwRFVCRFVhRF
+VTRFVwzFVCzFVhzFVWqKp/hKoNSVDmVeZT5lfmUBZUFlIWVhZRFlUWUxZXFlCWWSsqSylLK0soyy
+rLKcsryygjJZWVHpVIhClVCJlFhJlFTJlIaykrKysoqyqrKasrqyhpIrTaWlFEqpVMqaylrK2so6
+yrrKesr6ygbKhspGysbKJsqmymbK5soWypbKVsrWyjbKtsp2yvbKDsqOyk5KlzJFmapMU7qV6coM
+ZabSo+ys7KL0KrOUPqVfma3sqgwog8qQMqzspuyu7KHsqeyl7K3so+yr7KfsrxygHKgcpBysHKIc
+qhymHK4coRypHKUcrRyjHKscpxyvnKCcqJyknKycopyqnKacrpyhnKmcpZytnKOcq5ynnK9coFyo
+XKRcrFyiXKpcplyuXKFcqVylXK1co1yrXKdcr9yg3KjcpNys3KLcqtym3K7codyp3KXcrdyj3Kvc
+p9yvPKA8qDykPKw8ojyqPKY8rjyhPKk8pTytPKM8qzynPK+8oLyovKS8rLyivKq8pryuvKG8qbyl
+vK28o7yrvKe8r3ygfKh8pHysfKJ8qnymfK58oXypfKV8rXyjfKt8p3yv/KD8qPyk/Kz8ovyq/Kb8
+rvyh/KnMUf5S/lb+UUaUf9UJ6jzqRJVTeVVQRVVSZVVRVVVTddVQTdVSbdVRXbWmeqqvBmpd7VDn
+VedT51cXUBdUF1IXVhdRF1UXUxdXl1AnqUuqS6lLq8uoy6rLqcurK6iT1RXVTpWoVA3VSI3VRE3V
+TG2oK6krq6uoq6qrqaura6i52lRbaqGWaqWuqa6lrq2uo66rrqeur26gbqhupG6sbqJuqm6mbq5u
+oW6pbqVurW6jbqtup26v7qDuqO6kdqlT1KnqNLVbna7OUGeqPerO6i5qrzpL7VP71dnqruqAOqgO
+qcPqburu6h7qnupe6t7qPuq+6n7q/uoB6oHqQerB6iHqoeph6uHqEeqR6lHq0eox6rHqcerx6gnq
+iepJ6snqKeqp6mnq6eoZ6pnqWerZ6jnquep56vnqBeqF6kXqxeol6qXqZerl6hXqlepV6tXqNeq1
+6nXq9eoN6o3qTerN6i3qrept6u3qHeqd6l3q3eo96r3qfer96gPqg+pD6sPqI+qj6mPq4+oT6pPq
+U+rT6jPqs+pz6vPqC+qL6kvqy+or6qvqa+rr6hvqm+pb6tvqO+q76nvq++oH6ofqR+rH6ifqp+pn
+6ufqF+qX6lfq1+o36rfqd+r36g/qj+pP6s/qL+qv6m/q7+of6p/qHPUv9W/1H3VE/VeboM2jTdQ4
+jdcETdQkTdYUTdU0TdcMzdQszdYczdVqmqf5WqDVJ9w04WatQ5tXm2/CbRNun/CINv+EWybcOuFR
+bYEJB014aMLh2oLaQtrCE67WFtEW1RbTFteW0CZpS2pLaUtPeExbRltWW05bXltBm6ytqHVOuG/C
+/RrRqBZqkRZriZZqmdaYcI+2kraytoq2qraatrq2hpZrTa2lFVqpVdqa2lra2to62rraetr62gba
+htpG2sbaJtqm2mba5toW2pbaVtrW2jbattp22vbaDtqO2k5alzZFm6pN07q16doMbabWo+2s7aL1
+arO0Pq1fm63tqg1og9qQNqztpu2u7aHtqe2l7a3to+2r7aftrx2gHagdpB2sHaIdqh2mHa4doR2p
+HaUdrR0z4XftWO047XjtBO1E7STtZO0U7VTtNO107QztTO0s7WztHO1c7TztfO0C7ULtIu1i7RLt
+Uu0y7XLtCu1K7Srtau0a7VrtOu167QbtRu0m7WbtFu1W7Tbtdu0O7U7tLu1u7R7tXu0+7X7tAe1B
+7SHtYe0R7VHtMe1x7QntSe2pCcdoT2vPaM9qz2nPay9oL2ovaS9rr2ivaq9pr2tvaG9qb2lva+9o
+72rvae9rH2gfah9pH2ufaJ9qn2mfa19oX2pfaV9r32jfat9p32s/aD9qP2k/a79ov2q/ab9rf2h/
+anO0v7S/tX+0Ee1ffYI+jz5R53ReF3RRl3RZV3RV13RdN3RTt3Rbd3RXr+me7uuBXtc79Hn1+fT5
+9QX0BfWF9IX1RfRF9cX0xfUl9En6kvpS+tL6Mvqy+nL68voK+mR9Rb1TJzrVQz3SYz3RUz3TG/pK
++sr6Kvqq+mr66voaeq439ZZe6KVe6Wvqa+lr6+vo6+rr6evrG+gb6hvpG+ub6Jvqm+mb61voW+pb
+6Vvr2+jb6tvp2+s76DvqO+ld+hR9qj5N79an6zP0mXqPvrO+i96rz9L79H59tr6rPqAP6kP6sL6b
+vru+h76nvpe+t76Pvq++n76/foB+oH6QfrB+iH6ofph+uH6EfqR+lH60fox+rH6cfrx+gn6ifpJ+
+sn6Kfqp+mn66foZ+pn6WfrZ+zoQz9HP18/TzJ1yhX6BfqF+kX6xfol+qX6ZfPuEs/YoJZ074Xr9S
+v0q/Wr9Gv1a/Tr9+wqX6DfqN+k0TTtJv1m/Rb9Vv02/X79Dv1O/S79bv0e/V75twjn6//oD+oP6Q
+/rD+iP6o/pj+uP6E/qT+lP60/oz+rP6c/rz+gv6i/pL+sv6K/qr+mv66/ob+pv7WhMv1t/V39Hf1
+9/T39Q/0D/WP9I/1T/RP9c/0z/Uv9C/1r/Sv9W/0b/Xv9O/1H/QfJxyv/6T/rP8y4ZQJp+q/6r/p
+v+t/6H/qc/S/9L/1f/QR/V9jgjGPMdHgDN4QDNGQDNlQDNXQDN0wDNOwDNtwDNeoGZ7hG4FRNzqM
+eY35jPmNBYwFjYWMhY1FjEWNxYzFjSWMScaSxlLG0sYyxrLGcsbyxgrGZGNFo9MgBjVCIzJiIzFS
+IzMaxkrGysYqxqrGasbqxhpGbjSNllEYpVEZaxprGWsb6xjrGusZ6xsbGBsaGxkbG5sYmxqbGZsb
+WxhbGlsZWxvbGNsa2xnbGzsYOxo7GV3GFGOqMc3oNqYbM4yZRo+xs7GL0WvMMvqMfmO2sasxYAwa
+Q8awsZuxu7GHsaexl7G3sY+xr7Gfsb9xgHGgcZBxsHGIcahxmHG4cYRxpHGUcbRxjHGscZxxvHGC
+caJxknGycYpxqnGacbpxhnGmcZZxtnGOca5xnnG+cYFxoXGRcbFxiXGpcZlxuXGFcaVxlXG1cY1x
+rXGdcb1xg3GjcZNxs3GLcatxm3G7cYdxp3GXcbdxj3GvcZ9xv/GA8aDxkPGw8YjxqPGY8bjxhPGk
+8ZTxtPGM8azxnPG88YLxovGS8bLxivGq8ZrxuvGG8abxlvG28Y7xrvGe8b7xgfGh8ZHxsfGJ8anx
+mfG58YXxpfGV8bXxjfGt8Z3xvfGD8aPxk/Gz8Yvxq/Gb8bvxh/GnMcf4y/jb+McYMf41J5jzmBNN
+zuRNwRRNyZRNxVRNzdRNwzRNy7RNx3TNmumZvhmYdbPDnNecz5zfXMBc0FzIXNhcxFzUXMxc3FzC
+nGQuaS5lLm0uYy5rLmcub65gTjZXNDtNYlIzNCMzNhMzNTOzYa5krmyuYq5qrmaubq5h5mbTbJmF
+WZqVuaa5lrm2uY65rrmeub65gbmhuZG5sbmJuam5mbm5uYW5pbmVubW5jbmtuZ25vbmDuaO5k9ll
+TjGnmtPMbnO6OcOcafaYO5u7mL3mLLPP7Ddnm7uaA+agOWQOm7uZu5t7mHuae5l7m/uY+5r7mfub
+B5gHmgeZB5uHmIeah5mHm0eYR5pHmUebx5jHmseZx5snmCeaJ5knm6eYp5qnmaebZ5hnmmeZZ5vn
+mOea55nnmxeYF5oXmRebl5iXTrjTvMy83LzCvNK8yrzavMa81rzOvN68wbzRvMm82bzFvNW8zbzd
+vMO807zLvNu8x7zXvM+833zAfNB8yHzYfMR81HzMfNx8wnzSfMp82nzGfNZ8znzefMF80XzJfNl8
+xXzVfM183XzDfNN8y3zbfMd813zPfN/8wPzQ/Mj82PzE/NT8zPzc/ML80vzK/Nr8xvzW/M783vzB
+/NH8yfzZ/MX81fzN/N38w/zTnGP+Zf5t/mOOmP9aE6x5rIkWZ/GWYImWZMmWYqmWZumWYZmWZdmW
+Y7lWzfIs3wqsutVhzWvNZ81vLWAtaC1kLWwtYi1qLWYtbi1hTbKWtJaylraWsZa1lrOWt1awJlsr
+Wp0WsagVWpEVW4mVWpnVsFayVrZWsVa1VrNWt9awcqtptazCKq3KWtNay1rbWsda11rPWt/awNrQ
+2sja2NrE2tTazNrc2sLa0trK2traxtrW2s7a3trB2tHayeqyplhTrWlWtzXdmmHNtHqsna1drF5r
+ltVn9VuzrV2tAWvQGrKGrd2s3a09rD2tvay9rX2sfa39rP2tA6wDrYOsg61DrEOtw6zDrSOsI62j
+rKOtY6xjreOs460TrBOtk6yTrVOsU63TrNOtM6wzrbOss61zrHOt86zzrQusC62LrIutS6xLrcus
+y60rrCutq6yrrWusa63rrOutG6wbrZusm61brFut26zbrTusO627rLute6x7rfus+60HrAeth6yH
+rUesR63HrMetJ6wnraesp61nrGet56znrResF62XrJetV6xXrdes1603rDett6y3rXesd633rPet
+D6wPrY+sj61PrE+tz6zPrS+sL62vrK+tb6xvre+s760frB+tn6yfrV+sX63frN+tP6w/rTnWX9bf
+1j/WiPWvPcGex55oczZvC7ZoS7ZsK7Zqa7ZuG7ZpW7ZtO7Zr12zP9u3Artsd9rz2fPb89gL2gvZC
+9sL2Ivai9mL24vYS9iR7SXspe2l7GXtZezl7eXsFe7K9ot1pE5vaoR3ZsZ3YqZ3ZDXsle2V7FXtV
+ezV7dXsNO7ebdssu7NKu7DXttey17XXsde317PXtDewN7Y3sje1N7E3tzezN7S3sLe2t7K3tbext
+7e3s7e0d7B3tnewue4o91Z5md9vT7Rn2TLvH3tnexe61Z9l9dr89297VHrAH7SF72N7N3t3ew97T
+3sve297H3tfez97fPsA+0D7IPtg+xD7UPsw+3D7CPtI+yj7aPsY+1j7OPt4+wT7RPsk+2T7FPtU+
+zT7dPsM+0z7LPts+xz7XPs8+377AvtC+yL7YvsS+1L7Mvty+wr7Svsq+2r7Gvta+zr7evsG+0b7J
+vtm+xb7Vvs2+3b7DvtO+y77bvse+177Pvt9+wH7Qfsh+2H7EftR+zH7cfsJ+0n7Kftp+xn7Wfs5+
+3n7BftF+yX7ZfsV+1X7Nft1+w37Tfst+237Hftd+z37f/sD+0P7I/tj+xP7U/sz+3P7C/tL+yv7a
+/sb+1v7O/t7+wf7R/sn+2f7F/tX+zf7d/sP+055j/2X/bf9jj9j/OhOceZyJDufwjuCIjuTIjuKo
+jubojuGYjuXYjuO4Ts3xHN8JnLrT4czrzOfM7yzgLOgs5CzsLOIs6izmLO4s4UxylnSWcpZ2lnGW
+dZZzlndWcCY7KzqdDnGoEzqREzuJkzqZ03BWclZ2VnFWdVZzVnfWcHKn6bScwimdylnTWctZ21nH
+WddZz1nf2cDZ0NnI2djZxNnU2czZ3NnC2dLZytna2cbZ1tnO2d7ZwdnR2cnpcqY4U51pTrcz3Znh
+zHR6nJ2dXZxeZ5bT5/Q7s51dnQFn0Blyhp3dnN2dPZw9nb2cvZ19nH2d/Zz9nQOcA52DnIOdQ5xD
+ncOcw50jnCOdo5yjnWOcY53jnOOdE5wTnZOck51TnFOd05zTnTOcM52znLOdc5xznfOc850LnAud
+i5yLnUucS53LnMudK5wrnaucq51rnGud65zrnRucG52bnJudW5xbnduc2507nDudu5y7nXuce537
+nPudB5wHnYech51HnEedx5zHnSecJ52nnKedZ5xnneec550XnBedl5yXnVecV53XnNedN5w3nbec
+t513nHed95z3nQ+cD52PnI+dT5xPnc+cz50vnC+dr5yvnW+cb53vnO+dH5wfnZ+cn51fnF+d35zf
+nT+cP505zl/O384/zojzrzvBnced6HIu7wqu6Equ7Cqu6mqu7hqu6Vqu7Tqu69Zcz/XdwK27He68
+7nzu/O4C7oLuQu7C7iLuou5i7uLuEu4kd0l3KXdpdxl3WXc5d3l3BXeyu6Lb6RKXuqEbubGbuKmb
+uQ13JXdldxV3VXc1d3V3DTd3m27LLdzSrdw13bXctd113HXd9dz13Q3cDd2N3I3dTdxN3c3czd0t
+3C3drdyt3W3cbd3t3O3dHdwd3Z3cLneKO9Wd5na7090Z7ky3x93Z3cXtdWe5fW6/O9vd1R1wB90h
+d9jdzd3d3cPd093L3dvdx93X3c/d3z3APdA9yD3YPcQ91D3MPdw9wj3SPco92j3GPdY9zj3ePcE9
+0T3JPdk9xT3VPc093T3DPdM9yz3bPcc91z3PPd+9wL3Qvci92L3EvdS9zL3cvcK90r3Kvdq9xr3W
+vc693r3BvdG9yb3ZvcW91b3Nvd29w73Tvcu9273Hvde9z73ffcB90H3Ifdh9xH3Ufcx93H3CfdJ9
+yn3afcZ91n3Ofd59wX3Rfcl92X3FfdV9zX3dfcN9033Lfdt9x33Xfc993/3A/dD9yP3Y/cT91P3M
+/dz9wv3S/cr92v3G/db9zv3e/cH90f3J/dn9xf3V/c393f3D/dOd4/7l/u3+4464/9Ym1OapTaxx
+Nb4m1MSaVJNrSk2taTW9ZtTMmlWza07NrdVqXs2vBbV6raM2b22+2vy1BWoL1haqLVxbpLZobbHa
+4rUlapNqS9aWqi1dW6a2bG252vK1FWqTayvWOmukRmthLarFtaSW1rJao7ZSbeXaKrVVa6vVVq+t
+UctrzVqrVtTKWlVbs7ZWbe3aOrV1a+vV1q9tUNuwtlFt49omtU1rm9U2r21R27K2VW3r2ja1bWvb
+1bav7VDbsbZTras2pTa1Nq3WXZtem1GbWeup7VzbpdZbm1Xrq/XXZtd2rQ3UBmtDteHabrXda3vU
+9qztVdu7tk9t39p+tf1rB9QOrB1UO7h2SO3Q2mG1w2tH1I6sHVU7unZM7djacbXjayfUTqydVDu5
+dkrt1NpptdNrZ9TOrJ1VO7t2Tu3c2nm182sX1C6sXVS7uHZJ7dLaZbXLa1fUrqxdVbu6dk3t2tp1
+tetrN9RurN1Uu7l2y4QHJjxYu7V2W+322h21O2t31e6u3VO7t3Zf7f7aA7UHaw/VHq49Unu09ljt
+8doTtSdrT9Werj1Te7b2XO352gu1F2sv1V6uvVJ7tfZa7fXaG7U3a2/V3q69U3u39l7t/doHtQ9r
+H9U+rn1S+7T2We3z2he1L2tf1b6ufVP7tvZd7fvaD7Ufaz/Vfq79Uvu19lvt99oftT9rc2p/1f6u
+/VMbqf3rTfDm8SZ6nMd7gid6kid7iqd6mqd7hmd6lmd7jud6Nc/zfC/w6l6HN683nze/t4C3oLeQ
+t7C3iLeot5i3uLeEN8lb0lvKW9pbxlvWW85b3lvBm+yt6HV6xKNe6EVe7CVe6mVew1vJW9lbxVvV
+W81b3VvDy72m1/IKr/Qqb01vLW9tbx1vXW89b31vA29DbyNvY28Tb1NvM29zbwtvS28rb2tvG29b
+bztve28Hb0dvJ6/Lm+JN9aZ53d50b4Y30+vxdvZ28Xq9WV6f1+/N9nb1BrxBb8gb9nbzdvf28Pb0
+9vL29vbx9vX28/b3DvAO9A7yDvYO8Q71DvMO947wjvSO8o72jvGO9Y7zjvdO8E70TvJO9k7xTvVO
+8073zvDO9M7yzvbO8c71zvPO9y7wLvQu8i72LvEu9S7zLveu8K70rvKu9q7xrvWu8673bvBu9G7y
+bvZu8W71bvNu9+7w7vTu8u727vHu9e7z7vce8B70HvIe9h7xHvUe8x73nvCe9J7ynvae8Z71nvOe
+917wXvRe8l72XvFe9V7zXvfe8N703vLe9t7x3vXe8973PvA+9D7yPvY+8T71PvM+977wvvS+8r72
+vvG+9b7zvvd+8H70fvJ+9n7xfvV+8373/vD+9OZ4f3l/e/94I96//gR/Hn+iz/m8L/iiL/myr/iq
+r/m6b/imb/m27/iuX/M93/cDv+53+PP68/nz+wv4C/oL+Qv7i/iL+ov5i/tL+JP8Jf2l/KX9Zfxl
+/eX85f0V/Mn+in6nT3zqh37kx37ip37mN/yV/JX9VfxV/dX81f01/Nxv+i2/8Eu/8tf01/LX9tfx
+1/XX89f3N/A39DfyN/Y38Tf1N/M397fwt/S38rf2t/G39bfzt/d38Hf0d/K7/Cn+VH+a3+1P92f4
+M/0ef2d/F7/Xn+X3+f3+bH9Xf8Af9If8YX83f3d/D39Pfy9/b38ff19/P39//wD/QP8g/2D/EP9Q
+/zD/cP8I/0j/KP9o/xj/WP84/3j/BP9E/yT/ZP8U/1T/NP90/wz/TP8s/2z/HP9c/zz/fP8C/0L/
+Iv9i/xL/Uv8y/3L/Cv9K/yr/av8a/1r/Ov96/wb/Rv8m/2b/Fv9W/zb/dv8O/07/Lv9u/x7/Xv8+
+/37/Af9B/yH/Yf8R/1H/Mf9x/wn/Sf8p/2n/Gf9Z/zn/ef8F/0X/Jf9l/xX/Vf81/3X/Df9N/y3/
+bf8d/13/Pf99/wP/Q/8j/2P/E/9T/zP/c/8L/0v/K/9r/xv/W/87/3v/B/9H/yf/Z/8X/1f/N/93
+/w//T3+O/5f/t/+PP+L/G0wI5gkmBlzAB0IgBlIgB0qgBlqgB0ZgBlZgB07gBrXAC/wgCOpBRzBv
+MF8wf7BAsGCwULBwsEiwaLBYsHiwRDApWDJYKlg6WCZYNlguWD5YIZgcrBh0BiSgQRhEQRwkQRpk
+QSNYKVg5WCVYNVgtWD1YI8iDZtAKiqAMqmDNYK1g7WCdYN1gvWD9YINgw2CjYONgk2DTYLNg82CL
+YMtgq2DrYJtg22C7YPtgh2DHYKegK5gSTA2mBd3B9GBGMDPoCXYOdgl6g1lBX9AfzA52DQaCwWAo
+GA52C3YP9gj2DPYK9g72CfYN9gv2Dw4IDgwOCg4ODgkODQ4LDg+OCI4MjgqODo4Jjg2OC44PTghO
+DE4KTg5OCU4NTgtOD84IzgzOCs4OzgnODc4Lzg8uCC4MLgouDi4JLg0uCy4PrgiuDK4Krg6uCa4N
+rguuD24IbgxuCm4ObgluDW4Lbg/uCO4M7gruDu4J7g3uC+4PHggeDB4KHg4eCR4NHgseD54Ingye
+Cp4OngmeDZ4Lng9eCF4MXgpeDl4JXg1eC14P3gjeDN4K3g7eCd4N3gveDz4IPgw+Cj4OPgk+DT4L
+Pg++CL4Mvgq+Dr4Jvg2+C74Pfgh+DH4Kfg5+CX4Nfgt+D/4I/gzmBH8Ffwf/BCPBv/UJ9XnqE+tc
+na8LdbEu1eW6UlfrWl2vG3WzbtXtulN367W6V/frQb1e76jPW5+vPn99gfqC9YXqC9cXqS9aX6y+
+eH2J+qT6kvWl6kvXl6kvW1+uvnx9hfrk+or1zjqp03pYj+pxPamn9azeqK9UX7m+Sn3V+mr11etr
+1PN6s96qF/WyXtXXrK9VX7u+Tn3d+nr19esb1Desb1TfuL5JfdP6ZvXN61vUt6xvVd+6vk192/p2
+9e3rO9R3rO9U76pPqU+tT6t316fXZ9Rn1nvqO9d3qffWZ9X76v312fVd6wP1wfpQfbi+W333+h71
+Pet71feu71Pft75fff/6AfUD6wfVD64fUj+0flj98PoR9SPrR9WPrh9TP7Z+XP34+gn1E+sn1U+u
+n1I/tX5a/fT6GfUz62fVz66fUz+3fl79/PoF9QvrF9Uvrl9Sv7R+Wf3y+hX1K+tX1a+uX1O/tn5d
+/fr6DfUb6zfVb67fUr+1flv99vod9Tvrd9Xvrt9Tv7d+X/3++gP1B+sP1R+uP1J/tP5Y/fH6E/Un
+60/Vn64/U3+2/lz9+foL9RfrL9Vfrr9Sf7X+Wv31+hv1N+tv1d+uv1N/t/5e/f36B/UP6x/VP65/
+Uv+0/ln98/oX9S/rX9W/rn9T/7b+Xf37+g/1H+s/1X+u/1L/tf5b/ff6H/U/63Pqf9X/rv9TH6n/
+2zGhY56OiR1cB98hdIgdUofcoXSoHVqH3mF0mB1Wh93hdLgdtQ6vw+8IOuodHR3zdszXMX/HAh0L
+dizUsXDHIh2LdizWsXjHEh2TOpbsWKpj6Y5lOpbtWK5j+Y4VOiZ3rNjR2UE6aEfYEXXEHUlH2pF1
+NDpW6li5Y5WOVTtW61i9Y42OvKPZ0eooOsqOqmPNjrU61u5Yp2PdjvU61u/YoGPDjo06Nu7YpGPT
+js06Nu/YomPLjq06tu7YpmPbju06tu/YoWPHjp06ujqmdEztmNbR3TG9Y0bHzI6ejp07duno7Zil
+DE4fmrnn7JndfVI+q2vqQH+f1MVaMZ8y0L1bt9g11kh5/4z+vu5dpC7Waq2pPQNTh2dN7+3eQ5v6
+v89qa1r/UNfUqd19Q+rUuR/FYmrX6CGnsaaYOtDfNSSVAOwGYMkAu8catfzfgbrnfpRKdKObtWLJ
+jtg91mhrtnVqRlun1vzfsWbM/aivObV/1qwubMxo29DWajvOzP995tea0jXAz5zSNSCuPdTTO61b
+7BlrpLUxkh6MZG02kp6xRlobfe5h7cS115nYs7O2ThvGzv/7rK/b3qtd/s/GjIHu7r7err5pPVPF
+9bqmDg91i71jjb5e+369bRvieuwE9Y41/HrT+of43mn9Q+IG7Pd97PcbtP++r/33G7Df97ET3Nc1
+u39waKB/9sxuruybwXX3zZA2xOD7MfgN2eD7xxpjw5nDfTO6BoZn9XYNDxn97VviJqwPA6wPm7T3
+YaC9D5uwPgywZlP2q8GxRtu07TQOtp3GzdqPNtR+tM3YYYbYGdls9JIOjV7SzdklHR5rpM0xqmGM
+anM2quGxRth8oKdvhjA8+v/G5v9nhMPtW9LmuPTDeGq2bOvt7m2ft277vOf/PovbsLHuNdao2/zv
+Nt5r7keht79vxqA03NfTSbJOtAQtRRuijdDGaBO0KdoMbQNtjraJtoW2QFuirVjbAH4D+A3gNoDb
+AG4DuA3gNoDbAG4DuA3gNoDbAG4DuI1K2HBm/0Cf0D/6/+y7HGPOgZ0DOwd2Duwc2Dmwc2DnwM6B
+nQM7B3ZeCpuPYQ7/D7OJ8TaB3QR2E9hNYDeB3QR2E9hNYDeB3QR2E9hNYDcx7ibOdwvnuwX8FvBb
+wG8BvwX8FvBbwG8BvwX8FvBbwG8BvwX8FvBbwC+AXwC/AH4B/AL4BfAL4BfAL4BfAL8AfgH8AvgF
+8AvgF8AvgV8CvwR+CfwS+GUsrsme+hljDb4Fegn0Eugl0Eugl0AvgV4CvQJ6BfQK6BXQK6BXGH2F
+0VcYfQX8qqHmo0zCHvKuuR+lvGRtV/dYq2042Ns1OJN97v/f57Gj0M5OtAQtRRuijdDGaBO0KdoM
+bQNtjraJtoW2QFuiZWeDEuAT4BPgE+AT4BPgE+CTVN+0nasH2zawB3pC0BOCnhD0hKAnBD2h6AlF
+Tyh6QtETip5Q9ISiJxRnguJMUJwJCnwKfAp8CnwKfAr8EPgh8EPgh8APgR8CP0zkaf1Dvd2Dgzvj
+C3QgRAdCdCBEB0J0IEQHQnQgRAcidCBCByJ0IEIHInQgQgcinIAIJyACfgT8CPgR8CPgR8CPgB8B
+PwZ+DPwY+DHwY+DHwI+BHwM/Bn4M/Bj4MfBj4MfAj4EfAz8BfgL8BPgJ8BPgJ8BPgJ8APwF+AvwE
++AnwE+AnwE+AnwA/BX4K/BT4KfBT4KfAT4GfAj8Ffgr8FPgp8FPgp8BPgZ8CH9MAimkAxTSAYhpA
+MQ2gmAZQTAMopgEU0wCKaQDFNIBiGkAxDaCYBlBMAyimARTTAIppAG0AH9MBiukAxXSAYjpAMR2g
+mA5QTAcopgMU0wGK6QDFdIBiOkAbwM+BnwMfUwKKKQHFlIBiSkAxJaCYElBMCSimBBRTAoopAcWU
+gGJKQHPg58BvAh/TAoppAcW0gGJaQDEtoJgWUEwLKKYFFNMCimkBxbSAYlpAMS2gmBZQTAsopgUU
+0wKKaQHFtIBiWkAxLaCYBlBMAyimARTTAIppAMU0gGIaQDENoJgGUEwDKKYBFNMAimkAxTSAYhpA
+MQ2gkHUKWaeQdQpZp5B1ClmnkHVa4ngljlfieBB2CmGnEHIKIacQcgqhplWqzBjo2m00a2WKMiap
+o5/G/i3spMpYQdD2TYQ2RpugTdFmxsz+/l3G4njafpWjbaJtoS3QlmjZOQghpyHkNISchpDTEHIa
+Qk5DyGlI0AuSoW2gBT5ENISIhhDRECIaQkRDiGgIEQ0p1af1D43Fgv5vUFDSEEoaQklDKGkIJQ2h
+pCGUNISShlDSEEoaQklDKGkIJQ2hpCGUNISShlDSEEoahsAPgQ8hDSGkIYQ0hJCGENIQQhpCSEMI
+aQghDSGkIYQ0hJCGENIQQhpCSEMIaQghDSGkIYQ0hJCGENIQQhpCSEMIaQgBDSGgIQQ0hFCGEMoQ
+AhlCGEMIYwhhDCGMIYQxhDCGEMYQghVCsMJ0/HvgQbBCCFYIwQohWCEEKoRAhRCoEAIVQqDCtBKG
++vv6B41pPd0D3YM9g2Nbat47e2bX2Eelq69/qLu3u6dLL2cP9vT29419LZdD+Pe1+/FJ33BWz2hh
+zjY2b9tZ3XBW9wy2k9PTP9T1f7CEMSy+2T3UJazZNWtWF+tYI5KAx2/TPdTFlUNdwmYzu4e6+FFA
+Yd2u2bO7xPW6Zk2Z1jVx/eGJGwxP3KpHQg8mbtTDbTKzX9i0Z8asLm6zrmEJveE2mtnDtWb2cBsN
+9uhrt/XEwg7j22rX3BOgd7cPu3t82D3jw/aG/+9P2aDGfs9PGR3UjNFBCdO6e4e6JByL32t0SKP/
+ODQ2pNGDCbuMDal3bEjsHDRbE/uGJ+7RI/WzcXEDM/vFwdFBEWGs4Ya6hiXgc7Nn9nBTZ/Zwswd7
+hP7RE663n2vrP93U+9uv1nD71eqfe7VYNyAqYdEpjnWXyDhbuDchKmER8bNn9hBx7Pfj/4b7EXVl
+iLoyRF0Zoq4MUVeGqCtDCFAIAQohQCEEKIQAhRCgEAIUQoBCCFAIAQohQCEEKERlGaKyDCFIIQQp
+hCCFqCxDVJYhKssQlWUIwQpRWYaoLENUliEqyxCVZVgBvwJ+BfwK+BXwK+BXDD9CLRmhloxQS0ao
+JSNIYAQJjCCBESQwQi0ZoZaMIH4RxC+C+EUQvwjiF0H8IohfBPGLIH4RxC+C+EUQvwjiF0H8Iohf
+BPGLIH4RxC+C+EUQvwjiF0H8IohfBPGLUEFGqCAj6F4E3YugexF0L4LuRdC9CLoXQfci6F4E3Yug
+exF0L4LuRdC9CLoXQfci6F4E3YugexF0L4LuRdC9CLoXQfci6F4E3YugexF0L4LuRdC9CLoXQfci
+6F4E3YugexF0L4LuRdC9CLoXQfci6F4E3YugexF0L0IBGUH/IuhfBP2LUEBGKCAjFJARdDGCLkYo
+ICPoY4QCMoJORtDJCDoZQScj6GQEnYygkxEKyAgFZIQCMkIBGaGAjFBARiggIxSQEQrICAVkhAIy
+QgEZoYCMoMcR9DiCHkfQ4wh6HEGPI+hxBD2OUEBGKCAj6HMEfY6gzxH0OYI+RyggIxSQEQrICAVk
+hAIyQgEZoYCMUEBGKCAjFJARCsgIBWSEAjJCARmhgIxQQEYoICMUkBEKyAgFZIQCMkIBGaGAjFBA
+RiggIxSQEQrICAVkhAIyQgEZoYCMUEBGKCAjFJARCsgIBWSEAjJCARmhgIxQQEYoICMUkBEKyAgF
+ZIQCMkIBGaGAjFBARiggIxSQEQrICAVkhAIyQgEZoYCMUEBGKCAjFJARCsgIBWSEAjJCARmhgIxQ
+QEYoICMUkBEKyAgFZIQCMkIBGaGAjLCuHGFdOUJBGaGgjFBQRigoIxSUEQrKCAVlBO2PUFBGKCgj
+FJQRtD9CQRmhoIxQUEbQ/wj6H0H/I+h/BP2PoP8R9D+C/kfQ/wj6H0H/I+h/BP2PoP8R9D+C/kfQ
+/wj6H0H/I+h/BP2PoP8R9D+C/kfQ/wj6H0H/I+h/BP2PoP8R9D+C/kfQ/wj6H0H/I+h/BP2PoP8R
+9D+C/kfQ/xj6H0P/Y+h/DP2Pof8x9D+G/sfQ/xj6H0P/Y+h/DP2Pof8x9D+G/sfQ/xj6H0P/Y+h/
+DP2Pof8x9D+G/sfQ/xj6H0P/Y+h/DP2Pof8x9D+G/sfQ/xj6H0P/Y+h/DP2Pof8x9D+GTsfQ6Rg6
+HUOn43B8P/QTOh1Dp2PodAydjqHTMXQ6hk7H0OkYOh1Dp2PodAydjqHTMXQ6hk7H0OkYOh1Dp2Po
+dAydjqHTMXQ6hk7H0OkYOh1Dp2PodAydjqHTMXQ6hk7H0OkYuhxDl2PocgxdjqHLMXQ5hi7H0OMY
+ehxDj2PocQw9jqHHMfQ4hh7H0OMYehxDj2PocQw9jqHHMfQ4hh7H0OMYehxDj2PocQw9jqHHMfQ4
+hh7H0OMYehxDj2PocQw9jqHHMfQ4hh7H0OMYehxDj2PocQw9jqHHMfQ4hg7H0OEY+hJDX2LoSwx9
+iaEvMfQlhr7E0JcY+hJDX+Lm+HExHuhLDH2JoS8x9CWGvsTQlxj6EkNfYuhJDD2JoQcx9CCGHsTQ
+gxh6EEMPYuhBXIz/Hv2FHsTQgxh6EEMPYuhBDD2IoQcx9CCGHsTQgxh6EEMPYuhBDD2IoQcx9CCG
+HsTQgxg8H4PnY/B8DJ6PwfMJ+DUBvybg1QS8moBPE/BhAp5LwGcJeCwBjyXgsQQ8loDHEtQxCeqY
+BHVMgjomQR2ToI5JUMckqGMS1DEJ6pgEdUwCfkzAjwn4MQE/JuDHBPyYgB8T8GMCfkzAjwl4LwHv
+JeC9BLyXgPcS8F4C3kvAewl4LwHvJdH4cTEu8F4C3kvAewl4LwHvJeC9BLyXgPcS8F4C3ktQlyTg
+uwT1RQI+S8BnCfgsAZ8l4LMEfJaAzxLwWQI+S5Lx46L/4LMEfJaAzxLwWQI+S8BbCXgpAS8l4KUE
+vJSAlxLwUgJeSsBLCXgpAS8l4KUEvJSAlxLwUgJeSlAnJOCnBPyUoE5IUCckqBMS1AkJ6oQEdUKC
+OiFBnZCgTkhQJySoExLUCQnqhAR1QoI6IUGdkKBOSFAnJKgTEtQJCeqEBHVCgjohQZ2QoE5IUCck
+qBMS1AkJ6oQEdUKCOiFBnZCgTkhQJySoExLUCQnqhAQ8noDHE/B4Ah5PwOMJeDwBjyfg8QQ8noDH
+E/B4Ah5PwOMJeDwBjyfg8QQ8nYCnE8z7E8zzE8zzE8zvE/B4Ap5OwH8J+C8B/yXgv2Sc/6rx/YGH
+eW6CeW6CeW4KXUmhKyl0JYWupKhbUtQtKfqfov8p+p+2xo+Xo22ibaEt0JZo2flIMb4UOpVCp1Lo
+VAqdSjH+FDqVQqdS6FQKnUqhUyl0KoVOpdCpFDqVQqdS6FQKnUqhUyl0KoVOpdCpFDqVQqdS1Ccp
+6pMU9UmK+iRFfZLiuqW4bimuW4rrluK6pbhuKa5TOn6dUI+UmMeW4I8S/FGCN0rwRAmeKMEPJfig
+xPNd4vku8XyXeL5LPNclnusSz3WJ57psjB+nRIv+4Hku8TyXeJ5LPMclnt8Sz2uJ57XEc1riOS3x
+nJZ4Tks8pyWe0xLPaYnntMRzWuI5LfGclnhOSzynJZ7PEs9nieezxP1f4v4vcf+XuP9L3P8l7vsS
+93uJ+73E/V7ifi9xv5e430vc3yXu7xL3d4n7u8T9XeL+LnF/l7i/S9zfJe7vEvdzye5n0sn4lHSy
+80862fknnez8k07Gp6STXQfSyfiUdLLrQToZn5JOxqekk10f0smuD+lkfEo683G8Em3F2ibwm8Bv
+Ar8J/Cbwm8BvAr8J/Cbwm8BvAr8J/Cbwm8BvAr8J/BbwW8BvAb8F/BbwW8AvsH+B/QvsX2D/AvsX
+4/ujvwX6W6C/BfpboL8F+lugv+PXp0B/C/S3BH4J/BL4JfBL4JfAL4FfAr8Efgn8Evgl8Evgl8Av
+gV8CvwJ+BfwK+BXwK+BXwK+AXwG/An4F/Ar4FfDZOgmBD0LggxD4IAQ+CIEPQuCDEPggBP4Hgf9B
+4H8Q+B8E/geB/0HgfxD4HwT+B4H/QeB/EPgfBP4Hgf9B4H8Q+B8E/geB/0HgfxD4HgS+B4HvQeB7
+EPgeBL4Hge9B4HsQ+B4EvgeB70HgexD4HgS+B4HvQeB7EPgeBL4Hge9B4HsQ+B4EvgeB70HgexD4
+HgR+B4HfQeB3EPgdBH4Hgd9B4HcQ+B0EfgeB30HgdxD4HQR+B4HfQeB3EPgdBH4Hgd9B4HcQ+B0E
+PgeBz0HgcxD4HAQ+B4HPQeBzEPgbBP4Ggb9B4G8Q+BsE/gaBv0HgbxD4GwT+BoG/QeBvEPgbBP4G
+gb9B4G8Q+BsE/gaBv0HgbxD4GwT+BoG/QeBvEPgbBP4Ggb9B4G8Q+BsE/gaBv0HgbxD4GwT+BoG/
+QeBvEPgbBP4Ggb9B4G8Q+BsE/gaBv0HgbxD4GwT+BoG/QeBvEPgbBP4Ggb9B4G8Q+BsE/gaBv0Hg
+bxD4GwT+BoG/QeBvEPgbBP4Ggb9B4G8Q+BsE/gaBv0HgbxD4GwT+BoG/QeBvEPgbBP4Ggb9B4G8Q
++BsE/gaBv0HgbxD4GwT+BoG/QeBvEPgbBP4Ggb9B4G8Q+BsE/gaBv0HgbxD4GwT+BoG/QeBvEPgb
+BP4Ggb9B4G8Q+BsE/gaBv0HgbxD4GwT+BoGfQeBnEPgZBH4GgZ9B4GcQ+BkEfgaBn0HgZxD4GQR+
+BoGfQeBnEPgZBH4GgZ9B4GcQ+BkEfgaBn0HgZxD4GQR+BoGfQeBnEPgZBH4GgZ9B4GcQ+BkEfgaB
+n0HgZxD4GQR+BoGfQeBnEPgZBH4GgZ9B4GcQ+BkEPgaBj0HgYxD4GAQ+BoGPQeBjEPgYBD4GgY9B
+4GMQ+BgEPgaBj0HgYxD4GCQa1+cK+BXwmY9B4GMQ+BgEPgaBj0HgYxD4GAQ+BoGPQeBDEPgQBD4E
+gQ9B4EMQ+BAEPgSBD0HgQxD4EAQ+BIEPQeBDEPgQBD4EgQ9B4EMQ+BAEPgSBD0HgQxD4ECSGHsfQ
+4xh6HEOPY+hxDD2Ooccx9DiGHsfQ4xh6DH+DwN8g8DcI/A0Cf4PA3yDwNwj8DQJ/g8DfIPA3CPwN
+An+DwN8g8DUIfA0CX4PA1yDwNQh8DQJfg8DXIPA1CHwNAl+DwNcg8DUIfA0CX4PA1yDwNQj8DAI/
+g8DPIPAzCPwMEkOXY+gy/A0Cf4PA3yDwNwj8DQJ/g8DfIDF0GT4Hgc9B4HMQ+BwEPgeBz0HgcxD4
+HAQ+B4HPQeBPEPgTBP4EgT9B4E8Q+BME/gSBP0HgTxD4EwT+BIE/QeBPEPgTBP4EgT9B4E8Q+BME
+PgSJoZcx9DKGXsbQyxh6GUMvY+hlDL2MoZcx9DKGXsbQyxg6F0PnYuhcDJ2LoXMxdC7Ox3+H8wKd
+i6FzMXQuhs7F0LkYOhdD52LoHHwEAt+AYB2aYB2aYF2WYF2WYF2WJJgXJZgXJZgXYb2WJLiOCa4j
+1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ
+1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mEJ1mFJAT4vwOcF+LwAnxfg8wJ8XoDPC/B5wXxpUqAu
+K1CXFajLis5xnAJtiZaNs4AeFNCDAnpQQA8K6EEBHSigAwV0oIAOFNCBAjpQQAcK6EABHSigAwV0
+oIAOFNCBAjpQgPcL8H4B3i/A+wV4vwDvF+D9ArxfgPcL8H0Bvi/A9wX4vgDfF+D7AnxfgO8L8H0B
+vi/A9wX4vgDfF+D7IhzHxTjB+wV4vwDvF+D9ArxfgPcLPE8FnqcCvF+A9wvwfgHeL8D7BXi/AO8X
+4P0CvF+gHivA/wX4vwD/F+D/AvxfgP8L8H8B/i/A/wX4vwDvF+D9ArxfgPcL8H4B3i/A+wV4vwDv
+F+D9ArxfgPcL8H4B3i/A7wX4vQCfF+DxAnxQ4Dkrxp8z1A0F6oYCdUMBPi3ApwX4tACfFuDTAnxa
+gE8L8GkBPi3ApwX4tACfFuDTAnxagE8L8GmBuqFA3VCgbihQNxSoGwrUDQXqhgJ1Q4G6oUDdUKBu
+KFA3FKgbCtQNBdbnCqzPFagTCtQJBeqEAnVCgTqhQJ1Qgq9K8FUJvirBVyX4qgRfleCrEnxVgq/K
+zvHj5WibaFtoC7QlWtbfEnxVgq9K8FUJvirBVyXmryV4qwRvleCtErxVgrdK8FYJ3irBWyV4qwRv
+leCtErxVgrdK8FaJ+WuJ+WsJHivBYyV4rASPleCxEjxWgsdK8FgJHisxfy3BZyX4rASfleCzEnxW
+gs9K8FkJPivBZyX4rASfleCzEnxWgs9K8Bl8DVKCz0rwWQk+K8FnJfisBJ+V4LMSfFaCz0rwWQk+
+K8FnJfisBJ+V4LMSfFaCz0rwWQk+K8FnJfisBJ+V4LMSfFaCz0rwWQk+K8FnJeazJXitBK+V4LUS
+vFaC10rwWgleK8FrJXitBK+V4LUSvFaC10rwWol1phLrTCXWmUrMp0rMp0rMp0rMp0rMp0rMp0rM
+p0rMp0rMp0rwZgneLDEvLjEvLsGjJebFJfi0xLy4TCtxy7E3ysTdWbMly3HYfaxRthx/LULZffwT
++x3mZfC9CHwvUmJeBv+LlJiXlZiXwQ8jJeZlJeZlJeZlJeZlJeZlJeZl8M8I/DMC/4zAPyMl5mXw
+0Qh8NAIfjcBHI/DRCHw0Ah+NlNAL+GkEfhqBn0ZK6AV8NVJCL+CvkRJ6UUIv4LcR+G2khF7AdyPw
+3Qh8NwLfjcB3I/DdCHw3At+NwHcj8N0IfDcC343AdyMl9AL+G4H/RuC/kRJ6UUIv4McR+HEEfhyB
+H0fgx5ESegJfjpTQE/hzpBzXE6w7wZ8j8OcI/DlSYt2pxLoT/DoCv47AryPw6wj8OgK/jsCvI/Dr
+CPw6Ar+OlFh3KrHuBP+OjPt3JeqdEutOJdadStQ/JdadSqw7lVh3KrHuVGLdqcS6U4l1pxLrTiXW
+nUr4QyXWn0qsP5VYfyqx/lRi/amsiLg1exD3HGvwLdCx+lRi9anE6lOJ1acSq08lVp9KrD5VUPcK
+6l5B3SuoewV1r6DuFdS9grpXUPcK6l5B3avO8eO30BZoS7RsdBXUvYK6V1D3CupeQd0rqHsFNa+g
+5hXUvIKaV1DzCmpeQb0rqHcF9a6g3hXUu4J6V1DvCupdQb0rqHcF9a6g3hXUu6LjeBgf1LuCeldQ
+7wrqXUG9K6h3BfWuoN4V1LuCeldQ7wrqXUG9K6h3BfWuoN4V1LuCeldQ7wrqXUG9K6h3BfWuoNYV
+1LqCWldQ6wpqXUGtK6hzBXWuoM4V1LmCOldQ5wrqXEGdK6hyBTWu4vHjov9Q3wrqW0F9K6hvBfWt
+oL4V1LeC+lZQ3wrqW0F9K6hvBfWtoL4V1LeC+lZQ3wrqW0F9K6hvBfWtoL4V1LeC+lZQ3wrqW0F9
+K6hvBfWtoL4VVkEqrIJUWAWpsApSYRWkQtVTQW0rqG0Fta2gthXUtoLaVlDbKhvHw3ihthXUtoLa
+VlDbCmpbQW0rqG0Fta2gthXUtoLaVlDbCmpbQW0rqG0Fta2gthXUtoLaVlDbCmpbQW0rqG0Fta2g
+thXUtoLaVlDbCmpbQW0rqG0Fta2gthXUtoLaVlDbCmpbQW0rqGwFla2gshVUtoLKVlDZCipbQWUr
+qGwFla2gshVUtoLKVlDZCipbQV0rqGsFda2grhXUtYK6VlDXCupaQV0rqGsFda2grhXUtYK6VlDT
+CmpaQU0rqGkFNa2gphVUtIKKVlDRCipaQUUrqGgFFa2gohVUtIKKVlDRCipaQUUrqGgFFa2gohVU
+tIKKVlDRCq5NBdemgm5W0MsKellBLyvoZQW9rMb1Em5NBbemYm4N7WQ6SjuZjtJOpqO0k+ko7WQ6
+SjuZjtJOpqO0k+ko7WQ6SjuZjtJOpqO0k+ko7WQ6SjuZjtLOznHcirUE+ISo03tmDA90T+sanImv
+AEkSY7hvWvfA4NT+ge5pU3qNXYf7h7pHo+AGBrunYR8cjoZmf99oZGF3X29317TuAXNo9/62TeyF
+zlN0nqLzFJ2n6DxF5yk6T9F5CrSQiLN6+sYC8bqn9vehLyFOWYj+hzhlIVBDoIZNpXuPqb1ds6ZN
+6cU3wAmBEwInwkmKcJEiXKQIFykCUgSkCEgRkCKML8L4Iowvwvgi4EbAjYAbAzcGbgzcGLgxRhoD
+PwZ+DPwY+DHwY+DHwI+BHwM/Bn4M/AT4CfAT4CfAT4Cf4LgJjpvguAmOm+C4CY6b4rgpjpviOCnG
+kWIcKcaRYhwpxpECLwVeCrwUeCnwUuBlwMuAl2EcGcaRAT8Dfgb8DPgZ8DPgZ8DPgJ8BPwN+BvwG
+cBvAbQC3AdwGcBvAbQC3AdwGcBvAbQC3AdwGcPNOY2p/b3/frP6+7qGugT3xLeV7ewa6sAGIPBFn
+dw92D41/DYQcCHnOT+vvm8GXwwP9+AZjyjGmHOe0ibE1MbYmxtbE2JoYWxPATSA1gdTEWJo4foHj
+FThegXNR4FwUGHOBMTNBoAQESjrHtynaEG2ENkaboE3RZmgbaJtoW2gLtCVaNn5COuW1pw907TI0
+PIAvAEwATAAMGiUEwCTVdu/u6R4YHBroGhzEdwBnVQklpClv8n8PXuizB7oHpw70zB7qYRl+lIB0
+CcUpoDgFFD2g6AFFDyh6APoloF8C+iWgXwL6JaBfQgule3CoZ1bXEOiegIBJCOQQyCHOQYgehJHQ
+1ds9eya2gA8iJiBiEgI/BH4I/BD4oGUCWiagZQJaJqBlAlomoGUSYfygZwJ6JqBnAnomoGcCWiag
+YQL6JaBfElN59AGb2TMwTRnavX/sAy4gGJiAgQkYmICBCRiYxLnS39fd3TNj5tBMfWjmQDc+D2rT
+e3Yb/6wPdu/W3YcN/A5DBiMTMDIBIxMwMgEjkwT9SdCfBP1J0J8E/QFzEzA3AXMTMDcBcxMwNwFz
+EzA3SYGfAh9MTsDkBExOwOQETE7A5ARMTsDkBExOwOQETE7A5Ah5pQh5pQh5pQh5pQh5pQh5pSRr
+KF0DA/2793ZPH5LGPg3PVsfagdHTy/5xWv/ufezTlP6hmQp2m4anDKSMvFaKvFaKvFaKvFaKvFaK
+vFaKvFaKvFZKGhhCjiHkGEKOIeQYQo4hgLGR2UpJnupzezZlsBtfohM5OpGjEzk6AfYmYG8C9iZg
+b4S3UoS3UoS3UtKMzKldAwM9XTO6B7qHhgdwLproSxPnAqSODFeKDFeKDFeKDFeKDFeKDFeKDFeK
+DFeKDFeKDFeKDFeKDFeKDFeKDFeKDFeKDFeKDFeKDFeKDFeKDFeKDFeKDFdKWhU7l9Om9I7eGer4
+xvBsY/zj2P0xd6/RW2Tuxuhdwo5ToF8QK2S7UmS7UmS7UmS7UmS7UmS7UmS7UmS7UmS7UmS7UjIu
+ciXOS4nzUuK8lMAvgV8CvwR+CfwS+CXwS+CXwC+BXwK/An4F/Ar4FfAr4FfAr4BfAb8CfgX8CvgV
+8CvgV8BHNUSqShnu6xktLLrYlJx2Eq17j57Boe6+oZ6530Vy96zZQ3sOdg/JMwZGc5f6hqTu3u5Z
+o+HQLENr9CN2zuXB4akzh2Z2jX/RQlugZdjIcKXIbKXIbKXIbKWUJHrX4FD3QM/gLrO6cANQtqxI
+KQQcMa2UkpY+e6B/dv/AqFp39ar9A0MzR2Owu3qFrr4ZvezpRUgrRUgrRUgrRUgrRUgrpTRRe/tn
+9EztGs0/V/Cxf0Dv6RsarcGmjoIIw33jEwOEtFKEtFKEtFKEtFKEtFKEtFKEtFIahsrQzO6B7un9
+A+ghaigK6aaQbqS0UqS0UqS0Uho2pcGeWT29XQPYRg8g3UhppUhppUhppUhppUhppTSKlKn9fTMG
+hudeR4g3clop8lkp8lkp8lkp8lkp8lkp8lkp8lkp8lkp8lkp8lkp8lkp8lkp8lkp8lkp8lkp8lkp
+8lkp8lkp8lkp8lkp8lkp8lkpjSute9fhnt26erv7puLsQr2RzUqRxUqRxUqRxUqRxUqRxUqRxUqR
+xUqRxUqRxUqRxUqRxUqRxUqRxUqRxUqRxUqRxUqRxUqRxUqRxUqRxUqRxUqRxUqRxUqRxUqRxUqR
+xUqRxUppRsaehu6BweEpg91D5vjG6P91Dyl9/UPsH7B3rA90jybAY+/xDbY39kHPUJEhpZUipZUi
+pZUipZUipZUipZUipZUipZUipZUipZXSRqSOJtH3ds/uHR7EV4nJvpo13DvUM7t3T3yNnmA6gLxW
+irxWirxWirxWirxWirxWirxWirxWirxWirxWSvPImN09MLt79A8fDM99rjApQGorRWorRWorRWor
+RWorRWorRWorRWorRWorRWorRWorRWorRWorRWorRWorRWorRWorRWorRWorRWorRWorRWorRWor
+RWorRWorRWorRWorRWorRWorpa1ImtY/9D/exUSAYiKAFFeKFFeKFFeKFFeKFFeKFFeKFFeKFFeK
+FFeKFFeKFFeKFFeKFFeKFFeKFFeKFFdKIfgUgk8h+BSCTyH4FIKP1FeK1FeK1FeK1FeK1FeK1FeK
+1FeK1FeK1FeK1FeK1FeK1FdKIfgUgo8UWIoUWIoUWEoh+BSCTyH4FIKPlFhKIfgUgk8h+BSCTyH4
+FIJPIfgUgk8h+BSCTyH4lP3H6jREFR92EmFm/zBmtyFqeKTOUqTOUqTOUqTO0hA1fIgaHnmzFHmz
+FHmzFHmzFHmzNOysjIHu3aCnff2MW5A6S5EiS5EiS5EaS5EaS5EaS0NSqaNCPGOgq3do9tyPU3A0
+aDliYiliYiliYiliYiliYSliYSniRyniRyniRyniRiniRWmINaoQa0kheCcE74TgnRC8E4J3QvBO
+CN4JwTsheCdEGRKiDAnBOCEYJwTjhGCcEIwTgnFCME4IxgnBOGFrvEU/cIcjTpIiPpLi9TuK1+8o
+XtuieG2L4rUtite2KF7bonhti+K1LYrXtihe26JR0pA3rUhn5/jKe9xJRr8gbV+EaCO0MdoEbYo2
+Q9tAm6NtyptWne0HLNCWaKvRHcL/7YA7Dq9Y0JiEozvQth3QBawhxSQd3SFq2wF9wAJSTMb6kLXt
+gD7gpsVbFhRvWVC8ZUHxlgWN6VgXGv87AO5fvGZB8ZoFxWsWFK9ZULxmQWM61oOk7QDoASajeM+C
+4j0LivcsKN6zoHE41oP0fwfAXBQvWlC8aEHxogXFixYUL1rQOBzrQdx2APQAk1EkSVG8cUHxxgXF
+GxcUb1xQvHFB8cYFxRsXFG9cULxxQfHGBcUbFxRvXFC8cUHxxgXFGxc0jkbvg2jsPpA3rWiEDzEZ
+/0DxIWyM7zP+DR3fh8bjO3fiQzS+c5iN7zP3Qzr+IRnfZ+6H8X+KxiHIXNDxI9PxrkZzdx4/cjTe
+n2i8P9H4kaPxn8dzBzj3OG13MOawiJ+iiJ+iiJ+iiJ+iiJ+iiJ+ieL2D4vUOitc7KF7voHi9g+L1
+DorXOyhe76B4vYPi9Q6K1zvo2Osds6f09k/dhW1jxQmvd9DR1zum9bX/O/DhHcRZKrT/K9AxTx19
++aN3evu/Ax3z1DirpIEh9u+9Q4Mzu6Z1C2P/L03bhbVje4Hz8eoHxasfNMZSVYy5aYy5aYy5aQyN
+iKERMTQihkbEjUqZ3tPb2z1tSv8e4lqUdo6fbIgE3iGheIeE4h0SindIKN4hoXiHhMZ5Q1yLZHEU
+jjUxUdnhB7qnDmEH9AAqgZdIKMK6aNwk0tDoatTwbGyjA5iXIqyLIqyLIqyLIqyLxs0G+/0A8JpN
+to1FPoR1UYR1UYR1UYR1UYR1UYR10bgVst/3Tsc28DEvRXgXjTEvjVsNkRUP2MSJx3Q0bpVjJwbL
+5oj8ooj8ooj8ooj8ooj8ooj8ooj8onGRKj19u00Z7u3tHhr91A6JGSlivyhivyhivyhe16GI/aKI
+/aJ4fYci9osi9osi9ovGZaz2z+7uY6D4CoOHlCP5iyL5i8aYlMaYlMaYlMaYlMaYlCIhjCIhjCIh
+jCIhjCIhjMaYlMaYlMaYlMaYlMaYlMaYlMaYlMaYlMaYlMaYlMaYlCagpgTWUgJrKcEEIcEEIcEE
+AUllFEllNMEEAYllFIllNMG0FMllNMH8IMH8IIHFlGBFKsH0IMH0IMEKVYIVKiSf0QSzg4QAHxPY
+BJODBJMDJKTRBBPaBHODBHMDJKfRBHMDJKhRJKhRJKhRJKhRJKhRJKhRJKhRJKhRJKhRJKhRJKhR
+JKhRJKhRJKhRJKhRJKhRJKhRJKhRJKhRJKhRJKhRJKhRJKhRJKjRJGwog7N6erund03t1nv6dpu7
+wQ0O92EXdAFTgySsxOnds7rw8CQR4ds2AI9pAYLWKF5wo3jBjSJojSJojSJojSJojSJojSJojSJo
+jSJojSJojSJojSJojSJojSJojSJojSZYqULgGk2wUpVgpQoBbDTBSlWClaoEK1UJVqqSuBIGZ49r
+DGLaaJJQfmrv8BRsRMLM7q6BIWlaT9es8f/wAgltFAltNEka2qzhQdRW3Wbb5/H/4gJhbRRhbRQv
+BVK8FEjxUiDFS4EULwVSvBRI8VIgxUuBFC8F0gTqn0D9EfJGEfJGEfJGEfJGEfJGEfJGEfJG8XIh
+xcuFFC8XUrxcSPFyIcXLhRQvF1K8XEjxciHFy4UULxdSvFxI8XIhxcuFFC8XUrxcSPFyIcXLhRQv
+F1K8XEjxciHFy4UULxdSvFxI8XIhxcuFFC8XUrxcSPFyIUW4G0W4G0W4G0W4G0W4G0W4G0W4G0W4
+G0W4G0W4G0W4G0W4G0W4G0W4G0W4G0W4G0W4G0W4G0W4G0W4G0W4G0W4G0W4G0W4G0W4G0W4G0W4
+G02g3wn0O4F+J1hXSqBqKSg+7RzfDtFGaBO0KdoMbQNtC22BtkTLxpOCylNQeQoqT0HlKag8BZWn
+oPIUVJ6CylNQeQoqT0HlKag8BZWnoPIUVJ6CylNQeQoqT0HlKag8BZWnoPIUVJ6CwlNQeAoKT0Hh
+KSg8BYWnoPAUFJ6CwlNQeAoKT0HhKSg8BYWnoPAUFJ6itktR26X4TwRS/CcCKfg7BX+nKO1SlHYp
+SrsUpV0KDk/B4Sk4PAWHp+DwFByegsNTcHgKDk/B3Sk4OwVnp+DsFJydgptTcHIKTk7BySk4OQUn
+p+DkFJycgoVTrHqkWPVIseqRYtUjxapHCipOQcUpHIQUDkIKByEF/6bg3zQZx8N4wL8p+DcF/6bg
+3xT8m4J/U/BvCv5Nwb8p+DcF/6bg3xT8m4J/U/BvCv5Nwb8p+DcF/6bg3xT8m4J/U/BvCv5Nwb8p
++DcF/6bg3xT8m4J/U/BvCv5Nwb8p+DcF/6bg3xT8m4JnU/BsCp5NwbMpeDZFpZWCb1PwbQq+TcG3
+Kfg2Bd+m4NsUfJuCb1PwbQq+TcG3Kfg2Bd+m4NsUfJuCb1PwbQqeTcGzKXg2Bc+m4NkUPJuCZ1Pw
+bIp1/xTr/inW/VOs+6eor1LUVwihpAihpAhvpAhlpAhlpClKiBQlRIoSAmGNFGGMFGGMFGGMFGGM
+FGGMFGGMNEWJkKJEQDgjRTgjRTgjTavx46P/KBFSlAgpSoQMJUIG/cigHxn0I4N+ZCgRMuhIBh3J
+oCMZdCRDiZChRMigKxl0JYOuZNCVDLqSQVcy6EoGXcmgKxl0JYOuZNCVDLqSQVcy6EoGXcmgKxl0
+JYOuZNCVDLqSQVcy6EoGXcmgKxl0JYOuZNCVDCVCBn3JoC8Z9CWDvmTQlwz6kkFfMuhLBn3JoC8Z
+9CWDvmTQlwz6kkFfMuhLBn3JoC8Z9CWDvmTQlwz6kkFfMuhLBn3JoC8Z9CWDvmTQlwz6kkFfMuhL
+Bn3JoC8Z9CVDjZBBZzLUCBn0JoPeZNCbDHqToUbIUCNkqBEy6FCGGiGDHmXQowx6lEGPMuhRBj3K
+oEcZ/nu0DKvyGfQpgz5l0KcM+pRBnzLoUwZ9yqBPGfQpgz5l0KcM+pRBnzLoUwZ9yqBPGfQpgz5l
+0KcM+pRBnzLoUwZ9yqBPGfQpgz5l0KcM+pRBnzLoUwZ9yqBPGfQpgz5l0KcM+pRBnzLoUwZ9yqBP
+GfQpgz5l0KcM+pRBnzLoUwZ9yqBPGfQpgz5l0KcM+pShPshQH2SoDzLoVgbdyqBbGXQrg25l0K0M
+upVBtzLoVgbdyqBbGXQrg25l0K0MupVBtzLoVgbdyqBbGXQrg25l0K0MupVBtzLUCRn0K4N+ZdCv
+DPqVQb8y6FcG/cqgXxn0K4N+ZdCvDPqVQb8y6FeG9cEM9UWG+iJDfZGhvsjwH7Bl0LsMepdhfTDD
++mAG3zqDb53Bt86wUJhhoTCDe5bBPcuwUphhpTDDSmGGlcIMK4UZVgozrBRm8K0z+NYZfOsMq4QZ
+VgkzrBJmWCXMsEqYYZUwg25nWCXMUE9lWCXMsEqYwd3LoO8Z9D2DvmfQ9wz6nkHfMywRZlgizLBE
+mGGJMIP+Z9D/DPqfQf8z6H8G/c+g/xn0P4P+Z9D/DPqfQf8z6H8G/c+g/xn0vwE9b0DPG9DvBvS7
+AX1oQB8a4IUGeKGB69nA9WzgejZwPRu4ng1czwauZwPXs4Hz1EC/G+h3jnlJjnlJjnlJjvlEjvlE
+jvlEjvlEjvlEjvlEjvlEjvlEjvlEjvlEjvlEjvlEjvlEjvlEjvlEjvlEjvlEDv3Owfc5+D4Hz+bg
+2Rw8m4Nnc/BsDp7NcT5znM8cPJuDZ3PwbA6ezcGzOXg2B8/m4NkcPJuDZ3PwbA6ezcGzOXg2B8/m
+4NkcPJuDZ3PwbA6ezcGzOXg2B8/m4NkcPJuDZ3PwbA6ezcF/OfgvB//l4L8c/JeD/3LwXw7+y8F/
+OfgvB//l4L8c/JfjechxXzVxPzVxPzVxPzUxz21intvEPLeJ56KJ56KJeW4T89xm5/hxm2hbaAu0
+JVo2zibuyybuyybuyybuyybuyybuyybuyybuyybuyybuyybuyybmsU3MY5uYxzYxj21iHtvEPLaJ
+eVUT86oW5j8tzH9amP+0MP9pJeP7xWgTtCnaDG0DbY62ibaFtkBbomX9bmH+08L8p4X5Twvznxbm
+Py3Mf1qY/7Qw/2lh3tPCvKeFeU8L854WnscWnscWnkeEkVGEkVGEkVGEkVGEkVGEkVGEkVGEkVGE
+kVGEkVGEkVGEkVGEkVGEkVGEkVGEkVGEkVGEkVGEkVGEkVGEkdEC9wNCyShCyShCyShCyShCyShC
+yShCyShCyShCyShCyShCyShCyShCyShCyWiB+6VA3YNwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpw
+MYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpw
+MYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMYpwMVqgTkHIGEXI
+GEXIGEXIGEXIGEXIGEXIGEXIGEXIGC3wfCEEiiLUiSLEiSLEiSLEiSLEiSKEKSrYvDAq2LwwKti8
+MCrYvDBCmFaEMK0IYVoRwrQihGlFCNOKCjYvjAo2L4wKNi+MCjYvjIrWOG7F2gL4BfAL4BfAL4Bf
+AL8AfgH8AvgF8AvgF8AvgF8AvwB+AfwS+CXwS+CXwC+BXwK/BH4J/BL4JfBL4JfAL4FfAr8Efgn8
+CvgV8CvgV8CvgF8BvwJ+BfwK+BXwK+BXwK+AXwG/Aj6bF+YIPc4Repwj9DhH6HGO0OMcocc5Qo9z
+hB7nCD3OEXqcI/Q4R+hxjtDjHKHHOUKPc4Qe5wg9zhF6nCP0OEfocY7Q4xyhxzlCj3OEHucIPc4R
+epwj9DjHH3HMEX6cI/w4R/hxjvDjHOHHOcKPc4Qf5wg/zhF+nCP8OEf4cY7w4xzhxznCj3OEH+cI
+P84Rfpwj/DhH+HGO8OMc3mwObzaHN5vDm83hvebwXnN4rTm81hzeag5vNYe3msNbzfFHq3L80aoc
+f7Qqxx+tyvFHq3JYojks0Rx/tCrHH63K8UercvigOXzQHD5oDr8wh1+Ywy/M4Rfm8P9y+H85/L4c
+fl8OnyqHT5WnTGfzlOlsDt8qh2+Vw7fK4Vvl8K1y+FY5fKscvlUO3yqHb5XDt8rhS+XwpXL4Ujl8
+qRy+VA4fKocPlcOHyuFD5fChcvhQeUoxHorxUIyHAi9Ef0P0N8TxQxw/xPFDHD/E8UMcP8TxQxw/
+xPHD8eNjPCHGE2I8IcYTAT8CPu47+Ek5/KQcflIOPymHn5SnEY4X4Xi4L+En5fCT8jTGeHBfprgv
+4Qfl8INy+EE5/KAcfkoOPyWHn5LDT8nhk+TwSXL4JDl8khw+SQ6fJIc/ksMfyeFj5PAxcvgYOXyM
+HD5GDh8jh4+Rw8fI4WPk8DFy+Bg51ulzrNPnWKfPsU6fY50+xzp9jnX6PGUvlHXSMp4c41My91M6
+91M291Nj7qd4cjT3F9HcX0RzfxHN/UU09xfh3F+Ec38Rzv1FOPcX4dxf0Lm/oHN/Qef+gs79BZ37
+CzL3F2TuL8jcX5C5vyD84FD3LGm4r6fCf2RZIWWkQspIhZSRCn/zvsLfeqnwt16qaO6/R2hjtAna
+FG2GtoE2R9tE20JboC3RVqwlwCfAJ8AnwCfAJ8AnwCfAJ8AnwCfAJ8AnwCfAJ8AnwKfAp8CnwKfA
+p8CnwKfAp8ClwKXApcClwKXApcClwA2BGwI3BG4I3BC4IXBD4IYYdwj8EPgh8EPgh8APgR8CPwR+
+BHxGV1XCpqMVyv0K5X2F8r5CeV+hvK9QflcovyuU3xXK7wrld9XEeW/ivKPsrlB2Vyi7K5TdFcru
+CmV31cR5b+K8NymOi/PfxPlv4vw3cf6bOP9NnP8mzn8T57+J89/E+W/i/Ddx/ps4/02c/ybOfxPn
+vYnz3sR5b+K8N3HemzjfTZznJs5zM8LxIxw/wnEjjCvC8SMcP8LxIxw/wvEjjCsax8G4YowjBk4M
+nBjjiIEXAy8GXgy8GHgx8GLgxcCLgRcDL8H4EowvAX4C/AT4CfAT4CfAT4CfAD8BfgL8BPgJ8BPg
+J8BPgZ8CPwV+CvwU+CnwU+CnwE+BnwI/BX4K/BT4KfBT4KfAz4CfAT8Dfgb8DPgZ8DPgZ8DPgJ8B
+PwN+BvwM+BnwM+BnwG8AvwH8BvAbwG8AvwH8BvAbwG8AvwH8BvAbwG8AvwH8BvAbwM+BnwM/B34O
+/BzHy3G8HMfLcbwC+xXoZ4F+FuhngX4W6GeBfhY4boHjFjhugf4V6F+J45Y4bonjljhuieOWOG6F
+41ToX4XjsfKiQrZ1hWzrCtnWFbKtK2RbV8i2rpBtXSHbukK2dYVs6wrZ1hWyrStkW1fItq6QbV0h
+27pCtnSFbOkKyxIVliUqZP9WyP6tkP1bIfu3QvZvhczfCpm/FTJ/K2T+Vsj8rZD5WyHzt0Lmb4XM
+36rEc1DiOcDftKzwNy2rEs8B/rZlVeI5QOZvhczfCpm/FTJ/K2T+Vsj8rZD5WyHzt0Lmb4XM3wqZ
+vxUyfytk/lbI/K2Q+Vsh87dC5m+FzN8Kmb8VMn8rZP5WyPytkPlbIfO3QuZvhczfCpm/FTJ/K2T+
+Vsj8rZD5WyHzt0Lmb4XM3wqZvxUyfytk/lbI/K2Q+Vsh87dC5m+FzN8Kmb8VMn8rZP5WyPytkPlb
+IfO3QuZvhczfCpm/FTJ/K2T+Vsj8rZD5WyHzt0Lmb4XM3wqZvxUyfytk/lbI/K2Q+Vsh87dC5m+F
+zN8Kmb8VMn8rZP5WyPytkPlbIfO3QuZvhczfCpm/FTJ/K2T+Vsj8rZD5WyHzt0Lmb4XM3wqZvxUy
+fytk/lbI/K2Q+Vsh87dC5m+FzN8Kmb8VMn8rZP5WyPytkPlbIfO3QuZvhczfCpm/FTJ/K2T+Vsj8
+rZD5WyHzt0Lmb4XM3wqZv1VZAb8CfgX8CvgV8CvgV8CvgF8BvwJ+BXzwYgkeRNphhbTDCmmHFdIO
+q6oqxGEy+qYjawhrKGtC1kSsiVmTsCZlTcaaBmty1jRZ02INEErWVGMNYXiE4RGGRxgeYXiE4RGG
+RxgeYXiE4RGGRxgeYXiE4RGGRxkQZUCUAdFRoIINumCDLtigCzbogg26YIMu2KALNuiCDbpggy7Y
+oAs26IINumCDLtigCzbogg26YIMu2KALNuiCDbpggy7YoAs26IINumCDLtigCzbogg26YIMu2KCL
+kDA8yvAow6MMD2OnDI8yPMrwKMOjDI8yPMrwKMOjDI8yPMrwKMMLGV7I8EKGFzK8kOGFDC9keCHD
+CxleyPBChhcyvJDhhQwvZHghw4sYXsTwIoYXMbyI4UUML2J4EcOLGF7E8CKGFzG8iOFFDC9ieBHD
+ixlezPBihhczvJjhxQwvHsOL2cBiNrCYjShmI4rZiGI2opgNJWZDidlQYjaUmA0lZmOIWXdj1t2Y
+dTdm3Y0jHGysuzHrbsz6GbN+xqyfMetnjH7GKWtYd2PW3ZgBxQwoZkAxA4oZUMyAEgaUsM4nDC9h
+eAnDSxhewvAShpcwvIThJQwvYXg5O2bOjpmzY+bsmDk7Zs6OmbNj5uyYOTtmzo6Zs2PmOCYbQ87G
+kLMx5GwMTYbXZHhNhtdkeE2G12R4TYbXZHhNhtdkeE2G12R4TYbXZHhNhtdkeC2G12J4LYbXYngt
+htdieC2G12J4LYbXYngthtdieC2G12J4LYbXYngFwysYXsHwijG8tMhY02BNzpoma1qsKVhTsmbs
+mGnZyRrCGsoadswyYk3MmoQ1KWsYXsnwSoZXjuKV5Rgnl+UYJ5flGCeX5Rgnl+UYGZflGBmX5RgZ
+l+UYGZflGBmX5RgZl2UnDtZiTcGakjXVWEMYEGFAhAERBjRGxmVJGB5heIThEYZHGB5heIThEYZH
+GB5heIThUQZEGRBlCJQdk7KDUXYwyg5G2cEoOxhlB6PsYCHrfMiOGbJjhuyYIet1yHodMoSQIYTs
+0An7XcJ+l7DfJWzPhI0vYT9IWJcS1peE9SVhfUlYX1LWl5QdM2XHTNkxU9aXlPUlZQgpO3TKDp2y
+LqUMIR099GjyAmsIayhrQtZErIlZk7AmZU3GmgZrctY0WdNiTcEaAFVjTcTwIoYXMbyI4UUML2J4
+EcOLGF7E8CKGFzG8iOFFDC9ieBHDixhezPBihhczvJjhxQwvZngxw4sZXszwYoYXM7yY4cUML2Z4
+McOLGV7C8BKGlzC8hOElDC9heAnDSxhewvAShpcwvIThJQwvYXgJw0sYXsrwUoaXMryU4aUML2V4
+KcNLGV7K8FKGlzK8lOGlDC9leLhRUoaXMbyM4WUML2N4GcPLGF7G8DKGlzG8jOFlDC9jeBnDyxhe
+xvAyhtdgeA2G12B4DYaXs3/L2b/l7N9y/BvrS876krO+5KwvOetLzvqSs77krC8560vO+pKzvjQZ
+QpMhNBlCkyE0GUKTITQZQpMhNBlCkyE0GUKTITQZQpMhtBhCiyG0GEKLIbQYQoshtBhCiyG0GEKL
+IbQYQoshtBhCCwjsfBYMqGBABQMqGFDBgAoGVDCgggEVDKhgQAUDKhhQwYAKBjQmWRWbe1ds7l2x
+uXfF5t4Vm3tXbO5dsbl3xebeo/YAa8YOFjFeihgFJWOSVSVjklUlY5JVJWOSVSVjZUSVdGLPhDUp
+azLWNFiTs6bJmhZrCtaUrBkbQ0IYHhtKwoaSsKEkbCgJG0rChpKwoSRsKAlheIThEYZHGB5heITh
+EYZHGR5leJThUYZHGRBlQJQBUQZEGRBlQJQBsROZsBOZUIbA6D5hpzVhdJ8wuk8Y3Sc414zuE0b3
+CaP7hNF9wug+YXSfMLpPGN0njO4TRvcJo/skCpVpvesNTe0a6O+TC/ypKyEf/SNYwmY9vdO6hTVH
+/xyP2uoZmDo8azTiTmiN7qsP9/WM1q6Tp3YNdgvN0Zx9pegf6po6tbtvSF9ruG9G18DwrN6u4SGt
+6B+e0ts99ld91K6Brik9U3ec1j+k4yOd1j80OL4Rjm6Y7Rs7dpntO+7YNb5rNPa70V4kSTl5ek9f
+19yNnr6eobkbs7qn9bCNnLTtlpO23XLStltStR+taj9a1bZbM2/7l2Y+9i8a+jbQ0zeD/UPaajtY
+2mr7SdpqO1hatO9WtO9WtO2WtY8gax9B1j6CjLbvRtt3o+27xe27xe27xe0DbT8fzfbz0WTnY/wC
+zeiavuOUrgGp7Jsxuat3yBrdhybZ5Gn9Q73dg4Nsu1GMb2tjd1BnNrq4gs/R6DY+p23fj+5D2j53
+tu1P2j6Pfx/+57ettu/Hj98axWj7fvw49D+fO9s+h/ic/Oczafvc2fa50fbb8c9j60bqlIGusWyZ
+/iFhndGTxU5og12RAKumYxuTe/v7ZgwOdQ2Mn/T2q9Zsv2rN9qvWan8mWu3PRKv9mWiwW0Xo/V8n
+CgbgjG5EaTZ5Vn9f/4yBrtkz8U3jf99YPaNpsd27tF/i0cDy/2w32rdH/xTk/91uNce3uWlT+4W1
+R7vCso4nT+ka7B47b0lMOpOo2faZXdMkao5ut31ftn2usE/Ztk/Uts/o52ps1Gkr/9/5Gt2Ye4pG
+N+aevLTVbN+t2b5bs323VvturfbdWu27Fe27Fe27Fe27le27le27le27Ve27Ve27tT21adHZtlvR
+2bZb0dm+G2nfjbTvRtp3o+270fbdaPtuYftuYftuYftuUftuUftuUftucftucftucftuSftuSftu
+SftuaftuaftuaftuWftuWftuWftujfbdGu27Ndp3a7/fivb7rWi/34r2+61ov9+K9vutaL/fivb7
+rWi/34r2+61ov9+K9vutaL/fivb7rWi/34r2+61ov9+K9vutbL/fyvb7rWy/38r2+61sv9/K9vut
+bL/fyvb7rWy/38r2+61sv9/K9vutbL/fyvb7rWy/38r2+61sv9/K9vutbL/fyvb7rWy/38r2+61s
+v99Kdr8pm/T0zeia0r9bt4aEoVFKxGfS9pm2fQ7bPkdtn+O2z0nb53Qu40dpOXlaT9dUxu9ZPnnK
+6GxulI/xTfN/3wh7zv1ZkjXa5w95+0azfaN9/tDobN8I2zei9o2kfSNt32gHbeT/d+N/ItfI20Wu
+vTuNZvtuzfbd2udqjfa5WqN9rtZon6s12udqjfa5WqNdgBvtAtz4PwL8f85O++yq0T7bzNvPW972
+3IxutO3WPvPL22d+efvML28/8XnYvlvYvlv7VCNvn2rk7VONvP365Gn7bmn7bln7bln7bln7bu3X
+NG+/pnn7Nc3br2nefk3z9muat1/TvP2a5u3XNG+/WHn7xcrbL1az/So0269Cs/0qNNuvQrP9KjTb
+r0Kz/Y5vRu27Re27tT8LzaR9t6R9t/ar0Gy/Cs32q9BsvwrN9qvQbL8KzfbHrNlo363Rvlv76W22
+n95m++lttj8yzfZHpskeGQt/4qx/976xgtLZdXj0b/n09839RseLLuyf2yu9yaN529bsgf5pw1OH
+Jk/rGZzd27Xn2MyTks7xbWdweNasrtEjjn9jj0cg/9+f0NZ/tov/bJf/2a7+73bY+Z9t8p9t+p/t
+8P9ut/7z+9Z/ft/6z+9b//f3eWfnf7bJf7bpf7Zb/9ku/rNd/mf7/443J//BI//BI//BI//pL4n+
+sx3/Zzv5z3b6n+3sP9uN/2zn/9lu/md77vjvmHjAGv/+NcLN8bg/J3F/UO7307jfTO7XEe6XEe7n
+SdxPJvfjadwPk7jvj8qF70e4707jvj2N+2YO9/Uc7qsR7suVuC+a3Ocj3GeU+/STTYVPT+M+OSoX
+PtmU+/ijFYWP53Afrch9OMJ9MMK9T7n3PO7d07h3Rri3Xe6tfbk37+beGOFeOyoXXtuXe/WVNYVX
+9+VeWZN7+aX5hZdHuJfm514c4V4Y4Z4f4Z4b4Z49jXvm6YWEZ0a4pxfinqLckyPcY4c6wmMLcI8G
+3CMj3MMj3EMj3IMj3AMj3P0j3H0j3L0j3D0j3N0j3F0Od+dhk4Q7R7g7br9buGOEu/227YXb7+Zu
+P4C/7dZJwm3br/Evd9sa/K2TuFtGuJtP424a4W4c4W4Y4a4f4a6bxl1rctdcPUm4Zhp39VWucPUk
+7iqXu/KoXLhyDnfFCHf5CHfZCHepy10ywl18kSlcTLmLTO7CadwFR+XCBadx549w552rC+eNcOfq
+3DlnzyucM407+yxbOHte7iybO1PlzhjhTj/NEE4f4U4zuFOPyoVTT+NOOdkUTlmaO9nkTprDnXjC
+3cKJI9wJx28vnHA3d8IB/PHHTRKO3547fg3+uEncsSPcMUdPFo4Z4Y6ezB112CThqJw78ghNONLj
+jtC4ww+bJBw+jTvsUEc4bBJ3qMMdMsIdfJAjHDzCHeRwB45wB4xw+49wa/y73777CvuNcPvuy+0z
+jdt7M1/YexK31wi35wi3h8ntrnO7qdzwCDc0hxucww3M4Xadw80e4fpHuL4RrndRbpcRbmenKey8
+Kdczws3cl5vhNIXpI1z3CDdthJs6wk0Z4bpW4naaw+2gc9uPcNuOcNuMcFtvpQpbz+G2Urktg3mF
+LSm3xQi3+Wa+sHmT28znNp3HFjbt4DbxuI3XqQkbj3AbadyGI9wG69vCBiPc+ja33gi37jo1Yd0R
+bp21bWGdGrf2goawts2tZXBrjnDVaVx5GleMcK2JKwitOVzzbi5fl1tjhFt9hFttVVdYzeNWXcUS
+VnW5VVY2hFXW+NfiVja4lUa4xgiXpZ6QzeHSxBZSj0tiTUhsLta4aCEuNDhKNIGOcETjOlfUhE6D
+W1HjJq+gCJNtbgWFW55yyy07SVhuGrfsMq6w7CRuGZdbeqlJwtI5t9QkbslJmrCkxU3SuCVGuMVH
+uMUsbtF5bGFRl1tkGrfwHG6hBQ1hoWncgga3gNMUFhjh5p/Dzdfk5nWawrwjXMc0rh7MK9RHuGAe
+Wwjm5fwRzhvhaiOc6zQFd4RzJq4gOE3O3pezpnHmCGfogWCMcPo8tqAHnDbCqTanjHDyxBUEeYST
+PE6cxvHz2AI/wq3hcxNXELgRbuI8tjBxBW4em5swws1zxzzTDj12nuX+X/jfhP9f927B/28A
+`
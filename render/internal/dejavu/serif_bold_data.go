@@ -0,0 +1,3519 @@
+// Code generated by go run gen.go; DO NOT EDIT.
+
+package dejavu
+
+// serifBoldData is the "DejaVu Serif Bold" TrueType font program,
+// compressed using DEFLATE and encoded using base64.
+const serifBoldData = `
+xP0JfBTF1j8Of6vXWXpmevbsM5PJRkJISAgQCDBARGSXJQRkCYvIThQJDFtYhbAIboCoiMgFRFSu
+FxG5yKIBURFUwBVxIYJXI6JyuQpJz/vp6plkgnif5/6e53n/k3R1zZnq6lpOnTrnVJ1TIADsBOCQ
+263bwN6b+yalAKQIQMLtxbd1m1tYNR0gpQA23d6v74BHZp3uBZDfgHZxtw8Y1GX2ZnwAFDkAIa/v
+gJy8qZ/8OhDo0h1AyZgpo8rzB85cDXTZDFjLx1Tc733ghx1rgIEyQLaOK79nyqXlP1cDg/YAWHnP
+qOnlECECJQEA0j2Tg+Oe39T9LaCkF9hvYsbfPWps+t+/KQfXdiyA1uPH3z3K8rx7Ori2TwJIGT/l
+/lncA19Wg2t7GGh9fNLd903t+NrkkeCmLQd8tsnTxoyKf+bxDHBrnwRan5gyala5LZebDe6t8QC8
+U0dNufvbbevbg3tLTT+nfNr0+6+c+uV5cP/ggEV7y++7u/zhkofagZ90AOArARAA+PLHb2cAwFe5
+Tw5uuBMAEoAHsQ4MnGCZXSDMC8wRsMwbzBsgzJvMMbDMW8xxEOZt5j2wzEnmFAjzPnMaLHOGOQvC
+fMR8BZb5mvkWhLnI/AiWucwvBuGX8FVg+RXSFyDSeek8WOlL6UsQ6SvpIhjpkqkCxDTTtBCsaZHp
+ryDgADDh8qqXFhrwDq6AK+7WayDixgTvm4zMe+67exK6TR51/1SU0KcQCoGl6RnIYHr3LPZCHtC/
+ixdyk99uTjsCls5Db/NiRNf+A70YcXvn270YcceA3l6M6Nl5qBr276mGFNKLhr379vJiRB8a799X
+DQf0HKCGFDKws5rPQPpUkzexaj/DMWXUfZMQN2XSlEnwhn9Rw0iNWeggIwYC/R4DTr0zv2mpkhUQ
+0oWYQchESPCiC7qjDwZiKMowDpNxH2ZhPpZgBdZiHZ7EFuzAi9iD/TiMYziBD/EJzqMG3+MKrqEO
+pD4kjKNhEQ1tUWHRH8JaNRTjo57SwoVRIYWIbSNPKRCW0NAGopDGp6Lj0SED/pqa/r8TChwY6JiX
+mIMA8z3zPWSmlqmFlWKdjeJbjGmmaSZiTUFTEHGmOaZKxIOBwLzQ8IyNPmOnzzjoM3EUI+ORBmAj
+gM0AtgHYBeBlAPsAHARQDeAdAO8D+AjAOQDfAPgOwGUAVwFcD6OyABAJIDaAxAAkCSAq/coESC5A
+Wmu0jHQBSHeA9AHIQIAMBUgZQMYBZDJA7gPILLXu3OuRsD4UDn//Q3j9D/HX/yQ88AfI9UiogCum
+4e9qr6nx+hCFkCbvaniWiSrb/yRMlOIeAJPYDRJ5R8wUc8XWYpHYRewu9hEHikPFMnGcOFm8T5wl
+zheXiCvEteI68Ulxi7hDfFHcI+4XD4vHxBPih+In4nmxRvxevCJeE+t0jE6nM+scujidV5ema67L
+07XVddQV63ro+ulKdMN0o3XjdVN19+tm6xboHtCt0j2s26DbpNuq26nbrdurO6B7Q3dcd1J3RveZ
+7ivdRV2t7hfdbzpFz+kNelnv0ifok/UZ+hb6Vvp2+oC+m76Xvr++VD9CP1Y/UV+ur9DP1S/SL9c/
+qH9UvxHQb9Zv0+8C9C8D+n36g/pqQP+O/n39R/pz+m/03+kv66/qrwMGGASDZLAZYgxJhhRDpiHX
+0NpQZOhi6A4Y+gCGgYBhKGAoAwzjDJMN9xlmGeYDhiWGFYa1gGGd4UnDFsCwAzC8CBj2AIb9gOEw
+YDhmOGH4EDB8AhjOG2oM3wOGK4ZrhjojY9QZzUYHYIwDjF5jmrG5Mc/Y1tjRWGzsYexnLDEOM442
+jjdONd4PGGcbFxgfMK4yPmzcYNxk3Grcadxt3Gs8YHzDeNx40njG+JnxK+NFY63xF+NvRkXiJIMk
+Sy4pQUqWMqQWUiupnRSQukm9pP6AVCqNkMZKE6VyqUKaKy2SlksPSo9KG6XN0jZpl/SytE86KFVL
+70jvSx9J56RvpO+ky9JV6boJJsEkmWymGFOSKcWUaco1tTYVmbqYupv6mAaahprKTONMk033mWaZ
+5puWmFaY1prWmZ40bTHtML1o2mPabzpsOmY6YfrQ9InpvKnG9L3piumaqc7MmHVms9lhjjN7zWnm
+5uY8c1tzR3OxuYe5n7nEPMw82jzePNV8v3m2eYH5AfMq88PmDeZN5q3mnebd5r3mA+Y3zMfNJ81n
+zJ+ZvzJfNNeafzH/ZlYsnMVgkS0uS4Il2ZJhaWFpZWlnCVi6WXpZ+ltKLSMsYy0TLeWWCstcyyLL
+csuDlkctGy2bLdssuywvW/ZZDlqqLe9Y3rd8ZDln+cbyneWy5arlugxZkCXZJsfISXKKnCnnyq3l
+IrmL3F3uIw+Uh8pl8jh5snyfPEueLy8B5BWAvBaQ1wHyk4C8BZB3APKLgLwHkPfLh+VjgHwCkD8E
+5E8A+Twg1wDy94B8BZCvAXIdYGUAqw6wmgGrA7DGAVYvYE0DrM0Bax5gbQtYOwLWYsDaA7D2A6wl
+gHUYYB0NWMcD1qmA9X7AOhuwLgCsDwDWVYD1YcC6AbBuAqxbAetOwLobsO61HrC+YT1uPQlYzwDW
+z6xfWS9aa62/WH+zKjbOZrDJNpctwZZsy7C1sLWytbMFbN1svWz9baW2Ebaxtom2cluFba5tkW25
+7UHbo7aNts22bbZdtpdt+2wHbdW2d2zv2z6ynbN9Y/vOdtl21XbdDrtgl+w2e4w9yZ5iz7Tn2lvb
+i+xd7N3tfewD7UPtZfZx9sn2++yz7PPtS+wr7Gvt6+xP2rfYd9hftO+x77cfth+zn7B/aP/Eft5e
+Y//efsV+zV7nYBw6h9nhcMQ5vI40R3NHnqOto6Oj2NHD0c9R4hjmGO0Y75jquN8x27HA8YBjleNh
+xwbHJsdWx07HbsdexwHHG47jjpOOM47PHF85LjpqHb84fnMoTs5pcMpOlzPBmezMcLZwtnK2cwac
+3Zy9nP2dpc4RzrHOic5yZ4VzrnORc7nzQeejzo3Ozc5tzl3Ol537nAed1c53nO87P3Kec37j/M55
+2XnVed0Fl+CSXDZXjCvJleLKdOW6WruKXF1c3V19XANdQ11lrnGuya77XLNc811LXCtca13rXE+6
+tgCuHYDrRcC1B3DtB1yHAdcxwHUCcH0IuD4BXOddNa7vXVdc11x1bsatc5vdDnec2+tOczd357nb
+uju6i9093P3cJe5h7tHu8e6p7vvds90L3A+4V7kfdm9wb3Jvde9073bvdR9wv+E+7j7pPuP+zP2V
++6K71v2L+ze3EsPFGGLkGFdMQkxyTEZMi5hWMe1iAjHdYnrF9I8pjRkRMzZmYkx5TEXM3JhFMctj
+Hox5NGZjzOaYbTG7Yl6O2RdzMKY65p2Y92M+ijkX803MdzGXY67GXI9FrBArxdpiY2KTYlNiM2Nz
+Y1vHFsV2ie0e2yd2YOzQ2LLYcbGTY++LnRU7P3ZJ7IrYtbHrYp+M3QLE7oh9MXYPELsfiD0MxB4D
+Yk8AsR/GfhJ7PrYm9vvYK7HXYuvimDhdnDnOERcX541Li2selxfXNq5jXHFcj7h+cSVxw+JGx42P
+mxp3PwjL8a+pIQDCjqLxUTQepPHgH+A5NJ5D46lqnHxJ46spfDWNv0Pj76hx8mVjGvIjjRcq/UE4
+8HvUsDENm6rGuSIKL6L5rKfw9TQ+j8bnNZaHfBkNV9Y0LSfzqRpnPqVpCIUTGt9N47tpPI7G4+h7
+F6pxbiH4pnDmc5rP5zReS+O1NF6q8jRMqRonxRT+DIVvoPANNP/TFP4cha+k8JU03pWWn9aF2Ubh
+25T+Dfn8SvOcJ7SMpCHT1Tg+/0Oa4sZ8uNvVfLjbo8pA0zC7aHwXjf+ixsk8NR/mGZreq/SPpA+X
+pwtN34XmP5e+K5vC36TwN2l8BI2PCN1QW54rjvRLBB4dp/l3o/FuWl+IU0C4w7S1D1NIngph52lh
+VC+/RCGnaP6nbq5LExyLbpOlWvtrLaC+ne+h/sr3oK3Ugda6wx9qF9UjTXrzC20U8K9F8tRwjPla
+K2dUaY00bowaC/laGIprHAWNeBvObZ6GpfxrkZaP4CoN12phGA8PN7RqNB5qbWul4Xalf8PbV9+M
+XeHS0nZj1mghTXMPjd8TPbqV/o0YG4V1z92MXeE2pCOUdOKKI3XUxnITuhE97qJpThQ9iR4j0bSC
+tdE0tpvHXZPxHo3D0WM2Gg/raLzuD/mU05Yvb8SEcO93p2m6R/VXeEQr/RvjN4/6/1a8iMaL/hD/
+F43/q7E9tVHPbONfi/RjdJ82yXM+jc+PThM9cpX+DbX7NaqmdIw0oTbzGluSPErjj9L4RNojz9Jn
+O9FnZ0VTIUrVY+j4irmZIkXiSv+m8ehx2iT+660pGHecPnuc9toR2iNHaLwlxYSW9NnfaZnP02d/
+519rjGvwaIr3Z3FarzL63jn02adp/AaN36Dxp6OpJW3DWeq7cK6xncPxP0lDTlD4fUq/Rlqk9G/s
+L5r/azT+2h8ocFSc/YC2wwcanH8tAmeu0jRXQ9caKbDS/9/EvbStQvRZWk5mghonIQq/QuF9KLxP
+FD3RRtyDNJ8HafwNGn+Dxk/R9Edp/EcK//HmGY35J+3T+9U4uRLVzs/wr0VmKHLl1mUTdqrphZ3R
+caV/0zi3gpbzshrnH1fh/NWbcZU8zMtqSP4Owjan6ZvTtjXRtjXR+HkKP/+HukRRqiazfzCa5otZ
+IGwGzcGqUTw15AMqt8OeUGk7Z+D3ROizNq+FW1ijcks1mq+GHJ0vWNpfXBKN/51/rYGmjdaoqBpy
+Bhr/TauFNo644ggnwDxMw5k0pYXGR2lUjoYJjTMpqeK/Vn+l73qAK254L02p1YIz3Bzn7qMlNNOU
+v9FQK098Y905u1ZOfk+EkmijnjepEJ62vFYjbYaNxnzOE8UbRFPCaJ4nOj6Ls0eolsb/kB9v7uVo
+DGwyL0T1eJM8KTVjNL5rCY0voXGND1n6h/TDaHxYY5zQWZj9jL6rLY23pfHPaJrJNP0UGj9G48do
+fD1XHJl5uefVOPc8hW+krbRR6d849/2BT4vix5rERwk0pGUooOkLGttfK2cTKnSZxi//oS50JmLn
+03gmjWeqcXKR/0AN6YqBosYZhca9NO6lcR//lRr+AU5oXG1FQt6n+bxP4UUUXkTjY2h8DE2TTdNk
+03hrNY6j5JDaI7y6FrE0Ks0N+uxOCt9J411oPl1ovJx/Xw2ZJ0GYPArPaywbyW6Ma+Uk71L4uzRe
+Q+M1NE1/mqY/hW/hoYY0T16lPAyvwrnBtB8HQ/11TWN7hmfkl/7Aw0fjQ9RMQQ6peZJDzK7ITBTm
+i6LibJka5zQOYZMaZz5W47gi2NWQ2RWhPGHZ7QJNcxeN1/CvNeBwFc2N0nn2df4KCDMp3O/qWBt0
+s3wXLbM0kePCvJzSvykNjKYJTWTGKPgt+OFu0bKk0r8pzxk9LprIfVFjJLoMTaj9ef5KA0cRPa5n
+0vrOpGmuqjhDrtL+DcuhSkljOZWSBniXRp5Z62X+Ii0P7VmhBY1Tmsybo+gtLRtPy8Z1VCFcx+gy
+3ywnatIETzkQVpO8KJfImvjXInSPeZp/LcLVsCNofGzoSxC2D63jU0r/P4c3oatR8iOfTd+brWFI
+I63+M3gTqfAFCn+BwifRck5vhDM7/ms4p1P6R+DsUlpOjWM/rXGwNH2nf4dX5Eu1r7kaTV7gr0Tk
+BU6i+Uv0vQ6av4PW68/6JYzntAznG8evJiGSY3/gQufwr0U4W1LMGRvST6Pw5xvTs6n/Lp9oHU4T
+ziRKrxItzTXB+TH8hxFayuzieTVkdjVy79rY51+L9B3zrBrnNB3O07Q9n9baULwzMsb5kzTNOPAR
+OT0czovSeEzmipv+qpW2iSR7pLF9mMv8aw1zUHg8huJumqeix+lAGh9I45STYR64mQdg9nGFakjh
+Go5Nulmfw8XSusTeJGUzmIQUdABQjOGIwwN4AK2wHMtRgNVYjdbYgA1og43YiLbYhE0oxA7sQDvs
+x360x3f4B4rwK35FR2IiJnQiDuJEgDQjzdCFdCZd0JV0Jz1wG+lFeqE76Uf64w4yiJSgFyklQ9CH
+lJFR6EcmkInoT6aSqRhIykk5BpHpZDpKyAwyC4PJXDIXd5FtZDuGkV1kF0aQfWQfRpIa8i3KyPfk
+B4wm18jvGEtCJITxDM8YMIGRmP6Yxgxk7sFeZgIzAe8wk5hJeJeZwkzBCeYp5im8x1xl/omTzG/M
+73ifCTEhfMi2YFvgNDuNnYYz7L3sfTjLzmHn4GN2Pjsfn7AL2IX4lF3MLsbn7FJ2Kc6xy9kqfMGu
+ZFfiS/ZB9kF8xT7GrsPX7Ab2CVxgN7GbcIndzD6D79hn2WfxPfsX9i/4gX2OfQ617PPs8/iRfZF9
+EZfZV9i9+Indx+7Hz+wh9hCusm+wb+Cf7LvsGVxjP2Y/IWA/Zz8nDHuBrSEse4m9RHj2e/YHInCt
+uFZEx7Xh2hA99wNXSwzcT9wvROL+ySlE5sGDxPEsn07i+WZ8NgnwxXwxuY2/nb+ddONL+BJyOz+E
+H06683P5uaS3kCakkz7CEmEp6Se+Jb5F+ou/ir+SAWKdWE8G6jgdR0p0ok4kg3UGnYGUSlYpjgyR
+EqREMkbySH5yt5QqpZOJUjMpi0yRhkhjSbkUlLaRudJOaQ/ZaVpkWk5eMT1t2kEOmF40/ZUcAwM9
+3ZEBuhfDSXdhxNL9F0l050Uy3XORTdewC+gadnu6hl2E2//Ha80g8wGyBCArALIWIOtAyJMqvwNC
+doCQF0GIyu3vByGHVbqlysIg5EMQ8gmdWwmpASHfU6mTkGsgpA6EYUAYHQhjBmEcIIw6yr0gTBoI
+01zly0CYtiBMR4ApBpgeANMPYEoAlVdhRgPMeICZCjD3A8xsgFkAqOOfWQUwDwMqfWQ2AcxWgNkJ
+MLsBZi/AHADUvS3aSvb/f0IFbHM15KY0hsD/NAyF/nfy+fchV9xY5qh4090At6w1E0n/PwsZZ0dw
+gOywudAMefj/7KO8AIQmhdaGJoU8/1fvCK2l79gIhDaGIeeifn1B/fvzZ0MbQy/QZ01AJERa+Oc0
+Gktr8sS5/8dSnrv52/9rTv/tN76KtNCr/1VJ/seftP9rHArlhbaG8kKvhpb/m/5fe+t2Da1t+DsX
+Wq7d/5O2+t/8qFgYGhV64d+l+Z9/QpPCWP82vb8drp12ad9OR6Wm8dDWxu+h06FzYejp/+CtQ0Jr
+Q6bQDCA070+x8b/4KE7FGQnVXELz/m97JLQcPW+FU/+bY1P5LbRWeSH0amhSBHLTu97Wrlv/Fvlr
+TNs07zB8I3pGKN//Pz+0nV5VsSR0BDn/hublIOfPKUXo1UgYehU9m/b4f4KB/71PyKO8oPwWejW0
+NgoW9c5QUcgZckZGUqg4VKw+E/Ko/RfaHBoVujN0d2hUaDT9fdStRh4QKg6dCxWHXg0d+b9re2Vj
+aJKyMZQXGtWkpRs+oeLwFYZro1KjPWqtwn/zImmjPmkUr9IA5UhorXIkNONPR3QfddyH8WBrQ+4a
+7egTmhEaQv806JCm7wh5IvgQ6X+tpP8Xc6LyWyhP+S3Ehub9Ce0fFa7LucZ6Nf4WGhXqo/3d/FtT
+TA29+n9ErdYD6ExD/F/PHbfGpf/1/Cf9Aef+dz490TMSUrzM+799x/99S6n0CAg5G7//39TjFvR4
+0v9yPcK0/Q906n9x1Cu/IU05/X8/Nv6/mGtv/QlZ/ldz+1/g4QCFcinK6f/hXFsUGqX+GhoVKtbo
+8B9pSJO3XvyPa8v+x/h18zsKw3//i++4CZ+hnA55YLsVtfyP5xsGgyBDBkEKUsAgFalgqYTLIR3p
+4JGBDAhohmYQkYlM6JCNbOjRAi1goFycES3REhLykAcT8pEPM1qhFSwoQAFktEZrWNEGbWBDW7SF
+nbaPA+3QDk60R3u4UIQiuNEBHcDhDtyBGPRBH8SiP/ojDoMwCPEYgqFIwF24C4kYhmFIwhRMgQfz
+MA8eLMIDYKhWWUAVqsBhBVZDwMN4GF48gkcg4VFsgInqmU14Aptgx9N4Gm5sxmb48DJeRhz2YB/i
+qOY5DodwCEk4jMNIxhEcQRLewBtgUI1qMDiGY2BwHMfB4R28Aw4ncAIcTuIkOLyP98HjQ3wIHudw
+DgK+wlfQ4QZuwIgQQjAShjDwE5awSCF6oodEHMQBgbiICzJxEzfiSRyJg0ziSTziiZd4YSXJJBlW
+kkbSkEpySS7spB1pBzfpRDqBY+Yx85AmVoqVIOIycRnSxJXiSqSLq8XVyBD/Im6DR3pEegQG6VHp
+UcSCwQOwwAJC8YCBFVawSEQSOIoTPMUGgfa9SPteR3taT/vVQPvVSPtVov1qov1qpv1qof0qoxjF
+sKIbusGG7ugOO+1jB3qhF5zoi75w0Z620552owQliMFgDIYDpShFLIZgCOIwFEMRT/s+gfZ9Iu37
+RNr3PO37JNr3Au1pPe1pPe1pifa0jC3YAg+exbOwYiu2w0NXGrx4Ds/Bhp3YCR+ex/OwYxdegA97
+sAfJeAWvwI692Idkihl+/B1/RwoO4ABi8ToOIoXiSgLFkgSKJal4E28ijeJKKo7iKNIoxqTiLbyF
+NIo3At7G27BR7BEp9ogUewScwinYcBZnkYaP8THS8Ck+RRo+x+dIwxf4Anacx3mIFKtEfINvIKIG
+NRCJmZghEguxII3IRIZIrMSKNGIjNojETuxIo3imJ07iRDrFMzeJJbHIoHjmpnhmID7iQwzFNgPx
+Ez9iSApJQTOSSlLhoPhnJOkkHQ6SRbKQQbJJNjJIDslBBsVLibQkLRFL8kk+MkgBKUAGaU1aI5O0
+IW2QRdqStmhOCkkhsigGy+Qv5C9Io6svInmBvIA08iJ5ESJ5ibyENHKOnEMz8gX5Ag5yhVyBSH4m
+PyONXCVX0Zz8k/wTWYJBMCBTMApGZAmSICFTMAkmZAlmwYxMwSJYkCXMFeaiOR0b2eJycTlaiFVi
+FXLoOMkVV4mr0JKOlhw6WhKlr6Vv4ADBBMhIQSrSkI4MNEMmstECOWiJPOSjFQrQGm3QFoVoh/Yo
+QgfcgT7oj0GUct2FYZiCeViExViCpXgAy1GFFViJVViNh/EIHsVjWIf12ICNeAJP4ilswtPYjJex
+B69gL17FPuzHIRzGEbyBahzDcbyDEziJ9/EhzuEr3ECIMIQleuIgLuImcUSlGckkjeSSdqQTM0+s
+FJeJK8XVas2kR6RHI7ahpIjsamIbmkttQ0dT29CKBntPIWKFCQ66BgtRL7UQ9dIUPNDwe+QJ7bsA
+XUM+0anKYaO2o4u6qjafD1ML0kW3DejnxcPUjnTR7Z17efEwtSbdSK1JF1Fr0kXUmnQjtSbdSK1J
+d1Fr0o3UmnQXtSbdSK1JN1Jr0kXUmnTRLeoRXSI+/J2A3FR+8aaU6pP6UaMm34+2Y0ZNvxsdx4yZ
+Uo7isZMn3IMekyfcMwr9aFg6edqYyRhBw7HT1fQTARCINDRE2eg25qx+Y6MsWhkaCjAiBl5kIBdt
+EUB39EMpyjA+XJ9SLTXzXfheq+XEXNHurC18b67lzfYI3+9H2AogfP9Mu3M6MHCgjP5x6voQXRVm
+IKEXegP4GT+DYTKZTLDMSGYkOLq+xjPfMz8ijh3BjkASe4Q9Ag/7JvsmvHRlzce/yr+KdGGQMAgZ
+YFBKV4ZZOh+wVPJgae6EzgosFmEDWGzEM2ApVWUpPWUpJWUpDWUp9WQp9WQp9WTp3MvSuZfFGZwB
+i4/wEVh8gk/A4jN8BpbOySyloSyloSyloSyloSwu4nuwqEUtWFzGZbC4gitgaZ0JfsEvYHEVV8Hi
+Gq6BxW/4DSxuEB4sEYkIls7nLJGIBJZSZJbSYpZSYZbSX5bO8yyJITFg6TzPkgSSAJYkkSSwlAqz
+lP6ylPKylOayJINkgCWZJBMsaU6agyUtSAuwlOayJI/kgSWtSCuwlNqylM6ypB1pD5Z0IB3AUk6B
+JZ1JZ7CkO+kOlvQn/cGSgWQgWFJCSsCSNWQNWPIQeQQseYw8BpZsJVvBUurMUrrMks/IZ2ApXWYp
+RWYpLWYpThCKE5oVPENxgqE4QShOEIoTmm07Q3GCUJwgIGH8J2BAAOgphICl39RLBwJB31lNwz6p
+xqW5apxIlIsl0IXtv11SDcWsvtI3YNBX+goM7qA5q2ECTclAgFm6QNP1kr4Gg14NKZLAwEApP6da
+2mMwjX0bjjEoIZ3pG3nIcCAGCfCCmJ4GMb0IYnoJxLQbhFrk8+iBD3Ga7i4gyIPYkD4NmWjR5CnG
+tNy0GcT0TNTzYvh5iQRpHgziYDDNNs0yzTEFTfNNlaaZpgWmuaaFIDAwLzAvMi8xu5m/MXuYV5h9
+zGvMATBIgBG/kt9NixifabFpKfmdfnvAtAwERjrmfmaMTCYzkh3BHmHf5F8VBtGxzpqeM+00PU97
+YAwWSv0BEG4ZcwA8wOfzjwMkSbuzH2McYwMYQcfyAs8w3LcQQv3x+w0DBy8A9Bt321h44Q2FBIfi
+IBvFxeRCGfD0lx9D+/AAWADNAXCAoIAAgGVcuP9V2mWgcE74ECDJBBCRDGA+8x5zmjnP/IP5F9uJ
+7cr2ZUvZUexMtpJdwa5in2G/5kZyd3NTuJncau4Jbjv3Kvd3rpo7wf3OO/lEvoQfyS/jV5g7yUfl
+usRJibMSKxOXJh5KfDvphsfpSfTc5untGewZ7pnn2eOp9pz2fO75yXPVo3jTvO28Xbx3ePt6B3vH
+eu/1Puh90rvN+7qP9el9Fp/Ll+XL8xX7+viW+qqSkSwlO5O9ydnJHZK7JndPHpR8d/Ky5JeSj/oZ
+v9Fv9cf6M/zz/C/6T6X405BWlHZ72j1p09KfST+W/lX6P5pVNvsh05yZkzkpK6752ObTm89sXpmd
+2iKmRWqLFttu3zZ42+PbXds92ztv77t9wvaHtu/cHrqace3otTM3BMUSqg+FoH682My8r+52YGpZ
+hNuojC1nZ7NL2FXsg+yz7BVuNDeRK+dWcWu5TdxObj93mHuLO8XLfBzfkh/Cj+KrzJ3kzfL7iUic
+mTgncWniE4nVSfDAE+Pxerp7+nmGeEZ6Fnj2eo55PvJ84fnFc80Lb6a3yFvs7em90zvSO9F7v3et
+d5t3F20js8/lS/bl+Tr7evr6+ap8a5OZZEuyO9mf3Da5S3L35H7JZcmLklclV/sZv84v+2P8Xv88
+/xNqG6UirW1ap7SytAnpz6QfTf8q/bv0K81+yJQyczInZqF5WfN7ms9oHsxGi5gWnhYtthVv67dt
+w3bd9vjtadu7bR+/fc72ndt/u4prR699cAMKQr+rbRS6EHojdDh0MPQ6XS3bGXo6tD60NrQotCA0
+NzQnNDsUDE0KTQjdHRoT6hvqHeoZuiN0e6grEOoQSg/5Q3LIHDKFpGulv/726+VLP1z66tL5S59e
+qr50+NKKSw9cWnJp0aUFl+YDl2ZfmnVp5qWKS/dfmn7p3kvll6ZdmgxcuufSOODSXZdKLg28NOBS
+D+Divy7+cvHyxe+Aix9erAYu/v3ivosvAxdfuLjr4s6Lmy8+eXHDxYeAixMu3n3xzovOi/JF6aIA
+fLv92xXfTvzWXdO+hqthLnx/4R/f7PvmsW/mfjPjm2nfcF/XfF399YqvrgFfvvjlC1/u+rL5+XbA
++cw/KgfuZgDAFdMAING/Etd/X81AGPwnn69uCT1Dw2it4PmG2G8Ie4iIvkc+RLrpu+6/WY5fouJX
+AFwnIGYik+akFZlI5tJdPTvIXoDhGAPjYloBzHaA2cE8x+wEAOZ57WFmF6fj9ADAGTij8BEgfAwI
+n4jbxR3ic1oacae4V3xV3Bf+9pr4M73/Aoi/0thV8Z/0fk38l0jrK/4uauMaOugIvTM6VkffozPo
+/qbbo3sF0O3Vvarbp3sN0O3X/V13Sfcd/f0fuu/1Xr0P9KNPpqFfsko2QLJLDmkAAEgDpUFSiTRY
+GiINle6ShknDpREUPlIqk0ZJo6Ux0ljpbmmcdI80XpogTZQmSZOlKdJUaZpULt0r3SdNl+6XZkgV
+0kxplhSUZktzpLnSPGm+VCktkBZKi6TF0hJpqfSAtExaLlVJK6SV0ipptfSgtEZaKz0kPazKKNJj
+0jr6zvXSBnp/XNooPSE9KT0lbQL9SE9Lm6VnpC3Ss9JW6S/StjB0u7RDom0r7ZSel16QXpReknZL
+f5Velv4m7ZFekfZKr0r7pNek/dLfpQPS69JB6ZB0WDoivSG9KVVLR6Vj0lvScelt6R3pXemE9J50
+UjolvS99IH0onZbOSGelj6SPpU+kT6XPpM8B6ZxEsVH6UvpKlRilC1KN9K10UbokfSf9Q/pe+kGq
+lX6ULks/SVekn6VfpF+lq9I/pWvSv6TfpN+l69INSLRfxkP7vAzAC2B+eEbcDDDvARgNMO8DzGmA
+WQswHwHMeYB5FGC+Aph/AMxTAFMbvlR8vAwwV1S8BJhfw9fzAPOv8LVLewXbCWBeANiu2sX8CLB9
+tUvNgy3VLuYngC0D2FFavmw5wM4EmF8AdjbAVgLMdYBdArArwnmvCl8iwD4IsM8ArA5gnwXYrwE2
+AWCvANxIgL0L4EYD3N0A+y7ATQS4KYC6q5YrB7iZAHsa4FYB3GqA/Rzg1gLcEwD7BcBtArjtAPsP
+gNsJcK8C7K8Atx/g/g5wBOAOA1w1wLEA9xbAHQc4HcCp7zuhjlOAU9/3O8BJAC8DvBPg2gB8HMAn
+AlwHgG8J8CUA1xnghwD8SIC7B+BHAfwygJsK8FUAvwLgHgPMnbSLWw/ImwH5KMBtAOT3NS8Q3EEg
+EUDiJID7AUicCSTOAvjBQOIcILFSe0/iUu3iRwCJTwCJh7R3J1YDiW8D/BIgCUDSDe3dHgAeJyA8
+AHhiAE8iIDwJeLyA5zZA2AJ4ugOe3oBwHPD0AzyDAeEk4BmiXSrd8tylXSr98gzXLuETwDMS8MwD
+hE8BzwLAswcQfgM8ewFPNSBmA55jgOc0IBYBHjWvzwGxB+D5AvD8BIjqO38BPFcBcQzguQZ4FEAc
+pw0FbxogTgO8mYC3HSCuA7xFgLcjIG4HvF20S9wJeIsB7x2A+Dzg7Ql4+wLiXwHvnYB3ACDuBbyD
+tUt8DfCOBLxjAXE/4J0IeO8FxMOA937A+yAgHge8awHvk4B4AfBu0y7xCuDdpV0qxfa+pF0q3fb+
+VbtU+u3dA3hfUak44N0HePerlBzwvq5d4u+Aj9Uu8Trg02uXeAPwmQGfBRDrAJ9Lu0QF8CVrlxgC
+fGmALx3QAfBlAr4sQMcAvjzt0nGArzPgKwZ0PODrqV3qbOHro106A+DrB/iWAjoj4KvSLp363Fog
+GYCuC5DMAMkcoPsbkCwAyXp1lgGSjUCypM4zQLIFSHYCugNAshtI9gK6N4FkP5CcDejeA5LbAskd
+AN0nQLKab1dAdx5I7q5duq+A5H5A8iBA9zWQXAYkjwJ0l4DksUDy3eo8BiQvApKXAbofgORVQPJL
+gO5nILkaSD4K6J2An9EuvRvw6wC/EdDHAH4Z8FsBfQLgV7/HAvokwO8F/D5Ar95TtUudJf0Z2qX3
+A/552qVPAfxPAP4XAb2a9pR26QuBFL926dsBqeHtDfr2QFpbIK0IMBQBaZ2AtNsBQ2cgrQxIuwcw
+9ADSJgBp0wBDfyD9Ge0yDAHSjwLpxzSPRelfaZdhGJD+HZD+D8AwHEi/AjSrBAwjgWY/aJfxJyBT
+AjLNgPEKkJmjXcZfgcyJQOYkwHgVyAKQFQcYrwHNy4DmowDJCjQfAzQfq/IHQPN7gObTAckJNJ8B
+NJ8JSMlA8yDQvBKQUoFsANmpgNQcaBGjXar82MKjXSqP0SIZaKGmGQi0aKFdUimwrRjYdjsgDQG2
+9QO23QlIw4FtA4Ftg1UOBNi2Adj2OCCNAbbrgO0uQLob2B4PbFfzvx/YngZs7wxIQWB7N2C7mudj
+wPYewPaeKlcBbO8DbO+rchbA9vHA9gmA9ASwfQ6wfS4gPQVsXwBsX6byGsD2lcD21SqHAWxfC2x/
+SOUxgO3hS9oFbP8N2B4CpBeAqwCupgHSK8DVDO2SzgHXjmqX9AVw7QPtUrmIa2e0S/oSuAHghgBI
+XwEKAMUCSJeA0O9AqB6Q/gVyBiBn8gGpDuSjHSAffQ2YckE+fgfk43cB00SQj0+CfPwxYJoMgTEA
+ALmJn2/QNKqf/4pf155kwYGHQHWjehhghAQTzLBAhhU22OGAEy64EYNYxCEeCXRlyQMvfEiG/yZ9
+ehaah3Xquf9Gq94RnRBAZ3RBVxTjNnTD7eiOO9ADPakeow/6oh/uRH8MwEAMQgkGo7RBBz8cIzAS
+ZRgF4AEsQxVW4hFswCY8iy3Yim34C7bjOTyPnXQN6CW8iN34K/6GV7CHrgG9igP4u7rqI67BfRiL
+uzFBfAiz8AzKMUkMoQITdZewHBt172C6ymfjHszU/U13UHeALdSdxWTMZZOxA/uxEGMwVXeMDNR9
+pdurrg6Ij2E0FmEp1qs2LuIicbG4UlwlLhOX4zUDjyOknfi8+DPV3n8tfSOuRVCsEleLT2IJVmEx
+VmMF1mAtHsKDdC0BeJSuIzyBX5nlzEbcy6xj1jMbMJt5gnmcqQIDhsxRQzAowzUiYyKCABiUgCHD
+yGgEsYyMxhqUkTlkA5mDPhiGDliKX7CMLKTPe8hCspDsgvo5CAYHtRjxkF0oATAQ92IhNtB3vMUN
+48Zxc7hxZDRZSVaCwRfqb1w2l801A7AQwBwymoxW46qWkjTDOBSjGbYAuIaFCGIjMfDvoQRHSSrG
+4yDpg4NkKxGIDgw5S8zMOCIBmM4BQKJaP/4sJmIGgsTAn6V/VzARYxHkzwoO5hqZQ7ojSASuDN8i
+iHtxLzGQfDZf9RZJ+iCIKrVWZCcYVuVCy7gyLp3+FWM0JmIbggjiSWY0k8+lq6lo2Tvgc+yl5R6H
+hwD0x04agq3DabaAjKMrnEAsfwQlYg/iE8wkR6zEQEBIxEJmHDxsMaYDDuwVeI5lCJp75d1M6h1j
+dwfuLPUeH+LLbn7TV68senej325T0LsvFOpXysXzQ3bzCbvZVN1uLtX/9Z/9+HV28579Sr27P7it
+OJzrbWXF2c17DijdzaSq34qH+HzZzW8rzgYABjow1B8ng3HKOm4cvxUsRMQFJO4UhFNEx09hOORU
+n6ltCflM7ZnaXLvVZ031WX3jONRNZ+PrvlXWiebffrlPaAaGcKELTCV/FkZY0TrgtLD36cul6TaT
+WZBg5mQIDGzymdqrtbZCq62wJeSaq3UncgP6MmuZXGYus3BkeGqr1vl5LqdD8Cen2X2s019AuGl9
++pSX9+kzjSEzZ67cosb7lpfzi+r2Hjyo1eJNdic7nj8bLruRJxym6zgBjE4+c+7MiZaQrxadyMsl
+Vr/VZ/Vbye4fmb/8yJ+tP8tkqRfNYyUgNOMPgoUHAwP5iXoebruFiTdC5GJNjNPqSUqIj3O7nA7C
+GGPsVtlskvQ6Ue1QmFVfhF75zIlqq7vQ6i5siZyi6ry8M2ogn9SuXOJjfUbis+ezPqePXn47vQp8
+9OKg/NaN6LLKiG7UF6OIU9l7G0lQdpd9Uab8VvZlmfIP0r2b8i3pX8aOVy6z45VKslCpPKtc/lhZ
+SCrV62NiO0sWAiz2Kme5S4IbKchCHsYH2ouxWXmcg8zkHTNj0Jyv8HpSY2aY7bIp2VOez2cIToPd
+mKNLaO6JYS0GdUbJ0KX6TKxgEPLlq9XuvNoztbLiLjpz+erlcMeplauuzZOPXc47JtfINVZ3YW4b
+a34SaU+sLUhBq9ZtrPnWJOK2+luQdCun9qho9VtbEGImTofLbe9IClqlpb85y/rUWvPvn/zzXLD8
+vVFB+fG5xPRZ/ffBqd+QfoPGDBoTvHv8qMntif+BJeSxNgef3XNKICblVyErXbly/0JGWdX+rT2v
+vScovxAz05yrMg7s0K0kod5sHNyjR5kHAHi0DF0Qx/NXYIQb8UhHPgYHmsfFx8THxMbFZmbMSxDm
+2TBPajEvITmuPEaa0ypLl9pSF+vR6yweVhfvbCWfqa6tPlMrV1/+6DKt+dWi6lr58keX5cu5PQeU
+ijL/tnoNSSbJgtPhys9rrVYsgsMFrVr78omfRH13R6Uj+wNt2wY6t23b+dC77x46+O67XJ9ddUN3
+ce0PvfOO+vVg57aF6s/8ypXbtq9atX3byrs/OHr0ww+PHv3g91/Z7A+qj54+fbT6g7GRXwGAQf/Q
+BdHBX0ELtMWNwN0cy/CMvXl2c2LPzm6e15LJz7V7fd42bZkcu8/nzcxp7mOZ1rkpRq8zI6UirTXv
+jJ3vsM7JruDL05Cb701w+VJTHOnNYMzPSXA1T2FcjhZo5rI5EszmFGuzfNmsMxXKV6trz2jtcviw
+1VZIh7iVBrZCLd4SOcdqLl87plTLJ+XLebZCNVGhu1BrRvOP7kI1tKo3/sdwdEjyy7pcguGBXGOO
+PtfSxtg2PicuN7ZlUpv4tnp9joGCYnJic+NaJrWJa9vX2dc1LrciZ0aueTgZ3oykm4nfCxUdVaRM
+yc9zUYz0q13gdrlJR9LGX+Bz+s1EdOenariZn9dadCi+yrG/rFHOKC+88Fp+PrH/3uPo3IW2+yfd
+ff/fHAZyoqhYv5dc3W29q8fJnfXF9e+2aXt2a59HFvfrR/DVs5cGdJ1duHVvjFs5Pve+sv7D9jr0
+SlLxy/fOmvXwN4M/nsz06rp+wHOftQQAotIuspvSLuteos50DCdrJOsEJVhkd5hSgeCIcoQdIThg
+QmLAbKqAWCEsMAsw2FmzfKauOq8l5DqVSJMUWGWkOgXGKtvc7AjlJWUP6U4GbVq5cpNyZB75kAwl
+Q8mJJbPKlGXKdmWHsqwMAMF45QgrRPInav66BWajXQRnls9U59VG8rcXtLZZZSa9gFPfM17Nlgwi
+dyh/U47MKiNB1U6XBMtmLVFaKduUbUoLgCCbKeQY/gqsGBzIkU1GcJJe5BiOtUq8jjUQ8xybTjKw
+esHKEpZnYOFlzmEWjHqTaJNVSlqdV0dDlcTaVBorX6Xg6jybu9DmLswlJiKm2v12Pj2btOHZfDaV
+Y5QNZPxoZV/8q/HKvtFkgrJ+NOke/2o86c4d2LHziZ+UOWTpT0/s3LHpF7JcmfULQPApGP5znoMR
+yQELL7LQk4USw0FnECS1HOeKqul8Un2mqDqXWH1OdUbxFfis7HuMuf6Xi/W/MGaeC0cuMmaAIF55
+kWFIJ+jhC1gEloOeW2zQgydgDSp5oSS17ly1tbAw192RdCA+p4MR48ePJomvHT468P0VJbovlCNT
+ABBMI5uYC8wusJBfYcARcPI5FV3OnMi1+6y+acz99auYXQqty7bQBcZPytQdXXtMAgNJxZKeuy13
+lu4xMNBxRUVyUdGQlthDJ2r5aq32G09Yo6j9ltsmaj7eRifevn3KI3MwQIBQF6aW4m9MwMBgOsew
+BGBzquXDtsIwGucTP2M+X//zF/zZ36eo3GnoAt8yTJOTAzbMkIQZtjlSeQzrdurdFmeMfLWWshw1
+V2vly7mpea2tcpo/WbDKLrdXRTkfDZm3Zw0ZMku9lLPKG6QjySKZpKPyRuln5FWy5/xnSnfljs9m
+kDVkJplJ1irlSpVSpdwLgusAd5S/AgPaBeK5JbzAs0sEgc/kWEFHKoQ50IsxHBijfKa6uq66JXJq
+avOshWE6xf2oXkOS1T7nC1ReyEliSYzyNplJ2pPYILdv32PfXK8KgqAK4D7lryAepYEsye6wG4je
+6HDYM+2SEWYJsTMYfo60VFa/utw6k81ihYEICfJVdcY5U2stLFSJZu3V6rpqudpaaC2MzDgakVQn
+Hb+1I8n3cur8SnwF+VZfQZsUW0ErtbmIedbsn2aTRNJp929KGfNm1Y3dX5D4A2uqKh7kxpIuqan/
+eP/RH55Rftqt9Lj6+JvE9uoLr6wBQLAmdIE381eQiUGBbL0n0e5OrPC4Z9gszdJmgJ1jKfdkeRP9
+Sfo4oZkBSX63EOeWHAbYnFnaLHCmrlq+HKb8LSFX19AKHLssX5arrbbCXHtDkZOI08H5ktPSVdZB
+mxKzSIEW8ScLon/3kt8qiJn4tm1+87DyinKs4kZV0Pjkig3bXni6S6tHHl25qGKFOcjv9PuP7Fm2
+I9Fz4q8ffJmRQbo/+NhzT2zZPWv5ssplC+cAYDAQ4DvwV2CGFb0CqdYlgijIS0RRyJRFI1dh0ZuY
+CnEOrDyxsDEGSDreaaMUprquqJrOXTV1RWeKavNsUXjA/zgkuRmhrU58lBbkEyvh4+veOV//EHMv
+mUUQwvn6HUrXIJGns1fr4hYqV8hDzNn6LIBgR+gCd40/Cw9uD6QlymycIDviZgiOGRZJIEsxXSr3
+JloZQxLcbr3VkBRrEbzy1ZraM7Vq84b556h2tRaqBIniA5wONG1W2ppWbnHd1122DCUOElAOK1/N
+/nnxLGH1ikceXLm618z8sfxZ5bqyL7+V8o8rV5UrGZmk2yNrj77z1tFOXZgLAIOFoQu8n7+CWKSi
+dSAJ3hls3Ay9XcWMOfryNIfBFpfoFuDyuyVnmny1tlbl9Wkx1f6//NHlwlzSkeTn0dJpA7lxQrZH
+iul0uMhvnZ8Z+S3hlc+Un5WTylEygpQOPVkRjF059eWnz99TWnrPPYMHszNatVKufv8v5VdyLxlH
+1pOnk5KU38ZNuHrjg5c++eQl9QLBFoC7xJ+FDm0CcSwIGEKQybNLxemEZQgLzgZB0KuIq3HuV+uq
+m/J1euInPqff6uMu1W94lymt91Rxt3Ef32jG7X+HfAuwmBhuFzf8yEHXQKqE9Bm+7BlC/AzbHKE8
+FylOKTPJbYlxO+NT3Gym2xKvd+ZqXalSuEZxiDJQhYW5RBsY3pQG1sVHBwwbaaH8vNb2qDi77+XX
+lLeVz5XPleP7//rUkySBWIiZJD51sdew4T17Dh/eM334fdOHDb/v3hFsfOKJv5w8d+7kX04kpjy3
+6ODx4wcXPUfSN82cuempGTPqz766YsWrr61eDTCYFrrAy5ROpyIQ8EsWweMXZlj8M5LiLZjhnBNf
+nuY1JEkuvTuGdSfF2ZxpWp1q5ctabaIHfTR7bDcTfzIKWkWwoBFTmf33DB58zz2lpbO6PDOiRrlB
+UoiF5JEiZYuyQ8OAabs383MiHVz3bUEBMf7wG5GUB5UnlAnKqKQkohs34Z8AGBwIXWDasLV0nvEG
+ZP19rOU+Zzn7QIxNYCTEyOpwbgmZ9kGuPWqms0bFD6ji57S+fadGxFA2u2/5vX36TJ1a/2bDPMig
+QNkbfpcDLQJuiyzp72Mlh7yYfcDpkMAIViJZnPKZ6jqVrqtvrTunUckmb2bp9O9gxIbXLpswmiTu
+P9Tw1rq7B5xaUfq1ckDjBz5FF/5z7k0IKs/GcwwBL7AiYcCJ8pkTeYWUNXAX5uoZJykg/Od12ezp
+6wrPsKeVF5XdP5H3vyGnAAafkmz+c/ZjKkMnBszMMizjlgkLdTzhoJPPFJ1QW+pqbV1trl2lcz6n
+71OeUTO6rjDyBWbnhZvLIvAsA55wosBwENUcGspiLyBqYWgG7Gn29BWl5TdK7k+kHxgsDV3gBlKc
+S0ZhICnG6WLv0/uSqiSVxPidCe54tffc8CXYJL/KPJ1RpTH5cgOZUb746GRuatMmzc/jnA5OdKqT
+ZYQWkpIIO/PB3K8m1BGzcuOfK1ctmj1z6kx9sEHLMCQ5+Yv33vmH7T2SlbVv49pHliwCg2XKk+I0
+fjMk9MHEQNtWfVuSBK6gpYxYE5vAxXH3uwwrEu7vLa9quaKta1XqirbFfVsVeHrHmpAYLyba4z09
+7Fk9OvaVz1Wfq5bPH1WLn5dXqE2WZ6rramWlvujyZflytcroavMoHUEFUSPeaSaaOKnKNAX5WsXS
+KSUVBcbpsLldXH5e6zZugfMnp6SnMQWtbG1ap+TncW6XzSnD5+d+m3v3mGBwzN1zm68Zeuizzw4N
+XdP88zVPTr132G+rFn0z4sHHRw2ZRNzPzq65p3CB8sPaN5QDlfOXLJm/gNz+3sskZfrSJUq1UuMh
+v/Va+NRLjz66+4kHuve6cvr0T71un1cfl/797jXfl/UZ2O22icq+o08oP949c3Zx1xEDB86fP5f0
+PHyY9Jg3f+6o0dOUmheVWuUEmNAVQOjFn4UFNqwK3GnzyDaO56wenjN6eE6nZwwenufkJIZlbElW
+G8syFjNjSmIZSQVl2lhjhWzS8yypYKeLsFH9jNGgU/VtJrsAu3zmRFgzU1PrzjtTG5nG+R9F/kd3
+nijr6HyuXu48kUJV2JBkf0G+uund6SvIZ31svpP1sfJsJu8nJv/B+tsenlN/at7LHDl1McgPu741
+GCQtlVNBxs+0B1iUAUJ//ixsiEcK+gcyJHuF/8E4toKfnkAqEOewS17B5k/k7G6/3ptq4ZCRYPcz
+yany1dq8mjzKArpVIVpjA/Ou1ubV1Rbm7vWk9UsrT2PJ8PyCfKu/wBfNS7ERLsoaiZQdOsTOIFZi
+e33/11eUvypPKIt3bXvmr4vXHNq3//U1Qe5CcN9rS590OI9tPf8hO27ewkUVNxI3PL15EwAQlIQu
+8Nf4K4hDp0As1rrns6Y11vmSfg5bHm+2SXFwpAqmeJVhP3EmMtfXykptbsCUmzAtYQYzJ3ZO3NMJ
+AhlOBC4y6XPhSV+VT1Uk5a+1V04qivKAcgd5lcxeSrzPVKaffO6zM2fOPfZ6Ftl56SvyDLmbjCPP
+BDorDx34u3JI+ZvyN+Xwth0A1XZD2EjneCfaBmIlscI83YAKvUicFnCpNpM+A35jsku+WluTp7HV
+DQ2au+cl9yE3Q4an5uepQm0WIeFGpUVlNq/8/tNPflhZf/jQIUYhD5OdyiBlWpA0J7eT20jW9a1q
++ykLlPXKY0olQNSy8Ko878bgQAvTo0bnI3ZjheVRq/iI/mG2wvogP93+MKmALNnMzXQ2wWFzcciw
+GfxMckxjr19V56Wc2tqrtbV1tXLNZblWrsnd0y92WizT0On08hXkN9zLDh3iO66oX0WWVVUpQWZZ
+FS3a5o8V38H6uoP1Wz9WNofL9wh/VrXUCTQzPWp8uGnZoouWaTP4BQ4ZjNpyNXnVdUU18uWrl9Wi
+Xc67dvmyUivXKDW5eyxuj/tPypXvVEuVX1W/gcypqlKW/tC0TI8GIzgm5PNXkITigE+ymiosVv18
+B9aw8xPj5jjKPeYMCbZEyS4LqW6ThwqHZ2rlmgZsu/zRR5ethbl7ZG8/L0OGk2jUosUQOH8j5vEL
+sz889N2n758buLb9HiZuh/LXl5QFFPEWbCatn+Jw7K/KUeUFZbfyZloa2Tir/s0+vcgmMp5MIJtU
+9APC/Szm82cRjxGBbP3D7gctRpFlK0z89FinlSUVdjikbEOckK2zmTNcBr/MISNG52eSEyLdnFeT
+J9N/lShdduddUwPlcm7A6Ensl7gg8enElxL5hmZ1Ut01vVNaZA03LovDTH81PFyPQ/UvHlYbOKgY
+yLVg8EZiMMiMrX8yGC4vW0Z184UBh6iSID2pgEHI0PlpF0tqF9fV5DWMi4DFYso1BUz9TGWmctMC
+kxhdlMirtdfdSKT9GJqolLDqCo0DCwOJOouerOPnw2bSVxin2x5zMjZIGWa/IVPXwilframrofRC
+xXX5mFKjytcBUw5ySI4j1xVw9XOpJKPnbvPAnrstA+/quVtWA+vAu0pfhzN0GAROOBFoa23TdkjA
+OhIjyUhmJDuEG+kod6116YazBWHRJ1xih+BLTlu3SOjda1TvT9n4Q/9U/jlgh7LxhxGlj73HZwWD
+19/79avkqH6NIe5AR8nIGJIsskWfJFucHtkqOzxWq5zplPUsMRvU/pb46S6r2tuyyWjgbG4hw0kb
+1MYkx8pXq2vy6opq8sITT21e3tWiWrf6tWHuUcVILjzrDEl+WdSrus6NegNv5CWDw22UjW5Jltwm
+2eQ2y2aHJUZ2Wp02p93taG5IM6ZJmaZMczNLMzndWih3N9xmDBhvkwJSV1NXc1dLd7mzNWAL2AOO
+wYYBxgHSANMA8wDLUDloqDRWSssML1lfsr1kf8lxwHrAdsD+rvVd27v2DqLe4HTo3XKc06dPkwv0
+reUu+tvlO/UlcoV+ud4yPBoLCL3p1cmRNGJjcDOT/Vb9d8ynjyhC/T0ahtR/yfiCQSW/7vsgc7Z+
+a7BhDPHT+bMwoUMgzqjXsFKljkKGzmCTOGSoA8YcJtvqgM+5fPna5Ty5OjegzzXkGnOlXBPXZIho
+dFAtRq9lGm5m1yorVRoD6FTcjMHRQLE9SdSJtiSdTnR6BFFweFQ9gNMmsnYDW6Hjp7tkSR3CZjjs
+gsi5dZYMqzvDadJnWP1GDhlOrXdpqdQRLNc0rPpo4/gPXdygLXhZ5yBkeKCj0WlxJokxthhnhm2A
+s9yp4wWDTrBLDqfgsHscaUKamGZv5ygR+sWNc5TFzRBmihX2Ckd53IK4p+NcwxGps540UgTiV3uh
+5OBBZjzZSXYx9xw6VI+DSst6Jf+g2hZ1XdiDlChw02/8xFlvrA6CYCggKPxZ2DE/kG1RG0LwyLIl
+UxaJpGMrDPx0K6kwQ58h2jJkY4ZoM3HIkG1MsiPSAGr9KQmjNW9UlPGRKouiitQJAWc/53ih3LlM
+WOB82imKoktME9uIbeTxstCIViTSk2pdhh46RE6zSw8yhkPK9boptBY3vmR3BIPXt3Jt6rpQPGLo
+/LE5rE/MCdgwXxLma/rEVKc+1WK6SZ8Y0E+LrYw9FHsqVl1wpLO+Nl9EKxfZOYf27z908O9/P6gs
+UwaSF8gsEiS7lAHDSBfSk/QiXZS/K68q+5S/B8mTZCKZSJ5SxiqPKeuUsYjwJPwH/FnIiEWPQLK1
+QopR0VtVq0uCM8ZmMTlz9JZMq8mvp/Q3rnGKpQsmhYXhaTbvo8u5e/vFl8cviG/k+Kg841enADaa
+zev/O0lTPv1Nufzzz+WLnti4uFxtsB/fOv6TYgkyF154aO3zWnspa2h7OeDFbYFEyTkfFmv8fGGO
+FeU+WQ8pyWKLSdUnpbImH206Wh5N41R9tVZdvSlUWzG5MvlQ8qlkjgznUxpbjlcXAZ2q4BXVtOwM
+5QHlroZmfFV5v2BDMD/xoNIr3MyJAtlAxje04xvK/TmFede2kmY3t3eY33uZzr0peDTQ3WjQs5LO
+w3Ks6OE4NlPHIYHlEiqM6/32Cud0q4mrsAiil+PZWB1crNfoT2TNksDHmjMkb0qsQ85IkPy2Rq5b
+rqFLWHQpl65hFWrorXaGclld2rI1QXFtmQoWQobvMYoWCzOcJBG3Pd/pY1uQLBIenRE+PTXSYVyn
+IbOzP3tccV6+bVRJB3X6PsQOJByJf7t+3eJV69evWsx8aho2WCkLkjdX9a7/Iqh25geHn3zFt2H1
+6nUAwbLQBb4XfwXNiBjo5E9mUnweyZjkYRK9HqNkzDRKXh/nIhv4+Uh3bYib77TNSS/P9BolX6LO
+bVPnY53d2TIl0Q5LbibV3NZQUVrFv8LCBu7qmFJrbVykUzXPkZU5ZKm0bLmQJHjE5AxDhjFDyjBl
+mDM8Gd4MX0Zyhr+doZ2xndTO1M7cztPO287XLrmdv9RQaiyVSk2l5lJPqbfUV5pc6p9kmGScJE0y
+TTJP8kzyTvJNSp7k32nYadwp7TTtNO/07PTu9O1M3ul/3fC68XXpddPr5tc9r3tf972e/Lq/U25W
+v6zNWbuzuOEY3qZR4ZlDNL1Zg7ChCs6esGItOWVTZeInh57YMnXXV5+/TNKJYTGJ31CZWP3Ko+vH
+v/vV+39XjisfPKx81Z2M3rGt4r6hY7PatH1r3/l/NW+u7N245p5xw0e1atPq3JFvfsgDCNwA/yWV
+XacF4vU8MbIPi9NNpAKcTTDYdOYMyY8MgZHlM9WU7VG1FTmX865epsSS9NwtDVQZHY3JKX0dMuVv
+ZMgR/sYdsI60llnXcGv4NcIacYFugX6BYYHROJwyghHW0JrvJNOPKfdU8ceXKWPfCnKna8kMZWXt
+jezwfNstdEF4jz+LWPQJpEiCPRZ6l9XEsaiwMNNd5XFsBm/OkGxyho1k8H60iFNJ/Jmaupowr60y
+L/JJKj7lBvSd4p+Ofyn+UDxHhmuMgFVWFROxkS+UHBDuIItDL54++cJBRndI+UR5VvmL8hE/LBis
+dyr/+PJr4mR+CAbroexUviBpZBAAEroalpvNWBEoNJs0Ed+YZDCyLGP0SEauUSGQaWQlKvWLmM42
+SPx6CwRLg8SvMV0NQzYi7Osa2S6dXkXkONZIjDqDXjDaTXazLPtNfrNXDsj9ZONwDCdOP/FrddMT
+f4HQf179e5fr31vNHHiIPELWvcyfDV7fqlaszs9+ofHDlwG9g/LD3weGsUlGycgkEUaSjIyHZUxm
+E/GYzSarzFiSHHCYk0xmwHFz/cxGhoXdomf5CqskTmfhkC2Nqg0Ci9MmOBuVG1eLbuIwb66tRrQa
+Ier8bFJrX2xg4hmXOdnclelmHsCUmHUCo5NiEUucbLwuXu82xphlVxpJM6Wb/bLfehvpxnRjbzd3
+twRcA0mpabC5nyt2OIY3I3rii7STT6/FhA8URXlReVFRlJKHmOrNJJF0IQmkO/G/TE4+znW6cYS2
+3CTOfONrdlswjK8GQKAyFmkWGMcyDEkSRAFJ8RBFAR4Cp8sZG8fEe1wup9aKTtqKTqfD7LGYRZ1o
+lBiTR6cTM51mERyxWyRWV2E1TI9zqSgTw8bHOm1qcxpFjiEwu/UJVEFJmXX3H5j1WzXlrZBJVpuz
+lWCOg92ciVQzzzM8K5gl2WTVOezELthNSZZ4R3y8nJhGsixZcpa1ma2ZPcvhUNGs527jQJUi3NVz
+t0kNzJQkJFCSkICECElwtAXpYOwgtZfbW1vFtorrlNg3UTccw/WaqkrfiKkRFr0gn9mrvL2v/vI+
+UqVkVzEXtxIfSXqR0e1T1pDvV9e7tzGdlHbBINOe2REMKsPI1mCw/rv6gmAwjMv82xSX6wKdTRJj
+TJKtsiFJb7BaZYPHaFBnXx2jpxOwgViMOparMAnTbSq/YzXazQbRqmdgtlv9PBintrKqLVo1bA6p
+zfsvRyrpuVsfaR1KMKlo6Li1VNhPMBBB79K71KNVTbLJ6+oh9tDdJd6lGyINMY10TRWn6sbrp0nT
+TJWoJLPESnGWrlI3Sz9bqpRmmypN5fZyR7lrh7TDtNl1SDpkShuO4RqfHdW06vROyt5WiiuZE9tI
+P9LnMbKrUhn9dpD8zPwSDNa/xRQGg/Vd6j8P43QvgF9NZZ5gIFnvIQxhPDq9LtOo55iHhen6h6Fn
+WINNlFgbL0Hdd0H3HoX5wWtKtVzdlL0ON4lBbQjjwLvCjWOiTWKmTWKGOdwkezyWvhZmeH6BtqDl
+pCvGvdg59QVVvFy3hvmlajV36EsyfvWNzh8o2wAQAuUXto3g0FYFuAqBqcACHU9YGbqme07CcggB
+0+bs2fq3Bces30/OAv6wR4BucmEJwzTZI2AnfvIFYzlf/4u6R0B4hD73kPIL83bk3WwF4dV3QxYY
+TtdkPwoJ65uYt+uPnz0rOH47P0vIC7/7FLuD/5Y/C0F9N8sRTBdZwqtrI9qb8wpz9UTtTMK+x8Sc
+VXooPc4yHv5s/WnSXjnKZAMEUBI5KJ+DhZkeo8DkhHdcEKevgEMdlM8PHgTBau4ys00YBxbZrxBw
+hA1v5TlzIhdaL2mDmaPdwoELd4v6drKVMFuVlcI4ZTmZBQYDQxd4cMVIRAZWBVompcEju8x8GvFs
+Mac9o3M9ba+Kid9EtujKzazX7Odc+W6mIMnCyfDLbmNeM20lPi8vLE9oe6+UY0pNmMPTtmgV5gaa
+ezLbOTo5O7k6uTvFdIotjOubWZlpyHLmuHLclVKlqdJcaan0VHorfZXJxuFkuMbPRlYf2jespxTQ
+RX1S0LBCwY3foAjddk199qUb9ftnL5g4PebekSfuJYlEr2xaOmZi7779xzKr62uDywb2e+nZv73c
+aeGcwWO+TU4+U3/+7OS77x47GQAY5IQu8N0EBxzw4fZAis/JW2OStupitprJFnYBtpjLdZts8R2N
+RXamwGnlZLRNVvn4vJo8dek5p7ZG1aCqVaZcy8ud/IQM15SCdCXaKsOfnJbu9BWQyOKkWoG3NpDr
+2RvKLxBeuXGhfEO2IpDrIydOGTJkysSRQd4/q37VgDuVs8oN5YZy5s4By4Jk3P5tu7/99qVt+wGi
+9h23mCtGHPICbrirWJO1StJvUjXm+arGvKOQF60xr6PLVtW5L1sSCBlO6Ja1pmvjWoO24Ran/fCO
+UqMcJ62Ibdo3M8rl+fc9Om/2ikkLzaTX3r+RNGIkOpKRmal8umzx8X/9fGrBLG3vQ+gCP15wQIJP
+3ftAYE00cFvjDFsdSVu8C0xbHOVxmyyegkQZsrnAVCR2dOepjXi1pq6oqOaPjbi3k7/S/5KfJcOb
+rEjxEbxQC6/ttCvwsdPKJkwdMnTKhDIyer0iZj9e/o1ST9hvyh/P3kCuky8n7N/20rff7t62K7hs
+wJ2kOeGJQDLvHEDqwmOA288VQ0QC7g4kCywMMrbo5KdNVQ7dNrYqbpOjPFG2oqOuUC90cuclqj2v
+titt1dpaWcV2be0skFyWVJn0dNKpJO4QDgmHxEO6Q/pDhlM4JZwST+lO6U8ZJBW5aX0SCe8LN3l+
+g+rYC7agz4i7+jKf16fOXTH9Qce2rBAuKrXKp6QFMREdiWNKP3xu25u2mbqTr86+/5WdxE/Manco
+bwMEnQD2S8FBa5ImumAzbNHhaa4qIXaBLG1x6LabST5MWXqjKFsKYHdaOyequ8yqa+qKqmvCAsPl
+PG0A59k0scEwsPR1JCIAgkQkhg5rND5Xn2tghhMNcxIJ3ZUSlkDzw+uF7Jflup639etOjMo/36tv
+t37Tpm8+H7SlD2/o02v6wqpg3bvBIFsQXPrXV+12ij9KIT+eK4YHzXF/oEVzr5zgt5BmW+3YkqiT
+qkTOvzV2U+J2boe9PFufLyYVeBMsMorSOoquvGyVEF3VCJHaLXRFQ90Celm+XFgYliUSdIYYQ4ah
+Uwvqh4czyDpZ7zB4dV59ikFPaY62Syis0+1AotGswOckUWhYwH2gHFG+mPjZ9HstKxduXRaNcuR3
+RYzgI/tiXdlX//T5lAnjnnorGI18y4LKxjBa7ke47/gtggOJmBVoHucQTcT9jIHdwi+It5lZssWw
+SYa1hz2hu2ApsMumbiiIE2SuK9M5Sd0OkVdzNUpHdtmdV9c4kODxwENYMjzSmUm0M5OQFO7MvTme
+NZ7dHrZhcUUlWi5nuEO1Wlvznd3Wb2IQ2Drvb69uqF+68LZBk8YuolRq3siBp9+rPxsMMgXLJjz1
+vOLX7EG6AczHggNG1WNfIIbdqbdtcWyXpS1m9BRMBZJstxRYO7u0FYEzlIzm1dWeq1WXJtw57jL3
+GvduN0+GR29cIA1ajgPld/adNq3vneVk8Yb6aevXk9/5gXdOm3Zn32lTb8ygiAUwofFKIdOBliFJ
+pUmueHuSLLmfj2d36uOr7Hppi3m7fYcnJt+FnoLLIpsKpM4eddWgWhOf5dq62hMqDsk1GgeZu8fj
+zfEyTQtlV5uMxtIL3Jpi4OVp/dV9FXeWN1tPfr/0ddHyO+5l2ne5o51axvJ+fadNVXYGbwx7drvD
+/g3pd/uIOVRuBvgXBQdi8Vqgu2WJbNHrzEv0ep2rl9tlkS12B2Nz9lKVpi6LjmVM+i1GbouwIMYh
+s8wW43Yb9E67RTIIVhe6u0WZJwVWtnNcZHUgojqurXXnqVQ2WuKg2/zCC9PqWvTLFqcqW+QaXBaX
+S+dxcUa7xW5yWBxGp96VoI81xBoT7R57osPjiHd6nIkuT3xOfKd4OxXLwhiksa281l9EtOYzR+sX
+qr1EXqgk3x5VLpPD0+s3zVM+HfOAikN1sWRhMHit/kgwSOaUKl+E24Pijx4tAzZhi24Bhy0MKYAs
+sgV8Z4OGN2GsUXHGmGMsM64x7jby6jJrBE+YTmH0CAbVF2n56tQTaXMxN9DJG2c3cUIWWFlM39Is
+UX6mBbsp1RfjMPLilmbb/S49Yu2G7pK7T6yuQJIFZ59Y2Z7cPdMrZ6QUpHVuSQtRVHOmtiasoqZb
+rHKK6BBUii7nqVyQW919HjAhLycvkFeWV563Jk9ddbr1IPvDF7UhaVLu8x67pr/6yob6ZZUdBowY
+X1m/ubJj/5HjK+uXq9XruHnqoi1scOKoUx+oQ5Fce2v8Qw/U/xIdYwuC64aXjIng2hbK9wU1WuMm
+7Bbe/Yxhk0Zs+O1NiQ1HqQ2lNbWU6WlCa5QGdd7/A7Fx/hmxWU9+v4nYqBW4Na1ReQ+OK6a6+eyA
+HVWSUGXbpOrmOzrb6zta8lTlvLZLUMX+3L1ybCC2LJYlwxu2/ar2ItHbfneV3zX03nuHDbtXuaQc
+IZ1ILIkjHZUjAw8TEBw6oihv3EuyiEBE0kz5WPldua6cDfOSSgmvnp4nwY97A1kRPsi0xZKctMVr
+2OrYZNnufSyuPMXTCmJHd5HPTHkiU9uUBpZSrmmYxFQUilDySJOm0CZNQUq4SQN6ObVTamXqS6nc
+cOKM4jBTI+0b4UC1VmaaRbhMZdNNLOjW+tEbmO4Twnwm5ZcaOVClezC8FjJQKaFztQt+DAhk+t2i
+Pc671YAtDp5sYeO2ypsc29nHDOUpXCu3XZCdTAGKEjua8lIow5encXy3qOGeaamVqUyE/c+3/vsZ
+eH39mK1/PvHe8AWDZN+/mXJB0B7genHFiMGgQCpcVp3eTnjLFiv/jGuTSb/Fut1oaKVj8iF3telk
+qcDcOVabbBvWCSnuy9WaDUpuwOSJy4nrFNc3bmRcZZw6yCOs9R8Gc697hX697n/gSH239VsYrtXz
+cz1DuHzlzUEDTryiDKNj9fVpk/QAQWnoApfNFaMZsQa6mk2MRbrT59UbGJ3xTq/PG9G/v8C7Xojj
+q7ArPa7Kucu2iergfTopkXHnO5l86Po6UxL7wpKn6eCrIzp4a1gJX1dTK1+8HDGy+qMaPmAy6o0G
+o9EoGU1Gs4DhgQWVWU9nHcpim+mbGZoZm0vNTc3Mrf23+YfohxiGGFXlxxDzEM8Q7xDfkOQh/on6
+iYaJxonSRNNE80TPRO9E38Tkif4V+hWGFcYV0grTCvMK7wrfiuQV/sf1jxseNz4uPW563Py493Hf
+48mP+5/TP2d4zvic9JzpOfNz3ud8zyU/5y8aTv6oiW/dxPimUROfVnaved3CydPvmPDwXx69cn3E
+2YX36qsWTJ4WGPDQB5uVkPLzmPMe0mzmvJ7dAt3iUps9vuiF5zw+5eqUqbd1ad3RmVrw5Mo9zycB
+AFFtvdgd/L1wY0Qg0W2QddjCMVRaKBeY7TGyaCV6XmbRNka+WlSbd+JMtSop1OZdrauRqxuU8UZN
+m1L6OmLoaI5BTIRA9o0dGVsZyw63++kGDJW1DosJmjz87saNZDRJVz5t3i7QkXlM/9Thz59kXwyS
+HsreYP2MlcUlQzcvrHoVAKFy7UouHbGYEPC7ZN4o2Z/RuVUWbLuunGxhY7q7OJkpQDdTgUU2tqWq
+eFUg0+SxuqLavAaZFvEkTNSpBqj0dcTRgschLiITrInfHc80Ed0pL6ANV6u/gLu6XhE6UZpOrncp
+nTRmIZde11+j6UxWsP6RY5M27SJhHVboc4D7lEuHGfMC7Qx6RrfEZDaJSwTRbDaJvXQiCDieYYVe
+hCBTVPfSq1wyux1mk2oyyRHoLUamUUFf11Tt18D1RClVM3iV8TE157L4nlxP/i7uLl4Y7muqiSNv
+K8tmMKMXEhORVpI1c5Rl7IvBYH0ZszkYrOuv6TBFiUuHA+cDdxmXOOyGJXqDapbRy2gAgV4tL8cy
+zBL1sLolIDqdaO/lsEsmSbYytl4mk5RpNxBWZzUJLLPFwmG7Ueew22RVHa/XiZo6njdHqePr/iN1
+vKaNN6o1bWcxZBnaEFbQGfWigTcapBgpDX7Wz2XKmdYMRxvSmm3NFUq343bSle3KddP1kAZjsH6I
+pOrgSXy4SfzGyJpFPOH6/XJ8JVk9Tzl09esLvygH72eGzqu+zKXXt2d6qQ3EbKwfRxvsCD0YEgQA
+uNNcOuJJfuBuVcO+RNWwL9E07L0aNOy9dGrdGaKaVGCJpp3v1aCd7+VyOTX9u46q3bfEsNupIn6L
+1RAf63ZG6d/1tpxG7Xvd/5v2HVA3GyzgYGEsrMFoMRoki2QxSWadhY9FLB+nei5wwmP0SB5Tgtka
+a42zxqciy5glZZmamZNjk+OS4wtJIdOJKWQ7sYWmtuYCucDaOrZ1XC9yB3MH20sothQ7hpIRzAh2
+qFBqGhw/yzLL2dZitiHBnIJMcwEKzaIqtNqjde/GJnp55tSBxWTTauVnUrS6fljV8bfXKD02kDjl
+1EymaiGzjeiCQWWp0i0YJDHKd8EgWU12BLU1pW+VQu5jLh0J+DkwTJVFkCCZGPMSICE2hnEvSUCC
+a4nTBSQ0Sidh4SRRFoysWYZorGLj3Q5W3hJr287uSEyIcbucdrNFF6eTEwXE6fKFOGsi1TyHDTjd
+2oZ1uv6nVDeYdf4XXUMlFjjU/ujCm3Rm1g4764CDdXIxXKzbJNtlh9PpdKXaUx0F9gJHkbsopii2
+KK4oviihW0y32AH2AY6SmJLYJIvD6WKGk+G8mUSJcp1IGMm1dmYqc5dPbznr9nvZrl0798uQlZbT
+mbsribluNVmz8MqM0S7nedK7x+1jUrmCYLB+JTNDbVCC7gDXjUuHCUsDKfpehCFML6qPf1HPvchs
+Ebbrt1CNfL7I5vOSSDXy6h6BiEZeUWrkmv93jXxAEnTphDExZZZKC0v39UTr5rszo5WCmez5+qdI
+7cwgW7iXZM2se+uwqlICwS7lZW6K4EAmhgVyPLZ4sz5L8trj/WZdWjorYTmRtpjjl8cuyPJ7LJJJ
+l5kQy8QhzSObbLos1ZZRNahUpxArNcXVtOl1dM9xw7qMqmni1c0c6S1IBxKxzupAWrVu01Hb5iE4
+rdrmY3U24aY8Wrs1LzF20uoOHVZPik3M21r76D3l/ccsXDyqf/nZYMuOKVPLr6+fHpy+/nr5lNQO
+LWeNWK788vSypOQlTys/LxsBQnRKIvlSSKT2jQSN5rBnwuawX34sJP4GHQCC/crLXJDWf0QgNzkr
+KVay6JKMMQkWjvezSdjSLGlLgmWLdUGWJyFRF2MhzWwcEpNjZBlGjjaAalKqiUfW8Pb7hhbQNuGE
+dQtuOk1qpjjWcCO43EkkkThcWiMkEocgmkkW2X/3tAGjlywaO2Da3Q9rTbGqY8dVWlM8fHbEMmJ5
+6oHkpOWbiHn5iFktO6ROaWiPqSkdwzbBn5Kj/OdsJyQgO+CMcduJHCfBzi2Lk5bZE2PcsCSqyxeF
+YZ8M8uHwhqFcQo0C0tPUP1Unr6rk89wuD6Hq2c+H//3ukRusOtG0a1Tv7WVlr40dud6ms7xwd+/t
+bKeTJf3bCSzLd+l+x8nBdxaKrFDcHQBDOGUlU8nNgRFWNKM+HaTp+k2aTweZMwOMoPl0sIaNmK7W
+1J3IJRFj9/w8F+uzF+Q7mcryPpoNDaNUzVjJHugzdWqfvuX3Xl/I9oj4cdisnOU+4HtBQDIGBLJc
+9qRY4jGYEEdEzDCayAxHrH2Tqdwfb4hNchoMdgnJsSLXURb8qja9+sSZInWPpaZnrKuurpWrlWPU
++EhbYsxNtVID71QzUX0RaEbS0W4KOpI2zIUxg0uY/t7XNwSVH5QPSQviDLZ/puJey9Sxd42XRrCT
+Yt8+ffr46af+ZldqiVkgqUQgAvGLRKf8yqWlKp/OX2isWyrPnggQPAJwdXRfc69AhhijA2eWHIYK
+HTcjKX6OrUJ62KWT9SIxwGSzOK1GMcZt5z2UNa3WNv+rFKY6r7b2ajUVxqgRaGEuiUgu1BCHWh9S
+5a5V3e3icnNfzhLGDJwxjpQpm8nWqhvrjxw5QozE1vmVWdzLoyY/9pJyNVh/ukZ5JMh0CBLnJ2ds
+VjDohQ7cKe4ojMjBjEBHU7Pc7BiXN4mLFzIzzBKX7bTAF58uwOOOR7YQn2tyOy2cTe9plu7jUjL1
+SSkxkp7N8KboXWZbrkojT6iXVRtEjTbbZ06cOaE5CKCDy6b9QMkMiTIAtJD0NpobifQ2biqWudu4
+qYWDILrFdDoPMKvvWlA57K4F8+d+sP4DT2JS0gePn8qd3KHNlML3NnyQlJiY9MGTp1pM7NRuMtlW
+OWxYZeWw4fM3ntr4QYtJgU6TW7y36bSa5PTG99pOa91pQs7pjaeTEhNAkA7w6t6TFNQFhjkdjD0p
+JjZGtTiJjY2x2e02o2S0yIzVLknGTFu8riLWcMDLViTxxG2XWanCaZ6eTCqQEGt3cIkx/jib1cIm
+MhJn1DHwxLn8vsQYPWeCwKSq9KeaUqDq6jyNAkfMfdxRq+HymaLLeXVFl/P+3f4VWFWesTtjs9uK
+bSwskDmbbJFl2eqw2R12Z3NTc7Pf5XfnSwWWAjnf2t7eydHJWRTTy9JLvs16u61UmmaaZq50VDof
+szwme8Pr3L4CX0G6r8DHRnPZqucS0aktKpBuB5Up5JEOyk6S9GUVs3cnYQjWkz3LviApypYC8ogy
+5WCQDJ3RftkM8kUwWP8pkx4MKv4Z9/eZoWzT9BVEp2whX/IbwEJEfMBIwPHQcRCYBqOz6jMn5JOU
++Nu1CeDjj/kN9QbmWh3UEAw6hTqwV/grKEBXHAtMbpbBZKYPLupQlJLK+NMGd+hQRGXzwV1bd6XC
++eDWrbtmdu1Q1DpdEoy2tGwugfAJ3qr4GL4K+XOKOwT8rQvTjDop4M9OTC/sWuTX2Zgh8A92Jg6J
+1w3OdQYGo8SSWzg4viSzWD5TU1utXlQXra5phLtSW2AtbKCKqmRPrbjdhU2l+FvdhiQT502Ssmbf
+o6myb5as20Tka82BhYdoxmDswpnC+FF33tV66LLXV99334Denx0f8Mq4Ct34iYOHF9y17MCD06cP
+6PtZdY8Xlfuat7hr6o7snOHl/JtXSkp6dM3pktN99I7p4x/NyqkcWrXB7boytH+P21TomB333fNw
+8xYLhyx/3F2/y9cnu8O4dqX+vplF49oDYLCab8ZOF/pQfV/PgOTUW9gY20hJGKnaW9ZpjoRUZzxQ
+2SJVxqYMUQxiwgwRwpq0m2XvaLV+tHXf6tKiokEl7YtKyaHBRUWlpUVFg/kDRSUlRUWDS9ur9/al
+pQBL4gHhW/49dYcweqj21rnP374nv8PezvmvpHTeU1T4SgxSpI5FxSNa9yjKzRwqtE4aKgyz9ZTP
+1KqLs2erT8qX5ZMtkXPyo/P/PHZZ/laukZWaXKKZ0zkYsamZnTtZ5QZUxSXtqjYR6zx7tNWes+ni
+LjYQccqUyZOnTFF+37BB+V2LE3FD8P3BJerng+CckzRWeupa/KtPHPju++q+mwdPkCbcOX5mxYQ7
+Rrv596ZOmaJc37hBuTFlytSpU6YQfsNGIkyZMnXj4MElp+bMPlVSUjJocMmp2XNOlZSW1FtXrvrl
+2Ds/p6Z/OWLiq88888qwIQDAogP5jT3CXwELH5pjUqBNcibnd8TZ9PxxkYH/uA2Zx0Up7m33cSnB
+Mycb8ZaUdB0ROFu6Li5eSLbrxnHp4+It2dQ8szYvr1o+2WDFGBkO1VTn8t5Hl+lu4shuBNW29N9t
+MMhzRW0wuJdNqvuG+WK6sjR/4aA5S18/vGrEyF6lhrI7Ng786Jjy293dB3Qo7NSTqVzGVCvrRgwr
+bD37vgXzE4YO6dxxmzvmUWX1kp6dO3btAQabsJjrxGVDgEnluPSMkRNh1ks8I7IYzxvE8ZLqYKXo
+jOoGQquAisOU42LtTlJg125cp59+UrL0V64oWVz2I8pB5dCjZOEOsrAhSnk7D/85uww89ZchcMsg
+sASCfEbjcmtpI6guRT7lmW9vXGCX1W8gS/8jvx9cA66r+6OLMBAbAwO0PdIt1V1aLRv3SBv3JFhN
+3B6LcIffvifhb84OqXe05Pi+Igs2VufSNkoPl7wjYhOkEQ55uC32rr5Ds2x3dR2aNazNIHVo1MrK
+j3JNHlUU17gjMzqVjE5+dP5s7eU8+ay6c1oVl6L8VzRumyZJxEPynf70pjul81zRO6Xt/+Eo4x5M
+L7KPLfpyhrd9m7gSZU1JCTk6a8UY5dWufQcN6tt12H8w0JhfjAXNPu6vFGcp3/bvzyzuP3XkkHEp
+3bt27U42/EeDDSA4yvdj2wsSWGRE+jBqfxAlexwlexy4CNnzOX1HmYr6FYJ0AACDl9mxbC9egRWJ
+SAiYYiQbZifFDxKcgyxJVBygu5pUaYlKKE4qlvzR8RSDLrNzc1q2zM6dW7z60JHV3ceOuaP72Lt5
+JT8nd1Zx12B2bv6h1auPbBnbvfvoMbd3H0vf/SlAfeOoHEazgF1vFJdxJh7LGIHoOAlmg0yFqnNF
+1dSMTJMwc9vQMd3oKIeafTNXG7zlXLjAc8rGGmUjGVdDxgEEJcwu1kz9pgQCXs1XioznY4/LJxl+
+ToJdgtHkmqQzWUAs1gRVT3FCPnnmcthOVp1q62rk85q/FHuYbDgdgkWVqJ2+gjYRG/ADI7qv7/fK
+8SlPXSWbxtZ3X/j3sQPu6DSY5950OBfc13/h0JVl15cOnLNq+MCRAwCAYCjzJJtD/aL0CTTTe44n
+2p93H7dZmj2fdtxyMhHsnAa3KEjyTxLiJkkO2LJkVTKhPlFONvpEuVpNS0m3c2gOUfJcfzLJRztE
+sZBppY8F9uyfMG7W3BNv99vcr0ws6Vs6fPRdzTx3Du7TvUs/vox/2+6YMLb7CFmeNnz2HKfreL++
+Jf37DR5YHOjU7Y4AAQgZyl1mp4f3qTH/wT41dV8vM4y4lZVb1X1qAAgKlRJ+HZcODxYEWia4bHys
+kSWxz+jU3QSJti0OVZ1tfoxsYU0FxqTuCZpO224pcBpla2dveNFS1WtryzaqalulJnR5VlND5L4M
+380Kbi8dJurZI9oweTnga7IzLb2RlEQpuZUNitBmSg9Pe9+G+qkbNpDrxaUTxyymmu55A253OJU3
+g0Huy2D9rnNTn36earsJHgm15zbSvf3ZAaeBE5iHVN+CMvQiYXmTnhfVXf2qGX+1NjFUq8aTqicW
+VYuj/VE7BGYak3Xvhmc3fFxLOP7svN8vCIm/X2D7WL/Yd/A7gGAbY2D87FIYIb+iZyUBUkOf2G/l
+g4l5S/NGMQ2ECMo05l0AdiQGTIb3TPr3BIDV22WHus9W3eiYc6bmXE2uL4JdOSSCXOlM/47tFwZz
+x7Ui2YNSijr58gNTWpUMNZmetNpyshO6FAMEa5hdzFXqL6lTwMMdV/0lHVf9JfEcq3pMOo6Twhyj
+XpwU7TNJvlpX26i+jThNshfkW8WCNvnWfOeWra+ev7CV2TWCufvuCfPq40Y08h5vUt7DgnhkBpwM
+uOOy4bhrTgLsJiKOizWNsydQShfhJWrP1J47obEOUSKkO8JnOR0C5RTIml5du/bq3aVL7zM//XTm
+zE8/MZXLSKcHn9364Kptf1lV8v6rr37wwauvvg8GR7CYG93ID0i8gRVhlnSEh268QSTjWVUNWFR3
+pqjW1pQfsKuOLexsqnY7Qs4qWT/9RE6o9ytXuGylcodS+SjpTLo80hgFEAqpPpd0Nv6KnC4e5z6u
+HyunYQ4gi+Lz7MLI7+K3/JUwPF2DyyLuV1OHQhj6h+dnND4PBsOUqVwW1eMko00g0Rf2rKE61nhM
+v0l1rCEw8CVI8TE2P23dMxGTuepaWTn70Um5uolWh3KyKQWtUtrQxeDIdjl2aETNQ71qKL8Q/p8r
+Vy6ePWvKTMOsRqXPjohbDeVs1muPP/TwkkUIhTQfEOIkWxpS1LKbZvMcmXEL+Kw/gc9pAp/WAA82
+gS9ugM9U4WDD8CkwIg3NsDcwtpknvVl8QnyGJyE+2ZMQ7/EyPk9CQnx6ktlibpaU0cxiMaelMilJ
+FrNfBWU2i7ewyRXpemc6OyvFm8CaKpKkhMQYvzk9NYXPtPnik9kYwWJrluFhIcQkqsbsmdq8deak
+pm1tVISErcEvHvvopLp5sfC/66ciev8qKchvWHNVZ5Wm7iuYh/oUtOndp3VBn/qRsxmxXaftMyq2
+dyhihGiHFjVCv9a9e7du3bv377OD3E+pwR4zduyY0aMi44a1iZcLgAkdAcTP6f5uPw4HhkBtEzbJ
+YjEbDYw+yWLW0UaKfdQtVsD3iMf+iNNdEf9o4oPOhy1MhXW6Sc9XeB6GkZMtZoNeBzGF5UhCjC2u
+mcNm89qSuQwpyeUXSAp12FNdE/aQ0KApKaquVU2ra/Nq1aVy9e9ylEq+cZUtrJu3DOy5295oNpBC
+Jzn1cNTIJGf1s6r2g6WNRm7laoE8RD7oOf1g/bU1W5WB9zH93hYsK+q3kWnLlikPMdOWMT62/fWt
+QZKjfBC8hRMGOp5LlGmqXw1bGtLoOJ5NplE49R9BcTojPAZyyIxbwGf9CXxOE/jiBvjMRjhbRtM3
+1/Jnv7wlfNafwOc0gS9ugM9U4WBQCgjb+LOQkIhegTSb/mHZuNohVrimm1FhsiaZDCJhE93gUuOc
+pgwd/HZLchLVeYZdc0QGgebupKg2L/flQx4S5Z9DZSVJWOPZ6KfjxZXff/zx9ytJL2VP/aHDZ88e
+ZkHWkueUwUp5kGSR7qQbyay/VlXfJsj0q2IMQWWhso767AiFNBtoSi9ahunFDlpHaldM654X7ot5
+t4TP+hP4nCbwaQ3wYBP44gb4TBUOQnTI4XdxZ1VdbCDBoK6YMwQ6o55n1YVmkedYomOgow4pT+Rp
+etWi6jzq5UnUk3Q9aaMn/C6lO9m3UemudN9IXlNu30j2kX1cofZ9Hw3IvifVNABYFIdeFl4WfNDD
+jizMDXTwNDdlpdkNBFyit5mDM3GZaUmm+XHc/LQ5ceXNPc39mQ637E3y0yRiM7ec6Bez5FS3mOo3
+NaeT8uGwmYE7vIwS7eXssHxY234X3p6tn5ZdmX0o+1Q2R4brww48wnKdzdoqLZ1oTouSU9LF9DZp
+TIHmy6ONm3Ezf8l89v6DfzfkZD99/5v7JBOxDB2qDFCWb1L+NfHE0ON3UaPjzcQw/t2h5Etm28w5
+qiFxryXvzp5F+pBxne/OIp7xyj+3qpbbffq8N/TtccT4rGqG3Lv3u0NDIc1mkvZp2zAO7CYzbgGf
+9SfwOU3gixvgMzW4ZldF0xdp+XCf03EUsR93IBGDA80SKvTOCplQFwmqTZWgkw2xCS67LjbHZM90
+WnV+EzUjTwqbkUfZkVsbLMnls5epMbmn3LPAw0b5TXBGDMqdNxmU5/xEbLeyKQ/u/PGtt346FSRH
+dlGjcmpbyr8nONAK0wPtcmIEcEabNbYFz/qf16dXJXvYKvMCT7l+i5jTIsVpi7XGZCIlP9lpzoM1
+05KQJ8gJugJD5wL5ak113Qn58pmT8uUzlxvsw2gVapTLqj+5Gk2FGtDntK5svab1S625sMsXdUVE
+WwXxcu4mulN/tCvE9IjalP1WLCufMloR15MY1XksMcW7nni6xYDSv47+/at+L40NmkaP6DViq9M4
+ZNzQzdzRuWNmLrsxNMgxb79/+tu7hmR07dBpwabJm/ckJRFm0ujS7hNmWHSBHk9NeOAvCIU0+x1h
+nC0NxYAsoGspcCt4xa3hzPIm8JIG+MYm8D4ROCZGw8X4hvQbVDhYFS5OpbYUBQjgaOC2jBZpiR1b
+yy6zrgUrpGGLuc2WNNWqqOPmuBZPZ7FV5m25+cZN3rjytM5t09on6ixCp3SXu0V+Tut0dHKb28s5
+/rzO4R1r1ar+R/XpXFgYcfmpLmzUUncGYZOjBlfQ2oAPtGveKbtTi045nXI7tezX5VQXXVZ2Touc
+nMqUytTKtMr0yjaVbSsLK9uFrTXMhyyH5LC1hvmU5ZTsjrLWYKnLS3uDzYYmAobVHim+/KYaRLZR
+g8j/Q7XoqO792jQikjh9ferclfetcuzICl184P0+Q07PPfkRQoTdv3rOPfdR46Wq6sqlUyb2HVIy
+ImzrQfr16aO8o5zWnXw1OOOVnb379unzwl/2/LXT/Q8MHvVtcnLv+gGfTr333rLycN8o01S7IFsa
+umlzPlZG+ozbT/u+O4V3/RN4xa3hzPIm8D4N6SeG4Ta6X1tN/6uGi0u18nQDmN+i4BVhuAQwSiOc
+Wb4UDfn8JvSJwDFxKRr3+AqJSEI2JgbaJCXEOB12IdaSbIOUGJtcJWVUCeVS7IIWtiyHPVWflAiW
+tTgT2KyY2FR9Vke2fWpHfV4Lug+YYoi70BZZgFf3naguJy7LhzXccRfm7gnklOWoW1G1OUH17023
+obYg6Xx6aguS3iaJuFPdJEq7XtAqja088LbyhnJR+VH5O+l0NbOkubNzjnIkj7hbPT42YdX3d5Ee
+OcrrOT2900aMmDZtWCxf+JgypUb5UXmbpF69SFpIUqeprR4buzito3uq9Y42G++pP2q3Hfr2+4MH
+aicdamhPfgsdq3fSftmIjxvGJEfbs3+4f3fdEl5xazizvAm8pAG+sQm8TwSOiSocLD7FHP5zriri
++z5gc1pYvSVumT5mYUKsTYiRkBDl0vpE3Qn5pLrS9ieOMFVLIuZoaYeOpaUdO5RG7oqDenG8GobU
+Dw3/zPhqaBnyQxt5P1cFERakY26gY3yGKz012WLWiQQCF+tKkI1cWnKcscrFVSVvcpVnxGd40mS7
+OSHOoyNItZtjPUg3d7K3RydPXgZFkghjUXgTY1EXYSzCmGILW4YEmpU1YyJ8heC0h42I1LlBcz4q
+0tVmzeSujZt8TRZbh/aeOtxuGdZ7yjBi6NJVOaJcmra/366BWzt3UXec3/969x0lZDNZOLT00A/K
+F0OGHKhdTXTdD1Wom81b5u0qebbnkRnqBvS8ljtKaB/R/Z60T0vDOPDmLeEVt4Yzy5vA+zSkn6jB
+tX1hNP0wbSwPjNrzLjii97xzhq0ObmucuufdtMWyIK7c+5hj03+15722tuZ/uued3Gx1+R/ueWc2
+3mR3SXe9N9S9T0PdJw6k4zEs1/eT03EvcZBim4CUqLkzTk7DvV3MNgHFiE4/T05n1hGZdL8pfYGc
+xqybiej0yjTVZ6WczjxOPgca0yvTVNtdCu+H8BMav89fo7xeWphnDJIZYbq9WBjXMC9UYEN0ejnd
+NEdNaRPU5xrTy+nMcjWlTUC3qPSL5XRT5S3S95HTmfW3SN9Ynrm3Lg9TFS4P9bNI06eH04+KpBc+
+EBy2NNxO4RW6+NCuUCgiC8oCHm6YJ+ZRXGyGUYGWmv1FmndLRpJmjZq8JaXBGlX0T/YlyqxqkZqe
+qtmkZqqDv6i6ps5dVGMtjLZLVdeYImYunbIqs17K+q+NU9XdDSrzm+/0/xsjVeUAKd5Aru/a9eem
+qumzhs86O6tRdqf4hhDFt4yoeTmOwtdAxbju0bK+nG6ar0rnN6UvUPEQK4Gb0mv9+8f0Wv82Sa9M
+41/W8JM5BjSmV6apdrUqnHT/Q/73NugM5vJZZM4t+I2qRvwU8sP4uZqWJzOcfyE/Poyf22j+PaLT
+29Rz0tX85/MTyYyG9AW2NDWdLDLr8F5Ueq2+f8xfq2+T/BWn6kdSTmdO80+Gam9KL8rpzCFuG4lp
+SE99/YXL/w7NP4uO63fVeVyDs8UU3jPi04v6BvQjHcgPW9SGBajwPb+Jf0bWV+ArO3Qo2ktjxE/j
+YaZ//YtBdmvV3mBwr3pV1Q0Lu2tkx72KJnanqU3f5nNqZPSWdqCbNjEg9yiP32SgxeVX3TPryyrl
+2z9YaTXocqbJ6aagqsWxCWhO28cDMD8IJXIas3Gp2sq/anQvnJ7ieegrUtyQXqR8ZjyFd4FFe6JR
+h6TieZP8cwDmc42uNs1fmab6qgzrlgTm8XvC/JUyjfmF0pleGlyILk+jLqpSfUvETpFLb7BT3P4/
+tlNkC2h7KSVie/6sTN9nFZHNHgagC7DJyXFybctwWQv5IYJDpmW1iujNJgAQA0zrVPlES23+oP4y
+w3j2I22XbApXbWP3aeMn1EnFb/J7uF3yVPyj+P0J9DYBLcLvylPtUylcT+F90GC3yqUgFvh/Mv9k
+84KKjlQGg1fr3wgGyexS5XwDnZguTpbTTbO41qTCJiBHKwfAfKvB2YMU3jdSbtXPo1o+7jfoG9Mr
+eaodqQpnN0HfmF7zCymnmyqE10PTovPnfGp6UwU/KfRik/Sr1PQIshOwu0l6j5oeQVKHXVp6EMgA
+vzDsA7e5xWziOVYQ7VajWKGfblX9T1rMNgPPuWDIkGw2hw4ZsuQXqBvcooiLuDDbWdjok1IVTdRh
+Kqanhn0Yprp5qpGYcvjjbiTxMItDZGA3ZZzqmpLsqpyxg3k8GFT6vD3jan2l6qQSAIEOYD2CA1aU
+BfyyyiYLAgxbJGpjK+psrEUmpMAE2cgW6PnONvlqETV0lQ9HiqNis1qWQILRYpTjdR57jn2orsy+
+264TLJwsWF2yy8oPb0BxMV3zh9HGLTLF6+unrX+i46fryfUnAp8Hg0HGUTZhKbNwVv3pUROWNupK
+aR/zu2gft4ySfVrL6Sr3EnrfJuBOCh+q5Kn+JSluDoO+Mb2Sp9q5UjgLfWN6mv+kBl3sXH49mdGQ
+/7gG2SoyB40AxL+p62LM/A8gi7iTsWq4r5QIZv5nZKB3wOK2OZP8ZvAZqaKfbdFM5Wxr87QFrkBC
+kjlV5NJ8rnWqh7zHwFYI0+Gbb5yTRio0LbSs/p9Uaq22cA+rvdu6Tev8PFssoX5l0wvyw6vMKikW
+hViS7+x2cD0T12/83c98c+ma6mt28NIBvUb1HjN7fY/uh1QV2sZRWZnKReVy3dhfv0pOpn5o2am/
+v1E5W/muPjcYrkMhb+CKkRah/w0muU33CNzKNjfKtL2piW7dl6px+9eqbTub1TgHaLpojVeeQ2l6
+XpRsqfEuuyjv0r9hbt4c5l3mkRk3pdd4l13ALdIvuEV6ldY90ZgeDEoAvY769E7HyEDrpEcT0h5J
+SajwPpoc80jcw6Qi+UHX9JSHUaHXMS4DD1+izeOP9yPW5mnmTrVBz6fKpgy6LVxza6juKYg4NqQj
+9rLS4Fk71Rr28cH7ol3l/2Hdh1tdqfymXCUGYuCuX//4lPLz3U8999xTY4l0mndX1T9LplVVqQtA
+qudt5ZjyjnJMeSvYqrCE9PriHLljcLv86IWgsE7uS2obbUcrLAjk2yQhITnOIQnNkiFtiUt+2oOq
+tLhtFqEqz72peVp5QTNHvK0Tm+yXOrKFcfpOOXkFahVras+coMo3ej6dumZSKythDXDDf24gobI1
+OSQdsh2yH4o7FH8o4ZR0ynbKfiruVPypBGl4U4Prm1Rr/B9UaxEXNQ3W2Fxi3+FD+7zVc38F4agu
+bTZ1kpJe98GSY927nVmm/KIcU0XnyreiDLUZ7sPnth61kX79einHlE+I7tTe2fe/+NfefXv3JS0I
+q0rQvRvmeOHlMN2poHSngMKpnXATujMoMucIL4fpzgboG9MreapdsQpnX4a+MT3NX6U7BU1kGy1/
+le4MakJ3qO/NJuVpTeHUJrhJeUqi0tO5nvuUzLgp/Tg5jVneBVpqLb0yTfXtaUtD67Cu8IKWXpmm
+2hzb0tSUsoDZXRCdfyS9aS53gcxozD+SnqkKp3cr01SflXI6ZuNXOu7aUHhLZZpqQyunYTaFDg6X
+h/q4pPm3CbcPofmrNrf/Ejra0jBYk/34zqFPonxiugG7OnoanFQ2+qP5kfSKOKtUl+3eqrtyjHm7
+ilzXvFYqQlX97mCQ6dfUttcDRFnfqsxwNGZ2IP6C/AYr3JsscTdujNjiKj8E6yPWuPVBoGF9hvLA
+6sqMTUDbKH1LiZzObMSbtEVKo9Jr9PI4Kb4pvUYvz8F8U3qNXv4xf41e/jH/ybY00wsU7zYwPO5s
+SH+2QS+0Aa+F9jfJf8Et8tfoa5P8lZGqL9DIehTzOHkwdEVNr0zjP+DSG/J/nPkW0Nap9A7xPhV/
+hYVkrk1AuwhclDRelcyj+Q+NwPm3tfTc5zR9UbSeSsX3EWrqYUBU+sUN62Az1XWwUEjzl9dknHVQ
+02t2e03G2XCgIT2VGbkrZMZN6W1yGrO+V7WWOiq9itcdwuOmlsxoSK+Om+HauOkGRPtxigVSb3ar
+pG3oFgUn0ynKqxJ1tZSSEmz0qVTTp1e7jnMBgEGzsH8z9WxStPmDjIeb/JNp9PmtTaqmTBXz/tRJ
+WUTgu6oqzOqvRbkpI+9QN2VgQ4awn9UEpCMXID6ver6tKIh52p0KDWp9/NbWJD+vDV08izpZhHiY
+vcS5tGrEyP1fz5q1Y7vyRN0bh6pv63bsCebG4l0DBtzZL3LKCH922Mi9S/v0ccwas/X8hAlB7sKQ
+u/aTcQvrH+rYaeGi9u3DJ46E6fDdqs6IndvE/5sFWQBxuF1qOeR0ymY5qbNwK2XF3C5ZFKjxW7hT
+mGY5uROnZDdvnj1lYm4OMzioJGf36lV+YfGiRYsvlPfqla0IGzaQL/cPGFBaSt4ZXDpgwH72hPLV
+DoUb0Cxj3WP119ata5YxYBl1TQMG0MoFJ1IAalan/TkdXOQEOKtaNK2R0pOzCLN5yYnVJsPRhwgz
+S/mrUlV35JDyNbN57TvDWXbY8CFv6nRr2XE2wiTPYMiYrec/DDJvB5m461u7M0yvpzf37cmBvpfW
+3wkjPEA0DpACchOG5LONqtT6j5lmTXFjAyFfRnqf7JhFXqhfGY0a7Mybz3tpH4jTjnqhp77M0ZfH
+J5gcqQI99CVeNeRST/qi6/lKrVytStDiHGZG7Iw4lgy3t06JHO2VEl551XyBC2JJe5JHGDKbvKrc
+oTywVPkq+qgXrtmlr5SRyhPKRmVkoDOZduDvpDPpTXqTwLYdDeUTA8JryAHogTLpaVa5TWuf2h+t
+24RHYat0jR/2p2l4op4E0ibqlJnPz537nMxeurZdB+vAQeNUPtmT1OWOdpXp99zz3GcTJ54599jI
+EfTUmQ/eV0+d+eB9r8k2/7bbVK7ZnzJijvLQgRHDn9lcP045PGDADs3fH3+af4GOIUEUIryRu6BV
+0xIlkoYiOcOYrPr0m1HR4NRvavn90+9RC+RN7HJHu3K5U6f7Hu3YqUOHFZMCnVUff0OHRJz8OVs9
+O3BApETTlU+Xdek8duy/fr5nfCAwC3/UvdKxvpHir5eOdFjlpgO8jTbErXK6NrI7qYfdfHRWmVbP
+0DE94MeR5F8rv58yefLkH1byZ7Uzb44cjgzl27Ks17euW6cei/PYurCPdnW8xCFdfR81aaaqMW96
+mtou2l97EkuyCG0P1UqVS61PXMbvPHSo7ohStX3HLIZZe9RgWn1iyVr5zSHDhwnD3+F71ZIZ6iEG
+Eyac3zqGMDOSGWKrtzMz+vbd/HQvrvstaEZBqzAWaC1ONAWdoFELWaMdbo1BYXvl5kyc0jw7u/mU
+iTm55Pf1GxSBEo1Fixcv0ohGcpDrp+qCBwwoHay0Li0dMICbef2L4LIBGc3WrWMMj63LaDaA1O0g
+XuqLR0d9H6mnTiPV5XRYiEaiCvIp7cjP60Rap7fye2k5vG6X2idEK6pK5dLYq88ulRZ+OnbOrPvL
+edmQ3WXSrIAvXbdO+XbT05ufZvKe3rx5pLK9vEV2dvY3LVoMazZi7XO3CZ1MppizKU6zr0VuvL90
+62OPMdJj69Y9plxXfn90CLunrEzByLKyUaTujabnFalubPKdvkg/WRv6jN99fXcVs0wJVlWRZfWr
+VvAdDx0iZR8zww4y3EHy5cekLHgjsfEchp8Qi2SAhIm06G/dprUvXDkV7wp4X7gruF/GT6Rj7a32
+jz+u0oTPz330sbJcPXCBe6peHj721UPDh7EdiWnl5gEDnrt0b/kH7ytPKOtOnlSPXBj0jHLpgS3P
+PKjWIXSBL1H94OP2gN1k9JlZHWNDjFuXmmJNTTJlUi+xZ06cuWx1F1a3RMDuM8+XYhzzpXhmDfj5
+6XPiyzPVbUlnauVjl0+oG/JJo6MaX77T7xWi3UFGHcqY5+JjguYVzz238lFibNVF+ccLjx9e/Ir4
+Vgvl2x+VJcqBd/dt3kZ8ZOiS3/idytk5C5ctXrx81u5NTz+3onDa219+8PzHcYk7lu054gcAEuqn
+lNCzmbyAPVnVKWjNlP6HY5VkUdiy9cVFY+N8o9IySPQBSw/NVUqKf1iTpO/Z8wSfFbXzsFj5feoI
+kFAHZQ7lX1oCxB+2FBfDbiId4k3o2Ybum8/X6Jib+o1kGGNSmsrPqL4j773380F33tmnEbhpUxjE
+GyymQk9au0LVnWTBX4fdpTfYayiosN1C9lRw6V/vGmbQ2xtp+nThNbQCyJ8ScStFmsiBTiqVbSD9
+fLeY3oPGNZDNyuwJEw59N2Xy+PGRI56273hJWUCp/oLavvwTT3SMouXHSoc8tUk97unpTZHjntLS
+Tp1Sz3s6dcoLgIE/VMyrZ6SISAQInA5fXkGrph62CZyyz1vQivmXcpTM/EZZrWQ+x1xcShiS/Tqj
+26LMJDvq99V/zwxQlix9rOo+xsysCwaViWRdMFh/o/7N15VLN6o1/jJUrxMFBwoA4mgT5rm0HqGs
+Ga15LAnTtCjvVVbN2N/JGzbc3aljZkHSwt33jM9rOWzA5CmzFSHZP2hSXt6i+qURT5F3DSNDvyHl
+5a89UxaMbx5YuOjByWPG3NHVn0JGkv7LClr16asqhiJeJCdOeC/qDCkZiBzToqKoU0Ua4lGXN9Iz
+Rh5/OzVI7uSMwWAdu7iow5gYTRfdA2Bn0HNmImsZDUdJacdJnT2rZnD27OHwBs2gultzd+TsKrED
+fxY+QM/kRywEIxmQ1m3y3GGMEQX+ZcUZOXqoHuETiVasbtWr+I57vZ7AeL6VclfkFKKGo4lmMnqD
+/et+KWksF+3vMR2wN53C1Rb/gwZdvX8x/f6vI4xEYuIfden1C9dz7zw7YKCKdyn+nJbB+jFN/SpG
+fN5HfCtao+WL8OkO/vBShKrk27AhGAyScUG2eFZdcfjZOwD+dv57pEaeZcOFLODTiTrWKTkuaN2m
+tTtPtad3cyfmKPeuvKScXFi/aQb5l3JXR7KrW5fmpZ39yey9PQpvy/hm5ZnAOaLqgbvWvx4MsufI
+jQkE8zwuJjW19xcug0R+nj9hMaPt/9N1588iF0ht9DClYogr+hSu6C8N1jrs3zT/iUxQ85/IlGn+
+E5lZ69crIvWf+KLqPpH9NBisG6Y6TWQPRMduJIbdJ4KEeiglqr4ZHoBtoKAFN50sREWFtL0aAU1v
+csbQ1DtXW9VzwVQS2kM9GazxvKEbvygXxvdJRkS/zD/DFcPbVDfrblyUaxyhDVrZu4atr1+6KDwW
+wxrZiRNP1GUFg+yXDYMOANtwppEBdiQAvBcR/4epeW1a2302t4v1Mdo72DlNzyhSlh8a3t9QLxsG
+DH+NuWy4c/ihg8PvTCRPMmcaTykKPvMgsSk/r93CfEOkVc88uyoUwqDQBeE3QVb3I4dU2f+4UEpe
+1OD8NW60ur+IUDhfAoDBIOUZfTv+CrxoHilhHuXCvU2WJkVBdNFyRx0HNDtSYmVPSf+BA4jxn4fq
+Qod6j+qVJgpnaA3CxwJppR7hs1q/+vVGTDDIffXeY81F/UllPa3FH04H0spVIg6j/oozAGLXesLl
+lBFhzLVJRisjUQWqZEF28YNIYuId7ZJWKEfqT2qOJf++tLhTcdHXl7bWj67/qfyuod8MG6ac4sxz
+cnLiH/6nutUn4meys1Vv2P7sDdWp59LDjKrb5N5AhO+m+3jjkAoQX1iy9kd0YE2Zb02+Jh7mkd8f
+eeSRR9VNt3WnGrnv8kV9evfuvbicPzuz4q3jM2cplmAU+11vHlzy0NpBJVH7fvgXYEFrgDibCs7E
++gfJ5CYhmhavqQitbIqice2aytJb60er+3QaZekb7/GPRgkob0bL1GFnlapN0heqjhY+NANIeCFd
+O8xUZUDoCUppaouETTGo7Ku2zyGm7gBx0LOS3h3SoagFPUWpd0bLkg6cSvTJUu38JPVMkZ3qiUlx
+phbZpfQgpUc86SEEg2TWBhLX5AyllkCqytxp0kknEhbkNQ6WqhjCyhELabR24gseU756WPngySeH
+j3j/q3dbterZ69FXRo9OrNyw+sHFxPDIo0OGfP7Vrqlbnjj0SWJl9/y8GRXfHJk6tVWbVrkth49r
+lX97942kR3OXa+6c8/vGjG37/+PtP+CiOrrGAXjmtqWzy7IsHZYFlt6W3QXpVUFFRUREUexdwQJc
+ARVLFHuLGFvQGKOJMSYx0eQxYhJjYmISk2jypBc1lZhmisCd/X4z925DzPP+v+/3+973ibB3LzNn
+zpw5c/oxxU6rXtRAFF1bbckUkZ8SuIw5pLKDA1QpFqg4O6VSz0S0fDEV/da2svO97aNzTWnzVhYW
+Ode3zZk7saepqbT00R2zI8IHpc9rGzrUoz4kOHhM5emN+w0RqmxjfpjWZJoP3TSe8ooxT64aNjw6
+wl+rzS2OiMzOaQRmMxgNABtM7DOBhL5bkIn25nxAFNADALGE4i2jOVFU00v1iExKQlSG1AijqKab
+oMmIRU+VtymF+i0xvBZe9jLGlhQNdaorOzzm3dYNfv+JiMxJ6oAT2upLA/28At3QWKhMCgpac1bB
+/jFuVI6JYXreWzI4fVjZTaVy/IzHmLcp14g9X9CwwlSkDowbga4xLQmBlKxqBQUgSEbpxHYc7mA7
+9vbRaxySbU3GLBjWr4wjVFkdaUOCgyjKsZ7je5IrLTG+tVaq6xgu2b1VAEDr4dZb+jPhcLf0q+nk
+PDfQR3Gbpr5fL7HDSqQzLHZr4i32bs4bRIsw20wJjhK7iGdJcMeAS2eUCgrOLw4NslnAq8oufFpW
+Rp/kH7FJITErH7GUpczNKyo8AwAEalRJ4A8AIMLOam+lLJVIjFS6xXQfqZs8LFLHrRp7bDT6c1lz
+NKsV7fc9t94uHRoXN/GnsLA6Kf55mPmG0zRuHbY5c+QekV2kIsxmoDff4OrJ/VLFkOfsewAACCaY
+b3CvstdBGABQxVEqudon1GRUpFI6bCH1ASqiUeoiFanApDFQt0p3TpsGV06burOUWoGqn4Lw66/Q
+FrT1m68hfAqNZ6/zlajzNvp47759e6HuNqyt5PlpaM9tdOnVV155GWbehrOmEXnzMABk3hAgXlq6
+SK0ogOO22RjPNuGX3nbh9VHFmU1/716GctAWLPwSOTiShwYYDWV7Vk2rvnToM3QRtfqW/USkYr+2
+7Cxg6Q/FbWA/AoEAQFHBkizI0NrREc8DmWeGnEiOLCRUWBqImi73/WRtOMNOaHZxITQozBQ+5a1t
+Z6RagewJphhoAXCG96mdZ1RbDNeRTCWKvk8NvazHQ8RDMIo+0jtvgGJ6HZAcBMahx40Ky0ZQY9BY
+S9qpNGLLGfgIugvnwcWk8QzaBSHajybi9jNUZjvswR1okEu7cIE0obHV6FMBgLtwibWJsKdHGpem
+r36D/oTdjX0UdN79CwSkZp6ZquLRngvoMhaTYTUunYdE3jrYfIO5xF4HwQBA0YSrsU8QwRn30sjU
+J58v7ETPoUsNve286/4Ne44++XA+dEbl7fQH9GBVBf/+e19GRcEhWx48vu/wqa/g8i/hrHYAAATN
+5htMjziHMxTVd7FannRkcQFlbEkwGamZaBf9QTsqh875Dz95dM+G/a58e28DuoSe61xID6bYdrTn
+S7Tqq1OH9x1/cAs6GxX15Xvv8xV4DlTL9JDcewCT/g+TfNGIDANNAi/C7kZ0/Xm09+v+kwAAIJiF
+splLuA4ouaUtm4kzhlTeoSa9igs1pIYbUuENjBqMerQLdguZ8Nua+h/7OqlX4dyv4QyCf+/El9p3
+H1h57uyUVzpfWrMBQNAOAOvL/gq0eGwF6SGj8pbpVVpOJm20KdykMWgsMcQeTct+WQaDYE7Oqt7x
+D6Ja6tX23lOfQ9cpSEUZQre2N2xhpsH8iIgfrrbOah85Bf1yCpXeeeiF1e0T5j753Fap76z5BrGz
+YLwFw3u2X6uxGlx8GOrVC/32H7WKBpcn6CEhV960339qATG4+EMAQRGqJTgLBQAyap9/nYT6BMqK
++pMZutvBjORRWD38ryGV/9ZhngIefXkMGSgAQYL5G5pm64EWACXREVWEonFUSiLUS1YcfNvGQgP2
+jcIpu3YFjJtyEfUNWZNN1zMBQdUz/PwYJiGkZMm+fWw9OsZvdho+5uevXgwNu1Qepk1M+vGD2ODv
+eYtMuZRpBjLi/QKicG8w4motcqiyBcxFwx/bls9YuxvmXTnedxoHce+BPZ6wDB6pH3PmiQt/rEMT
+UMiTpNYCAACCVwCAe0n+MCA1Hvf+l73eE8teB2Yz/o5OwLF2cBUAchlcBXeRv/ECgN4r2hMwh1Ho
+iS/YWmUEzkHHYNV/YRX+gY79F/+gRx+GX6HQw+8dRqHwq8MAAEDZzS0DIEKlNShFCMj/MULvp0yE
+JT7ArRX7V2EO9yvxvOssz12N2P8Jcx2eV5hvOH8hPmf3kOeDxfdRpXMA8bvHs53keaL0PF32OYlV
+G+7wHMcADRaf0y7k+QgprqrS5S1xHNlI8jxZep7uvFl83+F5MUp38pDGf488HwUGyrlvEXPl78lH
+a6+2j7W05QO30N+Q990BoMx2OUPtDwD7uB9rjmsLuxwuHSDHpV3KZemfP9ki5U/2z4doBxds7zuV
+ynXuG8AdABz93rIU8lyGvnf0qzstlevcNw/wfg15PvD7O+7z/o77vL/lPu9vcXhf6nEk56gA2344
+lcoj3TcMkTIK7PL6yHrM+9H3lpwCu/c33ef9TQ7vk1x9MW6BeoXELay3vC9bIMYtwFdJ3MIxa2/+
+HvZXEAmAQ199gxQ/T1jOffvro62w/jTl//rr/95l/6Gm/zSht5su3tNonzb/KfUecgWJwASAGJdE
+Go0aoEK6KDgWSzZagyZV6gxkSLX2JRAFe5N9iD/ccyJ8RidpEfQqvcyxTUHvy7hpUGGknOI7t3ag
+hj1bO+G2vloPD76xbBnUaBDv4fHx0BLo8VM3dMN+0963Zs8oi6/oFw/fwm4ZMB7+BeYxu3h1W/x5
+C8fBpZZ4XvO72P4oPqeHkOdl4vhoErYJkbj9leZfbTF0aBL2M+Hn4BnyvNz2vn1MHzxhe98+pg8+
+SZ4TuUicl9mB54VibMxgfJdJ8A/G8FMV1jhlM47lgHcdzjzhlYQ3Zd3LK8nzmgF4JeZNNQ68UsSn
+GPuxBTYMgM+TNnyK97rzCvY6fguK3gLSjFZhURMMGgfDsUb8yS1Oqd5z6snzsGTXHvQaemv1AuGk
+1XRMgvapenQme+HXUAWzm5ABPbZ1+85mOLdN2C9akJEL/IvnAYV5O5fL/irej9DufvRSye1s1lQn
+5dyysWHTETjlqxf7arGJp4vKha6wDl5vmfPKsWvozi6UIvyCJvI8t5kH9nGxcp37MnYfoQn7uFuM
+iyeZhwguRpFzI/L1eYACbiABAKihKSIUYpiUGpnFnWBXGQRbWCIl5yw8Bt+9SUllQjZC9a/R4aND
+PLzhO9YSIahwGXzX2z20omAcLgQCF8wbPnzecDj6ukpbsbQ0I0Ddu1eqE8IzM3wCMkuXVhS6A0bi
+S/NAENCRTGTwf+gnSACnCdDeXFgkBpC2Lob7P/QZrBJX8Fj3Y6MLq8cON5qG4f/u233wzWnzuMbr
+3tqK7sce664ocEffmoYNnzd8uI1/EprkumBDP/5JaJK9SvbhGHlO6io4xC/tJc9JXqREw0+S91+0
+u4/r5Dr3NjYXLuuXg4bfP8eMs+WUWWrXyHXurSwDG+/JWdNR5+3fl3J8xPHr4DJbTpCY40PG33RP
+TpA4fiJs7Pe+OL79+zSRD8j4TDhcZsn1MD9M/BqzJflARp2jlwPaKk+Q8ekvYaPlfaAj72ut75+n
+l5tvW+UJEf4LcJmNB1rjiM9J+LSPOxbhb4WN/d4X4bd/X4wDFcfvhMtscaNiHCgZXzxn9nGj4viT
+YWO/98Xx7d8X5Rtx/F/hMkf5QBr/4j1xgOL4p2Bjv/fF8e3fX4ei7eNGqQ+YC1LcaLRd3Ch+bokD
+jRbjQKkPmPcc4kCjSRyoTnxTigSFoB2VM7fZP0CkpK+ZjIxcLFsn4xiZwWgKxwZKT2jAWocce+Sg
+04rOr/f+CVP2rN75xC+dwt13G36GE3Pm77l6fXdGUVFV2c8MgAvzsin6a/TcZyXKm0uefabxq+i2
+gLau7xcWLC2bu3rD5NqFMLZ4rJcJAAAgmI5GMNVMDoEhjBhCidKIgWA5CYZIXZgEBIYH/rFov7zu
+Z3gdKtHah4TVXS/sOgjz6oZkxZz9z9zhOYOy6bdXr4O6MW8surLS84WJt+6UhNWHlR3YN2kB9Myr
+H5syu3LsnNT0fnc2+7pdzth71pyxFrrUmjMm5iG8zf4GQmx+IkvqgL2HxJoxMGrmjM5vvusSqPP3
+pgr0uvA8c9U+RwDHkB/gkjhvoAUJYBC2YWNToH0HToWJmIhZThdpwu5jbSrxBqh9DKmysAiDTZ6i
+I7jo4Q7tOccl5HagIxtn+EBsgl0AXwv0ilpzRoE2WWSpGGdVtEPTzt6llKv/I1/QcLSSwXbYMljI
+tCQE0NgQexInUCLjqaMAAAqUm2+wqeyvwA9oAIDerNEg1xpCFd4yRiHXhCpSIyINco0qTIl/5VRy
+eBrqP3kfPvsd5IXxwqsw+E3Y3vwpzEFze5gJhmWzpkL3uajtwIa30R9oMdz8xVOrjkHNvN6V0H3T
+I61PoG4y5wzzDeYBzluaM1Vn8lHZz5dqUnMqb9YIU8Q56bpNdR9/DQcJXwtmqP7oatUSOLYL/X0X
+nf6BubqoI+Pg+xv3nUcvotmw4/OzW+6890zCpJ0tj8zoU8GY47saXkcfEluY8C2xhXmLtjDRdBIN
+cTFTHbaE23VfngiDXriwYGFMLGvtwuz/OXp5/ubqKdPmEzuY8C2xg91vLLtuEcVkrFnJsay1a4QT
+Gati1vgG270h5lYycGm/ewPnVp52vDfQQhwnJNdR19jwAXNJrzEr7O4BwnclvexlABz5rqTH/Iq+
+t/Jd2/vbQdc970fi5+Zf0Gf9+LoIf/M9+SRibug9+Sdk/E33gWeTPTxSPLIoO0fCwn7xyyR3fKKH
+JX4ZUKDY7McsIr2qNTjWy4SPF6fzVhv1KeTwcWqV1mQ0RSZHEpmUWlGzf0O1eU7ArtNX0annVrvC
+dsgEqdd2wLtfPATh3FZIfTNxUekn79NUSeiYbdumj1DuhRS9rUmaS5Yl1XL597kUhoE7FqrY0oHn
+J7lCwlprGtG/AHNYSh6yZhYBACiQbPZjatl6IAM6G2wRCju3ywBgUvMJNCjV4njpD5YFjC/gUSmC
+v7q6H0AAij1FGR3wAmCAzp+fOjb8pE/26/Mp5uPcJj1J40Ba/2jb/p2N7YNvg6BGYcdJmfdsFQ36
+dTx2iMk9aNVJrZG5KNmjXx9kYZMtTNeDh19auSgNylGlrJspBGEi74f9Gwqylj59ItRZUGOvPkeo
+bOtj3sNwQhoJBE5hwR4MKOqFLAYUTrEDdYplbfRJj9Gj0DXUi3rQh7gYQxMUu/rFUi4wDgOLNBKs
+L86eLS0QAMCYb5if4co5DalKEgw0AKh1JoPeBPVKqHa2aCT4J3SFGZQOmrTZ1W61cVToLw/Dd6qR
+G6wlagjajN49hr6kVvA/lJ++PAjpFS5l1e61sWwyWk7e6zsqqSC0HsYKr21F3ykAAJT5H/Mz3AxO
+A0JBDJk7UowKID0mcLwZ9FFbov00oTolJC43SuFdXn1nNzyDI4fnLNlR/dAKT39B5hs1Bwcuvy6G
+Fj/48ZvUdbnXzOOfXCurvvMK1MEZ8FCob/SO6ofQ+6WZAleR2Xn02HdfXYArqGtKRQ3qQs/OJzD9
+bd7LfM+0g1AQBQDpAU4qnRlskKnsIKPFNC212BEc7Xml4pUZpCl4/dDhKyvX13EeyNszKAt3Bs/N
+XrirFeL+4Pwrla9s+C8JHvZQr6hcd8MQg1Qjk2etbKJKst/4OyRIitPCubei78Y+TsvSbMQSqYVT
+dnAmq1201gWSxUqZXzM/w+k5DfCxZh5DHRSdiQTDOFReqVEwq/t+fV24VU0tRdPWsQ3Dqiva0cxL
+begFHlaQx9Rb3XDpsOoK9PMGNFOIYj5AL4i+cVRAfONDpHjrMFzSi8Qy6hQkdFEKpUi06LP6FLVC
+G6mR4q5DoEX7FX8l/jD6rxULtMVrJ02PKHdShWoUinqfY4lpL/szORFlO46WlP45fduLPfVeZnDV
+BeaEGLa/M6r81JKLHBuni47Kdy6g69GCSAZmZE98R+XGOdEsjD4X50LVeypGRfh4R8QMG7bqr8LA
+XHTn02aOKwmTe0QYho/Y+H65kXV1WzAlPLx0OBRl2yEondko+nQ0Nl+L0Qao5FNPhJHQ603rhX8u
+0xgZIwIyuKlj7aRpkSOU1psf7t0wOQJP8YXKJyN74sU4Ke5XJflWcTRBElG4kzQpjJzRJklFDJQa
+0VeRBOfP9Alg6rM3FMF2sR6cDpstIGRxSTh2QqCm/FJY6M9o0S/Ig+epuWJVOCl2j0lgComPUxxM
+DELD3aNSGLmMk4mOAzgfj2SZ5fBdGLlrTMNvfT93wdF4sIAwaYLGouAGMVaOBkOl/BUf0pkcKKWC
+kIylkDbJzDBYnXrWvBXsJhh6rYteChXQ66UXv/4VPU1SV2Db6hNHD1lyVuBw9Cyc207P4s++sHa/
+t+oSztH4Hfa2tq1qEPNVetrFuz/DfIP7iOTRRFjiWCzGJWIeFSO3sPuF1agI81VA5jwNuqi+85RT
+1+XL6L/oEfQo+vDyZWaFwJz84J0nceiK8Jd0sOajx9HnMBKOmdveXoV++PJrqLLp/iQWQwUAYECt
+eRN7ihsCgkCoWKOC1Zmka9+kluGfWlr8Fz/V6JTaCJleq9QoqI94X+HHri76Sy3v++mG5qqF7aUv
+UHS7UFr9wgtwXWjoozvRxiURI7AysjiiDDu5vrSUM22nJvw+B36JNIDAUGHezLzIPApYEAHiQQoA
+5GC6Qp0pUifxWZMaW3KUGtoaUh2mC4BiGJLSpFV50ydkQ5S+Uz9ZEjCndBfurh8fHbIksGnkZmfh
+tzlZMy299/Mzsuv9QsaP8MoYTlW9ONFPic4JBu1YbekOsfE+M107Xle1H11HR/W4Ib8TNR8ucj76
+qhe69KrTAgBJ/YVtZN9wrKCUS+0YmUocabtXccOHTR5++/bHdEDXtWt/4nRutJekbFN7rNyv9o+v
+woDVLorrGkS6ryHWUo0kI1aiSlkL8XUmSTkR3rY4mdDILOgY1uyjVsit+StGBRNDDX9z+ORhw6VY
+iz9NkyZsQj883Nn5MFRvmjDJ9MCOh7Kzl3fseIB9f1XP128/WIVjL776Y8H4+Lh3r5J45nfi4scL
+fdD3n9VrhHL0zz/QV+oZPIgrJPFyJF/CCpjEbCIN9t1+lXYiBL0Q3u0YVJKfQdWXrM/8+jt7Ycfa
+7rdzPd97vXni4JHwG6X3Y49A2k7AQWMsEgKGoxTbUkicdRQA1mgvayiYQ4sI21GnOsVoL/iwNRSs
+aHJlFjbh4puqgoSJ0d444EtotQaCbRoufM7zlLaJGsLjEDH7nsOhVl4iSstS21oZJ7Mlr2YctjQH
+HkT6BRekOUMK9eGuwfBLHlaSJsFOuG3wjDEbeYSNoTPw3Uj0CvY6OaMAD4S93DpCcGqNifyU4cWS
+6jFqWHvZDNBrP87FwR5z4RtzH4QnVsz9Eb32zeXl6ONdPPwNPmurIIPahE95JqgHz2PpExmBcUkP
+MIX2noiQaKgMuDQaR3yUw7nDcdvE4e8gdLRfSMhZikd311NL+Qs8fwH/x/ftswsIofrQRUutJ0D6
+mOsAUNppHt5crDV+jtB9OFRZTLnde2DPPZZcdAP9DadIlly4l/K+x2ZLhRGbrbXGlOgv0pDcHyux
+KuxDjq2dq+38Qnc7+tV+ssnaNM33velY9kl+z1x24rTCXtmyCOQqarhlLiTDCpa9yE2qnD0F3zx1
+tE/gaSO/zl60ltvlNLmC+H6aiULfr5+QGBdoywu0L7AG7TSg+KHDHJJH7ZQQRpltrRKB/jsqOsqW
+KCjmu+E6lsAfJOQ6e2dz/sBD7xYgJzk2uKSGO1C30wed3d0U7c51AfI7fdeuyO/0XUyCSlJNQCEH
+/WIhwjhZReRPb8JAmIauoNsLv1laJ1++aFfrMizAUp8+/yz6BP2J/kH/jYmBunWr3/j7t3dXNok8
+dZjZj1nBpAEZSMD6plrUhY2mUBmnCbOqmLpIg5WnWiLo1D6UcsKBvivmEdvuQDeWQXlnV4r6ppvr
+8ePHofpQVdW4SV7Z2YsfHFZGwS86IFy6hKK8hlDfvz5Y1DpdnPciYH5oj686Cf26KTd3cdM3Ewk/
+TecKyF4lONKg7F7rnNb+LIRF2hNk/FBHcxy047Q22uwcFeVog7vb3Yq57deY2Vrrc3JFxEaYKGUG
+OJyBe+hHCx2U2f7Hwo6EoE0x5B3Px7kFNgKagZ5ysP1JPm8gI1KhRpwMegFbyqjeUqLxY1g5YvzE
+MtTsDP2hE3SHCehj1P2tGcQe9d6yeEMLVeX16tHj6AyMgyb0X/QP+h19/vhzS5adeccJDDAXwXUW
+jNBYvEzGcIkgQwH8GBeDFSKoT1tI5YpYCL6F3lCH3kd/oH/Qt/BdUvK10emdM1iaQJ+j3zFJQhOZ
+Z4H5hiyfKQTOwAgAZC3pU3SkzjYR0eOkIF21pLjoSGA6dk/qTHQieoIJ000cH6Vz8YzItoNip1Lx
+9lOJyRlTnRqG04mTltSMcj2lFwHbPDzyGWria4+VVowuvwgjJpnGY+h2eKka6NiJMXGhe5KgomZU
+04wlBzG0+ypXzQQAQDDV/B2zkCkGyWBYrkIVyzlp9VyEPBsEZIPYlBRc11fefS3zymeZpLZmripR
+fdjbW8doI0LadU6cWztzUFeXgpPmLl6Ud1/DzU8Su9OTIM6XiTCKYao6Sd8iHVLxecP/T2JIGbJy
+7MdLgPvmt0KI3q5zLR1c05yXmV60Mj/rwepCCLOHP9RRESVbiP58/at952HVzM0bNtCDFheNpKD+
+elG0DibH5xQlpHUUG6pGGAsgHBWZ+WN61tATqwpHeQSsAYRPfcfUMmkkr0yMKVcRrEckkXBOMQVV
+F6lN0hos0fdaTtSFjGpG7cNEbdiweWYVPL/vq9fRnwtlURUdDw3PhrCw+sGs/JVF6Zl5zTWDS13r
+3kYQts6n48Y3BHiMKlx1YmhW+o+ZkaMgLDCOqDIUt6clFOXEJ0NddNF1PaRGFkmwyfKYNFBAdFgb
+aA6QxUJHwCS4BqAi+kbRhk2z7EAds/v+oI73/O2pxORBU52txETtzgjwGFXU9r9AHzTulANV2er/
+TmcKgRcIwxGzjk2LQhVyaKeSaUIVclo9f8yCTZGRG+oq56HL0AS94aArzz9/Bd1CbwtZFZNqS5Y3
+PTBs0kg0CA2mf925ecZWX9+1Mzc/QsVQJiDZ+bBs4y/Z+URB3d7WhwVEbO/bu9eOWT34IH2SR8m8
+cMRm9qMm8NIaUDrhkS7k/FqYXxaMUIiRewYLSxJZJzYqaPrzTbruTYuRDL1M4kvqcTTJOpF7rln1
+DUy1N0Aek2xj3/d98dWfGg2aPePA67zIQ7/d8+AjdvxThI+dRfp+W/Kp7wcXVFrlcyqTjusYEBRK
+BSVYGR3fVzvA/EgDay2iuXTnHyHyHLArrCxmY9qVA1IrGA/58m346k5KXAVvNt7qtOS2b/5mKXVZ
+vLxDYFQTyjClWpLZY2LQx4ACfiiXeY7RAT+iRwOcj6oJxxYcZ0sPe0uupSWvK5X2gxVLFqG/0Q+V
+pbACLcX9018Se6snod7EpHWL9EWUHqWjazOHD4NDc5e8CrdT/jyP/sE91oXV6MDaQUHBwVEpXYSO
+n2U+YmIB8Z0qU/UpEOhT1DKtIVUbRks2I02YGCFB+HoQ1EIrKLpIHSfjKj68AWkYWZ3mCWcrA28v
+yRo8x4h++HX5vuKUmsvBXv6L4HofzfRstXuYXyyXP5J+pxptgg21e5Ql6PzhRauKpg8KHjltwY58
+3emftEN9hLlaZUC0b1CEUZfknFEMIInB3EFwJEZOKGzJdnadru4cRECsAAl7iEmf0fU81kTlYjM9
+FcsL5dh2Dz+35RySOpOxos1vYHlyoGqT9qJA7qhyh4qT9GubbBT8xsSYmH51Jy31zThvMOh/zBtE
+OdQ7y6L/HYZ7S5/dCwrij/Ct1oet/BG43VYLTcrBFPV3Oee+GgBLDiv1K6MDHpJGY8t+/Xwn/DMm
+bvyTLw6dDEdRNbyQhlNfk3Ik35BUhxIANwCUOtxFV1JjP++gtndAktRJn+T5vrewN4XMlQ8A7cF5
+kxwE8WWc4KMltGepemLQEysmNR0PEK72XWBIHt8B73p7+i4wxJTirM2X6FBtlnzH0GIOnmoSxjmp
+8KdhmW4iXHiObzlvoAJB1lm0kYZUTYraknEKI/EdbtLTg/AcB+5CbX5yB7x74gQ66LJww6LteBJf
++oleN7igqe/PJsiWuMsn1XKWHMl0+lnOGyhFPq1UOIq6n+Oqk3YyLbu/qdcZzbAXXvEYtdweMRf3
+/rH6Mrs0aI2KOfFZ3UBB+4ZFUiFP+mN4sX/sPo5QF1pJwjNSSrVQEs03nJIZHYhxyGm1S2K1/9WW
+zbpfzGaFPSSZFX5Nclnh1w6prPgQbsf5q5SH7Ze+cmseKz7n/09zK/5/nLvnGE/lksnJvslOMYUg
+CwAoKu1+/doSZkE/6OBZHNjNyHxKwLE7kAQsoVPM7xXW490ggNE8hgzx6IbdqYRBsByDJ+C8yr8s
+v2F3IwYUmmejdFxDUsqRshZHdIBIq+pXK/Fzi2vTwqqgo1Nwt2OFXOFxG0Bwr5WuuWamEMTdU5ex
+vzaXpDCaBqrOSNmrctCxQKMwwaa/wVn+vlbOROZeCQCby+hsefTWvGYtvg9WtrfDMmrqTNjQ/h9h
+x8xldFKrsA3+0draV0k1C+Zlll4gpC4Jzi0OAICxaEAKOYtjZTU+OLc4UheJU4tfs+TCQn+YLRyr
+S4hi0BwmOmE83M3q4r9JiAqy5MCij9Bd1PNibeX1D8ZOppjLoydNLhf5zEoAnFyYOOCCdypCQao5
+YNZJyi2KHaxxuUUVIWWyKC0pt8isqBj94snChczaXrN9sUX6l3bk29Pe3gN72sfSy9NXoJ4/d6SP
+jYjOun3wwE850RF9e9Hx9WjrejRyL55/tPmGLIMpBN4g0mqnMaQCbRhQSQqhMhSovIlVgsgyhlTA
+1FWW5taiL965gn6szS2tfATehLvRXfTZltW5OUu2oM/QXbiF8op677Vvdt+FHuj3u7u/ufheFKVH
+a/reQbcyFi3KgAHv9MEWjG80jPMgcSchIAIAVqJQEe3i7tHkQ4QCS24aYtqhn78oLHwVfSdi/1Vq
++6sQbwF6Wfi8fsIE2IzLLgahb3geBlt2AI7leXRc3Ad0vZ5egdOQcToygNhHwQxjdMAXALXCZut2
+yMPPIJbMB16mXuw4LPQR46YbzBoz+spz8Ai5nLBN0zIWpwJ+1rFM9xusoOCe0WbMepbewfM9n4vD
+4fFQOhvM6EAsGY8IVEa7HDtb4mGYjlT4k5SejPqEKAnc4KCS/OAg8UAtWQxTK8e0hMTF4+liokXo
+e683JyaGa/GxGl3xSOtLC40GliFzVxGbL5GnLPXb7uFjwyyLEYo7LHZfRk+su7Oe7TvN84xlNdJ6
+yJha65icSuHIGbLgPaM/YMcTHOe48pxww8YTWumTdnMVA0A/wxQClfUGv6cWHQbaxuOCgvCd3WbJ
+XE3m7x1HrF1gbZgjogO+EBRkA7BDKOapNj45UQJKWCHldGNZcg/JFQsn2VUDmO5Jsylbd2d6TXlD
++PYOVHQmf2h8WofQ3NFBnX8HMhdQMD+jsWUyT8mcc/JQLw/zlujQAXIBPNi+cG3uwuziGQPOSVpZ
+2dw4KUaTEh8xD8hKLkAtvWbHBdT3jpDf0UGt7UiLH5p/Bv6nY3t4A5rIT25pnEGFeqxd2P4gnopH
+B3RLYB6PevNyxqLHZhRnLxR90OnEB50GgNKuHz9xOBttPgNbEypGE6brdzvU1nvsbpu3uGQ2djD/
+2jPxelu9c/vKeQtzR29/rxOZ0W9xifb3xJDG1qHFucX+xJv85PEQDbozf0FRvjFbFWHYv/H0E/RU
+B7sf1pNxbrY30ACgjDQ5kIVa6SOz05hNRp1XwKZ6S7nC8jCT8rJP+xIb0cR5Mieeqpkg1i7MWNHz
+3o7po0UdOrFksqSTk7pCCdh30o8EFdr7qOgKbCb43FolcZQ2nOqnsXewLKOzFk3MWIEQery/Ag8r
+JRquQOkMYNLweqGUg271l5uURnuiVvvQlZdVKTaKXrJLc9kvyy4HvYO62vfs2NGJot9q9LyN9Kg5
+IweRhPMJC4CU+24k+BVz34nJi5NxunAp5IC+F+c4OS8iUosT37GBh/ptgVugl19gaX0bOtKRlBMZ
+8R+/Da3vSugoiTV6XYa14YnCDwrF2TVBQUlKNG5EXKC6KG00pL/YE+FKMW8/Nl3alOIlPe8xjCln
+1AcUtaJKRgUmtIiyyvP0S0whUBP+gP3SOFtOsn6Kx5vW7t2LDuJyqXbFUnkePQ9LeWqjpU6qlEff
+ydbjO8Qxj96Opq2583aUa0mZ771uT6CiT2MjsWlESBWFFHr7K4RUcyWPWanMq4He3gZvbtrUgbgc
+LEe1tWG7+Cy0J79q7lSqClZi+w7aKwpShDJQMh9xae7BE5INg9wzmEto7HqjSzKIxqDBWebePmJn
+ZIOGDUbPnjowdcLoj5c1f1gxccqBp9GzFAia3Ppm66RgCtAnc//ZMu9SScHg4sKhryzY8k8uzytR
+78EXvvrqhUM9AVINb7PAZjI6EAwAGy6mo9ynrBP1EeJwYZ/+1ZtoGk6gynlBP2C5Jov+XijqpVL4
+lPQD0pWQXyocbEN/oDsbUX0z5Em3a57qFGp5nj5p5f2yaWJcqcZV+kt9ANSLv7pCmvr9jY1ocSvM
+u/P1jd9h/hLhaOvF29Rrwmk8hjCD2kvGzBGGWMdj09jbxOIuOlBVOsIMPaEFLvKDOujoW4TvLhb2
+rUC/921G9W2s2s6r2PsWz1NLhY08b5GBa5ho4C15UB2MQwq9Ci6+jF7FHtP33sX+UvT1ZR76U0E8
+j/bDaTyP1OgZXoq1Yf/D6ECQfTwt7gjj7VC+IgvaAmupqTbR/fPGJdYQmxALaY8uf7QVAAoMMZeQ
+WN0AYhP1DoLYD8VaZ9ETCy+2+kZg7ivjqC3tEFIoxzpTJs2mV23dXAfR5EjBXXF2HFSqtm1TKaF1
+TjeX9rQJMWG7X100ZlqYAkAxT0uktf8v89rx1APrxjC2kZzZWdIcWuKHDDWkmpJMKWrH0cXJZT7Y
+C8DRpM4HXiSVh45czH9hHWObC+36fGHncq8FDPNwavvMs+v3jNag2q+fVDOJwRrrvMgbA3RmnsGk
+Vg2HfgZIragZo8HnGQAG1x7xl+xHKQr73CHStkyhV53pWHJ7yyxLWlDyH5+v28NTr8OkxzcT7/Fz
+t746I+zigeN4YmVMfJdr7CxS8gyo0NNPCwv3rPv8D5hcf2VSve/iObO23F7SwVPzznx167mwsFtT
+x25+HF0V0gHoNyYWQhQpUnK2VSGVx0KF9gy1HQ+J3rUfkvPhhV12g8Ik6nUy5jsojX6f8yG2WIXc
+R+9jDSExKGwAy8I4KrEmZ3waXZ+9bgh6++JFqB+yLrueThs/w8hM6N2NegthMQ6revEr5nEx37sY
+zrSrPeUO/AivTLXUmeHCdJFKuzJOaltVKuofXaTRoIscNi9St1oq3nTesSwV9YNBp9PpDMZhkZHC
+8f6Vm3jH+lSW3LYsRifn6AMD+BlCFXINka8yLDUPlPiRXXlG6jA0ocvzKus2REZuWjBmPvWqkIXe
+RrewrwEOqoAvwEsjJw17oGl5Se0k+lfhsvDhI5tnrvX13Tpj807LffGbaBf9F5u3ur/Z8r4W8MJs
+O9nmfrZwdqPV3iCYbX0FdxM8kJwy83GURgeL9kkttk+uBRb5p5I9J9mGMV8kTDZC4osmKQCCznoA
+jV6BAl6DXih3MTWlFeqmktiHSp6ORSt4/i8qh+fR2iq4n+8NbZL4LXOG0QF3m72DKOiKlV1d1NcX
+4MEmIij3fSrGf2I47Pzf9hm7ogpg8z5jf7wj9uz835P3CFOP2DzPw4fG26NviCVyqO9XXow7lnzP
+UdGjYJQVh/ZnMMwW0zoQr5CaN53p6LiXY+zZw8NUHvo7sI0uHl3hHceX+uzoDQPzDpVW4h977uUg
+HR14ii4HLoK+5dEVi83G6XHOG9AgiFTLUkDNADHp+P7CO+/gv6eykAHF9QtEpz8WDsDuxp7XLOim
++oSnaFlf2bp+8edI2dj3+gV02S7yXNzjWucYzhukgGz7HM7+0Nz3GvrXEAMCH9p1HzMuirODuZUf
+AGDkPcAVhuJtC8BxiiUEn67ACwwia7ADiA3FV7IMOlzUrBRMwt6zUD8INTa6pbsg5eT5fG20cBkj
+OZNhpHt8KIT4jheofkvF+2PD7skx64sjFtGn7C94Rrr6+y+173u8Z2J/BABkcWw9MPWXzZM0KfbB
+0APrptHQsewVNWXe4vDwOffTS4VIhzpYIZHFudqwgPvopVvmibqDiYvkvEG6DT7d/9RJaat2Ya9c
+uHr/D1X1MPrGTpORAF17X/WViReWWrUcMYflI5mJrQcJIM0GraTpSCH5djhUG/WhJMEcw24gkR8M
+RrgdOse0TSXR+Za4JveXrpKIozj0194XX3ztGbrbxT1rA4x/e7N9dbGx669bnKP4qqgrz/3+9bal
+yy7lBfrMgrOXUZDUi0XpTq6cN4kjECEKghr9/2wUpCHKty0f3uJ7o78k7QqGQCca/jVgIyFhbccu
+Gv1j1+CAdSkbtrit/b7dhXja0PeWrfuBVUfgDnHeIB6ACI1DW24HApWi9dVSlX3Sjo2KmbeEEOeu
+X3smfriyDtNmXahm9N6uqpEQIfR773OkXZuFKvfYE2VIqHdEwpiyjae3iJX9JfsR50t4q5eFs+rt
+2SmVg4+o8KDoOyJ808ItJX8R4ZBkrGoAmF4WAFK5DUpKCa11hVqa/BrA3PME0vqDq8ZDatR7B3fs
+OvD+ULhu1Ip97fc+okajxbC0oED4FRdYo+QFBeg8XEudGOipBMuftJEFpPeutWKv5SdtfAIte4L6
+jPw7Gj3y8SdwInVC/AmgeTT2M3PeJFIZU4lYTEosfqawi+LHd3gQ1KsUdqVWmTsHhRp4OPdI63PV
+47GHLyhYtAQsXgK1YaT4KfFHN8E3JlXMnvM2fBLubZ5oNc4+wgtvrTEZRpSJHmoMSzo3k/MGWZIv
+7P8EhMYP9je5aukdVphyQ+6BCd60t7bt/p+g9f2F7M2yIl1XUyfoTzglsblJVGQp03hvYdhJU2bC
+4dFGw6ilGZlT0LTRPj5pRZrQCupEAfV1Adpj8FVnZCxGlwsKYNbQ4KC4uEnoTgGZ4xn6Q84PJIlz
+iGfXVj3OVs1zgCmrp8DdO3Rx8YklVGRsolIZbTCUL8nMmIqmVqh9TMWhmjHUMwVUUNn4iRzrujw+
+KsC/6GTqgHBQoBqepJI5b+DRT0vAO6Qmlg5rQXHJSQCTIsIN+vDwIXpDeETAFDhk6FB7YNhjCSEh
+IaHxcdkhIRcKhZkTEhJZDkPhH1Ak4pYys56kDpltrTZbvbRqylzKpCYPi41bjgZNmQ1HhKWZRnkH
+BbHcM0kJ6enTuwsK4JXCOdnBwRRt2bPDlJll8bhKWylImz9D8jdUlzCpKUPjYlvhpalz0KkwU9pI
+76Ag6gnrsCjVMiwE1fBJeh1jBjEDjOltKY9JBrZ3adhNMUWpTIyNpEoS4+J1O3ZDy2yPWmcTHi/y
+D4iKX+7KchPHF9vWQxEa/JYxkwobxBJA1PEkXHbWIr6rxLJguNIpDgfHNXRUlvYliTBSSz3g75OR
+mjnGv3Qp0i1aBC8ujlC4OPs4OTtFNsLICXMDIsLRryM03p4h8fF+/kn0ZxTtEhKePTzGLSgJ/pGU
+9ClFuTFOTvrTSUe1XsrAH1jWOyg9LcTTU8T3HKaHeQl4D8whB+SHzEtoMSwpLBR+I2zOs7AQnYcP
+SPsH59LbmZeIPszJOMeodnvjEOUaEhdfGlqWUELFxcak+ruiD4bCLeVPHRkP6Qp4Mz8g0M2tFcbG
+RKV5U+MLC1EnrC0sFHWwTmYJPM/NkHPUX5bP9ApSr+Ev+DKpfvMXgWUzfZQqZ38FTiAo150GDHBq
+dnYC9GzWWX6t7yLu0Hbn4hVFehL0gEFQY/QyUeW1VegPp2Mn2FkFL200vQsDah3HAcpsmAU1HCXb
+XFsF3Z2OnWASyJvoVi1wmNMFAJgki6R0SdhcSpXPPnHMCbpXMQnra2HAu6aNLwEIbtOfUK6kNwZQ
+ppiMmDMYUtQ++KTeNkVG6iKNDRWjR1c0MEt0kfMidXtHV3RXjHb8O6gK0+EiLHpVmIzzVvtQruKf
+GCN1kZHMEvFP9pI/t5yvJ6lkTgXCAFCSJjmWtgMyrUkqmo1toAq9KpYyXBia5B1MU3JFfoaXF11C
+RVUmHs/NyRnkNXXqt1OoX+EWhbpxdUCAtzJhodIrNXX52sRYFj1aUOA4DyRj6RXWBECfEGgJ/Mah
+qBkQvjVlyrdTp3ql5+bmHE+sjKJKaC+vjHy5gqKCVIlDqV8LCuB4NjZx7fLUVC/lwgSld0DA6ka1
+AkDoyoRRf3JdgAMARqjcoSoCusIf0X9gEfJh3/zz+JFHn/gT9HtPxRrioYH6E/nAIvQf9s1n/3zi
+0SPHRfw8w7TQZdwM8p4SHwQlVX3n2JGjj9/hZiAfWIjOwR/7v0djAy0Nj4rvMS3wR/QiLEY++L11
+zG2aJ+9l5jozLAVkDMXJ5NeuYPK7diUlPQkMPeVcMfSUR8X4qpeAzHwBQCADMpBLGrifrgJjIQVr
+oB5qdTItXPc7BEeuXz/CzUDrv/7aYfzUXGcaMFBGs9A6fuaV/zG+M9SrTXoI15nJsGbA3IZNN26g
+9QBA8CoTAFdwSkATWlG8eo4JgP8gJ3LmXmNH0hmcmxcH6vFnWM3cphdzM7w40EC+38zcpo6SzwUE
+r6+ah8AVMBePpSZjnRWHImOhFXSGOUMuw2PdTSR880sAKBXdJNYCjIZKXBdJS6nQm40lF6CxsQS6
+UfPQHXHPvqTmSe8CpYbWfom/p5vQHTz2bSqScmVq5TJqJQBoGHB81iY+AxAshOXUTLoUuBJ7DTY/
+qLQGrQG6nzt48NxB6gT+9+A5IL37KzWTOoHngzinYyZyok6cO0e+e5xBdCVnAO7AmOsiA66Mh8wJ
+Ag/co+kK2fGLKZYdcSM74kF2xAN4SDsieTBNeqIuPN7K1U2dWse1Pnfokac5Q0lZWcmORw8DCOez
+0dROrgw4g7znnWk3bhJwk+OED/m1K3h8l4qql4AbyAUQuAE38wUyNMBz4udkSjf8PXkOU43EFBoW
+CeePbRo7tmksey6zsjIzo6qK7MUN5ms6SBYAXIEajMl1U9FXPJ19vbg5bsBXfu1Kn7iuPjKvWwVe
+G16XL5nEF/haJhG/dB3wSyVRNcTwIJMUE4LVoxuPNTQ8hv/baykUxSLxwWMN+LNx+HAAYRZaSHUD
+AJQgJNfD5W2Z69seAChcIKPwll/77OJFHDWfeO3mZzeTNHprwXCZpcwVPNearEtP1Y2KhMbamPrW
+7LzSoOh4ued+V9cJFanzAQR72QB6BlcJgsCi3FBfH0D7Kz2cZbQrA/2VclfG31UZ7Oss8wny8AwC
+wWSnFWJzU/m1z/DvpOmnmvyXlBvnRrsxbqwb5yJzcXJxdnHxdPV083T39PD09JR7KpxVLj4uahdf
+Fz9vDp/7GsmDG0IRrOhFHCVSRHncO2dGxOCEwXRceHTyAvL7EBgfHp3MBsl3dPgFJ1JBxVnibzC4
+OIvs5dfMbfofbgY5V4Ny3TiKAU6MDNKszEl+7do1givMNwiVelh304lsmBNwsuymHmppPa2l3brR
+R0c2f3znMOZIsAmtBxAAajpdxUCgBEAB7wmPhzC+X8Q7epbqgTKHYHZ0C1BADp+m/mbMUgyyWKxf
+vERE6UQtJtBBW7H1VHjnw5Ihj50yJn50dyh8qLyxcTRkx6HPjgQFjxkSGgvp78zHBsXEwpjw3Plf
+w4SCgl27CgrQvtdLQxUKhU/gXKveQLGcEjiTu0uS0+DhqWjMlClwCPVLAVYJyHsnqf+yGpBC5Eof
+W/Cxzb6jtpYJd0x314ZFTip1TkquKI6M8FUXTZqblLT/cHZRUUUpGx9XUTxE5W2auD41tWL007nb
+qHcfK9BqVSpduLfS09s3JrZk8kQnZ6/dWcEhkREhCoWbKjg2rmx6YpJc0nlaGTORy6X8QjVZg05G
+fpjE5EMqvrkSekw49fYENGTcqlU16MbUM5fK4NEKRrersPDaPwUFBw4UFHz5c2Hh///ucvAaAHQG
+uwmwAIiucWoFKqYamKvnepYAu+9llu/15A344jl6/zmY2rPkX9+hZp2jZv2Pd2DqOXq/wzssjsHB
+pY+pFeeEDeeYqz1LzuE75BnhZbrM/IRcRq0D4G4ROVub6aP0X0ROVBBvqygpRlh+oZIlmVH44x7h
+cb3lF7FumBfNmzeQyqrgbjhwnO8BcT6zGdxA3nQQKPPCnUfBXT15thctoWeYn/KSUXsBuDsOP+vb
+xNxGj3Iz5DJQ0GsWa5IK45jb5kXkWYPlWd8DzG1zE35Grbc+e4gNME/nKuUyaq/12UV2pHkQ54bv
+a+szwA4HgHOVy2TH+4D07DiDzGM4g1xG7bbOO4+NRju4MrmM6rD+7SY22rwIPwNzxGcAChnMbTPg
+fgZqAKBFKFWRfjmSwGoyIu7DEDY4rurcyLxkZ9Y/3FRSA2dxPz/g7ZNqGhWxYEFkcmRamKevdr+/
+2dz3DfO1OVAWgLu0Wuf9irlt/pusd98AOGi3wtLJ3EYTuDaxJrJeIXSeY27DnWg+fv8mc9vsy7XJ
+ddSL5kJSze1FQMQEQPVpmNvmNInXYmkSapRaWnD/+gj6iKp+6fAdC8uETXb4kqXjekm9ZvCTDW8A
+WnAJ3ACgtQmk1Bq5F55o5eouSfIBg6zygbCBPor+YX4heghWb8RodfRPWemHxUXbmGMxs4pimYUj
+RpoAFOYzH6PD3CViceOsVTpEnOuMCGTHxMbq8gqT4/1ip03JZZ9y1kVnG5Nj0/LTxkXlNyYmgH5j
++KhlkY7DIM+o3CnTYv3ikwvzIuPiotmnmhISG/OjxqXlp8UmG7OjdQBAczlzG5wk+AJY2psN1WeJ
+oItxs1m4i46aF2Ea7gN3XQktVQvIvNjcgGm4D9yVk72aIkSgc+YggnFsStUrtAgcPHjunPDquYNE
+4IRCoRCBDorvsJKoJxRi8e7GwXMHAUQzWR5MktUDFsOB80Jr0EX4zznuuXN4AGj2pE6DTmYKUAJA
+GyyeFJwnKV7OvzkKEHTK8mRdGpYviHgh9hsQFvXdQFvNQUSHE1U3vUWVQ1vtlT+0OlI3Txe5t2J0
+92ixBrzgJLxqBtIa8RJVepXgdPDcQeFVq4wq0MKrqNeKBw1Z46GD8J+D59Dkc+cOSuNEkHFcLbiS
+cHFUFHbF4Q5K46HJZDxarEog0PAfPBChzeko3TwIBgE5AHR/K/cl+wzVIDTDMYAb9q1D6eYJ4t/C
+/vFLfevgFHszYJB9QgLmJWgo2mmuw7ykD9wNI2doHRpqno+fgTm9PfgZoAQl8kY/mH8ErkAFAJsa
+aavKj2VeA5Z+UaU+IjIlJTJCv3P9pfXrL603h+tTIiJT9OFT29unTlmP9SEhG0WjE+ZF5Exhpi6m
+fAjZ4plCddYzZTYLHFqI3hK7hVv4oZCFFqJusZOz+AxA4XHhSzTDvMjKWx4/J3xJeAuAfa8JPeYM
+8yKyh1IZE6HtHNVwDj1/DvONcwD2daAM8xzzIhAEgJjRTFwfOr1UeMeg91bhX1NNHdTWkPCaNFPN
+9AWw1W1osQdc4Vo63Bluuat3HTLk8IPbZItZ1t3d3X0ZvV7mJOJSQGYeny+qXTxf5FmPmcfnkFrf
+B+66kWePowxzJXm2uw/czSL7+jjKNFeaF9/Drx6341coU+JXZnPfLpRurjUvIfdMz91gMu5r6Hm8
+fnzP9IG7M8gzgIIAQJ/KZSDfes+QZ+bbcpnsAQteexTwKXMaPZZY/q3yiZSzQ9hSCBRFRkvmDkZd
+3z6Z+4nJVUNMXooFqfrZNdOm7vRy8jwxuarE6OW1wJgyZ8K02t2UG83mhwdqQiPlO4YOSYhOl9Gc
+w2eyr7nwS/N7VJX1vORS2+GXJC4X9iyCX5pP2L7rWcQOsn73gXmv+VWmHX9HQx3M6p1Wwexl9L0z
+K3HpUnh3pPkZ83lOg79ndVB99/Fqzru6rJpTVYv0tLPvLjpl1hO+0s+mJOy05yvCzv58pe81JDdn
+mNdLfEWBPVKISD3oyjl8S50DVN9XQqo52LxYutNUegNUaBTCpu/QAioLvf4d+Sm8Ko5nR5uSFZw4
+lLRSNrJKm2rAv3rL+vYQ4jSl9SdOlDEwdULBnXkU/c1tI7xLpjPpTKSJngxRdWvrLP9jferW1i3E
+vy2sWwugMId5Az0qY0lMlgrzgBCK8OxYaMAsPJGSpHb0SFZxMEwM9uvYIc8qDqISgzzcOnbI2Onh
+IZFqaAwzBI4bMy0iJEBDGcIC/QPHjcE0yAwi8o9OlH9EOQj9TM69dDfKOYrUfzVDNhroRBlI2CXd
+71s5jq6XzSfyDmF/EeGEZolHhWUwqdL1nbdaxqK76NLUD8/p4vd/vaYKsjBtau/TLrL5T88syh02
+XRV4aEpx7rBFxA/dV8c509tk84EcAAVOeMeCeqjYBTYcnnnvw5Twyw8/L0x7/uHL4SPPwjTZ/HkL
+NjT6BgT4Np7NnSfVG8b1aDm3MIufkBnL6IheJPUi0qioHHSwA96lfOFfyAUn9Q7h8btqALhU9jqm
+Q6JAYbct/hscEnjw0puolQtCrW++zjMfCF9SjwuVlKY3nhdz7dj/Mjr8d0rrX4gj5LO70cGz2Ol5
+loqBAqLhGtGXiUj/dmixCwMaAGyQ68z7fAGzBP6OPPB3qBKeB0PwdxG0VgnP532+YAjygL9j/QYA
+2RT2OpHaFVBvbfhDzPPUXrroYpcAu/oeOtxFA3ZC3zLa3HOE5+kdvX/i0lC2OMtDUlwOwYw4jgK+
+2w5/ouJO7EGccI1+cpnQSLX3jV5GtQuN+O/gQRROXTY/QHAq48idkgOpy0NuvKbXo5nylC9Xba1x
+d7f2EcC1PuScU47DZx3g4YPmcjkHivs/B8vM5db3Deh5ykR3e3GA8FdSc6mZve7FgXgCi2TDAzSI
+f44CDKQZuWS0cjQiMsQYwADGYgzQQC01AarRRlFcI/Y/NppeK/tbrqMB8ILb5RyYY6uThXtH0K74
+lNAAd+Uzm8FRyoXS0mvlHCB17s1fASALxzZ92hMuEd9EDwBg+c7pSfKdAi679zvmE/Kd8t7vzDe4
+C7hGPu0Xav1Gmusp8jfB8Nt7x+P+IH8TlmT3N0BhPkVvlv0tj6ThGiDn6BQAbOvDZwaEW2tSl5PP
+EZYabKTfjbtdT1FylKjDP/V90CW2s+FJMXvU+ZHoQ8oEgL1N+qJw2BYK9VAD2ds9ZVTtL8JCOhot
+YK/3HKFv2WqEk/nEmrBS/yc5B7JsfQ7I51iH3pbYthcmZbNLcNEDRxcyruir9nYY2t6+7T6xhbdw
+M+rzQh8y/I/AQkvNcQJPXD/4EgAA0IJP4AGASYqGluKWe3DdeyrlF0qPK1CiBTzPsxPIwbyFa8wC
+4NRMxkmy1ewnn5PJuusBYLWkvpXow5TuOyjVi4M2NBiYY829Zc30ub7CZuaZZsHU3Aw/bG6GPttQ
+NTy6jT6+DR5F1eh5adHn7XqtsL/KLbVt8bpS8Pz0Bus+vYc/Az2hC6mXH+EfzpBQhDMJeBhN7RFm
+MW81Iwi3CJ9RDzZT3dQP6PSrKEbo3Iaq8VhqC58W64Vb6lrJSZ1cvMcAcA+z14ETiCS9+qVC3GJj
+Sb1BqshjMoh6go/aBzuLZREKqY0hk5ESXVMTnYKreP124MMP9/+Oa07+vu+jDw/8hlakRE2cGAXL
+vFIW3fp2UbJX7588Py5wwe6/Ib1xevgEnp8QPn0jEv7evSCwhTZ6JS/69taiFLMZy9CcuM8ZZP0e
+AMjy2OsEPgWFExDD1LhZBCuWJDSE6yKVCmMsNGhSaOy0VUuhE0aTka2hXoHhM8ZTDzSgbzCQ6OMF
+D0Bq/AwY/jKcGD8HQ7sociK9DXrDZP4s+hTdaJzt2cPzPZ6zl0MfGHGWR++i7l8nxQfR03m+b5/S
+JPYNm0B6z/0KtGBobpQXp6KeAG7Lg5vVQMn4enkCdqaz10xP6EX7eHk7R9AazwjvAPdwXK7l2sVu
++W35bXm3vDud1GxJzHwn5a/b8psp6elJEWIrLaPd4RIrmUVSBvGMeVPM17CsXdDu73zjwoXXD+8V
+wtbDET/fgS/DRXARfOXO2Vb01suhMAm6QB00oLfQR6gXvR36MjS0ToB5n8FMuBq1ooufoS67PkGr
+vSJpJenj00h/C5da6IQ8DxafszKpH9Bp9m/CDwF4B2DuJvb6IHVqpOePkucJUj+jStZFen6HPBfP
+XDHmkYR3ggN2PFLMwyB3AwSvkvezpZji0+zfTBEIxrwASk55mRIXYVExYkIPCfQ1GVnGFUv1pDs/
+luGZmopJbY2Z3nGm8LGVo9DP58PDw0eOfxB9joRVK2E2rFmZolg/Jyk+PmHenEQ3+pFR+rhJU8Ma
+0Yv/ZZj8ffN5dfigMLFyE9PxIEMJsfCRHS5OtWORsWochOUqkQ+3oUrmSy4GqEmklI/Fe2pXGUbG
++VEahZxlLEVNpZBLeCM5e+Toia7uiRkl+am62LRB4YYt6CD8Haobto8u37Z1Vy16Dv01bbJzxeSt
+D03kmwrHPdQ2NAnS6PHezWg+Fw0fp+CEhftOVowODjsw48H3vgQAghQ0iq0Qa69ATuaFlRhdpAmr
++0ovAhoxSjNTL+7NM06Yu1NvbEFfLIXHe16Mcw1WZ7ddGToLHrtyFv06uJGiZy54k74yt+85qHk7
+dcbw0rhBGy5Y47wZLgToSPRRpE1/kuoKRWrDPCHO7wpV48bC4vZY+/jCaY+s5bi2j6c1Ny2pY+Uu
+8flzm3I1OieP9TDg4MNic9/OSXCc2M+XRhO3HS+i6Rx3d9/r4SoPTUJSgLbqyK7dqMfW4PfBqr5h
+lra+FppkiuUcFSTlg49hFnDeRMYXMyAATbT9UDWOaDSkmIyYhlJNXnSh0MwzcpTTmLNsAxwc79M2
+58vXaOo1HCG+9wK77xjU8Ohv9P3OD35797uFNcPmHViL48Lf+RgqpZ7TLkwh8JHkPWvHaY0lsO9z
+a6NpdBBH64n57HNmvtv7DFMlprOTWOLPWYaLI/cQ3kfcRxp3BcZNpVNMRi9bPjj8Cu5hYpI/SY6B
+u9AsJjrhm4ToUfYZ4XQeHLX24MG1yIgeWb1r12q0GJ6EPGyGJ1AFWovWSTlQp+jNopwawegslQzU
+PiZ6M3rjtWc74N3mZeM0dS3150me0+cMM1j8O9wHSew7aIn+wwFs/fuvU68JbR0d1IoH7m27ztPG
+pia03LHVul2v9J9AUP9e6YTAcENd0iedUfbrkT51QvxYa3t06LXLrjf6V7E+FKWLGA6/wI3RnXGz
+SQjSUSW7W1wD1Ny3ZoSO0qODe2BPYdWcqavhrT2IM80vDcnQoEq2ihdOfLbg4Sfgp7g+ROvowd4q
+C24yxdylaGgJMxswrUzUlUqq66KzY9SZbdcKZqVkjlpWtI8+yfeN8h87v4w/k75xmDouY8yqUY2T
+64bWx9Xa0bRkyzCkRoiUzai8NXqVliFBTHb0TS50yjBmzlj4mHAX03gw9ErKQjOyRjbC4gQLof/5
+6n56sDIr5dnLlZjWC7660+SJjj0ybIqF2jcvfhmmWeryY70DlFtpYTepezA0V+spow+zjyncnWl4
+2BW4DfHwGiJ34QwecqdiBhjkcirPW36n+2YK/k9+U34TX4a31SnodkpKUq4zUIWoElW5KgbW6O2K
+vWokcay4o4MduWePsHo1emSVmNjwlhTDKvJjFjDFwA1j3lpLAJ8+KI8g1bks9dc18IZd3cXtlQ9C
+pnWVpfI7qfd46pbw1FG0gRpS6gnpnQ8hM+rFNRaFd0kuxxhmAVOIeUqEIxvpF37sQ90gjGTe5xZG
+4hBqvKzCjo/YhxP/9q6V17YwOuBCMkRTjIrUSDIq9CGMNdVkjIYq6oTILCnl8xL/3AjjqasVFyC4
+3fVyN3qFrt4l3BG55Y8HN/LimSe9SRUAKKXq4TgJTEXOfxfPnEZjm+um4uNPn8SUIJz4esqLIgv4
+/zJvckp+dkxqSNtTs2allBY45k0urbtxYepyv7i81atO7a9aaSR5k2K/rs+YWuAG0gCIIOdHDJVU
++UHSeVxn6YelV0lyKSnKS9pYW+dnRoR6TTVlx6Tkz0MH4bVBLQUFpSmzZj3VFpIakz1oXKwHpN89
++MYIDFXGmuy5nFP8kFGQulSz8I4QjMbBxzx8kozGlVX7T61anRfnt3zGSzfqZnrIILUAwsHp6WUN
++zowyIkmf4IXP5TO+jCFpPOD1LSLtkZTGSz3pCUZUuljLZ9gcYVTr5xsFLYshts3RXnHyDMDg7iJ
+KckpiZmDc5LmvDCTmrIYTVsTrez3jTc6MWcO7QaXzPUMCQoufMvTySkheiX6Fs6aO7fvS/TIPA+7
+x2LfQFQpS2DECEQLa7IhUsScTKFRWLAst22riGVmiUtijCm7dNZTbakhbimlBSUNGYfR/qMmgl+3
+1JC2VytKs9OjAoMYXV8pdHOdXnfjtZrVeXHRC2L/OVG10pgR6+OB8eupIvg9Abmg5X5xQ1rmP3dr
+wUxnGUc/DwAEo8x3ZSWMDhgBiLhnt/UpflCjkOsHpDsZR7K9I6Hcbr/zRzXCKY2j7EkxY83IM9tm
+Ttv5WO2adf32eSl0gRPQEfTX0robL82wI9BEk4amdm2laZ3JbAb+RKYtk0eCOZvvkWlxrxMwB/zH
+ItPa9cKKpMFC/DTN4X17WVp8n+BAfA6vkef5QDwf3txR0gsgwD7zS6QtZ0otgxFQoTepa8VeeXwn
+Ff+68D31MWpaX4m8YYlw94JmHWmRJ3xJaXge6ft+RE3rx8DuTsogHPFcR2SRD1mKiwPOwJ9UfRYr
+MobJOJbBuiDLiFXDQ9U+kNN6WcMdOPjV7KZnolPnzE+OodygF7d5C3oLdR/pJIJJ6to1wv6cGUx0
+Qv1sY3zsJDrPp+mZ3QcPrv1pFXr0+Y49MtkXH4lSyofX0UQ1EV2WLf91cZall8vnXBMXD2QkBoTC
+8Ki98K1HSY0EtQaNXARFGUpTOOWD7mWUypjxjIxK+u035aaD6Bb69uGHOuDdUcbZ9QnRDFpEUzDn
+wh6ezoOD9h/8Bi3g0ZMXPvroPSqKFw5+vmvXalhDQTgNwutPkPOTBQD7OfsnyaH2UXtFGJU+Mq2R
+SPtSfIicjtQaGGo0nzcEZv1U5hV/IEEdPesf3Bz8TMtJmM/803IN3siOM4OxTbUVDybWCe+IDcJh
+MQQneM5qa5K1iXuPrUxyGT0UHpHohcBAeuw0sixcKufooWIuGqqUJZG6YcFAh893KO6NLuOw6ZS0
+YzcZNQooHRotVEh1QJklcMOOzs4DqA3tR20HOjt3wA1w2vqR4ybWNKD9DTUTx43kvFH89ocF1LN7
+J6qHW3fuhpzw8Hb4Qc+w+uWv/TF5Yt9eesbEyX+8trxe3Ke7simMDqgx/4aagQ9txABH1srXMSWz
+zX2POvnbH10/6BMX2O/oZuBDHhH2oiyQPumSe+/hzXKPjNh0tN/ZTRTPe2KgmLeejdIZgXkY5AOg
+IVCYcPU2nAaVA42i2Ckj1gXMAWU50Avf+0TTEeumUlJss1gilL4ak/SIWq1sDNafvpwaoV01fhzL
+hOYn5sjrWubElB8ODZFNG/X4m+Sb0Swda4zNkdeFnl3wOPGNBgfnPcfKTYsWzUnKnoDO741av2Ja
+xfuzRyjCkvLXQV2iK+ObxC+dlbwXFu4n3707b7BfaGLBWuF461DsTtVGQIrUtB8n2fICpG75koGB
+FZm7pEjIOLpZstzBerQXHfzQYrv78MCE/ha7uWgW3BO15x2r2W7P1T1izuE4LBMCJ9J/2qLvSRMp
+rDNZpBUYjvbtn9NpUVU651ZYdLhJaBrcr5my265w1d87p0j31hj2U/ZXB7nLkCpW5Rf72xLBi5J7
+MbmwIkm9at7nb7wqmL/5jDSGgaGHVujeOf7JtWsTfqkfj8Wu996Ec3ETGNzQ9tx/UJcwA10AVvnu
+Z6DB55s4BMkFLRtAb5QDau9XuhF1SxYVNkzNuVdxhKlMIdTPlL+W6ek8fdrfv73bT+JzsAHb6oaR
+/zSSHlIrWYEpph2t/UmyBCMNevG8mBtvzkcj6avsr5gjqhWplsS9VCmvhIh3FuokaXLGaxuXvFHw
+0Dg+NXOoNh16o+4NDW8UPVTFGzOGadP9mPXHG6b4qn+sLkuv2Y4+5wXVY41T1b4/jCtLH0/gnQsA
+M0i0TUZDJdTiAm801NN6ZhA61yA89/yTT8KiJqqdf/JJ6nO0j77JCznwxd4n8N92AcCs55RABoAz
+1Co1UE8z69F3G04JMdBvwxP0F31hVAvdIbxFeuhXsDh+KpC8CyN1+B+t2FXQUhsabwRTiua0UUrX
+6L4f2+jpddeOv6NbcQiGroXL4BlUgt6eM2cWlS+cp+58vqt3H/Phof+cgwvzctEkxAqH0CS+8wC2
+7WF1jpkO/CxzibPpIjWGcJZRekGtUWmkt6L32+BG91BlrPBXG5U2puJB9DP8GYbAybFT0ZiVjRyN
+lWsqxZAcohd86Lh03eD60E0n0FfCLPTNXRgk1k3MNq9hBOYAMBIfz/8Ll1FILJI6OyBniaoQOcvx
+txw5y8oZCWWPqNVw2334CeuXuIzwk7yHHPgJ+jjJlfU3LVpksXeVSfpVUj/9ilgMjOoU0Qgk47Q4
+bEjhqHTZVK7UzP9sWV+bUu+aMnd7RcebTRMdNTCb/tW3fkRp1YhMKu7sIK/oYfH0xNInKKq/Subg
+C/OSfF5ivzHOW86B4Q5+DRmQi/o+ERK8rPI5tHNo3BHkNRPOd73IyEvGv3x+QokcLu7v0UAvHDoE
+8+G7P6w8dGjlD30N9/gxMDwrMTy0aHMda4GPPmtv7/fiQCew9O8XewgE9e9X0L+jmX3PgH5ty0TU
+4UYBvRWyYof+ZKIPAp8nLw5EivxU9IkAXyvfcbbz90BSr6Xy/HlqFnwcnqBmdnUJ4DxKFpD+PGFC
+6fB1vjeI55nFyAf+2LuZ3KPbUSV3QKp3Q4nVL/FAGgV8HB6Ch6DfHsShNwvQm+gAo0NK+HNfOU8D
+tB0u7K3Eze8xLsagSjaNKQa+IELMouuvveMmQQYHAhwzzZGG4A60AB20NURDl3hqen/KQWf5ujp6
+lYXaALDHkVO2w+dIjDO5DPDUNBBme058rSEgzPq+2JN3rlckvZLY0JexiXCp9flq6/NG/BxA82FU
+yq1jr5OKLcTNYleAJAeKacEapWQBsfQuUmhSGG6dc8DYZUgYfWwsaYGjYzX7VqF/qCcFxUvo9XbO
+ZZ3wCk4P0cHT6KGC3JdH1IWF/TSxaqjb2Jtw0vYnhXU8z3zQjRO2ulHIL1bY5xLYlxMYm9gADKNl
+rcAXDMl19/J293L1BQrvCM7dT36nOwU3lpBfSQa5SsUObz+wzWc57bbVc7mrczNd5ye/eediX7cc
+dcuvdSfBXNlC/4f9k/zpGsgx4mHThLIKLaUJpTUqjZcmVMn+JXZre4D0o18GOZi7ogydQnPU1EPC
+TE/YCXWV8PHvvoKH4Aw4HR5qQ9tho/D6IaELlpFYWfMN3GNXzgGjzdfh6E+UfB/1ku/jS7jU5vuW
+i75vCsSbbzhnstdFD5kJ70eK0cvPkniYIhoZGCltT6OQ07hAvKWGQvwqbvj+8uQudkzXsMNrX+hs
+Qp8dGjG7724XMpabrrzDLqh66LGTO6qSvplYdRj2wWwYjjuVq0Obph754oyXM898gLqFh9B/L+3e
+89Dex0V9DwDnMxZ4oi3uyXsapitIJwNJqLbCIxvUd7dLOImjFroocxdVjv8dBq+eoKimYy2HRqCr
+VdufOrmjiqemOTZORy4nKDj1yBezy7yc+c7tBBZoPgyArJi9DkJI3EcKY1fWtT+pUt8RGuwSgo73
+o052GAr55Q30Gs/DDEdytO+/7xVJr5Vo8We41OH5aul8fU+ep5hvcDNlPXKd2xfYLebF0VtE2Qp1
+Yf8x5qqkx5wWqz0DoE3FPDRN7r3NWz5NmGnXXv7Clqcp6uktBCW98cwHPOZ4GD5vALg60a8fLu0P
+N534VANAca6Pm5eLnxzKfBgnJkLp7Bvl4aT1CwvEx+VmyrVuL3U6Sc7JVbjt9PBscNrisSOgwXkx
+8G1QJ95O+ev2bXRb3p1k3T7JOK3HhVClXHuqc9t7Z198d5twgX18nRC0Bn4Na9HTqL3v5a4uHvc/
+o77DB9sf3Xn8i/d55sZ9Yw/mEjnOmOsO3V29nGV0lLuWDfMgFtpr3fKLySDXzWWn2w6uwWkxYBpo
+Ah7qTnrG0xPCmoEjFlzbhQt2EQvYVtHFvURwEwLycpUqL4V7lJPWwzUqkINeIT5+AaE4P+2z7vT0
+m9e6b36GU9VyPTxcGsAOt8VyWUCDl79P4pWU7pSUO93dKUlQIadU3nqNIkLaOoU2ldJqwij8e4qX
+ghpcHUSVCjO72D1d3/Ph03ofnRbOz9s/mh2OfhL2oVdYNXPNE+8jKqZgDnqZgi/CPvhq/1gJAPEe
+u2D8iLXXnS1FzBwsLTZXB0GFDgfs7KQWoNjXqfhOiifHjRhfUCy1YKcAjqG5y/E5XI7mHuNpLbzA
+88JRqtryL8rtw62v8niUxvPwDV7okuKJas032Ar2V6AD83KVQQHO/kxopBcIjeDUEa5eQBElv3bx
+yrXua93y2wo1xp/S2X95oEK1PNAjcqt2OaCbPeqiJGZ86fYV+e30JHjKZWhFVReIBlHmC4ACEESB
+KBLBo04bB057yxk5VUO6ApEOEQxuahAartNLl65Ga9CHWjoEafev+WcQPAI1RzvhavQcuoluopcS
+Xj+3d+/RJ9EP+anQddfG48c3ePDs41rty6fXHQvKRoff+/Kl2U8ff/jgqab1q1eva2u20CQ5rwFg
+ea6vt1u8iz8X7+TlEeXjopUzIMrXSUvZzlEKdiHg/yWDXP8YF2fK18XV18/TVUbTDe7sYj+VgoYN
+SpCY2a1OuYP/kV+UX1So05OGjq46o3ZxdnH2VfsyMvllmfzyuFzXkKCRQSuDHg56Koi1dz9Ag70b
+AqqIRmbjEALoEk5eIByi72Pq3FzCIni6QChcxwM7XiYQ3nQZLlVymHcB/SnF0IpTwUNJCJUR5AIA
+jMBovpDmlZo27pRyKM7MM5oviI9BLnkM+uHokVwV68TR7q5e0F0Fo5S0Z4A6TmI0fZk3Uy56paeL
+9KCOUXoxtK8zS9H+DTKw2FvuRvs2eKhtuJHwkquVcayTs4unXOHhrghx8wpxUbq6url6uShdlC7B
+rDKYYRmZnPlZDgWZc2amTO70s5z9GV7NlFv+Ny7XOSTIU+7p6RnEwBrl/U+Lthaj8RA5HmFUIzke
+RSsxRrvY67ywn8IVAYf1vcfzVNZSfCIwI4P4bubOEJ5elOvl6uUcFA+1av8oP+8o2svZC2fcdd+8
+djOl76Yc81ili7PMY7mM2uHr3aBaLKvzD2oITOxOuXmnO6Vb3i2/djEpwnKBW8LUbRe9SmNgGvGt
+/fbbNau44WVl4q3eJUDbJT3c/hrHew8GiDdyiLPqHw8k7WkKsQEMz1W6xbvI4p21gImilR5RLlp5
+mOgzExd1MxnkerrsoBQNysWMzI32bOA8xG3sTulOynUNUY1UrVQ9rHpKxcIa6OA/E7m1LAUvwCIQ
+sNdFKf6D3njCtMG98Uh28i7RHfrHGAEo3r/sdeAPmnLdFD5ujBfl4uPr5hIgv3bxs4vyz7rFXkU+
+MQoXNxlNyZaDugC1Uk67NXh7LA6Qi6fzTve1KwqvdJEO/VxCFAov1xC5XOEVrHZ2CXZxVQQr5L5q
+X0xyTj+ns/KfZfKfneQ/j3smJBDCGjbMKjdbyI38gB6Qcnng5Cpu+JxH9WrhyRXUlWNwBWzZDU+s
+EPYHrw8q/Gli1ZQ4ZhHPC2HUFzzfO2XQISlOSpbDXgd+IBToQG2uKiJC5k5FuWporW9QhLcHiJL7
++0bJr2VmksuyT/yJm6y5gMUeYaE7XBRung0eiX2Zn2V2p8jvDB1ddVrjv0PNZGZ2pwSc0fjvYNS0
+b4P49bgkrHupxGLnUpATqXauMWjVYoICVBDOSw3FLX594EE0Gf1E4pt+QpPhfujb1TXtyJFpsG3a
+kSPsdX4kutHTg25M5/npMKinBwaNvHzk7t2jdGbfxSO9vYcHiLnCstJ49jpQYwnGI95VFu8CVEp5
+lKvWi4NRLp50mK8kGOBLWnTe5soVbs402+DBLfaiGlx2qGDile5ukaMk2UUGaBR2tzVX3cNZWChl
+xre0KGAlMVetFzOAIAcA7ibhc0NzlV7x3jL/KCc/cio8o7y17oHya+I5z8R3AJamnHf4Uv5K2rMh
+QL7Yl5E1cIndKVdE0upOOi33kisohxMRFm4tZe6jxxByN8nJQKO+Rb90UT8cb50GCy0HBH73ziNw
+I8+jpY1t1Gpe1J+d3yXwLcr194r3puOhlnOOclLHq7T+8cDTFUMZFigd3T4M6x0RaUoZFeCjZD0a
+GF/5YuC9w9mtwTWxOwXjjSAuVzkiKIQJoeX+cr8Q3xA1Bt0Z1uQ6h/qH+iX6JqqZ+giNg3fcAcvs
+B/AcRmzXX+uIwC9+2iRil7fDNZDsql3O/xB5fllukIuMZbzivUPig7RUFCMuJVBaSqjdUvBK5KKs
+5k0Hi6sBvvLFgd47WLcGzjXxiuN6NCEgJORf1gPtZLl7qEaj0HJXRUqZ9vckuzXBc+L2EJKxJ6Ik
+5irRm9wAkA0msqc/jiaxSNRiwcVQRi7K0xoV/WRj19mzXY19k5jarm/hVDgKPYUO9H3ShWbz+x7u
+3AcXwaU8cvkA5X6AFRULj3TOYa/LdSCO/ZL45josuho3iMS6q0FGricX5eQd4cbCKDUWXnwtwgsh
+BHfnBvUWJ6aBXSyHDYBI1ynodhJ8xtPPIQIiFEtfOgy+XKOtJQj4ljqNQfy2sessam+UlDY0/KsP
++D0Pw2xgp7f7AaDUY+VaY9BgVVurCMdOO+wVCg2vfL0S6mCnpzCTesgPzUOnylbAPMhC/rPPPvsE
+rUUCegc+jp6h8g5R6agdLmy7+r5gePe9r/E6zTecPyD22SgwKtc7NMLTOzLCPQC6xAe6amVMFBsW
+Lb8jns/P0ruvpWPW6Kut8/NUNActV9fRgTvcXGUNTovdINYs8FHtTpF3y+9030lPx+qPHWHrvUxG
+i8FAF0lroZ03g+q0KLbdPuc/tNjo0Hq07sPzqm7GQ/JtiCoJL3wFE8+8Y7HUvXMG6oWX+zs5AAUS
+pH63TiAUzMz1UEcBP63CO0rJJoa4aeTXLnSLjAdznRhn0OAPFQ3yxYHKEHVDsE9iZncKVlZSPusW
+rzJ5UGB8QHBIVKh/iHNASGios0wOf5b/DOV3MuV3MsedlgfJAzFnUkiZaCwpkRptF3QsCk7Mot9w
+O110fN7PMAAy9CuEW11B/3lUiHqI9qaG7N68eU+Rdvv58x+Sg05pcSNdq98ZkJidQkKjRcQPbQQh
+IAoAJYnii8iGJj32xDHEH2ck6A4FtC6SkYvmyLCiYTP3rYJ5+8bBVc4tBVvQmabC2mS3A1QFaX7x
+DnpP7sLCvRPqsZOHrji09WJptq/bIKcJy/LlEVHqBWlLTls6YMBAqDpV5WJpggEAoC01ZUm+tNbu
+tBosv0jXSajC2rGOyqluePnUuuoRC58+3jgC926l3oGfPLnz+Y/RB+h9ftyUmin7SocUlmIro4FH
+dyfVuXtsWXJwtzV/ZZpD7VTJ41Lc0cEE86iig/zRl8dweBH2aaJbrCep4SpVy/FSJEm6QZJeocWG
+hTBZkkyJlVONSk4V588d08GM7Hi0US6fJsRPk8u7NHAueqUM3YK74EyoeRLHL6F5m7ds2QwPe9CG
+k+hb9IjNnv0i2a8hYmwJALJesQ+H3qCHEQPoouwAuqjfYngB3X4N3loBV7ZRtW1wpUMY3rcFbUJn
+Wz661cpTOdjeswKucgzKmzMYfc7zUDsY7ebF2J8bzGWmkFCNpXJYqoY0acyGhlRNmAeUaQ3hers9
+Ci0+qmp/fsWo4agtK+mHwmQl+nDnan+2Dq5eSAUfXvTYs+g8uoMmQzqyPS951Oftydr04CdqtOHX
+c9H3k5e7yx9q2XfAGmf2uBiv92/aWXFHB9W8CtavplrFULGtYqgYCoZzG8WIMfgZerCet9TvfZxr
+l+vcvgT/mN/z4uhH+sc36u8f3miZq39w46oBgxuxzBBuvsFOFGMD1WGJJOcwm7IvaIYvI7mMejWn
+mhuakx1aEu/ZwUzr6BDqOtDJqOKgoNaQiMpPjjz+pCX07ST6vQudssDMPSn2kZFghko71GigBLAJ
+mBGlF8fEmvoNQufCGqqF73t96VLqtjCIuiT48PaxfQ540AwUmIfxUGPFuRSeh6NRJZS3otU8sMXA
+DBg/CP5fg/7IeMR3QsYb7ZgXhUcWc8iY3bYcMkt0J8Z0PlPBmwHOITMDmoMa8bDztOGY2UziuVxx
+bWcwIRXzp9GokrvFeQM34Ef6b2GumAMVpJoHJHXjSFN4Twhx/dkQMfST9HsmnotES5sV0mgYfjFG
+Xx7h4RFRrh9DLSQ/yIM+Dg7auS3e4yAFPOK37YSD0CX8cY+wYA/+jC5R3mZwyuRDXVKZTgkHfUyn
+IADmUyYVOoBrzw5WwdNNwjXVYPQh6kF30YeDfdBWnof1PoNhjJlUD2A+IGsaL8VH32IfcaAZu/2V
+uJptc1tEXiZtAm2w8C9bztuDIq0oJak6AzpuJ+6VVkw1r8oY/CkerUNYthmZX/8bN9hD7xemSL2M
+eOg7dxcMlsZ0qrgf/dno0AbiTAnQDgcgxX8tuXnolhOOTQn9tzHvWftMOwxMH2BwCx4oEhf5AYmv
+9CMU10/UhKSleiwMppd3HIB+0Bd9j74T5nSgO9SCNWVbt5atofpgLI/OPogu4jY3zwittadrAQ3G
+ACA7Q+hYDbT2sJPRNTppXJvSpVeN6eigttlmOSCN/98O3NxQRJE0T+3pWqTk7fBE4fnYQGm++842
+8Az9RhbxLvlAQaDt7sCNPUiwLHZ7WwS8sUdd2+auz0lxQfPgLq5h1/J6Rd0TMAB6wVT0BrqJ6mHO
+nLZZo99ufOV8w3qoG4L+i/5Cf6NPMMzzzDdkn5CeKiEOMBsBdvCGAh3GCpD7EHFmHkHDJXeYROkl
+CeYj5esd8OyEr8dXfyOuYc8NGIA+Ev5A16H6Onqcp73sZBYKpABAzg/pYQ1Egg+DBr0yAaaG622U
+r4Wwp3HGBx9MEV469Puks5C6Sms7OoQ1q7eiz+jSBxbCcX3lPNwYshB1iCdgKgq3ygEkP7KT+B8L
+wEabfMCVWZ/Pwc8BBMEoncWx4RG2GqcaleQ+VskBm6QzepGMBIVoY1AEdx2kADyFRuYeaT0D1egx
+9PWwrsaW2tcwU8Qlj5c2ISWPPp1Ugb5+H/2Nnjg9k4c0dDlxEZ29LrXEFO9RZhqB8yDWhECDZKuU
+/OBkz0msoBQcLdOoND5SrU1KEwoqcGQ0DHiiTlG/fFcDB3eheS4pOevntrkeLUH1OJSG8oIuMGoI
++nh9w/lXGt8ePattDjoCI81mUGW+gfs9yTlQaYGF9AjlgFjfzQsA6h+MK/AHgW3OWjBAjACF72bZ
+g6R/RqztdibxsVnQjvSlYyyXURHSGbBe1mofJxm+rh2O9cmoYjpMPBbi9T1/fnn5409az4flDt8t
+HhRS81h2hdCUDgDLHAOYUmzAYP8VEqfoHWLHrW1wLCeXp+2QixezBIHlbiY+ALHfbPi99OPQvtFC
+PlHP2chH6ttYe/Ee0vngbSJFxq+bbSUaSfY6SWjmUULDDeAksMlk+PkjEs3/DYDlPuZ2E1ntQfMX
+Xhx90sLPifwQeB8ZBQvljry8dc8epJomly+yXmYf0zrC+bZs3rxF4uHcE0T/i7LTRCIUGikrUoNv
+h1hotwn44qcVohVBcIeLiHliEU/LqzfZ2xM2VctpYlG4AzXjeH4c+tJ3JeoW7QpwpS+e2xsAtozz
+BgGYyyst0WAqbf/5ZJabxBu64F1wmecxJcGe9BKmeFBAJAva8DvZjt+XoTMi1RmXCYWY4ohvyTma
+/ZXkk3CMqGAr7tW+HR31ztGSo/6zV1XdVgVc/fJn/+K0f+8FmCR8JunhX8PYs1cd3Pd4LzH/rmQK
+STyE0WS8L8PQReocYaTjFOv2/isL2b/J41IH7jJLbe+gRn/WtqsfL9m55huphR2PHpX2gfj/KEwD
+zrRBjzsmQYNGBVN81JaUJFvzPLwfKiYGvgYv/TNpJ9ww96VnEM1NRfQzL82FG4hzY3lcvhbjSpsf
+h5YfY4ehcniy5zQPhQ0z4DebN6PgGRsQzfNUZDb2w1PTsoVPpLvYov/ReJecYZIz/D+BQ1fCeBjf
+M3sxDDIdbkXHmYvoeOthEwwiisqdIK0CK4AKbRC600qfRN9Cf3wNzS8ugHmNjairoBjt5HlYFYFW
+Ywk+Ah3jAY3tW25/E7zEAz0AzgyBQX1fIGybpIRGk0bBPY9x1FcpYWfipIHR03OB0NROuCEhTmgX
+kYX8RTzp9fciSjKBCUooZMYK+0WbwjwAXIYwOkdYjf93WNlHMAKFERLqRpUbB8Rd7xFCVothECdD
+U0VMopdFJMKwsHvRKDFh5ALnOzmh1YT2fQFw+oq9jk89cV1A6/mDOHVECumEFrqn3NGezbAHTsvE
+pvROGF/c0bipvqggX4Me78V+AbiGns/37aIn8nzfYerzxyP/nlo6sq8L/cnz0JXkq1Q6vSz2IJRW
+rrFN239S1hl3aEa1aN1S+DTkp3XAu52wMGd/zf76tJLBCtT+N3qX52EyTl0rZ7VNPdPp+IO6t/Pz
+YvrG2vu7mAhLzxTmW6YQyIA7PmeMKJ3JI3CcjSlCk4CLYNGPCd34nqJUMLuvhxn7aiWDy86wFRdJ
+a1vKHccHC3+898HZ56/zl5+ZXC767GU57K/AW9JBnB1XAb2pc9vhTrh56A4JV8KXt4W3cd2OPi39
+uoiinqvsBMnmwt5kCu8zlgb6UVPaoDt0zttLkDBEjtrRTbQOKzNCLbXHsnr6pBgvIsuRzZXr3NvY
+u3CpF0d/JN5peA5Sq/oc6wqAF0f/16JDcNMk+6Od3dpgvX9UGru7gHpTuncqYBy5d+LQB/aXjmjC
+3tSOL5x2620j8RVWYekzbrnx9Q7SheWW8XvjIAXeeMP+goFTpNvlsZXkdmmyXC4Jfe9LdwsA7Aqu
+B7gDA44xcfZyGyjGxNWlX4jJ7Sv3hpjoFZYQE2Z3u6AV7bkwApVbbHkL2Cp7W57VekA/xQvvEcsB
+TEaVfW9JvhsS+6Kyrdoi64gzVl4WI1ngcTQWT0r92A5/IJHXunZhK89T9eTMZko2RJWtf5HjWMUd
+hw6J1kSYiy508HAxD0uwURGd4ZEYM1mLSrl8Mc7ErhOrJdBPdb+wQPsKISTKyjmgstkWEBjJafat
+on4X1llCcUi81QChgJa+2awboyM5I7QDDEoNyWPu1yEUz59HYUGvgxnJozAKTn5u3ASHhiTwe7Sd
+lyyo6Ks5895ED9p6g8Kl62bjeb1RF7ljk/41xkZhCdv6vwba8N7yaQj+H4JtUDEO7+ofcIN54y1y
+3yb+q71VoR0ArH+xuWLjCXrof9pdsSmhv+kV+5vQQhwDJNeBZeA68TetkuotLMQ2WXkkWDYFPz0M
+gNWfi+NEQgfIEOuPUymkxRaLYcFg0UoxtsUWjSGhjERkWOVvbCsNGdBW6oCje+ylFozcYzMVcSCa
+TQEEpVLMi1bs1afSW6e4J6jEoDeQwJLr1wcMLbl+HbsM69rRg/eEl9A/tteJfmf2pNSbl7BbjUoj
+TcbeMxlO64cz0UP3JrgLbR0HWcPMpi/bhbZ+hmCe0beLe+QGgFOuGJ8oWooGXpRG5cZMHjhUBs1m
+auHSAaNlPugWbQIAcEuYKHEO9r7+Ao0qmJ7/wIDrQHeoPhgH77Vo87TxWYfYgQFtajZaU2gUjuFT
+32M6Ez62hlCJcae9QRKR4XhUBztlyH3GtxveXs0j5kpUf489WqQuovaSO4rE+gbdM7a9rdgecNa5
+XXCI/CJgo86PcAyNBLTYu1XL6P73uFaIGRceVdxjP5eM0iK44n3homSvk/wRfEEk2W6IJKmfSZLJ
+aEjCTDpJc0/4j0al5RZLF8YAEeTSsnAQFvfBBXruwJeGGLArhQxZ7arpssuMDiQTuNQ+qiTp1kiy
+XBsiSNqBQGIQnPxc9XiHG+RdCRX4lmFH7qG2DHCJiHeM1MRK5JOVaKEUm78MLiR8chN5XoEWSnXQ
+lmFrmZyjTxC41agLxzIROc/iH7CjWJWafWMdmiZS6tVLaCY+b2hjd2+8SKTiMcN+BnSL+Bnsxkmy
+I03J2SCSZI29w4FQI/E59KvVBM23UCXzkShf0GLauLWVLh40CQZQ01dA3983w61t6CPsJUCbiQC6
+g1rA8wKNgnkeHqNNeCxpbBAojWW5bVU2qUdj0BigF3XlKBwJy0iD3inQGf2NE1fR39AZFWJJWXid
+Sud5IX9TO8L9HeXtVdTv9rAG9oOVlJEl4xswc3YE+e23MdBvv+0I9iX+EZ5/hN9EmwAELqiL2yLy
+FqUd3PZ3mLW3sgv17RGogcEnKaezaCv8Udy1l46iS+jyWeH2WdiO4vEa0ATsaxC+FwziJsJLaBDP
+UxnUMXEvAbrF/CT6CJKtckmAZWLbtuKezHugP3q3kWpve+ncanhwM/oNZoqbnNP+xuWtPNwMj/E8
++gc64d6dqFjkPb7oe16kPdnbJIZIopl+oVJiMIxKzYxe9zU1pYP67wUcA3P350tfWqgw1y5oykaL
+I9EtLlHcC4Izwz2eDXHgkXQCPx9um05NEnll79yO+XT+rVvz7N0aPG2YR/rZdcnc2evivWgXNWXH
+4pOscVU5lvApErYjMnkSV0WwL8ZQWfm8GFtlz+dDB/Ah2R+mfo4kEd8zB3AmSdi2+JNwTNkXIr7t
+ePI9OQw5XV108m/9chdETu/pkLWA+UqOaDOVc2DogDknxI5EdL/lslKSq91m0f1kvZxBrqN2Uy6E
+Hx0CFn3gqMTf9f9X/m6xFJCf9KEHBtYHRPaOb7E0Kg8LE//K38ly4W92/J0tl/i72udf+LvMASYJ
+pGEVZ8Y6KgjLxjzgUB2I2gqpWfdy+GGinGGRDS2ybSmRB6NxPf6BU2HuVZuY36WLzSp+WG/AFukG
+HEOtd0yB6fnNATkWPOwWe9Wz/dSiASFhkN2ypevMKqG02hZLLcb2IZuYIvaQ7OJu3u/MqUhKC75P
+7z1zXcLH0+Te/PcDnDl+y9M46cHhzGG/h2Zgvy0xnyv0Sfd6b1HNNLm88dEBPLhbNsNd0qGDFn8M
+cBVldwuKiqltHdim1MF5N/V8zvOs1pJXI2vFZwKmwiFyjm62xS9xBnkktbsRn5R9Uh0E8f3V8ki6
+WcznYqPgUuv7ZfJIep/o86ki75tfBkD2KTmb6y3jyhYQ39Ex+/w9fFZxhp6co1da3mNeFM+qJDt0
+2sVx/yLn4CjHuB34sO17qU7DJjweo7L335H6IBvweIyPHa8g78ty7+EV5H0brzCbxbwQhzoQkk6I
+43Sk8Z8CklZoNoPB5hvMJbx+KMpKUl96OUdV2OUqjJTrQD38BhbKSa6C1Sfjj5+DHuAhJ3Ey1vfF
++b+BS/u9L87fA4D1fXt8tJL32+3qoojvnyDvH3eMk6cnOcaOUKaBx2MibO/Zj8dE2uLYpfedyPx7
+bPm5Yh0XokOflehLzCsT8XGN4GOLnS9KxMdlgo+Tdu+L438Il/Z7X4RnPQD93sf0tnKA97FdcJ/D
++yQmWhp/G3l/hxXfD0rjm8n7z1j0MmLn8uoX4yZe17VSuha5oCUzkSTWinyB2LYU9/yt6EwjVi1r
+QIhVkrXFxopwcvsInLvJcxLDIMIJvyZwPm+l40qcdwvFmKBy8w3mOlNIupQTdw+pCZIADXJNCmsp
+NRgqFiTCTeZDrT1/mOu70WR059Ch6nGPLTpMBS+Eq+tYd/e8/J3oQ2eX5MIfkrJQ2/BRK54vKlRB
+Gn01omxfy/Bhcvflk6Fv7vVwhVdC4hPBgUHa5PbPRyXnFRaJudLxqJLknIST2rBWbi+nuVBdv+xQ
+b3zpUEfJpTscpZWb0IwaW0Lon+jP0cfQ3p8mVj34NhswUApJz9t/fBWG5wxH6SRWLHKgWLH+F88A
+QWP2t06/2LHe67Zrh4m1iyHDea89FlvkAKtzdrBPWH9QTzou00EG3yyo7eXvNHbYQMu2SeS8TRrf
+A8SYOdlBRgfiB8BDgAM8rhZ4BkCHnXS+WZiAJXNUSiR39LojbmySOi+K6USCt+CI6JdbpdydVEmv
+rGRnkTukwlJv19mb3DGDLJ9lbiTmqlqqn3ZD5sRex2cZm7fDdLjOYAJlzUUIpvQppgTakGpSqnHR
+kWBKnQ1TEyid5QUcWcm9WogitMn5u0fvvPv8jC4adAUX1Y0eVRg3KjuSrpzlDlOrFuV4yH1icqLx
+l6lJeYt5XuXrn7/2zfV0Lc/3uSXXDkv0DCkdNizM3Q1OUQ+iZQFjps1N0Y6cUD0iV0WV83xf55gH
+c6KTLbGFj7G/g0QRZiwpZzP6lGBKavJqSE2gSJlLTUowpWYTaK2GWtclgK6EiiWbOse03Toxfbzw
+xvjAjJq8VYvh3aLOPaui0O+KtCFjErEjj6drJ+9vHGPyMy5/p2Miz/8+eHpeyMpNwpMyb43fG2FG
+nUrs3yzbwP4OwjHeoTgPtPPfJVAGCSiVAuMrzIPCyMuAbFtRx+amKPR7WONEPQaqu+2nZ+cmj+W3
+PVK54sbxqWi5X1r1qkk5ISE5k/K6KX8yaeFIsvW+plUfHZp7tKXS4Dto5ds7UB41unh6fkj45CfW
+tT06SSvFTMiOs78Tn7WEDAsY+v5QSmiiWIwNG24S+wEo4olu5vmJNrygPDvA6DsiikR64h6TaiXj
+mgjWw6Hy9qC0YQmMwarn2PZm8aq8mozA8VTa+OknbrWN6dy0pCIBE0r/vYGzNq0MyZs++Heen7jn
+7eVGP9OYxv2TMQWht2ybQ+h6t3kel8f+TurKkUB23GkMh7JnU/oUBgcDezAyC0a02EGiT8mGXph0
+6JGHHqKcm5CPwmnBS2h7B6TPz0upqFu+KmushBnN4uq+pXDVaP6F1tyg4iUs/9JLgfmRvL42busH
+GwtL93x/YOaZg6tnlHgToPLLYfFLU69A4wdzHl04iOBoHqcR7ccm/T3osPu1uItgYbEDFtqB2f5z
+3zlRQbAhoO+nfrjA8+HzEourIVnGvv+pYVbaj9/7yoDHpt06x32OS1+gVCOt23k6+ztIw7H+UKQB
+fDSoDGiQjoWFGrNheCgTDIOgtC10LNSQY0NKdcnaq3ZdnLfojQfHVO56fdG+9PrHvnisPh3TJ/8Z
+1MMlUP8Zryha3TA9Cv3uGqVPC1AJt8Iy4vwryvbCt5uea8rMbjq1aOmJOuP+wc0TUg0Tmov7ynme
+9t/xzb6Rg3d+2aEmCwo3RXiHlDSPP/MYLIGj8Tk335BtIHuldzjnEjWnZjOieqKA3sFUSjZmnGEe
+lMMhn3r8xorKR7bxY5PFU97dReX17QrJmbSqOs2vO29STgijsxymiTveXjnIO2lE4+EPj7ZUGNTQ
+wKNy4YN1j1QFhuRPL6aMYTVHyJ5OA4Cbx14HYfa1+nHN8QF2lHq0S1Q0uxatdtxFKQ8FvRnsuHki
+3Uwzz2N/Ef2SA9DpNJE4tQ7EuEeqTmBHgXicG87B0j0u3RoSHfTXUS03jtEgy8PsYMaJb1dgiNFV
+ywpodwl0ouSVYXQZl7+9Bx7u+1Ray1u88KJlFRToNN/iPmd/A7H4hsOtfnzEwy/yotRsykTOfjZt
+4QWQAJDNYOTpOIq5OOPkkC4Y9urURS+jTROfO7JxenpYdpURfQWb8oafOH1mXM7KxlnR6PewjYIx
+fNSaybmTi+L9XZKWXtw5ZDg7JzPdve7p7x/o7N4/LKxw1qZTc4ZuXb8iHU75LH1CXpirKtTnjcLR
+fb/MfWblYF3hhHkNGQu6to7lZqJfLfFv3C6RTzgE5Q9wRRtNzGiMMIym9OY8jDOMLYyq5UtojCti
+tTfKPQi2nuElJPFrATS/Y25ii0SfA+QY3IU0mFJjqob44s+mw020hi36C+39sc4nJMyVHr6y98/c
+KqO6oOXpzyC9zQz3Mbrhq+aMCVUEKD1odAeNnw0He8cnpQZGjaudNm16EjxJeMFu83mujv0dpIOh
+9+fKlCwYqk1qmAAtooj4pRqndERC6R7FK06g6bp7OXVk4eRBuuG6kZXocubD4xd8u2D4Q8uGGmft
+njQHyvcmpjwoXFBGhihjsooDx+wtGFQ/s3oA7j1kx5b1Wap435GV6EL8hLjs4pDB9aOzllQbC/On
+/7Xj+o3eZ91UgYrIhADn+HhfXZAc75V5nmwj+zuJW7vPvav7lztXYbtZhEMD37h9p8TbZCRlu28p
+zJW4p9kfgY7IIaQAAgzGTnPcPCybMtGYjRo0uBdmNq2HGhy8oYMc5qrsHzlDp30yg5oVEhPk7dKT
+NKF83P5rzW95BIXHB5fAkspdQ5Lnn+p4oLBvF3w/KEyOhq5qGsf8GVA9pm9XginQeW5+Zt6LX/Su
+hlplRICCr5xZPLXO5NGzLdRXraYWPYV+rK2ssdw/N5ywrVYn0nF/JtqPkg1M/WYq1MY/VRYm0EW9
+s1nEDDuBlxhm3ykLBxDlSKscMs18k6tmfwGDSX8y8UxThCFqZBqlRqaxfMQEpUnNpkKDKZH6aJW3
+B2QIe6AmDImrXD66fHV1UvakxUb0hReaHA8fdoGKuPKcyKii8amGmqJoNG3u839A+a/PzEqoaCyp
+eqg+r/U99MuuKeef2DEnE343L2VGeWpqzaoRY5aNiFgOx5UHZozPzpyYqwkrmFFQu2NqSvKkjeMy
+po0wuLgXzFxf3vzsEpO/sWLRTiKfmy+ab8h03GwL7qxyFLloEihdRDBU2+GOLbVnmyJVQQ/Nomqq
+VqIqHGww0Yq0kTLvUH9CU3a4e9x8k4tmr5Nqx2L/QK1BY+Ga4eS4MnqdhbDUrCICR0WKB5NeSI4k
+/FE6kkjompdSuXTNlqKPfdMGZfg1UOVDtubDq0JPkNG/bOX4FG3p4hqHAzhs3w8Ha49vWjAsaryL
+t9yF9wl8BXV+4uw0dMPLDS0XVhda8zu4Z9jfgaa/DqDqJ9yu2yyk2EkwXULKZuvdZy+59HU6yLG7
+zRWckv0dJN+fUzFa3OgDC9NqHwt19ZMdd0B4oS6ttm3bniH/zBVmzN1rSh+xdX5exNBF/bhO+YGb
+2yc+0j63RDcdbUuDfnB+Rq5X0QOvr5rz9Ipi6S5wAuzvhA4s/EWUl+wWLHVNUXjQEoNZeQtmwxaY
+eXMFvhiiSmfn4ad5s0uj6GYrGVjWPmhsls6l71uep/1cdNmV6YRnP2Gexw1n/wB6UG6VHi3yspGk
+2SVwOokkUoIZlTflAWXKBFqkB3WwjBwq/B5T2E96HtJidHXOnLJy/bq6ke4UpRlcd+DttppnDq6p
+NU5/9L35T3vm1TQUZc8bmeAjzx09aeLYgijG2cMlv7DdJlVHhUcXBw2vTlb7hBXzUzxD5JktS2YO
+jwsrmL726NQHzeDNreH0qIUHpiVEDZk6yGSMSg0PCI0pmDJYpTcafaYK7db+AU7+os50Dy3dT2fq
+p08G2/hVmE1n6kdi/6YzPSPVsOonH93DGN/pGpAd8n2dAzJCAKx3k738ZVN8DHqDeM1a1TTxcqV/
+wfKDwrYs4XGLYIE5jCRYsBOswl7hSP4zUbqwiJH8WokPB7G/gALCh4NpfTaNz0miKFpQmGbUGgsf
+9g6mQqCVAWNOQ00ogWk1y4pyZpVEp45fXtJVd2hm8pgjPz80dHd7U3Uag6YkFFfo9JW54cnVK8ue
+Xf70Qv2cLvRn50H02+v18Lt5ZfMLQ+hAfUli+qisRPWgsAl1q4dMPNoyRBWbP37R4PL5+YFBqUPi
+8ioyYn2NkZMWrRk26ZHGwsLVF1osPKZsIB7TX76+r3HhvsYEcra8zTe5dPYXkEFuKU0YJ4vIpk0i
+YvpdR6EYGRpykUmcxigW4Me7RW9AWyc2hqHfNRPrlmUllqWFTHniByj/7rGJcRWNQ2v3zc+YdwH9
+hV5Bv12qh/+UhJTwp5eVhCSPmLF66fu8ZlB5SgNNjSx8QxWsdKXUhoyC8OpNtSlJNZvGZ0wvM7p6
+lMxuK2x6js/KW3Vh5bzE6RXGrOmrB5csGRXb17N8VWvWuPRACVeFpD6Joz7S3wRDdIbdDgrtDJ7v
+r8KK8dLYxlJKzkU00b5IBygmGMo9ICOTnPiR4QlQng3DTQYtJQ+m1AoPCsgxJ8ymqNEUOn8IwtOT
+J59G5kPnBer8IWTGnyA8dH5W/Mi52dlzR9KHYsvm5eXNK2Ov86Uu+ctfQd0X24pcp/H8NNfCFa9B
+31dacl2HIg1CR2qjOXoSF1mDaiB1qCaS6zvExdQCyjwHAJmM/R2kgCJ8wqRrOQMqRK2CXBVhkTqV
+xnrqROChvVgSxqngCjfpwg5rnChnpj5+a+2K707NTq5s2vLw6KLmdCjYHUczxiZcpcmdkJlRk6PR
+5EzIbG2BanKfF46kGvjTSwdlNp1pLl83Z0Sqn0LxlHRKD/I8vWLCypHh4RXrp03ZWBX1yHOYFi01
+SV2wl96uPjMbFh5Jyb2M4aGMj5fKm+IYS4FmyJ9DH15duPA9GAMbYOzVhQuvousONZqFxfycN2AI
+nAND35gz5w301XOn0ddvzCV7O9J8Q8YTmTAZZIm+uGBabTOcYdqRVDWR+sNTxV0lkgaxpI2knIey
+CcPm5B8de3TViLjS2pmz4qgb549B9tmJTbfQs6gSHftjHbslatj8oqIFQ4WVM9WJUX5+UYnqmfAK
+n1M1SCOjKwILFowylmdEqp15dNF96Oqutp3vrs5Kb3xh8M2OiYnOfWddUiYzU2WB6TNyIzzMZnCb
+/oRyxf51qo3w1gC6kKLY67hHopI0U1d5U7KAWVNg0AsX6Pcqrm6odPocvTyfvMsx3dRibhqgcUy9
+BlKL0Qn0HWS4aaTNNgQM8zutlUUArDRjdqglDDKbMui9PSi4v2xRaficQbO2VpbVlWgXpM/ayh7W
+6HOCKjbPGhSUlKut2TZVL/J7f6abWmebB66GDPoOnbDO48L8l27miDyutJIouXB0+mCaxHeLDFnm
+4jZlyxNjstfML04fv9CYPW1YkvNqNiAiRpmVEZiYox28fGoOx/y29LnmnND8qQWFEwf5haSPSPIN
+93PP1Qcna7z01S1DRJiqmd/oZzhvwJJqBSqNqvos1cn8BrVn50p31A2nGEYHYgCI8IDaUKmVh9rW
+BGegfjj0g6UnFqNde2BPcMjoibNWwK+zy2tnroBfdyBZdueCVYdPzpkMO6lYXtj+hj512zrKgxe2
+vz6L/NJXzu+uqZyKYUOVrIEpJHxeZW+RwMgR2yZJpjHWIAQTlzm8qxk8ZnrO6efRQUV4RsxcHO74
+Ls8zX/K7kwpjvd9/B+2EjYUT0nwPPIG0+Hw1oUquguQekuqqtignzqbjqnE+SGqk5SokV/6fcBI6
+1CFMPTLu8/rTrQUFrafrPx8H7yJZ7WzjsCSVKmmYcXYtfbLvLTI/PHukbPvVVauvbht+ZB2P9r54
+VFezb+mSfROjjr4o2XRQJbeOKQQR9mu1LO+elXPr0CGcRyeu0XHl4orpLy2rJNEC0uqF61IdLnaL
+1O9K3DMat862BqRbdpf5uwNxocVjZuScfn4P7KF9+n7UaKrmTqW+ETr43UkFcd7vv8P48jh/z+2S
+0XDwBPQE9jj1JVq3QvN/xGffvv+BzF7N/8Ik6cvKfM954xh/qNJIc+OFMd8L86iw3pt79sAeRkfM
+LmwYj5QSnT0v0hnsj2nHjWC39rxlh2rbFjgSmXCd5ymDuAF9PMFJNaqUFTP5wA3kY3srZh8mjADa
+ztJqmTaYUetVWg/agrEMmMAyxjptdkIgPOEVpVFtMPIzCqVdj52/fFNJ6YPrGsaZmD0PulRtfH7W
+nGMNOfGTdkz/0Sk+d8TY8ZB5IbaqfZI8uoiaFjmiZUwfL8KaXBjj5WesaNhby698ZmFK2OD5Q7Pm
+lScNWzQqNdiN7KP5BpfPeQMfECli09uDCkug5BgiglNW4vmEGXL56NCY3Ve639s/vnr/e41vHaE4
+dPAt47AElXfiUFPq8BRfRsejeTuvbyooWPc29Hl7XQFcgoknpvbA98fnG4LK2ueJZyETVbJ7LLHV
+4u4NuBt6VeaAm7EKVfLcFV5oHWg3pNigalRJX5X6EltphL7atwGPKO1mzzFLTFOl0zzOm1hy7M/L
+PcdTBDMI2tij7LrtCA10WMl5amN0feWWAwVjrZDaL+JH8YB9ypN9uclWcSNAgOjN8aAkOjFFKNUy
+VjQ8BEPGTorRacM8IKuecOijv/7bWVPUdKgGvffPpGRYnLsgAbqdNJr6PgvLGJUYNzQtxDd1TKY2
+LDuRkm96b0PRkI1XVs97bsN4xVU4paDkRvyEJL7ysv8Xj8xLja9aNWbszhXzU1Lnin0FK9kZnDfR
+leysoUz/O4K5iRGQ/fCC2KpRg1OD98Ce7PLaGSvg14RNvl5T6abJmrplCt3L9x6RLgQgnWv2JZEm
+9P9OE5M7DlLgXprgWW3TQBSBtLxE6+xIrgCkgVJJUradPxO+gbEubcyBkrOVnF+1B431AKwm6Txo
+lbTrzAuWLa/ePDV1/KbjZc7RptzgMUOzjLUT1Z7BBQtHaYrys3yzImYsmKUbdXJbDTqgyRiZaBzk
+xxBy4EVSMMzeP2PFJ8/vMFGsi7Pv8KSEWaZ5w4M9PT1CAr1oJ1fnei9DTpHGuWztmUUzOxdmMYbF
+q3dXESqxv0+sPO1f7pK+8wNeJPe/RvpigWjDv8E2cbEgEeTh+lBeJq3BYrBXaUVTA8aP0obNCBFH
+WVBEWecg4+QOw7rOszNSKocXhS2nA6P1/pmzq4cGr/ny0Hhoj0fYQHCTMGJOhsLfyxmuboPBm/h5
+5zaOYt283H9IytUpchbsHCMre+CFRajaisEDBCElE7dMSXPKWbWSt8g07AtcLOk6Q3Q1KbLT7hq0
+nOBU0RkhrSmS2Zs3VJOSnqJxuBgJZKFJpqTQ3Mbjc6IrRw8LXu7GvpiXG5GTmTt8YpbgZDncWOLZ
+KZ7kZcbK0rz8ITE5MwbrODcvtx+OEbtiJaqkYqU6NAQQUj0cH+PLHfDut9+gP34NTwnxoE/yvfyR
+o7wycVQ2WdNKaS/yJBkVuwpUHrTKQVSz513ZlCmBtl8bVRFpCveSheSmeQYo3UTGBG/aFuoZkhIe
+XqAPzqx/ZKZ1jcFJWSGxI3JiuFqXyKS0IHTMwqbsGNra0IJsvcIzNntMetHi8gTLYqH5O1RJP8cM
+B0Z7eVc8XhqVRjx8nMmYahV7vQ2ND+yuGLdtuim1csGiBZWpSq1eA9/p+zQ0Pc5fXzF/Ud2ELBkM
+yhyfHTc016CiywZNyg9Pql45YtSWlUsWzFuwJCcgTCnjFdoUjW7YiDGT69bur3FVumbOGhqrCk8O
+lPx37AWm0I7PqO7DZ6YNfPc4SFv92AyAIAJV0uVMOZE2LWukTBaPitEE1TLR0aNPwfwFO1npEqEx
+MTPMY/Saxypc1a6lzdUp0fHQY2xphCa6ZEp6Vn5Y07HZSdlG9C4fEJ+pqXj6mVOjINRmlyfFjIuH
+HpVDtNmBoakR3m7VNW+8fqEip4ycAVTppGJ0pGuuQuxh+X8S77lkIr/H3Ue+XyWsRe9iNj511v0l
+/J5jPOG5PKpknZhhIAlgkGzUiKlA1o8OKFxDLBLaDJg0rmDPybDZKhI2GWqKomY9/X3b1Jd2VufO
+Xb9148ISDnpju+j0vkHaQTG+huqluX4m+BMXW1htSB6cqHZ1jzUMCorz902dUKiDMCpudM28olE7
+6woSJ++cNnLTisVzZ86YbWKdfP09aN47PDk4fHDJ8NjYJP10+HD6gsrUUH2uJiwyMS99kC6zKHJE
+bNzU+uWD3XwtNPQWU0jsegOzXcmIRKTTbJq+45s8TG9lv+HDKyYaKvcvLYrKHx0Ly4VlsREpIR7U
+vMK6UfE2TmwoipabljzTPHzXqrkRPB+waMseC29bI8b0w/sxNa2B+fleBuYghdgxqk95iV64YZLO
+INmyBxhXo+CG9R3b3X9keFO83fuPTb/B//MfYKdnFhJ7Ei4kIvFkS2Nci2Y5gEDxiU2YQBwWJlah
+wAHkC3q7RbYQfuephZgUhd/53ZaH9vQpyXzsYabQTga9Hx8ovq8Mej+FgMhwKJ3dxBSCcLHDItHz
+pSB0HJrzv7Sl8v03tr9/q95zw6pRiwZr/ocOWlt3siknH82ZHj56/XT+3/WnaQAw4aJcHGHTncL7
+cvEqeUJ6feUS/4jhvIHh/vulV/yfd2xgkXCALesbYi8lWjeN7uVFHYutJ7p8EgAsaQCjtewdDhGR
+YyRH6vygRYKT7hV2Qt8/6ODu3aRRzlfPrSgsXP5cfd2eeHXyMINxWKLKJ3Go0TAsWf0GP3s2zzTw
+6ypGDt9+FfVd3V42sgI9Tctn16ytjIqauO/7/TW6qMq1mLctMN9gg5lC4EUiQeycmvJgOpToUKEY
+IEhdMpUl+fgklZnSypJ9fJLLLqLvv9g3evS+L6Dvl/vKy/dVxM58sm31iRkxsTNPIHRiZlz98G1X
+odPVbcOGb7uKet7dIdpks1ClbAznDRLBIGzzhQpsgDVkQpOdAQ+Tk4P9TszBEMMGs2mbBY/WPoR+
+f3MpRXmvp1LGtZZtH3tkxfDKjSfHUYs690OnC/PmdKG/D3YKVfTEuMqVFeNXleuEITnafH1IiD5f
+m4Pehcny8rYn57goXfmCGSWJbnRK+NCGw0tKwni0xmNU26n5s85sn+yFrvI8PXpS+/hU174P3XNn
+7aA/kUcPXlAS6wWs9cI/57xFXqa5Ly/7G37bd+bfmBnjy/cetdOfrptvwC9pHsjx3phSs6mUYEpS
+bW03EBWWAK+rwpPSksJV3trkQclab1VEUkBgcri3d3hyWnL4rMDkcJUqItmIf4QnUxvTxK8Cg5Lw
+T6yzwIep16mfsC1CSUJwTdJNW51zuKq8Mz/ncNXoh/OpnwKCAoJQN/4Xr5sF4eg6u5RTk/7eMSAF
+R4hG6FP0ijBS5pT8lCo9GfQqzJOkMpoyjlVoFUqjyYibneIWYbgrK6VbSUFIrVwqk8lkSwXhiSeo
+B3jfO38eP3r5o93b0DWeT4soHRKeMTK5fHTyiDlU5Gx4pnfkHKZ4jiAcOrykXeF1cusrE6oY3B6Y
+lnn6G79J9Xfvfd8zKh/6FkXLAQWWAsju5i6DEJAh3nixRATFp0+jzqb1aixh6lUaWvTMODohiM+G
+ftY7JEqVHpqjD0UPtcAdcT7JxvTgz2oaiwKoVhTU0uIRFBMo6FtaqInNMKhqb31eq3CI/U+IIdIn
+Mn9cyp2WZq/YCH+YNbU1v6F3zY8uAf5ey6iM2bOF1zR5k7LONIj0tBRAWQr3uhifagWFCBh0DIwM
+096TPIOVeb1KwwYuCE40+aJjLWjz3M65Rpp29k2MoiY2p+fCPAzT9OUbhwo/Nh3zT43xXwa3hZQs
+q4ajYXMLerCqglK3tPR+vXP3xkYCg7ma28u9gS0qrC304Z5WPSnBTBDUMMfa1KFKpwDT6DR0pRXV
+NQuP8Dw7piV7cmlqqCfyGORlmDKyfHKKRwsTJLzRtpJKb23tvRE3bFp9Q9rHYk2gpWYzt4F7HahB
+gd3eBEFyzvH8Gnz1aIijMTWbMSk0KplWAkerM2gVwayKTYget3HyvKqt002xE3fNmlO7cVwMnDDl
++Dcr0Rurbhyfoq9qWLe9GAkb3xvONo189sm+/C0PNI0zUE/Men7d8OK20wuGNv+nNWf4A8/P3nar
+c8zYQ7c2Ly1ds2hiSYo7HDznVAMMRV83npqDXmCSR85ubMsV98psZt/i3gBKvFf9kIMJZl4LRsfh
+lha2srVVONTIfiD80NZGqZcv74lvEv/eKYZ7A0RYVj1QPjEhQHJZcctZP33CqWbhyDJUv1B4spln
+RzfD/Lyl41IrUQWtCsuK9+8718SzHwivrVlPpbW0Ct/HjZiXg3rapDqIZiTbyV0CcmASZ9RG0N4+
+IoojLCg2KPp7dPFPTaiPikv0i8vSohfgoCE/BSdpFHCafvquWrSsZn9dzoe5dRVJy6hJrR7evi5U
+Lg+HQI9eOjkwLtgTCnvnTFIljUhPG7N5elryhAcq6PnheeMMa1ta+MjkQLflzx/dL8K3wYycc7hL
+wJPA5x1MwiPxKWWt2AlLYAgN6B0wQ7Hh+TV1qwY/9ujicw+UsOUu4YWDDi4Tlj7w08W9c9NyFh26
+dLOVWrMMeo3ZU5+/EvnSbsUdO9dMz2A/mHf6xxVbMqcOjuozLkufueWJl6e/N+uV4xunmprR4rSZ
+O8ahf5aJ53KM2cyc4i4RD5d4HrOgzgFHBJDaJvR3Tm1eGL2UGt/sotb6UcGLFgmnmpjqqILKhHVU
+S8ti35RoP773KG+jfR/uEnAHOmlPNEo8YISlkpGdK5+sl0lURuXEorehv6EsFXJw1grhYKuAqvhh
+Yc1UzTJ4q7JtTBz9ZlppnBean1GWqKRQKb8MmvRjF2XTtS0t6D+RYx6otcwtu8a9DjyIppcaqY1Q
+iEfOSMghFkphljZaZDEE3MIMI7ooD0kIuWDMgNOHthys+Kvm6PJS9B1KbWpg3lwBg+aefWAYnZNe
+AIUZmqzEoHGjCmrXV0bpJ64fw3wh1D1AuS1rEf6GYaVNYzEcu8xm2R+ELksky4AVDgXeeq2OtlFn
+arhGb9AMRKJWang5a3is3DM0RdtQMpR6yD3UoDtKx0/aPRvdnX28KQ+qoc9ldMdQU6RrpCa0ugQm
+6yjn5TC/ctlw7UqURP/HO77UiDSajHj/qqFU52+mcTlhUDg+eX2lLnbcAxPg1LMXPvNLyItubWkp
+c9eGqps+j65cOx75tFp5wk/cJeyLwuIccdbiXFxx88SuYjTZZushp78oWzoyqhUFJkWgXri4/uFp
+CbBV6GyhXINSY9EnhINwl7zTZ1W0vBQRLfyVMeOBocIxnqeqEmrLUvr28jz7QbN4fs46dXGXQBDO
+ToKpFA49CfNggsSjhONSoTUETOdBx0LLacqAKYyPVxAUIygJy1do2EP6GXtnrs2aN75EH+CXPHjC
+wtwFafNqR2Zo6OCZiVP3zE4dVzZYG1c2YnTyq5POPLppRrq+ZnXnC9Ov7YHshfkxw+bm6ppHJKcH
+yeAset12SL1cN2ha+4EnxszN69i9aV6uqXbVrs4ytOvsqKVDwz2ih+WEJoZ6Jo1f89jr9VfmXDq5
+fVb6is/QJ8cerj60baVp2MiYnMFBMM16FmXDuEtEDyPxLhKl2jOuYFovRb+I/A0TsGxYa/LcJ5b9
+vPyZesOagomZgTw1vgW6+cWEwHXNzn7x2p+Dw725Fmoi835f0+iW0dGRI5tG0zt1RbVpS5ctq/NP
+jvJd0bcgvtQQFGIqS6TbW0VYuFe410h8vF4RIUKAobExhwzYLxGVmboMvVu7ZlT4yrzJuRq4jJrQ
+EhKbqOgNDf8GX9TNwqEmykSFljaN+4+PaVLpqaamd/zDfV3R0hgT7besqSdW8g/g+2Mu9xqJMyMc
+kvb2YCy4IOoMOUNKG/cIwVyFW+fsE+7/GYwdXDunLvWpScdaS1rgzdzMLelD4Qpq8nJYVtY6NhHC
+5SiQzvdLCFcJ7+QvHFcQr86cu7OS0q8UqMlzJ89lnXhe+Cth4rYpu1ZKdP819xrQOu6JUmtw2Ab8
+mf26rXTlE5PfHHN0zSiIF+wRGBt0ITAmyINaTk2h3xKOV26YnGqcurGS9KnzNYzNDgtIKtBRBxus
+PJPt4C4BZ+JbkE6X0eAouhFugIk9wrZyxiOzGL0Fk4ryP1nm7BujEfTNzbTP+nOL9SmLzm1AV2Dl
+0s3D/SC1QjjAnh4yUng5e2jvNw/6pOu1y5jQZSFZNTnodNHEdD8KPZxYviC7nbfcW+1OxdxFEILj
+3GBqAtP/2CktLg7bofMyhjucOloU4vb5JRZVz895oHBRzeAk3yBT2ZTFuRNy6yeV6gNOhJdmRmBz
+UeL6oxdmrpn9yuMbp5nmvQLdDr14ALq8ssBVrnIZtqwyMQdBemP58f3tMwYNmrH+wLHRU0YffWjt
+lDTDpFUPPV7V90mQcWhClDFC7Txx44QEfe3GJ99Z8syTMOhK3YK30DdPPaMZUzkywDuloCr9TDOg
+yR0xnnsNOAEVSAEAyvFFbDJ6WYOpTQpIdtvCzkKBguhwGP/snep5WzfNn9D3mKEkXqmMLzHsZ8KH
+bnl37dZW4TC/H/2Kfixqf2cDtXPdu1tKmDjoClW9X0IIGTqgdNObK1u6VhdAJlS42vHdgVHoDZ6H
+adAATZ3CUzUTTvZ2WniBk557jfACcf8tgYwR95zCfgI9e61JHhQbcGO5cKgFPTi4pcaIjyLvEaqP
+eL+ZmrQcLsnnxxtbhUNUwmNxo7IjYN8rPE/VwfCS+SW3+KazmmH5sdTPTU3Ct4lls9K/W245k5yM
+ew37mCKIBUbUM6RSZHKvDJjiFUDUiceWufjFhNwN1vk6w2bh0ebfvu399AdItwqdTUzb5NVlmtDh
+q6f2ruB5ZjDkIDAD1NP7Am+Zw2CdQ+N4zuyz6ukPlqOahY/VpZFj5qMzhcIZLdSk1lbhYBNlyl56
+bC78b1MTYvLnl8XREU0iZ4Fgg9nMBXOXgMlylq2yN2USpyBHWdMvypBMzAW3Dm/ZX4aeGXagrTxn
+Wku2lyYhCHr7R/q5UeixeadaC5dRE5rlIYmhcFQzNYkb1vNX1pLxppTKpQXPRE2bOsZPuKMrSQsL
+Sy+NptaEVWyeC72XLUOvBQ/JiaFclln5HmfkXrP07bTG7Srx9rN2wjNVCd+q2DU/d6WwrxW6Bet1
+aGOz8OiyZmo8NxwtHrx0zyihhuepazFVg+Nhn4nn2etNTeIcoWaBu8q9gbMNIjxomdbu1Aaz5FYn
+oS52R12NMz/sOiCwg8Jrmh6ckDnrwrGNU43178KI02MHbdi8Znr2CqT09vKLG1RYEj62sGFamTHI
+P6Wkpi53fHZ9bZkpeAOV3NKCLr+bMMwUCmPHLD/6zrIvX4TBr06HIZlVi7eOaWkJjA8oGlJs1KRP
+Xbv74WGP52zevGp6HhueXlyU6fXq6tWIbQKSXOUUxV0CXkTntZOr8O7ZBCvpxsCNWvqrHNTHg0Yb
+/JRRWTH7Q5LCvCiqw9VH6/c+zGt8Yu6N2U+3DYE+kIVeLdSUFbDAtGjCoOUoiX4r2DAkBq1InzIk
+JrJ4ahY1eI+/MTYQCi9M21GbmDZjaxWcduwZymXZMuEvXfWOuShxucTTnyVysEU3wUw9m7Jw9Qjb
+tpLzS6hSlPmw2s6Y/ONMgegMzNVkxPnfXObq5eeJ3m4RjjQ10duG71xUnLfwwQo0CuprD9RlU8uF
+TvYbZUSwEiUrNXH+fYdOesboAmnh2aYm9lpzdP6oqNuJYwuiL2pyajJPtgLLmXDmLpEoPemWJbIT
+pVd5QKuqih8lMFrpCGIywcocNjxyzmmT2rbvK20auW9z09iUYc0HRiXExY5YPKRUV1aSkxjgG5s1
+ZHSc0NnEalasQH7osVPTzx/dMMUE3yxpnVFmDPCNzxs9xTSxZGZ+8Kiw/w9r/wEeZbE9DsAzb00h
+yZZsNj3ZbHY3vWzJprdNI400UikhhNBJKIEsvUiTXgREREVEROSCCnpRKQKKHS9wRcUGyM+riFyv
+ehWys98z87672RTu//m+5xOT7J73vGfOnJk5c86ZmTNTRsRltRfrwlOKyyvV5XGjGyrSVdSB+fNv
+9MBm2z/Cs5pmrR0hjpNhwpyMYwlD3xeLxSxEEOglC0tLbMMWLqRaF8NL86mxS5f2lsNXDx1ebHtq
+3uOFLYuZsJ4e2/tUCv69AG1dsuqY1TEfu83gLgAvshdV9NUcfnufV9u3i0Qunp3qr6GFOYN384u3
+xL3/vqEgWgY78ha/Pu+3RbanlqCPk7MjvObb9i+AGXOOdMPxqG7SY2PjaSs1dpGXJt9EL51xYnkJ
+Y04oN4fZ/qNMKIivnjM8wvbfHivlJk8ZX4V+s1rh6MauvEBIeXtFFWds6ulZ7R0o91RhJ0SwpVZz
+F4DOofOG8H4cvjL+6rZ6Sf6cp8b81PnqkiJ0DQUtWsT8snB45eGAuHDZIqqNPmxbUjerOCyqZtFI
+FtqmLqQCrNYH6rnL1BlV8VTTQqev8Luox0TbxdXzV/SFnh5bNCwwOhgdw27BQqp1EYx79PRc0yLb
+03T9T1nlsZIHs3qs7BWr9cOE1m0T9i8U2/5v3Dug2KW/ispaIdollLDErUyghY6Mq0oaQhnKKri/
+6Zvmb36m9sXqp7cuGmWcS2VMDU62lNdEd4xcXBud0rGlBU5/apKhYNauOngSffRTqGl4zPDHNy9p
+NcNbBQunNeZEROY2dsxKh74LFp2NGzdmZK42p/tAR17l4gkjwqA6p2p6VX1uU2oAunLs30HpKYkS
+hT6vdvq6BkEuiP2Du4DPlDnmN1o9RNIYescC9O6y1+aaqYXU6J6cHJiOu+5i29M9VIUyf95YqFq6
+FNnbZ9M+Vuv9uPnOuWMHdwEEiTsoDNIhBwWTabXtn2/btwD71FTTAqp18SLb8z1WK526ggrs6bH9
+HxWAfz8CAE30rBfRXeHkdJqL9hoQyKJVckfXyoLYk+2nzzQKx5Dk3pPpsuPQdzAjTK+WP/goxBwb
+6B4Qo3o3PMbfA8LJVNGy451PTnrlkXIYCEM+nUs9vfGLbeX5S95agP5DPVi6FFnYF8IyEoLQzKA4
+c5BtsTI2O+q3qCJjqCqjRg8Xov3Vj05Iix+1fuycw6ep+/P98+eMMtTNKgyB9xaj8IXUKGEOnAEA
+U8ucJ+snLqdvhTVfl407uAOphI5DM7WNW99qH3N4eWV4wbTyxrDEMIkupzompThOFpqYFqQuSFFB
+j5CEzPDY5rIkeHr2wRkpKVOfmpw9c1xN6I9u/hqDOrMlMyyhdlZBzviKNL/g7IqxWc2N2fVZ8b7B
+5ROWEH2D+drKXQa+Dn3Tt+7kiGQJrDLCIZP+HJJINE3ne0RVFhRXRPK8rrY8f3gkD6uKVp5buPr9
+VRZZbNHkjaMZ2lcV7auypKphmrtUMSzAGBsEqawV04uonNGPTUpNnbitpWbrjNz0cctLYpdd3VU3
+5qlP52QvnDOpNn2YQieNNkcq3X00KWWGtWuV4QG+Hn4GS1NGUKiftGz5OcFvqEJG5iqzGshx3EMz
+oB4aV4mTFWThqZ+SdxwlwmsAnA8MpekNoekjU0vac4KDs9uKs5tSA2FJ65MfTlt67akmw4Sd7VXT
+LSH5OTFpam+p7+nSHOO0AzOb93YXPL2NaX5xVXXcyHXt5qiWbVOq59dEh1cs6e55+9HSskdemzZ+
+67x2Y8yI0uKIrEKvwJhgZYL/qqhJVWkTqxITqqbnNOzMZuVV858T2gRlcS8ys4AvaB+iTWgRwOvw
+IQXWuaUjGzpWMpxVNTiWzwU9xFZ5hqUnpRRHS6TRRSlJ6WGe90zTnp0RlJSSF/tGkCmjIDp5XFNF
+arhlxemF5jqLwS9vd0vXa8uKi5a+Mn3m6Ucr3RSRIZRvZHSyypiv9TaNW9+QuWz+tOok6sn8aRUx
+EXUbpzUvKFcFF81tfvDAML05zTuqKO1CUIzK3y3UUtW2YOeYtpdXjZCG6PyS00vWvru0+43lhSNW
+HR1nbKou13pJ3OnE0Wsa2/Y9MjkqxJhRMnaBkMMZGZjJzGySF6PvtDDj2Csh7NlxCkjJu+wqYCZb
+lp9bUr2pZ2y+OtoysrkhIlyiDpGPnjypPiipICoyOyFoWFB8+Nyc9oLIiiUHmrtfX1rgNuOl+bna
+/Mb2KYbcCeUpkQqm3Ss+t8644ZElC2mzuVDr5RudG+eniQiXXlNUjhqrnbSlOdo0YXurkF/PyHQz
+s0GNk9dBYzwLipfXuZx69PXrx7Z42oTpHrnheGv3BzsbsqdtHakO0vp7BqbWZcRkRcmrRjaPDzbH
+BspjCpLwFr6UpOriKUURhQsPT2p5ZWNjXMmopKRxlcn0rCNzMvK6Hm+omVWR4DNRkZRZmlDQoPeN
+tIxJH79+3eOKpMq0ERNSFT66whR5lNfnfEF9Y0jt6lZjTOOqltKZdZm+AYWN0/OE9cZQss/2MyAH
+OtAwuFcO/K5x7KZLMbtWjePx+pBC2ChGRXgGJaj9Y0JlXkHRwWGJYT5wcWBKVUpyYayvLLbQYKlL
+lMASqmLd+Z55Z1YPL6ksmNugt0xfX9qxY3ySPmqhJLNp/ghTiVGnyOso0rDJaS05EYFpo/IL6vVy
+74R6W17Zpq4CS/feFuPcCfmG1kenPfb5BkvRmg8eWfdBqc/wiUsKOp8YF5/ftb0mZWGqV9nSMSnf
+SaLykyOqux4T9CJ3k5kF5GSvysDakQr5KfvOlQxoU95AzguRLkDnhxiHxyUPT/b3TSg1Jww3BNvi
+6recmjz2eEFYeXlxUOffHymNLmmbuSBLHxuVnxSoiC9OdlNFJ/hBWYivR/n6C9a5bz9aTmXM2NES
+FdXy2LTpO8fExIze0bn16qMWS3pwgkpWsO7KltF7l7TmqV7Om76hHCPS0khVRH5JTdLaq1vLqrZf
+dc5B85nZZJe2L8WrTdl0lqNqZGIl28IcrcXMX7XmqCohxEsSV5llLIiSsH5xutMLHhm+8q3uuWcf
+LaNqjqFPuha6hxd0Nq3p3tmkDq7bs6yzae5wncfCWTDmmPWDLdWVm94je0UNzBhmNhjRbwwPHBck
+3CQe+CcypLPpfgNiTNP2c5PN1rlTa5LK5qzL0/lFBvookytMlsooD5/ACDk6o2tqrMqK5JQxkZ6B
+GkPERFWuOcazY88Uc8zwtrTClZPzfFe/PjPZL65g1JyihnmVMR7TFAlpRfElTcnSmIbVo5KTWsuT
+DsjVyRmWyPD4EG93ZVQo9PT0dqfjauaWGmoLM4KZ6vlbyTnQGSjLbQ2zGKwWbEK1zqX1Q6Cvn1Kh
+MuFzKP3Fq3IVucIXsKGsQm1MYHQJtNqb5nXZUBgujKDRaRfF7rbG1NA5b3YijCrUB/tqTCp1Rpy/
+fyA6HRgg12VG6bKiFW4+Cm/0dy9fb06ZWJigTw91h5PgmNylcyeWx/4SV1g/stSvqXN4cmCsxVJu
+eKJosWXU49MztZZR03uytCPK8gMC8kvLIyLLS3KU+sdamg8tH0G92LRzweic8Kf17TvaG6emydwj
+irOq4ktiLQWxJXG1xuJYmSy22DhSqdNo/fIL/HSaKGW10aL1SW/pMl1Bb42XJ2WVt0zNiSlOj49Q
+8sFhEgYqYi0J7yZleiVsevZAQfvjXaXxcmV0qio8IyEoMC4ronBk7OS/Oe2SK0AhrmMyD5vP8TiU
+Oyb0foqUrvUJ0iWnh+uL4nz9DSPMmWVxEphTu+7Vcas/3VTasOWN9tS5SbC1O6ZIHzziWliSSlY+
+/4nK7ZdX51Cy0Y+0mELc4pvXNM/cOUqnrVsZ/+jrnYk1Oz5d0nNxc5UmAFoLy73CTVGazCPhhQXZ
+ATULamLimteOJvP3egCYozwLJIJN1W8rj5N1qcimY2cxHhUEUez29OiW+sT6PC1emhrdCWsbdrw3
+e+YbK0uTxm4YnT+mIEkWkJZdoC1QZo8Yl6bLT0vUqbx8g2VuMNSSn6mcdHJ9DVV08oWYsTumzd1U
+EbBry7QNnz9WkWU93kPPnLh1dFxASIBvREJgVcyoimTf0MhQX3lsSQpaqwhTeOSteBf3bzvL7WZm
+g0bBXuXxphI+gVabBCvwod1c6Sskp1Ko+1pC7Mjc7vjqmRZKa9brw9cm1FTVxE+azoVkmGIKDcGh
+wehEsCLEWByTlB7uAaE6JS9k44i1HRmWxa90GZorcvwD8mrHZ0w8ZM2nKvK2b7RqPAI1xsgDRWMz
+Alcpf/3Z0tNoDM9uySiIGR6dnx89PKYgoyU7PGlkd2HIetaDzZ7RmOG5SDdrx8muORc214QYCnR4
+GNVtf0/QixSzibsCSoW6Psw279PxXBa+aSCUE1UTs6lgxakFSz9YX2qesL5O6RfoxfgmVKZnF0e6
+B5pHps88p4oN8tTlVNVV52h/SCgb1z6uLMFQ1Z5kmdtohL/NeO2R4fkzNpZZZreWhyzwitWblHnT
+KmPja2YXTd4+Nu7xsJSyOENBoipMYx6RkaRXx6sCw2JzR+cXTCw3+PgNn75d0O3IwGxhZoICce9J
+NmMyOI4vD6yL3BvyYhoBnYl1KC7+mZYncg0zp7eVxV0aseHsrKlnt9YnT9g9OThEp3SXxJVn4A1L
+MHtLaXq2InfuuBuQDVL58sr4/NgXmQB1iKc8KELn9+DShotL0tMnrNnTWZ7o3RZoSC+Kj85P8NeV
+TrHQc6Q+MHxmU+rYAq3h1u4And4/1BztHyDwb7dzO7kLYKwQXeOzoUvPIXJWm/6XihW2j4q9jjFn
+02aDN0u3V2xaOCEybkaaoak8J8A/q7Q+ecqrK0qTx29vO5Bdq1fwIVkpQTmmyMAAdM7fN0KfHtDa
+oC1oSTnWtH1apq9x9NaP1zdtn1Oj3Mf5xQ/v3MPWJVZPywqLDk7K12rzk4JLHn1ncdOJZ1fqXo1u
+3jJp5LZp2YGZ7cNLoit0+QW68qiSsvGZAW+enbCzPflV9SPPvd5YfOytN59boVGqNXEqRXFNXUEs
+2QN+BwDme+4K2cvdpx4cFVU7FZrU15HfQJw/Sa0Z5vuRm9+aNO34iuGMt7/CR+5Bbw/NGV9YOrcm
+rrolqzJBFpZgTghTpHVU56WkJRRVFyXA72a8vKhgxJrXJtFfR8T6u7n5RUfsvRhbkaaKHbm4tvft
+j877FS6d2jCtMC4hy6AqKioMvRqoTC7MyBwxFduCamRkb7LPAU8QAOaIGo6kZsiGciPeV6wQGIX4
+mKow28vMwvRm0Au3dfGkOzJCdbQ6l7MXhj6PzJfj1aEs/YTZEB0Xp9LneSQnpSYnauXRaj8qq+Vv
+yHZw9oVnFo2M27pZV1GUKqsxtOsDk/wj8sIyqHCNgnMPMTYsapi0tjo8QudlKm1KzptQFMmF5qbD
+pqLIogl5BVNLdfm1sHrzgrZCDfNueFSiJjQ1RJuDKuPHRmlGJoVo3XwUKPU59NPFrtQJjz77+tij
+MHWXW1xaThDS+lHuPtb4tknT07b965Wl1dqqvT/tmfZKWbhJpwhLrUwK06daEhPazPqa9PDw9Jr2
+iSlh4+atWfeosPcVGdhlzGzQKdhDQjzJmOIYo8I6l3Sw5WDSiTBelJdZ5ZC1q8GgYJdlLzjeM1Xf
+1dlekfDBe1FBUUFe/rFZmlZtikY5jPEL10pCIqQM7ebtaQeeXjwFfZRBXh4BAX58gDYuKzllwogk
+CkYGhCr8gzThqsQCQ2hKUpREnphWoKteMz41Zeqb66tCUqvaeyybjkWOU6SUjM5Mqs/ThpsKi4uC
+6bgoY+iwsOgot83SxORESUG+NFGfKFsfnBAu9fKPUKQ2ZIaFl8ys3luxKEYVqS9vLNfLw6MVftFh
+ct2oXV3E/zVwK5nZQA5mD+FpuGro/+FWiSY402cn9FcnoTTN+ERmJqRYdN5eGovJkKMeRk+rfezj
+ha/+tM4cXLa8IzSvvSixMi3ckBepjkqN8BkWnhoXGKPT+VNewX7e2vyWqfOyuo4vKypa8UZ3xIgR
+5aFhJSWWwGmvLCtJrJ6Rk9lRFkvtqJ9TGKItm1la2V2p05TPtT017ulZ2Vlt87ODUlMMik+jLUmB
+oeYq/dzGllWaurUTcsflhnM+gXJZckZRVOumDovOp2zJwTHjjywrGxYYGxoSE+SVP++58U2r2ix+
+koTiicCp+zdzn4HcQX6nc+erSTq0t6mU8jqNgdmcu+i1edZLu+qzpm2rD8+IDUuvNWaWRnnHFNbH
++idolYFBn8SlhHnmTl1XbqiypIdc7IRH3uJbX1xSWrjg+bbhcxvThk2OXtFa0JYTElXSkU3Hh6fH
+BQ5TpcWllQQ9ElxSURZq6Wk2evhF+MNbqz7cTnjO5r5iZoNxon2hE/JRDG1WOAIYKnIiWqeNEOMf
+0DzYyPhKP+NvC9Mzh+u8vNRZrdXp4X4B6O/+igB9uSEpVyuJKW1Ly9m8eWVHNvovHT9u5xTWnQ0v
+yTUMu5Gwua1i66zC0lWvzzCOrS0IDKlsaTdM+duCAuq1+h1rFmXuiC6unVpRNqM0UpFQZg6NLtfm
+52vLo0PNZQkKXcWs8oIVc8ZHRhWVNc17copEkTx3Wp0Ehpir9JcyCobFzd12tGPhkenJwfp8TWSe
+PjRj1nOOnPwGZh4zC5+91/gC1VCmh7Qv/5bZ4ApXMfPQH+ivqWe3NSSP29qmlPkNo6XR+UmpIwxK
+3cS0Ca+uHhFZYa3/JbPO5C+NSNHGVKarYUGqHd1PHNldVNlVkTBsxjCdISsiOD0hVJ3XnAoXB4QV
+dO9trpg5pj7ZMHbU6OSczurEQH1xHPyncPchs4+7CuRg1BA+sYtnh23yfhMY/sgNiM84BiM9PiKj
+KtFcGOUji69IN5TpA2BO+bp3F9RtmtOUEVa94fSMzLnxXv4RfkGRfu7ve4dEB8XHufn4S5IrpuSH
+mEZOT42ryE/x9UvLK4yoWjHaQBXWP9IUr29ZWtG8Y1pmQO1TsTjqlFjbtXxTcefpDdUmDbwWrJIw
+biHmxHPnEs0hbrrGNLWcWxZcXFYaXj4pOygwLjUsJC0uKKJl7wLSRuvtfzBHuc+IJ9Jn/iqkD3X/
+yVF1l3AONSlA7hkQE+qv9h/mm5RVnjz+5ZUjEio7umbpR7X6pZsiZVE5CYkNlij0Vuas/ZOaVjfH
+J9dMNjP/8RujDzHolEFZbcXojjRA6la8/PWZLc8+OqMyerdx2v6u8uVjTar0EYk2PRs/7ekppqiS
+tnRjS0W20qEX2JnMLByv0YiaUFh49qZ5KZmhzQbhzJgzm0lfRRiTAR+UI6qSZWcm189ZubVk482n
+RuqyK7Ty3Mo6XVRyVG15juJUSlYIry4Yv7wtTxViKNQ17A/S+g/TpRWk6b4114ytMQdXruuKrKur
+zIwMrXhk+sj00Emv/mfdPstTe7elDotNyVZBibtXQFTQ2vApyzfmo7+PP7SoOKZ8Sm75I23mJ2Wx
+xaak8vyM3NJEo8WUmNtcGN1YnurhpUrIHDHBEb9+jLsC5CDpIX1S3ETh6JImwTWMzG0yxWRopb4J
+5WkJpYZgmF++7txc9Bv6ffiKVyZXrjKExRnktfuXVVH82pfGqTMmbqzteGxcYljlivb5Z1YVwygY
+PPHik+NS4+Hc4uoojzUp7ZtbhHPfMcwFDudfmd+PHwYHv8zZdJ+y0/YbHQKSSxCX92Z5NUn6lUDr
+XMO8gneYIQlPCk+uz9P4KAOVPhDCrLbCSPhokKE0sd7dy42O0fgnaPze9vSPDAjW+LlTFMtwdMoT
+k5qfWVDiGRgfIaubMmfCcI+PAmlGrtLEBLspFAqJh65hfXtkrilWKkvMLIlt6BkeTr1sbsmL1OTU
+JcV39iyYNUHj7sPDmu1PvEQ/E1NSYIksD0mISwiJD+ekIX5nzgSFetM+sRVZXumldSMsvpnFOTOf
+GBVa2jLDEjl3095dqxLHt/hF+048fOh4V/jkpVs2r07tPjI7zTci1s8vVqUIyWgSz84bmZe5K8AX
+LCbnxFyb1Aw1CrFfPsRPY0xm14SFRGTihiGS18cQyhL5apSsgqVKJLHxMd7ugf4yqVLJSRMS4nyo
+RPQxMZNHbX+rddq5rfWFXVsqVGEaXz7YUBSLp8Ww+JT4sGGJEzLzppTFRFd2FoVlpqeHePi4MR5B
+CWpvlSrEHf2hKbVk+O2KHIk7fnBMsgJmxKMnYAOdL48Mlgbo4n3lyXGh8rBYf/Tof0qLJ1QXVbUX
+Lj06I7lk6ctTLTNqjB5j/TIr2zJN1eaQmML6uNxZE5pGFIfNTsxIq2uLTppYl+KnSQosGV8QL48s
+LimLCimuG538SGDuqMUN8WVFxXUdecEVNTWqt/5ZF7UVUMTH+IXoboszI3CfUcD2uR3Ylie9MkXw
+ObKh2eg8+0x5NY20NOh9/eJyYuKqc7XUjZYnPpkz8/jiAp+gQMXaxZty2ws1ylRtyqiFRRl5dcZg
+97oDK2vhyUVrA4xVqdA7fcZIY3h6rXH7vpsbc3H+WIb39fZWnlu1sDO0bkcP+lamdK/qacoN/Ls0
+JsEYzJeuPi/ED9l3mdlga1/8UEfYcfYCc/8MPsLSCO2yKgD7OgPrWP7pv/iDRxzkQ52b9HXmUJp9
+N7luRlZsQ2ND/choVUpCtG9y19ic1GlPTcycUW84oYiI8dVakkP8NXFyTiN71qdq1sbK6AxDcuiw
+sGD5MH9t4CNjRqc1d6W0Pz63RacqKy00hulVPqH+Pl6LA0MNlWNisqdVJ+R1zE/TNsZ5qmMSE+Jl
+ihiVrzw0SlEdVZml+SS7vVjnHpo2Zv04RXScPpRSF3eNqFrUkBCW2ZRhCo8P9hqmykiIKjSE+Knj
+CxbNnqpfsLcl0l0eLA9Jio1WHCp7piKtozw+c9Zzk70Cw3SGgrjIaHcfXw9DsrI4OKko0S8sb3xB
+xqjSdH+JNjC4orY6V+fupwlRhCll7q/4pxfX9a1dbuOukNUo5yyfMjA8oualysEfdQk0nkgog94x
+mZBWw9Y5s61m3Yn27BULZ9QmbXw820fmQUfmtZiNBTofhUonf3GrNEYb4KfSyXZulERF+rtpyvLH
+b2tN+o+7nyZ40auLK2K9E0ubYuNGlSXpilpTKX1bZRI8OfP56SapLmf04uppz6Q1+WhMxcm5Y3LC
+QrPH5lVPzFS2QLcseXROXHxetG8jlNV6hBhjIpPDfCjKO7G5UqEO8JKExWcMjwkzJWh9JLHmfG1s
+SUaiLLC0ph4410svcz8ALzDPGdsLgdm0XClu9hW6DuuopEIMnil8GXHPtcxFx0udPjDutz5QKmQw
+FL9RbdH6MK2CTypKVCZto1laEadRBsTnaGtDqupq86MSimoiYUhRVnR0zvBwGFaaE4P+i+7O/2Bb
+TXzrzimGhtxIY9M8i09obEqeNqveHOjpGyRx4x9Z5eEmUwdKfMM0Eprbxr6Rn6kumVGeJ1EbStEd
+LYQqfXrA2JVtwyNKZfrcqtbOHG1FUaafb3JqVlhwZnqKQmHKKY155vm4nqsHqWHnIPtkflR1T9XI
+rgpjAFKOWdViCnYLjk0JVBWkqplSGDKcDU8wRZmT1VQalp89l9nKzAa+ILv/fE0W9fHw5nEuf6mw
+rVotFc5R9VmNdLYstsiIV1NUhqzgYFVuchisC7NMKY8rzJ9Qu2HpvMyqBXMX15jqIjW54bVzSlV4
+0aD9za1N1OxJK4YHhVUsa3v01cnR0ZNffXTkY4/MMRQXoLv+iQVxMcWGYKgID1eFm3tWPVY799TK
+4rLVb/b5V9uYn0AdAJhJs0u0gu1zscwGoalhyEODiGbBwqKWJI2tzvKOmjCxNaJl/6JS7yCNP4yO
+0bgHGWLypo+t1n6b0jQzHT7xVNWGszM7395cZ+rYNkYnVwdJZLElKZqilAgojytIrqLi05pnpgXE
+hsmUWVNqNVlmfWBzbFmUj1alDM1oTH/mi82Nobkfrx4/6fm5ueWLnh5Z2tOSI+3wyiirVme0l0T7
+Jtdmf5beWqjd7eJDtj98jcYk799LcSDUqVZZp7rlnAs2m4tXnu5p2Wltzgw1TNjWGosXbIJMIwxp
+FrUnlIbFBXr7nLsg8wmMVHoaaqd2Tqk1lMzZVla+aXa1D/rdMyw+OzY8PyUiu2PFmhUd2aF5k8oM
+U+vN5sVnl1uii5rHjY+pWdCULpvuo81szC9qyw5Jqu3Khd7JzYUx3EgYWciFJmWFlo3J0qiiC6fU
+juypUIdXLG01Z9ck+Sp06RpLjSEkOCJnfKWxpTRVqkwfMckCAI3HN6vnroAYkE7iwTq1wmCW6tS8
+s00jNSYuQh0e6ah6CDSYoesCPUkn55ha2eRng/1e2B8EU7qOLVjyybYq2Aa7pCopehW9Vb7+XM/K
+t3rM/gfRsKmbG7TB5mpTeHpsIFqla94yJdhQFJNQlBRAvxhy3owOr/l+5ObJmakdmxrePk2dhe+9
+3bBxQqph3KZR/95mWxCS3pKVPiJZgbU3k1U9JtkLdgRGKNwU+iohnsIr2V3AF7S4jDmpyC/sbyrh
+qEEIdN1MoNWRv2aXPiDWlj0bmlIaH5KhD9NklGp02TF+vdcXfrS9pmLtyenSRHNmKB1SOKclNCk4
+aWbajJOry4tWvDk3rSMpff5bK2BTRHygB4xKy/XXZscHhKXXmaic1U9UyN3URdlohMESJQkd+QTK
+SV/1xVO7P1+TzXvLho2ylsd4jwgoSpyj8MteeXnHc9+sSR8mW9m8qil+5zClSkH/lFMc6R4ycvfC
+xXtGhjrGrpGlmdWgFgBW8IEYMUBs6osbD1p/FzcXuFgCdHLdzOXrCyzrVsyp10cXjZk622wcWxIb
+qs+PrC5395K6nw5PifLD3lv17p6SnAmLsw0T64zdK5Ib83WcLCwgr+aR0frAykWthfGKsIz6zpXl
+w63ja4oygwMNqZbY7LJor8IaPig4gHsiMCUjO8I0c0xmTMOqlqLuCQ268NKGSdlPPKvOG52RXWfJ
+ipodPW7KHADBcmRgDjKzQQYAcpXUdReRc/lZq3MGjbWOaUkdDkzLYYE6vTImNjVsWGj6yJRXOraO
+jRux+tUJmeOjxnUl5UdLtbqa0LLKsvTIhq1vT0F/oT+YMSglvjorMqx4Vl11Z0NFQmTTtOWVLTun
+ZSoDUXfnXJkuJyFnemCKuzIsOqUwtnBufTL0QOJ+FgPzH2YRkAorLA4+ydzJOlK8+g4h9T4rH08B
+8K1gc21qycTc0IUbsutT/NGxuIqpi9aXLPn8qaZzl8tnlmur83WW5GCK83R/JjJFIyMbInZNz9y+
+rfGFR6qpOXWrx+jjx+2c+uwLcc3rGqvWzWrMCita+sq0Z9B/J2srK0rDCws9/KNCfUMDAiRpbrpk
+gzyjrTgqoXJSRtOT2X51iw+QdYpZzBZmNpm9XOJW4pBwU2oSaGdwymUJjNmCj+nNc/fkKQ9teX58
+VUaEVJWcO2JUTYHeB/3TXRKakiBLS0/2+CBpQSmVM86irlx/bu6U01vqqTu6msoCZbafKiI6PHl8
+lT4op6O0sKMyPTnBWNq+tAo2d2b7hZpKYmdWjgmPHz1tcUXH3hlp5glbhH0bT9pvMY8wjwMfEAYS
+AZC5nH6GlOsX4PrlyVBTUVWxMSzMWFxVZArt940q6veVfq2ywBASYigQ/tg4x9dC/Af+1O+pYEOp
+qTeoY7wPiCW3GUpU4QHQuRiaYlYI3pvOoI3QRei86RB8w74fb1RHKEgaNeoYeh2dTGpcMkIeFRnq
+7ja6IqkhXp0U4ecGaZaioLe/zFOne+Gp/1ZXVoaqfGj6F/QhNFLu5lH5kR7DPFQhPdHxrBtPQwhp
+WVCE9AvusaffeOO1YH0+uScDALaA5OORASDeIKRT8eQvpFU0XQ+t3ba9y4rQrMJ1aOYCaJ0P43eS
+0+fWI0esVvqIzQrFsyxF+F4ucj+xGgAhg5ojw4K4WdLkoKw2sbRKyl2zIn/oa7WiO/A364NHn0dr
+a9biQlacgsP/hNmMDn1zAH28Ci1YheYfQN8IJZqsVtuvlLfQ3n72m3wSUwB4YCI3LfgK+ZfkONkL
+oMn5uQiOV6dEkv0rUDhNrlILe6lwOAgfWJcryPX+6ggOPlE5pmXE+Yq/d6K/0G136suF62at9z0Y
+C+y3V12qbLq88ON/2pHt5IYFE2f5zxz74cy155esnD5lRFP9GPjJP144cE6GjlZWwhQY7/bxa9bu
+44cqRlRWvvTcq8dy5qxqGPd9REQF9dLnM260dhHeseynMzrgBqQgjpyBx/EPEpISPsC+2/TDyVWO
+BoXaT+mn9GVUVE7V3Jf2rK4a0XnshXkjEL9z+/ad1Mfwi3oPzx9/kMqmQXQZ3bNWTR3d9kRpSUFp
+b40VrrPCZCv6a2wqx1k9PIvn7N0BILmDr5UpAAEAAIkgEymRnQTgHOtSNU6xDr9ZtH3WYklX941O
+dBf3LijrvNHdJVk8azvtiaQXV6xGn8fEwChKCqNiYtDnq1c4+gMbReqncKmdeLQ/HEglQEXltOze
+uHF3C+J37qS2w1CYhC6hG9YL/7x6obcGNzX6BdkRQj+70pP03SbtQkrnKACf7C4i8nASfEIo5dtv
+rXAM/t9BlRSE9oln4jIAYI4xOqB8SO/FyRieG9Bjnx/UR2mTFUD7+/br9DtMATn3FM7g3AU8vi0U
+e0U6fBunn3BTm8moExpc+KjT4r5qToE9MAmGTph44sTECegGutMwvn36HI/s7O5p6WlFxY9qtesL
+i9LSps9e6HtIOXYMU7B7w8TiYn//kuKODbvlMs1no+MTjIax5dm5Pdm5w1sNeghHLVw2biyg7JdR
+ANXOxANP3CaQXKBGQh9+fYdP4N2u6hGdnSOqu9CiXdSWnTsRjz6v7uysHtE5g1lHbvQQ5BVqv8kk
+Cnc9m533wbnc/xYqXP/2OR0k3PimIxe+wXtWK3Ij17uJd/YdYXQyjn6O0IwAgHlNuOvZYDKYeJ1G
+oKjQKCFpg6WnPyuCIaep787A2UWoGO7tsf5zafcL8KzVevd6N7qPJt1B64CDNvcSof28I986+wyj
+A3KSE1zcLg/VIsuQfWYXvmvbduXwWmrRViqO0c3v/Wj+fMrHNo9+af5827+ptcD1/kUZB4R7+pbY
+bzLrHGNI0DfSbGggYwhbJEQ0NHCOISiDRvQhuusYQzAY/nJxxWqoi4lB12y/oGsxMVAnjqE6+5vM
+q8w04E5u1Pb1C8d9PJyjJEDs9uHnz1DgzNuIebsLxkAO8jAaXUN/ovuUb9eo5lkzW1rQXEoPc9AZ
+9AO6jd4mY76XOc7EgkAAWHybICHly/EqstnFsbgN3zrxCtRCT+jmGNQX//vvT5b2MNqf3ofBMNW1
+BovmPzp1mTcANDDad7MRzKMkt4cOzygCSeI3+mVASHSL1Jhi1pmVREa4YCVPpcOlzY2n7qCvm5re
+vLMeupWcmovuoyt6/eH6fWVn5+Jq6fUH66nraKG0pXzGGF+flsrpLeiP/AKYC/0736g6XLc/Lw+9
+jW7PebPkYD3Rrx72m/xjTAFwAxLxPgWJnz5Foh0wSWgckwSZHkSuSNOxWV0tzTdGjXpwc8To5sp3
+y07ORb1kVpj/6OyNvgd0vZ8+8k5J0ZXV0Bumo7Po/5a8S18+g8Dps7D3bVvvP17Yf0GGjlaVw3QY
+Bd0+OTF/zpFjFSMqRqB/IBv6C31WASiQab/JLGYKQQDJZOjLppiMalO4wpdnFBJVuNSo0ZokKkWE
+nFP4qvQmI9wDwcs9MPcVGGQ7gPyB/RycVFD8IfS6SVuKLGU13db/zJwx/wH6HgatXzVuI/SwovC2
+qn1P4qNHoI7yxf0WDANALjS6SoKTudfBHBgAA2E2mt18YxTlS/rQZ+gvCE6fRcIcwTKUHL8HHe/5
+4Us/GFxp3KngBvyiHFcKRkPeTl50zAHMRmJfKB0Zu4SDC4yEUacI6lpP5aywPbJzJzX3EqQQurRq
+QZtW2wEvomNktt+J7p74YXc72obpzQaA/YHRAQ3RrOQKTHIrZorB5VbdCJ7DwU0aHxfKhhoBwJEE
+ltSWaNWoEaNX+PrOOk59dvSoreRYaqDEO2JYYPprKOOZLTFdcJ/VEJqkM+WaOyie4WTmvBlry4a5
+m4SbjMYztIIPaUGnrZDNzm+ATR6S8JiS7EzDIN4SoA6fPJGHQuF+TpfLhPu4ZtURAmMYg9pS0xWz
+5Rl44bX0wGER3pLA1GPU60eP2qKPz/L1XTF6xCgVtFo7zLkmXVKowUqFu+dnQ9aKTreE8AqageOJ
+aja5DytbOyPPLONKDZnZJTHhEg9gt4N8APC9/DKOfonorET7TVbG6LDOkjvPx7jqb3W41vTuLmrB
+sk0T6x9fBzctP4vQ5zfJ7VfD3pna+Q38zGq1XX3tX5c/IvRW2Ccx7zLnCT3SBnjDFacWTgSmkANk
+CvUK+oh1x9i6yx/Zrlqp6e9M3XsYaaxM3C7E5Ty38JXXdsH7+Y1Txy8HgAZd9kncl8x54A7k5H6M
+CK0xhdhuNP5k0Pv5cvCh5UAqs76+p6ExI7O+PjOjsRE2D1Xwo409dfXpmY3wVGNPY2NPI7y9A3E5
+zy52MoLPpq9Cvsz7nB9ZbesryqDoK2zQFydXtH3KuE8+xbnR4B/vTtqyyvar6yec5G50/Xi4rPTw
+7NeO76KsS7JwqkGqdUl2zdhJS6ienY5k4sBut38JAPM5bj/Q5Hqfpozcp2m3gxIAmCLyfTQATruO
+3GcNyYXTKgUkBgjPqSM0A+wPePx3ZJpHf41+XDC68fQT376Lfu/ko0bu2FWZY2naEURNhGUnYOw8
+tFY+vHDZ4bKstB8ztVUF5spGE9kbXGa/yUxh75F7vMKx02IyKDg1Vl6mcBP2Y9SmFEO4VMLSw9DN
+n27M7PnSNhOOgSHkI7UJ7aNKfrZveicf9vzV8O1/f9/0Tj7aimwNQq5k+00ugSkgHoUe4EXFPiM1
+QsepnfMrvhfXCGjTZjj22a6KVjLVHum8sm3blc4jZJJqreh6Fo5mCtC3m49eb6sRbNbmZmGurWm7
+fnQjjHDaDfjOXwlH73V8xz6SjKMqnd+FsZTmxN+Iv7M04bkMAG4/oyMZBkxGrJgiQiDOXiXeHRGp
+02ZBI7nFiORSVgqDjsb6AKyBwSW5YyvRSXwtOHqjamzOcBiyNj9w/k5qy05oDbJQay98bm399HxL
+mecmq3WTZ1nzhU9brZ9fWFcSHEDFW622y15R4wlfkfab+O5cGUcfAABQ9j+ow/Sf7HeCr0ebDNDg
+4u/RKtqjArbtgOObUfGoCrS3souGkVuowxbqV5u3xfL88xYYD9MxnWvUYeY/7HeAxq0iV0nVdD/P
+TqUgyTSJnldjm01hg5qkkh70WvNE9GsJ7F5C/6MBPfLDyJE/wLUj6WvwXZuX5cABi4U6mn92Lvpz
+Mro1Gb0y720yh2dSh+kH7HdABoJIaeLs4cwJxqnpPrtfTyaUTKuVSjj80ry5G3de/u7p2mmTOzpr
+m61zunuacWWO5k/oGF7v6Tm9ef4c+HVhcUmerar2aD0uK5E6zGjYe8AdaEjbEcI6rU7t4ofp/RR9
+jphQfIpZdGyez+iZk67LfwQ9Zl21ivCQ6SM7fdrPt/zyd8seT4swdnTWUvdGNjXHhXigcQWwpQL6
+FkycVBrn6Vbh6WMetXAuvOPmpy4Zni/MnZnUYSaa/Q7wxGI1YNPF6T6R+RM+37ht7ZrtjegxqxXe
+tEEv9B8b9ctzsOM5mxeu6WU06woeQ9Rh+jH2O6B0ym9QC2VarXRlA5rz3/r6/8Jd9dThwY3Rjw7W
+vQqVVE00nkFKCOLP9GO9zzfAnX/U1/+Buhvoip4e6vDb82DFZBg8GbrNPZtvqxJ8hxRqD/2DqDMS
+oGBy4oGNc6qRsR0pIS67gu4JeaRn69oVj5paNDNh7ZTLM35FP0HFr+3nxzPfTB/30sm/H1H43shH
+F2JjbsH1t9SR7wORV2Yt+x0Icqmz6yFNMVVWptUKb1nhPPzbioKtaK1VqHs+LiQ/31ZlscAqdNQi
+0qTC2e+ApytNBw3He7YqjGvfB9+mktnvgC8ZaQN8I3WEdkw1k5yUlbQW/t6z+wXjFPh/ywzJNTOp
+a/n5tshVC2T96uDvWp4wwFg8cmFfBTZNgGMXoSPwg9HoLBrtrMMVGJefj1ClBW5CrzrqQHey3wEv
+V5oqk5PQ7JGOejQu/4i002G2sR8P7lCotuA8YR5SenrgI9ALesFHsBB70PWn0Fc9Ah3bvykfIkVq
+lu0clWXbaBHaP406zExivwNyJ12HgURIpvX0QC2V2gOHW5+znXHQeoOi8/NtXlS17VcLobGHeZ79
+lowOKTQMdOngfsrnN9Kqti87euAtdg/iqawCW0FBAQVsb9KgoEAYZynUHiaOjHsZnmVSiA8n8WPC
+SR9kJFSytbvbOm/uXNiOtOhX6P3zXehFfXMMTjv2MtrxsuUm3HjzJppFaBmpPXQJa3fY88oUk5En
+9rwf76sKNxnNWpPRoIiQ601GDaf0hfKzax+Bsi13IapHO19d8t+KR9+zg72QDQktSE/dCTP/2Vgx
+e+fXXy9sGz5mX6ZtwY7KUUWtuwXdQB8iutzfRSv6ciT84VSJWBX6P/n3k3te2rWruGbcuJpiQZLL
+r69Av1jgjtYRVWP6dE0qGS+RxNIhOW90rldLi1rXGcehXkqtCF47Dr3/WFxWig6L2UoF7H95MZpf
+2zh2bGMtk8Ubkm5Z/qyIQVeIbp89ub5dVldZUUtyr1B76Hr2Hogm3Avjw6hNhCQNPRn4aodaCBMN
+rwhte5VsTU9bR+H0DY92nXy+9uXWKtnCWaPH5i3dsGLu+dOjTlBX3h7VkpetNyvDQmdMmL/G3//E
+yMrUVENKcFiwdcqiZcFC3/OmDtOvENkRyakUTo2o+HEhsjfQz41EvYuxpJ5f/tFHy21NYp+No/Yw
+w9nv/ofNHKE17e+Bt3qmr1w+vQeWWE9e/+JN9qn8fDRh9/wFj8Mn8vNR05tP73tDkHk9tYcuZlkQ
+AnTObMZQCMhkQIdTaiJeKW3CGwpxadQPY9HbcdMLJ87Y+3R3RbWlxKc4c2nxq89/U5dtSdIbcxg5
+um4pTYhrbW5rUxcXJJkXy+Xd6Ep3Zqc5s1+ZUQDIxdyxQlQ0Fg4RFRXYONEKc+KmFXXM2PtUd0WV
+ZbhPceay4ldImcl6Qw69EUZayhLjxjWNb4soLkhKWSyTd8O47oxUszmjr65rWRZ4gnBiFWkd9ZE6
+tAkpU0La3kSVZeUXpqYV5GfB7LFj0dshrUWbnn12U1FrCP1K+sSW1t5xLfBgPsq0lMbHPwfD98cn
+uAMal8F8wrIDY8FKQlmnGuTmP7zWBBOWJWek6xckdRcdOMbBj0Y0lDR6TQl6ZkvdCn3qysrlq55+
+dm5TZV6xT0nmsuL6hVV1hRZ9akom/eKc0aOmevw9NvbF55hZ7fm548fExsbGtjaPb4vILU0yLZbJ
+Y6B1XuYiY5ZDLus4BviCcLE9pA7OhLu7TBpfPwdHCtwOwa1Fm557blNRazA6C3My8wtTUwvzM6lf
+qMv56EBC/HObNj8XH19qOd/RMq63tWWiQ/YcAzxJtggX2SsHyB0X3yf6b/oJvhXmOGU/pk/u0D/f
+2b5BRPbBjhMSIZBVOWTr6NcGvR+lTc5I08MPkbGyoaR52OSgZ7YcOXnq0IGX6T/mjGqZ6pHHzpxg
+yR0/5unHtjz74hC0xVbV9G9PQV5CkyEDaa0mr8lB+7Yc+fupF54/JrZKHjOr3ZLTRxuCemoXdY1l
+QQIAkHBoFjWQ0qGCBP88AyqEj35KXB+O5+D7Z15dOnv20lePmHOyC4dzlaUFmsi4+AZ//8a4uJLC
+kkqvqd5Z6dSukRVplV7elamVIz09fOca9CHBxpgIdX6EOtqYCg0FxSnGgXy4WrdCtJg4OP2DxVq1
+I1bc7uAjJSencDhfOXwQHz6ZGSw7sjK10turMq2ij49oNeYjykT4MJmIrOHLZJyGkiyEgk4TGUjJ
+gq5jVKVg+3qSiY557zXLoyXFHg3Vk0b29RqYjd529CfmZUQtXCWTrU9PrZ+R7+hAZZYLQqfqENoa
+Pg1/Y/4cHJ+m++LTh4oN+qIivaH4t1a4F6sHyiM+Kys+NiuTWmKxoCwLkedh+hTHgADBViUDS0NI
+8KLTwysM8F9o+tixMBt6V0H9wpdg4HC0tPLvOcQEupxv++KKxbIanbdYLiU9LbTRYbaNZUksQOh0
+jmTprknB+31x6lc6LXFayZxZrWhmlc6YkV+FllXpDJn5VWgWZkA3obCmg3ksPWXWbPSJxQJ9p6eN
+KEXf9Pt0zlJrSDITPuDLdAPLAq1L7naBnX7NZepjpR4XEjVpRNecrRs19QnFUBOToIkzFeTUUIcJ
+ZWPinJmouGmUp8fCOJ3RAk+VpdWPQfctzvFXSsafDACA7aMITsKEiw2j8IVrN6albUyHerT3vfeO
+vXyR+mIKDJg8Bf3rhf1bNu/fv3kLkd0e6i+WBYHkvro+Bdc39+OxXO+/fckrF95+qWhjabHHiJLR
+IyoaLDVuTHb7+H3btjyrVO4rLZmxZuXMonxh7NKfEr5wPFNgRU0up6zHTNxP25hOf4qLXz95yjpx
+rO+hS+lLYJizZxl8cRyTLnW+cGnLs89u2TB5ynqhL75NryNzVgQA4jRB+NS5TptETQu+72MObfxN
+Pz09ETW30j+nCwp5jKXMoaR/zc938EWtZA8R/0xtMuCk1w7bwqE5Xxs37puTJ4Mjo3VwDDfe+mgb
+87Ll+HEL2l2uTUpuLC6b4KjfCeZ30uddrIn+6enphrHo7aiJI2bOboU50amWnBrmd9t9S40xYc5M
+mGxBh6ZnNIyFLB5DtVQxTbHF/zumV0tVl4/JND6yHD1bDlcvsrRPQ1fLmV9Hou/VowvbJ46EQZrk
+zNThIn9sG/M7GT99rA0yo1y/OGtBLUmcNnz2rFa4qUprysirgkuqdMbMvCq4qbUVnSXjhx6WYZo1
+GyZbLOjO9PSqUqjq9ynLUmNITBHHMb2A+R14AwAFtRIE1SaDQk3DDT+XwqXVL7zQ8KDiLnWY4iwW
+tBHOslhs9wEEWmoP/T0rEWJRfn2xKEVfLMrUF4tKodiWrzqKkzNLRngUl84oWjl61Kqi6aVFHlUl
+mcnFE6+zkmeqxy8wJwwvf85fqdcr/Z8rH55gXjB+xLMAUKAavkXLuHCgJPl1SaxOp3VR/zotz/FS
+hVpqZMWwHk9Whjl1BEwK0+lNGbxbiDYhOjwgSB2pVDXBb2D60dIWo7G5eXQmvHQwl7rBJqVUjTQV
+WLT6+pKYIEidti2/Qc/5hYKG7IZxSQlSeWNa7dyFAIJw+Abdwtjx2IUc74dj6TqtmQS3UwhbJJxI
+nVswLjoi3TI6PKLyteHQc293MC/3jqpempRPPz5r4q4oC4RpWbOo8EwUtW92SFpsjH94xXRcVxO1
+h47mAoEG11WuNZPIuFmYgUmEH2/C45R+GCThwrUSc4pM1ELfToS1DFOzJqeirLSI9uSDYixl0Qp/
+xr3+5fb299De99oztxHlxPxgrBmrpaAauvFePb7D3GSBQT6+yaNgXS364vnnobZWb/t5GgydOg3d
+Iv4QfIupZcPAMHKLEuaHCF0doZAq1Dqtrm/lwaDgeI4nIXyl1GhOwR943B4cz1Gv+Xpmq3WB4dEF
+38CVmsqYmMTw/LzOat/wQKiLTAtyf3Z5+2J9PgxLjNHU6qgfaDYgKn5LpzF7DWr7+We3YUFhoSXJ
+DeOHD4/09y5Om7IkJ51nIBy9WRceHpfXMLIxqSQ0KAx3YyCBL9FGVgJMAEAhEicnPOKVD7VJlGcY
+NJPuTlYkDHon89jIgG/NKENzS2yNAV6BnlqpjMkIDw0P0cZHheZ358GlJchWEzis/5MQ6rVTmZnw
++KVMNymUSrWz3FgGBipL9r6fmYlyzmW6SyVS7UwRaLeTu3jepxtlPP8uALY6EqNsBoCyU+NlPNXt
+hNXb79EnqOEynrX0wQCgFxBYkRNWbZfSMnqOjGdLnLBwuw/dQtXKeHa4AMNjyS6ltVQ7GAZCnHpc
+nHAk5hSDGMjDkWQ1TMq0YBVuyfzGljJmf2XN/k3FY0O+gcUZk0aPX7qxbfQ/YHG0O1VTv3nrwfhE
+dIGUmWmXMrXUbBnPljn5kNghbaTaZTxb7uQj0X6T/y9TAKLI/R3CjUQRkQOvD0kxhONraUX1zzG0
+w5j+UNiB8gq6L65PLIO3WnugGwx0t2kWkhXJWDvIxRtYQvC+k8sfCTfRTCX3QoSg99AVt49fmz/n
++CGqm+xVwaL/DqUxvzMFIAzEYv/DGKnq872FbZFAKgGiKyLY3iQ8ouiBOVA95YvZM33WLdu/Ov7x
+rhuQRrYbXY/HIx7+1Tp5RlPz9Mmt6BP66W9/V6nQ5I4n37XWVqMr6AG6j/5ZXbvaCjtOHvgbfP/o
+gZN2uyPWJeHYMMdaPfWZsFYvFyYC59oK/inC+xJ24RD5zp078b2Lh6xot9UKO6ywnrxfCgB1j9EB
+v747Fg04fZ3Sz6DARnUWLN22Df5+7FhMXMtLJ8vGwW+ssN6Kkq1LV2RmjU/KubFR2F+UKN6TEwZ0
+IEn0+7FUlPhifjXHiysQDs5YlS8ZWFBF1BbPvUsuXem6tXymz5jRnnOX3+raFb/f1rZr1y7qHpo1
+dsqMOC2aBzey2rgZU5IS6CPW1bXVO5ZdtX67akd1LSqxWmmJ1XryQGMFNB4pbawdIfYjsnYXDDTO
+SERfaFOtIIt3OJuoweGACSt58Hu8jrdcWMnbtYsuRkF4cY+s6R2C9fi3dRRe0RtltWrwGh+g7Nft
+N9ltjA7EE82i8nPWG/+LEBWh2oSD4GaVSUXUCa67zqQi2HpzChuKXjlWmShb3k0lJY+qnTbtj6lT
+Ro5JTqR6VsoTRhxDr1AgJEnlCU96hCeFUgAtQplsevb72en0kdyFpVXWiWPbSi2RarW6oLx19GRr
+VenCXKvVd3lzx6T6RUFW2+sHJ3V1TQIAAgqlUZ9xvsJd4CFwgO8imuNUzq7/+y5zzfCZVEb+8HT0
+qXDvz6Jnn/eVf1dVPGbBInyR4lAxCxf7T9rPtJcIJiDc7nB00NvY3A4eJzhB44KJ7YddHNRggeeJ
+/Ye+3R8f797fLoYuTrJL+AurAeov/+1LXx5gGFe70+MntBHD2B8bxqtXzirOF/iOp+4zCPAO28Fk
+VHO6FHMKz6kidJxSoTanmLU6PI8anLMs7qxKXzgno60h7ZnklsdfoGn0f3OqeVgPKZkbN3XqlKMT
+zKmpGcN0uuGjk/QUra3LKIAeOgiXztBJE0eMSI3l2DroX1fn5RW4o0IdmZu/0Ngnx1LOHYSSGcAR
+zBMrmgB1vM6cAHXmUKg0K2lHtYmKpkbOXXLx5Zc+em93YEqwV3Toe2FHVeNyZI3b0y6Ffhia6FuU
+kbE+XcKuq/lq8c4Xn1m15ADPRWSF1JhLFBHDsjyiQkemoXc8PCYvXjp50rKMya5+NrZBcffFvQNv
+UelnWgmBtxRBPH23z8D2NRmZPlO9KksKS+LiGv39G+LjIjUFwyv54YU5OSlHmpuxH97cTO0yphQX
+GGCqMVodka+OiDEGh+gNc309PGNjHE54TCwQbf/D1FMcQyIbDq3bT9eJYqKeKmYT4/TxBw8uQ7mt
+7e0bHzVNTGZnxcTkFJdh2xZ7ccPHTfD0EPxDai3zO5ntnIpPDCU4Zn6iHmDuWJg9ZcrWjZrY2IRi
+KJdHJ8jkY8YwL1ts9y2FTaNYzmMRjPOVBwZaCi0AOOMDzO8gmMQsnWHPASECbBhBZ8jSBNdSYOzA
++EBtDaxaIkYGfrcMCA1sXlxXg/582xEXgMQXe4P5HQSJvo10aAeCbmhHb4nezebq2BTBvfHJh5mi
+f2NB75WmN4yBnKM+1GH4G8cAKYnXC+uzavKDL8tX00ZHJ+CJAOH2VtQ2diwe362tv0WqDXq1Wq3W
+G9SR1C8Wy3nLmxa7Bf5ffGhYWGh8bFhoaKijjaHgb8idlzuRWeiV0TAzMDhtendiFj0BVlhQT4kq
+IiVQ7cIb8zsAxMPV4SVisVscHwv3joWEFeZli9j+jv5E3hHnTVwHhUut6nHApFWsA/O75U3LecI2
+mevq4THqEY4Bjp3KA+oux7Mdblq9Qa8U6qHAtpNoo2sVvsRKhgFqtTFZHSmI5WdZbEnVl09TdEjQ
+WJidpQwt4mVe33t6zqmVy2TsbiypsDgiqd7XY4ITqfnTKIoKsNhmUXSoOj45zl9aBWFhMgMlPiPE
+2Ae1hGOAwnF/qIsdi5k0HR8LcyITYmRyWJIQG6vdWDKcfTu/d7zFKPeNW+jBsQkJTQ46YRxD+pOh
+L9DmOjpwFeHp1v6j4zRueuqXNWRwLHSMDepyvtjOiPmz/9oadufre3rgLesPOK4E383PR3nLPxJ5
+YCmWJTdmuwRwXBkxSY2CanZGl/p/xWWIekqMMPVnV+5rzFCpcLRJ7mvIVKkc8SaDvrDGYBAjTsWu
+lYF7pocER+kc0ae+zzj+5O8fHAQc4/H/B19e+v8fX95Wkw8zsTNPZMjksyyZ6VyEMUhcgwRLdF+0
+wThiZlp6K+qq9vMzFYSF95ce87Kl1uivTE+fSaJyKWXBIbGxY9AsV3mJPOQIPMhd28RPQfsq+/VP
+rSlJ7tKCQljOaBzRNYeSyxKi5XJYnBAbq9m4lRLYcYbn/JU4PmcJDBR78agmeErk5r7FEdOoY/7b
+t55qwOupBsd6an19/T/gsrw79XNRTx4hWohegLLCQtt9OBbdsgjjX4zzeQEFnjtAuODhkjAXz+Bq
+qPyUOmzdRfActQztndDePuHYyxfb0bMbgwNpZKcDQyCg/UM2hgRQe2pqtmyuqdm/eUuNrymxe07i
+7c54oyle0DNv0m9wDAgBWrLPAuspRxBDVFYO59qklkvFrXtyWvTBoRnuXbCtZfbsUdsWorYFm5rm
+zGnatGBBsEyrlZ2H4XKtVsYxljNeeTWbt9TkeT1jsTzjnYuZyfE5Y4uGH3r5m9atN/nDsyjRK9C0
+Yb0pUNQHZ1gWWACQu26OSoRahxT6LCXCTxh0OI46xaBmbil2T0yqLoiMVCrzR01KSCwv25thsVQX
+s3GxVQVFvr7GUY/o9fteDA6RyROi5TIKN7t2w1a6NS9CrfDVqeW+3r7KmJji1oREdzf5tszQUG1k
+qFQyTBESG1Mxfgxldu0Kwng8Rh3gGHKfp9bsYkLynFLux7sGp1J0Z6WNxY+mdCQXsUmxRoV62BLv
+huFCXFgbnSALdmezWw0GT49FMTFhqiKbtsqcQOLCgVEm4ChrJfsiiBgQr8yA/YK6WI26hC7/tnWD
+tj6hmNJEx/vK1rX3BTBni2HnQP9CU9kEQDnjmKEkc6djKiY9Q9TyRD+TB0q1Q/gmqqcGJjc24vCm
+0TBiZnpaTU0rzLl5U+GbagkLp//Ac/N5S41RiQe0nkzVvtNDQuNix5Lxc5BuZnlSolow9/C/CDHO
+k0ICJ6Qn+jn26ptUdNDLncvDwtKNBYW7V5sywsKWd/0b5ssjAiuX6qKCVHJIM+fVG0dkpJijNHJf
+nSY6xZQ5YqPa0jKsKKVhwsLs7AlNpnyfFrKXntpD6xn7UGvLBufa8qKxcHNNc565tbG3Zv7TJzcx
+b1os6IfOzOxF0A1bNhOXz51N6nKInivaf47wgtnJtaGvdvA3dBZbMrk6XWCErJrUxVRQQB2iTsNi
+C+peCLOyOxpN+T7+6o2VmUItBsRPhVupPLHNajLIqYKfS5EjfkriyhY4E22yWChOeI/6gvldmB+h
+sOxONhLVw6XomzKqCy2BKWVYO11ofy4fpU950THXvExfYH4H4f3eUxgGDjvcL1xILYOug2td2fA+
+ytLuvvGTENdS6LC3igl/QSTeL/pJKmeJxMdNUfopOI3BDztT8AKEDRBC9L2zUC1FR5qbm4ohuu8/
+jqZ0WdRLnp5VVZ4ejpJ5rixMr5TVTs2GGTpvX7F+79KxYnv5Kf0GLozEQpNZZOH3dUUTLr1Ttre+
+nG9raJ08vT3meVxwJ/3csATL7QV+fscra0bVN41bgAsTdfrjdCknJfvxsY8ham9W9M39iI4XVTwc
+ASk6MOzRsEBgR4gODNkYEmjAWh4vj7Qzc08Or68f3numpKam5Mu58z759KefPv1kHoC97zB37be4
+DsACAM0GqDKhVSf3I2S7xR56E63BtiXsDWbu2tNFHJVCBQ22+fCM7RZC+9lDb8IeAAbQUZlUUI1W
+oVwqGFL7CaFBdHg1VCls80/uhxQVTMiQsh58y9wF4VwHcAcAGqBax6uhQYkSfoVg/9Wrv9rB/qtc
+B1rz3Xew57tB+Eqz+IbtH1ev7reDX69e3Q8B1/Hdd8IbwG6330O+XDl7VaYFkQBIeOofLCOcC0G+
+LGAKZFpQIMCZRgJvBYCr4VdIdF5L2HGwW8YBDYHjOE4Rv0KmBYUE/1W6EJowPirjagh9DYFfYscJ
++KiMLeJ8nfiXmGcE+uhEP/yvnfgn+uF/LeLXo072D36qTAvWYrhXD2uF3YT/TmYF1yHTghcwHMwF
+u5z87+7Hv855DmYS4b9Y4J+94uR/N3tVoqMuMa8CIHHiozJ2EudL4C8SeLGT/92Ef10//uvQCYzv
+pP8184KI39kP/wqTKuJ39sO/wor8I99++GvA8b+0Ynu54q8BLxJ4KwDsUbduidZr82wg40AUcJ77
+YU7ykyU6r832T9BvMg6UANAPf9ND8Df1x0e+7FHCTxQp9ws2EQQK/DAnSf8pEeDMOgJvRWn98L9i
+E8X6pvXD/4pZ55APe5SfKtF5LWYTcXvBHx3yYU5yJomO2gHWASDj4E9O/reJ7avB+HgWtNtBDgD0
+N6R9hwvtyxSQ9q233+QM/DKZFsQQ+DF2o9h/0thJ3DCZFpQKcOZ5B33eINJ/n9CPddBn9xH6ZQP7
+P28Q+g/7PuknIj4qY/eJ/ec3Ai8bQH+eK337pwPoH3fS78T0ZVoQK/QTfpNIvxPTd+JfYT8V5ZnR
+D/9L9n0RP6Mf/pfMf0T8NLqV4McJ7UJ/Q+BSlEZ9RfDLRfgiB/90q1u3A99rg11N4DIAqD/5epkW
+/EeAo/fQd876zpJpQYIwfjkPuNBxlo7AKwU4XQkX9snTgU9d4sR97qiMPcL5OvCpS07+T/TD/9qJ
+f6If/tdO/DJ2tjje/0HaJdFBH8djMZzeSOAj+vD5KokOzLR/CwskHHumD1+EbyTwsw5+MH0Z8RaI
+fhD0Zz46genLtGCEAHfIE8lFftaAf/yllTn4USBf2s0Ff419Ixnv9QC4LSBySxLlOdwhT7cSog+r
+CP4RrsSxFuW2gF+M+zOXCbtkHH7PiV/pxN9JTRH1bZnbAkE+XCYAffiozK2EKSDwYiKfKseaGIfE
+8bKT9OdkB312H6FfLdAHvwn4qIxD4njZCUAfPh4vTIHLeKkW8U9gfJkWJAtyY3eK+CcwvpO+o32b
+kW8//DVwJJFbEfLth78G/CbI036Te9qtW6LzegycIfzo+85N8rUYbr+LvhbOTzrxZ0p0XtvA6UH4
+ZRhuv4e+GICP6W8GZwfhC/r5HvptCPxND8Hf1A8f68NPif7sEec7gzif1rNFpD/UCv2B+Y8Lvuv8
+6Ipf6cTfCb5z4HMv98M3ETg+f1xO8EeK+vZxx3jhXhbbdxxpRxEflTHlTIHL+BrZhy+MIziOjKNb
+ffjcMAzHM7uEY793zr8vk/Y19Zt/M9AJTN/Jj2N8rbbfZMsF/pnPCf8pBN5ov8nEE/7rMXVqZ76g
+N1ajMracvUf64ecA9OGjMiZe7J/5hP96F/ovSXRee9l8yA6gn4rhzFr7Zlk/fLdVEq3Xdh0UsPvw
+ealE57Xdvgqt78NHZf35Z+/08ePk38EVbgcLea4EgP1GbDdI6m0m8GT7TfogmWcaxHlsEZlnlKiM
+/UbUiw9I/UR8VEYfZGcSuInAGwT66ATGl2mBWZA3C0X8ExjfSf9rJl7E9+2HvwbYyPhLRr70ERf8
+NcAkjtcy7iOCn0rgn7JHnXblOkYn04JGAc6cE/VBWj/8r5z4af3wv3Li+/bD/4I9SuyXROTbD/8L
+5hyBF9lvch+JeuJH0i9SnXbxOr6awFl0XcYBwY4W8LGeWAd+G4QvwHk7cODbfyN2d6dMC9KIXrey
+CtjtOEvH4Xm1ifBzlBkr4At2NxlnOtIu5HyX/UtUhs/ekfaqI3DnGTx3X6In5nPLSH9I7zubx3VI
+dNRL7GIAHGf0XPB7HoJ/ZAh8YtcMgY/nsWbMP5gCF9nf6Ye/ZAj8Sgc+9Sq7WICjKndfcV5aBtg+
+fFRGzhY68C+xwrj3AIBz2HGBhH4GgQOSIw7TbxHHy9gB+PP+J/5xUf53AWDfE8fllwQ/k8C/R2nM
+ZwR/VD/65QCwG4gfk0XadwFzB7evcBaS6OnRBP9vjOBnlKMydgN71YFPXWKEcV+CysjZSQf+JaZI
+xD/RD/9rJ/6Jfvhfi/g5eP50sQcd86f7AP1wXNAPQrvwQU45v8Yu7qsvH+Ss72tifevsN/l0zlfi
+8C+XsomudrpEJ1joMg7mAQAgYDCc8yUxQZ3Z1LeY6IgMhkCzkhcj5fnZn+/de+PLkfsqu9zKCqsi
+I/fkXv/Ilr6Tip+8fId15bHX5PJbleXp2QsvjJ68whZitVISXEaOWEa48048XAxPNgS4FsWrFBoq
+BxPcu3fviwFlM/tKkrtDGv1uJSdiF1DW8ZmjhKLCk61Q2Ativ8n7s7+KNzbHQhNU990HKdwYrIA4
+DxsjpFuQGfQp7LLnn8uH7r/NfHxkxGkbOFX69L0XaR+0feSm8ZUJoZ7oAvqpcWmDVflU+0i49NyL
+38InLOuOL7cprFYKHbn3eMFa29duUSmFReU5YR/1amJhCfpqu9Nm4MIkHE10sP0eKusXC7jkjAWU
+9YsFXBJiAfZ7AHDlbm1O/BMsK/hO9pss4H524p9gmlzwOyVarx0lWDNE9vmWLODuS3ReO+x70DoZ
+BwoG4O98CP7OIfB/lmi9nhyE78H3SHReTw6Bv1Gi9do1mD4fINF57eqPj+XDTxXrqxPkg8cqy4g+
+YxkLuA6x3jpBTmTMutYf+9a76waXZ5LovHbb30BnBvCH8Z94CP4Tg/HdoyVarz1D4P9LovPaMwR9
+XP/Hh8CvlOi8Hu+PT+p/aGD9qVdY1qX+pkH1f4Vpcthy2PeXOHz/S07fvwz7/hKH73+JWecSi2hz
+xgpOsEmO/sWcJP2rROxf6/vw+ccd+F5WNhk+5cSvd+IfdcF/U+iPA2MdrL/YHz8h7e8aGyH9cSA+
+847YHwfifyf0x0H0s8T+OJg+6Y9DxF6E/tgPH8uTnyrWVyfIk/RHvaM9mJNch1hvnSBX0h/Xu/jq
+bU7f/gT9LXmP7Ffj/iXRUSfoxfZeCQecvjGOBUgc+Jfob/v5xo5YALHgBV+Re5q9J9ECfb+5F/cT
+hrR3jdjeh118mzaZA/8Eu9ipTxjS3jVie7/kgt+J22mQL5Qmtt89tM7pC/Xh7xyEz4jtNxj/Z9xO
+g/DHiO03GH8jbqfB9MX264eP5cN/KdZXJ8gHt4+j3lhOXIdYb50gJ9J+Qv1H2m9yf4q+3SISG2tz
+wNk/sA0G5oLDmA84fgD+/IfgWwbju7XhfsAuHoT/M4YzLw3E57F8rUPQr5foqN396aMy7k9O4qh5
+Hz4qY/9g2hw1duKLNr/EYZNf6m/zE/yviE3ramO3OW34E+wx2O2wsUl/ahT703nBRrLfdGsX5XmU
+8D+TwA32m9xMUZ7nCD+zBuDPfwi+ZTC+KM9jg/AFeZ4fRF+Q52D6gjz70Udlbu3caiLPowD04aMy
+bqYoz6/68EUbFceKMonemM98CRc6bDYuRbTZOGDxuOSwpdn32KsOfOoS82WfjcfoRHzcLo/30Xeb
+7MQ/wVx3of+zE/9EP5u508mPlfkn7Hbi1zvxiY8DKKy/3GazV4EXkDpyqIknwKVQJVW1nj7NgTNU
++eoHh9EyuMTK3LRaH8TfQevYUQ/iHTnYJnG+QAmC+ll8mIzKsVQLpSopc2nX/a/x7lz4F0zcCf/a
+tQvtRZ9arVZGZ13R82BFzwPrg88Z3YPPHfr6Vfa/3MsSHZ8CfsESpGMGwGeAW0PCLeD6kPAq8I8h
+4QUwdEh4NYwcCHeTS3R8Ibg1JLwGnOmDC3YB97JEy6csx1BPAPrBdfwMexcQn9jtgAWA/5HgWw4D
+CU97QiHXCO2EVw2Ec6cIvGAjoTJLkBsHAHeDwKtfJvhgh4udIpdo+cKNg/gR+LfPdeFnvP3/mE6B
+/zkYmgBAPziW/zYgPumHb5k4FL6Wr5o1NJ0CcHwIOjq+GrzpAucB4O4K/QEdxT2Y9oHZIi0GAO66
+wBM6JD6LE5+xALj96iJXHzrfIT+3X13k6gqvd8qVp32oAw65uq13kasPdV3Up/Wsh8AXlBF+kwbA
+Z4DfhoRbwO0h4Y5+OhBeAP2HhFdDn4FwsZ/ahoTXgF9c4DwAbjP7yVXqKle3Cf3kKoX6Prl6LHOR
+q5SudMjPY7WLXKV0nQPu/o2LXKXUYYdcPYJc5CqlfnDA3c1CPezThLIpRiybB8A9Weyz00W+kPis
+yH6U3iD02ydwLfWD4DP2uMDtN51wy6NDw6vWu9IJcsILDrji9zrh1Qdd8dMxXBh3T7vCk53wmp2u
+cB4A5lC/9pDD0r72YLb2aw+5a3twB13aQ06XOPXEEZf2kPe1E8e5tIe8r59zJS7tIe9rD3ZKv/aQ
+Ux59PLON/dpDDv90sdMZoT7gNdLvUgfAZ4B3hoRbwMEh4VXg5SHhBZAaEl4N7rjAeftN7rqLfDk6
+IJPI1n6Tj+gn2wCw3CFb+033bS6yDaAEW56233Tf6CLbAOquA87/4iLbACisc3H2m25JLrINgJvF
+vmljM0UZfUB4yh4Ad8hoINwCjg0JrwInhoQXQH5IeDWEA+GiDnlvSHhNPzgDAD+7n+xC4SEAnON+
+k4uMQul3nONb7SKLULrZqY/S+vWlUKpLpFVt/4sfLurab0j5+QPgM+DVIeEWeGFIeBV8d0h4Abw3
+JLwa2gbCBTnBj4eE18B/usB5AHhlv74XUeeQX1M/+UXgWdsxrt3fc+l7ETTnlOtVF7lG0DLn/CV3
+6XsRsMo5fzW5yDsC7nDA+cv9xnUElPSNa7ewfm0RAUcBV/usQ6Lj24awtzokHK1xmc8FvN2D5nmM
+p3WZzwS8LwbNcxhP2DMSTPRsh0TLt20arN8xXpTL+BfovTRIL2C8QpcxIOC9NWhsYLwilz5A8OCy
+QX0D4xWLfnmkYD9S93EbSjhu1UPgqx8CX/MQ+NqHwB99CHxdH9xN7gJf/xD4BjE+FSnapyawn8A3
+PgQu7JFgUSSxT3W8iSJrdpyg0+hB8C0OOLFbdbwJ+hH4VgLnUCR3Q4SnE/i2vnLd5C7lbn8I/DGx
+v0QSu0hL3cfzPLcPgCHhzz4Evv8h8OceAj/wEPjzrnA3uRN+8CHwF0Q4jyJFe8xEs6RehwicQZGi
+LWai/iLwFx3yJ3aYjjexCgI/7JCzx2oRHkTgLzngxA7T8SZmNoEfccjfI0iE/43A/+aAi3aYiSZz
+E3fUwadog5loolu5Y8J4QJGCrqTuw7cJ/IuHwL98CPz6Q+BfPQT+9UPg3/TB3eQu8G8fAv/OUS9R
+R5vgDQK/4ZC/qKNNFPELuZsO+bu/J8ptO4Hfcsr5qgh/msC/d8Dd5CKdrwn8tkPObiJ9WqD/fw64
+qJ9N1F4C/8HZT8JE+I8E/i8X/Svs6TozSC8La/2fusKJ3uiQabkfSVzgPtjRB2cKZVo6ZjAc49Oa
+/nBCv16i483gnUH062VaPt4VX/A7+UWYTyiBJQ6/U4QLexV8YYEDTuzV7YL+dfipJU58on+pPr3A
+Xu3TC8L7sIG5QGH/jePJ3gG4F0VS79lXSTjqPgAAOp4DGQCQ69vhT3ZG61Lgj9kxsbG6vILk+IDY
+9rZc9gl3XXR2SnJsan5qU1T+vMQEzONuNoju4OolHLWb8LyBjaZn84eJTACsl3BgiouuwDK/iznE
+GqAPjteI6KTBcCJz3QA4nhsFmf/Rb87E+Fjmmf3waQD4HS4yxH7ybdF/+RYAPpLIkXHaF7H9cKXw
+zz5ct5cILivGtCOF/Xm8SbSnpATexFyg3yF9ug2GYxp8ip0SaIBu5gIt2IXTg4UnoQS+m7lAdxC/
+KIW8gSX3lt0Ocsn8bpJoqR07Bs/7wyRaMLOff8VhOH9fouVbN7rCpQT+XwlHe5DvWvJ+vUTLmwf7
+efxhiZZP7fc+ifcLeynpb2C3hKPlLnCXPWwinMa+UT85yl3lyHxB5CjYdA1Oec1ANlJ7IjdhHDEX
+6GfEZ/exjPnpCf3lNaOfvAT7RdADpwfZNYIeeH0gnL8v0fGt4OQgOJaXsAd0GapngeirCDZs+gD4
+jH5woTyhj34+iC4eG6ngTB9cjF8Ke1ULiHxDXeCCXtDCAgec2Ltp/eTr9BdIn/4bka+b009R9LOz
+g2CBgAt6yFi9L9GCKYLk7T/B7eIYXknqL+y92cDcpQ5gmsDiMu/gsfyHoN/g231wHM+l8wfDyVgu
+7g/HdiT7Xwc+b4ZX7M848DGc7+iHT9tvupn61TsAPuaot/0md4bUm3fKaHY/3Ah4tE9G3H8IrjvB
+HYUiuXN4bxZvghsFHwXDYTNzl55N6j2X6Mtm5gK1WdSXfkpe219lQltU7vj2mID4pMI8bVxcNPtE
+T0LivPyoptT81NjklOxoHQAQdMK91E3qMKCB5DgFGAgYyfUPk4HkyodJcpVU1UnNsa2nDqNfMV8i
+rozjlwj3WsGDrJraBWjg/Sp5N/Fjx5sK1Qzm+IMyatc9AY/e+lA8OrL3uhOP74/3oQseH/mniAfg
+QTcwFJ5UpVC5gT8BRrPboRsKoaO5EIkOjMXxGwkHhD0bAIUwwH5XogWknfFvKAcQbKAPUDXsPeAG
+QnK9aMAAtwXuboCezLpLrvSeT0sGkt/OfyhNS4L4djJVisxM1bQ2ov+4HTzMTrK8tc78CQxqBQCC
+NnodpWOvAjcQlutNM8CNme3uBtjJgHaXXDl/R0YoXT8vTUuS4wvGVRzFt7U2QG+3g4cZmeXNDeZP
+0Q/jAARZ6A+KgjnADYTmehE6g8hcIWSUDjJZIpkVljfXmz9Bt1tBf34A5LWUDiegoHSTDx90g94N
+jGz1OOj/qXnDmwAACsuAURMZSIE+138YDbwYdyB1WyCTDhOFMWyytwwLBMtEKlQm87z+Q700zUU0
+rOMDo25tQr+6vfAS2jVAWIHj1vRJDZfdRq+j/yR8SoExN2AYTUr2YmYLZeOae092HyaTXDnfSwTg
+KP165nmhdIccNI4PlKG1Hvq4HTxse2eQhFc7PuCys9Af9GUiaykw5Po7y/7fRV9xFs0OLnpYaxP0
+di16Bakuut262qV5BtS7r4XYAU3lU08lD2ozS1/jQZBmz6D/YK8CT5CaG+JBH/WkXnZnjrqxYDY3
+jOZgHUvV8KDO3dNtmORK5vlb+t7MW/rzyUBy/rcrmcK3JGigNQqNXC1nTSw8cDMa7kBTom9Goylw
+B3sVlaqvqR8cYWrU19Su5SnAuFyTL31UQb0sZ46y3u5HvTxe9nE7ystYIOE9wWw/N9qTg3UePEtT
+NdJhoE7urZBJaC8/yRVl5nn9Lb2+V5l5S68/r08GkltXzusl7+gltwlHEszYbSdjfdzR/Vh0ZTTa
+lV10H/WqP1Oj4fA19WdqyEAOMw+hDm6h/2BagRJIjss4/2HAX3JF1CLQmGIW8pGStBi8kKo0AUKd
+yWBaPnz4Cr3RlGIwrCgdvhznfr1fulxvMKXojcuGl64wGFLwI0D0sw7mMUXMUUCRXMhQR4fBPLoT
+lQAAwQH7TUoNW4EnAGbxrBc+Ynagq3JEV9eIyq59lV3kIwAUxmVOwVbgTu5sEu9ciNBqXN6j87v2
+dHXt6bK1DyQwQvwLaEyHbyZlKoEaaHPlQT70LJXCvSuSo4CMGhZK+UdKrtzB/8iwyryj1ye5lvKw
+z+xXjmJsE7oq8afK/8HJCGfVAI/36XrcZO+JPCUCA8gBZWBqrllvSDIkJeuTY3KHLQKhi2L8F0UW
+yRYZQbqe60qKXFCucKN93Nwtblnuw92SzSo3nTnIzRBfjjXj+St3JOfvfvTPu5K7/7yLe5T+t8zM
+8/o7evxdclefVFbbyEvY9/BPUwR01MJkTFE6sruYjFro8lnngqMyQPXD3nFvPv3+B6dOffDBqVyz
+OTcvNfXPsNzUVPwh7/QHH5w+9cEHTOXh3ubDTMbp99/HX0/lpabhx7Tk0/MXLl++cP7T9rUHX3h0
+/fMH1lHfrDvw/Hr8acKnFy784x8XLnz613/oeCee4ykAALhjOXqPYe8BD+AHVCAepAELKAa1YIwj
+L174QF41Q4MHVJsk0pEMqjTThzXs6un3P0CP9NW5t2AA4P8lBGBfTqQwhBDa/6dY0Nx//AM+OqRY
+AIAgimmFP7FXAQ1AEFTBqJegH/qGaYXdSIjLRTGtdBvxmfbCecSe3+uAM58LcLyaINFiuIQXsPrT
+hWqogj/tQ99AP0wYOOlOJO8/AxcSus8MoPsModvvOYDgExTAeoNMwGK6GlYNWe/30SH04vtUGAp4
+8IBSAwj2ghXUbCYecMA/14NiAE/RYBLLS67c0ZMJ6cO0JKig5Qpoombfu4dimfht6BQ6vR0uO0jK
+OAtWUDsc77M04Fk4iQa85ErmHcf7cqiAJjl9Fl5Fsb/8wsSjJQfRku0wD+bb7WCl/SazhOxdYZZh
+24mhhJxcGF7H3gMyEEoyYGEFyih8GV6h1tH4YLNa7qIwoJA3YOXCbyf3Qm/04Pd1sN1PluFu/dSh
+IeDYggBZHns4IuKrj97/l+yjz6jL3vL85b3RovJgzqMwv8BRu+dge46tYADnKVhyvOB/x9tfdaO4
+gxId8KIgeB3/ZYBtgYQHXgDYFgAAIFphv0ldZu8BGkTnSika0ABSMIbqAgsYSDOSK3huvHVrgLYw
+QDUVfai3+RB776//CHoeoF9pM+eLdbmQB0fIrEG1XbW9R5mvXqXqbH49fESPzW8Mxgdb0K/UewK+
+3HGhnUGq3kKl2i5evXr1Kuf759c91I9jcFCJnKmy33TrIPvPIk5hmUcwwjrDSvtNrk6AEzuWoa8O
+gAttFEHaCIJt9gxmN3sVSLBOYPDUliRVC/9M1KlrkLnz2a5nd81kr/5w6vWvpL0vcyF/3eRCFgEA
+KLAbrqA7mFrsJwAADVJeJ0yKwlxJbbx2bT1F5kMyRzI1Nj2EeCbcjifHQe/rpAYzmV/J9BoL4axr
+19YPX27C86hh+XAKroDUxzj30/LteErF7QkAQ9GvyYT2e5fwZEInKDN9B7gBOckeK9xHJE/itZQp
+CVtO8OiMvZ1dezpXd54+CUPa6PjKGTP2zOx6cHHjdFjwXeOjnwj21zT7LfoRbhyQAjUA0AvyGqla
+yurioZmVGqQa+SAI/Qj6E7ploT/WoN+zoCf5tQZ6ZA0JZTvXrZu5Df0M5dtmrlvXtQXK0c9beocN
+ASTnC+35jBbrCCC1T6PJX4q2/ynhgQyQ9TwAQTu8wO+hc8jZcSHPKc6IEIkzZJmEPHhhOMNYCr9n
+/a+75j0IHObhi9DW2Vc3b76347HrQcP8/np29lU65+P6mnSOptn8kuEfN1Sn8TRXUOLQU1zVIP3n
+0JI8v5eZQOSWRe5VuAe8CSfOPF08J1H6KSOcucwkPAfPlYUEB4eUlQeHhASXv4VzlLz1ZvuECe3U
+9czMX+9lZWVmouDMzNwZM+CeGTNmzEDtM2YIbRNHHYbvkzzpCgBcVYjcmWxnbl/qaZxDCWazu8XU
+07ZlJEcOppNMHaYzCK9SUJUbJb3I8ZzkIs9zMRLex/2iJ+NFXQQf8wtkUhYm+9BTPUDyMDdWJrly
+JfPW+d7MW1LiafTe0V/JvKOXpglKgfmZl7A/N0VAco2qCRpIWi+DRkrTGtv9RegxuKB327ZFaN49
+6vAYayOlsP17jPUzWIleJu04hdpDY19Lg9vRkcJQyOw04DpKsltfLaW+QHxMR9pua0fHhBMVu2rL
+mbj4htHx8XFxTUlajSqHvbf51eDglopHVpWV+SlfqYqKMqd0Tk8xy6SR8DlBnjXUHjqHvQfCSN63
+vnRq6ghH9MAl95yMFK8V0q3D7Bi9IXNLbc3JF8+2j5/R+Xna8rIKSWxcUZdBX7Y8ODh1eVBwMLMt
+2Me7dPiyNds7Jlz4dtYsiXRLakiIpcD2SnLS3HmtSUnz5iaRundQh+kA4mcCdzyJ4v1udABasBQu
+QAkN1Ctsu62EeqELegIaWESeg4AOmIR8DFhKQpoEsxj2CCcwnVErWibmFJzVR2AdZ1Wgmrsy0tvG
+o41t4+d2jk9ObmqG9a/qk7cmzUlKSs/wVwaEhG4OCQ0N2RwSyoyTpKa2zMzMysqc2dIl8U1MKJlk
+Ns+YVJKQuK8hP7+hPjc8HH3SrtVqte0dOp1Oh/fgUXtoA3sPDMNyBY5icePJOd5Vuo7MXc6ELPA+
+FlzQ8tTgpBiDPnNzTe2o0S+evdg544vU/LzSckljUadBzx5NSp47Lympdd7cZDQ/xNundPiytcNL
+Pzz/7axZbu6SLanpBRYi17XUS/RRdoYwF6nwdUBSlUklpSbAMnR8CzoOy9gZ6Jsl6BuoWgJVgj5Z
+S39EH2XKAU3yk6nW0rrez5nyJaS/rKVr6KPMe4AGvPBU6sAgPx8vhPMWYRrzoY3k7hoGANRSEnKF
+Mk7MT4Vd+fTjz8Y3No6HtgII4ULb8HzTJ6feumQUys6HNviD8J5cuOpSZwqFEiofv/LPS/+Atnzj
+pbdOfWLKH25DjyA7fmcsdZj+gvUhZ2j659saIiPX2LY2WBKdYqruzshsQ+21fn6pharwOpx35MOC
+OpO/MiNjNnrPYoFZZaEhcXFj0W8WrAOzAKBL6DoZz78DgK2d6MVNQEL9hmFggRNWDyS0N4HNccKa
+AaATCazbCUsGEjqD0LvohE0BgFYT2HtOWA0AdA6Bve+EdQAJHUBgHzhhFifeh05YEQC0gcA+csLW
+Ail9lPqHjOc/7oPBZPooPCrj+U9c8E7QR6l4Gc9fcsLm28dRYfQkGc9/6oTl28fBHwjsHy4yAHQx
+NVnGswn9YEEEZuwHKyWwLBdZSehFJNfw00O8a+qH9wU9T8ZTs/rJ/hTmhU13xaNYgrfXFY9tI/Qy
+nLCxmB7OD8xf7sOza+l1BC/PhZ6MukatlPHUU678UStpbxnP5gswQINYANgO9irQgGgh55gK/8N9
+Ezr38yrIP6kKOlJJmg06NWswqeMgRHYIT8PL0GP9KcrjNKQQwj/oPvrXhlO2P05vqK6CVDCsouKt
+T1nhXqvtX3Cv1YrarE9ZbZepeFRutcJXrU899RQA0P6n/SabzN4DRtCdmxPPzXUDm5O76aBNgWHd
+ygj53Fi3SKlyAd1lio/V0FGRcWq5m0nq4057RSTE+XJe6rioYFmgEUQpZbyXSXLlvP7OFeJRn5el
+peH/lWnSNBz/kirTiIF8JfNDh2+N7mD3GnKMOgLQarPBKKg9k9pskAGD8+5qjlfxKkE/qHgVyalj
+ZpO1CP0DoWPGUyYrpGl4xLTWCEegabfXzY3aMmf5diX8LAU10RQNYY0J3fF97JGuLUHw0Dc/wEjo
+OY/3MSkCUj24edATRmZb0Jbzr33x7by4ZF86RTnv6+svPQcYEIW63RXsReAHIkAiyADAYDakmOW6
+CKkCJ51WGcKlvhRPqcz4sll8qI2mOCgPhPieRKCO0NFyP4Nehm9P5Hxg1O7EqZFPKA//9tQ+uC+X
+ks4ff8P2wuRC6A0jHpkXNtdQRq3bV/HHj3Ay+tv/FWXWr4rMbN/alVmslVyYepJ2g6g3cAyU7t2H
+uL+jRxaimxfgY9C66RnbEWR/xtZBx8EVUI+2ok9RUSSqofZWQvPVM2tPVwEAGHJWXcFeBQyIBklk
+RjTozSro3PWNf1RiNmQ834hJkXHSUVqhwnOSRoUvi3q663QB2mA7c7q5ubn5NF3z17Z69Zgiiioa
+pW7c/ie6++9/w3UUhbrhzMI3u6ibh3pnW2nfqfVTn7XOK2yd6qdU+E5tL+5BPlamst5XofSb+qyg
+02PtN7nP2Hsgl+Smx8cMVUKWLuGWSI5RC1nscGJtIUVxSj8zByvzcDLHqxTsMZ/w+Ep4qXfXi6mp
++/acuha6eDv6diu6tGfPsD+gKtTdUIv09LTXko2l5dtPtLUFL921ceNy6LZjOw8pulAdYdBZYwz6
+5llzDxxEew367rk3CtKsiR4Zsdbk+OTRk/SG4pLdsDTOz2/hgq/1sVYSb0NX2WROCVQgRqyBVC3V
+6rRSCc5+Z1CQrPIkwyZm1QxxNjypROnHJmuXjVpl/e2FO3f2/2VNSZ29bm5UiXlEhbkkCD3dqJ/Y
+kQQPfTOn1h/9C3pTmy/Cclj08Wbqh3tuPmG4w7YUSh+8IMlreA4dZ+oU1QfRLXTnbzWAImfcF5B4
+A7kdS6WA5O5zrEsMCiXb/uCdd5CEbUc+77Lla9fef9VKv7J2bW+FFQAIRgPA5rBXgQ4AuVnFq8iI
+48SbCbANTeP7xEiWXbPKTDJkjzYxL6WgnBYlXJmC/u7e0v5BU1ZmwhePI8XdwnH1Ix5bX1bMIp0J
+Pi5LLFvPjpk3r7x73urqlkCvhPhG1GqF59ZX9JQXL5lXnFAu9AcdAOxYohvJ6QZeZzbxOrN4h7hZ
+yeMfqT4S9wdfiucYdevpU6l/nEn98zQNzryR/vtb6f/9ErKKv8MVwyf3oKMnrfBgk3X27ibrHDjf
+ar3QuGDW400LZtuO//t+zwvovxPmjBPOVLBjSExd47g1su/yfpPj4kgcoYiFRC8bFNTTfotWr1rs
+ZztzmvxHffMX1KLP/3wKnkNZ/6ZuvrRl84v391uB3Wq1Ulbrz+9e/MVqtVsFH73SftM9gUUSLZi1
+B/vcWnGvWyUAbh+4wKMxHEBQCACr5nzJrflQbVbRTuufNvAquTPlMV0ElXuVFFhU++qiV4rjvaft
+UiJupfcw/5FTV6jmsHt6bIvG1k2xTMsqxdfoHilKigs0Pvmi7ZDjbvUI4awxDJc6KBPCnlAl53Vm
+udqs4jVKls6G9FdQ10e99XzuHLT3woGwr9Jx6cmQD0IXDLWvMgXo/8SiSpoDjeh12yH69+L2sTC2
+B82cuAr9d2wdIDpqhP2mWw37OfAEvsCfZCQVfRscTQRaMZ00STtmTDHw+EocFbnayCwmJ4W8ztrS
+MLLZdvgIDGxZ1ty0ohmlUo22g+jJFVfgKgu6b1tDPpyGl6kpTPXc7gMHu+bdhjU/oAs9PfUN3fNQ
++GrmyKNPPplW9aCaMfReJh+ZWuuDm4ACd+lt3OvsVeADosDS3GJpSFgwQ0WGB/p5sNxrIW7e1Ota
+32E081qEv5tMAmZHh4ZIpIxKFhTgBQJGB6lGhynlo4MUOvXoMA0NgftoT8iPZqMlV26dv37rvOTj
+qx/f0v/zY7x8Ibkl+Vgqw7OmEFM/Ly52SM7fVeqlZErF65tqk8MoUCvUmiCXfNO0mnY8Md2lTOUr
+6tGb9SvK6LUHDvxeX4/eqt8385l6O6irY6++2oZaq6rg022voi+hpoZ8mYhuwhD0OAbX4LVlAMja
+MllTBhCMsn/PnWOvgigxc7RKwVFYl4WbU6QSklARNwb2uCTE48K3aVDZ6OW11Fd7nm5vh0vbx1dX
+76a+XoOO/Tx//nff2oK/+27+fDZkETSdDUeX0B/o891PPLEbRkN29+PhZ9EHi0Y9/cx1dP7s22+f
+hZnXn3nakTuJvSrhQILL/qPpEg6sJjwGAcBWknUmAAWxuETZMH9s5enT9z9aS01CK9euhQt+Yq9a
+H4RYYetn8JtTFHOKmmYldIz2m+xF4X5TeUqk40b5SJ0UWyAyk5HCA05qkKqMm2HKc7AbvooK0OLH
+0C/WRf9dePuve19lToz+yXaQbS8qQmPRE2gnaks1Q1NxEYS//AwpHwn6GiEAgQwAxsxeBSwA0VCq
+kqoYM1piG8VomJehGkDQCACzSHhOHkuZRQ++pPazV+/HQjUjxAYgAOxvwpptNHSHKozI/oZa0GF0
+GDVTaXAZ5Wu7Q7dS3bZ1AFAg3H6Tu8HeAwkgFftpwo35jjTpTN+VzELCSegtXkiSDc06NQuzxRvo
+wuE3S9p/3QTjYO1LfzcY0M9/lV5YuEw2Z+qEOa/4eiBjZoH7CTTsqLSl9OND1JuUyZx6dX/lNvby
+iqoqO/j22f+rtcxP23/CXwlTF85qrRl1wtcd3ih4eWZPz9YbDZ9Ns71q2Vn7whfJAEDgDwCznNHh
+NjUMaE3hk78V/oDuWK3Q93nq6PPPQ9UBqF8FV66Ca8iV6mS/iP0mf4/EORf8C8enXqR3kPjUB8xn
+tnbhuZvHEM8/6nvu+TR7z/nEgfeOW6OtXcKDBS50OIwHujFExOOFt4TzsuS5FvuAfeWA7r73+fuk
+nPce9v6A53OA6/Nm8bkW+5NOrL7nyY7n/MUhn2P+/Qj994csf4r4XIv9UCfWUO9/OOT7zYOed4Oh
+6Duf8+/1e94x6PkH/Z732G9yk53t2Acn+73YqzKOxFzInM+1kvVvH9dzlAYFHjzCKcozvbmOE5Qh
+VnbUgxDmprB+0QqAu+Nd+eC3VQIFd3DmzF+5EOcxHUBFoAQJnWT2KvBzoeEu3A8M1cTxaz19mk3c
+ArfBHS/DNxfZPrpr++jMGUKsV01/ZbXe348pWh25ckj90gAAEH93uyrQJtMBVAuERfpSg4K9evo0
+obiBelMogskhxDFRRwEPQrA6BBSm5ynQC3woRQ3WO4Oo8jlnzthOo2WDKVuxKACNaXs7aIf+T+qq
+IUvwEEtASx5aCv5xtLs7YK+CQBA2WOruwgUC+A+UqtwhFv/o/VAFQ49Qbq+jTfDHDTblAfQOeu91
+2x7oAdeieNIYaBTcb7XafrCZrFb4Dkon7UxlUAdBXx4d0jYZhAcPANwOOHhwljmguvg+OpU7NFAn
+cGl3X8eFraVuC9ww7505c3//AaobXcRcnaZyULrVikt0MCNUHLMk9FkPADyFMkOB+n+WimX88JL5
+986c+e/+A2w3Eff/Lv1BiMiBmOdhNpFBokOPsX+w92Qc0PblKCXPdX05+sh3Z24+kiuQA+WO7+x3
+ffkXQDnt4czh95MTrhPg+Dnn63jO33R5r0l4T8gphe/aF3NDfQkAv4jgNQnvMwcccI/VTrgOlPNH
+Bz732uL63OPkUHiEj69c+GgR+Se5lQgfLY7v/B6C1yK+f8EB9zjghGP6n/R73ievEc58GiuYAhkH
+ivpyv5LnxX25D8nzKqeOu0p0nBwEYbsiHEjE3zCCksgysKYz6GUSSs3ttkHUhUbCQ3ALZYdb4CE0
+8sFzP137/Md1cNm6Hz+/9hNcRl2BS9FSWxxawo5CV21z0BXcPWActR7GOsrD7Q+GgVDcA3CHJDZX
+NDQZVAa9TCqhYiH2pqUSnuMDoCOXfNhpCsGtuEjUaaNOiwWuOzR9955pLySFZGWHsKOsaCnaiR5D
+S6wwDhbDQhg7KmzydvQn+n1bRxh9ofdkgGHiv//bngAA66w3D5QgTIj84LKweStVkPoKbEh4ToN9
+eYnST25SCxIxCXfy0H2iUrFfJoZm5QQnunBl+2diSHZWSNI6IqEXpu3ZPV0xUHyoC6YGGCbCH9oT
+AnozcgU5YaZtP2/raKWLAhLakf9EA/03UZi5YR3bKPn2yQ/CXEVNclML44zkJSdGHW6xCBI5cVi1
+4ZH178a+9dj1K1e+eOFj3ZJnYPhKOB++hoajVQihj+GhgwdgLrUb5r3xJuzMy0Vj0W70BBr7LcD0
+xX4FAh30HTezqaXZUCwgQlt3wHvZ1EfnL9o+a7Gkq/tGJ5RBI7qIbr3/EyzvWfrJv/97ccVqqIuJ
+Qddwq6AvXhHyaqN6wnuQYPtyaoGcnhFDg5RERqKBf6Alk1eiVXjfFpy/EoY/s0T38QtfXLly/bG3
+YuGh3sPwxVD4DJwAO+AzuXloy5tvoNO2DnTmwEEcux9grzksMg7H8gEE5eA3LodJJbmlFSq5Gpqg
+geZyHrQuN6Kx8Bnjcpq5jj6ET3rAJ9GHAMLXAWB62f9gfJVaypqgASo09MU+HObp5Ub4DBprXE7o
+d4PfuEkCfblJRRugAqrl3X1IVNR1aETjPdB4aASYvv0zppd9n/BjkGoUUA1NLHWnD4l9v483kX9M
+3wcAKFJ31IJVOArpfbSvNk5CfbXqK/c/hI5Yal/tBr8DY5wU57rWFyaATPZV5m3Mv4aHtI42s0qW
+fXVP7xuLNRRPlWrQB9f20IXoAw1VylOaxfgdADLZVeI7Oqgxa5Q0T8M9rmiZe+hCTMB2QgNNwjtT
+2FXMPSInM5Qr5bxGp4HAFY2p29P7BjRpbCdIQZi3Keyr4jtKyPKsTm6Ws/6uWMw9V06JfI9zOcw8
+IMXv0CqFitY5Bayi//xkGrxl+3D1J9PQYodA4D+YeWXo2nW0oQxGuQgZ94Xj3KQ+Wo7mwhRNKjrp
+k2lwuUOWKAHTDKaMhFaf/DHN68J+69XcaBoBNbmxS+lHtl6EwjDI6xKgLgJvpdBFaBMdLUAFRTTF
+xTdG5L3YUP9S/rQ90/DXJlX+oYb6w/nTFmtonirToPepOxGREKoj8iywKH/6E9MiIqFalW+BxZZp
+6H0NVcbTuMUAgKABruaKxPLNYpPh3ZEc3gii9oY+UGfOhsLGDnM2ZB7vIzAt/3B9w6F8VVN8XFPE
+tD3T8l+qb3gxL6IxPq6JukN4sR3XwJRplmJoyVepYWTEtCem5xdBS16EGsJI0G/cSh3jVi02ioGM
+397m5Ub0ntTRJlJodoxklz5sdPR9cUxLHWPaJPZ8hXNs93+LPrDcCM1SR2NJ0XvG5S5jXeoY6wax
+iTF33UO9JIz+D13HlxG4jMf3gdShBxTiqDQ59UH/19j3h6qzKKt/c1VMBhnbzr4LlVABeWatzc/x
+AvUjTN+AfoR+G6i+fgvNG9DPHujnDQCCdvBvbpJAxx3qoAmaoaMTU7HonQ3QD/24AaZTP/YpN/Te
+Bij3gPIN6D0XNefSfl5knxRemXZorfHXr/dmuuiroN67MMh1HDnbywsADc5qo3LRVP2xr1+/Tp8f
+Uh+TctU4wCPWAFa74lJR6Htahr4fUjd7CfcfKFR9+nkANnPXtRKuPCscPJtcNCym48J7/86G64Cb
+krrmYM4WhRvadRxsJbueTGSLprOF8WWIrMfS48eX9j7mlOdUjdFIn7h+3A6OO0sxlhpdZCPQIlfD
+OVUUuVQeHtIYjRp6qnNWeQzTpk8YS43Oih+H4DgAgHLyRaLakFaZjCnhfhIuFuKLMjCH7pDc1hjB
+KSjVcioFv3h8adN12/sCq9T5Jo3RWGpkUtGHTUuPY357e105NmpIOQ6ehXJEdnEEFbMc7hcCo3E4
+i9SG2eaQ3/vXMUkIjlMpvXcJLSZVrEBvr8iJULxTxrjPxAuRa7w+Iv7JcMy56giykSOC7NeJhWIT
+wHd0AZG6UEPG/QxDqC4yQKdzyC4oND2ruqi4ubioOis9NKivu2uN6/ftW2/UOiXavGPXrh3N/eaR
+cnEeUbrM+a7TklzpMvejrXgmueEoAc5Y/ck0muk/o/T1t9ll6BrRP2PtX7M72c9IX6c1PNRBMytn
+5UqaubICFrkjlQd62R0GVNge335tDANsgWVG9BIFbxpOwOPtdJHO9pOgxw4BwHzH/ofsQVH1DXPK
+Bm9dR3+KXDGnH+TRN0UuhPcuA8B6iO856ihVS+nXnPX68zpz2lmN3hDmtFCe/TPmO/Z98p5DHlKD
+lLI5BeB2HXo6K0zffJAnlGf/jPUQ31P1qQX6NRR8HbqJhUJP5nRviFiowKcneJ39B/Mv8p7DblKZ
+VMxeJ2u24fQ2ytOpZKnr5L14MJ0dwxoH8bnUBRE+4+STeq13On4P7IEq9j6zgbS9MQFG4OU7MylX
+mWJOiQxnZAo2dNzB8weTwz0/QO96oHcvD8uphF4wE3rtoYraDo4bV5ea3SUU0r1o2uo9e9B/9pK6
+gJNQxfmLtNVGWWQ4o/RTisTxtB7hDeGO63sEYpU5wy4T+h94hicfPH9wHFW0F3rt2bN62qJugXpX
+dmrduHHkgD0FfbBdyN4ENOlRJpVC7rQOoQ+zw8fV7GL569cffNrfSiRnVO07uBx2h0TntgMAOEbC
+u+0EAO0jvP8ExrNfMx+CUACguK8KmIxmJee8fsqo40l5zH9XHD7067/RoRf3TJ069bEdU6fm52hK
+eYrRMEHPXLp3+EVY/+9fX9wzedeOKdOmTd0xp+XrSIrlSzWCjP4JxrPvieXoiNmjcy3C7KfwBcIO
+L/jW/2kohi/V5ORPnbrjsalTp+55ER3696+HDjNB+ZpS3vYg8uuWOTumTps2ZceuyXteJCwdvndJ
+KKeIiiLzI0fuVDLQKkUR5WlczmRSUR4Uhz68jnGwjqUZgqMiRlAR+9eD88uNtt+JrUNxhBZ1h8w9
+fwFO0JAaqZq6IxBh/7L9blz+4Dxw2EH0RRFPSgwhYvxQHPvXfZ7JXG6kPIV5goriZgi84XmQNkBu
+hgOBWDS2+875ZIbAnzCATdwMoTya8bDdh8brIn/2T8m8ygFAttQqIDFwbPcTHdVx2ESf0hdFPDLQ
+DPRFgU6iIBuSX3AHN4n0kQKxj+QLfYTEpj/jmtn/SHRuhXAMjqa4WZAjH0Xfe/niewXO98rtei6H
+vS8+e1ukKZyP/QMA7hShaYFvizQd+bq67XpuEnkP08oWaArnRe1/2D/jTrHvY14wvoR3K8RPxFhN
+HDeDBRKd2wwAwEUJ7zYbAHvqgGedGCbh3WZhHHHOFXwVBbmtWIn3WpAfnVmtJCqGV9FmFa+k//wk
+Du5Hinwq3ra++JO4P1OFAZsKL67NR/FaLTOvzHru1XPny6wrif5Zida+2twMAKBd5p5goCKlGHRE
+U5r7lcOreKVKw6vMOnr7J3HbUwX9mYr2FJNvYnHtxWiLVgvjYVw+7BSKXUm05sq+os81N79KCqec
+/pOjfq4lq0wq/KMkRSaROolFphZ/EodGwZ/ybZepOc/mw8sDCzr36rlzQvVc7FJvXIJYGQWujAL+
+y8E3U/+gUatlDkIXHs+52Hj4XZ5YdjqpWqrjpWoqywWTqXfw9uAQc1CrfdDoYpfidx3VMqnMOpWJ
++WAgOv2KyLyjXNEuxe+K6lpqkCrNUgMzzwUTzhlYAdFOE+IHCpeSxZrTmAG+jwFbqINCDb1Kq+2d
+r3Wy4uwozaStXOIJCheuRKloMHM6Zt4Q7/YxSd1wFFvTO1+rpVdpSXzBfpZLZN4WZEz0OQ956AMh
+D9ebhQlkJS1Z23u8RUOXPUp9ulKYQcy99x6lyzQtvcfXCu280n6WXSXSEfU41MFEfDLvscHvMO1m
+IaqwsvfeWrqsRdN7/FGRDo5DsFMF2ZMohBmaYQ6EZjZq8DtUy0oh0GCmJY/2Hte00GUCPzg2wSUK
+dDRKEplQQiUMg1BJhw1+5+LgqpK+u5nLYfYAKQCavq6rUqiUKoWKUglyjWaKH8yLZF56UBvJrH3w
+980U9KCg9e0pb095W9DZm7lJIg3HoNLhH9wzmG3RD/7OrI18UMu8FPlgHlMcTVpos/C61Wb3sNld
+xpAcABgE8c4znSfkHeapgebuPzi27X7pg/tl6JbD3DlBf4b+CWMuX29CV2Fs04C4idBHMT2lw6dW
+YZpqk8Esp48cO+G0Cm+V3X9Qen/bsQf0Z5edJphA8/pl9M9+/n4A8fdV4vZMhy8mbs3EU9/3MIj8
+OJgRv34Pd5yrOgeNzkI/PFflOg4DiM8u0pG6WIX4H57aHGScRrhYEPs+mnKu6hz60GmsGjFlABin
+TGnAA6m4+8Ek3DroiHzgb/S8G/ShG70n5jg9iswbNHMd/+fiFAPGKYM+emrH2SNH9AN/oy8OfJe6
+eKO3/gZdOsfpBp6/ARhnG/XRM5HVJvzjaDGTQUFnDX6biiJlOKUh8CfKso+esAsG/zgkalCoqTsD
+36WpwRIQ+1COGCcl4SLi/OMQjYrLeYCwQ02LjjZDkfiH0enTf9j/fTkJ7ZCgBQ7sqLoZCkd28Os4
+pvMAEffqwz53vs8fxjaThFhNvNKkkuONDrjpFOyXD366c4dRtD/4KQtznUVnFP74YyE8Yrv7OJH8
+40Snim1GaGikBh1vkLJ4lwRuLjVtH/BKO6O4c+fBT+2MIgvLOsvFXxb5kKsUZp1CReNzZ7iJTJ8P
+eAX+G9NENZTscSLgx0Gfbn9frItUrTSrpRq8Dwc3i4E6PuAdtmNA5cCA/ucN/AHQmFQKViVV6QbX
+C53AtaJk7QMruGNHOxxzkaq9c8f2UjtV66wmoOBaANhYEiPyd8mDTAjqSAFqnA6ZGX+xd8xFBzlM
+/iL1xcX79+/TQQKx9jt3ekP0egAAoJ00PUEAOeVk0KkchMz9SoAGHW0vNKPdZkKv3aUQ9PzTVYzV
+bMZU2/vKAfbq/mVwwFe4+1wnHUxeSoql7YWvw4z+1C+8XniRsZ58kOxKvHcE88nJ3hA9pu+Quw/w
+AyFkT5LaZBbpKvEF9jreYCa+qI43MONtdx9/XKT9+OPjC378seAZj0OwFEuKzs7aLdDfnQUz7tx5
+9kEJnX3njksd5CAQaFykP7gcudTZFmJTDCrtabFV7t+/TxUMLHE+PezOnd7f9Hqxb1/gJjEThTaH
+zlV23LHNpKPjfXUm2DmoeeGGi/S+i2J3FbqBTSvY1E8CwEWwGwBL9pGQUzR96xpPsivv/4f5vJ/v
+uqHn/k8DVjgwna0AcEoHHUjO4bDOdQtOeX8B6/VA57p6wW64P7uHVVzrt4bhalcFCCdMyZgTewYk
+Q1FoObE2gjhtf8eVwj+Mwva0MAqpVkEGtsw7d+ioO3dI/3PqhwCgAtEkjp1Col1KZywQ37qs0+qi
+oXBDrUa8sbYbTvRLbLzfmOgHWefE+MABegb9ynGGzQYO/Qm9hU9U1PW0hMbGhDSn8ha+8pzBwN0k
+v/v7bGif4F9h303gFd3gJnGlwB3IyT4+0UpRm+EQn7qZpzONsAU9Z8x80Dr0Z+bl62+iLz3Ql2/e
+v+P4RD3q+DTUuoNL3BpHb7gc28/X0Qjn7H3sOvbDB1oQ/eLQQnxf5RLiwjbCmuvwmFOII67bfqbk
+TNgAg+F6v9iksn+czJUrl1jgK9fRK07uKq67xgEHcdlX1wBHXdUDKBtInYeyWRz1Hsp66lf/gEH1
+VwsywMTp0IFWFs7mca5qkCyIPJy0HTIJ65OJWiTsXCUSue+TzaVzVecuOepwiVRhUBFDVcVlbqfI
+iTcpr8HCwsVI4UuH2EzHtAfvwqqDM667rrv2zcfiu2YW1xyzLGX5Q/fPOyZiOhsdPTjDdamY+OKO
+d1liIUnNrJTXON4XeWD5Qw96D0Gdc7iLw59QPIg5Gri6S/hyB4D1J/skPMgt4lANDXJlEKS7Xvnd
+9t2ntu9+f6Vu/Tr6B/jz/Vj2KpLfxhd6Y1nY/49GbBEIAdEgKzcU6FTuQZzuLQV3ykf1VpCP4kzM
+sEh/QIfSskj/GMmVD6/jLEpCAp1bH16/I7kruSslGXWSnBtRhSwTZI8IIJ9lBr0fjx9R5ByJjLov
+9zXGxX2YHpP+0sIzP6J377349Q8vdqyRVpRMWFkxfMIj1I7xr+S3z96aGZs7Db2F/o1eQkXfH3sH
+8g0/z1mIfvuk2wo9AQSbAWDyyJ4YUUMLP0xe7wjqA5sJ/+B9ubfh1ttwy21BB222ezB53AdALty4
+AKUkDGIy4Evi8bKuWekDaYPUE6qkTJ5tYd43+pxF0/4FtdPQDPhJ/tf6rGmv/TYNtmK6f2SeFEnj
+T6gTbrGtRTPgVtIe0fhuQ/YqoAAHynOjqDBAsRwLwziOBaE0Q1OhkGIYOoZiADObZhkKApajecmV
+D/Fm88RbHzrOKbuRc8rCj9vPTREalTtlgBBQf6KVtsNU+12q/XV674NDmKMB5QJ3SqOCagjgIrig
+N5k9Ydtzlw3pbaP3vn4/FkDwOQCsnGVEfREhHvfBK8AGlUIVEUlJZJEGVo5KOk+lBnjTBb1vegek
+nuqEr6OSI+ja4cMw6gjL3O4cG5d0OzF2bOftwzAKjoJRh7GcP0ffs3IuDASDCHISR4lPMpH1eD0h
+rqN1cp0Wl5ABoVntp/QLIofLOJ7JuFZaunca9ED7prq548L0SVOuTeK2kwKtaE/Vv70DEn+ZpiFc
+cWEjPryN/nkbXbvNo69HwFCmXuSiN+7rxNgquBnGYA5B//oaTCq9eH6JLICr8IESmYRi1J/D1wnh
+3jfpAlJdVAJfPwKjDh9G1464VhZdQ/vRtcND1FXoUCqTSh5BCCvlSlrph6nHQsgbtDqtJzk2l2KG
+x0hd0R9wzFQ3d1ySs67o2hErbO9XV/T9iA9vw5jbMOo2DyNGoBtMvcgEfQXXFXWhfzrqmojmcavZ
+s0AOsnIjJMPcaOZtb+6czIN6G5z15ce5t7FwHO013rON9gHjpL6SK7f053tvSW7p0a1kIOm9o9f3
+Zt5R6snxBvEIg0r8q07kGk+dshW9bDt51FZ0+jR7tu6vMXV13D7hNwD9y8fek/MYpfjXwK3+6+Cp
+U9TJl6mio9TJ06fRvDpuX13dX2OE3wINXzSP/YY9C/xAWm6kj6dCwlCMkvc8LzvHvw3OKhUyic8w
+T3c3nqEpCLylcqXkynlyVKP3Tu8dKTnrmIRX2UgDQwVU6VNy8ExococBzOV1jaPktYttl6ndNhU9
+NynCo6TxyP2bdErvJ+xZVHvfGNv772hbY6D2e9uFYuo3+iOBp88pb/ZL6jdAA+kJCiwjSfdI8qnf
+hFR3n7PUfUT9dhsAivS3L1lGPPulUqhYl1PdIiLlbfv1tu1Xyptlbs5Eu2+h3bDjFuwAfe/PFnJH
+AZI/AR/2V7pQofaISVfOOgkxE3E2qc4SF2J2OwD2fOoOOachE+oBnmeOMGOIPYNzZODEXCRHBm2g
+NcwRtAtOakOvB70WhF5vg5PRzjZYEvRaECyhYw4eeuIXtACu/OWJQwf3/grXoJ5fMa+wlK6gTwM3
+Qp8s4OpTTJFizodwLbWjYkZZWVcZspxYtey119Afr1B/ryzvKi1LWfn6m0sfOf4KgE4a7gDInC9+
+7sCneRGR8F9i/4wt4PYCDxCTG+Dh7sZzrCf4gHqf8fRw5zmWoYEbdPN0dIjzvXdkyrQkHJOAasjr
+NHKm+5OJtqPt6L31obDsDrd32V8n6WbDzDm2qUJMn9DnV+F9aKAA7pBxbu+IZ6gxfJpEx18EUrhQ
+gAMKfATeZbbRCHgCDQCQHJQVDk3CCK3OGzrmRD98uEMRgY92GCiUML9y9Nxp1WWL4bWinaMK97Su
+O/vio6Mfbyx4eqx6YVH8gixqW3npzMaRM+egN2ZuWrnop6Ov3+je8MjSq7NWlZUL89lH4F32G1Ku
+EiSCrNyw+KBIf3dap1LQJ4Pe8HE/qfp70jAQ6S/jWmNkraFJJEeYNC0tTRgn+t47eskZ8SgTdN0v
+6FIF5QD+nYnLjsQvqBzTPa26dOEfzqo81a8a684eXjt6J5VcVt7ZVD9zVqRYncK+utw5+tqNmUKb
+/gr2sW+zswALgIas6bJvP0j6nv5pH3Op12/gc2iA5Dn92fe939JGB87n9lR4mnsS0MKaGDx9m3vy
+zyf4CZj+MXsqE8s9SewFXoe3sZiVUKqGDw7ug6W3D+5DJ27Df+LP9ArbePy1d6EwDu2p9HhCkxeo
+4n/0+NunRNou9Nkl3JNE5+Fjp2IZjnLoeaduL90HI27jP+jr29Tzp2wj4AdL9kE1U9e79RR1FKUs
+2Ye+evAigNAEAFvKHAYcACqogipPCE2wEK6Fxejx/fuZww/qaEnvPSI3V1yowtmyVNAEO/bvhxPR
+39E85nDvPVrygJztg+AaAOx1lsE8qtRSI1kkVkjVUrJ8LFOw1293nvr6VN9cTi3vPNXZearTtpha
+7pgFCR37/7HXmZ8wHblB6hsK9dnQJDVIfSkmPJIyXXOQESco5ifbYoEQtdy22GE9AAiS0TpmJicD
+0SAm1y/yHRD6rtc7vvyFGJ2K8Wj3n6BqZ2Ik14k6Jx32wzu9H95JYvXyFHNgX0ckOXQiaI73gLxL
+X8VZhOo75DQt+edXbfPKsxOyPViKDhrvy1CeUbrUv89vtVbm64uG9VJL1pTBXnimrSpzeFKcb0QC
+tK2ek42uoc86tj/RVpldmhOVHireO4PW8Vs4mUTndpXGOZk5t6sOuMcxAc7lAtD/Oe5DlIT9klkr
+nG+Brvn3cKz/QmNWdmNjdlYj8sXTAv1bViOBvH2L2FJggfiuYLO6vC11+YwPZDJHHZQcf3uj6Vm9
+G50Ubc3iY0p1CwC2H20hhx+QP4z+/yj384HF0p8NZqRf1ZyM0KohWXOptxyEgVgAIKeOSIT4oLkp
+xaAnaZLMmofwxH55+rEJL0XvHHv2zTfPjt0Z/dKEx073/jiQJWrWlgJJ2sGPD6ZJCra8OpANYZ6E
+77Bf0rnEX8NbhpLISfekPg6GKqkfXTCQjtyE30siWU+SFFp1hA+m/PkgMtSsj/vICPbAb+yX9GeA
+BqGiPUEySCVCrU7rTCAVRmGVTawLlhr9xoSxuwI5r8PjKp5vbf17+9idgT4vTah4nv7s5spPRtam
+e1BsfnHpJ/XVaR4kgRSex+PZLxkJoEGYUIZLlqr/UchOqTs/qBRKcpO6+XF9TRovZqqqr+krB9eF
+kYBgkAZG5SaajOYkf2WgPD7UHA3iVHIoCRwWkjRstTx6dZJZzqwONK9WpZuM/komBMT5pEtw3goy
+s1+5c/3OecmVu5K7wjQ28CdpSP4dWbbk/+sh+yURn8TDVX4yN1K11iEqLT6iOrFkOcYpWpKcC/40
+QBAEKszhRObsm8BduEWwz9KDYs4ep5Vn+0gQ+X3EHCVWnu2Tm9Shm4ABn9tLRBqiZ8s4qVAPp9c1
+iO5wQpad5Eod/G/6ffzSIn1mjEi319NR0P/X9P2GoO/g/3ORKq1wlvP/pA9u8J8wkX30Zf9DPp8P
+EA98xEGe9ieE0UHyByY8nD74H/S5mgEF3Ff+7wKwXfMH+yU7DMhBdm6El68HTYFh3h6rJW6rGbBa
+xi3zlXl7DXNjaMrNnfUBvn0D5LfMO9iV0ydIzpAwjVwlx744WWemsaeqMqs+p4Pub9nxK6p/nWrr
+vX1/645f4aHXbXvpz6Bp0es3b91E7y15/aZDJ7/BfskMJ7aQp6AhFA6pOeogeDa3Zt2g199w+jHM
+fnLqLTJX5u7GsQzrwYPVkOUY4EZ5Em5xDszEXhz/SNIoVCboBRXQBNkvMb1eK70a/7158yZ1+fLl
+n2HVd7AKuNLmBNocS1OAhQy/2p1zo3gGYNqZH7rQlpswXQ10MEyv7rWSvyfuoqPfoaM/X0bgpqiz
+Rf9NLsRHvKFv/8jIl7alok2z1LbUNSLSz4IaRAvHHrLxZkDXqAMlkrItpZb2RRv62VBCfdH37Jdc
+GPAD4YQrXA81rcOZ1IRgilzJu+P73KGvn9KP/RIhdJWKty2FE681JcQLMZSvJl4rte2AqrW4yLFB
+LHO79+Jt9P2IP2SBjuAJDBrx4QM7HYQ5MEsGlmswqfBGMoNciVORCIENWmfGW4SzoVGr034OKRhr
+u0z1FYuukWKpKegbsdjbdOptGEQKFSuI4xsM7P1eLFS0vavZL9lXAA2UAJjJfDS4JcSeN1RzmG9z
+bYMbZAi60qFahfTkIVrGfBuOGdg6lNMmdid7mxWOXTlwCPuY+RtqyzSiczDLmGl7dwAp6u71SzDN
+A6ZdgrYhzWaXsoSVlKFKcGqdgbY4PC9qnaGNchPRPK5leJLIPL6IFm/UNhlMQ9WI/ujGjds3btj+
+PaA0+hoG377x/2HuT+CbKr7HAfTM3CVN16RJGtrSJU2TAN2bpmEpLftO2UtbtrLvOwhhp2yyiaJs
+goobIhYFRK2ILK6IqEUpuBRFENxQ0a8itMn0fWbuvUlayubv/d/noWlu7pw5c7Y5c+bcmblI1zAr
+rG9g1jdUbN7HvInU7Wt+4BtjAV9xn6wPp5OTvUxDfOOaHwS+mlzhsuvpNki2GRmrn4eiqJ1XUImE
+vYE6Wv8WlFaoNSnt+Pq0ij1ppBkZmtW54j4p8NVuPrbmYl0YtkOTbpj+hqGgQAJfc5GPBRC88zJp
+f6cZkgDCNWxOpqHyZScZiSqTQI/+iTBqdTR7mO00WaS5j/ZLxbhlsaORacnDnFGhKJL8GBrlHJac
+dkW6QX5EkdINdMa/QlkZN4LeT0q7ki7VjCJXGCDLd9KaPwFwfnQaIK4ejSx1TPOaiCVVaTa3Pl3c
+CPdPoVFO2sGj3Ne5Rsp1fWLQCJpknTosCRnSk+lFch0ZaSFCWs/o376OLux1IpsqItPClg8jo9Oa
+UJ+CyXb7B2j8Bx8MPgO1dvsHZPOHHw4+W795vGPwhImDPyBb33/fXjl44oTBH6IJH35or8M/28Hg
+376aRo30IQhnpf7XoDfewn2e5/TgCVOPTK0cPHHqkan2W5v1nLZPPTJ1AtTSr4mDpfhQaZPmAU3+
+kQVIvZCtKwc/SrzxHTnAOiP9Q16qRwzOkkKMZBlg6lRiqUsOAO9tO4CdDt2sgdxeQiq6DRnc685e
+2dm9s8kLj0wau3Fj5Yan7kDNi82z+ziybRMe3Tx2/CMbvr49UT55aNlTK0Aqo8qmM9MzkWxOI2fn
+nPxtCOJPXbs2oiL6jeiKEX/+KV14+t+Bpq8Li7c/RX5Gxqe2Fxc++QztEs+suoO4/ORF4yMd85ty
+dGS9na5608Ng6GfjHShptx9d3J92p6b95ELzzdFSy7dtNRbP9TxYcocW/75y6fbNCV5fLMWB7NwN
+6t/omTnUN1OPS39zn6Amnr/Il6jJFdSEfIlD2TgdTL7kY+lN8qXnLwpA/TH9Jc+H6uBmeOmHrjbi
+/3Gf5LLdJ/nGFBG+rqAgX14RMNShSwQ1BEOYNKYgrZqeoUD/csrYgjB63EMQQttJLRmLSC0WMFnE
+7jxBaslIjOl9qK1D0y1zNEHm1tfnXvUjUOpj57nsmh8ofWw8EFuy57R01GaJ/K8u8EtrFl8QKj0r
+L3hW1IVBcp5fbHmhZjG/lD7kw/Mv4HnK+CNWCqXe013NdLRyexb87VkglF6oGco/A/Xh7OxBvcnw
+FV7+N15+gX+mZugFBYbX16cLD/I8X5cuBhOkwBj84GRYkn9h3wXSE/x5vQWe8ivz7IPHXng2BpvM
+2lZYIgS9eeHCvgv4T0+YlxhZJwq8iurZZNbaUd1a6GFaEw0nT12govPWZm2pTirnzfit/kzCWjse
+76Nw3wUfkUKlLM9aQWwpVkCYxBcy0p6GbIjxht5AOyrII+gTz8dkZAWaSg4wornZI9y/XXCvGcHp
+PSsAe3HQ2aIfFpWEia5UdJoMX13AepRXQX5H00iTvriZZwo5VoHC/7yAW/QlAyhdeO8Iz2sX9l3w
+9B2Be5Ce+NF9+5S4WcLPyZneVlhrl5rgDGaVTSfJ1Oal9fCk2RWnfYSSyT/VjEDHQJ6bC6qTDJcB
+GkFjr278SXaadU5zHjIbJMSoB6MbN/eU9MV/uXeRYxWdcy80bfpI30v1yN6GBhVeoFRjKIVSfgm/
+RM6zG502QY3RpwcPHCQYEQwE49KDBw4eQIQAwexZXCAu4FZAEGheU3PBIgR73wPjn8P0zubxh+z1
+JlOnyuu7ovn3gPZosGvViK6IVKON+D38fs1g7iiJJya8FLf3vO1ZfAktQIvJ0jrvCxCUM9cQYGcl
+eVLUz71ZIL8f4IRczh534hPkSfZWAHHP3Hr12RNVBGhEJflLlXCzYG69+uyhKz7h+agSjSB/zRX3
+UJ5RnPANt4qVsy4vfFNz6TK36hKZj8exp/4/8V2FZKlH6BIFrOMt4Sic4y1Yl/gmmoKmLFtGNpKN
+y8jJ48exCSccP+75Du1YvpwWlZaSjZ5vcTBufuiQ52/PyXIAABH2AohHxXCwQRJkQxvoDgUAyLtj
+0+7bK2qnO1rjra2RmT2702S34hxmm/REiT5LMrE9pPSH1vuiErPJ+6DJgV/du+HkSRT78d6TKPbk
+yQ176U9y8eTej8lF9nPv3r2ju3QePbozfnVvl9FjOisfT8+99G6X0VzJbWrKeMv2dh7Dqjw0RqnV
+Rf50hnrylZ5BfVNNLvMxKA6PI/Ol5y2fEeCnq8ZIK/qynOHO7DpPIswJnGjQos9U2gkTq0bM6dE6
+NTeIPncYHC0S4M/xFcjz7QX2qCFFn5DqWbnBXJ1D8UIKicCfqVay3TLpWr0Kq0Tfczd2orwu26HF
+DSFWrayJ4ysI54cYz9tgFj4FwFAGoOovnFAyNsxbmeiu5jLcGz+ETnr2eWaQbO5vfNDTQzhRfXmv
+0LisDJJgLkCQ5O+lZwOt2FtphsFEmAPLYANshxfgVTgKH8M5uAR/QA1SIR2KQ0nIidqjXmgQGotm
+osVoLdqCnkWvoLfQh+gL9AO6htw4gMo306DVm00JDm2WUau3mzId2iyzKQEZ9EkooTXKaoUyjX7X
+Fr9r4TYwt7t/L9fCbdoy/j+4tvldq/4fXNv+H9N/O7mpqEqlX2b6x667XSVWasqMQXozGhBw8fz5
+f2PPP9ot4GLXrv/GdkOcpZvF0s3ynaV7YmJ3C+om/SSdpO/z0hd6s87PBr/QQ5Yai6XGcqN9Q6VV
+d0eQeIeyqv8H9eQvFGtZa6H/WyzXmyVaLI/VEcjN5y0WnrdYhMrzNZfPn+ejz5+vudytGx/dzd24
+q8XS1SpBv8H+3u//b/w/hj/4n6iS/v/GQr61WDbFW7paPF387svikeKzKJKqug6v+K0LZBEh6kDO
+4lDuGA4lZ0kqevISGcH+sDpLPG5uiWoyq2NyaHThOhPmsBY9S87vPLQLwf69iz3uX7gZ6CJORxzZ
+5rlAprkfBqUuela1UnoHg9a0BJnJedXK6w+wMqLnlqhWMrwGhtbKYa0DPbv44U9r4fEPdqpW/uJ+
+ifQnUWTYNdweneGW/lKfHoeGw5wpXBeuNeAhi/fuR7Dr0E5KzTT0MI5HY4nHU0Fi3Q/9AggPYfVW
+Ai/FF+ncEokWtbz/zZ8ek0HDYRVDjIcg884PHq+FTx9eTClaTJI9R/5ET6MrqIwb4EePNC7SOc+z
+5DwHxOxxq6eT8/csB++5qehZdN4DqpX/7ifn1TPq6MALg4cQMwced0A+Ml/fAHAf/HnppJx5AFGg
+h5CZ4Zjp+UUoVE2WzgWhh+LSM/boKye49eTK/HnnkY78OCbJ5PlFNfuf9evItXSL9I6v3UTFrVe5
+2G6FCGNEJK1ss6YhLdtMrjXRo9Dwey2SRpMrU1pead36QpspKGp0E3w8vvVG8oenE3757QVteYSi
+O85+D5d5upB/tuYweoheKFS5GD1aujedkmOzOrTcelPSGBRJfvt64XyV68bTT1vSybV1q2+qBsp8
+/M3o4SReKfUoyvO3ms7Vdnt+4dbLPFIqzSatib1kA880JY0hPyLd+XnzPb+oFj5tSUeadev/ubFW
+wklUQqHMo9VmpTzqjRGtkJbmHw2UR2c2l5PTZDSKmtLmQuvWV1pOIVdGJ+HjppytKAiXe/q/N7sj
+uYz4tgve9vTFh5B2Y2tJdnpGqyRzPTvS3kTFhmeiqPkLvya/ocgxSSaV68aLN1evQxpZ5jTWYjER
+J+kbfYaAgHrl9XmA4RCd1/LvgcCwppvSUTr9q003pYuVNy9zIVzozctiNN6JE2ty2FujEWwkqWI0
+vMLmuSwba9duvMwdvUxS8VLqDzyLmR3VXsUT+MtcBG2Xc5gMev4mnlBWppTp+cvcJFamNWlpmR4V
+kt1Ufo0I4HLVUoleuxY32klAvfT6YsrLCQJcgFJm0qITqI1cyEEOnsBvYu1JOQ8HO3SGfbgZyOxp
+J30oEfQfm//mYD2/iZvkrSMfyEn/q1OHEad8gMONyGRcrtL56tm1nPzBjXbSf2QynuDZzMX6/gJw
+6ASZzAWodPXzMl5ejsmfWyvz9fhjZ+HbDXa/T87hufS/txUO5XxaXR7DJK35/+etV4dLxNQBPG5E
+puByVbivvj+3fhzv3ClMQ8+QoTcI/cuX0b+ePfQvmwOjE2QKF1AXjx/39SVwDLW5LTpmI8y2BOU9
+P7gR/sTzBQEhoqxmM7MxGWaSny9UYCaR3TVXQbanunjQCS6GmP3xoBP18XhhvHhqr1Kb9eKhq0ob
+ec7gUwT4CWXVv4AfTB16ZBg9Kqx+TIHhAvzxoBMkkWtcHw8XUJceGcaLBzeifU+1VIKhqRncaCdO
+wRMEY82mMlaur1Oulcr1wuSaX8lumW9/HHT8p/pB5xUkMoy+DoxWhvEhYn6A9WeB+QH2uCt5J55Q
+xk+s/ln2Bd5yrbdcjwp5Q/WjUn0uQC5nkYgeVaE2CgJW31uu9ZUrCHy2oFoKKkUeMsfUHNRLazZJ
+yr4VTlsX7ldJ4bJNqJayc/XYUysJY2/KPIXlJSHVbK4Pa5dbRyd678QpkqXxE2UCfLAqReaKRInZ
+j9CG2tfWbV9WADPPBmnQ1qFhEm+QwW+lQVuPBhlOsmcvHNMss+jkncxc+YnUZd8Cp60Lx7R0fbEM
+xwUoNEoxp5lBUnX2lnFW/1yzuSFYyhPtBzh5pwJas4n2GB+syoeXQlKsfoTWx6m9tX3ZomSZ3gpf
+jwYGXvNr9WO30KCtR4MiBAbH/JFCq0HyEvxNyajKZDuV4SZ52zYokPiUZKwUZ0FZzeZbYB2SBCQN
+SP6Qn1BW4NMpg/Wj0699Nj7UXG2YTsoPf5OY706n5K8ke7obnZKUJH97Zzr92vejU/ZhKsX3mAzM
+u6RgyULKlFhE0qPkf0wGBcrzheRGajaVFQDUg5XwaU0OGdYu4fyV7K4Lq/Li1cpty5bh84/+NFIp
+MQ+GzksIN5XBLW1LPBu8kMTsT+gtdJokOiVY9yV/Qm+h0+tB0XkZJyNUlrlqKQT5nqmwkaV8p+eM
+LNOazbyfXP3hTYpklb7vM1NGtb+9eOsoEq5XR6G+5moDdbQN1/FyUnP11jre+a3S29F5X0N3pE22
+ZtYOMdercvt2lL6Pzt9SyVcnxC8eNdBEuY86Yu7tJ7uCmqu31DPL/UceF0iiH1v6Aj8BNtAeeyOF
+j7Pevp5KRVjQMJ0Ommq3+3m23j6RFPhE35C+fH7Gn0g/hd1Wx3Vlf0cda28je38DbFhf2ob1dcd2
+tLdpR6qE68Ty7J1WdofJkIOuudA1KXoHXCdu93nj59G1mhfQNV+sDgAYNyLHcLl4XIH1i8u5SdwA
+90s1/bn+7jLpnIgT5BgX4IOtG3vXAce1V9ER/jL3jgTLsXdvsZlcjYq/yUWUlZHddJKB0VwCXKFq
+qYJTmleguXQexKZn8vxNjsnfkX28QRm36DgkdVThnbKyMnc7AITmymOM2vcuT7MWzeUmk5k7d8oR
+Oj+hZrPcPsMr+N78KffOudxkj51ayEyGvPoXfoKEX47H3/Ebb5S4PVmKy7kjZWVl1bnKWF/IRSj+
+VNq/oucm7dxJI1mKlNLC5CvD+mhxKAuhcLkni5tE4sksnMzor87ljrCxDzdCRxR/LcenBjlmp4JB
+R4R33W2l+RxCc/EEf1gpomSyRuul4FwZNDCaK+MVlFmqyYAUr43Wux/diT/hIviJ7rZlZLfwbvXP
+zG+jIyxek3h1mJSYnblTRGXCHa1u7Z3jc4VeWJkaPVonyYXSIUX5INkSV6jQIkEjCTdaR4eQUzu5
+iDLuaM0msrtMMFa39tqL0rfsfhYjS0aKy5ls3O0UO2B2GOQvHZNWlg+1AskeFX/LeesIoIYwfylJ
+NRVJMRuShVX9C0UiW5J/jBvkb0t2nzUl75TNiTt6fbFiI2zMDfKPYGmfIbOY+LyhqdR1gPPWEaTZ
+syRBzluXSZ2a1k5FjtW/MPOSEGA5ZnrHr00qF4fJoER4niz+pkSmu50QUVbmF+dJGvOeiKDnb5KZ
+ko7lTshPUGiU/YVaptHgP1bq+ZvuRxmNkjDLymQaJVHK/kbRn+xvWF3mc1g9aoGosIw76m5bVid+
+CvK3QRoZrZO7hGyHUp/g/Nrw0ehfj7Yk1/W2Ret725PtRVdX1z7b9OrcZ6Oy7hVDrY/Hn26vjGUa
+FEF7mfAJnPfiENiKPqPi+yR/488VLmcukPkezxkFNTC5Mw0IEXXY9MuxMGvJCmeLhUWDFjfaswe5
+yKqiQQS4ac8jVLZogfthvxyIBG9li4BptrTRoCLyIJq7u4zCL1pQRna7H5b1pvQZju4lZythRbMe
+zS0atLNsN55QtmgBN929YTeBOjkNit+UYGXgEXYTf5ms2l22c1ARcuEJZDetsWARxf8C0QvNVJNZ
+jhHZURF/+kBNGtGri66/APXK6VEPQrMDNWkHaLlfGW2LvktGjegrdJDQ7IDnmufPA3i8Z8sBHIY1
+BzxbiZ4HN/Bl/F438FDTz5vzGOeX+8QLvLlP3Agfq5ffxMf44zV5tGycL2fKeuY4vFCuKOdF8bE6
+eVH+WE0bqT0dHqfkUwUtbU+PChHZzXI0x+rnTOUGaXvePCwtY+1JiVg514qP+deTG/Tlb1XyGMFm
+QQ9TsWA2SSvwy9X6RwQnerOpMWueUg71cMm9SMHFJoe34DLLM/PeqE0dZLW1tVfxMbGVaolGpT4K
+wB9T+pnYqjbPm2sWexFAeeS4twwm1CmTcrVyGdpUp4zb5J7oLcPH6pR5dUHLuKt1yoSr1XpvmWCs
+U6Yy3vjZWya2q1MW0O7fI94y1dI6ZXJMRcuE6X5lwoo6ZfwLfmX8W3XKuPN+Zdyfdct0/mW2OmW4
+q18ZHlKnDC32K0OP1imD15Qyu1b/kVJUW8vpCIgtVUs1KvWHnA3oPw0A0QsjWP9U1kfSXUFxYGar
+aOg+Sye0gjzfuXv1v3X3+JvTve9u8h731ftu2/u4F/37vu9G/Uvx4fPnz58nJ7/99ttvbxyi1yib
+/XWyvw56HznZX3YfAKAJ5UW0qSbdlZf20Bm6Qy/oBwNhEAyDkTAWJsJUmAlzYD4shmWwCtbCBngU
+tsB2eApAZ3bYjX7PAEx2g9ni9xHs0oYDrcHsoMdSZtT7pi/05fw+GXSLgt/n/1r+3nvvvc/+1Yx4
+/320xvehv99jpcrnfv+Ji36X/12T/v1x7dofxPSH9O+a/I2+++MP9K30fc17/57vQqCsu7p26IAW
+0BraAtD3l1vsDrPyrVwjrd1h9vuwGZ7W7GBH5xmQwe5wypCcThKI+6pPOPSazHmP/Xsf51OrfN+z
+nxVJha+fLz5//nzht0XffvttdVeUfR5t+BZtoH/Pn7/+ArU78er588XffvstSH5RbEf9h0alPgxA
+z3KurRWme/vcSWEF+HKaej+/xjWR/ZrcR1vhYxqV+rTURyV/y+mZvz1d19+qlvieH4utbu7nmjAX
+skTx0xKMxibV8+GsV59W14qt3F+5v1QtqWlT00a9pD4Mx9rQi73cX7q/Ui25voSRQen1jgWnORt/
+rE67Vtqut6QuPtamnrNJvPPHri+Rz4qv5cRKwQIcgFFr0h4So91i/M0LAID9ylQAdD0zxYKSBWf1
+RzPE6DVCTnXfW2DDIA7AorenmzId6dosc7opwZDOpWs1pnRTvFb+Rsk/cFN/+MH9yA8zUAE3FRWQ
+Pe5HyJ7iH37gZv3wg3sdN4vdWkf2sPf/y7g1VnUV4+8y0gKAWIc+xYYZFxY5gPX/75AYXT2Qkl7/
+w/idsW/GAelzR7z0hYf1PofEaPL9vn2k6sAB8q3vm2GdJTSvPlH3AxDVIP579ZusbyofVLc7mrUN
+3Lvf3+wejZp6HDiAEvftQwkHDrgff9n7D8UfkP/RUukfSpDu0Ht7vf9qeDH6QVaHq3qZpB4gyftI
+8gGSWucnLr/7NUl9mXzLLC3AT3Z0Z2coexN1Y4iT9IMMds7ADlB00g89S1H+GB1M/xcvouU1nqWc
+qabmB/6Iq6bdM3xj93fP4MVkwTMXmcpQ/4t4sZss5Bu73d8hIWdxTTu0mH+75gc3F+9Z6kbLLl4E
+uBsdFrtDoM0apQ/d7id/nMxeyi56ltag5TU/1HCm6o9cjBK+cY37O7y4hiy4KFEy4+JFtMztWYo4
+k7vmB8QfWVzT/mm+sfvC03gxWfg0JcPPluh65VAIZ+e5x0MiNIEUyPB69E5eOxoMw2E0TGCjL1tL
+bdYanWxLlM1M/3B1fvl9HPVvSB+7BO6oV2KX6vh+CUa7w8Yi7uTMBQ5X5qJFmajXokWOjYQsWrCI
+0N/4KvvtWbRwIXlt4cJF5LVFixZxqxdmuIhnwaIF5FX657WFCxaQ19jPhQsX1MK8rAWZ/IOLMjLJ
+wUWLaJUFi8jr9OpVx6NZi/BbCzMzyWuLKLKsRx2LUFzWvMzq1WI0b89YkOX5IXPRogxKRhapXbRg
+kftUxqKFGZSMLOKRCFi4kGFDVxdkLJaoOLhw0QLy+gKFioMLFi4kS7MWZODfFmVuy/CjglJPDmZl
+LUKxizK2ZVDKKRVZixZlLc6Q7RnBIYjgD/DhwAE4qf/lbkRwbjcv+VRvmbwDUvK/nN59dQZ340Gu
+kfsBGZbieVys5B4CToKk61zE6Mfx3xIepUzOjVJfLo6s/kh4kUKhYKzxXJuB/5bGA5HbKyR4xwP8
+v2qx8c0fZB+vlNUZD3CY588Z+H8PCs2rC2RYBCI8LpoUepCJ31ozBa3hH2EUAWC/chUAfRe2QKFG
+II27H/mD+0GC9qOM4azlRJNgAQxgVCORruORByvsVyYAGE1qbj//vucJ9/8o0Br+ZPV7srxFiOAI
+H05xONVIRG7klgWO/coEACfFgZ8hrxCeAj2Id7l/YoB16dAikQ2Z0pk79elQI06L5vLv17SaIUb3
+xWPwmOo1CmxdWrRIZKonWJGPPy0SHvyMZ+gM7sZx1A/1c7+iwDI8ookPZ5Dp2nSULorREirsV0b3
+r7KVUByDmcuvq5k1Q4yug60ObzoT4+0vIVEe4+vwplMjiwntxyPwyOqHKRh/oCabPznj5gWFJoU3
+ioe7QXg+XLbrOrzJeFAf1Mf9KgXjdnqK8a4Zsl3704O0ohiN9ZymQXroJi7tL/z71QMpkOdx/pTn
+cRm0Hj1IK3I3sB6RBumR8OBn3PMoENmLd5G9MmhdWevSUbo2nZGklNeTN2IQ8l8zv04izQ+ljz+V
+hI/+Jxn2X5RR97X6diXt35Ahlf+exLPxA5417v8pNT0P4rl0I5+CgatT3wig1Lzlv1xUiSpJkmdt
+fZToHGlKmqJz9VEDB9NIrbhWaAohEA1NARI17Agb+upVc4INNAI91iZRY6EH5IDGQst4jUDLUClZ
+tHjJIkIWLVm8iIxGPZ/f9cLzqCd59fkXdj1PctGqd9997120irjefe/dd//A3TZ267bR8/rGbt24
+bA8+VlJyDJNjJSU1tdzyi9OnX3QvuDh9OpMVMXO/i7OZb0loo8UiBPBigMiOAxQFVYB8mKbmzCkt
+Pd5MrULV9NRTtJQsRn+TYBIsLHV/5v6Qa8Fbar6p+YJPAVyHRwi/A0+X78wF9+jtKadxxYxaJK4W
+bN69FS2gPfSEgQAWutUjXUV3kqQLdI93upHu/E7XsTtOVmphd2ysVBeRSUESbOkoO4sC6FXpyJpA
+izON6UjUU2RZznQ0OKlVavOMTi26O7ivm6dmZY5q1bFF5x3JLVObp3du0S3bbW2e2ippVEavFp1+
+6/F4D+uwYyVcN41mil7vebrH4z1sJceGucvZ7w05Gdb4CU3SW3ebHW9Nbts6v2V6E97mfzMjJ7dj
+u/Qm1WciIqZER+NhXXd2tQ06OYjrxn57npZ/A4h+8qaxsYVFxZBdR9I6LWWavtyXvt4XaalKtFZ6
+DiIVgNZqsxq12ZppdeUfZIxs27Zt28jVL72ENicnpSQnpyRdb9smymiManNpT18/ffDv1xwJC7MY
++etGS9jk/Z6q/fyg6gqVSkhXJdYEGi1hGr6NJszyjHvBfpwAAHy9fuAEAI30QikNOxwK33O/wNtm
+tGjRssWMGS1atmjx277CgYWF+/YVFg4sJMY7WxfeSm60M5naoYB2JhOZijaPtttHkwmj7Xb8yp3s
+DsG0Wl5cK8RDUN2969NOo4noWTTx9GmyiQwhm1Bb9Aya+PnnZBMZSjZ9/jnzTb66+rq1BZm/9Ppo
+cI7CQ32EwyUS74wXJah4Kr4G8O56nrxKZVQfL3++5BiVwJ3xSsrgufp4+Ury6q5dz+8iB1F9xDgX
+LaaCZfsA74CbC5f0rauPezBCPXY9/8LzqEcDuMlSihstroc7qmF5ZDoT6SLn20uFSt0z9naykRry
+b0d3azu3bwBvbQgxkwuifYNbKDSFALkXp2sTbKxrvvsu63Q3z06X4lhSy30owQHtDOnaTCP3IXFR
+QO4sNVix2XQWi14VHhebsLkXIHbwANtAyKauqKy67KUFi/aSN/kLuHphWdnCBS+95H5AzLn5HgAH
+hwio1GIT1k/j6Hjl3/fq9kuKDnW9c6cjw8Rem1i/kqTII9avLrI+drNLQJN/v5TG8ENYz9oNZqe9
+0Xy/bG/apkim4ZDYK0rG6hJ2VX+Geu56ftcu1BPreX3NVfQOxUty0aLjJSXHJZyUF6xXcNoVM7Br
+kUliKPFQlEJvVHUbIUO25Ffp8g2SSxGid2qu8npSKiFFIGI9pxeasl2BTFcGpneRomGK1lNCHvDq
+lsLrZPhMI69NZ3yli+++i1bNJq8+v0toyqhezPo1ApHQPJaewac7mNHaNYIo7noe9ZxNXO+S2geo
+KVLivPB6CR4l2CToCHs6p6NSmk2VQ2opF7SzMFvDeuaHdTL9PpoYH94RYbasT5khhUZ/mxXXYj3D
+k+6VbLpdluu02YpdzFZUhvVKh5Uke+u8hcWwSortNnOJBstMUtktMTrFxyJ0jOzoNLeCN0EgGOr0
+CZWy6TnBiqasObFm9cjRa1vtXbTgpbLqMlw9as2aUSNXrx654KWXFixka3R8uY0gOZ8h756g2cG/
+8A7PKClBIeQAwF3gqwfi7Z7Rt4NX+8PLGbq7wiIFsy97R6G5OnTo6lKCfBR5kvBIzxOeZDzC86RS
+u/o9qT1fnBwIIaDxO1OTHmep5vDigbm5BQULC0imb3qJJuQWFNDbpJt3onnr/EQwqTlOh0cqtdbc
+AdZo0qrxfjziFkgah0LAcrEJiw8hXIMi4p2gQdZ4FWhQYrwAGsTHW/5E719E711E75NWF0nORc8h
+LCAO84jDgqeGeDxu4uFja66SmmXLkMDrkbBsWfUT/G7UbPNmcramkJzdvNlfngI73YLN+OU5P93n
+lSw4qRhnMB2P8Dy5RsjxNPU0pYRyXfHoW+sjb3128kYyPoNHVn/krY97epoqKeUZePSd2pfrj8CV
+rL6k0TX4HB7tq35P9Cd5nvSrPxqfq+7rR79vHNRC7C3nLvGcSQj3jhU27S2DIrd6NTllxEM8zxpR
+luTGyaur19wySH4d2usAKZtByg6UlBzH7uO9Qj1JAHXab1S/fSFbGaFkMoy3tj97zWrUU/b2p4z4
+M0+GEWWtXl2//aGhvWirJSUyEb1C8Ui4W/tYQ5s0YWmQzHYm6ky3xjU9V6/GFUZyilKAlq9ZjbKM
+nmfqN88d9vDHe4XiStT/QElJjSW01wHU/57aZ9GUSSuqEG5I/oPlsTPL6ElfvZq7aCSnVq9psPWS
+kgOoP64M7eWpRv0P9Ar1PNFAn6TvSNealKxJPL+RmkoDcNKJEcpsnz9QM4XfOKPBHJWUG5LwreE3
+VntzWYdqXcIv/Cv182x8j5qDM4R0A9+3JkfIrj4pP79RYKV8nZC+Si6jeM4LCXz3W/KHy90LZvDj
+enFr3Dw/pWajjEeBlfDw48xy2S1+jOZHFP786FbDSwDiEnZWDD2Pkr7zIBNaQlvoCu0PsUQ5l1QO
+nKYcOlSUg6gphy4VUA6QVg44rRw6pZVDl7RDkAg2BpioKYekinKwacohrSI9A9mNWpXZphWMBpXN
+YlSZrTaHkBmH2DuC6Yt66fliRoPKZHNY5G/OHkGBHRa78sUwcDN7imJPETtb3mjt9rhbcydScHpF
+UciRzqmvjDC/0d7THfUTRbJX5GejIpWKvCB25lbOaH3D8xrX6noujma1hUqRPCOKaOj2PI7k/U7a
+fOjkX/u+5O1RxhfyBr82Npr8JvYSlf/JT6UuLtfDN8vhSB7/gFwTIBAm1n4qHhc8EAw50A66QD70
+h2IogTEwCQDYC4mzs9j0UbA67dlOW7bTmO10ZBsNEUZnBD1n0R6hMosqo6iyiSqDaHNYbSorPfcR
+ZTs5USVEGKVrm6iyRBgtVhuy2oQIo0VUGbOdgqjSZTstVpsqwoj/Tk9r325oelrb9mmexc2bT4ue
+2D9sZN8F7+T3en11rzHaAeMbT2vefJp++gDtmF5rXs/Pf21Nn+HaPuOiUY0pMfihEWhJ05g3Rq0j
+rwREfNzDiP9tJKJwXR/PRH0guRbRHR8MiDjVV0tmmSzB60eSxTG28lHrOevytLShy9PT09/Nz39j
+Qe9hmt5jYmkjEVP7hJX0cb2Zn79vWe/BQX3GxtC7xsl9ggb3dh1CybpAFBLRc0+g7oN8A8m1Rr1U
+UuqMTQhcPIJ0j22mXVfwtzWhbOz81eFB5Fpsz+fV+nd6xoIAewDEUuFLNnbSt2JloOGHIBiAmVuw
+5hCkQzK7TtccgjhIZNdxmkMgQji7FjVtoyECgsEKwZANwdAJgqEAgmEMBMMDEAwrIRg2QzDsgmB4
+HYLhAwiGsxAMIUPaCnAZguEfCAZMf/wC4VAN4fRHNEQCPeg8HFpAOHSFcCiCcBgP4eCCcFgN4bAN
+wuFFCIc3IRw+gnD4CsIhZEg5JGvKIbqC0lgOTSrKoUkaoy8drJAO2ZAOnSAdCiAdxkA6PADpsBLS
+YTOkwy5Ih9chHT6AdDgL6Qp96fAPpAMeIvVOexUcgjBQM9bDNIfYOT9SFz4EMdCIXcdoDoEVEti1
+VVMOCZpyaFYhXadWpGfoNGCKBy37q9ODPRMcWWBOAGTlzOEWXmfGTqst2xlhFAX5ew96HWUjJ3qN
+dCcfkZOk21Y05N9/0TDy9L//kmdxwef5HXVoIOqpaz86cwovzpogip4yejFepcK/ICd6g3RlFXug
+gyj7H/IUGv7PTTSEPEvWh5BqNCS8EXkJ4QvitNkq9dRZovwN92sf0bJ9RP//uX3EVVAa/39uH40g
+hl03+q/2EaGzYyGRs4ej+7OP/mfyO+rIbnJQ13YsKrs/A1EHI5E8G94IDSAEuW6xEADez0YMEAMW
++OQQhMgWEqI5BFEQy66jmFVoZasoB62mHCIqyiEijek2FqohVtFtLDSFWGgBsdAVYqEIYmE8xIIL
+YmE1xMI2iIUXIRbehFj4CGLhK4hlug2hwqwoh4Q0qQXbrdqI92qjsUxKY5mU4IpyCE4rh3hNOTSq
+kO4n1tMGMocLiTobtonIahNVEcZsi/x9iwbQ2l5G9CjaaexbUnsZbw1YvC5AXL7ac1xcsl4lrFhz
+i9DTyciYCLQLk3dCg3Hx6sCADSvV6nWrpG8AOi4qcr41liiEn8uhKK0citPKgasqB7GqHKBCCiRo
+dIEqygGllUORbP1xaeUQVlEOYbKk2lWUQzsZtn1FObSXrzvKUUnninLonCZ51R5VrMf0ACv0gGzo
+AZ2gBxRADxgDPeAB6AEroQdshh6wC3rA69ADPoAecBZ6KD2mB/wDPQAPASW+UeKdQ6CHgUwnes3/
+T2IeeggqS0Y72ElUM/NFMV/ELVrcaO2u8eTSECjj3OCQ97qkvzba8nYnXwg0BxWrVGSXEgId5Fr9
+2xpHs9qJpZEdF8RnrFTnbbQJX4rkaVFEw3bkcrVKOHSx5O1RjXa3GXxwHA2HeovK/+THUheX5+GT
+cjiSy8+Ra+KCzvPzkmdo25e2fRwAMLxSm863FL6BDGiDTIfACUYmJKfmEFvkTq/VmkOQCknsOlVz
+CACMkKoNb1EOfAUt9Zl6mKYcwiuo31YgjMw+mH6dYAUnZIMTOoETCsAJY8AJD4ATVoITNoMTdoET
+XgcnfABOOAtORb9O+AeczCM6NeVgqiiHJE05tK6g1JRDuyooh/g0qZMmpZVDSlo5hKQdgkAIYiQH
+ag6BDTLZtU1zCFqCjl23ZKxkgo0RqtOUQ0BFOQRpJEMOpL24ohy0aZQdCSo9gx49YtCrRJXBaVAZ
+zTazw26zSjZCTwFvjezUAoz2bGc2u1aJgllUKSesZdms3PgmiSuWT9rwZRVaik6exI9OXrEisUmT
+xBUPDRow2zprwKCHViSSf62zBwx6o3TxG4MG8AVRicvePrxgVOhpPAqd/HjE6ZDRCw6/vSwxKtKy
+7HBAYGlxP5WqX3FpYMDhZZZhYv+i0tI9e0pLi/oz3ZJaoZnwNQRBItJRXxkq+8pDEAlx7DpSQzsJ
+yJ2E+U2AagDFbwI0BYAWANAVAIoAYDwAuABgNQBsA4AXAeBNAPgIAL4CoEqj2hbBCiJkgwidQIQC
+EGEMiPAAiLASRNgMIuwCEV4HET4AEc6CqGhbhH9AZNqO1JRDY+o4ZT9BPbFeUw5WqvGYtEPU3zLC
+tWxQiPYOCmowy4ZbDmbZ+0bLjipKNiFTWnoG8p6Nl5ktWB1ZdGRk2tVaDILRzJkdJs7Kz/np6ed+
+/PG5p39yf/8i6v3HVdT7hdWvIg35BT+Ha6EWH+Keee6HH5579ssvf33i9z9QD3Lwj9+fWBRO/sWL
+MEGwAKk19XQRTQcOk3fgMEIwuzayiEctRzxMihxYgYNs4KATcFAAHIwBDh4ADuipLJuBg13AwevA
+wQfAwVngFCly8A9wSpSjhmpQKxpVQ1NQQwtQQ1dQQxGoYTyowQVqWA1q2AZqeBHU8Cao4SNQw1c0
+rTOERmbloKkoB02a1KfDK8ohPE0SK9MH7ScixHoNTAMh7Fqj8Q/v6O9y0FWUgy5NGl4NFeVgSJPK
+jNQbe88jzLLa2Ish7SrBYNGyjkeDFtrX8O+SRn76m2ngOYwiyLVXV79A9l39g+x7cTXef+655y5d
+eu65c88i9QKEaqkq/g1f9MTvv5ODqMfvvz+xRPJ9/4jPszP2zBB3CGLlPhGrKQcLHekbQ5Q8oqdn
+IGsifQIawTuzsU5MQnRksGU5s3kjsjhNWpUWRYRzf88iH8yaOXMW6jh7ZpBh98/4t1278O5d6MQ0
+NJ6sX4d+30C2jtJwjpmB02eRQ7Nm4FmzUI7n7HPPPf8r98sLu7Rj0WC8jug3oNnkmUmkBQBC/QCE
+c8KX0BieokZfDrFpdEIg+V4+rRzCpdEzHKwQDtkQDp0gHAogHMZAODwA4bASwmEzhMMuCIfXIRw+
+gHA4C+GKpYTDPywEpn6Ah3DmDKkEGlWVQ2QVVRCUQ6M0GlbR2EfljX0MsnQMGhoapGcgh5ILj0Fm
+pS9Jr343ObiQeXtenO96aTPC5W8hPPnSli1uHdahl1yul15y4TcRT9xv7p7PvV/dBC3YAggdAxAm
+CF9CHHwouXeTHEp5oxD6rSkHdVU5CLRDU8ddVQ7GKmbzRqgGo2LzRmgKRmgBRugKRigCI4wHI7jA
+CKvBCNvACC+CEd4EI3wERvgKjMzmFRunbiSmAiSbpeLn02gHBm8HDpYlEayRghxRJi2ggo5CURAs
+jR309H47XdqupS9oNeiZnGSRGbRm3GzChK0HDkzdeuCPdVvWb5865fGt/PotBw9uIQsnE/JW8WUX
+Xv57l/kv7plHOkk5sRsAYiaLk7PgYzpdjPVOF30k+Y/dkuXEV0kaTqiQfifKbtFaIbHQRB5hU6rK
+IV2+zpRH2+wqJgcaTZnkaOoQxMvzkHg2D4n2zkNopGWT5yFNK3yRF1VVSoWkzjSZiAzZR9vr+QAn
+6/jSWZx0xYFJK7/RUtnKLb0iU2viNjXv1au5s1e+vnV6j62TJ27rkZZLLpBPt29HmSh+C4pHGdu2
+kc/IBbSul7NFfn4LZ6+Z4ek9Jk3atm3SpB4ZWrSOdN+Cv9hKuqHX6WerJ2ULeg0AEOIBRJvwJeSg
+ZbSXBHlDSgSYXSPNIciCpuw6S1MOmBnBIQinqmE9Ss/cm1QSXUFdi1RCtaZcJXihER24KpgdN4Vq
+aKrYcVP2XwtoCl2hKRRBUxgPTcEFTWE1NIVt0BRehKbwJjSFj6ApfAVNmR03lcWelHYIkr1tZMla
+zUyT2mtRId3LrQIpnEdpNFYCOVaSLJqrkK6FCmpiCOIY4SHeq3Dvlc571dh7FeO9SvBemb1XSd6r
+ZO9VhvcqU75Kz7DIL8Kga3IcKpvTbjTYaK9iC1Xk31opAPMrpitYDHYDd3HLvn3Pz9uMHkaPbH34
+YYzRuPyxj6CNWx55ZHTPCfJdhDbPe/6Vt956a8vaiHmbz/V/aN22bese6o+3jOulxyfXr3388bXr
+PU59/oTNqEgqK+K/2Owyrt3C+iW6CiDkCV9COMTA85LLiEuT+pwUUvq8GI1rhCppwhhUJQ2NgoZ6
+Xqb9SKiGSEX7VP+R0AIioStEQhFEwniIBBdEwmqIhG0QCS9CJLwJkfARRMJXEAkhQ+AQO4ddcQUg
+x77A/Lda9t/pGcgUb4wwaKST4dgcR2tkR8Q5aV4U/Y22odRR8TWtsskePC8gwO0JeAR1Ku2iCXjG
+0kf4sqLnMHJ9sNazN6A0IED4cBlJLI3q/VyOBgCh9gDCT8IZaYQNlZMXoRqQxKCT52oJNBqL0IUb
+I2LkeZfZoDGaHdlam1nFXj3nyHJCHuK2Dv3yN9H2jKPN48Oa9Ts3gLOmDVj8Vg/1W6hVESeceYFc
+Jb+0GNC7yQslEeeDyeXN4hYUwxWuON019Y2PUenznOQ33wQkzhROQyPIhD7lYE8rhywajlSVg6mq
+HAJp+J9WDna/8CSqihqoAUK04S3kaCeAxmZJ5RAgD0pWZT6ZqYswZDrD6TKkGKRRgcqMmPPiDHoj
+sqPs1ogKNZ4TDWYp6yziZl/PaiyGTPv19DzredI6R/TsnPthn8sV6BsO5457u3NLcqMgUOwy+u2X
+J1qE0+S33o06IccX+b1DCPnR81U7/NuB1z9fkEI2aL75wXMYv/X0FmvZtMakrTUq//SF/Z2YTRYC
+CJ8LZyAAQsAgPxGg41gQ8ACsX6mRAVRWZHWoeW4zzvFcigsjxk64JeEwwogMFc54olSeVglBeElB
+CN7sGesZhXfgWEDwA4DYWTgDTvoMIkIehSKojqPSpPAdmLyiWEsURLmK916lyVdUfsYIoz4M2bPp
+sbXSdCuBo/ZgMNuytQ4zVqbltJz5gdaIinfL2dEh3Nwcrlt/3e8lxtNNOv81mutbIQ7gRv1zdEzk
+9XZ2dUE+1z8m51xn4cylrOazd+Y0Ptku55NxwSIKV5MXJ4viEtSTI79+1KHlz/2CG3OdO07pE/rR
+OB0AcKgdIOEXoRIS2DOEvXRKGeudUoaA3pudEqA5uxZYd9Mzi6HhcCwIsotUrsze0tbee7nyVTl0
+qJK1REPu8DQ6wKi9A4yazWooCg2TmnQ3qYKi0sgSzZWvaM9CUr+iYavSscwqOYp10q7lFJFoiKCH
+TWY67ZnSNzNUzNmttgQVt2URWlzwWkJ6xCMjmvU7V9Cs+YDxETv7RHKfooRhC8jLfQIfi2iqmVPy
+hiqu31jd6raBH195KC8kTNQIld+T7LUrudP5mq9DyE/bxS3IPHr2y+3yQrgTZ9DYrh+SqaUr3nKo
+v+702AtNYuP2fYbiaqYZi/sCIHgdQJwjnAEjRIIkByoPdRoVssbfWuh82y7ZCpI7G27y8axmmDMP
+nrTq9KL4Cs8foufT3R/2F878OiTjmYmTLvTrWn3JswLv/+TrLxakAPLrH0He3kH7RIQBWVGWk9uM
+/u3oeS/Y8+lU4Yxn5oAQT3QAPh+6BgA4Zv9dhCrIhBIYi2IOsbSl5FoPAQ/F7JrXUP+XL/u/Q6CC
+GAhlior0XhXIV8z3F0M1FCu+vxiaQjG0gGLoCsVQBMUwHorBBcWwGophGxTDi1AMb0IxfATF8BUU
+s5F/PLWhSDpNS6PGONTr+7vJyfRumkPQFFqyaxomqCrKQZUmXatp+CvPlunv5IpySE6Txn9nRTk4
+02j9cmgrxwR9Ksqhj1zeT743oKIcBqRRxlqC06svgz4M3VPnttroFaePQwZrEkoIQ3rpIHanPULK
+s7VGWWmIvZTUydIr9EG5XmV3WB3c9o/HRc6IiGvd9MZgzecJXf8cw/U9TX3B9WNjI/9tb7e2jhvZ
++MvO114MnviA+n+tVQ+2zv98hiZCZelX/OTuAcGebPGBiUvjo97KblreD6ka5zQWqj7t1GL5LqN4
+rnnLL0YFq5BeTV5gPqMXT376uGPLX/sGi9P2Wz8eryOvbj8VzKnPtH9siBXZotvOXbniSGqS+G06
+p+ZWT+mZ/PpAzYmaTxuXcCMjAABBWe11/IZQCVqw0ImFRp5Y+D23FdOoCxEVm7cbtfow9vbRrDzq
+A7V4XBfVe+nmM435L0wji3mhMqDa82wKvyKutJHQhj8jtcFPFSohBuzUBzVmbVD1Yv/nw3qWVdHL
+HqQx6H0tGqTUl9nGBG3T6lXmBEcWdRZaXDgwIHrvtXl8bOsfBkdeaPHXGWNJkVCpsmXndVqzsSQ+
+o3+nzs2ias5uS9m9zNiGP8vOoqlNE14VKiEWRkvRUbwcDcVUSPNcJUKK10gZaCXjTCdYoVXSpEGr
++EghjXYuQaZb472Kka/SM5BgZCS3lkSmtUgSVH5iD36VO5Zdud9W0MdTtbl91Jd7kop6C5Un+erf
+tjd7jl8UwbXkg16s+WZ184DtqnkJfHv+CwDAcLj2ukjlSnemrfXN0LFfvhzLsV2MPAcwpkkcRMrp
+xugKoC5D63UZjSDS+1wnWk4ORctPFZRpv0HOVwawMVwLBsavUb5Kz0BGrV5IsGU5qXnQBRzmeAf9
+oTUaTA5xIk9qfg7/eXIvzv18wF88IVdHFRZyg4RKvia80vDcmtBWeJOn+LPE/Qs1bfjvP9uxg/H6
+Wm01lyB8xZ4WPEEFHuT1ZgGg8QZDocyqqOcLggDFm2mgGjSKN9NAU9BAC9BAV9BAEWhgPGjABRpY
+DRrYBhp4ETTwJmjgI9DAV6Bh3oziNlT5WWtgmv9jQdpgoKxtnd2otWfbI3RYsGl12kRtti3hrRSB
+2/PIJX2M51WuOXom5Ok5wleiO+83jtSSgYWccLbmDHliJMooU8vvbcDpwlcQAjp4iXYMKQ7U+2W7
+Ff0Gy5kHxULD5ImSQSM9N1FUJsrZCq2cNZfSYH78qOTRja0lYfoUvFeB3qtg75XGe6UDlR/nFvmB
+hlmr09otWvP+piLKx3wTgbyCszCKwcJXAkl7GteMFAR0eSe/xrONLHxYWpe8F4DLEr6CJpCMWlPE
+Uh5Bx0Yvraxvql9lzkLJ1UIQC0KECqmUJj7U3rs0tW6somYdBTp2p3EFzbYpv6Lk1KxRHmwovEXJ
+9crt2OTvpAopP6GTUwp69qiTThmjWGt+0qTqMqZJT0wVaSpXau9VoPcq3HvVyHsV6b2K9V7Fea+s
+3qtm3qtkMPh8ptZpNIgqrZP+YRphf1WOLKfWrHI4tU6qLC3SWrR4Vaowh+PmzEMuIVUgF4RmwtKl
+ePFSoZmA4skFFE9VVpGD16tyyFrnKr49OcfzSLuO7OJ51DbnITEHzW++ti2K43lSvRL19Gwmq9Yi
+9jwVwWz4WxzHNwc1WxHtoHt3zY7ZvKE1epoMa43W4/BtZGQgGbkNEEwmB7hc0cbeotkM2RI5qy7c
+mW1OkLa8GCM4rMJCLHKmc7nqU06Si9HbRxE/0tl257TznoyoI28GGZGmc88kciCoH+r7BvoGVSXt
+XNj7DYROTSZNSfZussUohnCMrinkAJcntaVLd0RkOxM5K4dpK3TfjDnBGKELV2FBTJiS1LMz0hiD
+3jwSleE5P21nW+dIHh05gjDJdZ4iB0ZyIaIRjd+NTqJzk08h9EbvhTuTSCKxvEFe7hckrzn17lcS
+qRzUgoluiLyJ/iFBJAT9j2zFn5PNaIInVZwt7UfiMjwB7oc4AARnyV8cL0r70eibzEznUAzSkIso
+llzDpdzD7mnuaRTuOfIX/lyCY288M+HT5BK5hmLJRaTBpe5p3MPcw4BgHYDYRPgfRNAT/OhWapvW
+rGUfldaspZ91+KNA/NGL70541+F4d8K7fGwWVVVW05o3+TlRNVP54yEhNXn8I1E1q/nOko7X1Z4T
+mwgnKU6qXuQwau1a9nFq7Vr6EZt4nIEepxcpKssiw9DTWU35zjWro/hHavJCQvjjNVOj+Dk1bzKc
+O2CkUM3/CWZKpzHbma0sDbRZbTQvp7zcTiWqnAZk3vFFcF4+CkE5KGTHDvI/8t6qFNtGY1T/93YP
+H777vd0Z8UEfkw8DOWvW7IWTVkkQ5H878qNMz+tCMyjE8OH9m+dOyyLD2F7bkWIjqW01Y4e91ZK9
+fJamJei+rHj2Atp43hhhdIqNyIeB5MOPg+Izdkvt9Y8ybrSlrGJt7JCoys8L/oKzMqan5Tbvz6ga
+nhGqe94Ulb9DIXzVpIWzQYDZ0Et1gz/P1h+kgANas1PTJRnQGQfHepGOPt3j4xNtVpvJbGDvBRUc
+LBMVwdkdbNeUxWBnbyaa/XV4piyd/MzwqlJJo6VV4Zn5kiTyM8O5LVct5v7pBtL+apAhvb/5Q+kn
+elv6iZtUlRSs2rFjVUEJymI9FmUpNxqsIP8EQNAD/g7iJB+ADCYdezsuF8Rd31EqWUEpx1eRU+iJ
+QPQEOSX5jKBk2Wc4TDK3s9WjFLpxkyovDQp+Ch8GgGRopRX1V0qlfz/wteat7WvVn85QACS/w5e+
+58xoMqCfm0uVm6ujrj9mtaonIdcKVnXFu8XF7/rRTOvaGA1Ok8PktJkc6g3NJRKaX7+inmS1Xn+M
+e3UFa1+qi/3oN/i1LGPRUQKcPgJUuxR0ff993WoN6Gb1kiJjRXOKi4v9+QmRzrIyaxWpBNqqqv6d
+6yePaPfvKNpPGD5+QqSzq+xaRQ+oT1VVwIM+VZDLXDi57KcP7NduuB8/NHXeGptURh8vAblVVf9O
+s/o4mOT+fdK7Byn1FI+PhnA/qdKkeww2OW0+wf77TlVVwMNWq1ewk7hwLx4fL9EAKJozKpyYbEGC
+iipJx7eoqa6u2Y8EhS2yqXt1Df2vG5mPYvdXc+fIWdSMfr7wclpEKlGS8imq+oKchdpalMzvw4fF
+NhpVyGp6Dewefhwf5pOke3i7757YRmOT4OqWwQp8GEdI92CF7159eP8yPkkqw9vvWK8BGG45v0/Q
+SzTTa5D5YPf82vOV4cd9ZXj77cv4fQ3DwAofDKy4fRm/7+4wePu942kYlltOaZb0wy336edW/v3L
+6sr7tvUahIEVvvqw4tay2/HfUH28/fYwd+Mfb/fxL8FSmdyNnzvD4O13h+H33Rm2nn3cFYbfd++w
+ePu9w/L77qUOt5zWkforvW4Qzy1l9e3nNvVuC4Mj6uryTvVhxR3bv2c8DcNyy32wPtncHs+dYfD2
+u8Pw++4dFlbcHYbfd++wePu9w/L77qUOt9ynD2pTd5dfQzB19XkveO4M6+ef7hkfrLg7Prz97rB3
+k1/dOrfY3z3L8V5g8fZ7h+X33X8dWHHvsPy++6+Dt99/HX7f/dUl0/BhACmmINN89+rHK/5l9eOV
+29S7LUxd/3fn+rDiju3fM57bw9b3fw2V1fd/DcPU9Wl3wnNvsHV9zp3x3Qtsw77sXui8c526/u/O
+8sPbb1e/vk+7N/ndCV9dn3Nv8rs7ffeH997r1O+jd7XDu8I27Mvu2S7vWqdh33LPdnqP9P+3du6n
+ruSPqE+8u9xhxd3s417w3BnWzz/eMz5Yca/03R/ee6/jk/G9yPFeYPH2e4fl991/HVhx77D8vvuv
+g7fffx1+3/3X9fO/9yx/vP1u+Or42PuW/73ghxV3h72Dn75ve79/Pu6nbh0fc9/94F7q4O33X4ff
+99/rwor7r8Pv++918fb/Xpff919wcMspDin+5ZZL927Be0tZ/fn/Xei5Bab+/P8ucrxT+/eMp2FY
+brkP1je3vXv82zAM3n53GH7fvcPCirvD8PvuHRZvv3dYft+91OGW+/Thm1vdPf69kz7vBc+dYW83
+/7/3+Peu9nafdN6uzi32d89yvBdYvP3eYfl9918HVtw7LL/v/uvg7fdfh993P3W55T5/pPjGO8v9
+7v7oXvDcGbbh+Pfe5HqP/vI+6bxdnYbt917keC+wePu9w/L77r8OrLh3WH7f/dfB2++/Dr/vfure
+4n/vWf53gr1z/HvPfvmu+GHF3WHvLf69Z3/9n+XfcN3b+u/77gf3Ugdvv/86/L7/XhdW3H8dft9/
+r4u3//e6/L7/jgNAqkOm3R0/mXZ3e7sXPHeCrev/7w0frLh3+u4H773XUWR9L3jvDbauzd4PvfdW
+p66N3I+c74f+/9bO/dSt6//vTf54+93w1ffl9yf/e8Ff17fen/zvnf7/1s79163va+6nH9xbnYZ9
++H/pF/dWt2Ef+l/6yf3w939r97/g8Iv/71lv9+5v7wfvvdVpYH5w33q5//Hiv/BxP3XrxLj33X/u
+pU4Dc4P/3H/upW4Dsft/7j/3zt//rd3/gqOB+cd9jkP315/+L/3qftprIPb/j+PUf+tv/53P/4Kj
+wVj5P45j/60//l/0+l9w3GGO8H8c5/5v/fX/G3q/F1x+e1wiAZC0UNq7RJ8uM2aH0JjQX1WoZ2kW
+cmqVRcZa8lFWKXm1iixDC/k4tob6lP/K9CxyGUVX+eE3Mfz2evjN8qu35Veq4wffrbi1mYp3e79b
+Cwje7X3bllhr9AvkNdxiHt+cnbTjv4bbIL8x3FB/LTcaW4V1nt/op4p09l/TPendg+9OkpZjYy8v
+El67weRb1q28ydFoMDlt+MEqNFZZ3022VUmYsa4KvWm18nGTlIXeFDlDDRz0kHHTd+SZ6qwYd5j8
+OFCxFfW0Ef5jbwueKuLxssJVVZGXrFY0oAoN8F9VPsnHUnHxQf/15TJPfmvl/VVE18zjk7dqxW/1
+fF19QB0d3HUdPTevqsqddad19OI4L55b1tH7pOBeWVXFnbrdOnpUXnuOdwsnQSvZucGktbDtPgK1
+PXyVGY+PjcsoWjhJjaGXstEA7a+S8ADwbuF/FI9JsluBbUWyUJzciSpy2W8zAkVK1b5fkRzpVQWI
+HiEulEg4kLKFie1gopiEEtc7E96Z8I4Lo0CM+Fi6d2l1Yk0/fm9izRy+c1OGSeKpf+05oUTmqc7e
+JbptSSjx1AZ6aiVk/nuW5iTye2v6JfKra9700xPHdkuwd+UqOy3EPM9XB0krv40uVX5bXfx0w+rq
+pDfuKjtesO0get9v00uV/7YX4Lzt0tPvtf57a0wSDQ78PKlWmuZ3kh8Pkh/9t9pUVVVVUTy+PXoS
+Hi8FJokeAx+GRIWOmhIUeRBF+u/BUfCMgj/FcXwrug8Y4iie+q6K+Q9kQw7kRHYDdz63AX/49jJS
+QT5YjyLIL+tRyySpnbq9o6qKfLQe6QKRbj35iJoUCDBF5oHuqYyHJMgEAOXdDuaExLtdIolIHrXL
+TOvYMS2TrL/tBXdJoncfHj2iQ4eM9A7tG/rCERLBz3jt/STdn8RMnTo5g8lADR5fldyv4gV4TEXC
+VVVxVBg1BKBufUS7HPW/DpODdjzuBBOJsn8nSzhZQ0qzyEcUA3JmlfIYkFcvRu/4ZJDHJbOiDHxV
+Gp2kHvZq1XGfCo7LI4avW/tJH8APf5x3fDL4jUveNujoxJW+W6E0Q8ekDr52Orzb+9aW6rZGb9Tl
+Ry0jr+9xcZIPMbrWoOf1Q3uL+22Ip1vGXK/sCqp+vrWFn6v8SEi6/ah7G1la7jTWO1SsaaODjveH
+3n3y1taffLf3u69Lrb9+xzF/60G5/YNbmWxnw59ib74V23tnQkZkQCpkms3rUcv15BcUsZ58UHOV
+45FzPfktkPy2HjllmvOkOkjp3iYxr+aqTwC8vo7ElXFTaov6HBohGVV2uUWzUYVvehbg5ajl+rLZ
+vrZnl62nDsG5fo8fBXvW+9HAcFEn4/Tqx+bk3sLLPQvIB+vLZvtIml22njrDj9bv8SOM4fKNu7JP
+VbH3/DJv3r80i0ysJtVIrEablJ2LG28gPgDxN9BGZf+iPN7K+xedyIBszKOXlWahTdVIJNXVZKKy
+k3HKDeIOIO4bZIp3PyNrXxpnzVp/EkzCyKoqkl6XClTBx8keoA4pfrRQXEpoopBjonEJqqhLEklH
+IbI3qkcXSoUJwkr+HeaHdEadymKzIM7GOQWjwH+/yIJVntctyOF5iF7ibhbyMXoJOSye11XYsgjP
+IB9bcDd6CfVwqZHFaTFyKg4JKsGmc6Iv/IA9Dyko0Et4xq2NMFy1zwpZ/Dugo29RpK9TUKhCERox
+3sYdi+qWFeXZ5CULTxj0Wi0M2haV1S0KT/A1tam4Fl6TY50ltc8KK2WcRjHeqnF6qRT1EZn47x2D
+auG1QXWqR2V127aj+DUExXiij9LNUd2yZJyUZ2E1xYmsCaLe6JWiVZMdb8R/UzrrVB30GoJB/KOU
+UM9mnywnFiOJUEmOWTJOW3Z8hEYlS1GHsrOsCdyxHYMQvDaoTu2orG78ozuKX6uF4jpSkQhFCCBH
+OMi/Q/2rRUU9rE3F5KkyOm1MBjaVcHAHaUfa7UBHfqUI0BXL6V9/PW1BV2gLv365Ax2RCr23GBiJ
+spz+FbxtrOSvsTZsauREXtRGJxOK04Z23AlNzg50BB3ZQdr5bv162kKiGJjSxgThoNQGcqqREXlR
+q4xMRkYngjuh4fvvIO2kUu89H7+y/HO8bRjVSIW8qL1CExrdCQt/7U6SZOd/gdyG9D55QE6kQkZk
+s9Bv2IH67MAf7qj5if5FfXZQml/ZgX6jd16jl9AQDqpVE1KpsQ0JByl8H/dyvtGOmp/Qb+wX3599
+1fy0g2/kacGufXio3tTSW/ORTdGbxYlk6Qo2ZORH7likMOGO28F1VKTrGUiJ5Lgdi5Q7X+xwv6XI
+5Qta2GA7XtUJSG4QaZEK8SO9eBZ5WnAdvW2+hfrsIK/gposU1Ite8xHhfos/WI8nSTYs/rWokNPH
+F7Iho3BQlq+Pp7copV/u4BvtIDofL9LtBnBSeXNeHqidCAeZcF/zo7+S0cz3l6XuI50bKVPLefsm
+x96cymzBZFNEYlJMTsUxW3Av3+Enkr8U+1q0A62nluGnFgVo0Y5b21AjmxrLmBGnUiSDJUvBH7rf
+UhBzHb9Y5JURWs/Kic5fK/jFRV5psRhgoDw+0TjLG5mK7NQCVSgKQzZ6roEzM8IYi7hryOINUb8Z
+uKedKTktKbkoYdKOSW0LCwoGvtQ2ocBvbz9q386k1SGUmDBp++R2ZjNq3zaBtYmS4LjwIl/DxkTv
+1FMvmk0OU2Y2t807P7r8NMlB73UahTuj4d6Gn3r6ctUokPE8K7zIn2d4sqyseoRBmRNz20Z1otWf
+9kW03MBOo6ouP02eUuZkgFAKPCyMFUIZjsxsh9bOCJHncdz39SrgnaM6ofdIztOXie+8BNQTHhZW
+yTi8MkywMmQR+Iv6xOOdXoouP02xdZL4wR1rr/BvCd+DAcAiDyK5OCsV2ZyxWBOKuJXeMaRjSXZ2
+SccdRQs7dly41juCdHz2nZPvPNtxR9GZGlJdc4bJ6GbtFX6qjDMVa3KR0xiK9bHIKA19XCrDUbRD
+wigPfWuLztQgseZM0Q4ZpdIwoxPGoyXiaO46xYlycXwsMqpScUIoUklEo7DCM24kVp8p3tHpmXdO
+vvNcB5SV6Hk9AFvxO4WLOnRYVLijA22twyIrDsDdEhnOXihb7M6VMZyhOF5iOjMXOaVRX3i+8Iyb
+VPvhJKcScTeKc8ItOD2vJ7K8Sy94SuzOXQeOZgYtDpNBGe8lam2Mdiyin9AbcjWU1eG5d06+80yn
+HcVnqpHoPvPUnj3YrJC/SGpiB2sQMD0On8khiGW7pPOd2QG1DUmFG73nqaf2PEW63k44qCstf2oP
+cjUsJITiYI7wIH+UyShCA/HKCMeOw6NvQearysiLJG9ne0tXFeYt3w2Z2SxrUJeJE/mjL5FY8uLy
+nyyYV3W1tGkXEtFlIkqeCAglwxxhk4zTSA85Z4fryf5MjAcN+nL7xIldBmU1mznkO6l6+50kj7zI
+H90+kZyZ2CUipF0bS1eVx235aTkaiC4CRjoYKTwtHActmAFAE+7MdlrsqghjhPRuuXB6jksuyqJO
+xmi2iSoR6XasSslA7eqcDtPG71iYG7GN++Ngdn5KVPhf9KwZCWbHDhwiHzGTbgr6+FCgNr3h9m12
+ocH2VWan3P6khbOzULuMlFvabxx7gx0ZI7efF/zFX+FRDbSvDTz0cZApneajajeL44TNbOz0O0HF
+lx+aze/0uoSOpKM3O7XSl9Mh8XXzVHVw+jD5ckViXk2Jggi9hd5SGuBH+BC5x/ka+L/gRLt8ONGF
+OjhnA7DxJAgMdfuEzSeHuawnjPIJQTH976rqnWMCYl5DuHy08hsZLtzDj1ClH73lL0H/uZiRacXm
+NDqNKq+/NqpsKptzNpo02fX1167JaJxXQ1ulO7hJ1STXN9+4JnlplH4C+Oe+48AGab5zcOgZQQk2
+q80s00tPEaKnljZFVps1QSUKJnqWkjNbMCjNPR2RVlhdmBbxtMKPcoMMQ6GiaH/YLqIA8pd05Tfc
+tkgtLExt4eVY+qkS7XbxEvsLGJXX/sjyvjEsu8GOVKfZMHkEZvkw6cT1BGs2fbttljUBp0hIfSf1
+0MsWqYX7qvctHvPwmDEPI/2iwtQINLeBVOKDEamFixbu2/c+hRsj6aAT/C1OUc4iYilJOq+f4vkn
+q7RmEw6iM/pATzXKCsQiOVUPnsqQ6QtzpVmef/iJpVk4CDchp7AYiLI81YEsR36adws3KbzAUuOU
+Ne6ED2cabYSfSBuEuvCICoKFD/iqDyefQ1up2URbpPTgJgo9OpbqpfR0ojjxyxI5rBWJHED4qh9+
+FudI+H1Awk1Kiqevgp/yy/E++VCmxSk+GFk+jECJfu6EP7+UCe6EDybNRxvrAyhE7lPysxI7y1fT
+TIScsPR/KFIF9euYmAbYqx2EkTXh3nypkieOdv9O66DTcs5UriOnW6lkfcj53+vmWf3qWLR2b6YV
+X/WiruJ/9+ZXfX06j4+T2mFugWbo7KiPH6SUJGJZ3lvqUEa0Um5cGOklx+9IpizfM5NLMj9aszeL
+zJ3wouZ/9xNH/ToWX8rYzp3wQ17llbvcP2RZByq5J4eSdKq+xLpHiZRqUvoH1c0UCV4n55ZNWvPs
+Kq6Earz6UpWUe/RUB5LLPhnflPDLj3zowyPJIBldkkW6d1bR5zynmf2eZPAO5ZGTFp2WlEj7x+9V
+Ve6d1DKFGNl+qWwD/XJnDka/AqSkypj9Ml1M4eMU+uXE+OwqIUYmgtIvWfNln0wZ/cqDK1rrtIwR
+Rfs4B6V/X/Knn3USiX6Glv/dJ9raWugEyWKeABpbwBQAOKFRBUwFqG1On1nDNQDhPeGmxhYwDU7Q
+/WYB02kJK5sNyeIUVm+GXG+mr17taeE94aTGFjCb3tGoAmbR+ixG7YRKaD36DiTFoxjocx+bHTGv
+wuekVav4HOcTaCC+xpRURaZVlRL6HnFK6xTulFRX9qb02ZPRjGSP+l6acLPmPecT5EWcw7itQg9X
+laL+DbQt9Wy1fOqaOIW2SgV580s08Aknn4OvVVF9kzGBqH9pFUD99nWs96nlk9c60XapvsUm5MUn
+nDXv4ZwqqiD0eCApY9VZ+y4xj/8ROKZ9Gp9L/M8WTlarJOaRq6oKDZX4ltvsKOZxr8p16PFwUq+n
+dbLTlFa5V6tKWXvS81fkEqfwP4IIgX58+jNZrRJO4nMSg1urqmgd6ChO4V6V60iypX1FESxtDg+Q
+ZTq0yk/P1D6m19VznTLFdmZLtkP7AG4i5vEbaS5WZ8rMVqayGtFsms1vsmRlWWom8puWvPbakpqJ
+HJ/VLavqtVp4TYo7WEwjhrG8klaSCCWT80ZbVVyW+xT9jPXFW/zmS3jwJbe7zvNM5VnoRdCxE0DZ
+qxes7IBte2YcotMCX2TIa+aVtJkaNbz1/Llz57ceHjW1Tck892i/FvSPdNC02P3p7haaDo94OtWP
+67oxH8fasTTQjs4b3wlf3trOWF+sd92vFdSmbrxH+ekmjrtTO5Z75se/oVF1xEZ1QK7I7dggA0Bo
+oCXHfbd+Z3qm+NGz+fa00Rh/lJgn9gMRNABqTLuYn3S/cadwX1QTAY/yyVQYSV4m+/5AFRfRZzWm
++jF0Dxig+oQ/Kz/vFgxIwWVCBkHMI9fx1urrpEpBhhKFQM90jq/64Q/0hRcV+vzaRaqf2lHiOIU2
+nQMZkEPtS6B8JeBqwn1R85GP65oSYeQ1knGRpP+BentG1pl3UFxCDYSxF9fpI+wmgynBqslm3z6k
+mB+Qwxncv26vqtpOv3NIY3/0/LG5A65UoeyqK+5h/oKkfBtULYRIFjkbTDQdxjAn1ovtE9lddvhn
+nvu30KjmR6biJZ4lL5MvC1rqfCG9rmUBavIyLZl6pHl0KMdXZaQOm3ql7LFRU71Cmjb6sbIrU4el
+Zfr06OXP30U4TJl+86F9A3Lcv3IGyh/9zkE/+DTLd6HckZNVVzxL6+kV9YYIYZEQAREAlgSrxklF
+Z0gQZUHSb/zh9qopU6q2txk0qA1+w9OVfkdUoewpU1B2VdWgJYPoh+HKhghhlIQLZUZoVJRMh9ev
+0W/uNx8uT1f8Bv0WIqrIySlTyEk/ZAi+hsXC1/xeikuXlR1vzI6PMJizrOytWVr2/XV9GrjsW5GD
+hGuK8DW/g9GlF+NtYrzVYacTHSlTl5ntEL6uTwO6fCvXFJfvTGFQc6ga/U2CSbByfnBtLWSQtSGn
+xXCNLaBSXUL35gRUim2kby4eQCMGVDKa7GQtv0/Ugx1AoG83MDlM4U7vsggre0MYR8NgZlMq6Z5U
+rjLoI9CEkXqMyM8oofGQ6VfHl/Zt26ZtWPhoI4olXwWG6q25I4Yvn7i0f6ectlhzA29vnXwVzX/r
+yoT+bXr17GaN8IxWXa2cuGHMrn79xg1sm9+lfXAw10hXh67WdCRosGl7Jo1Tsh2M5uxbaKZDs6gy
+GfCPvFCXjvCiSPInMiJOJN+hVqY8H939YpGOXOQ4jj7zcljrE4bjfjOGDx3wa+UiLwek7a89erb/
+jY2xKADShDK+UiOyl1Ni+Ap9IHzD02dzcQ2PZxbfAhSr8M3RTaP3Nt0y7Pjhw8eHbWm6d/Smo+5f
+ClvnFhbmti7EM/yc7MHWhew+9f1fwXxvG0l3bwXdpUUSobTo2X3Htj3FUmEhYNhY+6Gwgn9L8qMW
+ZNQJRqRTKw8lcAC+lIHJqxmemJ/JGnxpcjJ9Xpo0eQC+lIF6oAxPDFmN5uBLk5I4lee1JJbPwCit
+9kMxwYvTiZwWnVONlKcQfNnPnpgM8irOwJc8MZOTaAI6eTK+NOBnipQczHBvoAhxdxWXNMkTQ2mE
+CcIKYaiEjz6mEziVWnlihXAAq9gTZ3hi8CWKz/N68mRPDF9B20GoRwY33RMzKcnzGkWIL8k0wgQx
+QcGpRjbOYkOcGskPAPkyT0wGRj0z8KWf0QOemMnJNIWdNJmvoKQfRBn4EppDVlO0nAp3Z4xjbx6H
++VmkHJhuMjD3igxOm8HkUBkduBkukY5Q9+xsTb1ra/ze1avuL69e5Z6SD1Tfxvyr582Ov/zSkX6U
+8VNZMwZIK6M1iHk1v0pYuKc8v0sVt0GdtXpqgKZIa2ZEmPmRXijlJHf/9YEUt0lrZ89B7LiP94h3
+4aTSjESLYjc0d+BnLv5G0qKuZSCvXdA6fubgbwQt6qgekFf39Ox1P5X7K1oYWke9yKtbWgd7Neqv
+R2FoXeUhP93dorlb9VVPTz75/Y/VV8Qni5wfWQeaN3jVXuKVP8wGGgfGsByB38pMIcsbSrhj+KO4
+hTc4ClDyK2HiOL4Tq+e3HFPIqmnLXZLHafw/0rgKBcihimJLV8Q8uT2/5aBoCX/UHSO3iVugH6rI
+DblJuR4KE/Pk9vxWfqIl3piAu1TTFv/PmwG9Ic29DtVyYqVgAY7WNGpNWku6KV2bbko/JEZXDxSc
+1R+5xfibF2bgJ6FheNQgPKfBT9aBxyACCCbE0SrJFG6GGL1GyKnuS8GhAViticKKI+tDYhBrOdEk
+WECgc1QhHfn9x+nwA5417v9xIVyo+69ReC6e63mQ07ivua8B3FJXl47Std66T+LZSkWs9zzIX/PV
+bKAe5dtbDz9QvdBXE8+9bT2TH61P8r/6SMV6f0qDvbIQ5BWkBoiGODBDU0iBDHACCAb60kSdwcw+
+iObQlW92Jf2yG8xeGB3LtIuVl6bPmDFjBnn+1C8n+Z4zpv9y6peT5LkZP9CbP8yYEXFl1gz0+qlT
+6ODJk+7EWbNmzRLKrsyg37OqP5gx64pShipmzbgi2V43AGEmexcR2FnvsjvM0ks9DWatvLadveaz
+5GhlZWXlUQ6O0dcq0w/uscqF9q9+bTWa6HKRTatfW02GXSVrJbwBAJxe1LMnfXaDqS5qLX1wYDeY
+cd5Wz9QtFO8WdJN+uVwutH/uubnopbme83PPzZX0Tmk8LVRCsLTin72LNIn1MMkhMJyZvEHPmylq
+vHNa6fbHl02jNHqOHaVE972JrOSrG+T3P/GlvY88/BKpXu22u7j+q5Ho+u3DE3+QMBdgKCEF4gGh
+kr1lAOjrxY06u8HEpaIkpGXvQHXaDaHIHG910AbjeYNetNgzszXWJMTnFbXOSf16GzH83nF4Qaue
+w3v0FEsH7u5H/vnnKNcf8Sj6I8/Wpeu2bFm3FH8VkppSSEpc6N11PT2bPtlUOPTXhIQL/xPsrtPH
+drxm2oqiNksyLCFHVXahEuIV3TCdyN92LXtjIZUsZbrkKFUN7kv/HnPpNaMIOup5+ZiLv+RykUB0
+3eWqiSGd8P6HXHiUZ4eL4c8jl4VnRD2YvPi1uYiGQxS5jFt+I67d0GnLkxjaPLfw1Te2ztFoRpEl
+SzsOmDiq1CWY53oWDuv/xSeeSjJp/UMu7Fg1/omXiNlVl4cYfx6kcUCNJB6SEPIx4NqJUz70/IS/
+epQycdMzVmLB8x02uVzE7v6FMYErPc9JPHQil4WXRT3F3xTJTEjoBeTlAqm0dvy+Z+mWLegm2rsY
+XX6f/I6OzWR8bFtIvhq5krLhjkRLXa7rnuOMDzS/kJyX/TM5Kqxn7wQDuwNJHQOZZPn34OZ7HKsF
+jXsDN1+S+3r+6Hdo3PqatiQb738IEHQhl/lOvA3C6tW3Wc1d8AjimMN963kCj6TkuLgWr6OkOe4P
+yZT1D1HbL6i9JM4RrkEwm+k6NOwdlZpwe2a28rpd+moLc7zNqtU4s030WRbfbOXGbbm5i7ZsXIl7
+fv6551XnsMHryM9P7dz5FDKuGzzMibc/gRrdWLbc05fcuMH3Kq3JLN0yKCX5swo0Do3/7NPklEES
+3wWkIGC+UOlnH2pGvV40JVhtDnss64taZKb6KzhyBI9De1AZHnv0H/JPv90DS8WePYb3JBkeYj9C
+lehuxx1xVX/yvwsJCb8OLdz0Cf9WzR+8tma9CwDXXgMQewiVEMb2ZBjMyCxpkT5wcehMOrHHPM+n
+f3g+eQi/tXE+zlh4gCcZV4RKV/VzwmCXi3yGMlye85734VaZGTTsebwGmxNE5YXF9PUg9nhjhFaj
+Ek30eSB+1iszz6uff457UpkhI5UZ+ZnKjGv+BPnpxrLl+GUUcKNmfyn/qSwzspVs8cms9hsAoYzv
+BAZqj4qdZxszI4wGcyoyW1X4MvmBrJqNRyxFISi49Z64B7tOx63a9TFwL7tcnhK8cwvS677v3Zm3
+M3y/A6jfE86x3UG0K6rYy2wUxCY1oi9bNKj78dOb5OvJQkLIy+RlQkjBI/i9nSgGtUONURfEdT6e
+v5n/bEvntjUJfF7NcSoz90Q+tOZ7LveS7XupHdUFviN7J0QqYg2ZgxQlRCN7NGXCaFBNxe1aZWU1
+It/+/f2lv8iRWbh44Xu/f/HXh/aDxZu5nP7tHZ3cu/HjnjGMm+O43NMK99iCbN8zXwAgfCdUsqfb
+Jq0yKrD+QK+EAk+3Yx6Q/MDrq1fj854dLtmBHfG4leeVwK/mbbfDwQPJK/Ws2LIFzy9Fx1evRtfJ
+MpeLc7hc5PgnEg4MJbWXxCq+PQSDA8DC3hSjZS8sNCC20Iq93Zq9ptigN7JXGWss7Cmx9I5E+rob
+vld8+EhnbrPMdpM8GyYMaN8tc9y4V5bGZTXLbVmUFIq4sW/0OtFrXGa39gXjPyQhE8WAlC59EP5g
+yNS/Pe1MHbOXFO7YV7qsbXLkojFvX5o2NlSF8BSEOjdunP/A9i07CpdkdzTlSvy2qr3E95B8NGK+
+WGV2KE7aYHKEInOC1aaVuyLu0qV3x+4B0/KfGfDNxWc87qyXFsQVtZwu9u4xayW/b+nMHvk/6HRv
+7K/5xPX21InqANR6QL9Tct66f+0lgec7gArC6PN4oOtxIzKzUXamkb5WCOJBQz9c8egNo0Y+Moqs
+KLxSzA28WIQueT4lx1EetqNctHbp0jcXLUajO3a4WH7IfRIl4RDUlJzz/E/iJReAP8jb2PN+5V3h
+doPfe8MN5m78hBdeqFnjQn+Tqy4X0pNGLt7mcn/sQqZdaOVKtGIlytxF8/fFtRhncOPCVXgWgKc/
+6ys38A6uRrgAoXU9CDI7uJp88sRm8kQRenMQQTBNuNDBAxx06OA5i5t1oLStIgVCD+EatAdwKuLM
+sqahVOTISjRl8katmXULgz4iDsUig543JdhiVd6gICFVsFmfXBzz5dHtz0wpu/DNAWRDgctQ9NbF
+Me+99tiWcR9fqHiLnCCnRwwPMukTYpolt0roPEU7P/vN4s6Lhvbr1KZTgqULGrF71wMzikclOZt/
+WP7tv8nJ5PXHN4wdM2R4ljOr6vjFX4N0eU2cZmtE2OQeb2d0SO05ZET/tkFByhhVIJyTYgXkpclK
+Ux8m75CTSUnn+EXdlmvmZx8eNG5/7swxa8gC7wBW8kjrUY2bOnPIZ9O6vp3RoX+P9Qu9Y1lR/lJz
+SoqBtVVU+5hwjo+BFgAoFKlErMrlnLnIaeJMzlBOFYpUJl0okr5NqlxEywQfDL95WNkIS4fEtMKB
+xRl8EIei8sn5zLSiwkEZnJojl95u0jGFV/Hk11ZIo23SMYWuMY8zphcOLM7EARjFFpOL3NLQRmH7
+yP59quDgYFVoYihZcVYMCQ5RhcQGcwsCQtURdgOKKQnQqEMtIWiSGBwcLIYmhJCh8rjWlj/Cx9Bc
+moBN8ZzTpIpF9mxnKrKlIkcOcirEmx12RnIu58wOd5odEgOhyKCnvoAfGkbSSWw7hNJQ0MoF5QKO
+NjaKwMP2jBBCBXK5bOZKLGIU/1RaYWFxRmzL2BkrKCexezAXHOpwkUMouFWrnLb80/lTzOY2MSqN
+OtgYEtm8EZcyJCQ+yGMUAoOCxPUobP1gFBob7P42ODrU5DT0WSLpewRpJ0bwMdCb6cCgN5ppFkuF
+hXjOZjLaIkw8ewurnToznahKV0k85SKnytyggkyyggRhNcdnojxRleep8bwYGYRig3AaikdR8WHp
+qJc4Ji25MHlQpjHN2MgeiqZwKkwurU7Jt6sNanKpAMU0SslnaoqLSKUaxQJGZrRRa9WPbZM/JSrP
+TL5BKGrHE3gF3tw+b3beiGERsejHAFWoKixg6ulpCKOpITFBeJVaE5gyPAUtHR6gDQxNDHYFhIaG
+BYSaQxBgWFNbw38l0tch9gBAuciRZU4Qw5BdlJik2slFzmwn5ZFGtPEGPS3BKoF2CkdWtlNiWRuK
+VMz15HJOOnhwezh+QGBk2C40ponWoglQhwT2eb5PbtQma9vkyHQjigrVWrWt/yTjgmKCbgaqQ2NC
+o0+9auuU8scfHZctXdH599/x+zqbdjaHI7RlvV/o07RrTEoqCokOCdjCJwWEqckTHD6VmtvnlT7k
+D2suQr/H905tt7Ld/GfNaFBAWGAr1G5TSFRkZGiq8mxnBOkg/MPHQBYAitc6dKEc7Wt2lfmO/cup
+Fw16p3CKJL2dVlhUnGHpkljCzwwkF48kdWUd7LscFK1L6pqOVRjFNEovLhyUyQVwKPYCwm+QDsi5
+jnMFhIaFBbxMDrwyNpNbqgoJ0Nq0CApVIQGh5mDkCggLCwsITQip+RUntDO9hCmtxaS9qpCPga4A
+JplQqgm7IVGble2U79gNZv9+pZAsf1u4zAgJQhj0dlpx0aCMlIEpoup9EhUQ8HZ6UdGgTEsny5Tl
+WIXJxeMzV2EVh6IbUbeRSTlJiGIg9DL+WExb/YNYwNzigLDQsIAKMroirmXjtx/FqH3jdlZuJru7
+l+zbOzaocRD34JDQ2OB5ElPmYBcrDDUHIfdCRMulmKEYQDgo6iAEcgAsuZxT4kjweTxVVrajGRIT
+zAYTZzJkZtONq17O7AYzd0mXaE7UpRUVDcqwdLHgEvK9Mb2waFAGDsDk+58RatSsWUo0+RMhTWof
+BzJyjdKLigZnciKHYo9NXVlR+NKePUVMMXvJGy/vnKgKCQlRhcQE8x1xrCMWDUFvu9FyMm+GRH9C
+MHIvGOulXTWAbwxB0JHGO+EK7bJWfCykIrODdh2LKtuZ7qzrF0yUBxUnWLpY04qKBmdYOlu4Y0fS
+iooHZ6QUppBLBubmsIDJd8dj2utXUUU0bpSWXJw8KJN58EivfmKOzFgZWLEP9d7nY4dbwq4ryOCK
+iapgyllcELcSoSE4NC4IzVWpQlWhAaGJIXMV9bgXjs0EBBMB+O18DDSV7S4U3c7KOOk+fvJtRn+Q
+PmjKMmZLxbNWUgJjoqg2pK5wfOaDWIVlmuyF9rFBMUFoB7UT2TwSQtwLZOPAUEDa85VCJajBLsuX
+DYrI2ho5fa7XpKNdlWZBTDovTU6D3shhS2fLkL3DrZ0s6MZLAenUvzKjuHgVIYRKS1Ew1yi9sLCY
+0RZ/AR2v2I/y92saa6jkPM/udn2VqhJDRcka8nHuzLziPNJmukJpza9488wjgKEngKoHy092r2MH
+TFx1KK3fXY0Ndle7MM/S1cpMwNLZgq9RnZMfDNIAgUVMvj0mmUoXy5RlHGXonZmr6JgY49dtJbPg
+VBjFHplW1yimBDUOmujljFvi80pSt8Wh8UFzVaGhoQGhluC5fnYPGNbW3uBfE3Wgh1ZSlCIPDUbJ
+qrPD6ago2OsOCBZtKKcymHQmNiKYtLgoqkmoMcPICxnOyc7YTH182/it6TdIGdIkar8LCdY30+u3
+LUdLk/v1K0glvyOkTenbb0AaCuGWcp3azvxrpj4xvH9oYHigMGLAdmTb7llamezg8KXE4VnDjg/t
+MUnfEsWrdeHhga1QyVvqMI1G3dZzSZ57A/CVog7SKfWcSiu7eeZJJPfvpyCV1mSQe6vWxGXHNm/e
+yqQv2TuSmnX8sckrAsn3R6iBdbFMZiZ/6VuEIltNaIs4hOLIBeFBMTgoSEWOzNLEaGTPkckt0TTW
+KMLmDRhDkVobGBwbRP7H/H2tW1XEx9B4uSlSHIj8LehSkc3hbCB0Un7ZFVZEFzUYZgwiJt+Fpw0c
+WJxBSfz+g5hO+gf5QA5Fv5NWOLAow9rD+sBaajyxb6cVFdJhzTpjNUd/H5+5eoIoO8MJUhQYEyxw
+D2KMBqOQ2CD3MsbeAVRwYAgKiQt0l9J4kP7ePyQkLkjxkwgGAYgTpbi2fiBrcXq9CboNK6FIxU+N
+YGEsH8CTyweyh7VShYnkR/vMFcwZmuwjWoihIoo9nMYGsy7WGcup3Tc+On0Vp8Jeh861CghTx3eP
+Ff4cEhoX9ExAmDquY4y7lHG2DxXuGxISG4S87ofSTdqKG0UdtKM5Akf9jswU4o1kZepl/54d7lSx
+xKxJyzn1EQa9UZhwhE+XnHYAR77fGN+yZeuEgNAAcqFwBnOTjdvmTesQoFejuD3UUxVnpg9LR8uW
+ofCPETpI2i5745qoClaFUkbwLiFArRbMPcxq9DR1nuSUOjyoWXEz90VRDBaDVd+jw99jZCvuRDh3
+e+yc6lx9kNkWgKpA1EEHgHqW7tVK3cicDr31HKtUQSh+e8jLwy1dLdNWspEpgpkOr+bIj8dnPcis
+6Qi7lTIoBeGlS1EUapReXDQ4k4atccdmPogDcJ2O4FPSg9R2qGkFB6u+QE9+gQftGTSoE8GK2w12
+L5Q0JPXlL0QdpPrblZfmIORThsKWPFSdMbI5EpsV/YjQ8uUoAh2m7rU4w9rVOmMZHQ/ijk1fhXmf
+9fAtcZ9tfZhI1zApi9RmBofGBcsmg6GQdOBf4+NAw3wjnSbIszjkNGc7BWo7nIpGkXStCQ08Uzk/
+68+2Zxr0Kic3T4zO39YnqW9S903dcXcdio0qHdpq2tRpOWg70pGbpMubofHxjdXM9uNDhh8fEWfa
+p0acuXFcWyEgPOAjsuikWq8OWRE4ZlYwk2Ji18TtjyHLG9wohDAaHBIXlBEcFcSN0piQZOfFpJWq
+jzCWjl5sbK3rdUwNeB2pcypGYnV4TZ1mdkT+OW06cz08Jt8bmB0wUR9v3F63ksZdMYeZa7J0scxd
+hzFGicz1ZKYUpDBlRJ9B6A3SasobZcMk6cf62cdyjNEgLiSG2kgIczf9ZXdTKtvMU1/gzKKMwTlk
+kzsYpxSnPLEIOFhGOoi1/DTQgRn6emc3YUjELCYz5yInHc3YJNSemYeyw9lvOx24Iowmaxr9JdJB
+zanLTkGpyGYyKkoLxSrhD8wVRSaWJ/dPzsmZi/L66twfGJuFFfNCu5DG3yQNSOZCwtcvQFlDBw/N
+Cp9BvhsfivKfzrc2uRg2IBlFo1mYx0gb3GZhmybNbnJiAM+FBUcHjxRjPiOzPwtUhwcN7487B/br
+QoqNaYZZQuNP0dpPPYX9x4QGaMLCAnYv3bpz/hdB0cFiZfN8tOGUPVufrlsfqA8Ur4c1S5PejVxE
+2okbRB0dVyzUe3FOrd5osjq0ulu6PnNl3m6k5ep2ff6LUUk9e/RK6Ye2kO+3dG/Wo1t+coA2gFxa
+OnMl87rjem8eEJkWidCKFSiqDysP1Aei6AV9x3EqbgWqZLkFMhqT6n1bJr6EstBklkew9EnERVST
+xBgcETzzh5m4cHfhICfhyBIxOChYbDaomeeNiaFxod5x/DQfQ58omZR5uIhVykynfpSqjCT4k3dZ
+GJVh7WKJydWvoCZ68ag0kMQ2SmczaxVGpqPTKDPcogAWH79MXn0FKXOauCA6UdMEhJiCsDJa0NxH
+B76Sj4F0uQ/5DxYSKQJ3qz+SOgz3wFNScJdByTlPHY9IaZA9UmfrtOUs0v8OoT2kw85lO0fJwVss
+JSgkPgi5l0mO/wAasJ8OCDW/4mZ9my1+Wnne0IH/go9hazaxKcHmjEXOTBab1df9rRT+2I9MOr47
+seNzJV0mJghqgfVmNrM6NmMF9ZMxRxiZmZRM5jhjnWR+t92pKfjxTVFNG/v5dZptKfXRSV2nRN8c
+0p6vkv24b46rMZqsbI6rjKT1VXn+CJvMmjuaAwL+Rz6tCgg8SL1HDFWjlNJKODZtJRegzDeeJ4ee
+t/SwkJ/f+w6prL1TuE4YjR+U56md4puaevX5LGkrNONjoBmNw/wm3iYDr81yNmRdfGfywxEWl6cM
+TMEcbux+J+jt6Stpz06ITi8sKs7k1HRYmbbSOycbVREcE3bjMQ7NMGdxi6jtz607y8SwHED8TtRB
+OJ1jMg8lynkYSU/IpPMmakSsElUCdVG0zGmR5EY7tFnK1ogqSijub0wIHRwWG9F8ZvMoe6PYnBh8
+iFxaLgjDAvTBj8a0iEmvJmOvhulT9EnX0RY6TQrIGp+FIjONSK0OHDZ9JTcK57Qg/TtkZqShgHA1
+P3PSWqRZ534cT4hMN67HuNEa8vuaqqZtP8joMPTzoZ/YctCSQXmejwN0AQL3b3zPFNJ3DNP7k6SD
+aikfAx198YjSfy2yyEWDRjBZb5V4/dmnsIwFJF0sMXl6GvSRy4fTiooHZSS0T9CiRmTnmYiHpjFz
+bRwlqSKAQ5E66TKQQ42P0y4mhSR7yf6XEZ2Yitx8pqenyDtP9e5JKg+fQtpBo/ELdaart85bERSS
+9kJjPhbaS/2NvtXfZqIraeOdVlM8nRZlhzsTrPXn0T7f5ZtW2eP5kdjz1881eoQQiuODxg5CaQiT
+x8inmI9vEx/TVr/Cf5KtxijOFZOrp2ZHLswe8vLwKEcUxiMxmkleX7dS1UhIcrYtf3d7oCH0SZT1
+JBoUGueb4Xk+RmOCY4KwPayxZuHNhQG6wELGz6OkvaBT4nefY3OKBo0p3Z7ppMbPemr9vK7dl9/Q
+G1jKgno4xKGixqGhr7/gaYvxkRnLabeIYeFiprWzZWopi76OTl0ZKM9RgyOCUbSxFtBjCJVqY0O5
+lbIrCQ72eTw6taL9ZRBpJ87lE0FDn4oglZRQiuWMKJYzqiycyKsMJgcdvkO5uvOP7ERJC4j/Jjgw
+PFwb5Bw3dnwLXYIpQY9QRIIj06AlzxxYT2ofqgX8iX1IZmRKSno0nZFcTZ+5kg0aSV+hV6rEEBFF
+Pk0ewFv46PS09OjAcJ0usFnXTl2TPO8IASHBwoc90YYJ70/Y9y22TP50Mq8KCOB1KeHixSEh8cGH
+zJ3N+jQ9cZIPAcFQgIC1fAz0AbA0kEuoM1VVPLmvg/ilmjh/n27h7AEtl3EiJpd10oyEhvAX6ETW
+0tUydQWbyL4dlZKc3lhQC+RyaxRtiEpJTo1hDwmUgUqFkfnI0JdG0Hh5BU0Zxhybmlkvr8Di+/2v
+jKED5yIxMDBINGRGIOtIQa1Wi6GJQQ8o2acg9/KQiBA6cRwUGssSDdLzENKef1PU0fWmij+wRYQb
+TUinygqnm5z0EYb6WahzO6XkbLdE1eSLk8mnZMhRhHAnchY3Si8aKPng+GfZxHAlrpYzHy+8EvAI
+qdiIe3GleDHiF00Y/dEY73zDwyljwqzaav5J/hk6JiBRJYjmdBoP5iGBdVXJ6WaHO7PNCUwRLDYU
+VXyztBvkoYBA/b+qdigyD2NT3tLc1jFRLaKCs9ttI//0Q43avvTAL5hDcYcznGi7Mf4mcqsNQS05
+tSEwtHQZCl+WlYxG/J64MCA80DuenxF1kM2eKUvu/tZ4o85k1BfNsWQNt3ro4EBBJaQlFSYVZbAZ
+6bHprCPGSVFHZspAaeIW9x3HV5AOJeVFTVOjbTE+7dLxPEiJO9hEbcjLgwfnEq7mL02i/thheuYG
+6SC8wMeAk83PkMpAI87W6JanQEo+FN068+f2aMxtkraRb+Y37ZrG6Hx35grEY5R4xNYxJUAXgIKS
+bZ1TrD1sc9ZIGcbpNHDrJwSJn+9aWvr4b6KaPbuSqRXUQrPBzd4R1EI5Gl1O7yG/fOOm2hviIVEH
+YTCMaZjzS2zpKNl60eAbTFXSaMpYyaYPhehDZKlTioJdXkmujMXmXM5JH4ggZ5bVYdKa8Jx8w0hB
+SM0Yl57mDHoQfZpamIVCjMEoua8VtcY8X9iyJ6fiyA8PcBiXaqxGlaAytzU3IW70oHngAH3CJb0x
+2dj4b+wcPXJ0c5LePxuhAHKDKwyaQDIjknWDOFVogKpDLp6PSFBESP/tAzCPA8TL4RExejJ5TJgp
+FGeE6MO1KzkeY/wcOfzc22aDGBbCfdd+8ISzExY3ZiEujioMMoQERgaQEwAcDCZT+CR+PoRDLFsB
+QpN8iY6sRKfDbqQicNo5vW/1PNKxB/180oIL493kLyT8sxaNighvpXadnpbfa9q0XvnTyNMdIsPb
+kgMJCec/Oflz+CfnPCmhunal3Ln8aQykJgd9HxE1+PFZUr6drUHn2tx+nTv6b+vcPRXKGnOEgGvJ
+OUU9CGyfrVlrQoCdleRJUT/X8yQgeIRriT+Sy+maKBMaU4mbkyeEHXM9Vlqf/MU50SlaLrBn73hE
+pecjNGLzXPw17buPkL/wR1I5YmvM8EeeE5VoJPlrLh4BGFbUXuL7C9cgCBIBLH7i1KX7npQb07Vm
+h5WK3p4ZwS1TxHnapZ4zZc680nVr/yE1KNQ9/sIzUsE0/jD5pHT5ow8/Xp5EKj8J//nkJ+cTpP16
+q2ov0efzGhGNZ78Lay/xKXwHjYgmsN8lAFyJUKkRUSH73Q0AX+NtGhEnsd/BAIE9hEqNDZLFq3S/
+B7eJrXuIBQiYwttAC2wvkU5ajBDPa3i2nMxkoCsB6HJD5F1oYjfEcou2PIEiUSPyE/nRM2EL+RtP
+WZ6/YUP+cvzlli34kS1bsBsluUj5JvLeJhfnOOBZWHKwhOjYQhT3x3Stoav2kvqqcA0aQxO6u9qM
+VaIpHrQaMMUbI3S+pkwOITNcSxcvYa0mXFV21XDkLFlFHiT9UBlyobnn3o64ehTXHj3qQe79R956
+68jRQ4dcOIF88sanaAeagCaiHZ++QU7jeLoA0OWKQR1RF9QVdSRHyEHyGjnCng/VXlKd4DtAJCQo
+q+hEkIkBo5eWRI01QaSLqVQnPFO3fKgj59jSj1wUhSJResgHjPGaNwZfLB40bboLFVSSn1ESEpEK
+NSWXL6FxlPUnkPv4UQLHpHU4AIEjhUrAtF1k4pDDrqu3pgfZEftN9/UbhKPosyueYfMuePKOetBR
+yjbHIc0f2P6Qp+PG+Z7PFh7AZtyq5hkXTvB8Ky0d8lzA26qfc7lQBvmMyn1S7aWAeL4DqKm3Rw4z
+20qVmY18q2kcWc56RGS3Qg6/tTfxvGhdMXJCz159R6ERW4nYqWzKs6/EkjNbPFOZ8tGjC68fmrdk
+wsxG04edmo5ikBrlV046OWoS2uq56lrVv/crzxa2I3vkZUlb++ctnT9w5OWEhDPUTgtqLwXlCtfC
+xeBtzG77114KaMp3CBeDH2cyex+AayWsY/tLac+267R2LVpx7Fj1Yb7icPUsYV31rLvAcTsO1+y+
+Bzg87rC76h7gUNZhomkQzkTh7FqzBHf4MF9RPashXPQkRq7VsWOk02GFC7gbnMzFXeFkLu4KJ3PR
+IA+CH9zhw8I6QF4YQTqz2WBCSw/jB0gnYd1hAL9yhkFrlyEOo0MUhJw6fFcYz9a7w7hH1YERpHMN
+tHa0FB3yrJFIBQSleAd+RPiT7UOhJ0Jb7ILBhj5b+w4SRMS/g3fgLV1jfvklpiuDXYEfEb5msHat
+0yCYLQ4j6rD2HeIWSc07wtee8TIshufwOvSocAHUbKd0VnamdFaJzs4ZzI7nOo3o3HlEJ3S1+0D8
+b1JeXlJKbi4XQZpOnMjs+Dm8Az0q/AGBbP+RMn5kRnBmncNuQI92zsjs2GlEZ3y120BheVJublJy
+Xp77KvpywgRpPHgOAD3K9dOogucAeEbJa40L2FrjxIbWS9dbtFp/zfTfviWr+XXXTfutWMVl0tJp
+tna6hRAo6sF6y9ppxovNYZQbamAB9Y/f50iLPru2rLeG2v3dsy/odRdR785D53NJvoXUCIykRPWO
+UAnJAGw4VjijIxRbmMib/BYC0gHLIe05mvkBGbtaOLGKjOJWkseqpu0kr5EPHqhZ7QrasWbrrr1P
+tUNqEvGhi//iKppN1l4l38whekN/1+env2vSBHV5aNOL25/Z9z0a4/7QxfSWQUpEIkyHSMgEoKGx
+tj4JTJXIpKyYpwGmH1EfP/44GsH38ackJv6bHbzek5/csk0u3hSYn5CtkMa97ELdyOv+FDnw6jfw
+Ws/stR0Kine2aayRCWQy6lJ7SWgjXKO5Vh1dtBfhW7RnzHbY9aoIuyHBJhpkyrQib45PdGRZrBtQ
+d9TSK5O8N12zBi04+/SGwwfXfBrkWk2+eIDsIWuQ5iO+DaWBlNNGF/QaP/zdBxrNbTxh2e4dmx4i
+5U2aVH5zav0sQDCk9kd+p3CNjuwCbYCuLJNiIB9BEa2QwSwNp9lOeyjC75Mr5MBXe1YdRUF/PfDB
+cldo4QOTp81fkNXuoS2bSicuDd1l/TXyx/ef+SbOtm/2rs/MZlI5f+njkx7YNvcEuXpoydzXX5Vi
+z/61l/gLQiUYoBGAUzZ3p0kyBnMoMiVY1cj0KTP07tjzBu6Klh07hv9HzR/NJePRFtuvQws3HpvJ
+HXQXL/3t2wT+Hc84ipe04LewtZtR0ip2is4g27rUSmusRf1xVzRi61Y/CyfjJVQLqWV/Tw174VK8
+1TOOrTltJhwWKiEcmtC5sylBWnprz5QX2tqyLXUYMBlMCgOCmNs0OdnatkNGSmSzUSPb1FyrwxHa
+QUbhv8jfCk+x8Z2zM5Kat2te1KTd3PTUpxpgkdJD9MIs3gbh0IzaELqVHiSTYajTySnj/LeZ8WZz
+bFZ2k0R9Qu9e9prNVBK0S9WRBTnaKKp5UpOEFEdyZ5NjmNWCnvYTz0XkJx9En87xz/M2+T0KDpOy
+et0bnfDPu0gjpHe5yNUXPL1feIG3ke92kU9Xkvkrybz5NL6Q8nxSrMz6Rb2lrTRip8dm37q41Woz
+mEumh25eOmlmlwkbdz16rbr/y22nT1evXjJpapt+j5zeSWrJnyP35E1HXeYs7N6pTcdoS7OtpXtf
+bBRqJQcnT+nYLjvXYHHsWHvwJWOwma0xpmv8AxezeC8WTErEh+xqX5CnMvqiPVq8Wwr4cOYf6Bn0
+jC/Qc3T1Rnx8Gvrsihz2CYPdPOeWwr0l++Wgj5bR9nnaN1QHWeyng1jo4h//WbxXKMKoxHsoq17k
+l2lk+wZ4/7XX7C8nygEhPihfkCG9Ucd+h+YtcblDajy+cPCjhV3zx7CgUIocHY+2brF7wadn5RCx
+iP3F+8a8Epi3dL6n5NUDSnToMIZoz1ySAsjYjpbcvbJMay+pjwjXAEvzF0WmdJorR/A2q42K0+I3
+jfCT6zRz8ln8Fyoj/ciDZNXZyQ4m09+OHjp09Mhbb3nlSq7Zm31KRpFNZDMZ9em4R8hnrsGoHeqO
+eqB25C3yBiknbynryFWv8x0gAZLAwaKCesGzMdsuEabITqA7qKgnRIoKHP39JYYardj8M4pCueQ4
++XHlL/lFXyz49KwQOG1Q8cXBg0mkEok7fKIaM4acIzdJNans2Ss/f2/OMQxHjxP+HfQZEzH1k7XH
+ay+pk4RK4CSpac06KiS6V0gKF5hjjlR+MDGiR9Dp7oWenzc8R/rPwL0/4n45+vIXn+49ggOOki/J
+s+T5TeOxiWvl7kFOy3MPA/n5u++RAf/qcnmA7CHnHx0n+2jVSdYfnQ1JiI9Bkhi8fZ3KSRLW7WQU
+g9TtCieOXIp+2ELEvOcWHhyc+/KCT8/iA17xvFnmnWdc+uKDiU+WoW9c7r6uzcP6T2zWdi96VBGN
+tBcnoDXrpwb/XspkgUxS5/Trmb+htw6gwws9n5APXvKbgwmDa3rxzV2u6ufQHmnu1b/2kij1v+S6
+cy8FtTIBu0Ui3v6FRqAua9GG+WQV6cTmXfWl4DfVYpPtvmy+5bONM8B5+aNjWQzjUNK8XdlYQ08u
+40zIbERBiJtDMl4+QDowVvuhLGSizJLv0JMk/QL5AK0/Pd7z6Wvl2EwKXNQBfYiPuVyeNi7PeWyu
+RXPROyRX7hcy7xmQVYd77rZsGyOMdh0jiBJo1vq8zKZvGmI/dvlS7uspVDxTfiZD9yiiSPWcryeE
+CWO/kkaNOaSA6psUBGiFSskrm/2VzQxPGoPzEFM8Z+I0TBSfoyc3o7LFnpmR68+sfLlU7DnheXv8
+FeY90GdXqPYf4/7nct2szHmqw69DC0fk4lY+Z8ziCfFfJo+8OtKgWSO/xr1jbB5iPcBqa0BO/uaR
+GYtar0cblpKm6Q/OzJjbeTrXvn3b3k1aSB1i0oTb2ssXH/QJ4Rq5XDUjZo+IMHyLenbrPHLDxGZt
+UUHB868eqD9HF67TOTo3QZmj88voHB17lNwTnYdoRO5n9jsPgO5R1IjcLyDvg1JZpf2EdBwX5BmK
+hY3vWHeU9GTbbF+lG2zx0uX4isvlObfc/bm0uVbaAzVT1EOId28tQ6G1cN/Q/bRkFN3muMPlcuHJ
+S7jsuR5+iTRWXK+9FBArXAO6W9JKY1OblS7sCXdms5yTgSahLJnObJ0p3BjBmbDNSo/L5APJqrNH
+DX/+ZTx8Fv919rDxr78MR8+SB48O6Rvo0QT2Hfom/j2o95CjR4b2jvm0HGU9jVJf//TT18jpp8mp
+8k9dTz+EwsmfDz+DL6LgdU+jZ9Z5aREz+Q5ggFiwSbTYzA56ZI+J2b3BLErEOOmJnJzJKhHD6clP
+Ox8OPfGRbs1O1AhFPbUm/KMToQ8/Sa5MS23Kk3F8s9RBaLNgS5k+PdUWs2nlpTlfLnnssaXn5nz/
+4KbpJQXnKgcOx/zJviW4pC+jQ8m3QChEUZnwbJ+TURo7423+uTeT3zX/tyeU9Ed78bVz8ujpCTvr
+2S+PmsJg+SIG7cBn0BOf+kbOAfUHzVsHUU7J+YAOGtPRSZDGy0zfyGkTbcqgaVIucBn5kW7EQpHV
+2x6/oYyXNx9//IY0UnKbpe8YJSc3a5YySs6ePV0ZIpVvZT4ttpH368VrNciXFdOCVmPmRdzSXfKn
++7OjR3EbrH5WqCTzSabnDzLU5RLXu2qy0TRUieS1E2mkQJjN27y4DP64sszcXORxH3zOM2LrVlSN
+ftnO28jXZDCJ2+ty8d+5an5C+ei5vRTPZFIgzBSusTODtGZpbs22qEvbOhPtmcLMxfpfyt7Fz3kG
+k4VbcFQfpJ67XzhHHlm4+hXCuTxxKtF1as40Gl+TAr4H34HOlJASAfshY/N5voe0fQ6dI03Jk89g
+nu2p4+3kXbp3jgx2kQKhkG2nk/hMARCozNgJgGZZYrR3C22OHvXAMc/BVUIlS8WyGbd8to8ev8fb
+pDMa6ZGIrWlKHydvJeIW/MhWst4lbEdz5oLMv3hE+BMay3MCRmV4NI0YDNRdcoyNSIT4LTiq9ydP
+X7yCcK9hJz56AmE0Yt6WjaRAJboeHz6A/Eh+8zy+zDVyzK84v+bYTfJTTYi0n7pAeIvvQPHrfFIw
+aGX83nzG+S3oJktmFAzae6iMpjGYIDbTLAZ5a5lr5NSLaAhNYMj7NhW9hfvrrb7ObtFX9YuysgD8
+9KWvo69bdXWrntwtfEpCkFh7SQwUKqGRdMqhSZR3VDvtIs+S8M5sZ6JwxX2xp33CmapNbyd92Ip8
+SghZSbpWRUYJPVavbpK/0zOGHNu1+8cL6Gk0Go2taKSXaEyovcRv8e5xpKhVemWOb2a4zQn8bM/7
+1ka5aN4aaV5/kvxATmw9ydtcjWyj/v3zMzqbR1YUhMTHKE4kok/wQqFSehZDc2tIRHPmrBUq3a8f
+OUJfYsjtQftYLKt9HcFMHjCvOXMqAzR/n0qnh6Oifb8JlZ5KnCSNReIPQmW4yG2U11QeVdmESoj0
+5rGQ3wEDWNqbX3z0KPqCW3EEBx79yZU46jf3ZLahu+Y7brd8rgDK453udi7w7sl/he/gt2/ccKdz
+BRRLen6ORjOazJLPFeAcrs0NHiuAagOl8ZXiRwkq0WDWZjdF/kcjGJRTEWhUF/dex04fbEfzcFvl
+eAQP0KMRPHBUGFw06BAas9R7QoJLOh3BJeVScnAZv1r4Hsx0hNJ5j7AwGei75KRve53mOJPDlOOi
+h1j84EJz6F+Xy0Uau8hql4uebYTL2mPoPrpdu9H0051A+/aoN9nXHn07mvp9B97B/yMIEAAaugYO
+JNdu0FuYsGKQjUnRaDL4LEqZRMmPMsc91KLFQy3x6cyWLTIWps3u9twBgWT1HNilOHh85FPL+i1M
+SV45YM8HH+3vvQh/PQFFjZuwdt3+WYOLJwW+mdxs77PCjFHt25QMb5bcLHnXhkeeTZJjjYAukj0r
+eU6TN7MqCbsT89kFi3HJYjQQ3dyyherOPZyzulzur7mn2AMvZhfRAEI+y02B/HDNu+9Xuhby3V/8
+SlasXo3me7auFuxHj7rwpCOYP4K+O4dKXDUxkl7sAMJzQiVoIFLBxJ7v2hkSpJUyGXbhOfdnf5Lf
+b5CvkPUm1/foUc+xacse317qQn/+ceLD31w1MXgCdr308CN7pZgsYIXkE2S/rUa+R0CIYnUErDhy
+xLOVFJD+nm00dXwEfeZBnxyR/Dl3xN2Ozfpq1vPamj/4mS4l1uNKVJMhyD8nraSej7nE+S7XzRWS
+fFh+ZDibl0BTxDb3SxZsYtesakCsd4f/m+T8AZK5De1BGHWW9/mbD6BPt9EN/nycu5hOR2r+Ypv8
+d7lcgGBs7VmhtfgvnZcjfSg2J6RiR1Yudhrp+oFYbM/MxY6sVGxL5VojE30mHouNsZzQeuCzPz2y
+4dIT/aPzxud/5Zps67d0UJ+JbaIzeo/Ksg/rloLy20dZWvdq1nVHaX/smF+1a0TnDZUb2i2bPy3t
+XO767kUbx7fos+y5/gOffHR1+7ZLlpa2HzTalmHt1q27pe321z4HDDNqzwpdxOvAVvyjrFRsTgjF
+CkUWH6W8MxfJBDpMEnF04Uosx12KaDU6v9+4Fnpt8/ED+45urkejh+++WLrk+xeGhyd3n/nUBF2Y
+Ls6mt/bItaE2wbqo0MYt0mJ1xuGvre2HO449uLJHj+X7Rw96aXmvPste6Lbq+6eLinZ+/2Deg8vn
+j+wYktY5LiElzqDWNm1T0qWxtbEh0JCW0yOzyYg2YYWPf0/tkcq1XPwbdNCrQfp5c0IqzySdy7GJ
+Cy2NMKpoAi4X2zNjMWXDECvwJRkFM9r2LS1Ozxq6vE/n6b2aoULHkEUP7+hZvO/JVSOc3Vcdmjbg
+gW6m/t2bdbLHqINuRmizpr08b/iueR22PNV25YLJfVJx0owXp2Y7pr70wNRnJ2Y1G/3iltEvrRnf
+OTGx09hlTw0ufX3V6Axbfn6PxIH9g6OT4yOzjHnmtlGtpw3IzCiY1X7IY20badLbF01bzfrZjNqz
+qrXidQiHkrp8ZeZiLpWjv1S2XORMFahyKB/GXCSxo+iMpr6VWsZYwaAXDWKqPr1PTtviFpFRzoHz
++tkjTmoGPPBQ1/RRI4cl7Wnx5LxJrzw4sm1cdMtBi18Y12ZI1xaNex4fNfeTTf0Hbv549rwzTw3K
+TUsZ0zmvb1p4ytgXF+Q88siDE/IQX7z82KLWrUs/Xr+8bJTNPn1/TY2lY3a8IbWro6xl52DrtFXb
+dj7To/ezm0uHO7VxqTGt2vTbdeP5dVeeHzRu73dzHzrbL65pp1UfLFn+7f6lVm1cE2fvaflisAhy
+n7GK19ks1d8SjaEcc8O52KH1k4xRlcp5lSpYC5789sHSC88PTepcPGxQokmbGKtbOa/10DYJyV0H
+pycVdEyOSWsdPyVnepGjz/qjUxZWbi9Ez895eUbzLksPjG8+pEfLpo2EMaFNcvo1f2JXTOvhHfnQ
+3H4Z+uisnvYmLZPiQs7oWxfO7jll69Bm2ROfkM/zV/r4QC+9vNTFOf8unoQcymUDlPNsqwDt+UOf
+rVrYefP6RUOcbadsyLfHmnVics/RLVoOyjPZOhRnZbYyd2yeEN28n9M+pk+mGG6KnuDs3yJmwCPv
+Tmm7cuGUvmltxixs3euxGR3QD/PeWd012t5jxNw1C8d0DR9lbNGlwN5vUpvI+IHb5o5eN9D2eFz3
+JSNyhne0BCcXdA0zmc2a/br0dgNbjd462q5Pals8s/Pw1SW5BkPHqc+xeVtq7VnhHfE6aKEpjJDP
+xvB1Oj9j5e2ZubzcCSV3p1woHY+u42qJYpHRIB18Esvh2T07xmQ1MUZYs+IszRPD0U/m9sNzWw7v
+ZAuISGyR72gxoLU1IgCNTukzY82TA5Zc3D3c3LJn0/CCdaOcXec9VTB5x+i0WN301M0lvDo8plHT
+XvqmTYM6rxiTKzwxamBok245uT2TwjTJPT1xpYfmNG8x//21rV1Th3Zq0qzryFlLek489LirX9KI
+fdc2dFs7f0LT7KGLuxUt7mNtP3JWeqt+8buewojD17Qh/XtbJm44IPfTL1Vfi/+Aju7wRFmYdTmE
+DfpwmjrJReGWrHApdMjl7JmxXLhBj0ORZAMo3JGVyrOuHCHdNyekIsy9rskaPaB3cXJQWFpRtz6j
+snXuw3lrLzyz4r3Oif3658cOe2bFmI6JI57/Zu708WklPdPD49NadbQFWLNy4zKScx/68cUd3z3U
+npuxG4W/O2HCu+T33bvIT6dmTvoQRe8/i/KvzOnaxeJM1EZ3XXyC/Hj0H/LshXFP9XkNmT9/8ufn
+x+dEBJpa26eSqjc/Ii9fX736Ouon2bYyTrRits3T7A/nyEGpgjkhlKeqpmfsKL6IF7pw4U26TOt7
+pEWbuAA+qJG1Ze/snP4tTFqeC4qwtmz69oDZPZrquEYtBj9YPmfuW2uHtYri9rd5aPvz+w8NX9Nk
+5atfzbdMW75h9cKhzpbjHnn5g/FtNj62Yd6wyHUlH3/w5uMzY4a8/9buhd26LX7h0Dv+tPWSaKvr
+J+p0vMxcXul3Kq3iLVVm0UyvhS5p/Wat2d531vmXJ5hb9exfYE3VRIaro/PGdO81qoUhwdnRfLVZ
+yZABba04yKBLHDCwT9y5mU+NTG4/9aHuSMDqyW+u6I4bT35p6aDmUa0WHF3epFub7KQ4dY+oNGcn
+e99FA5LTp7+9ftqDXY0vRibn9SxMb9LCrGmUkmtFfRK6zuzda8bA9vFc38c+UeR9IlAQr8NymSeb
+n8ugOWqjweTIRa2RHJ7KNzjphqyRWMFo9g4VXJ2hgvP7ChScQ+evXJsr5gxtYzI179KkWaesmKgY
+srJx1ODhQ8ebYsmisIgw1eTxllYp8YbAHssPnKqaOOzN5x8a30Z8yvrkZ9fm9npy89qWjyT2Lx6S
+MvT1Z1ePap7WZ/KCVW2bDBncLy6276Biq6WouH9c4/xBI9JK3n1iJP/ojIOrS3Iar+2w6NWpo56Z
+1jqyxbCO5zJ7p7Vtm9Y785sHVq164Hxmflrbto2SmiZHff3AvKSuI6Y+0Hzr11uGtYwNDmpkbVW8
+tDB5QLsm0a2GdbgUmRilEaIGuTY+d2Dw8o83j24dFZWam2hrl9G4cXo7S2JucmSfx79VxlShK/Nb
+XerHCtl+XkoSUVZ2nXCBYwXxvIHzpPcZ16LVsI6WZj0mtFk7H/VI6zttybqOD//vlZFZRXNXb+wc
+3z2/ezxa+aBjePcUY/yvsVZDQGqvcTPnOlEucuLGj787uUnz6bunLXx1RvYXT4zcPruv3Th41w+r
+h7+wenwXS0hE47B++/vnDwiOa5FibBWzwpSelBTdcnh+jk1/9ATIa1DPChNVYWCAgfX58Lpb3qGV
+3Sw1f9oV6HxFsgZDYyTqDUz5PC+kdh9ud4zqmdas65icxPY5mYl6NCa59/S1zxT13/HgtF7J9jGb
+R3ZcMauwUUzr9l1sHSLb9x/Tss3S3OisFGuI0RQRiCx98jtFdtuyfv5gB568/NniuMxJe+ZufGO8
+NaxJ52lPTio98eionKhGLYc/8mEp/nnKE6PSYhNj9YkZjfNT+rexNolQ68LUhow+OS0653ZqEZcc
+FRyfV7LkadoHZpKPVI+J12Gw7HPo8ny6HTJX8A6eWqUnKJEAwj5nlB2ei3x2jsJVj5nzCsbOap3R
+tW0bW1lm0YCC9IT8np2z4yMy+7RK7+GISYghC2MjrO0KM5p3tIaYsjt0yIt4pLlr1vheqajd8pNr
+mk8q6RcX26twaErpmS39uN0bvn2pdEz/Jjg0rnlyRfcxeY1XBaQPXP76b5sfc785rln+5HYkPa1X
+ctu2yb1TUUW7yfnN+rxNProy570dD/TLCFjSuM/0R9/+ef3bqNO1NfEt8lNT8luYxn5O3qnjb3vf
+Ml8QbhNMsKmD3RvsSVFEFyUUbzdze6FRG6UPVfO2jsNbdRmZ2zi125DUTz5O6t+uSWbv0fZuj87u
++nGzzkPseSsWTeuf0W7c4pyBj03JxWEbKh/q3HHle4u7zB7aLWapkJDeIjs9bNy6/ubkYZsmzN47
+s/n2yIxumemtzFoxoFFWUdfU9MjYcDXPBxmbtBnctsfErsnBUb1dL3p5ugH9buHJ56d11FBTsS2W
+M4RyYcisjeW80ZJK+WEXugx65uKq+d/vHdtm4vqeKeHRusDGeeN6ZI/onox0WbkdEkztcuw6S7dW
+FhTTdkJ+x+spRT1bhMQ3NQZE2btllLdctHRxq/wX1hV/gYtf/Wdb+/azdw7uN7lTkrarzpqWm1Q0
+t1tcdLvJffiRvEi3ovA4olW3wc7sUf1ahfBoafMBLWJMWbkxiX07pzZCKYZ4Q1BYbHKM4rcrVX+L
+Z2GSxKPK7KjruGOx11H79VuDWXYwmRF1uiuL31X+VoxVf+fMeH58WfvhubFhyb3bmPp2y1SF6kPI
+2pDwYKH1gMFxLfs7ozL7Tmr1+ox9rjxzt1nPfrG8x9oWnRdPLjItzZ3jWtA2Z167Zl3ju84Z2iki
+Z2NB82lji02mwlGTsmac3FaEp004tX9t053dV709Y+iu+V0atR7fZ15E38I+hnbtDH0K+0bM6zsh
+x9hl0UsjHj0+M3WnZe3r52a4/jp/bH0fnTExxTg2t7B5lF4XHJTRb1JOQlyMo2tKSldHTN7DvwEg
+gNqzwu9em1a8lCwGldkbF2r9J8R2OTQ2eGcynPB75sAHHtzUrceWtXOKMoN0jTUhBo0a/X/Y+xu4
+LIv87x9+z8xxPoAoJwqCgoKKiIqCPAgqKioiKioqIiImJioqKikWlpUPaFhWFKRmqKyRsWWt21Kx
+ZWVGpmVlG5Vtttnm7tqua1ZUlMh5v445TxCt9vf7Xdd1X/f9+t83vOYzM8fMMTOfeTq+853jmPPB
+4HFLJ027ffbgvhOWjU9ZNLpnUERCoOgxtH/AwDmlNwQmDO7Rte+QoLib5iXKmFW/u2v+yKCQxKyV
+JZONvv0jA2ye3aP6Pt4wePrIPoOz75rTfN+6/TcOCF1c99CMVZOj/boOjhsbEbNkZtxfjI6BAd37
+9+3VuffU/K2uZ4p1jn6m3HSNJOw/SvX162T4XrO4d52P5OdiFN9uteIarX66UkLDXKPCfbyc6x0c
+9dWwQV16d3c4uvfu7Nc3yKfFv6Ojg0UOLdi/Iv/eWaEDZm/OHjVq+qy8igWRgbFp0SFJcb0iM1aP
+S701K9ovIiVq5+l7xkRk3lZ1ZNWploaj83qMvHG8I9gROSssqzyyU6ivpWVUrGe3Qb17Dezp6+HZ
+PaR/tzu6zV6+rnhVxO+dj01N2/v1Yyk7tt3Ua8CaEcNz/V/1HJG5LCFibubUnl794scPSLlhWEDA
+sPmp/RIi+vqFrXv07dtueG5/6cKEW98Tvo+sfvaR7ZOkDOwhBi5b6WF3jZHhzg8tp6zft46RthX6
+1XnAcOu62w2YuH6uFYJrfoiLdytJ9HC5Rpzxs5yatPurA1N23LUmIzKp+OAK0aN/9w4RE+YMGjN3
+ZKiPpU/kyPHK7vBy4tnJroRMGNSjv82vX0xK7LAFE/oJQZTy6uznYfPu5CmtcWlzBgQPGzLQp0vk
+iIkRs3YUJsmgoj/9Zn7I6LlF902fseOWdK+bOo6cs3rM/Ltm9esxZMyE1F6GzJ44ZmJ8lwlTUzon
+JXVOmTrBN3psmn9I8uwhXXqNyop/fL9f185dvQxLp8Cu07IjPP1CBwV0H9zbd+DC6mLa1v5btE5m
+5c91Gqpdh7pmcmnrQK7abF8jbd1LuGQcVRo4dPrQUTNj/LpGzxyVOCPGPzAg5Y7FxXendPaMmDNt
++rwor66Rk2J7RA2K6uHdrbPnoClL121OStl+58rpg2c89O4dA5YuWxTWe1r6xB4lp8rSEhZsnjRx
+443DVr3e8k3VfuczNxgP3nJwVVxswYE1xc/cPGLYmqeuzM5cFOcITs6ftODOyT23Dllx8LacW1N7
+2PwG9A6YvmBp5Jyq0hVT+g+YunLbvjk3f/rEkk49h/TpNSTYe/T654oXVt06u1unxIXb582vKhoT
+s6RyadvzcYK1ngnX9KFrZX4ft7hzzQq7q3/cYNGnXy/LhPSHPtyaVlm+MTchbNzcZWtH+AXHhvkN
+nbU0JmX+8G4x2bMzBvUcERnUwT+0+6vRY/v5TLh514ybXkkfsSk6Rt4oWu49VTrOETZyTvGUiSum
+xfXxta/wCh81d3RyzrBuSUu3psjpSbOifTt279c9JCosuNOjwWkzZ0dMXJocMjL1RyHud3NosBVZ
+v2OB+1nR76pgc42If3U97d9TuUR661WJvn1L24oGFfxha2S/Yf0COloCYtIThk6PDwwIatnRvWu3
+obNGxKcO7Bwxddn60vHzn39kTeCjKaUb18VPe2r+hq4xYzOHzdp795rpgzJ3nLw5flHWxMDgzLyV
+CasPb5lkfD3v0BPV0zaHjx05emLWsIKDxaMjpi0fXTloxoCxYwbMHFQ5evm0iMg1Rx5Y/dTm3InD
+Avumr6q8aUTuuL633X2qx/CYfh4h82/ZtvM3019s3pUUPHRi//DU+JBRdx69uq4cb21kZFs76tlv
+tD5Yz63j6SRaL8fHtJ8ielnG5z7x97tu++SxBeHjsqOPDupXtHlsQdqA0ZWTCo7vzgmddU/++WHZ
+o3r5RqTE9EpNHjU4UMyU1m3v3Zc6srBy/oBZacO9ReJtkx+8u1t85ki5IXb00KW7bpxx//plkVFz
+lm7KilqWPbz7oMTkib3F7/W4NPUes/S4zPyPulJXi/i0NWUfV6vqr3Is1w1Nwxo1dVHcmLzk3oEj
+bhiXuGLGEDFtwW/Pldz1+aPZi2r+vDZ0+oxpvbuFx3QLigr1fS1wcGKvQWE+jpbjI+bfkjh4VlpS
+QMCo1BkDZ1WsSpIZuY+uHTtoyYF1JX8sigtb+uK4ze/cnzbrsa8e2f7pw+l+fSK7i4O9o4O9O0VM
+S5o5M3la/w4xg3pMjLTmPrw0IaB/XM+gof27DVuwIcXVLnqNYf1ef1Hu0vy65O1Rwq2kMq7XY/n0
+6tJehaXGBnaNmpgVHpwwsFvi7pyUsq1rZ0cPzS66rTj6hhs7jxk9uN/4G+LjlsyIbjkkJm7546oh
+K5ffODnCf+DocMuC7kP7hQwJcfQcljG05XhsQveE7I2/LVj+7IOFaWElUcUnHi5+NG9g7MylcVe8
+LX7lL60c2ME/NHZ8RL+RMQN99brB+aHlLeuXWjfleqTqoyVHyVjXg1XZfPS6SB/VqbS40fqEtvn4
+j1JuaToupqfSam6XKP1WfO7Gir1TH25pObxUCNFz5NxR/rEjxocnJPYN9O85qseWsP5d1NB5dzx4
+09Tw8DEZg/b/ZtC4gX4B/aICQkZG9XxHBEeP6jXkxrSoHhPX35B8d+EEOWrVM9tuHN1jYe1POzwi
+RiSNiPCYXfv0/mSrf9+YPsLDsBg9IspDlu58a1PLzZv+eEtC9LIDRVM3zU+wZIjADCMyLW9E6PCo
+/p0DYhPHD+g/IqK3t3/MqKnRcfNnpPToMmbhXdfo9of+an813GKnq7vGmV1SfRE6bv6ImNxJg/qM
+XzR21A1JIWJR7mN/uWP+49tXTgmPzrpl20OTZu8a3ntCwaS7Pt8/R3o8cOzW2D6L/lC24slbRics
+3ZF409H7psfOufWeHZMWP1G6OLn3qBiRlTglwmdP3JKdC9zr18PW7xlrjqMu1w2IvqOEe+HdNnB0
+v1PXKLf7tn8K9u4kH0pa9dCsgTNSR/kHJKXNGpx4y/wRLW84fMIieiVGBh71C40K7B4d3q33tBnT
+Q9f+uWZR9qOf31Xyt5oFYtqQGSsSx90wIrDPuLwxcYumRll2pGxYMCwgfGjPnnH9A+KX7MwVkZN6
+DIru0H9a8syZyen9vbyDo3u3zOoe2cc3/eFPtz/y1WOz0u5/Z/OMh15cGhZX9MeSdQeWDBq79tE2
+fYMtEV82g7j6MNIyi+jb2ruMXxCAWld0/a7OfVqHaNZNP/fLWVrBKONj3F3Ur6+/xc8iH+mcNH5U
+5069e/r6BgV38B03IclHRrY87hk5OrVXRNaEiMS8jXdvGz5q+7Y75g+bdtuuiUN6DwryCh83Z8jw
+RWkRwdEjg3sMHRAoRt4+OmVZamjoxMK00WWTfbparIPzh87aPnhq5YjAuEF9PJ61Dhzct+fAIV3E
+9KiWWWKvsdPHr4Py7tbHzzc00NvWuad/S91XkVOSYrv2GJc+f9jsu5dNiuzqFzHuhuJJ6SX5af4F
+AbHj542ZuGh0UM9ROaNTV85OCek5fNSYsOihA8bPjojNSe43bujIwpjuQ3z79s3Oempcst0nwBEe
+Gz0qulfKmPjOAe++PDlTf/NkrilOWr/HlxRztF/bK/wsrf2lVZxsW2T0EK5vdv3MT/8tckqPpKVT
+Ji0eFRg0cuGEuLz0KHVn3hOf377jH3vSu3Tr3qM8ZHxB2rhVUwf2mTXY+Nd3yfPHDXSMvLN42bTB
+4pmUoozI9E01c7b+Lr9/33ELEp997NKetBnlJ28z4vr4det+cmnGqqTuowsfyWkKHOBZd8r/35be
+0cODLMGJczcedP+GvPNVm5/1W8rbnoFCmmcPtPUOGedvyjBtzFqf/u3VU60y8FXdyPXbXOY0ZnFV
+T1i/Lj2VzW9E3l1pBftvy+k3dGX+gvC599wQ2Sls7JLyhWNXZwx5PigirnvvyB4dO/RMGNShd/fb
+o7dUv7aq48D4pJTUPp37BfvOnVM7IG14bxk5+9Ypcw7/dlu/8HnZGUlhXl17d/Xt18u3R9TDIxNH
+zVsaMW7t7OgpO/7yYOdevcMGx/fuGRPqN23WyMzitN4if/TSyQNu/UREPe/ZKyK2h318YfmssMyM
+tOF9hmQUjvboERPmLwalzomIWzQ10moZOO/OOaN6jCu9Y+X0SJ8e/QNGTer+Ub+5N+SEj1w6ddAN
+Bxt3egWFRo6eHhc8OjrEo3OQb89RXffMuGuUKXf3m3lnVvrWNQsHjdrz6J5bJ3UKie07b6ZN2CPn
+LL7jqhwywSbJ+2XdhKvqjT42H3/XEt2lfDG9bWM1zt8UWIyewlXVbQ+OCbkHPr295O9PLEiYe8vo
+FC+Hp9F/0pL1OYk9/fpEDB7os2WjzdsjYERMn77xSYEBt90ZMDZxQP8Zt01fvmdJzNPTM/8sQp5M
+iZ6eNyR26YzYgWlLRoxYmREjXr/rpZvjBq2ovSsxd1KM90zvngNGDp5/R1pI78nrZk/MnzCgqz1T
+dBop7EFDB0UP72GbKfynd+g1PDKsv69FKv8RS6Y7Bo3tNmxecuiIqH6Ojr0ixwyKHDM4pJN/8tw1
+bTpGa39rMx25pW1d20OMUpZruPm1PkR83Lsc+rqPa+fHveZVV5+p7d3xPS3y5KBhfQb625NmRHZW
+84KixvQNGxMVGDRkTN++Y6OClh++8kDm7x69f/nIxKKDNz1bM3jZ0xtCEiZlZA+cePvcGGvgsJhO
+kUMGelbHDe9uWAOHx3SMHBLhWTV8RHfpPzRrTOrYO1fPH9/P8sbkCVHZmzPS/QYnZR8YMHl0rJ/P
+oKGj+/aOHxzm4xeXkj189p0z+3cbNu/uP64Tde+L0GenH3pielH2pH4tk5buWj6ub8e+w5J7Dp48
+NMTw7BPayyZuuU126TWoR1DcwB6GR68+PW1iVp7oPXRcryX35c+IGOkYOXPRyjV67t+gn71+jL+6
+L2S035i29VQxfuYMr/QyTqsDOrs3idQ5vxFLpsdMGd6vm2dCelaviBumxog8/0FjMhaNGDhr+pTQ
+m2vuXDJ06MJb96/tMzUjM2Le/QtjIqbftG3vrNn77i5Mj1C7TgmfqqSgMcv3fHB39Y+/zcx5+sfK
+0Xffd88dK8J9OnvI70TgkPGDBo2P7HZJenT2SXru7fdznhDGqxun9e6TvulIi/OqDtIWpKXdaC04
+XRXPLVffW4iPcbFp6wyxQ39h60U+taAiP9Ezct1tq/ourL5lrFfXnp17iL4JQ6yBw2NT71yV2//7
+nnGps+ZHrSifE7bkqS9um7ardM3MyKPPRvkFdDKCR+eOSc4bGxI1fUVilZEwc8Pu1OARkT38hy+a
+0je2f6/OPfIDY0I79w3uMmDy0tH3fPCbNekxPuFLbr07rerPW4YHDJmyvHTWnhPR6zuOm5HVe1xR
+ZnR07t1Zs/bdOnFXe13r0l8a+12v1eAom3L3YNdRDm1PZ/OnIfWk69ZIxLt3OE0l7Pz9H968/eJT
+N/aavnXxCB+/DoYcnL5sRMyskX26RGen+A4fEest3hw9J75blz5DekauGzN2S1J8zriwUQVlM8Tj
+LTm9hw3wDwsJGDVhav/I+fNvGJSYl9r/gbKpK8eHiAdXPr4qIX75nsUJC2anh93Soe+g6G6+A32m
+LEzw652ydEJB2exwwzOoZ3ebGDxe9E2aExeVFObw79TJ3y8ma+zc4vFBexxhIweNiuzg272To2eA
+t+iRfNOs4j8mBo3NqwCUrpuXrN/jTQ+9w9leVdtO2yX69fExv1wyKyO0b1xYaLsHj0+MebBFvz5+
+pq22FlUvieo1OmdE6NjonnLuvLjssX2jljxaJON3j2z5quXHbX/Zlyk2iIo3Wz46On/eo2c2lL21
+MTGh46CoAR2qY+I7DY4a4CnnqZDEeUlj5gzr5tM7po/x6agkv9issZNuTOyurtTFtXz98otDFtw3
+79Rp63syZsneFfPuzx0SnrV9YcuX1i6+PpaWv458z9q1axeLS0b7yHZAyw/t13StGkihCbVuI5jv
+0rl2Evxc+lnd4+PdleG+taeKsSZ1CJ86rte0cYMGjJ05ICIlqltz7aYvDy2ZdPcbd/jEj5vYR3gH
+jRw5MmjHe5sTZdo9J+4cvnb4yI0n7hFlAcE+NtFn5PRBA8ZFdhODpiwZZvjf/WHFlJ7JhTOuvDZ8
+Zly3ocWvXvlm6v4fD74kuj6f7Qjs1zV/fufegY7lR1oaq37vrJ7apfP63I1TQx4JjBrbz/CcXjgh
+JHHbZ/u3H103VLfnppZk6+/12bx9mdN6AqUyD510nUBpnhKjzzSJN0+GMY/qdZ0J4z7TRJ9Wo6z6
+zEOfXj76vMMo9zG+rmPOLNs7BPYZPSoptOvAgQO7jl4/Wo3zuXKf/aYeeYYRMyyxeMCMAZ03bxbW
+5NLxKiAyoNZquT3oWRmXe0NuXMuFrN9neXboeshi6RgcHNyxa+Ec8bgKi+gzzqpP/1tU/1r9Io8u
+Ho6pcq8I39oS3qWPz2xl9+j8ptj6lnh17ugr/+7g28Fq+71vdM8rr1X42jt26mRrGS3+1SnYW97n
+6Nd15P0V9yfeeEuXpLbvNS2G1YtQ/U1Mv942q69/1xj3r23ED40Pa/0G0S8qfmi8zWqLoqvrvUA1
+sur2vh27pc1xvettnsVQlTh1+/DAHTf+681P0sYNmjzqyuc5n5svfVscKtpnVJcR5k9xtHxg/izH
+Qwl9AucPjHlgwmBhtQY0BKrLza53v/X5QcZFtd1aSjemPefVyddupbuj4eSwhpPDDnWYnJH1Ip1I
+wuo8kpAwZ8hz/r54+qjuOBoSG4YNOxnFIa/JGYc8JmfMzXqJ7s4jCLrTnaQEn/iEOV1af6/UPAWl
+7ddLzW8pi2YnJs7uF5M1Oz7MdF158MEfLN8nZmYOHz6wk/eA4cMzMxM3lN7lkpN2Gy1qsbVOvwcc
+5vrs3Gb+dqzp9LV1jZZ7Rk0rSFs2t6X+rWkFaSMTjliO9V8/ZcrQzTfO+O19Q6dMuT083PUNN1id
+Vl/iKEhy+MZ0UQMDhcMa18Wzg23MUEdjYn39uebEc46LSZ36xoQOGTgoItIRGxcaREOiGVB/znFx
+CElBkR5if2Bol33h/tv6dA7x3hAVK5V1f4Rn4OMxlsjEi9HRF/2jWxKPRbcknot2XIyOjmr3uzUx
+7i8w/VxvM/cQvX52wc8dW11pGfvOleE79u7965mM/VML7ZNS0lPFy+09Xi2NZgz1dPNb+nCJLb9/
+vkuXc1PT1mzc1s5pvo+5rpW//v2v3hQkxfYONmxBdowAx367sS14Q9fOnZTn/u72x329Ok7y7pJq
+EzF0iPN2eKT4xfnYHN0C/cf0MevoQvS5xgvnGi44zjnODcEkbPK96LgY7aj3GTbMp/OwqKQOhAaH
+RobeH3oo1CLmiTZu176p66oP/d228UJhh+KlFffIt3Zd2bKxZaf5kz5794ruwm/BRzcbjZnZ9z2c
+2TzDJOmmmvbO+wHdWjnt1b8HtiAp0psO3Tvvd3TYrzYE+Hooy/4gx+NdbT5eyj7J0y/V2xrn6TBS
+/OO6eDsCuzGm51U6Jplhw4Zdy6fzsGFRSR4EBwdHBh8KNsS8LrGh/ds+ijAJXX0buE9czKciVESL
+900CWfdu+OYbFwdjzQcnf1q0bp1l4PZHMte5S48gCexHreZp5et/1hcT2/fFjn1jQuMHRsQ5hl/t
+iuccF5+PihvkiI6LiXOcOdd8bghJwXHX9cuhw+XgaIurZw4ZZolM/F/om6G9rrlwbfupKy3j3r4y
+fOfevX/9ZJbZKSePT08VL7lb7ecN+kv9VLfjV9e1r7td7YetvgxlbZIjMmZwz4FDezh6xQ3uG9Z7
+THz7+vHs3HWgj6+jWxyBDYnmtfrnbHEWh2dcB3fFhHTcb2wI8Q3qs3/o4H3R2wYO2GD19OvW09Jr
+f+e+Qx/3CAi5WjPHWs6ZteJovhAl4n69sf9DnanLv9ALWpJ/Vk1Gv7Sf94ufV49r/hvj/ML6vDWY
+GNfvorZlHT/UYv5aVZT+hqX9O+JxOtz8hMp8XV6OPXll+K69ewvvEL5NaYUHRFjLn/XZCxX2vUf+
+XLl7t0fvvlmrhqQb/VxNsG7L7+cu6eDp5RPTsmKd++SFjeYpDOvOTe8dNjznBvc35rbDlmYy2/9+
+Uj/zB4j6ueZ5/7B41xEIPUUP8csFdH0TYP7k1i99j+7+GL1vn2UPHpg46dJPg4d0k6JL1V0HUyaM
+E+EtpzWFHZ6VR85U7t6dPn34ksFBjus/V/9LsOivv1bv0zuw74C0tE1P/dbwzj34m/79B+YWll5L
+rnTenYtuyRoU2KGl8ZrP2XtqGSba2dv+nO0ACm/CwMPQdFwUlE+MeyFqtfnExA01303v09tqFBlj
+L798+WVV8gsHYQlLyzfNS8/aDpgxjLFXRv2nE7Gcztbfy3NYCW09/8o6Q/v70u4bRYeVsLbw3Tq8
+X6vfckj7w9v85drfv/V+a4y+f0BruOubRgZee94WEabfuawlU92m/YOuiz+4Lf012h/pSh/srvhR
+2p8N1hbtH9KWf5XOP7rt/vd0eIwrvOV+d3hsG79ndHjcteeFMVT7/V2/PeewEq/9Kc4vrG9rf4Iu
+f2Nb/Q3TfvN393y1f7iO7wlWF58RreGWE9qfqMPTwPyNSIeVka3fhFowzycjWfsjnV9YUsxvQBnf
+Gm5+M+qwktIWP1+HT2gLf0GHp7Z+Q6o+0+ETXeEtwyz5OnxSa7j5LHdYmeziB/Ij7U/T5c1vGSZH
+av+U1nDXN6lMvS7+tFa/PVWnn94Wf7/2T28rr6H9M1z8WjItKdo/012+THf5MrR/BJjfbjmszLr2
+/DazJ5i/z+/8QtVYbnJYmd1WX/eY57ehz3NzfgLGx9o/p7X+bV7an63jA8b72j9Xh/+tZZjxkfbn
+6PBUMH+D02FlHteMH9Xh2vGjvK4dD6qj9ieC5aL2d7p2/Cjva9tfOa7t/8rn2v6sOl87flSX6+L7
+Xlc+v2vHi+p67XhR/tp/E1j6aH/Adfl1a0s/Wod3v3Y8qcDr4s/X/plg6anDg64dP6rHtf1f9Wwt
+n3Wf9gdfO15UiPZ3AtsY7e+l/TlgfU3n11v7+7emb3/e3b9qLT8Y4x1W5Zp/NrZkGp9ZBzisyjX/
+RLdMt2SY7akirvZHa7DDqlzzz0LnP4xVRorDqlzzT0rLLGOl/gY70u3PtHia/U9Fue//1GJYIxxW
+5Zp/UpyH1L06/eir40X7XfPPRLBMsPzLYVWu+WdYS6Zlhw6Pa4ufqP1Dr8s//trxoxLa4rvuH9bK
+14Iu/3D3eJplrNTxR7Tdv17HT2wtr/GT9o90+6+48x/lau+WTOsZI9dhVaO1v1vLMEtXnV6Sez7N
+tA3W8cdo/3Tnj7aJ2j/W1b+d/1AtlhSH1f6Htvlpg74/153fp9Zi6yCHVbnmo1HOEuOKscdhVXNb
+5wNblOY/1c1vqptfjvv+H2036vymXTf+9HzhHNuSrk7p/uKaL3KdX1iGmH5c4+cnMF7Xfj1+2GbO
+F9rvGj/3O7+wdNJ+1/jJAMtI7XeNnxrnF8b3Zn64xs9G5xeWPjrcNX72g/EPHe4aP8vawl3jZ5Xz
+C4tD+4MAcSXbuNjynHUxSn+z2efKPuFfV21d3FKKbL7X+NK5xtqJDvizPsnLz8NbBXSe72WdT4Cj
+obnhwhBzMX0yOorJhzwzJh/qpJfSASQhCCDAeUQvpZl8qEPG5ENeGXMnH+riiqEX2wFmTB1DTD7k
+yJh8yOc/xGi3AO/bzt18b1biiMxZiYlZ4pXZiYlZWYmJsy23Jc6enZiYmZnotmnl6exn+R5FwrMS
+QyjD0VBvEqiPSurojbcIJlhEEims8zBLm/UShi6FgdFail6iT4tD+Ld8VG35vqVJp9u827jgXGz5
+iRDOJ/UO7haAEeTn8PawG16GCPLrbPh5BRm9unnYA4K9fYLp5Wg42XDSZ5hes2l3Z3Pl6dPZX5uo
+pJwOqoPRweJh7WDrYO/g0cGzQ4cOXh06dujk7e3t8Pbx7uwR4NHNo3sXb+VteFs6WlsvewXYu3l3
+s3f37u4d6B3k3SNYBRvBlmDvYEewT3Dn4MDgoOAe3eeJee1bo/PVCu+lqfai19Um6Zzxq4EuOVmL
+vOapUiLG1RqRwr2YaN5dkDcy3cNIDQrt3H/IyuWLeo+LGxYY06X/EOOi121b+hmyV0eP6AjD+9bN
+nQODOnaKGWjouvzYuNhywN1G4hfaSAUTrCKJVP+hjbr0EVcuPVfd8pHZRMKu073LuOhcZ/keK5OS
+PAyLxGZIq83RUK8boT56WFRSF7MLeBtmJwg2zG4QaXi0ZWLTmdiwuTN5xmKIeaJXlz79bH2uLBax
+n1V/8IHuEr/5zXX5pSd5KAxhUxbRll9i/bCopG7ewluaTRgsgqXZVJEiUkYakRavX8/VU4l5QsX4
+x8eoK4t1np8ZF4V9//7WvnjQuOjMsHyPF6g+5q/h2rQasuvB27quqrnJ97agJ+8tqzEuxg8fMXTz
+lk3X3tORpCTPDoaNTja7oJPum7q0DSej3J3G0+wvHiZ46X7RSRewE53cBRTuM9BcS6VmM9eMjNZs
+Ld+3ZiuurDC+bCnX88otz3kocz7xcrhmk5PXziVeei7xwutX5xIvXQYvM2Zrx+2UMfmQtxnDYYLv
+LybUfkK5smK2a6q4OnUgr9iNi/TSfdEG5hk7pvleD/4dbVOAeUQ08vJnxsWWpta4Zg/s1aWPaP7e
+7IVy4dW+2KL7o37GrpLfGP0cYXwLDqt82Nr2m9HyI33Gb+t5KX3aDlLrI0ebZ6bsNL9C37XL/HR7
+8TqRua5liPub5cPGDjnC6ksHfVJTG7nDhdOnrVo1bXqhJWP6qlXTp61aiXT+tiVB9dTntfUGi/5Z
+9rjY+Jhof51XV1Mp0DdsoOht/nKPeRK3nB00fs0dLdUt9sEDd4ofa1d6RbVUdPbs+MxNHmE9ezRO
+jc74/HWl1Kl1l2curZs2+UF5+wwVFFSmufq0DJOftnG1yU/V7e46mCw/anf9lLrP/Z3/MFNepHfb
+d8it3/k7bO0O8Ysf2u+6D/5n3fhn9/l1C8NCxDVf/v9U3fKXqbP1qX5xg79tPQDA9WNNZaLMbLVE
+MUp09bX2vmX/LTdXr31srbj5sbVmOcVE6xgj0j7J0a/jLcZhkeWwcROH5Sh3WLoRab/J0c+8JnDY
+zDhyoQ7baB2jfrBPcoXJUQ5bxzvN+9ulufZqmh2LjfPyzl8Ju9XY5A7baM0xvHWYju8qi9DrADHX
+mqN+0GE6/jVhWdbRRug1ZVlnvCz0+keMs44x4q7Jb71xuK0so138XLyuu+9GI86+xdHPjC+KXPzk
+LW353a/T1PFNfpZ8d5rjrAstE+2rTA7W3nKdw9bxNuOwWNsaZvygw261Tv+FsDj7fa40ZcU1YVnW
+UUboNWF3GIeFXg+KfOsow9G+zn4x7Faj8pfCPPo7+rmuOWzyOeMFmdjGYaX9345+rjKYYdaVEjOs
+Oc9yg/Mma40jrGPxpEKHTda2NJGNYoFrHUwfBjMcOjtCe0X79/Lr4zqO1DzsMybEx9foY24PxGvT
+T7j1TObPm7sPA42L7dX7NhEkvB9YIeovzGo51/J19uTeb8gHW4Yeb7nlcN3cJ+L6y+S0nHmTJ78w
+uSXFvuCPx37T8vDjErH+eI9188pfPt6hf+/Uljt2z/3N1JUZU9ctntk1SPTce8ste/esXXultHef
++JKpI2bocej8wuq0fEAw9G07jFb0cY9F0csv3m+gcB1WKy+6j6O1HdTn1H4k7hXiFX1QrXlGZ8U7
+sldzL/1L/kfUH4VY15z97dneZh53g+WPrjNoXCpLD/cR8ZY//vTebfLHK2fEfVf23GaJvJIvDpTJ
+XPHhayLtir/7XK4PzXUJdnpC39bDhfxbTwM3ZwtbT6EPLw2TB11HCt3UevKQuRNVdeN9fYvXxfR4
++V8ZrYcJ3dR2DLg+cGhHTp/u/f66xbX/VQYWjH4orGZpRa8uHsLC5TLxhAjq2PJtS6OphZRVV3Lb
+/UZ9B3wJbj2nwTWbqdaSGldLKtcuX16wfHmcq5Di7ivvXC2lOLdMjFq2rOXospbCXymm63yopWAM
+NPrp38UPBOFW9wr3AbyW9mV4Zl3zqHXy2JXkderpdS1Tli9/YvlyEbWsZbHYvUy8sUzsbln829Zs
+QZhrbGOc0c9sJ9dhE66Giuul5rS8JJ5f1hwkvmyJV6eWtTwlT7U8uKwlVvaTfLDMXS7LAX0uRw/6
+uH4DwC/acBh9XAcrxfUOdYTGxPXp4mNWivAxq0e8Ih/Y+boIb/nzG+ZR5a+3nBZhb+zcOSE5WTwx
+ITnZ6Lcu+8mWD57MXrcu+0kx8MnsK8Xik6yWD7LEJy19s8RA12/smHqBHvp8R/r2iotxffcWG+Z6
+sdhPXzG33YmJxt/XqvYI67oWeiy4/c3b5/dsYZ2w/u6UWHa5SSyX7/1m+jdvdzm554/vvfvib44E
+vv3N9KR/3ve58Gxp+uI+85w0KQyQd+rfGPAhLKmLt1rtcZtXx05WLxxGJ5DWzo6GC40XfExRfwiR
+jc0no0S7XyJQvcyjpOWdhVOnrVw1dWqhbNm29h7LkKkrV06dVnjTTxvVpJdfNvMhx/mFMVDn05v4
+pB69egb4dVWrPXpu8/Iu9Litj1+Qv1XSK8grMKBzH0fDBdf/EBznGusvOFo++PAdR/01GXdp94MT
+Pu53yM0fnchuLcl7V39q4p7NtxavuMWz+Gq5alp/ZaLlg4F/fPiBB0s2aX2Jq4ydbV7mNOI6N2qI
+84I11OhHP/121EAR16WX60wdfcDO0JhefspUnrc/+NrYWX3b4D+3OPfkmFJPw0U19Icjj7zc8puR
+D4wsNuKzktOEV2nOLWLCU4+2BK5bJ7699Na6lnscT86sfLR7z78l5+e75CmX7pRuTE0K9bJ26YZH
+V5+OhuJmb7mma2F3FW7pFO7V2RHeWYRb+jC4u8PcITzXrHcIHecaEy/4Rzve0au1qCSP0YH7An8X
++EqgKfv/ytmb+AjjZXn4NvO4zdvE6+7jNls+tOT80iGbIkzM0mM3C2yhlg/xI8gcIzFXBb/QtvPu
+evWL6WqeeNc3rF/YQOGz4sgHHxxRiAcanuu2+U6PNxtaVr1yz5cFi61XauVE7+yCL+9ZJ9O3Sc91
+4kh1y9ltLb+rO7JO7LhXDN7WdPtDV753nW33nUuXq0/Zi3TLnPokxdYj7lwnQbvkT/N4O1Puim/d
+n7EZ7+1ssQ16uPDzTas7LVjQafWmzwsfHrRL/LRz5xVvS79BK5ZGRQoRGbV0ZUSYdd26u2ZO31Hy
+53VnNj80faZoLm5OXFebljVzeu262ukzs9JqQeDr/ML6iuWS/j0gcykpfXz9evm5Mh4arwtiHoXt
+Kl5YnHnWnOHvY+046E+vnP9Y9Gj5l9/k/DMZZSNqZfealt//rmXjmUcHfPquEM+LDVVi6B6DY79v
+eb3lqS5dtr2zem9YmNhdfOW1qWnv7r64rUsXsVTsTRrT8oCuF/ezOpwh5rNatdWF+1Ac1+s7V8+T
+Fu5K+3kVdWitFvO4oZ0tr7Z8uuzPa27yvmdj9V3uihN3X19V4iNXJd21rmX/upb4s9/16tWydPGe
+N9bpCry20gQgb6i75/d7xsz3TvyOYDvm39moytmt9o/pzV90+sCjPwI7Ut+BibbNLT3AO+bH9ObC
+Th+4r7f9dXzGeBt9VId8AuQJUJuFYQzkNWst91ge4DnbbobYhjFDruE1dYJX1QnyjUgGWWv5WJ4g
+UM1glTzBAXkBLA+Qa3mAnywPsM3yAPdbHiDD8gA1lgfYaHmA/ZYHWGZ5gFWykcOykTgzjVZjHGKL
+He6yxjkvWV8j13oDmdZL5Fq7kWt5mlzrB2Ta7idXvUyuOuFcZm0k11JFrv0TMm3pZFubyLQa5Gr7
+ALmWN7jL0oi/9SdSrHHORnuz86I1Dk/Lc86Llo9IUycEZpnVCR6w1vKuARhwryWSDMsuIo3PtJ1h
+8SdD3cRo7X6P0cqTFPk3Z77lHlJMt/0WUizvkWLJdN1nxjPiGWFsJEs9wxDLe0QaB5yfWL9wXjRe
+B+OA82/GPaRa+nJQfinslr684K5LXfeWB6iyPEC5xZ80ywP0M+MYkYw2y2ZHBKq/MdICe817zLo3
+rxnwugHP6GsbyVQbyTYQ2ZZPGGbxp9xsHwNhVRu530Df/6o9i/vdJts4RI6u918w9hTnq7oturna
+otWol6811hay2trhelMr7NYmknVbtDNmW1g7kWv1JUXX+y8Y29tk6Lbwv9YoTzqb9a888TLduv5b
+2+E6Y9aLJZMYsy3aG7MtdFsfcP7N5Grm9zP7BjKNz/6DfYlcs81N/to2+6v/f8P+gEzLrl+3zb5u
+ec/5lstmtK5nT4Ldts1tR7rtlFbbto1cqyTFHCNmP9Xj5B5StKlyxflV+2kcyhe72Y5mXV5v2324
+Qbfbe6SY7arr9jrb4yYy7cPIMMegOQ6ut81xaY6NX7Ub8dfj5jrbnW/kf9fW4/0L50Wzj+l2do97
+Pfaus93ju7/1Naen9UPdnpHWD8GyC3Tfb23rFrKsl67G0by7ueYcy+vmPOVMVzc5R5ptaB1AH3uW
+u91eZlJrO+h6rmSiOW/Y0p2TWuvS2sQsSyazPHYzy7adWVbD6anLcYCctrqpYaY84FzvrpfQ1vqx
+/kSa5T1ibMHk2ILZr7k+6fxbG88xTLCM4Ta3yTfnZ6udXOMexhuTGGx5gUiZw1F1iaPqIJ1Nt9cb
+5Ha4jVzPPmR4LCXXvoVcy1ekdOhGtmcc2fbnSGkdI+6+17GtL/w320iPg+vGmznfmGPeNsAcB87v
+2saFrj/nu9f3N5ObcQ8TlKczv32ZW+/zeJ1caxq5rX34+nzMecmcG3427q8br+pllPJ07nNz7fez
+/n99f7+e6/X9eyvbjDMsco/z98x41plkWPYxw/g3i1vb7mfl+ZVx12q39ndjBym2BaQYJQwx5wF7
+Ehn2JGZY45znrDc4m4zPnE2WKiZZGp2vm+XS/SSVXGMBudbXmGz9iRFm3m38TziXqQBnvvWEuz0O
+MEmP59bxFel+Xu66+vw0PiNN+z+6Om/bxrLS+JSFxh3anWF5ngwz3bZ7A8kw3qGbxUKGe57W8417
+rp+k7fGMNXow1nRb5+lncKTbpFjecy7V9/yBDWZ92G9ng+0+ZlpzyTAeZESbiXcZtYcU9TdSLPe5
+jFkX8oAzX93kzDdmkKHH2yVSWp/lllVkWO4lxdKLFNsYUix+pFhOscGsP7cx+2O+kU9Gm8nhHd0+
+95Ni8rVsJsPyCSmqs/O3lgtkGJfYYNnljptPhv0rMjxWuWzbBoZY6hhie4Ah9nRGW79zcbakka3u
+Ids475xpeck504gg2wgjW24iW01zm9NkG/FkWyaTrT4nWy6jyhhIlTzBvW3mIhe12eS610B0cBme
+cZu7TCPH8ppLzhDZpizS6peNfKaNOx3Vh1WmMeAJA7HCgC8MxEgDdhvwuZoNyopDZpKtwslWb7fm
+y+u/YJ4xVnCvuwzPuNJiNzRvhytzoHkrND8MzfXQDDT/Fq4UuMNHQPNfofmsK96VKmg+B829XPF0
+3LvhygqXcc6A5nvhSjZcuRGuJEPLEnB6w5XVcMUOV9RVu3kRNN/lulfbXeDKKLhihSsj4coT0Pw6
+NO9yhWvzhNtUuMNwmZ86w5Uk+Gk1/PQ+/JgOV8rdcT53pXGlE1xZ5opv5mn+OQU03w/NhdYR5BqV
+pFgL8LfGM9Zsa9PYasm2/J0UA7HXPSa1MeVvc0y3tqc51k050VbuPGv/xnnWMtl51hbgPGurcp61
+TXKeVZ/h0yqba3k8jkQ9N3xEmjmHmf1by4GNZpjzUqtcbvkNN5lhtvvINeVz8xlmzoFa9jOfe3F4
+2qbSyXx2mffr6+FkmHOEHvefkWKON/OaJZ2NllSiLZmucOOlq7K3jud0PVu13HsvKTo80zXWLDPY
+qOXIv5NhudGVpm0kuZZxdLOVkmnby3T1Gd0t97rvMcP3Ml0/d8z59U1SrEGMtB1xntV2KRm2vaRY
+RjKqbQ52ywlm/RgJzrFGHcstE3jFegO3WTLJMeO2lkPLZk2u+MZnzDblYP28v4FMs+6sf+UBy93M
+apU/2svgtqHO/Zat5FrnkWk+V9rk8BPOZR57GOQZQK6t1rnfNoFc3TZ3EK3tEnxN2UW34VZybT1c
+6Xneia8pv9judxvzvi4M0m3obtvW9jPLoWUOM82d7vZ7kVzbVEZ79iXTM4tM2+N4eewh17rbVUZP
+8/nbicH6GZHJeHMeNP5BimUWKeYzwe5LN0sgKZav3OYNUizVhFq/JcXyibs9TLv1ufIdYy3BdLPF
+M9NcU1m+dMWzP0yKvYYUax962i3Msqxkllm39lgKLF8S3bZ+OEJPM3+dlrlOcz1bOpttYvsHofae
+ul+FW95wlyeYbmb6tg70tFTh61FGru1ZCsx6M8vesQO5ngsosL9OgP003XR/SCDDNhNfyxW6tdrW
+enpaFtLNsptc42tSLFvJNP5BovVrci0vktKanpYlA0mxlZNraSTFNoFJljcYbc/Ay7qHnmZ9m5w9
+ZpNrO0CK51Fy7cWktPW/Rvx1HbWTfU3behFPyxCwD8LfupZ02wpGm+nYUhndusZoLUOrvKrlE5P7
+VEabtn7mluCrZRK33SZLtZdR2tnX82qV2XTaX7nz+OrX1xRmH9Jj5A6izba43m4tm9mnzXY1+55u
+f1d+M8y+bPYnj2YG2coItd5PpiWYDPsw50Xrx86LtrtJsT5Oii2MbNt4wq3lpFgfYIflR9d1D+EK
+sxaQZ3mDPI9N5Nm6UWU9TLjlb853rOfZYZtDuPVdYmymjPkEebZpznrrKzxhbSLFeg877CmEW1/n
+SdtzmmeKbRrh1lvIs95MivX3+FqeJsVWTbatl3OZHm/Pki7XclEGESizMN9bM+RDdFcleBqQbS8k
+3HI/2dahFFuXkmdpINpyiWLjTbItfyHblkK2NZRiy5skqiiy7c+QYrmfYsurRFv+RralmGLLefIs
+VVRZ3iRcfubMtLzKBtXk/Id5XRXQ176OcMsc1lm+Jc+MYxr7DG2nWGopVt+Qp/2fkW3ZzkrbY4y0
+Pkm0rOMD1Z1sywOEWjuw1raetdaLrLW2sNaaxFr7PtbaezDLo5a7LX2ZZb2HtfalrLXnU2Gdz1oP
+X+62HWGWLZ5Z9rOstWay1urFWvsj3G17l7X2UmZZzTSfYK31Qe62PslaWzIh9j1UWPux1lrO3dYN
+rLV/wVr7ZdZal7HW1oe11uWstXVgrX0jFcarrLTASktf0m3dWGn8ixTjC9ItmfS0TWelcZ6Vxkmi
+7d+z0tJTm+02OyuNK6y0dGGlzcJKy0znBetI+lgV4dZvWWkJYqVtMiuNH0m3DMbXvMfyASstvVhp
+6UC2xYNsyzznBauNaItgpWWMuwyClUYLKy3zWGnbwkrLTay0dGWTZQErDScrjVr2yAb6aJmzNym2
+LXTVc6g51heTYhrjK0YYnZ1vyfud7xu309Ocu4xL9NZz6N9dc6jxGXdarGRok0ms7Us8jRMktupJ
+jN+TYs1njWn0/DGYSMs0NtsWUmgZxdY2PZZLzs0wnmWydRmJ5n1m2cwxqNP4kMnmeFNfOr+3DHWe
+NsaSaIwl0riBRPWRyxhTGGq8RqKcS6K80bnfWECiuodESwVDjUcYZvyZYcZChqq7iVVvkmhEkage
+ZZY6QidjERHqBTK1aSbTMpZMdcXlVve5zWS3aSZTLibTiCLT0kCmOkGmeolMtdxtv+S6V/5AptGf
+TJXFTB3vIpmGJ2Eqi+lqJiEqhzjjfhJVOg7xTyJM+VrHv0hmW5xsprfGsWcQaQl2fm5ybF27aJ2B
+WaernGeUJ1I1u8uxzF0Os7w+ZMrLrnIbg1xll0Vu2zTfkym/JlM+QKYqJNNS5OJl1JJpPEOmesOV
+ljGYTBVDpurWjtvbZKpx9FRfusudRqYR77LVXtd90HwMmoOu2pfPmqZtHfqaW0653r5eH/Zf2f9N
+PZkpS5n6mWv1Yoy+1na+d/119zrdx213bn2eXW//ii5srNv2M2Ugc115vf1rOrJW+79ap1sNd721
+2r+iL/tv683+C/3Z/1iPFuds1OPdbbfq0/4r25QHTZms1W6TQX5F96bb6hk8rF+44tl7uPTt6iYM
+07aVu+auX9XP/p+y/7t621+xr9fD/pft/+u21gP+l/bP203rAP8r+3qZ8HrbXkWu5RHXeus/Gvf+
+gO19LLb3UdbHUda3sLZe1+uzXzC22dhs4zE85mLxmIuyv4Kyf4e1Vc/za8b+Njb7qxien2Lx/ALl
+OQLleQtWj3KsHvdiM9d/LuP8Qn3mPKc+I1B95ryiPmO4+owhFjs24xKGzYrF5oWy5qGsB7BajmO1
+PIftl/YNTGOLxWa7FcPjX1g8/o6yT0DZS7HqdeJ/MLZcDI/1KA8HVnsYNnMt+R/NGmy2ZzE8+2Lx
+HIKyr0LZn8Vqj8Rqvw1ba7231mObXuwzgvTat7XMrfm70/3fbcf/3Xb5P8X7P5Xdejuq1W0gZmtd
+h7a1zuneXyq3zYkydR0ed6FMfYf53DBgjgFrzfvUZyS5+5TV1H2ozwjTfcq91rY8hzJ1JAaY+WSY
+9/ysH8xgozat/udc+xH2MJTWq6RiGFBsltE0v1Q/9ntRph7G/i7K1MOYehKXzsY0YHftW2pb6iO2
+QGTqvVi9nyseBk7yi3/m/qFpLA+w0oCVdpfBQNjNNN06whvlCUaatrGbe43d2h6p7UiGmcbSQ/Qz
+jak/suRxwA4Hfik/sywdfBnS6X6GyCDCVTnhFk/C27stn/KuimGviuFVi50t5r6w5hfJIJHZstnc
+tzX3bO01jLV+zBbTmHuc7rbebe5Nm7otI5ICYw2d7ZBn3Ui4eIP/0Z/ayEgt521kiNrIMrWRGWoj
+i9VGxqmNpBjD2NZq5I3cKm9krNGP+eZ95j5r6z7s/+R+9YJbdn1Jy2rZplvrdaPINPWlWs4z8zBl
+3XCyTbnO8gADLQ84mzxGEm6dTq5Nmn4OWB7A3/IA88w9ZHNf3mMHU+1/Z6rlPcbrcfsM0+xw0b1n
+lNOqt7M8TaA1hlhjLZ0t3ciy+iFswwgxXiLAnkqxaTqccNn2VDLb2dn2VIa4/cvcdrbbvdi4QLHW
+D75MrodpfHV+jR4xzkavGGejd4yz0UOa1/D0MPD0MvA0ZbDW9wBMuUs/V31J8ehJiu0QKaZcYjvk
+/MTzBecnHeudn9jeJcWUOWzvgufHYMZv3cc3ZTZPT3LNtDz7utJt3ce1pzLSWkuatVbUWWtZ67bd
+fjHYWitwm8Gu62YcVlprmf3L95H38+uirt297e0069tivrVWPGGtFe+3sztYa4X5nkWltdZ8R0B4
+u+/5l7WWD621pLiMvHA1H9a285tpZ7flUev83hXu/P7qva1hrWX5Nf5scZlr+Kf9nNs196/95XS1
+2dbOuP067h5rLQ+2uz/7F+vrF9yWXngYKaQZ31FmGquV/qaxXODj9sZ6mEhtrPi2f/9Ev79yFx8b
+YS5jGUuq24y15PK29QnettbyjWnkLj42zvB7I5GPrVH83nqniNOmltOmMfYzxC4Y0qEXQ9rn8d81
+9hqX+R/cc7qd+e/E+TVzzT22Lm7ztMsY/3aZtutd+Nj+6M/9FskmFcnH1qd4sHW+brXNdI1pWG0F
+PKXd+8W7xn4G2eFgh+0U2x10N2DDtUbO+/m1q9etyDVWqHHZ7d3UmOnb7ucFsyzGe84LLiMDjPfE
+ceM9El3m1/wywGXEcZdpvb99Ov+T8OvN9fH/v81cXx/mNVHs9he7zPV8/yfX/ztGBrjsNvfj7Uxr
+HedfjafbLb9dedtsaz/nhf89o7pY+8FVY16z3HQ17NfucbnNfvjfMZYCl2n1G0tcxtoPa3tjGC7z
+a/7/aTxtLlOoGl229TKr3fZ/NEa8Ni9YM3jB9tpVo7phbW/a7rm+fHUi5pfqoX0ceyOFPwu/vizt
+063mBdNYzriMec0WwZO2CJbbIniivTG+5HeWj13GFmEaMdMWIY7YImiyRQjDFiEu2CLEOFsEf7RF
+iCxbBOdsEWKsLYLnWv3qGActgRy0RYhiWwSH1TGelWc4qD7T89xaVcMKVcNKs25VDR+qGqqttWx3
+G/2MtyeztkMxaR2K2+xfc5u2qUvHmiQift2okv9nh///uvl/evv+/9v/P5prZOxfstvk7QyX+Vm8
+PNc6pjUeeb9g1l5nX5/H4HZmg9u0XzPxC/5fMOZaSgx0m0HWWjHFWivHW2vFj9ZallprmeY2S621
+Ithaa/IXXVymbS3wC/Ug6txrnZR2PN3rptZrrfUo3nOZNn+7+NeEp/xC+CX3fZeu5vczs7ZdnEvt
+/O3LrN32sVf9tqdbuYl0a60Yaq3lz6YxnyWdxjDE+JwY0+j3rK/K7+Y6KrKdndZuTVf3y+HXr9tI
+a33W/tIz2TTmNfM9QuWJ3WowydyvaN2DadtjaX2X8YT73RZTV2e+R3jQ+YlHvfOi7azzon5/6R3X
+Oznm+3l6P/IrRun32b50Nun3+T8izRrJHIsvmdaV3GgZx93WKdxoe4ZsqxfZtt+RbVxiuWUgmbY/
+MMXixT1ud7a1nrnWfzPXdohsS38yLf3Jsj1Ftq2MzdbzzLH0I9OMq8OiucWygUdt6ymxfcJeyxqy
+LEspt55irv08N1i8mWMJp8gSQaalhdm2dB7S79Cb799ft94x9VetezPuvYFJnk/gZT9MT8801tlj
+yTbfj7FPo6DDDjLtt5Hhfvftf8moLmwyjRxKdavR7fEMo+02/G3jGWJ5gFTjM+ZZOpNhnCDDcpEM
+ywEyjJdc3y145jsv2b4gw7Or85LdToZHlvNVu4MMe4jzknXjVdvW6LxkPXb1vafW91RsM8k1DpBi
+93V+bw13ft/Ky3wP13zX2HIfK/SeVhWDlKfem1ih9bLu69YbCDU+o7faLKzmdyfm+x1t+2v3Oz3N
+PVXLYOLs9+l31gOtBjH2T1x6Wc+JzkuWapZYXmS1NZgltiustrzIEsvvWGKNY7B9ivZr41nMastn
+rLZ8ykN2O2v09U9ZbV/MausCsJ5ktTWP4bYfWG05w2rb31lt+TOrLf9ksHmPdSRrzPiWf/KQ5SVW
+W0eBbT1LLS+w2lrJQ5bDV/OyRrLa3p3VthFs0n0bikx9pfUB17s3prF/R5L9K0Zbmxhje50s/g//
+2c8T/X/r+5r/W9/R/J94J9F0/6f3ErUu6f/C+4daf/WZ611D811B8x1D8z79nqH7ncL/7jdWrvdY
+W99nbTbfmf243XuoB11Gv/tqh8ufud9RMM1h871kvXfu6drPFmVgKRYT3Wbjde65lmKRZSkW49zX
+xrXzj2vnz29nxkFznmUXC3Tf8uJuXU+9KDNto4KlxhvMtHqz1LKKmfqbtEPkmMY6w7WHbYsyv7dx
+fmdbgK9pu75269UJYdkQAJ4e4CC+5SkcDHMux8FwZxkORmh3ojMYB/tMFGFmHDHcGUwo8c4yQnX8
+UIY7dxNKosaJzjOEMlvjHOdThJKtMUfjPh3nFe1+1UQRaqYj+prxRZh299PuoWZMEa/jJGj3MO3W
+eYkzOuanOmajvvKdGWr1NN3WDtrtpd0dtbuTdntr93rTbSt1diTUtt1Er8/NdLz+6jxDXxyE0Zcw
+jQM0RmtM1KHbtLtC40Mad2jcqXGXxt0a9+n4x7X7TY0nNb6j8S8az2r8q8ZzJopOGnUZRGeNvhoT
+zNTEcI0H9ZWnNV7S2Ghet653niEMb40+zjP0w9v5PP00o374aPcw5xnC9ZX+eDuj6Y/DWU1/fLS7
+h/N5+tNTY6izlP4kO8voT6rGGRpnOc/QnyyNm3SczRpLNG7RuFXjfh3/UY3VGh/TeEDj4xprND6h
+8UmNBzU+pbFW47Man9P4vMY6jS9ofFGX5LDGlzS+rPGoLsNrOk69dr+u3ce0+w3t/kDjRxo/1viJ
+xk9NFN4afTR20ein0d9MX3TTGKhxoMZBGiM1DtEYozFOo+YuNC/xO41fO8sYoNtogG6RAbqNBmH2
+0kH4OBcwiB7a3dP5FINxOJczmFDtDnOeYDDRGhOcz5snaGhM1FdSne8zmBkaszTmOKsZzCZ972aN
+JRq3aNyqcZu+t0LjQxp3aNypcZfG3Rof0XlVatyjca/GfTr0CZ3jkxoPanxKY63GZzU+p/F5jXUa
+X3CeYTAvavdhjS9pfFnjK7r8r2o8rnN5R+OnZqjwNd3CT7ujzJKIIdo93HmCSOKdc4gkwVlGJMOc
+HYlkuHMtkSQ6byeSHOfzRPKIDq3UuEfjXo37dJxXdJxXTRRhZmoiygwVw81Q210tfkTaSjXeo3F7
+ix9D8HbezhB8NJoja4geWdF4O0uJxsFkovHRbnNURjPCeYYYvFuaiMHhLCMGn5aniNH3xuh7Ywh1
+LieGZOcJYkjVOEPjJn19s8YSjVs0btW4X8d5VGO1xsc0HtD4uMYajU9ofFLjQY1PaazV+KzG5zQ+
+r7FO4wsaPzVR+Jlo22aysN3b0kQs3s7dxGq+sfg4dxNHvPMMcQxzPk8cw53vE0ei81XiSCaSOHJ0
+6D59Zb++8qjGao2PaTyg8XGNNRpfIIw4XtH3vmqiCNM43EzHVmrmZdvufJ6hesQN1eUZqkfcUIY6
+32eoCNHY2/k+8Xg7g4nH0fIU8fi0NBGvWyFet0I85jMrnnjtTnYmEk+K0494UjXO0DjLuZx4Mp3J
+xJOlMVunmaNxhQ69XeMmndpmjSUat2jcqnG/s4p4HtW5VGv3YxoPaHxcY41zAfH8Vuf7hHM68Typ
+3Qe1+ymNtc5FxPOsvv6cdj+vsU7jCzqFF503Es9hXdqXtPtlja/oMr+q8YRO4V2Nn5oo/Mx7hb/J
+RQRq7GWmIPpoDDNrSQwx3bZtZqjtXo2PaTzgXE4C3s5kEnA4z5CAj3abtZ2gazuBUOerJLBJ42aN
+JRq3aNzqfJVheLfsZpieIYfho909nNEMo6fGUOcChhHtPMMwkp3JDCNVY5q+MkO7s5y3M0xLKcPY
+pONv1liicYvGrRor9F0PadyhcafGXRp3a9yv03xUY7XGxzQe0Pi4xhqNT2h8UuNBjU9prNX4rMbn
+ND6vsU7jCxpf1GU+rPEljS9rfEWzeFXjp2ZM4WuWSvhp9xAzjq2UMIbpcTrMtl27zdE6HO+WVxmu
+55/h+Gh3D+dahtNTY6jzdoaT7JzKcFKdcxiu63A4M7R7lrOa4WQ5n2I4Oc73Gc4mHX+zxhKNWzRu
+1bhfp/OoxmqNj2k8oPFxjTU63yd0+k9qPKjxKY21Gp/V+JzG5zXWaXxB44u6PIc1vqTxZY2v6BK+
+qvFTM6bw0+hvshCBGoeYMXVdDbdtcwYzXNfVcNu9zmBG4HA+zwhCNZqzzQjdx0awSV/ZrLFE4xaN
+WzVu03EqND6kcYfGnRp3adyt8bjGd0zULZionw6JOJzRJOp5KZEeTkUiPTWarZOoS5JIsnMBiaQ6
+p5Ko2yiRGdqdpXGTjrlZY4nGLRq3atym4+/XKTyqsVrjYxoPaHxcY43GJ3SaT2o8qPEpjbUan9X4
+nMbnNdZpfEHjixoPa3xJ48saj+syvKPxU/OK8NM4xDmVkVrKHaml3Il4O99nom6LiXo+n2grZScT
+bfcwhom27exkKg7nU8zQ8Wfh7VzOLD3PzLLdxWRm2Uo13qNxO5OZg7dzDnO0VDxHx5mj48zRcebo
+ONk6TraOk63jZOs42TpOto4zV89pOXg7E8nBx+lHjo6Zo2Pm6Jg5OuYKBjmfZwXxzuWsEGHO5dyu
+r9yur9yur2zSa65Nes21Sa+5Nuk11ya95tqk11yb9Jprk15zbdKz2Sa95tqk54RNek7YpNdcm/Sa
+a5Nec23Sa65Nes21Sa+5Nuk11ya95tqk11yb9Jprk15zbdJrrk16zbVJr7k26TXXJr3m2qTXXJv0
+mmuTXnNt0muuTXrNtUmvuTbpNdcmvebarHlt1rw2a16bNa/NmtdmzWuz5rVZ89qseW3WvDZrXps1
+r82a12bNa7PmtVnz2qx5bda8NmtemzWvzZrXZs1rs+a1WfParHlt1rw2a16bNa/NmtdmzWuz5rVZ
+89qseW3WvDZrXps1rxLNq0TzKtG8SjSvEs2rRPMq0bxKNK8SzatE8yrRvEo0rxLNq0TzKtG8SjSv
+Es2rRPMq0bxKNK8SzatE8yrRvEo0rxLNq0TzKtG8SjSvEs2rRPMq0bxKNK8SzatE8yrRvEo0ry2a
+1xbNa4vmtUXz2qJ5bdG8tmheWzSvLZrXFs1ri+a1RfPaonlt0by2aF5bNK8tmtcWzWuL5rVF89qi
+eW3RvLZoXls0ry2a1xbNa4vmtUXz2qJ5bdG8tmheWzSvLZrXFs1ri+a1RfPaqnlt1by2al5bNa+t
+mtdWzWur5rVV89qqeW3VvLZqXls1r62a11bNa6vmtVXz2qp5bdW8tmpeWzWvrZrXVs1rq+a1VfPa
+qnlt1by2al5bNa+tmtdWzWur5rVV89qqeW3VvLZqXls1r1K9utym58xteg68W1+5R+N2jfdqfFDL
+xg9q2fhBPZc+SKjzDA/qFcqDWgfyIJs0btZYonGLxq0aTb3Hg1pTUa5XQOV6BVSuJbpyLdFV6BVQ
+hc6lQq+AKnT6FXoF9JAOfUiHPqRDH9KhD+nQHTp0hw7doUN36NAdOnSnDt2pQ3fq0J06dKcO3aVD
+d+nQXTp0lw7dpUN369DdOnS3Dt2tQ3fr0Ee0jPqIns8f0c+ORzSjRzSjR7SM+oiWUR/RMuojWkZ9
+RMuoj2gZtVKnUKlTqNQpVOoUKnUKlTqFSp1CpU6hUqdQqVOo1Cns0Sns0Sns0Sns0Sns0Sns0Sns
+0Sns0Sns0Sns0Sns0Sns1Sns1Sns1Sns1Sns1Sns1Sns1Sns1Sns1Sns1Sns1Sns0xLIPi2B7NMS
+yD4tgezTEsg+LYHs0xLIPi2B7NMSyD4tgezTEsg+LYHs0xLIPi2B7NMSyD4tgezTEsg+LYHs0xLI
+Pi2B7NMSyD4tgezTEsg+LYHs0xLIPi2B7NMSyD4tgezTEsg+LYHs0xLIPi2B7NMSyD4tgezTEsg+
+LYHs0xLIPi2B7NMSyD4tgezTEsg+LYHs0xLIPi2BVOl6qNKjpgqflvd5Ro+sWo3PanxO4/Ma6zS+
+oPEVLQ+8ouWBV7Q88IqWB17R8sArWh44okfrEb1eflXHf1XHf1XHf1XHf1XHf1XHP6rnrqN67jqq
+566jeu46queuo3ruOqrnrqN67jqq566jeu46queuo3ruOqrnrqN67jqq566jeu46queuo3ruOqrn
+rqN67jqq566jeu46queuo3ruOqrnrqN67jqq566jeu46queuo3ruOqrnrqN67jqq566jeu46queu
+o3ruqte86jWves2rXvOq17zqNa96zate86rXvOo1r3rNq17zqte86jWves2rXvOq17zqNa96zate
+86rXvOo1r3rNq17zqte86jWves2rXvOq17zqNa96zate86rXvOo1r3rNq17zOqZ5HdO8jmlexzSv
+Y5rXMc3rmOZ1TPM6pnkd07yOaV7HNK9jmtcxzeuY5nVM8zqmeR3TvI5pXsc0r2Oa1zHN65jmdUzz
+OqZ5HdO8jmlexzSvY5rXMc3rmOZ1TPM6pnkd07yOaV7HNK/jut8e1/38Te1+U7tPavdJ7X5Hu9/R
+7lP6iXNKj6NT+olzSs+6f9LX/6Sv/0lf/5O+fkZfOatDz7rdZuhlreW7rLV8l7WW77LW8l3WWr7L
+Wst3WWv5Lmst32Wt5bustXyXtZbvstbyXdZavstay3dZa/kuay3fZa3lu6y1fJe1lu+y1vI5db5O
+na9T5+vU+Tp1vk6dr1Pn69T5OnW+Tp2vU+fr1Pk6db5Ona9T5+vU+Tp1vk6dr1Pn6zTzFZJpTm+h
+zNyFMnMXysxdKDN3oczchTJzF8rMXSgzd6HM3IUycxfKzF0oM3ehzNyFMnMXysxdKDN3oWylLSeE
+sm1veV94mE9z4WE+zYWH+dQQHuZTQ/iarSC6mjo90dXU6Ymupk5PdDV1eqKrqdMTXU2dnuhq6vRE
+V1OnJ7qaOj3R1dTpia6mTk90NXV6oqup0xNdTZ2e6Grq9ERXU6cnupo6PdHV1OmJrqZOT3Q1dXqi
+q6nTE/7mmk74m0804W/OkMLfnCGFvzlDCn9zhhTddQm76xJ21yXsrkvYXZewuy5hd13C7rqE3XUJ
+u+sSdtcl7K5L2F2XsLsuYXddwu66hN11CbvrEnbXJeyuS9hdlzBQlzBQlzBQlzBQlzBQlzBQlzDE
+7NUixJRARIjZq0WIqZkUIaZmUoSYmknRW8fpreP01nF66zi9dZzeOo65BxQswkztpQgzn9EiTLdX
+mG6vMFN7KcJM7aUIM7WXIszUXoowU3spwkztpQgztZcizNReijBTeynCTO2lCDO1lyLM1F6KMFN7
+KcJM7aUIM7WXIszUXoowU3spwkztpQhjv07/UY3VGh/TeEDj4xprnAtEmKm9FGE8ofFJjQc1PqWx
+VuOzGp/T+LzGOo0v6BRe1OU8rPEljS9rfEWX+VWNJ3T8dzV+aqKpvRRhpvZShJnaSxFmai9FmKm9
+FGGm9lKEmdpLEWZqL0WYqb0UYab2UoSZ2ksRZcpUIsqUqUSUbt8oXdtRurajTJlKRJkylYgyZSoR
+ZcpUIsqUqUSUKVMJU+fWJEydW7QYrtvL1LkpYercTAx13i6GmzKVMDVvC4SpeZsqtOZNmJo3052l
+cZOOuVljicYtGrdq3Kbj79cpPKqxWuNjGg9ofFxjjcYndJpPajyo8SmNtRqf1ficxuc11ml8QeOL
+Gg9rfEnjyxqP6zK8o/FT84opUwlTzzZVjDafC2K0+VyQtxPa8nd5O5s0btZYonGLxq0aS53Kdqe5
+c22703yS2u40d65td5o717Y7zZ1r253mzrXtTnPn2nanuXNtu8tM2XaXmbLtLjNl211myra7zJRt
+d5kp2+7SKd+jY97DMIbZ7mG4xhEaEzVu0qGbNZZo3KJxq0ZXCvvMmMK8917iW5ps9zKs5X3bvZgl
+uZcRdLbdS6Iz2XYvc5zP2+4lW+M+84oIM+OL4c5k22OmLsj2mKkLsj1m6oJsB/SVA/rKAfOKV7mp
+/fYqN/cHvcrN/UGvcnN/0Kvc3B/0Kjf3B73Kzf1Br3Jzf9Cr3Nwf9Co39we9ys39Qa9yc3/Qq9zc
+H/QqN/cHvcrN/UGvcnN/0Kvc3B/0Kjf3B73Kzf1Br3Jzf9Cr3Nwf9Co39we9ys39Qa9yc3/Qq9zc
+H/QqN/cHvcrN/UGvcnN/0Kvc3B/0Kjf3B73Kzf1Br3Jzf9Cr3Nwf9Co39we9ys39Qa9yc3/Qq9zc
+H/QqN/cHvcrN/UGvcnN/0Kvc3B/0Kjf3B73Kzf1Br3Jzf9Cr3Nwf9Co39we9ys39Qa9yc3/Qq9zc
+H/QqN/cHvcrN/UGvcnN/0KtC6wZb/5KvM4dBAOIkyNOgToKlGCzVYDXAmgs2O9hywFYDtgawJ4C9
+AOwXwCMDPL3A8yx0KAKvUPA6DR0ToZMvdGoA7yTwrgMH4EgGRyn4REDnDdAlA3wDwPcw+KWC33no
+uh78o8D/LAQkQzc7dC+C7mchsAmCDkOPdOhxAXoehOBa6JUIvc5B7+3QJwNCKyAsBPrlQ3gAhOdA
+eAWEn4b+QdA/GfqXwYBdMDANBp6BiAoYlAuDgyDSNBcgqhCGBEF0BESXQEwpxGZB7DmIOw5DyyA+
+FhLqYVghDI+A4ecg0RdG1sCoJhhdC0mlkFQLY7bD2AC3KYFxGa5mGG+HlGJIOQypqZB6FiZ5waRm
+mJwPk6tg8kmYkgdTamBqKEzdDtNCYdoFSE+A6QEwfQNMPwwzgmBGKcz0gpkbYGYVzDwCM89CRglk
+VEJGLWSchIxzkNEMmYkwuxBml8LsKphdB7NPwezzkAVkBUBWFGSlQlYzzPGFOREwJxnm5MCcIpiz
+HbKBuQEwNwrmpsLcXJhbDHPLICcJ5qXDvHyYtwHm7YJ5h2DecZh3Fubnwfz1ML8C5h+E+fUw/wzM
+b4RcL8gNhdxEWJAMC3JgQREs2A4LqmHBYVjQADdmwcJcWFgMC8tgYQ0sPAILT0PeYchrgLwLsMiA
+RUGwKBYWpcGiPFi0HhZVwKKDsKgeFp2BRY2w2AsWh8LiRFicAYvzYEkyLMmBJUWwZDssqYYlh2FJ
+Ayy5APkG5AdBfizkp0F+HuSvh/wKyD8I+fWQfwbyG2GpFywNhaUVsLQZlvnCsghYlgzLcmBZESzb
+DsuqYdlhWNYAyyNgeTosL4Ll22F5NSw/DMsboCACCpKhIAcKiqBgOxRUQ8FhKDDDL8CKUlgZDiuT
+YGUWrCyElea1KlhZBytPwaoKKAQKA6AwCgpToTAXCouhsAxuKoLVVbC6DlafgtXnYQ2wJgDWRMGa
+VFiTC2uKYU0ZrKmBNUdgzWlYcwmK7FAUAkUJUJQORVWwFlgbAGujYG0qrM2FtcWwtgzW1sDaI7D2
+NKy9BDfb4eYQuDkBbk6Hm/Ph5g1w8y64+RDcfBxuPgs3N8EtDrglHG4phlsaobgUinfBuhBYtwtu
+tcOtNXBbItx2Cm47C+vTYf1JuD0Dbm+CO5LhjsNwZwnceR42rIeNQbCxFDaFwqZc2FQJm71gcyls
+roeSJCg5DluiYEsZbDVgaxBsjYWtJ+GuLLjrFJQWQWkTbCuDu8Ph7gS4+wzckwr3lMF2O2zPg+3r
+YXsF3OsF9xbBvQ1wXxrcVwn3XYD70+H+WigLgrJiKLsAD6TDA3XwYCg8uB4e3AUPHoIHm6A8BMoT
+oDwdyvOhfAOU74LyQ1B+HMrPQnkTVDigIhwqkqAiCyoKoaIUKqqgog4qTkHFeXgIeCgAHoqCh1Lh
+oVx4qBgeKoWHquChOnjoFDx0HnYAOwJgxy7YmQw7c2BnEezcDjurYedh2NkAOy/ALgN2BcGuWNiV
+BrvyYNd62FUBuw7Crnp4OBkeroKH6+DhU7A7Hx4Jh0eS4JEseKQQHimFR6rgkTp45BQ8ch4qgcoA
+qIyCylSozIXKYqgsg8oaqDwClaeh8hLsscOeENiTAHvSYU8+7NkAe3bBnkOw5zjsOQt7mmCvA/aG
+w96DsC8c9iXBvizYVwdVhVB1Dn6TBb+pht9cgv122B8C+xNgfzrsz4f9G2D/Lth/CPYfh/1nYX8T
+POqAR8Ph0SR49CBUp0J1PTxmwGO1cKAADlyAxzdAjR1q0qDmIPy2FH57CZ7Igidq4clEeNK06+Fg
+KhxshKfS4Kk8eKoZni6B3xnwu3z43Sk4lA6HjsPvY+H3VfBMBDxTB39Igz+cgdoCqD0NzybBs5fg
+uQZ4vhDqEqHuIPwR+GMVvBACL5TAiwa8WAMvNsPhInjJC14KhZdy4KUyeKkRXs6Al3fBK77wShkc
+8YIjtfBqBLxaA69egKM5cPQMvJYDr52F+gioXw/1TfB6GrxeB8eAY6lw7DC8EQVvNMDxJDheDydi
+4cRpeDMU3iyCN8/BW1nw1mF4qxlOpsHJg3CyEd5Oh7fr4Z1weKcW/ZMB72bBuwfhlBecqoBT1fCe
+L7xXCe8dhPfq4U9B8Kc0+NMZeD8R3q+ChihouAQfHIEPc+EjL/ioDk4Xwulm+DgVPm6APzvgz5Xw
+SSp8chDOxMKZajjTDJ+mw6cn4S8F8JfD8FkIfBYFn6XCZ2XwmXntPJwNgbM5cLYePo+AzzPg8/Xw
+eTV8fhL+GgF/LYW/VsNfa+Gv9fDXBvjrJddxu19EwRdp8EUufFEEX1TBF4fhXCicK4O/RcHfyuDv
+OfD3I/APO/xjA/yjBs4nwvkyON8EXwbAl4nwZS58WQJfVsOXdfBP4J++8M9w+Gci/DMd/pkH/yyG
+f26Hf1bBP2vhn8fhn2fgn5fgXwb8KwP+lQ//Wg//KoN/HYR/HYd/NcEFX7iQDBcK4UItXGiCf/vC
+v8Ph34nw71z49wb4dxX8+xxcDIGLyXAxHy5WwMVDcPECfBUOXyXCV+nw1S74qh6+Og1fXYBLdrgU
+AZdK4VIlXDoEl+rhUiN8bYevg+DrKPg6Gb6uh6/PwtfN8E0QfJMI3xTDN5XwzXH4NhS+zYJva+Hb
+U/DtWbdphMZUaCyFxl3QWA+NzfBdAnyXAd8Vwne18N0F+D4Avg+H75Pg+yz4Ph++L4bv6+H7M/B9
+I/xgwA8h8EMi/JAFPxTCD9vhh2r44TD8cBJ+OANNDmhKhKY0aMqBpgJougBNzfCjF/yYDD9mwI95
+8GM9/NgAP56DHxvhpyj4yTzLOB1+Ogc/NcLlUmhOh+Z8aN7gPhP5EDQfd5/P3ARXdkFLOLRsB2cE
+OBPBWQ3OCwhyEFQguIQQWQhxCCEdCFmGkFUIFY5QZQjVhDDSEUYZwmhEWGoQ1nSEtQlhq0TYahG2
+kwjbOYStGWH3RdiLER5eCI8yhEcTwtMX4RmB8KxFdMhDeCUgvKoRHe2IjoWIjmcRnUIQncoQnU4i
+OjUhvPMQ3qcQjlKETyjCJxbhk4zwqUX41CN8GhA+5xCdUxGdsxCdDyI6X0J0KUB0WY/oUoHochDR
+pR7R5QzCNx/huwHhF4HwS0T4pSH88hB+RYiuBqJrGaJrI8LfC+EfivBPRPhXIPyrEQGpiIAsRMAR
+RLd8RLdiRHcD0f0CIvAIIsiB6GGaWkSPekSPBkSPc4ieRYieJYieFYie1YjgYkRIFiIkHxHShOi1
+C9E7F9EnEdFnF6LPIURoAiJ0F6JvESIsGRGWgQg7j+iXhehXiujXiAg3EOHbEf3tiP45iP5FiP4n
+EQPCEQPWIwacQgw4ixgYghgYhRiYhBhYjBhYiohIQ0TkICJqEBHNiEHpiEGHEIPtiMHFiMHHEZER
+iMhKRGQzIsoXERWBiKpGRB1GDAlBDIlCDElCDMlCDMlHDLmEiC5ARJ9GRF9CxNgRMSGImCJEzCVE
+bC4i9gIirhgx1EAMLUPEJyLizyMSshAJ9YiEBsSwCMSwYsSwesSwM4hhjYjhOYjhhxEjQhEjMhAj
+KhEjziIS8xCJZxAjixCj7IhRIYhRCYhRVYjRBmJ0IWL0GURSEiKpGjHGQIzJRYypQ4x1IMbWIMZF
+IcalIsblIsaZ/guIcc2I5FREcg1iPIjxRYjxZxApsYiUUkTKBcSENMSEKsSEQ4gJRxATTiMmXEKk
+liIm+iImliEmNiMm5SImHUFMuoCYbCAmByEmxyImpyEm5yAmFyAmlyAmVyImH0RMPoyY3ICYfAGR
+ZiDSghBpsYi0NERaDiKtAJFWgkirRJi/YZR2EpF2DpHWjJjihZgShJgSi5iShpiSh5iyHjGlAjHl
+IGJKPWLKGcSURsRUL8TUUMTURMTUYsTUWsTUk4ip5xBTGxHTShDTmhDpBYjpIKaXIaZXIaYfQkw/
+gph+CjGjBDHTjphZhcgIQWTUIGYlI2bVIjKjEJmXELNLEbMvILJiEVmHEHMKEHMaENmJiLkBiLll
+iJxYRE4NYl4hYt45xA2liBvOI+YXI+ZfQuQ2IBZkIBbUIm6MQtx4AbEwDZEXgMgrQCzyRSw6jVic
+ilgCYokDsSQRsSQHsaQasaQekZ+AyM9C5DcgliYglh5BLItALNuOWG5HLE9ELK9CFGQgCk4iVkQh
+VuQgVlxArNyAWHkWsSoJUehAFBYhbopA3HQcsRrE6lrEmlTEmhpEURSiqBaxdjviZjvi5lzEzUcQ
+t6QjbjmEKE5FFBci1hmIdWWIW0Hc2oC4LQJxWwXitpOI9esRt4cibq9B3BGAuOMU4k4H4s4NiA0h
+iA2HERt9ERvLEJsCEJvOITbnIkpSESUNiC3piC1nEFtTEVtPI+6qRZRmIErPIbalIrbVIO5ORdx9
+EHFPIWJ7EGL7YcS9hYh7TyPuq0Tc70DcX4AoC0eUlSDKTiMeOIR4MBVR7kCUn0FUnEc8VIHYUYjY
+mYvYFYvYdRDx8AbE7rOIRyoRlbmIPWmIvQ7E3lOIfScRVesRvzmM2H8GUe2FqD6CeOw44kAR4vEC
+RE0o4rd2xG93IZ44gzgYhTh4AfF0AeJ3xYhDdYjfFyCeSUf8IQtRG4F4NhzxnIF47iDi+WJEXTii
+7jDij1mIP1YjXghAvNCAeDEL8WIt4rCBOJyHOHwI8VIq4qUKxEunES+dR7wci3g5GfHydsTLlYiX
+mxCvpCFeqUEciUUcOY14NRnx6nrEq3WIo3bE0WrEa0mI19IRr1UhXruEqC9GvA7idQfi9RDE61GI
+1ysRrzchjhUgjq1HHNuOOFaJOHYQ8UYs4o1kxBvbEW80IY7nIY43Ik4kIk6UIE6cQrwZhXizBPHm
+IcSbjYi3UhFvFSPeOoU46Ys4mYM4WYN424F4Oxfx9inEO2mId0oQ7zQg3k1FvHsEcSoUceo04r1E
+xHtViPeaEX/KQvzpLOL9fMT75xENUYiGEsQHXogP1iM+OIL40BfxYTLiwyLEh2cQH4UiPipCfFSP
+OJ2GOF2COH0J8fF6xMdnEH8uRHwSgvikHvHJacQnFxBnQJzxRZwJR5xJRJypRJw5hDhTj/g0C/Hp
+WcSnlxB/iUL8pRjxmRfis2LE2VDE2SLE2dOIzyMQnx9G/DUV8dfTiC8iEF+UIs6BOJeFOHcYca4R
+8bdwxN/yEH+3I/6ejvh7NeLvDYh/lCHOByDOVyK+DEF8mYf48iTin7mIf4Ug/rUd8a8LiAu5iAtn
+Ef+OQPx7O+Lf9Yh/NyMu5iEunkF8lYD4qhLx1RHEV+cQl+yISxGISzmIS9sRl6oRlw4jLjUgLl1A
+fG0gvg5CfB2L+DoN8XUe4uv1iK8rEF8fRHxdj/j6DOLrRsQ3XohvQhHfJCK+yUB8U4D4pgTxTSXi
+m1rENycR35xDfNOM+NYX8W0E4ttkxLc5iG+LEN9uR3xbjfj2MOLbBsS3FxCNBqIxCNEYi2hMQzTm
+IRrXIxorEI0HEY31iMYziMZGxHdeiO9CEd8lIr7LQHxXgPiuBPFdJeK7WsR3JxHfnUN814z43hfx
+fQTi+2TE9zmI74sQ329HfF+N+P4w4vsGxPcXED8YiB+CED/EIn5IQ/yQh/hhPeKHCsQPBxE/1CN+
+OIP4oRHR5IVoCkU0JSKaMhBNBYimEkRTJaKpFtF0EtF0DtHUjPjRF/FjBOLHZMSPOYgfixA/bkf8
+WI348TDixwbEjxcQPxmIn4IQP8UifkpD/JSH+KkE8VMl4qdaxE8nET+dQ/zUjLjsi7gcgbicjLic
+g7hchLi8HXG5GnH5MOJyA+LyBUSzgWgOQjTHIprTEM15iOb1iOYKRPNBRHM9ovkMorkRccULcSUU
+cSURcSUDcaUAcaUEcaUScaUWceUk4so5xJVmRIsvoiUC0ZKMaMlBtBQhWrYjWqoRLYcRLQ2IlgsI
+p4FwBiGcsQjnLoSzGUkpkl1IDiE5juQskiakcCBFOFIkIUUWUhQiRSlSVCFFHVKcRoompHQgZThS
+JiFlFlIWImUpUlYhZR1SnkbKJqRyIFU4UiUhVRZSFSJVKVJVIVUdUp1CqvNIA6QRgDSikEYq0shH
+GqVIowpp1CGNU0jjPNIC0hKAtEQhLalISy7SUoy0lCEtNUjLEaTlNNJyCWm1I63hSGsq0pqLtBYj
+rWVIaw3SegRpPY20XkLa7EhbCNKWgLSlI235SNsGpG0X0nYIaTuOtJ1F2pqQdgfSHo60JyHtWUh7
+IdJeirRXIe11SPsppP080gOkRwDSIwrpkYr0KEV6nEV6NCE9HUjPcKRnEtIzC+lZiPQsRXpWIT3r
+kJ6nkJ7nkR1AdghAdohCdkhFdshFdihGdihDdqhBdjiC7HAa2eES0ssL6RWO9EpCemUhvQqRXqVI
+ryqkVx3S6xTS6zyyI8iOAciOUciOqciOeciOG5AddyE7HkJ2PI7seBbZsRnZKQDZKQrZKRXZKRfZ
+qRjZqQzZqQbZ6Qiy02lkp0aktwPpHY70TkJ6ZyG9C5He25HeNUjvI0jv00jvS0iHHekIQToSkI50
+pKMA6ShBOiqRjlqk4yTScQ7paEb6+CJ9IpA+yUifHKRPEdJnO9KnBulzBOlzGunTiOzshewciuyc
+iOycgexcgOxcguxciexci+x8Etn5PLILyC4ByC5RyC6pyC55yC4bkF12IbvUILscQXY5hexyHtml
+CenrQPqGIH0TkL6pSN9cpG8h0rcU6bsL6XsI6Xsc6XsW6duE9HMg/cKRfklIvyykXyHSrxTpV4X0
+q0P6nUL6nUd2Bdk1ANk1Ctk1Fdk1F9m1GNm1DNm1Btn1CLLraWTXS0h/O9I/BOmfgPRPR/rnI/03
+IP13If0PIf2PI/3PIv2bkAEOZEA4MiAJGZCFDChEBpQiA6qQAXXIgFPIgPPIbiC7BSC7RSG7pSK7
+5SK7FSO7lSG71SC7HUF2O43sdgnZ3Y7sHoDsHoXsnoTsno7sXoHsXo3sfhjZvQHZ/QIy0EAGBiED
+Y5GB6cjAXGTgemTgdmRgNTLwCDLwNDLwEjLIjgwKQQYlIINSkUG5yKBiZFAZMqgGGVSPDGpABl1C
+9rAje4QgeyQge6Qje+QjexQje5Qhe9QgexxB9jiN7HEJ2dOO7BmK7BmL7JmO7JmP7FmM7FmK7FmF
+7FmH7HkK2fM8MhhkcBAyOAIZnIoMzkIGFyKDNyCD65HB9cjg/x1zCRkCMiQcGZKODClGhlQhQ+qQ
+IaeQvQKQvXKRvWqQvRqQvdORvU8i+6Qi+5QhQ0GGHkb2DUH2TUT2zUf2PYQMS/510287MrwKGX4E
+GX4aGd6E7B+A7B+F7J+O7F+E7F+L7N+IHOCFHFCKHFCJHHAEOdCOHJiAHJiPHLgBOXAXcuAh5MAL
+yIgIZEQBMmIDMuI4clAGclAxctBp5GD+F0wecvBBZGQSMrIWGZWIjMpHDvFCDqlADjmOHHIOGe2F
+jE5GRhcjo88goy8hYwxkTAAyJgIZk4SMyUDG5CNj1iNjypAx1ciYOmTMSWTMWWRMIzLWjowNQsZG
+IWOTkbFZyNgCZOwGZGwFMrYGGXsYGXsKGXsOGduEjPNCxu1CDj2EjC9GJgQgE04jh+Ujh1Ujh4cj
+h1ciR3ghR4QiRyQiR1QhE4OQiYeRI2uRI+uRo1KRo7KQo/KRow4hRwcgRycgR+cgR29HJiUikzYg
+k6qQSUeQSeeRY+zIMeHIManIMfnIMaXIMTXIMceRY84jx9qRY8ORY1ORY/ORYzcgx5Yhx55EjvNF
+jstFjitEjjuCTA5CJpcik5uR472Q4zcgU0CmOJApIciUKGRKEjIlHZmSj0wpRaZUIVMOIVOOI1PO
+Iyc4kBPCkRMSkBPSkRMKkRM2ICeUISdUISccQqaGIFNrkBOLkRNPIyfFIiftQk6qQ046j5wcgJyc
+ipxcjJx8BJlmR6YlItMKkWnVyLTTyCmhyCmFyCmXkFMLkVMPI6cZyGnpyGmVyGnnkOlRyPQCZHoN
+Mv0ccnoIcnoxcno9coYDOaMYOeMMcmYBcuYpZEYAMiMdmVGKzDiCzGhCzopCzspHzqpHZoYgM/OQ
+mRuQmeeRs9ORs4uQsyuRs+uRsy8gs3yRWYnIrDxkVikyqxqZdQSZdQaZ1YSc44ucE4Wck4ack4+c
+U4Kccx6ZnYfMPo6cayDnJiHnrkfOrUPmOJA5+cicQ8icJuS8JOS8EuS848gbvJA35CBvOIicbyDn
+5yLnH0LmgsxNR+ZWIXPPIheEIBfkIRfUIBdcQN4YhbyxDHnjOeTCVOTCGmSeFzKvBJl3GrkoBLko
+H7noOHJxFnJxEXLxeeSSKOSSYuSSBmR+FDJ/OzK/Gbk0Abm0CLn0FHKZHbmsHrmsCbk8F7n8NLLA
+QBbkIgsakSvSkCvOIlfmIldWI1clIFflIVeZ7kvIQpCF6cjCCmThEeRNIcibEpA3pSNvOo5cXYFc
+XYtcfRq5uhG5JgK5JgO5pgq55iRyTROyKAlZVIpcm4Bcm45cW4hcexB5sx15czXylnDkLYXIW2qR
+xQHI4g3IdduR604hb41C3lqGvPUS8rZ05G2HkOsTkOsLkOuPI28PRd5eiLy9DnlHFPKOGuSdIO/M
+QN55BLkhFLlhO3JDLXLDGeRGB3JjMnJjPnJjGXJTAnJTLXJzAnLzQWRJOLLkMHJLFnJLA3JrEnJr
+JXLraeRdUci7ipF3VSLvqkfedQFZ6kCWxiJL85ClFcjSeuQ2kNsSkds2ILedRd4dgby7Anl3PfIe
+kPckIO/JQt5TjLxnF/KeOuQ9p5HbI5D3BiDvTUTeW4G89wLyvlDkg2eQ5Q5keRqyPB9ZXoUsP40s
+b0JWRCErMpAVhciKCmRFHbLiNLKiGflQLPKh7ciH6pEPnUXuCEDuKETuqEHuqEfuaELuDEHuTETu
+zELuLELuLEPurEfubEbuikXuKkTuqkHuOo98OAr58Hrkw1XIh+uRD59H7vZC7o5C7s5A7i5G7q5E
+7j6M3H0W+YiBfCQc+Ugq8pF85COlyEcOIh9pQD7SjKwMR1ZmICvXIysPIitPI/eA3BOO3JOO3LMe
+uacGuecUci/IvRHIvRnIveuRe2uQexuQe5uR+0KR+9KR+9Yj91Uj9x1H7mtEVoUgq1KRVYXIqkpk
+1XFk1SXkb0KQv8lA/qYU+ZvDyN9cQu4PRe7PQu4vQ+4/7vpRgEcTkI8WIB+tQj7agKy2I6sTkdW5
+yOrtyOo6ZPU55GNeyMdikY/lIh/bjnzsCPKxC8gDAcgDacgDxcgD1cgDp5GPG8jHo5CP5yEfr0A+
+fhxZ44WsSUTWFCJrDiFrziF/64v8bQbyt6XI39Yhf9uEfCIJ+UQJ8omzyCcdyCeTkE8WIp+sQj55
+BPnkGeTBIOTBBOTBDOTBQuTB7ciDNciD9ciDZ5EHm5FPhSCfSkU+VYB8qgL5VA3yqXrkU2eRTzUj
+nw5APh2LfDod+XQB8ulS5NPVyKePIJ8+g3y6Cfk7X+TvopC/S0P+Lhd5KAL5+/PIZw4inzmCfKYB
++cwl5B8M5B8CkH9IRP4hC/mH9cg/VCH/UIv8w0nkHy4ha72QtQnI2gpk7UHkswby2Tzkc1XI58OR
+zx9C/rEQ+cftyD/WIf/YgHwB5AtByBdSkS/kI1+oRL5Qh3zhLPKFZuSLocgXk5AvFiBfLEW+WIt8
+8RTyMMjDQcjDqcjDecjDZcjDB5GHTyMPNyJfCkG+lIR8qRD50i7kS6eRL8ciX65HvhKAfGUD8kgp
+8tVE5KtNyKOxyKPbkUfPIV9LQr5Wiaw3kPVZyPoNyPoaZP055Os5yNcPIY8ZyGOxyGN5yGMVyGP1
+yDe8kG9kIN8oRL6xHflGNfKNw8jjBvJ4LPJ4HvJ4GfL4YeQJkCfSkCeKkSeqkG96Id9MQ765Hfnm
+SeRbXsi3tiNPeiFPbke+7YV8Oxb5dg7y7RLk24eQb59Evt2EfMcX+U4i8t1C5KkzyD81Iz8IQX5Y
+ijy9HfnnfOQnjchPS5F/iUB+Foo8m4r8/BDyi7PIcxeQf9uA/HsR8u+nkP+oRX4ZjvyyAvnlKeQF
+B/JCEfLfBvLfJ5EX65BfVSMvVSK/KUR+U4n81o78tgj57SHkt6eRjSAbM5CNNcjvQpHfVSO/L0J+
+b9oNyB+CkD9kIX+oQP5wAdkUjmzKQzYdRDY1In/MdZsi5I+lyB+rkT/WIX9sQP54CfmTL/KncORP
+ycifcpE/lSB/qkL+VI/86Tzysi/ycjjycjLyci7ycgnychXycj3y8nlksy+yOQrZnIFsLkY2VyOb
+jyObG5FXQpBXMpBX8pFXSpBXqpBX6pFXziJbDGRLOLIlA9lSiGypQLbUIVvOIZ12pDMW6cxFOiuQ
+zkNIZwPS2YQiFEUqiiIUVSgaUDShRChKpKNECUocQolzKBmAkhkomY+SJShZhZL1KHkWpQyUCkep
+DJQqRKkKlKpDqXMow44yYlFGLsqoQBmHUEYDymhCWUJRllSUpQhlqUJZGlCWJpQ1FGVNR1lLUNZD
+KOs58xdXULYMlK0QZatA2epQtnMoux1lj0XZc1H2CpS9DmU/p3/lR3kkozwKUR7VKI/TKE9flGcC
+yjMP5VmG8qxHeV5CdQhFdchCdahAdTiC6tCI8gpHeeWhvHahvBpQHR2ojhmojvmojiWojlWojvWo
+jmdRnQxUp3BUpwxUp0JUpwpUpzpUp3MobzvKOxblnYvyrkB5H0J5N6C8m1COUJQjFeUoQjmqUI4G
+lKMJ5ROK8klH+ZSgfA6hfM6hOgegOmegOheiOlegOtehOp9DdbGjusSiuuSiulSgutShupxD+TpQ
+vsko30KUbzXK9zTKzxfll4Dyy0P5laH86lF+l1BdQ1Fds1BdK1Bdj6C6NqL8w1H+eSj/XSj/BlSA
+AxWQgQooRAVUoALqUAHnUN3sqG6xqG65qG4VqG51qG7nUN0dqO7JqO6FqO7VqO6nUYG+qMAEVGAe
+KrAMFViPCryECgpFBWWhgipQQUdQQY2oHuGoHnmoHrtQPRpQPR2onhmonsWontWonqdQwQYqOAoV
+nIcK3oUKbkCFgAqJRYXko0KqUSGnUb18Ub3SUb0qUL2OoHo1onqHo3rnoXrvQvVuQPVxoPpkoPqU
+ovrUo0JBhSajQjegQutRfe2ovhmovrWosHBU2AZU2BlUvwxUv4Oofo2o8ERUeAkq/DiqvwPVPwPV
+vwzV/yRqgC9qQA5qwGnUwCLUwFrUwEZURAYqoh41KBw1qAo1OAQ1OAM1eDtq8GlUZCgqMg8VWY2K
+vISKSkRFlaKiGlBDwlFD1qOGnEJFh6KiS1HRF1Ax6aiYWlRMEyo2CRVbg4rzRcXlo+KOoIaGooaW
+oeINVHwaKt50N6ASglAJhaiEetSwANSwAtSwk6jhiajhZajh51Aj8lAjzqESE1CJ21GJZ1EjI1Aj
+16NGnkaNWo8aDWp0FGp0Lmp0GWr0EdToS6ikEFRSOippAyqpFpV0HjUmCDUmHTWmBDWmDjXmAmps
+CGpsOmrsBtTYQ6ixZ1HjHKhxSahxhahxVahxh1HjTqPGNaKSHajkNFTydlTySdR4L9T4JNT4ItT4
+GtT4M6jxTagUX1RKOiqlEjXBQE0oQE1oQKUWolKPoCZ6oSZmoSbWoSYloCbtQk06h5ochZq8HjX5
+OGryeVSaA5VWg5qSg5pSgJpyCjU1AzW1BjW1CTUtDTWtCpUOKj0KlZ6DSt+OSj+CSm9ETQ9HTc9B
+Ta9ETT+DmuGLmpGGmlGCmnEYNeMCamYQamYqamYhauZB1MxzqIwgVEYGahaoWVmoWdWoTC9UZiUq
+sxk1Oxc1+wgqKxSVlYfKqkLNCULNKUXNaUBlp6Oyq1Bz7ai5Bai5h1BzG1E5Vah5dtS8AtS8OtS8
+k6gb7KgbjqPmx6LmN6Jy61ALalE3VqIWpqLywlF551CLjqAWJ6MWX0AtOY3K34VamoBa5oVaVo9a
+XoEqyEMVNKJWHEStLEOtKkMV5qIKL6Fu2o5aHYFafQS1JhVVFIoqakatPYm6uRh1SzKq2DQNqHU5
+qFsN1K21qNsaUOszUOvrUOtPo9Y3oW4PQN2egLq9BnV7Her246jbT6NuP4+6A9Qdxag7DdSd61F3
+XkJtKENtqEJtOITacBq1EdTGcNTGdNTGItTGStTGetTGC6hNvqhNCahNWahN61GbqlCb6lGbzqE2
+O1CbE1Gbi1Gb61CbT6JKElAlNagtUagtJ1FbM1BbT6HuCkfdtR5V6oUqrUSVnkNtK0Btu4C6+wjq
+nnTUPU2o7UWoe31R9xag7m1E3ZePut9A3V+MKotFlSWjyjJQZXmosiJUWS2q7ALqgWLUg6AerECV
+B6HK81Hlh1DlzaiKdFRFCariEOohB+qhfNRDB1EPNaJ2pKN2VKN21KN2XELtBLUzA7WzFrUrCLVr
+O+rhENTDBaiHa1G77ajduajd21G7L6AeKUFVJqP2BKH2hqD2nkLtq0NV1aN+cxC1PxX1aBCquhD1
+WALqQAjq8SDU45dQNQ2o315CPVGPerIJ9VQA6ulE1O9AHTJQhw6hfl+JeiYL9Ydc1B+aULVnUc8l
+o563o+q2o/5YiXrhDOrFKtThUtRL1aiXS1CvFKGObEC96ot6tRb1ajPqaDPqtVOo+lLU6zWoY7Wo
+N1JRxxP+32DqUMePo46fRh0/jzrehDphR50IQJ0IR51IQJ1IRZ3IQp3IR50oRp0oRZ3YhTpRgzpR
+hzpxHHXiNOrEedSJJtSbdtSbAag3w1FvJqDeTEW9mYV6Mx/1ZjHqzVLUm7tQb9ag3qxDvXkc9eZp
+1JvnUW82od6yo94KQL0VjnorAfVWKuqtLNRb+ai3ilFvlaLe2oV6qwb1Vh3qreOot06j3jqPeqsJ
+ddKOOhmAOhmOOpmAOpmKOpmFOpmPOlmMOlmKOrkLdbIGdbIOdfI46uRp1MnzqJNNqLftqLcDUG+H
+o95OQL2dino7C/V2PurtYtTbpai3d6HerkG9XYd6+zjq7dOot8+j3m5CvWNHvROAeicc9U4C6p1U
+1DtZqHfyUe8Uo94pRb2zC/VODeqdOtQ7x1HvnEa9cx71ThPqXTvq3QDUu+GodxNQ76ai3s1CvZuP
+ercY9W4p6t1dqHdrUO/Wod49jnr3NOrd86h3m1Cn7KhTAahT4ahTCahTqahTWahTRaj3klDv7UL9
+6Rzq/UTU+wdRDaGohixUw0nUB4WoDypRH5juc6gPQ1AfpqE+rEN9eAr14XnURxmoj4pRH1WgPjqE
++ugk6qPzqNMG6nQI6nQi6nQW6nQR6nQZ6vRB1OnjqNPnUB+D+jgI9XEC6uMM1MeFqI+3oz6uQX18
+BPXnQtSfG1CfOFCfbEB9chB1JgR1Zj3qTB3q0yjUp5Wovxiov9Sg/nIY9Vks6rMzqLMZqLPnUJ8X
+oP4agICOz0gPBlKDHYmDJGxgDBSbsWMA3cUoBK6/+RxxuwVeYrjbLTHEdLdb4SNudbsNfMw3SrXb
+gpc443ZbccgObrcdH5nkdnegh7zP7e7oUe7X4HZ3Ijb4T263A6+QXm63D0ZIAgpheAAvg9stCBAh
+brfELia43YreItPtNugttrvdFgLEUbfbSoj40u2201sGud0dGC5nuN0du4TJg253J/KD891uBwEh
+NrfbB3vIYMaxikLWsZqlLCGfIkIIZyH9CSGaKKKIIYQbWUcIY1lKEWsoYjWLWMAKIghhIitZyGBC
+GEMBBYQwoy2tNdq3iDUsYjU3s4g8BuNJMotYxgIyWUsIC8lnAStZomOGsECnH8JSVhJCIWu5kQKW
+spAQ8ljFChboME8Yt6pw3eqlS/KLQsIX9g+JjoqKCblxXcjYpUVrilYvWrAiImTiyoWDQ8YUFITM
+MGOtCZmxaM2i1Tcvyhvsmbxo2YLMtSEL8xesXLJoTciC1YtClq4MKVx7Y8HShSF5q1YsWLrSk+tK
+OlPzWMpicN8+c9HqpYthLKsoIA/GrirI+/W7Qq5GbH9/yP/OXZk63hqWskrXVzSDiSEeMhetXrN0
+1cqQ6MEx8dcmfjXpQdcnrVMe9EvlWazTd7Vpkbv9W8uzeNXKojUhRYsWrEC3exGFDCeSSPLcadzM
+WgazhlWsZTULWaTTW63bfDArWUQR5BcVFQ6PjMxbtGzBzWsHr1m1dvXCRYtXrV6yaPDKRUWQ0q4E
+rX2kta/+vG+aYWa/W6T77yIKWMUt9Nc99f9M/zN7sucv5uxqkwWEXFPmn481Twb9b/ybuf9/YvyG
+/Becl7prMUSHL9B9YIWu1eWEsIrFv5hC+7KYzNJ1eit0ald7tyvtfB22yM1ric5lpe6VeTqdxTp0
+UVturhZ29bYIXa5VuoQr9f2F7hHkymEVN1LkbuGlule4uCx013RrmkW6FNeOiwUs1PFWUOhOvTUF
+M7ar7K6etIiVulbN1urdrpf01i1n3pun7TW6XAtZygI3P1cfXMhaVuhUinRIa/0sZikF7n4c3lbG
+qzmY84pZ/iJucfdzM8erdWJeKWQ1q8hjrS7n1dLksdTdYku5kbW6PFfr4ddziHCPpYUUsJa8tjq5
+RfeBfD0nFLlrZgVLr2PUmv7qa3qlq7RrWeNOv7V1TPcKFrVr66vjdw35+sov8Yho4xmp56UQnbJr
+PLjSXuqu1Wtb/z+zbq05V2kL23p00XW97iqjW3R9rPhv5dA6GhbrOXWlm+GidjnmaTTziNC2WRPL
+WMRCitritO/HBe5ZsrWFFuq881ja1h5rGK5HZ4b7rgXcyCo9M1xtg/Zz0dUa+PlMsJJVOt2FbfNu
+a9zWsVL4i3NA+/tCNOcF7pa6kUXX9TVXbbhm8gX/oT1X6WdQiLvtV2h70f+gtc0463R5F+tZwEx7
+8DU19Z/uNetkXVv5V+jRt1SP5dYZzSx7kXvWc11xldSs07x2bd6+17U+v8xcXPW1lgLtu8ooT5fU
+bK+V7WpjCQWaTb772up2c+gCFrpLsqYtj+vrZ81/yan9HJd3TQ9bQMGvlOA/l+Ta/K6vl18qY4S7
+3Qv0fUv/w6y+2j0DLdLlW3FNuq1X1rT1zNZxc/1TZJF7vlt0TQvcolnl6ft7/8JzsXcb7+vvMOO3
+PnV7t+ttrrGTdt1z5kY97le1K+ta93hobYmbWcXSX6ixRRTrel7pHtGFFLqfYgv0zLqo7Y727e8q
+838eMfl6pg/R9hp3GRex8j/2Fxe7X5rD8/QTYaVu9/b11fs/yiu9r2nD/9Uxu8YtRoe4mbSOutYR
+tUA/31plkNXuO65NsVD36OUs0HFC3Lxcvern8sf/O2asX2d1o3uMFLmfi4vbaiqV8TqfaUwlQ+cz
+jRQymM0YZuiwicwkhHRmMI1MJpLMeJJ1u4xhpju8tx6Ns5moU5zGLJ2WK40ZjNFpzyFEpx2i/aZv
+MhOZSrK+dzxZOo/xzNSpTmOGTnsK6aQxkfHueOYd40hjFsnaPUFLo678pjJN22n6PrMsrpJmMK1d
+rteWaiLj3fmZJZvCeGYwjlR36BjGMlGnZ5Y/QteU6Z7aVs4Ud0nH6DoyUzbTHMcs0rTPvDqLGaQz
+jZm6Psdozq7STtUcUpjh5jJel8DVEq4SjWMa6czRMSaQSgYR7pwy3DH/X9S9Z3jUxv89Kmk06oIA
+KYQEspBK4oB3JO1KqWzRgAPYxJiQnqztxd6w9jq7a1pCQnrvCem9915J77333nvvldxnV8fGyff7
+/P6/53/f3JsXGe3uaM5nZqRzzmfQyA31Htb6k62fX0OdXv82iqwFs9wqxIa0MgljGcVRG/8dBpFn
+1/s/Q5hRH9taf2fXEUIhJcwcbHfg2plab2Hm4HU0p96/VH0cWuoI6fpvtVGsjeeMwZqtQ2YlUx+v
+2rw11DO8VL0nUwdn5989GWjtn7Pz366OAYSp9f6F9ZGaUa89W5glhEJGaBr8Jroem+p9zQhtg99l
+cN1H18SMIaObqfexNrPbC3NQI2rv372I7pBa/Kt6Ec1ACv/PDBmzVbPfjNnNDM51S/0q+89RmVu/
+F8N6rVR9rmcPjgKv378zEfmcIVfYwDzOwfXZMhjZP8d34D4aqPe/4Y6orQHsf85gtn49zUCEswdH
+4//cbsRdobCoznt90LXKfyj3UPe4ypUO9Z8NQ7h2qBOIWHhqvW7Pv+qt+jbi50izVuU8Qz3cf1Ou
+gSy54V/ud8B9RNwd5UZD3W9n3adHXrAy6Eoi/SgNOpOF9V/zQ7KWcj3a0r/yvUodN+pZP874d1uR
+v8wJRaBV/sto/k8K9e8Msa+u9xHKwvpxFc6k1r9+1K19v+RfWXH5X1nV/2kOBvryfxr/cn2++5BT
+FeojXPOTk9BueTA/WzUmtRGIVr96/jXrq66+Wmtb/IcPrdad8arIOzHj0UpaDdMQBF5fjKutZ9bW
+RAfXQmObVPL5WHu+WFo4cVLsf7H6OckwVp28Q76ci0UtD665Gpv/j/8Zxv/96mzsX8iFSiwXq5Zz
+nfmeXHl+rDTv360Yxqx8uadQqa94Fiqx7nw537441lXO9VbznQ2xeeV8vnZaR3eu3JVviFVLsVzv
+4lhfvlwp9cZK7dVcobfQ2xXLxTpKfYtrNavdeaxr5jo6Sj19ud7FtQrV7kIlVix05Hsr+dgmE+pD
+MmFiLNfbGctVKqWOQq6a74x1ljr6e/K91Vy1Fs+8QjFfiW1Sa7F+Qmx2aV51Ya6cnzCxHkk531cu
+dfZ35OvNdBYq1XKhvb+ar8fwjxMaYoXejmJ/Zy2ShYVqd6m/GisWegoAqtUvR0NZLcX6K/mGenca
+Yj35eq/r81vpbhiC0VDDnFwqxyr5YrFWu5CvDHT/X9C14Kql2pj1FKoYujrQwu5Sz3+eUJuGef3l
+3kKlO18/sbMUq5QaYpX+9r3yHdXaN9EYF4ulhbUOdZR6Owu1flS2MIy27nws115akK/3ILqK6gEM
+XgS9pWqhI1+Jvq3NSt+qKyD6LVbpzhWLsfY8Ri3fWbvIc//oZ6k3HyuVYz2lcv6/djtWXdyXn5fr
+yFcmRUH989ee3OJa+z2lzsK8Qu1CyxWr+XLtoFSO5To76z2Phq52f+XK1UJHfzFXrgN15iuFrt56
+GF3FxX3dldpJtSs011HNlyu1MwbiqfwbKbriOqMByxWHNPCvRnDeQCyrWmyIlXqLi2OFf1zq5Xys
+nO/N9UR1aweV2mDW5mbgFskXqt35qAMLS+XOSmzC4L04IVZa9UNsQu3WnVAftkIlNgP3THu+o1Rv
+tb9YrHdiQakwGFh+UTXfW43l+vqKhY5cezFf+yHqf6n874npzlVj3blKrD2f7/3nuBQqQ67wzlh/
+bycCnvBPXpkQ9fB/mtlKqVi7s+tTV5uoXKxYY5ByrDJQsS/XMT/XlY+191djvaVB/vjfX1j/gGpf
+HCtUK/nivFpQ08IYb2lui81u4W1zU61hrGl2bFZryw5N2TAbm5CaHWuaPaEhNrepbVrLnLbY3FRr
+a6q5badYC4+lmneKTW9qzjbEwh1ntYazZ8daWmNNM2fNaAqzDbGm5syMOdmm5qmx9Jy2WHNLW2xG
+08ymtjAba2upn4qmmsLZtcZmhq2ZaanmtlS6aUZT204NMd7U1lxrk7e0xlKxWanWtqbMnBmp1tis
+Oa2zWmaHsVRzNtbc0tzUzFubmqeGM8PmtlpDmZZZO7U2TZ3W1hCblWoLm9saYm2tqWw4M9U6vaEW
+YUvbtLA1Vq8yKdbUHGtuiYU71E6ePS01Y0Ys3dQ2u601TM2s1a2NztTmlpm1MZrTnE21NbU0x9Jh
+bEZTKj0jjGJr3imWmZFqmtkQy6ZmpqaGs1eBzGga7M6q4aidMDVsDltTMxpis2eFmabaQVNztqk1
+zLTVazZlw+a22rctrbFMS/PscPs5YXNbU2rGAERDbO60sA7R1BxLNcdSmXpk9e43t7Wmau20tbS2
+DYYyt2l22BBLtTbNroXAW1tmNsRq89nC632cMzusT14z4q3NUe27/7w6WlrrZ6OD2TA1o6l56uxa
+GP9Rd5JhhIs68n3V2rWNmzuixzqVRvzZUL9qIxIozYtN7S314Lv6YYyX+ns768oTMdyqm6smyQ2g
+3xp9tOdrahTRb+eCfLlaqNSopFSOlWpksrBQqd/pfeVSTwm6V8kV8w21swZrxTrzuWKht6syGOY/
+b6gBQewrF0rl2MJyoVrN98Zy/dXuUrmwBFJcLvX81x7UUP4dfzlf6ct3VAsL8sXFk2K8VK7pWT2S
+Qu+8UrkHXa8PX0d1iwEOrca66o13lqqxUrlrUsz4f/OvopPrLni+UBAm151jZ309blJ9bbRPmPyv
+db7/+d9QJy8szC9MLvR25hdN6uvumwxO/r/9Z25BEur//f2qsPN/+7vj9g2CIIiCJBBBFqigCKqg
+CbpgCKZgCbYwTBgurCaMEEYKo4TVhTWENYW1hNHC2sIYYR1hXWGsME5YT4gJ44UJwvrCBvW/Y7yx
+sIkwUdhU2ExoEDYXJgmThUYhLjDBEVzBExJCUvCFQNhC2FLYStha2EbYVphSz0Ez9VyR11cumoTt
+hOnCDGFmfdVhlrC90FpfnZgj7CDMFXYUan8HbRdhV2E3YXdhD2FPISdcJVwoHCwcItwlLBc+FQ4V
+jhWOEs4WLhcuEiXhSOF14SDhJOF74QfhGOFU4XDhAeFt4TvhHOEK4SfhR+Fn4QLhauEx4RHhGqFd
+6BCOFzqFJ4S88KjwuPCM8KTwlPC08JkwT3hBeFZ4TrhW6BK+FU4QXhZeFF4SuoUvhK+EI4S96vPf
+IxSFXuE8oSTsXc+DKvV8Z4GwUPhcWCQsERYL+whLhX2F24Tzhf2F/YRlwgHCl8LXwh0iEWWRioqo
+iprwl7BS1EVDNEVL+FsURFscJg4XRXE1cYQ4Uhwlri6uIa4priWOFtcWx4jrCL8Kv4nrimPFceJ6
+YkwcL04Q1xc3EDcUNxI3FjcRJ4qbipsJvwuviA3i5uIkcbLYKMZFJjqiK3piQkyKvhiIWwjvCx+I
+W4pbiVuL24jbilPElJgWM2JWDEUuThWniU3CdcL14nbidHGGOFNsFlvEWeL2Yqs4W/hD+FP4UPhI
+bBPniDuIc8UdxZ3EncVdxF3F3cTdxT3EPcWc2C52iJ1iXpwndondYkFYIe4lzheLYo/wsfCJ2CuW
+xD5xb7EsVsSq2C8uEBeKi8TF4hJxH3Ffcam4n7i/uEy4RDxAPFA8SDxYPEQ8VDxMPFw8QjxSPEo8
+WjxGPFY8TjxePEE8UTxJPFk8RVwuniqeJp4uniGeKZ4lni2eI54rnieeL14gXiheJF4sXiJeKl4m
+Xi5eIV4pXiVeLV4jXiteJ14v3iDeKN4k3izeIt4q3ibeLt4hrhDvFO8S7xbvEe8V7xPvFx8QHxQf
+Eh8WHxEfFR8THxefEJ8UnxKfFp8RnxWfE58XXxBfFF8SXxZfEV8VXxNfF98Q3xTfEt8W3xHfFd8T
+3xc/ED8UPxI/Fj8RPxU/Ez8XvxC/FL8Svxa/Eb8VvxO/F38QfxR/En8WfxF/FX8Tfxf/EP8U/xJX
+in9LgiRKkkQkWaKSIqmSJumSIZmSJdnSMGm4tJo0QhopjZJWl9aQ1pTWkkZLa0tjpHWkdaWx0jhp
+PSkmjZcmSOtLG0gbShtJG0ubSBOlTaXNpAZpc2mSNFlqlOISkxzJlTwpISUlXwqkLaQtpa2kraVt
+pG2lKVJKSksZKSuFEpemStOkJmk7abo0Q5opNUst0ixpe6lVmi21SXOkHaS50o7STtLO0i7SrtJu
+0u7SHtKeUk5qlzqkTikvzZO6pG6pIO0lzZeKUo/UK5WkPmlvqSxVpKrULy2QFkqLpMXSEmkfaV9p
+qbSftL+0TDpAOlA6SDpYOkQ6VDpMOlw6QjpSOko6WjpGOlY6TjpeOkE6UTpJOlk6RVounSqdJp0u
+nSGdKZ0lnS2dI50rnSedL10gXShdJF0sXSJdKl0mXS5dIV0pXSVdLV0jXStdJ10v3SDdKLwqvCfd
+JN0s3SLdKt0m3S7dIa2Q7pTuku6W7hHeEN4U3hLeFV4T3pHule6T7pcekB6UHpIelh6RHpUekx6X
+npCelJ6SnpaekZ6VnpOel16QXpRekl6WXpFelV6TXpfekN6U3pLelt6R3pXek96XPpA+lD6SPpY+
+kT6VPpM+l76QvpS+kr6WvpG+lb6Tvpd+kH6UfpJ+ln6RfpV+k36X/pD+lP6SVkp/E4GIRCKEyIQS
+hahEIzoxiEksYpNhZDhZjYwgI8kosjpZg6xJ1iKjydpkDFmHrEvGknFkPRIj48kEsj7ZgGxINiIb
+k03IRLIp2Yw0kM3JJDKZNJI4YcQhLvFIgiSJTwKyBdmSbEW2JtuQbckUkiJpkiFZEgrnEk6mkmmk
+iWxHppMZZCZpJi1kFtmetJLZpI3MITuQuWRHshPZmewinEl2JbuR3ckeZE+SI+2kg3SSPJlHukg3
+KZC9yHxSJD2kl5RIH9mblEmFVEk/WUAWkkVkMVlC9iH7kqVkP7I/WUYOIAeSg8jB5BByKDmMHE6O
+IEeSo8jR5BhyLDmOHE9OICeSk8jJ5BSynJxKTiOnkzPImeQscjY5h5xLziPnkwvIheQicjG5hFxK
+LiOXkyvIleQqcjW5hlxLriPXkxvIjeQmcjO5hdxKbiO3kzvICnInuYvcTe4h95L7yP3kAfIgeYg8
+TB4hj5LHyOPkCfIkeYo8TZ4hz5LnyPPkBfIieYm8TF4hr5LXyOvkDfImeYu8Td4h75L3yPvkA/Ih
++Yh8TD4hn5LPyOfkC/Il+Yp8Tb4h35LvyPfkB/Ij+Yn8TH4hv5LfyO/kD/In+YusJH/LgizKkkxk
+WaayIquyJuuyIZuyJdvyMHm4vJo8Qh4pj5JXl9eQ15TXkkfLa8tj5HXkdeWx8jh5PTkmj5cnyOvL
+G8gbyhvJG8ubyBPlTeXN5AZ5c3mSPFlulOMykx3ZlT05ISdlXw7kLeQt5a3kreVt5G3lKXJKTssZ
+OSuHMpenytPkJnk7ebo8Q54pN8st8ix5e7lVni23yXPkHeS58o7yTvLO8i7yrvJu8u7yHvKeck5u
+lzvkTjkvz5O75G65IO8lz5eLco/cK5fkPnlvuSxX5KrcLy+QF8qL5MXyEnkfeV95qbyfvL+8TD5A
+PlA+SD5YPkQ+VD5MPlw+Qj5SPko+Wj5GPlY+Tj5ePkE+UT5JPlk+RV4unyqfJp8unyGfKZ8lny2f
+I58rnyefL18gXyhfJF8sXyJfKl8mXy5fIV8pXyVfLV8jXytfJ18v3yDfKN8k3yzfIt8q3ybfLt8h
+r5DvlO+S75bvke+V75Pvlx+QH5Qfkh+WH5EflR+TH5efkJ+Un5Kflp+Rn5Wfk5+XX5BflF+SX5Zf
+kV+VX5Nfl9+Q35Tfkt+W35Hfld+T35c/kD+UP5I/lj+RP5U/kz+Xv5C/lL+Sv5a/kb+Vv5O/l3+Q
+f5R/kn+Wf5F/lX+Tf5f/kP+U/5JXyn9TgYpUooTKlFKFqlSjOjWoSS1q02F0OF2NjqAj6Si6Ol2D
+rknXoqPp2nQMXYeuS8fScXQ9GqPj6QS6Pt2Abkg3ohvTTehEuindjDbQzekkOpk20jhl1KEu9WiC
+JqlPA7oF3ZJuRbem29Bt6RSaommaoVkaUk6n0mm0iW5Hp9MZdCZtpi10Ft2ettLZtI3OoTvQuXRH
+uhPdme5Cd6W70d3pHnRPmqPttIN20jydR7toNy3Qveh8WqQ9tJeWaB/dm5ZphVZpP11AF9JFdDFd
+Qveh+9KldD+6P11GD6AH0oPowfQQeig9jB5Oj6BH0qPo0fQYeiw9jh5PT6An0pPoyfQUupyeSk+j
+p9Mz6Jn0LHo2PYeeS8+j59ML6IX0InoxvYReSi+jl9Mr6JX0Kno1vYZeS6+j19Mb6I30JnozvYXe
+Sm+jt9M76Ap6J72L3k3voffS++j99AH6IH2IPkwfoY/Sx+jj9An6JH2KPk2foc/S5+jz9AX6In2J
+vkxfoa/S1+jr9A36Jn2Lvk3foe/S9+j79AP6If2Ifkw/oZ/Sz+jn9Av6Jf2Kfk2/od/S7+j39Af6
+I/2J/kx/ob/S3+jv9A/6J/2LrqR/K4IiKpJCFFmhiqKoiqboiqGYiqXYyjBluLKaMkIZqYxSVlfW
+UNZU1lJGK2srY5R1lHWVsco4ZT0lpoxXJijrKxsoGyobKRsrmygTlU2VzZQGZXNlkjJZaVTiClMc
+xVU8JaEkFV8JlC2ULZWtlK2VbZRtlSlKSkkrGSWrhApXpirTlCZlO2W6MkOZqTQrLcosZXulVZmt
+tClzlB2UucqOyk7Kzsouyq7Kbsruyh7KnkpOaVc6lE4lr8xTupRupaDspcxXikqP0quUlD5lb6Ws
+VJSq0q8sUBYqi5TFyhJlH2VfZamyn7K/skw5QDlQOUg5WDlEOVQ5TDlcOUI5UjlKOVo5RjlWOU45
+XjlBOVE5STlZOUVZrpyqnKacrpyhnKmcpZytnKOcq5ynnK9coFyoXKRcrFyiXKpcplyuXKFcqVyl
+XK1co1yrXKdcr9yg3KjcpNys3KLcqtym3K7coaxQ7lTuUu5W7lHuVe5T7lceUB5UHlIeVh5RHlUe
+Ux5XnlCeVJ5SnlaeUZ5VnlOeV15QXlReUl5WXlFeVV5TXlfeUN5U3lLeVt5R3lXeU95XPlA+VD5S
+PlY+UT5VPlM+V75QvlS+Ur5WvlG+Vb5Tvld+UH5UflJ+Vn5RflV+U35X/lD+VP5SVip/q4IqqpJK
+VFmlqqKqqqbqqqGaqqXa6jB1uLqaOkIdqY5SV1fXUNdU11JHq2urY9R11HXVseo4dT01po5XJ6jr
+qxuoG6obqRurm6gT1U3VzdQGdXN1kjpZbVTjKlMd1VU9NaEmVV8N1C3ULdWt1K3VbdRt1SlqSk2r
+GTWrhipXp6rT1CZ1O3W6OkOdqTarLeosdXu1VZ2ttqlz1B3UueqO6k7qzuou6q7qburu6h7qnmpO
+bVc71E41r85Tu9RutaDupc5Xi2qP2quW1D51b7WsVtSq2q8uUBeqi9TF6hJ1H3Vfdam6n7q/ukw9
+QD1QPUg9WD1EPVQ9TD1cPUI9Uj1KPVo9Rj1WPU49Xj1BPVE9ST1ZPUVdrp6qnqaerp6hnqmepZ6t
+nqOeq56nnq9eoF4o3CDcqF6kXqxeItwi3Co8qF4q3CTcLDykXiYcKNwvHKZerl6hXilcqV6lXq1e
+o16rXqdeLzys3qDeqN6k3qzeot6q3qbeLtwt3KPeoa5Q71TvEu5U71bvUe9V71PvVx9QH1QfUh9W
+H1EfVR9TH1efUJ9Un1KfVp9Rn1WfU59XX1BfVF9SX1ZfUV9VX1NfV99Q31TfUt9W31HfVd9T31c/
+UD9UP1I/Vj9RP1U/Uz9Xv1C/VL9Sv1a/Ub9Vv1O/V39Qf1R/Un9Wf1F/VX9Tf1f/UP9U/1JXqn9r
+giZqkkY0WaOaoqmapumaoZmapdnaMG24tppwtDZCG6mN0lbX1tDW1NbSRmtra2O0dbR1tbHaOG09
+LaaN1yZo62sbaBtqG2kba5toE7VNtc20Bm1zbZI2WWvU4hrTHM3VPC2hJTVfC7QttC21rbSttW20
+bbUpWkpLaxktq4Ua16Zq07QmbTttujZDm6k1ay3aLG17rVWbrbVpc7QdtLnajtpO2s7aLtqu2m7a
+7toe2p5aTmvXOrROLa/N07q0bq2g7aXN14paj9arlbQ+bW+trFW0qtavLdAWaou0xdoSbR9tX22p
+tp+2v7ZMO0A7UDtIO1g7RDtUO0w7XDtCO1I7SjtaO0Y7VjtOO147QTtRO0k7WTtFW66dqp2mna6d
+oZ2pnaWdrZ2jnaudp52vXaBdqF2kXaxdol2qXaZdrl2hXaldpV2tXaNdq12nXa/doN2o3aTdrN2i
+3ardpt2u3aGt0O7U7tLu1u7R7tXu0+7XHhBO0x7UHhIu0x7WHtEe1R7THhfO0J4QThe+0Z7UntKe
+1p7RntWeEy7Wntde0F4UTtRe0l7WXtFe1V7TXtfe0N7U3tLeFs7S3tHe1d7T3tc+0D7UPtI+1j7R
+PhUu1T7TPte+0L7UvtK+Fo7TvhFOFk7RvtW+077XftB+1H7SftZ+0X7VftN+1/7Q/tT+0lZqf+uC
+LuqSTnRZp7qiq7qm67qhm7ql2/owfbi+mj5CH6mP0lfX19DX1NfSR+tr62P0dfR19bH6OH09PaaP
+1yfo6+sb6BvqG+kb65voE/VN9c30Bn1zfZI+WW/U4zrTHd3VPT2hJ3VfD/Qt9C31rfSt9W30bfUp
+ekpP6xk9q4c616fq0/QmfTt9uj5Dn6k36y36LH17vVWfrbfpc/Qd9Ln6jvpO+s76Lvqu+m767voe
++p56Tm/XO/ROPa/P07v0br2g76XP14t6j96rl/Q+fW+9rFf0qt6vL9AX6ov0xfoSfR99X32pvp++
+v75MP0A/UD9IP1g/RD9UP0w/XD9CP1I/Sj9aP0Y/Vj9OP14/QT9RP0k/WT9FX66fqp+mn66foZ+p
+n6WfrZ+jn6ufp5+vX6BfqF+kX6xfol+qX6Zfrl+hX6lfpV+tX6Nfq1+nX6/foN+o36TfrN+i36rf
+pt+u36Gv0O/U79Lv1u/R79Xv0+/XH9Af1B/SH9Yf0R/VH9Mf15/Qn9Sf0p/Wn9Gf1Z/Tn9df0F/U
+X9Jf1l/RX9Vf01/X39Df1N/S39bf0d/V39Pf1z/QP9Q/0j/WP9E/1T/TP9e/0L/Uv9K/1r/Rv9W/
+07/Xf9B/1H/Sf9Z/0X/Vf9N/1//Q/9T/0lfqfxuCIRqSQQzZoIZiqIZm6MLthmGYhmXYxjBjuLGa
+McIYaYwyVjfWMNY01jJGG2sbY4x1jHWNscY4Yz0jZow3JhjrGxsYGxobGRsbmxgTjU2NzYwGY3Nj
+kjHZaDTiBjMcwzU8I2EkDd8IjC2MLY2tjK2NbYxtjSlGykgbGSNrhAY3phrTjCZjO2O6McOYaTQb
+LcYsY3uj1ZhttBlzjB2MucaOxk7GzsYuxq7Gbsbuxh7GnkbOaDc6jE4jb8wzuoxuo2DsZcw3ikaP
+0WuUjD5jb6NsVIyq0W8sMBYai4zFxhJjH2NfY6mxn7G/scw4wDjQOMg42DjEONQ4zDjcOMI40jjK
+ONo4xjjWOM443jjBONE4yTjZOMVYbpxqnGacbpxhnGmcZZxtnGOca5xnnG9cYFxoXGRcbFxiXGpc
+ZlxuXGFcaVxlXG1cY1xrXGdcb9xg3GjcZNxs3GLcatxm3G7cYaww7jTuMu427jHuNe4z7jceMB40
+HjIeNh4xHjUeMx43njCeNJ4ynjaeMZ41njOeN14wXjReMl42XjFeNV4zXjfeMN403jLeNt4x3jXe
+M943PjA+ND4yPjY+MT41PjM+N74wvjS+Mr42vjG+Nb4zvjd+MH40fjJ+Nn4xfjV+M343/jD+NP4y
+Vhp/m4IpmpJJTNmkpmKqpmbqpmGapmXa5jBzuLmaOcIcaY4yVzfXMNc01zJHm2ubY8x1zHXNseY4
+cz0zZo43J5jrmxuYG5obmRubm5gTzU3NzcwGc3NzkjnZbDTjJjMd0zU9M2EmTd8MzC3MLc2tzK3N
+bcxtzSlmykybGTNrhiY3p5rTzCZzO3O6OcOcaTabLeYsc3uz1ZxttplzzB3MueaO5k7mzuYu5q7m
+bubu5h7mnmbObDc7zE4zb84zu8xus2DuZc43i2aP2WuWzD5zb7NsVsyq2W8uMBeai8zF5hJzH3Nf
+c6m5n7m/ucw8wDzQPMg82DzEPNQ8zDzcPMI80jzKPNo8xjzWPM483jzBPNE8yTzZPMVcbp5qnmae
+bp5hnmmeZZ5tnmOea55nnm9eYF5oXmRebF5iXmpeZl5uXmFeaV5lXm1eY15rXmdeb95g3mjeZN5s
+3mLeat5m3m7eYa4w7zTvMu827zHvNe8z7zcfMB80HzIfNh8xHzUfMx83nzCfNJ8ynzafMZ81nzOf
+N18wXzRfMl82XzFfNV8zXzffMN803zLfNt8x3zXfM983PzA/ND8yPzY/MT81PzM/N78wvzS/Mr82
+vzG/Nb8zvzd/MH80fzJ/Nn8xfzV/M383/zD/NP8yV5p/W4IlWpJFLNmilmKplmbplmGZlmXZ1jBr
+uLWaNcIaaY2yVrfWsNa01rJGW2tbY6x1rHWtsdY4az0rZo23JljrWxtYG1obWRtbm1gTrU2tzawG
+a3NrkjXZarTiFrMcy7U8K2ElLd8KrC2sLa2trK2tbaxtrSlWykpbGStrhRa3plrTrCZrO2u6NcOa
+aTVbLdYsa3ur1ZpttVlzrB2sudaO1k7WztYu1q7Wbtbu1h7WnlbOarc6rE4rb82zuqxuq2DtZc23
+ilaP1WuVrD5rb6tsVayq1W8tsBZai6zF1hJrH2tfa6m1n7W/tcw6wDrQOsg62DrEOtQ6zDrcOsI6
+0jrKOto6xjrWOs463jrBOtE6yTrZOsVabp1qnWadbp1hnWmdZZ1tnWOda51nnW9dYF1oXWRdbF1i
+XWpdZl1uXWFdaV1lXW1dY11rXWddb91g3WjdZN1s3WLdat1m3W7dYa2w7rTusu627rHute6z7rce
+sB60HrIeth6xHrUesx63nrCetJ6ynraesZ61nrOet16wXrResl62XrFetV6zXrfesN603rLett6x
+3rXes963PrA+FO4V7rM+sj62PrE+tT6zPre+sL60vrK+tr6xvrW+s763frB+tH6yfrZ+sX61frN+
+t/6w/rT+slZaf9uCLdqSTWzZprZiq7Zm67Zhm7Zl2/Ywe7i9mj3CHmmPsle317DXtNeyR9tr22Ps
+dex17bH2OHs9O2aPtyfY69sb2BvaG9kb25vYE+1N7c3sBntze5I92W604zazHdu1PTthJ23fDuwt
+7C3treyt7W3sbe0pdspO2xk7a4c2t6fa0+wmezt7uj3Dnmk32y32LHt7u9WebbfZc+wd7Ln2jvZO
+9s72Lvau9m727vYe9p52zm63O+xOO2/Ps7vsbrtg72XPt4t2j91rl+w+e2+7bFfsqt1vL7AX2ovs
+xfYSex97X3upvZ+9v73MPsA+0D7IPtg+xD7UPsw+3D7CPtI+yj7aPsY+1j7OPt4+wT7RPsk+2T7F
+Xm6fap9mn26fYZ9pn2WfbZ9jn2ufZ59vX2BfaF9kX2xfYl9qX2Zfbl9hX2lfZV9tX2Nfa19nX2/f
+YN+oV+ZVuxf3ded71VRPrqNc6lVzPblaqaTay/kFeSVXL9RUqavUm5+v5qLSzHQUyh39PfOK+UVm
+x6pjI9NZquY6OvK9VaNj8FDJduRqTXZGRbajXMpV1RCAeQCGEWC+Xhjhqobyg4dqiDDyUamEUYv5
+emFOHRJU15Cgpq5qq2vw0JraUerpyeFD15AP5rQh7XSvOpantefKcnd7rqw0VQvFzrxSqBdqE3pS
+QE+aop4U6oXahJgLUSk1bScV9jK3G4Kx16pja/rQqOb/40NXOZ/vLeZ6OwsdyoxcR381rxTrhTVj
+aL3ikA/KjGiAivVCntFZqsrFzlJVaY7O743Obx56fu/Q85uj83ujAe7N9ZUq1XKprztPwt4uku/t
+UlvQ+RI63xJ1vlQv7Jbu/t6uXLm/p5jrr9qloZ+U1iiGchRD69AYykNjaI1iKEfF7OisSr0wZw8Z
+xsqQYWwb2lp1aGttUTPVaETaalNarU3pnGhK++uFOge96kev5kS96q8XdE650NtF+2v/t+f8o4f9
+Qz+pczD1/bhr5g6JduGQ452GHC9edazsHPV1Sb0wdl51GS8ZPKTFUm9XRe3vLTTG/UaUcZQMpYPS
+RemhTKBMovRRBihTKNMoMyizKEOUPCoD4AfAD4AbADcAbgDcALgBcAPgBsANgBsANwBuANyA05bu
+UrmXlmr/j75Loc8pYKeAnQJ2CtgpYKeAnQJ2CtgpYKeAnQJ2KqRz6pj9qzDT6G8a2Glgp4GdBnYa
+2Glgp4GdBnYa2Glgp4GdBnYa/U5jvDMY7wzwM8DPAD8D/AzwM8DPAD8D/AzwM8DPAD8D/AzwM8DP
+AD8L/Czws8DPAj8L/Czws8DPAj8L/Czws8DPAj8L/Czws8DPAj8Efgj8EPgh8EPgh54yNbrru+oF
+vgV6CPQQ6CHQQ6CHQA+BHgKdA50DnQOdA50DnaP3HL3n6D0HPg+MVI1Jops8N3iopsKozOXrpdlS
+KeYq3dFxadVxvRXW2IgyjpKhdFC6KD2UCZRJlD7KAGUKZRplBmUWZYgyGg0WB34c+HHgx4EfB34c
++HHgx5PW7KFcXRnyATUQSRyRxBFJHJHEEUkckTBEwhAJQyQMkTBEwhAJQyQMI8EwEgwjwYDPgM+A
+z4DPgM+A7wDfAb4DfAf4DvAd4DsJrbNULeYrlb3wBQJwEICDABwE4CAABwE4CMBBAC4CcBGAiwBc
+BOAiABcBuBgAFwPgAt8Fvgt8F/gu8F3gu8B3ge8B3wO+B3wP+B7wPeB7wPeA7wHfA74HfA/4HvA9
+4HvA94CfAH4C+AngJ4CfAH4C+AngJ4CfAH4C+AngJ4CfAH4C+AngJ4CfBH4S+EngJ4GfBH4S+Eng
+J4GfBH4S+EngJ4GfBH4S+EngJ4EPG8BgAxhsAIMNYLABDDaAwQYw2AAGG8BgAxhsAIMNYLABDDaA
+wQYw2AAGG8BgA1gAfNgBBjvAYAcY7ACDHWCwAwx2gMEOMNgBBjvAYAcY7AALgJ8Cfgr4sAQMloDB
+EjBYAgZLwGAJGCwBgyVgsAQMloDBEjBYApYCfgr4aeDDFjDYAgZbwGALGGwBgy1gsAUMtoDBFjDY
+AgZbwGALGGwBgy1gsAUMtoDBFjDYAgZbwGALGGwBgw1gsAEMNoDBBjDYAAYbwCD7DLLPIPsMss8g
+8wwyzyDbDHLNINcMcs0g1wxyzULEFaKdEO1AsBkEm0GgWTjQPvoPYWYQYsaTelc5t6C2d6Bdr0tm
+7aj+m9PI9LrhH/KNi9JDmUCZROnb3aXS/Pr+kiFnpVCmUWZQZlGGKKMYHcilA7l0IJcO5NKBXDqQ
+Swdy6cQRRdxHGaAEPkTSgUg6EEkHIulAJB2IpAORdBizOkvV+g6vVZ2CUjpQSgdK6UApHSilA6V0
+oJQOlNKBUjpQSgdK6UApHSilA6V0oJQOlNKBUjpQSscBvgN8CKUDoXQglA6E0oFQOhBKB0LpQCgd
+CKID4XIgPA4ExIGAOCB0JznwGXGC0B0QugNCd0DoDgjdAYE7IHAHBO6AwB0QuJPktFrqLVXszkK+
+nK8UKvVPRqrY152rH+q53lI1X8wXclbYVykUS731r7Wwit+bSjiyWnoKtcQ1+jBnSGWjpSffFVUa
+UShVc//AonUsOZ2v5ujUXE9PLgoscFXgyTvnqzkSVnO0rTtfzck1QDo919eXU2bketo7c9LMfqm5
+X9qxoCICaVaBtHaX6OxCV0+OtOX6VURDZnUXSKa7QGZVClbTkEiGo8LAZyM3OABWfmi38wPdLgx0
+e/X+f54adap+vtxe61RXrVO0M1+s5lS0JS+pdan2Y7XepVpjdH69S8V6l6IxSGek3n5pUUEtRf0i
+5e6SUql1Kk7rBanm+lXgk77uAunoLpC+SoGWagNuDR3r4f8K0yoNna3+obNVGpytKAzkXk62UamH
+G9cwWrg2QcJO1pX7ugtxpX7+wG+4HpF3Oci7HORdDvIuB3mXg7zLAYE7yLscELkDIndAuA4f+Iz7
+AwTsIBNykAk5yIQcZEIOMiGHIx6OeDji4YiHIx6OeHgUj4vcx0Xu4yL3cZH7uKB0F5TugtJdULqL
+3MdF7uOCzF2QuQsyd0HmLsjcBZm7IHMXZO6CzF2QuQsyd0HmLsjcBZm7IHMXZO6CzF2QuQsyd0Hm
+LsjcBZm7IHMXZO4i43GR8bjgcRc87oLHXfC4Cx53weMueNwFj7vgcRc87oLHXfC4Cx53weMueNwF
+j7vgcRc87oLHXfC4Cx53weMueNwFj7vgcRc87oLHXfC4i4THRcLjIuFxwe8uEh4XCY+LhMdFwuMi
+4XGR8LhIeFwkPC4SHhcJj4uEx4VuuEh4XCQ8LhIeFwmPi4THRcLjIuFxkfC40B0XCY+LhMdFwuMi
+4XGR8LhIeFwkPC4SHBcJjosEx0WC4yKBcZHAuEhgXCQwLvTNhb650DcX+uZC31zomwt9c6FvLhIC
+FwmBiwTARQLgIgFwkQC4SABcJAAuEgAXCYCLBMBFAuAiAXCRALgw/C4MvwvD78LwuzD8Lgy/C8Pv
+wvC7MPwuDL8Lg+/C4Lsw+C4MvguD78LguzD4Lgy+C4PvwuC7MPguDL4Lg+/C4Lsw9C4MvQvD7sKw
+uzDsLgy7C8PuYt3OhWF3MwPnI15ogwuD7sKguzDoLrTBxbqci3U5F4bdhT640AcX+uBCH1zogwt9
+cKEPLvTBhT640AcX+uDC6Lsw+i6Mvguj78LouzD6Loy+C6PvYmXOxcqcC+PvYmXORQLgIgFwoUcu
+9MiFHrnQIxd65EKPXOiRCz1yoUcu9MgDv3vgdw/87oHfPfC7B373wN8e+NsDf3vgbw886YEnPfCk
+B570wJMeeNIDT3rgSQ886YEnPfCkB570nAGcEGU0Lh540gNPeuBJDzzpgSc98KQHnvTAkx540gNP
+euBJDzzpgSc98KQHnvTAkx540gNPeuBJDzzpgSc98KQHnvTAix540QMveuBFD7zogRc98KIH/+6B
+Hz3wowd+9MCPHhaAPCwAeVgA8rAA5IE/PfCnB/70wJ8eFoA8LAB54FMPfOqBTz3wqQc+9cCnHvjU
+A596WADysADkIX/wkD94yB885A8e8gcPC0AeFoA8LAB5WADysADkYQHIwwKQhwUgDwtAHhZ+PCz8
+hNH1E2+MeDbeGPFsvDHi2XhjxLPxxohn440Rz8YbI56NN0Y8G2+MFlbijdHCSrwx4t14Y8S78caI
+d+ONEe/GGyPejTemgJ8Gfhr4aeCngZ8Gfhr4aeCngZ8Gfhr4aeCngZ8Gfhr4aeCngZ8Bfgb4GeBn
+gJ8Bfgb4WdTPon4W9bOon0X97EB9xJtFvFnEm0W8WcSbRbxZxJtFvFnEm0W8IfBD4IfAD4EfAj8E
+fgj8EPgh8EPgh8APgR8CPwR+CPwQ+Bz4HPgc+Bz4HPgc+Bz4HPgc+Bz4HPgc+FFeEM9G/j+ejfx/
+PBv5/3g28v/xbOT/49nI/8ezkf+PZyP/H89G/j+ejfx/PBv5/3g28v/xbOMAThZliDLqZzYO/Djw
+48CPAz8O/Djw48CPAz8O/Djw48CPAz8O/Djw48CPA58BnwGfAZ8BnwGfAZ8BnwGfAZ8BnwGfAZ8B
+nwGfAZ8B3wG+A3wH+A7wHeA7wHeA7wDfAb4DfAf4DvAd4DvAd4APXsq6wHeB7wLfBb4LfBf4LvBd
+4LvAd4HvAt8Fvgt8F/gu8F3ge8D3gO8B3wO+B3wP+B7wPeB7wPeA7wHfA74HfA/4HvA94CeAnwB+
+AvgJ4CeAnwB+AvgJ4CeAnwB+AvgJ4CdRL4l6yYHvEVcScSURVxJx+cALUD9A/QD1A9QPUB/6koW+
+ZKEvWehLFvqShb5koS9Z6EsW+pKFvmShL1noSxb6koW+ZKEvWehLFvqShb5koS9Z6EsW+pKFvmSh
+L1noSxb6koW+ZKEvWehLFvqShb5koS9Z6EsW+pGFfmQzaD+D9jNoP1qoj4fgvRC8F4L3QvBeCN4L
+wXsheC8E74XgvbBxoL0UyjTKDMosyhBlFG8I3gvBeyF4LwTvheC9ELwXgvdC8F4I3gvBeyF4LwTv
+heC9ELwXgvdC8F4I3gvBeyF4LwTvheC9ELwXgvdC8F4I3gvBeyF4LwTvheC9ELwXgvdC8F4I3gvB
+eyF4LwTvheC9ELwXgvdC8F4I3gvBeyF4LwTvheC9ELwXgvcG/FgI3gvBeyF4LwTvheC9ELwXgvdC
+8F4I3gvBeyF4LwTvheC9ELwXgvdC8F4I3gvBeyF4LwTvheC9ELwXgvdC8F4I3gvBeyF4LwTvheC9
+ELwXgvdC8F4I3gvBeyF4LwTvheC9ELwXgvdC8F4I3gvBeyF4LwTvhQngJ4CfAH4C+EngJ4GfBH4S
++EngJ4GfBD74NASfhkngJ4EPfg3BryH4NQS/hkmuzK3/i5ayMCrmRs+JLawX+tyBZWV94cBRdJ4P
+fB/4PvB94PvA94HvA98Hvg98H/0P0P8A/Q/Q/wD9D9D/AP0PgB8APwB+APwA+NCLEHoRQi9C6EUI
+vQihFyH0IoRehNCLEHoRQi9C6EUIvQihFyH0IoRehNCLEHoRQi9C6EUIvQihFyH0IoRehNCLEHoR
+Qi9C6EUIvQihFyH0IoRehNCLEHoRQi9C6EUIvQiRj4TIR0LkIyHykRB6EkJPQuhJCD0JoSfhgJ5k
+gJ8Bfgb4GeBngJ8BPvKbEPlNiPwmRH4TIr8Jkd+EyG9C5Dch8psQ+U2I/CZEfhMivwmR34TIb0Lk
+NyHymxD5TYj8JkR+EyK/CZHfhMhvQuQ3IfKbEPlNiPwmRH4TIr8Jkd+EyG9C5Dch8puQx5Wdohtx
+cb3At0BHdhMiuwmR3YTIbkJkNyGymxDZDYe6c6g7h7pzqDuHunOoO4e6c6g7h7pzqDuHuvPGgfYz
+KLMoQ5RR7zjUnUPdOdSdQ9051J1D3TnUnEPNOdScQ8051JxDzTnUm0O9OdSbQ7051JtDvTnUm0O9
+OdSbQ7051JtDvTnUm7MBPPQP6s2h3hzqzaHeHOrNod4c6s2h3hzqzaHeHOrNod4c6s2h3hzqzaHe
+HOrNod4c6s2h3hzqzaHeHOrNodYcas2h1hxqzaHWHGrNoc4c6syhzhzqzKHOHOrMoc4c6syhyhxq
+zL2BdhE/1JdDfTnUl0N9OdSXQ3051JdDfTnUl0N9OdSXQ3051JdDfTnUl0N9OdSXQ3051JdDfTnU
+l0N9OdSXQ3051JdDfTnUl0N9OdSXQ325DzwfeD7wfOD5wEPWw6G2HGrLobYcasuhthxqy6G23B/A
+Q3+hthxqy6G2HGrLobYcasuhthxqy6G2HGrLobYcasuhthxqy6G2HGrLobYcasuhthxqy6G2HGrL
+obYcasuhthxqy6G2HGrLobYcasuhthxqy6G2HGrLobYcasuhthxqy6GyHCrLobIcKsuhshwqy6Gy
+HCrLobIcKsuhshwqy6GyHCrLobIc6sqhrhzqyqGuHOrKoa4c6sqhrhzqyqGuHOrKoa4c6sqhrhxq
+yqGmHGrKoaYcasqhphwqyqGiHCrKoaIcKsqhohwqyqGiHCrKoaIcKsqhohwqyqGiHCrKoaIcKsqh
+ohwqyrEqyLEqyKGbHHrJoZccesmhlxx6yQf0EquBnAMnekqANUY6yhojHWWNkY6yxkhHWWOko6wx
+0lHWGOkoa4x0lDVGOsoaIx1ljZGOssZIR1ljpKOsMdJR1tg4gMujMg78eNyYV+jqL+c7c5VufAXI
+eMKuv2e20lEq5zvbi/be/aXaq4AX5MuVfCfqoDnmDCv11rZE5XuL+VxnvjysurA05CNqIUiGIBmC
+ZAiSIUiGVp240lPorW+sydfepYxvMTQO4nQwNA6GxvH1/KKOYq6ns72Ib9C+g/ZdDLaLFly04KIF
+F4PrYnBdxOsiXhftuQPtIV4Pk+mhfQ+T6aFdD/USGPwE6idQP4H6CfQwgfFKAD8B/ATwE8BPoN0k
+2k2i3STaSaKfSfQziXiS6GcS/UwCLwm8JPCSwEsCLwk8H3g+8Hz0w0c/fOD7wPeB7wPfB74PfB/4
+PvB94PvA94EfADcAbgDcALgBcAPgBsANgBsANwBuANwAuAFwUwk57C+X8AGdTgM0DZA0QNJoNI1G
+02g0YnAWx50dxx0bxx0Zxx0Zj9ahWJwNlEmUKZRRcPHIEbJ45NBY3B34jPYjh8biuGjjuEjjkfNi
+cVykcY9ppd58tbtQ7tSrC0v1gwp+8lAiFFy/8WhphMU9dMFL6aXefL72KvNuq9pdzuO4Ys4rLBg4
+tir5BflefMB56DLugzjugzjugzjugzjug3gC8SQQTwLxJBBPAvHgfonjfonjfonjfonjfonjfonj
+fonjfokngZ8EPu6fOO6fOO6fOO6fOO6fOO6fOO6fOO6fOO6fOO6fOO6fOO4fbI1j2BrHsDWOYWsc
+w9Y4hq1xLO4Heq5cLi0s5udV1fpRf59RL+uvk49+7Cwt7I2O2kvVbh3VOnujJnArYJcbwy43hl1u
+DLvcGHa5MexyY9jlxrDLjcUDdCGFLqTQhRS6kEIXUuhCCl1IAT+VtAYja6/k8SWCSCGIFIJIIYgU
+gkghCNyS8TSCwK2JLW8MW95YPO0O68iVy4VcV76cr/aXMRZpxJLGWOAOxs43hp1vDDvfGHa+sfjA
+HZ1GGGmEkUEYGYSRQRgZhJHBWGQwFhngZ4CfAX4G+BngZ4CfAX4G+BngZ3g0lp3txdqVYQx86O+z
+Bw7r18dgrdolMvihdpVE7WQRVxZxZRFXFnFlEVcWcWURVxZxZRFXFnFlMS4hxiXEuIQYlxDjEmJc
+QuCHwA+BHwI/BH4I/BD4IfBD4IfAD4HPgc+Bz4HPgc+Bz4HPgc+Bz4HPgc+Bz4HPgc+BD48X51zv
+7y3U7FKuaOYXFSq1PwVQyEWmhDW6Wle59vBtb1XNF/O1P65hRA9S1w61Sn9Hd+0PAqByBIENbQwb
+2Bg2sDEW96xcpZovFyrze3KYTxatfTAWrX0w7FVjLJ6x+sqlvlI5+ksLRqlc7a7tBc4Vaa63qxjd
+jNiZxrAzjWFnGmMsYRRLXYWOXG3Tt47DUtkq9Nb+OkPt7eKlXtrfW4h2NDLsTGPYmcawkYxhIxnD
+RjJW20hWKfQUirkyPqM+9A0bxRg2cjFs5GLYyMWwkYthIxdjnmfm9+4vLMgV870d+XqP8+VKf3sl
+Xx028KH2v3xV7y1Vox+iM33PKudrW51Re+BDVBt10CtYE2w3YthuxLDdiGG7EcN2I4btRowFrlHb
+Ul3M9xX7K/gqMSz6qqe/WC30FRfja4wXGBobjxg2HjFsPGLYeMSw8Yhh4xHDxiOGjUcMG48YNh4x
+lnLtvny5L9/bGf0hD3ybQJlEiShA0dh+xLD9iGH7EcP2I4btRwzbj1hte09nqVq7Nml3qR+E7yCL
+ceCJsGGFOY1pu5xfgGurtxSNObalMGwzYU48MGoXXVc5V6z2DR62ozauV+wHYdjXwByMm4Nxc4KB
+70OUUcQOxs3BuDkYNwfj5kDZHCibgxFzMGIORszBiDkYMQcj5mDEHIyYgxHDs/TMZY42m8cbGwdS
+Ra8xXvsiPuQLB6WL0kOZQJlE6aMMUKZQprXZvHFog1mUIUpeq+CsqoAZwLOGzIvXYmxkQyogBJhZ
+L56sVXCHVEAMccQQr8fgD6mAGOKIARmmx/A7QwgMIdSHqTFY1QDm3YOP9uCjPVwHHkME8NUeq0eQ
+GNIAIkA26iEb9Rz87iACGHHPqUeQXNUAElM8KcnwpCTDk5IMT0oyPCnJPKcegTekAUSAfBWPSjI8
+KsnwqCTDo5IMj0oyPCrJ8Kgkw6OSDI9KMjwqyfCoJMOjkgyPSjI8KsnwqCTDo5LMc2vXgVu/DrTZ
+nLk48OIDBwwHTjBQZ+AbNlCHeQOVG3HgDlR2/IE6gwfJgYPEQJ3Bg4Gf3AGI+CDoQMtsIFR3sPJA
+y+5APO5APO5Ay+7A6d5gBwfbGXIFI5nA85UMz1cyPF/J8Hwlw/OVDM9XMjxfyfB8JcPzlQzPVzI8
+X8nwfCXD85UMz1cyPF/J8Hwlw/OVrP58ZV97sdQxP/qMZALPV7La85WdvUN/Bz6Scc9P0qG/Ah2p
+uOen1eK8ob8DHXrn+VwtV6Pfi9VKd64zT+v/VzvnR2W9FhJyDwm5hyzEQxbiQeM8aJwHjfPA1R64
+2gNXe+BqL+D6vEKxmO9sLy1SpjHWODDYIGsvNfAZAYCsPZC1B7L2QNZeKlCmxX3PdeqFFzei5sv5
+jioqIAKwtQd985CCeOm4Wq0lGv19+IwAsFrgYbXAQ+7hIffwkHt46SA6vwy8dDr6jPzNQ+7hIffw
+kHt4yD085B4ecg8v40TnF+fhM/CRe3jIPTzkHl4mUCITgo8YeKQcXiasD4yDXmWBmgVqFqjZgd/R
+a2QWHjILL5vUC70L2vuLxXy1djQUEtmFh+zCQ3bhIbvwkF14yC48ZBcesgsP2YWH7MJDduGFnlHq
+y/dGoPgKnUeC4SHB8JBgeEgwPCQYHhIMDwmGhwTDQ4LhIcHwkGB4SDA8JBgeEgwPCYaHBMNDguEh
+wfCQYHhIMDwkGB4SDA8JhhdtNatxZFRCYBJOoFd6CsX6H+OyCr0LBj+QSn8vqnBlXr4nh0FPuHF5
+yAcHpYvSQ5lAmaSVvoF7O4HFm0SCyR3F/nZ8cGl3Pleuqp2FXM/Aym0C6zaJRGD29Ffg7fLDhhwP
+LNkmsHSTANsmwLYJsG0SriyJ6zmJ6zmJqyGJKU1iSpOYoiSmKIkpSmKKkpiiJKYoiSlKYoqSmKIk
+H2gvhTKNMoMyizJEGcXrY4p8rPf7WO/34eF8eDgfHs6Hh/Ph4Xx4OB8ezoeH87He7yNB9GHhfFg4
+H6uLPhJHHw7Oh4PzkUj6SCR9GDgfBs6PAx+e24d/8+HffOSUfhz4sG8+7JsP++bDvvmwbz7sm48s
+00eW6cO9+XBvPtybD/fmw735cG8+VkV9ZJk+zJsP8+bDvPkwbz7Mmw/z5oPJfPyjgg/v5sO7+fBu
+Pm4tH97Nh3fzHeA7wId182HdfFg3H9bNh3XzYd183Gs+7jUf95qPe82HdfNh3XxYNx/WzYd182Hd
+fFg3H9bNxz9N+MiafWTNPrJmH1mzj6zZx9Kvj6VfH0u/PpZ+fSz9+h7wPeB7wPeA7wEfS70+lnp9
+sIWPpV4fS70+lnp9LPX6WOr1QRk+lnp9LPX6WOr1sdTrgy988IUPvvDBFz7cmQ935sOd+TAMPtyZ
+D3fmw535cGc+3JkPd+bDnflwZz7cmQ935sOd+Vjq9bHU68Od+XBnPpZ6fSz1+nBnPtyZj38q8fFP
+JT78mQ9/5uOfSnysR/jwZz78mY/1CB/rET7WI3ysR/hYj/Dh0Hw4NB8OzYdD8+HQfDg0Hw7Nh0Pz
+4dB8ODQfDs1HNu0jm/bB2z4Mmg+D5sOg+TBoPgyaD4Pmw6D5yKZ9ZNM+smkf2bQPf+bDn/nwZz78
+mY8lYh9LxD78mQ9/5sOf+fBnPvyZD3/mY23Yx9qwj7VhH2vDPvyZD3/mw5/58Gc+/JkPf+ZjbdjH
+2rAPPfOhZz78mQ9/5mNt2MfasI+1YR9GzYdR87E27GM1wYdT8+HUfDg1H07Nh1Pz4dR8ODUfa8A+
+1oB9rAH7cGk+XJoPl+bDpflwaT5cmg9d9uHSfLg0Hy7Nh0vzsQbsYw3Yh0Xzoec+9NyHRfNh0XxY
+NB8WzYdF82HRfOi/D/33of8+9N+H/vvQfx/670P/fei/D/33of8+9N+H/vvQfx/670P/A+h/AP0P
+oP8B9D+A/gfQ/wD6H0D/A+h/AP0PoP8B9D+A/gfQ/wD6H0D/A+h/AP0PoP8B9D+A/gfQ/wD6H0D/
+A+h/AP0PoP8B9D+A/gfQ/wD6H0D/A+h/AP0PoP8B9D+A/gfQ/wD6H0D/A+h/AP0PoP8B9D+A/gfQ
+/wD6H0D/A+h/AP0PoP8B9D+A/gfQ/wD6H0D/A+h/AP0PoP8B9D+A/gfQ/wD6H0D/A+h/AP0PoP8B
+9D+A/gfQ/wD6H0D/A+h/AP0PoP8B9D+A/gfQ/wD6H0D/A+h/AP0PoP8B9D+A/gfQ/wD6H0D/A+h/
+AP0PoP8B9D+A/gfQ/wD6H0D/A+h/AP0PoP8B9D+A/gfQ/wD6H0D/A+h/AP0PoP8B9D+A/gfQ/wD6
+H0D/A+h/AP0PoP8B9D+A/gfQ/wD6H0D/A+h/AP0PoP8B9D+A/gfQ/wD6H0D/A/BUCvdpCvdpCvdp
+CtdpCv1KoV9p8EYavJEGb6TBG2nwRhq8kQZvpMEbafBGGryRBm+kGwfaz6DMogxRRvGkwRtp8EYa
+vJEGb6TBG2nwRhq8kQZvpMEbafBGGryRwfWQwfWQwXWQwXWQwbxnMO8ZzHsmMXB+GmUGZRZliDKK
+P4N5z2DeM5j3DOY9g/nOYL4zmO8M5jmDec5gnjOY5wzmOYN5zmCeseuTYdcnw65Phl2fDLs+GXZ9
+Muz6ZNj1ybDrk2HXJ8OuT4Zdnwy7Phl2fTLs+mTY9cmw65Nh1yfDrk+GXZ8Muz4Zdn0y7Ppk2PXJ
+sOuTYdcnw65Phl2fDLs+GXZ9Muz6ZNj1ybDrk2HXJ8OuT4Zdnwy7Phl2fTLstmLYPcWwW4phtxTD
+bikWDtSPeD2FtYkU1iZSWJtIJSK+TCUivkslIr5LJTzU91DfQ30P9SN/m0pE/jaVjOYhlWwc+JxC
+mUaZQZlFGaLkURnNQyoZzUMqGc1DKhnNQyoZzUMqGc1DKhnNQyoZR/txtB9H+3G0H0f7DPUjfUwl
+GeJliJchXob2GNpjaA/jmGRoz0G8DuJ1EK+DeB3E6yBeB/gO8B3gO8B3gO8A3wG+A3wH+A7wXeC7
+wMd8JjGfSRftuWjPxfkuzk8APwH8JOolgeujfR/t++ifj/756J+P/vnA94Hvoz1/oD30I0C7AdpN
+IU6OeDji4QPfox2Odjja4ehP5Bc53h7F8fYojrdHcbw9iuPtURxvj+J4exTH26M43h7F8fYojrdH
+cbw9iuPtURxvj+J4exTH26M43h7F8fYojrdHcbw9iuPtURxvj+J4exTH26M43h7F8fYojrdHcbw9
+iuPtURxvj+J4exTH26M43h7F8fYojrdHcbw9iuPtURxvj+J4exTHW6N4IuNpHYvL6drTqrWD6KX3
+tV+gqBwKyqGgHArKoaAc+1g59rFy7GPl2MfKsY+VYx8rDwfPS6L0UQYoUyjTKDMosyhDlNEI4Ili
+jieKOZ4o5niimHOeVfpru8wboyIeFSwqnKhwo8KLikRUJKPCj4ogKlJRkY6KTFQAIYwKXi/iEV48
+wotHePEILx7hxSO8eIQXj/DiEV48wotHePEILx7hxSO8eIQXj/BYhMciPBbhsQiPRXgswmMRHovw
+WITHIjwW4bEIj0V4LMJjER6L8JwIz4nwnAjPqeMlUn5UBFGRiop0VGSiIhsVYVTU20ykG6MiHhUs
+KqI2025UeFGRiIpkVER46QgvHeGlI7x0hJeO8NIRXjrCy0R4mQgvE+FlIrxMhJeJ8DIRXibCy0R4
+mQgvE+FlIrxMhJeJ8DIRXibCy0Z42QgvG+FlI7xshJeN8LIRXjbCy0Z42QgvG+FlI7xshJeN8LIR
+XjbCCyO8sI6XjOJMRnEmo8iSUWTJKLJkFFkyiiwZRZaMIktGkSWjyJLZpN5ZnBH9HQYti42qNFXb
+wkrbau9kpVNrm+mMzODfQ6CZWl0LL06d1JGr5Gmdd/Ts4F/1mDbk7y+Y2VJ/ezFf35M3HH9+YxLe
+tT0cr3oe+GwO/kWFSYXheL3w0Lq1XeD//JxJD3xWw96uSbli1Wgv5+r/iFmqmnhXbKPT6OLYrX3G
+cXLI97U68SHHjUPqx4ccD3zv/OvczJDvB9rP1DCGfD/QDvvXceOQYwfHiX8dx4ccNw45DoacO3Ac
+r+HS+mBoGBRm4m8e1D4oe0+q9HT0GfUXcEaH9bdY1g/VRYXoq/rrNOuHWl83vqu/x7J+OBxbDkoL
+e+uXwIi9+/OV2qNwg99Y8Lv1D8O78uWeXG9ne7FSP31Y/Y93TKr9rzZ3RvTXaOrT1zl4aOLvBww5
+9mrHgnCbtGzKh8+sJE9PJ0+tJE8a5LFh5FFGHllBHl5BHvqdPLic3L+S3LeS3HvPVHrvUnLPVHJ3
+I7lrKbnTJCtWkjtWkttXktuGk1sMcvMa5KaNyI0GuXGKfMP1Y+j1Y8h1146h140j144h15xr02uS
+5OpzbXr1eHJVklxpkisuH0GvYOTyEeTyZfJlk8ilZ4yjl64kl1w8kl4yllw8klx04Wb0ohXkwupY
+euFm5ILqWHrBCnL+eWPo+SvJeWPIuTY55+wV9JyV5OyzdqNnryBnL5PPOnNDetZu5Kwp8plnjKNn
+bkjOOH0EPWMcOeO2v++d8rd8+ghymkVOmyKfOpYsN8kpy8nJNjlpbXLiCZ30xJXkhOpYekInOf44
+kx6/OjnOJMdNkY89xqbHrk6OscnRRxn0aEaOMsiRY8kRhy+lR6wkh1fH0sOXkkNNcsg4cnB1LD2Y
+kYMOHEUPWkkO3Hs4PXAUWba/TZetJPvbZP8p8n7VsXS/lWTpvuvRpSvJvuuRfZasoPusJEsW70aX
+rCBLlsmLF21IF+9GFk+RF21IFibJgupYumA+6a+Opf2/k+pYUllJynsPp+WVZO/hZO9lcl9pEu1b
+SUqTSO9K0rOSFFcj86eTvQzSvZJ0GaRrijxvPMkvJZ0ryZRjOuaT9hUkt5TsuZLsvibZzRxOd1tJ
+dhlBdtpxLN1pc7LjWDKXkR1M0jZ7DG1bTmaPIa1jyPaz1qTbb0hmDVuNzlqTtAxbjbasRZpnrkub
+l5KZTTaduS6ZOUWeMXxdOiNOpg9bjU5nZLsmm263lDTZZNpUg05bSqYahIc25YyEWYuGNgmjKcla
+JJMeTTPLSXo0SU0ZRlNLyZSETqcMI1OWydtuPZFuu4Jss/VEus1uZOvh69KtJ5KtthxNtxpJttxi
+JN1yNNkiMOgWI0lgED85nPpLSTKh0+RwklwmJ3SSmCJ77mjqLSfuZjp1RxNHH0ud5YRNtClbSeLD
+SKNl0sZxZPIGE+nkJJk03qCTxpHNG0bQzZeThs102jCCNEyRN9PJphtpdNOxZKJNJk6RN9l4BN1k
+Odl4I41uPIJsPEXeSCMbbjCRbriCbDBqPN1gIll/1Hi6/koyYbxBJywn42MaHW+Q8cvkmEZiU+T1
+Ro2n621Kptw0bsTmdNwWZOx4su5Sss4aZAwjazMyetR4OnolWWvNiXSt+WTNUePpmhPJGtSga4wj
+q48mo4atRkeNJyNHbE5HLiUj9LF0xOZkteHr0tVWkuHDVqPD1yXDViPDlsm2ZVL7d2KZxJoim8OJ
+oY+lxgqijyWaOopqK4g6iijUoMrqhBqETpFlMpLKaxF5mUzE4ZSMJGSKLInDqbSSiMOJuEwWhhHx
+NrHzkGPEzf7/+Z/w/51Qxgr/zwA=
+`
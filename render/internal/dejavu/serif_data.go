@@ -0,0 +1,3831 @@
+// Code generated by go run gen.go; DO NOT EDIT.
+
+package dejavu
+
+// serifData is the "DejaVu Serif" TrueType font program,
+// compressed using DEFLATE and encoded using base64.
+const serifData = `
+7P15fBRV1j+Ov++trZfq7uq900kn3dmahEBCQoBggBbDvoiAbLIECFsChH0JIIiyKQgKIiICKoIi
+IiIiogJixA3F0eCKKxF0JmYYJ8MgJNW/V93ubIjzPPP5PJ9nfn9806mq06dO3brLueeee869p0EA
+OAjAI9S9++B+O2+NTwZIXwBxPQq6dV+8Zs0XAJkMYHuPAbcOemHNr58CVAd0Hddj0O1db1r97ftA
+wVRA3Nnv5sE98+5+MQ/oIwP8N7cOysyetu/S34BbqwAMGT9t7IybX77nGDCgALDpxs+b41+f82wN
+MK4vQDZNnDFp2sVlfysHxn8H4L5JY2fPgAQJKJoKQJ40deHESVsvnQWKFoDPqpg8YWxRercf7gT/
+kJbfdpMnTxhr88fMBv/QPQCSJ0+bs6DXyy3HgX/oaaC9v2TCrOmxHyVtAH/6HcAfN7V0/Fjfunlx
+4KsqgfbJ08YumGFLFXIgJOsA+KePnTZh8YbE0xCSWwCB/jNKZ8+Z4GpxN4RuC4B7vDNmTZgxd6F4
+GcK7mwBhNAACAN/+8uNcAPgua9vQhisBIAO4H5tB4QRH94HQ5+gb4OhJehKEvklPgaNv03dA6Lv0
+A3D0Q3oGhH5EPwFHK+hZEPop/Q4c/Z7+CEIv0F/A0WrhbhDhHmENOOFe+a8g8iX5Ejj5b/LfQORf
+5Sug8m+mMhDTItMKcKaVprdBwAOg0fxqR+RswJcEEAq69x0M//iFs6Yia9KsCSXoO3XsnOkYOW3s
+nMk4xZ5FOAyOPUWhgPbrU+CHMmhgVz+UZveupx0Ny80juvkx+paBg/0Y3ePmHn6M7jWonx+j+9w8
+QjsP7KOdGaYvO/e7ta8fo/szeOCt2nlQn0HamWEG36ylM5g9dcM3A5zW7nBMGzurBN5pJdNK4I/S
+aOf6GuCggwIPRPbdw54nXLSWgh1BSG/iAiGzICOAAvTGAAzBSIzDZEzHHJRhGVZiLR7EFmzHLuzF
+ARzGaziJd/AhKvAlvsMFVOFXXIFKeBAVYhE7T2Rnx+/OPX6H+VA7S2KTpyLnqU3OEYzaJIVBTVKY
++F+dKYTLGvzfPFPo6H56DKB/pn+GlVbRKtgYN9oZH8aYFpkWwWtaYlqCWNNS092IA4VIn2t4xsae
+sbNnHOwZL+PUWKQC2ApgJ4DdAPYBOAjgCIBjAMoBvAfgIwCfAjgH4AcAPwGoBlAD4GqUxUWAyACx
+AcQDkHiAJAMkHSBZAGkHkHyAdAVIT4D0B8hggIwASCFAJgJkKkBmAWSBVnZ+2+/On//uvO0PMP/u
+uaBJCgW/w1yXMv0/fct150AofgRooAwyNepa69rqOupCuu66vrqBumG60boiXbFuhm6ebrFuuW61
+7n7dJt1W3U7dbt0+3UHdEd0xXbnuPd1Huk9153Q/6H7SVetqdFf10It6WW/Te/Tx+mR9uj5L306f
+r++q76nvrx+sH6Ev1E/UT9XP0i/Q36m/R3+vfoN+s36b/gn90/r9+kP6o/oT+lP60/qP9Z/rv9FX
+6v+sv6S/rK81UIPOYDY4DF6D35BqyDBkGzoYOhsKDL0NAwxDDCMN4wyTDdMNcwxlhmWGlYa1hgcN
+WwzbAcMuwLDXcMBw2PCa4SRgeAcwfGioMHwJGL4zXDBUGX41XDGoRt5oMCqA0WWMMyYaWxhbG9sa
+OxpDxu7GvsaBxmHG0YCxCDAWA8YZgHEeYFxsXG5cbbzfuAkwbjXuNO427jMeBIxHAOMxwFgOGN8D
+jB8Bxk+N54w/AMafAGO1scZ4VYYsyrJskz1yvJwsp8tZcjtAzpe7yj3l/vJgeYRcKE+Up8qz5AXy
+nfI98r3yBnmzvE1+Qn4akPfLh+Sj8gn5lHxa/lj+XP5GrpT/LF8C5MtyrYmadCazyWHymvymVFOG
+KdvUwdTZVGDqbRpgGmIaaRpnmgyYpgOmOaYy0zLAtBIwrQVMD5q2mLabdpn2mg6YDpteM500vWP6
+0FRh+tL0nemCqQow/Wq6AphUM282mBWzyxxnTjS3MLc2tzV3NIfM3c19zQPNw8yjzUXmYvMM8zzz
+YvNy82rz/eZN5q3mnebd5n3mg+Yj5mPmcvN75o/Mn5rPmX8w/2SuNteYr1pgEQGLDFhsgMUDWOIB
+SzJgSQcsWYClHWDJt3S19AQs/QHLYMAyArAUApaJgGUqYJkFWBYAljsByz2A5V7AsgGwbAYs2wDL
+E4DlacCyH7AcAixHAcsJwHIKsJwGLB8Dls8ByzeApRKw/BmwXAIslwFLLaBQQNEBihlQHIDiBRQ/
+oKQCSgagZANKB0DpDCgFSm9lgDJEGamMUyYr05U5gFIGKMsAZaWyVnlQ2aJsV3Ype5UDymHlNeWk
+8o7yoVKhfKl8p1xQqpRflSuKauWtBqtidVnjrInWFtbW1rbWjtaQtbu1r3WgdZh1tLXIWmydYZ1n
+XWxdbl1tvd+6ybrVutO627rPetB6xHrMWm59z/qR9VPrOesP1p+s1dYa61UbbKJNttlsHlu8LdmW
+bsuytbPl27raetr62wbbRtgKbRNtU22zbAtsd9rusd1r22DbbNtme8L2tG2/7ZDtqO2E7ZTttO1j
+2+e2b2yVtj/bLtku22rt1K6zm+0Ou9fut6faM+zZ9g72zvYCe2/7APsQ+0j7OPtk+3T7HHuZfZl9
+pX2t/UH7Fvt2+y77XvsB+2H7a/aT9ncA+4eAvcL+pf07+wV7lf1X+xW76uAdBoficDniHImOFo7W
+jraOjo6Qo7ujr2OgY5hjtKPIUeyY4ZjnWOxY7ljtuN+xybHVsdOx27HPcdBxxHHMUe54z/GR41PH
+OccPjp8c1Y4ax1UnnKJTdtqcHme8M9mZ7sxytnPmO7s6ezr7Owc7RzgLnROdU52znAucdzrvcd7r
+3ODc7NzmfML5tHO/85DzqPOE85TztPNj5+fOb5yVzj8DzkuA8zLgrAVcFHDpAJcZcDkAlxdw+QFX
+qivDle3q4OrsKnD1dg1wDXGNdI1zTXZNd81xlbmWuVa61roedG1xbXftcu11HXAddr3mOul6x/Wh
+q8L1pes71wVXletX1xWX6ubdBrfidrnj3InuFu7W7rbuju6Qu7u7r3uge5h7tLvIXeye4Z7nXuxe
+7l7tvt+9yb3VvdO9273PfdB9xH3MXe5+z/2R+1P3OfcP7p/c1e4a91UPPKJH9tg8Hk+8J9mT7sny
+tPPke7p6enr6ewZ7RngKPRM9Uz2zPAs8d3ru8dzr2eDZ7NnmecLztGe/55DnqOeE55TntOdjz+ee
+bzyVnj97Lnkue2pjaIwuxhzjiPHG+GNSYzJismM6xHSOKYjpHTMgZkjMyJhxMZNjpsfMiSmLWRaz
+MmZtzIMxW2K2x+yK2RtzIOZwzGsxJ2PeifkwpiLmy5jvYi7EVMX8GnMlRvXyXoNX8bq8cd5Ebwtv
+a29bb0dvyNvd29c70DvMO9pb5C32zvDO8y72Lveu9t7v3eTd6t3p3e3d5z3oPeI95i33vuf9yPup
+95z3B+9P3mpvjfdqLGLFWDnWFuuJjY9Njk2PzYptF5sf2zW2Z2z/2MGxI2ILYyfGTo2dFbsg9s7Y
+e2Lvjd0Quzl2W+wTsU/H7o89FHs09kTsqdjTsR/Hfh77TWxl7J9jL8Vejq2No3G6OHOcI84b549L
+jcuIy47rENc5riCud9yAuCFxI+PGxU2Omx43J64sblncyri1cQ/GbYnbHrcrbm/cgbjDca/FnYx7
+J+7DuIq4L+O+i7sQVxX3a9yVONXH+ww+xefyxfkSfS18rX1tfR19IV93X1/fQN8w32hfka8Y8M0A
+fPMA32Lfct9q3/2+TYBvK+DbCfh2+/b5DvqO+I75yn3v+T7yfeo75/vB95Ov2lfjuxqPeDFejrfF
+e+Lj45Pj0+Oz4tvF58d3je8JQs8Lr4DQ8wAIvcjgixrMddJgrtP1eFrF4CoGX9ZgsonRD2P0wxi8
+hMFLNJhsZjSbGfyiBuNVdSAI14vR9GqkoVc0mPdoMO9h6UxiNJMY3JPBPRvzQzY3xauHQehXLJ2v
+GM0xBh9j8FMMforRr2L0q4DmZefj2XvjGf4Lhv+CwY8z+HEGv8vgdxmcxxdoZw0mPUU00E9m+Mkw
+gHCfiainoaMZns07aa6GJ0MYPJLhR2p1QnqKpP5dkTRJz0YY86I02lMPXk/DfaGlw0XyMJAvqE+n
+IT8a/JYGk57qwPr8c58xuEUEz2jMjN7M0s9j76IM35PhBzK4K4O7sveWsnRKr8fTfAbns/StDLYy
+/NdSidZG0mwQ/gVW5y805TfGG12kkoZzk3bnVjDMdvbG7U1Lpw68jut6NikRqw3+K+FF7Ry+CsJT
+hqHX80az8jZpo4b2NWj5j+RTeKXh7ZHecSSSzyZcup3htzf2jnq+Cnub9wtukvBmfT4jKUS5N9IW
+LIfcwMg5ypMnGmq4KU82redWDN+qCf4WdWB9Trhh1/Ne9L29IufGGqC5TXu9OrA5J0drZs71XBet
+yQmNbRHl3iY9nVqb1N7Ff9U3m8mcpm9vIk8i6dMPGeWH1/fQZpKhKbc37d1NOfYMg89cnw7n1GDO
+2ZhOhAea9fTJjfjo2RE5s7sZDM74Hbyeweuvr7Gm8oTexeC7GD7aHyMygT01hOXkVOQMNMKM/vHG
+1uF6MXyklctZzssZTSdGs4LhVzC4U1OZwGgWMXgRwz/H4Oea8l5EnrC+8+vv5PYwRhPhvddE1JeL
+TGf4LqwUmwR2Js8275vNWuFJluaTDP8Iwz/yu/GlU6R/sfMjkgeEk1hq30Z4STvzUYkhnGgYj5rI
+kGiPiPBnJeuha4VDINwl7Uy/Zk/9hZ1nCCcactgrwp/amYvUTySHq5vww1tN4BHszCQS5+cL6vmK
+i2XwzkZK0pvVWHeWBz1f0PB2Rhkty3PXw9xKBmeKDf2LHmWpnRUO1Y84zeij43KT0bnJiMOHWL2F
+rh/lozJqSUTiCa80Hws4VkbOwCjbMnzn68dKmsXgLOA6OJXBqUA975G7gYb6XA005x8unr0r/nre
+aDYO2hlsZ+/dzGAmh/8QbjIm0gINJgsjEp7hCxrxEWnfTPJMZfBUBj/M4Ic1mH9Wg/lnGX6r0Es7
+qw//i7G1qWy3Nkk/hbVOCoN/Y/jfgHo+jEq5puPybgbv/l1ZzjH8OQYzuUGZDCF/Ej7SzlSzVtVo
+MK3RYFLB8BVR+DsG77sO/xmDP2PwYQYfZunEsnRiGZzN4GwNRliDEWb0NgbPZXLAymisjTTkG/bs
+JobfxOBkBiczuA+D+9BtzZ8lFU3SP83SOc3gvQzey+g7MPoODN7C4C2M5gkml55g8LeCop218nKV
+fC/tDKFeAtfrDAwu+53e1ZQfmshS0oel30crL1nF0l/F6jOiOff8nd7YFGa6B++NjMIi6vU0fChc
+0c50W70ci6TGLdRgjrU79x6D32Nv+Yrpn3oGn2Mw67Pcw4yG8S03lqXzbVMJoK6vx3Njrx+vud8Y
+HHk2gmejKn+H8ErDmPhPVg9JDI4R24Dgx8iIzOA4Vm/f8naNT66fLzTVgZuWsX7E/71m2Khj/5GU
++yOdqn5uog68Tj9p0r+aziOa9t9mefhH5Cxcuk4u9WBwD3b3Y8aTH9NtDdrgMXXIdXlroiWSjdpY
+QIZrMH+RjRGsRwtMV4zA/PDG8UJ4VntWYPKHU1k+1WZt2mQ+wsr7PCtXHwZfY6PJZgaPZ3kYz/Jz
+E4NvYulYGOwMfwvC8axcyerAP8bTfNa++dfLW/5N9t43I3zI8hmRn2zE4SNzuu2sD26HcN2c4t4m
+eDsr17ONePrpfwN/UR1Yj+cGs3x+yfL/JctnZIyOheGPeSnCq9xDDH5da3HyOnvXUYY/ytL5nKXz
+OfDH7UKPR3ib5WFUE3lyH8OvajqPY/CwxvRJz0Y814XhV6gDGzT2T/5VOs3sAE3ntk3m5k01/2Za
+QQ6TnzmMh1cKGmalBnMVrFwVkTGR0UfGrEUMPn29HYBslm6r79fCMoZnMoqbrsH0NgbPYvDtDH6H
+wfsYvKAJfj6DRzD4Lg0mlME/M/zb7L06Bv+F5SfIaPhI2TUYYHVSwWheZvgAgyeyZyN66UqW5l+F
+I/Vtyn3SSM/NakyTu8TwmzTbBTe1cebF3do4d+PaMdjeWCf0yvVl5x9h713baDOJyBDOy2BLRAdj
+cPuItsba92MNJt9EtEqmC1VEdFEN5qdpeLKL0b/H6C8z+BcNjxaMT95h+Pc0mHwt1IDgpYgM1+Dw
+6wz/FUvnanR8AQgOsDzfwnhgemP6tB3QkAdEZs0ajBfZu04z+q7qwHqtGF8z+jINJkyfId+yd0V4
+7xDD/8Lg/QxmfZb7iqUjXY+nOxj8HEt/CYOfYfQ8q1seaD7GNYMzIzo2g1OajI/rGH5dJG+NePIL
+g/O0d/HQZDWPRhqO6XJ8PsPn/65PNbFNcYThCYMPMPhAY7tzTB/gGZ/zd0Fojm82I24yLyMFTWbE
+Wxh+SxMeZnVC72P4+xh8CyvLkqa6pTqwIZ2/szSXiG3qachsDcZXv6MpaEyHZ+Mg36NJHhgN3ccX
+1Pdr+qsGkyWNc1verw6spydLrh+XyeIm9pA3Gf7NpvaE8DWthvmChnpuaq+Lwiz97gzuztKM8Ngs
+vqBRxjZq3YTNs/BTZCRtvBu19jS1vTSdz77G8vlaU3kb9jYvSzOdoV2TvtPUnrCKwauiemZew5z9
+byz9vwHNrRYcmztzL15nF6JYhGR0AlCAUfBiJdagLR7GFnTAVmxFR2zHdtyEp/EM8vETfkJn/B1/
+RxdiIiaEiIM4cTNpQdJwC7mZdEU30pP0Rg/Sl/RFbzKADEQfcjsZgv5kOBmOAWQMGYvbyBRSjMFk
+OpmOIWQ2mY2hZC5ZiGFkMVmMUWQ32YPRZB/Zh0JSSX7EWPJn8heMJ5fJb5hAwiSMKZRSimIqUAdK
+qIt6sIh6aQB30mSagpU0SINYTdNpOtbQDJqBe2kbmo37aDvaDutoHu2I+2lXWoANtC/ti430Nnob
+NtHBdDAeokPoEGymI+gIPExH0lHYQsfSsdhKH6OP4VFaQ/+BbfQK/Q3baZiGsZMr5UrxODeTm4Un
+uEXcIuzi7uTuxFPcMu4u7Obu5u7G09wKbgWe4VZza7CXu4+7D/u4+7n78Rz3ELcZ+7kt3KM4wG3n
+tuMQt5N7HC9xT3JP4mXuKe4pHOGe4Z7BK9yz3D4c5fZz+/Ea9xJ3GK9zR7ijOM4d547jJHeSO4k3
+ufe5CpRzn3Gf40PuK+4cPuLOc5X4mLvIXUQF9zP3F5zlfuF+wefcP7h/4Atex+vwJd+eb4+v+L/w
+VTjHV/O/4hv+H7yK8wIE4BeBE4KoFtKEVsQuFAgFxCP0EHqQGGGIMIR4heHCKBIrzBfmE7+wWFhM
+AsIR4RWSKKaKQZIs3iOuIKnS29LbpIX0d+nvJE26Kl0l6VKdDqSljup4kqkTdSLJ1hl1RpKjc+pi
+SFtdrC6W5MlJcgbpKLeWM0mB3EZuS7rL7eU80lu+Se5E+skl8jwyQL5PPkJGyq/Jp8i9JrOplDxk
+WmlaRf5pWmNaS34zPWnaR+pMB00vUQkUera6C2xdl5Ot6Ipha7ni2SquRLZ+qxVb95LL1r3cxNa9
+5KPH//X6FJA7AXIPQO4FyAaAbAYhbMYJQp4GIfs1SaPJExCi6fintHkrG78J+VwbwUFIJQj5Mwi5
+xMZpQmrZOE6ojunR2jyLUE2K+JktRZMR2lyK0A4RWwwtAGhvgA4A6BCAjgToOECTMXQ6oFl4aRlA
+lwF0JUDXApp+q40NdDtAdwF0L0APAPQwoMkverLJ6pf/lTO3mMGzGs/A/+05HP6fSedfn/mCxjzf
+AP4XZ/o/U3s0pjN4wNHZ1RFp6IL/P/wLvwyES8Ibwi+HE/4Db98AhLeGnwPCz0UwQPjc76jOaffD
+u5pS/Y7muebphs9pT2iH+kkUmdpwTW3y7Q/e+T/xFz7XrJZf/g+2cWr45X+Vv//Hf6n/Of5W3wjv
+Ut8Ivxzu/wd1M7eeD5vy5I0ptQ/jreiHYfuH59bD//2a/9+p//BqIDw2/Ny/ovl/+RcuifTM8Lvs
++m7TnhD51nhtDoejfTa8pFl670Y/58KfhJdc/8x//RceHt4QNmmcEF5y/b1/r33++I7qrM93eMl/
+qseHV6NPeHUEvj7X/+/7vDo2vEEdG345XHDjGgvvajxHMc/duCXDu7RP9Pqc9mn+zO+f0EYT9Alv
+/Q9Ueuq/4pXwyxpHh99A5n+b6zLZp1n6/4IfN0T7Vp/mPPe/JuEBqJ+oV9RPwi+HN9y4VsJjw2O1
+c1NMo5RoOj6G39Jow2PDj4XHhl+IPNf0mXBJ4/cGfEH4XLgg/HL4jX9XKvxP/KlbwwXq1nB2eO6N
+6z1cUH+uv9PYOxok2XPRchSEC+qv7LMkcqfpMw1/faJvL1G3hvv/Ed9Hxr7w8CZvn9vQD59rKnHD
+/bXxjEnK4ZHxLvxcgyye27TPNm3dSMuFX46k/r/Jc4B6JZygXglz4bE3fm+Ux/o3KXv/5vXSlD/D
++dE6iH4a7qX9i759Dv+hv/DL2uc/8OKHIy3/nyv5/750u+7NCZHjP/R2U+T4z/yplyLHf4rnI2Pc
+7yTs/4rsUZ9Dqvrcf5Lfw1v/Qy//N//Cvv+FdwwPn4vq89n/d/Liv9Ln/wd1m7HXf5o/cyPdRv0A
+UKv/RcGm43aMxRCcwzl8CWAJbsdaLMGj+As24APsxn58gBf/+zVLhBvk4gq7ZP4ft9b/lbz83dvz
+op//7vOf/A9KIM0G8DLy/quxN3zu/0oeUQyGAgUEyUgGRQpSwDHrFY8gghDQAi0gIg1pkJCOdOjQ
+Cq2gR2u0hoHNH4xogzaQkY1smJCDHJjRFm1hQS5yoaAd2sGK9mgPGzqgA+ysTh3oiI5w4ibcBBfy
+kQ83OqETPOiFXohBf/SHFwMxELG4HbcjDsMxAj7cgTvgxUiMRDyWYyUoVmM1RKzBGvC4F+sg4kE8
+iARsxEbI2IQtMDGPlwmPYjvs2IEdcGMndsKPgziIWBzCEcTiKI4iFsdxHPE4gRMI4A28gXicxElQ
+lKMcFKdwChTv4B3weA/vgcdpnAaPD/EheHyEjyDgY3wMgfUTEd/hO+hwDddgRBhhGAklFImEIxyS
+iJ7oIRMHcUAkLuKCQtzEjTjiJV4oJJbEIo74iR9WkkgSYSWpJBXJJItkwU7ak/ZIIR1JR7hJF9IF
+HrqELkGqtFRaCiKtklYhVbpPug9BaZ20Di3kp+SnYJB3y7vhlX+Vf0UMKJbBAgsI4wAKK6zg4EM8
+eMYNAuMDkbW6xFpdx9pYz1rUwFrUyFpUZi1qYi1qZi1qYS2qoAAFsKI7usOGHugBO3qiJxysjZ3o
+jd6woy/6woV+6Ac3bsWt8GAAbkMMa3svBmEQYjEYg2GP8sEQDIEPQzEU8RiGYUjAcAyHHyMwAgHG
+H4mMP5IwCqOQzLhEYFySwrhEZDyhZzyhZzwhM55Q8ASeQCqexJMIYhf2IBVP42m0wDN4BmnYi71I
+x7N4Fi2xD88hHYdwCBl4CS+hFQ7jCDIYD7XGqziGTMZJWYyHshgPtcGbeBPZjJPa4C28hWzGT23w
+Nt5GNuMqEe/iXaQx3pIYb0mMt0ScwRmk4SzOIgef4TPk4At8gRx8ha+Qg6/xNVriG3wDifGchB/w
+AyRUohISMRMzJGIhFuQQhSiQiJVYkUNsxAaJ2IkdOYwL9cRJnGjLuDCX8V8u4z8DCZAA2jEuNJAk
+koR2JJkkoz1JISnowPjSSIIkiA6MO2XShrRBJmlH2iGPcWpH0oF0wE0kj+Qhj3GtQp4iTyGbeYMl
+8hx5DjlkP9kPiTxPnkcOOUfOoT35mnyNDuQSuQSJ/I38DTmkhtTgJtEgGpAnGkUj8kVZlJEnmkQT
+8kWzaEaeaBEtyBcXi0uQJ62WVqMT6wmdpbXSWnRi3O+U/y7XoAMIJkFBMlKQiiBaIA3paIXWyEQb
+ZCMHbZGLdmiPDshDR9yEfHRCL/THQNzO5NEdGInluBv3YAVWYjXW4F7ch7VYhwexEZvwEDYzn/tW
+PIpteAzbsQM7cRCH8BIO42UcwVEcxwm8gZMoxym8g/dwGh/iI3yMc/gO1xAmlHBETxzERdzES2KJ
+nySSVJJF2pOOpAtdIi2VVkn3Sevkp+Td8q/1MSZIf/JesxgT7ViMicksxsRiLcYE6dAQvUFkT1Fw
+4KFriDThZ5Em/IxCABru1z8R+S5C15BOU6oZsLEYFMtv0WJHPMgiUSzvNmiAHw+yeBTLe9zc148H
+WVSKrSwqxXIWlWI5i0qxlUWl2MqiUuxjUSm2sqgU+1hUiq0sKsVWFpViOYtKsfwG5WiaIyH6nYBc
+l3/pOkq+SXkI9DCMHTt1DjqPHzt7AgrGj582A72Lpk6ZhAFTp0waiyHsPHpq6fipKGLn4tka/YzZ
+s+csxDwAREsfBMYmcTAib+fZOwn4aK61M8fOEhCNZOJFItKRjY7oit4YiBEYh2LMitIVRku8JZIu
+1zp6zY6kybWLXodFr9HnuA3R69Eo/eXIlfdHr/1B0A4ibPAgHslIRxao6Q3TG6Cmk6aToKY3TW+C
+mspN5aCmt0xvgZpOmbSRkUjbpScBUDhQyD685n/WvM6gkNEX/QD8DX8DZas4ODqGjgHPfPgC/TP9
+BV5uNDca8dwb3BtI4N7k3oSfee8DwsvCywiKt4u3owUohrPVLRwbSTj0QR9wLHXCxhAOy7EFHLbi
+cXBM+nJM7nJM4nJM1nJMynJMynJMynJsBOfYCM6hAhXg8Ck+BYfP8Tk4fIkvwbGRnWOylmOylmOy
+lmOylsMF/BkcqlAFDtWoBodLuASOlZngV/wKDjWoAYfLuAwOV3AFHK4RARyRiASOaQUckYkMjklu
+jslsjklrjslpjmkLHPEQDzimLXAkjsSBI/EkHhyT2RyT1hyT0xyT0BxpQVqAI+kkHRzJIBngSGvS
+GhyT2RzJJtngSFvSFhyT3ByT2RzpSG4CRzqRTuCYpsGRm8nN4EhP0hMcGUgGgiODyWBwZAgZAo6s
+J+vBkQfIRnDkIfIQOLKL7ALHJD3HZDxHviRfgmMynmPSnWNynWM8QRhPRKL2UMYTlPEEYTxBGE9E
+YvFQxhOE8QTR5B+4hv5EQCOSkGEIuwN26EEg6gdpNNw2DZaXaTCRmRZMoItGqXHJlxl/3SrXgOJW
++VdQ9GIpa+c4Rkkhwiz/g9H1lf8Oir4NFPGgMMABAl6LD4ShDPpnFKIYQm5mbxSgwAEP4uAHMT0J
+YjoIYnoRxHQIxPQSCAT0xsf4hK2fIsiG1ECfinS0bvYUNa017QIxPdXkeSn6vEwWsjQovDCY7jQt
+Ni01LTEtN91tWmS6x7TMtAIEBvoc3U+fpwfoi/QQfYkeoa/Q10ARByP+Tn4zraQB0yrTGvIb+3av
+6T4m27Se9zdqpOl0DDeae4N7U3hZvJ31eM70nGm/6XnWAuNxl1wUbZXv8BMGIF+G9DUA0OUAHwSE
+s2B/fDByBZp/J5Oj8O3/jRmmGaDz/ms6jIjQYkTkIOYoHjfAj2jyfXwjTDsA19YDyG94tAW3l/sS
+LshMXxiCERiNcZiIYkxHGVbiQWzGE9iPoziGkziF9/ApfkA1fsVlXIVKDMRFEklr0pF0JwOJEl2j
+NAAgM6LrjcoB8iVAI/mlug+kPqbDhgEM/lDqY3o5Cp+R+piOGAZQ7YsfVHdBWm56xbCd3bsoLTcd
+jcI/SctNrxq2Uz9A2wG0b2QtkXZPptJy02sROpmTlptej8K8tNx0zLCdDgZocXSd0eZofgYJNYZ5
+hiMMvl2oMcyPwkNFn2GB4XsGTxJqDIuj+ClCjWFJFN4o1BgWRuGHhBpDWRR+WPQZFrFnAU7fAUAL
+/VxA316bqerXgejboQWgj+RBo7mpgaZjA03edTSdG2g6NdDkM5qZDTQ3M5qJgD7EaO4E0XdpRiPo
+CxjNLEDfjdFsAdHfwqB7m+G6sufubpiFj9Ge0wHAGO2ODiDRFHveIMUeN0ixe/MUpRX1KUormqXI
+6XsDdJhoBkzHtVFANIOYTpAagPc2lDWbvbUU0Pdib1gD6NuwN0ytf4O+E3DDmqL6EHDD+qHX1TO0
+p6N3mtcutKcjd+DX7ggUgF+7I9CGO121OzwAsPrk0XBHe2MLOgTATO0OHfIv7xC4YISMzghhNMag
+UFqh+0D3oe6M7jPdBd1F3U/6NH26vqU+Q6YyJ/MgkOFHV8yUntEN0t2uG6qbpJui26h7SBfZ4eIl
+GyAAQo7wCEDiI1fuM0ykNoCKIieIAqX8jxDDA/HbNQPPyogBE7sVIQR/OCw6VAfZKk0j5wuBHd9+
+Vs9fADgAGQB4QIqPlMqiRke3DHAwMDwv/gqQRAJISARwJ/2AfkK/oT/Tf3JduFu4W7lh3FhuPreU
+u5dbyz3Ofc+P4Sfw0/j5/Dr+UX4P/zL/Kl/On+Z/E5yCTxgijBFWCfeauyhvKbW+Et8C31LfCt9x
+37vx1xKcCb6Ebgn9EoYmjEpYknAooTzhk4SvEv6aUJOg+lP9Hf1d/b38t/qH+ov8M/33+7f5d/tf
+D3ABS8AXaBnIDvQK9A+sCKxJRKKc6Ez0J7ZK7JR4S2LPxNsTJySuSnw+8a0kmmRMsibFJLVIWpK0
+P+nlpDPJSalIzU/tkToptTT4ePBU8Lvgz2lL0/6Sbk7PTC9p6c0oypidMT9jaauU1p7WKa1b7+6x
+e+juR/a49iTsuXnPrXum7Hlgz9494ZoWl9+6XHFNVC3hunAY2p8fO+lH2qpRWsUhWkeF3AyujLuH
+W8vdzz3JXeLH8cX8DH4tv4Hfzu/lj/In+Lf5M4IieIU2wnBhrLDG3EXZqXzkg2++b5Fvhe9RX3k8
+EpDgSfAn9EwYkDA8YUzCsoTDCacSPk34OuHXhMt++NP9+f4Cfx//bf4x/mL/HP8G/27/vgAN6APO
+QGIgO5Af6BMYEFgT2JBIEy2J7sSkxA6JXRN7Jg5ILExcnrg2sTyJJumSlCRPkj9pSdKjkTpKQWqH
+1C6phalTgo8H3wp+F/wpeCntL+lyemZ6cUtkFGZMypibsbAVWntaJ7Ruvbtg94DdW/bo9sTuSd3T
+fc/kPYv27N1zpQaX37r8p2tQEf5Nq6Pw+fDJ8InwsfDrbMXN3vCO8MPhDeHl4WXhxeFF4bLwwnBJ
+eEp4Qnh8+NZwv3CfcK9wj/AtQLhTOBhOCithc9gUli8P+/uVv1df/MvF7y5+c/GLi+UXT1y89+LK
+i/dcXH5x2cU7gYtlFxdcnH9x3sU5F2dfnHlxxsXSi1OBi5MuTgQu3nFxyMXBFwdd7A1ciFhrceGL
+Cx8BF05fePfCm8CFYxdev/DqhRcuPHfhmQtPABfKLsy7MO5C6oXABd8FN/DjkR8f/XHjj4t/TKvs
+V+mqdJyvPX/th/d+2PPD/T+s+uGuH1zfX/7+0++3fa8Dvj3x7fFvj33b+Zu+wDf5v1caJjgAwJXe
+gCBN7xLXv2EJp/h3/r67IbaCnZvagr8BmBbhBQDSAmh6rf8jra/7nv7fzrWtCawAxEv8pDXJIn3J
+YHIv2Ua2A+Qd8ilAk2k6bcc0hD0AfZo+Q/cCAH028jTdx+t4PQDwBt4ofgqInwHi59Ie6WnpmQiN
+tFc6LL0sHYl+e0X6G7v+Ckh/Z1CN9A92vSz9U2KcIf0m1Uap6yRVCuugIwCgozqOXXmdAOhEQCfp
+dAyj1xl0rLZ0sbpy3Vu6U4Dubd07und17wG693WndVd0v7H7V3XX9G30UY+QPoed28pJcjIgp8ip
+8gQAkCfKk+TJ8hS5RJ4qT5Ony6XyDIafKc+SZ8tz5LnyPHm+vEBeKJfJi+TF8hL5TnmpvEy+S14u
+3y3fI6+QV8qr5NXyGvle+T55rbxOvl9eL2+QH5AflDfKm+SH5M3yw/IW+RF5q/yovE1+TN4u75B3
+yo/LT8hPyruYRWiP/DR75zMyq3P5WXmf/Jy8X35ePgD2J78gH5RflA/JL8mH5ZflaA3Lr8hH5VcZ
+9Jr8unxcPiG/IZ+U35TL5bfkU/Lb8jvyu/J78vvyafkD+UP5jPyR/Cf5Y/kTuUI+K38qfyZ/Ln8h
+fyl/JZ+Tv5a/kb+Vv5O/l3+Qz8uV8o/yBfmi/JP8s/xn+S9ylfwLIFfLl9i7/ib/qlnm5H/Il+V/
+ylfk3+Sr8jW5Vq6TVTlsgomYqIkz8SbBJJokk86kNxlMRpNsMkFm7VLfN14D4AdwZ3TE3AnQDwCM
+A+hHAP0EoBsAqnHnNwDdBNDvAPozQB8DaFX00Pi1GqCXNL4F6N+jx7MA/Wf02Bd5BdcFoM8B3C2R
+g/4CcLdGDi0NbljkoH8FuEKAGxtJl5sBcPMB+ivAlQHcUoBeBbh7AO7eaNpro4cEcPcD3OMApwO4
+JwHue4CLA7hLAD8G4O4A+HEAPwHg3gf4YoCfBnBnAH4GwM8HuE8Afi3ArwO4rwB+A8A/CnBfA/x2
+gN8DcD8D/F6Afxng/g7wRwH+VYAnAH8C4MsBngP4twH+HYDXAbz2vtNaPwZ47X2/AbwMCAogOAG+
+PSB4AcEH8J0AoQ0gDAH4mwFhOCCMAfhJgDAWEFYB/HRAWAMI9wL8Q4C5S+TgHwaUnYDyFsBvAZSP
+AKUW4I8BPgC+EoD/C+CbD/gWAMJQwLcI8C2NvMe3InIIowHfo4DveOTdvnLA9y4g3APEA4i/Fnl3
+AoAEJyCuBBI8QIIPELcBCX4goRsgPgEk9AQS+gHiO0DCACBhKLRYskgYHjk0uZZwR+TQ5FvCqMgh
+fg4kjAESlgDiF0DCMiDhECBeARIOAwnlgNQKSDgFJHwCSPlAgpbWV4DUG0j4Gkj4KyBp7/wVSKgB
+pPFAwmUgQQWkiZGu4E8FpFLAnw74OwLSZsCfD/g7A9IewN81ckh7AX8B4O8FSM8C/j6A/1ZAegHw
+3wb4BwHSYcA/NHJIrwD+MYC/CJCOAv5iwD8TkE4A/jmA/35A0iJBbwD82wDpPODfHTmkS4B/X+TQ
+JLr/+cihyXX/C5FDk+/+Q4D/JU3KA/4jgP+oJukB/+uRQ/oNCFAgwAHSVSCgjxzaCBAwAQGLNgoA
+AScQcAE6AIEYIOAFdNpzvsih44FAYuTQRodAcuTQRolAKhAIaqMFEEgHAi21EQMIZEcOnREI5AOB
+XoBOBgJ9Ioc2ogT6Rw5dLBAYAARWALo4ILAmcugGAoENQCIA3SAgkQKJPKArBxJFIFGvjURAohFI
+lLWxCEi0AIlOQPcBkOgGEv2ArgJITAISWwG6b4DEDkBiJ0D3M5DYFUi8BdD9FUjsGTl0fwMSBwCJ
+twO6X4HEQiBxLKC7AiQWAYkTtLEOSFwOJK4CdLVA4log8XlAr+VRy99bgD4VSKKRQ98CSNIBSUZA
+nwYkKUCSFdC3ApI8QFIMoM8EkvxAUoDNK5GUEjm0kTSpReTQtwWSlkQOfS6Q9CiQtB/QtwOSXo4c
+2lw26Uzk0PcBkpMih74vkBJdPqHvB6R2AFLzAUN/ILULkNoDMAwCUguB1EmAYSSQOgVILQUMRUDw
+8chhKAGCbwHBU4BhKhD8LnIYpgPBn4Dgz4ChFAheAtKWAoaZQNpfIodMgHQZSDcDMgXSMyOHLADp
+xUB6CSCLQEsALb2ArAMyCoGMsYCcBGSMBzKKNH0CyJgEZMwG5CCQMRfImA/IbYGMhUDGUkBuD7QC
+0CoFkDsDrT2RQy4CWidEDk0naZ0ItNZoJgKtW0cOuRjYXQDs7gHIJcDuAcDu2wC5FNg9GNg9VNNY
+gN1bgN2PAPJcYI8O2OMC5PnAnlhgj5b+SmBPKrDnZkC+D9jTHdijpbkH2NMb2NNH00KAPf2BPbdq
+mgiwZzKwZwogPwfsWQTsWQzIzwN7lgF7Vmm6CbBHS2sdIL8C7NkA7HlA00mAPXsjh3wM2HMF2BMG
+5ONADYCaVEB+G6hpETnkauDyW5FD/itw+U+RQ9M6LldEDvlvwDUAWogMzY+mAlAtgPwbENaOOsCk
+B6loC1IxGDCZQSqGgFRMAkyZIGc7gJzNA0w3g5y9CeRsZ8B0C8jZEMjZAsDUA+Rsd3bA1BfkbG+Q
+swMAUz+QswNBzpYApsEgn+4C+fQpFh2YfPY5yGffA6YZEKkBAMh184wG35H291/NIyJPcuAhQGSe
+Lz0MzPJhghkWKLDCBjsccMIFNzyIgRexiGOrAhLgRwCJSLrOX9oSGVGfada/8Jp2RheEcDO64hYU
+oBu6owd6ohd6ow+zHvfHrczvPxCDMBi3YwiGYliDj3VUxC6DsQBWYhXW4D5sZDHgn8QT2IXdeAp7
+8AyexV7mm38e+3EAL+BFvIRDzDf/Ml7Dq3gdx6T1mIUiTMAU6QEswOOYgRKdgHko1l3BamzVfYXZ
+mn6PSZivK9ed0X3A5ekuYCoWc4l4GkdxF8Zjuu4zMlj3N93bmIYl0kMYh+VYgYe1XdPSculu6T5p
+rbRKWo1XDG68QTpKz0p/k56Sdmu6rbQBC6U10jppG+7BWtyNdbgX67EBD+B+5i8GNjFf8aP4O11N
+t2Im3UwfpltQRh+lj9A1xIDZoDiC9/E+PmEeh2LMxCrsBMWPWMTwb2h7gHkdKL4QNNpPcISkoRhU
++5BMABTHMBs5OIIf8T4mgmInxrH7V7kP2Gcr9wGdD8oN4LZyzI6MnTjC3cTz3AeRgz31PjrhKPZq
+sPABy/1Atj6hK2ajN17DF+Ru7CJnsQTrMJsHAB8xCGdRjGKME86yzyWMZG/WcMXCWdGBnSgmBryG
+97ErgidpZCBXyE0kw7AIlOzleqIYK1DMF/KFfJB9Clj5ImWgdBFm15cXH9CRNI0Pkr0Yyd7xAXZi
+LzoJH2AiPkNPfAaq5Z/7GTs5GUCM8AZ6S71FmYjSEgwGxSIuh2wVfSjEEm4witEfFOvQn3yG2YAD
+h0WB5yhBhl85QFN6FR0I3TbM/87wQKuM6776Fcl/AAMOmBb6j4TDA4bxscLwA0LcAS5Fd4BPSfr+
+j25+3yqjz4Bh/gPvdiuIptqtsKBVRp9Bww7QFO1bwfBAoFVGt4JWAEChA2W/0kAxUd3MTxR2gYME
+b0jm34b4NtEJoyiPzPKKqjZQKqoqqrLs1oA1JWANTORRO5uLrf1R3SyZr/w6S0wDJe7weRoQzsII
+K6aFbBZurL5QHmczE8UkQoZiU8orymvLleo2UCprasuzQnnHrceV45bj5uctz5s3WDcoy6zLlBnW
+GUqhtVAZYxljvtVyq7mLpYtZsSqKYlHMsEKBBeY4MiqkT7AmKAmWBDM/M6Vtu5xsl9MhJiWm2gOc
+MymXuPvmte/fv31eX57QgkWTO/Tv36F9/37CU7Xv19aC4l1uL/1ROBstr1EgPMbpeBFUp1Scqzjd
+BkpN/unsLGJNsgasSVYyeQeN2yGcrTtLW2oHAIrtgOgWjoFDAoaG2vn0wni47ZbxNNYIiY8xUac1
+IT4u1ut2OR1EoLLHblXMJlmvk0Seg8lgM3ioX6k5XW5151ndeW2QmV+enV2hnZTqyJFFAlzASAL2
+HC7gDLAjyc6O3AA7eJt6YTDx5U8k3kn7ppC26lMDSUd156R9U9QfJ++dqL5PRg5Uy8n4ydwK9SC3
+Sh1Ldqhjt6oHH1HHke3a8Qjpv5XsAMBhv/oJP010QIQJbTAxlCeaHKl8wNK6VYbFbMeEjIA/RZoQ
+6/NmZhRmJxr8rb12TtJDMZg50a9Ly0iJ42AQzW6ardSUV5W7sysqq5Rq5Rd3dqTJtaJVZVeUK9XZ
+n55STpyw2tx5WSmdSW7b1CBnJk6Hy02sOfHEbW1Nctu2a2/NsWpfklqToDWeOB2iZE2ytib04Mz5
+82YVTZo1ZYY6biq/ZfOFo+fKiya8OnyafO+8y2/99ElR4YcFhuljhixIoI9KU4YNKA7U9SSONWs5
+8mNoz707T8pEVmt0bdLUq3NW6tX8W557YPc7FvVXYqY5AATMDJ+X0oRLMMKNJASRg3GhnFQumBxM
+5lJSU/rJxR6xuDWKk23FntT4whbJU9qmOC1BPWeRA045Vi+3jOXkNsa2Sk1VeUWVUv1ptdWWZ7Xl
+sTqoyS9nKKU6q8+gYZIi/KIdwxNJouh0uHKy2+W2TW0KB+v5OrdtO3sOSbI3+U7eHzZ+wpDbx4/n
+Xhg6vmjIkHHjb99+9NXHdh59NXFL7Ygtsdtfe3XHjleOCht3P7Tx6T2bNu2pS3r6oY179mzc9MyI
+c6+++tVXr772Ff3ut7+Lpq9eff3Lr147eg4AxcbwefGYcAl6tMeJ0ASziVpkJbddrlFp1y6XEo4o
+aelpOj2VoKSnp/XLTZfRjrRypiW0aVWaKU23JEyNL0qaHjvFUtiu1JCW6k9v3So+K9sg57aCP544
+Uz3tDdl+S3wrS3aqEu/hzdk2UweNYyq0mlGqGWfkaRUWOUe/tEFmdeXlarVaq71sW16eLc+al5eX
+F6lG8y9Wd17DRfglCg5PTCGMs3Ky2wmBoJkk+aGxFmOwnGwX464kVt1u0pm0T8oNOJPMRHLn8APr
+jg4demHfxJfoi2rpminV96qq+vEjj+Zk/fjWLS/OnmleNn3SsoKplO92q+EQ+WKfdfygfX+5SCf0
+f2ni7u97PPnAsCFE98OKixM6Ley649XERFUtmTFzRKeFM+u+G/DKlJKS5T8UvgwQTQ6RyUwOWQ8T
+jONBeSUifk4z4UMmR6UOCJ5Q36DrRAdMiAuZJBPEmWYRBitHzUpNbXl2Gyi1p/PyskgqtSq29k5R
+u7jpur9fufL3rStXblXfmEa+IONJEfli2rhidan6pLpLXVoMAAQPqG/QJSzt2JAsEehmmo1WCbxZ
+qSjPrqpP2p7bzmZVaDDXpV0e2Lpq1daaK1fUN8YVk7vISDKK3FU8bpoaVLepj6lBAAQB2pf7VrgE
+K3qFWiomo0Ev8ZTAIOs4wSqbp9h0olk2CHrJahE4IlAovFFvkmyKJgbP5Zdnu/M0MaFVSHn2ufJs
+qzsvi5iIlGJPsgvBVqS9wOVwKdy36kKyqq+6y7TapO7qS1apC/uSkabVJjKSP3v4lXlfqHeTJV/M
+e/nI/C/IEvXuLyJl/gIQvhJ4GBEIWSBxs/RE0PGQqUGUtffX5pe3QWZFfm1+eRaxBpzaQBDIDVi5
+vbRN3ZmddWdoG4GvO/OEBjxB2wAg6K3+TN7CeegQF5I56Hi9DoINnF6pKK9iIqBCq0h3Z9KJ5JiJ
+1Hv0sLd0Dzz4drdXV6Q8+48RAAhGkM/oCDobHJSXKHiCKEtUnM6yB5yBERQq6OxtjPaN8HnyPqq1
+FbaHTCIgKxW15X0O2G8bdshAoePz85X8/OFtcIgNxkpNVeSeQDijFLmX1b7J4PlG/ahZP2ACBAh3
+pQcZj6aHrJQDB0JJP4opPAXhMk/n5UX4NSLP3tWO4YkkhyRR28666p3C2d+mAaBYFT7Pr6+XqyE7
+JsjiBNsUudDDiU6HXrQYPUpNVU0V0w6qlOqslGyNy5ISGR8nwqogJ1s70/se2bLlEe34u/pXYv17
+DVHUS93JADKIDCYD1APqPvU59cB4soVMJ6VkizpZfUB9UJ0MgOAqwNUIl2DAglAcXySIAlckCv04
+kSeCOEVHZkMvWXlQo1JRXl6rtX9Vdk25Nc8akTP8L5LCa9K6zwHD4GGvw4gQCIwwhk90sLbvMDyk
+98NPMvWZBn4U6XPAyGjCJyI0CDEajYWE3JQca8BJkgivfkKm3kv4Yv7LN++uuloW7Y/3AXx/4RLi
+0C8UkB2wmDCHFnknCFNM860Ok+yGgbhFndmgEJvRp9SUa+qZUq1JRE1QMjlZbs3LywoZuxu6G7vL
+3R3dnQIZRZKsnUlONu90EFEigdwcayCXsCE4KVEk4oySLyb/TMzqhx5ZXUVL59Z2Im9tXvbYveu3
+8TOJIS//h5NfqWfcsrrqiLprGinffO3eh/feDxBsC5/nlwqXkI5+oZZ634QE+xzbBPeEBEvaHExI
+ncBNsRS29MfrfUlpBiSJoleUHQbYaEsm9xuynhdp+9oq5RQT9HlZ9s4kwpySpgHwgcTUoKYiRMbG
+liQ3AiQlilLS23M/G/3V5b3bjryvXlSvzP12+gzD/u2PvHD4wJjs55/fsGrVQ6YZwuaM1q8/veol
+v/ezZ//0bXYOSdyw6YWtB16a+MCDd9+37E5QaFbjA8IlUFixI5RCi/QGPSkyGPT9iEHGbLOkCLMN
+Uziq6YpWE2cx6qhNqanIL9ekhVWr+8ra/Ir8quwowyh8dHw/mKknZNRhv95v8pu5CHP0OSAPvqPP
+AZN2sgy+Y9jrsDFescEW5ZWQx0i8pAPpRXiRumgLQzvawdCD9jRIozCKWPX1LZjDxFMOfV+l3OG6
+YZfq5tD7TxGfev4Sucp5ay+oYjFJmcbV1BpK1K8Agv3h8/xC4SwS8FwohRN9C7wTRGWOZYJjgiiT
++RgnF/p9VmqIh1vUx1sNFuqPaDPlWkNFeyhrI7XSlpdF+hzQD9ZK1FAQPyuIH/5oQUifA7bBfQ7Y
+IzdZr/FrRJFSplo4C28RLKJFsugsMRavJdYSZ/FZ4mULLCQBCSQTmUTUyuwMMAaG04HmzMB4wMqL
+dYPb3jfkV+JRfwxj0uczZ+qf3Lh97zM7RqzJHSKc3aUOTk5Wa376Rf2n1vr3b/7ozZNnQrcw5w3W
+hM/zm5l8SsH4UKIMcYIFE5wJU+MXJk2IneIsTPUb4l2caHPKol7wGFOVmqqqRolVG1H18rJCKXpZ
+b7LyiqCIimTV2WW7STErFkWxWtPkNFP/hP7+/gHbqJT6fDsdLq6+UElM0AWyXW4nU00o3b5uy5Z1
+6zdveaVg39RvCaf+/HOdeol0JSm37r9jprx8+slj3c+cPHnmndff/pRe6tNbrfnpvPpXspCMJqvJ
+/vj4yxOK1S8BEOwD+LuFs9BhVsjHcSCgHCHoJxBuvjSOcJRw4A0QqV7rlZEZSU1VPSM3KKpaWw97
+HXrWwnrom7awhmeNq9fuM/whN/FwdJSeJJGAM8ka4O+uO/IQbVXXfy73mVB69QGhzREyEgDH6n9d
+dHzIROdQYrKHm+DUT4i3TEifEl+YleyRbUJQFlpBjHWKYjBgzNIyWlMvPuoHjk+rNS2ovmZzsttx
+TeCIHElKTG6qBjodInd0x4YNO7Qj7d7Fi+67b9Hie6fuPaZevvJP9R/Hnn348StffHHlcbJl5wsv
+7Hz8hRe4eau3bFm9ZsuWd90f7Pj4/PmPd3zgjn1+9aEzZw6tfh6guCtalhikYFQoGV7/BM4+1bbQ
+PcHCeSfop1gKUx0GG8QkQXb6RJtL1Lipsqqmqio6PdZ6miYCNX6KTSAJNIFL4BOEhPiEhAR/QiAh
+8Wb7zY6bnaZRxEySEpHbNjo4ap0il3UKW9NpBHfslv3TvlHDxP1zHbGox9Rzt+6/Y4Zp+bSTx9Rj
+9z/88P33P7yFPtanN5F/Ok+s6ir1CXWBOjA+/sqEqSSFTzxz8uRH777+9qcAKM6Gz5NvuYWsnRJC
+2jTfOdaiL/TYRMjwKJr004qgtUoz5cLaBD7br0OHfn3z8vr265DXt29eh35M4ejQrx8tj+LB3qXp
+U+e5hdDBgcSQomlUtrEy79TDItg4p1JRy4wT2jzrw6Z6lb3Ju6I6VhftldpRr2zxxg79+rfv0K8f
+0wVHC1/xuyEiIaQIPCUQOFEiFB7eLSk1VdkR1c2dl6WnTpJLhK+u/cqbr6oC5WrVFeqqveRPu8mf
+Inn+gkwUvuK2g4MEX8hMZ0GcxesE4uHh1ik1keqJmFMCTvb5QqBaUldVbvvOKztxfX5EgaMQeCKJ
+VEtD0tJoyI89l2gZYknwZq52r5q5W83cSxYCoLgvfJ67yPpUIvJDfhc3Vu9xBuT4CU7LFH1hkgjE
+em1OArctQGSaxIR8ZBrGuFCblCkfKupbWU1NLFxUhRAlp6ZO1AtfMrlfRHvs93Xx8cHfEPL1t4te
+ePHBO2c94BzbYIAZn9n608NHq3JU0rPle9vWbL97UUQ3VLdJpcITCKEfpoVu6npzn27B/sky+nRr
+kcyZupXp+5RZykLc4uSylvrFnrKW7fsHW9jMPWPFm2XEmuNsvk5iXHxspza0v1JTXlulqHXMgqLN
+HbWKiqDyq6uV6vLGKaV2yUoxk8hsWpsO5uZEyhNMTdaKJFKnw+Z28TnZ7dq7RU1qBFNpbltb+3bJ
+Odm8O544FQhcbhPxIphv2jjq+S+/fH7Uxpu27X2t4JZi9cyLIw4Mee+7ojHTSMvn7vquMHeqWv3k
+y+qLS+5asXLZUjL85Kdk7Py+/dWj6gWyfvbCVavLZt1/ZeCAq++++9uAgffX1qZcfa301OAV61KD
+o9RV/9yjXpw0f0nfvt1HjVqxaCnp8foR0n3pnat3bxt3YbFao77LkfVTljz6/PYdz2sLFMI1bI5z
+FhQ2PBe6DZrGS4sIFUXBZqVKkShYGEoBlXQSUXSSWdFJRpmaFJ1O6kdFcykUWcfxpeI4A2dVLGaT
+USeJAiUw2fWcXak4HTVdVVa5syuqbA2jhTtbEn7R/hUdGzkkhop+GZ54sIWNkFEhlzbAW8wWC99C
+aCG2MLUwZ1hb2thIH9CT3BySQ3KcgdwcLsDlOMlWmqxW1/W8mzqeps6Ndd/vXlK3dsku6qOnrvmK
+hZKrDxUXk57qkWIA4DATEIrYeGdFMrqFgvFSqd6plLrH2VGqv9OmGCTiiU8WYDLH8uaAy5ash9UR
+SNG6e2W21kGt7og+XVWVXVOVremjKYHIoB3IrVdBSW6ONSk30FRJJVdJy0O7n3pJPavuevjhXao6
+/9gx+v0PP+545Nhb6k/qZ8Xb9j7zaPGdq9csubq5WEDJK0dWbLd53tz1zccACGaHzwu7hEvwoUMo
+1jsdnLLUUuSYLk/hCuOtFkuqLwi3WZ8Sr2gjXxPt41R11iEloTSBklFEY9ZUjVk1XYKPmjm0iZTG
+ysKuruq71Q9eHTtjGnmQ9Cojjh0zU75996+XL/91z+dZ5L4/fVY0dgbxHSIjybOhm9WnXzuoPqe+
+rB5Rn9uxPbI2Vqhh9epEp1CMLJWaxxlQqpeI0wLebDPpk2E1BlxKTZVWjVHFPpPVYVZIn+W+1b3M
+vcPNk1EpOX42ZiVFKzHyjVw9SpJIe/U99dxRVnUDyXLyvDpAXVpM+mp7TkjPSL2p89TH1Z3qHBCW
+pxzhLNwYGEp32i13WZeZ7jJK+mVcqTCOlNqXGe+EIlvMOovosLh4JNsMVhrwNDZ1hSaVM6s0ha62
+XKlUK5XyrENZMbfGUDIqJ5I7dgRycxquM48d4w8uqhtIfl60SHXTY4tYpg79SZVfVZVX69b/ST2E
+aN7aC2fhRPdQsHnO7KQ0mjGHQWcxi/UZc0UzVl6laOZSbUpXnX25WmU5y7pRfnKcWm5OLarrTNRF
+i1T61+Z5ubMYDbz1pnAJ8egRSpStelOpRT/dgaW+Im66d4qjMAEWn1m2+lIV0exOSdBYrLLBZN+g
+4uZlHfb7s/y3+jkyirQmSX7KJuft2jP7iMgnRRpSYzwhIembU6qqElGd+eEo9SKpXKF+8Ly6X51C
+tpDhy4h1K+9+9UX1kHpAfVnd36EDuauk7pN+/cgeMoaMI0/dlK9uj9ahuEY4i1iMDrV2L9NbjBLH
+lZqEcTFOK0dK9Xfa4ZCTDV4xWWczJ7sMVoVHskdnpYG4+jbOrsxW2L8mraqr3dmX86s103RWSO/3
+zfAt8x338Q1t7WR2f3Zl8scarWHa4xgnaudjda8cq716TKvlkrpaypeUXENxMV3Oaprllx5hvpD2
+IYdBLNWN41FK9SQZVolLFgKyUlOZXVuZ3dgvTFmmAaZC0wzTMtMOk6j1DWegPi/kqvY67bXFxeyN
+AEh4sDqEfiachQPTQrE6i56gSJhuM+lLjeNsy53UAjnZbDXonNrwV8nUpComxdRTWgvWmzCcTF93
+whnV1w9muYg2PTUPrp/LOZmm79QoGQWXG510RSuI6QT7ZnHDhs0f/ROdeezUuR4b1a1/GT9285dC
+XknJ1eP/PJdY34Yxwll4SItQgVJkVfQGvWykhiKLYnEo1oiLx+l09HMqeo6YDVoLy8I4l5WU2mEw
+GTmbItrcYrJTZ+WRTAMxrA7zK7PLbfX+k6rs7Jr8KjczE7JxKDr2KPWDz/DEgzO82sDzhKR36ZP1
+QaWdvru+p3Oofrgy3DlZKVPKnCaDwWA0yKJJNIsWURGtHoPT5DQ7LS6ry+ayOx3phhRjipxqSjUH
+LS2UjoZ2pnZmzcoSMnaXQ3J3U8jU3Rwyd7WErCFbyB5yhLyDDMOMw+TbTbebB1iGKcOsU4xT5Amm
+CeaJlnHWQluhvdCx0LjUuFBeKs8zzTPPsyxUFlpvGYUm3EjYRa+NhqSBFendT5BLT9ctpR/sVZ+q
+y49y4/s0t6Sk7oO6nGL6Vt1TxaiXQQnCWZiQFfIa9ZImfPTLSCnEZJ3BIvNI1vqKOSqylUomcS5X
+ZyvlWU16RETmaS/ey2RdyTXrVfU+gGAiIL3L2tYe6mEvknSSrUink5yKKIkORZLEfk67TeKJgSvV
+CeNcisyRUjNEp8MucW6dJdnqTnZaraZkfbKRT3Za6pu3qrKisrJKqVQqtRZmTgCt09bkVzc2sfBL
+xMrSYGmxsAZerZd0NostRvLYEmwtbJm2NHuao4Otiy3PnufoJfW03Wrrbe/tGCYNs42xjbCPcEyR
+JttKbVPsUxzzpaW2BFEUJVHH2XUOl+TQOeypUrIu1Z7qaCfl6HLtuY7uUjddgb2743ZpsO52+2BH
+kVSkK7KPd8yR5urm2Gc7lnl3eJ/3HvcmNzajnjTKE5IUacZ3yYtUpjLZ8vbxuiPHVKxTwZrx2hd8
+kIkUfvm1v/LWa3dG2rEofF7cLZyFHTNCrRStdiXFolj6KRKRdVypQRhn1SpWnyzZkhVjsmQx8UhW
+LDTgiDZtpVaZbZB5qlqTf9nZ1rwmhirNtHnQ79SqzyxIJMt5q3OGc5lzh1NoLASJMgPLf9G775Kl
+3NJ3qPzuwtqCd1nGJ/KZJSVXF/J/vvaYlmeqjTmij81B/MgI2TBdFqd7psiFAc4cqzfbUgJMkWkw
++x4ek1iauDORI6OaG4BTo7p3Z6JpDQ4qmQnX8x9Xr/7j8tWrl7eEx86YNm3GuNqtpBvZMH3GuNr+
+5DbSm/Qht6nPqy+ph9XnSzSlJu6ll4hvxrjx6hD1yRfV70vHjW/Y7yPEMJ0mBreEAih13s30Grch
+otfwnqSoauPSdBtv/aCiXKhXb7TxOVutzjo4JpaQUaReT6xXD3nN6qANIuQq0T20bdtD6hWm25z8
+jojq1e/Uty/SK49veWRnRLM5/8aJyrqviyN5m60eYfXnQiJCoTiLezpsDt90/RQHCpNsFvgddq9Z
+9pu5lCRWk3l51qgptbymSvlQU1sPj0kuTd6ZzJFRgh/WhkpUIMSTm4izNQk2qWquUH1GLW6oTLVC
+vdLu0WkJz/ytKFrbPvIUGdtYj+rn6rSOt4Re0T302/V1Xl+3Yns2didjdain0aDnZJ3C8Zyk8DzX
+T8cjjuPjSo13J9lLneOsJr7UIkoxST6jjvebeYGLccFvFoUYc7LsN8c4lOQ42WprVNOVynqPoeYS
+tLnzrBExkR1pEM2NaGtmw2J+QRJP3PYcZ4BrTVoSa8NYy9T4BiWfO9t9QosvDqs7f75lxrhvP6nb
+dewY/ceXvzxXV0aCD2/Y8LD6Bf3RfsdAtVsx+ere2+oOscH5o+NbX0hdv3kzs4vvC5/nfxQuIY0E
+Q12TEmlyQDHKxvgE6vMrsmzsZ5T9Ad5FlmpDd9C11FnknW6bEixM9xvlgE/ntmjDuC7NKSX70mCh
+6cxczrzqbGrbxGBerVY3SMSmztDhiQeVllqHNvpbZrUc0HJGy2UthVGN5tg+BxxskE9nakA60utt
+snNaGNKMmrkyLSHNnxZIS+ygzzN0NHaUO5ryzHnxHRM6+jsG8hI7JPUy9DL2lnuZeib08vcK9Eoc
+bhhuHC4PNw33Dw8MTyzWTzVMNU6VS0wlCVP9UwNTE6ckLTIsMi6SF5kWmhclLPIvCixKXJjUfmfL
+Ay3pKIxqb3XXm3QziWagS27up00g9Z6AY7OFV48ffHXm4bc+fZ6YayZfWTvT+sJL259a/O4r7z+n
+/ln9ZVG4JxlM9j65btXUOe1vuvnkM8c+btVSPbl93V2LSsry23d6fccX57IBgMABCF2Fs7BgUShW
+Lxi5UmmciZTCYNHxFtGcrJOtSBapolSUV9aWV0YMJJnV2TVqfnl2Vr1NVGGVqECp16USrKSJqV+j
+YLqUolFGqtmYaS20rrfutB63CqOYolmvemqyYtVxtdciofUitdfxYr7mZ1Ko7vz5mhzV5QvD58Vx
+wlnEYHooRRZj7NCvcFlNPIdSCx3nKvRyyYI5WbYoyTaSLFjBpFZlRWUtGwGUSqYpRR0vWYcSYgtj
+KRnVzFDvZeXxwltvy90QuzOWjoroIlZFM3jE1H+BNnsjCcdpz+Ov/vjjq8fJlWPqVfWUekr9h1Bc
+UlJbq/7www8knuNLSmp/Up9VL5A4MggACVcBgrYWx4wHQ3k8R6mmPpAiEJ1mkaBEb9CbzBQK88Lo
+DCLlUCrz43Qmg14ncZRAbxGMlgZLREQBbGqHYFqgLqoFDk88KBGCUaFEHdFRnuN5nd5DPNSjjzPF
+mVvQdFO62SQRFwkSbhQZpaesfFo4m9yAk0wkr5AjT5PDD9T9ZV9d9UPULxRfW8mXlZRc3ayVEgCB
+AdBpc1EH2Ry6gysyykbN4iLLRqpw1GQ2EcVsNlkVailywGEuMpkBh1GRjbzA6/TUoAgC389spBzs
+Fj0nlFplaRwHh2Z6kY0GXWQJmcUmOpn9TxN1NfnNdN7flzki+RoxwxMPJrgIa2z74Bto+KEvTdRg
+FMxemkATjF5zCk0zJpu70Hxjrrmvsbt5GB1pHGyeZiwy32mcY3bpiEg5Tq/T6yWjZNKZdZYYxBIX
+VTgnH6OL0TuMdtljijGnIpWk0lQujU82pZpzaVuunbGt3N7UC71IN9qV6853M/aQC0w9zXfgDjKM
+DuXu4IcZh8t3mMdYxihjrGNsY+xjHGNcEzGBTKATuMn8JGGibqJ+kmGScaJcZJpgnmwpVoqtkx1z
+6WxunjBXN1c/zzDXOF+eY1poWawsti50DBmFUYEkPUlqUKj1EUiM2aXuVffuUhduooGjpD0Z/AQZ
+QfL2kf0b+WPXumqte+0F/ui1PH5ktJ1lQHhaG9fI30OTOEpJkSiJKIqFJIlQCJwuZ4yXxioulzPS
+2k7W2k6nw6xYzFITM5vTLIEndovM6UqthnFel8CRUg8XG+NkBje9RAlMvM3NxykV58rr5zru6+Y6
+N273G/C/xUfIqIMJPtLMDRnp8nGMFeIQV88KYT1Es+T0wGV2OdPQQkoxpzrbmXOd3czdnUPMtzuL
+MUUaby5yLkSZ5JIgET3Vc5LEG3lZMukskksXo/O64CIxxhjZZYqxOBWn1e10u1wxLq/GEkEp1dTC
+nGZJUVKsyTHJ3kxfO7Qn7aX2pg6WPCXP2t7ZHbeQHrQH103sZupj6eXo7uzl6hV7OwaRQXQQN0gc
+Kg3SDTEOkQeZ7rDc4RjqHO4aHjsBRYxJisQJUpFugnGCXGQqsUxVplpLHBNche5Cz4SYCd4JsYW+
+BSgjZbSMKxMXSQt1C4wL5IWmReY7LYuVpcpia8i+1LrItsi+xLHQOde1IGaBd27srFEYpY8YJvX1
+fNQ4P8vNobvVjW+otn1kv9p3Jx1wgIRIrxPk56fVueSznXUHDtKldX8pLqaz6bqSEjWBfF9SUvdG
+XZfiiFwP/wwII5j8yAx1M8nUWKRYFUOR3mC1KgbFaNA0Jx3VM+XJQCxGzTprEsfZSClkq9FuNlip
+3ilRmO3JVquAZOpUKirLayvL2ZKqiN3bGrXI/Zey0qLJiYOI2AT0NzIY/J6HfidOiiRDO0M3wx3c
+COtEwwJuvkEn6XVGTuZMXsmrU2TFlC6l6zJdHaWOuvb6DoYuxjy5i5xn6mLqoi3/VLrrexmH6Icb
+75DvME0xTpZL5cmmUlOha540j7XXAtN6V5rWsdnEqkl7aJodufu4mrOWttxP5pK5T5OD69Qux4tp
+DL1YUlK3i44sKanLqKsoBsFIQOjC5sjTQkl6hVBCFZ1e18+o5+kycZx+GfTUwEkGi8xZBBna0ijN
+x1UecTJkVl9Wy5Xy5s7SgxaLpnt5ZUJ1VKSQoKONi+ktt1oMbGIV8Yw6mTd/JPdEbe0iflvtaK5v
+WU/B9i0Z0fNqNTMlguCA+iu9S3RAQnLIxs8W6WzM1GnmeI6zaYtXa6qUEydOnNAWbNU7mLSlAQfI
+n9RqYlMzRUfxbwcjfNZ8XQ84TYIR9NNWof2LdT12kkR2soU92roecSMItqu/0lKWJ80bOJsILE9U
+BxuvUzSXQF59dgizDwZyaamaSWxqtei48k2x2D+any+4p5mvQoQnZOB4gnESRwRJWzHFMpKdl6Un
+THRzr9GER1SPGrOVJghn6z7RFnXRVgABVB8PdRo4WF7CSsKDZrKlU1VZxBnI5VELddqAAZH37eWr
+6GyxCBzmvUTAE65xnRUiCxrMjJ15xs48+EZPt3lwdNlG/ZKHPgcU7WT9owfYMMuznsNr9xleKwop
+JfxcdZ9YpK4mCwCAYmL4PK/9Ap8BLXBnKDM2YIyBIpOymI269fbA/Unr4tenrrUXymVmGiMg0CrR
+lOG1IGgUvImu9LTIiprs7Mp671dmdaVaffmCUn252hqZJGWFkpX00vQFLU6k82UtTpAT9ARXzpcL
+5WK5s9xV7j7hORFzwmsaRUaR3AbPlp1NkhqcZYF6r18uW6HDZf6yZuXqObPXHdml7uq0s+SFj6q/
+IHTjiunLlTGFLw35+iLp//28stLl95PX6j4unt27+2s7nzrca+GKonGfpaV9FSn3TeHz/BHRAQcC
+6BFKCQhWJ4f4TTrPJjMp42aizFyoW2uL9Rpz7TTDaeWDyErUJoHZldmaFySzqpJNhBRtIpSXdXBp
+EiGj2jNNWsuizemgmk/EGcgl9V5xrXBVL5Ap7fYsKr9ypXzRnnbqw2TKxrXrHnhg3dqNxUKouG5a
+0Rj1vatX1XfHFO0uJnNPvPvDua+/e+84s3mFz/M+vgBe5Ia8eMS9njM9LD9kXa9fyxXGmlvJXji8
+YnqsNic/HbFv1GoZVCuzDpbGadpXxHOvoPkER7Oj877gNwd+Ui8Q708T3plQ4lx390NbHt48/34v
+6bL3IMlRESZtMrPUS2uXXbxw4afFSxr45ny0/m4Npbp4xckhZpMpYZNkLbPPRJlUaFpLbRlOhQuS
+DOTGeQ3pWgXWVNbm51f+rgJDeiQpSUuTDiTxUWu0ll/NBBPJb26gmeeTzzmgbtEq8rcrby3a024/
+mXhk49r7H3jg/rUbi4t3F40h7a9eJe3GFNG04mvvTzn+3ndfn/vh3YZ8a7+s7UAs+ofiqc5ZBt0j
+wnrZsx6P2tbKhXE6vdOLWOK1pDNrfkUV66eVtVVabSrVGkfb/L6Qb6mPO4Mz5Aw94zwTK2j8G4hW
+aE6TVRISq2mJH1J78YntCx9N2JTxyxs/qz8S1w9/I7RCfGD10gcVCt1nXy1d8vwBkqPWkrbqZy8c
+ff3YK4is8QS4maIDcZgZSpVcsBnKdIjj18fMVOQyh26bWW8irWAySkG0s1synNYsn1KTX5ldWZsf
+XT6VWZ2tmSY+PaWwlcxZB9fHR7RxpyYmfEx8+OCLio+XC1FIaJd4onlFI2ziI2y9V9RqkRP1XnMz
+x5JBt04aTNLUz6rq9h04cOCVF29Z2VWI6dN/5gNrSmr3FxdzA0tWHDzsiWH1rubx5/kCJCADE0M5
+GbAQuxLn59JQ5kvbZNdtl+T1fNKmmLX2Qv4x37ZW+lZSfIY/zhJEbqpXau9KbxVZssJkjeZdqKrU
+2OebC1EWYmu0NTZqrbRe2vpAa56MIhFnQXSFSrtOpClLafOrJgyVy+fU/jzlxLBC28olTzzcyFlk
+srqlnrO412rv+PG31NRvJxe+XL6+kcl2l6hroyx2or7N+GrRAR9KQxleh2Qi7hUGrkyYGWszc6TM
+sFaBtZM9LkO0ZNiDpo58hlcMIoNmxWtFzK6sabCbMs9RbXX9tPlwZsLShAMJXGPrxbPWi0d8tPXq
+nXWRhYzRNosU05rjHLz7AJmc8+TUA7t3qfyOMWXLVu5kYmfI1OnvfVp3qriY4s31Rw+q3qgtsDdA
+C4XzMMKJXiE3p7eVObYpcpkZnGjKkIN2S4Y1yxVxLlUwoZhdW1VxOuvgencTDnOxPLrgiuax6WoZ
+e4Mt7O1bMlvf0jUz85a/7K/784EDZLIws3VBQevMrl2vtWCcBBq+Sc0jB1l+4jEllBivuGVO73bF
+rrfr5TLzNvtjCZ5WLnCiq50laMqQsxKUmspatjRIY5habZXTp6eUD9lKjMOZ/kL/DH+Tukxg+UxA
+QjSfh2a7Z3soRjXLr1a5zbgq9wMt4zdnZd7Saj+Z+OazPe/uPEa6feAdQxvyr5aVXJu569VA4KvC
+EYvuAghuAviLogMxZGlomHuHXufa4XTpdZYdikWn15l36PU6u4PanJ0VxWLpbLa4XM5+LouOoyZ9
+mZEvE2d6HApHy4zbbCACp3faLbJBtLokmxXIcEtBIYPL8mplz9eslbY8zUwZcWdUVbmztW5zvc+q
+YSOD7hdJ94vETPMWzbQc+lLS6S16l87t0nktXleqLs2S5upgyXPluXtahuiGWibpJlkmuSa75+tW
+uzZbDus8vF4ySEZBFkyiGWbRorMbHZLLprcb7EZtdaLNrJidlliHx+l2tdC3MLQwpprTLK2UdGu6
+raUjw5nmyoxtJ2turjwl157n7BLbXV8gF5gKzN3sw/XDDMOMQy1DrENsQ+yT9RPliaZJ5gmWScpY
+61jbOPsEx0RXYewC/QLDAmOZssC6wDbPXua8z36fY41zjWt97GbLZuUV/VHDUeMR1+BRGJUWtZhb
+m82oiGTNob3rane/QKaQE4+Q7w+r5WTTI3U1W9STD+zWukytkTxWUqKeq/u4uJi8tEqNrKW4CaAD
+RQf0CIVsYpluJo8ySjIQlLgMIcsQ6SjRbpJ1cL2xSScxMOYzwBBlvkaHLNm1v+7nF14gUzRvbKg4
+Kl8k7VeEs/BM6Ga/127ixZbgJJ+ygguWpW1LCXgcRkEqa82tTXLpEWM3dJITM/y6DDkodnF3igk6
+M+xdWmSk+4PJGalZbaJCp7K2XvBEeSW/Orvana1WR7jFra3u7pI9Jrs0e0P2gWyhMettWNa1eOvR
+CZg905BpzHRnes4YzhjPuM94TmTrR5Ebi6TffdHagZHyKblPTd+za7dKdxaWLrprp6qLXimTYtuL
+Fu/mhs2df+ZPTHClPHkvE1zFNGPHqgjEDZ7yevGMRfXymC+ADzNCrSWTw8tzUYm87f9cItvc/4ZA
+dv53BHLhQk0gcwNLXm8ijx949cUGeazpLsV8AVsL2TJkx3pZXK+pLB7O6yzQey3pjXsatE6edcgf
+U6otKWm69tddr5g4HaCTNz388KZND2/ZqGl/MT//TGLUi93/XFn588+VlX8eT3JUlWSrH6qq+kFE
+Z1aH8Ef4AshIwu2hDAKrjzPxm7ymMktifJnfsMmx1lvoX27ZlpyQAcnrzg2YM3wKgqas5AbVmVmD
+o4N3doP6d2hpyoEUzSDsbKItB+srrl6bjlQf2VWvMx9ppk1r3YT2nBJVml8q2d2oTKuxJSVMBxnC
+dBAXkjAo1DJJsrs5+DcZUOYQSBnn3aSsNRRyyx3bkvkMt10MOmkGcn1eU3oyU1yzI5rrv8h9tH3/
+tZ5xoO7P+/9IwbgmlJSQH/9QtYjqg5/wBfDgoVCiTm8XCCxlVgiutSZ9mXWb0aCjraBk2HRBOcOc
+FRPh34oqpTyyrEc9dUqpVk6xPSahDgoUolCFU7Tl407FpbgVT6Y3hBAJ0RAX4kNCgTPkCrlDHicI
+qEbsJ34qjmpk+BjG8DGIqZ9+1s8tru/P3CfFQv8+qx84W/eBxvXt9pa1Gs+nqZdv7f/agfruWlpi
+jq4b4g7yBUjDF6GbmR+rc9SN1dnY6MZ6HDuF9UHX486d3vW2tY1urFZOX2eLLujsnkxbWdDtxm6s
+2irN/Xv5ejdWvRfLz7xYw4IJQX8wEEwMJo0wjDCOkEeYRiSM8I8IjEgclrQyYaV/ZWBl4sqkzQmb
+/ZsDmxM3J+1O2O3fHdiduDvJF2q5tOXzjuedz7uedz/veT7muOO487jruPu453iMWfM85eY09zy1
+y2lYht/oedI0jdnF/Lp7Fy8f88iKNYs+fX3w0fHF/Oq5c+aPWPXgwysuvj+p/KYr8+eNGt59SJtW
+GfdMfHB3RvpPk+YMHHjLbemtMteVPvxcBiJjU/g8FxRmwo3JIZ/bAMW03sHrUCbSbY5Cj6RYSYZe
+CHLI9LBFw5URO0hlbX5VdrmilkcX8Zi0JvcgBAIPPPXeO6cgCTqn5NSlSqm6zJilMetjdsQYRtmT
+cnOskc0iTeZFqblfP/HEK2SEurtt955d6X26+x/YcQ/3WgnprR4uqRu1qu/tYx5Yue4ZgKB9+Dz/
+FR9EDOaGEt1WUTY56Aq9x1RmwTZ9IY8MtxDkMkhHc4YSlDOZw4kpPZGNMdrqo+yI4ly/O+p3Lqal
+sQdiaQMvexkvezUydpsEmpghmJoQ6cPWJHqWDNuvbs15ouSFPbvJGKZK88HaW4pfKy599zOaV1L3
+0MkNr71IzgMg4asAv5QPwowjoTyDnup2mMwmaYcomc0mqbNOAgEvULGzZhGTIOsEbUjitsFsYtu3
+NZ+TkTb6nGr/SzuqzqCxb09RcklByS8NksZL8yRJp9PpY/lYwal3GlKQzqcL6fp0Q7q5A7KUnuhh
+6mEeimHmSZhsnsfPE+aLC3RMkTLFacbO5pZOclVduJD8vJe0JC13kPXz1YXcayUldSn0q5KS2gKA
+IACIx/ggHKQ8dIdxh91hN+zQGxwOu6Gz0QACvVZazf+2Q/O/7WD+N3tnh102yYqV2jqbTHI/u4Fw
+OqtJ5GiZhcc2o85htymaXyrqirMI5ka/VO3/gV+qULM3h8IccROXwWtPJhmGFvYc0smQZy8gvQ29
+7EMNo+1FZIphin0OKTOU2e81uERIRMcivgk6SS8aJG31lsPOJKmds0c34rh1ik7R2wwOo0d22e2O
+VLQgafpUQ5IxXUm3pthTHR1xE+lCu3Bd+C5CF7GL1FF3kz7PcJPcwdTB1tGe5+iObqSbrpteW+vV
+S+5p6qn0tPa09bR3d9yGAWQAHcAN4AcIA8QB0gDdAP1Aw2DjMNsQ+yDHANc4FJJCWsQV8YVCoVgo
+FeoK9eMMRcaJ9nGOQtdcLCAL6RJuCb9QWCgulMp0oYSF+nmGmca50fVg85X51vm2Ofb5jnv1awwn
+XAuYA8IedU02eLPshPuMKJe2krvmqeWPqFfV3zaqb80n3++rJjY+WHuSfqLxgha3gfFGK64z2EgB
+vpgPIpYcCE3SPFU7NE/VjoinqnODp6qzTmtiSnZoXq4dES9X5wYvV2dtRsT8WDrmvirzcNuYQ6vM
+aqj3Y0mUQLbxeps7Tqkob+rIqv0/cmQRzeugCYobOq6+42EwOxFrTkG6uQd6mYdiqDTcPMw5EROl
+yebJzoXmhc4VuM/sMDCXld4kWoQYwSvGeomXejnNXeU1OS0xMTFeZ2wLpJEMmsFpK/9amILmVEuq
+kmpNi0nzBmPzaBeax3XheqIX6WHubukRO4IMp8O54eJQabhpqPl2yxDXkJgh3iGxxaSYFnOTpMnG
+yfJkNk2aGLsAi8liuphbLC40zTfPscxR5ljnOBY457nmxsz1zo1diTXSWuNaeY1pjXmVZZVjpXNl
+zErvytg7NHOQvekkydjMJUUHf7uePLJOPU/63qc6V1386V516jqSor5+N/lqKV1DckpK1Plq++Ji
+0lk9WVxMNpFjmmOThK+qeUw2xhEuNJzsoMRijo2jXuywWMxGA9XvMFvMuh2SzmIx6zrrdYQSQaRS
+Z0pJP52PWrygLqd3vc2k16SEkd9me8xHnWZNQggEOovsbuWUOR/znljzIst1lKrayGKET5keZLX9
+C06IqAVsKadk1oTFCLcynEwhCwgvWZxKmpAmphvSjUFz0JKqDCOD6O3CAOF2cYA4SBpiGGKcTIqo
+1gmLpHmYTxbQO7k7+TnCHHGhXvMjLTTPtcxV5lnneefFzotrMQqjhPbN5ayZNDE1kKPd1dnNpG5M
+dllp5vjs0WJefvtb3nyB+7BeBl8bvPgOxfJ+wU2dAIKBAFfFB2FCcShR35lQQjvX+5rKxG36MuZr
+aiUYWkmyEHE1ldfvcsusVn/vaWq2ysTM+oIZ5nq7yRQ6UU+vczQNpFvr/lbMTaybSLuWDOEmHiFJ
+Q2ofeUM9CxAC9SDvFh1IxuhQVozFaRQDeq/iNAr+gE8/24jZxDnbPjNg0MtcipDsgp06HQQx/pig
+RUhhW8CYH0ozdUQ38GfWslX22tq0pttvBCcbyRs281rbtruJOJm2GtlPoQ3wvLvzb89Mvb9Tp/un
+PvNb54J1Q4qW3zNuyLofp2z6mtDNc6bM2Uzo1xsnD1unfr99dXziysfU79cNA0CITvWRvaIvGl+B
+Rw8tvkJVxMnD4ivsfUL0XYGueZnvCGXKrhhLAq+LdRpjLIKfj50dg9lJltnWmXxcjNepWAj4FF4X
+63UmOINGvrHI1ohlp7HA9eXNqy9wtJTa5sP6Yru0UjYWuyUh6L729nF3Lx9/+9ruXX7bM/X+zp3v
+n7rnty4/Dr2fJDy2MjF+9XaScP/QyRu/VlVWflX9ehPzoZGvha+4IGLROeR3OmJskmjkic0SY4SN
+fzLG+KQtzumQRB4es1uzpbvzonvRlBPMKxxtmrws4tQYPZOkBpmbh3l5EojLzTY5fVV0aFThfoPR
+tXHMyOeGF704WvvieGDsyANccNOwwV15Xp/Xq9+Wobd15Xldx96gxK3eRwP8EhYbKDlkM+vHyuO4
+tTYLMVlFyDA1jQ10uVJ9K4s0MfHFktwcp71JSJ9bFhVwV9jmvf79r77P5dbW1r4PABRb1c/4AUJf
+iEhEr1BQeiRBXG80eB+OeSge6x0u+1qToTDJLkNviG0VkyjFOw2cV0lSKqq0yDunK6qUqmy2eTG7
+VotVo22qUfMvZEViiLhTzEQLQaMtQ0Wz6DSdSXs696677rqLfJX02paic2GEzxWNfHpAsX120Yg5
+Tu5by4VPP7tg+efW5+xanBk7ybj0K2lpJQb1sq51UL206G65doq8dCoAEKwB+CK2R6NvqIVH0sEs
+OwyzdYjnJ8ROsc2Wl7l0il4iBpgMFqe1l1Gyuu0JjAGju5Y05ivP1raysGA7SnmkRetngcwnzPaN
+M+dBZFumm+8/xTCzcOlCsk0tIt/MqU154403fvz69if68O+OmfDoLvWLkrrXz6u7iunAEhL84GxM
+DACKyRjJH+KfRhIyURrq5NRzsXyreAQDRoPDzmfZ5FYWXxzlSKCVJRjIirfJ4NOTfXHavVhnukfP
+JRsNhKPJYronS6k4XXH6hDsa9iViIo3w5+mK08oJdyM2L4/FAWnvliJxgdxSMCKWpWBkT2/7YHs3
+m+Lam24DXvGn0X9qmZbW6kzhmQ7zu4WWdDo95oO0tPSMM2POdFjQrWvZbY/OmrHt0Zkzt3FfnBn9
+p/yyrt3m5Z0Z/UFGelraB6PPdC4LdZ+fd2b0mYz0FuqyGVu3zpi17TGAhC8Bgk84i2TSJzTK6aD2
+Io/PYyuy2nw+j02x2yRZsijUqsiy1M8mxBhmx+r83GzBbVc4ebbTPC6RzI4XEOPwJXg9dj7JZfNR
+XpOrVouRo3Al9Qr4rCb0EqkmZSrLa/O1lRzuaE1EFrxGWzw7uqQv+2y+5lj6V9MRtsh7i2BrZ5si
+TbFN9glGh8HpMsbKPpPP7LG67IpDcdpcNrfN00GXZ8lT2lnz7B0dHZ15nu7GPnI/Uz9zH0sfpbc1
+5Ag5u7q6urt7esUM0w2xDrcPdwx3DvdM0k0wTpInmSaZJ1omKpOsxfbJjlLHZGeps9hTHDM5bq5x
+oX2xY6ljsXOpc6GnLGaZY5ljmXOZc4Njg7N7dC1HIDeQGwzkXjfTCTgDkjPi/yKTj6tTyaaB6jEi
+qd/fR9OeJ0PJoD3kwP1qJVHUQz3IRnXasWJSOuXmdVPI3+pXfKiWKWWDp6gPsPXpRKc+QfYKW8BB
+0qK2gAg80fHoIWrBwCK7dcsrTisfsgHDHhk0nniCbKkz0Ms8tDMAUIwMd+J2sXXuN6MkdJMogPPE
+5+TxphbBjNJkk2wt7TIluasgciI8ObwjPtOc5+DSgo7e+Zm9A2ZZ3zsQG+R657ejXVn8rIryqorT
+LCDSh9E1O1GfgVIe6difnooYgZl0Etxs46q9wVzhdrkjnaPeaBHMrV9YG0wNRnpJvZGDLCpoT0r8
+HW+6752RdPKwotmXvfKbS6bM6t/r9HMjdvYbSaeWTF5Y6TR+fue8aQNvfWd3/y3c060H5d7zvi21
+MK/PSvrwez0HjR00do1FmrZ5/Jg7MzLvvOPu7bG+P5EBt40bPHOpLJZtHj96RUKbO29dviMOAMW7
+QnuuvRhgdtOykMEmyvBYnJzeUx9+rUILvwZtj1J0pYWtcX3R9daOpiuQIluWPEwB8miU9RQRO0l4
+WRS/LIJvEk0r0AQmnbK6dc3J7tqNvJTZrWt2TtduYqBbVk7XrjlZ3eqvADgSC4g/Ch/Ai2z01mKf
+yFY9nnU/zZmetT6t557pon8mZ3fsU112Bx2iF2Z5YLc+AfG2vD6Bfq36KBVsOUDdhxUfKh9G1Lpf
+lF80j7aqGfyzSNOdyc5m6wO4bN7t0mywUvNdye5EUYqMVayp20c2M7cmQmHsS/e/+u33rwx4dOhg
+66wp8xcumDd2pvPnLUSaNm3q1GnT1N+2bFF/i8BE2nL3D6NHjRkzavQPd99zfvSoUaNHjj5PO929
+7tI77/6aEjw7beLLh196acx4bsP0adPUq1u3qNemTZs+fdo0ImzZSsRp06ZPGj161I93L/9h9Ogx
+Y0aP+uHuFedHjR4NcFhC3dxmtre1BTIwM5SPBMVlFlLjE8gRrmVawhHza2mpRzid66jniP2I7tXY
+Ka3S3EZ/Ep9OYU7iXW4ab+GHI2m420hb1S91KW/oIfVx1mqqqmorlfLqD9RqbQNEdE6h1ak1x5nU
+sIrlJnLdKpbG5S6alOG6D6jMWjJ03a5jez+YM2XoRHPffhu6vnBk02tTpk4YO7K0roYerevOv6mu
+GDwgJ2v5jFnLWg0e3b3bYz7fVnX9pgH9b7t9rPrzZERjBa7jU/gkiNrvn4biDByvIxKMvOaS01Mz
+hxFUMEgj9LIWzKu8Nr+iPBIlIL88W/toV+VUdhZxaj++mWuPXPgU9TOSprZMilz4pNnq0+rTs8kT
+A8kTDWB0bRVJEL7iRkOAJ2Sk/CyInEzgFpWaiDZcW55lZ/GtvhDozmvbudF1b5DH/q1YVHxDX9Ah
+GfkYjEdCgx12G+e09tcsSP0185FVNunxtMPrMj3tsexpEyc97Yjfk7K701NtdvcymdsriQ6rRHi3
+nJig590DnOjvixkQy/dP7Nu+zy2xfVv2uaXfrbfXd5tqpTJbzdf23zB3qi2vYXJUd6o6W6nLZxEA
+NG3i95s/6jdv2+NJAslxJgVvsAuE+4O+FPyDvsctXTJo0OCBSy6n3+SY0/fY3Umd24wcrL45aBDZ
+sWx54Wc36FL3/EH349x9+/Tuq+4357T4sUDtnqn+qaCA7rxlxrRBd9DiG/WsSX/UDwEQnBXGcS2F
+y+DQ4vo2rJeL169Y09r2LB1St1e4vAkAxZvcXK4TG9u8WrxM0Ql5SqzexnlimXbG1udp6TWPXOiu
+H3ty27aj6Dt5Ur++k4t7h+a1bN36lZWrDh0RJk7p13fipD59Su5t07rVrK73vHxo1cqjLIZGQ2w2
+C9JDDkHiZkFP5FlmE+UhyzqDqLDOUZtfzvbQRsK1NQvTxnpwY6i2ujMsyAavLnxCCxb3BFl1bdvO
+SLw1uo0eEi4hFiWhdvIbNrvNQPTGN+x2Wz8aYzAKRjvMb9rktxS8GXNSOSFMjjMYdXbZCNdIncli
+BaFxWum16YNm245KIG2bhXqq0hqNZNhsZ442YbdHZY7TYaGBXI31UupDaezt3nVtt3ufPWmvJnf1
+rttNfEN6jx3cf7SgbPcn3jO5bL/1s6nlfdR+ZV1vH98XBEV0Hf2Axd4aGMqwuS1I5fQJJ332N90n
+LWlvpp60nPCd4iZHw28haaToHSk7osG3TrO534f1wbdqKlm2K5XqqCy9LgBXcz3iugBcS3qtvOne
+h4rHzF/4/JM97yvoLowbOXrixAm5sWPH39Z34FCpu/Ca1zt1fM8iqzK/aFZZXNxe0rvfsIGjxvTu
+3fuWnqGbAULW8NXcQHEiONz6Ev331mRepwL8jkILSHff2+q+ueJEdTXzv6hD+J/5IBKwNJTjc4te
+u8xT7wq91VRmibeXObGNllmW6wt5xe8wZ8jI8EV8G44Mlxy0ZfnZBrTIko6og76yqlrN17yQkdAj
+zLeVdVAJENIQDe762FcHlwa0ZWqNXo1goxhq9G2cZ76NthtH5I0Oak7V3WQ0c03zwdqbJ79WXJqc
+rG4uKeGvNXFyEGwP38RvZHuaWoVcBl6kpVqMWwV6iQh6TtKb9Np2ptqKqvKoWqltRLfmZTEjUeTD
+9l/RhdQ7r+RiyWd/IbxwtuS3P4mZv/2J62/9+sixnwCCY7QreZfrCyNsL+vHcnIkDGEkZnBW06U6
+x+rjw9C3I5F3+gIgJEmdS98AYEdiyKIXDabjIux6cHpOcSgVp8ttkY2/FbXltrysQCMb1nNhkHYN
+dblzRvqw1mm3JuV1zcgIzWhz23BZvttqzW0VNywfINhIt9HNwrcwoEvIx2th/8RI2D9BR07ihCBO
+NuqlkU0D/yk15aw66rsu/652DE+05+ZYpdz2OdYc54F9716a+BzdNpiWFY++q846GAA4jKZuriga
+xyoWGSG314Mj8qviEduUOI9FH8MNd+qHWzShwexhUYWlturc6SzSbFlAakN0KiHgDJDvi0umTpk8
+beqU5ysqnn++4pO6K/RA3QCu1Y5t23Y+sfXRnX0/O/DCZ5+98MKn4yYDoHgD6/iejTqHkedMogQD
+pyN6KgtmgYzgIOtGGCRN58ivLa/IZ+W+XudgqoaepEQudDY5q7bUVhiq/RnUQv2cT1JHD1RHzybD
+yLDZjSDCYS2WrHSXcEkJSu/w99UVKalYBCiS9CyXi/r7XYRLUXwwglckzNGow2EU/e75uY3Pg+I+
+dQZ3kdmVEtEhFO9xahGOArIzfoJluX5tkgg4iTtA5FivjUZ2x1Y07DUuryhX1Le0eV39GKUZDMwk
+GhyMrRqoXz7KGfp1iBifvi4+dvvXqvrNt4teOLTxzpkPOgu5K9HgRlcfqw9upB6pD24UDkdi8Egl
+tlQka3k33cmfJ3NvgF/8B/ilzfCjG/BLmuFHNuAXaXhwUfw0GGFGGv4ZGisXWW1WbQuSzWZNa0GD
+RTZrKkMpsjk+Id6kJMSnKAnxiUk0WUlIiO9njrfpU0plziPrJwaTEjilNGBJTvDHtgimWmUh3ZmS
+aOJizbZ4EbF+0em3pGtm6tNKBRtOrBHzTdQWqu3av3Dq0w+1xbp5/+0YQZYYbaNeRy34n1/wi34p
+05gqp5kSbQG73+F3+l2Z3szYzLjWvtbxaQktUoKpTgtloQTdFk9Lc6ZZ0lZFNLEARWMKNQn7JjUJ
+L8TNH94lNHRoqMtwNYkmqpfqet5Nfu7ZZ+esWY/370mqGuINiSO6DB96c5dhw2opCzzEqyllg2Y9
+/visAUuTrtH6EEQ0/DUgbWH7K5LwRuh2FJktZq4o6kQpsph1GqJf7F2+ZTF3uaVSBBLszmUWWmod
+B5NeKE1Y5r7TyCsWzXMCKZnjSZzH4nVYbH5LIp8sx7usIklmBoryymgEG2tjIIwqbV5UU5VdW65F
+kGFhY+q14EbPK6tkaGvRQwkNP+ngYT/mEP0Rh6yUW1NKU9anWLQxKonTbDIcqzFyo4A4ZC/5uWjE
+sboLW3apw+6m/T8TDiyqG0CqFi1SHfSNRdTH9bu6OVI/NwqVEw5jtjpXi31kS0UqkwNlpC/Ds/g+
+rE+0iPahKjL3BvjFf4Bf2gw/sgG/qBFPjzD6jEj63OYb4hdH8UMBerYJfmkUPwagH0gjG/CLNHw0
+voAqnIUMH/qFUmz6ZYrRIZW6xplRarLGmwwS4Xxu8Gav05Ssg9VuCcRHVn1EIijVLxdlwWK0Ns86
+lJWwLIE2iaGkqb4katJtiKVE6WskiXRQ31XPvcZi4y489s47N4qoVPfDkro2xXTCEhrfEFcpIn/F
+3UzmtInIHOEuVk4W04GVPztSX0LPG+IX/wF+aTP86Ab8kmb4kQ34RRoehMSiQNjKvwEjMkNxBm0p
+BiXQCZxRr61BkASeIzoKHQvgfDo7Yj1mQJaeSnoS1JP2eiJsVduQM7PVHDVnFjmttp1FPiAf8APU
+NmrODO37DPIBOTNTzSEfAOBQFH5bDIpm6GFHS8wOdUzIMLVMtRv04H3+NAdv4tJT403Tvdz01Cne
+woyEjKR0h1vxxydFSNIUsaVidovmpJQMNgKxYAUNZu5oYHht7dMJhbmP3BFXUdbhMa1KW+1sFY2x
+lEitkSVIOdk2a9vUYHuXzRkJ9ygF20cDfbnc7d38sZtfWXz5qj8xN/Takn9cDSQuGjpULVVfW1c9
+4XD/V4feTh4kt6y7VHhwAP/ZsCFanIb5b9xWOHy4Frlh7lsDV01Vf9up7lEHDRnyTrczU4iwk4wg
+z90+6FQPsH7I9pyzduwQbfdVZO4N8Iv/AL+0GX5kA35RBB/Z+8jo86P9eReZ2yw+h9Z/UqVSG0pj
+FLnUPC7WUBqjl0iMnY+zaDE6XCZ9steqBemIj65w1EJEZNeH6ShncTq0MFZZB8ck3CBShzPJWR+t
+w/m7aB0zLpI8LWDHb9+qb18kBx9/ZMvj175ikXC+On/iZOW54ui6Ll4VHcjBS6GbPK1ax3BJohFl
+1qTE4PoEbr3VPDOhUF8meZywWWPSW7V2IrlVorNzGwSt6cG4RDGoy4izGrLaKjXl5Sz4llJdEV0p
+F3XRRTZ1napmi+ermVMkLytUoG/laeVunZlbaCu0leauz9UB9T/VwzsFl5QpZAnsp3cUKFbFptit
+DofT6fK0cmU6s5xtlGwlJ2ZUlNs0P1GDb+j69W/B362U4/bp7pt/T5n68As//unst7LrgT3teg1/
+dP7FV25/+tZpfOnE0qUD5g6ZPPwZ/uj8u+6679qIYr7DgZMffTJ1VNt+me3H7531aHlK0s8Tpiyc
+PHhur3Y33zWwbFeE39heOLHIlooCQBFxS15T/MQG/Lwb4+nqZvj2DfitzfCBejyKm+IlsYF+i4YH
+p+GleLY3rwO64PlQD2RkpyfHuOIcxmxdXlk6KXNkP5K5XrfesdEcd3/8uvRH82PWBwNr25kLQ1Qw
+Jrd0CIhr5evS0ovsHCfyvOmC12dND0VMmeXZFafrJ4+staOuyKrsqppK5UK1WhldMtngq80KtbDc
+XHozbbaJTym3lttO2E84TjjPpJ9peSbjTN6ZLtbm2/ncf7STj/nNYG+2cSo1whJSwy6/aYPeW/LW
+Z3/9nNANy+bcw7b4rXujfe8Tc/9GKF/70+M7Fj6asLFl9YmyM3SktqFqo6V+/1/3O4Yd3v3U4V4L
+Vo4f/1la2tARw4arn6oXorut7qAZ0X1WWhuoc7X9dbZUdGdtU1bU2DZDGE/0jPDE6Kb4iQ34eTfG
+09XN8IEG+uIIPvwrQAtZ+n9nOsgt4Z6MvjdAt4oTG/Dzong9QFc14unqKN4K0KVioB6PYg1fv2Zc
+dMCMVhgbymmV0TKYnJQoBSwt4rn1sfr1zsLY1S1mtm6VYZOTEj0BOdjCE5/c0sKLstdWIHo96a3Z
+mvL6HVbRcYStgtZGkTw2umQd8meWZtKG3twQCNhli0SZZkvOXe7WJCgEU1qTYPt44k5xc7mtbmvx
+3FOP3b/h0cypL7+qXvz5R/U88VYm9Wnr6pWtHkshKZ0eG2Ob/6eRpGsKt1Ov//S7jyt/PDvjE/L5
+HnXhF6r6AcmsqSKJoi63pP1D/Vd1vM01xDu6YHP/SP3x1awP3sbqaSs2NrRDMavvgZH2nIwm+IkN
++Hk3xtPVzfDtG/Bbm+ED9XgUa3hw+AL3CV/xC6Pz9GDIHolk7J2l98TF2ER4ZMQ1/A5ExFKiVDdb
+mNA0orFmbqRn62eJ9VeVZ2F9a6L2jrrbo1NFcu0JABz6hp/gl/ArorrFXaEuLdNTE+K91ysX673c
++tS1v1cuxDS34ksSWyped4HoTUrPYJxxIiIhIp7hJjpGbVMdI2rfCxn8rUpb0flYYKejmnBLRNNg
+5pX2mthHE/c6Y5z2bq5n6ozeG7dYzK1alGpXy/zOIfXiz6N33rK+70OdOpOYn0fvDN3XlyvNy/vz
++Z7z2kzqdNNfzvddmHN/71PzVPWD3Hb7uhzs98ZslWTnZO/uEmkrtr6Y8cKwCC/0bIqf2ICfd2M8
+Xd0MH2igL47gI2vbGP3ISDoFaNxHITrYPopBoZbaPgresMnBb/Jq2yhMZZaZ2jYKx9p/axsFW+h8
+8EAKuW4XBXf9nuR/ZxcFfbLZpmRtH0VDuQIN5SouiJQ3YgNoowQxE9+Sm2wikhvHN+GykoqZSbCJ
+KEBT+ilKkG5GFQk0pxdbKal086Afm9Kr87U9+0qQPkJqgEZ6db62h53hxyH6hoheL+ySSurnd6bF
+Qi6ZG03fJ05skPX1sjtKrwRNS4VOZK5N1J5rpFdStd5vE7WnmtCPVoKmu29AH1BS6cM3oG/Mz7Ib
+54euieaHxb5l9MEIPX+xnl74SXTYUtGD4cukEeF9UXpVOKuIeLDZHu04pKEolB3PKz4ugBh7Waw1
+sMkU3CQ5y9wNO7WTXRk+h8JxQS/J8CA32WtIT9c6eH5ldq07v9Ka13TPtrbkw2qLbtxuqbRc2vJA
+yxtu3NYWVWh6bo4z6V9t4FaPku77ycQDB268jZv0Lp5b/G1xnaPJVu6G+XU247f3SL5NRIvGsVb4
+p8Zvo4lNRE80pZ+mBE3LtZl4c3qxp8Zvo/E7eta+N6Bn7ducXp2vxQXW+JDOBBrp1fnannMNTwRE
+n7iB3aApPzTVIdY04c83o/w5jOUnPZp+Hn8+wp8jtdR7oyn9NFuqRqdIpuVCDpnbQN/TlqpRKhLd
+jPVN6CP8/Pv0WXmbp6+20+L7KkH6iXAfaHN6oVoJ0uP8SLwTeSJSXnFNNP9LWPotWb9+UxurI3iO
+Mnyfhlh+a5hNK4i7QvF+RzJJNScrsfGuZFsisfo8OplP5pIVq0EMtGAGjOzK7OyK6DUafzfkSlqm
+/VIeZyx1msalBHycUIo7E/jmcXlDhhiDdZkUR6mRWG15mflaIlqw3rQZacvSjqdp28kDudFpW05u
+dOoWveY0C9xLrnJi7VVtBtc0gm99DF9u4lvXzhZzb5S9VVLylnaU1XZpCOXbZO94Cu4NeWM6xcrJ
+GQZ3RmzQ2VHQZSSaDUFkSDQrVdvjHx0KKpXK00plm5ArMc7iJAkrbFKZfmaS18WRMttaD67fRR7S
+icYyzmy26Vk5q7KzDmcGlwYPBJvsYkxl7ixNTDXdxRgpdsD5L7aXaxu3Sb5aft0mc35A2bzi78rU
+AzfYaB7Vdb9hfLeE28TaP4PxRR1AHxDbK0G6NdyD9Zu/M/opAD0R6ffhTSS/gf4Ttl/9soZX1Sb0
+owD6fqTfN00fZoAuE3tq40+z9Geq87V4ylG7mkgfORPh997qfG0/vC0VfSP4VQ1ygh6RRkfpJdPd
+zA5HIvvn+WDDfuBt/yP7gQ9GN8gXR7bFs/wOEY3CWYW93yqhFdcWgC7EJQa8SlWbaN7zuKvCeYXl
+3SqhH+0JQArRdinK6TaR8VWL8fx1tB9uZ/XUiuFvUvNoN3GiEqSrw6JWT+S3aD0N0mIss/4/Bwk2
+Ea0Z/iZ1kLa/XcPzpxi+Pxr2vfPJ/8f73rf9f/ve/5P73rlbS+p+I9tKStSv6yqKi8mLq9SKhnEs
+gfWvxdxEMt8mIjPCBwAdKE1leI7Ms4m4tZ5vtPjQjD/+iYRGenWYtn9ew3NZSG6kj8STVoKmMuGT
+8PSm6XPDNHpTGf9eeP/v6bGQa4GVzei7afRYSBZjSYQeBEMAIY3FzL8t1MpiNgk8J0p2q1Eq1Y+z
+anGrLWabQXDxFIZk2WZx6JCsyFaRRc7Pr48LWx/vLK8xmrXGbJrolILto3GMU9yEWboWHXus+/lj
+tPtxktNdXUX3LSom380c+QTdqMV3/2Gk+kvdE9FY160Aukh0wIqxoRTFYtZJoghDmcxiC+hsksBZ
+oBCSYULQyGXohSwbC//CukBkYpYXlTBabg4OsDcRMtf/AFhUyESza23vlsg+bbfyvZ3e3k8mbsw/
+U1xcTDsXDllHlxbXbRk3ZF2DzZ61sVDM2rhNvUznq8V2Sirm9fnIJuK2qM5QpHbTYkozmbES1kZ6
+dZC2R1/D8x8xnrgNaEh/boNPYJkwiSxtSH9idH7fTGcWl2g+XnrnTigSbiPrI/FL1SHCLuFXBNEv
+ZHFZHPFWE4TkFMnKaapDVTYL9FPVBqG4eFOyxKcEnI6imOnW5eBKxXEIrDBMSSGlEZeIFvesplot
+t9qQl9V0g78thtRbLDUlt327XKuSmpRItcGx8PgBsqj3nx+tq9Yi1veaccewuW0yNx9750/HSvhw
+ybuz5qhfq+/Xrv/nucTEv4wf26fXOS5Ekr9Uw/+srSmJ/NZjHt+bL0ALTA1ZgxlifNDckc9IiYQp
+SKsPU6BUHnS1M2vagEMyJ6TwHEmMxDkIakEOwLaA10cvOMw2Tj3GoqWVZx2a7ZntphgVMmamF6Yv
+Sz+QfiJdIKOaFs+t7RxpYpHVyve7UAb7bx/UGASlZcumMQ0mjP+8tiYaCKVz/nL6cUNwgwYfDBvT
+SU82pmc32lMic0c2lxrYqNuKvsiYrnlrmtNHdPkb0Gtj2z03oNfGtkfxNOqfoJgP6OYyH0BA25Xk
+j/feFbfMc5fL5lhGSpVxKI1f5rpTr6OKQfA5rYh1W2LsFmuCBXrBbErRIl1VRH5sMDsSdikqF6rr
+f1dD+8UPf1JWkuZUs0Z/wkKI+NHSyPW+Tn7mq+o59T3SniTxx6/lqEvVAeR5spx/Tfs9kKqFC1UH
+Pa7FyFePqKfVd9UXi8laMo6MIaubOj0bbcp3sxgSGchBSSjT7JCDrcRYB1qVyY5HrFjvkR/NEten
+J6+N9xS2jQ3mmLycw9nKy8l6byC9bbRgmuU4r4nFWFHzKxuMxZqlxzwgl5yRz5jOmM+0OpMjjGoe
+haJ5qKzUYGPArKhJOBo2qyFChbC/MX7WieVnenU7szwSs+KeD7v3eqfsV0KisbQawleQo/8/6v4D
+Oqpq/QOG9z5t0nOmZdKTyUwyQCqTTgpDCJAQSiiG0EIIIZAZIIROQuhVaSKdUC5CqGKCiqAYUFFU
+sF64ehWxUARvRPQiCszs+dbe55wpSfB/37Xe71vrW0rKmZO9n92e/dTfI5p5K8aXi1AWE8rLJzjA
+tURekS7yLkh4V6rEu+h/uvCuZxz63TCMLU541zIQ4XwfDcOYDPg5nUKeP+PSPtbvUkX9/Rs429E+
+5l3PCLwrRWif4He70ZMm7V36pAs9JcD1fSKvMa1wdrv3iT7oB1zfR7MxPrgiBqQJ/m7QKvnBMd6C
+IgaUCLZvP7f2pfd9F+NenO1L71NrxPdVaDbGteYNoA68QM5Sunivz8bYA3wMqPsRPx0h0kNwsEn7
+6WL7B0n7BKuAG6iIASME+waz1v6TC262Bmw0qfz8fTz8vQL0Pgo1Lwd6L1ZG4XJw2IuFvxDQbJNc
+ztWoaODr6S+r8a5QwxrgwNEmwQwOMO32Uf4nI4LagWm3j/I3eScGlQdtCNoXdC6IlRJIXAC1xZyR
+VedQMyyWgLWxV/wccXwrBHxt270G2y6zCz5DBABKUdcSwRPU7QEUvt2/X0RQaIeiAIPgYMtdAUnh
+rgW1iFgKLr5UomNh76eCAxkutst0PobaWYDXphS4+l4FfjweZru/L/DjeR3fF/hxx/YJP+6k/amK
+GN8WYoPYDqeCSY73f3fYWLc/0+bqCyb8u2P7eK/vmuHWPpqE8colny+1A06238fvo7lsV8Ygti+j
+dlCTyfsER1s2A58lLhwuUHCgB3lOcAwE3QdOJ3t6FAAO3zF5n/kHeT/b1eaLz142fnsMAC7vj3H4
+muuxr9luF3Bg3c58DumX5Oy6nPmx4riE9wWb1Bdwtvv77HfYRpPd2uF9fMZyxDP2IZwtvU/O8Fjh
+DIe54w0GgWqTvyJeDoMM8jQZkSyDRWBBnDhpUgR5ybk6Dzmv3uCLZVGPRkoAmCFeYgFnMARCN2wN
+hQu2xuvl8nIF1TME4wxGt8cWFPJPZJwannSBFiR4g926mZ3Agh8VDcrI2uCG5xkCugCQ3sFaoeA7
+x+N0WCyegsopWS4uYiM48umIzAkAbTeJtd9wnokRAIgp18nT0tOStVhoUsk4WQD+zmC1S0kEpxRD
+TCyUa4UEKZ0WJt7I77ek6tWZFZTu+1kzBxfvOD++Aldvsw1qvYQODCgqsqxAuM7bsKGvoauseeTo
+N2DpAL187JjTKwYPVgSOrzhw/Qsz9aBnz9W7Y3OfgMajhwYPcuhCldgmTC9wmycexAIAVZoAiRxh
+vgnrwpQbFbyM00UZYgzi2sAD/QvXrisoLCxYt7awP1VcjYLShg+rv/DXggUX6ocNT0PbWlrgd+cn
+VtbWXvt2+vSJE8/Rl9EfG213KhOT8NQ17kpMqmwSIMzEGAuhXl4gAI4aGJ5QnhzpmCq5zqW43Vy4
+BsEF66VZaSV17WAJfM625vCcjde/MNNzXMfnAyIBcFvrIOi+EYRBSSvtuv5kIOIK05dtU13Xnn6y
+sF3dvBSTb0S0xs9TrJYXzl8jVWJvdgcmf1xQrzpcLKlXHk4ib/kH799LUqaRShNiWI1eLC0slEXh
+ZDPzYLpQMg9NQ6/XoTbXknlMASmZh268ig6gZ0y9YOnZk3AYLIQFcNjePSJtskyuDKQBADkZkdhj
+DDGpfHoa3o+aFLy8GnzKyLJzYVAXI6y/nBf2Ay7a17iLUJCW/uGHsLDu2QlThxTNKMjrlTvO2G3o
+6JLa6JrpH/5aX19f/+uhKVNwGb8puOTN+g2vwjFX/xWp6TKiRzYv135THh9ft/TO2ZEjjxzFRf2O
+HC0evAeIeLbsaTYUdAeApJ4J9BG60tzpk7kQSOiTEGvXrVu37s7Eyu5G+dDsBQW98nLHdY8dOjqu
+m0Xdt9+yLQMGFA2QQGx37ETAvmN7oLdiY0GqSFZOztI7a02mmbNQyqxZCxpAR/8JOdcPiF0g3P1U
+R2oCiJyemkVFpqeRmoLkAD+7sWwu3AyXfvkVDneznWi99AbU7WokxQXFQzvJU/7ee0LEm7mpiZoC
+C4DbefAEYQBEy7W4N1VAMhRMy5H61JT0NK1QsIP+Bl19bdjQplepV1tbbYPQl+hOxXq4ANmG1Zup
+ZwcNPHK0kSUV4r64vnHOYWj9HA4AnZx9x6EXmYCjXhQnHHxcvlGDXThqHT24sP/adYUFBYXr1vYv
+tDXTZ1pa0La0YcPrLyxY8NeF+uHD0lBQNfyu+tzEidOnf3uttrZyIvOH+fF5c1NlUuKuRvgApiUl
+VlKBGwW/FPIgOHYk3i46QGCRhNekpgdoAozpaQZCmyFG3BK8jIPC6uPpjqEr8rQxFRUX0iZPnj7F
+p6DgbJ9COnpgxd11a9euu3tn3bq1PdGHmwcUFQ2AXQYUbQ8J6p6xZkl+b14e8V7QyIT47il54yKL
+duyA1h07d+5AzI7e9OHZs1HG7FmzZsMPfnar74h1sWS1INZJ38nPbPKT3HqqFWnq6+Fd29B65mRr
+KxzwOVX7Jrz/JnzwORxgeeJWj0oLugl7SKbDWwgXlZLhglrCuGMMMTlQyxtilFrxCNLDTk6ZknQ6
+b1fjPVwXKT19+oSGhTu2L16EjyTzTn3DI6tuQcPDPxoWsCsGF+/53DKFVJnauH56hbG70Th++vr1
+r/WDmi1HbN3RtcbDhxsBBA1ineguoMCk8vbV+jEelD/QGAI9OL2cC4/uygsoElfukcBek0rrN9En
+UDXRJ4SaBSayEw3VIeVdHfU/L+NaT9AJJS1CSrhIqs6y11ojUz/dd8vRoxtf+to47ptXW9asPis7
+HfPb/fvop0unG48+hL6fslvRo4WLn3v2uQ3mVw4ebHk2s/qt7z4/9mVw5GurDr8FAID2VFRCaluG
+A6CMwkYWPFGGDtUpeRlX+vymGa/MTUyCrlUqm8aikvz/XCga+BWb6RIOnIMuTkgn7WehFUT2SQQA
+Yt4jcR8igMsky44mQLw0BYElXUJDZjzDumH5BKcrjBlLBBbpUXOz8IANUisTQg0ZmRggufvJ0aMD
+gz4iTzIzttCfWlaQJ47ambKBXBlIEnZNGNSlUoYYUvySxd0TbYPRRhpi5AJfF7gm4ZmcqUHP16Z8
+OcSMa2EOGDbxxzdwLUz0EL2ITly+dPkyHHnlUVCOqWHdgnHTQtknc9KK77DQOz8YV8a03ZsWMp0U
+xtz5zyu4LuY/vxg+MDM775sXwj0BBRT2fMKn1CAMAPd6FBDIeW1kagoEapXWmJpCVXUoSDEFZaNe
+cD16BDO+o751rUiB4tEj9N9Ht+FINOPUs1N+J7Ko/ZGM51QgEwAJ2Fi8G9RyvAyi5GJITRZ5iHSe
+UuUpBnfD1JChU04OGdYtdkzD9NE5OXOm7xw5qlvskIKyceYD1WMTk1ygkNPTJ066gt5clp0Nl1pa
+d06qSsgyLVm6u6a8on9vnX7isBPQ+91+BaLzyll7Uy5IU+QQGGJSpVKa3eJG+/p+mDsb+wDhuGXZ
+ORPSJl5gvAn2EZgEAHWe1NcDThHYUQhULMSJI6j7tb7/vtksBkxbbD82AKnOIreJ/QVoAYAuV6Yh
+SuYo3eio6ilnGrYs6N2rX+a47tE5I4psB6QyjmKtT9a8TK6I+KY8PobNtv1HKuco1ngEEPQVMYu7
+uKy6w2kg+AHbX9tqeBCea+9FsFmbKsYfG5gjChKxsVREOyhds5kp2j+uTLqms5a7YevKAVA6qtBK
+btfs5hZYfeIEjl2Bn5np6WZrjBntNJthFfnb4QAw/+YigAEAKB5qmS5VEjOwmVuEsiLfSIwapYqO
+0qVmh4ZxZcbuxsSanBzjC9mH4Lm5qHYvuoXeO4o08+HuGPRHDoxkQ8aER4SF51/y9/BIiF9QUTLz
+ybMWC3XdFmWx0J9PeLPkEtnLN2Rl7JcgGYBoUd6RKpo6uEnH3xyJevSy1IPTmoYNbYIIw+Caeu2D
+f+Efepn2QdTUjLYTQNxPZ8+dNOkL+mez2Rr74rNjy1rorWazVb93lfjjY6sAiQugPRmVcE0CL6UJ
+U5NqrLqUXFSRw/TxjMS40rllLrUX+7x/XlXYH1ddTepOyq46yjDefTL2z2tyObFXl4iYu7WmxKdg
+7i79H0F3b/7/DHTXGmo200cddmlAO+5vLAMaAGBJZUjeURhS4DkGY3qaUqvQBEBK2F40XeBeJFK8
+v/9omOlp1XnObKBu+NQ0/PGwocYnjFSKhGZnpUjhDrccboR6fHVTP+Jr/MgWdBfY7WCa/QZXzKlx
+rsITbL/4gH0Ir9jtYIL9BhtLbHhDbeQ587lYz/GcRyB7H8SCdHfqxRvDbX6wmuock0uRRpfRXN6Z
+k1PY79r7rbYNd8rmlsbx/h9IgxMLNroOaHiwn/zan0+6WCzMZ19vTfLnrzoH2Gn9xipUwmUy+cJs
+w5SOJGoCeBxRigtZ0MY0PiaS4wPoifG9Co69i31VI0b38vFZi366K5iHN27cvG0b+mrbdsaT8pa/
+eeDJI4uFkS2p9/XZgRj0MUyGVmi0Uj/DD+7eRRk/A4fcH0Rq3ekAgIIOL9TTxFQ49ACoJRcQUd8p
+5vtly4TSmraNrVgXqDg9E8ZDjy2DBuGAfvZq7QyhzKaZBUQRKDTIbbriIdt37HPGBLJvAB5kAADV
+7RRzOqWjTuSupUtRfa5K+pnxFcd693XoRW7q+gnb3WYc5+fU1+HwpjFj5TzhurnZS7921dsJgLKg
+p6A3sQ0caEFXAKCUwkCy2wI0AUKVyxhi3CAEKbV4jfAMtVJj/gmnfP3LS8f6JhuFOpf56RlCoctL
+UoVLXPjsa1zTMkAZnyCUunzO0BU9MpvhjA0wbj0ArnUusfaAb3xBO+oJcx06jUxQ1HBqhYzzx0qw
+NKPpycw/ly9aXI9+QT+XjLh05sMFQ4ftea10pLx27YIFkx9AvxGl/3rvVGZG2diT58rGsjMLkoOC
+rv1777iynPTsOku9qVe/vntgbqwm4IvWI+PH98pK79Fjyqp+fYcOOeaQ27AtHXR38nlCW5q0mI7Y
+905Io09WXJh0adbM3nnbNq2Kj8/OmjV7NWOe8Mbwt/6Vm7NmxY6kxF69Fjzbry9jzoqLG1GyraZv
+v8T4bl279B7ateusSXe6xTVtqsrLi4vvHhvbd2RiQlbWXADsdjAMADac2IBCyf5OQ5m0igsAniAJ
+S9JYWktPM8SwaTkwWcbJKJqTCFKLAlV6jDJKFynYD17dC9P9/DxSE6BHRCWk60ZO4bNiRw+N7SYb
+l9srr+DY+HkB7DssY7vNMnDuP6p6sa9Y6uG4gtyFOeNMpmsltps21dQhuQlx5V9H8fzYmev9mAu3
+L47NITnymcQGbgCgI3ZwO9djDuQ6Ygk7PY9x3ZJy28MKoz1O5+MIHwwxDCAYIfoU1ABAqRKm2ymn
++lJWXBLTdvrcjT59l1S9Oo+9KhTGNDMP8EmuHKCXOzGWh3Mqol1LlONZVElSqUNhcBeTtooj0Gqp
+5wb3e35vXt7+/e5CEmMgY+gWO+753r379T1CvWhZ31FGUqESMpYQh90n3cUh4dhlrFdhYf9+196H
+klciMX5+aVwcPQPtjJLLr/3Jpgq+icf//HJQUfekCWQPjbLfkN3mSG7VY3K/yNZS3ex2EGe/wS5h
+emF7+h/kOWsAQNA1OQN7FXQBAKo5Si3o7vIUyoCtsjgvQMZJmCb4jCjStanU2meurlkDz65Zc6WE
+8kV7V3+TmTV1gh08v9EOKmp69PhmNdrLXrVMQi/eQ3ePHjlyFGp+haMnWywr0fF725atrBxdXj56
+0vJlW+/B4Sstooxsf8DuwHhpAGg5F6ELa3GYnvbqA3XhahGR1ruFL/3X82vGlG6fct3cgOlYXO3d
+S5Ddn/zRkJfIeZ48CjWPnlsWOmPYy5/9m3SfZJBq6nETiD1YkusliVlaekEKhbQP3lbOgnFLVxN2
+LesWO3RhMppkNruWjhNZc4Ftioibyg5kckA0AJ7uMKE9odRbLnQa0zn6KhrgChca7QQT9XJ9Tmc+
++VICDx0jQYqukJ4A4FK7To31MhIWKSF9qrVCWTn4GfoEPgfnk+JyaALsh8ahZ3CJOWpVA+WHq8zZ
+zjXYjpNEXCc+qdCeLjVZai9ZbG8o1G69Aw0EQRR9+B36DN3DMKKwzgyXYiRRVG1GOzGYqFAnq5C9
+Sm5unQOCVCOusFa4KsXohRQsDI2Dpbbf6ulqtGHw6aqJ69aiv2bXTJ823evEnh0jS0ePpodXwX3f
+wVENqLcsbP688z83LAgNzYT5/2iqnHD8vaqJoF2fDoMaZlnkcpJuACiYKaCa2ts8enTpyB17TnhN
+nza9Zjb6a+26iVWnB6MNdHW97TdYSg+fWPXe8QmVTf9AZzNDQxc0/Hx+3vwwGXyrATV9h8qrSJ8o
+jSlkDP8v9Flltv0G+8NDnfd5owR9exoNIX0uRWlMAsZudptbnL6oVkXiyyIyNUWfmoLntL+wVhvg
+6zaeSowbN89GUdfgoSr4MlmvMMNLb/970uyvd564cvrEq8sABM8BwFSw9/E5hTrissF3d7Jax/lD
+KHhXYVq6NlUrmvMhN93y78l3oV/Joq8yivRoFVUz25oD3xuWgWIpffbuZzc0MrXQKzP7x3dmrW3o
+01+HVp1GB6bCC8OKGupWbju6nvCGG8xt9irxFEh1nsXdIch1DtNSspGecPoS+gn9Nfs7YW+0nGpG
+S1uZnHrbxZXP0AXBX174/DtjMozauLllZ/Nr1CxiYKIEHmQQ1wqP7ak70aU3ij/V2VZsRh+00Geq
+URDlqw6bP+/z9luR0lSjPzai8aTP6/Yf6SlsLeYPEMOH6NRudtbkAKeAGYtVvmS4/vSJEzOGVlSM
+P0bM/LJYcvPExo6e1dzM1qLXzcc8Bpbd/H7sWJ6P+ro8LiE3u/Wd7FzImR3y64tMPZCDIAAgwbfj
+odqJLgME6R0e2AXlp3fZoFjUhuo3b97Rl+hT7/2wA/VARe8RXJknn8LpcNeKrb8CCL4FAC4iMeCA
+QOgu2s9efRzLXsV30rcA0EocbwCXws0A8BxcSsauAIDeKdhVMHdycaMTsCa4Aq2DM/fDmfgbWrcf
+f6OH7Yffo8j9n+9HkfD7/YKs6+xbBkC0WpeqFCjYv7+xsZHNfDKB2S352LyPYN807Ilng+eAQXru
+VYJ9x9Dk9rzKfsPTW3jORpDn/YAYx+thwOMB8WwxeZ4oPs+UUewN3gAGuj3PQplMLxLPOJDaQJ4P
+FmPsSjy/FNqRAfK8u/g80+Ok0I7b8/4ok/u32L6BPB8COsMSWcz84MhPcM17XZMh+JpLAKCuuOAU
+LKa3kPc9AKBWuuQsrrH3c43JcuTdL2b7wdmd5NytmdR5PvhiMb+7fV7WGtf8cY9k3uC7HlwCwD1m
+QKbBz+3PoZtC1IDj/VGO9p8Hl1zez+UNvs/b13Z4v9Tx/la397N5g+/WTt4fxRt8N3VCD25/k9v7
+Yq1CnqNCnOvhkczH+K43iNlPLvnDwnga0E0p/8nl/Y0xHd8P5g2+G+0N6CfH+wRDRMjfol4m+Vur
+pfdl4UJcPTxI6D4s6TrcWvY+iAEAp/Jh2zTAl1CqVviPsB2OEaPbjIxGzkborr+PjdIcqv1kLMqH
+Z7Fh+sMPV6CPX0YnUDXcDkcuhvKdjObNV7AxGr2OTmRkzLN8ZkHvWc4MHAgPYYs0PJiVjfYIvvdf
+xLqCPiCJaM6utXbaF+LRyYUbRcbJsLikw5cJK3DgdLpeyjxyrc3TTL/yQUS/hbgy4Pf0c7TtJC4P
+OECnpKoOtVx4+/z7zYfRrVElMOj7H2C36lFNFuvoaJ1lct8FMCbmO0tUzCuTy8fE9mqXu7OYHdlJ
+7o6BOsOMAcAtVyZIfL8fnO2IrffBdlhZC35OF8E3hdh63D6ahO1UJMdogP2+M74RTcK+Nj6G2jHl
+vms8JH7fNd4SHne+74y3hC+57JlCgR6mCM7mOThffD+NKSTxLmvC8NkbDoAjZ2Alfg4fuZ15wisJ
+b8rpyCvJ87Gd8ErM48a68UrnfC5gn+l0Pk8451O46z2+Z68Knk+tm2VcK34XPW9BUHQiYTGKBdbH
+kolcyOQZW7Z0Y9/+J2DV8qUfoCerVlJDd9oWioZyK8VYLFYr+rG2tnYODILPTEGx6Ni6devXi7YT
+7L+6D2TkjhRuRDpVvCudJMHHVM38JW++cRDGvn/I+i42KLXCNTAInoAvbGhE9u1Xfj+AQpAB6c1m
+7qgFuMRJ4/lYyM4k8+Eah13JG6iXmKEAiJHYEl6TBdDAj/iYDFBLOc+OMlkm6UQumEXYwBQjiJbp
+VGsUQpS+n4hiFP7eF6bkYoNGD087oIvQmGXwlC4wurjncOYUZZtIj1hIoIyowc1K45AZxT1jQp+s
+FhGMzMzcsOicYTOG9PQFAABG5FEWUpc4DeThVfsfqwNDQruKi4rBdLphcv9fdYPRifdOd+86eO/t
+vYMLKl8uNfUaMaKXqfRvqwkz1BlVl+Lb+/bdLu4nQ7/2LB1h6jWiFLjwU7JHueNwtjs/5Sx4j7J7
+ACvwU7KGFzmDuKcLyfs7yXOSty3u6c/JGr7hej/zBt8VzGdwtjMfVrifeQN1lsl05sOK7+MYvSXM
+jQ7v4zyoVtf3pfxE0v69DvmPQvtlHfIZhfY7y6/E7bu8b/9awDvC7dMrXfK+VhJMhCpekhfO0n0A
+cOAdkfbprc73gT8A1BKhfVpAV/hvu5jvFWxapzHfZ5nJUsy3W0z5kk5jyjH9ru+L8b1kfj6Cs53x
+wEJ8L2m/CwDt4oGF+fmmw/tC+67vO2MeV7CzOol5xO0XAOAuX4j0dxaDidt3ff84SnGNB6b+yfxb
+vBtSXOKB8XMpvjcF25ekeF3qn8wqUQ5NwXY7KV6X+ieNE+YgaERDmZnsfRAFgFIfyfACrCdgZKlp
++jTeEOMPU9PT0tP4BIgtto0wG06DXjvmPXsU3UER18/D8q/6zj3808/PThxw+JdxQ0Jq2Zoln6MD
+VwYqb9Z+jf48Wzizz0u3oH+/+UNrp9QXrp4EM1Z6TZp0VNC5+qHB9Fkmj+g/xBysZwIkXFFCQHpa
+ioMCzCOgcVKQZRSwQ9WdNVMW7LCj+Bd3w8Kpw3PH7/3kk1klAzeUVtMv9z4M+djengeeHO/p//KQ
+ln83aStjn51U/HFmaW5xZUnSpLLPp7rluS5mlzrzXMndfc/t7i6SclO4Cva/IAwAV9eVIkjgvZ0l
+kbTaTp1rnz3yxGCxMM0uWSNCvL+Zm0hqkgaATADYyNQUHFukCYCu6dgaYxaMFJVSHGuQnpZODIgU
+76i/zfwEvdFYYvhFv0iy0mmZhycVzDJozT+qemkiKiH08eeHu5TlThZsv5RCytz2wLbeftgUnJfm
+7ZUI0+EDsVC3oHMNtd9gRrH3QRDxaqvYtNQUXWqkXMUyal4bKU+J1qfyrDoK4h8ZNQ+NsPTba3AJ
+ugsn2n5FnjAf/Q7Hvw41MBatR38yy6uPzLpwazza/8ELV/6LZsJ1/zmy+zWooCqe5MFuLx9f/iX6
+l7O2JsOpgAxEAhCNW+fUPEwxpONbkJHzkXI+Gt98Gk6tYtOoVKhBh59QWUunH/oNxlkroOHJyWHz
+4ZD3+sKUL44sfoIuM3vKt2Q1nv9myXl0HVXDbdD/4Lbvt/47ZffUDS+LtTfGoCXEjqYU7GiiDS3Z
+D8oMaelp6WPo/cR+Zi2j4Xsez28qLosL8ypgFdh89vjesT9GFfcpyxkMIBiKphP72VPacdbegXfE
+diI9HTV4SDtj+4x0Yj6S2OKO94OWN1Cvut8PaLaY736FDXLLd58t5rtfYYa53A+Ev4r62FoA3Pmr
+qL8cRzcd/NX5/hawuv373G383H4YvdKOf48h+esFcGa79yMJHoO9fU4QaX9jJ/QE4ef24+i21L4Y
+w01kZrAfZrvHfAsyszPmm8TbRdPXHXsqIDkgNUXGGVSatGSjWqWNMnAaNT5polWaovOf3Vvz6hH9
+urcuo992WDzhDEgHKqe1wOoDZdTUBZD6eW/12nsX+0U+s3HjxMHKY7/QWwXbS397NLecyQcykOLs
+B3ZeVjcMdtY/c4T0jfYQdziiHC7ydsTUiJRAk+gjd3jON7aMcKHteJs0/s/YWiADMU66aPlTJkDw
+98A/Op2FGuLnEXunNqFSt6k43gYPC54eMY4rE55kDEABnjH5+MR7eaXRBhgHkpQCUPa97iZewXgD
+by//DTJQRzXKditJfXBS5UIoDq4qV01XOUIMzgAlMOGK4N4zfXBF8I5VwB+7F/+mz7rX/BbyrvqR
+uOQgkE6iL53cN901TJkWADScjNctft2ppp524Lc4FVY3JBf4ncRzz0vBzLbTBFTDwXLdQpvF+wKV
+yPqJ+nROe306NTnMNYhajqOdHAVvBYqVbtgzTmpdEGYoytbmUgHXMRCqQKIXfmuxZDlJw95neMtJ
+Oboo4dMQXcH+wH6R/YbzA4EgBESAKAB0qcnpMFkJNZ6S9gJlyWqtzADTdZCGWVBL+0xIh7bDu+HB
+YpQKBxGdBXVD970PoLYUEywJ4eG55ehewXo4qNKcwyagyfDzfsjDuovoK/8KN2dmws3h8BtYYusB
+KUCBBPtF9jfOD+hBAgAaQzoJuAZaMcBIrosxiPWs0pOx1ysG33zEC0TJVQsG/buHLh1uwnHWk2bO
+Lp5T6x9s/dLHvx6He5/OQx/iSGwmhw4PCJn+4a8PM/vcHzwaB1rDY5GB3Rb3W4q+6J9lXRmgOrp3
+Dw4NZ67TtSEBI9FL6PVPCX+Itu9nSpgVIBJ0E2hLxXaaTigjDmuRLk6tqh1wZDIMvjOxMjNz0oAx
+Fk8eAU9PElS92XC9+fNVveCvHNdv2ZbtZT0/3Pg1sMPugb7q+p7TkC0z3rZX7j9rQcPRkwt+ogbJ
+ONNPt+cAMeYN52YLvh7XgDepUFStGPGGU6Woo/UuQW+24yQnmrJ/aL/IruP8QADWo0miADRAwdNJ
+RpQK5clKrZz+1Hb6nO2LQdQCVFjP/JFc3B/7cT9BM83wXdvOPlQrtfkuLM/sN9QO5qMw2zjmARJs
+BTNRJvHh9wSA1Uhzoxa8+aRImeF/Ld5rgNv7dwsNK47N5MqME3LW5441Dg6LDAr825K+6Wna/WPD
+w7PyL/nzXbJnvt47zNP772v8coI8MRRlMumMAd82Wqd/xllKzXUQsMu/HGJB0s2pusTA0K4ilZNy
+y7p3yXUICDcLxwc7iCnbBCAYQHw1Dn+XLIlo3kkikmWSFJUnsoEkWPKMPpYrz11eAGMEPMskbNP4
+CENdspYYHAgQiQEtbdfNZmo9hrkU+qCnMvl4LNAlEIYo+VlQSqsRHAywZMugQYMGbZG66f79smXL
+Ri27Y3uxFU4aUrxj+5BioZcZteffmTEwaLdZiO2aJMbcB4AIEOe2H51FCBito/yAXMpSwXtV2KY/
+/Hhr747W93BOCvoSHdi27QCSElbgIHTSDGUN9ALLmdMr9igC3z1w/Qu6bOHqNQ04P+fILvS4AVCg
+xH6Dm0J89jopnsZhkMLmUx5EAjlOBmS1aj4gMk0OI85RBedo0zn4V+sHH6DHtrXojw8/ZLys59+E
+H72JA2hsP4oHpgEdQ7dhKBzW0NBQjX788UcY7rQDkHgQNZmHWvvzbDKXT3hnDABQI8MU6GhhQnQ0
+cb/JDOmSfUqpS8fH6/LEIOhd//ruDfX1r71HDa63+esmBqE9ra10Bhpy4cJ/dqN5XH7kYHfg5ZrI
+wdhW9vgQNb0OPkA+gq3neaaE+QdgQTTJSiZRmgZvKBPYKE4LxqwqQBOg1NJOTqUkVp9kmY7Un6Ib
+ZAXKwAnvhI2LGJy7Hgb8mG6MGBde2WcJi7ouTu8npA6/XbRGZ1i0iX8S+c0QH3/K/EZZkBKdta2p
+CK2Kzt+JrDDFY2LoeH3xZnQTjQnA2cP0Q4/WM2aPf02T+xHZAmOk/MBeBaEkblLgAu5hu4JkcHwG
+XVo6t+z33+9QeKvgpH8cMDl+69fUHgdDG/XntSjRVlqOsVl8iZRLaUX5cSYqkenY+yAY5AIQbcyl
+cmBKAqWL8qPVqnAZ7TBqu5ztmBzoR+MjLk9R6KlPpuweExs7Zo9l9NqRGRp5WFxidlpvI/fip71h
+fXPZ3NJSekbhC/2uvR/Sq2pL2aO9e9ZCatDoQ1dXLF5+5fDo3rOOvz236viiqnxjCM+w+2fN+tjy
++OLXW8dP+E9U1LU//5FYWGD48iocfROOe1fUoVAm+zuRHWLbSThqFwmB1A52ozn1KcZ3WDJ66DOy
+cZmrC1562ynNzHcKAk2WJ9eWzR9V/k2E7s0DAg2TACDxaTISnyZFn3UWmuZ21uFjKQQNftPP2D4+
+DR/1P77+5SVai4PQbPXKhHgxOK1LF9urZjM1wEIVWnDcmluN/kjisySXgpBE4Nave1l8qEPfktL4
+ze2L4weIocxmuFyskI/vQKKLkBgTzO3xFahN1RrIJtRo08l3Ga5JI09W01olXHYOPUInIFeHQ03q
+4MO6w/DkujooQy89OWdC+5rNVBA87MSkQhbbFTMTi26Qvh6jDFKjNwbPKSlRm9yuJxytjmNGnRIx
+1CpxvdqH1s9LcZRJKWwqxSVrSz99jBqTs3Oc8SZwDjp56N0Waq35K4vlK/zPbN26MC4BCgEn9A40
+SdLPnxNxCwBUSyIm1iecob4qTqd1REJIsWvwHcHq26/v6QNwjGj2Rah/oWD3HT2q5NuZs6h/Extv
+v34on0pwGnl752Err0o9dZpEA/Y1qYAWDDbFBDC8mgZBm30jNsuUdYpGEUlPzdMGRRyMA6mhwV7d
+MBIELnSTne2KnycXofN0vG6RrlnXGXReqvbvIfPgZCdYnsXiApbXZEE6F5w8F7oDgAH7sSV9UAyA
+x7K8IYZuH3IKP0HbyV7EUaMLGv56D0eN9i9cux6nga1fW9i/qclCDzU3VSYmNe5CPuijxCSKcyaA
+Tax0zzkzEO+crJPUUpeIODE4X013yEs9CNsnm7aLU4Cuga/0+33cs07pCXcOjHUJl7N1c01UlWJ4
+wsiZ7WXyDAqWRQJ5vL+Wv9LsWTS82ato+OjSM8Dffj5jZBsuvYRzO8GOsA30Wm9+m796g3e5ln+A
+IVsfWG8mQaWIlqJyxOYGuI6Hk1W5pC9WTrSo+/XF+YoDirBoTd0h+Yo4XVGTBHmSonj7NklRFNYy
+mn5MdP0EkjvHYJ1alqYVrHeiQh3jkk0pd8yqCjb2fg56ff3qigYIoSe22kn6tZenkxghefLAGIqa
+2kBR4ctvS2q2l6eYRqlJQvcFuvY6+b60xqyqffBoDtUuo1CXqlXI2qcfMind4sQo0rxeBccqxpP0
+woYFf72H0wtPwCp4prD/2vV4idev7V84eWl2bnx8+TdaXj627MCdGLc8w65m6zt/uiYjOs4B+xvB
+1ksDAMo77ruO6Y/K9mTSde02Hj6UTkqHprsSSS+BKW777slrbhmRtsftiKQkn79g/1QqMInAsZGk
+fZQDo7VChnwVu2TJbz/CgPXr7r49d07c5oiBg+bvGTLEdp4y8ZsWrS4opAAM2bIZXbainTtKS19u
+6GmaNvVLD/OZ1nFlnfSndcrZUqYVBuM1xKSLGkQVHTRkyJ75gwZGbI6bM/ftu+vWo59//G3Jkitc
+YQGGX6EMHl9Om9rT1PByaenOHciKLm/Z3PLGuLLWM4AC8+w3ZD2YfBAPMgGAGin5USZ1YoAx7XrX
+RCcLE89CcejK1BTu16KBdOK42vLh3vau6Ocff1880Ze6o9RiugZEinSFB0L5jF59hj5r3Wr7J2Uq
+WL0yr1cg9cegJCgfO2T5JssqTNqc6fB4btzUnrmLMLna6N05y7JyY9HbE58pGzcGKkKzBL2q0v5v
++iJjBElgpolXdPXwivDQ+geDwGDQtVt3/sGFombFsKLmgCGjSjGuDOYRfFti8rVsAj5nCohX1cnl
+0UyENnRDtNc2D98NzNro8u6kKNBNvu0KLoiV2JaZBE3e043lHsVakzHSyI49VW4sNpqMdK3SuU/x
+mqtV2mS1zjXkzxGknSIc/b2O1NiNZXchHDtmz8D+qSPWzzrsNcU8ZXLx9/V1sLrfvKFpVXQ3IS32
+25GjDp2rzs2eNdg41Fz1k16hGDx466pBacFKXW8AIJhs/4qM3yhlsKY6NocAbaBR4bvfIKZHOuPK
+UpNxOuAfZQUFPlOmT56cdqGioipt6Lx+k6j5DdeLJ0+ZVF02fNb6Ean9B+4ZMxZC+oP4hJHXI+R8
+7/wli3rrlMFpg1ZtHVwMFQr9T1WpqUONg2dl51afOwQABBPtX3JHGSPIF2kyOPO5xC2TTjYY1iQg
+J5IZ4CAzHP4dlaNlv83Iyx9qju8fr0sbMXPcMB90VhUqUA7rGq4Prp5inuJ1uD3p8FtMe8/ZV/E2
+ih8U4y2HY4YsR//RKNqNxewyFPEMLmHygQLosJzvXuwuUs7TvItCiuMSqMtTZq57Nibm2XW101Dt
+l1TVD1e2rVq1zTz5hm1n36nl1eYJ5opxU1EyMsJPqKQd68rXBwWun7R20EAg2UcJLmUwibPXOpUW
+g6tGg02aJ064GDWbm+mzFqQ02z5wWDapDLML//cGPQCASq3DYKh0ps2SdnOgQ8LRdnbt10P0vWgv
+XEiCbspxvM02UeiBpeMrjhX0EgOkR8d1owrgrO8/wAZD6oMn028taIiJ+W5y+esXNhBh6FuYtt6F
++WYvk+4q5gaJYTWQKP12I3dS6DoOSkedb7a2J4gKc5DLGCzW0bcete8faSUCJcwDpgvJiQMC9DlP
+DjAORJILCFg8QcCS0zvU69Zt2b5ta8W8uPdgw9DWl++i2zD4Ts0HE6mraxf/hFLuhHV9Ykbz+ppg
+MsKGt8QkdJ+MLwhlMNj2HUiiTcnVQaKBpRxdpfCNciSdpFH/gj1mjUNjhqZAP7TrRarrSxA8IPm6
+I7/Vpj67LC0GQvgYdTm3e5BpUi/LMfgW5WmxoL1wvMVi24pWHk7XpiVqe1wR7tiN9uuMB5MHuoAM
+AJRpRk1achLJS05N0SUJGWbSdSbEkeiiwqBO6cI2DJyM21j6/aYXPqKTwqsTYf6mcVnju5lvbpre
+fbCpdkf3rlXfJMcPG9UjOiNu0MhEI23t88mlzCJl4nc7F1yeNyjWYkkortrdsA/KRyhsXbNCA3t1
+izEtq1q/aMYCACBIRyXMN2IMs2C4FrwvjvKH5DxEUxdPJO+3tBxqguMIhC0sPWFrQ+eh1Vzz4ZdU
+pqP0oeXxDjMl5ZjeE3PH2yXWGVwdMZ1se/hKcvFQMR1RSEVGVFM7KYM2T0lPd2DnivnH7cTazJWY
+DowTyKlATxc6Oob95sD/k6wwKNHVjpT/i8Rrdw6UueSR3YE/P4XerOW/Agf+r8P+wXO+q4ADi4ia
+wBiALwAhUEphUUcZYh7BZji5W9zoYxVj4ThqrMWWgTOry0a6YO56g3yTylsC3fVyoO76CKi7RhEU
+05h0coOvC0yRD0kl9QE+HXB31ToReZcKOCFAEE0CAIJCAKhvhbxo8d12MgzZUQ7TP1kI+B8c/czQ
+cmW3wEC2nEpOHq1StsBqGSdXxyYx5EFQAM7+WEhRHl0MA1b1L2SoLLP1Ckt7FBet6l/IkbNWCAA9
+k1MBFQh29K43xKSLneJSwzFYTqAW4Ww/tALCthFZLbD6wYN31QVZZrMZmuG7t2AGVWm2Rk6Y8B2E
+M6qBmIdVwamARrwfOkj0qXxbC6xuJ6+zR82PlrtJ5bSnmBeF0thJnIpo7tguLXOpOvp3yRl0Akkk
+qBJjxekKtGHw8RkdA+OFvAJblRmn8CNlCQqT+a/qLFUDQBwLIytiDKCbmJ/X8fS7MQIp/Xp66sFp
+hw7gSqg19Uv2wVLxm0vi9aefUz3MttoXn33jJLxttpn3riI/WPPElGuB95C+4/7XvpPlf9+77ecT
+/0f3j3daLNBKCCAYotwZJh/kYy2tQyaAEKDPubEtWQcHshOfR63gzrS7kIXMdOSxLymJpKaLjuQ9
+lQuGDQtNHTps2qGhQ+FkN/ZA/fnis2PHEu5Axe3tnS/+TA/H05abUzs7K3vypM/RXFFuYX5h8kFC
+5/Q7bBoB7ceggCfba21wcvLQISLTTUqav7h373aEMXrLW648t7CgbOwraL80jx4CHbCTaXLFqGh3
+bujnhb6kmRkyFAOmtjtIlFnsTHKvp6cT6FS3swUABH3sNxibFPeR3C55P1ndp6kJjqV6NMGLTXW2
+NU24LeuvVJPFYjXQo2zxZgkPlGDxeACe5KtherUBmgBaG2OIicS4OwSFJ5KA8FCTNw/o6WU74WUa
+sI0azvYZgL4a0AdGY8iddfALDL/Td/aEkycnzIYPPjDPnm22/rBjJ7Tu2LFjB2J27hDkLwA4DWMA
+PsTTECOoMREwXS6D2kiAr6GuUK1L18q1hmQ5+Z6aTH10aurkyVVvhcYl0MnWLegeurxjJ1TS48ZD
+FAlL4LhxEB2F/xlPv8rNnv7BR9NrKOhJD/W0DoTB1kdr1/6yFJ1egPbWo/KlDjsAF0fseDEk11pw
+WANdFBB+zoFKnNtPScikuN4dfWDGmHHL0RfXv0P/XD5uzIzRcAJcfO/ynB3BwTvmXL4HF1HyLh98
+c+Gd33DS/m/vXPjmgy5UMjqDPv0E3Vo7bNhaGPIJ7A77ST52Norw1zCgA4CVUk4BDyQ/ghHwIFqO
+JUTBhE1H4rtHyGnHN9BdGIR+QuM2b9sOizBEahj61myGOiGfHVZMM6Od6BOc4T6B3oUT23GCu3Cn
+2m/Q/xTkH42D17qgTYg7tT+xShcUXqWSMbpE2jPP1MUbu1PQa/CgsrJmeNtsth6vfqsmLVUmA852
+OeX/1O6Kok7bHf0K/YXZ/Pi8e7sok7nJGEAsAI5GDO076KAKGyiL2HS6cyAvxsaOGTrcoyy3V6+C
+4+PHMkI3vq5jevfO0uzcUcIZG3VY6L+U2PJDHLb8Tg+7aMYvLCA2fDiZ9N7dKNjwy8qarTPMZsZa
+/VZNappMJrSbSdqNdW1XQXc0nkEF3Vk/kG7HNSB8qWO36AA64MY2JsOv4G0HGYLcSk9nclwkiE5F
+wZNXbR8fbMftsYDiZXZjmuYObcpdPLfiGMIEBwylaDeCg7aPcYNu9JptD7GzliJx7MfIvtWTbD3B
+nZMAXbw5gpIcLblrqUdGk37tRnSjKam4T90R21+HDlHlu47Uo7SXplRNsrxEBfr1KEQ3zHBoRQ+0
+wGymh5k3r65amDBxSHEFAJ31SfwLTlefMS1diY30fpCVfN3Uo7r6I7ts+w4dojyO1PUpTmqCYRvX
+6k1o4UuWSVVTKH//hVWrN+OuzGhBjwo41IxuFPYoQtsriodMdIs96AGA8m+DDDDXJIITp4tKhGp3
+h9rMzgMM4hNWbtqan/fTpUkVPiOIby13WcGxd2Fyp9EFXbp2i08o6Fuz7aUAunJJPT4W2jcPiPaD
+QxzxJrAB7e9hA0XHtN9AGgU8qZnvcgOPjM+Wm8ep57pdyll82eT1sxy7qXga9Sy1dcMMR77hkKm2
+asl+QXC68P37FOOFPBamZsF2cKJ4H7ezaJygqBOu4KL0WbSwnYEDLnUFG10jnt1TTC6IBoBVprXf
+3rKO8Amw54cEI6FbrISRsDTyQ3l2/EjXJ5PpftOG5JCtL+fLFqywnplW7DgJDR1xG/Bcx+gi1apo
+MeVXa0ym0juZe2VABNSlprw6MiHVw88PfUgBTa+qf6A1DEsFM+w7bMA8aRnwuY7N4qeMrKNhJWrM
+LRgH6y0L++WMvXibueC3fqbrUqhsN0uumUzjiHx/ijYQGzLmITohy1IUJ2U60RlCrdi//wyGLBaB
+DjBicV6exYJOwf4W6sVVA2K7jXt+Zd9+RySdoYRgQQR1wIJIlwKV3NAfnE1KoA9PPnNvkxLi4BkD
+CCeoBE4AKQer1UlKiVyn1EoWEuoqLD1x4gTamTxkiKVl+LCmpiY4Dn63bx/SJiXWLe7dizEgJfbR
+njVnpE+s/HIg3jFICZcileqdgoKyMQ7bz0biQwVQS1iJTMjaJycbJzar/QjsSyTBlDGkapmN6NaO
+pSXFBW+ZLW8UDn1m6U50C741t27UaBgzanTdHPgWfdb05+4p7xf27tc3v+idabv/NFksCbYdZ6ZP
+nz79zE5rgkXAUGPmMQYQBQB0g0nDPiJJu8NQCMT0Qr1JkNHiigvKyiwHqscSPVsCTaOvC0BoEwp7
+6/SysmEnRM07G1LLBaQ0jEsAAPEV+wHg6Q6rRS2Cq+YjzVF0FV3di2rnwlWMwZpvsVDf2KItFvqs
+sO4Eb5kxABUAWijmHOEmhEZoSHug+/d3okVzYPYOyEHZCzBrLoo4fg/do3Nt8bgd6y06hLT7T+s7
+pE2BJvYe8ce3w/pKa39cqHN94To3Mn8y5uTUJBq7G8u4sNDsdG0U83OL1SjRzRxfEJ/g4eF/KT88
+LCIcOPDG/hDH4AL+QL5hhgX3taDmlyjDG5CD3En49UF05oQZdqfCampQKTxcU2P7Db1tdsR0sUfJ
+nnWN7VZ3Iic4w7ypl/DNSlhLXq+CI5XjHbFcXyzLcrCUMU2TSbx9Hv07YwAsOb3G1BRdFK11dKRR
+aI0ylVatow5/07NxJUpy9HGDggn7IDq1YsqE+ei0owMI+9z+oLUdPsH/iMMgYQZ0isPggh3wFBwG
+CiwV+9QTSwPO3mM0ko2/PQWyAJnOwKXHyKLUKo2R6oLZIfSEidXxo0c3t6NFrvKHtZ7/mDOgtebg
+apR28Q5F7yypf69qYnuS/P2KlKlprWth/TgIINgFAP0yFwBCBP3MERbrRADiMAO63Txr1Kzlo0g0
+wlslR//8dcYJM/Wvunda55MUs08uf3bRtsLs3h6JeoyKcbvgBBBOeTJdafv5xIxf/zxa8lb5OH7p
+tFHLZ42a1Wym6i9+dvmTrl2/rKic3/pOna0bAO5tKoniEdCh6jLGB7hNBXRskgsw21a4NUr9C7e5
+CWXSnpwShAGgdCfQPVYpdZPzLi4pccojzKvi/Tt/2eObS+skwcMNj80XBIF4CSGMiEFRhhilC2aZ
+ph1SGyXzg9S/oiKTEqIi88oio2aIcGUP3YHapk2vsFJ3E6KioqISEvO0Wtvm9jBllg6AbeiHmooJ
+djsoBICJZQw8R+/uxK8TKee17kCpkNcSM7gIkpocSYXADah2Wq3g25lJfWHbeWOyGbt2rvxAVfWF
+n8CPp46rME8wV5dPhZ/YPh04aO2k9YFB68vX7ZAwld8R8Yee7l/QtNs0T/U2zJvyzvGCpbllHsOH
+jil5it8hdJ24VPOWo0hgtxOcyLtkDg478oCTBdvuPGzbXQOk+304fo9kwhKUIqXI4EX2TJgl9cvz
+sKyJarHdbqGQaQeKOAWz0ZQdlM92ymMV3G5+As1muj+aYLFAPZVgNou8Vy/YjJOdscWpyfLhra1U
+Siu8TwR9s7VViCUmuvjvxH6bCYDQudw9XCEHduKpahcVkMqkvGz7+YRbyEI7bcktegG9TSLaXWIW
+BrQLUHnBJTDgvMs51TnjVDvlJ2IxwtvNzR25yokTZphihhlurOVbM7os3jUufXSVKh0+hceodSKf
+OdGRLTQ3426+dWMM6AMzuizlQRwneRDRJELPNcJJia82AvXSLgUiCEKtSy4BuePyLE8+dUZDnYBV
+1Hzbb84oQdqjxLrjbXTVPeHBet/WQGcJ654me8SpQBro7WaVbtfz315a0a7Es+fJrVHtFqR1AlbR
+E59yo9m8pQE57NfuxCKl82Zxv+wyXOO7cPxsnqyZzKkCpJA4KzfCyKUOcSQ4L97mqR1AvjtMMvWf
+b3o22j6mK21Vt4SbfpltR/uQHGqu65S/MmE+9R2WAfJvf9Da2tQ+Iue+bTGdIWG5cvFsLUhrL9tr
+/y9tV4M1cDfYN2pCJ2pu4opNW/Lz4E+XbCvcQOCi0zrRcrt1FbTcjdOPCL4PtjcXAExP0W3dS7E4
+8pZiOlO8mEEB89opvfO6o6MuFVvwMPqp6HavYUV4w0xXRfgH2FvAsHt+5ToM/oYHtMZDVMjGJjp0
+YxJvx/UmOVeZUnyYLgq206DE6DC34DBNmjZSSMrCE0xfz98DVTcXWi2Omd4xc9a6dXcmXJ4ghYjp
+G6Aa+qH69xbMQ0/2Lj8wFlJT66mNTsi9YZt/wvFiSYmOcLHq5bcvjhy57ojDtsf9m1OBXoKc7BIf
+6778OHj2/yxARt1oQl3bVwsZTm1oX5MsrpsE+Wo94VZJpPb5NX9bpIzsW3ak4MeK/t+2qmiVPwCf
+/N0utW7G7i1z5t9tTqFwBkVoCCMY/ioSvyAwTKknfIbhAZE/2lI/Radxy5gzMgaBH+J2JC6I2+tn
+/5EpZwFQEW6M3ay4VLPOG+qI2VEXwnR4AqnNh7eMu1d4Ydz+F0dfKITdLJuPFnd4QqWiRLglO9t2
+F76FelOa7GxkgZ9Tlzp7iukAX9I6FgAZ8BeQnrS0Ti59p3Vm21/UZOqa8C0VvQi16Dosoy5JP4ky
+RSn7B6cieBbyZDWbmqwUYBBT5cmdmSF1cifuMdsHe9MowSlExboZayoorOiv3McYHjeaoQU+Jn4h
+6sD6ZdkOe83YA3ds998t6Ee8bgIfkeF84jyXSgtP6xtv/E6iJpPTJerho84JgiWwgxVYGAFjeCpx
+1pF33E3Ci3PE8QAAQQLVSPtxNIkGFOUMhz+rg2MwZdLU7yKNyb2nJqdMRO9NCAsvKu7abQLVmEX9
+loWW5kREZGbOReezsmDE1C5dUlImoF+zhD520R4cRWp/PL3xp0VLeER2b98jujApO8cc36VrTBYT
+HJKcERLMLO+09yx4e+mYlBRPT2VdWlCQLkKoM50A98DX2LvAG0QBAMhxFtyLGjz8jp5GaOgWFtpN
+pQoPU8umXM/OMcd1dfQcwm5IiYlJUSoUH2TbZo5JSfX0UtSnBQVF6QqAMPbd1DgWglAAYLvAUzLb
+kqGfGpdHBwYmDo+PX45enDT1u6iU1AGh4RHMb1viA9QpqRVkMN9mmXuGhzMMaXctNY65j9tVtvOJ
+kqwU6cJPcDQLxzqbpRrFZqE8KwvppGZxu3AP7cncJ7pWKtdhKtwcMK7b1wAX65JJ21EuPU4MDslM
+Dg5mswxdusabs7Moare5V2gYzXhsiVcHpKROgHxWFkpc0l+rCw5Kq1d6eqakjBXWiNpNvcHcF213
+UhSRwxqvxr33hMlqncwPyshveCvBlwbExKSklqOTw4dDr7ETYoMCwyftKi5XxP22LC8yMjIyj/7A
+Q6fPGdSlazdo79btP3I+/a1uLVp9xM9hofNCxZpYCdRuph9zH6g655CQqu/A/Zj7nfI5Mp/UE+Y+
+iAAApksNkCZhu9lNg/mxD4tgYvWZMxV/9kdfROpj+oRFRERk0QpFdFQ3KmkCpc3ORpPgjuxsW0W/
+wCCG9ZgRI+ejSEzNPmYW3MtV8Rz1UPqdVhLMkIdwFkFJe0jG9jn9kIpm7wMPEGLyoQHjUe3pAWgF
+68lfsV7ABR4fXM7MTIJ+MAzqcmE6FT2wzzuwYTW7z3R0Sc8Tv412byPM5EsDD6bC0wOwCkB78lcu
+tCkcrShzYQ7EsASfC40wfaVGnG14AQCTZAnQkJSsVgVQ0YNWN8B3+jB9i0f/dqLnkqOE5tn0r3AV
+uQOBMiVV0IJSUo0afGpnm0pLTTOLBw8upn/NyS019SzNyS0efLu42P3vsFsb8xaVWhCTVXAV+aOZ
++M/pX4uLbw8uzs0p7WkqzZX2wPvwSy4B6ABQypPdMxJdo2HlyepYKnXfgB5hISFhPdJCQpmeXWO7
+Jdb0NJlS+9fWzptBQ7gtKmqqOSoqKCitjuzwhsWpsQxqzMpy6UcLAIyFQjhRey7oUMLgxHm1tf1T
+TaaeNYmx3brkMqEhaVkZGVkDZlDvZ8FKJjZ1cYOD2+Wbp+aj6iwAoTdjpP7gmgEHAIxW+0J1NPSG
+P6MdcBIKYN/847lXX33uD9DuPTY1Hqay1B8oAE5CO+DPrMHxHnibWUD35KrIe8lKfDLg8cP/mXXp
+0iyuSngfgPbv6ejkEJhMDRfeYxYI/Uvv3RPfG23yZFgKyBiKk/FXLuOddOWyMTMJ4LKNRc1+pDKj
+jMRsyYBMjNkSyzb6Dh9N3ihq9u/8tQ6kroYMsrr1P8DkSQMGymgWOvrPvvx/9+83vLPnZMg0PE76
++w9zD85DVsig1QBAcIVJhZM5JaDJ/pJf2cykwutIOMtX2Qo6ln2o4EAt/h2uYe7RQ7kqBQfmkM+P
+Mm3UTK5SwWFdFrdlp+Fk8ANuS0PasglNkbbQeTrWPpyX4bYeCTkAXwEAv6bnCTiXXaESo33p4NcI
+TcjZB6kJOdCHmoIeCGvzFTVFfBcotbTuK/w5PQ89wG3PhgiuYnS8jFoMAPIH7s+WCM8ABKNgDyqD
+DgTeAMDUZGyxUOtSdanAvvngwc0HqZn468HNQHz3OyqDmon7g9hpnIGiqJmbN5PPLrJd6UyuAPiC
+aSZfGfA+x/jJRnvA0cCPF4o581faLhiTpPXwI+vhB/zE9QBi7c9OnvsPL2pW4K2jJCvsR6qD+uE3
+yRuiZ1ZUAi+W+06urJzsW+615/nn93AFg/v1G7xh40ZCP3yOTadmc1rgDeJf44AP7enDX7lMtjHe
+RL7Di5q9SBc+pAsf4CN2ASVDbGpKGgWS+uQlJ+f14bR9kpLz8pKT+pB1u8f8QCtkHPAGGjDG5Od/
+zlN9jg70GQ0Uo7lA5xRcaUuCQlc+eEjenZYzBfhTPCGdfQglSpKNATJXQDXF3pkz9+J/dRJYGvtg
+5r595KEEhQbhADSXehsAoAQxJrm3nxcFZOf8AJBT0IuRq/gr1y5kCmXKr7TJMzPlmUnaZJnYQ7pM
+gnmDH5fBEb0zQnqEQ33vyNoF2VR9YkKGr+8iT8+RQ7qTuMVTbBjdn0sHIeADUwRQyPy8GaiQ+au9
+zzGKc0GyUH/oB2Tq0QGy0R6hZF7kQl1mXAr2Spsx06V8cS9P4OPl4+3j4+Pr4+fj78P7yH0UPkp/
+lb/aP8Bf4xkSBEK9Qr1DfUJ9Q/1C/UP5UHmoIlQZoYpQRwREaIJCIuFYcdL98aTzZF5DybyGgtBO
+dppKeINsg1D8pjDzBqEQMvGiElwB576D1sxgrbJbEltOVY2OMUVnaqKE8FH8GzsqPdB/8IBVL4Rq
+0zV8cdGqFwj2P3jA3KOPc1XkrPcw+XAUAzwAzcog7cFfuXblskLkcUZ3HudBKPcAHg7mSeNgIx1s
+PvpgBsWdRD8IDHQe5mYAQEBRs+hRDA/UACiTojukZLBJFIwTcywStWKOxev0hJeE1IkuniR14i1B
+R+VhI/UH86sY006g4/XkVhTkJ42QhAqdhRTS4IfvF2ajv1K0R3+ogmOm/mPnfJhiRg+fU2lLh2kD
+IaRfR39ZSuP0MFOfU/4I6rKz33svOxs1XRkboQwPVmjnind+I3yWo4GnwzKMcZKDatGBmTP/Q32Z
+hdUc/F46tZYaxgKszyidqSWicaDD1e0I3BCzdvpQY0YVx3bLnTBiZHHfPvXp2TmmPlR83ICiQeFh
+pePGx8UWFS3vbepJtT6blxccbDQkhQQH9s8cmpjg46tZ2iNSm5AQE6AJDAruYsh9Jqm7r48krzRS
+VuY+iVsSk3QFC7tBRr6lCxm88B/Pj4VeY858NQq9Xbp12yj0aEzrF8/A1GGMx/Hs7Gt2kJ198mR2
+9o+Ps7Pd5aD/L8on4F8A0GFsKWAB0JJQAmoL6kX1Yz5b/fgwcPlcJn2eTN6A51bTjathyuPDf/sO
+NXk1Nfn/eAemrKYb3d5hcSwTNk1TW1bbzqxmPnt8eDW+3962vU33tB/jZdQqAB4JfPlz+iE9n8jD
+cgCgJPRGSz9IMjQqaycGF4vfSbtIQfe0P4uRUwF4pAfufa0U+rLbwT2kohUgUyGjtgPwKJk8O4Xm
+0P3tGxUyaicAj0biZ9Zmpg3N5yp5Geht5QT8K9sa5p59KFfFy8AcKxCeWd9m7tl74mfUasezZjbM
+ns+l8zJq55PH5CG0XmUr7LHsQ3Iva9Va61WqxHaUfbgZvw/YNwHgvHmZ7IijjYtsV3smV8DLqK3S
+M9tzbDqazWl5GbXN8d67bLq9O34GzFJftjzmHvqe+4z4ihzIH0TsFoVw/KPt99Ox3pHd+2yeEhqc
+Ftq1cGjceCX3WU1IZPeM0i6Dp6eFhxqyg3yjlsk5iPv5kfnBzsk4XHn6yQOx7wfMPftxMvZdnczH
+GuEZgLbTzD00gJtKxi5PlttOb2buwYVoKX7/a+aevRs3lTdQb4DrBA3xDXsU+TvK2ou5hx6JvBdA
+pY7WKXUQ5Zyd8WDfD7PQDxIDhfMc82XjDWRurjnnDUBpLrH8ROsSoHA9RAZgOeQDIobAqD1sV0kM
+gbaz9EPUxsYQPkYUOYwKh9oG9N0j69FjNvOw2/TigEmF/dPw2FYyX6Nd3H6CnU4MVQE4uU5IKU5J
+T0M++V2CPF9k6P7FXt2jYtKiuP3pujh+/7MMFT5gGKOLyehrwMtkq2C+RnOc7YiZgrIYQ0w6bsf2
+W3xUWkxUd6/iIop50TOoC7c/yNA3I0bHDBsQTjHP7ufjdOkAQHsDcw8sI3MG6GRal//+RnLRkLV5
+1daGGuwTyZ72eiTsqWdtv9iHkWdzrPAvO1mv0bZ+aLM9msy6AGmSisDmzQfR4oMoigia0DbF1g8d
+FN5hRZHUNgWLod8c3HwQQDSd3QoGyWYBVqgTI9ei6fD6ZnRKNgtFwf5kXdBn1GUwiulFxpyqEWGw
+DBoRCygGfUbVJSVk+Pgt9vQcVdzdTA9sJ9FgOpbbeqCt9nHAz6mjiioq2jpzcHHxYKKh2noMLr5d
+XFyP9VthTyptNvSbPU0cI8HFsZ3efBBFoecPHhTkZZvS1oZ+E8coytzot4ObD36DxwmgTW3rh/5r
+j24vl9veIHI5Wuwil9uU6HnSFg0Aq1VrbUqsYnxD+rEeQpn2cLAYyAEwqNuX7fnRaYqPjV18x2lq
+v0P+9luUaR8u/K0kNTjjBazfnukW54wTWCxF6+LoAMxP0Dw0214h8JNHvQS+g+bZU/EzYH6C8DNA
+2bogBbpibwXeQO2OcJaOUYNTU9KMAWh0mqFLamoXQ9r8Ba8uWPDqAvRzTFqqoUtqWsyohoZRIxcs
+ANCWjlLQJHslOVeEuePIKlu6eK5QpeNc2e02HZqN3sb8ANQ5+N8ANFd4Ru1w8JZTtluov73SwVtO
+bbbdJrwF81zbL/ZYexVZYxEmyFa9mSrZjL64BeejVbcAtL6Dettz7RXEvsjpomjRUpeSLv6Qlp5M
+8B4QSBpxzKfcs4end38fWO6Z6eXd3xvCcp9jw5Mo9PwgGLGMp1fKPIWv6If+lMgPkcIu3ktW8V6y
+vo1U9p72tcKd8ShGnPfe9kyyFlut4FGwwLdQH3umvfJv+RbqI/ItfO+gInu+faJw7zwi+OfWq+gL
+PAdYf7WCR9PJM4DCAEBTeRnIc/Bt8sw+lpfJVkpz+zgEvmLPoYcAAwBQqu4qJmphASZCyjFPFzK1
+sHxmPerlHfDcpKr0Io0/XU4ZUydUDq8+6P4sZ3RpeTXVlWE8M9XhuV1itmTlJHfNc/m1b7euZG1f
+gp/Z36V6kLVN1aptL1EB8DOCRQWfKOFn9tednz1RMgccn62277efYVaQs2aAmidXBtC3BpT1ZA70
+JON69JX9on0X5yd9/jhmEDuzOLMPR/UT9tQimwk9b5+IeQorWMl0otXMtsjJU3a5sBRo/cz2X3uM
+fYrEM7GLAm3abDu6Ga26hVbB+bcAZW2z9bQr7ZPE+0yuleN/ttkwAFVQMeg6+ln4yfa1+75k05KN
+StH0rZLpHPlyxBX9t/uybRD6wXVbwoj+FIA2LbMDfcs9Q/iWIR1DRWlkBpnBtnXW5NmzJ88iX9m5
+0k+zZk0G0LaEOYt2cZfFXFwcgyPGX4rYbbJUoZqcwdaqDX1hVVGxhywwHf80YLCHh4Z9OzQptHgI
+VcroI3kfH0Ws81c/Hz8F3n/MJSIDGQQZSJCF7Dw59+Idy3PUefy7PYJNB56CHGTbLN7xG7h+dK2s
+DGjwWcfcL1pPZolEerAMgaKo3XdrwQj0CL0/4V9nDfGNPywvhSzMmPCvf4XLysZP6mMaMFEdOrGi
+r2nADJ60OZ3rSW+UlQEeAHmkJkCjE1J8yAGg4Kq7EfoP956yVZ7a+6E+4et3ZWUFC0bPDQwJCZy7
+qrhAwveux7hhPim4PdAXAPoKYyD8T6iuQpydVJLts9NNsIxavMBmpd8iexioxDpr/pKvVyf+S1bD
+VefQ9o/YhI/Q9nNm5oHtY+qorYRKfuJjBhAMA4CJYAySV1DClsM/MRGnv8D1ZL84zRgQDZdaW81m
+Og8thDYAJfs3oAHARrd9vVqnMbPg78gPf4ZK4F5QgD+LpnVKuLdX67QC5Ad/J1gPN7gm9nciucth
+stgdJENTw7/oKfM/tJ1otW6e/yE1lDU/eZ35/PE8s5np/ySRXWURa46JMVAkBgn/ndiOHLbhWYmZ
+34T2276m3zJb/0v7WnubaV/rf/HfwddQItVsX0nmU8blwGS1qiekmgte2ZKcjCbxXa4u3TDW19dZ
+jyEM1wbxKHb7PQb3zsvAfNjHPtT1uUex8Bx8RJ73R3fhDXq+ggMCJhzGNfuSvargQDyhRbQxAhoM
+fo0CDKQZV7uV0zDAEMMAAxh346uP0+7a4Y1kqKOeu4iOzyaCnN0OPmTT6aGyv/gYYMY00gAAuEnC
+qcN1XWhv4bndi4y1lcqDH9IDeA7Ml/DfuQ3Yt0H7wzHCm2glANJnsu/IZ3JY0vEzxo98puz4mf0G
+l4Mx6OmgSMcnYnsm8jfh8HbH9rgR5G+iklz/Rm4/TTXj8dGkzg0N7QXuOHwiznKtgEfIcyBaqu9M
+6p37utSHFup5Pf7VtrpVqG1uJrUn0CtfCLaSQQCw00nNIg7bcmGyJ8VOf3ydyjkME1F/VMBefbyF
+mf9kldRfMulPwG0W67rxHMiR6OHWkN9j3WIvvUEkiAPlplAwzYebFljtUx4fZ/DvSmtj/PWetF+I
+p58iOp5/0Haljb/WZsT/ugMMd8LfM3nGRi3RLu4SvSQmUbCOKQiyUgxGTSF52UmnxiXUJOxLoOHY
+6A7RnKkpbuGc0FEAWpdKFzw9nhPV1NfD+/X1gzoP5XzttXahnLQnruKPIQuBNA+4xjcv1RsgtVnI
+7wkAACitI75fo6XAciFgnanAc44rYVCqw5T6BdsPZjz5ZjNrebzFbMbnEwDZh6StJGcdD/J7dwGb
+EQBWR/Ds/EEIANHiXQxFnEjXKWAO1z8ZVE+ftebXMyfrben19fBf9fVnN6JX4YCNzNiNcAB6FU2Q
+hibhX7P3eQn32rHe9LOO/SiMM5nsR7GOKN6PnkLciadQM5M+b/uS9ql/kkptt01mLtXbCukE2/qN
+6FWqjbqLXv3cbpf4P8+BdCeWNvk9W9hbAHC4bQNIApkAAAzWzzkQPlOxzTABphBJjQS0CgXNNFJd
+VQMtFyupsi1Tp06vbKgchMu2DJq4oBJvhknz82oxqGxt3vxJaOqJ8oaGCccuHZvQ0FDOJF5E7w/u
+qw3yGm82j/cK0vYd/D66OLggQs5XWiyVvDyiwLonYNScn36bWhJqnUDvDi2Z+ttPc0aJeP7sYTKG
+LAHHHwDuNfYqwYCQUyTMV4MLyUgJnnpDjFKeRqBdaDknht0JVUbZOPj2h1WjqZWz0ANMOPpl2kpI
+ja768G0Iy/J2Y9ob88rojTAK6iyn0cfoxtxqf/Sn2Qw9/asXwgCYfNqCvkXX74/LC6KXWyzWBYG9
+x5E6nABwBvY+6AoGmrooODUFfKZpqzVgrpIJVPgDTxb606ynwj/AX+XpRxv8/VQh0d34BxfarlzA
+SHUX+Av8PcEo3h0kZt8zPrzHXzBmZiZFC4GaaYpUUo5ZrlJoBIBD4ZSm6XFMFC7zSTHlsHi2rfnV
+M+je9evo3ulXbCdnw0GfP5g5bQIEzz+PJtTM+uPAGvTPU31h+h2ogcPQS+juXfRR31Mwfs0IWP6v
+bZCbNLp83JhJ6NHWq2ivcCYrAKA+k41RxNBKUnurnt4BZ0v7ijwPF54z34o1xc6ySYQ3dwOA52gw
+Uaqb82/6InmeIDwXeTmuX96fPE8Sng8Qn2NezlU5eDkQeLmQZ0N4fC55H46Qco3PsklMPtDjMwZJ
+YW3RlKIxksRnIWaCVuIkHDVHcE9jDGJeNK5LNatLxLjcae/VL1gw+8+jJQPCPAz6SRPLoyb31cek
+N1za0EvRo6dx9cwRhYWDVs0cBrmaknQ9pHY0Pv5j3zbYO2tJdE11SlZ4GbqJPtktDyqaP7suYwj0
+hjE/Q1g/8c612novzz8wnUtQCZPJRYAAEE+w2o0OcCTR1y3j5LScd8d94hltlEEOM4tf2NEyeuyJ
+ccZFpQWJA8f8cPqHuUPfnDeub58FpyZPxFUT9+csyWrZ+fmosZaCnL19Bi+jZaj2cRL7GXwxI/yF
+WZXrhw/rEjx1Cir7ToPGSLGBQxiM/REKQLRCmDYMPKmFivQ0KUYCk0F9kNsjsY85IeU5dKMZDof0
+jRDfoC5J02eFdpnUJRtdm1JC0ZNm3aSPW6z7YPcvJvS2yLP6Zg1PHX++yBGjb+YigI7kjLgZs7Gx
+ipTKwFohEKqOp6dBqeg4nJYfEeOA2iooONunkGa0AytIajYuP762J0yXqo/7BCdlrBHQtt4LGpkQ
+3z0lb1xkkZCijZO2d/S2ljqLkEv7kjEqOCpM4L3oGSaAU5G4ETVDczIqIFmPAa10qTi+V48RX5Vp
+0Zid0I/R9DdmQLjjIsyMDbCMOvfBu+jO2nWH18B629FqTlV/Hv1y5C766ya8NmPUgCm7V8DtO2H4
+RRh0a99G6C3W7u/P5AM1GG3y8TN496DiVIwBJAXwD0hd/u7ApGK9/VQMgPIVHr5LAayjGz3WgsR7
+1ja+zVGmX7NI06yh4VgRWiXAfh4AEAACpCr9rjX6dVIg52Op8sAZHKZJnxWqCzw5yahwIKatWYgh
+v8tUc7HEvh4Gupu8PEGw2ssjOKRbOKbwCpY3TP4edV5ejN+GQGaDojawPJwIIG3yzMS2JEeVfhbX
+7McbHBqBXOBjPKCfl+UXbX5hQG8ZNcz2kix/wKYXBuSjb/Gy3r7zE87Ap02w14uvvfYiOmdBb794
+6tSLzGZUhLaiLag/PAUt0AxfE3PiT1PN7fBryBkn+DX9MxZcXj8WRlE7LdYnz+kC+8+fXSfI8lh/
++InkUBx05JP9m4sg+Wsx7aNONO2y7FTRUbrU7NAwrszY3ZhYk5NjhD/NRbV70S303lGkmQ93syFj
+wiPCwvMv+Xt4JMQveKKzWKjrtiiLhf5c1F9QCdFfQsA0kxzEBbEGOg724OMUPga/pFBhE9y0Zt/s
+DkyBXJAywN+HoQJWeIb418lBI1Xnu9SznEm82XYPZd8z8ki4PTIzk07yYRDvBu/hnTg6Ty4Kg2Oh
+C+SKQVAnHZAo1A1YegLtTHlhVGaZAauXJIyXMVh7TT5rrtHrUSxb5YBlcuAHMTMYA8G1ECQHB+vQ
+iGmQMh3OgYQn65YXj8vx8OiWWTC9tjgXhqkLxtX/8OwZvE6zkmb1PTGlckzf8b5Z3acOr+qfEzdp
+xZjDcfRZi8u57IrPZaSYEeJyMMVSvOn61JRoIQWNkavoQeiPcYeKut9wHtC3ZtZ/btiH/gsL0dJq
++pDtE9pkyZ26bXNNgXRK5y56Y9/+iNCSrx5O3Ai962JQ612pVgnWA8FQx5j/Ipgg/U3R/jK6jm2U
++3rSsM4b+OT4KeJ4P4NXHBfn0YOJ4w0gjkpS8Q9utt18cPNmG3+Tv0kueY3Res9oTDrFqxPVJjUN
+xya74FtLkdrDm5qZEycwfArS4mhqi0WIp7aeMEv3yUAmH/gJHEsl3Sh48+LTTkxukWIhCZiJa0AU
+jFi0Ykjh6RHje5cugUAoFkEVkFIPtybX1Ux8Mpg+9aRuhi5Bqgsh1EF+hgkQsJaj3fmhBKbmjMum
+Gt0YIi5t8MFEi3rdsi3biwYW0Ncd3NABqbZ28U+379bOELFDe5CzHCpi9BliDCRwPkAZiSuay1Xi
+BUFN3rytqPfuHf2Ktm+B22EG2rRHvA9m9P355qeLpsCps/9982f6B8sO9BsS2T/BJhtmP01sHcT2
+IPEKsYS7mtrSP70BMwz0XTV9mT5rq1obRe7z4n3Uzn3Q2yLhcPyv+b48zvf9YfqR7LzO8n2je1cw
+hsEzl956Wr4v9MuqShRknFGohDUxSaArSCfVm7GwLtUJJAGhpE9hzZ0UyVwceJyMp7uevpjVt6BX
+fFBe9pHpFb2j01O+ix9w+/3JeUHxvQb2yn7/B2N6tGHEgDQ9RCXwEBOq7WOakv3CraUzB2clVmWg
+X9+oqVbCgz/MeZehP1p054XsKaaikGBuqqZyylvfTqmtLULoNoAgCGUyx5l8kCrEEyVDuiNHDZGw
+8gI6RLV6Q4pB6ufgzJVhEd14B5vN7jdAZ763CH6zDK1cER7e7pPJd9B5s5l6HX44QeNgvN3noS3w
+jMVi64V6uT2G49FgYS2XoBL2fcZA4qR18ihpVp3ijyYgXZ4sJ8nbEpWO9aaXnBk7otfAfgZNdEzK
+xHnxpvT8JWcW5aebMqZONHaJ1hj6Dew1YjQqgS/qQ4r6VmW/+O2U6dFZk0YvvdpqWUHTF6zZ9ApL
+682ZlWVZ+trq71/MrupbFKIXYrLsj7goxgB6ABDNyaI6W2281KnyZLfNluq+H+nchj2YQpcVz1+S
+nzDw9MCE/CX56dG9K/DeJIs/YmzjO+/I9CFF7iuOCYXZk6bDyWj79EnZEBMM5RlViVl44wo7QC8T
+5HY1kdu1ktwOzPYBTrmd00pyOzAXALcagTF0hiD/1wCX96uk92ngLzwnc0Ke5wl6AVEAIKhFKvaS
+UC/AyUXFjHBKI4PpmnQcKVFLKoxSy/bD+4dti6iP0YKVRWhK0cI/bYdbhdqil6hUi8X2sS0Z1a8s
+ggeK1v9JjbQdNEvy0AAuFniDYBIhw7EKYQXS0wwE9FRHoIVwFTUszFMkz/3BIfSbLH/EqkPpMSzX
+7T/eXtNf/IJIN3fgFlve5ReO0ddfGNCbzq6k0dsvfhYcNbnowm/n3nj445Q98BR1Fb6GVm7/qnz6
+Qah/7UUAKNDXfpedwiWDCOEm1FHCgcEcR5SxlLgqAc/iKg46IoDhzcFOWcCtWnfn5sYX1U2wzKe8
+ZPULA/Jld549D8tfnz+T+9IzcUDR0VFTaVMJOv75N9/8Be0W2/bLp069CHv2nwS7wWWezL8+Q+fh
+9FmvoS/eHSBgLeQQW8NtPOdQl2aIkTGOSnqGGF1qpCZAqcB0sMxzoyK7VqPvztlOn9tkscKwHQ1J
+k2H4g2BfJmb2xMXe4dZvUZrZDF+Hx389YdlSjz6lhvp4fz1TtPW9Q+yAxMrHy+giuFjUHUn/pA5Z
+PbMP18qli4iNYyAqYfWkHhip9oFlEnwu5DpsGeDT03CACUOAtlgDNmfwmgBa3ZI1oCi75Qz6Y+66
+dXOgzxnoM3fturnojzMtWQMGZBWpxsyGH8wqUz6eDzeql2C0rSVq+E8Ur16CMbeWqCfSm5Rls1DG
+7DECr46zP+IGkRox2ULGt/sd4X6cdV3h089zHBfVog/Llw7oIudp1oW3MEesn8XHuZ9oQwxjsIRm
+OU+m80z/Oq9nYLQpFbU95UgL9pURKJNpYg5ieZiNIuw4Jl24SihDDA7/Vxg6MvMAmSD6KfBB8IeU
+xsHUVYKLz+mcpr/z8M/uV2DoEqA2jM3o0fWX3h4+qudHTfDzSHHj6EV6fUCkqmBsUN9fesvVXeaP
+muzveMOQOWrwIdHBXZRV6pGQWLfwLbUiNmVpKrq0Ut535vThkFs/zODC8OtOBnvp8vqPhqkro1In
+TR6F/topfP7lzKPlzwju8VFiPRDR/qoHwNU6ysrFS9ct352TcQ6rKBx6pnz0onaG0YUjO1hEq9A0
+uMlLZ+wsxd0YJeRLi3hxAUQOEnRhjUAALlYjwMQJau+vZw7OXAeD4BcwaO3MvpJ+OxZNhS8E1u/E
+UGVYA965gOAePcPWs/cJHlokGUqqWDOcyG8Urs7sEPAMMfT3CO1ZPXn6VFI1C6r21kZ/9+GvD+tn
+wR2wNA7LeJ97eoQZ4yZNh2GkUpapFzp89iSuiYUuvHacgj8Kwh5wypC/EN4Z01GE14to+gHCBafj
+KPOS8gFFA93V63UtFxoThnpakreOpn+As8qsd2tnuCjYCIbXbYchxrHvFEJX274Tu09CniHpYLWi
+dZ+aX4+oX4mFH5fRQZfetC00Y5tzP1RMz2DvY0+BRkCyd+IcqYVMT/fNbauf2JQ8p28FV1RYHJcL
+e6K3F1Q1GWf1q+D6FxTH9gpiVr9ZUakJuF4ytI+pGX1rtnFvVlQGaL4tGdp3EoDAAgDTQ7D9doVK
+SNQmGibTyUwPdPYF2/ZTr74K++ynXpl/6hSdjHbRu83Wf8I3rNiVBc4BwKzmlNhL7Al1Si1MppnV
+6KfaH63fwaDaH+kF1uXUBXqwrZrUjH2G/bewDzyhDsYYyJcYmVDDNTlSGaAMkJG9rUvFVQPu2B4v
+oeZFFFvPL6Hrt73y/ozp0bV7oaoOFvz5ur+3NnPRlqVzer+w5Bj1vS2SXnai5kk588d6iho98iws
+7WVCD95HWx/tRf/ZdjLdaDn6ncAjx6ASZiKTDwAIBADSAgWEkOjUSGUATcl0aco0enkoerQElquS
+fCnkuYQyvoFqqOK/zocqW8qG+rKohI5Ez8KHw6tCoC2F1r7ILECnEQpHb6+aBkvnEH5m30b4WR+S
+B9eRQTmkzv+VzdFcZyxJYFoCW5tg/D/ZGvVRJ6yIsCqBlSUl/h+sDAj63yCi/+mI3RMbO0lwqIzT
+cbjco7v+h68V2l1HpAYsXzzKOM7bOO75Z5Jmzy1z0wpTRq5zKozox2wqbme6uuuAeLqs/zHK7KYj
+Iuu4HpX0i04t0ukTVXCgr4RRAU+yN3gODHTzc7HAl8S5anlFpIIWneWwE1wRNsMa+Ri2PWbVExqQ
+qmGCGv6nE1SRNvQKehkOgRfvrzxyZOV9a0PnQCJu/l0FR59r9/tpV9+MggP7hLxvVELqrPiAcBd9
+mxRhbF9EUg4POGpHulWMFJXtb7//6Mlg2XC36pC2ZrHm/gH2voIDMQLfFHxXINDBwzyh00gAdYJg
++yF8hfKhfOD2i+dsp1sRWIcAEWlt56helifAbGbetV2lYp/kkBzrCaiEHSfiR1Gi21yHPf8wBNbA
+mtMn0E70vhm9f5ox2O5QgdZek+kSlAXfewLYz/E8PINKmJVMPggk6HRatZaEsCW333Dumw3OgLdR
+8OmJE0eMzxvp2GV4kgpLFq0o7v+pZfp0C/WN28aC+932lGNuPAYD4PydN4D5lApECXPm8l6Mx2DB
+Hw8XgCi3WusLhdrp9GKXmupElmQD4WwleQ5WtGzaltf8QvP2vJbFTF7LEpC3oDmsOVxX2OzZ7K8r
+HN+8uGJys2ff8c1hfcubN/VuhuW6POF772bwTGmzv/hLRrO/vBmmjmxZDEgrgc1hPG4CNzU5q9lT
+3gxSQXNYM6crbDYVlzZzzaaMZg4/xX+F76EW1IfoN3HgGZNG5a/09+dj/KOBPEzpqQ+Qyb30vE88
+f+UCNhdewCkXuPpRd2DS6Cu7TtMujfaipwV7qfgA3xp5hYd3jVfiPeMDlH3ByF9p4y9b791Lci9j
+66iOJycOJnU75D+WPjnv1XfO2t5sRZvr2Z71tnsZMDP743vv/7vfC4VCQTb46emaCzXosMXC/Pcu
+LEf77tqmrDE+PI8K/7wWFUUKtEnzzjwUa/EHkvVYKOAzCusHQsAzJj9vxp+KURiUXr5+QdHYMJp5
+pe1B24XuwBTs7+fpwch4n8Xeofw0ZQC9iKqUTQPVAeWh2BBtbePfv8dfaSOWcmDyTPRK9E70SfRl
+xkIjK0o8tFatDYbJkUpcMDSSWKYH/QaziaBzx4f61Jbo+cuvcHA5KRCKvkJlcBN7fwmRcs6j/9Sh
+6+gNmPP5l3A/LIfl8Biu926/geu98xxI69SvLdTXJ3sN19dXcnQtAKCh2bdoeLN/0fDRzQH4iwZ/
+CcRftPiLDtdGgq1ATwy6AOiB3n4+Q5GSkjGyWV40vBS+RT5yfpAxsllRNLxZif84BH8Jw1/CSTNv
+4XcAxO8CE3nXJTaFF2JTKJBiv+GRz14FUSAWDDUFGPyATu7vz+g5eZg+Qhes9/D3D4zjr1xuu3kl
++yZxSZCKTZpuNZHLaN5fNc1Htjg8uCakwqdcz9TQicSpYWzDtVh4dC8J8l2FkDeeFncXYRYKRzZ1
+pMKBjOWRb7ttu9JquzQ0/aLhhcIZxf0LJ0S2Mn+2zoMj7tl6H1zXdHD9QdiMis3MfXTdtgN99X5U
+1KHQkgvU1GPQaLHYrAghrrHp4C5A4fXwWM1eBR6gCygzhTJ+ntE+kfpwRbA+NErvHy6PBoEqPR8q
+D9B2JUZ445XsNuNN/qYR/0+GGOjhvzhMFxFCa2q0QRVyX0Cra6LDFnqrsOv2nsb4EBt3+XvGJAdK
+qmOwLkD3zgKUkQr2kjAG6i0yUOtj7J3Gtgqaw1/xMM2YfHQBFZuppbaFZsFcYaUYYXSYRwhxBSAK
+DDYF0v4g0j/KX8/LffReQXqgYeQh+qgwncAkLty8Qko0EyahVE2TUVomoiaqQubtH0iH1viFJGa3
+aYwXBB7RlgQFXpCe5gQQaM8umPv1tnsrHj36rdX29plXFr76psQerpKz/zpFPTyPGi0WWHlm2sUp
+DsbgiPMQeUAB4QErnHESAq9mZ5Pny8jzQPsNtll2mI+h12Pe7nMf3IeziQ3oHBl/COhrUvvreZVe
+KdcE64MY2sdTz8u9tJh13DReuXYFh8BgI7xJrlHytE+N2rdCvjjYs4YJ8UjMvtxmbHuAh5+kjaLU
+uhRK18mKqZkhE/Vb9RNtH7isUit6m6JgTzMLzeYnPHPfbH5sNwtyRiAArF2IQdIDQEkxSDgfHAw3
+Bfj4ewXxUBbAeDB+Ss9AvZ+HPEgbKu6+NoUmU9h3ch8/jxr/ZRq/xSE1nhUgsEaTiEMF7vHoHn85
+CZo8/b38vf19/H2ZsdFSFVUo6jqRCrWKIZtQBx9L1VPRXKal3jYMwQX3cXFV26DWVjMumkr9gF75
+AjHox43XvzCzADwtbop5SHSVeFOgl89irsajAjA1NPT19veU0XpfOav1E6a8jb/QHRBa0YWkzoOs
+VtTbFrkGWWEd+ByO/wGeQAt6mZQBSrmf3lPu760Pk0F/rSY4NArnCl9ry8y8eaXt5jWcNmzy8/eu
+AYt9KnhZaI0yRJN42dhmND5oazMmQTlPYXFEK48W11CuS6EMZI3lycZgmEoZR/lFUuNtxZix/GQO
+nmbtXqk3V+zsw7Oj0HXbJvSOt4c/c5+3WJ4A9AwFk33Q2xQ8Bo/AM1M6xHmRxE7gcYu9CmKB1aRm
+PTja11sBfdVQr6TlsRp916A4/kr2TaM1+6bxgiIzU67BAwgbqFQwdKAnS3XRsd1qomWgQsX7hEWy
+gTXhfprEbLwxH5Cvck1mUtGwUpNOxrEenl7+vNzPV877KHgvpbe3j7fCS+ml9KpklZUMy8h45hce
+2mSe2dky3uMXnv0FfpbNS/+PBK8HK0MUUd5dGT5b+A+aYkNoRSgtD6P5cNo/gvaLpH21tE8U7a2j
+vfS0ZzTtEUPLDDTXhWa78YU9nv4fdDHFit5TqTKFwQ2GDsp19Ghsnp2IzbMoioo/ZDvThHr9A/PC
+f6BeTbYzh6h4FHUY3t9PTWxlr1psq6j5FgsKtN4xm6nVB1Co2QxvHrDNM5vpQHjHQj4XbPw19hvM
+IvY+6AIKTarwUI8QRmvoArQcG8h5KwxA3hVDKAiCk7AOKo+QiWFy9cQwP8MsMFE/kar2K+/qkCku
+8/cyk5RpyZHOAsb4CnPBckx1gY1b8uun0Pfh0cbTl9BP9+//FnNadnb1mpZXvxln/PqljUePbvGd
+zm4d9dbhVa9FBn957PPv3qrOfLbl4MFXzBuee/a5xQulsybythmmQJWP3iuY03so/PQBXnKeAfpA
+Dznl5BdG4arC1VdMwQO9PKlAL+/AIH9vGU3X+LIVQWo5DWuUIFHYS20aI44/kraTl6bSy9PLszJQ
+E8jI+F9k/C8jXSzqqa7+SaiWLOoi/7OdwTcXuZmsE6nfj1iI4kHvsvkdMQMXno559zIA5DKf+7AO
+zgZRjvKPzUGigJJB5JwMkCEKM+IcBJE52NrhPIVo9IRhtj9OGsdxooNrhLNEB9b4aZxj/3/xGCmf
+vtN1tQ6/A+Kp7KO2M/tQ4iFyyXe+j1lh36bYb3B92KsgAvQxKXz8vSL0UB4Uqg8J0NP+XqpIQfq6
+acTSF77Jvb1k/tNk1OLggBpNhaw8NKIm3Cl28VcuJEV3kLeCoWv9AOasU8bqXTxU54d5YavtTDu5
+aj+shjFEssIL3El8pVtMa/sYSJf9rAS5JqWP3kum95QDRk8r/fRecl6rEq4Nxz3t77WYktcoKxiZ
+D+1fw/kJy9dmbEuCbt5y4V7h1khbUZxfov7ef8KT66VjzKWLHinpj25xlAAKMod4/nx4jS/rT3tr
+gny9Q/krF65d4K+1iUUIByq8fT0Y2mMaKA8NVMlp3xq1X0UoL5yyB21XLuMYDbLfgrx5hULpw8vl
+CmVloJd3pbePolIhDwoMwlvL45dMlhw+D/6XkaxjtXpCd5RjPwjf7/p8/xljMoYn9UPR+6jiZlgO
+p52HPx+2tam2huHFqixqYIZbLNZPaLxcFQlvAkCD2QBwiexVEA0SQDooN6lj/byUlF4eQ8v1yX5h
+KqAPSNRn8Feys8ntbRW+dwcmXy9QoTKkT+M1CnWNKtGafS27zcg/KBpW+mpM4jQdk53dZgx5PSZx
+GqOj9TXCxyOToIAdFE6pVX5ULBSjTXVRCVRqSi6VBVN1BNtfzsfEUlGcHOc1U4lU39bA8Zcv1NSc
+a50V2Gp7ozWwbufFFZM++HpEYCtcHbj720+P/Ri45daVpkD2qmVY3o5PZ9dd3NS/1myu7bn42tLp
+F4/0HPaWdfsh6APsp15E9775qpNYUiwznmSvAg3oawrw03vL9F5AreT13nIFB/VeclobKEowWMAQ
+QjdMvNzHk2Zr/LgKBVXjtVgNEy+3tQncJElE9HQTFrGgwZ5/3OLCKn8y6y0skMTEJwANp2GumL/x
+PtlnvU1KhV4lC9Z7BJGT4a9XyX0xngI579mYv2OJ0HNxIBWspP1rQviKQEZWwyW2GS8L283tcETq
+pWNOZCBdKvt+61u2N1rREfQ7etxK5Z1pvA5jpMNC+b9/HC6yWNCS5cuolWLMA9abhDMwxhSs0Kto
+PZRznnoPjV4tD9YDf29MoSRk37RiOh8IE6aUUSEBStavhgnkK4BqsadPjXdim7FNlGSI5KrxDwRB
+IBhLrm5BMG5zyTykupOb5s+3BU8u+a1emEOLy4yKa0vojQCVpjAvGcso9KoIfZic0jMCyaEiyZEu
+JGOKeUGWVNHhAtUgkK8IVS3mGIHsy53TDR1r3YnCoJXruGpMuFlf+dcrLrRT3VvZq9IGcN0SPHMf
++3sB4BRETwgG/UyaQP9gtQLKfBneS+/B+PEqvY+HXB3C4zv/Sht/Wy5pCrysxneZMlCz2KsmuAKo
+apRE+LYSxduhGiiJ7gnUom6q1smp36An1kPRn62t12BvSNlu26a3ol31olZgQQxCp9EAM3P/czhA
+4KOyaexVHOPEnCKe+m1A0m9CCN2BwGRSe2PdhlHqPBg/T95Hr/aQ+2mDJHGFkI1ld1lNwDIfFV8j
+rwC+NT6EYqMbwaIW7bQPPJbIpbWEUjyj1+DINkIuOo8GEFkEE+1mUyox+fkr/GhPeaB/UIyXwRMf
+qwdtF/grbZiOYF8/pYpR+/CL5aE+0zy5oEXBleppoJp7ilFJkShP5BP9mbGQmI+0UXpDskJrVCi1
+qVovAVZErqc+hIVwEypDX91DH+adLoeDf23ztCVRn/nc/fXD76JrYTZ7Hz2DDqBGVPbl5+hd2BdG
+1UE1NJ08C0uXCHNqv+HJsRh7rgsYYlJF+vmrYvx8Q6CXPtRbLmP0LLZXCLzhWmbblUxiqdBNC/KX
+V4dN05TToYt9vGU1HhU+ULDGXH7QZuTbcOH0zMy2pGi346ZIT9MKo4k0xNBa6OIFhQ7bBJwU+vZ5
+mIwt72gs+gR9/N7H/mg3k4bTQ/54/Ngs2ijuwLC3z2PDO9qFxr33Mexl2w6HwCLYn/hDT6HX0MsA
+iHudJXsmEpSb/DR6ECSXq/RK1i/CR8tfOd8mML7uwKQY6AlqgpdBeQ1fEaqM0NSEByRmtxmxpme0
+tgn3Kx8Wqg8Jj9BHBvOeIXxkpKeMh7/wv0D+QTb/IHsklIt1R2UCtKhL1J0gujEL0JptGzdua9zz
+xS9f031aW20H/omuoQ1NtjNHaC1VuH7r1g1jI1tPtnwmcMwBtlcx2xF9CM+RWMF8Mq4R9htMNZdG
+7Gh5Jl6jDQW+3YJD86OD1SwEcfyVCxfOtz1ou4JFA8zSZbySVfvoNqi1QWu7bIgoj+Mf3nuYmXnv
+fYKjk6Q0xAgJGvpciCFqZAwn44RqcUCs8AwjMZePlPMTPdnAqJFHyqBq2CiZ2X9XAXppcWUeTBs9
+6l0SP33n7sqV8AG6uv0FGEupmi1lh/u8MiQ+NDRPPq7VO8U4pd+b5iGD0ScIoY83baK+Rsk3f8bo
+6AJGvIjDDngQBKJAhslfHUyFB8vkwZy/T5xvkg5vRhwmTBiSHyuviwryr+Mbo4J96rwd2y8zCRPL
+yRhn1R8nHr70A7UVjqmbDbv864bty0OH4Lgj5pmzqo9cnr1o4ZzLjAE9Kqv11f2yZyuGHEejR+bn
+j7K+NH306GkCliz9FYknTDL5cXEsHQ9YztczzivJj5B3pY1cUN6QWeFT59vIedZ5JLYZH7RZL4tG
+L0fi01dmlLYBKZtaGAP6rokkQQ4zC/WL0C1mB8nP1IICwU4R58n5e8eFyWD8U+wU/v7edWCFT6Ns
+BR9aJxkq2gRDxclIHRwL1Ti0wN1cYYjRaaP8ITZX5MJU+FZaIOUVBI8jrom2HTw4mecrbWsqeX5y
+Rv+yQPrEiTb0r/EeHJ3sbzZbX0L169avXwdX3LcdlfZoCdmjAl5mEACy3oyhg60iTklzsZq4/8VW
+URctA42iraLuf7FV5Poocl2VrL2scu//H9kqqOMU0wRP7YA/nEIfwg07bA+2o4824EzZDeij7bYH
+O+AG9OEp+MMOeKqJMZhRBdxjNqNvbF+YzfC1VQh/S1iFisxmKgFGm8nnIhab/Qb9J5MPorHOFxoc
+REfo40EExwRwXgrAx/BXLlx2tVQoZMF1IXLlBl8DiNpArQ3xLY/hH37y8N71T/h7n0h2CrmbmSKg
+vZUiUqZb9vIt2HQ1aPNm9NtHp38L2Zz3oH7/yyfGJL18aP18hAKm0I/7o9t7SilV7batuw/Nf2vR
+yFFLzFXFo6uOuebihoD5piCVT45XMJfjofCLC/Di+B4MiAv04Cgxpt344O8MFHW+bCMxUNQ93UCx
+Fxso9roYKODJxDA49u/MFH2bmvBq/YS/4iVCQU2kQIFtD0WPw+fDbKaG2myDzVJtBfYv3uDzG2i0
+b1Jy9IsAgGBw0qTpUji09C1skwAA2yYk5wmUcglACHjOFOQlV3gynAfvR/mqYA6t4Dx8gzVxgUnE
+PnH5Mj5Eck0mxmATOH7gQNpLrmACPLCVoo4LafTxV9GaOr8A5wQoxMGHeufyXrlyT4Wc9/Ly9lTI
+5bynQrGX9tzLMLSMZ3/hmV/8/WUe/C8snpen2yNSqf5N8DzZvBfgZrJ533kez8xT9iphdhDo7TeY
+S6T2S08Tz+fIOa843xwfWZwqPoKNFq0RN63kjo6MiNoQqlzhJavzaAwt9+Xr/F09QEnRuRDjlLjF
+u6tVGFaW5CUkQrxJYRdFlJ8XYW82WxNa4iXrolbUD5Pn5XMDenbtua3bu5MHfnBWWD86/8Te2cM+
+LS4YLAtoiFA5chPYX0md7mWmcE6tUspojYd/jlyTo5ZzvnGecd5MnNofB+gHkQD9K2KAPuZwRKNX
+A7VSIWOgF13nwTYG8D40rPMTovcfZN9zMDaVcq/MQ6bY6+EhU+dyMk6VK8PR1uwvmJsJ/9hfRj7V
+fd+/qQm+DGNhLNwnbM/mVaiF7FBbNPWNMLw06yU61fqRGbiftxWmIKVXnEcgm6ORcSE5VBzsofLg
+eJ84P2G/tRkf3LxpzZaOm9pjhdoHBKpovi5I3ghZT9q3jvFxOWnX2pKE4wRMXRMDEtWJHomy8wHn
+1ec9zsv2BexT7/PYJ9sYsFG90WOjbFzAOPU4j3Ey1VjYwQjj1H6Y3uKJs1mdp5B+Cw8K7kRVQqUQ
+9C7McZGfXHMtpLEqQR+TyifOS5bjyQEmju6h9Ivz4vgkwVj0wMVYtIKS1ykbibGozs1YdDJRja/W
+jiYj5m6TC5GMQZjyPOc1b7cLsR+ErmHu2ACYQjcMhWSTPx0PuDiW8/KN9/eM8+L5azevtfHWm9ew
+LORN+TE+K/wbWVHasGZj+EwXcYM41CKq0XeEpu+qnSJHXhPUEn8SymR249wlMCYcy2KDUQnBZNeB
+JJADKkzqjGBvZRxrVHCabsHhVJw6DiRpcok956ZozyFimc4o784CfVKghzYE1tG1uVmGDR7atanx
+G/xCynMVAXXqpbmYa2MLFt4g2IhFFB+BXCJ0kpSuRGkHkDBoOU4KiYAGB+ZPT6iUaxzBMjLq/RZY
+3TupeikWQpdWJ5Edgn9HP8Eg/HvvLgOHbdkybGCX3qcdP9KX4XfVttN9jegLhJANfWHsiyaazXBX
+XyNMhBSkYbzxSN8u397+6dsufaljtmf6drl++6drXfB8BQHAmMl8jRbzHm8xvzAGoAEDTRq/HG9Z
+HLH7xHlzih4cjPPi6CTB8POgo+Gnzo9rVFB1XivcDD/wZGIQHNu5+ccQw0BroesVNJnnq+nhuEAO
+nSeJZ9Je/5ac6zKTWhGnksUF53gECdvdP07FCRYgQcu7KVqAVggWoLoQvjGQkdW5WoCauWZPXWFz
+YHOIrnBoaYtn4Gn7+WZKkzHS1TQkFshW69SiaYj5VjgMFWXbmiDa1ycbjtonHgmYuW4gNJnN38ws
+hnvMDh4ryxBpDlbEqeg4yHGecR6aHDUXnAP8vTHdSX9nF6pjAvlGoFrhKWgGLvYV78jgyKDEwESN
+fxj795ahMPgWmdlHCmGeyW/1wuxKxYjwb0Baf0JzBKgSbUNxqoi4MI6KYwSyQ0Wy/w/bUB0I5BtD
+VSs4RlRqXGlP1CQGRgZFBvtr2bHQRYbvzDrEpojbovIRchkAfEtgRnh/uAwCfyW6RzYAzNuMAXiA
+IDDcJPePAwynkgMuMC7IJ94rKJi/cq2Nv4m1MDLhfvI6Dx9/rzrfRg8QWKchO8WafZlvSzKp1bSS
+5TleqVKpGF7Ge/AKXu411hHBQOswocTuIidmGKr8xsQ5cytvnH4wj/qo6V24By2xnWo2Tx8zpgae
+3Q99zOgkek8oekRjOjk1oVMDdKDaFKiMAyFRcUGcNjQunOE81YDjfeP8kvSS0niNcHRCtFpd5xEY
+qaT5Oq280SMoDNC+dSHCleWwdDrI16g8RfIDeLUL+ay76cilnK0aHhAGQo0nI2im/PBooFm8r8iI
+PkaXhWvqJBxgfZnspynS/LPiuAaZ/JVxwGUwgdJgyDB81XUeSr5O3ugBfOt8HArw/3PSOyW3HZVS
+LcwwEqM31OSrCWDiOYUcBAR7y4P4K1fajPxljMxLRJy6APkO1QY/dhu3hdoQsMtjrV95EH/T2oZt
+Xegm39YCqMIWVUBhcekrIMAk8A9KG0nr5AL/l7SKSKU2lc5Xo5/RX2GWN374EWrQ6TcO/GzYnPIf
+GOWN9NQYuAI9gPw49A16gi6i482P4EvVUj2dnUw+sW0NNwVEBvPB6vyYYL9QbyrOF3KebByX5LRu
+GYnJCkvOvisAo9vAByvWhm0ILGd8ZHWejRRbx4jGQ8Fwwl+/lwTdmEaym7nEACVfLa4WdwCvewvl
+3/SH98J1d4U085+fq/OHCFcAx6XAP0W7SOl8C3r232t3COaRHasuo7k/37x59+7Nmz8L8c29AGD2
+kb0RDt50RBKa/PgcoOC8fON8WC4gJMLNsMUPDAR1SuhV59noE8DXqf1xQBEeyfV7zf7NSl1hM8AM
+fWhpixJIrJwIoEXN3kNLfVoCNbJmDd+iyv2lOZBvUebavHxbAjUePlSzgm9WZjf7882qbC9v2Kxs
+VuBYw/EdohaLS1uUKuk33PzIkVAu3uLOO0OHcUmUguWMTr87pWriVGpoSxOi9h1AH6MfXnjU77Mj
+u6hn4OTKwYOr4BfC1bEcKczweeXi9XM3O+SnEq5SEUPvIzGavcF6l+da4bmcA+bJnUdi+jcDXWFz
+dHOsFIkZ3Xd8s3/nkZixjkjMWHkz7RqJ6c/jJnBTk7Oao+XNVCpoVjRHiZGYUTgSMwo/xX8l3B2x
+KJPZyqmAXqrdoiWJAGm4MDYPZIY0hbCZwsRsqNhPm+FkeAuFTIL08U/QgbezTn0yetXhlfsQ1YSr
+Zi0zI6UFbfv6V4R+QysXjp4Ln7n63rE3Tn6K83ztdsGGhXPewJweQMHRe8TaftL5DgZDTL6BQfJ4
+f4oOCAr27BZC0vMdxzugLojeATZw/Db/LaoNQbt81nLlIW7HO0BV2AKC8PEOCBKOd7SIfg0MCmd6
+bySOlVRQ5v+kbNZe+OwB+i+kYcjN2vfGWPw3LUdfs/BrpPfuSz/c8DFMRlAGu+KSVM8uRfXVaJjg
+Y8U1t3kOlAg5fgBQ5SQeXMgRlANALeK0vAGYMdqHnAMYr6u/My6Sx1/0YiBklBgIGQWiHIGQYUXD
+xQjHVvxU+pyo6SkZIzvEoFNYn+VWkTzeWDDApDRwkaEwh+KC42JDNXFsvI/aGeB4U/CsBMTWhQJf
+H/8NXsyKYE1dYKNXuQ7WAbfoxittSYIYz1N4EokumwuVTnUX7xStwWHePPcyWtIjpr6wsJQbkJLS
+M0lHVN4fL7yJlvwoMPsquuAE+i/65+bBYQ0RqinQj6i96ORL69HlqullZTWi/fwAkWm6gDGmUD0H
+OIVnnEzD5ARwXHBOZGgPpQybJX0FToolFUkxFCM3u8hWhKq8QzRYmgzkGyMZD9qnjvZ21Q6zsYXy
+b4MZjQEOAy53AC2xvf5yO9Wv6d0nL9r2CuOCKehyFdzjrgWiky3oMBmUcN4AYO5xKhANzCa1f46c
+84nzCoqDgAnkIvWhcdqwGGKbvGzNzr5MpDS86VUrZHREXSTQ1UXXevsH0aF1MhzV+Embxmhtu5Zt
+zebvJYm48Lj4wOvhEZFaACn6DMgBHpqMkQRpT0TNELzVbmdaLGENT+JzTYA0cIr+OFwtClFNn+IT
+zxgsaBtG1UDfms13390gHmh83oETf0I41wVAIaBQSM/vEr74osgXFwOHzifapfbbNyk4+gSZn2x0
+i3lI5O+peH54VY6S0wTHkbjHOJ7zSnpa3GOd2rdRviLYs65d3CPEh6zU5IfnQ6FUqQnuecgZsBj4
+aTJek3EsY2I0GSOJLbpTeTZZTQ/Bhmier0SjW8jC38FfW9avW7feVe85Xi3sW/ZT4qftAoBSrhVx
+p7RYWMZOgMgAnhMkoCh/CCVfYF9I4Jrg7hpaUfE6dvq1oUO2Xa2vVyho+MjhF/wRaidZLJPQd4Hr
+0W072mg3M+HrA62rdx090ijKbw0kVzQKo78Z0/C2DYKSjCvXRckcOWNqXYyOChX2rSTullT4VcAu
+LbAadqnwqzBPP10Dz4pyLz2s7iZcXo2UN+tE/7n9hsc69j5BwHlK+Dn2tcnbO+K49L+JR//8XV+0
+2+GQq1S98mFn0emfvwt72l4V/XHvw7jWf2HfiP0GN4jJx+cr2lHs3aDrRFBy4//MLpH/r1rk8V9J
+VnqoWLCu05tgx6ZbaK4oLe37Yulm55UwGPNfKUaSwmvvSacmw1yYjJFZoVGCXIFkEwg0ReG64pQd
+noVvPtp/CB6J37wWcewqxK3dHA+PkHDBWYlFo/B8jCpKRLOa2FEoDN543GSmxpjKKUNBge3f5Sbb
+AbOZys3HQdTU0nzbO2ZBXpN8IP+PaHkH6qDuiXwHjMldXYkamUjUWLk6F8YQi+rDoIhleIaWRQSh
+h9vps+gXqLTmm+Gukmg4pKQEvRxdQiwYc3WYAcKdOrTGDCQ8Oq+LZF7igBEAT+bvyXAumBKmpWvl
+zA94imw9/35yrElk1xyCR/zktueFqUI+T50lMeLcxlBjVArbQkJnFgAen5A5iwfdHXRq/idCtXJm
+IJ4922Nx3vL75HQ6cdatZI/tgNEoT5hEdEGYP4Wy4wQKe+2C7WdsXBTjcGWz2ask79Th48XM27HJ
+oZjXSWxTkL6LpwjdQMe3wGI4tBLP0T5oSN7fc3zxuOGpwwPQq3A5um02w2C63mLdTE+x4K9wZWjM
+N5OG9LDuAdD+L1Qii+RUmKu49NW+J4cR+F9o/nyohKsmtMDqTXBorx3GQSPGDeiRpUILvzthu9tC
+n7VY89me5scj6B57g7sc6Tquu7UYo11AE3CPr2OigYBV8D3DMPmkVm0AACyBQ8P5dbyMI9VNlKlU
+JE2tLhmyefIiGzxgWzgZecuWLLf5+yPOX+Pp6ZY4DL976xrcYYbb0WRhPrkP2Puk5ojgW3AfFAyk
+IpvgGXja+KIwY0MDbX8ct91jzRbLk5VMnThPj19mzVKcNPsMk/837cG7R2EsjOq72Tktq9B8+qzF
+YoumLjgmhD4rxdpzH8gsihj6S4JNt4JdRbDpSD8E5/Qr/Jw6y7xBzr0RALaMxIBFEgqk+0etdbl7
+lNK9Y4QmwmpN6Lzz0rkk3TfM6np83dQ77ponP5Kbhsia2FZA7ppQ510javZCqWfp1pFUfLT4gxZY
+/cEHTuMKvl/M5FYRzStV9Agpz9mLewx8QaLJF/p6+vt0EoBv8naJ0hcyBy67RuMnyx3R+OH11vtC
+/AaMRuVAqidM/8iWOnzpIJ7+n3zpbdiX7iou0vOrbe+IvnTYHQ0nlu3hpLbQJDGnQO3UrST9Svjr
+SR8IqQIQIQqTSn1dT6lxyoDtSb1to9lMTXfQ+iVjeHo7WS0tdGM1SoML0HJMCnynHi42o++a0PP1
+6LDZDEvF+MA+bAXJuwMuFgTyLw3nybdPjZMTCD8Z5wodmwkBjNo7zSU7btC8N6ePega+iw6ZxZxz
+2/S1MbFNM1zz4+DLr6YOCfQbXQKEOqdMLWMAXTrSoRUK9pIcZif+f0qMDh4gc0yfqUZBcNL7A4dN
+GH9s3QKMmDDcGDfuWXgZ7SHzjv7YiA5PnPoJuvniuLF5GAYhdc2P724QziY6R+7nRJJ76nR+p7o5
+yqWgNzdneaBrsL57WL9ZX4mOuUb2u8TrW1yiodEzNMx1j+wn2EK3yD39f9FkiOlAU5CrU97dfU+E
+1e9dXfgujnmzi0uUmGLdPPh2O6hBs3F+AR8D6uJwFNxSUZYfhWZjXz5+/hl+vh8AR9xpEJF5O+LV
+tJtPV8waEjtOZo+Ejws3cqfTRfbMLeYB0QeBu+u34zxR30ju3xZKtsfpFyYGaYcLGN+rE+BuiwVd
+E6ZBGEulGJevE5BO1MmuzbfzOienkkj4d9/tNBb+0iUcgLq5AW3vEA9P/9qwGUDMq5mfOJVYN5dw
+aq1a+5TujG9gwTwbXejU0c28UTfH/H2dbWIHXzdTLPkdZTx7FYQJ43pKJ1q1ri9d3XlsP9pV33l0
+P3NfxADMBoB9UcDOU8qf3olOnU29s6fTYTygQ6CPy7q4eOz3O/faGmGv/Z3eTqKUt6FjHZIPW836
+DimHT6AQsUzmCd0i/lm3vezm/dJJzta+7XxgleiOIxRD0P1FVwdxDIsxxxeFNWjXtqu7VO6Sh0Ju
+B8x8pUwUQjB65Qsc/k+GIa4tN48x/N/tOiimXzCjtAM2dNCF3txq0S8rkCvcF57J7FWQBgAULogk
+8YZIkq6IJOmOUCd15jRnLz3tvnDJaeACW6mPnn5j1KBDLgkPFrFOtj9jAAkAQGUnvdJPvUWYPOj0
+jLcwZ1rg0++SPPEmr0ZbO7tPsJyKZov5+nVwAIkXXivYfNFsEVe/rhI/PS7ySRU6R+oZKMV6Bo5V
+EngkrmlQWM8m1KNCHFltZh4IyeZPfKXtSbAjbxG/fPs2aGFvwvQmgvVXjb6DAr+j8zZCb/THRmur
+wOQ64GhD+2NUwixiDFi2pl0TMqQlTYIcFlrjYde9cMNctJlM4QYisYZTP1os1rlondkMZ9JrCI1S
++yC0XXtqp8SiTdWmQg0VewLOhrMPw5PrUE+Yhj7CoFroI5iGkrF8bTtAjbFYbHHP1aOvzWYYUz+P
++smV3s7aF7MxSPvuVONCrYRyXD/Vjfp+9S1mc0t9P5F+4IPOsWcFHuNESfV0S1aR7jW69CRqRC+8
+jRTH4Qk0gOSsmGDheXj3MJoNv3wVryOltv3HbKZmUussFhQBf7BYbG/bTC5rGoRuEXlI6M/brb+Q
+dnfbolU/3XkWTVkHo9Fby+A3i777bgPcsQ7dgAM2CddbLnrHbIabYavFgnEiLRY0F6U77zcVOid7
+huQ9tNuDbqxTB5lz6HI9Y6hHH9MBr1I5rT+57cgw10QPaV+y04ndTtB7Ujvjmjr1MOqj6n0QrYK3
+BJZpNTbto7sitMz6qTN2wEznLcPr/AE6x851rEP7tA+lK7108pvoIvoSJ3/0PNNohR5UK9WHJCiY
+Kd3N8zDTYkEXt/+DmuHk9k5erxXbjzI4gwc0QvSAk37qdhMKGp7dFXNQFd8TLnewfTMckRY9EA43
+m9+aGa+Di50hEHgMALCbhfnWOXlzJOOSe66lk9Uhrd9Q49+EmbAr5HDSeaMV/UW1khQK25Ob59ER
+iwWO2P4P23SL4GvANmSeA0WdYjIQO5RsKm/wXcptIlhyS4AYNyLrzRXwBmorPEf41T8cegHG4xBq
+6/+NIqDuROqivohLfSqHdwp79GQfj7/l8E7AQiDpCMdFHt+ZsAeTn6opXMtoJ/vV7eqEx6e0kwK3
+d8bkydwQeSMGgOikp0gbvNudyIL2ModjaupKo6mL7rLH4/fRb+KEiPJHJtmT0QCwci3dWXfuY6bX
+oiDo7pJwDHVUXMJkaoq7P+LJN9IYc6UztpngLICuMIpSJ+nkwklLch61JMdZYzej9ZV6c2snZ+0b
+9A4Nj1NR7Q6bq0ylJ33IkmQ6ebKSnLYkl+OW5DhvzF10B1vcm2AnJw7HfXdy4EQ9mSrlVMBbkHGl
+ycuiwlpobHhq4VTmx+fNZtbkgmMgqybnoQvU8hxd78x54OL5GGrrsFs8R+8Cru+P4WPoeqFOABsB
+Zzvex3iguwQ80Ezyvv1bAGTbyblcLbUrCyc+w8MuOD3knGL8Hl7C7xF8xgW4/zJ8SveBdnYxOAS4
+xb3Dvc7PRdyfAtweo3ZpT4tr5UwGPMcEOMfjcUt4n1vTgU8Q3yV8TeITWC/EeefC+8xi8v5SEcf0
+Bv2n0L6nQy8U+y3E44dCzZ0qlMYU4rg1arhL/rSRN4BauBxm8xImhhCz/BA/B8dJ/y+6vC+Mbzmc
+7f6+QK/b+9J8xNBrxPUqcKyXmazXEWG9JrebX3qce+wmle7eHhPdeXtMTPv22APifF0j9G53xloK
+85WMqT0trQfBAiHzAb4k87HexVcmzMdKMr4TLu8L7X8JZ7u/L85Hx/cxBsnyTt6v4g3ULrf3Sf6m
+ON9Z5P1Njvn+Vmx/H3n/pKSbEXuX3B2fULiha0VYDHInC3aiJ3Kn3pWFbpHclo5/a4jR9W1qoXdX
+o1RRoh1e7SbPCnl74j72JnRuJc9JnJa4jzMJnafI8wb7DWYdxt2CQx2xBzSTj3k8TFLrYrRiNWK1
+FPAp+JP0uOJbAkzltUb66ykBCM1ff+jlpDEnXt5f/yBvc8hvpz9Cv23eHHQVNt2iH186VjW6uMq8
+ZNTIRW/NP7R767ZaFVW6Bwbj/lJQH5IH3wWAaN79on1qQju7yuUW6SStPclxjXzHpnae3U7GqkeZ
+JOY9AQDW/SJJ7RiyjqPaHXHtTLTrvdIhfD1KISfB7U2THffLYibALYq9f1WFEOEuYAF4PifVvXnK
+mD3d7AeOb9Sq9qN3k7n32ZpdZfIR7KjOp8Mhivc0O0X0BjEvQNaLMWC91xnYHwZdZHG1q5yuFbFw
+Owv1d5HPn0Nqp+x+Ec3wkqlUncb/Q6fcbnbK880n9swZNmmyNJeC/nla4FcgReSvJcwNcr8I/NUL
+AA8Bw6UH+V0LANdK4oZHCbKG/Qapn5RHqsyoVeGUBhcwTKAM0t3PycLpLKjWRsUY8OgSKBcB3Jie
+QKempPWE9G01JU8a0Tc4zFsVrjhHFZyLNeh7JEb7DUFPggcl9j1aPufS1hL8vOXkc+N6DErA+45q
+NHuxfLxpZHbS3L7agf1NKqrcYrFuKt+ZG6xTeVjCwuJi81Z8tJqeZrFYZ717sXvq8IJe2oD44F2O
+enmcP/s7kQ8xybooP0ot1xrDKY0sgdZJglpKLmUMp4RAB2ZLfOnK0pErSuOpz/tMK+0bvlaemmMK
+tcVirbNk66W2S1tLxqPZ9NQ543dYMjPMOytQupdGHzw3SK/2QpcsFnrU6s/WFRSs+2x1qcUCKGIr
+3MX+TuLUgYLPpQgogDxZJMLhLdRGJUCpZGw4laytHL3zo2l4Tqa5kDGD6L67p320k0492fLSqcRR
+y0vgBDoXRn66smfvNV9sQM+4EQNT856Fc/956fMfaw9ahFpbXQDgLrK/EzRtMcwjl+qcJB7zODh0
+PJpdLo58jgstdQItJ1voqRbLlNUfrszLW/nhatTLfTbWwmcvvivtI3/2KulXEOf8KJe94rIovNaY
+lgOZVPjceLFbvC3aLQeccLKFmow7/mhFnrQH0FvOzrujORfffVbYB232A+zv7O8AC7PqZGMu49gM
+qgANLi2VmpLLJBvDGbXKj5G5kALfUNd9+er6CSnZU3eOHbRlZj+amYCu+meUNTy7Pq94z7r5pcb0
+sgXPPZ8Pw1yos1bDvpezpmw99cX0WSdmZ3Wf/OKMwOQQy4j+004sG9MjODJvwvJDEyefO7xuWiHv
+IBj2FWjdZz/AaQTbMnQS4qTJIekzh10npAH+4UrBwnN4zhiDo3nrx44fLYwdTxVZkwPkfEST8xGj
+iwyCbh25H4yWk2i32yo82e96IkbuefcimmNd5uyHnibtisUWi4DlfkN2m/0dZABcQJYcRscJJLsv
+We2yCYUNIqBlpCRAOsqPUoVTxlwqVc4ov3i5oS/egFPf+GPNsnunZ3hQXVwoixu48B9NB5YWFy85
+MPyPvg3GtLJ8gyG/bMpok55dgg+tdbV0Unsve2fhdOe2eX1+y6yMjBknfn5pRsak8Tsstje6DF3c
+vKwkNnZoXTGcJMRN4Fp6u8gaJQPgiHBzJRyzQXzWtaxAdTIhm36AV8XtQJdsvTRn2kc7R4/Z+eE0
+OAHtLlk+KjFh1IqS0pWl8azZfUtP2PDFmt55qz+BgR+v7FXXbcL+e02TExIqd9fAN8W9AwAXLO4d
+EYrJTRfNpZOd6/lry8kTzSyeQ0xU5NCxE7rPEZYSPhj+7sWzbyLeImyVivD4cD+8jGmifLDPfoDd
+LfgsXWzJ0s7ZJ2j0rMswiS77xLnZSRs3PF6R/IzktpBmSbw8RPbgqk1TXantY+NLV4zEs4OpFrcf
+2iAOgzWjtywoTWLN8COLBQ2X+DHcbW0VRoTXsNV+i4tj74MYUlskipMFMDhd1I+KTCCF5nCkQjKe
+sAA8KCjuvJQEqNdxFPPyjKtj34AhreUVL91Dd35rmTinEn38cp+dB44981xzylp5Vu++1l/z5r74
+zt7a3LxGhM6XTWOXFuZ5TTvZtnpfW+OAAY1t+3ZAzWw44lrm6F5Rxvi5QYYQX+uXmy7WpaXVX3x+
+xgebR1jQXREfpU2K5cBzFCl3y/X0o51XLtyNJwdOGA+fK8cz4lhUYWrS8bzAZxMtFuvLFgvcHR4f
+5kcm5pi4rlPt89gUEhcAoA/EZYsDNNjNSAoZ50J9Op3MqpEd7fihqlcWPdRiXWKZYfkRlv/x61+M
+YeKB5VWGSKMS3Uffz4LhUWXZGav2vFYFtwln5pK9lf2Y/R30ASMAiNbIEqAkLUiHP5dux5YToAtf
+pmThUBNNPsQbJS09gWaHFF3N3TLE/NWEfjOKu8UNrsnLWLJ6bX7yM7lR2dP3jB+4qbYPg/m1hyx9
+TP3qdb2G7N1YPyo5ZeyiovgheuuxwDitKjy5t764aUiauXL09YH/TBjdLTdf13NkWsqovJjAbhna
+9GVbD41ZfLY+O6V6nyU4NcwSPzTGfGTZ2OzQyN6Va45Xj2xu2t4rqHuQ2Uej0wR3Dee7JwZ2DeNJ
+rQj7Ae4o+zvo9tR71kA4nzhiOMnlms0Xj073zJxQpD8nSST0SHzfibvZ+ho5TYG6AC9ooedV7DRn
+ZFp2jCdzvRsALpsLBQkgh1Shxj3IYDi2dUSRuy6dxowqFVMSAXPpZCHG0AD9MFg2u8tT6Zm9aNmy
+nlReRLcwldfjr3v2MPQdP2dVwYd+MfGJARPvdOuf31s3cvih3et7WGdR28J0chRU2DA6OZ2thDBu
+8BST9VRCeqinJTcjaeGmF48eGwIjlWEKT4s6vl/KsuEbqrMel0Vq1EHU+6PPv7YvOysf17i035DF
+sVfJfGnbXwIOpuDgEfR9+LELgylwkRQcDIK96rz+XpPmjR5lsVhXSxyCnofXapn9BruJvQ96Czah
+cDpZOOuUTivTKZNlWmMulZJA4XXSiatINiStFln9MpiT59G/dsPAoTtn9+s9dV0hWuH7U9cfvGBN
+bvXAuPjhdTvrSxK+e2bLpbYPnh9qHDm/X9HW+UUVx+6sXHbv9SnwsiW/Mk8bW7pm3JB5xV2mwh7D
+InpNfLY8Kzg4q7zPyFVjEhLGrBqZbx6Q5OXTc+JzpdNb6nrlNZwC0P6p/QaXzXUnc6ZzF6qiEihD
+ejid7DprrAIzhnZ7DM8e/NwxadQClz1GF5EJDNapvSzW1S5bDEBwzH6D3ctexVoDdGwmDVRHC9tN
+LXWaEmPQpWoF1iod93TqaOigkWO7rfsmdWrVKD1lQUN1vSJrXlmYn2FunDBu55QeDDMBxnrIJp/4
+fl7d7VemDNvx1UoW+ESGKus4H7U/QleaZbLiFz5uWPD28j4uZ7Px+vreAxrv7V/4/Us1Pk4dIJz9
+HdvWYaRUrsVxFIXfYmgj2u0i4OMfW9glaI51lsVFirFuwgLtuxdJu+/ZD7An2d+xVA/VONGTbJH2
+3IuSIMBkrFqXClPhZzsL86c2N/SWBkqzhEE5Brrz65WQtdiq4M9ZJv8Bay/+9ZQBft002WfKX0Uw
+6EfhjuDOsL8DgwuvgfpIfKFJQ8T6mnRhqGEuZjYVr0MOFkDu9Qo83oTxW6vx0ym7KhOxRF/qYDRN
+Fgs9b8T6yb28rXaLhaa8elVvGEH4zA37AfYW+1+QAgYKknVaOmHinDjyDvK0UoVT0jkZThgRJgu+
+pH7u/gRfuRfbc/Ly55ZP7jl47729g7atnjksMXV0/brt/aHBTbL+OGPsnPo5YzO8/MomFc7dMWTk
+Zks27Hu5sHdASmqSfFBJV1/vwF7zJlae2zxa27ty+cEJlW8e3jh9oNxFzqY+KCzu6usTmFtbFlHU
+ZTL02bXiXF1mZuVqRwyEQtQZ3SXUzpWklASKNmLu7LJ/XM9WAX4eX7qyvdyJp7adtkbPw2zJRefG
+dZkjg6C7QCT+FkAb4QQXGYiozsPhs/RarCG7akVYMxb9evYD3ELRhyNPdpX0BSkiR+IiwtlNJ30x
+n5pchoOSXURF+Fw55hsly0YmUkTEcBHxLHCeJF9EWSwmPLLUiS+MpShR1qi132BHs/dBbnuemwgT
+aHH/UBqd40zhm8ohoNXCnFzPoulri0Y+X5WhHbhw7Hvlu6ZmFT77fsP4N7eUczczx8zOLpgzPKFL
+ycpxZy5/MmTHdXT7fstEeNlSUNUrrGvBhMzUYfmZoVlh/coaho3YVtsrqWxdWb+JpvDo/LEZaYNy
+UkLTcz7cXbZvdl7fJa+685HIzvZFmHTsOtkMf7P+n1gsbWTJAQWm2G+wjex9kAMGd7yF8P1tyIXJ
+wsx0vH+AeDsJCwfXwZy+3KA5GwtLd878/zD2HvBNHcnj+O4rko2xLcmW5W7LsiR32aq2JVvuvffe
+q4QLBoPBxhjTe+gkAQKEAAklpBNqCgnp7eCSS885/bgEEpIjYD3/P2/fkywD+f5/d8GS5s3bMjs7
+OzO7O5OSPbgthzrkm5Sg4W/QnlivLDMEsQtRbP3i5PwHF+W2HP9xJb0Q4Z+zK5W8bGVDYn8FfMec
+0Z4SEF61qrZySaFsljDYa2FUjECdUhRVvaY+Gq1K5oJoLsdo3lrdd3LImLr0OewHeuHySWhOT2tN
+CghIttolJL0/5oV0/bvdL4xmD2/Yc5mTnUI/vYjb1HeAg3EAOMnkVRAIwmjPFoq3yBDEmto7CqeX
+Go2Etr9EfBdEJDk/EcMSsYwLB37dW1Cw99cDdJTFA7/sy8/f98uBC1dU7Tvb2na242dV7btaW3e1
+k1fNVbPK1p0bmHPugTqneSbTPKe6B859cmZDlVO15fYHD5sSZuGNjvGd1K4PHuyMd5w8MCvBBDCa
+YTjN5G9ARd+IhIzVhUZKJhdOd98uJiS9VOuhG6PZ8pATCG7Fq3e8MZ+2DesfemtOy/O52E57g7+D
+ppDEDiJ/S1G7ovzY6edOPv0U4Tf01Lw4/YJnf3t2gV6tsthIeNZsPmtb07HG/KU1yudh7/OnjiJ5
+Pj41gWwiJyCmczBNp64VBQWj9Nt0cgSCTr7tQuCVf9CHJf74kz4q8edj1M3znZ3n4ezHDkKni92K
+qqUHXr43K/7CD6DPoceg7wcL+t+hJk72vnF8fasW4GB4aoKjQ75uJYi3cr+Iz6oLBFJi0NhCQhIU
+HAWD2ZFk8m/q4TBMzHRIbFtVlFK5dygjJLW6uTUSXkZjXPMCde3sk9SNF1uID9hBtXxsXLj4hhG+
+Y9bXp4U74GbPhO5ibYleJnI0U2ecKjZdGJj/xrbS3OXPxH3wsNngOPngrAQT0ZVddpj2jw7iv8LV
+HHcBB1vGxC7CP4NXUQw34JYIEyAdn6ymsfI1hy1bifD0syulx/6oofEAcQ3+i9MGcHqNhhLo8CH1
+0oOcNmoNgIAkbuBuXCkQsrJFgsRfIqZRubtgcEPB3JzgmviuzRX5/dmShriuzeTlMGNuUNJoa4LU
+kB+SO9agYWTUE8Q1bB5TB53jCPZBYpA6TlcCkZ7rTtzAuZxPaR+cm9xOOUEsqGIFThROC9z8uMyF
+D5YkLazTBeortCE5+hBOMe4eECLyCfNxgRJDYWTmUJWGuAEXv7A4wS+pPTO6xCD2iEyNdA304gkl
+UV7yeKlA27KWoRFxA3+a4w5I+syfSigW1pzG9hM3oOQ0sxbETU1w8wk5yhBOO/k1TIJeNBvu/W7L
+5IsPmL84cugwbBApC2JHxvbDKlFMIfPlFLVbta9tyWPvX3v/QyzeZJlbMi8r8MVn4Pcmi6moP4P5
+OpnSfd7Uv9gaH6GCGCDS6HMkUqG9aJpOJE0vRRwhnxiwJNF33uiE0gd7Th1+UaQqNSxbaTbT1xOI
+SfP5np63/kltgZrW5QWBZ56lfNjY7hXkISIN+IFQ+xoYGxiNhYcIrew4ctzwUOgQ8hC1+ZTl55Pa
+wyOtjy5ITl5wqGXksBZ2nTq9deOmhx/avHGr2Uy9bDbD79oaS3a8d+u97SWNbYfNk0/CL15682sq
+45s3Xmb7huqW2Nds7c49PSUPUehuH9Mt+54yXcSfYDvmjW73oe5aLtP1KKYmiH9w3OkzhlA4PWiq
+6SFjRpDYOfmnh6o0YdnK0/RA0UUfgvX09UHYsrxAfOZZSFgeYAqmYzO52dPPC3kr/59oZ1la9feE
+uw/Z7pD46mmy0XwJAOHOcQfOTH/EVkrxCXfLMsx3cs0hptm0O4U00g2FII6qIL5g+MhtJl1nkl0s
+TLXRdZrauBtVYeMhy+smEwYYYk/ONTN+lDiqgjOXSAVq2lqFQokLbospHEVK7DQTVDO99BAi1nul
+ox9z5v6IZw4+fHJDizqycWv7z45RKcXV9RHVG9s07FB7FfRuro4fHjSV6RwtawK1OWWV2Qp4W5IV
+L8UU5oXPLkoIy5uTnDCnWFE8XB0f5BJes65xsp9h/ZiiuABhTPGCR7qoj6OzY7wkVTvmukVks3mO
+D3HcgQjdCmHbrJq+pODuAqXItGc2VrFzXz4zlp4+9mwvjDoJO+BcanP6UurLjP6CMOqjjRs3+RU+
+8Obi4bc2F8BxM32ZPHvxmw/w4waO9sFX/v31V2yebnosPmNz2993EGyUgo/cNRA0LU7CDtx0z0D0
+m0ycnxi5paAq8GAUB5HlSLozeLDF6dRh2EC9bDYRFtPth2xt4Y5y3Fnd2zofEuA9TWHu4PrBaZHH
+ec86S2a0DUGYaUSnK09jmOaHGc20VCAYO6u+Z+/9DU1NECOcUOCDdlZZw4JWlqRuIi70hyJ6AYaE
+zYSnlfIgF0iMFKw99+W5tQWpQ4+1UK+8W9kKl7h4u0Du7syKyTcjigcyUgZKFV5xtSmZGQPFGLn4
+zQcK6WEauLCxwukbKE3YTP0+y93JXPaJ75dH58ZpOne35a4dNitgXP/0eL3Lyg++nby4W/rjg1aZ
+wXYfVu2nMOolaGFFxp3HbT2+/aDJZJ3LXzC8oLIn9L0scb95ud9EJpksFXbMMJ4f+OIzlDfKSb5h
+aoL4nKMAGpBlP7qM9qKTBEURaA9UxKVdqoz3UuQCuf44yfxCXCB3wYkIZqCrHhpIiSrqWbI2zUNW
+XpoXH+TjZ0r3d+L5ppiL5IVSReqeguKtfckWSUR+d8KylQHpvUXahswQLIDKo5lA2bHX3HZ0y5K+
+9hhHd78QVYCnzn9OnL+Tp7NTcJA316EowpTiWLDyhSsMkbI6D81PITUL1z7MjgG5jZFf8P9nndhm
+2VJ37yJB8/39lohJP8R7+6cmiBscCQhFvskZtBLoJBrW5c5knHTBuEp/jPTHrTQKR05pIpkhU8Oe
+vsQ4df0G1di2g+VZ48ZGx9KStMVzaoOXfXeszSLhROa0JyxbmT18oEomDrYSRzf3xMIl0Hm52fTi
+ukKx+P3yamPPzsrs1S992jJeSFOje0+HsvsLcyijl5AKjgLEAgDZZcbaMKG7PyG0tf4uPlVHEdjX
+sXWDCXSjuOUlnppwP09JmMTTbrVjONdDLBd7kJHxjWlSsfiz5mp3Wawk2GBIym9KmLzN0I1WX3ay
+7Lwgqa00PSmJOZNXAU+h2GuAyb5Oh5lH0S1vnYQdmqbVJYtHayvxc+Y7mypWVEWa5y0DrI9/gviC
+owBGeub7YyKhCy68v3qloY1bndUYn+469OZF5Btcfdxm22aftV+ufqE+KZUqd23NYJK17+oIX1Kq
+qEiP4jTNkkXH+lHHbHPTKrPgysDURBWfZyyuVxg7s+UMKULpJk79m6rAlxAZQEnr1zirsdLxBlHs
+LqGYmWUcxp/E4fJDaruHs/uO9On0zSPjo+1JBEzOwpwt0ZL4ME9j5/K1yzuNmur5iVVblfjqqKxo
+r8TuDfnFuzaMDXY3lAaTDmRkoMk9OMY/vL59zvwlq7cWlCwskKanMrQrA4C4RaTZyRDh38iQhvvK
+EJu2ZLeeIBGCztxUYNeIWrqfIn98umeY9QSFiIu88/RDEfr2M+7sr5LDxZaP6b7ljB6uyxpriZOH
+mYr8HdwFzuIwn6yiitDAoTOjKfAbv2APB9Sx+g+vflDnGJrelq5qD5+T5e/gJ48UiRM9ed5us4qr
+3/3wzTJHgXW9SmDunLGyWG5TzfH/SzUnv0Oaty+jmzfCe5VzVlab+hcP/F/qOSO5aZnRR1UQEiID
+KOi4KXbuoftxAkYnrZdBxopBx1VorxGHm4hrDBBuVnWUqqu3nW/t3d+l1DcvGR9tS8ZpDhFbfGkq
+qivnJs4OgE9z9dW9+viGVKkio1giFWNYbEdBFHRW1bbNy63e0hmb3rchK2/r2vGFcxpLgqa5Rl5e
+26zyD5Ckweez+nLl4viiaF1dcbZE1hg923O2as7AUKIDz8ZLpDORxloDtNzT3F/SWv3HzhYqNzhW
+6uZnbMvcvJuVuNrj8yt29iWJ4/IizGaTYsnajUnGxU36585aJe/c7rieg9352zcsiwdWW4uNt2ev
+h9w9lETkPaLKTsewF0nfm2xrN3nIunaLbfr5fUqGCy1LS+4qm+N+64TZDCfvKh3/h8lqH+qINKCh
+bxHbzTSu/fJxNzNaicfZtZ9y2E8z36IxnLELE4ca4m0MSU9UBmQ2m7Fwmv1OHaMcTedN/bK6h+Zh
+OWY7nmSBrL34y/+Dnq8Slt1PFvydmt9P+496qTjiP8heQ7MPWejW438J8G6zB9oZPYQ+eeTZ+f84
+2SRYOXpw193WD7Xbav3g5yZrB55enJT3VXfjC5c2zzCDqA32tiMt8ziMjiudtn84kwW0jms204fG
+J9NYeaHjuKPddP7fDAmfHRL+vYOI1909Lo/BeitoevxsouOuocH/YbJUMFDL89PDONloounpSVWQ
+JLK9QwAgbalbp5mEdc55sflt6dzjJDn5wOnXXqPz2B4eaaGpOIiouHXjpocepr7fesy8fbuZ6DQf
+bmss2f7erfd2lDS2UU9iv3e//MY38MzXb9Jyq3dqAvkUHJAn35rTnLDb9KZ9Wlj/jv37qX/uPwAd
+fz6/Ojt79YX5g/Tnqoyf4Fs//khpf2ot3v7e6NL3thUVb3tvdMn72wHAQQhVwRFy3EEk0IIU1mcG
+7+czw6xnQKJwKeM3g2gZd/fH9DAEhqU7T7xlqtzVnyQ1ltY3R8DPzh3835GKokN/PY7sDMtjhWRQ
+yqLHO7/+j2VJp6Ykzs8/tkTTiQWZ9j/ujNeKs+cVaytSFP4uZmrYsWD58wNzXtrW4ES9TkfOdKzf
+/nJv17ZmlcPkMbd/EdW+yoySLE2AVQ5xFjLyQno/KcRsPqj4nIWTlL2wWLKSOAzrMLP5/Axd/6lj
+8JbJNHkSrRdXpybgV/giwANBAOgYjzlj5fCm6Y4FRcGrwuDo2OhgobskJj5G4i6URvv4xgS7uwfH
+xMYEd/nGBAuF0hgt/REcg62PZR75+kXTn7RfCz6C1WDzaP+AG5qcOiGzUtYYl6YkjqUYl9L/YfN8
+/Hz89tB/AAlKqH8Qb3LcgTfwB3T0eTXas6MFBxOwnD4kypfw2ZlG39iDWh1KKCyH9j+wOW0+77z3
+wNpjT8PR4ak2y/fHjmHep0+fhr4mXVqazkR9S3/flZWbk/kgx/32wQPz1/GExza/UleNU/+AkfVE
+Y4PlE/gWFesYoVu4SBvhcOdRLNbyBp6ctmN7WjKG6NgLANnLuQz80S4v0r+Re1Ij0UhFdJ5qkial
+SijGVcw+xYzDQGhTBJ909teEPqStTZH+PgZVKeLQ/jjoWdKuE4xRm8bGHPiBnpOvjo3B35bC9wsW
+FoWOUS7kO6FxQTypxiA60QG7oEYJ0+asSem4s/Qp76ggNxN2Jj/fkhGS2Ri7tR1Auo2c+ZzLKKYP
+ClnFHqbENLjcdmbALlQXahXx7tzAaGrTGFXXtKYyFKOr5wUoxfDBMfjb2Oqq6gKquv3R9AwTrBNn
+LSiHH8HOLspfnRnuhj3c1XVn39j8Q+00D/dOreVkcN4AEgBI1lAOn75vrONPnwwTiolXxWm6IEla
+S9LkmOW7pZTL2BgJxmK6BvWu1BL+rPCqQn1BpMBEtFrO9PZALKOr687ewr3/2UuPw3KAceo4l4EI
++Xys44BcBHpo9RNLxGgHT52IGekFmCvR6FBDJHKNmC/yJ4Vkd1jzvoHDnQd74yM6Dg8fmru3Jfz3
+sh3vLqbWDb+3s6zz0Teas6kHdiwsJjWlDU9Ru7KMy5f0Fcdgp+a/MJ6WOPz8UMHq10YTctdcHNz0
+3f7K6kcn1i/ufXZFmSuE/Qf3dECYSL3csecg9QB0jM5p7Bk2MnqBlYfc6PG5mzJCMT5iR40xyqWd
+vGN5rrMTYjmdnbfJdlsZ3Jc4l5HnD/GZ9cwsruLO5Dm0tHBW8QMjfKivxyjeqOV7OAopHioeztG0
+ZkdUU6/jzpGJMtfJ0+10bc93d9OVWZ4Ly2qLp27RZ3a9AeC8xrkMBCDOVqON1iI6bQda3aTWLVMu
+s2UqFspFqBHkFYFEG0wVCKNytb8EayWCG4+doMqSewsjqOci06M8x+BNbKmTp9wX810C4R+6gdo4
+bCo4SeFjWRmbrxQJtTXpDvsPR2Q1q/EbUJldJTd3djYExEb5dMHvxendmQxPAIdnOZcBnzm/xrAD
+oYc6voRtsUgoprdtEiCzHHCnCYR/uvLTh8vlWV3jD1UcVCiwyw6+OgX1zVLLhZepN74YMvQ9fOGz
+ISxuKVTkrmyNX0Gt5TSbT99cf7bwkR3LW+IwcnLy3cyOdPFkMNa6+AMoeOiTzpcf39ga2w6pNE3L
++nLqfVYn9AaA8OJcBl7M2E/vMHORxo4YANOMUE/F5Kl8lsDrozAuA3NdsIDybSfGgg0FYY92dm5K
+Se26M9Zuk0dbOJeBMzpFjHqJ9sykPlBnPURNaGz3u/0xwiskqyOR+hy6qeuyYe7v2HLKcZnl8+h8
+fbj37DH42yjEODl9WcH4Z+kdaUGUZ6Y5SwLhe5NPwo526BkUn1dWGYJ3dnVRfxn6HmRskWoAuFLE
+GzH2vBEAPRIxjc46DzV8EolwJILcEOE5aj9VZhi13kedozgdlhLp9UdM9UgutbNkvE5JvUptGh0l
+ZOMQXkkdqddhE5JsvcSyTpajl0IchmdWK3wL17XHK6pGi8lZ0FLQjWXDzk7L86qqwRSmXZsA4Lai
+dmVZd6htLMsnkczgT7eOFwoR+2rowzlCuZAdDxuHYBtKuuM9RDF56iF1sc4Xq3BXliRulJeO11M3
+63eZ4yEFnamTYVkafzgCry8LD8Zyx2FOVnuS33JqA37CU1udTK2VlaZFhCSXRWCNb6UWhc+2HKhd
+WhQcUjpaDvthJSSgb2yhqq+rCy5TRHVSt72Sesstn5itMmM5I9OhbXedFu3TDdSocHtJINHgz2qr
+kyT11MspOmonPNy4uVExRrmMYbTOQB1DEoZz2VNTHB9h3qdXWF4Iq9ncbnm9vR1riq3Q+0++SMuC
+DoCBRVPPOcznvE7nYafHF4uC4Xaziz47Drl05p4g5LELh0FRBJpheqj0J/wgsy8vYjzZ5D790DND
+zaYnxzK9lfkdY/kLspaaS+IDsH92Xz4TnpOeFmyoLymMeqP25N4VjVpV/fjDzzQ+2fT0w0vrYsKz
+W3T+yriMmGCjwheq8EN7IP5Cx/xXLWvmpu/fv30gTdMwvu+pRmop9v7uNSJtVXKUPtjFYN76xPnm
+D2tfOLrVnGAwbzvxctuhsicfXR0ZaggVwgBNmhSK2XnJOc+5zJxeZjxHtlNjCZDLzFAk0CR2TCTR
+SDnnx8ObHpxzee4j7Yrv1dlRolF4YwnupS7UwuJRl5CM2MvqxCB6YpHek14FA/my4Oz+fPyGJKlS
+WQ27OktCtYHOXZO82Nwod4+Ygjj8xw5obY+KcxnZwio3Ka0Iym3MSQsLPcSZZZxuG9JnCeNSjKrq
+W/GSMl/lhY3CG0thacpf0CUwNgx6jsHfly6lZrdjtXB0BRRBkaYmdU9n55b0TOrxiAyVN66CXZ3w
+NofVH7j/Y2nBzmYrPXDboUM9HS/ZbVquCOmIEpwVQnG4B/VV9uDW7C/LN3fGYcvg70vdQpOizlfV
+wHF4cxzCnhRTthxbRm3Ed0sSIn0sMQ0baiINXZtKsZLuLsuJ+K6C6E1LiOudnVR2YP5oHbVnjpX/
++ziXgWTm+LjZtyeR+U32rTCYtlV+m7u5N5XRY2SJkf+KTJDysHH4B/6xZV7JSElYZPloEcnr7Lx9
+3Vgd6+MWlqrEXuoCVplKXONcBk6MPGMmWSKmgdMqnVzDTDskPtAUDECq3V913dQJmKvKV3lTf43y
+ZOlqy4mxMezV4ce7oyK7nhihTsH8rmGjG6TFLnmiutrygrM4IebO028EZieEmvG/YKdPXFkc9Uly
+e4aUetHQOC/ubBtg5iD3Nucy8AMlaFQIegoStikIBRo3+rzq/zUD/aBIKCY3+2gLO0dzGguWmwo1
+Pr6xxaZlOY1zT843fJ6X66EsMUSN7DrVsrb1xQOrmtQxtcseOdOxvfW5fctqVU7u3rzylbXRSdRc
+/GD1ib3Lm7XaphV7Tla31zx9cF1H/KK3IWfT5OcV6T66mJDZ5avrlcn9u59+uflM67kjG9vi4to3
+Hjnbeiair7vExSuhoCWhDtK78jSvuXIug9nACyhZKY1Gk88odWgDXofGG8k1kRs6fiEIhUrAx7pz
+2hK9vRPbcr7APvZP7sj+B7yS3ZHs/1va8ouL/zFOOQ/DL29TU5NgCjv8F+RymuJGXt+4+vygGv/D
+cmvbG4vUk06qwXOLtn2+PZvqbm+HcDf0gwqYAFOoi9TraL1OA4A7wbmMOEFHC1ypG2oGjpYKnMss
+FfRERKzIZRY2WtEgfxtprqI+xpZRTssglZtgKoiCS+D1JR7R+bHQEy6DfyzH4Pm4tvzIZZQrFg3f
+Mw/ByfH2doh1QUl6ixHyYFcX/DIkL16Ch3R1Qst/wrMbtdR1aLLOBzPnMjqVwrgN0EFwfPryiFgp
+QHMSXzjqFhTpRX3nFy0WwKUUf1RWsakTer36JD5GzW4j9vavzfOCXnlr595pbWsjWkZfWpZC3Zm8
+s6/NXlcNQHJIPHPOqexyMeCLxzCqu3VTXQSac4KQlBjYS9sOY7TUaZSWr+/Aoru6LN/kdKUE4Mqu
+LpusIXs4l4FyxrxGOrtOxXfXQ6WGqZWPlEpUq5QvkYvJOWNpvevTf0/bOJAdnVUugy+cOYpRjc3r
+qsOWwBtwKeGliYYPLofwf5ym2+/EzSlVRxX2GMdlBVkGd2rw8ttYaFDJqnZ4tLOTUgbnGOQQ29dp
+J2eY/jL6FLO22ok61N+F41AYXRhPPQNHKcEIXAJ/G4PwWM6yJt1yyqEdq1dXGoMmX6DXUForSTJv
+yrdcbWd096l3OK9xXqc9t1K5CxkOWZ1UoA1GEzYAqW4izT2znGB0RfrYqoQs83dSpJTUtCiMO6i/
+Xp3bfh7yj9doli5b1BAL4TKqR+4dlVxQGVmTv7y7SOPjrc7vWlbQEN7SXJ0WuhB+Ozb2+y3oodVp
+QrznvgZd9nx3AHp+OCRNqTEvSqyCHR1wjn9BUb4+OL519cNHSvdmPrx7dWeCRJuRnih8u7MTWt5s
+s8lKbhzSsfT36FhujI6FT6+mdI5FeiBFEjcbIbGxvDnpgb5xFfpuRa7SF8OyvWRK77ehzvRw65MN
++wdSJm9A8Rj8A1sJYXJkQ37MGK1OucrT1VRZWk2syF1dm4XVj0tjApwtjzYsKwyKKBstgRv3PYxM
+mOcDkpqMlme7mbZiZBbSlTW2lQ0J9rv0pmnRTqKzaUL67DJRzguOD6UC4ZcKg9j5vGtQXCj1rzGK
+NzKCD6aMtSfGtYxnWe7AZeXz0gOwRdRWcsJbEehmedc9WC22qETKMF/Lz62t5O2uiNQiKXUlriox
+AE5Ik+u1azuZeYaReziX0X08dxdiehaohBybVUsksBo+4kU0ucV8lZI+1Ezu0VQPrd6SNtp35XB7
+bO18Q52mdUNNXkxzdUFcYFLv1hLLu9gY8f4KbCW1BlLef2yB8JUB+GjGgrr0SFFMzVhBa2pfUUR7
+8tHwrM6UAB9FUlaRfFfu8tY47NmODupaFxyxPKsfPDnffi2WMv6FaS8HV4JPSwQpX0y7FkRINPhq
+i7SWvLExeAMOY5jPKLwxPj45AH9et3sZNbttW4Qh2NVEtHZ1WZ7Hcui/XZAqWDB4HGu18lgR5zKY
+BehtPjXKCYksfI71yLEbc8oDTRs3tVYl5YunlSVGPCN7o+HWyy9nNMZ7/fbdp9fGaLn8S2Z1jGCU
+ch3D3r4Kg6jttctLZNgYvLnMTdWYh11sfWwwhYj7huK5K3J1D+60PNbejtVKi8ZqqZuQXi8anziP
+w//yQ9NV27q6NogC3Byk+QvoBRrZRNsY/W2GPinmT1vn9nqkmLttWXzn5tJLbY/OS6TOUuvHxoiI
+ZT6xJbqV8jSl3zL4B37CMlDYkxYQkNpbSCZZCrpoSt3hpDUn+gXoyzRYe9dd8hrqmLGx9y8Ip71Q
+w8vcw1OiqO3LqNljdI9h55JHm6VjlDMeSvyU3ZrocyenvQ2St7u6vjDOe6Tl4BzW74BxajmX0d1n
+tleEhtFBhYyUYrfuo3CGfWnPspjZdCKFnNqOo5/NfzJ/59rBcuUieKIgSF9Y3xbT98GbifOPdLVv
+a1boO1Zlw5Efqe/9EuqTk9YtX1Clhv9oeXw0KyihtLUnFhbD5mbiL2VHQ2li8Nff9ZSPNuUGS+Jz
+O/K84rvyI//YPBmYpNe4C4OjE2uWVLD0mMu5zERbZVYSqw47Y/UaWQqpo/MfM8VgY/DGyMuwagz+
+sXw55dSO1ftmDtZAGW3xfj9j3eKkcS4jLzajl9hojLMiDpV7dZRyHYWWjaOjtD8P+2UpvAmXj1m2
+d3R0QDyrCzJCivnbDXBks0Zy3gDOwB+kWz1brPpp5+DSanCVG0N/GaKzHsW+DKTXCo5ciouFclo8
+8DkJ3rFViZPQU5Gr9Z0UhGTHBXnG5KrKm9vh71jSvEOt5d99rFtwZsV/hrFQOAvyf/qG+hPD/rkS
+W06tIZ+VJ0V6UmWeEYZgS5evtiD6oaiq1NB1g7CeejRvvFH7xDNDJWNVUbiqa92GgeElGEZ904NR
+Z7rhCZuPCv+O2IrsCPurLmiv1naQhpMAVUJ3fw7+XeH44zWlu+enCRXZ6hT/KH9edEG7JqM+VuSv
+NCYblf57gg15hXmGYHh4ziNdKlX7jlZDd2WK8JSDtzxWntKWGqSsHs7J6MiP1+gy6pKSjWn1aWp1
+QsWQVacP5hwAkSB5RmsIXSKcbgQhdOf641y7E/Z0I4ngxIUn+xc9t8jgGpLa9UAzh/QO18Xpwr3f
+DojURgZwjCt6Mv7trGqrrGtVODgqu5vqmsNJLHT4na1FNbvf6EsYntdeoHLwVQoViaE+Xn5Raar+
+fmlMWERSfXqkenbiyKvbWvf16fW9e5orHx5MyxjcDTBQRKmJTqIcxNH3SKT21KN3RWW2nVyu/dYv
+hyu8T+s7Kzafbp5/6YHi8LqNLbUL0r1L6uJywwWYoxvPLFJIRVENW1oL13Xod2+uOjxW8Kk0y5Re
+uSgvKCh3qDy/JyMwdODMeGbm6InO1o199UpFRWFOcHaJa0C02EUkdJ+1zjk0NlWubswKD8tui6/Y
+HCd3zhg8NFazwxwf3rRnoHJ5dYSkZC2rK1AJnAQiCRQDOiS3BEkJ5kiLPBHXRZHT1x6h/TFcdsd/
+evOadNPc3cdfN8sKF5epSsRSgy4uaImPLqlQFdPRWGYMNgw+0ReXb4jxy3iovu3gPGPi3Eea24+N
+pDsIZX7eUZqkyLQqpVts9+5m4+hAS1Zoi0tEYUpmnUbooa3NSCmMcKES5BWFSXwnJ6cAXcRyn1A/
+AemfXNA0eqCj/vGluW4SlTglK235hcV9Tw+n5C49UhdbX5wT7ObpQqpatja0H13bG+EXozWW9eEH
+azMHy6KCa3bPa15ZHByQO8bGrVLhd4hk+uQPac+VzHkG5maf3SUq++HG7xgGTwz0PLM0I0CZlJbk
+7cEL8nNbuCq1Xu8tMVZqoksSJEpjfVhqlGfq3K2FXYcGDPyuQ/MSCoZ3ZRkaMmIC+Xja7MjkcvWD
+WyQZnWm4zlgQ4SpSZKsUKeLjLsnFVcHNq8tlMY3r0Vltmh+5RDKomNHOmXNZxzr7rXc0bG0mZ1z7
+8seFBDdv9EB59wtrCxTFvUkiH5nnLHF6d3ZcscYbShKKIssb5Dl6iVdslTG2MSNErUgNUIX4u89K
+6N1dW/zIaGF0UbtGbS7Xesw51BubYNpckt+dJndKEUYbcqNzm2M9QrI7k1bmdRp9Oz21VcbK3kQR
+P7IwwTN69pO4X4RGEy3IH62OkRcvKa9cUKTgCePL5xei/UMAiBWcx4AaZIEGACB7j1it1c3YnuRw
+0a62KBFXCYNQf+Bd7Gj3m0DsuQJLX/LkHPPxoeSisqzhWlVc45Cxcrw8LDXBOUXurfaYHVvcoVen
+q2RCY1t6sOUoLyRVFZwY6e0ekhgWlRrhAUfFqe1pxpo4bydRsCY9oswUL8Skm99fnZSy/OXR/R8l
+69tW5XRtrQ1L7F6fn7PRwHUkyPyxWtWT7opCQ0DunB3YemNbhtTP2J6d2xQrdFU1W7ILH1yQnTl8
+tElaUpwdK9Z1brPq85xBIgnoQC4AbuyOuu2Q0d3Da3ffRnoXDXrzx59oaHsu1S8nL9On9dCClKJl
+B0t00erKxCAffW3S7Oj4BG+Bn7tT2uhTc0wnRtKwK9LU+lhjQ2KAl74xM74hRYJJN7y9Iqm4OCwh
+xC155dvrVr2zLnMsY97O4sH9zWGEMCpMnJxVqlz+zvqsvE1vLxl8pCUsrGXfvPn72yIjWx9h1x9i
+NpGMdDB3jCvRJOIJ1qHxY056SWS2gSVmL1o0ItaFiISxrQVp1Rqhi1grX9O3MHnh412mE8OpWNzT
+1BdtFY7ijMH6NSMHGqV+NYfX9tUvzg+ZVdEJfZ+a//L6/Nw1dL4hJaXCrxPJINc2V4i75wqryGuU
+iQS75S9Hp/zQBGFm9/XCVScbY3pMTdnhcVXdMV4ewd6ufsbmtIo2lbNXqNaP+p+8uqooIXiWOFkr
+0wdnuoeHimc9fUZXP5zaYxyqiyXHTpoVwtCkip6U4p50iUOmMCouK6qoVesW2bC5NUbdXaaeL5Rr
+krMLdBKeMPAFjiOJ7VlrqMlKDMiZvzGVph+V4OBN5IFVjE0ikduNvB90p4+/aej7HTNAYnsqC90B
+6Y8LJeooQh6FS1zweyQ9zvylfzh4h6VXNdRJYUi60tdHlRMVkxPj5el9x9tLEJETp82LEvr7UlPO
+7i4ckbosIa0obDZcAvurT22sXBad31Bf4lUzL0/rr801JoSaQnLyS6LTh03lhsCcRQ+XhFUXJrg6
+6gsr88py4xwFhoK6mKId89OxreUPDFYbAno1pkfMTYOJ7u7q6nRlZGZEWFh4doQmvsYQEGCoiddF
+ZIaHhXnIZSEiVXxFrI+xZVHcp9SpLu/sjhWl8tQ4RbCXg28gj8D4ErV0jU+IjysU5LYNLlqo3Pzi
+nDBPTUl8bEWcn19cRWxcicYrpPWwTR85CGJA5kztCJHFzUa/mRJfHUXMVExyhvdXLj63LLVg2ZGa
+kJpCvSP0S+rI1tUkS3J3u/kJnVLMq9NWXlwU96EgKCYhKzS5Ns47IKktPa9FJ4SXl580RRRufmPR
+vItr87xlYTz4XkhSuIgnNSpCYxe7qbQ6r9yBfFlo2dLKySdaNzTF+TtGN21pHjrQHBZas4k+4wsA
+kcJ5EWhBtl0frJFLkONOhGQo02icGWaunfqCOpFSsPr5zvbDC1NCixcVxpemxPkHJcTp/D2F2pT8
+8ACNMioy0jMoJsAV+hRVFPnWHxnJ/m6oP95UpBCntKbom1KlmGL1B5ty4ucdG8DnNq2tDPXy83L1
+kQr9ZKXpke7+wf7uQmVxAhUgTwjzSFxy/prp8nORbfvnjT5Y4hNWvAj5Y3ScCCIZFDB8Tm9W0LfR
+NIlshCSOjbNhArQ23kPICJDpw6BaunecCM8IY0GNUpuhVQXnSdPSskK6ehzkJdm98/x9qUlf4cLe
+9OIwJxikSfZPy1xSpzH0PdLa/2BIPlW7wxyPqWPmzGkvM8x2cBOrQmtj8xTCRtGtP7LHmnTrlqeF
+ZYWGhYVmhaUtXxfXtqZIlhKcOtSY7FQXbN78bG/fubUFS8YsRRtfstpphJhzEOTZjcy9uredPLeq
+4UKGAoTYMPhE7/znR1NCcjoSnYVezoSXvjWnuCFqtjitO3vOhZhkOU9uLCorNsoWR+U2tzXnRgXF
+5hQUBhVv6UmGn3WdXJKR2LkiM8lUnijMnh2h1nlmDpYpYqqW5PY90qboDEooU2rSowL8g3TFCSKv
+4Ah/D5+QlNYsQ01KlB+fcIrrZmKs9lIqQkIYQcm0Fnx3N9xoHpPJrUuRTM63uycaxNXQx1ASCY2K
+kGSOnuhoOrE8X5o7v8DdT+7hKIxtzstoNfpCWUN7t1Ka7h/SUqH3MpqKlbWhPvGxGlF4bMBsf31l
+rDZRszwzbnRRT2lMS8jqC0Ox2oalWzvTQ2Yleyv1mVGKXLVveNG8bPyIwMOFxDBHH11jvqoxO9xT
+CHFJZlywnyLeL9gY7ev1jTvfyc0nSO6B+G6KE8rZBlrZc7wedoovV6L5v6QtExGL5T5Cl4jrVC70
+VRdC6AtF/+YnlLTH9u0OC9nR1fDIYAodLKMxsVQpdAwpzQnOSwzx9vqfp7tUnxU0MiBLq9Waila1
+aHlR5WteW2M+OJjrnki6hRhb1tWXPry6vzAC4sKU3qKI+UuXzk9ffn6o8uk9SyTj4c0P9dXsmZfi
+m9JTnBWaJw8Lk+eFZpX0pPi8+e6c/Sb1eMCSfc9UZJ568fSBEbG3XBrqK0jMzNJLY8r6hpfpObNI
+dI4ZAKKHcxB5daalB7q26wcl7LQS8lk1mL1DrxOhzhNET96yx+ubDsxPdvCQGYLduGkiZYFueMOc
+hflt8SKBn0wu8xM4BxtVEUp1VEZxRhR8o/vowqTc8ROt+OKi+mhnkVQhWvmkLFXlt++hyVf+9aFn
+zrp56Q3GkABfeXxEQLJB7bY5JChUp4ovWwxwUEOpyYPkPFAH+ul7ttAdJfWLgsyskrFWDKlTcWdO
+MPldYR7QyWXrQu9P2muOokTcjdnAYQrQkgdLD/7y8CO3T9TICxcWe3vEVqVEzI7ySMjMy0wUhWER
++mDX0I4nlg3tq5d5KIviBAKpsTxaXazzIwPTEhVJcn5/d/8ivtyoKNkx2p4loxwcfNQRoRFCgnAP
+CY6K9Xd04ngqQhVaHw5HFC4zFkLn/dTNl+csfB3CdU0nHt1bgEtitG6bvP2cccLRudm4bvu+kqf+
+2ptZ+tjNg2W7Vs5XeRoC5MnR3j7K9DCxOjY12j0yWu1XPWdOtY9Wq/b2rB5cs3kz3iwM9nbh+YV4
+CiSyMC9fVZiESvHWxcX6eGvUGh/3IE+XCDoGG6Ui3Yhk0M7Mc8avxJOy85zZZebfpbtpEnENY0ES
+iL46FRfxCmGns5Juup4DJnNka0tNWijUevmE+LgExhUrm5RZSn8eSR9vi9b5cXEHF6f3nJy5GIRR
+sYq4IEm4Nji8xCjHnvaVyf3CwhRKnX9Ss4gXEZcVmT6/LNqv45kV+b7qvIa5SXBWbIJQl1WfoGnM
+CpPEZRfk+uMRisRgF5lSMesBviImihcRxo9SKgQb0oq0/SUaL//krpyVLxnjY0YrtZ6VpcpkOU9a
+sambtZFVHBGRDJrB4F3aowu01x3/XhG3063sTK67TRQOlrvqbF/PkeFSpYefsSlN5CAtWFSmb80M
+4YckxyQYdPkKIT8iP0Eaq1IFOPt6umYvfKi49eBAYuLgka6gosIcH//s3HSvpkcGkmJbVxVkL63X
+WDD36JLEzGqlmyCmKiO1XMHX1O00670jEzPyZWEJkUEu0e0NeVp/cWJ17AJlvsq7TF6zbU6mKUPi
+IAzycg3X5yrWPNEkyRx6pLrhscWZrsH6yDC9lG/s31PfvbVBM9tVmmLCGuqX5AaGliwuKltaFhZS
+MmZdI4I4j4EUG8Wsax20TUW+/USz06lEfK5cqiKC4voOmnrOriuIou1Rfbg8qzO5oFEt0NUNJgTH
+hwr5/iGixoAMvVxbN5SsryvMlP+QC8eGBLUHFmWkzNtXn95THOOYEbqqOdecHhhe1JuGR0pTVf6u
+shS1f7Q8wPmku67AlJHYVxrtHGxUYlsfWreC9ZFRiZw+Ihk0MTKQ1o81d+vTMGGGmPAnWYXa6juB
+djdSmEHn9IVUb2iTGbLlzgJFaVJiQ7LYw4ua8hR6xtakJpWrhGH5vWmpD6xbUq97mBBGli6rF8jc
+wypy41y2KPYuyt+3rDhl4aGWvmMyflZTf2zDQz0GbF/Z5pE50U2hmWVzCouHiuQiTXmCf0ieLCxM
+lhfin1CuEYWVjpTkrBrqCA1NyyyZs7k5Kj8lPj4hiEt6RxjEKw1xmHRg18WBwUOdisG5mnJDYKx5
+D0ODIkpFOBJJtA9EyhwjuUdV4U8HxtKp7OEqwvHX/zSdWFEQnGnO4gs8ZuMibaUxo93oHzxeVbN/
+KCMwvSd/mSozUiiKzlVrmnMi/hnw+18RBXOSsrvSQxzyZsvViRJxijZIntmWgHHKS5J6d5Tn9lQV
+RESUVdQq0xdWqfxji5TwPMCAOwCEmvMoyAWNthWLmGYk27BJNPdlNptMwu/2XqnTljzdl7e0q1Dr
+kz16rCWiOi9utq8iITA4xI3Y7RygkYtUYb4OriKevzozwi08ozqqZY2nkzq9UJ7WVxD+YVh2uz6z
+Vu0uim/JSW5KCoAf0K6pyELzkrVp3S+syg9NyBFjDcFRXo4+miLt0JC2SOPj7B3qG2WQunPy3WKT
+Uv3TG+I8F3TTF9YDy7fPt3DqNjbGaJvXljY9Ms/oXf04M04bpv4kUjiPoROX02wq5NsEDXG394e+
+Iq61SSGs25k3i+DJjFGyWKmbvza1NrVq34KM0IyatvZIaahfQXq0p6bcEGcujbn6zgu07REUmyUj
+/ukkjVR5ByVE+vinmguo1vDUKFHqyJPmyj0rTTkhXbqBEwtL1rfHSZOq1ZZc0v2tN6RJNeqYomQF
+j5ENJE4kgWpmXZHYNpddcFYzxHSq6Qtfd2vFhEbF6MT0Yk3izXsutSx7/4ECkaYyOSItJVPh5OcW
+VFGW6d6iTfDjhpcM5RcuKAyRplSrVnwYqpcJ5HFpcfKBQHVKeoo60D9vWWtwcWGWLpDsOTGU1Hby
+p1UHKw8/vF4/21umCXkZYg5CeYAp0Lz8gTTqxa5nVuRGls7LKNnYpTe7xxQbIrMS4xLSw8PjQwMC
+JOpsTUR5hsZ5trc8trjf6t+Wcw6CQHR6GvUU4zOh4ljW1NyX9eTpIydNN3711xY0zU3UNJckCwMi
+VG7GkZaEf0QU9iTHFalEXvrmbH1DigT+Mv+FpWnQG/JTxhe054YFJ5ZGYc5FtZFOFcqG1eWTpzuO
+jWQk9u2q6tnXqRSXbmTiQlJhRDPnUVAHRuwlHJNGF00Uxsz1x+2VXxeSK0mEGgOUR+HyKHLm/Jkp
+DHGhL+Qmcolmt2C1OMjRmYtnGvMKHiA8lDXxQS44xpnl4hRzZKxwk9k4yys80Lepf15jIt7IdcJ4
+AdIofwd3oZA3S5yzsLzM7MYLi89TZbUavKlvPaLSowymoiiMM8vR3ZfvAKWlBclBEh8++V5ocmyM
+d4BvZHiUnyEsK27tWnFMoKuPrjQ2UhU+ODwvdN5yvWlrpX92lcmo2/bI/jWtbnEDKgdXh84jj50e
+8mtfunnL2ri+Qz3aulplRri7t7p4coehLSskJKNB4yGLMoS1bOhrVPslBqlM3W01jR1zEB1rKDWR
+zDkI+sEKAKCU2av4W7uO0RiiuXdpBGpWJ2X9MCp/ElFbKiKFpFQz7WKnGcOT+jg8KTcpvGTl4xUt
+J5fnq8t740QBMnducHKtLr9RI0B7H4STm0ueLDcnWF2REBRkrI3zTc0pCHF2deZH5hswd3Gkz+Ne
+aRlG9/SQssJMTYCvPNQZavyokzCD4gm0er2Hb6ArQbj4Ko0JWvjOn3uzOorSC1szFh3pikpb/Hi7
+sT070iHBw1DUatDXJAYqcpuiMwaaSnKz5fyYtBp9TWRGhDq/WhrRkB8jN+QEJfS01ESEV9a1xpLx
+DUtzykT6igXFUZnJyQXNyZHmjgrPc+sbFNu3JXiEBQnF0bGeHrExYk+5CgAM2SILOY8CJciYYY3I
+bCKdkWP8RKiz0psGSPl3eemJhSUbzna37Z9rCIzw9OWR+W0DqiKdX1h3UlhmgzosszzK1bFg72ih
+xX9BX9kco6dfXLlO21EQjcXv+deahJKNF+fgP4XGuPpH+s/t6Wv1LdzQT9Wnl84qW1CsclvmFhNr
+FCcte0VA/WPtTt/4mkToYlxYFytJrgeM35FMJ5LBXpusI+ih1im11oCRjJpsJ+mmd1/waXc9nFYh
+Sc3fbD4gLwFps3J0/jiZLkksjZEXFReXlITqq1OVbh6kI+mTkmqM8dd2bKmJqc0IywpQJvhrcqKE
+giClmOvj58NNc83tXV8YkpiYFuURGewRraoyGEJTKyJqN/RWyMLra0oSgt0l0b4uYj93L2+Ti4cr
+l47zoqxKkUXktPTMVXMliniD3idYFeDC8wvzyfGKkYuej61LkjiJEzp2dAYbEtIjMejsE55QpMyZ
+XxzmrcqNEfpIRU68kHRtZH5sIOEocHZLWdjTHrPieKt8tpfMS18S1h+9MlddlxGatOhkr4ckOCy2
+QOMlFjpyeZ4CN6WbcXaYItwlLCXSkx4CfVmiIjIm0Le4piYr0slXEewjFs7mLHMNic1lfdBSzkFg
+stcYtHe7YYJcoUbkMT0oM37Ko3B6FcKmo37i7AchzR45WB27sK81N7yjN8iVPwuPKOpLTa9WuQfG
+6LWi0dFZrl4JWmmgMt5HxJ8/39HRMylOPkvRXFE+Wha60kUSH9F/aG6GzDk6r0GhbM5XyNJbE+I7
+8yLhAfPeTiUv2FC5IL9rU2iMq1SdHZPRlR4kTuvOrJqb4jVL/ZgM91BkqdQ50R5c5WMK3CnQoAyP
+lwowzCk4I1Eo8XJ28QvVpMiCYyODZs+WajIjo/Liw5x5qRVN1vWqnXMIuCDKWKeZKhEnZ/SQPVbM
+OBUD6XMUXImbPf9yNaJ7v8p1/iTWkloYrRfPdi7rTfbGcKk+O9gvURkoViX5+xvV4l//6H1hRY68
+dLwW+kS27p/ngIXl9aUXtMQK+f6hnoX5ssRIL7/ohICCkrB0pY9nZKI0yDMlM10bRB4szJIVDJWY
+feTa0t7wkkwtzzUyNiU4IClexecrjAXROx4Km/fWAWz2ucldRuqT3KaMuCCK2HmiUhSoMvqriuMD
+Qp6P91UYAgNStBLd80pJbIakaVVLljjANUyXWcXe7+2dyiCCiWQQjU4B0P1GwYi0OiF9ypr2pGsQ
+g+im56T9xi86V0IEB2YNlEBfY7LRz8XbSTFnaDx9lm7u/KFEYaB/Rla6b8XigmB6l6H68eWF34ti
+a9Po7ZeI1OJg3/Cy5BCspmbPqoFoB3quk+elsWmB3sb4cM6ZWa7uQlI9tPbhyv7nRtMyx56d07+h
+0E9cur576/m+8PC+81abTEo8Se8X0k3RSFxI61Jkb5fpVEiQQL9pJ6X2fnuKWFbaeHtCuJs+oyAn
+1atoS0/yLIE370Np4Gx5tiF3pKtcttw3OiWnWH748azRE+2tJ8dz9zzg5Sbx4Yli6zOia9LDoVds
+TVIjvJMzuDlDKA/yc3cS6prypHGRUkGSLD3MTRHi66+viu8/uqTKGOGmfeeB1pZH+gxZC3YVbTjk
+bXQ25JUEJ/cURoh0dekfJZvzw7oAsLc9a6dl7j3rsZtWJudqRNNxIK3xzpjBQuEf6U3RIOPCY+aS
+NXOKtT7yvP4sP3rHJyitJTmvOsoFQlP/kjF9XqRAVWruM5WqEtuXjg+3Zzjvd5LEFceFVaRHJHYs
+X7O8I3H5mvju4mj/oReWGOXJFXV1IfkDRUqXTFd5QnVKvjk9QF2zKB3OWrGO0J6JhL7R6WF5TQlS
+cUhad2lBX2agb9rc8iB9QZSbZ1RqeGaF2tcr0NBSOH+9m0BXMlDA5CWn5+6rnIOABySgGICZwU3d
++Pabn1KhXCJU6YRyCXdaW4HBOjrGW7BdOFC+Ck+KXfxYe4QkvSMlrEAvwYKTa7X62sTAyLxWkzkK
+biieM6e0dA5UtD3cM3B2ZVbxcehyzsnpJerGEwVpS06ZFx41xczBBgKTW9OSq7WetBzGdXCpOMLb
+yVNXk5JcEx/giqd+H/tn3u9pVNHqllhN46ri+jW1MZzvw6o3tBSvbFQr6lZWUDdZH8zjZBPwAG3M
+WWuJ9Vwj3UNor1GpEnF6qJHkQh1hfNESWm7pGHrY79mQL/MDo+JTZdLClDBFbr1CXRLrP9nXenhh
+Svriw82C2JSsID4URJWk8Dz9+aSfp96YEthyeCgNM8470m1cruK/+C4s840M4EFPuUqjFipL9eKA
++FINJmtaU6vxcXCUF2dShfH5kW4BdYeoptixD3Zvf39cP8vdT9C1skjuGDY7QBbueZjnJXDUL31r
+876r47F+vi8eOdyuyG9R47mKtEhvFyKg9tEVqx6rC2D5W03sIMrpUyh23ihrbAnWwLUP68qZ4f3U
++ePEjqbd5+oTRhd0F0Qlda/csLI7SZ5coejtcJjt6rA8RKNpfaA2c1WXUVczf2h+jU6SPa/IMKc4
+miMI8JIaOzLlqt6HWyP94oq7R7MbNveXZydnFGsyKiJdixsdfPy9SFNgdWpMV0WsvHikPHNuXUFO
+fk1PeulYZaQsqzMpsTQ1MSQ5sKgB3aEZp1REPJEM9AC4qeztPbmKWXDQ5oG7h33rWbBm/J8ROc0a
+Q344PyCxPmFZ7YqykIyh/TWVKyTDG42VWhFHEOTHrR/NCyxYfqLp2i9EBFWorEkLEeeP1JYNlBrF
+4pKOkdzyTR2xOWWWXxevcI/K0gSl6mScGjddbktCUk9hpOU31v9HrCRygRZkWfffrTaR7UCIWjbd
+MpoXadV9WqlDS2lvxeYXWua99kBxUMUDvfVjhZK+WlVlUrBncJhnUnxIQs+DtYXLG9XQr+qxZQXf
+SNK70iuHC4J3PpFvTvXHWuc9N5qSuujxztJNIz3K8PKSfElxyWz/GFmYzrObDNAUqDXVycFhWY2a
+k3PlzrmLj7bUPNCuie48MPfJ5xVNO1i5ODVASIhkYKBXL3p3G2pmmm0iZNoxLiyZRq1TTS9khGTV
+AEfg7BzTUh7Xlh0eWrQgPyTLEOH8IukWEBkgDOF7J8VFcCL3Y6ldmdKM0Se7G4+N52MXe58wVkW2
+G7VzKrX+af3FpjUNWWGilKq5mbCgKd53FtdBok3yTVa3KyPq5oyXNO3o1MZ1bqLlWQ0AeDD+LXAH
+IfStXt0MfqZHH8ruAUzHHJUEyTpJgu8n9xDK/QU8P7koUAf/7cD3lYsQwFcuCoylgAPfTx4h9+Oj
+37o0J+EsUViAmyAgVCQMDXCTwnMeQo/QQIHA3wpY5R4VGjD9m7YBsbPYYs6rYBZzL0USJHRHayhP
+HOgFbbuu7I1rqwdGRe82wBtrVq5U6rzJtdSv1I2QHHPKrCCZ1FVgiJQk+MmVYjeud7TUwzlFu2k5
+/s8rVz4IjM2LoL6DPphjTKlePGv2LDd+in+Qg5ODUBLpOQXc6LjA2NRtAFA+UVcgoKMropO4cjEX
+fUJcjGNL4epFlOiJUqqo9BFq7kK4+gFYcwLFzDC/9ZbZjJ+zLIKHkb4zdRUA8jzK5SkBADIh0DQq
+2yfKTcqWLNGIcDGffMpEeUJ3k4m6Bm+aJlOPUMvqD1JdC+HqxX/dgvmEnE7jfnEVtW+AWnyY+sr0
+Nl1hsdls+RbzBbg1pxyYBWKBkY3UzLgl5EzMCvpKKxP6QKdir7DTfwEuZtBoBwVNWeaSO1H+37Wr
+1syft7Gn9K0lr3386ycU9cDY/BW8xqbnKja+rMt5afAGRRG454FHFj0csC38l5cWv285xNmyZuk2
+V+xf3yxY3De+KaO26vnDjz2fPbSqtfXj0NDKmqpqGAa9HT7+bOmSJ0/VWq6cOX/hRbT+ZgNAdBJy
+4AkCUS74QDq1IVcllDCtRmSj/9EtU8vQcWn0ie2EdUmzXd5/te2fE5bbR44cOQIbHzfNm9/9+OOD
+S0cXPE7Iqb9q9U6zTOlb9+00wQMmmGWiaqvX1Eye6H++F1hzfuG3iTT6fhpkqYN8s4AHaA8NHwVE
+4sCE3TuWbxSa299o/5H6Hnr/2P5Gu1m4cfmO3Zj7Dz+MbaCuRytgDJyCMYpo6vqGsR+Qzkv3q5qQ
+Ay5wp0/V0sEhwiFkuCAQCN2BGB4defmpU6+MUAeOHIFHoBusoB6nfjF9Afd8OplKx92gvv/rDvWD
+XVk84GuNTGRXjpwtnL5IkX3kiH1hWUwNX39tggvMcIAtEVVBbWJzbAKA3yLkKCfE/TiVvnp57S7u
+PHIPQ+KlJkTPz/FniTR0/4XmOi6H6yFC4xgkpzN7edB2IBPfAHklma9yGYcr0eq0cOqbK7l5q1fn
+5V55rayxsat1VmLi4Jz4uIzMdTLZusyMuLieeSPuOz1qq4m0hzZ2ZmZ6emZldmx8yE0g/bg+Mkqt
+ajSpNW1qTXeTSglh3ciy5kaAgRwqB2siIsEsehygO4pxpNYqPXDbcWk4kaqISk1pSP3PSczj1Clq
+N3UuKi0tSpGSQnxCh5xg6OQ7NYE/QsjpUnS2TG3WDFmSIJkvk/b0R2zuhdc/z9wmZ7KbUmaz5fb/
+Pg8Ctpx5PxByAQd/DJWpoM/NEHJrnhWu3HpxXiqCiPZlF/ZmTFzA1BdgWga1AF43maDn3LpD8LjJ
+RP3r5zpqgpp/m1qPys4BgPyVzuuFH7Hm9UL5Nt2scYBUfChhi4diIZFKpwu1vN5O59PEZDAAPz95
+wmTCHp38J/6pyWSpx51N9vkPBRzA5MlbNjVBCNk5w8oOFPaFVqBpjYMhDZ44PWegN/W9/Zz5/oex
+DZAXraDepyD1viIa8qxzpgMIiNcJD+BAy2CoRtFUArU6dxAINGoQCIi2n6nYn3+Gb/zcQr1HEdR7
+UAknoRJz375zJ/XJ7ge30fPT8+efoBdF54gG7SCWeB3/Fd1Pp9dBoKJnDIcrRqdhZIEclADl5See
+hioKTM9fSv3jyJJY+Zen7mr6rp0LN3nTMit4aorkEtHAEbihO8yJbDYeVyanChv/TxIEaANQrkPO
+YFSxiIvfiIv7eSJrQUxngv4/E3mLVJtyXl9AUe9qtMeNT+e/PI+CSpXysBGqZf0523a7ukSG9NGf
+rgsTk6DXTw37Uzfn7UhIpH74qWF/0vo8VvYvRzFoQmh+gkGIZjwZnCnaRXZjhJqmY2Q9uWz7rl0w
+ZNfu2yW418F9ix4O2B7x35fG389Of3+c7v4PP614LyP7jcW/UVNXaBG/lYd/8zMV+9NP8I2fqQxW
+nrc0N7VS79ID0trU1ApDn0LSHQOGqQn8PRTTVUzPPZFWo5ZoAvnuJCHkiQP5aqlMoxYLg9yUGrWU
+I3SH4l9/GoW+1B1ooLypMkh8CSVtly3U99fxBTuaWzs3m6k/5o9sO00v5s8ubn3kc/Pkk6ceamjb
+foqWPZg7zafAieUcoYTmGCG+bRf1ya6PoRf8CHph7t/+9NO3FFRS71HWnI8mzA294+4RqNWoEKvB
+BJoiH1M/UFHUD5gbeofmNQrFcWfnrCstMQG9RNldtbIuUkTw4w9Tf9DxdZ7C5j9M1bzQ094x5wV8
+3i/PUg+iPLwUf3DfAOJ5TwCIRYQc+KPVTyan1Uetyi5zbRCHi9O3grQ6rZQvCeJy6Dy58J3FCYll
+5Q9jDUewI5R4xeL0oujD1DdbNgb7BsPxE2a1SqXswTxdjcaONTk5nZNHOjvh6vgW6mQnDMz2DsyF
+ZpVyv0p1v/rltGR084fMJQzmZlYQ0yySrV4P4TvKYN/gjVtgwOHoovTFK+BXR7AjloMPl5clJsC3
+TvQoVSq1+QTm6hLonQ0DO6mTLfFwdSfdjpycNR1GY5RKtV+pouVLKQB0LnsBBz+B5AtnaoI4yqxH
+wYF2IjZQZvuOzYaC7sPw8uH/UGP/OYyRh00PvILRyZQvf/zmmx9jdOZh8AqT9+U14jLRTc9/N2Yt
+Q7HiJEwoBKQiiYXL8fOmc6a+Nz+2XDZj5lceOPcM5Ye1wqqT1EOqR01PHTkMG5oWj63aD3Cwd+o1
+zmdEN3ACIlprdWMPv9Din2/3nfzbuvamR6tSUlTR6dZPePs+tUO36IwUtTIlHT6nSE9RqpLTOExz
+Dsyhm9PUgJoDIFhF1RFvkf0oR8l0bSqhrb57f9gahn85uPD9D1FsMumj6848TQeUwyIeWc18w8vo
+0NnDcIfmsV46zB+1v6lveNl+eIv9pA4zkdZG6JjpVt1ZwKF3JqdzTApQjsmpKXrlwK+h3/VojLum
+JvBfCTlz/10DEYmEEKkKXKuu4D6tK2CEEnpbbpjwDuqtfendi4a/XmDqbe9uqN61qVKTk4/hFbD1
+NJRUUGZfdeGqXQWFAjfJD62xmhJl4XxDIoB0Pjf8M/I6il3HI4WBfHexUqMSciSBfJ5UE6jhiYOE
+Eo1WFYh/Ru2n3ocxnzcN/UF5wyYYQ73/edOim/B7Yin1Xer71AdXn/40BRphfur7FOQ+/WkK6o9h
+aoJ8nkgDQbQtjhKpotWGWWzs9EoUYVFuoFeT+fn9r0I3SED5q/3589mlcve2bbvb32iHJRvGSlp+
+PnUMktSdY6d+bilhlMyaGkU0oiets32C5sw+MIP+WIFVFyBuoedx6DcrswQcEkftpc9y9xDMeqFR
+oxy3fpDW1tiUBcFy+h4WUtH8oLvIQ8TOPK1OS0SufSHb2Fg4ddLy86kpUNBozH5hbWr46lOYx8nx
+6FRs3fvvm9o/e7Myd9YjJtMjTjk1r3/Wbnr//Y3ZCj6WZe60PMuLTkNtCp6aoHPQCjj4YWQz3cL2
+4KfIrwGG1n4xDjUqqLIzwyAGa05Q2aWw0ASL6qgnugup+QWd+NtwkyVVjztO/k+vP31az9hfP2N7
+iDfJrwGOOEzMl+AzLC50i89ezxXC2VBtjF1InWzqpP4ogfNX4zvyqQKoLSig3oLnCvC34b8mb9Hl
+67F3DWcGqR+7qZ+7qYODZxgfYia2B28nvwZc5EUE1lVAw5hh4RBnlwdrDDB3jgQazw10dQ2c29/Z
+2Ni5/9bQEPxw0wNtTQtHTp3FPq66UGHIyMvPmLxF19fVmVM9y7mnbmQBXVcydhzfTV4HPiAYRFlj
+E9qKZ00nRummDQNav5ZoVFqdVk63JHNodBRVpXQVPPe0hyDx1NmDj87tVov1//1e5R8NGzv3Y8f1
+sDMDBtL1RjjPynDm6epGFuirLoT7zspw8JDk5WfQ6xbd55fIr4EDympwd08JoTshgcbBFy69enqQ
+7t2Cm9CVuv479vGZkydOM/364tJrXzJ2GF1WJvk1EFn7c+8IZQ4NYX/kU0VQnZ9PvQPPFGB77jcW
+M8qC9ItiPiv5+KhQ+jueaQEF8Az1TkEBVFNFBdifQ0PYnjODsKEbenRDz8EzBouKLisL24AfIH8F
+/myWNQ6r3NEJ+vhsNuhgFDoXj/fYsOTg/sf2Z47HPwVjyl7uvUpNQfhp0fFm4vvutjPnTp8PCPjE
+QL0RHv7fS69fE4svs20l/MmvgY9dv/l2FznZUGOZQ0OwbyH8YSHsGxqitiykvBYyvTdQX0GxwWBR
+6fV08Ec9WyZ8nvwa6TR2uSGhcSG1BZWDfYy42ADg1HZ4Ar5Ffk3bNPj9bJo5mVisLle/Fa4bGlkf
+WQevLNfpyhZj/zUYLK7rRtxs9P6Y/Bp42vdhOkeyBE53YEcHjDVRPfBV86dUiq0Ll6DBYKBeo3r1
+cCN10toHPJ78GjjblynW2Ao6wY6+wZKw4UMAQRK2h/hxRhsc4TQJIduGpVCA8pXA0UXUAwupC9XU
++SGmHMsJrBRREVtteRHLtCzSM7xkxDYQ0eTXwM1arorVifioSOPSpTcw9SjMGc23HF7KFjUHqzIY
+Jn/EXrKs199VBuRDlV2rUFEwEfP+ZHSUWjdk+SRvKVxAHrI8hB3UW5R6PdZpKcA9DQZmD43mxU/J
+X4EjzY0gUMvaL2SQTE4vNlqd1dPDdYFwAdTu3Uu9tRfGNu1PyExPz0x4pPndyfTMxH3YG+dePHPu
+3JkXb8VqjY+0dzxi1Ol+udR2IFGnY+pRY3uwC+QUo7O7IZ2dq1EK3bkeQndxoEatk+nUKkZnl3OE
+7i+/u2v978//QD1DvfreCfjlvFPnvnqK9IsvSBkYhoZLAyVztnz55fKm3Np9BkvvkaVZOUuOsLJD
+i2SH531khwd7FR4aDze0tDQcpsXH+Y2PPbbxtS1ERk15RTUjPpZ+spR6Vw+ssuhnNI8kSBOiw9dI
+cDqyk51uTtNGanUemSKSvNYOfLEtLDNxw0pq+dAQ/GLtzh6q+0xjTU3jGULvpIq6pYez8qOo59Fs
+mW+ubPUoOlrI8IUztgcvIr9Gsbk1KqtgQfGR/1xE/ScfTy2griHeen38tdfGLfEsPxmwDQTJyjlr
+mq+7dNznFsH+oQUwcMEQTFj4GDX/KHnIYKCK9i9duh+eMhio9Iv7HrmA9GRsDxnGzF0ocYISxp/h
+A1VsgnL8486PP+n8swFWLbj0ccenr/VA1zpsD3bUUoU7GAyWndhhSyk2l+YuDCRhG7AvSAAcUYY5
+DSPo6BCdKqGEjdyp1uqtKWfpk/sqpQcGd1SUV2ytmt/1uaw9tX/5zrUH68rSS2YZDAO69VvwytGU
+NGNW4V/UZX1SqLy7pq4jNC0nVrdIJBoGM+sMm/ZY0tFiJRqV0MrL4VDoLmLdlhxGNYS+91QbFcXU
+Gx+rW5+VTahHU9ITs4v+gnH6pNAQU3V9R2iqTBqrGxI5zU5IYOvG3UkAPJj+MpNSomFr4GlVSpGH
+G6150vaOkNVEsZiJvr6JILUmf6SwYM+SfI06KCqqqiYyKiqypioqithab6CC9fWxPt6VldDzpI8P
+9aXeHBdrTBwZTjLGxtFrdxK2gRghAZgFdCBxutc2Py3T4XvctDL5fd202FmaEtlZ1enqsYKRNbvW
+HKwpzi6l6a+tXiyJWpSz+yAOf29vy292r/PZuShvObWcqK0oqne0UkiuUnac6QhNzaVpI4pWxSiP
+7COGhjMzWlpV1BtdJnMnwIAU24D9xcGBGwikrSha10CN4CFdVijR6FCDmQYKd/R/G9SdP7Jnz0h+
+d9C339ZVVdfVVVfVYR9jN/TU5ljtSerHk5rYev2H5rlLh0eWoKQn7HhY64A2pqMHAumeKvaQHUMb
+7LG+CbqSvXuW5HcH9Xwzv66qpra2hq5FXx+rOQk9T2pjoVZveUxvnrtkZHjpXCu/nSUBcEeRsW3e
+cCt3q5QeXLTEc7GzlHNHa36ze73vjsVbn3ly8+6DK4m68qK6WfhZElGn5fimjSeOtHaZTGyMLWvZ
+jMwUWzkHeTVVSkYZC5LLdB4MLyfBmypVW15MjFu9T27uom3V1U9u2l1csoqIiqygq/mQGEpMkARn
+tMQoK8s3bzxeWtrapdGYO5EMScLWYOUkQLsmQcgq1yGnAaqIvgM6zbVIseciJxfdVQ6XA8s2rYpW
+lJcroleNaAyG5ESuXJ6dJg2OiKz09KyMjMhKzypwbnCJi8XWVBTGy0NcnENC4gornWYJh9Re3n5+
+GoOfX6yfv14bC1UZ2VqNXXui/x99vuibRIZ4GaZsWqmILi+PVqykm5Ni5MjlOWlSSUQE3ZwqSXB6
+dsHsRpcBElQWxoWEOLvQramY5eS+SO3t5eer1fv5x8brtX6+UJ2eNYcdC7gXzW1/5Jlj9JiZsbVZ
+vtKohFw7rtJgjz2yNWVRbIJTWWFH4zR/TUxY2Yt4xvLn2AahxxK9pqO3yMpo9YYPWC5jeFkBj8Cl
+xO/IN4j8Q0Eyemxs7mYJjA7z9wsL8/M39cCivr4JDKplMrUU22IwUMEGNMZSbA8u4uAon551B0SK
+CuAy8ZvkXKEKzqXOdvd/C72boWLgMtVMPd/4Rm0Xvejc0Fve/MFgeJS6rNf/kceed1Zge4hD7DjN
+DGQuswtKP+OHTRJje4K60ru7uqiL7QkZ+cVt1Kv0Z0k7dbG779/iVmNBNzGckjp/kHpJr4fq2vyu
+VupXvR7GVuey377QmwzGdKYdcC/2LAmAnFk7rXWI6LtR9mOlmW6Lhq6lI61XoxmeE1kqNxBadZzW
+kF9U3o7t0cPPDaaExDjdIiq3rttNMKxVpmXD7T3FXW3Ur3p2br5BAmSbAUDvlARxeCKaCQI9eBwo
+P9PYeKbx3Nann4Kyp57CXl0Owfj4FDhzHPoeP059e5ydcxuwXSSg/QdubGPduWxjmTnNkyP5nOS1
+oW9rdVVV9dak0aQ058iIkoamaEVjbjWPSGhsq6jYvKmi3Ntnd0Fw8DiEycnpGczYJOGvozYyvssg
+2nepDfQQYkmNZxrX0A3DXx8bHx/bdPz4Jlt73sBfo/Hd6IWajh4UKNO8gdCpT5/CX1u6fPnSTceO
+bWLmhBSewP4iAZgNguhVx05gy21jzMhzZuSho1Vwz7eX5z3Uk/34hJ4V3e2Geqs8p64bDAzfboCT
+5FFWv1FpVEIV0nHsJO36jo7BTz8VR0SGwUqyura9lHjG8OKLBmpVbni0pji3kA5VACTYBtyVQH57
+qLJTQtBEYFrPl8A3P+n9RtyR2t/d9YUhr7i8nfjV8qO+W5/YvwgmGqjDPcWmNuiC6FWKDeMY6Y78
+gBrajpj2zWls7rFSrCZ1QWr66BrqoVS4cnHtgrnUB/hvLze/4Verb22ov6RNy8qtmTmXaP8JUhrs
+mqbTst9Fdj+sXZDLMK1Yrabn0ucJGfkhoe3/8g/IyA8NafukbyKw1VigUeOByeKg+YPQaKC21OWr
+1W2QZ6A2VstkzFfLD3qT3igJotsxNYntwa4SvwIXAKBV/6MHVIJD4//yoNp8/lzbndw/sT2YyGCg
+OuGDBoOFzjkvw/bgD5K8u/xVWsZ8sFM/GH+VLNW5ujQ7OnPxiUNnhjNjskurXFKTRo1N9fWNSaNG
+vLsgK0q3vLVp67bG1uW6qKyC3T7eSqW3D8BAMTyPPc7xBh4gEgA3D5VyehlgVgQuR4jz1dZFklk6
+0MohfE1V19Qfp+8xBBTpovxj4jbN35SrXpBriAgvHEhJeupwhxy7KW6tHFSqDaFB1fKIbIz4avIC
+nvKfAH5pcmyRIsrDNcHw8xLna4zMOYu9SkzRu6fQg8vhcuQyHS5hfOrWRYquHr4sl/qHp/qJq57p
+++ejm/kOPC//zGy+V5IX9t6jCdEYjEsew1wMlO+xBTqZwVEsFyv8tXNCWR1gD/YGxxsE0fqsm/2S
+zJXJZUFcjghJCmae8XRaASuKfgh39xwZDkpJyUhziIxcGB6FYcLoxG1PPbVt69PV8k4knojrrj4B
++Vl5jrP4fS5Kby8fP6lGEEaLp+PQt0JquclKLbodsfA8XkgSIBTFjZZoaI++TC7TqXWsEk1rBiKk
+N2hZotP/17Bx5xmFAVLzl8giIkP9eDBUZs5IDBMFiTf6Rm9ZkhzG8w2NCZWNbAqQeHrqooNE8Px/
+MZ5AHpwQVDKWnRwu9o4L3N1l1M+6NprSg2FzM8dLghKCw1xdiATn2ATTcILRGLbpEQABD57AbpA8
+FL0ORT3D6XYirYlui04p8giAOsaUEbFKBNMBbpBcpoBcmE69XflzmcDd20nGFxD6QP9AP1lktEfK
+ziIYXvJLmZuAeWAIDAj0k0VEe6RshX8ZDHAlBDpnPuTzZQMOJAG9fVPf/p/BQC24HevM5/FlcxHQ
+J+2135g9W3qOL8XzBFzuZQAsbQgWBSDWiLULuNigDSaZuo67YqUCLplqhU1NAoBdRbAMG17xlBh7
+HB8VcMksG0wxFYC9irUKuGQ2A6PnzhQfex5rAy4oA4lVZjMkom8i6RCRWLH9Wn1VdW2ktrhMFTVf
+lxAWW3xg797hfFMQzNTP6V86PBafBhPjLI2Y+4b0JDdvyEhtpn+xU2K8EOsScMlcW3t4U3zsBjZf
+wCXzbO3RTU1w21E+m2gASKtlwmZ08LB5jrQqJZ2rwibz3Rilmly0+P2C0i+W0PsXjY30/gWsWnbx
+BsTIyR+su44lzP6iX21VbRXaxrHsoPdD4IS5uJf6mPqO3WzEVrNnR+ickXHEBJFm1fWQFs+mseL4
+IS1HwGOz8mqgvZkCPSZ/6n6pqkmwasnBXdojw5f+uvXa8BEttRt2bduwacuWTRu2US/jj333l0z2
+VVcTSqBCvUnNpt6i86fA+Rff+vqLz//95ks0rVgfHo9DMmcMcwDAmph9d6lNjeJPa39P047zU6do
+f/nJkyaTCQ6ZYZ+5k9poBrb3W5kzAW50LEDkVZDQk1YnDKL98jlPwlOw68knw8LrjrXUw3/Tr1My
+8/LlhkTY2lB9dDnjk9ZTFWQMyl8TgvaqrMlQRHTyegktA23LPd1CkhkznRaKWQviGp0a5cjwpZHe
+2cOLnfpHLg0f0Z60/PTUU09hNy2Xtm3YmJVkeQczOCRlbVyfk4WfozOlPLz2H+ZPNuyBjW2Uj9mM
+i81m/KU357bBoGea+9sZPrLu73GBBO3wJdC2mPQ+3k/62GmgLAGi3T7Ll9SBw/RWHxZEb/wdRlt/
+pgdekcle+ZEmgJnawvw1N9J7gI3MPsDXUxOkB0Fn4VDRmrSHre9cFxjECEbGN64Ta8RItNCdl2vE
+OI0bqNMSD1DfPZgc7rpxFQwLK846988Xc0rDQuGqjS4RyQ9R38HzCxMiZmOrZkckLIDn37X0k+W1
+UFZTgZ9LGilpNnc3teSkZmak5TU1mM3NJSNJZnPUaE13d81olNly9onu/n4mjv7UZ1QcVoJyhgCp
+yg/a68E6mkAMdeChk68ey1qeSGddqw0Ppw4+9RTsNpm6Dp0Viz9rqkk0jHfRWQxn+DdQLlUJq2ez
+1igPmWY461lBM4LLgRLk3dCoC4YLCveMFKg1QYooRgmMUmB/1Rvg57Rvw6eqkvrxhLcPDNKbY+NG
+h0dGY2PBDB2Z1tnsjGrrdETpi3TYLs8NfVuffnpr0qiR1ioaGhsbcmt4eGFT6/FNm4/5+OwuyFpG
+UeMZGeyaKsaWId09il65VR4aNZfD1aqUyFFD37nTaXXR7Jb5XeYvJMIq2zMGygoPHsHxa01pZBbE
+XGdXV1dvTTIajanOEZGlDYpo7JYGJmRAyM9Z1hciiC4ujouYVblpc3mFizNS05OSl1VP2/hvcHDg
+AiJn7gZo1DKcUSdtfY6Ccq5cFwXlOn8o0okw0lfl1dPVWl3b7Jc2b8FT2x549ukHPBRil6jAt0Uv
+ylv1TrnL49/2JA6R5NDo4NjYUOJC6NDw2aJNxx9fu+JRgvAzBJSG54kjZ0e7aGRlEeBeP4BcJqe5
+RXuPnW1bT1UzbHOYMh4X59I4uyA7PdhmbUvTcuRyjjHFYNCMWM1ybI1Gm52hgr6+Wr2/X2y8QePn
+5+2lHhLOcrKa5PL4wgpGJ8bCOTjSsawSeNrbTVsDDG2w8ASoViZrzp3bQa3t6+ubPzesLIxcGBZu
+LM6nHWi0QZfb2uHsAuDUCrgXLiJ+RXYXq0bb7RBbp4dEo9rX+01XV0LCnKjQUNo8VMX7+O7bRzxj
+sPxkiK/TqGc50nZhkCQr3jDtIyB+BT7I/2fb7rjLTaBRa6FuephhNTzbd4+LAJbD51j3wK+Gu/wD
+G0ZOUDc/WNLP+gYgkMATyK7xR3YNYxDYWQvTxoIcvtRLmzZqTdcX/v60cfNJ7ze98Cfoq0+MjWXt
+m5BQ2sAxTN42YLTfAdsDl5I/Aj7yb7Gp55l/dImYWqelHYxBcsjvpU719fX1/bu3d7vQIz4sVO9B
+7wm9qf/EQF3Tw1/Ubm4ytVTAjClcRvxK78P4QGsz6VVoL+z7t49v3JzEeLwZlhiogRyxRKeJYW0j
+uJT49Z79J6ZWWNRL1wU/N8Sh/bU9cDfxK7u/okGItMnIbkNn9fbCot7e3l7qVC++X/+JIe4z/Zt6
+/Zv0+ja1DO6FreSPQAC8kZTzEEnkiDOY7WoV310uYw4VCxDjuXPpvqf5EJlOxsb/YviRhRk934wE
+d2R0FRG0Q8ZdaBDC2zppWHj/OAYDsUyDZV4SVKZp8Wdo/4ybQMDMOynciwVxcKuNy3e3V03RgPJ2
+9H/r7Run9vEmDfKw0Mg5iQnkG/rJxuygIC8v7bDAcZZaUwefAXDqJ7gXLmD9PCrh3WKfFdvQsWfY
+HFUiNxAadZyvzxf9/d9iHw+yvo6goOx42uHDtE2D7cH2EJZ79800zL6ZmN43gz8bDFQg2jdTwL2E
+gQQgDQA4Pa88WF60WgNW15D8bt8Qu56IbF5OwpCQaGbmoLevKl4b4I9sW+rV9gD/aX9RjNJYoNbw
+g1Sa9G61GmuxTlAvr7Rs+HitXKZWW/1HUpmdAykgUBKUlCIO0scNfs7a4fAEssNjbLzGzCn5/4tR
+Tmj6qFM9jNEt+BujHP5kMMBI2t42/p9WOSOrcG8SgPAZtLTtqbLU8riLmu6Qby+2tH0TgWpVWl9X
+J/VSe4B/XnHZNHmUadlYscGUEBAwsBA52gJ6QkO62l5jxhF/lwRoZbpr1Gb41+5hVczo759fFBbO
+jgzysd3FuvifPaEhanUbGoZpX9sMZmZ4bwPuQ1DsXHab3ivFJRBXCTWVsPIGDBmFOdVwLvU0pLdL
+4X8MVAFcYDBY3oJfUXP0gN0jYf11rvRaAugGiz1EcjFjRbvRGzQ6baDIg8elvXfRIRxqHSc0Gg4R
+4dFnosNPb62urqqGMtoJh72aomtr0/2z35CcbKCSyyuhb0VFRQX1bWU5oyMnwRNoTaf3DcNtUlOG
+atCx4sgD1cSV8zlcDk/k4UYfguHJZfBiL/VknzmzuipzTh8s7jVnVFVlmOerAxXRgUdUAQpFAM07
+h13S6ErTXN42xL3nmkpXneoSD//nGp48BZIj4A+Uk2t4MgTJYYifs+BerIwEIN7ql6GVCwWMguza
+yepStOYWAK1edsVdW0aarFS8urKgxNBcWlGweqFmMDEVL8/JzvXzK6trCA/bujQ50UEXr9YQBnlp
+5JxhPCs1Ra2UR/t6eeXEldR7eY7HJ0dFyTxEIi/vELmxvGk2FpuVrtQOC9y669g1+TiHADLGlzJj
+LZZjuOxuTUwkgHzXvGkej/WTOaUanHMT5kSG0RBfb3Wcj3SWHmspSrYu1JGJcA4cKDaqHRzdhrVe
+3pLIRGojI3uRf1F2P/+i8K41nHO3u3HxnKgSdpoFyGY6Hl+3uY5juLQLEmA2H6Qf0hEY3WPajZcA
+rWurWCOh7V1matE+yV7GK6nWdHV1fQGj2tupj+glPCyU+JW6pn+T9lDGxi4KR6vtJ9R/nXpCQjUq
+q063G/uaxBAvSjymvTOMrYJ8NjRTuqBdUevpeY0KO/pQU1ycj48qUm9YtSBK5eMTF9f8EfTMzY+L
+OxMbL5flfkO8LsnOqjJqYkOl7u5yWZhOm1iVlS0x1HvNL+8yJiYauyri4r3qAQQEtgf7lZhCp1vt
+j4METZ+/hDknUrphQPf237Z3wfQu7IfiHuhvMFCvDM1FvlcD9e8e1k+6G/uQ+BV5nVV2/aF5Q2Nd
+PUUebEfg6czmWLYbqZ2p8bSO4RNfmEF3YEb7CU1UC6t1BEGYYzDTbb/bH8pslDih3USVG9z2Zx71
+jvnc+fbJ3P8Rv1pouf4g1WkwYCIA0ZmHRYye44aOI9IHM+h1Uw0fpZ5NxaRUHZyThu2Jhj90PhpN
+ec45woyXGu7FtzL6nN17wnvkbAK0K+gULWC9fUhDSGhopNmYMF3sYI7YJlTV9fHW8xhKjNanSHR6
+kT5BTV+ot1amQsYQ6U6bQtGwa528pvbftqpKffc+gePU9TKIfeIQnfYCtFb0/LI5xGyXomLEc3AP
+9gzxKzqhLdHomHJxlFT5rtlNp13ON3xAFx78Y8z8seqqwzkZGRmZnN7W5ljdAL427gZd+m8Ef9Wc
+5RnprrzA52rqY2ObegcYuf4AdpnDAw6Aj+L5s+KBS4pZ5x6uRJJWwgh4zEiEKxrqosNIuJBaS4Yr
+6uqjw09vq66qrtq2pbq6uorofba2o6P2WcMz9Ae2ZXgxZRpevHgY7lw8DACcvEr8MvUdpwOQAECd
+Coo11MH3BinK8hs5/i21Zjs9fpM+xC9T4SyOWCiGKksDfNfyG0UNkuPb4RC4uxyxRgwl1EFKhblA
+bJD8aDu15p5yuBIoFloa3huEGOZCfvQtHGLqUhK/TL3H6aDz7EKVGzqxoXKjDEd/nv/WO/QfTge1
+BhLUJByiJu/G94EqnH7DzXLpnbfm/3yU/sPpoCYhYX0H+fKmblIC8jPyqkAGggHgcbGPiAnmDgcl
+INYTaQIZSGPhzLnQuQCQbdwGntx5OfEDHBRwgJQ5Xzo1QZzm1gtk9H19Hhd7Fs+CBhqfGiLbUPlS
+BP+A+IHBp4aI0xx3nhz7gPgXAAIO/R7C/2gG/pc2/I9Y/C9n4M+jBslDXLNABtbS+M4jpAYOovYP
+En6cDoEMPI7KOUl02dp/c0b75bY7KxOo/ZlM+8mPbe2/SV5F7fwBgGl8aoiYYNsfh+CZtvbfRO2X
+z2h/B/URjW8r/0srPalxVL4MLPZ0Zkpn8Qdn4F8hL7H47mx7Pr2rPe5sez6d2R4ASJVDDU/mvF1B
+Q0Os5U9NEBXcWp7cefvUgSko4ICsu/C3/Q3+tpn4lDupYttzDbBvsO2pINIQvAEAO/xcFv+Lu/Bz
+aXyBjMbjcbEviAYWf5BUcXt4cudx4ho9XvBnG30qOFk8GbazAQg48D8A2NqvY8f3TzS+odbzzfhc
+NL7ZzPgSGjS+86YmyFcR/4Qh+FNkFcs/ccQE+adABnIYOFFnLZ+zlimfXILKD7eWT/yCys+9m/85
+axn+IZcAMI1PDRG/sPxzAMFz7cqv48mdh2eU74TKb7CV/5yt/EG6fIEMhDN8wrnNlj9Il2/Dv0LK
+WXqmzsD/jFzC4qfOwP+MOMDcP6NysU8RfgQzLvhOGj51i8rFNpATAhnIY+HM+NYDgL3jUMOTO2+h
+PkH9ikBwHgDYUq4Hgq+nzgk44Hdbf724AwIZiGLnbx4cYc6rEz9wnxLIQAEDx43wLEtPL/KqFR/7
+gN7/YOXJDxx3Kz72AbHVOh9n4H9pw/9oBv6XNvwhMoCd728AwOMABYs/RPtbaTiegeCFCN5N43OV
+PBn5El0OmDu1HY2LDzWEzeWqeDLyZRaeARNY+RCA2qNg672FyvGjPsLmofYUMnB8en4FsPPrFqIn
+0x5Hyh1bzcx3GlNgbU8HANw3uWae3HmE44n4J9rKn9wGTgdPjp0kGflZZIeP+Pk++GKBjMbjcbFd
+8JZV7nHfZOjD8QRgGp8a4jag+f7BjPLbpiY4h9n50onKj5meLxwxT47tAnsQfjGDTw1xDrPzpROA
+aXx6vjDlEwdm4H9E4wtkIIahG9nJ4n9E4wtkoJiBs/zcRrmz5X96V/nubPmfzih/3tQExw/x84Ng
+DYIrp+84cp1p+NRR6hnmrqMNv40nd951H/xAntx5CdEFBDw5MIEOMHXXe3Q928H6e95j5PSxKXgf
+/G1/g79tBj4tF70YvmDXPRXL1xXEaYYviNdQOaV2+PbrpD0+zRelDF+Ap2zz2Cp3P0P4Gpvc/QfC
+L2flboh1nnF07Dz7DIBpfGoI/wczzixfl0/jc5UCGfktM5/AZ9BgxSf/5MnI79CsnKsGtnnPlP/l
+jPL9qI/o8m3tsc6z41MTxHds+y+g9mutdMafRu2voMvHdjkw5R+nhojvyOuo/RcAmManhvCnWT51
+QfAKu/LX8+TOB4nrkHNX+UIEz596YCa+QztP5rzbWrod/nWe3Hn3VAm1nmfDp4Zmtp+8Nt0eNM+e
+pdvD45D/RXB3AMgUFv8x1F+dVY/D5dx6el5OxUODgAMqGXxqiExBeoqOla/HrHIRl5NzeTJQycIB
+i/8RjS+w4n9JPGaVu3gkOVdgxf+SwFh8d1Q+Pf8eA2C6PZQ7nkzORXAMAFt7mqghTgvC/5BcjeCx
+CK6jhojPCDkNJ0oQvIrFz6XxBTIQy6xX5GoWP5fGF8hAFQMnSlh8d7b8T+8q350t/9OZ5U9NcFpY
+OfGmPf7UBPEZKyfGqWfuwk/hyZ03g7fuwU+l4VPrp6AVf+oaAOR3XJNABuLQeriE+BIus97j4ugE
+MlCN2n+KSGHwqSHyO3Z+/YZmBtI3p25TQ/S9LwRPQ3Dm/t0sABxUSD6McvwRP8RP38vjtPHk2Amy
+DwDb/bxp/JH74DPrzL34SK+5D76Yloew/x58Zl26D75ABmoYeUL2MfjUkIMKjW88w4ccfwafGkL3
+Cq34H7D4swEgj7L8/y9Uvh7BvQAgTKj8WlZepdjho/b/n/jPsfT/CQCyhi3/AMI3sPSPI5Yi/Dq2
+fASnf5FGRM+lxJsIP2H6HiSyY+oR/pMEn8Gnhkgj6m8CO+/eZPCpIXRv0or/gQ3/oxn4X9rwP5qB
+/yXBn14/7fRBdv2cmkTyocE2f5/D46DBOi5cjo3OL5B90/3lcmz9fYHtb8fUBCeP486z2pdjxGtM
+vYyejuSVhpY/MBkAAIGKhnPcmXyvyE3Nles003uEjJ+TdmLrRFz49DXL8VPrEi6fOvXiM6mrUpph
+aWFnWNg2w/smkwlLbKpYt9288unnPb3eyi2IjX+AutVR8QBzjgLVEWh/DkPDbPfb18GlDX9UxalT
+p57jZ9Q9a6vD3wFKqY9NJhNeYjabF6bXMnWElpkBRHN+NvkbOmXFE9O3WIPowLt2KWuFkAm2y4ZF
+4NBB8OOoska4gXq/ZUtDnMdFy+mL+rVfPoppKHN0tbmmKkGatWRfffWF0gW4h1+UVOsr6lwDt8P2
+DswzqmzFw52Tk2Yz7vnYt3uzl1i+wpx8wzKqxvLnHmtXGpXQPa42PtTdCT5j1Rs4ATwOHsn6BYZm
++AU+sPkFhmb4BT5g7NipmwCQnzmk2/CfJyYYO2pqgljP+cCG//wM/HiezPkhNOuD7ezMVzmvWfnC
++aGpXdT6GfgP34O/nvM6T+788NR2agOPA9Lsyj/FkzkfuBefq7eVf2Bqp135gzyZ85774AMb/h4r
+Pk0f7mdsf+UMfdD8ZPtN0wnN2zT2eRz73L7/tJ29T3FvfQRP7rxvaif1xF39ofEfCbk//iM0vmAm
+/tc8mfP+++Cv48md998Hv5onc957H3ycJ3feOxMf9f/8Pf1/Zkb/s+7p/zM2v8cQ8gPIQAjLR9ds
+/EX7Dax+gA+IBju/RLrAiv887Q2w+iUQf2WxcDt8rsmK77yE+A4us+HrbPin7PDrGH6MvtvvMYn4
+MYvlxw+o9Sy+iuHHe/ArOK/b8B+e+oDaYMNH/HgP/ktcA0/ufIDG5M30wyB+vLd8LrCVv2fqQ2q9
+lZ5cM9tfOUNPHtd5qZVONF0RP2axzxtYfrT1HzvtkG6z85/Hd6L30Nk0zgc8GfidhSdYxw87zegX
++HakR0RY9XesiZxAcKQxgzyrHsrxY/Rl+hQjz2avUEN0DA7Uoi4Et7dv6PYomXrJLNt4m9B4l7Dj
+3WWHH8+TOz8ENtxlD/0bjV8JO37PU+tn4D98D77JDv/hqefs8E/R43QP/mGu3oZ/YEb5gzy58557
+y+cCG/4eKz5NH66Z7a+VSlznpdZ+03RC41cCgI1a9Pgx/e+dmuAUMfoY/YaAA1sQvHVqggzndPBk
+YEEX7SdrBeAu/NH74LfxZCD1XnyHdJ4ce56U34P/AQ0nlgD2DVv5tP6z5D7l63gy7KGZ5VNDnCKO
+0NrzaXxqiAyf5g9b+dP6/we0fs67W/+36vMfEKvs9O10m/7/PLkaDlr1bcRPVSw/MfOvZmqC+z1L
+z9Wo/XMRPGJqglzG0DOLbs0AAHfhj94HH9HzXnyWnvfiM/Rk6DBgVz5Dz3vxET1nlk8Ncb/nmK30
+mcanhshlRDKi56rp8ll9lfbDGRDfjRIH4IhVf+NoWf2NA1KJjxh8aoisYe0LZoW1028Z+4JE4wXq
+pst3yEP9OgBHZuKz/TXcjc81IX/JbrjsLnwdT46dmsYHGC2/uB3kVeAM+NZ4Z+xJFj7ki/lzL1wg
+Nl3Anhi+vR2OmEhgNt/h36bWk6Y7fOJX+n3a39jNcQciW0Zjq/ZHBxNgj9TifDEfP3vyzkdPPUUf
+pISNT8HukydPUwdMJhMRYj5hon6i/5nvfEKE3PmEne/nyGjOQZ4Mp/3LHK52BNwHzuX2grH7wlPB
+/vvCi8DO+8LTwKX7wovBS9Nw7k0bPB3suS+8BDwwrRegcpyY9o+D+8C53N4pxq51BIDL5RzkyXEn
+GMH0YGqE5Ul41zMOt2jcalv9zpTlifowxZwpJwDgKBh4GY1dvMaubu5Ntm4ON/2+cC63ZGoe64P8
+F34ZlRPF9CEY3Bfe63N/eKr4/vAiz/vD01Km4f+2gxfPYeDObL/kuCuYRC3VUhsAsPVZxD77lqGs
+7RlN2++YZ9jT96HtX+yz1+1o6wwAt4eFxwMbda11cR9ln/UDlsJoDa8gclC7oxl6tQFwP3hv1f3h
+qXX3hxfNuR+c5tnt94UX28O5N1k4h5s+BO4LLxmy0Ze7HvWLD7F76ctdyjwDf95LX8dk5hl2+l76
+Olazzz6YSV+Hqyw87176OpLssxE7+roA4FDJvYna9x4zC6eq2HfoZ8XsszdYLrY+y5k6jZ1C9FEy
+vZrKuC+8dyqPmQ9Tp7HrdvDUKeQbn5qYOo391w5exM63pKnT2B0bnMNN2wXYux2n8Vl28OLtDByj
+6+XeZOF0L1C9gLwLXjJVaB0X/Ayih9v9xgU/yjwD1+8dF7KfeXa/cSEXsM8uzBwXgmLhGfeOC6lm
+n901LsQGRHs3eOXecSEWs89euWtcGJ2Rpk8sM1/q7w/v/Rt4asH94UX3xafny9H7wovBRobOtO6J
+4F7TVKb7PTXB6WXgxTYK0/SdmnBYgejhhXXeTd+pCYdt7LMF9vSdmuA6M3Bw/W76Tk1w05hnUG4v
+V6b+IoZQ/YkMnQoAuB+813h/eGrc/eFFlfeHpw3fD07Tads0nHuThXO46cXgvvCS9GnZ/DXqlz9c
+dRePQgAcnmWe4TV2dCIAcJjDwgUzeY37NOInf6zqLn6qmfqLE4Tam8KMH4y+L7z3b+CpMPC+8CIY
+cV94GjTcF14M06bh3Js2eDqMvS+8BCbZ1jc5KidoJv8BwBlm4GUz+A8Ahy2IRkGY6d757bCXfbbg
+rnWNWSeD7uU/ALilzDMYcRfNSUTzIPDlvfObm8k8g4FMb+zmN61Ltdl0oxawwQ7ewePgUjs9oM22
+3rcM2OsHNJ7Mbp1rs61zLeCQHZzGk0/LV06bTY62TFXZyXsaL8RODrTZ5EbLsL3coPHS7eZBm42v
+W1rt5weNl2HHB222cW2BMXZwGo85W9JBKRgdE7utBAIOZxUA94Wv/hv4mr+Br/0b+Lq/ga+3h3Nv
+2uAb/ga+EVjPPimQDivnasAzNEU4m/4Gvpndc1cw+itXA79CcKQjA3gPfAuzZ0ApyN/Zct5GcOaM
+AUEpGP2Pq4FcBN82XS/3pl292/8GvoPlFwXSl+TY7SkTbQtxDv4N/NG/gR/6G/hjfwM//DfwI9Nw
+7k07+NG/gT/OyAlKwehpXA22FPXrCSt9GB2Nq8HmIfgxK/0Z/YyrITQIftxKf0Y342qIfAQ/YS2f
+0c24GvxNBD9pLZ/Ry7ga/C8Ef5KRAZSC0cu4GjwXwU/Z4MUsPB3Bn2JtbAWSl3LsNi35BBzOp38D
+/+xv4J//DfyLv4F/+Tfwr6bh3Jt28K//Bv6NlT4cOcuHJahf/7bx5zALP4zgE1b6M3Kaq8HPIfi3
+VvozMpqrwdGZGc53tvEVseOYguDf28a3lIWPIvgPVjoz8pmrwZBGz/nRBs9k4QMI/pOd/M2yymVs
+J9g9DSf/tMLBXPDAtBzgtNnkwM/28oFIs8LxMHs4p8MGl85YD3S29UAHttvh66z43Ehgb5d2W+1S
+bCe4BsU2eIwVDuaCr6DeCue0WeHcFkY/Z+G0/MWm5QJ5dVou0PhTU3AZ8Sm2g3OQ1rDQ7+coBXZq
+ahWPg90GAEDrc+AGAFSKPPxQgAM5GxRIp8W4aXJvx0dJLKdwVoxYpg3iHNRJIngH1xGYf14pIZHF
+Zsi96P4+T/rhORwdj4M9BJjfOjyHu5EnAyaWLgBW2ORFm938/2VaLhBp03A8ehrO6bCDy+3WR53N
+3tMNATt83TQ+l9FnHADgHKHrxV3hfJaSDewYugLA2YxoSVhxHVwRLh+uuheX+xXCJVnft4I508fV
+wCWI7sye6UbiU3wt4mvt1GymDOjBlvE48Snexj4LQa3vESCaEZ/iSDYiap23jhnwRWt8lnXtx3ZO
+MedgxDSc/NMKB3NZW2+ShnNvWeHcpinGj85n4Rx8FvrtjcrV2fB0U23TcO5GGzyWhdPn+d7m9vBk
+uBsNdx7HtyO/aTcA2EtcpRVuO/fmAACRhujoBjfcS0fCBdGRg34vtdEL0QlZrlwWd4T4FKftOhm3
+J5h5QoD706vXTofJsuo22E57XZ780woHc+vt4NxbVji3KWUmnIMz50aXURVEPtJZ4xmbJQ3cF96b
+Zvc+R2crV5dlX+5GGzzWAOz8nLRs8Gdlw2kotsFjrHAwFzwF9Qx9uf2Ivv6Yx334NAnR18Gmbzsi
+PdjnHpv6CVKH67i3aNoDALfzOICJ3f8mqcNLUP8hg0dcw+Zx2mjPr93a02a3lvw5DSfk03A8xQ6/
+ww6eOa1Lkp/Q4wgTpw7MxCc/mcbndjDzc2qCa0T99oJBd/d7aoKTgPrNtc5lbjvCDYKJ95n3lQjX
+Ef1eQq+B5PXpNZC2Waam4FriF7yExgMLkMwcIj7FNkzLTPZiNB2nRcfIzIggnSwoZlZhDkY+OstL
+zjnoJc+IlUmI0jx/jFh3kBchoRkW1MCPsRpsHsAB7zkMEBAQvCvvxADelXei3cRCcQ0GKIDNY3yy
+LK6Aw10KmHe/IM5jLQAHLs+idxXv2b1JtN7Zi7WsZPDww3+Lh+dNPmvD487Es28JN+8WiwfgFw7g
+fnh8sVDsAG4BGm1qCjpQfthNjh9PDhphDpptjagfgPIjwFQ9TwaQPUH/BdcBBB/if2JS8jpwAD5J
+s3FAOHQ7OgBcQDryrkxeiosBvJvvxMVFQxfoByWJUIdJ89NfgUvWkPuTnlhmPHmjFgAItuCfwR/I
+q8AB+CU548CBaHF0AKQA4I68K5euCWyluNFZxFUukLulIOMVOLqWCDceH0848VstTQfqJ3gVTAAH
+4Eu3w4G4p4grdBEiaxE1jZWvOWzZejn97ErpsT9qwMx2AMiNgnKV0N0D/lCwdhS+kkGEF9X+diJh
+/DjA6D7jp1Cf+UCR5DnbEQeEM8F36BbwZ7O9ny1wEdAUoInAZ3pgeEcpsqMEaf2Cn8pLfxUbWUO9
+ak+aJ6/XFtlIBDC6bfgAahsfqJK8UZ18B2eiBdWJAxeB42y6vwLelUuTVy5d4wtm1mujnfQuIsKk
+PLra1TZiFhmPoeoBm7P3J7wJ0ZUPlEles3FHpt7/v2qvMNWK7qmWJTzUN1Zd4m7ZZh2AIttAzOzr
+9EiQ1i/4ADMk1EsFq5fAV9JtQ2Msqr3+pHHZMQAgiJvS45fJq8AJaJN8ZuGHnDBH4pADCVo4s3EO
+zCIxLshydHKYzbtiuPSt8nPDt8pLMYB36eYVA/MrGqpwqVDqJnEjNSQ8/KwEPkD1S56VUP3wAfIq
+leF72O/ORmKe32FfAOzrE4K6pBh3/JAQcyMOkS6Oh5xnuToc4nGdBCRocfJwwJ04MGsWl8Qx/myQ
+5eYiFPBwZw/eFZHhkvJbpfJzkeFbpfKSErXlkpL3T8P3qDk8ulXf21o13TR8RvvsWymxbyv1L+oz
+v8N+VDo863fYD0qhHLUcQhX8EF9JiIET4D3niM/mgNk2WQbtchtA1YnRkWPHlyw5jt0eOXZsZMnx
+46jfUAWX4VeJuQADAPIhVGEH4TLsOn1BCoIPpybgZ+AXMAsA7XRZH+bH6goKdHHR/QUFsboCej5N
+TRArEZ4QgOBpTLuv+DD7lmXWzNfzmQ8AAE6Xw1WAX1CeBgmQJbn5uOLNYqFjUzAAnNlA4A88g3lX
+Jq9cu3INTQzDNaUyWmrXy7/7zpGyleZZKu791hXLtCT2rk8AAK1TTczKI6+zbYoGBpAE8sBIUooR
+T0pISsATjYn5szODTcDflOlpSggTmGKAUcdpSk7ozg9OjMYj5UliH/nseOFs3HW2Y+TseLV4dpra
+Z3aOUz7v5rVLV67xXv/l6i///IX3C5p6Inrufau8aTBcUl5T8pgHcdG5pVVcHvlf+l91ELR2S6PW
+iuzCmcC/+S6R273gpoISN7vfDjf3nTm7d/+Zs49UtrVVVrS03hqvakVf8KeqWtoqKlqayzEWJWj3
+ZM1un33nzj7yyItncN7nZ89+9tnZc5/VHN2x7ciRbdsfx744vGPrkcPbth+xiA8j2LajdVYs7Ou/
+fuc4f3b2/KefnTvzOQDAkaatcx95HcwCHkAMIkEcSAOFoJTWdyEbIuuuLkrvD76353SUNol8Gpvu
+NhbogWITadSzRXd1enLN/0mF43cTYTk0nDtLvfIizvucWjeTCDX3QO4hC5QdrbNizSALgCCEaIL7
+yasAB8AHqmDIibepr4gmOEitZ3SnEKIJewfZPftYv+o+Kxy/xcCRL1tGw3lcBmtmuVACVXB/JfXV
+23TBM8o8wJZ54K4yGbicewCrsj4HEDhRftixqVcBSZcp50ogdmz72XM7YAnl99dfKKkeBPvARmwe
+IQEcIEni4QQEJIfAuDiowUiMy7t56RotKq9cunTpyiXe69FQ6AiFUIPNoz6GoVQ4IZlHHaWOzoMH
+S+iyXgYbsZ3WsgicA3CIkVwS1uCALsswecm+LDe6JEf4MrxKhcMQ6hNCQjWUUA3zYBVk90zXT03g
+J+j+AWIK2aaAgEg3Bzh69gN5HQiAH7q3TgdjIOg4i0IJH6dvQ0sE0wLGDWdD+Kw3XSz7EsIvvhqO
+93TPFjZ/kR+HZMwzmlJ1TXYouU0R9c/nz1xTUdANe9tZkDJ85wAj/8iHLStC9O171hZGMm0D5FkC
+cJwYTY0dY/HUZe5XnOU8OXDGANhGfxLAMszjAmcALMMAAEitnZrA3iWvAxyEJ7lhOA5wmkowH2sC
+3QTEWU3322/vEin09NDtnCzZSV7/63cAIDhF/YYt47ijWKW0ikkfveCLhafgh9QvP/0EBdRv3CDT
+JJUzSZmYcaZ+w/pYfHS9Xoz+Xf6J+gV+SCmgAGvIwTHTrS9NOEb3JX1qgruWvM6TgaAzNOWD8IPW
+MSFHGDgzInjtDPj0WAVBlOYTQLBiSk8sIq8CHi030CBF8yXM/zXYvo8h8Z+PzT+YF5BXf7xw+gv+
+5NMcxV8fchRM3N9P4Ct4CCEAOBP5hs8Nh0w8KGzRk08uwJYcPzFKCCxPweNLRo7diy/n6yEb8Ryu
+fvLJBaMnji/B4CsQK6SXWHbM8K9ws2B6jDDwPvUT1oUvArOAGwBkNK0XRdOKEYau66u1SqyraOsW
+h9eqYvL78/P7LxfV/HFMuvIsfrI/Px+tlajcm/hX3D6aBwCAS9nyGV/vlqkUvBrxNX/qX0QW/Qk3
+IZoJwBaWZm3wC+4eXI6iDqEAFArmajeHi8LEozgVKqWWu2fnjxv2/c+VF/T5pnVfrtr5w0b6h/+n
+W9f9G5dvrypLIQjHuOz83ZXFKQThEJ9jlRtksJ0sknP34SvukUkYUGEbcUBeB47WqAUoiK47Ecj4
+wAKRiQejr5hMV0xwCXWysaGhEZY0NjRgl85dvHDu3IWLvw8MvPfuwMDAwLvvDTD6rjWeEspFJ1Cj
+4EmSIDfbYaIl4fFhof5+3Sis0b/JTSiIs6UXBUmh36dj8fxJfgUwwAdFSXLsZcdZjvDlWbMc8+Gs
+2eAVFy6PfAV/aVaXAHMgnHFXpzoHggMEtBZ6adJw6RKrs9+cNFwxXFNaV27COs34jhBlEdVYI1PA
+V6l3sDKqYCs1AB/YeOjQFvxtuIvqpj4sW1uGCSzXStYCCNKwjdj35FcgmB4rsVA7HXjVPvCyLW4Y
+H9tJSYOjY/RHW5pbW57K2llQwBno6FkQr+81KqKlevKrul/cZ83KzV22MifH1/dgYdn83jnx8QKB
+FB5ENJRjG7Fb5HXgDCKYSC4aa9gbET4zNPp0/HLmVD6MvaRRqzUtrV3qXFl6pvGh2ppXDj5V3/D1
+2zpDgrHQoSZ/VUoKdik+fk5PfHy/qc8ATVKRKC93fOnubtOrX1x3cOStizWUlDI8WojtwW6TXwEH
+AByhhImdgN2mTJ1wnAoqwY6Rhyf/xH7ofBUAHGiwjdgU0tO8gAYAoEZhZ1C8DTfmOxt8g8sGtGCZ
+TMdGaUFdwubodG/odPQfn+jo49HR6E/uYGpKfR11tK4+JXVBd//xXTsrK/px+bMxSmUM/QcLygsL
+Dw/Ly6f/9jvr46oHk4zGpMFKfbyLa0te2/z4+La8FpbfMfI68AcR0xGI1TMIaovjhNmlMNCosQGF
+wZD30NNNzQw1EzUGY75jVGTu6hWjLMXVGsI9zF24c9my3DwrLdfHBvgfworj43vmxMfHxpr6GLqu
+RTmbzEiCIdkuQbIaK4TN1CPt1COwmTRTr9dSr8P4WhjPvoP/Ez9FxAAc0HFCxWtx+eS/iBjGtl2L
+t+GniEcBDrjMU6EVA2E9WrOesc874E/wYQ4OZgMAZRhPoEMhUTxE8OEH9+x5sLmsrBn+lAAd4Sbo
+mKDTf3zp1Y+ZuOq18Ce4hXnPjUkBKdd4CHhYbXN5efNDe/bAn3SGj1+99LFel0D9jxqg/gcgiML2
+4C4kQJH9Z8a9mhHKiYm4pO7s+SpQqUrtUanbqdda/f1yi0LDWq1RygMC4uKmQzcxMZWQnFNP3cAB
+Xibgcl+3xWDdBgC2E08VcMGwDUYbxc/SeGC+DdY2dQN7F8GmY9FqAMD+pN/lvmGDpU3dwL5HsDdt
+sJCpG9gtVO9bNlghANhthPf2dHlTN7AphPeODaaauoFjCPauDbYWAPwU9r6Ay31vGgbj8VNwh4DL
+fd8O7w38FMYVcLkf2GAdU/nwYbxTwOV+aIPVTuXDLQj2kQ2WNHUd+wJrE3DJqBmwswimngF7A8ES
+7OL2AmwSKxNwsf33eVdjj4e70PViAzaYFABcRMPIeHs8uA7h7bPBFAAQh1B5+pnl0TDuP6bLm+Jj
+fyG8ZBtMN3UdK6Vh2CN2eNfhJD5bwCVTGBjAQezUBPE50sFDUcwvoUoOpy840adl3exCzqIgSRJI
+f4caiQge3Gaitr11/fr162/BsSsvvYHNRj+GqNvvvAU520z46M2bk+Mm+LzppgmOmyk3OG42U6Om
+mybLq1gClWPC1t28CQCAU7emJoi55HWgBv1J+kjOvHAHsWAeL6Yd4N5Lvdr82z268SYJTxMZHoyH
+SCKCBA4anqNzlDjCjeMcFBHi46UGIRw+11nDu3JJee0KrW1fuiSIi2P+48fRHiQ+MmQvKb+9eW2S
+NnF5vyi/MPyijIZM/mqJTsWE2tZJdCqBfdZmrpgrRtGuPYRirphNbD03/IcT56nriguRqyHEMOzZ
+yC0K6Hqh9fKIyf3BxWseE8JbCiqGfgQXKqiXRIdWz9/lDde/+xmMhg5drp4xLkHRoi7oAKMS06kn
+nn3ll++6XEQqvmOaV9f3vx7ZBwABfKhBhxryDdbK1wOg0qm0Ojc6HCaXp9OK6UywPJxDiHWqQMBH
+eY8wDnTzhqpAPp2eVY7TgdIFdJwfnOu7PXZ+2PHxna/s3w/XJf0bEr+MUMepK9ELoCdM2zlWtEqd
+g23Yn/P9SzCLevprdV3FSE7OilM796R57y/5B954eItnA+Q/coD6+Qa1kPp09+L98CjsPfmBpYqa
+OmBZj0fAeTCFeox6j9JJqBKspwCm/vkH9eI43Q/6zko9eRUEo4jPGlveaTaopJgNJEwvgUxMXZQI
+FRcrdWKNUEyv9FKxTCKee4EOuXYBe+Xr5dmBDRkYTK8JzF/+NXX5hx+gw6dJli+xWIhZ3sT8U65Q
+t0y41lxh3meem1RlFnm5uZkbUgcsX5iwW0dfrhC4e4nM+5g1pGRqgvyTvA5ymNXPFnApWkVHaZME
+BmvUumg2sm80/U+sEU/rNdEokSGywrjRTNu50VyxEOPzZ8c21D99saGenLdi6dgw9V/qZ7dj35Jk
++bsvBosnf8S/0ZWU7nuuqpo/d8PISNdN6OL8zi1nfvXVDdinWODs2fFzVmdmlBQfo46rvLw+/1du
+gonDaUjQOTmZDO3DScmZWXtgYrjI46MLyTqT0K0lKcwEMHCc+gfB3IUMY3rD5Uv4MrmMj2LQqej4
+cyI+HTBLTrdaB+ke8nkiD2Ju+Pz68baH1rz80vDRttSUthGTe56xrtKQ50092mbs6dPC9e/2VvGp
+a1DgvPYVmAGjP17rfPNPrjCAZt3mQtc7m5zTSvdRLxG97g1j1Lkp8FoTwNBdcgLJFpTdSiyEjBWG
+LDd34vpk20XLf4nrlv9eJBsWLrx90IRfWbhwMsIEAAQNABAvkFdRJlmu2EPETEFaURLRijMuY2PT
+0iH9dGL0S0e8oKCaqj1gk4L6J1lceSxDqQr51/PU/p/SdLEtO7/clZVGWL6LhD/wYrJ23Ywkr3YV
+jnVtLq32cIuKLKHSTfCz9SEh5sy0dV3lMdldAE7dAYD4N3kVBFlzaks1XLmUkYZCnYik//GVgGZh
+HhDPvfB9AVT/mAfVF7DMC98VUW//UES9+S3MfBx2UNXUfhN88UDvJ2sP9v4LzjebvzvQ98mag33/
+srwFpd+YqVFqJ7DeVyDeI68CXyBlarWTxCgVYjgUMgTErcnO5l5A/8MWfg051O2vf8HcLP/94Yfz
+O/bs2XHeBBXmtWZswDzx8kvfrjVTH5qwWwd2P7gf1VXB8r4XkAEAOYQ4EPB5QKwkWMGH8QS0qIMs
+m4joaFrkn3rqPYqiVlHZ8AW4eCUMPLBU/t7jn1658vmO8+GT3xYan38+sWj26Ch84oev4QHYDjvg
+gaRkasu5s9RFSwf10uGj+LIQ/QnqPer9E3o57yL274tgago0Tk04DCFbWoZdQXtEA3sQHAAHJwQP
+nYYDHDQBwFWRV4EPCACSGTc77sl5CPnok9908SIOXsJK6L8vWcBFy8mXqNjNeNrmRcTEokXULPjn
+okV3/BYtwtosexaRdXf8iAn6HyMnWgAg5nDcgR8AKijRiXFb8hZcxRW7Wc0RLt4KvU+5w64VWafn
+nDLK+X2H3KlHh9x54sVjx4LmkU+YLBVzetsTOlLLLa+bMFNneoI468zTllN0Ha0AEGY0fwFEaqG1
+cNpY4HBxiRtXbqQr50pFJHxty2czKrj9hGTe6c9f9/glgq5fAfs9qE7c9Gl7wpFKpqpxcdaLz1hO
+Eaaygo6vTX/sLThJ78UAwKHIq8ANeNmoaM1MKKZz3uFCqNLAmosX4T/wlRewWRep25M9Fz7Cvyqf
+VNCEu/MVfnTRotuHCN1kyiKyruROXzexGAAACJA3NcENJ68CR8AHQqAAADAZK7QqJaal7Wp3D5y+
+Mq3WqoRI65WgJEsaNqyqGHLDH+js2ry5qzOysHCwoOA8/IUSUO8uv7JLErTIsht9XoDzsXDsFnXt
+pZeg+0UKdj/Y3fVwF3Wqizi5bu96X7+iO0HEz5OJ6+nvxcSA+U4H07YmAMgPkX/GC/iBUFvfmXSj
+9vML56sgPc6sO43mopK/oIz61y3qlxs3+scffmh5/6LNmzHv9g5TZmdHJk2U/15+41fKdRE2cWLL
+A8fu+BH/XHD6xSGP+NJNG0vimPorqM2cA+QNQN8qV4IMAMhgNPnQFCTplNlCSZAMVwr4dNoBjM8T
+QA8+j5tIaNRRJKRFugtH6O5P4oPUKqoWnoBDcBE8Tr1AfaDZvUjld4HKu3jmzMULZ89imuHFitLS
+eCnh6qkwNk3+c3hE32xUeLkShKuXwtjkx4G7YRc0wb1UG7WDeoWar4hT/nkIhsIUmAvzYAp1lnqB
+Ok2dJd65QC2lll30TGwdeqJVktRU3lpWcufgRTgKx14KKCltK29MlgQnN5a3lBYDAABJ05jzNJqj
+EiAHMSAFZVRk5PfMjIqBBJtRkUcrMbTtRwt4BfQQueEeIg+uC8aQXyaXEcbqxZGfPkgJf0lvrkig
+5/FFvAwS0OdNy87lG3bt2rB80Wb81rkgWXC81EP29mEP9ygckkK3uhhDRHQ89i/nukqqaRF8dUO+
+5YtF9Fh9+NKe58S7ofdOZsLDLqOfrwNHkkMtJT70CmvoD9UVS73CdR2pzZJwRkb/hm/j7CGvAk8Q
+AhYnpUFnjutsH0IS4M6bRXIc5D7HpX4eOCEWOHhhoCUU8h1lpV5BumDfUm/HIid+kSsXL3IqI0Vu
+Ra6lwuAy70CdfyjvyreXrl259q2S/sf7lvct7xfet1d+sW63Gi6x23i890RKPqPRRtOx/GyCDpfg
+KDUQTc5QOk6/9YFEKCEaTj73WUUFNVHxYfjlshsVFZYlWGTagxXUNxV7ksmr1G/QJYVKSUmBT1Bv
+Qh1VkZICL6Q80YVAF9i8dHkAkBun79FZE23TNThCFczDhy2atSRvcjP221rq80nTPnz9RuLiV7Br
+453kD6nDjDilz1UAgM5VoPMUAIIlU99x5ORVZP8wZdJaGA+lTOCrMbkGyXMPARPvk3bDWeOXYmnU
+qUGs6Nn6urVr4bm1a08/gxUMUk9/eDMuvrd1ClBfLl3a2hcfP7yYjFkLI5/P2PPwj9RPTzz++BNQ
+9BPUZjxP/WNtJbX/n7uWL++sbYpW1HWuWL7z6qmnrLHHyKs8DohiztOh83g9PA5YjdpcAQDpi/Zl
+AWQGgG+fHFiiIX0vXLgTP4y9QmHDw5D6lbxqugPMMO9DePMsvH4WGzcBAHA063ei/B7odqHKLrnH
+jLVLwldl7NpFFu3ebVm+nHp0nNrRj13s71+EaxYtmnx70SJcM/n2Ivzk5Nv0F4ADJVVMlhFy4A2C
+gRppggI2UxGdz9NNMJ2pSEoLNjSxIBPbWqaAOiiBXA7ReumhZG2deZtKO0J9OQgfv30mwslflLjs
+ndwu6g3fwuBVBsuOqFCVjuvi4YJRVBWU+KbDo++cpq5nLsTwzt638HfMk89B8bvqjvyciPh1L31f
+n9L0jHR9S1jFnYf8PdO9ltfocbxrMiaqHwAMyeNtVv6y3bNEgw9VfAl28D+T/7h4kVStteym/tyy
+ZRGautT+j+9EEhNWfUBJXrXSEs6gIlMY5CPtgM9VXrxogRctJ+m/F8mHNk+e20xeRUs/8Y87kajk
+24eYxf+OH8CBhlpIjpMvI1+SEO1V6ej4naQjFLMJgunbpLf+gaW2W8z4+5NzqfPYbxYXMn/YMgD/
+Y2nDjcNknkU5euf3ojfwI/ij1LOvWrZOvrqUYvO0PDo1QZ5lc8oj35c2WMWoYkHBco0/tP7gQDPM
+sfwyCL33wwa4nhqkDm6l/urrn1wPBdCN+i/1i/koQVF9h7FlpeVUJ7WN2kp1ZmV83NgElZCO/ayU
+ovocAMAfJ68CEgAxcrPhj79lCcUPkOFPoOdc+s4V8xzSj/n40clG7OP/j7k/AW+q6B7H8XPmbk1b
+StIkDTtN0zbsLU3TgNAFBARklUU2AdnEsreAIPuqoLihbCrWqIBYBVGhorK6IG6sCq/Ai+CKdXnV
+V6FJpv9n5i5J2rK93+fze/5omps7M2efM2fOnTsjnaxovlXiPhArg2wfPnVdi9Nl4uciW8QfTtNR
+dOxpYv6EjAttEBLIR6G2IFZ+ASC/xuOAuuoeyGnsZJ8cr9npQp/H6vI51bQ6AWea1SVgkkCUVFZO
+0h+6hLb8BvTph4JvOfb+tddBKD6xfZ6tC42lU+j5vx79S2xa2K3bGziqX+JsbIG3PTYXx1S8yDS4
+b8X5wffQp+iC2VyT/rfow3TR2yvw5aE9sb46BidVXpRYvJUAKZDOe0o2C+F4hjYnH3NSIdnBqEOP
+4vRpyVkTekyYnu1L9PHth9nSkRFrJ89pWT+2wZeLUm3WYABzcW/b0escWEF/WuugSr3ZtMOYWy1i
+p0qYihAc726YO21o1/ub1R5J/rNr23erHkkZtGn3vaOnYLMXMTirYuzsB15cM+bJ2wcJD1Sck1Iq
+juSfWINtpta9tfh0j/yZjzK6O1VeFPeKnSAGkqEFeFi0qKaTa6PMerFHFl0qtYmgShbTs/MxxycL
+bJv4JJvi8WYLHevHON5u0y7d0Wzw/CsDN/Wi//6elt/Xz+zB8cQ+pUft4CtJSQ3S2h7LSRDsYoMH
+yaAHFjUa0G/Yp/edePvXt7Y+Pu75Lv0wBps8M+H13sOo/5ZXpj2+b3whLb+t3UfkQMOlT9T/iMm4
+chsAeV228TMsG/AzWbXD4VLSrUysXP3peeyswgRMEjxWFzZbX9JrXUnvp9Y5QlMca0vWOvDKrMyY
+Bu1bxzvLp5ZMlVqOX3C3754FIwNLmOub/WOTtAauJu2bBAoFL9crLaVtyXGOs562G7uBU2ChO3Gm
+uH0OmZ9LDAnITj3MdmEe+qwuQcYe60p6ryvpnba2Dl75oYC2mfVIi2lS2kM96uDmzHhnrq8tva/7
+HbW/mPpFktTynoWjfOMWjKRlswPD6E73BWyUf8vwOXKdJu2btGrY4Uprx4DbxeaBk9PUMfTuyovy
+w/zZkg/AhHnaKchpTkce6+PhE/3VXX60TdvdmIAK92F56HO7yGeky513fl867q1QT3x8xb2/rkSC
+rdY/7cn87oNb3yielrBw8j0LO00MBTv3jn2Tuksto/uV/iyWh57u9da4Td/c9sLjgwbSyxeW/TA2
+d3bH595JSUEyYeq0Ibmzp5HkPm/fO2HC4gsjdwFCawDhgnp2lKfK6KZetS7EH2l5YSHaNhN582Z0
+bsIOy3FUEa4tLAy+WsjzzpUXlWV8DeUrTDXmdJjDn7V9Ij4UGqOVr6qh/LNweey+auVumMPKzYry
+obLPgDOI1YMZvESvL8McNdd91fLpoNM5jeP5+Krtay6fru9leZXyGVrO+yrlyiED/xJefrhG/J2u
+Vq58bLRfycs/rbH9mKuWzzDg11iuwe991fJPdPwykX6vhpfvHSOdVPd61XIblXz9XO3IMd7eHJ0W
+p9hhT6CI78bwCV2KcwsL1d0YAATWTumitbNWb8k+8oN7rjTfs0c+qbYOvR0FQ4IAADI4MSxuSwIw
+EW7HqOaydXDCs/g2lm3BnY+Hfi4N/bpnT+jtPXuEY1JhYLl4/4QJFWukwgCoQI299zh/bfmYWA6g
+PK3C12lkCBgik/oln+UgOfSnSPImjk6YuIdTysBPmKCiYvJi8EwXOLx6V4PIBWBK5aKLhiq9ESHI
+SNABABAY7PimGuxGV6W3KarijZvAxRuNQanYE/yVoSC3XQURlzvXu8LG/3rQmPm9sLx1NNo9tHhM
+KJbS1fuD0/bsEf7ZjgXYbR/+tIXOwK9KQtt3BC69j6/RHmRB6GdVC7QxfjNhQmh/KJ/9DgApJqsg
+vC8f1416rn88gNJbp0HlVsNqiuJYsDhNKD++J2jaQZ+hq/fTxFKGsoT0UakRTuz5hP7NCGJbxtkZ
+IQypTgrjmZEDAPzZoGkNx9kIXNfCyrTIRG3CmFN7rhyoGbNc37DtG8Gv7RXVWDpp7A3M9rR+Ufo9
+UYZ0o/wvXu4O7/nLfxt7/bI9mxL1vZq6A4i/sT2coAd5NGIPp+4Aynz1vtQIoMbyBF4+OPK+ukel
+6E7U9ppkv+XvVDjisvAelOy+aXrEfTf0UGzR5fFCDeVu6GF6IVyP0SFf4fWGRtHH92rkdAzVf8sX
+NXhLeD3jvqko4j7DkxhR3g6A7QUd3nO58qLYUOyUKEMX4/dFXn5beC9lXt4HND9nyuR+zsrmME0x
+GczaX3Sxv06P1eLhF1Jl6AW6gPbBbbiY3IWLcRvtE/x5Nz1DD7MJ3O5PdrNZCj1Mz+DDZC+upDND
+Heh0qZCWhZ6kb7Kugj3IROyq42V2APHqujJmknzG0RS97QhHx8+u4VPwuqjlwTBjD7lDRUwXhF7b
+E8a4e/esVatm7R7dtkePtlLhBDqTPk9L6PRCFePdtkXrK+mGxTbhr2CxfdCM776ffidIBu8isLOV
+m4KXRbUWs9ti94QpMCsyslS0xesSwQySfsAOOsOSknJ+/LEKKXTOjz/uof+mn2AOJu/ht0iv6vJD
+exY+hU9mBeM7hUmlwobFwvksOpFOyBL+uhvp26En6VvYybZ4A4bWLwrERcoXUO9j/Jw5HsKpCTy2
+BEad1dmIwp43phaXZZ7a/Nvff//28b/Tpj2HtvuxGz4xaerdFU/8Sj/GhzY+h/2wK3bDfjvexUEd
+CugA+uKb9OLUu8d8pa5bUG0LGut4fDlmY7FN9GqccU/We+S+Nbf36NHjqSVdbrNPGDtmzNgfV61a
+terH7ecwf97c6dPx0+nFBQUPoznTUWcd0876HQxHMR3IeWH7XqqHfKYTb9QDAov6hMBjcYmnrc/8
+8kTF3VMn4RPY7X60PTct7d8fM/42n8os+2RYwkQcc/dUbPgmDsNXCjrQLe/uoK/yxOKrz20MfqKt
+XbhKnMbWMgBCD/hLzhfbgAl6FMSaiBQjKCJIseYT759p/2mWpW3b1gWmGJMgS8REYtlyc0vbtp+a
+P80siO9k6kQKYgqUAqFALKjV39Sf9Inpo/QR+ojxeBfanVYXetEjyPkVl/fH0RH4fNx+8oOfbsNn
+Y/FZug0QywDEoMTO4u9eYJJjBFHCGIw1cDDMKCqEvzaYcUKjJ7Mg/vaY27G33FvpLfQmBbWGxgzF
+EfIIZYQwgsTDXU6L5EUP2tMsgslAJsXsj8Pn6Yi4/RWX1fzADPhLHq/zbaCJNZ84EeaboCKJMRgT
+zfc1sONdVqfgQTu6rM4ZkTjJD9iXjo6lo7Ev47vyKzEoHea4TQLKosSQRPAdQRCEKWIyj+mEBTKX
+OSmo1T+mP/aRucwZ52hJs6MLvZJFeMVAJx0Oi1+KAUPnjPfaAKiTq2lLmqcTHdgd1poBLaw9MPj4
+k8FpiRpqTfjCK9XbHNABil10JJwebAl9pefEg2BifQIFt+CTHJL0XHGwaFyMoJDbY+iKg0XCo3RF
+DLldEWLGsTY26CMtFw/wNpjmS3MIioBoi6gVLDogPMoAhN6KwfuC01RcNiiUlotnWDv0odVhVdLc
+aWgrFh4xqoqFRcFpeF9M6C2ODLEVFEolWhsHSorktvqsUqvIWuKZSGq5jH+R88V8sPC1fILbkLDT
+6hDk/ND2559YGAoYIu5bsnoR6x1bOqPVEBgO6kx/4bb6izxehWX1svaGkQlu6wzS5/knFhJJF2lo
+GwPV2c8bh5XAAAMgFGEXeZxQwn2pJmn+dD4Ps5Iao8JSHgn8wNKLBjfDB82ZOyhv67tb8xYWL7zz
+MrscMDCXvGdIevigQXndu+ctLF7ELtLScwGhF3aRO6p4UD8jK6U2ujkihjFf05p09K7cgQPe3Zo3
+6PKdC4sXcjyD5s4ZNFwHT94bnpue1j1v0KBFxQsZnkGDhmu6ivBfqpytLnRpcvZw3xP4Y38cLR2m
+y3kY9te9UNgu2SVAhD+y8Bye5EWvZs12btHVW4kJ++Ow/zBd9MNoadz+CN+i6otTouqLUTejpkZM
+X33ptrC6tmFfiOhjhzl/HgunRO1pjDrBVL2ZdLgmno1+30Xt9yZ0oB0V1K1SXIj9i+gxbFVES4O7
+Int+Mf0yln5ZXKXvw+SwDzGhG70sfatxSbJpaRG2oseKsL/QTeeTdKbbirFZLDYrjqA4agyqBcBS
+ni6L4Y2e9fsDGyKoaRP8FdtEEBLWWS0W23nDzt8pXoyqi2384jjDt22IGgPYuWLsmYDeqywzoupi
+LSGRHgoL+VCkXmqp507ZnbrzFUz0UFR9qUkkE5E0W1WaI9wmA6JS3pn6o43Nj539eHfcfuEdnbJg
+5/1x9DlDfqPV92VzstNdXr4a2pBiZsuCgpbTNm6cFlTCsiwYUuDfeGFjxHisyUOFYwgjJZ0/SpZt
+SbhUuGLgVhi8lgUFEYPDxgsb/QVDCvRnWypN/Ok+hlXq9KprtZujL4s/r26I4kF/KMkg7NLGCxs3
+Tps0qWVBwZACLEDJoHDStI0aioKWkxgOnV4Vh8XFSM1Kahim3YIer/pMHP+jtiaXDD+ZRP8zicFi
+OFScYRUHcLNKQNR4mQEAyVb+TKedPnCy6NCVIqSnKISt622OuvvJ4m6VbMOBzexOc4NeA4yekJPW
+YOCQla3qDqzbauWQgQ3ScnTeuw3o1cDstAs/0guPtJxvUNNsEdZbt45+v6iZIYr5LR/Rxhg2Ljgi
+xvEqI014PKfmkicWUsMr4G8lqxeSH6IHiCojDwDiyMrvpMXSV8zOW6KCbvRJVsnqENLEJJxIqDOW
+vkGw6djQ8pdKZpK5e5fE0dcQL8RdJrmXhJfrh8b9DIhnAMS90p98HbIz3L3JR3jZT7dqFIn+wHAR
+NApU3/dfAPGU1s5Qqcsi/GLwtNUv+g0WgiD6ebszlV+Je6XDvJ0uC4vHQj4ymB/oxyYGsyIEhqv4
+Kr8ST2ntnGGHIPxCY/w4UEOKTUR/EDSkKp1xcFQ6Jp7n7fQQzOl1iosM0kIthJn4rmHji1U6u8Ak
+aYCUXY3O1hEVcY9BJzkRXKm9m95Udot38HOJXdmtSEoC0UeF7DyS1YjIbn+Hyeu3rJ/cwaBg6IYT
+l05sGEps/ddP7tBh8vr+Oo4ZJzYMHbrhBIO7CpvKQ1S4VhWQAVZFskqDYkDVsBCbBmWGDlXHAgAE
+a0Nf6SXpPTBBrcgIL83rtEdHebRSXBMV6X3s91dWwozKNXK+tMbsjtkCQP1mJWYzAHKdwR54XDaJ
+X4KTn2tva0Sy8kh2ultD0QhtCSSlFcnO8eEpXweN3A4+A2PPoRpLQ3sKf/rUUlbJZ5DQUy1mtXoC
+wivwuPS3hs8XAV4LQcMkiGeKIoAb4MJECH8WRcI2ws8IKgChC+nMx0YZwMrsw+7sgr/E7RcPVCik
+L/5At/nVmL4L87fkB16PDUdWVxfpSiBvfxz5Ot6vjtmCiY87V1gdExtsXBbBzkFIV6g1bn8gT7qi
+x0HCK2o99pzYInmF5xgQ6UqFIh7YH8fHTtJZnqzShXb2uNMjT1ZLSd94P/bVx9fJKk0m1ne9Tnmy
+joh8TetiX79KV+VRPpbKAJLLwqIcwc5gZKgcaHHQUeEVrQ7rXx6L8ByHkKGLQ81R9qhcI4/ntjKA
+2YhZienHbEZ75vCVPET60+yOGYh8R/aY/tQPVdv109oNMNr1qMyS86UKreyABjOPlVX+DSDv4TD7
+q3tVxvRnJbzdjMoseTxvx2DlqTDxgNqu8it5j3SY0UL5vhkxA1mJeg4ctJAnS2B2xywFqGxjVmIW
+AMChKmVL2D2zEsP2Mm6jjbmvyPniIrDz3DZ3KIrT52BHaPCP2+dM8zkVB15We+lgkj5wXUf8PPRP
+J9IzNGvguo70SdzcKfSux/MX9zn+n31v+t9V/w5n/U3gOMaLi8ABDXgfcChON/eSPg7foWFVnIrD
+6VOcPrewa11HjBmsOs/BNI9huTxYIwAPtKPJHg8+83snPO/xiIt8b/q5a+E4VRr8w4e/y7Cz8V7F
+rfLn9jm9Tv6JoMHKUJL16zrSLGLqFHqDPMg4nKijD/1rQSfSyeN5xeBLxYbDVf7CsWhCWIJ2xovd
+aUhNLAkoHo94JTDyL4NCf2RclwCAPJ5zW1wWN1swjGXhmuJtGjGBkeIVjyegiCURsShrq/Hidfrc
+bBh5t1p9sY1KNkccjkVZW+6vHRaPxeFjqweC4Yq4pBr9WpymxvH2MGZdi8goUAwCgit1vQkzg63F
+Jh5P4JTHoEXlkPqHM11F5AbsYWn4NOqQScVhCEXXwfDhw8XbroWLzcUrT8r1xQOcV+7jFVSwNqKC
+2EZhTl15XehcFJx7TyNhaXHwHXIvuyUo2cF3ioWlje4Jzi0SOgMgrK88KS3X4HDX7UY3ZiC6UWhZ
+QxOxTGHuWXk9+E6RsPSeRsG5xRoclk+Q7uRweDLBhz7MR/ShNLeGJuQguyUo2ULn4uDcRvcIS4uC
+74CaY5Drq3DSeILBgQ5sjOhA4YEamkyvgVfNfh+T88Vn+IqfsP067c5IE84Vlwa8uVJMxeVc8ZPA
+3McIxhJc4V/pX+lX/fZj8ngVRoQtOr3OSHPMDcwVP8mtuCzF5Aa84lLxmVBlbKhSAxLuRw6A8JTc
+V19gLyW44wTS8m89VNgza9euXbtmzd+Vz77ZNFGLd76gBzGXfb7wT9Ivo+ZtDLY7TlBcXhWyFiOK
+DxQwSPm75quQ94SnoAacSf4w9IjJaLgP19XX8bD/wrNK9Z03lgs4hG34JzzB1G694R/kx7d1nPQ2
+/6DIPlqXj6kaHEtEtMj+Y/N5HUx4uqnekg7Tnv5BfnqbEcS+zSDzNcGarAVQ+KpgQH5KNP/ocaXd
+5RXuuyBsvRDceUaHILx4gfzgZ/8MLvx+EA0ZhOGpayDZR5eEy+sRXqnalhy6EBx4Qeh+xpgmDrsA
+oqGvMDwvf17HPnpc7/XYhdzqrUlnjsOQhkqfJsswPHXNJvvoEmUnCJiqthVIdQlo9qTnH9jcxMKC
+ECY0OT+whM2x/XzmLc7jxjmcviMk0ndwON0W3Zbnfnn+gud/RTb1utvvp8/F7Q8s4VMtP3bWp/bh
+OTKLocwMs1dxeJ3sJXyuMrv0deDn8nLRPibwcy6jNldo1/nSpc74WujX9Vzi6wGIoSsOI83icSse
+i8RWlDA1uYTKKk3GiPby8sDPY0R7LpNxLoTn0RodVqfd57Y7BXacA1ON93SVJvgfBpPeQRLXc8Gu
+BzD8/WGNF4vL4XNZ0vhqSxd6PeStKm2kcVWYgyp2lwB1APgMwWlxuqvzRXcyrkjimKoMrlkzBocf
+Iv3Ky0OvjiH9DDaB4AoAqTnPFdWJWPHAAbo5Apfd6XWJow8Fhx/SwTHwh8i/DlVUVAj1VWBjysuD
+DbOyAAAEAyZ7H7kBs0S3Uwfki8KAHrdQ2dlHN/g4vDERSOjmkj7ibJ+PQR0TxgOVfaNxyGDj6zA9
+bkt18BaOVqjsXIbtoqF/UNb5kDh7d6B1JPBgb/GL3cGGWQy+LvfakAQNWU7K4vL6NLgOu0tgsvHx
+ualb8YijQ7+uX6/BXr9+dKdLlzo9H7sVuzNJCXm5G1T4G3KxXXn5C4GuQl55eQQPVqgHaRHSr47H
+ajF0oamiGrYSTSsVFRWkU1WM9wvx5eXBv7KyNNv+QB4v3qPq3FjByg3bxw2dLer24pRq6sVVhwT/
+Ic1cVTMIpR/iMDcDyCnSEjBB7cj5rLrOVDoWNaMdI82p+OlA5Jw2sE1aMqbiGyDwBIBcS4djDT+N
+4OtDL0Q+ksBnKpZJDnF/1COHvhXzxkiNo2KtujyDqvY5zTKQd0VVcxo3qjhDbzOm2Ee0h0rUXkhG
+qjIItS8vF5qUl3P7M/xDXUiB5sxD5Phy2PuFRp6N5ffd6W5nmiddfV7qcCWp7/zNwPutWX2+7ZNl
+xYXGkLhQu0WXE/MF2dTkluxWpjP6BfkhP6tPn6x8w22rP/2mmKZNY17hf6Pncv21udxAdS7H6KUX
+5PFyd74+kj3nMGYHlhquZkgxc+JwKH0pbk7F5ateTztNv46lX58OPKJdCC20i2rPICLy1yybI+eH
+jvhDh42UTo6fPX+oGjkAVHtuEJWRdllmkEw/yTGyl4f97JlB1UAh+vmeIzpvFklVRF6wlZ+20qnD
+Y/7InGA1KsO81tV5dVWB7OE81xSr6HzXFDVF8V9X599TRQbsvxk1BVi6LGoKnKJl0jhaJjVRHyGb
+VxgXrxhcDOBcVMNQnZvw2M5XSaFFkSwcCXu/48Ny7KN2N9GOpXjiUjjP748aj7W2vjQLJ9ji9Iq/
+ltPtahcN/CwspC0u9Q2HN9r8XJ/PJfLISG+qoU+z+CSLIq7Uurne7d8vD5SX1/Scdz1tcekSnrjE
+6WL5o0+kk0AglkdKAnqsTqsHhWZ04b6OoW9eeQf/fueV0DdC69Af9Kx0MkQJqdDe7xlf+YOwX2rJ
+3wvLKagLbqepvuy2y7Wd9WvbX28WnypAo8TUOs3MJz498yl7F4ftXvTpmU/Nn5s/VzcrzDQW96pb
+ffFlIMCvEz1ZSQorInx7sETyV+3a3br6HktNzXxs7Jtf0n+fXPfJWf/YxXF39p60amCfSavIpDtf
+uH3RkuktGueNoofpFfo67XLqhQ8Rh56fPo/SL6bPQVG1m7kAwi6+fkhbt69+hF3BD8j60D3sw9bw
++/GyH//xq/5nbqVV2CUfMN6bsLDUiMvrsbI3RXjCojYKHkscOi3CrtCOgmeyO09b/g+6ltM78WT+
+s9ktHvii4gFcxOD+0uygBppdURP+E7qHxuBlLlMPgDSQ60OGngVNyRhBFHAMoCgKaCYoyRKYZVnq
+iSLcJ44SJJEgSLKgmE98yl7bz/i2PHLLuRjtwzawSTOhgh4UPXQ4vesd0r6UtHtR7BDY+yKjqRpu
+QPa+BrpET/AdfCHkl1yhD0ql1oG94ncVzQHhNIBklUTWr9OcdmdKKjEnpnrYlawe8JrkaITCUCyj
+XV+jp0pLsclr7HrxuQ4tEoTVwUkJLTqcKykpxSY4DJuUliye0qO/v3+PKYsBBDhN/yNZZbYOPgWa
+afDdgtvKFjElprZDq4O9/87ypkKS2+pMciTV59sPyIqKcPTQSeumreg7kCEdPnpdr1d/eAJn0x4P
+dTg+lX6e0MJLn0jldJSUNKAn26Cv/8NPciqwdpuvSugUP11sHYo5/XsMwWfwcUZZNL/s2WCWmGgm
+ootdsVfEnV5nursVksdpVyx7DZuUltJTr7Frjic4SVjN+BXHlJTSU/RFeiqS4Wr8cvgOq4O90Wcm
+YnMUVEtzep3WdIfAXuKO43sr5PhUhGMYvw/2HciQ3jV6Xa/XfnyCLse3GL/YOqFFNn3CxekQx5Q0
+wOZt6McDHnqSU0H/0+arEnzcj/Otw+gn/XsMoWPoFM4w7ydeep/slvaDFXILUszxMYL4RoL8ZmIs
+eQN22JSBpjslHCjUGhh3p1AbBlps5hPfZr0f/Nb8bRb9tjWYg+VZWcH25Y4sth1u9beMpD/27g2V
+7gvl7AuV7t0r7e9+pXv37vJO9W9V/IDO6i8uy+6KhL17Sf995PA+0n/vXnpfd3ln9+5XtL8qDDO9
+T3pG2g9J0L3AVTvObhaJ6FDiEt9U3oAdDnuiuXZ8nClGEQWCtSxWcLDdoFhHMgfLg5+yPR0tbI1O
+LIkVGpAGgtjMjnexFUFWl7sVupxoR2ce5vNnoCY0iysn9+oWl9sp1EtoGvxImJGfGte115OBGGFd
+cLy0n66+mNIouC8t5MS6ad/Txk2FDOFdYLZFWktfk+MggK3ARIpAxDrgEM1/sZ0yT5Qz8TlPS6SC
+kuPqWMJs8WtJVNeHR+4ChKxLbCWtQ1+UhL5gX6yVsJ3O9tPZ+IAfH8D+JUb7LnxfTIjcadQRAYuc
+0Pa/6/mlAVDc36ZnL1+bnj1OhUFWVgJUdiQ7+HuAiVzmp2GbuFbsx2OnWqjw3VLdLdEnCR4hTVzL
+GvagL9Z6sBZ9sQc+QGf3wGG1HqyFw4Q2O9+eeZouwXmnZ+4qu+80zqNLTjN68R7BKvjVPWmBP/vN
+yvGmsniVvatCHhx4f//+c/vTvi/92bvfpj/8ZO+dA+b2699+9uYBff/zol+lyYABiXrD03r9gF4R
+IbfyK6muPA9ioVlB3VhTjCJLcbCHvCfGxZoUWRIFiMGYOPMJ1UjeD5Ynso2FPXyJvOJOQ3HYe8Wh
+1zrS/fPqYn0Eed7IK7uF4pZ9RoXUfYc4fGUhW7sHxfhYohzzVcT9KWa3cghu1+8DgbNwVlwlsH1V
+0wCQb7OibpeJKenuBNSHzST2aow9hb0Y4xHqtl42YOzCmYP7LsH93Vf3ue3pEQ+8s3n5iDW3d9p4
+d7MlPbOW3Uo2DBq07N6xy5fS8hmrHpx//qVtp+asWrlkzbIXBw9Wx7uzcFb6QlD3c82ATgWpJmdt
+e8v6EJ9aR6wtuJ3Ca/W31X7N+WpmPKTWSZQHNEsc0CjTzLZ3tbRt21brQOXmfawLJbZVx3r9TR3m
+qyM4cVRhQ7dGYXXWsv4qHx8ZHD0Zxc2y9zYvH7GaZAwe/MC9Y5cva6txlRhm6cKm176aAYj14RNp
+vdQNJDaesBBUWh9Y7BfOfCLOD6ZWLUcP8nLhkD+4T+iv1YHTlW1wjvwsCDwbYsE5fvnZy08rYwEQ
+Xq9sI7jkZ9WYwsMOUWdvULpxvX9G8Y/+GcVCLeGxUOKM4h+DU8hvM4rVflzZhpzm8BQVIvuPnPa/
+q8GNgC3eLT8LVg12GL6KY4X/Xf/Y4lP8Lxku5gdHv0u+Ce0fW3wqsE/Y+G6oESkYWwyICoB4QiwF
+GYCx6DIhxuC9FTj+ID1Hz4qlgf7CzqDqNyPrsjf5POjCmIPowpSDdF2FWBrsLuwMsHHxFIB0RhJV
+2lyW7MTUZDHRzq5aYXIC4qyIMf4cDZ5bTBaH5pPF+hjJrhefW7z4HDBYlT9IZ8SfdT5tRExOJV52
+1QiT81BqHzF8nkPx3GJyL4Omxxfs2oCVQVcJFXICpIGrIDF5J9TbVWunVXkrPXaQo+EgMd18pvwM
+c6/BT8uDn5ZnYpY1x1cvbHrMuacIshIbYZmNEH2FCQTj9xzuM6bA16xNvGwyT0gQRct77/ce2ynX
+mVk7NpZWkO0T2xIB3+3fISc/3VW7a+ip/ZOpk47q16FNfsMGif3YflV0lfycnGB2x3xPXgIwyzHq
+uS4ZdJWpk3pf6g4QXQ5EHSfE2Zrfj/DbktPuJCf1PZGpyN3+X5qv/tHPYyp4SGvL35KJbG0OX7MB
+RNQ3ie6hfQfeFYqCjxjwQg3VbU9JVz9IUXBToCW0ZusMaoZ9dZSnq2AUSquREMWSToJwb3WaKivh
+NDzMaDK7TW4M0O7qN7ZMlGN+0caCc9LXgpvbmV3m22e6UhRvDt8605Mlfb1357BNjlfHvvmafiG4
+V7+Wb+r8FP9bFYaVr3xkK8nt6a6U2sg2Azz92ptjX3VsGrZzr3EhuJ/qbMp/bTX/q+oT60tfCxtB
+4KvTnXandI3dPHkEIJHRb901bJMjNmn1iGGvDh77xl0jX4uNsz1+97DtwsaSDauH9M83iTFtu/VY
+PeSOjqJgattNxTNO+lr4CQRopOLRdg0lV8Uy6q3hI1+LdVZDQuQSsmT14H4dxXgVSQe+h6jBy0/Q
+ANrAxIK2Wa1zMlJdTZxJ9nrWRjkZLZpAcyfWs5rjxYYZ9V4Qm7yQkSPGv2DNecHZNqt1qivJLjeE
+Os1rO9qa/2pfnsXH1hPlJ4Lvm0/8av6VjSJsBOH7OaiXxidT5UXd7qAaO9ZrCFT6msuydkwNwhyk
+CiCuegkZw4QsC9FSxqNcKGJMlFR4v2Oylzrp7+RV7bXsv3CkRSVV/BVU3Kv33pLLJQAinK7cr8Fx
+8L18IHKPdMs1oVaH3kUDLu6IwnJdPJHU2zU84hEdftCkX/0vePAqeCw3gMeQVmCfdiE8rssNWysT
+hONhPNaryA01PKerig0f09EIvTTo9HntgjhVhmrAg9fBI4+siqhi9nURsdjaLX0tngUrtC9w1oq3
+xQokUS4SISG2yBxjS0yoFR8jCiTGJNWpDQ5bRG9qX57FPvvUXmN1orGGBLVVF6cFEWHea5hBux4k
+c4IVlM57jZ7EnQdDDwgbMX/c6ZLLJXQ/++LjyR7pa7ELj13iVK9i0T6ndTbYhxwrIbaSUJkx7xC3
+gAyxkF5gU3MXgqwUmTCGQB1FdMRxgrNYIqOcJTQyTYRvBM5849eBb8TGYcAiod/QC5tw6lacEvjr
+GYiAL6jwWcAuSjESFGGsDHViRMLhty+PgJ9md3otXrbbOEpfa4AbP/PMM8K4TfTxrfSxTdgItDmN
+OueyVst3JKCZZTrIwtBCIxJZGFrII5GSqtFPVVhVcgl5aG6FwuDQQrLQiJAWEhWWOKZq+MN5pv+R
+vpbZrlSp0ZkSoqdKmIaT3OhihDqSHEkqqVmZY9fd7XI+yek9MHpdL7TQt+jz9h3Y+8HF5xZ36DjZ
+XlKi0EttMK62dSA2CSdIgnmkubCesTNlWn58JA12bvcOtvmcypGauGA8OtDDmEt3pwul6/oPvuNJ
+zhs9PHpdr2e/whGhw2TqFPpSkDE6pbNsbfOjPXMgPRVOUgjvl7wc7MzZ7tBUjZ0Lpa+lDSCAA8DH
+eKxBK+qseXGNqon346fVtFMj3OoaUuEurFFN8X7psWqKIkZsa+Lrmu36chyphjhX3Ebr3BXHljHE
+3UVmVIVFjvt/wbax2PaXwpqD3zCuuKp+KK0GbOFxgoyvhmux5n6Sa0ZVDRfPHPClEJaaInghlp7A
+FiXYgp7AJ6oiQxmb+7E5PemnJ3F0zQjVvlMuiaAAeDSHc1qyBh4Vp0liSbB9CUTX0fO7UnnFL+K0
+wKOSWBFkxgS6DZVzXcepuq6LOkTmZDjUeD+20CHX2EbHoLVR0cT75UwdkT5Hsqk7LKub65xSYUq2
+inKxKPAIQJV6TbXN+KQzKhjJFnhELALJmG+p777yXTsSzXzSZWbCFpwWvt8KWpw8IkIn38IyBy0s
+TepIOqVbvCZ8CR8a0GNKhxakIT6U0KLDlB6YQ2fwO6GLdIZ650Rkg9JS4saHElqyAh+d0b/HlA4t
+Q9/QGfwOeyGT31FzCGFauWeIpBN5fjg5x5eTxjOnybIiV6VNeD3YnaVNWZ9vERwk7GQ/aPBchxZV
+ScJlLJO6eEqPv7VvdV+1MH4bf2M3I5oGwtyhCWXFZ2IBYrIiSyb21q7ZnS6YWJCY7MupSlN8h/ha
+aKeXasWtRAc6Em1TaHCKjTxCf0RHrfgOHeIW0J/oT4m2KShOsVWT3CBWORGTsI7Vuo7+hI5acbzJ
+j/SS1iRUjEn0EoMUX1WGSeCsgX5OuSxxcvPQ7E6vTnFcLfJconUKDZ4jP4XuTrROYb2tQ1yN1FkT
+yXO14jos7h66m32dQ3GKtQodDnBFe5VUM+/cZqZFiKQvHNe+qHZx7mXpuSoUGl7mHeaDtZqhP6Kp
+AxANGlgekO9FWC0X2AqvQotQXiVH+ME1KYpOIAauSVdYNhpVNeQ8r0KV+BPbSK4H/YvlQv9iHerj
+HhjPcqHxwa+vRaAgL31g5mb6Ft6+eeay5fdtxtvpW5tnX5PMCPkJoICV+2t9rnA1DUpka2gg+6y6
+JjUTS54swYnXRh8hJwFq82doTrsz/ZqYr4mVHPcfvxZKKYpfbf7FnGqaRd1FQnBa2NIsyylJDLWi
+U0kmO8CrhB3cVYKPsYHCj4/Safgoac3+sg8IVWByeMhgOi3OU5IY2CjsCxawD2uvNvTjo1G0yGCC
+eKit0eI08YMlTGjBU5IYrE3O0cdpMk7Cb0KpOBnP08dVSibj+ZALJ+M39AmaTM7Qx6vIU+Mtahal
+8ha8bAzw4zS69A5HBzNS9ZhUjpFO8jFKHVhdp/3iqkCxXzoZuscfGhddB7WnBHKMP1AsrmKPEMkG
+P1lvwHpVWqyfKMCqnpZOMCj3SIv9gWTxPFSt59HihtNkvZ+s94vnA8l+vY6wvSpdxBv6JJouXidO
+r2OPqKfVpRn+X/20VRSv1eozfjWeaZL/XT+1689beH1Bze66LE6NEpzjx+F+ISN41M8koFOk5tv0
+Nor6lEbdUy+iJQHWmB2wozWOAMBxKuP0PYAiVpe62K6fxBcm9d0wsSoITbaVNjlG3g+1VR7RgXym
+h5xP/BlfXE3n4O+hD+jQ1biM3s2lKSzrFNzrD97fSegYGgfEgMGeeUdAUQxI6HPaT/tJe+y3mn6G
+G2nSnaRDqJi+uho99A0/aTeI1mbEkS87hSb73/WHmnYiq6mdrHr3XVVGGnwBrJBkSNajYhHsLsVd
+H32akFcZFP916+TVfn+HDrrAVKpD/320vBMOxaEP6/kFmzKOw7ZDHWhgQI/iwefyWV1pPqddxYGb
+OCMkO7TwTvJV8Cx9dXWv6f5du1AYRF9S0UUxs2UUS72/y21kMSwWF4oLub7BpGARmjGB/k4JUgKU
+kMX0d0xAM/0DKQVKVB2Rjvit0APiIHGX6W52ACA7AVB/6hfRk42cBPlI67kc5xMA0krxfRDBxHq/
+CZkbMuETZC6ZG9gilNFUmkpKSGroTGikH8/iGu28J/18KEnvn/xkqL2yrfBK18jzoCS9n5Mpe+mv
+su3yObmssEp73mu371UPlbrStbBqez4hIFPYKVJ76R+FchnjGxtLXwvDeTl3D9LXgY0lwnA/HU3y
+gYCMRLRLiWrPsaZKxCqmJWKiIKYRa+rDuAgXrVhBF9AFK+h/ysqIQmLKykKXceWKFbgQF69cSeeH
+LuNfpMHrr9P40HevAwDI8A+gYpKtIEBraAddoDcMZtRZnBbtXLmcdvxtU292TjJ73dSri59tv6vr
+IRe11Sq2JI/VeH7lZY+1+PFHFn56HasiTGTHlr1Ng2VlC7ZuXVBWhuLb2OTtxUt27Vq4devCXbuW
+LH6bdhrVudOoUZ03beo8il2RPps7jbp75ahRK+8e1YnMxCa009aFu8qWLNyNTXYvWlymtixbvGg3
+PbV74RL2m/TZ3Pnuu1eOCm0f1anzqFGdyD+jVt59d+eQaXMnDlLzBRHyVp99fV1BS8RR2Jjk6+87
+bqMgVCgT1dWG2RHPQtzawxBiz7TgNkF7+tG8QZ3assk83hJDQYpJXaQ/8bCa09kjD3qcVKzjuJvR
+emSGskKFa4t4oOJgEwBPljXRm2khM6rDVVZU/Dd1UShEOxlwyQRsSST17K9SAKW/dEjPPnHv5mT5
+j1LSkWzAT0PvhsbRHOEv8maoh3So4tMnpewnn4TmMAsgziad1OLYDGgHt0E/GAET4D5YAo/C07AZ
+3oC98Al8BRfhNwigglZsjM3Rh7dibxyK92AxLsCHcC2+gNvwHfwIj+O3+DsGSQyTb5bdYnM5U7yW
+bIfF5nFmeS3ZLmcK2m3NMSUXs9thliPiOi3iWrpKnavdv5Fr6Sq4HP8H1+6Ia+X/4Nr9f0z/1eSm
+MJWqv1zsj8d6tUa81JnVEG0uHBBz4ezZfxqdXd095kK3bv806o5CWve0tO5p/067PTX19jTsrv6k
+XdTvs+oXvh31s8YvfCQtkJYWSLt8a02lZ64PIPUaZWf+D9ppX9go7aE09n9a2t/NUtPSnowSyJWX
+0tJEMS1NOnk28N3Zs2L9s2cD33XvLtbvHmzQLS2tW7paexf/e7P/7/o/rv/m/0SV+v/XafRcWtpT
+yWnd0kJdI+5r4lF9eD3aSimAz9Wsk0XLtVuc9XCXUIa76E+BGPrT5yza8LM/apvtoaDQR3mCtUGX
+12xNtHpAIBYsPliye1MJDQ5ZEApeEtbiBZKJQuhPujz0a/AXtR0WKyv4eWEei2v7wYPKir+LNZjU
+JvRRVnCYdgbSRQRi8WLxgtWflBx5rERZcSn4Er2T2uhY7Iy/knQh61JVWrxmgaAn0ZposZNVC4bQ
+YMmm3SWMFCtJxNkkgYZCR2ij4L2XAMkq3m4FiGoskin04cSYVlWhxWM3C0RwMaBk1cGSx46UfLJ6
+ASPms9DXlL3a8zSW48vC0DAtk9SzT5kksfggKaGuUNA07eAN8i/pK6+wGM+GRior/ul10DQDoCp8
+dS3VKuoiJaFgzGsH/36U19H4uh8E9X1ssurgQeX+vxdH4Lift+cHwZBVB0Mj8axy/98bDgKSF0KX
+pEHKJP5uk8vCDqFjGW9Ftgt37Z4yezda6Q9jO7UNXVJm/PvxjdvH3cneOd8SCgp3Kcv5GxNJjqS6
+6LKY3elpbq8n2ZdTFz0WsyKTJ29pNnb35NtpIDcXhZ6Td49tQvYn566mt4Z+JPG4a0QHEd/pPBrf
+IPGhH2nXde0ZLdQmDVKWc1os7FAg/ggi3WsR7mrbaSzWpb+UzZmiLL/85IaB47ZvfPQbZYzK/wuh
+v4S7lIc5/y6LR7hr9+7QXyY1ZtgSuiTcpfHHj3rjCyKT7BbyQttOY+kPaN09e0rokjJv/Z3jtm98
+/N+XH9JhBqVBGo/p7nTOlSPJ6bEroiJzjn05wsT2TcbuntwThdxcGrh98u6xzch+Z/t1WEbqhP6i
+PUd3fgfFDiNot9BfpA6+tzqX0UNtXHa1uD7YZnGMIF+Ol7ywe8qcMvoL1h3bqa2y/PIz3zy6cfu4
+gRvUeIrHPYK6lgW3fUjBtOLv+wAQRwCI0+UEdU9wvmrM4hGnl1AnXzNWMkRq2bLPqMBi8V9AYDeA
+fFJ8HySOPdOZiZnsryXTmSmfvPKdUEtIuPKdXJ+F+YH2fA0xwhO0lbQSPgcFwKce6eJ8okQoKwnE
+cA8RGsn2YCWF4ndCEqNP8DrtNvEKKdTOFa8sJzbxO2EiL7M4LazMhoPoFibjOhRImbJI54vUKaFg
+WvT3AsbXIQpCjF7mtOAhLNAKBWhPCsWnOD5F9QFOu1P7CEXoCnVUP4wI9g+At7GJTwkTjTYWp/Ff
+VBtOnP4BgdShk0iZYg2381gE7UPqlLB/dBIpDK0RGoX/Agh4iE4SYiLbqbkdg5d92qd6Y7EKf/wc
+J4/dE/Fp/+4s9t97OodaTi6ax9rqjCryP6NdFJfI1QEiqUMnkzIlMdw+ktsIjktKpKnop8MvU/ZX
+LGV/Q1vZXz5fxkN0shATDSeC+6oS2IcFVwXHbYTbluEjSR3yWeg4BSmpNLBG7atqnYlaHUtEnYl0
+S6AcNHuKhoOHhIbUFQkHD1WFY9Qx4FSWM5sVkiL8cZ3QCfIpBbGwtOISRNSJokerY8NBFU/qdYSY
+SDh4iKYKDarCEWKi6dHqGHBIHdb3lEVqHTZ/JnVKSEtSKDkCT5XycltUuUUtt0mTAj/TLRrfkTBY
+OMD0g2d1IFodW1Qdi1YnDIj7Ad6fJe4H+DO7FiWksFScUPGT5guMcotRbsNBor1itdpeiNHK2XMw
+iw3PYIEOgLc3yi3hch1A2BaURaDo8tA4ZuZgWhR4SlV29XqW6Ho/qwrXbEJZBCY976ZC7MOYZ3VF
+VUiBNVXrejTseKhPCWmpWpo4QSMgXFfRZa5LlLoiCK0JvyUav6YAbp410mCJomGiaNeqV6fBUoUG
+rZ5qz0Y9rllu0S1KuLmKE5jLrlbPEl2Pa+nvBVo9IUan0ck17eI1mTr7aDArfgqsqaku44n1A9Ki
+RK8aeIr1mHBdJQyX1WRQIwitCtNSHb9mUZpMq9evQgOvHvi54slqNFiq0KALgdfj/kin1a56CfGK
+alSlmp1q9SYauO16TfKpaqwM5sDSwJpqdb2qBFQNqP5QLCwdGNYprxtBZwR+Pj4Eymumk/EjXqGu
+69Op+ivVnq5Hpyol1d9em84I/BF0aj5M0X2P0869S0uiWkipHouoelT9j9Ou1wodV91I4KnSgQBV
+6qrwLE6vVtejwvyZbomuqxhwLRpuzTLC/jGSRiYl7sHwrArwqVKohlvl2W7UpK5IQqvR6VTpVOsG
+L0YSWo1Ow4PiWQ0mJ1STubLIeEKhyZOUlYROaDINrBEj5BpZ36lLVu/7YTPlVEfai9FGl3CVNjr1
+gfIa2lhqbmNwEiiv3sbrNDy42tvxbBjRNWnTrJnjoa4qTa6OR+/7eLZao3CbWhHxqJ29jhSmjrr6
+RMhuYKC8WjuX1n+0cYGmRrBlGxghwBrw8VNGwpz1CfdUJsKBNdPpZa9PeSI8W5+wSAaGRV+TvsJ+
+JpLICIVdVcfRsr+mji1XkX2kAdasL0vN+romHstV8KiNSFQsz3de83id9vb4+2z8XY3egUTF7WFv
+/BL+HtiMv4djdQAgpA7dR8rk/XrdiLhcmCgMCL4S6C/0D5aq+1kcovuEmHDd6Ng7qjqpLMc94nfC
+AbWuwM+N5TO5gCJeEZJKS+kWNskgOIuCMEhZpMNU5xU4i82D+PRMm79pMfkBzcfb9XGLjUNqR5UO
+lJaWBjsCIM7SxhhT+A00lwVnCZNocUmJFqGLhYE1Gn4OVwqfWq/1zlnCpJCHWUgxB15xSSxU4Wvx
++IGI8UaP21uocbmwp7S0tCJPqysMEpJ0f6q+R2MTJpaUsEiWAWW0gHpWCK8bpkW1Iga7LJQtTKTJ
+dDppwemvyBP28LGP1ME9ur/W4lO7FrMzweAe6WCwgzqfQ5xFCiPrqhEllzWuUoNzfdAgOEuDK+mz
+VKcdda+Nq4KrS8hnQpI4IdihlG6RDlb8xP027uHxmsqr16nH7NydIpOJsLciVx+TCoVBRl2NGhs+
+rMqF0aFG+aDakjBIp0WtjSpsfJgNIZ+WCEmlwt7AU3RLqeSoyDXsRe9bngiL0SSjxuVcNsGOuh1w
+O4yLlI7TosmHWYFqj7q/FYw2krqjRlhKaktdUtyGNGFVXGJANEuKjHHjIm3JE7amFiWaOQl7/16g
+2wgfc+MiI1jWZ+h0Lj4jNFW7DghGG0mdPasSFIy2XOrMtEp0OVZc4ualAiBazHQgAieTi9dp1yO8
+ULZ4RSUz2FFKKi2NiPNUjRk7N9jEK7RY1bHWCcVCnUbNX5g0Gu2RY6VNvBJczWlUhVlaqtGoilLz
+N7r+NH+jRm3M5/B2zAJxUKmwN9ihNCp+iou0QRYZPax1Cc0O1T4hROAI0xjZjmHS2hq4WHsDn2Yv
+1mhdh23T0HnYRjXd64ZaFU4k3YaMNRp0QRtMhAUuGjCYTVj4u7Z62t7rtERyRcq4C+S+J3RCBw1c
+7lwDUlIUmxE5Fm4t2Ynqm/d2C6mzdSvOpg8MHkpBmPoSYun8ucHHInIgan01pcoyqnWGDqYP4qwt
+paz+/LmldEvwsci8gKq3rBy+iFd22XDW4KElpVtIYen8ucK04KNbKETlNBh8Z0o6r57kcYrf0Qe2
+lJYMHYyzSSHdwlrMnc/gb6Y2qZkyiecY0YODxaM7AhnUZhr892aoUo4u9EjNdgQydrDyiDKGi50M
+ZFLfUJSa7Qj9HvrPDnJvaO0OUpuYd4TWUZsIQRBLxVeDIEKgn5HzGB+R+yRzjdwnqUP2Vclvkn3i
+/kA+KxsfzpnynjmezNMaanlRsi8qLyruCxSo+KxkvJ5PlSwMnw0HId3CczT7quZMNYQMn5GHZWUc
+n5qI1XKtZF9kOw1hOH+raGMEnwU9xsRC+CRtYESuNjIiONSHT405+kBBdC5YnX/Y1dmXCotPDqvB
+cmkz8z5YEAWMnQNG9sntlIVmxXQUQNyn9zO5XWW+kWuWe1PAfLrfKIPCqDI1V6uV4VNRZcJTwQlG
+GdkXVWbogpUJ5VFlUnmFzSiTHFFliuPyT0aZ3DGqLKbjP3uMMmVRVJkWU7EyaVpEmbQsqkzcHFEm
+vhNVJpyNKBP+E11mjSxzR5WRbhFl5K6oMlwQUYaro8rgLb3MY7F9rBdVVgpWCvItyiKzYjotuIH9
+MwNQmzSK9099baVDO2PeDc0hAzzgg3aQH94XsOq39QZ/C9YPgk3eF05/EHR/QHqzvx+Eb1S9lB87
+e/bsWXr43Llz5y7vZteYw//6+F8vu48+/pffBwBowniR3crE6/JyK9wGt0Nv6Ad3wlAYAaPhHpgA
+U6AY7oM5sACWwAPwEDwKq2EtPA3PAVhdXo8j4hmA02N3pUV8JHYSNduD0e7ysk00W1f59jH+Iz6t
+PXaXPeLz/1r+/vvvf8D/BUZ98AGuDH/Y7/d5qf652X/y/F+1f7+r/377/fffqPM39d/v2jf++7ff
+8Jz6/btx/4bvQqymu2g79EJbyIUOwA6+cqV5vC79W79Gi8frivjwGZ7F5eVb/NnR7vH6tJqCVRVI
+sDwsHHZN73uf//uA9GJW+UHodV6kFu48O+Ts2bODzg0+d+5cRTfMOYuPnsNH2d+zZ//ezOxOLj97
+dsi5c+dA9YtyR+Y/zIrpc4CYjuyeNM3oc2ekZRDOadoi/JrQRPNrWh9tR/aZFdNFtY+q/lawcX97
+MdrfKgu1tWDsRMh2V14XmnAXslD302ods1uFddX2rLlFbhc8HTylLAwUBApMC6vWETgOm9w7eCp4
+Wln490IOhtFrjAUXBbe4rzpecZ9ZNl2sBk/NgAlulXdx398LuWxgd6Ugn5TSQABwWJyW3XL9oJx8
+5TwAkIgyBYDtPcmgYAvJV/FxkVx/pdS+4o5qdWvzd19tnkxnljfTku3KdKbYM4VMi9mZ6Uy2aN/Y
+4lthyrffBh//tggHClNwIN0afJxuHfLtt8L0b78NPixM57ceplsZfzpsc7rpVy7Vf9ACAHIUfboN
+cy7StAA28r/dcv2KOxnpVT+c36LtRTvUzzXhshMsq3x2y/XpN9u30zM7dtBz4W8OdbrUpuJQ9Aeg
+Xo3wb9Rv8r6pfzC6O7osNdy72d/8HouaeuzYganbt2PKjh3BDa8Z/zB5h/aPlar/MEW9w+69avwL
+iHL9B3kb4cxrtNUO2mI7bbGDtor6Scquf01bvUbPcUuLiZAdexs2AWx8lXJjVT9o9wh2vtGjj33Y
+no/ax+Hl+r9wAZcGQosEZyDwrbhndqCjX2wQ/LefLKBz/Re4yrD/BbIgSOeJDYLBf6PUfkGgIy4Q
+3wt8GxSSQ4uCuOTCBYDr0ZHm8UoMrUP9sFcWtY+P20vphdCiAC4NfBsQnBUfz+aUiA0CwX+TBQE6
+94JKSdGFC7gkGFqEgjMY+BbFPQsCtz4vNgief54soPOeZ2RE2JIIJkiARL4XfTKkQhO+p4Tu0bsY
+djQM7oaxUMhHX0CHz+2yOHxuhV+xP0LUr4iPt+oN9eNRq3urlHjUNuFfksPjdfOIu0XWXO/srPnz
+s7D3/PneJyidP3c+Zb9JOf8dmj9vHn1r3rz59K358+cLK+a1nk1Dc+fPpW+wP2/NmzuXvsV/zps3
+txLuz56bJT44v3UWfXP+fNZk7ny6k1294V2dPZ+8My8ri741nwHLXu2dj42z78+qWCHXFz2t52aH
+vs2aP781IyObVs6fOz/4aev581ozMrJpSCVg3jwODcvntl6gUvHmvPlz6c65OhVvzp03jy7Kntua
+/DI/a33rCCoY9fTN7Oz52Gh+6/WtGeWMiuz587MXtNbsGWE3JIk7xEQQAHzM/wqXk4RgUFR9qlGm
+8N13NP8r2ILlRcLlB4U6wZlaXQZng3xSeAQEtSZb4yLX30D+UuHoZVpulPlyeXTFx9LLrBbGE3Po
+9yLylzoeyMKrUooxHpA/K+QGV77VfLxeFjUekNqh/xSRPx+U2lQM1OoiyLBBdur0oFNcF5iMK8XH
+OUUMVrhcATChEyVWaxSag/3ob8K3au0IyjjMSkF2SmlAANj7h2wNjzZYkYgyCcDhNAmvix+Eng3+
+ySqtFA9XvK/JW4YkgYqJDIbPhDIGMagJnESUSQA+BoP46TYqskoPkk3BH3nFaDosKPMhM/i7xlcU
+HSYULDhL/CDQrkiufwcZR8ZVrNTrRtNiQZmrnhJdPpG0qHCIPzS8SLi8H/thv+A2vS6HIzvFRF4z
+05KJmbJcXwVFIsrYCki+CkrgdWaJDwemF8n1o6BF8WZ1ct7+kFK1MT6KN6sJ05z4OhlFRlc8xqqJ
+OwI54uGiK+d1mnTeGBzhMhXFRM2uo3jT4GBf7Bt8g1UTSkJDyKYiza4j6UGLLNcnNsFcIz3sfTDL
+JfGDijtZpdAG8dPQBq1qFXrQIguXiQ1pjfSocIg/eD+rRF8lm+irWtVoWVszMdOSyUnSy6vIG3kN
+7a9LfFglLQJkmD9Fhcf+Uw37D8Zo8PeqdqW+26HV1P/bSGaQmaGVwT/1lqEHySwyK/SgDkGIau8A
+0FtW+y8PT+JJ2jz0UFWQ+BVtSpviV1VBgwBTaaX8kNQUakF9aAqQalaPhzWz08DcYJbY7sep5jS2
+WRCY01iZaJZYGS6m8xcsnE/p/IUL5tOx2POlTZtfwp70jZc2b3qJ5uEDBw++f5DtdHfw/YMHfyPd
+n+je/YnQzie6dxdyQmTfyJH7CN03cmSgUlh6Ydq0C8G5F6ZN47KiLuFXeQb3LSkFFiJDjCjHyKJA
+EGRJidH2/OSHjCa2zTQpWEEXsNdj6AL8i8bTeGlR8IvgR0JbMS3wdeC42BJIFI+QeA2evrs2F8Lq
+q1MOEANFlSivkNzGexZt4VboCXcCpLH30DMV9nZ6psTeTc90sDfWM638jo+XpvE7bl5qTcpiVVLc
+mZiTzSrYlExMT2HFWY5MlG0MWLYvE4c1b9eqTesubW/3Cv9q0yo7a0y7zm1ve6bFLa3aZN7WtntO
+ML1Nq3bNx7Tu3bbLLz029EgfsW+k0N1snmyzhZ7vsaGHe+S+EcEy/vvR9q3TkwubZOZ2n5Gc3qJD
+bq9bMpuI7sibrdvnde6Y2aTiRFLS5Pr1yYhuJd3cQw8PFbrz36Hntd8AcoS8WWycxqNiyImStNXC
+mFbf2zc70MJUYkl3pTj4q/uWdHe6w5Jjnhot/zhH3Q4dOnSou+KVV3BNi+YtW7Ro2fzvDgX1HI56
+BRe33hGhD/GDwJ7atdMc4t+OtNqTXg+deV0cWnFEUaRMJTUQ60irbRYLzLXT/MG5r5MUABCr9AMf
+AJgdSeGTk8kN9wuyvqht21vaFhW1vaVt21+2D7pz0KDt2wcNunMQdVzbusg6ermj09kRYzo6nXQK
+rhnr8YylhWM9HrLtWnaHMLVSlB+SkiEu+l35qUdxAr6AE44epU/Ru+hT2AH9OOHYMfoUHU6fOnaM
++6ZwW1t0a0njL7MqGNJe56EqwLtVEq8NF1MUkYmvBribXqJvMBlVhSueHbmPSeDacFVliEJVuOJJ
++samTS9tom9iVcAkDxcwwdJF16ZZSFT1ba0Kexhij00vbX4Je9QAmy5isHFBFdj1apZHli+VLXC+
+ulSY1EP3XE02KqJIPNbqeK6OgKyrCTCXC7K+IcyTmkKM1oszLSlu3jUPHuSd7sqX09Q4llYKH6n1
+gHWGTEuWQ/iIzmYVhS+ZwcrNpvFYtFzaIDfhcy9A/fXBFJlPXbG0ovSVufNfpW+L50nFvNLSeXNf
+eSU4U25/5X0AAXZTUExyE95PG7PxKrLvRfdLBg67XbvT0RFy76d4v1KlKCLvVxd4H7vSNabJP6fU
+MXw3sXG88WAHYG/QuzR7szRFjYbdcu96GtTZ0qaKL7Dnppc2bcKexCbaAuV4gMGleTh//8iR+1WY
+jBdi02F6dDPwWNCpMpS6u55Ob72KAqm1ZslvsOUbNI8BxAOBctFGF6tAEWRiE2xSU/6GINeVnetd
+ZmC4om2MkJmGbll9q1Y/yyFaMjlfmfLBg/jADPrGS5ukppzqBbxfI8iU5bFsvH6mlxutxyzJ8qaX
+sOcMOvsgrZzJTJERZ9S3qfUxxa3WTvJkClYmpRlMObSSccE6C7c1YuN+2KrRH6aJ82GMCDM0fWoM
+6TRG2qz8ELFxOJmGZDM9mlynztDtYoauMmLTO6wq2erzFh7D6im2q8wlaixzqmXVYnQGj0foBD14
+VFgmOiEW7FF9QtHfyU1Jx8krD61cMXrsQ+1enT/3ldKKUlIxZuXKMaNXrBg995VX5s7ja3TCuY04
+LZ+hvTnBsoN/kGdCY9QEhdQeAK5Tv+JO8nRo7NXqmyLraxm669ZFHXI4e8dqC1F0WKMpwTBFoeZk
+dOjZUAsyKrRRb13xvoovHCfHQi0wR+zXwjagMwlkwZ15eQMHzhtIs8LTSyzMGziQ3abdjYlm9fmJ
+5DQJgpWM1lutvEZdh9NiIq+TUdVqsjgUYpbKTXh8CIlmTEr2gRnTkxUwY2qyBGYUk9P+gx9cwPcv
+4Ae03QXa/kJoN5FQICIKRAoFaCgUpCGxUaCcBpYsQUm0obRkScWz4hZstmYN/TIwiH65Zk2kPCX1
+tFLJadHn/E6Lk+WBmRiLuI5HhTaulNqHmoaaMkKFbmRs9fZotE/j7ckJMrriY6M96RlqqqeUi8jY
+a+HX2o8iJ3l7VaMryVdkbLj5DdHfPLQxov1Y8lXFHRH0h8dBCzSqMhLKiig4pURjrHBbqg2KwooV
+9FMHuSv0ggOzVTdO31ixstog+a+E3jtoaREt3TFy5H4S3N87IdQcIAp/nar4pRx9hNLIcFTHP2Pl
+CuypeftPHeSLUGsHZq9YURX/8ITeDOvIkRoRvRPIaLgefmJmKJ1EHSRzfKlWZ/W4pueKFeSIg37K
+KMClK1dgtiPkr4peeDck7u+dQE5i/x0jRwbSEnrvwP43hJ9HU2zfLiQ1yX+YNnZmO0KZK1YIFxz0
+0xUra8Q+cuQO7E9OJvQOVWD/Hb0TQs/W0CfZme4Wp541SRafYKZSQz11Nwl9ti/uCEwWnyiqMUel
+5oZUeCvFJyqMXNbuytnSJXFb1Tyb2CPwZpGUaRfvCLSXcioOa89v9Lpqvk7KfEArY3DOSini7dXy
+h0uDc4vE8b2FlUFRnBx4QoOj11XhiONdWlk1P8byIzp/EXSb4BUAeSHfcyYerPxMhiy4BTpAN7h1
+N0+UC83LQDCXQacjZSCby6DrESgDyCgDklEGXTLKoGvGbkgFN6+Yai6D5kfKwG0ug4wjma3R47Ao
+LrdFctgVd5pDcaW7vVJWY7Tx6VS6N9uXg06HXXG6vWnat+BJYpW9aR79i0MQinvKck+Z+G65nBsM
+BXOFQy1J5pHBtfbc1mrbKNeuW0O3Yz9Zpq/K4gwcrCh0s3ybsLwo93LoLaHd33mkPm8tnZSpX5Zx
++NP5As3/lRZ85BPf+mbke2Mcm/OHvXVPffqL3FvW/6c/Lp4t5IXEZu0Fmi/O1FoCxMKEys/l/VII
+4qE9dISu0Av6wxAYCeNgIgDY+I672Xz6KKX7PDk+d47PkePz5jjsSQ5fEtsz0pOkuGTFIStuWbHL
+bm+6W0l3+9LdmOMTZEVKcqjXbllJS3Kkpbsx3S0lOdJkxZHjk2TFmuNLS3crSQ7yV2bGrR2HZ2Z0
+uDUjtKBNm6n1J/SvPfqOuQd69d65ovc4y4B7G0xt02aqbdoAy7jeK3f26vXWyr53W/qOr48BZ2r8
+I6NwYdOGu8Y8TLfFJH3Sw0H+qSNjorVvaIItlv6edDt5Mybp0zssdLozLX7VaLqgobtszCohfWlG
+xvClmZmZB3v12jW3zwhzn3GNGJKkKX1rj+w7++1evbYv6TMsru89Ddldx6S+ccP6zN6NLayxWCup
+59ZY64e97DQvvd4rIxf7GqXELhhFb2/UzPLwwL/SU0rvmbMiMY7+3qjnSybbgZ6NQIKtAPJi6RQf
+O53QFFrj3bshHoCbW7x5N2RCC36dad4NjSGVXzc27wYZEvm1bO5QH5IgHtIhHnIgHrpAPAyEeBgH
+8TAT4mE5xMMaiIdNEA87IR4+hHj4EuKh1l0dJPgO4uG/EA+E/bgEiVABiexHfagLidAUEqEtJEI3
+SITBkAj3QiLMhkRYAYmwHhLhZUiEtyERPoZEOA2JUOuuMmhhLoP6RxiNZdDkSBk0yeD0ZUI6ZEIO
+ZEIXyISBkAnjIBNmQiYsh0xYA5mwCTJhJ2TCh5AJX0KmTl8m/Bcygdyl9k7PGdgNtcHEWa9tZl3Y
+rnXh3dAQ6vDrhubdkA4p/DrdXAYp5jJodkS9bnUks7XVDM5ksPC/Vht4ssCbDa4UwHTBlZgmWl3E
+l+7O8SU5ZEn73oo7MQd9+Ba9nX5MD9Pu6/Cuf/7BEfT5f/6hL5CBx3p1tuKd2NN669isyaI8vVCW
+Q6Xs4l5FIZfQh7toN96wB76JOf+lz+Hd/72Cd9EX6KpatALvSqxDX0FyXp46QzFNmS5r33Cz9lFf
+s4/6/39uH42PMBr/P7ePOtCQX9f5X+0jyeohUqrgScSbs4/+J3p1ttIt9E1rh3uw9OYMxBSPMn0h
+sQ4OoBRnV7MQADHCRuzQENLgs91QS7OQWubdUA8a8et63CosmlWUgcVcBklHyiApg+u2EVRAI123
+jaApNIK20Ai6QSMYDI3gXmgEs6ERrIBGsB4awcvQCN6GRvAxNILT0IjrthYT5pEySMlQMbirayPZ
+0EYDjZQGGinxR8ogPqMMks1lUOeIej+1ijbQlSilWt3ELWO6W1aSHDlp2nc1DeBDvR24Gkscd4ys
+/I6si1nwcIy8dEVov7xwlSItW1lN6Jl0dMMk3ETogYR4MmRFbMyjy02mhx9QvwHYuKjLuXosMQh+
+KoPBGWUwJKMMhDNlIJ8pAziiBhIsusAjZYAZZTBYs/7GGWVQ+0gZ1NYk1fFIGXTU6t56pAxu1a47
+a1HJbUfK4LYM1av2OMN7TA9Ihx6QAz2gC/SAgdADxkEPmAk9YDn0gDXQAzZBD9gJPeBD6AFfQg+9
+x/SA/0IPIHeBHt/o8c5usMGdXCc28/8nMY9st6nJaC+LJoTiXrLcSyZt217ODQZCeSwEav3VsFrv
+d818a2zae13CIdB9OERR6CY9BHpTaPdPLqnPW6curtt5bnLr5ab8J9zSKZk+L8s44pk8oVIPhy6M
+fG9MnS0Fw94cz8KhPrL+P/1h8WwhPyQ2by/QPPE+rSUZeNuc/BZFllsXd9gAAAS2VWaKt0hfQ2so
+QOdu8IGDC8ln3s0XubNrk3k3tILm/LqVeTcAOKCVJbFtGYhHWGnY1GubyyDxCPPbeg0Htw+uXx+k
+gw9ywAddwAcDwQfjwAczwQfLwQdrwAebwAc7wQcfgg++BJ+uXx/8F3zcI/rMZeA8UgbNzWWQe4RR
+UwYdz0AZJGeonbR5Rhm0zCiDWhm7IRbiOMmx5t3ghix+7TbvhlvAyq9v4axkgZsTajWXQcyRMogz
+q4Ycy3rxkTKwZDB21FqZrdn2JHabIit2n11xuNwur8edrtqIL92dnoseZgEOT44vh18rsuSSFX0v
+tmx3unBvk9RlSyc+euoMLsLDh8nqScuWpTZpkrrskaEDZqRPHzD0kWWp9J/0GQOG7lq8YNfQAeLA
+eqlL3nt37piEo2QMHv5k1NFaY+e++96S1Hp105a8GxO7eEg/Rek3ZHFszLtL0kbI/QcvXrx16+LF
+g/tz3dJKqZn0L4iDVLQyX5mg+crdUBca8+u6ZtZJQOsk3G8CVADofhP4VrZtAaAbAAwGgHsBYDYA
+rACA9QDwMgC8DQAfA8BpAKY0pm0Z0kGGHJChC8gwEGQYBzLMBBmWgwxrQIZNIMNOkOFDkOFLkHVt
+y/BfkLm265rLoAFznJqfYJ7YZi6DdKbxhhm7wQICJ9zCB4X6xqBgApdmuGXg0rxvfc1R1dNMyJmR
+2Rp55k5VjpTuzWYjI9euJc0uOVyCy+sU0sX7fnz+xR9+ePH5H4PfvIx9fivHPptXvIFmeom8SCqh
+kuwW/C9+++2LL5w69fOzv/6GPeibv/367PxE+g+ZTyjCXDSZq+iiPhs4nMbA4YB4fu3gEY9Ji3i4
+FAVIBwFyQAB2msFAEGAcCDATBFgOAqwBATaBADtBgA9BgC9B0KUowH9B0KMcE1SASdeoCZqCCdqC
+CbqBCQaDCe4FE8wGE6wAE6wHE7wMJngbTPAxmOA0S+vcxSKzMjAfKQNzhtqnE4+UQWKGKlauD9ZP
+ZGhkGJgZavFrszkyvGO/y8B6pAysGerwaj9SBvYMtczBvLGxc2F2upsfYOlRJHuahXc8FrSwvkZ+
+VTXy419cAy8STKK/v7FiM91e/hvd/vIK8vpXL7548eKLL371AprmIlYyVfyTOP/ZX3+lb2KPX399
+dqHq+/4rv8T323NB493QSOsTjcxlkMZG+gZQTxvRM1tjeip7Apok+nKIVW6ObGRwZ/tyRAem+ZwW
+xYJJicJf0+mH04uLp2PnGcVx9i0/kV82bSJbNuGhqXgvXfUw/vooXTfGLHiLY6dNp7unF5Hp07F9
+6MsXX3zpZ+HS5k2We3AYeZjaHsUZ1D+RtgVA7AcgfSWdggbwHDP6MmiUwSYEqu8VM8ogUR09EyEd
+EiEHEqELJMJASIRxkAgzIRGWQyKsgUTYBImwExLhQ0iELyFRt5RE+C8PgZkfECGRO0MmgTpnyqDu
+GaYgKIM6GSysYrGPYsQ+dk06djMLDTJbo1fPhTdEl96XPPx8eqdXqHX/1pfnzH5lDZKyd5BMurh2
+bdBKrPjK7NmvvDKbvI0iDb69ZY7wQUUTnLsWEPcBSIXSKWgMH6nu3amFUkYUwr7NZWA6UwYS69DM
+cZ8pA8cZbvMOqACHbvMOaAoOaAsO6AYOGAwOuBccMBscsAIcsB4c8DI44G1wwMfggNPg4Dav2zhz
+Iw2PgGqzTPxiBuvAYHTgeE0S8WY1yJE10mKOsFGoHsSrY4fLy9fa210Wdpas3cblpInMbnGRZoWF
+63bsmLJux28Pr1319JTJG9aJq9a++eZaOm8Spe8M+W42Wfpr1zkvb72fdlFzYpcB5CweJ2fDJ2y6
+2MiYLoZJihy7VctJPqNqOOWI+jtVc4vpR1QWmmgjbMszZZCpXWdpo23OGS4HFk05tWhqNyRr85Bk
+Pg+pb8xDWKTl1uYhTY+EIy+mqpZHVHVmaES01ny0p4oP8CU5tA1O+SDLX1Djp23qr3Krx3danMJT
+bXr3buPr3cuWm9lj3aQJ63tk5NHz9POnn8YsTF6Lydh6/Xr6BT2PD/f2te3Vq62vd3FiZo+JE9ev
+nzixR2sLPkxvX0uOr6PdcSf7rAu1XItvAQCiCCC7pVPQHpewXhJnhJQIhF+jeTdkQ1N+nW0uA8KN
+YDckMtXwHmXj7k0tqX+EuRa1hGlNv0oxaiMbuI5wO24KFdBUt+Om/L+20BS6QVMYDE3hXmgKs6Ep
+rICmsB6awsvQFN6GpvAxNIXT0JTbcVNN7M0zdkMLA0e2ptWsDBVf2yPqvbwzoIbzmMFiJdBiJdWi
+hSPqtXSEmRhCY054LeMq0biyGlcNjKuGxlWKceUyrpobVy2Mq9bGVZZ2ldk6TX0vxcvW5HgVt8/j
+sLtZr+ILVbTfFjUAiyhmK1jsHrtwYe327S/dvwYfw8fXPfYYITi+1z2P4xNrH398bM9C7S7imvtf
+2vbOO++sfSjp/jVf9X/k4fXrH36kP1k7vreNHF710IYND60K+Wy9CtfgYLVssHh8zWzHQ2t5v8Ry
+AClfOgWJ0BBeUl1G4wy1z6khZdiLsbhGOqNOGOPOqEOjZGael2u/LlRAXV37TP91oS3UhW5QFwZD
+XbgX6sJsqAtsC9v1UBdehrrwNtSFj6EunIa6UOsu2M33c9ddAWixL3D/bdL8d2ZrdCY7kuxs0YzT
+os5xLA4L20bOx/Ki+Beux1ZjkgPtcuhWcn9MTDAU8zh2WdzVHONP6yudOtJzBP17mCX0aszimBjp
+oyU0dXG9Pi+2NwMg3gog/SidUEfYBC15kWAGVQxWba6WwqKxJGuiI6mhNu9y2c0OlzfH4nYp/BQ8
+b7YP8lFYN/zUL7Lb7y3YMKJZv68GCOkZAxa808P0DrYbLEgnNtNyeqntgD5NNo9MOhtPv1sjr8WG
+wqBlR7u12vUJLn5JUP3m24BysXQU6kAW9C0DT0YZZLNw5EwZOM+UQSwL/zPKwBMRntQ7wwzUDrUs
+iW21aCeGxWbNyyBGG5TS9flkljXJnuVLZMuQGqJZAcWF3HkJdpsDPZiTi0yoyYJsd6lZZ5k0+9f0
+BnKtqT8fvT/9LM1tL4dKZn3U97sj+LVA8sa/d9st9PLAWLnr2Pdem5AmHaW/9KnTBb3He/WpRekP
+odMdyS87dh6b25I+av7629C75J3n16aXTm1AO6TX63X0/OtduE0OApCOSScgBmqBXXsiwMaxOBAB
+eL8yoR2UdEz3mkRhDWkfuti4NnV0IbdQgSBBOlw6EaqnhNqlxJGFA2uRNaF7QmPIM6QRIHwLIN8m
+nQAfewaRpI1CSUzH9TLU8B24vOpxTLshybhKNq4ytCsmP0eSw1YbPTlsC1t1upUiMHuwu9w5Fq+L
+6NNyVs79QC4y8a79cmwtYVZ7oXt/668jHUeb3PbHWOGOI/IAYcx/946r+3dHj2lgL6F/w/Zf3Sad
+uJjdZkZJ+waHO7b/bHy8jIkm+vIkWV6IPQX688edbvmpX3wD4bbOk/smfDyenzGCHQGlS9JJSOHP
+EF5lU8pGxpSyFtiM7JQEbfi1xLubjVsMC4cbgaS5SP3KZZTmGvfytKsy6HRG0xILuRMz2ABjMgYY
+E5/VMBBmLjX1bvMjDJRZk2iedsV6Fqr9ioWtesdyKVoU62NdyyejbE9yJLXDLJ8nS/3mhkoET7o7
+RRHWzscFA99KyUx6fFSzfl8NbNZmwL1JJX3rCp9jyoi59LW+sU8mNTXfN3KX0rjfPdYVHWI/+f6R
+/Fq1ZbN08hua89By4Wgv879q0R+flteia+yM1zrm1xIOncB7un1Epyxe9o7X9K8uT25u0qjx9i+w
+cWCqY8gdAAg7AeT7pBPggLqgyoHJw5TBhGyOtBY23/aotoJaZyNNPpnejAiuYRMfODo/+UjoNzn0
++ZaP+ksnfr6rtX/CxPP9ulVcDC0jr3/2r+NzWwJG9I84o3ewPpFkx3TM9glr8J/OoffjQ59PkU6E
+igfUCtWPIWcTVgKAwO2/q3QGsmAk3IMNd/O0pepad4MIQ/i1aGb+r5fm/3aDAg0hgSuqrnE1ULvi
+vn8IVMAQ3fcPgaYwBNrCEOgGQ2AwDIF7YQjMhiGwAobAehgCL8MQeBuGwMcwBE7DED7y38tsqC6b
+pmUwYxxu+P7uWjK9u3k3NIVb+DULE5QjZaBkqNcmFv5qs2X2u8WRMmiRoY7/viNl4Mtg7cuggxYT
+9D1SBn218n7avQFHymBABmPsFvAZ+rLbauMNde50N7sSbI3Rnt4cU2ojT69Zc3yeJDXPlovZGcjP
+R/Xx9Ap7UG5TPN50r/D0J+PrFiU1zm16eZj5WEq3/4wT7jjKfMHf++6p+8+tnvTcxqMbnLrt95fj
+J8w0/ZmrPJjb61iROUlJ6zdk45YB8aEceeaERcn13slpWtYPlQbtG0hnPu/Sdukmh/xVm1uOj4lX
+0Gaim7nP6C3SHz/pfMvPd8TLU19P/+ReK33j6U/jBdOJW5+8Kx3d9TvMWr5sT6vm8rlMwSSsmNyz
+xc47zYcCnzcYKYxOAgCE0sq/yS7pJFggjU0szNrEIuK5rZzBXIis27zHYbHV5oeHZuczH2gh47sq
+72e6TjQQjztHDxGlkzEVoRdaissaL64jFYgnVBziFOkkNAQP80ENOA6mXhL5fNjGsyo2zYM0AFsY
+o11NfbncXNBui01xpXizmbOwkEF3xtR/9ff7xUa53w6re77tHyccIwdLJxV3Tn6XlU+MTG7dv8tt
+zeoFvlzfcssSR4H4Jd+LpjJDekM6CY1grBodJWvRUMMj6jxXj5CSzWoGWs84swlWwhl10mDRfaSU
+wTqXpNFtNq4aaleZrVFycJJzVZFZ0lQJ6j9JiLwh7Ms5+bp7YN/QmTW31ju1tfngPtLJw2LFL083
+e1GcnyTcIsa9HPh6RZuYp5X7U8RbxeMAQODdyr9lJlf2ZtpD4Rk6iciXEy22a6jNARwZKgd1tXRj
+/SPAXIbFcBl1oK7xXKe+lhyqrz1V0Kf9di1fGcPHcAvYOb8O7SqzNTosNinFne1j5sEWcLiSveyH
+xWF3euUJIg38lPjTpN5C8KWYP0RKy8cMGiQMlU6KgcST9hdXJrQjT4WGfJH6+jxzgfjNF888w3l9
+q7JCSJFO86cFzzKBxxneLAbMRjCUwK2Keb44iNG9mRkqwKx7MzM0BTO0BTN0AzMMBjPcC2aYDWZY
+AWZYD2Z4GczwNpjhYzDDaZZMukuFbT8TYa2xGZGPBRnCWE3bVo/D4snxJFmJ5LZYLamWHHfKOy0l
+YevjF20NQ28IbdBf6/n7pNNyMP8XgVbSOwcJ0peBE/TZ0di61KSd4UAypdNQC6zwCusYahxoi8h2
+6/qN1zIPuoXW1iZKdrP63ERXmaxlKyxa1lxNg0Xwo2ijG19LwvUpGVexxlW8cWU2rqygRHCepj3Q
+cFmsFk+axfV6Uxl7EbGJRLeRbIINiXRaohnPk8BoScLvSsSVofV03mPquuRXAYRs6TQ0gRaYywCr
+eQQrH70smr6ZfvU5CyPXAnE8CJGOqKUs8WEy7rLUuuMMM+t6YOV3Ghxh2Tb9Vz0tNevQBhtWP03P
+9Wp43Np38yNqfsKqpRRs/FEnmzLW49gipMnU5chQn5jq0tSvTMZVrHGVaFzVMa7qGleNjKvGxlW6
+cdXMuGoB9rDPtPgcdlmx+NgfrhH+V/Fm+ywuxeuz+JiyLGhJs5AHWkn3CcJ99+NsqZVEz0vNpEWL
+yIJFUjMJk+l5TGYqO9KerFLa04d8D4i30q9EES0P002iiB3aPyK3xzltHuqAjUWRVizHnqE19IGH
+kD9PRZgBf8njxTZg4iuivezdXZd3hmjPxefpiFxcRRLX09GxdPR6QJhEd5AjshsaAmAzdKcK6dZE
+X447XX3lxW4TiEKkRujLJEdM4320K8Fdr4nSgM4dZ3tOntvyUqwVm96Rb6M74vrhHa9hBX47fp5J
+OZx9CvHT52k6bbWWlve0x6pnDE2mO8hRFZc105uU40sV0gXizVZfwlFkR5I1USGSnDLZln8HNrXG
+vrTl3EnP7I6dB0jia7uQ0K6+8XTHbSTW3hNta/EY/uv5TxFPZR9WTPPG0wZUfo2+1i9OW3NqvK8k
+MzmYJCd7IfIK/pfG0Vr4J11HjtE1WBhqJc9Q30cSWodigo8IAAi76B9kjqy+j8a283Htwnpb6fdY
+bytZLDwWnBqcqsr5RfoHGa/WU3f+IePp91uxHv1+K1kcnCo8JjzG6z0MIDeR/oQkACt7d9rutrgs
+/KNYXBb2eZh8HEs+fse/0t+5s3+lX7wtjqkqrkNglrgvI2CR3HXqVJwWf8sIFIgPqrgfrvxKbiId
+ZjCZetHrsHgs/OOzeCzsIzcJ+WJDPgMoLomjI/D5uA7ig4GCDPG3itN16kjugCVD3BeYxWGOhYly
+lvgjpAFgI8zKI9mtSEoCsadxBFJ2HslqRGwJJCXdTY741m9ZP7lDh8n8C/tyg8K+QzecuHRiw1D+
+1ROX+/QKHSav779fxb9/hl5hw9ChPRnexTBR7q3hdSRgSiuiojIQczJsjUhWjvBtUc8whg1DDcRh
+PFvW4/KinkPDlMzQEfcPE8w25IAZYFMuS3X4moOW4IVcph82t0hNFtksQ+A9x8qe6InJqe50t4ef
+W8qkzA8y9fGcVDrTJH9hSpnxbu2O2vnEAzvWvrBf1eL+C7U7agcID+xY288OMu3Zv6R/zykdWiRE
+/SCd/QunPVla+uS0hQZf+o3+PSZ3bJmAD7HTTTtO7tHfr96gM/Ah9QYg9IC/4gS136PdaeWn+gpx
+wt/ZugDID366DZ+NxWfpNtVPxLXQ/IRTY9Y5w/SpTvff2eQHgxANPqtfGwD16hqW2A5Go7/D2IzG
+YawQQWcCQBpvzk//drDDLy6rbQebxvx9q8djeu/vZ/7iLf3+4cP9EEFzAgByEvh54W6n12laqFIw
++O9nTO95PH/fahojtuH41cYkgn57uLV2+LETGRTFAPLPh4M1UmJu+WdSTLnH84/NY8BTaaL+4cOH
+R/BTS92/ymUxpHK33//3lxHyaBP8FdtECCPMTy3t/DJdrpYZflMzQ6ZfYi0hkR4Ky/MQAInAm6ie
+3OE1uLF4nYqDof8nz+DjwN/feTxi4+CvKwz63x0+HEgEDYnqCRh2p1uTjsXuZJsN+P0xBwbrkskz
+1fd4GEEr/Ko4cPi7XA46HBcA1hccBi/uOJPi8np89UXpp127du2ahaLOGT2Yv8v4l//zjln8QtjJ
+Tpxmny8MlifptyI/k/xfhH8CVFZiQ0ki98h9zEqtVdhCsqn3yOfkHrGueo+cDd+T+5jd6r3o+rCO
+3IMX1XuwOXxPrw+bq9cX60aXkbPhsoh2NeATZkiSuFelWViqw9TuudV7VfmpXkbO1lh2Ff6qt4fN
+NZZdhd+r1yFnr1mnRv4Zzap+hKU18VGVfq1+VNlV2l2V/6rta+KfnI3m7Srtr8t/RJ2r8h/Na9gO
+qvNzbXuoWoecvWad69pHVXiw+Zp1rmsvV6tLzt5Q3Rrlx9qo/VVYWhN919LftfDXjE+tgxej28Pm
+q7e/Dv7ryiGizlX5j+Y1bD/V4VS3n2vVIWevWee69lMVHmy+Zp3r2s/V6t4IndeSX7Q9hP1QTfCu
+5U+q1rkOnOv6p6rwYPP14V3f3m7Mb9VQ90bsr0Y/dnW4N2aPV/Nr16l7w/Z5I37uavBvxF5vxu9d
+o02N/NC7yT2Vpeo9OjV8T4876NTq9cW60WXkbLgsot1V8eFFo06UvGpqX1U+VfDXKI+rwLkKPeLe
+iLpRdlelrEZ7u1YdcvaadW6Enhrt6lrwqsvr+nVvhM5ryS/aHsL+ryZ412lfo/+7CpwboadG/3ct
+eLD5huDdsPyu7f+uJaNr2+HV/N+N1CVnb6juzdB7w3Z6Pf93M21uho9ryT/aH4XjwJrg3oh93Ah9
+N0hPjXHiteDdIH03LMdrx4/XktG17fdq8eSN1CVnb6juzdB7w/Z7vXjzZtrcDB836H9rjEOvBf8G
+4d2wX75efHoj8GHzjcO/cXu/Ob99Y/Hrjcj2xvrBzfjz68W1N9jmf+HnpvvJ/+LvbzTuvcm2Nc4/
+GAw1/hWW0qlV4bJ7V7OvcFl1enjZdfx7uH11OYbbX92+q9e5Fh3X4j+a15rsVYdzLfusXoecvWad
+69hfdXiw+Zp1rmNfV697I3ReS37R9lCT3w3Du7o/rF7nOnCu41+rw4PN14d3fXu7ET9aY90bsb/r
++M2qcG/MHq/vJ2use8P2eX2/eHX4N2KvN+4Hr9nmqvKP9kc1xb9huDdiHzdC3/Xj3+rwYPP14d0g
+fTcsx+vlT68uo2vb7/Xj36vXJWdvqO4N2+/149+rw78R+73x+PeabW7E/14n/q0O/0b85/Xj3xrr
+3rB/vn78e3X4N27vN+e3bzR/e33Z3lg/uBl/fuPx7zXb3HT/uBk/f/Px7423/V/4vJa/rSyNjkev
+EgdfJR9cPR69kbj4BuPjq+SJrw7vRuLlm4mbbzx/XJVeYel16tygvd9IXrnGujdD7w3a9c3km6/f
+5mb4uH7+o7r8bwT+DcK7Qf9/M/npq8OHzTcO/8bt/Wb8//+Sv74aPzfWD27c/99MXvuabf4Xfm66
+n9y8//9f8t431PYm/e215gE3kg+/et0bof8m6b3BecLN5Muv3+Zm+Phf+s/15xE3k0+/fhty9qba
+/C/83HT/ufn5xv+Sd7+htjftz68/D7mZfPz129wknv+Fn5sep25+vvK/5O1vqO3/bK83M47d/Hzm
+f8nr31Db/xd+/+d++v8y3v3v857/Lf8f8b5LXb56WTtkW/CgsYCfLUImF/00Gz/1Y8n+OBzTQ1+A
+3IM+E7efjsRa2MaPbfgS622Ry9b7RsB3cvguiyviGPZqeISR/kF++jr29g/yo6sGXGcZLnpIx1cj
+Tr62W84X2/BddwA9du3QcHvVNd7CJD/JDB1hHz/N0td64+eh02yt9wr/u/4V0cu9gRj8qLBdxnmO
+Vdd9C7f5Vcgk04+f6+u/aRZxs/XfGuzIJeAgQA8NNjszz6lC1+mtBr8pKg6n8IqfrjHoLvRjoYFo
+DR70eEJP01yPBw9iLZ2PaKQMbYSO7PxdHCs79zlaOVJrJv3gk36/MDFKK6Qzl/4hbYF9WBMAEXq4
+2hp76Vm/P0h0+gUaePmqa+zl8QacmtfYC1RnPUjEO2tcY49llV+JQekwWDg17Ah37SUZZpOCSbdh
+/TUBbCMdDh3xhw4bLyHk+AEYHAAxKP3J4DhVe5b4q0lpDCbTyaGIFxUYUJLpJzm6zEKH/YDYH0Aa
+qcLAqPea2CtN2J9gLMEV/pURLzTlBuaKn+RWXJZicgNecSmDUfmVNFLjJ+o9JvYKkzQyVBkbqlRh
+6O8v5YpLA95cKabicq74SWBuhI4EqMWgsHNz9Tcw5PzQI4tD5REvwPgjXoGJ0Atva1VP39UsZgYp
+WkxsYTPx+/2RtiEYeE1QS6Vfx+pUafCSYvq+jlqKoc8sps9EvoLj9/v9DE74fT0VjkGBU6XHLnbA
+9jodFZdxzGIcQzqHidHhTDZ4sUNjRo/LKzG35PVE9AMnutGLPvTYhXlrSHfWKZYujeoQwV20tAhb
+0WNF2L+1LrG+/ipeqhibxWKzYrqN2ZNs4GbvVyZDc8iBXADQT3pwpaTexKX6ao5VnO9tku7zpTeh
+H13/QqyrUv8dmdLL53On+3Ku8YWVKht+iOgLh6EWAO8GTOxO1hUEE92Gw+k7QiJ9B4fTbeK8/XF4
+t99Pn4vbH1hStS2yrshU72TdUXgF+1I/dg7+ip2pH/tKhwNL9sfR5/x+vDtuvzgP0JCZQ+3Lxqs/
+LotLVxLr0cd0xdBW/pNh1ZwUG1fp6hFKAYiA37g6/EgcbBwTlvtxgIHnFf8g/6AwpkH+QdVxReNj
+NwCq8FR1aNQQikuqj4qh18PoyA9VTC3K3GrkKwpNJG/kJT+2r46Ovu+fFkY4TeOuCs4qEg3jTeN4
+PVXwalK1aKi5VBP9X1RH/gULEA5pyNsYsq0B+yFsQD/RKfgEG2gyZv6ii/aeH7M2B9pRERcG/ivG
+Yf8iegxbFXH3UEy/jKVfFht6ydfb2DVVkGwxLvBfXRDcNWnYovAwn+RQVFSKR0WHouObIloaupW8
+h/2LvrlXR0xLSedzxdxdFJ8zSMC+kTQweG6fSoZPVxV59hum+/dCt9LSom/ujTSHc8zL0G3F56IM
+HA3/y99HdDYkzP0q/HhgoXw/O9F1fxydUEErUK7Ap8Q2dJt5sZluwycuoxiD4mV8AhCmazqtDZBm
+deYSZk3soGM3kkP7g+UC8/9PVaBMKyrohL+wr3mxGfvSyZdpMIYGL9PJkXSoY7LLEqbDIzhZgBB6
+JJKSuP2kiIUI2CaKGON9QHm8BksPYVR6WAdy+P2kKJKiuP2hR4x3A8NUcXljK7hXelA8wOVjdViV
+NHcaCm7BJzkkyTEuRlBCb8XgfcEr4xRBIbfH0BXYA++LCb2lCDFjBZmuiCG3s0tQYY2Xlquw0iRF
+clt9VkzzpTkERSBmnBUTelMmMeMEmT4YQ3qwy3+NixFjSPcYulKQx8UQOfRmDM5S/W3LyjelDPEg
+WFmsphGESTY5xe1LysrJFieFMsfGaCSRL/qU/LtPUfLQfLSQL3SaxoVa9/l3SZ/i5PyhgLi08g1O
+mxUAZVtSls8hp6Rn+wz6ujTOH5pcxBqEIYxFc/KQguTiPiXn+5AvxurC4PQthUJpuTRbpU8XXHp2
+TpZDSU+RbaQLOWI0CLXW6RMbhFob4iNf9DnP6CsYwmVXKGWq8DAnOz1FcedkJdkU1OQoTkrW6YuA
+IDbQ6esdIQ5Onx36Ss+JB8EBkKaY0G0I0aSzjNJzxbQVbVWMx+lOpmn8IYb+gt2xO/0lBn9gEqQ7
+DxbhcdqyCI9H3cXurD6tG4NWuhMMfA+IZzg+twl9BpqwdPCB6wPrW4zH8HgxbYndIu7SXWiNoXVZ
+feym4yuUnlPxMWQOA41JFxminQE7xoBFoqA7w8C6i4VFtCUeL6Ito25HCUS1R+hr4HOYUDHQhK1T
+anl9WOKZYtpSlfmu8O1ukQLZBQCCpr8z2ln1gD5U0jhi9AmvFOHiosCPYp2iwE+4qIjOL2ZYFxQX
+szs/CDFFuKSoBhjcChQuLRRMdH4RLhZiigM/FZFUXFRM5+NxBmkRXVAs1i2i83BJEZ1n0PIAh1NL
+5R/d6PZp+k0z9EvWFtH5RUJM0RWDmeA04VFD9MFppDMjlriKroRvBqcZshIegZrwcWtyaFjc6Db6
+7FrOA9rC0K6EzhQJjxroyY+cMVp+xcBxhc4rFh4xGkTJWo+tDd50iYkNI3gK/Rkpuh8ieAn9GSHA
+GuAaPJg0TYoNI0kvjFAo6RJJ8u+RihWMvq3rw4eKboQOJdy/uZ0UB4sMO7tSROdHSCdYxMxmfqTA
+rhSFzkRpLEI+B8Gk4asqHw33VaQUQUCN0ooiQH/3n49vdQDQiEhT0t3pKbXR7cvDrCRHktgC+xqx
+6LbhW/MGXb5zYfHCvK3vbs0bFLGrQLe8QYMWFS/M6949704AxBbwsbRJ/IbpI83pdWbleI1JrU0m
+X9KBuHXoIrodn4nFjfS18x/7F9yF2w/EYwkdGX+A9ik5x33129IW8SjXaVaS3ZhdZ6e7nF5yauN5
+vIOOiqVjsM+ioXSgePRcCe1zIJ6OxJL4A7j9rgVsXtwFHpWGSyYOwya7jFkhI8gjDa/WRBix8Tx9
+DTfG4jN0+6KhuBUQB8Kj0mQNhsVjsSV5IqSFA6uRLozArXTg0EXYh46JpaPwDvW8Q3JnZaX4unQG
+7ABpbEMPdPsaEXMCKqqLI4duL2zXrvD2oqELunRZMFQffS70ePPE+RNv9igacqqS/l15asg4dQBS
+YQqVleJdGkzN7FshMSfm+Bxsmw4Uyo3gQgVbxJFc0KGPG3IKKjEWKk8NKVIRcbhQhKvl3sIvDK7u
+gfMwNVlMcihsBxDEAVeHQMRx+hgZiZPDHY/5cmdhPYerWXcCSVZFkZWH0igjrNDZ1YAKTWpkhJ3/
+Pxa2yB2FX0CAuqq1pXESFbdGsSoX0UYT8A+8PSxOnWwN8Bb/7hoVAAxHEQCXRxzPpKl7SfPNcNNq
+ko7Q1b9li38LfevqQsLurMYW/+6rygqbQK40STzPuEJfI1R3e9GcgLonTLrYT99epacBR9sNRpys
+b8/S05CptgcMIN4GudLDGuwE5Pu56N5G3fUlx0fu1qr7jPY6QHGyhsRnKEUnAwg2gInSOrkt2PgB
+Bgw2s3bCpmaykoAiY4TtK4O+HDatzmqEuCd6b5qDteJ7ThbWG/vGFJpr40y+Ww2hEVvd0G14iyg5
+J88K70LTUpGxiG4buuGqdKA7nQXwKRGEEDZ3THJE0dEzvhY9yPepEdZH7V+DM2ubC6PocEoi/ZBt
+ezMrejecR2Sl5QaWE6tcI4+X1oAJrBGZqbiwzxWGhM7quakDUkxEemlFOENF3g1nvKrCNCAZ0AVh
+CHEZKcKz5LuKyzoGXGEAEoaH4QPA/xtcsbMBN/R9VbgzAPhYEwf26P4jhHEs4p1mTJQAtF6Cz4aZ
+V+kEkPNrghemVNzK4ZHcCNYNcNH0hed6DnUe7/Y5fA7FIM2huBU3qU+H4KZHi775puhR/JcxKKbz
+O6Sz/7Gi8+eLHjPo5D8hMg/fGNyQEbFfj6zIKe50tzEm5fhy2I6qTg8bghXZwbbVzvLlSPMMXN9a
+s/p82yfLSr81OGqg33rwgmxqMr2J6Yz2HTE452f16ZOVb3Cs/jTFNG0a8wr/CwTLKst5DloBF+sr
+bO6XnsI2gLfx+VuOjw3fXm0It6M9jXzRd3rfvtMfys/qU/JtiQo+vLcQv2zDKvSdywgsKWE0EtP+
+OOw/TGdnGC2N2w8IXeAvebK29xHP2DLhdMFf4vaL/6HWuP2B2qQv/kC3xdO62De6vqZtp+CRJwdq
+74+jVvE/++NI33i6DX+I58bC8vRHxaB0hdU3sQCEBxGC3QApXVGxqBihahv08MjDInmF5+KxL60b
+T7dJV8LYWH3oQjpH8cB0rPIg7N4fh79cOaXywNsDCqYwfMll4XFRml2wx8erlTLkJoymYBcOXueZ
+/KDyoO315JQn65WoVW5CvmbMcMYNHoRXDB4415yHeLWedOXKKZ1AtQ9gLd6nYgGQP7NRleHQ06Sk
+Mz2kp1/9VeqzJx4evuOS9GzFKT05q2bGeeYVEI9q+VmtvprXdQmmCKhSEyOjG1U/zeLRM7qCyYDp
+l5roiVy9D+eLjVX43DAYF+gI11Iz/jyLXKU+o9zC0/zSszoJ2iZRPGXs1/LLFzX6LS49Oy28YsCU
+moRZj66fZuSiPcIrEVD9umy5jsPy5DbEadI6QrLfX/GyZkKseRtN/pM1+duNp2zy5IqX/X4xWe0G
+2qM0AEOeVyBW6wecJq0jqEC1nnDe75fuVG3oKLdTzoPEn03xp1yC3YCcId3p9wfOR9qpJlOr16nv
+wNVFr0S+jtc33gr73clq/YhnhfLkMB2aWXN04WcEF1U+1OduXqfWOTXQ0hVdBEZf1vQgaU/n7E6L
+8FwYbkZYxpWV0AVayPkSmN0xCwEq25iVmAUAcAigshJ+B5Del66Y3TGLK9sAmJWYRcDJqqyEGdBC
+nszbLdXaLQm3qzwqvS8dNrtjlrE7ZiVmOWvPx7IuOI+1AxlqaZpnXYONQh5V+QcqFLlJhSIeGN9H
+qMu05adz4/1v8bYMp7BNbWtSn1Mh48/h0oSYx/q5dCWQN74PsTKW/biUNa6ClyMUPMj2f/OiPJmh
+2x8XGjOeYcfeQt14P/YNnXjLH4+3V8Vr9ardje3/ZscuDNn+OPLMeIabvk6s8X66jbR4yx9PVZrx
+OTlfPAeCZrlet8qznF+Rk6EzTDr4/Ts5r6qMoJecL6wKtxFUnLyNilBY5ffTLQwXizfwOXlyJI40
+lT9WX7qiMsdRMLZYfeglTxZWgWxYotcpsO16DSFmVOQQryq/Hn5/WKdXInS6WNVpVNnyaDvh/fYB
+OV94CWoDWJ1soHWxGXISm57OEN9pWVDQMtBZfGfaxo3TAp1J04IhBf6NFzbqzyalaTzPZGFt1XUI
+4TDljJAd/JR97omMoaRpJWtK3qwSP6nxzpNghYZMPvych3S+m7cnqzEmebLCsZ9ovn9Gu8LakzsU
+T7l/ervC2lMKioMnIxGIT65+Ld/U+Sn+l/4YgYnFaeu4T7sqHqsRr0mnquH5KiJ0+yYCCT5dPb5c
+J4+/Fp6b4KcKqgh+BJhROUXD44bWAGk1cnST2Kdeh56ySHL2XI02FruPkfPlHJDBDGAidvRihHS/
+DvwhJlRQiYwJC1V6mC6jD2zFo5vwaMBZNSbuAUXKWHG39jwd7WhEqmhHOT/0l5BVcYWeNjTklpTg
+cTbEfrUVjxuw8PhW+pWqo8ox8nidPqsX7eg1hQVzWiIVVEwIPBJluw9vpRmbaMZWnB3qEjn/4bAk
+dkxa3cgdM9m4n5JuzvGwb9mWNCMSGLEIycHzj3/22ePsu9fkyXinAZJU+D/DlM/8k5+ZrPFuUyZI
+dSBNHbtTUqsE6qkqBkVm/pJNHcWfycLQwtf4vqL0Y0MmPr4L6WusbPG5Di0ShIzg0YQWHc69WcJ2
+EjWExLYWLVk8he8lOmWxrkudv/CcBsPuwiy7nF45P6xM8k+vyZN7Bc8LyYzF4Hm8M2LyOGjyM5P9
+n9Fzn2nP2YdAc2m6hGzVUVpKulk9Q9Gc47EzqenS85Cyxz8bFx8fHz/us8cZcPJuqBP7bv4ZpoyL
+j42NH6fJTJcbFkBzaYgKF7OSzAo7UJWR6jUIZ99CMBpuqBN5l31L+Bk9pwKm5yIAI/wGK6QPxBcY
+XGt2TrIjyZHjy0lOsruy0/kJXhb+/VtNdAmdH//snrj4+Lh7opGBCneW9IH4BKfXJie72RGwcnK6
+18OmQF6e7cvK8Uof1EQXfhkNWJcOgxve8xhMAlbgXzSexuv7G1dWQgZdFfeTnGB2x3wvdQcWM3xP
+XgIwp8d8z0eL75XfOH0ZdJVQISdASyZPKxtgE33G4ox0dyt0pQjMBG0KUdSTulgpS2X4ChMIrUTF
+dseIw33GFPiatYmXTeYJCQRj6J9oGT7i/d5jO+U6M2vHxtIKsn3xVtyw71z/Djn56a7aXUNPjd6a
+aKbOL77o16FNfsMGif0iaMkGwCrYPFnsvEBfTs0EsrFdcdrJxHo9IrBaxySGEJFI9B9Mqt8+kspR
+NhToFVHABBxJX9xiEEHGbe08pO8WJA+HKaVbXui5la9jrA+dpA3ifrMM69lvOI3npK/F2Wa3yY0B
+bJkox/yi3oeH9fvwBe3OvgW3Xg4Enqj8SFomvqP6qTR0WCUHWk0sHe8QFDeJIRdbE/pG61DDn+hK
+cnFSC9JdIc0nDSAXW2MPbB1qSFfgfeTixOYsM9Z8Ih+fMKPyIznFgOlDX5rVZ0KFJ/MUFEt/CjVs
+Td8grcnFUMNJzYlCureYRC4O+IkBpW+2Dj7KALIkXPOJoYaMRiiUlknDVXiooCIJigl9PNPpQxLD
+G/YkrUMNyUUGL7SzxaRQQ/EIw4PYo7UwLdRwYnOWm2w+kVzUaIRCOUWHaUK3kOZGwYQOnuB0iKWh
+hq0J9mxNLv6EM0MNJ7UI7WR8i0cY6W9ia3IR76MrGFiWiOSMEyP3UZv7MX0DdKfdpa5S87ntTq/i
+8JJmZKS6JXqoJJe5s1zyfnl58FR5ufCctkH6eu7KQm93vnSpM/sARD7vZ3NciwbWLucHflahCM+F
+flUbroeotXYmgKZocXEiXOJoo5a+MztErO9jsJ0WD3/64CF9jS3bpcM6GpUW3W7YnD7CXCKNpG20
+ZaBhF6xNhDlEGkHbKNUDGrrnuYCwyiMVLQ2PUi8aumVtiKHRSD1Kw6OVhxG6q6a56vqqoqew/P7k
+7XXxaSIXR0fVFu2G2kca8ocZWEceLwT4c9mIlZWSwxi1gyD6iWAMtgP9WnzdWh4v2ni7iOWUkiMw
+XARtSBSa0hg/DtSCA82WsI6cr+GLWM6Jz4r+IGg4iYCX/XSrhlJv11rO1/BFrNzEZzVcdJsIgeFC
+UyONt1Wd0+yuFOSTUhqP58FhcVrSnBbnbrl+xZ2Sr+LjoJx85XwR2QhV6sapdTHTmWnJdGZG1xfM
+ZGNUfQIygOREgTVpweoVyfVXSu0r7mDVoYa6FierK4+uWpOAXCnITikNJKgFIGVixH+ClcwMrQz+
+KdQSEoJ/jCGzyKzQg4I5+Hvwd4Bqba2ZmGkx2m4kM/SGxBZ6UPw93LKGdoxvox2ZWTEv3JLMumo7
+ZwStG8Wfw6QSWySl8YYsJO1psh3qQ2NwQVNoCa3BByDZ2QGIVruLf9i6Tpf+za/UXx67y6hj5Zlp
++eTFaUVFRUX0pU8vHRZ7Fk279Omlw/TFom/ZzW+LipK+n16EOz/9FN88fDiYOn369OlS6fdF7Ht6
+xYdF07/Xy/DI9KLvVbu7B0BqzM8VAg/vWR52OKOFd1KLNjnkR3ZO23Po0KFDe8hte7AP3c4+ZOuc
+Qtw377N5OHvCBPrAvM/m0b4V9CEVbioAmSjb+NM0j90ZDdrCEu0euwtffC300+sM7ut4L/sqLCzE
+YxM+m4CfFIY+mfDZBFXvjMa60kmwgTOSSmeWaLeJLgaNP4tlsSEjl1G6hxw4z5ZUnacf/fADxjz1
+zDNP0cuM6EJhxbyKCRf37/s2dLaQXH5+3fqSinlAYBodKPukk5AOrQDYMeEOq8fuFNLd6c3Rwg8z
+9XnsCcjiFcYBj03SnFk55nSXUzjZxZPV5PROWvJTJ1+bUf8eft+gQUJRt9W3nflwD/nvv355NXQ/
+utc+9thaepp8Z23V8g7auRC/fqhJk9Ccf625e/TPKSln/pE6TDiyd8Pr6Y9ii0cAEKbRvfIK6SQ0
+1jnm+tC+PRZXNnExoTLmp+1hWhFk9ndPYeoY+sqeYMWeQgkmTAgFiThhQgDoAAHzCsni0PxCQOhO
+vxN/lSOk6bTkIQu3GGB3OgesnWrrsffftB3He16YuH3Ti+PN5jH09+dG3L9weUmhVFAYGjhx8uEv
+Qx/SOaseKSRw8NHdO2i9QgCdfqazBpH0q77fhJz+5ogG8WSJH3/fElpAPttamDrm3lB7jfxPiHfC
+hNBnIQ9ngHwQeonR345+J/4g25jNNkWNgQjQKgeoWDykeyi46XW8F/etx2920vfxyfWch/Pr6IHH
+NzEWgnH47IQJ9EzoGGcC33qAHuP0D6N7pXzpJBt3PF5UOwM6udyHCf5gcI74THC48GFh6piuUuK/
+cUjXil9pJwHzWNs76HdCueiu1tad7rqDbAj9p1AYFxpHXmWkDBTGlaFrYHA9Qw9AoLjyovSj9DvU
+AQ+f/Ti82gm53gSBHa1myU5MtWblkVxkT0NTEgS7rZFCXn7hi1txzva9dGb9DuOeGn7luY0PI/ls
+4rPDmjcftnHC0IcHt3FYGrbIaJ9za5b0+/Tpn00I4ITDGd26ur86iUO/xREHK4YP3Xxy2cKlJ7YM
+vXV66f77xpUuGNcpq75ZBEAYRwcqH0snI+zFxLlSo3Ovh4XuOV4LulSFfoxvkHgSj+s++vDMbau7
+FQmDBt03nMIqClyrgdOie0LF3n/OpKT8PPruNf8S3wn8JloC85nhkMq/AKSvpZNA+HsVThPaXehS
+lcseWHhFb+gMJpKyJaHyLaGfV5PGm+aR6fNeFMpCt4gXCyuekiYUFtIy7FpYVZZSSrpb80NOe57A
+jhu02IgopCSQhuzZtScrT/Bmt1KEHS98cStdtn0vrjRkSWnNsmw4ffonE8TKCZ+qoqQvfUufPyj5
+rypKwMoKAKmnmAt1AJwu3Wj58YdJDruLzXRkhSx6gM6ejT9txeYY62mfOyVjdNZwuW17361pwrsT
+JoTSyLK5rVqaa3/SqV3uMECebYxV9cPPrTHgekyYJ4QhJxBlHn3mRbqVbn2Rzn6SOHejD/v7cQgm
+Jba/7ba0nlPyRyi39W+U5bSLPwfs4p5AR6lwwoTA6+LuQFvhbMHw7DpO59cjhtZr3pz3ESeAslTM
+Zf3QGUZrRQ9a84gny+BHil9PK+iV1fSD+/Cb0l8xcRGaj9dvP7rHXfcMl315OTmNhPrB7zhrLYW8
+4AEyr/fgjMafFOR3vFP1JQDSHOmk+jTZ4jG06FLPkpYgWLEn9LbmBefMIeWh+YWa+3uHmvVnCiDK
+opvDMAahMCDhoTlzaN1NoeCmTUTcRBa+Tc3v0HGFhUK/wkJ6EAgMqbwgFYgtIA08AGn8nBh+oDU/
+mdhiY6fIZBuHWPLTY1LTwocayooZp7Qd0KFl3Y7tX5466tY0X/a/W/b69qN7OtZt2WFgm7fpW0i+
+yfKlue/skZOKDRP7F0xsv/rHBcW922WMa0N/2z3lXiu+9M3Mjz5a8OPq9hML+ie2wwccYya+d3bi
+tGm3U6qN5d0rLwrHZRsbex0W3it9OV7Vkysur+7iWUKaH9Gb3r1Q6nX7g1273Tn0jgHKiLwlXV85
+uJ2uyxkw4P6WWa0JxvbuNXzENnJu0ZwhI792Ot95MfDmve9NyfEqCggwrvKiWCh2ggR+YCxAssXm
+0E7xdOR4ZbauNikrJxWSwQzJIKwObZUdGcOWPTi4uUOeUbhm/D3rx2MP+gPNoN9jXTyGdYVvqOfW
+trdk3DWiWY4v75nFC96eO68f/Qw9GMQs+rn6nAfELNENDp63Vs8NZx/92ml3LRJe37w5eGsh/kXL
+CwvRRusUiu7C4KuF6NyEy4tw1HLssInN31tVEnxL6JmokOkAoTG8b/5JnhF2SechAcBq0uYpqlFf
+QDsmFaJtGH19Et0xHO+UXgzNJ4vbtw9eFmLatweEUjpQ/E76HQoAfBaH6hGz0zOwFXqzU1kXtKid
+wW5LaoyN0G4TnSnuRlI4rmglufcUS+/s3fHOtJ0ffLkNE/4af/nhaZbX39r40tyP3/7kVXqJ/tKz
+d2KrxpmZ3Vp0nWye2OblwV3mDOndKa9bWlfsj1tfWPXAxOm+dh0OvLznWMvm9MDGVYvmTLi/vS/3
+vedOn4ltMKBNR3e9e27fknVry+5DRw3sUhu0cW6g9Kh0EppGjlWerEZCY7aYRVTpk10prQjjJWLs
+c6V3z+pUP/k+UWmc0r5lfnL3ReaJiz9re8/Q25pN7Li4hzEqJtX11UueKSjJyZkOa2HXLTMatG3b
+dEKH2wBhVuUT0i6xDtQHQElI83kEl09SXNba6LJ6lHx0KPye+AHNexL3WY70OJVNc2sd2/9r7cNt
+PrT+En+iDh6MOzrwhPBUx1Dr/PZ09b9zs4UnMgZjrYlZ7XB6u1voRM7jLNpOfEasAykAEpGSBZ9H
+SXKm+lohx8ZOsvEIaVaXN5+9YJYkLsFQiLYvwItow3Ssg9KGBJrvOPLazAdij/tx3/3Tl8UfX9Pg
+yWF0HaLo8TyAn68t9dxyj9C2e9uQnEM6dMO2oe4PvKTKV6TtxXUizwGjnKQw9Ok+j+xIcoq+nHSf
+VRJ8ikunQknzOcT4Ri80yaV1Qj/UTWjxXJ3arbEr3urK2J40keaNGoaT4o89ct8D8cfr4AHTl/jY
+2s8+p/9GtE9s12WMH984emEhgS60rTgshzzXve3S29siEBhTeU4MynaI41Tk1EZZEYgvLcdnVSyp
+cUgUSayPiWkWhfejNOYUhROtz738QLPbvPfi7SPqr/sODw/qlnuC3k3fHty2Dd5b7veTiX4/Ofh7
+usO+g75B/2vxJjwj9mlNl3SnT9Ct9FdnfmP0YwIquXhf6xz0lNzXlH7N48J24stiHZ7JSc9FqyR4
+DP5VfStJDmHDiwdo3lOjxeLYY/tmLo8/Whc/iD9+nuA22m7BfwYIT90eyu3vEZ7q3hYXd28b+PH2
+j19X9Zyn3KLK2skA2yMF61HS8lFw8HsSO8rble4l9H3abk3R0rhjBxmWevh+3MkG+GHsif3TH4g9
+tp+2f2vzj08Iq7vp2Jaw/9tgcCr29wiruwV3bpn37OqVAEBgFoD0pGwHARqyVZSSIKm27JPsLsFj
+SfN6BE6Ax+4S49fTduvIpON1afu4E5eQXjmI76PyidAAP4w/frBo6UFy7PZQ7gPFBR6x6zwcgdsr
+AZfTuRz1lP6g41N8ogMEaKzhs0pa93F7HfmocuzzcCkITTbQdmvFlAO03ZvHHTQ/5sTB+x6MO1IP
+348/UR8/jDuyb8ayOI4170HhqduDO4sLPMJq0p20xcVdb1ncvU1wan8P6GffibPEOuDgMlaqyJhj
+I4f203Ytpy2JOzZk5rJaxxow3R2YsTxeeOr2L/pno5/LMTiFQSQwi+aKb0hfggD1VT6YVaiSY7M8
+j5WDtdscQrN1NHctuXVjHM2NO1YuLF26T6iPB+NPnt+ryiu0c8OirwsKPGJfelsB7cANY92Ud4HA
+BACllVgHBHDpulFUUYWNxBFhJB5p09P0lrUktCz+mIPmxX75Pm23tmhxwrH3Zz4Qp1pJffwg/ti+
+YhVzfw+Tl2GU5BZVaBicoupqZOXH4leyHUzMA7HOJ+djjq8+yrzj5fjSLJLdZVV7ns+u2MkdvRuN
+bTLz++FNlnfLPEO30HfG9Ev5A1+mAz7ED/CVfbfeKjwSt4qm7RHctXaT2aHxX6XlZOMHuOSllALM
+HZqDfT/q254OjuljSlf9H4D4hmznTzUli26GEeyzXu/z2F3CSHowCT+IP3lw2rLY4wdo7rppS+KO
+nvsM24onTkibCugOzQZ1bsU6GBrVunVItY1ZlSGlnViHSdnJ5euTaqPVzf2q7l/Zt0clQB521EFz
+Y7+00w6xxz+a/YDlyIe4Z+P9D8Ud24/71898MP7YgRkPTsvzTMvzCMJqqTv6glPak+PGt9YbVP5e
+12yyikvXUCkCOmhB7aMHZiyLP74P3183fUn80f3Tl8dzxXVvE5ySR45196FqlQxmMW0nz5DtXGPp
+Xis3FsWtQ+bQeRrCYxEcNoe07FWRDo0/sYA+5Toydcby+KNDcELGiefxxQPLln34Ge6g7e57D8UC
+Dxl263oktu5t6R1dzgWvtBceQW/X26gQvOX88tc1XpQ2sj3Kh0XyVB8jugUrlVbsp7nripfFnqxD
+C8yfH5j5QNyxA7j/bbJkyefYAD8yHd8/Y3ktTV86u1PbC/1xcrfbKAl3bk2Osh3qanLUcMVhmoad
+9+7KOrSg1rEfcPnyw3gA92+YsaTWiQPTl5uYu8rFbpybqe3IsW63hPt4nviOmAkKixQxR5GEtByf
+Q1YkxdYO7a5ki9fqVj1IjiK82Gg49W/DERNtx3Neomb84LeLtPC9mcviTiTg27+0FFIeJ+nBT5on
+PiQfteSs37wYG78r3Cd1b9OmTd0SPgZ4Fa+UBG51fFGtkDvFSOtLs7o15Sk2h9z4cRu9RTmeRPNr
+HTswfVn8sX14cO39Dytf7ccDb5Jly774El+n3lHvlRYXcAk+JnUXvMGpueQot8H2woBZ3XLpD0Fh
+59P3AwgwguYprcWJoEBtTkdObWQcaz3fyvq9PcnhSs9A3v9botvjSEsV5EbNLn1dhk1L0oMP9mw8
+1PsBfYN8mPI1HWyZ9vXdCUgfp2/3HtgcAZ8xfRCPrXDqaCHhYFpKcHeT+tvI0PpP0v6VbqfQNVTw
+cr1O2+c9tXrJ97d4cQk++Vmr9v3WZLSjGeoY2V6eKtvBrXpCCx8HLdbqFmYRDAsjY+nEAU9eWNab
+Tk0+tnjmsvhjE/Cx0bh8+ecD8DH3kfkDx8c/gBfy6DD692ur73oD2+HC3LVkVPc2NKY9Hsd7urah
+Ap3Y7tXQtkHtdTvbZowh2pisjSFafyWXP2JWvdR0bP/M5XHH6uLB2JP7i5fFC090DeWhNhr38BKt
+w/KxX3xDrMPfQ0m3eHV+HIJuu3azQ9i3mbZXvjw448HYEwdx//rpS+NPfLSF5h3DOrfzjtEWg1Pb
+k2Pd2wbH9ad/ndfkxX1LHYA04lS8nmRrFQ/j84jksVC5uxlaX6D5cccPskHvAB5YP31JreMXQsPI
+XHxHhc7c1rFut3B6h9BccbfW16ySQCQn1EfNoxhSEGL20/aP9MVONET/7LVr+fKj9fH9uC8PFC/j
+HXosXfJfNONM4Q6c1bWAwkLNOatn87aTYjQZM0dPnHaxPkYJWXQfZQFBBzIi+PqIA9OXmb5sgO/X
+OnGgeBmPAei+xQIeFNZ2b7PYCDwITAYQz/CxrFHEWGbNR7tiF2RFSlEHNM4I6d6rwV2+FT/098w9
+OGTwjKyv6d1/dRjY/DTuXbq0TJiq3E97Beyp8RdI3uRad8T3DR05m9oRPfjk586O+FTXAl2vymht
+TNFsxW3IKyK20211x36au7Z4efzR/bT9ykE4mH5H/75z9fRltZjo4r+w4/sJRw8ULWHBCDckUXj8
+9tBsOuoHqMSnyXtajNc2wq5yxYBYF9IAJOJMFtweR5In2ZfuTPbpTsvnMcJK2ZMsdiDUSrs7heNi
+4nd4GyF0Dj3cYun0pTwSqnViTtEy04lZNHdC4814fgF9F2vVTVny/pG9ZBnyqCF0EPtnk063U/Eh
+Hf9/xDo8x+KTFJLGFFcfo+xPtZW8JJpnaoY/PLoolFqwf8bShGMH8ODaosW1TuwvWh5bXOChLzsW
+Ix40LFHjknCfMEhsrMau6JPqs5mXwB5HoCApbl84vEPxSv9Q8MU/d9SmP74feu5NcuIwrah9uPXM
+B+JOtBD21fn8eTqdPPLvofS50DfN38jD3bTNlq9Jc3ynWU/x++63vP9MP5pDP1TPCAalk6pX5JGB
+klZlyI6K0LDj9GVxx+vgwdiv2JgzfWn8sYNFi+KP2Gk704n9NG9d8bK4o8KarthmYdc2wWntyPGu
+bXlf0CKz9v20cZ3J8xnZDnYANvlAF9bn63zDsbJvMs17CneW05EHECk9RurjwVonJxcvj8fFxHp7
+KC/0FrldeBTpIDqE6euP/h7W3yrLxa3ic/z8ZhbXsdmrzOdYORKbzaYqoinjDH2Yftuyw2ft645G
+MrLB5Pr0lX/1/qwL6dwa67/XvE3tr/7CP2fHNa39EGk8827sV7niN8O3van6CkzPx5p8tewQNn2V
+Zacd408cmL404cRBNnItWXL8m8z/0ryz30WN+zO75VMh8ON3ZzXY0nKtf/kUdVpkqY06BqYYX2Qc
+9WXixSfPT/kj/sQHM5fFfHWg3LW35a/PzVlZ6/iB4mXxQ1P+9eK8+55DcKropjXY9klD8rvhlpj/
+qDwhn5Pt4ITmfNbvS41DNQhNlBQhHBF77Glutt7c8DDSavoXDseK7kPb9Yg/OiOHHste0eR7+hL9
+9M5h9U/i+zswHt99N3hmqmNMs7mfzE96RPLfSl+s3Zcup8P655OWCK0SQhPea9TGjU/j9nl1OpGG
+YwvS6Fd0Vu2ptMVHUiPbArJOPWv8ITpV+EGcB4nQENL5u02ulFRvdo7P67FY2TnRHmIzVq4IVlmR
+7TZHkvBD4d7+52jl2X/PuaWOrWvS3Wd7tvX16uVr+4a3X/aQbk3pZxmtvty5u9xD6S8hb63EjnPE
+EVN79Wrj61Uxlsx1tx/7zIreLcNrSwS3uuaEdtfWlCBsF24hi2QbP8ecPZZzbcej9Ne9sq0w9Bgr
+3yjcQqao5WxVucWF2+mveHSvtLUwlM7a0z/IIvyUlUusvQenYCLN2PtiIfkXb0//IFPwU6O9h0yh
+GZi4l/5RSKYCgYcqLwo/SL9DLLOW1LAIMDOc3HJkWlxePeklxGoiwGZ32x8vmv/EG6/P+fdZxHP9
+95ZM7dnL16aX+BINzlmyccUzh5vTMuop373zy1ZcBqWVF1k+zSzjvfx3ceVFYYfYySxjIf89DYCU
+SSfNMg7iv7sDkNGi2yyT5vx3F4D/H3v/HRblsf6Bw/c8bUEQdmGXpcOywEovy7I0AWkiYENExYaK
+qKAIKgpipdl778YYNUaNMVhijC3WmGaiiaaamJzExOMxOSbHCPvse8397AJqknO+1+/9473e90Xn
+ntnnmWdmPlPuueeeZjOWvykPZNfRtVAQyh/Esk0CEK5zOpCDK9WTEi1qvLQKva9CHkQMiQxdWyZN
+TVm1w2TfoxrmnT1vk+3ifNOxwyfujp0+o+Que82iLWbf2kXsy8Qj4kXx3bLKE5PJybZXUXE8kdb3
+ZvNdWw/+IXhCN7oDQcPIBI0vKOSg8VW7OLdP6Kk0Bj7aSSFntH6MQu4kWy1uc3zvovie+L44nKwk
+mUR/9pwnGYdTfKY32hb8/uTJ7789eVLG5Iln3rtIXiBjyXCy/+w58S7jSif8yso8SX+SS3LoJg3x
+mHjUusZ1uPmucIrLAHeJ86ImmKpo6f3onRKj5+nUpq8gd+EH/O44c8lP98QfqJ522Ry73/Ywjq9R
+7Cb7NRs3iLc2bCAfvLtgk6So3bT0NplePqHtQFmZ/0/kyr17YtxPGG8VgG00zmf6AWgMeudnFPnO
+eoK/6a4XlbOGsLkN35pyrGp95pf/7GNUa0zf7JltWjp7N8P4iw/KmDxTi0XP/yPT8GRdWRnJFk+U
+MV7MJQlrovmu8B8uA8IgTlo9ojFIM7gxxmdij9VrDE/pyVmDVJ310bHCgN3i7u47y1/70Es8SnG/
+xjjuIYdmfEiYNU0V9fKRxUcLv/zhxD8XNS+cNnUZs9H0UdnUnKxTO/uniFvKytiCCeXi4oJetU0l
+oz8NCvrc9P0302dOrl9uqdNdvuUfOgn2r2CdLTXflW3gMpwE+wOY/k8AWC9+sLS2w5nOlyv0ik/2
+7ydnFvKDF7ZG8oOf7Psv/jj/hW0l/4M/5uFC08b/wR85tVB890/9Uf0BnX2V/C1cuFDy9Zw/yu2J
+VqFnpu7fL/680Irkv/qzIPmv/ixI/qs/C5Ln/FEBS9PJH00hkHY/vHS+mkpDRixkeoo9+MELATq9
+l+F7vcXHQnKGehHfXfhf/Zg2/nc/bSVP+eGldcUKPRlBzpjekJJKdRfMVmY+/4u0nk1mCNDzKp2C
+9LxBOIFwN5itzPWJwk8/CRNNERZdB9PEzOc/k/wbVbw2wKBWkIM3xDZBbLvBf2aKkPwz16XyPMws
+JYP4O2CHOwg6zvkKdNazKq3hcKS/X1SUn38k82JIH+YT35AQX5+QEDZIjFm2zPL9VjKIf/j89x50
+E6Zzx/fBfUL4PT4hIT6+ISEmF/LusmVijNkMhwHIILZALrNfZJ27wfUbdL2E/5+tl3hmovrZRROd
+pqmfXjjRaZaaedO6diKeyxGUENgej3XtxDOR/MkCirdfyW5IHikbmD+08Jk1FG2Pdr+p0XxeXFQ3
+n/noqXUUSjFWaOBvQihyTUU7KjpNo7bMIwXqFHrrjFMIMUjrDBecEXvV8eF1Yi/WSVzR98Q18Qfx
+cfXXFZVdDm3f9NqxwyNJjunzM2Xco3ukWNx5T7xaKHrJvH45+9MfcfEk44U9lw9cvFZKXm07VCbx
+UjGWf5+vAneIRYmdaKyrYuhkp2WuMUYXSAVSrLZE7SLNhWro/lQFnS9l19FIXWKystOYJTY9I/rQ
+pAwZPHRo28Zdu95gP6XJLB27bKn4uHpyZcXy5DSyt5S8ahq+IG/gyFXpoY4v7CkZc+Bi6VhGVU6P
+asEE19ac/Wn2LE/PeJLEp2W9jHk22HyX680/pOudnWP8NTj5JhWHUh1r0CtlLnqVn87C3o16hQPR
++vobYoyBC4kbsal+saJy1vZNO7KOVp4o3Xxz5ZxtB7cf6lJZ8XW1+J147+tXubTrX0brxa9Wrn1t
+8zshLxSs3RdUGrFk37aVa1eKX+mj37+w98oUqezoHKkN/5DOrfGSAEkFo9inZwOpBsyvPS0OhBny
+QGy9s3fxqa/OjXipurLrmAVV4xu3Ro/ctGVt44zl7mt1t7wenN/6pVZzevbma+Gh4h9z5m2dMmtv
+6aUdCy7Mmr3/CDCQYr7LReEaCle6IkBDLJXSqDEQPV17hb0gs08MIJ+LAlb8YYxpAzPh2unTzIUv
+eq7h2kxeosPPY0ateW8Se7Ito/yXz/xouGI854Dzr+4AGtVTFb47sQQeoCBscUdNH0TDPXFI3CwG
+jG+v4+OlUMvpQmVgIcWczn3C3wQ5ONO8ItIkui7WaJlkfw6CRqVBCFz3jG5uL+T0tYvyC4z1Ezc+
+D4iMEbchJGJn1Ia+V+2VV8D5BcZn6dzYX/4EIwspopx7FeXDbnSEwndOiy7GGKv7U9gaCpuLivVV
+zk9MsdV5eJNQj4PPZgLpa/pDDGgI9dS+MErdPZ319A6P1Sgb/iJb6DonAFxHpMK9ogZpHV7nlQvs
+/TLRlSjLysT7e01P9u7ldOLXe8QzzeL2KeIElEEl/i7JzhDWvi6gY24aa6NB31EfpdlpxKiaWsYt
+WzyrfuSmpkV1n7xVsCC0rIxbWD1txtDm1eubf7g2bkWYF+k3Y/rwIVmFUWGhjaWr9/g6hIvnx03L
+z0/PDwoLXz55w0FfxwgAlq7rsX2Rvwke4A0aAK1BT1entAt9MnVn6Y8WuH0Do9xHVpKVHYKfMetp
+CZA5RmkZX976gHOS5L9VxztJgaYEKglyKFcVcBkQBLHQA/KkPucpgc/Xh3RaHoF1S4eUqDp8upAO
+oTCgw1n64HYnSZDYZDugQGgRGpuU2TtmX/yUnT/xRYsvGaOwyIomo8VBUl46ZpUQMxyCgj6/LgmR
+NT5Z/Y8Rc5QeXyaJkyXZcYpkAear+a7tcBxf+ECIJV+dY40UEyiUjEzCEqizZmynQQbm8MHw+tFt
+m0kmWVlROaZ100bzqHEZ7RnMfvHbkye//f7kSRlfLiavaxg9RiwUX3xd/Gby6DElo8a9aMnn1nLS
+n+SQXBxrHBWPSWMNXCMi03MZoIVQXPVqzVRDzHPytppSkIPOOuwg7flr4PUzP+gz4J3ZFz99KqeX
+nTPmvFN9j7iJ935eu4EORDaKqywZ6jV08NDBx/Z05OqgosFDxPdFTnxvjHVYQu5JmYkyjflL812b
+T3B8osXTFWhtlJaAKOQuvrFuVjfQ7T0BdGxdu3G3OLiB6fMpm3nmTfLOm2fI49PiE9PS5jFuogO5
+V0KOiCek4Ulbm/jtt98Sb5YrL2/7UXxF/Mfysk/L2d60X6V5FMZlQBdIAOgM+vk8UtEelb5r5wGd
+Kiob0V6pnsqlL38gTHhk7bzmnWTwIXGzflf5a8VdUg7NvvgpEyHhd+zIpc+vf/F2z+xTr5O75W3p
+ZafKJk9VxOUds67Lk33J3wQnUFlqWfsKGeuQTWqx35ET+8ixVaafD+zpGKrx5a0e3Mzy8ifryRFL
+pZGwCymIne5M6mhRKsNfVROVZZ0naYeLA7NnEY/fQVbMEGtJH6mhfIHjsc4wTR+x+eXlbRkAFt6E
+2Gi/5iWdWILFrycaS3T0DGV6JiixI+wp09d7d4vDEGwWSSQeFK74HTkoBn94gMCP08RZP/zIeIm9
+y8r48rbXmW/Ly03e5aa7jJf4MdnIDDW9ZGkbiF0HwWCgst1fQFa7qPTOmAiaKNZZq2A7Kgkb8Sfw
+p039Zx3FP+OB+MP3pWetA9Nn8qBi0gfEr7xc/KrcdJ2JEBPJRWtrYMyPxELZdetY/amylioc3dhG
+++KnC/4jsvcs+X6faZ/TxsagVTlThhsLRiYeeqoOZHD9y8v/uBlx0s9vr2fJ+i3k7FPj9VIxXliN
+9SHlqfrgrPYm6mdSgL1YCvlrjtJeQ04QJt2f9Mcq4aavtS6mjE0P7FmWjC3h6fz7h7XakFNfvD3O
+iU0qL28tmDMEl1smDT/kPCEurzNn+Qys+il+Nx3Ls2XWsTznRcfyjAl/lwDQ9dBygb2Hv6MB6Bpm
+ucD+ZF3jKLjzN/H8bjoFJI1cWCP2+WTi6VZced9G19wzK3szb5WXm672bmttX29PZYUkQQld29fb
+YxAKI7OcrrH/6TUy4aeysjKmrhfrUGbie9F6/7v5rmwO/xC8QQeREn+m/7QGfaxR6kLULnT4TyV8
+B6KLNsY6a5zULqyGkRY1sic3mkdVxkbIHv5iGxpbif3Ildedf3koizBWjv1j02+zp9q2aW2nzn7M
+fm4/ec6j32dP9ioZVbl85vqZi6csrxo95uYZ8cbM9TNXTC4ZVb5vCwkQv9i6j/mWqNe9/PJ6KX38
+SS4D6EpvOv/i1544VFdhwmiqjBoXmqpAXaCvTGBu/bhsTtdvv3WYtZTcWDq767ff2s9ZfH9tXpqN
+6YBNet4GpsAmLU+8lZfmtWnRZ+W3F27atPB2+adLN42pHvfWW+OqGe5U6bRppdh/WfQzEAQG6GHJ
+H0buZMkZdXsvK633lPpZdOo69bKaTm42m2bYpEmWrIoOCO1u6XyNxsqxdXNo95scFpZMnZael3/T
+4qA5RzxJGfGqHD3mpiE5q7ulN165YnJJZFTJqOTs7OSSyKjn+uK/7ps3cBngChoIpthADtLiTWMs
+XbOp9ZPAtHfIGquDGf8jXbN576elTg5yp6V07ab4w70flzk7yBVLf7L0xOxZi8PLohd0VqmcN0k9
+8CaV0sV5U3s/3ElNKI3jeaNlra+vQt5JD6sAhVzLTiZX2t7+wbTy9GmyiJn8hL8pNokeok70LysT
+9pe3ZpBDZA2xrPlNFAu5Kk4HSktYqqfDimFayXSTnjaP18gERnWa04nTxXpx3MXycq61vPUDUkmM
+xMeAYU0WC7kV/C8YlkIb6CuocHeXVlpOTqK5FVVycWULM9a05dphUpfz4xP+lnj+8P1a09tlJleZ
+svzqlKm4RlcsZD/GfAdiFbqfComyro9xYe4q8r3oceIwGR+7f2bYGC5I/L1vn1OHRfcysZAfP+Gt
+yeUOQCAKgPuavymdvmHR3aKWgPuaaopPmwrr+JtlrVBezv3rCam25IuSyed00pk+KouShOw+JG5+
+jVEfEueU8S+XlUmYhYH8r7g+sz11Tm5EScfPgQaFPFDrx6gUxIcC/mmL6UHh0DNn1p++cl0sRMDT
+xC/Fa6brdbUzTuxlnIj/Z6L5P0++tqxVFgu5Vi6Dhu3cgVylsIZtUZ88sepOCoe+cqa4dl6zWMiX
+lr9FdSbimw21Y07sJXZvr3rz9TahUxnJO8ro2fJ5umyeVFkKpqNclJ3L5U/K5LnyaBOshYG4epjv
+8iekfS94ogldHS53khQlHLIIxkB3yvfgZ7SdHJla9/u/9t6KPJEmXn2w+smoyklXtYH8iBkzggfv
+F4+LJ8SDO7Zf/5Q2fK8WMvSTAB8pjhg6vud04NIRh9yqPtD6gUFujGFNpn0RmrwN61Fl8NXhH8V/
+bKML7D2jp4kxP1I9AdGLxG4bDY94kN2MF39TmvehGjziQZiMOv5m27W2NiBwld1PxvM3gQXFMQKj
+OWA4+Y13o0D+6N1IeogRGb+Dv2m6yYSYzTT/+Ev8TSeBXY1pLRHPCHv4m+DWri8jnTcxSTMtJVev
+krns3CuM/VW6gSmzLeMq7hQp5SLKy1uJWMASDfdT67Yya935nnvIZXTaj6L6X/Yv7cG9Pw920Gq0
+s4zNL3/r6e1Lq958nardiDkVAHWJWgDiJxNUWgVd2f7UBiyVdfMVlRMj7mb0nF/aMnU0WWTdhmV6
+47S0KYEvGzL0JBmc569o34xVJm3EkvRqPZmtnDd/B1hcKUQPjOnY36aid05Itv6pSEkK+UF0e1xT
+U1NDJs8gP8wgk6lbXDVDdJvBNUw27U9kPDIqkpIqqMkwfZ+YSMaLGxOBhVRmKfcmDyADHYQDAOXq
+foI8QNOhgWofU8hjrSMzF5m0k4PoTo4ceXIk+2+xa2lJ3ijlcM91tfn14SEN+auPvLZmQH1o+Jze
+G3c1cUML+w3rwrzdQKC+3gzVfE1dz6zRo42xscYDxPNAXGxs3N4x48vKxlvKE0A2QlrXb9Wpato1
+uFJ25+zZQ0aQazvJ453kGhl/+PAEtqCsrT+bWVbW9ib7alnbgQlSWIUAvCd/E1SWvWGd9gZIbt7T
+tPBfIlNXR0RTch136fTpMqb+TfLwTfLoOskrb7Wcp9QLgPuFvwk2OOuu0EiyKMESoLo8f6wPena8
++JhuLmRWmVb+IF6+Q4+Ev8OcP32aecxM2blp4wsmmzJG+93Zc3cxZAKlALiXyrWdX9uSjokooqet
+Q3b69FWxt+mR6ZE4/vIZJvs0A8sYOC3xcU7Xeruch7Ky1jmcovVfXH2ZVX5kTsgmgV27/Ichkvad
+MWVlZcLG8nIAgrqYdTjeAVtiGerYdhrQ2RItEyVePCDmryEL6PYg3CMUf4AcWiPWStuHuNOtB8vL
++bLWeO5yazw3jA7t6H4hTMtE8y3uA+EKHfPTA0fp5gGq/FQ7EJW00YoxxIQzunC2O9HjBjZG7c1z
+H/Rd+3Hj/PeX540suT2vKnjosjFDa7K9o/qO1UcOzQp+otE5C4Ep+aHho4fnJ/szgTM+2TUyc8mH
+i5u/LjqXujBt0IrSuL5zX8jvv2HFovTuM2fNyyyKStGpu/hm9eqtCxpaOqVhrbRu2nyL+0y4BM6Q
+AWDZQ8dYkxXQntpkzphMLKk06DGFrEopyLxZ9kO3tClDhlenqp1TqkcPn5rqQroM3vj+9GnXNg5S
+BGdP3jLeSe4SEOHqm2bw+4eD2kfhqu/m7uw87NUF+Uzs2Ffrc3LmHRw95KV5ffrMe7Fnw2fbBhVu
+/bwxZXFTbXGqTUSGu2ewj9rOISBlZC+1n6ervVNwfF6sbrTRtv+Gby3rJ27xPYUL4Az9n0+/UenA
+af3COUNMMmOk29kCdfiWHinL0of6aG+GwlB581yuccScnkOWjYmJK10xuO+cQeFtkQVTGlZkDXhh
+ZV1RdOasg+NHzs71Lh0WPaC7n2e319Uejlz4mC0TB64p675uU8r82rI+4Yz35Bcnxugn7pk6cfsE
+fdCYl1aP3rOgNFOrzRgzb0tRfUv9yLDgvjlZfgP7EzuPEB/fOJdCVXS41iZpYn5U5ICqHiVru/Nd
+g1MKq6R5obnm2zKVcAmcYEIHNpmAZ66y4Sz+0iWzxnCeFhDFok5mJUhYbrTMjOGsNUfoqNHgzcsE
+Z3X8iKzscT18CPFKHbu8ONH9suOQ2Rv7GspGDdY2eabmFsWW7Jo7NMnT1ZA/df2IhMLMBO/AwSNG
+R0w80ZzXZ8HxCb2ap4/KDCTE2E0dFZ8ZmjPSqAoZ98p8dbxvbO20CXndBC583tl5qd3nnGus3T8x
+Oqr8lbYA//QYX0VAYvACzzCti+A/sWHd1h05fbavnjsiVqkz+vkZA1UD9/6+c+FXOwbpB09vXJax
+8Os+ck+lXUbj2Vlzbu6fo7PpakPsvYIT8yf2tLSp48IlSO3cppI5o9qBlTi3QdGpMlDonYucO95n
++ZW66dfWD/SL65mb7ennFODtvHHxonXR+RNio0b3i9JEJ3nYeNn0jSgrSsqe88qYyrNL+5LNU/dP
+js2ac6A0Y1JB9yAXrtTBmDssZuOL05rYX7v3i1R6GftGaePC/LuyXflbjhlj5udN3FgcYhi3sQSe
+4gOF7WmWaqbagbXWWNrEQohBcgZak2/sSDtHy1bmzXIfDFxzuarHonlTBkbrB1UmR/oEKIXogVNS
+08b1DDQOqUwICO82tI9+zvz4KUVxHqGx7tH6EdkheQ2HxiTUVo7JCYkvqozt1VSaRD6d/lZTT/fo
+nJHV6Zkzi9Mdil3j0vtF9J+Q7OY7cF316IUFARu8e80eWb2kS9iwfLV7V4b5ng/rNTphzNoxUcqg
+1MGVmSObRyQ6K1PL8Y6mWPMtPl+4BAoIgDG01lqanATvbxgMYxS0kVa3tV3ieenJRJ9A1CrLjk1v
+lplUPMg/LdrLMzItMCIz3IV83i23IqvHpH6h6pSqolEz0lxIl6JN70+d9t7Gwd4xmd3s7FxyZg6K
+SC1fmjdy0eAgD3lXP3+tQ8iOOTZOjtpEN6/YMF9Z7tKJPfiaUQOIgy47ISk32FEenGfSzzk6LdZY
+c2bhkEOLCoasPFa29cmrI4r3/7y4z+IZYwJJzNBZPQfV9Q3oMaoyIqXEiyEMObSX+ca2i0IbG+g1
+ftVJ2obnmT+TjREugjP0prnBIArCqJROVGOTTJwCYpwsGl/WUrycVAGIk8HinZG5OKmUDO6KIwx7
+yClpyqhh4/X2CmPpwGFTklVtH8XPeWf1wrNpmv79cz0HrqkZlurbLWv4xKnxWd2DB2aFhvadlGYX
+3SPTKzw4bv71TcvemZfEDt1N7N4aN+4t8bfdL4j/ulw54TxxPvghyfx6WtHg8PRQlW/u7DfNe+v/
+9cG+mrTN5VeJb8s10mV7ShfvuIiJ4tfH3xaPPWhu/hfJkfY5SH2I1L9xVONEtw5zlpLm6MZXK4vi
+uM9YRUBmWe+Zyek+vI1LUMao9BFTujszXVTduofW9avoGejEuugH1O2ZWPHy3MJYNbsxddnGF/cf
+Gbo6dPO7j5ekbNy4oiJt0pt/LEtau3Xrwgkea4sunD26YYpX0bljL87smV334tFTndPU+yn+8Fxb
+02oM0cmcwcpbHegueQsDlQla6uQ+G7713ckTrmwr7jFqcliQm7+LnXfPqQOHVKW6duuRHyR+GTyx
+alKhnnPy1/hGR0Z52wU4vFyyeJAupXR+GsO5jnppejrjsON2Y0JCzcl5g+uHxHTJcM3oPzSi38wB
+IRGTjy+a1NhT/ZJrSPfcwnCdUetop/JWyjxsiI+m5+TefacMTPVk+q+UzuiEheZbts3CJWiU8lmr
+s4KQ9my7qFUaQzLpTmICn38ic+CxKLxZtfbvug7J4mybw/uWVk2LERKGpvgG9iiMiB6Q7OfuKX7j
+6V4x1Tiou6+vl/i5p9e8upAMQ4CbXZ/mo+9/OXHOxzvHKwsCGo98VlfwwurZEdN88/ILgwa/vGHu
+8Jjg7NGVtYkho4f1dlHkDh+lCxw5rI/SOXvo2MiE6oqRWUHctMmvNg1P8GhOqzs0cdS2ikTXuGHp
+O4OzI3JyIrKDX5za6Jc6LP6loOxw+jtk19RahgT3HDlxinHV9TXDE3ztHUKKNs8JyU/t5hKRF3vc
+RePqyLuNnLNpf0vR/DMLB0U7u0T2jovrH+PmFtPPGJMX5arNGDt/u0X++Rz5Vdqz8kOssVPHgnmj
+sLBk7E0IPvblVOxPsUXVKWkTewdHDJzec9uSX4avOzmi4cudQyL6TaprSg0YMayfesPGxEkFeiY0
+6q0QfVDP4ePKo4gv8WAc1p8u0xkn7Z4089Bkw/sbV16oiSl64aumkbuaxmX6q7XBTqQ2t8DOxxii
+iPLfHDk6KW5kXrzO+Y23JZl8s/kWZxKugDMU/KXsxhkUFn6Km3ClFmA9RMyBUXkSQamSypz9VT9w
+UnxS5aCYiPwpGUmTCqKI3aBNH9b2XlY3Nrtb2OCGQZrs7AzvkGyPgNSkRN9gt5ScgRH62u6Gkf6a
+SB9HohkydIBH+uJ5lQWRzPD52wd7R03YM23566UB/hPObTv9x5pE17gRy8/OZW6WbxoVbueksnVQ
+yb106qiwASmBOhcnX2VE30TjqJGjjHG9I5Q+ycNmbwUC88wg8xYuwXALb/FmaUeuNSTzcgtehbWa
+W3t80sF0jE9VbuIk8/aN71c8KSEsPSlZ19itV27fME1ebqbBxz1xRGbckGSNr5d420sVmlsSlzEg
+XK6JSUvvruofUzlpVE4IcY/pW75wQNykkX3cXHMGF4dVn12Uw65Y+fm+ulF9Ahl779jQ7Un5epcl
+soiCua9+T3x/bxkblFuWKlbocoJzcoJ7dSOrU8tyg/qeEK9/PeXNDVP6Rdg0uedNWnHi+6Uz75zb
+Nrm7b+qI5O4jUzQl74rXOvOwvOd4GG8tZV042y4uhAvdiV6l9BasIsJnVjk8ZujMTKXC3dnBlgvp
+OymtYEqWFyHGgeOidmyNLs4Lj8gbXTo6L2Jawoja2bUjEhKHVRn6NI+OI3fnvd2YkTb7SGWvqkEp
+6mm8JsygD3MomtVbEzZ8+ejynWUx610je0Xquwe4qLxjC9PDIsKiPR0VnnHDeuVMyA62c+09/aUO
+HFch/zkcRC14M2qXZMbgLI2zvVmZA2uZpFV4syi000KVsd4sotRzn+WveW9m5XtbhxuGTOkeFKRn
+iF9OVZ/uVYV6Jqo8NmhgkKZ7hAdhdDll6Y6+jrFjozTpPRJdg2K87HySBscbe3PB46fOTBqwtSZ7
+FpNz8F9r03pM2VI0oHZgjEOP4GXD+pT38GaIe0p5f26gQiHjVXHZQ+MMo/vF2zHEL4iEBQ3oEcjy
+msh4L22/zDAf4ipXO9o4eoZ4AYH55k9ls4TXoUrCKdMaOhg0h4dqYEVlu5OOzpHRq7Qo3GE97Wig
+lg4pmXRUX0Y2yzh+fXFljxFJXk764bluSd3jQz1kXZUO4vddnex4X31aeoo6fVSKd2S/CfF141+o
+SPDNKFt3vtYnMyPdO2/uuL4epUlTKibFBY8eWxI2dGV5llP6q2XxU0sHuLv1L5kcW3psUT+muOzq
+gaaALb0aT0we+kJNVhe1LqGfYYYqxqBX5uQo9YYY1QxD/8RAtW3WzD0jV56qDNvi1/Tax1XVD78+
+v3KAIAjO3gHO/ZMKje7OauecacszemW4Gof06DHE6Np92T+AgK35Fj+rvV/mLBzK0pRlWkn4o3rP
+pwbG+FjVLsyz/KywvuWzFqRnLpozsV+4TOnvpdK42JOh7sZBSYs2BPWd3rtfVbafT2Sil2u4v4tv
+emmWuzHM0zXI6BM1bqCR8S9/pX54oqdP4sDyeb3YTVlD9E4qv2DVix+E9k30O3yobU/19uIgbUnL
+yl4T8gxq56CYjLCokv76T7muHi6qQP8ApU/2mGaJB9eZb/E/4vh56tM8WJ3MslLCjU+PNenAudOw
+1DKBJ63m0Xdq2gidqghc1Ow5G3WI1rubq62tWuetCXHrMrJ3VS9/dViP4KAEf4Uuc1R891EZ/gm5
+S6/O7x7UZ8qqw6XzPnxxRu9Aj6TiDK9oV79kL31dkp2nnVZw9nSy45mEqQenVW0arosYtbakV5++
++fxN7xB3e1u3ED/fIG91V0dP38C227K4AeONoUMKcjzlgVGpwVnD4tTquGFZ8WO0/lN3XJ057PD2
+puLY2NELXzpVUn1s08LsLjZK9e3uKTY2k5zzJ82eNSX4wOMX8nI3/bwzc+3CSt+w6riYIWqLTvgW
+P1K4ZG0nfzIO6qgTlmYjoyKlrl1MwwZiMKplmD/YaqSDXSzjX35k+tLP1mctmDk+L0RfvGgQ8dK5
+20X3H6vvU54RoGDsPSP8QvX0gipH+wd2DjKG2LqG+Hn4qew4Z11kki6iX4IvedmdYYIDHbxcFYwQ
+mpKt6THayykkrmdEbsOoOMaBMiDfpCFVi/r2XzM113aafUJBedLwhoJA39isnFwf9r3Q7gEKbUSo
+rcEpLbuHUy8mxymtV5pTlI/eXylzdHVK6Reh0CQVGl4k2xyUNlol7+Du0ntQcJeC/pGpAfKgUTuq
+waIDuCVzRPlk4nPyCWvNLPq4E69hpDr2tOzSoRno/Jad55syPCW7ONHNLXFUz0VTKzx6L64cNKsg
+yl1mnzBj4uiZ6Wp34+DkgAR9tFdXTxeH4WtaBo08uaZIk1JU1ZwbNKGs2FfTp2+2+4w36zP0g6al
+pVYP0pe2PF699qcXC7mp0/ZOjNFP2DV52qGp8V+LzIDKdE8Hz9Ck3hEjZvXyaoicsK+maHqWp6Dq
+plH3GTkuouGzbYOLdnw+v/faxsn9w+UBKRGhyTqn7jVHqou3zBjg1jVx1MKhw7dUpSRPfanU2q/c
+Fg5A5lP1iHtKxldY9R8uFpFNkoUUfoFaXQB3O3vBudrM5U3TB+l9YnsVjohQ+ugDVEkjquP7Tkzz
+MhQPH6kPyI7XRhqW9sxKHtfcM6lqQnFQ/My4SIaQXxe/09RDHpA4qDo3uywv2tdJmGQfmDAwIW1o
+nGtKaUMGo00fFu/u7BvmZkzzXhG+qKznpF4BmsQB0SSfXaqi6Z9rviWIwkUYaekvdNbe7hnhvb3Q
+ObU3L4nvQof0znTUe0HsNnR9RVBaboCtZ+LwtJQRKT5qd/FXd5VXyuiMHoV6VVjfiXMX90yYWlbc
+r7vT8IwFjXO7j3i513CXiKTcqL5r5k3IC+q94Hh55aFwl34lUxNK99b04GqGtexeFjslbOLcVf0m
+7J2WHNJ7fPdxgblBvXoF5QaO6z6+d0hY2av1Q7c2Vw7N9lCHx/ccNj0vfnia/8jyzZ5xkf62PuPm
+r9u0MeOVe8u7T5tqKEzyjWn8zlJ2nwoXntHfMEaVRX9Di86BbX+sf6pM9dyngzZfr5t8af2g+JJF
+/QNC1LLGdb1qBka45Q0eETKqZfEATe+5I05zPcakaVxjBnY31pT0eEz+aHp3cVbSpI3D+jcUJ9pO
+dzCkZfutWOBmKEhiLgRnx3obSteOHLC8ZmywnzZn5KyBEeMGxRkLy6LJbtoOY8y3uH8gn8//Uz0j
+01l1oZa4l+BFtFTnGIgbgWTWl5aWyP5qGDQlqVdlns4nvSwvfebQ2EeDN31QU/fumvxhGy9OCC4Z
+le/qo0/17Rl/um/f9HiF/I4mszwnakhuvEIel10YnlNfHMdkDt8+NTW0ZNf0+S1V+oBxx9LmXV2S
+M2DnvfWLPl3bJyAxW0seUNl8RGn//qVFDIkOc+sVyQ5fMy5WGZoVFZ4Z7hI3YhZV/FrGEpcgHYAk
+EyunVSliO5QSOn0nFqNSaJ0tGiladVkbTw8mKGNwRGBWjM/0L1Pqa8v6hkX1m1AxKSyKxLr0zjGG
+9SnvnjhlWNxvpMeMl8aEjB45KF3XLb0ois9xC/fxifSRe8Xlx4jT33YzDpq9e8L4wysm5QTMj5h6
+YV31jlHB+v6lepOW+2XFifJguXdYfGa/9NhgR5rumeZbfJFwEorb5QnGGBNr6VllBstBynioEPu0
+VEFfq5NZq4Sh92bVEmOUebN8UfSg6c2rei65f6CYMF4JgxNjq1OcfRTKCHVYP8/hMXHuXELp4n79
+5wwMjew71qAd4LchoSDOwz3Y4O4ZG+xeTfzisgJCCjOC3VMn5XevK0lhIicebB7Z3WvUoX+v7rmk
+5+CjB7emMsGxl1mGFbxC1nct3vLhHHH+nKNTjVHjd1X1nT88hjj4OvyYTEJzSxJ9DJGhSlV4XM9w
+f2Oov0IZltQ3JnZ4/zQPRXJx01M6/Li/GQd2DGKxt6A18MOg3IlpCRMH6HW9p+RmlWf7ky6D1l2d
+MmjD3JLMgLC+E2ctSNePKsxy12SOz55yakEuaV1+brrer/jgkvKXq5ONpasTK95a3Dd60PQFa7LH
+7Gkck6bxic0JJQcSckMUG/Ula0ZKfdgW8y2+t3AJkmnbcX6mGejovEKgzIFIjUWqW0+psRmDsbPG
+xs+B2RJXXJ8TPjA7TqGIzx0SVViR4vqNXBGX3rffW/E9fVP1Pur84rEhEy5uHJa/5t26mg83DXoU
+O7Q2I6883VuXV9kracogA1+ZMWtEnDosMzwqK1QZO27NcDYyxy00mjBFtK2McPSJ1IhO2uzEgD5r
+P120/t7OATlLrs7ru+rYuAB9Vcv86btKQlOnWs6RlXQI18EZVgAQqcOxcgYSYK1X3F/pe1G+jUVB
+0MpCqNKTcg6rcIhjH6Ne6aI2eLNKSVGVTIz0yjo1M0JhTEpy8dA4yWQKb7V7arJBwdiJLWxQSu9u
+wf2SA41Dq2fPMsTNnVU1SJ8ypjYxXBvhZR+WNza+x5SCKF99qq9HlE7NcL2XZyUMTvT27j48JWlr
+odrTQamfHNtjarAqLjVT45caq7Md6yZ38HFTOHloHO1dZAP29w8SFw15YzA32dm1K+fgpu3m5+4o
+c/Z1F7/82pjfI9bdM6HXyB4Dm0t7hauUIWlDp2X3n1ec5lziqu9RmNhzTHdP76Si7mnjC7M1nnFJ
+mcEKL0XhoKD0ghD9kLTAwuzc+XGB6b4JSfn9a508nW1t5Gq52seRdPUNjstONkSo5N524aMjds/0
+zfChZWCDfOASKOnsKXmmvqh5a01SWHVj2naRXJHM0nkSlQMr8+bJI99e1YUFU7J8fDIn96e6c4Yt
+Ldr87pRF15f18g5ycXfgSz3ii1Lih/XwdzGmZGi5UxeThvQIlcfVThnXJ4zszajKD+89b3dh/Stj
+u/mnjUg4vPOnTTn9Vlyu4Ri/bh4hsR7FpfnlKW7dJ20YIr7nqg/2ZFouOH/H+UXEuTNusYPmH6T3
+RJovCC8Ib8PG9r6RMDqavHa5zYjj5naEVmkAZT8qL8vCWaNFnxdrTOYtvtTteiumQxYkanrqmiUk
+Z29WeEFfWJ1WvHXO6IAeU0qHhgxcXhofPP5wU+zoXkHsSOIfl+4Vlhyo6KrLinfQKEbGzFz9alnX
+gMjE9NwgryAPe6IO7xlx0jchzJ0wQb3G9+j/yta5/tqQbkMH9+vur9BE+sYlE5KUcCUitPuQ0YHx
+o3sFZ6/8dLm9i729u3dAWJxfYI8Id2VYbmx+j6J4d1KcXJoTVPkBCTzaxcM/wptNm7R2YNy8iuyI
+/hO7c176QBcSmjUoRD86L5zlfUfP6m/wSG2eMzk/0sm7m9ojwMOl64feuQMGBSWW5oUOe+W3DV3t
+7b0DI5J6hXp3j/Tx07nGuzclz0hPK4hyCuw3qzB/wZTi4NDyxIQZk4uzgxx89f5hcb72RND2Gj4T
+OuTND2Dys3oM2VMNmtNi16OOdumY0cEHLsmctb2r0TvnTaRCsPY73O1B669U1X64eXD4wJpcmV0X
+paJLeP60zKwJmX6eYXHxMU6cpr/fSht1F6/s5GB9Tn8fpU+O1wJFtDE21CO8aMGQ4YuLwga7RucZ
+rhCbbanaxLygsP7d/b1i++qjC1P8yRsNx6fGhJa92pg0rGeMckwXO9dAY7dhdbk+ml7TB+aMy+ym
+5BkHP4evQxgbT0NobLw7Y+9jf8dImC7OmghNQJAzxzDq+DF9urh083ZyMw5N808ID3Cw94noERbR
+I8zL3iVtSGU7T+RDhEvQFSbhLJDU6pJZXil0wFV16CilSRB8/mwvrbD20pZMlF4YvVlmZ3LfiAQf
+uyGVqWpmycBUH2M3tVuQ0dvHGOQ6cOtnTX03LJw6IILOv21ZETr+5dlxI+dk9ZtVEGLTLb+X0qgc
+2bN/kB2645QjcgfobJTBGZGpPZomZvA7e2WGD5nXf4RHZOqQbYNmBjgGG1ID/OPC/BydozOGxBfM
+6t/N1Ti0+eh0cuA94nm4z8t7B0wfkh0gFm96Y4yvf1wP78hcgxdx7OZIumQSJ98wL09jsAdxDHB4
+nEQ0hnTNqCUT+gcaPScu2SXlVRP23yqUvjrNEXlzRhepB5d5s3pVOKtzYHGgZ2xXGXHsR65p1cMK
+KzM8vCK6pya7Rk0sSiA2EUPm9w/u0yvDt3hF9bi4uDGVi0Z4ZeTlhxQ0Do3o1qt01orefVfNLu2l
+Y+ddI7Zbk1O3i7+/M+61VZV5gYQZvP/R5hEfXz0Ur3CyJf8k3rF5ERF5Bs97jK2zIunVyx8U7TGd
+mpOn8es992QrdOj37sBQABKdzBm1Dry1baQQtQOrtnaGekmDq3pqEcQzc586pjZ31pgcvSejVvfM
+LyrIcRu0akJCFyc3R3mrNsrbntgFZCf1XTStuNtLHhE9eg8MGdXQXzti2/uV5Zc3D1+3NEzl4cBr
+syfm9K7K8TcU1aRPI5xnSPaI8WUR6oiwbt4Kl/ji3CCjztNRPkTh7eWtUGjc5N1yxiYvvL6tsnek
+Y+CYGYtyttyoj4upOjxr+6nAqQ6pffJ9elQVREWNaC4csHlGz7XQWTdLl+w8N7/kgv871WLKEHSd
++XWnzp7eC4MDVCqmWgUfQUZnngrXnCubd2vbEPfk8X1jnNVdOUL0g2ekJYzq2c0leWIh65uvIaty
+J6T7uIWlBMTUpqTOiQ/NifWJHT6nJ5kqrgzuqfdKjI5f1S9seFFhYNSAJE397MziZPLbrV0TjbHj
+N46JH9U/w7vc3j8ozMVO1cXWySZ3lFHll1GaWb5sYCDLKHTy3yK1KYNiIpIDHV0cHFTKqIE9Bk/L
+8Ngo908KTQoP8nT0dnX0TJs8YPrROLfkUQAs1Jpv8X2ES+AIXtgTd5JEDc4dM9p6opO2odJM8A8w
+BPp36pcUetof67QqPRVy2BlLzsyICciekBHSN0nLTJycNC43RD/jzBLy6ZjJvz6Y9c6KPmQmaTwv
+3jk9omDNlWnzj00zVKriVGXjJ3MuCWqmj0fi8PSUwbFuCk20H/dmUooyuiC195gEtekzjfj4jaOR
+I5YUvX+dvUYiSrZOLFoyPFJXuGCU+KuN2uae7hpr52EH0jzubVk0yheDO8nYVlZFEIeTRVsbG2PQ
+q6wzDtJsC1Z5oyUXOgnoQkDXiGH9dEW9YyLzRkYaBsR7t9VWXFw7KG3WkSrnHn3yteS4X1ZWum/T
+6ZpYhs2Y3TKlR1NmdNnuKWSMf7hbF9Ita3hMdP8479DcMXFsa9NHK3O90ib1M30U1z/GNab6lEhy
+t/+69wRxPDKIczVE+/ZyrwuPVnPjT4ptWw8+3pHn4bVsxOxc3w0e4amB7IM+kzJ945tv71j4VnUM
+LcsaMVl4W1B1nNul5dvP7TK2n7US4G9HT3ihlzcKRudYYwB/MqhUvNVCwsfITUXLPUt184j8oLyp
+6Q2STlTxc903iP2viBM/jawN3kjeZwP2GNreCXXtx7wetUD0O9a1G5tIzmenmr6K7im+YXpzlSJZ
+TCZ/ZLj+yOgv+2aBZY8QV8Y/AS3d4eUsp7tI5QGxenV0rNGRCFqdn3QAiToWt1Bp/cCodKHnWfiW
+fqcbT6oH9t/lNlXhODgp+eRrhX7ZQdHG7vekNdviPz7duIaEmPYzSpK3ni/pR+y1NjZu7Ho73mao
+vyY+yNXWTlqzTfTidz+R98l7AARm84RtEPTgAf1aHJQy8JTfeJeuBz2qYF3PdPGMlN+4fyE68rB9
+bsFh29yCoYPhLfA0nwUAT/CE1DinmLghhx1yC/A5pErPzWfxuXU3uMxFH220XA2kUkorAWZnRkZm
+CtGTJkUL1BV2cM+eY8H82fDMzMguLs7OcnlkZmZ4WOToEdFA4DI/nI0XSsENBrY4KAVw70ij+kwX
+d2sabaVkuGPy3MHdmjzH3ILDTrkFQw87WwC4Y0Ldqc+/Saig8Q28HOnnF+kWPWtWtBt1xYitYmuM
+4OERGenvr9Zq1f7+kZEeHi++aD2z2SwEC0owwPRU37CogDD/qNAInX9sTKgnyJRhzixx1znH2vKh
+drLIWPmjpO+iv2tLuiB/IL+R9J3kUsRHQaomxMvdnvOPIDYzPfydtU7d1Ct8HasiDQwrm2nnEbpV
+z0ckPYiOfqCOFpMeRH+SdCla/iA62kkdH0kCFB33M8R0Pofci2iee6CyrHllW0zifdOBw4cPn2hJ
+a04rZvL7lRYwTKcfpJt4i/pg3yRth8roctumI8dc3d7J7VO1alFjh7Ocvm1fB8rrBCX4wezUKJkn
+uMpn2oAft8KnysXJge0y091mq9KehEHX7o7OoTJHnV0o2LqpQhUynYc6Uit/lHQ/+rvoGxe+uy//
+Tv6d/EEUUMwU8oNPLskfRDvFx0em2sr9I/wn++/058hwkntYVTD4LdCazwIBLWghNU5hjBtCdFa4
+Ty/07ZRFXMQIdmbdpuXMZbp9wPTLIdNPhw8f/un7QceG8AIpHLh8U0lbOkVtwV588Yq7hxWjG95d
+UJ0abu9ho3ac2dVmJlSpFALLzHTrutWZswUHvrtMx4YSp1AHub2OuCpD3V0ivbH0L7QlXfhO/p34
+nSI+vhNAC7xjcp8In50+bAc2b8TmDd5WbO1YnipPCzTmS7LsVdO9w5++2Ex04m0J1b8E5ZPCsjI+
+tX5bSbkVz9ULUpllA9h0EZSQCEtTAyJCw3TRYTEQEOZvDDXo/BP+pBInPVOJL1hc37V9FwWp/lI9
+Njxdj8P1sYkML9XkqHg+Iukv6vKxiO4+UT7RFL7mf6jVf1HM7Jemj6W6/cbr6c1po8iAvuMKmDAs
+3OdLnT31fPXG0r79bDWwnkMveywoIRZqU+XtWdMFs8bYKWtSu0SHRoXqDKGe4EyzqS3pu1QbbahG
+FxgaofsiXsotR7/AKAPDCzPDPbpsDYjpyJhUeSSxndml20xNFe/spF7hWIVtoI3Wk+jI/5o5f15H
+2Numm8/nS8i/Hj1XZ/40T65eeLoWSfWnr/lb/ktBibeuBGJ8z6RN7aKmd78pOi9Ppztg9eya9Cmb
+lrc3vKGL0wkpEvdYTpdYvmpH465dfGN4exHQNthV2UecX245UaJ52cvMi+V0j4L5rsyJ/wdKOpEq
+6054tQs9At3l6f3+nffNqzuurlNFqv40jQbrsRMurGXrfFh48+r16ek/XBt3YfRTu+u9vLo8binc
+kpKa9ByKJeOzX8kMYR/8iBvruwUHhYVnZ03ecDA09PFTm+9ZMujAC0FBIcWVU57GOGXsoslzB4d5
+AAve5gIbF1kedAEN3VPnj0nGZBIqhkq9mEyhp+tLYvy1foKzLcOutRzjJX7S6Rgv0fxVwZk/2LY2
+ro37m9O8yGLqgW0DxjwagB/ZcbcHS4x6oid6mcaIG/9lZArjv7BtoLKB9dtHbFYrTd8o93Q3LX1r
+txJ3mXuV8eXtpzzR/YtfA/ALcI2+AvzpWTjRscbYQK3GqEdHCCFGvbPWqIl2ofpDbyITOA2ZTUJa
+Lmo0C46KN5Xi7sPuHnN3i6Yy5ek/TquYb7495+92etvJHb4h4hnx07Kt+1/eUjZn4aLZT9bT/Shv
+nGja7uT69u6vPrLW3bv8H/xD3DNs3dxjdAJNNKdmsRYBy+DWH764XNzxYPU/+hTFajPI96TXTCJb
+nVZFTl39l3glNbXlX3v/05csad/pM4y8ktpD3HfqiHjQugsI92B/DSDE8zdBBkq6yt8XnBiN1oLR
+F1gGNGT2SaIlNbX/Eb84aQWVT+rJmMwMkRXnlpE8ZiLJltCI08UXxJ3iNMSCeXlU2hOglUJkqdEY
+9e22L4bHHUmt62bKH0Xu+dcV1/mL6lrmdLe6VAyx5bpo/6Yof9O04rrY0hHuVWk/xp+Fq5dZQr1E
+Q00eRUQpVGb2v5RPBzlH4p/h5ruCI+5ZBRIbqPW1ZrGzxqhhBU7rC86WEuCLyJuXRFE81yP1glh1
+e3yu+IOKfDdprXj6VfGQeCojk/xEhswj/PIMTv3m62KLeFg8Lh6KiyPzy00f9+5N9pKRZDR5KTFJ
+3C7hkHng+RVWHDItq7HYWIWdO9AwPVWnS1ihRHL9cVppekN5Zlbbk9mnlU9dy2TZCWTJJzaHvynt
+38DgMGgyGz+2BlomHSgARNwjFrKr8U4sIEa9gIyHSJ8g+xH3hk2RZw6eURdCuB9VTJXqtPgk8pcv
+ei4VN+N5Rny85XgjKW5huLQnpQObFJgtbZ7kKWAVzC+7VOShap/JiemxXym+ZLo0/bTymfuayix3
+NdGwuU+l/Ymd8q29NtEAf2P2R9ZlYwCtCmlDM35nM/SpNNmSjqwmWmuarqrI66Q78znZqLr8+LTS
+dEJ5+huTYZl47ZvTyvbbhmhOc/Ud1wwBMd8035UlSPtMLeETS45j0MHKqzTouczmD6+oGHvVVfGf
+tab4z68qO/alPamVtqQxlA8Io/A8K3oWfzQ4OzFS21cjS3An9KpfX2AFRiZwzKXfSFJq6onffhcv
+pKae+Gbjz32KYrN2N1cTOd0J3D1Du6epWnzY59ltu+Wd9/92HMNh4Qv8nPa9SlYu2MH8BE6L7NVE
+bNa96eU9e5342Moczt/5LDBAfP+OeLn8B6XlOjTkDpYb0qQ1P+IJoS+2ObpL3Fc6lMcJQeHpOAzw
+3iSRyLT0bIxocLbcegMs9nzMD+LLL1YptS81SRC3T1YmiM3iP4OWT3riXy3+p2JiNM0R99hhmCXu
+scO8Pl7z+bAJR6WdzutuDZsg3hInJaSnvpGf/yvNloWTtox5mebLgklbSqQz5jAP3sT2qQUg3kTt
+rJdp2HASQtj25oT9AO0lnOilbTfSClzrjynFncu6FY/+eurHStNuKU9+++xigPeHpnqi2/BKYrV0
+adtQ6c62xf0tRw4+dVdbovku7yud82R0VkttMRblBJpH0S5qVhuLRxMIeLoO7Yb8AhPTpnpuOXPk
+U3fV0FfeeGeRJoAYH43/V0P3qq7rjm4/7aoqOX3ozEKfYPGM+M+63/77BS90zFsMwN+w3FlG+ZFM
+66yxcNnflWf+I/YKq8vgwzPqwsRe/zmjbD/6q9XeIoudN98Vfpf6AKn1s4yLb6yH1Q2+jDPZfkbF
+ZKvOrI9bSd75R9z6MyryWHV6c5r4tmnpDbwt6vnDTognGYDhjwcQZvA3wQGAOBNra6M2+fZzZv0+
+FTmmWtX21QGxdZ2K8VXxZa3N0qEhNFwg0Axgc1HieRrKoFgMwpnoLYHxC3eXmU7vnrlWxWhUJ0cx
+KbsmMk4HVOSQas3Td1nR/WpAzDMB+N8lnm4riSG2xBJkB/Mz6plVYsg5peikPMB0E6/tVDH9VIfv
+kj1nVeSeap8okD47VabDyiPMXNPPZWXMVGZZebnoQ74pLzedM6VY5dyxAPx9Ke16o749ArRoFf1V
+eWaC+PZSFROiOkSA/LRPRY6olplen3RGWca4MT+Ul5t2M8PKy02hphuUr14D4A9Y+SrBopYRylcN
+Dtz2traQunRuq0PraDav28zU9rt4sFdmIMN8l+vOZYITaAAC8CooQ4wRr1xW09ZClNijRsXgIQ8C
+82PTp4eHJNtl/H7dtD/h5HaleEpM+WDOK4HD+51dt9bQdc/bMwpq9PFk1LEtXvTqpdBPrp4l33qt
+3yA+SEX+Mch8l9sqKEFFz0ClkFnLySZKmQBUbJJpCbIKbDgS8xBkpOo1FZkwr9fJugsjkhVR4rsX
+6tb5+Z4gKrlv7NJlb4UoPaOXLz0ZQA+3nVQyckvpityBT8SrI0v2kK/7pgxNKvn2i7F9h3WvfOeM
+tC/UfJcbiGcRSbKaBBmk1hljjbM9FeyjbNF8+MfJvR2jycAfx54ZGlMek9Cw7mKY0tOwfsYePUnZ
+f2RT+ebMPmAmURGR4kMH+5qE1B/+MSG/OGXarNnSnFA3813ufStuWtYOxBJxLEbsrDWyxthn4+be
+Ez864ZvzRt2FESmKKBJzsW6ttvyQSqt09DUsXW7FLSj/uL+nZOSWcStzBj4hsSNLmKCy1msT+qQM
+TRp758uxfYZ3r7pqzftiLgM8pRuIpDiM0rF9vta0+NIzLGhKtH4C609COb/E7dPiS10XdFs3XiyZ
+3McxiuQ/JAveu15eebf5ZDzpQTYG+o1IrtCm9J87JHlT+ZaM3iYSI/58bczYL9JmvfKu+E+6VsU8
+Tyzk/AUl5rnWitORaGQa1oFojTQZzlqj3mi5/o5tG8W5+vUdV+bpQYJU4qeXtF+8bj9Bb/C6dFh1
+2F1bUfF6+vxk3k3lHO6pQ8URc+TY6Lik1axY3vR60VBXN4o3W4znLnOZ4AtRAIS1nMshSUjG2BQi
+FYEukFZ3Y6xMOsdEsJyslEK4y9fC177w9YQT/UOL2Wmzdw0fHujZ62T14SFxAueQ+dv1R3dWkIlv
+r1kT68hxblHzlp4MUHK61ocPps4ODPx6fPGwoosrSkbOyB3fPZuMOraZkEV+tBn0754cPODq2QnS
+XaZMEH8Xzx8G0t4OHAhWDNr6UBZJt+0XEe6hYm37jvBQdj2kFDe/cVhFxvNV4Rl+7r52dhFpGjdf
+u1a+jD1VTuuauEiMZ4byd0EBuqfDlWocdgPS6gqjvr2Wk+k2/SPCPZRMl96REZ7KgENKUvqt74+v
+ZM+KCxnJuvnlD61wfyZKcVB5axWeWOemStaE1c0HQndjcGMFJR3VSfKijEW2LsmQzG6i26QUb4tf
+vdL3BHF/TUkmXGQUm5QkRImH5TI+C8Tx/H7LVZwghcfQs2xkkixMQyEamgfS12Vl/H4qM9PVJzJb
+LgMiOiPGLGxvVKz2KTdNDw5yuVF5pyr2JgfIJ+9RirvmOjr5Ta57STv1xHwHJ63kOnlYScb792wp
+mbWcHVw9Y3zy2LQBpktlzIo+ccn+mSePmA4z63Njk7UZ1MmenvBWWWWdlCZuE5eB9+m2R/dnCVsp
+RZB9YuLh1EDF5N1K8cUapdyvdt5+7VT2FD2bYGz30vSBNMqycZndtdlvvm46LPEVystmchkgR/5t
+5Zm+FhaitrIYXwuHZRzWXgxVehrEWxdDlV6GeZSrRZGB5KPJeY5R4itZP303vn9xSvW978b3G5Va
+PQbZmkiiN5VRW3xPinOwWIhnLjghD5c9x651T2GV2DqrJTdVjhK3dvbQL1t6ch1l6b6UpQ9PlltY
++rQTTPaEpzj20fI9nRm66TAw0CIWcue4TMDbDQMsFxham7Ga1jrnzi3Z6BzLDs0sKJjc3nJ/O99d
+/PdrKnHD6bPr1lqa75xXAkaQPSWREZ1arPeTTWX8wk6tlnzrZSnXOC4DZSNW++d1Ki6mjHX1yV14
+zM9afXLPzAwrpieFKBUxXrpTh6WaIh0WQkBtvsvelOqvM22U0hmqFgWTETNTppWUMzLpaEbLkaq+
+up5l3H8e+7MZK2bPG8B7/X7ktZNhZdzY6p99uR5zFy03BOxrFCe+TvRe7seOOfn65EaFhVb1cPde
+vafwR7Wy17jdCm+frOCwiJ7RUR9NGoRnBlea77KJfBXqMuiAiJ7JqGHbi1nf3nURp12qXalvpJIi
+lbinwVHmnJU9QLnEf9+qHQbfruwpPJm13DS8b7ivPJAqoHqG9NP3sNwdZr7LbeR0GIcexfL2UFEx
+0NH7fn3iJG3v/tnHy19L1Skq9qjIyBqlXDNz3it+nE46m25s0rj0gUx8uWnduIzuftmnXsc4egNw
+k/H8Tiojao0a51ijk1FrpAevM+4L3qtVEueWqb13xs9UEedJi2vYU+VtJ0vmEpuH5WLJD1ek8zIB
+hMOcDu98iTXGOsuokMbIBBwVszKjxpnouYNN3hUTt6iInYqUjlqxbdMkceSa+TUq4lRJXp9/b6Xh
+CskiEyeKy143r5duN2UmtZ1n8X5gHoArtdwPrCWYSKInOGBpmE1u3m9QEocvyezZYsy/lyjFe98S
+kZwuF0eT7aK9aCwj+2jf2iLGc5s5HepBGJZp7+YsHbwVubPWyG0ec8j83tCHESMju41gnNx6HN7U
+Wqsizvdf0M1xq1Vx3qYL4kdiZlOi3PGa3DHY1W8T11De+s0vY4iR+OJ5ET+Z3+V6cEOxZ4k26mW0
+GvoQGo1MQD2XTGskgbrACKrBlRlj9d6EjZg4IUluCuri5ecXNSKjYbPrK/V9v8qiD5lbdk89ZD8Y
+XGjqwtrauXjHr6/dxyweXMj8xnX8lM7oewgg5OHYxgl1VHg8X8eZoayGNRI90cpnMtH/YvTLTZmr
+60wfzD7CkQ/+kURurTeZe9fyw57srq0lUeIHtYyWSXy1dSJ3FwAEOm4S8vFsQw/wh26QQGc1LCeR
++HK4OAEPWmatRywrrA6Wnn6D2kVBFoubt3SBrIUp6lxkSplQfOYMW00UxOmtk988FF8Tt4gNB/a8
+8FrDijMnTr61onYFm53GMnI3ldeEFLXWzzkvpXlQmH6Avn9PwtvUcndrT7zRtFWpurT7q4/Y0tnz
+66e3em3csXO7aQl3t9WLCZuwLL3IEOzn0s1BE9M7sjwlhuiUAcF5tXEap6LF/t6JNO84xLcZ9QP0
+DhF685MeDyULIZarcKULCQIoGLWLQu5ILyRWyHWBzM4lP92+9fMS09kzZxiRrCb7xYHiZPHqCjat
+S0OD3ZAehw6m1ZJQ0pNkkpAnu2l6xXniBnGdOLeWJpDb5XBUXCuuPuYQkLiNGIh+ayLehwfAH8Zx
+pkd7Pj91vi216ZnzxWfO8MmLTUvJgkWLxFpmwaLWH1dgJDs/FTWnTW2nTbs/FXfyw1q9uLuWcNeg
+rtHtz8PVq6yh6heZNpK6RYvEpp9Fu1VPh7m21hIiAEv7WkGPug9/iAYg4UQ6yo2yKQwTdY40+6I5
+tYJVqGKNCqILVMhTCB79pHbh54d9dObH2x9+UbAysYVx3ye+9qo4T+xFjpN5O0nstpMJCW0FtrPq
+u/RPOXasOweXXhMvigfFw+LbgYFkc43p7T55ZDsZTyaQ7ak9xFViD3GczNmVVIsTHN4i00jtKQdd
+0iviNfHdVxIsecsWox7RsfNJ6O3IWTh7VozDXKxt9bIiZcxlYiFbh+NTLwC2/RRz6/npGr9AolDK
+aNVWrK8XeueN6n2b9Tjzm/jbgH3kl+ieQQPEzT+PGLzuPT6ktvbJe/++4yd89+TmP8YqA/JO83pg
+abpketTneYLvn94Jr9IQSzKJjOilpDK1O5mwy6YfmdtrRME0jqacpPRmBi7D9Ju+ZjS1taK+7ada
+5qZptwWM6ac0UzVY69lUHCMrOsfYqXbRKPIWtAVY8iPsvrikc9kD2NRZ0vzs3efSJJV05TnRKvQB
+REv0hadPM+PJfnKAGXfmjAlOi1EmUX+a+37VqrYfxYxZ5OpIGk9bGnua5n0tNxVVkssw4SL7fetj
+2l5tYKD5rvAC/wvYgQuowRWCoQek0bVyAZ3OElTTU/qklssGOAfKjQ6C0pvnk4WYcF4X7c2q5HwA
+W3fm5Mkzp99887S4QCwgB0kNqSUHxAHE5Exmz0oclRLh5shxjm4RqcVsUGJxSoQr/ekakVKcRLw0
+Aeq2Tcw/h5E0kkvySJr4Jk5GvFlLtjI3yDaxRFwnrhdL+EzSOu8sC2d98geUFBT3yEobOXBMQX8v
+n/wBYwaOTM1KLR44piDfO6rfrHdqxHlnGWBggfkun4f6smwA47MnUvtjS3r+tmR/586TcjLksREk
+nKfyw/a5XrfObNlVceDO50eIjnRpIB4b53pdOLp2w/hrdz58U7wiXl8t3pElJPQ4dudyv5HqMT0y
+9t9JLE6KclExRdlk9L4906cUlYQY4y6f+Oo/oaHisc0rxpUOHxVjjPni3Lc/R3Mf9Z29uEd2hDGq
+Z8Hq7KR+O9fl9QsxOnq7ucrlbN8NvsDQPon/Gvsn52dP6rdU6qmXxHGL+CsLxJLLYsSqWu7j+6Ra
+XHK/NayD3QADWea7wnt4XlognrMvHUCsj+50AjEtdKKlrEUdzhkId5qFM4c+fv/gacbmjHhLfFF8
+SfyEdFlVlTrLNTDUW8kPq601qcR7X39DVMzPtbUmEPeLX5JAMpARTYz4VWWKp0tE4fjtI63n/GJf
+6EBxdLp8nx4OxRO9kQj5s03vPTC9t4w5tYqsIeuPtFUwJC9ADORv1j7ZTSNr07JfUuZSxC2S+u4H
+ALZK5C1uVEMeRGytB+va0hv+0aWhYWMEWuG6KIqHxEOiKBauYi7sJF4kjXiSbKI9Qt7f9LiBNPbT
+ittZf1LVz1fcWsaltJ7DaMs5h9Zv2D006ipuSkrrsAncTMzTLgCCHvV8Pu0nXtl2wLKyHwMeSM4c
+E6+eMD04QRaJYYuYf+wmGuJ9iLE5Ia4gPy0zqfeYYtnhK9v2MCliQm0tk8jsq60Vh5HdtbWmH02G
+Wqnbk8rS/MAy96XE8/KRXXSKk3IOW6wZpPiqmDGXeXcP6Uf6rCMH5oqjr7Y1kpdXiQW15Bfm19pa
+02UmvrbWlGb6vJYfZoowPWAcmRvYtxeY7/KA400dRNFTYJ+65yY2kTx1zDmHp+pKh+oq9IqYcKLz
+JoQK4LixlBu/URSyDlS8+Gqr6eTMeWVTXatGvltFvIituL1pTFnvvvkltZXdUqPc5KJ9v6A+vYqZ
+Zab7tQsK+r364utHUubXDRrzvZ/fDdNXNye+UzKRfGfaySjzpyQp9SNMvQfuLM11Kx5YUQIcRJjv
+8lmCEpzxtG0j5QHWezRw/VqgTqUxEEylHOfPUczCY+lluKScv7yRPAnbWHmX8GLr3cqNYaJAnows
+mzRkyKSykbWVzLrQyMhQ38Seeq3KNyIkRlvLa2tMSwf0F2+afhdv9B+woJaUntxzmLzz6p6TYhTv
+9+QrNt1w+ujrZ2NtfXoMWTE4o7p/ejdfB1p3Csx3uQYuE9ytN4FZOvvOVzigKsU6+g5UyLmGwJ/f
+Eb8Tr5AY4jT52+pK+Zwpa2fPXFw+38F0PTm8enp492ySd+x1EkjsiA3pFhws3l7QcOU/v3wwr4Yp
+8AituvHp5BCPRmJD7NrLeLygBDvwBX/QA1gKFfkCby1umi7pagmDRkarVaAukJ40aUkYO7l4QsWQ
+okkTisnoDaIsbFPlt6KJsN9WbgrbSJ7cqzSVKx0TEhyUgxJKShLJ1xNO7nlVjD2850DtggH9SSjh
+iUCC+w8gbTW1T77i/Z586Oo1heGqvFzd0qfevjUtw5JO7iSXCTLUNgZb1sELXoRvP12wXVryxetZ
+Y4yCQk5cVPpA1tBnxNC+zOemgFmLpy5X7gkxwz/E++JtEk66Ehvi3vY4PeA085b/Qi0z+KOX97zt
+NMPm/eMzpx3dT+iEpw3pJl41TZ96d9XZ8WXiffFd5uD4a1/Q8ksBYL8WlOAp3fb17Dq2TktgeAdC
+D5CMYb+utMnN7JdN7MTf3jMlbNi+/dvPB+7qI9rmp1byXfrkTZ2/qLbtWm0ta6hteu24s3O5x8R+
+re9xxnqprMR4fjyXgafxR9NTg4lCuqKl/cKIzmVFbxfrKEqDM5W4FMqOuoQlyF0Xz4lfln02tcpx
+yfzdCzqXHPlDlFmLNfuFStNnWHqDsCTZQ23Fd37TaMQJpdsu13YuxAW14mZL8Z40qQovsDvbig9z
+Iywl6WYpWeTdNP/4XbheTQNR7TKQotNtCZ3O2kRu5s1jNoazWRu2M5C6e/brxzeamuZnDiwvqRfX
+VTKOCg9HO5atVGsNEdgwZ48s+Pg9083aWsawYMK2V0RtLb+F1jExTBURro+IUpmuMSEZ45KC5MDR
+9VPMp9gWVKClY5nO9wcRa5lqiKRJsOhOnPUK7anK/n0nT+7bv5I0bDRN3rCB/CH+rlT6enXr5uWj
+UmoqK/mC/pMn9+87uaK1GguXhHkpld28fLopla0CawDOPF6MZ7pj3N4QAvqn46aHtFvvAJL2YBqc
+OyVCcKYHtmgVyiOT8/tU0nQEbSB//PBN0sJeVUxiWq+EfpiYIE/fbk6ZaZiYyn59J1eI+2tbh724
+V+n8LenXc0Qdfx0T5R3U7Y8h6oG5XEjrzSospywA/pBAe1haUtLZlzRFUl/DSzlDT3vS6BVaKmAz
+F03zaS6Qg3PJ9xfFB+TsVNP22eLtMc3iqspK8nEAGZFKi6fNjcyvrf3ddK62ltQNFr/k/drOacRk
+lFUsZWHb6dRxrUHD6hVaJsWSyfcqK2traThPxvJ+UvnZZHOZEAndIQ/6WTS3z1el5360n/LK0z2d
+WMECrC6enkLLfZ5zYOrxoxtNC+Z2HzBi/FzTzrnJ+SPHzzUtpOlI3llRv4t86OGuYCpd/GLCTUGe
+1MnIHD0CE0yfVbK1ZaM+uE5rIfn98vhVzaZfO7tYQ+364YVj3P3iG2vFLkxI5rgknZzzS2qYIXZh
+HIL0PUNjfNzajrOGtmuWstjFZTzTZlR/3WYCOrWZDeSP/9JmaGKeazLsobZrrMH9uRZj7T84LgNs
+sMcNQZ0xnvrqwlmZsULOt6stFPLOnRlzoHJo0bfDhpHFpvfFcySFuBF3kiy+FeZfW+sfFmHaE0od
+oZEFZxk4c07kzleRECIQGQkSPxX/EJ/McPWr+uijKj/XGrFVbGPmuWrwp3omYQgr6ScixEI+i8sA
+O1yTRvs3VUfnH2DNOotogPmXSFDxonaRo+IlluoqgqwCgLj9Gelgt2n0xnuVzDqlA+3gBieOHZvI
+ZE+wCADYwVkFAzG7tpb7ulXD/dPVq8rUNsXTzS1jGjk8NcPC2wuRt7uAHwSCwSpr6RV/x9Jp8T7L
+zzeYxuz+KzZeW2kqe4qLt2pqa8mJv+Lf5INWDfe1w5/wboZKg1wel4nj106nWj/TnrDt5FUJ/fKm
+NZ8zZW3YxXAxr8zyGWJqqeT04tsDB7x7VByGTeCtyeW2bclYyaU5AfNdLgzv1RnwJ+O4Z+65ah/H
+BVovTKUjOW+ilghVnxFpSBeoC2fpoK64ymH9/IlTe01Y/dLah09G3JxfZbto3sTJqQNWXd8pmsVf
+xnzV1942s1tU4+r+US72vp4qRxuGUbg66fxD4o+sCvVT+SoUXKYPCZoxOzcrNcs9IGhT/cGXfTTi
+o0kVmWmxyaoAw9YlLa94s5tkQlRF/yC9X4DSzc7GwyXcJyhcH9LF3r1v0uBgY3iAnYOtomtXPmVS
+kIQ7ynyX3cdXgZrOqdLLcfGY4meWf6rDSYyOMnxv7trmzWQ0XRsbmpCazKyz3Xb2862mLrakUlA4
+ab3YQ7VUZ15rql6SUVi0c/6i42e8+8e5sjfbQoISg1zlMuTvVGZewumQvwc9LeUjn1dab4ahxcmH
+Eylqlnu0QRRSkJ+QJ2mDy8fMJ+VVpl+CvdlKtV9MJKdry5eYCRNSa1pzqXz7AfJ5bVs+LWP3qCU7
+yCPTzXQLH2HMnwNwtzkdONCZPs3TAyhGT8hVcUE1M3o+6Ursl5AVdeIC0z9Jr3HiCfZQba2pmNlZ
+S0NGPtU+LpTZ4/n87nRkRjysBxDbWUedHkStlTnrjbZ0YMj1+/XKErJstnjm0Td3fxVPT2OKZl94
+MCvA60mWQ+uXxC3DSXzC6UyJTB6NiNlsKsWIzzEnavNNXjrWoe1aDEuAQabzMaeTxoPEufMI0O7Z
+0SFhPjjVQLYvE38hSctMwxZdubpCzNlI3MUPZjCL5pNXTC3jmL7MHmJTWys2iVm1tcRV/LG2liwj
++2pr265ZGgtj/l6M5z7ldJL2i3cgneSEFGLB7WwZCRsJMzdy4dSomp5VbHp6j37d5GLUVGbsXOLQ
+toysmN/WTBbHiAcfVo92UX1Feuf0HBPAGWprTUuY6tratrJ6VknnwQG4LE4HXWlZ6Q1EOv8aj5rG
+zMxmRouGGexXpm3k/gzxsWlnZQwTWsvGHyMhM9ounxWv0oLKYv4FQGANAOPG6azzsThBPZLOz+Ak
+V5llDpqILWI8Q+N0sqzyaJ9vlkZItYdUpPSudXrZPiN/6GQ39pR1OplegEZ11ea7fBT/EGzBGbxp
+TfePjlXQzkohd7ZovOSgk1i+tauSW3qq/g+HDHk45Lp4UzxPkkkICSbJ4nmHEG1TkzY0wrQljDrC
+IgZ/Ro6Tlq8+E7PFXuJrZAXzgKwUK01OYtUVn9A9zOa9ob5LxTyxN/tvn7C9ptI9YT5LyeukBRh4
+AsBdxPuPHeh4XqPgDQG05aPY60ZcxatkBkkkbqbvF9VyJ06s+/bJolp+RivDiZIsvYi2H/4h1rtQ
+mkcKHJPTYS0WjMZg9HeSpEW8cQh7ZHqpiItKSRxqZv5rJvEiKYcfi8XM24taD39JPE6tWDR9OZvS
+tosd8Z95+tSYhISCQq6EpAUE3Ptw7c8viP86LOY82vQ2cTp+8OiK1mPdmckjMgZOzJkcHOw682oL
+TdMK813egf8FgsFI64xze4r+/G7JdnFWpiVWjk2T1ymtdAZWJhxufDydOBDNnp1vnxWPipemty6q
+tdu6eOOegzvSYtasXVI/fbFDLfNjQXyiISV+eq+RpVGRwVExfbWKrgzjacPv12rPtSzY5+Xz7mvX
+v+7WjWQvX/fyll2HaxYumLtgfl1rq4uu28Ts+elJHpNffJU5o46OGpgwUKN1jkqJzhnQ2wVYuoaA
+784/BAdQdJwFbyASo9YT2ggUWt6j7Z2vTKuYKlJDwAxfmfaJ6UzswoW1RD6VfdTmPl98SFYxN00h
+4mRrGe4z3+X+Y7knLAJPhsdCBJUSns4szCMF316OUj5xDW3fpO0qIkqSKp4V78z8paFGWLZ4zfIl
+y/Jm6EtYb9NQ5qUl/eKrZyT0G8rfFJ+IJ/Qx4r2Hj8SH3YJJ1pqVF9+5fDEljbk7Nqm1l3tkyak3
+xuk9Zv9yT5Kj5pvv8lr+Ibjh7erxFtkak/as1sLJIgHysYqYQB0taz6QMRidjJZJC/K4xwsjvye8
++Jn4i/i+eJGMIIOL3p9e67ak4siOGe8PHvz+IPL2+9NHe2pMx1TRhKR7ua+er2GrY2LERz/9R/w3
+qSKlZAPZ4e0tPi6d8Kj1+qvk8Kuviv3qBxG7dYc1xj2rfuqTSgImtRGyxtXnoLhOvLxBR/vWXfRe
+JJyrssc1UxLj4jVGDfeDaeM1ZrDJZxGX2RbDhnRrO8h92hrEnXyHfH+0H4AMysx3eX/+F3ABDYSB
+EXpALgzC1aAqJefbfgGnvzSpx1r2ZrhExzpbnVQvJp1E4kJzxKoXc253Eap6lk6GZ08ceUO8ajom
+Xjn52ratxJM4Egfite0fecOG5+aezNUNnzJ12PApVSO6F/brN8bP9LHKb3T/fllhPkNHFjGv+4ZX
+FCmCclgPr3dfev+LL95/6V0v/5frT1+5crr+ZaLbPmPG9m3V1aabxxcvPv7GsmXMm16j8gqqNb32
+1PTuW71loKa6oPeotitjj2wa4T2rfFgz+WHEhiPjAhcMC8vJMkp1YbL5Li/nH4IM60IcAFCJNDpW
+7izdERJjrRMdlVZadiAoKEpHwsgsk4Cs5v1Bg94f7Jv2wojvxFbiTxxJNEkSd4n7pAox+fBOsZuX
+r/79aqayJyEkw9ttzXxfvu5Vsd+rr5LDprUGA7H7+TGxF5eLW8QJ4ihvb2JTOuE3Em/cs+rnyoHi
+r+sOMw3X20yiaa2bz0FSTuI2BAIAMS3iHojLhVJgwbOFAMNyuIfzCLAcyG+8G9lSA3OBIcOJntWa
+Ll9eWS2UigsBmLarnGg28j+AHaihPLWLk2APro4q1tZVfuMLvLfkRtK70ZGQe7hrAd0lN3TwW+AK
+qUDAFVzNZ6VtctJLe+kl7qFzpZ6sL+UFf/aRVRdgiInVdHKbukdmpkdHpWeSoxGZ6VHR6Zn8D1nh
+0enp0eFZVtuC18zxvwML/Y4ywBEK+AJN7oXI1K6O4Eh8wIdEQAQRhgNN2+C3gMOkccB1JO3Pn+uJ
+Vky5KD6p5n8XHwNpO8Y7mHP4e+BBZKkaB0cnOw/COZ1xk3FnVHaexFEl83ABB5CphrrIhtp4ym/c
+v3FfER+vcKL/4zEL224k3XfCR/GRqdF2YEfsGMcujnaO9o4Ojo6OckeFo5Ojs6PSUeXoYuchd2Qd
+OUfe4kHl6GIzHDcp2hbkHu5SMDT3sANmtSdmqbQfmKY7Ncgd3Ik74866c+68ZxdPO097TwdPR0+5
+p8LTydPZR+mj8nHxUbt7KGmedCnIPWxXMNRacJ6YC9KuYxoa5B5Wthf5s1FZdn3KdDgq8iESI5cW
+vpj+HakMUuhiuGKmdKB/sjFKHaoIihjFjBuRns0ne3Z1iA8nJdOdfNy7OhgjJkwJAwAgbb9zD8Qq
+S3mSPylP1gd82AiIYP+2PB3wOSaWo++l585aYjp5vFp8QsuT2GB857gH5hT+dxCgOtWW4xmQcYwg
+k9+4gAV2ITo+0hqNDKORgcwSTaozrV6OHK1gPhytYhGc7XBr5M/6ttZ/GSZKRt9bKpmzVifTmvYd
+/mHatWvTaMpeeOGpdI1JtWWBIzKWJ+3pSrrwN+lycySODK04PsSH8eF8+AgSwURwEbz9X6eO1auN
+eta0DxPxA/eA2OzahXX+Ki+YjfxjsAeg+7NoB41lfXWEU/mJcqcRyp0NjTt4gV5TUz17llSG1m+6
+Qp/UrnZnOBk4yIbakKHggG0CAdy4LwGw1L0uWL0cMFkO4GCFgi3YUeZo09W2a1dhOLFcaCwNF9to
+GoYNa0/E445EENMSHsRq/h7YQdhRAexZW3vp+qUb70aS9ngHvwX2GKU92FtzohMPEiEiKz06Oj2L
+v9fBcoBpe8Q9MB/gf7fekU+0LOUUP1BOscDKLoiN+Jj6LeAemO3b/TpriYbWQrdj1eIThjnWXhdF
+rI9mM+SIM5hiTicPhH8DyAVm04L2fd70OSg6aQ2tCzS05Ii0pfMwGX/oUFlZGSktI/XlorNlHf1l
+Hsh+/i50pdpXXLFOMfrpAi9r/CLCNX5pI/w0fFW4n5+fX3hEmkYDwJgTxXhGj+MSdwDiotbqBK2v
+IUYTrZaGznQpH119xzAhQpWiZIPYRLjTkw+R0ktxuya/NEf8anRabt7lfyRxTuWtVVWkqG4kAJjN
+5sdiLrO0HZ+M+ZLNtuCu6YRbxnzIFlruworHu7D8YWmq0tZfpvXX2Pn7yhy7cuCvcWQ0AfJH97+L
+vnE/mm5f/+51V0cXmfzG/ahUR42M2Nuwk7vwo/3IZAeAiEsPon+nW30V8fHH1S4lnhVO2wLkbfcf
+XYhMtdPI6R2Ecl+Nb+/cAYNlcu6f1AzxO+KrImR4qgMvI5GBfQMrA+cF7gjkh8PwZ+7lUlrWVhr0
+dCkllV2fuaIrtc/8aWk9kkfKQoJn9wgObMu62nlT1H/6jVHINZ8Xh4WRlPj3Lbd2EfMv5l9IIkkC
+OwBdJ62544ieGaNGZfQcsT6ruDgrY1QxmM2kSRjA/ttGL9d1reNOkYFyGVTBW0x3kN6NYv9tkya9
+Y4ZK78CM71YL/dgf6XeSf7msawN3ivQDa5iPbIo6wuw6i3vIlOC7eoyv87s53FLLu9XCKOk7KZ5n
+vhvF/vjUu47vpgtlXJen0jKbO0uG4bvZwgC21WZwp/jmcaeY0Z2+K5HrJP9yGeKcbfluDNtqM1au
+o7iYWdJ3pLr9uwybok7fzeJTLWmZLYzhQ20S5Lqus4RuTIZc1nVu+3ezhTHcAHyHzygG/lsmwxLm
+GK6LTbUUJrP0qe+mC4VcF5shlneVclnX+k55XWjJa8yrp97VC4WWvMa8eu67f9vckeukZ3IZc4x7
+hZG3p/OhzeGOdDLHBDm5Q9+1NfAjzP2FJnlg11m9K+UypkV8DCXAwiQxnruLeuVISLLORlru78YN
+3BqVRqqHOkGl1PgqlJzWl4r6dGFxoC7Q2eLZEKPxY0cvnlW3ZEndrMWmoMe3pw4hb4oPi6gjgQhM
+lrjoA/Hnz3YlsOysAJ9ZVzTbl176Wey7hzm3cOPGhYs2blzU8oFX+ehNLR949UhLEu+fWrO/9+pp
+oX0Lq5Tu40UubuLAOctHlko8wnwXeQRqbIm0+Krz/nO68oO2U/KYjSBzr176oueaXnjh/Yi2tbVX
+mXy+rPU411LelvefL/z8cKcnG/SkjV9AV9YDEFgMwA+U9g1JC5noeiaqTCQ/khumXnXcNdN4ZmOr
+oY61Z8OZ0Oskz6Q2KUneSryf9xOuDOcR3C1zCPQ+ULUfyMEXqCae7gJUhRNmvOXyzzV4Uyi9IXRp
+8dLYzZN8Xv4ly3rd5xjpYlB6vMzmCZq0lC02MwAYyATgojkdsCAABBGid7YlXHSbM3l9wTnxpniT
+07Vl0Fliyx2mmB47adWadJ+axM3YZ1MHjHN5+f7y8oHPJU38gXxXRpLLysTzZeLoP0me+B6wMAGA
+Y1EH5ggeAAHWm/gsy3yeintjeVtpOXPS1K+cbS4XB2C8J8rEr4hfGXO8jPiJX4lfW2MEIDAAgL3N
+6SxlQkPGojFomN/FxeRuWVszuSca2dIysS9TLA4uE79idAzcLANgYTwAtwd1mRrQWftTgzTRYL1E
+MFqaJ9Ep6NIPTXRsTKCWuUt72RO1q1fPPH6YcTl0fObq1bUnTtStaCApdSsaFpSVlZWQQROIzYTB
+JeXjxg+eID6eMIgMIN8tv7J8+ZXlYL3LjlvJZYAfjdegly6liKH3bkqHOetVejqkBNRBCGza3TIx
+fcbMo+JnR2dOF9PL7m5qIQmff0qSW1an3Xgv/OimNy5demNzS/h7N9JSf9r2Deki/ufbbT8BQ9QA
+jIb/FexAAZpUuYPtKHaCvSPpqhDAHro6yS/cuNB2Ac97+V28GEk6dS8exKBXORN1nnR+QR6XXpfB
+T4rDwwr6PKHa2TZJPwtLzN+zP2AcfhCX6u2qcmFH2WrsVd5jHYttJ2gFABVRa4i9h7sTo5U/un/j
+vkX8++7R/Qs3LsjFi/L3I4lV2tJHu+A4G3UOOEFkXQDBdukdJ6Xly7LTA78Uxa++rnutZc2cqtWq
+Yn5SXJ8+ccY+vZ9siwj/5NjJ+3pRPBHyztZF2xvqwGy2ptFJZl8CYLnLNd18n/uF00Eg5W8hxODc
+ef6UXlrJ4rqZTiyEc/8j7ee2BVvoESZXHpN3/7n8w5NvJK+ILxNycnsWEt0/y0jy4tuioqyM6fLt
+9XLxnN2OA9u3hWrPTkmYCgBAqI5WGI1r1CpSA+wFN2ewbXJRdOVYmOzIjHYpdmf9eQd/e0e5vxPx
+5xWgcaeCDT3hRf4d5ljSfXU0PbgoPj4+ssXHo9iDwbGgU0HuYWeUZN1xZCGdtkQl2ZaVHjs9mOF/
+sx7O5wzD1b35/fdvvk7unxafiJfES+Jvf7sflYUqAGEWrhf2BK11nlZaEC1dfM3IBI1O70vvvQ4I
+1AVqtIo6qqJh8sn8W2941M+x/eCWOPfaKaLduk7Wdo91VtVvFb84VcaMnc14l198Wfx+tnj09KWy
+PduJZvbDxbtN3wIAsOZ/mr/l7uKaikAIt7RZB6IV2q+3ti7jofKwxkXasdedHnfgJxNknP6wuDF2
+b93FWZPt6mbaT551sW5v7CFSeviwyc4mNXv50pxeZO3S5dmpNmVle0pGkq2LPyn/ZPEW3CHYZihv
+GVNVUnK2/PQ7laOPAQGl+VvBm3+Iq3XCidaXUSixW5Q2Yig00gpnTkpaoMGyzpmfqf2Knq1AyFJx
+jsfwBrHq/eGUjzaJ770qvvruum5X3yRkIz1eQbHZerqCp/vsyzUvd5yvcGPL97M9PNqPWKD5Yumv
+u0EUxOMqZEteWJYwP9MXdieWTNM/m0XO1myhN4Uebrs34ezgYqfm2bs2WLLtk6fziXwlZdCecnFZ
+uRj//R+4l+/4hRVS7j2VYwAEck03bF630YIzgLMG7xbV4JWlFjvXZrz4K3FoO0Yc2xyIvO0ocRB/
+tdFO+L1hwgTb2RJ9NhyCXxowNKtt8/p/NtJP2VzxIfur+AubQ4M13ZhgO3vChN8bJApAQG26IfuX
+jRbvA9Y4a9lwotUQFdEkEyPdx2OwJWqhfOvkcfLC4rZIrqZ1Cnd3iF5eWnH98R+86snPNlpTG+kR
+Gdw6Pqb1IfGPIZmmwQncfD4bgECa6d/8TpuVEABAoumOEmjXbKG+hGVkDGisTE/tTciJ9UqWKIg9
+8a5aPrRPYl+FTVf1BqXAu4qPxJ8rV4wqjEh3cXAQl7HvberP3GKmzRiWNyQ2SlXatk7811bTUNOx
+6cP6Dgnq5jYdwGym8dsOt1kpD+z6BoBc1vUN9i3r864e7c910nP6XlZnfS+/9dx7nfSe+rOLg874
+whCfUWPQODk7PQNRRu9YVjEs8xxOcS2Z5jmt+WmoLKkWG4lrc/MzcE/8wmQQR5LQAXjpL+4upiLx
+C/HzDsyd0mQEIIyMxqqLMVqi1Uc7awwaeue9QeNklFIqE3TS1f1aP3pUkiDTqNhJ/pNo/MtJVGRh
+RIbKwcFjuZu4mZQzrCAuJosCB2Kiw8P6JPaT28gE9WIvUiEu5Tkym/wqKh9gikLDgnVu09mxD0fP
+nfpPMvEySZg+PC8sjMRGqfR6U9A//1HxEAAI5w7ATRMcgAWgsyqce1GR4PDHr9I7RsO7CYnA4woE
+hUbFTSsCM/9NWyrnXgQEAIB8Qj6m30Y568ktUdxAPhbDAMxmAACWIR/LdV3PkVAAudD1nPU5Fy49
+ZwYDPP3+6TA1rJaQT26JZAIN9akwL1i+ufBMmBcsYT73ni/6kzh1Xc+xjU/FzYw4kfDv1tdHOib9
+Bj42QP/uRG4dZLX/uGVKkN+yuQMANsDgF/idbJLoBaDw+uNWW6X8luV5+1/XK9x7UAo0+P0AzBIA
+1kjU7CG4KrTAdl4Nh2SboUrmA2sYPVxlfWAX6wOrOACN0AK3mSWQw7RAEbMEzjFHAHg1LODV8IRX
+wxJeDVt5NaTQMHg1LOLVcMBiz2eOwE3mCOTQMKyGy4ElNgAL+MvmR/w3UCX4wFShDKr4T6GKXwZV
+QglMFZ5AFRsEVazBXMA/gSq+H1TZREGpDKBEWAFT+dFQRW1BgCq+Dg7whaAUTkMxf9l83yYeuvBH
+wJ7PNt/jV8Aw1gcO0zSzPrAd4wcADmA/dxFKeR9I5OrQLuVOQSnzO+RQN6+DHGYF5DB15kTuNiQy
+KyBRth5y6HPuuvQd9cd+DDmcB0xljZDI68DINZmfCLdBwzWAG3VzRsjn1QSYI8SG2pa8xLzn1bDZ
+kk/jebX5IfXDAQzjAK7aAPFg34PZ3PdSmmne02ccwE0O4G36jDVAEWuAEg7IIl4PibwatjNL4DQH
+RMsaYA37NozgvodzsnOwxmJKaN5jvv+JsWHMX9KywHLoZNigdjOIDYKRQjVUWcvhOdNCPIQVUELL
+orPBshgPVfxgSKT5/mdG1gSlXJ1UDp0Ns8L8K5bFCrBlVoAC899SDs8aLN/rkEfLorOhZYFlTe3L
+5kdY9s/YFDtX9zc2raM+UCpUSzbNG+7Uf7dpfeZ9/sam9VtnfluyIYcNoljNJjYIJjArzB+zQTCc
+WQEOtAxoPlhtYQJUcRmQQ9sIrae0ndC6Sg1tL8yKv7GLoJDZCGG0HGmcz9rCA6jCcqPuFTAV8/YZ
+29YNZsjuQSltg7QdPGvTdknbxl/ahaDEdvOMbYnX+L/atL3TNkfrGJavpd1j23vGtrTvRP4bcyrl
+PZJbspEPWcu6GqqEsg4/WOc+lXgO1waz+WVmA/O7ORHL8Ag4yaqlcmGDYJylHLIwn52ggPINGZj1
+1rwUVkAFdx3G2PSHqUI1lPKjzakYv4WXYd7sgwHMRrPRki+DrPkjnIYiXgehwi6YLSihiv/RfI/v
+bX5ixcc1QmknU4/8eQ5UcY2g43LgK14Jicww+JJ9CF+yB8CJuu3+gKouL0CVbSGU2jRBlewaVPFd
+ILGLAUps66BEtgtyrG2EDYJCZgXYtNeF/7GMsD08094ov6FtXrhC24H5n9b2YMk/+2frmxUXs8Kc
+2DnN1u9sE6GK3yvxJprXz8WzAkqQNzzb/p9pr2yQ+TNmhbmZ8jpmBTg+V/+fre/PYH2ufnOwlQuF
+npb2bU/9CQ1Qyq2BfK4cStvr6DPp+at2Z7Wt9Z0jkCjshRz235BI+YDsEJRSQ8MTfCCcq4MAvh+M
+4wvN79B0YT15BfLYY5DHfwPjhNNQSP2246f8pMmcKKRZ+JMA47A9W+OT+k2rwf6TGj4MSvnpHXxb
+OAM1XACUcE4wXoiAsdyPUErD5WdI33IvQil3Ddy4Blhp4dMF1CCP00r9L2sHvdhd0Iv5ARL5XdgH
+Gy0mhw80J/LLIIcPgUzMl3woFVZDKb8ISjkGctBMgBz2Y4vZAQXUcHsgB40HTGVCzYm0HbPfQ461
+vbX35b9DKfcl5LT36ZS3JEMBdxnyuTpLW6uDei4HtlgNOxxWY/nMh14UL98TSvmBkMX8U5IjuMFQ
+QPFb/cseQ6lNBpTK2qBUWA+J3BVIFDZDoiwQcvh3IZEfj896skugp5ACiYIfJLLTIZythHDGC8KZ
+y5JhqyCc9YNw7lUIZ8dAOJMAO9lDsJNZAtfbTTVUo0mGcCYFwjkgdpKBc50No4cbkpxBFlE5yfqb
+OQK30FjDeQGKqOEALnNAlnAADzggeRzAMQ7gETsIGJYHOfkFwpkjYGRLpHjZX+GTZw2Nl/0arndK
+xwMaDkDbYQDTIoC2c5K77SZAGwC0XQYwLQFoexvAlAbQ9i1A2yPJn+kEQNtnAG09JH/o9xSAaQGA
+aQyAeQ5AWwuAaTGAaSiAaSKAWAkgfghgagQwOVuMSrLb9gK0fWmJj4bVDcBkBDBpAUx5AKZjlnS9
+bUnnuY54n0ozADzxBDAdBGh1BmhdCPDHLQDTXIC2DwHa/mnB4wdgmi/5p2mmf2YfgLYVAG2V/H2o
+4lIhi/IXbhIMoGVNDfK9gZDFATlqbZPUUPmbtmlLeV6lbZ3KiTI9ONr0A0duLjgKr4KjLBwchY/A
+kdWCwiqbU3mcvwx9UBZcAcMoD6P1m/Z1KJdTudEil/MvSO+oHyqf0z6MppHyDfqcyuQyDopp38UG
+wWj6nB8HpcgjrkltnrY3fLYT5nNXLDIefV8IA7hr0m/qjwfIon0rr4csbKPjYQB9x6dBDtcC87k6
+Cy+aBAMwzM1QxN0GNyEL5gvNUMRqQcV9aQn/S8ihz7DfeRlK+ROQxc+B7oIIjtTmp0FvoRlCORMM
+aufBFjmB5g/bYu7JnYByviecEdJgK7cNhlG/1nRQnozfWfnk+A55g+Ydvx/GcC0w0Cp/dJbBhRLz
+a3yeNEai/YpFxhhJy9PmIMTY/ApVwhPza0IUtaGKvwKu1BYcwRXLjxr6/XypX7ExgSvXBpPRDzX0
+OxpOlCSHdi4/jJOWHw1zN1TT8kM/EaC01UAVNcJQyJJ9DVV8ieTfdilUCdMhC2X66zAI+WAUJHL/
+hBzaJ8iGgRs3HIpoPUVDy1EF/kI85NDfWIb0ubVfoeV3FFyFdOhLx1R8AOTwOZAlq4Qcang1JAkj
+IIkbCknUv/AfSOTtoUf7+MEEIVyUJSzK76VxhALL5Az4C99CFq+DEEwHjf8ouPKpkCS4Qhb3MSTZ
+2kCVcAASab7RtHf5GqpsfoBE2TJwlcWZP8FyLYdS4Q648pPA1WrzeyGaGwr+fDFUcYmQyOfBOC4S
+EvmPoYq71xEer4bJXC4U0XKg/YMQBSU8QLTMF7L4U5CEZaGBLFsvqBKuQ5btbqiSLZDqDtYlCw/o
+LPuifQTsaX7JtoGS/wIG8KL5Ct8EWWhLY4yq9jRYxg0Y10CLn4GS/ErrEY6NLLZVlnpKRulkW8Ok
+dYyWs1Vms4bdbv+VbGPFdQVcsSyesWndo+VP6zQtV1r3sPzbYDY3HEppXab1yXYdxMiKwB/5jg+U
+WscIQh1kCbMhS9gCJcJl6CZMhSxhK9wX1LCTPpedhixhAZQI4bCTr4adNpdhp3AeTgsVoOTPwSSh
+Dq4Jr0B34Qxsk4VDseABDUKR+QPBBl4RSiBLKIaLghmUwm64K3OCLHxWBkr+M6gSomnewkReATuF
+t6FeOAMgHIJ6YRXUYX/qCUXkEwDWF3jmJ9jPqkHJARTJNkA89wvk8CuhlF8JObwAEfw8KOWUEM+7
+QLwwAOL5EVDKKyGeqYQI2RWI536CGl6Q3vN2sBTtRNjJ6yCeqTVf4V1gEnvY/C0fBQXMffCXNUM8
+Nwcm82kog8XzXSBeVgnxKEf7QwX7FRTgbxdw5e5AhVAL3YS+EM+cgJvMASji1ZDPX4EK4T2oEHZA
+hfA1NPBfQoXsM6iQDQB3mw+ggVsI7gJAhex1GCJbCMv5X6HCZhPUykLBXXgB3G36QwX/FVTwQ6HC
+xgdqZXOgwqYbZPCfQQUNi/9Cei+zhQr6jj8GFUIXy7P9UGFDYAi/HSqEe1DBfw0VwhGokG2B5dwA
+qOBWQwV3HPoJr0AFNxuyuGrox/0K3YS7UMHNhApuBETbLIQK7jCapcJmqODoOO0lqBA2QAX3uflb
+vg2K+HXQTWiCCu4QVAhfQgW3BPpxZ0FJv+HHQQX3OlRwe6GE2wJF3Pfmb/kZEM2tgQruY0saqHsl
+VPBRUCFTQwXPQgW3D+q5f0MFtwoq2DlQxNyAIpQ5o803USdCeSjlX69CLzSTIJFzouMgyGFN4El5
+FzcYIjrzUK4O5qM8HgZj+TDwp+N0zg2S2nUlk2AidxZc0VAeewgEvi80yMbANj4ZmttlXjpWMMJ4
+LgVK+F8hCXkp5S+0n50EE/kdkEvbG/ui+TFvZ/6JbYGebAv04LpAT/agZDgBsrmx0JOYoCfDm9ey
+b0NPdjD05IMglRsPKWg0kM08ghi2BHqy16AnOwy6cimQxIeDwLpAKho9pHK/oh1A3aybZJg3JEOf
+kVKIYH6GAO5HiGCjIIJVQSpzEVJZlcXoIYD8AQFsFGjZwTCA+mNazW3saQhk7kF/loUI1gZS2QUQ
+x3YFOdkKEShfR4GW+rP6YX6H/lY/smtgxPHMWOhpHbtg/3UIBO538x1mhflzVi+lw5oWml5yCSLI
+DXMjYokCLfkaIsi/IQLtryGb3DLPJ+MggJSa7zEPwUDTirh6mBvZJMRnsOJidkI2ec3c2I7NE1KZ
+X4HDtNJ0vwQx7eae9I1FLvXoZEdT0z4OfUY/0llP8pQ+7L/Y/6uejMpSVD/ztF4Mcp6x7Z59zgbR
+vsr82KInk1v7s+fsDh3YBGYFeFh+e1lsW5Rj10POs/Zf6cis9n8dp4+25J/F/it9mcX2+lu9Wch/
+15/9X/VoVGdu0Yuibe0r/5ttlTHabavs8Re6Nywro7nNOpaWJUj6duZ30FNb2AXFmF9/pp/94b/Y
+F/8P9t/Vw/v/xUb90DN62D8r9+//iy35Qz3gf7WfLzdJB/hf7Odkwmds2Qao4vZAIh1v/a2xzA8I
+/wZb4d9A+K/BXvAAzvqcy4WSPzPCx9BVeBM4m0Vga7MNiI0Cutr0BA7HcX9jZG3QVfYQONsjYGv7
+DhDbLOhqOx04myvgYHMeHCh/lYz5N1Zr/o7VQiqrBTmrBYbVAs+FQFfODTj+G7DlfwDCT4au/EvA
+cSZw4H4Ghz+bN7CUR1fhDnA2v4OtLQ9ENhe6yo4Bh+PEvzHC+8DZ7AJiUwScbCc40LHk35pd0FX4
+HThbe7C1dQUiWw1dZe8DJ5sHDrLz4GDN9/a8tOSLBV/HvIc1fku4/4/L8f9hufy/C/ffpb2z4YDM
+R10H2qhzOvan6c4FG9R1zAMbqu+g/QYHsIwDeJl+w2rBk9XSiV5zG9V9sFpwRxMEw+kcDHcUbKiO
+hAOYywHMwnierQd0rE+N5TfqNrLN92QjwAb1KvnA4ZwjwFVq/1n+yF4DG9TD3AEbqoehehJJZ0Nq
+OACwAQCrzbwIwAAAKcS5WJzPJZsA4F340z+cu21BOb2IAzqXiAY4wDlHsOgIVzFLcI53FaeG65wa
+VnEBUIQ2QDyaE0RPDXMArvOFcN0G4PqfxUfT0uUUVHX9GqrIdejGmKAbFwTdOruZY2DH6mE7q4dz
+XBwsoXOTFnwaUiguovO2dM5W9j5kCjJYQg2vhkYO4JbFaJgj8AG12U2wyob2x32gG7kM/6c/1gB6
+KuexBjCwBshgDaBjDdDX8lvPxcMiqyH/gVLyHxiKOlgDxOA8q2UettP33f7r9y4W+ZXKbyUQju7p
+EE5lV6ovRTmPxqGHAKo7pXIdr4Y4Xm1+bFMNHvweqBKSId8y167k1TCCV5tbeTWOhwtt/g0jbUbB
+SNkK7DNG8zoYI+sHRbLVkCdQHdxjKBT2QLENwK/8p5CH80m7YLZVp8cvg0L+c8jisyGaPwTFsuVQ
+zB8Dg6CHF7lqsOGqQcbdNbcJD80f8BvBhVdDBtvF/CrzvXhA5gOjOC+Iss47d/m9ff65qJNdIjsH
+BsvvDItdYnH35ZbAGtQxVkKV7DhUyURM130be/P9LgPM9+03m+/LTkAV6hTPgn0XGdhTOc66loDK
+brRv5r0hR3YQcmRKSY8vU5qf2L5ofmIfbX4ic4Ic1JE4gZvtC+BGZUPrWgAq99nSPC6DKrQ7zQXL
+zkGM0AJ5Qgs5IbRAtcW2/CZhQgtRWky49Jz6gSlCC/T58++g4vnn5ESnbzvb1F+x0EK+EFrIb51s
+O6GFZAktUCS0wDKhhThavjkttMArQgtkSYa17YgHqjv9zrN8a4nD/Lv03vx7x7fWd9a0/Cl+inmT
+ZJ7Cn/c8tqe+r/7zcNEs6mQsv9HvXqEFVnf4hyKL/Ux+Pe/mboAtp4Xx3CCYRQ2/HvRo7sPtzkYY
+AQZq+GMg77yGBdfAVMJt9r5keDfo3m7K4EthP3yJayFaiAdTALfZG/AaewRu807wGv85kVEjtMAt
+argQiBA+hAjbaojoHMf/amwyJPN/+OZWu7kIt58ynd/9X803cJsaWbNkuEWSsT6nxibl+d88A/XM
+W3Bb8ITVVp5vtWl6OQ8QbAD+g+4Q8ioXAsE2AAfslkKNrAjcOYDDTxtm2fPPOp4LwLwoAOyT7M5u
+2EfD590I5ZsnaXq46+b7kmFcuevkCncddShJf/2bcZUMuSIZ6/edw/m/vH/WPOv//9PMs/lBn5Ea
+y+8ayTyL9//y/H8xjKtkt7v3djLWPB7f4Q/LbXyn9Lbbgs58//+ZYZ0FHUCHoc/4qo53f/WN5Kb1
+8H8x/ETJWH9z4yQj6EDobDhOMn/1+//qD00rVLKPJFtohSkW+28NZ0RzUiiAk7K3OwzrBkJn0/7N
+s+k7QfR/lg+d/dg8gsrn3j+bls7h7oaT1PBfSIY+k4XCK7JQKJeFwv7OhrsHr/K3JSMLpYYMkIWS
+s7JQeCwLJZwslNyXhZJ0WSi8IQslg2Wh8J0slKTJQuGY9Td7CQ7wHnBAFkpqZKFwir0ER5kv4AD7
+NRyk/RTrA5NYH6igecv6wHHWB3YLLbDUYsYKLVBvkwHVdjWQZ1fTbv+Vm9rV/BcA/BfE668NW/3/
+3e//f938f3v5/v/L/+/M03L2n9ntMneBZJ7zVyEZqz/r76dM9TM2/XZapzjCLYaOlRothv5WdTJh
+z/z+E0PDI6GWb7OEFlIotDCDhBaGtYy3xlv45BShhXQTWkhPoYV4SqZ9PPAn+UBOWMY7WZ1wWsZO
+1mfWfCTXJdP+u5P/p95n/cn7h5bvHnbE95yp7uTnYaffndOMbtlnHb9ld6zYSJHQQlKFFvgXNbQv
+sXeHCC5EMtIYpX1cQMdSEZ3svI78ICc6Pe/0/tmxG+T9XZ9MDX1G1yMyK8CfHw3j6LyHdS6nfa7G
+MmdA1yDiGhmqt6TrEXubn9h2gS6yRtDgOqgpkIVrexiLXnQSVNN1ceyL5n/jvgC6ZikCangl1PBy
+4Ph0KOG7QpXsCNQI9lAjexVquIewlA+BGtnrUM47QLHFXcMHQY3wT5gqOyy5qZEdhBrZShgp/Ag1
+vA5q+BDpOR8NRfw8qOCdoUL2OdTwU6GGnwA1wodQI9sH9bwj1PDdoIIPhRpehBpZP6ig+i5cx//M
+mIc+t87x4HofLeTYfAJZMndIsl0KzcJ/YLitN1TJoiDRrgCmykQo/bM1df+rYR7BVDQCHLYaLA9n
+XNuqFGwhkfseBuMc9BhI4VIhhbeBFO4bSOG8IJGm03aF+ZFsOZTafmB+JNsMpbbR5i9ltVAqO2N+
+JGR2sl83PxI2dlpTb1nvIuRCFVsLibJp5t/5w+bfrbjoel7uW4x/Mq5B7QdRuC7lNEzGPQCW54IP
+9ODqIIY1Eg+6f4WuE2mfn3tiTqVzs1wrpMr2QQ7qtkZDL1qvaP7abjE/4hNhIp8PNXRNgWwtzOXz
+YSKfBRP5dyFW9h3Mw3f5UNPFERby06GGnwabZTssz6dBjcwE8/nHYCuMgTq+FXrIlsNcWgdks2Eu
+XwU1/HyIod/wN2Am9c/Phy18AdTQc8pkzjCF7w81/EtQww+Q4kFzCWplr8E8WSLUYN0GmM0BXOZf
+hRy+UDI28ZBtkwxZQij0lU2HEvh/859NDHjzl81j+G/MdwQf6CuUme/wn5rv8MvMd4QSCBeemO+w
+QeY7rEHcyz8x3+H7me/YRJnvyADXRvXlR5vvCCugThDMd/g6nJuk+0HO85dhvE08NPNHzHV8Nozl
+V5ivcRchg/eBQVwdFPI+0I07BYOY383zeR/IZlbAOKZOXMzdxvXBdE39Cl4HK7jr6Hcw7wMt7Mew
+gvMANWuESl4HS7km6C3chqlcA/Bck7mFM5p/Fgzmh8LbUCw8hGLBDYqpblO4CYXsaShmr5rLhEdQ
+zO+EYpvPoVB4DAP5y7CAfwRq4QlkCQbzI5s28wPBAF34Y+YHfAQU8Bshgvsa7QJeDQVsFaSg+zqk
+sF0gi/nePJ5fAlnUbTMDsvjruG4Tv6P+OCMkcvNhMHsEovjrEMHtMX8u3DU/4C4CcHvM33NLEPsa
+pk48yq+CYn4VPOFXwSJ+FazgV0EBvwr28atgPr8KdvGroIxfBZMt62WvWmy6NvX3TutSr1reLbGs
+ly2wrImj5jJdp0zn0ulv2n5IqPkXvoY0Wcxqi11vcVN7Ol9DZv+JbXVP7/QNtWcDtDXwPjAJ2+c+
+WIx1u0law81NhAncGBjAO8B4uv6a7pPCeQHKE3WQjmvnF9F5dPM/hTOgpLYtgVxqbJxBzX8Haba7
+IK3rSEhTeEEa/53FuHHu1OD6KgaACwfo7OaLgP4RAF9XIPwgV4AuNl1ADkbzcZBDvLkc5JBgXgly
+SMQnSWYfkMMOSkkgfUISzD7gD0bzSvCHePNm8IcE80HwhySkvcxfgD8MQjoEnxSZd4M/DEP3DqRn
+kJ6jlPjTcEgA9U8C0a1Ddyy+NdJvSRy645FiXOQL9Pkl+nxEnwhdkNpR/4I9urui2wHdjuiehe7Z
+lMoWih+Dv2wppfa/0nDs/430kfkLCAA5BEIABCINRhqNNAnfLkL3WqTrkK5HugHpRqSbke5A/1fQ
+/Q7Sd5G+j/QrpHeQfov0O0qJA1JMA3FCqkQaR0MjCUgP4JNDSB8ipSkPBEfzcQgEhfk46NCtQyw6
+y5N48xfQDZ8EgaN4DoJAbt4NQaBAt5f5OASBN1J/cx8IggxzNb17yLwSgiAfaT0+b0DaiLQJaTPS
+Xej/RaS7kb6EdA/SvUj3Id2Pob2C9ADSg0hbkB5FegzpcaQnkJ5Eeh7jehvDuYDui+i+hO7L6L6J
+Pj9Fehvp50i/pJQ4IlUgdUaqQoqpJZgS8irSX8wrIRhzMhjzLRhzMhhrQjCWbzCWSBg4mhdCGCjM
+oyAMvMwHIQy8zQchHOTmcggHf3QHmq9COEQjjTMfh3BIQJqET7KR5iMdbP4YwmGYeTaEQz1+24C0
+EWkT0maki9D/WqTrkK5HugHpRqSbkW7BuLYi3YZ0O9Id+HY/0leQHkB6EGkL0qNIjyE9jvQE0pPm
+LyAc3sTUnkL6FtLTSM9g+s8hvYL+30f6JaVEiVSFNJKmhETRr0iC+SpEgNE8BCIgzrwSIiDe3BUi
+IMHcByIgyTwbImCY+QuIgC34divSbUi3I92Bfs6gn3OUkkAaGomkb0kCfStbKKogQrZUVEEUOJpn
+QxQokNJWEIWtIBrLNBrkkAvRoEA3bUHRkGj+AvTgKI4CPcjNK0EPCnTTb/X4rR78zRmghzj0mWHe
+DXrIRppvPgh6KDRfBT3Uo58GpI1Im5A2I92C325Fug3pdqS7MJwXke5G+hLSPUj3It2HdD/SV5Ae
+QHoQaQum4SjSY0iPIz2B9CTSL6lPoqJuoqGpJVqkkeYvIAYczZshBnMmBhTULVsIgRAjW4rn/RvN
+X4AB4s3HwQAJ5o/BAEnmc2CADIgAA5adAXbgk1345EWku5G+hHQP0r1I9yE9iSGfwW9pmRpIINIE
+Go5soXklGGRLzSshFltrLKYtFltrLMSav4BY4ovUz/wFGMFR/BiMIBcfgxEU6KZlZ8SyMwLtlYxg
+hEAwQoZ5FBghC2k20nykheZyMEIR+q9H/w1IG5E2IW1Gust8EYzwIn61G90vId2DdC/Sffj2ZaT7
+zdvACK+g+wC6DyJtMb8GRjiKz4+h+zjSE0hP4vOrSD9A+iWlRIVUQ1NLtEhpvxYHjuYMiAO5+QuI
+AwW6aQ7EYQ7Egb/5HMRBmPkqxEE9uhuQNiJtQtpsPgfx4ChuhniQmzMgHhTo9jJHQzx4I/U3V0M8
+RJu/gHjIQD/ZSPPwST66B5tnQzxKCPFQj/4bkDYibULajHQtfrUO6XqkG5BuRLoZ6S4M80Wku5G+
+hHQP0r1I9yHdj/QVpAeQHkTagvQo0mNIjyM9gfQk0jcxzaeQvoX0NNIziOIc0i+pT6KkqSIqdEdR
+P7KFkAvxsqWQCwmYewnYRyRg7iWAl7kPJIA3Un8zfZKB7mzzEEjAfEuAfHM1JMBA825IgMHmg5AA
+w8wfQwLUo/8GpI1Im5A2I92F4byIdDfSl5DuQboX6T4MeT/G9QrSA0gPIm3Bt0eRHkN6HOkJpCeR
+vonpOYX0LaSnkZ7BFJ5D+iUNjaiof6KmKIgH0ijqEzlJAnKSRJCbj0Mi+COl7T0R61Ii1OOTBqSN
+SJuQNiNdhH7WIl2HdD3SDUg3It2M9ArS9ynFkkoCR/ExJIHc7ANJoEC3l5mFJPBG6m8eBUmYkiTk
+DEmQbe4DSVguSZCP7sFI6/FtA9JGpE1Im5EuQv+70P0i0t1IX0K6B+lepPvMSZAE+zHMV5AeQHoQ
+aQvSo0iPIT2O9ATSk0jfRHoK6VtITyO9gml4H+mX9AlRmYMgiUSZ+0B3cDR/Ad1BYf4CeiFH7YVl
+0Qs5ai/ZQtgAvWRLIBB6yZbCBuiDnCQf/Q8ER7MPDASF2QeGgKO5KwwBhbkrFKG7CN1D0f8wcBQf
+wjBQiA+hHscU9TimqMcxRT2OKepxTFGPY4p6HFPU45iiHjlGPY4p6rHd1WO7q8cxRT2OKepxTFGP
+Y4p6HFPU45iiHscU9TimqMcxRT2OKepxTFGPY4p6HFPU45iiHscU9TimqMcxRT2OKepxTFGPY4p6
+HFPU45iiHscU9TimqMcxRQOia0B0DYiuAdE1ILoGRNeA6BoQXQOia0B0DYiuAdE1ILoGRNeA6BoQ
+XQOia0B0DYiuAdE1ILoGRNeA6BoQXQOia0B0DYiuAdE1ILoGRNeA6BoQXQOia0B0DYiuAdE1ILpG
+RNeI6BoRXSOia0R0jYiuEdE1IrpGRNeI6BoRXSOia0R0jYiuEdE1IrpGRNeI6BoRXSOia0R0jYiu
+EdE1IrpGRNeI6BoRXSOia0R0jYiuEdE1IrpGRNeI6BoRXSOia0R0TYiuCdE1IbomRNeE6JoQXROi
+a0J0TYiuCdE1IbomRNeE6JoQXROia0J0TYiuCdE1IbomRNeE6JoQXROia0J0TYiuCdE1IbomRNeE
+6JoQXROia0J0TYiuCdE1IbomRNeE6JoRXTOia0Z0zYiuGdE1I7pmRNeM6JoRXTOia0Z0zYiuGdE1
+I7pmRNeM6JoRXTOia0Z0zYiuGdE1I7pmRNeM6JoRXTOia0Z0zYiuGdE1I7pmRNeM6JoRXTOia0Z0
+zYiuGdEtxFHbIuRgi5B3LcYnS5AuRboM6Wr0sxrlxtXoczX4m7+A1Sjzr8YR32qoR9qAtBFpE9Jm
+pHQ8uBrHg2twTLEGxxRrULJag5LVWhxTrMVY1uKYYi2GvxbHFOvw7Tp8uw7frsO36/Dteny7Ht+u
+x7fr8e16fLsB327Atxvw7QZ8uwHfbsS3G/HtRny7Ed9uxLeb8e1mfLsZ327Gt5vx7RaUFbcgx96C
+suIWRLQFEW1BWXELyopbUFbcgrLiFpQVt6CsuAVlxa0YzlYMZyuGsxXD2YrhbMVwtmI4WzGcrRjO
+VgxnK4azFcPZhuFsw3C2YTjbMJxtGM42DGcbhrMNw9mG4WzDcLZhONswnO0YznYMZzuGsx3D2Y7h
+bMdwtmM42zGc7RjOdgxnO4azHcPZgTLDDpQZdqDMsANlhh0oM+xAmWEHygw7UGbYgTLDDpQZdqDM
+sANlhh0oM+xAmWEHygw7UGbYgTLDDpQZdqDMsANlhh0oM+xAmWEHygw7UGbYgTLDDpQZdqDMsANl
+hh0oM+xAmWEHygw7UGbYgTLDDpQZdqDMsANlhh0oM+xAmWEHygw7UGbYgTLDDpQZdqDMsANlhp3g
+KB6EndiadoJCPAhHUB5oQXoU6TGkx5GeQHoS6RmUBM6gJHAWZY+zOK48h8/P4fPzyKnOI6c6j5zq
+PHKq88ipziOnOo+c6jxyqvPIqc4jpzqPnOo8cqrzyKnOI6c6j5zqPHKq88ipziOnOo+c6jxyqvPI
+qc4jpzqPnOo8cqrzyKnOI6c6j5zqPHKq88ipziOnOo+c6jxyqvPIqc4jpzqPnOo8cqrzyKkuILoL
+iO4CoruA6C4guguI7gKiu4DoLiC6C4juAqK7gOguILoLiO4CoruA6C4guguI7gKiu4DoLiC6C4ju
+AqK7gOguILoLiO4CoruA6C4guguI7gKiu4DoLiC6C4juAqK7gOguILpLiO4SoruE6C4hukuI7hKi
+u4ToLiG6S4juEqK7hOguIbpLiO4SoruE6C4hukuI7hKiu4ToLiG6S4juEqK7hOguIbpLiO4SoruE
+6C4hukuI7hKiu4ToLiG6S4juEqK7hOguIbpLiO4K9h1XsNd4B93voPtddL+L7vfR/T66P0T3h9hS
+PrQ8ofz2I3z+ET7/CJ9/hM+/wCd38O0di1uBbtor3cF+5w72O62oN2tFvVkr6s1aUW/WinqzVtS9
+tKLerBX1Zq2oN2tFvVkr6s1aUcfSijqWVtSbtaLerBX1Zq2oN2tFvZkZ4zJjXGaMy4xxmTEuM8Zl
+xrjMGJcZ4zJjXGaMy4xxmTEuM8ZlxrjMGJcZ4zLTuAgDfc1ehKUxEpZq1QhLYyQsjZGwkGSOJiyN
+kbBUb0ZYqjcjLNWbEZbqzQgLO9DPGXSfo5TGSFiq0SIsSaBvaYyExRhtaQ9ObGkPTmxpv0Bsab9A
+lDT/iQvVcREXquMiLlTHRVyojou4UB0XccGUuFAdF3GhOi7iQnVcxIXquIgL1XERF6rjIi5Ux0Vc
+qI6LuFAdF3HBFLpgCl2ojou4UB0XcaE6LuJCdVxETUdYRE1HWMQdU+KOKXHHlLhjStwxJe6YEndM
+iTumxB1T4o4pcceUuGNK3DEl7pgSd0yJO6bEHVPijilxx5S4Y0rcMSUemBIPTIkvrZ+EHiGeS3xp
+/SS+VPNGfKnmjfhSzRvxQz9+6McP/fihHz/044d+Aql2jgRS7RwJpNo5Eoj5H4j5H0i1c4Rq5v5f
+xP0PfBzT/j+OP8/rzOzO7s6SahCUrjatlLTNzs4ms1Xkz+40JdpoU4KiaXbbRDfZSDZtSkpQBEVR
+BEXQUhRFEVdRbhHUVVcv4Ra99xZFURRB1e+xu69UuPdz3+/f5/f9Pn48Omdm9sx5vs45c57P1+vM
+nMloMTqlp2J0anZOjE7NzonRqdk5MTo1OydGp2bnxOjU7JwYnZqdE6NTs3NidGp2ToxOzc6J0Sk9
+FaNTeipGp/RUjE7pqRid0lMxOqWnYnRqdk6MTs3OidG4P719IL1dnd4+mN6uTW8fT2+fSG+fTG97
+0ts/pbevpLdvpLfvp7ap2TkxOjU7J0anZudEqk6jRUHKMxEFKc9EFKQ8E1GQboGCdAsUpDwTUZDy
+TERByjMRBSnPRBSkPBNRkPJMREHKMxGpec7XRWqG80tRhDAaRRFszBBFmIQaUYRynCyKMBlbRBGO
+T5+pSO+fiPdEESqxSBThpPR2Wvqq6VgiilCVzjkjff5k3CqKcAo+F0WoxlJRhFORQjwN94ginI6H
+RBFmps+cgcdEkSgSqijSv8EWkZr76hepua/hIjX3ldo/9FcpUnNfqW3urzViQsprEhPSvZyaAZsi
+0jNgIjUDltqvTm8vSv96cXq7OL29JL29NL29PJ3/rvT+3entivR2ZXp7T3p7b3q76teJIjX3lSrz
+gfR2dXr7YHq7Nr19PL19Ir19Mr3tSW//lN4+nd6uS2+fSW+fTW970zb8Jb19P3Um5TWJ1HzXFHFc
+ytsRx6W8IFqE3D39tAhjMZ4W4aL0/sXp7eL09pL09tL0tvPX4c4LUs9tnReknts6L0g9t3VekHpu
+67wg9dzWeUHqua3zgtRzW+cFqee2zstS5TsvS5XvvCxVvvOyVPnOy1LlOy9Lle+8LFW+87J0+Vem
+81+Zzn8lQgg5r8SE9Pbo9HZientROs/F6e3i9PaS9PbS9LZzz1vOK3FHKqdIXXtV2uarEPp1qfOq
+1PMv51Xp0q7CxPT+qb9ucV6F09LbO1Jn0vZfJSb8+qS+MjVTqq9MPU3TV6aepukrU0/T9JWpp2n6
+SmTyTEyfKU9vp6W31b++pa9MPU3TV6aepukrU0/T9JWpp2n6ytTTNH1l6mmavjL1NE1fmXqapq9M
+PU3TV6aepukrU0/T9JWpp2n6ytTTNH1l6mmavjL1NE1fmXqapq9MPU3TV6aepukrU0/T9JWpp2n6
+ytTTNH1l6mmavjL1NE1fmXqapq9MPU3TV6aepukrU0/T9JWpp2n6ytTTNH1l6mmavjL1NE1fmXqa
+pq9MPU3TV6aepukrU0/T9JWpp2n6ytTTNH1l6mmavjL1NE1fmXqapq9MPU3TV6aepun3pJhE/ybF
+vfo3qXk8/ZsU9+rfpObx9G9S83j6N+l5vMx/4T/82wkIEyAFkDqg6IDaBqgrAAcARzXg2AU4ywHn
+EsDZA2jDAC0KaNsB11LAXQx4NMCzE/AqgHcbsM9iYN9KICsfyOoChgAYUgEMWQoM6QP26wCG9gHZ
+m4H9lwAH5AMHrAcOnAkcuB3I6QYOqgQOWgscHAUO2QoMKwYOXQUcNhMYDmD4csA3Ezi8GhgJYORa
+ILcdGFUBHGECY5YARynAUauAo3YB+ROB/MVAfi+QvwsYuwQYtxgYbwLjNwAF7YA/H/DvAoytQGAV
+YFpAEEBhH1BUDFhNQMgCQj3AhDbg6InARB9wTBI4Ng84didw3DagZDcQ1oFIE2BnAXYfMEkBJvUC
+5bP4Xy8weQlwvAWcMBGo2A2cGAamNAFTLaCyDjhpFnDSVmBaDjAtDEzfAlT5gKqlQNVuYMY64ORO
+4ORdwCmbgeqJQHU7UL0VOLUCOHUzcFo1cFobcFoXcFoPcPpM4PQkcPoS4PQVwOnrgNM3A2dUAWd2
+Amd2A2f2AGduAs7cDpwF4Kwc4KwC4Kxy4KxZwKwcYFYBMKscmJU6bgNmLQVmrQJqhgGz84DZxcDs
+amB2EzC7E5jdDdRWANEuILoGiPYC0a1AtB+IZQGxPGDOMmDOamDOBmDOFmDOLmCuDszNBeZOBOZW
+AXPjQF0UqGsH6pYBdauBug1A3RagbhdQ3wac3Qmc3Q2c3QOcvQk4ezswD8C89cC8PmDeTiCuAXEf
+ELeAeCUQrwPiHUC8C4ivAeK9QHwrEO8HGrKAhjygoRhoqAYa6oDGcqBxFtDYBjQuBRpXAY3rgcY+
+IPVV8IQGJHxAwgISlUCiDkh0AIkuILEGSPQCia1Aoh9oygKa8oBzhgHNWUBzHtBcDDRXA81NQHMn
+0NwNNPcAzZuA5u1ASyXQkgRauoCWNUBLL9CyFWjpB1o3Aa3bgfkA5ucA8wuA+eXA/FnA/DZg/lJg
+QT6wYCOwYBuwYDfQlg205QNtYaBtJtCWBBaawLnZwLn5wLlh4NyZwLlJ4NwlwLkrgPPagfZOoL0b
+aO8B2jcB7duBRQAW5QCLCoBF5cCiWcCiNmDRUmDRKmDRemBRH7BoJ3C+BpzvA863gAuygQuSwAVL
+gAtWABesAy7YDFywA+hQgI5hQIcJdFQAHVGgox3oWAZ0rAY6NgAdW4COXcCFOnBhLnDhRODCKuDC
+OHDhYuDC5cBFVcBFm4CL48DF7Ugvx148E1i8AbhkFnCpAlzaBVy6CrgsG7isDegE0NkNdO4ALm8D
+rrCAK7qAK3OBK9cBS/KAJRuBqzTgqnLgqrXA1SZwdRNw9S7gmsXANduApauBaycC11YB18aB64YB
+13UD12cD168DlhUDy7YANzQBN3QAN+YANy4BbuwDbqoGbloL3LQRuGkb0BUHurYCN4eBm9cDt2QB
+t8wEbtkE3BoGbu0BlmcDyzuB5TuB26LAbZuB2/OB28uB22cBt68Cbt8E3L4duAPAHTnAHQXAHeXA
+HbOAO9qAO5YCd6wC7lgP3NEH3LET6NaAbh/QbQHdlUB3HdDdAXR3Ad1rgO5eoHsr0N0P3JkF3JkH
+3GkBd1YCd9YBd3YAd3YBd64B7uwF7qoA7toF3K0Dd+cCd08E7q4C7o4Ddy8G7l4O3L0WuHsjcPc2
+4O7dwIpsYEU+sCIMrJgJrEgCK/qBlVXAyjiwcjFwTy5wz3bgXgD35gD3FgD3lgP3zgLubQPuXQrc
+uwq4dz1wbx9w705glQas8gGrLGBVJbCqDljVAazqAlatAVb1Aqu2Aqv6gfuygPvygPuKgfuqgfua
+gPs6gfu6gft6gPs2AfdtB+6PAvdvBe7vBx7IAh6IA6uHAauXAQ9qwIOVwINdwINrgAd7gQe3Ag/2
+Aw9lAQ/lAQ8VAw9VAw81AQ91Ag91Aw/1AA9tAh7aDjxcCTy8HVgzC1izC3hkOfBoJfDoZuCxJuCx
+3cDaYmBtN/B4NfD4euCJXOCJTuBJHXiyA3hyKdCTBfSsA57KAp7KA57qA/40E/jTVuDpMPD0GmCd
+CazrAZ5pAp7ZATy7DHhuIvDcJmB9G/D8MOD5JcDz/cALa4A/rwE2hIEXs4AXO4AXNwEvtQMv9QMv
+zwRe3gL0tgO9m4FXZgKvZgGv5gGvpvY3Aq+VA691A6/tBDa2Axv7gdfbgL9YwF/WA2+UA28sAd7Y
+DWzqAN4E8GY78OZm4K/5wF9XA28pwFtLgLe2AptNYPMq4G/DgL+tB94uBt5eD7xjAe9sAvpygb42
+oG8X8G4ceHcL8N4w4L0m4L2twN/zgb8vAf6+DdhSDWzZDrxvAe8vAd7fCnwwEfigD/hgO/BhNfDh
+FuDDHcBWBdg6E9i6BPiHCfyjC/jHbuCfi4F/VQLbAGxbDnw0E/hYAz7uAT6ZCHyyBNieDWyvBrZv
+Az5dDnwG4LOlwGe7gc+rgM9XAztMYMcaYMdu4Iso8EUS+GIJ8MUm4Mts4Mti4Ms24Mu1wFfDgK86
+gK9WAV9tAr7qB3b6gJ3dwNfZwNcFwNfFwNeVwNezgK/bga+XAl+vA77uA77eAXwD4Jt84Jty4Jsd
+wLflwLc7gV3VwHftwHfbgO8LgO9XAd9vAn6IAj+sA/p9QH8x0B8F+juB/tVA/0agfyvwYy7wowX8
+WAH8OAv4MQn8mPrG7HLgxzXAjxuAH1P5dgE/aZlv0f5UAPy0GPipC/hpNfDTeuCnLcBP/cDPucDP
+xcDPTcDPy4GftwG784Hd5cDumalv0QK7l2W+pbs79R3b1Ldtq4Bf2oBfuoBfNgC/bAX2DAP2VAN7
+4sCeDmBPL7BnF/CrBvw6DPjVAn6dCfy6Dvh1E/DrNuDXfgjkQWAiBCohEIVAGwT6IUQ2hCiAEJUQ
+oglCrIAQvRBiFwRVQtBiCNoGIQEhs/hfLoRsg5A9ELIXQu6CUPIhlCiE0gGhLIdQtkKoqfd/wxBq
+FYQah1AXQ6jLINQVEI4sCEcehKMYwlEJ4YhDODohHKnf1kE4+iAcOyGcGoQz9YWbPAjnTAhnJ4Sz
+C8K5CsLZA6GtgdDWQ2ibIFw6hGsYhCsfwtUJ4eqCcK2CcPVAuHZBuBUIdzaEuxfC3QfhiUPoYQh9
+JoSehNCXQOgrIPR1EPpmCH0HhHcZxD65EPssgdg3F2JfE2LfboisYois7RBDfBBDCiCGdEPsVwex
+30aIoRUQQ2dCDN0GkV0Bkb0WYv8ciP1nQuy/CeKAdogD8yAOXA+Rk4TIWQKRswIiZx1EzmaInB0Q
+B1VCHLQZ4uBZEAdvgDh4K8TB/RCHtEMMsyAOTf1bB3FYNsRhSyGGKxDDwxDD10IM3wnhGwbh64Y4
+XIM4fBvEiA6IEUshRnRDjMyFGGlCjAxDjKyCGNkDMbIXIncYRO5MiNzNELnbIHJ3Q4zKhhiVDzFq
+I8ToFRCj10Ec0QZxRCfEEV0QeQUQecUQY3IgxuyEOHIVxJHrIY7sgzhyJ8RRSyCOWg6RXwmRPwsi
+fz3E2CaIsR0Q43WI8RshCpZBFGyC8G+AMOIQRjuEsQTCWA4RGAYRyIcITIQIVECYwyCC2RDBXIjg
+WojCSogiQBRtgLCiEFY7RCgLIrQJYsIaiKPbII7uhJg4C+IYBeKYOohjh0Ecmw9xXA9EcRyixIIo
+iUOUVkGUKRBlWyDCPRDhXohIJURkFkSkCSKyBSKyA2KSDjFpGMSktRDldRCTh0FM3gZx/BKIE6IQ
+FYCoWA1xYgfElG6IKT0QU6shpvZCTN0KUbkUorIbonINxEnZECflQpy0E2JaL8T0bojpPRDTN0FM
+3w5RtQpiRi7EjKUQJ/sgTl4BcUoBxCnrIKqjEKdqEKe2Q5y6A+LU3RCnVUOctgritH6I07MgTs+D
+OH0pxOk7IGZWQsxcCzFzN8QZYYgzNkKcGYY4sw/irDjEWYshzloOMQsQs+ogZm2GqCmGqFkBMVuD
+mF0HMXsDRO0wiNokRFSHiC6DiK6GiG6AiGkQsQqI2EyI2GqIOTrEnDjEnC0Qc8sh5nZDzN0FUVcF
+UdcDUa9D1A+DqM+HqA9D1M+EqN8JcXYbxNnbIeZVQ8xbBxHPhYhXQcTjEPHFEPHlEPG1EPENEPHN
+EPEdEA0KREM2REMuRMNEiIYqiIY4RMNiiIblEA1rIRo2QDRshmjYAdGoQDQOg2g0IRorIBqjEI1J
+iMbFEI3LIRrXQjRuhGjcBtG4GyKRDZHIh0iEIRIzIRJJiMQSiMQKiMRWiKZhEE0mRFMFRNNMiKaN
+EOeUQ5yzCaI5DtGSDdGSC9FiQrSEIVqqIJJZEMllEK25EK3LIebnQMzfALGgAGJBN0RbHcRCQCxM
+pashzs2HOHczxHnFEOcth2hfArFIg1i0DuJ8C+L8nRAXxCE6siE6Umk/xIVNEBeFIS7qhbjYB3Hx
+aojFMyEWr4O4pAvikh0Ql3ZCXFYMcVkPRGcUojMJ0bkcorMH4nJAXO6DuLwL4vIeiCsmQlyxHOLK
+HIgrOyCu3AKxpBJiyWKIJdsgrloOcXUWxNVNEFcvh7gmD+Ka9RBLh0EsbYO4Ngxx7QqI6yohrtsB
+cX0uxPWbIZbNgli2DuKGCogb+iBuXA1xUy7ETe0QN/VBdMUhunohbq6CuLkd4pYciFuWQtyyA+LW
+1RDLNYjlTRDLV0DcVglx2y6I25sgbu+DuGMZxB19EN3lEN07IO5cCnHnVoi7ohB37Ya4uxdiRRhi
+ZR7Eyh6Ie0yIe3oh7jUh7u2FWNUNcV8xxH19EPcXQ9zfDfFAAcQDyyBWz4J4UId4cBXEQ1UQD22A
+eLgN4uGdEGsqIR4BxCPVEI+sgXi0HeIxDeKxHoi1SyAe74Z4ohziyXyIngKInt0QTyUh/jQR4uku
+iHUzIZ7JhXimH+LZ9RDPLYdYvxzi+XKIF1ZB/HkNxIZtEC92Q7y0AuLlCojeCohXAPHKdohXOyBe
+64V4PQ/i9R0Qb3RAbOqCeLMH4q9tEG/NgthcBfE3E+LtYoh3dIh31kD0tUG8mwvx7kaI92ZCvLcK
+4u85EH/fDLGlEmLLaogt/RDvV0G83w3xgQnxQTvEB+shPtgE8eEwiA/zIT5MQny4GOLDrRBbCyC2
+dkL8IxviH2sg/jkM4p+VEP9cDPHPzRD/qoL4VyrdBrGtAGJbB8RHWRAfdUF8tAriox6Ij3ohPrYg
+Pu6C+ESD+CQH4pM8iE8siE/KIT7ZCPHJFojteRDbuyA+VSA+XQLxaR/EZ7kQnzVBfLYJ4vNciM8r
+IT5fBvH5dogdORA7miB2rIXYsRviiyjEF70QX+ZAfNkJ8eV6iK90iK+qIb7aCLGzHGLneoivkxBf
+90F8MxHimy6IbxWIb5dB7MqF2LUCYtd2iO8mQny3AeJ7E+L7Nojv+yB+0CB+sCB+6Ib4YTtEvwXR
+3wnxYxbEj+UQP66B+MmC+Gk5xM+5ED9vhNidhNjdCbF7OcTuNRC7N0Ds7oPYvQPilyqIX+ogfmmH
+2JMFsWcZxJ4VEHu2QfyaD/HrBhAKQOgDiTyQWA4SO0HUDpI6SC4HyZ0gpRykrAepw0BqE0hdAVI3
+gRw6yLEY5NgEcvpAzkqQlgXSloNcOSBXKt0GcleC3FtBnsUgHSA9DtK3grxRkHcNaB8faJ9q0D5L
+QfvsAu1bBdp3HSgrH5RVCcpKgrK6QFmpcztAQ3ygIRZoSCVoSB1oSAdoSBdoyBrQkF7QkK2gIf2g
+/bJA++WB9isG7VcN2q8JtF8naL9u0H49oP02gfbbDhoK0NAc0NAC0NBy0NBZoKFtoKFLQUNXgYau
+Bw3tAw3dCcrWQNk+ULYFyq4EZdeBsjtA2V2g7DWg7F5Q9lZQdj9o/yzQ/nmg/YtB+1eD9m8C7d8J
+2r8btH8PaP9NoP23gw4A6IAc0AEFoAPKQQfMAh3QBjpgKeiAVaAD1oMO6AMdsBN0oAY60Ac60AId
+WAk6sA50YAfowC7QgWtAB/aCDtwKOrAflJMFyskD5RSDcqpBOU2gnE5QzipQznpQTh8oZyfoIA10
+kA90kAU6qBJ0UB3ooA7QQV2gg9aADuoFHbQVdFA/6OAs0MF5oIOLQQdXgw5uAh3cCTq4G3RwD+jg
+TaCDt4MOAeiQHNAhBaBDykGHzAId0gY6ZCnokFWgQ9aDDukDHbITNEwDDfOBhlmgYZWgYXWgYR2g
+YV2gYWtAw3pBw7aChvWDDs0CHZoHOrQYdGg16NAm0KGdoEO7QYf2gA7dBDp0O+gwgA7LAR1WADqs
+HHTYLNBhbaDDloIOWwU6bD3osD7QYTtBwzXQcB9ouAUaXgkaXgca3gEa3gUavgY0vBfkywf5loAO
+zwMdboEOrwQdXgc6vAN0eBfo8DWgw3tBh28FHd4PGpEFGpEHGlEMGlENGtEGGtEFGrEGNKIXNGIr
+aEQ/aGQWaGQeaGQxaGQ1aGQbaGQXaOQa0Mhe0MitoJH9oNwsUG4eKLcYlFsNym0C5XaCcrtBuT2g
+3E2g3O2gURpoVB5oVDFoVDVoVBNoVCdoVDdoVA9o1CbQqO2g0QCNzgGNLgCNLgeNngUa3QYavRQ0
+ehVodC9o9HbQEQAdkQM6ogB0RDnoiFmgI9pARywFHbEKdMR60BF9oCN2gvI0UJ4PlGeB8ipBeXWg
+vA5QXhcobw0orxeUtxWU1w8akwUakwcaUwwaUw0a0wQa0wka0w0a0wMaswk0ZjvoyDzQkR2gI7tA
+R64BHdkLOnIr6Mh+0FFZoKPyQEcVg46qBh3VBDpqCeioFaCj1oGO2gw6agcoXwHlDwPlm6D8ClB+
+FJTfDspfBspfA8rfCMrfDhoL0NhhoLEmaGwFaGwUNLYdNHYZaOxq0NgNoLFbQGN3gcZlgcblg8aV
+g8bNAo1rB41bBhq3BjRuI2jcNtC43aDx2aDx+aDxYdD4maDxSdD4JaDxq0DjN4DGbwWN7wcVZIMK
+8kEF5aCCKKigHVSwDFSwGlSwAVSwBVSwC+TXQf5ckL8Y5K8G+ZMg/xKQfwXIvw7k3wzy7wAZCsgY
+BjJMkFEBMupARgfI6AIZa0HGRpCxDWTsBgWyQYF8UCAMCswEBZKgwBJQYAUosB4U6AMFdoFMHWTm
+gcwwyJwJMuMgczHIXAYyV4PMdSBzM8jcBjJ3g4I6KJgLCpqgYAUoOBMUTIKCS0DBFaDgOlBwMyi4
+A1SogAqHgQpNUGEFqDAKKmwHFS4DFa4GFW4AFW4BFe4CFemgolxQ0URQURWoKA4qWgwqWg4qWgsq
+2ggq2gYq2g2yskFWPsgKg6yZICsJspaArBUgax3I2gyydoBCCig0DBQyQaEKUCgKCrWDQstAodWg
+0AZQaAsotAs0QQdNyAVNmAiaUAWaEAdNWAyasBw0YS1owkbQhC2gCbtARyugo7NBR88EHR0HHb0Y
+dPRy0NFrQUdvBB29DXT0btDEHNDEPNDEMGhiFWhiHDSxEzSxGzSxBzRxE2jidtAxAB2TBTomD3RM
+MeiYatAxTaBjloCOWQ46pgd0zCbQMdtBxwJ0bA7o2ALQscWgY6tBxzaBju0EHdsNOrYHdOwm0LE7
+QMfuBh2XAzquAHRcMei4StBxdaDjOkDHdYGOWwM6rhd03DbQcbtAxVmgYh+o2AIVl4OKl/4/8G8d
+qHgjqHgXqGQYqCQMKomDShaDSpaDSraCSnNBpXWg0qWgMgVU1g4q2wkKm6DwClBkJiiyHBTpAUW2
+guw8kL3u//yvPAc0OR80uQI0OQqavBg0uRs0uQc0uQ80eTfo+HLQ8V2g49eCTjBBJ5SDToiDTkgd
+bwNVZIMq8kEVYVDFLFDFclDFRlDFbtCJWaATq0AnNoFOXAY6cSdoSs7/xb84aMpq0NRi0NQ1oEoL
+VBkFnaSBTloKOmkD6KStoGkaaFoxaFoSNK0PNG0HaDpA07NB0/NA0yeCpleCpkdB09tA05eApneD
+pq8FTe8FTd8Cmr4TVKWAqnJAVfmgqmJQVRWoqg5U1Q6qWgqqWgGq6gFVbQRVbQVV7QLN0EAzloJO
+XgE6JQ6q1kDVm0GnRkGndoNOywWd1gU6XQGdvhR0+irQ6etBMwtAM5eBztBAZ0RBZ6b+Pm8H6Kwo
+aNZEUE0BqKYNVNMJmj0MNDsfNHsiaPZi0OwtoNqloNpNoGg+KLoEFN0Nig0DxYpBsThojgKasw40
+tw40dwuoLgmq94Hqo6D6xaD6FaD6jaD6HaCzddDZ+aCzK0Bnx0FnLwGdvRp09kbQ2TtA83TQvHzQ
+vArQvChoXhI0bzVo3i5QPAyKV4HiK0ANADXEQQ1bQQ07QY1RUOM2UOMuUEIBJbJBiVxQwgQlKkCJ
+OCixGJRYBkqsBiU2ghK7QE06qGkYqMkENVWBmqKgpiSoaTGoaRnoHB10zlJQczWoeT2oJRfUsgzU
+shaULAAlV4Na80Ctq0CtO0HzLdD8OGj+CtD8PtACHbSgGrRgBahNB7UlQW3rQQs10MIq0MJu0MId
+oHMt0LlJ0LlrQOfuAJ2XBzqvDXTeelC7DmpvArX3gRY1gRZtBp2fAzq/EnR+J+j89aDz+0EXFIAu
+qANdsB7UkQPqqAZ1JEEd20AXVoEubAdd2A26sBd04Q7QRVmgiyzQRTNBFy0GXdQNumgd6KI+0EW7
+QBdngS7OB11cDro4Crq4A3TxNtDiWaDFG0CXAHTJRNAlbaBL1oIu1UGXRkGXrgZdugt02UTQZYtB
+l/WCOnVQZx2ocwPo8mGgy9tAl/eCrsgBXVEHuqIHdMUu0JUFoCuToCt7QFfuBi0pBi3pBi3ZBbqq
+GnRVD+jqYaCrl4Gu3g66pgB0TRvomj7Q0jhoaSdoaT/o2mLQtZ2ga7eBrisGXbccdL0Our4cdP1i
+0PVbQcuGgZZtBt2ggW5oAt2wA3RjDujGJOgmDXRTFHTTblBXO6hrPejmKOjmZaCbN4NuyQXdYoJu
+WQy6pRd0y07QrZWgW+tAt3aAbu0HLe8FLd8Ouk0H3ZYHum0m6LbFoNs2gm7rB93uA90eB93eA7qj
+CnRHHHTHEtAdvaBuH6h7Lah7M+jOHNCdM0F3bgDdlQe6uxh0dyfo7p2gFdWgFetBK/NAK5eC7skB
+3VMBumc16F4NdG8V6N5u0Kpc0Kou0KqdoPvKQfetBd3vA92/FHR/an8L6IEs0ANh0ANx0ANLQasn
+glavAz3YAXpIAz20BPRwLujhjaA1FaA160CPZIEeKQc9shz0yHbQozroURP06EzQox2gR1eAHt0M
+ekwBPWaBHmsDPdYDemw3aO1M0Noe0ON5oMdngh5fDnp8M+jxXaAnskFPmKAnqkBPJEFP9IKe3AB6
+cjuopxjU0wXq2QR6phP0zDrQM7tAz2aBni0HPdsBenY56NnNoGd3gZ7LAj1ngp6rBj2XBD23DPRc
+L2i9D7S+GrQ+CVq/GvS8AnreAj1fBXq+A/T8WtDzfaDnd4FeyAK9kA96oRz0QjvohbWgF3aA/pwH
++vMs0J+7QX/eDtpggjZUgza0gzZ0gzZsAG3YDnpRB71YAHqxCvRiEvRiF+jFdaAXt4JeAuglH+il
+YtBLUdBLS0AvrQW9tBX0sg562QS9HAW9vBT0cg/o5a2gXg3Ua4J6Z4F6O0G9PaDebaBXdNArJuiV
+WaBXloBeWQt6ZQvoVQ30qgl6dSbo1Q7Qq6tBr/aBXgPotTzQa1Wg1zpAr60CvdYH2qiDNhaDNiZB
+G1eBNm4BvZ4Fer0c9HoH6PUe0Os7QX/JBf2lGvSXJaC/rAf9ZRfojRzQG2HQG02gN5aD3tgAemMH
+aFMOaFMYtKkNtGkFaNMm0JsK6M0C0JszQW8uBb25DvTmdtBfh4H+WgH6awforxtAf90FeisP9FYd
+6K3loLc2gjbroM3FoM1NoM1rQJv7QX+bCPpbF+hvvaC/9YPezgO9XQ16uw309jLQ25tBb+8EvaOD
+3skDvRMGvTML9E476J0u0DtrQe/0gfoA6ssF9VWA+maB+tpBfV2gvrWgvk2gvh2gdzXQu7mgd4tB
+784EvdsGencZ6N01oHc3gt7dDnpPAb2XA3pvG2hLN+j9KOj9NtD7S0DvrwK9vw70/ibQ+7tAH2SB
+PjBBH1SDPoiDPlgM+mAV6IMNoA92gj6sAH0YBX24DrR1GOgf1aB/bAX9sw60LQ+0LQza1gTatgS0
+rQe0bTPoI4A+8oE+qgJ91AT6qAv00VrQR1tAH/WDPs4FfVwM+jgO+rgT9HEP6OPNoE8A+mQY6JNy
+0CdR0CdLQZ+sBn3SB/qkH7Q9D7S9ErR9KWj7DtCn7aBPN4E+s0CfF4M+3wXasQa0YwfoizDoi+Wg
+L/pBX1aBvlwH+ioL9JUF+moW6KvloJ3ZoJ11oJ3rQDt3gL4eBvq6AvR1O+jrDaBvdNA3eaBvwqBv
+ZoK+SYK+WQf6Zgfo22Ggb8tB3yZB3/aAdimgXQWgXdWgXRtA3ymg78Kg7xaDvtsA+j4M+n4D6Icw
+6IcNoB92gPqzQf0TQf11oP7FoP41oP6NoP5doJ/yQD8vA/2yFvRrH6QohpRhSNUH6VgNqRVDatsg
+XVsgPYDU6yD36YLcdwVklgU5JB9ySCfkfnHIoVshsysgszshD+iFPDAf8sB1kDmLIQ9qgjx4JuQh
+VZCH5kEeWgV56HrIw/IhD6uDPGwp5GE9kMN1yOGzIIf3QfoqIQ/3QR5eCXl4B+ThvZAjFMgRxZAj
+uiBHbIYcmQU5shpyZDdkbi4y/yzI3ArI3DrI3HbI3C7I3LWQuX2QuTsgR2mQo3IhR5VDjopCjuqE
+HLUaclQf5KgdkKM1yNG5kKPLIUdHIUd3Qo5eDTm6D3L0LsgjciCPmAh5RB3kEUsgj+iBPGIbZF4O
+ZF4+ZF45ZF4UMq8TMm8FZF4vZN4OyDE5kGNMyDHVkGPaIcesghyzEXJMP+SRuZBHVkMemYQ8sgvy
+yHWQR26HPEqHPMqCPCoKeVQX5FHrII/aDpmfDZlfDpmfhMxfBZm/BXJsDuTYfMix5ZBjo5BjOyHH
+roAc2ws5dgfkuBzIcSbkuGrIce2Q41ZBjtsIOa4fcnwu5PhqyPFJyPFdkOPXQY7fDlmgQxZYkAVR
+yIIuyIJ1kAXbIf3ZkP5ySH8S0r8K0r8F0siBNExIoxrSaIc0VkEaGyGNfshALmSgGjLQDhlYBRnY
+DGlqkKYJadZBmsshzT5IczdkMA8yWAUZ7IQMroUMbocsHAZZWA1ZuBiysAeycAdkUR5k0UzIoi7I
+os2QVg6klQ9plUNaUUirE9JaAWn1Qlo7IEM5kCETMlQNGWqHDK2CDG2EDPVDTsiFnFANOSEJOaEL
+csI6yAnbIY/WIY+2II+OQh7dBXn0Osijt0NOzIacWA45MQk5cRXkxC2Qx+RAHmNCHlMNeUw75DGr
+II/ZCHlMP+SxuZDHVkMe2w557CrIYzdDHqdBHmdCHlcHedxyyOP6II/bDVmcB1lcBVncCVm8FrJ4
+O2TJMMiSasiSxZAlPZAlOyBL8yBLZ0KWdkGWboYsy4EsMyHLqiHL2iHLVkGWbYQs64cM50KGqyHD
+7ZDhVZDhzZARDTJiQkbqICPLISN9kJHdkHYepF0FaXdC2msh7e2Qk4ZBTqqGnLQYclIP5KQdkOV5
+kOUzIcu7IMs3Q07OgZw8EXJyHeTkZZCTeyEn74I8Pg/y+JmQx3dBHr8B8vh+yBPyIU+ogzxhOeQJ
+fZAV2ZAV1ZAViyEreiArdkCemAd54kzIE7sgT9wMOSUHckoF5JROyCkbIKdqkFPDkFM7IaduhKzM
+gaxsg6zcAXlSOeRJKyCnDYOcloSctg5yugI5vQJy+lLI6Zshq3Igq6ogq5ZCVvVBzvBBzlgOeXI1
+5MnLIE/eBHlKAeQpKyCrNcjqDshTNchTJ0Ke2gR56nrI07IgT6uCPK0L8rRtkKcXQJ7eDnl6L+TM
+HMiZdZAzeyDP0CDPaIM8YzvkmdWQZ/ZCnqVDnlUFedYGyFkm5KwlkLO2QdZUQdZsgJztg5wdhZy9
+CnL2DshaE7K2E7J2K2TUgowugYzuhIxFIWPrIecMg5yzAnJuPuTcDsi5fZB1BZB1HZB1myDr8yHr
+N0GeXQF5djvk2ashz94KOS8Lcl4x5LwmyHkrIOdthowrkHETMh6FjC+DjG+AjO+CbMiFbKiCbFgM
+2bAWsmE7ZGMOZGM5ZGM7ZONqyMYtkI39kIlsyEQBZKICMtEJmdgA2QTIpomQTU2QTd2QTZsgz1Eg
+z/FBnjMR8pwk5DnrIZvzIJuXQjb3Q7Ysg2zZBpk0IZMdkMmtkK2zIFvXQ87PgZzfBDm/F3KBD3JB
+MeSCOsgFuyDblkG2rYBsWwfZtgVyYQHkwg7IhZshz82DPLcT8tweyHO3QZ6XDXleOeR57ZDnrYE8
+bxtkey5kexSyfTlk+2bIRTrkojDkoibIRd2QizZCLtoFeX4B5Pl1kOd3Q57fB3nBYsgLtkB25EF2
+LIG8cBjkhW2QF26BvMiCvGg55EV9kBdnQ168CnJxNuTiWZCLd0FeUg55yXrIS/MgL41DXroa8rJK
+yMvWQXYWQHa2Q3YuhezcCHn5YsgrAHlFHeQV2yCvrIO8chfkkkrIJRshr5oIedVayKsVyKvrIK/e
+DnlNHeQ1myCXlkMuXQ55bTbktUshr90OeV0V5HV9kNcnIa/vh1xWAblsBeSy3ZA3zIS8YS3kjbmQ
+N66AvCkX8qZOyJt2Q3YlIbs2Qt6cB3nzYsibd0He0gV5aw7krQWQt1ZC3toEeetSyFvXQC4fBrk8
+H3L5RMjlFZDLZ0IuT0Iu3wZ5WxLyth2Qt8+CvAOQd2RB3uGDvKMc8o4myDu6IO9YD3nHdsjuLMhu
+C7J7FmR3J2T3asjujZDdOyHvzIa804K8sxryzk7IO3sg7+yHvMuCvKsC8q61kHfnQt69GnJFNeSK
+rZArqyFXroG8R4O8ZxnkvT7Ie+sg790BuaoO8r4w5H1bIe9fAvmAAvnAasjVgFzdCbl6N+SDXZAP
+ZUE+tBHyoS2QD+2AfGg35MM65MMVkA93QK7xQa7phnykHfLRbMhHZ0E+uhry0V2Qj4UhH2uDfKwb
+8rHdkGsrIdcugVy7GfJxC/LxTsjHV0E+njreBvmEBflEF+STCuSTScgeDbKnCrJnOWTPLsinKiCf
+aoN8qg/yT0nIp03IdVmQz+RDPrMV8tkNkM9thlzfA/l8BeQLPsg/JyE3FEC+mA35UhbkS32QL6+H
+7N0G+cpayFe3Q27Mhny9APL1nZB/2QH5xnLITe2Qb5qQf7Ug/9oH+VYP5N8mQr6tQb7TDdm3EfLd
+fsj3eiD/vhpyywbI95dDfrAU8sM8yA97ILfOhPyHAvnPLMh/7oL81wbIbbsgP1YgP+6E/CT5/8K/
+nZDbiyG3r4f8tADy0yWQn26D/MyE/Gw15Oc65OcTIT/vhPx8G+SOYZA72iF39EJ+UQ75xSrILysg
+v+yF/KoS8qulkF/thNxZALmzA3LnDsivJ0J+3Qb59XbIb+KQ32yF/DYK+e1WyF1VkLt2QX5XAfld
+L+T3OZDfF0N+vxnyBwvyh9WQ/fmQ/ashfwTkj0sgf9wJ+VMV5E9dkD/1Q/48EfLnVZC78yF3d0L+
+Ugf5Sz/knkrIPSsgf62C/HUJ5K87oGAWFHRDETlQRD8U2ghFZkORy6DI7VCUOijKVihqGIraD8Wx
+AoozD4pzExQtCkXbDMW1AorbhOJeA8VTAcWzHopnFxS9D4q3Dso+GpR91kDZdxaUfddDyeqBMiQf
+ypA1UPYDlP3aoOy3A8rQmVCGroIydBOU7Gwo2WugZO+Esv9iKAfMhHLAWigHVkLJyYaSUwnloJlQ
+DvZBObgayiFZUA5phzJsK5RDK6Ecuh3KYU1QDlsBZXgelOGLoQxfA2X4VijD+6H4TCi+Oii+9VB8
+fVB8O6EcvgPKiBwoI0woIyqhjIhDGdEJZcQKKCPWQxmxBcqIfigjs6GMLIAysgLKyDooIxdDGdkN
+ZeQ6KCP7oIzcBSU3C0puPpTccii5s6CMyoYyKgll1Booo7OhjC6GMno1lCM0KEeEoRyxHkpeHpS8
+JVDGFEAZE4YypgfKkR1QjuyHctRiKPlZUPJ7oIwFlLHlUMaugDIuF8q4iVDGzYQybjGU8TlQxvdC
+Gb8NSoECpcAHpcCCUlANpSAJpWAJlILVUAo2QwDeXnLhKHRBAyELN8MJKB7xLTQoAA4Wx0JkltLh
+LKznfQFdTOB9giJO4n0JPfV2b3pfgS5u4n0VuniW9x3wiH/xvoYhNJT3PTiUanjf67p+/3t5fx+Y
+w1fyfhb04bt4fwgUnwcSQnEBeBbgfYEc4eN9giYm8b5EjjiZ9xXkiEW8ryJHrOB9Bw4Qr/G+hhHi
+K973YAKN4n3v0NF0Ae/vg7rhR/N+FnKGv8f7Q6D5BMqQQBMWohn1mIs6JOFDHmoxBj4YKEABAvBh
+NhbCh1LUI4kWJNGMGGrQgHz4MBmNqMU4+FCCOOLwYdreslrSRzG0IIZmzEcMUYyDG2HEcDZqcDJa
+4UMt6lCDRsxN5/ShJl2+D/VohA9NaMVsxFGPWvgQRQINqEn/5gbKEk0Lm+vn1iV9ebVjfEZBQcA3
+e6GvtD7ZkmyO1TTk+yY31o7zlcTjvmmpXC2+abGWWPP8WHScOxw7u+bkVl9tXU3j3FiLr6Y55qtv
+9DW1zo7X1/qiiYaa+kY3/mDp9HQ96jEH4Munx5rr5wClSCCBeUBpIjHvf33V/zLbyekfWlCPRLpF
+DIxDAEXAybHmlvpEo88YFyj6fWn/VtZ/RJyTLjDTTUnu0gHsOYnGZIsvGatpQLork2jCBIzHeES5
+jPloxTi0IIFWNKMWsXR5zeluHIdGxJAE6pLJpgnjx0djZ9fMbx3Xkmhtro3NSTTPjY1rjCUBe5AF
+A90+cPv9++2W+i11K8XSt2QMcSSwAGPSN9//M7dU6uZ0/0fkTCfUwPc7m/99+Lgx9v+H/1Po//8Y
+kr7/oc713Iq+9O816XugId2q8+BDAnP+R1tSNatMl9eQLu232zlTdl36txjXa24apTF9V0bT5cxJ
+/xrbi5bp4czdlp+2K5G2sDF9fRMPmQxCArOR5B6uT98VmbrUcksPlJlMW/H7cVGD2nS+BjRx6QMl
+pHJnbM/cSTE0pls11VsjBt0lI9I9l7o2mk5b0nbVoh41XL/MPViLVjSkS0mmfxlonzmoR5zv47y9
+Nv6GkGKOlP1JLOD7PIX4W5ukzjShGQlE0Zq28zdroqjnHqvHbLSm7fmtHf7PCPk8lmoRRyuie9tk
+QfoeqEtzQpJbpgH1f6jRQPnNv7srM9a2ooXLH+id1H4DYoP6+rfx24K69Jn/VI/8vfUcn+YlX7rk
+zHjIlF3Prfr73v/vtR5ouYy1TXvv6OQf7rrfarQg3R4N/yuEgdEwJ82pjVzD2CDEaHqbwshPp6mW
+OBsx1CK5N8/g+zjOLDnQQ7Vp7Cjq9/ZHCyakR2cVX1WD2UikmeG3PhjMRb+1wL8zQSMS6XJr9/Lu
+QN6BsdL0Hzlg8HW+dJ1ruKdmI/aHey3TGhkmr/kv/ZlIa5CP+74hncb+v+jtVJ6FaXvnpFkgVfa4
+37XUf7s21SYL99rfkB599emxPMBoKduTzHqZMxlLU20aHdTng++6Af1KoWTaqxXx9NFvNYqmLU31
+V+Og1piLeLo2dXyueRCH1qCWLWnZi/HH9mn5H+s0mOOiv7vDahD/P1jw3y35Pd4f2+U/2ZjP/R5P
+X1f/X1i9mRkolrav4XflDpxp2XtnDoybP6pIjPku9rseWJCuVTR9/Yj/oIsj9tb7j1ek8g+o7ohB
+d1tm7FT8QWdmp8d9YpCtrTweBnpiPhKo/w8tFkNbup0beUQ3oYlVrCbNrLG9Vwzu/4zN/33E1KWZ
+3pdOW9jGGBr/6/2Sqd1/4vBoWhEa0/0+uL1G/Fd/ZcTv+vD/dsy2pNlzQLN/G3UDI6omrW8DPkgz
+X/H7EpvSd/Q81KTz+Lhembvq3/2P/zcY6/9cq9k8RpKsi3P2tlQ5ImmcqZiCqjTOVNiowikowbT0
+b5MxHT5UYhqm4mRMRhgRhNP9UoLp/PuI9Gg8BZPTJU7FjHRZmTKmoSRd9qnwpcv2pY9TRydgMqYg
+nL42guo0RgTT06VOxbR02SeiEhWYjAjnS11RhgrMQDi9PyntjWbwpmBqOq1IX5eyJWNpFaYOQv29
+VZMRYbyUZScigmkoQzn/WoJSTE6Xl7I/P91Sqf0pe+202dKSdBulSk6VWYYZqEgfpc7OwDRUYiqm
+p9uzJF3njLVT0nWwMY3rEklbkOmJjEVlmIpKnJrOMQnlqEI+I1Vxzvx0DVP1CaevT6GekD6bsWwq
+9/I0+AaVMo7bMmNHqv1P3os8PV3/ClSk2zZV3+lphAhKcOLecgfunUnpEk7cex/NSNevJN0OU9MI
+penfUq2Yas+KvTmnDeqVsnR7pfotPx3hlaRrMmlv7/yxJgOl/b53/tPdMYAwKV2/SLqlKtK5p6MS
+EZRh8t4zmftxcrquZajae66M7/vMPVExqHXL0nVM9exJmME5MuX9sRaZEZKy/7daZHqghLdlg9rs
+t96fwr1btrevp6bvsn9vlVPSYzGSzlWS7uvpe1vBTo/fE9nyGYPusIF+nMH359S9lv2+fQfG0UC+
+/w13ZMoawP59D4bT91MFWzh9b2v8z+VmuCuCtjTvNbGutfybcg/2Hn/zSgf7n/mDuHawJ5Bh4Unp
+vA1/yPfb2Qw/ZzTrt5hnsA/3n5RrIErO/4P3O+B9ZLg7ExsN9n6jaT894wu27PVKMvqR2OuZLEj/
+GhsUtTSnrU38Id5rSeNmatbKV/yxrIx/WYM4o7X8h9b8bwr1xwixKa33GZQF6f0keyap+rVy3tT5
+c/8QFTf/Iar6n/pgoC7/U/s3p/u7iWOq+nQLp/zJcVxu89747Lc2SbVAZvar4Q+9/tvdlyptwr/5
+ocm0Z/yb5VHu8cxMWgrTDdjpybjUFGVqmnPv9KYvryUW882OxRMLxozz/S8mNMe53b9dfHKsucaX
+KXnvNKp77H/9z+3+v59w9f0Bub7FV+NLNtdEYw01zfN8iTl/LMXtrow1N9S3pKc461t8dbHm2OyF
+vrnNNY3JWDTfN6c5FktdVltX0zw3lu9LJnw1jQt9TbHmlkSjLzE7WVPfWN8411fjq000LUzlTNbF
+eF6zprY20dBU07gwlSFZV9/ii9fXxhpbYr68EekmGTHGV9MY9dW0tCRq62uSsagvmqhtbYg1JmuS
+KXvm1MdjLb68VInpC3zTE3OSC2qaYyPGpC1pjjU1J6KttbF0MdH6lmRz/ezWZCxtw+8uyPfVN9bG
+W6MpSxbUJ+sSrUlfvL6hnoFS+ZszTZlM+FpbYvnp6uT7GmLpWqf7t6UufxBGfgpzfKLZ1xKLx1O5
+62MtA9X/A3TKuGQi1WYN9UluujTQgrpEw79fkOqGOa3NjfUtdbH0hdGEryWR72tpnX12rDaZOpNp
+43g8sSBVodpEY7Q+VY+WCW53VV3MVzM7MT+WrkHmLkobsPcmaEwk62tjLZmzqV5p+u0OyPzma6mr
+icd9s2PcarFo6iav+V09E40xX6LZ15Bojv3HavuSC5tic2pqYy3jMkb9/teGmoWp8hsS0fo59akb
+rSaejDWndhLNvppoNF3zTNOlxldNc7K+tjVe05wGisZa6uc2ps2YG1/YVNeSuih1h9bUJmPNLakr
+Buxp+SNS5o6LZhqsJj6ogD8UwtcN2PJbifm+RGN8oa/+d7d6c8zXHGusacjkTe20pBoz1TcDQyRW
+n6yLZSqwINEcbfGN2DsWR/gSv/3gG5EauiPSzVbf4qvgMTM7VptIl9oaj6crMT9Rv9ewWFsy1pj0
+1TQ1xetra2bHY6kfMvVPNP+xY+pqkr66mhbf7Fis8fftUt8y6A6P+lobo2zwiN/zyohMDf9bz7Yk
+4qmRne66VEfV+OIpBmn2tQxkbKqpnVczN+ab3Zr0NSb28sf//sb6HdTshb76ZEssPidlVHnEZ0+d
+UuWbPtWuOqVkWsQ3ebqvctrUkyeHI2HfiJLpvsnTR+T7TplcVT51RpXvlJJp00qmVJ3qm2r7Sqac
+6jth8pRwvi9SXTktMn26b+o03+QTKysmR8L5vslTyipmhCdPmeQrnVHlmzK1ylcx+cTJVZGwr2pq
++lIuanJkeqqwEyPTyspLplSVlE6umFx1ar7Pnlw1JVWmPXWar8RXWTKtanLZjIqSab7KGdMqp06P
++EqmhH1Tpk6ZPMWeNnnKpMiJkSlVqYLKplaeOm3ypPKqfF9lSVVkSlW+r2paSThyYsm0E/JTFk6t
+Ko9M86WzjPNNnuKbMtUXOTl18fTykooKX+nkqulV0yIlJ6byplpn0pSpJ6baaMaUcEnV5KlTfKUR
+X8XkktKKSMa2Kaf6yipKJp+Y7wuXnFgyKTL9N5CKyXur81tzpC6YFJkSmVZSke+bXhkpm5zamTwl
+PHlapKwqnXNyODKlKnV26jRf2dQp0yMnzYhMqZpcUjEAke87pTyShpg8xVcyxVdSlrYsXf0pVdNK
+UuVUTZ1WtdeUUyZPj+T7SqZNnp4ywZ429cR8X6o/p9rpOs6YHkl33hS2N9VHqXP/fndMnZa+misY
+jpRUTJ4yaXrKjH/LO87tjrTVxpqSqXubB3eGHtNUmuHP/PRdmyGBxBzfpMZEA59L7/rsRGtjNK08
+GYb7bXClJDmf6TdFH7NjKTXK0G90fqw5Wd+SopJEsy+RIpMF9S3pkd7UnGhIsO611MRj+amr9uby
+RWM18frGuS17zfz9gBoQxKbm+kSzb0FzfTIZa/TVtCbrEs3157IUNyca/mMNUih/tL851tIUq03W
+z4/FF47z2YnmlJ6lLalvnJNobuCqp5uvNjlhgEOTvrnpwqOJpC/RPHecz/3/y1PR8WkveB7qMT7t
+OUbT83Hj0nOjTRj/h3m+//4MdfyC+nn14+sbo7G2cU11TeOZk/9vn1wDhPR/v/bhtP/0t7u9vQAE
+CBIKVDjghAYX3PBAhxf7YF9kYQj2w1BkY38cgAORg4NwMA7BMByKwzAcPhyOERiJ3PRf/T0CeRiD
+I3EU8jEW4zAeBfDDQAAmgihEESyEMAFHYyKOwbE4DsXpILQsHSza6amLyTgeJ6ACJ6anHSpxEqal
+pydm4GScgmqcitNwOmbiDJyJszALNViNu7EYl+AZ3IjtuBRX40rchvuwQhCuwHu4GNfjG3yLq3AT
+OvFnfICvcTvux3fYhe9xFx7EK3gZD2E2arEUUbyGGHrxKt7ARryOv+BTzMFb2IQ38TDmYieuxdvY
+jL+hDp/jC1yOs9M3QAPiaEQ3EjgnHQi1pAOe+ViAz9CGc7EQ52ER2tGDO3EBzkcHLsQOfIk/CSkU
+oQqHcAoNv2CPcAm38AgdvwoIr9hH7CuEyBJDxH5iqMgW+4sDxIEiRxwkDhaHoB8/imHiUHGYGC58
+4nAxQowUuWKUGC2OEHlijDhSHIWf8I7IF2PFODFeFAi/MERAmCIoCkWRsERITMA/8S9xtJgojhHH
+iuNEsSgRpaJMhEVE2GKSKBeTsQaPiOPFCaJCnCimiKmiUpwkponp+Bm7sQ0fiSoxQ5wsThHV4lRx
+mjhdzBRniDPFWWKWqBGzRa2IipiYI+aKOlGPp8XZYp6IiwZ8jE9Eo0iIJnGOaBYtIilaxXyxQLSJ
+heJccZ5oF4vE+eIC0YF7xIXiInGxWCwuEZeKy0SnuFxcIa4US8RV4mpxjVgqrhXXievFMnGDuFHc
+JLrEzeIWcatYLm4Tt4s7RLe4U9wl7hYrxEpxj7hXrBL3ifvFA2K1eFA8JB4Wa8Qj4lHxmFgrHhdP
+iCdFj3hK/Ek8LdaJZ8Sz4jmxXjwvXhB/FhvEi+Il8bLoFa+IV8VrYqN4XfxFvCE2iTfFX8VbYrP4
+m3hbvCP6xLviPfF3sUW8Lz4QH4qt4h/in+JfYpv4SHwsPhHbxafiM/G52CG+EF+Kr8RO8bX4Rnwr
+donvxPfiB9EvfhQ/iZ/FbvGL2CN+JZAgIkkKqeQgJ2nkIjd5SCcv7UP7UhYNof1oKGXT/nQAHUg5
+dBAdTIfQMDqUDqPh5KPDaQSNpFwaRaPpCMqjMXQkHUX5NJbG0XgqID8ZFCCTglRIRWRRiCbQ0TSR
+jqFj6TgqphIqpTIKU4RsmkTlNJmOpxOogk6kKTSVKukkmkbTqYpm0Ml0ClXTqXQanU4z6Qw6k86i
+WVRDs6mWohSjOTSX6qiezqZ5FKcGaqQENdE51EwtlKRWmk8LqI0W0rl0HrXTIjqfLqAOupAuootp
+MV1Cl9Jl1EmX0xV0JS2hq+hquoaW0rV0HV1Py+gGupFuoi66mW6hW2k53Ua30x3UTXfSXXQ3raCV
+dA/dS6voPrqfHqDV9CA9RA/TGnqEHqXH0Id/0Fp6nJ6gJ6mHnqI/0dO0jp6hZ+k5/B1b8D624l18
+SOvpeXqB/kwb6EV6iV6mXnqFXqXXaCO9Tn+hN2gTvUl/pbdoM/2N3qZ3qI/epffo77SF3qcP6EPa
+Sv+gf9K/aBt9RB/TJ7SdPqXP6HPaQV/Ql/QV7aSv6Rv6lnbRd/Q9/UD99CP9RD/TbvqF9tCvElJI
+klIqUpUO6ZSadEm39EhdeuU+cl+ZJYfI/eRQmS33lwfIA2WOPEgeLA+Rw+Sh8jA5XPrk4XKEHClz
+5Sg5Wh4h8+QYeaQ8SubLsXKcHC8LpF8aMiBNGZSFskhaMiQnyKPlRHmMPFYeJ4tliSyVZTIsI7hD
+2nKSLJeT5fHyBFkhT5RT5FRZKU+S0+R0WSVnyJPlKbJanipPk6fjVjlTniHPlGfJWbJGzpa1Mipj
+co6cK+tkvTxbzpNx2SAbZUI2yXNks2yRSdkq58sFsk0ulOfK82S7XCTPlxfIDnmhvEheLBfLS+Sl
+8jLZKS+XV8gr5RJ5lbxaXiOXymvldfJ6uUzeIG+UN8kuebO8Rd4ql8vb5O3yDtkt75R3ybvlCrlS
+3iPvlavkffJ++YBcLR+UD8mH5Rr5iHxUPibXysflE/JJ2SOfkn+ST8t18hn5rHxOrpfPyxfkn+UG
++aJ8Sb4se+Ur8lX5mtwoX5d/kW/ITfJN+Vf5ltws/ybflu/IPvmufE/+XW6R78sP5Idyq/yH/Kf8
+l9wmP5Ify0/kdvmp/Ex+LnfIL+SX8iu5U34tv5Hfyl3yO/m9/EH2yx/lT/JnuVv+IvfIXxUoQiFF
+KoqiKg7FqWiKS3ErHkVXvMo+yr5KljJE2U8ZqmQr+ysHKAcqOcpBysHKIcow5VDlMGW44lMOV0Yo
+I5VcZZQyWjlCyVPGKEcqRyn5ylhlnDJeKVD8iqEEFFMJKoVKkWIpIWWCcrQyUTlGOVY5TilWSpRS
+pUwJKxHFViYp5cpk5XjlBKVCOVGZokxVKpWTlGnKdKVKmaGcrJyiVCunKqcppyszlTOUM5WzlFlK
+jTJbqVWiSkyZo8xV6pR65WxlnhJXGpRGJaE0KecozUqLklRalfnKAqVNWaicq5yntCuLlPOVC5QO
+5ULlIuViZbFyiXKpcpnSqVyuXKFcqSxRrlKuVq5RlirXKtcp1yvLlBuUG5WblC7lZuUW5VZluXKb
+crtyh9Kt3KncpdytrFBWKvco9yqrlPuU+5UHlNXKg8pDysPKGuUR5VHlMWWt8rjyhPKk0qM8pfxJ
+eVpZpzyjPKs8p6xXnldeUP6sbFBeVF5SXlZ6lVeUV5XXlI3K68pflDeUTcqbyl+Vt5TNyt+Ut5V3
+lD7lXeU95e/KFuV95QPlQ2Wr8g/ln8q/lG3KR8rHyifKduVT5TPlc2WH8oXypfKVslP5WvlG+VbZ
+pXynfK/8oPQrPyo/KT8ru5VflD3KrypUoZIqVUVVVYfqVDXVpbpVj6qrXnUfdV81Sx2i7qcOVbPV
+/dUD1APVHPUg9WD1EHWYeqh6mDpc9amHqyPUkWquOkodrR6h5qlj1CPVo9R8daw6Th2vFqh+1VAD
+qqkG1UK1SLXUkDpBPVqdqB6jHqsepxarJWqpWqaG1Yhqq5PUcnWyerx6glqhnqhOUaeqlepJ6jR1
+ulqlzlBPVk9Rq9VT1dPU09WZ6hnqmepZ6iy1Rp2t1qpRNabOUeeqdWq9erY6T42rDWqjmlCb1HPU
+ZrVFTaqt6nx1gdqmLlTPVc9T29VF6vnqBWqHeqF6kXqxuli9RL1UvUztVC9Xr1CvVJeoV6lXq9eo
+S9Vr1evU69Vl6g3qjepNapd6s3qLequ6XL1NvV29Q+1W71TvUu9WV6gr1XvUe9VV6n3q/eoD6mr1
+QfUh9WF1jfqI+qj6mLpWfVx9Qn1S7VGfUv+kPq2uU59Rn1WfU9erz6svqH9WN6gvqi+pL6u96ivq
+q+pr6kb1dfUv6hvqJvVN9a/qW+pm9W/q2+o7ap/6rvqe+nd1i/q++oH6obpV/Yf6T/Vf6jb1I/Vj
+9RN1u/qp+pn6ubpD/UL9Uv1K3al+rX6jfqvuUr9Tv1d/UPvVH9Wf1J/V3eov6h71VwccwkEO6VAc
+qsPhcDo0h8vhdngcusPr2MexryPLMcSxn2OoI9uxv+MAx4GOHMdBjoMdhziGOQ51HOYY7vA5DneM
+cIx05DpGOUY7jnDkOcY4jnQc5ch3jHWMc4x3FDj8DsMRcJiOoKPQUeSwHCHHBMfRjomOYxzHOo5z
+FDtKHKWOMkfYEXHYjkmOcsdkx/GOExwVjhMdUxxTHZWOkxzTHNMdVY4ZjpMdpziqHac6TnOc7pjp
+OMNxpuMsxyxHjWO2o9YRdcQccxxzHXWOesfZjnmOuKPB0ehIOJoc5ziaHS2OpKPVMd+xwNHmWOg4
+13Geo92xyHG+4wJHh+NCx0WOix2LHZc4LnVc5uh0XO64wnGlY4njKsfVjmscSx3XOq5zXO9Y5rjB
+caPjJkeX42bHLY5bHcsdtzlud9zh6Hbc6bjLcbdjhWOl4x7HvY5Vjvsc9zsecKx2POh4yPGwY43j
+Ecejjsccax2PO55wPOnocTzl+JPjacc6xzOOZx3POdY7nne84PizY4PjRcdLjpcdvY5XHK86XnNs
+dLzu+IvjDccmx5uOvzrecmx2/M3xtuMdR5/jXcd7jr87tjjed3zg+NCx1fEPxz8d/3Jsc3zk+Njx
+iWO741PHZ47PHTscXzi+dHzl2On42vGN41vHLsd3ju8dPzj6HT86fnL87Njt+MWxx/GrE07hJKd0
+Kk7V6XA6nZrT5XQ7PU7d6XXu49zXmeUc4tzPOdSZ7dzfeYDzQGeO8yDnwc5DnMOchzoPcw53+pyH
+O0c4RzpznaOco51HOPOcY5xHOo9y5jvHOsc5xzsLnH6n4Qw4TWfQWegsclrOkHOC82jnROcxzmOd
+xzmLnSXOUmeZM+yMOG3nJGe5c7LzeOcJzgrnic4pzqnOSudJzmnO6c4q5wznyc5TnNXOU52nOU93
+znSe4TzTeZZzlrPGOdtZ64w6Y845zrnOOme982znPGfc2eBsdCacTc5znM3OFmfS2eqc71zgbHMu
+dJ7rPM/Z7lzkPN95gbPDeaHzIufFzsXOS5yXOi9zdjovd17hvNK5xHmV82rnNc6lzmud1zmvdy5z
+3uC80XmTs8t5s/MW563O5c7bnLc773B2O+903uW8G4/iMecK50rnPXgCT2KD816sxeN40bkKF+EF
+XOa8z3m/8wE84FztfND5kPNh5xrnI3jJ+ajzMeda5+POJ5xPOnucT+FZPOf8k/Np5zrnM1jnfNb5
+nHO983nnC84/Ozc4X3S+5HzZ2et8xfmq8zXnRufrzr8433Bucr7p/KvzLedm59+cbzvfcfY533W+
+5/y7c4vzfecHzg+dW53/cP7T+S/nNudHzo+dnzi3Oz91fub83LnD+YXzS+dXzp3Or53fOL917nJ+
+5/ze+YOz3/mj8yfnz87dzl+ce5y/atCERprUFE3VHJpT0zSX5tY8mq55tX20fbUsbYi2nzZUy9b2
+1w7AEu1ALUc7SDtYO0Qbph2qHaYN13za4doIbaSWq43SRmtHaHnaGO1I7SgtXxurjdPGawWaXzO0
+gGZqQa1QK9IsLaRN0I7WJmrHaMdqx2nFWolWqpVpYS2i2dokrVybrB2vnaBVaCdqU7SpWqV2kjZN
+m65VaTO0k7VTtGrtVO007XRtpnaGdqZ2ljZLq9Fma7VaVItpc7S5Wp1Wr52tzdPiWoPWqCW0Ju0c
+rVlr0ZJaqzZfW6C1aQu1c7XztHZtkXa+doHWoV2oXaRdrC3WLtEu1S7TOrXLtSu0K7Ul2lXa1do1
+2lLtWu067XptmXaDdqN2k9al3azdot2qLddu027X7tC6tTu1u7S7tRXaSu0e7V5tlXafdr/2gLZa
+e1B7SHtYW6M9oj2qPaat1R7XntCe1Hq0p7Q/aU9r67RntGe157T12vPaC9qftQ3ai9pL2star/aK
+9qr2mrZRe137i/aGtkl7U/ur9pa2GV3a37S3sUp7R+vT3tXe0/6OW7QtuBlfae9rH2gfalu1f2j/
+xErtX9o27SNcp32sfaJt1z7VPtM+13ZoX2hfal9hubZT+1r7RvtW26V9p32v/aD1az/iXu0n7Wdt
+t/aLtkf7Fde4gGW4wSVc5JIuxaW6HC6nS3O5XG6Xx6W7vK59XPu6slxDXPu5hrqyXfu7DnAd6Mpx
+HeQ62HWIa5jrUNdhruEun+tw1wjXSFeua5RrtOsIV55rjOtI11GufNdY1zjXeFeBy+8yXAGX6Qq6
+Cl1FLssVck1wHe2a6DrGdazrOFexq8RV6ipzhV0Rl+2a5Cp3TXYd7zrBVeE60TXFNdVV6TrJNc01
+3VXlmuE62XWKq9p1qus01+muma4zXGe6znLNctW4ZrtqXVFXzDXHNddV56p3ne2a54q7GlyNroSr
+yXWOq9nV4kq6Wl3zXQtcba6FrnNd57naXYtc57sucHW4LnRd5LrYtdh1ietS12WuTtflritcV7qW
+uK5yXe26xrXUda3rOtf1rmWuG1w3um5ydbludt3iutW13HWb63bXHa5u152uu1x3u1a4Vrrucd3r
+WuW6z3W/6wHXateDrodcD7vWuB5xPep6zLXW9bjrCdeTrh7XU64/uZ52rXM943rW9Zxrvet51wuu
+P7s2uF50veR62dXresX1qus110bX666/uN5wbXK96fqr6y3XZtffXG+73nH1ud51vef6u2uL633X
+B64PXVtd/3D90/Uv1zbXR66PXZ+4trs+dX3m+ty1w/WF60vXV66drq9d37i+de1yfef63vWDq9/1
+o+sn18+u3a5fXHtcv7rhFm5yS7fiVt0Ot9OtuV1ut9vj1t1e9z7ufd1Z7iHu/dxD3dnu/d0HuA90
+57gPch/sPgRPuYe5D3Uf5h7u9rkPd49wj3Tnuke5R7uPcOe5x7iPdB/lznePdY9zj3cXuP1uwx1w
+m+6gu9Bd5LbcIfcE99Huie5j3Me6j3MXu0vcpe4yd9gdcdvuSe5y92T38e4T3BXuE91T3FPdle6T
+3NPc091V7hnuk92nuKvdp7pPc5/unuk+w32m+yz3LHeNe7a71h11x9xz3HPdde5699nuee64u8Hd
+6E64m9znuJvdLe6ku9U9373A3eZe6D7XfZ673b3Ifb77AneH+0L3Re6L3Yvdl7gvdV/m7nRf7r7C
+faV7ifsq99Xua9xL3de6r3Nf717mvsF9o/smd5f7Zvct7lvdy923uW933+Hudt/pvst9t3uFe6X7
+Hve97lXu+9z3ux9wr3Y/6H7I/bB7jfsR96Pux9xr3Y+7n3A/6e5xP+X+k/tp9zr3M+5n3c+517uf
+d7/g/rN7g/tF90vul9297lfcr7pfc290v+7+i/sN9yb3m+6/ut9yb3b/zf22+x13n/td93vuv7u3
+uN93f+D+0L3V/Q/3P93/cm9zf+T+2P2Je7v7U/dn7s/dO9xfuL90f+Xe6f7a/Y37W/cu93fu790/
+uPvdP7p/cv/s3u3+xb3H/asHHuEhj/QoHtXj8Dg9msflcXs8Ht3j9ezj2deT5Rni2c8z1JPt2d9z
+gOdAT47nIM/BnkM8wzyHeg7zDPf4PId7RnhGenI9ozyjPUd48jxjPEd6jvLke8Z6xnnGewo8fo/h
+CXhMT9BT6CnyWJ6QZ4LnaM9EzzGeYz3HeYo9JZ5ST5kn7Il4bM8kT7lnsud4zwmeCs+JnimeqZ5K
+z0meaZ7pnirPDM/JnlM81Z5TPad5TvfM9JzhOdNzlmeWp8Yz21PriXpinjmeuZ46T73nbM88T9zT
+4Gn0JDxNnnM8zZ4WT9LT6pnvWeBp8yz0nOs5z9PuWeQ533OBp8Nzoeciz8WexZ5LPJd6LvN0ei73
+XOG50rPEc5Xnas81nqWeaz3Xea73LPPc4LnRc5Ony3Oz5xbPrZ7lnts8t3vu8HR77vTc5bnbs8Kz
+0nOP517PKs99nvs9D3hWex70POR52LPG84jnUc9jnrWexz1PeJ709Hie8vzJ87RnnecZz7Oe5zzr
+Pc97XvD82bPB86LnJc/Lnl7PK55XPa95Nnpe9/zF84Znk+dNz189b3k2e/7medvzjqfP867nPc/f
+PVs873s+8Hzo2er5h+efnn95tnk+8nzs+cSz3fOp5zPP554dni88X3q+8uz0fO35xvOtZ5fnO8/3
+nh88/Z4fPT95fvbs9vzi2eP5VYcudNKlruiq7tCduqa7dLfu0XXdq++j76tn6UP0/fShera+v36A
+fqCeox+kH6wfog/TD9UP04frPv1wfYQ+Us/VR+mj9SP0PH2MfqR+lJ6vj9XH6eP1At2vG3pAN/Wg
+XqgX6ZYe0ifoR+sT9WP0Y/Xj9GK9RC/Vy/SwHtFtfZJerk/Wj9dP0Cv0E/Up+lS9Uj9Jn6ZP16v0
+GfrJ+il6tX6qfpp+uj5TP0M/Uz9Ln6XX6LP1Wj2qx/Q5+ly9Tq/Xz9bn6XG9QW/UE3qTfo7erLfo
+Sb1Vn68v0Nv0hfq5+nl6u75IP1+/QO/QL9Qv0i/WF+uX6Jfql+md+uX6FfqV+hL9Kv1q/Rp9qX6t
+fp1+vb5Mv0G/Ub9J79Jv1m/Rb9WX67fpt+t36N36nfpd+t36Cn2lfo9+r75Kv0+/X39AX60/qD+k
+P6yv0R/RH9Uf09fqj+tP6E/qPfpT+p/0p/V1+jP6s/pz+nr9ef0F/c/6Bv1F/SX9Zb1Xf0V/VX9N
+36i/rv9Ff0PfpL+p/1V/S9+s/01/W39H79Pf1d/T/65v0d/XP9A/1Lfq/9D/qf9L36Z/pH+sf6Jv
+1z/VP9M/13foX+hf6l/pO/Wv9W/0b/Vd+nf691iP5/Uf9H79R/0n/Wd9t/6Lvkf/1Quv8JJXehWv
+6nV4nV7N6/K6vR6v7vV69/Hu683yDvHu5x3qzfbu7z3Ae6A3x3uQ92DvId5h3kO9h3mHe33ew70j
+vCO9ud5R3tHeI7x53jHeI71HefO9Y73jvOO9BV6/1/AGvKY36C30Fnktb8g7wXu0d6L3GO+x3uO8
+xd4Sb6m3zBv2Rry2d5K33DvZe7z3BG+F90TvFO9Ub6X3JO8073RvlXeG92TvKd5q76ne07yne2d6
+z/Ce6T3LO8tb453trfVGvTHvHO9cb5233nu2d5437m3wNnoT3ibvOd5mb4s36W31zvcu8LZ5F3rP
+9Z7nbfcu8p7vvcDb4b3Qe5H3Yu9i7yXeS72XeTu9l3uv8F7pXeK9ynu19xrvUu+13uu813uXeW/w
+3ui9ydvlvdl7i/dW73Lvbd7bvXd4u713eu/y3u1d4V3pvcd7r3eV9z7v/d4HvKu9D3of8j7sXeN9
+xPuo9zHvWu/j3ie8T3p7vE95/+R92rvO+4z3We9z3vXe570veP/s3eB90fuS92Vvr/cVV8ucZN3C
+prpYo7Okoaa2OdHorMmkjpLZzbH5MUdNOnGWJOYmGmPznDWZ1FNWW99c29owJx5r89T+tu8uiyaS
+NbW1scaku3bvriNcW5MqMppJwrXNiZqkM8KAMQaMZABj6cQd+a2g2N5dZ4TNiGVSRyRTYiydeCYN
+MmruIKMm/VbW3L27+qTaRENDDR/MHXTgKR9UTt1v+0r57JpmpW52TbNjcrI+Ho056tOJczLXpJ5r
+MjlTk/p04pzMNtdnUpp8PNWf7Tl+EMbZv+3rJwy2at7vDuY2x2KN8ZrGaH2to6KmtjUZc8TTiV4x
+OF980IGjItNA8XSiVEQTSSUeTSQdUzLXN2aunzL4+sbB10/JXN+YaeDGmqZES7I50VQXk5HGuTLW
+ONc5lSuf4MpPzVQ+kU68U+taG+fWNLc2xGtak97E4CPHtIwNzRkbpg22oXmwDdMyNjRnkumZq1rS
+iWf6oGZsGdSMVYNLSw4urSpTTDKdKFWpLk2munRGpktb04lzBteqlWs1I1Or1nSizmiub5yrtqa2
+3hm/q2Hr4CPnDO76Vh41pwyydsGg/VMH7S/8bd9xWqau56YT92m/3cbn7t1V44nGuS3O1sb6Ar9V
+wKmfU4PTAKcmp0FOCzkt4tTiNMRpCaelnJZxGuY0wqmdSUOMH2L8EOOGGDfEuCHGDTFuiHFDjBti
+3BDjhhg3xLghxg3Z6tS6RHOjmkhtM+dKuM4ljF3C2CWMXcLYJYxdwtgljF3C2CWMXcLYJYxdElFn
+pDFbf8Ms5fqWMnYpY5cydiljlzJ2KWOXMnYpY5cydiljlzJ2KWOXcr1Lub3LuL3LGL+M8csYv4zx
+yxi/jPHLGL+M8csYv4zxyxi/jPHLGL+M8csYP8z4YcYPM36Y8cOMH2b8MOOHGT/M+GHGDzN+mPHD
+jB9m/DDjhxk/wvgRxo8wfoTxI4wfCTomZUb93HTCZxk9wugRRo8weoTRI4weYfQIo9uMbjO6zeg2
+o9uMbnPtba69zbW3Gd8OuUtSTJIZ5DV7d50lkUxaE0unnqkt8ZqWusx+4rf9dClGQQGnfk4NTgOc
+mpwGOS3ktIhTi9MQpyWclnJaxmmY0winmdYw/IzvZ3w/4/sZ38/4fsb3M76/SJ8+mKtbBh1wDrbE
+z5b42RI/W+JnS/xsicGWGGyJwZYYbInBlhhsicGWGNwSBreEwS1hML7B+AbjG4xvML7B+AHGDzB+
+gPEDjB9g/ADjBwq1aCIZj7W0nM0n2IAAGxBgAwJsQIANCLABATYgwAaYbIDJBphsgMkGmGyAyQaY
+3AAmN4DJ+Cbjm4xvMr7J+Cbjm4xvMn6Q8YOMH2T8IOMHGT/I+EHGDzJ+kPGDjB9k/CDjBxk/yPhB
+xg8yfiHjFzJ+IeMXMn4h4xcyfiHjFzJ+IeMXMn4h4xcyfiHjFzJ+IeMXMn4R4xcxfhHjFzF+EeMX
+MX4R4xcxfhHjFzF+EeMXMX4R4xcxfhHjFzE+uwEGuwEGuwEGuwEGuwEGuwEGuwEGuwEGuwEGuwEG
+uwEGuwEGuwEGuwEGuwEGuwEGuwEGuwFGiPHZHTDYHTDYHTDYHTDYHTDYHTDYHTDYHTDYHTDYHTDY
+HTDYHTBCjF/C+CWMzy6BwS6BwS6BwS6BwS6BwS6BwS6BwS6BwS6BwS6BwS6BwS6BUcL4JYxfyvjs
+FhjsFhjsFhjsFhjsFhjsFhjsFhjsFhjsFhjsFhjsFhjsFhjsFhjsFhjsFhjsFhjsFhjsFhjsFhjs
+FhjsFhjsBhjsBhjsBhjsBhjsBhjsBhgs+wbLvsGyb7DsGyzzBsu8wbJtsFwbLNcGy7XBcm2wXBsR
+tivC5US4HBZsgwXbYIE2IgPlc/1ZmA0WYsMucs1trpmfWmgw25WWzNRe+rdAgeFKO/yDzpicBjkt
+5LSIU8tbl0jMSy9GGXRVCaelnJZxGuY0wmnGxgDLZYDlMsByGWC5DLBcBlguAyyXAT9b4bc4DXHK
++CySARbJAItkgEUywCIZYJEMsEgGDEOPJpLp5WC/VYqVMsBKGWClDLBSBlgpA6yUAVbKACtlgJUy
+wEoZYKUMsFIGWCkDrJQBVsoAK2WAlTLAShkIMH6A8VkoAyyUARbKAAtlgIUywEIZYKEMsFAGWBAD
+LFwBFp4AC0iABSTAhB4oGjhmO5nQA0zoASb0ABN6gAk9wAQeYAIPMIEHmMADTOCBIltNJhoTLd5o
+faw51lLfkj5yl8Sb6mrSu66axkQyFo/V1+iRppb6eKIxfVqLJPn3yQne06c21KcC18zBjEGZ3VMb
+YnMzmYbUJ5I1v8NS01hKaSxZo06qaWioyRgWMp2Mp5wWS9bISLJGraqLJWuUFKB6Qk1TU42joqZh
+drSGTmylKa1UXe9kC6iyXk6rS6jT6+c21MiqmlYnWyMr6+plWV29rGyp1ycPsmRfzjBw7K7Z2wB6
+bHC1YwPVrh+odnbr7y/NVCp9vTI7Vam5qUqp0Vg8WePkspRzU1VK/ZhMVylVmDovXaV4ukqZNigt
+o8ZWaqt3JjL1ks11CUdLqlJ+NZ3IZE2rk/FlU129rK2rl00t9Woi1eD64Lbe9w9m6onBvdU6uLcS
+e3srYwbHXoFwgSNtrl/j1uJ7k0k4EDaVprp6vyN9/cBvfD9y3BXguCvAcVeA464Ax10BjrsCTOAB
+jrsCTOQBJvIAE27AHjjm8cEEHOBIKMCRUIAjoQBHQgGOhAI222OzPTbbY7M9Nttjsz12xh6TYx+T
+Yx+TYx+TYx+TKd1kSjeZ0k2mdJNjH5NjH5PJ3GQyN5nMTSZzk8ncZDI3mcxNJnOTydxkMjeZzE0m
+c5PJ3GQyN5nMTSZzk8ncZDI3mcxNJnOTydxkMjeZzE0mc5MjHpMjHpN53GQeN5nHTeZxk3ncZB43
+mcdN5nGTedxkHjeZx03mcZN53GQeN5nHTeZxk3ncZB43mcdN5nGTedxkHjeZx03mcZN53GQeN5nH
+TeZxkwMekwMekwMek/nd5IDH5IDH5IDH5IDH5IDH5IDH5IDH5IDH5IDH5IDH5IDHZN0wOeAxOeAx
+OeAxOeAxOeAxOeAxOeAxOeAxWXdMDnhMDnhMDnhMDnhMDnhMDnhMDnhMDnBMDnBMDnBMDnBMDmBM
+DmBMDmBMDmBM1jeT9c1kfTNZ30zWN5P1zWR9M1nfTA4ITA4ITA4ATA4ATA4ATA4ATA4ATA4ATA4A
+TA4ATA4ATA4ATA4ATA4ATHb4TXb4TXb4TXb4TXb4TXb4TXb4TXb4TXb4TXb4TXbwTXbwTXbwTXbw
+TXbwTXbwTXbwTXbwTXbwTXbwTXbwTXbwTXbwTXbwTXboTXboTXbYTXbYTXbYTXbYTXbYTZ63M9lh
+N8sGrmd7WRtMdtBNdtBNdtBN1gaT5+VMnpcz2WE3WR9M1geT9cFkfTBZH0zWB5P1wWR9MFkfTNYH
+k/XBZEffZEffZEffZEffZEffZEffZEffZEff5Jk5k2fmTHb8TZ6ZMzkAMDkAMFmPTNYjk/XIZD0y
+WY9M1iOT9chkPTJZj0zWoyDze5D5Pcj8HmR+DzK/B5nfg8zfQebvIPN3kPk7yDwZZJ4MMk8GmSeD
+zJNB5skg82SQeTLIPBlkngwyTwaZJ4OBAZwIp5l2CTJPBpkng8yTQebJIPNkkHkyyDwZZJ4MMk8G
+mSeDzJNB5skg82SQeTLIPBlkngwyTwaZJ4PMk0HmySDzZJB5Msg8GWReDDIvBpkXg8yLQebFIPNi
+kHkxyP57kPkxyPwYZH4MMj8GeQIoyBNAQZ4ACvIEUJD5M8j8GWT+DDJ/BnkCKMgTQEHm0yDzaZD5
+NMh8GmQ+DTKfBplPg8ynQZ4ACvIEUJDjhyDHD0GOH4IcPwQ5fgjyBFCQJ4CCPAEU5AmgIE8ABXkC
+KMgTQEGeAAryBFCQJ36CPPETydw//oIMz/oLMjzrL8jwrL8gw7P+ggzP+gsyPOsvyPCsvyDDs/6C
+zMSKvyAzseIvyPCuvyDDu/6CDO/6CzK86y/I8K6/oITxSxm/lPFLGb+U8UsZv5TxSxm/lPFLGb+U
+8UsZv5TxSxm/lPFLGb+U8csYv4zxyxi/jPHLGL+M8cOcP8z5w5w/zPnDnD88kJ/tDbO9YbY3zPaG
+2d4w2xtme8Nsb5jtDbO9EcaPMH6E8SOMH2H8CONHGD/C+BHGjzB+hPEjjB9h/AjjRxg/wvg249uM
+bzO+zfg249uMbzO+zfg249uMbzO+zfiZuMAfzvj//nDG//eHM/6/P5zx//3hjP/vD2f8f3844//7
+wxn/3x/O+P/+cMb/94cz/r8/nPH//eGCAZwwpxFOM/UM+xnfz/h+xvczvp/x/YzvZ3w/4/sZ38/4
+fsb3M76f8f2M72d8P+MbjG8wvsH4BuMbjG8wvsH4BuMbjG8wvsH4BuMbjG8wvsH4BuMHGD/A+AHG
+DzB+gPEDjB9g/ADjBxg/wPgBxg8wfoDxA4wfYHzmpbDJ+Cbjm4xvMr7J+Cbjm4xvMr7J+Cbjm4xv
+Mr7J+Cbjm4xvMn6Q8YOMH2T8IOMHGT/I+EHGDzJ+kPGDjB9k/CDjBxk/yPhBxg8yfiHjFzJ+IeMX
+Mn4h4xcyfiHjFzJ+IeMXMn4h4xcyfhHnK+J8RQPn2a4itquI7SpiuyzGC3H+EOcPcf4Q5w9xftaX
+MOtLmPUlzPoSZn0Js76EWV/CrC9h1pcw60uY9SXM+hJmfQmzvoRZX8KsL2HWlzDrS5j1Jcz6EmZ9
+CbO+hFlfwqwvYdaXMOtLmPUlzPoSZn0Js76EWV/CrC9h1o8w60e4jMsv4/LLuPzMRL0/wrwXYd6L
+MO9FmPcizHsR5r0I816EeS/CvBcpGCivhNNSTss4DXMa4TRjb4R5L8K8F2HeizDvRZj3Isx7Eea9
+CPNehHkvwrwXYd6LMO9FmPcizHsR5r0I816EeS/CvBdh3osw70WY9yLMexHmvQjzXoR5L8K8F2He
+izDvRZj3Isx7Eea9CPNehHkvwrwXYd6LMO9FmPcizHsR5r0I816EeS/CvBdh3osw70WY9yLMexHm
+vQF/LMK8F2HeizDvRZj3Isx7Eea9CPNehHkvwrwXYd6LMO9FmPcizHsR5r0I816EeS/CvBdh3osw
+70WY9yLMexHmvQjzXoR5L8K8F2HeizDvRZj3Isx7Eea9CPNehHkvwrwXYd6LMO9FmPcizHsR5r0I
+816EeS/CvBdh3osw70WY9yKFjF/I+IWMX8j4RYxfxPhFjF/E+EWMX8T4RYzPfBphPo0UMX4R4zO/
+RphfI8yvEebXSJHtOCX9RMuxIJOcknlPbEE6cZ0yMK3sWjCwl7nOYnyL8S3GtxjfYnyL8S3Gtxjf
+YnyL6x/i+oe4/iGuf4jrH+L6h7j+IcYPMX6I8UOMH2J81osI60WE9SLCehFhvYiwXkRYLyKsFxHW
+iwjrRYT1IsJ6EWG9iLBeRFgvIqwXEdaLCOtFhPUiwnoRYb2IsF5EWC8irBcR1osI60WE9SLCehFh
+vYiwXkRYLyKsFxHWiwjrRYT1IjY7xinjczwS4XgkwvFIhOORCOtJhPUkwnoSYT2JsJ5EBvSkjPHL
+GL+M8cu4/mVc/zLG5/gmwvFNhOObCMc3EY5vIhzfRDi+iXB8E+H4JsLxTYTjmwjHNxGObyIc30Q4
+volwfBPh+CbC8U2E45sIxzcRjm8iHN9EOL6JcHwT4fgmwvFNhOObCMc3EY5vIhzfRDi+iXB8E+H4
+JmL7HadmBuLCdMJnGZ2jmwhHNxGObiIc3UQ4uolwdBPh6MZmdbdZ3W1Wd5vV3WZ1t1ndbVZ3m9Xd
+ZnW3Wd1tVne7YKD8Mk7DnEY4zdTOZnW3Wd1tVneb1d1mdbdZ3W1Wc5vV3GY1t1nNbVZzm9XcZvW2
+Wb1tVm+b1dtm9bZZvW1Wb5vV22b1tlm9bVZvm9XbZvW2jQE8rh+rt83qbbN626zeNqu3zepts3rb
+rN42q7fN6m2zetus3jart83qbbN626zeNqu3zepts3rbrN42q7fN6m2zetus1jartc1qbbNa26zW
+Nqu1zepsszrbrM42q7PN6myzOtuszjars82qbLMa28GBctl+Vl+b1ddm9bVZfW1WX5vV12b1tVl9
+bVZfm9XXZvW1WX1tVl+b1ddm9bVZfW1WX5vV12b1tVl9bVZfm9XXZvW1WX1tVl+b1ddm9bVZfW1W
+X5vV17YYz2I8i/EsxrMYj6Mem9XWZrW1WW1tVlub1dZmtbVZbW1rAI/ry2prs9rarLY2q63Namuz
+2tqstjarrc1qa7Pa2qy2NqutzWprs9rarLY2q63Namuz2tqstjarrc1qa7Pa2qy2NqutzWprs9ra
+rLY2q63Namuz2tqstjarrc1qa7Pa2qy2NqutzWprs9rarLI2q6zNKmuzytqssjarrM0qa7PK2hyV
+2RyV2ayyNquszSprs8rarLI2q6vN6mqzutqsrjarq83qarO62qyuNqurzepqs7rarK42q6vN6mqz
+mtqspjarqc1qarOa2qymNquozSpqs4rarKI2q6jNKmqzitqsojarqM0qarOK2qyiNquozSpqs4ra
+rKI2q6jNKmqzito8K2jzrKDNummzXtqslzbrpc16abNe2gN6ybOBts04mbcEjIKMjhoFGR01CjI6
+ahRkdNQoyOioUZDRUaMgo6NGQUZHjYKMjhoFGR01CjI6ahRkdNQoyOioUZDRUaOgYADXzqR+xvf7
+3XPq57Y2x6I1LXV8iiH9hd70R2lbahPNsejsuPec1kTqu8HzY80tsSjn4eKMwD6JxtSSqFhjPFYT
+jTXvk1yQGHTIudhIg4002EiDjTTYSINLDfgdDfWN6YU1sdSHl/ksN02A7Qxw0wS4aQKWK9ZWG69p
+iM6O8xkuP8Dlm9zYJpdgcgkml2By45rcuCbba7K9JpdnDpTH9ga5M4NcfpA7M8jlBjlfITd+Iecv
+5PyFnL+Qa1jI7VXI+IWMX8j4hYxfyOUWcblFXG4Rl1PE9SziehaxPUVczyKuZxHjFTFeEeMVMV4R
+4xUxnsV4FuNZXA+L62ExvsX4FuNbjG8xvsX4FuNbjG8xvsX4FuOHGDfEuCHGDTFuiHFDjBti3BDj
+hhg3xLghxg0xbohxSwqVSGtzgg+40qUMWsogpQxSyoWWcqGlXGiGwQ0/j3A/j3A/j1x/wcDvEU4z
+OH4ehv7M/JTh93P+jAdr+P18XcYjNfwG58t4ooafB5ufB5s/wPgZD9Dw86Dw86DwBxg34wEa/owH
+aPh5sPhNtttkPB48fh48fh48fh48fh48fh48fh4s/iCXz4PFHzS0RGMsWVffHHUlFyTSOy38E0ME
+GYLHkT/IEEGGCJa4Eo2xWOr763V6sq45xvstnjn18wf29ZbY/FgjH/B1XGUej34ej34ej34ej34e
+j/5CtqeQ7SlkewrZnkK2h8etn8etn8etn8etn8etn8etn8etn8etv4jxixifx7Gfx7Gfx7Gfx7Gf
+x7Gfx7Gfx7Gfx7Gfx7Gfx7Gfx7GfxzEv0TN4iZ7BS/QMXqJn8BI9g5foGX4r5Kppbk4siMfmJJ3p
+vdYmdzpNfwM/82M0saAxszc7kaxzcbZoY6YIHpK82s7g1XYGr7YzeLWdwavtDF5tZ/BqO4NX2xn+
+EFehhKtQwlUo4SqUcBVKuAolXIUSxi8p0vdaNrslxifZiBI2ooSNKGEjStiIEjaCqcFfykYwRfDS
+O4OX3hn+UnOf2prm5vqaubHmWLK1mduilG0p5bZgJuEVeAavwDN4BZ7BK/AM/wCzlLIZpWxGGZtR
+xmaUsRllbEYZt0UZt0UZ45cxfhnjlzF+GeOXMX4Z45cxfhnjl9mZtozOjqfuDPfAQWuTd2A3fX/s
+zZW6RfYepO6STDlhtivMdoXZrjDbFWa7wmxXmO0Ks11htivMdoW5XSLcLhFulwi3S4TbJcLtEmH8
+CONHGD/C+BHGjzB+hPEjjB9h/AjjRxjfZnyb8W3GtxnfZnyb8W3GtxnfZnyb8W3GtxnfZnyb8dnX
+9Nu2q7WxPuW21cQ9sbb6ltTfL6ivyThHRoGpzW1OvQTcmHTG4rHUXwRxZ17oTu1qLa21dam/YsCZ
+MxC8sM7ghXQGL6QzDH9Qr2lJxprrW+Y11HB/Gpk5GMNgBeM1c4bhL9ObmhNNiebMn4dwJ5qTdak1
+yTVxtaZxbjwzGHmFnMEr5AxeIWcYRqE7nphbX1uTWnzu4t1Es17fmPqTEqlPoica1dbG+szKSoNX
+yBm8Qs7gBW0GL2gzeEGbkVrQ1lLfUB+vaeZjzs86yAvWDF5QZvCCMoMXlBm8oMzgBWWGEQx6Yue0
+1s+viccaa2PpGseaW1pnt8SS+wwcpDaxpKsxkcz8kLnSCurNsdSSa849cJDJzXm4Vuwi8bIng5c9
+GbzsyeBlTwYvezJ42ZNhhEx3aml3PNYUb23hU4X7ZE41tMaT9U3xhXya24sZmhdAGbwAyuAFUAYv
+gDJ4AZTBC6AMXgBl8AIogxdAGbwAyjBKTG9TrLkp1hjN/PURPlvIaRGnbAVTNC+DMngZlMHLoAxe
+BmXwMiiDl0EZqWVG0UQydW+qdYlWJvwAR1MB9sl44YwRKCj1Nsfm873VmMi0OS+PMXi5ixHwh9yp
+m25uc0082bR3dzbn5vuV16UYvL7CCHC7BbjdAqGB8xFOMxYHuN0C3G4BbrcAt1uAlS3AyhbgFgtw
+iwW4xQLcYgFusQC3WIBbLMAtFuAWC7Aa8bv9hmkEtOm2v6BgIHQNFvhTJ/yDTgQ4NTkNclrIaRGn
+FqchTks4LdWm2wWDCwxzGuHUTmUI/JaBe4LffTSC/pSNBcagDGwCO9FBf1EqgzkoA9vAXnXQn7bB
+GpSBbfCzDRzxBg3+3WATDDYh3UwFod8K4P4Psn8eZP88yPdD0GAL2F8PGmkLCgcVwBZwdBzk6DgY
+4N8DbAE7+MFA2oKi3wrgQJnf3DT4zU2D39w0+M1Ng9/cNIKBtAXBQQWwBRwq8KubBr+6afCrmwa/
+umnwq5sGv7pp8KubBr+6afCrmwa/umnwq5sGv7pp8KubBr+6afCrmwa/umkEzdR9YKbvA226bZi8
+E/QP7Bi8EwgN5Bk4YwzkMYIDmQt4xxzIHLAG8uzdKRrYKRzIs3dn4CdzAMK/F3SgZGPAVHNv5oGS
+zQF7zAF7zIGSzYHLg3sruLecQXcwBxX8vqfB73sa/L6nwe97Gvy+p8Hvexr8vqfB73sa/L6nwe97
+Gvy+p8Hvexr8vqfB73sa/L6nwe97Gvy+p5F+37NpdjxROy9zzEEFv+9ppN73jDYO/p3xeXIgaBWp
+g39ldJ4aCFqlzvicwb8zOute0LKdzcnM7/FkS11NNKamt87ovEyazsUTBEGeIAhyNBLkaCTIWhdk
+rQuy1gWZs4PM2UHm7CBzdjBku+bUx+Ox6OxEm6PcMAoGGptJO1gycMwGMGkHmbSDTNpBJu1gSchR
+7reCZiCdBP3uTPHNsdokZ2ALmLWDrHNBDkWCpX5nMhVwtDbxMRvAsxdBnr0IcgwSZNYPcgwSLA1l
+rm9mvNLSzDHHcUGOQYIcgwQ5BglyDBLkGCTIMUiwLJC5Pj6HjxmfY5AgxyBBjkGCZSFHxhnhQ254
+Dj2CZZF0wwS4VmFGDTNqmFHDA79zrTnCCHKEEQwXueob589ujcdjydTeYEiOMoIcZQQ5yghylBHk
+KCPIUUaQo4wgRxlBjjKCHGUEOcoIRoLuRFOsMQPKp7jyHGgEOdAIcqAR5EAjyIFGkAONIAcaQQ40
+ghxoBDnQCHKgEeRAI8iBRpADjSAHGkEONIIcaAQ50AhyoBHkQCPIgUaQA40gBxrBzNK3FEdmUhaY
+wkDI1dJQH0//JTG9vnH+3gPZ0trIWWzHnFhDDTd6oelXBh0EODU5DXJayGmR2tI0MLYLeRKnsNBQ
+auOts/nAVOtiNc1JZ7S+pmFgJrmQ528KC0OehtYW9vFi+wzaH5hCLuQpnEJm20Jm20Jm2yL2zor4
+fi7i+7mI74Yi7tIi7tIi7qIi7qIi7qIi7qIi7qIi7qIi7qIi7qIi7qIie6C8Ek5LOS3jNMxphNOM
+vRZ3kcXPHyyenbTYh7PYh7PYh7PYh7PYh7PYh7PYh7PYh7P4+YPFgaLFLpzFLpzFs5sWB5AWe3AW
+e3AWB5QWB5QWO3AWO3CWn/HZ97bYf7PYf7M4trT8jM/um8Xum8Xum8Xum8Xum8Xum8XRpsXRpsXe
+m8Xem8Xem8Xem8Xem8Xem8WzrRZHmxY7bxY7bxY7bxY7bxY7bxY7bxYzmcUPOSz23Sz23Sz23Swe
+Whb7bhb7blaA8XmW12LXzWLXzWLXzWLXzWLXzWLXzeKxZvFYs3isWTzWLHbdLHbdLHbdLHbdLHbd
+LHbdLHbdLHbdLH5UYnH0bHH0bHH0bHH0bHH0bPEUsMVTwBZPAVs8BWzxFLAVZPwg4wcZP8j4Qcbn
+KV+Lp3wtZguLp3wtnvK1eMrX4ilfi6d8LaYMi6d8LZ7ytXjK1+IpX4v5wmK+sJgvLOYLi70zi70z
+i70zix0Gi70zi70zi70zi70zi70zi70zi70zi70zi70zi70zi70zi6d8LZ7ytdg7s9g7s3jK1+Ip
+X4u9M4u9M4sf3Vj86MZi/8xi/8ziRzcWz0tY7J9Z7J9ZPC9h8byExfMSFs9LWDwvYbGHZrGHZrGH
+ZrGHZrGHZrGHZrGHZrGHZrGHZrGHZrGHZnFUbXFUbTFvW+ygWeygWeygWeygWeygWeygWeygWRxV
+WxxVWxxVWxxVW+yfWeyfWeyfWeyfWTxVbPFUscX+mcX+mcX+mcX+mcX+mcX+mcVzxBbPEVs8R2zx
+HLHF/pnF/pnF/pnF/pnF/pnF/pnFc8QWzxFbrGcW65nF/pnF/pnFc8QWzxFbPEdssaNmsaNm8Ryx
+xbMJFntqFntqFntqFntqFntqFntqFntqFs8FWzwXbPFcsMVemsVemsVemsVemsVemsVemsW6bLGX
+ZrGXZrGXZrGXZvFcsMVzwRa7aBbrucV6brGLZrGLZrGLZrGLZrGLZrGLZrH+W6z/Fuu/xfpvsf5b
+rP8W67/F+m+x/lus/xbrv8X6b7H+W6z/Fuu/xfofYv0Psf6HWP9DrP8h1v8Q63+I9T/E+h9i/Q+x
+/odY/0Os/yHW/xDrf4j1P8T6H2L9D7H+h1j/Q6z/Idb/EOt/iPU/xPofYv0Psf6HWP9DrP8h1v8Q
+63+I9T/E+h9i/Q+x/odY/0Os/yHW/xDrf4j1P8T6H2L9D7H+h1j/Q6z/Idb/EOt/iPU/xPofYv0P
+sf6HWP9DrP8h1v8Q63+I9T/E+h9i/Q+x/odY/0Os/yHW/xDrf4j1P8T6H2L9D7H+h1j/Q6z/Idb/
+EOt/iPU/xPofYv0Psf6HWP9DrP8h1v8Q63+I9T/E+h9i/Q+x/odY/0Os/yHW/xDrf4j1P8T6H2L9
+D7H+h1j/Q6z/Idb/EOt/iPU/xPofYv0Psf6HWP9DrP8h1v8Q63+I9T/E+h9i/Q+x/odY/0Os/yHW
+/xDrf4j1P8T6H2L9D7H+h1j/Q6z/Idb/EOt/iPU/xPofYv0Psf6HWP9DrP8h1v8Q63+I9T/E+h9i
+nirhcVrC47SEx2kJ36clXK8Srlcp80Yp80Yp80Yp80Yp80Yp80Yp80Yp80Yp80Yp80Yp80ZpwUD5
+ZZyGOY1wmrGnlHmjlHmjlHmjlHmjlHmjlHmjlHmjlHmjlHmjlHmjlHmjjO+HMr4fyvg+KOP7oIz7
+vYz7vYz7vaxw4PpSTss4DXMa4TRjfxn3exn3exn3exn3exn3dxn3dxn3dxn3cxn3cxn3cxn3cxn3
+cxn3cxn3M69CNXgVqsGrUA1ehWrwKlSDV6EavArV4FWoBq9CNXgVqsGrUA1ehWrwKlSDV6EavArV
+4FWoBq9CNXgVqsGrUA1ehWrwKlSDV6EavArV4FWoBq9CNXgVqsGrUA1ehWrwKlSDV6EavArV4FWo
+Bq9CNXgVqsGrUA1ehWrwKlSDV6EavPrL4NVcBq/eMnj1lsGrt4zIQP4Mr5fw3EQJz02U8NxESWGG
+L0sKM3xXUpjhu5LCIOcPcv4g5w9y/ox/W1KY8W9LijL9UFJUMHBcwmkpp2WchjmNcGpn0kw/lBRl
++qGkKNMPJUWZfigpyvRDSVGmH0qKMv1QUuTn8v1cvp/L93P5fi7f4PwZfSwpMtheg+012F6DyzO4
+PIPL43YsMri8ANsbYHsDbG+A7Q2wvQG2N8D4AcYPMH6A8QOMH2D8AOMHGD/A+AHGNxnfZHzuzyLu
+zyKTyzO5PJOvN/n6QsYvZPwizlfEuBaXb3H5FtfP4vpZXD+L62cxvsX4FpdnDZTH9QhxuSEut4Tt
+tNkem+2xB85zOTaXY3M5Ntcn4y/a/DUrm79mZfPXrGz+mpXNX7Oy+WtWNn/NyuavWdn8NSubv2Zl
+89esbP6alc1fs7L5a1Y2f83K5q9Z2fw1K5u/ZmXz16xs/pqVzV+zsvlrVjZ/zcrmr1nZ/DUrm79m
+ZfPXrGz+mpXNX7Oy+WtWNn/NyuavWdn8NSubv2Zl89esbP6alc1fs7L5a1Y2f83K5q9Y2YVlQa12
+YXNp6u3Z1E7mI/ypX1hRbVZQmxXUZgW1WUFtXldr87pam9fV2ryu1uZ1tTavq7Uje68r4tTiNMRp
+CaelnJZxGuY0wmmmBfgNZ5vfcLb5DWeb33C2bTvsaE2tbjczSTCTFGaSokxiZZJQJinJJKWZpCyT
+cCmRTGKnE7Mgk/gziZFJApkkg2dm8MwMnpnBMzN4ZgbPzOCZGTwzg2dm8MwMnpnBC2bwghm8YAYv
+mMELZvCCGaBgBiiYAQpmgIIZoGAGKJgBCmaAghmgYAaoMANUmAEqzAAVZoAKM0CFmYoVZvAK03jB
+TEMGMw0ZzLRgMNOCwUwLBjMtGMw0XTDTdMFM0wUzTRfMNF0w02bBTPMEM80TzDRPMNM8QZMLS1sd
+zDRPMNMuwUy7BDPtEgwGM0mmzEzzBDPNE8w0TzDTPMFM8wQzzRPMNE8w0zzBTPMEM80TzDRPMNM8
+wUzzBDPNE8w0TzDTPEFunsIMXmEGrzCDV5jGKywxM0n6uqKwlUlCmaQkk5RmkrJMEs4kkUyStqwo
+UpBJ/K5ovCLzVxu0MC9rVUtSC17VqtQXXNVJqaV37rK9fz1BLUvl1fkzq+Nqa1piapoVXOG9fwOk
+fNBfa/CEE62z47H0Cr59+Y91jOMvc+/LH4YeOPbs/fsL4+r35Y8RD87rjxh/OC4rHTh2RhrnjquJ
+J92zm2vSjxgTSQ9/WTb19VneN1PHvF806Hwqj3/QfsGg/P5B+wPnA3+4tmzQ+YHyy1IYg84PlGP8
+Yb9g0H6A9wv/sO8ftF8waD806NqBfX8KV003hsaNYnj4LySkDhznjGtpqG1ypz/XmdlNf/Myvets
+q8+cSn98M72rNdXxufRXL9O7+/IChcSCxvQtMOSc1lhL6oW1vWd09kbTB/vOjTU31DRGZ8db0pfv
+k/5TH+NSm1TfuTN/uybdfdG9ux7+awOD9oOp/X2bmhPR1trkuGh9S1O8ZuG+/ELfwPGQltaGhpqU
+MQNnsgZeafr9JUbZH47DvzsuKSj7w/Eff48MHHsyJpSMi7Ul9+4nE016ZvXHuJaW5EK/nlkDkjnw
+tmZWgvzuKPi7o8LfHRVxIa2Z9RjpI6CHOoq3vrxHvjRUvrghV30xKl8sVjbkyj975QvPH6G+EJXP
+HyHXnyGfWyTXeeTTHvmnp7LVPxnyqWzZY8gn98gn9sjH98jH9shH98hH1kxSH/lJrpkkH94jH1ok
+H9wjV+8jH7hfVx/Ilvfr8j5DrorKew+TKwx5911R9e498q6ovPPGfdQ7R8nuNrfaPUrecYK8PUve
+Nk4u7zxMXb5H3npLlnrrofKWLHlz1z7qzaNkV5tb7dpHdhUrN924j3pTtrypQ7lxH3ljsXLDKLls
+cYG6bI+8/rqh6vWj5HXXetXrhsrrekRxsUu5dqlbvdYrr+0RKJ6sLHXLpeuVaxKL1Guelldf6FGv
+HiKvLlauutCjXjVBLrnyaXXJHnnlFWeoVz4tr+xQrrh8lHrFGfKKYuXyzsPUy0fJzsuGqJ2Hyc6e
+X9cX/6pcNkResrhAvSQqFxfIiw+QF90oL/TIjmhU7dgjL4hnqRccJM9ftI96viEX7SPbz9tXbR8q
+z9tXnnujXDhEtrnlgvk+dcFPcn7rMHW+T7YOk8l4lpo8TLbskc175DlNXvWcPbLJK5uKlcQi2dhw
+rNo4TzYcK+PzdDWeJeMdyjxdzitWzo5G1bN/kvV1T6v1e2Td3DPUuqdlXYcyd84ode4Zcm6xMmeU
+jEWjauwnGY3K2v3l7D2yZo+cddY4ddYeedY4eeYeecYeOfMEefoiedoeeWpYVu+Rp+yRJz8tZ+yR
+06NyWrY8yZCVU/dVKxfJqfvKKSXFpbLCI4+PyskjNHXyjbLckJNkljppqLT3kxFyq5GDZLhsqBqe
+J8tKs9SyobK0xKOWZsmSYpda4pHFLlmcasfpynE3ymOVseqxJ8pjJmarx5wgJx7tVidmy4nFytFu
+OSG0nzrhDBmyhqih/aQ1RBZ5ZeEeGTSz1eAeaQaGqma2DBhuNTBUGn6Xarilkekfv0sWjM9RC8Jy
+/Lj91fE5cvx6ZdxhbnXc/nJchzLWFVXH3ijzj8pW80+QR43Q1KOy5VHFypHkVo+MyjF5BeqYEpmn
+jFXzCuQRylj1iD1y9AQ5ypujjjpD5o7cT82dLkeO0NSR+8mRxcoITR7uy1EPP0P6hg9RfTnSt14Z
+fphbHT5EDu9QDnPLw4qVQ0fKYfvKQ3LlwQcVqAdPlwcpY9WDCmTOHnkgudUD98gDsuT+2dnq/vNk
+9tChana2zC5Whg6V+ylj1f2elkNkljpkj8ySWWpWqdzXFVX3vVHuo4xV99kjveRWvTnSW6zoe6SH
+3Kqn2Jon3a6o6l4kXVGpOYeoWrZ0Dvn/iJmN1YiVrZaZlUWXldWImYVZgJVFl5lZgJmJi5kxmJnh
+HzPjDsaU1l5G7UELGLQHM5BlYAAMAA==
+`
@@ -0,0 +1,885 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fontprog
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/showntop/unipdf/internal/textencoding"
+	"github.com/showntop/unipdf/internal/transform"
+)
+
+// CFF DICT operators. Two byte operators are represented as 1200 + the
+// value of their second byte.
+const (
+	cffOpCharset       = 15
+	cffOpEncoding      = 16
+	cffOpCharStrings   = 17
+	cffOpPrivate       = 18
+	cffOpSubrs         = 19
+	cffOpCharstringTyp = 1206
+	cffOpFontMatrix    = 1207
+	cffOpROS           = 1230
+	cffOpFDArray       = 1236
+	cffOpFDSelect      = 1237
+)
+
+// cffMaxStack is the maximum number of operands on the stack of the Type 2
+// charstring interpreter.
+const cffMaxStack = 513
+
+// CFF represents a font program in the Compact Font Format (Adobe Technical
+// Note #5176), containing Type 2 charstrings. FontFile3 streams with the
+// Type1C and CIDFontType0C subtypes contain CFF data.
+type CFF struct {
+	matrix      transform.Matrix
+	charStrings [][]byte
+	gsubrs      [][]byte
+
+	// subrs contains the local subroutines of the font, or of each font
+	// dict of CID-keyed fonts, which are selected through fdSelect.
+	subrs    [][][]byte
+	fdSelect []uint8
+
+	// charset maps glyph indices to SIDs, or to CIDs for CID-keyed fonts.
+	charset []uint16
+	isCID   bool
+
+	names    map[textencoding.GlyphName]textencoding.GID
+	cids     map[textencoding.CharCode]textencoding.GID
+	encoding map[byte]textencoding.GID
+}
+
+// ParseCFF parses the CFF font program contained in `data`. The first font
+// of font sets is used.
+func ParseCFF(data []byte) (*CFF, error) {
+	r := newByteReader(data, 2)
+	r.seek(int(r.u8()))
+	if r.err {
+		return nil, errInvalidFont
+	}
+
+	// Name INDEX, Top DICT INDEX, String INDEX and Global Subr INDEX.
+	if _, err := readCFFIndex(r); err != nil {
+		return nil, err
+	}
+	topDicts, err := readCFFIndex(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(topDicts) == 0 {
+		return nil, errInvalidFont
+	}
+	stringIndex, err := readCFFIndex(r)
+	if err != nil {
+		return nil, err
+	}
+	gsubrs, err := readCFFIndex(r)
+	if err != nil {
+		return nil, err
+	}
+
+	top, err := parseCFFDict(topDicts[0])
+	if err != nil {
+		return nil, err
+	}
+	if t, ok := top[cffOpCharstringTyp]; ok && len(t) == 1 && t[0] != 2 {
+		return nil, errors.New("unsupported charstring type")
+	}
+
+	f := &CFF{
+		matrix: transform.ScaleMatrix(0.001, 0.001),
+		gsubrs: gsubrs,
+		isCID:  top[cffOpROS] != nil,
+	}
+	if m := top[cffOpFontMatrix]; len(m) == 6 {
+		f.matrix = transform.NewMatrix(m[0], m[1], m[2], m[3], m[4], m[5])
+	}
+
+	// Glyph descriptions.
+	offsets := top[cffOpCharStrings]
+	if len(offsets) != 1 {
+		return nil, errors.New("missing CharStrings")
+	}
+	if f.charStrings, err = readCFFIndex(newByteReader(data, int(offsets[0]))); err != nil {
+		return nil, err
+	}
+	numGlyphs := len(f.charStrings)
+
+	// Local subroutines.
+	if f.isCID {
+		fdArray, ok := top[cffOpFDArray]
+		if !ok || len(fdArray) != 1 {
+			return nil, errors.New("missing FDArray")
+		}
+		fontDicts, err := readCFFIndex(newByteReader(data, int(fdArray[0])))
+		if err != nil {
+			return nil, err
+		}
+		for _, fontDict := range fontDicts {
+			d, err := parseCFFDict(fontDict)
+			if err != nil {
+				return nil, err
+			}
+			f.subrs = append(f.subrs, readCFFSubrs(data, d[cffOpPrivate]))
+		}
+
+		if fdSelect, ok := top[cffOpFDSelect]; ok && len(fdSelect) == 1 {
+			f.fdSelect = parseCFFFDSelect(data, int(fdSelect[0]), numGlyphs)
+		}
+	} else {
+		f.subrs = [][][]byte{readCFFSubrs(data, top[cffOpPrivate])}
+	}
+
+	// Charset and glyph names.
+	f.charset = make([]uint16, numGlyphs)
+	if cs := top[cffOpCharset]; len(cs) == 1 && cs[0] > 2 {
+		parseCFFCharset(newByteReader(data, int(cs[0])), f.charset)
+	} else {
+		// Predefined charsets are only supported for fonts whose glyphs
+		// are ordered as in the ISOAdobe charset.
+		for gid := range f.charset {
+			f.charset[gid] = uint16(gid)
+		}
+	}
+
+	f.names = map[textencoding.GlyphName]textencoding.GID{}
+	f.cids = map[textencoding.CharCode]textencoding.GID{}
+	for gid, id := range f.charset {
+		if f.isCID {
+			f.cids[textencoding.CharCode(id)] = textencoding.GID(gid)
+			continue
+		}
+
+		var name string
+		switch {
+		case int(id) < len(cffStandardStrings):
+			name = cffStandardStrings[id]
+		case int(id)-len(cffStandardStrings) < len(stringIndex):
+			name = string(stringIndex[int(id)-len(cffStandardStrings)])
+		default:
+			continue
+		}
+		if _, ok := f.names[textencoding.GlyphName(name)]; !ok {
+			f.names[textencoding.GlyphName(name)] = textencoding.GID(gid)
+		}
+	}
+
+	// Built-in encoding.
+	if !f.isCID {
+		var offset float64
+		if enc := top[cffOpEncoding]; len(enc) == 1 {
+			offset = enc[0]
+		}
+		f.parseEncoding(data, int(offset))
+	}
+
+	return f, nil
+}
+
+// parseEncoding loads the built-in encoding of the font, located at `offset`.
+// The offset values 0 and 1 represent the predefined Standard and Expert
+// encodings. The Expert encoding is not supported.
+func (f *CFF) parseEncoding(data []byte, offset int) {
+	f.encoding = map[byte]textencoding.GID{}
+	switch offset {
+	case 0:
+		for code := 0; code < 256; code++ {
+			name, ok := standardGlyphName(byte(code))
+			if !ok {
+				continue
+			}
+			if gid, ok := f.names[name]; ok {
+				f.encoding[byte(code)] = gid
+			}
+		}
+		return
+	case 1:
+		return
+	}
+
+	r := newByteReader(data, offset)
+	format := r.u8()
+	switch format & 0x7f {
+	case 0:
+		n := int(r.u8())
+		for gid := 1; gid <= n && !r.err; gid++ {
+			f.encoding[r.u8()] = textencoding.GID(gid)
+		}
+	case 1:
+		numRanges := int(r.u8())
+		gid := 1
+		for i := 0; i < numRanges && !r.err; i++ {
+			first, numLeft := int(r.u8()), int(r.u8())
+			for code := first; code <= first+numLeft && code < 256; code++ {
+				f.encoding[byte(code)] = textencoding.GID(gid)
+				gid++
+			}
+		}
+	}
+
+	// Supplementary encodings map additional codes to glyph SIDs.
+	if format&0x80 != 0 {
+		n := int(r.u8())
+		for i := 0; i < n && !r.err; i++ {
+			code, sid := r.u8(), r.u16()
+			for gid, id := range f.charset {
+				if id == sid {
+					f.encoding[code] = textencoding.GID(gid)
+					break
+				}
+			}
+		}
+	}
+}
+
+// Matrix returns the font matrix, which maps glyph space to text space.
+func (f *CFF) Matrix() transform.Matrix {
+	return f.matrix
+}
+
+// NumGlyphs returns the number of glyphs in the font program.
+func (f *CFF) NumGlyphs() int {
+	return len(f.charStrings)
+}
+
+// IsCIDKeyed returns true if the glyphs of the font are identified by CIDs.
+func (f *CFF) IsCIDKeyed() bool {
+	return f.isCID
+}
+
+// GlyphByName returns the index of the glyph with the specified name.
+func (f *CFF) GlyphByName(name textencoding.GlyphName) (textencoding.GID, bool) {
+	gid, ok := f.names[name]
+	return gid, ok
+}
+
+// GlyphByCode returns the index of the glyph selected by character code
+// `code`, through the built-in encoding of the font program.
+func (f *CFF) GlyphByCode(code byte) (textencoding.GID, bool) {
+	gid, ok := f.encoding[code]
+	return gid, ok
+}
+
+// GlyphByCID returns the index of the glyph with the specified CID. CIDs are
+// used as glyph indices for fonts which are not CID-keyed.
+func (f *CFF) GlyphByCID(cid textencoding.CharCode) (textencoding.GID, bool) {
+	if f.isCID {
+		gid, ok := f.cids[cid]
+		return gid, ok
+	}
+	if int(cid) >= len(f.charStrings) {
+		return 0, false
+	}
+	return textencoding.GID(cid), true
+}
+
+// Outline returns the outline of the glyph with the specified index, in
+// glyph space.
+func (f *CFF) Outline(gid textencoding.GID) (Path, error) {
+	if int(gid) >= len(f.charStrings) {
+		return nil, errNoGlyph
+	}
+
+	var subrs [][]byte
+	switch {
+	case f.fdSelect != nil && int(f.fdSelect[gid]) < len(f.subrs):
+		subrs = f.subrs[f.fdSelect[gid]]
+	case len(f.subrs) > 0:
+		subrs = f.subrs[0]
+	}
+
+	ip := &type2Interpreter{font: f, subrs: subrs}
+	if err := ip.run(f.charStrings[gid], 0); err != nil {
+		return nil, err
+	}
+	return ip.path, nil
+}
+
+// readCFFIndex reads the INDEX structure at the current position of `r` and
+// advances `r` past its end.
+func readCFFIndex(r *byteReader) ([][]byte, error) {
+	count := int(r.u16())
+	if count == 0 {
+		if r.err {
+			return nil, errInvalidFont
+		}
+		return nil, nil
+	}
+
+	offSize := int(r.u8())
+	offsets := make([]int, count+1)
+	for i := range offsets {
+		offsets[i] = r.offset(offSize)
+	}
+	if r.err {
+		return nil, errInvalidFont
+	}
+
+	base := r.off - 1
+	items := make([][]byte, count)
+	for i := range items {
+		start, end := base+offsets[i], base+offsets[i+1]
+		if start < base || end < start || end > len(r.data) {
+			return nil, errInvalidFont
+		}
+		items[i] = r.data[start:end]
+	}
+	r.seek(base + offsets[count])
+	return items, nil
+}
+
+// readCFFSubrs reads the local subroutines referenced by the private DICT,
+// whose size and offset are specified by `private`.
+func readCFFSubrs(data []byte, private []float64) [][]byte {
+	if len(private) != 2 {
+		return nil
+	}
+	size, offset := int(private[0]), int(private[1])
+	if offset < 0 || size < 0 || offset+size > len(data) {
+		return nil
+	}
+	d, err := parseCFFDict(data[offset : offset+size])
+	if err != nil {
+		return nil
+	}
+	subrs := d[cffOpSubrs]
+	if len(subrs) != 1 {
+		return nil
+	}
+	items, err := readCFFIndex(newByteReader(data, offset+int(subrs[0])))
+	if err != nil {
+		return nil
+	}
+	return items
+}
+
+// parseCFFDict parses the DICT data contained in `data` and returns its
+// entries, keyed by operator.
+func parseCFFDict(data []byte) (map[int][]float64, error) {
+	d := map[int][]float64{}
+	r := newByteReader(data, 0)
+
+	var operands []float64
+	for r.off < len(data) {
+		b0 := int(r.u8())
+		switch {
+		case b0 == 12:
+			d[1200+int(r.u8())] = operands
+			operands = nil
+		case b0 <= 21:
+			d[b0] = operands
+			operands = nil
+		case b0 == 28:
+			operands = append(operands, float64(r.i16()))
+		case b0 == 29:
+			operands = append(operands, float64(int32(r.u32())))
+		case b0 == 30:
+			operands = append(operands, readCFFReal(r))
+		case b0 >= 32 && b0 <= 246:
+			operands = append(operands, float64(b0-139))
+		case b0 >= 247 && b0 <= 250:
+			operands = append(operands, float64((b0-247)*256+int(r.u8())+108))
+		case b0 >= 251 && b0 <= 254:
+			operands = append(operands, float64(-(b0-251)*256-int(r.u8())-108))
+		default:
+			return nil, errInvalidFont
+		}
+		if r.err {
+			return nil, errInvalidFont
+		}
+	}
+	return d, nil
+}
+
+// readCFFReal reads a real number operand, encoded as a sequence of nibbles.
+func readCFFReal(r *byteReader) float64 {
+	var sb strings.Builder
+	for !r.err {
+		b := r.u8()
+		for _, nibble := range []uint8{b >> 4, b & 0x0f} {
+			switch {
+			case nibble <= 9:
+				sb.WriteByte('0' + nibble)
+			case nibble == 0x0a:
+				sb.WriteByte('.')
+			case nibble == 0x0b:
+				sb.WriteByte('E')
+			case nibble == 0x0c:
+				sb.WriteString("E-")
+			case nibble == 0x0e:
+				sb.WriteByte('-')
+			case nibble == 0x0f:
+				v, _ := strconv.ParseFloat(sb.String(), 64)
+				return v
+			}
+		}
+	}
+	return 0
+}
+
+// parseCFFCharset reads the charset at the current position of `r` into
+// `charset`. The first glyph is always .notdef.
+func parseCFFCharset(r *byteReader, charset []uint16) {
+	gid := 1
+	switch format := r.u8(); format {
+	case 0:
+		for ; gid < len(charset) && !r.err; gid++ {
+			charset[gid] = r.u16()
+		}
+	case 1, 2:
+		for gid < len(charset) && !r.err {
+			first := r.u16()
+			var numLeft int
+			if format == 2 {
+				numLeft = int(r.u16())
+			} else {
+				numLeft = int(r.u8())
+			}
+			for i := 0; i <= numLeft && gid < len(charset); i++ {
+				charset[gid] = first + uint16(i)
+				gid++
+			}
+		}
+	}
+}
+
+// parseCFFFDSelect reads the font dict indices of the glyphs of CID-keyed
+// fonts.
+func parseCFFFDSelect(data []byte, offset, numGlyphs int) []uint8 {
+	r := newByteReader(data, offset)
+	fds := make([]uint8, numGlyphs)
+	switch r.u8() {
+	case 0:
+		for gid := range fds {
+			fds[gid] = r.u8()
+		}
+	case 3:
+		numRanges := int(r.u16())
+		first := int(r.u16())
+		for i := 0; i < numRanges && !r.err; i++ {
+			fd := r.u8()
+			next := int(r.u16())
+			for gid := first; gid < next && gid < numGlyphs; gid++ {
+				fds[gid] = fd
+			}
+			first = next
+		}
+	default:
+		return nil
+	}
+	if r.err {
+		return nil
+	}
+	return fds
+}
+
+// subrBias returns the bias added to the operands of subroutine calls, based
+// on the number of subroutines.
+func subrBias(n int) int {
+	switch {
+	case n < 1240:
+		return 107
+	case n < 33900:
+		return 1131
+	}
+	return 32768
+}
+
+// type2Interpreter executes Type 2 charstrings (Adobe Technical Note #5177).
+type type2Interpreter struct {
+	font  *CFF
+	subrs [][]byte
+
+	stack     []float64
+	transient [32]float64
+	x, y      float64
+	numStems  int
+	haveWidth bool
+	ended     bool
+	path      Path
+}
+
+func (ip *type2Interpreter) pop() float64 {
+	n := len(ip.stack)
+	if n == 0 {
+		return 0
+	}
+	v := ip.stack[n-1]
+	ip.stack = ip.stack[:n-1]
+	return v
+}
+
+// clearWidth removes the optional advance width operand, which precedes the
+// operands of the first stack-clearing operator of a charstring, when the
+// number of operands differs from the expected count.
+func (ip *type2Interpreter) clearWidth(hasWidth bool) {
+	if !ip.haveWidth && hasWidth && len(ip.stack) > 0 {
+		ip.stack = ip.stack[1:]
+	}
+	ip.haveWidth = true
+}
+
+func (ip *type2Interpreter) countStems() {
+	ip.clearWidth(len(ip.stack)%2 != 0)
+	ip.numStems += len(ip.stack) / 2
+	ip.stack = ip.stack[:0]
+}
+
+func (ip *type2Interpreter) moveTo(dx, dy float64) {
+	ip.x += dx
+	ip.y += dy
+	ip.path.moveTo(ip.x, ip.y)
+}
+
+func (ip *type2Interpreter) lineTo(dx, dy float64) {
+	ip.x += dx
+	ip.y += dy
+	ip.path.lineTo(ip.x, ip.y)
+}
+
+func (ip *type2Interpreter) curveTo(dxa, dya, dxb, dyb, dxc, dyc float64) {
+	xa, ya := ip.x+dxa, ip.y+dya
+	xb, yb := xa+dxb, ya+dyb
+	ip.x, ip.y = xb+dxc, yb+dyc
+	ip.path.cubeTo(xa, ya, xb, yb, ip.x, ip.y)
+}
+
+// run executes charstring `cs`. The depth of nested subroutine calls is
+// specified by `depth`.
+func (ip *type2Interpreter) run(cs []byte, depth int) error {
+	if depth > maxRecursionDepth {
+		return errInvalidGlyph
+	}
+
+	r := newByteReader(cs, 0)
+	for r.off < len(cs) && !ip.ended {
+		b0 := int(r.u8())
+
+		// Operands.
+		if b0 == 28 || b0 >= 32 {
+			var v float64
+			switch {
+			case b0 == 28:
+				v = float64(r.i16())
+			case b0 <= 246:
+				v = float64(b0 - 139)
+			case b0 <= 250:
+				v = float64((b0-247)*256 + int(r.u8()) + 108)
+			case b0 <= 254:
+				v = float64(-(b0-251)*256 - int(r.u8()) - 108)
+			default:
+				v = float64(int32(r.u32())) / 65536
+			}
+			if r.err || len(ip.stack) >= cffMaxStack {
+				return errInvalidGlyph
+			}
+			ip.stack = append(ip.stack, v)
+			continue
+		}
+
+		// Operators.
+		s := ip.stack
+		switch b0 {
+		case 1, 3, 18, 23: // hstem, vstem, hstemhm, vstemhm
+			ip.countStems()
+		case 19, 20: // hintmask, cntrmask
+			ip.countStems()
+			r.skip((ip.numStems + 7) / 8)
+		case 21: // rmoveto
+			ip.clearWidth(len(s) > 2)
+			s = ip.stack
+			if len(s) >= 2 {
+				ip.moveTo(s[0], s[1])
+			}
+		case 22: // hmoveto
+			ip.clearWidth(len(s) > 1)
+			s = ip.stack
+			if len(s) >= 1 {
+				ip.moveTo(s[0], 0)
+			}
+		case 4: // vmoveto
+			ip.clearWidth(len(s) > 1)
+			s = ip.stack
+			if len(s) >= 1 {
+				ip.moveTo(0, s[0])
+			}
+		case 5: // rlineto
+			for ; len(s) >= 2; s = s[2:] {
+				ip.lineTo(s[0], s[1])
+			}
+		case 6, 7: // hlineto, vlineto
+			horizontal := b0 == 6
+			for ; len(s) >= 1; s = s[1:] {
+				if horizontal {
+					ip.lineTo(s[0], 0)
+				} else {
+					ip.lineTo(0, s[0])
+				}
+				horizontal = !horizontal
+			}
+		case 8: // rrcurveto
+			for ; len(s) >= 6; s = s[6:] {
+				ip.curveTo(s[0], s[1], s[2], s[3], s[4], s[5])
+			}
+		case 24: // rcurveline
+			for ; len(s) >= 8; s = s[6:] {
+				ip.curveTo(s[0], s[1], s[2], s[3], s[4], s[5])
+			}
+			if len(s) >= 2 {
+				ip.lineTo(s[0], s[1])
+			}
+		case 25: // rlinecurve
+			for ; len(s) >= 8; s = s[2:] {
+				ip.lineTo(s[0], s[1])
+			}
+			if len(s) >= 6 {
+				ip.curveTo(s[0], s[1], s[2], s[3], s[4], s[5])
+			}
+		case 26: // vvcurveto
+			var dx1 float64
+			if len(s)%2 != 0 {
+				dx1, s = s[0], s[1:]
+			}
+			for ; len(s) >= 4; s = s[4:] {
+				ip.curveTo(dx1, s[0], s[1], s[2], 0, s[3])
+				dx1 = 0
+			}
+		case 27: // hhcurveto
+			var dy1 float64
+			if len(s)%2 != 0 {
+				dy1, s = s[0], s[1:]
+			}
+			for ; len(s) >= 4; s = s[4:] {
+				ip.curveTo(s[0], dy1, s[1], s[2], s[3], 0)
+				dy1 = 0
+			}
+		case 30, 31: // vhcurveto, hvcurveto
+			vertical := b0 == 30
+			for ; len(s) >= 4; s = s[4:] {
+				var last float64
+				if len(s) == 5 {
+					last = s[4]
+				}
+				if vertical {
+					ip.curveTo(0, s[0], s[1], s[2], s[3], last)
+				} else {
+					ip.curveTo(s[0], 0, s[1], s[2], last, s[3])
+				}
+				vertical = !vertical
+			}
+		case 10, 29: // callsubr, callgsubr
+			subrs := ip.subrs
+			if b0 == 29 {
+				subrs = ip.font.gsubrs
+			}
+			index := int(ip.pop()) + subrBias(len(subrs))
+			if index < 0 || index >= len(subrs) {
+				return errInvalidGlyph
+			}
+			if err := ip.run(subrs[index], depth+1); err != nil {
+				return err
+			}
+			continue
+		case 11: // return
+			return nil
+		case 14: // endchar
+			ip.clearWidth(len(s) == 1 || len(s) == 5)
+			if s = ip.stack; len(s) == 4 {
+				if err := ip.seac(s[0], s[1], byte(s[2]), byte(s[3]), depth); err != nil {
+					return err
+				}
+			}
+			ip.ended = true
+		case 12:
+			if err := ip.escape(r.u8()); err != nil {
+				return err
+			}
+			continue
+		default:
+			return errInvalidGlyph
+		}
+		ip.stack = ip.stack[:0]
+	}
+	return nil
+}
+
+// seac composes an accented character from the glyphs selected by the
+// standard encoding codes `bchar` and `achar`, offsetting the accent by
+// `adx`,`ady`.
+func (ip *type2Interpreter) seac(adx, ady float64, bchar, achar byte, depth int) error {
+	for i, code := range []byte{bchar, achar} {
+		name, ok := standardGlyphName(code)
+		if !ok {
+			return errInvalidGlyph
+		}
+		gid, ok := ip.font.GlyphByName(name)
+		if !ok {
+			return errNoGlyph
+		}
+
+		component := &type2Interpreter{font: ip.font, subrs: ip.subrs}
+		if i == 1 {
+			component.x, component.y = adx, ady
+		}
+		if err := component.run(ip.font.charStrings[gid], depth+1); err != nil {
+			return err
+		}
+		ip.path = append(ip.path, component.path...)
+	}
+	return nil
+}
+
+// escape executes the two byte operator with the specified second byte.
+func (ip *type2Interpreter) escape(op uint8) error {
+	s := ip.stack
+	clearStack := true
+	switch op {
+	case 34: // hflex
+		if len(s) >= 7 {
+			ip.curveTo(s[0], 0, s[1], s[2], s[3], 0)
+			ip.curveTo(s[4], 0, s[5], -s[2], s[6], 0)
+		}
+	case 35: // flex
+		if len(s) >= 12 {
+			ip.curveTo(s[0], s[1], s[2], s[3], s[4], s[5])
+			ip.curveTo(s[6], s[7], s[8], s[9], s[10], s[11])
+		}
+	case 36: // hflex1
+		if len(s) >= 9 {
+			ip.curveTo(s[0], s[1], s[2], s[3], s[4], 0)
+			ip.curveTo(s[5], 0, s[6], s[7], s[8], -(s[1] + s[3] + s[7]))
+		}
+	case 37: // flex1
+		if len(s) >= 11 {
+			dx := s[0] + s[2] + s[4] + s[6] + s[8]
+			dy := s[1] + s[3] + s[5] + s[7] + s[9]
+			dx6, dy6 := s[10], -dy
+			if math.Abs(dy) > math.Abs(dx) {
+				dx6, dy6 = -dx, s[10]
+			}
+			ip.curveTo(s[0], s[1], s[2], s[3], s[4], s[5])
+			ip.curveTo(s[6], s[7], s[8], s[9], dx6, dy6)
+		}
+	default:
+		// Arithmetic and storage operators.
+		clearStack = false
+		if err := ip.arithmetic(op); err != nil {
+			return err
+		}
+	}
+	if clearStack {
+		ip.stack = ip.stack[:0]
+	}
+	return nil
+}
+
+// arithmetic executes the arithmetic, logic and storage operators.
+func (ip *type2Interpreter) arithmetic(op uint8) error {
+	push := func(v float64) {
+		ip.stack = append(ip.stack, v)
+	}
+	boolean := func(b bool) float64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+
+	switch op {
+	case 3: // and
+		b, a := ip.pop(), ip.pop()
+		push(boolean(a != 0 && b != 0))
+	case 4: // or
+		b, a := ip.pop(), ip.pop()
+		push(boolean(a != 0 || b != 0))
+	case 5: // not
+		push(boolean(ip.pop() == 0))
+	case 9: // abs
+		push(math.Abs(ip.pop()))
+	case 10: // add
+		b, a := ip.pop(), ip.pop()
+		push(a + b)
+	case 11: // sub
+		b, a := ip.pop(), ip.pop()
+		push(a - b)
+	case 12: // div
+		b, a := ip.pop(), ip.pop()
+		if b == 0 {
+			return errInvalidGlyph
+		}
+		push(a / b)
+	case 14: // neg
+		push(-ip.pop())
+	case 15: // eq
+		b, a := ip.pop(), ip.pop()
+		push(boolean(a == b))
+	case 18: // drop
+		ip.pop()
+	case 20: // put
+		i, v := int(ip.pop()), ip.pop()
+		if i >= 0 && i < len(ip.transient) {
+			ip.transient[i] = v
+		}
+	case 21: // get
+		i := int(ip.pop())
+		if i < 0 || i >= len(ip.transient) {
+			return errInvalidGlyph
+		}
+		push(ip.transient[i])
+	case 22: // ifelse
+		v2, v1, s2, s1 := ip.pop(), ip.pop(), ip.pop(), ip.pop()
+		if v1 > v2 {
+			s1 = s2
+		}
+		push(s1)
+	case 23: // random
+		push(0.5)
+	case 24: // mul
+		b, a := ip.pop(), ip.pop()
+		push(a * b)
+	case 26: // sqrt
+		push(math.Sqrt(math.Abs(ip.pop())))
+	case 27: // dup
+		v := ip.pop()
+		push(v)
+		push(v)
+	case 28: // exch
+		b, a := ip.pop(), ip.pop()
+		push(b)
+		push(a)
+	case 29: // index
+		i := int(ip.pop())
+		n := len(ip.stack)
+		if i < 0 {
+			i = 0
+		}
+		if i >= n {
+			return errInvalidGlyph
+		}
+		push(ip.stack[n-1-i])
+	case 30: // roll
+		j, n := int(ip.pop()), int(ip.pop())
+		if n <= 0 || n > len(ip.stack) {
+			return errInvalidGlyph
+		}
+		items := ip.stack[len(ip.stack)-n:]
+		rolled := make([]float64, n)
+		for i, v := range items {
+			rolled[((i+j)%n+n)%n] = v
+		}
+		copy(items, rolled)
+	default:
+		ip.stack = ip.stack[:0]
+	}
+	if len(ip.stack) > cffMaxStack {
+		return errInvalidGlyph
+	}
+	return nil
+}
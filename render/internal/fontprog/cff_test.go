@@ -0,0 +1,219 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fontprog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/internal/textencoding"
+)
+
+// cffIndex returns the CFF INDEX containing `items`, using 4 byte offsets.
+func cffIndex(items ...[]byte) []byte {
+	if len(items) == 0 {
+		return u16(0)
+	}
+	data := concat(u16(len(items)), []byte{4}, u32(1))
+	offset := 1
+	for _, item := range items {
+		offset += len(item)
+		data = append(data, u32(offset)...)
+	}
+	return concat(data, concat(items...))
+}
+
+// cffInt returns the 5 byte encoding of the DICT integer operand `v`, so
+// that the sizes of DICTs don't depend on the offsets they contain.
+func cffInt(v int) []byte {
+	return concat([]byte{29}, u32(v))
+}
+
+// cffSID returns the SID of the standard string `name`.
+func cffSID(t *testing.T, name string) int {
+	for sid, s := range cffStandardStrings {
+		if s == name {
+			return sid
+		}
+	}
+	t.Fatalf("no standard string %s", name)
+	return 0
+}
+
+// makeTestCFF returns a CFF font program with the glyphs .notdef, A, acute,
+// Aacute, composed from A and acute, and custom, which calls a global
+// subroutine. The acute glyph calls a local subroutine. The returned length
+// is the offset of the Private DICT, which follows the required structures.
+func makeTestCFF(t *testing.T) ([]byte, int) {
+	header := []byte{1, 0, 4, 4}
+	names := cffIndex([]byte("Test"))
+	strs := cffIndex([]byte("custom"))
+	gsubrs := cffIndex(makeCharstring(5, 5, opRlineto, opReturn))
+
+	charset := concat([]byte{0}, u16(cffSID(t, "A")), u16(cffSID(t, "acute")),
+		u16(cffSID(t, "Aacute")), u16(len(cffStandardStrings)))
+	charStrings := cffIndex(
+		makeCharstring(opEndchar),
+		makeCharstring(500, 10, 20, opRmoveto, 100, 0, opRlineto, 0, 100, opRlineto, opEndchar),
+		makeCharstring(0, 0, opRmoveto, -107, opCallsubr, opEndchar),
+		makeCharstring(200, 0, 65, 194, opEndchar),
+		makeCharstring(0, 0, opRmoveto, -107, opCallgsub, opEndchar),
+	)
+	private := concat(cffInt(6), []byte{cffOpSubrs})
+	subrs := cffIndex(makeCharstring(10, 10, opRlineto, opReturn))
+
+	top := func(charsetOffset, charStringsOffset, privateOffset int) []byte {
+		return concat(
+			cffInt(charsetOffset), []byte{cffOpCharset},
+			cffInt(charStringsOffset), []byte{cffOpCharStrings},
+			cffInt(len(private)), cffInt(privateOffset), []byte{cffOpPrivate},
+		)
+	}
+	start := len(header) + len(names) + len(cffIndex(top(0, 0, 0))) + len(strs) + len(gsubrs)
+	charStringsOffset := start + len(charset)
+	privateOffset := charStringsOffset + len(charStrings)
+	topDicts := cffIndex(top(start, charStringsOffset, privateOffset))
+
+	return concat(header, names, topDicts, strs, gsubrs, charset, charStrings, private, subrs), privateOffset
+}
+
+// makeTestCIDCFF returns a CID-keyed CFF font program with glyphs for CIDs
+// 0, 100 and 101. The glyph of CID 101 uses the local subroutines of the
+// second font dict. The returned length is the offset of the Private DICTs,
+// which follow the required structures.
+func makeTestCIDCFF(t *testing.T) ([]byte, int) {
+	header := []byte{1, 0, 4, 4}
+	names := cffIndex([]byte("Test"))
+	strs := cffIndex([]byte("Adobe"), []byte("Identity"))
+	gsubrs := cffIndex()
+
+	charset := concat([]byte{2}, u16(100), u16(1))
+	fdSelect := concat([]byte{3}, u16(2), u16(0), []byte{0}, u16(2), []byte{1}, u16(3))
+	charStrings := cffIndex(
+		makeCharstring(opEndchar),
+		makeCharstring(0, 0, opRmoveto, 20, 0, opRlineto, opEndchar),
+		makeCharstring(0, 0, opRmoveto, -107, opCallsubr, opEndchar),
+	)
+	private := concat(cffInt(6), []byte{cffOpSubrs})
+	subrs := cffIndex(makeCharstring(0, 30, opRlineto, opReturn))
+	fdArray := func(privateOffset int) []byte {
+		return cffIndex(
+			concat(cffInt(0), cffInt(privateOffset), []byte{cffOpPrivate}),
+			concat(cffInt(len(private)), cffInt(privateOffset), []byte{cffOpPrivate}),
+		)
+	}
+
+	top := func(charsetOffset, fdSelectOffset, charStringsOffset, fdArrayOffset int) []byte {
+		return concat(
+			cffInt(len(cffStandardStrings)), cffInt(len(cffStandardStrings)+1), cffInt(0), []byte{12, 30},
+			cffInt(charsetOffset), []byte{cffOpCharset},
+			cffInt(fdSelectOffset), []byte{12, 37},
+			cffInt(charStringsOffset), []byte{cffOpCharStrings},
+			cffInt(fdArrayOffset), []byte{12, 36},
+		)
+	}
+	start := len(header) + len(names) + len(cffIndex(top(0, 0, 0, 0))) + len(strs) + len(gsubrs)
+	fdSelectOffset := start + len(charset)
+	charStringsOffset := fdSelectOffset + len(fdSelect)
+	fdArrayOffset := charStringsOffset + len(charStrings)
+	privateOffset := fdArrayOffset + len(fdArray(0))
+	topDicts := cffIndex(top(start, fdSelectOffset, charStringsOffset, fdArrayOffset))
+
+	return concat(header, names, topDicts, strs, gsubrs, charset, fdSelect, charStrings,
+		fdArray(privateOffset), private, subrs), privateOffset
+}
+
+func TestCFF(t *testing.T) {
+	data, minLength := makeTestCFF(t)
+	f, err := ParseCFF(data)
+	require.NoError(t, err)
+	require.False(t, f.IsCIDKeyed())
+	require.Equal(t, 5, f.NumGlyphs())
+	m := f.Matrix()
+	x, y := m.Transform(1000, 500)
+	require.InDelta(t, 1, x, 1e-9)
+	require.InDelta(t, 0.5, y, 1e-9)
+
+	// Glyph lookup by name, through the charset and the String INDEX.
+	for name, expected := range map[textencoding.GlyphName]textencoding.GID{
+		"A": 1, "acute": 2, "Aacute": 3, "custom": 4,
+	} {
+		gid, ok := f.GlyphByName(name)
+		require.True(t, ok, name)
+		require.Equal(t, expected, gid, name)
+	}
+	_, ok := f.GlyphByName("B")
+	require.False(t, ok)
+
+	// Glyph lookup by code, through the standard encoding.
+	gid, ok := f.GlyphByCode('A')
+	require.True(t, ok)
+	require.Equal(t, textencoding.GID(1), gid)
+	gid, ok = f.GlyphByCode(0302)
+	require.True(t, ok)
+	require.Equal(t, textencoding.GID(2), gid)
+	_, ok = f.GlyphByCode('B')
+	require.False(t, ok)
+
+	// CIDs are glyph indices in fonts which are not CID-keyed.
+	gid, ok = f.GlyphByCID(3)
+	require.True(t, ok)
+	require.Equal(t, textencoding.GID(3), gid)
+	_, ok = f.GlyphByCID(5)
+	require.False(t, ok)
+
+	// Outlines. The advance width of A precedes the rmoveto operands.
+	a := makePath(nil, []float64{10, 20}, []float64{110, 20}, []float64{110, 120})
+	acute := makePath(nil, []float64{0, 0}, []float64{10, 10})
+	outlines := map[textencoding.GID]Path{
+		0: nil,
+		1: a,
+		2: acute,
+		3: append(append(Path{}, a...), makePath(nil, []float64{200, 0}, []float64{210, 10})...),
+		4: makePath(nil, []float64{0, 0}, []float64{5, 5}),
+	}
+	for gid, expected := range outlines {
+		path, err := f.Outline(gid)
+		require.NoError(t, err)
+		require.Equal(t, expected, path, "glyph %d", gid)
+	}
+	_, err = f.Outline(5)
+	require.Error(t, err)
+
+	requireTruncatedFails(t, data, minLength, func(data []byte) (Font, error) {
+		return ParseCFF(data)
+	})
+}
+
+func TestCIDKeyedCFF(t *testing.T) {
+	data, minLength := makeTestCIDCFF(t)
+	f, err := ParseCFF(data)
+	require.NoError(t, err)
+	require.True(t, f.IsCIDKeyed())
+	require.Equal(t, 3, f.NumGlyphs())
+
+	for cid, expected := range map[textencoding.CharCode]textencoding.GID{0: 0, 100: 1, 101: 2} {
+		gid, ok := f.GlyphByCID(cid)
+		require.True(t, ok, "CID %d", cid)
+		require.Equal(t, expected, gid, "CID %d", cid)
+	}
+	_, ok := f.GlyphByCID(2)
+	require.False(t, ok)
+	_, ok = f.GlyphByName("A")
+	require.False(t, ok)
+
+	path, err := f.Outline(1)
+	require.NoError(t, err)
+	require.Equal(t, makePath(nil, []float64{0, 0}, []float64{20, 0}), path)
+	path, err = f.Outline(2)
+	require.NoError(t, err)
+	require.Equal(t, makePath(nil, []float64{0, 0}, []float64{0, 30}), path)
+
+	requireTruncatedFails(t, data, minLength, func(data []byte) (Font, error) {
+		return ParseCFF(data)
+	})
+}
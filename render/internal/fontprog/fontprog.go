@@ -0,0 +1,96 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package fontprog parses the font programs which can be embedded in PDF
+// documents (TrueType, CFF and Type 1) and extracts the outlines of their
+// glyphs, so that they can be rasterized.
+package fontprog
+
+import (
+	"errors"
+
+	"github.com/showntop/unipdf/internal/textencoding"
+	"github.com/showntop/unipdf/internal/transform"
+)
+
+var (
+	errInvalidFont  = errors.New("invalid font program")
+	errInvalidGlyph = errors.New("invalid glyph description")
+	errNoGlyph      = errors.New("glyph not found")
+)
+
+// maxRecursionDepth is the maximum nesting level of composite glyphs and
+// charstring subroutine calls.
+const maxRecursionDepth = 10
+
+// Font represents a parsed font program.
+type Font interface {
+	// Matrix returns the font matrix, which maps glyph space to text space.
+	Matrix() transform.Matrix
+
+	// NumGlyphs returns the number of glyphs in the font program.
+	NumGlyphs() int
+
+	// GlyphByName returns the index of the glyph with the specified name.
+	GlyphByName(name textencoding.GlyphName) (textencoding.GID, bool)
+
+	// GlyphByCode returns the index of the glyph selected by the specified
+	// character code, through the built-in encoding of the font program.
+	GlyphByCode(code byte) (textencoding.GID, bool)
+
+	// Outline returns the outline of the glyph with the specified index,
+	// in glyph space.
+	Outline(gid textencoding.GID) (Path, error)
+}
+
+// SegmentOp represents the type of a path segment.
+type SegmentOp int
+
+// Path segment types.
+const (
+	SegmentMoveTo SegmentOp = iota
+	SegmentLineTo
+	SegmentQuadTo
+	SegmentCubeTo
+)
+
+// Segment represents a segment of a glyph outline. Move and line segments
+// use the first point, quadratic Bézier curves use the first two points and
+// cubic Bézier curves use all three points.
+type Segment struct {
+	Op     SegmentOp
+	Points [3]transform.Point
+}
+
+// Path represents the outline of a glyph, as a sequence of closed contours.
+type Path []Segment
+
+// Transform returns a copy of the path, with its points transformed by `m`.
+func (p Path) Transform(m transform.Matrix) Path {
+	out := make(Path, len(p))
+	for i, seg := range p {
+		out[i].Op = seg.Op
+		for j, pt := range seg.Points {
+			out[i].Points[j].X, out[i].Points[j].Y = m.Transform(pt.X, pt.Y)
+		}
+	}
+	return out
+}
+
+func (p *Path) moveTo(x, y float64) {
+	*p = append(*p, Segment{Op: SegmentMoveTo, Points: [3]transform.Point{{X: x, Y: y}}})
+}
+
+func (p *Path) lineTo(x, y float64) {
+	*p = append(*p, Segment{Op: SegmentLineTo, Points: [3]transform.Point{{X: x, Y: y}}})
+}
+
+func (p *Path) quadTo(x1, y1, x, y float64) {
+	*p = append(*p, Segment{Op: SegmentQuadTo, Points: [3]transform.Point{{X: x1, Y: y1}, {X: x, Y: y}}})
+}
+
+func (p *Path) cubeTo(x1, y1, x2, y2, x, y float64) {
+	*p = append(*p, Segment{Op: SegmentCubeTo, Points: [3]transform.Point{{X: x1, Y: y1}, {X: x2, Y: y2}, {X: x, Y: y}}})
+}
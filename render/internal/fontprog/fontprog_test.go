@@ -0,0 +1,115 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fontprog
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/internal/textencoding"
+)
+
+// csOp represents a charstring operator in the tokens passed to
+// makeCharstring. Two byte operators start with the escape byte 12.
+type csOp []byte
+
+// Charstring operators used by the tests.
+var (
+	opHsbw     = csOp{13}
+	opRmoveto  = csOp{21}
+	opRlineto  = csOp{5}
+	opCallsubr = csOp{10}
+	opCallgsub = csOp{29}
+	opReturn   = csOp{11}
+	opEndchar  = csOp{14}
+	opSeac     = csOp{12, 6}
+)
+
+// makeCharstring encodes the unencrypted Type 1 or Type 2 charstring made of
+// `tokens`, which are integer operands in the range [-1131, 1131] or csOps.
+func makeCharstring(tokens ...interface{}) []byte {
+	var cs []byte
+	for _, token := range tokens {
+		switch t := token.(type) {
+		case int:
+			switch {
+			case t >= -107 && t <= 107:
+				cs = append(cs, byte(t+139))
+			case t >= 108 && t <= 1131:
+				cs = append(cs, byte(247+(t-108)/256), byte((t-108)%256))
+			case t >= -1131 && t <= -108:
+				cs = append(cs, byte(251+(-t-108)/256), byte((-t-108)%256))
+			default:
+				panic("operand out of range")
+			}
+		case csOp:
+			cs = append(cs, t...)
+		}
+	}
+	return cs
+}
+
+// u16 and u32 return the big-endian encodings of `v`.
+func u16(v int) []byte {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, uint16(v))
+	return b
+}
+
+func u32(v int) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(v))
+	return b
+}
+
+// concat returns the concatenation of `parts`.
+func concat(parts ...[]byte) []byte {
+	var data []byte
+	for _, part := range parts {
+		data = append(data, part...)
+	}
+	return data
+}
+
+// makePath returns a path made of the moveto and lineto segments defined by
+// `points`. The first point of each contour is preceded by a nil entry.
+func makePath(points ...[]float64) Path {
+	var p Path
+	move := false
+	for _, pt := range points {
+		switch {
+		case pt == nil:
+			move = true
+		case move:
+			p.moveTo(pt[0], pt[1])
+			move = false
+		default:
+			p.lineTo(pt[0], pt[1])
+		}
+	}
+	return p
+}
+
+// requireTruncatedFails checks that parsing the font program `data`, as well
+// as loading the outlines of its glyphs, does not panic when the font
+// program is truncated at any position, and that parsing fails when it is
+// truncated before `minLength`.
+func requireTruncatedFails(t *testing.T, data []byte, minLength int, parse func(data []byte) (Font, error)) {
+	for n := 0; n < len(data); n++ {
+		font, err := parse(data[:n])
+		if n < minLength {
+			require.Error(t, err, "length %d", n)
+		}
+		if err != nil {
+			continue
+		}
+		for gid := 0; gid < font.NumGlyphs(); gid++ {
+			font.Outline(textencoding.GID(gid))
+		}
+	}
+}
@@ -0,0 +1,100 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fontprog
+
+// byteReader reads big-endian values from font program data. Reading past
+// the end of the data sets the error flag and returns zero values, so that
+// the callers only need to check for errors after reading a structure.
+type byteReader struct {
+	data []byte
+	off  int
+	err  bool
+}
+
+func newByteReader(data []byte, off int) *byteReader {
+	r := &byteReader{data: data}
+	r.seek(off)
+	return r
+}
+
+func (r *byteReader) seek(off int) {
+	if off < 0 || off > len(r.data) {
+		r.err = true
+		return
+	}
+	r.off = off
+}
+
+func (r *byteReader) skip(n int) {
+	r.seek(r.off + n)
+}
+
+func (r *byteReader) read(n int) []byte {
+	if n < 0 || r.off+n > len(r.data) {
+		r.err = true
+		r.off = len(r.data)
+		return nil
+	}
+	b := r.data[r.off : r.off+n]
+	r.off += n
+	return b
+}
+
+func (r *byteReader) u8() uint8 {
+	b := r.read(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (r *byteReader) i8() int8 {
+	return int8(r.u8())
+}
+
+func (r *byteReader) u16() uint16 {
+	b := r.read(2)
+	if b == nil {
+		return 0
+	}
+	return uint16(b[0])<<8 | uint16(b[1])
+}
+
+func (r *byteReader) i16() int16 {
+	return int16(r.u16())
+}
+
+func (r *byteReader) u24() uint32 {
+	b := r.read(3)
+	if b == nil {
+		return 0
+	}
+	return uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+}
+
+func (r *byteReader) u32() uint32 {
+	b := r.read(4)
+	if b == nil {
+		return 0
+	}
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
+
+// offset reads an unsigned integer of `size` bytes.
+func (r *byteReader) offset(size int) int {
+	switch size {
+	case 1:
+		return int(r.u8())
+	case 2:
+		return int(r.u16())
+	case 3:
+		return int(r.u24())
+	case 4:
+		return int(r.u32())
+	}
+	r.err = true
+	return 0
+}
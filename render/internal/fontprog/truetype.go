@@ -0,0 +1,624 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fontprog
+
+import (
+	"errors"
+	"math"
+
+	"github.com/showntop/unipdf/internal/textencoding"
+	"github.com/showntop/unipdf/internal/transform"
+)
+
+// maxCMapEntries is the maximum number of character mappings loaded from a
+// single cmap subtable. It is a guard against corrupt range definitions.
+const maxCMapEntries = 0x20000
+
+// cmapID identifies a cmap subtable by its platform and encoding.
+type cmapID struct {
+	platformID uint16
+	encodingID uint16
+}
+
+// TrueType represents a font program in the TrueType format, or in the
+// OpenType format with CFF glyph descriptions (see 9.9 "Embedded Font
+// Programs" and the OpenType specification).
+type TrueType struct {
+	unitsPerEm int
+	numGlyphs  int
+	loca       []int
+	glyf       []byte
+	advances   []uint16
+
+	cmaps map[cmapID]map[uint32]textencoding.GID
+	names map[textencoding.GlyphName]textencoding.GID
+
+	// cff contains the glyph descriptions of OpenType fonts based on CFF.
+	cff *CFF
+}
+
+// ParseTrueType parses the TrueType or OpenType font program contained
+// in `data`. The first font of font collections is used.
+func ParseTrueType(data []byte) (*TrueType, error) {
+	r := newByteReader(data, 0)
+	version := r.u32()
+	if version == 0x74746366 { // ttcf
+		r.skip(8)
+		r.seek(int(r.u32()))
+		version = r.u32()
+	}
+	switch version {
+	case 0x00010000, 0x74727565, 0x4f54544f: // 1.0, true, OTTO
+	default:
+		return nil, errInvalidFont
+	}
+
+	numTables := int(r.u16())
+	r.skip(6)
+	tables := make(map[string][]byte, numTables)
+	for i := 0; i < numTables; i++ {
+		tag := string(r.read(4))
+		r.skip(4)
+		offset, length := int(r.u32()), int(r.u32())
+		if r.err {
+			return nil, errInvalidFont
+		}
+		if offset < 0 || offset > len(data) {
+			continue
+		}
+		if length < 0 || offset+length > len(data) {
+			length = len(data) - offset
+		}
+		tables[tag] = data[offset : offset+length]
+	}
+
+	f := &TrueType{unitsPerEm: 1000}
+	if head, ok := tables["head"]; ok {
+		if upem := int(newByteReader(head, 18).u16()); upem >= 16 {
+			f.unitsPerEm = upem
+		}
+	}
+	if maxp, ok := tables["maxp"]; ok {
+		f.numGlyphs = int(newByteReader(maxp, 4).u16())
+	}
+
+	if cffData, ok := tables["CFF "]; ok {
+		cff, err := ParseCFF(cffData)
+		if err != nil {
+			return nil, err
+		}
+		f.cff = cff
+		f.numGlyphs = cff.NumGlyphs()
+	} else if err := f.parseGlyphs(tables); err != nil {
+		return nil, err
+	}
+
+	f.parseCMap(tables["cmap"])
+	f.parsePost(tables["post"])
+	f.parseMetrics(tables["hhea"], tables["hmtx"])
+	return f, nil
+}
+
+// parseMetrics loads the advance widths of the glyphs from the hmtx table.
+func (f *TrueType) parseMetrics(hhea, hmtx []byte) {
+	if hhea == nil || hmtx == nil {
+		return
+	}
+	n := int(newByteReader(hhea, 34).u16())
+	if n > len(hmtx)/4 {
+		n = len(hmtx) / 4
+	}
+	r := newByteReader(hmtx, 0)
+	f.advances = make([]uint16, n)
+	for i := range f.advances {
+		f.advances[i] = r.u16()
+		r.skip(2)
+	}
+}
+
+// parseGlyphs loads the glyph locations from the loca table.
+func (f *TrueType) parseGlyphs(tables map[string][]byte) error {
+	head, ok := tables["head"]
+	if !ok {
+		return errors.New("missing head table")
+	}
+	loca, ok := tables["loca"]
+	if !ok {
+		return errors.New("missing loca table")
+	}
+	f.glyf = tables["glyf"]
+
+	longOffsets := newByteReader(head, 50).i16() != 0
+	entrySize := 2
+	if longOffsets {
+		entrySize = 4
+	}
+	n := len(loca)/entrySize - 1
+	if f.numGlyphs == 0 || f.numGlyphs > n {
+		f.numGlyphs = n
+	}
+	if f.numGlyphs < 0 {
+		return errInvalidFont
+	}
+
+	r := newByteReader(loca, 0)
+	f.loca = make([]int, f.numGlyphs+1)
+	for i := range f.loca {
+		if longOffsets {
+			f.loca[i] = int(r.u32())
+		} else {
+			f.loca[i] = 2 * int(r.u16())
+		}
+	}
+	return nil
+}
+
+// parseCMap loads the character to glyph mappings of the supported cmap
+// subtables. Unsupported and invalid subtables are ignored.
+func (f *TrueType) parseCMap(data []byte) {
+	f.cmaps = map[cmapID]map[uint32]textencoding.GID{}
+	if data == nil {
+		return
+	}
+
+	r := newByteReader(data, 2)
+	numTables := int(r.u16())
+	for i := 0; i < numTables && !r.err; i++ {
+		id := cmapID{platformID: r.u16(), encodingID: r.u16()}
+		offset := int(r.u32())
+		if _, ok := f.cmaps[id]; ok {
+			continue
+		}
+		if m := parseCMapSubtable(data, offset); len(m) > 0 {
+			f.cmaps[id] = m
+		}
+	}
+}
+
+// parseCMapSubtable parses the cmap subtable located at `offset`. Formats
+// 0, 4, 6 and 12 are supported.
+func parseCMapSubtable(data []byte, offset int) map[uint32]textencoding.GID {
+	r := newByteReader(data, offset)
+	m := map[uint32]textencoding.GID{}
+	switch r.u16() {
+	case 0:
+		r.skip(4)
+		for code, gid := range r.read(256) {
+			if gid != 0 {
+				m[uint32(code)] = textencoding.GID(gid)
+			}
+		}
+	case 4:
+		r.skip(4)
+		segCount := int(r.u16()) / 2
+		r.skip(6)
+		endCodes := make([]uint16, segCount)
+		for i := range endCodes {
+			endCodes[i] = r.u16()
+		}
+		r.skip(2)
+		startCodes := make([]uint16, segCount)
+		for i := range startCodes {
+			startCodes[i] = r.u16()
+		}
+		deltas := make([]uint16, segCount)
+		for i := range deltas {
+			deltas[i] = r.u16()
+		}
+		rangeOffsetsPos := r.off
+		for i := 0; i < segCount && !r.err; i++ {
+			rangeOffset := int(r.u16())
+			for c := int(startCodes[i]); c <= int(endCodes[i]) && c != 0xffff; c++ {
+				gid := uint16(c) + deltas[i]
+				if rangeOffset != 0 {
+					pos := rangeOffsetsPos + 2*i + rangeOffset + 2*(c-int(startCodes[i]))
+					g := newByteReader(data, pos).u16()
+					if g == 0 {
+						continue
+					}
+					gid = g + deltas[i]
+				}
+				if gid != 0 {
+					m[uint32(c)] = textencoding.GID(gid)
+				}
+			}
+		}
+	case 6:
+		r.skip(4)
+		first := uint32(r.u16())
+		count := int(r.u16())
+		for i := 0; i < count && !r.err; i++ {
+			if gid := r.u16(); gid != 0 {
+				m[first+uint32(i)] = textencoding.GID(gid)
+			}
+		}
+	case 12:
+		r.skip(10)
+		numGroups := int(r.u32())
+		for i := 0; i < numGroups && !r.err && len(m) < maxCMapEntries; i++ {
+			start, end, gid := r.u32(), r.u32(), r.u32()
+			if end < start || end-start > maxCMapEntries {
+				continue
+			}
+			for c := start; c <= end; c++ {
+				if g := gid + c - start; g > 0 && g <= math.MaxUint16 {
+					m[c] = textencoding.GID(g)
+				}
+			}
+		}
+	}
+	if r.err {
+		return nil
+	}
+	return m
+}
+
+// parsePost loads the glyph names of the post table.
+func (f *TrueType) parsePost(data []byte) {
+	f.names = map[textencoding.GlyphName]textencoding.GID{}
+	if data == nil {
+		return
+	}
+
+	addName := func(name textencoding.GlyphName, gid int) {
+		if _, ok := f.names[name]; !ok && gid < f.numGlyphs {
+			f.names[name] = textencoding.GID(gid)
+		}
+	}
+
+	r := newByteReader(data, 0)
+	switch r.u32() {
+	case 0x00010000:
+		for gid, name := range macGlyphNames {
+			addName(name, gid)
+		}
+	case 0x00020000:
+		r.skip(28)
+		numGlyphs := int(r.u16())
+		indices := make([]int, numGlyphs)
+		for i := range indices {
+			indices[i] = int(r.u16())
+		}
+
+		var names []textencoding.GlyphName
+		for !r.err && r.off < len(data) {
+			n := int(r.u8())
+			names = append(names, textencoding.GlyphName(r.read(n)))
+		}
+
+		for gid, index := range indices {
+			switch {
+			case index < len(macGlyphNames):
+				addName(macGlyphNames[index], gid)
+			case index-len(macGlyphNames) < len(names):
+				addName(names[index-len(macGlyphNames)], gid)
+			}
+		}
+	}
+}
+
+// Matrix returns the font matrix, which maps glyph space to text space.
+func (f *TrueType) Matrix() transform.Matrix {
+	if f.cff != nil {
+		return f.cff.Matrix()
+	}
+	scale := 1 / float64(f.unitsPerEm)
+	return transform.ScaleMatrix(scale, scale)
+}
+
+// NumGlyphs returns the number of glyphs in the font program.
+func (f *TrueType) NumGlyphs() int {
+	return f.numGlyphs
+}
+
+// Advance returns the advance width of the glyph with the specified index,
+// in glyph space. The returned bool is false if the font program contains no
+// horizontal metrics.
+func (f *TrueType) Advance(gid textencoding.GID) (float64, bool) {
+	n := len(f.advances)
+	if n == 0 {
+		return 0, false
+	}
+	if int(gid) >= n {
+		// The last advance width applies to the remaining glyphs.
+		gid = textencoding.GID(n - 1)
+	}
+	return float64(f.advances[gid]), true
+}
+
+// HasCMap returns true if the font program contains a cmap subtable for the
+// specified platform and encoding.
+func (f *TrueType) HasCMap(platformID, encodingID uint16) bool {
+	_, ok := f.cmaps[cmapID{platformID, encodingID}]
+	return ok
+}
+
+// GlyphByCMap returns the index of the glyph which `code` is mapped to by the
+// cmap subtable for the specified platform and encoding.
+func (f *TrueType) GlyphByCMap(platformID, encodingID uint16, code uint32) (textencoding.GID, bool) {
+	gid, ok := f.cmaps[cmapID{platformID, encodingID}][code]
+	if !ok || int(gid) >= f.numGlyphs {
+		return 0, false
+	}
+	return gid, true
+}
+
+// GlyphByRune returns the index of the glyph which rune `r` is mapped to by
+// the Unicode cmap subtables of the font program.
+func (f *TrueType) GlyphByRune(r rune) (textencoding.GID, bool) {
+	for _, id := range []cmapID{{3, 10}, {3, 1}, {0, 4}, {0, 3}, {0, 1}, {0, 0}} {
+		if gid, ok := f.GlyphByCMap(id.platformID, id.encodingID, uint32(r)); ok {
+			return gid, true
+		}
+	}
+	return 0, false
+}
+
+// GlyphByName returns the index of the glyph with the specified name.
+// The glyph is looked up in the post table, followed by the Unicode and
+// Macintosh cmap subtables of the font program.
+func (f *TrueType) GlyphByName(name textencoding.GlyphName) (textencoding.GID, bool) {
+	if gid, ok := f.names[name]; ok {
+		return gid, true
+	}
+	if f.cff != nil {
+		if gid, ok := f.cff.GlyphByName(name); ok {
+			return gid, true
+		}
+	}
+
+	r, ok := textencoding.GlyphToRune(name)
+	if !ok {
+		return 0, false
+	}
+	if gid, ok := f.GlyphByRune(r); ok {
+		return gid, true
+	}
+	if code, ok := macRomanCodes[r]; ok {
+		return f.GlyphByCMap(1, 0, uint32(code))
+	}
+	return 0, false
+}
+
+// GlyphByCode returns the index of the glyph selected by character code
+// `code`, through the symbolic cmap subtables of the font program, as
+// specified for symbolic TrueType fonts (9.6.6.4 "Encodings for TrueType
+// Fonts").
+func (f *TrueType) GlyphByCode(code byte) (textencoding.GID, bool) {
+	if f.HasCMap(3, 0) {
+		for _, prefix := range []uint32{0x0000, 0xf000, 0xf100, 0xf200} {
+			if gid, ok := f.GlyphByCMap(3, 0, prefix|uint32(code)); ok {
+				return gid, true
+			}
+		}
+	}
+	if gid, ok := f.GlyphByCMap(1, 0, uint32(code)); ok {
+		return gid, true
+	}
+	if f.cff != nil {
+		return f.cff.GlyphByCode(code)
+	}
+	return 0, false
+}
+
+// GlyphByCID returns the index of the glyph with the specified CID, for
+// OpenType fonts containing CID-keyed CFF data. Otherwise CIDs are used as
+// glyph indices.
+func (f *TrueType) GlyphByCID(cid textencoding.CharCode) (textencoding.GID, bool) {
+	if f.cff != nil {
+		return f.cff.GlyphByCID(cid)
+	}
+	if int(cid) >= f.numGlyphs {
+		return 0, false
+	}
+	return textencoding.GID(cid), true
+}
+
+// Outline returns the outline of the glyph with the specified index, in
+// glyph space.
+func (f *TrueType) Outline(gid textencoding.GID) (Path, error) {
+	if f.cff != nil {
+		return f.cff.Outline(gid)
+	}
+
+	var p Path
+	if err := f.appendGlyph(&p, gid, transform.IdentityMatrix(), 0); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// glyphPoint represents a point of a TrueType glyph contour.
+type glyphPoint struct {
+	x, y    float64
+	onCurve bool
+}
+
+// appendGlyph appends the outline of the glyph with index `gid`, transformed
+// by `m`, to `p`.
+func (f *TrueType) appendGlyph(p *Path, gid textencoding.GID, m transform.Matrix, depth int) error {
+	if depth > maxRecursionDepth {
+		return errInvalidGlyph
+	}
+	if int(gid) >= f.numGlyphs {
+		return errNoGlyph
+	}
+	start, end := f.loca[gid], f.loca[gid+1]
+	if start >= end {
+		// Glyphs without outlines, such as spaces.
+		return nil
+	}
+	if start < 0 || end > len(f.glyf) {
+		return errInvalidGlyph
+	}
+
+	r := newByteReader(f.glyf[start:end], 0)
+	numContours := int(r.i16())
+	r.skip(8)
+	if numContours < 0 {
+		return f.appendCompositeGlyph(p, r, m, depth)
+	}
+
+	endPoints := make([]int, numContours)
+	for i := range endPoints {
+		endPoints[i] = int(r.u16())
+	}
+	r.skip(int(r.u16()))
+	if numContours == 0 || r.err {
+		return nil
+	}
+
+	numPoints := endPoints[numContours-1] + 1
+	flags := make([]uint8, 0, numPoints)
+	for len(flags) < numPoints && !r.err {
+		flag := r.u8()
+		flags = append(flags, flag)
+		if flag&0x08 != 0 {
+			for n := r.u8(); n > 0 && len(flags) < numPoints; n-- {
+				flags = append(flags, flag)
+			}
+		}
+	}
+
+	points := make([]glyphPoint, numPoints)
+	readCoords := func(short, same uint8, set func(pt *glyphPoint, v float64)) {
+		var v int
+		for i, flag := range flags {
+			switch {
+			case flag&short != 0:
+				d := int(r.u8())
+				if flag&same == 0 {
+					d = -d
+				}
+				v += d
+			case flag&same == 0:
+				v += int(r.i16())
+			}
+			set(&points[i], float64(v))
+		}
+	}
+	readCoords(0x02, 0x10, func(pt *glyphPoint, v float64) { pt.x = v })
+	readCoords(0x04, 0x20, func(pt *glyphPoint, v float64) { pt.y = v })
+	if r.err {
+		return errInvalidGlyph
+	}
+	for i, flag := range flags {
+		points[i].onCurve = flag&0x01 != 0
+		points[i].x, points[i].y = m.Transform(points[i].x, points[i].y)
+	}
+
+	first := 0
+	for _, last := range endPoints {
+		if last < first || last >= numPoints {
+			return errInvalidGlyph
+		}
+		appendContour(p, points[first:last+1])
+		first = last + 1
+	}
+	return nil
+}
+
+// appendCompositeGlyph appends the components of a composite glyph, whose
+// description is read by `r`, to `p`.
+func (f *TrueType) appendCompositeGlyph(p *Path, r *byteReader, m transform.Matrix, depth int) error {
+	const (
+		argsAreWords    = 0x0001
+		argsAreXY       = 0x0002
+		haveScale       = 0x0008
+		moreComponents  = 0x0020
+		haveXYScale     = 0x0040
+		haveTwoByTwo    = 0x0080
+		f2dot14Division = 1 << 14
+	)
+
+	for {
+		flags := r.u16()
+		gid := textencoding.GID(r.u16())
+
+		var dx, dy float64
+		if flags&argsAreWords != 0 {
+			dx, dy = float64(r.i16()), float64(r.i16())
+		} else {
+			dx, dy = float64(r.i8()), float64(r.i8())
+		}
+		if flags&argsAreXY == 0 {
+			// Matching points are not supported.
+			dx, dy = 0, 0
+		}
+
+		a, b, c, d := 1.0, 0.0, 0.0, 1.0
+		switch {
+		case flags&haveScale != 0:
+			a = float64(r.i16()) / f2dot14Division
+			d = a
+		case flags&haveXYScale != 0:
+			a = float64(r.i16()) / f2dot14Division
+			d = float64(r.i16()) / f2dot14Division
+		case flags&haveTwoByTwo != 0:
+			a = float64(r.i16()) / f2dot14Division
+			b = float64(r.i16()) / f2dot14Division
+			c = float64(r.i16()) / f2dot14Division
+			d = float64(r.i16()) / f2dot14Division
+		}
+		if r.err {
+			return errInvalidGlyph
+		}
+
+		cm := m.Mult(transform.NewMatrix(a, b, c, d, dx, dy))
+		if err := f.appendGlyph(p, gid, cm, depth+1); err != nil {
+			return err
+		}
+		if flags&moreComponents == 0 {
+			return nil
+		}
+	}
+}
+
+// appendContour appends the TrueType contour defined by `points` to `p`.
+// Consecutive off-curve points imply an on-curve point midway between them.
+func appendContour(p *Path, points []glyphPoint) {
+	if len(points) == 0 {
+		return
+	}
+	midpoint := func(a, b glyphPoint) glyphPoint {
+		return glyphPoint{x: (a.x + b.x) / 2, y: (a.y + b.y) / 2, onCurve: true}
+	}
+
+	first, last := points[0], points[len(points)-1]
+	var start glyphPoint
+	switch {
+	case first.onCurve:
+		start, points = first, points[1:]
+	case last.onCurve:
+		start, points = last, points[:len(points)-1]
+	default:
+		start = midpoint(first, last)
+	}
+	p.moveTo(start.x, start.y)
+
+	var ctrl *glyphPoint
+	for i := range points {
+		pt := points[i]
+		switch {
+		case pt.onCurve && ctrl == nil:
+			p.lineTo(pt.x, pt.y)
+		case pt.onCurve:
+			p.quadTo(ctrl.x, ctrl.y, pt.x, pt.y)
+			ctrl = nil
+		case ctrl != nil:
+			mid := midpoint(*ctrl, pt)
+			p.quadTo(ctrl.x, ctrl.y, mid.x, mid.y)
+			ctrl = &points[i]
+		default:
+			ctrl = &points[i]
+		}
+	}
+	if ctrl != nil {
+		p.quadTo(ctrl.x, ctrl.y, start.x, start.y)
+	} else {
+		p.lineTo(start.x, start.y)
+	}
+}
@@ -0,0 +1,239 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fontprog
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/internal/textencoding"
+)
+
+// makeSFNT returns a font file containing `tables`.
+func makeSFNT(version int, tables map[string][]byte) []byte {
+	var tags []string
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	data := concat(u32(version), u16(len(tables)), make([]byte, 6))
+	offset := len(data) + 16*len(tables)
+	var body []byte
+	for _, tag := range tags {
+		table := tables[tag]
+		data = concat(data, []byte(tag), u32(0), u32(offset), u32(len(table)))
+		body = append(body, table...)
+		offset += len(table)
+	}
+	return append(data, body...)
+}
+
+// ttfPoint is a point of a simple TrueType glyph contour.
+type ttfPoint struct {
+	x, y    int
+	onCurve bool
+}
+
+// makeSimpleGlyph returns the description of a TrueType glyph made of
+// `contours`. The coordinates are stored as 16-bit deltas.
+func makeSimpleGlyph(contours ...[]ttfPoint) []byte {
+	data := concat(u16(len(contours)), make([]byte, 8))
+	var points []ttfPoint
+	for _, contour := range contours {
+		points = append(points, contour...)
+		data = append(data, u16(len(points)-1)...)
+	}
+	data = append(data, u16(0)...)
+	for _, pt := range points {
+		var flag byte
+		if pt.onCurve {
+			flag = 0x01
+		}
+		data = append(data, flag)
+	}
+	var x, y int
+	for _, pt := range points {
+		data = append(data, u16(pt.x-x)...)
+		x = pt.x
+	}
+	for _, pt := range points {
+		data = append(data, u16(pt.y-y)...)
+		y = pt.y
+	}
+	return data
+}
+
+// cmapSubtable is a cmap subtable with its platform and encoding.
+type cmapSubtable struct {
+	platformID, encodingID int
+	data                   []byte
+}
+
+// makeCMap returns a cmap table containing `subtables`.
+func makeCMap(subtables ...cmapSubtable) []byte {
+	data := concat(u16(0), u16(len(subtables)))
+	offset := len(data) + 8*len(subtables)
+	var body []byte
+	for _, subtable := range subtables {
+		data = concat(data, u16(subtable.platformID), u16(subtable.encodingID), u32(offset))
+		body = append(body, subtable.data...)
+		offset += len(subtable.data)
+	}
+	return append(data, body...)
+}
+
+// makeCMapFormat4 returns a cmap subtable in format 4 mapping each of the
+// sorted character codes of `codes` to the corresponding glyph of `gids`.
+func makeCMapFormat4(codes []int, gids []int) []byte {
+	codes = append(codes, 0xffff)
+	gids = append(gids, 1)
+	segCount := len(codes)
+	var ends, starts, deltas, rangeOffsets []byte
+	for i, code := range codes {
+		ends = append(ends, u16(code)...)
+		starts = append(starts, u16(code)...)
+		deltas = append(deltas, u16(gids[i]-code)...)
+		rangeOffsets = append(rangeOffsets, u16(0)...)
+	}
+	data := concat(u16(2*segCount), make([]byte, 6), ends, u16(0), starts, deltas, rangeOffsets)
+	return concat(u16(4), u16(len(data)+6), u16(0), data)
+}
+
+// makeTestTrueType returns a TrueType font program with the glyphs .notdef,
+// A, acute, named acute.alt in the post table, and Aacute, which is a
+// composite glyph made of A and acute.
+func makeTestTrueType(t *testing.T) []byte {
+	glyphs := [][]byte{
+		nil,
+		makeSimpleGlyph([]ttfPoint{{0, 0, true}, {100, 0, true}, {100, 100, false}, {0, 100, true}}),
+		makeSimpleGlyph([]ttfPoint{{0, 0, true}, {10, 0, true}, {0, 10, true}}),
+		concat(u16(0xffff), make([]byte, 8),
+			u16(0x0023), u16(1), u16(0), u16(0),
+			u16(0x0003), u16(2), u16(50), u16(120)),
+	}
+	var glyf, loca []byte
+	for _, glyph := range glyphs {
+		loca = append(loca, u16(len(glyf)/2)...)
+		glyf = append(glyf, glyph...)
+		if len(glyf)%2 != 0 {
+			glyf = append(glyf, 0)
+		}
+	}
+	loca = append(loca, u16(len(glyf)/2)...)
+
+	head := make([]byte, 54)
+	copy(head[18:], u16(2048))
+	maxp := concat(u32(0x00005000), u16(len(glyphs)))
+	hhea := make([]byte, 36)
+	copy(hhea[34:], u16(2))
+	hmtx := concat(u16(500), u16(0), u16(600), u16(0))
+
+	macRoman := make([]byte, 256)
+	macRoman['A'] = 1
+	macRoman[0xe7] = 3 // Aacute
+	cmap := makeCMap(
+		cmapSubtable{1, 0, concat(u16(0), u16(262), u16(0), macRoman)},
+		cmapSubtable{3, 0, concat(u16(6), u16(12), u16(0), u16(0xf061), u16(1), u16(1))},
+		cmapSubtable{3, 1, makeCMapFormat4([]int{'A', 0xb4, 0xc1}, []int{1, 2, 3})},
+	)
+
+	var aacute int
+	for i, name := range macGlyphNames {
+		if name == "Aacute" {
+			aacute = i
+		}
+	}
+	require.NotZero(t, aacute)
+	post := concat(u32(0x00020000), make([]byte, 28), u16(len(glyphs)),
+		u16(0), u16(36), u16(len(macGlyphNames)), u16(aacute),
+		[]byte{9}, []byte("acute.alt"))
+
+	return makeSFNT(0x00010000, map[string][]byte{
+		"head": head, "maxp": maxp, "loca": loca, "glyf": glyf,
+		"hhea": hhea, "hmtx": hmtx, "cmap": cmap, "post": post,
+	})
+}
+
+func TestTrueType(t *testing.T) {
+	data := makeTestTrueType(t)
+	f, err := ParseTrueType(data)
+	require.NoError(t, err)
+	require.Equal(t, 4, f.NumGlyphs())
+	m := f.Matrix()
+	x, y := m.Transform(2048, 1024)
+	require.InDelta(t, 1, x, 1e-9)
+	require.InDelta(t, 0.5, y, 1e-9)
+
+	// Advance widths. The last width applies to the remaining glyphs.
+	w, ok := f.Advance(1)
+	require.True(t, ok)
+	require.Equal(t, 600.0, w)
+	w, ok = f.Advance(3)
+	require.True(t, ok)
+	require.Equal(t, 600.0, w)
+
+	// Glyph lookup by name, through the post table and the cmap subtables.
+	for name, expected := range map[textencoding.GlyphName]textencoding.GID{
+		"A": 1, "acute.alt": 2, "Aacute": 3, "acute": 2,
+	} {
+		gid, ok := f.GlyphByName(name)
+		require.True(t, ok, name)
+		require.Equal(t, expected, gid, name)
+	}
+	_, ok = f.GlyphByName("B")
+	require.False(t, ok)
+
+	// Glyph lookup by rune and code, through the Unicode, symbolic and
+	// Macintosh cmap subtables.
+	gid, ok := f.GlyphByRune(0xc1)
+	require.True(t, ok)
+	require.Equal(t, textencoding.GID(3), gid)
+	require.True(t, f.HasCMap(3, 0))
+	gid, ok = f.GlyphByCode('a')
+	require.True(t, ok)
+	require.Equal(t, textencoding.GID(1), gid)
+	gid, ok = f.GlyphByCode(0xe7)
+	require.True(t, ok)
+	require.Equal(t, textencoding.GID(3), gid)
+	_, ok = f.GlyphByCode('B')
+	require.False(t, ok)
+
+	// CIDs are glyph indices.
+	gid, ok = f.GlyphByCID(2)
+	require.True(t, ok)
+	require.Equal(t, textencoding.GID(2), gid)
+	_, ok = f.GlyphByCID(4)
+	require.False(t, ok)
+
+	// Outlines. Off-curve points are quadratic Bézier control points.
+	var a Path
+	a.moveTo(0, 0)
+	a.lineTo(100, 0)
+	a.quadTo(100, 100, 0, 100)
+	a.lineTo(0, 0)
+	acute := makePath(nil, []float64{0, 0}, []float64{10, 0}, []float64{0, 10}, []float64{0, 0})
+	outlines := map[textencoding.GID]Path{
+		0: nil,
+		1: a,
+		2: acute,
+		3: append(append(Path{}, a...), makePath(nil, []float64{50, 120}, []float64{60, 120}, []float64{50, 130},
+			[]float64{50, 120})...),
+	}
+	for gid, expected := range outlines {
+		path, err := f.Outline(gid)
+		require.NoError(t, err)
+		require.Equal(t, expected, path, "glyph %d", gid)
+	}
+	_, err = f.Outline(4)
+	require.Error(t, err)
+
+	requireTruncatedFails(t, data, 12, func(data []byte) (Font, error) {
+		return ParseTrueType(data)
+	})
+}
@@ -0,0 +1,588 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fontprog
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+
+	"github.com/showntop/unipdf/internal/textencoding"
+	"github.com/showntop/unipdf/internal/transform"
+)
+
+// Type 1 decryption keys (section 7 "Encryption" of the Adobe Type 1 Font
+// Format specification).
+const (
+	eexecKey      = 55665
+	charStringKey = 4330
+)
+
+var (
+	reFontMatrix    = regexp.MustCompile(`/FontMatrix\s*[\[{]([^\]}]*)[\]}]`)
+	reEncodingEntry = regexp.MustCompile(`dup\s+(\d+)\s*/(\S+?)\s+put`)
+	reLenIV         = regexp.MustCompile(`/lenIV\s+(-?\d+)`)
+)
+
+// Type1 represents a font program in the Type 1 format, as contained in
+// FontFile streams.
+type Type1 struct {
+	matrix      transform.Matrix
+	charStrings [][]byte
+	subrs       [][]byte
+	names       map[textencoding.GlyphName]textencoding.GID
+	encoding    map[byte]textencoding.GID
+}
+
+// ParseType1 parses the Type 1 font program contained in `data`, which can
+// be stored in the PFA or PFB formats. The lengths of the cleartext and of
+// the encrypted portions of the program, specified by the Length1 and
+// Length2 entries of the font file stream, are used when valid.
+func ParseType1(data []byte, length1, length2 int) (*Type1, error) {
+	cleartext, encrypted := splitType1(data, length1, length2)
+	if cleartext == nil || encrypted == nil {
+		return nil, errInvalidFont
+	}
+
+	// Encrypted portions stored in hexadecimal form.
+	if len(encrypted) >= 4 && isHex(encrypted[:4]) {
+		decoded := make([]byte, 0, len(encrypted)/2)
+		var hi byte
+		var odd bool
+		for _, c := range encrypted {
+			v, ok := hexValue(c)
+			if !ok {
+				continue
+			}
+			if odd {
+				decoded = append(decoded, hi<<4|v)
+			}
+			hi, odd = v, !odd
+		}
+		encrypted = decoded
+	}
+
+	private := decryptType1(encrypted, eexecKey, 4)
+	f := &Type1{
+		matrix:   transform.ScaleMatrix(0.001, 0.001),
+		names:    map[textencoding.GlyphName]textencoding.GID{},
+		encoding: map[byte]textencoding.GID{},
+	}
+	if groups := reFontMatrix.FindSubmatch(cleartext); groups != nil {
+		var m []float64
+		for _, field := range bytes.Fields(groups[1]) {
+			v, err := strconv.ParseFloat(string(field), 64)
+			if err != nil {
+				break
+			}
+			m = append(m, v)
+		}
+		if len(m) == 6 {
+			f.matrix = transform.NewMatrix(m[0], m[1], m[2], m[3], m[4], m[5])
+		}
+	}
+
+	lenIV := 4
+	if groups := reLenIV.FindSubmatch(private); groups != nil {
+		lenIV, _ = strconv.Atoi(string(groups[1]))
+	}
+	decrypt := func(cs []byte) []byte {
+		if lenIV < 0 {
+			return cs
+		}
+		return decryptType1(cs, charStringKey, lenIV)
+	}
+
+	// Subroutines.
+	if i := bytes.Index(private, []byte("/Subrs")); i >= 0 {
+		lex := &type1Lexer{data: private, off: i + len("/Subrs")}
+		count, _ := strconv.Atoi(lex.token())
+		if count > 0 && count <= len(private) {
+			f.subrs = make([][]byte, count)
+		}
+		for {
+			tok := lex.token()
+			if tok == "array" || tok == "ND" || tok == "|-" || tok == "NP" || tok == "|" ||
+				tok == "noaccess" || tok == "put" || tok == "readonly" || tok == "def" {
+				continue
+			}
+			if tok != "dup" {
+				break
+			}
+			index, _ := strconv.Atoi(lex.token())
+			cs, ok := lex.binary()
+			if !ok {
+				break
+			}
+			if index >= 0 && index < len(f.subrs) {
+				f.subrs[index] = decrypt(cs)
+			}
+		}
+	}
+
+	// Glyph descriptions.
+	i := bytes.Index(private, []byte("/CharStrings"))
+	if i < 0 {
+		return nil, errInvalidFont
+	}
+	lex := &type1Lexer{data: private, off: i + len("/CharStrings")}
+	for tok := lex.token(); tok != "begin"; tok = lex.token() {
+		if tok == "" {
+			return nil, errInvalidFont
+		}
+	}
+	for {
+		tok := lex.token()
+		if tok == "" || tok == "end" {
+			break
+		}
+		if len(tok) < 2 || tok[0] != '/' {
+			continue
+		}
+		cs, ok := lex.binary()
+		if !ok {
+			break
+		}
+		name := textencoding.GlyphName(tok[1:])
+		if _, ok := f.names[name]; !ok {
+			f.names[name] = textencoding.GID(len(f.charStrings))
+		}
+		f.charStrings = append(f.charStrings, decrypt(cs))
+	}
+
+	// Built-in encoding.
+	if bytes.Contains(cleartext, []byte("/Encoding StandardEncoding")) {
+		for code := 0; code < 256; code++ {
+			if name, ok := standardGlyphName(byte(code)); ok {
+				if gid, ok := f.names[name]; ok {
+					f.encoding[byte(code)] = gid
+				}
+			}
+		}
+	} else {
+		for _, groups := range reEncodingEntry.FindAllSubmatch(cleartext, -1) {
+			code, err := strconv.Atoi(string(groups[1]))
+			if err != nil || code > 255 {
+				continue
+			}
+			if gid, ok := f.names[textencoding.GlyphName(groups[2])]; ok {
+				f.encoding[byte(code)] = gid
+			}
+		}
+	}
+
+	return f, nil
+}
+
+// splitType1 returns the cleartext and the encrypted portions of the Type 1
+// font program contained in `data`.
+func splitType1(data []byte, length1, length2 int) ([]byte, []byte) {
+	// PFB segments.
+	if len(data) > 0 && data[0] == 0x80 {
+		var cleartext, encrypted []byte
+		r := newByteReader(data, 0)
+		for !r.err && r.off < len(data) && r.u8() == 0x80 {
+			segType := r.u8()
+			if segType == 3 {
+				break
+			}
+			b := r.read(int(r.u8()) | int(r.u8())<<8 | int(r.u8())<<16 | int(r.u8())<<24)
+			switch segType {
+			case 1:
+				if encrypted == nil {
+					cleartext = append(cleartext, b...)
+				}
+			case 2:
+				encrypted = append(encrypted, b...)
+			}
+		}
+		return cleartext, encrypted
+	}
+
+	i := bytes.Index(data, []byte("eexec"))
+	if i < 0 {
+		return nil, nil
+	}
+	if length1 > i && length1 < len(data) && length2 > 0 {
+		end := length1 + length2
+		if end > len(data) {
+			end = len(data)
+		}
+		return data[:length1], data[length1:end]
+	}
+
+	// The eexec operator is followed by a single whitespace character or
+	// by an end of line sequence.
+	i += len("eexec")
+	if i < len(data) && data[i] == '\r' {
+		i++
+	}
+	if i < len(data) && (data[i] == '\n' || data[i] == ' ' || data[i] == '\t') {
+		i++
+	}
+	return data[:i], data[i:]
+}
+
+// decryptType1 decrypts `data` using the specified key, discarding the first
+// `skip` bytes of plaintext.
+func decryptType1(data []byte, key uint16, skip int) []byte {
+	const c1, c2 = 52845, 22719
+	out := make([]byte, len(data))
+	for i, c := range data {
+		out[i] = c ^ byte(key>>8)
+		key = (uint16(c)+key)*c1 + c2
+	}
+	if skip > len(out) {
+		return nil
+	}
+	return out[skip:]
+}
+
+func isHex(data []byte) bool {
+	for _, c := range data {
+		if _, ok := hexValue(c); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func hexValue(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// type1Lexer splits the private portion of Type 1 font programs into tokens.
+type type1Lexer struct {
+	data []byte
+	off  int
+}
+
+func isType1Space(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' || c == 0
+}
+
+// token returns the next whitespace delimited token, or an empty string at
+// the end of the data.
+func (lex *type1Lexer) token() string {
+	for lex.off < len(lex.data) && isType1Space(lex.data[lex.off]) {
+		lex.off++
+	}
+	start := lex.off
+	for lex.off < len(lex.data) && !isType1Space(lex.data[lex.off]) {
+		lex.off++
+	}
+	return string(lex.data[start:lex.off])
+}
+
+// binary reads a length prefixed binary string, defined using the RD
+// procedure (e.g. `5 RD <5 bytes>`).
+func (lex *type1Lexer) binary() ([]byte, bool) {
+	n, err := strconv.Atoi(lex.token())
+	if err != nil || n < 0 {
+		return nil, false
+	}
+	lex.token()
+	lex.off++
+	if lex.off+n > len(lex.data) {
+		return nil, false
+	}
+	b := lex.data[lex.off : lex.off+n]
+	lex.off += n
+	return b, true
+}
+
+// Matrix returns the font matrix, which maps glyph space to text space.
+func (f *Type1) Matrix() transform.Matrix {
+	return f.matrix
+}
+
+// NumGlyphs returns the number of glyphs in the font program.
+func (f *Type1) NumGlyphs() int {
+	return len(f.charStrings)
+}
+
+// GlyphByName returns the index of the glyph with the specified name.
+func (f *Type1) GlyphByName(name textencoding.GlyphName) (textencoding.GID, bool) {
+	gid, ok := f.names[name]
+	return gid, ok
+}
+
+// GlyphByCode returns the index of the glyph selected by character code
+// `code`, through the built-in encoding of the font program.
+func (f *Type1) GlyphByCode(code byte) (textencoding.GID, bool) {
+	gid, ok := f.encoding[code]
+	return gid, ok
+}
+
+// Outline returns the outline of the glyph with the specified index, in
+// glyph space.
+func (f *Type1) Outline(gid textencoding.GID) (Path, error) {
+	if int(gid) >= len(f.charStrings) {
+		return nil, errNoGlyph
+	}
+	ip := &type1Interpreter{font: f}
+	if err := ip.run(f.charStrings[gid], 0); err != nil {
+		return nil, err
+	}
+	return ip.path, nil
+}
+
+// type1Interpreter executes Type 1 charstrings (section 6 "CharString
+// Commands" of the Adobe Type 1 Font Format specification).
+type type1Interpreter struct {
+	font *Type1
+
+	stack   []float64
+	psStack []float64
+	x, y    float64
+	sbx     float64
+	ended   bool
+	path    Path
+
+	// flexPoints collects the points of flex hints, which are drawn as two
+	// curves at the end of the flex sequence.
+	flexing    bool
+	flexPoints []transform.Point
+}
+
+func (ip *type1Interpreter) pop() float64 {
+	n := len(ip.stack)
+	if n == 0 {
+		return 0
+	}
+	v := ip.stack[n-1]
+	ip.stack = ip.stack[:n-1]
+	return v
+}
+
+func (ip *type1Interpreter) moveTo(dx, dy float64) {
+	ip.x += dx
+	ip.y += dy
+	if ip.flexing {
+		ip.flexPoints = append(ip.flexPoints, transform.NewPoint(ip.x, ip.y))
+		return
+	}
+	ip.path.moveTo(ip.x, ip.y)
+}
+
+func (ip *type1Interpreter) lineTo(dx, dy float64) {
+	ip.x += dx
+	ip.y += dy
+	ip.path.lineTo(ip.x, ip.y)
+}
+
+func (ip *type1Interpreter) curveTo(dxa, dya, dxb, dyb, dxc, dyc float64) {
+	xa, ya := ip.x+dxa, ip.y+dya
+	xb, yb := xa+dxb, ya+dyb
+	ip.x, ip.y = xb+dxc, yb+dyc
+	ip.path.cubeTo(xa, ya, xb, yb, ip.x, ip.y)
+}
+
+// run executes charstring `cs`. The depth of nested subroutine calls is
+// specified by `depth`.
+func (ip *type1Interpreter) run(cs []byte, depth int) error {
+	if depth > maxRecursionDepth {
+		return errInvalidGlyph
+	}
+
+	r := newByteReader(cs, 0)
+	for r.off < len(cs) && !ip.ended {
+		b0 := int(r.u8())
+
+		// Operands.
+		if b0 >= 32 {
+			var v float64
+			switch {
+			case b0 <= 246:
+				v = float64(b0 - 139)
+			case b0 <= 250:
+				v = float64((b0-247)*256 + int(r.u8()) + 108)
+			case b0 <= 254:
+				v = float64(-(b0-251)*256 - int(r.u8()) - 108)
+			default:
+				v = float64(int32(r.u32()))
+			}
+			if r.err || len(ip.stack) >= cffMaxStack {
+				return errInvalidGlyph
+			}
+			ip.stack = append(ip.stack, v)
+			continue
+		}
+
+		// Operators.
+		s := ip.stack
+		switch b0 {
+		case 1, 3, 9: // hstem, vstem, closepath
+		case 4: // vmoveto
+			if len(s) >= 1 {
+				ip.moveTo(0, s[0])
+			}
+		case 5: // rlineto
+			if len(s) >= 2 {
+				ip.lineTo(s[0], s[1])
+			}
+		case 6: // hlineto
+			if len(s) >= 1 {
+				ip.lineTo(s[0], 0)
+			}
+		case 7: // vlineto
+			if len(s) >= 1 {
+				ip.lineTo(0, s[0])
+			}
+		case 8: // rrcurveto
+			if len(s) >= 6 {
+				ip.curveTo(s[0], s[1], s[2], s[3], s[4], s[5])
+			}
+		case 10: // callsubr
+			index := int(ip.pop())
+			if index < 0 || index >= len(ip.font.subrs) {
+				return errInvalidGlyph
+			}
+			if err := ip.run(ip.font.subrs[index], depth+1); err != nil {
+				return err
+			}
+			continue
+		case 11: // return
+			return nil
+		case 13: // hsbw
+			if len(s) >= 2 {
+				ip.sbx = s[0]
+				ip.x += s[0]
+			}
+		case 14: // endchar
+			ip.ended = true
+		case 21: // rmoveto
+			if len(s) >= 2 {
+				ip.moveTo(s[0], s[1])
+			}
+		case 22: // hmoveto
+			if len(s) >= 1 {
+				ip.moveTo(s[0], 0)
+			}
+		case 30: // vhcurveto
+			if len(s) >= 4 {
+				ip.curveTo(0, s[0], s[1], s[2], s[3], 0)
+			}
+		case 31: // hvcurveto
+			if len(s) >= 4 {
+				ip.curveTo(s[0], 0, s[1], s[2], 0, s[3])
+			}
+		case 12:
+			if err := ip.escape(r.u8(), depth); err != nil {
+				return err
+			}
+			continue
+		default:
+			return errInvalidGlyph
+		}
+		ip.stack = ip.stack[:0]
+	}
+	return nil
+}
+
+// escape executes the two byte operator with the specified second byte.
+func (ip *type1Interpreter) escape(op uint8, depth int) error {
+	s := ip.stack
+	switch op {
+	case 6: // seac
+		if len(s) >= 5 {
+			if err := ip.seac(s[0], s[1], s[2], byte(s[3]), byte(s[4]), depth); err != nil {
+				return err
+			}
+		}
+		ip.ended = true
+	case 7: // sbw
+		if len(s) >= 4 {
+			ip.sbx = s[0]
+			ip.x += s[0]
+			ip.y += s[1]
+		}
+	case 12: // div
+		b, a := ip.pop(), ip.pop()
+		if b == 0 {
+			return errInvalidGlyph
+		}
+		ip.stack = append(ip.stack, a/b)
+		return nil
+	case 16: // callothersubr
+		ip.callOtherSubr()
+		return nil
+	case 17: // pop
+		if n := len(ip.psStack); n > 0 {
+			ip.stack = append(ip.stack, ip.psStack[n-1])
+			ip.psStack = ip.psStack[:n-1]
+		}
+		return nil
+	case 33: // setcurrentpoint
+		// The current point is already set by the preceding flex hint.
+	}
+	ip.stack = ip.stack[:0]
+	return nil
+}
+
+// callOtherSubr executes the standard OtherSubrs used by flex and hint
+// replacement. The arguments of other subroutines are returned through the
+// PostScript stack, so that they can be retrieved by subsequent pop commands.
+func (ip *type1Interpreter) callOtherSubr() {
+	othersubr := int(ip.pop())
+	n := int(ip.pop())
+	if n < 0 || n > len(ip.stack) {
+		n = len(ip.stack)
+	}
+	args := ip.stack[len(ip.stack)-n:]
+	ip.stack = ip.stack[:len(ip.stack)-n]
+
+	switch othersubr {
+	case 0: // End of flex.
+		ip.flexing = false
+		if pts := ip.flexPoints; len(pts) >= 7 {
+			ip.path.cubeTo(pts[1].X, pts[1].Y, pts[2].X, pts[2].Y, pts[3].X, pts[3].Y)
+			ip.path.cubeTo(pts[4].X, pts[4].Y, pts[5].X, pts[5].Y, pts[6].X, pts[6].Y)
+		}
+		ip.flexPoints = nil
+	case 1: // Start of flex.
+		ip.flexing = true
+		ip.flexPoints = nil
+	}
+
+	for i := len(args) - 1; i >= 0; i-- {
+		ip.psStack = append(ip.psStack, args[i])
+	}
+}
+
+// seac composes an accented character from the glyphs selected by the
+// standard encoding codes `bchar` and `achar`. The accent is positioned using
+// its side bearing `asb` and the offset `adx`,`ady`.
+func (ip *type1Interpreter) seac(asb, adx, ady float64, bchar, achar byte, depth int) error {
+	for i, code := range []byte{bchar, achar} {
+		name, ok := standardGlyphName(code)
+		if !ok {
+			return errInvalidGlyph
+		}
+		gid, ok := ip.font.GlyphByName(name)
+		if !ok {
+			return errNoGlyph
+		}
+
+		component := &type1Interpreter{font: ip.font}
+		if i == 1 {
+			component.x, component.y = ip.sbx+adx-asb, ady
+		}
+		if err := component.run(ip.font.charStrings[gid], depth+1); err != nil {
+			return err
+		}
+		ip.path = append(ip.path, component.path...)
+	}
+	return nil
+}
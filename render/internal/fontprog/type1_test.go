@@ -0,0 +1,151 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package fontprog
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/internal/textencoding"
+)
+
+// encryptType1 encrypts `data` using the specified key, preceded by `skip`
+// bytes.
+func encryptType1(data []byte, key uint16, skip int) []byte {
+	const c1, c2 = 52845, 22719
+	plain := append(make([]byte, skip), data...)
+	out := make([]byte, len(plain))
+	for i, p := range plain {
+		c := p ^ byte(key>>8)
+		out[i] = c
+		key = (uint16(c)+key)*c1 + c2
+	}
+	return out
+}
+
+// makeTestType1 returns the cleartext and the encrypted portions of a Type 1
+// font program with the glyphs .notdef, A, acute and Aacute, composed from A
+// and acute. The acute glyph calls a subroutine. The returned length is the
+// length of the encrypted portion up to the start of the glyph descriptions.
+func makeTestType1() ([]byte, []byte, int) {
+	cleartext := []byte("%!PS-AdobeFont-1.0: Test 001.000\n" +
+		"/FontMatrix [0.002 0 0 0.002 0 0] readonly def\n" +
+		"/Encoding 256 array\n0 1 255 {1 index exch /.notdef put} for\n" +
+		"dup 65 /A put\ndup 194 /acute put\nreadonly def\n" +
+		"currentfile eexec\n")
+
+	binary := func(cs []byte) []byte {
+		cs = encryptType1(cs, charStringKey, 4)
+		return concat([]byte(fmt.Sprintf("%d RD ", len(cs))), cs)
+	}
+	var private bytes.Buffer
+	private.WriteString("dup /Private 8 dict dup begin\n/lenIV 4 def\n/Subrs 1 array\ndup 0 ")
+	private.Write(binary(makeCharstring(10, 10, opRlineto, opReturn)))
+	private.WriteString(" NP\nND\n2 index /CharStrings 4 dict dup begin")
+	minLength := 4 + private.Len()
+	private.WriteString("\n")
+	for _, glyph := range []struct {
+		name string
+		cs   []byte
+	}{
+		{".notdef", makeCharstring(0, 500, opHsbw, opEndchar)},
+		{"A", makeCharstring(20, 600, opHsbw, 0, 10, opRmoveto, 100, 0, opRlineto, 0, 100, opRlineto,
+			opEndchar)},
+		{"acute", makeCharstring(5, 300, opHsbw, 0, 0, opRmoveto, 0, opCallsubr, opEndchar)},
+		{"Aacute", makeCharstring(20, 600, opHsbw, 5, 200, 50, 65, 194, opSeac)},
+	} {
+		private.WriteString("/" + glyph.name + " ")
+		private.Write(binary(glyph.cs))
+		private.WriteString(" ND\n")
+	}
+	private.WriteString("end\nend\nreadonly put\nput\n")
+	private.WriteString("dup /FontName get exch definefont pop\nmark currentfile closefile\n")
+
+	return cleartext, encryptType1(private.Bytes(), eexecKey, 4), minLength
+}
+
+func TestType1(t *testing.T) {
+	cleartext, encrypted, minLength := makeTestType1()
+
+	// The encrypted portion can be stored in binary or hexadecimal form, and
+	// the program in the PFA or PFB formats.
+	pfb := func(segments ...[]byte) []byte {
+		var data []byte
+		for i, segment := range segments {
+			data = concat(data, []byte{0x80, byte(i + 1)}, []byte{byte(len(segment)), byte(len(segment) >> 8),
+				byte(len(segment) >> 16), byte(len(segment) >> 24)}, segment)
+		}
+		return concat(data, []byte{0x80, 3})
+	}
+	programs := map[string][]byte{
+		"binary": concat(cleartext, encrypted),
+		"hex":    concat(cleartext, []byte(hex.EncodeToString(encrypted))),
+		"pfb":    pfb(cleartext, encrypted),
+	}
+	for format, data := range programs {
+		f, err := ParseType1(data, 0, 0)
+		require.NoError(t, err, format)
+		requireType1Glyphs(t, f)
+	}
+
+	// The lengths of the portions are used when they are valid.
+	data := concat(cleartext, encrypted, []byte("\n0000000000\ncleartomark\n"))
+	f, err := ParseType1(data, len(cleartext), len(encrypted))
+	require.NoError(t, err)
+	requireType1Glyphs(t, f)
+
+	requireTruncatedFails(t, programs["binary"], len(cleartext)+minLength, func(data []byte) (Font, error) {
+		return ParseType1(data, 0, 0)
+	})
+}
+
+// requireType1Glyphs checks the glyphs of the font program returned by
+// makeTestType1.
+func requireType1Glyphs(t *testing.T, f *Type1) {
+	require.Equal(t, 4, f.NumGlyphs())
+	m := f.Matrix()
+	x, y := m.Transform(500, 250)
+	require.InDelta(t, 1, x, 1e-9)
+	require.InDelta(t, 0.5, y, 1e-9)
+
+	// Glyph lookup by name and through the built-in encoding.
+	for name, expected := range map[textencoding.GlyphName]textencoding.GID{
+		".notdef": 0, "A": 1, "acute": 2, "Aacute": 3,
+	} {
+		gid, ok := f.GlyphByName(name)
+		require.True(t, ok, name)
+		require.Equal(t, expected, gid, name)
+	}
+	gid, ok := f.GlyphByCode('A')
+	require.True(t, ok)
+	require.Equal(t, textencoding.GID(1), gid)
+	gid, ok = f.GlyphByCode(0302)
+	require.True(t, ok)
+	require.Equal(t, textencoding.GID(2), gid)
+	_, ok = f.GlyphByCode('B')
+	require.False(t, ok)
+
+	// Outlines start at the left side bearing. The accent of Aacute is
+	// positioned using its side bearing and the seac offset.
+	a := makePath(nil, []float64{20, 10}, []float64{120, 10}, []float64{120, 110})
+	outlines := map[textencoding.GID]Path{
+		0: nil,
+		1: a,
+		2: makePath(nil, []float64{5, 0}, []float64{15, 10}),
+		3: append(append(Path{}, a...), makePath(nil, []float64{220, 50}, []float64{230, 60})...),
+	}
+	for gid, expected := range outlines {
+		path, err := f.Outline(gid)
+		require.NoError(t, err)
+		require.Equal(t, expected, path, "glyph %d", gid)
+	}
+	_, err := f.Outline(4)
+	require.Error(t, err)
+}
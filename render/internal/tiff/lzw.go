@@ -0,0 +1,91 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package tiff
+
+const (
+	lzwClear    = 256
+	lzwEOI      = 257
+	lzwFirst    = 258
+	lzwMaxWidth = 12
+
+	// lzwMaxCode is the code at which the table is reset, so that the
+	// codes do not exceed the maximum code width.
+	lzwMaxCode = 1<<lzwMaxWidth - 2
+)
+
+// lzwWriter writes LZW codes, most significant bit first.
+type lzwWriter struct {
+	data  []byte
+	bits  uint32
+	nBits uint
+	width uint
+}
+
+func (w *lzwWriter) write(code uint16) {
+	w.bits |= uint32(code) << (32 - w.width - w.nBits)
+	w.nBits += w.width
+	for w.nBits >= 8 {
+		w.data = append(w.data, byte(w.bits>>24))
+		w.bits <<= 8
+		w.nBits -= 8
+	}
+}
+
+func (w *lzwWriter) flush() {
+	if w.nBits > 0 {
+		w.data = append(w.data, byte(w.bits>>24))
+	}
+	w.bits, w.nBits = 0, 0
+}
+
+// encodeLZW compresses the specified data using the LZW variant of the TIFF
+// specification (section 13 "LZW Compression" TIFF 6.0). Unlike the standard
+// LZW algorithm, the code width is increased one code earlier.
+func encodeLZW(data []byte) []byte {
+	w := &lzwWriter{width: 9}
+	w.write(lzwClear)
+	if len(data) == 0 {
+		w.write(lzwEOI)
+		w.flush()
+		return w.data
+	}
+
+	table := map[uint32]uint16{}
+	next := uint16(lzwFirst)
+
+	// advance accounts for a table entry added by the decoder, which
+	// increases the code width when the table is about to outgrow it.
+	advance := func() {
+		next++
+		if next == lzwMaxCode {
+			w.write(lzwClear)
+			table = map[uint32]uint16{}
+			next = lzwFirst
+			w.width = 9
+		} else if next == 1<<w.width && w.width < lzwMaxWidth {
+			w.width++
+		}
+	}
+
+	prefix := uint16(data[0])
+	for _, c := range data[1:] {
+		key := uint32(prefix)<<8 | uint32(c)
+		if code, ok := table[key]; ok {
+			prefix = code
+			continue
+		}
+
+		w.write(prefix)
+		table[key] = next
+		advance()
+		prefix = uint16(c)
+	}
+	w.write(prefix)
+	advance()
+	w.write(lzwEOI)
+	w.flush()
+	return w.data
+}
@@ -0,0 +1,434 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+// Package tiff implements an encoder for single and multi-page TIFF images
+// (TIFF Revision 6.0).
+package tiff
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/showntop/unipdf/internal/ccittfax"
+)
+
+// Compression represents the compression scheme used for encoding the pages
+// of TIFF images.
+type Compression int
+
+// Compression schemes.
+const (
+	// CompressionNone stores the color samples of the pages uncompressed.
+	CompressionNone Compression = iota
+
+	// CompressionLZW compresses the color samples of the pages using LZW.
+	CompressionLZW
+
+	// CompressionDeflate compresses the color samples of the pages using
+	// the zlib format.
+	CompressionDeflate
+
+	// CompressionCCITTGroup3 encodes bilevel pages using one-dimensional
+	// CCITT Group 3 fax encoding.
+	CompressionCCITTGroup3
+
+	// CompressionCCITTGroup4 encodes bilevel pages using CCITT Group 4 fax
+	// encoding.
+	CompressionCCITTGroup4
+)
+
+// bilevel returns true if the compression scheme encodes bilevel images.
+func (c Compression) bilevel() bool {
+	return c == CompressionCCITTGroup3 || c == CompressionCCITTGroup4
+}
+
+// Options specifies the parameters used for encoding the pages of TIFF images.
+type Options struct {
+	// Compression specifies the compression scheme. Images encoded using
+	// CCITT compression schemes are converted to bilevel images, having
+	// the pixels darker than 50% gray painted black.
+	Compression Compression
+
+	// XResolution and YResolution represent the resolution of the image,
+	// in pixels per inch. The resolution is not written if it is not set.
+	XResolution float64
+	YResolution float64
+}
+
+// TIFF field tags (section 8 "Baseline Fields" TIFF 6.0).
+const (
+	tagImageWidth                = 256
+	tagImageLength               = 257
+	tagBitsPerSample             = 258
+	tagCompression               = 259
+	tagPhotometricInterpretation = 262
+	tagStripOffsets              = 273
+	tagSamplesPerPixel           = 277
+	tagRowsPerStrip              = 278
+	tagStripByteCounts           = 279
+	tagXResolution               = 282
+	tagYResolution               = 283
+	tagT4Options                 = 292
+	tagT6Options                 = 293
+	tagResolutionUnit            = 296
+	tagExtraSamples              = 338
+)
+
+// TIFF field types.
+const (
+	typeShort    = 3
+	typeLong     = 4
+	typeRational = 5
+)
+
+// field represents a field of an image file directory. The values of the
+// field are encoded in little-endian byte order.
+type field struct {
+	tag   uint16
+	typ   uint16
+	count uint32
+	value []byte
+}
+
+func shortField(tag uint16, values ...uint16) field {
+	value := make([]byte, 2*len(values))
+	for i, v := range values {
+		binary.LittleEndian.PutUint16(value[2*i:], v)
+	}
+	return field{tag: tag, typ: typeShort, count: uint32(len(values)), value: value}
+}
+
+func longField(tag uint16, values ...uint32) field {
+	value := make([]byte, 4*len(values))
+	for i, v := range values {
+		binary.LittleEndian.PutUint32(value[4*i:], v)
+	}
+	return field{tag: tag, typ: typeLong, count: uint32(len(values)), value: value}
+}
+
+func rationalField(tag uint16, v float64) field {
+	// The resolution is expressed as a fraction having a fixed denominator,
+	// which preserves two decimals.
+	const den = 100
+	value := make([]byte, 8)
+	binary.LittleEndian.PutUint32(value, uint32(math.Round(v*den)))
+	binary.LittleEndian.PutUint32(value[4:], den)
+	return field{tag: tag, typ: typeRational, count: 1, value: value}
+}
+
+// page represents an encoded page, which has not been written yet.
+type page struct {
+	fields []field
+	data   []byte
+}
+
+// Writer writes images as the pages of a TIFF image. The pages are written
+// as they are added, except for the last one, which is written when the
+// writer is closed.
+type Writer struct {
+	w       io.Writer
+	offset  uint32
+	pending *page
+	err     error
+}
+
+// NewWriter returns a new writer which writes a TIFF image to `w`.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Encode writes the specified image to `w` as a single-page TIFF image.
+func Encode(w io.Writer, img image.Image, opts *Options) error {
+	tw := NewWriter(w)
+	if err := tw.WritePage(img, opts); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// WritePage encodes the specified image and adds it as a page of the TIFF
+// image. If `opts` is nil, the page is compressed using LZW.
+func (tw *Writer) WritePage(img image.Image, opts *Options) error {
+	if tw.err != nil {
+		return tw.err
+	}
+	if opts == nil {
+		opts = &Options{Compression: CompressionLZW}
+	}
+
+	p, err := encodePage(img, opts)
+	if err != nil {
+		return err
+	}
+	if tw.pending != nil {
+		if err := tw.writePage(tw.pending, true); err != nil {
+			return err
+		}
+	}
+	tw.pending = p
+	return nil
+}
+
+// Close writes the last page of the TIFF image. It does not close the
+// underlying writer.
+func (tw *Writer) Close() error {
+	if tw.err != nil {
+		return tw.err
+	}
+	if tw.pending == nil {
+		return errors.New("tiff: no pages written")
+	}
+	err := tw.writePage(tw.pending, false)
+	tw.pending = nil
+	if err == nil {
+		tw.err = errors.New("tiff: writer is closed")
+	}
+	return err
+}
+
+// write writes the specified data, keeping track of the current offset.
+func (tw *Writer) write(data []byte) error {
+	if tw.err != nil {
+		return tw.err
+	}
+	n, err := tw.w.Write(data)
+	tw.offset += uint32(n)
+	tw.err = err
+	return err
+}
+
+// writePage writes the image file directory and the data of the specified
+// page. If `more` is true, the directory links to the directory of the next
+// page, which is written right after the data of the page.
+func (tw *Writer) writePage(p *page, more bool) error {
+	if tw.offset == 0 {
+		// The image file header links to the first image file directory,
+		// which follows the header.
+		if err := tw.write([]byte{'I', 'I', 42, 0, 8, 0, 0, 0}); err != nil {
+			return err
+		}
+	}
+
+	sort.Slice(p.fields, func(i, j int) bool {
+		return p.fields[i].tag < p.fields[j].tag
+	})
+
+	// The values of the fields which do not fit in the directory entries are
+	// written after the directory, followed by the data of the page.
+	n := uint32(len(p.fields))
+	valueOffset := tw.offset + 2 + 12*n + 4
+	var values []byte
+	for _, f := range p.fields {
+		if len(f.value) > 4 {
+			values = append(values, f.value...)
+			if len(values)%2 == 1 {
+				values = append(values, 0)
+			}
+		}
+	}
+	dataOffset := valueOffset + uint32(len(values))
+	end := dataOffset + uint32(len(p.data))
+	if end%2 == 1 {
+		end++
+	}
+
+	ifd := make([]byte, 2, 2+12*n+4)
+	binary.LittleEndian.PutUint16(ifd, uint16(n))
+	for _, f := range p.fields {
+		entry := make([]byte, 12)
+		binary.LittleEndian.PutUint16(entry, f.tag)
+		binary.LittleEndian.PutUint16(entry[2:], f.typ)
+		binary.LittleEndian.PutUint32(entry[4:], f.count)
+		switch {
+		case f.tag == tagStripOffsets:
+			binary.LittleEndian.PutUint32(entry[8:], dataOffset)
+		case len(f.value) > 4:
+			binary.LittleEndian.PutUint32(entry[8:], valueOffset)
+			valueOffset += uint32(len(f.value) + len(f.value)%2)
+		default:
+			copy(entry[8:], f.value)
+		}
+		ifd = append(ifd, entry...)
+	}
+
+	var next uint32
+	if more {
+		next = end
+	}
+	ifd = append(ifd, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(ifd[len(ifd)-4:], next)
+
+	for _, data := range [][]byte{ifd, values, p.data} {
+		if err := tw.write(data); err != nil {
+			return err
+		}
+	}
+	if tw.offset < end {
+		return tw.write([]byte{0})
+	}
+	return nil
+}
+
+// encodePage encodes the specified image using the specified options.
+func encodePage(img image.Image, opts *Options) (*page, error) {
+	b := img.Bounds()
+	width, height := b.Dx(), b.Dy()
+	if width <= 0 || height <= 0 {
+		return nil, errors.New("tiff: invalid image dimensions")
+	}
+
+	var data []byte
+	var fields []field
+	if opts.Compression.bilevel() {
+		data, fields = encodeBilevel(img, opts.Compression)
+	} else {
+		var err error
+		if data, fields, err = encodeColor(img, opts.Compression); err != nil {
+			return nil, err
+		}
+	}
+
+	fields = append(fields,
+		longField(tagImageWidth, uint32(width)),
+		longField(tagImageLength, uint32(height)),
+		longField(tagStripOffsets, 0),
+		longField(tagRowsPerStrip, uint32(height)),
+		longField(tagStripByteCounts, uint32(len(data))),
+	)
+	if opts.XResolution > 0 && opts.YResolution > 0 {
+		fields = append(fields,
+			rationalField(tagXResolution, opts.XResolution),
+			rationalField(tagYResolution, opts.YResolution),
+			shortField(tagResolutionUnit, 2),
+		)
+	}
+
+	return &page{fields: fields, data: data}, nil
+}
+
+// encodeBilevel encodes the specified image as a bilevel image, using the
+// specified CCITT compression scheme.
+func encodeBilevel(img image.Image, compression Compression) ([]byte, []field) {
+	b := img.Bounds()
+	pixels := make([][]byte, b.Dy())
+	for y := range pixels {
+		row := make([]byte, b.Dx())
+		for x := range row {
+			gray := color.GrayModel.Convert(img.At(b.Min.X+x, b.Min.Y+y)).(color.Gray)
+			if gray.Y >= 128 {
+				row[x] = 1
+			}
+		}
+		pixels[y] = row
+	}
+
+	encoder := &ccittfax.Encoder{
+		Columns: b.Dx(),
+		Rows:    b.Dy(),
+	}
+	fields := []field{
+		shortField(tagBitsPerSample, 1),
+		shortField(tagPhotometricInterpretation, 0),
+		shortField(tagSamplesPerPixel, 1),
+	}
+	// The strips are encoded like libtiff does. Group 3 rows are
+	// one-dimensional and each starts with an EOL code, without fill bits
+	// (T4Options 0). Group 4 strips end with an EOFB code.
+	if compression == CompressionCCITTGroup4 {
+		encoder.K = -1
+		encoder.EndOfBlock = true
+		fields = append(fields, shortField(tagCompression, 4), longField(tagT6Options, 0))
+	} else {
+		encoder.EndOfLine = true
+		fields = append(fields, shortField(tagCompression, 3), longField(tagT4Options, 0))
+	}
+
+	return encoder.Encode(pixels), fields
+}
+
+// encodeColor encodes the specified image as an RGB image, using the specified
+// compression scheme. Images which are not opaque include an alpha channel.
+func encodeColor(img image.Image, compression Compression) ([]byte, []field, error) {
+	b := img.Bounds()
+	opaque := isOpaque(img)
+	spp := 4
+	if opaque {
+		spp = 3
+	}
+
+	samples := make([]byte, 0, b.Dx()*b.Dy()*spp)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			samples = append(samples, c.R, c.G, c.B)
+			if !opaque {
+				samples = append(samples, c.A)
+			}
+		}
+	}
+
+	fields := []field{
+		shortField(tagPhotometricInterpretation, 2),
+		shortField(tagSamplesPerPixel, uint16(spp)),
+	}
+	if opaque {
+		fields = append(fields, shortField(tagBitsPerSample, 8, 8, 8))
+	} else {
+		// The alpha samples are unassociated.
+		fields = append(fields,
+			shortField(tagBitsPerSample, 8, 8, 8, 8),
+			shortField(tagExtraSamples, 2),
+		)
+	}
+
+	var data []byte
+	switch compression {
+	case CompressionLZW:
+		data = encodeLZW(samples)
+		fields = append(fields, shortField(tagCompression, 5))
+	case CompressionDeflate:
+		var buf bytes.Buffer
+		zw := zlib.NewWriter(&buf)
+		if _, err := zw.Write(samples); err != nil {
+			return nil, nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, nil, err
+		}
+		data = buf.Bytes()
+		fields = append(fields, shortField(tagCompression, 8))
+	case CompressionNone:
+		data = samples
+		fields = append(fields, shortField(tagCompression, 1))
+	default:
+		return nil, nil, errors.New("tiff: unsupported compression")
+	}
+
+	return data, fields, nil
+}
+
+// isOpaque returns true if all the pixels of the specified image are opaque.
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if _, _, _, a := img.At(x, y).RGBA(); a != 0xffff {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,176 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package tiff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	xtiff "golang.org/x/image/tiff"
+
+	"github.com/showntop/unipdf/internal/ccittfax"
+)
+
+// readIFD returns the first values of the fields of the image file directory
+// located at the specified offset, and the offset of the next directory.
+func readIFD(t *testing.T, data []byte, offset uint32) (map[uint16]uint32, uint32) {
+	le := binary.LittleEndian
+	require.Zero(t, offset%2)
+	n := uint32(le.Uint16(data[offset:]))
+	fields := map[uint16]uint32{}
+	for i := uint32(0); i < n; i++ {
+		entry := data[offset+2+12*i:]
+		switch le.Uint16(entry[2:]) {
+		case typeShort:
+			fields[le.Uint16(entry)] = uint32(le.Uint16(entry[8:]))
+		default:
+			fields[le.Uint16(entry)] = le.Uint32(entry[8:])
+		}
+	}
+	return fields, le.Uint32(data[offset+2+12*n:])
+}
+
+func testImage(width, height int, opaque bool) *image.NRGBA {
+	r := rand.New(rand.NewSource(1))
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			c := color.NRGBA{uint8(x), uint8(y), uint8(r.Intn(4) * 64), 255}
+			if !opaque {
+				c.A = uint8(x + y)
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestEncodeColor(t *testing.T) {
+	for _, compression := range []Compression{CompressionNone, CompressionLZW, CompressionDeflate} {
+		for _, opaque := range []bool{true, false} {
+			img := testImage(300, 200, opaque)
+
+			var buf bytes.Buffer
+			err := Encode(&buf, img, &Options{Compression: compression, XResolution: 150, YResolution: 150})
+			require.NoError(t, err)
+
+			decoded, err := xtiff.Decode(bytes.NewReader(buf.Bytes()))
+			require.NoError(t, err)
+			require.Equal(t, img.Bounds(), decoded.Bounds())
+			for y := 0; y < 200; y++ {
+				for x := 0; x < 300; x++ {
+					want := img.NRGBAAt(x, y)
+					got := color.NRGBAModel.Convert(decoded.At(x, y)).(color.NRGBA)
+					if want.A == 0 {
+						require.Zero(t, got.A)
+						continue
+					}
+					require.Equal(t, want, got, "compression %d at %d,%d", compression, x, y)
+				}
+			}
+		}
+	}
+}
+
+func TestEncodeMultiPage(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	require.NoError(t, w.WritePage(testImage(31, 20, true), nil))
+	require.NoError(t, w.WritePage(testImage(17, 9, false), &Options{Compression: CompressionDeflate}))
+	require.NoError(t, w.WritePage(testImage(64, 48, true), &Options{Compression: CompressionCCITTGroup4}))
+	require.NoError(t, w.Close())
+	require.Error(t, w.WritePage(testImage(1, 1, true), nil))
+
+	data := buf.Bytes()
+	offset := binary.LittleEndian.Uint32(data[4:])
+	var widths []uint32
+	for offset != 0 {
+		fields, next := readIFD(t, data, offset)
+		widths = append(widths, fields[tagImageWidth])
+
+		// Decode the page, by linking the header to its directory.
+		if fields[tagCompression] != 4 {
+			page := append([]byte{}, data...)
+			binary.LittleEndian.PutUint32(page[4:], offset)
+			img, err := xtiff.Decode(bytes.NewReader(page))
+			require.NoError(t, err)
+			require.Equal(t, int(fields[tagImageWidth]), img.Bounds().Dx())
+		}
+		offset = next
+	}
+	require.Equal(t, []uint32{31, 17, 64}, widths)
+}
+
+// readStrip returns the fields of the first image file directory of the
+// specified TIFF data and the data of its single strip.
+func readStrip(t *testing.T, data []byte) (map[uint16]uint32, []byte) {
+	fields, next := readIFD(t, data, binary.LittleEndian.Uint32(data[4:]))
+	require.Zero(t, next)
+	start := fields[tagStripOffsets]
+	return fields, data[start : start+fields[tagStripByteCounts]]
+}
+
+// TestEncodeBilevel tests the CCITT encoding of bilevel pages. The expected
+// strips are taken from the files in testdata, which were produced by libtiff
+// (TIFFWriteScanline) for the same image, using the same compression and
+// T4Options/T6Options.
+func TestEncodeBilevel(t *testing.T) {
+	const width, height = 97, 61
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if (x/7+y/5)%3 == 0 || x == y {
+				img.SetGray(x, y, color.Gray{Y: 20})
+			} else {
+				img.SetGray(x, y, color.Gray{Y: 230})
+			}
+		}
+	}
+
+	testcases := []struct {
+		compression Compression
+		fixture     string
+	}{
+		{CompressionCCITTGroup3, "bilevel_g3.tif"},
+		{CompressionCCITTGroup4, "bilevel_g4.tif"},
+	}
+	for _, tcase := range testcases {
+		var buf bytes.Buffer
+		require.NoError(t, Encode(&buf, img, &Options{Compression: tcase.compression}))
+		fields, strip := readStrip(t, buf.Bytes())
+		require.Equal(t, uint32(1), fields[tagBitsPerSample])
+		require.Equal(t, uint32(0), fields[tagPhotometricInterpretation])
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", tcase.fixture))
+		require.NoError(t, err)
+		expFields, expStrip := readStrip(t, expected)
+		for _, tag := range []uint16{tagCompression, tagT4Options, tagT6Options} {
+			require.Equal(t, expFields[tag], fields[tag], "%s tag %d", tcase.fixture, tag)
+		}
+		require.Equal(t, expStrip, strip, tcase.fixture)
+
+		decoder := &ccittfax.Encoder{Columns: width, Rows: height, EndOfLine: true}
+		if tcase.compression == CompressionCCITTGroup4 {
+			decoder = &ccittfax.Encoder{K: -1, Columns: width, Rows: height, EndOfBlock: true}
+		}
+		pixels, err := decoder.Decode(strip)
+		require.NoError(t, err)
+		require.True(t, len(pixels) >= height)
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				white := img.GrayAt(x, y).Y >= 128
+				require.Equal(t, white, pixels[y][x] == 1, "%s at %d,%d", tcase.fixture, x, y)
+			}
+		}
+	}
+}
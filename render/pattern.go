@@ -0,0 +1,230 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/internal/transform"
+	"github.com/showntop/unipdf/model"
+	"github.com/showntop/unipdf/render/internal/context"
+	"github.com/showntop/unipdf/render/internal/context/imagerender"
+)
+
+// maxTileSize represents the maximum width and height, in pixels, of the
+// rendered cells of tiling patterns.
+const maxTileSize = 2048
+
+// maxTileOverlap represents the maximum number of neighbouring cells rendered
+// in each direction, for tiling patterns whose cells overlap.
+const maxTileOverlap = 4
+
+// patternKey identifies the rendering patterns created for the pattern colors
+// of a content stream.
+type patternKey struct {
+	name core.PdfObjectName
+
+	// tint contains the RGB components of the color used for painting
+	// uncolored tiling patterns.
+	tint [3]float64
+}
+
+// newPattern returns a rendering pattern for the pattern with the specified
+// name. The color `tint` is used for painting uncolored tiling patterns.
+// The matrix `m` maps the default coordinate space of the content stream
+// using the pattern to device space.
+func (r renderer) newPattern(ctx context.Context, name core.PdfObjectName, tint *model.PdfColorDeviceRGB,
+	resources *model.PdfPageResources, m transform.Matrix) (context.Pattern, error) {
+	pattern, ok := resources.GetPatternByName(name)
+	if !ok {
+		return nil, fmt.Errorf("pattern %s not found", name)
+	}
+
+	switch {
+	case pattern.IsTiling():
+		tilingPattern := pattern.GetAsTilingPattern()
+		pm, err := patternMatrix(tilingPattern.Matrix)
+		if err != nil {
+			return nil, err
+		}
+		if tilingPattern.IsColored() {
+			tint = nil
+		}
+
+		p, err := r.newTilingPattern(tilingPattern, m.Mult(pm), tint)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	case pattern.IsShading():
+		shadingPattern := pattern.GetAsShadingPattern()
+		if shadingPattern.Shading == nil {
+			return nil, errors.New("missing pattern shading")
+		}
+		pm, err := patternMatrix(shadingPattern.Matrix)
+		if err != nil {
+			return nil, err
+		}
+
+		p, err := newShadingPattern(shadingPattern.Shading, m.Mult(pm), ctx.Width(), ctx.Height(), true)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	}
+
+	return nil, errors.New("unsupported pattern type")
+}
+
+// patternMatrix returns the pattern matrix specified by the Matrix entry of
+// a pattern dictionary. Defaults to the identity matrix.
+func patternMatrix(arr *core.PdfObjectArray) (transform.Matrix, error) {
+	if arr == nil {
+		return transform.IdentityMatrix(), nil
+	}
+
+	mf, err := arr.GetAsFloat64Slice()
+	if err != nil {
+		return transform.Matrix{}, err
+	}
+	if len(mf) != 6 {
+		return transform.Matrix{}, errRange
+	}
+	return transform.NewMatrix(mf[0], mf[1], mf[2], mf[3], mf[4], mf[5]), nil
+}
+
+// tilingPattern is a rendering pattern which paints the replicated cells of
+// a tiling pattern (section 8.7.3 "Tiling Patterns" p. 175 PDF32000_2008).
+type tilingPattern struct {
+	// inverse maps device space to pattern space.
+	inverse transform.Matrix
+
+	// x0, y0 represent the origin of the pattern cell, in pattern space.
+	x0, y0 float64
+
+	// xStep, yStep represent the spacing between the pattern cells.
+	xStep, yStep float64
+
+	// sx, sy represent the number of tile pixels per pattern space unit.
+	sx, sy float64
+
+	// tile contains the rendered pattern cell, covering the area between
+	// two adjacent cells.
+	tile *image.RGBA
+}
+
+// newTilingPattern renders the cell of the specified tiling pattern and returns
+// a pattern which replicates it. The matrix `m` maps pattern space to device
+// space. If `tint` is not nil, the cell is painted using the specified color.
+func (r renderer) newTilingPattern(pattern *model.PdfTilingPattern, m transform.Matrix,
+	tint *model.PdfColorDeviceRGB) (*tilingPattern, error) {
+	if pattern.BBox == nil || pattern.XStep == nil || pattern.YStep == nil {
+		return nil, model.ErrRequiredAttributeMissing
+	}
+	bbox := normalizeBox(pattern.BBox)
+	xStep := math.Abs(float64(*pattern.XStep))
+	yStep := math.Abs(float64(*pattern.YStep))
+	if xStep == 0 || yStep == 0 {
+		return nil, errors.New("invalid tiling pattern step")
+	}
+
+	inverse, ok := m.Inverse()
+	if !ok {
+		return nil, errors.New("non-invertible pattern matrix")
+	}
+
+	content, err := pattern.GetContentStream()
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate tile dimensions, based on the scaling factors of the pattern
+	// matrix, so that the cell is rendered at device resolution.
+	tileSize := func(step, scale float64) int {
+		return int(math.Max(1, math.Min(math.Ceil(step*scale), maxTileSize)))
+	}
+	width := tileSize(xStep, m.ScalingFactorX())
+	height := tileSize(yStep, m.ScalingFactorY())
+
+	p := &tilingPattern{
+		inverse: inverse,
+		x0:      bbox.Llx,
+		y0:      bbox.Lly,
+		xStep:   xStep,
+		yStep:   yStep,
+		sx:      float64(width) / xStep,
+		sy:      float64(height) / yStep,
+	}
+
+	// Render pattern cell. If the cells overlap, the parts of the preceding
+	// cells which overlap the tile area are rendered as well.
+	ctx := imagerender.NewContext(width, height)
+	ctx.Translate(0, float64(height))
+	ctx.Scale(p.sx, -p.sy)
+	ctx.Translate(-p.x0, -p.y0)
+
+	nx := int(math.Min(math.Ceil(bbox.Width()/xStep)-1, maxTileOverlap))
+	ny := int(math.Min(math.Ceil(bbox.Height()/yStep)-1, maxTileOverlap))
+	for i := 0; i <= nx; i++ {
+		for j := 0; j <= ny; j++ {
+			ctx.Push()
+			ctx.Translate(-float64(i)*xStep, -float64(j)*yStep)
+			ctx.DrawRectangle(bbox.Llx, bbox.Lly, bbox.Width(), bbox.Height())
+			ctx.Clip()
+
+			ctx.SetLineWidth(1.0)
+			ctx.SetRGBA(0, 0, 0, 1)
+			if err := r.renderContentStream(ctx, string(content), pattern.Resources); err != nil {
+				return nil, err
+			}
+			ctx.Pop()
+		}
+	}
+
+	tile, ok := ctx.Image().(*image.RGBA)
+	if !ok {
+		return nil, errType
+	}
+
+	// Paint uncolored patterns using the specified color, preserving the
+	// shape of the cell.
+	if tint != nil {
+		tr, tg, tb := tint.R(), tint.G(), tint.B()
+		for i := 0; i < len(tile.Pix); i += 4 {
+			a := float64(tile.Pix[i+3])
+			tile.Pix[i+0] = uint8(math.Round(tr * a))
+			tile.Pix[i+1] = uint8(math.Round(tg * a))
+			tile.Pix[i+2] = uint8(math.Round(tb * a))
+		}
+	}
+	p.tile = tile
+
+	return p, nil
+}
+
+// ColorAt returns the color of the pattern at the specified pixel.
+func (p *tilingPattern) ColorAt(x, y int) color.Color {
+	u, v := p.inverse.Transform(float64(x)+0.5, float64(y)+0.5)
+
+	u = math.Mod(u-p.x0, p.xStep)
+	if u < 0 {
+		u += p.xStep
+	}
+	v = math.Mod(v-p.y0, p.yStep)
+	if v < 0 {
+		v += p.yStep
+	}
+
+	bounds := p.tile.Bounds()
+	tx := int(math.Min(u*p.sx, float64(bounds.Dx()-1)))
+	ty := bounds.Dy() - 1 - int(math.Min(v*p.sy, float64(bounds.Dy()-1)))
+	return p.tile.RGBAAt(tx, ty)
+}
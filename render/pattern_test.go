@@ -0,0 +1,61 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+)
+
+func TestColoredTilingPattern(t *testing.T) {
+	// Red 10x10 squares, repeated every 20 units, on a transparent background.
+	resources := model.NewPdfPageResources()
+	require.NoError(t, resources.SetPatternByName("P0", makeTestStream(t, `<<
+		/PatternType 1 /PaintType 1 /TilingType 1 /BBox [0 0 20 20] /XStep 20 /YStep 20
+		/Resources <<>>
+	>>`, []byte("1 0 0 rg 0 0 10 10 re f"))))
+
+	img := renderTestPage(t, "0 0 1 rg 0 0 100 100 re f /Pattern cs /P0 scn 0 0 100 100 re f", resources)
+	requirePixel(t, img, 5, 5, testRed, 0)
+	requirePixel(t, img, 25, 65, testRed, 0)
+	requirePixel(t, img, 15, 5, testBlue, 0)
+	requirePixel(t, img, 15, 15, testBlue, 0)
+
+	// The cells are positioned by the pattern matrix.
+	require.NoError(t, resources.SetPatternByName("P1", makeTestStream(t, `<<
+		/PatternType 1 /PaintType 1 /TilingType 1 /BBox [0 0 20 20] /XStep 20 /YStep 20
+		/Resources <<>>
+		/Matrix [1 0 0 1 10 0]
+	>>`, []byte("1 0 0 rg 0 0 10 10 re f"))))
+	img = renderTestPage(t, "/Pattern cs /P1 scn 0 0 100 100 re f", resources)
+	requirePixel(t, img, 5, 5, testWhite, 0)
+	requirePixel(t, img, 15, 5, testRed, 0)
+}
+
+func TestUncoloredTilingPattern(t *testing.T) {
+	cs, err := model.NewPdfColorspaceFromPdfObject(core.MakeArray(
+		core.MakeName("Pattern"), core.MakeName("DeviceRGB")))
+	require.NoError(t, err)
+
+	// The colors specified by the cell content stream are ignored.
+	resources := model.NewPdfPageResources()
+	require.NoError(t, resources.SetColorspaceByName("Cs0", cs))
+	require.NoError(t, resources.SetPatternByName("P0", makeTestStream(t, `<<
+		/PatternType 1 /PaintType 2 /TilingType 1 /BBox [0 0 20 20] /XStep 20 /YStep 20
+		/Resources <<>>
+	>>`, []byte("1 0 0 rg 0 0 10 10 re f"))))
+
+	img := renderTestPage(t, "/Cs0 cs 0 1 0 /P0 scn 0 0 50 100 re f 0 0 1 /P0 scn 50 0 50 100 re f",
+		resources)
+	requirePixel(t, img, 5, 5, testGreen, 0)
+	requirePixel(t, img, 15, 15, testWhite, 0)
+	requirePixel(t, img, 65, 5, testBlue, 0)
+	requirePixel(t, img, 75, 5, testWhite, 0)
+}
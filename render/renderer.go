@@ -8,14 +8,13 @@ package render
 import (
 	"errors"
 
-	"github.com/adrg/sysfont"
-
 	"github.com/showntop/unipdf/common"
 	"github.com/showntop/unipdf/contentstream"
 	"github.com/showntop/unipdf/core"
 	"github.com/showntop/unipdf/model"
 	"github.com/showntop/unipdf/render/internal/context"
 
+	"github.com/showntop/unipdf/internal/textencoding"
 	"github.com/showntop/unipdf/internal/transform"
 )
 
@@ -24,7 +23,18 @@ var (
 	errRange = errors.New("range check error")
 )
 
+// maxType3Depth is the maximum nesting level of Type 3 glyph procedures, which
+// can show text using Type 3 fonts as well.
+const maxType3Depth = 4
+
 type renderer struct {
+	// cache holds the resources shared by the rendered pages. If nil, the
+	// resources are loaded by each content stream using them.
+	cache *renderCache
+
+	// type3Depth is the nesting level of the Type 3 glyph procedure being
+	// rendered, or 0 if the content stream is not a glyph procedure.
+	type3Depth int
 }
 
 func (r renderer) renderPage(ctx context.Context, page *model.PdfPage) error {
@@ -33,17 +43,6 @@ func (r renderer) renderPage(ctx context.Context, page *model.PdfPage) error {
 		return err
 	}
 
-	// Change coordinate system.
-	ctx.Translate(0, float64(ctx.Height()))
-	ctx.Scale(1, -1)
-
-	// Create white background.
-	ctx.Push()
-	ctx.SetRGBA(1, 1, 1, 1)
-	ctx.DrawRectangle(0, 0, float64(ctx.Width()), float64(ctx.Height()))
-	ctx.Fill()
-	ctx.Pop()
-
 	// Set defaults.
 	ctx.SetLineWidth(1.0)
 	ctx.SetRGBA(0, 0, 0, 1)
@@ -57,16 +56,163 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 		return err
 	}
 
+	return r.renderOperations(ctx, operations, resources)
+}
+
+func (r renderer) renderOperations(ctx context.Context, operations *contentstream.ContentStreamOperations,
+	resources *model.PdfPageResources) error {
 	textState := ctx.TextState()
-	fontCache := map[string]*context.TextFont{}
-	fontFinder := sysfont.NewFinder(&sysfont.FinderOpts{
-		Extensions: []string{".ttf", ".ttc"},
-	})
+	fontCache := map[core.PdfObject]*context.TextFont{}
+
+	// Pattern matrices map pattern space to the default coordinate space of
+	// the content stream, which is the one in effect when it starts executing.
+	patternMatrix := ctx.Matrix()
+	patterns := map[patternKey]context.Pattern{}
+	getPattern := func(color *model.PdfColorPattern, cs model.PdfColorspace, resources *model.PdfPageResources) context.Pattern {
+		// Uncolored tiling patterns are painted using the color specified
+		// in the underlying colorspace of the pattern colorspace.
+		key := patternKey{name: color.PatternName}
+		var tint *model.PdfColorDeviceRGB
+		if color.Color != nil {
+			rgbColor, err := colorToRGB(cs, color)
+			if err != nil {
+				common.Log.Debug("Error converting color: %v", err)
+				return nil
+			}
+			tint = rgbColor
+			key.tint = [3]float64{rgbColor.R(), rgbColor.G(), rgbColor.B()}
+		}
+
+		if pattern, ok := patterns[key]; ok {
+			return pattern
+		}
+
+		pattern, err := r.newPattern(ctx, color.PatternName, tint, resources, patternMatrix)
+		if err != nil {
+			common.Log.Debug("ERROR: could not load pattern %s: %v", color.PatternName, err)
+		}
+		patterns[key] = pattern
+		return pattern
+	}
+
+	// Glyph procedures of Type 3 fonts paint using the colors in effect when
+	// the glyphs are shown, unless they set their own colors. Color operators
+	// are ignored by the procedures of uncolored glyphs (see 9.6.5 "Type 3
+	// Fonts").
+	inheritFill := r.type3Depth > 0
+	inheritStroke := r.type3Depth > 0
+	uncoloredGlyph := false
+
+	// The graphics state converts Separation and DeviceN colors to their
+	// alternate colorspaces, so the tints of these colors are tracked
+	// separately, for rendering their colorants.
+	var tints colorTints
+	var tintStack []colorTints
+
+	setFillColor := func(gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+		if inheritFill {
+			return nil
+		}
+		if color, ok := gs.ColorNonStroking.(*model.PdfColorPattern); ok {
+			if pattern := getPattern(color, gs.ColorspaceNonStroking, resources); pattern != nil {
+				ctx.SetFillStyle(pattern)
+			} else {
+				// Patterns which cannot be loaded are not painted.
+				ctx.SetFillRGBA(0, 0, 0, 0)
+			}
+			return nil
+		}
+		if isNoneColorant(gs.ColorspaceNonStroking) {
+			ctx.SetFillRGBA(0, 0, 0, 0)
+			return nil
+		}
+
+		rgbColor, err := colorToRGB(gs.ColorspaceNonStroking, gs.ColorNonStroking)
+		if err != nil {
+			return err
+		}
+		ctx.SetFillRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
+		if inkCtx, ok := ctx.(context.InkContext); ok {
+			if inks, ok := colorInks(gs.ColorspaceNonStroking, gs.ColorNonStroking, tints.fill); ok {
+				inkCtx.SetFillInks(inks)
+			}
+		}
+		return nil
+	}
+
+	setStrokeColor := func(gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+		if inheritStroke {
+			return nil
+		}
+		if color, ok := gs.ColorStroking.(*model.PdfColorPattern); ok {
+			if pattern := getPattern(color, gs.ColorspaceStroking, resources); pattern != nil {
+				ctx.SetStrokeStyle(pattern)
+			} else {
+				// Patterns which cannot be loaded are not painted.
+				ctx.SetStrokeRGBA(0, 0, 0, 0)
+			}
+			return nil
+		}
+		if isNoneColorant(gs.ColorspaceStroking) {
+			ctx.SetStrokeRGBA(0, 0, 0, 0)
+			return nil
+		}
+
+		rgbColor, err := colorToRGB(gs.ColorspaceStroking, gs.ColorStroking)
+		if err != nil {
+			return err
+		}
+		ctx.SetStrokeRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
+		if inkCtx, ok := ctx.(context.InkContext); ok {
+			if inks, ok := colorInks(gs.ColorspaceStroking, gs.ColorStroking, tints.stroke); ok {
+				inkCtx.SetStrokeInks(inks)
+			}
+		}
+		return nil
+	}
+
+	// Glyphs are filled and/or stroked, depending on the text rendering mode.
+	setTextColor := func(gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
+		if err := setFillColor(gs, resources); err != nil {
+			return err
+		}
+		return setStrokeColor(gs, resources)
+	}
+
+	// The clipping path operators modify the clipping path when the current
+	// path is ended by the next painting operator, after the path is painted
+	// (see 8.5.4 "Clipping Path Operators").
+	clipPending := false
+	clipRule := context.FillRuleWinding
+	endPath := func() {
+		if !clipPending {
+			ctx.ClearPath()
+			return
+		}
+		clipPending = false
+		ctx.SetFillRule(clipRule)
+		ctx.Clip()
+	}
 
 	processor := contentstream.NewContentStreamProcessor(*operations)
 	processor.AddHandler(contentstream.HandlerConditionEnumAllOperands, "",
 		func(op *contentstream.ContentStreamOperation, gs contentstream.GraphicsState, resources *model.PdfPageResources) error {
 			common.Log.Debug("Processing %s", op.Operand)
+			switch op.Operand {
+			case "rg", "k", "g", "cs", "sc", "scn":
+				if uncoloredGlyph {
+					return nil
+				}
+				inheritFill = false
+				tints.fill, _ = core.GetNumbersAsFloat(op.Params)
+			case "RG", "K", "G", "CS", "SC", "SCN":
+				if uncoloredGlyph {
+					return nil
+				}
+				inheritStroke = false
+				tints.stroke, _ = core.GetNumbersAsFloat(op.Params)
+			}
+
 			switch op.Operand {
 			//
 			// Graphics stage operators
@@ -75,9 +221,14 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 			// Push current graphics state to the stack.
 			case "q":
 				ctx.Push()
+				tintStack = append(tintStack, tints)
 			// Pop graphics state from the stack.
 			case "Q":
 				ctx.Pop()
+				if n := len(tintStack); n > 0 {
+					tints = tintStack[n-1]
+					tintStack = tintStack[:n-1]
+				}
 			// Modify graphics state matrix.
 			case "cm":
 				if len(op.Params) != 6 {
@@ -92,10 +243,6 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 				m := transform.NewMatrix(fv[0], fv[1], fv[2], fv[3], fv[4], fv[5])
 				common.Log.Debug("Graphics state matrix: %+v", m)
 				ctx.SetMatrix(ctx.Matrix().Mult(m))
-
-				// TODO: Take angle into account for line widths (8.4.3.2 Line Width).
-				s := (gs.CTM.ScalingFactorX() + gs.CTM.ScalingFactorY()) / 2.0
-				ctx.SetLineWidth(s * ctx.LineWidth())
 			// Set line width.
 			case "w":
 				if len(op.Params) != 1 {
@@ -107,9 +254,7 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 					return err
 				}
 
-				// TODO: Take angle into account for line widths (8.4.3.2 Line Width).
-				s := (gs.CTM.ScalingFactorX() + gs.CTM.ScalingFactorY()) / 2.0
-				ctx.SetLineWidth(s * fw[0])
+				ctx.SetLineWidth(fw[0])
 			// Set line cap style.
 			case "J":
 				if len(op.Params) != 1 {
@@ -121,20 +266,12 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 					return errType
 				}
 
-				switch val {
-				// Butt cap.
-				case 0:
-					ctx.SetLineCap(context.LineCapButt)
-				// Round cap.
-				case 1:
-					ctx.SetLineCap(context.LineCapRound)
-				// Projecting square cap.
-				case 2:
-					ctx.SetLineCap(context.LineCapSquare)
-				default:
+				lineCap, ok := lineCapStyle(val)
+				if !ok {
 					common.Log.Debug("Invalid line cap style: %d", val)
 					return errRange
 				}
+				ctx.SetLineCap(lineCap)
 			// Set line join style.
 			case "j":
 				if len(op.Params) != 1 {
@@ -146,20 +283,12 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 					return errType
 				}
 
-				switch val {
-				// Miter join.
-				case 0:
-					ctx.SetLineJoin(context.LineJoinBevel)
-				// Round join.
-				case 1:
-					ctx.SetLineJoin(context.LineJoinRound)
-				// Bevel join.
-				case 2:
-					ctx.SetLineJoin(context.LineJoinBevel)
-				default:
+				lineJoin, ok := lineJoinStyle(val)
+				if !ok {
 					common.Log.Debug("Invalid line join style: %d", val)
 					return errRange
 				}
+				ctx.SetLineJoin(lineJoin)
 			// Set miter limit.
 			case "M":
 				if len(op.Params) != 1 {
@@ -171,10 +300,7 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 					return err
 				}
 
-				// TODO: Add miter support in context.
-				// ctx.SetMiterLimit(fw[0])
-				_ = fw
-				common.Log.Debug("Miter limit not supported")
+				ctx.SetMiterLimit(fw[0])
 			// Set line dash pattern.
 			case "d":
 				if len(op.Params) != 2 {
@@ -186,8 +312,8 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 					return errType
 				}
 
-				phase, ok := core.GetIntVal(op.Params[1])
-				if !ok {
+				phase, err := core.GetNumberAsFloat(op.Params[1])
+				if err != nil {
 					return errType
 				}
 
@@ -196,11 +322,7 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 					return err
 				}
 				ctx.SetDash(dashes...)
-
-				// TODO: Add support for dash phase in context.
-				//ctx.SetDashPhase(phase)
-				_ = phase
-				common.Log.Debug("Line dash phase not supported")
+				ctx.SetDashOffset(phase)
 			// Set color rendering intent.
 			case "ri":
 				// TODO: Add rendering intent support.
@@ -236,6 +358,10 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 				}
 				common.Log.Debug("GS dict: %s", extdict.String())
 
+				if err := r.setExtGState(ctx, extdict, resources); err != nil {
+					common.Log.Debug("ERROR: could not apply graphics state %s: %v", *rname, err)
+				}
+
 			//
 			// Path operators
 			//
@@ -318,177 +444,129 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 
 			// Set path stroke.
 			case "S":
-				color, err := gs.ColorspaceStroking.ColorToRGB(gs.ColorStroking)
-				if err != nil {
+				if err := setStrokeColor(gs, resources); err != nil {
 					common.Log.Debug("Error converting color: %v", err)
 					return err
 				}
 
-				rgbColor, ok := color.(*model.PdfColorDeviceRGB)
-				if !ok {
-					common.Log.Debug("Error converting color")
-					return err
-				}
-
-				ctx.SetRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
-				ctx.Stroke()
+				ctx.StrokePreserve()
+				endPath()
 			// Close and stroke.
 			case "s":
-				color, err := gs.ColorspaceStroking.ColorToRGB(gs.ColorStroking)
-				if err != nil {
+				if err := setStrokeColor(gs, resources); err != nil {
 					common.Log.Debug("Error converting color: %v", err)
 					return err
 				}
 
-				rgbColor, ok := color.(*model.PdfColorDeviceRGB)
-				if !ok {
-					common.Log.Debug("Error converting color")
-					return err
-				}
-
 				ctx.ClosePath()
 				ctx.NewSubPath()
-				ctx.SetRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
-				ctx.Stroke()
+				ctx.StrokePreserve()
+				endPath()
 			// Fill path using non-zero winding number rule.
 			case "f", "F":
-				color, err := gs.ColorspaceNonStroking.ColorToRGB(gs.ColorNonStroking)
-				if err != nil {
+				if err := setFillColor(gs, resources); err != nil {
 					common.Log.Debug("Error converting color: %v", err)
 					return err
 				}
 
-				rgbColor, ok := color.(*model.PdfColorDeviceRGB)
-				if !ok {
-					common.Log.Debug("Error converting color")
-					return err
-				}
-
-				ctx.SetRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
 				ctx.SetFillRule(context.FillRuleWinding)
-				ctx.Fill()
+				ctx.FillPreserve()
+				endPath()
 			// Fill path using even-odd rule.
 			case "f*":
-				color, err := gs.ColorspaceNonStroking.ColorToRGB(gs.ColorNonStroking)
-				if err != nil {
+				if err := setFillColor(gs, resources); err != nil {
 					common.Log.Debug("Error converting color: %v", err)
 					return err
 				}
 
-				rgbColor, ok := color.(*model.PdfColorDeviceRGB)
-				if !ok {
-					common.Log.Debug("Error converting color")
-					return err
-				}
-
-				ctx.SetRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
 				ctx.SetFillRule(context.FillRuleEvenOdd)
-				ctx.Fill()
+				ctx.FillPreserve()
+				endPath()
 			// Fill then stroke the path using non-zero winding rule.
 			case "B":
 				// Fill path.
-				color, err := gs.ColorspaceNonStroking.ColorToRGB(gs.ColorNonStroking)
-				if err != nil {
+				if err := setFillColor(gs, resources); err != nil {
 					common.Log.Debug("Error converting color: %v", err)
 					return err
 				}
 
-				rgbColor := color.(*model.PdfColorDeviceRGB)
-				ctx.SetRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
 				ctx.SetFillRule(context.FillRuleWinding)
 				ctx.FillPreserve()
 
 				// Stroke path.
-				color, err = gs.ColorspaceStroking.ColorToRGB(gs.ColorStroking)
-				if err != nil {
+				if err := setStrokeColor(gs, resources); err != nil {
 					common.Log.Debug("Error converting color: %v", err)
 					return err
 				}
 
-				rgbColor = color.(*model.PdfColorDeviceRGB)
-				ctx.SetRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
-				ctx.Stroke()
+				ctx.StrokePreserve()
+				endPath()
 			// Fill then stroke the path using even-odd rule.
 			case "B*":
 				// Fill path.
-				color, err := gs.ColorspaceNonStroking.ColorToRGB(gs.ColorNonStroking)
-				if err != nil {
+				if err := setFillColor(gs, resources); err != nil {
 					common.Log.Debug("Error converting color: %v", err)
 					return err
 				}
 
-				rgbColor := color.(*model.PdfColorDeviceRGB)
-				ctx.SetRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
 				ctx.SetFillRule(context.FillRuleEvenOdd)
 				ctx.FillPreserve()
 
 				// Stroke path.
-				color, err = gs.ColorspaceStroking.ColorToRGB(gs.ColorStroking)
-				if err != nil {
+				if err := setStrokeColor(gs, resources); err != nil {
 					common.Log.Debug("Error converting color: %v", err)
 					return err
 				}
 
-				rgbColor = color.(*model.PdfColorDeviceRGB)
-				ctx.SetRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
-				ctx.Stroke()
+				ctx.StrokePreserve()
+				endPath()
 			// Close, fill and stroke the path using non-zero winding rule.
 			case "b":
 				// Fill path.
-				color, err := gs.ColorspaceNonStroking.ColorToRGB(gs.ColorNonStroking)
-				if err != nil {
+				if err := setFillColor(gs, resources); err != nil {
 					common.Log.Debug("Error converting color: %v", err)
 					return err
 				}
 
-				rgbColor := color.(*model.PdfColorDeviceRGB)
-				ctx.SetRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
 				ctx.ClosePath()
 				ctx.NewSubPath()
 				ctx.SetFillRule(context.FillRuleWinding)
 				ctx.FillPreserve()
 
 				// Stroke path.
-				color, err = gs.ColorspaceStroking.ColorToRGB(gs.ColorStroking)
-				if err != nil {
+				if err := setStrokeColor(gs, resources); err != nil {
 					common.Log.Debug("Error converting color: %v", err)
 					return err
 				}
 
-				rgbColor = color.(*model.PdfColorDeviceRGB)
-				ctx.SetRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
-				ctx.Stroke()
+				ctx.StrokePreserve()
+				endPath()
 			// Close, fill and stroke the path using even-odd rule.
 			case "b*":
 				// Close current subpath.
 				ctx.ClosePath()
 
 				// Fill path.
-				color, err := gs.ColorspaceNonStroking.ColorToRGB(gs.ColorNonStroking)
-				if err != nil {
+				if err := setFillColor(gs, resources); err != nil {
 					common.Log.Debug("Error converting color: %v", err)
 					return err
 				}
 
-				rgbColor := color.(*model.PdfColorDeviceRGB)
-				ctx.SetRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
 				ctx.NewSubPath()
 				ctx.SetFillRule(context.FillRuleEvenOdd)
 				ctx.FillPreserve()
 
 				// Stroke path.
-				color, err = gs.ColorspaceStroking.ColorToRGB(gs.ColorStroking)
-				if err != nil {
+				if err := setStrokeColor(gs, resources); err != nil {
 					common.Log.Debug("Error converting color: %v", err)
 					return err
 				}
 
-				rgbColor = color.(*model.PdfColorDeviceRGB)
-				ctx.SetRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
-				ctx.Stroke()
+				ctx.StrokePreserve()
+				endPath()
 			// End the current path without filling or stroking.
 			case "n":
-				ctx.ClearPath()
+				endPath()
 
 			//
 			// Path clipping operators
@@ -496,135 +574,65 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 
 			// Modify current clipping path using non-zero winding rule.
 			case "W":
-				// TODO: fix clipping.
-				//ctx.StrokePreserve()
-				//ctx.Clip()
-				ctx.SetFillRule(context.FillRuleWinding)
-				ctx.ClipPreserve()
+				clipRule = context.FillRuleWinding
+				clipPending = true
 			// Modify current clipping path using even-odd rule.
 			case "W*":
-				// TODO: fix clipping.
-				//ctx.StrokePreserve()
-				//ctx.Clip()
-				ctx.SetFillRule(context.FillRuleEvenOdd)
-				ctx.ClipPreserve()
+				clipRule = context.FillRuleEvenOdd
+				clipPending = true
 
 			//
 			// Color operators
 			//
 
-			// Set RGB non-stroking color.
-			case "rg":
-				rgbColor, ok := gs.ColorNonStroking.(*model.PdfColorDeviceRGB)
-				if !ok {
-					common.Log.Debug("Error converting color: %v", gs.ColorNonStroking)
-					return nil
-				}
-				ctx.SetFillRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
-			// Set RGB stroking color.
-			case "RG":
-				rgbColor, ok := gs.ColorStroking.(*model.PdfColorDeviceRGB)
-				if !ok {
-					common.Log.Debug("Error converting color: %v", gs.ColorStroking)
-					return nil
-				}
-				ctx.SetStrokeRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
-			// Set CMYK non-stroking color.
-			case "k":
-				cmykColor, ok := gs.ColorNonStroking.(*model.PdfColorDeviceCMYK)
-				if !ok {
-					common.Log.Debug("Error converting color: %v", gs.ColorNonStroking)
-					return nil
-				}
-				color, err := gs.ColorspaceNonStroking.ColorToRGB(cmykColor)
-				if err != nil {
-					common.Log.Debug("Error converting color: %v", gs.ColorNonStroking)
-					return nil
-				}
-				rgbColor, ok := color.(*model.PdfColorDeviceRGB)
-				if !ok {
-					common.Log.Debug("Error converting color: %v", color)
-					return nil
-				}
-				ctx.SetFillRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
-			// Set CMYK stroking color.
-			case "K":
-				cmykColor, ok := gs.ColorStroking.(*model.PdfColorDeviceCMYK)
-				if !ok {
-					common.Log.Debug("Error converting color: %v", gs.ColorStroking)
-					return nil
-				}
-				color, err := gs.ColorspaceStroking.ColorToRGB(cmykColor)
-				if err != nil {
-					common.Log.Debug("Error converting color: %v", gs.ColorStroking)
-					return nil
-				}
-				rgbColor, ok := color.(*model.PdfColorDeviceRGB)
-				if !ok {
-					common.Log.Debug("Error converting color: %v", color)
-					return nil
-				}
-				ctx.SetStrokeRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
-			// Set Grayscale non-stroking color.
-			case "g":
-				grayColor, ok := gs.ColorNonStroking.(*model.PdfColorDeviceGray)
-				if !ok {
+			// Set non-stroking color.
+			case "rg", "k", "g", "cs", "sc", "scn":
+				if err := setFillColor(gs, resources); err != nil {
 					common.Log.Debug("Error converting color: %v", gs.ColorNonStroking)
 					return nil
 				}
-				color, err := gs.ColorspaceNonStroking.ColorToRGB(grayColor)
-				if err != nil {
-					common.Log.Debug("Error converting color: %v", gs.ColorNonStroking)
-					return nil
-				}
-				rgbColor, ok := color.(*model.PdfColorDeviceRGB)
-				if !ok {
-					common.Log.Debug("Error converting color: %v", color)
-					return nil
-				}
-				ctx.SetFillRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
-			// Set Grayscale stroking color.
-			case "G":
-				grayColor, ok := gs.ColorStroking.(*model.PdfColorDeviceGray)
-				if !ok {
+			// Set stroking color.
+			case "RG", "K", "G", "CS", "SC", "SCN":
+				if err := setStrokeColor(gs, resources); err != nil {
 					common.Log.Debug("Error converting color: %v", gs.ColorStroking)
 					return nil
 				}
-				color, err := gs.ColorspaceStroking.ColorToRGB(grayColor)
-				if err != nil {
-					common.Log.Debug("Error converting color: %v", gs.ColorStroking)
-					return nil
+
+			//
+			// Shading operators
+			//
+
+			// Paint the shape and color shading described by a shading dictionary.
+			case "sh":
+				if len(op.Params) != 1 {
+					return errRange
 				}
-				rgbColor, ok := color.(*model.PdfColorDeviceRGB)
+
+				name, ok := core.GetName(op.Params[0])
 				if !ok {
-					common.Log.Debug("Error converting color: %v", color)
-					return nil
-				}
-				ctx.SetStrokeRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
-			case "cs", "sc", "scn":
-				color, err := gs.ColorspaceNonStroking.ColorToRGB(gs.ColorNonStroking)
-				if err != nil {
-					common.Log.Debug("Error converting color: %v", gs.ColorNonStroking)
-					return nil
+					return errType
 				}
-				rgbColor, ok := color.(*model.PdfColorDeviceRGB)
+
+				shading, ok := resources.GetShadingByName(*name)
 				if !ok {
-					common.Log.Debug("Error converting color: %v", color)
+					common.Log.Debug("ERROR: shading %s not found", name.String())
 					return nil
 				}
-				ctx.SetFillRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
-			case "CS", "SC", "SCN":
-				color, err := gs.ColorspaceStroking.ColorToRGB(gs.ColorStroking)
+
+				pattern, err := newShadingPattern(shading, ctx.Matrix(), ctx.Width(), ctx.Height(), false)
 				if err != nil {
-					common.Log.Debug("Error converting color: %v", gs.ColorStroking)
+					common.Log.Debug("ERROR: could not load shading %s: %v", name.String(), err)
 					return nil
 				}
-				rgbColor, ok := color.(*model.PdfColorDeviceRGB)
-				if !ok {
-					common.Log.Debug("Error converting color: %v", color)
-					return nil
-				}
-				ctx.SetStrokeRGBA(rgbColor.R(), rgbColor.G(), rgbColor.B(), 1)
+
+				// Paint the shading over the current clipping region.
+				ctx.Push()
+				ctx.SetMatrix(transform.IdentityMatrix())
+				ctx.SetFillStyle(pattern)
+				ctx.SetFillRule(context.FillRuleWinding)
+				ctx.DrawRectangle(0, 0, float64(ctx.Width()), float64(ctx.Height()))
+				ctx.Fill()
+				ctx.Pop()
 
 			//
 			// Image operators
@@ -641,28 +649,23 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 					return errType
 				}
 
-				_, xtype := resources.GetXObjectByName(*name)
+				stream, xtype := resources.GetXObjectByName(*name)
 				switch xtype {
 				case model.XObjectTypeImage:
 					common.Log.Debug("XObject image: %s", name.String())
 
-					ximg, err := resources.GetXObjectImageByName(*name)
-					if err != nil {
+					// The images painting colorants are drawn separately by
+					// the contexts rendering colorants.
+					if drawn, err := drawInkXObjectImage(ctx, stream); err != nil || drawn {
 						return err
 					}
 
-					img, err := ximg.ToImage()
-					if err != nil {
-						return err
-					}
-
-					goImg, err := img.ToGoImage()
+					goImg, err := r.loadImage(stream)
 					if err != nil {
 						return err
 					}
 					bounds := goImg.Bounds()
 
-					// TODO: Handle soft masks.
 					ctx.Push()
 					ctx.Scale(1.0/float64(bounds.Dx()), -1.0/float64(bounds.Dy()))
 					ctx.DrawImageAnchored(goImg, 0, 0, 0, 1)
@@ -676,64 +679,9 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 						return err
 					}
 
-					formContent, err := xform.GetContentStream()
-					if err != nil {
-						return err
-					}
-
-					formResources := xform.Resources
-					if formResources == nil {
-						formResources = resources
-					}
-
-					ctx.Push()
-					if xform.Matrix != nil {
-						array, ok := core.GetArray(xform.Matrix)
-						if !ok {
-							return errType
-						}
-
-						mf, err := core.GetNumbersAsFloat(array.Elements())
-						if err != nil {
-							return err
-						}
-						if len(mf) != 6 {
-							return errRange
-						}
-
-						m := transform.NewMatrix(mf[0], mf[1], mf[2], mf[3], mf[4], mf[5])
-						ctx.SetMatrix(ctx.Matrix().Mult(m))
-					}
-
-					if xform.BBox != nil {
-						array, ok := core.GetArray(xform.BBox)
-						if !ok {
-							return errType
-						}
-
-						bf, err := core.GetNumbersAsFloat(array.Elements())
-						if err != nil {
-							return err
-						}
-						if len(bf) != 4 {
-							common.Log.Debug("Len = %d", len(bf))
-							return errRange
-						}
-
-						// Set clipping region.
-						ctx.DrawRectangle(bf[0], bf[1], bf[2]-bf[0], bf[3]-bf[1])
-						ctx.SetRGBA(1, 0, 0, 1)
-						ctx.Clip()
-					} else {
-						common.Log.Debug("ERROR: Required BBox missing on XObject Form")
-					}
-
-					// Process the content stream in the Form object.
-					err = r.renderContentStream(ctx, string(formContent), formResources)
-					if err != nil {
+					if err := r.renderForm(ctx, xform, resources); err != nil {
 						return err
 					}
-					ctx.Pop()
 				}
 			// Display inline image.
 			case "BI":
@@ -751,6 +699,10 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 					return err
 				}
 
+				if cs, err := iimg.GetColorSpace(resources); err == nil && drawInkImage(ctx, cs, img, iimg.Decode, nil) {
+					return nil
+				}
+
 				goImg, err := img.ToGoImage()
 				if err != nil {
 					return err
@@ -766,12 +718,22 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 			// Text operators
 			//
 
+			//
+			// Type 3 font operators
+			//
+
+			// Set glyph width of colored glyphs.
+			case "d0":
+			// Set glyph width and bounding box of uncolored glyphs.
+			case "d1":
+				uncoloredGlyph = r.type3Depth > 0
+
 			// Begin text.
 			case "BT":
-				textState.Reset()
+				textState.ProcBT()
 			// End text.
 			case "ET":
-				textState.Reset()
+				textState.ProcET(ctx)
 			// Set text leading.
 			case "TL":
 				if len(op.Params) != 1 {
@@ -832,6 +794,18 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 				}
 
 				textState.Ts = ts
+			// Set text rendering mode.
+			case "Tr":
+				if len(op.Params) != 1 {
+					return errRange
+				}
+
+				tr, err := core.GetNumberAsInt64(op.Params[0])
+				if err != nil {
+					return err
+				}
+
+				textState.Tr = context.TextRenderingMode(tr)
 			// Move to the next line with specified offsets.
 			case "Td":
 				if len(op.Params) != 2 {
@@ -885,6 +859,11 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 				}
 				common.Log.Debug("' string: %s", string(charcodes))
 
+				if err := setTextColor(gs, resources); err != nil {
+					common.Log.Debug("Error converting color: %v", err)
+					return err
+				}
+
 				textState.ProcQ(charcodes, ctx)
 			// Move to the next line and show text string.
 			case `"`:
@@ -907,6 +886,11 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 					return errType
 				}
 
+				if err := setTextColor(gs, resources); err != nil {
+					common.Log.Debug("Error converting color: %v", err)
+					return err
+				}
+
 				textState.ProcDQ(charcodes, aw, ac, ctx)
 			// Show text string.
 			case "Tj":
@@ -920,6 +904,11 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 				}
 				common.Log.Debug("Tj string: `%s`", string(charcodes))
 
+				if err := setTextColor(gs, resources); err != nil {
+					common.Log.Debug("Error converting color: %v", err)
+					return err
+				}
+
 				textState.ProcTj(charcodes, ctx)
 			// Show array of text strings.
 			case "TJ":
@@ -934,6 +923,11 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 				}
 				common.Log.Debug("TJ array: %+v", array)
 
+				if err := setTextColor(gs, resources); err != nil {
+					common.Log.Debug("Error converting color: %v", err)
+					return err
+				}
+
 				for _, obj := range array.Elements() {
 					switch t := obj.(type) {
 					case *core.PdfObjectString:
@@ -943,7 +937,9 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 					case *core.PdfObjectFloat, *core.PdfObjectInteger:
 						val, err := core.GetNumberAsFloat(t)
 						if err == nil {
-							textState.Translate(-val*0.001*textState.Tf.Size, 0)
+							if textState.Tf != nil {
+								textState.Translate(-val*0.001*textState.Tf.Size*textState.Th/100, 0)
+							}
 						}
 					}
 				}
@@ -978,74 +974,18 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 				}
 				common.Log.Debug("Font: %T", fObj)
 
-				fontDict, ok := core.GetDict(fObj)
+				textFont, ok := fontCache[fObj]
 				if !ok {
-					common.Log.Debug("ERROR: could not get font dict")
-					return errType
-				}
-
-				pdfFont, err := model.NewPdfFontFromPdfObject(fontDict)
-				if err != nil {
-					common.Log.Debug("ERROR: could not load font from object")
-					return err
-				}
-
-				baseFont := pdfFont.BaseFont()
-				if baseFont == "" {
-					baseFont = fontName.String()
-				}
-
-				textFont, ok := fontCache[baseFont]
-				if !ok {
-					textFont, err = context.NewTextFont(pdfFont, fontSize)
+					textFont, err = r.loadTextFont(ctx, fObj, fontSize, resources)
 					if err != nil {
-						common.Log.Debug("ERROR: %v", err)
-					}
-				}
-
-				if textFont == nil {
-					// Treat cases such as: OPEIOA+ArialMT
-					if len(baseFont) > 7 && baseFont[6] == '+' {
-						baseFont = baseFont[7:]
-					}
-
-					substitutes := []string{baseFont, "Times New Roman", "Arial", "DejaVu Sans"}
-					for _, name := range substitutes {
-						common.Log.Debug("DEBUG: searching system font `%s`", name)
-
-						// Check if font is cached.
-						if textFont, ok = fontCache[name]; ok {
-							break
-						}
-
-						// Find font or suitable alternative.
-						fontInfo := fontFinder.Match(name)
-						if fontInfo == nil {
-							common.Log.Debug("could not find font file %s", name)
-							continue
-						}
-
-						// Load matched font.
-						textFont, err = context.NewTextFontFromPath(fontInfo.Filename, fontSize)
-						if err != nil {
-							common.Log.Debug("could not load font file %s", fontInfo.Filename)
-							continue
-						}
-
-						// Update font cache.
-						common.Log.Debug("Substituting font %s with %s (%s)", baseFont, fontInfo.Name, fontInfo.Filename)
-						fontCache[name] = textFont
-						break
+						common.Log.Debug("ERROR: could not load font %s: %v", fontName.String(), err)
+						return err
 					}
-				}
-
-				if textFont == nil {
-					common.Log.Debug("ERROR: could not find any suitable font")
-					return errors.New("could not find any suitable font")
+					fontCache[fObj] = textFont
 				}
 
 				// Set font.
-				textState.ProcTf(textFont.WithSize(fontSize, pdfFont))
+				textState.ProcTf(textFont.WithSize(fontSize))
 
 			//
 			// Marked content operators
@@ -1062,10 +1002,92 @@ func (r renderer) renderContentStream(ctx context.Context, contents string, reso
 			return nil
 		})
 
-	err = processor.Process(resources)
+	return processor.Process(resources)
+}
+
+// lineCapStyle returns the line cap style specified by the numeric value of a
+// line cap parameter (see 8.4.3.3 "Line Cap Style").
+func lineCapStyle(val int) (context.LineCap, bool) {
+	switch val {
+	// Butt cap.
+	case 0:
+		return context.LineCapButt, true
+	// Round cap.
+	case 1:
+		return context.LineCapRound, true
+	// Projecting square cap.
+	case 2:
+		return context.LineCapSquare, true
+	}
+	return 0, false
+}
+
+// lineJoinStyle returns the line join style specified by the numeric value of
+// a line join parameter (see 8.4.3.4 "Line Join Style").
+func lineJoinStyle(val int) (context.LineJoin, bool) {
+	switch val {
+	// Miter join.
+	case 0:
+		return context.LineJoinMiter, true
+	// Round join.
+	case 1:
+		return context.LineJoinRound, true
+	// Bevel join.
+	case 2:
+		return context.LineJoinBevel, true
+	}
+	return 0, false
+}
+
+// colorToRGB converts the specified color to the DeviceRGB colorspace.
+func colorToRGB(cs model.PdfColorspace, color model.PdfColor) (*model.PdfColorDeviceRGB, error) {
+	rgb, err := cs.ColorToRGB(color)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	rgbColor, ok := rgb.(*model.PdfColorDeviceRGB)
+	if !ok {
+		return nil, errType
+	}
+	return rgbColor, nil
+}
+
+// newType3TextFont returns a text font which paints the glyphs of the specified
+// Type 3 font by rendering their glyph procedures. The glyph procedures use the
+// resources of the content stream showing the glyphs, if the font has none.
+func (r renderer) newType3TextFont(ctx context.Context, font *model.PdfFont, size float64,
+	resources *model.PdfPageResources) (*context.TextFont, error) {
+	if r.type3Depth >= maxType3Depth {
+		return nil, errors.New("too many nested Type 3 glyph procedures")
+	}
+	if fontResources := font.Type3Resources(); fontResources != nil {
+		resources = fontResources
+	}
+	glyphRenderer := r
+	glyphRenderer.type3Depth++
+	glyphContents := map[*core.PdfObjectStream][]byte{}
+
+	drawGlyph := func(code textencoding.CharCode, m transform.Matrix) error {
+		charProc, ok := font.Type3CharProc(code)
+		if !ok {
+			return nil
+		}
+		content, ok := glyphContents[charProc]
+		if !ok {
+			var err error
+			if content, err = core.DecodeStream(charProc); err != nil {
+				return err
+			}
+			glyphContents[charProc] = content
+		}
+
+		ctx.Push()
+		defer ctx.Pop()
+		ctx.SetMatrix(ctx.Matrix().Mult(m))
+		ctx.ClearPath()
+		return glyphRenderer.renderContentStream(ctx, string(content), resources)
 	}
 
-	return nil
+	return context.NewType3TextFont(font, size, drawGlyph)
 }
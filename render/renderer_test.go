@@ -0,0 +1,191 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+)
+
+// newTestPage returns a 100x100 page with content stream `contents` and
+// resources `resources`.
+func newTestPage(t *testing.T, contents string, resources *model.PdfPageResources) *model.PdfPage {
+	if resources == nil {
+		resources = model.NewPdfPageResources()
+	}
+
+	page := model.NewPdfPage()
+	page.MediaBox = &model.PdfRectangle{Urx: 100, Ury: 100}
+	page.Resources = resources
+	require.NoError(t, page.SetContentStreams([]string{contents}, nil))
+	return page
+}
+
+// renderTestPage renders a 100x100 page with content stream `contents` and
+// resources `resources` at 72 DPI, so that each pixel covers a PDF unit.
+func renderTestPage(t *testing.T, contents string, resources *model.PdfPageResources) image.Image {
+	img, err := NewImageDevice().Render(newTestPage(t, contents, resources))
+	require.NoError(t, err)
+	require.Equal(t, image.Rect(0, 0, 100, 100), img.Bounds())
+	return img
+}
+
+// parseTestDict parses the PDF dictionary `text`.
+func parseTestDict(t *testing.T, text string) *core.PdfObjectDictionary {
+	dict, err := core.NewParserFromString(text).ParseDict()
+	require.NoError(t, err)
+	return dict
+}
+
+// makeTestStream returns a stream with dictionary `dict` and data `data`.
+func makeTestStream(t *testing.T, dict string, data []byte) *core.PdfObjectStream {
+	stream, err := core.MakeStream(data, nil)
+	require.NoError(t, err)
+	stream.PdfObjectDictionary.Merge(parseTestDict(t, dict))
+	return stream
+}
+
+// requirePixel checks that the pixel of `img` at the PDF coordinates (x, y)
+// is `expected`, with a tolerance of `delta` for each component.
+func requirePixel(t *testing.T, img image.Image, x, y int, expected color.Color, delta float64) {
+	t.Helper()
+	bounds := img.Bounds()
+	actual := color.NRGBAModel.Convert(img.At(x, bounds.Dy()-1-y)).(color.NRGBA)
+	want := color.NRGBAModel.Convert(expected).(color.NRGBA)
+	require.InDelta(t, want.R, actual.R, delta, "R at (%d, %d): %v", x, y, actual)
+	require.InDelta(t, want.G, actual.G, delta, "G at (%d, %d): %v", x, y, actual)
+	require.InDelta(t, want.B, actual.B, delta, "B at (%d, %d): %v", x, y, actual)
+	require.InDelta(t, want.A, actual.A, delta, "A at (%d, %d): %v", x, y, actual)
+}
+
+var (
+	testWhite = color.RGBA{255, 255, 255, 255}
+	testBlack = color.RGBA{0, 0, 0, 255}
+	testRed   = color.RGBA{255, 0, 0, 255}
+	testGreen = color.RGBA{0, 255, 0, 255}
+	testBlue  = color.RGBA{0, 0, 255, 255}
+)
+
+func TestType3Font(t *testing.T) {
+	// The square glyph is uncoloured (d1) and painted with the fill colour of
+	// the text. The red glyph is coloured (d0) and sets its own colour.
+	font := parseTestDict(t, `<<
+		/Type /Font /Subtype /Type3
+		/FontBBox [0 0 100 100]
+		/FontMatrix [0.01 0 0 0.01 0 0]
+		/Encoding << /Type /Encoding /Differences [65 /square /red] >>
+		/FirstChar 65 /LastChar 66 /Widths [100 100]
+	>>`)
+	charProcs := core.MakeDict()
+	charProcs.Set("square", makeTestStream(t, "<<>>", []byte("100 0 0 0 100 100 d1 0 0 100 100 re f")))
+	charProcs.Set("red", makeTestStream(t, "<<>>", []byte("100 0 d0 1 0 0 rg 0 0 100 100 re f")))
+	font.Set("CharProcs", charProcs)
+
+	resources := model.NewPdfPageResources()
+	require.NoError(t, resources.SetFontByName("F1", font))
+	img := renderTestPage(t, "0 0 1 rg BT /F1 20 Tf 10 10 Td (AB) Tj ET", resources)
+
+	requirePixel(t, img, 20, 20, testBlue, 1)
+	requirePixel(t, img, 40, 20, testRed, 1)
+	requirePixel(t, img, 60, 20, testWhite, 1)
+	requirePixel(t, img, 20, 40, testWhite, 1)
+}
+
+func TestClipping(t *testing.T) {
+	t.Run("Nested", func(t *testing.T) {
+		// Nested clipping paths intersect, and the clipping path is restored
+		// by Q. The path ended by the painting operator following W is
+		// painted before the clipping path is modified.
+		img := renderTestPage(t, "0 0 1 rg q 0 0 50 100 re W f q 0 0 100 50 re W n "+
+			"1 0 0 rg 0 0 100 100 re f Q 0 1 0 rg 0 50 100 50 re f Q", nil)
+		requirePixel(t, img, 25, 25, testRed, 1)
+		requirePixel(t, img, 25, 75, testGreen, 1)
+		requirePixel(t, img, 75, 25, testWhite, 1)
+		requirePixel(t, img, 75, 75, testWhite, 1)
+	})
+
+	t.Run("EvenOdd", func(t *testing.T) {
+		// The inner square is a hole in the clipping path with the even-odd
+		// rule, but not with the non-zero winding number rule.
+		clip := "10 10 80 80 re 30 30 40 40 re"
+		img := renderTestPage(t, "q "+clip+" W* n 1 0 0 rg 0 0 100 100 re f Q", nil)
+		requirePixel(t, img, 20, 20, testRed, 1)
+		requirePixel(t, img, 50, 50, testWhite, 1)
+		requirePixel(t, img, 5, 5, testWhite, 1)
+
+		img = renderTestPage(t, "q "+clip+" W n 1 0 0 rg 0 0 100 100 re f Q", nil)
+		requirePixel(t, img, 20, 20, testRed, 1)
+		requirePixel(t, img, 50, 50, testRed, 1)
+		requirePixel(t, img, 5, 5, testWhite, 1)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		// The glyphs shown with the clipping text rendering modes are added
+		// to the clipping path at the end of the text object.
+		font := model.NewStandard14FontMustCompile(model.HelveticaBoldName)
+		resources := model.NewPdfPageResources()
+		require.NoError(t, resources.SetFontByName("F1", font.ToPdfObject()))
+		img := renderTestPage(t, "q BT /F1 100 Tf 7 Tr 10 10 Td (I) Tj ET "+
+			"1 0 0 rg 0 0 100 100 re f Q 0 0 1 rg 60 0 40 10 re f", resources)
+		requirePixel(t, img, 24, 50, testRed, 1)
+		requirePixel(t, img, 50, 50, testWhite, 1)
+		requirePixel(t, img, 80, 5, testBlue, 1)
+	})
+}
+
+func TestStroke(t *testing.T) {
+	t.Run("MiterLimit", func(t *testing.T) {
+		// The sharp join is mitered with a high miter limit and beveled with
+		// a low one.
+		contents := "10 w 0 j 20 20 m 50 80 l 80 20 l S"
+		img := renderTestPage(t, "10 M "+contents, nil)
+		requirePixel(t, img, 50, 88, testBlack, 1)
+		img = renderTestPage(t, "1.5 M "+contents, nil)
+		requirePixel(t, img, 50, 88, testWhite, 1)
+		requirePixel(t, img, 50, 80, testBlack, 1)
+	})
+
+	t.Run("DashPhase", func(t *testing.T) {
+		// The dash pattern is started at the dash phase.
+		img := renderTestPage(t, "10 w [20 20] 0 d 0 50 m 100 50 l S", nil)
+		requirePixel(t, img, 10, 50, testBlack, 1)
+		requirePixel(t, img, 30, 50, testWhite, 1)
+		img = renderTestPage(t, "10 w [20 20] 10 d 0 50 m 100 50 l S", nil)
+		requirePixel(t, img, 5, 50, testBlack, 1)
+		requirePixel(t, img, 15, 50, testWhite, 1)
+		requirePixel(t, img, 35, 50, testBlack, 1)
+	})
+
+	t.Run("OpenSubpath", func(t *testing.T) {
+		// An open subpath ending at its starting point is capped at both
+		// ends, while a subpath closed by h is joined at its starting point.
+		contents := "10 w 0 j 0 J 20 20 m 80 20 l 80 80 l 20 80 l 20 20 l"
+		img := renderTestPage(t, contents+" S", nil)
+		requirePixel(t, img, 17, 17, testWhite, 1)
+		requirePixel(t, img, 83, 17, testBlack, 1)
+		img = renderTestPage(t, contents+" h S", nil)
+		requirePixel(t, img, 17, 17, testBlack, 1)
+		requirePixel(t, img, 83, 17, testBlack, 1)
+	})
+
+	t.Run("Transformed", func(t *testing.T) {
+		// The pen is transformed by the CTM, so that the width of the
+		// horizontal line is scaled vertically and the width of the vertical
+		// line isn't.
+		img := renderTestPage(t, "1 0 0 4 0 0 cm 5 w 10 10 m 40 10 l S 70 2 m 70 20 l S", nil)
+		requirePixel(t, img, 25, 40, testBlack, 1)
+		requirePixel(t, img, 25, 52, testWhite, 1)
+		requirePixel(t, img, 25, 28, testWhite, 1)
+		requirePixel(t, img, 71, 50, testBlack, 1)
+		requirePixel(t, img, 74, 50, testWhite, 1)
+	})
+}
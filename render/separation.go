@@ -0,0 +1,404 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/showntop/unipdf/common"
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+	"github.com/showntop/unipdf/render/internal/context"
+	"github.com/showntop/unipdf/render/internal/context/inkrender"
+)
+
+// SeparationDevice is used to render the color separations of PDF pages. The
+// process colorants (cyan, magenta, yellow and black) and the spot colorants
+// of Separation and DeviceN colorspaces are rendered onto separate plates,
+// taking into account the overprint parameters of the pages (OP, op and OPM).
+// The composite of the plates simulates the appearance of the printed pages.
+type SeparationDevice struct {
+	renderer
+
+	// DPI represents the resolution, in dots per inch, at which the plates
+	// are rendered. Defaults to 72.
+	DPI float64
+
+	// PageBox specifies the page boundary which is rendered.
+	// Defaults to the crop box of the page.
+	PageBox PageBox
+
+	// IgnoreRotation specifies whether the rotation of the pages (the Rotate
+	// entry of the page dictionaries) should be ignored. By default, pages
+	// are rendered as they are displayed by PDF viewers.
+	IgnoreRotation bool
+
+	// Target specifies whether pages are rendered as they are displayed on
+	// screen or as they are printed, which determines the annotations that
+	// are visible on the rendered pages. Defaults to PrintTarget.
+	Target RenderTarget
+
+	// SkipAnnotations specifies whether the appearances of the annotations
+	// of the pages should not be rendered. By default, they are rendered on
+	// top of the page content.
+	SkipAnnotations bool
+}
+
+// Separations represents the color separations of a rendered page.
+type Separations struct {
+	// Inks contains the names of the rendered colorants. The process
+	// colorants are listed first, followed by the spot colorants used by
+	// the page, in the order in which they were found.
+	Inks []string
+
+	// Plates contains the plates of the colorants, in the order of Inks.
+	// The colorants are painted black at full tint.
+	Plates []*image.Gray
+
+	// Composite simulates the appearance of the printed page, by combining
+	// the plates using the appearances of their colorants.
+	Composite *image.RGBA
+}
+
+// NewSeparationDevice returns a new separation device.
+func NewSeparationDevice() *SeparationDevice {
+	return &SeparationDevice{Target: PrintTarget}
+}
+
+// Render renders the color separations of the specified PDF page.
+func (d *SeparationDevice) Render(page *model.PdfPage) (*Separations, error) {
+	view, err := newPageView(page, d.PageBox, d.IgnoreRotation)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate the dimensions of the rendering area.
+	dpi := d.DPI
+	if dpi <= 0 {
+		dpi = 72
+	}
+	scale := dpi / 72
+	width := int(math.Max(1, math.Round(view.width*scale)))
+	height := int(math.Max(1, math.Round(view.height*scale)))
+	ctx := inkrender.NewContext(width, height, pageSpotInks(page, !d.SkipAnnotations))
+
+	// Render page.
+	view.setMatrix(ctx, float64(width), float64(height))
+	if err := d.renderPageView(ctx, page, !d.SkipAnnotations, d.Target); err != nil {
+		return nil, err
+	}
+
+	inks := ctx.Inks()
+	separations := &Separations{
+		Inks:      make([]string, len(inks)),
+		Plates:    ctx.Plates(),
+		Composite: ctx.Composite(),
+	}
+	for i, ink := range inks {
+		separations.Inks[i] = ink.Name
+	}
+	return separations, nil
+}
+
+// colorTints contains the components of the fill and stroke colors set by
+// the color operators of a content stream.
+type colorTints struct {
+	fill, stroke []float64
+}
+
+// isNoneColorant returns true if `cs` is a Separation colorspace having the
+// colorant None, whose colors are never painted.
+func isNoneColorant(cs model.PdfColorspace) bool {
+	sep, ok := cs.(*model.PdfColorspaceSpecialSeparation)
+	return ok && sep.ColorantName != nil && sep.ColorantName.String() == "None"
+}
+
+// colorInks returns the inks painted by the specified color of colorspace
+// `cs`, whose components are `tints`. Separation and DeviceN colors paint
+// their colorants, while DeviceCMYK colors paint the process colorants.
+// The colors of other colorspaces are not represented by inks.
+func colorInks(cs model.PdfColorspace, color model.PdfColor, tints []float64) (context.Inks, bool) {
+	tint := func(i int) float64 {
+		// The initial tints of Separation and DeviceN colors are 1.
+		if i >= len(tints) {
+			return 1
+		}
+		return math.Max(0, math.Min(tints[i], 1))
+	}
+
+	switch t := cs.(type) {
+	case *model.PdfColorspaceSpecialSeparation:
+		if t.ColorantName == nil {
+			return context.Inks{}, false
+		}
+		return context.Inks{Tints: map[string]float64{t.ColorantName.String(): tint(0)}}, true
+	case *model.PdfColorspaceDeviceN:
+		if t.ColorantNames == nil {
+			return context.Inks{}, false
+		}
+		inks := context.Inks{Tints: map[string]float64{}}
+		for i, obj := range t.ColorantNames.Elements() {
+			name, ok := core.GetNameVal(obj)
+			if !ok || name == "None" {
+				continue
+			}
+			inks.Tints[name] = tint(i)
+		}
+		return inks, true
+	}
+
+	if cmyk, ok := color.(*model.PdfColorDeviceCMYK); ok {
+		return context.Inks{
+			Tints: map[string]float64{
+				context.InkCyan:    cmyk.C(),
+				context.InkMagenta: cmyk.M(),
+				context.InkYellow:  cmyk.Y(),
+				context.InkBlack:   cmyk.K(),
+			},
+			CMYK: true,
+		}, true
+	}
+	return context.Inks{}, false
+}
+
+// imageInks returns the ink image painted by image `img` of colorspace `cs`,
+// whose samples are mapped to tints by the decode array `decode`, if not
+// empty, and whose alpha values are `alpha`, if not nil. Separation and
+// DeviceN images paint their colorants, while DeviceCMYK images paint the
+// process colorants. The images of other colorspaces are not represented by
+// inks.
+func imageInks(cs model.PdfColorspace, img *model.Image, decode []float64,
+	alpha *image.Alpha) (*context.InkImage, bool) {
+	var names []string
+	cmyk := false
+	switch t := cs.(type) {
+	case *model.PdfColorspaceSpecialSeparation:
+		if t.ColorantName == nil {
+			return nil, false
+		}
+		names = []string{t.ColorantName.String()}
+	case *model.PdfColorspaceDeviceN:
+		if t.ColorantNames == nil {
+			return nil, false
+		}
+		for _, obj := range t.ColorantNames.Elements() {
+			name, _ := core.GetNameVal(obj)
+			names = append(names, name)
+		}
+	case *model.PdfColorspaceDeviceCMYK:
+		names = []string{context.InkCyan, context.InkMagenta, context.InkYellow, context.InkBlack}
+		cmyk = true
+	default:
+		return nil, false
+	}
+
+	n := len(names)
+	width, height := int(img.Width), int(img.Height)
+	samples := img.GetSamples()
+	if img.ColorComponents != n || width <= 0 || height <= 0 || len(samples) < width*height*n {
+		return nil, false
+	}
+	maxVal := float64(uint32(1)<<uint(img.BitsPerComponent) - 1)
+
+	bounds := image.Rect(0, 0, width, height)
+	if alpha != nil && alpha.Bounds() != bounds {
+		alpha = nil
+	}
+	inkImg := &context.InkImage{Alpha: alpha, CMYK: cmyk}
+	for j, name := range names {
+		// The components of the colorant None are never painted.
+		if name == "" || name == "None" {
+			continue
+		}
+
+		dMin, dMax := 0.0, 1.0
+		if len(decode) >= 2*n {
+			dMin, dMax = decode[2*j], decode[2*j+1]
+		}
+		tints := image.NewGray(bounds)
+		for i := range tints.Pix {
+			v := dMin + float64(samples[i*n+j])*(dMax-dMin)/maxVal
+			tints.Pix[i] = uint8(math.Round(clampUnit(v) * 255))
+		}
+		inkImg.Inks = append(inkImg.Inks, name)
+		inkImg.Tints = append(inkImg.Tints, tints)
+	}
+	return inkImg, true
+}
+
+// drawInkImage draws image `img` of colorspace `cs`, whose decode array is
+// `decodeObj` and whose alpha values are `alpha`, using the colorants it
+// paints, if `ctx` renders colorants separately. The returned bool is false
+// if the image is not represented by inks, in which case it is not drawn.
+func drawInkImage(ctx context.Context, cs model.PdfColorspace, img *model.Image, decodeObj core.PdfObject,
+	alpha *image.Alpha) bool {
+	inkCtx, ok := ctx.(context.InkContext)
+	if !ok {
+		return false
+	}
+
+	var decode []float64
+	if arr, ok := core.GetArray(decodeObj); ok {
+		var err error
+		if decode, err = arr.ToFloat64Array(); err != nil {
+			common.Log.Debug("ERROR: invalid image decode array: %v", err)
+		}
+	}
+	inkImg, ok := imageInks(cs, img, decode, alpha)
+	if !ok {
+		return false
+	}
+
+	ctx.Push()
+	ctx.Scale(1.0/float64(img.Width), -1.0/float64(img.Height))
+	inkCtx.DrawInkImageAnchored(inkImg, 0, 0, 0, 1)
+	ctx.Pop()
+	return true
+}
+
+// drawInkXObjectImage draws the image XObject `stream` using the colorants
+// it paints, if `ctx` renders colorants separately. The returned bool is
+// false if the image is not represented by inks, in which case it is not
+// drawn.
+func drawInkXObjectImage(ctx context.Context, stream *core.PdfObjectStream) (bool, error) {
+	if _, ok := ctx.(context.InkContext); !ok {
+		return false, nil
+	}
+
+	ximg, err := model.NewXObjectImageFromStream(stream)
+	if err != nil {
+		return false, err
+	}
+	if ximg.ColorSpace == nil {
+		return false, nil
+	}
+	img, err := ximg.ToImage()
+	if err != nil {
+		return false, err
+	}
+	alpha, err := imageSoftMask(ximg, image.Rect(0, 0, int(img.Width), int(img.Height)))
+	if err != nil {
+		common.Log.Debug("ERROR: could not apply image soft mask: %v", err)
+	}
+	return drawInkImage(ctx, ximg.ColorSpace, img, ximg.Decode, alpha), nil
+}
+
+// pageSpotInks returns the spot colorants used by the Separation and DeviceN
+// colorspaces of the resources of `page` and, optionally, of the appearances
+// of its annotations. The appearance of each colorant is the color of its
+// alternate colorspace at full tint.
+func pageSpotInks(page *model.PdfPage, annotations bool) []inkrender.Ink {
+	var inks []inkrender.Ink
+	found := map[string]struct{}{
+		context.InkCyan: {}, context.InkMagenta: {}, context.InkYellow: {}, context.InkBlack: {},
+		context.InkAll: {}, "None": {},
+	}
+	addInk := func(name string, cs model.PdfColorspace, c model.PdfColor) {
+		if _, ok := found[name]; ok {
+			return
+		}
+		found[name] = struct{}{}
+
+		appearance := color.Color(color.Black)
+		if rgb, err := colorToRGB(cs, c); err == nil {
+			appearance = color.NRGBA{
+				R: uint8(math.Round(255 * rgb.R())),
+				G: uint8(math.Round(255 * rgb.G())),
+				B: uint8(math.Round(255 * rgb.B())),
+				A: 255,
+			}
+		} else {
+			common.Log.Debug("ERROR: could not get appearance of colorant %s: %v", name, err)
+		}
+		inks = append(inks, inkrender.Ink{Name: name, Color: appearance})
+	}
+
+	visited := map[core.PdfObject]struct{}{}
+	var walk func(obj core.PdfObject)
+	walk = func(obj core.PdfObject) {
+		obj = core.TraceToDirectObject(obj)
+		if _, ok := visited[obj]; ok || obj == nil {
+			return
+		}
+		visited[obj] = struct{}{}
+
+		switch t := obj.(type) {
+		case *core.PdfObjectDictionary:
+			for _, key := range t.Keys() {
+				walk(t.Get(key))
+			}
+		case *core.PdfObjectStream:
+			for _, key := range t.PdfObjectDictionary.Keys() {
+				walk(t.PdfObjectDictionary.Get(key))
+			}
+		case *core.PdfObjectArray:
+			if family, ok := core.GetNameVal(t.Get(0)); ok && (family == "Separation" || family == "DeviceN") {
+				cs, err := model.NewPdfColorspaceFromPdfObject(t)
+				if err != nil {
+					common.Log.Debug("ERROR: could not load colorspace: %v", err)
+					return
+				}
+				addSpotInks(cs, addInk)
+				return
+			}
+			for _, elem := range t.Elements() {
+				walk(elem)
+			}
+		}
+	}
+
+	for _, obj := range resourceObjects(page.Resources) {
+		walk(obj)
+	}
+	if annots, ok := core.GetArray(page.Annots); ok && annotations {
+		// Only the appearance streams of the annotations are walked, as the
+		// annotation dictionaries refer to the pages of the document.
+		for _, annot := range annots.Elements() {
+			if annotDict, ok := core.GetDict(annot); ok {
+				walk(annotDict.Get("AP"))
+			}
+		}
+	}
+	return inks
+}
+
+// addSpotInks calls `addInk` with the colorants of the specified Separation
+// or DeviceN colorspace, and their colors at full tint.
+func addSpotInks(cs model.PdfColorspace, addInk func(name string, cs model.PdfColorspace, color model.PdfColor)) {
+	switch t := cs.(type) {
+	case *model.PdfColorspaceSpecialSeparation:
+		if t.ColorantName == nil {
+			return
+		}
+		color, err := t.ColorFromFloats([]float64{1})
+		if err != nil {
+			common.Log.Debug("ERROR: could not get color of colorant: %v", err)
+			return
+		}
+		addInk(t.ColorantName.String(), t, color)
+	case *model.PdfColorspaceDeviceN:
+		if t.ColorantNames == nil {
+			return
+		}
+		n := t.ColorantNames.Len()
+		for i, obj := range t.ColorantNames.Elements() {
+			name, ok := core.GetNameVal(obj)
+			if !ok {
+				continue
+			}
+			vals := make([]float64, n)
+			vals[i] = 1
+			color, err := t.ColorFromFloats(vals)
+			if err != nil {
+				common.Log.Debug("ERROR: could not get color of colorant: %v", err)
+				continue
+			}
+			addInk(name, t, color)
+		}
+	}
+}
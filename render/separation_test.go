@@ -0,0 +1,177 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"fmt"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+)
+
+const (
+	// testGoldCS is a Separation colorspace with the spot colorant Gold.
+	testGoldCS = `[/Separation /Gold /DeviceCMYK
+		<< /FunctionType 2 /Domain [0 1] /C0 [0 0 0 0] /C1 [0 0.2 1 0] /N 1 >>]`
+
+	// testAllCS is a Separation colorspace with the colorant All.
+	testAllCS = `[/Separation /All /DeviceGray
+		<< /FunctionType 2 /Domain [0 1] /C0 [1] /C1 [0] /N 1 >>]`
+)
+
+// parseTestColorspace parses the colorspace array `text`.
+func parseTestColorspace(t *testing.T, text string) core.PdfObject {
+	return parseTestDict(t, "<< /CS "+text+" >>").Get("CS")
+}
+
+// newTestSpotsCS returns a DeviceN colorspace with the spot colorants Gold
+// and Silver, and the colorant None.
+func newTestSpotsCS(t *testing.T) core.PdfObject {
+	function := makeTestStream(t, "<< /FunctionType 4 /Domain [0 1 0 1 0 1] /Range [0 1] >>",
+		[]byte("{ add add 3 div 1 exch sub }"))
+	return core.MakeArray(core.MakeName("DeviceN"),
+		core.MakeArray(core.MakeName("Gold"), core.MakeName("None"), core.MakeName("Silver")),
+		core.MakeName("DeviceGray"), function)
+}
+
+// newSeparationResources returns resources with the colorspaces
+// `colorspaces`, named CS0, CS1, etc., and the graphics state parameter
+// dictionaries GSop, overprinting fills in zero overprint mode, and GSopm,
+// overprinting fills in nonzero overprint mode.
+func newSeparationResources(t *testing.T, colorspaces ...core.PdfObject) *model.PdfPageResources {
+	csDict := core.MakeDict()
+	for i, cs := range colorspaces {
+		csDict.Set(core.PdfObjectName(fmt.Sprintf("CS%d", i)), cs)
+	}
+	dict := parseTestDict(t, "<< /ExtGState << /GSop << /op true /OPM 0 >> /GSopm << /op true /OPM 1 >> >> >>")
+	dict.Set("ColorSpace", csDict)
+	resources, err := model.NewPdfPageResourcesFromDict(dict)
+	require.NoError(t, err)
+	return resources
+}
+
+// renderTestSeparations renders the separations of a 100x100 page with
+// content stream `contents` and resources `resources` at 72 DPI.
+func renderTestSeparations(t *testing.T, contents string, resources *model.PdfPageResources) *Separations {
+	separations, err := NewSeparationDevice().Render(newTestPage(t, contents, resources))
+	require.NoError(t, err)
+	return separations
+}
+
+// requireTints checks the tints of the colorants of `separations` at the PDF
+// coordinates (x, y). The tints of the colorants missing from `tints` are
+// checked to be 0.
+func requireTints(t *testing.T, separations *Separations, x, y int, tints map[string]float64) {
+	t.Helper()
+	for i, ink := range separations.Inks {
+		plate := separations.Plates[i]
+		actual := 1 - float64(plate.GrayAt(x, plate.Bounds().Dy()-1-y).Y)/255
+		require.InDelta(t, tints[ink], actual, 0.01, "%s at (%d, %d)", ink, x, y)
+	}
+}
+
+func TestSeparationKnockout(t *testing.T) {
+	// Without overprinting, the colorants which are not painted are erased.
+	separations := renderTestSeparations(t, "1 0 0 0 k 0 0 60 100 re f "+
+		"0 0 0.5 0 k 40 0 60 100 re f", nil)
+	require.Equal(t, []string{"Cyan", "Magenta", "Yellow", "Black"}, separations.Inks)
+	requireTints(t, separations, 20, 50, map[string]float64{"Cyan": 1})
+	requireTints(t, separations, 50, 50, map[string]float64{"Yellow": 0.5})
+	requireTints(t, separations, 80, 50, map[string]float64{"Yellow": 0.5})
+
+	// RGB colors are painted with process colorants approximating them.
+	separations = renderTestSeparations(t, "1 0 0 rg 0 0 100 100 re f", nil)
+	requireTints(t, separations, 50, 50, map[string]float64{"Magenta": 1, "Yellow": 1})
+}
+
+func TestSeparationOverprint(t *testing.T) {
+	resources := newSeparationResources(t, parseTestColorspace(t, testGoldCS))
+	contents := "1 0 0 0 k 0 0 100 100 re f %s 0 0.5 0 0 k 0 0 50 100 re f " +
+		"/CS0 cs 0.5 scn 50 0 50 100 re f"
+
+	// In zero overprint mode, all the components of DeviceCMYK colors are
+	// painted, and spot colors leave the process colorants unchanged.
+	separations := renderTestSeparations(t, fmt.Sprintf(contents, "/GSop gs"), resources)
+	require.Equal(t, []string{"Cyan", "Magenta", "Yellow", "Black", "Gold"}, separations.Inks)
+	requireTints(t, separations, 25, 50, map[string]float64{"Magenta": 0.5})
+	requireTints(t, separations, 75, 50, map[string]float64{"Cyan": 1, "Gold": 0.5})
+
+	// In nonzero overprint mode, the components of DeviceCMYK colors having
+	// a tint of 0 leave their colorants unchanged.
+	separations = renderTestSeparations(t, fmt.Sprintf(contents, "/GSopm gs"), resources)
+	requireTints(t, separations, 25, 50, map[string]float64{"Cyan": 1, "Magenta": 0.5})
+	requireTints(t, separations, 75, 50, map[string]float64{"Cyan": 1, "Gold": 0.5})
+
+	// Without overprinting, spot colors erase the process colorants.
+	separations = renderTestSeparations(t, fmt.Sprintf(contents, ""), resources)
+	requireTints(t, separations, 25, 50, map[string]float64{"Magenta": 0.5})
+	requireTints(t, separations, 75, 50, map[string]float64{"Gold": 0.5})
+}
+
+func TestSeparationSpotColors(t *testing.T) {
+	resources := newSeparationResources(t, parseTestColorspace(t, testGoldCS), newTestSpotsCS(t),
+		parseTestColorspace(t, testAllCS))
+	separations := renderTestSeparations(t, "/CS1 cs 0.25 1 0.75 scn 0 0 50 50 re f "+
+		"/CS2 cs 0.5 scn 50 0 50 50 re f /CS0 cs 0 50 50 50 re f", resources)
+	require.Equal(t, []string{"Cyan", "Magenta", "Yellow", "Black", "Gold", "Silver"}, separations.Inks)
+
+	// The None component of DeviceN colors is not painted, the colorant All
+	// paints all the plates and the initial tint of Separation colors is 1.
+	requireTints(t, separations, 25, 25, map[string]float64{"Gold": 0.25, "Silver": 0.75})
+	requireTints(t, separations, 75, 25, map[string]float64{
+		"Cyan": 0.5, "Magenta": 0.5, "Yellow": 0.5, "Black": 0.5, "Gold": 0.5, "Silver": 0.5,
+	})
+	requireTints(t, separations, 25, 75, map[string]float64{"Gold": 1})
+	requireTints(t, separations, 75, 75, nil)
+
+	// The composite simulates the appearance of the colorants at full tint.
+	requirePixel(t, separations.Composite, 25, 75, color.RGBA{255, 204, 0, 255}, 1)
+}
+
+func TestSeparationImages(t *testing.T) {
+	// addImage adds a 2x1 image XObject named `name`, of colorspace `cs`, to
+	// `resources`.
+	resources := newSeparationResources(t)
+	addImage := func(name string, cs core.PdfObject, dict string, data []byte) {
+		stream := makeTestStream(t, "<< /Type /XObject /Subtype /Image /Width 2 /Height 1 "+
+			"/BitsPerComponent 8 "+dict+" >>", data)
+		stream.Set("ColorSpace", cs)
+		require.NoError(t, resources.SetXObjectByName(core.PdfObjectName(name), stream))
+	}
+	addImage("Im0", parseTestColorspace(t, testGoldCS), "", []byte{255, 128})
+	addImage("Im1", newTestSpotsCS(t), "/Decode [1 0 0 1 0 1]", []byte{0, 0, 255, 255, 255, 0})
+	addImage("Im2", core.MakeName("DeviceCMYK"), "", []byte{0, 255, 0, 0, 64, 0, 0, 0})
+
+	// Spot images paint their colorants and erase the process colorants.
+	contents := "1 0 0 0 k 0 0 100 100 re f %s q 100 0 0 50 0 0 cm /Im%d Do Q"
+	separations := renderTestSeparations(t, fmt.Sprintf(contents, "", 0), resources)
+	require.Equal(t, []string{"Cyan", "Magenta", "Yellow", "Black", "Gold", "Silver"}, separations.Inks)
+	requireTints(t, separations, 25, 25, map[string]float64{"Gold": 1})
+	requireTints(t, separations, 75, 25, map[string]float64{"Gold": 0.5})
+	requireTints(t, separations, 50, 75, map[string]float64{"Cyan": 1})
+
+	// The samples are decoded using the decode array of the image.
+	separations = renderTestSeparations(t, fmt.Sprintf(contents, "", 1), resources)
+	requireTints(t, separations, 25, 25, map[string]float64{"Gold": 1, "Silver": 1})
+	requireTints(t, separations, 75, 25, nil)
+
+	// When overprinting, spot images leave the process colorants unchanged.
+	separations = renderTestSeparations(t, fmt.Sprintf(contents, "/GSop gs", 0), resources)
+	requireTints(t, separations, 25, 25, map[string]float64{"Cyan": 1, "Gold": 1})
+
+	// DeviceCMYK images keep their ink values. In nonzero overprint mode,
+	// their components having a tint of 0 leave their colorants unchanged.
+	separations = renderTestSeparations(t, fmt.Sprintf(contents, "", 2), resources)
+	requireTints(t, separations, 25, 25, map[string]float64{"Magenta": 1})
+	requireTints(t, separations, 75, 25, map[string]float64{"Cyan": 0.25})
+	separations = renderTestSeparations(t, fmt.Sprintf(contents, "/GSopm gs", 2), resources)
+	requireTints(t, separations, 25, 25, map[string]float64{"Cyan": 1, "Magenta": 1})
+	requireTints(t, separations, 75, 25, map[string]float64{"Cyan": 0.25})
+}
@@ -0,0 +1,875 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/internal/transform"
+	"github.com/showntop/unipdf/model"
+)
+
+// shadingLookupSize represents the number of precomputed colors used for
+// evaluating shadings whose colors depend on a single parametric variable.
+const shadingLookupSize = 1024
+
+// shadingPattern is a rendering pattern which paints the colors of a shading
+// (section 8.7.4 "Shading Patterns" p. 182 PDF32000_2008).
+type shadingPattern struct {
+	// inverse maps device space to shading space.
+	inverse transform.Matrix
+
+	// bbox represents the boundaries of the shading, in shading space.
+	bbox *model.PdfRectangle
+
+	// background represents the color used for the areas outside of the
+	// geometry of the shading. If nil, the areas are not painted.
+	background color.Color
+
+	// colorAt returns the color of the shading at the specified point, in
+	// shading space. Used for function-based, axial and radial shadings.
+	colorAt func(x, y float64) (color.Color, bool)
+
+	// raster contains the rasterized shading, in device space. Used for
+	// mesh shadings. Pixels which are not covered by the mesh are transparent.
+	raster *image.RGBA
+}
+
+// newShadingPattern returns a pattern which paints the specified shading.
+// The matrix `m` maps shading space to device space. The background of the
+// shading is used only if `useBackground` is true, as the background is
+// ignored when painting with the `sh` operator.
+func newShadingPattern(shading *model.PdfShading, m transform.Matrix, width, height int,
+	useBackground bool) (*shadingPattern, error) {
+	inverse, ok := m.Inverse()
+	if !ok {
+		return nil, errors.New("non-invertible shading matrix")
+	}
+
+	p := &shadingPattern{inverse: inverse}
+	if shading.BBox != nil {
+		p.bbox = normalizeBox(shading.BBox)
+	}
+
+	conv := shadingColorConverter{cs: shading.ColorSpace}
+	if useBackground && shading.Background != nil {
+		vals, err := shading.Background.GetAsFloat64Slice()
+		if err != nil {
+			return nil, err
+		}
+		if p.background, err = conv.convert(vals); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	switch t := shading.GetContext().(type) {
+	case *model.PdfShadingType1:
+		conv.fns = t.Function
+		p.colorAt, err = newFunctionShading(t, conv)
+	case *model.PdfShadingType2:
+		conv.fns = t.Function
+		p.colorAt, err = newAxialShading(t, conv)
+	case *model.PdfShadingType3:
+		conv.fns = t.Function
+		p.colorAt, err = newRadialShading(t, conv)
+	case *model.PdfShadingType4:
+		conv.fns = t.Function
+		p.raster, err = rasterizeMesh(shading, conv, meshParams{
+			bitsPerCoordinate: t.BitsPerCoordinate,
+			bitsPerComponent:  t.BitsPerComponent,
+			bitsPerFlag:       t.BitsPerFlag,
+			decode:            t.Decode,
+		}, m, width, height)
+	case *model.PdfShadingType5:
+		conv.fns = t.Function
+		p.raster, err = rasterizeMesh(shading, conv, meshParams{
+			bitsPerCoordinate: t.BitsPerCoordinate,
+			bitsPerComponent:  t.BitsPerComponent,
+			verticesPerRow:    t.VerticesPerRow,
+			decode:            t.Decode,
+		}, m, width, height)
+	case *model.PdfShadingType6:
+		conv.fns = t.Function
+		p.raster, err = rasterizeMesh(shading, conv, meshParams{
+			bitsPerCoordinate: t.BitsPerCoordinate,
+			bitsPerComponent:  t.BitsPerComponent,
+			bitsPerFlag:       t.BitsPerFlag,
+			decode:            t.Decode,
+		}, m, width, height)
+	case *model.PdfShadingType7:
+		conv.fns = t.Function
+		p.raster, err = rasterizeMesh(shading, conv, meshParams{
+			bitsPerCoordinate: t.BitsPerCoordinate,
+			bitsPerComponent:  t.BitsPerComponent,
+			bitsPerFlag:       t.BitsPerFlag,
+			decode:            t.Decode,
+		}, m, width, height)
+	default:
+		return nil, fmt.Errorf("unsupported shading type: %T", t)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// ColorAt returns the color of the pattern at the specified pixel.
+func (p *shadingPattern) ColorAt(x, y int) color.Color {
+	sx, sy := p.inverse.Transform(float64(x)+0.5, float64(y)+0.5)
+	if box := p.bbox; box != nil {
+		if sx < box.Llx || sx > box.Urx || sy < box.Lly || sy > box.Ury {
+			return color.Transparent
+		}
+	}
+
+	if p.raster != nil {
+		if c := p.raster.RGBAAt(x, y); c.A != 0 {
+			return c
+		}
+	} else if c, ok := p.colorAt(sx, sy); ok {
+		return c
+	}
+
+	if p.background != nil {
+		return p.background
+	}
+	return color.Transparent
+}
+
+// newFunctionShading returns the color function of a function-based shading.
+func newFunctionShading(shading *model.PdfShadingType1, conv shadingColorConverter) (func(x, y float64) (color.Color, bool), error) {
+	domain := []float64{0, 1, 0, 1}
+	if shading.Domain != nil {
+		vals, err := shading.Domain.GetAsFloat64Slice()
+		if err != nil || len(vals) != 4 {
+			return nil, errors.New("invalid shading domain")
+		}
+		domain = vals
+	}
+
+	inverse := transform.IdentityMatrix()
+	if shading.Matrix != nil {
+		vals, err := shading.Matrix.GetAsFloat64Slice()
+		if err != nil || len(vals) != 6 {
+			return nil, errors.New("invalid shading matrix")
+		}
+		m := transform.NewMatrix(vals[0], vals[1], vals[2], vals[3], vals[4], vals[5])
+
+		var ok bool
+		if inverse, ok = m.Inverse(); !ok {
+			return nil, errors.New("non-invertible shading matrix")
+		}
+	}
+
+	return func(x, y float64) (color.Color, bool) {
+		x, y = inverse.Transform(x, y)
+		if x < domain[0] || x > domain[1] || y < domain[2] || y > domain[3] {
+			return nil, false
+		}
+
+		c, err := conv.convert([]float64{x, y})
+		if err != nil {
+			return nil, false
+		}
+		return c, true
+	}, nil
+}
+
+// newAxialShading returns the color function of an axial shading.
+func newAxialShading(shading *model.PdfShadingType2, conv shadingColorConverter) (func(x, y float64) (color.Color, bool), error) {
+	if shading.Coords == nil {
+		return nil, errors.New("missing shading coordinates")
+	}
+	coords, err := shading.Coords.GetAsFloat64Slice()
+	if err != nil || len(coords) != 4 {
+		return nil, errors.New("invalid shading coordinates")
+	}
+	lookup, extend, err := newParametricShading(shading.Domain, shading.Extend, conv)
+	if err != nil {
+		return nil, err
+	}
+
+	x0, y0, x1, y1 := coords[0], coords[1], coords[2], coords[3]
+	dx, dy := x1-x0, y1-y0
+	denom := dx*dx + dy*dy
+
+	return func(x, y float64) (color.Color, bool) {
+		var s float64
+		if denom != 0 {
+			s = ((x-x0)*dx + (y-y0)*dy) / denom
+		}
+		if (s < 0 && !extend[0]) || (s > 1 && !extend[1]) {
+			return nil, false
+		}
+		return lookup.at(s), true
+	}, nil
+}
+
+// newRadialShading returns the color function of a radial shading.
+func newRadialShading(shading *model.PdfShadingType3, conv shadingColorConverter) (func(x, y float64) (color.Color, bool), error) {
+	if shading.Coords == nil {
+		return nil, errors.New("missing shading coordinates")
+	}
+	coords, err := shading.Coords.GetAsFloat64Slice()
+	if err != nil || len(coords) != 6 {
+		return nil, errors.New("invalid shading coordinates")
+	}
+	lookup, extend, err := newParametricShading(shading.Domain, shading.Extend, conv)
+	if err != nil {
+		return nil, err
+	}
+
+	x0, y0, r0 := coords[0], coords[1], coords[2]
+	dx, dy, dr := coords[3]-x0, coords[4]-y0, coords[5]-r0
+
+	// The circles are defined by the center c(s) = c0 + s*(c1-c0) and the
+	// radius r(s) = r0 + s*(r1-r0). The color of a point is given by the
+	// largest value of s for which the point lies on the circle, i.e. the
+	// largest solution of the equation a*s^2 - 2*b*s + c = 0.
+	a := dx*dx + dy*dy - dr*dr
+
+	valid := func(s float64) bool {
+		if r0+s*dr < 0 {
+			return false
+		}
+		return (s >= 0 || extend[0]) && (s <= 1 || extend[1])
+	}
+
+	return func(x, y float64) (color.Color, bool) {
+		px, py := x-x0, y-y0
+		b := px*dx + py*dy + r0*dr
+		c := px*px + py*py - r0*r0
+
+		if a == 0 {
+			if b == 0 {
+				return nil, false
+			}
+			if s := c / (2 * b); valid(s) {
+				return lookup.at(s), true
+			}
+			return nil, false
+		}
+
+		disc := b*b - a*c
+		if disc < 0 {
+			return nil, false
+		}
+		sqrtDisc := math.Sqrt(disc)
+		s1, s2 := (b+sqrtDisc)/a, (b-sqrtDisc)/a
+		if s1 < s2 {
+			s1, s2 = s2, s1
+		}
+
+		if valid(s1) {
+			return lookup.at(s1), true
+		}
+		if valid(s2) {
+			return lookup.at(s2), true
+		}
+		return nil, false
+	}, nil
+}
+
+// newParametricShading returns a color lookup for the parametric variable of
+// an axial or radial shading, along with its Extend flags. The lookup maps
+// values in the [0, 1] range to the shading domain.
+func newParametricShading(domainArr, extendArr *core.PdfObjectArray, conv shadingColorConverter) (*shadingLookup, [2]bool, error) {
+	var extend [2]bool
+	if extendArr != nil {
+		if extendArr.Len() != 2 {
+			return nil, extend, errors.New("invalid shading extend")
+		}
+		extend[0], _ = core.GetBoolVal(extendArr.Get(0))
+		extend[1], _ = core.GetBoolVal(extendArr.Get(1))
+	}
+
+	t0, t1 := 0.0, 1.0
+	if domainArr != nil {
+		domain, err := domainArr.GetAsFloat64Slice()
+		if err != nil || len(domain) != 2 {
+			return nil, extend, errors.New("invalid shading domain")
+		}
+		t0, t1 = domain[0], domain[1]
+	}
+
+	lookup, err := newShadingLookup(conv, t0, t1)
+	if err != nil {
+		return nil, extend, err
+	}
+	lookup.t0, lookup.t1 = 0, 1
+	return lookup, extend, nil
+}
+
+// shadingColorConverter converts shading color values to RGBA colors.
+type shadingColorConverter struct {
+	cs  model.PdfColorspace
+	fns []model.PdfFunction
+}
+
+// convert returns the RGBA color corresponding to the specified values.
+// If the converter has shading functions, the values represent their input.
+// Otherwise, the values represent color components in the colorspace of the
+// shading.
+func (conv shadingColorConverter) convert(vals []float64) (color.RGBA, error) {
+	if len(conv.fns) > 0 {
+		var err error
+		if vals, err = evaluateShadingFunctions(conv.fns, vals); err != nil {
+			return color.RGBA{}, err
+		}
+	}
+
+	// Clamp color components to the ranges of the colorspace.
+	decode := conv.cs.DecodeArray()
+	comps := make([]float64, len(vals))
+	for i, val := range vals {
+		if 2*i+1 < len(decode) {
+			val = math.Max(decode[2*i], math.Min(val, decode[2*i+1]))
+		}
+		comps[i] = val
+	}
+
+	pdfColor, err := conv.cs.ColorFromFloats(comps)
+	if err != nil {
+		return color.RGBA{}, err
+	}
+	pdfColor, err = conv.cs.ColorToRGB(pdfColor)
+	if err != nil {
+		return color.RGBA{}, err
+	}
+	rgbColor, ok := pdfColor.(*model.PdfColorDeviceRGB)
+	if !ok {
+		return color.RGBA{}, errType
+	}
+
+	toUint8 := func(val float64) uint8 {
+		return uint8(math.Round(255 * math.Max(0, math.Min(val, 1))))
+	}
+	return color.RGBA{
+		R: toUint8(rgbColor.R()),
+		G: toUint8(rgbColor.G()),
+		B: toUint8(rgbColor.B()),
+		A: 255,
+	}, nil
+}
+
+// evaluateShadingFunctions evaluates the functions of a shading. The
+// shading can have either a single function with an output value for each
+// color component, or an array of functions with one output value each.
+func evaluateShadingFunctions(fns []model.PdfFunction, vals []float64) ([]float64, error) {
+	if len(fns) == 1 {
+		return fns[0].Evaluate(vals)
+	}
+
+	out := make([]float64, 0, len(fns))
+	for _, fn := range fns {
+		res, err := fn.Evaluate(vals)
+		if err != nil {
+			return nil, err
+		}
+		if len(res) == 0 {
+			return nil, errRange
+		}
+		out = append(out, res[0])
+	}
+	return out, nil
+}
+
+// shadingLookup contains precomputed shading colors for values of a single
+// parametric variable in the [t0, t1] range. Values outside of the range are
+// clamped.
+type shadingLookup struct {
+	t0, t1 float64
+	colors []color.RGBA
+}
+
+// newShadingLookup precomputes the colors of the parametric variable values
+// in the [t0, t1] range.
+func newShadingLookup(conv shadingColorConverter, t0, t1 float64) (*shadingLookup, error) {
+	lookup := &shadingLookup{
+		t0:     t0,
+		t1:     t1,
+		colors: make([]color.RGBA, shadingLookupSize),
+	}
+
+	step := (t1 - t0) / float64(shadingLookupSize-1)
+	for i := range lookup.colors {
+		c, err := conv.convert([]float64{t0 + float64(i)*step})
+		if err != nil {
+			return nil, err
+		}
+		lookup.colors[i] = c
+	}
+	return lookup, nil
+}
+
+// at returns the color of the specified value of the parametric variable.
+func (l *shadingLookup) at(t float64) color.RGBA {
+	if l.t0 == l.t1 {
+		return l.colors[0]
+	}
+
+	s := (t - l.t0) / (l.t1 - l.t0)
+	s = math.Max(0, math.Min(s, 1))
+	return l.colors[int(math.Round(s*float64(len(l.colors)-1)))]
+}
+
+// meshParams contains the parameters used for decoding the data of mesh
+// shadings (types 4-7).
+type meshParams struct {
+	bitsPerCoordinate *core.PdfObjectInteger
+	bitsPerComponent  *core.PdfObjectInteger
+	bitsPerFlag       *core.PdfObjectInteger
+	verticesPerRow    *core.PdfObjectInteger
+	decode            *core.PdfObjectArray
+}
+
+// meshVertex represents a vertex of a mesh shading, in device space. The
+// color values are either color components or the input value of the
+// shading functions.
+type meshVertex struct {
+	x, y  float64
+	color []float64
+}
+
+// meshRasterizer rasterizes the triangles and patches of mesh shadings.
+type meshRasterizer struct {
+	img    *image.RGBA
+	conv   shadingColorConverter
+	lookup *shadingLookup
+}
+
+// rasterizeMesh decodes the data of the specified mesh shading and returns
+// the rasterized mesh, in device space. The matrix `m` maps shading space
+// to device space.
+func rasterizeMesh(shading *model.PdfShading, conv shadingColorConverter, params meshParams,
+	m transform.Matrix, width, height int) (*image.RGBA, error) {
+	stream, ok := core.GetStream(shading.GetContainingPdfObject())
+	if !ok {
+		return nil, errors.New("mesh shading is not a stream")
+	}
+	data, err := core.DecodeStream(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	shadingType := *shading.ShadingType
+	r := &meshRasterizer{
+		img:  image.NewRGBA(image.Rect(0, 0, width, height)),
+		conv: conv,
+	}
+
+	// Shadings with functions use a single color value, representing the
+	// parametric variable of the functions.
+	numComps := conv.cs.GetNumComponents()
+	if len(conv.fns) > 0 {
+		numComps = 1
+	}
+
+	if params.bitsPerCoordinate == nil || params.bitsPerComponent == nil || params.decode == nil {
+		return nil, model.ErrRequiredAttributeMissing
+	}
+	decode, err := params.decode.GetAsFloat64Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(decode) < 4+2*numComps {
+		return nil, errors.New("invalid mesh decode array")
+	}
+	if len(conv.fns) > 0 {
+		if r.lookup, err = newShadingLookup(conv, decode[4], decode[5]); err != nil {
+			return nil, err
+		}
+	}
+
+	reader := &meshReader{
+		data:              data,
+		matrix:            m,
+		bitsPerCoordinate: int(*params.bitsPerCoordinate),
+		bitsPerComponent:  int(*params.bitsPerComponent),
+		decode:            decode,
+		numComps:          numComps,
+	}
+	if shadingType != 5 {
+		if params.bitsPerFlag == nil {
+			return nil, model.ErrRequiredAttributeMissing
+		}
+		reader.bitsPerFlag = int(*params.bitsPerFlag)
+	}
+
+	switch shadingType {
+	case 4:
+		r.fillFreeFormMesh(reader)
+	case 5:
+		if params.verticesPerRow == nil || *params.verticesPerRow < 2 {
+			return nil, errors.New("invalid mesh vertices per row")
+		}
+		r.fillLatticeMesh(reader, int(*params.verticesPerRow))
+	case 6, 7:
+		r.fillPatchMesh(reader, shadingType == 7)
+	}
+
+	return r.img, nil
+}
+
+// fillFreeFormMesh rasterizes a free-form Gouraud-shaded triangle mesh
+// (section 8.7.4.5.5 "Type 4 Shadings" p. 193 PDF32000_2008).
+func (r *meshRasterizer) fillFreeFormMesh(reader *meshReader) {
+	var va, vb, vc meshVertex
+	var hasTriangle bool
+	for {
+		flag, ok := reader.readFlag()
+		if !ok {
+			return
+		}
+		v, ok := reader.readVertex()
+		if !ok {
+			return
+		}
+		reader.align()
+
+		switch {
+		case flag == 0:
+			// Start a new triangle. The flags of the other two vertices are ignored.
+			vertices := []meshVertex{v}
+			for i := 0; i < 2; i++ {
+				if _, ok := reader.readFlag(); !ok {
+					return
+				}
+				if v, ok = reader.readVertex(); !ok {
+					return
+				}
+				reader.align()
+				vertices = append(vertices, v)
+			}
+			va, vb, vc = vertices[0], vertices[1], vertices[2]
+			hasTriangle = true
+		case flag == 1 && hasTriangle:
+			va, vb, vc = vb, vc, v
+		case flag == 2 && hasTriangle:
+			vb, vc = vc, v
+		default:
+			return
+		}
+		r.fillTriangle(va, vb, vc)
+	}
+}
+
+// fillLatticeMesh rasterizes a lattice-form Gouraud-shaded triangle mesh
+// (section 8.7.4.5.6 "Type 5 Shadings" p. 195 PDF32000_2008).
+func (r *meshRasterizer) fillLatticeMesh(reader *meshReader, verticesPerRow int) {
+	var prevRow []meshVertex
+	for {
+		row := make([]meshVertex, 0, verticesPerRow)
+		for len(row) < verticesPerRow {
+			v, ok := reader.readVertex()
+			if !ok {
+				return
+			}
+			row = append(row, v)
+		}
+
+		if prevRow != nil {
+			for i := 0; i < verticesPerRow-1; i++ {
+				r.fillTriangle(prevRow[i], prevRow[i+1], row[i])
+				r.fillTriangle(prevRow[i+1], row[i+1], row[i])
+			}
+		}
+		prevRow = row
+	}
+}
+
+// patchPerimeter contains the positions in the control point grid of the
+// perimeter control points of a patch, in the order they are specified in
+// the data stream of patch mesh shadings.
+var patchPerimeter = [12][2]int{
+	{0, 0}, {0, 1}, {0, 2}, {0, 3}, {1, 3}, {2, 3},
+	{3, 3}, {3, 2}, {3, 1}, {3, 0}, {2, 0}, {1, 0},
+}
+
+// patchInterior contains the positions in the control point grid of the
+// interior control points of tensor-product patches, in the order they are
+// specified in the data stream.
+var patchInterior = [4][2]int{{1, 1}, {1, 2}, {2, 2}, {2, 1}}
+
+// meshPatch represents a Coons or tensor-product patch, in device space.
+type meshPatch struct {
+	// points contains the control points of the patch. The control points
+	// of Coons patches are stored in the first 12 elements.
+	points [16][2]float64
+
+	// colors contains the colors of the corners of the patch, in the order
+	// they are specified in the data stream.
+	colors [4][]float64
+}
+
+// fillPatchMesh rasterizes a Coons patch mesh or a tensor-product patch mesh
+// (section 8.7.4.5.7 "Type 6 Shadings" p. 196 PDF32000_2008).
+func (r *meshRasterizer) fillPatchMesh(reader *meshReader, tensor bool) {
+	numPoints := 12
+	if tensor {
+		numPoints = 16
+	}
+
+	var prev *meshPatch
+	for {
+		flag, ok := reader.readFlag()
+		if !ok {
+			return
+		}
+
+		patch := &meshPatch{}
+		startPoint, startColor := 0, 0
+		if flag != 0 {
+			if prev == nil || flag > 3 {
+				return
+			}
+
+			// The first edge of the patch is shared with an edge of the
+			// previous patch.
+			edge := 3 * int(flag)
+			for i := 0; i < 4; i++ {
+				patch.points[i] = prev.points[(edge+i)%12]
+			}
+			patch.colors[0] = prev.colors[flag]
+			patch.colors[1] = prev.colors[(flag+1)%4]
+			startPoint, startColor = 4, 2
+		}
+
+		for i := startPoint; i < numPoints; i++ {
+			x, y, ok := reader.readPoint()
+			if !ok {
+				return
+			}
+			patch.points[i] = [2]float64{x, y}
+		}
+		for i := startColor; i < 4; i++ {
+			if patch.colors[i], ok = reader.readColor(); !ok {
+				return
+			}
+		}
+		reader.align()
+
+		r.fillPatch(patch, tensor)
+		prev = patch
+	}
+}
+
+// fillPatch rasterizes the specified patch by subdividing it into triangles.
+func (r *meshRasterizer) fillPatch(patch *meshPatch, tensor bool) {
+	var grid [4][4][2]float64
+	for i, pos := range patchPerimeter {
+		grid[pos[0]][pos[1]] = patch.points[i]
+	}
+	if tensor {
+		for i, pos := range patchInterior {
+			grid[pos[0]][pos[1]] = patch.points[12+i]
+		}
+	} else {
+		// Calculate the interior control points of the equivalent
+		// tensor-product patch.
+		for k := 0; k < 2; k++ {
+			p := func(i, j int) float64 { return grid[i][j][k] }
+			grid[1][1][k] = (-4*p(0, 0) + 6*(p(0, 1)+p(1, 0)) - 2*(p(0, 3)+p(3, 0)) +
+				3*(p(3, 1)+p(1, 3)) - p(3, 3)) / 9
+			grid[1][2][k] = (-4*p(0, 3) + 6*(p(0, 2)+p(1, 3)) - 2*(p(0, 0)+p(3, 3)) +
+				3*(p(3, 2)+p(1, 0)) - p(3, 0)) / 9
+			grid[2][1][k] = (-4*p(3, 0) + 6*(p(3, 1)+p(2, 0)) - 2*(p(3, 3)+p(0, 0)) +
+				3*(p(1, 3)+p(0, 1)) - p(0, 3)) / 9
+			grid[2][2][k] = (-4*p(3, 3) + 6*(p(3, 2)+p(2, 3)) - 2*(p(3, 0)+p(0, 3)) +
+				3*(p(2, 0)+p(0, 2)) - p(0, 0)) / 9
+		}
+	}
+
+	// Choose the number of subdivisions based on the size of the patch, in
+	// device space.
+	var length float64
+	for i := range patchPerimeter {
+		p0, p1 := patch.points[i], patch.points[(i+1)%12]
+		length = math.Max(length, math.Hypot(p1[0]-p0[0], p1[1]-p0[1]))
+	}
+	n := int(math.Max(2, math.Min(math.Ceil(length), 64)))
+
+	bernstein := func(t float64) [4]float64 {
+		mt := 1 - t
+		return [4]float64{mt * mt * mt, 3 * t * mt * mt, 3 * t * t * mt, t * t * t}
+	}
+
+	numComps := len(patch.colors[0])
+	vertices := make([][]meshVertex, n+1)
+	for ui := 0; ui <= n; ui++ {
+		u := float64(ui) / float64(n)
+		bu := bernstein(u)
+		vertices[ui] = make([]meshVertex, n+1)
+
+		for vi := 0; vi <= n; vi++ {
+			v := float64(vi) / float64(n)
+			bv := bernstein(v)
+
+			var x, y float64
+			for i := 0; i < 4; i++ {
+				for j := 0; j < 4; j++ {
+					w := bu[i] * bv[j]
+					x += w * grid[i][j][0]
+					y += w * grid[i][j][1]
+				}
+			}
+
+			// The corner colors c00, c03, c33 and c30 are interpolated bilinearly.
+			c := make([]float64, numComps)
+			for k := range c {
+				c[k] = (1-u)*(1-v)*patch.colors[0][k] + (1-u)*v*patch.colors[1][k] +
+					u*v*patch.colors[2][k] + u*(1-v)*patch.colors[3][k]
+			}
+			vertices[ui][vi] = meshVertex{x: x, y: y, color: c}
+		}
+	}
+
+	for ui := 0; ui < n; ui++ {
+		for vi := 0; vi < n; vi++ {
+			r.fillTriangle(vertices[ui][vi], vertices[ui+1][vi], vertices[ui][vi+1])
+			r.fillTriangle(vertices[ui+1][vi], vertices[ui+1][vi+1], vertices[ui][vi+1])
+		}
+	}
+}
+
+// fillTriangle rasterizes the specified triangle, interpolating the colors
+// of its vertices.
+func (r *meshRasterizer) fillTriangle(a, b, c meshVertex) {
+	denom := (b.y-c.y)*(a.x-c.x) + (c.x-b.x)*(a.y-c.y)
+	if denom == 0 {
+		return
+	}
+
+	bounds := r.img.Bounds()
+	minX := int(math.Max(math.Floor(math.Min(a.x, math.Min(b.x, c.x))), float64(bounds.Min.X)))
+	maxX := int(math.Min(math.Ceil(math.Max(a.x, math.Max(b.x, c.x))), float64(bounds.Max.X-1)))
+	minY := int(math.Max(math.Floor(math.Min(a.y, math.Min(b.y, c.y))), float64(bounds.Min.Y)))
+	maxY := int(math.Min(math.Ceil(math.Max(a.y, math.Max(b.y, c.y))), float64(bounds.Max.Y-1)))
+
+	const eps = 1e-9
+	comps := make([]float64, len(a.color))
+	for y := minY; y <= maxY; y++ {
+		py := float64(y) + 0.5
+		for x := minX; x <= maxX; x++ {
+			px := float64(x) + 0.5
+
+			// Calculate the barycentric coordinates of the pixel center.
+			wa := ((b.y-c.y)*(px-c.x) + (c.x-b.x)*(py-c.y)) / denom
+			wb := ((c.y-a.y)*(px-c.x) + (a.x-c.x)*(py-c.y)) / denom
+			wc := 1 - wa - wb
+			if wa < -eps || wb < -eps || wc < -eps {
+				continue
+			}
+
+			for i := range comps {
+				comps[i] = wa*a.color[i] + wb*b.color[i] + wc*c.color[i]
+			}
+
+			if r.lookup != nil {
+				r.img.SetRGBA(x, y, r.lookup.at(comps[0]))
+			} else if col, err := r.conv.convert(comps); err == nil {
+				r.img.SetRGBA(x, y, col)
+			}
+		}
+	}
+}
+
+// meshReader reads the vertex data of mesh shadings.
+type meshReader struct {
+	data   []byte
+	bitPos int
+
+	matrix            transform.Matrix
+	bitsPerCoordinate int
+	bitsPerComponent  int
+	bitsPerFlag       int
+	decode            []float64
+	numComps          int
+}
+
+// readBits reads the next `n` bits of data. The returned flag is false if
+// there is not enough data left.
+func (r *meshReader) readBits(n int) (uint64, bool) {
+	if n <= 0 || n > 64 || r.bitPos+n > 8*len(r.data) {
+		return 0, false
+	}
+
+	var val uint64
+	for i := 0; i < n; i++ {
+		bit := (r.data[r.bitPos>>3] >> uint(7-r.bitPos&7)) & 1
+		val = val<<1 | uint64(bit)
+		r.bitPos++
+	}
+	return val, true
+}
+
+// readValue reads a value of `n` bits and maps it to the [dmin, dmax] range.
+func (r *meshReader) readValue(n int, dmin, dmax float64) (float64, bool) {
+	val, ok := r.readBits(n)
+	if !ok {
+		return 0, false
+	}
+	maxVal := math.Pow(2, float64(n)) - 1
+	return dmin + float64(val)*(dmax-dmin)/maxVal, true
+}
+
+// align skips the remaining bits of the current byte.
+func (r *meshReader) align() {
+	r.bitPos = (r.bitPos + 7) &^ 7
+}
+
+// readFlag reads the edge flag of a vertex or patch.
+func (r *meshReader) readFlag() (uint64, bool) {
+	return r.readBits(r.bitsPerFlag)
+}
+
+// readPoint reads a point and transforms it to device space.
+func (r *meshReader) readPoint() (float64, float64, bool) {
+	x, ok := r.readValue(r.bitsPerCoordinate, r.decode[0], r.decode[1])
+	if !ok {
+		return 0, 0, false
+	}
+	y, ok := r.readValue(r.bitsPerCoordinate, r.decode[2], r.decode[3])
+	if !ok {
+		return 0, 0, false
+	}
+
+	x, y = r.matrix.Transform(x, y)
+	return x, y, true
+}
+
+// readColor reads the color values of a vertex.
+func (r *meshReader) readColor() ([]float64, bool) {
+	vals := make([]float64, r.numComps)
+	for i := range vals {
+		val, ok := r.readValue(r.bitsPerComponent, r.decode[4+2*i], r.decode[5+2*i])
+		if !ok {
+			return nil, false
+		}
+		vals[i] = val
+	}
+	return vals, true
+}
+
+// readVertex reads the coordinates and the color values of a vertex.
+func (r *meshReader) readVertex() (meshVertex, bool) {
+	x, y, ok := r.readPoint()
+	if !ok {
+		return meshVertex{}, false
+	}
+	vals, ok := r.readColor()
+	if !ok {
+		return meshVertex{}, false
+	}
+	return meshVertex{x: x, y: y, color: vals}, true
+}
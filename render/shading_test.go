@@ -0,0 +1,95 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+)
+
+func TestAxialShading(t *testing.T) {
+	resources := model.NewPdfPageResources()
+	require.NoError(t, resources.SetShadingByName("Sh0", parseTestDict(t, `<<
+		/ShadingType 2 /ColorSpace /DeviceRGB /Coords [20 0 80 0]
+		/Function << /FunctionType 2 /Domain [0 1] /C0 [1 0 0] /C1 [0 0 1] /N 1 >>
+	>>`)))
+
+	// Without Extend, the shading is not painted outside of its axis.
+	img := renderTestPage(t, "/Sh0 sh", resources)
+	requirePixel(t, img, 10, 50, testWhite, 0)
+	requirePixel(t, img, 20, 50, testRed, 8)
+	requirePixel(t, img, 50, 50, color.RGBA{128, 0, 128, 255}, 8)
+	requirePixel(t, img, 79, 50, testBlue, 8)
+	requirePixel(t, img, 90, 50, testWhite, 0)
+
+	// The shading is painted over the clipping region only.
+	require.NoError(t, resources.SetShadingByName("Sh1", parseTestDict(t, `<<
+		/ShadingType 2 /ColorSpace /DeviceRGB /Coords [20 0 80 0] /Extend [true true]
+		/Function << /FunctionType 2 /Domain [0 1] /C0 [1 0 0] /C1 [0 0 1] /N 1 >>
+	>>`)))
+	img = renderTestPage(t, "0 0 100 50 re W n /Sh1 sh", resources)
+	requirePixel(t, img, 5, 25, testRed, 0)
+	requirePixel(t, img, 95, 25, testBlue, 0)
+	requirePixel(t, img, 5, 75, testWhite, 0)
+}
+
+func TestRadialShading(t *testing.T) {
+	resources := model.NewPdfPageResources()
+	require.NoError(t, resources.SetShadingByName("Sh0", parseTestDict(t, `<<
+		/ShadingType 3 /ColorSpace /DeviceGray /Coords [50 50 0 50 50 40]
+		/Function << /FunctionType 2 /Domain [0 1] /C0 [0] /C1 [1] /N 1 >>
+	>>`)))
+	img := renderTestPage(t, "1 0 0 rg 0 0 100 100 re f /Sh0 sh", resources)
+	requirePixel(t, img, 50, 50, testBlack, 8)
+	requirePixel(t, img, 70, 50, color.RGBA{128, 128, 128, 255}, 8)
+	requirePixel(t, img, 50, 30, color.RGBA{128, 128, 128, 255}, 8)
+	requirePixel(t, img, 5, 5, testRed, 0)
+}
+
+func TestMeshShading(t *testing.T) {
+	// A free-form triangle mesh with a single red triangle covering the lower
+	// left half of the page.
+	data := []byte{
+		0, 0, 0, 255, 0, 0,
+		0, 255, 0, 255, 0, 0,
+		0, 0, 255, 255, 0, 0,
+	}
+	resources := model.NewPdfPageResources()
+	require.NoError(t, resources.SetShadingByName("Sh0", makeTestStream(t, `<<
+		/ShadingType 4 /ColorSpace /DeviceRGB /BitsPerCoordinate 8 /BitsPerComponent 8
+		/BitsPerFlag 8 /Decode [0 100 0 100 0 1 0 1 0 1]
+	>>`, data)))
+
+	img := renderTestPage(t, "/Sh0 sh", resources)
+	requirePixel(t, img, 10, 10, testRed, 0)
+	requirePixel(t, img, 40, 40, testRed, 0)
+	requirePixel(t, img, 60, 60, testWhite, 0)
+	requirePixel(t, img, 90, 90, testWhite, 0)
+}
+
+func TestShadingPattern(t *testing.T) {
+	// The pattern matrix maps the shading to the default coordinate space of
+	// the page, regardless of the CTM in effect when the pattern is used.
+	resources := model.NewPdfPageResources()
+	require.NoError(t, resources.SetPatternByName("P0", core.MakeIndirectObject(parseTestDict(t, `<<
+		/PatternType 2 /Matrix [1 0 0 1 50 0]
+		/Shading <<
+			/ShadingType 2 /ColorSpace /DeviceRGB /Coords [0 0 50 0] /Extend [true true]
+			/Function << /FunctionType 2 /Domain [0 1] /C0 [0 1 0] /C1 [0 0 1] /N 1 >>
+		>>
+	>>`))))
+
+	img := renderTestPage(t, "q 2 0 0 2 0 0 cm /Pattern cs /P0 scn 0 0 50 25 re f Q", resources)
+	requirePixel(t, img, 10, 25, testGreen, 0)
+	requirePixel(t, img, 75, 25, color.RGBA{0, 128, 128, 255}, 8)
+	requirePixel(t, img, 99, 25, testBlue, 8)
+	requirePixel(t, img, 50, 75, testWhite, 0)
+}
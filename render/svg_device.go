@@ -0,0 +1,105 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"image/color"
+	"io"
+	"math"
+
+	"github.com/showntop/unipdf/model"
+	"github.com/showntop/unipdf/render/internal/context/svgrender"
+)
+
+// SVGDevice is used to render PDF pages to SVG images. The paths, clipping
+// paths and images of the pages are converted to SVG elements, and the text
+// is converted to the outlines of its glyphs. Content which cannot be
+// represented by SVG elements, such as shadings, tiling patterns and soft
+// masks, is rasterized.
+type SVGDevice struct {
+	renderer
+
+	// DPI represents the resolution, in dots per inch, at which the
+	// rasterized content of the pages is rendered. It does not affect the
+	// dimensions of the SVG images. Defaults to 144.
+	DPI float64
+
+	// PageBox specifies the page boundary which is rendered.
+	// Defaults to the crop box of the page.
+	PageBox PageBox
+
+	// Background represents the color used for filling the background of the
+	// rendered images. If nil, the background is white. Use color.Transparent
+	// for transparent output.
+	Background color.Color
+
+	// IgnoreRotation specifies whether the rotation of the pages (the Rotate
+	// entry of the page dictionaries) should be ignored. By default, pages
+	// are rendered as they are displayed by PDF viewers.
+	IgnoreRotation bool
+
+	// Target specifies whether pages are rendered as they are displayed on
+	// screen or as they are printed, which determines the annotations that
+	// are visible on the rendered pages. Defaults to ScreenTarget.
+	Target RenderTarget
+
+	// SkipAnnotations specifies whether the appearances of the annotations
+	// of the pages should not be rendered. By default, they are rendered on
+	// top of the page content.
+	SkipAnnotations bool
+}
+
+// NewSVGDevice returns a new SVG device.
+func NewSVGDevice() *SVGDevice {
+	return &SVGDevice{}
+}
+
+// Render converts the specified PDF page into an SVG image and writes the
+// result to `w`. The dimensions of the SVG image are the dimensions of the
+// rendered page boundary, in points.
+func (d *SVGDevice) Render(page *model.PdfPage, w io.Writer) error {
+	view, err := newPageView(page, d.PageBox, d.IgnoreRotation)
+	if err != nil {
+		return err
+	}
+
+	// Calculate the dimensions of the rendering area.
+	dpi := d.DPI
+	if dpi <= 0 {
+		dpi = 144
+	}
+	scale := dpi / 72
+	width := int(math.Max(1, math.Round(view.width*scale)))
+	height := int(math.Max(1, math.Round(view.height*scale)))
+	ctx := svgrender.NewContext(width, height)
+
+	// Fill image background.
+	background := d.Background
+	if background == nil {
+		background = color.White
+	}
+	if c := color.NRGBAModel.Convert(background).(color.NRGBA); c.A != 0 {
+		ctx.SetFillRGBA(float64(c.R)/255, float64(c.G)/255, float64(c.B)/255, float64(c.A)/255)
+		ctx.DrawRectangle(0, 0, float64(width), float64(height))
+		ctx.Fill()
+	}
+
+	// Render page.
+	view.setMatrix(ctx, float64(width), float64(height))
+	if err := d.renderPageView(ctx, page, !d.SkipAnnotations, d.Target); err != nil {
+		return err
+	}
+
+	return ctx.Encode(w, view.width, view.height)
+}
+
+// RenderToPath converts the specified PDF page into an SVG image and saves
+// the result at the specified location.
+func (d *SVGDevice) RenderToPath(page *model.PdfPage, outputPath string) error {
+	return writeFile(outputPath, func(w io.Writer) error {
+		return d.Render(page, w)
+	})
+}
@@ -0,0 +1,105 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"image/png"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/model"
+)
+
+// renderTestSVG renders a 100x100 page with content stream `contents` and
+// resources `resources` to an SVG image, checks that the image is well-formed
+// XML and returns the SVG elements of the page content, one per line,
+// without the background.
+func renderTestSVG(t *testing.T, contents string, resources *model.PdfPageResources) []string {
+	var buf bytes.Buffer
+	d := NewSVGDevice()
+	d.DPI = 72
+	require.NoError(t, d.Render(newTestPage(t, contents, resources), &buf))
+
+	decoder := xml.NewDecoder(bytes.NewReader(buf.Bytes()))
+	for {
+		_, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.True(t, len(lines) >= 4)
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`, lines[0])
+	require.Equal(t, `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" `+
+		`version="1.1" width="100pt" height="100pt" viewBox="0 0 100 100">`, lines[1])
+	require.Equal(t, `<path d="M 0 0 L 100 0 L 100 100 L 0 100 Z" fill="#ffffff"/>`, lines[2])
+	require.Equal(t, "</svg>", lines[len(lines)-1])
+	return lines[3 : len(lines)-1]
+}
+
+func TestSVGDevicePaths(t *testing.T) {
+	elements := renderTestSVG(t, "1 0 0 rg 10 10 30 20 re f "+
+		"q 50 50 40 40 re W n 0 0 1 RG 5 w [2 1] 0 d 0 0 m 100 100 l S Q", nil)
+	require.Equal(t, []string{
+		`<path d="M 10 90 L 40 90 L 40 70 L 10 70 Z" fill="#ff0000"/>`,
+		`<clipPath id="c1" clipPathUnits="userSpaceOnUse"><path d="M 50 50 L 90 50 L 90 10 L 50 10 Z"/></clipPath>`,
+		`<g clip-path="url(#c1)"><path d="M 0 0 L 100 100" transform="matrix(1 0 0 -1 0 100)" fill="none" ` +
+			`stroke="#0000ff" stroke-width="5" stroke-miterlimit="10" stroke-dasharray="2,1"/></g>`,
+	}, elements)
+}
+
+func TestSVGDeviceTransparency(t *testing.T) {
+	resources := model.NewPdfPageResources()
+	require.NoError(t, resources.AddExtGState("GS0", parseTestDict(t, "<< /ca 0.5 /BM /Multiply >>")))
+	elements := renderTestSVG(t, "/GS0 gs 0 1 0 rg 0 0 10 10 re f", resources)
+	require.Equal(t, []string{
+		`<g style="mix-blend-mode:multiply"><path d="M 0 100 L 10 100 L 10 90 L 0 90 Z" fill="#00ff00" ` +
+			`fill-opacity="0.5"/></g>`,
+	}, elements)
+}
+
+func TestSVGDeviceText(t *testing.T) {
+	// Text is converted to the outlines of its glyphs.
+	resources := model.NewPdfPageResources()
+	font := model.NewStandard14FontMustCompile(model.HelveticaName)
+	require.NoError(t, resources.SetFontByName("F1", font.ToPdfObject()))
+	elements := renderTestSVG(t, "BT /F1 20 Tf 10 10 Td (l) Tj ET", resources)
+	require.Len(t, elements, 1)
+	require.Regexp(t, `^<path d="M [0-9. LQZ]+" fill="#000000"/>$`, elements[0])
+}
+
+func TestSVGDeviceShading(t *testing.T) {
+	// Shadings are rasterized to images, clipped by the clipping path.
+	resources := model.NewPdfPageResources()
+	require.NoError(t, resources.SetShadingByName("Sh0", parseTestDict(t, `<<
+		/ShadingType 2 /ColorSpace /DeviceRGB /Coords [0 0 100 0]
+		/Function << /FunctionType 2 /Domain [0 1] /C0 [1 0 0] /C1 [0 0 1] /N 1 >>
+	>>`)))
+	elements := renderTestSVG(t, "q 0 50 50 50 re W n /Sh0 sh Q", resources)
+	require.Len(t, elements, 3)
+	require.Equal(t, `<clipPath id="c1" clipPathUnits="userSpaceOnUse">`+
+		`<path d="M 0 50 L 50 50 L 50 0 L 0 0 Z"/></clipPath>`, elements[0])
+	require.True(t, strings.HasPrefix(elements[1], `<mask id="p2" `))
+
+	match := regexp.MustCompile(`^<g clip-path="url\(#c1\)"><image x="0" y="0" width="100" height="100" ` +
+		`mask="url\(#p2\)" xlink:href="data:image/png;base64,([^"]+)"/></g>$`).FindStringSubmatch(elements[2])
+	require.Len(t, match, 2)
+	data, err := base64.StdEncoding.DecodeString(match[1])
+	require.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	requirePixel(t, img, 0, 50, testRed, 4)
+	requirePixel(t, img, 99, 50, testBlue, 4)
+}
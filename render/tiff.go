@@ -0,0 +1,161 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"image"
+	"image/color"
+	"io"
+
+	"github.com/showntop/unipdf/internal/imageutil"
+	"github.com/showntop/unipdf/model"
+	"github.com/showntop/unipdf/render/internal/tiff"
+)
+
+// TIFFCompression represents the compression scheme used for encoding the
+// pages of rendered TIFF images.
+type TIFFCompression int
+
+// TIFF compression schemes.
+const (
+	// TIFFCompressionLZW encodes color pages using LZW compression.
+	TIFFCompressionLZW TIFFCompression = iota
+
+	// TIFFCompressionDeflate encodes color pages using Deflate compression.
+	TIFFCompressionDeflate
+
+	// TIFFCompressionNone encodes uncompressed color pages.
+	TIFFCompressionNone
+
+	// TIFFCompressionCCITTG3 encodes bilevel pages using one-dimensional
+	// CCITT Group 3 fax compression.
+	TIFFCompressionCCITTG3
+
+	// TIFFCompressionCCITTG4 encodes bilevel pages using CCITT Group 4 fax
+	// compression.
+	TIFFCompressionCCITTG4
+)
+
+// BilevelMethod represents the method used for converting rendered pages to
+// bilevel (black and white) images.
+type BilevelMethod int
+
+// Bilevel conversion methods.
+const (
+	// BilevelThreshold paints the pixels darker than a threshold black and
+	// the other pixels white. It is suitable for text and line art.
+	BilevelThreshold BilevelMethod = iota
+
+	// BilevelOrderedDither approximates the gray levels of the pages using
+	// regular dot patterns, which compress well using fax compression.
+	BilevelOrderedDither
+
+	// BilevelFloydSteinberg approximates the gray levels of the pages using
+	// error diffusion, which renders photographs in more detail.
+	BilevelFloydSteinberg
+)
+
+// RenderToTIFF converts the specified PDF pages into images and writes them
+// to `w` as the pages of a TIFF image, using the TIFF options of the device.
+func (d *ImageDevice) RenderToTIFF(w io.Writer, pages ...*model.PdfPage) error {
+	tw := tiff.NewWriter(w)
+	for _, page := range pages {
+		img, err := d.Render(page)
+		if err != nil {
+			return err
+		}
+		if err := d.writeTIFFPage(tw, page, img); err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+// RenderToTIFFPath converts the specified PDF pages into images and saves
+// them as the pages of a TIFF image at the specified location.
+func (d *ImageDevice) RenderToTIFFPath(outputPath string, pages ...*model.PdfPage) error {
+	return writeFile(outputPath, func(w io.Writer) error {
+		return d.RenderToTIFF(w, pages...)
+	})
+}
+
+// saveTIFF saves the specified rendered image of `page` as a single-page
+// TIFF image at the specified location.
+func (d *ImageDevice) saveTIFF(path string, page *model.PdfPage, img image.Image) error {
+	return writeFile(path, func(w io.Writer) error {
+		tw := tiff.NewWriter(w)
+		if err := d.writeTIFFPage(tw, page, img); err != nil {
+			return err
+		}
+		return tw.Close()
+	})
+}
+
+// writeTIFFPage adds the specified rendered image of `page` to a TIFF image,
+// using the TIFF options of the device.
+func (d *ImageDevice) writeTIFFPage(tw *tiff.Writer, page *model.PdfPage, img image.Image) error {
+	// Calculate the resolution of the rendered image.
+	view, err := newPageView(page, d.PageBox, d.IgnoreRotation)
+	if err != nil {
+		return err
+	}
+	b := img.Bounds()
+	opts := &tiff.Options{
+		XResolution: float64(b.Dx()) / view.width * 72,
+		YResolution: float64(b.Dy()) / view.height * 72,
+	}
+
+	switch d.TIFFCompression {
+	case TIFFCompressionDeflate:
+		opts.Compression = tiff.CompressionDeflate
+	case TIFFCompressionNone:
+		opts.Compression = tiff.CompressionNone
+	case TIFFCompressionCCITTG3:
+		opts.Compression = tiff.CompressionCCITTGroup3
+		img = d.toBilevel(img)
+	case TIFFCompressionCCITTG4:
+		opts.Compression = tiff.CompressionCCITTGroup4
+		img = d.toBilevel(img)
+	default:
+		opts.Compression = tiff.CompressionLZW
+	}
+
+	return tw.WritePage(img, opts)
+}
+
+// toBilevel converts the specified rendered image to a bilevel image, using
+// the bilevel conversion method of the device. Transparent pixels are
+// converted as if they were painted over a white background.
+func (d *ImageDevice) toBilevel(img image.Image) *image.Gray {
+	b := img.Bounds()
+	gray := image.NewGray(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			c := color.RGBA64{
+				R: uint16(r + 0xffff - a),
+				G: uint16(g + 0xffff - a),
+				B: uint16(b + 0xffff - a),
+				A: 0xffff,
+			}
+			gray.SetGray(x, y, color.GrayModel.Convert(c).(color.Gray))
+		}
+	}
+
+	switch d.BilevelMethod {
+	case BilevelOrderedDither:
+		return imageutil.DitherOrdered(gray)
+	case BilevelFloydSteinberg:
+		return imageutil.DitherFloydSteinberg(gray)
+	}
+
+	threshold := d.Threshold
+	if threshold == 0 {
+		threshold = 128
+	}
+	return imageutil.GrayToBilevel(gray, threshold)
+}
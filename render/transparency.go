@@ -0,0 +1,465 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"math"
+
+	"golang.org/x/image/draw"
+
+	"github.com/showntop/unipdf/common"
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/internal/transform"
+	"github.com/showntop/unipdf/model"
+	"github.com/showntop/unipdf/render/internal/context"
+	"github.com/showntop/unipdf/render/internal/context/imagerender"
+)
+
+// blendModes maps the names of the standard blend modes to the blend modes
+// of the rendering context (section 11.3.5 "Blend Mode" p. 320 PDF32000_2008).
+var blendModes = map[core.PdfObjectName]context.BlendMode{
+	"Normal":     context.BlendModeNormal,
+	"Compatible": context.BlendModeNormal,
+	"Multiply":   context.BlendModeMultiply,
+	"Screen":     context.BlendModeScreen,
+	"Overlay":    context.BlendModeOverlay,
+	"Darken":     context.BlendModeDarken,
+	"Lighten":    context.BlendModeLighten,
+	"ColorDodge": context.BlendModeColorDodge,
+	"ColorBurn":  context.BlendModeColorBurn,
+	"HardLight":  context.BlendModeHardLight,
+	"SoftLight":  context.BlendModeSoftLight,
+	"Difference": context.BlendModeDifference,
+	"Exclusion":  context.BlendModeExclusion,
+	"Hue":        context.BlendModeHue,
+	"Saturation": context.BlendModeSaturation,
+	"Color":      context.BlendModeColor,
+	"Luminosity": context.BlendModeLuminosity,
+}
+
+// setExtGState applies the line style and transparency parameters of the
+// specified graphics state parameter dictionary to the rendering context.
+//
+// See section 8.4.5 "Graphics State Parameter Dictionaries" and
+// Table 58 (pp. 128-131 PDF32000_2008).
+func (r renderer) setExtGState(ctx context.Context, extdict *core.PdfObjectDictionary,
+	resources *model.PdfPageResources) error {
+	if lw, err := core.GetNumberAsFloat(extdict.Get("LW")); err == nil {
+		ctx.SetLineWidth(lw)
+	}
+	if val, ok := core.GetIntVal(extdict.Get("LC")); ok {
+		if lineCap, ok := lineCapStyle(val); ok {
+			ctx.SetLineCap(lineCap)
+		}
+	}
+	if val, ok := core.GetIntVal(extdict.Get("LJ")); ok {
+		if lineJoin, ok := lineJoinStyle(val); ok {
+			ctx.SetLineJoin(lineJoin)
+		}
+	}
+	if ml, err := core.GetNumberAsFloat(extdict.Get("ML")); err == nil {
+		ctx.SetMiterLimit(ml)
+	}
+	if arr, ok := core.GetArray(extdict.Get("D")); ok && arr.Len() == 2 {
+		dashArray, ok := core.GetArray(arr.Get(0))
+		if !ok {
+			return errType
+		}
+		dashes, err := core.GetNumbersAsFloat(dashArray.Elements())
+		if err != nil {
+			return err
+		}
+		phase, err := core.GetNumberAsFloat(arr.Get(1))
+		if err != nil {
+			return err
+		}
+		ctx.SetDash(dashes...)
+		ctx.SetDashOffset(phase)
+	}
+
+	if alpha, err := core.GetNumberAsFloat(extdict.Get("CA")); err == nil {
+		ctx.SetStrokeAlpha(clampUnit(alpha))
+	}
+	if alpha, err := core.GetNumberAsFloat(extdict.Get("ca")); err == nil {
+		ctx.SetFillAlpha(clampUnit(alpha))
+	}
+
+	// The overprint parameters only affect contexts rendering colorants
+	// separately. The OP entry also sets the fill overprint parameter, if
+	// the op entry is absent.
+	if inkCtx, ok := ctx.(context.InkContext); ok {
+		if op, ok := core.GetBoolVal(extdict.Get("OP")); ok {
+			inkCtx.SetStrokeOverprint(op)
+			if extdict.Get("op") == nil {
+				inkCtx.SetFillOverprint(op)
+			}
+		}
+		if op, ok := core.GetBoolVal(extdict.Get("op")); ok {
+			inkCtx.SetFillOverprint(op)
+		}
+		if mode, ok := core.GetIntVal(extdict.Get("OPM")); ok {
+			inkCtx.SetOverprintMode(mode)
+		}
+	}
+
+	// The blend mode may be specified as an array of names, in which case
+	// the first recognized blend mode is used.
+	if obj := extdict.Get("BM"); obj != nil {
+		names := []core.PdfObject{obj}
+		if arr, ok := core.GetArray(obj); ok {
+			names = arr.Elements()
+		}
+		for _, obj := range names {
+			name, ok := core.GetName(obj)
+			if !ok {
+				continue
+			}
+			if mode, ok := blendModes[*name]; ok {
+				ctx.SetBlendMode(mode)
+				break
+			}
+			common.Log.Debug("Unsupported blend mode: %s", name)
+		}
+	}
+
+	if obj := extdict.Get("SMask"); obj != nil {
+		if name, ok := core.GetName(obj); ok && *name == "None" {
+			ctx.SetSoftMask(nil)
+			return nil
+		}
+
+		smask, ok := core.GetDict(obj)
+		if !ok {
+			return errType
+		}
+		mask, err := r.newSoftMask(ctx, smask, resources)
+		if err != nil {
+			return err
+		}
+		ctx.SetSoftMask(mask)
+	}
+
+	return nil
+}
+
+// newSoftMask renders the transparency group of the specified soft mask
+// dictionary and derives the soft mask values from its alpha or luminosity.
+// The group is rendered using the current transformation matrix.
+//
+// See section 11.6.5.2 "Soft-Mask Dictionaries" and
+// Table 144 (pp. 339-340 PDF32000_2008).
+func (r renderer) newSoftMask(ctx context.Context, smask *core.PdfObjectDictionary,
+	resources *model.PdfPageResources) (*image.Alpha, error) {
+	subtype, _ := core.GetNameVal(smask.Get("S"))
+	if subtype != "Alpha" && subtype != "Luminosity" {
+		return nil, errors.New("invalid soft mask subtype")
+	}
+
+	stream, ok := core.GetStream(smask.Get("G"))
+	if !ok {
+		return nil, model.ErrRequiredAttributeMissing
+	}
+	xform, err := model.NewXObjectFormFromStream(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	width, height := ctx.Width(), ctx.Height()
+	maskCtx := imagerender.NewContext(width, height)
+	maskCtx.SetMatrix(ctx.Matrix())
+
+	// Luminosity masks are computed from the group composited with the
+	// backdrop color, which defaults to black.
+	luminosity := subtype == "Luminosity"
+	if luminosity {
+		backdrop, err := softMaskBackdrop(xform, smask.Get("BC"))
+		if err != nil {
+			common.Log.Debug("ERROR: invalid soft mask backdrop: %v", err)
+			backdrop = color.Black
+		}
+		maskCtx.Push()
+		maskCtx.Identity()
+		maskCtx.SetColor(backdrop)
+		maskCtx.DrawRectangle(0, 0, float64(width), float64(height))
+		maskCtx.Fill()
+		maskCtx.Pop()
+	}
+
+	maskCtx.SetLineWidth(1.0)
+	maskCtx.SetRGBA(0, 0, 0, 1)
+	if err := r.renderForm(maskCtx, xform, resources); err != nil {
+		return nil, err
+	}
+
+	var transfer model.PdfFunction
+	if obj := smask.Get("TR"); obj != nil {
+		if name, ok := core.GetName(obj); !ok || *name != "Identity" {
+			if transfer, err = model.NewPdfFunctionFromPdfObject(obj); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Calculate the mask values. The transfer function, if any, is applied
+	// using a lookup table.
+	var table [256]uint8
+	for i := range table {
+		table[i] = uint8(i)
+		if transfer == nil {
+			continue
+		}
+		out, err := transfer.Evaluate([]float64{float64(i) / 255})
+		if err != nil || len(out) == 0 {
+			common.Log.Debug("ERROR: could not evaluate soft mask transfer function: %v", err)
+			transfer = nil
+			continue
+		}
+		table[i] = uint8(math.Round(clampUnit(out[0]) * 255))
+	}
+
+	src, ok := maskCtx.Image().(*image.RGBA)
+	if !ok {
+		return nil, errType
+	}
+	mask := image.NewAlpha(src.Bounds())
+	for i := range mask.Pix {
+		pix := src.Pix[4*i : 4*i+4]
+		v := pix[3]
+		if luminosity {
+			l := 0.3*float64(pix[0]) + 0.59*float64(pix[1]) + 0.11*float64(pix[2])
+			v = uint8(math.Round(math.Min(l, 255)))
+		}
+		mask.Pix[i] = table[v]
+	}
+
+	return mask, nil
+}
+
+// softMaskBackdrop returns the backdrop color of a luminosity soft mask,
+// specified by the BC entry of the soft mask dictionary in the color space
+// of the transparency group of the mask.
+func softMaskBackdrop(xform *model.XObjectForm, bc core.PdfObject) (color.Color, error) {
+	arr, ok := core.GetArray(bc)
+	if !ok {
+		return color.Black, nil
+	}
+	values, err := arr.ToFloat64Array()
+	if err != nil {
+		return nil, err
+	}
+
+	var cs model.PdfColorspace
+	if groupDict, ok := core.GetDict(xform.Group); ok {
+		if obj := groupDict.Get("CS"); obj != nil {
+			if cs, err = model.NewPdfColorspaceFromPdfObject(obj); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if cs == nil {
+		switch len(values) {
+		case 1:
+			cs = model.NewPdfColorspaceDeviceGray()
+		case 3:
+			cs = model.NewPdfColorspaceDeviceRGB()
+		case 4:
+			cs = model.NewPdfColorspaceDeviceCMYK()
+		default:
+			return nil, errRange
+		}
+	}
+
+	c, err := cs.ColorFromFloats(values)
+	if err != nil {
+		return nil, err
+	}
+	rgbColor, err := colorToRGB(cs, c)
+	if err != nil {
+		return nil, err
+	}
+	return color.NRGBA{
+		R: uint8(math.Round(rgbColor.R() * 255)),
+		G: uint8(math.Round(rgbColor.G() * 255)),
+		B: uint8(math.Round(rgbColor.B() * 255)),
+		A: 255,
+	}, nil
+}
+
+// transparencyGroup returns the isolated and knockout flags of the
+// transparency group represented by the specified form XObject. The returned
+// bool is false if the form XObject is not a transparency group.
+//
+// See section 11.6.6 "Transparency Group XObjects" and
+// Table 147 (p. 342 PDF32000_2008).
+func transparencyGroup(xform *model.XObjectForm) (isolated, knockout, ok bool) {
+	groupDict, ok := core.GetDict(xform.Group)
+	if !ok {
+		return false, false, false
+	}
+	if subtype, _ := core.GetNameVal(groupDict.Get("S")); subtype != "Transparency" {
+		return false, false, false
+	}
+
+	if val, ok := core.GetBoolVal(groupDict.Get("I")); ok {
+		isolated = val
+	}
+	if val, ok := core.GetBoolVal(groupDict.Get("K")); ok {
+		knockout = val
+	}
+	return isolated, knockout, true
+}
+
+// renderForm renders the content stream of the specified form XObject. The
+// form is clipped to its bounding box and, if it is a transparency group,
+// composited with its backdrop as a group. The resources of the form default
+// to the specified resources.
+func (r renderer) renderForm(ctx context.Context, xform *model.XObjectForm,
+	resources *model.PdfPageResources) error {
+	operations, err := r.loadFormOperations(xform)
+	if err != nil {
+		return err
+	}
+
+	formResources := xform.Resources
+	if formResources == nil {
+		formResources = resources
+	}
+
+	ctx.Push()
+	defer ctx.Pop()
+
+	if xform.Matrix != nil {
+		m, err := formMatrix(xform)
+		if err != nil {
+			return err
+		}
+		ctx.SetMatrix(ctx.Matrix().Mult(m))
+	}
+
+	if xform.BBox != nil {
+		array, ok := core.GetArray(xform.BBox)
+		if !ok {
+			return errType
+		}
+
+		bf, err := core.GetNumbersAsFloat(array.Elements())
+		if err != nil {
+			return err
+		}
+		if len(bf) != 4 {
+			common.Log.Debug("Len = %d", len(bf))
+			return errRange
+		}
+
+		// Set clipping region.
+		ctx.DrawRectangle(bf[0], bf[1], bf[2]-bf[0], bf[3]-bf[1])
+		ctx.SetRGBA(1, 0, 0, 1)
+		ctx.Clip()
+	} else {
+		common.Log.Debug("ERROR: Required BBox missing on XObject Form")
+	}
+
+	if isolated, knockout, ok := transparencyGroup(xform); ok {
+		ctx.BeginGroup(isolated, knockout)
+		defer ctx.EndGroup()
+	}
+
+	// Process the content stream in the Form object.
+	return r.renderOperations(ctx, operations, formResources)
+}
+
+// formMatrix returns the matrix of the specified form XObject, which maps
+// form space to user space. Defaults to the identity matrix.
+func formMatrix(xform *model.XObjectForm) (transform.Matrix, error) {
+	if xform.Matrix == nil {
+		return transform.IdentityMatrix(), nil
+	}
+
+	array, ok := core.GetArray(xform.Matrix)
+	if !ok {
+		return transform.Matrix{}, errType
+	}
+
+	mf, err := core.GetNumbersAsFloat(array.Elements())
+	if err != nil {
+		return transform.Matrix{}, err
+	}
+	if len(mf) != 6 {
+		return transform.Matrix{}, errRange
+	}
+
+	return transform.NewMatrix(mf[0], mf[1], mf[2], mf[3], mf[4], mf[5]), nil
+}
+
+// applyImageSoftMask returns the specified image combined with the soft mask
+// image of the image XObject `ximg`, which specifies the alpha values of the
+// image samples (section 11.6.5.3 "Soft-Mask Images" p. 340 PDF32000_2008).
+// The soft mask is scaled to the dimensions of the image, if they differ.
+func applyImageSoftMask(img image.Image, ximg *model.XObjectImage) (image.Image, error) {
+	b := img.Bounds()
+	alpha, err := imageSoftMask(ximg, b)
+	if err != nil || alpha == nil {
+		return img, err
+	}
+
+	out := image.NewNRGBA(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			c.A = uint8(uint32(c.A) * uint32(alpha.AlphaAt(x, y).A) / 255)
+			out.SetNRGBA(x, y, c)
+		}
+	}
+	return out, nil
+}
+
+// imageSoftMask returns the alpha values specified by the soft mask image of
+// the image XObject `ximg`, scaled to the bounds `b` of the image. A nil
+// mask is returned if the image has no soft mask.
+func imageSoftMask(ximg *model.XObjectImage, b image.Rectangle) (*image.Alpha, error) {
+	stream, ok := core.GetStream(ximg.SMask)
+	if !ok {
+		return nil, nil
+	}
+
+	xmask, err := model.NewXObjectImageFromStream(stream)
+	if err != nil {
+		return nil, err
+	}
+	maskImg, err := xmask.ToImage()
+	if err != nil {
+		return nil, err
+	}
+	goMask, err := maskImg.ToGoImage()
+	if err != nil {
+		return nil, err
+	}
+
+	// The soft mask is a grayscale image, the values of which represent the
+	// alpha values of the image.
+	mb := goMask.Bounds()
+	alpha := image.NewAlpha(mb)
+	for y := mb.Min.Y; y < mb.Max.Y; y++ {
+		for x := mb.Min.X; x < mb.Max.X; x++ {
+			alpha.SetAlpha(x, y, color.Alpha{A: color.GrayModel.Convert(goMask.At(x, y)).(color.Gray).Y})
+		}
+	}
+
+	if mb.Size() != b.Size() {
+		scaled := image.NewAlpha(b)
+		draw.BiLinear.Scale(scaled, b, alpha, mb, draw.Src, nil)
+		alpha = scaled
+	}
+	return alpha, nil
+}
+
+// clampUnit returns the specified value, clamped to the 0-1 range.
+func clampUnit(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
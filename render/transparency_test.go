@@ -0,0 +1,110 @@
+/*
+ * This file is subject to the terms and conditions defined in
+ * file 'LICENSE.md', which is part of this source code package.
+ */
+
+package render
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/showntop/unipdf/core"
+	"github.com/showntop/unipdf/model"
+)
+
+// newExtGStateResources returns resources with the graphics state parameter
+// dictionary `gs` named GS0.
+func newExtGStateResources(t *testing.T, gs *core.PdfObjectDictionary) *model.PdfPageResources {
+	resources := model.NewPdfPageResources()
+	require.NoError(t, resources.AddExtGState("GS0", gs))
+	return resources
+}
+
+func TestFillAlpha(t *testing.T) {
+	resources := newExtGStateResources(t, parseTestDict(t, "<< /ca 0.5 /CA 1 >>"))
+	img := renderTestPage(t, "/GS0 gs 1 0 0 rg 0 0 50 100 re f", resources)
+	requirePixel(t, img, 25, 50, color.RGBA{255, 128, 128, 255}, 2)
+	requirePixel(t, img, 75, 50, testWhite, 1)
+}
+
+func TestBlendModes(t *testing.T) {
+	// A red square is painted with each blend mode over a backdrop whose left
+	// half is blue and whose right half is white.
+	testCases := []struct {
+		mode        string
+		blue, white color.Color
+	}{
+		{"Normal", testRed, testRed},
+		{"Multiply", testBlack, testRed},
+		{"Screen", color.RGBA{255, 0, 255, 255}, testWhite},
+		{"Darken", testBlack, testRed},
+		{"Lighten", color.RGBA{255, 0, 255, 255}, testWhite},
+		{"Difference", color.RGBA{255, 0, 255, 255}, color.RGBA{0, 255, 255, 255}},
+		{"Exclusion", color.RGBA{255, 0, 255, 255}, color.RGBA{0, 255, 255, 255}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.mode, func(t *testing.T) {
+			resources := newExtGStateResources(t, parseTestDict(t, "<< /BM /"+tc.mode+" >>"))
+			img := renderTestPage(t, "0 0 1 rg 0 0 50 100 re f /GS0 gs 1 0 0 rg 0 0 100 50 re f",
+				resources)
+			requirePixel(t, img, 25, 25, tc.blue, 1)
+			requirePixel(t, img, 75, 25, tc.white, 1)
+			requirePixel(t, img, 25, 75, testBlue, 1)
+			requirePixel(t, img, 75, 75, testWhite, 1)
+		})
+	}
+
+	// The first supported blend mode of an array is used.
+	resources := newExtGStateResources(t, parseTestDict(t, "<< /BM [/Unknown /Multiply] >>"))
+	img := renderTestPage(t, "0 0 1 rg 0 0 50 100 re f /GS0 gs 1 0 0 rg 0 0 100 50 re f", resources)
+	requirePixel(t, img, 25, 25, testBlack, 1)
+}
+
+// newSoftMaskResources returns resources with a graphics state parameter
+// dictionary named GS0 whose soft mask of subtype `subtype` is the
+// transparency group with content stream `group`.
+func newSoftMaskResources(t *testing.T, subtype, group string) *model.PdfPageResources {
+	form := makeTestStream(t, `<< /Type /XObject /Subtype /Form /BBox [0 0 100 100]
+		/Group << /S /Transparency /CS /DeviceRGB >> /Resources << >> >>`, []byte(group))
+	smask := parseTestDict(t, "<< /Type /Mask /S /"+subtype+" >>")
+	smask.Set("G", form)
+	gs := core.MakeDict()
+	gs.Set("SMask", smask)
+	return newExtGStateResources(t, gs)
+}
+
+func TestSoftMask(t *testing.T) {
+	t.Run("Luminosity", func(t *testing.T) {
+		// The mask is white on the left half, grey on the bottom right
+		// quarter and black, the backdrop color, elsewhere.
+		resources := newSoftMaskResources(t, "Luminosity",
+			"1 g 0 0 50 100 re f 0.5 g 50 0 50 50 re f")
+		img := renderTestPage(t, "/GS0 gs 1 0 0 rg 0 0 100 100 re f", resources)
+		requirePixel(t, img, 25, 50, testRed, 1)
+		requirePixel(t, img, 75, 25, color.RGBA{255, 128, 128, 255}, 2)
+		requirePixel(t, img, 75, 75, testWhite, 1)
+	})
+
+	t.Run("Alpha", func(t *testing.T) {
+		// The mask is the opacity of the group, regardless of its color.
+		resources := newSoftMaskResources(t, "Alpha", "0 g 0 0 50 100 re f")
+		img := renderTestPage(t, "/GS0 gs 0 0 1 rg 0 0 100 100 re f", resources)
+		requirePixel(t, img, 25, 50, testBlue, 1)
+		requirePixel(t, img, 75, 50, testWhite, 1)
+	})
+
+	t.Run("None", func(t *testing.T) {
+		// The soft mask is removed by a soft mask of None and by restoring
+		// the graphics state.
+		resources := newSoftMaskResources(t, "Alpha", "0 g 0 0 50 100 re f")
+		require.NoError(t, resources.AddExtGState("GS1", parseTestDict(t, "<< /SMask /None >>")))
+		img := renderTestPage(t, "q /GS0 gs /GS1 gs 0 0 1 rg 0 0 100 50 re f Q "+
+			"q /GS0 gs Q 0 1 0 rg 0 50 100 50 re f", resources)
+		requirePixel(t, img, 75, 25, testBlue, 1)
+		requirePixel(t, img, 75, 75, testGreen, 1)
+	})
+}